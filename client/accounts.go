@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// AccountsService calls the /api/v1/accounts endpoints.
+type AccountsService struct {
+	c *Client
+}
+
+// OpenAccountRequest is the body of POST /api/v1/accounts. An empty TenantID
+// defaults to the caller's tenant.
+type OpenAccountRequest struct {
+	TenantID               string `json:"tenant_id,omitempty"`
+	AccountType            string `json:"account_type"`
+	Currency               string `json:"currency"`
+	HolderFirstName        string `json:"holder_first_name"`
+	HolderLastName         string `json:"holder_last_name"`
+	HolderEmail            string `json:"holder_email"`
+	IdentityVerificationID string `json:"identity_verification_id,omitempty"`
+}
+
+// OpenAccountResponse is the result of opening an account.
+type OpenAccountResponse struct {
+	AccountID         string `json:"account_id"`
+	AccountNumber     string `json:"account_number"`
+	Status            string `json:"status"`
+	LedgerAccountCode string `json:"ledger_account_code"`
+}
+
+// Account is a customer account.
+type Account struct {
+	AccountID         string `json:"account_id"`
+	TenantID          string `json:"tenant_id"`
+	AccountNumber     string `json:"account_number"`
+	AccountType       string `json:"account_type"`
+	Status            string `json:"status"`
+	Currency          string `json:"currency"`
+	LedgerAccountCode string `json:"ledger_account_code"`
+	HolderFirstName   string `json:"holder_first_name"`
+	HolderLastName    string `json:"holder_last_name"`
+	HolderEmail       string `json:"holder_email"`
+	Version           int32  `json:"version"`
+}
+
+// ListAccountsParams filters GET /api/v1/accounts.
+type ListAccountsParams struct {
+	TenantID string
+	HolderID string
+}
+
+// AccountList is a list of accounts.
+type AccountList struct {
+	Accounts   []Account `json:"accounts"`
+	TotalCount int32     `json:"total_count"`
+}
+
+type reasonBody struct {
+	Reason string `json:"reason"`
+}
+
+// Open opens an account.
+func (s *AccountsService) Open(ctx context.Context, req *OpenAccountRequest) (*OpenAccountResponse, error) {
+	var resp OpenAccountResponse
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/accounts", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get returns an account.
+func (s *AccountsService) Get(ctx context.Context, accountID string) (*Account, error) {
+	var resp Account
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/accounts/"+url.PathEscape(accountID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Freeze freezes an account.
+func (s *AccountsService) Freeze(ctx context.Context, accountID, reason string) (*Account, error) {
+	var resp Account
+	path := "/api/v1/accounts/" + url.PathEscape(accountID) + "/freeze"
+	if err := s.c.do(ctx, http.MethodPost, path, nil, reasonBody{Reason: reason}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Close closes an account.
+func (s *AccountsService) Close(ctx context.Context, accountID, reason string) (*Account, error) {
+	var resp Account
+	path := "/api/v1/accounts/" + url.PathEscape(accountID) + "/close"
+	if err := s.c.do(ctx, http.MethodPost, path, nil, reasonBody{Reason: reason}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// List lists accounts.
+func (s *AccountsService) List(ctx context.Context, params ListAccountsParams) (*AccountList, error) {
+	q := url.Values{}
+	if params.TenantID != "" {
+		q.Set("tenant_id", params.TenantID)
+	}
+	if params.HolderID != "" {
+		q.Set("holder_id", params.HolderID)
+	}
+	var resp AccountList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/accounts", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
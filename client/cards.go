@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// CardsService calls the /api/v1/cards endpoints.
+type CardsService struct {
+	c *Client
+}
+
+// IssueCardRequest is the body of POST /api/v1/cards. Limits are decimal
+// strings.
+type IssueCardRequest struct {
+	TenantID     string `json:"tenant_id,omitempty"`
+	AccountID    string `json:"account_id"`
+	CardType     string `json:"card_type"`
+	Currency     string `json:"currency"`
+	DailyLimit   string `json:"daily_limit"`
+	MonthlyLimit string `json:"monthly_limit"`
+}
+
+// CardStatus is the result of issuing or freezing a card.
+type CardStatus struct {
+	CardID string `json:"card_id"`
+	Status string `json:"status"`
+}
+
+// Card is a payment card. Only the masked PAN is ever returned.
+type Card struct {
+	CardID       string            `json:"card_id"`
+	TenantID     string            `json:"tenant_id"`
+	AccountID    string            `json:"account_id"`
+	CardType     string            `json:"card_type"`
+	Status       string            `json:"status"`
+	Currency     string            `json:"currency"`
+	DailyLimit   string            `json:"daily_limit"`
+	MonthlyLimit string            `json:"monthly_limit"`
+	MaskedPAN    string            `json:"masked_pan"`
+	Controls     *SpendingControls `json:"spending_controls,omitempty"`
+	Version      int32             `json:"version"`
+}
+
+// SpendingControls restricts where, and how quickly, a card can be spent.
+// Empty lists allow everything; blocked lists win over allowed lists. MCCs
+// are four digits, countries ISO 3166-1 alpha-2 codes and amounts decimal
+// strings.
+type SpendingControls struct {
+	AllowedMCCs       []string        `json:"allowed_mccs,omitempty"`
+	BlockedMCCs       []string        `json:"blocked_mccs,omitempty"`
+	MaxPerTransaction string          `json:"max_per_transaction,omitempty"`
+	AllowedCountries  []string        `json:"allowed_countries,omitempty"`
+	BlockedCountries  []string        `json:"blocked_countries,omitempty"`
+	VelocityLimits    []VelocityLimit `json:"velocity_limits,omitempty"`
+}
+
+// VelocityLimit caps the transactions a card authorizes in a rolling HOUR
+// or DAY window by count, amount or both.
+type VelocityLimit struct {
+	Window    string `json:"window"`
+	MaxAmount string `json:"max_amount,omitempty"`
+	MaxCount  int32  `json:"max_count,omitempty"`
+}
+
+// CardSpendingControls is a card's spending controls after an update.
+type CardSpendingControls struct {
+	Controls *SpendingControls `json:"spending_controls"`
+	CardID   string            `json:"card_id"`
+}
+
+// AuthorizeRequest is the body of POST /api/v1/cards/{id}/authorize.
+type AuthorizeRequest struct {
+	Amount           string `json:"amount"`
+	Currency         string `json:"currency"`
+	MerchantName     string `json:"merchant_name"`
+	MerchantCategory string `json:"merchant_category"`
+	MerchantCountry  string `json:"merchant_country,omitempty"`
+}
+
+// Authorization is the outcome of a card authorization.
+type Authorization struct {
+	Approved      bool      `json:"approved"`
+	DeclineReason string    `json:"decline_reason,omitempty"`
+	Merchant      *Merchant `json:"merchant,omitempty"`
+}
+
+// Merchant is a card merchant as shown to customers: its normalized name,
+// spending category and, for recognized brands, logo.
+type Merchant struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Logo     string `json:"logo,omitempty"`
+}
+
+// Issue issues a card.
+func (s *CardsService) Issue(ctx context.Context, req *IssueCardRequest) (*CardStatus, error) {
+	var resp CardStatus
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/cards", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get returns a card.
+func (s *CardsService) Get(ctx context.Context, cardID string) (*Card, error) {
+	var resp Card
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/cards/"+url.PathEscape(cardID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Freeze freezes a card.
+func (s *CardsService) Freeze(ctx context.Context, cardID string) (*CardStatus, error) {
+	var resp CardStatus
+	path := "/api/v1/cards/" + url.PathEscape(cardID) + "/freeze"
+	if err := s.c.do(ctx, http.MethodPost, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Authorize authorizes a transaction on a card.
+func (s *CardsService) Authorize(ctx context.Context, cardID string, req *AuthorizeRequest) (*Authorization, error) {
+	var resp Authorization
+	path := "/api/v1/cards/" + url.PathEscape(cardID) + "/authorize"
+	if err := s.c.do(ctx, http.MethodPost, path, nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateSpendingControls replaces a card's spending controls.
+func (s *CardsService) UpdateSpendingControls(ctx context.Context, cardID string, req *SpendingControls) (*CardSpendingControls, error) {
+	var resp CardSpendingControls
+	path := "/api/v1/cards/" + url.PathEscape(cardID) + "/spending-controls"
+	if err := s.c.do(ctx, http.MethodPut, path, nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,348 @@
+// Package client is the Go SDK for the bib gateway REST API.
+//
+// Its request and response types mirror the gateway's JSON contract
+// (api/openapi/bib-gateway-v1.yaml). A Client authenticates every request
+// with a bearer token, retries transient failures, and sends an
+// Idempotency-Key with every mutating request so a retried call is applied
+// at most once. List endpoints that page by offset also have iterator
+// methods that fetch pages on demand.
+//
+//	c, err := client.New("https://api.bib.example", client.WithToken(token))
+//	acct, err := c.Accounts.Open(ctx, &client.OpenAccountRequest{...})
+//	for tenant, err := range c.Tenants.All(ctx, client.ListTenantsParams{}) {
+//		...
+//	}
+package client
+
+import (
+	"bytes"
+	"context"
+	crand "crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxErrorBody caps how much of an error response body is read.
+const maxErrorBody = 64 << 10
+
+// TokenSource supplies the bearer token sent with each request. It is called
+// once per attempt, so implementations can refresh expiring tokens.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticToken is a TokenSource that always returns the same token.
+type StaticToken string
+
+// Token returns the token.
+func (t StaticToken) Token(context.Context) (string, error) {
+	return string(t), nil
+}
+
+// RetryPolicy controls how transient failures are retried. Network errors and
+// 429, 502, 503 and 504 responses are retried; the wait doubles from
+// InitialBackoff up to MaxBackoff, or follows the server's Retry-After header.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryPolicy is used when no WithRetryPolicy option is given.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+}
+
+// NoRetry disables retries.
+var NoRetry = RetryPolicy{MaxAttempts: 1}
+
+// Client is a client for the bib gateway API. It is safe for concurrent use.
+type Client struct {
+	httpClient *http.Client
+	tokens     TokenSource
+	baseURL    *url.URL
+	userAgent  string
+	retry      RetryPolicy
+
+	Accounts   *AccountsService
+	Payments   *PaymentsService
+	FX         *FXService
+	Identity   *IdentityService
+	Deposits   *DepositsService
+	Cards      *CardsService
+	Lending    *LendingService
+	Fraud      *FraudService
+	Tenants    *TenantsService
+	Scheduler  *SchedulerService
+	Statements *StatementsService
+	Webhooks   *WebhooksService
+	Treasury   *TreasuryService
+	Privacy    *PrivacyService
+	Limits     *LimitsService
+	Pricing    *PricingService
+	Consent    *ConsentService
+	Close      *CloseService
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to send requests.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) { c.httpClient = hc }
+}
+
+// WithToken authenticates requests with a fixed bearer token.
+func WithToken(token string) Option {
+	return WithTokenSource(StaticToken(token))
+}
+
+// WithTokenSource authenticates requests with tokens from ts.
+func WithTokenSource(ts TokenSource) Option {
+	return func(c *Client) { c.tokens = ts }
+}
+
+// WithRetryPolicy sets the retry policy.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(c *Client) { c.retry = p }
+}
+
+// WithUserAgent sets the User-Agent header.
+func WithUserAgent(ua string) Option {
+	return func(c *Client) { c.userAgent = ua }
+}
+
+// New creates a client for the gateway at baseURL, for example
+// "http://localhost:8080".
+func New(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(strings.TrimSuffix(baseURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("parse base url: %w", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("base url %q must be absolute", baseURL)
+	}
+
+	c := &Client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		baseURL:    u,
+		userAgent:  "bib-go-client",
+		retry:      DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.retry.MaxAttempts < 1 {
+		c.retry.MaxAttempts = 1
+	}
+
+	c.Accounts = &AccountsService{c: c}
+	c.Payments = &PaymentsService{c: c}
+	c.FX = &FXService{c: c}
+	c.Identity = &IdentityService{c: c}
+	c.Deposits = &DepositsService{c: c}
+	c.Cards = &CardsService{c: c}
+	c.Lending = &LendingService{c: c}
+	c.Fraud = &FraudService{c: c}
+	c.Tenants = &TenantsService{c: c}
+	c.Scheduler = &SchedulerService{c: c}
+	c.Statements = &StatementsService{c: c}
+	c.Webhooks = &WebhooksService{c: c}
+	c.Treasury = &TreasuryService{c: c}
+	c.Privacy = &PrivacyService{c: c}
+	c.Limits = &LimitsService{c: c}
+	c.Pricing = &PricingService{c: c}
+	c.Consent = &ConsentService{c: c}
+	c.Close = &CloseService{c: c}
+	return c, nil
+}
+
+// APIError is returned when the gateway answers with a non-2xx status.
+type APIError struct {
+	Message    string
+	StatusCode int
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("bib api: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("bib api: %d %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is an APIError with status 404.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+type idempotencyKeyCtx struct{}
+
+// WithIdempotencyKey returns a context whose mutating requests carry key as
+// their Idempotency-Key, so a caller can safely repeat an operation across
+// process restarts. Without it, each call gets a fresh random key that is
+// reused for that call's retries.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtx{}, key)
+}
+
+func idempotencyKey(ctx context.Context) (string, error) {
+	if key, ok := ctx.Value(idempotencyKeyCtx{}).(string); ok && key != "" {
+		return key, nil
+	}
+	var b [16]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("generate idempotency key: %w", err)
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+// do sends a request to path, encoding body (if not nil) as JSON and decoding
+// a successful response into out (if not nil). An out of type *[]byte
+// receives the raw response body, for downloads.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+	}
+
+	u := *c.baseURL
+	u.Path += path
+	u.RawQuery = query.Encode()
+
+	var key string
+	if method != http.MethodGet && method != http.MethodHead {
+		var err error
+		if key, err = idempotencyKey(ctx); err != nil {
+			return err
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		wait, err := c.send(ctx, method, u.String(), key, payload, out)
+		if wait < 0 || attempt >= c.retry.MaxAttempts {
+			return err
+		}
+		if wait == 0 {
+			wait = c.backoff(attempt)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// send makes one attempt. It returns a negative wait when the outcome is
+// final, zero when the request should be retried after the policy's backoff,
+// and a positive wait when the server asked for one with Retry-After.
+func (c *Client) send(ctx context.Context, method, u, key string, payload []byte, out any) (time.Duration, error) {
+	var reqBody io.Reader
+	if payload != nil {
+		reqBody = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, reqBody)
+	if err != nil {
+		return -1, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if key != "" {
+		req.Header.Set("Idempotency-Key", key)
+	}
+	if c.tokens != nil {
+		token, err := c.tokens.Token(ctx)
+		if err != nil {
+			return -1, fmt.Errorf("get token: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return -1, err
+		}
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if out == nil {
+			return -1, nil
+		}
+		if raw, ok := out.(*[]byte); ok {
+			if *raw, err = io.ReadAll(resp.Body); err != nil {
+				return 0, fmt.Errorf("read response: %w", err)
+			}
+			return -1, nil
+		}
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil && !errors.Is(err, io.EOF) {
+			return -1, fmt.Errorf("decode response: %w", err)
+		}
+		return -1, nil
+	}
+
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody)) //nolint:errcheck
+	var errBody struct {
+		Error string `json:"error"`
+	}
+	if json.Unmarshal(data, &errBody) == nil && errBody.Error != "" {
+		apiErr.Message = errBody.Error
+	} else {
+		apiErr.Message = strings.TrimSpace(string(data))
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return retryAfter(resp.Header.Get("Retry-After")), apiErr
+	default:
+		return -1, apiErr
+	}
+}
+
+// backoff returns the wait before the retry following the given attempt:
+// exponential from InitialBackoff, capped at MaxBackoff, with jitter over its
+// upper half.
+func (c *Client) backoff(attempt int) time.Duration {
+	d := c.retry.InitialBackoff << (attempt - 1)
+	if c.retry.MaxBackoff > 0 && (d > c.retry.MaxBackoff || d <= 0) {
+		d = c.retry.MaxBackoff
+	}
+	if d <= 1 {
+		return max(d, time.Nanosecond)
+	}
+	return d/2 + rand.N(d/2)
+}
+
+// retryAfter parses a Retry-After header given in seconds, returning zero if
+// it is absent or malformed.
+func retryAfter(v string) time.Duration {
+	secs, err := strconv.Atoi(v)
+	if err != nil || secs <= 0 {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
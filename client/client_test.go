@@ -0,0 +1,313 @@
+package client_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/client"
+)
+
+func newTestClient(t *testing.T, h http.HandlerFunc, opts ...client.Option) *client.Client {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	opts = append([]client.Option{
+		client.WithToken("tok"),
+		client.WithRetryPolicy(client.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}),
+	}, opts...)
+	c, err := client.New(srv.URL, opts...)
+	require.NoError(t, err)
+	return c
+}
+
+func TestClient_SendsAuthAndDecodesResponse(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer tok", r.Header.Get("Authorization"))
+		assert.Equal(t, "/api/v1/fx/rates/USD-EUR", r.URL.Path)
+		assert.Empty(t, r.Header.Get("Idempotency-Key"), "reads carry no idempotency key")
+		_, _ = w.Write([]byte(`{"base_currency":"USD","quote_currency":"EUR","rate":"0.92","timestamp":"2026-03-10T12:00:00Z"}`))
+	})
+
+	rate, err := c.FX.GetRate(context.Background(), "USD", "EUR")
+	require.NoError(t, err)
+	assert.Equal(t, "0.92", rate.Rate)
+	assert.Equal(t, "EUR", rate.QuoteCurrency)
+}
+
+func TestClient_DownloadReturnsRawBody(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/statements/st-1/content", r.URL.Path)
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = w.Write([]byte("date,description,amount\n"))
+	})
+
+	content, err := c.Statements.Download(context.Background(), "st-1")
+	require.NoError(t, err)
+	assert.Equal(t, "date,description,amount\n", string(content))
+}
+
+func TestTreasury_GetPositionSendsHorizon(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/treasury/positions/n-1", r.URL.Path)
+		assert.Equal(t, "horizon_days=10", r.URL.RawQuery)
+		_, _ = w.Write([]byte(`{"position":{"nostro_id":"n-1","currency":"USD","booked_balance":"1000",` +
+			`"projections":[{"date":"2026-03-10","closing_balance":"400","below_minimum":true}]}}`))
+	})
+
+	pos, err := c.Treasury.GetPosition(context.Background(), "n-1", client.PositionOptions{Currency: "USD", HorizonDays: 10})
+	require.NoError(t, err)
+	assert.Equal(t, "1000", pos.BookedBalance)
+	require.Len(t, pos.Projections, 1)
+	assert.True(t, pos.Projections[0].BelowMinimum)
+}
+
+func TestPrivacy_PutRetentionPolicy(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/privacy/retention-policies/identity.applicant_data", r.URL.Path)
+		var body map[string]int
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]int{"retention_days": 30}, body)
+		_, _ = w.Write([]byte(`{"policy":{"category":"identity.applicant_data","retention_days":30,"version":1}}`))
+	})
+
+	policy, err := c.Privacy.PutRetentionPolicy(context.Background(), "identity.applicant_data", 30)
+	require.NoError(t, err)
+	assert.Equal(t, int32(30), policy.RetentionDays)
+	assert.Equal(t, int32(1), policy.Version)
+}
+
+func TestLimits_CheckAndReserveDeclined(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/limits/reservations", r.URL.Path)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "CARDS", body["category"])
+		assert.Equal(t, "pay-1", body["reference"])
+		_, _ = w.Write([]byte(`{"approved":false,"headroom":"250","binding_limit_id":"l-1"}`))
+	})
+
+	res, err := c.Limits.CheckAndReserve(context.Background(), &client.CheckAndReserveRequest{
+		PartyID:   "p-1",
+		Category:  "CARDS",
+		Currency:  "USD",
+		Amount:    "300",
+		Reference: "pay-1",
+	})
+	require.NoError(t, err)
+	assert.False(t, res.Approved)
+	assert.Nil(t, res.Reservation)
+	assert.Equal(t, "l-1", res.BindingLimitID)
+}
+
+func TestPricing_EvaluateFee(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/pricing/evaluate", r.URL.Path)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "PAYMENTS", body["product"])
+		assert.Equal(t, "ACH_TRANSFER", body["fee_code"])
+		assert.NotContains(t, body, "reference")
+		_, _ = w.Write([]byte(`{"fee":"1.75","currency":"USD","priced":true,"schedule_id":"s-1",` +
+			`"rule":{"fee_code":"ACH_TRANSFER","currency":"USD","fixed":"0.25","rate":"0.015"}}`))
+	})
+
+	eval, err := c.Pricing.EvaluateFee(context.Background(), &client.EvaluateFeeRequest{
+		Product:  "PAYMENTS",
+		Channel:  "ONLINE",
+		FeeCode:  "ACH_TRANSFER",
+		Currency: "USD",
+		Amount:   "100",
+	})
+	require.NoError(t, err)
+	assert.True(t, eval.Priced)
+	assert.Equal(t, "1.75", eval.Fee)
+	assert.Equal(t, "0.015", eval.Rule.Rate)
+	assert.Nil(t, eval.Assessment)
+}
+
+func TestConsent_CheckConsent(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/api/v1/consent/check", r.URL.Path)
+		assert.Equal(t, "CREDIT_PULL", r.URL.Query().Get("type"))
+		assert.Equal(t, "c-1", r.URL.Query().Get("customer_id"))
+		_, _ = w.Write([]byte(`{"granted":false,"status":"EXPIRED","receipt_id":"r-1"}`))
+	})
+
+	check, err := c.Consent.CheckConsent(context.Background(), "c-1", "CREDIT_PULL")
+	require.NoError(t, err)
+	assert.False(t, check.Granted)
+	assert.Equal(t, "EXPIRED", check.Status)
+	assert.Equal(t, "r-1", check.ReceiptID)
+}
+
+func TestClose_SignOff(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/close/periods/2026-03/sign-offs", r.URL.Path)
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]string{"role": "REVIEWER"}, body)
+		_, _ = w.Write([]byte(`{"close":{"period":"2026-03","status":"SIGNED_OFF","version":7,` +
+			`"sign_offs":[{"role":"PREPARER","user_id":"u1"},{"role":"REVIEWER","user_id":"u2"}]}}`))
+	})
+
+	pc, err := c.Close.SignOff(context.Background(), "2026-03", "REVIEWER")
+	require.NoError(t, err)
+	assert.Equal(t, "SIGNED_OFF", pc.Status)
+	require.Len(t, pc.SignOffs, 2)
+	assert.Equal(t, "u2", pc.SignOffs[1].UserID)
+}
+
+func TestScheduler_StartEODRun(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/scheduler/eod-runs", r.URL.Path)
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]string{"business_date": "2026-03-10"}, body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"run":{"eod_run_id":"e1","business_date":"2026-03-10","status":"RUNNING",` +
+			`"steps":[{"name":"payments.cutoff","status":"PENDING"}]}}`))
+	})
+
+	run, err := c.Scheduler.StartEODRun(context.Background(), "2026-03-10")
+	require.NoError(t, err)
+	assert.Equal(t, "e1", run.EODRunID)
+	require.Len(t, run.Steps, 1)
+	assert.Equal(t, "PENDING", run.Steps[0].Status)
+}
+
+func TestScheduler_AdvanceClock(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/scheduler/clock/advance", r.URL.Path)
+		var body map[string]int
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]int{"days": 30}, body)
+		_, _ = w.Write([]byte(`{"clock":{"business_date":"2026-04-09","tenant_id":"t1"},"days_simulated":30,"job_runs":120}`))
+	})
+
+	adv, err := c.Scheduler.AdvanceClock(context.Background(), 30)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-04-09", adv.Clock.BusinessDate)
+	assert.Equal(t, int32(30), adv.DaysSimulated)
+	assert.Equal(t, int32(120), adv.JobRuns)
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	body := []byte(`{"type":"payment.order.completed"}`)
+	sign := func(at time.Time, secret string) string {
+		ts := strconv.FormatInt(at.Unix(), 10)
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(ts + "." + string(body)))
+		return "t=" + ts + ",v1=deadbeef,v1=" + hex.EncodeToString(mac.Sum(nil))
+	}
+	header := sign(time.Now(), "whsec_new")
+
+	assert.True(t, client.VerifyWebhookSignature(header, "whsec_new", body, 5*time.Minute))
+	assert.False(t, client.VerifyWebhookSignature(header, "whsec_other", body, 5*time.Minute))
+	assert.False(t, client.VerifyWebhookSignature(header, "whsec_new", []byte(`{}`), 5*time.Minute))
+	assert.False(t, client.VerifyWebhookSignature(sign(time.Now().Add(-time.Hour), "whsec_new"), "whsec_new", body, 5*time.Minute),
+		"stale timestamps are rejected")
+}
+
+func TestClient_RetriesMutationsWithSameIdempotencyKey(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		keys []string
+	)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		n := len(keys)
+		mu.Unlock()
+
+		var body client.OpenAccountRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "USD", body.Currency, "the body is resent on every attempt")
+
+		if n < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"error":"try again"}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"account_id":"a1","status":"ACTIVE"}`))
+	})
+
+	resp, err := c.Accounts.Open(context.Background(), &client.OpenAccountRequest{AccountType: "CHECKING", Currency: "USD"})
+	require.NoError(t, err)
+	assert.Equal(t, "a1", resp.AccountID)
+
+	require.Len(t, keys, 3)
+	assert.NotEmpty(t, keys[0])
+	assert.Equal(t, keys[0], keys[1])
+	assert.Equal(t, keys[0], keys[2])
+}
+
+func TestClient_CallerIdempotencyKey(t *testing.T) {
+	var got string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Idempotency-Key")
+		_, _ = w.Write([]byte(`{"card_id":"c1","status":"FROZEN"}`))
+	})
+
+	ctx := client.WithIdempotencyKey(context.Background(), "freeze-c1")
+	_, err := c.Cards.Freeze(ctx, "c1")
+	require.NoError(t, err)
+	assert.Equal(t, "freeze-c1", got)
+}
+
+func TestClient_DoesNotRetryClientErrors(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"payment not found"}`))
+	})
+
+	_, err := c.Payments.Get(context.Background(), "p1")
+	require.Error(t, err)
+	assert.True(t, client.IsNotFound(err))
+	assert.Equal(t, 1, calls)
+
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "payment not found", apiErr.Message)
+}
+
+func TestClient_GivesUpAfterMaxAttempts(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, err := c.Tenants.Get(context.Background(), "t1")
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+	assert.Equal(t, 3, calls)
+}
+
+func TestNew_RejectsRelativeURL(t *testing.T) {
+	_, err := client.New("localhost:8080/api")
+	require.Error(t, err)
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// CloseService calls the /api/v1/close endpoints.
+type CloseService struct {
+	c *Client
+}
+
+// ChecklistItem is one step of the month-end close checklist. CompletedBy
+// and CompletedAt are empty while the item is open.
+type ChecklistItem struct {
+	Key         string `json:"key"`
+	Title       string `json:"title"`
+	CompletedBy string `json:"completed_by"`
+	CompletedAt string `json:"completed_at"`
+	Note        string `json:"note"`
+}
+
+// SignOff is a signature on the checklist. Role is PREPARER or REVIEWER.
+type SignOff struct {
+	Role     string `json:"role"`
+	UserID   string `json:"user_id"`
+	SignedAt string `json:"signed_at"`
+}
+
+// PeriodClose is a tenant's close of a YYYY-MM accounting period. Status is
+// OPEN, SIGNED_OFF or CLOSED.
+type PeriodClose struct {
+	Period   string           `json:"period"`
+	Status   string           `json:"status"`
+	Items    []*ChecklistItem `json:"items"`
+	SignOffs []*SignOff       `json:"sign_offs"`
+	ClosedBy string           `json:"closed_by"`
+	ClosedAt string           `json:"closed_at"`
+	Version  int32            `json:"version"`
+}
+
+// CloseCheck is one of the checks a period must pass before it is closed.
+// Name is unposted_interest, reconciliation_breaks or failed_accruals;
+// Status is CLEAR, WARNING, BLOCKING or UNAVAILABLE.
+type CloseCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Count  int32  `json:"count"`
+	Detail string `json:"detail"`
+}
+
+// CloseDashboard is the close status of a period across the ledger, the
+// backoffice and the scheduler.
+type CloseDashboard struct {
+	Close        *PeriodClose  `json:"close"`
+	LedgerStatus string        `json:"ledger_status"`
+	Checks       []*CloseCheck `json:"checks"`
+	ReadyToClose bool          `json:"ready_to_close"`
+}
+
+// GetDashboard returns the close status of a YYYY-MM period.
+func (s *CloseService) GetDashboard(ctx context.Context, period string) (*CloseDashboard, error) {
+	var resp CloseDashboard
+	if err := s.c.do(ctx, http.MethodGet, closePath(period, ""), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CompleteItem marks a checklist item done.
+func (s *CloseService) CompleteItem(ctx context.Context, period, itemKey, note string) (*PeriodClose, error) {
+	body := map[string]string{"note": note}
+	return s.post(ctx, closePath(period, "/items/"+url.PathEscape(itemKey)+"/complete"), body)
+}
+
+// ReopenItem reopens a completed checklist item, withdrawing the
+// checklist's sign-offs.
+func (s *CloseService) ReopenItem(ctx context.Context, period, itemKey, reason string) (*PeriodClose, error) {
+	body := map[string]string{"reason": reason}
+	return s.post(ctx, closePath(period, "/items/"+url.PathEscape(itemKey)+"/reopen"), body)
+}
+
+// SignOff signs the completed checklist off as role, PREPARER or REVIEWER.
+func (s *CloseService) SignOff(ctx context.Context, period, role string) (*PeriodClose, error) {
+	return s.post(ctx, closePath(period, "/sign-offs"), map[string]string{"role": role})
+}
+
+// ClosePeriod closes a signed-off period in the ledger.
+func (s *CloseService) ClosePeriod(ctx context.Context, period string) (*PeriodClose, error) {
+	return s.post(ctx, closePath(period, "/close"), struct{}{})
+}
+
+func (s *CloseService) post(ctx context.Context, path string, body any) (*PeriodClose, error) {
+	var resp struct {
+		Close *PeriodClose `json:"close"`
+	}
+	if err := s.c.do(ctx, http.MethodPost, path, nil, body, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Close, nil
+}
+
+func closePath(period, suffix string) string {
+	return "/api/v1/close/periods/" + url.PathEscape(period) + suffix
+}
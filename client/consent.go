@@ -0,0 +1,227 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// ConsentService calls the /api/v1/consent endpoints.
+type ConsentService struct {
+	c *Client
+}
+
+// Disclosure is a version of the text customers consent to, identified by
+// the hex SHA-256 of its content. Type is TERMS_OF_SERVICE, ESIGN or
+// CREDIT_PULL. A later version supersedes it from its EffectiveFrom.
+type Disclosure struct {
+	DisclosureID  string `json:"disclosure_id"`
+	TenantID      string `json:"tenant_id"`
+	Type          string `json:"type"`
+	Version       string `json:"version"`
+	ContentHash   string `json:"content_hash"`
+	URL           string `json:"url"`
+	EffectiveFrom string `json:"effective_from"`
+	PublishedAt   string `json:"published_at"`
+}
+
+// PublishDisclosureRequest is the body used to publish a disclosure version.
+// Leave EffectiveFrom empty for a version in effect at once; otherwise it is
+// RFC 3339.
+type PublishDisclosureRequest struct {
+	Type          string `json:"type"`
+	Version       string `json:"version"`
+	ContentHash   string `json:"content_hash"`
+	URL           string `json:"url,omitempty"`
+	EffectiveFrom string `json:"effective_from,omitempty"`
+}
+
+// DisclosureList is one page of disclosures.
+type DisclosureList struct {
+	Disclosures []*Disclosure `json:"disclosures"`
+	TotalCount  int32         `json:"total_count"`
+}
+
+// ConsentReceipt is the immutable record of a customer granting or
+// withdrawing consent. Type is TERMS_OF_SERVICE, ESIGN, CREDIT_PULL,
+// MARKETING_EMAIL, MARKETING_SMS or MARKETING_PUSH; Action is GRANTED or
+// WITHDRAWN. Each receipt's Hash covers its content and PreviousHash, the
+// hash of the customer's previous receipt of the type.
+type ConsentReceipt struct {
+	ReceiptID         string `json:"receipt_id"`
+	TenantID          string `json:"tenant_id"`
+	CustomerID        string `json:"customer_id"`
+	Type              string `json:"type"`
+	Action            string `json:"action"`
+	DisclosureID      string `json:"disclosure_id"`
+	DisclosureVersion string `json:"disclosure_version"`
+	ContentHash       string `json:"content_hash"`
+	Channel           string `json:"channel"`
+	Evidence          string `json:"evidence"`
+	CapturedAt        string `json:"captured_at"`
+	ExpiresAt         string `json:"expires_at"`
+	PreviousHash      string `json:"previous_hash"`
+	Hash              string `json:"hash"`
+	RecordedAt        string `json:"recorded_at"`
+	Sequence          int32  `json:"sequence"`
+}
+
+// RecordConsentRequest is the body used to record a customer granting or
+// withdrawing consent. CustomerID defaults to the caller. Channel is ONLINE,
+// MOBILE, BRANCH, PHONE or PAPER. A grant without DisclosureID cites the
+// disclosure in effect for the type. CapturedAt and ExpiresAt are RFC 3339;
+// leave them empty for now and the type's default expiry.
+type RecordConsentRequest struct {
+	CustomerID   string `json:"customer_id,omitempty"`
+	Type         string `json:"type"`
+	Action       string `json:"action"`
+	DisclosureID string `json:"disclosure_id,omitempty"`
+	Channel      string `json:"channel"`
+	Evidence     string `json:"evidence,omitempty"`
+	CapturedAt   string `json:"captured_at,omitempty"`
+	ExpiresAt    string `json:"expires_at,omitempty"`
+}
+
+// ConsentCheck is whether a customer's consent stands. Status is GRANTED,
+// NOT_GIVEN, WITHDRAWN, EXPIRED or OUTDATED, the last when the customer
+// consented to a superseded disclosure.
+type ConsentCheck struct {
+	Status    string `json:"status"`
+	ReceiptID string `json:"receipt_id"`
+	Granted   bool   `json:"granted"`
+}
+
+// ConsentState is a customer's standing consent of one type, and the
+// receipt it derives from.
+type ConsentState struct {
+	Type    string          `json:"type"`
+	Status  string          `json:"status"`
+	Receipt *ConsentReceipt `json:"receipt"`
+}
+
+// VerifiedReceipt is a receipt and whether its hash verifies against its
+// content and the receipt before it.
+type VerifiedReceipt struct {
+	Receipt  *ConsentReceipt `json:"receipt"`
+	Verified bool            `json:"verified"`
+}
+
+// ConsentReceiptList is one page of consent receipts.
+type ConsentReceiptList struct {
+	Receipts   []*ConsentReceipt `json:"receipts"`
+	TotalCount int32             `json:"total_count"`
+}
+
+// ConsentReceiptFilter narrows a receipt listing. CustomerID defaults to the
+// caller; an empty Type matches every type.
+type ConsentReceiptFilter struct {
+	CustomerID string
+	Type       string
+}
+
+// PublishDisclosure publishes a disclosure version.
+func (s *ConsentService) PublishDisclosure(ctx context.Context, req *PublishDisclosureRequest) (*Disclosure, error) {
+	var resp struct {
+		Disclosure *Disclosure `json:"disclosure"`
+	}
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/consent/disclosures", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Disclosure, nil
+}
+
+// ListDisclosures returns one page of disclosures, of every type when
+// consentType is empty.
+func (s *ConsentService) ListDisclosures(ctx context.Context, consentType string, opts ListOptions) (*DisclosureList, error) {
+	q := url.Values{}
+	if consentType != "" {
+		q.Set("type", consentType)
+	}
+	opts.apply(q)
+	var resp DisclosureList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/consent/disclosures", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RecordConsent records a customer granting or withdrawing consent and
+// returns its receipt.
+func (s *ConsentService) RecordConsent(ctx context.Context, req *RecordConsentRequest) (*ConsentReceipt, error) {
+	var resp struct {
+		Receipt *ConsentReceipt `json:"receipt"`
+	}
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/consent/receipts", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Receipt, nil
+}
+
+// CheckConsent reports whether a customer's consent of a type stands. An
+// empty customerID checks the caller's.
+func (s *ConsentService) CheckConsent(ctx context.Context, customerID, consentType string) (*ConsentCheck, error) {
+	q := url.Values{"type": {consentType}}
+	if customerID != "" {
+		q.Set("customer_id", customerID)
+	}
+	var resp ConsentCheck
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/consent/check", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListConsents returns a customer's standing consent of every type. An
+// empty customerID lists the caller's.
+func (s *ConsentService) ListConsents(ctx context.Context, customerID string) ([]*ConsentState, error) {
+	q := url.Values{}
+	if customerID != "" {
+		q.Set("customer_id", customerID)
+	}
+	var resp struct {
+		Consents []*ConsentState `json:"consents"`
+	}
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/consent/consents", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Consents, nil
+}
+
+// ListReceipts returns one page of a customer's consent receipts.
+func (s *ConsentService) ListReceipts(ctx context.Context, filter ConsentReceiptFilter, opts ListOptions) (*ConsentReceiptList, error) {
+	q := url.Values{}
+	if filter.CustomerID != "" {
+		q.Set("customer_id", filter.CustomerID)
+	}
+	if filter.Type != "" {
+		q.Set("type", filter.Type)
+	}
+	opts.apply(q)
+	var resp ConsentReceiptList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/consent/receipts", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// AllReceipts iterates over every consent receipt matching filter, fetching
+// pages as needed.
+func (s *ConsentService) AllReceipts(ctx context.Context, filter ConsentReceiptFilter, opts ListOptions) iter.Seq2[*ConsentReceipt, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ListOptions) ([]*ConsentReceipt, int, error) {
+		page, err := s.ListReceipts(ctx, filter, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Receipts, int(page.TotalCount), nil
+	})
+}
+
+// GetReceipt returns a consent receipt and whether its hash verifies.
+func (s *ConsentService) GetReceipt(ctx context.Context, receiptID string) (*VerifiedReceipt, error) {
+	var resp VerifiedReceipt
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/consent/receipts/"+url.PathEscape(receiptID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
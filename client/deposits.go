@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// DepositsService calls the /api/v1/deposits endpoints.
+type DepositsService struct {
+	c *Client
+}
+
+// InterestTier is the rate, in basis points, paid on balances in a band.
+type InterestTier struct {
+	MinBalance string `json:"min_balance"`
+	MaxBalance string `json:"max_balance"`
+	RateBps    int32  `json:"rate_bps"`
+}
+
+// CreateProductRequest is the body of POST /api/v1/deposits/products.
+type CreateProductRequest struct {
+	TenantID string         `json:"tenant_id,omitempty"`
+	Name     string         `json:"name"`
+	Currency string         `json:"currency"`
+	Tiers    []InterestTier `json:"tiers"`
+	TermDays int32          `json:"term_days"`
+}
+
+// DepositProduct is a deposit product.
+type DepositProduct struct {
+	ID        string         `json:"id"`
+	TenantID  string         `json:"tenant_id"`
+	Name      string         `json:"name"`
+	Currency  string         `json:"currency"`
+	Tiers     []InterestTier `json:"tiers"`
+	TermDays  int32          `json:"term_days"`
+	IsActive  bool           `json:"is_active"`
+	CreatedAt string         `json:"created_at"`
+	UpdatedAt string         `json:"updated_at"`
+	Version   int32          `json:"version"`
+}
+
+// OpenPositionRequest is the body of POST /api/v1/deposits/positions.
+type OpenPositionRequest struct {
+	TenantID  string `json:"tenant_id,omitempty"`
+	AccountID string `json:"account_id"`
+	ProductID string `json:"product_id"`
+	Principal string `json:"principal"`
+}
+
+// DepositPosition is a deposit held in a product.
+type DepositPosition struct {
+	ID              string `json:"id"`
+	TenantID        string `json:"tenant_id"`
+	AccountID       string `json:"account_id"`
+	ProductID       string `json:"product_id"`
+	Principal       string `json:"principal"`
+	Currency        string `json:"currency"`
+	AccruedInterest string `json:"accrued_interest"`
+	Status          string `json:"status"`
+	OpenedAt        string `json:"opened_at"`
+	MaturityDate    string `json:"maturity_date,omitempty"`
+	LastAccrualDate string `json:"last_accrual_date"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"`
+	Version         int32  `json:"version"`
+}
+
+type positionEnvelope struct {
+	Position DepositPosition `json:"position"`
+}
+
+// CreateProduct creates a deposit product.
+func (s *DepositsService) CreateProduct(ctx context.Context, req *CreateProductRequest) (*DepositProduct, error) {
+	var resp struct {
+		Product DepositProduct `json:"product"`
+	}
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/deposits/products", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Product, nil
+}
+
+// OpenPosition opens a deposit position.
+func (s *DepositsService) OpenPosition(ctx context.Context, req *OpenPositionRequest) (*DepositPosition, error) {
+	var resp positionEnvelope
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/deposits/positions", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Position, nil
+}
+
+// GetPosition returns a deposit position.
+func (s *DepositsService) GetPosition(ctx context.Context, positionID string) (*DepositPosition, error) {
+	var resp positionEnvelope
+	path := "/api/v1/deposits/positions/" + url.PathEscape(positionID)
+	if err := s.c.do(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Position, nil
+}
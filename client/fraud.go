@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// FraudService calls the /api/v1/fraud endpoints.
+type FraudService struct {
+	c *Client
+}
+
+// AssessTransactionRequest is the body of POST /api/v1/fraud/assessments.
+type AssessTransactionRequest struct {
+	Metadata           map[string]string `json:"metadata,omitempty"`
+	TenantID           string            `json:"tenant_id,omitempty"`
+	TransactionID      string            `json:"transaction_id"`
+	AccountID          string            `json:"account_id"`
+	Amount             string            `json:"amount"`
+	Currency           string            `json:"currency"`
+	TransactionType    string            `json:"transaction_type"`
+	CounterpartyName   string            `json:"counterparty_name,omitempty"`
+	DestinationCountry string            `json:"destination_country,omitempty"`
+	DeviceFingerprint  string            `json:"device_fingerprint,omitempty"`
+	IPAddress          string            `json:"ip_address,omitempty"`
+	DestinationAccount string            `json:"destination_account,omitempty"`
+}
+
+// AssessmentResult is the decision returned when a transaction is assessed.
+type AssessmentResult struct {
+	AssessmentID  string   `json:"assessment_id"`
+	RiskLevel     string   `json:"risk_level"`
+	Decision      string   `json:"decision"`
+	ModelVersion  string   `json:"model_version,omitempty"`
+	Signals       []string `json:"signals"`
+	RiskScore     int      `json:"risk_score"`
+	PolicyVersion int      `json:"policy_version"`
+}
+
+// Assessment is a stored fraud assessment.
+type Assessment struct {
+	AssessmentID    string   `json:"assessment_id"`
+	TransactionID   string   `json:"transaction_id"`
+	AccountID       string   `json:"account_id"`
+	Amount          string   `json:"amount"`
+	Currency        string   `json:"currency"`
+	TransactionType string   `json:"transaction_type"`
+	RiskLevel       string   `json:"risk_level"`
+	Decision        string   `json:"decision"`
+	ModelVersion    string   `json:"model_version,omitempty"`
+	AssessedAt      string   `json:"assessed_at,omitempty"`
+	Signals         []string `json:"signals"`
+	RiskScore       int      `json:"risk_score"`
+	PolicyVersion   int      `json:"policy_version"`
+}
+
+// ListAssessmentsParams filters GET /api/v1/fraud/assessments. From and To
+// are RFC 3339 timestamps.
+type ListAssessmentsParams struct {
+	AccountID string
+	Decision  string
+	RiskLevel string
+	From      string
+	To        string
+	ListOptions
+}
+
+// AssessmentList is one page of fraud assessments.
+type AssessmentList struct {
+	Assessments []Assessment `json:"assessments"`
+	TotalCount  int          `json:"total_count"`
+}
+
+// Assess submits a transaction for fraud assessment.
+func (s *FraudService) Assess(ctx context.Context, req *AssessTransactionRequest) (*AssessmentResult, error) {
+	var resp AssessmentResult
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/fraud/assessments", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetAssessment returns a fraud assessment.
+func (s *FraudService) GetAssessment(ctx context.Context, assessmentID string) (*Assessment, error) {
+	var resp Assessment
+	path := "/api/v1/fraud/assessments/" + url.PathEscape(assessmentID)
+	if err := s.c.do(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAssessments returns one page of fraud assessments.
+func (s *FraudService) ListAssessments(ctx context.Context, params ListAssessmentsParams) (*AssessmentList, error) {
+	q := url.Values{}
+	for k, v := range map[string]string{
+		"account_id": params.AccountID,
+		"decision":   params.Decision,
+		"risk_level": params.RiskLevel,
+		"from":       params.From,
+		"to":         params.To,
+	} {
+		if v != "" {
+			q.Set(k, v)
+		}
+	}
+	params.ListOptions.apply(q)
+
+	var resp AssessmentList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/fraud/assessments", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Assessments iterates over every fraud assessment matching params, fetching
+// pages as needed.
+func (s *FraudService) Assessments(ctx context.Context, params ListAssessmentsParams) iter.Seq2[Assessment, error] {
+	return paginate(ctx, params.ListOptions, func(ctx context.Context, opts ListOptions) ([]Assessment, int, error) {
+		params.ListOptions = opts
+		page, err := s.ListAssessments(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Assessments, page.TotalCount, nil
+	})
+}
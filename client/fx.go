@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// FXService calls the /api/v1/fx endpoints.
+type FXService struct {
+	c *Client
+}
+
+// ExchangeRate is the current rate for a currency pair.
+type ExchangeRate struct {
+	BaseCurrency  string `json:"base_currency"`
+	QuoteCurrency string `json:"quote_currency"`
+	Rate          string `json:"rate"`
+	Timestamp     string `json:"timestamp"`
+}
+
+// ConvertRequest is the body of POST /api/v1/fx/convert. Amount is a decimal
+// string.
+type ConvertRequest struct {
+	TenantID     string `json:"tenant_id,omitempty"`
+	FromCurrency string `json:"from_currency"`
+	ToCurrency   string `json:"to_currency"`
+	Amount       string `json:"amount"`
+}
+
+// Conversion is the result of converting an amount.
+type Conversion struct {
+	OriginalAmount  string `json:"original_amount"`
+	ConvertedAmount string `json:"converted_amount"`
+	FromCurrency    string `json:"from_currency"`
+	ToCurrency      string `json:"to_currency"`
+	Rate            string `json:"rate"`
+}
+
+// GetRate returns the exchange rate from base to quote currency.
+func (s *FXService) GetRate(ctx context.Context, base, quote string) (*ExchangeRate, error) {
+	var resp ExchangeRate
+	path := "/api/v1/fx/rates/" + url.PathEscape(base+"-"+quote)
+	if err := s.c.do(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Convert converts an amount between currencies at the current rate.
+func (s *FXService) Convert(ctx context.Context, req *ConvertRequest) (*Conversion, error) {
+	var resp Conversion
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/fx/convert", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
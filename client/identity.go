@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// IdentityService calls the /api/v1/identity endpoints.
+type IdentityService struct {
+	c *Client
+}
+
+// InitiateVerificationRequest is the body of POST
+// /api/v1/identity/verifications. DateOfBirth is YYYY-MM-DD.
+type InitiateVerificationRequest struct {
+	TenantID        string   `json:"tenant_id,omitempty"`
+	FirstName       string   `json:"first_name"`
+	LastName        string   `json:"last_name"`
+	Email           string   `json:"email"`
+	DateOfBirth     string   `json:"date_of_birth"`
+	Country         string   `json:"country"`
+	RiskTier        string   `json:"risk_tier,omitempty"`
+	ScreeningChecks []string `json:"screening_checks,omitempty"`
+	DocumentNumber  string   `json:"document_number,omitempty"`
+}
+
+// Verification is an identity verification. Timestamps are RFC 3339 strings.
+type Verification struct {
+	ID                     string              `json:"id"`
+	TenantID               string              `json:"tenant_id"`
+	ApplicantFirstName     string              `json:"applicant_first_name"`
+	ApplicantLastName      string              `json:"applicant_last_name"`
+	ApplicantEmail         string              `json:"applicant_email"`
+	ApplicantDOB           string              `json:"applicant_dob"`
+	ApplicantCountry       string              `json:"applicant_country"`
+	Status                 string              `json:"status"`
+	RiskTier               string              `json:"risk_tier"`
+	RiskFactors            []string            `json:"risk_factors"`
+	ExpiresAt              string              `json:"expires_at,omitempty"`
+	ErasedAt               string              `json:"erased_at,omitempty"`
+	PreviousVerificationID string              `json:"previous_verification_id,omitempty"`
+	CreatedAt              string              `json:"created_at"`
+	UpdatedAt              string              `json:"updated_at"`
+	Checks                 []VerificationCheck `json:"checks"`
+	RiskScore              int32               `json:"risk_score"`
+	Version                int32               `json:"version"`
+}
+
+// VerificationCheck is one provider check within a verification.
+type VerificationCheck struct {
+	ID                string `json:"id"`
+	CheckType         string `json:"check_type"`
+	Status            string `json:"status"`
+	Provider          string `json:"provider"`
+	ProviderReference string `json:"provider_reference"`
+	CompletedAt       string `json:"completed_at,omitempty"`
+	FailureReason     string `json:"failure_reason,omitempty"`
+}
+
+type verificationEnvelope struct {
+	Verification Verification `json:"verification"`
+}
+
+// InitiateVerification starts an identity verification.
+func (s *IdentityService) InitiateVerification(ctx context.Context, req *InitiateVerificationRequest) (*Verification, error) {
+	var resp verificationEnvelope
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/identity/verifications", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Verification, nil
+}
+
+// GetVerification returns an identity verification.
+func (s *IdentityService) GetVerification(ctx context.Context, verificationID string) (*Verification, error) {
+	var resp verificationEnvelope
+	path := "/api/v1/identity/verifications/" + url.PathEscape(verificationID)
+	if err := s.c.do(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Verification, nil
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// LendingService calls the /api/v1/loans endpoints.
+type LendingService struct {
+	c *Client
+}
+
+// SubmitApplicationRequest is the body of POST /api/v1/loans/applications.
+type SubmitApplicationRequest struct {
+	TenantID        string `json:"tenant_id,omitempty"`
+	ApplicantID     string `json:"applicant_id"`
+	RequestedAmount string `json:"requested_amount"`
+	Currency        string `json:"currency"`
+	Purpose         string `json:"purpose"`
+	TermMonths      int    `json:"term_months"`
+}
+
+// LoanApplication is the status of a loan application.
+type LoanApplication struct {
+	ApplicationID string `json:"application_id"`
+	Status        string `json:"status"`
+	CreatedAt     string `json:"created_at"`
+}
+
+// DisburseLoanRequest is the body of POST /api/v1/loans/disburse.
+type DisburseLoanRequest struct {
+	TenantID          string `json:"tenant_id,omitempty"`
+	ApplicationID     string `json:"application_id"`
+	BorrowerAccountID string `json:"borrower_account_id"`
+	InterestRateBps   int    `json:"interest_rate_bps"`
+}
+
+// Loan is a disbursed loan.
+type Loan struct {
+	LoanID    string `json:"loan_id"`
+	Status    string `json:"status"`
+	Amount    string `json:"amount"`
+	Currency  string `json:"currency"`
+	CreatedAt string `json:"created_at"`
+}
+
+// LoanPayment is the result of a loan repayment.
+type LoanPayment struct {
+	PaymentID string `json:"payment_id"`
+	Status    string `json:"status"`
+}
+
+// SubmitApplication submits a loan application.
+func (s *LendingService) SubmitApplication(ctx context.Context, req *SubmitApplicationRequest) (*LoanApplication, error) {
+	var resp LoanApplication
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/loans/applications", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetApplication returns a loan application.
+func (s *LendingService) GetApplication(ctx context.Context, applicationID string) (*LoanApplication, error) {
+	var resp LoanApplication
+	path := "/api/v1/loans/applications/" + url.PathEscape(applicationID)
+	if err := s.c.do(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Disburse disburses an approved loan application.
+func (s *LendingService) Disburse(ctx context.Context, req *DisburseLoanRequest) (*Loan, error) {
+	var resp Loan
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/loans/disburse", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetLoan returns a loan.
+func (s *LendingService) GetLoan(ctx context.Context, loanID string) (*Loan, error) {
+	var resp Loan
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/loans/"+url.PathEscape(loanID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// MakePayment makes a repayment on a loan.
+func (s *LendingService) MakePayment(ctx context.Context, loanID, amount string) (*LoanPayment, error) {
+	var resp LoanPayment
+	path := "/api/v1/loans/" + url.PathEscape(loanID) + "/payments"
+	body := struct {
+		Amount string `json:"amount"`
+	}{Amount: amount}
+	if err := s.c.do(ctx, http.MethodPost, path, nil, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
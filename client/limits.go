@@ -0,0 +1,305 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// LimitsService calls the /api/v1/limits endpoints.
+type LimitsService struct {
+	c *Client
+}
+
+// Limit caps a party's exposure, or the whole tenant's when PartyID is
+// empty, of one category in one currency. Category is PAYMENTS, CARDS,
+// OVERDRAFT, LENDING or TOTAL. A limit with a parent is also bound by the
+// parent's headroom. Available is negative when the limit was lowered
+// below its exposure.
+type Limit struct {
+	LimitID   string `json:"limit_id"`
+	TenantID  string `json:"tenant_id"`
+	PartyID   string `json:"party_id"`
+	ParentID  string `json:"parent_id"`
+	Category  string `json:"category"`
+	Currency  string `json:"currency"`
+	Amount    string `json:"amount"`
+	Utilized  string `json:"utilized"`
+	Reserved  string `json:"reserved"`
+	Available string `json:"available"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+	Version   int32  `json:"version"`
+}
+
+// CreateLimitRequest is the body used to create a limit. Leave PartyID
+// empty for a tenant-wide limit and ParentID empty for a limit that rolls
+// up into no other.
+type CreateLimitRequest struct {
+	PartyID  string `json:"party_id,omitempty"`
+	ParentID string `json:"parent_id,omitempty"`
+	Category string `json:"category"`
+	Currency string `json:"currency"`
+	Amount   string `json:"amount"`
+}
+
+// LimitList is one page of limits.
+type LimitList struct {
+	Limits     []*Limit `json:"limits"`
+	TotalCount int32    `json:"total_count"`
+}
+
+// LimitFilter narrows a limit listing; empty fields match everything.
+// TenantWide lists only the limits that apply to the whole tenant.
+type LimitFilter struct {
+	PartyID    string
+	Currency   string
+	TenantWide bool
+}
+
+// Reservation is an amount set aside on a chain of limits, listed in
+// LimitIDs from the most specific up. Status is HELD, COMMITTED, RELEASED
+// or EXPIRED.
+type Reservation struct {
+	ReservationID string   `json:"reservation_id"`
+	TenantID      string   `json:"tenant_id"`
+	PartyID       string   `json:"party_id"`
+	Category      string   `json:"category"`
+	Currency      string   `json:"currency"`
+	Reference     string   `json:"reference"`
+	Amount        string   `json:"amount"`
+	Status        string   `json:"status"`
+	ExpiresAt     string   `json:"expires_at"`
+	CreatedAt     string   `json:"created_at"`
+	UpdatedAt     string   `json:"updated_at"`
+	LimitIDs      []string `json:"limit_ids"`
+	Version       int32    `json:"version"`
+}
+
+// ReservationList is one page of reservations.
+type ReservationList struct {
+	Reservations []*Reservation `json:"reservations"`
+	TotalCount   int32          `json:"total_count"`
+}
+
+// ReservationFilter narrows a reservation listing; empty fields match
+// everything.
+type ReservationFilter struct {
+	PartyID string
+	Status  string
+}
+
+// CheckAndReserveRequest is the body used to check exposure against the
+// limits capping it. Reference identifies the exposure, such as a payment
+// ID; repeating it returns the reservation first made. DryRun only checks
+// the amount.
+type CheckAndReserveRequest struct {
+	PartyID   string `json:"party_id,omitempty"`
+	Category  string `json:"category"`
+	Currency  string `json:"currency"`
+	Amount    string `json:"amount"`
+	Reference string `json:"reference"`
+	DryRun    bool   `json:"dry_run,omitempty"`
+}
+
+// CheckAndReserveResult reports whether the amount fits. Headroom is the
+// least headroom along the chain of limits after any reservation;
+// BindingLimitID is set when the amount was declined.
+type CheckAndReserveResult struct {
+	Reservation    *Reservation `json:"reservation"`
+	Headroom       string       `json:"headroom"`
+	BindingLimitID string       `json:"binding_limit_id"`
+	Approved       bool         `json:"approved"`
+}
+
+// ReduceExposureRequest is the body used to reduce utilized exposure, such
+// as when a loan is repaid.
+type ReduceExposureRequest struct {
+	PartyID   string `json:"party_id,omitempty"`
+	Category  string `json:"category"`
+	Currency  string `json:"currency"`
+	Amount    string `json:"amount"`
+	Reference string `json:"reference"`
+}
+
+// LimitExposure is a limit with the headroom left against it once the
+// limits it rolls up into are taken into account.
+type LimitExposure struct {
+	Limit             *Limit `json:"limit"`
+	EffectiveHeadroom string `json:"effective_headroom"`
+	BindingLimitID    string `json:"binding_limit_id"`
+}
+
+// CurrencyExposure is what is utilized and reserved in one currency.
+type CurrencyExposure struct {
+	Currency string `json:"currency"`
+	Utilized string `json:"utilized"`
+	Reserved string `json:"reserved"`
+}
+
+// Exposure is a party's consolidated exposure across its limits.
+type Exposure struct {
+	PartyID string              `json:"party_id"`
+	Limits  []*LimitExposure    `json:"limits"`
+	Totals  []*CurrencyExposure `json:"totals"`
+}
+
+type limitEnvelope struct {
+	Limit *Limit `json:"limit"`
+}
+
+type reservationEnvelope struct {
+	Reservation *Reservation `json:"reservation"`
+}
+
+func limitPath(limitID string) string {
+	return "/api/v1/limits/" + url.PathEscape(limitID)
+}
+
+func reservationPath(reservationID string) string {
+	return "/api/v1/limits/reservations/" + url.PathEscape(reservationID)
+}
+
+// CreateLimit creates a limit.
+func (s *LimitsService) CreateLimit(ctx context.Context, req *CreateLimitRequest) (*Limit, error) {
+	var resp limitEnvelope
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/limits", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Limit, nil
+}
+
+// GetLimit returns a limit.
+func (s *LimitsService) GetLimit(ctx context.Context, limitID string) (*Limit, error) {
+	var resp limitEnvelope
+	if err := s.c.do(ctx, http.MethodGet, limitPath(limitID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Limit, nil
+}
+
+// UpdateLimit replaces a limit's amount.
+func (s *LimitsService) UpdateLimit(ctx context.Context, limitID, amount string) (*Limit, error) {
+	body := struct {
+		Amount string `json:"amount"`
+	}{Amount: amount}
+	var resp limitEnvelope
+	if err := s.c.do(ctx, http.MethodPut, limitPath(limitID), nil, body, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Limit, nil
+}
+
+// ListLimits returns one page of limits.
+func (s *LimitsService) ListLimits(ctx context.Context, filter LimitFilter, opts ListOptions) (*LimitList, error) {
+	q := url.Values{}
+	if filter.PartyID != "" {
+		q.Set("party_id", filter.PartyID)
+	}
+	if filter.Currency != "" {
+		q.Set("currency", filter.Currency)
+	}
+	if filter.TenantWide {
+		q.Set("tenant_wide", strconv.FormatBool(true))
+	}
+	opts.apply(q)
+	var resp LimitList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/limits", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// AllLimits iterates over every limit matching filter, fetching pages as
+// needed.
+func (s *LimitsService) AllLimits(ctx context.Context, filter LimitFilter, opts ListOptions) iter.Seq2[*Limit, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ListOptions) ([]*Limit, int, error) {
+		page, err := s.ListLimits(ctx, filter, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Limits, int(page.TotalCount), nil
+	})
+}
+
+// CheckAndReserve checks an amount against the limits capping it and
+// reserves it when they all have the headroom. A declined amount is not an
+// error; check Approved.
+func (s *LimitsService) CheckAndReserve(ctx context.Context, req *CheckAndReserveRequest) (*CheckAndReserveResult, error) {
+	var resp CheckAndReserveResult
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/limits/reservations", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetReservation returns a reservation.
+func (s *LimitsService) GetReservation(ctx context.Context, reservationID string) (*Reservation, error) {
+	var resp reservationEnvelope
+	if err := s.c.do(ctx, http.MethodGet, reservationPath(reservationID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Reservation, nil
+}
+
+// ListReservations returns one page of reservations, newest first.
+func (s *LimitsService) ListReservations(ctx context.Context, filter ReservationFilter, opts ListOptions) (*ReservationList, error) {
+	q := url.Values{}
+	if filter.PartyID != "" {
+		q.Set("party_id", filter.PartyID)
+	}
+	if filter.Status != "" {
+		q.Set("status", filter.Status)
+	}
+	opts.apply(q)
+	var resp ReservationList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/limits/reservations", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CommitReservation turns a held reservation into utilized exposure.
+func (s *LimitsService) CommitReservation(ctx context.Context, reservationID string) (*Reservation, error) {
+	var resp reservationEnvelope
+	if err := s.c.do(ctx, http.MethodPost, reservationPath(reservationID)+"/commit", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Reservation, nil
+}
+
+// ReleaseReservation returns a held reservation's amount to the limits'
+// headroom.
+func (s *LimitsService) ReleaseReservation(ctx context.Context, reservationID string) (*Reservation, error) {
+	var resp reservationEnvelope
+	if err := s.c.do(ctx, http.MethodPost, reservationPath(reservationID)+"/release", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Reservation, nil
+}
+
+// ReduceExposure reduces utilized exposure and returns the most specific
+// limit reduced.
+func (s *LimitsService) ReduceExposure(ctx context.Context, req *ReduceExposureRequest) (*Limit, error) {
+	var resp limitEnvelope
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/limits/exposure/reduce", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Limit, nil
+}
+
+// GetExposure returns a party's consolidated exposure; an empty partyID
+// returns the tenant's own.
+func (s *LimitsService) GetExposure(ctx context.Context, partyID string) (*Exposure, error) {
+	q := url.Values{}
+	if partyID != "" {
+		q.Set("party_id", partyID)
+	}
+	var resp Exposure
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/limits/exposure", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
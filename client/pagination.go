@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// ListOptions selects a page of a list endpoint that pages by offset. A zero
+// PageSize uses the server's default.
+type ListOptions struct {
+	PageSize int
+	Offset   int
+}
+
+func (o ListOptions) apply(q url.Values) {
+	if o.PageSize > 0 {
+		q.Set("page_size", strconv.Itoa(o.PageSize))
+	}
+	if o.Offset > 0 {
+		q.Set("offset", strconv.Itoa(o.Offset))
+	}
+}
+
+// paginate yields every item from opts.Offset onwards, calling fetch for each
+// page. It stops after the first error, a short page, or once total_count
+// items have been seen.
+func paginate[T any](ctx context.Context, opts ListOptions, fetch func(context.Context, ListOptions) ([]T, int, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			items, total, err := fetch(ctx, opts)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			opts.Offset += len(items)
+			if len(items) == 0 || opts.Offset >= total || (opts.PageSize > 0 && len(items) < opts.PageSize) {
+				return
+			}
+		}
+	}
+}
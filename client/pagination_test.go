@@ -0,0 +1,72 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/client"
+)
+
+func TestTenantsAll_FetchesEveryPage(t *testing.T) {
+	const total = 5
+	var offsets []int
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "ACTIVE", r.URL.Query().Get("status"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		offsets = append(offsets, offset)
+
+		var tenants []string
+		for i := offset; i < min(offset+size, total); i++ {
+			tenants = append(tenants, fmt.Sprintf(`{"tenant_id":"t%d"}`, i))
+		}
+		fmt.Fprintf(w, `{"tenants":[%s],"total_count":%d}`, strings.Join(tenants, ","), total)
+	})
+
+	var ids []string
+	params := client.ListTenantsParams{Status: "ACTIVE", ListOptions: client.ListOptions{PageSize: 2}}
+	for tenant, err := range c.Tenants.All(context.Background(), params) {
+		require.NoError(t, err)
+		ids = append(ids, tenant.TenantID)
+	}
+
+	assert.Equal(t, []string{"t0", "t1", "t2", "t3", "t4"}, ids)
+	assert.Equal(t, []int{0, 2, 4}, offsets)
+}
+
+func TestSchedulerJobs_StopsOnError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error":"insufficient permissions"}`))
+	})
+
+	var errs []error
+	for job, err := range c.Scheduler.Jobs(context.Background(), client.ListOptions{}) {
+		assert.Nil(t, job)
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 1)
+	assert.ErrorContains(t, errs[0], "insufficient permissions")
+}
+
+func TestFraudAssessments_EarlyBreakStopsFetching(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"assessments":[{"assessment_id":"a1"},{"assessment_id":"a2"}],"total_count":10}`))
+	})
+
+	for a, err := range c.Fraud.Assessments(context.Background(), client.ListAssessmentsParams{ListOptions: client.ListOptions{PageSize: 2}}) {
+		require.NoError(t, err)
+		assert.Equal(t, "a1", a.AssessmentID)
+		break
+	}
+	assert.Equal(t, 1, calls)
+}
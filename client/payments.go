@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// PaymentsService calls the /api/v1/payments endpoints.
+type PaymentsService struct {
+	c *Client
+}
+
+// InitiatePaymentRequest is the body of POST /api/v1/payments. Amount is a
+// decimal string. An empty TenantID defaults to the caller's tenant.
+type InitiatePaymentRequest struct {
+	TenantID              string `json:"tenant_id,omitempty"`
+	SourceAccountID       string `json:"source_account_id"`
+	DestinationAccountID  string `json:"destination_account_id,omitempty"`
+	Amount                string `json:"amount"`
+	Currency              string `json:"currency"`
+	RoutingNumber         string `json:"routing_number,omitempty"`
+	ExternalAccountNumber string `json:"external_account_number,omitempty"`
+	DestinationCountry    string `json:"destination_country,omitempty"`
+	Reference             string `json:"reference,omitempty"`
+	Description           string `json:"description,omitempty"`
+}
+
+// InitiatePaymentResponse is the result of initiating a payment.
+type InitiatePaymentResponse struct {
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	Rail      string `json:"rail"`
+	CreatedAt string `json:"created_at"`
+}
+
+// Payment is a payment order. Timestamps are RFC 3339 strings.
+type Payment struct {
+	ID                    string        `json:"id"`
+	TenantID              string        `json:"tenant_id"`
+	SourceAccountID       string        `json:"source_account_id"`
+	DestinationAccountID  string        `json:"destination_account_id"`
+	Amount                string        `json:"amount"`
+	Currency              string        `json:"currency"`
+	Rail                  string        `json:"rail"`
+	Status                string        `json:"status"`
+	RoutingNumber         string        `json:"routing_number"`
+	ExternalAccountNumber string        `json:"external_account_number"`
+	Reference             string        `json:"reference"`
+	Description           string        `json:"description"`
+	FailureReason         string        `json:"failure_reason,omitempty"`
+	InitiatedAt           string        `json:"initiated_at"`
+	SettledAt             string        `json:"settled_at,omitempty"`
+	CreatedAt             string        `json:"created_at"`
+	UpdatedAt             string        `json:"updated_at"`
+	Version               int32         `json:"version"`
+	Counterparty          *Counterparty `json:"counterparty,omitempty"`
+}
+
+// Counterparty is the other party of a payment as shown to customers.
+// MaskedIdentifier shows the last four characters of the external account
+// and is empty for payments between the bank's accounts.
+type Counterparty struct {
+	Name             string `json:"name,omitempty"`
+	MaskedIdentifier string `json:"masked_identifier,omitempty"`
+	Category         string `json:"category"`
+	Logo             string `json:"logo,omitempty"`
+}
+
+// ListPaymentsParams filters GET /api/v1/payments.
+type ListPaymentsParams struct {
+	TenantID  string
+	AccountID string
+}
+
+// PaymentList is a list of payments.
+type PaymentList struct {
+	Payments   []Payment `json:"payments"`
+	TotalCount int32     `json:"total_count"`
+}
+
+// Initiate initiates a payment.
+func (s *PaymentsService) Initiate(ctx context.Context, req *InitiatePaymentRequest) (*InitiatePaymentResponse, error) {
+	var resp InitiatePaymentResponse
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/payments", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get returns a payment.
+func (s *PaymentsService) Get(ctx context.Context, paymentID string) (*Payment, error) {
+	var resp struct {
+		Payment Payment `json:"payment"`
+	}
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/payments/"+url.PathEscape(paymentID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Payment, nil
+}
+
+// List lists payments.
+func (s *PaymentsService) List(ctx context.Context, params ListPaymentsParams) (*PaymentList, error) {
+	q := url.Values{}
+	if params.TenantID != "" {
+		q.Set("tenant_id", params.TenantID)
+	}
+	if params.AccountID != "" {
+		q.Set("account_id", params.AccountID)
+	}
+	var resp PaymentList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/payments", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
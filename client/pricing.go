@@ -0,0 +1,229 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// PricingService calls the /api/v1/pricing endpoints.
+type PricingService struct {
+	c *Client
+}
+
+// FeeRule prices one kind of transaction in one currency: Fixed plus Rate
+// times the amount, bounded by Minimum and, when it is not zero, Maximum.
+// Amounts and the rate are decimal strings; a rate of 0.015 is 1.5%.
+type FeeRule struct {
+	FeeCode  string `json:"fee_code"`
+	Currency string `json:"currency"`
+	Fixed    string `json:"fixed,omitempty"`
+	Rate     string `json:"rate,omitempty"`
+	Minimum  string `json:"minimum,omitempty"`
+	Maximum  string `json:"maximum,omitempty"`
+}
+
+// FeeSchedule is the tenant's pricing of a product on a channel from
+// EffectiveFrom until a later schedule takes effect. Product is PAYMENTS,
+// CARDS, FX or DEPOSITS; Channel is ANY, BRANCH, ONLINE, MOBILE, API, ATM
+// or POS, where ANY covers every channel without a schedule of its own.
+type FeeSchedule struct {
+	ScheduleID    string     `json:"schedule_id"`
+	TenantID      string     `json:"tenant_id"`
+	Product       string     `json:"product"`
+	Channel       string     `json:"channel"`
+	EffectiveFrom string     `json:"effective_from"`
+	CreatedAt     string     `json:"created_at"`
+	UpdatedAt     string     `json:"updated_at"`
+	Rules         []*FeeRule `json:"rules"`
+	Version       int32      `json:"version"`
+}
+
+// CreateFeeScheduleRequest is the body used to create a fee schedule.
+// Leave EffectiveFrom empty for a schedule taking effect at once; otherwise
+// it is RFC 3339.
+type CreateFeeScheduleRequest struct {
+	Product       string     `json:"product"`
+	Channel       string     `json:"channel"`
+	EffectiveFrom string     `json:"effective_from,omitempty"`
+	Rules         []*FeeRule `json:"rules"`
+}
+
+// ReviseFeeScheduleRequest is the body used to revise a schedule not yet in
+// effect. Leave EffectiveFrom empty to keep the schedule's effective date.
+type ReviseFeeScheduleRequest struct {
+	EffectiveFrom string     `json:"effective_from,omitempty"`
+	Rules         []*FeeRule `json:"rules"`
+}
+
+// FeeScheduleList is one page of fee schedules.
+type FeeScheduleList struct {
+	Schedules  []*FeeSchedule `json:"schedules"`
+	TotalCount int32          `json:"total_count"`
+}
+
+// FeeScheduleFilter narrows a schedule listing; empty fields match
+// everything.
+type FeeScheduleFilter struct {
+	Product string
+	Channel string
+}
+
+// EvaluateFeeRequest is the body used to price a transaction. Reference
+// identifies the transaction, such as a payment ID; when set the fee is
+// recorded as charged and repeating it returns the fee first charged.
+// Leave it empty for a quote. At, RFC 3339, prices the transaction at
+// another time than now.
+type EvaluateFeeRequest struct {
+	Product   string `json:"product"`
+	Channel   string `json:"channel"`
+	FeeCode   string `json:"fee_code"`
+	Currency  string `json:"currency"`
+	Amount    string `json:"amount"`
+	Reference string `json:"reference,omitempty"`
+	At        string `json:"at,omitempty"`
+}
+
+// FeeAssessment is a fee recorded as charged on a transaction.
+type FeeAssessment struct {
+	AssessmentID string `json:"assessment_id"`
+	TenantID     string `json:"tenant_id"`
+	Reference    string `json:"reference"`
+	Product      string `json:"product"`
+	Channel      string `json:"channel"`
+	FeeCode      string `json:"fee_code"`
+	Currency     string `json:"currency"`
+	Amount       string `json:"amount"`
+	Fee          string `json:"fee"`
+	ScheduleID   string `json:"schedule_id"`
+	AssessedAt   string `json:"assessed_at"`
+}
+
+// FeeEvaluation is the fee on a transaction. Priced is false when no
+// schedule prices it, and the transaction is then free. Assessment is set
+// when the fee was recorded.
+type FeeEvaluation struct {
+	Fee        string         `json:"fee"`
+	Currency   string         `json:"currency"`
+	ScheduleID string         `json:"schedule_id"`
+	Rule       *FeeRule       `json:"rule"`
+	Assessment *FeeAssessment `json:"assessment"`
+	Priced     bool           `json:"priced"`
+}
+
+// SimulateFeeChangeRequest is the body used to reprice recorded fees.
+// ScheduleID names a schedule to simulate; when empty, Product, Channel
+// and Rules give the proposed pricing. From and To, RFC 3339, default to
+// the 30 days up to now.
+type SimulateFeeChangeRequest struct {
+	ScheduleID string     `json:"schedule_id,omitempty"`
+	Product    string     `json:"product,omitempty"`
+	Channel    string     `json:"channel,omitempty"`
+	Rules      []*FeeRule `json:"rules,omitempty"`
+	From       string     `json:"from,omitempty"`
+	To         string     `json:"to,omitempty"`
+}
+
+// FeeImpact is the volume of one kind of transaction in one currency, with
+// the fees it was charged and would be charged under the proposal.
+type FeeImpact struct {
+	FeeCode      string `json:"fee_code"`
+	Currency     string `json:"currency"`
+	Volume       string `json:"volume"`
+	CurrentFees  string `json:"current_fees"`
+	ProposedFees string `json:"proposed_fees"`
+	Difference   string `json:"difference"`
+	Count        int32  `json:"count"`
+}
+
+// FeeSimulation is the revenue impact of a fee change over a period.
+type FeeSimulation struct {
+	From    string       `json:"from"`
+	To      string       `json:"to"`
+	Impacts []*FeeImpact `json:"impacts"`
+}
+
+type feeScheduleEnvelope struct {
+	Schedule *FeeSchedule `json:"schedule"`
+}
+
+func feeSchedulePath(scheduleID string) string {
+	return "/api/v1/pricing/schedules/" + url.PathEscape(scheduleID)
+}
+
+// CreateFeeSchedule creates a fee schedule.
+func (s *PricingService) CreateFeeSchedule(ctx context.Context, req *CreateFeeScheduleRequest) (*FeeSchedule, error) {
+	var resp feeScheduleEnvelope
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/pricing/schedules", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Schedule, nil
+}
+
+// GetFeeSchedule returns a fee schedule.
+func (s *PricingService) GetFeeSchedule(ctx context.Context, scheduleID string) (*FeeSchedule, error) {
+	var resp feeScheduleEnvelope
+	if err := s.c.do(ctx, http.MethodGet, feeSchedulePath(scheduleID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Schedule, nil
+}
+
+// ReviseFeeSchedule replaces the rules of a schedule not yet in effect.
+func (s *PricingService) ReviseFeeSchedule(ctx context.Context, scheduleID string, req *ReviseFeeScheduleRequest) (*FeeSchedule, error) {
+	var resp feeScheduleEnvelope
+	if err := s.c.do(ctx, http.MethodPut, feeSchedulePath(scheduleID), nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Schedule, nil
+}
+
+// ListFeeSchedules returns one page of fee schedules.
+func (s *PricingService) ListFeeSchedules(ctx context.Context, filter FeeScheduleFilter, opts ListOptions) (*FeeScheduleList, error) {
+	q := url.Values{}
+	if filter.Product != "" {
+		q.Set("product", filter.Product)
+	}
+	if filter.Channel != "" {
+		q.Set("channel", filter.Channel)
+	}
+	opts.apply(q)
+	var resp FeeScheduleList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/pricing/schedules", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// AllFeeSchedules iterates over every fee schedule matching filter,
+// fetching pages as needed.
+func (s *PricingService) AllFeeSchedules(ctx context.Context, filter FeeScheduleFilter, opts ListOptions) iter.Seq2[*FeeSchedule, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ListOptions) ([]*FeeSchedule, int, error) {
+		page, err := s.ListFeeSchedules(ctx, filter, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Schedules, int(page.TotalCount), nil
+	})
+}
+
+// EvaluateFee prices a transaction under the schedule in effect for its
+// product and channel.
+func (s *PricingService) EvaluateFee(ctx context.Context, req *EvaluateFeeRequest) (*FeeEvaluation, error) {
+	var resp FeeEvaluation
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/pricing/evaluate", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SimulateFeeChange reprices the fees recorded over a period under
+// proposed pricing.
+func (s *PricingService) SimulateFeeChange(ctx context.Context, req *SimulateFeeChangeRequest) (*FeeSimulation, error) {
+	var resp FeeSimulation
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/pricing/simulate", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,262 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// PrivacyService calls the /api/v1/privacy endpoints.
+type PrivacyService struct {
+	c *Client
+}
+
+// ErasureRequest is the body used to request a data subject's erasure.
+// Identify the subject by Email, CustomerID or both; Reference is the
+// ticket the request came in on.
+type ErasureRequest struct {
+	Email      string `json:"email,omitempty"`
+	CustomerID string `json:"customer_id,omitempty"`
+	Reference  string `json:"reference"`
+}
+
+// RetainedRecord is a record a service kept back from an erasure, with the
+// legal ground for keeping it.
+type RetainedRecord struct {
+	Record string `json:"record"`
+	Reason string `json:"reason"`
+}
+
+// ErasureTask is one service's part in an erasure job. Status is PENDING,
+// COMPLETED, FAILED or TIMED_OUT.
+type ErasureTask struct {
+	Service     string            `json:"service"`
+	Status      string            `json:"status"`
+	Error       string            `json:"error"`
+	CompletedAt string            `json:"completed_at"`
+	Retained    []*RetainedRecord `json:"retained"`
+	Erased      int32             `json:"erased"`
+	Attempts    int32             `json:"attempts"`
+}
+
+// ErasureJob tracks an erasure fanned out to the services holding personal
+// data. Kind is SUBJECT or RETENTION; Status is IN_PROGRESS, COMPLETED or
+// FAILED. The subject's email is never returned, only its SHA-256 digest.
+type ErasureJob struct {
+	JobID              string         `json:"job_id"`
+	TenantID           string         `json:"tenant_id"`
+	Kind               string         `json:"kind"`
+	Status             string         `json:"status"`
+	SubjectEmailSHA256 string         `json:"subject_email_sha256"`
+	CustomerID         string         `json:"customer_id"`
+	Reference          string         `json:"reference"`
+	Category           string         `json:"category"`
+	Cutoff             string         `json:"cutoff"`
+	RequestedBy        string         `json:"requested_by"`
+	Deadline           string         `json:"deadline"`
+	CreatedAt          string         `json:"created_at"`
+	UpdatedAt          string         `json:"updated_at"`
+	CompletedAt        string         `json:"completed_at"`
+	Tasks              []*ErasureTask `json:"tasks"`
+	Version            int32          `json:"version"`
+	CertificateIssued  bool           `json:"certificate_issued"`
+}
+
+// ErasureJobList is one page of erasure jobs.
+type ErasureJobList struct {
+	Jobs       []*ErasureJob `json:"jobs"`
+	TotalCount int32         `json:"total_count"`
+}
+
+// ErasureJobFilter narrows an erasure job listing; empty fields match
+// everything.
+type ErasureJobFilter struct {
+	Kind   string
+	Status string
+}
+
+// CertifiedService is one service's outcome as recorded on an erasure
+// certificate.
+type CertifiedService struct {
+	Service  string            `json:"service"`
+	Retained []*RetainedRecord `json:"retained"`
+	Erased   int32             `json:"erased"`
+}
+
+// ErasureCertificate attests that a subject erasure completed. Document is
+// the signed JSON body; Digest is its SHA-256 and Signature its HMAC under
+// the privacy service's key.
+type ErasureCertificate struct {
+	JobID              string              `json:"job_id"`
+	TenantID           string              `json:"tenant_id"`
+	SubjectEmailSHA256 string              `json:"subject_email_sha256"`
+	CustomerID         string              `json:"customer_id"`
+	Reference          string              `json:"reference"`
+	RequestedAt        string              `json:"requested_at"`
+	CompletedAt        string              `json:"completed_at"`
+	IssuedAt           string              `json:"issued_at"`
+	Document           string              `json:"document"`
+	Digest             string              `json:"digest"`
+	Signature          string              `json:"signature"`
+	Algorithm          string              `json:"algorithm"`
+	Services           []*CertifiedService `json:"services"`
+}
+
+// ErasureParticipant is a service that takes part in erasures.
+type ErasureParticipant struct {
+	Service      string   `json:"service"`
+	RegisteredAt string   `json:"registered_at"`
+	Categories   []string `json:"categories"`
+}
+
+// RetentionPolicy keeps a data category for RetentionDays before it is
+// erased. LastRunOn is the YYYY-MM-DD the policy last ran.
+type RetentionPolicy struct {
+	Category      string `json:"category"`
+	LastRunOn     string `json:"last_run_on"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+	RetentionDays int32  `json:"retention_days"`
+	Version       int32  `json:"version"`
+}
+
+// RetentionRun lists the retention jobs started by a run, and the
+// categories skipped because no participant erases them.
+type RetentionRun struct {
+	Jobs              []*ErasureJob `json:"jobs"`
+	SkippedCategories []string      `json:"skipped_categories"`
+}
+
+type erasureJobEnvelope struct {
+	Job *ErasureJob `json:"job"`
+}
+
+func erasureJobPath(jobID string) string {
+	return "/api/v1/privacy/erasures/" + url.PathEscape(jobID)
+}
+
+func retentionPolicyPath(category string) string {
+	return "/api/v1/privacy/retention-policies/" + url.PathEscape(category)
+}
+
+// RequestErasure starts erasing a data subject's personal data.
+func (s *PrivacyService) RequestErasure(ctx context.Context, req *ErasureRequest) (*ErasureJob, error) {
+	var resp erasureJobEnvelope
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/privacy/erasures", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Job, nil
+}
+
+// GetErasureJob returns an erasure job.
+func (s *PrivacyService) GetErasureJob(ctx context.Context, jobID string) (*ErasureJob, error) {
+	var resp erasureJobEnvelope
+	if err := s.c.do(ctx, http.MethodGet, erasureJobPath(jobID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Job, nil
+}
+
+// ListErasureJobs returns one page of erasure jobs, newest first.
+func (s *PrivacyService) ListErasureJobs(ctx context.Context, filter ErasureJobFilter, opts ListOptions) (*ErasureJobList, error) {
+	q := url.Values{}
+	if filter.Kind != "" {
+		q.Set("kind", filter.Kind)
+	}
+	if filter.Status != "" {
+		q.Set("status", filter.Status)
+	}
+	opts.apply(q)
+	var resp ErasureJobList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/privacy/erasures", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// AllErasureJobs iterates over every erasure job matching filter, fetching
+// pages as needed.
+func (s *PrivacyService) AllErasureJobs(ctx context.Context, filter ErasureJobFilter, opts ListOptions) iter.Seq2[*ErasureJob, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ListOptions) ([]*ErasureJob, int, error) {
+		page, err := s.ListErasureJobs(ctx, filter, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Jobs, int(page.TotalCount), nil
+	})
+}
+
+// RetryErasureJob asks the services that failed or timed out on a failed
+// job to erase again.
+func (s *PrivacyService) RetryErasureJob(ctx context.Context, jobID string) (*ErasureJob, error) {
+	var resp erasureJobEnvelope
+	if err := s.c.do(ctx, http.MethodPost, erasureJobPath(jobID)+"/retry", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Job, nil
+}
+
+// GetErasureCertificate returns the certificate of a completed subject
+// erasure.
+func (s *PrivacyService) GetErasureCertificate(ctx context.Context, jobID string) (*ErasureCertificate, error) {
+	var resp struct {
+		Certificate *ErasureCertificate `json:"certificate"`
+	}
+	if err := s.c.do(ctx, http.MethodGet, erasureJobPath(jobID)+"/certificate", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Certificate, nil
+}
+
+// ListParticipants returns the services that take part in erasures.
+func (s *PrivacyService) ListParticipants(ctx context.Context) ([]*ErasureParticipant, error) {
+	var resp struct {
+		Participants []*ErasureParticipant `json:"participants"`
+	}
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/privacy/participants", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Participants, nil
+}
+
+// PutRetentionPolicy creates or replaces the retention period of a data
+// category.
+func (s *PrivacyService) PutRetentionPolicy(ctx context.Context, category string, retentionDays int) (*RetentionPolicy, error) {
+	body := struct {
+		RetentionDays int `json:"retention_days"`
+	}{RetentionDays: retentionDays}
+	var resp struct {
+		Policy *RetentionPolicy `json:"policy"`
+	}
+	if err := s.c.do(ctx, http.MethodPut, retentionPolicyPath(category), nil, body, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Policy, nil
+}
+
+// ListRetentionPolicies returns the tenant's retention policies.
+func (s *PrivacyService) ListRetentionPolicies(ctx context.Context) ([]*RetentionPolicy, error) {
+	var resp struct {
+		Policies []*RetentionPolicy `json:"policies"`
+	}
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/privacy/retention-policies", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Policies, nil
+}
+
+// DeleteRetentionPolicy stops erasing a data category on a schedule.
+func (s *PrivacyService) DeleteRetentionPolicy(ctx context.Context, category string) error {
+	return s.c.do(ctx, http.MethodDelete, retentionPolicyPath(category), nil, nil, nil)
+}
+
+// RunRetention starts retention erasures for the policies that have not
+// run today.
+func (s *PrivacyService) RunRetention(ctx context.Context) (*RetentionRun, error) {
+	var resp RetentionRun
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/privacy/retention/run", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
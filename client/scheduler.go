@@ -0,0 +1,353 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// SchedulerService calls the /api/v1/scheduler endpoints.
+type SchedulerService struct {
+	c *Client
+}
+
+// JobTarget is what a scheduled job invokes: a gRPC method on a service, or
+// an event published to a topic.
+type JobTarget struct {
+	Kind    string          `json:"kind"`
+	Service string          `json:"service,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Topic   string          `json:"topic,omitempty"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// JobRetryPolicy controls how failed runs of a job are retried. Backoff is a
+// Go duration string such as "1m".
+type JobRetryPolicy struct {
+	Backoff     string `json:"backoff"`
+	MaxAttempts int32  `json:"max_attempts"`
+}
+
+// JobDefinition is the body used to register or update a job. Schedule is a
+// cron expression.
+type JobDefinition struct {
+	Target      *JobTarget      `json:"target"`
+	Retry       *JobRetryPolicy `json:"retry"`
+	Name        string          `json:"name,omitempty"`
+	Description string          `json:"description"`
+	Schedule    string          `json:"schedule"`
+}
+
+// Job is a scheduled job.
+type Job struct {
+	Target      *JobTarget      `json:"target"`
+	Retry       *JobRetryPolicy `json:"retry"`
+	JobID       string          `json:"job_id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Schedule    string          `json:"schedule"`
+	NextRunAt   string          `json:"next_run_at"`
+	LastRunAt   string          `json:"last_run_at,omitempty"`
+	CreatedAt   string          `json:"created_at"`
+	UpdatedAt   string          `json:"updated_at"`
+	Enabled     bool            `json:"enabled"`
+	Version     int32           `json:"version"`
+}
+
+// JobRun is one run of a job. Trigger is SCHEDULED, MANUAL, EOD for runs
+// started by a step of an end-of-day run, or SIMULATION for runs started by
+// a sandbox tenant advancing its clock.
+type JobRun struct {
+	RunID        string `json:"run_id"`
+	JobID        string `json:"job_id"`
+	JobName      string `json:"job_name"`
+	Trigger      string `json:"trigger"`
+	Status       string `json:"status"`
+	ScheduledFor string `json:"scheduled_for"`
+	Error        string `json:"error,omitempty"`
+	StartedAt    string `json:"started_at"`
+	FinishedAt   string `json:"finished_at,omitempty"`
+	NextRetryAt  string `json:"next_retry_at,omitempty"`
+	Attempt      int32  `json:"attempt"`
+	MaxAttempts  int32  `json:"max_attempts"`
+}
+
+// JobList is one page of jobs.
+type JobList struct {
+	Jobs       []*Job `json:"jobs"`
+	TotalCount int32  `json:"total_count"`
+}
+
+// JobRunList is one page of job runs.
+type JobRunList struct {
+	Runs       []*JobRun `json:"runs"`
+	TotalCount int32     `json:"total_count"`
+}
+
+// EODStep is one step of an end-of-day run. Status is PENDING, RUNNING,
+// SUCCEEDED or FAILED; RunID is the job run of its current attempt.
+type EODStep struct {
+	Name       string   `json:"name"`
+	JobName    string   `json:"job_name"`
+	Status     string   `json:"status"`
+	RunID      string   `json:"run_id,omitempty"`
+	Error      string   `json:"error,omitempty"`
+	StartedAt  string   `json:"started_at,omitempty"`
+	FinishedAt string   `json:"finished_at,omitempty"`
+	DependsOn  []string `json:"depends_on"`
+}
+
+// EODRun is the end-of-day close of a business date (YYYY-MM-DD). Status
+// is RUNNING, SUCCEEDED or FAILED.
+type EODRun struct {
+	EODRunID     string     `json:"eod_run_id"`
+	BusinessDate string     `json:"business_date"`
+	Trigger      string     `json:"trigger"`
+	Status       string     `json:"status"`
+	StartedAt    string     `json:"started_at"`
+	UpdatedAt    string     `json:"updated_at"`
+	FinishedAt   string     `json:"finished_at,omitempty"`
+	Steps        []*EODStep `json:"steps"`
+	Version      int32      `json:"version"`
+}
+
+// EODRunList is one page of end-of-day runs.
+type EODRunList struct {
+	Runs       []*EODRun `json:"runs"`
+	TotalCount int32     `json:"total_count"`
+}
+
+// TenantClock is a sandbox tenant's virtual business date (YYYY-MM-DD): the
+// first date whose end of day has not been simulated for the tenant.
+type TenantClock struct {
+	BusinessDate string `json:"business_date"`
+	TenantID     string `json:"tenant_id"`
+}
+
+// ClockAdvance is the outcome of advancing a sandbox tenant's clock.
+type ClockAdvance struct {
+	Clock         *TenantClock `json:"clock"`
+	DaysSimulated int32        `json:"days_simulated"`
+	JobRuns       int32        `json:"job_runs"`
+}
+
+type eodRunEnvelope struct {
+	Run *EODRun `json:"run"`
+}
+
+type jobEnvelope struct {
+	Job *Job `json:"job"`
+}
+
+type jobRunEnvelope struct {
+	Run *JobRun `json:"run"`
+}
+
+func jobPath(jobID string) string {
+	return "/api/v1/scheduler/jobs/" + url.PathEscape(jobID)
+}
+
+// RegisterJob registers a job.
+func (s *SchedulerService) RegisterJob(ctx context.Context, def *JobDefinition) (*Job, error) {
+	var resp jobEnvelope
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/scheduler/jobs", nil, def, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Job, nil
+}
+
+// UpdateJob replaces a job's schedule, target and retry policy.
+func (s *SchedulerService) UpdateJob(ctx context.Context, jobID string, def *JobDefinition) (*Job, error) {
+	var resp jobEnvelope
+	if err := s.c.do(ctx, http.MethodPut, jobPath(jobID), nil, def, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Job, nil
+}
+
+// GetJob returns a job.
+func (s *SchedulerService) GetJob(ctx context.Context, jobID string) (*Job, error) {
+	var resp jobEnvelope
+	if err := s.c.do(ctx, http.MethodGet, jobPath(jobID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Job, nil
+}
+
+// ListJobs returns one page of jobs.
+func (s *SchedulerService) ListJobs(ctx context.Context, opts ListOptions) (*JobList, error) {
+	q := url.Values{}
+	opts.apply(q)
+	var resp JobList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/scheduler/jobs", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Jobs iterates over every job, fetching pages as needed.
+func (s *SchedulerService) Jobs(ctx context.Context, opts ListOptions) iter.Seq2[*Job, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ListOptions) ([]*Job, int, error) {
+		page, err := s.ListJobs(ctx, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Jobs, int(page.TotalCount), nil
+	})
+}
+
+// PauseJob stops a job from being scheduled.
+func (s *SchedulerService) PauseJob(ctx context.Context, jobID string) (*Job, error) {
+	var resp jobEnvelope
+	if err := s.c.do(ctx, http.MethodPost, jobPath(jobID)+"/pause", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Job, nil
+}
+
+// ResumeJob schedules a paused job again.
+func (s *SchedulerService) ResumeJob(ctx context.Context, jobID string) (*Job, error) {
+	var resp jobEnvelope
+	if err := s.c.do(ctx, http.MethodPost, jobPath(jobID)+"/resume", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Job, nil
+}
+
+// TriggerJob runs a job now.
+func (s *SchedulerService) TriggerJob(ctx context.Context, jobID string) (*JobRun, error) {
+	var resp jobRunEnvelope
+	if err := s.c.do(ctx, http.MethodPost, jobPath(jobID)+"/trigger", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Run, nil
+}
+
+// ListRuns returns one page of a job's runs.
+func (s *SchedulerService) ListRuns(ctx context.Context, jobID string, opts ListOptions) (*JobRunList, error) {
+	q := url.Values{}
+	opts.apply(q)
+	var resp JobRunList
+	if err := s.c.do(ctx, http.MethodGet, jobPath(jobID)+"/runs", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Runs iterates over every run of a job, fetching pages as needed.
+func (s *SchedulerService) Runs(ctx context.Context, jobID string, opts ListOptions) iter.Seq2[*JobRun, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ListOptions) ([]*JobRun, int, error) {
+		page, err := s.ListRuns(ctx, jobID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Runs, int(page.TotalCount), nil
+	})
+}
+
+// GetRun returns a job run.
+func (s *SchedulerService) GetRun(ctx context.Context, runID string) (*JobRun, error) {
+	var resp jobRunEnvelope
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/scheduler/runs/"+url.PathEscape(runID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Run, nil
+}
+
+// RetryRun retries a failed job run now.
+func (s *SchedulerService) RetryRun(ctx context.Context, runID string) (*JobRun, error) {
+	var resp jobRunEnvelope
+	path := "/api/v1/scheduler/runs/" + url.PathEscape(runID) + "/retry"
+	if err := s.c.do(ctx, http.MethodPost, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Run, nil
+}
+
+func eodRunPath(eodRunID string) string {
+	return "/api/v1/scheduler/eod-runs/" + url.PathEscape(eodRunID)
+}
+
+// StartEODRun starts the end-of-day close of businessDate, given as
+// YYYY-MM-DD.
+func (s *SchedulerService) StartEODRun(ctx context.Context, businessDate string) (*EODRun, error) {
+	body := struct {
+		BusinessDate string `json:"business_date"`
+	}{BusinessDate: businessDate}
+	var resp eodRunEnvelope
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/scheduler/eod-runs", nil, body, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Run, nil
+}
+
+// GetEODRun returns an end-of-day run.
+func (s *SchedulerService) GetEODRun(ctx context.Context, eodRunID string) (*EODRun, error) {
+	var resp eodRunEnvelope
+	if err := s.c.do(ctx, http.MethodGet, eodRunPath(eodRunID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Run, nil
+}
+
+// ListEODRuns returns one page of end-of-day runs, latest business date
+// first.
+func (s *SchedulerService) ListEODRuns(ctx context.Context, opts ListOptions) (*EODRunList, error) {
+	q := url.Values{}
+	opts.apply(q)
+	var resp EODRunList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/scheduler/eod-runs", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EODRuns iterates over every end-of-day run, fetching pages as needed.
+func (s *SchedulerService) EODRuns(ctx context.Context, opts ListOptions) iter.Seq2[*EODRun, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ListOptions) ([]*EODRun, int, error) {
+		page, err := s.ListEODRuns(ctx, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Runs, int(page.TotalCount), nil
+	})
+}
+
+// ResumeEODRun restarts the failed steps of a failed end-of-day run.
+func (s *SchedulerService) ResumeEODRun(ctx context.Context, eodRunID string) (*EODRun, error) {
+	var resp eodRunEnvelope
+	if err := s.c.do(ctx, http.MethodPost, eodRunPath(eodRunID)+"/resume", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Run, nil
+}
+
+// GetClock returns the calling sandbox tenant's business date. It is only
+// available on sandbox deployments.
+func (s *SchedulerService) GetClock(ctx context.Context) (*TenantClock, error) {
+	var resp struct {
+		Clock *TenantClock `json:"clock"`
+	}
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/scheduler/clock", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Clock, nil
+}
+
+// AdvanceClock simulates the calling sandbox tenant's end of day days times,
+// 1 to 366, running the end-of-day jobs for each day on the tenant's behalf
+// only. If a day's job fails, the clock stays on that day and the error is
+// returned; advancing again runs it again. It is only available on sandbox
+// deployments.
+func (s *SchedulerService) AdvanceClock(ctx context.Context, days int) (*ClockAdvance, error) {
+	body := struct {
+		Days int `json:"days"`
+	}{Days: days}
+	var resp ClockAdvance
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/scheduler/clock/advance", nil, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
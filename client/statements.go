@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// StatementsService calls the /api/v1/statements and
+// /api/v1/statement-schedules endpoints.
+type StatementsService struct {
+	c *Client
+}
+
+// GenerateStatementRequest is the body used to generate a statement on
+// demand. Periods are inclusive YYYY-MM-DD dates; Format is PDF (the
+// default) or CSV.
+type GenerateStatementRequest struct {
+	CustomerID  string `json:"customer_id"`
+	PeriodStart string `json:"period_start"`
+	PeriodEnd   string `json:"period_end"`
+	Format      string `json:"format,omitempty"`
+}
+
+// Statement is a customer's account statement for a period.
+type Statement struct {
+	StatementID   string `json:"statement_id"`
+	CustomerID    string `json:"customer_id"`
+	ScheduleID    string `json:"schedule_id,omitempty"`
+	PeriodStart   string `json:"period_start"`
+	PeriodEnd     string `json:"period_end"`
+	Format        string `json:"format"`
+	Status        string `json:"status"`
+	ContentType   string `json:"content_type"`
+	SHA256        string `json:"sha256,omitempty"`
+	FailureReason string `json:"failure_reason,omitempty"`
+	GeneratedAt   string `json:"generated_at"`
+	Href          string `json:"href,omitempty"`
+	SizeBytes     int64  `json:"size_bytes"`
+	EntryCount    int32  `json:"entry_count"`
+}
+
+// StatementList is one page of statements.
+type StatementList struct {
+	Statements []*Statement `json:"statements"`
+	TotalCount int32        `json:"total_count"`
+}
+
+// StatementScheduleRequest is the body used to create or change a
+// customer's statement schedule. Frequency is MONTHLY or QUARTERLY.
+type StatementScheduleRequest struct {
+	Frequency string `json:"frequency"`
+	Format    string `json:"format,omitempty"`
+	Paused    bool   `json:"paused"`
+}
+
+// StatementSchedule generates a customer's statements each month or quarter.
+type StatementSchedule struct {
+	ScheduleID    string `json:"schedule_id"`
+	CustomerID    string `json:"customer_id"`
+	Frequency     string `json:"frequency"`
+	Format        string `json:"format"`
+	LastPeriodEnd string `json:"last_period_end"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+	Active        bool   `json:"active"`
+	Version       int32  `json:"version"`
+}
+
+// StatementScheduleList is one page of statement schedules.
+type StatementScheduleList struct {
+	Schedules  []*StatementSchedule `json:"schedules"`
+	TotalCount int32                `json:"total_count"`
+}
+
+type statementEnvelope struct {
+	Statement *Statement `json:"statement"`
+}
+
+type statementScheduleEnvelope struct {
+	Schedule *StatementSchedule `json:"schedule"`
+}
+
+func statementPath(statementID string) string {
+	return "/api/v1/statements/" + url.PathEscape(statementID)
+}
+
+// Generate generates a statement now. A statement that could not be
+// generated is returned with status FAILED.
+func (s *StatementsService) Generate(ctx context.Context, req *GenerateStatementRequest) (*Statement, error) {
+	var resp statementEnvelope
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/statements", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Statement, nil
+}
+
+// Get returns a statement.
+func (s *StatementsService) Get(ctx context.Context, statementID string) (*Statement, error) {
+	var resp statementEnvelope
+	if err := s.c.do(ctx, http.MethodGet, statementPath(statementID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Statement, nil
+}
+
+// List returns one page of a customer's statements, latest period first.
+func (s *StatementsService) List(ctx context.Context, customerID string, opts ListOptions) (*StatementList, error) {
+	q := url.Values{"customer_id": {customerID}}
+	opts.apply(q)
+	var resp StatementList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/statements", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// All iterates over every statement of a customer, fetching pages as needed.
+func (s *StatementsService) All(ctx context.Context, customerID string, opts ListOptions) iter.Seq2[*Statement, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ListOptions) ([]*Statement, int, error) {
+		page, err := s.List(ctx, customerID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Statements, int(page.TotalCount), nil
+	})
+}
+
+// Download returns a generated statement's PDF or CSV document.
+func (s *StatementsService) Download(ctx context.Context, statementID string) ([]byte, error) {
+	var content []byte
+	if err := s.c.do(ctx, http.MethodGet, statementPath(statementID)+"/content", nil, nil, &content); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// SetSchedule creates or changes a customer's statement schedule.
+func (s *StatementsService) SetSchedule(ctx context.Context, customerID string, req *StatementScheduleRequest) (*StatementSchedule, error) {
+	var resp statementScheduleEnvelope
+	path := "/api/v1/statement-schedules/" + url.PathEscape(customerID)
+	if err := s.c.do(ctx, http.MethodPut, path, nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Schedule, nil
+}
+
+// ListSchedules returns one page of statement schedules, those of one
+// customer when customerID is not empty.
+func (s *StatementsService) ListSchedules(ctx context.Context, customerID string, opts ListOptions) (*StatementScheduleList, error) {
+	q := url.Values{}
+	if customerID != "" {
+		q.Set("customer_id", customerID)
+	}
+	opts.apply(q)
+	var resp StatementScheduleList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/statement-schedules", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// StatementRunResult counts the statements generated by a run of the due
+// schedules.
+type StatementRunResult struct {
+	Generated int32 `json:"generated"`
+	Failed    int32 `json:"failed"`
+}
+
+// RunDueSchedules generates every statement that is due now, rather than
+// waiting for the service's own poll.
+func (s *StatementsService) RunDueSchedules(ctx context.Context) (*StatementRunResult, error) {
+	var resp StatementRunResult
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/statement-schedules/run", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
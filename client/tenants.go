@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// TenantsService calls the /api/v1/tenants endpoints.
+type TenantsService struct {
+	c *Client
+}
+
+// TenantSettings are a tenant's editable settings.
+type TenantSettings struct {
+	Name         string `json:"name"`
+	BaseCurrency string `json:"base_currency"`
+	Jurisdiction string `json:"jurisdiction"`
+}
+
+// ProvisioningStep is the state of one provisioning hook run for a tenant.
+type ProvisioningStep struct {
+	Hook      string `json:"hook"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// Tenant is a tenant of the platform.
+type Tenant struct {
+	Settings      *TenantSettings     `json:"settings"`
+	FeatureFlags  map[string]bool     `json:"feature_flags"`
+	Limits        map[string]string   `json:"limits"`
+	TenantID      string              `json:"tenant_id"`
+	Slug          string              `json:"slug"`
+	Status        string              `json:"status"`
+	SuspendReason string              `json:"suspend_reason,omitempty"`
+	CreatedAt     string              `json:"created_at"`
+	UpdatedAt     string              `json:"updated_at"`
+	Provisioning  []*ProvisioningStep `json:"provisioning"`
+	Version       int32               `json:"version"`
+}
+
+// CreateTenantRequest is the body of POST /api/v1/tenants.
+type CreateTenantRequest struct {
+	Settings *TenantSettings `json:"settings"`
+	Slug     string          `json:"slug"`
+}
+
+// ListTenantsParams filters GET /api/v1/tenants.
+type ListTenantsParams struct {
+	Status string
+	ListOptions
+}
+
+// TenantList is one page of tenants.
+type TenantList struct {
+	Tenants    []*Tenant `json:"tenants"`
+	TotalCount int32     `json:"total_count"`
+}
+
+type tenantEnvelope struct {
+	Tenant *Tenant `json:"tenant"`
+}
+
+// Create creates a tenant.
+func (s *TenantsService) Create(ctx context.Context, req *CreateTenantRequest) (*Tenant, error) {
+	var resp tenantEnvelope
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/tenants", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Tenant, nil
+}
+
+// Get returns a tenant.
+func (s *TenantsService) Get(ctx context.Context, tenantID string) (*Tenant, error) {
+	var resp tenantEnvelope
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/tenants/"+url.PathEscape(tenantID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Tenant, nil
+}
+
+// List returns one page of tenants.
+func (s *TenantsService) List(ctx context.Context, params ListTenantsParams) (*TenantList, error) {
+	q := url.Values{}
+	if params.Status != "" {
+		q.Set("status", params.Status)
+	}
+	params.ListOptions.apply(q)
+
+	var resp TenantList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/tenants", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// All iterates over every tenant matching params, fetching pages as needed.
+func (s *TenantsService) All(ctx context.Context, params ListTenantsParams) iter.Seq2[*Tenant, error] {
+	return paginate(ctx, params.ListOptions, func(ctx context.Context, opts ListOptions) ([]*Tenant, int, error) {
+		params.ListOptions = opts
+		page, err := s.List(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Tenants, int(page.TotalCount), nil
+	})
+}
+
+// Suspend suspends a tenant.
+func (s *TenantsService) Suspend(ctx context.Context, tenantID, reason string) (*Tenant, error) {
+	var resp tenantEnvelope
+	path := "/api/v1/tenants/" + url.PathEscape(tenantID) + "/suspend"
+	if err := s.c.do(ctx, http.MethodPost, path, nil, reasonBody{Reason: reason}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Tenant, nil
+}
+
+// Reactivate reactivates a suspended tenant.
+func (s *TenantsService) Reactivate(ctx context.Context, tenantID string) (*Tenant, error) {
+	var resp tenantEnvelope
+	path := "/api/v1/tenants/" + url.PathEscape(tenantID) + "/reactivate"
+	if err := s.c.do(ctx, http.MethodPost, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Tenant, nil
+}
@@ -0,0 +1,412 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// TreasuryService calls the /api/v1/treasury endpoints.
+type TreasuryService struct {
+	c *Client
+}
+
+// NostroAccountRequest is the body used to create a nostro account.
+// Amounts are decimal strings in the account's currency. Rails lists the
+// payment rails settling through the account; leave it empty for the
+// currency's catch-all account.
+type NostroAccountRequest struct {
+	Name              string   `json:"name"`
+	CorrespondentBIC  string   `json:"correspondent_bic"`
+	AccountNumber     string   `json:"account_number"`
+	Currency          string   `json:"currency"`
+	LedgerAccountCode string   `json:"ledger_account_code"`
+	OpeningBalance    string   `json:"opening_balance,omitempty"`
+	MinimumBalance    string   `json:"minimum_balance,omitempty"`
+	Rails             []string `json:"rails,omitempty"`
+}
+
+// NostroAccountUpdate is the body used to change a nostro account.
+type NostroAccountUpdate struct {
+	Name           string   `json:"name"`
+	MinimumBalance string   `json:"minimum_balance,omitempty"`
+	Rails          []string `json:"rails,omitempty"`
+	Active         bool     `json:"active"`
+}
+
+// NostroAccount is an account the bank holds with a correspondent.
+type NostroAccount struct {
+	NostroID          string   `json:"nostro_id"`
+	Name              string   `json:"name"`
+	CorrespondentBIC  string   `json:"correspondent_bic"`
+	AccountNumber     string   `json:"account_number"`
+	Currency          string   `json:"currency"`
+	LedgerAccountCode string   `json:"ledger_account_code"`
+	OpeningBalance    string   `json:"opening_balance"`
+	MinimumBalance    string   `json:"minimum_balance"`
+	CreatedAt         string   `json:"created_at"`
+	UpdatedAt         string   `json:"updated_at"`
+	Rails             []string `json:"rails"`
+	Version           int32    `json:"version"`
+	Active            bool     `json:"active"`
+}
+
+// NostroAccountList is one page of nostro accounts.
+type NostroAccountList struct {
+	NostroAccounts []*NostroAccount `json:"nostro_accounts"`
+	TotalCount     int32            `json:"total_count"`
+}
+
+// DailyProjection is a nostro account's projected closing balance on a
+// day.
+type DailyProjection struct {
+	Date           string `json:"date"`
+	Inflows        string `json:"inflows"`
+	Outflows       string `json:"outflows"`
+	ClosingBalance string `json:"closing_balance"`
+	BelowMinimum   bool   `json:"below_minimum"`
+}
+
+// LiquidityPosition is a nostro account's intraday position and its
+// projected balances over the forecast horizon.
+type LiquidityPosition struct {
+	NostroID         string             `json:"nostro_id"`
+	Currency         string             `json:"currency"`
+	BookedBalance    string             `json:"booked_balance"`
+	PendingOutflows  string             `json:"pending_outflows"`
+	AvailableBalance string             `json:"available_balance"`
+	MinimumBalance   string             `json:"minimum_balance"`
+	AsOf             string             `json:"as_of"`
+	Projections      []*DailyProjection `json:"projections"`
+}
+
+// PositionOptions narrows a position query; zero fields use the service
+// defaults.
+type PositionOptions struct {
+	Currency    string
+	HorizonDays int
+}
+
+// FundingForecastRequest is the body used to forecast a movement on a
+// nostro account. Direction is INFLOW or OUTFLOW; ValueDate is YYYY-MM-DD.
+type FundingForecastRequest struct {
+	NostroID    string `json:"nostro_id"`
+	ValueDate   string `json:"value_date"`
+	Direction   string `json:"direction"`
+	Amount      string `json:"amount"`
+	Description string `json:"description,omitempty"`
+}
+
+// FundingForecast is an expected movement on a nostro account. Status is
+// EXPECTED or CANCELLED.
+type FundingForecast struct {
+	ForecastID  string `json:"forecast_id"`
+	NostroID    string `json:"nostro_id"`
+	ValueDate   string `json:"value_date"`
+	Direction   string `json:"direction"`
+	Amount      string `json:"amount"`
+	Description string `json:"description,omitempty"`
+	Status      string `json:"status"`
+	SweepID     string `json:"sweep_id,omitempty"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+	Version     int32  `json:"version"`
+}
+
+// FundingForecastList is one page of funding forecasts.
+type FundingForecastList struct {
+	Forecasts  []*FundingForecast `json:"forecasts"`
+	TotalCount int32              `json:"total_count"`
+}
+
+// FundingForecastFilter narrows a forecast listing; empty fields match
+// everything. Dates are inclusive YYYY-MM-DD.
+type FundingForecastFilter struct {
+	NostroID         string
+	FromDate         string
+	ToDate           string
+	IncludeCancelled bool
+}
+
+// SweepRequest is the body used to create a sweep instruction. Type is
+// EXCESS or COVER.
+type SweepRequest struct {
+	SourceNostroID string `json:"source_nostro_id"`
+	TargetNostroID string `json:"target_nostro_id"`
+	Type           string `json:"type"`
+	TriggerBalance string `json:"trigger_balance"`
+	TargetBalance  string `json:"target_balance"`
+}
+
+// SweepUpdate is the body used to change a sweep instruction.
+type SweepUpdate struct {
+	TriggerBalance string `json:"trigger_balance"`
+	TargetBalance  string `json:"target_balance"`
+	Active         bool   `json:"active"`
+}
+
+// Sweep is a standing instruction to move funds between two nostro
+// accounts, run at most once a day.
+type Sweep struct {
+	SweepID        string `json:"sweep_id"`
+	SourceNostroID string `json:"source_nostro_id"`
+	TargetNostroID string `json:"target_nostro_id"`
+	Type           string `json:"type"`
+	TriggerBalance string `json:"trigger_balance"`
+	TargetBalance  string `json:"target_balance"`
+	LastExecutedOn string `json:"last_executed_on,omitempty"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+	Version        int32  `json:"version"`
+	Active         bool   `json:"active"`
+}
+
+// SweepList is one page of sweep instructions.
+type SweepList struct {
+	Sweeps     []*Sweep `json:"sweeps"`
+	TotalCount int32    `json:"total_count"`
+}
+
+// LiquidityAlert is raised when a nostro account's projected balance falls
+// below its minimum. Status is OPEN or RESOLVED.
+type LiquidityAlert struct {
+	AlertID          string `json:"alert_id"`
+	NostroID         string `json:"nostro_id"`
+	Status           string `json:"status"`
+	BreachDate       string `json:"breach_date"`
+	ProjectedBalance string `json:"projected_balance"`
+	MinimumBalance   string `json:"minimum_balance"`
+	OpenedAt         string `json:"opened_at"`
+	ResolvedAt       string `json:"resolved_at,omitempty"`
+	Version          int32  `json:"version"`
+}
+
+// LiquidityAlertList is one page of liquidity alerts.
+type LiquidityAlertList struct {
+	Alerts     []*LiquidityAlert `json:"alerts"`
+	TotalCount int32             `json:"total_count"`
+}
+
+// LiquidityEvaluation summarizes an on-demand liquidity evaluation.
+type LiquidityEvaluation struct {
+	SweepsExecuted int32 `json:"sweeps_executed"`
+	AlertsOpened   int32 `json:"alerts_opened"`
+	AlertsResolved int32 `json:"alerts_resolved"`
+}
+
+type nostroAccountEnvelope struct {
+	NostroAccount *NostroAccount `json:"nostro_account"`
+}
+
+type liquidityPositionEnvelope struct {
+	Position *LiquidityPosition `json:"position"`
+}
+
+type fundingForecastEnvelope struct {
+	Forecast *FundingForecast `json:"forecast"`
+}
+
+type sweepEnvelope struct {
+	Sweep *Sweep `json:"sweep"`
+}
+
+func nostroAccountPath(nostroID string) string {
+	return "/api/v1/treasury/nostro-accounts/" + url.PathEscape(nostroID)
+}
+
+func (o PositionOptions) apply(q url.Values) {
+	if o.Currency != "" {
+		q.Set("currency", o.Currency)
+	}
+	if o.HorizonDays > 0 {
+		q.Set("horizon_days", strconv.Itoa(o.HorizonDays))
+	}
+}
+
+// CreateNostroAccount starts tracking a nostro account.
+func (s *TreasuryService) CreateNostroAccount(ctx context.Context, req *NostroAccountRequest) (*NostroAccount, error) {
+	var resp nostroAccountEnvelope
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/treasury/nostro-accounts", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.NostroAccount, nil
+}
+
+// GetNostroAccount returns a nostro account.
+func (s *TreasuryService) GetNostroAccount(ctx context.Context, nostroID string) (*NostroAccount, error) {
+	var resp nostroAccountEnvelope
+	if err := s.c.do(ctx, http.MethodGet, nostroAccountPath(nostroID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.NostroAccount, nil
+}
+
+// UpdateNostroAccount replaces a nostro account's name, rails, minimum
+// balance and active state.
+func (s *TreasuryService) UpdateNostroAccount(ctx context.Context, nostroID string, req *NostroAccountUpdate) (*NostroAccount, error) {
+	var resp nostroAccountEnvelope
+	if err := s.c.do(ctx, http.MethodPut, nostroAccountPath(nostroID), nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.NostroAccount, nil
+}
+
+// ListNostroAccounts returns one page of nostro accounts, those in one
+// currency when it is not empty.
+func (s *TreasuryService) ListNostroAccounts(ctx context.Context, currency string, opts ListOptions) (*NostroAccountList, error) {
+	q := url.Values{}
+	if currency != "" {
+		q.Set("currency", currency)
+	}
+	opts.apply(q)
+	var resp NostroAccountList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/treasury/nostro-accounts", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetPosition returns a nostro account's liquidity position. The currency
+// option is ignored.
+func (s *TreasuryService) GetPosition(ctx context.Context, nostroID string, opts PositionOptions) (*LiquidityPosition, error) {
+	q := url.Values{}
+	opts.Currency = ""
+	opts.apply(q)
+	var resp liquidityPositionEnvelope
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/treasury/positions/"+url.PathEscape(nostroID), q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Position, nil
+}
+
+// ListPositions returns the liquidity positions of the active nostro
+// accounts.
+func (s *TreasuryService) ListPositions(ctx context.Context, opts PositionOptions) ([]*LiquidityPosition, error) {
+	q := url.Values{}
+	opts.apply(q)
+	var resp struct {
+		Positions []*LiquidityPosition `json:"positions"`
+	}
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/treasury/positions", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Positions, nil
+}
+
+// CreateForecast forecasts a movement on a nostro account.
+func (s *TreasuryService) CreateForecast(ctx context.Context, req *FundingForecastRequest) (*FundingForecast, error) {
+	var resp fundingForecastEnvelope
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/treasury/forecasts", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Forecast, nil
+}
+
+// CancelForecast stops a forecast counting towards projections.
+func (s *TreasuryService) CancelForecast(ctx context.Context, forecastID string) (*FundingForecast, error) {
+	var resp fundingForecastEnvelope
+	path := "/api/v1/treasury/forecasts/" + url.PathEscape(forecastID) + "/cancel"
+	if err := s.c.do(ctx, http.MethodPost, path, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Forecast, nil
+}
+
+// ListForecasts returns one page of forecasts, by value date.
+func (s *TreasuryService) ListForecasts(ctx context.Context, filter FundingForecastFilter, opts ListOptions) (*FundingForecastList, error) {
+	q := url.Values{}
+	if filter.NostroID != "" {
+		q.Set("nostro_id", filter.NostroID)
+	}
+	if filter.FromDate != "" {
+		q.Set("from_date", filter.FromDate)
+	}
+	if filter.ToDate != "" {
+		q.Set("to_date", filter.ToDate)
+	}
+	if filter.IncludeCancelled {
+		q.Set("include_cancelled", "true")
+	}
+	opts.apply(q)
+	var resp FundingForecastList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/treasury/forecasts", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// AllForecasts iterates over every forecast matching filter, fetching
+// pages as needed.
+func (s *TreasuryService) AllForecasts(ctx context.Context, filter FundingForecastFilter, opts ListOptions) iter.Seq2[*FundingForecast, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ListOptions) ([]*FundingForecast, int, error) {
+		page, err := s.ListForecasts(ctx, filter, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Forecasts, int(page.TotalCount), nil
+	})
+}
+
+// CreateSweep creates a sweep instruction between two nostro accounts in
+// the same currency.
+func (s *TreasuryService) CreateSweep(ctx context.Context, req *SweepRequest) (*Sweep, error) {
+	var resp sweepEnvelope
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/treasury/sweeps", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Sweep, nil
+}
+
+// UpdateSweep replaces a sweep instruction's balances and active state.
+func (s *TreasuryService) UpdateSweep(ctx context.Context, sweepID string, req *SweepUpdate) (*Sweep, error) {
+	var resp sweepEnvelope
+	if err := s.c.do(ctx, http.MethodPut, "/api/v1/treasury/sweeps/"+url.PathEscape(sweepID), nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Sweep, nil
+}
+
+// ListSweeps returns one page of sweep instructions, those from or to one
+// nostro account when nostroID is not empty.
+func (s *TreasuryService) ListSweeps(ctx context.Context, nostroID string, opts ListOptions) (*SweepList, error) {
+	q := url.Values{}
+	if nostroID != "" {
+		q.Set("nostro_id", nostroID)
+	}
+	opts.apply(q)
+	var resp SweepList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/treasury/sweeps", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAlerts returns one page of liquidity alerts, newest first. Empty
+// nostroID and status match everything.
+func (s *TreasuryService) ListAlerts(ctx context.Context, nostroID, status string, opts ListOptions) (*LiquidityAlertList, error) {
+	q := url.Values{}
+	if nostroID != "" {
+		q.Set("nostro_id", nostroID)
+	}
+	if status != "" {
+		q.Set("status", status)
+	}
+	opts.apply(q)
+	var resp LiquidityAlertList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/treasury/alerts", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Evaluate runs the tenant's due sweeps and re-evaluates its liquidity
+// alerts now.
+func (s *TreasuryService) Evaluate(ctx context.Context) (*LiquidityEvaluation, error) {
+	var resp LiquidityEvaluation
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/treasury/evaluate", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,276 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WebhookSignatureHeader is the request header carrying the signatures of a
+// delivered webhook payload.
+const WebhookSignatureHeader = "X-Bib-Signature"
+
+// WebhooksService calls the /api/v1/webhooks endpoints.
+type WebhooksService struct {
+	c *Client
+}
+
+// WebhookSubscriptionRequest is the body used to create or change a webhook
+// subscription. EventTypes are event types such as
+// "payment.order.completed", prefixes such as "card.*", or "*".
+type WebhookSubscriptionRequest struct {
+	URL         string   `json:"url"`
+	Description string   `json:"description,omitempty"`
+	EventTypes  []string `json:"event_types"`
+	// Active is only used by Update; new subscriptions are active.
+	Active bool `json:"active"`
+}
+
+// WebhookSubscription is an endpoint receiving the events matching its
+// event type filters.
+type WebhookSubscription struct {
+	SubscriptionID          string   `json:"subscription_id"`
+	URL                     string   `json:"url"`
+	Description             string   `json:"description,omitempty"`
+	PreviousSecretExpiresAt string   `json:"previous_secret_expires_at,omitempty"`
+	CreatedAt               string   `json:"created_at"`
+	UpdatedAt               string   `json:"updated_at"`
+	EventTypes              []string `json:"event_types"`
+	Version                 int32    `json:"version"`
+	Active                  bool     `json:"active"`
+}
+
+// WebhookSubscriptionSecret is a subscription with its signing secret,
+// returned only when the subscription is created or its secret rotated.
+type WebhookSubscriptionSecret struct {
+	Subscription *WebhookSubscription `json:"subscription"`
+	Secret       string               `json:"secret"`
+}
+
+// WebhookSubscriptionList is one page of webhook subscriptions.
+type WebhookSubscriptionList struct {
+	Subscriptions []*WebhookSubscription `json:"subscriptions"`
+	TotalCount    int32                  `json:"total_count"`
+}
+
+// WebhookDeliveryAttempt is one HTTP request made for a delivery.
+type WebhookDeliveryAttempt struct {
+	AttemptedAt string `json:"attempted_at"`
+	Error       string `json:"error,omitempty"`
+	DurationMS  int64  `json:"duration_ms"`
+	Number      int32  `json:"number"`
+	StatusCode  int32  `json:"status_code"`
+}
+
+// WebhookDelivery is one event sent to one subscription. Status is
+// PENDING, SUCCEEDED or FAILED.
+type WebhookDelivery struct {
+	DeliveryID     string                    `json:"delivery_id"`
+	SubscriptionID string                    `json:"subscription_id"`
+	EventID        string                    `json:"event_id"`
+	EventType      string                    `json:"event_type"`
+	Status         string                    `json:"status"`
+	NextAttemptAt  string                    `json:"next_attempt_at,omitempty"`
+	LastError      string                    `json:"last_error,omitempty"`
+	DeliveredAt    string                    `json:"delivered_at,omitempty"`
+	CreatedAt      string                    `json:"created_at"`
+	UpdatedAt      string                    `json:"updated_at"`
+	Payload        json.RawMessage           `json:"payload,omitempty"`
+	AttemptLog     []*WebhookDeliveryAttempt `json:"attempt_log"`
+	Attempts       int32                     `json:"attempts"`
+	LastStatusCode int32                     `json:"last_status_code,omitempty"`
+	Version        int32                     `json:"version"`
+}
+
+// WebhookDeliveryList is one page of webhook deliveries.
+type WebhookDeliveryList struct {
+	Deliveries []*WebhookDelivery `json:"deliveries"`
+	TotalCount int32              `json:"total_count"`
+}
+
+// WebhookDeliveryFilter narrows a delivery listing; empty fields match
+// everything.
+type WebhookDeliveryFilter struct {
+	SubscriptionID string
+	Status         string
+	EventType      string
+}
+
+type webhookSubscriptionEnvelope struct {
+	Subscription *WebhookSubscription `json:"subscription"`
+}
+
+type webhookDeliveryEnvelope struct {
+	Delivery *WebhookDelivery `json:"delivery"`
+}
+
+func webhookSubscriptionPath(subscriptionID string) string {
+	return "/api/v1/webhooks/subscriptions/" + url.PathEscape(subscriptionID)
+}
+
+func webhookDeliveryPath(deliveryID string) string {
+	return "/api/v1/webhooks/deliveries/" + url.PathEscape(deliveryID)
+}
+
+// CreateSubscription creates a webhook subscription. Keep the returned
+// secret: it is needed to verify payloads and is not returned again.
+func (s *WebhooksService) CreateSubscription(ctx context.Context, req *WebhookSubscriptionRequest) (*WebhookSubscriptionSecret, error) {
+	var resp WebhookSubscriptionSecret
+	if err := s.c.do(ctx, http.MethodPost, "/api/v1/webhooks/subscriptions", nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetSubscription returns a webhook subscription.
+func (s *WebhooksService) GetSubscription(ctx context.Context, subscriptionID string) (*WebhookSubscription, error) {
+	var resp webhookSubscriptionEnvelope
+	if err := s.c.do(ctx, http.MethodGet, webhookSubscriptionPath(subscriptionID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Subscription, nil
+}
+
+// UpdateSubscription replaces a subscription's endpoint, filters,
+// description and active state.
+func (s *WebhooksService) UpdateSubscription(ctx context.Context, subscriptionID string, req *WebhookSubscriptionRequest) (*WebhookSubscription, error) {
+	var resp webhookSubscriptionEnvelope
+	if err := s.c.do(ctx, http.MethodPut, webhookSubscriptionPath(subscriptionID), nil, req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Subscription, nil
+}
+
+// DeleteSubscription deletes a subscription along with its deliveries.
+func (s *WebhooksService) DeleteSubscription(ctx context.Context, subscriptionID string) error {
+	return s.c.do(ctx, http.MethodDelete, webhookSubscriptionPath(subscriptionID), nil, nil, nil)
+}
+
+// ListSubscriptions returns one page of webhook subscriptions.
+func (s *WebhooksService) ListSubscriptions(ctx context.Context, opts ListOptions) (*WebhookSubscriptionList, error) {
+	q := url.Values{}
+	opts.apply(q)
+	var resp WebhookSubscriptionList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/webhooks/subscriptions", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RotateSecret replaces a subscription's signing secret. Payloads are also
+// signed with the old secret for gracePeriod, so endpoints can switch over;
+// a nil gracePeriod uses the service default.
+func (s *WebhooksService) RotateSecret(ctx context.Context, subscriptionID string, gracePeriod *time.Duration) (*WebhookSubscriptionSecret, error) {
+	var body struct {
+		GracePeriodSeconds *int64 `json:"grace_period_seconds,omitempty"`
+	}
+	if gracePeriod != nil {
+		seconds := int64(gracePeriod.Seconds())
+		body.GracePeriodSeconds = &seconds
+	}
+	var resp WebhookSubscriptionSecret
+	if err := s.c.do(ctx, http.MethodPost, webhookSubscriptionPath(subscriptionID)+"/rotate-secret", nil, &body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetDelivery returns a delivery with its payload and attempt log.
+func (s *WebhooksService) GetDelivery(ctx context.Context, deliveryID string) (*WebhookDelivery, error) {
+	var resp webhookDeliveryEnvelope
+	if err := s.c.do(ctx, http.MethodGet, webhookDeliveryPath(deliveryID), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Delivery, nil
+}
+
+// ListDeliveries returns one page of deliveries, latest first.
+func (s *WebhooksService) ListDeliveries(ctx context.Context, filter WebhookDeliveryFilter, opts ListOptions) (*WebhookDeliveryList, error) {
+	q := url.Values{}
+	if filter.SubscriptionID != "" {
+		q.Set("subscription_id", filter.SubscriptionID)
+	}
+	if filter.Status != "" {
+		q.Set("status", filter.Status)
+	}
+	if filter.EventType != "" {
+		q.Set("event_type", filter.EventType)
+	}
+	opts.apply(q)
+	var resp WebhookDeliveryList
+	if err := s.c.do(ctx, http.MethodGet, "/api/v1/webhooks/deliveries", q, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// AllDeliveries iterates over every delivery matching filter, fetching
+// pages as needed.
+func (s *WebhooksService) AllDeliveries(ctx context.Context, filter WebhookDeliveryFilter, opts ListOptions) iter.Seq2[*WebhookDelivery, error] {
+	return paginate(ctx, opts, func(ctx context.Context, opts ListOptions) ([]*WebhookDelivery, int, error) {
+		page, err := s.ListDeliveries(ctx, filter, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Deliveries, int(page.TotalCount), nil
+	})
+}
+
+// Redeliver queues a succeeded or failed delivery to be sent again.
+func (s *WebhooksService) Redeliver(ctx context.Context, deliveryID string) (*WebhookDelivery, error) {
+	var resp webhookDeliveryEnvelope
+	if err := s.c.do(ctx, http.MethodPost, webhookDeliveryPath(deliveryID)+"/redeliver", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Delivery, nil
+}
+
+// VerifyWebhookSignature reports whether a delivered payload was signed
+// with secret, given its WebhookSignatureHeader value. The header holds
+// "t=<unix seconds>" and one or more "v1=<hex>" HMAC-SHA256 signatures of
+// "<unix seconds>.<body>"; timestamps further than tolerance from now are
+// rejected to stop replays.
+func VerifyWebhookSignature(header, secret string, body []byte, tolerance time.Duration) bool {
+	var (
+		ts         string
+		signatures []string
+	)
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			ts = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return false
+	}
+	if age := time.Since(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts + "."))
+	mac.Write(body)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	for _, s := range signatures {
+		if hmac.Equal([]byte(s), []byte(expected)) {
+			return true
+		}
+	}
+	return false
+}
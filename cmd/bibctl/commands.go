@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+)
+
+type repostStuckPaymentsReq struct {
+	PaymentID string `json:"payment_id,omitempty"`
+	Limit     int32  `json:"limit,omitempty"`
+}
+
+type accrueInterestReq struct {
+	AsOfDate string `json:"as_of_date"`
+}
+
+type reopenPeriodReq struct {
+	Reason     string `json:"reason,omitempty"`
+	ApprovalID string `json:"approval_id,omitempty"`
+	Year       int32  `json:"year"`
+	Month      int32  `json:"month"`
+}
+
+type listApprovalsReq struct {
+	Status string `json:"status,omitempty"`
+	Action string `json:"action,omitempty"`
+	Limit  int    `json:"limit,omitempty"`
+	Queue  bool   `json:"queue,omitempty"`
+}
+
+type decideApprovalReq struct {
+	ID      string `json:"id"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type replayOutboxReq struct {
+	From        string `json:"from"`
+	To          string `json:"to,omitempty"`
+	EventType   string `json:"event_type,omitempty"`
+	AggregateID string `json:"aggregate_id,omitempty"`
+	Limit       int    `json:"limit,omitempty"`
+}
+
+// ledgerApprovals is the service the ledger decides its approval requests
+// under.
+const ledgerApprovals = "/bib.ledger.v1.LedgerApprovals/"
+
+func runPaymentRepostStuck(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("payment repost-stuck")
+	conn := addConnFlags(fs)
+	paymentID := fs.String("payment", "", "repost only this payment")
+	limit := fs.Int("limit", 0, "most stuck payments to repost (default 100)")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+
+	req := repostStuckPaymentsReq{PaymentID: *paymentID, Limit: int32(*limit)} //nolint:gosec // flag value
+	return callAndPrint(ctx, conn, stdout, "payment", "/bib.payment.v1.PaymentService/RepostStuckPayments", true, req)
+}
+
+func runDepositAccrue(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("deposit accrue")
+	conn := addConnFlags(fs)
+	date := fs.String("date", "", "day to accrue interest for, as YYYY-MM-DD")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+
+	asOf, err := time.Parse(time.DateOnly, *date)
+	if err != nil {
+		return fmt.Errorf("%w: -date must be YYYY-MM-DD", errUsage)
+	}
+	req := accrueInterestReq{AsOfDate: asOf.Format(time.RFC3339)}
+	return callAndPrint(ctx, conn, stdout, "deposit", "/bib.deposit.v1.DepositService/AccrueInterest", true, req)
+}
+
+func runLedgerReopenPeriod(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("ledger reopen-period")
+	conn := addConnFlags(fs)
+	period := fs.String("period", "", "fiscal period to reopen, as YYYY-MM")
+	reason := fs.String("reason", "", "why the period must be reopened; required to request it")
+	approvalID := fs.String("approval", "", "ID of the approved request to reopen the period under")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+
+	month, err := time.Parse("2006-01", *period)
+	if err != nil {
+		return fmt.Errorf("%w: -period must be YYYY-MM", errUsage)
+	}
+	if *approvalID == "" && *reason == "" {
+		return fmt.Errorf("%w: -reason is required to request a reopening", errUsage)
+	}
+	req := reopenPeriodReq{
+		Year:       int32(month.Year()),  //nolint:gosec // parsed year
+		Month:      int32(month.Month()), //nolint:gosec // parsed month
+		Reason:     *reason,
+		ApprovalID: *approvalID,
+	}
+	return callAndPrint(ctx, conn, stdout, "ledger", "/bib.ledger.v1.LedgerService/ReopenPeriod", true, req)
+}
+
+func runLedgerApprovals(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("ledger approvals")
+	conn := addConnFlags(fs)
+	queue := fs.Bool("queue", false, "list only the pending requests awaiting your decision")
+	statusFilter := fs.String("status", "", "list only requests in this status, such as PENDING")
+	limit := fs.Int("limit", 0, "most requests to list")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+
+	req := listApprovalsReq{Queue: *queue, Status: *statusFilter, Limit: *limit}
+	return callAndPrint(ctx, conn, stdout, "ledger", ledgerApprovals+"ListApprovalRequests", true, req)
+}
+
+// runLedgerDecide returns the subcommand name, deciding a ledger approval
+// request with method, ApproveRequest or RejectRequest.
+func runLedgerDecide(name, method string) func(context.Context, []string, io.Writer) error {
+	return func(ctx context.Context, args []string, stdout io.Writer) error {
+		fs := newFlagSet("ledger " + name)
+		conn := addConnFlags(fs)
+		id := fs.String("id", "", "ID of the approval request")
+		comment := fs.String("comment", "", "comment recorded with the decision")
+		if err := parse(fs, args); err != nil {
+			return err
+		}
+		if *id == "" {
+			return fmt.Errorf("%w: -id is required", errUsage)
+		}
+
+		req := decideApprovalReq{ID: *id, Comment: *comment}
+		return callAndPrint(ctx, conn, stdout, "ledger", ledgerApprovals+method, true, req)
+	}
+}
+
+func runOutboxReplay(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("outbox replay")
+	conn := addConnFlags(fs)
+	service := fs.String("service", "", "service whose outbox to replay, such as payment")
+	from := fs.String("from", "", "replay events written at or after this RFC 3339 time")
+	to := fs.String("to", "", "replay events written before this RFC 3339 time (default now)")
+	eventType := fs.String("event-type", "", "replay only events of this type")
+	aggregateID := fs.String("aggregate-id", "", "replay only the events of this aggregate")
+	limit := fs.Int("limit", 0, "most events to replay (default 1000)")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *service == "" || *from == "" {
+		return fmt.Errorf("%w: -service and -from are required", errUsage)
+	}
+
+	req := replayOutboxReq{From: *from, To: *to, EventType: *eventType, AggregateID: *aggregateID, Limit: *limit}
+	return callAndPrint(ctx, conn, stdout, *service, "/bib.outbox.v1.OutboxAdmin/ReplayOutbox", false, req)
+}
+
+func runOutboxLag(ctx context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("outbox lag")
+	conn := addConnFlags(fs)
+	service := fs.String("service", "", "service whose outbox to inspect, such as payment")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *service == "" {
+		return fmt.Errorf("%w: -service is required", errUsage)
+	}
+
+	return callAndPrint(ctx, conn, stdout, *service, "/bib.outbox.v1.OutboxAdmin/GetOutboxLag", false, struct{}{})
+}
+
+// callAndPrint calls method and writes its response to stdout as indented
+// JSON.
+func callAndPrint(ctx context.Context, conn *connOptions, stdout io.Writer, service, method string, requireTenant bool, req any) error {
+	var resp json.RawMessage
+	if err := conn.call(ctx, service, method, requireTenant, req, &resp); err != nil {
+		return err
+	}
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, resp, "", "  "); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	out.WriteByte('\n')
+	_, err := out.WriteTo(stdout)
+	return err
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet("bibctl "+name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+// parse parses args into fs, returning usage errors with the subcommand's
+// flags described.
+func parse(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		var usage bytes.Buffer
+		fs.SetOutput(&usage)
+		fs.PrintDefaults()
+		return fmt.Errorf("%w: %s: %v\n\nflags:\n%s", errUsage, fs.Name(), err, usage.String())
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("%w: %s: unexpected arguments %q", errUsage, fs.Name(), fs.Args())
+	}
+	return nil
+}
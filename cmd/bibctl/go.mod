@@ -0,0 +1,24 @@
+module github.com/bibbank/bib/cmd/bibctl
+
+go 1.24
+
+require (
+	github.com/bibbank/bib/pkg/auth v0.0.0
+	github.com/bibbank/bib/pkg/tlsutil v0.0.0
+	github.com/golang-jwt/jwt/v5 v5.2.1
+	github.com/google/uuid v1.6.0
+	google.golang.org/grpc v1.68.1
+)
+
+require (
+	golang.org/x/net v0.29.0 // indirect
+	golang.org/x/sys v0.25.0 // indirect
+	golang.org/x/text v0.18.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240903143218-8af14fe29dc1 // indirect
+	google.golang.org/protobuf v1.35.2 // indirect
+)
+
+replace (
+	github.com/bibbank/bib/pkg/auth => ../../pkg/auth
+	github.com/bibbank/bib/pkg/tlsutil => ../../pkg/tlsutil
+)
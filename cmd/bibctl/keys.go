@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// Files of a key directory. The gateway signs with privateKeyFile
+// (JWT_PRIVATE_KEY_FILE) and every service validates with publicKeyFile
+// (JWT_PUBLIC_KEY_FILE), which after a rotation holds both the new key and
+// the one it replaced.
+const (
+	privateKeyFile  = "jwt-private.pem"
+	publicKeyFile   = "jwt-public.pem"
+	previousKeyFile = "jwt-private.pem.previous"
+)
+
+// runKeysRotate rotates the token signing key pair in a key directory. A
+// rotation is deployed in two steps so no valid token is rejected:
+//
+//  1. Roll out the new jwt-public.pem to the services and the gateway, which
+//     then accept tokens signed by either key. Services validating with
+//     JWT_JWKS_URL need only the gateway's, which publishes the bundle at
+//     /.well-known/jwks.json.
+//  2. Roll out the new jwt-private.pem to the gateway, which then signs with
+//     the new key.
+//
+// Once the old key's tokens have expired, the next rotation drops it from
+// the bundle. With -drop-previous, for a compromised key, the bundle holds
+// the new key only and tokens signed with the old key are rejected at once.
+func runKeysRotate(_ context.Context, args []string, stdout io.Writer) error {
+	fs := newFlagSet("keys rotate")
+	dir := fs.String("dir", "", "key directory holding jwt-private.pem and jwt-public.pem")
+	dropPrevious := fs.Bool("drop-previous", false, "do not trust the replaced key's tokens")
+	if err := parse(fs, args); err != nil {
+		return err
+	}
+	if *dir == "" {
+		return fmt.Errorf("%w: -dir is required", errUsage)
+	}
+
+	privPEM, pubPEM, err := auth.GenerateKeyPair()
+	if err != nil {
+		return err
+	}
+	bundle := pubPEM
+
+	current, err := os.ReadFile(filepath.Join(*dir, privateKeyFile))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return fmt.Errorf("read current key: %w", err)
+	default:
+		if !*dropPrevious {
+			previousPub, err := publicKeyPEM(current)
+			if err != nil {
+				return fmt.Errorf("current key: %w", err)
+			}
+			bundle = append(bundle, previousPub...)
+		}
+		if err := writeKey(filepath.Join(*dir, previousKeyFile), current, 0o600); err != nil {
+			return err
+		}
+	}
+
+	if err := writeKey(filepath.Join(*dir, publicKeyFile), bundle, 0o644); err != nil { //nolint:gosec // public keys
+		return err
+	}
+	if err := writeKey(filepath.Join(*dir, privateKeyFile), privPEM, 0o600); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(stdout, "rotated keys in %s: deploy %s to the services and gateway, then %s to the gateway\n",
+		*dir, publicKeyFile, privateKeyFile)
+	return err
+}
+
+// publicKeyPEM returns the PEM public key of a PEM RSA private key.
+func publicKeyPEM(privPEM []byte) ([]byte, error) {
+	key, err := jwt.ParseRSAPrivateKeyFromPEM(privPEM)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+// writeKey replaces path with data atomically, so a failed rotation leaves
+// the previous file in place.
+func writeKey(path string, data []byte, perm os.FileMode) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, perm); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+func TestKeysRotate(t *testing.T) {
+	dir := t.TempDir()
+	rotate := func(args ...string) {
+		t.Helper()
+		var out bytes.Buffer
+		if err := run(context.Background(), append([]string{"keys", "rotate", "-dir", dir}, args...), &out); err != nil {
+			t.Fatalf("keys rotate: %v", err)
+		}
+	}
+	signToken := func(privPEM []byte) string {
+		t.Helper()
+		signer, err := auth.NewJWTService(auth.JWTConfig{PrivateKeyPEM: string(privPEM), Issuer: "bib-gateway", Expiration: time.Minute})
+		if err != nil {
+			t.Fatalf("NewJWTService: %v", err)
+		}
+		token, err := signer.GenerateToken(uuid.New(), uuid.New(), []string{auth.RoleCustomer})
+		if err != nil {
+			t.Fatalf("GenerateToken: %v", err)
+		}
+		return token
+	}
+	validator := func() *auth.JWTService {
+		t.Helper()
+		bundle, err := os.ReadFile(filepath.Join(dir, publicKeyFile))
+		if err != nil {
+			t.Fatalf("read bundle: %v", err)
+		}
+		svc, err := auth.NewJWTService(auth.JWTConfig{PublicKeyPEM: string(bundle), Issuer: "bib-gateway"})
+		if err != nil {
+			t.Fatalf("NewJWTService: %v", err)
+		}
+		return svc
+	}
+	readKey := func(name string) []byte {
+		t.Helper()
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("read %s: %v", name, err)
+		}
+		return data
+	}
+
+	rotate()
+	first := readKey(privateKeyFile)
+	if _, err := validator().ValidateToken(signToken(first)); err != nil {
+		t.Fatalf("first key's token rejected: %v", err)
+	}
+
+	rotate()
+	second := readKey(privateKeyFile)
+	if bytes.Equal(first, second) {
+		t.Fatal("rotation kept the signing key")
+	}
+	if !bytes.Equal(readKey(previousKeyFile), first) {
+		t.Error("rotation did not keep the replaced key")
+	}
+	v := validator()
+	for name, key := range map[string][]byte{"new": second, "replaced": first} {
+		if _, err := v.ValidateToken(signToken(key)); err != nil {
+			t.Errorf("%s key's token rejected: %v", name, err)
+		}
+	}
+
+	// The next rotation drops the key before the one it replaces.
+	rotate()
+	if _, err := validator().ValidateToken(signToken(first)); err == nil {
+		t.Error("key replaced two rotations ago is still trusted")
+	}
+
+	rotate("-drop-previous")
+	if _, err := validator().ValidateToken(signToken(readKey(previousKeyFile))); err == nil {
+		t.Error("-drop-previous still trusts the replaced key")
+	}
+	info, err := os.Stat(filepath.Join(dir, privateKeyFile))
+	if err != nil {
+		t.Fatalf("stat private key: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("private key mode = %v, want 0600", info.Mode().Perm())
+	}
+}
+
+func TestKeysRotate_RequiresDir(t *testing.T) {
+	if err := run(context.Background(), []string{"keys", "rotate"}, &bytes.Buffer{}); err == nil {
+		t.Fatal("keys rotate without -dir succeeded")
+	}
+}
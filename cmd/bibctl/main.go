@@ -0,0 +1,91 @@
+// Command bibctl runs operational tasks against the services' gRPC APIs, so
+// operators no longer need direct SQL access to the services' databases:
+//
+//	bibctl payment repost-stuck [-payment ID] [-limit N]
+//	bibctl deposit accrue -date YYYY-MM-DD
+//	bibctl ledger reopen-period -period YYYY-MM -reason TEXT
+//	bibctl ledger approvals [-queue] [-status STATUS]
+//	bibctl ledger approve -id ID [-comment TEXT]
+//	bibctl ledger reject -id ID [-comment TEXT]
+//	bibctl ledger reopen-period -period YYYY-MM -approval ID
+//	bibctl outbox replay -service NAME -from TIME [-to TIME] [-event-type TYPE] [-aggregate-id ID]
+//	bibctl outbox lag -service NAME
+//	bibctl keys rotate -dir DIR
+//
+// Calls authenticate as an operator with a token from -token or
+// BIBCTL_TOKEN, or with a short-lived admin token minted for -operator from
+// the platform's issuing key (-jwt-private-key-file, or -jwt-secret where
+// services still verify HMAC tokens). Responses are written to stdout as
+// JSON.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+)
+
+// errUsage marks errors in how bibctl was invoked.
+var errUsage = errors.New("usage")
+
+// command is a bibctl subcommand, such as "payment repost-stuck".
+type command struct {
+	run     func(ctx context.Context, args []string, stdout io.Writer) error
+	group   string
+	name    string
+	summary string
+}
+
+var commands = []command{
+	{group: "payment", name: "repost-stuck", summary: "retry the sagas of payments that ran out of attempts", run: runPaymentRepostStuck},
+	{group: "deposit", name: "accrue", summary: "re-run the interest accrual for a date", run: runDepositAccrue},
+	{group: "ledger", name: "reopen-period", summary: "request, or with -approval make, the reopening of a closed fiscal period", run: runLedgerReopenPeriod},
+	{group: "ledger", name: "approvals", summary: "list the ledger's approval requests", run: runLedgerApprovals},
+	{group: "ledger", name: "approve", summary: "approve a pending ledger approval request", run: runLedgerDecide("approve", "ApproveRequest")},
+	{group: "ledger", name: "reject", summary: "reject a pending ledger approval request", run: runLedgerDecide("reject", "RejectRequest")},
+	{group: "outbox", name: "replay", summary: "republish a service's outbox events", run: runOutboxReplay},
+	{group: "outbox", name: "lag", summary: "show a service's unpublished outbox events", run: runOutboxLag},
+	{group: "keys", name: "rotate", summary: "rotate the token signing key pair", run: runKeysRotate},
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err := run(ctx, os.Args[1:], os.Stdout)
+	switch {
+	case err == nil:
+	case errors.Is(err, errUsage):
+		fmt.Fprintln(os.Stderr, "bibctl:", err)
+		os.Exit(2)
+	default:
+		fmt.Fprintln(os.Stderr, "bibctl:", err)
+		os.Exit(1)
+	}
+}
+
+// run dispatches args to their subcommand.
+func run(ctx context.Context, args []string, stdout io.Writer) error {
+	if len(args) < 2 {
+		return fmt.Errorf("%w: bibctl <group> <command> [flags]\n\n%s", errUsage, commandList())
+	}
+	for _, c := range commands {
+		if c.group == args[0] && c.name == args[1] {
+			return c.run(ctx, args[2:], stdout)
+		}
+	}
+	return fmt.Errorf("%w: unknown command %q\n\n%s", errUsage, args[0]+" "+args[1], commandList())
+}
+
+func commandList() string {
+	var b strings.Builder
+	b.WriteString("commands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(&b, "  %-22s %s\n", c.group+" "+c.name, c.summary)
+	}
+	return b.String()
+}
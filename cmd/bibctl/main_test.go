@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+const testSecret = "bibctl-test-secret"
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+// fakeService records the requests and callers of one unary method.
+type fakeService struct {
+	requests []map[string]any
+	callers  []*auth.Claims
+	response map[string]any
+}
+
+// serve serves method on a local listener with the services' token
+// validation and returns its address.
+func (f *fakeService) serve(t *testing.T, service, method string) string {
+	t.Helper()
+	jwtSvc, err := auth.NewJWTService(auth.JWTConfig{Secret: testSecret, Issuer: "bib-gateway"})
+	if err != nil {
+		t.Fatalf("NewJWTService: %v", err)
+	}
+
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(auth.UnaryAuthInterceptor(jwtSvc, nil)))
+	srv.RegisterService(&grpc.ServiceDesc{
+		ServiceName: service,
+		HandlerType: (*any)(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: method,
+			Handler: func(_ interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) { //nolint:revive // gRPC handler signature
+				var req map[string]any
+				if err := dec(&req); err != nil {
+					return nil, err
+				}
+				info := &grpc.UnaryServerInfo{FullMethod: "/" + service + "/" + method}
+				return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+					claims, _ := auth.ClaimsFromContext(ctx)
+					f.requests = append(f.requests, req.(map[string]any))
+					f.callers = append(f.callers, claims)
+					return f.response, nil
+				})
+			},
+		}},
+	}, struct{}{})
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+func TestRun_OutboxReplay(t *testing.T) {
+	fake := &fakeService{response: map[string]any{"replayed": 3}}
+	addr := fake.serve(t, "bib.outbox.v1.OutboxAdmin", "ReplayOutbox")
+	operatorID := uuid.New()
+
+	var out bytes.Buffer
+	err := run(context.Background(), []string{
+		"outbox", "replay",
+		"-addr", addr, "-service", "payment",
+		"-operator", operatorID.String(), "-jwt-secret", testSecret,
+		"-from", "2026-03-01T00:00:00Z", "-event-type", "payment.initiated",
+	}, &out)
+	if err != nil {
+		t.Fatalf("outbox replay: %v", err)
+	}
+
+	if len(fake.requests) != 1 {
+		t.Fatalf("got %d calls, want 1", len(fake.requests))
+	}
+	want := map[string]any{"from": "2026-03-01T00:00:00Z", "event_type": "payment.initiated"}
+	for k, v := range want {
+		if fake.requests[0][k] != v {
+			t.Errorf("request %s = %v, want %v", k, fake.requests[0][k], v)
+		}
+	}
+	caller := fake.callers[0]
+	if caller == nil || caller.UserID != operatorID || !caller.HasRole(auth.RoleAdmin) {
+		t.Errorf("caller = %+v, want admin %s", caller, operatorID)
+	}
+
+	var resp map[string]any
+	if err := json.Unmarshal(out.Bytes(), &resp); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, out.String())
+	}
+	if resp["replayed"] != float64(3) {
+		t.Errorf("output = %s", out.String())
+	}
+}
+
+func TestRun_LedgerReopenPeriodActsForTenant(t *testing.T) {
+	fake := &fakeService{response: map[string]any{"period": "2026-03", "status": "CLOSED"}}
+	addr := fake.serve(t, "bib.ledger.v1.LedgerService", "ReopenPeriod")
+	tenantID := uuid.New()
+
+	err := run(context.Background(), []string{
+		"ledger", "reopen-period",
+		"-addr", addr, "-tenant", tenantID.String(),
+		"-operator", uuid.NewString(), "-jwt-secret", testSecret,
+		"-period", "2026-03", "-reason", "late supplier invoice",
+	}, &bytes.Buffer{})
+	if err != nil {
+		t.Fatalf("ledger reopen-period: %v", err)
+	}
+
+	if len(fake.requests) != 1 {
+		t.Fatalf("got %d calls, want 1", len(fake.requests))
+	}
+	req := fake.requests[0]
+	if req["year"] != float64(2026) || req["month"] != float64(3) || req["reason"] != "late supplier invoice" {
+		t.Errorf("request = %v", req)
+	}
+	if fake.callers[0].TenantID != tenantID {
+		t.Errorf("tenant = %s, want %s", fake.callers[0].TenantID, tenantID)
+	}
+}
+
+func TestRun_UsageErrors(t *testing.T) {
+	creds := []string{"-operator", uuid.NewString(), "-jwt-secret", testSecret}
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"no command", nil},
+		{"unknown command", []string{"payment", "refund"}},
+		{"unknown flag", []string{"payment", "repost-stuck", "-force"}},
+		{"bad date", append([]string{"deposit", "accrue", "-tenant", uuid.NewString(), "-date", "March 1st"}, creds...)},
+		{"reopen without reason", append([]string{"ledger", "reopen-period", "-tenant", uuid.NewString(), "-period", "2026-03"}, creds...)},
+		{"missing tenant", append([]string{"payment", "repost-stuck"}, creds...)},
+		{"no credentials", []string{"outbox", "lag", "-service", "payment", "-operator", uuid.NewString(), "-jwt-secret", ""}},
+		{"unknown service", append([]string{"outbox", "lag", "-service", "ledgerr"}, creds...)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := run(context.Background(), tt.args, &bytes.Buffer{})
+			if !errors.Is(err, errUsage) {
+				t.Errorf("err = %v, want a usage error", err)
+			}
+		})
+	}
+}
+
+func TestResolveAddr(t *testing.T) {
+	t.Setenv("BIBCTL_PAYMENT_ADDR", "payments.internal:443")
+
+	o := &connOptions{}
+	if got, _ := o.resolveAddr("payment"); got != "payments.internal:443" {
+		t.Errorf("payment addr = %q, want the environment's", got)
+	}
+	if got, _ := o.resolveAddr("ledger"); got != "localhost:9081" {
+		t.Errorf("ledger addr = %q, want the default", got)
+	}
+	o.addr = "localhost:1234"
+	if got, _ := o.resolveAddr("payment"); got != "localhost:1234" {
+		t.Errorf("payment addr = %q, want the flag's", got)
+	}
+}
+
+func TestCommandList(t *testing.T) {
+	list := commandList()
+	for _, c := range commands {
+		if !strings.Contains(list, c.group+" "+c.name) {
+			t.Errorf("command list misses %s %s", c.group, c.name)
+		}
+	}
+}
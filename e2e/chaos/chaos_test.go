@@ -0,0 +1,324 @@
+//go:build chaos
+
+// Package chaos takes backends of a running docker compose stack down in
+// the middle of gateway flows and checks that the rest of the bank
+// degrades gracefully: the gateway keeps serving what does not depend on
+// the failed backend, events raised during a broker outage are delivered
+// once it returns, and retried payments are applied exactly once across a
+// database failover.
+//
+// The tests stop, freeze and restart containers, so they run against a
+// disposable stack and never alongside other suites:
+//
+//	make docker-up && make test-chaos
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/client"
+	"github.com/bibbank/bib/e2e/internal/fixture"
+)
+
+// tenantID is the tenant every test acts for.
+var tenantID = uuid.MustParse("00000000-0000-0000-0000-000000000020")
+
+func gatewayURL() string { return envOr("GATEWAY_URL", "http://localhost:8080") }
+
+func TestMain(m *testing.M) {
+	if err := exec.Command("docker", "compose", "version").Run(); err != nil {
+		fmt.Fprintln(os.Stderr, "the chaos suite needs docker compose to control the stack:", err)
+		os.Exit(1)
+	}
+	for i := 0; ; i++ {
+		resp, err := http.Get(gatewayURL() + "/healthz")
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				break
+			}
+		}
+		if i == 30 {
+			fmt.Fprintf(os.Stderr, "gateway at %s did not become healthy within 60 s\n", gatewayURL())
+			os.Exit(1)
+		}
+		time.Sleep(2 * time.Second)
+	}
+	os.Exit(m.Run())
+}
+
+// newClient returns a client for the test tenant. Retries are off so that
+// each test sees, and decides how to handle, every failure itself.
+func newClient(t *testing.T, timeout time.Duration) *client.Client {
+	t.Helper()
+	token, err := fixture.Token(envOr("JWT_SECRET", "test-e2e-secret"), envOr("JWT_ISSUER", "bib-gateway"), tenantID.String())
+	require.NoError(t, err)
+	c, err := client.New(gatewayURL(),
+		client.WithToken(token),
+		client.WithUserAgent("bib-chaos"),
+		client.WithRetryPolicy(client.NoRetry),
+		client.WithHTTPClient(&http.Client{Timeout: timeout}),
+	)
+	require.NoError(t, err)
+	return c
+}
+
+func payment(source uuid.UUID, amount, reference string) *client.InitiatePaymentRequest {
+	return &client.InitiatePaymentRequest{
+		TenantID:              tenantID.String(),
+		SourceAccountID:       source.String(),
+		Amount:                amount,
+		Currency:              "USD",
+		RoutingNumber:         "021000021",
+		ExternalAccountNumber: "123456789",
+		Reference:             reference,
+		Description:           "chaos test",
+	}
+}
+
+func assessment() *client.AssessTransactionRequest {
+	return &client.AssessTransactionRequest{
+		TenantID:        tenantID.String(),
+		TransactionID:   uuid.NewString(),
+		AccountID:       uuid.NewString(),
+		Amount:          "250.00",
+		Currency:        "USD",
+		TransactionType: "WIRE_TRANSFER",
+	}
+}
+
+// statusOf returns the HTTP status of a gateway error, or 0 when the
+// request never got a response.
+func statusOf(err error) int {
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// ---------------------------------------------------------------------------
+// fraud-service
+// ---------------------------------------------------------------------------
+
+// With fraud-service down, fraud calls fail fast with a gateway error and
+// payments, whose acceptance does not wait on fraud-service, still go
+// through: the payment path fails open.
+func TestFraudServiceDown_PaymentsFailOpen(t *testing.T) {
+	c := newClient(t, 15*time.Second)
+	ctx := context.Background()
+	restore := newStack().Inject(t, "fraud-service", Kill)
+
+	start := time.Now()
+	_, err := c.Fraud.Assess(ctx, assessment())
+	require.Error(t, err, "an assessment cannot succeed without fraud-service")
+	assert.Contains(t, []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}, statusOf(err),
+		"the gateway reports the outage as unavailability, not an internal error: %v", err)
+	assert.Less(t, time.Since(start), 10*time.Second, "a refused backend fails fast")
+
+	initiated, err := c.Payments.Initiate(ctx, payment(uuid.New(), "42.00", "CHAOS-FRAUD-DOWN"))
+	require.NoError(t, err, "payments are accepted while fraud-service is down")
+	assert.NotEmpty(t, initiated.ID)
+
+	restore()
+	require.EventuallyWithT(t, func(c2 *assert.CollectT) {
+		_, err := c.Fraud.Assess(ctx, assessment())
+		assert.NoError(c2, err)
+	}, time.Minute, 2*time.Second, "the gateway reconnects once fraud-service is back")
+}
+
+// A hung fraud-service ties up only the requests sent to it; the gateway
+// keeps serving payments at normal latency meanwhile.
+func TestFraudServiceHung_OtherRoutesUnaffected(t *testing.T) {
+	c := newClient(t, 15*time.Second)
+	ctx := context.Background()
+	newStack().Inject(t, "fraud-service", Hang)
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hungCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+			defer cancel()
+			_, err := c.Fraud.Assess(hungCtx, assessment())
+			assert.Error(t, err, "an assessment cannot succeed against a frozen fraud-service")
+		}()
+	}
+
+	source := uuid.New()
+	for i := range 10 {
+		start := time.Now()
+		initiated, err := c.Payments.Initiate(ctx, payment(source, "10.00", fmt.Sprintf("CHAOS-FRAUD-HUNG-%d", i)))
+		require.NoError(t, err, "payment %d", i)
+		_, err = c.Payments.Get(ctx, initiated.ID)
+		require.NoError(t, err, "payment %d", i)
+		assert.Less(t, time.Since(start), 2*time.Second, "payment %d is not held up by the hung fraud calls", i)
+	}
+	wg.Wait()
+}
+
+// ---------------------------------------------------------------------------
+// Kafka
+// ---------------------------------------------------------------------------
+
+// Payments initiated while Kafka is down are accepted, their events wait in
+// the payment outbox, and every one is published once the broker returns.
+func TestKafkaUnavailable_EventsQueuedThenDelivered(t *testing.T) {
+	c := newClient(t, 15*time.Second)
+	ctx := context.Background()
+	restore := newStack().Inject(t, "kafka", Kill)
+
+	source := uuid.New()
+	var ids []string
+	for i := range 5 {
+		initiated, err := c.Payments.Initiate(ctx, payment(source, "15.00", fmt.Sprintf("CHAOS-KAFKA-%d", i)))
+		require.NoError(t, err, "payment %d is accepted while Kafka is down", i)
+		ids = append(ids, initiated.ID)
+	}
+
+	restore()
+	awaitEvents(t, "bib.payment.orders", ids, 3*time.Minute)
+}
+
+// awaitEvents reads topic from the beginning until a message keyed by each
+// of keys has been seen.
+func awaitEvents(t *testing.T, topic string, keys []string, timeout time.Duration) {
+	t.Helper()
+	pending := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		pending[k] = true
+	}
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     strings.Split(envOr("KAFKA_BROKERS", "localhost:9092"), ","),
+		Topic:       topic,
+		GroupID:     "bib-chaos-" + uuid.NewString(),
+		StartOffset: kafka.FirstOffset,
+		MaxWait:     time.Second,
+	})
+	defer r.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	for len(pending) > 0 {
+		msg, err := r.ReadMessage(ctx)
+		if err != nil {
+			t.Fatalf("%d events never reached %s: %v (last error: %v)", len(pending), topic, keysOf(pending), err)
+		}
+		delete(pending, string(msg.Key))
+	}
+}
+
+func keysOf(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// ---------------------------------------------------------------------------
+// Postgres
+// ---------------------------------------------------------------------------
+
+// Payments retried with their idempotency keys across a Postgres failover
+// are each applied exactly once: none is lost and none is doubled.
+func TestPostgresFailover_PaymentsExactlyOnce(t *testing.T) {
+	c := newClient(t, 10*time.Second)
+	source := uuid.New()
+	run := uuid.NewString()[:8]
+
+	const n = 20
+	type attempt struct {
+		key, reference, amount, id string
+		err                        error
+	}
+	payments := make([]attempt, n)
+	for i := range payments {
+		payments[i] = attempt{
+			key:       fmt.Sprintf("chaos/%s/%d", run, i),
+			reference: fmt.Sprintf("CHAOS-PG-%s-%02d", run, i),
+			amount:    fmt.Sprintf("%d.25", 10+i),
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := range payments {
+			p := &payments[i]
+			p.id, p.err = initiateUntilAccepted(ctx, c, p.key, payment(source, p.amount, p.reference))
+			time.Sleep(200 * time.Millisecond)
+		}
+	}()
+
+	time.Sleep(time.Second)
+	newStack().Inject(t, "postgres", Bounce)()
+	<-done
+
+	byID := map[string]attempt{}
+	for _, p := range payments {
+		require.NoError(t, p.err, "payment %s was never accepted", p.reference)
+		byID[p.id] = p
+
+		replayed, err := c.Payments.Initiate(client.WithIdempotencyKey(ctx, p.key), payment(source, p.amount, p.reference))
+		require.NoError(t, err, "replay %s", p.reference)
+		assert.Equal(t, p.id, replayed.ID, "a replayed key returns the original payment, not a new one")
+	}
+	require.Len(t, byID, n, "every key created its own payment")
+
+	list, err := c.Payments.List(ctx, client.ListPaymentsParams{TenantID: tenantID.String(), AccountID: source.String()})
+	require.NoError(t, err)
+	require.Len(t, list.Payments, n, "no payment was lost or applied twice")
+	for _, got := range list.Payments {
+		want, ok := byID[got.ID]
+		if assert.True(t, ok, "payment %s (%s) was not created by a caller", got.ID, got.Reference) {
+			assert.Equal(t, want.reference, got.Reference)
+			assert.InDelta(t, amount(t, want.amount), amount(t, got.Amount), 1e-9)
+		}
+	}
+}
+
+func amount(t *testing.T, s string) float64 {
+	t.Helper()
+	f, err := strconv.ParseFloat(s, 64)
+	require.NoError(t, err, "amount %q", s)
+	return f
+}
+
+// initiateUntilAccepted sends the payment with its idempotency key until the
+// gateway accepts it, retrying anything that is not a client error, as a
+// well-behaved caller would through an outage.
+func initiateUntilAccepted(ctx context.Context, c *client.Client, key string, req *client.InitiatePaymentRequest) (string, error) {
+	for {
+		initiated, err := c.Payments.Initiate(client.WithIdempotencyKey(ctx, key), req)
+		if err == nil {
+			return initiated.ID, nil
+		}
+		if code := statusOf(err); code >= 400 && code < 500 && code != http.StatusTooManyRequests {
+			return "", err
+		}
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("%w (last error: %w)", ctx.Err(), err)
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
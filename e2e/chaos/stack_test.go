@@ -0,0 +1,111 @@
+//go:build chaos
+
+package chaos
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+)
+
+// stack controls the containers of the docker compose stack under test.
+type stack struct {
+	file    string
+	project string
+}
+
+func newStack() *stack {
+	return &stack{
+		file:    envOr("CHAOS_COMPOSE_FILE", "../../docker-compose.yml"),
+		project: os.Getenv("CHAOS_COMPOSE_PROJECT"),
+	}
+}
+
+// compose runs docker compose against the stack.
+func (s *stack) compose(ctx context.Context, args ...string) error {
+	full := []string{"compose", "-f", s.file}
+	if s.project != "" {
+		full = append(full, "-p", s.project)
+	}
+	cmd := exec.CommandContext(ctx, "docker", append(full, args...)...) //nolint:gosec // arguments are fixed by the tests
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return &composeError{args: args, out: strings.TrimSpace(string(out)), err: err}
+	}
+	return nil
+}
+
+type composeError struct {
+	args []string
+	out  string
+	err  error
+}
+
+func (e *composeError) Error() string {
+	return "docker compose " + strings.Join(e.args, " ") + ": " + e.err.Error() + ": " + e.out
+}
+
+// Fault is a way of taking a service out.
+type Fault string
+
+const (
+	// Kill stops the container, so connections to it are refused.
+	Kill Fault = "stop"
+	// Hang freezes the container's processes, so connections to it are
+	// accepted but never answered, like a wedged or partitioned backend.
+	Hang Fault = "pause"
+	// Bounce restarts the container, like a database failover: every
+	// connection is dropped and the service returns after a gap.
+	Bounce Fault = "restart"
+)
+
+// Inject applies the fault to the service and returns a function that
+// restores it and waits until it is healthy again. Restoring is also
+// registered as a cleanup, so a failing test does not leave the stack
+// broken for the next one; calling it twice is harmless.
+func (s *stack) Inject(t *testing.T, service string, fault Fault) (restore func()) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	if err := s.compose(ctx, string(fault), service); err != nil {
+		t.Fatalf("inject %s into %s: %v", fault, service, err)
+	}
+	t.Logf("%s: %s", service, fault)
+
+	paused := fault == Hang
+	restore = func() {
+		t.Helper()
+		if paused {
+			paused = false
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+			if err := s.compose(ctx, "unpause", service); err != nil {
+				t.Errorf("unpause %s: %v", service, err)
+			}
+		}
+		s.awaitHealthy(t, service)
+	}
+	t.Cleanup(restore)
+	return restore
+}
+
+// awaitHealthy starts the service if it is stopped and waits until its
+// health check passes.
+func (s *stack) awaitHealthy(t *testing.T, service string) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+	if err := s.compose(ctx, "up", "-d", "--wait", service); err != nil {
+		t.Errorf("recover %s: %v", service, err)
+	}
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
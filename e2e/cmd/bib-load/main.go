@@ -0,0 +1,252 @@
+// Command bib-load drives a steady mix of the gateway's hot paths — payment
+// initiation, card authorization and fraud assessment — at a fixed rate and
+// holds the latencies to budgets. It runs against any environment a
+// bib-seed manifest describes, so the same command serves a laptop, a
+// staging soak and a CI gate.
+//
+// Requests start on a fixed schedule regardless of how earlier ones fare,
+// so a slow gateway shows up as latency and dropped requests rather than a
+// quietly lower rate. The run fails, exiting 1, when a scenario's latency
+// percentile exceeds its budget, its error rate exceeds -max-error-rate,
+// or requests are dropped at -max-in-flight.
+//
+//	bib-seed -seed 42 -out fixtures.json
+//	bib-load -fixtures fixtures.json -rate 200 -duration 5m -budget card-auth:p99=120ms -out report.json
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/client"
+	"github.com/bibbank/bib/e2e/internal/fixture"
+)
+
+// Report is the JSON written by -out.
+type Report struct {
+	RunID        string    `json:"run_id"`
+	Gateway      string    `json:"gateway"`
+	StartedAt    time.Time `json:"started_at"`
+	Rate         float64   `json:"rate_rps"`
+	Duration     string    `json:"duration"`
+	Warmup       string    `json:"warmup"`
+	Budgets      []string  `json:"budgets"`
+	MaxErrorRate float64   `json:"max_error_rate"`
+	Summaries    []Summary `json:"summaries"`
+	Violations   []string  `json:"violations"`
+	Passed       bool      `json:"passed"`
+}
+
+// budgetFlag collects -budget values on top of the defaults; a flag for a
+// scenario and percentile replaces the default for them.
+type budgetFlag []Budget
+
+func (b *budgetFlag) String() string {
+	parts := make([]string, len(*b))
+	for i, budget := range *b {
+		parts[i] = budget.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+func (b *budgetFlag) Set(s string) error {
+	budget, err := ParseBudget(s)
+	if err != nil {
+		return err
+	}
+	for i, existing := range *b {
+		if existing.Scenario == budget.Scenario && existing.Percentile == budget.Percentile {
+			(*b)[i] = budget
+			return nil
+		}
+	}
+	*b = append(*b, budget)
+	return nil
+}
+
+func main() {
+	var (
+		cfg          Config
+		budgets      = budgetFlag(append([]Budget(nil), defaultBudgets...))
+		gateway      = flag.String("gateway", fixture.EnvOr("GATEWAY_URL", "http://localhost:8080"), "gateway base URL")
+		secret       = flag.String("jwt-secret", os.Getenv("JWT_SECRET"), "HMAC secret the gateway verifies tokens with")
+		issuer       = flag.String("jwt-issuer", fixture.EnvOr("JWT_ISSUER", "bib-gateway"), "token issuer the gateway expects")
+		fixtures     = flag.String("fixtures", "", "bib-seed manifest naming the tenant, accounts and cards to use")
+		mixFlag      = flag.String("mix", "payments=1,card-auth=1,fraud=1", "scenarios and their relative weights")
+		warmup       = flag.Duration("warmup", 0, "leading period whose requests are sent but left out of the results")
+		maxErrorRate = flag.Float64("max-error-rate", 0.01, "highest error rate, per scenario, the run tolerates")
+		reportEvery  = flag.Duration("report-every", 0, "log running results at this interval, for soaks; 0 disables")
+		out          = flag.String("out", "", "file to write the JSON report to, or - for stdout")
+		samplesOut   = flag.String("samples", "", "file to write every request's latency to as CSV")
+	)
+	flag.Float64Var(&cfg.Rate, "rate", 50, "requests per second across the mix")
+	flag.DurationVar(&cfg.Duration, "duration", time.Minute, "how long to send requests for")
+	flag.IntVar(&cfg.MaxInFlight, "max-in-flight", 256, "concurrent requests beyond which due requests are dropped")
+	flag.DurationVar(&cfg.Timeout, "timeout", 5*time.Second, "timeout for each request")
+	flag.Var(&budgets, "budget", "latency budget as scenario:pNN=duration; repeatable, replaces the default for that scenario and percentile")
+	flag.Parse()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	usage := func(msg string, args ...any) {
+		logger.Error(msg, args...)
+		os.Exit(2)
+	}
+	if *secret == "" {
+		usage("a JWT secret is required: set -jwt-secret or JWT_SECRET")
+	}
+	if *fixtures == "" {
+		usage("-fixtures is required; create one with bib-seed")
+	}
+	if cfg.Rate <= 0 || cfg.Duration <= 0 || *warmup >= cfg.Duration {
+		usage("-rate and -duration must be positive and -warmup shorter than -duration")
+	}
+	mix, err := ParseMix(*mixFlag)
+	if err != nil {
+		usage("invalid -mix", "error", err)
+	}
+	manifest, err := fixture.LoadManifest(*fixtures)
+	if err != nil {
+		usage("load fixtures", "error", err)
+	}
+	token, err := fixture.Token(*secret, *issuer, manifest.TenantID)
+	if err != nil {
+		usage("mint token", "error", err)
+	}
+	c, err := client.New(*gateway,
+		client.WithToken(token),
+		client.WithUserAgent("bib-load"),
+		// A retry would hide the failure and fold two round trips into one
+		// latency sample.
+		client.WithRetryPolicy(client.NoRetry),
+		client.WithHTTPClient(&http.Client{Transport: &http.Transport{
+			MaxIdleConnsPerHost: cfg.MaxInFlight,
+			IdleConnTimeout:     90 * time.Second,
+		}}),
+	)
+	if err != nil {
+		usage("create client", "error", err)
+	}
+	runID := uuid.NewString()[:8]
+	if err := bind(mix, c, manifest, runID); err != nil {
+		usage("fixtures do not cover the mix", "error", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	names := make([]string, len(mix))
+	for i, sc := range mix {
+		names[i] = sc.Name
+	}
+	rec := NewRecorder()
+	started := time.Now()
+	logger.Info("load run starting", "run_id", runID, "gateway", *gateway, "rate", cfg.Rate, "duration", cfg.Duration, "mix", *mixFlag)
+	if *reportEvery > 0 {
+		go progress(ctx, logger, rec, names, started, *reportEvery)
+	}
+	Run(ctx, cfg, mix, rec)
+	elapsed := time.Since(started)
+
+	samples, dropped := rec.Snapshot()
+	measured := samples[:0:0]
+	for _, s := range samples {
+		if s.Offset >= *warmup {
+			measured = append(measured, s)
+		}
+	}
+	summaries := Summarize(names, measured, dropped, elapsed-*warmup)
+	violations := Check(summaries, budgets, *maxErrorRate)
+
+	for _, s := range summaries {
+		logger.Info("scenario results", "scenario", s.Scenario, "requests", s.Requests, "errors", s.Errors, "dropped", s.Dropped,
+			"rps", strconv.FormatFloat(s.Throughput, 'f', 1, 64), "p50", s.P50, "p95", s.P95, "p99", s.P99, "max", s.Max)
+	}
+	for _, v := range violations {
+		logger.Error("budget exceeded", "violation", v)
+	}
+
+	if *out != "" {
+		report := Report{
+			RunID:        runID,
+			Gateway:      *gateway,
+			StartedAt:    started.UTC(),
+			Rate:         cfg.Rate,
+			Duration:     cfg.Duration.String(),
+			Warmup:       warmup.String(),
+			MaxErrorRate: *maxErrorRate,
+			Summaries:    summaries,
+			Violations:   violations,
+			Passed:       len(violations) == 0,
+		}
+		for _, b := range budgets {
+			report.Budgets = append(report.Budgets, b.String())
+		}
+		if err := fixture.WriteJSON(*out, report); err != nil {
+			logger.Error("write report", "error", err)
+			os.Exit(1)
+		}
+	}
+	if *samplesOut != "" {
+		if err := writeSamples(*samplesOut, samples); err != nil {
+			logger.Error("write samples", "error", err)
+			os.Exit(1)
+		}
+	}
+	if len(violations) > 0 {
+		os.Exit(1)
+	}
+}
+
+// progress logs the results so far at every interval until ctx ends.
+func progress(ctx context.Context, logger *slog.Logger, rec *Recorder, names []string, started time.Time, every time.Duration) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		samples, dropped := rec.Snapshot()
+		for _, s := range Summarize(names, samples, dropped, time.Since(started)) {
+			logger.Info("running results", "scenario", s.Scenario, "requests", s.Requests, "errors", s.Errors,
+				"dropped", s.Dropped, "p99", s.P99)
+		}
+	}
+}
+
+// writeSamples writes one CSV row per request, warmup included, for
+// plotting latency over the course of a soak.
+func writeSamples(path string, samples []Sample) error {
+	f, err := os.Create(path) //nolint:gosec // path is an operator-supplied flag
+	if err != nil {
+		return err
+	}
+	w := csv.NewWriter(f)
+	_ = w.Write([]string{"scenario", "offset_ms", "latency_ms", "outcome"})
+	for _, s := range samples {
+		_ = w.Write([]string{
+			s.Scenario,
+			strconv.FormatInt(s.Offset.Milliseconds(), 10),
+			strconv.FormatFloat(float64(s.Latency)/float64(time.Millisecond), 'f', 3, 64),
+			s.Outcome,
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return f.Close()
+}
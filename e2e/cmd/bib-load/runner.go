@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bibbank/bib/client"
+)
+
+// Scenario is one kind of request the load mix sends.
+type Scenario struct {
+	Name string
+	// Weight is the scenario's share of the mix relative to the others.
+	Weight int
+	// Do sends one request; seq is unique within the run.
+	Do func(ctx context.Context, seq uint64) error
+}
+
+// Config paces a run.
+type Config struct {
+	// Rate is the target number of requests per second across the mix.
+	Rate float64
+	// Duration is how long requests are started for.
+	Duration time.Duration
+	// MaxInFlight caps concurrent requests. A request due while the cap is
+	// reached is dropped and counted rather than delayed, so a slow backend
+	// shows up as drops instead of being hidden by a lower offered rate.
+	MaxInFlight int
+	// Timeout bounds each request.
+	Timeout time.Duration
+}
+
+// Sample is the outcome of one request.
+type Sample struct {
+	Scenario string
+	// Offset is when the request was due, relative to the start of the run.
+	Offset  time.Duration
+	Latency time.Duration
+	// Outcome is "ok", an HTTP status code, "timeout" or "transport".
+	Outcome string
+}
+
+// Recorder collects samples from concurrent requests.
+type Recorder struct {
+	mu      sync.Mutex
+	samples []Sample
+	dropped map[string]int
+}
+
+// NewRecorder returns an empty recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{dropped: map[string]int{}}
+}
+
+func (r *Recorder) record(s Sample) {
+	r.mu.Lock()
+	r.samples = append(r.samples, s)
+	r.mu.Unlock()
+}
+
+func (r *Recorder) drop(scenario string) {
+	r.mu.Lock()
+	r.dropped[scenario]++
+	r.mu.Unlock()
+}
+
+// Snapshot returns a copy of the samples and drop counts so far.
+func (r *Recorder) Snapshot() ([]Sample, map[string]int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	dropped := make(map[string]int, len(r.dropped))
+	for k, v := range r.dropped {
+		dropped[k] = v
+	}
+	return append([]Sample(nil), r.samples...), dropped
+}
+
+// Run offers cfg.Rate requests per second for cfg.Duration, spread over the
+// scenarios by weight, and waits for the requests in flight to finish.
+// Requests are started on a fixed schedule whatever the latency of earlier
+// ones, so queueing in the system under test is measured, not absorbed.
+func Run(ctx context.Context, cfg Config, scenarios []Scenario, rec *Recorder) {
+	slots := weightedSlots(scenarios)
+	if len(slots) == 0 || cfg.Rate <= 0 {
+		return
+	}
+	interval := time.Duration(float64(time.Second) / cfg.Rate)
+	inFlight := make(chan struct{}, max(cfg.MaxInFlight, 1))
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	start := time.Now()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for seq := uint64(0); ; seq++ {
+		due := time.Duration(seq) * interval
+		if due >= cfg.Duration {
+			return
+		}
+		timer.Reset(time.Until(start.Add(due)))
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		sc := scenarios[slots[seq%uint64(len(slots))]]
+		select {
+		case inFlight <- struct{}{}:
+		default:
+			rec.drop(sc.Name)
+			continue
+		}
+		wg.Add(1)
+		go func(seq uint64) {
+			defer wg.Done()
+			defer func() { <-inFlight }()
+			reqCtx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+			defer cancel()
+			began := time.Now()
+			err := sc.Do(reqCtx, seq)
+			rec.record(Sample{Scenario: sc.Name, Offset: due, Latency: time.Since(began), Outcome: outcome(err)})
+		}(seq)
+	}
+}
+
+// weightedSlots lays the scenarios out over one cycle of the mix,
+// interleaved so that a heavy scenario does not arrive in bursts.
+func weightedSlots(scenarios []Scenario) []int {
+	var total int
+	for _, sc := range scenarios {
+		total += max(sc.Weight, 0)
+	}
+	slots := make([]int, 0, total)
+	credit := make([]int, len(scenarios))
+	for range total {
+		best := -1
+		for i, sc := range scenarios {
+			if sc.Weight <= 0 {
+				continue
+			}
+			credit[i] += sc.Weight
+			if best < 0 || credit[i] > credit[best] {
+				best = i
+			}
+		}
+		credit[best] -= total
+		slots = append(slots, best)
+	}
+	return slots
+}
+
+func outcome(err error) string {
+	var apiErr *client.APIError
+	switch {
+	case err == nil:
+		return "ok"
+	case errors.As(err, &apiErr):
+		return strconv.Itoa(apiErr.StatusCode)
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	default:
+		return "transport"
+	}
+}
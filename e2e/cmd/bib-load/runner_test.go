@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/client"
+)
+
+func TestWeightedSlots_InterleavesByWeight(t *testing.T) {
+	slots := weightedSlots([]Scenario{{Name: "a", Weight: 3}, {Name: "b", Weight: 1}, {Name: "off", Weight: 0}, {Name: "c", Weight: 2}})
+	require.Len(t, slots, 6)
+
+	counts := map[int]int{}
+	for i, s := range slots {
+		counts[s]++
+		if i > 0 && s == 0 {
+			assert.NotEqual(t, 0, slots[i-1], "the heaviest scenario is spread out, not bunched: %v", slots)
+		}
+	}
+	assert.Equal(t, map[int]int{0: 3, 1: 1, 3: 2}, counts)
+}
+
+func TestRun_PacesAndRecords(t *testing.T) {
+	var calls atomic.Int64
+	scenarios := []Scenario{
+		{Name: "fast", Weight: 1, Do: func(context.Context, uint64) error { calls.Add(1); return nil }},
+		{Name: "failing", Weight: 1, Do: func(context.Context, uint64) error {
+			calls.Add(1)
+			return &client.APIError{StatusCode: 503, Message: "unavailable"}
+		}},
+	}
+	rec := NewRecorder()
+	start := time.Now()
+	Run(context.Background(), Config{Rate: 200, Duration: 100 * time.Millisecond, MaxInFlight: 10, Timeout: time.Second}, scenarios, rec)
+
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond, "requests are spread over the duration")
+	samples, dropped := rec.Snapshot()
+	require.Len(t, samples, 20, "rate x duration requests are offered")
+	assert.EqualValues(t, 20, calls.Load())
+	assert.Empty(t, dropped)
+	for _, s := range samples {
+		switch s.Scenario {
+		case "fast":
+			assert.Equal(t, "ok", s.Outcome)
+		case "failing":
+			assert.Equal(t, "503", s.Outcome)
+		}
+	}
+}
+
+func TestRun_DropsAtInFlightCap(t *testing.T) {
+	release := make(chan struct{})
+	scenarios := []Scenario{{Name: "stuck", Weight: 1, Do: func(ctx context.Context, _ uint64) error {
+		select {
+		case <-release:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}}}
+	rec := NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		Run(context.Background(), Config{Rate: 500, Duration: 50 * time.Millisecond, MaxInFlight: 2, Timeout: time.Second}, scenarios, rec)
+		close(done)
+	}()
+	time.Sleep(80 * time.Millisecond)
+	close(release)
+	<-done
+
+	samples, dropped := rec.Snapshot()
+	assert.Len(t, samples, 2, "only the requests under the cap are sent")
+	assert.Equal(t, 23, dropped["stuck"], "the rest are dropped, not delayed")
+}
+
+func TestOutcome(t *testing.T) {
+	assert.Equal(t, "ok", outcome(nil))
+	assert.Equal(t, "429", outcome(fmt.Errorf("wrapped: %w", &client.APIError{StatusCode: 429})))
+	assert.Equal(t, "timeout", outcome(fmt.Errorf("do: %w", context.DeadlineExceeded)))
+	assert.Equal(t, "transport", outcome(errors.New("connection refused")))
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/client"
+	"github.com/bibbank/bib/e2e/internal/fixture"
+)
+
+// Scenario names, as used in -mix and -budget.
+const (
+	scenarioPayments = "payments"
+	scenarioCardAuth = "card-auth"
+	scenarioFraud    = "fraud"
+)
+
+// defaultBudgets are the p99 latencies the gateway's hot paths are held to
+// unless -budget overrides them. Card authorization sits inside a network
+// round trip with a scheme timeout, so it has the tightest budget.
+var defaultBudgets = []Budget{
+	{Scenario: scenarioPayments, Percentile: 99, Max: 500 * time.Millisecond},
+	{Scenario: scenarioCardAuth, Percentile: 99, Max: 150 * time.Millisecond},
+	{Scenario: scenarioFraud, Percentile: 99, Max: 250 * time.Millisecond},
+}
+
+// ParseMix parses a comma-separated list of scenario=weight pairs, for
+// example "payments=2,card-auth=5,fraud=3". A name without a weight has
+// weight 1.
+func ParseMix(s string) ([]Scenario, error) {
+	var mix []Scenario
+	seen := map[string]bool{}
+	for _, part := range strings.Split(s, ",") {
+		name, w, hasWeight := strings.Cut(strings.TrimSpace(part), "=")
+		weight := 1
+		if hasWeight {
+			var err error
+			if weight, err = strconv.Atoi(w); err != nil || weight < 0 {
+				return nil, fmt.Errorf("mix %q: weight of %s must be a non-negative integer", s, name)
+			}
+		}
+		switch name {
+		case scenarioPayments, scenarioCardAuth, scenarioFraud:
+		default:
+			return nil, fmt.Errorf("mix %q: unknown scenario %q", s, name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("mix %q: %s listed twice", s, name)
+		}
+		seen[name] = true
+		if weight > 0 {
+			mix = append(mix, Scenario{Name: name, Weight: weight})
+		}
+	}
+	if len(mix) == 0 {
+		return nil, fmt.Errorf("mix %q: no scenario has a positive weight", s)
+	}
+	return mix, nil
+}
+
+// bind gives each scenario in the mix its request, drawing accounts and
+// cards round-robin from the manifest. Every request carries its own
+// idempotency key under runID, so it is processed rather than replayed.
+func bind(mix []Scenario, c *client.Client, m *fixture.Manifest, runID string) error {
+	var accounts, cards []string
+	for _, cust := range m.Customers {
+		if cust.CheckingAccountID != "" {
+			accounts = append(accounts, cust.CheckingAccountID)
+		}
+		if cust.CardID != "" {
+			cards = append(cards, cust.CardID)
+		}
+	}
+	key := func(ctx context.Context, seq uint64) context.Context {
+		return client.WithIdempotencyKey(ctx, fmt.Sprintf("bib-load/%s/%d", runID, seq))
+	}
+
+	for i := range mix {
+		sc := &mix[i]
+		switch sc.Name {
+		case scenarioPayments:
+			if len(accounts) == 0 {
+				return fmt.Errorf("%s: the manifest has no checking accounts", sc.Name)
+			}
+			sc.Do = func(ctx context.Context, seq uint64) error {
+				_, err := c.Payments.Initiate(key(ctx, seq), &client.InitiatePaymentRequest{
+					SourceAccountID:       accounts[seq%uint64(len(accounts))],
+					Amount:                "1.00",
+					Currency:              "USD",
+					RoutingNumber:         "021000021",
+					ExternalAccountNumber: "123456789",
+					Reference:             fmt.Sprintf("LOAD-%s-%d", runID, seq),
+					Description:           "bib-load",
+				})
+				return err
+			}
+		case scenarioCardAuth:
+			if len(cards) == 0 {
+				return fmt.Errorf("%s: the manifest has no cards", sc.Name)
+			}
+			sc.Do = func(ctx context.Context, seq uint64) error {
+				_, err := c.Cards.Authorize(key(ctx, seq), cards[seq%uint64(len(cards))], &client.AuthorizeRequest{
+					Amount:           "1.00",
+					Currency:         "USD",
+					MerchantName:     "bib-load",
+					MerchantCategory: "5411",
+				})
+				return err
+			}
+		case scenarioFraud:
+			if len(accounts) == 0 {
+				return fmt.Errorf("%s: the manifest has no checking accounts", sc.Name)
+			}
+			sc.Do = func(ctx context.Context, seq uint64) error {
+				_, err := c.Fraud.Assess(key(ctx, seq), &client.AssessTransactionRequest{
+					TransactionID:   uuid.NewString(),
+					AccountID:       accounts[seq%uint64(len(accounts))],
+					Amount:          "25.00",
+					Currency:        "USD",
+					TransactionType: "WIRE_TRANSFER",
+				})
+				return err
+			}
+		}
+	}
+	return nil
+}
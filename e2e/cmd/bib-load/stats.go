@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Summary describes one scenario's samples.
+type Summary struct {
+	Scenario   string         `json:"scenario"`
+	Requests   int            `json:"requests"`
+	Errors     int            `json:"errors"`
+	Dropped    int            `json:"dropped"`
+	ErrorRate  float64        `json:"error_rate"`
+	Throughput float64        `json:"throughput_rps"`
+	Outcomes   map[string]int `json:"outcomes"`
+	P50        Millis         `json:"p50_ms"`
+	P90        Millis         `json:"p90_ms"`
+	P95        Millis         `json:"p95_ms"`
+	P99        Millis         `json:"p99_ms"`
+	Max        Millis         `json:"max_ms"`
+
+	sorted []time.Duration
+}
+
+// Millis is a duration reported in fractional milliseconds.
+type Millis time.Duration
+
+// MarshalJSON encodes the duration as milliseconds.
+func (m Millis) MarshalJSON() ([]byte, error) {
+	return strconv.AppendFloat(nil, float64(time.Duration(m))/float64(time.Millisecond), 'f', 3, 64), nil
+}
+
+func (m Millis) String() string { return time.Duration(m).Round(10 * time.Microsecond).String() }
+
+// Summarize groups samples by scenario, in the order names are given.
+// Latency percentiles include failed requests, since a caller waits for
+// those too.
+func Summarize(names []string, samples []Sample, dropped map[string]int, elapsed time.Duration) []Summary {
+	byName := make(map[string]*Summary, len(names))
+	out := make([]Summary, len(names))
+	for i, name := range names {
+		out[i] = Summary{Scenario: name, Outcomes: map[string]int{}, Dropped: dropped[name]}
+		byName[name] = &out[i]
+	}
+	for _, s := range samples {
+		sum, ok := byName[s.Scenario]
+		if !ok {
+			continue
+		}
+		sum.Requests++
+		sum.Outcomes[s.Outcome]++
+		if s.Outcome != "ok" {
+			sum.Errors++
+		}
+		sum.sorted = append(sum.sorted, s.Latency)
+	}
+	for i := range out {
+		sum := &out[i]
+		slices.Sort(sum.sorted)
+		if sum.Requests > 0 {
+			sum.ErrorRate = float64(sum.Errors) / float64(sum.Requests)
+			sum.Max = Millis(sum.sorted[len(sum.sorted)-1])
+		}
+		if elapsed > 0 {
+			sum.Throughput = float64(sum.Requests) / elapsed.Seconds()
+		}
+		sum.P50 = Millis(percentile(sum.sorted, 50))
+		sum.P90 = Millis(percentile(sum.sorted, 90))
+		sum.P95 = Millis(percentile(sum.sorted, 95))
+		sum.P99 = Millis(percentile(sum.sorted, 99))
+	}
+	return out
+}
+
+// Percentile returns the p-th percentile of the scenario's latencies.
+func (s Summary) Percentile(p float64) time.Duration { return percentile(s.sorted, p) }
+
+// percentile returns the nearest-rank p-th percentile of sorted.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[min(max(rank, 1), len(sorted))-1]
+}
+
+// Budget is the most a scenario's latency percentile may be.
+type Budget struct {
+	Scenario   string
+	Percentile float64
+	Max        time.Duration
+}
+
+func (b Budget) String() string {
+	return fmt.Sprintf("%s:p%s=%s", b.Scenario, strconv.FormatFloat(b.Percentile, 'f', -1, 64), b.Max)
+}
+
+// ParseBudget parses a budget written as scenario:pNN=duration, for example
+// "card-auth:p99=150ms" or "payments:p99.9=1s".
+func ParseBudget(s string) (Budget, error) {
+	scenario, rest, ok := strings.Cut(s, ":")
+	pct, limit, ok2 := strings.Cut(rest, "=")
+	if !ok || !ok2 || scenario == "" || !strings.HasPrefix(pct, "p") {
+		return Budget{}, fmt.Errorf("budget %q: want scenario:pNN=duration", s)
+	}
+	p, err := strconv.ParseFloat(pct[1:], 64)
+	if err != nil || p <= 0 || p > 100 {
+		return Budget{}, fmt.Errorf("budget %q: percentile must be in (0, 100]", s)
+	}
+	d, err := time.ParseDuration(limit)
+	if err != nil || d <= 0 {
+		return Budget{}, fmt.Errorf("budget %q: invalid duration %q", s, limit)
+	}
+	return Budget{Scenario: scenario, Percentile: p, Max: d}, nil
+}
+
+// Check returns a description of every budget the summaries break: a
+// latency budget, an error rate above maxErrorRate, or any dropped request.
+// A scenario with no requests breaks its budgets, since it proves nothing.
+func Check(summaries []Summary, budgets []Budget, maxErrorRate float64) []string {
+	var violations []string
+	byName := make(map[string]Summary, len(summaries))
+	for _, s := range summaries {
+		byName[s.Scenario] = s
+		switch {
+		case s.Requests == 0:
+			violations = append(violations, fmt.Sprintf("%s: no requests completed", s.Scenario))
+			continue
+		case s.ErrorRate > maxErrorRate:
+			violations = append(violations, fmt.Sprintf("%s: error rate %.2f%% over budget %.2f%%", s.Scenario, 100*s.ErrorRate, 100*maxErrorRate))
+		}
+		if s.Dropped > 0 {
+			violations = append(violations, fmt.Sprintf("%s: %d requests dropped at the in-flight cap", s.Scenario, s.Dropped))
+		}
+	}
+	for _, b := range budgets {
+		s, ok := byName[b.Scenario]
+		if !ok || s.Requests == 0 {
+			continue
+		}
+		if got := s.Percentile(b.Percentile); got > b.Max {
+			violations = append(violations, fmt.Sprintf("%s: p%s %s over budget %s",
+				b.Scenario, strconv.FormatFloat(b.Percentile, 'f', -1, 64), Millis(got), b.Max))
+		}
+	}
+	return violations
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentile_NearestRank(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i)*time.Millisecond)
+	}
+	assert.Equal(t, 50*time.Millisecond, percentile(sorted, 50))
+	assert.Equal(t, 99*time.Millisecond, percentile(sorted, 99))
+	assert.Equal(t, 100*time.Millisecond, percentile(sorted, 99.9))
+	assert.Equal(t, 100*time.Millisecond, percentile(sorted, 100))
+	assert.Equal(t, time.Millisecond, percentile(sorted, 0.1))
+	assert.Zero(t, percentile(nil, 99))
+}
+
+func TestSummarize(t *testing.T) {
+	samples := []Sample{
+		{Scenario: "payments", Latency: 30 * time.Millisecond, Outcome: "ok"},
+		{Scenario: "payments", Latency: 10 * time.Millisecond, Outcome: "ok"},
+		{Scenario: "payments", Latency: 20 * time.Millisecond, Outcome: "503"},
+		{Scenario: "fraud", Latency: 5 * time.Millisecond, Outcome: "timeout"},
+		{Scenario: "unknown", Latency: time.Second, Outcome: "ok"},
+	}
+	got := Summarize([]string{"payments", "fraud", "card-auth"}, samples, map[string]int{"fraud": 2}, 2*time.Second)
+	require.Len(t, got, 3)
+
+	payments := got[0]
+	assert.Equal(t, "payments", payments.Scenario)
+	assert.Equal(t, 3, payments.Requests)
+	assert.Equal(t, 1, payments.Errors)
+	assert.InDelta(t, 1.0/3, payments.ErrorRate, 1e-9)
+	assert.InDelta(t, 1.5, payments.Throughput, 1e-9)
+	assert.Equal(t, map[string]int{"ok": 2, "503": 1}, payments.Outcomes)
+	assert.Equal(t, Millis(20*time.Millisecond), payments.P50)
+	assert.Equal(t, Millis(30*time.Millisecond), payments.P99)
+	assert.Equal(t, Millis(30*time.Millisecond), payments.Max)
+
+	assert.Equal(t, 2, got[1].Dropped)
+	assert.Equal(t, 1, got[1].Errors)
+	assert.Zero(t, got[2].Requests, "a scenario without samples is still reported")
+}
+
+func TestParseBudget(t *testing.T) {
+	b, err := ParseBudget("card-auth:p99.9=150ms")
+	require.NoError(t, err)
+	assert.Equal(t, Budget{Scenario: "card-auth", Percentile: 99.9, Max: 150 * time.Millisecond}, b)
+	assert.Equal(t, "card-auth:p99.9=150ms", b.String())
+
+	for _, bad := range []string{"", "payments", "payments:p99", "payments:99=1s", ":p99=1s", "payments:p0=1s", "payments:p101=1s", "payments:p99=fast", "payments:p99=-1s"} {
+		_, err := ParseBudget(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	var latencies []Sample
+	for i := 1; i <= 100; i++ {
+		latencies = append(latencies, Sample{Scenario: "card-auth", Latency: time.Duration(i) * time.Millisecond, Outcome: "ok"})
+	}
+	latencies = append(latencies, Sample{Scenario: "payments", Latency: time.Millisecond, Outcome: "500"})
+	summaries := Summarize([]string{"card-auth", "payments", "fraud"}, latencies, map[string]int{"card-auth": 3}, time.Second)
+
+	violations := Check(summaries, []Budget{
+		{Scenario: "card-auth", Percentile: 99, Max: 50 * time.Millisecond},
+		{Scenario: "card-auth", Percentile: 50, Max: 50 * time.Millisecond},
+		{Scenario: "payments", Percentile: 99, Max: time.Second},
+	}, 0.01)
+
+	assert.ElementsMatch(t, []string{
+		"card-auth: 3 requests dropped at the in-flight cap",
+		"card-auth: p99 99ms over budget 50ms",
+		"payments: error rate 100.00% over budget 1.00%",
+		"fraud: no requests completed",
+	}, violations)
+}
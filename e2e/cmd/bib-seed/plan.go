@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/bibbank/bib/client"
+)
+
+// Options sizes the data set. The same options, seed included, always give
+// the same plan.
+type Options struct {
+	TenantSlug         string
+	Seed               uint64
+	Customers          int
+	PaymentsPerAccount int
+}
+
+// Plan is the data set to create, fixed before any call is made.
+type Plan struct {
+	Tenant    client.CreateTenantRequest
+	Product   client.CreateProductRequest
+	Customers []Customer
+}
+
+// Customer is a verified customer with a checking account and, depending on
+// the draw, a savings account, a term deposit, a card and a loan.
+type Customer struct {
+	FirstName   string
+	LastName    string
+	Email       string
+	DateOfBirth string
+	Savings     bool
+	// Deposit is the principal placed in the term deposit product; empty
+	// means none.
+	Deposit  string
+	Card     *client.IssueCardRequest
+	Loan     *client.SubmitApplicationRequest
+	Payments []Payment
+}
+
+// Payment moves money from the customer's checking account, either to
+// another customer's checking account or to an external account.
+type Payment struct {
+	// To is the index of the receiving customer, or -1 for an external
+	// account.
+	To          int
+	Amount      string
+	Reference   string
+	Description string
+}
+
+var (
+	firstNames = []string{"Ada", "Grace", "Alan", "Katherine", "Edsger", "Barbara", "Donald", "Frances", "Ken", "Radia", "Tim", "Margaret"}
+	lastNames  = []string{"Lovelace", "Hopper", "Turing", "Johnson", "Dijkstra", "Liskov", "Knuth", "Allen", "Thompson", "Perlman", "Berners-Lee", "Hamilton"}
+	purposes   = []string{"Home renovation", "Car purchase", "Debt consolidation", "Education", "Small business"}
+	payees     = []string{"Rent", "Groceries", "Utilities", "Insurance", "Gym", "Phone bill", "Dinner", "Travel"}
+)
+
+// NewPlan draws the data set for opts.
+func NewPlan(opts Options) Plan {
+	rng := rand.New(rand.NewPCG(opts.Seed, 0x62696221)) //nolint:gosec // demo data, not secrets
+
+	plan := Plan{
+		Tenant: client.CreateTenantRequest{
+			Slug: opts.TenantSlug,
+			Settings: &client.TenantSettings{
+				Name:         "Demo Bank (" + opts.TenantSlug + ")",
+				BaseCurrency: "USD",
+				Jurisdiction: "US",
+			},
+		},
+		Product: client.CreateProductRequest{
+			Name:     "12-Month Fixed Deposit",
+			Currency: "USD",
+			Tiers: []client.InterestTier{
+				{MinBalance: "1000.00", MaxBalance: "50000.00", RateBps: 450},
+				{MinBalance: "50000.01", MaxBalance: "1000000.00", RateBps: 500},
+			},
+			TermDays: 365,
+		},
+	}
+
+	for i := range opts.Customers {
+		first := firstNames[rng.IntN(len(firstNames))]
+		last := lastNames[rng.IntN(len(lastNames))]
+		c := Customer{
+			FirstName:   first,
+			LastName:    last,
+			Email:       fmt.Sprintf("%s.%s.%d@%s.example.com", strings.ToLower(first), strings.ToLower(last), i, opts.TenantSlug),
+			DateOfBirth: fmt.Sprintf("%d-%02d-%02d", 1950+rng.IntN(55), 1+rng.IntN(12), 1+rng.IntN(28)),
+			Savings:     rng.IntN(2) == 0,
+		}
+		if rng.IntN(3) == 0 {
+			c.Deposit = money(1000+rng.IntN(49000), 0)
+		}
+		if rng.IntN(4) != 0 {
+			cardType := "PHYSICAL"
+			if rng.IntN(2) == 0 {
+				cardType = "VIRTUAL"
+			}
+			c.Card = &client.IssueCardRequest{
+				CardType:     cardType,
+				Currency:     "USD",
+				DailyLimit:   "5000.00",
+				MonthlyLimit: "25000.00",
+			}
+		}
+		if rng.IntN(5) == 0 {
+			c.Loan = &client.SubmitApplicationRequest{
+				RequestedAmount: money(5000+rng.IntN(45000), 0),
+				Currency:        "USD",
+				Purpose:         purposes[rng.IntN(len(purposes))],
+				TermMonths:      12 * (1 + rng.IntN(5)),
+			}
+		}
+		for j := range opts.PaymentsPerAccount {
+			p := Payment{
+				To:        -1,
+				Amount:    money(5+rng.IntN(500), rng.IntN(100)),
+				Reference: fmt.Sprintf("SEED-%d-%03d-%03d", opts.Seed, i, j),
+			}
+			if opts.Customers > 1 && rng.IntN(2) == 0 {
+				// Pick another customer: shift by 1..n-1 so it is never i.
+				p.To = (i + 1 + rng.IntN(opts.Customers-1)) % opts.Customers
+			}
+			p.Description = payees[rng.IntN(len(payees))]
+			c.Payments = append(c.Payments, p)
+		}
+		plan.Customers = append(plan.Customers, c)
+	}
+	return plan
+}
+
+func money(units, cents int) string {
+	return fmt.Sprintf("%d.%02d", units, cents)
+}
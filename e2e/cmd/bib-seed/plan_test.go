@@ -0,0 +1,52 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPlan_IsDeterministic(t *testing.T) {
+	opts := Options{TenantSlug: "demo", Seed: 42, Customers: 20, PaymentsPerAccount: 5}
+
+	assert.Equal(t, NewPlan(opts), NewPlan(opts), "the same options give the same plan")
+
+	other := opts
+	other.Seed = 43
+	assert.NotEqual(t, NewPlan(opts), NewPlan(other), "another seed gives another plan")
+}
+
+func TestNewPlan_Shape(t *testing.T) {
+	plan := NewPlan(Options{TenantSlug: "demo", Seed: 7, Customers: 30, PaymentsPerAccount: 4})
+
+	require.Len(t, plan.Customers, 30)
+	assert.Equal(t, "demo", plan.Tenant.Slug)
+
+	emails := map[string]bool{}
+	var internal, external int
+	for i, c := range plan.Customers {
+		assert.False(t, emails[c.Email], "email %s is unique", c.Email)
+		emails[c.Email] = true
+		require.Len(t, c.Payments, 4)
+		for _, p := range c.Payments {
+			switch {
+			case p.To < 0:
+				external++
+			default:
+				internal++
+				assert.NotEqual(t, i, p.To, "customers do not pay themselves")
+				assert.Less(t, p.To, 30)
+			}
+		}
+	}
+	assert.Positive(t, internal, "some payments stay within the bank")
+	assert.Positive(t, external, "some payments leave the bank")
+}
+
+func TestNewPlan_SingleCustomerPaysOut(t *testing.T) {
+	plan := NewPlan(Options{TenantSlug: "solo", Seed: 1, Customers: 1, PaymentsPerAccount: 3})
+	for _, p := range plan.Customers[0].Payments {
+		assert.Equal(t, -1, p.To, "a lone customer can only pay external accounts")
+	}
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/bibbank/bib/client"
+	"github.com/bibbank/bib/e2e/internal/fixture"
+)
+
+// Seeder creates a Plan through the gateway.
+type Seeder struct {
+	// ClientFor returns a client acting for the tenant; an empty tenantID is
+	// the platform operator, which creates the tenant itself.
+	ClientFor func(tenantID string) (*client.Client, error)
+	Logger    *slog.Logger
+	// LoanDecisionWait bounds how long to wait for a loan application to be
+	// approved before leaving it undisbursed.
+	LoanDecisionWait time.Duration
+}
+
+// Run creates the plan. Every call carries an idempotency key derived from
+// the tenant slug, seed and step, so running the same plan again replays
+// the earlier responses instead of creating duplicates.
+func (s *Seeder) Run(ctx context.Context, opts Options, plan Plan) (*fixture.Manifest, error) {
+	key := func(step string, args ...any) context.Context {
+		return client.WithIdempotencyKey(ctx, fmt.Sprintf("bib-seed/%s/%d/%s", opts.TenantSlug, opts.Seed, fmt.Sprintf(step, args...)))
+	}
+
+	platform, err := s.ClientFor("")
+	if err != nil {
+		return nil, err
+	}
+	tenant, err := s.tenant(key("tenant"), platform, plan.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	s.Logger.Info("tenant ready", "tenant_id", tenant.TenantID, "slug", tenant.Slug)
+
+	c, err := s.ClientFor(tenant.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	m := &fixture.Manifest{TenantID: tenant.TenantID, TenantSlug: tenant.Slug, Seed: opts.Seed}
+
+	product, err := c.Deposits.CreateProduct(key("deposit-product"), &plan.Product)
+	if err != nil {
+		return nil, fmt.Errorf("create deposit product: %w", err)
+	}
+	m.ProductID = product.ID
+
+	if err := s.creditPullDisclosure(key("credit-pull-disclosure"), c); err != nil {
+		return nil, err
+	}
+
+	for i, cust := range plan.Customers {
+		rec, err := s.customer(ctx, key, c, i, cust, product.ID)
+		if err != nil {
+			return nil, fmt.Errorf("customer %d (%s): %w", i, cust.Email, err)
+		}
+		m.Customers = append(m.Customers, rec)
+	}
+	s.Logger.Info("customers created", "count", len(m.Customers))
+
+	for i, cust := range plan.Customers {
+		for j, p := range cust.Payments {
+			req := &client.InitiatePaymentRequest{
+				SourceAccountID: m.Customers[i].CheckingAccountID,
+				Amount:          p.Amount,
+				Currency:        "USD",
+				Reference:       p.Reference,
+				Description:     p.Description,
+			}
+			if p.To >= 0 {
+				req.DestinationAccountID = m.Customers[p.To].CheckingAccountID
+			} else {
+				req.RoutingNumber, req.ExternalAccountNumber = "021000021", "123456789"
+			}
+			payment, err := c.Payments.Initiate(key("customer/%d/payment/%d", i, j), req)
+			if err != nil {
+				return nil, fmt.Errorf("payment %s: %w", p.Reference, err)
+			}
+			m.PaymentIDs = append(m.PaymentIDs, payment.ID)
+		}
+	}
+	s.Logger.Info("payments initiated", "count", len(m.PaymentIDs))
+	return m, nil
+}
+
+// tenant creates the tenant, or finds it when an earlier run with another
+// seed already took the slug.
+func (s *Seeder) tenant(ctx context.Context, platform *client.Client, req client.CreateTenantRequest) (*client.Tenant, error) {
+	tenant, err := platform.Tenants.Create(ctx, &req)
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusConflict {
+		if err != nil {
+			return nil, fmt.Errorf("create tenant: %w", err)
+		}
+		return tenant, nil
+	}
+	for t, err := range platform.Tenants.All(ctx, client.ListTenantsParams{}) {
+		if err != nil {
+			return nil, fmt.Errorf("find tenant %s: %w", req.Slug, err)
+		}
+		if t.Slug == req.Slug {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("tenant %s exists but is not listed", req.Slug)
+}
+
+// creditPullDisclosure publishes the credit pull authorization applicants
+// consent to before their loan applications pull a credit report, unless
+// an earlier run already published it.
+func (s *Seeder) creditPullDisclosure(ctx context.Context, c *client.Client) error {
+	_, err := c.Consent.PublishDisclosure(ctx, &client.PublishDisclosureRequest{
+		Type:        "CREDIT_PULL",
+		Version:     "bib-seed-1",
+		ContentHash: fmt.Sprintf("%x", sha256.Sum256([]byte("I authorize a credit bureau pull for my loan application."))),
+	})
+	var apiErr *client.APIError
+	if err != nil && (!errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusConflict) {
+		return fmt.Errorf("publish credit pull disclosure: %w", err)
+	}
+	return nil
+}
+
+func (s *Seeder) customer(
+	ctx context.Context,
+	key func(string, ...any) context.Context,
+	c *client.Client,
+	i int,
+	cust Customer,
+	productID string,
+) (fixture.CustomerRecord, error) {
+	rec := fixture.CustomerRecord{Email: cust.Email}
+
+	verification, err := c.Identity.InitiateVerification(key("customer/%d/verification", i), &client.InitiateVerificationRequest{
+		FirstName:   cust.FirstName,
+		LastName:    cust.LastName,
+		Email:       cust.Email,
+		DateOfBirth: cust.DateOfBirth,
+		Country:     "US",
+	})
+	if err != nil {
+		return rec, fmt.Errorf("verify identity: %w", err)
+	}
+	rec.VerificationID = verification.ID
+
+	open := func(step, accountType string) (string, error) {
+		acct, err := c.Accounts.Open(key("customer/%d/%s", i, step), &client.OpenAccountRequest{
+			AccountType:            accountType,
+			Currency:               "USD",
+			HolderFirstName:        cust.FirstName,
+			HolderLastName:         cust.LastName,
+			HolderEmail:            cust.Email,
+			IdentityVerificationID: verification.ID,
+		})
+		if err != nil {
+			return "", fmt.Errorf("open %s account: %w", step, err)
+		}
+		return acct.AccountID, nil
+	}
+	if rec.CheckingAccountID, err = open("checking", "CHECKING"); err != nil {
+		return rec, err
+	}
+	if cust.Savings {
+		if rec.SavingsAccountID, err = open("savings", "SAVINGS"); err != nil {
+			return rec, err
+		}
+	}
+
+	if cust.Deposit != "" {
+		accountID := rec.SavingsAccountID
+		if accountID == "" {
+			accountID = rec.CheckingAccountID
+		}
+		position, err := c.Deposits.OpenPosition(key("customer/%d/deposit", i), &client.OpenPositionRequest{
+			AccountID: accountID,
+			ProductID: productID,
+			Principal: cust.Deposit,
+		})
+		if err != nil {
+			return rec, fmt.Errorf("open deposit: %w", err)
+		}
+		rec.DepositID = position.ID
+	}
+
+	if cust.Card != nil {
+		req := *cust.Card
+		req.AccountID = rec.CheckingAccountID
+		card, err := c.Cards.Issue(key("customer/%d/card", i), &req)
+		if err != nil {
+			return rec, fmt.Errorf("issue card: %w", err)
+		}
+		rec.CardID = card.CardID
+	}
+
+	if cust.Loan != nil {
+		req := *cust.Loan
+		req.ApplicantID = verification.ID
+		_, err := c.Consent.RecordConsent(key("customer/%d/credit-pull-consent", i), &client.RecordConsentRequest{
+			CustomerID: verification.ID,
+			Type:       "CREDIT_PULL",
+			Action:     "GRANTED",
+			Channel:    "ONLINE",
+			Evidence:   "bib-seed",
+		})
+		if err != nil {
+			return rec, fmt.Errorf("record credit pull consent: %w", err)
+		}
+		application, err := c.Lending.SubmitApplication(key("customer/%d/loan-application", i), &req)
+		if err != nil {
+			return rec, fmt.Errorf("apply for loan: %w", err)
+		}
+		rec.LoanApplicationID = application.ApplicationID
+		if s.awaitApproval(ctx, c, application) {
+			loan, err := c.Lending.Disburse(key("customer/%d/loan", i), &client.DisburseLoanRequest{
+				ApplicationID:     application.ApplicationID,
+				BorrowerAccountID: rec.CheckingAccountID,
+				InterestRateBps:   700,
+			})
+			if err != nil {
+				return rec, fmt.Errorf("disburse loan: %w", err)
+			}
+			rec.LoanID = loan.LoanID
+		}
+	}
+	return rec, nil
+}
+
+// awaitApproval polls the application until it is approved, rejected or
+// LoanDecisionWait passes, and reports whether it was approved.
+func (s *Seeder) awaitApproval(ctx context.Context, c *client.Client, application *client.LoanApplication) bool {
+	deadline := time.Now().Add(s.LoanDecisionWait)
+	for status := application.Status; ; {
+		switch status {
+		case "APPROVED":
+			return true
+		case "REJECTED", "DISBURSED":
+			return false
+		}
+		if time.Now().After(deadline) {
+			s.Logger.Warn("loan application not approved in time; leaving it undisbursed",
+				"application_id", application.ApplicationID, "status", status)
+			return false
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(time.Second):
+		}
+		latest, err := c.Lending.GetApplication(ctx, application.ApplicationID)
+		if err != nil {
+			s.Logger.Warn("poll loan application", "application_id", application.ApplicationID, "error", err)
+			continue
+		}
+		status = latest.Status
+	}
+}
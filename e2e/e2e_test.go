@@ -3,10 +3,11 @@
 package e2e
 
 import (
-	"bytes"
+	"context"
+	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"os"
 	"testing"
@@ -16,6 +17,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/client"
 )
 
 // ---------------------------------------------------------------------------
@@ -69,68 +72,16 @@ func getTestToken(t *testing.T) string {
 	return signed
 }
 
-// newClient returns an *http.Client with a reasonable timeout for e2e calls.
-func newClient() *http.Client {
-	return &http.Client{Timeout: 15 * time.Second}
-}
-
-// doJSON performs an HTTP request with JSON body and auth header, returning the
-// parsed response body as a map and the raw *http.Response (so callers can
-// assert on status codes). The response body is closed before returning.
-func doJSON(t *testing.T, client *http.Client, method, url, token string, body interface{}) (map[string]interface{}, *http.Response) {
-	t.Helper()
-
-	var bodyReader io.Reader
-	if body != nil {
-		jsonBody, err := json.Marshal(body)
-		require.NoError(t, err)
-		bodyReader = bytes.NewReader(jsonBody)
-	}
-
-	req, err := http.NewRequest(method, url, bodyReader)
-	require.NoError(t, err)
-	req.Header.Set("Authorization", "Bearer "+token)
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
-	}
-
-	resp, err := client.Do(req)
-	require.NoError(t, err)
-	defer resp.Body.Close()
-
-	respBody, err := io.ReadAll(resp.Body)
-	require.NoError(t, err)
-
-	var result map[string]interface{}
-	if len(respBody) > 0 {
-		err = json.Unmarshal(respBody, &result)
-		if err != nil {
-			// Not all responses are JSON objects (could be arrays, etc.).
-			// Store raw body for debugging.
-			t.Logf("response body (non-JSON-object): %s", string(respBody))
-		}
-	}
-	return result, resp
-}
-
-// postJSON is a convenience wrapper around http.Post with JSON content.
-// It does NOT include an auth token (legacy helper kept for health check).
-func postJSON(t *testing.T, path string, body interface{}) *http.Response {
+// newClient returns a gateway client authenticated with a fresh test token.
+func newClient(t *testing.T) *client.Client {
 	t.Helper()
-	jsonBody, err := json.Marshal(body)
-	require.NoError(t, err)
-
-	resp, err := http.Post(gatewayURL()+path, "application/json", bytes.NewBuffer(jsonBody))
-	require.NoError(t, err)
-	return resp
-}
 
-// getJSON is a convenience wrapper around http.Get (no auth token).
-func getJSON(t *testing.T, path string) *http.Response {
-	t.Helper()
-	resp, err := http.Get(gatewayURL() + path)
+	c, err := client.New(gatewayURL(),
+		client.WithToken(getTestToken(t)),
+		client.WithHTTPClient(&http.Client{Timeout: 15 * time.Second}),
+	)
 	require.NoError(t, err)
-	return resp
+	return c
 }
 
 // ---------------------------------------------------------------------------
@@ -184,53 +135,43 @@ func TestHealthCheck(t *testing.T) {
 // ---------------------------------------------------------------------------
 
 func TestOnboardingFlow(t *testing.T) {
-	client := newClient()
-	token := getTestToken(t)
-	base := gatewayURL()
+	c := newClient(t)
+	ctx := context.Background()
 
 	// Step 1: Initiate identity verification.
-	verificationReq := map[string]interface{}{
-		"tenant_id":     testTenantID.String(),
-		"first_name":    "John",
-		"last_name":     "Doe",
-		"email":         "john.doe@example.com",
-		"date_of_birth": "1990-01-15",
-		"country":       "US",
-	}
-	result, resp := doJSON(t, client, "POST", base+"/api/v1/identity/verifications", token, verificationReq)
-	require.Equal(t, http.StatusCreated, resp.StatusCode, "initiate verification failed: %v", result)
-
-	verification, ok := result["verification"].(map[string]interface{})
-	require.True(t, ok, "response missing 'verification' object")
-	verificationID, ok := verification["id"].(string)
-	require.True(t, ok && verificationID != "", "verification id is missing or empty")
-	assert.Equal(t, "John", verification["applicant_first_name"])
-	assert.Equal(t, "Doe", verification["applicant_last_name"])
-	assert.NotEmpty(t, verification["status"])
+	verification, err := c.Identity.InitiateVerification(ctx, &client.InitiateVerificationRequest{
+		TenantID:    testTenantID.String(),
+		FirstName:   "John",
+		LastName:    "Doe",
+		Email:       "john.doe@example.com",
+		DateOfBirth: "1990-01-15",
+		Country:     "US",
+	})
+	require.NoError(t, err, "initiate verification failed")
+	require.NotEmpty(t, verification.ID, "verification id is missing or empty")
+	assert.Equal(t, "John", verification.ApplicantFirstName)
+	assert.Equal(t, "Doe", verification.ApplicantLastName)
+	assert.NotEmpty(t, verification.Status)
 
 	// Step 2: Get verification by ID.
-	result, resp = doJSON(t, client, "GET", base+"/api/v1/identity/verifications/"+verificationID, token, nil)
-	require.Equal(t, http.StatusOK, resp.StatusCode, "get verification failed: %v", result)
-	verification2 := result["verification"].(map[string]interface{})
-	assert.Equal(t, verificationID, verification2["id"])
+	verification2, err := c.Identity.GetVerification(ctx, verification.ID)
+	require.NoError(t, err, "get verification failed")
+	assert.Equal(t, verification.ID, verification2.ID)
 
 	// Step 3: Open account.
-	accountReq := map[string]interface{}{
-		"tenant_id":                testTenantID.String(),
-		"account_type":             "CHECKING",
-		"currency":                 "USD",
-		"holder_first_name":        "John",
-		"holder_last_name":         "Doe",
-		"holder_email":             "john.doe@example.com",
-		"identity_verification_id": verificationID,
-	}
-	result, resp = doJSON(t, client, "POST", base+"/api/v1/accounts", token, accountReq)
-	require.Equal(t, http.StatusCreated, resp.StatusCode, "open account failed: %v", result)
-
-	accountID, ok := result["account_id"].(string)
-	require.True(t, ok && accountID != "", "account_id is missing or empty")
-	assert.NotEmpty(t, result["account_number"])
-	assert.Equal(t, "ACTIVE", result["status"])
+	account, err := c.Accounts.Open(ctx, &client.OpenAccountRequest{
+		TenantID:               testTenantID.String(),
+		AccountType:            "CHECKING",
+		Currency:               "USD",
+		HolderFirstName:        "John",
+		HolderLastName:         "Doe",
+		HolderEmail:            "john.doe@example.com",
+		IdentityVerificationID: verification.ID,
+	})
+	require.NoError(t, err, "open account failed")
+	require.NotEmpty(t, account.AccountID, "account_id is missing or empty")
+	assert.NotEmpty(t, account.AccountNumber)
+	assert.Equal(t, "ACTIVE", account.Status)
 }
 
 // ---------------------------------------------------------------------------
@@ -238,42 +179,35 @@ func TestOnboardingFlow(t *testing.T) {
 // ---------------------------------------------------------------------------
 
 func TestPaymentFlow(t *testing.T) {
-	client := newClient()
-	token := getTestToken(t)
-	base := gatewayURL()
+	c := newClient(t)
+	ctx := context.Background()
 
 	// Step 1: Initiate payment.
-	paymentReq := map[string]interface{}{
-		"tenant_id":               testTenantID.String(),
-		"source_account_id":       uuid.New().String(),
-		"amount":                  "100.00",
-		"currency":                "USD",
-		"routing_number":          "021000021",
-		"external_account_number": "123456789",
-		"description":             "E2E test payment",
-	}
-	result, resp := doJSON(t, client, "POST", base+"/api/v1/payments", token, paymentReq)
-	require.Equal(t, http.StatusCreated, resp.StatusCode, "initiate payment failed: %v", result)
-
-	paymentID, ok := result["id"].(string)
-	require.True(t, ok && paymentID != "", "payment id is missing or empty")
-	assert.NotEmpty(t, result["status"])
-	assert.NotEmpty(t, result["rail"])
+	initiated, err := c.Payments.Initiate(ctx, &client.InitiatePaymentRequest{
+		TenantID:              testTenantID.String(),
+		SourceAccountID:       uuid.New().String(),
+		Amount:                "100.00",
+		Currency:              "USD",
+		RoutingNumber:         "021000021",
+		ExternalAccountNumber: "123456789",
+		Description:           "E2E test payment",
+	})
+	require.NoError(t, err, "initiate payment failed")
+	require.NotEmpty(t, initiated.ID, "payment id is missing or empty")
+	assert.NotEmpty(t, initiated.Status)
+	assert.NotEmpty(t, initiated.Rail)
 
 	// Step 2: Get payment by ID.
-	result, resp = doJSON(t, client, "GET", base+"/api/v1/payments/"+paymentID, token, nil)
-	require.Equal(t, http.StatusOK, resp.StatusCode, "get payment failed: %v", result)
-
-	payment, ok := result["payment"].(map[string]interface{})
-	require.True(t, ok, "response missing 'payment' object")
-	assert.Equal(t, paymentID, payment["id"])
-	assert.Equal(t, "100.00", payment["amount"])
-	assert.Equal(t, "USD", payment["currency"])
+	payment, err := c.Payments.Get(ctx, initiated.ID)
+	require.NoError(t, err, "get payment failed")
+	assert.Equal(t, initiated.ID, payment.ID)
+	assert.Equal(t, "100.00", payment.Amount)
+	assert.Equal(t, "USD", payment.Currency)
 
 	// Step 3: List payments for tenant.
-	result, resp = doJSON(t, client, "GET", base+"/api/v1/payments", token, nil)
-	require.Equal(t, http.StatusOK, resp.StatusCode, "list payments failed: %v", result)
-	assert.NotNil(t, result["payments"], "payments array should be present")
+	payments, err := c.Payments.List(ctx, client.ListPaymentsParams{})
+	require.NoError(t, err, "list payments failed")
+	assert.NotNil(t, payments.Payments, "payments array should be present")
 }
 
 // ---------------------------------------------------------------------------
@@ -281,52 +215,43 @@ func TestPaymentFlow(t *testing.T) {
 // ---------------------------------------------------------------------------
 
 func TestAccountLifecycle(t *testing.T) {
-	client := newClient()
-	token := getTestToken(t)
-	base := gatewayURL()
+	c := newClient(t)
+	ctx := context.Background()
 
 	// 1. Open account.
-	openReq := map[string]interface{}{
-		"tenant_id":        testTenantID.String(),
-		"account_type":     "CHECKING",
-		"currency":         "USD",
-		"holder_first_name": "Alice",
-		"holder_last_name":  "Smith",
-		"holder_email":      "alice.smith@example.com",
-	}
-	result, resp := doJSON(t, client, "POST", base+"/api/v1/accounts", token, openReq)
-	require.Equal(t, http.StatusCreated, resp.StatusCode, "open account failed: %v", result)
-
-	accountID, ok := result["account_id"].(string)
-	require.True(t, ok && accountID != "", "account_id missing")
-	assert.NotEmpty(t, result["account_number"], "account_number should be set")
-	assert.Equal(t, "ACTIVE", result["status"])
+	opened, err := c.Accounts.Open(ctx, &client.OpenAccountRequest{
+		TenantID:        testTenantID.String(),
+		AccountType:     "CHECKING",
+		Currency:        "USD",
+		HolderFirstName: "Alice",
+		HolderLastName:  "Smith",
+		HolderEmail:     "alice.smith@example.com",
+	})
+	require.NoError(t, err, "open account failed")
+	accountID := opened.AccountID
+	require.NotEmpty(t, accountID, "account_id missing")
+	assert.NotEmpty(t, opened.AccountNumber, "account_number should be set")
+	assert.Equal(t, "ACTIVE", opened.Status)
 
 	// 2. Get account.
-	result, resp = doJSON(t, client, "GET", base+"/api/v1/accounts/"+accountID, token, nil)
-	require.Equal(t, http.StatusOK, resp.StatusCode, "get account failed: %v", result)
-	assert.Equal(t, accountID, result["account_id"])
-	assert.Equal(t, "CHECKING", result["account_type"])
-	assert.Equal(t, "USD", result["currency"])
-	assert.Equal(t, "Alice", result["holder_first_name"])
+	account, err := c.Accounts.Get(ctx, accountID)
+	require.NoError(t, err, "get account failed")
+	assert.Equal(t, accountID, account.AccountID)
+	assert.Equal(t, "CHECKING", account.AccountType)
+	assert.Equal(t, "USD", account.Currency)
+	assert.Equal(t, "Alice", account.HolderFirstName)
 
 	// 3. Freeze account.
-	freezeReq := map[string]interface{}{
-		"reason": "Suspicious activity detected",
-	}
-	result, resp = doJSON(t, client, "POST", base+"/api/v1/accounts/"+accountID+"/freeze", token, freezeReq)
-	require.Equal(t, http.StatusOK, resp.StatusCode, "freeze account failed: %v", result)
-	assert.Equal(t, "FROZEN", result["status"])
-	assert.Equal(t, accountID, result["account_id"])
+	account, err = c.Accounts.Freeze(ctx, accountID, "Suspicious activity detected")
+	require.NoError(t, err, "freeze account failed")
+	assert.Equal(t, "FROZEN", account.Status)
+	assert.Equal(t, accountID, account.AccountID)
 
 	// 4. Close account.
-	closeReq := map[string]interface{}{
-		"reason": "Customer requested closure",
-	}
-	result, resp = doJSON(t, client, "POST", base+"/api/v1/accounts/"+accountID+"/close", token, closeReq)
-	require.Equal(t, http.StatusOK, resp.StatusCode, "close account failed: %v", result)
-	assert.Equal(t, "CLOSED", result["status"])
-	assert.Equal(t, accountID, result["account_id"])
+	account, err = c.Accounts.Close(ctx, accountID, "Customer requested closure")
+	require.NoError(t, err, "close account failed")
+	assert.Equal(t, "CLOSED", account.Status)
+	assert.Equal(t, accountID, account.AccountID)
 }
 
 // ---------------------------------------------------------------------------
@@ -334,57 +259,43 @@ func TestAccountLifecycle(t *testing.T) {
 // ---------------------------------------------------------------------------
 
 func TestDepositFlow(t *testing.T) {
-	client := newClient()
-	token := getTestToken(t)
-	base := gatewayURL()
+	c := newClient(t)
+	ctx := context.Background()
 
 	// 1. Create deposit product.
-	productReq := map[string]interface{}{
-		"tenant_id": testTenantID.String(),
-		"name":      "12-Month Fixed Deposit",
-		"currency":  "USD",
-		"tiers": []map[string]interface{}{
-			{"min_balance": "1000.00", "max_balance": "50000.00", "rate_bps": 450},
-			{"min_balance": "50000.01", "max_balance": "1000000.00", "rate_bps": 500},
+	product, err := c.Deposits.CreateProduct(ctx, &client.CreateProductRequest{
+		TenantID: testTenantID.String(),
+		Name:     "12-Month Fixed Deposit",
+		Currency: "USD",
+		Tiers: []client.InterestTier{
+			{MinBalance: "1000.00", MaxBalance: "50000.00", RateBps: 450},
+			{MinBalance: "50000.01", MaxBalance: "1000000.00", RateBps: 500},
 		},
-		"term_days": 365,
-	}
-	result, resp := doJSON(t, client, "POST", base+"/api/v1/deposits/products", token, productReq)
-	require.Equal(t, http.StatusCreated, resp.StatusCode, "create deposit product failed: %v", result)
-
-	product, ok := result["product"].(map[string]interface{})
-	require.True(t, ok, "response missing 'product' object")
-	productID, ok := product["id"].(string)
-	require.True(t, ok && productID != "", "product id missing")
-	assert.Equal(t, "12-Month Fixed Deposit", product["name"])
-	assert.Equal(t, "USD", product["currency"])
-	assert.Equal(t, true, product["is_active"])
+		TermDays: 365,
+	})
+	require.NoError(t, err, "create deposit product failed")
+	require.NotEmpty(t, product.ID, "product id missing")
+	assert.Equal(t, "12-Month Fixed Deposit", product.Name)
+	assert.Equal(t, "USD", product.Currency)
+	assert.True(t, product.IsActive)
 
 	// 2. Open deposit position.
-	positionReq := map[string]interface{}{
-		"tenant_id":  testTenantID.String(),
-		"account_id": uuid.New().String(),
-		"product_id": productID,
-		"principal":  "10000.00",
-	}
-	result, resp = doJSON(t, client, "POST", base+"/api/v1/deposits/positions", token, positionReq)
-	require.Equal(t, http.StatusCreated, resp.StatusCode, "open deposit position failed: %v", result)
-
-	position, ok := result["position"].(map[string]interface{})
-	require.True(t, ok, "response missing 'position' object")
-	positionID, ok := position["id"].(string)
-	require.True(t, ok && positionID != "", "position id missing")
-	assert.Equal(t, "10000.00", position["principal"])
-	assert.NotEmpty(t, position["status"])
+	position, err := c.Deposits.OpenPosition(ctx, &client.OpenPositionRequest{
+		TenantID:  testTenantID.String(),
+		AccountID: uuid.New().String(),
+		ProductID: product.ID,
+		Principal: "10000.00",
+	})
+	require.NoError(t, err, "open deposit position failed")
+	require.NotEmpty(t, position.ID, "position id missing")
+	assert.Equal(t, "10000.00", position.Principal)
+	assert.NotEmpty(t, position.Status)
 
 	// 3. Get deposit position.
-	result, resp = doJSON(t, client, "GET", base+"/api/v1/deposits/positions/"+positionID, token, nil)
-	require.Equal(t, http.StatusOK, resp.StatusCode, "get deposit position failed: %v", result)
-
-	position2, ok := result["position"].(map[string]interface{})
-	require.True(t, ok, "response missing 'position' object")
-	assert.Equal(t, positionID, position2["id"])
-	assert.Equal(t, productID, position2["product_id"])
+	position2, err := c.Deposits.GetPosition(ctx, position.ID)
+	require.NoError(t, err, "get deposit position failed")
+	assert.Equal(t, position.ID, position2.ID)
+	assert.Equal(t, product.ID, position2.ProductID)
 }
 
 // ---------------------------------------------------------------------------
@@ -392,32 +303,49 @@ func TestDepositFlow(t *testing.T) {
 // ---------------------------------------------------------------------------
 
 func TestLendingFlow(t *testing.T) {
-	client := newClient()
-	token := getTestToken(t)
-	base := gatewayURL()
-
-	// 1. Submit loan application.
-	applicationReq := map[string]interface{}{
-		"tenant_id":        testTenantID.String(),
-		"applicant_id":     uuid.New().String(),
-		"requested_amount": "25000.00",
-		"currency":         "USD",
-		"term_months":      36,
-		"purpose":          "Home renovation",
+	c := newClient(t)
+	ctx := context.Background()
+
+	// 1. The applicant authorizes the credit pull, citing the disclosure
+	// in effect, which an earlier run may already have published.
+	applicantID := uuid.New().String()
+	_, err := c.Consent.PublishDisclosure(ctx, &client.PublishDisclosureRequest{
+		Type:        "CREDIT_PULL",
+		Version:     "e2e-1",
+		ContentHash: fmt.Sprintf("%x", sha256.Sum256([]byte("e2e credit pull authorization"))),
+	})
+	var apiErr *client.APIError
+	if err != nil && !(errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict) {
+		require.NoError(t, err, "publish credit pull disclosure failed")
 	}
-	result, resp := doJSON(t, client, "POST", base+"/api/v1/loans/applications", token, applicationReq)
-	require.Equal(t, http.StatusCreated, resp.StatusCode, "submit loan application failed: %v", result)
-
-	applicationID, ok := result["application_id"].(string)
-	require.True(t, ok && applicationID != "", "application_id missing")
-	assert.NotEmpty(t, result["status"])
-	assert.NotEmpty(t, result["created_at"])
-
-	// 2. Get loan application status.
-	result, resp = doJSON(t, client, "GET", base+"/api/v1/loans/applications/"+applicationID, token, nil)
-	require.Equal(t, http.StatusOK, resp.StatusCode, "get loan application failed: %v", result)
-	assert.Equal(t, applicationID, result["application_id"])
-	assert.NotEmpty(t, result["status"])
+	receipt, err := c.Consent.RecordConsent(ctx, &client.RecordConsentRequest{
+		CustomerID: applicantID,
+		Type:       "CREDIT_PULL",
+		Action:     "GRANTED",
+		Channel:    "ONLINE",
+	})
+	require.NoError(t, err, "record credit pull consent failed")
+	assert.NotEmpty(t, receipt.Hash)
+
+	// 2. Submit loan application.
+	application, err := c.Lending.SubmitApplication(ctx, &client.SubmitApplicationRequest{
+		TenantID:        testTenantID.String(),
+		ApplicantID:     applicantID,
+		RequestedAmount: "25000.00",
+		Currency:        "USD",
+		TermMonths:      36,
+		Purpose:         "Home renovation",
+	})
+	require.NoError(t, err, "submit loan application failed")
+	require.NotEmpty(t, application.ApplicationID, "application_id missing")
+	assert.NotEmpty(t, application.Status)
+	assert.NotEmpty(t, application.CreatedAt)
+
+	// 3. Get loan application status.
+	application2, err := c.Lending.GetApplication(ctx, application.ApplicationID)
+	require.NoError(t, err, "get loan application failed")
+	assert.Equal(t, application.ApplicationID, application2.ApplicationID)
+	assert.NotEmpty(t, application2.Status)
 }
 
 // ---------------------------------------------------------------------------
@@ -425,35 +353,31 @@ func TestLendingFlow(t *testing.T) {
 // ---------------------------------------------------------------------------
 
 func TestCardIssuance(t *testing.T) {
-	client := newClient()
-	token := getTestToken(t)
-	base := gatewayURL()
+	c := newClient(t)
+	ctx := context.Background()
 
 	// 1. Issue virtual card.
-	issueReq := map[string]interface{}{
-		"tenant_id":     testTenantID.String(),
-		"account_id":    uuid.New().String(),
-		"card_type":     "VIRTUAL",
-		"currency":      "USD",
-		"daily_limit":   "5000.00",
-		"monthly_limit": "25000.00",
-	}
-	result, resp := doJSON(t, client, "POST", base+"/api/v1/cards", token, issueReq)
-	require.Equal(t, http.StatusCreated, resp.StatusCode, "issue card failed: %v", result)
-
-	cardID, ok := result["card_id"].(string)
-	require.True(t, ok && cardID != "", "card_id missing")
-	assert.NotEmpty(t, result["status"])
+	issued, err := c.Cards.Issue(ctx, &client.IssueCardRequest{
+		TenantID:     testTenantID.String(),
+		AccountID:    uuid.New().String(),
+		CardType:     "VIRTUAL",
+		Currency:     "USD",
+		DailyLimit:   "5000.00",
+		MonthlyLimit: "25000.00",
+	})
+	require.NoError(t, err, "issue card failed")
+	require.NotEmpty(t, issued.CardID, "card_id missing")
+	assert.NotEmpty(t, issued.Status)
 
 	// 2. Get card details.
-	result, resp = doJSON(t, client, "GET", base+"/api/v1/cards/"+cardID, token, nil)
-	require.Equal(t, http.StatusOK, resp.StatusCode, "get card failed: %v", result)
-	assert.Equal(t, cardID, result["card_id"])
-	assert.Equal(t, "VIRTUAL", result["card_type"])
-	assert.Equal(t, "USD", result["currency"])
-	assert.NotEmpty(t, result["masked_pan"], "masked_pan should be present")
-	assert.Equal(t, "5000.00", result["daily_limit"])
-	assert.Equal(t, "25000.00", result["monthly_limit"])
+	card, err := c.Cards.Get(ctx, issued.CardID)
+	require.NoError(t, err, "get card failed")
+	assert.Equal(t, issued.CardID, card.CardID)
+	assert.Equal(t, "VIRTUAL", card.CardType)
+	assert.Equal(t, "USD", card.Currency)
+	assert.NotEmpty(t, card.MaskedPAN, "masked_pan should be present")
+	assert.Equal(t, "5000.00", card.DailyLimit)
+	assert.Equal(t, "25000.00", card.MonthlyLimit)
 }
 
 // ---------------------------------------------------------------------------
@@ -461,32 +385,30 @@ func TestCardIssuance(t *testing.T) {
 // ---------------------------------------------------------------------------
 
 func TestFXRateQuery(t *testing.T) {
-	client := newClient()
-	token := getTestToken(t)
-	base := gatewayURL()
+	c := newClient(t)
+	ctx := context.Background()
 
 	// 1. Get exchange rate for USD/EUR.
-	result, resp := doJSON(t, client, "GET", base+"/api/v1/fx/rates/USD-EUR", token, nil)
-	require.Equal(t, http.StatusOK, resp.StatusCode, "get fx rate failed: %v", result)
-	assert.Equal(t, "USD", result["base_currency"])
-	assert.Equal(t, "EUR", result["quote_currency"])
-	assert.NotEmpty(t, result["rate"], "rate should be present")
-	assert.NotEmpty(t, result["timestamp"], "timestamp should be present")
+	rate, err := c.FX.GetRate(ctx, "USD", "EUR")
+	require.NoError(t, err, "get fx rate failed")
+	assert.Equal(t, "USD", rate.BaseCurrency)
+	assert.Equal(t, "EUR", rate.QuoteCurrency)
+	assert.NotEmpty(t, rate.Rate, "rate should be present")
+	assert.NotEmpty(t, rate.Timestamp, "timestamp should be present")
 
 	// 2. Convert amount.
-	convertReq := map[string]interface{}{
-		"tenant_id":     testTenantID.String(),
-		"from_currency": "USD",
-		"to_currency":   "EUR",
-		"amount":        "1000.00",
-	}
-	result, resp = doJSON(t, client, "POST", base+"/api/v1/fx/convert", token, convertReq)
-	require.Equal(t, http.StatusOK, resp.StatusCode, "fx convert failed: %v", result)
-	assert.Equal(t, "1000.00", result["original_amount"])
-	assert.Equal(t, "USD", result["from_currency"])
-	assert.Equal(t, "EUR", result["to_currency"])
-	assert.NotEmpty(t, result["converted_amount"], "converted_amount should be present")
-	assert.NotEmpty(t, result["rate"], "rate should be present")
+	conversion, err := c.FX.Convert(ctx, &client.ConvertRequest{
+		TenantID:     testTenantID.String(),
+		FromCurrency: "USD",
+		ToCurrency:   "EUR",
+		Amount:       "1000.00",
+	})
+	require.NoError(t, err, "fx convert failed")
+	assert.Equal(t, "1000.00", conversion.OriginalAmount)
+	assert.Equal(t, "USD", conversion.FromCurrency)
+	assert.Equal(t, "EUR", conversion.ToCurrency)
+	assert.NotEmpty(t, conversion.ConvertedAmount, "converted_amount should be present")
+	assert.NotEmpty(t, conversion.Rate, "rate should be present")
 }
 
 // ---------------------------------------------------------------------------
@@ -494,44 +416,37 @@ func TestFXRateQuery(t *testing.T) {
 // ---------------------------------------------------------------------------
 
 func TestFraudAssessment(t *testing.T) {
-	client := newClient()
-	token := getTestToken(t)
-	base := gatewayURL()
+	c := newClient(t)
+	ctx := context.Background()
 
 	// 1. Submit transaction for fraud assessment.
-	assessReq := map[string]interface{}{
-		"tenant_id":        testTenantID.String(),
-		"transaction_id":   uuid.New().String(),
-		"account_id":       uuid.New().String(),
-		"amount":           "5000.00",
-		"currency":         "USD",
-		"transaction_type": "WIRE_TRANSFER",
-		"metadata": map[string]string{
+	result, err := c.Fraud.Assess(ctx, &client.AssessTransactionRequest{
+		TenantID:        testTenantID.String(),
+		TransactionID:   uuid.New().String(),
+		AccountID:       uuid.New().String(),
+		Amount:          "5000.00",
+		Currency:        "USD",
+		TransactionType: "WIRE_TRANSFER",
+		Metadata: map[string]string{
 			"ip_address":          "192.168.1.100",
 			"destination_country": "US",
 		},
-	}
-	result, resp := doJSON(t, client, "POST", base+"/api/v1/fraud/assessments", token, assessReq)
-	require.Equal(t, http.StatusCreated, resp.StatusCode, "fraud assessment failed: %v", result)
-
-	assessmentID, ok := result["assessment_id"].(string)
-	require.True(t, ok && assessmentID != "", "assessment_id missing")
-	assert.NotNil(t, result["risk_score"], "risk_score should be present")
-	assert.NotEmpty(t, result["risk_level"], "risk_level should be present")
-	assert.NotEmpty(t, result["decision"], "decision should be present")
+	})
+	require.NoError(t, err, "fraud assessment failed")
+	require.NotEmpty(t, result.AssessmentID, "assessment_id missing")
+	assert.NotEmpty(t, result.RiskLevel, "risk_level should be present")
+	assert.NotEmpty(t, result.Decision, "decision should be present")
 
-	// Validate risk_score is a number between 0 and 100.
-	riskScore, ok := result["risk_score"].(float64)
-	require.True(t, ok, "risk_score should be a number")
-	assert.GreaterOrEqual(t, riskScore, float64(0))
-	assert.LessOrEqual(t, riskScore, float64(100))
+	// Validate risk_score is between 0 and 100.
+	assert.GreaterOrEqual(t, result.RiskScore, 0)
+	assert.LessOrEqual(t, result.RiskScore, 100)
 
 	// 2. Get fraud assessment by ID.
-	result, resp = doJSON(t, client, "GET", base+"/api/v1/fraud/assessments/"+assessmentID, token, nil)
-	require.Equal(t, http.StatusOK, resp.StatusCode, "get fraud assessment failed: %v", result)
-	assert.Equal(t, assessmentID, result["assessment_id"])
-	assert.Equal(t, "5000.00", result["amount"])
-	assert.Equal(t, "USD", result["currency"])
-	assert.Equal(t, "WIRE_TRANSFER", result["transaction_type"])
-	assert.NotEmpty(t, result["decision"])
+	assessment, err := c.Fraud.GetAssessment(ctx, result.AssessmentID)
+	require.NoError(t, err, "get fraud assessment failed")
+	assert.Equal(t, result.AssessmentID, assessment.AssessmentID)
+	assert.Equal(t, "5000.00", assessment.Amount)
+	assert.Equal(t, "USD", assessment.Currency)
+	assert.Equal(t, "WIRE_TRANSFER", assessment.TransactionType)
+	assert.NotEmpty(t, assessment.Decision)
 }
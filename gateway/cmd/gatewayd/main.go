@@ -7,17 +7,33 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
 	"syscall"
 	"time"
 
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/gateway/internal/apikey"
 	"github.com/bibbank/bib/gateway/internal/config"
 	"github.com/bibbank/bib/gateway/internal/handler"
+	"github.com/bibbank/bib/gateway/internal/idempotency"
 	"github.com/bibbank/bib/gateway/internal/middleware"
+	"github.com/bibbank/bib/gateway/internal/openapi"
 	"github.com/bibbank/bib/gateway/internal/proxy"
+	"github.com/bibbank/bib/gateway/internal/security"
+	"github.com/bibbank/bib/gateway/internal/tokenstore"
+	"github.com/bibbank/bib/pkg/archive"
 	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/capture"
+	pkgkafka "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
 	"github.com/bibbank/bib/pkg/observability"
+	pkgpostgres "github.com/bibbank/bib/pkg/postgres"
 )
 
+// gatewayIssuer is the issuer of the tokens the gateway signs.
+const gatewayIssuer = "bib-gateway"
+
 func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
@@ -30,13 +46,22 @@ func main() {
 		Format: cfg.LogFormat,
 	})
 	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
 
 	logger.Info("starting gateway", "port", cfg.HTTPPort)
 
+	// Refresh tokens and the revocation list of access tokens.
+	tokenStore, err := newTokenStore(ctx, cfg.Tokens, lc, logger)
+	if err != nil {
+		logger.Error("failed to initialize token store", "error", err)
+		os.Exit(1)
+	}
+
 	// JWT service for token signing (gateway is the issuer).
 	jwtCfg := auth.JWTConfig{
-		Issuer:     "bib-gateway",
-		Expiration: 24 * time.Hour,
+		Issuer:      gatewayIssuer,
+		Expiration:  cfg.Tokens.AccessTTL,
+		Revocations: tokenStore,
 	}
 
 	// Prefer RSA private key; fall back to HMAC secret for backwards compat.
@@ -53,6 +78,21 @@ func main() {
 	default:
 		jwtCfg.Secret = cfg.JWTSecret
 	}
+	// During a key rotation the previous key's tokens stay valid until they
+	// expire.
+	if jwtCfg.PrivateKeyPEM != "" {
+		switch {
+		case cfg.JWTPublicKey != "":
+			jwtCfg.PublicKeyPEM = cfg.JWTPublicKey
+		case cfg.JWTPublicKeyFile != "":
+			keyData, err := auth.LoadKeyFromFile(cfg.JWTPublicKeyFile)
+			if err != nil {
+				logger.Error("failed to load JWT public key file", "error", err)
+				os.Exit(1)
+			}
+			jwtCfg.PublicKeyPEM = string(keyData)
+		}
+	}
 
 	jwtService, err := auth.NewJWTService(jwtCfg)
 	if err != nil {
@@ -60,30 +100,163 @@ func main() {
 		os.Exit(1)
 	}
 
+	// In an active-active deployment, route calls across regions.
+	var router *proxy.Router
+	if cfg.Regions.Enabled() {
+		router, err = newRegionRouter(cfg.Regions)
+		if err != nil {
+			logger.Error("failed to configure region routing", "error", err)
+			os.Exit(1)
+		}
+		logger.Info("routing across regions", "region", cfg.Regions.Local, "peers", len(cfg.Regions.PeerSuffixes))
+	}
+
+	// Sandbox tenants' calls go to the sandbox deployment of each service,
+	// where external adapters are simulated.
+	var sandbox *proxy.Sandbox
+	if cfg.Sandbox.Enabled() {
+		sandbox, err = newSandbox(cfg.Sandbox)
+		if err != nil {
+			logger.Error("failed to configure sandbox", "error", err)
+			os.Exit(1)
+		}
+		logger.Info("routing sandbox tenants to sandbox deployments", "tenants", len(cfg.Sandbox.Tenants))
+	}
+
 	// Connect to backend gRPC services.
-	proxies, closers, err := dialBackends(cfg, logger)
+	proxies, closers, err := dialBackends(cfg, router, sandbox, logger)
 	if err != nil {
 		logger.Error("failed to connect to backend services", "error", err)
 		// Continue anyway -- connections are lazy and will retry.
 	}
-	defer func() {
-		for _, c := range closers {
-			c.Close()
+	// Sessions are started with login tokens from a trusted issuer, never
+	// with tokens the gateway issued itself.
+	var loginRealm *auth.JWTService
+	if cfg.Tokens.Login.Enabled() {
+		loginRealm, err = newLoginRealm(cfg.Tokens.Login, tokenStore)
+		if err != nil {
+			logger.Error("failed to initialize login realm", "error", err)
+			os.Exit(1)
 		}
-	}()
+	} else {
+		logger.Warn("login issuer key not set, /api/v1/auth/token disabled")
+	}
+	sessions := auth.NewSessions(jwtService, tokenStore, cfg.Tokens.RefreshTTL)
+	proxies.Auth = proxy.NewAuthProxy(jwtService, sessions, loginRealm, cfg.Tokens.Login.MaxAge, logger)
+
+	// API keys, with which machine clients call instead of with a JWT.
+	apiKeyStore, err := newAPIKeyStore(ctx, cfg.APIKeys, lc, logger)
+	if err != nil {
+		logger.Error("failed to initialize api key store", "error", err)
+		os.Exit(1)
+	}
+	apiKeys := apikey.NewManager(apiKeyStore, cfg.APIKeys.RotationGrace)
+	proxies.APIKeys = proxy.NewAPIKeyProxy(apiKeys, logger)
+	lc.Go(lifecycle.PhaseWorkers, "token purge", func(ctx context.Context) error {
+		purgeTokens(ctx, tokenStore, time.Hour, logger)
+		return nil
+	})
+	for _, c := range closers {
+		lc.OnStop(lifecycle.PhaseResources, c.Name+" client", lifecycle.Close(c.Close))
+	}
+	if router != nil {
+		lc.Go(lifecycle.PhaseWorkers, "region health", func(ctx context.Context) error {
+			router.Watch(ctx, closers, cfg.Regions.HealthInterval, logger)
+			return nil
+		})
+	}
 
 	// Per-client rate limiter.
 	rateLimiter := middleware.NewPerClientRateLimiter(cfg.RateLimit)
 
+	// Brute-force protection, reporting clients that fail authentication
+	// too often to the fraud service.
+	var securityPublisher security.Publisher = security.NoopPublisher{}
+	if len(cfg.KafkaBrokers) > 0 {
+		producer := pkgkafka.NewProducer(pkgkafka.Config{Brokers: cfg.KafkaBrokers})
+		lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(producer.Close))
+		securityPublisher = security.NewKafkaPublisher(producer)
+	} else {
+		logger.Warn("KAFKA_BROKERS not set, security events will not be published")
+	}
+	authGuard := middleware.NewAuthGuard(middleware.AuthGuardConfig{
+		Window:          cfg.AuthGuard.Window,
+		ThrottleAfter:   cfg.AuthGuard.ThrottleAfter,
+		StepUpAfter:     cfg.AuthGuard.StepUpAfter,
+		LockoutAfter:    cfg.AuthGuard.LockoutAfter,
+		LockoutDuration: cfg.AuthGuard.LockoutDuration,
+	}, securityPublisher, logger)
+
+	// Traffic capture, for reproducing production-only bugs elsewhere with
+	// bib-replay. It is opt-in, per tenant or route.
+	capturePolicy := capture.Policy{Tenants: cfg.Capture.Tenants, Routes: cfg.Capture.Routes}
+	var recorder *capture.Recorder
+	if capturePolicy.Enabled() {
+		if store := archive.NewStore(cfg.Capture.Store); store != nil {
+			recorder = capture.NewRecorder(store, capture.RecorderConfig{}, logger)
+			lc.Go(lifecycle.PhaseWorkers, "capture recorder", recorder.Run)
+			lc.OnStop(lifecycle.PhaseOutbox, "capture recorder", recorder.Flush)
+			logger.Warn("capturing traffic", "tenants", cfg.Capture.Tenants, "routes", cfg.Capture.Routes)
+		} else {
+			logger.Warn("CAPTURE_TENANTS or CAPTURE_ROUTES set without CAPTURE_S3_BUCKET or CAPTURE_DIR, not capturing traffic")
+		}
+	}
+
+	// Idempotency: retried POSTs carrying an Idempotency-Key replay the
+	// original response.
+	idempotencyStore, err := newIdempotencyStore(ctx, cfg.Idempotency, lc, logger)
+	if err != nil {
+		logger.Error("failed to initialize idempotency store", "error", err)
+		os.Exit(1)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "idempotency purge", func(ctx context.Context) error {
+		purgeIdempotencyKeys(ctx, idempotencyStore, time.Hour, logger)
+		return nil
+	})
+
 	// Routes.
 	mux := http.NewServeMux()
 	handler.RegisterRoutes(mux, proxies)
+	// Services configured with JWT_JWKS_URL fetch the signing keys here,
+	// picking up rotations without a restart.
+	mux.Handle("GET /.well-known/jwks.json", jwtService.JWKSHandler())
+	publicPaths := slices.Clone(handler.PublicPaths)
+	// The OpenAPI document and its Swagger UI are for admins unless
+	// DOCS_PUBLIC opens them to all; production turns them off.
+	if cfg.Docs.Enabled {
+		var spec, docs http.Handler = openapi.SpecHandler(), openapi.DocsHandler("/openapi.json")
+		if cfg.Docs.Public {
+			publicPaths = append(publicPaths, "/openapi.json", "/docs")
+		} else {
+			spec = middleware.RequireRoles(auth.RoleAdmin)(spec)
+			docs = middleware.RequireRoles(auth.RoleAdmin)(docs)
+		}
+		mux.Handle("GET /openapi.json", spec)
+		mux.Handle("GET /docs", docs)
+	}
 
 	// Build middleware chain (applied in reverse order).
 	var h http.Handler = mux
+	h = middleware.IdempotencyKeyMiddleware(h)
+	h = middleware.IdempotencyMiddleware(idempotencyStore, middleware.IdempotencyConfig{
+		Routes:       cfg.Idempotency.Routes,
+		TTL:          cfg.Idempotency.TTL,
+		LockTimeout:  cfg.Idempotency.LockTimeout,
+		MaxBodyBytes: int64(cfg.Idempotency.MaxBody),
+	}, logger)(h)
+	h = middleware.CaptureMiddleware(recorder, capturePolicy, capture.Sanitizer{MaxBody: cfg.Capture.MaxBody})(h)
 	h = middleware.LoggingMiddleware(logger)(h)
 	h = middleware.PerClientRateLimitMiddleware(rateLimiter)(h)
-	h = middleware.AuthMiddleware(jwtService, []string{"/healthz", "/readyz"})(h)
+	h = middleware.AuthMiddleware(jwtService, publicPaths)(h)
+	apiKeyAuth := middleware.NewAPIKeyAuthenticator(apiKeys, jwtService)
+	// Rotated and revoked keys lose their signed token and rate limiter at
+	// once; a key changed elsewhere is caught on its next call.
+	apiKeys.OnChange(func(id uuid.UUID) {
+		apiKeyAuth.Forget(id)
+		rateLimiter.ForgetAPIKey(id)
+	})
+	h = middleware.APIKeyMiddleware(apiKeyAuth, logger)(h)
+	h = middleware.AuthGuardMiddleware(authGuard)(h)
 
 	server := &http.Server{
 		Addr:              fmt.Sprintf(":%d", cfg.HTTPPort),
@@ -91,30 +264,246 @@ func main() {
 		ReadHeaderTimeout: 10 * time.Second,
 	}
 
-	errCh := make(chan error, 1)
-	go func() {
-		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			errCh <- err
+	lc.ServeHTTP("HTTP server", server)
+
+	// The operations console is served on its own listener, to operators
+	// authenticated in the backoffice realm: platform tokens, whether a
+	// customer's or an API client's, are not accepted there.
+	if cfg.Backoffice.Enabled() {
+		realm, err := newBackofficeRealm(cfg.Backoffice)
+		if err != nil {
+			logger.Error("failed to initialize backoffice realm", "error", err)
+			os.Exit(1)
 		}
-	}()
+		backofficeMux := http.NewServeMux()
+		handler.RegisterBackofficeRoutes(backofficeMux, proxies.Backoffice)
+
+		var bh http.Handler = backofficeMux
+		bh = middleware.IdempotencyKeyMiddleware(bh)
+		bh = middleware.LoggingMiddleware(logger)(bh)
+		bh = middleware.RequireRoles(auth.RoleAdmin, auth.RoleOperator, auth.RoleCompliance, auth.RoleAuditor)(bh)
+		bh = middleware.PerClientRateLimitMiddleware(rateLimiter)(bh)
+		bh = middleware.AuthMiddleware(realm, []string{"/healthz", "/readyz"})(bh)
+		bh = middleware.AuthGuardMiddleware(authGuard)(bh)
 
-	select {
-	case <-ctx.Done():
-		logger.Info("shutdown signal received")
-	case err := <-errCh:
-		logger.Error("server error", "error", err)
+		backofficeServer := &http.Server{
+			Addr:              fmt.Sprintf(":%d", cfg.Backoffice.HTTPPort),
+			Handler:           bh,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		lc.ServeHTTP("backoffice listener", backofficeServer)
+	} else {
+		logger.Warn("backoffice realm key not set, operations console listener disabled")
 	}
 
-	if err := server.Shutdown(context.Background()); err != nil {
-		logger.Error("shutdown error", "error", err)
+	// Wait for a shutdown signal or a listener failure, then drain
+	// in-flight requests.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
 	}
 	logger.Info("gateway stopped")
 }
 
-// dialBackends establishes gRPC connections to all backend services.
-// Returns the Proxies struct, a slice of connections to close on shutdown,
-// and an error if any connection fails (non-fatal, connections are lazy).
-func dialBackends(cfg config.Config, logger *slog.Logger) (*handler.Proxies, []*proxy.ServiceConn, error) {
+// newBackofficeRealm returns the validator of the backoffice realm's
+// operator tokens.
+func newBackofficeRealm(cfg config.BackofficeConfig) (*auth.JWTService, error) {
+	jwtCfg := auth.JWTConfig{Issuer: cfg.JWTIssuer}
+	switch {
+	case cfg.JWTPublicKey != "":
+		jwtCfg.PublicKeyPEM = cfg.JWTPublicKey
+	case cfg.JWTPublicKeyFile != "":
+		keyData, err := auth.LoadKeyFromFile(cfg.JWTPublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load backoffice JWT public key file: %w", err)
+		}
+		jwtCfg.PublicKeyPEM = string(keyData)
+	default:
+		jwtCfg.Secret = cfg.JWTSecret
+	}
+	return auth.NewJWTService(jwtCfg)
+}
+
+// newLoginRealm returns the validator of the login tokens that start
+// sessions, using revocations to refuse login tokens already used.
+func newLoginRealm(cfg config.LoginConfig, revocations auth.RevocationList) (*auth.JWTService, error) {
+	if cfg.JWTIssuer == "" || cfg.JWTIssuer == gatewayIssuer {
+		return nil, fmt.Errorf("login issuer must be set and differ from %q", gatewayIssuer)
+	}
+	jwtCfg := auth.JWTConfig{Issuer: cfg.JWTIssuer, JWKSURL: cfg.JWKSURL, Revocations: revocations}
+	switch {
+	case cfg.JWTPublicKey != "":
+		jwtCfg.PublicKeyPEM = cfg.JWTPublicKey
+	case cfg.JWTPublicKeyFile != "":
+		keyData, err := auth.LoadKeyFromFile(cfg.JWTPublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load login JWT public key file: %w", err)
+		}
+		jwtCfg.PublicKeyPEM = string(keyData)
+	}
+	return auth.NewJWTService(jwtCfg)
+}
+
+// newIdempotencyStore returns the store of responses to replay to retried
+// requests: Postgres when cfg configures a database, memory otherwise.
+func newIdempotencyStore(ctx context.Context, cfg config.IdempotencyConfig, lc *lifecycle.Manager, logger *slog.Logger) (idempotency.Store, error) {
+	if cfg.DB.Host == "" {
+		logger.Warn("IDEMPOTENCY_DB_HOST not set, idempotent responses are not shared between replicas")
+		return idempotency.NewMemoryStore(), nil
+	}
+	dbCfg := pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	}
+	dbCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	pool, err := pkgpostgres.NewPool(dbCtx, dbCfg)
+	if err != nil {
+		return nil, fmt.Errorf("connect to idempotency database: %w", err)
+	}
+	lc.OnStop(lifecycle.PhaseResources, "idempotency database pool", lifecycle.Func(pool.Close))
+	if err := pkgpostgres.RunMigrations(dbCfg.DSN(), "file://internal/idempotency/migrations"); err != nil {
+		logger.Warn("migration warning", "error", err)
+	}
+	return idempotency.NewPostgresStore(pool), nil
+}
+
+// purgeIdempotencyKeys deletes the store's expired keys every interval
+// until ctx is cancelled.
+func purgeIdempotencyKeys(ctx context.Context, store idempotency.Store, interval time.Duration, logger *slog.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := store.Purge(ctx)
+			if err != nil {
+				logger.Warn("failed to purge idempotency keys", "error", err)
+			} else if n > 0 {
+				logger.Debug("purged idempotency keys", "count", n)
+			}
+		}
+	}
+}
+
+// newTokenStore returns the store of refresh tokens and revoked access
+// tokens: Postgres when cfg configures a database, memory otherwise.
+func newTokenStore(ctx context.Context, cfg config.TokenConfig, lc *lifecycle.Manager, logger *slog.Logger) (tokenstore.Store, error) {
+	if cfg.DB.Host == "" {
+		logger.Warn("TOKEN_DB_HOST not set, refresh tokens and revocations are not shared between replicas or kept across restarts")
+		return auth.NewMemoryTokenStore(), nil
+	}
+	dbCfg := pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	}
+	dbCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	pool, err := pkgpostgres.NewPool(dbCtx, dbCfg)
+	if err != nil {
+		return nil, fmt.Errorf("connect to token database: %w", err)
+	}
+	lc.OnStop(lifecycle.PhaseResources, "token database pool", lifecycle.Func(pool.Close))
+	if err := pkgpostgres.RunMigrations(dbCfg.DSN(), "file://internal/tokenstore/migrations"); err != nil {
+		logger.Warn("migration warning", "error", err)
+	}
+	return tokenstore.NewPostgresStore(pool), nil
+}
+
+// newAPIKeyStore returns the store of API keys: Postgres when cfg
+// configures a database, memory otherwise.
+func newAPIKeyStore(ctx context.Context, cfg config.APIKeyConfig, lc *lifecycle.Manager, logger *slog.Logger) (apikey.Store, error) {
+	if cfg.DB.Host == "" {
+		logger.Warn("API_KEY_DB_HOST not set, api keys are not shared between replicas or kept across restarts")
+		return apikey.NewMemoryStore(), nil
+	}
+	dbCfg := pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	}
+	dbCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	pool, err := pkgpostgres.NewPool(dbCtx, dbCfg)
+	if err != nil {
+		return nil, fmt.Errorf("connect to api key database: %w", err)
+	}
+	lc.OnStop(lifecycle.PhaseResources, "api key database pool", lifecycle.Func(pool.Close))
+	if err := pkgpostgres.RunMigrations(dbCfg.DSN(), "file://internal/apikey/migrations"); err != nil {
+		logger.Warn("migration warning", "error", err)
+	}
+	return apikey.NewPostgresStore(pool), nil
+}
+
+// purgeTokens deletes the store's expired refresh tokens and revocations
+// every interval until ctx is cancelled.
+func purgeTokens(ctx context.Context, store tokenstore.Store, interval time.Duration, logger *slog.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := store.Purge(ctx)
+			if err != nil {
+				logger.Warn("failed to purge expired tokens", "error", err)
+			} else if n > 0 {
+				logger.Debug("purged expired tokens", "count", n)
+			}
+		}
+	}
+}
+
+// newRegionRouter returns the router of calls across cfg's regions.
+func newRegionRouter(cfg config.RegionConfig) (*proxy.Router, error) {
+	homes := make(map[uuid.UUID]string, len(cfg.TenantHomes))
+	for tenant, region := range cfg.TenantHomes {
+		id, err := uuid.Parse(tenant)
+		if err != nil {
+			return nil, fmt.Errorf("TENANT_REGIONS: invalid tenant ID %q: %w", tenant, err)
+		}
+		homes[id] = region
+	}
+	return proxy.NewRouter(proxy.RouterConfig{
+		Local:          cfg.Local,
+		TenantHomes:    homes,
+		ReplicationLag: cfg.ReplicationLag,
+	}), nil
+}
+
+// newSandbox returns the sandbox of cfg's tenants.
+func newSandbox(cfg config.SandboxConfig) (*proxy.Sandbox, error) {
+	tenants := make([]uuid.UUID, 0, len(cfg.Tenants))
+	for _, tenant := range cfg.Tenants {
+		id, err := uuid.Parse(tenant)
+		if err != nil {
+			return nil, fmt.Errorf("SANDBOX_TENANTS: invalid tenant ID %q: %w", tenant, err)
+		}
+		tenants = append(tenants, id)
+	}
+	return proxy.NewSandbox(tenants), nil
+}
+
+// dialBackends establishes gRPC connections to all backend services, in
+// every region when router is set, and to their sandbox deployments when
+// sandbox is set. Each connection follows its service's resilience policy
+// from cfg. Returns the Proxies struct, a slice of
+// connections to close on shutdown, and an error if any connection fails
+// (non-fatal, connections are lazy).
+func dialBackends(cfg config.Config, router *proxy.Router, sandbox *proxy.Sandbox, logger *slog.Logger) (*handler.Proxies, []*proxy.ServiceConn, error) {
 	type svcDef struct {
 		name string
 		addr string
@@ -131,6 +520,21 @@ func dialBackends(cfg config.Config, logger *slog.Logger) (*handler.Proxies, []*
 		{"fraud-service", cfg.FraudAddr},
 		{"card-service", cfg.CardAddr},
 		{"reporting-service", cfg.ReportingAddr},
+		{"notification-service", cfg.NotificationAddr},
+		{"customer-service", cfg.CustomerAddr},
+		{"tenant-service", cfg.TenantAddr},
+		{"scheduler-service", cfg.SchedulerAddr},
+		{"statement-service", cfg.StatementAddr},
+		{"document-service", cfg.DocumentAddr},
+		{"webhooks-service", cfg.WebhooksAddr},
+		{"treasury-service", cfg.TreasuryAddr},
+		{"privacy-service", cfg.PrivacyAddr},
+		{"limits-service", cfg.LimitsAddr},
+		{"openbanking-service", cfg.OpenBankingAddr},
+		{"backoffice-service", cfg.BackofficeAddr},
+		{"pricing-service", cfg.PricingAddr},
+		{"consent-service", cfg.ConsentAddr},
+		{"close-service", cfg.CloseAddr},
 	}
 
 	conns := make(map[string]*proxy.ServiceConn, len(defs))
@@ -138,7 +542,15 @@ func dialBackends(cfg config.Config, logger *slog.Logger) (*handler.Proxies, []*
 	var firstErr error
 
 	for _, d := range defs {
-		conn, err := proxy.Dial(d.name, d.addr, logger)
+		var (
+			conn *proxy.ServiceConn
+			err  error
+		)
+		if router != nil {
+			conn, err = proxy.DialRegions(d.name, cfg.Regions.Addrs(d.addr), router, logger)
+		} else {
+			conn, err = proxy.Dial(d.name, d.addr, logger)
+		}
 		if err != nil {
 			logger.Error("failed to dial backend", "service", d.name, "addr", d.addr, "error", err)
 			if firstErr == nil {
@@ -146,21 +558,53 @@ func dialBackends(cfg config.Config, logger *slog.Logger) (*handler.Proxies, []*
 			}
 			continue
 		}
+		if sandbox != nil {
+			if err := conn.DialSandbox(cfg.Sandbox.Addr(d.addr), sandbox); err != nil {
+				logger.Error("failed to dial backend sandbox", "service", d.name, "error", err)
+				if firstErr == nil {
+					firstErr = err
+				}
+			}
+		}
+		p := cfg.Resilience.Policy(d.name)
+		conn.SetResilience(proxy.ResiliencePolicy{
+			Timeout:          p.Timeout,
+			RetryBackoff:     p.RetryBackoff,
+			BreakerCooldown:  p.BreakerCooldown,
+			MaxAttempts:      p.MaxAttempts,
+			BreakerThreshold: p.BreakerThreshold,
+		})
 		conns[d.name] = conn
 		closers = append(closers, conn)
 	}
 
 	proxies := &handler.Proxies{
-		Ledger:    proxy.NewLedgerProxy(conns["ledger-service"], logger),
-		Account:   proxy.NewAccountProxy(conns["account-service"], logger),
-		FX:        proxy.NewFXProxy(conns["fx-service"], logger),
-		Deposit:   proxy.NewDepositProxy(conns["deposit-service"], logger),
-		Identity:  proxy.NewIdentityProxy(conns["identity-service"], logger),
-		Payment:   proxy.NewPaymentProxy(conns["payment-service"], logger),
-		Lending:   proxy.NewLendingProxy(conns["lending-service"], logger),
-		Fraud:     proxy.NewFraudProxy(conns["fraud-service"], logger),
-		Card:      proxy.NewCardProxy(conns["card-service"], logger),
-		Reporting: proxy.NewReportingProxy(conns["reporting-service"], logger),
+		Ledger:       proxy.NewLedgerProxy(conns["ledger-service"], logger),
+		Account:      proxy.NewAccountProxy(conns["account-service"], logger),
+		FX:           proxy.NewFXProxy(conns["fx-service"], logger),
+		Deposit:      proxy.NewDepositProxy(conns["deposit-service"], logger),
+		Identity:     proxy.NewIdentityProxy(conns["identity-service"], logger),
+		Payment:      proxy.NewPaymentProxy(conns["payment-service"], logger),
+		Lending:      proxy.NewLendingProxy(conns["lending-service"], logger),
+		Fraud:        proxy.NewFraudProxy(conns["fraud-service"], logger),
+		Card:         proxy.NewCardProxy(conns["card-service"], logger),
+		Reporting:    proxy.NewReportingProxy(conns["reporting-service"], logger),
+		Notification: proxy.NewNotificationProxy(conns["notification-service"], logger),
+		Customer:     proxy.NewCustomerProxy(conns["customer-service"], logger),
+		Tenant:       proxy.NewTenantProxy(conns["tenant-service"], logger),
+		Scheduler:    proxy.NewSchedulerProxy(conns["scheduler-service"], logger),
+		Statement:    proxy.NewStatementProxy(conns["statement-service"], logger),
+		Document:     proxy.NewDocumentProxy(conns["document-service"], logger),
+		Webhooks:     proxy.NewWebhooksProxy(conns["webhooks-service"], logger),
+		Treasury:     proxy.NewTreasuryProxy(conns["treasury-service"], logger),
+		Privacy:      proxy.NewPrivacyProxy(conns["privacy-service"], logger),
+		Limits:       proxy.NewLimitsProxy(conns["limits-service"], logger),
+		OpenBanking:  proxy.NewOpenBankingProxy(conns["openbanking-service"], logger),
+		Backoffice:   proxy.NewBackofficeProxy(conns["backoffice-service"], logger),
+		Pricing:      proxy.NewPricingProxy(conns["pricing-service"], logger),
+		Consent:      proxy.NewConsentProxy(conns["consent-service"], logger),
+		Close:        proxy.NewCloseProxy(conns["close-service"], logger),
+		Backends:     closers,
 	}
 
 	return proxies, closers, firstErr
@@ -3,10 +3,20 @@ module github.com/bibbank/bib/gateway
 go 1.24
 
 require (
+	github.com/bibbank/bib/api/gen/go v0.0.0
+	github.com/bibbank/bib/pkg/archive v0.0.0
 	github.com/bibbank/bib/pkg/auth v0.0.0
+	github.com/bibbank/bib/pkg/capture v0.0.0
+	github.com/bibbank/bib/pkg/contract v0.0.0
+	github.com/bibbank/bib/pkg/events v0.0.0
+	github.com/bibbank/bib/pkg/kafka v0.0.0
+	github.com/bibbank/bib/pkg/lifecycle v0.0.0
 	github.com/bibbank/bib/pkg/observability v0.0.0
+	github.com/bibbank/bib/pkg/postgres v0.0.0
 	github.com/google/uuid v1.6.0
+	github.com/jackc/pgx/v5 v5.7.2
 	google.golang.org/grpc v1.68.1
+	google.golang.org/protobuf v1.35.2
 )
 
 require (
@@ -16,13 +26,25 @@ require (
 	github.com/go-logr/logr v1.4.2 // indirect
 	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/golang-jwt/jwt/v5 v5.2.1 // indirect
+	github.com/golang-migrate/migrate/v4 v4.18.1 // indirect
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.23.0 // indirect
+	github.com/hashicorp/errwrap v1.1.0 // indirect
+	github.com/hashicorp/go-multierror v1.1.1 // indirect
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
+	github.com/jackc/puddle/v2 v2.2.2 // indirect
 	github.com/klauspost/compress v1.17.9 // indirect
+	github.com/lib/pq v1.10.9 // indirect
 	github.com/munnerz/goautoneg v0.0.0-20191010083416-a7dc8b61c822 // indirect
+	github.com/pierrec/lz4/v4 v4.1.18 // indirect
 	github.com/prometheus/client_golang v1.20.5 // indirect
 	github.com/prometheus/client_model v0.6.1 // indirect
 	github.com/prometheus/common v0.60.1 // indirect
 	github.com/prometheus/procfs v0.15.1 // indirect
+	github.com/segmentio/kafka-go v0.4.47 // indirect
+	github.com/xdg-go/pbkdf2 v1.0.0 // indirect
+	github.com/xdg-go/scram v1.1.2 // indirect
+	github.com/xdg-go/stringprep v1.0.4 // indirect
 	go.opentelemetry.io/otel v1.32.0 // indirect
 	go.opentelemetry.io/otel/exporters/otlp/otlptrace v1.32.0 // indirect
 	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc v1.32.0 // indirect
@@ -32,15 +54,25 @@ require (
 	go.opentelemetry.io/otel/sdk/metric v1.32.0 // indirect
 	go.opentelemetry.io/otel/trace v1.32.0 // indirect
 	go.opentelemetry.io/proto/otlp v1.3.1 // indirect
+	go.uber.org/atomic v1.7.0 // indirect
+	golang.org/x/crypto v0.31.0 // indirect
 	golang.org/x/net v0.30.0 // indirect
-	golang.org/x/sys v0.27.0 // indirect
-	golang.org/x/text v0.20.0 // indirect
+	golang.org/x/sync v0.10.0 // indirect
+	golang.org/x/sys v0.28.0 // indirect
+	golang.org/x/text v0.21.0 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20241104194629-dd2ea8efbc28 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20241104194629-dd2ea8efbc28 // indirect
-	google.golang.org/protobuf v1.35.2 // indirect
 )
 
 replace (
+	github.com/bibbank/bib/api/gen/go => ../api/gen/go
+	github.com/bibbank/bib/pkg/archive => ../pkg/archive
 	github.com/bibbank/bib/pkg/auth => ../pkg/auth
+	github.com/bibbank/bib/pkg/capture => ../pkg/capture
+	github.com/bibbank/bib/pkg/contract => ../pkg/contract
+	github.com/bibbank/bib/pkg/events => ../pkg/events
+	github.com/bibbank/bib/pkg/kafka => ../pkg/kafka
+	github.com/bibbank/bib/pkg/lifecycle => ../pkg/lifecycle
 	github.com/bibbank/bib/pkg/observability => ../pkg/observability
+	github.com/bibbank/bib/pkg/postgres => ../pkg/postgres
 )
@@ -0,0 +1,207 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+var (
+	// ErrInvalidKey is returned by Authenticate for keys that are
+	// malformed, unknown, revoked or expired.
+	ErrInvalidKey = errors.New("invalid api key")
+	// ErrInvalidParams is returned for keys requested with missing or
+	// disallowed fields.
+	ErrInvalidParams = errors.New("invalid api key parameters")
+)
+
+// keyTag starts every key, so leaked keys are easy to recognise in logs
+// and by secret scanners.
+const keyTag = "bib_"
+
+// GrantableRoles are the roles an API key may carry. Admin is not among
+// them: keys cannot manage keys.
+var GrantableRoles = []string{auth.RoleAPIClient, auth.RoleAuditor, auth.RoleCompliance}
+
+// CreateParams describes a key to create.
+type CreateParams struct {
+	Name string
+	// Roles defaults to api_client.
+	Roles     []string
+	RateLimit int
+	TenantID  uuid.UUID
+	CreatedBy uuid.UUID
+}
+
+// Manager creates, rotates and revokes tenants' API keys and authenticates
+// the calls made with them.
+type Manager struct {
+	store         Store
+	now           func() time.Time
+	onChange      []func(id uuid.UUID)
+	rotationGrace time.Duration
+}
+
+// NewManager creates a Manager keeping keys in store. A rotated key keeps
+// working for rotationGrace, while its clients move to the new one.
+func NewManager(store Store, rotationGrace time.Duration) *Manager {
+	return &Manager{store: store, rotationGrace: rotationGrace, now: time.Now}
+}
+
+// OnChange registers fn to be called with the ID of each key rotated or
+// revoked through the manager, so that state kept for the key, such as the
+// token signed for it, can be dropped. It must be called before the manager
+// is used.
+func (m *Manager) OnChange(fn func(id uuid.UUID)) {
+	m.onChange = append(m.onChange, fn)
+}
+
+func (m *Manager) changed(id uuid.UUID) {
+	for _, fn := range m.onChange {
+		fn(id)
+	}
+}
+
+// Create creates a key, returning it with its secret. The secret cannot be
+// recovered later.
+func (m *Manager) Create(ctx context.Context, p CreateParams) (Key, string, error) {
+	if strings.TrimSpace(p.Name) == "" {
+		return Key{}, "", fmt.Errorf("%w: name is required", ErrInvalidParams)
+	}
+	if p.RateLimit < 0 {
+		return Key{}, "", fmt.Errorf("%w: rate_limit must not be negative", ErrInvalidParams)
+	}
+	roles := p.Roles
+	if len(roles) == 0 {
+		roles = []string{auth.RoleAPIClient}
+	}
+	for _, r := range roles {
+		if !slices.Contains(GrantableRoles, r) {
+			return Key{}, "", fmt.Errorf("%w: role %q cannot be granted to an api key", ErrInvalidParams, r)
+		}
+	}
+
+	key, secret, err := m.newKey(p.TenantID, p.CreatedBy, p.Name, slices.Compact(slices.Sorted(slices.Values(roles))), p.RateLimit)
+	if err != nil {
+		return Key{}, "", err
+	}
+	if err := m.store.Create(ctx, key); err != nil {
+		return Key{}, "", err
+	}
+	return key, secret, nil
+}
+
+// Rotate replaces the tenant's key id with a new one of the same name,
+// roles and rate limit, returning it with its secret. The old key keeps
+// working for the rotation grace period.
+func (m *Manager) Rotate(ctx context.Context, tenantID, id, rotatedBy uuid.UUID) (Key, string, error) {
+	old, err := m.store.Get(ctx, tenantID, id)
+	if err != nil {
+		return Key{}, "", err
+	}
+	if !old.Active(m.now()) {
+		return Key{}, "", ErrNotFound
+	}
+
+	key, secret, err := m.newKey(tenantID, rotatedBy, old.Name, old.Roles, old.RateLimit)
+	if err != nil {
+		return Key{}, "", err
+	}
+	if err := m.store.Rotate(ctx, tenantID, id, key.CreatedAt.Add(m.rotationGrace), key); err != nil {
+		return Key{}, "", err
+	}
+	m.changed(id)
+	return key, secret, nil
+}
+
+// Revoke revokes the tenant's key id at once.
+func (m *Manager) Revoke(ctx context.Context, tenantID, id uuid.UUID) error {
+	if err := m.store.Revoke(ctx, tenantID, id, m.now().UTC()); err != nil {
+		return err
+	}
+	m.changed(id)
+	return nil
+}
+
+// List returns the tenant's keys, newest first.
+func (m *Manager) List(ctx context.Context, tenantID uuid.UUID) ([]Key, error) {
+	return m.store.List(ctx, tenantID)
+}
+
+// Authenticate returns the active key the secret belongs to.
+func (m *Manager) Authenticate(ctx context.Context, secret string) (*Key, error) {
+	prefix, ok := parsePrefix(secret)
+	if !ok {
+		return nil, ErrInvalidKey
+	}
+	key, err := m.store.GetByPrefix(ctx, prefix)
+	if errors.Is(err, ErrNotFound) {
+		return nil, ErrInvalidKey
+	}
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(key.Hash)) != 1 || !key.Active(m.now()) {
+		return nil, ErrInvalidKey
+	}
+	return key, nil
+}
+
+// newKey generates a key and its secret, bib_<prefix>_<random>.
+func (m *Manager) newKey(tenantID, createdBy uuid.UUID, name string, roles []string, rateLimit int) (Key, string, error) {
+	prefixBytes := make([]byte, 6)
+	randomBytes := make([]byte, 32)
+	if _, err := rand.Read(prefixBytes); err != nil {
+		return Key{}, "", fmt.Errorf("generate api key: %w", err)
+	}
+	if _, err := rand.Read(randomBytes); err != nil {
+		return Key{}, "", fmt.Errorf("generate api key: %w", err)
+	}
+	prefix := hex.EncodeToString(prefixBytes)
+	secret := keyTag + prefix + "_" + base64.RawURLEncoding.EncodeToString(randomBytes)
+
+	return Key{
+		ID:        uuid.New(),
+		TenantID:  tenantID,
+		CreatedBy: createdBy,
+		Name:      name,
+		Prefix:    prefix,
+		Hash:      hashSecret(secret),
+		Roles:     roles,
+		RateLimit: rateLimit,
+		CreatedAt: m.now().UTC(),
+	}, secret, nil
+}
+
+// parsePrefix returns the prefix of a well-formed secret.
+func parsePrefix(secret string) (string, bool) {
+	rest, ok := strings.CutPrefix(secret, keyTag)
+	if !ok {
+		return "", false
+	}
+	prefix, random, ok := strings.Cut(rest, "_")
+	if !ok || len(prefix) != 12 || random == "" {
+		return "", false
+	}
+	if _, err := hex.DecodeString(prefix); err != nil {
+		return "", false
+	}
+	return prefix, true
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
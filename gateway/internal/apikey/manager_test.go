@@ -0,0 +1,175 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+func newTestManager(now *time.Time) (*Manager, *MemoryStore) {
+	store := NewMemoryStore()
+	m := NewManager(store, time.Hour)
+	m.now = func() time.Time { return *now }
+	return m, store
+}
+
+func TestManager_CreateAndAuthenticate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	m, store := newTestManager(&now)
+	tenantID, adminID := uuid.New(), uuid.New()
+
+	key, secret, err := m.Create(ctx, CreateParams{TenantID: tenantID, CreatedBy: adminID, Name: "payroll sync", RateLimit: 50})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if !strings.HasPrefix(secret, keyTag+key.Prefix+"_") {
+		t.Errorf("secret %q does not start with the key's prefix %q", secret, key.Prefix)
+	}
+	if !slices.Equal(key.Roles, []string{auth.RoleAPIClient}) {
+		t.Errorf("Roles = %v, want the api_client default", key.Roles)
+	}
+	stored, _ := store.Get(ctx, tenantID, key.ID)
+	if stored.Hash == "" || strings.Contains(stored.Hash, secret) {
+		t.Errorf("stored hash %q, want the secret's hash only", stored.Hash)
+	}
+
+	got, err := m.Authenticate(ctx, secret)
+	if err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	if got.ID != key.ID || got.TenantID != tenantID || got.RateLimit != 50 {
+		t.Errorf("Authenticate = %+v, want key %s of tenant %s", got, key.ID, tenantID)
+	}
+
+	for name, s := range map[string]string{
+		"wrong secret": secret[:len(secret)-1] + "x",
+		"unknown":      keyTag + "000000000000_abc",
+		"malformed":    "not-a-key",
+		"empty":        "",
+	} {
+		if _, err := m.Authenticate(ctx, s); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Authenticate(%s) error = %v, want ErrInvalidKey", name, err)
+		}
+	}
+}
+
+func TestManager_CreateValidation(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	m, _ := newTestManager(&now)
+
+	tests := []struct {
+		name   string
+		params CreateParams
+	}{
+		{name: "missing name", params: CreateParams{}},
+		{name: "admin role", params: CreateParams{Name: "ops", Roles: []string{auth.RoleAdmin}}},
+		{name: "negative rate limit", params: CreateParams{Name: "ops", RateLimit: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := m.Create(ctx, tt.params); !errors.Is(err, ErrInvalidParams) {
+				t.Errorf("Create error = %v, want ErrInvalidParams", err)
+			}
+		})
+	}
+}
+
+func TestManager_Rotate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	m, _ := newTestManager(&now)
+	tenantID := uuid.New()
+
+	old, oldSecret, _ := m.Create(ctx, CreateParams{TenantID: tenantID, Name: "reconciler", Roles: []string{auth.RoleAuditor}, RateLimit: 5})
+	key, secret, err := m.Rotate(ctx, tenantID, old.ID, uuid.New())
+	if err != nil {
+		t.Fatalf("Rotate: %v", err)
+	}
+	if key.ID == old.ID || key.Name != old.Name || !slices.Equal(key.Roles, old.Roles) || key.RateLimit != old.RateLimit {
+		t.Errorf("Rotate = %+v, want a new key like %+v", key, old)
+	}
+
+	// Both keys work during the grace period.
+	if _, err := m.Authenticate(ctx, oldSecret); err != nil {
+		t.Errorf("Authenticate(old key) in grace period: %v", err)
+	}
+	if _, err := m.Authenticate(ctx, secret); err != nil {
+		t.Errorf("Authenticate(new key): %v", err)
+	}
+
+	now = now.Add(time.Hour)
+	if _, err := m.Authenticate(ctx, oldSecret); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Authenticate(old key) after grace period error = %v, want ErrInvalidKey", err)
+	}
+	if _, err := m.Authenticate(ctx, secret); err != nil {
+		t.Errorf("Authenticate(new key) after grace period: %v", err)
+	}
+	if _, _, err := m.Rotate(ctx, tenantID, old.ID, uuid.New()); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Rotate(expired key) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestManager_Revoke(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	m, _ := newTestManager(&now)
+	tenantID := uuid.New()
+
+	key, secret, _ := m.Create(ctx, CreateParams{TenantID: tenantID, Name: "exporter"})
+	if err := m.Revoke(ctx, uuid.New(), key.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Revoke(other tenant) error = %v, want ErrNotFound", err)
+	}
+	if err := m.Revoke(ctx, tenantID, key.ID); err != nil {
+		t.Fatalf("Revoke: %v", err)
+	}
+	if _, err := m.Authenticate(ctx, secret); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Authenticate(revoked key) error = %v, want ErrInvalidKey", err)
+	}
+
+	keys, _ := m.List(ctx, tenantID)
+	if len(keys) != 1 || keys[0].RevokedAt == nil {
+		t.Errorf("List = %+v, want the revoked key", keys)
+	}
+	if keys, _ := m.List(ctx, uuid.New()); len(keys) != 0 {
+		t.Errorf("List(other tenant) = %+v, want none", keys)
+	}
+}
+
+func TestManager_OnChange(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	m, _ := newTestManager(&now)
+	var changed []uuid.UUID
+	m.OnChange(func(id uuid.UUID) { changed = append(changed, id) })
+	tenantID := uuid.New()
+
+	key, _, err := m.Create(ctx, CreateParams{TenantID: tenantID, Name: "sync"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changed) != 0 {
+		t.Fatalf("changed = %v after Create, want none", changed)
+	}
+	rotated, _, err := m.Rotate(ctx, tenantID, key.ID, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Revoke(ctx, tenantID, rotated.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Revoke(ctx, tenantID, uuid.New()); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Revoke of unknown key error = %v, want ErrNotFound", err)
+	}
+	if !slices.Equal(changed, []uuid.UUID{key.ID, rotated.ID}) {
+		t.Errorf("changed = %v, want the rotated and the revoked key", changed)
+	}
+}
@@ -0,0 +1,18 @@
+-- API keys machine clients authenticate with in X-API-Key. Only the SHA-256
+-- hash of a key is kept; prefix, the key's public part, finds it. A rotated
+-- key keeps working until expires_at.
+CREATE TABLE IF NOT EXISTS api_keys (
+    id         UUID         PRIMARY KEY,
+    tenant_id  UUID         NOT NULL,
+    name       VARCHAR(255) NOT NULL,
+    prefix     VARCHAR(32)  NOT NULL UNIQUE,
+    key_hash   CHAR(64)     NOT NULL,
+    roles      TEXT[]       NOT NULL DEFAULT '{}',
+    rate_limit INT          NOT NULL DEFAULT 0,
+    created_by UUID         NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL,
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_tenant_id ON api_keys (tenant_id);
@@ -0,0 +1,145 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const keyColumns = `id, tenant_id, name, prefix, key_hash, roles, rate_limit, created_by, created_at, expires_at, revoked_at`
+
+// PostgresStore keeps API keys in the api_keys table, so every gateway
+// replica authenticates the same keys.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore creates a new PostgresStore.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// Create implements Store.
+func (s *PostgresStore) Create(ctx context.Context, key Key) error {
+	if err := insertKey(ctx, s.pool, key); err != nil {
+		return fmt.Errorf("create api key: %w", err)
+	}
+	return nil
+}
+
+// Get implements Store.
+func (s *PostgresStore) Get(ctx context.Context, tenantID, id uuid.UUID) (*Key, error) {
+	row := s.pool.QueryRow(ctx,
+		`SELECT `+keyColumns+` FROM api_keys WHERE tenant_id = $1 AND id = $2`, tenantID, id)
+	k, err := scanKey(row)
+	if err != nil {
+		return nil, fmt.Errorf("get api key: %w", err)
+	}
+	return k, nil
+}
+
+// GetByPrefix implements Store.
+func (s *PostgresStore) GetByPrefix(ctx context.Context, prefix string) (*Key, error) {
+	row := s.pool.QueryRow(ctx, `SELECT `+keyColumns+` FROM api_keys WHERE prefix = $1`, prefix)
+	k, err := scanKey(row)
+	if err != nil {
+		return nil, fmt.Errorf("get api key: %w", err)
+	}
+	return k, nil
+}
+
+// List implements Store.
+func (s *PostgresStore) List(ctx context.Context, tenantID uuid.UUID) ([]Key, error) {
+	rows, err := s.pool.Query(ctx,
+		`SELECT `+keyColumns+` FROM api_keys WHERE tenant_id = $1 ORDER BY created_at DESC`, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []Key
+	for rows.Next() {
+		k, err := scanKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan api key: %w", err)
+		}
+		keys = append(keys, *k)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate api keys: %w", err)
+	}
+	return keys, nil
+}
+
+// Rotate implements Store.
+func (s *PostgresStore) Rotate(ctx context.Context, tenantID, id uuid.UUID, expiresAt time.Time, replacement Key) error {
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, `
+			UPDATE api_keys SET expires_at = LEAST(COALESCE(expires_at, $3), $3)
+			WHERE tenant_id = $1 AND id = $2 AND revoked_at IS NULL`,
+			tenantID, id, expiresAt.UTC())
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrNotFound
+		}
+		return insertKey(ctx, tx, replacement)
+	})
+	if err != nil {
+		return fmt.Errorf("rotate api key: %w", err)
+	}
+	return nil
+}
+
+// Revoke implements Store.
+func (s *PostgresStore) Revoke(ctx context.Context, tenantID, id uuid.UUID, at time.Time) error {
+	tag, err := s.pool.Exec(ctx, `
+		UPDATE api_keys SET revoked_at = COALESCE(revoked_at, $3)
+		WHERE tenant_id = $1 AND id = $2`,
+		tenantID, id, at.UTC())
+	if err != nil {
+		return fmt.Errorf("revoke api key: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// execer is a pool or a transaction.
+type execer interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+func insertKey(ctx context.Context, db execer, key Key) error {
+	roles := key.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+	_, err := db.Exec(ctx, `
+		INSERT INTO api_keys (`+keyColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		key.ID, key.TenantID, key.Name, key.Prefix, key.Hash, roles, key.RateLimit,
+		key.CreatedBy, key.CreatedAt.UTC(), key.ExpiresAt, key.RevokedAt)
+	return err
+}
+
+func scanKey(row pgx.Row) (*Key, error) {
+	var k Key
+	err := row.Scan(&k.ID, &k.TenantID, &k.Name, &k.Prefix, &k.Hash, &k.Roles, &k.RateLimit,
+		&k.CreatedBy, &k.CreatedAt, &k.ExpiresAt, &k.RevokedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &k, nil
+}
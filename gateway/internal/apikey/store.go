@@ -0,0 +1,160 @@
+// Package apikey manages the API keys machine clients authenticate to the
+// gateway with, in place of a JWT. Keys belong to a tenant and carry the
+// roles they were created with; only their hashes are stored.
+package apikey
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned for keys that do not exist or, where a live key
+// is needed, have been revoked.
+var ErrNotFound = errors.New("api key not found")
+
+// Key is a stored API key. The secret is never stored, only its hash.
+type Key struct {
+	CreatedAt time.Time
+	// ExpiresAt is set on rotated keys, which keep working until then.
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+	Name      string
+	// Prefix is the key's public part, by which it is found.
+	Prefix string
+	// Hash is the hex SHA-256 hash of the whole key.
+	Hash  string
+	Roles []string
+	// RateLimit overrides the gateway's per-client requests per second for
+	// the key's calls; zero keeps the default.
+	RateLimit int
+	ID        uuid.UUID
+	TenantID  uuid.UUID
+	CreatedBy uuid.UUID
+}
+
+// Active reports whether the key authenticates calls at now.
+func (k Key) Active(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// Store keeps API keys.
+type Store interface {
+	// Create stores a new key.
+	Create(ctx context.Context, key Key) error
+	// Get returns the tenant's key with the given ID.
+	Get(ctx context.Context, tenantID, id uuid.UUID) (*Key, error)
+	// GetByPrefix returns the key with the given prefix, of any tenant.
+	GetByPrefix(ctx context.Context, prefix string) (*Key, error)
+	// List returns the tenant's keys, revoked ones included, newest first.
+	List(ctx context.Context, tenantID uuid.UUID) ([]Key, error)
+	// Rotate stores replacement and makes the tenant's unrevoked key id
+	// expire at expiresAt, unless it expires sooner, in one step.
+	Rotate(ctx context.Context, tenantID, id uuid.UUID, expiresAt time.Time, replacement Key) error
+	// Revoke revokes the tenant's key id at the given time. Revoking a
+	// revoked key is not an error.
+	Revoke(ctx context.Context, tenantID, id uuid.UUID, at time.Time) error
+}
+
+// MemoryStore keeps API keys in memory. Keys are not shared between
+// gateway replicas nor kept across restarts, so it suits single-replica
+// deployments and tests.
+type MemoryStore struct {
+	keys map[uuid.UUID]*Key
+	mu   sync.Mutex
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{keys: make(map[uuid.UUID]*Key)}
+}
+
+// Create implements Store.
+func (s *MemoryStore) Create(_ context.Context, key Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys[key.ID] = cloneKey(key)
+	return nil
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(_ context.Context, tenantID, id uuid.UUID) (*Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k, ok := s.keys[id]
+	if !ok || k.TenantID != tenantID {
+		return nil, ErrNotFound
+	}
+	return cloneKey(*k), nil
+}
+
+// GetByPrefix implements Store.
+func (s *MemoryStore) GetByPrefix(_ context.Context, prefix string) (*Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, k := range s.keys {
+		if k.Prefix == prefix {
+			return cloneKey(*k), nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// List implements Store.
+func (s *MemoryStore) List(_ context.Context, tenantID uuid.UUID) ([]Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var keys []Key
+	for _, k := range s.keys {
+		if k.TenantID == tenantID {
+			keys = append(keys, *cloneKey(*k))
+		}
+	}
+	slices.SortFunc(keys, func(a, b Key) int { return b.CreatedAt.Compare(a.CreatedAt) })
+	return keys, nil
+}
+
+// Rotate implements Store.
+func (s *MemoryStore) Rotate(_ context.Context, tenantID, id uuid.UUID, expiresAt time.Time, replacement Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k, ok := s.keys[id]
+	if !ok || k.TenantID != tenantID || k.RevokedAt != nil {
+		return ErrNotFound
+	}
+	if k.ExpiresAt == nil || expiresAt.Before(*k.ExpiresAt) {
+		k.ExpiresAt = &expiresAt
+	}
+	s.keys[replacement.ID] = cloneKey(replacement)
+	return nil
+}
+
+// Revoke implements Store.
+func (s *MemoryStore) Revoke(_ context.Context, tenantID, id uuid.UUID, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k, ok := s.keys[id]
+	if !ok || k.TenantID != tenantID {
+		return ErrNotFound
+	}
+	if k.RevokedAt == nil {
+		k.RevokedAt = &at
+	}
+	return nil
+}
+
+// cloneKey copies key, so callers cannot change a stored key through it.
+func cloneKey(key Key) *Key {
+	key.Roles = slices.Clone(key.Roles)
+	return &key
+}
@@ -1,8 +1,13 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/pkg/archive"
 )
 
 // Config holds all configuration for the API gateway.
@@ -17,13 +22,255 @@ type Config struct {
 	LendingAddr       string
 	LedgerAddr        string
 	ReportingAddr     string
+	NotificationAddr  string
+	CustomerAddr      string
+	TenantAddr        string
+	SchedulerAddr     string
+	StatementAddr     string
+	DocumentAddr      string
+	WebhooksAddr      string
+	TreasuryAddr      string
+	PrivacyAddr       string
+	LimitsAddr        string
+	OpenBankingAddr   string
+	BackofficeAddr    string
+	PricingAddr       string
+	ConsentAddr       string
+	CloseAddr         string
 	LogFormat         string
 	JWTSecret         string
 	JWTPrivateKey     string
 	JWTPrivateKeyFile string
-	LogLevel          string
-	RateLimit         int
-	HTTPPort          int
+	// JWTPublicKey and JWTPublicKeyFile hold PEM public keys trusted in
+	// addition to the signing key's, such as the previous key during a key
+	// rotation.
+	JWTPublicKey     string
+	JWTPublicKeyFile string
+	LogLevel         string
+	KafkaBrokers     []string
+	AuthGuard        AuthGuardConfig
+	Backoffice       BackofficeConfig
+	Regions          RegionConfig
+	Capture          CaptureConfig
+	Sandbox          SandboxConfig
+	Idempotency      IdempotencyConfig
+	Tokens           TokenConfig
+	APIKeys          APIKeyConfig
+	Resilience       ResilienceConfig
+	Docs             DocsConfig
+	RateLimit        int
+	HTTPPort         int
+}
+
+// AuthGuardConfig configures brute-force protection on authentication.
+// Zero values take the middleware's defaults.
+type AuthGuardConfig struct {
+	Window          time.Duration
+	LockoutDuration time.Duration
+	ThrottleAfter   int
+	StepUpAfter     int
+	LockoutAfter    int
+}
+
+// BackofficeConfig configures the listener the operations console calls.
+// Operators authenticate in the backoffice realm, with tokens of issuer
+// JWTIssuer verified with JWTPublicKey (or the key in JWTPublicKeyFile) or
+// JWTSecret; platform tokens are not accepted there. The listener is off
+// when no realm key is set.
+type BackofficeConfig struct {
+	JWTIssuer        string
+	JWTPublicKey     string
+	JWTPublicKeyFile string
+	JWTSecret        string
+	HTTPPort         int
+}
+
+// Enabled reports whether a realm key is configured.
+func (c BackofficeConfig) Enabled() bool {
+	return c.JWTPublicKey != "" || c.JWTPublicKeyFile != "" || c.JWTSecret != ""
+}
+
+// RegionConfig configures active-active routing across regions. The
+// gateway runs in region Local and reaches each service in a peer region at
+// its local address with the peer's host suffix appended to the host, so
+// "ledger-service:9081" becomes "ledger-service.us-east-1.bib.internal:9081"
+// for the suffix ".us-east-1.bib.internal". Routing is off without peers.
+type RegionConfig struct {
+	// PeerSuffixes maps each peer region to its host suffix.
+	PeerSuffixes map[string]string
+	// TenantHomes maps tenant IDs to their home region.
+	TenantHomes map[string]string
+	Local       string
+	// ReplicationLag bounds how far replicas trail a tenant's home region.
+	ReplicationLag time.Duration
+	HealthInterval time.Duration
+}
+
+// Enabled reports whether peer regions are configured.
+func (c RegionConfig) Enabled() bool {
+	return c.Local != "" && len(c.PeerSuffixes) > 0
+}
+
+// Addrs returns a service's address in every region, given its local
+// address.
+func (c RegionConfig) Addrs(localAddr string) map[string]string {
+	addrs := map[string]string{c.Local: localAddr}
+	host, port, found := strings.Cut(localAddr, ":")
+	for region, suffix := range c.PeerSuffixes {
+		addr := host + suffix
+		if found {
+			addr += ":" + port
+		}
+		addrs[region] = addr
+	}
+	return addrs
+}
+
+// CaptureConfig configures traffic capture for debugging: requests of
+// Tenants, or to Routes, are recorded, sanitized, into Store for replay
+// with bib-replay. A route is a path prefix, optionally preceded by a
+// method, such as "POST /api/v1/payments". Capture is off unless tenants or
+// routes are set and a store is configured.
+type CaptureConfig struct {
+	Store   archive.StoreConfig
+	Tenants []string
+	Routes  []string
+	// MaxBody is the largest request or response body kept, in bytes.
+	MaxBody int
+}
+
+// SandboxConfig configures the sandbox, in which Tenants test their
+// integrations against services whose external adapters are simulated.
+// Their calls go to the sandbox deployment of each service, reached at its
+// live address with HostSuffix appended to the host, so
+// "bib-payment:9086" becomes "bib-payment-sandbox:9086" for the suffix
+// "-sandbox". The sandbox is off unless tenants and a suffix are set.
+type SandboxConfig struct {
+	HostSuffix string
+	Tenants    []string
+}
+
+// Enabled reports whether sandbox tenants and a host suffix are configured.
+func (c SandboxConfig) Enabled() bool {
+	return len(c.Tenants) > 0 && c.HostSuffix != ""
+}
+
+// Addr returns a service's sandbox address, given its live address.
+func (c SandboxConfig) Addr(liveAddr string) string {
+	host, port, found := strings.Cut(liveAddr, ":")
+	addr := host + c.HostSuffix
+	if found {
+		addr += ":" + port
+	}
+	return addr
+}
+
+// IdempotencyConfig configures the replay of responses to retried requests
+// carrying an Idempotency-Key. Responses to Routes, "METHOD path" pairs, are
+// replayed for TTL; a request in flight holds its key for LockTimeout, and
+// bodies above MaxBody bytes are rejected. They are kept in Postgres when
+// DB.Host is set, so every replica replays them, and in the gateway's memory
+// otherwise.
+type IdempotencyConfig struct {
+	DB          DatabaseConfig
+	Routes      []string
+	TTL         time.Duration
+	LockTimeout time.Duration
+	MaxBody     int
+}
+
+// TokenConfig configures the tokens the gateway issues from
+// /api/v1/auth/token and /api/v1/auth/refresh: access tokens valid for
+// AccessTTL, paired with refresh tokens valid for RefreshTTL. Sessions are
+// started with a login token from the issuer Login describes. Refresh tokens
+// and revoked access tokens are kept in Postgres when DB.Host is set, so
+// every replica honours them, and in the gateway's memory otherwise.
+//
+// Revocations are checked by the gateway only: services validating tokens
+// against its JWKS do not see them, so a revoked access token is refused at
+// the gateway but stays valid for a direct call to a service until it
+// expires, at most AccessTTL later.
+type TokenConfig struct {
+	Login      LoginConfig
+	DB         DatabaseConfig
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+}
+
+// LoginConfig configures the issuer of login tokens, the credentials that
+// start a session at /api/v1/auth/token: tokens of issuer JWTIssuer,
+// verified with JWTPublicKey (or the key in JWTPublicKeyFile) or the keys
+// at JWKSURL, and issued at most MaxAge before. Sessions cannot be started
+// when no key is set.
+type LoginConfig struct {
+	JWTIssuer        string
+	JWTPublicKey     string
+	JWTPublicKeyFile string
+	JWKSURL          string
+	MaxAge           time.Duration
+}
+
+// Enabled reports whether a login issuer key is configured.
+func (c LoginConfig) Enabled() bool {
+	return c.JWTPublicKey != "" || c.JWTPublicKeyFile != "" || c.JWKSURL != ""
+}
+
+// APIKeyConfig configures the API keys machine clients authenticate with.
+// A rotated key keeps working for RotationGrace. Keys are kept in Postgres
+// when DB.Host is set, so every replica accepts them, and in the gateway's
+// memory otherwise.
+type APIKeyConfig struct {
+	DB            DatabaseConfig
+	RotationGrace time.Duration
+}
+
+// ResilienceConfig configures how the gateway calls its backends: Default
+// applies to every backend, and Backends overrides it for the services
+// named. Zero fields take the proxy's defaults.
+type ResilienceConfig struct {
+	Backends map[string]BackendPolicy
+	Default  BackendPolicy
+}
+
+// Policy returns the policy of the named backend service.
+func (c ResilienceConfig) Policy(service string) BackendPolicy {
+	if p, ok := c.Backends[service]; ok {
+		return p
+	}
+	return c.Default
+}
+
+// BackendPolicy configures calls to one backend. Each attempt of a call is
+// bounded by Timeout; reads and idempotent writes that find the backend
+// unavailable or timed out get up to MaxAttempts attempts, RetryBackoff
+// apart and doubling. BreakerThreshold consecutive failures open the
+// backend's circuit breaker for BreakerCooldown, after which a probe call
+// decides whether it closes.
+type BackendPolicy struct {
+	Timeout          time.Duration
+	RetryBackoff     time.Duration
+	BreakerCooldown  time.Duration
+	MaxAttempts      int
+	BreakerThreshold int
+}
+
+// DocsConfig configures the OpenAPI document served at /openapi.json and
+// the Swagger UI rendering it at /docs. They are served to admins only
+// unless Public is set, and not at all unless Enabled; production
+// deployments disable them.
+type DocsConfig struct {
+	Enabled bool
+	Public  bool
+}
+
+// DatabaseConfig holds PostgreSQL connection settings.
+type DatabaseConfig struct {
+	Host     string
+	User     string
+	Password string
+	Name     string
+	SSLMode  string
+	Port     int
 }
 
 // Validate checks required configuration values.
@@ -31,6 +278,17 @@ func (c Config) Validate() {
 	if c.JWTPrivateKey == "" && c.JWTPrivateKeyFile == "" && c.JWTSecret == "" {
 		panic("JWT_PRIVATE_KEY, JWT_PRIVATE_KEY_FILE, or JWT_SECRET environment variable is required")
 	}
+	if len(c.Regions.PeerSuffixes) > 0 && c.Regions.Local == "" {
+		panic("REGION environment variable is required when PEER_REGIONS is set")
+	}
+	for tenant, region := range c.Regions.TenantHomes {
+		if _, peer := c.Regions.PeerSuffixes[region]; !peer && region != c.Regions.Local {
+			panic(fmt.Sprintf("TENANT_REGIONS homes tenant %s in unknown region %q", tenant, region))
+		}
+	}
+	if len(c.Sandbox.Tenants) > 0 && c.Sandbox.HostSuffix == "" {
+		panic("SANDBOX_HOST_SUFFIX environment variable is required when SANDBOX_TENANTS is set")
+	}
 }
 
 // Load reads configuration from environment variables with sensible defaults.
@@ -49,13 +307,172 @@ func Load() Config {
 		FraudAddr:         getEnvWithAlt("FRAUD_ADDR", "FRAUD_SERVICE_ADDR", "localhost:9088"),
 		CardAddr:          getEnvWithAlt("CARD_ADDR", "CARD_SERVICE_ADDR", "localhost:9089"),
 		ReportingAddr:     getEnvWithAlt("REPORTING_ADDR", "REPORTING_SERVICE_ADDR", "localhost:9090"),
+		NotificationAddr:  getEnvWithAlt("NOTIFICATION_ADDR", "NOTIFICATION_SERVICE_ADDR", "localhost:9091"),
+		CustomerAddr:      getEnvWithAlt("CUSTOMER_ADDR", "CUSTOMER_SERVICE_ADDR", "localhost:9093"),
+		TenantAddr:        getEnvWithAlt("TENANT_ADDR", "TENANT_SERVICE_ADDR", "localhost:9094"),
+		SchedulerAddr:     getEnvWithAlt("SCHEDULER_ADDR", "SCHEDULER_SERVICE_ADDR", "localhost:9095"),
+		StatementAddr:     getEnvWithAlt("STATEMENT_ADDR", "STATEMENT_SERVICE_ADDR", "localhost:9096"),
+		DocumentAddr:      getEnvWithAlt("DOCUMENT_ADDR", "DOCUMENT_SERVICE_ADDR", "localhost:9097"),
+		WebhooksAddr:      getEnvWithAlt("WEBHOOKS_ADDR", "WEBHOOKS_SERVICE_ADDR", "localhost:9098"),
+		TreasuryAddr:      getEnvWithAlt("TREASURY_ADDR", "TREASURY_SERVICE_ADDR", "localhost:9099"),
+		PrivacyAddr:       getEnvWithAlt("PRIVACY_ADDR", "PRIVACY_SERVICE_ADDR", "localhost:9100"),
+		LimitsAddr:        getEnvWithAlt("LIMITS_ADDR", "LIMITS_SERVICE_ADDR", "localhost:9101"),
+		OpenBankingAddr:   getEnvWithAlt("OPENBANKING_ADDR", "OPENBANKING_SERVICE_ADDR", "localhost:9102"),
+		BackofficeAddr:    getEnvWithAlt("BACKOFFICE_ADDR", "BACKOFFICE_SERVICE_ADDR", "localhost:9103"),
+		PricingAddr:       getEnvWithAlt("PRICING_ADDR", "PRICING_SERVICE_ADDR", "localhost:9104"),
+		ConsentAddr:       getEnvWithAlt("CONSENT_ADDR", "CONSENT_SERVICE_ADDR", "localhost:9105"),
+		CloseAddr:         getEnvWithAlt("CLOSE_ADDR", "CLOSE_SERVICE_ADDR", "localhost:9106"),
 		JWTSecret:         getEnv("JWT_SECRET", ""),
 		JWTPrivateKey:     getEnv("JWT_PRIVATE_KEY", ""),
 		JWTPrivateKeyFile: getEnv("JWT_PRIVATE_KEY_FILE", ""),
+		JWTPublicKey:      getEnv("JWT_PUBLIC_KEY", ""),
+		JWTPublicKeyFile:  getEnv("JWT_PUBLIC_KEY_FILE", ""),
 		RateLimit:         getEnvInt("RATE_LIMIT", 100),
-		LogLevel:          getEnv("LOG_LEVEL", "info"),
-		LogFormat:         getEnv("LOG_FORMAT", "json"),
+		KafkaBrokers:      getEnvList("KAFKA_BROKERS"),
+		AuthGuard: AuthGuardConfig{
+			Window:          getEnvDuration("AUTH_FAILURE_WINDOW", 15*time.Minute),
+			LockoutDuration: getEnvDuration("AUTH_LOCKOUT_DURATION", 15*time.Minute),
+			ThrottleAfter:   getEnvInt("AUTH_THROTTLE_AFTER", 3),
+			StepUpAfter:     getEnvInt("AUTH_STEP_UP_AFTER", 5),
+			LockoutAfter:    getEnvInt("AUTH_LOCKOUT_AFTER", 10),
+		},
+		Backoffice: BackofficeConfig{
+			HTTPPort:         getEnvInt("BACKOFFICE_HTTP_PORT", 8070),
+			JWTIssuer:        getEnv("BACKOFFICE_JWT_ISSUER", "bib-backoffice"),
+			JWTPublicKey:     getEnv("BACKOFFICE_JWT_PUBLIC_KEY", ""),
+			JWTPublicKeyFile: getEnv("BACKOFFICE_JWT_PUBLIC_KEY_FILE", ""),
+			JWTSecret:        getEnv("BACKOFFICE_JWT_SECRET", ""),
+		},
+		Regions: RegionConfig{
+			Local:          getEnv("REGION", ""),
+			PeerSuffixes:   getEnvMap("PEER_REGIONS"),
+			TenantHomes:    getEnvMap("TENANT_REGIONS"),
+			ReplicationLag: getEnvDuration("REPLICATION_LAG", 5*time.Second),
+			HealthInterval: getEnvDuration("REGION_HEALTH_INTERVAL", 5*time.Second),
+		},
+		Capture: CaptureConfig{
+			Tenants: getEnvList("CAPTURE_TENANTS"),
+			Routes:  getEnvList("CAPTURE_ROUTES"),
+			MaxBody: getEnvInt("CAPTURE_MAX_BODY", 64<<10),
+			Store: archive.StoreConfig{
+				Dir:         getEnv("CAPTURE_DIR", ""),
+				S3Endpoint:  getEnv("CAPTURE_S3_ENDPOINT", "https://s3.amazonaws.com"),
+				S3Region:    getEnv("CAPTURE_S3_REGION", "us-east-1"),
+				S3Bucket:    getEnv("CAPTURE_S3_BUCKET", ""),
+				S3AccessKey: getEnv("CAPTURE_S3_ACCESS_KEY", ""),
+				S3SecretKey: getEnv("CAPTURE_S3_SECRET_KEY", ""),
+			},
+		},
+		Sandbox: SandboxConfig{
+			Tenants:    getEnvList("SANDBOX_TENANTS"),
+			HostSuffix: getEnv("SANDBOX_HOST_SUFFIX", ""),
+		},
+		Idempotency: IdempotencyConfig{
+			Routes:      getEnvListWithDefault("IDEMPOTENCY_ROUTES", []string{"POST /api/v1/payments", "POST /api/v1/payment-schedules", "POST /api/v1/accounts"}),
+			TTL:         getEnvDuration("IDEMPOTENCY_TTL", 24*time.Hour),
+			LockTimeout: getEnvDuration("IDEMPOTENCY_LOCK_TIMEOUT", time.Minute),
+			MaxBody:     getEnvInt("IDEMPOTENCY_MAX_BODY", 1<<20),
+			DB: DatabaseConfig{
+				Host:     getEnv("IDEMPOTENCY_DB_HOST", ""),
+				Port:     getEnvInt("IDEMPOTENCY_DB_PORT", 5432),
+				User:     getEnv("IDEMPOTENCY_DB_USER", "bib"),
+				Password: getEnv("IDEMPOTENCY_DB_PASSWORD", ""),
+				Name:     getEnv("IDEMPOTENCY_DB_NAME", "bib_gateway"),
+				SSLMode:  getEnv("IDEMPOTENCY_DB_SSLMODE", "require"),
+			},
+		},
+		Tokens: TokenConfig{
+			AccessTTL:  getEnvDuration("ACCESS_TOKEN_TTL", 15*time.Minute),
+			RefreshTTL: getEnvDuration("REFRESH_TOKEN_TTL", 30*24*time.Hour),
+			Login: LoginConfig{
+				JWTIssuer:        getEnv("LOGIN_JWT_ISSUER", "bib-identity"),
+				JWTPublicKey:     getEnv("LOGIN_JWT_PUBLIC_KEY", ""),
+				JWTPublicKeyFile: getEnv("LOGIN_JWT_PUBLIC_KEY_FILE", ""),
+				JWKSURL:          getEnv("LOGIN_JWKS_URL", ""),
+				MaxAge:           getEnvDuration("LOGIN_TOKEN_MAX_AGE", 5*time.Minute),
+			},
+			DB: DatabaseConfig{
+				Host:     getEnv("TOKEN_DB_HOST", ""),
+				Port:     getEnvInt("TOKEN_DB_PORT", 5432),
+				User:     getEnv("TOKEN_DB_USER", "bib"),
+				Password: getEnv("TOKEN_DB_PASSWORD", ""),
+				Name:     getEnv("TOKEN_DB_NAME", "bib_gateway_tokens"),
+				SSLMode:  getEnv("TOKEN_DB_SSLMODE", "require"),
+			},
+		},
+		APIKeys: APIKeyConfig{
+			RotationGrace: getEnvDuration("API_KEY_ROTATION_GRACE", 24*time.Hour),
+			DB: DatabaseConfig{
+				Host:     getEnv("API_KEY_DB_HOST", ""),
+				Port:     getEnvInt("API_KEY_DB_PORT", 5432),
+				User:     getEnv("API_KEY_DB_USER", "bib"),
+				Password: getEnv("API_KEY_DB_PASSWORD", ""),
+				Name:     getEnv("API_KEY_DB_NAME", "bib_gateway_api_keys"),
+				SSLMode:  getEnv("API_KEY_DB_SSLMODE", "require"),
+			},
+		},
+		Resilience: loadResilience(),
+		Docs: DocsConfig{
+			Enabled: getEnvBool("DOCS_ENABLED", true),
+			Public:  getEnvBool("DOCS_PUBLIC", false),
+		},
+		LogLevel:  getEnv("LOG_LEVEL", "info"),
+		LogFormat: getEnv("LOG_FORMAT", "json"),
+	}
+}
+
+// loadResilience reads the default backend policy from the BACKEND_*
+// variables, and per-backend overrides from BACKEND_POLICIES, whose
+// comma-separated entries pair a service with semicolon-separated settings,
+// such as "reporting-service=timeout:60s;max_attempts:1". Settings not
+// given in an override keep the default. Malformed settings are ignored.
+func loadResilience() ResilienceConfig {
+	def := BackendPolicy{
+		Timeout:          getEnvDuration("BACKEND_TIMEOUT", 10*time.Second),
+		MaxAttempts:      getEnvInt("BACKEND_MAX_ATTEMPTS", 3),
+		RetryBackoff:     getEnvDuration("BACKEND_RETRY_BACKOFF", 100*time.Millisecond),
+		BreakerThreshold: getEnvInt("BACKEND_BREAKER_THRESHOLD", 5),
+		BreakerCooldown:  getEnvDuration("BACKEND_BREAKER_COOLDOWN", 30*time.Second),
+	}
+	cfg := ResilienceConfig{Default: def}
+	for service, settings := range getEnvMap("BACKEND_POLICIES") {
+		p := def
+		for _, setting := range strings.Split(settings, ";") {
+			k, v, _ := strings.Cut(setting, ":")
+			v = strings.TrimSpace(v)
+			switch strings.TrimSpace(k) {
+			case "timeout":
+				p.Timeout = parseDuration(v, p.Timeout)
+			case "max_attempts":
+				p.MaxAttempts = parseInt(v, p.MaxAttempts)
+			case "retry_backoff":
+				p.RetryBackoff = parseDuration(v, p.RetryBackoff)
+			case "breaker_threshold":
+				p.BreakerThreshold = parseInt(v, p.BreakerThreshold)
+			case "breaker_cooldown":
+				p.BreakerCooldown = parseDuration(v, p.BreakerCooldown)
+			}
+		}
+		if cfg.Backends == nil {
+			cfg.Backends = make(map[string]BackendPolicy)
+		}
+		cfg.Backends[service] = p
 	}
+	return cfg
+}
+
+func parseDuration(s string, defaultVal time.Duration) time.Duration {
+	if d, err := time.ParseDuration(s); err == nil {
+		return d
+	}
+	return defaultVal
+}
+
+func parseInt(s string, defaultVal int) int {
+	if i, err := strconv.Atoi(s); err == nil {
+		return i
+	}
+	return defaultVal
 }
 
 // getEnv returns the value of an environment variable or a default.
@@ -87,3 +504,61 @@ func getEnvInt(key string, defaultVal int) int {
 	}
 	return defaultVal
 }
+
+// getEnvBool returns the boolean value of an environment variable or a default.
+func getEnvBool(key string, defaultVal bool) bool {
+	if val, ok := os.LookupEnv(key); ok {
+		if b, err := strconv.ParseBool(val); err == nil {
+			return b
+		}
+	}
+	return defaultVal
+}
+
+// getEnvDuration returns the duration value of an environment variable or a default.
+func getEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	if val, ok := os.LookupEnv(key); ok {
+		if d, err := time.ParseDuration(val); err == nil {
+			return d
+		}
+	}
+	return defaultVal
+}
+
+// getEnvList returns the comma-separated values of an environment variable,
+// or nil when it is unset or empty.
+func getEnvList(key string) []string {
+	var list []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// getEnvListWithDefault returns the comma-separated values of an environment
+// variable, or defaultVal when it is unset or empty.
+func getEnvListWithDefault(key string, defaultVal []string) []string {
+	if list := getEnvList(key); list != nil {
+		return list
+	}
+	return defaultVal
+}
+
+// getEnvMap returns the comma-separated key=value pairs of an environment
+// variable, or nil when it is unset or empty. Pairs without "=" are ignored.
+func getEnvMap(key string) map[string]string {
+	var m map[string]string
+	for _, pair := range getEnvList(key) {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		if m == nil {
+			m = make(map[string]string)
+		}
+		m[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return m
+}
@@ -9,29 +9,74 @@ import (
 
 // Proxies holds all backend service proxy instances.
 type Proxies struct {
-	Account   *proxy.AccountProxy
-	Ledger    *proxy.LedgerProxy
-	Payment   *proxy.PaymentProxy
-	FX        *proxy.FXProxy
-	Identity  *proxy.IdentityProxy
-	Deposit   *proxy.DepositProxy
-	Card      *proxy.CardProxy
-	Lending   *proxy.LendingProxy
-	Fraud     *proxy.FraudProxy
-	Reporting *proxy.ReportingProxy
-	Partner   *proxy.PartnerProxy
+	Account      *proxy.AccountProxy
+	Ledger       *proxy.LedgerProxy
+	Payment      *proxy.PaymentProxy
+	FX           *proxy.FXProxy
+	Identity     *proxy.IdentityProxy
+	Deposit      *proxy.DepositProxy
+	Card         *proxy.CardProxy
+	Lending      *proxy.LendingProxy
+	Fraud        *proxy.FraudProxy
+	Reporting    *proxy.ReportingProxy
+	Notification *proxy.NotificationProxy
+	Customer     *proxy.CustomerProxy
+	Tenant       *proxy.TenantProxy
+	Scheduler    *proxy.SchedulerProxy
+	Statement    *proxy.StatementProxy
+	Document     *proxy.DocumentProxy
+	Webhooks     *proxy.WebhooksProxy
+	Treasury     *proxy.TreasuryProxy
+	Privacy      *proxy.PrivacyProxy
+	Limits       *proxy.LimitsProxy
+	OpenBanking  *proxy.OpenBankingProxy
+	Pricing      *proxy.PricingProxy
+	Consent      *proxy.ConsentProxy
+	Close        *proxy.CloseProxy
+	Partner      *proxy.PartnerProxy
+	// Auth serves the token endpoints; they are not registered when nil.
+	Auth *proxy.AuthProxy
+	// APIKeys serves the management of API keys; it is not registered
+	// when nil.
+	APIKeys *proxy.APIKeyProxy
+	// Backoffice is served on the backoffice listener, by
+	// RegisterBackofficeRoutes.
+	Backoffice *proxy.BackofficeProxy
+	// Backends are the backend connections /readyz reports on.
+	Backends []*proxy.ServiceConn
 }
 
+// PublicPaths are the paths served without an access token. Refreshing
+// needs none: the caller's has usually expired. Starting a session takes a
+// login token instead, which the token endpoint validates itself.
+var PublicPaths = []string{"/healthz", "/readyz", "/.well-known/jwks.json", "/api/v1/auth/token", "/api/v1/auth/refresh"}
+
 // RegisterRoutes registers all REST API routes on the given ServeMux.
 func RegisterRoutes(mux *http.ServeMux, p *Proxies) {
 	// Health
 	mux.HandleFunc("/healthz", healthz)
-	mux.HandleFunc("/readyz", readyz)
+	mux.HandleFunc("/readyz", readyz(p.Backends))
+
+	// --- Auth ---
+	if p.Auth != nil {
+		mux.HandleFunc("POST /api/v1/auth/token", p.Auth.Token)
+		mux.HandleFunc("POST /api/v1/auth/refresh", p.Auth.Refresh)
+		mux.HandleFunc("POST /api/v1/auth/revoke", p.Auth.Revoke)
+	}
+
+	// --- API keys ---
+	if p.APIKeys != nil {
+		mux.HandleFunc("POST /api/v1/api-keys", p.APIKeys.CreateAPIKey)
+		mux.HandleFunc("GET /api/v1/api-keys", p.APIKeys.ListAPIKeys)
+		mux.HandleFunc("POST /api/v1/api-keys/{id}/rotate", p.APIKeys.RotateAPIKey)
+		mux.HandleFunc("POST /api/v1/api-keys/{id}/revoke", p.APIKeys.RevokeAPIKey)
+	}
 
 	// --- Ledger ---
 	mux.HandleFunc("POST /api/v1/ledger/entries", p.Ledger.PostEntry)
 	mux.HandleFunc("GET /api/v1/ledger/entries/{id}", p.Ledger.GetEntry)
 	mux.HandleFunc("GET /api/v1/ledger/balances/{account_code}", p.Ledger.GetBalance)
+	mux.HandleFunc("GET /api/v1/ledger/trial-balance", p.Ledger.GetTrialBalance)
 
 	// --- Accounts ---
 	mux.HandleFunc("POST /api/v1/accounts", p.Account.OpenAccount)
@@ -44,6 +89,12 @@ func RegisterRoutes(mux *http.ServeMux, p *Proxies) {
 	mux.HandleFunc("POST /api/v1/payments", p.Payment.InitiatePayment)
 	mux.HandleFunc("GET /api/v1/payments/{id}", p.Payment.GetPayment)
 	mux.HandleFunc("GET /api/v1/payments", p.Payment.ListPayments)
+	mux.HandleFunc("POST /api/v1/payments/{id}/reverse", p.Payment.ReversePayment)
+	mux.HandleFunc("POST /api/v1/payment-schedules", p.Payment.SchedulePayment)
+	mux.HandleFunc("GET /api/v1/payment-schedules", p.Payment.ListPaymentSchedules)
+	mux.HandleFunc("POST /api/v1/payment-schedules/{id}/cancel", p.Payment.CancelPaymentSchedule)
+	mux.HandleFunc("POST /api/v1/payment-batches", p.Payment.ImportPaymentBatch)
+	mux.HandleFunc("GET /api/v1/payment-batches/{id}", p.Payment.GetPaymentBatch)
 
 	// --- FX ---
 	mux.HandleFunc("GET /api/v1/fx/rates/{pair}", p.FX.GetRate)
@@ -52,6 +103,24 @@ func RegisterRoutes(mux *http.ServeMux, p *Proxies) {
 	// --- Identity ---
 	mux.HandleFunc("POST /api/v1/identity/verifications", p.Identity.InitiateVerification)
 	mux.HandleFunc("GET /api/v1/identity/verifications/{id}", p.Identity.GetVerification)
+	mux.HandleFunc("GET /api/v1/identity/verifications/{id}/screenings", p.Identity.GetScreeningResults)
+	mux.HandleFunc("GET /api/v1/identity/verifications/{id}/duplicates", p.Identity.GetDuplicateMatches)
+	mux.HandleFunc("POST /api/v1/identity/verifications/{id}/rescreen", p.Identity.RescreenVerification)
+	mux.HandleFunc("POST /api/v1/identity/verifications/{id}/reverify", p.Identity.ReverifyVerification)
+	mux.HandleFunc("POST /api/v1/identity/verifications/{id}/documents", p.Identity.UploadDocument)
+	mux.HandleFunc("GET /api/v1/identity/verifications/{id}/documents", p.Identity.ListDocuments)
+	mux.HandleFunc("GET /api/v1/identity/documents/{id}", p.Identity.GetDocument)
+	mux.HandleFunc("GET /api/v1/identity/documents/{id}/content", p.Identity.GetDocumentContent)
+	mux.HandleFunc("POST /api/v1/identity/businesses", p.Identity.InitiateBusinessVerification)
+	mux.HandleFunc("GET /api/v1/identity/businesses/{id}", p.Identity.GetBusinessVerification)
+	mux.HandleFunc("GET /api/v1/identity/reviews", p.Identity.ListReviewQueue)
+	mux.HandleFunc("GET /api/v1/identity/reviews/metrics", p.Identity.GetReviewMetrics)
+	mux.HandleFunc("GET /api/v1/identity/reviews/{id}", p.Identity.GetReviewCase)
+	mux.HandleFunc("POST /api/v1/identity/reviews/{id}/claim", p.Identity.ClaimReviewCase)
+	mux.HandleFunc("POST /api/v1/identity/reviews/{id}/assign", p.Identity.AssignReviewCase)
+	mux.HandleFunc("POST /api/v1/identity/reviews/{id}/decision", p.Identity.DecideReviewCase)
+	mux.HandleFunc("POST /api/v1/identity/data-subjects/erasure", p.Identity.EraseApplicantData)
+	mux.HandleFunc("POST /api/v1/identity/data-subjects/export", p.Identity.ExportApplicantData)
 
 	// --- Deposits ---
 	mux.HandleFunc("POST /api/v1/deposits/products", p.Deposit.CreateProduct)
@@ -63,6 +132,7 @@ func RegisterRoutes(mux *http.ServeMux, p *Proxies) {
 	mux.HandleFunc("GET /api/v1/cards/{id}", p.Card.GetCard)
 	mux.HandleFunc("POST /api/v1/cards/{id}/freeze", p.Card.FreezeCard)
 	mux.HandleFunc("POST /api/v1/cards/{id}/authorize", p.Card.AuthorizeTransaction)
+	mux.HandleFunc("PUT /api/v1/cards/{id}/spending-controls", p.Card.UpdateSpendingControls)
 
 	// --- Lending ---
 	mux.HandleFunc("POST /api/v1/loans/applications", p.Lending.SubmitApplication)
@@ -73,12 +143,210 @@ func RegisterRoutes(mux *http.ServeMux, p *Proxies) {
 
 	// --- Fraud ---
 	mux.HandleFunc("POST /api/v1/fraud/assessments", p.Fraud.AssessTransaction)
+	mux.HandleFunc("GET /api/v1/fraud/assessments", p.Fraud.ListAssessments)
+	mux.HandleFunc("GET /api/v1/fraud/assessments/metrics", p.Fraud.GetAssessmentMetrics)
 	mux.HandleFunc("GET /api/v1/fraud/assessments/{id}", p.Fraud.GetAssessment)
+	mux.HandleFunc("POST /api/v1/fraud/rules", p.Fraud.CreateRule)
+	mux.HandleFunc("GET /api/v1/fraud/rules", p.Fraud.ListRules)
+	mux.HandleFunc("PUT /api/v1/fraud/rules/{id}", p.Fraud.UpdateRule)
+	mux.HandleFunc("POST /api/v1/fraud/rules/{id}/disable", p.Fraud.DisableRule)
+	mux.HandleFunc("GET /api/v1/fraud/rules/{id}/versions", p.Fraud.ListRuleVersions)
+	mux.HandleFunc("GET /api/v1/fraud/rules/metrics", p.Fraud.GetRuleMetrics)
+	mux.HandleFunc("POST /api/v1/fraud/rules/dry-run", p.Fraud.DryRunRule)
+	mux.HandleFunc("GET /api/v1/fraud/cases", p.Fraud.ListCases)
+	mux.HandleFunc("GET /api/v1/fraud/cases/{id}", p.Fraud.GetCase)
+	mux.HandleFunc("POST /api/v1/fraud/cases/{id}/assign", p.Fraud.AssignCase)
+	mux.HandleFunc("POST /api/v1/fraud/cases/{id}/notes", p.Fraud.AddCaseNote)
+	mux.HandleFunc("POST /api/v1/fraud/cases/{id}/escalate", p.Fraud.EscalateCase)
+	mux.HandleFunc("POST /api/v1/fraud/cases/{id}/resolve", p.Fraud.ResolveCase)
+	mux.HandleFunc("POST /api/v1/fraud/cases/{id}/assessments", p.Fraud.LinkCaseAssessment)
+	mux.HandleFunc("POST /api/v1/fraud/labels/chargebacks", p.Fraud.RecordChargeback)
+	mux.HandleFunc("POST /api/v1/fraud/datasets/export", p.Fraud.ExportTrainingDataset)
+	mux.HandleFunc("GET /api/v1/fraud/transactions/{id}/screenings", p.Fraud.GetScreeningLog)
+	mux.HandleFunc("GET /api/v1/fraud/policy", p.Fraud.GetDecisionPolicy)
+	mux.HandleFunc("PUT /api/v1/fraud/policy", p.Fraud.SetDecisionPolicy)
+	mux.HandleFunc("GET /api/v1/fraud/policy/versions", p.Fraud.ListDecisionPolicyVersions)
+	mux.HandleFunc("POST /api/v1/fraud/policy/simulate", p.Fraud.SimulateDecisionPolicy)
+	mux.HandleFunc("GET /api/v1/fraud/accounts/{id}/links", p.Fraud.GetAccountLinks)
 
 	// --- Reporting ---
 	mux.HandleFunc("POST /api/v1/reports", p.Reporting.GenerateReport)
 	mux.HandleFunc("GET /api/v1/reports/{id}", p.Reporting.GetReport)
 	mux.HandleFunc("POST /api/v1/reports/{id}/submit", p.Reporting.SubmitReport)
+	mux.HandleFunc("POST /api/v1/reports/{id}/acknowledgment", p.Reporting.RecordSubmissionAcknowledgment)
+	mux.HandleFunc("GET /api/v1/reports/{id}/audit", p.Reporting.ListSubmissionAudit)
+	mux.HandleFunc("POST /api/v1/reports/{id}/amendments", p.Reporting.AmendReport)
+	mux.HandleFunc("GET /api/v1/reports/{id}/versions", p.Reporting.ListReportVersions)
+	mux.HandleFunc("GET /api/v1/reports/{id}/diff", p.Reporting.DiffReportVersions)
+	mux.HandleFunc("POST /api/v1/reports/{id}/reviews", p.Reporting.ReviewReport)
+	mux.HandleFunc("GET /api/v1/reports/{id}/reviews", p.Reporting.ListReportReviews)
+	mux.HandleFunc("POST /api/v1/reports/{id}/revise", p.Reporting.ReviseReport)
+	mux.HandleFunc("POST /api/v1/report-jobs", p.Reporting.CreateReportJob)
+	mux.HandleFunc("GET /api/v1/report-jobs", p.Reporting.ListReportJobs)
+	mux.HandleFunc("GET /api/v1/report-jobs/{id}", p.Reporting.GetReportJob)
+	mux.HandleFunc("GET /api/v1/report-jobs/{id}/artifacts/{name}", p.Reporting.GetReportJobArtifact)
+	mux.HandleFunc("PUT /api/v1/reports/schedules", p.Reporting.UpsertReportSchedule)
+	mux.HandleFunc("GET /api/v1/reports/schedules", p.Reporting.ListReportSchedules)
+	mux.HandleFunc("GET /api/v1/reports/schedules/runs", p.Reporting.ListScheduledRuns)
+	mux.HandleFunc("GET /api/v1/reports/liquidity", p.Reporting.GetLiquidityMetrics)
+
+	// --- Management dashboards ---
+	mux.HandleFunc("GET /api/v1/dashboards/balance-sheet", p.Reporting.GetBalanceSheetSummary)
+	mux.HandleFunc("GET /api/v1/dashboards/deposit-growth", p.Reporting.GetDepositGrowth)
+	mux.HandleFunc("GET /api/v1/dashboards/loan-book", p.Reporting.GetLoanBook)
+	mux.HandleFunc("GET /api/v1/dashboards/payment-volumes", p.Reporting.GetPaymentVolumes)
+	mux.HandleFunc("GET /api/v1/dashboards/fraud-decisions", p.Reporting.GetFraudDecisionRates)
+
+	// --- Notifications ---
+	mux.HandleFunc("PUT /api/v1/notifications/templates", p.Notification.UpsertTemplate)
+	mux.HandleFunc("GET /api/v1/notifications/templates", p.Notification.ListTemplates)
+	mux.HandleFunc("PUT /api/v1/notifications/preferences", p.Notification.SetPreferences)
+	mux.HandleFunc("GET /api/v1/notifications/preferences", p.Notification.GetPreferences)
+	mux.HandleFunc("GET /api/v1/notifications", p.Notification.ListNotifications)
+	mux.HandleFunc("GET /api/v1/notifications/{id}", p.Notification.GetNotification)
+
+	// --- Customers ---
+	mux.HandleFunc("POST /api/v1/customers", p.Customer.CreateParty)
+	mux.HandleFunc("GET /api/v1/customers/lookup", p.Customer.FindPartyByRef)
+	mux.HandleFunc("GET /api/v1/customers/{id}", p.Customer.GetParty)
+	mux.HandleFunc("PUT /api/v1/customers/{id}", p.Customer.UpdateParty)
+	mux.HandleFunc("GET /api/v1/customers/{id}/duplicates", p.Customer.FindDuplicates)
+	mux.HandleFunc("POST /api/v1/customers/{id}/merge", p.Customer.MergeParties)
+	mux.HandleFunc("POST /api/v1/customers/{id}/relationships", p.Customer.AddRelationship)
+	mux.HandleFunc("DELETE /api/v1/customers/{id}/relationships/{related_id}", p.Customer.RemoveRelationship)
+	mux.HandleFunc("POST /api/v1/customers/{id}/refs", p.Customer.LinkExternalRef)
+	mux.HandleFunc("PUT /api/v1/customers/{id}/kyc", p.Customer.LinkKYC)
+
+	// --- Tenants ---
+	mux.HandleFunc("POST /api/v1/tenants", p.Tenant.CreateTenant)
+	mux.HandleFunc("GET /api/v1/tenants", p.Tenant.ListTenants)
+	mux.HandleFunc("GET /api/v1/tenants/{id}", p.Tenant.GetTenant)
+	mux.HandleFunc("GET /api/v1/tenants/{id}/config", p.Tenant.GetTenantConfig)
+	mux.HandleFunc("PUT /api/v1/tenants/{id}/settings", p.Tenant.ConfigureTenant)
+	mux.HandleFunc("PUT /api/v1/tenants/{id}/features/{key}", p.Tenant.SetFeatureFlag)
+	mux.HandleFunc("PUT /api/v1/tenants/{id}/limits/{key}", p.Tenant.SetLimit)
+	mux.HandleFunc("DELETE /api/v1/tenants/{id}/limits/{key}", p.Tenant.RemoveLimit)
+	mux.HandleFunc("POST /api/v1/tenants/{id}/suspend", p.Tenant.SuspendTenant)
+	mux.HandleFunc("POST /api/v1/tenants/{id}/reactivate", p.Tenant.ReactivateTenant)
+	mux.HandleFunc("POST /api/v1/tenants/{id}/provision", p.Tenant.ProvisionTenant)
+
+	// --- Scheduler ---
+	mux.HandleFunc("POST /api/v1/scheduler/jobs", p.Scheduler.RegisterJob)
+	mux.HandleFunc("GET /api/v1/scheduler/jobs", p.Scheduler.ListJobs)
+	mux.HandleFunc("GET /api/v1/scheduler/jobs/{id}", p.Scheduler.GetJob)
+	mux.HandleFunc("PUT /api/v1/scheduler/jobs/{id}", p.Scheduler.UpdateJob)
+	mux.HandleFunc("POST /api/v1/scheduler/jobs/{id}/pause", p.Scheduler.PauseJob)
+	mux.HandleFunc("POST /api/v1/scheduler/jobs/{id}/resume", p.Scheduler.ResumeJob)
+	mux.HandleFunc("POST /api/v1/scheduler/jobs/{id}/trigger", p.Scheduler.TriggerJob)
+	mux.HandleFunc("GET /api/v1/scheduler/jobs/{id}/runs", p.Scheduler.ListJobRuns)
+	mux.HandleFunc("GET /api/v1/scheduler/runs/{id}", p.Scheduler.GetJobRun)
+	mux.HandleFunc("POST /api/v1/scheduler/runs/{id}/retry", p.Scheduler.RetryJobRun)
+	mux.HandleFunc("POST /api/v1/scheduler/eod-runs", p.Scheduler.StartEODRun)
+	mux.HandleFunc("GET /api/v1/scheduler/eod-runs", p.Scheduler.ListEODRuns)
+	mux.HandleFunc("GET /api/v1/scheduler/eod-runs/{id}", p.Scheduler.GetEODRun)
+	mux.HandleFunc("POST /api/v1/scheduler/eod-runs/{id}/resume", p.Scheduler.ResumeEODRun)
+	mux.HandleFunc("GET /api/v1/scheduler/clock", p.Scheduler.GetClock)
+	mux.HandleFunc("POST /api/v1/scheduler/clock/advance", p.Scheduler.AdvanceClock)
+
+	// --- Statements ---
+	mux.HandleFunc("POST /api/v1/statements", p.Statement.GenerateStatement)
+	mux.HandleFunc("GET /api/v1/statements", p.Statement.ListStatements)
+	mux.HandleFunc("GET /api/v1/statements/{id}", p.Statement.GetStatement)
+	mux.HandleFunc("GET /api/v1/statements/{id}/content", p.Statement.GetStatementContent)
+	mux.HandleFunc("GET /api/v1/statement-schedules", p.Statement.ListSchedules)
+	mux.HandleFunc("PUT /api/v1/statement-schedules/{customer_id}", p.Statement.UpsertSchedule)
+	mux.HandleFunc("POST /api/v1/statement-schedules/run", p.Statement.RunDueSchedules)
+
+	// --- Documents ---
+	mux.HandleFunc("GET /api/v1/documents", p.Document.ListDocuments)
+	mux.HandleFunc("GET /api/v1/documents/{id}", p.Document.GetDocument)
+	mux.HandleFunc("GET /api/v1/documents/{id}/download-url", p.Document.GetDownloadURL)
+
+	// --- Webhooks ---
+	mux.HandleFunc("POST /api/v1/webhooks/subscriptions", p.Webhooks.CreateSubscription)
+	mux.HandleFunc("GET /api/v1/webhooks/subscriptions", p.Webhooks.ListSubscriptions)
+	mux.HandleFunc("GET /api/v1/webhooks/subscriptions/{id}", p.Webhooks.GetSubscription)
+	mux.HandleFunc("PUT /api/v1/webhooks/subscriptions/{id}", p.Webhooks.UpdateSubscription)
+	mux.HandleFunc("DELETE /api/v1/webhooks/subscriptions/{id}", p.Webhooks.DeleteSubscription)
+	mux.HandleFunc("POST /api/v1/webhooks/subscriptions/{id}/rotate-secret", p.Webhooks.RotateSecret)
+	mux.HandleFunc("GET /api/v1/webhooks/deliveries", p.Webhooks.ListDeliveries)
+	mux.HandleFunc("GET /api/v1/webhooks/deliveries/{id}", p.Webhooks.GetDelivery)
+	mux.HandleFunc("POST /api/v1/webhooks/deliveries/{id}/redeliver", p.Webhooks.Redeliver)
+
+	// --- Treasury ---
+	mux.HandleFunc("POST /api/v1/treasury/nostro-accounts", p.Treasury.CreateNostroAccount)
+	mux.HandleFunc("GET /api/v1/treasury/nostro-accounts", p.Treasury.ListNostroAccounts)
+	mux.HandleFunc("GET /api/v1/treasury/nostro-accounts/{id}", p.Treasury.GetNostroAccount)
+	mux.HandleFunc("PUT /api/v1/treasury/nostro-accounts/{id}", p.Treasury.UpdateNostroAccount)
+	mux.HandleFunc("GET /api/v1/treasury/positions", p.Treasury.ListLiquidityPositions)
+	mux.HandleFunc("GET /api/v1/treasury/positions/{nostro_id}", p.Treasury.GetLiquidityPosition)
+	mux.HandleFunc("POST /api/v1/treasury/forecasts", p.Treasury.CreateForecast)
+	mux.HandleFunc("GET /api/v1/treasury/forecasts", p.Treasury.ListForecasts)
+	mux.HandleFunc("POST /api/v1/treasury/forecasts/{id}/cancel", p.Treasury.CancelForecast)
+	mux.HandleFunc("POST /api/v1/treasury/sweeps", p.Treasury.CreateSweep)
+	mux.HandleFunc("GET /api/v1/treasury/sweeps", p.Treasury.ListSweeps)
+	mux.HandleFunc("PUT /api/v1/treasury/sweeps/{id}", p.Treasury.UpdateSweep)
+	mux.HandleFunc("GET /api/v1/treasury/alerts", p.Treasury.ListAlerts)
+	mux.HandleFunc("POST /api/v1/treasury/evaluate", p.Treasury.EvaluateLiquidity)
+
+	// --- Privacy ---
+	mux.HandleFunc("POST /api/v1/privacy/erasures", p.Privacy.RequestErasure)
+	mux.HandleFunc("GET /api/v1/privacy/erasures", p.Privacy.ListErasureJobs)
+	mux.HandleFunc("GET /api/v1/privacy/erasures/{id}", p.Privacy.GetErasureJob)
+	mux.HandleFunc("POST /api/v1/privacy/erasures/{id}/retry", p.Privacy.RetryErasureJob)
+	mux.HandleFunc("GET /api/v1/privacy/erasures/{id}/certificate", p.Privacy.GetErasureCertificate)
+	mux.HandleFunc("GET /api/v1/privacy/participants", p.Privacy.ListParticipants)
+	mux.HandleFunc("PUT /api/v1/privacy/retention-policies/{category}", p.Privacy.UpsertRetentionPolicy)
+	mux.HandleFunc("GET /api/v1/privacy/retention-policies", p.Privacy.ListRetentionPolicies)
+	mux.HandleFunc("DELETE /api/v1/privacy/retention-policies/{category}", p.Privacy.DeleteRetentionPolicy)
+	mux.HandleFunc("POST /api/v1/privacy/retention/run", p.Privacy.RunRetention)
+
+	// --- Limits ---
+	mux.HandleFunc("POST /api/v1/limits", p.Limits.CreateLimit)
+	mux.HandleFunc("GET /api/v1/limits", p.Limits.ListLimits)
+	mux.HandleFunc("GET /api/v1/limits/{id}", p.Limits.GetLimit)
+	mux.HandleFunc("PUT /api/v1/limits/{id}", p.Limits.UpdateLimit)
+	mux.HandleFunc("POST /api/v1/limits/reservations", p.Limits.CheckAndReserve)
+	mux.HandleFunc("GET /api/v1/limits/reservations", p.Limits.ListReservations)
+	mux.HandleFunc("GET /api/v1/limits/reservations/{id}", p.Limits.GetReservation)
+	mux.HandleFunc("POST /api/v1/limits/reservations/{id}/commit", p.Limits.CommitReservation)
+	mux.HandleFunc("POST /api/v1/limits/reservations/{id}/release", p.Limits.ReleaseReservation)
+	mux.HandleFunc("POST /api/v1/limits/exposure/reduce", p.Limits.ReduceExposure)
+	mux.HandleFunc("GET /api/v1/limits/exposure", p.Limits.GetExposure)
+
+	// --- Pricing ---
+	mux.HandleFunc("POST /api/v1/pricing/schedules", p.Pricing.CreateFeeSchedule)
+	mux.HandleFunc("GET /api/v1/pricing/schedules", p.Pricing.ListFeeSchedules)
+	mux.HandleFunc("GET /api/v1/pricing/schedules/{id}", p.Pricing.GetFeeSchedule)
+	mux.HandleFunc("PUT /api/v1/pricing/schedules/{id}", p.Pricing.ReviseFeeSchedule)
+	mux.HandleFunc("POST /api/v1/pricing/evaluate", p.Pricing.EvaluateFee)
+	mux.HandleFunc("POST /api/v1/pricing/simulate", p.Pricing.SimulateFeeChange)
+
+	// --- Consent ---
+	mux.HandleFunc("POST /api/v1/consent/disclosures", p.Consent.PublishDisclosure)
+	mux.HandleFunc("GET /api/v1/consent/disclosures", p.Consent.ListDisclosures)
+	mux.HandleFunc("POST /api/v1/consent/receipts", p.Consent.RecordConsent)
+	mux.HandleFunc("GET /api/v1/consent/receipts", p.Consent.ListReceipts)
+	mux.HandleFunc("GET /api/v1/consent/receipts/{id}", p.Consent.GetReceipt)
+	mux.HandleFunc("GET /api/v1/consent/check", p.Consent.CheckConsent)
+	mux.HandleFunc("GET /api/v1/consent/consents", p.Consent.ListConsents)
+
+	// --- Month-end Close ---
+	mux.HandleFunc("GET /api/v1/close/periods/{period}", p.Close.GetDashboard)
+	mux.HandleFunc("POST /api/v1/close/periods/{period}/items/{key}/complete", p.Close.CompleteItem)
+	mux.HandleFunc("POST /api/v1/close/periods/{period}/items/{key}/reopen", p.Close.ReopenItem)
+	mux.HandleFunc("POST /api/v1/close/periods/{period}/sign-offs", p.Close.SignOff)
+	mux.HandleFunc("POST /api/v1/close/periods/{period}/close", p.Close.ClosePeriod)
+
+	// --- Open Banking ---
+	mux.HandleFunc("GET /api/v1/open-banking/consents", p.OpenBanking.ListConsents)
+	mux.HandleFunc("GET /api/v1/open-banking/consents/{id}", p.OpenBanking.GetConsent)
+	mux.HandleFunc("POST /api/v1/open-banking/consents/{id}/authorise", p.OpenBanking.AuthoriseConsent)
+	mux.HandleFunc("POST /api/v1/open-banking/consents/{id}/reject", p.OpenBanking.RejectConsent)
+	mux.HandleFunc("POST /api/v1/open-banking/consents/{id}/revoke", p.OpenBanking.RevokeConsent)
+	mux.HandleFunc("GET /api/v1/open-banking/providers", p.OpenBanking.ListProviders)
+	mux.HandleFunc("POST /api/v1/open-banking/providers/{id}/suspend", p.OpenBanking.SuspendProvider)
+	mux.HandleFunc("POST /api/v1/open-banking/providers/{id}/reinstate", p.OpenBanking.ReinstateProvider)
 
 	// --- Partner / Embedded Finance ---
 	if p.Partner != nil {
@@ -90,12 +358,54 @@ func RegisterRoutes(mux *http.ServeMux, p *Proxies) {
 	}
 }
 
+// RegisterBackofficeRoutes registers the operations console's routes on the
+// given ServeMux, which the gateway serves on its backoffice listener.
+func RegisterBackofficeRoutes(mux *http.ServeMux, p *proxy.BackofficeProxy) {
+	// Health
+	mux.HandleFunc("/healthz", healthz)
+	mux.HandleFunc("/readyz", readyz(nil))
+
+	// --- Work queues ---
+	mux.HandleFunc("GET /backoffice/v1/queues", p.GetQueueSummary)
+	mux.HandleFunc("GET /backoffice/v1/tasks", p.ListTasks)
+	mux.HandleFunc("POST /backoffice/v1/tasks", p.OpenTask)
+	mux.HandleFunc("GET /backoffice/v1/tasks/{id}", p.GetTask)
+	mux.HandleFunc("POST /backoffice/v1/tasks/{id}/claim", p.ClaimTask)
+	mux.HandleFunc("POST /backoffice/v1/tasks/{id}/release", p.ReleaseTask)
+	mux.HandleFunc("POST /backoffice/v1/tasks/{id}/notes", p.AddTaskNote)
+	mux.HandleFunc("POST /backoffice/v1/tasks/{id}/resolve", p.ResolveTask)
+
+	// --- Support ---
+	mux.HandleFunc("GET /backoffice/v1/transactions/{id}/timeline", p.GetTransactionTimeline)
+}
+
 func healthz(w http.ResponseWriter, _ *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	_ = json.NewEncoder(w).Encode(map[string]string{"status": "ok"}) //nolint:errcheck
 }
 
-func readyz(w http.ResponseWriter, _ *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
-	_ = json.NewEncoder(w).Encode(map[string]string{"status": "ready"}) //nolint:errcheck
+// readyz reports the gateway ready along with the status of each backend.
+// The status is "degraded" while any backend's circuit breaker is not
+// closed. The gateway stays ready then, as every replica sees the same
+// backends and taking them all out of rotation would fail the calls to the
+// healthy backends too.
+func readyz(backends []*proxy.ServiceConn) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		resp := struct {
+			Backends map[string]proxy.BackendStatus `json:"backends,omitempty"`
+			Status   string                         `json:"status"`
+		}{Status: "ready"}
+		for _, sc := range backends {
+			st := sc.Status()
+			if st.State != proxy.BreakerClosed {
+				resp.Status = "degraded"
+			}
+			if resp.Backends == nil {
+				resp.Backends = make(map[string]proxy.BackendStatus, len(backends))
+			}
+			resp.Backends[sc.Name] = st
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp) //nolint:errcheck
+	}
 }
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"log/slog"
 	"net/http"
@@ -17,16 +18,29 @@ func testProxies() *Proxies {
 	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
 	// Create proxies with nil connections. Health routes don't use proxies.
 	return &Proxies{
-		Account:   proxy.NewAccountProxy(nil, logger),
-		Ledger:    proxy.NewLedgerProxy(nil, logger),
-		Payment:   proxy.NewPaymentProxy(nil, logger),
-		FX:        proxy.NewFXProxy(nil, logger),
-		Identity:  proxy.NewIdentityProxy(nil, logger),
-		Deposit:   proxy.NewDepositProxy(nil, logger),
-		Card:      proxy.NewCardProxy(nil, logger),
-		Lending:   proxy.NewLendingProxy(nil, logger),
-		Fraud:     proxy.NewFraudProxy(nil, logger),
-		Reporting: proxy.NewReportingProxy(nil, logger),
+		Account:      proxy.NewAccountProxy(nil, logger),
+		Ledger:       proxy.NewLedgerProxy(nil, logger),
+		Payment:      proxy.NewPaymentProxy(nil, logger),
+		FX:           proxy.NewFXProxy(nil, logger),
+		Identity:     proxy.NewIdentityProxy(nil, logger),
+		Deposit:      proxy.NewDepositProxy(nil, logger),
+		Card:         proxy.NewCardProxy(nil, logger),
+		Lending:      proxy.NewLendingProxy(nil, logger),
+		Fraud:        proxy.NewFraudProxy(nil, logger),
+		Reporting:    proxy.NewReportingProxy(nil, logger),
+		Notification: proxy.NewNotificationProxy(nil, logger),
+		Customer:     proxy.NewCustomerProxy(nil, logger),
+		Tenant:       proxy.NewTenantProxy(nil, logger),
+		Scheduler:    proxy.NewSchedulerProxy(nil, logger),
+		Statement:    proxy.NewStatementProxy(nil, logger),
+		Webhooks:     proxy.NewWebhooksProxy(nil, logger),
+		Treasury:     proxy.NewTreasuryProxy(nil, logger),
+		Privacy:      proxy.NewPrivacyProxy(nil, logger),
+		Limits:       proxy.NewLimitsProxy(nil, logger),
+		Pricing:      proxy.NewPricingProxy(nil, logger),
+		Consent:      proxy.NewConsentProxy(nil, logger),
+		Close:        proxy.NewCloseProxy(nil, logger),
+		OpenBanking:  proxy.NewOpenBankingProxy(nil, logger),
 	}
 }
 
@@ -72,6 +86,51 @@ func TestReadyz(t *testing.T) {
 	}
 }
 
+func TestReadyz_ReportsBackends(t *testing.T) {
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	up, err := proxy.Dial("ledger-service", "localhost:1", logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer up.Close()
+	// Nothing listens on port 1, so one failed call opens the breaker.
+	down, err := proxy.Dial("account-service", "localhost:1", logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer down.Close()
+	down.SetResilience(proxy.ResiliencePolicy{MaxAttempts: 1, BreakerThreshold: 1})
+	var resp map[string]string
+	_ = down.Invoke(context.Background(), "/bib.account.v1.AccountService/OpenAccount", map[string]string{}, &resp) //nolint:errcheck // failing on purpose
+
+	p := testProxies()
+	p.Backends = []*proxy.ServiceConn{up, down}
+	mux := http.NewServeMux()
+	RegisterRoutes(mux, p)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	var body struct {
+		Backends map[string]proxy.BackendStatus `json:"backends"`
+		Status   string                         `json:"status"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if body.Status != "degraded" {
+		t.Errorf("expected status degraded, got %q", body.Status)
+	}
+	if got := body.Backends["ledger-service"].State; got != proxy.BreakerClosed {
+		t.Errorf("expected ledger-service closed, got %q", got)
+	}
+	if got := body.Backends["account-service"].State; got != proxy.BreakerOpen {
+		t.Errorf("expected account-service open, got %q", got)
+	}
+}
+
 func TestHealthz_ContentType(t *testing.T) {
 	mux := http.NewServeMux()
 	RegisterRoutes(mux, testProxies())
@@ -85,3 +144,43 @@ func TestHealthz_ContentType(t *testing.T) {
 		t.Fatalf("expected Content-Type application/json, got %q", ct)
 	}
 }
+
+func TestBackofficeRoutes(t *testing.T) {
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	mux := http.NewServeMux()
+	RegisterBackofficeRoutes(mux, proxy.NewBackofficeProxy(nil, logger))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	// An unknown queue is rejected before the backend is called.
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/backoffice/v1/tasks?queue=complaints", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+
+	// The console's routes are not served on the platform API.
+	api := http.NewServeMux()
+	RegisterRoutes(api, testProxies())
+	rec = httptest.NewRecorder()
+	api.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/backoffice/v1/queues", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+}
+
+func TestLedgerTrialBalance_RequiresPeriod(t *testing.T) {
+	mux := http.NewServeMux()
+	RegisterRoutes(mux, testProxies())
+
+	// Requests without a period are rejected before the backend is called.
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/ledger/trial-balance", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+}
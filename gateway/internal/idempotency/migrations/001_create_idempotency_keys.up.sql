@@ -0,0 +1,13 @@
+-- Responses to requests carrying an Idempotency-Key, replayed to retries.
+-- status is NULL while the first request is in flight.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key          VARCHAR(320) PRIMARY KEY,
+    request_hash CHAR(64)     NOT NULL,
+    status       INT,
+    content_type VARCHAR(255),
+    body         BYTEA,
+    created_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMPTZ  NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
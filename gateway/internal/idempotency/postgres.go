@@ -0,0 +1,110 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresStore keeps idempotency keys in the idempotency_keys table, so
+// every gateway replica replays the same responses.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore creates a new PostgresStore.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// Reserve implements Store. An expired key is taken over as if it were free,
+// under a new reservation token.
+func (s *PostgresStore) Reserve(ctx context.Context, key, requestHash string, lockTimeout time.Duration) (string, *Entry, error) {
+	now := time.Now().UTC()
+	token := uuid.New()
+	tag, err := s.pool.Exec(ctx, `
+		INSERT INTO idempotency_keys (key, request_hash, lock_token, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash, lock_token = EXCLUDED.lock_token,
+			status = NULL, content_type = NULL, body = NULL,
+			created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at`,
+		key, requestHash, token, now, now.Add(lockTimeout))
+	if err != nil {
+		return "", nil, fmt.Errorf("reserve idempotency key: %w", err)
+	}
+	if tag.RowsAffected() == 1 {
+		return token.String(), nil, nil
+	}
+
+	var (
+		entry       Entry
+		status      *int
+		contentType *string
+		body        []byte
+	)
+	err = s.pool.QueryRow(ctx,
+		`SELECT request_hash, status, content_type, body FROM idempotency_keys WHERE key = $1`, key,
+	).Scan(&entry.RequestHash, &status, &contentType, &body)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Released since the insert conflicted; try again for it.
+		return s.Reserve(ctx, key, requestHash, lockTimeout)
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("find idempotency key: %w", err)
+	}
+	if status != nil {
+		entry.Response = &Response{Status: *status, Body: body}
+		if contentType != nil {
+			entry.Response.ContentType = *contentType
+		}
+	}
+	return "", &entry, nil
+}
+
+// Complete implements Store.
+func (s *PostgresStore) Complete(ctx context.Context, key, token string, resp Response, ttl time.Duration) error {
+	lockToken, err := uuid.Parse(token)
+	if err != nil {
+		// No reservation was made under a malformed token.
+		return nil
+	}
+	_, err = s.pool.Exec(ctx, `
+		UPDATE idempotency_keys SET status = $3, content_type = $4, body = $5, expires_at = $6
+		WHERE key = $1 AND lock_token = $2 AND status IS NULL`,
+		key, lockToken, resp.Status, resp.ContentType, resp.Body, time.Now().UTC().Add(ttl))
+	if err != nil {
+		return fmt.Errorf("complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Release implements Store. Completed keys are kept.
+func (s *PostgresStore) Release(ctx context.Context, key, token string) error {
+	lockToken, err := uuid.Parse(token)
+	if err != nil {
+		// No reservation was made under a malformed token.
+		return nil
+	}
+	_, err = s.pool.Exec(ctx,
+		`DELETE FROM idempotency_keys WHERE key = $1 AND lock_token = $2 AND status IS NULL`, key, lockToken)
+	if err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Purge implements Store.
+func (s *PostgresStore) Purge(ctx context.Context) (int64, error) {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= $1`, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("purge idempotency keys: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
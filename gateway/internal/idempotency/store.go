@@ -0,0 +1,119 @@
+// Package idempotency stores the responses the gateway returns to requests
+// carrying an Idempotency-Key, so that retries of a request replay its
+// original response instead of repeating it.
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Response is a stored response to a request.
+type Response struct {
+	ContentType string
+	Body        []byte
+	Status      int
+}
+
+// Entry is the state stored under an idempotency key: the hash of the
+// request that first used the key and, once it has completed, its response.
+type Entry struct {
+	// Response is nil while the first request is in flight.
+	Response    *Response
+	RequestHash string
+}
+
+// Store keeps idempotency keys with the responses to their requests.
+type Store interface {
+	// Reserve claims key for a request with the given hash until
+	// lockTimeout elapses, after which a retry may run the request again.
+	// When the key was free it returns a token identifying the
+	// reservation; otherwise it returns the entry already stored under it.
+	Reserve(ctx context.Context, key, requestHash string, lockTimeout time.Duration) (string, *Entry, error)
+	// Complete stores the response to the request holding the reservation
+	// token on key, kept for ttl. It does nothing once the reservation has
+	// lapsed and a retry has taken the key over.
+	Complete(ctx context.Context, key, token string, resp Response, ttl time.Duration) error
+	// Release frees a key whose request failed, so a retry runs it again.
+	// Like Complete, it does nothing once the reservation token no longer
+	// holds the key.
+	Release(ctx context.Context, key, token string) error
+	// Purge deletes the expired keys, returning how many were deleted.
+	Purge(ctx context.Context) (int64, error)
+}
+
+type memoryEntry struct {
+	expiresAt time.Time
+	token     string
+	Entry
+}
+
+// MemoryStore keeps idempotency keys in memory. Keys are not shared between
+// gateway replicas, so it suits single-replica deployments and tests.
+type MemoryStore struct {
+	now     func() time.Time
+	entries map[string]*memoryEntry
+	mu      sync.Mutex
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string]*memoryEntry), now: time.Now}
+}
+
+// Reserve implements Store.
+func (s *MemoryStore) Reserve(_ context.Context, key, requestHash string, lockTimeout time.Duration) (string, *Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if e, ok := s.entries[key]; ok && now.Before(e.expiresAt) {
+		entry := e.Entry
+		return "", &entry, nil
+	}
+	token := uuid.NewString()
+	s.entries[key] = &memoryEntry{Entry: Entry{RequestHash: requestHash}, token: token, expiresAt: now.Add(lockTimeout)}
+	return token, nil, nil
+}
+
+// Complete implements Store.
+func (s *MemoryStore) Complete(_ context.Context, key, token string, resp Response, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.entries[key]; ok && e.token == token && e.Response == nil {
+		e.Response = &resp
+		e.expiresAt = s.now().Add(ttl)
+	}
+	return nil
+}
+
+// Release implements Store. Completed keys are kept.
+func (s *MemoryStore) Release(_ context.Context, key, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.entries[key]; ok && e.token == token && e.Response == nil {
+		delete(s.entries, key)
+	}
+	return nil
+}
+
+// Purge implements Store.
+func (s *MemoryStore) Purge(_ context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	var n int64
+	for key, e := range s.entries {
+		if !now.Before(e.expiresAt) {
+			delete(s.entries, key)
+			n++
+		}
+	}
+	return n, nil
+}
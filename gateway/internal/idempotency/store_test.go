@@ -0,0 +1,76 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	s := NewMemoryStore()
+	s.now = func() time.Time { return now }
+
+	token, e, _ := s.Reserve(ctx, "k1", "h1", time.Hour)
+	if token == "" || e != nil {
+		t.Fatalf("Reserve free key = %q, %+v, want a token", token, e)
+	}
+	_, e, _ = s.Reserve(ctx, "k1", "h2", time.Hour)
+	if e == nil || e.RequestHash != "h1" || e.Response != nil {
+		t.Fatalf("Reserve in-flight key = %+v, want h1 without a response", e)
+	}
+
+	_ = s.Complete(ctx, "k1", token, Response{Status: 201, ContentType: "application/json", Body: []byte(`{}`)}, 2*time.Hour)
+	_ = s.Release(ctx, "k1", token)
+	_, e, _ = s.Reserve(ctx, "k1", "h1", time.Hour)
+	if e == nil || e.Response == nil || e.Response.Status != 201 {
+		t.Fatalf("Reserve completed key = %+v, want its response kept", e)
+	}
+
+	token, _, _ = s.Reserve(ctx, "k2", "h1", time.Hour)
+	_ = s.Release(ctx, "k2", token)
+	if _, e, _ := s.Reserve(ctx, "k2", "h1", time.Hour); e != nil {
+		t.Errorf("Reserve released key = %+v, want nil", e)
+	}
+
+	// A reservation lapses after its lock timeout; a response is kept for
+	// its TTL.
+	now = now.Add(time.Hour)
+	if n, _ := s.Purge(ctx); n != 1 {
+		t.Errorf("Purge = %d, want the lapsed reservation", n)
+	}
+	if _, e, _ := s.Reserve(ctx, "k1", "h1", time.Hour); e == nil || e.Response == nil {
+		t.Errorf("Reserve completed key = %+v, want its response kept for its TTL", e)
+	}
+	now = now.Add(time.Hour)
+	if _, e, _ := s.Reserve(ctx, "k1", "h3", time.Hour); e != nil {
+		t.Errorf("Reserve expired key = %+v, want nil", e)
+	}
+}
+
+func TestMemoryStore_LateRequestAfterTakeover(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	s := NewMemoryStore()
+	s.now = func() time.Time { return now }
+
+	late, _, _ := s.Reserve(ctx, "k1", "h1", time.Minute)
+	now = now.Add(2 * time.Minute)
+	retry, e, _ := s.Reserve(ctx, "k1", "h1", time.Minute)
+	if retry == "" || e != nil {
+		t.Fatalf("Reserve lapsed key = %q, %+v, want it taken over", retry, e)
+	}
+
+	// The request that held the lapsed reservation finishes last.
+	_ = s.Complete(ctx, "k1", late, Response{Status: 500}, time.Hour)
+	_ = s.Release(ctx, "k1", late)
+	if _, e, _ := s.Reserve(ctx, "k1", "h1", time.Minute); e == nil || e.Response != nil {
+		t.Fatalf("Reserve after a late request = %+v, want the retry still in flight", e)
+	}
+
+	_ = s.Complete(ctx, "k1", retry, Response{Status: 201}, time.Hour)
+	if _, e, _ := s.Reserve(ctx, "k1", "h1", time.Minute); e == nil || e.Response == nil || e.Response.Status != 201 {
+		t.Errorf("Reserve after the retry completed = %+v, want its response", e)
+	}
+}
@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/gateway/internal/apikey"
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// APIKeyHeader carries the API key a machine client authenticates with.
+const APIKeyHeader = "X-API-Key"
+
+// tokenRenewMargin is how long before it expires a token minted for an API
+// key is replaced, so that backends never receive an expired one.
+const tokenRenewMargin = time.Minute
+
+type apiKeyContextKey struct{}
+
+// APIKeyFromContext retrieves the API key stored by APIKeyMiddleware.
+func APIKeyFromContext(ctx context.Context) (*apikey.Key, bool) {
+	key, ok := ctx.Value(apiKeyContextKey{}).(*apikey.Key)
+	return key, ok
+}
+
+type mintedToken struct {
+	claims *auth.Claims
+	token  string
+}
+
+// APIKeyAuthenticator resolves API keys into the claims of the calls made
+// with them. Backends only accept JWTs, so it also signs a token for each
+// key to forward, reusing it until it is about to expire or the key's roles
+// change.
+type APIKeyAuthenticator struct {
+	keys   *apikey.Manager
+	jwt    *auth.JWTService
+	tokens map[uuid.UUID]mintedToken
+	now    func() time.Time
+	mu     sync.Mutex
+}
+
+// NewAPIKeyAuthenticator creates an APIKeyAuthenticator checking keys with
+// keys and signing tokens with jwt.
+func NewAPIKeyAuthenticator(keys *apikey.Manager, jwt *auth.JWTService) *APIKeyAuthenticator {
+	return &APIKeyAuthenticator{keys: keys, jwt: jwt, tokens: make(map[uuid.UUID]mintedToken), now: time.Now}
+}
+
+// token returns the token to forward for key's calls. Its user is the key.
+func (a *APIKeyAuthenticator) token(key *apikey.Key) (mintedToken, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+	if t, ok := a.tokens[key.ID]; ok && now.Before(t.claims.ExpiresAt.Add(-tokenRenewMargin)) &&
+		t.claims.TenantID == key.TenantID && slices.Equal(t.claims.Roles, key.Roles) {
+		return t, nil
+	}
+	token, claims, err := a.jwt.IssueToken(key.ID, key.TenantID, key.Roles)
+	if err != nil {
+		return mintedToken{}, err
+	}
+	// Forget the tokens of keys no longer in use.
+	for id, t := range a.tokens {
+		if !now.Before(t.claims.ExpiresAt.Time) {
+			delete(a.tokens, id)
+		}
+	}
+	t := mintedToken{token: token, claims: claims}
+	a.tokens[key.ID] = t
+	return t, nil
+}
+
+// Forget drops the token signed for the key id, for a key that was rotated
+// or revoked.
+func (a *APIKeyAuthenticator) Forget(id uuid.UUID) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.tokens, id)
+}
+
+// APIKeyMiddleware authenticates requests carrying an X-API-Key header,
+// which then pass AuthMiddleware without a JWT. Requests without one are
+// left to AuthMiddleware. Behind AuthGuardMiddleware, invalid keys count
+// towards throttling and lockout.
+func APIKeyMiddleware(authenticator *APIKeyAuthenticator, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			secret := r.Header.Get(APIKeyHeader)
+			if secret == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if r.Header.Get("Authorization") != "" {
+				http.Error(w, `{"error":"send either an API key or an authorization header, not both"}`, http.StatusBadRequest)
+				return
+			}
+
+			key, err := authenticator.keys.Authenticate(r.Context(), secret)
+			if errors.Is(err, apikey.ErrInvalidKey) {
+				rejectAuth(w, r, authFailureAPIKey, `{"error":"invalid api key"}`, "")
+				return
+			}
+			if err != nil {
+				// The key may well be valid; do not count a failed attempt.
+				logger.Error("failed to look up api key", "error", err)
+				http.Error(w, `{"error":"authentication unavailable"}`, http.StatusServiceUnavailable)
+				return
+			}
+			minted, err := authenticator.token(key)
+			if err != nil {
+				logger.Error("failed to sign token for api key", "api_key_id", key.ID, "error", err)
+				http.Error(w, `{"error":"authentication unavailable"}`, http.StatusServiceUnavailable)
+				return
+			}
+			if attempt, ok := r.Context().Value(authAttemptKey{}).(*authAttempt); ok {
+				attempt.succeeded(key.ID)
+			}
+
+			ctx := auth.ContextWithClaims(r.Context(), minted.claims)
+			ctx = context.WithValue(ctx, bearerTokenKey{}, minted.token)
+			ctx = context.WithValue(ctx, apiKeyContextKey{}, key)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/gateway/internal/apikey"
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// newAPIKeyChain serves next behind the API key, auth and rate limiting
+// middleware, in the gateway's order, returning it with a key manager.
+func newAPIKeyChain(t *testing.T, rps int, next http.Handler) (http.Handler, *apikey.Manager) {
+	t.Helper()
+	jwtSvc := newTestJWTService()
+	keys := apikey.NewManager(apikey.NewMemoryStore(), time.Hour)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	h := PerClientRateLimitMiddleware(NewPerClientRateLimiter(rps))(next)
+	h = AuthMiddleware(jwtSvc, nil)(h)
+	h = APIKeyMiddleware(NewAPIKeyAuthenticator(keys, jwtSvc), logger)(h)
+	return h, keys
+}
+
+func TestAPIKeyMiddleware_ResolvesClaims(t *testing.T) {
+	var (
+		gotClaims *auth.Claims
+		gotTokens []string
+	)
+	h, keys := newAPIKeyChain(t, 100, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotClaims, _ = auth.ClaimsFromContext(r.Context())
+		token, _ := BearerTokenFromContext(r.Context())
+		gotTokens = append(gotTokens, token)
+		w.WriteHeader(http.StatusOK)
+	}))
+	tenantID := uuid.New()
+	key, secret, err := keys.Create(context.Background(), apikey.CreateParams{TenantID: tenantID, Name: "sync", Roles: []string{auth.RoleAuditor}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/accounts", nil)
+		req.Header.Set(APIKeyHeader, secret)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("request %d: status = %d, want 200: %s", i+1, rec.Code, rec.Body)
+		}
+	}
+	if gotClaims == nil || gotClaims.TenantID != tenantID || gotClaims.UserID != key.ID || !slices.Equal(gotClaims.Roles, []string{auth.RoleAuditor}) {
+		t.Errorf("claims = %+v, want the key's tenant, ID and roles", gotClaims)
+	}
+	// Backends are forwarded a token the gateway signed for the key, reused
+	// while it is fresh.
+	if gotTokens[0] == "" || gotTokens[0] != gotTokens[1] {
+		t.Errorf("forwarded tokens = %q, want one token reused", gotTokens)
+	}
+	claims, err := newTestJWTService().ValidateToken(gotTokens[0])
+	if err != nil || claims.UserID != key.ID {
+		t.Errorf("forwarded token claims = %+v, %v, want the key's", claims, err)
+	}
+}
+
+func TestAPIKeyMiddleware_Rejects(t *testing.T) {
+	h, keys := newAPIKeyChain(t, 100, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	tenantID := uuid.New()
+	key, secret, err := keys.Create(context.Background(), apikey.CreateParams{TenantID: tenantID, Name: "sync"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwtToken, err := newTestJWTService().GenerateToken(uuid.New(), tenantID, []string{auth.RoleCustomer})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		apiKey string
+		bearer string
+		want   int
+	}{
+		{name: "unknown key", apiKey: secret[:len(secret)-2] + "xx", want: http.StatusUnauthorized},
+		{name: "malformed key", apiKey: "secret", want: http.StatusUnauthorized},
+		{name: "key and token", apiKey: secret, bearer: jwtToken, want: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/accounts", nil)
+			req.Header.Set(APIKeyHeader, tt.apiKey)
+			if tt.bearer != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.bearer)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+
+	if err := keys.Revoke(context.Background(), tenantID, key.ID); err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/accounts", nil)
+	req.Header.Set(APIKeyHeader, secret)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("revoked key: status = %d, want 401", rec.Code)
+	}
+}
+
+func TestAPIKeyMiddleware_RateLimitOverride(t *testing.T) {
+	h, keys := newAPIKeyChain(t, 5, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	tenantID := uuid.New()
+	_, limited, _ := keys.Create(context.Background(), apikey.CreateParams{TenantID: tenantID, Name: "batch", RateLimit: 1})
+	_, unlimited, _ := keys.Create(context.Background(), apikey.CreateParams{TenantID: tenantID, Name: "sync"})
+
+	do := func(secret string) int {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/accounts", nil)
+		req.Header.Set(APIKeyHeader, secret)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := do(limited); code != http.StatusOK {
+		t.Fatalf("first call with rate-limited key: status = %d, want 200", code)
+	}
+	if code := do(limited); code != http.StatusTooManyRequests {
+		t.Errorf("second call with rate-limited key: status = %d, want 429", code)
+	}
+	// The tenant's other key has its own bucket, at the default rate.
+	for i := 0; i < 5; i++ {
+		if code := do(unlimited); code != http.StatusOK {
+			t.Fatalf("call %d with default-rate key: status = %d, want 200", i+1, code)
+		}
+	}
+}
+
+func TestAPIKeyAuthenticator_RenewsTokenOnChange(t *testing.T) {
+	keys := apikey.NewManager(apikey.NewMemoryStore(), time.Hour)
+	a := NewAPIKeyAuthenticator(keys, newTestJWTService())
+	key := &apikey.Key{ID: uuid.New(), TenantID: uuid.New(), Roles: []string{auth.RoleAPIClient}}
+
+	first, err := a.token(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := a.token(key); again.token != first.token {
+		t.Error("token was not reused for an unchanged key")
+	}
+
+	key.Roles = []string{auth.RoleAuditor}
+	changed, err := a.token(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed.token == first.token || !slices.Equal(changed.claims.Roles, key.Roles) {
+		t.Errorf("token after a role change has roles %v, want a new token with %v", changed.claims.Roles, key.Roles)
+	}
+
+	a.Forget(key.ID)
+	if forgotten, _ := a.token(key); forgotten.token == changed.token {
+		t.Error("token was reused after Forget")
+	}
+}
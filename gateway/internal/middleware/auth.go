@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -17,7 +18,9 @@ func BearerTokenFromContext(ctx context.Context) (string, bool) {
 }
 
 // AuthMiddleware validates JWT tokens on incoming requests.
-// Requests to paths listed in skipPaths bypass authentication.
+// Requests to paths listed in skipPaths bypass authentication, as do those
+// APIKeyMiddleware authenticated with an API key. Behind
+// AuthGuardMiddleware, failed attempts count towards throttling and lockout.
 func AuthMiddleware(jwtService *auth.JWTService, skipPaths []string) func(http.Handler) http.Handler {
 	skipSet := make(map[string]struct{}, len(skipPaths))
 	for _, p := range skipPaths {
@@ -31,30 +34,51 @@ func AuthMiddleware(jwtService *auth.JWTService, skipPaths []string) func(http.H
 				next.ServeHTTP(w, r)
 				return
 			}
-
-			// Extract Bearer token.
-			authHeader := r.Header.Get("Authorization")
-			if authHeader == "" {
-				http.Error(w, `{"error":"missing authorization header"}`, http.StatusUnauthorized)
-				return
-			}
-			parts := strings.SplitN(authHeader, " ", 2)
-			if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
-				http.Error(w, `{"error":"invalid authorization format"}`, http.StatusUnauthorized)
+			if _, ok := APIKeyFromContext(r.Context()); ok {
+				next.ServeHTTP(w, r)
 				return
 			}
 
-			rawToken := parts[1]
-			claims, err := jwtService.ValidateToken(rawToken)
-			if err != nil {
-				http.Error(w, `{"error":"invalid token"}`, http.StatusUnauthorized)
-				return
+			if r, ok := Authenticate(jwtService, w, r); ok {
+				next.ServeHTTP(w, r)
 			}
-
-			// Add claims and raw token to context for downstream use.
-			ctx := auth.ContextWithClaims(r.Context(), claims)
-			ctx = context.WithValue(ctx, bearerTokenKey{}, rawToken)
-			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
+
+// Authenticate validates the request's Bearer token with jwtService,
+// returning the request with the token's claims and the raw token in its
+// context. When the token is missing or invalid it answers the request
+// itself, as AuthMiddleware does, and returns false.
+func Authenticate(jwtService *auth.JWTService, w http.ResponseWriter, r *http.Request) (*http.Request, bool) {
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		http.Error(w, `{"error":"missing authorization header"}`, http.StatusUnauthorized)
+		return r, false
+	}
+	parts := strings.SplitN(authHeader, " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+		rejectAuth(w, r, authFailureFormat, `{"error":"invalid authorization format"}`, "")
+		return r, false
+	}
+
+	rawToken := parts[1]
+	claims, err := jwtService.ValidateTokenContext(r.Context(), rawToken)
+	if errors.Is(err, auth.ErrRevocationCheck) {
+		// The token may well be valid; do not count a failed attempt.
+		http.Error(w, `{"error":"authentication unavailable"}`, http.StatusServiceUnavailable)
+		return r, false
+	}
+	if err != nil {
+		rejectAuth(w, r, authFailureToken, `{"error":"invalid token"}`, rawToken)
+		return r, false
+	}
+	if attempt, ok := r.Context().Value(authAttemptKey{}).(*authAttempt); ok {
+		attempt.succeeded(claims.UserID)
+	}
+
+	// Add claims and raw token to context for downstream use.
+	ctx := auth.ContextWithClaims(r.Context(), claims)
+	ctx = context.WithValue(ctx, bearerTokenKey{}, rawToken)
+	return r.WithContext(ctx), true
+}
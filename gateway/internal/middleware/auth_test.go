@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -124,3 +126,40 @@ func TestAuthMiddleware_ValidToken(t *testing.T) {
 		t.Fatal("expected claims to be present in context")
 	}
 }
+
+type unavailableRevocations struct{}
+
+func (unavailableRevocations) Revoke(context.Context, string, time.Time) error { return nil }
+func (unavailableRevocations) RevokeOnce(context.Context, string, time.Time) (bool, error) {
+	return false, nil
+}
+func (unavailableRevocations) IsRevoked(context.Context, string) (bool, error) {
+	return false, errors.New("database unavailable")
+}
+
+func TestAuthMiddleware_RevocationCheckUnavailable(t *testing.T) {
+	jwtSvc, err := auth.NewJWTService(auth.JWTConfig{
+		Secret:      "test-secret-key",
+		Expiration:  time.Hour,
+		Revocations: unavailableRevocations{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := jwtSvc.GenerateToken(uuid.New(), uuid.New(), []string{"admin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := AuthMiddleware(jwtSvc, nil)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/accounts", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 when revocations cannot be checked, got %d", rec.Code)
+	}
+}
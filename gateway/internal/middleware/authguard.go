@@ -0,0 +1,389 @@
+package middleware
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/gateway/internal/security"
+)
+
+// Reasons an authentication attempt failed, as reported in security events.
+const (
+	authFailureFormat = "invalid_authorization_format"
+	authFailureToken  = "invalid_token"
+	authFailureAPIKey = "invalid_api_key"
+)
+
+// stepUpHeader tells a client that it must complete a step-up challenge,
+// such as a captcha, with the token issuer before its next attempt.
+const stepUpHeader = "X-Step-Up-Required"
+
+// maxTrackedClients bounds the clients an AuthGuard remembers, so a flood of
+// distinct forged identities cannot exhaust memory.
+const maxTrackedClients = 100_000
+
+// AuthGuardConfig tunes brute-force protection. Zero fields take the
+// defaults noted on each.
+type AuthGuardConfig struct {
+	// Window is how long a failed authentication counts against a client
+	// (15m).
+	Window time.Duration
+	// ThrottleAfter is how many failures a client may make in a window
+	// before it must wait between attempts (3).
+	ThrottleAfter int
+	// BaseDelay is the wait after the first throttled failure; it doubles
+	// with each further failure up to MaxDelay (1s, 30s).
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// StepUpAfter is how many failures in a window make the client complete
+	// a step-up challenge (5).
+	StepUpAfter int
+	// LockoutAfter is how many failures in a window lock the client out for
+	// LockoutDuration (10, 15m).
+	LockoutAfter    int
+	LockoutDuration time.Duration
+}
+
+func (c AuthGuardConfig) withDefaults() AuthGuardConfig {
+	if c.Window <= 0 {
+		c.Window = 15 * time.Minute
+	}
+	if c.ThrottleAfter <= 0 {
+		c.ThrottleAfter = 3
+	}
+	if c.BaseDelay <= 0 {
+		c.BaseDelay = time.Second
+	}
+	if c.MaxDelay <= 0 {
+		c.MaxDelay = 30 * time.Second
+	}
+	if c.StepUpAfter <= 0 {
+		c.StepUpAfter = 5
+	}
+	if c.LockoutAfter <= 0 {
+		c.LockoutAfter = 10
+	}
+	if c.LockoutDuration <= 0 {
+		c.LockoutDuration = 15 * time.Minute
+	}
+	return c
+}
+
+// authRecord is the failure history of one client in the current window.
+type authRecord struct {
+	windowStart time.Time
+	// retryAt is when a throttled or locked-out client may try again.
+	retryAt  time.Time
+	failures int
+	locked   bool
+	stepUp   bool
+}
+
+// authVerdict is what a client must do before its next attempt.
+type authVerdict struct {
+	retryAfter time.Duration
+	locked     bool
+	stepUp     bool
+}
+
+// AuthGuard tracks failed authentications per client IP and per claimed
+// user. Past each threshold a client is throttled with growing delays, then
+// asked to complete a step-up challenge, then locked out for a while. A
+// security event is published when a client reaches the step-up or lockout
+// threshold, for the fraud service to correlate with transaction risk.
+//
+// A locked-out IP address is refused outright. A locked-out user is only
+// refused on failed attempts: the user is read from tokens that failed
+// validation, so anyone could forge the claim, and doing so must not lock
+// the real user out.
+type AuthGuard struct {
+	now       func() time.Time
+	publisher security.Publisher
+	logger    *slog.Logger
+	records   map[string]*authRecord
+	cfg       AuthGuardConfig
+	mu        sync.Mutex
+}
+
+// NewAuthGuard creates an AuthGuard publishing to publisher. A nil
+// publisher discards events.
+func NewAuthGuard(cfg AuthGuardConfig, publisher security.Publisher, logger *slog.Logger) *AuthGuard {
+	if publisher == nil {
+		publisher = security.NoopPublisher{}
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AuthGuard{
+		now:       time.Now,
+		publisher: publisher,
+		logger:    logger,
+		records:   make(map[string]*authRecord),
+		cfg:       cfg.withDefaults(),
+	}
+}
+
+// blocked returns how long the client with key must wait before trying
+// again, and whether it is locked out.
+func (g *AuthGuard) blocked(key string) (time.Duration, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	rec, ok := g.records[key]
+	if !ok {
+		return 0, false
+	}
+	wait := rec.retryAt.Sub(g.now())
+	if wait <= 0 {
+		return 0, false
+	}
+	return wait, rec.locked
+}
+
+// fail records a failed attempt by the client with key and returns the
+// verdict, along with any event types for thresholds it crossed.
+func (g *AuthGuard) fail(key string) (authRecord, authVerdict, []string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := g.now()
+
+	rec, ok := g.records[key]
+	if ok && g.expired(rec, now) {
+		delete(g.records, key)
+		ok = false
+	}
+	if !ok {
+		if len(g.records) >= maxTrackedClients {
+			g.sweep(now)
+			if len(g.records) >= maxTrackedClients {
+				g.logger.Warn("auth guard is tracking too many clients, not tracking new ones", "tracked", len(g.records))
+				return authRecord{}, authVerdict{}, nil
+			}
+		}
+		rec = &authRecord{windowStart: now}
+		g.records[key] = rec
+	}
+
+	rec.failures++
+	var crossed []string
+	if rec.failures >= g.cfg.StepUpAfter && !rec.stepUp {
+		rec.stepUp = true
+		crossed = append(crossed, security.EventAuthStepUpRequired)
+	}
+	switch {
+	case rec.locked:
+	case rec.failures >= g.cfg.LockoutAfter:
+		rec.locked = true
+		rec.retryAt = now.Add(g.cfg.LockoutDuration)
+		crossed = append(crossed, security.EventAuthLockedOut)
+	case rec.failures > g.cfg.ThrottleAfter:
+		rec.retryAt = now.Add(g.delay(rec.failures - g.cfg.ThrottleAfter))
+	}
+
+	return *rec, authVerdict{
+		retryAfter: max(rec.retryAt.Sub(now), 0),
+		locked:     rec.locked,
+		stepUp:     rec.stepUp,
+	}, crossed
+}
+
+// succeed forgets the failures of the client with key.
+func (g *AuthGuard) succeed(key string) {
+	g.mu.Lock()
+	delete(g.records, key)
+	g.mu.Unlock()
+}
+
+// delay is the wait after the nth throttled failure.
+func (g *AuthGuard) delay(n int) time.Duration {
+	d := g.cfg.BaseDelay
+	for i := 1; i < n && d < g.cfg.MaxDelay; i++ {
+		d *= 2
+	}
+	return min(d, g.cfg.MaxDelay)
+}
+
+// expired reports whether rec's window has closed and any lockout ended.
+func (g *AuthGuard) expired(rec *authRecord, now time.Time) bool {
+	if now.Before(rec.retryAt) {
+		return false
+	}
+	return rec.locked || now.Sub(rec.windowStart) >= g.cfg.Window
+}
+
+// sweep drops expired records. The caller holds g.mu.
+func (g *AuthGuard) sweep(now time.Time) {
+	for key, rec := range g.records {
+		if g.expired(rec, now) {
+			delete(g.records, key)
+		}
+	}
+}
+
+// trackedClient is a client whose failures an AuthGuard counts: an IP
+// address or a user, as named by security.AggregateClientIP and
+// security.AggregateUser.
+type trackedClient struct {
+	aggregateType string
+	id            string
+}
+
+func (c trackedClient) key() string { return c.aggregateType + ":" + c.id }
+
+// authAttempt is an authentication attempt the AuthGuard is watching. It
+// travels in the request context from AuthGuardMiddleware to AuthMiddleware,
+// which reports the outcome.
+type authAttempt struct {
+	guard     *AuthGuard
+	ip        string
+	userAgent string
+}
+
+type authAttemptKey struct{}
+
+// AuthGuardMiddleware refuses clients locked out or throttled by guard and
+// watches the outcome of everyone else's authentication. It must wrap
+// AuthMiddleware.
+func AuthGuardMiddleware(guard *AuthGuard) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := remoteIP(r)
+			if wait, locked := guard.blocked(trackedClient{security.AggregateClientIP, ip}.key()); wait > 0 {
+				writeAuthBlocked(w, wait, locked)
+				return
+			}
+			attempt := &authAttempt{guard: guard, ip: ip, userAgent: r.UserAgent()}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), authAttemptKey{}, attempt)))
+		})
+	}
+}
+
+// rejectAuth answers a failed authentication. When the request is watched
+// by an AuthGuard the failure is recorded against the client IP and the
+// user the token claims, and the response asks for step-up or tells the
+// client to wait once it has failed too often.
+func rejectAuth(w http.ResponseWriter, r *http.Request, reason, body, rawToken string) {
+	attempt, ok := r.Context().Value(authAttemptKey{}).(*authAttempt)
+	if !ok {
+		http.Error(w, body, http.StatusUnauthorized)
+		return
+	}
+
+	verdict := attempt.record(reason, rawToken)
+	if verdict.stepUp {
+		w.Header().Set(stepUpHeader, "captcha")
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token", error_description="step-up authentication required"`)
+	}
+	if verdict.retryAfter > 0 {
+		writeAuthBlocked(w, verdict.retryAfter, verdict.locked)
+		return
+	}
+	http.Error(w, body, http.StatusUnauthorized)
+}
+
+// record records a failed attempt against the client's IP address and the
+// claimed user, publishes events for thresholds crossed, and returns the
+// stricter of the two verdicts.
+func (a *authAttempt) record(reason, rawToken string) authVerdict {
+	userID, tenantID := unverifiedClaims(rawToken)
+	clients := []trackedClient{{security.AggregateClientIP, a.ip}}
+	if userID != "" {
+		clients = append(clients, trackedClient{security.AggregateUser, userID})
+	}
+
+	var verdict authVerdict
+	for _, c := range clients {
+		rec, v, crossed := a.guard.fail(c.key())
+		verdict.retryAfter = max(verdict.retryAfter, v.retryAfter)
+		verdict.locked = verdict.locked || v.locked
+		verdict.stepUp = verdict.stepUp || v.stepUp
+
+		for _, eventType := range crossed {
+			evt := security.NewAuthEvent(eventType, c.aggregateType, c.id, tenantID)
+			evt.ClientIP = a.ip
+			evt.UserID = userID
+			evt.Reason = reason
+			evt.UserAgent = a.userAgent
+			evt.Failures = rec.failures
+			if eventType == security.EventAuthLockedOut {
+				lockedUntil := rec.retryAt.UTC()
+				evt.LockedUntil = &lockedUntil
+			}
+			a.guard.publish(evt)
+		}
+	}
+	return verdict
+}
+
+// succeeded forgets the failed attempts of the user a valid token names.
+func (a *authAttempt) succeeded(userID uuid.UUID) {
+	a.guard.succeed(trackedClient{security.AggregateUser, userID.String()}.key())
+}
+
+// publish sends evt in the background, so that a slow or unavailable broker
+// never delays a response.
+func (g *AuthGuard) publish(evt security.AuthEvent) {
+	g.logger.Warn("authentication threshold reached",
+		"event_type", evt.EventType(),
+		"client", evt.AggregateType(),
+		"client_ip", evt.ClientIP,
+		"user_id", evt.UserID,
+		"failures", evt.Failures,
+	)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := g.publisher.Publish(ctx, evt); err != nil {
+			g.logger.Error("failed to publish security event", "event_type", evt.EventType(), "error", err)
+		}
+	}()
+}
+
+// writeAuthBlocked refuses a throttled or locked-out client.
+func writeAuthBlocked(w http.ResponseWriter, wait time.Duration, locked bool) {
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	w.Header().Set("Content-Type", "application/json")
+	if locked {
+		http.Error(w, `{"error":"temporarily locked out after repeated failed authentication"}`, http.StatusTooManyRequests)
+		return
+	}
+	http.Error(w, `{"error":"too many failed authentication attempts"}`, http.StatusTooManyRequests)
+}
+
+// unverifiedClaims reads the user and tenant IDs from a JWT without
+// verifying it. They identify whom a failed attempt targeted and must not
+// be trusted for anything else.
+func unverifiedClaims(rawToken string) (userID, tenantID string) {
+	parts := strings.Split(rawToken, ".")
+	if len(parts) != 3 || len(parts[1]) > 4096 {
+		return "", ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", ""
+	}
+	var claims struct {
+		UserID   string `json:"user_id"`
+		TenantID string `json:"tenant_id"`
+	}
+	if json.Unmarshal(payload, &claims) != nil {
+		return "", ""
+	}
+	// Only well-formed IDs are tracked, so junk claims cannot pose as users.
+	if id, err := uuid.Parse(claims.UserID); err == nil {
+		userID = id.String()
+	}
+	if id, err := uuid.Parse(claims.TenantID); err == nil {
+		tenantID = id.String()
+	}
+	return userID, tenantID
+}
@@ -0,0 +1,228 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/gateway/internal/security"
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+type recordingPublisher struct {
+	events chan security.AuthEvent
+}
+
+func newRecordingPublisher() *recordingPublisher {
+	return &recordingPublisher{events: make(chan security.AuthEvent, 16)}
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, evt security.AuthEvent) error {
+	p.events <- evt
+	return nil
+}
+
+func (p *recordingPublisher) next(t *testing.T) security.AuthEvent {
+	t.Helper()
+	select {
+	case evt := <-p.events:
+		return evt
+	case <-time.After(time.Second):
+		t.Fatal("expected a security event")
+		return security.AuthEvent{}
+	}
+}
+
+// guardedHandler is the gateway's auth chain with a fixed clock.
+func guardedHandler(cfg AuthGuardConfig, pub security.Publisher) (http.Handler, *AuthGuard, *time.Time) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	guard := NewAuthGuard(cfg, pub, nil)
+	guard.now = func() time.Time { return now }
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	return AuthGuardMiddleware(guard)(AuthMiddleware(newTestJWTService(), nil)(ok)), guard, &now
+}
+
+func send(h http.Handler, remoteAddr, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/accounts", nil)
+	req.RemoteAddr = remoteAddr
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+// forgedToken is a well-formed token for userID signed with the wrong key.
+func forgedToken(t *testing.T, userID uuid.UUID) string {
+	t.Helper()
+	other, err := auth.NewJWTService(auth.JWTConfig{Secret: "wrong-secret", Issuer: "test", Expiration: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := other.GenerateToken(userID, uuid.New(), []string{"admin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestAuthGuard_ThrottlesWithGrowingDelay(t *testing.T) {
+	h, _, now := guardedHandler(AuthGuardConfig{ThrottleAfter: 2, BaseDelay: time.Second, MaxDelay: 4 * time.Second}, nil)
+	const addr = "198.51.100.7:4000"
+
+	for i := 0; i < 2; i++ {
+		if rec := send(h, addr, "garbage"); rec.Code != http.StatusUnauthorized {
+			t.Fatalf("failure %d: expected 401, got %d", i+1, rec.Code)
+		}
+	}
+
+	for _, want := range []string{"1", "2", "4", "4"} {
+		rec := send(h, addr, "garbage")
+		if rec.Code != http.StatusTooManyRequests {
+			t.Fatalf("expected 429 once throttled, got %d", rec.Code)
+		}
+		if got := rec.Header().Get("Retry-After"); got != want {
+			t.Fatalf("expected Retry-After %s, got %s", want, got)
+		}
+		if rec := send(h, addr, "garbage"); rec.Code != http.StatusTooManyRequests {
+			t.Fatalf("expected 429 while waiting, got %d", rec.Code)
+		}
+		*now = now.Add(5 * time.Second)
+	}
+
+	// Other clients are unaffected.
+	if rec := send(h, "198.51.100.8:4000", "garbage"); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for another client, got %d", rec.Code)
+	}
+}
+
+func TestAuthGuard_RequiresStepUp(t *testing.T) {
+	pub := newRecordingPublisher()
+	h, _, now := guardedHandler(AuthGuardConfig{ThrottleAfter: 100, StepUpAfter: 3}, pub)
+	const addr = "198.51.100.7:4000"
+
+	for i := 0; i < 2; i++ {
+		if rec := send(h, addr, "garbage"); rec.Header().Get(stepUpHeader) != "" {
+			t.Fatalf("failure %d: step-up required too early", i+1)
+		}
+	}
+	rec := send(h, addr, "garbage")
+	if rec.Code != http.StatusUnauthorized || rec.Header().Get(stepUpHeader) != "captcha" {
+		t.Fatalf("expected 401 requiring step-up, got %d with %q", rec.Code, rec.Header().Get(stepUpHeader))
+	}
+
+	evt := pub.next(t)
+	if evt.EventType() != security.EventAuthStepUpRequired || evt.AggregateType() != security.AggregateClientIP {
+		t.Fatalf("unexpected event %s for %s", evt.EventType(), evt.AggregateType())
+	}
+	if evt.ClientIP != "198.51.100.7" || evt.Failures != 3 || evt.Reason != authFailureToken {
+		t.Fatalf("unexpected event %+v", evt)
+	}
+
+	// The requirement lasts for the window.
+	*now = now.Add(time.Minute)
+	if rec := send(h, addr, "garbage"); rec.Header().Get(stepUpHeader) != "captcha" {
+		t.Fatal("expected step-up to still be required")
+	}
+}
+
+func TestAuthGuard_LocksOutIP(t *testing.T) {
+	pub := newRecordingPublisher()
+	h, _, now := guardedHandler(AuthGuardConfig{ThrottleAfter: 100, StepUpAfter: 100, LockoutAfter: 3, LockoutDuration: 10 * time.Minute}, pub)
+	const addr = "198.51.100.7:4000"
+
+	for i := 0; i < 2; i++ {
+		send(h, addr, "garbage")
+	}
+	rec := send(h, addr, "garbage")
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") != "600" {
+		t.Fatalf("expected lockout for 600s, got %d with Retry-After %q", rec.Code, rec.Header().Get("Retry-After"))
+	}
+	evt := pub.next(t)
+	if evt.EventType() != security.EventAuthLockedOut || evt.LockedUntil == nil || !evt.LockedUntil.Equal(now.Add(10*time.Minute)) {
+		t.Fatalf("unexpected lockout event %+v", evt)
+	}
+
+	// A locked-out IP is refused even with a valid token.
+	valid, err := newTestJWTService().GenerateToken(uuid.New(), uuid.New(), []string{"admin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec := send(h, addr, valid); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429 while locked out, got %d", rec.Code)
+	}
+
+	*now = now.Add(10 * time.Minute)
+	if rec := send(h, addr, valid); rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 after lockout, got %d", rec.Code)
+	}
+	if rec := send(h, addr, "garbage"); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected a fresh window after lockout, got %d", rec.Code)
+	}
+}
+
+func TestAuthGuard_UserLockoutOnlyRefusesFailures(t *testing.T) {
+	pub := newRecordingPublisher()
+	h, _, _ := guardedHandler(AuthGuardConfig{ThrottleAfter: 100, StepUpAfter: 100, LockoutAfter: 3}, pub)
+	userID := uuid.New()
+	forged := forgedToken(t, userID)
+
+	// Attempts for one user spread over several IPs.
+	var last *httptest.ResponseRecorder
+	for _, addr := range []string{"198.51.100.1:1", "198.51.100.2:1", "198.51.100.3:1"} {
+		last = send(h, addr, forged)
+	}
+	if last.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected the user's failed attempts to be locked out, got %d", last.Code)
+	}
+	evt := pub.next(t)
+	if evt.AggregateType() != security.AggregateUser || evt.AggregateID() != userID.String() || evt.UserID != userID.String() {
+		t.Fatalf("unexpected event %+v", evt)
+	}
+
+	// The real user, with a valid token, is not locked out.
+	valid, err := newTestJWTService().GenerateToken(userID, uuid.New(), []string{"admin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec := send(h, "203.0.113.9:1", valid); rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 for the real user, got %d", rec.Code)
+	}
+}
+
+func TestAuthGuard_SuccessResetsUser(t *testing.T) {
+	h, guard, _ := guardedHandler(AuthGuardConfig{ThrottleAfter: 100, StepUpAfter: 2}, nil)
+	userID := uuid.New()
+
+	send(h, "198.51.100.1:1", forgedToken(t, userID))
+	valid, err := newTestJWTService().GenerateToken(userID, uuid.New(), []string{"admin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	send(h, "198.51.100.1:1", valid)
+
+	rec := send(h, "198.51.100.2:1", forgedToken(t, userID))
+	if rec.Header().Get(stepUpHeader) != "" {
+		t.Fatal("expected the user's failures to be forgotten after a valid token")
+	}
+	if _, ok := guard.records["user:"+userID.String()]; !ok {
+		t.Fatal("expected the new failure to be tracked")
+	}
+}
+
+func TestUnverifiedClaims(t *testing.T) {
+	userID := uuid.New()
+	if got, _ := unverifiedClaims(forgedToken(t, userID)); got != userID.String() {
+		t.Fatalf("expected user %s, got %q", userID, got)
+	}
+	for _, token := range []string{"", "garbage", "a.b.c", "a.eyJ1c2VyX2lkIjoiYWRtaW4ifQ.c"} {
+		if got, _ := unverifiedClaims(token); got != "" {
+			t.Fatalf("expected no user from %q, got %q", token, got)
+		}
+	}
+}
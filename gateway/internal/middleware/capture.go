@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/capture"
+)
+
+// captureWriter wraps http.ResponseWriter to keep the status code and the
+// start of the body.
+type captureWriter struct {
+	http.ResponseWriter
+	body       bytes.Buffer
+	max        int
+	statusCode int
+	truncated  bool
+}
+
+func (cw *captureWriter) WriteHeader(code int) {
+	if cw.statusCode == 0 {
+		cw.statusCode = code
+	}
+	cw.ResponseWriter.WriteHeader(code)
+}
+
+func (cw *captureWriter) Write(b []byte) (int, error) {
+	if cw.statusCode == 0 {
+		cw.statusCode = http.StatusOK
+	}
+	room := cw.max - cw.body.Len()
+	if len(b) > room {
+		cw.truncated = true
+	}
+	cw.body.Write(b[:min(max(room, 0), len(b))])
+	return cw.ResponseWriter.Write(b)
+}
+
+// CaptureMiddleware records the requests policy selects, with their
+// responses, sanitized by sanitizer, for replay with bib-replay. It must run
+// after AuthMiddleware, which identifies the tenant. Requests the policy
+// does not select pass straight through.
+func CaptureMiddleware(rec *capture.Recorder, policy capture.Policy, sanitizer capture.Sanitizer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if rec == nil || !policy.Enabled() {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var tenantID string
+			if claims, ok := auth.ClaimsFromContext(r.Context()); ok {
+				tenantID = claims.TenantID.String()
+			}
+			if !policy.Matches(tenantID, r.Method, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Read as much of the body as is kept, then hand the handler
+			// that part followed by the rest.
+			maxBody := sanitizer.MaxBodyBytes()
+			var reqBody []byte
+			if r.Body != nil {
+				reqBody, _ = io.ReadAll(io.LimitReader(r.Body, int64(maxBody)+1)) //nolint:errcheck // the handler sees the same error
+				r.Body = struct {
+					io.Reader
+					io.Closer
+				}{io.MultiReader(bytes.NewReader(reqBody), r.Body), r.Body}
+			}
+			reqTruncated := len(reqBody) > maxBody
+			if reqTruncated {
+				reqBody = reqBody[:maxBody]
+			}
+
+			start := time.Now()
+			cw := &captureWriter{ResponseWriter: w, max: maxBody}
+			next.ServeHTTP(cw, r)
+			if cw.statusCode == 0 {
+				cw.statusCode = http.StatusOK
+			}
+
+			rec.Record(capture.Record{
+				CapturedAt: start.UTC(),
+				TenantID:   tenantID,
+				Method:     r.Method,
+				Path:       r.URL.Path,
+				Query:      sanitizer.Query(r.URL.Query()),
+				Request:    sanitizer.Message(r.Header, reqBody, reqTruncated),
+				Response:   sanitizer.Message(w.Header(), cw.body.Bytes(), cw.truncated),
+				Status:     cw.statusCode,
+				DurationMS: time.Since(start).Milliseconds(),
+			})
+		})
+	}
+}
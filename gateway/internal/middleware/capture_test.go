@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/archive"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/capture"
+)
+
+func TestCaptureMiddleware(t *testing.T) {
+	dir := t.TempDir()
+	rec := capture.NewRecorder(archive.NewFileStore(dir), capture.RecorderConfig{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	tenantID := uuid.New()
+	policy := capture.Policy{Tenants: []string{tenantID.String()}}
+
+	var gotBody string
+	handler := CaptureMiddleware(rec, policy, capture.Sanitizer{})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		gotBody = string(b)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"payment_id":"p-1","beneficiary_iban":"DE89370400440532013000"}`))
+	}))
+
+	serve := func(tenant uuid.UUID) *httptest.ResponseRecorder {
+		body := `{"amount":"10.00","password":"hunter2"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/payments", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer token")
+		req = req.WithContext(auth.ContextWithClaims(context.Background(), &auth.Claims{TenantID: tenant}))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		if gotBody != body {
+			t.Errorf("handler read %q, want %q", gotBody, body)
+		}
+		return w
+	}
+	if w := serve(tenantID); w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want 201", w.Code)
+	}
+	serve(uuid.New())
+
+	if err := rec.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	keys, _ := filepath.Glob(filepath.Join(dir, "captures", "*", "*", "*", "*.jsonl.gz"))
+	if len(keys) != 1 {
+		t.Fatalf("objects = %v, want 1", keys)
+	}
+	f, err := os.Open(keys[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	records, err := capture.ReadRecords(f)
+	if err != nil || len(records) != 1 {
+		t.Fatalf("records = %v, %v; want only the selected tenant's request", records, err)
+	}
+	r := records[0]
+	if r.TenantID != tenantID.String() || r.Status != http.StatusCreated || r.Path != "/api/v1/payments" {
+		t.Errorf("record = %+v", r)
+	}
+	if r.Request.Header.Get("Authorization") != "" {
+		t.Error("Authorization header captured")
+	}
+	if got := string(r.Request.Body); got != `{"amount":"10.00","password":"[REDACTED]"}` {
+		t.Errorf("request body = %s", got)
+	}
+	if got := string(r.Response.Body); got != `{"beneficiary_iban":"[REDACTED]","payment_id":"p-1"}` {
+		t.Errorf("response body = %s", got)
+	}
+}
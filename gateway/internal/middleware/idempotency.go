@@ -0,0 +1,203 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/bibbank/bib/gateway/internal/idempotency"
+)
+
+const (
+	// maxIdempotencyKeyLength is the longest Idempotency-Key accepted.
+	maxIdempotencyKeyLength = 255
+	// defaultIdempotencyLockTimeout is how long a request holds its key
+	// unless IdempotencyConfig.LockTimeout is set.
+	defaultIdempotencyLockTimeout = time.Minute
+	// defaultIdempotencyMaxBody is the largest request body accepted unless
+	// IdempotencyConfig.MaxBodyBytes is set.
+	defaultIdempotencyMaxBody = 1 << 20
+)
+
+type idempotencyKeyKey struct{}
+
+// IdempotencyKeyFromContext retrieves the Idempotency-Key header stored by
+// IdempotencyKeyMiddleware.
+func IdempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyKey{}).(string)
+	return key, ok
+}
+
+// IdempotencyKeyMiddleware stores the request's Idempotency-Key header in the
+// context so proxies can forward it to backend services, which replay the
+// first response to retries carrying the same key.
+func IdempotencyKeyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if key := r.Header.Get("Idempotency-Key"); key != "" {
+			r = r.WithContext(context.WithValue(r.Context(), idempotencyKeyKey{}, key))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// IdempotencyConfig configures IdempotencyMiddleware.
+type IdempotencyConfig struct {
+	// Routes are the "METHOD path" pairs whose responses are stored, such
+	// as "POST /api/v1/payments".
+	Routes []string
+	// TTL is how long a response is replayed to retries.
+	TTL time.Duration
+	// LockTimeout is how long a request holds its key before a retry may
+	// run it again, covering a gateway that stopped mid-request. Defaults
+	// to one minute.
+	LockTimeout time.Duration
+	// MaxBodyBytes is the largest request body read to hash the request;
+	// larger requests are rejected. Defaults to 1 MiB.
+	MaxBodyBytes int64
+}
+
+// recordingWriter wraps http.ResponseWriter to keep the status code and the
+// body.
+type recordingWriter struct {
+	http.ResponseWriter
+	body       bytes.Buffer
+	statusCode int
+}
+
+func (rw *recordingWriter) WriteHeader(code int) {
+	if rw.statusCode == 0 {
+		rw.statusCode = code
+	}
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *recordingWriter) Write(b []byte) (int, error) {
+	if rw.statusCode == 0 {
+		rw.statusCode = http.StatusOK
+	}
+	rw.body.Write(b)
+	return rw.ResponseWriter.Write(b)
+}
+
+// IdempotencyMiddleware replays the stored response to retries of requests
+// to cfg's routes carrying an Idempotency-Key, so a retried POST returns the
+// original result instead of creating a duplicate. Keys are scoped to the
+// client, and a key reused with a different request is rejected. Server
+// errors are not stored, so the request can be retried. A request holds its
+// key only for cfg.LockTimeout, so one that never completes does not block
+// its retries for the whole TTL. It must run after AuthMiddleware, which
+// identifies the client.
+//
+// When the store cannot be reached, requests pass through: backend services
+// still deduplicate them by the forwarded key.
+func IdempotencyMiddleware(store idempotency.Store, cfg IdempotencyConfig, logger *slog.Logger) func(http.Handler) http.Handler {
+	routes := make(map[string]bool, len(cfg.Routes))
+	for _, route := range cfg.Routes {
+		routes[route] = true
+	}
+	if cfg.LockTimeout <= 0 {
+		cfg.LockTimeout = defaultIdempotencyLockTimeout
+	}
+	if cfg.MaxBodyBytes <= 0 {
+		cfg.MaxBodyBytes = defaultIdempotencyMaxBody
+	}
+	return func(next http.Handler) http.Handler {
+		if store == nil || len(routes) == 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get("Idempotency-Key")
+			if key == "" || !routes[r.Method+" "+r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				w.Header().Set("Content-Type", "application/json")
+				http.Error(w, `{"error":"Idempotency-Key is too long"}`, http.StatusBadRequest)
+				return
+			}
+
+			var body []byte
+			if r.Body != nil {
+				var err error
+				if body, err = io.ReadAll(http.MaxBytesReader(w, r.Body, cfg.MaxBodyBytes)); err != nil {
+					w.Header().Set("Content-Type", "application/json")
+					var maxErr *http.MaxBytesError
+					if errors.As(err, &maxErr) {
+						http.Error(w, `{"error":"request body is too large"}`, http.StatusRequestEntityTooLarge)
+						return
+					}
+					http.Error(w, `{"error":"failed to read request body"}`, http.StatusBadRequest)
+					return
+				}
+				r.Body = io.NopCloser(bytes.NewReader(body))
+			}
+			hash := requestHash(r, body)
+			storeKey := clientKey(r) + ":" + key
+
+			token, entry, err := store.Reserve(r.Context(), storeKey, hash, cfg.LockTimeout)
+			if err != nil {
+				logger.Warn("idempotency store unavailable, not deduplicating request", "path", r.URL.Path, "error", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+			if entry != nil {
+				switch {
+				case entry.RequestHash != hash:
+					w.Header().Set("Content-Type", "application/json")
+					http.Error(w, `{"error":"Idempotency-Key was already used with a different request"}`, http.StatusUnprocessableEntity)
+				case entry.Response == nil:
+					w.Header().Set("Content-Type", "application/json")
+					http.Error(w, `{"error":"a request with this Idempotency-Key is in progress"}`, http.StatusConflict)
+				default:
+					replay(w, *entry.Response)
+				}
+				return
+			}
+
+			rw := &recordingWriter{ResponseWriter: w}
+			next.ServeHTTP(rw, r)
+			if rw.statusCode == 0 {
+				rw.statusCode = http.StatusOK
+			}
+
+			// The client may be gone; the outcome is still recorded for its retry.
+			ctx := context.WithoutCancel(r.Context())
+			if rw.statusCode >= http.StatusInternalServerError {
+				err = store.Release(ctx, storeKey, token)
+			} else {
+				err = store.Complete(ctx, storeKey, token, idempotency.Response{
+					Status:      rw.statusCode,
+					ContentType: w.Header().Get("Content-Type"),
+					Body:        rw.body.Bytes(),
+				}, cfg.TTL)
+			}
+			if err != nil {
+				logger.Warn("failed to store idempotent response", "path", r.URL.Path, "error", err)
+			}
+		})
+	}
+}
+
+// requestHash identifies a request by its method, path and body.
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func replay(w http.ResponseWriter, resp idempotency.Response) {
+	if resp.ContentType != "" {
+		w.Header().Set("Content-Type", resp.ContentType)
+	}
+	w.Header().Set("Idempotent-Replayed", "true")
+	w.WriteHeader(resp.Status)
+	_, _ = w.Write(resp.Body) //nolint:errcheck // the client is gone
+}
@@ -0,0 +1,179 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/gateway/internal/idempotency"
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+func TestIdempotencyKeyMiddleware(t *testing.T) {
+	var (
+		got string
+		ok  bool
+	)
+	handler := IdempotencyKeyMiddleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got, ok = IdempotencyKeyFromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/payments", nil)
+	req.Header.Set("Idempotency-Key", "key-1")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	if !ok || got != "key-1" {
+		t.Errorf("key = %q, %v; want key-1", got, ok)
+	}
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/api/v1/payments", nil))
+	if ok {
+		t.Errorf("key = %q, want none without the header", got)
+	}
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	calls := 0
+	handler := IdempotencyMiddleware(idempotency.NewMemoryStore(), IdempotencyConfig{
+		Routes: []string{"POST /api/v1/payments"},
+		TTL:    time.Hour,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("X-Fail") != "" {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{"payment_id":"p-%d"}`, calls)
+	}))
+
+	tenantID := uuid.New()
+	serve := func(tenant uuid.UUID, path, key, body string, fail bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req = req.WithContext(auth.ContextWithClaims(context.Background(), &auth.Claims{TenantID: tenant}))
+		if key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		if fail {
+			req.Header.Set("X-Fail", "1")
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	first := serve(tenantID, "/api/v1/payments", "key-1", `{"amount":"10.00"}`, false)
+	if first.Code != http.StatusCreated || first.Body.String() != `{"payment_id":"p-1"}` {
+		t.Fatalf("first = %d %s", first.Code, first.Body)
+	}
+
+	retry := serve(tenantID, "/api/v1/payments", "key-1", `{"amount":"10.00"}`, false)
+	if retry.Code != http.StatusCreated || retry.Body.String() != `{"payment_id":"p-1"}` {
+		t.Errorf("retry = %d %s, want the original response", retry.Code, retry.Body)
+	}
+	if retry.Header().Get("Idempotent-Replayed") != "true" || retry.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("retry headers = %v", retry.Header())
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want the retry replayed", calls)
+	}
+
+	if w := serve(tenantID, "/api/v1/payments", "key-1", `{"amount":"99.00"}`, false); w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("reused key status = %d, want 422", w.Code)
+	}
+	if w := serve(uuid.New(), "/api/v1/payments", "key-1", `{"amount":"10.00"}`, false); w.Code != http.StatusCreated || calls != 2 {
+		t.Errorf("other tenant = %d after %d calls, want its own request run", w.Code, calls)
+	}
+	serve(tenantID, "/api/v1/payments", "", `{"amount":"10.00"}`, false)
+	serve(tenantID, "/api/v1/accounts", "key-1", `{}`, false)
+	if calls != 4 {
+		t.Errorf("calls = %d, want requests without a key or to other routes run", calls)
+	}
+
+	if w := serve(tenantID, "/api/v1/payments", "key-2", `{}`, true); w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("failed status = %d", w.Code)
+	}
+	if w := serve(tenantID, "/api/v1/payments", "key-2", `{}`, false); w.Code != http.StatusCreated || calls != 6 {
+		t.Errorf("retry after failure = %d after %d calls, want it run again", w.Code, calls)
+	}
+}
+
+func TestIdempotencyMiddleware_InFlight(t *testing.T) {
+	store := idempotency.NewMemoryStore()
+	if _, _, err := store.Reserve(context.Background(), "ip:192.0.2.1:key-1", requestHash(httptest.NewRequest(http.MethodPost, "/api/v1/payments", nil), nil), time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	handler := IdempotencyMiddleware(store, IdempotencyConfig{Routes: []string{"POST /api/v1/payments"}, TTL: time.Hour},
+		slog.New(slog.NewTextHandler(io.Discard, nil)))(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		t.Error("handler called while the first request is in flight")
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/payments", nil)
+	req.Header.Set("Idempotency-Key", "key-1")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	if w.Code != http.StatusConflict {
+		t.Errorf("status = %d, want 409", w.Code)
+	}
+}
+
+func TestIdempotencyMiddleware_BodyTooLarge(t *testing.T) {
+	handler := IdempotencyMiddleware(idempotency.NewMemoryStore(), IdempotencyConfig{
+		Routes:       []string{"POST /api/v1/payments"},
+		TTL:          time.Hour,
+		MaxBodyBytes: 16,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		t.Error("handler called with an oversized body")
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/payments", strings.NewReader(strings.Repeat("x", 17)))
+	req.Header.Set("Idempotency-Key", "key-1")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	if w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("status = %d, want 413", w.Code)
+	}
+}
+
+func TestIdempotencyMiddleware_ReservationLapses(t *testing.T) {
+	store := idempotency.NewMemoryStore()
+	calls := 0
+	handler := IdempotencyMiddleware(store, IdempotencyConfig{
+		Routes:      []string{"POST /api/v1/payments"},
+		TTL:         time.Hour,
+		LockTimeout: 10 * time.Millisecond,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	// A request that never completed, as when the gateway stopped mid-request.
+	if _, _, err := store.Reserve(context.Background(), "ip:192.0.2.1:key-1", requestHash(httptest.NewRequest(http.MethodPost, "/api/v1/payments", nil), nil), 10*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	serve := func() int {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/payments", nil)
+		req.Header.Set("Idempotency-Key", "key-1")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w.Code
+	}
+	if code := serve(); code != http.StatusCreated || calls != 1 {
+		t.Fatalf("retry after the lock timeout = %d after %d calls, want it run", code, calls)
+	}
+	// The response is kept for the TTL, not the lock timeout.
+	time.Sleep(20 * time.Millisecond)
+	if code := serve(); code != http.StatusCreated || calls != 1 {
+		t.Errorf("retry of a completed request = %d after %d calls, want it replayed", code, calls)
+	}
+}
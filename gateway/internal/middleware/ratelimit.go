@@ -6,6 +6,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/bibbank/bib/pkg/auth"
 )
 
@@ -15,6 +17,7 @@ type RateLimiter struct {
 	tokens     float64
 	maxTokens  float64
 	refillRate float64
+	rps        int
 	mu         sync.Mutex
 }
 
@@ -24,6 +27,7 @@ func NewRateLimiter(rps int) *RateLimiter {
 		tokens:     float64(rps),
 		maxTokens:  float64(rps),
 		refillRate: float64(rps),
+		rps:        rps,
 		lastRefill: time.Now(),
 	}
 }
@@ -64,8 +68,8 @@ func RateLimitMiddleware(limiter *RateLimiter) func(http.Handler) http.Handler {
 }
 
 // PerClientRateLimiter maintains per-client token bucket rate limiters.
-// Clients are identified by tenant ID from JWT claims, falling back to
-// the remote IP address for unauthenticated requests.
+// Clients are identified by their API key or by tenant ID from JWT claims,
+// falling back to the remote IP address for unauthenticated requests.
 type PerClientRateLimiter struct {
 	limiters map[string]*RateLimiter
 	rps      int
@@ -80,22 +84,35 @@ func NewPerClientRateLimiter(rps int) *PerClientRateLimiter {
 	}
 }
 
-// getLimiter returns (or creates) the rate limiter for a given client key.
-func (pcrl *PerClientRateLimiter) getLimiter(key string) *RateLimiter {
+// getLimiter returns (or creates) the rate limiter for a given client key,
+// allowing rps requests per second, or the default when rps is zero. A
+// client whose rate has changed gets a new limiter at the new rate.
+func (pcrl *PerClientRateLimiter) getLimiter(key string, rps int) *RateLimiter {
 	pcrl.mu.Lock()
 	defer pcrl.mu.Unlock()
 
-	if rl, ok := pcrl.limiters[key]; ok {
+	if rps <= 0 {
+		rps = pcrl.rps
+	}
+	if rl, ok := pcrl.limiters[key]; ok && rl.rps == rps {
 		return rl
 	}
-	rl := NewRateLimiter(pcrl.rps)
+	rl := NewRateLimiter(rps)
 	pcrl.limiters[key] = rl
 	return rl
 }
 
+// ForgetAPIKey drops the limiter of the API key id, for a key that was
+// rotated or revoked.
+func (pcrl *PerClientRateLimiter) ForgetAPIKey(id uuid.UUID) {
+	pcrl.mu.Lock()
+	defer pcrl.mu.Unlock()
+	delete(pcrl.limiters, apiKeyRateLimitKey(id))
+}
+
 // Allow checks if a request from the identified client is allowed.
 func (pcrl *PerClientRateLimiter) Allow(key string) bool {
-	return pcrl.getLimiter(key).Allow()
+	return pcrl.getLimiter(key, 0).Allow()
 }
 
 // clientKey extracts a per-client key from the request. It uses the tenant
@@ -107,19 +124,39 @@ func clientKey(r *http.Request) string {
 	}
 
 	// Fall back to IP address.
+	return "ip:" + remoteIP(r)
+}
+
+// rateLimitKey returns the key a request is rate limited under and the
+// requests per second the client is allowed, zero for the default. Calls
+// made with an API key are limited per key, at the key's own rate when it
+// sets one; others per client.
+func rateLimitKey(r *http.Request) (string, int) {
+	if key, ok := APIKeyFromContext(r.Context()); ok {
+		return apiKeyRateLimitKey(key.ID), key.RateLimit
+	}
+	return clientKey(r), 0
+}
+
+func apiKeyRateLimitKey(id uuid.UUID) string {
+	return "apikey:" + id.String()
+}
+
+// remoteIP returns the IP address of the request's peer.
+func remoteIP(r *http.Request) string {
 	ip, _, err := net.SplitHostPort(r.RemoteAddr)
 	if err != nil {
-		return "ip:" + r.RemoteAddr
+		return r.RemoteAddr
 	}
-	return "ip:" + ip
+	return ip
 }
 
 // PerClientRateLimitMiddleware applies per-client rate limiting.
 func PerClientRateLimitMiddleware(limiter *PerClientRateLimiter) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			key := clientKey(r)
-			if !limiter.Allow(key) {
+			key, rps := rateLimitKey(r)
+			if !limiter.getLimiter(key, rps).Allow() {
 				w.Header().Set("Content-Type", "application/json")
 				http.Error(w, `{"error":"rate limit exceeded"}`, http.StatusTooManyRequests)
 				return
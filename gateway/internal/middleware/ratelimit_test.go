@@ -5,6 +5,8 @@ import (
 	"net/http/httptest"
 	"testing"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 func TestRateLimiter_Allow(t *testing.T) {
@@ -142,3 +144,23 @@ func TestPerClientRateLimitMiddleware_KeysByIP(t *testing.T) {
 		t.Fatalf("expected 200 for first request from 10.0.0.2, got %d", rec3.Code)
 	}
 }
+
+func TestPerClientRateLimiter_FollowsRateChanges(t *testing.T) {
+	pcrl := NewPerClientRateLimiter(10)
+	id := uuid.New()
+	key := apiKeyRateLimitKey(id)
+
+	first := pcrl.getLimiter(key, 2)
+	if pcrl.getLimiter(key, 2) != first {
+		t.Error("limiter was not reused at the same rate")
+	}
+	changed := pcrl.getLimiter(key, 5)
+	if changed == first || changed.rps != 5 {
+		t.Errorf("limiter after a rate change allows %d rps, want a new limiter at 5", changed.rps)
+	}
+
+	pcrl.ForgetAPIKey(id)
+	if _, ok := pcrl.limiters[key]; ok {
+		t.Error("limiter kept after ForgetAPIKey")
+	}
+}
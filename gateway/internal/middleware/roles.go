@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// RequireRoles rejects authenticated requests whose claims hold none of
+// the given roles. Requests AuthMiddleware let through unauthenticated,
+// such as health checks, pass.
+func RequireRoles(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := auth.ClaimsFromContext(r.Context())
+			if ok && !slices.ContainsFunc(roles, claims.HasRole) {
+				http.Error(w, `{"error":"insufficient role"}`, http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/google/uuid"
+)
+
+func TestRequireRoles(t *testing.T) {
+	jwtSvc := newTestJWTService()
+	mw := AuthMiddleware(jwtSvc, []string{"/healthz"})
+	handler := mw(RequireRoles(auth.RoleOperator, auth.RoleAdmin)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	tests := []struct {
+		name  string
+		path  string
+		roles []string
+		want  int
+	}{
+		{name: "operator", path: "/tasks", roles: []string{auth.RoleOperator}, want: http.StatusOK},
+		{name: "customer", path: "/tasks", roles: []string{auth.RoleCustomer}, want: http.StatusForbidden},
+		{name: "no roles", path: "/tasks", want: http.StatusForbidden},
+		{name: "unauthenticated path", path: "/healthz", want: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.path != "/healthz" {
+				token, err := jwtSvc.GenerateToken(uuid.New(), uuid.New(), tt.roles)
+				if err != nil {
+					t.Fatalf("GenerateToken: %v", err)
+				}
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Fatalf("expected %d, got %d", tt.want, rec.Code)
+			}
+		})
+	}
+}
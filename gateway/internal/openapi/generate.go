@@ -0,0 +1,739 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Document is an OpenAPI 3 document.
+type Document struct {
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components Components                       `json:"components"`
+	Info       Info                             `json:"info"`
+	OpenAPI    string                           `json:"openapi"`
+	Tags       []Tag                            `json:"tags"`
+	Security   []map[string][]string            `json:"security"`
+}
+
+// Info describes the API.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+// Tag groups the operations of one backend service.
+type Tag struct {
+	Name string `json:"name"`
+}
+
+// Components holds the schemas operations refer to and the security schemes.
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes"`
+}
+
+// SecurityScheme describes how callers authenticate.
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	In           string `json:"in,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+// Operation is one method on a path.
+type Operation struct {
+	// Security is empty, rather than nil, for operations that need no token.
+	Security    *[]map[string][]string `json:"security,omitempty"`
+	RequestBody *RequestBody           `json:"requestBody,omitempty"`
+	Responses   map[string]*Response   `json:"responses"`
+	OperationID string                 `json:"operationId"`
+	Description string                 `json:"description,omitempty"`
+	Tags        []string               `json:"tags"`
+	Parameters  []*Parameter           `json:"parameters,omitempty"`
+}
+
+// Parameter is a path or query parameter.
+type Parameter struct {
+	Schema   *Schema `json:"schema"`
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required,omitempty"`
+}
+
+// RequestBody is an operation's request body.
+type RequestBody struct {
+	Content  map[string]*MediaType `json:"content"`
+	Required bool                  `json:"required"`
+}
+
+// Response is one of an operation's responses.
+type Response struct {
+	Content     map[string]*MediaType `json:"content,omitempty"`
+	Description string                `json:"description"`
+}
+
+// MediaType holds the schema of a body.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Schema is a JSON Schema as used by OpenAPI 3.
+type Schema struct {
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+}
+
+// errorSchema is the body writeError sends with every failure.
+const errorSchema = "Error"
+
+// errorWriters are the proxy helpers writing the Error response.
+var errorWriters = map[string]bool{"writeError": true, "handleGRPCError": true}
+
+var pathParamRE = regexp.MustCompile(`\{([^}]+)\}`)
+
+// httpStatuses maps the net/http status constants handlers respond with to
+// their codes.
+var httpStatuses = map[string]string{
+	"StatusOK":        "200",
+	"StatusCreated":   "201",
+	"StatusAccepted":  "202",
+	"StatusNoContent": "204",
+}
+
+// Generate builds the OpenAPI document of the routes RegisterRoutes in
+// routesFile registers, reading the request and response messages from the
+// proxy handlers in proxyDir. Requests to publicPaths need no token.
+func Generate(routesFile, proxyDir string, publicPaths []string) ([]byte, error) {
+	fset := token.NewFileSet()
+	routes, err := parser.ParseFile(fset, routesFile, nil, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parse routes: %w", err)
+	}
+
+	g := &generator{
+		types:   make(map[string]*ast.TypeSpec),
+		methods: make(map[string]*ast.FuncDecl),
+		schemas: map[string]*Schema{
+			errorSchema: {Type: "object", Properties: map[string]*Schema{"error": {Type: "string"}}},
+		},
+	}
+	if err := g.loadProxies(fset, proxyDir); err != nil {
+		return nil, err
+	}
+
+	doc := &Document{
+		OpenAPI: "3.0.3",
+		Info: Info{
+			Title:       "BIB Gateway API",
+			Description: "Bank-in-a-Box platform REST gateway API. Generated from the gateway's routes and proxy handlers.",
+			Version:     "1.0.0",
+		},
+		Paths: make(map[string]map[string]*Operation),
+		// Either a JWT or, for machine clients, an API key.
+		Security: []map[string][]string{{"bearerAuth": {}}, {"apiKeyAuth": {}}},
+		Components: Components{
+			Schemas: g.schemas,
+			SecuritySchemes: map[string]*SecurityScheme{
+				"bearerAuth": {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+				"apiKeyAuth": {Type: "apiKey", In: "header", Name: "X-API-Key"},
+			},
+		},
+	}
+
+	proxyTypes := proxyFieldTypes(routes)
+	var ops []*Operation
+	methodNames := make(map[string]int)
+	for _, route := range registeredRoutes(routes) {
+		method, path, found := strings.Cut(route.pattern, " ")
+		if !found {
+			method, path = "GET", route.pattern
+		}
+
+		op := &Operation{
+			Tags:      []string{route.tag},
+			Responses: make(map[string]*Response),
+		}
+		for _, m := range pathParamRE.FindAllStringSubmatch(path, -1) {
+			op.Parameters = append(op.Parameters, &Parameter{
+				Name:     strings.TrimSuffix(m[1], "..."),
+				In:       "path",
+				Required: true,
+				Schema:   &Schema{Type: "string"},
+			})
+		}
+		if fn := g.methods[proxyTypes[route.tag]+"."+route.name]; fn != nil {
+			op.Description = strings.TrimSpace(fn.Doc.Text())
+			g.describe(op, proxyTypes[route.tag], fn, 0)
+		}
+		if len(op.Responses) == 0 {
+			op.Responses["200"] = &Response{Description: "OK"}
+		}
+		op.Responses["default"] = &Response{
+			Description: "Error",
+			Content:     jsonContent(&Schema{Ref: "#/components/schemas/" + errorSchema}),
+		}
+		if slices.Contains(publicPaths, path) {
+			op.Security = &[]map[string][]string{}
+		}
+		// Operation IDs are the handlers' names, qualified by their tag
+		// where two backends share one.
+		op.OperationID = route.name
+		methodNames[route.name]++
+
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(map[string]*Operation)
+		}
+		doc.Paths[path][strings.ToLower(method)] = op
+		ops = append(ops, op)
+		if !slices.ContainsFunc(doc.Tags, func(t Tag) bool { return t.Name == route.tag }) {
+			doc.Tags = append(doc.Tags, Tag{Name: route.tag})
+		}
+	}
+	for _, op := range ops {
+		if methodNames[op.OperationID] > 1 {
+			op.OperationID = lowerFirst(op.Tags[0]) + op.OperationID
+		}
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal document: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// route is a route RegisterRoutes registers: its pattern, and the handler
+// serving it as the name of a method of the proxy in the Proxies field tag.
+type route struct {
+	pattern string
+	tag     string
+	name    string
+}
+
+// registeredRoutes returns the routes registered in RegisterRoutes, in
+// order. Handlers that are not proxy methods, such as the health checks,
+// are tagged Health.
+func registeredRoutes(file *ast.File) []route {
+	var routes []route
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != "RegisterRoutes" {
+			continue
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) != 2 {
+				return true
+			}
+			if sel, ok := call.Fun.(*ast.SelectorExpr); !ok || sel.Sel.Name != "HandleFunc" {
+				return true
+			}
+			lit, ok := call.Args[0].(*ast.BasicLit)
+			if !ok {
+				return true
+			}
+			pattern, err := strconv.Unquote(lit.Value)
+			if err != nil {
+				return true
+			}
+			r := route{pattern: pattern, tag: "Health"}
+			switch h := call.Args[1].(type) {
+			case *ast.SelectorExpr:
+				r.name = h.Sel.Name
+				if field, ok := h.X.(*ast.SelectorExpr); ok {
+					r.tag = field.Sel.Name
+				}
+			case *ast.Ident:
+				r.name = h.Name
+			case *ast.CallExpr:
+				if id, ok := h.Fun.(*ast.Ident); ok {
+					r.name = id.Name
+				}
+			}
+			routes = append(routes, r)
+			return true
+		})
+	}
+	return routes
+}
+
+// proxyFieldTypes maps each field of Proxies to the name of its proxy type.
+func proxyFieldTypes(file *ast.File) map[string]string {
+	fields := make(map[string]string)
+	ast.Inspect(file, func(n ast.Node) bool {
+		ts, ok := n.(*ast.TypeSpec)
+		if !ok || ts.Name.Name != "Proxies" {
+			return true
+		}
+		st, ok := ts.Type.(*ast.StructType)
+		if !ok {
+			return false
+		}
+		for _, f := range st.Fields.List {
+			star, ok := f.Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			sel, ok := star.X.(*ast.SelectorExpr)
+			if !ok {
+				continue
+			}
+			for _, name := range f.Names {
+				fields[name.Name] = sel.Sel.Name
+			}
+		}
+		return false
+	})
+	return fields
+}
+
+// generator resolves the messages of proxy handlers into schemas.
+type generator struct {
+	// types are the proxy package's type declarations by name.
+	types map[string]*ast.TypeSpec
+	// methods are the proxies' methods by "Type.Method", and the package's
+	// functions by ".Function".
+	methods map[string]*ast.FuncDecl
+	// schemas are the component schemas referred to so far.
+	schemas map[string]*Schema
+}
+
+func (g *generator) loadProxies(fset *token.FileSet, dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("read proxy dir: %w", err)
+	}
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") || strings.HasSuffix(e.Name(), "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, e.Name()), nil, parser.ParseComments)
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", e.Name(), err)
+		}
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok {
+						g.types[ts.Name.Name] = ts
+					}
+				}
+			case *ast.FuncDecl:
+				g.methods[receiverType(d)+"."+d.Name.Name] = d
+			}
+		}
+	}
+	return nil
+}
+
+func receiverType(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) != 1 {
+		return ""
+	}
+	t := fn.Recv.List[0].Type
+	if star, ok := t.(*ast.StarExpr); ok {
+		t = star.X
+	}
+	if id, ok := t.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+// describe fills op's parameters, request body and responses from what the
+// handler fn reads and writes. Handlers that delegate to another method of
+// their proxy are described by it.
+func (g *generator) describe(op *Operation, recv string, fn *ast.FuncDecl, depth int) {
+	vars := localVarTypes(fn.Body)
+	queryVars := make(map[string]bool)
+	var form map[string]*Schema
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) == 1 && len(n.Rhs) == 1 && isURLQuery(n.Rhs[0]) {
+				if id, ok := n.Lhs[0].(*ast.Ident); ok {
+					queryVars[id.Name] = true
+				}
+			}
+		case *ast.CallExpr:
+			g.describeCall(op, recv, n, vars, queryVars, &form, depth)
+		}
+		return true
+	})
+
+	if form != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]*MediaType{"multipart/form-data": {Schema: &Schema{Type: "object", Properties: form}}},
+		}
+	}
+}
+
+func (g *generator) describeCall(op *Operation, recv string, call *ast.CallExpr, vars map[string]ast.Expr, queryVars map[string]bool, form *map[string]*Schema, depth int) {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		switch fun.Name {
+		case "readJSON":
+			if len(call.Args) == 2 && op.RequestBody == nil {
+				if u, ok := call.Args[1].(*ast.UnaryExpr); ok {
+					if id, ok := u.X.(*ast.Ident); ok && vars[id.Name] != nil {
+						op.RequestBody = &RequestBody{Required: true, Content: jsonContent(g.schemaOf(vars[id.Name]))}
+					}
+				}
+			}
+		case "writeJSON":
+			if len(call.Args) == 3 {
+				g.describeResponse(op, call.Args[1], call.Args[2], vars)
+			}
+		case "readFormFile":
+			if len(call.Args) >= 2 {
+				if name, ok := stringLit(call.Args[1]); ok {
+					formField(form, name, &Schema{Type: "string", Format: "binary"})
+				}
+			}
+		default:
+			// Follow helpers the handler passes its response writer to,
+			// other than those writing the Error response.
+			if fn := g.methods["."+fun.Name]; fn != nil && depth < 2 && writesResponse(call) && !errorWriters[fun.Name] {
+				g.describe(op, recv, fn, depth+1)
+			}
+		}
+	case *ast.SelectorExpr:
+		switch fun.Sel.Name {
+		case "Get":
+			if len(call.Args) != 1 {
+				return
+			}
+			id, isIdent := fun.X.(*ast.Ident)
+			if !isURLQuery(fun.X) && (!isIdent || !queryVars[id.Name]) {
+				return
+			}
+			if name, ok := stringLit(call.Args[0]); ok && !slices.ContainsFunc(op.Parameters, func(p *Parameter) bool { return p.In == "query" && p.Name == name }) {
+				op.Parameters = append(op.Parameters, &Parameter{Name: name, In: "query", Schema: &Schema{Type: "string"}})
+			}
+		case "ParseMultipartForm":
+			formField(form, "", nil)
+		case "FormValue":
+			if len(call.Args) == 1 {
+				if name, ok := stringLit(call.Args[0]); ok {
+					formField(form, name, &Schema{Type: "string"})
+				}
+			}
+		default:
+			// Follow delegation to another method of the same proxy.
+			if id, ok := fun.X.(*ast.Ident); ok && id.Name == "p" && depth < 2 {
+				if m := g.methods[recv+"."+fun.Sel.Name]; m != nil {
+					g.describe(op, recv, m, depth+1)
+				}
+			}
+		}
+	}
+}
+
+func (g *generator) describeResponse(op *Operation, status, body ast.Expr, vars map[string]ast.Expr) {
+	// Statuses chosen at runtime are described as 200.
+	code := "200"
+	if sel, ok := status.(*ast.SelectorExpr); ok {
+		if c, ok := httpStatuses[sel.Sel.Name]; ok {
+			code = c
+		}
+	}
+	if _, exists := op.Responses[code]; exists {
+		return
+	}
+
+	var t ast.Expr
+	switch b := body.(type) {
+	case *ast.Ident:
+		t = vars[b.Name]
+	case *ast.CompositeLit:
+		t = b.Type
+	case *ast.UnaryExpr:
+		if lit, ok := b.X.(*ast.CompositeLit); ok {
+			t = lit.Type
+		}
+	case *ast.CallExpr:
+		t = g.resultType(b)
+	}
+	schema := &Schema{Type: "object"}
+	if t != nil {
+		schema = g.schemaOf(t)
+	}
+	op.Responses[code] = &Response{Description: statusDescription(code), Content: jsonContent(schema)}
+}
+
+// resultType returns the type of the single result of the package function
+// call calls, such as a helper converting a generated message to the
+// response the proxy writes.
+func (g *generator) resultType(call *ast.CallExpr) ast.Expr {
+	id, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	fn := g.methods["."+id.Name]
+	if fn == nil || fn.Type.Results == nil || len(fn.Type.Results.List) != 1 {
+		return nil
+	}
+	return fn.Type.Results.List[0].Type
+}
+
+// schemaOf returns the schema of the type expression t, adding the proxy
+// types it names to the components.
+func (g *generator) schemaOf(t ast.Expr) *Schema {
+	switch t := t.(type) {
+	case *ast.Ident:
+		if s := builtinSchema(t.Name); s != nil {
+			return s
+		}
+		ts, ok := g.types[t.Name]
+		if !ok {
+			return &Schema{Type: "object"}
+		}
+		name := upperFirst(t.Name)
+		ref := &Schema{Ref: "#/components/schemas/" + name}
+		if _, done := g.schemas[name]; done {
+			return ref
+		}
+		// Claim the name before resolving fields, so recursive types end.
+		g.schemas[name] = &Schema{}
+		*g.schemas[name] = *g.schemaOf(ts.Type)
+		if ts.Doc != nil {
+			g.schemas[name].Description = strings.TrimSpace(ts.Doc.Text())
+		}
+		return ref
+	case *ast.StarExpr:
+		return g.schemaOf(t.X)
+	case *ast.ArrayType:
+		if id, ok := t.Elt.(*ast.Ident); ok && id.Name == "byte" {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: g.schemaOf(t.Elt)}
+	case *ast.MapType:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaOf(t.Value)}
+	case *ast.StructType:
+		s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+		g.addFields(s, t)
+		return s
+	case *ast.SelectorExpr:
+		if pkg, ok := t.X.(*ast.Ident); ok {
+			switch pkg.Name + "." + t.Sel.Name {
+			case "time.Time":
+				return &Schema{Type: "string", Format: "date-time"}
+			case "json.RawMessage":
+				return &Schema{}
+			}
+		}
+		// Generated protobuf messages, sent as their JSON encoding.
+		return &Schema{Type: "object"}
+	case *ast.InterfaceType:
+		return &Schema{}
+	}
+	return &Schema{}
+}
+
+func (g *generator) addFields(s *Schema, st *ast.StructType) {
+	for _, f := range st.Fields.List {
+		name, omit := "", false
+		if f.Tag != nil {
+			tag, _ := strconv.Unquote(f.Tag.Value)
+			name, _, _ = strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+			omit = name == "-"
+		}
+		if omit {
+			continue
+		}
+		if len(f.Names) == 0 && name == "" {
+			// Embedded structs contribute their fields.
+			if id, ok := f.Type.(*ast.Ident); ok {
+				if ts, ok := g.types[id.Name]; ok {
+					if embedded, ok := ts.Type.(*ast.StructType); ok {
+						g.addFields(s, embedded)
+					}
+				}
+			}
+			continue
+		}
+		for _, n := range f.Names {
+			if !n.IsExported() {
+				continue
+			}
+			key := name
+			if key == "" {
+				key = n.Name
+			}
+			prop := g.schemaOf(f.Type)
+			// Siblings of a $ref are ignored, so only inline schemas
+			// carry the field's comment.
+			if prop.Ref == "" {
+				prop.Description = fieldDoc(f)
+			}
+			s.Properties[key] = prop
+		}
+	}
+}
+
+func fieldDoc(f *ast.Field) string {
+	if f.Doc != nil {
+		return strings.TrimSpace(f.Doc.Text())
+	}
+	if f.Comment != nil {
+		return strings.TrimSpace(f.Comment.Text())
+	}
+	return ""
+}
+
+// localVarTypes maps the variables a function body declares to their
+// types, where a declaration or composite literal states them.
+func localVarTypes(body *ast.BlockStmt) map[string]ast.Expr {
+	vars := make(map[string]ast.Expr)
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ValueSpec:
+			if n.Type != nil {
+				for _, name := range n.Names {
+					vars[name.Name] = n.Type
+				}
+			}
+		case *ast.AssignStmt:
+			if n.Tok != token.DEFINE || len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, lhs := range n.Lhs {
+				id, ok := lhs.(*ast.Ident)
+				if !ok {
+					continue
+				}
+				if t := literalType(n.Rhs[i]); t != nil {
+					vars[id.Name] = t
+				}
+			}
+		}
+		return true
+	})
+	return vars
+}
+
+func literalType(e ast.Expr) ast.Expr {
+	switch e := e.(type) {
+	case *ast.CompositeLit:
+		return e.Type
+	case *ast.UnaryExpr:
+		if lit, ok := e.X.(*ast.CompositeLit); ok {
+			return lit.Type
+		}
+	case *ast.CallExpr:
+		if id, ok := e.Fun.(*ast.Ident); ok && id.Name == "make" && len(e.Args) > 0 {
+			return e.Args[0]
+		}
+	}
+	return nil
+}
+
+// writesResponse reports whether call is passed the response writer w.
+func writesResponse(call *ast.CallExpr) bool {
+	return slices.ContainsFunc(call.Args, func(arg ast.Expr) bool {
+		id, ok := arg.(*ast.Ident)
+		return ok && id.Name == "w"
+	})
+}
+
+// isURLQuery reports whether e is r.URL.Query().
+func isURLQuery(e ast.Expr) bool {
+	call, ok := e.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Query" {
+		return false
+	}
+	url, ok := sel.X.(*ast.SelectorExpr)
+	return ok && url.Sel.Name == "URL"
+}
+
+func stringLit(e ast.Expr) (string, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+// formField records a multipart form field; an empty name only marks the
+// body as multipart.
+func formField(form *map[string]*Schema, name string, s *Schema) {
+	if *form == nil {
+		*form = make(map[string]*Schema)
+	}
+	if name != "" {
+		(*form)[name] = s
+	}
+}
+
+func builtinSchema(name string) *Schema {
+	switch name {
+	case "string":
+		return &Schema{Type: "string"}
+	case "bool":
+		return &Schema{Type: "boolean"}
+	case "int", "int64", "uint64":
+		return &Schema{Type: "integer", Format: "int64"}
+	case "int32", "uint32":
+		return &Schema{Type: "integer", Format: "int32"}
+	case "float32", "float64":
+		return &Schema{Type: "number", Format: "double"}
+	case "any":
+		return &Schema{}
+	}
+	return nil
+}
+
+func jsonContent(s *Schema) map[string]*MediaType {
+	return map[string]*MediaType{"application/json": {Schema: s}}
+}
+
+func statusDescription(code string) string {
+	switch code {
+	case "201":
+		return "Created"
+	case "202":
+		return "Accepted"
+	case "204":
+		return "No Content"
+	}
+	return "OK"
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
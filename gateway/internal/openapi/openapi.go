@@ -0,0 +1,59 @@
+// Package openapi serves the gateway's OpenAPI document and a Swagger UI
+// rendering it.
+//
+// The document, openapi.json, is generated from the routes RegisterRoutes
+// registers and the request and response messages of the proxy handlers
+// serving them. Run make openapi after changing either; a test fails while
+// the committed document is out of date.
+package openapi
+
+import (
+	_ "embed"
+	"fmt"
+	"net/http"
+)
+
+//go:embed openapi.json
+var spec []byte
+
+// Spec returns the gateway's OpenAPI document.
+func Spec() []byte {
+	return spec
+}
+
+// SpecHandler serves the gateway's OpenAPI document.
+func SpecHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(spec) //nolint:errcheck
+	})
+}
+
+// DocsHandler serves a Swagger UI page rendering the document at specURL.
+// The page loads Swagger UI's assets from the unpkg CDN.
+func DocsHandler(specURL string) http.Handler {
+	page := fmt.Sprintf(docsPage, specURL)
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(page)) //nolint:errcheck
+	})
+}
+
+const docsPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>BIB Gateway API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({ url: %q, dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>
+`
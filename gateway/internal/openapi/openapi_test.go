@@ -0,0 +1,112 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/bibbank/bib/gateway/internal/handler"
+)
+
+// TestSpecUpToDate regenerates the document and compares it with the
+// committed openapi.json. Run with UPDATE_OPENAPI=1, or make openapi, to
+// rewrite it.
+func TestSpecUpToDate(t *testing.T) {
+	got, err := Generate("../handler/routes.go", "../proxy", handler.PublicPaths)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+
+	if os.Getenv("UPDATE_OPENAPI") != "" {
+		if err := os.WriteFile("openapi.json", got, 0o644); err != nil { //nolint:gosec // committed, world-readable document
+			t.Fatal(err)
+		}
+		return
+	}
+	if !bytes.Equal(got, Spec()) {
+		t.Fatal("openapi.json is out of date; run make openapi")
+	}
+}
+
+func TestGenerate_DescribesProxyMessages(t *testing.T) {
+	var doc Document
+	if err := json.Unmarshal(Spec(), &doc); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if doc.OpenAPI != "3.0.3" {
+		t.Errorf("openapi = %q", doc.OpenAPI)
+	}
+
+	op := doc.Paths["/api/v1/cards/{id}/authorize"]["post"]
+	if op == nil {
+		t.Fatal("POST /api/v1/cards/{id}/authorize missing")
+	}
+	if op.OperationID != "AuthorizeTransaction" || op.Tags[0] != "Card" {
+		t.Errorf("operation = %q tagged %v", op.OperationID, op.Tags)
+	}
+	if len(op.Parameters) != 1 || op.Parameters[0].Name != "id" || op.Parameters[0].In != "path" {
+		t.Errorf("parameters = %+v", op.Parameters)
+	}
+	if ref := op.RequestBody.Content["application/json"].Schema.Ref; ref != "#/components/schemas/AuthorizeTransactionReq" {
+		t.Errorf("request schema = %q", ref)
+	}
+	if ref := op.Responses["200"].Content["application/json"].Schema.Ref; ref != "#/components/schemas/AuthorizeTransactionResp" {
+		t.Errorf("response schema = %q", ref)
+	}
+	req := doc.Components.Schemas["AuthorizeTransactionReq"]
+	if req == nil || req.Properties["merchant_country"] == nil || req.Properties["merchant_country"].Type != "string" {
+		t.Errorf("AuthorizeTransactionReq = %+v", req)
+	}
+
+	if created := doc.Paths["/api/v1/cards"]["post"].Responses["201"]; created == nil {
+		t.Error("IssueCard has no 201 response")
+	}
+	if healthz := doc.Paths["/healthz"]["get"]; healthz == nil || healthz.Security == nil || len(*healthz.Security) != 0 {
+		t.Error("healthz is not public")
+	}
+	if doc.Paths["/api/v1/cards"]["post"].Security != nil {
+		t.Error("IssueCard is public")
+	}
+}
+
+func TestGenerate_QueryAndMultipart(t *testing.T) {
+	var doc Document
+	if err := json.Unmarshal(Spec(), &doc); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	list := doc.Paths["/api/v1/accounts"]["get"]
+	var query []string
+	for _, p := range list.Parameters {
+		if p.In == "query" {
+			query = append(query, p.Name)
+		}
+	}
+	if strings.Join(query, ",") != "tenant_id,holder_id" {
+		t.Errorf("ListAccounts query parameters = %v", query)
+	}
+
+	upload := doc.Paths["/api/v1/identity/verifications/{id}/documents"]["post"]
+	form := upload.RequestBody.Content["multipart/form-data"]
+	if form == nil || form.Schema.Properties["file"].Format != "binary" || form.Schema.Properties["document_type"] == nil {
+		t.Errorf("UploadDocument body = %+v", upload.RequestBody)
+	}
+}
+
+func TestHandlers(t *testing.T) {
+	rec := httptest.NewRecorder()
+	SpecHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/json" || !json.Valid(rec.Body.Bytes()) {
+		t.Errorf("spec: %d %q", rec.Code, rec.Header().Get("Content-Type"))
+	}
+
+	rec = httptest.NewRecorder()
+	DocsHandler("/openapi.json").ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/docs", nil))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `url: "/openapi.json"`) {
+		t.Errorf("docs: %d %s", rec.Code, rec.Body.String())
+	}
+}
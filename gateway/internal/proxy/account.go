@@ -3,23 +3,23 @@ package proxy
 import (
 	"log/slog"
 	"net/http"
+	"strings"
 
+	accountv1 "github.com/bibbank/bib/api/gen/go/bib/account/v1"
 	"github.com/bibbank/bib/pkg/auth"
 )
 
 // AccountProxy proxies HTTP requests to the account gRPC service.
 type AccountProxy struct {
-	conn   *ServiceConn
+	client accountv1.AccountServiceClient
 	logger *slog.Logger
 }
 
 // NewAccountProxy creates a new account service proxy.
 func NewAccountProxy(conn *ServiceConn, logger *slog.Logger) *AccountProxy {
-	return &AccountProxy{conn: conn, logger: logger}
+	return &AccountProxy{client: accountv1.NewAccountServiceClient(conn), logger: logger}
 }
 
-// --- Request/Response types matching the account service handler ---
-
 type openAccountReq struct {
 	TenantID               string `json:"tenant_id"`
 	AccountType            string `json:"account_type"`
@@ -74,13 +74,12 @@ func (p *AccountProxy) OpenAccount(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	var resp openAccountResp
-	err := p.conn.Invoke(r.Context(), "/bib.account.v1.AccountService/OpenAccount", &req, &resp)
+	resp, err := p.client.OpenAccount(r.Context(), toOpenAccountMsg(req))
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusCreated, resp)
+	writeJSON(w, http.StatusCreated, toOpenAccountResp(resp.GetAccount()))
 }
 
 // GetAccount handles GET /api/v1/accounts/{id}.
@@ -91,14 +90,12 @@ func (p *AccountProxy) GetAccount(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req := map[string]string{"account_id": accountID}
-	var resp accountResp
-	err := p.conn.Invoke(r.Context(), "/bib.account.v1.AccountService/GetAccount", &req, &resp)
+	resp, err := p.client.GetAccount(r.Context(), &accountv1.GetAccountRequest{Id: accountID})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, toAccountResp(resp.GetAccount()))
 }
 
 // FreezeAccount handles POST /api/v1/accounts/{id}/freeze.
@@ -115,17 +112,12 @@ func (p *AccountProxy) FreezeAccount(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req := map[string]string{
-		"account_id": accountID,
-		"reason":     body.Reason,
-	}
-	var resp accountResp
-	err := p.conn.Invoke(r.Context(), "/bib.account.v1.AccountService/FreezeAccount", &req, &resp)
+	resp, err := p.client.FreezeAccount(r.Context(), &accountv1.FreezeAccountRequest{Id: accountID, Reason: body.Reason})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, toAccountResp(resp.GetAccount()))
 }
 
 // CloseAccount handles POST /api/v1/accounts/{id}/close.
@@ -142,37 +134,77 @@ func (p *AccountProxy) CloseAccount(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req := map[string]string{
-		"account_id": accountID,
-		"reason":     body.Reason,
-	}
-	var resp accountResp
-	err := p.conn.Invoke(r.Context(), "/bib.account.v1.AccountService/CloseAccount", &req, &resp)
+	resp, err := p.client.CloseAccount(r.Context(), &accountv1.CloseAccountRequest{Id: accountID, Reason: body.Reason})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, toAccountResp(resp.GetAccount()))
 }
 
 // ListAccounts handles GET /api/v1/accounts.
 func (p *AccountProxy) ListAccounts(w http.ResponseWriter, r *http.Request) {
-	req := map[string]interface{}{
-		"tenant_id": r.URL.Query().Get("tenant_id"),
-		"holder_id": r.URL.Query().Get("holder_id"),
+	req := &accountv1.ListAccountsRequest{
+		TenantId: r.URL.Query().Get("tenant_id"),
+		HolderId: r.URL.Query().Get("holder_id"),
 	}
 
-	if req["tenant_id"] == "" {
+	if req.TenantId == "" {
 		if claims, ok := auth.ClaimsFromContext(r.Context()); ok {
-			req["tenant_id"] = claims.TenantID.String()
+			req.TenantId = claims.TenantID.String()
 		}
 	}
 
-	var resp listAccountsResp
-	err := p.conn.Invoke(r.Context(), "/bib.account.v1.AccountService/ListAccounts", &req, &resp)
+	resp, err := p.client.ListAccounts(r.Context(), req)
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	out := listAccountsResp{
+		Accounts:   make([]accountResp, 0, len(resp.GetAccounts())),
+		TotalCount: resp.GetPagination().GetTotalCount(),
+	}
+	for _, a := range resp.GetAccounts() {
+		out.Accounts = append(out.Accounts, toAccountResp(a))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func toOpenAccountMsg(req openAccountReq) *accountv1.OpenAccountRequest {
+	return &accountv1.OpenAccountRequest{
+		TenantId: req.TenantID,
+		Type:     accountv1.AccountType(accountv1.AccountType_value["ACCOUNT_TYPE_"+strings.ToUpper(req.AccountType)]),
+		Currency: req.Currency,
+		Holder: &accountv1.AccountHolder{
+			FirstName:              req.HolderFirstName,
+			LastName:               req.HolderLastName,
+			Email:                  req.HolderEmail,
+			IdentityVerificationId: req.IdentityVerificationID,
+		},
+	}
+}
+
+func toOpenAccountResp(a *accountv1.Account) openAccountResp {
+	return openAccountResp{
+		AccountID:         a.GetId(),
+		AccountNumber:     a.GetAccountNumber(),
+		Status:            enumName(a.GetStatus().String(), "ACCOUNT_STATUS_"),
+		LedgerAccountCode: a.GetLedgerAccountCode(),
+	}
+}
+
+func toAccountResp(a *accountv1.Account) accountResp {
+	return accountResp{
+		AccountID:         a.GetId(),
+		TenantID:          a.GetTenantId(),
+		AccountNumber:     a.GetAccountNumber(),
+		AccountType:       enumName(a.GetType().String(), "ACCOUNT_TYPE_"),
+		Status:            enumName(a.GetStatus().String(), "ACCOUNT_STATUS_"),
+		Currency:          a.GetCurrency(),
+		LedgerAccountCode: a.GetLedgerAccountCode(),
+		HolderFirstName:   a.GetHolder().GetFirstName(),
+		HolderLastName:    a.GetHolder().GetLastName(),
+		HolderEmail:       a.GetHolder().GetEmail(),
+		Version:           a.GetAudit().GetVersion(),
+	}
 }
@@ -0,0 +1,204 @@
+package proxy
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/gateway/internal/apikey"
+	"github.com/bibbank/bib/gateway/internal/middleware"
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// APIKeyProxy serves the management of a tenant's API keys, with which
+// machine clients call the gateway instead of with a JWT. Like AuthProxy it
+// calls no backend. Only the tenant's admins manage its keys, and only
+// with a JWT: keys cannot manage keys.
+type APIKeyProxy struct {
+	keys   *apikey.Manager
+	logger *slog.Logger
+}
+
+// NewAPIKeyProxy creates a new API key management handler.
+func NewAPIKeyProxy(keys *apikey.Manager, logger *slog.Logger) *APIKeyProxy {
+	return &APIKeyProxy{keys: keys, logger: logger}
+}
+
+type createAPIKeyReq struct {
+	Name string `json:"name"`
+	// Roles granted to the key: api_client, the default, auditor or
+	// compliance.
+	Roles []string `json:"roles"`
+	// RateLimit overrides the per-client requests per second for the
+	// key's calls; 0 keeps the default.
+	RateLimit int `json:"rate_limit"`
+}
+
+// apiKeyResp describes an API key. Its secret is never shown again after
+// it is issued.
+type apiKeyResp struct {
+	CreatedAt time.Time `json:"created_at"`
+	// ExpiresAt is set on rotated keys, which keep working until then.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	// Prefix identifies the key: every key starts bib_<prefix>_.
+	Prefix    string   `json:"prefix"`
+	CreatedBy string   `json:"created_by"`
+	Roles     []string `json:"roles"`
+	RateLimit int      `json:"rate_limit"`
+}
+
+// issuedAPIKeyResp is a newly issued API key with its secret, to send in
+// the X-API-Key header. It cannot be retrieved later.
+type issuedAPIKeyResp struct {
+	apiKeyResp
+	Key string `json:"key"`
+}
+
+type listAPIKeysResp struct {
+	Keys []apiKeyResp `json:"keys"`
+}
+
+func toAPIKeyResp(k apikey.Key) apiKeyResp {
+	return apiKeyResp{
+		ID:        k.ID.String(),
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Roles:     k.Roles,
+		RateLimit: k.RateLimit,
+		CreatedBy: k.CreatedBy.String(),
+		CreatedAt: k.CreatedAt,
+		ExpiresAt: k.ExpiresAt,
+		RevokedAt: k.RevokedAt,
+	}
+}
+
+// keyAdmin returns the claims of a caller allowed to manage its tenant's
+// keys, answering the request otherwise.
+func keyAdmin(w http.ResponseWriter, r *http.Request) (*auth.Claims, bool) {
+	claims, ok := auth.ClaimsFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "missing claims")
+		return nil, false
+	}
+	if _, ok := middleware.APIKeyFromContext(r.Context()); ok || !claims.HasRole(auth.RoleAdmin) {
+		writeError(w, http.StatusForbidden, "api keys are managed by tenant admins")
+		return nil, false
+	}
+	return claims, true
+}
+
+// CreateAPIKey handles POST /api/v1/api-keys, issuing a key for the
+// caller's tenant. The key's secret is in the response only.
+func (p *APIKeyProxy) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	claims, ok := keyAdmin(w, r)
+	if !ok {
+		return
+	}
+	var req createAPIKeyReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	key, secret, err := p.keys.Create(r.Context(), apikey.CreateParams{
+		TenantID:  claims.TenantID,
+		CreatedBy: claims.UserID,
+		Name:      req.Name,
+		Roles:     req.Roles,
+		RateLimit: req.RateLimit,
+	})
+	if errors.Is(err, apikey.ErrInvalidParams) {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		p.logger.Error("failed to create api key", "tenant_id", claims.TenantID, "error", err)
+		writeError(w, http.StatusInternalServerError, "failed to create api key")
+		return
+	}
+	p.logger.Info("api key created", "tenant_id", claims.TenantID, "api_key_id", key.ID, "created_by", claims.UserID)
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusCreated, issuedAPIKeyResp{apiKeyResp: toAPIKeyResp(key), Key: secret})
+}
+
+// ListAPIKeys handles GET /api/v1/api-keys, listing the caller's tenant's
+// keys, revoked and expired ones included, newest first.
+func (p *APIKeyProxy) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	claims, ok := keyAdmin(w, r)
+	if !ok {
+		return
+	}
+	keys, err := p.keys.List(r.Context(), claims.TenantID)
+	if err != nil {
+		p.logger.Error("failed to list api keys", "tenant_id", claims.TenantID, "error", err)
+		writeError(w, http.StatusInternalServerError, "failed to list api keys")
+		return
+	}
+	resp := make([]apiKeyResp, 0, len(keys))
+	for _, k := range keys {
+		resp = append(resp, toAPIKeyResp(k))
+	}
+	writeJSON(w, http.StatusOK, listAPIKeysResp{Keys: resp})
+}
+
+// RotateAPIKey handles POST /api/v1/api-keys/{id}/rotate, issuing a key
+// with the same name, roles and rate limit to replace it. The old key keeps
+// working for a grace period, while clients move to the new one.
+func (p *APIKeyProxy) RotateAPIKey(w http.ResponseWriter, r *http.Request) {
+	claims, ok := keyAdmin(w, r)
+	if !ok {
+		return
+	}
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusNotFound, "api key not found")
+		return
+	}
+
+	key, secret, err := p.keys.Rotate(r.Context(), claims.TenantID, id, claims.UserID)
+	if errors.Is(err, apikey.ErrNotFound) {
+		writeError(w, http.StatusNotFound, "api key not found")
+		return
+	}
+	if err != nil {
+		p.logger.Error("failed to rotate api key", "tenant_id", claims.TenantID, "api_key_id", id, "error", err)
+		writeError(w, http.StatusInternalServerError, "failed to rotate api key")
+		return
+	}
+	p.logger.Info("api key rotated", "tenant_id", claims.TenantID, "api_key_id", id, "replacement_id", key.ID, "rotated_by", claims.UserID)
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusCreated, issuedAPIKeyResp{apiKeyResp: toAPIKeyResp(key), Key: secret})
+}
+
+// RevokeAPIKey handles POST /api/v1/api-keys/{id}/revoke. The key stops
+// working at once, even during a rotation's grace period.
+func (p *APIKeyProxy) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	claims, ok := keyAdmin(w, r)
+	if !ok {
+		return
+	}
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusNotFound, "api key not found")
+		return
+	}
+
+	err = p.keys.Revoke(r.Context(), claims.TenantID, id)
+	if errors.Is(err, apikey.ErrNotFound) {
+		writeError(w, http.StatusNotFound, "api key not found")
+		return
+	}
+	if err != nil {
+		p.logger.Error("failed to revoke api key", "tenant_id", claims.TenantID, "api_key_id", id, "error", err)
+		writeError(w, http.StatusInternalServerError, "failed to revoke api key")
+		return
+	}
+	p.logger.Info("api key revoked", "tenant_id", claims.TenantID, "api_key_id", id, "revoked_by", claims.UserID)
+	writeJSON(w, http.StatusOK, map[string]string{"status": "revoked"})
+}
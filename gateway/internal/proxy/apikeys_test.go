@@ -0,0 +1,119 @@
+package proxy
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/gateway/internal/apikey"
+	"github.com/bibbank/bib/gateway/internal/middleware"
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+func TestAPIKeyProxy(t *testing.T) {
+	store := auth.NewMemoryTokenStore()
+	jwtSvc, err := auth.NewJWTService(auth.JWTConfig{
+		Secret:      "test-secret-key",
+		Issuer:      "bib-gateway",
+		Expiration:  15 * time.Minute,
+		Revocations: store,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	keys := apikey.NewManager(apikey.NewMemoryStore(), time.Hour)
+	p := NewAPIKeyProxy(keys, logger)
+	a := NewAuthProxy(jwtSvc, auth.NewSessions(jwtSvc, store, time.Hour), nil, 0, logger)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v1/auth/token", a.Token)
+	mux.HandleFunc("POST /api/v1/api-keys", p.CreateAPIKey)
+	mux.HandleFunc("GET /api/v1/api-keys", p.ListAPIKeys)
+	mux.HandleFunc("POST /api/v1/api-keys/{id}/rotate", p.RotateAPIKey)
+	mux.HandleFunc("POST /api/v1/api-keys/{id}/revoke", p.RevokeAPIKey)
+	handler := middleware.AuthMiddleware(jwtSvc, nil)(mux)
+	handler = middleware.APIKeyMiddleware(middleware.NewAPIKeyAuthenticator(keys, jwtSvc), logger)(handler)
+
+	do := func(method, path, bearer, apiKey, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if bearer != "" {
+			req.Header.Set("Authorization", "Bearer "+bearer)
+		}
+		if apiKey != "" {
+			req.Header.Set(middleware.APIKeyHeader, apiKey)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	tenantID := uuid.New()
+	admin, _ := jwtSvc.GenerateToken(uuid.New(), tenantID, []string{auth.RoleAdmin})
+	customer, _ := jwtSvc.GenerateToken(uuid.New(), tenantID, []string{auth.RoleCustomer})
+
+	if rec := do(http.MethodPost, "/api/v1/api-keys", customer, "", `{"name":"sync"}`); rec.Code != http.StatusForbidden {
+		t.Errorf("create by non-admin: status = %d, want 403", rec.Code)
+	}
+	if rec := do(http.MethodPost, "/api/v1/api-keys", admin, "", `{"name":"sync","roles":["admin"]}`); rec.Code != http.StatusBadRequest {
+		t.Errorf("create with admin role: status = %d, want 400", rec.Code)
+	}
+
+	rec := do(http.MethodPost, "/api/v1/api-keys", admin, "", `{"name":"sync","rate_limit":20}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create: status = %d, want 201: %s", rec.Code, rec.Body)
+	}
+	if rec.Header().Get("Cache-Control") != "no-store" {
+		t.Error("create response may be cached")
+	}
+	var created issuedAPIKeyResp
+	if err := json.NewDecoder(rec.Body).Decode(&created); err != nil {
+		t.Fatal(err)
+	}
+	if created.Key == "" || created.RateLimit != 20 {
+		t.Fatalf("created = %+v, want a key with its secret", created)
+	}
+
+	// Keys cannot manage keys, nor start refresh sessions.
+	if rec := do(http.MethodGet, "/api/v1/api-keys", "", created.Key, ""); rec.Code != http.StatusForbidden {
+		t.Errorf("list with api key: status = %d, want 403", rec.Code)
+	}
+	if rec := do(http.MethodPost, "/api/v1/auth/token", "", created.Key, ""); rec.Code != http.StatusBadRequest {
+		t.Errorf("token with api key: status = %d, want 400", rec.Code)
+	}
+
+	rec = do(http.MethodPost, "/api/v1/api-keys/"+created.ID+"/rotate", admin, "", "")
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("rotate: status = %d, want 201: %s", rec.Code, rec.Body)
+	}
+	var rotated issuedAPIKeyResp
+	if err := json.NewDecoder(rec.Body).Decode(&rotated); err != nil {
+		t.Fatal(err)
+	}
+
+	if rec := do(http.MethodPost, "/api/v1/api-keys/"+created.ID+"/revoke", admin, "", ""); rec.Code != http.StatusOK {
+		t.Fatalf("revoke: status = %d, want 200", rec.Code)
+	}
+	if rec := do(http.MethodPost, "/api/v1/api-keys/"+uuid.NewString()+"/revoke", admin, "", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("revoke of unknown key: status = %d, want 404", rec.Code)
+	}
+
+	rec = do(http.MethodGet, "/api/v1/api-keys", admin, "", "")
+	var list listAPIKeysResp
+	if err := json.NewDecoder(rec.Body).Decode(&list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Keys) != 2 || list.Keys[0].ID != rotated.ID || list.Keys[1].RevokedAt == nil {
+		t.Errorf("list = %+v, want the rotated key and the revoked one", list.Keys)
+	}
+	if strings.Contains(rec.Body.String(), created.Key) {
+		t.Error("list shows a key's secret")
+	}
+}
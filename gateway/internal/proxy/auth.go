@@ -0,0 +1,212 @@
+package proxy
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/bibbank/bib/gateway/internal/middleware"
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// DefaultLoginMaxAge is how old a login token may be when AuthProxy is
+// given no maximum age.
+const DefaultLoginMaxAge = 5 * time.Minute
+
+// AuthProxy serves the gateway's token endpoints. Rather than calling a
+// backend, it issues and revokes tokens itself: a caller exchanges a login
+// token for a short-lived access token paired with a refresh token, renews
+// the pair with the refresh token, and revokes it on logout.
+type AuthProxy struct {
+	jwt         *auth.JWTService
+	sessions    *auth.Sessions
+	login       *auth.JWTService
+	logger      *slog.Logger
+	maxLoginAge time.Duration
+}
+
+// NewAuthProxy creates a new token endpoint handler signing access tokens
+// with jwt, which must be configured with a revocation list. Sessions are
+// started with login tokens validated by login, issued at most maxLoginAge
+// (DefaultLoginMaxAge if zero) before; login must be configured with a
+// revocation list too, to which each login token is added once it has been
+// used. With a nil login, sessions cannot be started.
+func NewAuthProxy(jwt *auth.JWTService, sessions *auth.Sessions, login *auth.JWTService, maxLoginAge time.Duration, logger *slog.Logger) *AuthProxy {
+	if maxLoginAge <= 0 {
+		maxLoginAge = DefaultLoginMaxAge
+	}
+	return &AuthProxy{jwt: jwt, sessions: sessions, login: login, logger: logger, maxLoginAge: maxLoginAge}
+}
+
+type tokenResp struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	RefreshToken     string `json:"refresh_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	RefreshExpiresIn int64  `json:"refresh_expires_in"`
+}
+
+type refreshTokenReq struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+func writeTokenPair(w http.ResponseWriter, pair auth.TokenPair) {
+	now := time.Now()
+	// Token responses must not be cached (RFC 6749, section 5.1).
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, tokenResp{
+		AccessToken:      pair.AccessToken,
+		TokenType:        "Bearer",
+		RefreshToken:     pair.RefreshToken,
+		ExpiresIn:        int64(pair.AccessExpiresAt.Sub(now).Seconds()),
+		RefreshExpiresIn: int64(pair.RefreshExpiresAt.Sub(now).Seconds()),
+	})
+}
+
+// Token handles POST /api/v1/auth/token, starting a refresh session for the
+// caller. The caller authenticates with a login token from the login
+// issuer, not with a token the gateway issued: neither a session's access
+// token nor a service or API key token can be turned into a long-lived
+// refresh token. The login token must be recent, and starts one session
+// only. The access token issued carries its user, tenant and roles.
+func (p *AuthProxy) Token(w http.ResponseWriter, r *http.Request) {
+	if _, ok := middleware.APIKeyFromContext(r.Context()); ok {
+		writeError(w, http.StatusBadRequest, "api keys cannot start a session; send the key with each request")
+		return
+	}
+	if p.login == nil {
+		writeError(w, http.StatusNotImplemented, "no login issuer configured")
+		return
+	}
+	// The route is public on the gateway: it is authenticated here, against
+	// the login issuer rather than the gateway's own tokens.
+	r, ok := middleware.Authenticate(p.login, w, r)
+	if !ok {
+		return
+	}
+	claims, ok := auth.ClaimsFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "missing claims")
+		return
+	}
+	if claims.ID == "" || claims.IssuedAt == nil {
+		writeError(w, http.StatusUnauthorized, "login token must carry jti and iat claims")
+		return
+	}
+	if age := time.Since(claims.IssuedAt.Time); age > p.maxLoginAge {
+		writeError(w, http.StatusUnauthorized, "login token too old; log in again")
+		return
+	}
+	// Use the login token up before starting the session, so that it cannot
+	// start another, even from a concurrent request.
+	if err := p.login.Consume(r.Context(), claims); errors.Is(err, auth.ErrTokenRevoked) {
+		writeError(w, http.StatusUnauthorized, "login token already used; log in again")
+		return
+	} else if err != nil {
+		p.logger.Error("failed to use up login token", "user_id", claims.UserID, "error", err)
+		writeError(w, http.StatusInternalServerError, "failed to issue token")
+		return
+	}
+	pair, err := p.sessions.Issue(r.Context(), claims.UserID, claims.TenantID, claims.Roles)
+	if err != nil {
+		p.logger.Error("failed to issue token pair", "user_id", claims.UserID, "error", err)
+		writeError(w, http.StatusInternalServerError, "failed to issue token")
+		return
+	}
+	writeTokenPair(w, pair)
+}
+
+// Refresh handles POST /api/v1/auth/refresh, exchanging a refresh token for
+// a new pair. It is served without an access token, as the caller's has
+// usually expired. A refresh token can be exchanged once: presenting it
+// again revokes its session.
+func (p *AuthProxy) Refresh(w http.ResponseWriter, r *http.Request) {
+	var req refreshTokenReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	if req.RefreshToken == "" {
+		writeError(w, http.StatusBadRequest, "refresh_token is required")
+		return
+	}
+
+	pair, err := p.sessions.Refresh(r.Context(), req.RefreshToken)
+	switch {
+	case errors.Is(err, auth.ErrRefreshTokenReused):
+		p.logger.Warn("refresh token reused, session revoked")
+		writeError(w, http.StatusUnauthorized, "invalid refresh token")
+	case errors.Is(err, auth.ErrInvalidRefreshToken):
+		writeError(w, http.StatusUnauthorized, "invalid refresh token")
+	case err != nil:
+		p.logger.Error("failed to refresh token pair", "error", err)
+		writeError(w, http.StatusInternalServerError, "failed to refresh token")
+	default:
+		writeTokenPair(w, pair)
+	}
+}
+
+// Revoke handles POST /api/v1/auth/revoke. With a refresh_token in the
+// body, it revokes that token's session, which must be the caller's own.
+// Without one, it logs the caller out: the access token presented is
+// revoked along with the session it was issued in, if any. Unknown refresh
+// tokens are not an error (RFC 7009, section 2.2). API keys are revoked
+// through /api/v1/api-keys instead. Revoked access tokens are refused by the
+// gateway only; services validating tokens against its JWKS accept them
+// until they expire.
+func (p *AuthProxy) Revoke(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.ClaimsFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "missing claims")
+		return
+	}
+	if _, ok := middleware.APIKeyFromContext(r.Context()); ok {
+		writeError(w, http.StatusBadRequest, "api keys are revoked with /api/v1/api-keys/{id}/revoke")
+		return
+	}
+	var req refreshTokenReq
+	if r.ContentLength != 0 {
+		if err := readJSON(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+			return
+		}
+	}
+
+	if req.RefreshToken != "" {
+		rt, err := p.sessions.Lookup(r.Context(), req.RefreshToken)
+		switch {
+		case errors.Is(err, auth.ErrInvalidRefreshToken):
+			// Nothing to revoke.
+		case err != nil:
+			p.logger.Error("failed to look up refresh token", "error", err)
+			writeError(w, http.StatusInternalServerError, "failed to revoke token")
+			return
+		case rt.UserID != claims.UserID || rt.TenantID != claims.TenantID:
+			writeError(w, http.StatusForbidden, "refresh token belongs to another user")
+			return
+		default:
+			if err := p.sessions.RevokeSession(r.Context(), rt.SessionID); err != nil {
+				p.logger.Error("failed to revoke session", "session_id", rt.SessionID, "error", err)
+				writeError(w, http.StatusInternalServerError, "failed to revoke token")
+				return
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"status": "revoked"})
+		return
+	}
+
+	if claims.SessionID != "" {
+		if err := p.sessions.RevokeSession(r.Context(), claims.SessionID); err != nil {
+			p.logger.Error("failed to revoke session", "session_id", claims.SessionID, "error", err)
+			writeError(w, http.StatusInternalServerError, "failed to revoke token")
+			return
+		}
+	}
+	if err := p.jwt.Revoke(r.Context(), claims); err != nil {
+		p.logger.Error("failed to revoke access token", "user_id", claims.UserID, "error", err)
+		writeError(w, http.StatusInternalServerError, "failed to revoke token")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "revoked"})
+}
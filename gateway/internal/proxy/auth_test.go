@@ -0,0 +1,245 @@
+package proxy
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/gateway/internal/middleware"
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// authFixture serves the token endpoints and a protected route behind the
+// auth middleware, as the gateway does.
+type authFixture struct {
+	jwt     *auth.JWTService
+	login   *auth.JWTService
+	handler http.Handler
+}
+
+func newAuthFixture(t *testing.T, maxLoginAge time.Duration) *authFixture {
+	t.Helper()
+	store := auth.NewMemoryTokenStore()
+	jwtSvc, err := auth.NewJWTService(auth.JWTConfig{
+		Secret:      "test-secret-key",
+		Issuer:      "bib-gateway",
+		Expiration:  15 * time.Minute,
+		Revocations: store,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	login, err := auth.NewJWTService(auth.JWTConfig{
+		Secret:      "test-login-key",
+		Issuer:      "bib-identity",
+		Expiration:  time.Hour,
+		Revocations: store,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewAuthProxy(jwtSvc, auth.NewSessions(jwtSvc, store, time.Hour), login, maxLoginAge, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v1/auth/token", p.Token)
+	mux.HandleFunc("POST /api/v1/auth/refresh", p.Refresh)
+	mux.HandleFunc("POST /api/v1/auth/revoke", p.Revoke)
+	mux.HandleFunc("GET /api/v1/accounts", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	return &authFixture{
+		jwt:     jwtSvc,
+		login:   login,
+		handler: middleware.AuthMiddleware(jwtSvc, []string{"/api/v1/auth/token", "/api/v1/auth/refresh"})(mux),
+	}
+}
+
+// loginToken returns a login token for the given user, as the login issuer
+// would.
+func (f *authFixture) loginToken(t *testing.T, userID, tenantID uuid.UUID) string {
+	t.Helper()
+	token, err := f.login.GenerateToken(userID, tenantID, []string{auth.RoleCustomer})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func (f *authFixture) do(t *testing.T, method, path, bearer, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if bearer != "" {
+		req.Header.Set("Authorization", "Bearer "+bearer)
+	}
+	rec := httptest.NewRecorder()
+	f.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeTokenResp(t *testing.T, rec *httptest.ResponseRecorder) tokenResp {
+	t.Helper()
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", rec.Code, rec.Body)
+	}
+	var resp tokenResp
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.AccessToken == "" || resp.RefreshToken == "" || resp.TokenType != "Bearer" {
+		t.Fatalf("token response = %+v, want a bearer token pair", resp)
+	}
+	return resp
+}
+
+func TestAuthProxy_TokenRefreshRevoke(t *testing.T) {
+	f := newAuthFixture(t, 0)
+	userID, tenantID := uuid.New(), uuid.New()
+	login := f.loginToken(t, userID, tenantID)
+
+	pair := decodeTokenResp(t, f.do(t, http.MethodPost, "/api/v1/auth/token", login, ""))
+	if pair.ExpiresIn <= 0 || pair.ExpiresIn > 900 || pair.RefreshExpiresIn <= pair.ExpiresIn {
+		t.Errorf("expires_in = %d, refresh_expires_in = %d", pair.ExpiresIn, pair.RefreshExpiresIn)
+	}
+	// A login token starts one session only.
+	if rec := f.do(t, http.MethodPost, "/api/v1/auth/token", login, ""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("reused login token: status = %d, want 401", rec.Code)
+	}
+	// Tokens the gateway issued cannot start a session, whether a session's
+	// or not.
+	if rec := f.do(t, http.MethodPost, "/api/v1/auth/token", pair.AccessToken, ""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("token from session token: status = %d, want 401", rec.Code)
+	}
+	service, err := f.jwt.GenerateToken(userID, tenantID, []string{auth.RoleCustomer})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec := f.do(t, http.MethodPost, "/api/v1/auth/token", service, ""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("token from gateway token: status = %d, want 401", rec.Code)
+	}
+
+	// Refreshing needs no access token and rotates the refresh token.
+	next := decodeTokenResp(t, f.do(t, http.MethodPost, "/api/v1/auth/refresh", "", `{"refresh_token":"`+pair.RefreshToken+`"}`))
+	if next.RefreshToken == pair.RefreshToken {
+		t.Error("refresh did not rotate the refresh token")
+	}
+	if rec := f.do(t, http.MethodPost, "/api/v1/auth/refresh", "", `{"refresh_token":"`+pair.RefreshToken+`"}`); rec.Code != http.StatusUnauthorized {
+		t.Errorf("reused refresh token: status = %d, want 401", rec.Code)
+	}
+	// The reuse revoked the session.
+	if rec := f.do(t, http.MethodPost, "/api/v1/auth/refresh", "", `{"refresh_token":"`+next.RefreshToken+`"}`); rec.Code != http.StatusUnauthorized {
+		t.Errorf("refresh token of revoked session: status = %d, want 401", rec.Code)
+	}
+
+	// Logging out revokes the access token presented and its session.
+	third := decodeTokenResp(t, f.do(t, http.MethodPost, "/api/v1/auth/token", f.loginToken(t, userID, tenantID), ""))
+	if rec := f.do(t, http.MethodGet, "/api/v1/accounts", third.AccessToken, ""); rec.Code != http.StatusOK {
+		t.Fatalf("access token before logout: status = %d, want 200", rec.Code)
+	}
+	if rec := f.do(t, http.MethodPost, "/api/v1/auth/revoke", third.AccessToken, ""); rec.Code != http.StatusOK {
+		t.Fatalf("revoke: status = %d, want 200: %s", rec.Code, rec.Body)
+	}
+	if rec := f.do(t, http.MethodGet, "/api/v1/accounts", third.AccessToken, ""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("access token after logout: status = %d, want 401", rec.Code)
+	}
+	if rec := f.do(t, http.MethodPost, "/api/v1/auth/refresh", "", `{"refresh_token":"`+third.RefreshToken+`"}`); rec.Code != http.StatusUnauthorized {
+		t.Errorf("refresh token after logout: status = %d, want 401", rec.Code)
+	}
+}
+
+func TestAuthProxy_TokenConcurrentLogin(t *testing.T) {
+	f := newAuthFixture(t, 0)
+	login := f.loginToken(t, uuid.New(), uuid.New())
+
+	const requests = 8
+	codes := make(chan int, requests)
+	var wg sync.WaitGroup
+	for range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			codes <- f.do(t, http.MethodPost, "/api/v1/auth/token", login, "").Code
+		}()
+	}
+	wg.Wait()
+	close(codes)
+
+	// A login token starts one session only, however many requests race.
+	started := 0
+	for code := range codes {
+		switch code {
+		case http.StatusOK:
+			started++
+		case http.StatusUnauthorized:
+		default:
+			t.Errorf("status = %d, want 200 or 401", code)
+		}
+	}
+	if started != 1 {
+		t.Errorf("sessions started = %d, want 1", started)
+	}
+}
+
+func TestAuthProxy_TokenMaxLoginAge(t *testing.T) {
+	f := newAuthFixture(t, time.Nanosecond)
+	login := f.loginToken(t, uuid.New(), uuid.New())
+	if rec := f.do(t, http.MethodPost, "/api/v1/auth/token", login, ""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("stale login token: status = %d, want 401", rec.Code)
+	}
+}
+
+func TestAuthProxy_TokenWithoutLoginIssuer(t *testing.T) {
+	store := auth.NewMemoryTokenStore()
+	jwtSvc, err := auth.NewJWTService(auth.JWTConfig{Secret: "test-secret-key", Issuer: "bib-gateway", Expiration: time.Minute, Revocations: store})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewAuthProxy(jwtSvc, auth.NewSessions(jwtSvc, store, time.Hour), nil, 0, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	rec := httptest.NewRecorder()
+	p.Token(rec, httptest.NewRequest(http.MethodPost, "/api/v1/auth/token", nil))
+	if rec.Code != http.StatusNotImplemented {
+		t.Errorf("status = %d, want 501", rec.Code)
+	}
+}
+
+func TestAuthProxy_RevokeRefreshToken(t *testing.T) {
+	f := newAuthFixture(t, 0)
+	userID, tenantID := uuid.New(), uuid.New()
+	pair := decodeTokenResp(t, f.do(t, http.MethodPost, "/api/v1/auth/token", f.loginToken(t, userID, tenantID), ""))
+	caller, err := f.jwt.GenerateToken(userID, tenantID, []string{auth.RoleCustomer})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Another user cannot revoke the session.
+	other, err := f.jwt.GenerateToken(uuid.New(), tenantID, []string{auth.RoleCustomer})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := `{"refresh_token":"` + pair.RefreshToken + `"}`
+	if rec := f.do(t, http.MethodPost, "/api/v1/auth/revoke", other, body); rec.Code != http.StatusForbidden {
+		t.Errorf("revoke by another user: status = %d, want 403", rec.Code)
+	}
+	// Unknown refresh tokens are not an error.
+	if rec := f.do(t, http.MethodPost, "/api/v1/auth/revoke", caller, `{"refresh_token":"unknown"}`); rec.Code != http.StatusOK {
+		t.Errorf("revoke of unknown token: status = %d, want 200", rec.Code)
+	}
+
+	if rec := f.do(t, http.MethodPost, "/api/v1/auth/revoke", caller, body); rec.Code != http.StatusOK {
+		t.Fatalf("revoke: status = %d, want 200", rec.Code)
+	}
+	if rec := f.do(t, http.MethodPost, "/api/v1/auth/refresh", "", body); rec.Code != http.StatusUnauthorized {
+		t.Errorf("revoked refresh token: status = %d, want 401", rec.Code)
+	}
+	// Revoking a refresh token leaves the caller's own access token valid.
+	if rec := f.do(t, http.MethodGet, "/api/v1/accounts", caller, ""); rec.Code != http.StatusOK {
+		t.Errorf("caller's token after revoking a session: status = %d, want 200", rec.Code)
+	}
+}
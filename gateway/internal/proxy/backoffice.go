@@ -0,0 +1,413 @@
+package proxy
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	backofficev1 "github.com/bibbank/bib/api/gen/go/bib/backoffice/v1"
+)
+
+// BackofficeProxy proxies HTTP requests from the operations console to the
+// backoffice gRPC service: the work queues of fraud reviews, payment
+// repairs, reconciliation breaks, KYC reviews and loan collections.
+// Operators call it on the gateway's backoffice listener with tokens of the
+// backoffice realm.
+type BackofficeProxy struct {
+	client backofficev1.BackofficeServiceClient
+	logger *slog.Logger
+}
+
+// NewBackofficeProxy creates a new backoffice service proxy.
+func NewBackofficeProxy(conn *ServiceConn, logger *slog.Logger) *BackofficeProxy {
+	return &BackofficeProxy{client: backofficev1.NewBackofficeServiceClient(conn), logger: logger}
+}
+
+const (
+	queueHint      = "queue must be FRAUD_REVIEW, PAYMENT_REPAIR, RECONCILIATION_BREAK, KYC_REVIEW or LOAN_COLLECTION"
+	taskStatusHint = "status must be OPEN, CLAIMED or RESOLVED"
+)
+
+type openTaskReq struct {
+	Details  map[string]string `json:"details,omitempty"`
+	Queue    string            `json:"queue"`
+	SourceID string            `json:"source_id"`
+	Summary  string            `json:"summary"`
+	DueAt    string            `json:"due_at,omitempty"`
+}
+
+type releaseTaskReq struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+type addTaskNoteReq struct {
+	Note string `json:"note"`
+}
+
+type resolveTaskReq struct {
+	Outcome string `json:"outcome"`
+	Note    string `json:"note"`
+}
+
+type taskMsg struct {
+	TaskID         string            `json:"task_id"`
+	TenantID       string            `json:"tenant_id"`
+	Queue          string            `json:"queue"`
+	SourceID       string            `json:"source_id"`
+	Summary        string            `json:"summary"`
+	Details        map[string]string `json:"details,omitempty"`
+	Status         string            `json:"status"`
+	ClaimedBy      string            `json:"claimed_by,omitempty"`
+	ClaimedAt      string            `json:"claimed_at,omitempty"`
+	DueAt          string            `json:"due_at,omitempty"`
+	Overdue        bool              `json:"overdue"`
+	Outcome        string            `json:"outcome,omitempty"`
+	ResolutionNote string            `json:"resolution_note,omitempty"`
+	ResolvedBy     string            `json:"resolved_by,omitempty"`
+	ResolvedAt     string            `json:"resolved_at,omitempty"`
+	Version        int32             `json:"version"`
+	CreatedAt      string            `json:"created_at"`
+	UpdatedAt      string            `json:"updated_at"`
+}
+
+type taskAuditEntryMsg struct {
+	EntryID    string `json:"entry_id"`
+	Action     string `json:"action"`
+	ActorID    string `json:"actor_id,omitempty"`
+	Detail     string `json:"detail,omitempty"`
+	OccurredAt string `json:"occurred_at"`
+}
+
+type taskResp struct {
+	Task *taskMsg `json:"task"`
+}
+
+type getTaskResp struct {
+	Task       *taskMsg             `json:"task"`
+	AuditTrail []*taskAuditEntryMsg `json:"audit_trail"`
+}
+
+type listTasksResp struct {
+	Tasks      []*taskMsg `json:"tasks"`
+	TotalCount int32      `json:"total_count"`
+}
+
+type queueSummaryMsg struct {
+	Queue           string `json:"queue"`
+	Open            int32  `json:"open"`
+	Claimed         int32  `json:"claimed"`
+	Overdue         int32  `json:"overdue"`
+	ClaimedByCaller int32  `json:"claimed_by_caller"`
+}
+
+type queueSummaryResp struct {
+	Queues []*queueSummaryMsg `json:"queues"`
+}
+
+type timelineEventMsg struct {
+	Details    map[string]string `json:"details,omitempty"`
+	EventID    string            `json:"event_id"`
+	Stage      string            `json:"stage"`
+	EventType  string            `json:"event_type"`
+	Source     string            `json:"source"`
+	Summary    string            `json:"summary"`
+	OccurredAt string            `json:"occurred_at"`
+}
+
+type transactionTimelineResp struct {
+	TransactionID string              `json:"transaction_id"`
+	Events        []*timelineEventMsg `json:"events"`
+}
+
+// ListTasks handles GET /backoffice/v1/tasks.
+// Query parameters: queue, status, claimed_by ("me" for the caller),
+// overdue, page_size, offset.
+func (p *BackofficeProxy) ListTasks(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	req := &backofficev1.ListTasksRequest{
+		ClaimedBy:   q.Get("claimed_by"),
+		OverdueOnly: q.Get("overdue") == "true",
+		PageSize:    pageSize,
+		Offset:      offset,
+	}
+	if v := q.Get("queue"); v != "" {
+		if req.Queue, ok = parseQueue(v); !ok {
+			writeError(w, http.StatusBadRequest, queueHint)
+			return
+		}
+	}
+	if v := q.Get("status"); v != "" {
+		s, found := backofficev1.TaskStatus_value["TASK_STATUS_"+strings.ToUpper(v)]
+		if !found || s == 0 {
+			writeError(w, http.StatusBadRequest, taskStatusHint)
+			return
+		}
+		req.Status = backofficev1.TaskStatus(s)
+	}
+
+	resp, err := p.client.ListTasks(r.Context(), req)
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listTasksResp{
+		Tasks:      make([]*taskMsg, 0, len(resp.GetTasks())),
+		TotalCount: resp.GetTotalCount(),
+	}
+	for _, t := range resp.GetTasks() {
+		out.Tasks = append(out.Tasks, toTaskMsg(t))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetTask handles GET /backoffice/v1/tasks/{id}, returning the task with
+// its audit trail.
+func (p *BackofficeProxy) GetTask(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "task id is required")
+		return
+	}
+
+	resp, err := p.client.GetTask(r.Context(), &backofficev1.GetTaskRequest{TaskId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := getTaskResp{
+		Task:       toTaskMsg(resp.GetTask()),
+		AuditTrail: make([]*taskAuditEntryMsg, 0, len(resp.GetAuditTrail())),
+	}
+	for _, e := range resp.GetAuditTrail() {
+		out.AuditTrail = append(out.AuditTrail, &taskAuditEntryMsg{
+			EntryID:    e.GetEntryId(),
+			Action:     e.GetAction(),
+			ActorID:    e.GetActorId(),
+			Detail:     e.GetDetail(),
+			OccurredAt: formatTimestamp(e.GetOccurredAt()),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// OpenTask handles POST /backoffice/v1/tasks, raising a task by hand, such
+// as a reconciliation break. due_at is RFC 3339.
+func (p *BackofficeProxy) OpenTask(w http.ResponseWriter, r *http.Request) {
+	var req openTaskReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	queue, ok := parseQueue(req.Queue)
+	if !ok {
+		writeError(w, http.StatusBadRequest, queueHint)
+		return
+	}
+	var dueAt *timestamppb.Timestamp
+	if req.DueAt != "" {
+		t, err := time.Parse(time.RFC3339, req.DueAt)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "due_at must be an RFC 3339 timestamp")
+			return
+		}
+		dueAt = timestamppb.New(t)
+	}
+
+	resp, err := p.client.OpenTask(r.Context(), &backofficev1.OpenTaskRequest{
+		Queue:    queue,
+		SourceId: req.SourceID,
+		Summary:  req.Summary,
+		Details:  req.Details,
+		DueAt:    dueAt,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusCreated, taskResp{Task: toTaskMsg(resp.GetTask())})
+}
+
+// ClaimTask handles POST /backoffice/v1/tasks/{id}/claim.
+func (p *BackofficeProxy) ClaimTask(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "task id is required")
+		return
+	}
+
+	resp, err := p.client.ClaimTask(r.Context(), &backofficev1.ClaimTaskRequest{TaskId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, taskResp{Task: toTaskMsg(resp.GetTask())})
+}
+
+// ReleaseTask handles POST /backoffice/v1/tasks/{id}/release, handing a
+// claimed task back to its queue.
+func (p *BackofficeProxy) ReleaseTask(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "task id is required")
+		return
+	}
+	var req releaseTaskReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.ReleaseTask(r.Context(), &backofficev1.ReleaseTaskRequest{
+		TaskId: id,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, taskResp{Task: toTaskMsg(resp.GetTask())})
+}
+
+// AddTaskNote handles POST /backoffice/v1/tasks/{id}/notes.
+func (p *BackofficeProxy) AddTaskNote(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "task id is required")
+		return
+	}
+	var req addTaskNoteReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.AddTaskNote(r.Context(), &backofficev1.AddTaskNoteRequest{
+		TaskId: id,
+		Note:   req.Note,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, taskResp{Task: toTaskMsg(resp.GetTask())})
+}
+
+// ResolveTask handles POST /backoffice/v1/tasks/{id}/resolve, closing the
+// caller's claimed task with one of its queue's outcomes.
+func (p *BackofficeProxy) ResolveTask(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "task id is required")
+		return
+	}
+	var req resolveTaskReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.ResolveTask(r.Context(), &backofficev1.ResolveTaskRequest{
+		TaskId:  id,
+		Outcome: req.Outcome,
+		Note:    req.Note,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, taskResp{Task: toTaskMsg(resp.GetTask())})
+}
+
+// GetQueueSummary handles GET /backoffice/v1/queues.
+func (p *BackofficeProxy) GetQueueSummary(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.GetQueueSummary(r.Context(), &backofficev1.GetQueueSummaryRequest{})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := queueSummaryResp{Queues: make([]*queueSummaryMsg, 0, len(resp.GetQueues()))}
+	for _, q := range resp.GetQueues() {
+		out.Queues = append(out.Queues, &queueSummaryMsg{
+			Queue:           enumName(q.GetQueue().String(), "QUEUE_"),
+			Open:            q.GetOpen(),
+			Claimed:         q.GetClaimed(),
+			Overdue:         q.GetOverdue(),
+			ClaimedByCaller: q.GetClaimedByCaller(),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetTransactionTimeline handles GET /backoffice/v1/transactions/{id}/timeline,
+// returning a payment's or card transaction's journey through the platform,
+// oldest event first.
+func (p *BackofficeProxy) GetTransactionTimeline(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "transaction id is required")
+		return
+	}
+
+	resp, err := p.client.GetTransactionTimeline(r.Context(), &backofficev1.GetTransactionTimelineRequest{TransactionId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := transactionTimelineResp{
+		TransactionID: resp.GetTransactionId(),
+		Events:        make([]*timelineEventMsg, 0, len(resp.GetEvents())),
+	}
+	for _, e := range resp.GetEvents() {
+		out.Events = append(out.Events, &timelineEventMsg{
+			EventID:    e.GetEventId(),
+			Stage:      enumName(e.GetStage().String(), "TIMELINE_STAGE_"),
+			EventType:  e.GetEventType(),
+			Source:     e.GetSource(),
+			Summary:    e.GetSummary(),
+			Details:    e.GetDetails(),
+			OccurredAt: formatTimestamp(e.GetOccurredAt()),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func parseQueue(v string) (backofficev1.Queue, bool) {
+	q, found := backofficev1.Queue_value["QUEUE_"+strings.ToUpper(v)]
+	if !found || q == 0 {
+		return 0, false
+	}
+	return backofficev1.Queue(q), true
+}
+
+func toTaskMsg(t *backofficev1.Task) *taskMsg {
+	if t == nil {
+		return nil
+	}
+	return &taskMsg{
+		TaskID:         t.GetTaskId(),
+		TenantID:       t.GetTenantId(),
+		Queue:          enumName(t.GetQueue().String(), "QUEUE_"),
+		SourceID:       t.GetSourceId(),
+		Summary:        t.GetSummary(),
+		Details:        t.GetDetails(),
+		Status:         enumName(t.GetStatus().String(), "TASK_STATUS_"),
+		ClaimedBy:      t.GetClaimedBy(),
+		ClaimedAt:      formatTimestamp(t.GetClaimedAt()),
+		DueAt:          formatTimestamp(t.GetDueAt()),
+		Overdue:        t.GetOverdue(),
+		Outcome:        t.GetOutcome(),
+		ResolutionNote: t.GetResolutionNote(),
+		ResolvedBy:     t.GetResolvedBy(),
+		ResolvedAt:     formatTimestamp(t.GetResolvedAt()),
+		Version:        t.GetVersion(),
+		CreatedAt:      formatTimestamp(t.GetCreatedAt()),
+		UpdatedAt:      formatTimestamp(t.GetUpdatedAt()),
+	}
+}
@@ -0,0 +1,68 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const taskJSON = `{
+	"task_id": "5a7c9e1f-3b4d-4f6a-8c8e-0f2b4d6a8c1e",
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"queue": "QUEUE_RECONCILIATION_BREAK",
+	"source_id": "REC-2026-0115",
+	"summary": "Nostro statement differs by 12.50 EUR",
+	"details": {"difference": "12.50"},
+	"status": "TASK_STATUS_OPEN",
+	"version": 1,
+	"created_at": "2026-01-15T09:00:00Z",
+	"updated_at": "2026-01-15T09:00:00Z"
+}`
+
+func TestBackofficeContract(t *testing.T) {
+	stub, conn := newContractStub(t, "backoffice-service")
+	p := NewBackofficeProxy(conn, conn.Logger)
+	taskID := contractTaskID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "open a reconciliation break",
+		Method:      "/bib.backoffice.v1.BackofficeService/OpenTask",
+		Response:    json.RawMessage(`{"task": ` + taskJSON + `}`),
+	})
+	rec := call(t, p.OpenTask, http.MethodPost, "/backoffice/v1/tasks", `{"queue": "RECONCILIATION_BREAK",
+		"source_id": "REC-2026-0115", "summary": "Nostro statement differs by 12.50 EUR", "details": {"difference": "12.50"}}`)
+	if rec.Code != http.StatusCreated {
+		t.Errorf("OpenTask = %d %s", rec.Code, rec.Body)
+	} else if task, _ := decodeBody(t, rec)["task"].(map[string]any); task["queue"] != "RECONCILIATION_BREAK" {
+		t.Errorf("OpenTask task = %v", task)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a task with its audit trail",
+		State:       "a reconciliation break is open",
+		Method:      "/bib.backoffice.v1.BackofficeService/GetTask",
+		Response: json.RawMessage(`{"task": ` + taskJSON + `, "audit_trail": [{"entry_id":
+			"6b8d0f2a-4c5e-4a7b-9d9f-1a3c5e7b9d2f", "action": "OPENED", "occurred_at": "2026-01-15T09:00:00Z"}]}`),
+	})
+	rec = call(t, p.GetTask, http.MethodGet, "/backoffice/v1/tasks/"+taskID, "", "id", taskID)
+	if rec.Code != http.StatusOK {
+		t.Errorf("GetTask = %d %s", rec.Code, rec.Body)
+	} else if trail, _ := decodeBody(t, rec)["audit_trail"].([]any); len(trail) != 1 {
+		t.Errorf("GetTask audit_trail = %v", trail)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing task",
+		Method:      "/bib.backoffice.v1.BackofficeService/GetTask",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetTask, http.MethodGet, "/backoffice/v1/tasks/"+taskID, "", "id", taskID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetTask of a missing task = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-backoffice-service.json"))
+}
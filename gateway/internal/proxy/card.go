@@ -3,19 +3,22 @@ package proxy
 import (
 	"log/slog"
 	"net/http"
+	"strings"
 
+	cardv1 "github.com/bibbank/bib/api/gen/go/bib/card/v1"
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
 	"github.com/bibbank/bib/pkg/auth"
 )
 
 // CardProxy proxies HTTP requests to the card gRPC service.
 type CardProxy struct {
-	conn   *ServiceConn
+	client cardv1.CardServiceClient
 	logger *slog.Logger
 }
 
 // NewCardProxy creates a new card service proxy.
 func NewCardProxy(conn *ServiceConn, logger *slog.Logger) *CardProxy {
-	return &CardProxy{conn: conn, logger: logger}
+	return &CardProxy{client: cardv1.NewCardServiceClient(conn), logger: logger}
 }
 
 type issueCardReq struct {
@@ -33,16 +36,37 @@ type issueCardResp struct {
 }
 
 type cardResp struct {
-	CardID       string `json:"card_id"`
-	TenantID     string `json:"tenant_id"`
-	AccountID    string `json:"account_id"`
-	CardType     string `json:"card_type"`
-	Status       string `json:"status"`
-	Currency     string `json:"currency"`
-	DailyLimit   string `json:"daily_limit"`
-	MonthlyLimit string `json:"monthly_limit"`
-	MaskedPAN    string `json:"masked_pan"`
-	Version      int32  `json:"version"`
+	CardID       string               `json:"card_id"`
+	TenantID     string               `json:"tenant_id"`
+	AccountID    string               `json:"account_id"`
+	CardType     string               `json:"card_type"`
+	Status       string               `json:"status"`
+	Currency     string               `json:"currency"`
+	DailyLimit   string               `json:"daily_limit"`
+	MonthlyLimit string               `json:"monthly_limit"`
+	MaskedPAN    string               `json:"masked_pan"`
+	Controls     *spendingControlsMsg `json:"spending_controls,omitempty"`
+	Version      int32                `json:"version"`
+}
+
+type spendingControlsMsg struct {
+	AllowedMCCs       []string           `json:"allowed_mccs,omitempty"`
+	BlockedMCCs       []string           `json:"blocked_mccs,omitempty"`
+	MaxPerTransaction string             `json:"max_per_transaction,omitempty"`
+	AllowedCountries  []string           `json:"allowed_countries,omitempty"`
+	BlockedCountries  []string           `json:"blocked_countries,omitempty"`
+	VelocityLimits    []velocityLimitMsg `json:"velocity_limits,omitempty"`
+}
+
+type velocityLimitMsg struct {
+	Window    string `json:"window"`
+	MaxAmount string `json:"max_amount,omitempty"`
+	MaxCount  int32  `json:"max_count,omitempty"`
+}
+
+type updateSpendingControlsResp struct {
+	Controls *spendingControlsMsg `json:"spending_controls"`
+	CardID   string               `json:"card_id"`
 }
 
 type authorizeTransactionReq struct {
@@ -51,11 +75,19 @@ type authorizeTransactionReq struct {
 	Currency         string `json:"currency"`
 	MerchantName     string `json:"merchant_name"`
 	MerchantCategory string `json:"merchant_category"`
+	MerchantCountry  string `json:"merchant_country,omitempty"`
+}
+
+type merchantMsg struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Logo     string `json:"logo,omitempty"`
 }
 
 type authorizeTransactionResp struct {
-	DeclineReason string `json:"decline_reason,omitempty"`
-	Approved      bool   `json:"approved"`
+	Merchant      *merchantMsg `json:"merchant,omitempty"`
+	DeclineReason string       `json:"decline_reason,omitempty"`
+	Approved      bool         `json:"approved"`
 }
 
 type freezeCardResp struct {
@@ -77,13 +109,22 @@ func (p *CardProxy) IssueCard(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	var resp issueCardResp
-	err := p.conn.Invoke(r.Context(), "/bib.card.v1.CardService/IssueCard", &req, &resp)
+	resp, err := p.client.IssueCard(r.Context(), &cardv1.IssueCardRequest{
+		TenantId:     req.TenantID,
+		AccountId:    req.AccountID,
+		Type:         cardv1.CardType(cardv1.CardType_value["CARD_TYPE_"+req.CardType]),
+		DailyLimit:   req.DailyLimit,
+		MonthlyLimit: req.MonthlyLimit,
+		Currency:     req.Currency,
+	})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusCreated, resp)
+	writeJSON(w, http.StatusCreated, issueCardResp{
+		CardID: resp.GetCard().GetId(),
+		Status: cardStatusName(resp.GetCard().GetStatus()),
+	})
 }
 
 // GetCard handles GET /api/v1/cards/{id}.
@@ -94,14 +135,12 @@ func (p *CardProxy) GetCard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req := map[string]string{"card_id": cardID}
-	var resp cardResp
-	err := p.conn.Invoke(r.Context(), "/bib.card.v1.CardService/GetCard", &req, &resp)
+	resp, err := p.client.GetCard(r.Context(), &cardv1.GetCardRequest{Id: cardID})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, toCardResp(resp.GetCard()))
 }
 
 // FreezeCard handles POST /api/v1/cards/{id}/freeze.
@@ -112,14 +151,15 @@ func (p *CardProxy) FreezeCard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req := map[string]string{"card_id": cardID}
-	var resp freezeCardResp
-	err := p.conn.Invoke(r.Context(), "/bib.card.v1.CardService/FreezeCard", &req, &resp)
+	resp, err := p.client.FreezeCard(r.Context(), &cardv1.FreezeCardRequest{CardId: cardID})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, freezeCardResp{
+		CardID: resp.GetCardId(),
+		Status: cardStatusName(resp.GetStatus()),
+	})
 }
 
 // AuthorizeTransaction handles POST /api/v1/cards/{id}/authorize.
@@ -135,13 +175,115 @@ func (p *CardProxy) AuthorizeTransaction(w http.ResponseWriter, r *http.Request)
 		writeError(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	req.CardID = cardID
 
-	var resp authorizeTransactionResp
-	err := p.conn.Invoke(r.Context(), "/bib.card.v1.CardService/AuthorizeTransaction", &req, &resp)
+	resp, err := p.client.AuthorizeTransaction(r.Context(), &cardv1.AuthorizeTransactionRequest{
+		CardId:           cardID,
+		Amount:           &commonv1.Money{Amount: req.Amount, Currency: req.Currency},
+		MerchantName:     req.MerchantName,
+		MerchantCategory: req.MerchantCategory,
+		MerchantCountry:  req.MerchantCountry,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := authorizeTransactionResp{
+		Approved:      resp.GetApproved(),
+		DeclineReason: resp.GetDeclineReason(),
+	}
+	if m := resp.GetMerchant(); m != nil {
+		out.Merchant = &merchantMsg{Name: m.GetName(), Category: m.GetCategory(), Logo: m.GetLogo()}
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// UpdateSpendingControls handles PUT /api/v1/cards/{id}/spending-controls,
+// replacing the card's spending controls with those in the body.
+func (p *CardProxy) UpdateSpendingControls(w http.ResponseWriter, r *http.Request) {
+	cardID := r.PathValue("id")
+	if cardID == "" {
+		writeError(w, http.StatusBadRequest, "card id is required")
+		return
+	}
+
+	var controls spendingControlsMsg
+	if err := readJSON(r, &controls); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.UpdateSpendingControls(r.Context(), &cardv1.UpdateSpendingControlsRequest{
+		CardId:           cardID,
+		SpendingControls: fromSpendingControlsMsg(controls),
+	})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, updateSpendingControlsResp{
+		CardID:   resp.GetCardId(),
+		Controls: toSpendingControlsMsg(resp.GetSpendingControls()),
+	})
+}
+
+func toCardResp(c *cardv1.Card) cardResp {
+	return cardResp{
+		CardID:       c.GetId(),
+		TenantID:     c.GetTenantId(),
+		AccountID:    c.GetAccountId(),
+		CardType:     strings.TrimPrefix(c.GetType().String(), "CARD_TYPE_"),
+		Status:       cardStatusName(c.GetStatus()),
+		Currency:     c.GetDailyLimit().GetCurrency(),
+		DailyLimit:   c.GetDailyLimit().GetAmount(),
+		MonthlyLimit: c.GetMonthlyLimit().GetAmount(),
+		MaskedPAN:    c.GetLastFour(),
+		Controls:     toSpendingControlsMsg(c.GetSpendingControls()),
+		Version:      c.GetAudit().GetVersion(),
+	}
+}
+
+func cardStatusName(s cardv1.CardStatus) string {
+	return strings.TrimPrefix(s.String(), "CARD_STATUS_")
+}
+
+// fromSpendingControlsMsg converts controls from the request body. Velocity
+// windows the card service does not know are sent unspecified, which it
+// rejects.
+func fromSpendingControlsMsg(m spendingControlsMsg) *cardv1.SpendingControls {
+	controls := &cardv1.SpendingControls{
+		AllowedMccs:       m.AllowedMCCs,
+		BlockedMccs:       m.BlockedMCCs,
+		MaxPerTransaction: m.MaxPerTransaction,
+		AllowedCountries:  m.AllowedCountries,
+		BlockedCountries:  m.BlockedCountries,
+	}
+	for _, l := range m.VelocityLimits {
+		controls.VelocityLimits = append(controls.VelocityLimits, &cardv1.VelocityLimit{
+			Window:    cardv1.VelocityWindow(cardv1.VelocityWindow_value["VELOCITY_WINDOW_"+l.Window]),
+			MaxCount:  l.MaxCount,
+			MaxAmount: l.MaxAmount,
+		})
+	}
+	return controls
+}
+
+func toSpendingControlsMsg(c *cardv1.SpendingControls) *spendingControlsMsg {
+	if c == nil {
+		return nil
+	}
+	m := &spendingControlsMsg{
+		AllowedMCCs:       c.GetAllowedMccs(),
+		BlockedMCCs:       c.GetBlockedMccs(),
+		MaxPerTransaction: c.GetMaxPerTransaction(),
+		AllowedCountries:  c.GetAllowedCountries(),
+		BlockedCountries:  c.GetBlockedCountries(),
+	}
+	for _, l := range c.GetVelocityLimits() {
+		m.VelocityLimits = append(m.VelocityLimits, velocityLimitMsg{
+			Window:    strings.TrimPrefix(l.GetWindow().String(), "VELOCITY_WINDOW_"),
+			MaxAmount: l.GetMaxAmount(),
+			MaxCount:  l.GetMaxCount(),
+		})
+	}
+	return m
 }
@@ -0,0 +1,85 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const cardJSON = `{
+	"id": "1e3a5c7b-9d2f-4a6e-8c0b-2d4f6a8c0e1b",
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"account_id": "5b0e2d8a-7c41-4f6e-a3b9-2c8d1e4f6a70",
+	"type": "CARD_TYPE_VIRTUAL",
+	"status": "CARD_STATUS_ACTIVE",
+	"last_four": "4242",
+	"daily_limit": {"amount": "5000.00", "currency": "USD"},
+	"monthly_limit": {"amount": "20000.00", "currency": "USD"},
+	"audit": {"version": 1}
+}`
+
+func TestCardContract(t *testing.T) {
+	stub, conn := newContractStub(t, "card-service")
+	p := NewCardProxy(conn, conn.Logger)
+	cardID := contractCardID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "issue a virtual card",
+		Method:      "/bib.card.v1.CardService/IssueCard",
+		Response:    json.RawMessage(`{"card": {"id": "` + cardID + `", "status": "CARD_STATUS_PENDING"}}`),
+	})
+	rec := call(t, p.IssueCard, http.MethodPost, "/api/v1/cards", `{"account_id": "`+contractAccountID.String()+
+		`", "card_type": "VIRTUAL", "currency": "USD", "daily_limit": "5000", "monthly_limit": "20000"}`)
+	if rec.Code != http.StatusCreated || decodeBody(t, rec)["card_id"] != cardID {
+		t.Errorf("IssueCard = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a card",
+		State:       "an active card exists",
+		Method:      "/bib.card.v1.CardService/GetCard",
+		Response:    json.RawMessage(`{"card": ` + cardJSON + `}`),
+	})
+	rec = call(t, p.GetCard, http.MethodGet, "/api/v1/cards/"+cardID, "", "id", cardID)
+	if body := decodeBody(t, rec); rec.Code != http.StatusOK || body["status"] != "ACTIVE" || body["masked_pan"] != "4242" {
+		t.Errorf("GetCard = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing card",
+		Method:      "/bib.card.v1.CardService/GetCard",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetCard, http.MethodGet, "/api/v1/cards/"+cardID, "", "id", cardID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetCard of a missing card = %d, want 404", rec.Code)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "authorize a card transaction",
+		State:       "an active card exists",
+		Method:      "/bib.card.v1.CardService/AuthorizeTransaction",
+		Response:    json.RawMessage(`{"approved": true, "merchant": {"name": "Coffee", "category": "5814"}}`),
+	})
+	rec = call(t, p.AuthorizeTransaction, http.MethodPost, "/api/v1/cards/"+cardID+"/authorize", `{"amount": "12.50",
+		"currency": "USD", "merchant_name": "Coffee", "merchant_category": "5814"}`, "id", cardID)
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["approved"] != true {
+		t.Errorf("AuthorizeTransaction = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "freeze a card",
+		State:       "an active card exists",
+		Method:      "/bib.card.v1.CardService/FreezeCard",
+		Response:    json.RawMessage(`{"card_id": "` + cardID + `", "status": "CARD_STATUS_FROZEN"}`),
+	})
+	rec = call(t, p.FreezeCard, http.MethodPost, "/api/v1/cards/"+cardID+"/freeze", "", "id", cardID)
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["status"] != "FROZEN" {
+		t.Errorf("FreezeCard = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-card-service.json"))
+}
@@ -0,0 +1,225 @@
+package proxy
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	closev1 "github.com/bibbank/bib/api/gen/go/bib/close/v1"
+)
+
+// CloseProxy proxies HTTP requests to the close gRPC service.
+type CloseProxy struct {
+	client closev1.CloseServiceClient
+	logger *slog.Logger
+}
+
+// NewCloseProxy creates a new close service proxy.
+func NewCloseProxy(conn *ServiceConn, logger *slog.Logger) *CloseProxy {
+	return &CloseProxy{client: closev1.NewCloseServiceClient(conn), logger: logger}
+}
+
+const signOffRoleHint = "role must be PREPARER or REVIEWER"
+
+type completeChecklistItemReq struct {
+	Note string `json:"note,omitempty"`
+}
+
+type reopenChecklistItemReq struct {
+	Reason string `json:"reason"`
+}
+
+type signOffChecklistReq struct {
+	Role string `json:"role"`
+}
+
+type checklistItemMsg struct {
+	Key         string `json:"key"`
+	Title       string `json:"title"`
+	CompletedBy string `json:"completed_by,omitempty"`
+	CompletedAt string `json:"completed_at,omitempty"`
+	Note        string `json:"note,omitempty"`
+}
+
+type signOffMsg struct {
+	Role     string `json:"role"`
+	UserID   string `json:"user_id"`
+	SignedAt string `json:"signed_at"`
+}
+
+type periodCloseMsg struct {
+	Period   string              `json:"period"`
+	Status   string              `json:"status"`
+	Items    []*checklistItemMsg `json:"items"`
+	SignOffs []*signOffMsg       `json:"sign_offs"`
+	ClosedBy string              `json:"closed_by,omitempty"`
+	ClosedAt string              `json:"closed_at,omitempty"`
+	Version  int32               `json:"version"`
+}
+
+type periodCloseResp struct {
+	Close *periodCloseMsg `json:"close"`
+}
+
+type closeCheckMsg struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Count  int32  `json:"count"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type closeDashboardResp struct {
+	Close        *periodCloseMsg  `json:"close"`
+	LedgerStatus string           `json:"ledger_status,omitempty"`
+	Checks       []*closeCheckMsg `json:"checks"`
+	ReadyToClose bool             `json:"ready_to_close"`
+}
+
+// GetDashboard handles GET /api/v1/close/periods/{period}, the close status
+// of a YYYY-MM accounting period across the ledger, the backoffice and the
+// scheduler.
+func (p *CloseProxy) GetDashboard(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.GetCloseDashboard(r.Context(), &closev1.GetCloseDashboardRequest{
+		Period: r.PathValue("period"),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := closeDashboardResp{
+		Close:        toPeriodCloseMsg(resp.GetClose()),
+		LedgerStatus: resp.GetLedgerStatus(),
+		Checks:       make([]*closeCheckMsg, 0, len(resp.GetChecks())),
+		ReadyToClose: resp.GetReadyToClose(),
+	}
+	for _, c := range resp.GetChecks() {
+		out.Checks = append(out.Checks, &closeCheckMsg{
+			Name:   c.GetName(),
+			Status: enumName(c.GetStatus().String(), "CHECK_STATUS_"),
+			Count:  c.GetCount(),
+			Detail: c.GetDetail(),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// CompleteItem handles POST /api/v1/close/periods/{period}/items/{key}/complete.
+func (p *CloseProxy) CompleteItem(w http.ResponseWriter, r *http.Request) {
+	var req completeChecklistItemReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.CompleteChecklistItem(r.Context(), &closev1.CompleteChecklistItemRequest{
+		Period:  r.PathValue("period"),
+		ItemKey: r.PathValue("key"),
+		Note:    req.Note,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, periodCloseResp{Close: toPeriodCloseMsg(resp.GetClose())})
+}
+
+// ReopenItem handles POST /api/v1/close/periods/{period}/items/{key}/reopen.
+// Reopening an item withdraws the checklist's sign-offs.
+func (p *CloseProxy) ReopenItem(w http.ResponseWriter, r *http.Request) {
+	var req reopenChecklistItemReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.ReopenChecklistItem(r.Context(), &closev1.ReopenChecklistItemRequest{
+		Period:  r.PathValue("period"),
+		ItemKey: r.PathValue("key"),
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, periodCloseResp{Close: toPeriodCloseMsg(resp.GetClose())})
+}
+
+// SignOff handles POST /api/v1/close/periods/{period}/sign-offs. The
+// preparer signs first; the reviewer must be someone else.
+func (p *CloseProxy) SignOff(w http.ResponseWriter, r *http.Request) {
+	var req signOffChecklistReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	role, found := signOffRoleValue(req.Role)
+	if !found {
+		writeError(w, http.StatusBadRequest, signOffRoleHint)
+		return
+	}
+
+	resp, err := p.client.SignOffChecklist(r.Context(), &closev1.SignOffChecklistRequest{
+		Period: r.PathValue("period"),
+		Role:   role,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, periodCloseResp{Close: toPeriodCloseMsg(resp.GetClose())})
+}
+
+// ClosePeriod handles POST /api/v1/close/periods/{period}/close, closing a
+// signed-off period in the ledger once no check holds it up.
+func (p *CloseProxy) ClosePeriod(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.ClosePeriod(r.Context(), &closev1.ClosePeriodRequest{
+		Period: r.PathValue("period"),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, periodCloseResp{Close: toPeriodCloseMsg(resp.GetClose())})
+}
+
+// signOffRoleValue converts a sign-off role name to its enum value; the role
+// is required.
+func signOffRoleValue(name string) (closev1.SignOffRole, bool) {
+	v, ok := closev1.SignOffRole_value["SIGN_OFF_ROLE_"+strings.ToUpper(name)]
+	if !ok || v == 0 {
+		return closev1.SignOffRole_SIGN_OFF_ROLE_UNSPECIFIED, false
+	}
+	return closev1.SignOffRole(v), true
+}
+
+func toPeriodCloseMsg(pc *closev1.PeriodClose) *periodCloseMsg {
+	if pc == nil {
+		return nil
+	}
+	out := &periodCloseMsg{
+		Period:   pc.GetPeriod(),
+		Status:   enumName(pc.GetStatus().String(), "CLOSE_STATUS_"),
+		Items:    make([]*checklistItemMsg, 0, len(pc.GetItems())),
+		SignOffs: make([]*signOffMsg, 0, len(pc.GetSignOffs())),
+		ClosedBy: pc.GetClosedBy(),
+		ClosedAt: formatTimestamp(pc.GetClosedAt()),
+		Version:  pc.GetVersion(),
+	}
+	for _, item := range pc.GetItems() {
+		out.Items = append(out.Items, &checklistItemMsg{
+			Key:         item.GetKey(),
+			Title:       item.GetTitle(),
+			CompletedBy: item.GetCompletedBy(),
+			CompletedAt: formatTimestamp(item.GetCompletedAt()),
+			Note:        item.GetNote(),
+		})
+	}
+	for _, s := range pc.GetSignOffs() {
+		out.SignOffs = append(out.SignOffs, &signOffMsg{
+			Role:     enumName(s.GetRole().String(), "SIGN_OFF_ROLE_"),
+			UserID:   s.GetUserId(),
+			SignedAt: formatTimestamp(s.GetSignedAt()),
+		})
+	}
+	return out
+}
@@ -0,0 +1,58 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+func TestCloseContract(t *testing.T) {
+	stub, conn := newContractStub(t, "close-service")
+	p := NewCloseProxy(conn, conn.Logger)
+
+	stub.Given(contract.Interaction{
+		Description: "complete a checklist item",
+		Method:      "/bib.close.v1.CloseService/CompleteChecklistItem",
+		Response: json.RawMessage(`{"close": {"period": "2026-01", "status": "CLOSE_STATUS_OPEN", "items": [{"key":
+			"nostro-reconciliation", "title": "Reconcile nostro and settlement accounts"}], "version": 1}}`),
+	})
+	rec := call(t, p.CompleteItem, http.MethodPost, "/api/v1/close/periods/2026-01/items/nostro-reconciliation/complete",
+		`{"note": "No breaks"}`, "period", "2026-01", "key", "nostro-reconciliation")
+	if rec.Code != http.StatusOK {
+		t.Errorf("CompleteItem = %d %s", rec.Code, rec.Body)
+	} else if pc, _ := decodeBody(t, rec)["close"].(map[string]any); pc["status"] != "OPEN" {
+		t.Errorf("CompleteItem close = %v", pc)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "sign off the checklist as preparer",
+		State:       "every checklist item is complete",
+		Method:      "/bib.close.v1.CloseService/SignOffChecklist",
+		Response: json.RawMessage(`{"close": {"period": "2026-01", "status": "CLOSE_STATUS_OPEN", "sign_offs": [{"role":
+			"SIGN_OFF_ROLE_PREPARER", "user_id": "3e5a7c9b-1d2f-4a6b-8c0e-2f4a6c8e0b1d", "signed_at":
+			"2026-02-03T09:00:00Z"}], "version": 6}}`),
+	})
+	rec = call(t, p.SignOff, http.MethodPost, "/api/v1/close/periods/2026-01/sign-offs", `{"role": "PREPARER"}`,
+		"period", "2026-01")
+	if rec.Code != http.StatusOK {
+		t.Errorf("SignOff = %d %s", rec.Code, rec.Body)
+	} else if pc, _ := decodeBody(t, rec)["close"].(map[string]any); pc["sign_offs"] == nil {
+		t.Errorf("SignOff close = %v", pc)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "complete an unknown checklist item",
+		Method:      "/bib.close.v1.CloseService/CompleteChecklistItem",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.CompleteItem, http.MethodPost, "/api/v1/close/periods/2026-01/items/coffee-run/complete", `{}`,
+		"period", "2026-01", "key", "coffee-run")
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("CompleteItem of an unknown item = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-close-service.json"))
+}
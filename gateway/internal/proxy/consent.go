@@ -0,0 +1,420 @@
+package proxy
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	consentv1 "github.com/bibbank/bib/api/gen/go/bib/consent/v1"
+)
+
+// ConsentProxy proxies HTTP requests to the consent gRPC service.
+type ConsentProxy struct {
+	client consentv1.ConsentServiceClient
+	logger *slog.Logger
+}
+
+// NewConsentProxy creates a new consent service proxy.
+func NewConsentProxy(conn *ServiceConn, logger *slog.Logger) *ConsentProxy {
+	return &ConsentProxy{client: consentv1.NewConsentServiceClient(conn), logger: logger}
+}
+
+const (
+	consentTypeHint    = "type must be TERMS_OF_SERVICE, ESIGN, CREDIT_PULL, MARKETING_EMAIL, MARKETING_SMS or MARKETING_PUSH"
+	consentActionHint  = "action must be GRANTED or WITHDRAWN"
+	consentChannelHint = "channel must be ONLINE, MOBILE, BRANCH, PHONE or PAPER"
+)
+
+type publishDisclosureReq struct {
+	Type          string `json:"type"`
+	Version       string `json:"version"`
+	ContentHash   string `json:"content_hash"`
+	URL           string `json:"url,omitempty"`
+	EffectiveFrom string `json:"effective_from,omitempty"`
+}
+
+type recordConsentReq struct {
+	CustomerID   string `json:"customer_id,omitempty"`
+	Type         string `json:"type"`
+	Action       string `json:"action"`
+	DisclosureID string `json:"disclosure_id,omitempty"`
+	Channel      string `json:"channel"`
+	Evidence     string `json:"evidence,omitempty"`
+	CapturedAt   string `json:"captured_at,omitempty"`
+	ExpiresAt    string `json:"expires_at,omitempty"`
+}
+
+type disclosureMsg struct {
+	DisclosureID  string `json:"disclosure_id"`
+	TenantID      string `json:"tenant_id"`
+	Type          string `json:"type"`
+	Version       string `json:"version"`
+	ContentHash   string `json:"content_hash"`
+	URL           string `json:"url,omitempty"`
+	EffectiveFrom string `json:"effective_from"`
+	PublishedAt   string `json:"published_at"`
+}
+
+type disclosureResp struct {
+	Disclosure *disclosureMsg `json:"disclosure"`
+}
+
+type listDisclosuresResp struct {
+	Disclosures []*disclosureMsg `json:"disclosures"`
+	TotalCount  int32            `json:"total_count"`
+}
+
+type consentReceiptMsg struct {
+	ReceiptID         string `json:"receipt_id"`
+	TenantID          string `json:"tenant_id"`
+	CustomerID        string `json:"customer_id"`
+	Type              string `json:"type"`
+	Action            string `json:"action"`
+	DisclosureID      string `json:"disclosure_id,omitempty"`
+	DisclosureVersion string `json:"disclosure_version,omitempty"`
+	ContentHash       string `json:"content_hash,omitempty"`
+	Channel           string `json:"channel"`
+	Evidence          string `json:"evidence,omitempty"`
+	CapturedAt        string `json:"captured_at"`
+	ExpiresAt         string `json:"expires_at,omitempty"`
+	Sequence          int32  `json:"sequence"`
+	PreviousHash      string `json:"previous_hash,omitempty"`
+	Hash              string `json:"hash"`
+	RecordedAt        string `json:"recorded_at"`
+}
+
+type consentReceiptResp struct {
+	Receipt  *consentReceiptMsg `json:"receipt"`
+	Verified *bool              `json:"verified,omitempty"`
+}
+
+type checkConsentResp struct {
+	Granted   bool   `json:"granted"`
+	Status    string `json:"status"`
+	ReceiptID string `json:"receipt_id,omitempty"`
+}
+
+type consentStateMsg struct {
+	Type    string             `json:"type"`
+	Status  string             `json:"status"`
+	Receipt *consentReceiptMsg `json:"receipt,omitempty"`
+}
+
+type listConsentStatesResp struct {
+	Consents []*consentStateMsg `json:"consents"`
+}
+
+type listConsentReceiptsResp struct {
+	Receipts   []*consentReceiptMsg `json:"receipts"`
+	TotalCount int32                `json:"total_count"`
+}
+
+// PublishDisclosure handles POST /api/v1/consent/disclosures. effective_from
+// is RFC 3339; when empty the disclosure takes effect at once.
+func (p *ConsentProxy) PublishDisclosure(w http.ResponseWriter, r *http.Request) {
+	var req publishDisclosureReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	consentType, found := consentTypeValue(req.Type)
+	if !found {
+		writeError(w, http.StatusBadRequest, consentTypeHint)
+		return
+	}
+	effectiveFrom, ok := readConsentTime(w, "effective_from", req.EffectiveFrom)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.PublishDisclosure(r.Context(), &consentv1.PublishDisclosureRequest{
+		Type:          consentType,
+		Version:       req.Version,
+		ContentHash:   req.ContentHash,
+		Url:           req.URL,
+		EffectiveFrom: effectiveFrom,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusCreated, disclosureResp{Disclosure: toDisclosureMsg(resp.GetDisclosure())})
+}
+
+// ListDisclosures handles GET /api/v1/consent/disclosures.
+// Query parameters: type, page_size, offset.
+func (p *ConsentProxy) ListDisclosures(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+	var consentType consentv1.ConsentType
+	if v := r.URL.Query().Get("type"); v != "" {
+		var found bool
+		if consentType, found = consentTypeValue(v); !found {
+			writeError(w, http.StatusBadRequest, consentTypeHint)
+			return
+		}
+	}
+
+	resp, err := p.client.ListDisclosures(r.Context(), &consentv1.ListDisclosuresRequest{
+		Type:     consentType,
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listDisclosuresResp{
+		Disclosures: make([]*disclosureMsg, 0, len(resp.GetDisclosures())),
+		TotalCount:  resp.GetTotalCount(),
+	}
+	for _, d := range resp.GetDisclosures() {
+		out.Disclosures = append(out.Disclosures, toDisclosureMsg(d))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// RecordConsent handles POST /api/v1/consent/receipts, recording a customer
+// granting or withdrawing consent. customer_id defaults to the caller.
+func (p *ConsentProxy) RecordConsent(w http.ResponseWriter, r *http.Request) {
+	var req recordConsentReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	consentType, found := consentTypeValue(req.Type)
+	if !found {
+		writeError(w, http.StatusBadRequest, consentTypeHint)
+		return
+	}
+	action, found := consentActionValue(req.Action)
+	if !found {
+		writeError(w, http.StatusBadRequest, consentActionHint)
+		return
+	}
+	channel, found := consentChannelValue(req.Channel)
+	if !found {
+		writeError(w, http.StatusBadRequest, consentChannelHint)
+		return
+	}
+	capturedAt, ok := readConsentTime(w, "captured_at", req.CapturedAt)
+	if !ok {
+		return
+	}
+	expiresAt, ok := readConsentTime(w, "expires_at", req.ExpiresAt)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.RecordConsent(r.Context(), &consentv1.RecordConsentRequest{
+		CustomerId:   req.CustomerID,
+		Type:         consentType,
+		Action:       action,
+		DisclosureId: req.DisclosureID,
+		Channel:      channel,
+		Evidence:     req.Evidence,
+		CapturedAt:   capturedAt,
+		ExpiresAt:    expiresAt,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	receipt := toConsentReceiptMsg(resp.GetReceipt())
+	w.Header().Set("Location", "/api/v1/consent/receipts/"+url.PathEscape(receipt.ReceiptID))
+	writeJSON(w, http.StatusCreated, consentReceiptResp{Receipt: receipt})
+}
+
+// CheckConsent handles GET /api/v1/consent/check.
+// Query parameters: type (required), customer_id (defaults to the caller).
+func (p *ConsentProxy) CheckConsent(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	consentType, found := consentTypeValue(q.Get("type"))
+	if !found {
+		writeError(w, http.StatusBadRequest, consentTypeHint)
+		return
+	}
+
+	resp, err := p.client.CheckConsent(r.Context(), &consentv1.CheckConsentRequest{
+		CustomerId: q.Get("customer_id"),
+		Type:       consentType,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, checkConsentResp{
+		Granted:   resp.GetGranted(),
+		Status:    enumName(resp.GetStatus().String(), "CONSENT_STATUS_"),
+		ReceiptID: resp.GetReceiptId(),
+	})
+}
+
+// ListConsents handles GET /api/v1/consent/consents, a customer's standing
+// consent of every type. Query parameters: customer_id (defaults to the caller).
+func (p *ConsentProxy) ListConsents(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.ListConsents(r.Context(), &consentv1.ListConsentsRequest{
+		CustomerId: r.URL.Query().Get("customer_id"),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listConsentStatesResp{Consents: make([]*consentStateMsg, 0, len(resp.GetConsents()))}
+	for _, c := range resp.GetConsents() {
+		out.Consents = append(out.Consents, &consentStateMsg{
+			Type:    enumName(c.GetType().String(), "CONSENT_TYPE_"),
+			Status:  enumName(c.GetStatus().String(), "CONSENT_STATUS_"),
+			Receipt: toConsentReceiptMsg(c.GetReceipt()),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// ListReceipts handles GET /api/v1/consent/receipts.
+// Query parameters: customer_id (defaults to the caller), type, page_size, offset.
+func (p *ConsentProxy) ListReceipts(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	var consentType consentv1.ConsentType
+	if v := q.Get("type"); v != "" {
+		var found bool
+		if consentType, found = consentTypeValue(v); !found {
+			writeError(w, http.StatusBadRequest, consentTypeHint)
+			return
+		}
+	}
+
+	resp, err := p.client.ListReceipts(r.Context(), &consentv1.ListReceiptsRequest{
+		CustomerId: q.Get("customer_id"),
+		Type:       consentType,
+		PageSize:   pageSize,
+		Offset:     offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listConsentReceiptsResp{
+		Receipts:   make([]*consentReceiptMsg, 0, len(resp.GetReceipts())),
+		TotalCount: resp.GetTotalCount(),
+	}
+	for _, rc := range resp.GetReceipts() {
+		out.Receipts = append(out.Receipts, toConsentReceiptMsg(rc))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetReceipt handles GET /api/v1/consent/receipts/{id}, reporting whether
+// the receipt's hash verifies against its content and predecessor.
+func (p *ConsentProxy) GetReceipt(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "receipt id is required")
+		return
+	}
+
+	resp, err := p.client.GetReceipt(r.Context(), &consentv1.GetReceiptRequest{ReceiptId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	verified := resp.GetVerified()
+	writeJSON(w, http.StatusOK, consentReceiptResp{
+		Receipt:  toConsentReceiptMsg(resp.GetReceipt()),
+		Verified: &verified,
+	})
+}
+
+// consentTypeValue converts a consent type name to its enum value; the type
+// is required.
+func consentTypeValue(name string) (consentv1.ConsentType, bool) {
+	v, ok := consentv1.ConsentType_value["CONSENT_TYPE_"+strings.ToUpper(name)]
+	if !ok || v == 0 {
+		return consentv1.ConsentType_CONSENT_TYPE_UNSPECIFIED, false
+	}
+	return consentv1.ConsentType(v), true
+}
+
+// consentActionValue converts an action name to its enum value; the action
+// is required.
+func consentActionValue(name string) (consentv1.ConsentAction, bool) {
+	v, ok := consentv1.ConsentAction_value["CONSENT_ACTION_"+strings.ToUpper(name)]
+	if !ok || v == 0 {
+		return consentv1.ConsentAction_CONSENT_ACTION_UNSPECIFIED, false
+	}
+	return consentv1.ConsentAction(v), true
+}
+
+// consentChannelValue converts a capture channel name to its enum value; the
+// channel is required.
+func consentChannelValue(name string) (consentv1.CaptureChannel, bool) {
+	v, ok := consentv1.CaptureChannel_value["CAPTURE_CHANNEL_"+strings.ToUpper(name)]
+	if !ok || v == 0 {
+		return consentv1.CaptureChannel_CAPTURE_CHANNEL_UNSPECIFIED, false
+	}
+	return consentv1.CaptureChannel(v), true
+}
+
+// readConsentTime parses an optional RFC 3339 field, writing a 400 when it
+// is malformed.
+func readConsentTime(w http.ResponseWriter, field, value string) (*timestamppb.Timestamp, bool) {
+	if value == "" {
+		return nil, true
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, field+" must be an RFC 3339 timestamp")
+		return nil, false
+	}
+	return timestamppb.New(t), true
+}
+
+func toDisclosureMsg(d *consentv1.Disclosure) *disclosureMsg {
+	if d == nil {
+		return nil
+	}
+	return &disclosureMsg{
+		DisclosureID:  d.GetDisclosureId(),
+		TenantID:      d.GetTenantId(),
+		Type:          enumName(d.GetType().String(), "CONSENT_TYPE_"),
+		Version:       d.GetVersion(),
+		ContentHash:   d.GetContentHash(),
+		URL:           d.GetUrl(),
+		EffectiveFrom: formatTimestamp(d.GetEffectiveFrom()),
+		PublishedAt:   formatTimestamp(d.GetPublishedAt()),
+	}
+}
+
+func toConsentReceiptMsg(rc *consentv1.ConsentReceipt) *consentReceiptMsg {
+	if rc == nil {
+		return nil
+	}
+	return &consentReceiptMsg{
+		ReceiptID:         rc.GetReceiptId(),
+		TenantID:          rc.GetTenantId(),
+		CustomerID:        rc.GetCustomerId(),
+		Type:              enumName(rc.GetType().String(), "CONSENT_TYPE_"),
+		Action:            enumName(rc.GetAction().String(), "CONSENT_ACTION_"),
+		DisclosureID:      rc.GetDisclosureId(),
+		DisclosureVersion: rc.GetDisclosureVersion(),
+		ContentHash:       rc.GetContentHash(),
+		Channel:           enumName(rc.GetChannel().String(), "CAPTURE_CHANNEL_"),
+		Evidence:          rc.GetEvidence(),
+		CapturedAt:        formatTimestamp(rc.GetCapturedAt()),
+		ExpiresAt:         formatTimestamp(rc.GetExpiresAt()),
+		Sequence:          rc.GetSequence(),
+		PreviousHash:      rc.GetPreviousHash(),
+		Hash:              rc.GetHash(),
+		RecordedAt:        formatTimestamp(rc.GetRecordedAt()),
+	}
+}
@@ -0,0 +1,74 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const receiptJSON = `{
+	"receipt_id": "9e1a3c5d-7f8b-4d0e-9a2c-4d6f8b0c2e5a",
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"customer_id": "a4c6e8b0-2d4f-4a1c-9e3b-5d7f9b1d3f6a",
+	"type": "CONSENT_TYPE_TERMS_OF_SERVICE",
+	"action": "CONSENT_ACTION_GRANTED",
+	"disclosure_id": "8d0f2b4c-6e7a-4c9d-8f1b-3c5e7a9b1d4f",
+	"disclosure_version": "2026.1",
+	"content_hash": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+	"channel": "CAPTURE_CHANNEL_ONLINE",
+	"evidence": "signup page checkbox",
+	"captured_at": "2026-01-15T09:00:00Z",
+	"sequence": 1,
+	"hash": "4e07408562bedb8b60ce05c1decfe3ad16b72230967de01f640b7e4729b49fce",
+	"recorded_at": "2026-01-15T09:00:00Z"
+}`
+
+func TestConsentContract(t *testing.T) {
+	stub, conn := newContractStub(t, "consent-service")
+	p := NewConsentProxy(conn, conn.Logger)
+	receiptID := contractReceiptID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "publish the terms of service",
+		Method:      "/bib.consent.v1.ConsentService/PublishDisclosure",
+		Response: json.RawMessage(`{"disclosure": {"disclosure_id": "8d0f2b4c-6e7a-4c9d-8f1b-3c5e7a9b1d4f",
+			"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f", "type": "CONSENT_TYPE_TERMS_OF_SERVICE",
+			"version": "2026.1", "content_hash": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			"url": "https://bank.example.com/terms/2026.1", "effective_from": "2026-01-15T09:00:00Z",
+			"published_at": "2026-01-15T09:00:00Z"}}`),
+	})
+	rec := call(t, p.PublishDisclosure, http.MethodPost, "/api/v1/consent/disclosures", `{"type": "TERMS_OF_SERVICE",
+		"version": "2026.1", "content_hash": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		"url": "https://bank.example.com/terms/2026.1"}`)
+	if rec.Code != http.StatusCreated {
+		t.Errorf("PublishDisclosure = %d %s", rec.Code, rec.Body)
+	} else if disclosure, _ := decodeBody(t, rec)["disclosure"].(map[string]any); disclosure["type"] != "TERMS_OF_SERVICE" {
+		t.Errorf("PublishDisclosure disclosure = %v", disclosure)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a consent receipt",
+		State:       "the customer accepted the terms of service",
+		Method:      "/bib.consent.v1.ConsentService/GetReceipt",
+		Response:    json.RawMessage(`{"receipt": ` + receiptJSON + `, "verified": true}`),
+	})
+	rec = call(t, p.GetReceipt, http.MethodGet, "/api/v1/consent/receipts/"+receiptID, "", "id", receiptID)
+	if body := decodeBody(t, rec); rec.Code != http.StatusOK || body["verified"] != true {
+		t.Errorf("GetReceipt = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing consent receipt",
+		Method:      "/bib.consent.v1.ConsentService/GetReceipt",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetReceipt, http.MethodGet, "/api/v1/consent/receipts/"+receiptID, "", "id", receiptID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetReceipt of a missing receipt = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-consent-service.json"))
+}
@@ -0,0 +1,382 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+// The gateway is the consumer of the contracts in contracts/. Each test
+// drives a proxy against a contract stub and checks the recorded requests
+// against the committed contract; run make contracts after changing what a
+// proxy sends or reads, and the provider's tests verify the new contract.
+
+// contractsDir is the repository's contracts directory.
+const contractsDir = "../../../contracts"
+
+// Fixed identifiers keep recorded requests stable between runs.
+var (
+	contractTenantID       = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+	contractAccountID      = uuid.MustParse("5b0e2d8a-7c41-4f6e-a3b9-2c8d1e4f6a70")
+	contractPaymentID      = uuid.MustParse("c3a9e4f1-6b2d-4e8a-9f07-4d1c2b3a5e68")
+	contractScheduleID     = uuid.MustParse("e7b2c9d4-1f3a-4b6c-8d5e-9a0b1c2d3e4f")
+	contractBatchID        = uuid.MustParse("3c9e1f7a-5b2d-4e8c-a6f0-7d1b2c3e4f5a")
+	contractEntryID        = uuid.MustParse("9a1c3e5f-7b2d-4f6a-8c0e-1d3f5a7b9c2e")
+	contractProductID      = uuid.MustParse("2f8a4c6e-0b1d-4e3f-9a5c-7e9b1d3f5a8c")
+	contractPositionID     = uuid.MustParse("6d2b8f4a-1c3e-4a5b-8d7f-0e2a4c6b8d1f")
+	contractVerifyID       = uuid.MustParse("4e6a8c0b-2d4f-4b7a-9c1e-3f5b7d9a1c2e")
+	contractLoanAppID      = uuid.MustParse("8b0d2f4a-6c8e-4a1b-9d3f-5a7c9e1b3d5f")
+	contractLoanID         = uuid.MustParse("0a2c4e6b-8d1f-4c3a-b5e7-9f1b3d5a7c9e")
+	contractAssessID       = uuid.MustParse("5c7e9a1b-3d5f-4e8a-a0c2-6e8a0c2e4a6b")
+	contractCardID         = uuid.MustParse("1e3a5c7b-9d2f-4a6e-8c0b-2d4f6a8c0e1b")
+	contractReportJobID    = uuid.MustParse("7f1b3d5a-9c2e-4f8b-a6d0-2c4e6a8b0d3f")
+	contractCustomerID     = uuid.MustParse("a4c6e8b0-2d4f-4a1c-9e3b-5d7f9b1d3f6a")
+	contractJobID          = uuid.MustParse("d2f4a6c8-0e1b-4d3f-a5c7-9e1b3d5f7a0c")
+	contractStatementID    = uuid.MustParse("e5a7c9b1-3d6f-4b8a-9c2e-4f6a8c0e2b4d")
+	contractSubscriptionID = uuid.MustParse("f6b8d0a2-4c7e-4a9b-8d1f-5a7c9e1b3d6f")
+	contractNostroID       = uuid.MustParse("0b2d4f6a-8c1e-4a3b-9d5f-7a9c1e3b5d8f")
+	contractErasureJobID   = uuid.MustParse("1c3e5a7b-9d0f-4b2c-8e4a-6b8d0f2a4c7e")
+	contractLimitID        = uuid.MustParse("2d4f6b8c-0e1a-4c3d-9f5b-7c9e1a3d5f8b")
+	contractConsentID      = uuid.MustParse("3e5a7c9d-1f2b-4d4e-8a6c-8d0f2b4e6a9c")
+	contractTaskID         = uuid.MustParse("5a7c9e1f-3b4d-4f6a-8c8e-0f2b4d6a8c1e")
+	contractDocumentID     = uuid.MustParse("6b8d0f2a-4c5e-4a7b-8d9f-1a3c5e7b9d2f")
+	contractFeeScheduleID  = uuid.MustParse("7c9e1a3b-5d6f-4b8c-9e0a-2b4d6f8a0c3e")
+	contractReceiptID      = uuid.MustParse("9e1a3c5d-7f8b-4d0e-9a2c-4d6f8b0c2e5a")
+)
+
+func newContractStub(t *testing.T, provider string) (*contract.Stub, *ServiceConn) {
+	t.Helper()
+	stub := contract.NewStub(t, "gateway", provider)
+	return stub, &ServiceConn{Name: provider, Conn: stub.Conn(), Logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+}
+
+// callMultipart posts fields and a file as multipart/form-data to handler.
+func callMultipart(t *testing.T, handler http.HandlerFunc, target string, fields map[string]string, fileName, content string) *httptest.ResponseRecorder {
+	t.Helper()
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for name, value := range fields {
+		if err := mw.WriteField(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fw, err := mw.CreateFormFile("file", fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.WriteString(fw, content); err != nil {
+		t.Fatal(err)
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	rec := call(t, func(w http.ResponseWriter, r *http.Request) {
+		r.Header.Set("Content-Type", mw.FormDataContentType())
+		handler(w, r)
+	}, http.MethodPost, target, body.String())
+	return rec
+}
+
+// call serves one request through handler as an authenticated caller of the
+// contract tenant. pathValues are name, value pairs.
+func call(t *testing.T, handler http.HandlerFunc, method, target, body string, pathValues ...string) *httptest.ResponseRecorder {
+	t.Helper()
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, target, reader)
+	for i := 0; i+1 < len(pathValues); i += 2 {
+		req.SetPathValue(pathValues[i], pathValues[i+1])
+	}
+	ctx := auth.ContextWithClaims(context.Background(), &auth.Claims{
+		UserID:   uuid.Nil,
+		TenantID: contractTenantID,
+		Roles:    []string{auth.RoleAdmin},
+	})
+	rec := httptest.NewRecorder()
+	handler(rec, req.WithContext(ctx))
+	return rec
+}
+
+func decodeBody(t *testing.T, rec *httptest.ResponseRecorder) map[string]any {
+	t.Helper()
+	var body map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode response %q: %v", rec.Body.String(), err)
+	}
+	return body
+}
+
+const accountJSON = `{
+	"id": "5b0e2d8a-7c41-4f6e-a3b9-2c8d1e4f6a70",
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"account_number": "BIB-0000-0001",
+	"type": "ACCOUNT_TYPE_CHECKING",
+	"status": "ACCOUNT_STATUS_ACTIVE",
+	"currency": "USD",
+	"ledger_account_code": "2000-100",
+	"holder": {"first_name": "Jane", "last_name": "Smith", "email": "jane@example.com"},
+	"audit": {"version": 1}
+}`
+
+func TestAccountContract(t *testing.T) {
+	stub, conn := newContractStub(t, "account-service")
+	p := NewAccountProxy(conn, conn.Logger)
+	id := contractAccountID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "open a checking account",
+		Method:      "/bib.account.v1.AccountService/OpenAccount",
+		Response: json.RawMessage(`{"account": {"id": "5b0e2d8a-7c41-4f6e-a3b9-2c8d1e4f6a70",
+			"account_number": "BIB-0000-0001", "status": "ACCOUNT_STATUS_ACTIVE", "ledger_account_code": "2000-100"}}`),
+	})
+	rec := call(t, p.OpenAccount, http.MethodPost, "/api/v1/accounts", `{"account_type": "CHECKING",
+		"currency": "USD", "holder_first_name": "Jane", "holder_last_name": "Smith", "holder_email": "jane@example.com"}`)
+	if rec.Code != http.StatusCreated || decodeBody(t, rec)["account_id"] != id {
+		t.Errorf("OpenAccount = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get an account",
+		State:       "an active account exists",
+		Method:      "/bib.account.v1.AccountService/GetAccount",
+		Response:    json.RawMessage(`{"account": ` + accountJSON + `}`),
+	})
+	rec = call(t, p.GetAccount, http.MethodGet, "/api/v1/accounts/"+id, "", "id", id)
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["status"] != "ACTIVE" {
+		t.Errorf("GetAccount = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing account",
+		Method:      "/bib.account.v1.AccountService/GetAccount",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetAccount, http.MethodGet, "/api/v1/accounts/"+id, "", "id", id)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetAccount of a missing account = %d, want 404", rec.Code)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "freeze an account",
+		State:       "an active account exists",
+		Method:      "/bib.account.v1.AccountService/FreezeAccount",
+		Response:    json.RawMessage(`{"account": ` + strings.Replace(accountJSON, `"ACCOUNT_STATUS_ACTIVE"`, `"ACCOUNT_STATUS_FROZEN"`, 1) + `}`),
+	})
+	rec = call(t, p.FreezeAccount, http.MethodPost, "/api/v1/accounts/"+id+"/freeze",
+		`{"reason": "suspected fraud"}`, "id", id)
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["status"] != "FROZEN" {
+		t.Errorf("FreezeAccount = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "close an account",
+		State:       "an active account exists",
+		Method:      "/bib.account.v1.AccountService/CloseAccount",
+		Response:    json.RawMessage(`{"account": ` + strings.Replace(accountJSON, `"ACCOUNT_STATUS_ACTIVE"`, `"ACCOUNT_STATUS_CLOSED"`, 1) + `}`),
+	})
+	rec = call(t, p.CloseAccount, http.MethodPost, "/api/v1/accounts/"+id+"/close",
+		`{"reason": "customer request"}`, "id", id)
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["status"] != "CLOSED" {
+		t.Errorf("CloseAccount = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "list the tenant's accounts",
+		State:       "an active account exists",
+		Method:      "/bib.account.v1.AccountService/ListAccounts",
+		Response:    json.RawMessage(`{"accounts": [` + accountJSON + `], "pagination": {"total_count": 1}}`),
+	})
+	rec = call(t, p.ListAccounts, http.MethodGet, "/api/v1/accounts", "")
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["total_count"] != float64(1) {
+		t.Errorf("ListAccounts = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-account-service.json"))
+}
+
+const paymentJSON = `{
+	"id": "c3a9e4f1-6b2d-4e8a-9f07-4d1c2b3a5e68",
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"source_account_id": "5b0e2d8a-7c41-4f6e-a3b9-2c8d1e4f6a70",
+	"amount": {"amount": "100.00", "currency": "USD"},
+	"routing_number": "021000021",
+	"external_account_number": "123456789",
+	"rail": "PAYMENT_RAIL_ACH",
+	"status": "PAYMENT_STATUS_INITIATED",
+	"reference": "REF-001",
+	"description": "Rent",
+	"initiated_at": "2026-01-02T03:04:05Z",
+	"audit": {"created_at": "2026-01-02T03:04:05Z", "updated_at": "2026-01-02T03:04:05Z", "version": 1}
+}`
+
+const paymentScheduleJSON = `{
+	"id": "e7b2c9d4-1f3a-4b6c-8d5e-9a0b1c2d3e4f",
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"source_account_id": "5b0e2d8a-7c41-4f6e-a3b9-2c8d1e4f6a70",
+	"amount": {"amount": "100.00", "currency": "USD"},
+	"reference": "REF-001",
+	"description": "Rent",
+	"frequency": "SCHEDULE_FREQUENCY_MONTHLY",
+	"interval": 1,
+	"start_at": "2026-02-01T09:00:00Z",
+	"status": "SCHEDULE_STATUS_ACTIVE",
+	"next_run_at": "2026-02-01T09:00:00Z",
+	"audit": {"created_at": "2026-01-02T03:04:05Z", "updated_at": "2026-01-02T03:04:05Z"}
+}`
+
+const paymentBatchJSON = `{
+	"id": "3c9e1f7a-5b2d-4e8c-a6f0-7d1b2c3e4f5a",
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"source_account_id": "5b0e2d8a-7c41-4f6e-a3b9-2c8d1e4f6a70",
+	"format": "BATCH_FILE_FORMAT_PAIN001",
+	"file_name": "suppliers.xml",
+	"status": "BATCH_STATUS_PROCESSING",
+	"total_entries": 2,
+	"rejected_entries": 1,
+	"pending_entries": 1,
+	"errors": [{"sequence": 2, "reference": "E2E-2", "amount": {"amount": "200.00", "currency": "USD"},
+		"status": "REJECTED", "error": "creditor account is required"}],
+	"created_at": "2026-01-02T03:04:05Z"
+}`
+
+// contractPain001 is a pain.001 file of one payable and one rejected
+// transaction.
+const contractPain001 = `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pain.001.001.09"><CstmrCdtTrfInitn>` +
+	`<GrpHdr><NbOfTxs>2</NbOfTxs><CtrlSum>300.00</CtrlSum></GrpHdr><PmtInf><PmtInfId>P1</PmtInfId>` +
+	`<CdtTrfTxInf><PmtId><EndToEndId>E2E-1</EndToEndId></PmtId><Amt><InstdAmt Ccy="USD">100.00</InstdAmt></Amt>` +
+	`<CdtrAgt><FinInstnId><ClrSysMmbId><MmbId>021000021</MmbId></ClrSysMmbId></FinInstnId></CdtrAgt>` +
+	`<Cdtr><Nm>Supplier Inc</Nm></Cdtr><CdtrAcct><Id><Othr><Id>12345678</Id></Othr></Id></CdtrAcct></CdtTrfTxInf>` +
+	`<CdtTrfTxInf><PmtId><EndToEndId>E2E-2</EndToEndId></PmtId><Amt><InstdAmt Ccy="USD">200.00</InstdAmt></Amt>` +
+	`<CdtrAgt><FinInstnId><BICFI>DEUTDEFF</BICFI></FinInstnId></CdtrAgt><Cdtr><Nm>No Account Ltd</Nm></Cdtr></CdtTrfTxInf>` +
+	`</PmtInf></CstmrCdtTrfInitn></Document>`
+
+func TestPaymentContract(t *testing.T) {
+	stub, conn := newContractStub(t, "payment-service")
+	p := NewPaymentProxy(conn, conn.Logger)
+	id := contractPaymentID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "initiate an ACH payment",
+		Method:      "/bib.payment.v1.PaymentService/InitiatePayment",
+		Response: json.RawMessage(`{"order": {"id": "c3a9e4f1-6b2d-4e8a-9f07-4d1c2b3a5e68", "status": "PAYMENT_STATUS_INITIATED",
+			"rail": "PAYMENT_RAIL_ACH", "audit": {"created_at": "2026-01-02T03:04:05Z"}}}`),
+	})
+	rec := call(t, p.InitiatePayment, http.MethodPost, "/api/v1/payments", `{
+		"source_account_id": "5b0e2d8a-7c41-4f6e-a3b9-2c8d1e4f6a70", "amount": "100", "currency": "USD",
+		"routing_number": "021000021", "external_account_number": "123456789", "reference": "REF-001", "description": "Rent"}`)
+	if rec.Code != http.StatusCreated || decodeBody(t, rec)["id"] != id {
+		t.Errorf("InitiatePayment = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a payment",
+		State:       "a payment exists",
+		Method:      "/bib.payment.v1.PaymentService/GetPayment",
+		Response:    json.RawMessage(`{"order": ` + paymentJSON + `}`),
+	})
+	rec = call(t, p.GetPayment, http.MethodGet, "/api/v1/payments/"+id, "", "id", id)
+	if rec.Code != http.StatusOK {
+		t.Errorf("GetPayment = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "list an account's payments",
+		State:       "a payment exists",
+		Method:      "/bib.payment.v1.PaymentService/ListPayments",
+		Response:    json.RawMessage(`{"orders": [` + paymentJSON + `], "pagination": {"total_count": 1}}`),
+	})
+	rec = call(t, p.ListPayments, http.MethodGet, "/api/v1/payments?account_id="+contractAccountID.String(), "")
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["total_count"] != float64(1) {
+		t.Errorf("ListPayments = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "reverse a settled payment",
+		State:       "a settled payment exists",
+		Method:      "/bib.payment.v1.PaymentService/ReversePayment",
+		Response:    json.RawMessage(`{"payment": ` + strings.Replace(paymentJSON, `"PAYMENT_STATUS_INITIATED"`, `"PAYMENT_STATUS_REVERSED"`, 1) + `}`),
+	})
+	rec = call(t, p.ReversePayment, http.MethodPost, "/api/v1/payments/"+id+"/reverse", `{"reason": "returned by rail: R01"}`, "id", id)
+	if rec.Code != http.StatusOK {
+		t.Errorf("ReversePayment = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "schedule a monthly payment",
+		Method:      "/bib.payment.v1.PaymentService/SchedulePayment",
+		Response:    json.RawMessage(`{"schedule": ` + paymentScheduleJSON + `}`),
+	})
+	rec = call(t, p.SchedulePayment, http.MethodPost, "/api/v1/payment-schedules", `{
+		"source_account_id": "5b0e2d8a-7c41-4f6e-a3b9-2c8d1e4f6a70", "amount": "100", "currency": "USD",
+		"routing_number": "021000021", "external_account_number": "123456789", "reference": "REF-001", "description": "Rent",
+		"frequency": "MONTHLY", "interval": 1, "start_at": "2026-02-01T09:00:00Z"}`)
+	if rec.Code != http.StatusCreated || decodeBody(t, rec)["id"] != contractScheduleID.String() {
+		t.Errorf("SchedulePayment = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "list payment schedules",
+		State:       "a payment schedule exists",
+		Method:      "/bib.payment.v1.PaymentService/ListPaymentSchedules",
+		Response:    json.RawMessage(`{"schedules": [` + paymentScheduleJSON + `], "pagination": {"total_count": 1}}`),
+	})
+	rec = call(t, p.ListPaymentSchedules, http.MethodGet, "/api/v1/payment-schedules?page_size=10", "")
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["total_count"] != float64(1) {
+		t.Errorf("ListPaymentSchedules = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "cancel a payment schedule",
+		State:       "a payment schedule exists",
+		Method:      "/bib.payment.v1.PaymentService/CancelPaymentSchedule",
+		Response: json.RawMessage(`{"schedule": ` + strings.Replace(strings.Replace(paymentScheduleJSON,
+			`"SCHEDULE_STATUS_ACTIVE"`, `"SCHEDULE_STATUS_CANCELLED"`, 1), `"next_run_at": "2026-02-01T09:00:00Z",`, "", 1) + `}`),
+	})
+	scheduleID := contractScheduleID.String()
+	rec = call(t, p.CancelPaymentSchedule, http.MethodPost, "/api/v1/payment-schedules/"+scheduleID+"/cancel", "", "id", scheduleID)
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["status"] != "CANCELLED" {
+		t.Errorf("CancelPaymentSchedule = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "import a pain.001 payment file",
+		Method:      "/bib.payment.v1.PaymentService/ImportPaymentBatch",
+		Response:    json.RawMessage(`{"batch": ` + paymentBatchJSON + `}`),
+	})
+	rec = callMultipart(t, p.ImportPaymentBatch, "/api/v1/payment-batches", map[string]string{
+		"source_account_id": contractAccountID.String(),
+		"format":            "PAIN001",
+	}, "suppliers.xml", contractPain001)
+	if rec.Code != http.StatusCreated || decodeBody(t, rec)["id"] != contractBatchID.String() {
+		t.Errorf("ImportPaymentBatch = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a payment batch",
+		State:       "a payment batch exists",
+		Method:      "/bib.payment.v1.PaymentService/GetPaymentBatch",
+		Response:    json.RawMessage(`{"batch": ` + paymentBatchJSON + `}`),
+	})
+	batchID := contractBatchID.String()
+	rec = call(t, p.GetPaymentBatch, http.MethodGet, "/api/v1/payment-batches/"+batchID, "", "id", batchID)
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["total_entries"] != float64(2) {
+		t.Errorf("GetPaymentBatch = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-payment-service.json"))
+}
@@ -0,0 +1,390 @@
+package proxy
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	customerv1 "github.com/bibbank/bib/api/gen/go/bib/customer/v1"
+)
+
+// CustomerProxy proxies HTTP requests to the customer gRPC service.
+type CustomerProxy struct {
+	client customerv1.CustomerServiceClient
+	logger *slog.Logger
+}
+
+// NewCustomerProxy creates a new customer service proxy.
+func NewCustomerProxy(conn *ServiceConn, logger *slog.Logger) *CustomerProxy {
+	return &CustomerProxy{client: customerv1.NewCustomerServiceClient(conn), logger: logger}
+}
+
+type partyAddress struct {
+	Type       string `json:"type"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+}
+
+type partyDetails struct {
+	Type        string          `json:"type"`
+	GivenName   string          `json:"given_name,omitempty"`
+	FamilyName  string          `json:"family_name,omitempty"`
+	LegalName   string          `json:"legal_name,omitempty"`
+	DateOfBirth string          `json:"date_of_birth,omitempty"`
+	Email       string          `json:"email,omitempty"`
+	Phone       string          `json:"phone,omitempty"`
+	Addresses   []*partyAddress `json:"addresses"`
+}
+
+type partyRelationship struct {
+	Type    string `json:"type"`
+	PartyID string `json:"party_id"`
+}
+
+type partyExternalRef struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id"`
+}
+
+type partyResp struct {
+	Details           *partyDetails        `json:"details"`
+	PartyID           string               `json:"party_id"`
+	DisplayName       string               `json:"display_name"`
+	Status            string               `json:"status"`
+	KYCVerificationID string               `json:"kyc_verification_id,omitempty"`
+	KYCStatus         string               `json:"kyc_status"`
+	MergedInto        string               `json:"merged_into,omitempty"`
+	CreatedAt         string               `json:"created_at"`
+	UpdatedAt         string               `json:"updated_at"`
+	Relationships     []*partyRelationship `json:"relationships"`
+	ExternalRefs      []*partyExternalRef  `json:"external_refs"`
+	Version           int32                `json:"version"`
+}
+
+type duplicateMatchResp struct {
+	PartyID string   `json:"party_id"`
+	Reasons []string `json:"reasons"`
+	Score   float64  `json:"score"`
+}
+
+type partyEnvelope struct {
+	Party *partyResp `json:"party"`
+}
+
+type createPartyReq struct {
+	Details *partyDetails `json:"details"`
+}
+
+type createPartyResp struct {
+	Party      *partyResp            `json:"party"`
+	Duplicates []*duplicateMatchResp `json:"duplicates"`
+}
+
+type updatePartyReq struct {
+	Details *partyDetails `json:"details"`
+}
+
+type findDuplicatesResp struct {
+	Duplicates []*duplicateMatchResp `json:"duplicates"`
+}
+
+type mergePartiesResp struct {
+	Survivor *partyResp `json:"survivor"`
+	Merged   *partyResp `json:"merged"`
+}
+
+type mergePartiesReq struct {
+	DuplicateID string `json:"duplicate_id"`
+}
+
+type linkKYCReq struct {
+	VerificationID string `json:"verification_id"`
+	KYCStatus      string `json:"kyc_status,omitempty"`
+}
+
+// CreateParty handles POST /api/v1/customers.
+func (p *CustomerProxy) CreateParty(w http.ResponseWriter, r *http.Request) {
+	var req createPartyReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.CreateParty(r.Context(), &customerv1.CreatePartyRequest{
+		Details: toPartyDetailsMsg(req.Details),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusCreated, createPartyResp{
+		Party:      toPartyResp(resp.GetParty()),
+		Duplicates: toDuplicateMatchResps(resp.GetDuplicates()),
+	})
+}
+
+// GetParty handles GET /api/v1/customers/{id}.
+func (p *CustomerProxy) GetParty(w http.ResponseWriter, r *http.Request) {
+	partyID := r.PathValue("id")
+	if partyID == "" {
+		writeError(w, http.StatusBadRequest, "customer id is required")
+		return
+	}
+
+	resp, err := p.client.GetParty(r.Context(), &customerv1.GetPartyRequest{PartyId: partyID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, partyEnvelope{Party: toPartyResp(resp.GetParty())})
+}
+
+// UpdateParty handles PUT /api/v1/customers/{id}.
+func (p *CustomerProxy) UpdateParty(w http.ResponseWriter, r *http.Request) {
+	var req updatePartyReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.UpdateParty(r.Context(), &customerv1.UpdatePartyRequest{
+		PartyId: r.PathValue("id"),
+		Details: toPartyDetailsMsg(req.Details),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, partyEnvelope{Party: toPartyResp(resp.GetParty())})
+}
+
+// FindPartyByRef handles GET /api/v1/customers/lookup?kind=&id=, resolving
+// a record in another service to the customer it refers to.
+func (p *CustomerProxy) FindPartyByRef(w http.ResponseWriter, r *http.Request) {
+	kind, id := r.URL.Query().Get("kind"), r.URL.Query().Get("id")
+	if kind == "" || id == "" {
+		writeError(w, http.StatusBadRequest, "kind and id are required")
+		return
+	}
+
+	resp, err := p.client.FindPartyByRef(r.Context(), &customerv1.FindPartyByRefRequest{Kind: kind, Id: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, partyEnvelope{Party: toPartyResp(resp.GetParty())})
+}
+
+// FindDuplicates handles GET /api/v1/customers/{id}/duplicates.
+func (p *CustomerProxy) FindDuplicates(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.FindDuplicates(r.Context(), &customerv1.FindDuplicatesRequest{PartyId: r.PathValue("id")})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, findDuplicatesResp{Duplicates: toDuplicateMatchResps(resp.GetDuplicates())})
+}
+
+// MergeParties handles POST /api/v1/customers/{id}/merge, merging the
+// duplicate named in the body into the customer in the path.
+func (p *CustomerProxy) MergeParties(w http.ResponseWriter, r *http.Request) {
+	var req mergePartiesReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.MergeParties(r.Context(), &customerv1.MergePartiesRequest{
+		SurvivorId:  r.PathValue("id"),
+		DuplicateId: req.DuplicateID,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, mergePartiesResp{
+		Survivor: toPartyResp(resp.GetSurvivor()),
+		Merged:   toPartyResp(resp.GetMerged()),
+	})
+}
+
+// AddRelationship handles POST /api/v1/customers/{id}/relationships.
+func (p *CustomerProxy) AddRelationship(w http.ResponseWriter, r *http.Request) {
+	var rel partyRelationship
+	if err := readJSON(r, &rel); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.AddRelationship(r.Context(), &customerv1.AddRelationshipRequest{
+		PartyId:      r.PathValue("id"),
+		Relationship: toRelationshipMsg(&rel),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, partyEnvelope{Party: toPartyResp(resp.GetParty())})
+}
+
+// RemoveRelationship handles
+// DELETE /api/v1/customers/{id}/relationships/{related_id}?type=.
+func (p *CustomerProxy) RemoveRelationship(w http.ResponseWriter, r *http.Request) {
+	relType := r.URL.Query().Get("type")
+	if relType == "" {
+		writeError(w, http.StatusBadRequest, "type is required")
+		return
+	}
+
+	resp, err := p.client.RemoveRelationship(r.Context(), &customerv1.RemoveRelationshipRequest{
+		PartyId:      r.PathValue("id"),
+		Relationship: toRelationshipMsg(&partyRelationship{Type: relType, PartyID: r.PathValue("related_id")}),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, partyEnvelope{Party: toPartyResp(resp.GetParty())})
+}
+
+// LinkExternalRef handles POST /api/v1/customers/{id}/refs.
+func (p *CustomerProxy) LinkExternalRef(w http.ResponseWriter, r *http.Request) {
+	var ref partyExternalRef
+	if err := readJSON(r, &ref); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.LinkExternalRef(r.Context(), &customerv1.LinkExternalRefRequest{
+		PartyId: r.PathValue("id"),
+		Ref:     &customerv1.ExternalRef{Kind: ref.Kind, Id: ref.ID},
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, partyEnvelope{Party: toPartyResp(resp.GetParty())})
+}
+
+// LinkKYC handles PUT /api/v1/customers/{id}/kyc.
+func (p *CustomerProxy) LinkKYC(w http.ResponseWriter, r *http.Request) {
+	var req linkKYCReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.LinkKYC(r.Context(), &customerv1.LinkKYCRequest{
+		PartyId:        r.PathValue("id"),
+		VerificationId: req.VerificationID,
+		KycStatus:      customerv1.KYCStatus(customerv1.KYCStatus_value["KYC_STATUS_"+strings.ToUpper(req.KYCStatus)]),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, partyEnvelope{Party: toPartyResp(resp.GetParty())})
+}
+
+// toPartyDetailsMsg converts details from the request body. Type names the
+// customer service does not know are sent unspecified, which it rejects.
+func toPartyDetailsMsg(d *partyDetails) *customerv1.PartyDetails {
+	if d == nil {
+		return nil
+	}
+	msg := &customerv1.PartyDetails{
+		Type:        customerv1.PartyType(customerv1.PartyType_value["PARTY_TYPE_"+strings.ToUpper(d.Type)]),
+		GivenName:   d.GivenName,
+		FamilyName:  d.FamilyName,
+		LegalName:   d.LegalName,
+		DateOfBirth: d.DateOfBirth,
+		Email:       d.Email,
+		Phone:       d.Phone,
+	}
+	for _, a := range d.Addresses {
+		if a == nil {
+			continue
+		}
+		msg.Addresses = append(msg.Addresses, &customerv1.Address{
+			Type:       customerv1.AddressType(customerv1.AddressType_value["ADDRESS_TYPE_"+strings.ToUpper(a.Type)]),
+			Line1:      a.Line1,
+			Line2:      a.Line2,
+			City:       a.City,
+			Region:     a.Region,
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+		})
+	}
+	return msg
+}
+
+func toRelationshipMsg(rel *partyRelationship) *customerv1.Relationship {
+	return &customerv1.Relationship{
+		Type:    customerv1.RelationshipType(customerv1.RelationshipType_value["RELATIONSHIP_TYPE_"+strings.ToUpper(rel.Type)]),
+		PartyId: rel.PartyID,
+	}
+}
+
+func toPartyResp(m *customerv1.Party) *partyResp {
+	if m == nil {
+		return nil
+	}
+	resp := &partyResp{
+		PartyID:           m.GetPartyId(),
+		DisplayName:       m.GetDisplayName(),
+		Status:            enumName(m.GetStatus().String(), "PARTY_STATUS_"),
+		KYCVerificationID: m.GetKycVerificationId(),
+		KYCStatus:         enumName(m.GetKycStatus().String(), "KYC_STATUS_"),
+		MergedInto:        m.GetMergedInto(),
+		CreatedAt:         formatTimestamp(m.GetCreatedAt()),
+		UpdatedAt:         formatTimestamp(m.GetUpdatedAt()),
+		Relationships:     make([]*partyRelationship, 0, len(m.GetRelationships())),
+		ExternalRefs:      make([]*partyExternalRef, 0, len(m.GetExternalRefs())),
+		Version:           m.GetVersion(),
+	}
+	if d := m.GetDetails(); d != nil {
+		resp.Details = &partyDetails{
+			Type:        enumName(d.GetType().String(), "PARTY_TYPE_"),
+			GivenName:   d.GetGivenName(),
+			FamilyName:  d.GetFamilyName(),
+			LegalName:   d.GetLegalName(),
+			DateOfBirth: d.GetDateOfBirth(),
+			Email:       d.GetEmail(),
+			Phone:       d.GetPhone(),
+			Addresses:   make([]*partyAddress, 0, len(d.GetAddresses())),
+		}
+		for _, a := range d.GetAddresses() {
+			resp.Details.Addresses = append(resp.Details.Addresses, &partyAddress{
+				Type:       enumName(a.GetType().String(), "ADDRESS_TYPE_"),
+				Line1:      a.GetLine1(),
+				Line2:      a.GetLine2(),
+				City:       a.GetCity(),
+				Region:     a.GetRegion(),
+				PostalCode: a.GetPostalCode(),
+				Country:    a.GetCountry(),
+			})
+		}
+	}
+	for _, rel := range m.GetRelationships() {
+		resp.Relationships = append(resp.Relationships, &partyRelationship{
+			Type:    enumName(rel.GetType().String(), "RELATIONSHIP_TYPE_"),
+			PartyID: rel.GetPartyId(),
+		})
+	}
+	for _, ref := range m.GetExternalRefs() {
+		resp.ExternalRefs = append(resp.ExternalRefs, &partyExternalRef{Kind: ref.GetKind(), ID: ref.GetId()})
+	}
+	return resp
+}
+
+func toDuplicateMatchResps(ms []*customerv1.DuplicateMatch) []*duplicateMatchResp {
+	out := make([]*duplicateMatchResp, 0, len(ms))
+	for _, m := range ms {
+		out = append(out, &duplicateMatchResp{PartyID: m.GetPartyId(), Reasons: m.GetReasons(), Score: m.GetScore()})
+	}
+	return out
+}
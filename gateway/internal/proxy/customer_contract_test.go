@@ -0,0 +1,66 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const partyJSON = `{
+	"party_id": "a4c6e8b0-2d4f-4a1c-9e3b-5d7f9b1d3f6a",
+	"display_name": "Ada Lovelace",
+	"details": {"type": "PARTY_TYPE_INDIVIDUAL", "given_name": "Ada", "family_name": "Lovelace",
+		"email": "ada@example.com"},
+	"status": "PARTY_STATUS_ACTIVE",
+	"kyc_status": "KYC_STATUS_UNVERIFIED",
+	"version": 1,
+	"created_at": "2026-01-15T09:00:00Z",
+	"updated_at": "2026-01-15T09:00:00Z"
+}`
+
+func TestCustomerContract(t *testing.T) {
+	stub, conn := newContractStub(t, "customer-service")
+	p := NewCustomerProxy(conn, conn.Logger)
+	partyID := contractCustomerID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "create an individual",
+		Method:      "/bib.customer.v1.CustomerService/CreateParty",
+		Response:    json.RawMessage(`{"party": ` + partyJSON + `}`),
+	})
+	rec := call(t, p.CreateParty, http.MethodPost, "/api/v1/customers", `{"details": {"type": "individual",
+		"given_name": "Ada", "family_name": "Lovelace", "email": "ada@example.com"}}`)
+	if rec.Code != http.StatusCreated {
+		t.Errorf("CreateParty = %d %s", rec.Code, rec.Body)
+	} else if party, _ := decodeBody(t, rec)["party"].(map[string]any); party["party_id"] != partyID {
+		t.Errorf("CreateParty party = %v", party)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a customer",
+		State:       "an individual is a customer",
+		Method:      "/bib.customer.v1.CustomerService/GetParty",
+		Response:    json.RawMessage(`{"party": ` + partyJSON + `}`),
+	})
+	rec = call(t, p.GetParty, http.MethodGet, "/api/v1/customers/"+partyID, "", "id", partyID)
+	if rec.Code != http.StatusOK {
+		t.Errorf("GetParty = %d %s", rec.Code, rec.Body)
+	} else if party, _ := decodeBody(t, rec)["party"].(map[string]any); party["kyc_status"] != "UNVERIFIED" {
+		t.Errorf("GetParty party = %v", party)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing customer",
+		Method:      "/bib.customer.v1.CustomerService/GetParty",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetParty, http.MethodGet, "/api/v1/customers/"+partyID, "", "id", partyID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetParty of a missing customer = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-customer-service.json"))
+}
@@ -3,19 +3,21 @@ package proxy
 import (
 	"log/slog"
 	"net/http"
+	"strings"
 
+	depositv1 "github.com/bibbank/bib/api/gen/go/bib/deposit/v1"
 	"github.com/bibbank/bib/pkg/auth"
 )
 
 // DepositProxy proxies HTTP requests to the deposit gRPC service.
 type DepositProxy struct {
-	conn   *ServiceConn
+	client depositv1.DepositServiceClient
 	logger *slog.Logger
 }
 
 // NewDepositProxy creates a new deposit service proxy.
 func NewDepositProxy(conn *ServiceConn, logger *slog.Logger) *DepositProxy {
-	return &DepositProxy{conn: conn, logger: logger}
+	return &DepositProxy{client: depositv1.NewDepositServiceClient(conn), logger: logger}
 }
 
 type interestTier struct {
@@ -95,13 +97,26 @@ func (p *DepositProxy) CreateProduct(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	var resp createProductResp
-	err := p.conn.Invoke(r.Context(), "/bib.deposit.v1.DepositService/CreateProduct", &req, &resp)
+	tiers := make([]*depositv1.InterestTier, 0, len(req.Tiers))
+	for _, t := range req.Tiers {
+		tiers = append(tiers, &depositv1.InterestTier{
+			MinBalance: t.MinBalance,
+			MaxBalance: t.MaxBalance,
+			RateBps:    t.RateBps,
+		})
+	}
+	resp, err := p.client.CreateProduct(r.Context(), &depositv1.CreateDepositProductRequest{
+		TenantId: req.TenantID,
+		Name:     req.Name,
+		Currency: req.Currency,
+		Tiers:    tiers,
+		TermDays: req.TermDays,
+	})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusCreated, resp)
+	writeJSON(w, http.StatusCreated, createProductResp{Product: toDepositProductMsg(resp.GetProduct())})
 }
 
 // OpenPosition handles POST /api/v1/deposits/positions.
@@ -118,13 +133,17 @@ func (p *DepositProxy) OpenPosition(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	var resp openPositionResp
-	err := p.conn.Invoke(r.Context(), "/bib.deposit.v1.DepositService/OpenPosition", &req, &resp)
+	resp, err := p.client.OpenPosition(r.Context(), &depositv1.OpenDepositPositionRequest{
+		TenantId:  req.TenantID,
+		AccountId: req.AccountID,
+		ProductId: req.ProductID,
+		Principal: req.Principal,
+	})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusCreated, resp)
+	writeJSON(w, http.StatusCreated, openPositionResp{Position: toDepositPositionMsg(resp.GetPosition())})
 }
 
 // GetPosition handles GET /api/v1/deposits/positions/{id}.
@@ -135,12 +154,52 @@ func (p *DepositProxy) GetPosition(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req := map[string]string{"id": positionID}
-	var resp getPositionResp
-	err := p.conn.Invoke(r.Context(), "/bib.deposit.v1.DepositService/GetPosition", &req, &resp)
+	resp, err := p.client.GetPosition(r.Context(), &depositv1.GetDepositPositionRequest{Id: positionID})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, getPositionResp{Position: toDepositPositionMsg(resp.GetPosition())})
+}
+
+func toDepositProductMsg(m *depositv1.DepositProduct) depositProductMsg {
+	tiers := make([]interestTier, 0, len(m.GetTiers()))
+	for _, t := range m.GetTiers() {
+		tiers = append(tiers, interestTier{
+			MinBalance: t.GetMinBalance(),
+			MaxBalance: t.GetMaxBalance(),
+			RateBps:    t.GetRateBps(),
+		})
+	}
+	return depositProductMsg{
+		ID:        m.GetId(),
+		TenantID:  m.GetTenantId(),
+		Name:      m.GetName(),
+		Currency:  m.GetCurrency(),
+		CreatedAt: formatTimestamp(m.GetAudit().GetCreatedAt()),
+		UpdatedAt: formatTimestamp(m.GetAudit().GetUpdatedAt()),
+		Tiers:     tiers,
+		TermDays:  m.GetTermDays(),
+		Version:   m.GetAudit().GetVersion(),
+		IsActive:  m.GetIsActive(),
+	}
+}
+
+func toDepositPositionMsg(m *depositv1.DepositPosition) depositPositionMsg {
+	return depositPositionMsg{
+		ID:              m.GetId(),
+		TenantID:        m.GetTenantId(),
+		AccountID:       m.GetAccountId(),
+		ProductID:       m.GetProductId(),
+		Principal:       m.GetPrincipal().GetAmount(),
+		Currency:        m.GetPrincipal().GetCurrency(),
+		AccruedInterest: m.GetAccruedInterest().GetAmount(),
+		Status:          strings.TrimPrefix(m.GetStatus().String(), "DEPOSIT_POSITION_STATUS_"),
+		OpenedAt:        formatTimestamp(m.GetOpenedAt()),
+		LastAccrualDate: formatTimestamp(m.GetLastAccrualDate()),
+		MaturityDate:    formatTimestamp(m.GetMaturityDate()),
+		CreatedAt:       formatTimestamp(m.GetAudit().GetCreatedAt()),
+		UpdatedAt:       formatTimestamp(m.GetAudit().GetUpdatedAt()),
+		Version:         m.GetAudit().GetVersion(),
+	}
 }
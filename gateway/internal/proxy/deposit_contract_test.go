@@ -0,0 +1,78 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const depositPositionJSON = `{
+	"id": "6d2b8f4a-1c3e-4a5b-8d7f-0e2a4c6b8d1f",
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"account_id": "5b0e2d8a-7c41-4f6e-a3b9-2c8d1e4f6a70",
+	"product_id": "2f8a4c6e-0b1d-4e3f-9a5c-7e9b1d3f5a8c",
+	"principal": {"amount": "5000.00", "currency": "USD"},
+	"accrued_interest": {"amount": "0.00", "currency": "USD"},
+	"status": "DEPOSIT_POSITION_STATUS_ACTIVE",
+	"opened_at": "2026-01-15T09:00:00Z",
+	"last_accrual_date": "2026-01-15T09:00:00Z",
+	"audit": {"created_at": "2026-01-15T09:00:00Z", "updated_at": "2026-01-15T09:00:00Z", "version": 1}
+}`
+
+func TestDepositContract(t *testing.T) {
+	stub, conn := newContractStub(t, "deposit-service")
+	p := NewDepositProxy(conn, conn.Logger)
+	id := contractPositionID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "create a tiered savings product",
+		Method:      "/bib.deposit.v1.DepositService/CreateProduct",
+		Response: json.RawMessage(`{"product": {"id": "2f8a4c6e-0b1d-4e3f-9a5c-7e9b1d3f5a8c",
+			"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f", "name": "Easy Saver", "currency": "USD",
+			"tiers": [{"min_balance": "0", "max_balance": "10000", "rate_bps": 150}], "term_days": 0,
+			"is_active": true, "audit": {"created_at": "2026-01-15T09:00:00Z", "updated_at": "2026-01-15T09:00:00Z", "version": 1}}}`),
+	})
+	rec := call(t, p.CreateProduct, http.MethodPost, "/api/v1/deposits/products", `{"name": "Easy Saver",
+		"currency": "USD", "tiers": [{"min_balance": "0", "max_balance": "10000", "rate_bps": 150}]}`)
+	if rec.Code != http.StatusCreated || decodeBody(t, rec)["product"].(map[string]any)["is_active"] != true {
+		t.Errorf("CreateProduct = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "open a position in an active product",
+		State:       "an active savings product exists",
+		Method:      "/bib.deposit.v1.DepositService/OpenPosition",
+		Response:    json.RawMessage(`{"position": ` + depositPositionJSON + `}`),
+	})
+	rec = call(t, p.OpenPosition, http.MethodPost, "/api/v1/deposits/positions", `{"account_id": "`+
+		contractAccountID.String()+`", "product_id": "`+contractProductID.String()+`", "principal": "5000"}`)
+	if rec.Code != http.StatusCreated || decodeBody(t, rec)["position"].(map[string]any)["status"] != "ACTIVE" {
+		t.Errorf("OpenPosition = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a deposit position",
+		State:       "a deposit position exists",
+		Method:      "/bib.deposit.v1.DepositService/GetPosition",
+		Response:    json.RawMessage(`{"position": ` + depositPositionJSON + `}`),
+	})
+	rec = call(t, p.GetPosition, http.MethodGet, "/api/v1/deposits/positions/"+id, "", "id", id)
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["position"].(map[string]any)["principal"] != "5000.00" {
+		t.Errorf("GetPosition = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing deposit position",
+		Method:      "/bib.deposit.v1.DepositService/GetPosition",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetPosition, http.MethodGet, "/api/v1/deposits/positions/"+id, "", "id", id)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetPosition of a missing position = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-deposit-service.json"))
+}
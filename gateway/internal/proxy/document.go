@@ -0,0 +1,153 @@
+package proxy
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	documentv1 "github.com/bibbank/bib/api/gen/go/bib/document/v1"
+)
+
+// DocumentProxy proxies HTTP requests to the document gRPC service. Other
+// services render documents; the gateway only lets callers find and
+// download them.
+type DocumentProxy struct {
+	client documentv1.DocumentServiceClient
+	logger *slog.Logger
+}
+
+// NewDocumentProxy creates a new document service proxy.
+func NewDocumentProxy(conn *ServiceConn, logger *slog.Logger) *DocumentProxy {
+	return &DocumentProxy{client: documentv1.NewDocumentServiceClient(conn), logger: logger}
+}
+
+type renderedDocumentMsg struct {
+	DocumentID      string `json:"document_id"`
+	TemplateName    string `json:"template_name"`
+	CustomerID      string `json:"customer_id,omitempty"`
+	OwnerReference  string `json:"owner_reference,omitempty"`
+	Title           string `json:"title"`
+	Status          string `json:"status"`
+	ContentType     string `json:"content_type"`
+	SHA256          string `json:"sha256"`
+	CreatedAt       string `json:"created_at"`
+	RetainUntil     string `json:"retain_until"`
+	PurgedAt        string `json:"purged_at,omitempty"`
+	SizeBytes       int64  `json:"size_bytes"`
+	TemplateVersion int32  `json:"template_version"`
+	PageCount       int32  `json:"page_count"`
+}
+
+type renderedDocumentResp struct {
+	Document *renderedDocumentMsg `json:"document"`
+}
+
+type listDocumentsResp struct {
+	Documents  []*renderedDocumentMsg `json:"documents"`
+	TotalCount int32                  `json:"total_count"`
+}
+
+type documentDownloadURLResp struct {
+	URL       string `json:"url"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// GetDocument handles GET /api/v1/documents/{id}.
+func (p *DocumentProxy) GetDocument(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "document id is required")
+		return
+	}
+
+	resp, err := p.client.GetDocument(r.Context(), &documentv1.GetDocumentRequest{DocumentId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, renderedDocumentResp{Document: toRenderedDocumentMsg(resp.GetDocument())})
+}
+
+// ListDocuments handles GET /api/v1/documents.
+// Query parameters: customer_id, owner_reference, page_size, offset.
+func (p *DocumentProxy) ListDocuments(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+
+	q := r.URL.Query()
+	resp, err := p.client.ListDocuments(r.Context(), &documentv1.ListDocumentsRequest{
+		CustomerId:     q.Get("customer_id"),
+		OwnerReference: q.Get("owner_reference"),
+		PageSize:       pageSize,
+		Offset:         offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listDocumentsResp{
+		Documents:  make([]*renderedDocumentMsg, 0, len(resp.GetDocuments())),
+		TotalCount: resp.GetTotalCount(),
+	}
+	for _, d := range resp.GetDocuments() {
+		out.Documents = append(out.Documents, toRenderedDocumentMsg(d))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetDownloadURL handles GET /api/v1/documents/{id}/download-url, returning
+// a signed URL the document can be fetched from until it expires.
+// Query parameters: ttl_seconds.
+func (p *DocumentProxy) GetDownloadURL(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "document id is required")
+		return
+	}
+	var ttl int32
+	if v := r.URL.Query().Get("ttl_seconds"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid ttl_seconds")
+			return
+		}
+		ttl = int32(n)
+	}
+
+	resp, err := p.client.GetDownloadURL(r.Context(), &documentv1.GetDownloadURLRequest{
+		DocumentId: id,
+		TtlSeconds: ttl,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, documentDownloadURLResp{
+		URL:       resp.GetUrl(),
+		ExpiresAt: formatTimestamp(resp.GetExpiresAt()),
+	})
+}
+
+func toRenderedDocumentMsg(d *documentv1.Document) *renderedDocumentMsg {
+	if d == nil {
+		return nil
+	}
+	return &renderedDocumentMsg{
+		DocumentID:      d.GetDocumentId(),
+		TemplateName:    d.GetTemplateName(),
+		TemplateVersion: d.GetTemplateVersion(),
+		CustomerID:      d.GetCustomerId(),
+		OwnerReference:  d.GetOwnerReference(),
+		Title:           d.GetTitle(),
+		Status:          enumName(d.GetStatus().String(), "DOCUMENT_STATUS_"),
+		ContentType:     d.GetContentType(),
+		SizeBytes:       d.GetSizeBytes(),
+		SHA256:          d.GetSha256(),
+		PageCount:       d.GetPageCount(),
+		CreatedAt:       formatTimestamp(d.GetCreatedAt()),
+		RetainUntil:     formatTimestamp(d.GetRetainUntil()),
+		PurgedAt:        formatTimestamp(d.GetPurgedAt()),
+	}
+}
@@ -0,0 +1,59 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+func TestDocumentContract(t *testing.T) {
+	stub, conn := newContractStub(t, "document-service")
+	p := NewDocumentProxy(conn, conn.Logger)
+	documentID := contractDocumentID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "get a document",
+		State:       "a document was rendered",
+		Method:      "/bib.document.v1.DocumentService/GetDocument",
+		Response: json.RawMessage(`{"document": {"document_id": "` + documentID + `",
+			"customer_id": "` + contractCustomerID.String() + `", "template_name": "loan-agreement",
+			"template_version": 1, "title": "Loan agreement", "status": "DOCUMENT_STATUS_STORED",
+			"content_type": "application/pdf", "size_bytes": "2048", "sha256": "e3b0c442", "page_count": 2,
+			"created_at": "2026-02-01T02:00:00Z", "retain_until": "2033-02-01T02:00:00Z"}}`),
+	})
+	rec := call(t, p.GetDocument, http.MethodGet, "/api/v1/documents/"+documentID, "", "id", documentID)
+	if rec.Code != http.StatusOK {
+		t.Errorf("GetDocument = %d %s", rec.Code, rec.Body)
+	} else if doc, _ := decodeBody(t, rec)["document"].(map[string]any); doc["status"] != "STORED" {
+		t.Errorf("GetDocument document = %v", doc)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing document",
+		Method:      "/bib.document.v1.DocumentService/GetDocument",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetDocument, http.MethodGet, "/api/v1/documents/"+documentID, "", "id", documentID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetDocument of a missing document = %d, want 404", rec.Code)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a download URL",
+		State:       "a document was rendered",
+		Method:      "/bib.document.v1.DocumentService/GetDownloadURL",
+		Response: json.RawMessage(`{"url": "https://documents.example/download",
+			"expires_at": "2026-02-01T02:15:00Z"}`),
+	})
+	rec = call(t, p.GetDownloadURL, http.MethodGet, "/api/v1/documents/"+documentID+"/download-url?ttl_seconds=900", "", "id", documentID)
+	if rec.Code != http.StatusOK {
+		t.Errorf("GetDownloadURL = %d %s", rec.Code, rec.Body)
+	} else if body := decodeBody(t, rec); body["url"] == "" || body["expires_at"] == "" {
+		t.Errorf("GetDownloadURL body = %v", body)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-document-service.json"))
+}
@@ -1,21 +1,46 @@
 package proxy
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	fraudv1 "github.com/bibbank/bib/api/gen/go/bib/fraud/v1"
 	"github.com/bibbank/bib/pkg/auth"
 )
 
-// FraudProxy proxies HTTP requests to the fraud gRPC service.
+// FraudProxy proxies HTTP requests to the fraud gRPC services.
 type FraudProxy struct {
-	conn   *ServiceConn
-	logger *slog.Logger
+	assessments fraudv1.FraudServiceClient
+	rules       fraudv1.FraudRuleServiceClient
+	cases       fraudv1.FraudCaseServiceClient
+	labels      fraudv1.FraudLabelServiceClient
+	screenings  fraudv1.FraudScreeningServiceClient
+	policies    fraudv1.FraudPolicyServiceClient
+	links       fraudv1.FraudLinkServiceClient
+	logger      *slog.Logger
 }
 
 // NewFraudProxy creates a new fraud service proxy.
 func NewFraudProxy(conn *ServiceConn, logger *slog.Logger) *FraudProxy {
-	return &FraudProxy{conn: conn, logger: logger}
+	return &FraudProxy{
+		assessments: fraudv1.NewFraudServiceClient(conn),
+		rules:       fraudv1.NewFraudRuleServiceClient(conn),
+		cases:       fraudv1.NewFraudCaseServiceClient(conn),
+		labels:      fraudv1.NewFraudLabelServiceClient(conn),
+		screenings:  fraudv1.NewFraudScreeningServiceClient(conn),
+		policies:    fraudv1.NewFraudPolicyServiceClient(conn),
+		links:       fraudv1.NewFraudLinkServiceClient(conn),
+		logger:      logger,
+	}
 }
 
 type assessTransactionReq struct {
@@ -26,14 +51,32 @@ type assessTransactionReq struct {
 	Amount          string            `json:"amount"`
 	Currency        string            `json:"currency"`
 	TransactionType string            `json:"transaction_type"`
+	// Screened against sanctions lists when set.
+	CounterpartyName   string `json:"counterparty_name,omitempty"`
+	DestinationCountry string `json:"destination_country,omitempty"`
+	DeviceFingerprint  string `json:"device_fingerprint,omitempty"`
+	IPAddress          string `json:"ip_address,omitempty"`
+	DestinationAccount string `json:"destination_account,omitempty"`
+}
+
+type fraudRuleHitMsg struct {
+	RuleID      string `json:"rule_id"`
+	RuleName    string `json:"rule_name"`
+	Signal      string `json:"signal"`
+	ScoreImpact int    `json:"score_impact"`
+	RuleVersion int    `json:"rule_version"`
+	Shadow      bool   `json:"shadow"`
 }
 
 type assessTransactionResp struct {
-	AssessmentID string   `json:"assessment_id"`
-	RiskLevel    string   `json:"risk_level"`
-	Decision     string   `json:"decision"`
-	Signals      []string `json:"signals"`
-	RiskScore    int      `json:"risk_score"`
+	AssessmentID  string            `json:"assessment_id"`
+	RiskLevel     string            `json:"risk_level"`
+	Decision      string            `json:"decision"`
+	ModelVersion  string            `json:"model_version,omitempty"`
+	Signals       []string          `json:"signals"`
+	RuleHits      []fraudRuleHitMsg `json:"rule_hits"`
+	RiskScore     int               `json:"risk_score"`
+	PolicyVersion int               `json:"policy_version"`
 }
 
 type getAssessmentResp struct {
@@ -45,8 +88,59 @@ type getAssessmentResp struct {
 	TransactionType string   `json:"transaction_type"`
 	RiskLevel       string   `json:"risk_level"`
 	Decision        string   `json:"decision"`
+	ModelVersion    string   `json:"model_version,omitempty"`
 	Signals         []string `json:"signals"`
 	RiskScore       int      `json:"risk_score"`
+	PolicyVersion   int      `json:"policy_version"`
+}
+
+type fraudAssessmentMsg struct {
+	AssessmentID    string   `json:"assessment_id"`
+	TransactionID   string   `json:"transaction_id"`
+	AccountID       string   `json:"account_id"`
+	Amount          string   `json:"amount"`
+	Currency        string   `json:"currency"`
+	TransactionType string   `json:"transaction_type"`
+	RiskLevel       string   `json:"risk_level"`
+	Decision        string   `json:"decision"`
+	ModelVersion    string   `json:"model_version,omitempty"`
+	AssessedAt      string   `json:"assessed_at"`
+	Signals         []string `json:"signals"`
+	RiskScore       int      `json:"risk_score"`
+	PolicyVersion   int      `json:"policy_version"`
+}
+
+type listAssessmentsResp struct {
+	Assessments []fraudAssessmentMsg `json:"assessments"`
+	TotalCount  int                  `json:"total_count"`
+}
+
+type fraudScoreBandMsg struct {
+	Min   int `json:"min"`
+	Max   int `json:"max"`
+	Count int `json:"count"`
+}
+
+type fraudDailyDecisionsMsg struct {
+	Day      string `json:"day"`
+	Approved int    `json:"approved"`
+	Review   int    `json:"review"`
+	Declined int    `json:"declined"`
+}
+
+type getAssessmentMetricsResp struct {
+	Decisions         map[string]int           `json:"decisions"`
+	RiskLevels        map[string]int           `json:"risk_levels"`
+	From              string                   `json:"from"`
+	To                string                   `json:"to"`
+	ScoreHistogram    []fraudScoreBandMsg      `json:"score_histogram"`
+	Daily             []fraudDailyDecisionsMsg `json:"daily"`
+	ApprovalRate      float64                  `json:"approval_rate"`
+	ReviewRate        float64                  `json:"review_rate"`
+	DeclineRate       float64                  `json:"decline_rate"`
+	AverageScore      float64                  `json:"average_score"`
+	Total             int                      `json:"total"`
+	SanctionsHitCount int                      `json:"sanctions_hit_count"`
 }
 
 // AssessTransaction handles POST /api/v1/fraud/assessments.
@@ -63,13 +157,46 @@ func (p *FraudProxy) AssessTransaction(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	var resp assessTransactionResp
-	err := p.conn.Invoke(r.Context(), "/bib.fraud.v1.FraudService/AssessTransaction", &req, &resp)
+	resp, err := p.assessments.AssessTransaction(r.Context(), &fraudv1.AssessTransactionRequest{
+		TenantId:           req.TenantID,
+		TransactionId:      req.TransactionID,
+		AccountId:          req.AccountID,
+		Amount:             &commonv1.Money{Amount: req.Amount, Currency: req.Currency},
+		TransactionType:    req.TransactionType,
+		Metadata:           req.Metadata,
+		CounterpartyName:   req.CounterpartyName,
+		DestinationCountry: req.DestinationCountry,
+		DeviceFingerprint:  req.DeviceFingerprint,
+		IpAddress:          req.IPAddress,
+		DestinationAccount: req.DestinationAccount,
+	})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusCreated, resp)
+
+	a := resp.GetAssessment()
+	out := assessTransactionResp{
+		AssessmentID:  a.GetId(),
+		RiskLevel:     enumName(a.GetRiskLevel().String(), "RISK_LEVEL_"),
+		Decision:      enumName(a.GetDecision().String(), "ASSESSMENT_DECISION_"),
+		ModelVersion:  a.GetModelVersion(),
+		Signals:       a.GetRiskSignals(),
+		RuleHits:      make([]fraudRuleHitMsg, 0, len(resp.GetRuleHits())),
+		RiskScore:     int(a.GetRiskScore()),
+		PolicyVersion: int(a.GetPolicyVersion()),
+	}
+	for _, h := range resp.GetRuleHits() {
+		out.RuleHits = append(out.RuleHits, fraudRuleHitMsg{
+			RuleID:      h.GetRuleId(),
+			RuleName:    h.GetRuleName(),
+			Signal:      h.GetSignal(),
+			ScoreImpact: int(h.GetScoreImpact()),
+			RuleVersion: int(h.GetRuleVersion()),
+			Shadow:      h.GetShadow(),
+		})
+	}
+	writeJSON(w, http.StatusCreated, out)
 }
 
 // GetAssessment handles GET /api/v1/fraud/assessments/{id}.
@@ -80,20 +207,1055 @@ func (p *FraudProxy) GetAssessment(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tenantID := ""
-	if claims, ok := auth.ClaimsFromContext(r.Context()); ok {
-		tenantID = claims.TenantID.String()
+	resp, err := p.assessments.GetAssessment(r.Context(), &fraudv1.GetAssessmentRequest{Id: assessmentID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+
+	a := toFraudAssessmentMsg(resp.GetAssessment())
+	writeJSON(w, http.StatusOK, getAssessmentResp{
+		AssessmentID:    a.AssessmentID,
+		TransactionID:   a.TransactionID,
+		AccountID:       a.AccountID,
+		Amount:          a.Amount,
+		Currency:        a.Currency,
+		TransactionType: a.TransactionType,
+		RiskLevel:       a.RiskLevel,
+		Decision:        a.Decision,
+		ModelVersion:    a.ModelVersion,
+		Signals:         a.Signals,
+		RiskScore:       a.RiskScore,
+		PolicyVersion:   a.PolicyVersion,
+	})
+}
+
+// ListAssessments handles GET /api/v1/fraud/assessments.
+// Query parameters: account_id, decision, risk_level, from, to (RFC 3339),
+// page_size, offset.
+func (p *FraudProxy) ListAssessments(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	decision, ok := readFraudEnum(w, "decision", q.Get("decision"), "ASSESSMENT_DECISION_", fraudv1.AssessmentDecision_value)
+	if !ok {
+		return
+	}
+	riskLevel, ok := readFraudEnum(w, "risk_level", q.Get("risk_level"), "RISK_LEVEL_", fraudv1.RiskLevel_value)
+	if !ok {
+		return
+	}
+	from, ok := readFraudTime(w, "from", q.Get("from"))
+	if !ok {
+		return
+	}
+	to, ok := readFraudTime(w, "to", q.Get("to"))
+	if !ok {
+		return
+	}
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.assessments.ListAssessments(r.Context(), &fraudv1.ListAssessmentsRequest{
+		AccountId: q.Get("account_id"),
+		Decision:  fraudv1.AssessmentDecision(decision),
+		RiskLevel: fraudv1.RiskLevel(riskLevel),
+		From:      from,
+		To:        to,
+		PageSize:  pageSize,
+		Offset:    offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+
+	out := listAssessmentsResp{
+		Assessments: make([]fraudAssessmentMsg, 0, len(resp.GetAssessments())),
+		TotalCount:  int(resp.GetTotalCount()),
+	}
+	for _, a := range resp.GetAssessments() {
+		out.Assessments = append(out.Assessments, toFraudAssessmentMsg(a))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetAssessmentMetrics handles GET /api/v1/fraud/assessments/metrics.
+// Query parameters: from, to (RFC 3339); the default period is the last 7 days.
+func (p *FraudProxy) GetAssessmentMetrics(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	from, ok := readFraudTime(w, "from", q.Get("from"))
+	if !ok {
+		return
+	}
+	to, ok := readFraudTime(w, "to", q.Get("to"))
+	if !ok {
+		return
+	}
+
+	resp, err := p.assessments.GetAssessmentMetrics(r.Context(), &fraudv1.GetAssessmentMetricsRequest{From: from, To: to})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+
+	out := getAssessmentMetricsResp{
+		Decisions:         fraudCounts(resp.GetDecisions()),
+		RiskLevels:        fraudCounts(resp.GetRiskLevels()),
+		From:              formatTimestamp(resp.GetFrom()),
+		To:                formatTimestamp(resp.GetTo()),
+		ScoreHistogram:    make([]fraudScoreBandMsg, 0, len(resp.GetScoreHistogram())),
+		Daily:             make([]fraudDailyDecisionsMsg, 0, len(resp.GetDaily())),
+		ApprovalRate:      resp.GetApprovalRate(),
+		ReviewRate:        resp.GetReviewRate(),
+		DeclineRate:       resp.GetDeclineRate(),
+		AverageScore:      resp.GetAverageScore(),
+		Total:             int(resp.GetTotal()),
+		SanctionsHitCount: int(resp.GetSanctionsHitCount()),
+	}
+	for _, b := range resp.GetScoreHistogram() {
+		out.ScoreHistogram = append(out.ScoreHistogram, fraudScoreBandMsg{
+			Min:   int(b.GetMin()),
+			Max:   int(b.GetMax()),
+			Count: int(b.GetCount()),
+		})
+	}
+	for _, d := range resp.GetDaily() {
+		out.Daily = append(out.Daily, fraudDailyDecisionsMsg{
+			Day:      d.GetDay(),
+			Approved: int(d.GetApproved()),
+			Review:   int(d.GetReview()),
+			Declined: int(d.GetDeclined()),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+type fraudRuleReq struct {
+	RuleID      string `json:"rule_id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description"`
+	Condition   string `json:"condition"`
+	Signal      string `json:"signal"`
+	Mode        string `json:"mode,omitempty"`
+	ScoreImpact int    `json:"score_impact"`
+}
+
+type fraudRuleMsg struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Condition   string `json:"condition"`
+	Signal      string `json:"signal"`
+	Mode        string `json:"mode"`
+	UpdatedBy   string `json:"updated_by"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+	ScoreImpact int    `json:"score_impact"`
+	Version     int    `json:"version"`
+}
+
+type fraudRuleResp struct {
+	Rule fraudRuleMsg `json:"rule"`
+}
+
+type listFraudRulesResp struct {
+	Rules []fraudRuleMsg `json:"rules"`
+}
+
+type fraudRuleVersionMsg struct {
+	Description string `json:"description"`
+	Condition   string `json:"condition"`
+	Signal      string `json:"signal"`
+	Mode        string `json:"mode"`
+	ChangedBy   string `json:"changed_by"`
+	ChangedAt   string `json:"changed_at"`
+	ScoreImpact int    `json:"score_impact"`
+	Version     int    `json:"version"`
+}
+
+type listFraudRuleVersionsResp struct {
+	Versions []fraudRuleVersionMsg `json:"versions"`
+}
+
+type fraudRuleMetricsMsg struct {
+	RuleID     string `json:"rule_id"`
+	Name       string `json:"name"`
+	Signal     string `json:"signal"`
+	Mode       string `json:"mode"`
+	LastHitAt  string `json:"last_hit_at,omitempty"`
+	Hits       int64  `json:"hits"`
+	ShadowHits int64  `json:"shadow_hits"`
+}
+
+type fraudRuleMetricsResp struct {
+	Rules []fraudRuleMetricsMsg `json:"rules"`
+}
+
+type dryRunFraudRuleReq struct {
+	Metadata        map[string]string `json:"metadata,omitempty"`
+	Condition       string            `json:"condition"`
+	Signal          string            `json:"signal"`
+	Amount          string            `json:"amount"`
+	Currency        string            `json:"currency"`
+	TransactionType string            `json:"transaction_type"`
+	ScoreImpact     int               `json:"score_impact"`
+}
+
+type dryRunFraudRuleResp struct {
+	CurrentSignals []string `json:"current_signals"`
+	CurrentScore   int      `json:"current_score"`
+	ProjectedScore int      `json:"projected_score"`
+	Matched        bool     `json:"matched"`
+}
+
+// CreateRule handles POST /api/v1/fraud/rules.
+func (p *FraudProxy) CreateRule(w http.ResponseWriter, r *http.Request) {
+	var req fraudRuleReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	mode, ok := readFraudEnum(w, "mode", req.Mode, "RULE_MODE_", fraudv1.RuleMode_value)
+	if !ok {
+		return
+	}
+
+	resp, err := p.rules.CreateRule(r.Context(), &fraudv1.CreateRuleRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		Condition:   req.Condition,
+		Signal:      req.Signal,
+		ScoreImpact: int32(req.ScoreImpact), //nolint:gosec // validated by the fraud service
+		Mode:        fraudv1.RuleMode(mode),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusCreated, fraudRuleResp{Rule: toFraudRuleMsg(resp.GetRule())})
+}
+
+// UpdateRule handles PUT /api/v1/fraud/rules/{id}.
+func (p *FraudProxy) UpdateRule(w http.ResponseWriter, r *http.Request) {
+	ruleID := r.PathValue("id")
+	if ruleID == "" {
+		writeError(w, http.StatusBadRequest, "rule id is required")
+		return
+	}
+
+	var req fraudRuleReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	mode, ok := readFraudEnum(w, "mode", req.Mode, "RULE_MODE_", fraudv1.RuleMode_value)
+	if !ok {
+		return
+	}
+
+	resp, err := p.rules.UpdateRule(r.Context(), &fraudv1.UpdateRuleRequest{
+		RuleId:      ruleID,
+		Description: req.Description,
+		Condition:   req.Condition,
+		Signal:      req.Signal,
+		ScoreImpact: int32(req.ScoreImpact), //nolint:gosec // validated by the fraud service
+		Mode:        fraudv1.RuleMode(mode),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, fraudRuleResp{Rule: toFraudRuleMsg(resp.GetRule())})
+}
+
+// DisableRule handles POST /api/v1/fraud/rules/{id}/disable.
+func (p *FraudProxy) DisableRule(w http.ResponseWriter, r *http.Request) {
+	ruleID := r.PathValue("id")
+	if ruleID == "" {
+		writeError(w, http.StatusBadRequest, "rule id is required")
+		return
+	}
+
+	resp, err := p.rules.DisableRule(r.Context(), &fraudv1.DisableRuleRequest{RuleId: ruleID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, fraudRuleResp{Rule: toFraudRuleMsg(resp.GetRule())})
+}
+
+// ListRules handles GET /api/v1/fraud/rules.
+func (p *FraudProxy) ListRules(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.rules.ListRules(r.Context(), &fraudv1.ListRulesRequest{})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+
+	out := listFraudRulesResp{Rules: make([]fraudRuleMsg, 0, len(resp.GetRules()))}
+	for _, rule := range resp.GetRules() {
+		out.Rules = append(out.Rules, toFraudRuleMsg(rule))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// ListRuleVersions handles GET /api/v1/fraud/rules/{id}/versions.
+func (p *FraudProxy) ListRuleVersions(w http.ResponseWriter, r *http.Request) {
+	ruleID := r.PathValue("id")
+	if ruleID == "" {
+		writeError(w, http.StatusBadRequest, "rule id is required")
+		return
+	}
+
+	resp, err := p.rules.ListRuleVersions(r.Context(), &fraudv1.ListRuleVersionsRequest{RuleId: ruleID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+
+	out := listFraudRuleVersionsResp{Versions: make([]fraudRuleVersionMsg, 0, len(resp.GetVersions()))}
+	for _, v := range resp.GetVersions() {
+		out.Versions = append(out.Versions, fraudRuleVersionMsg{
+			Description: v.GetDescription(),
+			Condition:   v.GetCondition(),
+			Signal:      v.GetSignal(),
+			Mode:        enumName(v.GetMode().String(), "RULE_MODE_"),
+			ChangedBy:   v.GetChangedBy(),
+			ChangedAt:   formatTimestamp(v.GetChangedAt()),
+			ScoreImpact: int(v.GetScoreImpact()),
+			Version:     int(v.GetVersion()),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetRuleMetrics handles GET /api/v1/fraud/rules/metrics.
+func (p *FraudProxy) GetRuleMetrics(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.rules.GetRuleMetrics(r.Context(), &fraudv1.GetRuleMetricsRequest{})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+
+	out := fraudRuleMetricsResp{Rules: make([]fraudRuleMetricsMsg, 0, len(resp.GetRules()))}
+	for _, m := range resp.GetRules() {
+		out.Rules = append(out.Rules, fraudRuleMetricsMsg{
+			RuleID:     m.GetRuleId(),
+			Name:       m.GetName(),
+			Signal:     m.GetSignal(),
+			Mode:       enumName(m.GetMode().String(), "RULE_MODE_"),
+			LastHitAt:  formatTimestamp(m.GetLastHitAt()),
+			Hits:       m.GetHits(),
+			ShadowHits: m.GetShadowHits(),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// DryRunRule handles POST /api/v1/fraud/rules/dry-run.
+func (p *FraudProxy) DryRunRule(w http.ResponseWriter, r *http.Request) {
+	var req dryRunFraudRuleReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.rules.DryRunRule(r.Context(), &fraudv1.DryRunRuleRequest{
+		Condition:       req.Condition,
+		Signal:          req.Signal,
+		ScoreImpact:     int32(req.ScoreImpact), //nolint:gosec // validated by the fraud service
+		Amount:          &commonv1.Money{Amount: req.Amount, Currency: req.Currency},
+		TransactionType: req.TransactionType,
+		Metadata:        req.Metadata,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, dryRunFraudRuleResp{
+		CurrentSignals: resp.GetCurrentSignals(),
+		CurrentScore:   int(resp.GetCurrentScore()),
+		ProjectedScore: int(resp.GetProjectedScore()),
+		Matched:        resp.GetMatched(),
+	})
+}
+
+type fraudCaseNoteMsg struct {
+	ID        string `json:"id"`
+	AuthorID  string `json:"author_id"`
+	Body      string `json:"body"`
+	CreatedAt string `json:"created_at"`
+}
+
+type fraudCaseMsg struct {
+	ID            string             `json:"id"`
+	AssessmentID  string             `json:"assessment_id"`
+	TransactionID string             `json:"transaction_id"`
+	AccountID     string             `json:"account_id"`
+	RiskLevel     string             `json:"risk_level"`
+	Status        string             `json:"status"`
+	Disposition   string             `json:"disposition,omitempty"`
+	AssigneeID    string             `json:"assignee_id,omitempty"`
+	SLADueAt      string             `json:"sla_due_at"`
+	ResolvedAt    string             `json:"resolved_at,omitempty"`
+	CreatedAt     string             `json:"created_at"`
+	UpdatedAt     string             `json:"updated_at"`
+	Notes         []fraudCaseNoteMsg `json:"notes"`
+	LinkedIDs     []string           `json:"linked_assessment_ids,omitempty"`
+	RiskScore     int                `json:"risk_score"`
+	Version       int                `json:"version"`
+	SLABreached   bool               `json:"sla_breached"`
+}
+
+type fraudCaseResp struct {
+	Case fraudCaseMsg `json:"case"`
+}
+
+type listFraudCasesResp struct {
+	Cases      []fraudCaseMsg `json:"cases"`
+	TotalCount int            `json:"total_count"`
+}
+
+// fraudCaseActionReq carries the body of the case workflow endpoints. Each
+// endpoint uses only the fields relevant to its action.
+type fraudCaseActionReq struct {
+	CaseID       string `json:"case_id"`
+	AssessmentID string `json:"assessment_id,omitempty"`
+	AnalystID    string `json:"analyst_id,omitempty"`
+	Body         string `json:"body,omitempty"`
+	Reason       string `json:"reason,omitempty"`
+	Disposition  string `json:"disposition,omitempty"`
+	Note         string `json:"note,omitempty"`
+}
+
+// ListCases handles GET /api/v1/fraud/cases.
+// Query parameters: status, assignee_id, overdue, page_size, offset.
+func (p *FraudProxy) ListCases(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	caseStatus, ok := readFraudEnum(w, "status", q.Get("status"), "CASE_STATUS_", fraudv1.CaseStatus_value)
+	if !ok {
+		return
+	}
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.cases.ListCases(r.Context(), &fraudv1.ListCasesRequest{
+		Status:      fraudv1.CaseStatus(caseStatus),
+		AssigneeId:  q.Get("assignee_id"),
+		OverdueOnly: q.Get("overdue") == "true",
+		PageSize:    pageSize,
+		Offset:      offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+
+	out := listFraudCasesResp{
+		Cases:      make([]fraudCaseMsg, 0, len(resp.GetCases())),
+		TotalCount: int(resp.GetTotalCount()),
+	}
+	for _, c := range resp.GetCases() {
+		out.Cases = append(out.Cases, toFraudCaseMsg(c))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetCase handles GET /api/v1/fraud/cases/{id}.
+func (p *FraudProxy) GetCase(w http.ResponseWriter, r *http.Request) {
+	caseID := r.PathValue("id")
+	if caseID == "" {
+		writeError(w, http.StatusBadRequest, "case id is required")
+		return
+	}
+
+	resp, err := p.cases.GetCase(r.Context(), &fraudv1.GetCaseRequest{CaseId: caseID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, fraudCaseResp{Case: toFraudCaseMsg(resp.GetCase())})
+}
+
+// AssignCase handles POST /api/v1/fraud/cases/{id}/assign.
+// Omitting analyst_id assigns the case to the caller.
+func (p *FraudProxy) AssignCase(w http.ResponseWriter, r *http.Request) {
+	p.caseAction(w, r, func(ctx context.Context, req fraudCaseActionReq) (*fraudv1.CaseResponse, error) {
+		return p.cases.AssignCase(ctx, &fraudv1.AssignCaseRequest{CaseId: req.CaseID, AnalystId: req.AnalystID})
+	})
+}
+
+// AddCaseNote handles POST /api/v1/fraud/cases/{id}/notes.
+func (p *FraudProxy) AddCaseNote(w http.ResponseWriter, r *http.Request) {
+	p.caseAction(w, r, func(ctx context.Context, req fraudCaseActionReq) (*fraudv1.CaseResponse, error) {
+		return p.cases.AddCaseNote(ctx, &fraudv1.AddCaseNoteRequest{CaseId: req.CaseID, Body: req.Body})
+	})
+}
+
+// EscalateCase handles POST /api/v1/fraud/cases/{id}/escalate.
+func (p *FraudProxy) EscalateCase(w http.ResponseWriter, r *http.Request) {
+	p.caseAction(w, r, func(ctx context.Context, req fraudCaseActionReq) (*fraudv1.CaseResponse, error) {
+		return p.cases.EscalateCase(ctx, &fraudv1.EscalateCaseRequest{CaseId: req.CaseID, Reason: req.Reason})
+	})
+}
+
+// ResolveCase handles POST /api/v1/fraud/cases/{id}/resolve.
+func (p *FraudProxy) ResolveCase(w http.ResponseWriter, r *http.Request) {
+	p.caseAction(w, r, func(ctx context.Context, req fraudCaseActionReq) (*fraudv1.CaseResponse, error) {
+		disposition, ok := fraudv1.CaseDisposition_value["CASE_DISPOSITION_"+strings.ToUpper(req.Disposition)]
+		if !ok || disposition == 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid disposition")
+		}
+		return p.cases.ResolveCase(ctx, &fraudv1.ResolveCaseRequest{
+			CaseId:      req.CaseID,
+			Disposition: fraudv1.CaseDisposition(disposition),
+			Note:        req.Note,
+		})
+	})
+}
+
+// LinkCaseAssessment handles POST /api/v1/fraud/cases/{id}/assessments.
+func (p *FraudProxy) LinkCaseAssessment(w http.ResponseWriter, r *http.Request) {
+	p.caseAction(w, r, func(ctx context.Context, req fraudCaseActionReq) (*fraudv1.CaseResponse, error) {
+		return p.cases.LinkCaseAssessment(ctx, &fraudv1.LinkCaseAssessmentRequest{CaseId: req.CaseID, AssessmentId: req.AssessmentID})
+	})
+}
+
+// caseAction reads a case workflow request and forwards it with call.
+func (p *FraudProxy) caseAction(w http.ResponseWriter, r *http.Request, call func(context.Context, fraudCaseActionReq) (*fraudv1.CaseResponse, error)) {
+	caseID := r.PathValue("id")
+	if caseID == "" {
+		writeError(w, http.StatusBadRequest, "case id is required")
+		return
+	}
+
+	var req fraudCaseActionReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.CaseID = caseID
+
+	resp, err := call(r.Context(), req)
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, fraudCaseResp{Case: toFraudCaseMsg(resp.GetCase())})
+}
+
+type recordChargebackReq struct {
+	TransactionID string `json:"transaction_id"`
+	ChargebackID  string `json:"chargeback_id"`
+	ReasonCode    string `json:"reason_code,omitempty"`
+}
+
+type fraudLabelMsg struct {
+	AssessmentID string `json:"assessment_id"`
+	Label        string `json:"label"`
+	Source       string `json:"source"`
+	SourceRef    string `json:"source_ref"`
+	LabeledBy    string `json:"labeled_by"`
+	LabeledAt    string `json:"labeled_at"`
+}
+
+type recordChargebackResp struct {
+	Label fraudLabelMsg `json:"label"`
+}
+
+type exportTrainingDatasetReq struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type exportTrainingDatasetResp struct {
+	URI            string `json:"uri"`
+	Rows           int    `json:"rows"`
+	FraudRows      int    `json:"fraud_rows"`
+	LegitimateRows int    `json:"legitimate_rows"`
+}
+
+// RecordChargeback handles POST /api/v1/fraud/labels/chargebacks.
+func (p *FraudProxy) RecordChargeback(w http.ResponseWriter, r *http.Request) {
+	var req recordChargebackReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.labels.RecordChargeback(r.Context(), &fraudv1.RecordChargebackRequest{
+		TransactionId: req.TransactionID,
+		ChargebackId:  req.ChargebackID,
+		ReasonCode:    req.ReasonCode,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+
+	l := resp.GetLabel()
+	writeJSON(w, http.StatusCreated, recordChargebackResp{Label: fraudLabelMsg{
+		AssessmentID: l.GetAssessmentId(),
+		Label:        enumName(l.GetLabel().String(), "FRAUD_LABEL_"),
+		Source:       enumName(l.GetSource().String(), "LABEL_SOURCE_"),
+		SourceRef:    l.GetSourceRef(),
+		LabeledBy:    l.GetLabeledBy(),
+		LabeledAt:    formatTimestamp(l.GetLabeledAt()),
+	}})
+}
+
+// ExportTrainingDataset handles POST /api/v1/fraud/datasets/export.
+func (p *FraudProxy) ExportTrainingDataset(w http.ResponseWriter, r *http.Request) {
+	var req exportTrainingDatasetReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	from, ok := readFraudTime(w, "from", req.From)
+	if !ok {
+		return
+	}
+	to, ok := readFraudTime(w, "to", req.To)
+	if !ok {
+		return
+	}
+
+	resp, err := p.labels.ExportTrainingDataset(r.Context(), &fraudv1.ExportTrainingDatasetRequest{From: from, To: to})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, exportTrainingDatasetResp{
+		URI:            resp.GetUri(),
+		Rows:           int(resp.GetRows()),
+		FraudRows:      int(resp.GetFraudRows()),
+		LegitimateRows: int(resp.GetLegitimateRows()),
+	})
+}
+
+type fraudScreeningSubjectMsg struct {
+	Role  string `json:"role"`
+	Value string `json:"value"`
+}
+
+type fraudScreeningMatchMsg struct {
+	Subject     fraudScreeningSubjectMsg `json:"subject"`
+	List        string                   `json:"list"`
+	EntryID     string                   `json:"entry_id"`
+	MatchedName string                   `json:"matched_name"`
+	Score       float64                  `json:"score"`
+}
+
+type fraudScreeningRecordMsg struct {
+	ID            string                     `json:"id"`
+	AssessmentID  string                     `json:"assessment_id"`
+	TransactionID string                     `json:"transaction_id"`
+	ListVersion   string                     `json:"list_version"`
+	ScreenedAt    string                     `json:"screened_at"`
+	Subjects      []fraudScreeningSubjectMsg `json:"subjects"`
+	Matches       []fraudScreeningMatchMsg   `json:"matches"`
+	Threshold     float64                    `json:"threshold"`
+	Hit           bool                       `json:"hit"`
+}
+
+type getScreeningLogResp struct {
+	Records []fraudScreeningRecordMsg `json:"records"`
+}
+
+// GetScreeningLog handles GET /api/v1/fraud/transactions/{id}/screenings.
+func (p *FraudProxy) GetScreeningLog(w http.ResponseWriter, r *http.Request) {
+	txnID := r.PathValue("id")
+	if txnID == "" {
+		writeError(w, http.StatusBadRequest, "transaction id is required")
+		return
+	}
+
+	resp, err := p.screenings.GetScreeningLog(r.Context(), &fraudv1.GetScreeningLogRequest{TransactionId: txnID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+
+	out := getScreeningLogResp{Records: make([]fraudScreeningRecordMsg, 0, len(resp.GetRecords()))}
+	for _, rec := range resp.GetRecords() {
+		msg := fraudScreeningRecordMsg{
+			ID:            rec.GetId(),
+			AssessmentID:  rec.GetAssessmentId(),
+			TransactionID: rec.GetTransactionId(),
+			ListVersion:   rec.GetListVersion(),
+			ScreenedAt:    formatTimestamp(rec.GetScreenedAt()),
+			Subjects:      make([]fraudScreeningSubjectMsg, 0, len(rec.GetSubjects())),
+			Matches:       make([]fraudScreeningMatchMsg, 0, len(rec.GetMatches())),
+			Threshold:     rec.GetThreshold(),
+			Hit:           rec.GetHit(),
+		}
+		for _, subj := range rec.GetSubjects() {
+			msg.Subjects = append(msg.Subjects, fraudScreeningSubjectMsg{Role: subj.GetRole(), Value: subj.GetValue()})
+		}
+		for _, m := range rec.GetMatches() {
+			msg.Matches = append(msg.Matches, fraudScreeningMatchMsg{
+				Subject:     fraudScreeningSubjectMsg{Role: m.GetSubject().GetRole(), Value: m.GetSubject().GetValue()},
+				List:        m.GetList(),
+				EntryID:     m.GetEntryId(),
+				MatchedName: m.GetMatchedName(),
+				Score:       m.GetScore(),
+			})
+		}
+		out.Records = append(out.Records, msg)
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+type fraudPolicyThresholdsMsg struct {
+	MediumAt   int `json:"medium_at"`
+	HighAt     int `json:"high_at"`
+	CriticalAt int `json:"critical_at"`
+	ReviewAt   int `json:"review_at"`
+	DeclineAt  int `json:"decline_at"`
+}
+
+type fraudDecisionPolicyMsg struct {
+	Thresholds    fraudPolicyThresholdsMsg `json:"thresholds"`
+	EffectiveFrom string                   `json:"effective_from,omitempty"`
+	Reason        string                   `json:"reason"`
+	CreatedBy     string                   `json:"created_by,omitempty"`
+	CreatedAt     string                   `json:"created_at,omitempty"`
+	Version       int                      `json:"version"`
+}
+
+type setDecisionPolicyReq struct {
+	Thresholds    fraudPolicyThresholdsMsg `json:"thresholds"`
+	EffectiveFrom string                   `json:"effective_from,omitempty"`
+	Reason        string                   `json:"reason"`
+}
+
+type decisionPolicyResp struct {
+	Policy fraudDecisionPolicyMsg `json:"policy"`
+}
+
+type getDecisionPolicyResp struct {
+	Effective fraudDecisionPolicyMsg   `json:"effective"`
+	Scheduled []fraudDecisionPolicyMsg `json:"scheduled"`
+}
+
+type listDecisionPolicyVersionsResp struct {
+	Versions []fraudDecisionPolicyMsg `json:"versions"`
+}
+
+type simulateDecisionPolicyReq struct {
+	Thresholds fraudPolicyThresholdsMsg `json:"thresholds"`
+	Days       int                      `json:"days,omitempty"`
+}
+
+type fraudDecisionTransitionMsg struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Count int    `json:"count"`
+}
+
+type simulateDecisionPolicyResp struct {
+	From        string                       `json:"from"`
+	To          string                       `json:"to"`
+	Actual      map[string]int               `json:"actual"`
+	Proposed    map[string]int               `json:"proposed"`
+	Transitions []fraudDecisionTransitionMsg `json:"transitions"`
+	Total       int                          `json:"total"`
+	Changed     int                          `json:"changed"`
+}
+
+// GetDecisionPolicy handles GET /api/v1/fraud/policy.
+func (p *FraudProxy) GetDecisionPolicy(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.policies.GetDecisionPolicy(r.Context(), &fraudv1.GetDecisionPolicyRequest{})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+
+	out := getDecisionPolicyResp{
+		Effective: toFraudDecisionPolicyMsg(resp.GetEffective()),
+		Scheduled: make([]fraudDecisionPolicyMsg, 0, len(resp.GetScheduled())),
+	}
+	for _, policy := range resp.GetScheduled() {
+		out.Scheduled = append(out.Scheduled, toFraudDecisionPolicyMsg(policy))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// SetDecisionPolicy handles PUT /api/v1/fraud/policy.
+func (p *FraudProxy) SetDecisionPolicy(w http.ResponseWriter, r *http.Request) {
+	var req setDecisionPolicyReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	effectiveFrom, ok := readFraudTime(w, "effective_from", req.EffectiveFrom)
+	if !ok {
+		return
+	}
+
+	resp, err := p.policies.SetDecisionPolicy(r.Context(), &fraudv1.SetDecisionPolicyRequest{
+		Thresholds:    toFraudThresholds(req.Thresholds),
+		EffectiveFrom: effectiveFrom,
+		Reason:        req.Reason,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, decisionPolicyResp{Policy: toFraudDecisionPolicyMsg(resp.GetPolicy())})
+}
+
+// ListDecisionPolicyVersions handles GET /api/v1/fraud/policy/versions.
+func (p *FraudProxy) ListDecisionPolicyVersions(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.policies.ListDecisionPolicyVersions(r.Context(), &fraudv1.ListDecisionPolicyVersionsRequest{})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+
+	out := listDecisionPolicyVersionsResp{Versions: make([]fraudDecisionPolicyMsg, 0, len(resp.GetVersions()))}
+	for _, policy := range resp.GetVersions() {
+		out.Versions = append(out.Versions, toFraudDecisionPolicyMsg(policy))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// SimulateDecisionPolicy handles POST /api/v1/fraud/policy/simulate.
+func (p *FraudProxy) SimulateDecisionPolicy(w http.ResponseWriter, r *http.Request) {
+	var req simulateDecisionPolicyReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.policies.SimulateDecisionPolicy(r.Context(), &fraudv1.SimulateDecisionPolicyRequest{
+		Thresholds: toFraudThresholds(req.Thresholds),
+		Days:       int32(req.Days), //nolint:gosec // validated by the fraud service
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+
+	out := simulateDecisionPolicyResp{
+		From:        formatTimestamp(resp.GetFrom()),
+		To:          formatTimestamp(resp.GetTo()),
+		Actual:      fraudCounts(resp.GetActual()),
+		Proposed:    fraudCounts(resp.GetProposed()),
+		Transitions: make([]fraudDecisionTransitionMsg, 0, len(resp.GetTransitions())),
+		Total:       int(resp.GetTotal()),
+		Changed:     int(resp.GetChanged()),
+	}
+	for _, t := range resp.GetTransitions() {
+		out.Transitions = append(out.Transitions, fraudDecisionTransitionMsg{
+			From:  enumName(t.GetFrom().String(), "ASSESSMENT_DECISION_"),
+			To:    enumName(t.GetTo().String(), "ASSESSMENT_DECISION_"),
+			Count: int(t.GetCount()),
+		})
 	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+type fraudLinkEntityMsg struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type fraudLinkedAccountMsg struct {
+	AccountID      string               `json:"account_id"`
+	Shared         []fraudLinkEntityMsg `json:"shared"`
+	ConfirmedFraud bool                 `json:"confirmed_fraud"`
+}
 
-	req := map[string]string{
-		"tenant_id":     tenantID,
-		"assessment_id": assessmentID,
+type getAccountLinksResp struct {
+	AccountID           string                  `json:"account_id"`
+	Linked              []fraudLinkedAccountMsg `json:"linked"`
+	ConfirmedFraudCount int                     `json:"confirmed_fraud_count"`
+}
+
+// GetAccountLinks handles GET /api/v1/fraud/accounts/{id}/links.
+// Optional query parameters: limit and fraud_only=true.
+func (p *FraudProxy) GetAccountLinks(w http.ResponseWriter, r *http.Request) {
+	accountID := r.PathValue("id")
+	if accountID == "" {
+		writeError(w, http.StatusBadRequest, "account id is required")
+		return
+	}
+
+	req := &fraudv1.GetAccountLinksRequest{AccountId: accountID}
+	q := r.URL.Query()
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		req.Limit = int32(n)
 	}
-	var resp getAssessmentResp
-	err := p.conn.Invoke(r.Context(), "/bib.fraud.v1.FraudService/GetAssessment", &req, &resp)
+	if v := q.Get("fraud_only"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid fraud_only")
+			return
+		}
+		req.FraudOnly = b
+	}
+
+	resp, err := p.links.GetAccountLinks(r.Context(), req)
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+
+	out := getAccountLinksResp{
+		AccountID:           resp.GetAccountId(),
+		Linked:              make([]fraudLinkedAccountMsg, 0, len(resp.GetLinked())),
+		ConfirmedFraudCount: int(resp.GetConfirmedFraudCount()),
+	}
+	for _, a := range resp.GetLinked() {
+		msg := fraudLinkedAccountMsg{
+			AccountID:      a.GetAccountId(),
+			Shared:         make([]fraudLinkEntityMsg, 0, len(a.GetShared())),
+			ConfirmedFraud: a.GetConfirmedFraud(),
+		}
+		for _, e := range a.GetShared() {
+			msg.Shared = append(msg.Shared, fraudLinkEntityMsg{Type: e.GetType(), Value: e.GetValue()})
+		}
+		out.Linked = append(out.Linked, msg)
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// readFraudEnum looks up an optional enum query or body value by its name
+// without the type prefix, writing a 400 when it is unknown. An empty value
+// is unspecified.
+func readFraudEnum(w http.ResponseWriter, field, name, prefix string, values map[string]int32) (int32, bool) {
+	if name == "" {
+		return 0, true
+	}
+	v, ok := values[prefix+strings.ToUpper(name)]
+	if !ok || v == 0 {
+		writeError(w, http.StatusBadRequest, "invalid "+field)
+		return 0, false
+	}
+	return v, true
+}
+
+// readFraudTime parses an optional RFC 3339 field, writing a 400 when it
+// is malformed.
+func readFraudTime(w http.ResponseWriter, field, value string) (*timestamppb.Timestamp, bool) {
+	if value == "" {
+		return nil, true
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, field+" must be an RFC 3339 timestamp")
+		return nil, false
+	}
+	return timestamppb.New(t), true
+}
+
+func fraudCounts(m map[string]int32) map[string]int {
+	out := make(map[string]int, len(m))
+	for k, v := range m {
+		out[k] = int(v)
+	}
+	return out
+}
+
+func toFraudAssessmentMsg(a *fraudv1.TransactionAssessment) fraudAssessmentMsg {
+	return fraudAssessmentMsg{
+		AssessmentID:    a.GetId(),
+		TransactionID:   a.GetTransactionId(),
+		AccountID:       a.GetAccountId(),
+		Amount:          a.GetAmount().GetAmount(),
+		Currency:        a.GetAmount().GetCurrency(),
+		TransactionType: a.GetTransactionType(),
+		RiskLevel:       enumName(a.GetRiskLevel().String(), "RISK_LEVEL_"),
+		Decision:        enumName(a.GetDecision().String(), "ASSESSMENT_DECISION_"),
+		ModelVersion:    a.GetModelVersion(),
+		AssessedAt:      formatTimestamp(a.GetAssessedAt()),
+		Signals:         a.GetRiskSignals(),
+		RiskScore:       int(a.GetRiskScore()),
+		PolicyVersion:   int(a.GetPolicyVersion()),
+	}
+}
+
+func toFraudRuleMsg(r *fraudv1.FraudRule) fraudRuleMsg {
+	return fraudRuleMsg{
+		ID:          r.GetId(),
+		Name:        r.GetName(),
+		Description: r.GetDescription(),
+		Condition:   r.GetCondition(),
+		Signal:      r.GetSignal(),
+		Mode:        enumName(r.GetMode().String(), "RULE_MODE_"),
+		UpdatedBy:   r.GetUpdatedBy(),
+		CreatedAt:   formatTimestamp(r.GetCreatedAt()),
+		UpdatedAt:   formatTimestamp(r.GetUpdatedAt()),
+		ScoreImpact: int(r.GetScoreImpact()),
+		Version:     int(r.GetVersion()),
+	}
+}
+
+func toFraudCaseMsg(c *fraudv1.FraudCase) fraudCaseMsg {
+	msg := fraudCaseMsg{
+		ID:            c.GetId(),
+		AssessmentID:  c.GetAssessmentId(),
+		TransactionID: c.GetTransactionId(),
+		AccountID:     c.GetAccountId(),
+		RiskLevel:     enumName(c.GetRiskLevel().String(), "RISK_LEVEL_"),
+		Status:        enumName(c.GetStatus().String(), "CASE_STATUS_"),
+		Disposition:   enumName(c.GetDisposition().String(), "CASE_DISPOSITION_"),
+		AssigneeID:    c.GetAssigneeId(),
+		SLADueAt:      formatTimestamp(c.GetSlaDueAt()),
+		ResolvedAt:    formatTimestamp(c.GetResolvedAt()),
+		CreatedAt:     formatTimestamp(c.GetCreatedAt()),
+		UpdatedAt:     formatTimestamp(c.GetUpdatedAt()),
+		Notes:         make([]fraudCaseNoteMsg, 0, len(c.GetNotes())),
+		LinkedIDs:     c.GetLinkedAssessmentIds(),
+		RiskScore:     int(c.GetRiskScore()),
+		Version:       int(c.GetVersion()),
+		SLABreached:   c.GetSlaBreached(),
+	}
+	for _, n := range c.GetNotes() {
+		msg.Notes = append(msg.Notes, fraudCaseNoteMsg{
+			ID:        n.GetId(),
+			AuthorID:  n.GetAuthorId(),
+			Body:      n.GetBody(),
+			CreatedAt: formatTimestamp(n.GetCreatedAt()),
+		})
+	}
+	return msg
+}
+
+func toFraudThresholds(t fraudPolicyThresholdsMsg) *fraudv1.DecisionThresholds {
+	//nolint:gosec // thresholds are validated by the fraud service
+	return &fraudv1.DecisionThresholds{
+		MediumAt:   int32(t.MediumAt),
+		HighAt:     int32(t.HighAt),
+		CriticalAt: int32(t.CriticalAt),
+		ReviewAt:   int32(t.ReviewAt),
+		DeclineAt:  int32(t.DeclineAt),
+	}
+}
+
+func toFraudDecisionPolicyMsg(p *fraudv1.DecisionPolicy) fraudDecisionPolicyMsg {
+	t := p.GetThresholds()
+	return fraudDecisionPolicyMsg{
+		Thresholds: fraudPolicyThresholdsMsg{
+			MediumAt:   int(t.GetMediumAt()),
+			HighAt:     int(t.GetHighAt()),
+			CriticalAt: int(t.GetCriticalAt()),
+			ReviewAt:   int(t.GetReviewAt()),
+			DeclineAt:  int(t.GetDeclineAt()),
+		},
+		EffectiveFrom: formatTimestamp(p.GetEffectiveFrom()),
+		Reason:        p.GetReason(),
+		CreatedBy:     p.GetCreatedBy(),
+		CreatedAt:     formatTimestamp(p.GetCreatedAt()),
+		Version:       int(p.GetVersion()),
+	}
 }
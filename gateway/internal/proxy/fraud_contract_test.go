@@ -0,0 +1,66 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const fraudAssessmentJSON = `{
+	"id": "5c7e9a1b-3d5f-4e8a-a0c2-6e8a0c2e4a6b",
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"transaction_id": "c3a9e4f1-6b2d-4e8a-9f07-4d1c2b3a5e68",
+	"account_id": "5b0e2d8a-7c41-4f6e-a3b9-2c8d1e4f6a70",
+	"amount": {"amount": "100", "currency": "USD"},
+	"transaction_type": "TRANSFER",
+	"risk_level": "RISK_LEVEL_LOW",
+	"risk_score": 25,
+	"decision": "ASSESSMENT_DECISION_APPROVE",
+	"assessed_at": "2026-01-15T09:00:00Z",
+	"model_version": "",
+	"policy_version": 0
+}`
+
+func TestFraudContract(t *testing.T) {
+	stub, conn := newContractStub(t, "fraud-service")
+	p := NewFraudProxy(conn, conn.Logger)
+	id := contractAssessID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "assess a transfer",
+		Method:      "/bib.fraud.v1.FraudService/AssessTransaction",
+		Response:    json.RawMessage(`{"assessment": ` + fraudAssessmentJSON + `}`),
+	})
+	rec := call(t, p.AssessTransaction, http.MethodPost, "/api/v1/fraud/assessments", `{"transaction_id": "`+
+		contractPaymentID.String()+`", "account_id": "`+contractAccountID.String()+`", "amount": "100",
+		"currency": "USD", "transaction_type": "TRANSFER"}`)
+	if rec.Code != http.StatusCreated || decodeBody(t, rec)["decision"] != "APPROVE" {
+		t.Errorf("AssessTransaction = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get an assessment",
+		State:       "a transfer was assessed",
+		Method:      "/bib.fraud.v1.FraudService/GetAssessment",
+		Response:    json.RawMessage(`{"assessment": ` + fraudAssessmentJSON + `}`),
+	})
+	rec = call(t, p.GetAssessment, http.MethodGet, "/api/v1/fraud/assessments/"+id, "", "id", id)
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["risk_level"] != "LOW" {
+		t.Errorf("GetAssessment = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing assessment",
+		Method:      "/bib.fraud.v1.FraudService/GetAssessment",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetAssessment, http.MethodGet, "/api/v1/fraud/assessments/"+id, "", "id", id)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetAssessment of a missing assessment = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-fraud-service.json"))
+}
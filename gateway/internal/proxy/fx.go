@@ -5,18 +5,19 @@ import (
 	"net/http"
 	"strings"
 
+	fxv1 "github.com/bibbank/bib/api/gen/go/bib/fx/v1"
 	"github.com/bibbank/bib/pkg/auth"
 )
 
 // FXProxy proxies HTTP requests to the FX gRPC service.
 type FXProxy struct {
-	conn   *ServiceConn
+	client fxv1.FXServiceClient
 	logger *slog.Logger
 }
 
 // NewFXProxy creates a new FX service proxy.
 func NewFXProxy(conn *ServiceConn, logger *slog.Logger) *FXProxy {
-	return &FXProxy{conn: conn, logger: logger}
+	return &FXProxy{client: fxv1.NewFXServiceClient(conn), logger: logger}
 }
 
 type exchangeRateResp struct {
@@ -63,26 +64,20 @@ func (p *FXProxy) GetRate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tenantID := r.URL.Query().Get("tenant_id")
-	if tenantID == "" {
-		if claims, ok := auth.ClaimsFromContext(r.Context()); ok {
-			tenantID = claims.TenantID.String()
-		}
-	}
-
-	req := map[string]string{
-		"tenant_id":      tenantID,
-		"base_currency":  strings.ToUpper(baseCurrency),
-		"quote_currency": strings.ToUpper(quoteCurrency),
-	}
-
-	var resp exchangeRateResp
-	err := p.conn.Invoke(r.Context(), "/bib.fx.v1.FXService/GetExchangeRate", &req, &resp)
+	resp, err := p.client.GetExchangeRate(r.Context(), &fxv1.GetExchangeRateRequest{
+		BaseCurrency:  strings.ToUpper(baseCurrency),
+		QuoteCurrency: strings.ToUpper(quoteCurrency),
+	})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, exchangeRateResp{
+		BaseCurrency:  resp.GetBaseCurrency(),
+		QuoteCurrency: resp.GetQuoteCurrency(),
+		Rate:          resp.GetRate(),
+		Timestamp:     resp.GetEffectiveAt().AsTime().Format("2006-01-02T15:04:05Z"),
+	})
 }
 
 // Convert handles POST /api/v1/fx/convert.
@@ -99,11 +94,21 @@ func (p *FXProxy) Convert(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	var resp convertResp
-	err := p.conn.Invoke(r.Context(), "/bib.fx.v1.FXService/ConvertAmount", &req, &resp)
+	resp, err := p.client.ConvertAmount(r.Context(), &fxv1.ConvertAmountRequest{
+		TenantId:     req.TenantID,
+		FromCurrency: req.FromCurrency,
+		ToCurrency:   req.ToCurrency,
+		Amount:       req.Amount,
+	})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, convertResp{
+		OriginalAmount:  resp.GetOriginalAmount(),
+		ConvertedAmount: resp.GetConvertedAmount(),
+		FromCurrency:    resp.GetFromCurrency(),
+		ToCurrency:      resp.GetToCurrency(),
+		Rate:            resp.GetRate(),
+	})
 }
@@ -0,0 +1,42 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+func TestFXContract(t *testing.T) {
+	stub, conn := newContractStub(t, "fx-service")
+	p := NewFXProxy(conn, conn.Logger)
+
+	stub.Given(contract.Interaction{
+		Description: "get a spot rate",
+		State:       "a USD/EUR rate is quoted",
+		Method:      "/bib.fx.v1.FXService/GetExchangeRate",
+		Response: json.RawMessage(`{"base_currency": "USD", "quote_currency": "EUR", "rate": "0.92",
+			"effective_at": "2026-01-15T09:00:00Z"}`),
+	})
+	rec := call(t, p.GetRate, http.MethodGet, "/api/v1/fx/rates/usd-eur", "", "pair", "usd-eur")
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["rate"] != "0.92" {
+		t.Errorf("GetRate = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "convert an amount at the quoted rate",
+		State:       "a USD/EUR rate is quoted",
+		Method:      "/bib.fx.v1.FXService/ConvertAmount",
+		Response: json.RawMessage(`{"original_amount": "100.00", "converted_amount": "92.00",
+			"from_currency": "USD", "to_currency": "EUR", "rate": "0.92"}`),
+	})
+	rec = call(t, p.Convert, http.MethodPost, "/api/v1/fx/convert", `{"from_currency": "USD", "to_currency": "EUR",
+		"amount": "100"}`)
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["converted_amount"] != "92.00" {
+		t.Errorf("Convert = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-fx-service.json"))
+}
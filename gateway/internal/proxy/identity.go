@@ -1,21 +1,41 @@
 package proxy
 
 import (
+	"errors"
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	identityv1 "github.com/bibbank/bib/api/gen/go/bib/identity/v1"
 	"github.com/bibbank/bib/pkg/auth"
 )
 
+// maxDocumentBytes mirrors the identity service's upload limit.
+const maxDocumentBytes = 10 << 20
+
+// documentCallOptions raise gRPC message limits above the 4 MiB default for
+// document payloads.
+var documentCallOptions = []grpc.CallOption{
+	grpc.MaxCallSendMsgSize(16 << 20),
+	grpc.MaxCallRecvMsgSize(16 << 20),
+}
+
 // IdentityProxy proxies HTTP requests to the identity gRPC service.
 type IdentityProxy struct {
-	conn   *ServiceConn
+	client identityv1.IdentityServiceClient
 	logger *slog.Logger
 }
 
 // NewIdentityProxy creates a new identity service proxy.
 func NewIdentityProxy(conn *ServiceConn, logger *slog.Logger) *IdentityProxy {
-	return &IdentityProxy{conn: conn, logger: logger}
+	return &IdentityProxy{client: identityv1.NewIdentityServiceClient(conn), logger: logger}
 }
 
 type initiateVerificationReq struct {
@@ -25,21 +45,33 @@ type initiateVerificationReq struct {
 	Email       string `json:"email"`
 	DateOfBirth string `json:"date_of_birth"`
 	Country     string `json:"country"`
+	// RiskTier is LOW, MEDIUM or HIGH; it defaults to MEDIUM.
+	RiskTier string `json:"risk_tier,omitempty"`
+	// ScreeningChecks optionally adds PEP, SANCTIONS or ADVERSE_MEDIA checks.
+	ScreeningChecks []string `json:"screening_checks,omitempty"`
+	// DocumentNumber is used only, hashed, to detect duplicate identities.
+	DocumentNumber string `json:"document_number,omitempty"`
 }
 
 type verificationMsg struct {
-	ID                 string     `json:"id"`
-	TenantID           string     `json:"tenant_id"`
-	ApplicantFirstName string     `json:"applicant_first_name"`
-	ApplicantLastName  string     `json:"applicant_last_name"`
-	ApplicantEmail     string     `json:"applicant_email"`
-	ApplicantDOB       string     `json:"applicant_dob"`
-	ApplicantCountry   string     `json:"applicant_country"`
-	Status             string     `json:"status"`
-	CreatedAt          string     `json:"created_at"`
-	UpdatedAt          string     `json:"updated_at"`
-	Checks             []checkMsg `json:"checks"`
-	Version            int32      `json:"version"`
+	ID                     string     `json:"id"`
+	TenantID               string     `json:"tenant_id"`
+	ApplicantFirstName     string     `json:"applicant_first_name"`
+	ApplicantLastName      string     `json:"applicant_last_name"`
+	ApplicantEmail         string     `json:"applicant_email"`
+	ApplicantDOB           string     `json:"applicant_dob"`
+	ApplicantCountry       string     `json:"applicant_country"`
+	Status                 string     `json:"status"`
+	RiskTier               string     `json:"risk_tier"`
+	RiskFactors            []string   `json:"risk_factors"`
+	ExpiresAt              string     `json:"expires_at,omitempty"`
+	ErasedAt               string     `json:"erased_at,omitempty"`
+	PreviousVerificationID string     `json:"previous_verification_id,omitempty"`
+	CreatedAt              string     `json:"created_at"`
+	UpdatedAt              string     `json:"updated_at"`
+	Checks                 []checkMsg `json:"checks"`
+	RiskScore              int32      `json:"risk_score"`
+	Version                int32      `json:"version"`
 }
 
 type checkMsg struct {
@@ -69,14 +101,35 @@ func (p *IdentityProxy) InitiateVerification(w http.ResponseWriter, r *http.Requ
 			req.TenantID = claims.TenantID.String()
 		}
 	}
+	riskTier, ok := readIdentityEnum(w, "risk_tier", req.RiskTier, "RISK_TIER_", identityv1.RiskTier_value)
+	if !ok {
+		return
+	}
+	checks := make([]identityv1.CheckType, 0, len(req.ScreeningChecks))
+	for _, c := range req.ScreeningChecks {
+		v, ok := readIdentityEnum(w, "screening_checks", c, "CHECK_TYPE_", identityv1.CheckType_value)
+		if !ok {
+			return
+		}
+		checks = append(checks, identityv1.CheckType(v))
+	}
 
-	var resp verificationResp
-	err := p.conn.Invoke(r.Context(), "/bib.identity.v1.IdentityService/InitiateVerification", &req, &resp)
+	resp, err := p.client.InitiateVerification(r.Context(), &identityv1.InitiateVerificationRequest{
+		TenantId:        req.TenantID,
+		FirstName:       req.FirstName,
+		LastName:        req.LastName,
+		Email:           req.Email,
+		DateOfBirth:     req.DateOfBirth,
+		Country:         req.Country,
+		ScreeningChecks: checks,
+		RiskTier:        identityv1.RiskTier(riskTier),
+		DocumentNumber:  req.DocumentNumber,
+	})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusCreated, resp)
+	writeJSON(w, http.StatusCreated, verificationResp{Verification: toVerificationMsg(resp.GetVerification())})
 }
 
 // GetVerification handles GET /api/v1/identity/verifications/{id}.
@@ -87,12 +140,810 @@ func (p *IdentityProxy) GetVerification(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	req := map[string]string{"id": verificationID}
-	var resp verificationResp
-	err := p.conn.Invoke(r.Context(), "/bib.identity.v1.IdentityService/GetVerification", &req, &resp)
+	resp, err := p.client.GetVerification(r.Context(), &identityv1.GetVerificationRequest{Id: verificationID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, verificationResp{Verification: toVerificationMsg(resp.GetVerification())})
+}
+
+type screeningMatchMsg struct {
+	Name       string   `json:"name"`
+	Categories []string `json:"categories,omitempty"`
+	Sources    []string `json:"sources,omitempty"`
+	EntryID    string   `json:"entry_id,omitempty"`
+	Score      float64  `json:"score"`
+}
+
+type screeningResultMsg struct {
+	ID                string              `json:"id"`
+	CheckID           string              `json:"check_id"`
+	CheckType         string              `json:"check_type"`
+	Provider          string              `json:"provider"`
+	ProviderReference string              `json:"provider_reference"`
+	Trigger           string              `json:"trigger"`
+	Outcome           string              `json:"outcome"`
+	ScreenedAt        string              `json:"screened_at"`
+	Matches           []screeningMatchMsg `json:"matches"`
+}
+
+// GetScreeningResults handles GET /api/v1/identity/verifications/{id}/screenings.
+func (p *IdentityProxy) GetScreeningResults(w http.ResponseWriter, r *http.Request) {
+	verificationID := r.PathValue("id")
+	if verificationID == "" {
+		writeError(w, http.StatusBadRequest, "verification id is required")
+		return
+	}
+
+	resp, err := p.client.GetScreeningResults(r.Context(), &identityv1.GetScreeningResultsRequest{VerificationId: verificationID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := struct {
+		Results []screeningResultMsg `json:"results"`
+	}{Results: make([]screeningResultMsg, 0, len(resp.GetResults()))}
+	for _, res := range resp.GetResults() {
+		out.Results = append(out.Results, toScreeningResultMsg(res))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+type duplicateMatchMsg struct {
+	VerificationID string   `json:"verification_id"`
+	Reasons        []string `json:"reasons"`
+	Score          float64  `json:"score"`
+}
+
+// GetDuplicateMatches handles GET /api/v1/identity/verifications/{id}/duplicates.
+func (p *IdentityProxy) GetDuplicateMatches(w http.ResponseWriter, r *http.Request) {
+	verificationID := r.PathValue("id")
+	if verificationID == "" {
+		writeError(w, http.StatusBadRequest, "verification id is required")
+		return
+	}
+
+	resp, err := p.client.GetDuplicateMatches(r.Context(), &identityv1.GetDuplicateMatchesRequest{VerificationId: verificationID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, struct {
+		VerificationID string              `json:"verification_id"`
+		ApplicantID    string              `json:"applicant_id,omitempty"`
+		Matches        []duplicateMatchMsg `json:"matches"`
+	}{
+		VerificationID: resp.GetVerificationId(),
+		ApplicantID:    resp.GetApplicantId(),
+		Matches:        toDuplicateMatchMsgs(resp.GetMatches()),
+	})
+}
+
+// RescreenVerification handles POST /api/v1/identity/verifications/{id}/rescreen.
+func (p *IdentityProxy) RescreenVerification(w http.ResponseWriter, r *http.Request) {
+	verificationID := r.PathValue("id")
+	if verificationID == "" {
+		writeError(w, http.StatusBadRequest, "verification id is required")
+		return
+	}
+
+	resp, err := p.client.RescreenVerification(r.Context(), &identityv1.RescreenVerificationRequest{VerificationId: verificationID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, verificationResp{Verification: toVerificationMsg(resp.GetVerification())})
+}
+
+// ReverifyVerification handles POST /api/v1/identity/verifications/{id}/reverify.
+func (p *IdentityProxy) ReverifyVerification(w http.ResponseWriter, r *http.Request) {
+	verificationID := r.PathValue("id")
+	if verificationID == "" {
+		writeError(w, http.StatusBadRequest, "verification id is required")
+		return
+	}
+
+	resp, err := p.client.ReverifyVerification(r.Context(), &identityv1.ReverifyVerificationRequest{VerificationId: verificationID})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusCreated, verificationResp{Verification: toVerificationMsg(resp.GetVerification())})
+}
+
+type documentMsg struct {
+	ID             string `json:"id"`
+	VerificationID string `json:"verification_id"`
+	CheckID        string `json:"check_id"`
+	DocumentType   string `json:"document_type"`
+	ContentType    string `json:"content_type"`
+	SHA256         string `json:"sha256"`
+	UploadedAt     string `json:"uploaded_at"`
+	RetainUntil    string `json:"retain_until"`
+	PurgedAt       string `json:"purged_at,omitempty"`
+	SizeBytes      int64  `json:"size_bytes"`
+}
+
+type documentResp struct {
+	Document documentMsg `json:"document"`
+}
+
+// UploadDocument handles POST /api/v1/identity/verifications/{id}/documents.
+// It accepts multipart/form-data with a "file" part and "document_type" and
+// optional "check_id" fields.
+func (p *IdentityProxy) UploadDocument(w http.ResponseWriter, r *http.Request) {
+	verificationID := r.PathValue("id")
+	if verificationID == "" {
+		writeError(w, http.StatusBadRequest, "verification id is required")
+		return
+	}
+
+	// Allow headroom for multipart framing around the file.
+	r.Body = http.MaxBytesReader(w, r.Body, maxDocumentBytes+1<<20)
+	if err := r.ParseMultipartForm(maxDocumentBytes); err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			writeError(w, http.StatusRequestEntityTooLarge, "document is too large")
+			return
+		}
+		writeError(w, http.StatusBadRequest, "invalid multipart form")
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }() //nolint:errcheck // best-effort temp file cleanup
+
+	content, _, err := readFormFile(r, "file", maxDocumentBytes)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	documentType, ok := readIdentityEnum(w, "document_type", r.FormValue("document_type"), "DOCUMENT_TYPE_", identityv1.DocumentType_value)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.UploadDocument(r.Context(), &identityv1.UploadDocumentRequest{
+		VerificationId: verificationID,
+		CheckId:        r.FormValue("check_id"),
+		DocumentType:   identityv1.DocumentType(documentType),
+		Content:        content,
+	}, documentCallOptions...)
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusCreated, documentResp{Document: toDocumentMsg(resp.GetDocument())})
+}
+
+// ListDocuments handles GET /api/v1/identity/verifications/{id}/documents.
+func (p *IdentityProxy) ListDocuments(w http.ResponseWriter, r *http.Request) {
+	verificationID := r.PathValue("id")
+	if verificationID == "" {
+		writeError(w, http.StatusBadRequest, "verification id is required")
+		return
+	}
+
+	resp, err := p.client.ListDocuments(r.Context(), &identityv1.ListDocumentsRequest{VerificationId: verificationID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := struct {
+		Documents []documentMsg `json:"documents"`
+	}{Documents: toDocumentMsgs(resp.GetDocuments())}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetDocument handles GET /api/v1/identity/documents/{id}.
+func (p *IdentityProxy) GetDocument(w http.ResponseWriter, r *http.Request) {
+	documentID := r.PathValue("id")
+	if documentID == "" {
+		writeError(w, http.StatusBadRequest, "document id is required")
+		return
+	}
+
+	resp, err := p.client.GetDocument(r.Context(), &identityv1.GetDocumentRequest{Id: documentID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, documentResp{Document: toDocumentMsg(resp.GetDocument())})
+}
+
+// GetDocumentContent handles GET /api/v1/identity/documents/{id}/content and
+// streams the decrypted document back as a download.
+func (p *IdentityProxy) GetDocumentContent(w http.ResponseWriter, r *http.Request) {
+	documentID := r.PathValue("id")
+	if documentID == "" {
+		writeError(w, http.StatusBadRequest, "document id is required")
+		return
+	}
+
+	resp, err := p.client.GetDocument(r.Context(), &identityv1.GetDocumentRequest{Id: documentID, IncludeContent: true}, documentCallOptions...)
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+
+	w.Header().Set("Content-Type", resp.GetDocument().GetContentType())
+	w.Header().Set("Content-Length", strconv.Itoa(len(resp.GetContent())))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.GetDocument().GetId()))
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(resp.GetContent()) //nolint:errcheck // client disconnects are not actionable
+}
+
+// readFormFile reads a single uploaded file part from a parsed multipart form.
+// readFormFile reads the file uploaded as field of a parsed multipart form,
+// returning its content and file name. Files over limit bytes are refused.
+func readFormFile(r *http.Request, field string, limit int) ([]byte, string, error) {
+	file, header, err := r.FormFile(field)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s is required", field)
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, int64(limit)+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("read %s: %w", field, err)
+	}
+	if len(content) > limit {
+		return nil, "", fmt.Errorf("%s exceeds %d bytes", field, limit)
+	}
+	return content, header.Filename, nil
+}
+
+type beneficialOwnerMsg struct {
+	FirstName        string  `json:"first_name"`
+	LastName         string  `json:"last_name"`
+	DateOfBirth      string  `json:"date_of_birth"`
+	Country          string  `json:"country"`
+	OwnershipPercent float64 `json:"ownership_percent"`
+}
+
+type initiateBusinessVerificationReq struct {
+	LegalName          string               `json:"legal_name"`
+	RegistrationNumber string               `json:"registration_number"`
+	Country            string               `json:"country"`
+	BeneficialOwners   []beneficialOwnerMsg `json:"beneficial_owners"`
+}
+
+type businessVerificationMsg struct {
+	ID                 string               `json:"id"`
+	TenantID           string               `json:"tenant_id"`
+	LegalName          string               `json:"legal_name"`
+	RegistrationNumber string               `json:"registration_number"`
+	Country            string               `json:"country"`
+	Status             string               `json:"status"`
+	CreatedAt          string               `json:"created_at"`
+	UpdatedAt          string               `json:"updated_at"`
+	BeneficialOwners   []beneficialOwnerMsg `json:"beneficial_owners"`
+	Checks             []checkMsg           `json:"checks"`
+	Version            int32                `json:"version"`
+}
+
+type businessVerificationResp struct {
+	Verification businessVerificationMsg `json:"verification"`
+}
+
+// InitiateBusinessVerification handles POST /api/v1/identity/businesses.
+func (p *IdentityProxy) InitiateBusinessVerification(w http.ResponseWriter, r *http.Request) {
+	var req initiateBusinessVerificationReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	owners := make([]*identityv1.BeneficialOwner, 0, len(req.BeneficialOwners))
+	for _, o := range req.BeneficialOwners {
+		owners = append(owners, &identityv1.BeneficialOwner{
+			FirstName:        o.FirstName,
+			LastName:         o.LastName,
+			DateOfBirth:      o.DateOfBirth,
+			Country:          o.Country,
+			OwnershipPercent: o.OwnershipPercent,
+		})
+	}
+
+	resp, err := p.client.InitiateBusinessVerification(r.Context(), &identityv1.InitiateBusinessVerificationRequest{
+		LegalName:          req.LegalName,
+		RegistrationNumber: req.RegistrationNumber,
+		Country:            req.Country,
+		BeneficialOwners:   owners,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusCreated, businessVerificationResp{Verification: toBusinessVerificationMsg(resp.GetVerification())})
+}
+
+// GetBusinessVerification handles GET /api/v1/identity/businesses/{id}.
+func (p *IdentityProxy) GetBusinessVerification(w http.ResponseWriter, r *http.Request) {
+	verificationID := r.PathValue("id")
+	if verificationID == "" {
+		writeError(w, http.StatusBadRequest, "business verification id is required")
+		return
+	}
+
+	resp, err := p.client.GetBusinessVerification(r.Context(), &identityv1.GetBusinessVerificationRequest{Id: verificationID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, businessVerificationResp{Verification: toBusinessVerificationMsg(resp.GetVerification())})
+}
+
+type reviewDecisionMsg struct {
+	AnalystID string `json:"analyst_id"`
+	Outcome   string `json:"outcome"`
+	Reason    string `json:"reason"`
+	DecidedAt string `json:"decided_at"`
+}
+
+type reviewCaseMsg struct {
+	ID               string              `json:"id"`
+	VerificationID   string              `json:"verification_id"`
+	Status           string              `json:"status"`
+	Reason           string              `json:"reason"`
+	Outcome          string              `json:"outcome,omitempty"`
+	AssigneeID       string              `json:"assignee_id,omitempty"`
+	OpenedAt         string              `json:"opened_at"`
+	DueAt            string              `json:"due_at"`
+	ClaimedAt        string              `json:"claimed_at,omitempty"`
+	DecidedAt        string              `json:"decided_at,omitempty"`
+	Decisions        []reviewDecisionMsg `json:"decisions"`
+	RequiresFourEyes bool                `json:"requires_four_eyes"`
+	Overdue          bool                `json:"overdue"`
+}
+
+type reviewCaseResp struct {
+	Case reviewCaseMsg `json:"case"`
+}
+
+type listReviewQueueResp struct {
+	Cases      []reviewCaseMsg `json:"cases"`
+	TotalCount int32           `json:"total_count"`
+}
+
+type assignReviewCaseReq struct {
+	AssigneeID string `json:"assignee_id"`
+}
+
+type decideReviewCaseReq struct {
+	Outcome string `json:"outcome"`
+	Reason  string `json:"reason"`
+}
+
+type reviewMetricsResp struct {
+	Since                    string  `json:"since"`
+	Open                     int32   `json:"open"`
+	AwaitingSecondApproval   int32   `json:"awaiting_second_approval"`
+	Overdue                  int32   `json:"overdue"`
+	Decided                  int32   `json:"decided"`
+	DecidedWithinSLA         int32   `json:"decided_within_sla"`
+	WithinSLARate            float64 `json:"within_sla_rate"`
+	AvgTimeToDecisionSeconds int64   `json:"avg_time_to_decision_seconds"`
+	P95TimeToDecisionSeconds int64   `json:"p95_time_to_decision_seconds"`
+}
+
+// ListReviewQueue handles GET /api/v1/identity/reviews.
+// Query parameters: status, assignee_id, page_size, offset.
+func (p *IdentityProxy) ListReviewQueue(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+
+	resp, err := p.client.ListReviewQueue(r.Context(), &identityv1.ListReviewQueueRequest{
+		Status:     q.Get("status"),
+		AssigneeId: q.Get("assignee_id"),
+		PageSize:   pageSize,
+		Offset:     offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listReviewQueueResp{
+		Cases:      make([]reviewCaseMsg, 0, len(resp.GetCases())),
+		TotalCount: resp.GetTotalCount(),
+	}
+	for _, c := range resp.GetCases() {
+		out.Cases = append(out.Cases, toReviewCaseMsg(c))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetReviewCase handles GET /api/v1/identity/reviews/{id}.
+func (p *IdentityProxy) GetReviewCase(w http.ResponseWriter, r *http.Request) {
+	caseID := r.PathValue("id")
+	if caseID == "" {
+		writeError(w, http.StatusBadRequest, "review case id is required")
+		return
+	}
+
+	resp, err := p.client.GetReviewCase(r.Context(), &identityv1.GetReviewCaseRequest{Id: caseID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, reviewCaseResp{Case: toReviewCaseMsg(resp.GetCase())})
+}
+
+// ClaimReviewCase handles POST /api/v1/identity/reviews/{id}/claim.
+func (p *IdentityProxy) ClaimReviewCase(w http.ResponseWriter, r *http.Request) {
+	caseID := r.PathValue("id")
+	if caseID == "" {
+		writeError(w, http.StatusBadRequest, "review case id is required")
+		return
+	}
+
+	resp, err := p.client.ClaimReviewCase(r.Context(), &identityv1.ClaimReviewCaseRequest{Id: caseID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, reviewCaseResp{Case: toReviewCaseMsg(resp.GetCase())})
+}
+
+// AssignReviewCase handles POST /api/v1/identity/reviews/{id}/assign.
+func (p *IdentityProxy) AssignReviewCase(w http.ResponseWriter, r *http.Request) {
+	caseID := r.PathValue("id")
+	if caseID == "" {
+		writeError(w, http.StatusBadRequest, "review case id is required")
+		return
+	}
+
+	var body assignReviewCaseReq
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.AssignReviewCase(r.Context(), &identityv1.AssignReviewCaseRequest{
+		Id:         caseID,
+		AssigneeId: body.AssigneeID,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, reviewCaseResp{Case: toReviewCaseMsg(resp.GetCase())})
+}
+
+// DecideReviewCase handles POST /api/v1/identity/reviews/{id}/decision.
+func (p *IdentityProxy) DecideReviewCase(w http.ResponseWriter, r *http.Request) {
+	caseID := r.PathValue("id")
+	if caseID == "" {
+		writeError(w, http.StatusBadRequest, "review case id is required")
+		return
+	}
+
+	var body decideReviewCaseReq
+	if err := readJSON(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.DecideReviewCase(r.Context(), &identityv1.DecideReviewCaseRequest{
+		Id:      caseID,
+		Outcome: body.Outcome,
+		Reason:  body.Reason,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, reviewCaseResp{Case: toReviewCaseMsg(resp.GetCase())})
+}
+
+// GetReviewMetrics handles GET /api/v1/identity/reviews/metrics.
+// Query parameter: since (RFC 3339); defaults to the last 30 days.
+func (p *IdentityProxy) GetReviewMetrics(w http.ResponseWriter, r *http.Request) {
+	since, ok := readIdentityTime(w, "since", r.URL.Query().Get("since"))
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.GetReviewMetrics(r.Context(), &identityv1.GetReviewMetricsRequest{Since: since})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, reviewMetricsResp{
+		Since:                    formatTimestamp(resp.GetSince()),
+		Open:                     resp.GetOpen(),
+		AwaitingSecondApproval:   resp.GetAwaitingSecondApproval(),
+		Overdue:                  resp.GetOverdue(),
+		Decided:                  resp.GetDecided(),
+		DecidedWithinSLA:         resp.GetDecidedWithinSla(),
+		WithinSLARate:            resp.GetWithinSlaRate(),
+		AvgTimeToDecisionSeconds: resp.GetAvgTimeToDecisionSeconds(),
+		P95TimeToDecisionSeconds: resp.GetP95TimeToDecisionSeconds(),
+	})
+}
+
+type eraseApplicantDataReq struct {
+	ApplicantEmail   string `json:"applicant_email"`
+	RequestReference string `json:"request_reference"`
+}
+
+type retainedVerificationMsg struct {
+	VerificationID string `json:"verification_id"`
+	Status         string `json:"status"`
+	Reason         string `json:"reason"`
+}
+
+type eraseApplicantDataResp struct {
+	ErasedVerificationIDs []string                  `json:"erased_verification_ids"`
+	Retained              []retainedVerificationMsg `json:"retained"`
+	DocumentsErased       int32                     `json:"documents_erased"`
+}
+
+type exportApplicantDataReq struct {
+	ApplicantEmail string `json:"applicant_email"`
+}
+
+type applicantVerificationExportMsg struct {
+	Verification     verificationMsg      `json:"verification"`
+	Documents        []documentMsg        `json:"documents"`
+	ScreeningResults []screeningResultMsg `json:"screening_results"`
+	DuplicateMatches []duplicateMatchMsg  `json:"duplicate_matches"`
+}
+
+type exportApplicantDataResp struct {
+	ApplicantEmail string                           `json:"applicant_email"`
+	ExportedAt     string                           `json:"exported_at"`
+	Verifications  []applicantVerificationExportMsg `json:"verifications"`
+}
+
+// EraseApplicantData handles POST /api/v1/identity/data-subjects/erasure.
+func (p *IdentityProxy) EraseApplicantData(w http.ResponseWriter, r *http.Request) {
+	var req eraseApplicantDataReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.EraseApplicantData(r.Context(), &identityv1.EraseApplicantDataRequest{
+		ApplicantEmail:   req.ApplicantEmail,
+		RequestReference: req.RequestReference,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := eraseApplicantDataResp{
+		ErasedVerificationIDs: resp.GetErasedVerificationIds(),
+		Retained:              make([]retainedVerificationMsg, 0, len(resp.GetRetained())),
+		DocumentsErased:       resp.GetDocumentsErased(),
+	}
+	if out.ErasedVerificationIDs == nil {
+		out.ErasedVerificationIDs = []string{}
+	}
+	for _, rv := range resp.GetRetained() {
+		out.Retained = append(out.Retained, retainedVerificationMsg{
+			VerificationID: rv.GetVerificationId(),
+			Status:         enumName(rv.GetStatus().String(), "VERIFICATION_STATUS_"),
+			Reason:         rv.GetReason(),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// ExportApplicantData handles POST /api/v1/identity/data-subjects/export.
+// The email travels in the body so it stays out of access logs.
+func (p *IdentityProxy) ExportApplicantData(w http.ResponseWriter, r *http.Request) {
+	var req exportApplicantDataReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.ExportApplicantData(r.Context(), &identityv1.ExportApplicantDataRequest{ApplicantEmail: req.ApplicantEmail})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := exportApplicantDataResp{
+		ApplicantEmail: resp.GetApplicantEmail(),
+		ExportedAt:     formatTimestamp(resp.GetExportedAt()),
+		Verifications:  make([]applicantVerificationExportMsg, 0, len(resp.GetVerifications())),
+	}
+	for _, v := range resp.GetVerifications() {
+		export := applicantVerificationExportMsg{
+			Verification:     toVerificationMsg(v.GetVerification()),
+			Documents:        toDocumentMsgs(v.GetDocuments()),
+			ScreeningResults: make([]screeningResultMsg, 0, len(v.GetScreeningResults())),
+			DuplicateMatches: toDuplicateMatchMsgs(v.GetDuplicateMatches()),
+		}
+		for _, res := range v.GetScreeningResults() {
+			export.ScreeningResults = append(export.ScreeningResults, toScreeningResultMsg(res))
+		}
+		out.Verifications = append(out.Verifications, export)
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// readIdentityEnum resolves an optional enum query or body value, writing a
+// 400 when it is not a known value.
+func readIdentityEnum(w http.ResponseWriter, field, name, prefix string, values map[string]int32) (int32, bool) {
+	if name == "" {
+		return 0, true
+	}
+	v, ok := values[prefix+strings.ToUpper(name)]
+	if !ok || v == 0 {
+		writeError(w, http.StatusBadRequest, "invalid "+field)
+		return 0, false
+	}
+	return v, true
+}
+
+// readIdentityTime parses an optional RFC 3339 field, writing a 400 when it
+// is malformed.
+func readIdentityTime(w http.ResponseWriter, field, value string) (*timestamppb.Timestamp, bool) {
+	if value == "" {
+		return nil, true
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, field+" must be an RFC 3339 timestamp")
+		return nil, false
+	}
+	return timestamppb.New(t), true
+}
+
+func toVerificationMsg(v *identityv1.IdentityVerification) verificationMsg {
+	return verificationMsg{
+		ID:                     v.GetId(),
+		TenantID:               v.GetTenantId(),
+		ApplicantFirstName:     v.GetApplicantFirstName(),
+		ApplicantLastName:      v.GetApplicantLastName(),
+		ApplicantEmail:         v.GetApplicantEmail(),
+		ApplicantDOB:           v.GetApplicantDateOfBirth(),
+		ApplicantCountry:       v.GetApplicantCountry(),
+		Status:                 enumName(v.GetStatus().String(), "VERIFICATION_STATUS_"),
+		RiskTier:               enumName(v.GetRiskTier().String(), "RISK_TIER_"),
+		RiskFactors:            v.GetRiskFactors(),
+		ExpiresAt:              formatTimestamp(v.GetExpiresAt()),
+		ErasedAt:               formatTimestamp(v.GetErasedAt()),
+		PreviousVerificationID: v.GetPreviousVerificationId(),
+		CreatedAt:              formatTimestamp(v.GetAudit().GetCreatedAt()),
+		UpdatedAt:              formatTimestamp(v.GetAudit().GetUpdatedAt()),
+		Checks:                 toCheckMsgs(v.GetChecks()),
+		RiskScore:              v.GetRiskScore(),
+		Version:                v.GetAudit().GetVersion(),
+	}
+}
+
+func toCheckMsgs(in []*identityv1.VerificationCheck) []checkMsg {
+	checks := make([]checkMsg, 0, len(in))
+	for _, c := range in {
+		checks = append(checks, checkMsg{
+			ID:                c.GetId(),
+			CheckType:         enumName(c.GetType().String(), "CHECK_TYPE_"),
+			Status:            enumName(c.GetStatus().String(), "VERIFICATION_STATUS_"),
+			Provider:          c.GetProvider(),
+			ProviderReference: c.GetProviderReference(),
+			CompletedAt:       formatTimestamp(c.GetCompletedAt()),
+			FailureReason:     c.GetFailureReason(),
+		})
+	}
+	return checks
+}
+
+func toScreeningResultMsg(r *identityv1.ScreeningResult) screeningResultMsg {
+	msg := screeningResultMsg{
+		ID:                r.GetId(),
+		CheckID:           r.GetCheckId(),
+		CheckType:         enumName(r.GetCheckType().String(), "CHECK_TYPE_"),
+		Provider:          r.GetProvider(),
+		ProviderReference: r.GetProviderReference(),
+		Trigger:           r.GetTrigger(),
+		Outcome:           r.GetOutcome(),
+		ScreenedAt:        formatTimestamp(r.GetScreenedAt()),
+		Matches:           make([]screeningMatchMsg, 0, len(r.GetMatches())),
+	}
+	for _, m := range r.GetMatches() {
+		msg.Matches = append(msg.Matches, screeningMatchMsg{
+			Name:       m.GetName(),
+			Categories: m.GetCategories(),
+			Sources:    m.GetSources(),
+			EntryID:    m.GetEntryId(),
+			Score:      m.GetScore(),
+		})
+	}
+	return msg
+}
+
+func toDuplicateMatchMsgs(in []*identityv1.DuplicateMatch) []duplicateMatchMsg {
+	matches := make([]duplicateMatchMsg, 0, len(in))
+	for _, m := range in {
+		matches = append(matches, duplicateMatchMsg{
+			VerificationID: m.GetVerificationId(),
+			Reasons:        m.GetReasons(),
+			Score:          m.GetScore(),
+		})
+	}
+	return matches
+}
+
+func toDocumentMsg(d *identityv1.IdentityDocument) documentMsg {
+	return documentMsg{
+		ID:             d.GetId(),
+		VerificationID: d.GetVerificationId(),
+		CheckID:        d.GetCheckId(),
+		DocumentType:   enumName(d.GetDocumentType().String(), "DOCUMENT_TYPE_"),
+		ContentType:    d.GetContentType(),
+		SHA256:         d.GetSha256(),
+		UploadedAt:     formatTimestamp(d.GetUploadedAt()),
+		RetainUntil:    formatTimestamp(d.GetRetainUntil()),
+		PurgedAt:       formatTimestamp(d.GetPurgedAt()),
+		SizeBytes:      d.GetSizeBytes(),
+	}
+}
+
+func toDocumentMsgs(in []*identityv1.IdentityDocument) []documentMsg {
+	docs := make([]documentMsg, 0, len(in))
+	for _, d := range in {
+		docs = append(docs, toDocumentMsg(d))
+	}
+	return docs
+}
+
+func toBusinessVerificationMsg(v *identityv1.BusinessVerification) businessVerificationMsg {
+	owners := make([]beneficialOwnerMsg, 0, len(v.GetBeneficialOwners()))
+	for _, o := range v.GetBeneficialOwners() {
+		owners = append(owners, beneficialOwnerMsg{
+			FirstName:        o.GetFirstName(),
+			LastName:         o.GetLastName(),
+			DateOfBirth:      o.GetDateOfBirth(),
+			Country:          o.GetCountry(),
+			OwnershipPercent: o.GetOwnershipPercent(),
+		})
+	}
+	return businessVerificationMsg{
+		ID:                 v.GetId(),
+		TenantID:           v.GetTenantId(),
+		LegalName:          v.GetLegalName(),
+		RegistrationNumber: v.GetRegistrationNumber(),
+		Country:            v.GetCountry(),
+		Status:             enumName(v.GetStatus().String(), "VERIFICATION_STATUS_"),
+		CreatedAt:          formatTimestamp(v.GetAudit().GetCreatedAt()),
+		UpdatedAt:          formatTimestamp(v.GetAudit().GetUpdatedAt()),
+		BeneficialOwners:   owners,
+		Checks:             toCheckMsgs(v.GetChecks()),
+		Version:            v.GetAudit().GetVersion(),
+	}
+}
+
+func toReviewCaseMsg(c *identityv1.ReviewCase) reviewCaseMsg {
+	msg := reviewCaseMsg{
+		ID:               c.GetId(),
+		VerificationID:   c.GetVerificationId(),
+		Status:           c.GetStatus(),
+		Reason:           c.GetReason(),
+		Outcome:          c.GetOutcome(),
+		AssigneeID:       c.GetAssigneeId(),
+		OpenedAt:         formatTimestamp(c.GetOpenedAt()),
+		DueAt:            formatTimestamp(c.GetDueAt()),
+		ClaimedAt:        formatTimestamp(c.GetClaimedAt()),
+		DecidedAt:        formatTimestamp(c.GetDecidedAt()),
+		Decisions:        make([]reviewDecisionMsg, 0, len(c.GetDecisions())),
+		RequiresFourEyes: c.GetRequiresFourEyes(),
+		Overdue:          c.GetOverdue(),
+	}
+	for _, d := range c.GetDecisions() {
+		msg.Decisions = append(msg.Decisions, reviewDecisionMsg{
+			AnalystID: d.GetAnalystId(),
+			Outcome:   d.GetOutcome(),
+			Reason:    d.GetReason(),
+			DecidedAt: formatTimestamp(d.GetDecidedAt()),
+		})
+	}
+	return msg
 }
@@ -0,0 +1,80 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const verificationJSON = `{
+	"id": "4e6a8c0b-2d4f-4b7a-9c1e-3f5b7d9a1c2e",
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"applicant_first_name": "Jane",
+	"applicant_last_name": "Smith",
+	"applicant_email": "jane@example.com",
+	"applicant_date_of_birth": "1990-01-01",
+	"applicant_country": "US",
+	"status": "VERIFICATION_STATUS_IN_PROGRESS",
+	"risk_tier": "RISK_TIER_MEDIUM",
+	"risk_score": 0,
+	"checks": [{"id": "7c9e1a3b-5d7f-4a2c-8e0b-2d4f6a8c0e1b", "type": "CHECK_TYPE_DOCUMENT",
+		"status": "VERIFICATION_STATUS_PENDING", "provider": "persona", "provider_reference": "inq_123"}],
+	"audit": {"created_at": "2026-01-15T09:00:00Z", "updated_at": "2026-01-15T09:00:00Z", "version": 2}
+}`
+
+func TestIdentityContract(t *testing.T) {
+	stub, conn := newContractStub(t, "identity-service")
+	p := NewIdentityProxy(conn, conn.Logger)
+	id := contractVerifyID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "initiate a verification",
+		Method:      "/bib.identity.v1.IdentityService/InitiateVerification",
+		Response:    json.RawMessage(`{"verification": ` + verificationJSON + `}`),
+	})
+	rec := call(t, p.InitiateVerification, http.MethodPost, "/api/v1/identity/verifications", `{"first_name": "Jane",
+		"last_name": "Smith", "email": "jane@example.com", "date_of_birth": "1990-01-01", "country": "US"}`)
+	if rec.Code != http.StatusCreated || decodeBody(t, rec)["verification"].(map[string]any)["status"] != "IN_PROGRESS" {
+		t.Errorf("InitiateVerification = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a verification",
+		State:       "a verification is in progress",
+		Method:      "/bib.identity.v1.IdentityService/GetVerification",
+		Response:    json.RawMessage(`{"verification": ` + verificationJSON + `}`),
+	})
+	rec = call(t, p.GetVerification, http.MethodGet, "/api/v1/identity/verifications/"+id, "", "id", id)
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["verification"].(map[string]any)["risk_tier"] != "MEDIUM" {
+		t.Errorf("GetVerification = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing verification",
+		Method:      "/bib.identity.v1.IdentityService/GetVerification",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetVerification, http.MethodGet, "/api/v1/identity/verifications/"+id, "", "id", id)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetVerification of a missing verification = %d, want 404", rec.Code)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "list open review cases",
+		State:       "a review case is open",
+		Method:      "/bib.identity.v1.IdentityService/ListReviewQueue",
+		Response: json.RawMessage(`{"cases": [{"id": "1b3d5f7a-9c2e-4d6f-8a0b-4c6e8a0b2d4f",
+			"verification_id": "4e6a8c0b-2d4f-4b7a-9c1e-3f5b7d9a1c2e", "status": "OPEN", "reason": "document mismatch",
+			"opened_at": "2026-01-15T09:00:00Z", "due_at": "2026-01-16T09:00:00Z", "requires_four_eyes": false,
+			"overdue": false}], "total_count": 1}`),
+	})
+	rec = call(t, p.ListReviewQueue, http.MethodGet, "/api/v1/identity/reviews?status=OPEN&page_size=20", "")
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["total_count"] != float64(1) {
+		t.Errorf("ListReviewQueue = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-identity-service.json"))
+}
@@ -3,19 +3,25 @@ package proxy
 import (
 	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	ledgerv1 "github.com/bibbank/bib/api/gen/go/bib/ledger/v1"
 	"github.com/bibbank/bib/pkg/auth"
 )
 
 // LedgerProxy proxies HTTP requests to the ledger gRPC service.
 type LedgerProxy struct {
-	conn   *ServiceConn
+	client ledgerv1.LedgerServiceClient
 	logger *slog.Logger
 }
 
 // NewLedgerProxy creates a new ledger service proxy.
 func NewLedgerProxy(conn *ServiceConn, logger *slog.Logger) *LedgerProxy {
-	return &LedgerProxy{conn: conn, logger: logger}
+	return &LedgerProxy{client: ledgerv1.NewLedgerServiceClient(conn), logger: logger}
 }
 
 type postingPair struct {
@@ -26,12 +32,22 @@ type postingPair struct {
 	Description   string `json:"description,omitempty"`
 }
 
+// postingLeg is one leg of a multi-leg entry; side is DEBIT or CREDIT.
+type postingLeg struct {
+	AccountCode string `json:"account_code"`
+	Side        string `json:"side"`
+	Amount      string `json:"amount"`
+	Currency    string `json:"currency"`
+	Description string `json:"description,omitempty"`
+}
+
 type postJournalEntryReq struct {
 	TenantID      string        `json:"tenant_id"`
 	EffectiveDate string        `json:"effective_date"`
 	Description   string        `json:"description,omitempty"`
 	Reference     string        `json:"reference,omitempty"`
 	Postings      []postingPair `json:"postings"`
+	Legs          []postingLeg  `json:"legs,omitempty"`
 }
 
 type journalEntryMsg struct {
@@ -44,6 +60,7 @@ type journalEntryMsg struct {
 	CreatedAt     string        `json:"created_at"`
 	UpdatedAt     string        `json:"updated_at"`
 	Postings      []postingPair `json:"postings"`
+	Legs          []postingLeg  `json:"legs"`
 	Version       int32         `json:"version"`
 }
 
@@ -58,7 +75,31 @@ type getBalanceResp struct {
 	AsOf        string `json:"as_of"`
 }
 
-// PostEntry handles POST /api/v1/ledger/entries.
+type trialBalanceLine struct {
+	AccountCode string `json:"account_code"`
+	Currency    string `json:"currency"`
+	Debit       string `json:"debit"`
+	Credit      string `json:"credit"`
+}
+
+type trialBalanceTotal struct {
+	Currency string `json:"currency"`
+	Debit    string `json:"debit"`
+	Credit   string `json:"credit"`
+	Balanced bool   `json:"balanced"`
+}
+
+type getTrialBalanceResp struct {
+	Period        string              `json:"period"`
+	Lines         []trialBalanceLine  `json:"lines"`
+	Totals        []trialBalanceTotal `json:"totals"`
+	Balanced      bool                `json:"balanced"`
+	NextPageToken string              `json:"next_page_token,omitempty"`
+}
+
+// PostEntry handles POST /api/v1/ledger/entries. An entry is given as
+// debit/credit postings, as legs balanced per currency, or both.
+// effective_date is YYYY-MM-DD.
 func (p *LedgerProxy) PostEntry(w http.ResponseWriter, r *http.Request) {
 	var req postJournalEntryReq
 	if err := readJSON(r, &req); err != nil {
@@ -71,14 +112,43 @@ func (p *LedgerProxy) PostEntry(w http.ResponseWriter, r *http.Request) {
 			req.TenantID = claims.TenantID.String()
 		}
 	}
+	effectiveDate, err := time.Parse(time.DateOnly, req.EffectiveDate)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "effective_date must be a YYYY-MM-DD date")
+		return
+	}
 
-	var resp postJournalEntryResp
-	err := p.conn.Invoke(r.Context(), "/bib.ledger.v1.LedgerService/PostJournalEntry", &req, &resp)
+	msg := &ledgerv1.PostJournalEntryRequest{
+		TenantId:      req.TenantID,
+		EffectiveDate: timestamppb.New(effectiveDate),
+		Description:   req.Description,
+		Reference:     req.Reference,
+		Postings:      make([]*ledgerv1.PostingPair, 0, len(req.Postings)),
+		Legs:          make([]*ledgerv1.PostingLeg, 0, len(req.Legs)),
+	}
+	for _, pp := range req.Postings {
+		msg.Postings = append(msg.Postings, &ledgerv1.PostingPair{
+			DebitAccount:  pp.DebitAccount,
+			CreditAccount: pp.CreditAccount,
+			Amount:        &commonv1.Money{Amount: pp.Amount, Currency: pp.Currency},
+			Description:   pp.Description,
+		})
+	}
+	for _, l := range req.Legs {
+		msg.Legs = append(msg.Legs, &ledgerv1.PostingLeg{
+			AccountCode: l.AccountCode,
+			Side:        ledgerv1.PostingSide(ledgerv1.PostingSide_value["POSTING_SIDE_"+l.Side]),
+			Amount:      &commonv1.Money{Amount: l.Amount, Currency: l.Currency},
+			Description: l.Description,
+		})
+	}
+
+	resp, err := p.client.PostJournalEntry(r.Context(), msg)
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusCreated, resp)
+	writeJSON(w, http.StatusCreated, postJournalEntryResp{Entry: toJournalEntryResp(resp.GetEntry())})
 }
 
 // GetEntry handles GET /api/v1/ledger/entries/{id}.
@@ -89,17 +159,16 @@ func (p *LedgerProxy) GetEntry(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req := map[string]string{"id": entryID}
-	var resp postJournalEntryResp
-	err := p.conn.Invoke(r.Context(), "/bib.ledger.v1.LedgerService/GetJournalEntry", &req, &resp)
+	resp, err := p.client.GetJournalEntry(r.Context(), &ledgerv1.GetJournalEntryRequest{Id: entryID})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, postJournalEntryResp{Entry: toJournalEntryResp(resp.GetEntry())})
 }
 
 // GetBalance handles GET /api/v1/ledger/balances/{account_code}.
+// Query parameters: as_of (YYYY-MM-DD), currency.
 func (p *LedgerProxy) GetBalance(w http.ResponseWriter, r *http.Request) {
 	accountCode := r.PathValue("account_code")
 	if accountCode == "" {
@@ -107,17 +176,133 @@ func (p *LedgerProxy) GetBalance(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req := map[string]string{
-		"account_code": accountCode,
-		"as_of":        r.URL.Query().Get("as_of"),
-		"currency":     r.URL.Query().Get("currency"),
+	req, ok := readBalanceRequest(w, r, accountCode)
+	if !ok {
+		return
+	}
+	resp, err := p.client.GetBalance(r.Context(), req)
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
 	}
+	writeJSON(w, http.StatusOK, toGetBalanceResp(resp))
+}
 
-	var resp getBalanceResp
-	err := p.conn.Invoke(r.Context(), "/bib.ledger.v1.LedgerService/GetBalance", &req, &resp)
+// GetTrialBalance handles GET /api/v1/ledger/trial-balance.
+// Query parameters: period (YYYY-MM, YYYY-Qn or YYYY), page_token, page_size.
+func (p *LedgerProxy) GetTrialBalance(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	req := &ledgerv1.GetTrialBalanceRequest{Period: q.Get("period"), PageToken: q.Get("page_token")}
+	if req.Period == "" {
+		writeError(w, http.StatusBadRequest, "period is required")
+		return
+	}
+	if v := q.Get("page_size"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid page_size")
+			return
+		}
+		req.PageSize = int32(n)
+	}
+
+	resp, err := p.client.GetTrialBalance(r.Context(), req)
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+
+	out := getTrialBalanceResp{
+		Period:        resp.GetPeriod(),
+		Lines:         make([]trialBalanceLine, 0, len(resp.GetLines())),
+		Totals:        make([]trialBalanceTotal, 0, len(resp.GetTotals())),
+		Balanced:      resp.GetBalanced(),
+		NextPageToken: resp.GetNextPageToken(),
+	}
+	for _, l := range resp.GetLines() {
+		out.Lines = append(out.Lines, trialBalanceLine{
+			AccountCode: l.GetAccountCode(),
+			Currency:    l.GetCurrency(),
+			Debit:       l.GetDebit(),
+			Credit:      l.GetCredit(),
+		})
+	}
+	for _, t := range resp.GetTotals() {
+		out.Totals = append(out.Totals, trialBalanceTotal{
+			Currency: t.GetCurrency(),
+			Debit:    t.GetDebit(),
+			Credit:   t.GetCredit(),
+			Balanced: t.GetBalanced(),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// readBalanceRequest builds a GetBalance request for accountCode from the
+// as_of and currency query parameters, writing a 400 when as_of is not a
+// YYYY-MM-DD date.
+func readBalanceRequest(w http.ResponseWriter, r *http.Request, accountCode string) (*ledgerv1.GetBalanceRequest, bool) {
+	q := r.URL.Query()
+	req := &ledgerv1.GetBalanceRequest{AccountCode: accountCode, Currency: q.Get("currency")}
+	if v := q.Get("as_of"); v != "" {
+		asOf, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "as_of must be a YYYY-MM-DD date")
+			return nil, false
+		}
+		req.AsOf = timestamppb.New(asOf)
+	}
+	return req, true
+}
+
+func toGetBalanceResp(b *ledgerv1.GetBalanceResponse) getBalanceResp {
+	return getBalanceResp{
+		AccountCode: b.GetAccountCode(),
+		Amount:      b.GetBalance().GetAmount(),
+		Currency:    b.GetBalance().GetCurrency(),
+		AsOf:        formatDate(b.GetAsOf()),
+	}
+}
+
+func toJournalEntryResp(e *ledgerv1.JournalEntry) journalEntryMsg {
+	msg := journalEntryMsg{
+		ID:            e.GetId(),
+		TenantID:      e.GetTenantId(),
+		EffectiveDate: formatDate(e.GetEffectiveDate()),
+		Status:        enumName(e.GetStatus().String(), "ENTRY_STATUS_"),
+		Description:   e.GetDescription(),
+		Reference:     e.GetReference(),
+		CreatedAt:     formatTimestamp(e.GetAudit().GetCreatedAt()),
+		UpdatedAt:     formatTimestamp(e.GetAudit().GetUpdatedAt()),
+		Postings:      make([]postingPair, 0, len(e.GetPostings())),
+		Legs:          make([]postingLeg, 0, len(e.GetLegs())),
+		Version:       e.GetAudit().GetVersion(),
+	}
+	for _, pp := range e.GetPostings() {
+		msg.Postings = append(msg.Postings, postingPair{
+			DebitAccount:  pp.GetDebitAccount(),
+			CreditAccount: pp.GetCreditAccount(),
+			Amount:        pp.GetAmount().GetAmount(),
+			Currency:      pp.GetAmount().GetCurrency(),
+			Description:   pp.GetDescription(),
+		})
+	}
+	for _, l := range e.GetLegs() {
+		msg.Legs = append(msg.Legs, postingLeg{
+			AccountCode: l.GetAccountCode(),
+			Side:        enumName(l.GetSide().String(), "POSTING_SIDE_"),
+			Amount:      l.GetAmount().GetAmount(),
+			Currency:    l.GetAmount().GetCurrency(),
+			Description: l.GetDescription(),
+		})
+	}
+	return msg
+}
+
+// formatDate formats ts as a YYYY-MM-DD date, or "" when it is unset.
+func formatDate(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().UTC().Format(time.DateOnly)
 }
@@ -0,0 +1,89 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const journalEntryJSON = `{
+	"id": "9a1c3e5f-7b2d-4f6a-8c0e-1d3f5a7b9c2e",
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"effective_date": "2026-01-15T00:00:00Z",
+	"status": "ENTRY_STATUS_POSTED",
+	"description": "Customer deposit",
+	"reference": "DEP-001",
+	"postings": [{"debit_account": "1000", "credit_account": "2000",
+		"amount": {"amount": "100", "currency": "USD"}, "description": "Cash in"}],
+	"audit": {"created_at": "2026-01-15T09:00:00Z", "updated_at": "2026-01-15T09:00:00Z", "version": 1}
+}`
+
+func TestLedgerContract(t *testing.T) {
+	stub, conn := newContractStub(t, "ledger-service")
+	p := NewLedgerProxy(conn, conn.Logger)
+	id := contractEntryID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "post a balanced journal entry",
+		Method:      "/bib.ledger.v1.LedgerService/PostJournalEntry",
+		Response:    json.RawMessage(`{"entry": ` + journalEntryJSON + `}`),
+	})
+	rec := call(t, p.PostEntry, http.MethodPost, "/api/v1/ledger/entries", `{"effective_date": "2026-01-15",
+		"description": "Customer deposit", "reference": "DEP-001", "postings": [{"debit_account": "1000",
+		"credit_account": "2000", "amount": "100", "currency": "USD", "description": "Cash in"}]}`)
+	if rec.Code != http.StatusCreated || decodeBody(t, rec)["entry"].(map[string]any)["status"] != "POSTED" {
+		t.Errorf("PostEntry = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a journal entry",
+		State:       "a journal entry exists",
+		Method:      "/bib.ledger.v1.LedgerService/GetJournalEntry",
+		Response:    json.RawMessage(`{"entry": ` + journalEntryJSON + `}`),
+	})
+	rec = call(t, p.GetEntry, http.MethodGet, "/api/v1/ledger/entries/"+id, "", "id", id)
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["entry"].(map[string]any)["id"] != id {
+		t.Errorf("GetEntry = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing journal entry",
+		Method:      "/bib.ledger.v1.LedgerService/GetJournalEntry",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetEntry, http.MethodGet, "/api/v1/ledger/entries/"+id, "", "id", id)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetEntry of a missing entry = %d, want 404", rec.Code)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get an account balance as of a date",
+		Method:      "/bib.ledger.v1.LedgerService/GetBalance",
+		Response: json.RawMessage(`{"account_code": "2000", "balance": {"amount": "1000", "currency": "USD"},
+			"as_of": "2026-01-31T00:00:00Z"}`),
+	})
+	rec = call(t, p.GetBalance, http.MethodGet, "/api/v1/ledger/balances/2000?as_of=2026-01-31&currency=USD", "",
+		"account_code", "2000")
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["amount"] != "1000" {
+		t.Errorf("GetBalance = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a monthly trial balance",
+		State:       "the period has postings",
+		Method:      "/bib.ledger.v1.LedgerService/GetTrialBalance",
+		Response: json.RawMessage(`{"period": "2026-01",
+			"lines": [{"account_code": "1000", "currency": "USD", "debit": "100", "credit": "0"}],
+			"totals": [{"currency": "USD", "debit": "100", "credit": "100", "balanced": true}],
+			"balanced": true, "next_page_token": ""}`),
+	})
+	rec = call(t, p.GetTrialBalance, http.MethodGet, "/api/v1/ledger/trial-balance?period=2026-01&page_size=50", "")
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["balanced"] != true {
+		t.Errorf("GetTrialBalance = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-ledger-service.json"))
+}
@@ -3,19 +3,22 @@ package proxy
 import (
 	"log/slog"
 	"net/http"
+	"strings"
 
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	lendingv1 "github.com/bibbank/bib/api/gen/go/bib/lending/v1"
 	"github.com/bibbank/bib/pkg/auth"
 )
 
 // LendingProxy proxies HTTP requests to the lending gRPC service.
 type LendingProxy struct {
-	conn   *ServiceConn
+	client lendingv1.LendingServiceClient
 	logger *slog.Logger
 }
 
 // NewLendingProxy creates a new lending service proxy.
 func NewLendingProxy(conn *ServiceConn, logger *slog.Logger) *LendingProxy {
-	return &LendingProxy{conn: conn, logger: logger}
+	return &LendingProxy{client: lendingv1.NewLendingServiceClient(conn), logger: logger}
 }
 
 type submitLoanApplicationReq struct {
@@ -73,13 +76,18 @@ func (p *LendingProxy) SubmitApplication(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
-	var resp loanApplicationResp
-	err := p.conn.Invoke(r.Context(), "/bib.lending.v1.LendingService/SubmitApplication", &req, &resp)
+	resp, err := p.client.SubmitApplication(r.Context(), &lendingv1.SubmitLoanApplicationRequest{
+		TenantId:        req.TenantID,
+		ApplicantId:     req.ApplicantID,
+		RequestedAmount: &commonv1.Money{Amount: req.RequestedAmount, Currency: req.Currency},
+		TermMonths:      int32(req.TermMonths), //nolint:gosec // validated by the lending service
+		Purpose:         req.Purpose,
+	})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusCreated, resp)
+	writeJSON(w, http.StatusCreated, toLoanApplicationResp(resp.GetApplication()))
 }
 
 // GetApplication handles GET /api/v1/loans/applications/{id}.
@@ -90,22 +98,12 @@ func (p *LendingProxy) GetApplication(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tenantID := ""
-	if claims, ok := auth.ClaimsFromContext(r.Context()); ok {
-		tenantID = claims.TenantID.String()
-	}
-
-	req := map[string]string{
-		"tenant_id":      tenantID,
-		"application_id": applicationID,
-	}
-	var resp loanApplicationResp
-	err := p.conn.Invoke(r.Context(), "/bib.lending.v1.LendingService/GetApplication", &req, &resp)
+	resp, err := p.client.GetApplication(r.Context(), &lendingv1.GetApplicationRequest{Id: applicationID})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, toLoanApplicationResp(resp.GetApplication()))
 }
 
 // DisburseLoan handles POST /api/v1/loans/disburse.
@@ -122,13 +120,17 @@ func (p *LendingProxy) DisburseLoan(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	var resp loanResp
-	err := p.conn.Invoke(r.Context(), "/bib.lending.v1.LendingService/DisburseLoan", &req, &resp)
+	resp, err := p.client.DisburseLoan(r.Context(), &lendingv1.DisburseLoanRequest{
+		TenantId:          req.TenantID,
+		ApplicationId:     req.ApplicationID,
+		BorrowerAccountId: req.BorrowerAccountID,
+		InterestRateBps:   int32(req.InterestRateBps), //nolint:gosec // validated by the lending service
+	})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusCreated, resp)
+	writeJSON(w, http.StatusCreated, toLoanResp(resp.GetLoan()))
 }
 
 // GetLoan handles GET /api/v1/loans/{id}.
@@ -139,22 +141,12 @@ func (p *LendingProxy) GetLoan(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tenantID := ""
-	if claims, ok := auth.ClaimsFromContext(r.Context()); ok {
-		tenantID = claims.TenantID.String()
-	}
-
-	req := map[string]string{
-		"tenant_id": tenantID,
-		"loan_id":   loanID,
-	}
-	var resp loanResp
-	err := p.conn.Invoke(r.Context(), "/bib.lending.v1.LendingService/GetLoan", &req, &resp)
+	resp, err := p.client.GetLoan(r.Context(), &lendingv1.GetLoanRequest{Id: loanID})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, toLoanResp(resp.GetLoan()))
 }
 
 // MakePayment handles POST /api/v1/loans/{id}/payments.
@@ -178,11 +170,35 @@ func (p *LendingProxy) MakePayment(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	var resp loanPaymentResp
-	err := p.conn.Invoke(r.Context(), "/bib.lending.v1.LendingService/MakePayment", &req, &resp)
+	resp, err := p.client.MakePayment(r.Context(), &lendingv1.MakePaymentRequest{
+		TenantId: req.TenantID,
+		LoanId:   req.LoanID,
+		Amount:   req.Amount,
+	})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusCreated, resp)
+	writeJSON(w, http.StatusCreated, loanPaymentResp{
+		PaymentID: resp.GetLoanId(),
+		Status:    strings.TrimPrefix(resp.GetLoanStatus().String(), "LOAN_STATUS_"),
+	})
+}
+
+func toLoanApplicationResp(a *lendingv1.LoanApplication) loanApplicationResp {
+	return loanApplicationResp{
+		ApplicationID: a.GetId(),
+		Status:        strings.TrimPrefix(a.GetStatus().String(), "LOAN_APPLICATION_STATUS_"),
+		CreatedAt:     formatTimestamp(a.GetAudit().GetCreatedAt()),
+	}
+}
+
+func toLoanResp(l *lendingv1.Loan) loanResp {
+	return loanResp{
+		LoanID:    l.GetId(),
+		Status:    strings.TrimPrefix(l.GetStatus().String(), "LOAN_STATUS_"),
+		Amount:    l.GetPrincipal().GetAmount(),
+		Currency:  l.GetPrincipal().GetCurrency(),
+		CreatedAt: formatTimestamp(l.GetAudit().GetCreatedAt()),
+	}
 }
@@ -0,0 +1,75 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const loanApplicationJSON = `{
+	"id": "8b0d2f4a-6c8e-4a1b-9d3f-5a7c9e1b3d5f",
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"applicant_id": "5b0e2d8a-7c41-4f6e-a3b9-2c8d1e4f6a70",
+	"requested_amount": {"amount": "25000", "currency": "USD"},
+	"term_months": 36,
+	"purpose": "home improvement",
+	"status": "LOAN_APPLICATION_STATUS_APPROVED",
+	"audit": {"created_at": "2026-01-15T09:00:00Z", "updated_at": "2026-01-15T09:00:00Z"}
+}`
+
+func TestLendingContract(t *testing.T) {
+	stub, conn := newContractStub(t, "lending-service")
+	p := NewLendingProxy(conn, conn.Logger)
+	appID, loanID := contractLoanAppID.String(), contractLoanID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "submit a loan application",
+		Method:      "/bib.lending.v1.LendingService/SubmitApplication",
+		Response:    json.RawMessage(`{"application": ` + loanApplicationJSON + `}`),
+	})
+	rec := call(t, p.SubmitApplication, http.MethodPost, "/api/v1/loans/applications", `{"applicant_id": "`+
+		contractAccountID.String()+`", "requested_amount": "25000", "currency": "USD", "term_months": 36,
+		"purpose": "home improvement"}`)
+	if rec.Code != http.StatusCreated || decodeBody(t, rec)["application_id"] != appID {
+		t.Errorf("SubmitApplication = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a loan application",
+		State:       "a loan application was approved",
+		Method:      "/bib.lending.v1.LendingService/GetApplication",
+		Response:    json.RawMessage(`{"application": ` + loanApplicationJSON + `}`),
+	})
+	rec = call(t, p.GetApplication, http.MethodGet, "/api/v1/loans/applications/"+appID, "", "id", appID)
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["status"] != "APPROVED" {
+		t.Errorf("GetApplication = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get an active loan",
+		State:       "an active loan exists",
+		Method:      "/bib.lending.v1.LendingService/GetLoan",
+		Response: json.RawMessage(`{"loan": {"id": "0a2c4e6b-8d1f-4c3a-b5e7-9f1b3d5a7c9e",
+			"status": "LOAN_STATUS_ACTIVE", "principal": {"amount": "25000", "currency": "USD"},
+			"audit": {"created_at": "2026-01-16T09:00:00Z"}}}`),
+	})
+	rec = call(t, p.GetLoan, http.MethodGet, "/api/v1/loans/"+loanID, "", "id", loanID)
+	if rec.Code != http.StatusOK || decodeBody(t, rec)["amount"] != "25000" {
+		t.Errorf("GetLoan = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing loan",
+		Method:      "/bib.lending.v1.LendingService/GetLoan",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetLoan, http.MethodGet, "/api/v1/loans/"+loanID, "", "id", loanID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetLoan of a missing loan = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-lending-service.json"))
+}
@@ -0,0 +1,483 @@
+package proxy
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	limitsv1 "github.com/bibbank/bib/api/gen/go/bib/limits/v1"
+)
+
+// LimitsProxy proxies HTTP requests to the limits gRPC service.
+type LimitsProxy struct {
+	client limitsv1.LimitsServiceClient
+	logger *slog.Logger
+}
+
+// NewLimitsProxy creates a new limits service proxy.
+func NewLimitsProxy(conn *ServiceConn, logger *slog.Logger) *LimitsProxy {
+	return &LimitsProxy{client: limitsv1.NewLimitsServiceClient(conn), logger: logger}
+}
+
+const limitCategoryHint = "category must be PAYMENTS, CARDS, OVERDRAFT, LENDING or TOTAL"
+
+type createLimitReq struct {
+	PartyID  string `json:"party_id,omitempty"`
+	ParentID string `json:"parent_id,omitempty"`
+	Category string `json:"category"`
+	Currency string `json:"currency"`
+	Amount   string `json:"amount"`
+}
+
+type updateLimitReq struct {
+	Amount string `json:"amount"`
+}
+
+type checkAndReserveReq struct {
+	PartyID   string `json:"party_id,omitempty"`
+	Category  string `json:"category"`
+	Currency  string `json:"currency"`
+	Amount    string `json:"amount"`
+	Reference string `json:"reference"`
+	DryRun    bool   `json:"dry_run,omitempty"`
+}
+
+type reduceExposureReq struct {
+	PartyID   string `json:"party_id,omitempty"`
+	Category  string `json:"category"`
+	Currency  string `json:"currency"`
+	Amount    string `json:"amount"`
+	Reference string `json:"reference"`
+}
+
+type limitMsg struct {
+	LimitID   string `json:"limit_id"`
+	TenantID  string `json:"tenant_id"`
+	PartyID   string `json:"party_id,omitempty"`
+	ParentID  string `json:"parent_id,omitempty"`
+	Category  string `json:"category"`
+	Currency  string `json:"currency"`
+	Amount    string `json:"amount"`
+	Utilized  string `json:"utilized"`
+	Reserved  string `json:"reserved"`
+	Available string `json:"available"`
+	Version   int32  `json:"version"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type limitResp struct {
+	Limit *limitMsg `json:"limit"`
+}
+
+type listLimitsResp struct {
+	Limits     []*limitMsg `json:"limits"`
+	TotalCount int32       `json:"total_count"`
+}
+
+type reservationMsg struct {
+	ReservationID string   `json:"reservation_id"`
+	TenantID      string   `json:"tenant_id"`
+	PartyID       string   `json:"party_id,omitempty"`
+	Category      string   `json:"category"`
+	Currency      string   `json:"currency"`
+	Reference     string   `json:"reference"`
+	Amount        string   `json:"amount"`
+	LimitIDs      []string `json:"limit_ids"`
+	Status        string   `json:"status"`
+	ExpiresAt     string   `json:"expires_at"`
+	Version       int32    `json:"version"`
+	CreatedAt     string   `json:"created_at"`
+	UpdatedAt     string   `json:"updated_at"`
+}
+
+type reservationResp struct {
+	Reservation *reservationMsg `json:"reservation"`
+}
+
+type listReservationsResp struct {
+	Reservations []*reservationMsg `json:"reservations"`
+	TotalCount   int32             `json:"total_count"`
+}
+
+type checkAndReserveResp struct {
+	Approved       bool            `json:"approved"`
+	Reservation    *reservationMsg `json:"reservation,omitempty"`
+	Headroom       string          `json:"headroom"`
+	BindingLimitID string          `json:"binding_limit_id,omitempty"`
+}
+
+type limitExposureMsg struct {
+	Limit             *limitMsg `json:"limit"`
+	EffectiveHeadroom string    `json:"effective_headroom"`
+	BindingLimitID    string    `json:"binding_limit_id"`
+}
+
+type currencyExposureMsg struct {
+	Currency string `json:"currency"`
+	Utilized string `json:"utilized"`
+	Reserved string `json:"reserved"`
+}
+
+type exposureResp struct {
+	PartyID string                 `json:"party_id,omitempty"`
+	Limits  []*limitExposureMsg    `json:"limits"`
+	Totals  []*currencyExposureMsg `json:"totals"`
+}
+
+// CreateLimit handles POST /api/v1/limits.
+func (p *LimitsProxy) CreateLimit(w http.ResponseWriter, r *http.Request) {
+	var req createLimitReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	category, found := limitCategory(req.Category)
+	if !found {
+		writeError(w, http.StatusBadRequest, limitCategoryHint)
+		return
+	}
+
+	resp, err := p.client.CreateLimit(r.Context(), &limitsv1.CreateLimitRequest{
+		PartyId:  req.PartyID,
+		ParentId: req.ParentID,
+		Category: category,
+		Currency: req.Currency,
+		Amount:   req.Amount,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	limit := toLimitMsg(resp.GetLimit())
+	w.Header().Set("Location", "/api/v1/limits/"+url.PathEscape(limit.LimitID))
+	writeJSON(w, http.StatusCreated, limitResp{Limit: limit})
+}
+
+// ListLimits handles GET /api/v1/limits.
+// Query parameters: party_id, currency, tenant_wide, page_size, offset.
+func (p *LimitsProxy) ListLimits(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	var tenantWide bool
+	if v := q.Get("tenant_wide"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid tenant_wide")
+			return
+		}
+		tenantWide = b
+	}
+
+	resp, err := p.client.ListLimits(r.Context(), &limitsv1.ListLimitsRequest{
+		PartyId:    q.Get("party_id"),
+		Currency:   q.Get("currency"),
+		TenantWide: tenantWide,
+		PageSize:   pageSize,
+		Offset:     offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listLimitsResp{
+		Limits:     make([]*limitMsg, 0, len(resp.GetLimits())),
+		TotalCount: resp.GetTotalCount(),
+	}
+	for _, l := range resp.GetLimits() {
+		out.Limits = append(out.Limits, toLimitMsg(l))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetLimit handles GET /api/v1/limits/{id}.
+func (p *LimitsProxy) GetLimit(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "limit id is required")
+		return
+	}
+
+	resp, err := p.client.GetLimit(r.Context(), &limitsv1.GetLimitRequest{LimitId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, limitResp{Limit: toLimitMsg(resp.GetLimit())})
+}
+
+// UpdateLimit handles PUT /api/v1/limits/{id}, replacing the limit's
+// amount.
+func (p *LimitsProxy) UpdateLimit(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "limit id is required")
+		return
+	}
+	var req updateLimitReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.UpdateLimit(r.Context(), &limitsv1.UpdateLimitRequest{
+		LimitId: id,
+		Amount:  req.Amount,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, limitResp{Limit: toLimitMsg(resp.GetLimit())})
+}
+
+// CheckAndReserve handles POST /api/v1/limits/reservations, reserving the
+// amount against the limits capping it when they all have the headroom. A
+// declined amount is not an error: the response reports approved false and
+// the binding limit.
+func (p *LimitsProxy) CheckAndReserve(w http.ResponseWriter, r *http.Request) {
+	var req checkAndReserveReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	category, found := limitCategory(req.Category)
+	if !found {
+		writeError(w, http.StatusBadRequest, limitCategoryHint)
+		return
+	}
+
+	resp, err := p.client.CheckAndReserve(r.Context(), &limitsv1.CheckAndReserveRequest{
+		PartyId:   req.PartyID,
+		Category:  category,
+		Currency:  req.Currency,
+		Amount:    req.Amount,
+		Reference: req.Reference,
+		DryRun:    req.DryRun,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := checkAndReserveResp{
+		Approved:       resp.GetApproved(),
+		Headroom:       resp.GetHeadroom(),
+		BindingLimitID: resp.GetBindingLimitId(),
+	}
+	statusCode := http.StatusOK
+	if res := resp.GetReservation(); res != nil {
+		out.Reservation = toReservationMsg(res)
+		w.Header().Set("Location", "/api/v1/limits/reservations/"+url.PathEscape(out.Reservation.ReservationID))
+		statusCode = http.StatusCreated
+	}
+	writeJSON(w, statusCode, out)
+}
+
+// ListReservations handles GET /api/v1/limits/reservations.
+// Query parameters: party_id, status, page_size, offset.
+func (p *LimitsProxy) ListReservations(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	var status limitsv1.ReservationStatus
+	if v := q.Get("status"); v != "" {
+		n, found := limitsv1.ReservationStatus_value["RESERVATION_STATUS_"+strings.ToUpper(v)]
+		if !found {
+			writeError(w, http.StatusBadRequest, "status must be HELD, COMMITTED, RELEASED or EXPIRED")
+			return
+		}
+		status = limitsv1.ReservationStatus(n)
+	}
+
+	resp, err := p.client.ListReservations(r.Context(), &limitsv1.ListReservationsRequest{
+		PartyId:  q.Get("party_id"),
+		Status:   status,
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listReservationsResp{
+		Reservations: make([]*reservationMsg, 0, len(resp.GetReservations())),
+		TotalCount:   resp.GetTotalCount(),
+	}
+	for _, res := range resp.GetReservations() {
+		out.Reservations = append(out.Reservations, toReservationMsg(res))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetReservation handles GET /api/v1/limits/reservations/{id}.
+func (p *LimitsProxy) GetReservation(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "reservation id is required")
+		return
+	}
+
+	resp, err := p.client.GetReservation(r.Context(), &limitsv1.GetReservationRequest{ReservationId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, reservationResp{Reservation: toReservationMsg(resp.GetReservation())})
+}
+
+// CommitReservation handles POST /api/v1/limits/reservations/{id}/commit,
+// turning the reserved amount into utilized exposure.
+func (p *LimitsProxy) CommitReservation(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "reservation id is required")
+		return
+	}
+
+	resp, err := p.client.CommitReservation(r.Context(), &limitsv1.CommitReservationRequest{ReservationId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, reservationResp{Reservation: toReservationMsg(resp.GetReservation())})
+}
+
+// ReleaseReservation handles POST /api/v1/limits/reservations/{id}/release,
+// returning the reserved amount to the limits' headroom.
+func (p *LimitsProxy) ReleaseReservation(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "reservation id is required")
+		return
+	}
+
+	resp, err := p.client.ReleaseReservation(r.Context(), &limitsv1.ReleaseReservationRequest{ReservationId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, reservationResp{Reservation: toReservationMsg(resp.GetReservation())})
+}
+
+// ReduceExposure handles POST /api/v1/limits/exposure/reduce, such as when
+// a loan is repaid.
+func (p *LimitsProxy) ReduceExposure(w http.ResponseWriter, r *http.Request) {
+	var req reduceExposureReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	category, found := limitCategory(req.Category)
+	if !found {
+		writeError(w, http.StatusBadRequest, limitCategoryHint)
+		return
+	}
+
+	resp, err := p.client.ReduceExposure(r.Context(), &limitsv1.ReduceExposureRequest{
+		PartyId:   req.PartyID,
+		Category:  category,
+		Currency:  req.Currency,
+		Amount:    req.Amount,
+		Reference: req.Reference,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, limitResp{Limit: toLimitMsg(resp.GetLimit())})
+}
+
+// GetExposure handles GET /api/v1/limits/exposure.
+// Query parameters: party_id, empty for the tenant's own exposure.
+func (p *LimitsProxy) GetExposure(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.GetExposure(r.Context(), &limitsv1.GetExposureRequest{
+		PartyId: r.URL.Query().Get("party_id"),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := exposureResp{
+		PartyID: resp.GetPartyId(),
+		Limits:  make([]*limitExposureMsg, 0, len(resp.GetLimits())),
+		Totals:  make([]*currencyExposureMsg, 0, len(resp.GetTotals())),
+	}
+	for _, l := range resp.GetLimits() {
+		out.Limits = append(out.Limits, &limitExposureMsg{
+			Limit:             toLimitMsg(l.GetLimit()),
+			EffectiveHeadroom: l.GetEffectiveHeadroom(),
+			BindingLimitID:    l.GetBindingLimitId(),
+		})
+	}
+	for _, t := range resp.GetTotals() {
+		out.Totals = append(out.Totals, &currencyExposureMsg{
+			Currency: t.GetCurrency(),
+			Utilized: t.GetUtilized(),
+			Reserved: t.GetReserved(),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// limitCategory converts a category name to its enum value; the category
+// is required.
+func limitCategory(name string) (limitsv1.LimitCategory, bool) {
+	v, ok := limitsv1.LimitCategory_value["LIMIT_CATEGORY_"+strings.ToUpper(name)]
+	if !ok || v == 0 {
+		return limitsv1.LimitCategory_LIMIT_CATEGORY_UNSPECIFIED, false
+	}
+	return limitsv1.LimitCategory(v), true
+}
+
+func toLimitMsg(l *limitsv1.Limit) *limitMsg {
+	if l == nil {
+		return nil
+	}
+	return &limitMsg{
+		LimitID:   l.GetLimitId(),
+		TenantID:  l.GetTenantId(),
+		PartyID:   l.GetPartyId(),
+		ParentID:  l.GetParentId(),
+		Category:  enumName(l.GetCategory().String(), "LIMIT_CATEGORY_"),
+		Currency:  l.GetCurrency(),
+		Amount:    l.GetAmount(),
+		Utilized:  l.GetUtilized(),
+		Reserved:  l.GetReserved(),
+		Available: l.GetAvailable(),
+		Version:   l.GetVersion(),
+		CreatedAt: formatTimestamp(l.GetCreatedAt()),
+		UpdatedAt: formatTimestamp(l.GetUpdatedAt()),
+	}
+}
+
+func toReservationMsg(res *limitsv1.Reservation) *reservationMsg {
+	if res == nil {
+		return nil
+	}
+	limitIDs := res.GetLimitIds()
+	if limitIDs == nil {
+		limitIDs = []string{}
+	}
+	return &reservationMsg{
+		ReservationID: res.GetReservationId(),
+		TenantID:      res.GetTenantId(),
+		PartyID:       res.GetPartyId(),
+		Category:      enumName(res.GetCategory().String(), "LIMIT_CATEGORY_"),
+		Currency:      res.GetCurrency(),
+		Reference:     res.GetReference(),
+		Amount:        res.GetAmount(),
+		LimitIDs:      limitIDs,
+		Status:        enumName(res.GetStatus().String(), "RESERVATION_STATUS_"),
+		ExpiresAt:     formatTimestamp(res.GetExpiresAt()),
+		Version:       res.GetVersion(),
+		CreatedAt:     formatTimestamp(res.GetCreatedAt()),
+		UpdatedAt:     formatTimestamp(res.GetUpdatedAt()),
+	}
+}
@@ -0,0 +1,67 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const limitJSON = `{
+	"limit_id": "2d4f6b8c-0e1a-4c3d-9f5b-7c9e1a3d5f8b",
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"party_id": "a4c6e8b0-2d4f-4a1c-9e3b-5d7f9b1d3f6a",
+	"category": "LIMIT_CATEGORY_PAYMENTS",
+	"currency": "GBP",
+	"amount": "10000",
+	"utilized": "0",
+	"reserved": "0",
+	"available": "10000",
+	"version": 1,
+	"created_at": "2026-01-15T09:00:00Z",
+	"updated_at": "2026-01-15T09:00:00Z"
+}`
+
+func TestLimitsContract(t *testing.T) {
+	stub, conn := newContractStub(t, "limits-service")
+	p := NewLimitsProxy(conn, conn.Logger)
+	limitID := contractLimitID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "set a customer's payments limit",
+		Method:      "/bib.limits.v1.LimitsService/CreateLimit",
+		Response:    json.RawMessage(`{"limit": ` + limitJSON + `}`),
+	})
+	rec := call(t, p.CreateLimit, http.MethodPost, "/api/v1/limits", `{"party_id": "`+contractCustomerID.String()+`",
+		"category": "PAYMENTS", "currency": "GBP", "amount": "10000"}`)
+	if rec.Code != http.StatusCreated || rec.Header().Get("Location") != "/api/v1/limits/"+limitID {
+		t.Errorf("CreateLimit = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a limit",
+		State:       "a customer has a payments limit",
+		Method:      "/bib.limits.v1.LimitsService/GetLimit",
+		Response:    json.RawMessage(`{"limit": ` + limitJSON + `}`),
+	})
+	rec = call(t, p.GetLimit, http.MethodGet, "/api/v1/limits/"+limitID, "", "id", limitID)
+	if rec.Code != http.StatusOK {
+		t.Errorf("GetLimit = %d %s", rec.Code, rec.Body)
+	} else if limit, _ := decodeBody(t, rec)["limit"].(map[string]any); limit["category"] != "PAYMENTS" {
+		t.Errorf("GetLimit limit = %v", limit)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing limit",
+		Method:      "/bib.limits.v1.LimitsService/GetLimit",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetLimit, http.MethodGet, "/api/v1/limits/"+limitID, "", "id", limitID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetLimit of a missing limit = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-limits-service.json"))
+}
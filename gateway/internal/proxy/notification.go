@@ -0,0 +1,287 @@
+package proxy
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	notificationv1 "github.com/bibbank/bib/api/gen/go/bib/notification/v1"
+)
+
+// NotificationProxy proxies HTTP requests to the notification gRPC service.
+type NotificationProxy struct {
+	client notificationv1.NotificationServiceClient
+	logger *slog.Logger
+}
+
+// NewNotificationProxy creates a new notification service proxy.
+func NewNotificationProxy(conn *ServiceConn, logger *slog.Logger) *NotificationProxy {
+	return &NotificationProxy{client: notificationv1.NewNotificationServiceClient(conn), logger: logger}
+}
+
+type notificationTemplateResp struct {
+	TemplateID string `json:"template_id"`
+	EventType  string `json:"event_type"`
+	Channel    string `json:"channel"`
+	Subject    string `json:"subject,omitempty"`
+	Body       string `json:"body"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+	Version    int32  `json:"version"`
+}
+
+type upsertNotificationTemplateReq struct {
+	EventType string `json:"event_type"`
+	Channel   string `json:"channel"`
+	Subject   string `json:"subject,omitempty"`
+	Body      string `json:"body"`
+}
+
+type upsertNotificationTemplateResp struct {
+	Template *notificationTemplateResp `json:"template"`
+}
+
+type listNotificationTemplatesResp struct {
+	Templates []*notificationTemplateResp `json:"templates"`
+}
+
+type channelPreferencesResp struct {
+	CustomerID  string   `json:"customer_id"`
+	Email       string   `json:"email,omitempty"`
+	Phone       string   `json:"phone,omitempty"`
+	PushToken   string   `json:"push_token,omitempty"`
+	UpdatedAt   string   `json:"updated_at"`
+	Channels    []string `json:"channels"`
+	MutedEvents []string `json:"muted_events"`
+	Subjects    []string `json:"subjects"`
+	Version     int32    `json:"version"`
+}
+
+type setChannelPreferencesReq struct {
+	CustomerID  string   `json:"customer_id,omitempty"`
+	Email       string   `json:"email,omitempty"`
+	Phone       string   `json:"phone,omitempty"`
+	PushToken   string   `json:"push_token,omitempty"`
+	Channels    []string `json:"channels"`
+	MutedEvents []string `json:"muted_events,omitempty"`
+	Subjects    []string `json:"subjects,omitempty"`
+}
+
+type channelPreferencesEnvelope struct {
+	Preferences *channelPreferencesResp `json:"preferences"`
+}
+
+type notificationResp struct {
+	NotificationID    string `json:"notification_id"`
+	CustomerID        string `json:"customer_id"`
+	EventID           string `json:"event_id"`
+	EventType         string `json:"event_type"`
+	Channel           string `json:"channel"`
+	Recipient         string `json:"recipient"`
+	Subject           string `json:"subject,omitempty"`
+	Body              string `json:"body"`
+	Status            string `json:"status"`
+	NextAttemptAt     string `json:"next_attempt_at,omitempty"`
+	Provider          string `json:"provider,omitempty"`
+	ProviderMessageID string `json:"provider_message_id,omitempty"`
+	LastError         string `json:"last_error,omitempty"`
+	SentAt            string `json:"sent_at,omitempty"`
+	CreatedAt         string `json:"created_at"`
+	UpdatedAt         string `json:"updated_at"`
+	Attempts          int32  `json:"attempts"`
+}
+
+type getNotificationResp struct {
+	Notification *notificationResp `json:"notification"`
+}
+
+type listNotificationsResp struct {
+	Notifications []*notificationResp `json:"notifications"`
+}
+
+// UpsertTemplate handles PUT /api/v1/notifications/templates.
+func (p *NotificationProxy) UpsertTemplate(w http.ResponseWriter, r *http.Request) {
+	var req upsertNotificationTemplateReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.UpsertTemplate(r.Context(), &notificationv1.UpsertTemplateRequest{
+		EventType: req.EventType,
+		Channel:   notificationChannel(req.Channel),
+		Subject:   req.Subject,
+		Body:      req.Body,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, upsertNotificationTemplateResp{Template: toNotificationTemplateResp(resp.GetTemplate())})
+}
+
+// ListTemplates handles GET /api/v1/notifications/templates.
+func (p *NotificationProxy) ListTemplates(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.ListTemplates(r.Context(), &notificationv1.ListTemplatesRequest{})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listNotificationTemplatesResp{Templates: make([]*notificationTemplateResp, 0, len(resp.GetTemplates()))}
+	for _, t := range resp.GetTemplates() {
+		out.Templates = append(out.Templates, toNotificationTemplateResp(t))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// SetPreferences handles PUT /api/v1/notifications/preferences. Customers
+// set their own preferences; staff name the customer in the body.
+func (p *NotificationProxy) SetPreferences(w http.ResponseWriter, r *http.Request) {
+	var req setChannelPreferencesReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	channels := make([]notificationv1.Channel, 0, len(req.Channels))
+	for _, c := range req.Channels {
+		channels = append(channels, notificationChannel(c))
+	}
+	resp, err := p.client.SetPreferences(r.Context(), &notificationv1.SetPreferencesRequest{
+		CustomerId:  req.CustomerID,
+		Email:       req.Email,
+		Phone:       req.Phone,
+		PushToken:   req.PushToken,
+		Channels:    channels,
+		MutedEvents: req.MutedEvents,
+		Subjects:    req.Subjects,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, channelPreferencesEnvelope{Preferences: toChannelPreferencesResp(resp.GetPreferences())})
+}
+
+// GetPreferences handles GET /api/v1/notifications/preferences?customer_id=.
+func (p *NotificationProxy) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.GetPreferences(r.Context(), &notificationv1.GetPreferencesRequest{
+		CustomerId: r.URL.Query().Get("customer_id"),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, channelPreferencesEnvelope{Preferences: toChannelPreferencesResp(resp.GetPreferences())})
+}
+
+// ListNotifications handles GET /api/v1/notifications?customer_id=&limit=50.
+func (p *NotificationProxy) ListNotifications(w http.ResponseWriter, r *http.Request) {
+	req := &notificationv1.ListNotificationsRequest{CustomerId: r.URL.Query().Get("customer_id")}
+	if v := r.URL.Query().Get("limit"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || limit < 0 {
+			writeError(w, http.StatusBadRequest, "limit must be a non-negative integer")
+			return
+		}
+		req.Limit = int32(limit) //nolint:gosec // parsed with a 32-bit size
+	}
+
+	resp, err := p.client.ListNotifications(r.Context(), req)
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listNotificationsResp{Notifications: make([]*notificationResp, 0, len(resp.GetNotifications()))}
+	for _, n := range resp.GetNotifications() {
+		out.Notifications = append(out.Notifications, toNotificationResp(n))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetNotification handles GET /api/v1/notifications/{id}.
+func (p *NotificationProxy) GetNotification(w http.ResponseWriter, r *http.Request) {
+	notificationID := r.PathValue("id")
+	if notificationID == "" {
+		writeError(w, http.StatusBadRequest, "notification id is required")
+		return
+	}
+
+	resp, err := p.client.GetNotification(r.Context(), &notificationv1.GetNotificationRequest{NotificationId: notificationID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, getNotificationResp{Notification: toNotificationResp(resp.GetNotification())})
+}
+
+func notificationChannel(c string) notificationv1.Channel {
+	return notificationv1.Channel(notificationv1.Channel_value["CHANNEL_"+c])
+}
+
+func notificationChannelName(c notificationv1.Channel) string {
+	return strings.TrimPrefix(c.String(), "CHANNEL_")
+}
+
+func toNotificationTemplateResp(t *notificationv1.Template) *notificationTemplateResp {
+	if t == nil {
+		return nil
+	}
+	return &notificationTemplateResp{
+		TemplateID: t.GetTemplateId(),
+		EventType:  t.GetEventType(),
+		Channel:    notificationChannelName(t.GetChannel()),
+		Subject:    t.GetSubject(),
+		Body:       t.GetBody(),
+		CreatedAt:  formatTimestamp(t.GetCreatedAt()),
+		UpdatedAt:  formatTimestamp(t.GetUpdatedAt()),
+		Version:    t.GetVersion(),
+	}
+}
+
+func toChannelPreferencesResp(p *notificationv1.ChannelPreferences) *channelPreferencesResp {
+	if p == nil {
+		return nil
+	}
+	channels := make([]string, 0, len(p.GetChannels()))
+	for _, c := range p.GetChannels() {
+		channels = append(channels, notificationChannelName(c))
+	}
+	return &channelPreferencesResp{
+		CustomerID:  p.GetCustomerId(),
+		Email:       p.GetEmail(),
+		Phone:       p.GetPhone(),
+		PushToken:   p.GetPushToken(),
+		UpdatedAt:   formatTimestamp(p.GetUpdatedAt()),
+		Channels:    channels,
+		MutedEvents: p.GetMutedEvents(),
+		Subjects:    p.GetSubjects(),
+		Version:     p.GetVersion(),
+	}
+}
+
+func toNotificationResp(n *notificationv1.Notification) *notificationResp {
+	if n == nil {
+		return nil
+	}
+	return &notificationResp{
+		NotificationID:    n.GetNotificationId(),
+		CustomerID:        n.GetCustomerId(),
+		EventID:           n.GetEventId(),
+		EventType:         n.GetEventType(),
+		Channel:           notificationChannelName(n.GetChannel()),
+		Recipient:         n.GetRecipient(),
+		Subject:           n.GetSubject(),
+		Body:              n.GetBody(),
+		Status:            strings.TrimPrefix(n.GetStatus().String(), "DELIVERY_STATUS_"),
+		NextAttemptAt:     formatTimestamp(n.GetNextAttemptAt()),
+		Provider:          n.GetProvider(),
+		ProviderMessageID: n.GetProviderMessageId(),
+		LastError:         n.GetLastError(),
+		SentAt:            formatTimestamp(n.GetSentAt()),
+		CreatedAt:         formatTimestamp(n.GetCreatedAt()),
+		UpdatedAt:         formatTimestamp(n.GetUpdatedAt()),
+		Attempts:          n.GetAttempts(),
+	}
+}
@@ -0,0 +1,59 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+func TestNotificationContract(t *testing.T) {
+	stub, conn := newContractStub(t, "notification-service")
+	p := NewNotificationProxy(conn, conn.Logger)
+	customerID := contractCustomerID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "upsert an email template",
+		Method:      "/bib.notification.v1.NotificationService/UpsertTemplate",
+		Response: json.RawMessage(`{"template": {"template_id": "b1d3f5a7-9c2e-4b6d-8f0a-3c5e7a9c1e2b",
+			"event_type": "payment.completed", "channel": "CHANNEL_EMAIL", "subject": "Payment sent",
+			"body": "Your payment of {{.amount}} was sent.", "version": 1,
+			"created_at": "2026-01-15T09:00:00Z", "updated_at": "2026-01-15T09:00:00Z"}}`),
+	})
+	rec := call(t, p.UpsertTemplate, http.MethodPut, "/api/v1/notifications/templates", `{"event_type": "payment.completed",
+		"channel": "EMAIL", "subject": "Payment sent", "body": "Your payment of {{.amount}} was sent."}`)
+	if rec.Code != http.StatusOK {
+		t.Errorf("UpsertTemplate = %d %s", rec.Code, rec.Body)
+	} else if tmpl, _ := decodeBody(t, rec)["template"].(map[string]any); tmpl["channel"] != "EMAIL" {
+		t.Errorf("UpsertTemplate template = %v", tmpl)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a customer's channel preferences",
+		State:       "the customer set channel preferences",
+		Method:      "/bib.notification.v1.NotificationService/GetPreferences",
+		Response: json.RawMessage(`{"preferences": {"customer_id": "` + customerID + `",
+			"email": "ada@example.com", "channels": ["CHANNEL_EMAIL"], "version": 1,
+			"updated_at": "2026-01-15T09:00:00Z"}}`),
+	})
+	rec = call(t, p.GetPreferences, http.MethodGet, "/api/v1/notifications/preferences?customer_id="+customerID, "")
+	if rec.Code != http.StatusOK {
+		t.Errorf("GetPreferences = %d %s", rec.Code, rec.Body)
+	} else if prefs, _ := decodeBody(t, rec)["preferences"].(map[string]any); prefs["email"] != "ada@example.com" {
+		t.Errorf("GetPreferences preferences = %v", prefs)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get preferences of a customer who set none",
+		Method:      "/bib.notification.v1.NotificationService/GetPreferences",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetPreferences, http.MethodGet, "/api/v1/notifications/preferences?customer_id="+customerID, "")
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetPreferences of a customer who set none = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-notification-service.json"))
+}
@@ -0,0 +1,353 @@
+package proxy
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	openbankingv1 "github.com/bibbank/bib/api/gen/go/bib/openbanking/v1"
+)
+
+// OpenBankingProxy proxies HTTP requests to the open banking gRPC service:
+// the PSU's side of the consents third party providers request, and the
+// bank's oversight of those providers. Providers themselves call the
+// service's PSD2 API directly, with their eIDAS certificates.
+type OpenBankingProxy struct {
+	client openbankingv1.OpenBankingServiceClient
+	logger *slog.Logger
+}
+
+// NewOpenBankingProxy creates a new open banking service proxy.
+func NewOpenBankingProxy(conn *ServiceConn, logger *slog.Logger) *OpenBankingProxy {
+	return &OpenBankingProxy{client: openbankingv1.NewOpenBankingServiceClient(conn), logger: logger}
+}
+
+const consentStatusHint = "status must be RECEIVED, VALID, REJECTED, REVOKED, TERMINATED, EXPIRED or CONSUMED"
+
+type authoriseConsentReq struct {
+	AccountIDs []string `json:"account_ids,omitempty"`
+}
+
+type rejectConsentReq struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+type suspendProviderReq struct {
+	Reason string `json:"reason"`
+}
+
+type consentPaymentMsg struct {
+	DebtorAccountID       string `json:"debtor_account_id"`
+	Product               string `json:"product"`
+	Amount                string `json:"amount"`
+	Currency              string `json:"currency"`
+	CreditorAccountID     string `json:"creditor_account_id,omitempty"`
+	CreditorAccountNumber string `json:"creditor_account_number,omitempty"`
+	CreditorRoutingNumber string `json:"creditor_routing_number,omitempty"`
+	CreditorName          string `json:"creditor_name"`
+	CreditorCountry       string `json:"creditor_country,omitempty"`
+	RemittanceInformation string `json:"remittance_information,omitempty"`
+}
+
+type consentMsg struct {
+	ConsentID       string             `json:"consent_id"`
+	TenantID        string             `json:"tenant_id"`
+	ProviderID      string             `json:"provider_id"`
+	Type            string             `json:"type"`
+	Status          string             `json:"status"`
+	StatusReason    string             `json:"status_reason,omitempty"`
+	Permissions     []string           `json:"permissions,omitempty"`
+	AccountIDs      []string           `json:"account_ids,omitempty"`
+	ValidUntil      string             `json:"valid_until,omitempty"`
+	FrequencyPerDay int32              `json:"frequency_per_day,omitempty"`
+	Recurring       bool               `json:"recurring,omitempty"`
+	Payment         *consentPaymentMsg `json:"payment,omitempty"`
+	PSUID           string             `json:"psu_id,omitempty"`
+	PaymentID       string             `json:"payment_id,omitempty"`
+	AuthorisedAt    string             `json:"authorised_at,omitempty"`
+	Version         int32              `json:"version"`
+	CreatedAt       string             `json:"created_at"`
+	UpdatedAt       string             `json:"updated_at"`
+}
+
+type consentResp struct {
+	Consent *consentMsg `json:"consent"`
+}
+
+type listConsentsResp struct {
+	Consents   []*consentMsg `json:"consents"`
+	TotalCount int32         `json:"total_count"`
+}
+
+type providerMsg struct {
+	ProviderID             string   `json:"provider_id"`
+	TenantID               string   `json:"tenant_id"`
+	OrganizationID         string   `json:"organization_id"`
+	Name                   string   `json:"name"`
+	NCAName                string   `json:"nca_name"`
+	NCAID                  string   `json:"nca_id"`
+	Roles                  []string `json:"roles"`
+	CertificateFingerprint string   `json:"certificate_fingerprint"`
+	Status                 string   `json:"status"`
+	StatusReason           string   `json:"status_reason,omitempty"`
+	Version                int32    `json:"version"`
+	CreatedAt              string   `json:"created_at"`
+	UpdatedAt              string   `json:"updated_at"`
+}
+
+type providerResp struct {
+	Provider *providerMsg `json:"provider"`
+}
+
+type listProvidersResp struct {
+	Providers  []*providerMsg `json:"providers"`
+	TotalCount int32          `json:"total_count"`
+}
+
+// ListConsents handles GET /api/v1/open-banking/consents.
+// Query parameters: provider_id, psu_id, status, page_size, offset.
+func (p *OpenBankingProxy) ListConsents(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	var status openbankingv1.ConsentStatus
+	if v := q.Get("status"); v != "" {
+		s, found := openbankingv1.ConsentStatus_value["CONSENT_STATUS_"+strings.ToUpper(v)]
+		if !found || s == 0 {
+			writeError(w, http.StatusBadRequest, consentStatusHint)
+			return
+		}
+		status = openbankingv1.ConsentStatus(s)
+	}
+
+	resp, err := p.client.ListConsents(r.Context(), &openbankingv1.ListConsentsRequest{
+		ProviderId: q.Get("provider_id"),
+		PsuId:      q.Get("psu_id"),
+		Status:     status,
+		PageSize:   pageSize,
+		Offset:     offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listConsentsResp{
+		Consents:   make([]*consentMsg, 0, len(resp.GetConsents())),
+		TotalCount: resp.GetTotalCount(),
+	}
+	for _, c := range resp.GetConsents() {
+		out.Consents = append(out.Consents, toConsentMsg(c))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetConsent handles GET /api/v1/open-banking/consents/{id}.
+func (p *OpenBankingProxy) GetConsent(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "consent id is required")
+		return
+	}
+
+	resp, err := p.client.GetConsent(r.Context(), &openbankingv1.GetConsentRequest{ConsentId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, consentResp{Consent: toConsentMsg(resp.GetConsent())})
+}
+
+// AuthoriseConsent handles POST /api/v1/open-banking/consents/{id}/authorise:
+// the calling customer authorises a provider's consent. For an account
+// consent that names no accounts, account_ids selects the accounts granted.
+func (p *OpenBankingProxy) AuthoriseConsent(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "consent id is required")
+		return
+	}
+	var req authoriseConsentReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.AuthoriseConsent(r.Context(), &openbankingv1.AuthoriseConsentRequest{
+		ConsentId:  id,
+		AccountIds: req.AccountIDs,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, consentResp{Consent: toConsentMsg(resp.GetConsent())})
+}
+
+// RejectConsent handles POST /api/v1/open-banking/consents/{id}/reject.
+func (p *OpenBankingProxy) RejectConsent(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "consent id is required")
+		return
+	}
+	var req rejectConsentReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.RejectConsent(r.Context(), &openbankingv1.RejectConsentRequest{
+		ConsentId: id,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, consentResp{Consent: toConsentMsg(resp.GetConsent())})
+}
+
+// RevokeConsent handles POST /api/v1/open-banking/consents/{id}/revoke,
+// withdrawing a valid consent from its provider.
+func (p *OpenBankingProxy) RevokeConsent(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "consent id is required")
+		return
+	}
+
+	resp, err := p.client.RevokeConsent(r.Context(), &openbankingv1.RevokeConsentRequest{ConsentId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, consentResp{Consent: toConsentMsg(resp.GetConsent())})
+}
+
+// ListProviders handles GET /api/v1/open-banking/providers.
+// Query parameters: page_size, offset.
+func (p *OpenBankingProxy) ListProviders(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.ListProviders(r.Context(), &openbankingv1.ListProvidersRequest{
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listProvidersResp{
+		Providers:  make([]*providerMsg, 0, len(resp.GetProviders())),
+		TotalCount: resp.GetTotalCount(),
+	}
+	for _, pr := range resp.GetProviders() {
+		out.Providers = append(out.Providers, toProviderMsg(pr))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// SuspendProvider handles POST /api/v1/open-banking/providers/{id}/suspend,
+// blocking the provider from the PSD2 API. A reason is required.
+func (p *OpenBankingProxy) SuspendProvider(w http.ResponseWriter, r *http.Request) {
+	var req suspendProviderReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	p.setProviderStatus(w, r, true, req.Reason)
+}
+
+// ReinstateProvider handles POST /api/v1/open-banking/providers/{id}/reinstate.
+func (p *OpenBankingProxy) ReinstateProvider(w http.ResponseWriter, r *http.Request) {
+	p.setProviderStatus(w, r, false, "")
+}
+
+func (p *OpenBankingProxy) setProviderStatus(w http.ResponseWriter, r *http.Request, suspend bool, reason string) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "provider id is required")
+		return
+	}
+
+	resp, err := p.client.SetProviderStatus(r.Context(), &openbankingv1.SetProviderStatusRequest{
+		ProviderId: id,
+		Suspend:    suspend,
+		Reason:     reason,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, providerResp{Provider: toProviderMsg(resp.GetProvider())})
+}
+
+func toConsentMsg(c *openbankingv1.Consent) *consentMsg {
+	if c == nil {
+		return nil
+	}
+	out := &consentMsg{
+		ConsentID:       c.GetConsentId(),
+		TenantID:        c.GetTenantId(),
+		ProviderID:      c.GetProviderId(),
+		Type:            enumName(c.GetType().String(), "CONSENT_TYPE_"),
+		Status:          enumName(c.GetStatus().String(), "CONSENT_STATUS_"),
+		StatusReason:    c.GetStatusReason(),
+		Permissions:     c.GetPermissions(),
+		AccountIDs:      c.GetAccountIds(),
+		FrequencyPerDay: c.GetFrequencyPerDay(),
+		Recurring:       c.GetRecurring(),
+		PSUID:           c.GetPsuId(),
+		PaymentID:       c.GetPaymentId(),
+		ValidUntil:      formatTimestamp(c.GetValidUntil()),
+		AuthorisedAt:    formatTimestamp(c.GetAuthorisedAt()),
+		Version:         c.GetVersion(),
+		CreatedAt:       formatTimestamp(c.GetCreatedAt()),
+		UpdatedAt:       formatTimestamp(c.GetUpdatedAt()),
+	}
+	if pd := c.GetPayment(); pd != nil {
+		out.Payment = &consentPaymentMsg{
+			DebtorAccountID:       pd.GetDebtorAccountId(),
+			Product:               pd.GetProduct(),
+			Amount:                pd.GetAmount(),
+			Currency:              pd.GetCurrency(),
+			CreditorAccountID:     pd.GetCreditorAccountId(),
+			CreditorAccountNumber: pd.GetCreditorAccountNumber(),
+			CreditorRoutingNumber: pd.GetCreditorRoutingNumber(),
+			CreditorName:          pd.GetCreditorName(),
+			CreditorCountry:       pd.GetCreditorCountry(),
+			RemittanceInformation: pd.GetRemittanceInformation(),
+		}
+	}
+	return out
+}
+
+func toProviderMsg(pr *openbankingv1.Provider) *providerMsg {
+	if pr == nil {
+		return nil
+	}
+	roles := pr.GetRoles()
+	if roles == nil {
+		roles = []string{}
+	}
+	return &providerMsg{
+		ProviderID:             pr.GetProviderId(),
+		TenantID:               pr.GetTenantId(),
+		OrganizationID:         pr.GetOrganizationId(),
+		Name:                   pr.GetName(),
+		NCAName:                pr.GetNcaName(),
+		NCAID:                  pr.GetNcaId(),
+		Roles:                  roles,
+		CertificateFingerprint: pr.GetCertificateFingerprint(),
+		Status:                 enumName(pr.GetStatus().String(), "PROVIDER_STATUS_"),
+		StatusReason:           pr.GetStatusReason(),
+		Version:                pr.GetVersion(),
+		CreatedAt:              formatTimestamp(pr.GetCreatedAt()),
+		UpdatedAt:              formatTimestamp(pr.GetUpdatedAt()),
+	}
+}
@@ -0,0 +1,70 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const consentJSON = `{
+	"consent_id": "3e5a7c9d-1f2b-4d4e-8a6c-8d0f2b4e6a9c",
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"provider_id": "4f6b8d0e-2a3c-4e5f-9b7d-9e1a3c5f7b0d",
+	"type": "CONSENT_TYPE_ACCOUNTS",
+	"status": "CONSENT_STATUS_RECEIVED",
+	"permissions": ["READ_BALANCES"],
+	"valid_until": "2026-04-15T00:00:00Z",
+	"frequency_per_day": 4,
+	"version": 1,
+	"created_at": "2026-01-15T09:00:00Z",
+	"updated_at": "2026-01-15T09:00:00Z"
+}`
+
+func TestOpenBankingContract(t *testing.T) {
+	stub, conn := newContractStub(t, "openbanking-service")
+	p := NewOpenBankingProxy(conn, conn.Logger)
+	consentID := contractConsentID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "get a consent",
+		State:       "a provider requested account access",
+		Method:      "/bib.openbanking.v1.OpenBankingService/GetConsent",
+		Response:    json.RawMessage(`{"consent": ` + consentJSON + `}`),
+	})
+	rec := call(t, p.GetConsent, http.MethodGet, "/api/v1/open-banking/consents/"+consentID, "", "id", consentID)
+	if rec.Code != http.StatusOK {
+		t.Errorf("GetConsent = %d %s", rec.Code, rec.Body)
+	} else if consent, _ := decodeBody(t, rec)["consent"].(map[string]any); consent["status"] != "RECEIVED" {
+		t.Errorf("GetConsent consent = %v", consent)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "reject a consent",
+		State:       "a provider requested account access",
+		Method:      "/bib.openbanking.v1.OpenBankingService/RejectConsent",
+		Response: json.RawMessage(`{"consent": {"consent_id": "3e5a7c9d-1f2b-4d4e-8a6c-8d0f2b4e6a9c",
+			"status": "CONSENT_STATUS_REJECTED", "status_reason": "not requested by me", "version": 2}}`),
+	})
+	rec = call(t, p.RejectConsent, http.MethodPost, "/api/v1/open-banking/consents/"+consentID+"/reject",
+		`{"reason": "not requested by me"}`, "id", consentID)
+	if rec.Code != http.StatusOK {
+		t.Errorf("RejectConsent = %d %s", rec.Code, rec.Body)
+	} else if consent, _ := decodeBody(t, rec)["consent"].(map[string]any); consent["status"] != "REJECTED" {
+		t.Errorf("RejectConsent consent = %v", consent)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing consent",
+		Method:      "/bib.openbanking.v1.OpenBankingService/GetConsent",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetConsent, http.MethodGet, "/api/v1/open-banking/consents/"+consentID, "", "id", consentID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetConsent of a missing consent = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-openbanking-service.json"))
+}
@@ -8,6 +8,10 @@ import (
 	"net/http"
 	"sync"
 	"time"
+
+	accountv1 "github.com/bibbank/bib/api/gen/go/bib/account/v1"
+	ledgerv1 "github.com/bibbank/bib/api/gen/go/bib/ledger/v1"
+	paymentv1 "github.com/bibbank/bib/api/gen/go/bib/payment/v1"
 )
 
 // PartnerProxy handles embedded finance / partner API requests.
@@ -17,9 +21,9 @@ type PartnerProxy struct {
 	logger       *slog.Logger
 	partners     map[string]*PartnerConfig
 	webhooks     map[string][]WebhookRegistration
-	accountConn  *ServiceConn
-	paymentConn  *ServiceConn
-	ledgerConn   *ServiceConn
+	accounts     accountv1.AccountServiceClient
+	payments     paymentv1.PaymentServiceClient
+	ledger       ledgerv1.LedgerServiceClient
 	rateLimiters map[string]*partnerRateLimiter
 	mu           sync.RWMutex
 }
@@ -90,9 +94,9 @@ func NewPartnerProxy(
 		logger:       logger,
 		partners:     make(map[string]*PartnerConfig),
 		webhooks:     make(map[string][]WebhookRegistration),
-		accountConn:  accountConn,
-		paymentConn:  paymentConn,
-		ledgerConn:   ledgerConn,
+		accounts:     accountv1.NewAccountServiceClient(accountConn),
+		payments:     paymentv1.NewPaymentServiceClient(paymentConn),
+		ledger:       ledgerv1.NewLedgerServiceClient(ledgerConn),
 		rateLimiters: make(map[string]*partnerRateLimiter),
 	}
 }
@@ -152,17 +156,13 @@ func (p *PartnerProxy) CreateAccount(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var req map[string]interface{}
+	var req openAccountReq
 	if err = readJSON(r, &req); err != nil {
 		writeError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	// Add partner context
-	req["partner_id"] = partner.PartnerID
-
-	var resp map[string]interface{}
-	err = p.accountConn.Invoke(r.Context(), "/bib.account.v1.AccountService/OpenAccount", &req, &resp)
+	resp, err := p.accounts.OpenAccount(r.Context(), toOpenAccountMsg(req))
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
@@ -172,7 +172,7 @@ func (p *PartnerProxy) CreateAccount(w http.ResponseWriter, r *http.Request) {
 		"partner_id", partner.PartnerID,
 		"partner_name", partner.PartnerName,
 	)
-	writeJSON(w, http.StatusCreated, resp)
+	writeJSON(w, http.StatusCreated, toOpenAccountResp(resp.GetAccount()))
 }
 
 // InitiatePayment handles POST /api/v1/partner/payments.
@@ -187,16 +187,13 @@ func (p *PartnerProxy) InitiatePayment(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var req map[string]interface{}
+	var req initiatePaymentReq
 	if err = readJSON(r, &req); err != nil {
 		writeError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	req["partner_id"] = partner.PartnerID
-
-	var resp map[string]interface{}
-	err = p.paymentConn.Invoke(r.Context(), "/bib.payment.v1.PaymentService/InitiatePayment", &req, &resp)
+	resp, err := p.payments.InitiatePayment(r.Context(), req.toProto())
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
@@ -205,7 +202,7 @@ func (p *PartnerProxy) InitiatePayment(w http.ResponseWriter, r *http.Request) {
 	p.logger.Info("partner payment initiated",
 		"partner_id", partner.PartnerID,
 	)
-	writeJSON(w, http.StatusCreated, resp)
+	writeJSON(w, http.StatusCreated, toInitiatePaymentResp(resp.GetOrder()))
 }
 
 // GetBalance handles GET /api/v1/partner/balances/{account_code}.
@@ -226,18 +223,17 @@ func (p *PartnerProxy) GetBalance(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req := map[string]string{
-		"account_code": accountCode,
-		"partner_id":   partner.PartnerID,
+	req, ok := readBalanceRequest(w, r, accountCode)
+	if !ok {
+		return
 	}
-	var resp map[string]interface{}
-	err = p.ledgerConn.Invoke(r.Context(), "/bib.ledger.v1.LedgerService/GetBalance", &req, &resp)
+	resp, err := p.ledger.GetBalance(r.Context(), req)
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
 
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, toGetBalanceResp(resp))
 }
 
 // RegisterWebhook handles POST /api/v1/partner/webhooks.
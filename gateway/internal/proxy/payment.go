@@ -1,21 +1,29 @@
 package proxy
 
 import (
+	"errors"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	paymentv1 "github.com/bibbank/bib/api/gen/go/bib/payment/v1"
 	"github.com/bibbank/bib/pkg/auth"
 )
 
 // PaymentProxy proxies HTTP requests to the payment gRPC service.
 type PaymentProxy struct {
-	conn   *ServiceConn
+	client paymentv1.PaymentServiceClient
 	logger *slog.Logger
 }
 
 // NewPaymentProxy creates a new payment service proxy.
 func NewPaymentProxy(conn *ServiceConn, logger *slog.Logger) *PaymentProxy {
-	return &PaymentProxy{conn: conn, logger: logger}
+	return &PaymentProxy{client: paymentv1.NewPaymentServiceClient(conn), logger: logger}
 }
 
 type initiatePaymentReq struct {
@@ -38,25 +46,33 @@ type initiatePaymentResp struct {
 	CreatedAt string `json:"created_at"`
 }
 
+type counterpartyMsg struct {
+	Name             string `json:"name,omitempty"`
+	MaskedIdentifier string `json:"masked_identifier,omitempty"`
+	Category         string `json:"category"`
+	Logo             string `json:"logo,omitempty"`
+}
+
 type paymentOrderMsg struct {
-	RoutingNumber         string `json:"routing_number"`
-	Reference             string `json:"reference"`
-	SourceAccountID       string `json:"source_account_id"`
-	DestinationAccountID  string `json:"destination_account_id"`
-	Amount                string `json:"amount"`
-	Currency              string `json:"currency"`
-	Rail                  string `json:"rail"`
-	Status                string `json:"status"`
-	TenantID              string `json:"tenant_id"`
-	ID                    string `json:"id"`
-	ExternalAccountNumber string `json:"external_account_number"`
-	Description           string `json:"description"`
-	FailureReason         string `json:"failure_reason,omitempty"`
-	InitiatedAt           string `json:"initiated_at"`
-	SettledAt             string `json:"settled_at,omitempty"`
-	UpdatedAt             string `json:"updated_at"`
-	CreatedAt             string `json:"created_at"`
-	Version               int32  `json:"version"`
+	Counterparty          *counterpartyMsg `json:"counterparty,omitempty"`
+	RoutingNumber         string           `json:"routing_number"`
+	Reference             string           `json:"reference"`
+	SourceAccountID       string           `json:"source_account_id"`
+	DestinationAccountID  string           `json:"destination_account_id"`
+	Amount                string           `json:"amount"`
+	Currency              string           `json:"currency"`
+	Rail                  string           `json:"rail"`
+	Status                string           `json:"status"`
+	TenantID              string           `json:"tenant_id"`
+	ID                    string           `json:"id"`
+	ExternalAccountNumber string           `json:"external_account_number"`
+	Description           string           `json:"description"`
+	FailureReason         string           `json:"failure_reason,omitempty"`
+	InitiatedAt           string           `json:"initiated_at"`
+	SettledAt             string           `json:"settled_at,omitempty"`
+	UpdatedAt             string           `json:"updated_at"`
+	CreatedAt             string           `json:"created_at"`
+	Version               int32            `json:"version"`
 }
 
 type getPaymentResp struct {
@@ -82,13 +98,12 @@ func (p *PaymentProxy) InitiatePayment(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	var resp initiatePaymentResp
-	err := p.conn.Invoke(r.Context(), "/bib.payment.v1.PaymentService/InitiatePayment", &req, &resp)
+	resp, err := p.client.InitiatePayment(r.Context(), req.toProto())
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusCreated, resp)
+	writeJSON(w, http.StatusCreated, toInitiatePaymentResp(resp.GetOrder()))
 }
 
 // GetPayment handles GET /api/v1/payments/{id}.
@@ -99,34 +114,453 @@ func (p *PaymentProxy) GetPayment(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req := map[string]string{"payment_id": paymentID}
-	var resp getPaymentResp
-	err := p.conn.Invoke(r.Context(), "/bib.payment.v1.PaymentService/GetPayment", &req, &resp)
+	resp, err := p.client.GetPayment(r.Context(), &paymentv1.GetPaymentRequest{Id: paymentID})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, getPaymentResp{Payment: toPaymentOrderMsg(resp.GetOrder())})
 }
 
 // ListPayments handles GET /api/v1/payments.
+// Query parameters: account_id, page_size, offset.
 func (p *PaymentProxy) ListPayments(w http.ResponseWriter, r *http.Request) {
-	req := map[string]interface{}{
-		"tenant_id":  r.URL.Query().Get("tenant_id"),
-		"account_id": r.URL.Query().Get("account_id"),
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
 	}
 
-	if req["tenant_id"] == "" {
+	req := &paymentv1.ListPaymentsRequest{
+		TenantId:   r.URL.Query().Get("tenant_id"),
+		AccountId:  r.URL.Query().Get("account_id"),
+		Pagination: toPagination(pageSize, offset),
+	}
+	if req.TenantId == "" {
 		if claims, ok := auth.ClaimsFromContext(r.Context()); ok {
-			req["tenant_id"] = claims.TenantID.String()
+			req.TenantId = claims.TenantID.String()
+		}
+	}
+
+	resp, err := p.client.ListPayments(r.Context(), req)
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listPaymentsResp{
+		Payments:   make([]paymentOrderMsg, 0, len(resp.GetOrders())),
+		TotalCount: resp.GetPagination().GetTotalCount(),
+	}
+	for _, o := range resp.GetOrders() {
+		out.Payments = append(out.Payments, toPaymentOrderMsg(o))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+type reversePaymentReq struct {
+	PaymentID string `json:"payment_id"`
+	Reason    string `json:"reason"`
+}
+
+// ReversePayment handles POST /api/v1/payments/{id}/reverse, returning a
+// settled payment's funds to its source account.
+func (p *PaymentProxy) ReversePayment(w http.ResponseWriter, r *http.Request) {
+	var req reversePaymentReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.PaymentID = r.PathValue("id")
+	if req.PaymentID == "" {
+		writeError(w, http.StatusBadRequest, "payment id is required")
+		return
+	}
+
+	resp, err := p.client.ReversePayment(r.Context(), &paymentv1.ReversePaymentRequest{
+		PaymentId: req.PaymentID,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, getPaymentResp{Payment: toPaymentOrderMsg(resp.GetPayment())})
+}
+
+type schedulePaymentReq struct {
+	SourceAccountID       string `json:"source_account_id"`
+	DestinationAccountID  string `json:"destination_account_id,omitempty"`
+	Amount                string `json:"amount"`
+	Currency              string `json:"currency"`
+	RoutingNumber         string `json:"routing_number,omitempty"`
+	ExternalAccountNumber string `json:"external_account_number,omitempty"`
+	DestinationCountry    string `json:"destination_country,omitempty"`
+	Reference             string `json:"reference,omitempty"`
+	Description           string `json:"description,omitempty"`
+	Frequency             string `json:"frequency"`
+	CronExpression        string `json:"cron_expression,omitempty"`
+	StartAt               string `json:"start_at"`
+	EndAt                 string `json:"end_at,omitempty"`
+	Interval              int32  `json:"interval,omitempty"`
+	MaxRuns               int32  `json:"max_runs,omitempty"`
+}
+
+type paymentScheduleMsg struct {
+	ID                    string `json:"id"`
+	TenantID              string `json:"tenant_id"`
+	SourceAccountID       string `json:"source_account_id"`
+	DestinationAccountID  string `json:"destination_account_id"`
+	Amount                string `json:"amount"`
+	Currency              string `json:"currency"`
+	RoutingNumber         string `json:"routing_number"`
+	ExternalAccountNumber string `json:"external_account_number"`
+	DestinationCountry    string `json:"destination_country,omitempty"`
+	Reference             string `json:"reference"`
+	Description           string `json:"description"`
+	Frequency             string `json:"frequency"`
+	CronExpression        string `json:"cron_expression,omitempty"`
+	StartAt               string `json:"start_at"`
+	EndAt                 string `json:"end_at,omitempty"`
+	Status                string `json:"status"`
+	NextRunAt             string `json:"next_run_at,omitempty"`
+	LastPaymentID         string `json:"last_payment_id,omitempty"`
+	LastRunAt             string `json:"last_run_at,omitempty"`
+	LastFailure           string `json:"last_failure,omitempty"`
+	CreatedAt             string `json:"created_at"`
+	UpdatedAt             string `json:"updated_at"`
+	Interval              int32  `json:"interval,omitempty"`
+	MaxRuns               int32  `json:"max_runs,omitempty"`
+	RunCount              int32  `json:"run_count"`
+}
+
+type listPaymentSchedulesResp struct {
+	Schedules  []paymentScheduleMsg `json:"schedules"`
+	TotalCount int32                `json:"total_count"`
+}
+
+// SchedulePayment handles POST /api/v1/payment-schedules, creating a
+// standing order that initiates a payment on each run of its recurrence.
+func (p *PaymentProxy) SchedulePayment(w http.ResponseWriter, r *http.Request) {
+	var req schedulePaymentReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	frequency, ok := readPaymentEnum(w, "frequency", req.Frequency, "SCHEDULE_FREQUENCY_", paymentv1.ScheduleFrequency_value)
+	if !ok {
+		return
+	}
+	startAt, ok := readPaymentTime(w, "start_at", req.StartAt)
+	if !ok {
+		return
+	}
+	endAt, ok := readPaymentTime(w, "end_at", req.EndAt)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.SchedulePayment(r.Context(), &paymentv1.SchedulePaymentRequest{
+		SourceAccountId:       req.SourceAccountID,
+		DestinationAccountId:  req.DestinationAccountID,
+		Amount:                &commonv1.Money{Amount: req.Amount, Currency: req.Currency},
+		RoutingNumber:         req.RoutingNumber,
+		ExternalAccountNumber: req.ExternalAccountNumber,
+		DestinationCountry:    req.DestinationCountry,
+		Reference:             req.Reference,
+		Description:           req.Description,
+		Frequency:             paymentv1.ScheduleFrequency(frequency),
+		Interval:              req.Interval,
+		CronExpression:        req.CronExpression,
+		StartAt:               startAt,
+		EndAt:                 endAt,
+		MaxRuns:               req.MaxRuns,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toPaymentScheduleMsg(resp.GetSchedule()))
+}
+
+// ListPaymentSchedules handles GET /api/v1/payment-schedules.
+// Query parameters: page_size, offset.
+func (p *PaymentProxy) ListPaymentSchedules(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.ListPaymentSchedules(r.Context(), &paymentv1.ListPaymentSchedulesRequest{
+		Pagination: toPagination(pageSize, offset),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listPaymentSchedulesResp{
+		Schedules:  make([]paymentScheduleMsg, 0, len(resp.GetSchedules())),
+		TotalCount: resp.GetPagination().GetTotalCount(),
+	}
+	for _, sch := range resp.GetSchedules() {
+		out.Schedules = append(out.Schedules, toPaymentScheduleMsg(sch))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// CancelPaymentSchedule handles POST /api/v1/payment-schedules/{id}/cancel.
+func (p *PaymentProxy) CancelPaymentSchedule(w http.ResponseWriter, r *http.Request) {
+	scheduleID := r.PathValue("id")
+	if scheduleID == "" {
+		writeError(w, http.StatusBadRequest, "schedule id is required")
+		return
+	}
+
+	resp, err := p.client.CancelPaymentSchedule(r.Context(), &paymentv1.CancelPaymentScheduleRequest{ScheduleId: scheduleID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, toPaymentScheduleMsg(resp.GetSchedule()))
+}
+
+// maxBatchFileBytes mirrors the payment service's bulk payment file limit.
+const maxBatchFileBytes = 2 << 20
+
+type batchEntryErrorMsg struct {
+	Reference       string `json:"reference"`
+	BeneficiaryName string `json:"beneficiary_name,omitempty"`
+	Amount          string `json:"amount"`
+	Currency        string `json:"currency"`
+	Status          string `json:"status"`
+	Error           string `json:"error"`
+	PaymentID       string `json:"payment_id,omitempty"`
+	Sequence        int32  `json:"sequence"`
+}
+
+type paymentBatchMsg struct {
+	ID              string               `json:"id"`
+	TenantID        string               `json:"tenant_id"`
+	SourceAccountID string               `json:"source_account_id"`
+	Format          string               `json:"format"`
+	FileName        string               `json:"file_name"`
+	Status          string               `json:"status"`
+	CreatedAt       string               `json:"created_at"`
+	Errors          []batchEntryErrorMsg `json:"errors"`
+	TotalEntries    int32                `json:"total_entries"`
+	RejectedEntries int32                `json:"rejected_entries"`
+	PendingEntries  int32                `json:"pending_entries"`
+	SettledEntries  int32                `json:"settled_entries"`
+	FailedEntries   int32                `json:"failed_entries"`
+}
+
+// ImportPaymentBatch handles POST /api/v1/payment-batches. It accepts
+// multipart/form-data with a "file" part holding a NACHA or pain.001 file,
+// a "format" field of NACHA or PAIN001 and the "source_account_id" the
+// payments debit.
+func (p *PaymentProxy) ImportPaymentBatch(w http.ResponseWriter, r *http.Request) {
+	// Allow headroom for multipart framing around the file.
+	r.Body = http.MaxBytesReader(w, r.Body, maxBatchFileBytes+1<<20)
+	if err := r.ParseMultipartForm(maxBatchFileBytes); err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			writeError(w, http.StatusRequestEntityTooLarge, "payment file is too large")
+			return
 		}
+		writeError(w, http.StatusBadRequest, "invalid multipart form")
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }() //nolint:errcheck // best-effort temp file cleanup
+
+	content, fileName, err := readFormFile(r, "file", maxBatchFileBytes)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	format, ok := readPaymentEnum(w, "format", r.FormValue("format"), "BATCH_FILE_FORMAT_", paymentv1.BatchFileFormat_value)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.ImportPaymentBatch(r.Context(), &paymentv1.ImportPaymentBatchRequest{
+		SourceAccountId: r.FormValue("source_account_id"),
+		Format:          paymentv1.BatchFileFormat(format),
+		FileName:        fileName,
+		Content:         content,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusCreated, toPaymentBatchMsg(resp.GetBatch()))
+}
+
+// GetPaymentBatch handles GET /api/v1/payment-batches/{id}, returning a
+// batch's progress and its error report.
+func (p *PaymentProxy) GetPaymentBatch(w http.ResponseWriter, r *http.Request) {
+	batchID := r.PathValue("id")
+	if batchID == "" {
+		writeError(w, http.StatusBadRequest, "batch id is required")
+		return
 	}
 
-	var resp listPaymentsResp
-	err := p.conn.Invoke(r.Context(), "/bib.payment.v1.PaymentService/ListPayments", &req, &resp)
+	resp, err := p.client.GetPaymentBatch(r.Context(), &paymentv1.GetPaymentBatchRequest{BatchId: batchID})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, toPaymentBatchMsg(resp.GetBatch()))
+}
+
+func (req initiatePaymentReq) toProto() *paymentv1.InitiatePaymentRequest {
+	return &paymentv1.InitiatePaymentRequest{
+		TenantId:              req.TenantID,
+		SourceAccountId:       req.SourceAccountID,
+		DestinationAccountId:  req.DestinationAccountID,
+		Amount:                &commonv1.Money{Amount: req.Amount, Currency: req.Currency},
+		RoutingNumber:         req.RoutingNumber,
+		ExternalAccountNumber: req.ExternalAccountNumber,
+		DestinationCountry:    req.DestinationCountry,
+		Reference:             req.Reference,
+		Description:           req.Description,
+	}
+}
+
+// readPaymentEnum maps an optional enum field to its proto value, writing a
+// 400 when it names no value.
+func readPaymentEnum(w http.ResponseWriter, field, name, prefix string, values map[string]int32) (int32, bool) {
+	if name == "" {
+		return 0, true
+	}
+	v, ok := values[prefix+strings.ToUpper(name)]
+	if !ok || v == 0 {
+		writeError(w, http.StatusBadRequest, "invalid "+field)
+		return 0, false
+	}
+	return v, true
+}
+
+// readPaymentTime parses an optional RFC 3339 field, writing a 400 when it
+// is malformed.
+func readPaymentTime(w http.ResponseWriter, field, value string) (*timestamppb.Timestamp, bool) {
+	if value == "" {
+		return nil, true
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, field+" must be an RFC 3339 timestamp")
+		return nil, false
+	}
+	return timestamppb.New(t), true
+}
+
+// toPagination pages from offset; the payment service's page token is the
+// offset of the page.
+func toPagination(pageSize, offset int32) *commonv1.Pagination {
+	page := &commonv1.Pagination{PageSize: pageSize}
+	if offset > 0 {
+		page.PageToken = strconv.Itoa(int(offset))
+	}
+	return page
+}
+
+func toInitiatePaymentResp(o *paymentv1.PaymentOrder) initiatePaymentResp {
+	return initiatePaymentResp{
+		ID:        o.GetId(),
+		Status:    enumName(o.GetStatus().String(), "PAYMENT_STATUS_"),
+		Rail:      enumName(o.GetRail().String(), "PAYMENT_RAIL_"),
+		CreatedAt: formatTimestamp(o.GetAudit().GetCreatedAt()),
+	}
+}
+
+func toPaymentOrderMsg(o *paymentv1.PaymentOrder) paymentOrderMsg {
+	msg := paymentOrderMsg{
+		ID:                    o.GetId(),
+		TenantID:              o.GetTenantId(),
+		SourceAccountID:       o.GetSourceAccountId(),
+		DestinationAccountID:  o.GetDestinationAccountId(),
+		Amount:                o.GetAmount().GetAmount(),
+		Currency:              o.GetAmount().GetCurrency(),
+		Rail:                  enumName(o.GetRail().String(), "PAYMENT_RAIL_"),
+		Status:                enumName(o.GetStatus().String(), "PAYMENT_STATUS_"),
+		RoutingNumber:         o.GetRoutingNumber(),
+		ExternalAccountNumber: o.GetExternalAccountNumber(),
+		Reference:             o.GetReference(),
+		Description:           o.GetDescription(),
+		FailureReason:         o.GetFailureReason(),
+		InitiatedAt:           formatTimestamp(o.GetInitiatedAt()),
+		SettledAt:             formatTimestamp(o.GetSettledAt()),
+		CreatedAt:             formatTimestamp(o.GetAudit().GetCreatedAt()),
+		UpdatedAt:             formatTimestamp(o.GetAudit().GetUpdatedAt()),
+		Version:               o.GetAudit().GetVersion(),
+	}
+	if c := o.GetCounterparty(); c != nil {
+		msg.Counterparty = &counterpartyMsg{
+			Name:             c.GetName(),
+			MaskedIdentifier: c.GetMaskedIdentifier(),
+			Category:         c.GetCategory(),
+			Logo:             c.GetLogo(),
+		}
+	}
+	return msg
+}
+
+func toPaymentScheduleMsg(sch *paymentv1.PaymentSchedule) paymentScheduleMsg {
+	return paymentScheduleMsg{
+		ID:                    sch.GetId(),
+		TenantID:              sch.GetTenantId(),
+		SourceAccountID:       sch.GetSourceAccountId(),
+		DestinationAccountID:  sch.GetDestinationAccountId(),
+		Amount:                sch.GetAmount().GetAmount(),
+		Currency:              sch.GetAmount().GetCurrency(),
+		RoutingNumber:         sch.GetRoutingNumber(),
+		ExternalAccountNumber: sch.GetExternalAccountNumber(),
+		DestinationCountry:    sch.GetDestinationCountry(),
+		Reference:             sch.GetReference(),
+		Description:           sch.GetDescription(),
+		Frequency:             enumName(sch.GetFrequency().String(), "SCHEDULE_FREQUENCY_"),
+		CronExpression:        sch.GetCronExpression(),
+		StartAt:               formatTimestamp(sch.GetStartAt()),
+		EndAt:                 formatTimestamp(sch.GetEndAt()),
+		Status:                enumName(sch.GetStatus().String(), "SCHEDULE_STATUS_"),
+		NextRunAt:             formatTimestamp(sch.GetNextRunAt()),
+		LastPaymentID:         sch.GetLastPaymentId(),
+		LastRunAt:             formatTimestamp(sch.GetLastRunAt()),
+		LastFailure:           sch.GetLastFailure(),
+		CreatedAt:             formatTimestamp(sch.GetAudit().GetCreatedAt()),
+		UpdatedAt:             formatTimestamp(sch.GetAudit().GetUpdatedAt()),
+		Interval:              sch.GetInterval(),
+		MaxRuns:               sch.GetMaxRuns(),
+		RunCount:              sch.GetRunCount(),
+	}
+}
+
+func toPaymentBatchMsg(b *paymentv1.PaymentBatch) paymentBatchMsg {
+	msg := paymentBatchMsg{
+		ID:              b.GetId(),
+		TenantID:        b.GetTenantId(),
+		SourceAccountID: b.GetSourceAccountId(),
+		Format:          enumName(b.GetFormat().String(), "BATCH_FILE_FORMAT_"),
+		FileName:        b.GetFileName(),
+		Status:          enumName(b.GetStatus().String(), "BATCH_STATUS_"),
+		CreatedAt:       formatTimestamp(b.GetCreatedAt()),
+		Errors:          make([]batchEntryErrorMsg, 0, len(b.GetErrors())),
+		TotalEntries:    b.GetTotalEntries(),
+		RejectedEntries: b.GetRejectedEntries(),
+		PendingEntries:  b.GetPendingEntries(),
+		SettledEntries:  b.GetSettledEntries(),
+		FailedEntries:   b.GetFailedEntries(),
+	}
+	for _, e := range b.GetErrors() {
+		msg.Errors = append(msg.Errors, batchEntryErrorMsg{
+			Reference:       e.GetReference(),
+			BeneficiaryName: e.GetBeneficiaryName(),
+			Amount:          e.GetAmount().GetAmount(),
+			Currency:        e.GetAmount().GetCurrency(),
+			Status:          e.GetStatus(),
+			Error:           e.GetError(),
+			PaymentID:       e.GetPaymentId(),
+			Sequence:        e.GetSequence(),
+		})
+	}
+	return msg
 }
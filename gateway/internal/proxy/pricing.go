@@ -0,0 +1,476 @@
+package proxy
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pricingv1 "github.com/bibbank/bib/api/gen/go/bib/pricing/v1"
+)
+
+// PricingProxy proxies HTTP requests to the pricing gRPC service.
+type PricingProxy struct {
+	client pricingv1.PricingServiceClient
+	logger *slog.Logger
+}
+
+// NewPricingProxy creates a new pricing service proxy.
+func NewPricingProxy(conn *ServiceConn, logger *slog.Logger) *PricingProxy {
+	return &PricingProxy{client: pricingv1.NewPricingServiceClient(conn), logger: logger}
+}
+
+const (
+	pricingProductHint = "product must be PAYMENTS, CARDS, FX or DEPOSITS"
+	pricingChannelHint = "channel must be ANY, BRANCH, ONLINE, MOBILE, API, ATM or POS"
+)
+
+type feeRuleMsg struct {
+	FeeCode  string `json:"fee_code"`
+	Currency string `json:"currency"`
+	Fixed    string `json:"fixed,omitempty"`
+	Rate     string `json:"rate,omitempty"`
+	Minimum  string `json:"minimum,omitempty"`
+	Maximum  string `json:"maximum,omitempty"`
+}
+
+type createFeeScheduleReq struct {
+	Product       string        `json:"product"`
+	Channel       string        `json:"channel"`
+	EffectiveFrom string        `json:"effective_from,omitempty"`
+	Rules         []*feeRuleMsg `json:"rules"`
+}
+
+type reviseFeeScheduleReq struct {
+	EffectiveFrom string        `json:"effective_from,omitempty"`
+	Rules         []*feeRuleMsg `json:"rules"`
+}
+
+type evaluateFeeReq struct {
+	Product   string `json:"product"`
+	Channel   string `json:"channel"`
+	FeeCode   string `json:"fee_code"`
+	Currency  string `json:"currency"`
+	Amount    string `json:"amount"`
+	Reference string `json:"reference,omitempty"`
+	At        string `json:"at,omitempty"`
+}
+
+type simulateFeeChangeReq struct {
+	ScheduleID string        `json:"schedule_id,omitempty"`
+	Product    string        `json:"product,omitempty"`
+	Channel    string        `json:"channel,omitempty"`
+	Rules      []*feeRuleMsg `json:"rules,omitempty"`
+	From       string        `json:"from,omitempty"`
+	To         string        `json:"to,omitempty"`
+}
+
+type feeScheduleMsg struct {
+	ScheduleID    string        `json:"schedule_id"`
+	TenantID      string        `json:"tenant_id"`
+	Product       string        `json:"product"`
+	Channel       string        `json:"channel"`
+	EffectiveFrom string        `json:"effective_from"`
+	Rules         []*feeRuleMsg `json:"rules"`
+	Version       int32         `json:"version"`
+	CreatedAt     string        `json:"created_at"`
+	UpdatedAt     string        `json:"updated_at"`
+}
+
+type feeScheduleResp struct {
+	Schedule *feeScheduleMsg `json:"schedule"`
+}
+
+type listFeeSchedulesResp struct {
+	Schedules  []*feeScheduleMsg `json:"schedules"`
+	TotalCount int32             `json:"total_count"`
+}
+
+type feeAssessmentMsg struct {
+	AssessmentID string `json:"assessment_id"`
+	TenantID     string `json:"tenant_id"`
+	Reference    string `json:"reference"`
+	Product      string `json:"product"`
+	Channel      string `json:"channel"`
+	FeeCode      string `json:"fee_code"`
+	Currency     string `json:"currency"`
+	Amount       string `json:"amount"`
+	Fee          string `json:"fee"`
+	ScheduleID   string `json:"schedule_id,omitempty"`
+	AssessedAt   string `json:"assessed_at"`
+}
+
+type evaluateFeeResp struct {
+	Fee        string            `json:"fee"`
+	Currency   string            `json:"currency"`
+	Priced     bool              `json:"priced"`
+	ScheduleID string            `json:"schedule_id,omitempty"`
+	Rule       *feeRuleMsg       `json:"rule,omitempty"`
+	Assessment *feeAssessmentMsg `json:"assessment,omitempty"`
+}
+
+type feeImpactMsg struct {
+	FeeCode      string `json:"fee_code"`
+	Currency     string `json:"currency"`
+	Count        int32  `json:"count"`
+	Volume       string `json:"volume"`
+	CurrentFees  string `json:"current_fees"`
+	ProposedFees string `json:"proposed_fees"`
+	Difference   string `json:"difference"`
+}
+
+type simulateFeeChangeResp struct {
+	From    string          `json:"from"`
+	To      string          `json:"to"`
+	Impacts []*feeImpactMsg `json:"impacts"`
+}
+
+// CreateFeeSchedule handles POST /api/v1/pricing/schedules. effective_from
+// is RFC 3339; when empty the schedule takes effect at once.
+func (p *PricingProxy) CreateFeeSchedule(w http.ResponseWriter, r *http.Request) {
+	var req createFeeScheduleReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	product, found := pricingProduct(req.Product)
+	if !found {
+		writeError(w, http.StatusBadRequest, pricingProductHint)
+		return
+	}
+	channel, found := pricingChannel(req.Channel)
+	if !found {
+		writeError(w, http.StatusBadRequest, pricingChannelHint)
+		return
+	}
+	effectiveFrom, ok := readPricingTime(w, "effective_from", req.EffectiveFrom)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.CreateFeeSchedule(r.Context(), &pricingv1.CreateFeeScheduleRequest{
+		Product:       product,
+		Channel:       channel,
+		EffectiveFrom: effectiveFrom,
+		Rules:         toFeeRules(req.Rules),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	schedule := toFeeScheduleMsg(resp.GetSchedule())
+	w.Header().Set("Location", "/api/v1/pricing/schedules/"+url.PathEscape(schedule.ScheduleID))
+	writeJSON(w, http.StatusCreated, feeScheduleResp{Schedule: schedule})
+}
+
+// ListFeeSchedules handles GET /api/v1/pricing/schedules.
+// Query parameters: product, channel, page_size, offset.
+func (p *PricingProxy) ListFeeSchedules(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	var product pricingv1.Product
+	if v := q.Get("product"); v != "" {
+		var found bool
+		if product, found = pricingProduct(v); !found {
+			writeError(w, http.StatusBadRequest, pricingProductHint)
+			return
+		}
+	}
+	var channel pricingv1.Channel
+	if v := q.Get("channel"); v != "" {
+		var found bool
+		if channel, found = pricingChannel(v); !found {
+			writeError(w, http.StatusBadRequest, pricingChannelHint)
+			return
+		}
+	}
+
+	resp, err := p.client.ListFeeSchedules(r.Context(), &pricingv1.ListFeeSchedulesRequest{
+		Product:  product,
+		Channel:  channel,
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listFeeSchedulesResp{
+		Schedules:  make([]*feeScheduleMsg, 0, len(resp.GetSchedules())),
+		TotalCount: resp.GetTotalCount(),
+	}
+	for _, s := range resp.GetSchedules() {
+		out.Schedules = append(out.Schedules, toFeeScheduleMsg(s))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetFeeSchedule handles GET /api/v1/pricing/schedules/{id}.
+func (p *PricingProxy) GetFeeSchedule(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "schedule id is required")
+		return
+	}
+
+	resp, err := p.client.GetFeeSchedule(r.Context(), &pricingv1.GetFeeScheduleRequest{ScheduleId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, feeScheduleResp{Schedule: toFeeScheduleMsg(resp.GetSchedule())})
+}
+
+// ReviseFeeSchedule handles PUT /api/v1/pricing/schedules/{id}, replacing
+// the rules of a schedule not yet in effect. An empty effective_from keeps
+// the schedule's effective date.
+func (p *PricingProxy) ReviseFeeSchedule(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "schedule id is required")
+		return
+	}
+	var req reviseFeeScheduleReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	effectiveFrom, ok := readPricingTime(w, "effective_from", req.EffectiveFrom)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.ReviseFeeSchedule(r.Context(), &pricingv1.ReviseFeeScheduleRequest{
+		ScheduleId:    id,
+		EffectiveFrom: effectiveFrom,
+		Rules:         toFeeRules(req.Rules),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, feeScheduleResp{Schedule: toFeeScheduleMsg(resp.GetSchedule())})
+}
+
+// EvaluateFee handles POST /api/v1/pricing/evaluate. Without a reference
+// the fee is only quoted; with one it is recorded as charged, and a
+// repeated request returns the fee first charged.
+func (p *PricingProxy) EvaluateFee(w http.ResponseWriter, r *http.Request) {
+	var req evaluateFeeReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	product, found := pricingProduct(req.Product)
+	if !found {
+		writeError(w, http.StatusBadRequest, pricingProductHint)
+		return
+	}
+	channel, found := pricingChannel(req.Channel)
+	if !found {
+		writeError(w, http.StatusBadRequest, pricingChannelHint)
+		return
+	}
+	at, ok := readPricingTime(w, "at", req.At)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.EvaluateFee(r.Context(), &pricingv1.EvaluateFeeRequest{
+		Product:   product,
+		Channel:   channel,
+		FeeCode:   req.FeeCode,
+		Currency:  req.Currency,
+		Amount:    req.Amount,
+		Reference: req.Reference,
+		At:        at,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, evaluateFeeResp{
+		Fee:        resp.GetFee(),
+		Currency:   resp.GetCurrency(),
+		Priced:     resp.GetPriced(),
+		ScheduleID: resp.GetScheduleId(),
+		Rule:       toFeeRuleMsg(resp.GetRule()),
+		Assessment: toFeeAssessmentMsg(resp.GetAssessment()),
+	})
+}
+
+// SimulateFeeChange handles POST /api/v1/pricing/simulate, repricing the
+// fees recorded between from and to under a schedule or proposed rules.
+func (p *PricingProxy) SimulateFeeChange(w http.ResponseWriter, r *http.Request) {
+	var req simulateFeeChangeReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var product pricingv1.Product
+	if req.Product != "" {
+		var found bool
+		if product, found = pricingProduct(req.Product); !found {
+			writeError(w, http.StatusBadRequest, pricingProductHint)
+			return
+		}
+	}
+	var channel pricingv1.Channel
+	if req.Channel != "" {
+		var found bool
+		if channel, found = pricingChannel(req.Channel); !found {
+			writeError(w, http.StatusBadRequest, pricingChannelHint)
+			return
+		}
+	}
+	from, ok := readPricingTime(w, "from", req.From)
+	if !ok {
+		return
+	}
+	to, ok := readPricingTime(w, "to", req.To)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.SimulateFeeChange(r.Context(), &pricingv1.SimulateFeeChangeRequest{
+		ScheduleId: req.ScheduleID,
+		Product:    product,
+		Channel:    channel,
+		Rules:      toFeeRules(req.Rules),
+		From:       from,
+		To:         to,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := simulateFeeChangeResp{
+		From:    formatTimestamp(resp.GetFrom()),
+		To:      formatTimestamp(resp.GetTo()),
+		Impacts: make([]*feeImpactMsg, 0, len(resp.GetImpacts())),
+	}
+	for _, i := range resp.GetImpacts() {
+		out.Impacts = append(out.Impacts, &feeImpactMsg{
+			FeeCode:      i.GetFeeCode(),
+			Currency:     i.GetCurrency(),
+			Count:        i.GetCount(),
+			Volume:       i.GetVolume(),
+			CurrentFees:  i.GetCurrentFees(),
+			ProposedFees: i.GetProposedFees(),
+			Difference:   i.GetDifference(),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// pricingProduct converts a product name to its enum value; the product is
+// required.
+func pricingProduct(name string) (pricingv1.Product, bool) {
+	v, ok := pricingv1.Product_value["PRODUCT_"+strings.ToUpper(name)]
+	if !ok || v == 0 {
+		return pricingv1.Product_PRODUCT_UNSPECIFIED, false
+	}
+	return pricingv1.Product(v), true
+}
+
+// pricingChannel converts a channel name to its enum value; the channel is
+// required.
+func pricingChannel(name string) (pricingv1.Channel, bool) {
+	v, ok := pricingv1.Channel_value["CHANNEL_"+strings.ToUpper(name)]
+	if !ok || v == 0 {
+		return pricingv1.Channel_CHANNEL_UNSPECIFIED, false
+	}
+	return pricingv1.Channel(v), true
+}
+
+// readPricingTime parses an optional RFC 3339 field, writing a 400 when it
+// is malformed.
+func readPricingTime(w http.ResponseWriter, field, value string) (*timestamppb.Timestamp, bool) {
+	if value == "" {
+		return nil, true
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, field+" must be an RFC 3339 timestamp")
+		return nil, false
+	}
+	return timestamppb.New(t), true
+}
+
+func toFeeRules(rules []*feeRuleMsg) []*pricingv1.FeeRule {
+	out := make([]*pricingv1.FeeRule, 0, len(rules))
+	for _, r := range rules {
+		if r == nil {
+			continue
+		}
+		out = append(out, &pricingv1.FeeRule{
+			FeeCode:  r.FeeCode,
+			Currency: r.Currency,
+			Fixed:    r.Fixed,
+			Rate:     r.Rate,
+			Minimum:  r.Minimum,
+			Maximum:  r.Maximum,
+		})
+	}
+	return out
+}
+
+func toFeeRuleMsg(r *pricingv1.FeeRule) *feeRuleMsg {
+	if r == nil {
+		return nil
+	}
+	return &feeRuleMsg{
+		FeeCode:  r.GetFeeCode(),
+		Currency: r.GetCurrency(),
+		Fixed:    r.GetFixed(),
+		Rate:     r.GetRate(),
+		Minimum:  r.GetMinimum(),
+		Maximum:  r.GetMaximum(),
+	}
+}
+
+func toFeeScheduleMsg(s *pricingv1.FeeSchedule) *feeScheduleMsg {
+	if s == nil {
+		return nil
+	}
+	rules := make([]*feeRuleMsg, 0, len(s.GetRules()))
+	for _, r := range s.GetRules() {
+		rules = append(rules, toFeeRuleMsg(r))
+	}
+	return &feeScheduleMsg{
+		ScheduleID:    s.GetScheduleId(),
+		TenantID:      s.GetTenantId(),
+		Product:       enumName(s.GetProduct().String(), "PRODUCT_"),
+		Channel:       enumName(s.GetChannel().String(), "CHANNEL_"),
+		EffectiveFrom: formatTimestamp(s.GetEffectiveFrom()),
+		Rules:         rules,
+		Version:       s.GetVersion(),
+		CreatedAt:     formatTimestamp(s.GetCreatedAt()),
+		UpdatedAt:     formatTimestamp(s.GetUpdatedAt()),
+	}
+}
+
+func toFeeAssessmentMsg(a *pricingv1.FeeAssessment) *feeAssessmentMsg {
+	if a == nil {
+		return nil
+	}
+	return &feeAssessmentMsg{
+		AssessmentID: a.GetAssessmentId(),
+		TenantID:     a.GetTenantId(),
+		Reference:    a.GetReference(),
+		Product:      enumName(a.GetProduct().String(), "PRODUCT_"),
+		Channel:      enumName(a.GetChannel().String(), "CHANNEL_"),
+		FeeCode:      a.GetFeeCode(),
+		Currency:     a.GetCurrency(),
+		Amount:       a.GetAmount(),
+		Fee:          a.GetFee(),
+		ScheduleID:   a.GetScheduleId(),
+		AssessedAt:   formatTimestamp(a.GetAssessedAt()),
+	}
+}
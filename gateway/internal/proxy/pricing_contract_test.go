@@ -0,0 +1,66 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const feeScheduleJSON = `{
+	"schedule_id": "7c9e1a3b-5d6f-4b8c-9e0a-2b4d6f8a0c3e",
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"product": "PRODUCT_PAYMENTS",
+	"channel": "CHANNEL_ONLINE",
+	"effective_from": "2026-01-15T09:00:00Z",
+	"rules": [{"fee_code": "FASTER_PAYMENT", "currency": "GBP", "fixed": "0.2", "rate": "0.001",
+		"minimum": "0.2", "maximum": "5"}],
+	"version": 1,
+	"created_at": "2026-01-15T09:00:00Z",
+	"updated_at": "2026-01-15T09:00:00Z"
+}`
+
+func TestPricingContract(t *testing.T) {
+	stub, conn := newContractStub(t, "pricing-service")
+	p := NewPricingProxy(conn, conn.Logger)
+	scheduleID := contractFeeScheduleID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "create an online payments fee schedule",
+		Method:      "/bib.pricing.v1.PricingService/CreateFeeSchedule",
+		Response:    json.RawMessage(`{"schedule": ` + feeScheduleJSON + `}`),
+	})
+	rec := call(t, p.CreateFeeSchedule, http.MethodPost, "/api/v1/pricing/schedules", `{"product": "PAYMENTS",
+		"channel": "ONLINE", "rules": [{"fee_code": "FASTER_PAYMENT", "currency": "GBP", "fixed": "0.20",
+		"rate": "0.001", "minimum": "0.20", "maximum": "5.00"}]}`)
+	if rec.Code != http.StatusCreated || rec.Header().Get("Location") != "/api/v1/pricing/schedules/"+scheduleID {
+		t.Errorf("CreateFeeSchedule = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a fee schedule",
+		State:       "an online payments fee schedule is in effect",
+		Method:      "/bib.pricing.v1.PricingService/GetFeeSchedule",
+		Response:    json.RawMessage(`{"schedule": ` + feeScheduleJSON + `}`),
+	})
+	rec = call(t, p.GetFeeSchedule, http.MethodGet, "/api/v1/pricing/schedules/"+scheduleID, "", "id", scheduleID)
+	if rec.Code != http.StatusOK {
+		t.Errorf("GetFeeSchedule = %d %s", rec.Code, rec.Body)
+	} else if schedule, _ := decodeBody(t, rec)["schedule"].(map[string]any); schedule["channel"] != "ONLINE" {
+		t.Errorf("GetFeeSchedule schedule = %v", schedule)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing fee schedule",
+		Method:      "/bib.pricing.v1.PricingService/GetFeeSchedule",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetFeeSchedule, http.MethodGet, "/api/v1/pricing/schedules/"+scheduleID, "", "id", scheduleID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetFeeSchedule of a missing schedule = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-pricing-service.json"))
+}
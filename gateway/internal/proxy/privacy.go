@@ -0,0 +1,438 @@
+package proxy
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	privacyv1 "github.com/bibbank/bib/api/gen/go/bib/privacy/v1"
+)
+
+// PrivacyProxy proxies HTTP requests to the privacy gRPC service.
+type PrivacyProxy struct {
+	client privacyv1.PrivacyServiceClient
+	logger *slog.Logger
+}
+
+// NewPrivacyProxy creates a new privacy service proxy.
+func NewPrivacyProxy(conn *ServiceConn, logger *slog.Logger) *PrivacyProxy {
+	return &PrivacyProxy{client: privacyv1.NewPrivacyServiceClient(conn), logger: logger}
+}
+
+type requestErasureReq struct {
+	Email      string `json:"email,omitempty"`
+	CustomerID string `json:"customer_id,omitempty"`
+	Reference  string `json:"reference"`
+}
+
+type upsertRetentionPolicyReq struct {
+	RetentionDays int32 `json:"retention_days"`
+}
+
+type retainedRecordMsg struct {
+	Record string `json:"record"`
+	Reason string `json:"reason"`
+}
+
+type erasureTaskMsg struct {
+	Service     string               `json:"service"`
+	Status      string               `json:"status"`
+	Erased      int32                `json:"erased"`
+	Retained    []*retainedRecordMsg `json:"retained"`
+	Error       string               `json:"error,omitempty"`
+	Attempts    int32                `json:"attempts"`
+	CompletedAt string               `json:"completed_at,omitempty"`
+}
+
+type erasureJobMsg struct {
+	JobID              string            `json:"job_id"`
+	TenantID           string            `json:"tenant_id"`
+	Kind               string            `json:"kind"`
+	Status             string            `json:"status"`
+	SubjectEmailSHA256 string            `json:"subject_email_sha256,omitempty"`
+	CustomerID         string            `json:"customer_id,omitempty"`
+	Reference          string            `json:"reference,omitempty"`
+	Category           string            `json:"category,omitempty"`
+	Cutoff             string            `json:"cutoff,omitempty"`
+	RequestedBy        string            `json:"requested_by"`
+	Tasks              []*erasureTaskMsg `json:"tasks"`
+	Deadline           string            `json:"deadline"`
+	CertificateIssued  bool              `json:"certificate_issued"`
+	Version            int32             `json:"version"`
+	CreatedAt          string            `json:"created_at"`
+	UpdatedAt          string            `json:"updated_at"`
+	CompletedAt        string            `json:"completed_at,omitempty"`
+}
+
+type erasureJobResp struct {
+	Job *erasureJobMsg `json:"job"`
+}
+
+type listErasureJobsResp struct {
+	Jobs       []*erasureJobMsg `json:"jobs"`
+	TotalCount int32            `json:"total_count"`
+}
+
+type certifiedServiceMsg struct {
+	Service  string               `json:"service"`
+	Erased   int32                `json:"erased"`
+	Retained []*retainedRecordMsg `json:"retained"`
+}
+
+type erasureCertificateMsg struct {
+	JobID              string                 `json:"job_id"`
+	TenantID           string                 `json:"tenant_id"`
+	SubjectEmailSHA256 string                 `json:"subject_email_sha256,omitempty"`
+	CustomerID         string                 `json:"customer_id,omitempty"`
+	Reference          string                 `json:"reference"`
+	Services           []*certifiedServiceMsg `json:"services"`
+	RequestedAt        string                 `json:"requested_at"`
+	CompletedAt        string                 `json:"completed_at"`
+	IssuedAt           string                 `json:"issued_at"`
+	Document           string                 `json:"document"`
+	Digest             string                 `json:"digest"`
+	Signature          string                 `json:"signature"`
+	Algorithm          string                 `json:"algorithm"`
+}
+
+type erasureCertificateResp struct {
+	Certificate *erasureCertificateMsg `json:"certificate"`
+}
+
+type participantMsg struct {
+	Service      string   `json:"service"`
+	Categories   []string `json:"categories"`
+	RegisteredAt string   `json:"registered_at"`
+}
+
+type listParticipantsResp struct {
+	Participants []*participantMsg `json:"participants"`
+}
+
+type retentionPolicyMsg struct {
+	Category      string `json:"category"`
+	RetentionDays int32  `json:"retention_days"`
+	LastRunOn     string `json:"last_run_on,omitempty"`
+	Version       int32  `json:"version"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+type retentionPolicyResp struct {
+	Policy *retentionPolicyMsg `json:"policy"`
+}
+
+type listRetentionPoliciesResp struct {
+	Policies []*retentionPolicyMsg `json:"policies"`
+}
+
+type runRetentionResp struct {
+	Jobs              []*erasureJobMsg `json:"jobs"`
+	SkippedCategories []string         `json:"skipped_categories"`
+}
+
+// RequestErasure handles POST /api/v1/privacy/erasures, starting a
+// right-to-erasure job for a data subject identified by email or customer
+// id.
+func (p *PrivacyProxy) RequestErasure(w http.ResponseWriter, r *http.Request) {
+	var req requestErasureReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.RequestErasure(r.Context(), &privacyv1.RequestErasureRequest{
+		Email:      req.Email,
+		CustomerId: req.CustomerID,
+		Reference:  req.Reference,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	job := toErasureJobMsg(resp.GetJob())
+	w.Header().Set("Location", "/api/v1/privacy/erasures/"+url.PathEscape(job.JobID))
+	writeJSON(w, http.StatusAccepted, erasureJobResp{Job: job})
+}
+
+// ListErasureJobs handles GET /api/v1/privacy/erasures.
+// Query parameters: kind, status, page_size, offset.
+func (p *PrivacyProxy) ListErasureJobs(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	var kind privacyv1.ErasureKind
+	if v := q.Get("kind"); v != "" {
+		n, found := privacyv1.ErasureKind_value["ERASURE_KIND_"+strings.ToUpper(v)]
+		if !found {
+			writeError(w, http.StatusBadRequest, "kind must be SUBJECT or RETENTION")
+			return
+		}
+		kind = privacyv1.ErasureKind(n)
+	}
+	var status privacyv1.ErasureJobStatus
+	if v := q.Get("status"); v != "" {
+		n, found := privacyv1.ErasureJobStatus_value["ERASURE_JOB_STATUS_"+strings.ToUpper(v)]
+		if !found {
+			writeError(w, http.StatusBadRequest, "status must be IN_PROGRESS, COMPLETED or FAILED")
+			return
+		}
+		status = privacyv1.ErasureJobStatus(n)
+	}
+
+	resp, err := p.client.ListErasureJobs(r.Context(), &privacyv1.ListErasureJobsRequest{
+		Kind:     kind,
+		Status:   status,
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listErasureJobsResp{
+		Jobs:       make([]*erasureJobMsg, 0, len(resp.GetJobs())),
+		TotalCount: resp.GetTotalCount(),
+	}
+	for _, j := range resp.GetJobs() {
+		out.Jobs = append(out.Jobs, toErasureJobMsg(j))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetErasureJob handles GET /api/v1/privacy/erasures/{id}.
+func (p *PrivacyProxy) GetErasureJob(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "erasure job id is required")
+		return
+	}
+
+	resp, err := p.client.GetErasureJob(r.Context(), &privacyv1.GetErasureJobRequest{JobId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, erasureJobResp{Job: toErasureJobMsg(resp.GetJob())})
+}
+
+// RetryErasureJob handles POST /api/v1/privacy/erasures/{id}/retry,
+// asking the services that failed or timed out to erase again.
+func (p *PrivacyProxy) RetryErasureJob(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "erasure job id is required")
+		return
+	}
+
+	resp, err := p.client.RetryErasureJob(r.Context(), &privacyv1.RetryErasureJobRequest{JobId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusAccepted, erasureJobResp{Job: toErasureJobMsg(resp.GetJob())})
+}
+
+// GetErasureCertificate handles GET /api/v1/privacy/erasures/{id}/certificate.
+func (p *PrivacyProxy) GetErasureCertificate(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "erasure job id is required")
+		return
+	}
+
+	resp, err := p.client.GetErasureCertificate(r.Context(), &privacyv1.GetErasureCertificateRequest{JobId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, erasureCertificateResp{Certificate: toErasureCertificateMsg(resp.GetCertificate())})
+}
+
+// ListParticipants handles GET /api/v1/privacy/participants.
+func (p *PrivacyProxy) ListParticipants(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.ListParticipants(r.Context(), &privacyv1.ListParticipantsRequest{})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listParticipantsResp{Participants: make([]*participantMsg, 0, len(resp.GetParticipants()))}
+	for _, pt := range resp.GetParticipants() {
+		categories := pt.GetCategories()
+		if categories == nil {
+			categories = []string{}
+		}
+		out.Participants = append(out.Participants, &participantMsg{
+			Service:      pt.GetService(),
+			Categories:   categories,
+			RegisteredAt: formatTimestamp(pt.GetRegisteredAt()),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// UpsertRetentionPolicy handles PUT /api/v1/privacy/retention-policies/{category}.
+func (p *PrivacyProxy) UpsertRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	category := r.PathValue("category")
+	if category == "" {
+		writeError(w, http.StatusBadRequest, "category is required")
+		return
+	}
+	var req upsertRetentionPolicyReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.UpsertRetentionPolicy(r.Context(), &privacyv1.UpsertRetentionPolicyRequest{
+		Category:      category,
+		RetentionDays: req.RetentionDays,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, retentionPolicyResp{Policy: toRetentionPolicyMsg(resp.GetPolicy())})
+}
+
+// ListRetentionPolicies handles GET /api/v1/privacy/retention-policies.
+func (p *PrivacyProxy) ListRetentionPolicies(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.ListRetentionPolicies(r.Context(), &privacyv1.ListRetentionPoliciesRequest{})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listRetentionPoliciesResp{Policies: make([]*retentionPolicyMsg, 0, len(resp.GetPolicies()))}
+	for _, pol := range resp.GetPolicies() {
+		out.Policies = append(out.Policies, toRetentionPolicyMsg(pol))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// DeleteRetentionPolicy handles DELETE /api/v1/privacy/retention-policies/{category}.
+func (p *PrivacyProxy) DeleteRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	category := r.PathValue("category")
+	if category == "" {
+		writeError(w, http.StatusBadRequest, "category is required")
+		return
+	}
+
+	if _, err := p.client.DeleteRetentionPolicy(r.Context(), &privacyv1.DeleteRetentionPolicyRequest{Category: category}); err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RunRetention handles POST /api/v1/privacy/retention/run, starting
+// retention erasures for the tenant's policies that have not run today.
+func (p *PrivacyProxy) RunRetention(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.RunRetention(r.Context(), &privacyv1.RunRetentionRequest{})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	skipped := resp.GetSkippedCategories()
+	if skipped == nil {
+		skipped = []string{}
+	}
+	out := runRetentionResp{
+		Jobs:              make([]*erasureJobMsg, 0, len(resp.GetJobs())),
+		SkippedCategories: skipped,
+	}
+	for _, j := range resp.GetJobs() {
+		out.Jobs = append(out.Jobs, toErasureJobMsg(j))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+func toRetainedRecordMsgs(records []*privacyv1.RetainedRecord) []*retainedRecordMsg {
+	out := make([]*retainedRecordMsg, 0, len(records))
+	for _, rec := range records {
+		out = append(out, &retainedRecordMsg{Record: rec.GetRecord(), Reason: rec.GetReason()})
+	}
+	return out
+}
+
+func toErasureJobMsg(j *privacyv1.ErasureJob) *erasureJobMsg {
+	if j == nil {
+		return nil
+	}
+	msg := &erasureJobMsg{
+		JobID:              j.GetJobId(),
+		TenantID:           j.GetTenantId(),
+		Kind:               enumName(j.GetKind().String(), "ERASURE_KIND_"),
+		Status:             enumName(j.GetStatus().String(), "ERASURE_JOB_STATUS_"),
+		SubjectEmailSHA256: j.GetSubjectEmailSha256(),
+		CustomerID:         j.GetCustomerId(),
+		Reference:          j.GetReference(),
+		Category:           j.GetCategory(),
+		Cutoff:             formatTimestamp(j.GetCutoff()),
+		RequestedBy:        j.GetRequestedBy(),
+		Tasks:              make([]*erasureTaskMsg, 0, len(j.GetTasks())),
+		Deadline:           formatTimestamp(j.GetDeadline()),
+		CertificateIssued:  j.GetCertificateIssued(),
+		Version:            j.GetVersion(),
+		CreatedAt:          formatTimestamp(j.GetCreatedAt()),
+		UpdatedAt:          formatTimestamp(j.GetUpdatedAt()),
+		CompletedAt:        formatTimestamp(j.GetCompletedAt()),
+	}
+	for _, t := range j.GetTasks() {
+		msg.Tasks = append(msg.Tasks, &erasureTaskMsg{
+			Service:     t.GetService(),
+			Status:      enumName(t.GetStatus().String(), "ERASURE_TASK_STATUS_"),
+			Erased:      t.GetErased(),
+			Retained:    toRetainedRecordMsgs(t.GetRetained()),
+			Error:       t.GetError(),
+			Attempts:    t.GetAttempts(),
+			CompletedAt: formatTimestamp(t.GetCompletedAt()),
+		})
+	}
+	return msg
+}
+
+func toErasureCertificateMsg(c *privacyv1.ErasureCertificate) *erasureCertificateMsg {
+	if c == nil {
+		return nil
+	}
+	msg := &erasureCertificateMsg{
+		JobID:              c.GetJobId(),
+		TenantID:           c.GetTenantId(),
+		SubjectEmailSHA256: c.GetSubjectEmailSha256(),
+		CustomerID:         c.GetCustomerId(),
+		Reference:          c.GetReference(),
+		Services:           make([]*certifiedServiceMsg, 0, len(c.GetServices())),
+		RequestedAt:        formatTimestamp(c.GetRequestedAt()),
+		CompletedAt:        formatTimestamp(c.GetCompletedAt()),
+		IssuedAt:           formatTimestamp(c.GetIssuedAt()),
+		Document:           c.GetDocument(),
+		Digest:             c.GetDigest(),
+		Signature:          c.GetSignature(),
+		Algorithm:          c.GetAlgorithm(),
+	}
+	for _, s := range c.GetServices() {
+		msg.Services = append(msg.Services, &certifiedServiceMsg{
+			Service:  s.GetService(),
+			Erased:   s.GetErased(),
+			Retained: toRetainedRecordMsgs(s.GetRetained()),
+		})
+	}
+	return msg
+}
+
+func toRetentionPolicyMsg(p *privacyv1.RetentionPolicy) *retentionPolicyMsg {
+	if p == nil {
+		return nil
+	}
+	return &retentionPolicyMsg{
+		Category:      p.GetCategory(),
+		RetentionDays: p.GetRetentionDays(),
+		LastRunOn:     p.GetLastRunOn(),
+		Version:       p.GetVersion(),
+		CreatedAt:     formatTimestamp(p.GetCreatedAt()),
+		UpdatedAt:     formatTimestamp(p.GetUpdatedAt()),
+	}
+}
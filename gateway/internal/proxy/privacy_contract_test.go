@@ -0,0 +1,68 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const erasureJobJSON = `{
+	"job_id": "1c3e5a7b-9d0f-4b2c-8e4a-6b8d0f2a4c7e",
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"kind": "ERASURE_KIND_SUBJECT",
+	"status": "ERASURE_JOB_STATUS_IN_PROGRESS",
+	"customer_id": "a4c6e8b0-2d4f-4a1c-9e3b-5d7f9b1d3f6a",
+	"reference": "PRIV-1042",
+	"requested_by": "3e5a7c9b-1d2f-4a6b-8c0e-2f4a6c8e0b1d",
+	"tasks": [{"service": "customer-service", "status": "ERASURE_TASK_STATUS_PENDING"}],
+	"deadline": "2026-02-14T09:00:00Z",
+	"version": 1,
+	"created_at": "2026-01-15T09:00:00Z",
+	"updated_at": "2026-01-15T09:00:00Z"
+}`
+
+func TestPrivacyContract(t *testing.T) {
+	stub, conn := newContractStub(t, "privacy-service")
+	p := NewPrivacyProxy(conn, conn.Logger)
+	jobID := contractErasureJobID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "request a customer's erasure",
+		State:       "customer-service takes part in erasures",
+		Method:      "/bib.privacy.v1.PrivacyService/RequestErasure",
+		Response:    json.RawMessage(`{"job": ` + erasureJobJSON + `}`),
+	})
+	rec := call(t, p.RequestErasure, http.MethodPost, "/api/v1/privacy/erasures",
+		`{"customer_id": "`+contractCustomerID.String()+`", "reference": "PRIV-1042"}`)
+	if rec.Code != http.StatusAccepted || rec.Header().Get("Location") != "/api/v1/privacy/erasures/"+jobID {
+		t.Errorf("RequestErasure = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get an erasure job",
+		State:       "a customer's erasure is in progress",
+		Method:      "/bib.privacy.v1.PrivacyService/GetErasureJob",
+		Response:    json.RawMessage(`{"job": ` + erasureJobJSON + `}`),
+	})
+	rec = call(t, p.GetErasureJob, http.MethodGet, "/api/v1/privacy/erasures/"+jobID, "", "id", jobID)
+	if rec.Code != http.StatusOK {
+		t.Errorf("GetErasureJob = %d %s", rec.Code, rec.Body)
+	} else if job, _ := decodeBody(t, rec)["job"].(map[string]any); job["status"] != "IN_PROGRESS" {
+		t.Errorf("GetErasureJob job = %v", job)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing erasure job",
+		Method:      "/bib.privacy.v1.PrivacyService/GetErasureJob",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetErasureJob, http.MethodGet, "/api/v1/privacy/erasures/"+jobID, "", "id", jobID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetErasureJob of a missing job = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-privacy-service.json"))
+}
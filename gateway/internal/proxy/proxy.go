@@ -2,18 +2,20 @@
 //
 // Each service client holds a gRPC connection and exposes HTTP handler
 // functions that translate JSON requests into gRPC calls and return
-// JSON responses. The clients use a JSON codec so that proto-generated
-// stubs are not required; once generated code is available the raw
-// Invoke calls can be replaced with typed client stubs.
+// JSON responses. Services are called through their generated clients in
+// api/gen/go.
 package proxy
 
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/bibbank/bib/gateway/internal/middleware"
@@ -23,15 +25,26 @@ import (
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// ServiceConn represents a gRPC client connection to a backend service.
+// ServiceConn represents a gRPC client connection to a backend service. A
+// connection made with DialRegions reaches the service in every region, and
+// Conn, Health and Addr are those of the local region. A connection given a
+// sandbox with DialSandbox sends the sandbox tenants' calls to the service's
+// sandbox deployment. A connection given a policy with SetResilience times
+// out, retries and breaks its calls by it.
 type ServiceConn struct {
-	Health healthpb.HealthClient
-	Conn   *grpc.ClientConn
-	Logger *slog.Logger
-	Name   string
-	Addr   string
+	Health      healthpb.HealthClient
+	Conn        *grpc.ClientConn
+	Logger      *slog.Logger
+	router      *Router
+	resilience  *resilience
+	sandbox     *Sandbox
+	sandboxConn *grpc.ClientConn
+	Name        string
+	Addr        string
+	regions     []*regionConn
 }
 
 // Dial establishes a gRPC connection to the backend service.
@@ -54,28 +67,86 @@ func Dial(name, addr string, logger *slog.Logger) (*ServiceConn, error) {
 	}, nil
 }
 
-// Close closes the underlying gRPC connection.
+// Close closes the underlying gRPC connections.
 func (sc *ServiceConn) Close() error {
-	if sc == nil || sc.Conn == nil {
+	if sc == nil {
 		return nil
 	}
-	return sc.Conn.Close()
+	var errs []error
+	if sc.sandboxConn != nil {
+		errs = append(errs, sc.sandboxConn.Close())
+	}
+	if len(sc.regions) > 0 {
+		for _, rc := range sc.regions {
+			errs = append(errs, rc.conn.Close())
+		}
+	} else if sc.Conn != nil {
+		errs = append(errs, sc.Conn.Close())
+	}
+	return errors.Join(errs...)
 }
 
-// Invoke calls a gRPC method on the backend service using the JSON codec.
-// It forwards the Bearer token from the HTTP context as gRPC metadata so
-// backend services can authenticate the request.
-func (sc *ServiceConn) Invoke(ctx context.Context, method string, req, resp interface{}) error {
+// Invoke calls a gRPC method on the backend service. It forwards the Bearer
+// token from the HTTP context as gRPC metadata so backend services can
+// authenticate the request, along with any idempotency key. Calls by sandbox
+// tenants go to the sandbox deployment; otherwise connections made with
+// DialRegions route the call to a region, under the connection's resilience
+// policy if it has one.
+//
+// Invoke and NewStream make ServiceConn a grpc.ClientConnInterface, so
+// generated clients can be built on it.
+func (sc *ServiceConn) Invoke(ctx context.Context, method string, req, resp interface{}, opts ...grpc.CallOption) error {
 	if sc == nil || sc.Conn == nil {
 		return status.Error(codes.Unavailable, "backend service not connected")
 	}
 
+	if conn, ok, err := sc.sandboxed(ctx); ok {
+		if err != nil {
+			return err
+		}
+		return conn.Invoke(outgoingContext(ctx), method, req, resp, opts...)
+	}
+	return sc.call(ctx, method, func(ctx context.Context) error {
+		if len(sc.regions) > 0 {
+			return sc.invokeRegions(outgoingContext(ctx), method, req, resp, opts...)
+		}
+		return sc.Conn.Invoke(outgoingContext(ctx), method, req, resp, opts...)
+	})
+}
+
+// NewStream opens a stream to the backend service, forwarding credentials as
+// Invoke does. Streams to a backend whose circuit breaker is open fail fast.
+func (sc *ServiceConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if sc == nil || sc.Conn == nil {
+		return nil, status.Error(codes.Unavailable, "backend service not connected")
+	}
+	if conn, ok, err := sc.sandboxed(ctx); ok {
+		if err != nil {
+			return nil, err
+		}
+		return conn.NewStream(outgoingContext(ctx), desc, method, opts...)
+	}
+	if err := sc.checkBreaker(); err != nil {
+		return nil, err
+	}
+	if len(sc.regions) > 0 {
+		return sc.newStreamRegions(outgoingContext(ctx), desc, method, opts...)
+	}
+	return sc.Conn.NewStream(outgoingContext(ctx), desc, method, opts...)
+}
+
+// outgoingContext adds the caller's Bearer token and idempotency key to the
+// outgoing gRPC metadata.
+func outgoingContext(ctx context.Context) context.Context {
 	// Forward the Bearer token as gRPC metadata for backend auth.
 	if token, ok := middleware.BearerTokenFromContext(ctx); ok {
 		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
 	}
-
-	return sc.Conn.Invoke(ctx, method, req, resp, grpcCallOption())
+	// Forward the client's idempotency key so retries are not applied twice.
+	if key, ok := middleware.IdempotencyKeyFromContext(ctx); ok {
+		ctx = metadata.AppendToOutgoingContext(ctx, "idempotency-key", key)
+	}
+	return ctx
 }
 
 // CheckHealth queries the gRPC health check endpoint of the backend service.
@@ -129,7 +200,7 @@ func grpcToHTTPStatus(code codes.Code) int {
 	switch code {
 	case codes.OK:
 		return http.StatusOK
-	case codes.InvalidArgument:
+	case codes.InvalidArgument, codes.FailedPrecondition:
 		return http.StatusBadRequest
 	case codes.NotFound:
 		return http.StatusNotFound
@@ -169,18 +240,44 @@ func handleGRPCError(w http.ResponseWriter, err error, logger *slog.Logger) {
 	writeError(w, httpStatus, st.Message())
 }
 
-// jsonCodec is a gRPC codec that uses JSON encoding.
-// This allows making raw gRPC calls without proto-generated types.
-type jsonCodec struct{}
-
-func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
-	return json.Marshal(v)
+// enumName strips the type prefix from a proto enum value name, leaving ""
+// for unspecified values.
+func enumName(name, prefix string) string {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "UNSPECIFIED" {
+		return ""
+	}
+	return name
 }
 
-func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
-	return json.Unmarshal(data, v)
+// formatTimestamp renders a proto timestamp as RFC 3339 in UTC, leaving ""
+// for unset ones.
+func formatTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339)
 }
 
-func (jsonCodec) Name() string {
-	return "json"
+// readStatementPage reads the page_size and offset query parameters of a
+// list request, writing a 400 when either is not a 32-bit integer.
+func readStatementPage(w http.ResponseWriter, r *http.Request) (pageSize, offset int32, ok bool) {
+	q := r.URL.Query()
+	if v := q.Get("page_size"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid page_size")
+			return 0, 0, false
+		}
+		pageSize = int32(n)
+	}
+	if v := q.Get("offset"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid offset")
+			return 0, 0, false
+		}
+		offset = int32(n)
+	}
+	return pageSize, offset, true
 }
@@ -0,0 +1,254 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// readMethodPrefixes are the method name prefixes of calls that only read,
+// which any region with a current enough replica may serve.
+var readMethodPrefixes = []string{"Get", "List", "Search", "Query", "Lookup", "Export"}
+
+// isReadMethod reports whether the full gRPC method name is a read.
+func isReadMethod(method string) bool {
+	name := method[strings.LastIndex(method, "/")+1:]
+	for _, prefix := range readMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// RouterConfig configures a Router.
+type RouterConfig struct {
+	// TenantHomes maps tenants to their home region, where their writes go.
+	// Tenants not listed are homed in the local region.
+	TenantHomes map[uuid.UUID]string
+	// Local is the region this gateway runs in.
+	Local string
+	// ReplicationLag bounds how far a region's replicas trail the home
+	// region. Reads by a tenant within this long of its last write go to
+	// its home region, so the tenant reads its own writes.
+	ReplicationLag time.Duration
+}
+
+// Router routes backend calls across the regional backend sets of an
+// active-active deployment. Writes go to the tenant's home region; reads go
+// to the local region unless the tenant wrote recently. Regions failing
+// their health checks are skipped, falling over to the next region, and
+// reads that find a region unavailable are retried in the next. Writes are
+// never retried in another region, as the first may have applied.
+type Router struct {
+	now       func() time.Time
+	homes     map[uuid.UUID]string
+	lastWrite map[uuid.UUID]time.Time
+	local     string
+	lag       time.Duration
+	mu        sync.Mutex
+}
+
+// NewRouter creates a new Router.
+func NewRouter(cfg RouterConfig) *Router {
+	return &Router{
+		now:       time.Now,
+		homes:     cfg.TenantHomes,
+		lastWrite: make(map[uuid.UUID]time.Time),
+		local:     cfg.Local,
+		lag:       cfg.ReplicationLag,
+	}
+}
+
+// regionConn is a service's connection in one region.
+type regionConn struct {
+	conn    *grpc.ClientConn
+	health  healthpb.HealthClient
+	region  string
+	healthy atomic.Bool
+}
+
+// DialRegions connects to a backend service in every region, given its
+// address in each. The local region's connection serves health checks.
+func DialRegions(name string, addrs map[string]string, router *Router, logger *slog.Logger) (*ServiceConn, error) {
+	if _, ok := addrs[router.local]; !ok {
+		return nil, fmt.Errorf("dial %s: no address in local region %s", name, router.local)
+	}
+	regions := make([]string, 0, len(addrs))
+	for region := range addrs {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	sc := &ServiceConn{Name: name, Logger: logger, router: router}
+	for _, region := range regions {
+		addr := addrs[region]
+		conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			_ = sc.Close() //nolint:errcheck // reporting the dial error
+			return nil, fmt.Errorf("dial %s in %s at %s: %w", name, region, addr, err)
+		}
+		rc := &regionConn{conn: conn, health: healthpb.NewHealthClient(conn), region: region}
+		rc.healthy.Store(true)
+		sc.regions = append(sc.regions, rc)
+		if region == router.local {
+			sc.Addr, sc.Conn, sc.Health = addr, conn, rc.health
+		}
+		logger.Info("connected to backend service", "service", name, "region", region, "addr", addr)
+	}
+	return sc, nil
+}
+
+// invokeRegions makes a unary call in the region the router picks.
+func (sc *ServiceConn) invokeRegions(ctx context.Context, method string, req, resp interface{}, opts ...grpc.CallOption) error {
+	tenant, hasTenant := tenantOf(ctx)
+	read := isReadMethod(method)
+	var err error
+	for _, rc := range sc.router.route(sc.regions, tenant, hasTenant, read) {
+		err = rc.conn.Invoke(ctx, method, req, resp, opts...)
+		if err == nil {
+			if !read && hasTenant {
+				sc.router.recordWrite(tenant)
+			}
+			return nil
+		}
+		if !read || status.Code(err) != codes.Unavailable {
+			return err
+		}
+		sc.Logger.Warn("backend region unavailable, trying next region",
+			"service", sc.Name, "region", rc.region, "method", method)
+	}
+	return err
+}
+
+// newStreamRegions opens a stream in the region the router picks. Streams
+// are not retried in another region once opened.
+func (sc *ServiceConn) newStreamRegions(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	tenant, hasTenant := tenantOf(ctx)
+	read := isReadMethod(method)
+	rc := sc.router.route(sc.regions, tenant, hasTenant, read)[0]
+	if !read && hasTenant {
+		sc.router.recordWrite(tenant)
+	}
+	return rc.conn.NewStream(ctx, desc, method, opts...)
+}
+
+// route orders the regions a call should be tried in: the preferred region,
+// then the tenant's home region, then the local region, then the rest.
+// Unhealthy regions are dropped unless none is healthy.
+func (r *Router) route(regions []*regionConn, tenant uuid.UUID, hasTenant, read bool) []*regionConn {
+	home := r.local
+	if h, ok := r.homes[tenant]; ok && hasTenant {
+		home = h
+	}
+	preferred := home
+	if read && !(hasTenant && r.wroteRecently(tenant)) {
+		preferred = r.local
+	}
+
+	rank := func(rc *regionConn) int {
+		switch rc.region {
+		case preferred:
+			return 0
+		case home:
+			return 1
+		case r.local:
+			return 2
+		default:
+			return 3
+		}
+	}
+	ordered := make([]*regionConn, len(regions))
+	copy(ordered, regions)
+	sort.SliceStable(ordered, func(i, j int) bool { return rank(ordered[i]) < rank(ordered[j]) })
+
+	healthy := make([]*regionConn, 0, len(ordered))
+	for _, rc := range ordered {
+		if rc.healthy.Load() {
+			healthy = append(healthy, rc)
+		}
+	}
+	if len(healthy) == 0 {
+		return ordered
+	}
+	return healthy
+}
+
+func (r *Router) recordWrite(tenant uuid.UUID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastWrite[tenant] = r.now()
+}
+
+func (r *Router) wroteRecently(tenant uuid.UUID) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	at, ok := r.lastWrite[tenant]
+	if !ok {
+		return false
+	}
+	if r.now().Sub(at) >= r.lag {
+		delete(r.lastWrite, tenant)
+		return false
+	}
+	return true
+}
+
+// Watch health-checks every region of each service every interval until ctx
+// is cancelled, so calls skip regions that are down.
+func (r *Router) Watch(ctx context.Context, conns []*ServiceConn, interval time.Duration, logger *slog.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for _, sc := range conns {
+			for _, rc := range sc.regions {
+				healthy := rc.check(ctx, sc.Name) == nil
+				if rc.healthy.Swap(healthy) != healthy && ctx.Err() == nil {
+					logger.Warn("backend region health changed",
+						"service", sc.Name, "region", rc.region, "healthy", healthy)
+				}
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (rc *regionConn) check(ctx context.Context, service string) error {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	resp, err := rc.health.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return err
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("%s not serving in %s: %s", service, rc.region, resp.Status)
+	}
+	return nil
+}
+
+// tenantOf returns the authenticated caller's tenant.
+func tenantOf(ctx context.Context) (uuid.UUID, bool) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok || claims.TenantID == uuid.Nil {
+		return uuid.Nil, false
+	}
+	return claims.TenantID, true
+}
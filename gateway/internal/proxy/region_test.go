@@ -0,0 +1,169 @@
+package proxy
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+const (
+	getMethod  = "/bib.account.v1.AccountService/GetAccount"
+	openMethod = "/bib.account.v1.AccountService/OpenAccount"
+)
+
+// regionServer answers every call with the name of its region, or fails
+// with Unavailable while down is set.
+func regionServer(t *testing.T, region string, down *bool) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(1 << 16)
+	srv := grpc.NewServer(grpc.UnknownServiceHandler(func(_ any, stream grpc.ServerStream) error {
+		var req structpb.Struct
+		if err := stream.RecvMsg(&req); err != nil {
+			return err
+		}
+		if *down {
+			return status.Error(codes.Unavailable, "region down")
+		}
+		return stream.SendMsg(&structpb.Struct{Fields: map[string]*structpb.Value{"region": structpb.NewStringValue(region)}})
+	}))
+	go srv.Serve(lis) //nolint:errcheck // stopped by cleanup
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///"+region,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+type regionFixture struct {
+	conn    *ServiceConn
+	router  *Router
+	down    map[string]*bool
+	now     time.Time
+	euID    uuid.UUID
+	usID    uuid.UUID
+	regions map[string]*regionConn
+}
+
+func newRegionFixture(t *testing.T) *regionFixture {
+	t.Helper()
+	f := &regionFixture{
+		down:    map[string]*bool{"eu-west-1": new(bool), "us-east-1": new(bool)},
+		now:     time.Date(2026, time.May, 4, 12, 0, 0, 0, time.UTC),
+		euID:    uuid.New(),
+		usID:    uuid.New(),
+		regions: make(map[string]*regionConn),
+	}
+	f.router = NewRouter(RouterConfig{
+		Local:          "eu-west-1",
+		TenantHomes:    map[uuid.UUID]string{f.usID: "us-east-1"},
+		ReplicationLag: 5 * time.Second,
+	})
+	f.router.now = func() time.Time { return f.now }
+
+	f.conn = &ServiceConn{Name: "account-service", router: f.router, Logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	for _, region := range []string{"eu-west-1", "us-east-1"} {
+		conn := regionServer(t, region, f.down[region])
+		rc := &regionConn{conn: conn, health: healthpb.NewHealthClient(conn), region: region}
+		rc.healthy.Store(true)
+		f.regions[region] = rc
+		if region == "eu-west-1" {
+			f.conn.Conn, f.conn.Health = conn, rc.health
+		}
+		f.conn.regions = append(f.conn.regions, rc)
+	}
+	return f
+}
+
+// call invokes method as a user of tenant and returns the region that
+// answered.
+func (f *regionFixture) call(t *testing.T, tenant uuid.UUID, method string) (string, error) {
+	t.Helper()
+	ctx := auth.ContextWithClaims(context.Background(), &auth.Claims{TenantID: tenant, UserID: uuid.New()})
+	var resp structpb.Struct
+	err := f.conn.Invoke(ctx, method, &structpb.Struct{}, &resp)
+	return resp.GetFields()["region"].GetStringValue(), err
+}
+
+func TestRegions_WritesGoHomeAndReadsStayLocal(t *testing.T) {
+	f := newRegionFixture(t)
+
+	for _, tc := range []struct {
+		tenant uuid.UUID
+		method string
+		want   string
+	}{
+		{f.euID, openMethod, "eu-west-1"},
+		{f.usID, getMethod, "eu-west-1"},
+	} {
+		got, err := f.call(t, tc.tenant, tc.method)
+		if err != nil || got != tc.want {
+			t.Errorf("%s by %s = %q, %v; want %q", tc.method, tc.tenant, got, err, tc.want)
+		}
+	}
+
+	// A write pins the tenant's reads to its home region until replicas
+	// have caught up.
+	if got, _ := f.call(t, f.usID, openMethod); got != "us-east-1" {
+		t.Fatalf("write by US tenant went to %q", got)
+	}
+	if got, _ := f.call(t, f.usID, getMethod); got != "us-east-1" {
+		t.Errorf("read after write went to %q, want us-east-1", got)
+	}
+	f.now = f.now.Add(5 * time.Second)
+	if got, _ := f.call(t, f.usID, getMethod); got != "eu-west-1" {
+		t.Errorf("read after replication lag went to %q, want eu-west-1", got)
+	}
+}
+
+func TestRegions_Failover(t *testing.T) {
+	f := newRegionFixture(t)
+
+	// An unhealthy home region fails writes over to the next region.
+	f.regions["us-east-1"].healthy.Store(false)
+	if got, err := f.call(t, f.usID, openMethod); err != nil || got != "eu-west-1" {
+		t.Errorf("write with home down = %q, %v; want eu-west-1", got, err)
+	}
+	f.regions["us-east-1"].healthy.Store(true)
+
+	// A read that finds a region unavailable is retried in the next.
+	*f.down["eu-west-1"] = true
+	if got, err := f.call(t, f.euID, getMethod); err != nil || got != "us-east-1" {
+		t.Errorf("read with local down = %q, %v; want us-east-1", got, err)
+	}
+	// A write is not, since it may have applied.
+	if _, err := f.call(t, f.euID, openMethod); status.Code(err) != codes.Unavailable {
+		t.Errorf("write with local down: %v, want Unavailable", err)
+	}
+}
+
+func TestIsReadMethod(t *testing.T) {
+	for method, want := range map[string]bool{
+		getMethod:  true,
+		openMethod: false,
+		"/bib.payment.v1.PaymentService/ListPayments":     true,
+		"/bib.reporting.v1.ReportingService/SubmitReport": false,
+	} {
+		if got := isReadMethod(method); got != want {
+			t.Errorf("isReadMethod(%s) = %v, want %v", method, got, want)
+		}
+	}
+}
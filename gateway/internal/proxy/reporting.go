@@ -1,33 +1,45 @@
 package proxy
 
 import (
+	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/bibbank/bib/pkg/auth"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	reportingv1 "github.com/bibbank/bib/api/gen/go/bib/reporting/v1"
 )
 
 // ReportingProxy proxies HTTP requests to the reporting gRPC service.
 type ReportingProxy struct {
-	conn   *ServiceConn
+	client reportingv1.ReportingServiceClient
 	logger *slog.Logger
 }
 
 // NewReportingProxy creates a new reporting service proxy.
 func NewReportingProxy(conn *ServiceConn, logger *slog.Logger) *ReportingProxy {
-	return &ReportingProxy{conn: conn, logger: logger}
+	return &ReportingProxy{client: reportingv1.NewReportingServiceClient(conn), logger: logger}
 }
 
 type generateReportReq struct {
 	TenantID   string `json:"tenant_id"`
 	ReportType string `json:"report_type"`
 	Period     string `json:"period"`
+	Format     string `json:"format,omitempty"`
 }
 
 type generateReportResp struct {
-	ReportID  string `json:"report_id"`
-	Status    string `json:"status"`
-	CreatedAt string `json:"created_at"`
+	ReportID    string `json:"report_id"`
+	Status      string `json:"status"`
+	CreatedAt   string `json:"created_at"`
+	Format      string `json:"format,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	FileName    string `json:"file_name,omitempty"`
+	Content     []byte `json:"content,omitempty"`
 }
 
 type getReportResp struct {
@@ -38,14 +50,63 @@ type getReportResp struct {
 	Status     string `json:"status"`
 	CreatedAt  string `json:"created_at"`
 	UpdatedAt  string `json:"updated_at"`
+	// XBRLContent is the filing, for upload by hand in manual submission mode.
+	XBRLContent         string   `json:"xbrl_content,omitempty"`
+	SubmissionChannel   string   `json:"submission_channel,omitempty"`
+	SubmissionReference string   `json:"submission_reference,omitempty"`
+	SubmissionAttempts  int32    `json:"submission_attempts,omitempty"`
+	ValidationErrors    []string `json:"validation_errors,omitempty"`
+	Revision            int32    `json:"revision"`
+	OriginalID          string   `json:"original_id"`
+	AmendsID            string   `json:"amends_id,omitempty"`
+	AmendmentReason     string   `json:"amendment_reason,omitempty"`
+	AmendmentNote       string   `json:"amendment_note,omitempty"`
+	// LinkedCaseIDs are the fraud cases an AML report was filed for.
+	LinkedCaseIDs []string `json:"linked_case_ids,omitempty"`
+	PreparedBy    string   `json:"prepared_by,omitempty"`
+	ApprovedBy    string   `json:"approved_by,omitempty"`
+	ApprovedAt    string   `json:"approved_at,omitempty"`
 }
 
 type submitReportResp struct {
-	ReportID string `json:"report_id"`
-	Status   string `json:"status"`
+	ReportID  string `json:"report_id"`
+	Status    string `json:"status"`
+	Channel   string `json:"channel"`
+	Reference string `json:"reference"`
+	Attempt   int32  `json:"attempt"`
+}
+
+type recordAcknowledgmentReq struct {
+	Reference string   `json:"reference,omitempty"`
+	Accepted  bool     `json:"accepted"`
+	Errors    []string `json:"errors,omitempty"`
+}
+
+type recordAcknowledgmentResp struct {
+	ReportID         string   `json:"report_id"`
+	Status           string   `json:"status"`
+	ValidationErrors []string `json:"validation_errors,omitempty"`
+}
+
+type submissionAuditEntryMsg struct {
+	EntryID       string `json:"entry_id"`
+	Channel       string `json:"channel"`
+	Attempt       int32  `json:"attempt"`
+	Action        string `json:"action"`
+	Reference     string `json:"reference,omitempty"`
+	Detail        string `json:"detail,omitempty"`
+	Actor         string `json:"actor,omitempty"`
+	ContentSHA256 string `json:"content_sha256"`
+	OccurredAt    string `json:"occurred_at"`
+}
+
+type listSubmissionAuditResp struct {
+	Entries []*submissionAuditEntryMsg `json:"entries"`
 }
 
-// GenerateReport handles POST /api/v1/reports.
+// GenerateReport handles POST /api/v1/reports. When the request names a
+// format, the rendered report is returned as a download; otherwise the
+// generated report's summary is returned as JSON.
 func (p *ReportingProxy) GenerateReport(w http.ResponseWriter, r *http.Request) {
 	var req generateReportReq
 	if err := readJSON(r, &req); err != nil {
@@ -53,19 +114,49 @@ func (p *ReportingProxy) GenerateReport(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if req.TenantID == "" {
-		if claims, ok := auth.ClaimsFromContext(r.Context()); ok {
-			req.TenantID = claims.TenantID.String()
-		}
+	reportType, ok := readReportingEnum(w, "report_type", req.ReportType, "REPORT_TYPE_", reportingv1.ReportType_value)
+	if !ok {
+		return
+	}
+	format, ok := readReportingEnum(w, "format", req.Format, "REPORT_FORMAT_", reportingv1.ReportFormat_value)
+	if !ok {
+		return
 	}
 
-	var resp generateReportResp
-	err := p.conn.Invoke(r.Context(), "/bib.reporting.v1.ReportingService/GenerateReport", &req, &resp)
+	// The reporting service generates for the caller's tenant.
+	out, err := p.client.GenerateReport(r.Context(), &reportingv1.GenerateReportRequest{
+		Type:            reportingv1.ReportType(reportType),
+		ReportingPeriod: req.Period,
+		Format:          reportingv1.ReportFormat(format),
+	})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusCreated, resp)
+	resp := generateReportResp{
+		ReportID:    out.GetSubmission().GetId(),
+		Status:      enumName(out.GetSubmission().GetStatus().String(), "SUBMISSION_STATUS_"),
+		CreatedAt:   formatTimestamp(out.GetSubmission().GetGeneratedAt()),
+		Format:      enumName(out.GetFormat().String(), "REPORT_FORMAT_"),
+		ContentType: out.GetContentType(),
+		FileName:    out.GetFileName(),
+		Content:     out.GetContent(),
+	}
+
+	if req.Format == "" {
+		resp.Content = nil
+		writeJSON(w, http.StatusCreated, resp)
+		return
+	}
+
+	w.Header().Set("Content-Type", resp.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(resp.Content)))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.FileName))
+	w.Header().Set("X-Report-ID", resp.ReportID)
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(resp.Content) //nolint:errcheck // client disconnects are not actionable
 }
 
 // GetReport handles GET /api/v1/reports/{id}.
@@ -76,14 +167,12 @@ func (p *ReportingProxy) GetReport(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req := map[string]string{"report_id": reportID}
-	var resp getReportResp
-	err := p.conn.Invoke(r.Context(), "/bib.reporting.v1.ReportingService/GetReport", &req, &resp)
+	resp, err := p.client.GetReport(r.Context(), &reportingv1.GetReportRequest{Id: reportID})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	writeJSON(w, http.StatusOK, toGetReportResp(resp.GetSubmission()))
 }
 
 // SubmitReport handles POST /api/v1/reports/{id}/submit.
@@ -94,12 +183,1006 @@ func (p *ReportingProxy) SubmitReport(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req := map[string]string{"report_id": reportID}
-	var resp submitReportResp
-	err := p.conn.Invoke(r.Context(), "/bib.reporting.v1.ReportingService/SubmitReport", &req, &resp)
+	resp, err := p.client.SubmitReport(r.Context(), &reportingv1.SubmitReportRequest{Id: reportID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, submitReportResp{
+		ReportID:  resp.GetSubmission().GetId(),
+		Status:    enumName(resp.GetSubmission().GetStatus().String(), "SUBMISSION_STATUS_"),
+		Channel:   enumName(resp.GetChannel().String(), "SUBMISSION_CHANNEL_"),
+		Reference: resp.GetReference(),
+		Attempt:   resp.GetAttempt(),
+	})
+}
+
+// RecordSubmissionAcknowledgment handles POST /api/v1/reports/{id}/acknowledgment.
+func (p *ReportingProxy) RecordSubmissionAcknowledgment(w http.ResponseWriter, r *http.Request) {
+	reportID := r.PathValue("id")
+	if reportID == "" {
+		writeError(w, http.StatusBadRequest, "report id is required")
+		return
+	}
+
+	var req recordAcknowledgmentReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.RecordSubmissionAcknowledgment(r.Context(), &reportingv1.RecordSubmissionAcknowledgmentRequest{
+		Id:        reportID,
+		Reference: req.Reference,
+		Accepted:  req.Accepted,
+		Errors:    req.Errors,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, recordAcknowledgmentResp{
+		ReportID:         resp.GetSubmission().GetId(),
+		Status:           enumName(resp.GetSubmission().GetStatus().String(), "SUBMISSION_STATUS_"),
+		ValidationErrors: resp.GetSubmission().GetValidationErrors(),
+	})
+}
+
+// ListSubmissionAudit handles GET /api/v1/reports/{id}/audit.
+func (p *ReportingProxy) ListSubmissionAudit(w http.ResponseWriter, r *http.Request) {
+	reportID := r.PathValue("id")
+	if reportID == "" {
+		writeError(w, http.StatusBadRequest, "report id is required")
+		return
+	}
+
+	resp, err := p.client.ListSubmissionAudit(r.Context(), &reportingv1.ListSubmissionAuditRequest{Id: reportID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listSubmissionAuditResp{Entries: make([]*submissionAuditEntryMsg, 0, len(resp.GetEntries()))}
+	for _, e := range resp.GetEntries() {
+		out.Entries = append(out.Entries, &submissionAuditEntryMsg{
+			EntryID:       e.GetId(),
+			Channel:       enumName(e.GetChannel().String(), "SUBMISSION_CHANNEL_"),
+			Attempt:       e.GetAttempt(),
+			Action:        e.GetAction(),
+			Reference:     e.GetReference(),
+			Detail:        e.GetDetail(),
+			Actor:         e.GetActor(),
+			ContentSHA256: e.GetContentSha256(),
+			OccurredAt:    formatTimestamp(e.GetOccurredAt()),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+type amendReportReq struct {
+	Reason string `json:"reason"`
+	Note   string `json:"note,omitempty"`
+}
+
+type factChangeMsg struct {
+	Concept string `json:"concept"`
+	Context string `json:"context"`
+	Unit    string `json:"unit,omitempty"`
+	Change  string `json:"change"`
+	From    string `json:"from,omitempty"`
+	To      string `json:"to,omitempty"`
+	Delta   string `json:"delta,omitempty"`
+}
+
+type amendReportResp struct {
+	ReportID   string           `json:"report_id"`
+	OriginalID string           `json:"original_id"`
+	AmendsID   string           `json:"amends_id"`
+	Revision   int32            `json:"revision"`
+	Status     string           `json:"status"`
+	Reason     string           `json:"reason"`
+	Note       string           `json:"note,omitempty"`
+	Changes    []*factChangeMsg `json:"changes"`
+}
+
+type reportVersionMsg struct {
+	ReportID        string `json:"report_id"`
+	Revision        int32  `json:"revision"`
+	Status          string `json:"status"`
+	AmendsID        string `json:"amends_id,omitempty"`
+	AmendmentReason string `json:"amendment_reason,omitempty"`
+	AmendmentNote   string `json:"amendment_note,omitempty"`
+	GeneratedAt     string `json:"generated_at,omitempty"`
+	SubmittedAt     string `json:"submitted_at,omitempty"`
+	CreatedAt       string `json:"created_at"`
+}
+
+type listReportVersionsResp struct {
+	Versions []*reportVersionMsg `json:"versions"`
+}
+
+type diffReportVersionsResp struct {
+	FromReportID string           `json:"from_report_id"`
+	ToReportID   string           `json:"to_report_id"`
+	FromRevision int32            `json:"from_revision"`
+	ToRevision   int32            `json:"to_revision"`
+	Changes      []*factChangeMsg `json:"changes"`
+}
+
+// AmendReport handles POST /api/v1/reports/{id}/amendments.
+func (p *ReportingProxy) AmendReport(w http.ResponseWriter, r *http.Request) {
+	reportID := r.PathValue("id")
+	if reportID == "" {
+		writeError(w, http.StatusBadRequest, "report id is required")
+		return
+	}
+
+	var req amendReportReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	reason, ok := readReportingEnum(w, "reason", req.Reason, "AMENDMENT_REASON_", reportingv1.AmendmentReason_value)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.AmendReport(r.Context(), &reportingv1.AmendReportRequest{
+		Id:     reportID,
+		Reason: reportingv1.AmendmentReason(reason),
+		Note:   req.Note,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	sub := resp.GetSubmission()
+	writeJSON(w, http.StatusCreated, amendReportResp{
+		ReportID:   sub.GetId(),
+		OriginalID: sub.GetOriginalId(),
+		AmendsID:   sub.GetAmendsId(),
+		Revision:   sub.GetRevision(),
+		Status:     enumName(sub.GetStatus().String(), "SUBMISSION_STATUS_"),
+		Reason:     enumName(sub.GetAmendmentReason().String(), "AMENDMENT_REASON_"),
+		Note:       sub.GetAmendmentNote(),
+		Changes:    toFactChangeMsgs(resp.GetChanges()),
+	})
+}
+
+// ListReportVersions handles GET /api/v1/reports/{id}/versions.
+func (p *ReportingProxy) ListReportVersions(w http.ResponseWriter, r *http.Request) {
+	reportID := r.PathValue("id")
+	if reportID == "" {
+		writeError(w, http.StatusBadRequest, "report id is required")
+		return
+	}
+
+	resp, err := p.client.ListReportVersions(r.Context(), &reportingv1.ListReportVersionsRequest{Id: reportID})
 	if err != nil {
 		handleGRPCError(w, err, p.logger)
 		return
 	}
-	writeJSON(w, http.StatusOK, resp)
+	out := listReportVersionsResp{Versions: make([]*reportVersionMsg, 0, len(resp.GetVersions()))}
+	for _, v := range resp.GetVersions() {
+		out.Versions = append(out.Versions, &reportVersionMsg{
+			ReportID:        v.GetId(),
+			Revision:        v.GetRevision(),
+			Status:          enumName(v.GetStatus().String(), "SUBMISSION_STATUS_"),
+			AmendsID:        v.GetAmendsId(),
+			AmendmentReason: enumName(v.GetAmendmentReason().String(), "AMENDMENT_REASON_"),
+			AmendmentNote:   v.GetAmendmentNote(),
+			GeneratedAt:     formatTimestamp(v.GetGeneratedAt()),
+			SubmittedAt:     formatTimestamp(v.GetSubmittedAt()),
+			CreatedAt:       formatTimestamp(v.GetAudit().GetCreatedAt()),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// DiffReportVersions handles GET /api/v1/reports/{id}/diff. The optional
+// "from" query parameter names the version to compare against; it defaults
+// to the version the report amends.
+func (p *ReportingProxy) DiffReportVersions(w http.ResponseWriter, r *http.Request) {
+	reportID := r.PathValue("id")
+	if reportID == "" {
+		writeError(w, http.StatusBadRequest, "report id is required")
+		return
+	}
+
+	resp, err := p.client.DiffReportVersions(r.Context(), &reportingv1.DiffReportVersionsRequest{
+		Id:     reportID,
+		FromId: r.URL.Query().Get("from"),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, diffReportVersionsResp{
+		FromReportID: resp.GetFromId(),
+		ToReportID:   resp.GetToId(),
+		FromRevision: resp.GetFromRevision(),
+		ToRevision:   resp.GetToRevision(),
+		Changes:      toFactChangeMsgs(resp.GetChanges()),
+	})
+}
+
+type reviewReportReq struct {
+	// Decision is APPROVED or CHANGES_REQUESTED.
+	Decision string `json:"decision"`
+	// Comment is required when requesting changes.
+	Comment string `json:"comment,omitempty"`
+}
+
+type reportReviewMsg struct {
+	ReviewID      string `json:"review_id"`
+	ReportID      string `json:"report_id"`
+	Decision      string `json:"decision"`
+	Reviewer      string `json:"reviewer"`
+	Comment       string `json:"comment,omitempty"`
+	ContentSHA256 string `json:"content_sha256"`
+	ReviewedAt    string `json:"reviewed_at"`
+}
+
+type reviewReportResp struct {
+	Review *reportReviewMsg `json:"review"`
+	Status string           `json:"status"`
+}
+
+type reviseReportResp struct {
+	ReportID    string `json:"report_id"`
+	Status      string `json:"status"`
+	PreparedBy  string `json:"prepared_by,omitempty"`
+	GeneratedAt string `json:"generated_at,omitempty"`
+}
+
+type listReportReviewsResp struct {
+	Reviews []*reportReviewMsg `json:"reviews"`
+}
+
+// ReviewReport handles POST /api/v1/reports/{id}/reviews.
+func (p *ReportingProxy) ReviewReport(w http.ResponseWriter, r *http.Request) {
+	reportID := r.PathValue("id")
+	if reportID == "" {
+		writeError(w, http.StatusBadRequest, "report id is required")
+		return
+	}
+
+	var req reviewReportReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	decision, ok := readReportingEnum(w, "decision", req.Decision, "REVIEW_DECISION_", reportingv1.ReviewDecision_value)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.ReviewReport(r.Context(), &reportingv1.ReviewReportRequest{
+		Id:       reportID,
+		Decision: reportingv1.ReviewDecision(decision),
+		Comment:  req.Comment,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusCreated, reviewReportResp{
+		Review: toReportReviewMsg(resp.GetReview()),
+		Status: enumName(resp.GetStatus().String(), "SUBMISSION_STATUS_"),
+	})
+}
+
+// ListReportReviews handles GET /api/v1/reports/{id}/reviews.
+func (p *ReportingProxy) ListReportReviews(w http.ResponseWriter, r *http.Request) {
+	reportID := r.PathValue("id")
+	if reportID == "" {
+		writeError(w, http.StatusBadRequest, "report id is required")
+		return
+	}
+
+	resp, err := p.client.ListReportReviews(r.Context(), &reportingv1.ListReportReviewsRequest{Id: reportID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listReportReviewsResp{Reviews: make([]*reportReviewMsg, 0, len(resp.GetReviews()))}
+	for _, rv := range resp.GetReviews() {
+		out.Reviews = append(out.Reviews, toReportReviewMsg(rv))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// ReviseReport handles POST /api/v1/reports/{id}/revise.
+func (p *ReportingProxy) ReviseReport(w http.ResponseWriter, r *http.Request) {
+	reportID := r.PathValue("id")
+	if reportID == "" {
+		writeError(w, http.StatusBadRequest, "report id is required")
+		return
+	}
+
+	resp, err := p.client.ReviseReport(r.Context(), &reportingv1.ReviseReportRequest{Id: reportID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	sub := resp.GetSubmission()
+	writeJSON(w, http.StatusOK, reviseReportResp{
+		ReportID:    sub.GetId(),
+		Status:      enumName(sub.GetStatus().String(), "SUBMISSION_STATUS_"),
+		PreparedBy:  sub.GetPreparedBy(),
+		GeneratedAt: formatTimestamp(sub.GetGeneratedAt()),
+	})
+}
+
+type upsertReportScheduleReq struct {
+	ReportType   string `json:"report_type"`
+	Frequency    string `json:"frequency"`
+	DeadlineDays int32  `json:"deadline_days"`
+	LeadDays     int32  `json:"lead_days"`
+	Active       bool   `json:"active"`
+}
+
+type reportScheduleMsg struct {
+	ScheduleID   string `json:"schedule_id"`
+	ReportType   string `json:"report_type"`
+	Frequency    string `json:"frequency"`
+	DeadlineDays int32  `json:"deadline_days"`
+	LeadDays     int32  `json:"lead_days"`
+	Active       bool   `json:"active"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+type upsertReportScheduleResp struct {
+	Schedule *reportScheduleMsg `json:"schedule"`
+}
+
+type listReportSchedulesResp struct {
+	Schedules []*reportScheduleMsg `json:"schedules"`
+}
+
+type scheduledRunMsg struct {
+	RunID         string `json:"run_id"`
+	ScheduleID    string `json:"schedule_id"`
+	ReportType    string `json:"report_type"`
+	Period        string `json:"period"`
+	Deadline      string `json:"deadline"`
+	Status        string `json:"status"`
+	ReportID      string `json:"report_id,omitempty"`
+	Attempts      int32  `json:"attempts"`
+	FailureReason string `json:"failure_reason,omitempty"`
+	SubmittedAt   string `json:"submitted_at,omitempty"`
+	SLAMet        bool   `json:"sla_met"`
+}
+
+type deadlineSLAMsg struct {
+	Open   int32 `json:"open"`
+	Met    int32 `json:"met"`
+	Late   int32 `json:"late"`
+	Missed int32 `json:"missed"`
+}
+
+type listScheduledRunsResp struct {
+	Runs []*scheduledRunMsg `json:"runs"`
+	SLA  *deadlineSLAMsg    `json:"sla"`
+}
+
+// UpsertReportSchedule handles PUT /api/v1/reports/schedules.
+func (p *ReportingProxy) UpsertReportSchedule(w http.ResponseWriter, r *http.Request) {
+	var req upsertReportScheduleReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	reportType, ok := readReportingEnum(w, "report_type", req.ReportType, "REPORT_TYPE_", reportingv1.ReportType_value)
+	if !ok {
+		return
+	}
+	frequency, ok := readReportingEnum(w, "frequency", req.Frequency, "REPORTING_FREQUENCY_", reportingv1.ReportingFrequency_value)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.UpsertReportSchedule(r.Context(), &reportingv1.UpsertReportScheduleRequest{
+		Type:         reportingv1.ReportType(reportType),
+		Frequency:    reportingv1.ReportingFrequency(frequency),
+		DeadlineDays: req.DeadlineDays,
+		LeadDays:     req.LeadDays,
+		Active:       req.Active,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, upsertReportScheduleResp{Schedule: toReportScheduleMsg(resp.GetSchedule())})
+}
+
+// ListReportSchedules handles GET /api/v1/reports/schedules.
+func (p *ReportingProxy) ListReportSchedules(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.ListReportSchedules(r.Context(), &reportingv1.ListReportSchedulesRequest{})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listReportSchedulesResp{Schedules: make([]*reportScheduleMsg, 0, len(resp.GetSchedules()))}
+	for _, s := range resp.GetSchedules() {
+		out.Schedules = append(out.Schedules, toReportScheduleMsg(s))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// ListScheduledRuns handles GET /api/v1/reports/schedules/runs.
+func (p *ReportingProxy) ListScheduledRuns(w http.ResponseWriter, r *http.Request) {
+	since, ok := readReportingTime(w, "since", r.URL.Query().Get("since"))
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.ListScheduledRuns(r.Context(), &reportingv1.ListScheduledRunsRequest{Since: since})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listScheduledRunsResp{
+		Runs: make([]*scheduledRunMsg, 0, len(resp.GetRuns())),
+		SLA: &deadlineSLAMsg{
+			Open:   resp.GetSla().GetOpen(),
+			Met:    resp.GetSla().GetMet(),
+			Late:   resp.GetSla().GetLate(),
+			Missed: resp.GetSla().GetMissed(),
+		},
+	}
+	for _, run := range resp.GetRuns() {
+		out.Runs = append(out.Runs, &scheduledRunMsg{
+			RunID:         run.GetId(),
+			ScheduleID:    run.GetScheduleId(),
+			ReportType:    enumName(run.GetType().String(), "REPORT_TYPE_"),
+			Period:        run.GetReportingPeriod(),
+			Deadline:      formatTimestamp(run.GetDeadline()),
+			Status:        enumName(run.GetStatus().String(), "RUN_STATUS_"),
+			ReportID:      run.GetReportId(),
+			Attempts:      run.GetAttempts(),
+			FailureReason: run.GetFailureReason(),
+			SubmittedAt:   formatTimestamp(run.GetSubmittedAt()),
+			SLAMet:        run.GetSlaMet(),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+type liquidityContributionMsg struct {
+	AccountCode string `json:"account_code"`
+	Rule        string `json:"rule"`
+	Level       string `json:"level,omitempty"`
+	Balance     string `json:"balance"`
+	Weight      string `json:"weight"`
+	Amount      string `json:"amount"`
+}
+
+type liquidityFigureMsg struct {
+	Name          string                     `json:"name"`
+	Gross         string                     `json:"gross"`
+	Amount        string                     `json:"amount"`
+	Contributions []liquidityContributionMsg `json:"contributions"`
+}
+
+type getLiquidityMetricsResp struct {
+	TenantID    string               `json:"tenant_id"`
+	Period      string               `json:"period"`
+	LCR         string               `json:"lcr"`
+	NSFR        string               `json:"nsfr"`
+	NetOutflows string               `json:"net_outflows"`
+	Figures     []liquidityFigureMsg `json:"figures"`
+}
+
+// GetLiquidityMetrics handles GET /api/v1/reports/liquidity?period=2025-Q1.
+func (p *ReportingProxy) GetLiquidityMetrics(w http.ResponseWriter, r *http.Request) {
+	period := r.URL.Query().Get("period")
+	if period == "" {
+		writeError(w, http.StatusBadRequest, "period is required")
+		return
+	}
+
+	resp, err := p.client.GetLiquidityMetrics(r.Context(), &reportingv1.GetLiquidityMetricsRequest{Period: period})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := getLiquidityMetricsResp{
+		TenantID:    resp.GetTenantId(),
+		Period:      resp.GetPeriod(),
+		LCR:         resp.GetLcr(),
+		NSFR:        resp.GetNsfr(),
+		NetOutflows: resp.GetNetOutflows(),
+		Figures:     make([]liquidityFigureMsg, 0, len(resp.GetFigures())),
+	}
+	for _, f := range resp.GetFigures() {
+		fig := liquidityFigureMsg{
+			Name:          f.GetName(),
+			Gross:         f.GetGross(),
+			Amount:        f.GetAmount(),
+			Contributions: make([]liquidityContributionMsg, 0, len(f.GetContributions())),
+		}
+		for _, c := range f.GetContributions() {
+			fig.Contributions = append(fig.Contributions, liquidityContributionMsg{
+				AccountCode: c.GetAccountCode(),
+				Rule:        c.GetRule(),
+				Level:       c.GetLevel(),
+				Balance:     c.GetBalance(),
+				Weight:      c.GetWeight(),
+				Amount:      c.GetAmount(),
+			})
+		}
+		out.Figures = append(out.Figures, fig)
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+type balanceSheetLineMsg struct {
+	Currency        string `json:"currency"`
+	Loans           string `json:"loans"`
+	Deposits        string `json:"deposits"`
+	AccruedInterest string `json:"accrued_interest"`
+	LoanToDeposit   string `json:"loan_to_deposit"`
+	LoanCount       int32  `json:"loan_count"`
+	DepositCount    int32  `json:"deposit_count"`
+}
+
+type getBalanceSheetSummaryResp struct {
+	Lines []balanceSheetLineMsg `json:"lines"`
+}
+
+// GetBalanceSheetSummary handles GET /api/v1/dashboards/balance-sheet.
+func (p *ReportingProxy) GetBalanceSheetSummary(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.GetBalanceSheetSummary(r.Context(), &reportingv1.GetBalanceSheetSummaryRequest{})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := getBalanceSheetSummaryResp{Lines: make([]balanceSheetLineMsg, 0, len(resp.GetLines()))}
+	for _, l := range resp.GetLines() {
+		out.Lines = append(out.Lines, balanceSheetLineMsg{
+			Currency:        l.GetCurrency(),
+			Loans:           l.GetLoans(),
+			Deposits:        l.GetDeposits(),
+			AccruedInterest: l.GetAccruedInterest(),
+			LoanToDeposit:   l.GetLoanToDeposit(),
+			LoanCount:       l.GetLoanCount(),
+			DepositCount:    l.GetDepositCount(),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+type depositGrowthPointMsg struct {
+	Period         string `json:"period"`
+	Currency       string `json:"currency"`
+	Opened         string `json:"opened"`
+	Closed         string `json:"closed"`
+	Net            string `json:"net"`
+	ClosingBalance string `json:"closing_balance"`
+}
+
+type getDepositGrowthResp struct {
+	Points []depositGrowthPointMsg `json:"points"`
+}
+
+// GetDepositGrowth handles GET /api/v1/dashboards/deposit-growth?from=2025-01-01&to=2025-03-31&granularity=MONTH.
+func (p *ReportingProxy) GetDepositGrowth(w http.ResponseWriter, r *http.Request) {
+	rng, ok := dashboardRange(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.GetDepositGrowth(r.Context(), &reportingv1.GetDepositGrowthRequest{
+		From:        rng.GetFrom(),
+		To:          rng.GetTo(),
+		Granularity: r.URL.Query().Get("granularity"),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := getDepositGrowthResp{Points: make([]depositGrowthPointMsg, 0, len(resp.GetPoints()))}
+	for _, pt := range resp.GetPoints() {
+		out.Points = append(out.Points, depositGrowthPointMsg{
+			Period:         pt.GetPeriod(),
+			Currency:       pt.GetCurrency(),
+			Opened:         pt.GetOpened(),
+			Closed:         pt.GetClosed(),
+			Net:            pt.GetNet(),
+			ClosingBalance: pt.GetClosingBalance(),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+type loanBookBucketMsg struct {
+	Bucket      string `json:"bucket"`
+	Currency    string `json:"currency"`
+	Outstanding string `json:"outstanding"`
+	Count       int32  `json:"count"`
+}
+
+type getLoanBookResp struct {
+	Buckets []loanBookBucketMsg `json:"buckets"`
+}
+
+// GetLoanBook handles GET /api/v1/dashboards/loan-book.
+func (p *ReportingProxy) GetLoanBook(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.GetLoanBook(r.Context(), &reportingv1.GetLoanBookRequest{})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := getLoanBookResp{Buckets: make([]loanBookBucketMsg, 0, len(resp.GetBuckets()))}
+	for _, b := range resp.GetBuckets() {
+		out.Buckets = append(out.Buckets, loanBookBucketMsg{
+			Bucket:      b.GetBucket(),
+			Currency:    b.GetCurrency(),
+			Outstanding: b.GetOutstanding(),
+			Count:       b.GetCount(),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+type railVolumeMsg struct {
+	Rail          string `json:"rail"`
+	Currency      string `json:"currency"`
+	SettledAmount string `json:"settled_amount"`
+	Initiated     int32  `json:"initiated"`
+	Settled       int32  `json:"settled"`
+	Failed        int32  `json:"failed"`
+	Reversed      int32  `json:"reversed"`
+}
+
+type getPaymentVolumesResp struct {
+	Rails []railVolumeMsg `json:"rails"`
+}
+
+// GetPaymentVolumes handles GET /api/v1/dashboards/payment-volumes?from=2025-01-01&to=2025-01-31.
+func (p *ReportingProxy) GetPaymentVolumes(w http.ResponseWriter, r *http.Request) {
+	req, ok := dashboardRange(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.GetPaymentVolumes(r.Context(), req)
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := getPaymentVolumesResp{Rails: make([]railVolumeMsg, 0, len(resp.GetRails()))}
+	for _, v := range resp.GetRails() {
+		out.Rails = append(out.Rails, railVolumeMsg{
+			Rail:          v.GetRail(),
+			Currency:      v.GetCurrency(),
+			SettledAmount: v.GetSettledAmount(),
+			Initiated:     v.GetInitiated(),
+			Settled:       v.GetSettled(),
+			Failed:        v.GetFailed(),
+			Reversed:      v.GetReversed(),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+type decisionRateMsg struct {
+	Decision string `json:"decision"`
+	Rate     string `json:"rate"`
+	Count    int32  `json:"count"`
+}
+
+type getFraudDecisionRatesResp struct {
+	Rates       []decisionRateMsg `json:"rates"`
+	Assessments int32             `json:"assessments"`
+}
+
+// GetFraudDecisionRates handles GET /api/v1/dashboards/fraud-decisions?from=2025-01-01&to=2025-01-31.
+func (p *ReportingProxy) GetFraudDecisionRates(w http.ResponseWriter, r *http.Request) {
+	req, ok := dashboardRange(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.GetFraudDecisionRates(r.Context(), req)
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := getFraudDecisionRatesResp{
+		Rates:       make([]decisionRateMsg, 0, len(resp.GetRates())),
+		Assessments: resp.GetAssessments(),
+	}
+	for _, rate := range resp.GetRates() {
+		out.Rates = append(out.Rates, decisionRateMsg{
+			Decision: rate.GetDecision(),
+			Rate:     rate.GetRate(),
+			Count:    rate.GetCount(),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// dashboardRange reads a dashboard's required from and to query parameters,
+// writing a 400 when either is missing.
+func dashboardRange(w http.ResponseWriter, r *http.Request) (*reportingv1.DashboardRangeRequest, bool) {
+	from, to := r.URL.Query().Get("from"), r.URL.Query().Get("to")
+	if from == "" || to == "" {
+		writeError(w, http.StatusBadRequest, "from and to are required")
+		return nil, false
+	}
+	return &reportingv1.DashboardRangeRequest{From: from, To: to}, true
+}
+
+type createReportJobReq struct {
+	ReportType string `json:"report_type"`
+	Period     string `json:"period"`
+	Format     string `json:"format,omitempty"`
+}
+
+type reportArtifactMsg struct {
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	SHA256      string `json:"sha256"`
+	SizeBytes   int64  `json:"size_bytes"`
+	// Href is the gateway route the artifact is downloaded from.
+	Href string `json:"href"`
+}
+
+type reportJobMsg struct {
+	JobID         string               `json:"job_id"`
+	ReportType    string               `json:"report_type"`
+	Period        string               `json:"period"`
+	Format        string               `json:"format,omitempty"`
+	RequestedBy   string               `json:"requested_by,omitempty"`
+	Status        string               `json:"status"`
+	Progress      int32                `json:"progress"`
+	Stage         string               `json:"stage,omitempty"`
+	ReportID      string               `json:"report_id,omitempty"`
+	Artifacts     []*reportArtifactMsg `json:"artifacts"`
+	FailureDetail string               `json:"failure_detail,omitempty"`
+	Attempts      int32                `json:"attempts"`
+	StartedAt     string               `json:"started_at,omitempty"`
+	CompletedAt   string               `json:"completed_at,omitempty"`
+	CreatedAt     string               `json:"created_at"`
+	UpdatedAt     string               `json:"updated_at"`
+}
+
+type reportJobResp struct {
+	Job *reportJobMsg `json:"job"`
+}
+
+type listReportJobsResp struct {
+	Jobs []*reportJobMsg `json:"jobs"`
+}
+
+// CreateReportJob handles POST /api/v1/report-jobs. The report is generated
+// in the background; poll GetReportJob for its progress.
+func (p *ReportingProxy) CreateReportJob(w http.ResponseWriter, r *http.Request) {
+	var req createReportJobReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	reportType, ok := readReportingEnum(w, "report_type", req.ReportType, "REPORT_TYPE_", reportingv1.ReportType_value)
+	if !ok {
+		return
+	}
+	format, ok := readReportingEnum(w, "format", req.Format, "REPORT_FORMAT_", reportingv1.ReportFormat_value)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.CreateReportJob(r.Context(), &reportingv1.CreateReportJobRequest{
+		ReportType: reportingv1.ReportType(reportType),
+		Period:     req.Period,
+		Format:     reportingv1.ReportFormat(format),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := reportJobResp{Job: toReportJobMsg(resp.GetJob())}
+	if out.Job != nil {
+		w.Header().Set("Location", "/api/v1/report-jobs/"+url.PathEscape(out.Job.JobID))
+	}
+	writeJSON(w, http.StatusAccepted, out)
+}
+
+// GetReportJob handles GET /api/v1/report-jobs/{id}.
+func (p *ReportingProxy) GetReportJob(w http.ResponseWriter, r *http.Request) {
+	jobID := r.PathValue("id")
+	if jobID == "" {
+		writeError(w, http.StatusBadRequest, "job id is required")
+		return
+	}
+
+	resp, err := p.client.GetReportJob(r.Context(), &reportingv1.GetReportJobRequest{JobId: jobID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, reportJobResp{Job: toReportJobMsg(resp.GetJob())})
+}
+
+// ListReportJobs handles GET /api/v1/report-jobs?limit=20.
+func (p *ReportingProxy) ListReportJobs(w http.ResponseWriter, r *http.Request) {
+	req := &reportingv1.ListReportJobsRequest{}
+	if v := r.URL.Query().Get("limit"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || limit < 0 {
+			writeError(w, http.StatusBadRequest, "limit must be a non-negative integer")
+			return
+		}
+		req.Limit = int32(limit) //nolint:gosec // parsed with a 32-bit size
+	}
+
+	resp, err := p.client.ListReportJobs(r.Context(), req)
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listReportJobsResp{Jobs: make([]*reportJobMsg, 0, len(resp.GetJobs()))}
+	for _, job := range resp.GetJobs() {
+		out.Jobs = append(out.Jobs, toReportJobMsg(job))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetReportJobArtifact handles GET /api/v1/report-jobs/{id}/artifacts/{name},
+// returning the artifact as a download.
+func (p *ReportingProxy) GetReportJobArtifact(w http.ResponseWriter, r *http.Request) {
+	jobID, name := r.PathValue("id"), r.PathValue("name")
+	if jobID == "" || name == "" {
+		writeError(w, http.StatusBadRequest, "job id and artifact name are required")
+		return
+	}
+
+	resp, err := p.client.GetReportJobArtifact(r.Context(), &reportingv1.GetReportJobArtifactRequest{JobId: jobID, Name: name})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+
+	w.Header().Set("Content-Type", resp.GetContentType())
+	w.Header().Set("Content-Length", strconv.Itoa(len(resp.GetContent())))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.GetName()))
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(resp.Content) //nolint:errcheck // client disconnects are not actionable
+}
+
+// readReportingEnum maps an optional enum field to its proto value, writing
+// a 400 when it names no value.
+func readReportingEnum(w http.ResponseWriter, field, name, prefix string, values map[string]int32) (int32, bool) {
+	if name == "" {
+		return 0, true
+	}
+	v, ok := values[prefix+strings.ToUpper(name)]
+	if !ok || v == 0 {
+		writeError(w, http.StatusBadRequest, "invalid "+field)
+		return 0, false
+	}
+	return v, true
+}
+
+// readReportingTime parses an optional RFC 3339 field, writing a 400 when it
+// is malformed.
+func readReportingTime(w http.ResponseWriter, field, value string) (*timestamppb.Timestamp, bool) {
+	if value == "" {
+		return nil, true
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, field+" must be an RFC 3339 timestamp")
+		return nil, false
+	}
+	return timestamppb.New(t), true
+}
+
+func toGetReportResp(s *reportingv1.ReportSubmission) getReportResp {
+	return getReportResp{
+		ReportID:            s.GetId(),
+		TenantID:            s.GetTenantId(),
+		ReportType:          enumName(s.GetType().String(), "REPORT_TYPE_"),
+		Period:              s.GetReportingPeriod(),
+		Status:              enumName(s.GetStatus().String(), "SUBMISSION_STATUS_"),
+		CreatedAt:           formatTimestamp(s.GetAudit().GetCreatedAt()),
+		UpdatedAt:           formatTimestamp(s.GetAudit().GetUpdatedAt()),
+		XBRLContent:         s.GetXbrlContent(),
+		SubmissionChannel:   enumName(s.GetSubmissionChannel().String(), "SUBMISSION_CHANNEL_"),
+		SubmissionReference: s.GetSubmissionReference(),
+		SubmissionAttempts:  s.GetSubmissionAttempts(),
+		ValidationErrors:    s.GetValidationErrors(),
+		Revision:            s.GetRevision(),
+		OriginalID:          s.GetOriginalId(),
+		AmendsID:            s.GetAmendsId(),
+		AmendmentReason:     enumName(s.GetAmendmentReason().String(), "AMENDMENT_REASON_"),
+		AmendmentNote:       s.GetAmendmentNote(),
+		LinkedCaseIDs:       s.GetLinkedCaseIds(),
+		PreparedBy:          s.GetPreparedBy(),
+		ApprovedBy:          s.GetApprovedBy(),
+		ApprovedAt:          formatTimestamp(s.GetApprovedAt()),
+	}
+}
+
+func toFactChangeMsgs(changes []*reportingv1.FactChange) []*factChangeMsg {
+	msgs := make([]*factChangeMsg, 0, len(changes))
+	for _, c := range changes {
+		msgs = append(msgs, &factChangeMsg{
+			Concept: c.GetConcept(),
+			Context: c.GetContext(),
+			Unit:    c.GetUnit(),
+			Change:  c.GetChange(),
+			From:    c.GetFrom(),
+			To:      c.GetTo(),
+			Delta:   c.GetDelta(),
+		})
+	}
+	return msgs
+}
+
+func toReportReviewMsg(r *reportingv1.ReportReview) *reportReviewMsg {
+	return &reportReviewMsg{
+		ReviewID:      r.GetId(),
+		ReportID:      r.GetReportId(),
+		Decision:      enumName(r.GetDecision().String(), "REVIEW_DECISION_"),
+		Reviewer:      r.GetReviewer(),
+		Comment:       r.GetComment(),
+		ContentSHA256: r.GetContentSha256(),
+		ReviewedAt:    formatTimestamp(r.GetReviewedAt()),
+	}
+}
+
+func toReportScheduleMsg(s *reportingv1.ReportSchedule) *reportScheduleMsg {
+	return &reportScheduleMsg{
+		ScheduleID:   s.GetId(),
+		ReportType:   enumName(s.GetType().String(), "REPORT_TYPE_"),
+		Frequency:    enumName(s.GetFrequency().String(), "REPORTING_FREQUENCY_"),
+		DeadlineDays: s.GetDeadlineDays(),
+		LeadDays:     s.GetLeadDays(),
+		Active:       s.GetActive(),
+		CreatedAt:    formatTimestamp(s.GetCreatedAt()),
+		UpdatedAt:    formatTimestamp(s.GetUpdatedAt()),
+	}
+}
+
+// toReportJobMsg maps a report job, linking each artifact to its download
+// route.
+func toReportJobMsg(j *reportingv1.ReportJob) *reportJobMsg {
+	if j == nil {
+		return nil
+	}
+	msg := &reportJobMsg{
+		JobID:         j.GetJobId(),
+		ReportType:    enumName(j.GetReportType().String(), "REPORT_TYPE_"),
+		Period:        j.GetPeriod(),
+		Format:        enumName(j.GetFormat().String(), "REPORT_FORMAT_"),
+		RequestedBy:   j.GetRequestedBy(),
+		Status:        enumName(j.GetStatus().String(), "REPORT_JOB_STATUS_"),
+		Progress:      j.GetProgress(),
+		Stage:         j.GetStage(),
+		ReportID:      j.GetReportId(),
+		Artifacts:     make([]*reportArtifactMsg, 0, len(j.GetArtifacts())),
+		FailureDetail: j.GetFailureDetail(),
+		Attempts:      j.GetAttempts(),
+		StartedAt:     formatTimestamp(j.GetStartedAt()),
+		CompletedAt:   formatTimestamp(j.GetCompletedAt()),
+		CreatedAt:     formatTimestamp(j.GetCreatedAt()),
+		UpdatedAt:     formatTimestamp(j.GetUpdatedAt()),
+	}
+	for _, a := range j.GetArtifacts() {
+		msg.Artifacts = append(msg.Artifacts, &reportArtifactMsg{
+			Name:        a.GetName(),
+			ContentType: a.GetContentType(),
+			SHA256:      a.GetSha256(),
+			SizeBytes:   a.GetSizeBytes(),
+			Href:        fmt.Sprintf("/api/v1/report-jobs/%s/artifacts/%s", url.PathEscape(msg.JobID), url.PathEscape(a.GetName())),
+		})
+	}
+	return msg
 }
@@ -0,0 +1,64 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const reportJobJSON = `{
+	"job_id": "7f1b3d5a-9c2e-4f8b-a6d0-2c4e6a8b0d3f",
+	"report_type": "REPORT_TYPE_COREP",
+	"period": "2026-Q1",
+	"format": "REPORT_FORMAT_XBRL",
+	"status": "REPORT_JOB_STATUS_QUEUED",
+	"progress": 0,
+	"attempts": 0,
+	"created_at": "2026-04-01T09:00:00Z",
+	"updated_at": "2026-04-01T09:00:00Z"
+}`
+
+func TestReportingContract(t *testing.T) {
+	stub, conn := newContractStub(t, "reporting-service")
+	p := NewReportingProxy(conn, conn.Logger)
+	jobID := contractReportJobID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "queue a report job",
+		Method:      "/bib.reporting.v1.ReportingService/CreateReportJob",
+		Response:    json.RawMessage(`{"job": ` + reportJobJSON + `}`),
+	})
+	rec := call(t, p.CreateReportJob, http.MethodPost, "/api/v1/report-jobs",
+		`{"report_type": "COREP", "period": "2026-Q1", "format": "XBRL"}`)
+	if rec.Code != http.StatusAccepted || rec.Header().Get("Location") != "/api/v1/report-jobs/"+jobID {
+		t.Errorf("CreateReportJob = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a report job",
+		State:       "a report job is queued",
+		Method:      "/bib.reporting.v1.ReportingService/GetReportJob",
+		Response:    json.RawMessage(`{"job": ` + reportJobJSON + `}`),
+	})
+	rec = call(t, p.GetReportJob, http.MethodGet, "/api/v1/report-jobs/"+jobID, "", "id", jobID)
+	if rec.Code != http.StatusOK {
+		t.Errorf("GetReportJob = %d %s", rec.Code, rec.Body)
+	} else if job, _ := decodeBody(t, rec)["job"].(map[string]any); job["status"] != "QUEUED" {
+		t.Errorf("GetReportJob job = %v", job)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing report job",
+		Method:      "/bib.reporting.v1.ReportingService/GetReportJob",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetReportJob, http.MethodGet, "/api/v1/report-jobs/"+jobID, "", "id", jobID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetReportJob of a missing job = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-reporting-service.json"))
+}
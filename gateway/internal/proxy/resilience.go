@@ -0,0 +1,274 @@
+package proxy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bibbank/bib/gateway/internal/middleware"
+)
+
+// ResiliencePolicy configures how a ServiceConn calls its backend. Zero
+// values take the defaults of DefaultResiliencePolicy.
+type ResiliencePolicy struct {
+	// Timeout bounds each attempt of a unary call, unless the caller's own
+	// deadline is sooner.
+	Timeout time.Duration
+	// RetryBackoff is the wait before the first retry, doubled before each
+	// retry after it.
+	RetryBackoff time.Duration
+	// BreakerCooldown is how long the breaker stays open before a probe call
+	// is let through.
+	BreakerCooldown time.Duration
+	// MaxAttempts is the number of attempts a retryable call gets, counting
+	// the first.
+	MaxAttempts int
+	// BreakerThreshold is the number of consecutive failed calls that opens
+	// the breaker.
+	BreakerThreshold int
+}
+
+// DefaultResiliencePolicy returns the policy of backends not configured
+// otherwise.
+func DefaultResiliencePolicy() ResiliencePolicy {
+	return ResiliencePolicy{
+		Timeout:          10 * time.Second,
+		RetryBackoff:     100 * time.Millisecond,
+		BreakerCooldown:  30 * time.Second,
+		MaxAttempts:      3,
+		BreakerThreshold: 5,
+	}
+}
+
+func (p ResiliencePolicy) withDefaults() ResiliencePolicy {
+	def := DefaultResiliencePolicy()
+	if p.Timeout <= 0 {
+		p.Timeout = def.Timeout
+	}
+	if p.RetryBackoff <= 0 {
+		p.RetryBackoff = def.RetryBackoff
+	}
+	if p.BreakerCooldown <= 0 {
+		p.BreakerCooldown = def.BreakerCooldown
+	}
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = def.MaxAttempts
+	}
+	if p.BreakerThreshold <= 0 {
+		p.BreakerThreshold = def.BreakerThreshold
+	}
+	return p
+}
+
+// BreakerState is the state of a backend's circuit breaker.
+type BreakerState string
+
+// Circuit breaker states. A closed breaker lets calls through; an open one
+// fails them fast with Unavailable until its cooldown has passed, when it
+// turns half-open and lets a single probe call through. The probe closes
+// the breaker if the backend answers and reopens it if not.
+const (
+	BreakerClosed   BreakerState = "closed"
+	BreakerOpen     BreakerState = "open"
+	BreakerHalfOpen BreakerState = "half_open"
+)
+
+// BackendStatus is the health of a backend as the gateway sees it.
+type BackendStatus struct {
+	// Regions reports, for connections made with DialRegions, whether each
+	// region passed its last health check.
+	Regions map[string]bool `json:"regions,omitempty"`
+	State   BreakerState    `json:"state"`
+	// ConsecutiveFailures counts the failed calls since the last success.
+	ConsecutiveFailures int `json:"consecutive_failures"`
+}
+
+// breaker is a circuit breaker over the calls to one backend. Only
+// Unavailable and DeadlineExceeded count as failures: any other answer
+// shows the backend is up.
+type breaker struct {
+	now      func() time.Time
+	openedAt time.Time
+	state    BreakerState
+	cooldown time.Duration
+	failures int
+	limit    int
+	probing  bool
+	mu       sync.Mutex
+}
+
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{now: time.Now, state: BreakerClosed, limit: threshold, cooldown: cooldown}
+}
+
+// allow reports whether a unary call may go ahead, and whether it is the
+// half-open probe. Once the cooldown of an open breaker has passed, the
+// first caller becomes the probe and the rest are refused until it
+// completes.
+func (b *breaker) allow() (ok, probe bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case BreakerOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return false, false
+		}
+		b.state, b.probing = BreakerHalfOpen, true
+		return true, true
+	case BreakerHalfOpen:
+		if b.probing {
+			return false, false
+		}
+		b.probing = true
+		return true, true
+	default:
+		return true, false
+	}
+}
+
+// rejects reports whether the breaker is open with its cooldown still
+// running. Streams check it instead of allow, as they never probe.
+func (b *breaker) rejects() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state == BreakerOpen && b.now().Sub(b.openedAt) < b.cooldown
+}
+
+// record records the outcome of a call let through by allow, made on behalf
+// of a caller with context ctx, returning the state before and after it.
+// Only the probe decides a half-open breaker: calls let through before the
+// breaker opened count only while it is closed.
+func (b *breaker) record(ctx context.Context, probe bool, err error) (from, to BreakerState) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	from = b.state
+	if probe {
+		b.probing = false
+	} else if b.state != BreakerClosed {
+		return from, b.state
+	}
+	switch {
+	case status.Code(err) == codes.Canceled, ctx.Err() != nil:
+		// The caller gave up or ran out of its own time; that says nothing
+		// of the backend.
+	case isTransient(err):
+		b.failures++
+		if probe || b.failures >= b.limit {
+			b.state, b.openedAt = BreakerOpen, b.now()
+		}
+	default:
+		b.state, b.failures = BreakerClosed, 0
+	}
+	return from, b.state
+}
+
+func (b *breaker) status() BackendStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return BackendStatus{State: b.state, ConsecutiveFailures: b.failures}
+}
+
+// isTransient reports whether err is a failure worth retrying: the backend
+// was unreachable or did not answer in time.
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// resilience holds a ServiceConn's policy and breaker.
+type resilience struct {
+	breaker *breaker
+	policy  ResiliencePolicy
+}
+
+// SetResilience makes the connection's calls follow policy: each attempt of
+// a unary call is bounded by the policy's timeout, reads and calls carrying
+// an idempotency key are retried with backoff when the backend is
+// unavailable or times out, and a circuit breaker fails calls fast while the
+// backend keeps failing. Other writes are never retried, as the first
+// attempt may have applied. Calls by sandbox tenants bypass the policy, so
+// the sandbox deployment cannot trip the live backend's breaker.
+func (sc *ServiceConn) SetResilience(policy ResiliencePolicy) {
+	policy = policy.withDefaults()
+	sc.resilience = &resilience{
+		breaker: newBreaker(policy.BreakerThreshold, policy.BreakerCooldown),
+		policy:  policy,
+	}
+}
+
+// Status returns the backend's breaker state and, for connections made with
+// DialRegions, the health of each region. Connections without a resilience
+// policy report a closed breaker.
+func (sc *ServiceConn) Status() BackendStatus {
+	st := BackendStatus{State: BreakerClosed}
+	if sc.resilience != nil {
+		st = sc.resilience.breaker.status()
+	}
+	if len(sc.regions) > 0 {
+		st.Regions = make(map[string]bool, len(sc.regions))
+		for _, rc := range sc.regions {
+			st.Regions[rc.region] = rc.healthy.Load()
+		}
+	}
+	return st
+}
+
+// call makes a unary call with invoke under the connection's resilience
+// policy.
+func (sc *ServiceConn) call(ctx context.Context, method string, invoke func(context.Context) error) error {
+	r := sc.resilience
+	if r == nil {
+		return invoke(ctx)
+	}
+	_, hasKey := middleware.IdempotencyKeyFromContext(ctx)
+	retryable := isReadMethod(method) || hasKey
+
+	backoff := r.policy.RetryBackoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		ok, probe := r.breaker.allow()
+		if !ok {
+			if err != nil {
+				return err
+			}
+			return status.Errorf(codes.Unavailable, "%s circuit breaker open", sc.Name)
+		}
+		attemptCtx, cancel := context.WithTimeout(ctx, r.policy.Timeout)
+		err = invoke(attemptCtx)
+		cancel()
+		if from, to := r.breaker.record(ctx, probe, err); from != to {
+			sc.Logger.Warn("backend circuit breaker state changed",
+				"service", sc.Name, "from", from, "to", to, "error", err)
+		}
+		if err == nil || !retryable || !isTransient(err) || attempt >= r.policy.MaxAttempts {
+			return err
+		}
+		sc.Logger.Warn("backend call failed, retrying",
+			"service", sc.Name, "method", method, "attempt", attempt, "error", err)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+// checkBreaker fails a stream fast while the backend's breaker is open.
+// Streams are neither timed out nor retried, as they may run for as long as
+// the caller reads them.
+func (sc *ServiceConn) checkBreaker() error {
+	if sc.resilience != nil && sc.resilience.breaker.rejects() {
+		return status.Errorf(codes.Unavailable, "%s circuit breaker open", sc.Name)
+	}
+	return nil
+}
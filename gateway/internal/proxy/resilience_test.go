@@ -0,0 +1,279 @@
+package proxy
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/bibbank/bib/gateway/internal/middleware"
+)
+
+// flakyBackend answers calls, failing the first fail of them with code.
+type flakyBackend struct {
+	code  codes.Code
+	calls atomic.Int32
+	fail  atomic.Int32
+	delay time.Duration
+}
+
+func newFlakyConn(t *testing.T, b *flakyBackend, policy ResiliencePolicy) *ServiceConn {
+	t.Helper()
+	lis := bufconn.Listen(1 << 16)
+	srv := grpc.NewServer(grpc.UnknownServiceHandler(func(_ any, stream grpc.ServerStream) error {
+		var req structpb.Struct
+		if err := stream.RecvMsg(&req); err != nil {
+			return err
+		}
+		b.calls.Add(1)
+		if b.delay > 0 {
+			select {
+			case <-time.After(b.delay):
+			case <-stream.Context().Done():
+				return stream.Context().Err()
+			}
+		}
+		if b.fail.Add(-1) >= 0 {
+			return status.Error(b.code, "backend failing")
+		}
+		return stream.SendMsg(&structpb.Struct{Fields: map[string]*structpb.Value{"ok": structpb.NewStringValue("yes")}})
+	}))
+	go srv.Serve(lis) //nolint:errcheck // stopped by cleanup
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///account",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	sc := &ServiceConn{Name: "account-service", Conn: conn, Logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	sc.SetResilience(policy)
+	return sc
+}
+
+func invokeFlaky(ctx context.Context, sc *ServiceConn, method string) error {
+	var resp structpb.Struct
+	return sc.Invoke(ctx, method, &structpb.Struct{}, &resp)
+}
+
+// idempotentContext returns a context carrying an idempotency key, as
+// IdempotencyKeyMiddleware leaves it.
+func idempotentContext(t *testing.T) context.Context {
+	t.Helper()
+	var ctx context.Context
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/accounts", nil)
+	req.Header.Set("Idempotency-Key", "key-1")
+	middleware.IdempotencyKeyMiddleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	})).ServeHTTP(httptest.NewRecorder(), req)
+	return ctx
+}
+
+func TestResilience_RetriesTransientFailures(t *testing.T) {
+	policy := ResiliencePolicy{MaxAttempts: 3, RetryBackoff: time.Millisecond, BreakerThreshold: 10}
+
+	for _, tc := range []struct {
+		name      string
+		ctx       context.Context
+		method    string
+		code      codes.Code
+		wantCalls int32
+		wantErr   codes.Code
+	}{
+		{"read retried on Unavailable", context.Background(), getMethod, codes.Unavailable, 3, codes.OK},
+		{"read retried on DeadlineExceeded", context.Background(), getMethod, codes.DeadlineExceeded, 3, codes.OK},
+		{"read not retried on other errors", context.Background(), getMethod, codes.NotFound, 1, codes.NotFound},
+		{"write not retried", context.Background(), openMethod, codes.Unavailable, 1, codes.Unavailable},
+		{"idempotent write retried", idempotentContext(t), openMethod, codes.Unavailable, 3, codes.OK},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			b := &flakyBackend{code: tc.code}
+			b.fail.Store(2)
+			sc := newFlakyConn(t, b, policy)
+
+			err := invokeFlaky(tc.ctx, sc, tc.method)
+			if status.Code(err) != tc.wantErr {
+				t.Errorf("err = %v, want %s", err, tc.wantErr)
+			}
+			if got := b.calls.Load(); got != tc.wantCalls {
+				t.Errorf("backend called %d times, want %d", got, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func TestResilience_GivesUpAfterMaxAttempts(t *testing.T) {
+	b := &flakyBackend{code: codes.Unavailable}
+	b.fail.Store(10)
+	sc := newFlakyConn(t, b, ResiliencePolicy{MaxAttempts: 2, RetryBackoff: time.Millisecond, BreakerThreshold: 10})
+
+	if err := invokeFlaky(context.Background(), sc, getMethod); status.Code(err) != codes.Unavailable {
+		t.Fatalf("err = %v, want Unavailable", err)
+	}
+	if got := b.calls.Load(); got != 2 {
+		t.Errorf("backend called %d times, want 2", got)
+	}
+}
+
+func TestResilience_TimesOutEachAttempt(t *testing.T) {
+	b := &flakyBackend{delay: time.Second}
+	sc := newFlakyConn(t, b, ResiliencePolicy{Timeout: 20 * time.Millisecond, MaxAttempts: 1})
+
+	start := time.Now()
+	if err := invokeFlaky(context.Background(), sc, getMethod); status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("err = %v, want DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("call took %s, want it cut off by the attempt timeout", elapsed)
+	}
+}
+
+func TestResilience_CircuitBreaker(t *testing.T) {
+	b := &flakyBackend{code: codes.Unavailable}
+	b.fail.Store(3)
+	sc := newFlakyConn(t, b, ResiliencePolicy{MaxAttempts: 1, BreakerThreshold: 3, BreakerCooldown: time.Minute})
+	now := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+	sc.resilience.breaker.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		_ = invokeFlaky(context.Background(), sc, openMethod) //nolint:errcheck // failing on purpose
+	}
+	if st := sc.Status(); st.State != BreakerOpen || st.ConsecutiveFailures != 3 {
+		t.Fatalf("status after 3 failures = %+v, want open", st)
+	}
+
+	// An open breaker fails calls without reaching the backend.
+	if err := invokeFlaky(context.Background(), sc, openMethod); status.Code(err) != codes.Unavailable {
+		t.Errorf("call with breaker open: %v, want Unavailable", err)
+	}
+	if _, err := sc.NewStream(context.Background(), &grpc.StreamDesc{ServerStreams: true}, getMethod); status.Code(err) != codes.Unavailable {
+		t.Errorf("stream with breaker open: %v, want Unavailable", err)
+	}
+	if got := b.calls.Load(); got != 3 {
+		t.Errorf("backend called %d times, want 3", got)
+	}
+
+	// After the cooldown a single probe goes through; the backend has
+	// recovered, so the breaker closes.
+	now = now.Add(time.Minute)
+	if ok, probe := sc.resilience.breaker.allow(); !ok || !probe {
+		t.Fatal("breaker refused the probe after its cooldown")
+	}
+	if ok, _ := sc.resilience.breaker.allow(); ok {
+		t.Error("breaker let a second call through while probing")
+	}
+	sc.resilience.breaker.record(context.Background(), true, nil)
+	if st := sc.Status(); st.State != BreakerClosed || st.ConsecutiveFailures != 0 {
+		t.Errorf("status after successful probe = %+v, want closed", st)
+	}
+	if err := invokeFlaky(context.Background(), sc, openMethod); err != nil {
+		t.Errorf("call with breaker closed: %v", err)
+	}
+}
+
+func TestBreaker_FailedProbeReopens(t *testing.T) {
+	now := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+	br := newBreaker(1, time.Minute)
+	br.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	unavailable := status.Error(codes.Unavailable, "down")
+	br.record(ctx, false, unavailable)
+	if ok, _ := br.allow(); ok {
+		t.Fatal("open breaker allowed a call")
+	}
+
+	now = now.Add(time.Minute)
+	if ok, probe := br.allow(); !ok || !probe {
+		t.Fatal("breaker refused the probe after its cooldown")
+	}
+	if from, to := br.record(ctx, true, unavailable); from != BreakerHalfOpen || to != BreakerOpen {
+		t.Errorf("failed probe moved breaker %s -> %s, want half_open -> open", from, to)
+	}
+	if ok, _ := br.allow(); ok {
+		t.Error("breaker allowed a call right after a failed probe")
+	}
+
+	// A probe the caller cancelled decides nothing; the next call probes.
+	now = now.Add(time.Minute)
+	br.allow()
+	br.record(ctx, true, status.Error(codes.Canceled, "caller went away"))
+	if ok, _ := br.allow(); !ok {
+		t.Error("breaker refused a new probe after a cancelled one")
+	}
+}
+
+func TestBreaker_StaleCallDuringHalfOpen(t *testing.T) {
+	now := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+	br := newBreaker(1, time.Minute)
+	br.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// A call let through while closed is still in flight when another
+	// opens the breaker.
+	_, staleProbe := br.allow()
+	br.record(ctx, false, status.Error(codes.Unavailable, "down"))
+
+	now = now.Add(time.Minute)
+	if ok, probe := br.allow(); !ok || !probe {
+		t.Fatal("breaker refused the probe after its cooldown")
+	}
+
+	// The stale call finishing decides nothing, whatever its outcome, and
+	// does not free the probe's slot.
+	for _, err := range []error{nil, status.Error(codes.Unavailable, "down")} {
+		if from, to := br.record(ctx, staleProbe, err); from != BreakerHalfOpen || to != BreakerHalfOpen {
+			t.Errorf("stale call (%v) moved breaker %s -> %s, want half_open -> half_open", err, from, to)
+		}
+	}
+	if ok, _ := br.allow(); ok {
+		t.Error("breaker let a second probe through while probing")
+	}
+
+	if from, to := br.record(ctx, true, nil); from != BreakerHalfOpen || to != BreakerClosed {
+		t.Errorf("successful probe moved breaker %s -> %s, want half_open -> closed", from, to)
+	}
+}
+
+func TestBreaker_IgnoresCallerDeadline(t *testing.T) {
+	br := newBreaker(1, time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+
+	// The caller's own deadline ran out, not the backend's time.
+	_, probe := br.allow()
+	if from, to := br.record(ctx, probe, status.Error(codes.DeadlineExceeded, "deadline exceeded")); from != BreakerClosed || to != BreakerClosed {
+		t.Errorf("caller deadline moved breaker %s -> %s, want closed -> closed", from, to)
+	}
+	if st := br.status(); st.ConsecutiveFailures != 0 {
+		t.Errorf("failures = %d, want 0", st.ConsecutiveFailures)
+	}
+}
+
+func TestStatus_ReportsRegions(t *testing.T) {
+	f := newRegionFixture(t)
+	f.regions["us-east-1"].healthy.Store(false)
+
+	st := f.conn.Status()
+	if st.State != BreakerClosed {
+		t.Errorf("state = %s, want closed", st.State)
+	}
+	if !st.Regions["eu-west-1"] || st.Regions["us-east-1"] {
+		t.Errorf("regions = %v, want eu-west-1 healthy and us-east-1 not", st.Regions)
+	}
+}
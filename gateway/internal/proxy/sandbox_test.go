@@ -0,0 +1,42 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSandbox_RoutesSandboxTenantsToSandboxDeployment(t *testing.T) {
+	f := newRegionFixture(t)
+	sandboxID := uuid.New()
+	f.conn.sandbox = NewSandbox([]uuid.UUID{sandboxID})
+	f.conn.sandboxConn = regionServer(t, "sandbox", new(bool))
+
+	for _, tc := range []struct {
+		tenant uuid.UUID
+		method string
+		want   string
+	}{
+		{sandboxID, openMethod, "sandbox"},
+		{sandboxID, getMethod, "sandbox"},
+		{f.euID, openMethod, "eu-west-1"},
+		{f.usID, openMethod, "us-east-1"},
+	} {
+		got, err := f.call(t, tc.tenant, tc.method)
+		if err != nil || got != tc.want {
+			t.Errorf("%s by %s = %q, %v; want %q", tc.method, tc.tenant, got, err, tc.want)
+		}
+	}
+}
+
+func TestSandbox_NeverFallsBackToLiveDeployment(t *testing.T) {
+	f := newRegionFixture(t)
+	sandboxID := uuid.New()
+	f.conn.sandbox = NewSandbox([]uuid.UUID{sandboxID})
+
+	if got, err := f.call(t, sandboxID, openMethod); status.Code(err) != codes.Unavailable {
+		t.Errorf("sandbox call without sandbox connection = %q, %v; want Unavailable", got, err)
+	}
+}
@@ -0,0 +1,588 @@
+package proxy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	schedulerv1 "github.com/bibbank/bib/api/gen/go/bib/scheduler/v1"
+)
+
+// SchedulerProxy proxies HTTP requests to the scheduler gRPC service.
+type SchedulerProxy struct {
+	client schedulerv1.SchedulerServiceClient
+	logger *slog.Logger
+}
+
+// NewSchedulerProxy creates a new scheduler service proxy.
+func NewSchedulerProxy(conn *ServiceConn, logger *slog.Logger) *SchedulerProxy {
+	return &SchedulerProxy{client: schedulerv1.NewSchedulerServiceClient(conn), logger: logger}
+}
+
+type jobTarget struct {
+	Kind    string          `json:"kind"`
+	Service string          `json:"service,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Topic   string          `json:"topic,omitempty"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type jobRetryPolicy struct {
+	Backoff     string `json:"backoff"`
+	MaxAttempts int32  `json:"max_attempts"`
+}
+
+type jobResp struct {
+	Target      *jobTarget      `json:"target"`
+	Retry       *jobRetryPolicy `json:"retry"`
+	JobID       string          `json:"job_id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Schedule    string          `json:"schedule"`
+	NextRunAt   string          `json:"next_run_at"`
+	LastRunAt   string          `json:"last_run_at,omitempty"`
+	CreatedAt   string          `json:"created_at"`
+	UpdatedAt   string          `json:"updated_at"`
+	Enabled     bool            `json:"enabled"`
+	Version     int32           `json:"version"`
+}
+
+type jobEnvelope struct {
+	Job *jobResp `json:"job"`
+}
+
+type jobRunResp struct {
+	RunID        string `json:"run_id"`
+	JobID        string `json:"job_id"`
+	JobName      string `json:"job_name"`
+	Trigger      string `json:"trigger"`
+	Status       string `json:"status"`
+	ScheduledFor string `json:"scheduled_for"`
+	Error        string `json:"error,omitempty"`
+	StartedAt    string `json:"started_at"`
+	FinishedAt   string `json:"finished_at,omitempty"`
+	NextRetryAt  string `json:"next_retry_at,omitempty"`
+	Attempt      int32  `json:"attempt"`
+	MaxAttempts  int32  `json:"max_attempts"`
+}
+
+type jobRunEnvelope struct {
+	Run *jobRunResp `json:"run"`
+}
+
+type jobDefinitionReq struct {
+	Target      *jobTarget      `json:"target"`
+	Retry       *jobRetryPolicy `json:"retry"`
+	Name        string          `json:"name,omitempty"`
+	Description string          `json:"description"`
+	Schedule    string          `json:"schedule"`
+}
+
+type jobPage struct {
+	PageSize int32
+	Offset   int32
+}
+
+type listJobsResp struct {
+	Jobs       []*jobResp `json:"jobs"`
+	TotalCount int32      `json:"total_count"`
+}
+
+type listJobRunsResp struct {
+	Runs       []*jobRunResp `json:"runs"`
+	TotalCount int32         `json:"total_count"`
+}
+
+type eodStepResp struct {
+	Name       string   `json:"name"`
+	JobName    string   `json:"job_name"`
+	Status     string   `json:"status"`
+	RunID      string   `json:"run_id,omitempty"`
+	Error      string   `json:"error,omitempty"`
+	StartedAt  string   `json:"started_at,omitempty"`
+	FinishedAt string   `json:"finished_at,omitempty"`
+	DependsOn  []string `json:"depends_on"`
+}
+
+type eodRunResp struct {
+	EODRunID     string         `json:"eod_run_id"`
+	BusinessDate string         `json:"business_date"`
+	Trigger      string         `json:"trigger"`
+	Status       string         `json:"status"`
+	StartedAt    string         `json:"started_at"`
+	UpdatedAt    string         `json:"updated_at"`
+	FinishedAt   string         `json:"finished_at,omitempty"`
+	Steps        []*eodStepResp `json:"steps"`
+	Version      int32          `json:"version"`
+}
+
+type eodRunEnvelope struct {
+	Run *eodRunResp `json:"run"`
+}
+
+type listEODRunsResp struct {
+	Runs       []*eodRunResp `json:"runs"`
+	TotalCount int32         `json:"total_count"`
+}
+
+type tenantClockResp struct {
+	BusinessDate string `json:"business_date"`
+	TenantID     string `json:"tenant_id"`
+}
+
+type advanceClockResp struct {
+	Clock         *tenantClockResp `json:"clock"`
+	DaysSimulated int32            `json:"days_simulated"`
+	JobRuns       int32            `json:"job_runs"`
+}
+
+type tenantClockEnvelope struct {
+	Clock *tenantClockResp `json:"clock"`
+}
+
+// RegisterJob handles POST /api/v1/scheduler/jobs.
+func (p *SchedulerProxy) RegisterJob(w http.ResponseWriter, r *http.Request) {
+	var req jobDefinitionReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	target, err := toJobTargetMsg(req.Target)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.RegisterJob(r.Context(), &schedulerv1.RegisterJobRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		Schedule:    req.Schedule,
+		Target:      target,
+		Retry:       toRetryPolicyMsg(req.Retry),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusCreated, jobEnvelope{Job: toJobResp(resp.GetJob())})
+}
+
+// UpdateJob handles PUT /api/v1/scheduler/jobs/{id}.
+func (p *SchedulerProxy) UpdateJob(w http.ResponseWriter, r *http.Request) {
+	var req jobDefinitionReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	target, err := toJobTargetMsg(req.Target)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.UpdateJob(r.Context(), &schedulerv1.UpdateJobRequest{
+		JobId:       r.PathValue("id"),
+		Description: req.Description,
+		Schedule:    req.Schedule,
+		Target:      target,
+		Retry:       toRetryPolicyMsg(req.Retry),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, jobEnvelope{Job: toJobResp(resp.GetJob())})
+}
+
+// ListJobs handles GET /api/v1/scheduler/jobs.
+// Query parameters: page_size, offset.
+func (p *SchedulerProxy) ListJobs(w http.ResponseWriter, r *http.Request) {
+	page, ok := readJobPage(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.ListJobs(r.Context(), &schedulerv1.ListJobsRequest{PageSize: page.PageSize, Offset: page.Offset})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listJobsResp{Jobs: make([]*jobResp, 0, len(resp.GetJobs())), TotalCount: resp.GetTotalCount()}
+	for _, j := range resp.GetJobs() {
+		out.Jobs = append(out.Jobs, toJobResp(j))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetJob handles GET /api/v1/scheduler/jobs/{id}.
+func (p *SchedulerProxy) GetJob(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	resp, err := p.client.GetJob(r.Context(), &schedulerv1.GetJobRequest{JobId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, jobEnvelope{Job: toJobResp(resp.GetJob())})
+}
+
+// PauseJob handles POST /api/v1/scheduler/jobs/{id}/pause.
+func (p *SchedulerProxy) PauseJob(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	resp, err := p.client.PauseJob(r.Context(), &schedulerv1.PauseJobRequest{JobId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, jobEnvelope{Job: toJobResp(resp.GetJob())})
+}
+
+// ResumeJob handles POST /api/v1/scheduler/jobs/{id}/resume.
+func (p *SchedulerProxy) ResumeJob(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	resp, err := p.client.ResumeJob(r.Context(), &schedulerv1.ResumeJobRequest{JobId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, jobEnvelope{Job: toJobResp(resp.GetJob())})
+}
+
+// TriggerJob handles POST /api/v1/scheduler/jobs/{id}/trigger, running the
+// job now and returning the run.
+func (p *SchedulerProxy) TriggerJob(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	resp, err := p.client.TriggerJob(r.Context(), &schedulerv1.TriggerJobRequest{JobId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, jobRunEnvelope{Run: toJobRunResp(resp.GetRun())})
+}
+
+// ListJobRuns handles GET /api/v1/scheduler/jobs/{id}/runs.
+// Query parameters: page_size, offset.
+func (p *SchedulerProxy) ListJobRuns(w http.ResponseWriter, r *http.Request) {
+	page, ok := readJobPage(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.ListJobRuns(r.Context(), &schedulerv1.ListJobRunsRequest{
+		JobId:    r.PathValue("id"),
+		PageSize: page.PageSize,
+		Offset:   page.Offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listJobRunsResp{Runs: make([]*jobRunResp, 0, len(resp.GetRuns())), TotalCount: resp.GetTotalCount()}
+	for _, run := range resp.GetRuns() {
+		out.Runs = append(out.Runs, toJobRunResp(run))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetJobRun handles GET /api/v1/scheduler/runs/{id}.
+func (p *SchedulerProxy) GetJobRun(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	resp, err := p.client.GetJobRun(r.Context(), &schedulerv1.GetJobRunRequest{RunId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, jobRunEnvelope{Run: toJobRunResp(resp.GetRun())})
+}
+
+// RetryJobRun handles POST /api/v1/scheduler/runs/{id}/retry.
+func (p *SchedulerProxy) RetryJobRun(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	resp, err := p.client.RetryJobRun(r.Context(), &schedulerv1.RetryJobRunRequest{RunId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, jobRunEnvelope{Run: toJobRunResp(resp.GetRun())})
+}
+
+// StartEODRun handles POST /api/v1/scheduler/eod-runs, starting the
+// end-of-day close of the business date in the body.
+func (p *SchedulerProxy) StartEODRun(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		BusinessDate string `json:"business_date"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.StartEODRun(r.Context(), &schedulerv1.StartEODRunRequest{BusinessDate: req.BusinessDate})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusCreated, eodRunEnvelope{Run: toEODRunResp(resp.GetRun())})
+}
+
+// ListEODRuns handles GET /api/v1/scheduler/eod-runs.
+// Query parameters: page_size, offset.
+func (p *SchedulerProxy) ListEODRuns(w http.ResponseWriter, r *http.Request) {
+	page, ok := readJobPage(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.ListEODRuns(r.Context(), &schedulerv1.ListEODRunsRequest{PageSize: page.PageSize, Offset: page.Offset})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listEODRunsResp{Runs: make([]*eodRunResp, 0, len(resp.GetRuns())), TotalCount: resp.GetTotalCount()}
+	for _, run := range resp.GetRuns() {
+		out.Runs = append(out.Runs, toEODRunResp(run))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetEODRun handles GET /api/v1/scheduler/eod-runs/{id}.
+func (p *SchedulerProxy) GetEODRun(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	resp, err := p.client.GetEODRun(r.Context(), &schedulerv1.GetEODRunRequest{EodRunId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, eodRunEnvelope{Run: toEODRunResp(resp.GetRun())})
+}
+
+// ResumeEODRun handles POST /api/v1/scheduler/eod-runs/{id}/resume.
+func (p *SchedulerProxy) ResumeEODRun(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	resp, err := p.client.ResumeEODRun(r.Context(), &schedulerv1.ResumeEODRunRequest{EodRunId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, eodRunEnvelope{Run: toEODRunResp(resp.GetRun())})
+}
+
+// GetClock handles GET /api/v1/scheduler/clock, returning the calling
+// sandbox tenant's business date.
+func (p *SchedulerProxy) GetClock(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.GetTenantClock(r.Context(), &schedulerv1.GetTenantClockRequest{})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, tenantClockEnvelope{Clock: toTenantClockResp(resp.GetClock())})
+}
+
+// AdvanceClock handles POST /api/v1/scheduler/clock/advance, simulating the
+// calling sandbox tenant's end of day the number of days in the body.
+func (p *SchedulerProxy) AdvanceClock(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Days int32 `json:"days"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.AdvanceTenantClock(r.Context(), &schedulerv1.AdvanceTenantClockRequest{Days: req.Days})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, advanceClockResp{
+		Clock:         toTenantClockResp(resp.GetClock()),
+		DaysSimulated: resp.GetDaysSimulated(),
+		JobRuns:       resp.GetJobRuns(),
+	})
+}
+
+// readJobPage reads the page_size and offset query parameters, writing an error
+// response if either is invalid.
+func readJobPage(w http.ResponseWriter, r *http.Request) (jobPage, bool) {
+	var page jobPage
+	q := r.URL.Query()
+	if v := q.Get("page_size"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid page_size")
+			return page, false
+		}
+		page.PageSize = int32(n) //nolint:gosec // parsed with a 32-bit size
+	}
+	if v := q.Get("offset"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid offset")
+			return page, false
+		}
+		page.Offset = int32(n) //nolint:gosec // parsed with a 32-bit size
+	}
+	return page, true
+}
+
+// pathID reads the ID in the path, writing an error response if it is missing.
+func pathID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "id is required")
+		return "", false
+	}
+	return id, true
+}
+
+// toJobTargetMsg converts a target from the request body. The payload must
+// be a JSON object.
+func toJobTargetMsg(t *jobTarget) (*schedulerv1.JobTarget, error) {
+	if t == nil {
+		return nil, nil
+	}
+	msg := &schedulerv1.JobTarget{
+		Kind:    schedulerv1.TargetKind(schedulerv1.TargetKind_value["TARGET_KIND_"+t.Kind]),
+		Service: t.Service,
+		Method:  t.Method,
+		Topic:   t.Topic,
+	}
+	if len(t.Payload) > 0 {
+		var fields map[string]any
+		if err := json.Unmarshal(t.Payload, &fields); err != nil {
+			return nil, errors.New("target payload must be a JSON object")
+		}
+		payload, err := structpb.NewStruct(fields)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target payload: %w", err)
+		}
+		msg.Payload = payload
+	}
+	return msg, nil
+}
+
+func toRetryPolicyMsg(r *jobRetryPolicy) *schedulerv1.RetryPolicy {
+	if r == nil {
+		return nil
+	}
+	return &schedulerv1.RetryPolicy{MaxAttempts: r.MaxAttempts, Backoff: r.Backoff}
+}
+
+func toJobResp(j *schedulerv1.Job) *jobResp {
+	if j == nil {
+		return nil
+	}
+	resp := &jobResp{
+		JobID:       j.GetJobId(),
+		Name:        j.GetName(),
+		Description: j.GetDescription(),
+		Schedule:    j.GetSchedule(),
+		NextRunAt:   formatTimestamp(j.GetNextRunAt()),
+		LastRunAt:   formatTimestamp(j.GetLastRunAt()),
+		CreatedAt:   formatTimestamp(j.GetCreatedAt()),
+		UpdatedAt:   formatTimestamp(j.GetUpdatedAt()),
+		Enabled:     j.GetEnabled(),
+		Version:     j.GetVersion(),
+	}
+	if t := j.GetTarget(); t != nil {
+		resp.Target = &jobTarget{
+			Kind:    strings.TrimPrefix(t.GetKind().String(), "TARGET_KIND_"),
+			Service: t.GetService(),
+			Method:  t.GetMethod(),
+			Topic:   t.GetTopic(),
+		}
+		if t.GetPayload() != nil {
+			resp.Target.Payload, _ = json.Marshal(t.GetPayload().AsMap())
+		}
+	}
+	if rp := j.GetRetry(); rp != nil {
+		resp.Retry = &jobRetryPolicy{Backoff: rp.GetBackoff(), MaxAttempts: rp.GetMaxAttempts()}
+	}
+	return resp
+}
+
+func toJobRunResp(r *schedulerv1.JobRun) *jobRunResp {
+	if r == nil {
+		return nil
+	}
+	return &jobRunResp{
+		RunID:        r.GetRunId(),
+		JobID:        r.GetJobId(),
+		JobName:      r.GetJobName(),
+		Trigger:      strings.TrimPrefix(r.GetTrigger().String(), "RUN_TRIGGER_"),
+		Status:       strings.TrimPrefix(r.GetStatus().String(), "RUN_STATUS_"),
+		ScheduledFor: formatTimestamp(r.GetScheduledFor()),
+		Error:        r.GetError(),
+		StartedAt:    formatTimestamp(r.GetStartedAt()),
+		FinishedAt:   formatTimestamp(r.GetFinishedAt()),
+		NextRetryAt:  formatTimestamp(r.GetNextRetryAt()),
+		Attempt:      r.GetAttempt(),
+		MaxAttempts:  r.GetMaxAttempts(),
+	}
+}
+
+func toEODRunResp(r *schedulerv1.EODRun) *eodRunResp {
+	if r == nil {
+		return nil
+	}
+	resp := &eodRunResp{
+		EODRunID:     r.GetEodRunId(),
+		BusinessDate: r.GetBusinessDate(),
+		Trigger:      strings.TrimPrefix(r.GetTrigger().String(), "RUN_TRIGGER_"),
+		Status:       strings.TrimPrefix(r.GetStatus().String(), "RUN_STATUS_"),
+		StartedAt:    formatTimestamp(r.GetStartedAt()),
+		UpdatedAt:    formatTimestamp(r.GetUpdatedAt()),
+		FinishedAt:   formatTimestamp(r.GetFinishedAt()),
+		Steps:        make([]*eodStepResp, 0, len(r.GetSteps())),
+		Version:      r.GetVersion(),
+	}
+	for _, s := range r.GetSteps() {
+		resp.Steps = append(resp.Steps, &eodStepResp{
+			Name:       s.GetName(),
+			JobName:    s.GetJobName(),
+			Status:     strings.TrimPrefix(s.GetStatus().String(), "EOD_STEP_STATUS_"),
+			RunID:      s.GetRunId(),
+			Error:      s.GetError(),
+			StartedAt:  formatTimestamp(s.GetStartedAt()),
+			FinishedAt: formatTimestamp(s.GetFinishedAt()),
+			DependsOn:  s.GetDependsOn(),
+		})
+	}
+	return resp
+}
+
+func toTenantClockResp(c *schedulerv1.TenantClock) *tenantClockResp {
+	if c == nil {
+		return nil
+	}
+	return &tenantClockResp{BusinessDate: c.GetBusinessDate(), TenantID: c.GetTenantId()}
+}
@@ -0,0 +1,71 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const schedulerJobJSON = `{
+	"job_id": "d2f4a6c8-0e1b-4d3f-a5c7-9e1b3d5f7a0c",
+	"name": "deposit.accrue-interest",
+	"description": "Nightly interest accrual",
+	"schedule": "@daily",
+	"target": {"kind": "TARGET_KIND_GRPC", "service": "deposit-service",
+		"method": "/bib.deposit.v1.DepositService/AccrueInterest"},
+	"retry": {"max_attempts": 3, "backoff": "30s"},
+	"enabled": true,
+	"next_run_at": "2026-01-16T00:00:00Z",
+	"version": 1,
+	"created_at": "2026-01-15T09:00:00Z",
+	"updated_at": "2026-01-15T09:00:00Z"
+}`
+
+func TestSchedulerContract(t *testing.T) {
+	stub, conn := newContractStub(t, "scheduler-service")
+	p := NewSchedulerProxy(conn, conn.Logger)
+	jobID := contractJobID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "register a job",
+		Method:      "/bib.scheduler.v1.SchedulerService/RegisterJob",
+		Response:    json.RawMessage(`{"job": ` + schedulerJobJSON + `}`),
+	})
+	rec := call(t, p.RegisterJob, http.MethodPost, "/api/v1/scheduler/jobs", `{"name": "deposit.accrue-interest",
+		"description": "Nightly interest accrual", "schedule": "@daily",
+		"target": {"kind": "GRPC", "service": "deposit-service", "method": "/bib.deposit.v1.DepositService/AccrueInterest"},
+		"retry": {"max_attempts": 3, "backoff": "30s"}}`)
+	if rec.Code != http.StatusCreated {
+		t.Errorf("RegisterJob = %d %s", rec.Code, rec.Body)
+	} else if job, _ := decodeBody(t, rec)["job"].(map[string]any); job["job_id"] != jobID {
+		t.Errorf("RegisterJob job = %v", job)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a job",
+		State:       "an enabled job is registered",
+		Method:      "/bib.scheduler.v1.SchedulerService/GetJob",
+		Response:    json.RawMessage(`{"job": ` + schedulerJobJSON + `}`),
+	})
+	rec = call(t, p.GetJob, http.MethodGet, "/api/v1/scheduler/jobs/"+jobID, "", "id", jobID)
+	if rec.Code != http.StatusOK {
+		t.Errorf("GetJob = %d %s", rec.Code, rec.Body)
+	} else if job, _ := decodeBody(t, rec)["job"].(map[string]any); job["enabled"] != true {
+		t.Errorf("GetJob job = %v", job)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing job",
+		Method:      "/bib.scheduler.v1.SchedulerService/GetJob",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetJob, http.MethodGet, "/api/v1/scheduler/jobs/"+jobID, "", "id", jobID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetJob of a missing job = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-scheduler-service.json"))
+}
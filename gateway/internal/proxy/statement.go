@@ -0,0 +1,316 @@
+package proxy
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	statementv1 "github.com/bibbank/bib/api/gen/go/bib/statement/v1"
+)
+
+// StatementProxy proxies HTTP requests to the statement gRPC service.
+type StatementProxy struct {
+	client statementv1.StatementServiceClient
+	logger *slog.Logger
+}
+
+// NewStatementProxy creates a new statement service proxy.
+func NewStatementProxy(conn *ServiceConn, logger *slog.Logger) *StatementProxy {
+	return &StatementProxy{client: statementv1.NewStatementServiceClient(conn), logger: logger}
+}
+
+type generateStatementReq struct {
+	CustomerID  string `json:"customer_id"`
+	PeriodStart string `json:"period_start"`
+	PeriodEnd   string `json:"period_end"`
+	Format      string `json:"format,omitempty"`
+}
+
+type statementMsg struct {
+	StatementID   string `json:"statement_id"`
+	CustomerID    string `json:"customer_id"`
+	ScheduleID    string `json:"schedule_id,omitempty"`
+	PeriodStart   string `json:"period_start"`
+	PeriodEnd     string `json:"period_end"`
+	Format        string `json:"format"`
+	Status        string `json:"status"`
+	ContentType   string `json:"content_type"`
+	SizeBytes     int64  `json:"size_bytes"`
+	SHA256        string `json:"sha256,omitempty"`
+	EntryCount    int32  `json:"entry_count"`
+	FailureReason string `json:"failure_reason,omitempty"`
+	GeneratedAt   string `json:"generated_at"`
+	// Href is the gateway route the statement's document is downloaded
+	// from; it is empty for failed statements.
+	Href string `json:"href,omitempty"`
+}
+
+type statementResp struct {
+	Statement *statementMsg `json:"statement"`
+}
+
+type listStatementsResp struct {
+	Statements []*statementMsg `json:"statements"`
+	TotalCount int32           `json:"total_count"`
+}
+
+type upsertStatementScheduleReq struct {
+	Frequency string `json:"frequency"`
+	Format    string `json:"format,omitempty"`
+	Paused    bool   `json:"paused"`
+}
+
+type statementScheduleMsg struct {
+	ScheduleID    string `json:"schedule_id"`
+	CustomerID    string `json:"customer_id"`
+	Frequency     string `json:"frequency"`
+	Format        string `json:"format"`
+	Active        bool   `json:"active"`
+	LastPeriodEnd string `json:"last_period_end"`
+	Version       int32  `json:"version"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+type statementScheduleResp struct {
+	Schedule *statementScheduleMsg `json:"schedule"`
+}
+
+type listStatementSchedulesResp struct {
+	Schedules  []*statementScheduleMsg `json:"schedules"`
+	TotalCount int32                   `json:"total_count"`
+}
+
+type runDueStatementSchedulesResp struct {
+	Generated int32 `json:"generated"`
+	Failed    int32 `json:"failed"`
+}
+
+// GenerateStatement handles POST /api/v1/statements, generating a
+// customer's statement for a period of inclusive YYYY-MM-DD dates.
+func (p *StatementProxy) GenerateStatement(w http.ResponseWriter, r *http.Request) {
+	var req generateStatementReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	format, ok := statementFormat(req.Format)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "format must be PDF or CSV")
+		return
+	}
+
+	resp, err := p.client.GenerateStatement(r.Context(), &statementv1.GenerateStatementRequest{
+		CustomerId:  req.CustomerID,
+		PeriodStart: req.PeriodStart,
+		PeriodEnd:   req.PeriodEnd,
+		Format:      format,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	stmt := toStatementMsg(resp.GetStatement())
+	w.Header().Set("Location", "/api/v1/statements/"+url.PathEscape(stmt.StatementID))
+	writeJSON(w, http.StatusCreated, statementResp{Statement: stmt})
+}
+
+// ListStatements handles GET /api/v1/statements.
+// Query parameters: customer_id (required), page_size, offset.
+func (p *StatementProxy) ListStatements(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.ListStatements(r.Context(), &statementv1.ListStatementsRequest{
+		CustomerId: r.URL.Query().Get("customer_id"),
+		PageSize:   pageSize,
+		Offset:     offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listStatementsResp{
+		Statements: make([]*statementMsg, 0, len(resp.GetStatements())),
+		TotalCount: resp.GetTotalCount(),
+	}
+	for _, s := range resp.GetStatements() {
+		out.Statements = append(out.Statements, toStatementMsg(s))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetStatement handles GET /api/v1/statements/{id}.
+func (p *StatementProxy) GetStatement(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "statement id is required")
+		return
+	}
+
+	resp, err := p.client.GetStatement(r.Context(), &statementv1.GetStatementRequest{StatementId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, statementResp{Statement: toStatementMsg(resp.GetStatement())})
+}
+
+// GetStatementContent handles GET /api/v1/statements/{id}/content,
+// downloading the statement's PDF or CSV document.
+func (p *StatementProxy) GetStatementContent(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "statement id is required")
+		return
+	}
+
+	resp, err := p.client.GetStatementContent(r.Context(), &statementv1.GetStatementContentRequest{StatementId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+
+	w.Header().Set("Content-Type", resp.GetContentType())
+	w.Header().Set("Content-Length", strconv.Itoa(len(resp.GetContent())))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.GetFileName()))
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(resp.GetContent()) //nolint:errcheck // client disconnects are not actionable
+}
+
+// UpsertSchedule handles PUT /api/v1/statement-schedules/{customer_id},
+// creating or changing the customer's statement schedule.
+func (p *StatementProxy) UpsertSchedule(w http.ResponseWriter, r *http.Request) {
+	customerID := r.PathValue("customer_id")
+	if customerID == "" {
+		writeError(w, http.StatusBadRequest, "customer id is required")
+		return
+	}
+	var req upsertStatementScheduleReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	frequency, ok := statementv1.StatementFrequency_value["STATEMENT_FREQUENCY_"+strings.ToUpper(req.Frequency)]
+	if !ok || req.Frequency == "" {
+		writeError(w, http.StatusBadRequest, "frequency must be MONTHLY or QUARTERLY")
+		return
+	}
+	format, ok := statementFormat(req.Format)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "format must be PDF or CSV")
+		return
+	}
+
+	resp, err := p.client.UpsertStatementSchedule(r.Context(), &statementv1.UpsertStatementScheduleRequest{
+		CustomerId: customerID,
+		Frequency:  statementv1.StatementFrequency(frequency),
+		Format:     format,
+		Paused:     req.Paused,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, statementScheduleResp{Schedule: toStatementScheduleMsg(resp.GetSchedule())})
+}
+
+// ListSchedules handles GET /api/v1/statement-schedules.
+// Query parameters: customer_id, page_size, offset.
+func (p *StatementProxy) ListSchedules(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.ListStatementSchedules(r.Context(), &statementv1.ListStatementSchedulesRequest{
+		CustomerId: r.URL.Query().Get("customer_id"),
+		PageSize:   pageSize,
+		Offset:     offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listStatementSchedulesResp{
+		Schedules:  make([]*statementScheduleMsg, 0, len(resp.GetSchedules())),
+		TotalCount: resp.GetTotalCount(),
+	}
+	for _, s := range resp.GetSchedules() {
+		out.Schedules = append(out.Schedules, toStatementScheduleMsg(s))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// RunDueSchedules handles POST /api/v1/statement-schedules/run, generating
+// the statements of every schedule whose period has ended.
+func (p *StatementProxy) RunDueSchedules(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.RunDueSchedules(r.Context(), &statementv1.RunDueSchedulesRequest{})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, runDueStatementSchedulesResp{
+		Generated: resp.GetGenerated(),
+		Failed:    resp.GetFailed(),
+	})
+}
+
+// statementFormat converts a format name to its enum value; an empty name
+// leaves the service default.
+func statementFormat(name string) (statementv1.StatementFormat, bool) {
+	if name == "" {
+		return statementv1.StatementFormat_STATEMENT_FORMAT_UNSPECIFIED, true
+	}
+	v, ok := statementv1.StatementFormat_value["STATEMENT_FORMAT_"+strings.ToUpper(name)]
+	return statementv1.StatementFormat(v), ok
+}
+
+func toStatementMsg(s *statementv1.Statement) *statementMsg {
+	if s == nil {
+		return nil
+	}
+	msg := &statementMsg{
+		StatementID:   s.GetStatementId(),
+		CustomerID:    s.GetCustomerId(),
+		ScheduleID:    s.GetScheduleId(),
+		PeriodStart:   s.GetPeriodStart(),
+		PeriodEnd:     s.GetPeriodEnd(),
+		Format:        enumName(s.GetFormat().String(), "STATEMENT_FORMAT_"),
+		Status:        enumName(s.GetStatus().String(), "STATEMENT_STATUS_"),
+		ContentType:   s.GetContentType(),
+		SizeBytes:     s.GetSizeBytes(),
+		SHA256:        s.GetSha256(),
+		EntryCount:    s.GetEntryCount(),
+		FailureReason: s.GetFailureReason(),
+		GeneratedAt:   formatTimestamp(s.GetGeneratedAt()),
+	}
+	if s.GetStatus() == statementv1.StatementStatus_STATEMENT_STATUS_GENERATED {
+		msg.Href = "/api/v1/statements/" + url.PathEscape(msg.StatementID) + "/content"
+	}
+	return msg
+}
+
+func toStatementScheduleMsg(s *statementv1.StatementSchedule) *statementScheduleMsg {
+	if s == nil {
+		return nil
+	}
+	return &statementScheduleMsg{
+		ScheduleID:    s.GetScheduleId(),
+		CustomerID:    s.GetCustomerId(),
+		Frequency:     enumName(s.GetFrequency().String(), "STATEMENT_FREQUENCY_"),
+		Format:        enumName(s.GetFormat().String(), "STATEMENT_FORMAT_"),
+		Active:        s.GetActive(),
+		LastPeriodEnd: s.GetLastPeriodEnd(),
+		Version:       s.GetVersion(),
+		CreatedAt:     formatTimestamp(s.GetCreatedAt()),
+		UpdatedAt:     formatTimestamp(s.GetUpdatedAt()),
+	}
+}
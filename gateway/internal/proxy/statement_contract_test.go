@@ -0,0 +1,59 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+func TestStatementContract(t *testing.T) {
+	stub, conn := newContractStub(t, "statement-service")
+	p := NewStatementProxy(conn, conn.Logger)
+	customerID, statementID := contractCustomerID.String(), contractStatementID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "schedule monthly statements",
+		Method:      "/bib.statement.v1.StatementService/UpsertStatementSchedule",
+		Response: json.RawMessage(`{"schedule": {"schedule_id": "` + contractScheduleID.String() + `",
+			"customer_id": "` + customerID + `", "frequency": "STATEMENT_FREQUENCY_MONTHLY",
+			"format": "STATEMENT_FORMAT_PDF", "active": true, "last_period_end": "2025-12-31", "version": 1}}`),
+	})
+	rec := call(t, p.UpsertSchedule, http.MethodPut, "/api/v1/statement-schedules/"+customerID,
+		`{"frequency": "MONTHLY", "format": "PDF"}`, "customer_id", customerID)
+	if rec.Code != http.StatusOK {
+		t.Errorf("UpsertSchedule = %d %s", rec.Code, rec.Body)
+	} else if schedule, _ := decodeBody(t, rec)["schedule"].(map[string]any); schedule["frequency"] != "MONTHLY" {
+		t.Errorf("UpsertSchedule schedule = %v", schedule)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a statement",
+		State:       "a statement was generated",
+		Method:      "/bib.statement.v1.StatementService/GetStatement",
+		Response: json.RawMessage(`{"statement": {"statement_id": "` + statementID + `",
+			"customer_id": "` + customerID + `", "period_start": "2026-01-01", "period_end": "2026-01-31",
+			"format": "STATEMENT_FORMAT_PDF", "status": "STATEMENT_STATUS_GENERATED",
+			"content_type": "application/pdf", "entry_count": 12, "generated_at": "2026-02-01T02:00:00Z"}}`),
+	})
+	rec = call(t, p.GetStatement, http.MethodGet, "/api/v1/statements/"+statementID, "", "id", statementID)
+	if rec.Code != http.StatusOK {
+		t.Errorf("GetStatement = %d %s", rec.Code, rec.Body)
+	} else if stmt, _ := decodeBody(t, rec)["statement"].(map[string]any); stmt["href"] != "/api/v1/statements/"+statementID+"/content" {
+		t.Errorf("GetStatement statement = %v", stmt)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing statement",
+		Method:      "/bib.statement.v1.StatementService/GetStatement",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetStatement, http.MethodGet, "/api/v1/statements/"+statementID, "", "id", statementID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetStatement of a missing statement = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-statement-service.json"))
+}
@@ -0,0 +1,360 @@
+package proxy
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	tenantv1 "github.com/bibbank/bib/api/gen/go/bib/tenant/v1"
+)
+
+// TenantProxy proxies HTTP requests to the tenant gRPC service.
+type TenantProxy struct {
+	client tenantv1.TenantServiceClient
+	logger *slog.Logger
+}
+
+// NewTenantProxy creates a new tenant service proxy.
+func NewTenantProxy(conn *ServiceConn, logger *slog.Logger) *TenantProxy {
+	return &TenantProxy{client: tenantv1.NewTenantServiceClient(conn), logger: logger}
+}
+
+type tenantSettings struct {
+	Name         string `json:"name"`
+	BaseCurrency string `json:"base_currency"`
+	Jurisdiction string `json:"jurisdiction"`
+}
+
+type tenantProvisioningStep struct {
+	Hook      string `json:"hook"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type tenantResp struct {
+	Settings      *tenantSettings           `json:"settings"`
+	FeatureFlags  map[string]bool           `json:"feature_flags"`
+	Limits        map[string]string         `json:"limits"`
+	TenantID      string                    `json:"tenant_id"`
+	Slug          string                    `json:"slug"`
+	Status        string                    `json:"status"`
+	SuspendReason string                    `json:"suspend_reason,omitempty"`
+	CreatedAt     string                    `json:"created_at"`
+	UpdatedAt     string                    `json:"updated_at"`
+	Provisioning  []*tenantProvisioningStep `json:"provisioning"`
+	Version       int32                     `json:"version"`
+}
+
+type tenantEnvelope struct {
+	Tenant *tenantResp `json:"tenant"`
+}
+
+type createTenantReq struct {
+	Settings *tenantSettings `json:"settings"`
+	Slug     string          `json:"slug"`
+}
+
+type listTenantsResp struct {
+	Tenants    []*tenantResp `json:"tenants"`
+	TotalCount int32         `json:"total_count"`
+}
+
+type setFeatureFlagReq struct {
+	TenantID string `json:"tenant_id"`
+	Key      string `json:"key"`
+	Enabled  bool   `json:"enabled"`
+}
+
+type setLimitReq struct {
+	TenantID string `json:"tenant_id"`
+	Key      string `json:"key"`
+	Value    string `json:"value,omitempty"`
+}
+
+type suspendTenantReq struct {
+	TenantID string `json:"tenant_id"`
+	Reason   string `json:"reason"`
+}
+
+type tenantConfigResp struct {
+	FeatureFlags map[string]bool   `json:"feature_flags"`
+	Limits       map[string]string `json:"limits"`
+	TenantID     string            `json:"tenant_id"`
+	Status       string            `json:"status"`
+	BaseCurrency string            `json:"base_currency"`
+	Jurisdiction string            `json:"jurisdiction"`
+	Active       bool              `json:"active"`
+	Version      int32             `json:"version"`
+}
+
+// CreateTenant handles POST /api/v1/tenants.
+func (p *TenantProxy) CreateTenant(w http.ResponseWriter, r *http.Request) {
+	var req createTenantReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.CreateTenant(r.Context(), &tenantv1.CreateTenantRequest{
+		Slug:     req.Slug,
+		Settings: toTenantSettingsMsg(req.Settings),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusCreated, tenantEnvelope{Tenant: toTenantResp(resp.GetTenant())})
+}
+
+// ListTenants handles GET /api/v1/tenants.
+// Query parameters: status, page_size, offset.
+func (p *TenantProxy) ListTenants(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	req := &tenantv1.ListTenantsRequest{}
+	if v := q.Get("status"); v != "" {
+		st, ok := tenantv1.TenantStatus_value["TENANT_STATUS_"+v]
+		if !ok {
+			writeError(w, http.StatusBadRequest, "invalid status")
+			return
+		}
+		req.Status = tenantv1.TenantStatus(st)
+	}
+	if v := q.Get("page_size"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid page_size")
+			return
+		}
+		req.PageSize = int32(n) //nolint:gosec // parsed with a 32-bit size
+	}
+	if v := q.Get("offset"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+		req.Offset = int32(n) //nolint:gosec // parsed with a 32-bit size
+	}
+
+	resp, err := p.client.ListTenants(r.Context(), req)
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listTenantsResp{Tenants: make([]*tenantResp, 0, len(resp.GetTenants())), TotalCount: resp.GetTotalCount()}
+	for _, t := range resp.GetTenants() {
+		out.Tenants = append(out.Tenants, toTenantResp(t))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetTenant handles GET /api/v1/tenants/{id}.
+func (p *TenantProxy) GetTenant(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := tenantPathID(w, r)
+	if !ok {
+		return
+	}
+	resp, err := p.client.GetTenant(r.Context(), &tenantv1.GetTenantRequest{TenantId: tenantID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, tenantEnvelope{Tenant: toTenantResp(resp.GetTenant())})
+}
+
+// ConfigureTenant handles PUT /api/v1/tenants/{id}/settings.
+func (p *TenantProxy) ConfigureTenant(w http.ResponseWriter, r *http.Request) {
+	var settings tenantSettings
+	if err := readJSON(r, &settings); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.ConfigureTenant(r.Context(), &tenantv1.ConfigureTenantRequest{
+		TenantId: r.PathValue("id"),
+		Settings: toTenantSettingsMsg(&settings),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, tenantEnvelope{Tenant: toTenantResp(resp.GetTenant())})
+}
+
+// SetFeatureFlag handles PUT /api/v1/tenants/{id}/features/{key}.
+func (p *TenantProxy) SetFeatureFlag(w http.ResponseWriter, r *http.Request) {
+	var req setFeatureFlagReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.SetFeatureFlag(r.Context(), &tenantv1.SetFeatureFlagRequest{
+		TenantId: r.PathValue("id"),
+		Key:      r.PathValue("key"),
+		Enabled:  req.Enabled,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, tenantEnvelope{Tenant: toTenantResp(resp.GetTenant())})
+}
+
+// SetLimit handles PUT /api/v1/tenants/{id}/limits/{key}.
+func (p *TenantProxy) SetLimit(w http.ResponseWriter, r *http.Request) {
+	var req setLimitReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if req.Value == "" {
+		writeError(w, http.StatusBadRequest, "value is required")
+		return
+	}
+
+	resp, err := p.client.SetLimit(r.Context(), &tenantv1.SetLimitRequest{
+		TenantId: r.PathValue("id"),
+		Key:      r.PathValue("key"),
+		Value:    req.Value,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, tenantEnvelope{Tenant: toTenantResp(resp.GetTenant())})
+}
+
+// RemoveLimit handles DELETE /api/v1/tenants/{id}/limits/{key}.
+func (p *TenantProxy) RemoveLimit(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.SetLimit(r.Context(), &tenantv1.SetLimitRequest{
+		TenantId: r.PathValue("id"),
+		Key:      r.PathValue("key"),
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, tenantEnvelope{Tenant: toTenantResp(resp.GetTenant())})
+}
+
+// SuspendTenant handles POST /api/v1/tenants/{id}/suspend.
+func (p *TenantProxy) SuspendTenant(w http.ResponseWriter, r *http.Request) {
+	var req suspendTenantReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.SuspendTenant(r.Context(), &tenantv1.SuspendTenantRequest{
+		TenantId: r.PathValue("id"),
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, tenantEnvelope{Tenant: toTenantResp(resp.GetTenant())})
+}
+
+// ReactivateTenant handles POST /api/v1/tenants/{id}/reactivate.
+func (p *TenantProxy) ReactivateTenant(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := tenantPathID(w, r)
+	if !ok {
+		return
+	}
+	resp, err := p.client.ReactivateTenant(r.Context(), &tenantv1.ReactivateTenantRequest{TenantId: tenantID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, tenantEnvelope{Tenant: toTenantResp(resp.GetTenant())})
+}
+
+// ProvisionTenant handles POST /api/v1/tenants/{id}/provision, retrying the
+// tenant's failed provisioning hooks.
+func (p *TenantProxy) ProvisionTenant(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := tenantPathID(w, r)
+	if !ok {
+		return
+	}
+	resp, err := p.client.ProvisionTenant(r.Context(), &tenantv1.ProvisionTenantRequest{TenantId: tenantID})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, tenantEnvelope{Tenant: toTenantResp(resp.GetTenant())})
+}
+
+// GetTenantConfig handles GET /api/v1/tenants/{id}/config.
+func (p *TenantProxy) GetTenantConfig(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.GetTenantConfig(r.Context(), &tenantv1.GetTenantConfigRequest{TenantId: r.PathValue("id")})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, tenantConfigResp{
+		FeatureFlags: resp.GetFeatureFlags(),
+		Limits:       resp.GetLimits(),
+		TenantID:     resp.GetTenantId(),
+		Status:       tenantStatusName(resp.GetStatus()),
+		BaseCurrency: resp.GetBaseCurrency(),
+		Jurisdiction: resp.GetJurisdiction(),
+		Active:       resp.GetActive(),
+		Version:      resp.GetVersion(),
+	})
+}
+
+// tenantPathID reads the tenant ID in the path, writing an error response
+// if it is missing.
+func tenantPathID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	tenantID := r.PathValue("id")
+	if tenantID == "" {
+		writeError(w, http.StatusBadRequest, "tenant id is required")
+		return "", false
+	}
+	return tenantID, true
+}
+
+func tenantStatusName(s tenantv1.TenantStatus) string {
+	return strings.TrimPrefix(s.String(), "TENANT_STATUS_")
+}
+
+func toTenantSettingsMsg(s *tenantSettings) *tenantv1.TenantSettings {
+	if s == nil {
+		return nil
+	}
+	return &tenantv1.TenantSettings{Name: s.Name, BaseCurrency: s.BaseCurrency, Jurisdiction: s.Jurisdiction}
+}
+
+func toTenantResp(t *tenantv1.Tenant) *tenantResp {
+	if t == nil {
+		return nil
+	}
+	resp := &tenantResp{
+		FeatureFlags:  t.GetFeatureFlags(),
+		Limits:        t.GetLimits(),
+		TenantID:      t.GetTenantId(),
+		Slug:          t.GetSlug(),
+		Status:        tenantStatusName(t.GetStatus()),
+		SuspendReason: t.GetSuspendReason(),
+		CreatedAt:     formatTimestamp(t.GetCreatedAt()),
+		UpdatedAt:     formatTimestamp(t.GetUpdatedAt()),
+		Provisioning:  make([]*tenantProvisioningStep, 0, len(t.GetProvisioning())),
+		Version:       t.GetVersion(),
+	}
+	if st := t.GetSettings(); st != nil {
+		resp.Settings = &tenantSettings{Name: st.GetName(), BaseCurrency: st.GetBaseCurrency(), Jurisdiction: st.GetJurisdiction()}
+	}
+	for _, step := range t.GetProvisioning() {
+		resp.Provisioning = append(resp.Provisioning, &tenantProvisioningStep{
+			Hook:      step.GetHook(),
+			Status:    strings.TrimPrefix(step.GetStatus().String(), "PROVISIONING_STATUS_"),
+			Error:     step.GetError(),
+			UpdatedAt: formatTimestamp(step.GetUpdatedAt()),
+		})
+	}
+	return resp
+}
@@ -0,0 +1,64 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const tenantJSON = `{
+	"tenant_id": "8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f",
+	"slug": "acme-bank",
+	"settings": {"name": "Acme Bank", "base_currency": "GBP", "jurisdiction": "GB"},
+	"status": "TENANT_STATUS_ACTIVE",
+	"version": 1,
+	"created_at": "2026-01-15T09:00:00Z",
+	"updated_at": "2026-01-15T09:00:00Z"
+}`
+
+func TestTenantContract(t *testing.T) {
+	stub, conn := newContractStub(t, "tenant-service")
+	p := NewTenantProxy(conn, conn.Logger)
+	tenantID := contractTenantID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "create a tenant",
+		Method:      "/bib.tenant.v1.TenantService/CreateTenant",
+		Response:    json.RawMessage(`{"tenant": ` + tenantJSON + `}`),
+	})
+	rec := call(t, p.CreateTenant, http.MethodPost, "/api/v1/tenants", `{"slug": "acme-bank",
+		"settings": {"name": "Acme Bank", "base_currency": "GBP", "jurisdiction": "GB"}}`)
+	if rec.Code != http.StatusCreated {
+		t.Errorf("CreateTenant = %d %s", rec.Code, rec.Body)
+	} else if tenant, _ := decodeBody(t, rec)["tenant"].(map[string]any); tenant["tenant_id"] != tenantID {
+		t.Errorf("CreateTenant tenant = %v", tenant)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a tenant",
+		State:       "an active tenant exists",
+		Method:      "/bib.tenant.v1.TenantService/GetTenant",
+		Response:    json.RawMessage(`{"tenant": ` + tenantJSON + `}`),
+	})
+	rec = call(t, p.GetTenant, http.MethodGet, "/api/v1/tenants/"+tenantID, "", "id", tenantID)
+	if rec.Code != http.StatusOK {
+		t.Errorf("GetTenant = %d %s", rec.Code, rec.Body)
+	} else if tenant, _ := decodeBody(t, rec)["tenant"].(map[string]any); tenant["slug"] != "acme-bank" {
+		t.Errorf("GetTenant tenant = %v", tenant)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing tenant",
+		Method:      "/bib.tenant.v1.TenantService/GetTenant",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetTenant, http.MethodGet, "/api/v1/tenants/"+tenantID, "", "id", tenantID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetTenant of a missing tenant = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-tenant-service.json"))
+}
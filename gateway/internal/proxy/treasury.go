@@ -0,0 +1,668 @@
+package proxy
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	treasuryv1 "github.com/bibbank/bib/api/gen/go/bib/treasury/v1"
+)
+
+// TreasuryProxy proxies HTTP requests to the treasury gRPC service.
+type TreasuryProxy struct {
+	client treasuryv1.TreasuryServiceClient
+	logger *slog.Logger
+}
+
+// NewTreasuryProxy creates a new treasury service proxy.
+func NewTreasuryProxy(conn *ServiceConn, logger *slog.Logger) *TreasuryProxy {
+	return &TreasuryProxy{client: treasuryv1.NewTreasuryServiceClient(conn), logger: logger}
+}
+
+type createNostroAccountReq struct {
+	Name              string   `json:"name"`
+	CorrespondentBIC  string   `json:"correspondent_bic"`
+	AccountNumber     string   `json:"account_number"`
+	Currency          string   `json:"currency"`
+	LedgerAccountCode string   `json:"ledger_account_code"`
+	OpeningBalance    string   `json:"opening_balance,omitempty"`
+	MinimumBalance    string   `json:"minimum_balance,omitempty"`
+	Rails             []string `json:"rails,omitempty"`
+}
+
+type updateNostroAccountReq struct {
+	Name           string   `json:"name"`
+	MinimumBalance string   `json:"minimum_balance,omitempty"`
+	Rails          []string `json:"rails,omitempty"`
+	Active         bool     `json:"active"`
+}
+
+type createForecastReq struct {
+	NostroID    string `json:"nostro_id"`
+	ValueDate   string `json:"value_date"`
+	Direction   string `json:"direction"`
+	Amount      string `json:"amount"`
+	Description string `json:"description,omitempty"`
+}
+
+type createSweepReq struct {
+	SourceNostroID string `json:"source_nostro_id"`
+	TargetNostroID string `json:"target_nostro_id"`
+	Type           string `json:"type"`
+	TriggerBalance string `json:"trigger_balance"`
+	TargetBalance  string `json:"target_balance"`
+}
+
+type updateSweepReq struct {
+	TriggerBalance string `json:"trigger_balance"`
+	TargetBalance  string `json:"target_balance"`
+	Active         bool   `json:"active"`
+}
+
+type nostroAccountMsg struct {
+	NostroID          string   `json:"nostro_id"`
+	Name              string   `json:"name"`
+	CorrespondentBIC  string   `json:"correspondent_bic"`
+	AccountNumber     string   `json:"account_number"`
+	Currency          string   `json:"currency"`
+	LedgerAccountCode string   `json:"ledger_account_code"`
+	OpeningBalance    string   `json:"opening_balance"`
+	MinimumBalance    string   `json:"minimum_balance"`
+	CreatedAt         string   `json:"created_at"`
+	UpdatedAt         string   `json:"updated_at"`
+	Rails             []string `json:"rails"`
+	Version           int32    `json:"version"`
+	Active            bool     `json:"active"`
+}
+
+type nostroAccountResp struct {
+	NostroAccount *nostroAccountMsg `json:"nostro_account"`
+}
+
+type listNostroAccountsResp struct {
+	NostroAccounts []*nostroAccountMsg `json:"nostro_accounts"`
+	TotalCount     int32               `json:"total_count"`
+}
+
+type dailyProjectionMsg struct {
+	Date           string `json:"date"`
+	Inflows        string `json:"inflows"`
+	Outflows       string `json:"outflows"`
+	ClosingBalance string `json:"closing_balance"`
+	BelowMinimum   bool   `json:"below_minimum"`
+}
+
+type liquidityPositionMsg struct {
+	NostroID         string                `json:"nostro_id"`
+	Currency         string                `json:"currency"`
+	BookedBalance    string                `json:"booked_balance"`
+	PendingOutflows  string                `json:"pending_outflows"`
+	AvailableBalance string                `json:"available_balance"`
+	MinimumBalance   string                `json:"minimum_balance"`
+	AsOf             string                `json:"as_of"`
+	Projections      []*dailyProjectionMsg `json:"projections"`
+}
+
+type liquidityPositionResp struct {
+	Position *liquidityPositionMsg `json:"position"`
+}
+
+type listLiquidityPositionsResp struct {
+	Positions []*liquidityPositionMsg `json:"positions"`
+}
+
+type forecastMsg struct {
+	ForecastID  string `json:"forecast_id"`
+	NostroID    string `json:"nostro_id"`
+	ValueDate   string `json:"value_date"`
+	Direction   string `json:"direction"`
+	Amount      string `json:"amount"`
+	Description string `json:"description,omitempty"`
+	Status      string `json:"status"`
+	SweepID     string `json:"sweep_id,omitempty"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+	Version     int32  `json:"version"`
+}
+
+type forecastResp struct {
+	Forecast *forecastMsg `json:"forecast"`
+}
+
+type listForecastsResp struct {
+	Forecasts  []*forecastMsg `json:"forecasts"`
+	TotalCount int32          `json:"total_count"`
+}
+
+type sweepMsg struct {
+	SweepID        string `json:"sweep_id"`
+	SourceNostroID string `json:"source_nostro_id"`
+	TargetNostroID string `json:"target_nostro_id"`
+	Type           string `json:"type"`
+	TriggerBalance string `json:"trigger_balance"`
+	TargetBalance  string `json:"target_balance"`
+	LastExecutedOn string `json:"last_executed_on,omitempty"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+	Version        int32  `json:"version"`
+	Active         bool   `json:"active"`
+}
+
+type sweepResp struct {
+	Sweep *sweepMsg `json:"sweep"`
+}
+
+type listSweepsResp struct {
+	Sweeps     []*sweepMsg `json:"sweeps"`
+	TotalCount int32       `json:"total_count"`
+}
+
+type liquidityAlertMsg struct {
+	AlertID          string `json:"alert_id"`
+	NostroID         string `json:"nostro_id"`
+	Status           string `json:"status"`
+	BreachDate       string `json:"breach_date"`
+	ProjectedBalance string `json:"projected_balance"`
+	MinimumBalance   string `json:"minimum_balance"`
+	OpenedAt         string `json:"opened_at"`
+	ResolvedAt       string `json:"resolved_at,omitempty"`
+	Version          int32  `json:"version"`
+}
+
+type listLiquidityAlertsResp struct {
+	Alerts     []*liquidityAlertMsg `json:"alerts"`
+	TotalCount int32                `json:"total_count"`
+}
+
+type evaluateLiquidityResp struct {
+	SweepsExecuted int32 `json:"sweeps_executed"`
+	AlertsOpened   int32 `json:"alerts_opened"`
+	AlertsResolved int32 `json:"alerts_resolved"`
+}
+
+// CreateNostroAccount handles POST /api/v1/treasury/nostro-accounts.
+func (p *TreasuryProxy) CreateNostroAccount(w http.ResponseWriter, r *http.Request) {
+	var req createNostroAccountReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.CreateNostroAccount(r.Context(), &treasuryv1.CreateNostroAccountRequest{
+		Name:              req.Name,
+		CorrespondentBic:  req.CorrespondentBIC,
+		AccountNumber:     req.AccountNumber,
+		Currency:          req.Currency,
+		LedgerAccountCode: req.LedgerAccountCode,
+		Rails:             req.Rails,
+		OpeningBalance:    req.OpeningBalance,
+		MinimumBalance:    req.MinimumBalance,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	nostro := toNostroAccountMsg(resp.GetNostroAccount())
+	w.Header().Set("Location", "/api/v1/treasury/nostro-accounts/"+url.PathEscape(nostro.NostroID))
+	writeJSON(w, http.StatusCreated, nostroAccountResp{NostroAccount: nostro})
+}
+
+// ListNostroAccounts handles GET /api/v1/treasury/nostro-accounts.
+// Query parameters: currency, page_size, offset.
+func (p *TreasuryProxy) ListNostroAccounts(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.ListNostroAccounts(r.Context(), &treasuryv1.ListNostroAccountsRequest{
+		Currency: r.URL.Query().Get("currency"),
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listNostroAccountsResp{
+		NostroAccounts: make([]*nostroAccountMsg, 0, len(resp.GetNostroAccounts())),
+		TotalCount:     resp.GetTotalCount(),
+	}
+	for _, n := range resp.GetNostroAccounts() {
+		out.NostroAccounts = append(out.NostroAccounts, toNostroAccountMsg(n))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetNostroAccount handles GET /api/v1/treasury/nostro-accounts/{id}.
+func (p *TreasuryProxy) GetNostroAccount(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "nostro account id is required")
+		return
+	}
+
+	resp, err := p.client.GetNostroAccount(r.Context(), &treasuryv1.GetNostroAccountRequest{NostroId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, nostroAccountResp{NostroAccount: toNostroAccountMsg(resp.GetNostroAccount())})
+}
+
+// UpdateNostroAccount handles PUT /api/v1/treasury/nostro-accounts/{id},
+// replacing the account's name, rails, minimum balance and state.
+func (p *TreasuryProxy) UpdateNostroAccount(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "nostro account id is required")
+		return
+	}
+	var req updateNostroAccountReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.UpdateNostroAccount(r.Context(), &treasuryv1.UpdateNostroAccountRequest{
+		NostroId:       id,
+		Name:           req.Name,
+		Rails:          req.Rails,
+		MinimumBalance: req.MinimumBalance,
+		Active:         req.Active,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, nostroAccountResp{NostroAccount: toNostroAccountMsg(resp.GetNostroAccount())})
+}
+
+// ListLiquidityPositions handles GET /api/v1/treasury/positions, one
+// position per active nostro account.
+// Query parameters: currency, horizon_days.
+func (p *TreasuryProxy) ListLiquidityPositions(w http.ResponseWriter, r *http.Request) {
+	horizon, ok := readHorizonDays(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.ListLiquidityPositions(r.Context(), &treasuryv1.ListLiquidityPositionsRequest{
+		Currency:    r.URL.Query().Get("currency"),
+		HorizonDays: horizon,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listLiquidityPositionsResp{Positions: make([]*liquidityPositionMsg, 0, len(resp.GetPositions()))}
+	for _, pos := range resp.GetPositions() {
+		out.Positions = append(out.Positions, toLiquidityPositionMsg(pos))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetLiquidityPosition handles GET /api/v1/treasury/positions/{nostro_id}.
+// Query parameters: horizon_days.
+func (p *TreasuryProxy) GetLiquidityPosition(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("nostro_id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "nostro account id is required")
+		return
+	}
+	horizon, ok := readHorizonDays(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.GetLiquidityPosition(r.Context(), &treasuryv1.GetLiquidityPositionRequest{
+		NostroId:    id,
+		HorizonDays: horizon,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, liquidityPositionResp{Position: toLiquidityPositionMsg(resp.GetPosition())})
+}
+
+// CreateForecast handles POST /api/v1/treasury/forecasts.
+func (p *TreasuryProxy) CreateForecast(w http.ResponseWriter, r *http.Request) {
+	var req createForecastReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	direction, found := treasuryv1.ForecastDirection_value["FORECAST_DIRECTION_"+strings.ToUpper(req.Direction)]
+	if !found || direction == 0 {
+		writeError(w, http.StatusBadRequest, "direction must be INFLOW or OUTFLOW")
+		return
+	}
+
+	resp, err := p.client.CreateForecast(r.Context(), &treasuryv1.CreateForecastRequest{
+		NostroId:    req.NostroID,
+		ValueDate:   req.ValueDate,
+		Direction:   treasuryv1.ForecastDirection(direction),
+		Amount:      req.Amount,
+		Description: req.Description,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusCreated, forecastResp{Forecast: toForecastMsg(resp.GetForecast())})
+}
+
+// ListForecasts handles GET /api/v1/treasury/forecasts.
+// Query parameters: nostro_id, from_date, to_date, include_cancelled,
+// page_size, offset.
+func (p *TreasuryProxy) ListForecasts(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	var includeCancelled bool
+	if v := q.Get("include_cancelled"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid include_cancelled")
+			return
+		}
+		includeCancelled = b
+	}
+
+	resp, err := p.client.ListForecasts(r.Context(), &treasuryv1.ListForecastsRequest{
+		NostroId:         q.Get("nostro_id"),
+		FromDate:         q.Get("from_date"),
+		ToDate:           q.Get("to_date"),
+		IncludeCancelled: includeCancelled,
+		PageSize:         pageSize,
+		Offset:           offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listForecastsResp{
+		Forecasts:  make([]*forecastMsg, 0, len(resp.GetForecasts())),
+		TotalCount: resp.GetTotalCount(),
+	}
+	for _, f := range resp.GetForecasts() {
+		out.Forecasts = append(out.Forecasts, toForecastMsg(f))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// CancelForecast handles POST /api/v1/treasury/forecasts/{id}/cancel.
+func (p *TreasuryProxy) CancelForecast(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "forecast id is required")
+		return
+	}
+
+	resp, err := p.client.CancelForecast(r.Context(), &treasuryv1.CancelForecastRequest{ForecastId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, forecastResp{Forecast: toForecastMsg(resp.GetForecast())})
+}
+
+// CreateSweep handles POST /api/v1/treasury/sweeps.
+func (p *TreasuryProxy) CreateSweep(w http.ResponseWriter, r *http.Request) {
+	var req createSweepReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	sweepType, found := treasuryv1.SweepType_value["SWEEP_TYPE_"+strings.ToUpper(req.Type)]
+	if !found || sweepType == 0 {
+		writeError(w, http.StatusBadRequest, "type must be EXCESS or COVER")
+		return
+	}
+
+	resp, err := p.client.CreateSweepInstruction(r.Context(), &treasuryv1.CreateSweepInstructionRequest{
+		SourceNostroId: req.SourceNostroID,
+		TargetNostroId: req.TargetNostroID,
+		Type:           treasuryv1.SweepType(sweepType),
+		TriggerBalance: req.TriggerBalance,
+		TargetBalance:  req.TargetBalance,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	sweep := toSweepMsg(resp.GetSweep())
+	w.Header().Set("Location", "/api/v1/treasury/sweeps/"+url.PathEscape(sweep.SweepID))
+	writeJSON(w, http.StatusCreated, sweepResp{Sweep: sweep})
+}
+
+// ListSweeps handles GET /api/v1/treasury/sweeps.
+// Query parameters: nostro_id, page_size, offset.
+func (p *TreasuryProxy) ListSweeps(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.ListSweepInstructions(r.Context(), &treasuryv1.ListSweepInstructionsRequest{
+		NostroId: r.URL.Query().Get("nostro_id"),
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listSweepsResp{
+		Sweeps:     make([]*sweepMsg, 0, len(resp.GetSweeps())),
+		TotalCount: resp.GetTotalCount(),
+	}
+	for _, s := range resp.GetSweeps() {
+		out.Sweeps = append(out.Sweeps, toSweepMsg(s))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// UpdateSweep handles PUT /api/v1/treasury/sweeps/{id}, replacing the
+// sweep's balances and state.
+func (p *TreasuryProxy) UpdateSweep(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "sweep id is required")
+		return
+	}
+	var req updateSweepReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.UpdateSweepInstruction(r.Context(), &treasuryv1.UpdateSweepInstructionRequest{
+		SweepId:        id,
+		TriggerBalance: req.TriggerBalance,
+		TargetBalance:  req.TargetBalance,
+		Active:         req.Active,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, sweepResp{Sweep: toSweepMsg(resp.GetSweep())})
+}
+
+// ListAlerts handles GET /api/v1/treasury/alerts.
+// Query parameters: nostro_id, status, page_size, offset.
+func (p *TreasuryProxy) ListAlerts(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	var status treasuryv1.AlertStatus
+	if v := q.Get("status"); v != "" {
+		n, found := treasuryv1.AlertStatus_value["ALERT_STATUS_"+strings.ToUpper(v)]
+		if !found {
+			writeError(w, http.StatusBadRequest, "status must be OPEN or RESOLVED")
+			return
+		}
+		status = treasuryv1.AlertStatus(n)
+	}
+
+	resp, err := p.client.ListAlerts(r.Context(), &treasuryv1.ListAlertsRequest{
+		NostroId: q.Get("nostro_id"),
+		Status:   status,
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listLiquidityAlertsResp{
+		Alerts:     make([]*liquidityAlertMsg, 0, len(resp.GetAlerts())),
+		TotalCount: resp.GetTotalCount(),
+	}
+	for _, a := range resp.GetAlerts() {
+		out.Alerts = append(out.Alerts, toLiquidityAlertMsg(a))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// EvaluateLiquidity handles POST /api/v1/treasury/evaluate, running the
+// tenant's due sweeps and re-evaluating its alerts now.
+func (p *TreasuryProxy) EvaluateLiquidity(w http.ResponseWriter, r *http.Request) {
+	resp, err := p.client.EvaluateLiquidity(r.Context(), &treasuryv1.EvaluateLiquidityRequest{})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, evaluateLiquidityResp{
+		SweepsExecuted: resp.GetSweepsExecuted(),
+		AlertsOpened:   resp.GetAlertsOpened(),
+		AlertsResolved: resp.GetAlertsResolved(),
+	})
+}
+
+func readHorizonDays(w http.ResponseWriter, r *http.Request) (int32, bool) {
+	v := r.URL.Query().Get("horizon_days")
+	if v == "" {
+		return 0, true
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid horizon_days")
+		return 0, false
+	}
+	return int32(n), true
+}
+
+func toNostroAccountMsg(n *treasuryv1.NostroAccount) *nostroAccountMsg {
+	if n == nil {
+		return nil
+	}
+	rails := n.GetRails()
+	if rails == nil {
+		rails = []string{}
+	}
+	return &nostroAccountMsg{
+		NostroID:          n.GetNostroId(),
+		Name:              n.GetName(),
+		CorrespondentBIC:  n.GetCorrespondentBic(),
+		AccountNumber:     n.GetAccountNumber(),
+		Currency:          n.GetCurrency(),
+		LedgerAccountCode: n.GetLedgerAccountCode(),
+		Rails:             rails,
+		OpeningBalance:    n.GetOpeningBalance(),
+		MinimumBalance:    n.GetMinimumBalance(),
+		Active:            n.GetActive(),
+		Version:           n.GetVersion(),
+		CreatedAt:         formatTimestamp(n.GetCreatedAt()),
+		UpdatedAt:         formatTimestamp(n.GetUpdatedAt()),
+	}
+}
+
+func toLiquidityPositionMsg(p *treasuryv1.LiquidityPosition) *liquidityPositionMsg {
+	if p == nil {
+		return nil
+	}
+	msg := &liquidityPositionMsg{
+		NostroID:         p.GetNostroId(),
+		Currency:         p.GetCurrency(),
+		BookedBalance:    p.GetBookedBalance(),
+		PendingOutflows:  p.GetPendingOutflows(),
+		AvailableBalance: p.GetAvailableBalance(),
+		MinimumBalance:   p.GetMinimumBalance(),
+		Projections:      make([]*dailyProjectionMsg, 0, len(p.GetProjections())),
+		AsOf:             formatTimestamp(p.GetAsOf()),
+	}
+	for _, d := range p.GetProjections() {
+		msg.Projections = append(msg.Projections, &dailyProjectionMsg{
+			Date:           d.GetDate(),
+			Inflows:        d.GetInflows(),
+			Outflows:       d.GetOutflows(),
+			ClosingBalance: d.GetClosingBalance(),
+			BelowMinimum:   d.GetBelowMinimum(),
+		})
+	}
+	return msg
+}
+
+func toForecastMsg(f *treasuryv1.FundingForecast) *forecastMsg {
+	if f == nil {
+		return nil
+	}
+	return &forecastMsg{
+		ForecastID:  f.GetForecastId(),
+		NostroID:    f.GetNostroId(),
+		ValueDate:   f.GetValueDate(),
+		Direction:   enumName(f.GetDirection().String(), "FORECAST_DIRECTION_"),
+		Amount:      f.GetAmount(),
+		Description: f.GetDescription(),
+		Status:      enumName(f.GetStatus().String(), "FORECAST_STATUS_"),
+		SweepID:     f.GetSweepId(),
+		Version:     f.GetVersion(),
+		CreatedAt:   formatTimestamp(f.GetCreatedAt()),
+		UpdatedAt:   formatTimestamp(f.GetUpdatedAt()),
+	}
+}
+
+func toSweepMsg(s *treasuryv1.SweepInstruction) *sweepMsg {
+	if s == nil {
+		return nil
+	}
+	return &sweepMsg{
+		SweepID:        s.GetSweepId(),
+		SourceNostroID: s.GetSourceNostroId(),
+		TargetNostroID: s.GetTargetNostroId(),
+		Type:           enumName(s.GetType().String(), "SWEEP_TYPE_"),
+		TriggerBalance: s.GetTriggerBalance(),
+		TargetBalance:  s.GetTargetBalance(),
+		Active:         s.GetActive(),
+		LastExecutedOn: s.GetLastExecutedOn(),
+		Version:        s.GetVersion(),
+		CreatedAt:      formatTimestamp(s.GetCreatedAt()),
+		UpdatedAt:      formatTimestamp(s.GetUpdatedAt()),
+	}
+}
+
+func toLiquidityAlertMsg(a *treasuryv1.LiquidityAlert) *liquidityAlertMsg {
+	if a == nil {
+		return nil
+	}
+	return &liquidityAlertMsg{
+		AlertID:          a.GetAlertId(),
+		NostroID:         a.GetNostroId(),
+		Status:           enumName(a.GetStatus().String(), "ALERT_STATUS_"),
+		BreachDate:       a.GetBreachDate(),
+		ProjectedBalance: a.GetProjectedBalance(),
+		MinimumBalance:   a.GetMinimumBalance(),
+		OpenedAt:         formatTimestamp(a.GetOpenedAt()),
+		ResolvedAt:       formatTimestamp(a.GetResolvedAt()),
+		Version:          a.GetVersion(),
+	}
+}
@@ -0,0 +1,69 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const nostroAccountJSON = `{
+	"nostro_id": "0b2d4f6a-8c1e-4a3b-9d5f-7a9c1e3b5d8f",
+	"name": "EUR correspondent",
+	"correspondent_bic": "DEUTDEFF",
+	"account_number": "100200300",
+	"currency": "EUR",
+	"ledger_account_code": "1050",
+	"rails": ["SEPA"],
+	"opening_balance": "1000000",
+	"minimum_balance": "250000",
+	"active": true,
+	"version": 1,
+	"created_at": "2026-01-15T09:00:00Z",
+	"updated_at": "2026-01-15T09:00:00Z"
+}`
+
+func TestTreasuryContract(t *testing.T) {
+	stub, conn := newContractStub(t, "treasury-service")
+	p := NewTreasuryProxy(conn, conn.Logger)
+	nostroID := contractNostroID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "track a nostro account",
+		Method:      "/bib.treasury.v1.TreasuryService/CreateNostroAccount",
+		Response:    json.RawMessage(`{"nostro_account": ` + nostroAccountJSON + `}`),
+	})
+	rec := call(t, p.CreateNostroAccount, http.MethodPost, "/api/v1/treasury/nostro-accounts", `{"name":
+		"EUR correspondent", "correspondent_bic": "DEUTDEFF", "account_number": "100200300", "currency": "EUR",
+		"ledger_account_code": "1050", "rails": ["SEPA"], "opening_balance": "1000000", "minimum_balance": "250000"}`)
+	if rec.Code != http.StatusCreated || rec.Header().Get("Location") != "/api/v1/treasury/nostro-accounts/"+nostroID {
+		t.Errorf("CreateNostroAccount = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a nostro account",
+		State:       "an active nostro account exists",
+		Method:      "/bib.treasury.v1.TreasuryService/GetNostroAccount",
+		Response:    json.RawMessage(`{"nostro_account": ` + nostroAccountJSON + `}`),
+	})
+	rec = call(t, p.GetNostroAccount, http.MethodGet, "/api/v1/treasury/nostro-accounts/"+nostroID, "", "id", nostroID)
+	if rec.Code != http.StatusOK {
+		t.Errorf("GetNostroAccount = %d %s", rec.Code, rec.Body)
+	} else if nostro, _ := decodeBody(t, rec)["nostro_account"].(map[string]any); nostro["minimum_balance"] != "250000" {
+		t.Errorf("GetNostroAccount nostro_account = %v", nostro)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing nostro account",
+		Method:      "/bib.treasury.v1.TreasuryService/GetNostroAccount",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetNostroAccount, http.MethodGet, "/api/v1/treasury/nostro-accounts/"+nostroID, "", "id", nostroID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetNostroAccount of a missing account = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-treasury-service.json"))
+}
@@ -0,0 +1,371 @@
+package proxy
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	webhooksv1 "github.com/bibbank/bib/api/gen/go/bib/webhooks/v1"
+)
+
+// WebhooksProxy proxies HTTP requests to the webhooks gRPC service.
+type WebhooksProxy struct {
+	client webhooksv1.WebhooksServiceClient
+	logger *slog.Logger
+}
+
+// NewWebhooksProxy creates a new webhooks service proxy.
+func NewWebhooksProxy(conn *ServiceConn, logger *slog.Logger) *WebhooksProxy {
+	return &WebhooksProxy{client: webhooksv1.NewWebhooksServiceClient(conn), logger: logger}
+}
+
+type createSubscriptionReq struct {
+	URL         string   `json:"url"`
+	Description string   `json:"description,omitempty"`
+	EventTypes  []string `json:"event_types"`
+}
+
+type updateSubscriptionReq struct {
+	URL         string   `json:"url"`
+	Description string   `json:"description,omitempty"`
+	EventTypes  []string `json:"event_types"`
+	Active      bool     `json:"active"`
+}
+
+type rotateSecretReq struct {
+	// GracePeriodSeconds is how long the old secret keeps signing payloads;
+	// omitted, the service default applies.
+	GracePeriodSeconds *int32 `json:"grace_period_seconds,omitempty"`
+}
+
+type subscriptionMsg struct {
+	SubscriptionID          string   `json:"subscription_id"`
+	URL                     string   `json:"url"`
+	Description             string   `json:"description,omitempty"`
+	PreviousSecretExpiresAt string   `json:"previous_secret_expires_at,omitempty"`
+	CreatedAt               string   `json:"created_at"`
+	UpdatedAt               string   `json:"updated_at"`
+	EventTypes              []string `json:"event_types"`
+	Version                 int32    `json:"version"`
+	Active                  bool     `json:"active"`
+}
+
+type subscriptionResp struct {
+	Subscription *subscriptionMsg `json:"subscription"`
+}
+
+type subscriptionSecretResp struct {
+	Subscription *subscriptionMsg `json:"subscription"`
+	Secret       string           `json:"secret"`
+}
+
+type listSubscriptionsResp struct {
+	Subscriptions []*subscriptionMsg `json:"subscriptions"`
+	TotalCount    int32              `json:"total_count"`
+}
+
+type deliveryAttemptMsg struct {
+	AttemptedAt string `json:"attempted_at"`
+	Error       string `json:"error,omitempty"`
+	DurationMS  int64  `json:"duration_ms"`
+	Number      int32  `json:"number"`
+	StatusCode  int32  `json:"status_code"`
+}
+
+type deliveryMsg struct {
+	DeliveryID     string                `json:"delivery_id"`
+	SubscriptionID string                `json:"subscription_id"`
+	EventID        string                `json:"event_id"`
+	EventType      string                `json:"event_type"`
+	Status         string                `json:"status"`
+	NextAttemptAt  string                `json:"next_attempt_at,omitempty"`
+	LastError      string                `json:"last_error,omitempty"`
+	DeliveredAt    string                `json:"delivered_at,omitempty"`
+	CreatedAt      string                `json:"created_at"`
+	UpdatedAt      string                `json:"updated_at"`
+	Payload        json.RawMessage       `json:"payload,omitempty"`
+	AttemptLog     []*deliveryAttemptMsg `json:"attempt_log"`
+	Attempts       int32                 `json:"attempts"`
+	LastStatusCode int32                 `json:"last_status_code,omitempty"`
+	Version        int32                 `json:"version"`
+}
+
+type deliveryResp struct {
+	Delivery *deliveryMsg `json:"delivery"`
+}
+
+type listDeliveriesResp struct {
+	Deliveries []*deliveryMsg `json:"deliveries"`
+	TotalCount int32          `json:"total_count"`
+}
+
+// CreateSubscription handles POST /api/v1/webhooks/subscriptions. The
+// response carries the signing secret, which is not returned again.
+func (p *WebhooksProxy) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	var req createSubscriptionReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.CreateSubscription(r.Context(), &webhooksv1.CreateSubscriptionRequest{
+		Url:         req.URL,
+		EventTypes:  req.EventTypes,
+		Description: req.Description,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	sub := toSubscriptionMsg(resp.GetSubscription())
+	w.Header().Set("Location", "/api/v1/webhooks/subscriptions/"+url.PathEscape(sub.SubscriptionID))
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusCreated, subscriptionSecretResp{Subscription: sub, Secret: resp.GetSecret()})
+}
+
+// ListSubscriptions handles GET /api/v1/webhooks/subscriptions.
+// Query parameters: page_size, offset.
+func (p *WebhooksProxy) ListSubscriptions(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := p.client.ListSubscriptions(r.Context(), &webhooksv1.ListSubscriptionsRequest{
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listSubscriptionsResp{
+		Subscriptions: make([]*subscriptionMsg, 0, len(resp.GetSubscriptions())),
+		TotalCount:    resp.GetTotalCount(),
+	}
+	for _, s := range resp.GetSubscriptions() {
+		out.Subscriptions = append(out.Subscriptions, toSubscriptionMsg(s))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetSubscription handles GET /api/v1/webhooks/subscriptions/{id}.
+func (p *WebhooksProxy) GetSubscription(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "subscription id is required")
+		return
+	}
+
+	resp, err := p.client.GetSubscription(r.Context(), &webhooksv1.GetSubscriptionRequest{SubscriptionId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, subscriptionResp{Subscription: toSubscriptionMsg(resp.GetSubscription())})
+}
+
+// UpdateSubscription handles PUT /api/v1/webhooks/subscriptions/{id},
+// replacing the subscription's endpoint, filters, description and state.
+func (p *WebhooksProxy) UpdateSubscription(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "subscription id is required")
+		return
+	}
+	var req updateSubscriptionReq
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := p.client.UpdateSubscription(r.Context(), &webhooksv1.UpdateSubscriptionRequest{
+		SubscriptionId: id,
+		Url:            req.URL,
+		EventTypes:     req.EventTypes,
+		Description:    req.Description,
+		Active:         req.Active,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, subscriptionResp{Subscription: toSubscriptionMsg(resp.GetSubscription())})
+}
+
+// DeleteSubscription handles DELETE /api/v1/webhooks/subscriptions/{id}.
+func (p *WebhooksProxy) DeleteSubscription(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "subscription id is required")
+		return
+	}
+
+	if _, err := p.client.DeleteSubscription(r.Context(), &webhooksv1.DeleteSubscriptionRequest{SubscriptionId: id}); err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RotateSecret handles POST /api/v1/webhooks/subscriptions/{id}/rotate-secret.
+// The body is optional.
+func (p *WebhooksProxy) RotateSecret(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "subscription id is required")
+		return
+	}
+	var req rotateSecretReq
+	if r.ContentLength != 0 {
+		if err := readJSON(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	resp, err := p.client.RotateSecret(r.Context(), &webhooksv1.RotateSecretRequest{
+		SubscriptionId:     id,
+		GracePeriodSeconds: req.GracePeriodSeconds,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	writeJSON(w, http.StatusOK, subscriptionSecretResp{
+		Subscription: toSubscriptionMsg(resp.GetSubscription()),
+		Secret:       resp.GetSecret(),
+	})
+}
+
+// ListDeliveries handles GET /api/v1/webhooks/deliveries.
+// Query parameters: subscription_id, status, event_type, page_size, offset.
+func (p *WebhooksProxy) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	pageSize, offset, ok := readStatementPage(w, r)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	var status webhooksv1.DeliveryStatus
+	if v := q.Get("status"); v != "" {
+		n, found := webhooksv1.DeliveryStatus_value["DELIVERY_STATUS_"+strings.ToUpper(v)]
+		if !found {
+			writeError(w, http.StatusBadRequest, "status must be PENDING, SUCCEEDED or FAILED")
+			return
+		}
+		status = webhooksv1.DeliveryStatus(n)
+	}
+
+	resp, err := p.client.ListDeliveries(r.Context(), &webhooksv1.ListDeliveriesRequest{
+		SubscriptionId: q.Get("subscription_id"),
+		Status:         status,
+		EventType:      q.Get("event_type"),
+		PageSize:       pageSize,
+		Offset:         offset,
+	})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	out := listDeliveriesResp{
+		Deliveries: make([]*deliveryMsg, 0, len(resp.GetDeliveries())),
+		TotalCount: resp.GetTotalCount(),
+	}
+	for _, d := range resp.GetDeliveries() {
+		out.Deliveries = append(out.Deliveries, toDeliveryMsg(d))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// GetDelivery handles GET /api/v1/webhooks/deliveries/{id}, including the
+// delivery's payload and attempt log.
+func (p *WebhooksProxy) GetDelivery(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "delivery id is required")
+		return
+	}
+
+	resp, err := p.client.GetDelivery(r.Context(), &webhooksv1.GetDeliveryRequest{DeliveryId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusOK, deliveryResp{Delivery: toDeliveryMsg(resp.GetDelivery())})
+}
+
+// Redeliver handles POST /api/v1/webhooks/deliveries/{id}/redeliver,
+// queueing a succeeded or failed delivery to be sent again.
+func (p *WebhooksProxy) Redeliver(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "delivery id is required")
+		return
+	}
+
+	resp, err := p.client.Redeliver(r.Context(), &webhooksv1.RedeliverRequest{DeliveryId: id})
+	if err != nil {
+		handleGRPCError(w, err, p.logger)
+		return
+	}
+	writeJSON(w, http.StatusAccepted, deliveryResp{Delivery: toDeliveryMsg(resp.GetDelivery())})
+}
+
+func toSubscriptionMsg(s *webhooksv1.Subscription) *subscriptionMsg {
+	if s == nil {
+		return nil
+	}
+	eventTypes := s.GetEventTypes()
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	return &subscriptionMsg{
+		SubscriptionID:          s.GetSubscriptionId(),
+		URL:                     s.GetUrl(),
+		EventTypes:              eventTypes,
+		Description:             s.GetDescription(),
+		Active:                  s.GetActive(),
+		Version:                 s.GetVersion(),
+		PreviousSecretExpiresAt: formatTimestamp(s.GetPreviousSecretExpiresAt()),
+		CreatedAt:               formatTimestamp(s.GetCreatedAt()),
+		UpdatedAt:               formatTimestamp(s.GetUpdatedAt()),
+	}
+}
+
+func toDeliveryMsg(d *webhooksv1.Delivery) *deliveryMsg {
+	if d == nil {
+		return nil
+	}
+	msg := &deliveryMsg{
+		DeliveryID:     d.GetDeliveryId(),
+		SubscriptionID: d.GetSubscriptionId(),
+		EventID:        d.GetEventId(),
+		EventType:      d.GetEventType(),
+		Status:         enumName(d.GetStatus().String(), "DELIVERY_STATUS_"),
+		Attempts:       d.GetAttempts(),
+		NextAttemptAt:  formatTimestamp(d.GetNextAttemptAt()),
+		LastStatusCode: d.GetLastStatusCode(),
+		LastError:      d.GetLastError(),
+		DeliveredAt:    formatTimestamp(d.GetDeliveredAt()),
+		AttemptLog:     make([]*deliveryAttemptMsg, 0, len(d.GetAttemptLog())),
+		Version:        d.GetVersion(),
+		CreatedAt:      formatTimestamp(d.GetCreatedAt()),
+		UpdatedAt:      formatTimestamp(d.GetUpdatedAt()),
+	}
+	if payload := d.GetPayload(); payload != "" && json.Valid([]byte(payload)) {
+		msg.Payload = json.RawMessage(payload)
+	}
+	for _, a := range d.GetAttemptLog() {
+		msg.AttemptLog = append(msg.AttemptLog, &deliveryAttemptMsg{
+			Number:      a.GetNumber(),
+			AttemptedAt: formatTimestamp(a.GetAttemptedAt()),
+			StatusCode:  a.GetStatusCode(),
+			Error:       a.GetError(),
+			DurationMS:  a.GetDurationMs(),
+		})
+	}
+	return msg
+}
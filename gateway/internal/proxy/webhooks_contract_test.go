@@ -0,0 +1,63 @@
+package proxy
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/contract"
+)
+
+const subscriptionJSON = `{
+	"subscription_id": "f6b8d0a2-4c7e-4a9b-8d1f-5a7c9e1b3d6f",
+	"url": "https://hooks.example.com/bib",
+	"event_types": ["payment.*"],
+	"description": "Payment updates",
+	"active": true,
+	"version": 1,
+	"created_at": "2026-01-15T09:00:00Z",
+	"updated_at": "2026-01-15T09:00:00Z"
+}`
+
+func TestWebhooksContract(t *testing.T) {
+	stub, conn := newContractStub(t, "webhooks-service")
+	p := NewWebhooksProxy(conn, conn.Logger)
+	subscriptionID := contractSubscriptionID.String()
+
+	stub.Given(contract.Interaction{
+		Description: "subscribe to payment events",
+		Method:      "/bib.webhooks.v1.WebhooksService/CreateSubscription",
+		Response:    json.RawMessage(`{"subscription": ` + subscriptionJSON + `, "secret": "whsec_c2VjcmV0"}`),
+	})
+	rec := call(t, p.CreateSubscription, http.MethodPost, "/api/v1/webhooks/subscriptions", `{"url":
+		"https://hooks.example.com/bib", "event_types": ["payment.*"], "description": "Payment updates"}`)
+	if body := decodeBody(t, rec); rec.Code != http.StatusCreated || body["secret"] != "whsec_c2VjcmV0" {
+		t.Errorf("CreateSubscription = %d %s", rec.Code, rec.Body)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a subscription",
+		State:       "an active subscription exists",
+		Method:      "/bib.webhooks.v1.WebhooksService/GetSubscription",
+		Response:    json.RawMessage(`{"subscription": ` + subscriptionJSON + `}`),
+	})
+	rec = call(t, p.GetSubscription, http.MethodGet, "/api/v1/webhooks/subscriptions/"+subscriptionID, "", "id", subscriptionID)
+	if rec.Code != http.StatusOK {
+		t.Errorf("GetSubscription = %d %s", rec.Code, rec.Body)
+	} else if sub, _ := decodeBody(t, rec)["subscription"].(map[string]any); sub["url"] != "https://hooks.example.com/bib" {
+		t.Errorf("GetSubscription subscription = %v", sub)
+	}
+
+	stub.Given(contract.Interaction{
+		Description: "get a missing subscription",
+		Method:      "/bib.webhooks.v1.WebhooksService/GetSubscription",
+		Code:        "NotFound",
+	})
+	rec = call(t, p.GetSubscription, http.MethodGet, "/api/v1/webhooks/subscriptions/"+subscriptionID, "", "id", subscriptionID)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("GetSubscription of a missing subscription = %d, want 404", rec.Code)
+	}
+
+	stub.Check(filepath.Join(contractsDir, "gateway-webhooks-service.json"))
+}
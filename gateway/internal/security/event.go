@@ -0,0 +1,61 @@
+// Package security publishes the gateway's security events, such as a client
+// crossing a failed-authentication threshold, for other services to
+// correlate with their own risk signals.
+package security
+
+import (
+	"context"
+	"time"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// AuthEventsTopic is the topic carrying authentication security events.
+const AuthEventsTopic = "bib.security.auth-events"
+
+// Authentication security event types.
+const (
+	// EventAuthStepUpRequired is published when a client's failed
+	// authentications reach the step-up threshold.
+	EventAuthStepUpRequired = "security.auth.step_up_required"
+	// EventAuthLockedOut is published when a client is locked out.
+	EventAuthLockedOut = "security.auth.locked_out"
+)
+
+// Aggregate types of an AuthEvent: the kind of client that was tracked.
+const (
+	AggregateClientIP = "client_ip"
+	AggregateUser     = "user"
+)
+
+// AuthEvent reports that a client, identified by IP address or by the user
+// its tokens claim, crossed a failed-authentication threshold.
+type AuthEvent struct {
+	events.BaseEvent
+	// LockedUntil is set on lockout events.
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	ClientIP    string     `json:"client_ip"`
+	// UserID is the user claimed by the rejected token, when it could be
+	// read. The token failed validation, so the claim is unverified.
+	UserID    string `json:"user_id,omitempty"`
+	Reason    string `json:"reason"`
+	UserAgent string `json:"user_agent,omitempty"`
+	Failures  int    `json:"failures"`
+}
+
+// NewAuthEvent creates an AuthEvent about the client identified by
+// aggregateType and aggregateID.
+func NewAuthEvent(eventType, aggregateType, aggregateID, tenantID string) AuthEvent {
+	return AuthEvent{BaseEvent: events.NewBaseEvent(eventType, aggregateID, aggregateType, tenantID)}
+}
+
+// Publisher publishes security events.
+type Publisher interface {
+	Publish(ctx context.Context, evt AuthEvent) error
+}
+
+// NoopPublisher discards events. It is used when no broker is configured.
+type NoopPublisher struct{}
+
+// Publish discards evt.
+func (NoopPublisher) Publish(context.Context, AuthEvent) error { return nil }
@@ -0,0 +1,59 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bibbank/bib/pkg/events"
+	pkgkafka "github.com/bibbank/bib/pkg/kafka"
+)
+
+// eventSource is the CloudEvents source of events the gateway publishes.
+const eventSource = "/bib/gateway"
+
+// KafkaPublisher publishes security events to AuthEventsTopic as CloudEvents,
+// keyed by the tracked client so a client's events stay in order.
+type KafkaPublisher struct {
+	producer *pkgkafka.Producer
+}
+
+// NewKafkaPublisher creates a publisher writing through producer.
+func NewKafkaPublisher(producer *pkgkafka.Producer) *KafkaPublisher {
+	return &KafkaPublisher{producer: producer}
+}
+
+// Publish sends evt.
+func (p *KafkaPublisher) Publish(ctx context.Context, evt AuthEvent) error {
+	msg, err := encode(evt)
+	if err != nil {
+		return err
+	}
+	if err := p.producer.Publish(ctx, AuthEventsTopic, msg); err != nil {
+		return fmt.Errorf("publish %s: %w", evt.EventType(), err)
+	}
+	return nil
+}
+
+// encode wraps evt in a CloudEvents envelope with the headers the outbox
+// relay sets on domain events, so consumers handle both alike.
+func encode(evt AuthEvent) (pkgkafka.Message, error) {
+	ce, err := events.NewCloudEvent(eventSource, evt)
+	if err != nil {
+		return pkgkafka.Message{}, err
+	}
+	payload, err := json.Marshal(ce)
+	if err != nil {
+		return pkgkafka.Message{}, fmt.Errorf("marshal CloudEvent %s: %w", evt.EventType(), err)
+	}
+	return pkgkafka.Message{
+		Key:   []byte(evt.AggregateID()),
+		Value: payload,
+		Headers: map[string]string{
+			"event_type":     evt.EventType(),
+			"aggregate_type": evt.AggregateType(),
+			"event_id":       evt.EventID(),
+			"content-type":   events.CloudEventsContentType,
+		},
+	}, nil
+}
@@ -0,0 +1,37 @@
+package security
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+func TestEncode(t *testing.T) {
+	evt := NewAuthEvent(EventAuthLockedOut, AggregateClientIP, "198.51.100.7", "")
+	evt.ClientIP = "198.51.100.7"
+	evt.Failures = 10
+
+	msg, err := encode(evt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(msg.Key) != "198.51.100.7" {
+		t.Fatalf("expected the message keyed by client, got %q", msg.Key)
+	}
+	if msg.Headers["event_type"] != EventAuthLockedOut || msg.Headers["aggregate_type"] != AggregateClientIP || msg.Headers["event_id"] != evt.EventID() {
+		t.Fatalf("unexpected headers %v", msg.Headers)
+	}
+
+	data, err := events.EventData(msg.Value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got AuthEvent
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.ClientIP != evt.ClientIP || got.Failures != 10 || got.EventType() != EventAuthLockedOut {
+		t.Fatalf("unexpected event %+v", got)
+	}
+}
@@ -0,0 +1,25 @@
+-- Refresh tokens issued by /api/v1/auth/token and /api/v1/auth/refresh.
+-- Only the SHA-256 hash of a token is kept. used_at is set when the token
+-- is exchanged; presenting it again revokes its whole session.
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    token_hash CHAR(64)    PRIMARY KEY,
+    session_id UUID        NOT NULL,
+    user_id    UUID        NOT NULL,
+    tenant_id  UUID        NOT NULL,
+    roles      TEXT[]      NOT NULL DEFAULT '{}',
+    issued_at  TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at    TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_session_id ON refresh_tokens (session_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_expires_at ON refresh_tokens (expires_at);
+
+-- Revoked access token IDs (jti), kept until the tokens expire.
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    jti        VARCHAR(64) PRIMARY KEY,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_revoked_tokens_expires_at ON revoked_tokens (expires_at);
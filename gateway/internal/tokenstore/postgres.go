@@ -0,0 +1,181 @@
+// Package tokenstore keeps the state behind the gateway's token endpoints:
+// refresh tokens and the revocation list of access tokens.
+package tokenstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// Store keeps refresh tokens and revoked access tokens.
+type Store interface {
+	auth.RevocationList
+	auth.RefreshTokenStore
+	// Purge deletes expired refresh tokens and revocations, returning how
+	// many were deleted.
+	Purge(ctx context.Context) (int64, error)
+}
+
+// PostgresStore keeps refresh tokens in the refresh_tokens table and
+// revoked access tokens in revoked_tokens, so every gateway replica sees
+// the same sessions and revocations.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore creates a new PostgresStore.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// Revoke implements auth.RevocationList.
+func (s *PostgresStore) Revoke(ctx context.Context, jti string, expiresAt time.Time) error {
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO revoked_tokens (jti, expires_at) VALUES ($1, $2)
+		ON CONFLICT (jti) DO NOTHING`,
+		jti, expiresAt.UTC())
+	if err != nil {
+		return fmt.Errorf("revoke token: %w", err)
+	}
+	return nil
+}
+
+// IsRevoked implements auth.RevocationList.
+func (s *PostgresStore) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	var revoked bool
+	err := s.pool.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1)`, jti,
+	).Scan(&revoked)
+	if err != nil {
+		return false, fmt.Errorf("check token revocation: %w", err)
+	}
+	return revoked, nil
+}
+
+// RevokeOnce implements auth.RevocationList. The primary key on jti lets
+// only one of concurrent inserts through.
+func (s *PostgresStore) RevokeOnce(ctx context.Context, jti string, expiresAt time.Time) (bool, error) {
+	tag, err := s.pool.Exec(ctx, `
+		INSERT INTO revoked_tokens (jti, expires_at) VALUES ($1, $2)
+		ON CONFLICT (jti) DO NOTHING`,
+		jti, expiresAt.UTC())
+	if err != nil {
+		return false, fmt.Errorf("revoke token: %w", err)
+	}
+	return tag.RowsAffected() == 0, nil
+}
+
+// Save implements auth.RefreshTokenStore.
+func (s *PostgresStore) Save(ctx context.Context, token auth.RefreshToken) error {
+	sessionID, err := uuid.Parse(token.SessionID)
+	if err != nil {
+		return fmt.Errorf("save refresh token: invalid session ID: %w", err)
+	}
+	roles := token.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+	_, err = s.pool.Exec(ctx, `
+		INSERT INTO refresh_tokens (token_hash, session_id, user_id, tenant_id, roles, issued_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		token.Hash, sessionID, token.UserID, token.TenantID, roles, token.IssuedAt.UTC(), token.ExpiresAt.UTC())
+	if err != nil {
+		return fmt.Errorf("save refresh token: %w", err)
+	}
+	return nil
+}
+
+// Get implements auth.RefreshTokenStore.
+func (s *PostgresStore) Get(ctx context.Context, hash string) (*auth.RefreshToken, error) {
+	row := s.pool.QueryRow(ctx, `
+		SELECT token_hash, session_id, user_id, tenant_id, roles, issued_at, expires_at,
+			used_at IS NOT NULL, revoked_at IS NOT NULL
+		FROM refresh_tokens WHERE token_hash = $1`, hash)
+	rt, err := scanRefreshToken(row)
+	if err != nil {
+		return nil, fmt.Errorf("find refresh token: %w", err)
+	}
+	return rt, nil
+}
+
+// Use implements auth.RefreshTokenStore. The row lock taken by the first of
+// concurrent calls makes the others see the token used.
+func (s *PostgresStore) Use(ctx context.Context, hash string) (*auth.RefreshToken, error) {
+	row := s.pool.QueryRow(ctx, `
+		WITH prev AS (
+			SELECT token_hash, used_at FROM refresh_tokens WHERE token_hash = $1 FOR UPDATE
+		)
+		UPDATE refresh_tokens r SET used_at = COALESCE(prev.used_at, $2)
+		FROM prev WHERE r.token_hash = prev.token_hash
+		RETURNING r.token_hash, r.session_id, r.user_id, r.tenant_id, r.roles, r.issued_at, r.expires_at,
+			prev.used_at IS NOT NULL, r.revoked_at IS NOT NULL`,
+		hash, time.Now().UTC())
+	rt, err := scanRefreshToken(row)
+	if err != nil {
+		return nil, fmt.Errorf("use refresh token: %w", err)
+	}
+	return rt, nil
+}
+
+// RevokeSession implements auth.RefreshTokenStore.
+func (s *PostgresStore) RevokeSession(ctx context.Context, sessionID string) error {
+	id, err := uuid.Parse(sessionID)
+	if err != nil {
+		// No session was stored under a malformed ID.
+		return nil
+	}
+	_, err = s.pool.Exec(ctx,
+		`UPDATE refresh_tokens SET revoked_at = $2 WHERE session_id = $1 AND revoked_at IS NULL`,
+		id, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("revoke session: %w", err)
+	}
+	return nil
+}
+
+// Purge implements Store.
+func (s *PostgresStore) Purge(ctx context.Context) (int64, error) {
+	now := time.Now().UTC()
+	var n int64
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, `DELETE FROM refresh_tokens WHERE expires_at <= $1`, now)
+		if err != nil {
+			return err
+		}
+		n = tag.RowsAffected()
+		tag, err = tx.Exec(ctx, `DELETE FROM revoked_tokens WHERE expires_at <= $1`, now)
+		if err != nil {
+			return err
+		}
+		n += tag.RowsAffected()
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("purge tokens: %w", err)
+	}
+	return n, nil
+}
+
+func scanRefreshToken(row pgx.Row) (*auth.RefreshToken, error) {
+	var (
+		rt        auth.RefreshToken
+		sessionID uuid.UUID
+	)
+	err := row.Scan(&rt.Hash, &sessionID, &rt.UserID, &rt.TenantID, &rt.Roles, &rt.IssuedAt, &rt.ExpiresAt, &rt.Used, &rt.Revoked)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, auth.ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	rt.SessionID = sessionID.String()
+	return &rt, nil
+}
@@ -0,0 +1,106 @@
+// Package approval implements dual control (maker-checker) for sensitive
+// operations such as ledger adjustments, limit changes, report submission and
+// loan modifications. A maker submits a request for an action instead of
+// performing it; the request stays pending until a checker holding one of the
+// action's approver roles approves or rejects it, or it expires. The maker can
+// never check their own request.
+//
+// The package records the decision; the service performs the action once
+// Approve returns, using the request's payload. A service typically keeps the
+// approved request's ID on whatever it changes, so a crash between the two is
+// visible and the action can be retried.
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrNotFound is returned when no request has the given ID in the tenant.
+	ErrNotFound = errors.New("approval request not found")
+	// ErrUnknownAction is returned when submitting an action without a rule.
+	ErrUnknownAction = errors.New("action does not require approval")
+	// ErrSelfApproval is returned when a maker tries to decide their own
+	// request.
+	ErrSelfApproval = errors.New("maker cannot decide their own request")
+	// ErrNotApprover is returned when the checker holds none of the action's
+	// approver roles.
+	ErrNotApprover = errors.New("caller is not an approver for this action")
+	// ErrNotMaker is returned when someone other than the maker cancels a
+	// request.
+	ErrNotMaker = errors.New("only the maker can cancel a request")
+	// ErrNotPending is returned when a request was already decided, cancelled
+	// or expired.
+	ErrNotPending = errors.New("approval request is not pending")
+	// ErrExpired is returned when deciding a request past its expiry.
+	ErrExpired = errors.New("approval request has expired")
+	// ErrUnauthenticated is returned when the context carries no claims.
+	ErrUnauthenticated = errors.New("no authenticated caller")
+)
+
+// Status is the state of an approval request.
+type Status string
+
+// Request statuses. Only PENDING requests can change; the others are final.
+const (
+	StatusPending   Status = "PENDING"
+	StatusApproved  Status = "APPROVED"
+	StatusRejected  Status = "REJECTED"
+	StatusCancelled Status = "CANCELLED"
+	StatusExpired   Status = "EXPIRED"
+)
+
+// Request is a pending or decided request to perform an action.
+type Request struct {
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	// DecidedAt is when the request left PENDING; nil while it is pending.
+	DecidedAt *time.Time
+	// Action names the operation, such as "ledger.adjustment".
+	Action string
+	// Subject identifies what the action applies to, such as an account code.
+	Subject string
+	Status  Status
+	// Reason is the maker's justification.
+	Reason string
+	// Comment is the checker's note on the decision.
+	Comment string
+	// Payload holds the service's parameters for the action, as JSON.
+	Payload   json.RawMessage
+	ID        uuid.UUID
+	TenantID  uuid.UUID
+	MakerID   uuid.UUID
+	CheckerID uuid.UUID
+}
+
+// Filter selects requests to list. Zero fields match everything.
+type Filter struct {
+	// Actions restricts the requests to these actions.
+	Actions []string
+	Status  Status
+	// Limit caps the number returned; zero returns all.
+	Limit int
+}
+
+// Store persists approval requests.
+type Store interface {
+	// Create saves a new request.
+	Create(ctx context.Context, r Request) error
+	// Get returns the request with the given ID in the tenant, or
+	// ErrNotFound.
+	Get(ctx context.Context, tenantID, id uuid.UUID) (Request, error)
+	// Decide saves r's final status and decision if the stored request is
+	// still pending, returning ErrNotPending otherwise, so two checkers
+	// cannot both decide it.
+	Decide(ctx context.Context, r Request) error
+	// List returns the tenant's requests matching the filter, newest first.
+	List(ctx context.Context, tenantID uuid.UUID, filter Filter) ([]Request, error)
+	// ExpirePending marks every pending request that expired by now as
+	// EXPIRED, returning the number marked.
+	ExpirePending(ctx context.Context, now time.Time) (int64, error)
+}
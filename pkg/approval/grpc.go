@@ -0,0 +1,241 @@
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RequestMsg is the wire form of a Request in the services' JSON-coded gRPC
+// responses.
+type RequestMsg struct {
+	ID        string          `json:"id"`
+	Action    string          `json:"action"`
+	Subject   string          `json:"subject"`
+	Status    string          `json:"status"`
+	Reason    string          `json:"reason,omitempty"`
+	Comment   string          `json:"comment,omitempty"`
+	MakerID   string          `json:"maker_id"`
+	CheckerID string          `json:"checker_id,omitempty"`
+	Payload   json.RawMessage `json:"payload,omitempty"`
+	CreatedAt string          `json:"created_at"`
+	ExpiresAt string          `json:"expires_at"`
+	DecidedAt string          `json:"decided_at,omitempty"`
+}
+
+// GetRequest is the request of the GetApprovalRequest and
+// CancelApprovalRequest methods.
+type GetRequest struct {
+	ID string `json:"id"`
+}
+
+// DecideRequest is the request of the ApproveRequest and RejectRequest
+// methods.
+type DecideRequest struct {
+	ID      string `json:"id"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// ListRequest is the request of the ListApprovalRequests method. With Queue
+// set it lists the pending requests awaiting the caller's decision, and the
+// other fields are ignored.
+type ListRequest struct {
+	Status string `json:"status,omitempty"`
+	Action string `json:"action,omitempty"`
+	Limit  int    `json:"limit,omitempty"`
+	Queue  bool   `json:"queue,omitempty"`
+}
+
+// ListResponse is the response of the ListApprovalRequests method.
+type ListResponse struct {
+	Requests []RequestMsg `json:"requests"`
+}
+
+// ToMsg converts a Request to its wire form.
+func ToMsg(r Request) RequestMsg {
+	msg := RequestMsg{
+		ID:        r.ID.String(),
+		Action:    r.Action,
+		Subject:   r.Subject,
+		Status:    string(r.Status),
+		Reason:    r.Reason,
+		Comment:   r.Comment,
+		MakerID:   r.MakerID.String(),
+		Payload:   r.Payload,
+		CreatedAt: r.CreatedAt.Format(time.RFC3339),
+		ExpiresAt: r.ExpiresAt.Format(time.RFC3339),
+	}
+	if r.CheckerID != uuid.Nil {
+		msg.CheckerID = r.CheckerID.String()
+	}
+	if r.DecidedAt != nil {
+		msg.DecidedAt = r.DecidedAt.Format(time.RFC3339)
+	}
+	return msg
+}
+
+// ToListResponse converts requests to a ListResponse.
+func ToListResponse(requests []Request) *ListResponse {
+	msgs := make([]RequestMsg, 0, len(requests))
+	for _, r := range requests {
+		msgs = append(msgs, ToMsg(r))
+	}
+	return &ListResponse{Requests: msgs}
+}
+
+// ParseID parses a request ID from a gRPC request, returning an
+// InvalidArgument status error when it is malformed.
+func ParseID(id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid approval request id: %v", err)
+	}
+	return parsed, nil
+}
+
+// StatusError maps an error from the Manager to a gRPC status error. Errors
+// the package does not define become Internal.
+func StatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var code codes.Code
+	switch {
+	case errors.Is(err, ErrUnauthenticated):
+		code = codes.Unauthenticated
+	case errors.Is(err, ErrNotFound):
+		code = codes.NotFound
+	case errors.Is(err, ErrSelfApproval), errors.Is(err, ErrNotApprover), errors.Is(err, ErrNotMaker):
+		code = codes.PermissionDenied
+	case errors.Is(err, ErrNotPending), errors.Is(err, ErrExpired):
+		code = codes.FailedPrecondition
+	case errors.Is(err, ErrUnknownAction):
+		code = codes.InvalidArgument
+	default:
+		return status.Errorf(codes.Internal, "approval: %v", err)
+	}
+	return status.Error(code, err.Error())
+}
+
+// Server serves a Manager's requests over gRPC, for services to register
+// next to their own service. Submitting stays with each service's own
+// methods, which call Manager.Submit and return the pending request.
+type Server struct {
+	manager *Manager
+}
+
+// NewServer creates a new Server for m.
+func NewServer(m *Manager) *Server {
+	return &Server{manager: m}
+}
+
+// ListApprovalRequests lists the tenant's requests, or the caller's queue.
+func (s *Server) ListApprovalRequests(ctx context.Context, req *ListRequest) (*ListResponse, error) {
+	var (
+		requests []Request
+		err      error
+	)
+	if req.Queue {
+		requests, err = s.manager.Queue(ctx)
+	} else {
+		filter := Filter{Status: Status(req.Status), Limit: req.Limit}
+		if req.Action != "" {
+			filter.Actions = []string{req.Action}
+		}
+		requests, err = s.manager.List(ctx, filter)
+	}
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	return ToListResponse(requests), nil
+}
+
+// GetApprovalRequest returns one request.
+func (s *Server) GetApprovalRequest(ctx context.Context, req *GetRequest) (*RequestMsg, error) {
+	return s.call(req.ID, func(id uuid.UUID) (Request, error) { return s.manager.Get(ctx, id) })
+}
+
+// ApproveRequest approves a pending request.
+func (s *Server) ApproveRequest(ctx context.Context, req *DecideRequest) (*RequestMsg, error) {
+	return s.call(req.ID, func(id uuid.UUID) (Request, error) { return s.manager.Approve(ctx, id, req.Comment) })
+}
+
+// RejectRequest rejects a pending request.
+func (s *Server) RejectRequest(ctx context.Context, req *DecideRequest) (*RequestMsg, error) {
+	return s.call(req.ID, func(id uuid.UUID) (Request, error) { return s.manager.Reject(ctx, id, req.Comment) })
+}
+
+// CancelApprovalRequest withdraws the caller's pending request.
+func (s *Server) CancelApprovalRequest(ctx context.Context, req *GetRequest) (*RequestMsg, error) {
+	return s.call(req.ID, func(id uuid.UUID) (Request, error) { return s.manager.Cancel(ctx, id) })
+}
+
+func (s *Server) call(rawID string, fn func(uuid.UUID) (Request, error)) (*RequestMsg, error) {
+	id, err := ParseID(rawID)
+	if err != nil {
+		return nil, err
+	}
+	r, err := fn(id)
+	if err != nil {
+		return nil, StatusError(err)
+	}
+	msg := ToMsg(r)
+	return &msg, nil
+}
+
+// server is the handler type of the approvals service descriptor.
+type server interface {
+	ListApprovalRequests(context.Context, *ListRequest) (*ListResponse, error)
+	GetApprovalRequest(context.Context, *GetRequest) (*RequestMsg, error)
+	ApproveRequest(context.Context, *DecideRequest) (*RequestMsg, error)
+	RejectRequest(context.Context, *DecideRequest) (*RequestMsg, error)
+	CancelApprovalRequest(context.Context, *GetRequest) (*RequestMsg, error)
+}
+
+// Register registers srv as the gRPC service serviceName, such as
+// "bib.ledger.v1.LedgerApprovals". Like the services' own stand-ins, it
+// expects the JSON codec.
+func Register(s grpc.ServiceRegistrar, serviceName string, srv *Server) {
+	s.RegisterService(&grpc.ServiceDesc{
+		ServiceName: serviceName,
+		HandlerType: (*server)(nil),
+		Methods: []grpc.MethodDesc{
+			unaryMethod(serviceName, "ListApprovalRequests", server.ListApprovalRequests),
+			unaryMethod(serviceName, "GetApprovalRequest", server.GetApprovalRequest),
+			unaryMethod(serviceName, "ApproveRequest", server.ApproveRequest),
+			unaryMethod(serviceName, "RejectRequest", server.RejectRequest),
+			unaryMethod(serviceName, "CancelApprovalRequest", server.CancelApprovalRequest),
+		},
+		Streams: []grpc.StreamDesc{},
+	}, srv)
+}
+
+func unaryMethod[Req, Resp any](serviceName, name string, call func(server, context.Context, *Req) (*Resp, error)) grpc.MethodDesc {
+	fullMethod := "/" + serviceName + "/" + name
+	return grpc.MethodDesc{
+		MethodName: name,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) { //nolint:revive // gRPC handler signature
+			in := new(Req)
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+			if interceptor == nil {
+				return call(srv.(server), ctx, in)
+			}
+			info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return call(srv.(server), ctx, req.(*Req))
+			}
+			return interceptor(ctx, in, info, handler)
+		},
+	}
+}
@@ -0,0 +1,196 @@
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// Manager runs the maker-checker workflow for a service. Callers are
+// identified by the auth claims in their context, and every request is
+// scoped to the caller's tenant.
+type Manager struct {
+	store  Store
+	now    func() time.Time
+	policy Policy
+}
+
+// NewManager creates a new Manager enforcing policy.
+func NewManager(store Store, policy Policy) *Manager {
+	return &Manager{store: store, policy: policy, now: time.Now}
+}
+
+// Policy returns the policy the manager enforces.
+func (m *Manager) Policy() Policy {
+	return m.policy
+}
+
+// Submit records the caller's request to perform action on subject, with
+// payload marshaled as JSON for the service to act on once approved.
+func (m *Manager) Submit(ctx context.Context, action, subject, reason string, payload any) (Request, error) {
+	claims, err := callerOf(ctx)
+	if err != nil {
+		return Request{}, err
+	}
+	rule, ok := m.policy.Rule(action)
+	if !ok {
+		return Request{}, fmt.Errorf("%w: %s", ErrUnknownAction, action)
+	}
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return Request{}, fmt.Errorf("marshal approval payload: %w", err)
+	}
+
+	now := m.now().UTC()
+	r := Request{
+		ID:        uuid.New(),
+		TenantID:  claims.TenantID,
+		Action:    action,
+		Subject:   subject,
+		Payload:   raw,
+		Reason:    reason,
+		MakerID:   claims.UserID,
+		Status:    StatusPending,
+		CreatedAt: now,
+		ExpiresAt: now.Add(rule.TTL),
+	}
+	if err := m.store.Create(ctx, r); err != nil {
+		return Request{}, fmt.Errorf("create approval request: %w", err)
+	}
+	return r, nil
+}
+
+// Get returns a request in the caller's tenant.
+func (m *Manager) Get(ctx context.Context, id uuid.UUID) (Request, error) {
+	claims, err := callerOf(ctx)
+	if err != nil {
+		return Request{}, err
+	}
+	return m.store.Get(ctx, claims.TenantID, id)
+}
+
+// List returns the requests in the caller's tenant matching filter.
+func (m *Manager) List(ctx context.Context, filter Filter) ([]Request, error) {
+	claims, err := callerOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return m.store.List(ctx, claims.TenantID, filter)
+}
+
+// Queue returns the pending requests the caller can decide: those for actions
+// they hold an approver role for, other than their own.
+func (m *Manager) Queue(ctx context.Context) ([]Request, error) {
+	claims, err := callerOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+	actions := m.policy.ApprovableActions(claims)
+	if len(actions) == 0 {
+		return nil, nil
+	}
+	pending, err := m.store.List(ctx, claims.TenantID, Filter{Actions: actions, Status: StatusPending})
+	if err != nil {
+		return nil, err
+	}
+	now := m.now()
+	queue := pending[:0]
+	for _, r := range pending {
+		if r.MakerID != claims.UserID && now.Before(r.ExpiresAt) {
+			queue = append(queue, r)
+		}
+	}
+	return queue, nil
+}
+
+// Approve approves a pending request as the caller, who must hold an approver
+// role for its action and must not be its maker. The service performs the
+// action after Approve returns.
+func (m *Manager) Approve(ctx context.Context, id uuid.UUID, comment string) (Request, error) {
+	return m.decide(ctx, id, StatusApproved, comment)
+}
+
+// Reject rejects a pending request as the caller, under the same conditions
+// as Approve.
+func (m *Manager) Reject(ctx context.Context, id uuid.UUID, comment string) (Request, error) {
+	return m.decide(ctx, id, StatusRejected, comment)
+}
+
+// Cancel withdraws a pending request. Only its maker can cancel it.
+func (m *Manager) Cancel(ctx context.Context, id uuid.UUID) (Request, error) {
+	claims, err := callerOf(ctx)
+	if err != nil {
+		return Request{}, err
+	}
+	r, err := m.store.Get(ctx, claims.TenantID, id)
+	if err != nil {
+		return Request{}, err
+	}
+	if r.MakerID != claims.UserID {
+		return Request{}, ErrNotMaker
+	}
+	return m.finish(ctx, r, StatusCancelled, claims.UserID, "")
+}
+
+func (m *Manager) decide(ctx context.Context, id uuid.UUID, status Status, comment string) (Request, error) {
+	claims, err := callerOf(ctx)
+	if err != nil {
+		return Request{}, err
+	}
+	r, err := m.store.Get(ctx, claims.TenantID, id)
+	if err != nil {
+		return Request{}, err
+	}
+	if r.MakerID == claims.UserID {
+		return Request{}, ErrSelfApproval
+	}
+	if !m.policy.CanApprove(r.Action, claims) {
+		return Request{}, ErrNotApprover
+	}
+	return m.finish(ctx, r, status, claims.UserID, comment)
+}
+
+// finish moves a pending request to status, expiring it instead when it is
+// past its expiry.
+func (m *Manager) finish(ctx context.Context, r Request, status Status, checkerID uuid.UUID, comment string) (Request, error) {
+	if r.Status != StatusPending {
+		return Request{}, fmt.Errorf("%w: %s", ErrNotPending, r.Status)
+	}
+	now := m.now().UTC()
+	if !now.Before(r.ExpiresAt) {
+		r.Status, r.DecidedAt = StatusExpired, &now
+		if err := m.store.Decide(ctx, r); err != nil {
+			return Request{}, fmt.Errorf("expire approval request: %w", err)
+		}
+		return Request{}, ErrExpired
+	}
+
+	r.Status = status
+	r.CheckerID = checkerID
+	r.Comment = comment
+	r.DecidedAt = &now
+	if err := m.store.Decide(ctx, r); err != nil {
+		return Request{}, fmt.Errorf("decide approval request: %w", err)
+	}
+	return r, nil
+}
+
+// ExpirePending marks every pending request past its expiry as EXPIRED. Run it
+// periodically so lists of pending requests stay current; Approve and Reject
+// refuse expired requests either way.
+func (m *Manager) ExpirePending(ctx context.Context) (int64, error) {
+	return m.store.ExpirePending(ctx, m.now().UTC())
+}
+
+func callerOf(ctx context.Context) (*auth.Claims, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	return claims, nil
+}
@@ -0,0 +1,199 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+const adjustment = "ledger.adjustment"
+
+type fixture struct {
+	manager *Manager
+	now     time.Time
+	tenant  uuid.UUID
+}
+
+func newFixture() *fixture {
+	f := &fixture{
+		tenant: uuid.New(),
+		now:    time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC),
+	}
+	f.manager = NewManager(NewMemoryStore(), Policy{
+		adjustment:     {ApproverRoles: []string{auth.RoleAdmin}, TTL: 24 * time.Hour},
+		"limits.raise": {ApproverRoles: []string{auth.RoleOperator, auth.RoleCompliance}},
+	})
+	f.manager.now = func() time.Time { return f.now }
+	return f
+}
+
+// as returns a context authenticated as a new user with roles.
+func (f *fixture) as(roles ...string) context.Context {
+	return auth.ContextWithClaims(context.Background(), &auth.Claims{
+		UserID: uuid.New(), TenantID: f.tenant, Roles: roles,
+	})
+}
+
+func (f *fixture) submit(t *testing.T, ctx context.Context) Request {
+	t.Helper()
+	r, err := f.manager.Submit(ctx, adjustment, "2000-001", "correct duplicate fee", map[string]string{"amount": "12.50"})
+	if err != nil {
+		t.Fatalf("Submit: %v", err)
+	}
+	return r
+}
+
+func TestSubmitAndApprove(t *testing.T) {
+	f := newFixture()
+	maker := f.as(auth.RoleAdmin)
+	r := f.submit(t, maker)
+	if r.Status != StatusPending || !r.ExpiresAt.Equal(f.now.Add(24*time.Hour)) {
+		t.Fatalf("submitted request = %+v", r)
+	}
+	if string(r.Payload) != `{"amount":"12.50"}` {
+		t.Errorf("payload = %s", r.Payload)
+	}
+
+	if _, err := f.manager.Approve(maker, r.ID, ""); !errors.Is(err, ErrSelfApproval) {
+		t.Errorf("maker approving = %v, want ErrSelfApproval", err)
+	}
+	if _, err := f.manager.Approve(f.as(auth.RoleOperator), r.ID, ""); !errors.Is(err, ErrNotApprover) {
+		t.Errorf("operator approving = %v, want ErrNotApprover", err)
+	}
+
+	checker := f.as(auth.RoleAdmin)
+	approved, err := f.manager.Approve(checker, r.ID, "verified against statement")
+	if err != nil {
+		t.Fatalf("Approve: %v", err)
+	}
+	claims, _ := auth.ClaimsFromContext(checker)
+	if approved.Status != StatusApproved || approved.CheckerID != claims.UserID || approved.DecidedAt == nil {
+		t.Errorf("approved request = %+v", approved)
+	}
+
+	if _, err := f.manager.Reject(f.as(auth.RoleAdmin), r.ID, ""); !errors.Is(err, ErrNotPending) {
+		t.Errorf("deciding twice = %v, want ErrNotPending", err)
+	}
+}
+
+func TestSubmitUnknownAction(t *testing.T) {
+	f := newFixture()
+	if _, err := f.manager.Submit(f.as(), "card.freeze", "", "", nil); !errors.Is(err, ErrUnknownAction) {
+		t.Errorf("Submit = %v, want ErrUnknownAction", err)
+	}
+	if _, err := f.manager.Submit(context.Background(), adjustment, "", "", nil); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("Submit = %v, want ErrUnauthenticated", err)
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	f := newFixture()
+	r := f.submit(t, f.as())
+	other := f.submit(t, f.as())
+
+	f.now = f.now.Add(24 * time.Hour)
+	if _, err := f.manager.Approve(f.as(auth.RoleAdmin), r.ID, ""); !errors.Is(err, ErrExpired) {
+		t.Fatalf("approving expired = %v, want ErrExpired", err)
+	}
+	if got, _ := f.manager.Get(f.as(), r.ID); got.Status != StatusExpired {
+		t.Errorf("status after expiry = %s, want EXPIRED", got.Status)
+	}
+
+	n, err := f.manager.ExpirePending(context.Background())
+	if err != nil || n != 1 {
+		t.Fatalf("ExpirePending = %d, %v; want 1", n, err)
+	}
+	if got, _ := f.manager.Get(f.as(), other.ID); got.Status != StatusExpired {
+		t.Errorf("status after sweep = %s, want EXPIRED", got.Status)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	f := newFixture()
+	maker := f.as()
+	r := f.submit(t, maker)
+
+	if _, err := f.manager.Cancel(f.as(auth.RoleAdmin), r.ID); !errors.Is(err, ErrNotMaker) {
+		t.Errorf("checker cancelling = %v, want ErrNotMaker", err)
+	}
+	cancelled, err := f.manager.Cancel(maker, r.ID)
+	if err != nil || cancelled.Status != StatusCancelled {
+		t.Fatalf("Cancel = %+v, %v", cancelled, err)
+	}
+}
+
+func TestTenantIsolation(t *testing.T) {
+	f := newFixture()
+	r := f.submit(t, f.as())
+
+	other := auth.ContextWithClaims(context.Background(), &auth.Claims{
+		UserID: uuid.New(), TenantID: uuid.New(), Roles: []string{auth.RoleAdmin},
+	})
+	if _, err := f.manager.Approve(other, r.ID, ""); !errors.Is(err, ErrNotFound) {
+		t.Errorf("approving another tenant's request = %v, want ErrNotFound", err)
+	}
+}
+
+func TestQueue(t *testing.T) {
+	f := newFixture()
+	checker := f.as(auth.RoleAdmin)
+	own := f.submit(t, checker)
+	theirs := f.submit(t, f.as())
+	if _, err := f.manager.Submit(f.as(), "limits.raise", "acct-1", "", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	queue, err := f.manager.Queue(checker)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queue) != 1 || queue[0].ID != theirs.ID {
+		t.Errorf("admin queue = %v, want only %s (not own %s)", queue, theirs.ID, own.ID)
+	}
+
+	queue, err = f.manager.Queue(f.as(auth.RoleCompliance))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queue) != 1 || queue[0].Action != "limits.raise" {
+		t.Errorf("compliance queue = %v, want the limit raise", queue)
+	}
+}
+
+func TestServer(t *testing.T) {
+	f := newFixture()
+	srv := NewServer(f.manager)
+	r := f.submit(t, f.as())
+
+	msg, err := srv.ApproveRequest(f.as(auth.RoleAdmin), &DecideRequest{ID: r.ID.String(), Comment: "ok"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Status != string(StatusApproved) || msg.Comment != "ok" || msg.DecidedAt == "" {
+		t.Errorf("response = %+v", msg)
+	}
+
+	for _, tc := range []struct {
+		id   string
+		ctx  context.Context
+		code codes.Code
+	}{
+		{"not-a-uuid", f.as(auth.RoleAdmin), codes.InvalidArgument},
+		{uuid.NewString(), f.as(auth.RoleAdmin), codes.NotFound},
+		{r.ID.String(), f.as(auth.RoleAdmin), codes.FailedPrecondition},
+		{r.ID.String(), f.as(auth.RoleAuditor), codes.PermissionDenied},
+		{r.ID.String(), context.Background(), codes.Unauthenticated},
+	} {
+		_, err := srv.RejectRequest(tc.ctx, &DecideRequest{ID: tc.id})
+		if got := status.Code(err); got != tc.code {
+			t.Errorf("RejectRequest(%s) code = %s, want %s", tc.id, got, tc.code)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package approval
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MemoryStore is an in-process Store for tests and single-instance tools.
+// Requests held in memory do not survive a restart.
+type MemoryStore struct {
+	requests map[uuid.UUID]Request
+	mu       sync.Mutex
+}
+
+// NewMemoryStore creates a new, empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{requests: make(map[uuid.UUID]Request)}
+}
+
+// Create implements Store.
+func (s *MemoryStore) Create(_ context.Context, r Request) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests[r.ID] = r
+	return nil
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(_ context.Context, tenantID, id uuid.UUID) (Request, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.requests[id]
+	if !ok || r.TenantID != tenantID {
+		return Request{}, ErrNotFound
+	}
+	return r, nil
+}
+
+// Decide implements Store.
+func (s *MemoryStore) Decide(_ context.Context, r Request) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.requests[r.ID]
+	if !ok || stored.TenantID != r.TenantID {
+		return ErrNotFound
+	}
+	if stored.Status != StatusPending {
+		return ErrNotPending
+	}
+	s.requests[r.ID] = r
+	return nil
+}
+
+// List implements Store.
+func (s *MemoryStore) List(_ context.Context, tenantID uuid.UUID, filter Filter) ([]Request, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var out []Request
+	for _, r := range s.requests {
+		if r.TenantID != tenantID ||
+			(len(filter.Actions) > 0 && !slices.Contains(filter.Actions, r.Action)) ||
+			(filter.Status != "" && r.Status != filter.Status) {
+			continue
+		}
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.After(out[j].CreatedAt) })
+	if filter.Limit > 0 && len(out) > filter.Limit {
+		out = out[:filter.Limit]
+	}
+	return out, nil
+}
+
+// ExpirePending implements Store.
+func (s *MemoryStore) ExpirePending(_ context.Context, now time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var n int64
+	for id, r := range s.requests {
+		if r.Status == StatusPending && !now.Before(r.ExpiresAt) {
+			decided := now
+			r.Status, r.DecidedAt = StatusExpired, &decided
+			s.requests[id] = r
+			n++
+		}
+	}
+	return n, nil
+}
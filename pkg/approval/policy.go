@@ -0,0 +1,61 @@
+package approval
+
+import (
+	"sort"
+	"time"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// DefaultTTL is how long a request stays pending when its rule sets no TTL.
+const DefaultTTL = 72 * time.Hour
+
+// Rule configures dual control for one action.
+type Rule struct {
+	// ApproverRoles lists the roles allowed to check the action; a checker
+	// needs any one of them.
+	ApproverRoles []string
+	// TTL is how long a request stays pending before it expires. Defaults to
+	// DefaultTTL.
+	TTL time.Duration
+}
+
+// Policy maps each action under dual control to its rule.
+type Policy map[string]Rule
+
+// Rule returns the rule for action and whether the action is under dual
+// control.
+func (p Policy) Rule(action string) (Rule, bool) {
+	rule, ok := p[action]
+	if ok && rule.TTL <= 0 {
+		rule.TTL = DefaultTTL
+	}
+	return rule, ok
+}
+
+// CanApprove reports whether claims hold an approver role for action.
+func (p Policy) CanApprove(action string, claims *auth.Claims) bool {
+	rule, ok := p[action]
+	if !ok || claims == nil {
+		return false
+	}
+	for _, role := range rule.ApproverRoles {
+		if claims.HasRole(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// ApprovableActions returns the actions claims can approve, for listing a
+// checker's queue, sorted.
+func (p Policy) ApprovableActions(claims *auth.Claims) []string {
+	var actions []string
+	for action := range p {
+		if p.CanApprove(action, claims) {
+			actions = append(actions, action)
+		}
+	}
+	sort.Strings(actions)
+	return actions
+}
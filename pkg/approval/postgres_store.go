@@ -0,0 +1,166 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresStore is a Store backed by a service's approval_requests table:
+//
+//	CREATE TABLE approval_requests (
+//	    id          UUID PRIMARY KEY,
+//	    tenant_id   UUID NOT NULL,
+//	    action      VARCHAR(100) NOT NULL,
+//	    subject     VARCHAR(255) NOT NULL DEFAULT '',
+//	    payload     JSONB NOT NULL,
+//	    reason      TEXT NOT NULL DEFAULT '',
+//	    maker_id    UUID NOT NULL,
+//	    checker_id  UUID,
+//	    comment     TEXT NOT NULL DEFAULT '',
+//	    status      VARCHAR(20) NOT NULL,
+//	    created_at  TIMESTAMPTZ NOT NULL,
+//	    expires_at  TIMESTAMPTZ NOT NULL,
+//	    decided_at  TIMESTAMPTZ
+//	);
+//	CREATE INDEX idx_approval_requests_pending
+//	    ON approval_requests (tenant_id, action, created_at DESC) WHERE status = 'PENDING';
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore creates a new PostgresStore.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+const selectColumns = `
+	SELECT id, tenant_id, action, subject, payload, reason, maker_id, checker_id,
+		comment, status, created_at, expires_at, decided_at
+	FROM approval_requests
+`
+
+// Create implements Store.
+func (s *PostgresStore) Create(ctx context.Context, r Request) error {
+	const insertSQL = `
+		INSERT INTO approval_requests (id, tenant_id, action, subject, payload, reason,
+			maker_id, status, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := s.pool.Exec(ctx, insertSQL, r.ID, r.TenantID, r.Action, r.Subject, r.Payload,
+		r.Reason, r.MakerID, string(r.Status), r.CreatedAt, r.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("insert approval request: %w", err)
+	}
+	return nil
+}
+
+// Get implements Store.
+func (s *PostgresStore) Get(ctx context.Context, tenantID, id uuid.UUID) (Request, error) {
+	r, err := scanRequest(s.pool.QueryRow(ctx, selectColumns+`WHERE tenant_id = $1 AND id = $2`, tenantID, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Request{}, ErrNotFound
+	}
+	if err != nil {
+		return Request{}, fmt.Errorf("get approval request: %w", err)
+	}
+	return r, nil
+}
+
+// Decide implements Store.
+func (s *PostgresStore) Decide(ctx context.Context, r Request) error {
+	const updateSQL = `
+		UPDATE approval_requests
+		SET status = $3, checker_id = $4, comment = $5, decided_at = $6
+		WHERE tenant_id = $1 AND id = $2 AND status = 'PENDING'
+	`
+
+	var checkerID *uuid.UUID
+	if r.CheckerID != uuid.Nil {
+		checkerID = &r.CheckerID
+	}
+	tag, err := s.pool.Exec(ctx, updateSQL, r.TenantID, r.ID, string(r.Status), checkerID, r.Comment, r.DecidedAt)
+	if err != nil {
+		return fmt.Errorf("update approval request: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		if _, err := s.Get(ctx, r.TenantID, r.ID); err != nil {
+			return err
+		}
+		return ErrNotPending
+	}
+	return nil
+}
+
+// List implements Store.
+func (s *PostgresStore) List(ctx context.Context, tenantID uuid.UUID, filter Filter) ([]Request, error) {
+	query := selectColumns + `
+		WHERE tenant_id = $1
+			AND (cardinality($2::text[]) = 0 OR action = ANY($2))
+			AND ($3::text = '' OR status = $3)
+		ORDER BY created_at DESC
+	`
+	args := []any{tenantID, filter.Actions, string(filter.Status)}
+	if filter.Actions == nil {
+		args[1] = []string{}
+	}
+	if filter.Limit > 0 {
+		query += ` LIMIT $4`
+		args = append(args, filter.Limit)
+	}
+
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list approval requests: %w", err)
+	}
+	defer rows.Close()
+
+	var out []Request
+	for rows.Next() {
+		r, err := scanRequest(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan approval request: %w", err)
+		}
+		out = append(out, r)
+	}
+	return out, rows.Err()
+}
+
+// ExpirePending implements Store.
+func (s *PostgresStore) ExpirePending(ctx context.Context, now time.Time) (int64, error) {
+	const updateSQL = `
+		UPDATE approval_requests
+		SET status = 'EXPIRED', decided_at = $1
+		WHERE status = 'PENDING' AND expires_at <= $1
+	`
+
+	tag, err := s.pool.Exec(ctx, updateSQL, now)
+	if err != nil {
+		return 0, fmt.Errorf("expire approval requests: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+func scanRequest(row pgx.Row) (Request, error) {
+	var (
+		r         Request
+		checkerID *uuid.UUID
+		status    string
+	)
+	err := row.Scan(&r.ID, &r.TenantID, &r.Action, &r.Subject, &r.Payload, &r.Reason, &r.MakerID,
+		&checkerID, &r.Comment, &status, &r.CreatedAt, &r.ExpiresAt, &r.DecidedAt)
+	if err != nil {
+		return Request{}, err
+	}
+	if checkerID != nil {
+		r.CheckerID = *checkerID
+	}
+	r.Status = Status(status)
+	return r, nil
+}
@@ -0,0 +1,108 @@
+// Package archive moves historical data out of PostgreSQL into compressed
+// objects in cold storage, and brings it back on demand.
+//
+// Data passes through three tiers. Operational tables hold recent rows.
+// Once a month of rows passes its table's retention it moves to the archive
+// schema: the postgres.PartitionManager detaches partitioned tables' months
+// there, and an Archiver sweeps rows of unpartitioned tables, such as closed
+// deposit positions, into monthly tables there. Months stay in the archive
+// schema, still queryable in SQL, until they are old enough for cold
+// storage, when the Archiver exports each as a gzipped CSV object with a
+// JSON manifest and drops it.
+//
+// Restore loads an archived month back into the archive schema for SQL
+// queries, and Open reads it straight from its object without touching the
+// database.
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErrNotFound is returned by a Store for a key with no object.
+var ErrNotFound = errors.New("archive: object not found")
+
+// Store is the object storage archived months are kept in. Services keep
+// their other objects, such as rendered documents and exports, in it too.
+type Store interface {
+	// Put writes body under key, replacing any object already there.
+	Put(ctx context.Context, key string, body io.ReadSeeker, contentType string) error
+	// Get opens the object under key. It returns ErrNotFound when there is
+	// none.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the object under key. Deleting a missing object is not
+	// an error.
+	Delete(ctx context.Context, key string) error
+	// URI returns the URI of the object under key, for handing to readers
+	// outside the platform.
+	URI(key string) string
+}
+
+// StoreConfig configures a Store: the S3 bucket S3Bucket when it is set,
+// and the directory Dir otherwise.
+type StoreConfig struct {
+	Dir         string
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
+}
+
+// NewStore creates the Store cfg configures. It returns nil when neither a
+// bucket nor a directory is set, in which case nothing should be archived:
+// a month is dropped from the database once it is in the store.
+func NewStore(cfg StoreConfig) Store {
+	switch {
+	case cfg.S3Bucket != "":
+		return NewS3Store(S3Config{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+		})
+	case cfg.Dir != "":
+		return NewFileStore(cfg.Dir)
+	default:
+		return nil
+	}
+}
+
+// Manifest describes an archived month. It is written after the month's
+// data, so an object without a manifest is an incomplete export.
+type Manifest struct {
+	ArchivedAt time.Time `json:"archived_at"`
+	// Table is the operational table the rows came from.
+	Table string `json:"table"`
+	// Name is the month's table in the archive schema, such as
+	// journal_entries_p202103.
+	Name string `json:"name"`
+	// Month is the month archived, as YYYY-MM.
+	Month string `json:"month"`
+	// Columns are the CSV's columns, in order.
+	Columns []string `json:"columns"`
+	Rows    int64    `json:"rows"`
+	// SHA256 is the hex digest of the compressed object.
+	SHA256 string `json:"sha256"`
+}
+
+// dataKey is the key of a month's gzipped CSV under prefix.
+func dataKey(prefix, table, name string) string {
+	return fmt.Sprintf("%s/%s/%s.csv.gz", prefix, table, name)
+}
+
+// manifestKey is the key of a month's manifest under prefix.
+func manifestKey(prefix, table, name string) string {
+	return fmt.Sprintf("%s/%s/%s.manifest.json", prefix, table, name)
+}
+
+// monthStart returns the first instant of t's month in UTC.
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
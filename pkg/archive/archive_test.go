@@ -0,0 +1,234 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFileStore(t *testing.T) {
+	store := NewFileStore(t.TempDir())
+	ctx := context.Background()
+
+	if err := store.Put(ctx, "ledger/journal_entries/x.csv.gz", strings.NewReader("cold"), "application/gzip"); err != nil {
+		t.Fatal(err)
+	}
+	obj, err := store.Get(ctx, "ledger/journal_entries/x.csv.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	if data, _ := io.ReadAll(obj); string(data) != "cold" {
+		t.Fatalf("expected the stored object, got %q", data)
+	}
+
+	if _, err := store.Get(ctx, "ledger/missing"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if err := store.Put(ctx, "../escape", strings.NewReader("x"), ""); err == nil {
+		t.Fatal("expected a key outside the root to be refused")
+	}
+	if uri := store.URI("ledger/journal_entries/x.csv.gz"); !strings.HasPrefix(uri, "file:///") || !strings.HasSuffix(uri, "/ledger/journal_entries/x.csv.gz") {
+		t.Fatalf("unexpected URI %q", uri)
+	}
+
+	for range 2 {
+		if err := store.Delete(ctx, "ledger/journal_entries/x.csv.gz"); err != nil {
+			t.Fatalf("expected deleting, even twice, to succeed: %v", err)
+		}
+	}
+	if _, err := store.Get(ctx, "ledger/journal_entries/x.csv.gz"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected the deleted object to be gone, got %v", err)
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	var mu sync.Mutex
+	objects := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			sum := sha256.Sum256(body)
+			if r.Header.Get("x-amz-content-sha256") != hex.EncodeToString(sum[:]) || r.ContentLength != int64(len(body)) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			objects[r.URL.Path] = body
+		case http.MethodGet:
+			body, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(body)
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	store := NewS3Store(S3Config{Endpoint: server.URL, Region: "us-east-1", Bucket: "archive", AccessKey: "access", SecretKey: "secret"})
+	ctx := context.Background()
+	if err := store.Put(ctx, "payments/payment_orders/p.csv.gz", strings.NewReader("cold"), "application/gzip"); err != nil {
+		t.Fatal(err)
+	}
+	obj, err := store.Get(ctx, "payments/payment_orders/p.csv.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Close()
+	if data, _ := io.ReadAll(obj); string(data) != "cold" {
+		t.Fatalf("expected the stored object, got %q", data)
+	}
+	if _, err := store.Get(ctx, "payments/missing"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if uri := store.URI("payments/payment_orders/p.csv.gz"); uri != "s3://archive/payments/payment_orders/p.csv.gz" {
+		t.Fatalf("unexpected URI %q", uri)
+	}
+
+	if err := store.Delete(ctx, "payments/payment_orders/p.csv.gz"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Get(ctx, "payments/payment_orders/p.csv.gz"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected the deleted object to be gone, got %v", err)
+	}
+}
+
+func TestS3Store_EncodesKeys(t *testing.T) {
+	var gotPath, gotSSE string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.EscapedPath()
+		gotSSE = r.Header.Get("x-amz-server-side-encryption")
+	}))
+	defer server.Close()
+
+	store := NewS3Store(S3Config{Endpoint: server.URL + "/", Region: "eu-west-1", Bucket: "warehouse", AccessKey: "access", SecretKey: "secret"})
+	if err := store.Put(context.Background(), "curated/payments/v1/dt=2025-03-14/part-00000.parquet", strings.NewReader("PAR1"), "application/vnd.apache.parquet"); err != nil {
+		t.Fatal(err)
+	}
+	// The "=" of Hive-style partition segments is encoded too.
+	if gotPath != "/warehouse/curated/payments/v1/dt%3D2025-03-14/part-00000.parquet" {
+		t.Fatalf("unexpected object path %q", gotPath)
+	}
+	if gotSSE != "AES256" {
+		t.Fatalf("expected server-side encryption, got %q", gotSSE)
+	}
+}
+
+func TestNewStore(t *testing.T) {
+	if NewStore(StoreConfig{}) != nil {
+		t.Fatal("expected no store without a bucket or directory")
+	}
+	if _, ok := NewStore(StoreConfig{Dir: t.TempDir()}).(*FileStore); !ok {
+		t.Fatal("expected a FileStore for a directory")
+	}
+	if _, ok := NewStore(StoreConfig{Dir: t.TempDir(), S3Bucket: "archive"}).(*S3Store); !ok {
+		t.Fatal("expected the bucket to take precedence")
+	}
+}
+
+// putMonth stores csv as an archived month of journal_entries, returning
+// its manifest.
+func putMonth(t *testing.T, store Store, csv string) Manifest {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, _ = gz.Write([]byte(csv))
+	_ = gz.Close()
+	sum := sha256.Sum256(buf.Bytes())
+
+	m := Manifest{
+		Table:   "journal_entries",
+		Name:    "journal_entries_p202103",
+		Month:   "2021-03",
+		Columns: []string{"id", "status"},
+		Rows:    int64(strings.Count(csv, "\n") - 1),
+		SHA256:  hex.EncodeToString(sum[:]),
+	}
+	ctx := context.Background()
+	if err := store.Put(ctx, dataKey("ledger", m.Table, m.Name), bytes.NewReader(buf.Bytes()), "application/gzip"); err != nil {
+		t.Fatal(err)
+	}
+	body, _ := json.Marshal(m)
+	if err := store.Put(ctx, manifestKey("ledger", m.Table, m.Name), bytes.NewReader(body), "application/json"); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestArchiver_Open(t *testing.T) {
+	store := NewFileStore(t.TempDir())
+	const csv = "id,status\n1,POSTED\n2,REVERSED\n"
+	want := putMonth(t, store, csv)
+	a := NewArchiver(nil, store, Config{Prefix: "ledger"}, nil)
+
+	r, m, err := a.Open(context.Background(), "journal_entries", time.Date(2021, 3, 17, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if !reflect.DeepEqual(m, want) {
+		t.Fatalf("expected manifest %+v, got %+v", want, m)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil || string(data) != csv {
+		t.Fatalf("expected the archived rows, got %q (%v)", data, err)
+	}
+
+	if _, _, err := a.Open(context.Background(), "journal_entries", time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for a month not archived, got %v", err)
+	}
+}
+
+func TestArchiver_OpenDetectsCorruption(t *testing.T) {
+	store := NewFileStore(t.TempDir())
+	m := putMonth(t, store, "id,status\n1,POSTED\n")
+	m.SHA256 = strings.Repeat("0", 64)
+	body, _ := json.Marshal(m)
+	if err := store.Put(context.Background(), manifestKey("ledger", m.Table, m.Name), bytes.NewReader(body), ""); err != nil {
+		t.Fatal(err)
+	}
+
+	a := NewArchiver(nil, store, Config{Prefix: "ledger"}, nil)
+	r, _, err := a.Open(context.Background(), "journal_entries", time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if _, err := io.ReadAll(r); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Fatalf("expected a checksum mismatch, got %v", err)
+	}
+}
+
+func TestPolicy_SweepStatements(t *testing.T) {
+	p := Policy{Table: "deposit_positions", Sweep: "status = 'CLOSED'", SweepBy: "updated_at", SweepAfter: 24, ColdAfter: 84}
+	got := p.sweepStatements(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	want := []string{
+		`CREATE TABLE IF NOT EXISTS "archive"."deposit_positions_p202402" (LIKE "deposit_positions")`,
+		`WITH moved AS (DELETE FROM "deposit_positions" WHERE (status = 'CLOSED') AND "updated_at" >= $1 AND "updated_at" < $2 RETURNING *) INSERT INTO "archive"."deposit_positions_p202402" SELECT * FROM moved`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected statements:\n%q\nwant\n%q", got, want)
+	}
+}
@@ -0,0 +1,467 @@
+package archive
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/pkg/postgres"
+)
+
+// restoredComment starts the comment on a month restored into the archive
+// schema, followed by the time it was restored.
+const restoredComment = "bib:restored "
+
+// Policy declares when a table's months move to cold storage.
+type Policy struct {
+	// Table is the operational table.
+	Table string
+	// ColdAfter is how many months, counting the current one, a month stays
+	// in the database. Older months in the archive schema are exported to
+	// the store and dropped.
+	ColdAfter int
+	// Sweep, for an unpartitioned Table, is the SQL condition selecting the
+	// rows that may leave it, such as "status = 'CLOSED'". Those dated more
+	// than SweepAfter months ago by SweepBy move to monthly tables in the
+	// archive schema. Partitioned tables leave Sweep empty, since the
+	// postgres.PartitionManager moves their months.
+	Sweep string
+	// SweepBy is the timestamp column that dates a swept row.
+	SweepBy string
+	// SweepAfter is how many months, counting the current one, rows stay in
+	// Table. It must be less than ColdAfter, so that a month is never swept
+	// into once it is in cold storage.
+	SweepAfter int
+}
+
+// Config configures an Archiver.
+type Config struct {
+	// Prefix starts every object key, such as the service's name.
+	Prefix   string
+	Policies []Policy
+	// RestoreTTL is how long a restored month stays in the archive schema
+	// before it is dropped again. Defaults to 7 days.
+	RestoreTTL time.Duration
+}
+
+// Archiver moves months of the configured tables from the archive schema to
+// cold storage, and restores them on demand.
+type Archiver struct {
+	pool   *pgxpool.Pool
+	store  Store
+	logger *slog.Logger
+	now    func() time.Time
+	cfg    Config
+}
+
+// NewArchiver creates an Archiver keeping months in store.
+func NewArchiver(pool *pgxpool.Pool, store Store, cfg Config, logger *slog.Logger) *Archiver {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if cfg.RestoreTTL <= 0 {
+		cfg.RestoreTTL = 7 * 24 * time.Hour
+	}
+	return &Archiver{pool: pool, store: store, logger: logger, now: time.Now, cfg: cfg}
+}
+
+// Run archives immediately and then every interval until ctx is cancelled.
+// Failures are logged and retried on the next run.
+func (a *Archiver) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := a.Archive(ctx); err != nil && ctx.Err() == nil {
+			a.logger.Error("archival failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Archive runs every policy once: it sweeps rows into the archive schema,
+// exports the months there that are due for cold storage and drops
+// restored months whose time is up.
+func (a *Archiver) Archive(ctx context.Context) error {
+	month := monthStart(a.now())
+	var errs []error
+	for _, p := range a.cfg.Policies {
+		if err := a.archive(ctx, p, month); err != nil {
+			errs = append(errs, fmt.Errorf("archive: %s: %w", p.Table, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (a *Archiver) archive(ctx context.Context, p Policy, month time.Time) error {
+	if p.Sweep != "" {
+		if err := postgres.WithTransaction(ctx, a.pool, func(tx pgx.Tx) error {
+			return a.sweep(ctx, tx, p, month)
+		}); err != nil {
+			return fmt.Errorf("sweep: %w", err)
+		}
+	}
+
+	names, err := a.archivedMonths(ctx, p.Table)
+	if err != nil {
+		return err
+	}
+	cutoff := month.AddDate(0, 1-p.ColdAfter, 0)
+	var errs []error
+	for _, name := range names {
+		if m, ok := postgres.PartitionMonth(p.Table, name); !ok || !m.Before(cutoff) {
+			continue
+		}
+		if err := postgres.WithTransaction(ctx, a.pool, func(tx pgx.Tx) error {
+			return a.freeze(ctx, tx, p.Table, name)
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sweep moves p's matching rows from before the sweep cutoff into their
+// months' tables in the archive schema.
+func (a *Archiver) sweep(ctx context.Context, tx pgx.Tx, p Policy, month time.Time) error {
+	if locked, err := lock(ctx, tx, p.Table); err != nil || !locked {
+		return err
+	}
+
+	by := pgx.Identifier{p.SweepBy}.Sanitize()
+	rows, err := tx.Query(ctx, fmt.Sprintf(
+		`SELECT DISTINCT date_trunc('month', %s AT TIME ZONE 'UTC') FROM %s WHERE (%s) AND %s < $1`,
+		by, pgx.Identifier{p.Table}.Sanitize(), p.Sweep, by), month.AddDate(0, 1-p.SweepAfter, 0))
+	if err != nil {
+		return err
+	}
+	months, err := pgx.CollectRows(rows, pgx.RowTo[time.Time])
+	if err != nil {
+		return err
+	}
+
+	if len(months) > 0 {
+		if _, err := tx.Exec(ctx, "CREATE SCHEMA IF NOT EXISTS "+pgx.Identifier{postgres.ArchiveSchema}.Sanitize()); err != nil {
+			return err
+		}
+	}
+	for _, m := range months {
+		m = time.Date(m.Year(), m.Month(), 1, 0, 0, 0, 0, time.UTC)
+		for _, stmt := range p.sweepStatements(m) {
+			tag, err := tx.Exec(ctx, stmt, m, m.AddDate(0, 1, 0))
+			if err != nil {
+				return err
+			}
+			if tag.Insert() {
+				a.logger.Info("rows archived", "table", p.Table, "month", m.Format("2006-01"), "rows", tag.RowsAffected())
+			}
+		}
+	}
+	return nil
+}
+
+// sweepStatements returns the statements moving p's matching rows dated in
+// month, given as $1 to $2, into the month's table in the archive schema.
+func (p Policy) sweepStatements(month time.Time) []string {
+	table := pgx.Identifier{p.Table}.Sanitize()
+	archived := pgx.Identifier{postgres.ArchiveSchema, postgres.PartitionName(p.Table, month)}.Sanitize()
+	by := pgx.Identifier{p.SweepBy}.Sanitize()
+	return []string{
+		fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (LIKE %s)", archived, table),
+		fmt.Sprintf("WITH moved AS (DELETE FROM %s WHERE (%s) AND %s >= $1 AND %s < $2 RETURNING *) INSERT INTO %s SELECT * FROM moved",
+			table, p.Sweep, by, by, archived),
+	}
+}
+
+// archivedMonths lists the tables in the archive schema, oldest name first.
+func (a *Archiver) archivedMonths(ctx context.Context, table string) ([]string, error) {
+	rows, err := a.pool.Query(ctx, `
+		SELECT c.relname
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relkind IN ('r', 'p') AND NOT c.relispartition
+		  AND c.relname LIKE $2
+		ORDER BY c.relname`, postgres.ArchiveSchema, strings.ReplaceAll(table, "_", `\_`)+`\_p%`)
+	if err != nil {
+		return nil, fmt.Errorf("list archived months: %w", err)
+	}
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("list archived months: %w", err)
+	}
+	return names, nil
+}
+
+// freeze exports an archived month to the store and drops it, or drops a
+// restored month once it has been kept for the restore TTL.
+func (a *Archiver) freeze(ctx context.Context, tx pgx.Tx, table, name string) error {
+	if locked, err := lock(ctx, tx, table); err != nil || !locked {
+		return err
+	}
+	qualified := pgx.Identifier{postgres.ArchiveSchema, name}.Sanitize()
+
+	var exists bool
+	var comment *string
+	if err := tx.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL, obj_description(to_regclass($1), 'pg_class')`, qualified).
+		Scan(&exists, &comment); err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	if comment != nil && strings.HasPrefix(*comment, restoredComment) {
+		restoredAt, err := time.Parse(time.RFC3339, strings.TrimPrefix(*comment, restoredComment))
+		if err == nil && a.now().Sub(restoredAt) < a.cfg.RestoreTTL {
+			return nil
+		}
+		if _, err := tx.Exec(ctx, "DROP TABLE "+qualified); err != nil {
+			return err
+		}
+		a.logger.Info("restored month dropped", "table", table, "month", name)
+		return nil
+	}
+
+	// A month already in the store means rows were swept into it after it
+	// was exported. Overwriting the object would lose the earlier rows, so
+	// the month is left for an operator to merge.
+	if obj, err := a.store.Get(ctx, manifestKey(a.cfg.Prefix, table, name)); err == nil {
+		obj.Close()
+		return fmt.Errorf("already in cold storage; restore it and merge the rows before it can be archived again")
+	} else if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	m, err := a.export(ctx, tx, table, name)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, "DROP TABLE "+qualified); err != nil {
+		return err
+	}
+	a.logger.Info("month moved to cold storage", "table", table, "month", name, "rows", m.Rows)
+	return nil
+}
+
+// export writes an archived month and then its manifest to the store.
+func (a *Archiver) export(ctx context.Context, tx pgx.Tx, table, name string) (Manifest, error) {
+	qualified := pgx.Identifier{postgres.ArchiveSchema, name}.Sanitize()
+	rows, err := tx.Query(ctx, `
+		SELECT attname FROM pg_attribute
+		WHERE attrelid = to_regclass($1) AND attnum > 0 AND NOT attisdropped
+		ORDER BY attnum`, qualified)
+	if err != nil {
+		return Manifest{}, err
+	}
+	columns, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	f, err := os.CreateTemp("", "archive-*.csv.gz")
+	if err != nil {
+		return Manifest{}, err
+	}
+	defer os.Remove(f.Name()) //nolint:errcheck
+	defer f.Close()
+
+	digest := sha256.New()
+	gz := gzip.NewWriter(io.MultiWriter(f, digest))
+	tag, err := tx.Conn().PgConn().CopyTo(ctx, gz, fmt.Sprintf(
+		"COPY (SELECT %s FROM %s) TO STDOUT WITH (FORMAT csv, HEADER)", columnList(columns), qualified))
+	if err != nil {
+		return Manifest{}, fmt.Errorf("copy rows: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return Manifest{}, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return Manifest{}, err
+	}
+
+	month, _ := postgres.PartitionMonth(table, name)
+	m := Manifest{
+		ArchivedAt: a.now().UTC(),
+		Table:      table,
+		Name:       name,
+		Month:      month.Format("2006-01"),
+		Columns:    columns,
+		Rows:       tag.RowsAffected(),
+		SHA256:     hex.EncodeToString(digest.Sum(nil)),
+	}
+	if err := a.store.Put(ctx, dataKey(a.cfg.Prefix, table, name), f, "application/gzip"); err != nil {
+		return Manifest{}, err
+	}
+	body, err := json.Marshal(m)
+	if err != nil {
+		return Manifest{}, err
+	}
+	if err := a.store.Put(ctx, manifestKey(a.cfg.Prefix, table, name), strings.NewReader(string(body)), "application/json"); err != nil {
+		return Manifest{}, err
+	}
+	return m, nil
+}
+
+// Restore loads a month of table from cold storage back into the archive
+// schema and returns the qualified name of the table it is in. The month
+// stays there for the restore TTL. Archived tables have no row-level
+// security, so they are for operators and auditors, not tenants.
+func (a *Archiver) Restore(ctx context.Context, table string, month time.Time) (string, error) {
+	name := postgres.PartitionName(table, monthStart(month))
+	qualified := pgx.Identifier{postgres.ArchiveSchema, name}.Sanitize()
+
+	r, m, err := a.Open(ctx, table, month)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	err = postgres.WithTransaction(ctx, a.pool, func(tx pgx.Tx) error {
+		if locked, err := lock(ctx, tx, table); err != nil {
+			return err
+		} else if !locked {
+			return fmt.Errorf("%s is being archived, try again shortly", table)
+		}
+		var exists bool
+		if err := tx.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, qualified).Scan(&exists); err != nil {
+			return err
+		}
+		if exists {
+			return nil
+		}
+
+		stmts := []string{
+			"CREATE SCHEMA IF NOT EXISTS " + pgx.Identifier{postgres.ArchiveSchema}.Sanitize(),
+			fmt.Sprintf("CREATE TABLE %s (LIKE %s)", qualified, pgx.Identifier{table}.Sanitize()),
+		}
+		for _, stmt := range stmts {
+			if _, err := tx.Exec(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		tag, err := tx.Conn().PgConn().CopyFrom(ctx, r, fmt.Sprintf(
+			"COPY %s (%s) FROM STDIN WITH (FORMAT csv, HEADER)", qualified, columnList(m.Columns)))
+		if err != nil {
+			return fmt.Errorf("copy rows: %w", err)
+		}
+		if tag.RowsAffected() != m.Rows {
+			return fmt.Errorf("restored %d rows, manifest lists %d", tag.RowsAffected(), m.Rows)
+		}
+		_, err = tx.Exec(ctx, fmt.Sprintf("COMMENT ON TABLE %s IS '%s%s'",
+			qualified, restoredComment, a.now().UTC().Format(time.RFC3339)))
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("archive: restore %s: %w", name, err)
+	}
+	a.logger.Info("month restored", "table", table, "month", name, "rows", m.Rows)
+	return postgres.ArchiveSchema + "." + name, nil
+}
+
+// Open reads a month of table straight from cold storage, as CSV with a
+// header row. Reading fails at the end of the object if it does not match
+// its manifest's checksum.
+func (a *Archiver) Open(ctx context.Context, table string, month time.Time) (io.ReadCloser, Manifest, error) {
+	name := postgres.PartitionName(table, monthStart(month))
+	m, err := a.Manifest(ctx, table, month)
+	if err != nil {
+		return nil, Manifest{}, err
+	}
+	obj, err := a.store.Get(ctx, dataKey(a.cfg.Prefix, table, name))
+	if err != nil {
+		return nil, Manifest{}, fmt.Errorf("archive: open %s: %w", name, err)
+	}
+	r, err := newVerifiedReader(obj, m.SHA256)
+	if err != nil {
+		obj.Close()
+		return nil, Manifest{}, fmt.Errorf("archive: open %s: %w", name, err)
+	}
+	return r, m, nil
+}
+
+// Manifest returns the manifest of a month of table in cold storage.
+func (a *Archiver) Manifest(ctx context.Context, table string, month time.Time) (Manifest, error) {
+	name := postgres.PartitionName(table, monthStart(month))
+	obj, err := a.store.Get(ctx, manifestKey(a.cfg.Prefix, table, name))
+	if err != nil {
+		return Manifest{}, fmt.Errorf("archive: manifest of %s: %w", name, err)
+	}
+	defer obj.Close()
+	var m Manifest
+	if err := json.NewDecoder(obj).Decode(&m); err != nil {
+		return Manifest{}, fmt.Errorf("archive: manifest of %s: %w", name, err)
+	}
+	return m, nil
+}
+
+// lock takes the transaction-scoped lock serializing archival of table,
+// reporting false if another replica holds it.
+func lock(ctx context.Context, tx pgx.Tx, table string) (bool, error) {
+	var locked bool
+	if err := tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock(hashtext('archive:' || $1))`, table).Scan(&locked); err != nil {
+		return false, fmt.Errorf("lock: %w", err)
+	}
+	return locked, nil
+}
+
+// columnList joins columns as quoted identifiers.
+func columnList(columns []string) string {
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = pgx.Identifier{c}.Sanitize()
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// verifiedReader decompresses an archived object, checking the object's
+// digest once it has been read to the end.
+type verifiedReader struct {
+	obj    io.ReadCloser
+	raw    io.Reader
+	gz     *gzip.Reader
+	digest hash.Hash
+	want   string
+}
+
+func newVerifiedReader(obj io.ReadCloser, want string) (*verifiedReader, error) {
+	digest := sha256.New()
+	raw := io.TeeReader(obj, digest)
+	gz, err := gzip.NewReader(raw)
+	if err != nil {
+		return nil, err
+	}
+	return &verifiedReader{obj: obj, raw: raw, gz: gz, digest: digest, want: want}, nil
+}
+
+func (r *verifiedReader) Read(p []byte) (int, error) {
+	n, err := r.gz.Read(p)
+	if err != io.EOF {
+		return n, err
+	}
+	if _, err := io.Copy(io.Discard, r.raw); err != nil {
+		return n, err
+	}
+	if got := hex.EncodeToString(r.digest.Sum(nil)); got != r.want {
+		return n, fmt.Errorf("archive: checksum mismatch: object is %s, manifest lists %s", got, r.want)
+	}
+	return n, io.EOF
+}
+
+func (r *verifiedReader) Close() error {
+	return r.obj.Close()
+}
@@ -0,0 +1,123 @@
+// Command bib-archive reads months of historical data back from cold
+// storage. It is the on-demand path into the archives services write with
+// an archive.Archiver.
+//
+// restore loads a month into the service database's archive schema, where
+// it can be queried in SQL until the service drops it again after the
+// restore TTL. cat writes a month to stdout as CSV straight from the
+// archive, and manifest prints its manifest, neither touching the
+// database.
+//
+//	bib-archive restore -prefix ledger -table journal_entries -month 2019-03
+//	bib-archive cat -prefix payments -table payment_orders -month 2019-03 > orders.csv
+//
+// The store is configured like the services': ARCHIVE_S3_BUCKET with
+// ARCHIVE_S3_ENDPOINT, ARCHIVE_S3_REGION, ARCHIVE_S3_ACCESS_KEY and
+// ARCHIVE_S3_SECRET_KEY, or ARCHIVE_DIR. restore connects to DATABASE_URL.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/pkg/archive"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	cmd := os.Args[1]
+	fs := flag.NewFlagSet(cmd, flag.ExitOnError)
+	var (
+		prefix = fs.String("prefix", "", "key prefix the service archives under, such as ledger")
+		table  = fs.String("table", "", "operational table the month was archived from")
+		month  = fs.String("month", "", "month to read, as YYYY-MM")
+		dsn    = fs.String("database-url", os.Getenv("DATABASE_URL"), "service database to restore into")
+	)
+	_ = fs.Parse(os.Args[2:]) //nolint:errcheck // ExitOnError
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	m, err := time.Parse("2006-01", *month)
+	if err != nil || *prefix == "" || *table == "" {
+		logger.Error("-prefix, -table and -month (YYYY-MM) are required")
+		os.Exit(2)
+	}
+	store := archive.NewStore(archive.StoreConfig{
+		Dir:         os.Getenv("ARCHIVE_DIR"),
+		S3Endpoint:  envOr("ARCHIVE_S3_ENDPOINT", "https://s3.amazonaws.com"),
+		S3Region:    envOr("ARCHIVE_S3_REGION", "us-east-1"),
+		S3Bucket:    os.Getenv("ARCHIVE_S3_BUCKET"),
+		S3AccessKey: os.Getenv("ARCHIVE_S3_ACCESS_KEY"),
+		S3SecretKey: os.Getenv("ARCHIVE_S3_SECRET_KEY"),
+	})
+	if store == nil {
+		logger.Error("no archive configured: set ARCHIVE_S3_BUCKET or ARCHIVE_DIR")
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var pool *pgxpool.Pool
+	if cmd == "restore" {
+		if *dsn == "" {
+			logger.Error("restore needs the service database: set -database-url or DATABASE_URL")
+			os.Exit(2)
+		}
+		if pool, err = pgxpool.New(ctx, *dsn); err != nil {
+			logger.Error("failed to connect to database", "error", err)
+			os.Exit(1)
+		}
+		defer pool.Close()
+	}
+	archiver := archive.NewArchiver(pool, store, archive.Config{Prefix: *prefix}, logger)
+
+	switch cmd {
+	case "restore":
+		var name string
+		if name, err = archiver.Restore(ctx, *table, m); err == nil {
+			fmt.Println(name)
+		}
+	case "cat":
+		var r io.ReadCloser
+		if r, _, err = archiver.Open(ctx, *table, m); err == nil {
+			_, err = io.Copy(os.Stdout, r)
+			r.Close()
+		}
+	case "manifest":
+		var manifest archive.Manifest
+		if manifest, err = archiver.Manifest(ctx, *table, m); err == nil {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			err = enc.Encode(manifest)
+		}
+	default:
+		usage()
+	}
+	if err != nil {
+		logger.Error(cmd+" failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: bib-archive restore|cat|manifest -prefix <service> -table <table> -month YYYY-MM")
+	os.Exit(2)
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,102 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Compile-time interface check.
+var _ Store = (*FileStore)(nil)
+
+// FileStore implements Store on a local directory, for development and for
+// deployments that mount their storage; production uses S3Store.
+type FileStore struct {
+	root string
+}
+
+// NewFileStore creates a FileStore rooted at dir.
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{root: dir}
+}
+
+// Put writes body under key, replacing any existing object.
+func (s *FileStore) Put(_ context.Context, key string, body io.ReadSeeker, _ string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("archive: create directory: %w", err)
+	}
+
+	// Write to a temporary file and rename so readers never see a partial object.
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600) //nolint:gosec // key is confined to the store's root
+	if err != nil {
+		return fmt.Errorf("archive: write object: %w", err)
+	}
+	_, err = io.Copy(f, body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp) //nolint:errcheck
+		return fmt.Errorf("archive: write object: %w", err)
+	}
+	return nil
+}
+
+// Get opens the object under key.
+func (s *FileStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path) //nolint:gosec // key is confined to the store's root
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("archive: read object: %w", err)
+	}
+	return f, nil
+}
+
+// Delete removes the object under key.
+func (s *FileStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("archive: delete object: %w", err)
+	}
+	return nil
+}
+
+// URI returns the file:// URI of the object under key.
+func (s *FileStore) URI(key string) string {
+	path := filepath.Join(s.root, filepath.Clean("/"+key))
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	return "file://" + path
+}
+
+// path maps key to a file under the store's root.
+func (s *FileStore) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if strings.Contains(key, "..") || clean == "/" {
+		return "", fmt.Errorf("archive: invalid object key: %q", key)
+	}
+	return filepath.Join(s.root, clean), nil
+}
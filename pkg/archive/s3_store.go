@@ -0,0 +1,224 @@
+package archive
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Compile-time interface check.
+var _ Store = (*S3Store)(nil)
+
+// S3Config configures an S3-compatible bucket (AWS S3, MinIO, Ceph RGW, ...).
+type S3Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+}
+
+// S3Store implements Store against the S3 REST API using path-style
+// addressing and Signature Version 4.
+type S3Store struct {
+	client *http.Client
+	cfg    S3Config
+}
+
+// NewS3Store creates an S3Store for the configured bucket.
+func NewS3Store(cfg S3Config) *S3Store {
+	cfg.Endpoint = strings.TrimRight(cfg.Endpoint, "/")
+	return &S3Store{
+		cfg: cfg,
+		// Archived months can be large, so only the connection is timed
+		// out; requests are bounded by their context.
+		client: &http.Client{},
+	}
+}
+
+// Put uploads body under key. The body is read twice: once to sign its
+// digest and once to send it.
+func (s *S3Store) Put(ctx context.Context, key string, body io.ReadSeeker, contentType string) error {
+	if key == "" || strings.Contains(key, "..") {
+		return fmt.Errorf("archive: invalid object key: %q", key)
+	}
+
+	digest := sha256.New()
+	size, err := io.Copy(digest, body)
+	if err != nil {
+		return fmt.Errorf("archive: read object: %w", err)
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("archive: rewind object: %w", err)
+	}
+
+	objectPath := "/" + s.cfg.Bucket + "/" + escapeKey(key)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.cfg.Endpoint+objectPath, io.NopCloser(body))
+	if err != nil {
+		return fmt.Errorf("archive: create request: %w", err)
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("x-amz-server-side-encryption", "AES256")
+	s.sign(req, objectPath, hex.EncodeToString(digest.Sum(nil)), time.Now().UTC())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("archive: object storage request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024)) //nolint:errcheck // best-effort error detail
+	return fmt.Errorf("archive: object storage put failed (status %d): %s", resp.StatusCode, string(detail))
+}
+
+// Get opens the object under key for streaming.
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	if key == "" || strings.Contains(key, "..") {
+		return nil, fmt.Errorf("archive: invalid object key: %q", key)
+	}
+
+	objectPath := "/" + s.cfg.Bucket + "/" + escapeKey(key)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.cfg.Endpoint+objectPath, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("archive: create request: %w", err)
+	}
+	s.sign(req, objectPath, sha256Hex(nil), time.Now().UTC())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("archive: object storage request failed: %w", err)
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024)) //nolint:errcheck // best-effort error detail
+		resp.Body.Close()
+		return nil, fmt.Errorf("archive: object storage get failed (status %d): %s", resp.StatusCode, string(detail))
+	}
+	return resp.Body, nil
+}
+
+// Delete removes the object under key. S3 reports success for missing
+// objects, so deleting one is not an error.
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	if key == "" || strings.Contains(key, "..") {
+		return fmt.Errorf("archive: invalid object key: %q", key)
+	}
+
+	objectPath := "/" + s.cfg.Bucket + "/" + escapeKey(key)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.cfg.Endpoint+objectPath, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("archive: create request: %w", err)
+	}
+	s.sign(req, objectPath, sha256Hex(nil), time.Now().UTC())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("archive: object storage request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 || resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024)) //nolint:errcheck // best-effort error detail
+	return fmt.Errorf("archive: object storage delete failed (status %d): %s", resp.StatusCode, string(detail))
+}
+
+// URI returns the s3:// URI of the object under key.
+func (s *S3Store) URI(key string) string {
+	return "s3://" + s.cfg.Bucket + "/" + key
+}
+
+// sign adds AWS Signature Version 4 headers to req, whose body has the hex
+// SHA-256 digest payloadHash.
+func (s *S3Store) sign(req *http.Request, canonicalPath, payloadHash string, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		lower := strings.ToLower(name)
+		if lower == "content-type" || strings.HasPrefix(lower, "x-amz-") {
+			headers[lower] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalPath,
+		"",
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.cfg.Region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+s.cfg.SecretKey), date)
+	key = hmacSHA256(key, s.cfg.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.cfg.AccessKey, scope, signedHeaders, signature,
+	))
+}
+
+// escapeKey URI-encodes each segment of an object key as SigV4 requires.
+func escapeKey(key string) string {
+	const hexDigits = "0123456789ABCDEF"
+	var b strings.Builder
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/':
+			b.WriteByte(c)
+		default:
+			b.WriteByte('%')
+			b.WriteByte(hexDigits[c>>4])
+			b.WriteByte(hexDigits[c&0x0F])
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -8,9 +8,12 @@ import (
 // Claims represents the JWT claims for BIB platform.
 type Claims struct {
 	jwt.RegisteredClaims
-	Roles    []string  `json:"roles"`
-	UserID   uuid.UUID `json:"user_id"`
-	TenantID uuid.UUID `json:"tenant_id"`
+	Roles []string `json:"roles"`
+	// SessionID names the refresh session an access token was issued in,
+	// for tokens issued by Sessions.
+	SessionID string    `json:"sid,omitempty"`
+	UserID    uuid.UUID `json:"user_id"`
+	TenantID  uuid.UUID `json:"tenant_id"`
 }
 
 // HasRole checks if the claims include the specified role.
@@ -25,9 +28,10 @@ func (c Claims) HasRole(role string) bool {
 
 // Role constants
 const (
-	RoleAdmin     = "admin"
-	RoleOperator  = "operator"
-	RoleAuditor   = "auditor"
-	RoleCustomer  = "customer"
-	RoleAPIClient = "api_client"
+	RoleAdmin      = "admin"
+	RoleOperator   = "operator"
+	RoleAuditor    = "auditor"
+	RoleCustomer   = "customer"
+	RoleAPIClient  = "api_client"
+	RoleCompliance = "compliance"
 )
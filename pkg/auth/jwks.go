@@ -0,0 +1,244 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultJWKSRefreshInterval is how often a JWKS-configured JWTService
+	// refetches the key set when JWKSRefreshInterval is unset.
+	DefaultJWKSRefreshInterval = 5 * time.Minute
+
+	// jwksMinRefetch bounds how often a token with an unknown kid makes the
+	// key set be refetched.
+	jwksMinRefetch = 30 * time.Second
+
+	jwksFetchTimeout = 5 * time.Second
+)
+
+// JWK is an RSA public key in JSON Web Key form (RFC 7517).
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	Kid string `json:"kid,omitempty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// JWKS is a JSON Web Key Set, as served at /.well-known/jwks.json.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// KeyID returns the kid of an RSA public key: its RFC 7638 JWK thumbprint.
+// The issuer sets it in the header of the tokens it signs, and validators
+// use it to pick the key out of a set.
+func KeyID(key *rsa.PublicKey) string {
+	n, e := jwkComponents(key)
+	// Members in lexicographic order, without whitespace, as RFC 7638 requires.
+	sum := sha256.Sum256([]byte(`{"e":"` + e + `","kty":"RSA","n":"` + n + `"}`))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func jwkComponents(key *rsa.PublicKey) (n, e string) {
+	return base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+}
+
+// NewJWK returns key as a signing JWK.
+func NewJWK(key *rsa.PublicKey) JWK {
+	n, e := jwkComponents(key)
+	return JWK{Kty: "RSA", Use: "sig", Alg: "RS256", Kid: KeyID(key), N: n, E: e}
+}
+
+// PublicKey parses the JWK as an RSA public key.
+func (k JWK) PublicKey() (*rsa.PublicKey, error) {
+	if k.Kty != "RSA" {
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+	exp := new(big.Int).SetBytes(e)
+	if len(n) == 0 || !exp.IsInt64() || exp.Int64() < 3 || exp.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("invalid RSA key %q", k.Kid)
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}, nil
+}
+
+// JWKS returns the RSA public keys the service trusts: the signing key's,
+// if any, then the rest, such as a rotated-out key still being honoured.
+// It is empty in HMAC mode, whose secret cannot be published.
+func (s *JWTService) JWKS() JWKS {
+	set := JWKS{Keys: []JWK{}}
+	seen := make(map[string]bool)
+	for _, key := range s.verificationKeys() {
+		jwk := NewJWK(key)
+		if seen[jwk.Kid] {
+			continue
+		}
+		seen[jwk.Kid] = true
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
+
+// JWKSHandler serves the service's JWKS, for validators configured with
+// JWKSURL to fetch. It is public: the set holds public keys only.
+func (s *JWTService) JWKSHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		body, err := json.Marshal(s.JWKS())
+		if err != nil {
+			http.Error(w, "failed to encode key set", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		_, _ = w.Write(body)
+	})
+}
+
+// remoteKeys is a key set fetched from a JWKS URL. It is refetched in the
+// background once older than interval, and at once (at most every
+// jwksMinRefetch) when a token names a key it does not hold, so a rotation
+// at the issuer is picked up without a restart.
+type remoteKeys struct {
+	fetchedAt  time.Time
+	attemptAt  time.Time
+	client     *http.Client
+	keys       map[string]*rsa.PublicKey
+	url        string
+	interval   time.Duration
+	minRefetch time.Duration
+	mu         sync.Mutex
+	fetchMu    sync.Mutex
+	refreshing bool
+}
+
+func newRemoteKeys(url string, interval time.Duration) *remoteKeys {
+	if interval <= 0 {
+		interval = DefaultJWKSRefreshInterval
+	}
+	return &remoteKeys{
+		client:     &http.Client{Timeout: jwksFetchTimeout},
+		url:        url,
+		interval:   interval,
+		minRefetch: jwksMinRefetch,
+	}
+}
+
+// snapshot returns the current keys, starting a background refresh when
+// they are stale. After a failed fetch it retries at most every minRefetch.
+func (r *remoteKeys) snapshot() map[string]*rsa.PublicKey {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stale := time.Since(r.fetchedAt) >= r.interval && time.Since(r.attemptAt) >= min(r.interval, r.minRefetch)
+	if !r.refreshing && stale {
+		r.refreshing = true
+		go func() {
+			_ = r.refresh(context.Background())
+			r.mu.Lock()
+			r.refreshing = false
+			r.mu.Unlock()
+		}()
+	}
+	return r.keys
+}
+
+// lookup returns the key with kid, refetching the set if it is unknown and
+// the set was not fetched within jwksMinRefetch.
+func (r *remoteKeys) lookup(kid string) (*rsa.PublicKey, bool) {
+	if key, ok := r.snapshot()[kid]; ok {
+		return key, true
+	}
+	r.mu.Lock()
+	recent := time.Since(r.attemptAt) < r.minRefetch
+	r.mu.Unlock()
+	if recent || r.refresh(context.Background()) != nil {
+		return nil, false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key, ok := r.keys[kid]
+	return key, ok
+}
+
+// refresh fetches the key set, keeping the current one if that fails.
+func (r *remoteKeys) refresh(ctx context.Context) error {
+	r.fetchMu.Lock()
+	defer r.fetchMu.Unlock()
+
+	r.mu.Lock()
+	r.attemptAt = time.Now()
+	r.mu.Unlock()
+
+	keys, err := r.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	r.keys = keys
+	r.fetchedAt = time.Now()
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *remoteKeys) fetch(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build JWKS request: %w", err)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("JWKS request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 256<<10))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS endpoint returned %d", resp.StatusCode)
+	}
+
+	var set JWKS
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		key, err := jwk.PublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("JWKS key %q: %w", jwk.Kid, err)
+		}
+		kid := jwk.Kid
+		if kid == "" {
+			kid = KeyID(key)
+		}
+		keys[kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("JWKS at %s holds no RSA signing keys", r.url)
+	}
+	return keys, nil
+}
@@ -0,0 +1,184 @@
+package auth
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func newTestIssuer(t *testing.T, extraPublicPEM string) *JWTService {
+	t.Helper()
+	privPEM, _, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("GenerateKeyPair: %v", err)
+	}
+	svc, err := NewJWTService(JWTConfig{
+		PrivateKeyPEM: string(privPEM),
+		PublicKeyPEM:  extraPublicPEM,
+		Issuer:        "bib-gateway",
+		Expiration:    time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("NewJWTService: %v", err)
+	}
+	return svc
+}
+
+// jwksServer serves the JWKS of whichever issuer is current.
+func jwksServer(t *testing.T, issuer *atomic.Pointer[JWTService]) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var fetches atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		issuer.Load().JWKSHandler().ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &fetches
+}
+
+func TestJWKS_PublishesSigningAndRotatedKeys(t *testing.T) {
+	_, previousPub, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("GenerateKeyPair: %v", err)
+	}
+	issuer := newTestIssuer(t, string(previousPub))
+
+	set := issuer.JWKS()
+	if len(set.Keys) != 2 {
+		t.Fatalf("got %d keys, want the signing and the previous key", len(set.Keys))
+	}
+	if set.Keys[0].Kid != issuer.signingKeyID {
+		t.Errorf("first key = %s, want the signing key %s", set.Keys[0].Kid, issuer.signingKeyID)
+	}
+	for _, jwk := range set.Keys {
+		key, err := jwk.PublicKey()
+		if err != nil {
+			t.Fatalf("PublicKey: %v", err)
+		}
+		if KeyID(key) != jwk.Kid || jwk.Alg != "RS256" || jwk.Use != "sig" {
+			t.Errorf("JWK = %+v", jwk)
+		}
+	}
+
+	token, err := issuer.GenerateToken(uuid.New(), uuid.New(), []string{RoleCustomer})
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	parsed, err := issuer.ValidateToken(token)
+	if err != nil {
+		t.Fatalf("ValidateToken: %v", err)
+	}
+	if parsed.Issuer != "bib-gateway" {
+		t.Errorf("issuer = %q", parsed.Issuer)
+	}
+
+	if keys := newTestJWTService().JWKS().Keys; len(keys) != 0 {
+		t.Errorf("HMAC service published %d keys", len(keys))
+	}
+}
+
+func TestJWKSURL_PicksUpRotatedKey(t *testing.T) {
+	var current atomic.Pointer[JWTService]
+	current.Store(newTestIssuer(t, ""))
+	srv, fetches := jwksServer(t, &current)
+
+	validator, err := NewJWTService(JWTConfig{JWKSURL: srv.URL, Issuer: "bib-gateway"})
+	if err != nil {
+		t.Fatalf("NewJWTService: %v", err)
+	}
+	validator.remote.minRefetch = 0
+
+	sign := func() string {
+		t.Helper()
+		token, err := current.Load().GenerateToken(uuid.New(), uuid.New(), []string{RoleCustomer})
+		if err != nil {
+			t.Fatalf("GenerateToken: %v", err)
+		}
+		return token
+	}
+
+	oldToken := sign()
+	if _, err := validator.ValidateToken(oldToken); err != nil {
+		t.Fatalf("token before rotation rejected: %v", err)
+	}
+
+	// Rotate: the issuer now signs with a new key and still publishes the
+	// old one.
+	oldPub, err := publicKeyPEMOf(current.Load())
+	if err != nil {
+		t.Fatalf("encode old key: %v", err)
+	}
+	current.Store(newTestIssuer(t, oldPub))
+
+	if _, err := validator.ValidateToken(sign()); err != nil {
+		t.Fatalf("token signed with the rotated-in key rejected: %v", err)
+	}
+	if _, err := validator.ValidateToken(oldToken); err != nil {
+		t.Errorf("token signed with the rotated-out key rejected: %v", err)
+	}
+	if got := fetches.Load(); got != 2 {
+		t.Errorf("JWKS fetched %d times, want 2", got)
+	}
+
+	// A token from an unknown issuer key is still rejected.
+	stranger := newTestIssuer(t, "")
+	token, err := stranger.GenerateToken(uuid.New(), uuid.New(), nil)
+	if err != nil {
+		t.Fatalf("GenerateToken: %v", err)
+	}
+	if _, err := validator.ValidateToken(token); err == nil {
+		t.Error("token signed with an unpublished key accepted")
+	}
+}
+
+func TestJWKSURL_RefreshesPeriodically(t *testing.T) {
+	var current atomic.Pointer[JWTService]
+	current.Store(newTestIssuer(t, ""))
+	srv, fetches := jwksServer(t, &current)
+
+	validator, err := NewJWTService(JWTConfig{JWKSURL: srv.URL, JWKSRefreshInterval: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("NewJWTService: %v", err)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	_ = validator.JWKS()
+	deadline := time.Now().Add(time.Second)
+	for fetches.Load() < 2 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got := fetches.Load(); got < 2 {
+		t.Errorf("JWKS fetched %d times, want a refresh after the interval", got)
+	}
+}
+
+func TestJWKSURL_Unreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+
+	if _, err := NewJWTService(JWTConfig{JWKSURL: srv.URL}); err == nil {
+		t.Error("NewJWTService succeeded without any key")
+	}
+
+	_, pubPEM, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("GenerateKeyPair: %v", err)
+	}
+	if _, err := NewJWTService(JWTConfig{JWKSURL: srv.URL, PublicKeyPEM: string(pubPEM)}); err != nil {
+		t.Errorf("NewJWTService with a static key: %v", err)
+	}
+}
+
+func publicKeyPEMOf(svc *JWTService) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(&svc.privateKey.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), nil
+}
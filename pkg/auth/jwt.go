@@ -1,12 +1,14 @@
 package auth
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -22,9 +24,21 @@ type JWTConfig struct {
 	// PrivateKeyPEM is a PEM-encoded RSA private key for signing tokens (issuer mode).
 	PrivateKeyPEM string
 
-	// PublicKeyPEM is a PEM-encoded RSA public key for validating tokens (validator mode).
+	// PublicKeyPEM holds one or more PEM-encoded RSA public keys for
+	// validating tokens (validator mode). A token signed by any of them is
+	// accepted, so during a key rotation it lists both the new key and the
+	// one it replaces. With PrivateKeyPEM set, its keys are trusted in
+	// addition to the signing key's.
 	PublicKeyPEM string
 
+	// JWKSURL is the issuer's JSON Web Key Set, such as the gateway's
+	// /.well-known/jwks.json. Its keys are trusted in addition to any
+	// PublicKeyPEM keys and are refetched every JWKSRefreshInterval (default
+	// DefaultJWKSRefreshInterval), and whenever a token names a key not in
+	// the set, so rotated keys are picked up without a restart.
+	JWKSURL             string
+	JWKSRefreshInterval time.Duration
+
 	// SigningMethod selects the algorithm: "RS256" (default) or "HS256" (legacy).
 	// When PrivateKeyPEM or PublicKeyPEM is set, this defaults to "RS256".
 	// When only Secret is set, this defaults to "HS256".
@@ -32,21 +46,28 @@ type JWTConfig struct {
 
 	Issuer     string
 	Expiration time.Duration
+
+	// Revocations is the denylist of revoked token IDs. When set,
+	// ValidateToken rejects tokens whose jti it lists.
+	Revocations RevocationList
 }
 
 // JWTService handles JWT token operations.
 type JWTService struct {
-	privateKey *rsa.PrivateKey
-	publicKey  *rsa.PublicKey
-	config     JWTConfig
-	useRSA     bool
+	privateKey   *rsa.PrivateKey
+	remote       *remoteKeys
+	signingKeyID string
+	publicKeys   []*rsa.PublicKey
+	config       JWTConfig
+	useRSA       bool
 }
 
 // NewJWTService creates a new JWTService with the given configuration.
 //
 // Configuration modes:
-//   - PrivateKeyPEM set: full issuer mode (can sign and validate). The public key is derived.
-//   - PublicKeyPEM set (no private): validation-only mode. GenerateToken returns an error.
+//   - PrivateKeyPEM set: full issuer mode (can sign and validate). The public key is derived,
+//     and any PublicKeyPEM keys are trusted as well.
+//   - PublicKeyPEM or JWKSURL set (no private): validation-only mode. GenerateToken returns an error.
 //   - Only Secret set: legacy HMAC-SHA256 mode (backwards compatible).
 func NewJWTService(cfg JWTConfig) (*JWTService, error) {
 	svc := &JWTService{config: cfg}
@@ -59,16 +80,26 @@ func NewJWTService(cfg JWTConfig) (*JWTService, error) {
 			return nil, fmt.Errorf("failed to parse RSA private key: %w", err)
 		}
 		svc.privateKey = privKey
-		svc.publicKey = &privKey.PublicKey
+		svc.signingKeyID = KeyID(&privKey.PublicKey)
+		svc.publicKeys = []*rsa.PublicKey{&privKey.PublicKey}
 		svc.useRSA = true
+		if cfg.PublicKeyPEM != "" {
+			pubKeys, err := parsePublicKeys(cfg.PublicKeyPEM)
+			if err != nil {
+				return nil, err
+			}
+			svc.publicKeys = append(svc.publicKeys, pubKeys...)
+		}
 
-	case cfg.PublicKeyPEM != "":
-		// Validation-only mode: parse RSA public key.
-		pubKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(cfg.PublicKeyPEM))
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse RSA public key: %w", err)
+	case cfg.PublicKeyPEM != "" || cfg.JWKSURL != "":
+		// Validation-only mode: parse RSA public keys.
+		if cfg.PublicKeyPEM != "" {
+			pubKeys, err := parsePublicKeys(cfg.PublicKeyPEM)
+			if err != nil {
+				return nil, err
+			}
+			svc.publicKeys = pubKeys
 		}
-		svc.publicKey = pubKey
 		svc.useRSA = true
 
 	case cfg.Secret != "":
@@ -76,16 +107,58 @@ func NewJWTService(cfg JWTConfig) (*JWTService, error) {
 		svc.useRSA = false
 
 	default:
-		return nil, fmt.Errorf("jwt configuration requires PrivateKeyPEM, PublicKeyPEM, or Secret")
+		return nil, fmt.Errorf("jwt configuration requires PrivateKeyPEM, PublicKeyPEM, JWKSURL, or Secret")
+	}
+
+	if cfg.JWKSURL != "" {
+		svc.remote = newRemoteKeys(cfg.JWKSURL, cfg.JWKSRefreshInterval)
+		// Without static keys no token validates until the set is fetched.
+		if err := svc.remote.refresh(context.Background()); err != nil && len(svc.publicKeys) == 0 {
+			return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+		}
 	}
 
 	return svc, nil
 }
 
+// parsePublicKeys parses each PEM block of data as an RSA public key.
+func parsePublicKeys(data string) ([]*rsa.PublicKey, error) {
+	var keys []*rsa.PublicKey
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		key, err := jwt.ParseRSAPublicKeyFromPEM(pem.EncodeToMemory(block))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse RSA public key %d: %w", len(keys)+1, err)
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("failed to parse RSA public key: no PEM data")
+	}
+	return keys, nil
+}
+
 // GenerateToken creates a new JWT token for the given user.
 func (s *JWTService) GenerateToken(userID, tenantID uuid.UUID, roles []string) (string, error) {
+	token, _, err := s.generate(userID, tenantID, roles, "")
+	return token, err
+}
+
+// IssueToken is GenerateToken, also returning the token's claims.
+func (s *JWTService) IssueToken(userID, tenantID uuid.UUID, roles []string) (string, *Claims, error) {
+	return s.generate(userID, tenantID, roles, "")
+}
+
+// generate signs a token for the given user, in the refresh session
+// sessionID when it is set, returning it with its claims.
+func (s *JWTService) generate(userID, tenantID uuid.UUID, roles []string, sessionID string) (string, *Claims, error) {
 	now := time.Now()
-	claims := Claims{
+	claims := &Claims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    s.config.Issuer,
 			Subject:   userID.String(),
@@ -94,41 +167,49 @@ func (s *JWTService) GenerateToken(userID, tenantID uuid.UUID, roles []string) (
 			NotBefore: jwt.NewNumericDate(now),
 			ID:        uuid.New().String(),
 		},
-		UserID:   userID,
-		TenantID: tenantID,
-		Roles:    roles,
+		UserID:    userID,
+		TenantID:  tenantID,
+		Roles:     roles,
+		SessionID: sessionID,
 	}
 
 	if s.useRSA {
 		if s.privateKey == nil {
-			return "", fmt.Errorf("cannot generate token: no private key configured (validation-only mode)")
+			return "", nil, fmt.Errorf("cannot generate token: no private key configured (validation-only mode)")
 		}
 		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = s.signingKeyID
 		signedToken, err := token.SignedString(s.privateKey)
 		if err != nil {
-			return "", fmt.Errorf("failed to sign token with RSA: %w", err)
+			return "", nil, fmt.Errorf("failed to sign token with RSA: %w", err)
 		}
-		return signedToken, nil
+		return signedToken, claims, nil
 	}
 
 	// Legacy HMAC-SHA256 mode.
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	signedToken, err := token.SignedString([]byte(s.config.Secret))
 	if err != nil {
-		return "", fmt.Errorf("failed to sign token: %w", err)
+		return "", nil, fmt.Errorf("failed to sign token: %w", err)
 	}
-	return signedToken, nil
+	return signedToken, claims, nil
 }
 
 // ValidateToken parses and validates a JWT token string.
 func (s *JWTService) ValidateToken(tokenString string) (*Claims, error) {
+	return s.ValidateTokenContext(context.Background(), tokenString)
+}
+
+// ValidateTokenContext is ValidateToken, checking the revocation list, if
+// one is configured, within ctx.
+func (s *JWTService) ValidateTokenContext(ctx context.Context, tokenString string) (*Claims, error) {
 	claims := &Claims{}
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
 		if s.useRSA {
 			if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
 				return nil, fmt.Errorf("unexpected signing method: %v (expected RS256)", token.Header["alg"])
 			}
-			return s.publicKey, nil
+			return s.verificationKey(token)
 		}
 
 		// Legacy HMAC-SHA256 mode.
@@ -151,9 +232,112 @@ func (s *JWTService) ValidateToken(tokenString string) (*Claims, error) {
 		}
 	}
 
+	if s.config.Revocations != nil && claims.ID != "" {
+		revoked, err := s.config.Revocations.IsRevoked(ctx, claims.ID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrRevocationCheck, err)
+		}
+		if revoked {
+			return nil, ErrTokenRevoked
+		}
+	}
+
 	return claims, nil
 }
 
+// Revoke adds the token with the given claims to the revocation list, so
+// ValidateToken rejects it from now until it expires.
+func (s *JWTService) Revoke(ctx context.Context, claims *Claims) error {
+	if s.config.Revocations == nil {
+		return fmt.Errorf("cannot revoke token: no revocation list configured")
+	}
+	if claims.ID == "" {
+		return fmt.Errorf("cannot revoke token: no jti claim")
+	}
+	if err := s.config.Revocations.Revoke(ctx, claims.ID, s.revokedUntil(claims)); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+	return nil
+}
+
+// Consume revokes the token with the given claims, as Revoke does, for a
+// token that may be used only once. It returns ErrTokenRevoked when the
+// token was already revoked, including by a concurrent Consume.
+func (s *JWTService) Consume(ctx context.Context, claims *Claims) error {
+	if s.config.Revocations == nil {
+		return fmt.Errorf("cannot consume token: no revocation list configured")
+	}
+	if claims.ID == "" {
+		return fmt.Errorf("cannot consume token: no jti claim")
+	}
+	revoked, err := s.config.Revocations.RevokeOnce(ctx, claims.ID, s.revokedUntil(claims))
+	if err != nil {
+		return fmt.Errorf("failed to consume token: %w", err)
+	}
+	if revoked {
+		return ErrTokenRevoked
+	}
+	return nil
+}
+
+// revokedUntil returns how long the token with the given claims must stay
+// on the revocation list: until it expires.
+func (s *JWTService) revokedUntil(claims *Claims) time.Time {
+	if claims.ExpiresAt != nil {
+		return claims.ExpiresAt.Time
+	}
+	return time.Now().Add(s.config.Expiration)
+}
+
+// verificationKey returns the key named by the token's kid or, for tokens
+// without one or naming an unknown key, every trusted key.
+func (s *JWTService) verificationKey(token *jwt.Token) (interface{}, error) {
+	if kid, _ := token.Header["kid"].(string); kid != "" {
+		for _, key := range s.publicKeys {
+			if KeyID(key) == kid {
+				return key, nil
+			}
+		}
+		if s.remote != nil {
+			if key, ok := s.remote.lookup(kid); ok {
+				return key, nil
+			}
+		}
+	}
+
+	keys := s.verificationKeys()
+	switch len(keys) {
+	case 0:
+		return nil, fmt.Errorf("no verification keys available")
+	case 1:
+		return keys[0], nil
+	}
+	keySet := jwt.VerificationKeySet{Keys: make([]jwt.VerificationKey, 0, len(keys))}
+	for _, key := range keys {
+		keySet.Keys = append(keySet.Keys, key)
+	}
+	return keySet, nil
+}
+
+// verificationKeys returns the static keys followed by the JWKS keys, in
+// kid order.
+func (s *JWTService) verificationKeys() []*rsa.PublicKey {
+	keys := append([]*rsa.PublicKey(nil), s.publicKeys...)
+	if s.remote == nil {
+		return keys
+	}
+	remote := s.remote.snapshot()
+	kids := make([]string, 0, len(remote))
+	for kid := range remote {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+	for _, kid := range kids {
+		keys = append(keys, remote[kid])
+	}
+	return keys
+}
+
 // LoadKeyFromFile reads a PEM-encoded key from a file path.
 func LoadKeyFromFile(path string) ([]byte, error) {
 	data, err := os.ReadFile(path)
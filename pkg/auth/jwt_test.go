@@ -303,6 +303,93 @@ func TestRSA_IssuerCanAlsoValidate(t *testing.T) {
 	}
 }
 
+func TestRSA_PublicKeyBundle_AcceptsEveryKey(t *testing.T) {
+	oldPriv, oldPub, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("GenerateKeyPair() error = %v", err)
+	}
+	newPriv, newPub, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("GenerateKeyPair() error = %v", err)
+	}
+	_, otherPub, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("GenerateKeyPair() error = %v", err)
+	}
+
+	// During a rotation validators trust both the new and the old key.
+	validator, err := NewJWTService(JWTConfig{
+		PublicKeyPEM: string(newPub) + string(oldPub),
+		Issuer:       "bib-test",
+	})
+	if err != nil {
+		t.Fatalf("NewJWTService() error = %v", err)
+	}
+
+	for name, privPEM := range map[string][]byte{"old": oldPriv, "new": newPriv} {
+		issuer, err := NewJWTService(JWTConfig{
+			PrivateKeyPEM: string(privPEM),
+			Issuer:        "bib-test",
+			Expiration:    15 * time.Minute,
+		})
+		if err != nil {
+			t.Fatalf("NewJWTService() error = %v", err)
+		}
+		tokenString, err := issuer.GenerateToken(uuid.New(), uuid.New(), []string{RoleOperator})
+		if err != nil {
+			t.Fatalf("GenerateToken() error = %v", err)
+		}
+		if _, err := validator.ValidateToken(tokenString); err != nil {
+			t.Errorf("ValidateToken() of %s key's token error = %v", name, err)
+		}
+	}
+
+	// The issuer trusts the bundle in addition to its own key.
+	issuer, err := NewJWTService(JWTConfig{
+		PrivateKeyPEM: string(newPriv),
+		PublicKeyPEM:  string(oldPub),
+		Issuer:        "bib-test",
+	})
+	if err != nil {
+		t.Fatalf("NewJWTService() error = %v", err)
+	}
+	oldIssuer, err := NewJWTService(JWTConfig{PrivateKeyPEM: string(oldPriv), Issuer: "bib-test", Expiration: time.Minute})
+	if err != nil {
+		t.Fatalf("NewJWTService() error = %v", err)
+	}
+	tokenString, err := oldIssuer.GenerateToken(uuid.New(), uuid.New(), []string{RoleCustomer})
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+	if _, err := issuer.ValidateToken(tokenString); err != nil {
+		t.Errorf("ValidateToken() of old key's token by rotated issuer error = %v", err)
+	}
+
+	// Keys outside the bundle are still rejected.
+	stranger, err := NewJWTService(JWTConfig{PublicKeyPEM: string(otherPub), Issuer: "bib-test"})
+	if err != nil {
+		t.Fatalf("NewJWTService() error = %v", err)
+	}
+	if _, err := stranger.ValidateToken(tokenString); err == nil {
+		t.Fatal("ValidateToken() expected error for key outside the bundle, got nil")
+	}
+}
+
+func TestNewJWTService_InvalidPublicKeyBundle(t *testing.T) {
+	_, pubPEM, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("GenerateKeyPair() error = %v", err)
+	}
+	for name, bundle := range map[string]string{
+		"no PEM":     "not a key",
+		"bad second": string(pubPEM) + "-----BEGIN PUBLIC KEY-----\nAAAA\n-----END PUBLIC KEY-----\n",
+	} {
+		if _, err := NewJWTService(JWTConfig{PublicKeyPEM: bundle}); err == nil {
+			t.Errorf("%s: NewJWTService() expected error, got nil", name)
+		}
+	}
+}
+
 func TestGenerateKeyPair(t *testing.T) {
 	privPEM, pubPEM, err := GenerateKeyPair()
 	if err != nil {
@@ -43,39 +43,83 @@ func UnaryAuthInterceptor(jwtService *JWTService, skipMethods []string) grpc.Una
 			return handler(ctx, req)
 		}
 
-		// Validate JWT service is configured.
-		if jwtService == nil {
-			return nil, status.Error(codes.Internal, "JWT service not configured")
-		}
-
-		// Extract the authorization token from metadata.
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		newCtx, err := authenticate(ctx, jwtService)
+		if err != nil {
+			return nil, err
 		}
+		return handler(newCtx, req)
+	}
+}
 
-		authHeader := md.Get("authorization")
-		if len(authHeader) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "missing authorization header")
-		}
+// StreamAuthInterceptor is UnaryAuthInterceptor for streaming RPCs: the
+// token is validated once, when the stream opens.
+func StreamAuthInterceptor(jwtService *JWTService, skipMethods []string) grpc.StreamServerInterceptor {
+	skipSet := make(map[string]struct{}, len(skipMethods))
+	for _, m := range skipMethods {
+		skipSet[m] = struct{}{}
+	}
 
-		tokenString := strings.TrimPrefix(authHeader[0], "Bearer ")
-		if tokenString == "" {
-			return nil, status.Error(codes.Unauthenticated, "empty token")
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if _, skip := skipSet[info.FullMethod]; skip {
+			return handler(srv, ss)
 		}
 
-		// Validate the token.
-		claims, err := jwtService.ValidateToken(tokenString)
+		ctx, err := authenticate(ss.Context(), jwtService)
 		if err != nil {
-			return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+			return err
 		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		// Attach claims to the context.
-		newCtx := context.WithValue(ctx, claimsContextKey, claims)
-		return handler(newCtx, req)
+// authenticate validates the bearer token in the call's metadata and
+// returns ctx with its claims attached.
+func authenticate(ctx context.Context, jwtService *JWTService) (context.Context, error) {
+	// Validate JWT service is configured.
+	if jwtService == nil {
+		return nil, status.Error(codes.Internal, "JWT service not configured")
+	}
+
+	// Extract the authorization token from metadata.
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	authHeader := md.Get("authorization")
+	if len(authHeader) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+	}
+
+	tokenString := strings.TrimPrefix(authHeader[0], "Bearer ")
+	if tokenString == "" {
+		return nil, status.Error(codes.Unauthenticated, "empty token")
+	}
+
+	// Validate the token.
+	claims, err := jwtService.ValidateTokenContext(ctx, tokenString)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
 	}
+
+	// Attach claims to the context.
+	return context.WithValue(ctx, claimsContextKey, claims), nil
 }
 
+// authenticatedStream is a server stream whose context carries the
+// caller's claims.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context { return s.ctx }
+
 // RequireRole returns a gRPC unary server interceptor that checks for a required role.
 func RequireRole(roles ...string) grpc.UnaryServerInterceptor {
 	return func(
@@ -0,0 +1,302 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrTokenRevoked is returned by ValidateToken for a token on the
+	// revocation list.
+	ErrTokenRevoked = errors.New("token revoked")
+	// ErrRevocationCheck is returned by ValidateToken when the revocation
+	// list could not be consulted. The token may be valid.
+	ErrRevocationCheck = errors.New("failed to check token revocation")
+	// ErrInvalidRefreshToken is returned for a refresh token that is
+	// unknown, expired or revoked.
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	// ErrRefreshTokenReused is returned for a refresh token that was already
+	// exchanged. Its session is revoked, as the token may have been stolen.
+	ErrRefreshTokenReused = errors.New("refresh token reused")
+)
+
+// DefaultRefreshTokenTTL is how long a refresh token stays valid when
+// Sessions is given no TTL.
+const DefaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+// RevocationList is the denylist of revoked token IDs (jti claims). An
+// entry is kept until the token it revokes expires.
+type RevocationList interface {
+	// Revoke adds jti to the list until expiresAt.
+	Revoke(ctx context.Context, jti string, expiresAt time.Time) error
+	// IsRevoked reports whether jti is on the list.
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+	// RevokeOnce adds jti to the list until expiresAt, reporting whether it
+	// was already on it. Of concurrent calls for the same jti, only one
+	// finds it absent.
+	RevokeOnce(ctx context.Context, jti string, expiresAt time.Time) (bool, error)
+}
+
+// RefreshToken is the stored state of a refresh token. Each token belongs
+// to a session: exchanging it for a new pair rotates it, marking it used,
+// and the new refresh token joins the same session.
+type RefreshToken struct {
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+	// Hash is the SHA-256 hash of the token, in hex; the token itself is
+	// not stored.
+	Hash      string
+	SessionID string
+	Roles     []string
+	UserID    uuid.UUID
+	TenantID  uuid.UUID
+	Used      bool
+	Revoked   bool
+}
+
+// RefreshTokenStore keeps refresh tokens.
+type RefreshTokenStore interface {
+	// Save stores a new refresh token.
+	Save(ctx context.Context, token RefreshToken) error
+	// Get returns the token with the given hash, or ErrInvalidRefreshToken
+	// when there is none.
+	Get(ctx context.Context, hash string) (*RefreshToken, error)
+	// Use marks the token with the given hash used, returning it as it was
+	// before, or ErrInvalidRefreshToken when there is none. Of concurrent
+	// calls for one token, only one sees it unused.
+	Use(ctx context.Context, hash string) (*RefreshToken, error)
+	// RevokeSession revokes every refresh token of the session.
+	RevokeSession(ctx context.Context, sessionID string) error
+}
+
+// TokenPair is an access token with the refresh token that renews it.
+type TokenPair struct {
+	AccessExpiresAt  time.Time
+	RefreshExpiresAt time.Time
+	AccessToken      string
+	RefreshToken     string
+	SessionID        string
+}
+
+// Sessions issues access tokens paired with refresh tokens. A refresh token
+// is single-use: exchanging it with Refresh rotates it, and presenting a
+// rotated token again revokes its whole session. Access tokens carry their
+// session's ID in the sid claim, so that revoking a session can revoke the
+// access token presented too.
+type Sessions struct {
+	jwt        *JWTService
+	store      RefreshTokenStore
+	now        func() time.Time
+	refreshTTL time.Duration
+}
+
+// NewSessions creates Sessions signing access tokens with jwt and keeping
+// refresh tokens, valid for refreshTTL (DefaultRefreshTokenTTL if zero), in
+// store.
+func NewSessions(jwt *JWTService, store RefreshTokenStore, refreshTTL time.Duration) *Sessions {
+	if refreshTTL <= 0 {
+		refreshTTL = DefaultRefreshTokenTTL
+	}
+	return &Sessions{jwt: jwt, store: store, refreshTTL: refreshTTL, now: time.Now}
+}
+
+// Issue starts a session for the given user, returning its first pair.
+func (s *Sessions) Issue(ctx context.Context, userID, tenantID uuid.UUID, roles []string) (TokenPair, error) {
+	return s.issue(ctx, uuid.NewString(), userID, tenantID, roles)
+}
+
+// Refresh exchanges a refresh token for a new pair in its session. The
+// token presented is used up.
+func (s *Sessions) Refresh(ctx context.Context, refreshToken string) (TokenPair, error) {
+	rt, err := s.store.Use(ctx, hashRefreshToken(refreshToken))
+	if err != nil {
+		return TokenPair{}, err
+	}
+	if rt.Revoked || !s.now().Before(rt.ExpiresAt) {
+		return TokenPair{}, ErrInvalidRefreshToken
+	}
+	if rt.Used {
+		if err := s.store.RevokeSession(ctx, rt.SessionID); err != nil {
+			return TokenPair{}, fmt.Errorf("failed to revoke session: %w", err)
+		}
+		return TokenPair{}, ErrRefreshTokenReused
+	}
+	return s.issue(ctx, rt.SessionID, rt.UserID, rt.TenantID, rt.Roles)
+}
+
+// Lookup returns the stored state of a refresh token, or
+// ErrInvalidRefreshToken when it is unknown.
+func (s *Sessions) Lookup(ctx context.Context, refreshToken string) (*RefreshToken, error) {
+	return s.store.Get(ctx, hashRefreshToken(refreshToken))
+}
+
+// RevokeSession revokes every refresh token of the session. Access tokens
+// already issued in it stay valid until they expire, unless revoked with
+// JWTService.Revoke.
+func (s *Sessions) RevokeSession(ctx context.Context, sessionID string) error {
+	if err := s.store.RevokeSession(ctx, sessionID); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return nil
+}
+
+func (s *Sessions) issue(ctx context.Context, sessionID string, userID, tenantID uuid.UUID, roles []string) (TokenPair, error) {
+	access, claims, err := s.jwt.generate(userID, tenantID, roles, sessionID)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	var raw [32]byte
+	if _, err := rand.Read(raw[:]); err != nil {
+		return TokenPair{}, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	refresh := base64.RawURLEncoding.EncodeToString(raw[:])
+	now := s.now()
+	rt := RefreshToken{
+		Hash:      hashRefreshToken(refresh),
+		SessionID: sessionID,
+		UserID:    userID,
+		TenantID:  tenantID,
+		Roles:     roles,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(s.refreshTTL),
+	}
+	if err := s.store.Save(ctx, rt); err != nil {
+		return TokenPair{}, fmt.Errorf("failed to save refresh token: %w", err)
+	}
+
+	return TokenPair{
+		AccessToken:      access,
+		AccessExpiresAt:  claims.ExpiresAt.Time,
+		RefreshToken:     refresh,
+		RefreshExpiresAt: rt.ExpiresAt,
+		SessionID:        sessionID,
+	}, nil
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// MemoryTokenStore keeps refresh tokens and the revocation list in memory.
+// They are not shared between replicas, so it suits single-replica
+// deployments and tests.
+type MemoryTokenStore struct {
+	now     func() time.Time
+	revoked map[string]time.Time
+	tokens  map[string]*RefreshToken
+	mu      sync.Mutex
+}
+
+// NewMemoryTokenStore creates an empty MemoryTokenStore.
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{
+		now:     time.Now,
+		revoked: make(map[string]time.Time),
+		tokens:  make(map[string]*RefreshToken),
+	}
+}
+
+// Revoke implements RevocationList.
+func (m *MemoryTokenStore) Revoke(_ context.Context, jti string, expiresAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.revoked[jti] = expiresAt
+	return nil
+}
+
+// IsRevoked implements RevocationList.
+func (m *MemoryTokenStore) IsRevoked(_ context.Context, jti string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.revoked[jti]
+	return ok, nil
+}
+
+// RevokeOnce implements RevocationList.
+func (m *MemoryTokenStore) RevokeOnce(_ context.Context, jti string, expiresAt time.Time) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.revoked[jti]; ok {
+		return true, nil
+	}
+	m.revoked[jti] = expiresAt
+	return false, nil
+}
+
+// Save implements RefreshTokenStore.
+func (m *MemoryTokenStore) Save(_ context.Context, token RefreshToken) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tokens[token.Hash] = &token
+	return nil
+}
+
+// Get implements RefreshTokenStore.
+func (m *MemoryTokenStore) Get(_ context.Context, hash string) (*RefreshToken, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	rt, ok := m.tokens[hash]
+	if !ok {
+		return nil, ErrInvalidRefreshToken
+	}
+	cp := *rt
+	return &cp, nil
+}
+
+// Use implements RefreshTokenStore.
+func (m *MemoryTokenStore) Use(_ context.Context, hash string) (*RefreshToken, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	rt, ok := m.tokens[hash]
+	if !ok {
+		return nil, ErrInvalidRefreshToken
+	}
+	before := *rt
+	rt.Used = true
+	return &before, nil
+}
+
+// RevokeSession implements RefreshTokenStore.
+func (m *MemoryTokenStore) RevokeSession(_ context.Context, sessionID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, rt := range m.tokens {
+		if rt.SessionID == sessionID {
+			rt.Revoked = true
+		}
+	}
+	return nil
+}
+
+// Purge deletes expired refresh tokens and revocations, returning how many
+// were deleted.
+func (m *MemoryTokenStore) Purge(_ context.Context) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	var n int64
+	for jti, expiresAt := range m.revoked {
+		if !now.Before(expiresAt) {
+			delete(m.revoked, jti)
+			n++
+		}
+	}
+	for hash, rt := range m.tokens {
+		if !now.Before(rt.ExpiresAt) {
+			delete(m.tokens, hash)
+			n++
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,178 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func newTestSessions(t *testing.T) (*Sessions, *JWTService, *MemoryTokenStore) {
+	t.Helper()
+	store := NewMemoryTokenStore()
+	svc, err := NewJWTService(JWTConfig{
+		Secret:      "test-secret-key-for-unit-tests",
+		Issuer:      "bib-test",
+		Expiration:  15 * time.Minute,
+		Revocations: store,
+	})
+	if err != nil {
+		t.Fatalf("NewJWTService() error = %v", err)
+	}
+	return NewSessions(svc, store, time.Hour), svc, store
+}
+
+func TestSessions_IssueAndRefresh(t *testing.T) {
+	sessions, svc, _ := newTestSessions(t)
+	ctx := context.Background()
+	userID, tenantID := uuid.New(), uuid.New()
+
+	pair, err := sessions.Issue(ctx, userID, tenantID, []string{RoleCustomer})
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	claims, err := svc.ValidateToken(pair.AccessToken)
+	if err != nil {
+		t.Fatalf("ValidateToken(access token) error = %v", err)
+	}
+	if claims.SessionID != pair.SessionID || claims.UserID != userID {
+		t.Errorf("claims = %+v, want user %s in session %s", claims, userID, pair.SessionID)
+	}
+
+	next, err := sessions.Refresh(ctx, pair.RefreshToken)
+	if err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if next.SessionID != pair.SessionID {
+		t.Errorf("refreshed session = %s, want %s", next.SessionID, pair.SessionID)
+	}
+	if next.RefreshToken == pair.RefreshToken {
+		t.Error("Refresh() did not rotate the refresh token")
+	}
+	claims, err = svc.ValidateToken(next.AccessToken)
+	if err != nil {
+		t.Fatalf("ValidateToken(refreshed token) error = %v", err)
+	}
+	if claims.TenantID != tenantID || !claims.HasRole(RoleCustomer) {
+		t.Errorf("refreshed claims = %+v, want the original tenant and roles", claims)
+	}
+}
+
+func TestSessions_ReuseRevokesSession(t *testing.T) {
+	sessions, _, _ := newTestSessions(t)
+	ctx := context.Background()
+
+	pair, err := sessions.Issue(ctx, uuid.New(), uuid.New(), []string{RoleCustomer})
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	next, err := sessions.Refresh(ctx, pair.RefreshToken)
+	if err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	if _, err := sessions.Refresh(ctx, pair.RefreshToken); !errors.Is(err, ErrRefreshTokenReused) {
+		t.Fatalf("Refresh(used token) error = %v, want ErrRefreshTokenReused", err)
+	}
+	// The reuse revoked the session, taking the rotated token with it.
+	if _, err := sessions.Refresh(ctx, next.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("Refresh(token of revoked session) error = %v, want ErrInvalidRefreshToken", err)
+	}
+}
+
+func TestSessions_RejectsUnknownAndExpiredTokens(t *testing.T) {
+	sessions, _, _ := newTestSessions(t)
+	ctx := context.Background()
+
+	if _, err := sessions.Refresh(ctx, "not-a-refresh-token"); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("Refresh(unknown token) error = %v, want ErrInvalidRefreshToken", err)
+	}
+
+	pair, err := sessions.Issue(ctx, uuid.New(), uuid.New(), nil)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	sessions.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	if _, err := sessions.Refresh(ctx, pair.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("Refresh(expired token) error = %v, want ErrInvalidRefreshToken", err)
+	}
+}
+
+func TestJWTService_Revoke(t *testing.T) {
+	_, svc, store := newTestSessions(t)
+	ctx := context.Background()
+
+	token, err := svc.GenerateToken(uuid.New(), uuid.New(), []string{RoleAdmin})
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+	claims, err := svc.ValidateToken(token)
+	if err != nil {
+		t.Fatalf("ValidateToken() error = %v", err)
+	}
+	if err := svc.Revoke(ctx, claims); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+	if _, err := svc.ValidateToken(token); !errors.Is(err, ErrTokenRevoked) {
+		t.Errorf("ValidateToken(revoked token) error = %v, want ErrTokenRevoked", err)
+	}
+
+	// The revocation is purged once the token has expired anyway.
+	store.now = func() time.Time { return claims.ExpiresAt.Add(time.Second) }
+	if n, _ := store.Purge(ctx); n != 1 {
+		t.Errorf("Purge() = %d, want 1", n)
+	}
+}
+
+func TestJWTService_Consume(t *testing.T) {
+	_, svc, _ := newTestSessions(t)
+	ctx := context.Background()
+
+	token, err := svc.GenerateToken(uuid.New(), uuid.New(), []string{RoleCustomer})
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+	claims, err := svc.ValidateToken(token)
+	if err != nil {
+		t.Fatalf("ValidateToken() error = %v", err)
+	}
+	if err := svc.Consume(ctx, claims); err != nil {
+		t.Fatalf("Consume() error = %v", err)
+	}
+	if err := svc.Consume(ctx, claims); !errors.Is(err, ErrTokenRevoked) {
+		t.Errorf("Consume(consumed token) error = %v, want ErrTokenRevoked", err)
+	}
+	if _, err := svc.ValidateToken(token); !errors.Is(err, ErrTokenRevoked) {
+		t.Errorf("ValidateToken(consumed token) error = %v, want ErrTokenRevoked", err)
+	}
+}
+
+type failingRevocations struct{}
+
+func (failingRevocations) Revoke(context.Context, string, time.Time) error { return nil }
+func (failingRevocations) RevokeOnce(context.Context, string, time.Time) (bool, error) {
+	return false, nil
+}
+func (failingRevocations) IsRevoked(context.Context, string) (bool, error) {
+	return false, errors.New("database unavailable")
+}
+
+func TestValidateToken_RevocationCheckFailure(t *testing.T) {
+	svc, err := NewJWTService(JWTConfig{
+		Secret:      "test-secret-key-for-unit-tests",
+		Expiration:  15 * time.Minute,
+		Revocations: failingRevocations{},
+	})
+	if err != nil {
+		t.Fatalf("NewJWTService() error = %v", err)
+	}
+	token, err := svc.GenerateToken(uuid.New(), uuid.New(), nil)
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+	if _, err := svc.ValidateToken(token); !errors.Is(err, ErrRevocationCheck) {
+		t.Errorf("ValidateToken() error = %v, want ErrRevocationCheck", err)
+	}
+}
@@ -0,0 +1,93 @@
+// Package capture records API traffic for debugging and replays it
+// elsewhere, for reproducing bugs that only show in production.
+//
+// Capture is opt-in: a Policy names the tenants and routes whose requests
+// are recorded, and nothing else is. Each request and its response are
+// sanitized before they leave the process: only allowlisted headers are
+// kept, sensitive query parameters and JSON body fields are replaced with
+// Redacted, and bodies that are not JSON, or too large, are dropped. A
+// Recorder batches the sanitized records into gzipped JSON Lines objects in
+// an archive.Store.
+//
+// Replay re-issues recorded requests against another environment, such as
+// staging, and reports where its responses differ from production's.
+package capture
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Record is one request the gateway served, and its response, sanitized.
+type Record struct {
+	CapturedAt time.Time `json:"captured_at"`
+	ID         string    `json:"id"`
+	TenantID   string    `json:"tenant_id,omitempty"`
+	Method     string    `json:"method"`
+	Path       string    `json:"path"`
+	// Query is the sanitized query string, without the leading "?".
+	Query      string  `json:"query,omitempty"`
+	Request    Message `json:"request"`
+	Response   Message `json:"response"`
+	Status     int     `json:"status"`
+	DurationMS int64   `json:"duration_ms"`
+}
+
+// Message is the sanitized headers and body of a request or response.
+type Message struct {
+	Header http.Header     `json:"header,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	// BodyOmitted says why a body was not kept, such as that it was not
+	// JSON. It is empty when the body was kept or there was none.
+	BodyOmitted string `json:"body_omitted,omitempty"`
+}
+
+// Policy selects the traffic to capture: requests of one of Tenants, or to
+// a path under one of Routes. A route is a path prefix, optionally preceded
+// by a method, such as "POST /api/v1/payments". An empty Policy captures
+// nothing.
+type Policy struct {
+	Tenants []string
+	Routes  []string
+}
+
+// Enabled reports whether the policy captures anything.
+func (p Policy) Enabled() bool {
+	return len(p.Tenants) > 0 || len(p.Routes) > 0
+}
+
+// Matches reports whether a request of tenantID, to method and path, is
+// captured.
+func (p Policy) Matches(tenantID, method, path string) bool {
+	for _, t := range p.Tenants {
+		if tenantID != "" && t == tenantID {
+			return true
+		}
+	}
+	for _, route := range p.Routes {
+		routeMethod, prefix, found := strings.Cut(route, " ")
+		if !found {
+			routeMethod, prefix = "", route
+		}
+		if routeMethod != "" && !strings.EqualFold(routeMethod, method) {
+			continue
+		}
+		if matchesPrefix(path, strings.TrimSpace(prefix)) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesPrefix reports whether path is prefix or lies under it, so
+// /api/v1/payments matches /api/v1/payments/123 but not
+// /api/v1/payments-export.
+func matchesPrefix(path, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		return true
+	}
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
@@ -0,0 +1,195 @@
+package capture
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bibbank/bib/pkg/archive"
+)
+
+func TestPolicy_Matches(t *testing.T) {
+	p := Policy{
+		Tenants: []string{"t-1"},
+		Routes:  []string{"POST /api/v1/payments", "/api/v1/accounts/"},
+	}
+	tests := []struct {
+		tenant, method, path string
+		want                 bool
+	}{
+		{"t-1", http.MethodGet, "/api/v1/fx/rates", true},
+		{"t-2", http.MethodPost, "/api/v1/payments", true},
+		{"t-2", http.MethodPost, "/api/v1/payments/p-1/cancel", true},
+		{"t-2", http.MethodGet, "/api/v1/payments", false},
+		{"t-2", http.MethodPost, "/api/v1/payments-export", false},
+		{"t-2", http.MethodGet, "/api/v1/accounts/a-1", true},
+		{"", http.MethodGet, "/api/v1/ledger/entries", false},
+	}
+	for _, tt := range tests {
+		if got := p.Matches(tt.tenant, tt.method, tt.path); got != tt.want {
+			t.Errorf("Matches(%q, %s %s) = %v, want %v", tt.tenant, tt.method, tt.path, got, tt.want)
+		}
+	}
+	if (Policy{}).Enabled() || (Policy{}).Matches("t-1", http.MethodGet, "/") {
+		t.Error("an empty policy captures traffic")
+	}
+}
+
+func TestSanitizer_Message(t *testing.T) {
+	var s Sanitizer
+	h := http.Header{}
+	h.Set("Content-Type", "application/json")
+	h.Set("Authorization", "Bearer secret-token")
+	h.Set("Cookie", "session=abc")
+	h.Set("Idempotency-Key", "key-1")
+	body := `{"amount":"10.00","card_number":"4111111111111111","holder":{"firstName":"Jane","pin":"1234"},` +
+		`"items":[{"email":"jane@example.com","shipping":"express"}],"note":null}`
+
+	msg := s.Message(h, []byte(body), false)
+	if msg.Header.Get("Authorization") != "" || msg.Header.Get("Cookie") != "" {
+		t.Errorf("credentials kept: %v", msg.Header)
+	}
+	if msg.Header.Get("Idempotency-Key") != "key-1" {
+		t.Errorf("Idempotency-Key = %q, want key-1", msg.Header.Get("Idempotency-Key"))
+	}
+	var got map[string]any
+	if err := json.Unmarshal(msg.Body, &got); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	want := map[string]any{
+		"amount":      "10.00",
+		"card_number": Redacted,
+		"holder":      map[string]any{"firstName": Redacted, "pin": Redacted},
+		"items":       []any{map[string]any{"email": Redacted, "shipping": "express"}},
+		"note":        nil,
+	}
+	if g, w := mustJSON(t, got), mustJSON(t, want); g != w {
+		t.Errorf("body = %s, want %s", g, w)
+	}
+
+	if msg := s.Message(http.Header{"Content-Type": {"text/csv"}}, []byte("a,b"), false); msg.Body != nil || msg.BodyOmitted != "not JSON" {
+		t.Errorf("CSV body: %+v", msg)
+	}
+	if msg := s.Message(h, []byte(`{"a":`), true); msg.Body != nil || !strings.HasPrefix(msg.BodyOmitted, "larger than") {
+		t.Errorf("truncated body: %+v", msg)
+	}
+	if q := s.Query(url.Values{"status": {"SETTLED"}, "email": {"jane@example.com"}}); q != "email=%5BREDACTED%5D&status=SETTLED" {
+		t.Errorf("query = %q", q)
+	}
+}
+
+func TestRecorder_WritesBatches(t *testing.T) {
+	dir := t.TempDir()
+	rec := NewRecorder(archive.NewFileStore(dir), RecorderConfig{BatchSize: 2}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	rec.now = func() time.Time { return time.Date(2026, 3, 10, 14, 15, 0, 0, time.UTC) }
+	for _, path := range []string{"/a", "/b", "/c"} {
+		rec.Record(Record{Method: http.MethodGet, Path: path, Status: http.StatusOK})
+	}
+	if err := rec.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	keys, err := filepath.Glob(filepath.Join(dir, "captures", "2026", "03", "10", "20260310T141500Z-*.jsonl.gz"))
+	if err != nil || len(keys) != 2 {
+		t.Fatalf("objects = %v, %v; want 2", keys, err)
+	}
+	var paths []string
+	for _, key := range keys {
+		f, err := os.Open(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		records, err := ReadRecords(f)
+		f.Close()
+		if err != nil {
+			t.Fatalf("ReadRecords: %v", err)
+		}
+		for _, r := range records {
+			if r.ID == "" {
+				t.Error("record written without an ID")
+			}
+			paths = append(paths, r.Path)
+		}
+	}
+	if len(paths) != 3 {
+		t.Errorf("records = %v, want /a, /b and /c", paths)
+	}
+}
+
+func TestRecorder_DropsBeyondMaxPending(t *testing.T) {
+	rec := NewRecorder(archive.NewFileStore(t.TempDir()), RecorderConfig{BatchSize: 1, MaxPending: 2}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	for range 5 {
+		rec.Record(Record{Method: http.MethodGet, Path: "/a"})
+	}
+	if len(rec.pending) != 2 || rec.dropped != 3 {
+		t.Errorf("pending %d, dropped %d; want 2 and 3", len(rec.pending), rec.dropped)
+	}
+}
+
+func TestReplayer_Replay(t *testing.T) {
+	var got *http.Request
+	var gotBody map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"error":"insufficient funds"}`))
+	}))
+	defer srv.Close()
+
+	p := NewReplayer(ReplayConfig{
+		Target:    srv.URL + "/",
+		Token:     "staging-token",
+		KeySuffix: "-replay-1",
+		Fill:      map[string]string{"account_number": "STAGING-0001"},
+	})
+	rec := Record{
+		Method: http.MethodPost,
+		Path:   "/api/v1/payments",
+		Query:  "dry_run=true",
+		Request: Message{
+			Header: http.Header{"Content-Type": {"application/json"}, "Idempotency-Key": {"key-1"}},
+			Body:   json.RawMessage(`{"amount":"10.00","account_number":"[REDACTED]","email":"[REDACTED]"}`),
+		},
+		Status: http.StatusCreated,
+	}
+	res := p.Replay(context.Background(), rec)
+	if res.Err != nil {
+		t.Fatalf("Replay: %v", res.Err)
+	}
+	if res.Matched() || res.Status != http.StatusUnprocessableEntity {
+		t.Errorf("status %d matched %v; want a 422 mismatch", res.Status, res.Matched())
+	}
+	if got.URL.Path != "/api/v1/payments" || got.URL.RawQuery != "dry_run=true" {
+		t.Errorf("replayed to %s", got.URL)
+	}
+	if got.Header.Get("Authorization") != "Bearer staging-token" || got.Header.Get("Idempotency-Key") != "key-1-replay-1" {
+		t.Errorf("headers = %v", got.Header)
+	}
+	if gotBody["account_number"] != "STAGING-0001" || gotBody["email"] != Redacted {
+		t.Errorf("body = %v", gotBody)
+	}
+
+	rec.Request = Message{BodyOmitted: "not JSON"}
+	if res := p.Replay(context.Background(), rec); res.Err == nil {
+		t.Error("replayed a request whose body was not captured")
+	}
+}
+
+func mustJSON(t *testing.T, v any) string {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
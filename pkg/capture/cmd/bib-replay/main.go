@@ -0,0 +1,145 @@
+// Command bib-replay re-issues API traffic the gateway captured against
+// another environment, for reproducing production-only bugs in staging.
+//
+// It reads the capture objects named on the command line, in order,
+// replays the requests matching the filters one at a time, and prints each
+// request with its recorded and replayed status, flagging those that
+// differ. -v also prints the replayed response bodies of the mismatches.
+//
+//	bib-replay -target https://staging.bib.example -token "$STAGING_TOKEN" \
+//	    -route /api/v1/payments -fill account_number=STAGING-0001 \
+//	    captures/2026/03/10/20260310T141500Z-5f2c9a1e0b7d4c36.jsonl.gz
+//
+// The store is configured like the gateway's capture: CAPTURE_S3_BUCKET
+// with CAPTURE_S3_ENDPOINT, CAPTURE_S3_REGION, CAPTURE_S3_ACCESS_KEY and
+// CAPTURE_S3_SECRET_KEY, or CAPTURE_DIR.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/pkg/archive"
+	"github.com/bibbank/bib/pkg/capture"
+)
+
+// fillFlag collects repeated -fill field=value flags.
+type fillFlag map[string]string
+
+func (f fillFlag) String() string { return fmt.Sprint(map[string]string(f)) }
+
+func (f fillFlag) Set(s string) error {
+	k, v, ok := strings.Cut(s, "=")
+	if !ok || k == "" {
+		return fmt.Errorf("want field=value, got %q", s)
+	}
+	f[k] = v
+	return nil
+}
+
+func main() {
+	fill := fillFlag{}
+	var (
+		target  = flag.String("target", "", "base URL of the environment to replay against")
+		token   = flag.String("token", os.Getenv("REPLAY_TOKEN"), "bearer token for the target environment")
+		tenant  = flag.String("tenant", "", "replay only this tenant's requests")
+		route   = flag.String("route", "", `replay only requests to this route, such as "POST /api/v1/payments"`)
+		timeout = flag.Duration("timeout", 30*time.Second, "timeout of each replayed request")
+		verbose = flag.Bool("v", false, "print the replayed response bodies of mismatches")
+	)
+	flag.Var(fill, "fill", "value for a redacted body field, as field=value; repeatable")
+	flag.Parse()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	if *target == "" || flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: bib-replay -target <url> [-token t] [-tenant id] [-route r] [-fill field=value] <key>...")
+		os.Exit(2)
+	}
+	store := archive.NewStore(archive.StoreConfig{
+		Dir:         os.Getenv("CAPTURE_DIR"),
+		S3Endpoint:  envOr("CAPTURE_S3_ENDPOINT", "https://s3.amazonaws.com"),
+		S3Region:    envOr("CAPTURE_S3_REGION", "us-east-1"),
+		S3Bucket:    os.Getenv("CAPTURE_S3_BUCKET"),
+		S3AccessKey: os.Getenv("CAPTURE_S3_ACCESS_KEY"),
+		S3SecretKey: os.Getenv("CAPTURE_S3_SECRET_KEY"),
+	})
+	if store == nil {
+		logger.Error("no capture store configured: set CAPTURE_S3_BUCKET or CAPTURE_DIR")
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	routes := capture.Policy{Routes: []string{*route}}
+	replayer := capture.NewReplayer(capture.ReplayConfig{
+		Client:    &http.Client{Timeout: *timeout},
+		Fill:      fill,
+		Target:    *target,
+		Token:     *token,
+		KeySuffix: "-replay-" + time.Now().UTC().Format("20060102T150405"),
+	})
+
+	var replayed, mismatched int
+	for _, key := range flag.Args() {
+		records, err := readObject(ctx, store, key)
+		if err != nil {
+			logger.Error("failed to read captured traffic", "key", key, "error", err)
+			os.Exit(1)
+		}
+		for _, rec := range records {
+			if ctx.Err() != nil {
+				os.Exit(1)
+			}
+			// Both filters must match when both are set.
+			if *tenant != "" && rec.TenantID != *tenant {
+				continue
+			}
+			if *route != "" && !routes.Matches("", rec.Method, rec.Path) {
+				continue
+			}
+			res := replayer.Replay(ctx, rec)
+			replayed++
+			switch {
+			case res.Err != nil:
+				mismatched++
+				fmt.Printf("ERROR    %s %s %s: %v\n", rec.ID, rec.Method, rec.Path, res.Err)
+			case !res.Matched():
+				mismatched++
+				fmt.Printf("MISMATCH %s %s %s: recorded %d, replayed %d\n", rec.ID, rec.Method, rec.Path, rec.Status, res.Status)
+				if *verbose {
+					fmt.Printf("         %s\n", res.Body)
+				}
+			default:
+				fmt.Printf("OK       %s %s %s: %d\n", rec.ID, rec.Method, rec.Path, res.Status)
+			}
+		}
+	}
+	fmt.Printf("%d replayed, %d differed\n", replayed, mismatched)
+	if mismatched > 0 {
+		os.Exit(1)
+	}
+}
+
+func readObject(ctx context.Context, store archive.Store, key string) ([]capture.Record, error) {
+	r, err := store.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return capture.ReadRecords(r)
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,26 @@
+module github.com/bibbank/bib/pkg/capture
+
+go 1.24
+
+require github.com/bibbank/bib/pkg/archive v0.0.0
+
+require (
+	github.com/bibbank/bib/pkg/postgres v0.0.0 // indirect
+	github.com/golang-migrate/migrate/v4 v4.18.1 // indirect
+	github.com/hashicorp/errwrap v1.1.0 // indirect
+	github.com/hashicorp/go-multierror v1.1.1 // indirect
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
+	github.com/jackc/pgx/v5 v5.7.2 // indirect
+	github.com/jackc/puddle/v2 v2.2.2 // indirect
+	github.com/lib/pq v1.10.9 // indirect
+	go.uber.org/atomic v1.7.0 // indirect
+	golang.org/x/crypto v0.31.0 // indirect
+	golang.org/x/sync v0.10.0 // indirect
+	golang.org/x/text v0.21.0 // indirect
+)
+
+replace (
+	github.com/bibbank/bib/pkg/archive => ../archive
+	github.com/bibbank/bib/pkg/postgres => ../postgres
+)
@@ -0,0 +1,177 @@
+package capture
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/bibbank/bib/pkg/archive"
+)
+
+// RecorderConfig configures a Recorder. Zero values take the defaults.
+type RecorderConfig struct {
+	// Prefix is the key prefix objects are written under; "captures" by
+	// default.
+	Prefix string
+	// BatchSize is the number of records written per object; 100 by
+	// default.
+	BatchSize int
+	// MaxPending bounds the records held while the store is slow or down;
+	// records beyond it are dropped. 10 batches by default.
+	MaxPending int
+	// FlushInterval is how often a partial batch is written; one minute by
+	// default.
+	FlushInterval time.Duration
+}
+
+// Recorder batches records into gzipped JSON Lines objects in a store, one
+// record per line, under keys of the form
+// <prefix>/2026/03/10/20260310T141500Z-<id>.jsonl.gz.
+//
+// Capture must never slow or fail the request it records, so Record only
+// queues; Run writes the queue in the background and drops records when it
+// falls too far behind.
+type Recorder struct {
+	store  archive.Store
+	logger *slog.Logger
+	now    func() time.Time
+	full   chan struct{}
+	cfg    RecorderConfig
+
+	mu      sync.Mutex
+	pending []Record
+	dropped int
+}
+
+// NewRecorder creates a Recorder writing to store.
+func NewRecorder(store archive.Store, cfg RecorderConfig, logger *slog.Logger) *Recorder {
+	if cfg.Prefix == "" {
+		cfg.Prefix = "captures"
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.MaxPending <= 0 {
+		cfg.MaxPending = 10 * cfg.BatchSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = time.Minute
+	}
+	return &Recorder{
+		store:  store,
+		logger: logger,
+		now:    time.Now,
+		full:   make(chan struct{}, 1),
+		cfg:    cfg,
+	}
+}
+
+// Record queues rec to be written, assigning its ID when it has none.
+func (r *Recorder) Record(rec Record) {
+	if rec.ID == "" {
+		rec.ID = newID()
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.pending) >= r.cfg.MaxPending {
+		r.dropped++
+		return
+	}
+	r.pending = append(r.pending, rec)
+	if len(r.pending) >= r.cfg.BatchSize {
+		select {
+		case r.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Run writes queued records every FlushInterval, and as soon as a batch is
+// full, until ctx is done. Records still queued then are written by Flush.
+func (r *Recorder) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.cfg.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-r.full:
+		}
+		if err := r.Flush(ctx); err != nil && ctx.Err() == nil {
+			r.logger.Error("failed to write captured traffic", "error", err)
+		}
+	}
+}
+
+// Flush writes every queued record. Records of a batch that fails to write
+// are put back to be retried, as far as MaxPending allows.
+func (r *Recorder) Flush(ctx context.Context) error {
+	for {
+		r.mu.Lock()
+		n := min(len(r.pending), r.cfg.BatchSize)
+		batch := r.pending[:n:n]
+		r.pending = r.pending[n:]
+		dropped := r.dropped
+		r.dropped = 0
+		r.mu.Unlock()
+
+		if dropped > 0 {
+			r.logger.Warn("dropped captured traffic, the store is falling behind", "records", dropped)
+		}
+		if n == 0 {
+			return nil
+		}
+		if err := r.write(ctx, batch); err != nil {
+			r.requeue(batch)
+			return err
+		}
+	}
+}
+
+func (r *Recorder) requeue(batch []Record) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	room := max(r.cfg.MaxPending-len(r.pending), 0)
+	if room < len(batch) {
+		r.dropped += len(batch) - room
+		batch = batch[:room]
+	}
+	r.pending = append(batch[:len(batch):len(batch)], r.pending...)
+}
+
+func (r *Recorder) write(ctx context.Context, batch []Record) error {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	enc := json.NewEncoder(zw)
+	for i := range batch {
+		if err := enc.Encode(&batch[i]); err != nil {
+			return fmt.Errorf("capture: encode record: %w", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("capture: compress batch: %w", err)
+	}
+
+	now := r.now().UTC()
+	key := fmt.Sprintf("%s/%s/%s-%s.jsonl.gz", r.cfg.Prefix, now.Format("2006/01/02"), now.Format("20060102T150405Z"), newID())
+	if err := r.store.Put(ctx, key, bytes.NewReader(buf.Bytes()), "application/gzip"); err != nil {
+		return fmt.Errorf("capture: write %s: %w", key, err)
+	}
+	r.logger.Debug("wrote captured traffic", "key", key, "records", len(batch))
+	return nil
+}
+
+// newID returns a random identifier for a record or an object.
+func newID() string {
+	var b [8]byte
+	_, _ = rand.Read(b[:]) //nolint:errcheck // crypto/rand.Read never fails
+	return hex.EncodeToString(b[:])
+}
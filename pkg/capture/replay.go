@@ -0,0 +1,181 @@
+package capture
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ReadRecords decodes a gzipped JSON Lines object a Recorder wrote.
+func ReadRecords(r io.Reader) ([]Record, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("capture: open batch: %w", err)
+	}
+	defer zr.Close()
+
+	var records []Record
+	sc := bufio.NewScanner(zr)
+	sc.Buffer(make([]byte, 0, 64<<10), 4*DefaultMaxBody)
+	for sc.Scan() {
+		if len(bytes.TrimSpace(sc.Bytes())) == 0 {
+			continue
+		}
+		var rec Record
+		if err := json.Unmarshal(sc.Bytes(), &rec); err != nil {
+			return nil, fmt.Errorf("capture: decode record: %w", err)
+		}
+		records = append(records, rec)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("capture: read batch: %w", err)
+	}
+	return records, nil
+}
+
+// ReplayConfig configures a Replayer.
+type ReplayConfig struct {
+	// Client sends the requests; http.DefaultClient when nil.
+	Client *http.Client
+	// Fill gives values for redacted body fields, by field name, such as a
+	// staging account number. Redacted fields without one are sent as
+	// Redacted.
+	Fill map[string]string
+	// Target is the base URL of the environment replayed against, such as
+	// https://staging.bib.example.
+	Target string
+	// Token is sent as the bearer token of every request: recorded
+	// credentials are never captured, and would not be valid there anyway.
+	Token string
+	// KeySuffix is appended to recorded Idempotency-Key headers, so
+	// replaying the same requests again is not answered with the responses
+	// of the previous replay.
+	KeySuffix string
+}
+
+// Result is the outcome of replaying a record.
+type Result struct {
+	Err error
+	// Body is the replayed response's body.
+	Body   []byte
+	Record Record
+	// Status is the replayed response's status; zero when the request
+	// failed.
+	Status int
+}
+
+// Matched reports whether the replayed response had the recorded status.
+func (r Result) Matched() bool {
+	return r.Err == nil && r.Status == r.Record.Status
+}
+
+// Replayer re-issues recorded requests against another environment.
+type Replayer struct {
+	cfg ReplayConfig
+}
+
+// NewReplayer creates a Replayer.
+func NewReplayer(cfg ReplayConfig) *Replayer {
+	if cfg.Client == nil {
+		cfg.Client = http.DefaultClient
+	}
+	cfg.Target = strings.TrimRight(cfg.Target, "/")
+	return &Replayer{cfg: cfg}
+}
+
+// Replay re-issues rec's request and returns the response.
+func (p *Replayer) Replay(ctx context.Context, rec Record) Result {
+	res := Result{Record: rec}
+	req, err := p.request(ctx, rec)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	resp, err := p.cfg.Client.Do(req)
+	if err != nil {
+		res.Err = fmt.Errorf("capture: replay %s %s: %w", rec.Method, rec.Path, err)
+		return res
+	}
+	defer resp.Body.Close()
+	res.Status = resp.StatusCode
+	res.Body, res.Err = io.ReadAll(io.LimitReader(resp.Body, int64(4*DefaultMaxBody)))
+	return res
+}
+
+func (p *Replayer) request(ctx context.Context, rec Record) (*http.Request, error) {
+	if rec.Request.BodyOmitted != "" {
+		return nil, fmt.Errorf("capture: %s %s cannot be replayed: request body was %s", rec.Method, rec.Path, rec.Request.BodyOmitted)
+	}
+	target := p.cfg.Target + rec.Path
+	if rec.Query != "" {
+		target += "?" + rec.Query
+	}
+	var body io.Reader
+	if len(rec.Request.Body) > 0 {
+		filled, err := p.fill(rec.Request.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(filled)
+	}
+	req, err := http.NewRequestWithContext(ctx, rec.Method, target, body)
+	if err != nil {
+		return nil, fmt.Errorf("capture: build request: %w", err)
+	}
+	for name, values := range rec.Request.Header {
+		for _, v := range values {
+			req.Header.Add(name, v)
+		}
+	}
+	if key := req.Header.Get("Idempotency-Key"); key != "" && p.cfg.KeySuffix != "" {
+		req.Header.Set("Idempotency-Key", key+p.cfg.KeySuffix)
+	}
+	if p.cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.cfg.Token)
+	}
+	return req, nil
+}
+
+// fill substitutes configured values for the redacted fields of body.
+func (p *Replayer) fill(body json.RawMessage) ([]byte, error) {
+	if len(p.cfg.Fill) == 0 {
+		return body, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("capture: decode request body: %w", err)
+	}
+	out, err := json.Marshal(fillValue(v, p.cfg.Fill))
+	if err != nil {
+		return nil, fmt.Errorf("capture: encode request body: %w", err)
+	}
+	return out, nil
+}
+
+func fillValue(v any, fill map[string]string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, field := range v {
+			if field == Redacted {
+				if value, ok := fill[k]; ok {
+					v[k] = value
+				}
+				continue
+			}
+			v[k] = fillValue(field, fill)
+		}
+	case []any:
+		for i, elem := range v {
+			v[i] = fillValue(elem, fill)
+		}
+	}
+	return v
+}
@@ -0,0 +1,195 @@
+package capture
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Redacted replaces the value of every sensitive field.
+const Redacted = "[REDACTED]"
+
+// DefaultMaxBody is the largest body kept, in bytes.
+const DefaultMaxBody = 64 << 10
+
+// defaultHeaders are the headers kept by default. Credentials, cookies and
+// client addresses are never among them.
+var defaultHeaders = []string{
+	"Accept",
+	"Content-Type",
+	"Idempotency-Key",
+	"Location",
+	"User-Agent",
+	"X-Request-Id",
+}
+
+// sensitiveSubstrings mark a field as sensitive wherever they appear in its
+// normalized name, so card_number and cardNumber are both redacted.
+var sensitiveSubstrings = []string{
+	"password", "passphrase", "secret", "token", "apikey", "authorization",
+	"cardnumber", "cvv", "cvc", "ssn", "taxid", "nationalid", "iban",
+	"accountnumber", "routingnumber", "dateofbirth", "birthdate", "email",
+	"phone", "firstname", "lastname", "fullname", "holdername", "address",
+}
+
+// sensitiveNames mark a field as sensitive only when they are its whole
+// normalized name: as substrings they would match innocent fields, such as
+// pin in shipping.
+var sensitiveNames = []string{"pin", "pan", "dob", "otp"}
+
+// Sanitizer strips what must not be stored from captured traffic.
+type Sanitizer struct {
+	// Headers are the headers kept; all others are dropped. Nil keeps the
+	// defaults.
+	Headers []string
+	// MaxBody is the largest body kept, in bytes; zero means
+	// DefaultMaxBody.
+	MaxBody int
+}
+
+// MaxBodyBytes returns the largest body kept.
+func (s Sanitizer) MaxBodyBytes() int {
+	if s.MaxBody > 0 {
+		return s.MaxBody
+	}
+	return DefaultMaxBody
+}
+
+// Header returns the allowlisted headers of h.
+func (s Sanitizer) Header(h http.Header) http.Header {
+	keep := s.Headers
+	if keep == nil {
+		keep = defaultHeaders
+	}
+	var out http.Header
+	for _, name := range keep {
+		if v := h.Values(name); len(v) > 0 {
+			if out == nil {
+				out = make(http.Header)
+			}
+			out[http.CanonicalHeaderKey(name)] = append([]string(nil), v...)
+		}
+	}
+	return out
+}
+
+// Query returns the query string with the values of sensitive parameters
+// redacted.
+func (s Sanitizer) Query(q url.Values) string {
+	if len(q) == 0 {
+		return ""
+	}
+	out := make(url.Values, len(q))
+	for k, vs := range q {
+		if sensitive(k) {
+			redacted := make([]string, len(vs))
+			for i := range redacted {
+				redacted[i] = Redacted
+			}
+			out[k] = redacted
+			continue
+		}
+		out[k] = vs
+	}
+	return out.Encode()
+}
+
+// Message sanitizes a request's or response's headers and body. truncated
+// reports that body was cut at MaxBodyBytes.
+func (s Sanitizer) Message(h http.Header, body []byte, truncated bool) Message {
+	msg := Message{Header: s.Header(h)}
+	switch {
+	case len(body) == 0:
+	case truncated:
+		msg.BodyOmitted = fmt.Sprintf("larger than %d bytes", s.MaxBodyBytes())
+	case !isJSON(h.Get("Content-Type")):
+		msg.BodyOmitted = "not JSON"
+	default:
+		clean, err := redactJSON(body)
+		if err != nil {
+			msg.BodyOmitted = "malformed JSON"
+			break
+		}
+		msg.Body = clean
+	}
+	return msg
+}
+
+// isJSON reports whether a content type is JSON. Bodies without one are
+// assumed to be, as the API accepts no other.
+func isJSON(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// redactJSON returns body with the values of its sensitive fields, at any
+// depth, replaced with Redacted.
+func redactJSON(body []byte) (json.RawMessage, error) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, field := range v {
+			if sensitive(k) && field != nil {
+				v[k] = Redacted
+				continue
+			}
+			v[k] = redactValue(field)
+		}
+	case []any:
+		for i, elem := range v {
+			v[i] = redactValue(elem)
+		}
+	}
+	return v
+}
+
+// sensitive reports whether a field or parameter name holds a secret or
+// personal data.
+func sensitive(name string) bool {
+	n := normalize(name)
+	for _, s := range sensitiveNames {
+		if n == s {
+			return true
+		}
+	}
+	for _, s := range sensitiveSubstrings {
+		if strings.Contains(n, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize lowercases name and drops separators.
+func normalize(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '_', '-', '.', ' ':
+			return -1
+		}
+		return r
+	}, strings.ToLower(name))
+}
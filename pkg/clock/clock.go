@@ -0,0 +1,60 @@
+// Package clock provides the current time to domain services and
+// schedulers, so that tests and the sandbox can control it.
+//
+// Services read the time from a Clock injected at construction rather than
+// calling time.Now: System in production, and a Manual clock in tests that
+// need to step through month-long lifecycles without waiting for them.
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the current time.
+type Clock interface {
+	Now() time.Time
+}
+
+// Func adapts a function to a Clock.
+type Func func() time.Time
+
+// Now calls f.
+func (f Func) Now() time.Time { return f() }
+
+// System is the wall clock, in UTC.
+var System Clock = Func(func() time.Time { return time.Now().UTC() })
+
+// Manual is a clock that only moves when it is set or advanced. It is safe
+// for concurrent use.
+type Manual struct {
+	now time.Time
+	mu  sync.Mutex
+}
+
+// NewManual creates a Manual clock reading now.
+func NewManual(now time.Time) *Manual {
+	return &Manual{now: now}
+}
+
+// Now returns the clock's time.
+func (m *Manual) Now() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.now
+}
+
+// Set moves the clock to now.
+func (m *Manual) Set(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.now = now
+}
+
+// Advance moves the clock forward by d and returns the new time.
+func (m *Manual) Advance(d time.Duration) time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.now = m.now.Add(d)
+	return m.now
+}
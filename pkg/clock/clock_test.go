@@ -0,0 +1,34 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSystem_ReadsWallClockInUTC(t *testing.T) {
+	before := time.Now()
+	now := System.Now()
+	if now.Location() != time.UTC {
+		t.Errorf("System.Now() location = %v, want UTC", now.Location())
+	}
+	if now.Before(before.Add(-time.Second)) || now.After(time.Now().Add(time.Second)) {
+		t.Errorf("System.Now() = %v, not the wall clock", now)
+	}
+}
+
+func TestManual_MovesOnlyWhenTold(t *testing.T) {
+	start := time.Date(2026, time.January, 31, 9, 0, 0, 0, time.UTC)
+	c := NewManual(start)
+	if got := c.Now(); !got.Equal(start) {
+		t.Fatalf("Now() = %v, want %v", got, start)
+	}
+
+	if got := c.Advance(24 * time.Hour); !got.Equal(start.AddDate(0, 0, 1)) {
+		t.Errorf("Advance(24h) = %v, want %v", got, start.AddDate(0, 0, 1))
+	}
+	later := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	c.Set(later)
+	if got := c.Now(); !got.Equal(later) {
+		t.Errorf("Now() after Set = %v, want %v", got, later)
+	}
+}
@@ -0,0 +1,78 @@
+// Package config provides feature flags and settings that change at runtime,
+// so behaviour such as the fraud service's ML scoring can be toggled without
+// a restart. A Provider keeps a snapshot of settings loaded from a Source
+// (PostgreSQL, Redis or a watched file), refreshes it on an interval and
+// notifies subscribers of what changed.
+//
+// A setting has a default and optional per-tenant overrides. Typed accessors
+// resolve the override for the tenant in the request context, then the
+// default, then the caller's fallback, so a missing or malformed value never
+// fails a request.
+package config
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// ErrInvalidSetting is returned by sources for a setting that cannot be
+// loaded, such as one without a key.
+var ErrInvalidSetting = errors.New("invalid setting")
+
+// Setting is one value of a setting: its default when TenantID is nil, or
+// the override for one tenant.
+type Setting struct {
+	TenantID *uuid.UUID
+	Key      string
+	Value    string
+}
+
+// Source loads every setting.
+type Source interface {
+	// Load returns the current settings. A setting repeated for the same
+	// key and tenant takes its last value.
+	Load(ctx context.Context) ([]Setting, error)
+}
+
+// Change describes a setting whose value changed on refresh. Old is empty
+// for a new setting and New is empty for a removed one, as Removed reports.
+type Change struct {
+	TenantID *uuid.UUID
+	Key      string
+	Old      string
+	New      string
+	Removed  bool
+}
+
+// StaticSource is a Source of fixed settings, such as defaults carried over
+// from environment variables or settings in tests.
+type StaticSource []Setting
+
+// Load implements Source.
+func (s StaticSource) Load(context.Context) ([]Setting, error) {
+	return s, nil
+}
+
+type tenantKey struct{}
+
+// ContextWithTenant scopes settings read with ctx to tenantID, for work such
+// as event consumers that runs without the caller's claims.
+func ContextWithTenant(ctx context.Context, tenantID uuid.UUID) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantID)
+}
+
+// tenantFromContext returns the tenant settings are read for: the one set
+// with ContextWithTenant, else the authenticated caller's.
+func tenantFromContext(ctx context.Context) (uuid.UUID, bool) {
+	if id, ok := ctx.Value(tenantKey{}).(uuid.UUID); ok {
+		return id, true
+	}
+	if claims, ok := auth.ClaimsFromContext(ctx); ok {
+		return claims.TenantID, true
+	}
+	return uuid.Nil, false
+}
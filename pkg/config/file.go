@@ -0,0 +1,113 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FileSource loads settings from a JSON file, such as a mounted Kubernetes
+// ConfigMap:
+//
+//	{
+//	  "defaults": {"fraud.ml.enabled": false, "fraud.ml.threshold": 0.8},
+//	  "tenants": {
+//	    "6f1c...": {"fraud.ml.enabled": true}
+//	  }
+//	}
+//
+// Values may be strings, numbers or booleans. The file is only parsed again
+// once its modification time or size changes, so polling it is cheap.
+type FileSource struct {
+	modTime  time.Time
+	path     string
+	settings []Setting
+	size     int64
+	mu       sync.Mutex
+}
+
+// NewFileSource creates a new FileSource reading path.
+func NewFileSource(path string) *FileSource {
+	return &FileSource{path: path}
+}
+
+type fileDocument struct {
+	Defaults map[string]json.RawMessage            `json:"defaults"`
+	Tenants  map[string]map[string]json.RawMessage `json:"tenants"`
+}
+
+// Load implements Source.
+func (s *FileSource) Load(context.Context) ([]Setting, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("load settings: %w", err)
+	}
+	if s.settings != nil && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return s.settings, nil
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("load settings: %w", err)
+	}
+	settings, err := parseDocument(data)
+	if err != nil {
+		return nil, fmt.Errorf("load settings from %s: %w", s.path, err)
+	}
+	s.settings, s.modTime, s.size = settings, info.ModTime(), info.Size()
+	return settings, nil
+}
+
+func parseDocument(data []byte) ([]Setting, error) {
+	var doc fileDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	settings := make([]Setting, 0, len(doc.Defaults))
+	for key, raw := range doc.Defaults {
+		value, err := scalar(key, raw)
+		if err != nil {
+			return nil, err
+		}
+		settings = append(settings, Setting{Key: key, Value: value})
+	}
+	for tenant, values := range doc.Tenants {
+		tenantID, err := uuid.Parse(tenant)
+		if err != nil {
+			return nil, fmt.Errorf("%w: tenant %q is not a UUID", ErrInvalidSetting, tenant)
+		}
+		for key, raw := range values {
+			value, err := scalar(key, raw)
+			if err != nil {
+				return nil, err
+			}
+			settings = append(settings, Setting{TenantID: &tenantID, Key: key, Value: value})
+		}
+	}
+	return settings, nil
+}
+
+// scalar returns a JSON string's text, or a number's or boolean's literal.
+func scalar(key string, raw json.RawMessage) (string, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) > 0 && raw[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return "", err
+		}
+		return s, nil
+	}
+	if len(raw) == 0 || raw[0] == '{' || raw[0] == '[' || string(raw) == "null" {
+		return "", fmt.Errorf("%w: %s must be a string, number or boolean", ErrInvalidSetting, key)
+	}
+	return string(raw), nil
+}
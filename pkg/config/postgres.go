@@ -0,0 +1,64 @@
+package config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DefaultTable is the table PostgresSource reads when none is given.
+const DefaultTable = "config_settings"
+
+// PostgresSource loads settings from a table in the service's database,
+// created by the service's migrations as:
+//
+//	CREATE TABLE config_settings (
+//	    key        TEXT NOT NULL,
+//	    tenant_id  UUID,
+//	    value      TEXT NOT NULL,
+//	    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+//	);
+//	CREATE UNIQUE INDEX config_settings_scope
+//	    ON config_settings (key, COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'));
+//
+// A row without a tenant_id is the setting's default.
+type PostgresSource struct {
+	pool  *pgxpool.Pool
+	query string
+}
+
+// NewPostgresSource creates a new PostgresSource reading table, or
+// DefaultTable when table is empty.
+func NewPostgresSource(pool *pgxpool.Pool, table string) *PostgresSource {
+	if table == "" {
+		table = DefaultTable
+	}
+	return &PostgresSource{
+		pool:  pool,
+		query: `SELECT key, tenant_id, value FROM ` + pgx.Identifier{table}.Sanitize(),
+	}
+}
+
+// Load implements Source.
+func (s *PostgresSource) Load(ctx context.Context) ([]Setting, error) {
+	rows, err := s.pool.Query(ctx, s.query)
+	if err != nil {
+		return nil, fmt.Errorf("load settings: %w", err)
+	}
+	defer rows.Close()
+
+	var settings []Setting
+	for rows.Next() {
+		var st Setting
+		if err := rows.Scan(&st.Key, &st.TenantID, &st.Value); err != nil {
+			return nil, fmt.Errorf("scan setting: %w", err)
+		}
+		settings = append(settings, st)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("load settings: %w", err)
+	}
+	return settings, nil
+}
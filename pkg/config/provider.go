@@ -0,0 +1,236 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const defaultRefreshInterval = 30 * time.Second
+
+// ProviderConfig configures a Provider.
+type ProviderConfig struct {
+	// RefreshInterval is how often Run reloads the source. Defaults to
+	// thirty seconds.
+	RefreshInterval time.Duration
+}
+
+// scope identifies a setting's value: its key and, for an override, the
+// tenant.
+type scope struct {
+	key    string
+	tenant uuid.UUID
+}
+
+type subscription struct {
+	fn  func(Change)
+	key string
+	id  int
+}
+
+// Provider serves settings from a snapshot of its source. Reads never touch
+// the source, so they are cheap enough for every request; the snapshot is
+// replaced by Refresh, which Run calls on an interval.
+type Provider struct {
+	source   Source
+	logger   *slog.Logger
+	settings map[scope]string
+	subs     []subscription
+	interval time.Duration
+	nextSub  int
+	mu       sync.RWMutex
+	subMu    sync.Mutex
+}
+
+// NewProvider creates a new Provider for source. Its snapshot is empty until
+// the first Refresh. logger may be nil.
+func NewProvider(source Source, cfg ProviderConfig, logger *slog.Logger) *Provider {
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = defaultRefreshInterval
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Provider{
+		source:   source,
+		logger:   logger.With("component", "config"),
+		settings: make(map[scope]string),
+		interval: cfg.RefreshInterval,
+	}
+}
+
+// Refresh reloads the source and notifies subscribers of every setting that
+// changed. On error the previous snapshot is kept.
+func (p *Provider) Refresh(ctx context.Context) error {
+	loaded, err := p.source.Load(ctx)
+	if err != nil {
+		return err
+	}
+	next := make(map[scope]string, len(loaded))
+	for _, s := range loaded {
+		next[scopeOf(s.Key, s.TenantID)] = s.Value
+	}
+
+	p.mu.Lock()
+	prev := p.settings
+	p.settings = next
+	p.mu.Unlock()
+
+	p.notify(diff(prev, next))
+	return nil
+}
+
+// Run refreshes the snapshot until ctx is cancelled. A failed refresh is
+// logged and the previous snapshot kept, so a source outage leaves settings
+// as they were rather than reverting them to defaults.
+func (p *Provider) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.Refresh(ctx); err != nil && ctx.Err() == nil {
+				p.logger.Warn("failed to refresh settings", "error", err)
+			}
+		}
+	}
+}
+
+// Subscribe calls fn with every change to key, for any tenant, after each
+// refresh; an empty key subscribes to every setting. fn runs on the
+// refreshing goroutine and must not block. The returned function cancels
+// the subscription.
+func (p *Provider) Subscribe(key string, fn func(Change)) (cancel func()) {
+	p.subMu.Lock()
+	defer p.subMu.Unlock()
+	p.nextSub++
+	id := p.nextSub
+	p.subs = append(p.subs, subscription{id: id, key: key, fn: fn})
+	return func() {
+		p.subMu.Lock()
+		defer p.subMu.Unlock()
+		for i, s := range p.subs {
+			if s.id == id {
+				p.subs = append(p.subs[:i:i], p.subs[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Lookup returns key's value for the tenant in ctx: its override when it
+// has one, else the default.
+func (p *Provider) Lookup(ctx context.Context, key string) (string, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if tenant, ok := tenantFromContext(ctx); ok {
+		if v, ok := p.settings[scope{key: key, tenant: tenant}]; ok {
+			return v, true
+		}
+	}
+	v, ok := p.settings[scope{key: key}]
+	return v, ok
+}
+
+// String returns key's value, or def when it is not set.
+func (p *Provider) String(ctx context.Context, key, def string) string {
+	if v, ok := p.Lookup(ctx, key); ok {
+		return v
+	}
+	return def
+}
+
+// Bool returns key's value as a bool, or def when it is not set or not a
+// bool.
+func (p *Provider) Bool(ctx context.Context, key string, def bool) bool {
+	return lookupAs(p, ctx, key, def, strconv.ParseBool)
+}
+
+// Int returns key's value as an int, or def when it is not set or not an
+// int.
+func (p *Provider) Int(ctx context.Context, key string, def int) int {
+	return lookupAs(p, ctx, key, def, strconv.Atoi)
+}
+
+// Float returns key's value as a float64, or def when it is not set or not
+// a number.
+func (p *Provider) Float(ctx context.Context, key string, def float64) float64 {
+	return lookupAs(p, ctx, key, def, func(v string) (float64, error) {
+		return strconv.ParseFloat(v, 64)
+	})
+}
+
+// Duration returns key's value as a duration such as "15m", or def when it
+// is not set or not a duration.
+func (p *Provider) Duration(ctx context.Context, key string, def time.Duration) time.Duration {
+	return lookupAs(p, ctx, key, def, time.ParseDuration)
+}
+
+// lookupAs parses key's value, falling back to def. A malformed value is
+// logged so the mistake is visible without failing the caller.
+func lookupAs[T any](p *Provider, ctx context.Context, key string, def T, parse func(string) (T, error)) T {
+	v, ok := p.Lookup(ctx, key)
+	if !ok {
+		return def
+	}
+	parsed, err := parse(v)
+	if err != nil {
+		p.logger.Warn("malformed setting, using default", "key", key, "value", v, "error", err)
+		return def
+	}
+	return parsed
+}
+
+func (p *Provider) notify(changes []Change) {
+	if len(changes) == 0 {
+		return
+	}
+	p.subMu.Lock()
+	subs := append([]subscription(nil), p.subs...)
+	p.subMu.Unlock()
+
+	for _, c := range changes {
+		for _, s := range subs {
+			if s.key == "" || s.key == c.Key {
+				s.fn(c)
+			}
+		}
+	}
+}
+
+func scopeOf(key string, tenantID *uuid.UUID) scope {
+	if tenantID == nil {
+		return scope{key: key}
+	}
+	return scope{key: key, tenant: *tenantID}
+}
+
+// diff lists the settings added, changed or removed between two snapshots.
+func diff(prev, next map[scope]string) []Change {
+	var changes []Change
+	for sc, v := range next {
+		if old, ok := prev[sc]; !ok || old != v {
+			changes = append(changes, Change{TenantID: sc.tenantID(), Key: sc.key, Old: old, New: v})
+		}
+	}
+	for sc, old := range prev {
+		if _, ok := next[sc]; !ok {
+			changes = append(changes, Change{TenantID: sc.tenantID(), Key: sc.key, Old: old, Removed: true})
+		}
+	}
+	return changes
+}
+
+func (s scope) tenantID() *uuid.UUID {
+	if s.tenant == uuid.Nil {
+		return nil
+	}
+	id := s.tenant
+	return &id
+}
@@ -0,0 +1,182 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// mutableSource is a Source whose settings tests replace between refreshes.
+type mutableSource struct {
+	err      error
+	settings []Setting
+}
+
+func (s *mutableSource) Load(context.Context) ([]Setting, error) {
+	return s.settings, s.err
+}
+
+func TestProvider_TenantOverridesDefault(t *testing.T) {
+	tenant := uuid.New()
+	p := NewProvider(StaticSource{
+		{Key: "fraud.ml.enabled", Value: "false"},
+		{Key: "fraud.ml.enabled", TenantID: &tenant, Value: "true"},
+		{Key: "fraud.ml.threshold", Value: "not-a-number"},
+	}, ProviderConfig{}, nil)
+	if err := p.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	if p.Bool(context.Background(), "fraud.ml.enabled", true) {
+		t.Error("default = true, want false")
+	}
+	if !p.Bool(ContextWithTenant(context.Background(), tenant), "fraud.ml.enabled", false) {
+		t.Error("tenant override = false, want true")
+	}
+	claimsCtx := auth.ContextWithClaims(context.Background(), &auth.Claims{TenantID: tenant})
+	if !p.Bool(claimsCtx, "fraud.ml.enabled", false) {
+		t.Error("override for the caller's tenant = false, want true")
+	}
+	if p.Bool(ContextWithTenant(context.Background(), uuid.New()), "fraud.ml.enabled", true) {
+		t.Error("another tenant should read the default")
+	}
+	if got := p.Float(context.Background(), "fraud.ml.threshold", 0.8); got != 0.8 {
+		t.Errorf("malformed Float() = %v, want fallback 0.8", got)
+	}
+	if got := p.Duration(context.Background(), "missing", time.Minute); got != time.Minute {
+		t.Errorf("missing Duration() = %v, want fallback 1m", got)
+	}
+}
+
+func TestProvider_NotifiesChanges(t *testing.T) {
+	src := &mutableSource{settings: []Setting{
+		{Key: "a", Value: "1"},
+		{Key: "b", Value: "x"},
+	}}
+	p := NewProvider(src, ProviderConfig{}, nil)
+	if err := p.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	var all, onlyA []Change
+	p.Subscribe("", func(c Change) { all = append(all, c) })
+	cancel := p.Subscribe("a", func(c Change) { onlyA = append(onlyA, c) })
+
+	src.settings = []Setting{{Key: "a", Value: "2"}, {Key: "c", Value: "y"}}
+	if err := p.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Key < all[j].Key })
+	want := []Change{
+		{Key: "a", Old: "1", New: "2"},
+		{Key: "b", Old: "x", Removed: true},
+		{Key: "c", New: "y"},
+	}
+	if len(all) != len(want) {
+		t.Fatalf("changes = %+v, want %+v", all, want)
+	}
+	for i := range want {
+		if all[i] != want[i] {
+			t.Errorf("change %d = %+v, want %+v", i, all[i], want[i])
+		}
+	}
+	if len(onlyA) != 1 || onlyA[0].New != "2" {
+		t.Errorf("key subscription got %+v, want the change to a", onlyA)
+	}
+
+	cancel()
+	src.settings = []Setting{{Key: "a", Value: "3"}}
+	if err := p.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if len(onlyA) != 1 {
+		t.Errorf("cancelled subscription still notified: %+v", onlyA)
+	}
+}
+
+func TestProvider_KeepsSnapshotWhenSourceFails(t *testing.T) {
+	src := &mutableSource{settings: []Setting{{Key: "a", Value: "on"}}}
+	p := NewProvider(src, ProviderConfig{}, nil)
+	if err := p.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+
+	src.settings, src.err = nil, errors.New("connection refused")
+	if err := p.Refresh(context.Background()); err == nil {
+		t.Fatal("Refresh() error = nil, want the source's error")
+	}
+	if got := p.String(context.Background(), "a", "off"); got != "on" {
+		t.Errorf("String() = %q, want the previous value", got)
+	}
+}
+
+func TestFileSource_ReloadsWhenFileChanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings.json")
+	tenant := uuid.New()
+	write := func(body string, modTime time.Time) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	start := time.Now().Add(-time.Hour)
+	write(`{"defaults":{"fraud.ml.enabled":false,"fraud.ml.threshold":0.8,"fraud.model":"v2"},`+
+		`"tenants":{"`+tenant.String()+`":{"fraud.ml.enabled":true}}}`, start)
+
+	p := NewProvider(NewFileSource(path), ProviderConfig{}, nil)
+	if err := p.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	ctx := ContextWithTenant(context.Background(), tenant)
+	if !p.Bool(ctx, "fraud.ml.enabled", false) {
+		t.Error("tenant override = false, want true")
+	}
+	if got := p.Float(ctx, "fraud.ml.threshold", 0); got != 0.8 {
+		t.Errorf("Float() = %v, want 0.8", got)
+	}
+	if got := p.String(ctx, "fraud.model", ""); got != "v2" {
+		t.Errorf("String() = %q, want v2", got)
+	}
+
+	write(`{"defaults":{"fraud.ml.enabled":true}}`, start.Add(time.Minute))
+	if err := p.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if !p.Bool(context.Background(), "fraud.ml.enabled", false) {
+		t.Error("default after reload = false, want true")
+	}
+	if _, ok := p.Lookup(ctx, "fraud.model"); ok {
+		t.Error("removed setting still set after reload")
+	}
+
+	write(`{"defaults":{"fraud.ml.enabled":{"nested":true}}}`, start.Add(2*time.Minute))
+	if err := p.Refresh(context.Background()); !errors.Is(err, ErrInvalidSetting) {
+		t.Errorf("Refresh() error = %v, want ErrInvalidSetting", err)
+	}
+}
+
+func TestParseField(t *testing.T) {
+	tenant := uuid.New()
+	st, err := parseField(tenant.String() + "/fraud.ml.enabled")
+	if err != nil || st.TenantID == nil || *st.TenantID != tenant || st.Key != "fraud.ml.enabled" {
+		t.Errorf("parseField(override) = %+v, %v", st, err)
+	}
+	st, err = parseField("fraud.ml.enabled")
+	if err != nil || st.TenantID != nil || st.Key != "fraud.ml.enabled" {
+		t.Errorf("parseField(default) = %+v, %v", st, err)
+	}
+	if _, err := parseField("acme/fraud.ml.enabled"); !errors.Is(err, ErrInvalidSetting) {
+		t.Errorf("parseField(bad tenant) error = %v, want ErrInvalidSetting", err)
+	}
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// DefaultRedisKey is the hash RedisSource reads when none is given.
+const DefaultRedisKey = "config:settings"
+
+// RedisClient is the part of a Redis client the source uses. Services adapt
+// their client library to it.
+type RedisClient interface {
+	// HGetAll returns every field of the hash at key.
+	HGetAll(ctx context.Context, key string) (map[string]string, error)
+}
+
+// RedisSource loads settings from a Redis hash shared by every replica. A
+// field named after a setting's key holds its default; a field named
+// "<tenant-id>/<key>" holds a tenant's override.
+type RedisSource struct {
+	client RedisClient
+	key    string
+}
+
+// NewRedisSource creates a new RedisSource reading the hash at key, or
+// DefaultRedisKey when key is empty.
+func NewRedisSource(client RedisClient, key string) *RedisSource {
+	if key == "" {
+		key = DefaultRedisKey
+	}
+	return &RedisSource{client: client, key: key}
+}
+
+// Load implements Source.
+func (s *RedisSource) Load(ctx context.Context) ([]Setting, error) {
+	fields, err := s.client.HGetAll(ctx, s.key)
+	if err != nil {
+		return nil, fmt.Errorf("load settings: %w", err)
+	}
+	settings := make([]Setting, 0, len(fields))
+	for field, value := range fields {
+		st, err := parseField(field)
+		if err != nil {
+			return nil, err
+		}
+		st.Value = value
+		settings = append(settings, st)
+	}
+	return settings, nil
+}
+
+// parseField splits a hash field into the setting's tenant and key.
+func parseField(field string) (Setting, error) {
+	prefix, key, found := strings.Cut(field, "/")
+	if !found {
+		return Setting{Key: field}, nil
+	}
+	tenantID, err := uuid.Parse(prefix)
+	if err != nil || key == "" {
+		return Setting{}, fmt.Errorf("%w: field %q is not <tenant-id>/<key>", ErrInvalidSetting, field)
+	}
+	return Setting{TenantID: &tenantID, Key: key}, nil
+}
@@ -0,0 +1,64 @@
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// rawCodec passes JSON through untouched, so stubs and Verify see exactly
+// the bytes on the wire. Its name matches the services' JSON codec, so calls
+// carry the same content subtype as the gateway's.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v any) ([]byte, error) {
+	switch m := v.(type) {
+	case json.RawMessage:
+		return m, nil
+	case *json.RawMessage:
+		return *m, nil
+	}
+	return nil, fmt.Errorf("contract: cannot marshal %T as raw JSON", v)
+}
+
+func (rawCodec) Unmarshal(data []byte, v any) error {
+	m, ok := v.(*json.RawMessage)
+	if !ok {
+		return fmt.Errorf("contract: cannot unmarshal raw JSON into %T", v)
+	}
+	*m = append((*m)[:0], data...)
+	return nil
+}
+
+func (rawCodec) Name() string { return "json" }
+
+// strictCodec is the services' JSON codec, except that it rejects fields
+// the handler's request type does not know. A consumer sending a field the
+// provider silently drops is the drift contract tests exist to catch.
+//
+// Generated messages are encoded with their zero-valued fields, which a
+// proto3 decoder reads back as set, so a contract can rely on a field whose
+// example value is zero. Stubs encode the consumer's generated requests with
+// it too, so the contract records them as protojson.
+type strictCodec struct{}
+
+func (strictCodec) Marshal(v any) ([]byte, error) {
+	if m, ok := v.(proto.Message); ok {
+		return protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(m)
+	}
+	return json.Marshal(v)
+}
+
+func (strictCodec) Unmarshal(data []byte, v any) error {
+	if m, ok := v.(proto.Message); ok {
+		return protojson.Unmarshal(data, m)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+func (strictCodec) Name() string { return "json" }
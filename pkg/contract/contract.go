@@ -0,0 +1,198 @@
+// Package contract implements consumer-driven contract tests for the gRPC
+// calls the gateway makes, whether with generated messages or hand-written
+// JSON ones.
+//
+// The consumer's tests drive its code against a Stub, which answers each
+// call with a canned response and records the request the consumer sent.
+// Stub.Check compares the recorded interactions with the contract file
+// committed under contracts/, so a change to what the consumer sends or
+// reads fails its tests until the file is regenerated with
+// UPDATE_CONTRACTS=1 (make contracts).
+//
+// The provider's tests replay the same file against the service's real
+// handler with Verify: every request must decode without unknown fields,
+// and every response must carry each field the consumer reads, with the same
+// JSON type. Together the two halves stop the structs duplicated on either
+// side of the wire from drifting apart unnoticed.
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// UpdateEnv is the environment variable that makes Stub.Check write the
+// recorded contract instead of comparing against it.
+const UpdateEnv = "UPDATE_CONTRACTS"
+
+// Contract is the set of interactions a consumer relies on from a provider.
+type Contract struct {
+	Consumer     string        `json:"consumer"`
+	Provider     string        `json:"provider"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is one gRPC call: the request the consumer sends and the
+// response, or error code, it handles.
+type Interaction struct {
+	// Description names the interaction, such as "open a checking account".
+	// It is unique within a contract.
+	Description string `json:"description"`
+	// State is the provider state the call needs, such as "an active
+	// account exists". The provider's Verify call sets it up.
+	State string `json:"state,omitempty"`
+	// Method is the full gRPC method name.
+	Method string `json:"method"`
+	// Request is the JSON the consumer sends. Stubs record it.
+	Request json.RawMessage `json:"request,omitempty"`
+	// Response holds the fields the consumer reads from the response, with
+	// example values. Providers must return each of them.
+	Response json.RawMessage `json:"response,omitempty"`
+	// Code is the gRPC status code name, such as "NotFound", of a call that
+	// fails. Empty means the call succeeds.
+	Code string `json:"code,omitempty"`
+}
+
+// Load reads a contract file.
+func Load(path string) (*Contract, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read contract: %w", err)
+	}
+	var c Contract
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parse contract %s: %w", path, err)
+	}
+	return &c, nil
+}
+
+// Write writes the contract to path with its JSON normalized, so the file
+// only changes when an interaction does.
+func (c *Contract) Write(path string) error {
+	data, err := c.encode()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create contract directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil { //nolint:gosec // contracts are committed source files
+		return fmt.Errorf("write contract: %w", err)
+	}
+	return nil
+}
+
+func (c *Contract) encode() ([]byte, error) {
+	normalized := *c
+	normalized.Interactions = make([]Interaction, len(c.Interactions))
+	for i, in := range c.Interactions {
+		var err error
+		if in.Request, err = normalize(in.Request); err != nil {
+			return nil, fmt.Errorf("interaction %q request: %w", in.Description, err)
+		}
+		if in.Response, err = normalize(in.Response); err != nil {
+			return nil, fmt.Errorf("interaction %q response: %w", in.Description, err)
+		}
+		normalized.Interactions[i] = in
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(normalized); err != nil {
+		return nil, fmt.Errorf("encode contract: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// normalize re-encodes raw JSON compactly with sorted object keys.
+func normalize(raw json.RawMessage) (json.RawMessage, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// Match reports how got fails to satisfy want, by type rather than value:
+// every field of a want object must be present in got with the same JSON
+// type, and every element of a got array must match the first element of
+// the want array. A null in want matches anything, including a missing
+// field. It returns nil when got satisfies want.
+func Match(want, got json.RawMessage) []string {
+	var w, g any
+	if err := json.Unmarshal(want, &w); err != nil {
+		return []string{fmt.Sprintf("contract: %v", err)}
+	}
+	if err := json.Unmarshal(got, &g); err != nil {
+		return []string{fmt.Sprintf("response: %v", err)}
+	}
+	var problems []string
+	match("$", w, g, &problems)
+	return problems
+}
+
+func match(path string, want, got any, problems *[]string) {
+	if want == nil {
+		return
+	}
+	if kind(want) != kind(got) {
+		*problems = append(*problems, fmt.Sprintf("%s: want %s, got %s", path, kind(want), kind(got)))
+		return
+	}
+	switch w := want.(type) {
+	case map[string]any:
+		g := got.(map[string]any)
+		keys := make([]string, 0, len(w))
+		for k := range w {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			v, ok := g[k]
+			if !ok && w[k] != nil {
+				*problems = append(*problems, fmt.Sprintf("%s.%s: missing", path, k))
+				continue
+			}
+			match(path+"."+k, w[k], v, problems)
+		}
+	case []any:
+		g := got.([]any)
+		if len(w) == 0 {
+			return
+		}
+		if len(g) == 0 {
+			*problems = append(*problems, fmt.Sprintf("%s: want at least one element", path))
+			return
+		}
+		for i, v := range g {
+			match(fmt.Sprintf("%s[%d]", path, i), w[0], v, problems)
+		}
+	}
+}
+
+func kind(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "bool"
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", v), "*")
+}
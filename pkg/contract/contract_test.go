@@ -0,0 +1,180 @@
+package contract
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/apipb"
+)
+
+func TestMatch(t *testing.T) {
+	want := json.RawMessage(`{"id":"a","count":1,"items":[{"name":"x"}],"note":null}`)
+	cases := []struct {
+		name string
+		got  string
+		want []string
+	}{
+		{"extra fields and other values match", `{"id":"b","count":7,"items":[{"name":"y","size":2}],"more":true}`, nil},
+		{"missing field", `{"count":1,"items":[{"name":"x"}]}`, []string{"$.id: missing"}},
+		{"wrong type", `{"id":"a","count":"1","items":[{"name":"x"}]}`, []string{"$.count: want number, got string"}},
+		{"every element is checked", `{"id":"a","count":1,"items":[{"name":"x"},{}]}`, []string{"$.items[1].name: missing"}},
+		{"empty array", `{"id":"a","count":1,"items":[]}`, []string{"$.items: want at least one element"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Match(want, json.RawMessage(tc.got))
+			if strings.Join(got, "; ") != strings.Join(tc.want, "; ") {
+				t.Errorf("Match() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+// greetRequest and greetResponse are a provider's hand-written messages.
+type greetRequest struct {
+	Name string `json:"name"`
+}
+
+type greetResponse struct {
+	Greeting string `json:"greeting"`
+}
+
+var greeterDesc = grpc.ServiceDesc{
+	ServiceName: "bib.test.v1.Greeter",
+	HandlerType: (*any)(nil),
+	Methods: []grpc.MethodDesc{{
+		MethodName: "Greet",
+		Handler: func(_ any, ctx context.Context, dec func(any) error, _ grpc.UnaryServerInterceptor) (any, error) {
+			var req greetRequest
+			if err := dec(&req); err != nil {
+				return nil, err
+			}
+			return &greetResponse{Greeting: "hello " + req.Name}, nil
+		},
+	}},
+}
+
+// greet is the consumer: it sends a request of its own shape.
+func greet(conn grpc.ClientConnInterface, req any) (map[string]any, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	var resp json.RawMessage
+	if err := conn.Invoke(context.Background(), "/bib.test.v1.Greeter/Greet", json.RawMessage(data), &resp,
+		grpc.ForceCodecCallOption{Codec: rawCodec{}}); err != nil {
+		return nil, err
+	}
+	var out map[string]any
+	return out, json.Unmarshal(resp, &out)
+}
+
+func TestStubAndVerify(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "consumer-greeter.json")
+	t.Setenv(UpdateEnv, "1")
+
+	record := func(t *testing.T, req any) {
+		stub := NewStub(t, "consumer", "greeter")
+		stub.Given(Interaction{
+			Description: "greet someone",
+			Method:      "/bib.test.v1.Greeter/Greet",
+			Response:    json.RawMessage(`{"greeting":"hello ada"}`),
+		})
+		resp, err := greet(stub.Conn(), req)
+		if err != nil {
+			t.Fatalf("greet() error = %v", err)
+		}
+		if resp["greeting"] != "hello ada" {
+			t.Fatalf("greeting = %v, want the stubbed response", resp["greeting"])
+		}
+		stub.Check(path)
+	}
+	provider := Serve(t, func(s *grpc.Server) { s.RegisterService(&greeterDesc, nil) })
+
+	t.Run("provider honours the contract", func(t *testing.T) {
+		record(t, map[string]string{"name": "ada"})
+		Verify(t, provider, path, nil)
+	})
+
+	t.Run("provider rejects a renamed field", func(t *testing.T) {
+		record(t, map[string]string{"full_name": "ada"})
+		c, err := Load(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		in := c.Interactions[0]
+		var resp json.RawMessage
+		err = provider.Invoke(context.Background(), in.Method, in.Request, &resp, grpc.ForceCodecCallOption{Codec: rawCodec{}})
+		if err == nil || !strings.Contains(err.Error(), "unknown field") {
+			t.Fatalf("Invoke() error = %v, want an unknown field error", err)
+		}
+	})
+}
+
+func TestStubCheck_DetectsDrift(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "consumer-greeter.json")
+	c := Contract{Consumer: "consumer", Provider: "greeter", Interactions: []Interaction{{
+		Description: "greet someone",
+		Method:      "/bib.test.v1.Greeter/Greet",
+		Request:     json.RawMessage(`{"name":"ada"}`),
+		Response:    json.RawMessage(`{"greeting":"hello ada"}`),
+	}}}
+	if err := c.Write(path); err != nil {
+		t.Fatal(err)
+	}
+
+	stub := NewStub(t, "consumer", "greeter")
+	stub.Given(Interaction{
+		Description: "greet someone",
+		Method:      "/bib.test.v1.Greeter/Greet",
+		Response:    json.RawMessage(`{"greeting":"hello ada"}`),
+	})
+	if _, err := greet(stub.Conn(), map[string]string{"name": "ada"}); err != nil {
+		t.Fatal(err)
+	}
+	recorded, err := stub.contract.encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	committed, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := committed.encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(recorded) != string(want) {
+		t.Fatalf("recorded contract differs from the committed one:\n%s\n%s", recorded, want)
+	}
+
+	stub.contract.Interactions[0].Request = json.RawMessage(`{"full_name":"ada"}`)
+	if recorded, _ := stub.contract.encode(); string(recorded) == string(want) {
+		t.Fatal("a changed request encodes the same as the committed contract")
+	}
+}
+
+func TestStub_GeneratedMessages(t *testing.T) {
+	stub := NewStub(t, "consumer", "greeter")
+	stub.Given(Interaction{
+		Description: "greet someone",
+		Method:      "/bib.test.v1.Greeter/Greet",
+		Response:    json.RawMessage(`{"name":"hello ada"}`),
+	})
+
+	var resp apipb.Mixin
+	if err := stub.Conn().Invoke(context.Background(), "/bib.test.v1.Greeter/Greet", &apipb.Mixin{Name: "ada"}, &resp); err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+	if resp.GetName() != "hello ada" {
+		t.Fatalf("name = %q, want the stubbed response", resp.GetName())
+	}
+	// A zero-valued field is recorded, so providers are checked against it.
+	if got, _ := normalize(stub.contract.Interactions[0].Request); string(got) != `{"name":"ada","root":""}` {
+		t.Fatalf("recorded request = %s, want the message as protojson", got)
+	}
+}
@@ -0,0 +1,149 @@
+package contract
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Stub stands in for a provider in the consumer's tests. It answers each
+// call with the next interaction given for its method and records the
+// request the consumer sent.
+type Stub struct {
+	t        *testing.T
+	conn     *grpc.ClientConn
+	mu       sync.Mutex
+	contract Contract
+	called   []bool
+}
+
+// NewStub starts a stub provider for the test. Calls on its connection are
+// sent as JSON, generated messages included, so the contract records what
+// the consumer sends. The stub stops when the test ends.
+func NewStub(t *testing.T, consumer, provider string) *Stub {
+	t.Helper()
+	s := &Stub{t: t, contract: Contract{Consumer: consumer, Provider: provider}}
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(
+		grpc.ForceServerCodec(rawCodec{}),
+		grpc.UnknownServiceHandler(s.handle),
+	)
+	go func() { _ = srv.Serve(lis) }()
+
+	conn, err := grpc.NewClient("passthrough:///"+provider,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodecCallOption{Codec: strictCodec{}}))
+	if err != nil {
+		t.Fatalf("contract: dial stub: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+		srv.Stop()
+	})
+	s.conn = conn
+	return s
+}
+
+// Conn returns a connection to the stub, for the consumer's client.
+func (s *Stub) Conn() *grpc.ClientConn {
+	return s.conn
+}
+
+// Given adds an interaction the consumer is expected to make. Its Request
+// is filled in from the call.
+func (s *Stub) Given(in Interaction) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	in.Request = nil
+	s.contract.Interactions = append(s.contract.Interactions, in)
+	s.called = append(s.called, false)
+}
+
+func (s *Stub) handle(_ any, stream grpc.ServerStream) error {
+	method, _ := grpc.MethodFromServerStream(stream)
+	var req json.RawMessage
+	if err := stream.RecvMsg(&req); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	var in *Interaction
+	for i := range s.contract.Interactions {
+		if !s.called[i] && s.contract.Interactions[i].Method == method {
+			s.called[i] = true
+			in = &s.contract.Interactions[i]
+			in.Request = append(json.RawMessage(nil), req...)
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	if in == nil {
+		s.t.Errorf("contract: unexpected call to %s with %s", method, req)
+		return status.Errorf(codes.Unimplemented, "no interaction given for %s", method)
+	}
+	if in.Code != "" {
+		return status.Errorf(parseCode(in.Code), "%s", in.Description)
+	}
+	if len(in.Response) == 0 {
+		return stream.SendMsg(json.RawMessage("{}"))
+	}
+	return stream.SendMsg(in.Response)
+}
+
+// Check fails the test if a given interaction was never made or the
+// recorded contract differs from the one at path. With UPDATE_CONTRACTS set
+// it writes the recorded contract to path instead.
+func (s *Stub) Check(path string) {
+	s.t.Helper()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, in := range s.contract.Interactions {
+		if !s.called[i] {
+			s.t.Errorf("contract: interaction %q was never called", in.Description)
+		}
+	}
+	if s.t.Failed() {
+		return
+	}
+
+	if os.Getenv(UpdateEnv) != "" {
+		if err := s.contract.Write(path); err != nil {
+			s.t.Fatalf("contract: %v", err)
+		}
+		return
+	}
+	recorded, err := s.contract.encode()
+	if err != nil {
+		s.t.Fatalf("contract: %v", err)
+	}
+	committed, err := os.ReadFile(path)
+	if err != nil {
+		s.t.Fatalf("contract: %v; run make contracts to create it", err)
+	}
+	if string(recorded) != string(committed) {
+		s.t.Errorf("contract: %s is out of date with the consumer; run make contracts and review the diff.\nrecorded:\n%s", path, recorded)
+	}
+}
+
+// parseCode returns the code named name, such as "NotFound", or Unknown.
+func parseCode(name string) codes.Code {
+	for c := codes.OK; c <= codes.Unauthenticated; c++ {
+		if c.String() == name {
+			return c
+		}
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,99 @@
+package contract
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Serve starts an in-process gRPC server for the provider's verification,
+// with register adding the service's handlers, and returns a connection to
+// it. Requests are decoded strictly, so fields the handler does not know
+// fail the call. opts typically add an interceptor putting caller claims in
+// the context. The server stops when the test ends.
+func Serve(t *testing.T, register func(*grpc.Server), opts ...grpc.ServerOption) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(append([]grpc.ServerOption{grpc.ForceServerCodec(strictCodec{})}, opts...)...)
+	register(srv)
+	go func() { _ = srv.Serve(lis) }()
+
+	conn, err := grpc.NewClient("passthrough:///provider",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("contract: dial provider: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+		srv.Stop()
+	})
+	return conn
+}
+
+// Verify replays every interaction of the contract at path against conn,
+// each as a subtest. Before an interaction runs, the function states maps
+// its State to sets the provider up; an interaction whose state has no
+// function fails. states[""], if present, runs before interactions without
+// a state, to reset whatever earlier states set up.
+func Verify(t *testing.T, conn grpc.ClientConnInterface, path string, states map[string]func(t *testing.T)) {
+	t.Helper()
+
+	c, err := Load(path)
+	if err != nil {
+		t.Fatalf("contract: %v", err)
+	}
+	for _, in := range c.Interactions {
+		t.Run(in.Description, func(t *testing.T) {
+			setup, ok := states[in.State]
+			switch {
+			case ok:
+				setup(t)
+			case in.State != "":
+				t.Fatalf("contract: no setup for provider state %q", in.State)
+			}
+			verify(t, conn, c.Consumer, in)
+		})
+	}
+}
+
+func verify(t *testing.T, conn grpc.ClientConnInterface, consumer string, in Interaction) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := in.Request
+	if len(req) == 0 {
+		req = json.RawMessage("{}")
+	}
+	var resp json.RawMessage
+	err := conn.Invoke(ctx, in.Method, req, &resp, grpc.ForceCodecCallOption{Codec: rawCodec{}})
+
+	code := status.Code(err).String()
+	if in.Code != "" {
+		if code != in.Code {
+			t.Fatalf("%s: %s expects code %s, got %s (%v)", in.Method, consumer, in.Code, code, err)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("%s: %s expects success, got %v", in.Method, consumer, err)
+	}
+	if len(in.Response) == 0 {
+		return
+	}
+	if problems := Match(in.Response, resp); len(problems) > 0 {
+		t.Fatalf("%s: response does not satisfy %s:\n  %s\nresponse: %s",
+			in.Method, consumer, strings.Join(problems, "\n  "), resp)
+	}
+}
@@ -0,0 +1,200 @@
+// Package cron parses standard five-field cron expressions and finds the
+// times they fire.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxCronSearch bounds the search for the next run time. A schedule such as
+// "0 0 30 2 *" never fires; every valid one fires within four years.
+const maxCronSearch = 5 * 366 * 24 * time.Hour
+
+var cronAliases = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = [5]cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 6},
+}
+
+// Schedule is a standard five-field cron expression (minute, hour, day of
+// month, month, day of week) evaluated in UTC. Fields accept *, single
+// values, ranges, lists and steps such as "*/15" or "1-5"; a day of week of
+// 7 is Sunday. As in cron, when both day fields are restricted a day
+// matching either one fires. The zero Schedule never fires.
+type Schedule struct {
+	expr          string
+	minute        uint64
+	hour          uint64
+	dom           uint64
+	month         uint64
+	dow           uint64
+	domRestricted bool
+	dowRestricted bool
+}
+
+// Parse parses a cron expression or one of the aliases @yearly, @monthly,
+// @weekly, @daily and @hourly.
+func Parse(expr string) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	spec := expr
+	if alias, ok := cronAliases[spec]; ok {
+		spec = alias
+	}
+	parts := strings.Fields(spec)
+	if len(parts) != len(cronFields) {
+		return Schedule{}, fmt.Errorf("cron expression %q must have 5 fields", expr)
+	}
+
+	var bits [5]uint64
+	for i, part := range parts {
+		f := cronFields[i]
+		if i == 4 {
+			f.max = 7
+		}
+		b, err := parseCronField(part, f)
+		if err != nil {
+			return Schedule{}, fmt.Errorf("cron expression %q: %w", expr, err)
+		}
+		bits[i] = b
+	}
+	// Sunday may be written as 0 or 7.
+	if bits[4]&(1<<7) != 0 {
+		bits[4] = bits[4]&^(1<<7) | 1
+	}
+
+	return Schedule{
+		expr:          expr,
+		minute:        bits[0],
+		hour:          bits[1],
+		dom:           bits[2],
+		month:         bits[3],
+		dow:           bits[4],
+		domRestricted: !strings.HasPrefix(parts[2], "*"),
+		dowRestricted: !strings.HasPrefix(parts[4], "*"),
+	}, nil
+}
+
+func parseCronField(field string, f cronField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			s, err := strconv.Atoi(stepPart)
+			if err != nil || s <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field", stepPart, f.name)
+			}
+			step = s
+		}
+
+		lo, hi := f.min, f.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			a, b, _ := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = cronValue(a, f); err != nil {
+				return 0, err
+			}
+			if hi, err = cronValue(b, f); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range %q in %s field", rangePart, f.name)
+			}
+		default:
+			v, err := cronValue(rangePart, f)
+			if err != nil {
+				return 0, err
+			}
+			lo = v
+			if !hasStep {
+				hi = v
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+func cronValue(s string, f cronField) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s value %q must be between %d and %d", f.name, s, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Next returns the first time the schedule fires strictly after t, in UTC.
+// It returns the zero time if the schedule never fires, such as on
+// February 30th.
+func (c Schedule) Next(t time.Time) time.Time {
+	if c.IsZero() {
+		return time.Time{}
+	}
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxCronSearch)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (c Schedule) dayMatches(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domRestricted && c.dowRestricted {
+		return domMatch || dowMatch
+	}
+	return domMatch && dowMatch
+}
+
+// String returns the expression the schedule was parsed from.
+func (c Schedule) String() string {
+	return c.expr
+}
+
+// IsZero returns true if the Schedule has not been set.
+func (c Schedule) IsZero() bool {
+	return c.expr == ""
+}
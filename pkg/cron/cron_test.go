@@ -0,0 +1,40 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSchedule_Next(t *testing.T) {
+	tests := []struct {
+		expr  string
+		after time.Time
+		want  time.Time
+	}{
+		{"*/15 * * * *", time.Date(2026, 3, 10, 10, 7, 30, 0, time.UTC), time.Date(2026, 3, 10, 10, 15, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", time.Date(2026, 3, 13, 10, 0, 0, 0, time.UTC), time.Date(2026, 3, 16, 9, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2026, 12, 15, 0, 0, 0, 0, time.UTC), time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}},
+	}
+	for _, tt := range tests {
+		s, err := Parse(tt.expr)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tt.expr, err)
+		}
+		if got := s.Next(tt.after); !got.Equal(tt.want) {
+			t.Errorf("%q.Next(%v) = %v, want %v", tt.expr, tt.after, got, tt.want)
+		}
+	}
+	if !(Schedule{}).Next(time.Now()).IsZero() {
+		t.Error("zero Schedule fires")
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "@fortnightly"} {
+		if _, err := Parse(expr); err == nil {
+			t.Errorf("Parse(%q) succeeded, want an error", expr)
+		}
+	}
+}
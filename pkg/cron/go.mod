@@ -0,0 +1,3 @@
+module github.com/bibbank/bib/pkg/cron
+
+go 1.24
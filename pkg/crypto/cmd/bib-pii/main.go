@@ -0,0 +1,139 @@
+// Command bib-pii manages the data keys personal data is encrypted under in
+// a service database.
+//
+// rotate generates a new data key version for a tenant, or for every
+// tenant with a key, which new values are then sealed under. reencrypt
+// seals a table's encrypted columns under their tenants' current keys:
+// run it after rotate to move values off the old version, and once after a
+// column is first encrypted to seal the values written before.
+//
+//	bib-pii rotate -tenant 5b0e...
+//	bib-pii reencrypt -table payment_orders -columns routing_number,external_account_number
+//	bib-pii reencrypt -table account_holders -columns email \
+//		-tenant "(SELECT tenant_id FROM customer_accounts WHERE id = account_id)"
+//
+// The KMS is configured like the services': PII_KMS_KEY_ID with
+// PII_KMS_ENDPOINT, PII_KMS_REGION, PII_KMS_ACCESS_KEY and
+// PII_KMS_SECRET_KEY, or PII_MASTER_KEY. The database is DATABASE_URL.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/pkg/crypto"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	cmd := os.Args[1]
+	fs := flag.NewFlagSet(cmd, flag.ExitOnError)
+	var (
+		dsn     = fs.String("database-url", os.Getenv("DATABASE_URL"), "service database")
+		tenant  = fs.String("tenant", "", "rotate: tenant to rotate, or every tenant if empty; reencrypt: column or SQL expression giving each row's tenant")
+		table   = fs.String("table", "", "table to re-encrypt")
+		key     = fs.String("key", "id", "UUID column unique within the table")
+		columns = fs.String("columns", "", "comma-separated encrypted columns")
+		batch   = fs.Int("batch", 500, "rows re-encrypted per batch")
+	)
+	_ = fs.Parse(os.Args[2:]) //nolint:errcheck // ExitOnError
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	kms, err := crypto.NewKMS(crypto.KMSConfig{
+		MasterKey:    os.Getenv("PII_MASTER_KEY"),
+		AWSKeyID:     os.Getenv("PII_KMS_KEY_ID"),
+		AWSEndpoint:  os.Getenv("PII_KMS_ENDPOINT"),
+		AWSRegion:    envOr("PII_KMS_REGION", "us-east-1"),
+		AWSAccessKey: os.Getenv("PII_KMS_ACCESS_KEY"),
+		AWSSecretKey: os.Getenv("PII_KMS_SECRET_KEY"),
+	})
+	switch {
+	case err != nil:
+		logger.Error("invalid KMS configuration", "error", err)
+		os.Exit(2)
+	case kms == nil:
+		logger.Error("no KMS configured: set PII_KMS_KEY_ID or PII_MASTER_KEY")
+		os.Exit(2)
+	case *dsn == "":
+		logger.Error("no database: set -database-url or DATABASE_URL")
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	pool, err := pgxpool.New(ctx, *dsn)
+	if err != nil {
+		logger.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	defer pool.Close()
+	store := crypto.NewPostgresKeyStore(pool)
+	cipher := crypto.NewCipher(kms, store)
+
+	switch cmd {
+	case "rotate":
+		var tenants []uuid.UUID
+		if *tenant != "" {
+			id, parseErr := uuid.Parse(*tenant)
+			if parseErr != nil {
+				logger.Error("-tenant must be a tenant ID", "error", parseErr)
+				os.Exit(2)
+			}
+			tenants = []uuid.UUID{id}
+		} else if tenants, err = store.Tenants(ctx); err != nil {
+			break
+		}
+		for _, id := range tenants {
+			var version int
+			if version, err = cipher.Rotate(ctx, id); err != nil {
+				break
+			}
+			fmt.Printf("%s\tv%d\n", id, version)
+		}
+	case "reencrypt":
+		if *table == "" || *columns == "" {
+			logger.Error("-table and -columns are required")
+			os.Exit(2)
+		}
+		tenantExpr := *tenant
+		if tenantExpr == "" {
+			tenantExpr = "tenant_id"
+		}
+		var updated int
+		updated, err = crypto.Reencrypt(ctx, pool, cipher, crypto.Table{
+			Name:    *table,
+			Key:     *key,
+			Tenant:  tenantExpr,
+			Columns: strings.Split(*columns, ","),
+		}, *batch)
+		fmt.Printf("%s\t%d rows re-encrypted\n", *table, updated)
+	default:
+		usage()
+	}
+	if err != nil {
+		logger.Error(cmd+" failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: bib-pii rotate [-tenant <id>] | reencrypt -table <table> -columns <col,...> [-tenant <expr>]")
+	os.Exit(2)
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,319 @@
+// Package crypto encrypts personal data in application code before it
+// reaches PostgreSQL, so a database dump, replica or backup alone does not
+// disclose it.
+//
+// Values are sealed with AES-256-GCM under a data key belonging to the
+// row's tenant. Data keys are generated by a KMS, which returns each both
+// in plaintext and wrapped under a master key it never releases; only the
+// wrapped form is stored, in a KeyStore, and a Cipher unwraps it through
+// the KMS the first time it is used. Erasing a tenant's data keys makes
+// every value sealed under them unreadable.
+//
+// A sealed value is stored as text:
+//
+//	enc:v1:<tenant id>:<key version>:<base64 nonce and ciphertext>
+//
+// naming the key it needs, so reading it takes no other column. The tenant
+// and key version are authenticated with the ciphertext, so a value copied
+// to another tenant's row fails to decrypt. Values without the prefix are
+// returned as they are: they were written before their column was
+// encrypted, and Reencrypt seals them in place.
+//
+// Rotating a tenant's key adds a new version that new values are sealed
+// under. Older versions stay in the KeyStore so existing values can still
+// be read until Reencrypt has moved them to the current one.
+package crypto
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// prefix marks a value sealed by a Cipher.
+const prefix = "enc:v1:"
+
+// currentTTL is how long a Cipher trusts its record of a tenant's current
+// key version, so a rotation made elsewhere is picked up within it.
+const currentTTL = time.Minute
+
+var (
+	// ErrNoKey is returned by a KeyStore for a tenant or key version with
+	// no data key.
+	ErrNoKey = errors.New("crypto: data key not found")
+	// ErrKeyExists is returned by a KeyStore adding a key version that is
+	// already stored.
+	ErrKeyExists = errors.New("crypto: data key version already exists")
+	// ErrMalformed is returned decrypting a value that has the sealed
+	// prefix but cannot be parsed or authenticated.
+	ErrMalformed = errors.New("crypto: malformed encrypted value")
+	// ErrNoCipher is returned decrypting a sealed value without a Cipher.
+	ErrNoCipher = errors.New("crypto: encrypted value but no cipher configured")
+)
+
+// IsEncrypted reports whether value was sealed by a Cipher.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+type keyRef struct {
+	tenantID uuid.UUID
+	version  int
+}
+
+type currentKey struct {
+	loadedAt time.Time
+	version  int
+}
+
+// Cipher seals and opens values under per-tenant data keys.
+//
+// A nil *Cipher leaves values in plaintext, for services run without a KMS
+// configured; it can still read plaintext values, but not sealed ones.
+type Cipher struct {
+	kms     KMS
+	store   KeyStore
+	now     func() time.Time
+	keys    map[keyRef]cipher.AEAD
+	current map[uuid.UUID]currentKey
+	mu      sync.Mutex
+}
+
+// NewCipher creates a Cipher whose data keys are generated and unwrapped by
+// kms and kept in store.
+func NewCipher(kms KMS, store KeyStore) *Cipher {
+	return &Cipher{
+		kms:     kms,
+		store:   store,
+		now:     time.Now,
+		keys:    make(map[keyRef]cipher.AEAD),
+		current: make(map[uuid.UUID]currentKey),
+	}
+}
+
+// Encrypt seals plaintext under tenantID's current data key, generating the
+// tenant's first key if it has none. Empty values stay empty.
+func (c *Cipher) Encrypt(ctx context.Context, tenantID uuid.UUID, plaintext string) (string, error) {
+	if c == nil || plaintext == "" {
+		return plaintext, nil
+	}
+	version, err := c.currentVersion(ctx, tenantID)
+	if err != nil {
+		return "", err
+	}
+	if version == 0 {
+		if version, err = c.Rotate(ctx, tenantID); err != nil {
+			return "", err
+		}
+	}
+	aead, err := c.key(ctx, keyRef{tenantID, version})
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("crypto: generate nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), additionalData(tenantID, version))
+	return fmt.Sprintf("%s%s:%d:%s", prefix, tenantID, version, base64.RawStdEncoding.EncodeToString(sealed)), nil
+}
+
+// Decrypt opens a value sealed by Encrypt. Values that were not sealed are
+// returned unchanged.
+func (c *Cipher) Decrypt(ctx context.Context, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	if c == nil {
+		return "", ErrNoCipher
+	}
+	ref, sealed, err := parse(value)
+	if err != nil {
+		return "", err
+	}
+	aead, err := c.key(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", ErrMalformed
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData(ref.tenantID, ref.version))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrMalformed, err)
+	}
+	return string(plaintext), nil
+}
+
+// Reencrypt seals value under its tenant's current data key, reporting
+// whether it changed. Values already under the current key, and empty
+// values, are returned unchanged. A sealed value keeps the tenant it was
+// sealed for; tenantID is the tenant of a plaintext value.
+func (c *Cipher) Reencrypt(ctx context.Context, tenantID uuid.UUID, value string) (string, bool, error) {
+	if c == nil || value == "" {
+		return value, false, nil
+	}
+	if IsEncrypted(value) {
+		ref, _, err := parse(value)
+		if err != nil {
+			return "", false, err
+		}
+		current, err := c.currentVersion(ctx, ref.tenantID)
+		if err != nil {
+			return "", false, err
+		}
+		if ref.version == current {
+			return value, false, nil
+		}
+		tenantID = ref.tenantID
+	}
+	plaintext, err := c.Decrypt(ctx, value)
+	if err != nil {
+		return "", false, err
+	}
+	sealed, err := c.Encrypt(ctx, tenantID, plaintext)
+	if err != nil {
+		return "", false, err
+	}
+	return sealed, true, nil
+}
+
+// Rotate generates a new data key for tenantID and makes it current,
+// returning its version. Values sealed under older versions stay readable.
+func (c *Cipher) Rotate(ctx context.Context, tenantID uuid.UUID) (int, error) {
+	if c == nil {
+		return 0, ErrNoCipher
+	}
+	latest, err := c.store.Current(ctx, tenantID)
+	if err != nil && !errors.Is(err, ErrNoKey) {
+		return 0, err
+	}
+
+	plaintext, wrapped, err := c.kms.GenerateDataKey(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("crypto: generate data key: %w", err)
+	}
+	aead, err := newAEAD(plaintext)
+	if err != nil {
+		return 0, err
+	}
+	key := DataKey{TenantID: tenantID, Version: latest.Version + 1, Wrapped: wrapped, CreatedAt: c.now().UTC()}
+	if err := c.store.Add(ctx, key); err != nil {
+		if errors.Is(err, ErrKeyExists) {
+			// Another instance generated the same version first; use it.
+			c.forgetCurrent(tenantID)
+			return c.currentVersion(ctx, tenantID)
+		}
+		return 0, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.keys[keyRef{tenantID, key.Version}] = aead
+	c.current[tenantID] = currentKey{loadedAt: c.now(), version: key.Version}
+	return key.Version, nil
+}
+
+// currentVersion returns tenantID's current key version, or 0 if it has
+// no data key yet.
+func (c *Cipher) currentVersion(ctx context.Context, tenantID uuid.UUID) (int, error) {
+	c.mu.Lock()
+	cur, ok := c.current[tenantID]
+	c.mu.Unlock()
+	if ok && c.now().Sub(cur.loadedAt) < currentTTL {
+		return cur.version, nil
+	}
+
+	key, err := c.store.Current(ctx, tenantID)
+	switch {
+	case errors.Is(err, ErrNoKey):
+		return 0, nil
+	case err != nil:
+		return 0, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.current[tenantID] = currentKey{loadedAt: c.now(), version: key.Version}
+	return key.Version, nil
+}
+
+func (c *Cipher) forgetCurrent(tenantID uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.current, tenantID)
+}
+
+// key returns the unwrapped data key ref names, unwrapping it through the
+// KMS on first use.
+func (c *Cipher) key(ctx context.Context, ref keyRef) (cipher.AEAD, error) {
+	c.mu.Lock()
+	aead, ok := c.keys[ref]
+	c.mu.Unlock()
+	if ok {
+		return aead, nil
+	}
+
+	stored, err := c.store.Get(ctx, ref.tenantID, ref.version)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := c.kms.Decrypt(ctx, stored.Wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("crypto: unwrap data key %s v%d: %w", ref.tenantID, ref.version, err)
+	}
+	if aead, err = newAEAD(plaintext); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.keys[ref] = aead
+	return aead, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("crypto: invalid data key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// additionalData binds a sealed value to the tenant and key version in its
+// header.
+func additionalData(tenantID uuid.UUID, version int) []byte {
+	return []byte(tenantID.String() + ":" + strconv.Itoa(version))
+}
+
+// parse splits a sealed value into the key it names and its nonce and
+// ciphertext.
+func parse(value string) (keyRef, []byte, error) {
+	parts := strings.SplitN(strings.TrimPrefix(value, prefix), ":", 3)
+	if len(parts) != 3 {
+		return keyRef{}, nil, ErrMalformed
+	}
+	tenantID, err := uuid.Parse(parts[0])
+	if err != nil {
+		return keyRef{}, nil, ErrMalformed
+	}
+	version, err := strconv.Atoi(parts[1])
+	if err != nil || version < 1 {
+		return keyRef{}, nil, ErrMalformed
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return keyRef{}, nil, ErrMalformed
+	}
+	return keyRef{tenantID, version}, sealed, nil
+}
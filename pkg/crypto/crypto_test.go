@@ -0,0 +1,193 @@
+package crypto
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func newTestCipher(t *testing.T) (*Cipher, *MemoryKeyStore) {
+	t.Helper()
+	kms, err := NewLocalKMS(make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := NewMemoryKeyStore()
+	return NewCipher(kms, store), store
+}
+
+func TestCipher_RoundTrip(t *testing.T) {
+	c, store := newTestCipher(t)
+	ctx := context.Background()
+	tenant := uuid.New()
+
+	sealed, err := c.Encrypt(ctx, tenant, "jane@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsEncrypted(sealed) || strings.Contains(sealed, "jane") {
+		t.Fatalf("sealed = %q", sealed)
+	}
+	if _, err := store.Get(ctx, tenant, 1); err != nil {
+		t.Errorf("first encryption did not create a data key: %v", err)
+	}
+
+	// A new Cipher unwraps the stored key through the KMS.
+	fresh := NewCipher(c.kms, store)
+	if got, err := fresh.Decrypt(ctx, sealed); err != nil || got != "jane@example.com" {
+		t.Errorf("Decrypt = %q, %v", got, err)
+	}
+
+	if got, _ := c.Encrypt(ctx, tenant, ""); got != "" {
+		t.Errorf("empty value sealed as %q", got)
+	}
+	if got, err := c.Decrypt(ctx, "021000021"); err != nil || got != "021000021" {
+		t.Errorf("plaintext value = %q, %v", got, err)
+	}
+}
+
+func TestCipher_BoundToTenant(t *testing.T) {
+	c, _ := newTestCipher(t)
+	ctx := context.Background()
+	a, b := uuid.New(), uuid.New()
+
+	sealed, err := c.Encrypt(ctx, a, "1990-04-12")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Encrypt(ctx, b, "x"); err != nil {
+		t.Fatal(err)
+	}
+	moved := strings.Replace(sealed, a.String(), b.String(), 1)
+	if _, err := c.Decrypt(ctx, moved); !errors.Is(err, ErrMalformed) {
+		t.Errorf("value moved to another tenant: %v, want ErrMalformed", err)
+	}
+	if _, err := c.Decrypt(ctx, "enc:v1:garbage"); !errors.Is(err, ErrMalformed) {
+		t.Errorf("garbage: %v, want ErrMalformed", err)
+	}
+}
+
+func TestCipher_RotateAndReencrypt(t *testing.T) {
+	c, _ := newTestCipher(t)
+	ctx := context.Background()
+	tenant := uuid.New()
+
+	old, err := c.Encrypt(ctx, tenant, "123456789")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, changed, _ := c.Reencrypt(ctx, tenant, old); changed || got != old {
+		t.Errorf("value under the current key was rewritten")
+	}
+
+	if v, err := c.Rotate(ctx, tenant); err != nil || v != 2 {
+		t.Fatalf("Rotate = %d, %v", v, err)
+	}
+	if got, _ := c.Decrypt(ctx, old); got != "123456789" {
+		t.Errorf("value under the old key = %q", got)
+	}
+	next, changed, err := c.Reencrypt(ctx, tenant, old)
+	if err != nil || !changed || !strings.HasPrefix(next, "enc:v1:"+tenant.String()+":2:") {
+		t.Fatalf("Reencrypt = %q, %v, %v", next, changed, err)
+	}
+	if got, _ := c.Decrypt(ctx, next); got != "123456789" {
+		t.Errorf("re-encrypted value = %q", got)
+	}
+
+	// Values written before the column was encrypted are sealed.
+	sealed, changed, err := c.Reencrypt(ctx, tenant, "legacy@example.com")
+	if err != nil || !changed || !IsEncrypted(sealed) {
+		t.Errorf("Reencrypt(plaintext) = %q, %v, %v", sealed, changed, err)
+	}
+}
+
+func TestCipher_Nil(t *testing.T) {
+	var c *Cipher
+	ctx := context.Background()
+	if got, err := c.Encrypt(ctx, uuid.New(), "x"); err != nil || got != "x" {
+		t.Errorf("Encrypt = %q, %v", got, err)
+	}
+	if _, err := c.Decrypt(ctx, "enc:v1:a:1:b"); !errors.Is(err, ErrNoCipher) {
+		t.Errorf("Decrypt(sealed) = %v, want ErrNoCipher", err)
+	}
+}
+
+func TestCipher_ArgAndDest(t *testing.T) {
+	c, _ := newTestCipher(t)
+	ctx := context.Background()
+
+	v, err := c.Arg(ctx, uuid.New(), "GB29NWBK60161331926819").Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got string
+	if err := c.Dest(ctx, &got).Scan([]byte(v.(string))); err != nil || got != "GB29NWBK60161331926819" {
+		t.Errorf("Scan = %q, %v", got, err)
+	}
+	if err := c.Dest(ctx, &got).Scan(nil); err != nil || got != "" {
+		t.Errorf("Scan(NULL) = %q, %v", got, err)
+	}
+	if err := c.Dest(ctx, &got).Scan(42); err == nil {
+		t.Error("scanning an integer succeeded")
+	}
+}
+
+func TestNewKMS(t *testing.T) {
+	if kms, err := NewKMS(KMSConfig{}); kms != nil || err != nil {
+		t.Errorf("unconfigured = %v, %v", kms, err)
+	}
+	if _, err := NewKMS(KMSConfig{MasterKey: "c2hvcnQ="}); err == nil {
+		t.Error("short master key accepted")
+	}
+	if kms, _ := NewKMS(KMSConfig{AWSKeyID: "alias/pii", AWSRegion: "eu-west-1"}); kms.(*AWSKMS).cfg.Endpoint != "https://kms.eu-west-1.amazonaws.com" {
+		t.Errorf("endpoint = %q", kms.(*AWSKMS).cfg.Endpoint)
+	}
+}
+
+func TestAWSKMS(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") {
+			http.Error(w, `{"__type":"MissingAuthenticationToken"}`, http.StatusBadRequest)
+			return
+		}
+		var req struct {
+			KeyID          string `json:"KeyId"`
+			CiphertextBlob []byte
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req) //nolint:errcheck // test server
+		switch r.Header.Get("X-Amz-Target") {
+		case "TrentService.GenerateDataKey":
+			_ = json.NewEncoder(w).Encode(map[string][]byte{ //nolint:errcheck // test server
+				"Plaintext":      make([]byte, 32),
+				"CiphertextBlob": []byte("wrapped:" + req.KeyID),
+			})
+		case "TrentService.Decrypt":
+			if string(req.CiphertextBlob) != "wrapped:"+req.KeyID {
+				http.Error(w, `{"__type":"InvalidCiphertextException"}`, http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string][]byte{"Plaintext": make([]byte, 32)}) //nolint:errcheck // test server
+		}
+	}))
+	defer srv.Close()
+
+	kms := NewAWSKMS(AWSKMSConfig{KeyID: "alias/pii", Endpoint: srv.URL, Region: "eu-west-1", AccessKey: "AKID", SecretKey: "secret"})
+	c := NewCipher(kms, NewMemoryKeyStore())
+	ctx := context.Background()
+	sealed, err := c.Encrypt(ctx, uuid.New(), "jane@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := NewCipher(kms, c.store).Decrypt(ctx, sealed); err != nil || got != "jane@example.com" {
+		t.Errorf("Decrypt = %q, %v", got, err)
+	}
+	if _, err := kms.Decrypt(ctx, []byte("forged")); err == nil || !strings.Contains(err.Error(), "InvalidCiphertextException") {
+		t.Errorf("forged key: %v", err)
+	}
+}
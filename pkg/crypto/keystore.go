@@ -0,0 +1,108 @@
+package crypto
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DataKey is one version of a tenant's data key, wrapped by the KMS.
+type DataKey struct {
+	CreatedAt time.Time
+	Wrapped   []byte
+	Version   int
+	TenantID  uuid.UUID
+}
+
+// KeyStore keeps tenants' wrapped data keys.
+type KeyStore interface {
+	// Current returns the tenant's latest key version. It returns ErrNoKey
+	// when the tenant has none.
+	Current(ctx context.Context, tenantID uuid.UUID) (DataKey, error)
+	// Get returns a version of the tenant's key. It returns ErrNoKey when
+	// there is none.
+	Get(ctx context.Context, tenantID uuid.UUID, version int) (DataKey, error)
+	// Add stores a new key version. It returns ErrKeyExists when the
+	// version is already stored.
+	Add(ctx context.Context, key DataKey) error
+	// Tenants lists the tenants with a data key.
+	Tenants(ctx context.Context) ([]uuid.UUID, error)
+}
+
+// Compile-time interface check.
+var _ KeyStore = (*MemoryKeyStore)(nil)
+
+// MemoryKeyStore is an in-process KeyStore for tests and tools. Keys held
+// in memory do not survive a restart, and neither do values sealed under
+// them.
+type MemoryKeyStore struct {
+	keys map[keyRef]DataKey
+	mu   sync.Mutex
+}
+
+// NewMemoryKeyStore creates a new, empty MemoryKeyStore.
+func NewMemoryKeyStore() *MemoryKeyStore {
+	return &MemoryKeyStore{keys: make(map[keyRef]DataKey)}
+}
+
+// Current implements KeyStore.
+func (s *MemoryKeyStore) Current(_ context.Context, tenantID uuid.UUID) (DataKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var latest DataKey
+	for ref, key := range s.keys {
+		if ref.tenantID == tenantID && key.Version > latest.Version {
+			latest = key
+		}
+	}
+	if latest.Version == 0 {
+		return DataKey{}, ErrNoKey
+	}
+	return latest, nil
+}
+
+// Get implements KeyStore.
+func (s *MemoryKeyStore) Get(_ context.Context, tenantID uuid.UUID, version int) (DataKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.keys[keyRef{tenantID, version}]
+	if !ok {
+		return DataKey{}, ErrNoKey
+	}
+	return key, nil
+}
+
+// Add implements KeyStore.
+func (s *MemoryKeyStore) Add(_ context.Context, key DataKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ref := keyRef{key.TenantID, key.Version}
+	if _, ok := s.keys[ref]; ok {
+		return ErrKeyExists
+	}
+	s.keys[ref] = key
+	return nil
+}
+
+// Tenants implements KeyStore.
+func (s *MemoryKeyStore) Tenants(context.Context) ([]uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[uuid.UUID]bool)
+	var tenants []uuid.UUID
+	for ref := range s.keys {
+		if !seen[ref.tenantID] {
+			seen[ref.tenantID] = true
+			tenants = append(tenants, ref.tenantID)
+		}
+	}
+	sort.Slice(tenants, func(i, j int) bool { return tenants[i].String() < tenants[j].String() })
+	return tenants, nil
+}
@@ -0,0 +1,110 @@
+package crypto
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// dataKeySize is the size of the AES-256 data keys a KMS generates.
+const dataKeySize = 32
+
+// KMS generates data keys and unwraps them. The master key that wraps them
+// never leaves it.
+type KMS interface {
+	// GenerateDataKey returns a new AES-256 key in plaintext and wrapped
+	// under the master key.
+	GenerateDataKey(ctx context.Context) (plaintext, wrapped []byte, err error)
+	// Decrypt unwraps a key returned by GenerateDataKey.
+	Decrypt(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+// KMSConfig configures the KMS data keys come from: AWS KMS when AWSKeyID
+// is set, and a LocalKMS with the base64 MasterKey otherwise.
+type KMSConfig struct {
+	MasterKey    string
+	AWSKeyID     string
+	AWSEndpoint  string
+	AWSRegion    string
+	AWSAccessKey string
+	AWSSecretKey string
+}
+
+// NewKMS creates the KMS cfg configures. It returns nil when neither an AWS
+// key nor a master key is set, in which case values are left in plaintext.
+func NewKMS(cfg KMSConfig) (KMS, error) {
+	switch {
+	case cfg.AWSKeyID != "":
+		return NewAWSKMS(AWSKMSConfig{
+			KeyID:     cfg.AWSKeyID,
+			Endpoint:  cfg.AWSEndpoint,
+			Region:    cfg.AWSRegion,
+			AccessKey: cfg.AWSAccessKey,
+			SecretKey: cfg.AWSSecretKey,
+		}), nil
+	case cfg.MasterKey != "":
+		key, err := base64.StdEncoding.DecodeString(cfg.MasterKey)
+		if err != nil {
+			return nil, fmt.Errorf("crypto: master key is not base64: %w", err)
+		}
+		return NewLocalKMS(key)
+	default:
+		return nil, nil
+	}
+}
+
+// Compile-time interface check.
+var _ KMS = (*LocalKMS)(nil)
+
+// localKeyAD is the additional data keys wrapped by a LocalKMS are
+// authenticated with.
+var localKeyAD = []byte("bib-data-key")
+
+// LocalKMS wraps data keys under a master key held in process, for
+// development and single-tenant installs without a key management service.
+// Anyone with the master key can unwrap every data key.
+type LocalKMS struct {
+	aead cipher.AEAD
+}
+
+// NewLocalKMS creates a LocalKMS with a 32-byte master key.
+func NewLocalKMS(masterKey []byte) (*LocalKMS, error) {
+	if len(masterKey) != dataKeySize {
+		return nil, fmt.Errorf("crypto: master key must be %d bytes, got %d", dataKeySize, len(masterKey))
+	}
+	block, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, fmt.Errorf("crypto: invalid master key: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("crypto: invalid master key: %w", err)
+	}
+	return &LocalKMS{aead: aead}, nil
+}
+
+// GenerateDataKey implements KMS.
+func (k *LocalKMS) GenerateDataKey(context.Context) ([]byte, []byte, error) {
+	key := make([]byte, dataKeySize)
+	nonce := make([]byte, k.aead.NonceSize())
+	if _, err := rand.Read(key); err != nil {
+		return nil, nil, err
+	}
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	return key, k.aead.Seal(nonce, nonce, key, localKeyAD), nil
+}
+
+// Decrypt implements KMS.
+func (k *LocalKMS) Decrypt(_ context.Context, wrapped []byte) ([]byte, error) {
+	if len(wrapped) < k.aead.NonceSize() {
+		return nil, errors.New("wrapped key too short")
+	}
+	nonce, sealed := wrapped[:k.aead.NonceSize()], wrapped[k.aead.NonceSize():]
+	return k.aead.Open(nil, nonce, sealed, localKeyAD)
+}
@@ -0,0 +1,68 @@
+package crypto
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Arg returns a query argument that stores plaintext sealed under
+// tenantID's data key, so repositories seal a column where they pass it:
+//
+//	tx.Exec(ctx, `UPDATE account_holders SET email = $2 WHERE id = $1`,
+//		id, cipher.Arg(ctx, tenantID, email))
+//
+// The column must be text, as sealed values are.
+func (c *Cipher) Arg(ctx context.Context, tenantID uuid.UUID, plaintext string) driver.Valuer {
+	return sealedArg{ctx: ctx, cipher: c, tenantID: tenantID, plaintext: plaintext}
+}
+
+// Dest returns a scan destination that opens a sealed column into dst:
+//
+//	row.Scan(&id, cipher.Dest(ctx, &email))
+//
+// NULL scans as the empty string.
+func (c *Cipher) Dest(ctx context.Context, dst *string) sql.Scanner {
+	return openedDest{ctx: ctx, cipher: c, dst: dst}
+}
+
+type sealedArg struct {
+	ctx       context.Context //nolint:containedctx // driver.Valuer takes no context
+	cipher    *Cipher
+	plaintext string
+	tenantID  uuid.UUID
+}
+
+// Value implements driver.Valuer.
+func (a sealedArg) Value() (driver.Value, error) {
+	return a.cipher.Encrypt(a.ctx, a.tenantID, a.plaintext)
+}
+
+type openedDest struct {
+	ctx    context.Context //nolint:containedctx // sql.Scanner takes no context
+	cipher *Cipher
+	dst    *string
+}
+
+// Scan implements sql.Scanner.
+func (d openedDest) Scan(src any) error {
+	var value string
+	switch v := src.(type) {
+	case nil:
+	case string:
+		value = v
+	case []byte:
+		value = string(v)
+	default:
+		return fmt.Errorf("crypto: cannot scan %T into an encrypted string", src)
+	}
+	plaintext, err := d.cipher.Decrypt(d.ctx, value)
+	if err != nil {
+		return err
+	}
+	*d.dst = plaintext
+	return nil
+}
@@ -0,0 +1,93 @@
+package crypto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Compile-time interface check.
+var _ KeyStore = (*PostgresKeyStore)(nil)
+
+// PostgresKeyStore is a KeyStore backed by a service's data_keys table:
+//
+//	CREATE TABLE data_keys (
+//	    tenant_id   UUID NOT NULL,
+//	    version     INT NOT NULL,
+//	    wrapped_key BYTEA NOT NULL,
+//	    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+//	    PRIMARY KEY (tenant_id, version)
+//	);
+//
+// The table is not subject to row-level security: the Cipher reads keys
+// for whichever tenant a value names.
+type PostgresKeyStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresKeyStore creates a new PostgresKeyStore.
+func NewPostgresKeyStore(pool *pgxpool.Pool) *PostgresKeyStore {
+	return &PostgresKeyStore{pool: pool}
+}
+
+// Current implements KeyStore.
+func (s *PostgresKeyStore) Current(ctx context.Context, tenantID uuid.UUID) (DataKey, error) {
+	return s.queryKey(ctx, `
+		SELECT tenant_id, version, wrapped_key, created_at FROM data_keys
+		WHERE tenant_id = $1 ORDER BY version DESC LIMIT 1
+	`, tenantID)
+}
+
+// Get implements KeyStore.
+func (s *PostgresKeyStore) Get(ctx context.Context, tenantID uuid.UUID, version int) (DataKey, error) {
+	return s.queryKey(ctx, `
+		SELECT tenant_id, version, wrapped_key, created_at FROM data_keys
+		WHERE tenant_id = $1 AND version = $2
+	`, tenantID, version)
+}
+
+// Add implements KeyStore.
+func (s *PostgresKeyStore) Add(ctx context.Context, key DataKey) error {
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO data_keys (tenant_id, version, wrapped_key, created_at)
+		VALUES ($1, $2, $3, $4)
+	`, key.TenantID, key.Version, key.Wrapped, key.CreatedAt)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return ErrKeyExists
+	}
+	if err != nil {
+		return fmt.Errorf("crypto: insert data key: %w", err)
+	}
+	return nil
+}
+
+// Tenants implements KeyStore.
+func (s *PostgresKeyStore) Tenants(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := s.pool.Query(ctx, `SELECT DISTINCT tenant_id FROM data_keys ORDER BY tenant_id`)
+	if err != nil {
+		return nil, fmt.Errorf("crypto: list data key tenants: %w", err)
+	}
+	tenants, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, fmt.Errorf("crypto: list data key tenants: %w", err)
+	}
+	return tenants, nil
+}
+
+func (s *PostgresKeyStore) queryKey(ctx context.Context, query string, args ...any) (DataKey, error) {
+	var key DataKey
+	err := s.pool.QueryRow(ctx, query, args...).Scan(&key.TenantID, &key.Version, &key.Wrapped, &key.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return DataKey{}, ErrNoKey
+	}
+	if err != nil {
+		return DataKey{}, fmt.Errorf("crypto: query data key: %w", err)
+	}
+	return key, nil
+}
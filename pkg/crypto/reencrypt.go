@@ -0,0 +1,125 @@
+package crypto
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Table names the encrypted columns of a table for Reencrypt.
+type Table struct {
+	// Name is the table, optionally schema-qualified.
+	Name string
+	// Key is a UUID column unique within the table, such as id.
+	Key string
+	// Tenant is the column holding each row's tenant, or an SQL expression
+	// giving it, such as a subquery on a parent table. It is only read for
+	// values still in plaintext.
+	Tenant  string
+	Columns []string
+}
+
+// Reencrypt seals every value in t's columns under its tenant's current
+// data key, walking the table by key in batches of batchSize rows, and
+// returns how many rows it rewrote. It seals plaintext values written
+// before a column was encrypted, and moves values off keys older than a
+// rotation, after which old key versions are no longer read.
+//
+// A row changed while it is being re-encrypted is skipped: the new value
+// was written under the current key.
+func Reencrypt(ctx context.Context, pool *pgxpool.Pool, c *Cipher, t Table, batchSize int) (int, error) {
+	if c == nil {
+		return 0, ErrNoCipher
+	}
+	if t.Name == "" || t.Key == "" || t.Tenant == "" || len(t.Columns) == 0 {
+		return 0, fmt.Errorf("crypto: re-encrypt needs a table, key, tenant and columns")
+	}
+	table := pgx.Identifier(strings.Split(t.Name, ".")).Sanitize()
+	key := pgx.Identifier{t.Key}.Sanitize()
+	columns := make([]string, len(t.Columns))
+	for i, col := range t.Columns {
+		columns[i] = pgx.Identifier{col}.Sanitize()
+	}
+
+	selectSQL := fmt.Sprintf(`SELECT %s, %s, %s FROM %s WHERE %s > $1 ORDER BY %s LIMIT $2`,
+		key, t.Tenant, strings.Join(columns, ", "), table, key, key)
+	sets := make([]string, len(columns))
+	guards := make([]string, len(columns))
+	for i, col := range columns {
+		sets[i] = fmt.Sprintf("%s = $%d", col, i+2)
+		guards[i] = fmt.Sprintf("%s IS NOT DISTINCT FROM $%d", col, len(columns)+i+2)
+	}
+	updateSQL := fmt.Sprintf(`UPDATE %s SET %s WHERE %s = $1 AND %s`,
+		table, strings.Join(sets, ", "), key, strings.Join(guards, " AND "))
+
+	var (
+		after   uuid.UUID
+		updated int
+	)
+	for {
+		rows, err := pool.Query(ctx, selectSQL, after, batchSize)
+		if err != nil {
+			return updated, fmt.Errorf("crypto: read %s: %w", t.Name, err)
+		}
+		type row struct {
+			values   []*string
+			id       uuid.UUID
+			tenantID uuid.UUID
+		}
+		var batch []row
+		for rows.Next() {
+			r := row{values: make([]*string, len(columns))}
+			dest := []any{&r.id, &r.tenantID}
+			for i := range r.values {
+				dest = append(dest, &r.values[i])
+			}
+			if err := rows.Scan(dest...); err != nil {
+				rows.Close()
+				return updated, fmt.Errorf("crypto: scan %s: %w", t.Name, err)
+			}
+			batch = append(batch, r)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return updated, fmt.Errorf("crypto: read %s: %w", t.Name, err)
+		}
+
+		for _, r := range batch {
+			args := make([]any, 1, 1+2*len(columns))
+			args[0] = r.id
+			changed := false
+			for _, v := range r.values {
+				if v == nil {
+					args = append(args, nil)
+					continue
+				}
+				sealed, ok, err := c.Reencrypt(ctx, r.tenantID, *v)
+				if err != nil {
+					return updated, fmt.Errorf("crypto: re-encrypt %s %s: %w", t.Name, r.id, err)
+				}
+				changed = changed || ok
+				args = append(args, sealed)
+			}
+			if !changed {
+				continue
+			}
+			for _, v := range r.values {
+				args = append(args, v)
+			}
+			tag, err := pool.Exec(ctx, updateSQL, args...)
+			if err != nil {
+				return updated, fmt.Errorf("crypto: update %s %s: %w", t.Name, r.id, err)
+			}
+			updated += int(tag.RowsAffected())
+		}
+
+		if len(batch) < batchSize {
+			return updated, nil
+		}
+		after = batch[len(batch)-1].id
+	}
+}
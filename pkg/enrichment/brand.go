@@ -0,0 +1,34 @@
+package enrichment
+
+// Brand is a merchant or payee recognized by name. Prefixes are upper-case
+// name prefixes matched on word boundaries against normalized names, so
+// "AMZN" matches "AMZN MKTP US" but not "AMZNX". Logo keys the brand's
+// logo in the asset set clients render.
+type Brand struct {
+	Name     string
+	Logo     string
+	Category Category
+	Prefixes []string
+}
+
+// DefaultBrands are the brands recognized out of the box.
+var DefaultBrands = []Brand{
+	{Name: "Airbnb", Logo: "airbnb", Category: CategoryTravel, Prefixes: []string{"AIRBNB"}},
+	{Name: "Amazon", Logo: "amazon", Category: CategoryShopping, Prefixes: []string{"AMAZON", "AMZN"}},
+	{Name: "Apple", Logo: "apple", Category: CategoryShopping, Prefixes: []string{"APPLE", "APPLE.COM"}},
+	{Name: "Costco", Logo: "costco", Category: CategoryGroceries, Prefixes: []string{"COSTCO"}},
+	{Name: "Delta Air Lines", Logo: "delta", Category: CategoryTravel, Prefixes: []string{"DELTA AIR"}},
+	{Name: "DoorDash", Logo: "doordash", Category: CategoryDining, Prefixes: []string{"DOORDASH", "DD DOORDASH"}},
+	{Name: "Google", Logo: "google", Category: CategoryShopping, Prefixes: []string{"GOOGLE"}},
+	{Name: "Lyft", Logo: "lyft", Category: CategoryTransport, Prefixes: []string{"LYFT"}},
+	{Name: "McDonald's", Logo: "mcdonalds", Category: CategoryDining, Prefixes: []string{"MCDONALD'S", "MCDONALDS"}},
+	{Name: "Netflix", Logo: "netflix", Category: CategoryEntertainment, Prefixes: []string{"NETFLIX"}},
+	{Name: "Shell", Logo: "shell", Category: CategoryFuel, Prefixes: []string{"SHELL"}},
+	{Name: "Spotify", Logo: "spotify", Category: CategoryEntertainment, Prefixes: []string{"SPOTIFY"}},
+	{Name: "Starbucks", Logo: "starbucks", Category: CategoryDining, Prefixes: []string{"STARBUCKS"}},
+	{Name: "Target", Logo: "target", Category: CategoryShopping, Prefixes: []string{"TARGET"}},
+	{Name: "Uber Eats", Logo: "uber-eats", Category: CategoryDining, Prefixes: []string{"UBER EATS", "UBEREATS"}},
+	{Name: "Uber", Logo: "uber", Category: CategoryTransport, Prefixes: []string{"UBER"}},
+	{Name: "Walmart", Logo: "walmart", Category: CategoryGroceries, Prefixes: []string{"WALMART", "WAL-MART", "WM SUPERCENTER"}},
+	{Name: "Whole Foods Market", Logo: "whole-foods", Category: CategoryGroceries, Prefixes: []string{"WHOLE FOODS", "WHOLEFDS"}},
+}
@@ -0,0 +1,90 @@
+package enrichment
+
+import "strconv"
+
+// Category is the spending category a transaction is filed under on
+// statements and in spending insights.
+type Category string
+
+// Spending categories.
+const (
+	CategoryGroceries     Category = "GROCERIES"
+	CategoryDining        Category = "DINING"
+	CategoryTransport     Category = "TRANSPORT"
+	CategoryTravel        Category = "TRAVEL"
+	CategoryFuel          Category = "FUEL"
+	CategoryShopping      Category = "SHOPPING"
+	CategoryEntertainment Category = "ENTERTAINMENT"
+	CategoryUtilities     Category = "UTILITIES"
+	CategoryHealth        Category = "HEALTH"
+	CategoryEducation     Category = "EDUCATION"
+	CategoryServices      Category = "SERVICES"
+	CategoryFinancial     Category = "FINANCIAL"
+	CategoryCash          Category = "CASH"
+	CategoryGovernment    Category = "GOVERNMENT"
+	CategoryTransfers     Category = "TRANSFERS"
+	CategoryOther         Category = "OTHER"
+)
+
+func (c Category) String() string { return string(c) }
+
+// mccRange files merchant category codes from, to inclusive under a
+// category.
+type mccRange struct {
+	from, to int
+	category Category
+}
+
+// mccRanges is searched in order, so specific codes come before the ranges
+// containing them. Codes follow ISO 18245.
+var mccRanges = []mccRange{
+	{3000, 3299, CategoryTravel},    // airlines
+	{3351, 3441, CategoryTravel},    // car rental
+	{3501, 3999, CategoryTravel},    // lodging
+	{4111, 4131, CategoryTransport}, // commuter transport, taxis, buses
+	{4411, 4411, CategoryTravel},    // cruise lines
+	{4511, 4511, CategoryTravel},    // airlines
+	{4722, 4722, CategoryTravel},    // travel agencies
+	{4784, 4789, CategoryTransport}, // tolls, transport services
+	{4812, 4816, CategoryUtilities}, // telecom
+	{4899, 4900, CategoryUtilities}, // cable, utilities
+	{5122, 5122, CategoryHealth},    // drugs
+	{5411, 5411, CategoryGroceries},
+	{5422, 5499, CategoryGroceries}, // butchers, bakeries, convenience
+	{5541, 5542, CategoryFuel},
+	{5811, 5814, CategoryDining},
+	{5815, 5818, CategoryEntertainment}, // digital goods
+	{5912, 5912, CategoryHealth},        // pharmacies
+	{5983, 5983, CategoryFuel},
+	{5000, 5999, CategoryShopping},
+	{6010, 6011, CategoryCash},
+	{6012, 6399, CategoryFinancial},
+	{7011, 7011, CategoryTravel},
+	{7512, 7512, CategoryTravel},
+	{7523, 7523, CategoryTransport}, // parking
+	{7832, 7841, CategoryEntertainment},
+	{7911, 7999, CategoryEntertainment},
+	{7000, 7999, CategoryServices},
+	{8011, 8099, CategoryHealth},
+	{8211, 8299, CategoryEducation},
+	{8000, 8999, CategoryServices},
+	{9211, 9402, CategoryGovernment},
+}
+
+// CategoryForMCC files a four-digit merchant category code under a spending
+// category, returning CategoryOther for unknown or malformed codes.
+func CategoryForMCC(mcc string) Category {
+	if len(mcc) != 4 {
+		return CategoryOther
+	}
+	code, err := strconv.Atoi(mcc)
+	if err != nil {
+		return CategoryOther
+	}
+	for _, r := range mccRanges {
+		if code >= r.from && code <= r.to {
+			return r.category
+		}
+	}
+	return CategoryOther
+}
@@ -0,0 +1,196 @@
+// Package enrichment turns the raw merchant descriptors and counterparty
+// details transactions arrive with into the names, categories and logos
+// customers see on statements and in spending insights.
+//
+// Card networks send descriptors such as "SQ *BLUE BOTTLE #0412" or
+// "AMZN MKTP US*2K4LL0"; payments carry free-text descriptions and account
+// identifiers in whatever format the customer typed them. An Enricher
+// normalizes both, recognizes known brands, and files each transaction
+// under a Category. Enrichment is a pure function of its input, so a
+// service can enrich when a transaction happens and a consumer can enrich
+// again, with the same result, when an older event lacks the fields.
+package enrichment
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Merchant is a card merchant as shown to customers. RawName and MCC are
+// as received from the network; Logo is empty when the brand is unknown.
+type Merchant struct {
+	RawName  string
+	MCC      string
+	Name     string
+	Category Category
+	Logo     string
+}
+
+// Counterparty is the other party of a payment as shown to customers.
+// Identifier is the normalized account number or IBAN and MaskedIdentifier
+// shows only its last four characters; both are empty for payments between
+// the bank's own accounts.
+type Counterparty struct {
+	Name             string
+	Identifier       string
+	MaskedIdentifier string
+	Category         Category
+	Logo             string
+}
+
+// Enricher enriches merchants and counterparties using a brand directory.
+// It is safe for concurrent use.
+type Enricher struct {
+	brands []Brand
+}
+
+// NewEnricher creates an Enricher recognizing brands.
+func NewEnricher(brands []Brand) *Enricher {
+	return &Enricher{brands: brands}
+}
+
+// Default recognizes DefaultBrands.
+var Default = NewEnricher(DefaultBrands)
+
+// processorPrefixes are the payment facilitators that prepend their own
+// name to the merchant's in descriptors.
+var processorPrefixes = []string{"SQ *", "SQU*", "TST*", "PAYPAL *", "PP*", "SP *", "IZ *", "SUMUP *", "ZTL*"}
+
+// legalSuffixes are dropped from merchant names.
+var legalSuffixes = map[string]bool{"INC": true, "LLC": true, "LTD": true, "CORP": true, "CO": true, "PLC": true}
+
+// Merchant enriches a card merchant from its descriptor and merchant
+// category code. A recognized brand gives the name, category and logo;
+// otherwise the descriptor is cleaned of processor prefixes, store numbers
+// and legal suffixes, and the category comes from the MCC.
+func (e *Enricher) Merchant(rawName, mcc string) Merchant {
+	m := Merchant{RawName: rawName, MCC: strings.TrimSpace(mcc)}
+	key, normalized := normalizeDescriptor(rawName)
+	if b, ok := e.match(key); ok {
+		m.Name, m.Category, m.Logo = b.Name, b.Category, b.Logo
+		return m
+	}
+	m.Name = titleCase(normalized)
+	if m.Name == "" {
+		m.Name = strings.TrimSpace(rawName)
+	}
+	m.Category = CategoryForMCC(m.MCC)
+	return m
+}
+
+// Counterparty enriches the other party of a payment from the payment's
+// description and the counterparty's account identifier. A recognized
+// brand gives the name, category and logo; otherwise the name is the
+// description with its whitespace collapsed and the payment is filed under
+// CategoryTransfers.
+func (e *Enricher) Counterparty(description, identifier string) Counterparty {
+	c := Counterparty{
+		Name:       strings.Join(strings.Fields(description), " "),
+		Identifier: NormalizeIdentifier(identifier),
+		Category:   CategoryTransfers,
+	}
+	c.MaskedIdentifier = MaskIdentifier(c.Identifier)
+	if b, ok := e.match(strings.ToUpper(c.Name)); ok {
+		c.Name, c.Category, c.Logo = b.Name, b.Category, b.Logo
+	}
+	return c
+}
+
+// match returns the brand with the longest prefix matching name.
+func (e *Enricher) match(name string) (Brand, bool) {
+	var (
+		best    Brand
+		bestLen int
+	)
+	for _, b := range e.brands {
+		for _, p := range b.Prefixes {
+			if len(p) > bestLen && hasWordPrefix(name, p) {
+				best, bestLen = b, len(p)
+			}
+		}
+	}
+	return best, bestLen > 0
+}
+
+// hasWordPrefix reports whether s starts with prefix followed by the end of
+// s or a character that is not a letter or digit.
+func hasWordPrefix(s, prefix string) bool {
+	if !strings.HasPrefix(s, prefix) {
+		return false
+	}
+	rest := s[len(prefix):]
+	if rest == "" {
+		return true
+	}
+	r := []rune(rest)[0]
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// normalizeDescriptor upper-cases a card descriptor and strips the
+// processor prefix. It returns the result, with any "*" read as a space, as
+// the key brands are matched against, and the merchant's name: the result
+// cut at the reference after a "*", without words containing digits or "#"
+// (store and terminal numbers) or legal suffixes.
+func normalizeDescriptor(raw string) (key, name string) {
+	s := strings.ToUpper(strings.TrimSpace(raw))
+	for _, p := range processorPrefixes {
+		if strings.HasPrefix(s, p) {
+			s = strings.TrimSpace(s[len(p):])
+			break
+		}
+	}
+	key = strings.Join(strings.Fields(strings.ReplaceAll(s, "*", " ")), " ")
+	if i := strings.IndexByte(s, '*'); i >= 0 {
+		s = s[:i]
+	}
+	words := strings.Fields(s)
+	kept := words[:0]
+	for _, w := range words {
+		if strings.ContainsAny(w, "#0123456789") {
+			continue
+		}
+		kept = append(kept, w)
+	}
+	for len(kept) > 1 && legalSuffixes[strings.Trim(kept[len(kept)-1], ".,")] {
+		kept = kept[:len(kept)-1]
+	}
+	return key, strings.Join(kept, " ")
+}
+
+// titleCase capitalizes the first letter of each word and lower-cases the
+// rest.
+func titleCase(s string) string {
+	words := strings.Fields(strings.ToLower(s))
+	for i, w := range words {
+		r := []rune(w)
+		r[0] = unicode.ToUpper(r[0])
+		words[i] = string(r)
+	}
+	return strings.Join(words, " ")
+}
+
+// NormalizeIdentifier upper-cases an account number or IBAN and removes the
+// spaces, dashes and dots it was typed with.
+func NormalizeIdentifier(identifier string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '\t':
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, identifier)
+}
+
+// MaskIdentifier shows only the last four characters of a normalized
+// identifier, as "••••6789". Identifiers of four characters or fewer are
+// masked entirely.
+func MaskIdentifier(identifier string) string {
+	if identifier == "" {
+		return ""
+	}
+	r := []rune(identifier)
+	if len(r) <= 4 {
+		return "••••"
+	}
+	return "••••" + string(r[len(r)-4:])
+}
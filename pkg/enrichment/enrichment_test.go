@@ -0,0 +1,66 @@
+package enrichment
+
+import "testing"
+
+func TestMerchant_NormalizesDescriptors(t *testing.T) {
+	tests := []struct {
+		raw, mcc string
+		want     Merchant
+	}{
+		{"AMZN MKTP US*2K4LL0", "5942", Merchant{Name: "Amazon", Category: CategoryShopping, Logo: "amazon"}},
+		{"UBER   *EATS PENDING", "5812", Merchant{Name: "Uber Eats", Category: CategoryDining, Logo: "uber-eats"}},
+		{"UBER *TRIP HELP.UBER.COM", "4121", Merchant{Name: "Uber", Category: CategoryTransport, Logo: "uber"}},
+		{"SQ *BLUE BOTTLE COFFEE #0412", "5814", Merchant{Name: "Blue Bottle Coffee", Category: CategoryDining}},
+		{"JOE'S HARDWARE 00231 LLC", "5251", Merchant{Name: "Joe's Hardware", Category: CategoryShopping}},
+		{"TARGETED ADS", "7311", Merchant{Name: "Targeted Ads", Category: CategoryServices}},
+		{"12345", "", Merchant{Name: "12345", Category: CategoryOther}},
+	}
+	for _, tt := range tests {
+		got := Default.Merchant(tt.raw, tt.mcc)
+		tt.want.RawName, tt.want.MCC = tt.raw, tt.mcc
+		if got != tt.want {
+			t.Errorf("Merchant(%q, %q) = %+v, want %+v", tt.raw, tt.mcc, got, tt.want)
+		}
+	}
+}
+
+func TestCategoryForMCC(t *testing.T) {
+	tests := map[string]Category{
+		"5411": CategoryGroceries,
+		"5541": CategoryFuel,
+		"3058": CategoryTravel,
+		"5912": CategoryHealth,
+		"5732": CategoryShopping,
+		"6011": CategoryCash,
+		"7832": CategoryEntertainment,
+		"9311": CategoryGovernment,
+		"0742": CategoryOther,
+		"54a1": CategoryOther,
+		"541":  CategoryOther,
+	}
+	for mcc, want := range tests {
+		if got := CategoryForMCC(mcc); got != want {
+			t.Errorf("CategoryForMCC(%q) = %s, want %s", mcc, got, want)
+		}
+	}
+}
+
+func TestCounterparty(t *testing.T) {
+	got := Default.Counterparty("  Netflix   March ", "de89 3704-0044 0532 0130 00")
+	want := Counterparty{
+		Name:             "Netflix",
+		Identifier:       "DE89370400440532013000",
+		MaskedIdentifier: "••••3000",
+		Category:         CategoryEntertainment,
+		Logo:             "netflix",
+	}
+	if got != want {
+		t.Errorf("Counterparty = %+v, want %+v", got, want)
+	}
+
+	got = Default.Counterparty("Rent  March", "")
+	want = Counterparty{Name: "Rent March", Category: CategoryTransfers}
+	if got != want {
+		t.Errorf("Counterparty = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/bibbank/bib/pkg/enrichment
+
+go 1.24
@@ -0,0 +1,124 @@
+// Package erasure is the contract between the privacy coordinator and the
+// services holding personal data. Each such service implements Eraser and
+// runs a Participant, which registers the service with the coordinator,
+// consumes the erasure requests it fans out on Topic and reports back how
+// each went. The coordinator tracks completion across the registered
+// services and certifies the erasure once all of them have reported.
+//
+// Requests are delivered at least once and may be retried after a failure
+// or timeout, so erasers must be idempotent: erasing what is already erased
+// succeeds and reports nothing further erased.
+package erasure
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Topic carries participant registrations, erasure requests and their
+// results between the coordinator and the participants.
+const Topic = "bib.privacy.erasure"
+
+// Event types published on Topic.
+const (
+	EventParticipantRegistered = "privacy.participant.registered"
+	EventErasureRequested      = "privacy.erasure.requested"
+	EventErasureCompleted      = "privacy.erasure.completed"
+)
+
+// Kind is what an erasure request asks for.
+type Kind string
+
+const (
+	// KindSubject erases one data subject's personal data on their
+	// request.
+	KindSubject Kind = "SUBJECT"
+	// KindRetention erases the records of a data category that have been
+	// kept past the tenant's retention period.
+	KindRetention Kind = "RETENTION"
+)
+
+// Outcome is how a participant's erasure went.
+type Outcome string
+
+const (
+	OutcomeCompleted Outcome = "COMPLETED"
+	OutcomeFailed    Outcome = "FAILED"
+)
+
+// ErrUnsupported may be returned by an Eraser for requests it cannot act
+// on; the request is reported failed.
+var ErrUnsupported = errors.New("erasure request not supported")
+
+// Subject identifies a data subject. Services match on whichever
+// identifiers they hold; either may be empty.
+type Subject struct {
+	Email      string    `json:"email,omitempty"`
+	CustomerID uuid.UUID `json:"customer_id,omitempty"`
+}
+
+// IsZero reports whether the subject carries no identifier.
+func (s Subject) IsZero() bool {
+	return strings.TrimSpace(s.Email) == "" && s.CustomerID == uuid.Nil
+}
+
+// Request asks a participant to erase personal data.
+type Request struct {
+	// Cutoff is set on retention requests: records of Category whose
+	// retention period started before it are erased.
+	Cutoff   time.Time `json:"cutoff,omitempty"`
+	Kind     Kind      `json:"kind"`
+	Category string    `json:"category,omitempty"`
+	// Reference identifies the data subject's verified request, e.g. the
+	// privacy ticket, for services that record it with the erasure.
+	Reference string    `json:"reference,omitempty"`
+	Subject   Subject   `json:"subject"`
+	JobID     uuid.UUID `json:"job_id"`
+	TenantID  uuid.UUID `json:"tenant_id"`
+}
+
+// Retained is a record a participant kept back from an erasure, with the
+// legal ground for keeping it, such as an open contract or a regulatory
+// retention obligation.
+type Retained struct {
+	Record string `json:"record"`
+	Reason string `json:"reason"`
+}
+
+// Result is what a participant erased.
+type Result struct {
+	Retained []Retained `json:"retained,omitempty"`
+	Erased   int        `json:"erased"`
+}
+
+// Eraser erases a service's personal data. Implementations must be
+// idempotent and should return a zero Result when they hold nothing
+// matching the request.
+type Eraser interface {
+	Erase(ctx context.Context, req Request) (Result, error)
+}
+
+// EraserFunc adapts a function to Eraser.
+type EraserFunc func(ctx context.Context, req Request) (Result, error)
+
+// Erase calls f.
+func (f EraserFunc) Erase(ctx context.Context, req Request) (Result, error) {
+	return f(ctx, req)
+}
+
+// EmailDigest returns the SHA-256 digest of a normalized email address, by
+// which an erased subject can still be recognized without keeping their
+// address.
+func EmailDigest(email string) string {
+	if strings.TrimSpace(email) == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return hex.EncodeToString(sum[:])
+}
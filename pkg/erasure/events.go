@@ -0,0 +1,75 @@
+package erasure
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// ParticipantRegistered announces a service taking part in erasures. It is
+// published every time the service starts, so the coordinator's registry
+// follows the categories the service currently declares.
+type ParticipantRegistered struct {
+	events.BaseEvent
+	Service string `json:"service"`
+	// Categories are the data categories the service erases on retention
+	// requests.
+	Categories []string `json:"categories"`
+}
+
+// NewParticipantRegistered creates a ParticipantRegistered event.
+func NewParticipantRegistered(service string, categories []string) ParticipantRegistered {
+	return ParticipantRegistered{
+		BaseEvent:  events.NewBaseEvent(EventParticipantRegistered, service, "ErasureParticipant", ""),
+		Service:    service,
+		Categories: categories,
+	}
+}
+
+// ErasureRequested asks the participants to erase personal data. Service
+// names the one participant it is meant for when the coordinator retries a
+// single service, and is empty when every participant should act on it.
+type ErasureRequested struct {
+	events.BaseEvent
+	Service string  `json:"service,omitempty"`
+	Request Request `json:"request"`
+}
+
+// NewErasureRequested creates an ErasureRequested event.
+func NewErasureRequested(req Request, service string) ErasureRequested {
+	return ErasureRequested{
+		BaseEvent: events.NewBaseEvent(EventErasureRequested, req.JobID.String(), "ErasureJob", req.TenantID.String()),
+		Service:   service,
+		Request:   req,
+	}
+}
+
+// ErasureCompleted reports how one participant's erasure went.
+type ErasureCompleted struct {
+	CompletedAt time.Time `json:"completed_at"`
+	events.BaseEvent
+	Service string    `json:"service"`
+	Outcome Outcome   `json:"outcome"`
+	Error   string    `json:"error,omitempty"`
+	Result  Result    `json:"result"`
+	JobID   uuid.UUID `json:"job_id"`
+}
+
+// NewErasureCompleted creates an ErasureCompleted event.
+func NewErasureCompleted(req Request, service string, result Result, eraseErr error, now time.Time) ErasureCompleted {
+	evt := ErasureCompleted{
+		BaseEvent:   events.NewBaseEvent(EventErasureCompleted, req.JobID.String(), "ErasureJob", req.TenantID.String()),
+		JobID:       req.JobID,
+		Service:     service,
+		Outcome:     OutcomeCompleted,
+		Result:      result,
+		CompletedAt: now,
+	}
+	if eraseErr != nil {
+		evt.Outcome = OutcomeFailed
+		evt.Error = eraseErr.Error()
+	}
+	return evt
+}
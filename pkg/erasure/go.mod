@@ -0,0 +1,17 @@
+module github.com/bibbank/bib/pkg/erasure
+
+go 1.24
+
+require (
+	github.com/bibbank/bib/pkg/events v0.0.0
+	github.com/google/uuid v1.6.0
+	github.com/stretchr/testify v1.10.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/bibbank/bib/pkg/events => ../events
@@ -0,0 +1,85 @@
+package erasure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// Participant connects a service's Eraser to the coordinator.
+type Participant struct {
+	eraser     Eraser
+	publisher  events.EventPublisher
+	logger     *slog.Logger
+	service    string
+	categories []string
+}
+
+// NewParticipant creates a Participant for service. categories are the
+// data categories the eraser handles retention requests for; subject
+// requests are always handled.
+func NewParticipant(service string, categories []string, eraser Eraser, publisher events.EventPublisher, logger *slog.Logger) *Participant {
+	return &Participant{
+		eraser:     eraser,
+		publisher:  publisher,
+		logger:     logger,
+		service:    service,
+		categories: categories,
+	}
+}
+
+// Register announces the participant to the coordinator. Services call it
+// on startup; requests are only fanned out to registered participants.
+func (p *Participant) Register(ctx context.Context) error {
+	evt := NewParticipantRegistered(p.service, p.categories)
+	if err := p.publisher.Publish(ctx, Topic, evt); err != nil {
+		return fmt.Errorf("failed to publish participant registration: %w", err)
+	}
+	return nil
+}
+
+// Handle processes an event consumed from Topic. Erasure requests meant
+// for the participant are erased and the result, failed or not, reported
+// to the coordinator, which retries failures; an error is only returned
+// when the request cannot be decoded or the result not published, so the
+// message is redelivered.
+func (p *Participant) Handle(ctx context.Context, eventType string, payload []byte) error {
+	if eventType != EventErasureRequested {
+		return nil
+	}
+	var evt ErasureRequested
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		return fmt.Errorf("failed to decode erasure request: %w", err)
+	}
+	if !p.handles(evt) {
+		return nil
+	}
+
+	req := evt.Request
+	result, err := p.eraser.Erase(ctx, req)
+	if err != nil {
+		p.logger.Error("erasure failed", "job_id", req.JobID, "kind", req.Kind, "error", err)
+		result = Result{}
+	}
+	completed := NewErasureCompleted(req, p.service, result, err, time.Now().UTC())
+	if err := p.publisher.Publish(ctx, Topic, completed); err != nil {
+		return fmt.Errorf("failed to publish erasure result: %w", err)
+	}
+	return nil
+}
+
+// handles reports whether the request is meant for the participant.
+func (p *Participant) handles(evt ErasureRequested) bool {
+	if evt.Service != "" && evt.Service != p.service {
+		return false
+	}
+	if evt.Request.Kind == KindRetention {
+		return slices.Contains(p.categories, evt.Request.Category)
+	}
+	return true
+}
@@ -0,0 +1,125 @@
+package erasure_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/pkg/events"
+)
+
+type recordingPublisher struct {
+	topics []string
+	events []events.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, topic string, evts ...events.DomainEvent) error {
+	for _, e := range evts {
+		p.topics = append(p.topics, topic)
+		p.events = append(p.events, e)
+	}
+	return nil
+}
+
+func requested(t *testing.T, req erasure.Request, service string) []byte {
+	t.Helper()
+	payload, err := json.Marshal(erasure.NewErasureRequested(req, service))
+	require.NoError(t, err)
+	return payload
+}
+
+func TestParticipant_ErasesAndReports(t *testing.T) {
+	pub := &recordingPublisher{}
+	var got erasure.Request
+	p := erasure.NewParticipant("identity-service", []string{"identity.applicant_data"},
+		erasure.EraserFunc(func(_ context.Context, req erasure.Request) (erasure.Result, error) {
+			got = req
+			return erasure.Result{Erased: 2, Retained: []erasure.Retained{{Record: "verification/1", Reason: "active_approval"}}}, nil
+		}), pub, slog.Default())
+
+	req := erasure.Request{
+		JobID:     uuid.New(),
+		TenantID:  uuid.New(),
+		Kind:      erasure.KindSubject,
+		Subject:   erasure.Subject{Email: "jane@example.com"},
+		Reference: "DSR-1",
+	}
+	require.NoError(t, p.Handle(context.Background(), erasure.EventErasureRequested, requested(t, req, "")))
+	assert.Equal(t, req, got)
+
+	require.Len(t, pub.events, 1)
+	assert.Equal(t, erasure.Topic, pub.topics[0])
+	completed, ok := pub.events[0].(erasure.ErasureCompleted)
+	require.True(t, ok)
+	assert.Equal(t, erasure.OutcomeCompleted, completed.Outcome)
+	assert.Equal(t, "identity-service", completed.Service)
+	assert.Equal(t, req.JobID, completed.JobID)
+	assert.Equal(t, req.TenantID.String(), completed.TenantID())
+	assert.Equal(t, 2, completed.Result.Erased)
+}
+
+func TestParticipant_ReportsFailures(t *testing.T) {
+	pub := &recordingPublisher{}
+	p := erasure.NewParticipant("identity-service", nil,
+		erasure.EraserFunc(func(context.Context, erasure.Request) (erasure.Result, error) {
+			return erasure.Result{Erased: 1}, errors.New("store unavailable")
+		}), pub, slog.Default())
+
+	req := erasure.Request{JobID: uuid.New(), TenantID: uuid.New(), Kind: erasure.KindSubject}
+	require.NoError(t, p.Handle(context.Background(), erasure.EventErasureRequested, requested(t, req, "")))
+
+	completed := pub.events[0].(erasure.ErasureCompleted)
+	assert.Equal(t, erasure.OutcomeFailed, completed.Outcome)
+	assert.Equal(t, "store unavailable", completed.Error)
+	assert.Zero(t, completed.Result.Erased)
+}
+
+func TestParticipant_IgnoresRequestsMeantForOthers(t *testing.T) {
+	pub := &recordingPublisher{}
+	calls := 0
+	p := erasure.NewParticipant("identity-service", []string{"identity.applicant_data"},
+		erasure.EraserFunc(func(context.Context, erasure.Request) (erasure.Result, error) {
+			calls++
+			return erasure.Result{}, nil
+		}), pub, slog.Default())
+	ctx := context.Background()
+	subject := erasure.Request{JobID: uuid.New(), Kind: erasure.KindSubject}
+
+	require.NoError(t, p.Handle(ctx, erasure.EventErasureRequested, requested(t, subject, "customer-service")))
+	require.NoError(t, p.Handle(ctx, erasure.EventErasureRequested, requested(t, erasure.Request{
+		JobID: uuid.New(), Kind: erasure.KindRetention, Category: "card.transactions", Cutoff: time.Now(),
+	}, "")))
+	require.NoError(t, p.Handle(ctx, erasure.EventErasureCompleted, []byte(`{}`)))
+	assert.Zero(t, calls)
+
+	require.NoError(t, p.Handle(ctx, erasure.EventErasureRequested, requested(t, erasure.Request{
+		JobID: uuid.New(), Kind: erasure.KindRetention, Category: "identity.applicant_data", Cutoff: time.Now(),
+	}, "identity-service")))
+	assert.Equal(t, 1, calls)
+
+	assert.Error(t, p.Handle(ctx, erasure.EventErasureRequested, []byte(`not json`)))
+}
+
+func TestParticipant_Register(t *testing.T) {
+	pub := &recordingPublisher{}
+	p := erasure.NewParticipant("identity-service", []string{"identity.applicant_data"}, nil, pub, slog.Default())
+	require.NoError(t, p.Register(context.Background()))
+
+	registered := pub.events[0].(erasure.ParticipantRegistered)
+	assert.Equal(t, erasure.EventParticipantRegistered, registered.EventType())
+	assert.Equal(t, []string{"identity.applicant_data"}, registered.Categories)
+}
+
+func TestEmailDigest_Normalizes(t *testing.T) {
+	assert.Equal(t, erasure.EmailDigest("jane@example.com"), erasure.EmailDigest("  Jane@Example.COM "))
+	assert.Len(t, erasure.EmailDigest("jane@example.com"), 64)
+	assert.Empty(t, erasure.EmailDigest(" "))
+}
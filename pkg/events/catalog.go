@@ -0,0 +1,98 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// Catalog is the committed record of the event schemas a service produces,
+// kept under events/ at the repository root.
+type Catalog struct {
+	Producer string   `json:"producer"`
+	Events   []Schema `json:"events"`
+}
+
+// LoadCatalog reads a catalog file.
+func LoadCatalog(path string) (*Catalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read event catalog: %w", err)
+	}
+	var c Catalog
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parse event catalog %s: %w", path, err)
+	}
+	return &c, nil
+}
+
+// Encode returns the catalog file contents: indented JSON.
+func (c *Catalog) Encode() ([]byte, error) {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode event catalog: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// CheckCompatible lists the changes from old to next that break consumers of
+// the same schema version: removing a field, changing its type, or making a
+// required field optional. Adding fields is compatible; anything else needs a
+// new version and an upcaster.
+func CheckCompatible(old, next Schema) []string {
+	fields := make(map[string]Field, len(next.Fields))
+	for _, f := range next.Fields {
+		fields[f.Name] = f
+	}
+	var problems []string
+	for _, was := range old.Fields {
+		is, ok := fields[was.Name]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("field %s was removed", was.Name))
+		case is.Type != was.Type:
+			problems = append(problems, fmt.Sprintf("field %s changed type from %s to %s", was.Name, was.Type, is.Type))
+		case is.Optional && !was.Optional:
+			problems = append(problems, fmt.Sprintf("field %s became optional", was.Name))
+		}
+	}
+	return problems
+}
+
+// RenderCatalog renders catalogs as Markdown documentation, one section per
+// producer.
+func RenderCatalog(catalogs []Catalog) []byte {
+	sort.Slice(catalogs, func(i, j int) bool { return catalogs[i].Producer < catalogs[j].Producer })
+
+	var b bytes.Buffer
+	b.WriteString("# Event catalog\n\n")
+	b.WriteString("<!-- Generated by make event-catalog from the JSON catalogs in this directory. Do not edit. -->\n\n")
+	b.WriteString("Every domain event the services publish, by producer. Payloads also carry the\n")
+	b.WriteString("envelope fields `event_id`, `event_type`, `aggregate_id`, `aggregate_type`,\n")
+	b.WriteString("`tenant_id` and `occurred_at`. Within a version fields are only ever added;\n")
+	b.WriteString("other changes publish a new version, and consumers upcast older payloads.\n")
+	for _, c := range catalogs {
+		fmt.Fprintf(&b, "\n## %s\n", c.Producer)
+		for _, s := range c.Events {
+			fmt.Fprintf(&b, "\n### %s v%d\n\n", s.Type, s.Version)
+			if s.Description != "" {
+				b.WriteString(s.Description + "\n\n")
+			}
+			if len(s.Fields) == 0 {
+				b.WriteString("No fields beyond the envelope.\n")
+				continue
+			}
+			b.WriteString("| Field | Type | Required |\n|---|---|---|\n")
+			for _, f := range s.Fields {
+				required := "yes"
+				if f.Optional {
+					required = "no"
+				}
+				fmt.Fprintf(&b, "| `%s` | %s | %s |\n", f.Name, f.Type, required)
+			}
+		}
+	}
+	return b.Bytes()
+}
@@ -0,0 +1,11 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/bibbank/bib/pkg/events/catalogtest"
+)
+
+func TestEventCatalogDocs(t *testing.T) {
+	catalogtest.CheckDocs(t, "../../events")
+}
@@ -0,0 +1,117 @@
+// Package catalogtest checks a service's declared event schemas against the
+// event catalog committed under events/ at the repository root, so a change
+// that would break consumers fails the service's tests. Run
+// make event-catalog to record additions.
+package catalogtest
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// UpdateEnv is the environment variable that makes CheckCatalog and
+// CheckDocs write the catalog instead of comparing against it.
+const UpdateEnv = "UPDATE_EVENT_CATALOG"
+
+// CheckCatalog compares producer's declared schemas with its catalog file at
+// path. It fails the test if a declared schema breaks a committed one of the
+// same version, if a committed schema is no longer declared, or if the file
+// is out of date. With UPDATE_EVENT_CATALOG set it writes the file instead,
+// unless a change is breaking.
+func CheckCatalog(t testing.TB, path, producer string, schemas []events.Schema) {
+	t.Helper()
+
+	declared := events.Catalog{Producer: producer, Events: make([]events.Schema, 0, len(schemas))}
+	registry := events.NewRegistry()
+	for _, s := range schemas {
+		if err := registry.Register(producer, s); err != nil {
+			t.Fatalf("event catalog: %v", err)
+		}
+	}
+	declared.Events = registry.Schemas()
+	recorded, err := declared.Encode()
+	if err != nil {
+		t.Fatalf("event catalog: %v", err)
+	}
+
+	committed, err := events.LoadCatalog(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist) && os.Getenv(UpdateEnv) != "":
+		committed = &events.Catalog{}
+	case err != nil:
+		t.Fatalf("event catalog: %v; run make event-catalog to create it", err)
+	}
+	for _, was := range committed.Events {
+		is, ok := registry.Schema(was.Type, was.Version)
+		if !ok {
+			t.Errorf("event catalog: %s v%d is no longer declared; consumers may still receive it", was.Type, was.Version)
+			continue
+		}
+		for _, problem := range events.CheckCompatible(was, is) {
+			t.Errorf("event catalog: %s v%d: %s; declare v%d with an upcaster instead", was.Type, was.Version, problem, was.Version+1)
+		}
+	}
+	if t.Failed() {
+		return
+	}
+
+	if os.Getenv(UpdateEnv) != "" {
+		writeFile(t, path, recorded)
+		return
+	}
+	current, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("event catalog: %v", err)
+	}
+	if !bytes.Equal(current, recorded) {
+		t.Errorf("event catalog: %s is out of date; run make event-catalog and review the diff.\nrecorded:\n%s", path, recorded)
+	}
+}
+
+// CheckDocs renders the catalogs in dir as Markdown and compares them
+// with dir/README.md, or writes it with UPDATE_EVENT_CATALOG set.
+func CheckDocs(t testing.TB, dir string) {
+	t.Helper()
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatalf("event catalog: %v", err)
+	}
+	catalogs := make([]events.Catalog, 0, len(paths))
+	for _, path := range paths {
+		c, err := events.LoadCatalog(path)
+		if err != nil {
+			t.Fatalf("event catalog: %v", err)
+		}
+		catalogs = append(catalogs, *c)
+	}
+	rendered := events.RenderCatalog(catalogs)
+
+	path := filepath.Join(dir, "README.md")
+	if os.Getenv(UpdateEnv) != "" {
+		writeFile(t, path, rendered)
+		return
+	}
+	current, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("event catalog: %v; run make event-catalog to create it", err)
+	}
+	if !bytes.Equal(current, rendered) {
+		t.Errorf("event catalog: %s is out of date; run make event-catalog", path)
+	}
+}
+
+func writeFile(t testing.TB, path string, data []byte) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("event catalog: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil { //nolint:gosec // the catalog is a committed source file
+		t.Fatalf("event catalog: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// CloudEvents attributes used when publishing domain events in the
+// CloudEvents 1.0 structured content mode.
+const (
+	CloudEventsSpecVersion = "1.0"
+	CloudEventsContentType = "application/cloudevents+json"
+)
+
+// ErrNotCloudEvent is returned when a payload is not a CloudEvents envelope.
+var ErrNotCloudEvent = errors.New("payload is not a CloudEvents envelope")
+
+// CloudEvent is a CloudEvents 1.0 envelope around a domain event. The
+// domain event itself is the data; tenantid and aggregatetype are extension
+// attributes so consumers can route without decoding the data.
+type CloudEvent struct {
+	Time            time.Time       `json:"time"`
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	DataContentType string          `json:"datacontenttype"`
+	DataSchema      string          `json:"dataschema,omitempty"`
+	TenantID        string          `json:"tenantid,omitempty"`
+	AggregateType   string          `json:"aggregatetype,omitempty"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// NewCloudEvent wraps a domain event in a CloudEvents envelope. Source
+// identifies the producing service, e.g. "/bib/identity-service"; the
+// subject is the aggregate the event belongs to, and the dataschema names the
+// event's schema version.
+func NewCloudEvent(source string, event DomainEvent) (CloudEvent, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return CloudEvent{}, fmt.Errorf("marshal event %s: %w", event.EventType(), err)
+	}
+	return CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              event.EventID(),
+		Source:          source,
+		Type:            event.EventType(),
+		Subject:         event.AggregateID(),
+		Time:            event.OccurredAt(),
+		DataContentType: "application/json",
+		DataSchema:      SchemaURI(event.EventType(), VersionOf(event)),
+		TenantID:        event.TenantID(),
+		AggregateType:   event.AggregateType(),
+		Data:            data,
+	}, nil
+}
+
+// ParseCloudEvent decodes a CloudEvents envelope in structured content mode.
+// It returns ErrNotCloudEvent for payloads without a specversion, such as
+// events published before the envelope was introduced.
+func ParseCloudEvent(payload []byte) (CloudEvent, error) {
+	var ce CloudEvent
+	if err := json.Unmarshal(payload, &ce); err != nil {
+		return CloudEvent{}, fmt.Errorf("decode CloudEvent: %w", err)
+	}
+	if ce.SpecVersion == "" {
+		return CloudEvent{}, ErrNotCloudEvent
+	}
+	if ce.SpecVersion != CloudEventsSpecVersion {
+		return CloudEvent{}, fmt.Errorf("unsupported CloudEvents specversion %q", ce.SpecVersion)
+	}
+	if ce.ID == "" || ce.Source == "" || ce.Type == "" {
+		return CloudEvent{}, fmt.Errorf("CloudEvent is missing id, source or type")
+	}
+	return ce, nil
+}
+
+// EventData returns the domain event carried by a message payload: the data
+// of a CloudEvents envelope, or the payload itself when it is a bare event.
+// Consumers use it to accept both forms while producers migrate.
+func EventData(payload []byte) ([]byte, error) {
+	ce, err := ParseCloudEvent(payload)
+	if errors.Is(err, ErrNotCloudEvent) {
+		return payload, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ce.Data, nil
+}
@@ -0,0 +1,97 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type testEvent struct {
+	BaseEvent
+	Amount int `json:"amount"`
+}
+
+func TestNewCloudEvent(t *testing.T) {
+	event := testEvent{BaseEvent: NewBaseEvent("account.opened", "agg-1", "Account", "tenant-1"), Amount: 42}
+
+	ce, err := NewCloudEvent("/bib/account-service", event)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if ce.SpecVersion != CloudEventsSpecVersion {
+		t.Errorf("expected specversion %q, got %q", CloudEventsSpecVersion, ce.SpecVersion)
+	}
+	if ce.ID != event.EventID() || ce.Type != "account.opened" || ce.Subject != "agg-1" {
+		t.Errorf("unexpected envelope attributes: %+v", ce)
+	}
+	if ce.Source != "/bib/account-service" || ce.TenantID != "tenant-1" || ce.AggregateType != "Account" {
+		t.Errorf("unexpected envelope attributes: %+v", ce)
+	}
+	if !ce.Time.Equal(event.OccurredAt()) {
+		t.Errorf("expected time %v, got %v", event.OccurredAt(), ce.Time)
+	}
+
+	var data testEvent
+	if err := json.Unmarshal(ce.Data, &data); err != nil {
+		t.Fatalf("failed to decode data: %v", err)
+	}
+	if data.Amount != 42 {
+		t.Errorf("expected amount 42, got %d", data.Amount)
+	}
+}
+
+func TestParseCloudEvent_RoundTrip(t *testing.T) {
+	event := testEvent{BaseEvent: NewBaseEvent("account.opened", "agg-1", "Account", "tenant-1"), Amount: 7}
+	ce, err := NewCloudEvent("/bib/account-service", event)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	payload, err := json.Marshal(ce)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	parsed, err := ParseCloudEvent(payload)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if parsed.ID != ce.ID || parsed.Type != ce.Type || string(parsed.Data) != string(ce.Data) {
+		t.Errorf("round trip mismatch: %+v vs %+v", parsed, ce)
+	}
+}
+
+func TestParseCloudEvent_Invalid(t *testing.T) {
+	if _, err := ParseCloudEvent([]byte(`{"event_type":"account.opened"}`)); !errors.Is(err, ErrNotCloudEvent) {
+		t.Errorf("expected ErrNotCloudEvent, got %v", err)
+	}
+	if _, err := ParseCloudEvent([]byte(`{"specversion":"0.3","id":"1","source":"s","type":"t"}`)); err == nil {
+		t.Error("expected error for unsupported specversion")
+	}
+	if _, err := ParseCloudEvent([]byte(`{"specversion":"1.0","id":"1"}`)); err == nil {
+		t.Error("expected error for missing source and type")
+	}
+	if _, err := ParseCloudEvent([]byte(`not json`)); err == nil {
+		t.Error("expected error for malformed payload")
+	}
+}
+
+func TestEventData(t *testing.T) {
+	bare := []byte(`{"event_type":"account.opened","amount":1}`)
+	data, err := EventData(bare)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != string(bare) {
+		t.Errorf("expected bare payload to pass through, got %s", data)
+	}
+
+	wrapped := []byte(`{"specversion":"1.0","id":"1","source":"/bib/x","type":"account.opened","data":{"amount":2}}`)
+	data, err = EventData(wrapped)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != `{"amount":2}` {
+		t.Errorf("expected envelope data, got %s", data)
+	}
+}
@@ -14,7 +14,10 @@ type OutboxEntry struct {
 	AggregateID   string
 	AggregateType string
 	EventType     string
-	Payload       []byte
+	// Topic is the topic the entry is published to. It is empty for entries
+	// written before the topic was known.
+	Topic   string
+	Payload []byte
 }
 
 // NewOutboxEntry creates an OutboxEntry from a DomainEvent.
@@ -0,0 +1,204 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// schemaURIPrefix prefixes the dataschema of CloudEvents: an event of
+// version 2 of "account.opened" has dataschema "urn:bib:event:account.opened:2".
+const schemaURIPrefix = "urn:bib:event:"
+
+// ErrUnknownEvent is returned when an event type or version is not registered.
+var ErrUnknownEvent = errors.New("event schema not registered")
+
+// Versioned is implemented by events whose payload is past version 1 of its
+// schema. Events that do not implement it are version 1.
+type Versioned interface {
+	SchemaVersion() int
+}
+
+// VersionOf returns the schema version of an event.
+func VersionOf(event DomainEvent) int {
+	if v, ok := event.(Versioned); ok {
+		return v.SchemaVersion()
+	}
+	return 1
+}
+
+// SchemaURI returns the CloudEvents dataschema of version of eventType.
+func SchemaURI(eventType string, version int) string {
+	return schemaURIPrefix + eventType + ":" + strconv.Itoa(version)
+}
+
+// ParseSchemaVersion returns the version in a dataschema written by
+// SchemaURI. Envelopes without a dataschema predate versioning and are
+// version 1.
+func ParseSchemaVersion(dataSchema string) (int, error) {
+	if dataSchema == "" {
+		return 1, nil
+	}
+	rest, ok := strings.CutPrefix(dataSchema, schemaURIPrefix)
+	if !ok {
+		return 0, fmt.Errorf("unrecognized dataschema %q", dataSchema)
+	}
+	i := strings.LastIndex(rest, ":")
+	version, err := strconv.Atoi(rest[i+1:])
+	if i < 0 || err != nil || version < 1 {
+		return 0, fmt.Errorf("dataschema %q has no version", dataSchema)
+	}
+	return version, nil
+}
+
+// Upcaster rewrites a payload of one version of an event type into the next
+// version, such as by renaming or splitting a field.
+type Upcaster func(payload map[string]any) (map[string]any, error)
+
+type schemaKey struct {
+	eventType string
+	version   int
+}
+
+// Registry holds the schemas of the event types a service produces or
+// consumes, and the upcasters that carry old payloads to the latest version.
+type Registry struct {
+	schemas   map[schemaKey]Schema
+	latest    map[string]int
+	upcasters map[schemaKey]Upcaster
+}
+
+// NewRegistry creates a new, empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		schemas:   make(map[schemaKey]Schema),
+		latest:    make(map[string]int),
+		upcasters: make(map[schemaKey]Upcaster),
+	}
+}
+
+// Register adds the schemas of producer's events. Each event type and
+// version may be registered once.
+func (r *Registry) Register(producer string, schemas ...Schema) error {
+	for _, s := range schemas {
+		if s.Type == "" || s.Version < 1 {
+			return fmt.Errorf("schema %q version %d: type and a positive version are required", s.Type, s.Version)
+		}
+		key := schemaKey{s.Type, s.Version}
+		if existing, ok := r.schemas[key]; ok {
+			return fmt.Errorf("event %s v%d already registered by %s", s.Type, s.Version, existing.Producer)
+		}
+		s.Producer = producer
+		r.schemas[key] = s
+		if s.Version > r.latest[s.Type] {
+			r.latest[s.Type] = s.Version
+		}
+	}
+	return nil
+}
+
+// RegisterUpcaster adds the upcaster from version from of eventType to
+// version from+1.
+func (r *Registry) RegisterUpcaster(eventType string, from int, u Upcaster) error {
+	key := schemaKey{eventType, from}
+	if _, ok := r.upcasters[key]; ok {
+		return fmt.Errorf("upcaster for %s v%d already registered", eventType, from)
+	}
+	r.upcasters[key] = u
+	return nil
+}
+
+// Schema returns a version of an event type's schema.
+func (r *Registry) Schema(eventType string, version int) (Schema, bool) {
+	s, ok := r.schemas[schemaKey{eventType, version}]
+	return s, ok
+}
+
+// Latest returns the newest version of an event type's schema.
+func (r *Registry) Latest(eventType string) (Schema, bool) {
+	return r.Schema(eventType, r.latest[eventType])
+}
+
+// Schemas returns every registered schema, ordered by type and version.
+func (r *Registry) Schemas() []Schema {
+	out := make([]Schema, 0, len(r.schemas))
+	for _, s := range r.schemas {
+		out = append(out, s)
+	}
+	sortSchemas(out)
+	return out
+}
+
+// Validate checks that each event type's versions run from 1 without gaps
+// and that each version but the latest has an upcaster to the next.
+func (r *Registry) Validate() error {
+	var errs []error
+	for eventType, latest := range r.latest {
+		for v := 1; v <= latest; v++ {
+			if _, ok := r.schemas[schemaKey{eventType, v}]; !ok {
+				errs = append(errs, fmt.Errorf("event %s: version %d is missing", eventType, v))
+			}
+			if _, ok := r.upcasters[schemaKey{eventType, v}]; v < latest && !ok {
+				errs = append(errs, fmt.Errorf("event %s: no upcaster from v%d to v%d", eventType, v, v+1))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Upcast carries a payload of version of eventType to the latest version,
+// returning the payload and the version it is now at. Payloads already at
+// the latest version are returned unchanged.
+func (r *Registry) Upcast(eventType string, version int, payload json.RawMessage) (json.RawMessage, int, error) {
+	latest, ok := r.latest[eventType]
+	if !ok || version < 1 || version > latest {
+		return nil, 0, fmt.Errorf("%w: %s v%d", ErrUnknownEvent, eventType, version)
+	}
+	if version == latest {
+		return payload, version, nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return nil, 0, fmt.Errorf("decode %s v%d payload: %w", eventType, version, err)
+	}
+	for ; version < latest; version++ {
+		upcast, ok := r.upcasters[schemaKey{eventType, version}]
+		if !ok {
+			return nil, 0, fmt.Errorf("event %s: no upcaster from v%d to v%d", eventType, version, version+1)
+		}
+		var err error
+		if fields, err = upcast(fields); err != nil {
+			return nil, 0, fmt.Errorf("upcast %s from v%d: %w", eventType, version, err)
+		}
+	}
+	out, err := json.Marshal(fields)
+	if err != nil {
+		return nil, 0, fmt.Errorf("encode %s v%d payload: %w", eventType, latest, err)
+	}
+	return out, latest, nil
+}
+
+// UpcastCloudEvent returns the data of a CloudEvents envelope carried to the
+// latest version of its event type, reading its version from the
+// dataschema.
+func (r *Registry) UpcastCloudEvent(ce CloudEvent) (json.RawMessage, error) {
+	version, err := ParseSchemaVersion(ce.DataSchema)
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := r.Upcast(ce.Type, version, ce.Data)
+	return data, err
+}
+
+func sortSchemas(schemas []Schema) {
+	sort.Slice(schemas, func(i, j int) bool {
+		if schemas[i].Type != schemas[j].Type {
+			return schemas[i].Type < schemas[j].Type
+		}
+		return schemas[i].Version < schemas[j].Version
+	})
+}
@@ -0,0 +1,181 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type legPayload struct {
+	Amount string `json:"amount"`
+	Note   string `json:"note,omitempty"`
+}
+
+type transferEvent struct {
+	BookedAt *time.Time `json:"booked_at"`
+	BaseEvent
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	Reference string            `json:"reference"`
+	Legs      []legPayload      `json:"legs"`
+	Tags      []string          `json:"tags"`
+	ID        uuid.UUID         `json:"id"`
+	Count     int               `json:"count"`
+	Rate      float64           `json:"rate"`
+	Final     bool              `json:"final"`
+	internal  string
+}
+
+type transferEventV2 struct {
+	BaseEvent
+	Memo string `json:"memo"`
+}
+
+func (transferEventV2) SchemaVersion() int { return 2 }
+
+func TestDeclare(t *testing.T) {
+	s := Declare[transferEvent]("transfer.booked", 1, "A transfer was booked.")
+
+	want := []Field{
+		{Name: "booked_at", Type: FieldTimestamp, Optional: true},
+		{Name: "count", Type: FieldInteger},
+		{Name: "final", Type: FieldBoolean},
+		{Name: "id", Type: FieldString},
+		{Name: "legs", Type: FieldArray},
+		{Name: "legs[].amount", Type: FieldString},
+		{Name: "legs[].note", Type: FieldString, Optional: true},
+		{Name: "metadata", Type: FieldObject, Optional: true},
+		{Name: "rate", Type: FieldNumber},
+		{Name: "reference", Type: FieldString},
+		{Name: "tags", Type: FieldArray},
+	}
+	if !reflect.DeepEqual(s.Fields, want) {
+		t.Errorf("fields = %+v\nwant %+v", s.Fields, want)
+	}
+	if s.Type != "transfer.booked" || s.Version != 1 || s.Description != "A transfer was booked." {
+		t.Errorf("schema = %+v", s)
+	}
+}
+
+func TestCheckCompatible(t *testing.T) {
+	old := Schema{Fields: []Field{
+		{Name: "amount", Type: FieldString},
+		{Name: "count", Type: FieldInteger},
+		{Name: "memo", Type: FieldString},
+		{Name: "reason", Type: FieldString, Optional: true},
+	}}
+	next := Schema{Fields: []Field{
+		{Name: "amount", Type: FieldString},
+		{Name: "count", Type: FieldNumber},
+		{Name: "memo", Type: FieldString, Optional: true},
+		{Name: "reason", Type: FieldString},
+		{Name: "added", Type: FieldString},
+	}}
+	if got := CheckCompatible(old, old); len(got) != 0 {
+		t.Errorf("unchanged schema: %v", got)
+	}
+	got := CheckCompatible(old, next)
+	want := []string{"field count changed type from integer to number", "field memo became optional"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("problems = %q, want %q", got, want)
+	}
+
+	next.Fields = next.Fields[1:]
+	if got := CheckCompatible(old, next); len(got) == 0 || got[0] != "field amount was removed" {
+		t.Errorf("removed field: %q", got)
+	}
+}
+
+func newTransferRegistry(t *testing.T) *Registry {
+	t.Helper()
+	r := NewRegistry()
+	err := r.Register("payment-service",
+		Declare[transferEvent]("transfer.booked", 1, ""),
+		Declare[transferEventV2]("transfer.booked", 2, ""),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.RegisterUpcaster("transfer.booked", 1, func(p map[string]any) (map[string]any, error) {
+		p["memo"] = p["reference"]
+		delete(p, "reference")
+		return p, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestRegistry_Upcast(t *testing.T) {
+	r := newTransferRegistry(t)
+	if err := r.Validate(); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if latest, _ := r.Latest("transfer.booked"); latest.Version != 2 || latest.Producer != "payment-service" {
+		t.Errorf("latest = %+v", latest)
+	}
+
+	data, version, err := r.Upcast("transfer.booked", 1, json.RawMessage(`{"reference":"INV-7"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != 2 || string(data) != `{"memo":"INV-7"}` {
+		t.Errorf("upcast = %s v%d", data, version)
+	}
+
+	current := json.RawMessage(`{"memo":"x"}`)
+	if data, _, _ := r.Upcast("transfer.booked", 2, current); string(data) != string(current) {
+		t.Errorf("latest payload changed: %s", data)
+	}
+	if _, _, err := r.Upcast("transfer.reversed", 1, current); !errors.Is(err, ErrUnknownEvent) {
+		t.Errorf("unknown event: %v", err)
+	}
+}
+
+func TestRegistry_Validate(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Register("svc", Declare[transferEventV2]("transfer.booked", 2, "")); err != nil {
+		t.Fatal(err)
+	}
+	err := r.Validate()
+	if err == nil || !strings.Contains(err.Error(), "version 1 is missing") || !strings.Contains(err.Error(), "no upcaster from v1") {
+		t.Errorf("Validate = %v", err)
+	}
+	if err := r.Register("other", Declare[transferEventV2]("transfer.booked", 2, "")); err == nil {
+		t.Error("registering a version twice succeeded")
+	}
+}
+
+func TestRegistry_UpcastCloudEvent(t *testing.T) {
+	r := newTransferRegistry(t)
+
+	v2 := transferEventV2{BaseEvent: NewBaseEvent("transfer.booked", "agg-1", "Transfer", "tenant-1"), Memo: "m"}
+	ce, err := NewCloudEvent("/bib/payment-service", v2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ce.DataSchema != "urn:bib:event:transfer.booked:2" {
+		t.Errorf("dataschema = %q", ce.DataSchema)
+	}
+
+	// An envelope from before versioning is version 1.
+	ce.DataSchema = ""
+	ce.Data = json.RawMessage(`{"reference":"R"}`)
+	data, err := r.UpcastCloudEvent(ce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"memo":"R"}` {
+		t.Errorf("data = %s", data)
+	}
+
+	ce.DataSchema = "https://example.com/schema"
+	if _, err := r.UpcastCloudEvent(ce); err == nil {
+		t.Error("foreign dataschema accepted")
+	}
+}
@@ -0,0 +1,164 @@
+package events
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxSchemaDepth bounds how deep nested payload types are described, so
+// recursive types terminate.
+const maxSchemaDepth = 6
+
+// Field types in a Schema.
+const (
+	FieldString    = "string"
+	FieldInteger   = "integer"
+	FieldNumber    = "number"
+	FieldBoolean   = "boolean"
+	FieldTimestamp = "timestamp"
+	FieldObject    = "object"
+	FieldArray     = "array"
+	FieldAny       = "any"
+)
+
+// Field is one JSON field of an event payload. Nested fields are named by
+// path: "limits.daily" for a field of an object, "legs[].amount" for a field
+// of the objects in an array.
+type Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// Optional marks fields that may be absent or null.
+	Optional bool `json:"optional,omitempty"`
+}
+
+// Schema declares one version of an event type: its name, the service that
+// produces it and the fields of its payload. The envelope fields every event
+// carries through BaseEvent are not listed.
+type Schema struct {
+	Type        string  `json:"type"`
+	Producer    string  `json:"producer"`
+	Description string  `json:"description,omitempty"`
+	Fields      []Field `json:"fields"`
+	Version     int     `json:"version"`
+}
+
+// Declare returns the schema of version of the event type eventType, whose
+// payload is the Go type T, with its fields read from T's JSON encoding and
+// listed by name.
+func Declare[T DomainEvent](eventType string, version int, description string) Schema {
+	var fields []Field
+	describe(reflect.TypeFor[T](), "", false, 0, &fields)
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Name < fields[j].Name })
+	return Schema{Type: eventType, Version: version, Description: description, Fields: fields}
+}
+
+var (
+	baseEventType     = reflect.TypeFor[BaseEvent]()
+	timeType          = reflect.TypeFor[time.Time]()
+	rawMessageType    = reflect.TypeFor[json.RawMessage]()
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// describe appends the JSON fields of struct type t to out.
+func describe(t reflect.Type, prefix string, optional bool, depth int, out *[]Field) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || depth > maxSchemaDepth {
+		return
+	}
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name, omitEmpty, skip := jsonName(f)
+		if skip {
+			continue
+		}
+		ft := f.Type
+		if f.Anonymous && name == "" {
+			if ft == baseEventType {
+				continue
+			}
+			describe(ft, prefix, optional, depth, out)
+			continue
+		}
+		fieldOptional := optional || omitEmpty
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+			fieldOptional = true
+		}
+		path := prefix + name
+		*out = append(*out, Field{Name: path, Type: typeOf(ft), Optional: fieldOptional})
+		switch {
+		case typeOf(ft) == FieldObject && ft.Kind() == reflect.Struct:
+			describe(ft, path+".", fieldOptional, depth+1, out)
+		case typeOf(ft) == FieldArray:
+			describe(ft.Elem(), path+"[].", fieldOptional, depth+1, out)
+		}
+	}
+}
+
+// jsonName returns the JSON name of a struct field, whether it is omitted
+// when empty, and whether it is not encoded at all. Embedded structs without
+// a name return "".
+func jsonName(f reflect.StructField) (name string, omitEmpty, skip bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	omitEmpty = strings.Contains(","+opts+",", ",omitempty,")
+	if f.Anonymous && name == "" {
+		return "", omitEmpty, false
+	}
+	if !f.IsExported() {
+		return "", false, true
+	}
+	if name == "" {
+		name = f.Name
+	}
+	return name, omitEmpty, false
+}
+
+// typeOf returns the Schema type of Go type t as encoding/json writes it.
+func typeOf(t reflect.Type) string {
+	switch {
+	case t == timeType:
+		return FieldTimestamp
+	case t == rawMessageType:
+		return FieldAny
+	case marshalsItself(t):
+		// Identifiers and decimals marshal themselves as strings.
+		return FieldString
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return FieldString
+	case reflect.Bool:
+		return FieldBoolean
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return FieldInteger
+	case reflect.Float32, reflect.Float64:
+		return FieldNumber
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return FieldString
+		}
+		return FieldArray
+	case reflect.Map, reflect.Struct:
+		return FieldObject
+	default:
+		return FieldAny
+	}
+}
+
+func marshalsItself(t reflect.Type) bool {
+	pt := reflect.PointerTo(t)
+	return t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType) ||
+		pt.Implements(jsonMarshalerType) || pt.Implements(textMarshalerType)
+}
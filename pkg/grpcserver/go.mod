@@ -0,0 +1,29 @@
+module github.com/bibbank/bib/pkg/grpcserver
+
+go 1.24
+
+require (
+	github.com/bibbank/bib/pkg/auth v0.0.0
+	github.com/bibbank/bib/pkg/tlsutil v0.0.0
+	go.opentelemetry.io/otel v1.32.0
+	go.opentelemetry.io/otel/metric v1.32.0
+	go.opentelemetry.io/otel/trace v1.32.0
+	google.golang.org/grpc v1.68.1
+)
+
+require (
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/golang-jwt/jwt/v5 v5.2.1 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	golang.org/x/net v0.29.0 // indirect
+	golang.org/x/sys v0.25.0 // indirect
+	golang.org/x/text v0.18.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240903143218-8af14fe29dc1 // indirect
+	google.golang.org/protobuf v1.35.2 // indirect
+)
+
+replace (
+	github.com/bibbank/bib/pkg/auth => ../auth
+	github.com/bibbank/bib/pkg/tlsutil => ../tlsutil
+)
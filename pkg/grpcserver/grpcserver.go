@@ -0,0 +1,200 @@
+// Package grpcserver builds the gRPC server every service runs, so each one
+// gets the same behaviour without wiring it by hand: JWT authentication,
+// call logging and metrics, panic recovery, deadline enforcement, request
+// validation, health checks, optional TLS and optional reflection.
+//
+// Unary calls pass through the interceptors in this order:
+//
+//	logging → metrics → recovery → deadline → cancellation → auth → validation → Config.Interceptors
+//
+// so logs and metrics see every outcome, including recovered panics and
+// rejected credentials, and service-specific interceptors such as
+// idempotency run with the caller's claims in the context.
+//
+// Every call runs under a deadline, and its context is cancelled when the
+// client goes away. Handlers must pass that context to their queries and
+// downstream calls, so work nobody is waiting for stops with it.
+package grpcserver
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/tlsutil"
+)
+
+const (
+	defaultTimeout = 30 * time.Second
+	maxTimeout     = 2 * time.Minute
+)
+
+// healthMethods are never authenticated, so orchestrators can probe them.
+var healthMethods = []string{
+	"/grpc.health.v1.Health/Check",
+	"/grpc.health.v1.Health/Watch",
+}
+
+// reflectionMethods are not authenticated when reflection is enabled, so
+// tools like grpcurl can list services without a token.
+var reflectionMethods = []string{
+	"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo",
+}
+
+// Config configures a Server.
+type Config struct {
+	// JWTService validates the bearer token of every call. It is required.
+	JWTService *auth.JWTService
+	// MeterProvider records call metrics; nil uses the global provider.
+	MeterProvider metric.MeterProvider
+	// ServiceName is the name the service reports as serving on the health
+	// service, such as "limits-service". It is required.
+	ServiceName string
+	// TLSCertFile and TLSKeyFile enable TLS. They default to the
+	// GRPC_TLS_CERT_FILE and GRPC_TLS_KEY_FILE environment variables; with
+	// neither set the server runs without TLS.
+	TLSCertFile string
+	TLSKeyFile  string
+	// PublicMethods are full method names called without a token, in
+	// addition to the health checks.
+	PublicMethods []string
+	// Interceptors run innermost, after authentication and validation.
+	Interceptors []grpc.UnaryServerInterceptor
+	// ServerOptions are passed to grpc.NewServer after the server's own.
+	ServerOptions []grpc.ServerOption
+	// DefaultTimeout is the deadline given to calls that arrive without one.
+	// Defaults to thirty seconds.
+	DefaultTimeout time.Duration
+	// MaxTimeout caps the deadline of any call. Defaults to two minutes.
+	MaxTimeout time.Duration
+	// MethodTimeouts overrides DefaultTimeout and MaxTimeout for particular
+	// methods, keyed by full method name or by service prefix such as
+	// "/bib.reporting.v1.ReportingService/".
+	MethodTimeouts map[string]time.Duration
+	// Reflection registers the reflection service, for tools such as
+	// grpcurl. It is also enabled by GRPC_REFLECTION=true.
+	Reflection bool
+}
+
+// Server is a gRPC server with the platform's standard interceptors and a
+// health service. It implements grpc.ServiceRegistrar, so generated
+// Register functions accept it.
+type Server struct {
+	server *grpc.Server
+	health *health.Server
+	logger *slog.Logger
+}
+
+// New creates a new Server. Services register their handlers on it before
+// calling Serve. logger may be nil.
+func New(cfg Config, logger *slog.Logger) (*Server, error) {
+	if cfg.ServiceName == "" {
+		return nil, errors.New("grpcserver: service name is required")
+	}
+	if cfg.JWTService == nil {
+		return nil, errors.New("grpcserver: JWT service is required")
+	}
+	if cfg.DefaultTimeout <= 0 {
+		cfg.DefaultTimeout = defaultTimeout
+	}
+	if cfg.MaxTimeout <= 0 {
+		cfg.MaxTimeout = maxTimeout
+	}
+	if cfg.TLSCertFile == "" && cfg.TLSKeyFile == "" {
+		cfg.TLSCertFile, cfg.TLSKeyFile = os.Getenv("GRPC_TLS_CERT_FILE"), os.Getenv("GRPC_TLS_KEY_FILE")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger = logger.With("service", cfg.ServiceName)
+
+	metrics, err := UnaryMetrics(cfg.MeterProvider)
+	if err != nil {
+		return nil, fmt.Errorf("grpcserver: register metrics: %w", err)
+	}
+	skip := append(append([]string(nil), healthMethods...), cfg.PublicMethods...)
+	withReflection := cfg.Reflection || os.Getenv("GRPC_REFLECTION") == "true"
+	if withReflection {
+		skip = append(skip, reflectionMethods...)
+	}
+	unary := append([]grpc.UnaryServerInterceptor{
+		UnaryLogging(logger),
+		metrics,
+		UnaryRecovery(logger),
+		UnaryMethodDeadline(cfg.DefaultTimeout, cfg.MaxTimeout, cfg.MethodTimeouts),
+		UnaryCancellation(),
+		auth.UnaryAuthInterceptor(cfg.JWTService, skip),
+		UnaryValidation(),
+	}, cfg.Interceptors...)
+
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(
+			StreamRecovery(logger),
+			auth.StreamAuthInterceptor(cfg.JWTService, skip),
+		),
+	}
+	switch {
+	case cfg.TLSCertFile != "" && cfg.TLSKeyFile != "":
+		creds, err := tlsutil.ServerTLSConfig(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("grpcserver: load TLS credentials: %w", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+		logger.Info("gRPC TLS enabled", "cert", cfg.TLSCertFile, "key", cfg.TLSKeyFile)
+	case cfg.TLSCertFile != "" || cfg.TLSKeyFile != "":
+		return nil, errors.New("grpcserver: TLS needs both a certificate and a key")
+	default:
+		logger.Info("gRPC TLS not configured, running without TLS")
+	}
+	opts = append(opts, cfg.ServerOptions...)
+
+	srv := grpc.NewServer(opts...)
+	healthSrv := health.NewServer()
+	healthpb.RegisterHealthServer(srv, healthSrv)
+	healthSrv.SetServingStatus(cfg.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	if withReflection {
+		reflection.Register(srv)
+	}
+
+	return &Server{server: srv, health: healthSrv, logger: logger}, nil
+}
+
+// RegisterService implements grpc.ServiceRegistrar.
+func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	s.server.RegisterService(desc, impl)
+}
+
+// Serve accepts connections on lis until Stop is called.
+func (s *Server) Serve(lis net.Listener) error {
+	s.logger.Info("gRPC server starting", slog.String("addr", lis.Addr().String()))
+	return s.server.Serve(lis)
+}
+
+// ListenAndServe listens on addr, such as ":9101", and serves on it.
+func (s *Server) ListenAndServe(addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("listen on %s: %w", addr, err)
+	}
+	return s.Serve(lis)
+}
+
+// Stop reports the service as not serving, so load balancers stop routing
+// to it, then waits for in-flight calls to finish.
+func (s *Server) Stop() {
+	s.logger.Info("gRPC server stopping")
+	s.health.Shutdown()
+	s.server.GracefulStop()
+}
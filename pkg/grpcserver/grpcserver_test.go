@@ -0,0 +1,193 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+var testInfo = &grpc.UnaryServerInfo{FullMethod: "/bib.test.v1.TestService/Do"}
+
+type validatedRequest struct{ err error }
+
+func (r validatedRequest) Validate() error { return r.err }
+
+func TestUnaryRecovery_ReturnsInternal(t *testing.T) {
+	interceptor := UnaryRecovery(discardLogger())
+	_, err := interceptor(context.Background(), nil, testInfo, func(context.Context, any) (any, error) {
+		panic("nil map write")
+	})
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("error = %v, want Internal", err)
+	}
+	if msg := status.Convert(err).Message(); !strings.HasPrefix(msg, "internal error (ref ") {
+		t.Errorf("message = %q, want a panic reference", msg)
+	}
+}
+
+func TestUnaryDeadline(t *testing.T) {
+	interceptor := UnaryDeadline(time.Second, time.Minute)
+	remaining := func(ctx context.Context) time.Duration {
+		var left time.Duration
+		_, _ = interceptor(ctx, nil, testInfo, func(ctx context.Context, _ any) (any, error) {
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				t.Fatal("handler ran without a deadline")
+			}
+			left = time.Until(deadline)
+			return nil, nil
+		})
+		return left
+	}
+
+	if left := remaining(context.Background()); left > time.Second {
+		t.Errorf("default deadline = %v, want at most 1s", left)
+	}
+	long, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	if left := remaining(long); left > time.Minute {
+		t.Errorf("capped deadline = %v, want at most 1m", left)
+	}
+	short, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if left := remaining(short); left <= time.Second {
+		t.Errorf("caller's deadline = %v, want it kept", left)
+	}
+}
+
+func TestUnaryMethodDeadline(t *testing.T) {
+	interceptor := UnaryMethodDeadline(time.Second, time.Minute, map[string]time.Duration{
+		"/bib.test.v1.TestService/":       5 * time.Minute,
+		"/bib.test.v1.TestService/Quick":  100 * time.Millisecond,
+		"/bib.other.v1.OtherService/Noop": time.Hour,
+	})
+	remaining := func(method string) time.Duration {
+		var left time.Duration
+		_, _ = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, _ any) (any, error) {
+				deadline, _ := ctx.Deadline()
+				left = time.Until(deadline)
+				return nil, nil
+			})
+		return left
+	}
+
+	if left := remaining("/bib.test.v1.TestService/Quick"); left > 100*time.Millisecond {
+		t.Errorf("method deadline = %v, want at most 100ms", left)
+	}
+	if left := remaining("/bib.test.v1.TestService/Export"); left <= time.Minute || left > 5*time.Minute {
+		t.Errorf("service deadline = %v, want the 5m service limit", left)
+	}
+	if left := remaining("/bib.other.v1.OtherService/Do"); left > time.Second {
+		t.Errorf("default deadline = %v, want at most 1s", left)
+	}
+}
+
+func TestUnaryCancellation(t *testing.T) {
+	interceptor := UnaryCancellation()
+
+	gone, cancel := context.WithCancel(context.Background())
+	cancel()
+	called := false
+	_, err := interceptor(gone, nil, testInfo, func(context.Context, any) (any, error) {
+		called = true
+		return nil, nil
+	})
+	if status.Code(err) != codes.Canceled || called {
+		t.Fatalf("error = %v, called = %v; want Canceled without calling the handler", err, called)
+	}
+
+	expiring, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = interceptor(expiring, nil, testInfo, func(ctx context.Context, _ any) (any, error) {
+		<-ctx.Done()
+		return nil, status.Errorf(codes.Internal, "query accounts: %v", ctx.Err())
+	})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("error = %v, want the handler's Internal reported as DeadlineExceeded", err)
+	}
+
+	_, err = interceptor(context.Background(), nil, testInfo, func(context.Context, any) (any, error) {
+		return nil, status.Error(codes.Internal, "constraint violated")
+	})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("error = %v, want a live call's error kept", err)
+	}
+}
+
+func TestUnaryValidation_RejectsInvalidRequests(t *testing.T) {
+	interceptor := UnaryValidation()
+	called := false
+	handler := func(context.Context, any) (any, error) {
+		called = true
+		return nil, nil
+	}
+
+	_, err := interceptor(context.Background(), validatedRequest{err: errors.New("amount is required")}, testInfo, handler)
+	if status.Code(err) != codes.InvalidArgument || called {
+		t.Fatalf("error = %v, called = %v; want InvalidArgument without calling the handler", err, called)
+	}
+	if _, err := interceptor(context.Background(), validatedRequest{}, testInfo, handler); err != nil || !called {
+		t.Fatalf("error = %v, called = %v; want the handler called", err, called)
+	}
+}
+
+func TestServer_ServesHealthWithoutToken(t *testing.T) {
+	jwtSvc, err := auth.NewJWTService(auth.JWTConfig{Secret: "test-secret", Issuer: "bib-test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv, err := New(Config{ServiceName: "test-service", JWTService: jwtSvc}, discardLogger())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = srv.Serve(lis) }()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	client := healthpb.NewHealthClient(conn)
+	resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "test-service"})
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("status = %v, want SERVING", resp.GetStatus())
+	}
+
+	srv.Stop()
+	if _, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "test-service"}); err == nil {
+		t.Error("Check() after Stop succeeded, want an error")
+	}
+}
+
+func TestNew_RequiresJWTService(t *testing.T) {
+	if _, err := New(Config{ServiceName: "test-service"}, discardLogger()); err == nil {
+		t.Fatal("New() without a JWT service succeeded")
+	}
+}
@@ -0,0 +1,229 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Validator is implemented by requests that can check their own fields.
+// UnaryValidation rejects a request whose Validate returns an error.
+type Validator interface {
+	Validate() error
+}
+
+// UnaryRecovery turns a panicking handler into an Internal error, so one
+// bad request cannot take down the process. The panic is logged with its
+// stack and recorded on the call's trace span under a short reference,
+// which the error message carries so a caller's report can be matched to
+// the log line.
+func UnaryRecovery(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				resp, err = nil, recovered(ctx, logger, info.FullMethod, r)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecovery is UnaryRecovery for streaming handlers.
+func StreamRecovery(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(ss.Context(), logger, info.FullMethod, r)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+// recovered logs and traces a panic and returns the Internal error the
+// caller sees.
+func recovered(ctx context.Context, logger *slog.Logger, method string, r any) error {
+	stack := string(debug.Stack())
+	ref := panicRef()
+	logger.ErrorContext(ctx, "grpc handler panicked",
+		"method", method, "panic", r, "panic_ref", ref, "stack", stack)
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(fmt.Errorf("panic: %v", r), trace.WithAttributes(
+		attribute.String("exception.stacktrace", stack),
+		attribute.String("panic.ref", ref),
+	))
+	span.SetStatus(otelcodes.Error, "handler panicked")
+	return status.Errorf(codes.Internal, "internal error (ref %s)", ref)
+}
+
+// panicRef returns a short random reference for a recovered panic.
+func panicRef() string {
+	var b [6]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// UnaryDeadline bounds how long a handler may run: a request without a
+// deadline gets defaultTimeout, and one whose deadline is further off than
+// maxTimeout is cut to it. A zero duration leaves that case alone.
+func UnaryDeadline(defaultTimeout, maxTimeout time.Duration) grpc.UnaryServerInterceptor {
+	return UnaryMethodDeadline(defaultTimeout, maxTimeout, nil)
+}
+
+// UnaryMethodDeadline is UnaryDeadline with limits for particular methods.
+// methods maps a full method name, or a service prefix such as
+// "/bib.reporting.v1.ReportingService/", to the timeout that replaces both
+// defaultTimeout and maxTimeout for it, so a slow export can be given
+// longer than the service default and a hot path held to less. An exact
+// method name wins over a service prefix.
+func UnaryMethodDeadline(defaultTimeout, maxTimeout time.Duration, methods map[string]time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		defaultFor, maxFor := defaultTimeout, maxTimeout
+		if limit, ok := methodTimeout(methods, info.FullMethod); ok {
+			defaultFor, maxFor = limit, limit
+		}
+		deadline, ok := ctx.Deadline()
+		switch {
+		case !ok && defaultFor > 0:
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, defaultFor)
+			defer cancel()
+		case ok && maxFor > 0 && time.Until(deadline) > maxFor:
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, maxFor)
+			defer cancel()
+		}
+		return handler(ctx, req)
+	}
+}
+
+// methodTimeout looks fullMethod up by name, then by service prefix.
+func methodTimeout(methods map[string]time.Duration, fullMethod string) (time.Duration, bool) {
+	if limit, ok := methods[fullMethod]; ok {
+		return limit, true
+	}
+	if i := strings.LastIndex(fullMethod, "/"); i > 0 {
+		limit, ok := methods[fullMethod[:i+1]]
+		return limit, ok
+	}
+	return 0, false
+}
+
+// UnaryCancellation stops work whose caller has gone. gRPC cancels a
+// call's context when the client disconnects or the deadline passes, and
+// handlers pass that context to their queries, so the database abandons
+// the statement. This interceptor also refuses to start a handler whose
+// context is already done, and reports a handler that failed because its
+// context ended as Canceled or DeadlineExceeded rather than the Internal
+// or Unknown it wrapped the driver's error in, so an impatient client does
+// not read as a server fault in logs and metrics.
+func UnaryCancellation() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+		resp, err := handler(ctx, req)
+		if err != nil && ctx.Err() != nil {
+			if code := status.Code(err); code != codes.Canceled && code != codes.DeadlineExceeded {
+				return nil, status.FromContextError(ctx.Err()).Err()
+			}
+		}
+		return resp, err
+	}
+}
+
+// UnaryValidation rejects requests implementing Validator whose Validate
+// fails, with InvalidArgument, before they reach the handler.
+func UnaryValidation() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if v, ok := req.(Validator); ok {
+			if err := v.Validate(); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// UnaryLogging logs every call with its method, status code and duration.
+// Calls failing with a server-side code are logged as errors, other
+// failures at info and successes at debug, so a healthy service logs
+// little.
+func UnaryLogging(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		code := status.Code(err)
+		attrs := []any{
+			"method", info.FullMethod,
+			"code", code.String(),
+			"duration_ms", time.Since(start).Milliseconds(),
+		}
+		switch {
+		case code == codes.OK:
+			logger.DebugContext(ctx, "grpc call", attrs...)
+		case isServerError(code):
+			logger.ErrorContext(ctx, "grpc call failed", append(attrs, "error", err)...)
+		default:
+			logger.InfoContext(ctx, "grpc call rejected", append(attrs, "error", err)...)
+		}
+		return resp, err
+	}
+}
+
+// UnaryMetrics records the rpc.server.requests counter and the
+// rpc.server.duration histogram, by method and status code. A nil provider
+// uses the global one.
+func UnaryMetrics(provider metric.MeterProvider) (grpc.UnaryServerInterceptor, error) {
+	if provider == nil {
+		provider = otel.GetMeterProvider()
+	}
+	meter := provider.Meter("github.com/bibbank/bib/pkg/grpcserver")
+	requests, err := meter.Int64Counter("rpc.server.requests",
+		metric.WithDescription("gRPC calls handled, by method and status code."))
+	if err != nil {
+		return nil, err
+	}
+	duration, err := meter.Float64Histogram("rpc.server.duration",
+		metric.WithDescription("Time taken to handle gRPC calls."),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		attrs := metric.WithAttributes(
+			attribute.String("rpc.method", info.FullMethod),
+			attribute.String("rpc.grpc.status_code", status.Code(err).String()),
+		)
+		requests.Add(ctx, 1, attrs)
+		duration.Record(ctx, time.Since(start).Seconds(), attrs)
+		return resp, err
+	}, nil
+}
+
+// isServerError reports whether code means the service, rather than the
+// caller, is at fault.
+func isServerError(code codes.Code) bool {
+	switch code {
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable, codes.Unimplemented:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+// Package idempotency makes mutating gRPC methods safely retryable. A client
+// sends a unique key in the idempotency-key metadata; the first request with
+// that key runs and its response is stored, and retries with the same key get
+// the stored response instead of running the method again.
+//
+// Services enable it per method with UnaryServerInterceptor, chained after the
+// auth interceptor so keys are scoped to the caller's tenant. Requests without
+// a key run as before. Failed requests are not stored, so a retry runs again.
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// MetadataKey is the gRPC metadata key carrying the client's idempotency key.
+const MetadataKey = "idempotency-key"
+
+// ErrKeyNotFound is returned by Store.Complete when the key is not reserved.
+var ErrKeyNotFound = errors.New("idempotency key not found")
+
+// Key identifies a request: the client's key within a scope, which is the
+// method and tenant it was sent for.
+type Key struct {
+	Scope string
+	Value string
+}
+
+// Record is the stored state of a key.
+type Record struct {
+	// Fingerprint is the hash of the request the key was first used with.
+	Fingerprint []byte
+	// Response is the marshaled response, set once the request completed.
+	Response []byte
+	// Completed reports whether the request completed; otherwise it is still
+	// in progress.
+	Completed bool
+}
+
+// Store persists idempotency keys.
+type Store interface {
+	// Reserve claims key for a request with the given fingerprint until
+	// lockTimeout passes. It returns nil when the key was claimed, and the
+	// existing record when it is already reserved or completed.
+	Reserve(ctx context.Context, key Key, fingerprint []byte, lockTimeout time.Duration) (*Record, error)
+	// Complete stores the response of a reserved key, kept for ttl.
+	Complete(ctx context.Context, key Key, response []byte, ttl time.Duration) error
+	// Release removes a reserved key so the request can be retried.
+	Release(ctx context.Context, key Key) error
+}
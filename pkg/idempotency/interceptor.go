@@ -0,0 +1,193 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+const (
+	defaultTTL         = 24 * time.Hour
+	defaultLockTimeout = time.Minute
+	maxKeyLength       = 255
+
+	// replayedHeader is set on responses replayed from the store.
+	replayedHeader = "idempotent-replayed"
+)
+
+// Config configures UnaryServerInterceptor.
+type Config struct {
+	// Store persists the keys. It is required.
+	Store Store
+	// Methods maps the full name of each idempotent method to a constructor
+	// of its response type, into which stored responses are replayed. Other
+	// methods are not affected.
+	Methods map[string]func() any
+	// Logger records keys that could not be stored; nil uses slog.Default.
+	Logger *slog.Logger
+	// TTL is how long completed responses are kept. Defaults to 24 hours.
+	TTL time.Duration
+	// LockTimeout is how long a request holds its key before a retry may run
+	// the method again, covering a server that stopped mid-request. Defaults
+	// to one minute.
+	LockTimeout time.Duration
+}
+
+// Response returns a constructor of the response type T for Config.Methods.
+func Response[T any]() func() any {
+	return func() any { return new(T) }
+}
+
+// UnaryServerInterceptor returns a gRPC unary server interceptor that makes
+// the configured methods idempotent for requests carrying an idempotency key.
+// Responses are stored as JSON, matching the services' JSON codec; generated
+// messages are stored with protojson.
+func UnaryServerInterceptor(cfg Config) grpc.UnaryServerInterceptor {
+	if cfg.TTL <= 0 {
+		cfg.TTL = defaultTTL
+	}
+	if cfg.LockTimeout <= 0 {
+		cfg.LockTimeout = defaultLockTimeout
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		newResponse, ok := cfg.Methods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		value := keyFromContext(ctx)
+		if value == "" {
+			return handler(ctx, req)
+		}
+		if len(value) > maxKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key exceeds %d characters", maxKeyLength)
+		}
+
+		key := Key{Scope: scopeOf(ctx, info.FullMethod), Value: value}
+		fingerprint, err := fingerprintOf(req)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "fingerprint request: %v", err)
+		}
+
+		existing, err := cfg.Store.Reserve(ctx, key, fingerprint, cfg.LockTimeout)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "reserve idempotency key: %v", err)
+		}
+		if existing != nil {
+			return replay(ctx, existing, fingerprint, newResponse)
+		}
+
+		// The key is settled even if the client has gone away, so a retry
+		// does not wait for the lock to time out.
+		storeCtx := context.WithoutCancel(ctx)
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if releaseErr := cfg.Store.Release(storeCtx, key); releaseErr != nil {
+				cfg.Logger.Warn("failed to release idempotency key",
+					"method", info.FullMethod, "error", releaseErr)
+			}
+			return nil, err
+		}
+
+		payload, err := encodeResponse(resp)
+		if err == nil {
+			err = cfg.Store.Complete(storeCtx, key, payload, cfg.TTL)
+		}
+		if err != nil {
+			// The method took effect, so its response is returned; a retry
+			// after the lock times out would run it again.
+			cfg.Logger.Error("failed to store idempotent response",
+				"method", info.FullMethod, "error", err)
+		}
+		return resp, nil
+	}
+}
+
+// replay answers a request whose key was already used.
+func replay(ctx context.Context, record *Record, fingerprint []byte, newResponse func() any) (interface{}, error) {
+	if !bytes.Equal(record.Fingerprint, fingerprint) {
+		return nil, status.Error(codes.InvalidArgument, "idempotency key was already used with a different request")
+	}
+	if !record.Completed {
+		return nil, status.Error(codes.Aborted, "a request with this idempotency key is in progress")
+	}
+
+	resp := newResponse()
+	if err := decodeResponse(record.Response, resp); err != nil {
+		return nil, status.Errorf(codes.Internal, "decode stored response: %v", err)
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(replayedHeader, "true")) //nolint:errcheck // informational header
+	return resp, nil
+}
+
+func keyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(MetadataKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// scopeOf scopes keys to the method and, when authenticated, the tenant, so
+// different tenants cannot see each other's responses.
+func scopeOf(ctx context.Context, method string) string {
+	if claims, ok := auth.ClaimsFromContext(ctx); ok {
+		return method + "/" + claims.TenantID.String()
+	}
+	return method
+}
+
+func fingerprintOf(req interface{}) ([]byte, error) {
+	var payload []byte
+	var err error
+	if m, ok := req.(proto.Message); ok {
+		payload, err = proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	} else {
+		payload, err = json.Marshal(req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(payload)
+	return sum[:], nil
+}
+
+// encodeResponse encodes a response for the store. encoding/json does not
+// know how generated messages encode enums and well-known types, so those
+// are encoded with protojson.
+func encodeResponse(resp interface{}) ([]byte, error) {
+	if m, ok := resp.(proto.Message); ok {
+		return protojson.Marshal(m)
+	}
+	return json.Marshal(resp)
+}
+
+func decodeResponse(data []byte, resp any) error {
+	if m, ok := resp.(proto.Message); ok {
+		return protojson.Unmarshal(data, m)
+	}
+	return json.Unmarshal(data, resp)
+}
@@ -0,0 +1,233 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+const postMethod = "/bib.ledger.v1.LedgerService/PostJournalEntry"
+
+type postRequest struct {
+	Amount string `json:"amount"`
+}
+
+type postResponse struct {
+	EntryID string `json:"entry_id"`
+}
+
+// countingHandler returns a new entry ID on every call, failing while err is set.
+type countingHandler struct {
+	err   error
+	calls int
+}
+
+func (h *countingHandler) handle(context.Context, interface{}) (interface{}, error) {
+	h.calls++
+	if h.err != nil {
+		return nil, h.err
+	}
+	return &postResponse{EntryID: uuid.NewString()}, nil
+}
+
+func newInterceptor(store Store) grpc.UnaryServerInterceptor {
+	return UnaryServerInterceptor(Config{
+		Store:   store,
+		Methods: map[string]func() any{postMethod: Response[postResponse]()},
+	})
+}
+
+func keyedContext(key string, tenantID uuid.UUID) context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, key))
+	return auth.ContextWithClaims(ctx, &auth.Claims{TenantID: tenantID})
+}
+
+func call(t *testing.T, interceptor grpc.UnaryServerInterceptor, ctx context.Context, method string, req *postRequest, h *countingHandler) (*postResponse, error) {
+	t.Helper()
+	resp, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, h.handle)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*postResponse), nil
+}
+
+func TestInterceptor_ReplaysCompletedRequest(t *testing.T) {
+	interceptor := newInterceptor(NewMemoryStore())
+	h := &countingHandler{}
+	ctx := keyedContext("key-1", uuid.New())
+
+	first, err := call(t, interceptor, ctx, postMethod, &postRequest{Amount: "10"}, h)
+	if err != nil {
+		t.Fatalf("first call error = %v", err)
+	}
+	second, err := call(t, interceptor, ctx, postMethod, &postRequest{Amount: "10"}, h)
+	if err != nil {
+		t.Fatalf("retry error = %v", err)
+	}
+	if h.calls != 1 {
+		t.Errorf("handler calls = %d, want 1", h.calls)
+	}
+	if second.EntryID != first.EntryID {
+		t.Errorf("retry entry = %s, want replayed %s", second.EntryID, first.EntryID)
+	}
+}
+
+func TestInterceptor_RejectsKeyReuseWithDifferentRequest(t *testing.T) {
+	interceptor := newInterceptor(NewMemoryStore())
+	h := &countingHandler{}
+	ctx := keyedContext("key-1", uuid.New())
+
+	if _, err := call(t, interceptor, ctx, postMethod, &postRequest{Amount: "10"}, h); err != nil {
+		t.Fatalf("first call error = %v", err)
+	}
+	_, err := call(t, interceptor, ctx, postMethod, &postRequest{Amount: "20"}, h)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("code = %v, want InvalidArgument", status.Code(err))
+	}
+}
+
+func TestInterceptor_RejectsConcurrentRequest(t *testing.T) {
+	store := NewMemoryStore()
+	interceptor := newInterceptor(store)
+	tenantID := uuid.New()
+	ctx := keyedContext("key-1", tenantID)
+	req := &postRequest{Amount: "10"}
+
+	fingerprint, err := fingerprintOf(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := Key{Scope: postMethod + "/" + tenantID.String(), Value: "key-1"}
+	if _, err := store.Reserve(context.Background(), key, fingerprint, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	h := &countingHandler{}
+	_, err = call(t, interceptor, ctx, postMethod, req, h)
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("code = %v, want Aborted", status.Code(err))
+	}
+	if h.calls != 0 {
+		t.Errorf("handler calls = %d, want 0", h.calls)
+	}
+}
+
+func TestInterceptor_RetriesFailedRequest(t *testing.T) {
+	interceptor := newInterceptor(NewMemoryStore())
+	h := &countingHandler{err: errors.New("ledger unavailable")}
+	ctx := keyedContext("key-1", uuid.New())
+
+	if _, err := call(t, interceptor, ctx, postMethod, &postRequest{Amount: "10"}, h); err == nil {
+		t.Fatal("first call error = nil, want failure")
+	}
+	h.err = nil
+	if _, err := call(t, interceptor, ctx, postMethod, &postRequest{Amount: "10"}, h); err != nil {
+		t.Fatalf("retry error = %v", err)
+	}
+	if h.calls != 2 {
+		t.Errorf("handler calls = %d, want 2", h.calls)
+	}
+}
+
+func TestInterceptor_ScopesKeysByTenantAndMethod(t *testing.T) {
+	interceptor := UnaryServerInterceptor(Config{
+		Store: NewMemoryStore(),
+		Methods: map[string]func() any{
+			postMethod:                              Response[postResponse](),
+			"/bib.payment.v1.PaymentService/Refund": Response[postResponse](),
+		},
+	})
+	h := &countingHandler{}
+	req := &postRequest{Amount: "10"}
+
+	if _, err := call(t, interceptor, keyedContext("key-1", uuid.New()), postMethod, req, h); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := call(t, interceptor, keyedContext("key-1", uuid.New()), postMethod, req, h); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := call(t, interceptor, keyedContext("key-1", uuid.New()), "/bib.payment.v1.PaymentService/Refund", req, h); err != nil {
+		t.Fatal(err)
+	}
+	if h.calls != 3 {
+		t.Errorf("handler calls = %d, want 3", h.calls)
+	}
+}
+
+func TestInterceptor_PassesThroughWithoutKeyOrMethod(t *testing.T) {
+	interceptor := newInterceptor(NewMemoryStore())
+	h := &countingHandler{}
+	req := &postRequest{Amount: "10"}
+
+	for range 2 {
+		if _, err := call(t, interceptor, context.Background(), postMethod, req, h); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := call(t, interceptor, keyedContext("key-1", uuid.New()), "/bib.ledger.v1.LedgerService/GetBalance", req, h); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if h.calls != 4 {
+		t.Errorf("handler calls = %d, want 4", h.calls)
+	}
+}
+
+func TestMemoryStore_ExpiredKeyIsReclaimed(t *testing.T) {
+	store := NewMemoryStore()
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	key := Key{Scope: postMethod, Value: "key-1"}
+
+	if _, err := store.Reserve(context.Background(), key, []byte("a"), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(2 * time.Minute)
+	record, err := store.Reserve(context.Background(), key, []byte("b"), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record != nil {
+		t.Errorf("Reserve() = %+v, want expired key reclaimed", record)
+	}
+}
+
+func TestInterceptor_ReplaysGeneratedMessages(t *testing.T) {
+	interceptor := UnaryServerInterceptor(Config{
+		Store:   NewMemoryStore(),
+		Methods: map[string]func() any{postMethod: Response[timestamppb.Timestamp]()},
+	})
+	ctx := keyedContext("key-1", uuid.New())
+	req := wrapperspb.String("10")
+	calls := 0
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		calls++
+		return timestamppb.New(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)), nil
+	}
+
+	first, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: postMethod}, handler)
+	if err != nil {
+		t.Fatalf("first call error = %v", err)
+	}
+	second, err := interceptor(ctx, wrapperspb.String("10"), &grpc.UnaryServerInfo{FullMethod: postMethod}, handler)
+	if err != nil {
+		t.Fatalf("retry error = %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("handler calls = %d, want 1", calls)
+	}
+	if !proto.Equal(second.(proto.Message), first.(proto.Message)) {
+		t.Errorf("retry response = %v, want replayed %v", second, first)
+	}
+}
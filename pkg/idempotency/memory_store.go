@@ -0,0 +1,72 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore is an in-process Store for tests and single-instance tools.
+// Keys held in memory do not survive a restart.
+type MemoryStore struct {
+	now  func() time.Time
+	keys map[Key]memoryRecord
+	mu   sync.Mutex
+}
+
+type memoryRecord struct {
+	expiresAt time.Time
+	Record
+}
+
+// NewMemoryStore creates a new, empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{now: time.Now, keys: make(map[Key]memoryRecord)}
+}
+
+// Reserve implements Store.
+func (s *MemoryStore) Reserve(_ context.Context, key Key, fingerprint []byte, lockTimeout time.Duration) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if existing, ok := s.keys[key]; ok && now.Before(existing.expiresAt) {
+		record := existing.Record
+		record.Fingerprint = bytes.Clone(record.Fingerprint)
+		record.Response = bytes.Clone(record.Response)
+		return &record, nil
+	}
+	s.keys[key] = memoryRecord{
+		Record:    Record{Fingerprint: bytes.Clone(fingerprint)},
+		expiresAt: now.Add(lockTimeout),
+	}
+	return nil, nil
+}
+
+// Complete implements Store.
+func (s *MemoryStore) Complete(_ context.Context, key Key, response []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.keys[key]
+	if !ok {
+		return ErrKeyNotFound
+	}
+	existing.Response = bytes.Clone(response)
+	existing.Completed = true
+	existing.expiresAt = s.now().Add(ttl)
+	s.keys[key] = existing
+	return nil
+}
+
+// Release implements Store.
+func (s *MemoryStore) Release(_ context.Context, key Key) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.keys[key]; ok && !existing.Completed {
+		delete(s.keys, key)
+	}
+	return nil
+}
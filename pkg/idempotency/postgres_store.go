@@ -0,0 +1,134 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// reserveAttempts bounds Reserve's retries when a key is released between
+// the failed claim and the read of the existing record.
+const reserveAttempts = 3
+
+// PostgresStore is a Store backed by a service's idempotency_keys table:
+//
+//	CREATE TABLE idempotency_keys (
+//	    scope           VARCHAR(255) NOT NULL,
+//	    idempotency_key VARCHAR(255) NOT NULL,
+//	    fingerprint     BYTEA NOT NULL,
+//	    response        BYTEA,
+//	    completed       BOOLEAN NOT NULL DEFAULT FALSE,
+//	    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+//	    expires_at      TIMESTAMPTZ NOT NULL,
+//	    PRIMARY KEY (scope, idempotency_key)
+//	);
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore creates a new PostgresStore.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// Reserve implements Store. An expired key is claimed as if it were new.
+func (s *PostgresStore) Reserve(ctx context.Context, key Key, fingerprint []byte, lockTimeout time.Duration) (*Record, error) {
+	const claimSQL = `
+		INSERT INTO idempotency_keys (scope, idempotency_key, fingerprint, expires_at)
+		VALUES ($1, $2, $3, NOW() + make_interval(secs => $4))
+		ON CONFLICT (scope, idempotency_key) DO UPDATE
+		SET fingerprint = EXCLUDED.fingerprint, response = NULL, completed = FALSE,
+			created_at = NOW(), expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= NOW()
+	`
+	const selectSQL = `
+		SELECT fingerprint, response, completed
+		FROM idempotency_keys
+		WHERE scope = $1 AND idempotency_key = $2
+	`
+
+	for range reserveAttempts {
+		tag, err := s.pool.Exec(ctx, claimSQL, key.Scope, key.Value, fingerprint, lockTimeout.Seconds())
+		if err != nil {
+			return nil, fmt.Errorf("claim idempotency key: %w", err)
+		}
+		if tag.RowsAffected() == 1 {
+			return nil, nil
+		}
+
+		var record Record
+		err = s.pool.QueryRow(ctx, selectSQL, key.Scope, key.Value).
+			Scan(&record.Fingerprint, &record.Response, &record.Completed)
+		if errors.Is(err, pgx.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read idempotency key: %w", err)
+		}
+		return &record, nil
+	}
+	return nil, fmt.Errorf("claim idempotency key: released concurrently %d times", reserveAttempts)
+}
+
+// Complete implements Store.
+func (s *PostgresStore) Complete(ctx context.Context, key Key, response []byte, ttl time.Duration) error {
+	const updateSQL = `
+		UPDATE idempotency_keys
+		SET response = $3, completed = TRUE, expires_at = NOW() + make_interval(secs => $4)
+		WHERE scope = $1 AND idempotency_key = $2
+	`
+
+	tag, err := s.pool.Exec(ctx, updateSQL, key.Scope, key.Value, response, ttl.Seconds())
+	if err != nil {
+		return fmt.Errorf("complete idempotency key: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrKeyNotFound
+	}
+	return nil
+}
+
+// Release implements Store.
+func (s *PostgresStore) Release(ctx context.Context, key Key) error {
+	const deleteSQL = `
+		DELETE FROM idempotency_keys
+		WHERE scope = $1 AND idempotency_key = $2 AND NOT completed
+	`
+
+	if _, err := s.pool.Exec(ctx, deleteSQL, key.Scope, key.Value); err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// PurgeExpired deletes expired keys, returning the number deleted.
+func (s *PostgresStore) PurgeExpired(ctx context.Context) (int64, error) {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= NOW()`)
+	if err != nil {
+		return 0, fmt.Errorf("purge idempotency keys: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// RunPurge deletes expired keys every interval until ctx is cancelled.
+func (s *PostgresStore) RunPurge(ctx context.Context, interval time.Duration, logger *slog.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if n, err := s.PurgeExpired(ctx); err != nil && ctx.Err() == nil {
+				logger.Error("failed to purge idempotency keys", "error", err)
+			} else if n > 0 {
+				logger.Info("purged expired idempotency keys", "count", n)
+			}
+		}
+	}
+}
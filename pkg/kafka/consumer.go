@@ -85,8 +85,11 @@ func resolveConsumerSASL(cfg Config) sasl.Mechanism {
 }
 
 // Start begins consuming messages. Blocks until the context is canceled.
+// Cancelling stops fetching; a message already fetched is still handled and
+// committed, so shutting down does not abandon it half-processed.
 func (c *Consumer) Start(ctx context.Context) error {
 	c.logger.Info("consumer starting", "topic", c.reader.Config().Topic, "group", c.reader.Config().GroupID)
+	inFlight := context.WithoutCancel(ctx)
 
 	for {
 		m, err := c.reader.FetchMessage(ctx)
@@ -107,7 +110,7 @@ func (c *Consumer) Start(ctx context.Context) error {
 			msg.Headers[h.Key] = string(h.Value)
 		}
 
-		if err := c.handler(ctx, msg); err != nil {
+		if err := c.handler(inFlight, msg); err != nil {
 			c.logger.Error("handler error",
 				"topic", m.Topic,
 				"partition", m.Partition,
@@ -117,7 +120,7 @@ func (c *Consumer) Start(ctx context.Context) error {
 			continue
 		}
 
-		if err := c.reader.CommitMessages(ctx, m); err != nil {
+		if err := c.reader.CommitMessages(inFlight, m); err != nil {
 			c.logger.Error("commit error",
 				"topic", m.Topic,
 				"partition", m.Partition,
@@ -0,0 +1,3 @@
+module github.com/bibbank/bib/pkg/lifecycle
+
+go 1.24
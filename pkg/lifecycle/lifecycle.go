@@ -0,0 +1,244 @@
+// Package lifecycle runs a service's long-running components and shuts them
+// down in a fixed order within one deadline.
+//
+// A service registers its components with a Manager: servers, consumers
+// and background loops with Go, and the steps that release what they used
+// with OnStop, each in the Phase it belongs to. Wait blocks until the
+// service is signalled to stop or a component fails, then stops the phases
+// in order:
+//
+//  1. PhaseIngress: gRPC and HTTP servers stop accepting requests and
+//     finish those in flight.
+//  2. PhaseConsumers: Kafka consumers stop fetching and finish the message
+//     in hand.
+//  3. PhaseWorkers: background loops, including outbox relays' polling,
+//     stop.
+//  4. PhaseOutbox: outboxes are flushed, publishing the events written by
+//     the requests and messages just drained.
+//  5. PhaseProducers: Kafka producers are closed.
+//  6. PhaseResources: database pools, client connections and telemetry
+//     exporters are closed.
+//
+// Each phase starts once everything in the one before it has stopped, so
+// nothing still running can write to an outbox already flushed or publish
+// through a closed producer. The whole sequence shares one deadline; a
+// phase still running when it passes is abandoned, and the later phases
+// skipped, so the process exits before its orchestrator kills it.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultTimeout is the shutdown deadline when none is configured, inside
+// Kubernetes' default 30-second termination grace period.
+const DefaultTimeout = 25 * time.Second
+
+// Phase is a step of shutdown. Phases stop in the order declared.
+type Phase int
+
+// Shutdown phases, in the order they stop.
+const (
+	PhaseIngress Phase = iota
+	PhaseConsumers
+	PhaseWorkers
+	PhaseOutbox
+	PhaseProducers
+	PhaseResources
+)
+
+var phaseNames = [...]string{"ingress", "consumers", "workers", "outbox", "producers", "resources"}
+
+// String returns the phase's name.
+func (p Phase) String() string {
+	if p < 0 || int(p) >= len(phaseNames) {
+		return "unknown"
+	}
+	return phaseNames[p]
+}
+
+type component struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+	stop   func(ctx context.Context) error
+	name   string
+	phase  Phase
+}
+
+// Manager runs a service's components and shuts them down in phase order.
+type Manager struct {
+	logger     *slog.Logger
+	failed     chan error
+	components []*component
+	timeout    time.Duration
+	mu         sync.Mutex
+}
+
+// New creates a Manager whose shutdown must finish within timeout, or
+// DefaultTimeout if it is zero.
+func New(logger *slog.Logger, timeout time.Duration) *Manager {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Manager{logger: logger, timeout: timeout, failed: make(chan error, 1)}
+}
+
+// Go runs a component until it returns. Its context is cancelled when
+// shutdown reaches phase, and it is then expected to return nil once its
+// work in hand is done. An error it returns before then shuts the service
+// down.
+func (m *Manager) Go(phase Phase, name string, run func(ctx context.Context) error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &component{name: name, phase: phase, cancel: cancel, done: make(chan struct{})}
+	m.add(c)
+	go func() {
+		defer close(c.done)
+		if err := run(ctx); err != nil && ctx.Err() == nil {
+			select {
+			case m.failed <- fmt.Errorf("%s: %w", name, err):
+			default:
+			}
+		}
+	}()
+}
+
+// OnStop registers a step to run when shutdown reaches phase, such as
+// closing a pool, or asking a server started with Go to stop. Steps of a
+// phase run concurrently with each other and with the cancellation of its
+// components, and are given the remaining shutdown deadline.
+func (m *Manager) OnStop(phase Phase, name string, stop func(ctx context.Context) error) {
+	m.add(&component{name: name, phase: phase, stop: stop})
+}
+
+// ServeHTTP serves srv in PhaseIngress, shutting it down gracefully.
+func (m *Manager) ServeHTTP(name string, srv *http.Server) {
+	m.Go(PhaseIngress, name, func(context.Context) error {
+		m.logger.Info(name+" starting", "addr", srv.Addr)
+		if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	})
+	m.OnStop(PhaseIngress, name, srv.Shutdown)
+}
+
+func (m *Manager) add(c *component) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.components = append(m.components, c)
+}
+
+// Wait blocks until ctx is cancelled, typically by a termination signal,
+// or a component fails, then shuts down. It returns the component's error,
+// or nil after a signal.
+func (m *Manager) Wait(ctx context.Context) error {
+	var cause error
+	select {
+	case <-ctx.Done():
+		m.logger.Info("shutdown signal received")
+	case cause = <-m.failed:
+		m.logger.Error("component failed, shutting down", "error", cause)
+	}
+	m.Shutdown()
+	return cause
+}
+
+// Shutdown stops every component, phase by phase, within the deadline.
+func (m *Manager) Shutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+	start := time.Now()
+
+	m.mu.Lock()
+	components := append([]*component(nil), m.components...)
+	m.mu.Unlock()
+
+	for phase := PhaseIngress; phase <= PhaseResources; phase++ {
+		if !m.stopPhase(ctx, phase, components) {
+			m.logger.Error("shutdown deadline exceeded, abandoning shutdown",
+				"phase", phase.String(), "timeout", m.timeout)
+			return
+		}
+	}
+	m.logger.Info("shutdown complete", "duration", time.Since(start))
+}
+
+// stopPhase stops the phase's components and runs its steps, reporting
+// whether they all finished before ctx's deadline.
+func (m *Manager) stopPhase(ctx context.Context, phase Phase, components []*component) bool {
+	var wg sync.WaitGroup
+	pending := make(map[string]int)
+	var mu sync.Mutex
+	track := func(name string, wait func()) {
+		mu.Lock()
+		pending[name]++
+		mu.Unlock()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait()
+			mu.Lock()
+			pending[name]--
+			mu.Unlock()
+		}()
+	}
+
+	for _, c := range components {
+		if c.phase != phase {
+			continue
+		}
+		switch {
+		case c.stop != nil:
+			track(c.name, func() {
+				if err := c.stop(ctx); err != nil {
+					m.logger.Error("shutdown step failed", "phase", phase.String(), "component", c.name, "error", err)
+				}
+			})
+		default:
+			c.cancel()
+			track(c.name, func() { <-c.done })
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		mu.Lock()
+		defer mu.Unlock()
+		for name, n := range pending {
+			if n > 0 {
+				m.logger.Error("component did not stop in time", "phase", phase.String(), "component", name)
+			}
+		}
+		return false
+	}
+}
+
+// Close adapts a Close method to a shutdown step.
+func Close(close func() error) func(context.Context) error {
+	return func(context.Context) error { return close() }
+}
+
+// Func adapts a function that cannot fail, such as a pool's Close or a
+// server's blocking Stop, to a shutdown step.
+func Func(f func()) func(context.Context) error {
+	return func(context.Context) error {
+		f()
+		return nil
+	}
+}
@@ -0,0 +1,111 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recorder records the order components stop in.
+type recorder struct {
+	stopped []string
+	mu      sync.Mutex
+}
+
+func (r *recorder) record(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stopped = append(r.stopped, name)
+}
+
+func newTestManager(timeout time.Duration) *Manager {
+	return New(slog.New(slog.NewTextHandler(io.Discard, nil)), timeout)
+}
+
+func TestManager_StopsPhasesInOrder(t *testing.T) {
+	m := newTestManager(time.Second)
+	rec := &recorder{}
+	loop := func(name string, linger time.Duration) func(context.Context) error {
+		return func(ctx context.Context) error {
+			<-ctx.Done()
+			// Finish the work in hand.
+			time.Sleep(linger)
+			rec.record(name)
+			return nil
+		}
+	}
+	step := func(name string) func(context.Context) error {
+		return func(context.Context) error {
+			rec.record(name)
+			return nil
+		}
+	}
+
+	// Registered out of order.
+	m.OnStop(PhaseResources, "pool", step("pool"))
+	m.OnStop(PhaseProducers, "producer", step("producer"))
+	m.OnStop(PhaseOutbox, "outbox flush", step("outbox flush"))
+	m.Go(PhaseWorkers, "relay", loop("relay", 0))
+	m.Go(PhaseConsumers, "consumer", loop("consumer", 20*time.Millisecond))
+	m.Go(PhaseIngress, "grpc", loop("grpc", 20*time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := m.Wait(ctx); err != nil {
+		t.Fatalf("Wait = %v", err)
+	}
+	want := []string{"grpc", "consumer", "relay", "outbox flush", "producer", "pool"}
+	if !slices.Equal(rec.stopped, want) {
+		t.Errorf("stopped %v, want %v", rec.stopped, want)
+	}
+}
+
+func TestManager_ComponentFailureShutsDown(t *testing.T) {
+	m := newTestManager(time.Second)
+	rec := &recorder{}
+	m.Go(PhaseIngress, "grpc", func(context.Context) error { return errors.New("address in use") })
+	m.OnStop(PhaseResources, "pool", func(context.Context) error {
+		rec.record("pool")
+		return nil
+	})
+
+	err := m.Wait(context.Background())
+	if err == nil || err.Error() != "grpc: address in use" {
+		t.Errorf("Wait = %v", err)
+	}
+	if !slices.Equal(rec.stopped, []string{"pool"}) {
+		t.Errorf("stopped %v", rec.stopped)
+	}
+}
+
+func TestManager_DeadlineAbandonsShutdown(t *testing.T) {
+	m := newTestManager(50 * time.Millisecond)
+	rec := &recorder{}
+	m.Go(PhaseConsumers, "stuck consumer", func(context.Context) error {
+		select {} // ignores cancellation
+	})
+	m.OnStop(PhaseResources, "pool", func(context.Context) error {
+		rec.record("pool")
+		return nil
+	})
+
+	start := time.Now()
+	m.Shutdown()
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("Shutdown took %v", d)
+	}
+	if len(rec.stopped) != 0 {
+		t.Errorf("later phases ran after the deadline: %v", rec.stopped)
+	}
+}
+
+func TestPhase_String(t *testing.T) {
+	if PhaseOutbox.String() != "outbox" || Phase(42).String() != "unknown" {
+		t.Errorf("names = %q, %q", PhaseOutbox, Phase(42))
+	}
+}
@@ -0,0 +1,134 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+const (
+	defaultRetryInterval   = 5 * time.Second
+	defaultRefreshInterval = 10 * time.Second
+	releaseTimeout         = 5 * time.Second
+)
+
+// ElectorConfig configures an Elector.
+type ElectorConfig struct {
+	// RetryInterval is how often a follower tries to become leader. Defaults
+	// to five seconds.
+	RetryInterval time.Duration
+	// RefreshInterval is how often the leader refreshes its lock. It must be
+	// well below the lease of a Redis lock. Defaults to ten seconds.
+	RefreshInterval time.Duration
+}
+
+// Elector elects one leader among the replicas campaigning for the same name.
+type Elector struct {
+	locker          Locker
+	name            string
+	logger          *slog.Logger
+	retryInterval   time.Duration
+	refreshInterval time.Duration
+}
+
+// NewElector creates a new Elector for name. logger may be nil.
+func NewElector(locker Locker, name string, cfg ElectorConfig, logger *slog.Logger) *Elector {
+	if cfg.RetryInterval <= 0 {
+		cfg.RetryInterval = defaultRetryInterval
+	}
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = defaultRefreshInterval
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Elector{
+		locker:          locker,
+		name:            name,
+		logger:          logger.With("election", name),
+		retryInterval:   cfg.RetryInterval,
+		refreshInterval: cfg.RefreshInterval,
+	}
+}
+
+// Run campaigns until ctx is cancelled. Whenever this replica becomes leader
+// it calls lead with a context that is cancelled when leadership is lost or
+// ctx ends; lead should return promptly then. If lead returns while still
+// leader, leadership is given up and the campaign continues.
+func (e *Elector) Run(ctx context.Context, lead func(ctx context.Context)) {
+	for {
+		l, err := e.locker.TryAcquire(ctx, e.name)
+		switch {
+		case err == nil:
+			e.logger.Info("acquired leadership")
+			e.lead(ctx, l, lead)
+		case !errors.Is(err, ErrNotAcquired) && ctx.Err() == nil:
+			e.logger.Error("leader election failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(e.retryInterval):
+		}
+	}
+}
+
+// lead runs lead while refreshing the lock, then releases it.
+func (e *Elector) lead(ctx context.Context, l Lock, lead func(ctx context.Context)) {
+	leaderCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		lead(leaderCtx)
+	}()
+
+	ticker := time.NewTicker(e.refreshInterval)
+	defer ticker.Stop()
+loop:
+	for {
+		select {
+		case <-done:
+			break loop
+		case <-leaderCtx.Done():
+			break loop
+		case <-ticker.C:
+			if err := l.Refresh(leaderCtx); err != nil {
+				e.logger.Warn("lost leadership", "error", err)
+				break loop
+			}
+		}
+	}
+	cancel()
+	<-done
+
+	// Release with a fresh context: ctx may already be cancelled.
+	releaseCtx, releaseCancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer releaseCancel()
+	if err := l.Release(releaseCtx); err != nil {
+		e.logger.Warn("failed to release leadership", "error", err)
+		return
+	}
+	e.logger.Info("released leadership")
+}
+
+// RunExclusive runs fn if the named lock can be taken, reporting whether it
+// ran. It suits one-off jobs; periodic work should run under an Elector so a
+// job finishing on one replica is not started again on another.
+func RunExclusive(ctx context.Context, locker Locker, name string, fn func(ctx context.Context) error) (bool, error) {
+	l, err := locker.TryAcquire(ctx, name)
+	if errors.Is(err, ErrNotAcquired) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	fnErr := fn(ctx)
+	releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), releaseTimeout)
+	defer cancel()
+	return true, errors.Join(fnErr, l.Release(releaseCtx))
+}
@@ -0,0 +1,35 @@
+// Package lock provides distributed locks and leader election, so batch work
+// such as interest accrual, maturity processing and revaluation runs on one
+// replica at a time. Locks are held on PostgreSQL advisory locks or Redis keys;
+// an Elector keeps a lock for as long as its replica leads.
+package lock
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrNotAcquired is returned by Locker.TryAcquire when another holder has
+	// the lock.
+	ErrNotAcquired = errors.New("lock held by another holder")
+	// ErrLost is returned by Lock.Refresh when the lock is no longer held,
+	// because its connection closed or its lease expired.
+	ErrLost = errors.New("lock lost")
+)
+
+// Locker acquires named locks shared by every replica.
+type Locker interface {
+	// TryAcquire takes the named lock without waiting, returning
+	// ErrNotAcquired when it is held elsewhere.
+	TryAcquire(ctx context.Context, name string) (Lock, error)
+}
+
+// Lock is a held lock.
+type Lock interface {
+	// Refresh confirms the lock is still held, extending its lease where it
+	// has one, and returns ErrLost otherwise.
+	Refresh(ctx context.Context) error
+	// Release releases the lock.
+	Release(ctx context.Context) error
+}
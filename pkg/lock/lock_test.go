@@ -0,0 +1,190 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeRedis implements RedisClient for the locker's commands and scripts.
+type fakeRedis struct {
+	now  time.Time
+	keys map[string]fakeEntry
+	mu   sync.Mutex
+}
+
+type fakeEntry struct {
+	expiresAt time.Time
+	value     string
+}
+
+func newFakeRedis() *fakeRedis {
+	return &fakeRedis{now: time.Now(), keys: make(map[string]fakeEntry)}
+}
+
+func (r *fakeRedis) get(key string) (string, bool) {
+	e, ok := r.keys[key]
+	if !ok || !r.now.Before(e.expiresAt) {
+		return "", false
+	}
+	return e.value, true
+}
+
+func (r *fakeRedis) SetNX(_ context.Context, key, value string, ttl time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.get(key); ok {
+		return false, nil
+	}
+	r.keys[key] = fakeEntry{value: value, expiresAt: r.now.Add(ttl)}
+	return true, nil
+}
+
+func (r *fakeRedis) Eval(_ context.Context, script string, keys []string, args ...any) (any, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	value, ok := r.get(keys[0])
+	if !ok || value != args[0] {
+		return int64(0), nil
+	}
+	switch script {
+	case refreshScript:
+		r.keys[keys[0]] = fakeEntry{value: value, expiresAt: r.now.Add(time.Duration(args[1].(int64)) * time.Millisecond)}
+	case releaseScript:
+		delete(r.keys, keys[0])
+	default:
+		return nil, errors.New("unknown script")
+	}
+	return int64(1), nil
+}
+
+func (r *fakeRedis) advance(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.now = r.now.Add(d)
+}
+
+func TestRedisLocker_ExcludesOtherHolders(t *testing.T) {
+	ctx := context.Background()
+	locker := NewRedisLocker(newFakeRedis(), time.Minute)
+
+	held, err := locker.TryAcquire(ctx, "deposit.accrual")
+	if err != nil {
+		t.Fatalf("TryAcquire() error = %v", err)
+	}
+	if _, err := locker.TryAcquire(ctx, "deposit.accrual"); !errors.Is(err, ErrNotAcquired) {
+		t.Fatalf("second TryAcquire() error = %v, want ErrNotAcquired", err)
+	}
+	if _, err := locker.TryAcquire(ctx, "deposit.maturity"); err != nil {
+		t.Fatalf("TryAcquire() of another lock error = %v", err)
+	}
+
+	if err := held.Release(ctx); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+	if _, err := locker.TryAcquire(ctx, "deposit.accrual"); err != nil {
+		t.Fatalf("TryAcquire() after release error = %v", err)
+	}
+}
+
+func TestRedisLocker_ExpiredLeaseIsLost(t *testing.T) {
+	ctx := context.Background()
+	redis := newFakeRedis()
+	locker := NewRedisLocker(redis, time.Minute)
+
+	stale, err := locker.TryAcquire(ctx, "fx.revaluation")
+	if err != nil {
+		t.Fatal(err)
+	}
+	redis.advance(2 * time.Minute)
+	current, err := locker.TryAcquire(ctx, "fx.revaluation")
+	if err != nil {
+		t.Fatalf("TryAcquire() after expiry error = %v", err)
+	}
+
+	if err := stale.Refresh(ctx); !errors.Is(err, ErrLost) {
+		t.Errorf("Refresh() of expired lock error = %v, want ErrLost", err)
+	}
+	if err := stale.Release(ctx); err != nil {
+		t.Fatalf("Release() of expired lock error = %v", err)
+	}
+	if err := current.Refresh(ctx); err != nil {
+		t.Errorf("stale release removed the current holder's lock: %v", err)
+	}
+}
+
+func TestElector_OneLeaderAtATime(t *testing.T) {
+	locker := NewRedisLocker(newFakeRedis(), time.Minute)
+	cfg := ElectorConfig{RetryInterval: time.Millisecond, RefreshInterval: time.Millisecond}
+
+	var leaders, maxLeaders, terms atomic.Int32
+	lead := func(ctx context.Context) {
+		n := leaders.Add(1)
+		for {
+			m := maxLeaders.Load()
+			if n <= m || maxLeaders.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		terms.Add(1)
+		select {
+		case <-ctx.Done():
+		case <-time.After(5 * time.Millisecond):
+		}
+		leaders.Add(-1)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			NewElector(locker, "reporting.schedules", cfg, nil).Run(ctx, lead)
+		}()
+	}
+	deadline := time.After(5 * time.Second)
+	for terms.Load() < 5 {
+		select {
+		case <-deadline:
+			t.Fatalf("only %d leadership terms", terms.Load())
+		case <-time.After(time.Millisecond):
+		}
+	}
+	cancel()
+	wg.Wait()
+
+	if maxLeaders.Load() != 1 {
+		t.Errorf("max concurrent leaders = %d, want 1", maxLeaders.Load())
+	}
+}
+
+func TestRunExclusive_SkipsWhileHeld(t *testing.T) {
+	ctx := context.Background()
+	locker := NewRedisLocker(newFakeRedis(), time.Minute)
+	held, err := locker.TryAcquire(ctx, "deposit.maturity")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := 0
+	job := func(context.Context) error { calls++; return nil }
+	if ran, err := RunExclusive(ctx, locker, "deposit.maturity", job); ran || err != nil {
+		t.Errorf("RunExclusive() = %v, %v while held; want skipped", ran, err)
+	}
+	if err := held.Release(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if ran, err := RunExclusive(ctx, locker, "deposit.maturity", job); !ran || err != nil {
+		t.Errorf("RunExclusive() = %v, %v; want ran", ran, err)
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+	if _, err := locker.TryAcquire(ctx, "deposit.maturity"); err != nil {
+		t.Errorf("lock not released after RunExclusive: %v", err)
+	}
+}
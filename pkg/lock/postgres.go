@@ -0,0 +1,74 @@
+package lock
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresLocker holds locks as session-level advisory locks, each on a
+// dedicated pool connection. A lock is lost when its connection closes, so
+// it cannot outlive a crashed holder.
+type PostgresLocker struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresLocker creates a new PostgresLocker.
+func NewPostgresLocker(pool *pgxpool.Pool) *PostgresLocker {
+	return &PostgresLocker{pool: pool}
+}
+
+// TryAcquire implements Locker.
+func (l *PostgresLocker) TryAcquire(ctx context.Context, name string) (Lock, error) {
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("acquire lock connection: %w", err)
+	}
+
+	key := advisoryKey(name)
+	var locked bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock($1)`, key).Scan(&locked); err != nil {
+		conn.Release()
+		return nil, fmt.Errorf("take lock %s: %w", name, err)
+	}
+	if !locked {
+		conn.Release()
+		return nil, ErrNotAcquired
+	}
+	return &postgresLock{conn: conn, name: name, key: key}, nil
+}
+
+type postgresLock struct {
+	conn *pgxpool.Conn
+	name string
+	key  int64
+}
+
+// Refresh implements Lock by checking the lock's session is still alive.
+func (l *postgresLock) Refresh(ctx context.Context) error {
+	if err := l.conn.Ping(ctx); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrLost, l.name, err)
+	}
+	return nil
+}
+
+// Release implements Lock.
+func (l *postgresLock) Release(ctx context.Context) error {
+	defer l.conn.Release()
+	if _, err := l.conn.Exec(ctx, `SELECT pg_advisory_unlock($1)`, l.key); err != nil {
+		// A session lock that cannot be released must not go back to the
+		// pool, where it would stay held.
+		_ = l.conn.Conn().Close(ctx) //nolint:errcheck // connection is discarded
+		return fmt.Errorf("release lock %s: %w", l.name, err)
+	}
+	return nil
+}
+
+// advisoryKey maps a lock name to an advisory lock key.
+func advisoryKey(name string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte("lock:" + name)) //nolint:errcheck // hash writes never fail
+	return int64(h.Sum64())                //nolint:gosec // keys are compared, not interpreted
+}
@@ -0,0 +1,108 @@
+package lock
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// DefaultRedisTTL is the lease of a Redis lock that is not refreshed.
+const DefaultRedisTTL = 30 * time.Second
+
+const (
+	// refreshScript extends the lease if the caller still owns the lock.
+	refreshScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("PEXPIRE", KEYS[1], ARGV[2]) else return 0 end`
+	// releaseScript deletes the lock if the caller still owns it.
+	releaseScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) else return 0 end`
+)
+
+// RedisClient is the part of a Redis client the locker uses. Services adapt
+// their client library to it.
+type RedisClient interface {
+	// SetNX sets key to value with the given expiry if it does not exist,
+	// reporting whether it was set.
+	SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error)
+	// Eval runs a Lua script and returns its result.
+	Eval(ctx context.Context, script string, keys []string, args ...any) (any, error)
+}
+
+// RedisLocker holds locks as Redis keys with a lease. A holder that stops
+// refreshing loses the lock once the lease expires.
+type RedisLocker struct {
+	client RedisClient
+	prefix string
+	ttl    time.Duration
+}
+
+// NewRedisLocker creates a new RedisLocker whose locks are leased for ttl,
+// or DefaultRedisTTL when ttl is zero. Lock keys are prefixed with "lock:".
+func NewRedisLocker(client RedisClient, ttl time.Duration) *RedisLocker {
+	if ttl <= 0 {
+		ttl = DefaultRedisTTL
+	}
+	return &RedisLocker{client: client, prefix: "lock:", ttl: ttl}
+}
+
+// TryAcquire implements Locker.
+func (l *RedisLocker) TryAcquire(ctx context.Context, name string) (Lock, error) {
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	key := l.prefix + name
+	ok, err := l.client.SetNX(ctx, key, token, l.ttl)
+	if err != nil {
+		return nil, fmt.Errorf("take lock %s: %w", name, err)
+	}
+	if !ok {
+		return nil, ErrNotAcquired
+	}
+	return &redisLock{client: l.client, key: key, token: token, ttl: l.ttl}, nil
+}
+
+type redisLock struct {
+	client RedisClient
+	key    string
+	token  string
+	ttl    time.Duration
+}
+
+// Refresh implements Lock by extending the lease.
+func (l *redisLock) Refresh(ctx context.Context) error {
+	res, err := l.client.Eval(ctx, refreshScript, []string{l.key}, l.token, l.ttl.Milliseconds())
+	if err != nil {
+		return fmt.Errorf("refresh lock %s: %w", l.key, err)
+	}
+	if !isOne(res) {
+		return fmt.Errorf("%w: %s", ErrLost, l.key)
+	}
+	return nil
+}
+
+// Release implements Lock. Releasing a lock whose lease expired is a no-op.
+func (l *redisLock) Release(ctx context.Context) error {
+	if _, err := l.client.Eval(ctx, releaseScript, []string{l.key}, l.token); err != nil {
+		return fmt.Errorf("release lock %s: %w", l.key, err)
+	}
+	return nil
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate lock token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func isOne(res any) bool {
+	switch v := res.(type) {
+	case int64:
+		return v == 1
+	case int:
+		return v == 1
+	}
+	return false
+}
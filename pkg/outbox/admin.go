@@ -0,0 +1,176 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// AdminServiceName is the gRPC service under which every service serves
+// its outbox administration methods.
+const AdminServiceName = "bib.outbox.v1.OutboxAdmin"
+
+// defaultReplayLimit caps a replay whose request sets no limit.
+const defaultReplayLimit = 1000
+
+// ReplayRequest is the request of the ReplayOutbox method. From and To are
+// RFC 3339 times.
+type ReplayRequest struct {
+	From        string `json:"from"`
+	To          string `json:"to,omitempty"`
+	EventType   string `json:"event_type,omitempty"`
+	AggregateID string `json:"aggregate_id,omitempty"`
+	Limit       int    `json:"limit,omitempty"`
+}
+
+// ReplayResponse is the response of the ReplayOutbox method.
+type ReplayResponse struct {
+	Replayed int64 `json:"replayed"`
+}
+
+// LagRequest is the request of the GetOutboxLag method.
+type LagRequest struct{}
+
+// LagResponse is the response of the GetOutboxLag method.
+type LagResponse struct {
+	OldestPendingAt string `json:"oldest_pending_at,omitempty"`
+	Pending         int64  `json:"pending"`
+}
+
+// adminStore is the part of Store the AdminServer uses.
+type adminStore interface {
+	Replay(ctx context.Context, filter ReplayFilter) (int64, error)
+	Lag(ctx context.Context) (int64, time.Time, error)
+}
+
+// AdminServer serves a service's outbox administration methods, which
+// operators use to replay events consumers missed. Only admins may call
+// them.
+type AdminServer struct {
+	store adminStore
+}
+
+// NewAdminServer creates a new AdminServer for store, normally a *Store.
+func NewAdminServer(store adminStore) *AdminServer {
+	return &AdminServer{store: store}
+}
+
+// ReplayOutbox marks the matching published entries for the relay to
+// publish again.
+func (s *AdminServer) ReplayOutbox(ctx context.Context, req *ReplayRequest) (*ReplayResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.From == "" {
+		return nil, status.Error(codes.InvalidArgument, "from is required")
+	}
+	from, err := time.Parse(time.RFC3339, req.From)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from: %v", err)
+	}
+	var to time.Time
+	if req.To != "" {
+		to, err = time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid to: %v", err)
+		}
+		if !to.After(from) {
+			return nil, status.Error(codes.InvalidArgument, "to must be after from")
+		}
+	}
+	if req.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative, got %d", req.Limit)
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultReplayLimit
+	}
+
+	replayed, err := s.store.Replay(ctx, ReplayFilter{
+		From:        from,
+		To:          to,
+		EventType:   req.EventType,
+		AggregateID: req.AggregateID,
+		Limit:       limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "replay outbox: %v", err)
+	}
+	return &ReplayResponse{Replayed: replayed}, nil
+}
+
+// GetOutboxLag returns the number of unpublished entries and when the
+// oldest was written.
+func (s *AdminServer) GetOutboxLag(ctx context.Context, _ *LagRequest) (*LagResponse, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	pending, oldest, err := s.store.Lag(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "outbox lag: %v", err)
+	}
+	resp := &LagResponse{Pending: pending}
+	if !oldest.IsZero() {
+		resp.OldestPendingAt = oldest.UTC().Format(time.RFC3339)
+	}
+	return resp, nil
+}
+
+func requireAdmin(ctx context.Context) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing authentication")
+	}
+	if !claims.HasRole(auth.RoleAdmin) {
+		return status.Errorf(codes.PermissionDenied, "role %q required", auth.RoleAdmin)
+	}
+	return nil
+}
+
+// adminServer is the handler type of the outbox admin service descriptor.
+type adminServer interface {
+	ReplayOutbox(context.Context, *ReplayRequest) (*ReplayResponse, error)
+	GetOutboxLag(context.Context, *LagRequest) (*LagResponse, error)
+}
+
+// RegisterAdmin registers srv as the AdminServiceName gRPC service. Like the
+// services' own stand-ins, it expects the JSON codec.
+func RegisterAdmin(s grpc.ServiceRegistrar, srv *AdminServer) {
+	s.RegisterService(&grpc.ServiceDesc{
+		ServiceName: AdminServiceName,
+		HandlerType: (*adminServer)(nil),
+		Methods: []grpc.MethodDesc{
+			unaryMethod("ReplayOutbox", adminServer.ReplayOutbox),
+			unaryMethod("GetOutboxLag", adminServer.GetOutboxLag),
+		},
+		Streams: []grpc.StreamDesc{},
+	}, srv)
+}
+
+func unaryMethod[Req, Resp any](name string, call func(adminServer, context.Context, *Req) (*Resp, error)) grpc.MethodDesc {
+	fullMethod := "/" + AdminServiceName + "/" + name
+	return grpc.MethodDesc{
+		MethodName: name,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) { //nolint:revive // gRPC handler signature
+			in := new(Req)
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+			if interceptor == nil {
+				return call(srv.(adminServer), ctx, in)
+			}
+			info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return call(srv.(adminServer), ctx, req.(*Req))
+			}
+			return interceptor(ctx, in, info, handler)
+		},
+	}
+}
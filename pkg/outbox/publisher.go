@@ -0,0 +1,33 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// Publisher writes domain events to the outbox for the Relay to deliver,
+// implementing the Publish(ctx, topic, events...) port of the services.
+// Events a repository already wrote in the aggregate's transaction are
+// matched by event ID and only given the topic; others are written now, so
+// they survive a broker outage but not a crash between saving the aggregate
+// and publishing.
+type Publisher struct {
+	store *Store
+}
+
+// NewPublisher creates a new Publisher.
+func NewPublisher(store *Store) *Publisher {
+	return &Publisher{store: store}
+}
+
+// Publish writes the events to the outbox for topic.
+func (p *Publisher) Publish(ctx context.Context, topic string, domainEvents ...events.DomainEvent) error {
+	entries := make([]events.OutboxEntry, 0, len(domainEvents))
+	for _, evt := range domainEvents {
+		entry := events.NewOutboxEntry(evt)
+		entry.Topic = topic
+		entries = append(entries, entry)
+	}
+	return p.store.Store(ctx, entries)
+}
@@ -0,0 +1,318 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/pkg/kafka"
+)
+
+const (
+	defaultInterval  = time.Second
+	defaultBatchSize = 100
+	lagQueryTimeout  = 5 * time.Second
+)
+
+// Repository is the outbox a Relay drains. Store implements it.
+type Repository interface {
+	events.OutboxRepository
+	// Lag returns the number of unpublished entries and when the oldest was
+	// written, which is the zero time when there are none.
+	Lag(ctx context.Context) (int64, time.Time, error)
+	// TryLock takes the relay lock, returning ErrLocked when another relay
+	// holds it, and a function releasing it.
+	TryLock(ctx context.Context) (func(), error)
+}
+
+// Producer publishes messages to a topic. *kafka.Producer implements it.
+type Producer interface {
+	Publish(ctx context.Context, topic string, messages ...kafka.Message) error
+}
+
+// Encoder builds the message published for an outbox entry.
+type Encoder func(entry events.OutboxEntry) (kafka.Message, error)
+
+// RelayConfig configures a Relay.
+type RelayConfig struct {
+	// Route returns the topic of an entry written without one, such as an
+	// event a repository wrote before the use case published it. It is
+	// required.
+	Route func(entry events.OutboxEntry) string
+	// Encode builds the published messages; nil publishes the payload with
+	// the event_type, aggregate_type and event_id headers.
+	Encode Encoder
+	// MeterProvider records the relay's metrics; nil uses the global provider.
+	MeterProvider metric.MeterProvider
+	// Interval is how often the outbox is polled. Defaults to one second.
+	Interval time.Duration
+	// BatchSize is the number of entries read at a time. Defaults to 100.
+	BatchSize int
+}
+
+// StaticRoute routes every entry written without a topic to topic.
+func StaticRoute(topic string) func(events.OutboxEntry) string {
+	return func(events.OutboxEntry) string { return topic }
+}
+
+// Relay publishes the entries of a service's outbox to Kafka in the order
+// they were written and marks them published. Only one replica relays at a
+// time; the others wait for the lock.
+type Relay struct {
+	repo      Repository
+	producer  Producer
+	route     func(events.OutboxEntry) string
+	encode    Encoder
+	logger    *slog.Logger
+	published metric.Int64Counter
+	failures  metric.Int64Counter
+	interval  time.Duration
+	batchSize int
+}
+
+// NewRelay creates a new Relay and registers its metrics: the number of
+// events published and failed publishes, and the number and age of the
+// oldest unpublished entries. logger may be nil.
+func NewRelay(repo Repository, producer Producer, cfg RelayConfig, logger *slog.Logger) (*Relay, error) {
+	if cfg.Route == nil {
+		return nil, errors.New("outbox relay requires a route")
+	}
+	if cfg.Interval < 0 || cfg.BatchSize < 0 {
+		return nil, fmt.Errorf("invalid outbox relay interval %v or batch size %d", cfg.Interval, cfg.BatchSize)
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	r := &Relay{
+		repo:      repo,
+		producer:  producer,
+		route:     cfg.Route,
+		encode:    cfg.Encode,
+		logger:    logger,
+		interval:  cfg.Interval,
+		batchSize: cfg.BatchSize,
+	}
+	if r.encode == nil {
+		r.encode = encodePayload
+	}
+	if r.interval == 0 {
+		r.interval = defaultInterval
+	}
+	if r.batchSize == 0 {
+		r.batchSize = defaultBatchSize
+	}
+	if err := r.registerMetrics(cfg.MeterProvider); err != nil {
+		return nil, fmt.Errorf("register outbox metrics: %w", err)
+	}
+	return r, nil
+}
+
+func (r *Relay) registerMetrics(provider metric.MeterProvider) error {
+	if provider == nil {
+		provider = otel.GetMeterProvider()
+	}
+	meter := provider.Meter("github.com/bibbank/bib/pkg/outbox")
+
+	var err error
+	r.published, err = meter.Int64Counter("outbox.events.published",
+		metric.WithDescription("Outbox events published to Kafka."))
+	if err != nil {
+		return err
+	}
+	r.failures, err = meter.Int64Counter("outbox.publish.failures",
+		metric.WithDescription("Failed attempts to publish outbox events."))
+	if err != nil {
+		return err
+	}
+	pending, err := meter.Int64ObservableGauge("outbox.pending",
+		metric.WithDescription("Outbox events not yet published."))
+	if err != nil {
+		return err
+	}
+	lag, err := meter.Float64ObservableGauge("outbox.lag",
+		metric.WithDescription("Age of the oldest unpublished outbox event."),
+		metric.WithUnit("s"))
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		ctx, cancel := context.WithTimeout(ctx, lagQueryTimeout)
+		defer cancel()
+		count, oldest, lagErr := r.repo.Lag(ctx)
+		if lagErr != nil {
+			return lagErr
+		}
+		o.ObserveInt64(pending, count)
+		age := 0.0
+		if !oldest.IsZero() {
+			age = time.Since(oldest).Seconds()
+		}
+		o.ObserveFloat64(lag, age)
+		return nil
+	}, pending, lag)
+	return err
+}
+
+// Run relays the outbox every interval until ctx is cancelled, then returns
+// nil. Entries written since its last pass are left for Flush.
+func (r *Relay) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := r.Drain(ctx); err != nil && ctx.Err() == nil {
+				r.logger.Error("outbox relay failed", "error", err)
+			}
+		}
+	}
+}
+
+// Flush publishes what is left in the outbox, for a service shutting down
+// once nothing writes to it any more.
+func (r *Relay) Flush(ctx context.Context) error {
+	n, err := r.Drain(ctx)
+	if n > 0 {
+		r.logger.Info("outbox flushed", "published", n)
+	}
+	return err
+}
+
+// Drain publishes unpublished entries until the outbox is empty or a publish
+// fails, returning the number published. It publishes nothing, without error,
+// while another relay holds the lock.
+func (r *Relay) Drain(ctx context.Context) (int, error) {
+	release, err := r.repo.TryLock(ctx)
+	if errors.Is(err, ErrLocked) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+
+	total := 0
+	for {
+		batch, err := r.repo.FetchUnpublished(ctx, r.batchSize)
+		if err != nil {
+			return total, err
+		}
+		n, err := r.publish(ctx, batch)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		if len(batch) < r.batchSize {
+			return total, nil
+		}
+	}
+}
+
+// publish sends the batch in order, one message set per run of entries for
+// the same topic, stopping at the first failure so later events are not
+// delivered ahead of it.
+func (r *Relay) publish(ctx context.Context, batch []events.OutboxEntry) (int, error) {
+	published := 0
+	for start := 0; start < len(batch); {
+		topic := r.topicOf(batch[start])
+		end := start + 1
+		for end < len(batch) && r.topicOf(batch[end]) == topic {
+			end++
+		}
+
+		run := batch[start:end]
+		messages := make([]kafka.Message, 0, len(run))
+		ids := make([]string, 0, len(run))
+		for _, entry := range run {
+			msg, err := r.encode(entry)
+			if err != nil {
+				r.failures.Add(ctx, 1, metric.WithAttributes(attribute.String("topic", topic)))
+				return published, fmt.Errorf("encode outbox entry %s: %w", entry.ID, err)
+			}
+			messages = append(messages, msg)
+			ids = append(ids, entry.ID)
+		}
+
+		if err := r.producer.Publish(ctx, topic, messages...); err != nil {
+			r.failures.Add(ctx, 1, metric.WithAttributes(attribute.String("topic", topic)))
+			return published, fmt.Errorf("publish outbox entries to %s: %w", topic, err)
+		}
+		if err := r.repo.MarkPublished(ctx, ids); err != nil {
+			return published, err
+		}
+		r.published.Add(ctx, int64(len(run)), metric.WithAttributes(attribute.String("topic", topic)))
+		published += len(run)
+		start = end
+	}
+	return published, nil
+}
+
+func (r *Relay) topicOf(entry events.OutboxEntry) string {
+	if entry.Topic != "" {
+		return entry.Topic
+	}
+	return r.route(entry)
+}
+
+// encodePayload publishes the stored event as it is, keyed by its aggregate.
+func encodePayload(entry events.OutboxEntry) (kafka.Message, error) {
+	return kafka.Message{
+		Key:     []byte(entry.AggregateID),
+		Value:   entry.Payload,
+		Headers: eventHeaders(entry),
+	}, nil
+}
+
+// CloudEventsEncoder publishes each event as a CloudEvents 1.0 envelope in
+// structured content mode, with source identifying the service.
+func CloudEventsEncoder(source string) Encoder {
+	return func(entry events.OutboxEntry) (kafka.Message, error) {
+		var tenant struct {
+			TenantID string `json:"tenant_id"`
+		}
+		if err := json.Unmarshal(entry.Payload, &tenant); err != nil {
+			return kafka.Message{}, fmt.Errorf("decode event %s: %w", entry.EventType, err)
+		}
+		payload, err := json.Marshal(events.CloudEvent{
+			SpecVersion:     events.CloudEventsSpecVersion,
+			ID:              entry.ID,
+			Source:          source,
+			Type:            entry.EventType,
+			Subject:         entry.AggregateID,
+			Time:            entry.CreatedAt,
+			DataContentType: "application/json",
+			TenantID:        tenant.TenantID,
+			AggregateType:   entry.AggregateType,
+			Data:            entry.Payload,
+		})
+		if err != nil {
+			return kafka.Message{}, fmt.Errorf("marshal CloudEvent %s: %w", entry.EventType, err)
+		}
+		headers := eventHeaders(entry)
+		headers["content-type"] = events.CloudEventsContentType
+		return kafka.Message{
+			Key:     []byte(entry.AggregateID),
+			Value:   payload,
+			Headers: headers,
+		}, nil
+	}
+}
+
+func eventHeaders(entry events.OutboxEntry) map[string]string {
+	return map[string]string{
+		"event_type":     entry.EventType,
+		"aggregate_type": entry.AggregateType,
+		"event_id":       entry.ID,
+	}
+}
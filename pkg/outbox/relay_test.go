@@ -0,0 +1,302 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/pkg/kafka"
+)
+
+// memoryRepo is an in-memory outbox.
+type memoryRepo struct {
+	entries   []events.OutboxEntry
+	published map[string]bool
+	locked    bool
+}
+
+func newMemoryRepo(entries ...events.OutboxEntry) *memoryRepo {
+	return &memoryRepo{entries: entries, published: make(map[string]bool)}
+}
+
+func (m *memoryRepo) Store(_ context.Context, entries []events.OutboxEntry) error {
+	m.entries = append(m.entries, entries...)
+	return nil
+}
+
+func (m *memoryRepo) FetchUnpublished(_ context.Context, batchSize int) ([]events.OutboxEntry, error) {
+	var batch []events.OutboxEntry
+	for _, e := range m.entries {
+		if !m.published[e.ID] && len(batch) < batchSize {
+			batch = append(batch, e)
+		}
+	}
+	return batch, nil
+}
+
+func (m *memoryRepo) MarkPublished(_ context.Context, ids []string) error {
+	for _, id := range ids {
+		m.published[id] = true
+	}
+	return nil
+}
+
+func (m *memoryRepo) Lag(context.Context) (int64, time.Time, error) {
+	var (
+		pending int64
+		oldest  time.Time
+	)
+	for _, e := range m.entries {
+		if m.published[e.ID] {
+			continue
+		}
+		pending++
+		if oldest.IsZero() || e.CreatedAt.Before(oldest) {
+			oldest = e.CreatedAt
+		}
+	}
+	return pending, oldest, nil
+}
+
+func (m *memoryRepo) TryLock(context.Context) (func(), error) {
+	if m.locked {
+		return nil, ErrLocked
+	}
+	m.locked = true
+	return func() { m.locked = false }, nil
+}
+
+type publishCall struct {
+	topic string
+	ids   []string
+}
+
+// fakeProducer records publishes, failing those to failTopic.
+type fakeProducer struct {
+	failTopic string
+	calls     []publishCall
+}
+
+func (p *fakeProducer) Publish(_ context.Context, topic string, messages ...kafka.Message) error {
+	if topic == p.failTopic {
+		return errors.New("broker unavailable")
+	}
+	call := publishCall{topic: topic}
+	for _, msg := range messages {
+		call.ids = append(call.ids, msg.Headers["event_id"])
+	}
+	p.calls = append(p.calls, call)
+	return nil
+}
+
+func entry(id, topic string, createdAt time.Time) events.OutboxEntry {
+	return events.OutboxEntry{
+		ID:            id,
+		AggregateID:   "agg-" + id,
+		AggregateType: "Payment",
+		EventType:     "payment.order.initiated",
+		Topic:         topic,
+		Payload:       []byte(`{"tenant_id":"tenant-1"}`),
+		CreatedAt:     createdAt,
+	}
+}
+
+func TestDrain_PublishesInOrderGroupedByTopic(t *testing.T) {
+	now := time.Now()
+	repo := newMemoryRepo(
+		entry("1", "orders", now),
+		entry("2", "", now),
+		entry("3", "alerts", now),
+		entry("4", "orders", now),
+	)
+	producer := &fakeProducer{}
+	relay, err := NewRelay(repo, producer, RelayConfig{Route: StaticRoute("orders"), BatchSize: 3}, nil)
+	if err != nil {
+		t.Fatalf("NewRelay() error = %v", err)
+	}
+
+	n, err := relay.Drain(context.Background())
+	if err != nil {
+		t.Fatalf("Drain() error = %v", err)
+	}
+	if n != 4 {
+		t.Errorf("published = %d, want 4", n)
+	}
+	want := []publishCall{
+		{topic: "orders", ids: []string{"1", "2"}},
+		{topic: "alerts", ids: []string{"3"}},
+		{topic: "orders", ids: []string{"4"}},
+	}
+	if !slices.EqualFunc(producer.calls, want, func(a, b publishCall) bool {
+		return a.topic == b.topic && slices.Equal(a.ids, b.ids)
+	}) {
+		t.Errorf("calls = %+v, want %+v", producer.calls, want)
+	}
+	if repo.locked {
+		t.Error("lock not released")
+	}
+}
+
+func TestDrain_StopsAtFailureAndRetries(t *testing.T) {
+	now := time.Now()
+	repo := newMemoryRepo(
+		entry("1", "orders", now),
+		entry("2", "alerts", now),
+		entry("3", "orders", now),
+	)
+	producer := &fakeProducer{failTopic: "alerts"}
+	relay, err := NewRelay(repo, producer, RelayConfig{Route: StaticRoute("orders")}, nil)
+	if err != nil {
+		t.Fatalf("NewRelay() error = %v", err)
+	}
+
+	n, err := relay.Drain(context.Background())
+	if err == nil {
+		t.Fatal("Drain() error = nil, want publish failure")
+	}
+	if n != 1 || !repo.published["1"] || repo.published["3"] {
+		t.Errorf("published = %d %v, want only the entry before the failure", n, repo.published)
+	}
+
+	producer.failTopic = ""
+	if _, err := relay.Drain(context.Background()); err != nil {
+		t.Fatalf("Drain() retry error = %v", err)
+	}
+	if !repo.published["2"] || !repo.published["3"] {
+		t.Errorf("published = %v, want all entries after retry", repo.published)
+	}
+}
+
+func TestDrain_SkipsWhileLocked(t *testing.T) {
+	repo := newMemoryRepo(entry("1", "orders", time.Now()))
+	repo.locked = true
+	producer := &fakeProducer{}
+	relay, err := NewRelay(repo, producer, RelayConfig{Route: StaticRoute("orders")}, nil)
+	if err != nil {
+		t.Fatalf("NewRelay() error = %v", err)
+	}
+
+	n, err := relay.Drain(context.Background())
+	if err != nil || n != 0 || len(producer.calls) != 0 {
+		t.Errorf("Drain() = %d, %v with %d publishes, want nothing while locked", n, err, len(producer.calls))
+	}
+}
+
+func TestRelay_RunLeavesEntriesForFlush(t *testing.T) {
+	repo := newMemoryRepo()
+	producer := &fakeProducer{}
+	relay, err := NewRelay(repo, producer, RelayConfig{Route: StaticRoute("orders"), Interval: time.Hour}, nil)
+	if err != nil {
+		t.Fatalf("NewRelay() error = %v", err)
+	}
+
+	// Written after the relay's last poll, as by a request drained during
+	// shutdown.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := relay.Run(ctx); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if err := repo.Store(context.Background(), []events.OutboxEntry{entry("1", "", time.Now())}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := relay.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	if len(producer.calls) != 1 || !slices.Equal(producer.calls[0].ids, []string{"1"}) {
+		t.Errorf("calls = %+v, want entry 1 published", producer.calls)
+	}
+}
+
+func TestRelay_ReportsLag(t *testing.T) {
+	repo := newMemoryRepo(
+		entry("1", "orders", time.Now().Add(-time.Minute)),
+		entry("2", "orders", time.Now()),
+	)
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	if _, err := NewRelay(repo, &fakeProducer{}, RelayConfig{Route: StaticRoute("orders"), MeterProvider: provider}, nil); err != nil {
+		t.Fatalf("NewRelay() error = %v", err)
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	var (
+		pending int64
+		lag     float64
+	)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Gauge[int64]:
+				if m.Name == "outbox.pending" {
+					pending = data.DataPoints[0].Value
+				}
+			case metricdata.Gauge[float64]:
+				if m.Name == "outbox.lag" {
+					lag = data.DataPoints[0].Value
+				}
+			}
+		}
+	}
+	if pending != 2 {
+		t.Errorf("outbox.pending = %d, want 2", pending)
+	}
+	if lag < 60 {
+		t.Errorf("outbox.lag = %v, want at least 60s", lag)
+	}
+}
+
+func TestCloudEventsEncoder(t *testing.T) {
+	e := entry("evt-1", "verifications", time.Now())
+	msg, err := CloudEventsEncoder("/bib/identity-service")(e)
+	if err != nil {
+		t.Fatalf("encode error = %v", err)
+	}
+
+	ce, err := events.ParseCloudEvent(msg.Value)
+	if err != nil {
+		t.Fatalf("ParseCloudEvent() error = %v", err)
+	}
+	if ce.ID != "evt-1" || ce.Source != "/bib/identity-service" || ce.TenantID != "tenant-1" || ce.Subject != e.AggregateID {
+		t.Errorf("CloudEvent = %+v", ce)
+	}
+	if !json.Valid(ce.Data) || string(ce.Data) != string(e.Payload) {
+		t.Errorf("data = %s, want the stored payload", ce.Data)
+	}
+	if msg.Headers["content-type"] != events.CloudEventsContentType || msg.Headers["event_id"] != "evt-1" {
+		t.Errorf("headers = %v", msg.Headers)
+	}
+}
+
+func TestNewRelay_PollingSettings(t *testing.T) {
+	relay, err := NewRelay(newMemoryRepo(), &fakeProducer{}, RelayConfig{Route: StaticRoute("orders")}, nil)
+	if err != nil {
+		t.Fatalf("NewRelay() error = %v", err)
+	}
+	if relay.interval != time.Second || relay.batchSize != 100 {
+		t.Errorf("default interval, batch size = %v, %d; want 1s, 100", relay.interval, relay.batchSize)
+	}
+
+	relay, err = NewRelay(newMemoryRepo(), &fakeProducer{}, RelayConfig{Route: StaticRoute("orders"), Interval: time.Minute, BatchSize: 10}, nil)
+	if err != nil {
+		t.Fatalf("NewRelay() error = %v", err)
+	}
+	if relay.interval != time.Minute || relay.batchSize != 10 {
+		t.Errorf("configured interval, batch size = %v, %d; want 1m, 10", relay.interval, relay.batchSize)
+	}
+
+	if _, err := NewRelay(newMemoryRepo(), &fakeProducer{}, RelayConfig{Route: StaticRoute("orders"), BatchSize: -1}, nil); err == nil {
+		t.Error("NewRelay() with a negative batch size succeeded")
+	}
+}
@@ -0,0 +1,197 @@
+// Package outbox delivers domain events through the transactional outbox
+// shared by every service. Events are written to the service's outbox table,
+// ideally in the transaction that saves the aggregate, and a Relay publishes
+// them to Kafka and marks them published. An event is never lost while the
+// broker is down, but may be delivered more than once: consumers must
+// tolerate duplicates, which carry the same event_id header.
+//
+// Every service's outbox table has the columns id, aggregate_id,
+// aggregate_type, event_type, topic, payload, created_at and published_at,
+// where id is the event ID.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// relayLockKey is the advisory lock held while a relay drains the outbox, so
+// only one replica of a service publishes at a time and events keep their
+// order.
+const relayLockKey = 0x6f7574626f78 // "outbox"
+
+// ErrLocked is returned by Store.TryLock when another relay holds the outbox.
+var ErrLocked = errors.New("outbox is locked by another relay")
+
+// Store is the PostgreSQL outbox table of a service.
+type Store struct {
+	pool *pgxpool.Pool
+}
+
+// NewStore creates a new Store.
+func NewStore(pool *pgxpool.Pool) *Store {
+	return &Store{pool: pool}
+}
+
+// Store writes entries to the outbox. An entry whose event was already
+// written, typically by a repository within the aggregate's transaction, is
+// left as it is except that it is given the topic if it had none.
+func (s *Store) Store(ctx context.Context, entries []events.OutboxEntry) error {
+	const insertSQL = `
+		INSERT INTO outbox (id, aggregate_id, aggregate_type, event_type, topic, payload, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (id) DO UPDATE SET topic = EXCLUDED.topic
+		WHERE outbox.topic = ''
+	`
+
+	for _, e := range entries {
+		_, err := s.pool.Exec(ctx, insertSQL,
+			e.ID, e.AggregateID, e.AggregateType, e.EventType, e.Topic, e.Payload, e.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("insert outbox entry %s: %w", e.ID, err)
+		}
+	}
+	return nil
+}
+
+// FetchUnpublished returns up to batchSize unpublished entries, oldest first.
+func (s *Store) FetchUnpublished(ctx context.Context, batchSize int) ([]events.OutboxEntry, error) {
+	const query = `
+		SELECT id, aggregate_id, aggregate_type, event_type, topic, payload, created_at
+		FROM outbox
+		WHERE published_at IS NULL
+		ORDER BY created_at, id
+		LIMIT $1
+	`
+
+	rows, err := s.pool.Query(ctx, query, batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("query outbox: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []events.OutboxEntry
+	for rows.Next() {
+		var e events.OutboxEntry
+		if err := rows.Scan(&e.ID, &e.AggregateID, &e.AggregateType, &e.EventType, &e.Topic, &e.Payload, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan outbox entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("outbox row iteration: %w", err)
+	}
+	return entries, nil
+}
+
+// MarkPublished records the entries as published.
+func (s *Store) MarkPublished(ctx context.Context, ids []string) error {
+	const updateSQL = `UPDATE outbox SET published_at = NOW() WHERE id = ANY($1::uuid[])`
+
+	if _, err := s.pool.Exec(ctx, updateSQL, ids); err != nil {
+		return fmt.Errorf("mark outbox entries published: %w", err)
+	}
+	return nil
+}
+
+// ReplayFilter selects the published entries to replay. From is required;
+// the other fields narrow the selection when set.
+type ReplayFilter struct {
+	// From and To bound when the entries were written, as [From, To). A
+	// zero To means up to now.
+	From time.Time
+	To   time.Time
+	// EventType selects entries of one event type, such as
+	// "payment.initiated".
+	EventType string
+	// AggregateID selects the entries of one aggregate.
+	AggregateID string
+	// Limit caps the number of entries replayed.
+	Limit int
+}
+
+// Replay marks published entries matching filter as unpublished, so the
+// relay publishes them again, oldest first, and returns how many it marked.
+// Consumers receive the replayed events as duplicates carrying the
+// original event IDs.
+func (s *Store) Replay(ctx context.Context, filter ReplayFilter) (int64, error) {
+	const updateSQL = `
+		UPDATE outbox SET published_at = NULL
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE published_at IS NOT NULL
+				AND created_at >= $1
+				AND ($2::timestamptz IS NULL OR created_at < $2)
+				AND ($3 = '' OR event_type = $3)
+				AND ($4 = '' OR aggregate_id::text = $4)
+			ORDER BY created_at, id
+			LIMIT $5
+		)
+	`
+
+	var to *time.Time
+	if !filter.To.IsZero() {
+		to = &filter.To
+	}
+	tag, err := s.pool.Exec(ctx, updateSQL, filter.From, to, filter.EventType, filter.AggregateID, filter.Limit)
+	if err != nil {
+		return 0, fmt.Errorf("replay outbox entries: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// Lag returns the number of unpublished entries and when the oldest was
+// written, which is the zero time when there are none.
+func (s *Store) Lag(ctx context.Context) (int64, time.Time, error) {
+	const query = `SELECT COUNT(*), MIN(created_at) FROM outbox WHERE published_at IS NULL`
+
+	var (
+		pending int64
+		oldest  *time.Time
+	)
+	if err := s.pool.QueryRow(ctx, query).Scan(&pending, &oldest); err != nil {
+		return 0, time.Time{}, fmt.Errorf("query outbox lag: %w", err)
+	}
+	if oldest == nil {
+		return pending, time.Time{}, nil
+	}
+	return pending, *oldest, nil
+}
+
+// TryLock takes the service's outbox relay lock, returning ErrLocked when
+// another relay holds it. The lock is held on a dedicated connection until
+// release is called.
+func (s *Store) TryLock(ctx context.Context) (func(), error) {
+	conn, err := s.pool.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("acquire outbox lock connection: %w", err)
+	}
+
+	var locked bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock($1)`, int64(relayLockKey)).Scan(&locked); err != nil {
+		conn.Release()
+		return nil, fmt.Errorf("take outbox lock: %w", err)
+	}
+	if !locked {
+		conn.Release()
+		return nil, ErrLocked
+	}
+
+	return func() {
+		// Unlock with a fresh context: the caller's may already be cancelled.
+		unlockCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := conn.Exec(unlockCtx, `SELECT pg_advisory_unlock($1)`, int64(relayLockKey)); err != nil {
+			// A session lock that cannot be released must not go back to the
+			// pool, where it would block every relay.
+			_ = conn.Conn().Close(unlockCtx) //nolint:errcheck // connection is discarded
+		}
+		conn.Release()
+	}, nil
+}
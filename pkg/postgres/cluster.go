@@ -0,0 +1,225 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ClusterConfig describes a primary and its read replicas. Replicas share
+// the primary's credentials, database and pool limits.
+type ClusterConfig struct {
+	// Replicas are the replica addresses, as "host" or "host:port". A
+	// replica without a port uses the primary's.
+	Replicas []string
+	Primary  Config
+	// MaxReplicaLag, when positive, takes a replica that has fallen further
+	// behind the primary than this out of rotation until it catches up.
+	MaxReplicaLag time.Duration
+}
+
+// replicaLagQuery reports how far a replica's replay trails the primary, in
+// seconds. A replica that has replayed everything it received is not
+// lagging, however long ago the primary last committed.
+const replicaLagQuery = `
+	SELECT CASE
+		WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+		ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+	END`
+
+type replica struct {
+	pool    *pgxpool.Pool
+	addr    string
+	healthy atomic.Bool
+}
+
+// Cluster routes writes to the primary and reads to healthy replicas,
+// falling back to the primary when none is healthy or a replica read fails.
+// Replica health is refreshed by CheckReplicas, which RunHealthChecks calls
+// periodically.
+type Cluster struct {
+	primary  *pgxpool.Pool
+	logger   *slog.Logger
+	replicas []*replica
+	maxLag   time.Duration
+	next     atomic.Uint64
+}
+
+// NewCluster connects to the primary and each replica. The primary must be
+// reachable; a replica that is not is logged and left out of rotation until
+// a health check finds it up.
+func NewCluster(ctx context.Context, cfg ClusterConfig, logger *slog.Logger) (*Cluster, error) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	primary, err := NewPool(ctx, cfg.Primary)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cluster{primary: primary, logger: logger, maxLag: cfg.MaxReplicaLag}
+	for _, addr := range cfg.Replicas {
+		replicaCfg, err := cfg.Primary.withAddress(addr)
+		if err != nil {
+			c.Close()
+			return nil, err
+		}
+		pool, err := newPool(ctx, replicaCfg)
+		if err != nil {
+			c.Close()
+			return nil, fmt.Errorf("postgres: replica %s: %w", addr, err)
+		}
+		c.replicas = append(c.replicas, &replica{pool: pool, addr: addr})
+	}
+	c.CheckReplicas(ctx)
+	return c, nil
+}
+
+// WriterPool returns the primary's pool.
+func (c *Cluster) WriterPool() *pgxpool.Pool {
+	return c.primary
+}
+
+// ReaderPool returns the pool of a healthy replica, chosen round-robin, or
+// the primary's pool when there is none. Reads from a replica may trail the
+// primary, so reads that must see the caller's own writes use WriterPool.
+func (c *Cluster) ReaderPool() *pgxpool.Pool {
+	n := len(c.replicas)
+	if n == 0 {
+		return c.primary
+	}
+	start := int(c.next.Add(1) % uint64(n))
+	for i := 0; i < n; i++ {
+		if r := c.replicas[(start+i)%n]; r.healthy.Load() {
+			return r.pool
+		}
+	}
+	return c.primary
+}
+
+// Reader runs the reads of one repository call against a single pool, so
+// that related queries, such as a count and the page it counts, see the same
+// data. Cluster and PoolReader implement it.
+type Reader interface {
+	Read(ctx context.Context, fn func(q Querier) error) error
+}
+
+// Read runs fn against the pool ReaderPool returns. When fn fails on a
+// replica it is run again on the primary, so a replica that fails between
+// health checks costs a retry rather than the read. fn may run twice and
+// must not keep results from a failed run.
+func (c *Cluster) Read(ctx context.Context, fn func(q Querier) error) error {
+	pool := c.ReaderPool()
+	err := fn(pool)
+	if err == nil || pool == c.primary || ctx.Err() != nil {
+		return err
+	}
+	c.logger.Warn("postgres replica read failed, retrying on the primary", "error", err)
+	return fn(c.primary)
+}
+
+// PoolReader returns a Reader that runs every read against pool.
+func PoolReader(pool *pgxpool.Pool) Reader {
+	return poolReader{pool}
+}
+
+type poolReader struct {
+	pool *pgxpool.Pool
+}
+
+func (r poolReader) Read(_ context.Context, fn func(q Querier) error) error {
+	return fn(r.pool)
+}
+
+// CheckReplicas pings each replica, and checks its lag when MaxReplicaLag is
+// set, taking unhealthy replicas out of rotation and returning recovered
+// ones to it.
+func (c *Cluster) CheckReplicas(ctx context.Context) {
+	for _, r := range c.replicas {
+		err := c.checkReplica(ctx, r)
+		healthy := err == nil
+		if r.healthy.Swap(healthy) == healthy {
+			continue
+		}
+		if healthy {
+			c.logger.Info("postgres replica back in rotation", "replica", r.addr)
+		} else {
+			c.logger.Warn("postgres replica out of rotation", "replica", r.addr, "error", err)
+		}
+	}
+}
+
+func (c *Cluster) checkReplica(ctx context.Context, r *replica) error {
+	if err := r.pool.Ping(ctx); err != nil {
+		return err
+	}
+	if c.maxLag <= 0 {
+		return nil
+	}
+	var lagSeconds float64
+	if err := r.pool.QueryRow(ctx, replicaLagQuery).Scan(&lagSeconds); err != nil {
+		return fmt.Errorf("check lag: %w", err)
+	}
+	if lag := time.Duration(lagSeconds * float64(time.Second)); lag > c.maxLag {
+		return fmt.Errorf("replica lag %s exceeds %s", lag.Round(time.Millisecond), c.maxLag)
+	}
+	return nil
+}
+
+// RunHealthChecks calls CheckReplicas every interval until ctx is
+// cancelled.
+func (c *Cluster) RunHealthChecks(ctx context.Context, interval time.Duration) {
+	if len(c.replicas) == 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.CheckReplicas(ctx)
+		}
+	}
+}
+
+// HealthCheck pings the primary. Replicas are not checked: reads fall back
+// to the primary without them.
+func (c *Cluster) HealthCheck(ctx context.Context) error {
+	return HealthCheck(ctx, c.primary)
+}
+
+// Close closes every pool in the cluster.
+func (c *Cluster) Close() {
+	for _, r := range c.replicas {
+		r.pool.Close()
+	}
+	c.primary.Close()
+}
+
+// withAddress returns a copy of c pointing at addr, "host" or "host:port".
+func (c Config) withAddress(addr string) (Config, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		var addrErr *net.AddrError
+		if !errors.As(err, &addrErr) || addrErr.Err != "missing port in address" {
+			return Config{}, fmt.Errorf("postgres: replica address %q: %w", addr, err)
+		}
+		c.Host = addr
+		return c, nil
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		return Config{}, fmt.Errorf("postgres: replica address %q: invalid port", addr)
+	}
+	c.Host, c.Port = host, p
+	return c, nil
+}
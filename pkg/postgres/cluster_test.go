@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func TestConfig_withAddress(t *testing.T) {
+	base := Config{Host: "primary", Port: 5432, User: "bib", Database: "bib_account"}
+	tests := []struct {
+		name     string
+		addr     string
+		wantHost string
+		wantPort int
+		wantErr  bool
+	}{
+		{name: "host keeps the primary's port", addr: "replica-1", wantHost: "replica-1", wantPort: 5432},
+		{name: "host and port", addr: "replica-2:6432", wantHost: "replica-2", wantPort: 6432},
+		{name: "bracketed IPv6 with port", addr: "[::1]:5433", wantHost: "::1", wantPort: 5433},
+		{name: "invalid port", addr: "replica:abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := base.withAddress(tt.addr)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("withAddress(%q) = %+v, want error", tt.addr, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("withAddress(%q): %v", tt.addr, err)
+			}
+			if got.Host != tt.wantHost || got.Port != tt.wantPort {
+				t.Errorf("withAddress(%q) = %s:%d, want %s:%d", tt.addr, got.Host, got.Port, tt.wantHost, tt.wantPort)
+			}
+			if got.User != base.User || got.Database != base.Database {
+				t.Errorf("withAddress(%q) did not keep the primary's credentials and database", tt.addr)
+			}
+		})
+	}
+}
+
+// testPool creates a pool that never connects: pgxpool only dials on use.
+func testPool(t *testing.T, host string) *pgxpool.Pool {
+	t.Helper()
+	pool, err := newPool(context.Background(), Config{Host: host, Port: 5432, User: "bib", Database: "bib", SSLMode: "disable"})
+	if err != nil {
+		t.Fatalf("newPool: %v", err)
+	}
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func TestCluster_ReaderPool(t *testing.T) {
+	primary := testPool(t, "primary.invalid")
+	r1 := &replica{pool: testPool(t, "replica-1.invalid"), addr: "replica-1"}
+	r2 := &replica{pool: testPool(t, "replica-2.invalid"), addr: "replica-2"}
+
+	t.Run("without replicas reads go to the primary", func(t *testing.T) {
+		c := &Cluster{primary: primary}
+		if c.ReaderPool() != primary {
+			t.Error("ReaderPool() did not return the primary")
+		}
+	})
+
+	t.Run("reads are spread over healthy replicas", func(t *testing.T) {
+		r1.healthy.Store(true)
+		r2.healthy.Store(true)
+		c := &Cluster{primary: primary, replicas: []*replica{r1, r2}}
+		seen := map[*pgxpool.Pool]int{}
+		for i := 0; i < 4; i++ {
+			seen[c.ReaderPool()]++
+		}
+		if seen[r1.pool] != 2 || seen[r2.pool] != 2 {
+			t.Errorf("reads were not spread evenly: replica-1=%d replica-2=%d primary=%d", seen[r1.pool], seen[r2.pool], seen[primary])
+		}
+		if c.WriterPool() != primary {
+			t.Error("WriterPool() did not return the primary")
+		}
+	})
+
+	t.Run("unhealthy replicas are skipped", func(t *testing.T) {
+		r1.healthy.Store(false)
+		r2.healthy.Store(true)
+		c := &Cluster{primary: primary, replicas: []*replica{r1, r2}}
+		for i := 0; i < 3; i++ {
+			if c.ReaderPool() != r2.pool {
+				t.Fatal("ReaderPool() did not skip the unhealthy replica")
+			}
+		}
+	})
+
+	t.Run("reads fall back to the primary when no replica is healthy", func(t *testing.T) {
+		r1.healthy.Store(false)
+		r2.healthy.Store(false)
+		c := &Cluster{primary: primary, replicas: []*replica{r1, r2}}
+		if c.ReaderPool() != primary {
+			t.Error("ReaderPool() did not fall back to the primary")
+		}
+	})
+}
+
+func TestCluster_Read(t *testing.T) {
+	primary := testPool(t, "primary.invalid")
+	r1 := &replica{pool: testPool(t, "replica-1.invalid"), addr: "replica-1"}
+	r1.healthy.Store(true)
+	c := &Cluster{primary: primary, replicas: []*replica{r1}, logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	ctx := context.Background()
+
+	t.Run("a call's queries share one replica", func(t *testing.T) {
+		var used []Querier
+		err := c.Read(ctx, func(q Querier) error {
+			used = append(used, q, q)
+			return nil
+		})
+		if err != nil || len(used) != 2 || used[0] != Querier(r1.pool) {
+			t.Errorf("Read() ran on %v, err = %v; want replica-1 once", used, err)
+		}
+	})
+
+	t.Run("a failed replica read is retried on the primary", func(t *testing.T) {
+		var used []Querier
+		err := c.Read(ctx, func(q Querier) error {
+			used = append(used, q)
+			if q != Querier(primary) {
+				return errors.New("replica unavailable")
+			}
+			return nil
+		})
+		if err != nil || len(used) != 2 || used[1] != Querier(primary) {
+			t.Errorf("Read() ran on %v, err = %v; want replica-1 then the primary", used, err)
+		}
+	})
+
+	t.Run("a failed primary read is not retried", func(t *testing.T) {
+		r1.healthy.Store(false)
+		defer r1.healthy.Store(true)
+		calls := 0
+		err := c.Read(ctx, func(Querier) error {
+			calls++
+			return errors.New("primary unavailable")
+		})
+		if err == nil || calls != 1 {
+			t.Errorf("Read() = %v after %d calls, want the error after one", err, calls)
+		}
+	})
+}
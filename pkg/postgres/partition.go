@@ -0,0 +1,265 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ArchiveSchema is the schema expired partitions are moved to when their
+// policy archives them.
+const ArchiveSchema = "archive"
+
+// ExpireAction is what happens to a partition once it leaves a policy's
+// retention window.
+type ExpireAction int
+
+const (
+	// ExpireArchive detaches the partition and moves it to ArchiveSchema,
+	// where it stays queryable until it is exported and dropped.
+	ExpireArchive ExpireAction = iota
+	// ExpireDrop detaches and drops the partition.
+	ExpireDrop
+)
+
+func (a ExpireAction) String() string {
+	if a == ExpireDrop {
+		return "drop"
+	}
+	return "archive"
+}
+
+// PartitionPolicy declares how a table range-partitioned by month is
+// maintained. The table and its DEFAULT partition are created by a
+// migration; the monthly partitions are named <table>_pYYYYMM.
+type PartitionPolicy struct {
+	// Table is the partitioned parent table.
+	Table string
+	// Premake is how many months after the current one have partitions
+	// created ahead of time. Defaults to 3.
+	Premake int
+	// TenantBuckets, when positive, sub-partitions each month by hash of
+	// tenant_id into that many partitions, named <table>_pYYYYMM_hN.
+	TenantBuckets int
+	// Retain is how many months, counting the current one, stay attached.
+	// Zero keeps every partition.
+	Retain int
+	// Expire is what happens to partitions older than Retain.
+	Expire ExpireAction
+}
+
+// PartitionManager creates monthly partitions ahead of time and expires old
+// ones, for each policy in order. When tables reference each other, list the
+// referencing table first so its partitions expire before the ones they
+// point at.
+type PartitionManager struct {
+	pool     *pgxpool.Pool
+	logger   *slog.Logger
+	now      func() time.Time
+	policies []PartitionPolicy
+}
+
+// NewPartitionManager creates a PartitionManager for the given policies.
+func NewPartitionManager(pool *pgxpool.Pool, policies []PartitionPolicy, logger *slog.Logger) *PartitionManager {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &PartitionManager{pool: pool, logger: logger, now: time.Now, policies: policies}
+}
+
+// Run maintains the partitions immediately and then every interval until
+// ctx is cancelled. Failures are logged and retried on the next run.
+func (m *PartitionManager) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := m.Maintain(ctx); err != nil && ctx.Err() == nil {
+			m.logger.Error("partition maintenance failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Maintain runs every policy once. A policy whose table another replica is
+// maintaining is skipped.
+func (m *PartitionManager) Maintain(ctx context.Context) error {
+	month := monthStart(m.now())
+	var errs []error
+	for _, p := range m.policies {
+		if err := WithTransaction(ctx, m.pool, func(tx pgx.Tx) error {
+			return m.maintain(ctx, tx, p, month)
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("postgres: maintain partitions of %s: %w", p.Table, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m *PartitionManager) maintain(ctx context.Context, tx pgx.Tx, p PartitionPolicy, month time.Time) error {
+	var locked bool
+	if err := tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock(hashtext('partitions:' || $1))`, p.Table).Scan(&locked); err != nil {
+		return fmt.Errorf("lock: %w", err)
+	}
+	if !locked {
+		return nil
+	}
+
+	for _, stmt := range p.createStatements(month) {
+		if _, err := tx.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("create partition: %w", err)
+		}
+	}
+
+	if p.Retain <= 0 {
+		return nil
+	}
+	names, err := partitionsOf(ctx, tx, p.Table)
+	if err != nil {
+		return err
+	}
+	for _, name := range p.expired(names, month) {
+		if err := expire(ctx, tx, p, name); err != nil {
+			return fmt.Errorf("expire %s: %w", name, err)
+		}
+		m.logger.Info("partition expired", "table", p.Table, "partition", name, "action", p.Expire.String())
+	}
+	return nil
+}
+
+// createStatements returns the DDL creating the partitions for month and
+// the Premake months after it, if they do not exist.
+func (p PartitionPolicy) createStatements(month time.Time) []string {
+	premake := p.Premake
+	if premake <= 0 {
+		premake = 3
+	}
+	parent := pgx.Identifier{p.Table}.Sanitize()
+
+	var stmts []string
+	for i := 0; i <= premake; i++ {
+		from := month.AddDate(0, i, 0)
+		name := PartitionName(p.Table, from)
+		stmt := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')",
+			pgx.Identifier{name}.Sanitize(), parent, from.Format(time.RFC3339), from.AddDate(0, 1, 0).Format(time.RFC3339))
+		if p.TenantBuckets > 0 {
+			stmt += " PARTITION BY HASH (tenant_id)"
+		}
+		stmts = append(stmts, stmt)
+		for b := 0; b < p.TenantBuckets; b++ {
+			stmts = append(stmts, fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES WITH (MODULUS %d, REMAINDER %d)",
+				pgx.Identifier{fmt.Sprintf("%s_h%d", name, b)}.Sanitize(), pgx.Identifier{name}.Sanitize(), p.TenantBuckets, b))
+		}
+	}
+	return stmts
+}
+
+// expired returns the monthly partitions among names that fall outside the
+// retention window ending with month, oldest first.
+func (p PartitionPolicy) expired(names []string, month time.Time) []string {
+	cutoff := month.AddDate(0, 1-p.Retain, 0)
+	var out []string
+	for _, name := range names {
+		if m, ok := PartitionMonth(p.Table, name); ok && m.Before(cutoff) {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// partitionsOf lists the direct partitions of table, oldest name first.
+func partitionsOf(ctx context.Context, tx pgx.Tx, table string) ([]string, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = to_regclass($1)
+		ORDER BY c.relname`, pgx.Identifier{table}.Sanitize())
+	if err != nil {
+		return nil, fmt.Errorf("list partitions: %w", err)
+	}
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("list partitions: %w", err)
+	}
+	return names, nil
+}
+
+// expire detaches a partition and archives or drops it. An archived
+// partition is a standalone table, so its foreign keys are dropped: they
+// would otherwise stop the partitions they reference from expiring.
+func expire(ctx context.Context, tx pgx.Tx, p PartitionPolicy, name string) error {
+	parent := pgx.Identifier{p.Table}.Sanitize()
+	partition := pgx.Identifier{name}.Sanitize()
+	if _, err := tx.Exec(ctx, fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s", parent, partition)); err != nil {
+		return err
+	}
+	if p.Expire == ExpireDrop {
+		_, err := tx.Exec(ctx, "DROP TABLE "+partition)
+		return err
+	}
+
+	rows, err := tx.Query(ctx, `SELECT conname FROM pg_constraint WHERE conrelid = to_regclass($1) AND contype = 'f'`, partition)
+	if err != nil {
+		return err
+	}
+	fks, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return err
+	}
+	for _, fk := range fks {
+		if _, err := tx.Exec(ctx, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", partition, pgx.Identifier{fk}.Sanitize())); err != nil {
+			return err
+		}
+	}
+	// Tenant buckets move with their month, since SET SCHEMA does not
+	// move a table's partitions.
+	buckets, err := partitionsOf(ctx, tx, name)
+	if err != nil {
+		return err
+	}
+	stmts := []string{"CREATE SCHEMA IF NOT EXISTS " + pgx.Identifier{ArchiveSchema}.Sanitize()}
+	for _, table := range append(buckets, name) {
+		stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s SET SCHEMA %s", pgx.Identifier{table}.Sanitize(), pgx.Identifier{ArchiveSchema}.Sanitize()))
+	}
+	for _, stmt := range stmts {
+		if _, err := tx.Exec(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PartitionName is the name of table's partition for month.
+func PartitionName(table string, month time.Time) string {
+	return fmt.Sprintf("%s_p%s", table, month.Format("200601"))
+}
+
+// PartitionMonth parses the month of one of table's monthly partitions. It
+// reports false for any other partition, such as the DEFAULT one.
+func PartitionMonth(table, name string) (time.Time, bool) {
+	suffix, ok := strings.CutPrefix(name, table+"_p")
+	if !ok || len(suffix) != len("200601") {
+		return time.Time{}, false
+	}
+	m, err := time.Parse("200601", suffix)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return m, true
+}
+
+// monthStart returns the first instant of t's month in UTC.
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPartitionPolicy_CreateStatements(t *testing.T) {
+	month := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	got := PartitionPolicy{Table: "card_transactions", Premake: 1}.createStatements(month)
+	want := []string{
+		`CREATE TABLE IF NOT EXISTS "card_transactions_p202611" PARTITION OF "card_transactions" FOR VALUES FROM ('2026-11-01T00:00:00Z') TO ('2026-12-01T00:00:00Z')`,
+		`CREATE TABLE IF NOT EXISTS "card_transactions_p202612" PARTITION OF "card_transactions" FOR VALUES FROM ('2026-12-01T00:00:00Z') TO ('2027-01-01T00:00:00Z')`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected statements:\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestPartitionPolicy_CreateStatementsWithTenantBuckets(t *testing.T) {
+	month := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	got := PartitionPolicy{Table: "payment_orders", TenantBuckets: 2}.createStatements(month)
+	if len(got) != 4*3 {
+		t.Fatalf("expected the current and 3 premade months with 2 buckets each, got %d statements", len(got))
+	}
+	want := []string{
+		`CREATE TABLE IF NOT EXISTS "payment_orders_p202611" PARTITION OF "payment_orders" FOR VALUES FROM ('2026-11-01T00:00:00Z') TO ('2026-12-01T00:00:00Z') PARTITION BY HASH (tenant_id)`,
+		`CREATE TABLE IF NOT EXISTS "payment_orders_p202611_h0" PARTITION OF "payment_orders_p202611" FOR VALUES WITH (MODULUS 2, REMAINDER 0)`,
+		`CREATE TABLE IF NOT EXISTS "payment_orders_p202611_h1" PARTITION OF "payment_orders_p202611" FOR VALUES WITH (MODULUS 2, REMAINDER 1)`,
+	}
+	if !reflect.DeepEqual(got[:3], want) {
+		t.Fatalf("unexpected statements:\n%q\nwant\n%q", got[:3], want)
+	}
+}
+
+func TestPartitionPolicy_Expired(t *testing.T) {
+	month := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	names := []string{
+		"journal_entries_default",
+		"journal_entries_p202512",
+		"journal_entries_p202601",
+		"journal_entries_p202602",
+		"journal_entries_p202603",
+		"journal_entries_p202604",
+		"journal_entries_legacy",
+	}
+
+	got := PartitionPolicy{Table: "journal_entries", Retain: 3}.expired(names, month)
+	if want := []string{"journal_entries_p202512"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	if got := (PartitionPolicy{Table: "journal_entries", Retain: 1}).expired(names, month); len(got) != 3 {
+		t.Fatalf("expected every month before the current one to expire, got %v", got)
+	}
+}
+
+func TestPartitionMonth(t *testing.T) {
+	tests := []struct {
+		name string
+		ok   bool
+	}{
+		{"payment_orders_p202611", true},
+		{"payment_orders_default", false},
+		{"payment_orders_p2026", false},
+		{"payment_orders_p202613", false},
+		{"card_transactions_p202611", false},
+	}
+	for _, tt := range tests {
+		if _, ok := PartitionMonth("payment_orders", tt.name); ok != tt.ok {
+			t.Errorf("PartitionMonth(%q) = %v, want %v", tt.name, ok, tt.ok)
+		}
+	}
+}
+
+func TestMonthStart(t *testing.T) {
+	got := monthStart(time.Date(2026, 11, 30, 23, 30, 0, 0, time.FixedZone("UTC-5", -5*3600)))
+	if want := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+}
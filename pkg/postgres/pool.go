@@ -36,6 +36,21 @@ func (c Config) DSN() string {
 // It applies MaxConns and MinConns when they are greater than zero and
 // verifies connectivity by pinging the database before returning.
 func NewPool(ctx context.Context, cfg Config) (*pgxpool.Pool, error) {
+	pool, err := newPool(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("postgres: ping: %w", err)
+	}
+
+	return pool, nil
+}
+
+// newPool creates a pool without connecting to the database.
+func newPool(ctx context.Context, cfg Config) (*pgxpool.Pool, error) {
 	poolCfg, err := pgxpool.ParseConfig(cfg.DSN())
 	if err != nil {
 		return nil, fmt.Errorf("postgres: parse config: %w", err)
@@ -55,12 +70,6 @@ func NewPool(ctx context.Context, cfg Config) (*pgxpool.Pool, error) {
 	if err != nil {
 		return nil, fmt.Errorf("postgres: create pool: %w", err)
 	}
-
-	if err := pool.Ping(ctx); err != nil {
-		pool.Close()
-		return nil, fmt.Errorf("postgres: ping: %w", err)
-	}
-
 	return pool, nil
 }
 
@@ -0,0 +1,122 @@
+package saga
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MemoryStore is an in-process Store for tests and single-instance tools.
+// Sagas held in memory do not survive a restart.
+type MemoryStore struct {
+	sagas map[uuid.UUID]State
+	mu    sync.Mutex
+}
+
+// NewMemoryStore creates a new, empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{sagas: make(map[uuid.UUID]State)}
+}
+
+// Create implements Store.
+func (s *MemoryStore) Create(_ context.Context, state State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.sagas {
+		if existing.Type == state.Type && existing.CorrelationID == state.CorrelationID {
+			return ErrAlreadyExists
+		}
+	}
+	s.sagas[state.ID] = clone(state)
+	return nil
+}
+
+// Update implements Store.
+func (s *MemoryStore) Update(_ context.Context, state State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.sagas[state.ID]
+	if !ok {
+		return ErrNotFound
+	}
+	if existing.Version != state.Version-1 {
+		return ErrVersionConflict
+	}
+	s.sagas[state.ID] = clone(state)
+	return nil
+}
+
+// Find implements Store.
+func (s *MemoryStore) Find(_ context.Context, id uuid.UUID) (State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.sagas[id]
+	if !ok {
+		return State{}, ErrNotFound
+	}
+	return clone(state), nil
+}
+
+// FindByCorrelation implements Store.
+func (s *MemoryStore) FindByCorrelation(_ context.Context, sagaType, correlationID string) (State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, state := range s.sagas {
+		if state.Type == sagaType && state.CorrelationID == correlationID {
+			return clone(state), nil
+		}
+	}
+	return State{}, ErrNotFound
+}
+
+// FindStalled implements Store.
+func (s *MemoryStore) FindStalled(_ context.Context, before time.Time, limit int) ([]State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var stalled []State
+	for _, state := range s.sagas {
+		if !state.Status.Terminal() && state.UpdatedAt.Before(before) {
+			stalled = append(stalled, clone(state))
+		}
+	}
+	slices.SortFunc(stalled, func(a, b State) int { return a.UpdatedAt.Compare(b.UpdatedAt) })
+	if len(stalled) > limit {
+		stalled = stalled[:limit]
+	}
+	return stalled, nil
+}
+
+// FindFailed implements Store.
+func (s *MemoryStore) FindFailed(_ context.Context, limit int) ([]State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var failed []State
+	for _, state := range s.sagas {
+		if state.Status == StatusFailed {
+			failed = append(failed, clone(state))
+		}
+	}
+	slices.SortFunc(failed, func(a, b State) int { return a.UpdatedAt.Compare(b.UpdatedAt) })
+	if len(failed) > limit {
+		failed = failed[:limit]
+	}
+	return failed, nil
+}
+
+// clone copies the state's reference fields so callers cannot mutate the
+// stored saga.
+func clone(state State) State {
+	state.Data = maps.Clone(state.Data)
+	state.CompletedSteps = slices.Clone(state.CompletedSteps)
+	return state
+}
@@ -0,0 +1,363 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Orchestrator runs sagas against their registered definitions, persisting
+// their state in a Store after every transition.
+type Orchestrator struct {
+	store       Store
+	logger      *slog.Logger
+	definitions map[string]Definition
+	now         func() time.Time
+}
+
+// NewOrchestrator creates a new Orchestrator. logger may be nil.
+func NewOrchestrator(store Store, logger *slog.Logger) *Orchestrator {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Orchestrator{
+		store:       store,
+		logger:      logger,
+		definitions: make(map[string]Definition),
+		now:         func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Register makes a saga definition available to Start, Resume and
+// RecoverStalled. Registering a type again replaces its definition.
+func (o *Orchestrator) Register(def Definition) {
+	o.definitions[def.Type] = def
+}
+
+// Start creates a saga of the given type for correlationID and runs it. If the
+// saga already exists it is resumed instead, so a redelivered trigger does not
+// start a second saga for the same business object.
+//
+// Start returns without error once the saga is COMPLETED or COMPENSATED. It
+// returns the saga's state and an error when the saga failed, when a step or
+// compensation must be retried, or when its state could not be saved.
+func (o *Orchestrator) Start(ctx context.Context, sagaType, correlationID string, data map[string]string) (State, error) {
+	def, ok := o.definitions[sagaType]
+	if !ok {
+		return State{}, fmt.Errorf("%w: %s", ErrUnknownType, sagaType)
+	}
+
+	now := o.now()
+	state := State{
+		ID:            uuid.New(),
+		Type:          sagaType,
+		CorrelationID: correlationID,
+		Status:        StatusRunning,
+		Data:          make(map[string]string, len(data)),
+		Version:       1,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	maps.Copy(state.Data, data)
+
+	err := o.store.Create(ctx, state)
+	if errors.Is(err, ErrAlreadyExists) {
+		existing, findErr := o.store.FindByCorrelation(ctx, sagaType, correlationID)
+		if findErr != nil {
+			return State{}, fmt.Errorf("failed to find saga: %w", findErr)
+		}
+		return o.run(ctx, def, existing)
+	}
+	if err != nil {
+		return State{}, fmt.Errorf("failed to create saga: %w", err)
+	}
+
+	return o.run(ctx, def, state)
+}
+
+// Resume continues a persisted saga from its recorded position.
+func (o *Orchestrator) Resume(ctx context.Context, id uuid.UUID) (State, error) {
+	state, err := o.store.Find(ctx, id)
+	if err != nil {
+		return State{}, fmt.Errorf("failed to find saga: %w", err)
+	}
+	def, ok := o.definitions[state.Type]
+	if !ok {
+		return state, fmt.Errorf("%w: %s", ErrUnknownType, state.Type)
+	}
+	return o.run(ctx, def, state)
+}
+
+// RecoverStalled resumes up to limit sagas that have not progressed for
+// staleAfter, typically because the process running them stopped or a step
+// is waiting to be retried. staleAfter should exceed the longest step
+// timeout. It returns the number of sagas that reached COMPLETED or
+// COMPENSATED; the others are left for the next pass.
+func (o *Orchestrator) RecoverStalled(ctx context.Context, staleAfter time.Duration, limit int) (int, error) {
+	stalled, err := o.store.FindStalled(ctx, o.now().Add(-staleAfter), limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find stalled sagas: %w", err)
+	}
+
+	recovered := 0
+	for _, state := range stalled {
+		def, ok := o.definitions[state.Type]
+		if !ok {
+			o.logger.Warn("skipping stalled saga of unknown type", "saga_id", state.ID, "saga_type", state.Type)
+			continue
+		}
+		if _, runErr := o.run(ctx, def, state); runErr != nil {
+			if ctx.Err() != nil {
+				return recovered, ctx.Err()
+			}
+			o.logger.Warn("stalled saga not recovered",
+				"saga_id", state.ID,
+				"saga_type", state.Type,
+				"correlation_id", state.CorrelationID,
+				"error", runErr,
+			)
+			continue
+		}
+		recovered++
+	}
+	return recovered, nil
+}
+
+// Retry resumes a FAILED saga where it stopped, with a fresh attempt budget,
+// once whatever made it fail has been fixed. A saga that failed running a
+// retriable step carries on forward; one that failed compensating carries on
+// compensating. Retrying a saga that is not FAILED resumes it like Resume.
+func (o *Orchestrator) Retry(ctx context.Context, id uuid.UUID) (State, error) {
+	state, err := o.store.Find(ctx, id)
+	if err != nil {
+		return State{}, fmt.Errorf("failed to find saga: %w", err)
+	}
+	def, ok := o.definitions[state.Type]
+	if !ok {
+		return state, fmt.Errorf("%w: %s", ErrUnknownType, state.Type)
+	}
+	if state.Status == StatusFailed {
+		state.Status = failedPhase(def, state)
+		state.Attempts = 0
+		if state.Status == StatusRunning {
+			// A saga going forward has not failed until it runs out of
+			// attempts again.
+			state.FailedStep, state.FailureReason = "", ""
+		}
+		o.logger.Info("retrying failed saga",
+			"saga_id", state.ID,
+			"saga_type", state.Type,
+			"correlation_id", state.CorrelationID,
+			"status", state.Status,
+		)
+	}
+	return o.run(ctx, def, state)
+}
+
+// RetryFailed retries up to limit FAILED sagas, oldest first, and returns
+// the number that reached COMPLETED or COMPENSATED. Sagas that fail again
+// are left FAILED.
+func (o *Orchestrator) RetryFailed(ctx context.Context, limit int) (int, error) {
+	failed, err := o.store.FindFailed(ctx, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find failed sagas: %w", err)
+	}
+
+	retried := 0
+	for _, state := range failed {
+		if _, err := o.Retry(ctx, state.ID); err != nil {
+			if ctx.Err() != nil {
+				return retried, ctx.Err()
+			}
+			o.logger.Warn("failed saga not retried",
+				"saga_id", state.ID,
+				"saga_type", state.Type,
+				"correlation_id", state.CorrelationID,
+				"error", err,
+			)
+			continue
+		}
+		retried++
+	}
+	return retried, nil
+}
+
+// failedPhase returns the status a FAILED saga was in when it ran out of
+// attempts. Only retriable steps are retried going forward, and a saga
+// failing at one records it as its failed step; any other failure happened
+// while compensating.
+func failedPhase(def Definition, state State) Status {
+	if state.StepIndex < len(def.Steps) {
+		step := def.Steps[state.StepIndex]
+		if step.Retriable && step.Name == state.FailedStep {
+			return StatusRunning
+		}
+	}
+	return StatusCompensating
+}
+
+// run advances the saga until it is terminal or cannot progress further.
+func (o *Orchestrator) run(ctx context.Context, def Definition, state State) (State, error) {
+	for !state.Status.Terminal() {
+		var err error
+		if state.Status == StatusCompensating {
+			err = o.compensate(ctx, def, &state)
+		} else {
+			err = o.advance(ctx, def, &state)
+		}
+		if err != nil {
+			return state, err
+		}
+	}
+	if state.Status == StatusFailed {
+		return state, fmt.Errorf("saga %s failed at %s: %s", state.ID, state.FailedStep, state.LastError)
+	}
+	return state, nil
+}
+
+// advance runs the next step.
+func (o *Orchestrator) advance(ctx context.Context, def Definition, state *State) error {
+	if state.StepIndex >= len(def.Steps) {
+		state.Status = StatusCompleted
+		return o.save(ctx, state)
+	}
+	step := def.Steps[state.StepIndex]
+
+	// Saving before the step claims the saga and keeps it from looking
+	// stalled while the step runs.
+	if err := o.save(ctx, state); err != nil {
+		return err
+	}
+
+	err := runStep(ctx, step.Timeout, step.Action, state)
+	if err == nil {
+		state.CompletedSteps = append(state.CompletedSteps, step.Name)
+		state.StepIndex++
+		state.Attempts = 0
+		state.LastError = ""
+		if state.StepIndex == len(def.Steps) {
+			state.Status = StatusCompleted
+		}
+		return o.save(ctx, state)
+	}
+	if ctx.Err() != nil {
+		// Shutting down: the saga is left RUNNING for RecoverStalled.
+		return ctx.Err()
+	}
+
+	if step.Retriable {
+		return o.retryLater(ctx, def, state, step.Name, err)
+	}
+
+	o.logger.Warn("saga step failed, compensating",
+		"saga_id", state.ID,
+		"saga_type", state.Type,
+		"step", step.Name,
+		"error", err,
+	)
+	state.Status = StatusCompensating
+	state.FailedStep = step.Name
+	state.FailureReason = err.Error()
+	state.LastError = err.Error()
+	state.Attempts = 0
+	// A step that timed out may still have taken effect, so it is
+	// compensated along with the steps before it.
+	if errors.Is(err, context.DeadlineExceeded) {
+		state.StepIndex++
+	}
+	return o.save(ctx, state)
+}
+
+// compensate undoes the most recent step that has not yet been compensated,
+// and runs the definition's OnCompensated hook once none are left.
+func (o *Orchestrator) compensate(ctx context.Context, def Definition, state *State) error {
+	if state.StepIndex == 0 {
+		if def.OnCompensated != nil {
+			if err := o.save(ctx, state); err != nil {
+				return err
+			}
+			if err := runStep(ctx, 0, def.OnCompensated, state); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				return o.retryLater(ctx, def, state, "on_compensated", err)
+			}
+		}
+		state.Status = StatusCompensated
+		state.Attempts = 0
+		state.LastError = ""
+		return o.save(ctx, state)
+	}
+
+	step := def.Steps[state.StepIndex-1]
+	if step.Compensate != nil {
+		if err := o.save(ctx, state); err != nil {
+			return err
+		}
+		if err := runStep(ctx, step.Timeout, step.Compensate, state); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return o.retryLater(ctx, def, state, "compensate "+step.Name, err)
+		}
+	}
+	state.StepIndex--
+	state.Attempts = 0
+	state.LastError = ""
+	return o.save(ctx, state)
+}
+
+// retryLater records a failed attempt, leaving the saga for RecoverStalled
+// to retry, or marks it FAILED once its attempts are used up.
+func (o *Orchestrator) retryLater(ctx context.Context, def Definition, state *State, name string, cause error) error {
+	maxAttempts := def.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+
+	state.Attempts++
+	state.LastError = cause.Error()
+	if state.Attempts >= maxAttempts {
+		state.Status = StatusFailed
+		if state.FailedStep == "" {
+			state.FailedStep = name
+			state.FailureReason = cause.Error()
+		}
+		o.logger.Error("saga failed, manual intervention required",
+			"saga_id", state.ID,
+			"saga_type", state.Type,
+			"correlation_id", state.CorrelationID,
+			"step", name,
+			"attempts", state.Attempts,
+			"error", cause,
+		)
+	}
+	if err := o.save(ctx, state); err != nil {
+		return err
+	}
+	return fmt.Errorf("saga %s: %s: %w", state.ID, name, cause)
+}
+
+// save persists the saga as the next version.
+func (o *Orchestrator) save(ctx context.Context, state *State) error {
+	state.Version++
+	state.UpdatedAt = o.now()
+	if err := o.store.Update(ctx, *state); err != nil {
+		return fmt.Errorf("failed to save saga %s: %w", state.ID, err)
+	}
+	return nil
+}
+
+func runStep(ctx context.Context, timeout time.Duration, fn StepFunc, state *State) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return fn(ctx, state)
+}
@@ -0,0 +1,350 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+// recorder collects the order in which steps and compensations ran.
+type recorder struct {
+	calls []string
+}
+
+func (r *recorder) step(name string, err error) StepFunc {
+	return func(_ context.Context, state *State) error {
+		r.calls = append(r.calls, name)
+		if err != nil {
+			return err
+		}
+		state.Data[name] = "done"
+		return nil
+	}
+}
+
+func newTestOrchestrator(def Definition) (*Orchestrator, *MemoryStore) {
+	store := NewMemoryStore()
+	o := NewOrchestrator(store, nil)
+	o.Register(def)
+	return o, store
+}
+
+func TestStart_CompletesAllSteps(t *testing.T) {
+	r := &recorder{}
+	o, store := newTestOrchestrator(Definition{
+		Type: "transfer",
+		Steps: []Step{
+			{Name: "hold", Action: r.step("hold", nil), Compensate: r.step("release", nil)},
+			{Name: "post", Action: r.step("post", nil), Compensate: r.step("reverse", nil)},
+		},
+	})
+
+	state, err := o.Start(context.Background(), "transfer", "order-1", map[string]string{"order": "order-1"})
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if state.Status != StatusCompleted {
+		t.Errorf("status = %s, want %s", state.Status, StatusCompleted)
+	}
+	if !slices.Equal(r.calls, []string{"hold", "post"}) {
+		t.Errorf("calls = %v", r.calls)
+	}
+
+	stored, err := store.Find(context.Background(), state.ID)
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if stored.Status != StatusCompleted || !slices.Equal(stored.CompletedSteps, []string{"hold", "post"}) {
+		t.Errorf("stored = %+v", stored)
+	}
+	if stored.Data["order"] != "order-1" || stored.Data["post"] != "done" {
+		t.Errorf("stored data = %v", stored.Data)
+	}
+}
+
+func TestStart_CompensatesCompletedStepsInReverse(t *testing.T) {
+	r := &recorder{}
+	o, _ := newTestOrchestrator(Definition{
+		Type: "transfer",
+		Steps: []Step{
+			{Name: "hold", Action: r.step("hold", nil), Compensate: r.step("release", nil)},
+			{Name: "post", Action: r.step("post", nil), Compensate: r.step("reverse", nil)},
+			{Name: "settle", Action: r.step("settle", errors.New("rail rejected")), Compensate: r.step("recall", nil)},
+		},
+		OnCompensated: r.step("mark-failed", nil),
+	})
+
+	state, err := o.Start(context.Background(), "transfer", "order-1", nil)
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if state.Status != StatusCompensated {
+		t.Errorf("status = %s, want %s", state.Status, StatusCompensated)
+	}
+	if state.FailedStep != "settle" || state.FailureReason != "rail rejected" {
+		t.Errorf("failed step = %q, reason = %q", state.FailedStep, state.FailureReason)
+	}
+	want := []string{"hold", "post", "settle", "reverse", "release", "mark-failed"}
+	if !slices.Equal(r.calls, want) {
+		t.Errorf("calls = %v, want %v", r.calls, want)
+	}
+}
+
+func TestStart_CompensatesTimedOutStep(t *testing.T) {
+	r := &recorder{}
+	slow := func(ctx context.Context, _ *State) error {
+		r.calls = append(r.calls, "post")
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	o, _ := newTestOrchestrator(Definition{
+		Type: "transfer",
+		Steps: []Step{
+			{Name: "hold", Action: r.step("hold", nil), Compensate: r.step("release", nil)},
+			{Name: "post", Action: slow, Compensate: r.step("reverse", nil), Timeout: 10 * time.Millisecond},
+		},
+	})
+
+	state, err := o.Start(context.Background(), "transfer", "order-1", nil)
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if state.Status != StatusCompensated {
+		t.Errorf("status = %s, want %s", state.Status, StatusCompensated)
+	}
+	want := []string{"hold", "post", "reverse", "release"}
+	if !slices.Equal(r.calls, want) {
+		t.Errorf("calls = %v, want %v", r.calls, want)
+	}
+}
+
+func TestRecoverStalled_RetriesFailedCompensation(t *testing.T) {
+	r := &recorder{}
+	releaseErr := errors.New("ledger unavailable")
+	release := func(ctx context.Context, state *State) error {
+		return r.step("release", releaseErr)(ctx, state)
+	}
+	o, _ := newTestOrchestrator(Definition{
+		Type: "transfer",
+		Steps: []Step{
+			{Name: "hold", Action: r.step("hold", nil), Compensate: release},
+			{Name: "post", Action: r.step("post", errors.New("declined"))},
+		},
+	})
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	o.now = func() time.Time { return now }
+
+	state, err := o.Start(context.Background(), "transfer", "order-1", nil)
+	if err == nil {
+		t.Fatal("Start() expected error for failed compensation")
+	}
+	if state.Status != StatusCompensating || state.Attempts != 1 {
+		t.Fatalf("status = %s, attempts = %d", state.Status, state.Attempts)
+	}
+
+	releaseErr = nil
+	now = now.Add(time.Minute)
+	recovered, err := o.RecoverStalled(context.Background(), 30*time.Second, 10)
+	if err != nil {
+		t.Fatalf("RecoverStalled() error = %v", err)
+	}
+	if recovered != 1 {
+		t.Errorf("recovered = %d, want 1", recovered)
+	}
+
+	resumed, err := o.Resume(context.Background(), state.ID)
+	if err != nil {
+		t.Fatalf("Resume() error = %v", err)
+	}
+	if resumed.Status != StatusCompensated {
+		t.Errorf("status = %s, want %s", resumed.Status, StatusCompensated)
+	}
+	want := []string{"hold", "post", "release", "release"}
+	if !slices.Equal(r.calls, want) {
+		t.Errorf("calls = %v, want %v", r.calls, want)
+	}
+}
+
+func TestStart_FailsAfterMaxCompensationAttempts(t *testing.T) {
+	r := &recorder{}
+	o, _ := newTestOrchestrator(Definition{
+		Type: "transfer",
+		Steps: []Step{
+			{Name: "hold", Action: r.step("hold", nil), Compensate: r.step("release", errors.New("ledger unavailable"))},
+			{Name: "post", Action: r.step("post", errors.New("declined"))},
+		},
+		MaxAttempts: 2,
+	})
+
+	state, err := o.Start(context.Background(), "transfer", "order-1", nil)
+	if err == nil || state.Status != StatusCompensating {
+		t.Fatalf("first attempt: status = %s, err = %v", state.Status, err)
+	}
+	state, err = o.Resume(context.Background(), state.ID)
+	if err == nil {
+		t.Fatal("Resume() expected error")
+	}
+	if state.Status != StatusFailed {
+		t.Errorf("status = %s, want %s", state.Status, StatusFailed)
+	}
+	if state.FailedStep != "post" {
+		t.Errorf("failed step = %q, want post", state.FailedStep)
+	}
+}
+
+func TestStart_RetriesRetriableStepInsteadOfCompensating(t *testing.T) {
+	r := &recorder{}
+	postErr := errors.New("ledger unavailable")
+	post := func(ctx context.Context, state *State) error {
+		return r.step("post", postErr)(ctx, state)
+	}
+	o, _ := newTestOrchestrator(Definition{
+		Type: "transfer",
+		Steps: []Step{
+			{Name: "submit", Action: r.step("submit", nil)},
+			{Name: "post", Action: post, Retriable: true},
+		},
+	})
+
+	state, err := o.Start(context.Background(), "transfer", "order-1", nil)
+	if err == nil {
+		t.Fatal("Start() expected error for failed retriable step")
+	}
+	if state.Status != StatusRunning || state.StepIndex != 1 {
+		t.Fatalf("status = %s, step index = %d", state.Status, state.StepIndex)
+	}
+
+	postErr = nil
+	state, err = o.Resume(context.Background(), state.ID)
+	if err != nil {
+		t.Fatalf("Resume() error = %v", err)
+	}
+	if state.Status != StatusCompleted {
+		t.Errorf("status = %s, want %s", state.Status, StatusCompleted)
+	}
+	want := []string{"submit", "post", "post"}
+	if !slices.Equal(r.calls, want) {
+		t.Errorf("calls = %v, want %v", r.calls, want)
+	}
+}
+
+func TestRetry_ResumesFailedRetriableStep(t *testing.T) {
+	r := &recorder{}
+	postErr := errors.New("ledger unavailable")
+	post := func(ctx context.Context, state *State) error {
+		return r.step("post", postErr)(ctx, state)
+	}
+	o, _ := newTestOrchestrator(Definition{
+		Type: "transfer",
+		Steps: []Step{
+			{Name: "submit", Action: r.step("submit", nil), Compensate: r.step("cancel", nil)},
+			{Name: "post", Action: post, Retriable: true},
+		},
+		MaxAttempts: 1,
+	})
+
+	state, err := o.Start(context.Background(), "transfer", "order-1", nil)
+	if err == nil || state.Status != StatusFailed {
+		t.Fatalf("Start(): status = %s, err = %v", state.Status, err)
+	}
+
+	postErr = nil
+	state, err = o.Retry(context.Background(), state.ID)
+	if err != nil {
+		t.Fatalf("Retry() error = %v", err)
+	}
+	if state.Status != StatusCompleted {
+		t.Errorf("status = %s, want %s", state.Status, StatusCompleted)
+	}
+	want := []string{"submit", "post", "post"}
+	if !slices.Equal(r.calls, want) {
+		t.Errorf("calls = %v, want %v", r.calls, want)
+	}
+}
+
+func TestRetryFailed_ResumesFailedCompensation(t *testing.T) {
+	r := &recorder{}
+	releaseErr := errors.New("ledger unavailable")
+	release := func(ctx context.Context, state *State) error {
+		return r.step("release", releaseErr)(ctx, state)
+	}
+	o, store := newTestOrchestrator(Definition{
+		Type: "transfer",
+		Steps: []Step{
+			{Name: "hold", Action: r.step("hold", nil), Compensate: release},
+			{Name: "post", Action: r.step("post", errors.New("declined"))},
+		},
+		MaxAttempts: 1,
+	})
+
+	state, err := o.Start(context.Background(), "transfer", "order-1", nil)
+	if err == nil || state.Status != StatusFailed {
+		t.Fatalf("Start(): status = %s, err = %v", state.Status, err)
+	}
+
+	releaseErr = nil
+	retried, err := o.RetryFailed(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("RetryFailed() error = %v", err)
+	}
+	if retried != 1 {
+		t.Errorf("retried = %d, want 1", retried)
+	}
+	state, _ = store.Find(context.Background(), state.ID)
+	if state.Status != StatusCompensated {
+		t.Errorf("status = %s, want %s", state.Status, StatusCompensated)
+	}
+	if state.FailedStep != "post" {
+		t.Errorf("failed step = %q, want post", state.FailedStep)
+	}
+	want := []string{"hold", "post", "release", "release"}
+	if !slices.Equal(r.calls, want) {
+		t.Errorf("calls = %v, want %v", r.calls, want)
+	}
+}
+
+func TestStart_ResumesExistingSagaForCorrelationID(t *testing.T) {
+	r := &recorder{}
+	o, _ := newTestOrchestrator(Definition{
+		Type:  "transfer",
+		Steps: []Step{{Name: "hold", Action: r.step("hold", nil)}},
+	})
+
+	first, err := o.Start(context.Background(), "transfer", "order-1", nil)
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	second, err := o.Start(context.Background(), "transfer", "order-1", nil)
+	if err != nil {
+		t.Fatalf("second Start() error = %v", err)
+	}
+	if second.ID != first.ID {
+		t.Errorf("second saga ID = %s, want %s", second.ID, first.ID)
+	}
+	if len(r.calls) != 1 {
+		t.Errorf("calls = %v, want the step to run once", r.calls)
+	}
+}
+
+func TestStart_UnknownType(t *testing.T) {
+	o := NewOrchestrator(NewMemoryStore(), nil)
+	if _, err := o.Start(context.Background(), "missing", "order-1", nil); !errors.Is(err, ErrUnknownType) {
+		t.Errorf("Start() error = %v, want ErrUnknownType", err)
+	}
+}
+
+func TestMemoryStore_UpdateRejectsStaleVersion(t *testing.T) {
+	store := NewMemoryStore()
+	o := NewOrchestrator(store, nil)
+	o.Register(Definition{Type: "transfer"})
+
+	state, err := o.Start(context.Background(), "transfer", "order-1", nil)
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if err := store.Update(context.Background(), state); !errors.Is(err, ErrVersionConflict) {
+		t.Errorf("Update() error = %v, want ErrVersionConflict", err)
+	}
+}
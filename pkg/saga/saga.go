@@ -0,0 +1,136 @@
+// Package saga provides persisted saga orchestration for workflows that span
+// several services, such as moving money between a customer account, the
+// ledger and a payment rail. A saga runs its steps in order and records its
+// progress after every step, so a crashed or timed-out saga can be resumed
+// from where it stopped. When a step fails, the steps that already completed
+// are compensated in reverse order, giving each failure a defined recovery
+// instead of relying on best-effort event choreography.
+//
+// Steps may run more than once: a process can stop after a step took effect
+// but before its completion was recorded, and stalled sagas are resumed by
+// RecoverStalled. Actions and compensations must therefore be idempotent.
+package saga
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrNotFound is returned by a Store when no saga matches.
+	ErrNotFound = errors.New("saga not found")
+	// ErrAlreadyExists is returned by Store.Create when a saga of the same type
+	// already exists for the correlation ID.
+	ErrAlreadyExists = errors.New("saga already exists")
+	// ErrVersionConflict is returned by Store.Update when the saga was changed
+	// since it was read, typically because another instance resumed it.
+	ErrVersionConflict = errors.New("saga version conflict")
+	// ErrUnknownType is returned when no definition is registered for a saga type.
+	ErrUnknownType = errors.New("unknown saga type")
+)
+
+// Status is the lifecycle status of a saga.
+type Status string
+
+const (
+	// StatusRunning means the saga is executing its steps.
+	StatusRunning Status = "RUNNING"
+	// StatusCompensating means a step failed and completed steps are being undone.
+	StatusCompensating Status = "COMPENSATING"
+	// StatusCompleted means every step succeeded.
+	StatusCompleted Status = "COMPLETED"
+	// StatusCompensated means a step failed and every completed step was undone.
+	StatusCompensated Status = "COMPENSATED"
+	// StatusFailed means the saga could not finish or be compensated within its
+	// attempt budget and needs manual intervention.
+	StatusFailed Status = "FAILED"
+)
+
+// Terminal reports whether the saga will make no further progress on its own.
+func (s Status) Terminal() bool {
+	return s == StatusCompleted || s == StatusCompensated || s == StatusFailed
+}
+
+// StepFunc performs or undoes a step. It may record values in state.Data for
+// later steps and compensations; they are persisted with the saga.
+type StepFunc func(ctx context.Context, state *State) error
+
+// Step is a single unit of work in a saga.
+type Step struct {
+	// Action performs the step.
+	Action StepFunc
+	// Compensate undoes the step. It is nil when there is nothing to undo.
+	Compensate StepFunc
+	// Name identifies the step in the saga's persisted state.
+	Name string
+	// Timeout bounds each run of Action and Compensate. Zero means no limit.
+	Timeout time.Duration
+	// Retriable marks a step that follows the saga's pivot: once the pivot
+	// has succeeded the saga can only move forward, so a failing retriable
+	// step is retried rather than triggering compensation.
+	Retriable bool
+}
+
+// Definition describes a type of saga.
+type Definition struct {
+	// OnCompensated runs once every completed step has been compensated, for
+	// example to mark the originating order as failed. It may be nil.
+	OnCompensated StepFunc
+	// Type names the saga and is used to resume persisted sagas.
+	Type string
+	// Steps run in order.
+	Steps []Step
+	// MaxAttempts is how many times a retriable step or a compensation is
+	// tried before the saga is marked FAILED. Zero means DefaultMaxAttempts.
+	MaxAttempts int
+}
+
+// DefaultMaxAttempts is used when a Definition does not set MaxAttempts.
+const DefaultMaxAttempts = 5
+
+// State is the persisted state of a saga instance.
+type State struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// Data carries values between steps, such as the IDs of posted entries.
+	Data map[string]string
+	// Type is the Definition type.
+	Type string
+	// CorrelationID ties the saga to the business object it acts on. A saga
+	// type has at most one saga per correlation ID.
+	CorrelationID  string
+	Status         Status
+	FailedStep     string
+	FailureReason  string
+	LastError      string
+	CompletedSteps []string
+	// StepIndex is the index of the next step to run while RUNNING, and the
+	// number of steps still to compensate while COMPENSATING.
+	StepIndex int
+	// Attempts counts failed runs of the current retriable step or compensation.
+	Attempts int
+	Version  int
+	ID       uuid.UUID
+}
+
+// Store persists saga state.
+type Store interface {
+	// Create inserts a new saga. It returns ErrAlreadyExists when a saga of
+	// the same type already exists for the correlation ID.
+	Create(ctx context.Context, state State) error
+	// Update saves the saga if its stored version is state.Version-1, and
+	// returns ErrVersionConflict otherwise.
+	Update(ctx context.Context, state State) error
+	// Find returns the saga with the given ID.
+	Find(ctx context.Context, id uuid.UUID) (State, error)
+	// FindByCorrelation returns the saga of the given type for the correlation ID.
+	FindByCorrelation(ctx context.Context, sagaType, correlationID string) (State, error)
+	// FindStalled returns up to limit non-terminal sagas last updated before
+	// the given time, oldest first.
+	FindStalled(ctx context.Context, before time.Time, limit int) ([]State, error)
+	// FindFailed returns up to limit FAILED sagas, oldest first.
+	FindFailed(ctx context.Context, limit int) ([]State, error)
+}
@@ -0,0 +1,67 @@
+package testutil
+
+import (
+	"context"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// EnvOptions configures the infrastructure started by NewEnv.
+type EnvOptions struct {
+	// MigrationsDir is the service's migrations directory, applied to the
+	// database before NewEnv returns. Empty leaves the database empty.
+	MigrationsDir string
+	// Kafka also starts a Kafka broker.
+	Kafka bool
+}
+
+// Env is the real infrastructure an integration suite runs against: a
+// migrated PostgreSQL database and, when asked for, a Kafka broker. Its
+// containers are terminated when the test ends.
+type Env struct {
+	Postgres *PostgresContainer
+	Kafka    *KafkaContainer
+}
+
+// NewEnv starts the containers described by opts for the test.
+func NewEnv(t *testing.T, opts EnvOptions) *Env {
+	t.Helper()
+	ctx := context.Background()
+
+	env := &Env{Postgres: NewPostgresContainer(ctx, t)}
+	t.Cleanup(func() { env.Postgres.Cleanup(t) })
+	if opts.MigrationsDir != "" {
+		env.Postgres.RunMigrations(t, opts.MigrationsDir)
+	}
+
+	if opts.Kafka {
+		env.Kafka = NewKafkaContainer(ctx, t)
+		t.Cleanup(func() { env.Kafka.Cleanup(t) })
+	}
+	return env
+}
+
+// Pool returns the pool connected to the test database.
+func (e *Env) Pool() *pgxpool.Pool {
+	return e.Postgres.Pool
+}
+
+// Brokers returns the Kafka broker addresses; it is nil unless the Env was
+// started with Kafka.
+func (e *Env) Brokers() []string {
+	if e.Kafka == nil {
+		return nil
+	}
+	return e.Kafka.Brokers
+}
+
+// MigrationsDir returns the migrations directory of the service whose
+// test/integration package calls it, following the services' layout of
+// internal/infrastructure/postgres/migrations.
+func MigrationsDir() string {
+	_, filename, _, _ := runtime.Caller(1)
+	return filepath.Join(filepath.Dir(filename), "..", "..", "internal", "infrastructure", "postgres", "migrations")
+}
@@ -2,9 +2,11 @@ package testutil
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
+	kafkago "github.com/segmentio/kafka-go"
 	"github.com/testcontainers/testcontainers-go/modules/kafka"
 )
 
@@ -51,3 +53,34 @@ func (kc *KafkaContainer) Cleanup(t *testing.T) {
 		}
 	}
 }
+
+// ReadMessages reads the first n messages published to topic, failing the
+// test if they do not all arrive within timeout. The topic is read from its
+// first offset, so messages published before the call are included.
+func (kc *KafkaContainer) ReadMessages(t *testing.T, topic string, n int, timeout time.Duration) []kafkago.Message {
+	t.Helper()
+
+	reader := kafkago.NewReader(kafkago.ReaderConfig{
+		Brokers:     kc.Brokers,
+		Topic:       topic,
+		StartOffset: kafkago.FirstOffset,
+		MaxWait:     250 * time.Millisecond,
+	})
+	defer reader.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	messages := make([]kafkago.Message, 0, n)
+	for len(messages) < n {
+		msg, err := reader.ReadMessage(ctx)
+		if errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("read %d of %d messages from %s before timing out", len(messages), n, topic)
+		}
+		if err != nil {
+			t.Fatalf("failed to read from %s: %v", topic, err)
+		}
+		messages = append(messages, msg)
+	}
+	return messages
+}
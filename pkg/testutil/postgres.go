@@ -6,9 +6,11 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
@@ -82,7 +84,8 @@ func (pc *PostgresContainer) Cleanup(t *testing.T) {
 }
 
 // RunMigrations runs migrations from the given directory against the test database.
-// Migration files are expected to be .sql files and are executed in lexicographic order.
+// Migration files are expected to be .sql files and are executed in lexicographic order;
+// .down.sql files are skipped.
 func (pc *PostgresContainer) RunMigrations(t *testing.T, migrationsDir string) {
 	t.Helper()
 
@@ -93,7 +96,7 @@ func (pc *PostgresContainer) RunMigrations(t *testing.T, migrationsDir string) {
 
 	var sqlFiles []string
 	for _, entry := range entries {
-		if !entry.IsDir() && filepath.Ext(entry.Name()) == ".sql" {
+		if !entry.IsDir() && filepath.Ext(entry.Name()) == ".sql" && !strings.HasSuffix(entry.Name(), ".down.sql") {
 			sqlFiles = append(sqlFiles, entry.Name())
 		}
 	}
@@ -116,3 +119,24 @@ func (pc *PostgresContainer) RunMigrations(t *testing.T, migrationsDir string) {
 		fmt.Printf("applied migration: %s\n", file)
 	}
 }
+
+// Truncate empties the given tables, and whatever references them, so tests
+// sharing a database start from a clean state.
+func (pc *PostgresContainer) Truncate(t *testing.T, tables ...string) {
+	t.Helper()
+
+	if len(tables) == 0 {
+		return
+	}
+	quoted := make([]string, len(tables))
+	for i, table := range tables {
+		quoted[i] = pgx.Identifier{table}.Sanitize()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := pc.Pool.Exec(ctx, "TRUNCATE "+strings.Join(quoted, ", ")+" CASCADE"); err != nil {
+		t.Fatalf("failed to truncate %s: %v", strings.Join(tables, ", "), err)
+	}
+}
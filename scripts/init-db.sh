@@ -17,6 +17,21 @@ psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "$POSTGRES_DB" <<-E
     CREATE DATABASE bib_fraud;
     CREATE DATABASE bib_card;
     CREATE DATABASE bib_reporting;
+    CREATE DATABASE bib_notification;
+    CREATE DATABASE bib_customer;
+    CREATE DATABASE bib_tenant;
+    CREATE DATABASE bib_scheduler;
+    CREATE DATABASE bib_statement;
+    CREATE DATABASE bib_document;
+    CREATE DATABASE bib_webhooks;
+    CREATE DATABASE bib_treasury;
+    CREATE DATABASE bib_privacy;
+    CREATE DATABASE bib_limits;
+    CREATE DATABASE bib_openbanking;
+    CREATE DATABASE bib_backoffice;
+    CREATE DATABASE bib_pricing;
+    CREATE DATABASE bib_consent;
+    CREATE DATABASE bib_close;
 
     -- Create per-service users with limited privileges
     CREATE USER bib_ledger_user   WITH PASSWORD 'ledger_dev_password';
@@ -29,6 +44,21 @@ psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "$POSTGRES_DB" <<-E
     CREATE USER bib_fraud_user    WITH PASSWORD 'fraud_dev_password';
     CREATE USER bib_card_user     WITH PASSWORD 'card_dev_password';
     CREATE USER bib_reporting_user WITH PASSWORD 'reporting_dev_password';
+    CREATE USER bib_notification_user WITH PASSWORD 'notification_dev_password';
+    CREATE USER bib_customer_user WITH PASSWORD 'customer_dev_password';
+    CREATE USER bib_tenant_user   WITH PASSWORD 'tenant_dev_password';
+    CREATE USER bib_scheduler_user WITH PASSWORD 'scheduler_dev_password';
+    CREATE USER bib_statement_user WITH PASSWORD 'statement_dev_password';
+    CREATE USER bib_document_user WITH PASSWORD 'document_dev_password';
+    CREATE USER bib_webhooks_user WITH PASSWORD 'webhooks_dev_password';
+    CREATE USER bib_treasury_user WITH PASSWORD 'treasury_dev_password';
+    CREATE USER bib_privacy_user WITH PASSWORD 'privacy_dev_password';
+    CREATE USER bib_limits_user WITH PASSWORD 'limits_dev_password';
+    CREATE USER bib_openbanking_user WITH PASSWORD 'openbanking_dev_password';
+    CREATE USER bib_backoffice_user WITH PASSWORD 'backoffice_dev_password';
+    CREATE USER bib_pricing_user WITH PASSWORD 'pricing_dev_password';
+    CREATE USER bib_consent_user WITH PASSWORD 'consent_dev_password';
+    CREATE USER bib_close_user WITH PASSWORD 'close_dev_password';
 EOSQL
 
 # Grant per-service privileges on each database.
@@ -54,3 +84,18 @@ grant_service_access bib_lending  bib_lending_user
 grant_service_access bib_fraud    bib_fraud_user
 grant_service_access bib_card     bib_card_user
 grant_service_access bib_reporting bib_reporting_user
+grant_service_access bib_notification bib_notification_user
+grant_service_access bib_customer bib_customer_user
+grant_service_access bib_tenant   bib_tenant_user
+grant_service_access bib_scheduler bib_scheduler_user
+grant_service_access bib_statement bib_statement_user
+grant_service_access bib_document bib_document_user
+grant_service_access bib_webhooks bib_webhooks_user
+grant_service_access bib_treasury bib_treasury_user
+grant_service_access bib_privacy bib_privacy_user
+grant_service_access bib_limits bib_limits_user
+grant_service_access bib_openbanking bib_openbanking_user
+grant_service_access bib_backoffice bib_backoffice_user
+grant_service_access bib_pricing bib_pricing_user
+grant_service_access bib_consent bib_consent_user
+grant_service_access bib_close bib_close_user
@@ -13,6 +13,21 @@ SERVICES=(
     "fraud-service"
     "card-service"
     "reporting-service"
+    "notification-service"
+    "customer-service"
+    "tenant-service"
+    "scheduler-service"
+    "statement-service"
+    "document-service"
+    "webhooks-service"
+    "treasury-service"
+    "privacy-service"
+    "limits-service"
+    "openbanking-service"
+    "backoffice-service"
+    "pricing-service"
+    "consent-service"
+    "close-service"
 )
 
 for SERVICE in "${SERVICES[@]}"; do
@@ -20,6 +35,7 @@ for SERVICE in "${SERVICES[@]}"; do
     CMD_NAME=$(basename "$CMD_DIR")
     
     # Default ports if not found
+    EXTRA_PORTS=""
     case $SERVICE in
         "account-service") HTTP_PORT="8082"; GRPC_PORT="9082" ;;
         "fx-service") HTTP_PORT="8083"; GRPC_PORT="9083" ;;
@@ -30,6 +46,21 @@ for SERVICE in "${SERVICES[@]}"; do
         "fraud-service") HTTP_PORT="8088"; GRPC_PORT="9088" ;;
         "card-service") HTTP_PORT="8089"; GRPC_PORT="9089" ;;
         "reporting-service") HTTP_PORT="8090"; GRPC_PORT="9090" ;;
+        "notification-service") HTTP_PORT="8091"; GRPC_PORT="9091" ;;
+        "customer-service") HTTP_PORT="8093"; GRPC_PORT="9093" ;;
+        "tenant-service") HTTP_PORT="8094"; GRPC_PORT="9094" ;;
+        "scheduler-service") HTTP_PORT="8095"; GRPC_PORT="9095" ;;
+        "statement-service") HTTP_PORT="8096"; GRPC_PORT="9096" ;;
+        "document-service") HTTP_PORT="8097"; GRPC_PORT="9097" ;;
+        "webhooks-service") HTTP_PORT="8098"; GRPC_PORT="9098" ;;
+        "treasury-service") HTTP_PORT="8099"; GRPC_PORT="9099" ;;
+        "privacy-service") HTTP_PORT="8100"; GRPC_PORT="9100" ;;
+        "limits-service") HTTP_PORT="8101"; GRPC_PORT="9101" ;;
+        "openbanking-service") HTTP_PORT="8102"; GRPC_PORT="9102"; EXTRA_PORTS=" 8443" ;;
+        "backoffice-service") HTTP_PORT="8103"; GRPC_PORT="9103" ;;
+        "pricing-service") HTTP_PORT="8104"; GRPC_PORT="9104" ;;
+        "consent-service") HTTP_PORT="8105"; GRPC_PORT="9105" ;;
+        "close-service") HTTP_PORT="8106"; GRPC_PORT="9106" ;;
     esac
 
     # Check if service has migrations
@@ -52,8 +83,9 @@ RUN apk add --no-cache git ca-certificates
 
 WORKDIR /build
 
-# Copy shared packages first for better caching
+# Copy shared packages and generated API code first for better caching
 COPY pkg/ pkg/
+COPY api/gen/go/ api/gen/go/
 
 # Copy service
 COPY services/${SERVICE}/ services/${SERVICE}/
@@ -78,7 +110,7 @@ WORKDIR /app
 COPY --from=builder /bin/${CMD_NAME} /app/${CMD_NAME}
 ${MIGRATIONS}
 
-EXPOSE ${HTTP_PORT} ${GRPC_PORT}
+EXPOSE ${HTTP_PORT} ${GRPC_PORT}${EXTRA_PORTS}
 
 ENTRYPOINT ["/app/${CMD_NAME}"]
 EOF
@@ -10,14 +10,20 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/crypto"
 	pkgkafka "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
+	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
 	pgpkg "github.com/bibbank/bib/pkg/postgres"
 	"github.com/bibbank/bib/services/account-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/account-service/internal/domain/port"
+	"github.com/bibbank/bib/services/account-service/internal/infrastructure/client"
 	"github.com/bibbank/bib/services/account-service/internal/infrastructure/config"
-	infraKafka "github.com/bibbank/bib/services/account-service/internal/infrastructure/kafka"
 	infraPostgres "github.com/bibbank/bib/services/account-service/internal/infrastructure/postgres"
 	grpcPresentation "github.com/bibbank/bib/services/account-service/internal/presentation/grpc"
 	"github.com/bibbank/bib/services/account-service/internal/presentation/rest"
@@ -29,6 +35,7 @@ func main() {
 		Level: slog.LevelInfo,
 	}))
 	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
 
 	logger.Info("starting account service")
 
@@ -39,60 +46,90 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	poolConfig, err := pgxpool.ParseConfig(cfg.Database.DSN())
-	if err != nil {
-		logger.Error("failed to parse database config", "error", err)
-		os.Exit(1)
+	dbCfg := pgpkg.Config{
+		Host:     cfg.Database.Host,
+		Port:     cfg.Database.Port,
+		User:     cfg.Database.User,
+		Password: cfg.Database.Password,
+		Database: cfg.Database.Database,
+		SSLMode:  cfg.Database.SSLMode,
 	}
-
-	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	db, err := pgpkg.NewCluster(ctx, pgpkg.ClusterConfig{
+		Primary:       dbCfg,
+		Replicas:      cfg.Database.ReplicaHosts,
+		MaxReplicaLag: cfg.Database.MaxReplicaLag,
+	}, logger)
 	if err != nil {
 		logger.Error("failed to connect to database", "error", err)
 		os.Exit(1)
 	}
-	defer pool.Close()
-
-	// Verify database connection.
-	if pingErr := pool.Ping(ctx); pingErr != nil {
-		logger.Error("failed to ping database", "error", pingErr)
-		os.Exit(1)
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(db.Close))
+	pool := db.WriterPool()
+	logger.Info("connected to database", "database", cfg.Database.Database, "replicas", len(cfg.Database.ReplicaHosts))
+	if len(cfg.Database.ReplicaHosts) > 0 {
+		lc.Go(lifecycle.PhaseWorkers, "database replica health checks", func(ctx context.Context) error {
+			db.RunHealthChecks(ctx, cfg.Database.ReplicaCheckInterval)
+			return nil
+		})
 	}
-	logger.Info("connected to database", "database", cfg.Database.Database)
 
 	// Run database migrations.
-	migDSN := pgpkg.Config{
-		Host:     cfg.Database.Host,
-		Port:     cfg.Database.Port,
-		User:     cfg.Database.User,
-		Password: cfg.Database.Password,
-		Database: cfg.Database.Database,
-		SSLMode:  cfg.Database.SSLMode,
-	}.DSN()
+	migDSN := dbCfg.DSN()
 	if migErr := pgpkg.RunMigrations(migDSN, "file://internal/infrastructure/postgres/migrations"); migErr != nil {
 		logger.Warn("migration warning", "error", migErr)
 	}
 
+	// Holder emails are encrypted under per-tenant data keys when a KMS is
+	// configured.
+	kms, err := crypto.NewKMS(cfg.PII)
+	if err != nil {
+		logger.Error("invalid PII encryption config", "error", err)
+		os.Exit(1)
+	}
+	var pii *crypto.Cipher
+	if kms != nil {
+		pii = crypto.NewCipher(kms, crypto.NewPostgresKeyStore(pool))
+	} else {
+		logger.Warn("no KMS configured, storing personal data unencrypted")
+	}
+
 	// Initialize infrastructure adapters.
-	accountRepo := infraPostgres.NewAccountRepository(pool)
+	accountRepo := infraPostgres.NewAccountRepository(pool, db, pii)
 	kafkaProducer := pkgkafka.NewProducer(pkgkafka.Config{
 		Brokers: cfg.Kafka.Brokers,
 	})
-	defer kafkaProducer.Close()
-	eventPublisher := infraKafka.NewPublisher(kafkaProducer, logger)
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(kafkaProducer.Close))
 
-	// Initialize use cases.
-	// LedgerClient is nil for now; will be integrated when ledger service is available.
-	openAccountUC := usecase.NewOpenAccountUseCase(accountRepo, eventPublisher, nil, logger)
-	getAccountUC := usecase.NewGetAccountUseCase(accountRepo, logger)
-	freezeAccountUC := usecase.NewFreezeAccountUseCase(accountRepo, eventPublisher, logger)
-	closeAccountUC := usecase.NewCloseAccountUseCase(accountRepo, eventPublisher, logger)
-	listAccountsUC := usecase.NewListAccountsUseCase(accountRepo, logger)
+	// Outbox relay: events are written to the outbox and published from it,
+	// so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     outbox.StaticRoute("account-events"),
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: cfg.ServiceName,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, kafkaProducer, relayCfg, logger)
+	if err != nil {
+		logger.Error("failed to create outbox relay", "error", err)
+		os.Exit(1)
+	}
+	eventPublisher := outbox.NewPublisher(outboxStore)
 
 	// JWT service (validation-only: public key preferred, secret as fallback).
 	jwtCfg := auth.JWTConfig{
 		Issuer: "bib-gateway",
 	}
 	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
 	case os.Getenv("JWT_PUBLIC_KEY") != "":
 		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
 	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
@@ -115,6 +152,115 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Ledger client: ledger accounts are opened with tenant tokens signed
+	// like the gateway's, since onboarding runs from events and the saga
+	// recovery loop, where there is no caller to forward.
+	var signer *auth.JWTService
+	switch {
+	case cfg.Onboarding.SigningKeyFile != "":
+		keyData, loadErr := auth.LoadKeyFromFile(cfg.Onboarding.SigningKeyFile)
+		if loadErr != nil {
+			logger.Error("failed to load onboarding signing key file", "error", loadErr)
+			os.Exit(1)
+		}
+		signer, err = auth.NewJWTService(auth.JWTConfig{
+			PrivateKeyPEM: string(keyData),
+			Issuer:        "bib-gateway",
+			Expiration:    15 * time.Minute,
+		})
+		if err != nil {
+			logger.Error("failed to initialize onboarding token signer", "error", err)
+			os.Exit(1)
+		}
+	case jwtCfg.Secret != "":
+		signer, err = auth.NewJWTService(auth.JWTConfig{
+			Secret:     jwtCfg.Secret,
+			Issuer:     "bib-gateway",
+			Expiration: 15 * time.Minute,
+		})
+		if err != nil {
+			logger.Error("failed to initialize onboarding token signer", "error", err)
+			os.Exit(1)
+		}
+	default:
+		logger.Warn("ONBOARDING_SIGNING_KEY_FILE not set, ledger calls will be unauthenticated")
+	}
+	var ledgerClient port.LedgerClient
+	if cfg.Onboarding.LedgerGRPCAddr != "" {
+		ledgerConn, dialErr := grpc.NewClient(cfg.Onboarding.LedgerGRPCAddr,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if dialErr != nil {
+			logger.Error("failed to create ledger service client", "addr", cfg.Onboarding.LedgerGRPCAddr, "error", dialErr)
+			os.Exit(1)
+		}
+		lc.OnStop(lifecycle.PhaseResources, "ledger client", lifecycle.Close(ledgerConn.Close))
+		ledgerClient = client.NewLedgerGRPCClient(ledgerConn, signer, client.Config{
+			MaxRetries:   cfg.Onboarding.MaxRetries,
+			RetryBackoff: cfg.Onboarding.RetryBackoff,
+			Timeout:      cfg.Onboarding.StepTimeout,
+		})
+	} else {
+		logger.Warn("LEDGER_SERVICE_ADDR not set, ledger accounts will not be opened")
+	}
+
+	// Initialize use cases.
+	openAccountUC := usecase.NewOpenAccountUseCase(accountRepo, eventPublisher, ledgerClient, logger)
+	getAccountUC := usecase.NewGetAccountUseCase(accountRepo, logger)
+	freezeAccountUC := usecase.NewFreezeAccountUseCase(accountRepo, eventPublisher, logger)
+	closeAccountUC := usecase.NewCloseAccountUseCase(accountRepo, eventPublisher, logger)
+	listAccountsUC := usecase.NewListAccountsUseCase(accountRepo, logger)
+	handleIdentityEventUC := usecase.NewHandleIdentityEventUseCase(accountRepo, eventPublisher, logger)
+
+	// Onboarding saga: open an account, and its ledger account, for each
+	// customer whose first identity verification is approved.
+	var onboardCustomerUC *usecase.OnboardCustomer
+	if cfg.Onboarding.Enabled {
+		onboardCustomerUC = usecase.NewOnboardCustomer(accountRepo, eventPublisher, ledgerClient,
+			infraPostgres.NewSagaStore(pool),
+			usecase.OnboardingConfig{
+				AccountType: cfg.Onboarding.AccountType,
+				Currency:    cfg.Onboarding.Currency,
+				StepTimeout: cfg.Onboarding.StepTimeout,
+				MaxAttempts: cfg.Onboarding.MaxAttempts,
+			}, logger)
+		// Resume onboarding sagas left unfinished by a crash or a failed retry.
+		lc.Go(lifecycle.PhaseWorkers, "onboarding saga recovery", func(ctx context.Context) error {
+			ticker := time.NewTicker(cfg.Onboarding.RecoveryInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+					recovered, recoverErr := onboardCustomerUC.RecoverStalled(ctx, cfg.Onboarding.StaleAfter, 100)
+					if recoverErr != nil {
+						logger.Error("onboarding saga recovery failed", "error", recoverErr)
+					} else if recovered > 0 {
+						logger.Info("onboarding sagas recovered", "count", recovered)
+					}
+				}
+			}
+		})
+	}
+
+	// Consume identity events to onboard newly verified customers and to
+	// restrict accounts whose KYC has lapsed.
+	identityConsumer := pkgkafka.NewConsumer(pkgkafka.Config{
+		Brokers:       cfg.Kafka.Brokers,
+		ConsumerGroup: cfg.Kafka.ConsumerGroup,
+	}, usecase.IdentityVerificationsTopic, func(ctx context.Context, msg pkgkafka.Message) error {
+		eventType := msg.Headers["event_type"]
+		if err := handleIdentityEventUC.Execute(ctx, eventType, msg.Value); err != nil {
+			return err
+		}
+		if onboardCustomerUC != nil {
+			return onboardCustomerUC.Execute(ctx, eventType, msg.Value)
+		}
+		return nil
+	}, logger)
+	lc.OnStop(lifecycle.PhaseResources, "identity event consumer", lifecycle.Close(identityConsumer.Close))
+
 	// Initialize gRPC handler and server.
 	handler := grpcPresentation.NewAccountHandler(
 		openAccountUC,
@@ -124,12 +270,20 @@ func main() {
 		listAccountsUC,
 
 		logger)
-	grpcServer := grpcPresentation.NewServer(handler, cfg.GRPCPort, logger, jwtSvc)
+	grpcServer, err := grpcPresentation.NewServer(handler, cfg.GRPCPort, logger, jwtSvc)
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
 
 	// Initialize HTTP health server.
 	healthHandler := rest.NewHealthHandler(cfg.ServiceName, logger)
 	httpMux := http.NewServeMux()
 	healthHandler.RegisterRoutes(httpMux)
+	if metricsHandler != nil {
+		httpMux.Handle("/metrics", metricsHandler)
+	}
 
 	httpServer := &http.Server{
 		Addr:              fmt.Sprintf(":%d", cfg.HTTPPort),
@@ -137,43 +291,22 @@ func main() {
 		ReadHeaderTimeout: 10 * time.Second,
 	}
 
-	// Start servers in goroutines.
-	errCh := make(chan error, 2)
-
-	go func() {
-		if err := grpcServer.Start(); err != nil {
-			errCh <- fmt.Errorf("gRPC server error: %w", err)
-		}
-	}()
-
-	go func() {
-		logger.Info("HTTP health server starting", "port", cfg.HTTPPort)
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			errCh <- fmt.Errorf("HTTP server error: %w", err)
-		}
-	}()
-
-	// Wait for shutdown signal.
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
-
-	select {
-	case sig := <-sigCh:
-		logger.Info("received shutdown signal", "signal", sig)
-	case err := <-errCh:
-		logger.Error("server error", "error", err)
-	}
-
-	// Graceful shutdown.
-	logger.Info("shutting down servers")
-
-	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 15*time.Second)
-	defer shutdownCancel()
-
-	grpcServer.Stop()
+	// Start servers, the consumer and the relay.
+	lc.Go(lifecycle.PhaseConsumers, "identity event consumer", identityConsumer.Start)
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", func(context.Context) error {
+		return grpcServer.Start()
+	})
+	lc.OnStop(lifecycle.PhaseIngress, "gRPC server", lifecycle.Func(grpcServer.Stop))
+	lc.ServeHTTP("HTTP health server", httpServer)
 
-	if err := httpServer.Shutdown(shutdownCtx); err != nil {
-		logger.Error("failed to shutdown HTTP server", "error", err)
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully.
+	sigCtx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	if err := lc.Wait(sigCtx); err != nil {
+		os.Exit(1)
 	}
 
 	logger.Info("account service stopped")
@@ -3,17 +3,26 @@ module github.com/bibbank/bib/services/account-service
 go 1.24
 
 require (
+	github.com/bibbank/bib/api/gen/go v0.0.0
 	github.com/bibbank/bib/pkg/auth v0.0.0-00010101000000-000000000000
+	github.com/bibbank/bib/pkg/contract v0.0.0
+	github.com/bibbank/bib/pkg/crypto v0.0.0
 	github.com/bibbank/bib/pkg/events v0.0.0
+	github.com/bibbank/bib/pkg/grpcserver v0.0.0
 	github.com/bibbank/bib/pkg/kafka v0.0.0
+	github.com/bibbank/bib/pkg/lifecycle v0.0.0
+	github.com/bibbank/bib/pkg/observability v0.0.0
 	github.com/bibbank/bib/pkg/openbanking v0.0.0
+	github.com/bibbank/bib/pkg/outbox v0.0.0
 	github.com/bibbank/bib/pkg/postgres v0.0.0
+	github.com/bibbank/bib/pkg/saga v0.0.0
 	github.com/bibbank/bib/pkg/testutil v0.0.0
 	github.com/bibbank/bib/pkg/tlsutil v0.0.0-00010101000000-000000000000
 	github.com/google/uuid v1.6.0
 	github.com/jackc/pgx/v5 v5.7.2
 	github.com/stretchr/testify v1.10.0
 	google.golang.org/grpc v1.68.1
+	google.golang.org/protobuf v1.35.2
 )
 
 require (
@@ -86,16 +95,23 @@ require (
 	golang.org/x/sys v0.28.0 // indirect
 	golang.org/x/text v0.21.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240903143218-8af14fe29dc1 // indirect
-	google.golang.org/protobuf v1.35.2 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
 replace (
+	github.com/bibbank/bib/api/gen/go => ../../api/gen/go
 	github.com/bibbank/bib/pkg/auth => ../../pkg/auth
+	github.com/bibbank/bib/pkg/contract => ../../pkg/contract
+	github.com/bibbank/bib/pkg/crypto => ../../pkg/crypto
 	github.com/bibbank/bib/pkg/events => ../../pkg/events
+	github.com/bibbank/bib/pkg/grpcserver => ../../pkg/grpcserver
 	github.com/bibbank/bib/pkg/kafka => ../../pkg/kafka
+	github.com/bibbank/bib/pkg/lifecycle => ../../pkg/lifecycle
+	github.com/bibbank/bib/pkg/observability => ../../pkg/observability
 	github.com/bibbank/bib/pkg/openbanking => ../../pkg/openbanking
+	github.com/bibbank/bib/pkg/outbox => ../../pkg/outbox
 	github.com/bibbank/bib/pkg/postgres => ../../pkg/postgres
+	github.com/bibbank/bib/pkg/saga => ../../pkg/saga
 	github.com/bibbank/bib/pkg/testutil => ../../pkg/testutil
 	github.com/bibbank/bib/pkg/tlsutil => ../../pkg/tlsutil
 )
@@ -53,7 +53,7 @@ func TestCloseAccountUseCase_Execute(t *testing.T) {
 		now := time.Now()
 		frozenAccount := model.ReconstructCustomerAccount(
 			uuid.New(), uuid.New(), valueobject.NewAccountNumber(), acctType,
-			model.AccountStatusFrozen, "USD", holder, "2000-100", 2, now, now,
+			model.AccountStatusFrozen, "USD", holder, "2000-100", "", 2, now, now,
 		)
 
 		repo := &mockAccountRepository{
@@ -97,7 +97,7 @@ func TestCloseAccountUseCase_Execute(t *testing.T) {
 		now := time.Now()
 		pendingAccount := model.ReconstructCustomerAccount(
 			uuid.New(), uuid.New(), valueobject.NewAccountNumber(), acctType,
-			model.AccountStatusPending, "USD", holder, "2000-100", 1, now, now,
+			model.AccountStatusPending, "USD", holder, "2000-100", "", 1, now, now,
 		)
 
 		repo := &mockAccountRepository{
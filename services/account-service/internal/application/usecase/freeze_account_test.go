@@ -22,7 +22,7 @@ func activeAccount() model.CustomerAccount {
 	now := time.Now()
 	return model.ReconstructCustomerAccount(
 		uuid.New(), uuid.New(), valueobject.NewAccountNumber(), acctType,
-		model.AccountStatusActive, "USD", holder, "2000-100", 1, now, now,
+		model.AccountStatusActive, "USD", holder, "2000-100", "", 1, now, now,
 	)
 }
 
@@ -81,7 +81,7 @@ func TestFreezeAccountUseCase_Execute(t *testing.T) {
 		now := time.Now()
 		pendingAccount := model.ReconstructCustomerAccount(
 			uuid.New(), uuid.New(), valueobject.NewAccountNumber(), acctType,
-			model.AccountStatusPending, "USD", holder, "2000-100", 1, now, now,
+			model.AccountStatusPending, "USD", holder, "2000-100", "", 1, now, now,
 		)
 
 		repo := &mockAccountRepository{
@@ -26,7 +26,7 @@ func TestGetAccountUseCase_Execute(t *testing.T) {
 
 		account := model.ReconstructCustomerAccount(
 			accountID, tenantID, valueobject.NewAccountNumber(), acctType,
-			model.AccountStatusActive, "USD", holder, "2000-100", 1, now, now,
+			model.AccountStatusActive, "USD", holder, "2000-100", "", 1, now, now,
 		)
 
 		repo := &mockAccountRepository{
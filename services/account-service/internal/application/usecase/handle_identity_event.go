@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/account-service/internal/domain/model"
+	"github.com/bibbank/bib/services/account-service/internal/domain/port"
+)
+
+const (
+	// IdentityVerificationsTopic is the identity-service topic carrying
+	// verification lifecycle events.
+	IdentityVerificationsTopic = "bib.identity.verifications"
+
+	identityVerificationExpired   = "identity.verification.expired"
+	identityVerificationCompleted = "identity.verification.completed"
+)
+
+// identityVerificationEvent holds the identity event fields this service acts on.
+type identityVerificationEvent struct {
+	PreviousVerificationID *uuid.UUID `json:"previous_verification_id"`
+	TenantID               uuid.UUID  `json:"tenant_id"`
+	VerificationID         uuid.UUID  `json:"verification_id"`
+}
+
+// HandleIdentityEventUseCase restricts accounts whose holder's KYC has lapsed.
+// When a holder's identity verification expires, their active accounts are
+// frozen with reason KYC_LAPSED. When a re-verification that replaces it is
+// approved, the accounts are relinked to the new verification and those
+// frozen for KYC_LAPSED are unfrozen. Other identity events are ignored.
+type HandleIdentityEventUseCase struct {
+	repo      port.AccountRepository
+	publisher port.EventPublisher
+	logger    *slog.Logger
+}
+
+// NewHandleIdentityEventUseCase creates a new HandleIdentityEventUseCase.
+func NewHandleIdentityEventUseCase(
+	repo port.AccountRepository,
+	publisher port.EventPublisher,
+	logger *slog.Logger,
+) *HandleIdentityEventUseCase {
+	return &HandleIdentityEventUseCase{
+		repo:      repo,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// Execute applies an identity event of the given type. The payload is a
+// CloudEvents envelope or, from older producers, the bare event. Handling
+// is idempotent, so a redelivered event leaves accounts unchanged.
+func (uc *HandleIdentityEventUseCase) Execute(ctx context.Context, eventType string, payload []byte) error {
+	if eventType != identityVerificationExpired && eventType != identityVerificationCompleted {
+		return nil
+	}
+
+	data, err := events.EventData(payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	var evt identityVerificationEvent
+	if err := json.Unmarshal(data, &evt); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+
+	switch eventType {
+	case identityVerificationExpired:
+		return uc.restrictAccounts(ctx, evt)
+	default:
+		if evt.PreviousVerificationID == nil {
+			return nil
+		}
+		return uc.restoreAccounts(ctx, evt)
+	}
+}
+
+// restrictAccounts freezes the active accounts linked to an expired verification.
+func (uc *HandleIdentityEventUseCase) restrictAccounts(ctx context.Context, evt identityVerificationEvent) error {
+	accounts, err := uc.repo.ListByIdentityVerification(ctx, evt.VerificationID)
+	if err != nil {
+		return fmt.Errorf("failed to list accounts for verification %s: %w", evt.VerificationID, err)
+	}
+
+	now := time.Now()
+	for _, account := range accounts {
+		if account.TenantID() != evt.TenantID || account.Status() != model.AccountStatusActive {
+			continue
+		}
+		frozen, err := account.Freeze(model.FreezeReasonKYCLapsed, now)
+		if err != nil {
+			return fmt.Errorf("failed to freeze account %s: %w", account.ID(), err)
+		}
+		if err := uc.save(ctx, frozen); err != nil {
+			return err
+		}
+		uc.logger.Info("account frozen after KYC lapsed",
+			"account_id", frozen.ID(),
+			"verification_id", evt.VerificationID,
+		)
+	}
+	return nil
+}
+
+// restoreAccounts moves accounts from the replaced verification to the new
+// one and lifts KYC_LAPSED freezes.
+func (uc *HandleIdentityEventUseCase) restoreAccounts(ctx context.Context, evt identityVerificationEvent) error {
+	accounts, err := uc.repo.ListByIdentityVerification(ctx, *evt.PreviousVerificationID)
+	if err != nil {
+		return fmt.Errorf("failed to list accounts for verification %s: %w", *evt.PreviousVerificationID, err)
+	}
+
+	now := time.Now()
+	for _, account := range accounts {
+		if account.TenantID() != evt.TenantID || account.Status() == model.AccountStatusClosed {
+			continue
+		}
+		updated, err := account.RelinkIdentityVerification(evt.VerificationID, now)
+		if err != nil {
+			return fmt.Errorf("failed to relink account %s: %w", account.ID(), err)
+		}
+		if updated.Status() == model.AccountStatusFrozen && updated.FreezeReason() == model.FreezeReasonKYCLapsed {
+			updated, err = updated.Unfreeze(now)
+			if err != nil {
+				return fmt.Errorf("failed to unfreeze account %s: %w", account.ID(), err)
+			}
+		}
+		if err := uc.save(ctx, updated); err != nil {
+			return err
+		}
+		uc.logger.Info("account relinked to refreshed identity verification",
+			"account_id", updated.ID(),
+			"verification_id", evt.VerificationID,
+			"status", updated.Status(),
+		)
+	}
+	return nil
+}
+
+// save persists an account and publishes its domain events.
+func (uc *HandleIdentityEventUseCase) save(ctx context.Context, account model.CustomerAccount) error {
+	if err := uc.repo.Save(ctx, account); err != nil {
+		return fmt.Errorf("failed to save account %s: %w", account.ID(), err)
+	}
+
+	events := account.DomainEvents()
+	if len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, accountEventsTopic, events...); err != nil {
+			uc.logger.Error("failed to publish domain events",
+				"error", err,
+				"account_id", account.ID(),
+				"event_count", len(events),
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/account-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/account-service/internal/domain/model"
+	"github.com/bibbank/bib/services/account-service/internal/domain/valueobject"
+)
+
+// identityMockAccountRepository serves accounts by identity verification and records saves.
+type identityMockAccountRepository struct {
+	byVerification map[uuid.UUID][]model.CustomerAccount
+	saved          []model.CustomerAccount
+}
+
+func (m *identityMockAccountRepository) Save(_ context.Context, account model.CustomerAccount) error {
+	m.saved = append(m.saved, account)
+	return nil
+}
+
+func (m *identityMockAccountRepository) FindByID(_ context.Context, _ uuid.UUID) (model.CustomerAccount, error) {
+	return model.CustomerAccount{}, fmt.Errorf("not implemented")
+}
+
+func (m *identityMockAccountRepository) FindByAccountNumber(_ context.Context, _ valueobject.AccountNumber) (model.CustomerAccount, error) {
+	return model.CustomerAccount{}, fmt.Errorf("not implemented")
+}
+
+func (m *identityMockAccountRepository) ListByTenant(_ context.Context, _ uuid.UUID, _, _ int) ([]model.CustomerAccount, int, error) {
+	return nil, 0, nil
+}
+
+func (m *identityMockAccountRepository) ListByHolder(_ context.Context, _ uuid.UUID, _, _ int) ([]model.CustomerAccount, int, error) {
+	return nil, 0, nil
+}
+
+func (m *identityMockAccountRepository) ListByIdentityVerification(_ context.Context, verificationID uuid.UUID) ([]model.CustomerAccount, error) {
+	return m.byVerification[verificationID], nil
+}
+
+func verifiedAccount(tenantID, verificationID uuid.UUID, status model.AccountStatus, freezeReason string) model.CustomerAccount {
+	holder := model.ReconstructAccountHolder(uuid.New(), "Jane", "Smith", "jane@example.com", verificationID)
+	now := time.Now()
+	return model.ReconstructCustomerAccount(
+		uuid.New(), tenantID, valueobject.NewAccountNumber(), valueobject.AccountTypeChecking,
+		status, "USD", holder, "2000-100", freezeReason, 1, now, now,
+	)
+}
+
+func TestHandleIdentityEventUseCase_Execute(t *testing.T) {
+	t.Run("freezes active accounts when verification expires", func(t *testing.T) {
+		tenantID, verificationID := uuid.New(), uuid.New()
+		active := verifiedAccount(tenantID, verificationID, model.AccountStatusActive, "")
+		closed := verifiedAccount(tenantID, verificationID, model.AccountStatusClosed, "")
+		repo := &identityMockAccountRepository{
+			byVerification: map[uuid.UUID][]model.CustomerAccount{verificationID: {active, closed}},
+		}
+		publisher := &mockEventPublisher{}
+		uc := usecase.NewHandleIdentityEventUseCase(repo, publisher, testLogger())
+
+		payload := fmt.Sprintf(`{"event_type":"identity.verification.expired","tenant_id":%q,"verification_id":%q}`, tenantID, verificationID)
+		err := uc.Execute(context.Background(), "identity.verification.expired", []byte(payload))
+		require.NoError(t, err)
+
+		require.Len(t, repo.saved, 1)
+		assert.Equal(t, active.ID(), repo.saved[0].ID())
+		assert.Equal(t, model.AccountStatusFrozen, repo.saved[0].Status())
+		assert.Equal(t, model.FreezeReasonKYCLapsed, repo.saved[0].FreezeReason())
+		require.Len(t, publisher.publishedEvents, 1)
+		assert.Equal(t, "account.frozen", publisher.publishedEvents[0].EventType())
+	})
+
+	t.Run("unwraps CloudEvents envelopes", func(t *testing.T) {
+		tenantID, verificationID := uuid.New(), uuid.New()
+		active := verifiedAccount(tenantID, verificationID, model.AccountStatusActive, "")
+		repo := &identityMockAccountRepository{
+			byVerification: map[uuid.UUID][]model.CustomerAccount{verificationID: {active}},
+		}
+		uc := usecase.NewHandleIdentityEventUseCase(repo, &mockEventPublisher{}, testLogger())
+
+		payload := fmt.Sprintf(`{"specversion":"1.0","id":%q,"source":"/bib/identity-service","type":"identity.verification.expired",`+
+			`"subject":%q,"datacontenttype":"application/json","data":{"tenant_id":%q,"verification_id":%q}}`,
+			uuid.New(), verificationID, tenantID, verificationID)
+		err := uc.Execute(context.Background(), "identity.verification.expired", []byte(payload))
+		require.NoError(t, err)
+
+		require.Len(t, repo.saved, 1)
+		assert.Equal(t, model.AccountStatusFrozen, repo.saved[0].Status())
+	})
+
+	t.Run("relinks and unfreezes KYC-lapsed accounts on re-verification", func(t *testing.T) {
+		tenantID, previousID, newID := uuid.New(), uuid.New(), uuid.New()
+		lapsed := verifiedAccount(tenantID, previousID, model.AccountStatusFrozen, model.FreezeReasonKYCLapsed)
+		fraudFrozen := verifiedAccount(tenantID, previousID, model.AccountStatusFrozen, "fraud investigation")
+		repo := &identityMockAccountRepository{
+			byVerification: map[uuid.UUID][]model.CustomerAccount{previousID: {lapsed, fraudFrozen}},
+		}
+		uc := usecase.NewHandleIdentityEventUseCase(repo, &mockEventPublisher{}, testLogger())
+
+		payload := fmt.Sprintf(`{"tenant_id":%q,"verification_id":%q,"previous_verification_id":%q}`, tenantID, newID, previousID)
+		err := uc.Execute(context.Background(), "identity.verification.completed", []byte(payload))
+		require.NoError(t, err)
+
+		require.Len(t, repo.saved, 2)
+		assert.Equal(t, model.AccountStatusActive, repo.saved[0].Status())
+		assert.Empty(t, repo.saved[0].FreezeReason())
+		assert.Equal(t, model.AccountStatusFrozen, repo.saved[1].Status())
+		for _, a := range repo.saved {
+			assert.Equal(t, newID, a.Holder().IdentityVerificationID())
+		}
+	})
+
+	t.Run("ignores first-time verification completions", func(t *testing.T) {
+		repo := &identityMockAccountRepository{}
+		uc := usecase.NewHandleIdentityEventUseCase(repo, &mockEventPublisher{}, testLogger())
+
+		payload := fmt.Sprintf(`{"tenant_id":%q,"verification_id":%q}`, uuid.New(), uuid.New())
+		err := uc.Execute(context.Background(), "identity.verification.completed", []byte(payload))
+		require.NoError(t, err)
+		assert.Empty(t, repo.saved)
+	})
+
+	t.Run("skips accounts of other tenants", func(t *testing.T) {
+		verificationID := uuid.New()
+		other := verifiedAccount(uuid.New(), verificationID, model.AccountStatusActive, "")
+		repo := &identityMockAccountRepository{
+			byVerification: map[uuid.UUID][]model.CustomerAccount{verificationID: {other}},
+		}
+		uc := usecase.NewHandleIdentityEventUseCase(repo, &mockEventPublisher{}, testLogger())
+
+		payload := fmt.Sprintf(`{"tenant_id":%q,"verification_id":%q}`, uuid.New(), verificationID)
+		err := uc.Execute(context.Background(), "identity.verification.expired", []byte(payload))
+		require.NoError(t, err)
+		assert.Empty(t, repo.saved)
+	})
+
+	t.Run("ignores unrelated event types without decoding", func(t *testing.T) {
+		uc := usecase.NewHandleIdentityEventUseCase(&identityMockAccountRepository{}, &mockEventPublisher{}, testLogger())
+
+		err := uc.Execute(context.Background(), "identity.document.uploaded", []byte("not json"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns error for malformed payload", func(t *testing.T) {
+		uc := usecase.NewHandleIdentityEventUseCase(&identityMockAccountRepository{}, &mockEventPublisher{}, testLogger())
+
+		err := uc.Execute(context.Background(), "identity.verification.expired", []byte("not json"))
+		assert.Error(t, err)
+	})
+}
@@ -47,6 +47,10 @@ func (m *listMockAccountRepository) ListByHolder(ctx context.Context, holderID u
 	return nil, 0, nil
 }
 
+func (m *listMockAccountRepository) ListByIdentityVerification(_ context.Context, _ uuid.UUID) ([]model.CustomerAccount, error) {
+	return nil, nil
+}
+
 func sampleAccounts(tenantID uuid.UUID, count int) []model.CustomerAccount {
 	var accounts []model.CustomerAccount
 	for i := 0; i < count; i++ {
@@ -55,7 +59,7 @@ func sampleAccounts(tenantID uuid.UUID, count int) []model.CustomerAccount {
 		now := time.Now()
 		accounts = append(accounts, model.ReconstructCustomerAccount(
 			uuid.New(), tenantID, valueobject.NewAccountNumber(), acctType,
-			model.AccountStatusActive, "USD", holder, fmt.Sprintf("2000-%03d", i), 1, now, now,
+			model.AccountStatusActive, "USD", holder, fmt.Sprintf("2000-%03d", i), "", 1, now, now,
 		))
 	}
 	return accounts
@@ -0,0 +1,345 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/pkg/saga"
+	"github.com/bibbank/bib/services/account-service/internal/application/dto"
+	"github.com/bibbank/bib/services/account-service/internal/domain/event"
+	"github.com/bibbank/bib/services/account-service/internal/domain/model"
+	"github.com/bibbank/bib/services/account-service/internal/domain/port"
+)
+
+// OnboardingSagaType is the onboarding saga's type in the saga store.
+const OnboardingSagaType = "onboarding"
+
+// Onboarding saga steps.
+const (
+	OnboardingStepOpenAccount         = "OPEN_ACCOUNT"
+	OnboardingStepCreateLedgerAccount = "CREATE_LEDGER_ACCOUNT"
+	OnboardingStepComplete            = "COMPLETE"
+)
+
+// CloseReasonOnboardingFailed is the close reason recorded on an account
+// opened by an onboarding saga that was then compensated.
+const CloseReasonOnboardingFailed = "ONBOARDING_FAILED"
+
+// Keys of the values the onboarding saga records between steps. The
+// applicant's details are only kept until the account holding them is open.
+const (
+	onboardingDataTenantID        = "tenant_id"
+	onboardingDataFirstName       = "first_name"
+	onboardingDataLastName        = "last_name"
+	onboardingDataEmail           = "email"
+	onboardingDataAccountID       = "account_id"
+	onboardingDataAccountNumber   = "account_number"
+	onboardingDataLedgerCode      = "ledger_account_code"
+	onboardingDataAccountAdopted  = "account_adopted"
+	onboardingDataAccountTypeName = "account_type"
+	onboardingDataCurrency        = "currency"
+)
+
+// verificationCompletedEvent holds the identity.verification.completed
+// fields onboarding needs.
+type verificationCompletedEvent struct {
+	PreviousVerificationID *uuid.UUID `json:"previous_verification_id"`
+	ApplicantFirstName     string     `json:"applicant_first_name"`
+	ApplicantLastName      string     `json:"applicant_last_name"`
+	ApplicantEmail         string     `json:"applicant_email"`
+	TenantID               uuid.UUID  `json:"tenant_id"`
+	VerificationID         uuid.UUID  `json:"verification_id"`
+}
+
+// OnboardingConfig configures the onboarding saga.
+type OnboardingConfig struct {
+	// AccountType and Currency are those of the account opened for each
+	// newly verified customer.
+	AccountType string
+	Currency    string
+	// StepTimeout bounds each step and compensation.
+	StepTimeout time.Duration
+	// MaxAttempts is how often publishing the outcome, or a compensation,
+	// is retried before the saga needs manual intervention.
+	MaxAttempts int
+}
+
+// OnboardCustomer onboards a customer once identity-service approves their
+// first verification, through a persisted saga: open a customer account
+// linked to the verification, open its ledger account in the ledger service,
+// and publish onboarding.completed. If the ledger account cannot be opened,
+// the customer account is closed again and onboarding.failed is published.
+//
+// The saga is keyed by the verification ID, so a redelivered event resumes
+// it rather than opening a second account. Re-verifications, which replace
+// an earlier approval, are handled by HandleIdentityEventUseCase instead.
+type OnboardCustomer struct {
+	repo         port.AccountRepository
+	publisher    port.EventPublisher
+	ledgerClient port.LedgerClient
+	opener       *OpenAccountUseCase
+	closer       *CloseAccountUseCase
+	orchestrator *saga.Orchestrator
+	logger       *slog.Logger
+	config       OnboardingConfig
+}
+
+// NewOnboardCustomer creates a new OnboardCustomer. ledgerClient may be nil,
+// in which case no ledger account is opened.
+func NewOnboardCustomer(
+	repo port.AccountRepository,
+	publisher port.EventPublisher,
+	ledgerClient port.LedgerClient,
+	sagaStore saga.Store,
+	config OnboardingConfig,
+	logger *slog.Logger,
+) *OnboardCustomer {
+	uc := &OnboardCustomer{
+		repo:         repo,
+		publisher:    publisher,
+		ledgerClient: ledgerClient,
+		// The saga opens the ledger account in a step of its own, so it can
+		// be compensated.
+		opener:       NewOpenAccountUseCase(repo, publisher, nil, logger),
+		closer:       NewCloseAccountUseCase(repo, publisher, logger),
+		orchestrator: saga.NewOrchestrator(sagaStore, logger),
+		logger:       logger,
+		config:       config,
+	}
+	uc.orchestrator.Register(uc.sagaDefinition())
+	return uc
+}
+
+// Execute starts onboarding for an identity.verification.completed event.
+// The payload is a CloudEvents envelope or, from older producers, the bare
+// event. Other events and completed re-verifications are ignored. An error
+// means the saga is waiting to be retried by RecoverStalled.
+func (uc *OnboardCustomer) Execute(ctx context.Context, eventType string, payload []byte) error {
+	if eventType != identityVerificationCompleted {
+		return nil
+	}
+
+	data, err := events.EventData(payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	var evt verificationCompletedEvent
+	if err := json.Unmarshal(data, &evt); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	if evt.PreviousVerificationID != nil {
+		return nil
+	}
+
+	sagaData := map[string]string{
+		onboardingDataTenantID:        evt.TenantID.String(),
+		onboardingDataFirstName:       evt.ApplicantFirstName,
+		onboardingDataLastName:        evt.ApplicantLastName,
+		onboardingDataEmail:           evt.ApplicantEmail,
+		onboardingDataAccountTypeName: uc.config.AccountType,
+		onboardingDataCurrency:        uc.config.Currency,
+	}
+	if _, err := uc.orchestrator.Start(ctx, OnboardingSagaType, evt.VerificationID.String(), sagaData); err != nil {
+		return fmt.Errorf("onboarding saga for verification %s: %w", evt.VerificationID, err)
+	}
+	return nil
+}
+
+// RecoverStalled resumes up to limit onboarding sagas that have not
+// progressed for staleAfter, and returns how many of them finished.
+func (uc *OnboardCustomer) RecoverStalled(ctx context.Context, staleAfter time.Duration, limit int) (int, error) {
+	return uc.orchestrator.RecoverStalled(ctx, staleAfter, limit)
+}
+
+func (uc *OnboardCustomer) sagaDefinition() saga.Definition {
+	timeout := uc.config.StepTimeout
+	return saga.Definition{
+		Type: OnboardingSagaType,
+		Steps: []saga.Step{
+			{Name: OnboardingStepOpenAccount, Action: uc.openAccount, Compensate: uc.closeAccount, Timeout: timeout},
+			{Name: OnboardingStepCreateLedgerAccount, Action: uc.createLedgerAccount, Timeout: timeout},
+			{Name: OnboardingStepComplete, Action: uc.complete, Timeout: timeout, Retriable: true},
+		},
+		OnCompensated: uc.fail,
+		MaxAttempts:   uc.config.MaxAttempts,
+	}
+}
+
+// openAccount opens the customer's account. If an account is already linked
+// to the verification, no other is opened: one opened since the saga started
+// is from a run of this step whose completion was not recorded, and one
+// opened before it, through the API, is adopted and left open on failure.
+func (uc *OnboardCustomer) openAccount(ctx context.Context, state *saga.State) error {
+	verificationID, tenantID, err := onboardingIDs(state)
+	if err != nil {
+		return err
+	}
+
+	existing, err := uc.linkedAccount(ctx, verificationID, tenantID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		recordAccount(state, existing.ID(), existing.AccountNumber().String(), existing.LedgerAccountCode())
+		if existing.CreatedAt().Before(state.CreatedAt) {
+			state.Data[onboardingDataAccountAdopted] = "true"
+		}
+		return nil
+	}
+
+	opened, err := uc.opener.Execute(ctx, dto.OpenAccountRequest{
+		TenantID:               tenantID,
+		AccountType:            state.Data[onboardingDataAccountTypeName],
+		Currency:               state.Data[onboardingDataCurrency],
+		HolderFirstName:        state.Data[onboardingDataFirstName],
+		HolderLastName:         state.Data[onboardingDataLastName],
+		HolderEmail:            state.Data[onboardingDataEmail],
+		IdentityVerificationID: verificationID,
+	})
+	if err != nil {
+		return err
+	}
+	recordAccount(state, opened.AccountID, opened.AccountNumber, opened.LedgerAccountCode)
+	return nil
+}
+
+// closeAccount closes the account the saga opened. Adopted accounts were
+// not opened by the saga and are left open.
+func (uc *OnboardCustomer) closeAccount(ctx context.Context, state *saga.State) error {
+	if state.Data[onboardingDataAccountAdopted] != "" {
+		return nil
+	}
+	accountID, err := uuid.Parse(state.Data[onboardingDataAccountID])
+	if err != nil {
+		// The step failed before opening an account.
+		return nil
+	}
+
+	account, err := uc.repo.FindByID(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("failed to find account %s: %w", accountID, err)
+	}
+	if account.Status() == model.AccountStatusClosed {
+		return nil
+	}
+	_, err = uc.closer.Execute(ctx, dto.CloseAccountRequest{
+		AccountID: accountID,
+		Reason:    CloseReasonOnboardingFailed,
+	})
+	return err
+}
+
+// createLedgerAccount opens the account's ledger account. The ledger opens
+// an account once however often it is asked.
+func (uc *OnboardCustomer) createLedgerAccount(ctx context.Context, state *saga.State) error {
+	if uc.ledgerClient == nil {
+		return nil
+	}
+	_, tenantID, err := onboardingIDs(state)
+	if err != nil {
+		return err
+	}
+	code := state.Data[onboardingDataLedgerCode]
+	if err := uc.ledgerClient.CreateLedgerAccount(ctx, tenantID, code, state.Data[onboardingDataCurrency]); err != nil {
+		return fmt.Errorf("failed to create ledger account %s: %w", code, err)
+	}
+	return nil
+}
+
+// complete publishes onboarding.completed.
+func (uc *OnboardCustomer) complete(ctx context.Context, state *saga.State) error {
+	verificationID, tenantID, err := onboardingIDs(state)
+	if err != nil {
+		return err
+	}
+	accountID, err := uuid.Parse(state.Data[onboardingDataAccountID])
+	if err != nil {
+		return fmt.Errorf("invalid onboarding account ID %q: %w", state.Data[onboardingDataAccountID], err)
+	}
+
+	completed := event.NewOnboardingCompleted(verificationID, tenantID, accountID,
+		state.Data[onboardingDataAccountNumber],
+		state.Data[onboardingDataAccountTypeName],
+		state.Data[onboardingDataCurrency],
+		state.Data[onboardingDataLedgerCode],
+	)
+	if err := uc.publisher.Publish(ctx, accountEventsTopic, completed); err != nil {
+		return fmt.Errorf("failed to publish onboarding.completed: %w", err)
+	}
+	uc.logger.Info("customer onboarded",
+		"verification_id", verificationID,
+		"account_id", accountID,
+		"ledger_code", state.Data[onboardingDataLedgerCode],
+	)
+	return nil
+}
+
+// fail publishes onboarding.failed once the saga has been compensated.
+func (uc *OnboardCustomer) fail(ctx context.Context, state *saga.State) error {
+	verificationID, tenantID, err := onboardingIDs(state)
+	if err != nil {
+		return err
+	}
+	// Adopted accounts stay open, so they are not reported as the failed
+	// onboarding's account.
+	var accountID uuid.UUID
+	if state.Data[onboardingDataAccountAdopted] == "" {
+		accountID, _ = uuid.Parse(state.Data[onboardingDataAccountID]) //nolint:errcheck // nil when no account was opened
+	}
+
+	failed := event.NewOnboardingFailed(verificationID, tenantID, accountID, state.FailedStep, state.FailureReason)
+	if err := uc.publisher.Publish(ctx, accountEventsTopic, failed); err != nil {
+		return fmt.Errorf("failed to publish onboarding.failed: %w", err)
+	}
+	uc.logger.Warn("customer onboarding failed",
+		"verification_id", verificationID,
+		"failed_step", state.FailedStep,
+		"reason", state.FailureReason,
+	)
+	return nil
+}
+
+// linkedAccount returns the tenant's open account linked to the
+// verification, or nil if there is none.
+func (uc *OnboardCustomer) linkedAccount(ctx context.Context, verificationID, tenantID uuid.UUID) (*model.CustomerAccount, error) {
+	accounts, err := uc.repo.ListByIdentityVerification(ctx, verificationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts for verification %s: %w", verificationID, err)
+	}
+	for i := range accounts {
+		if accounts[i].TenantID() == tenantID && accounts[i].Status() != model.AccountStatusClosed {
+			return &accounts[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// recordAccount records the opened account in the saga and drops the
+// applicant's details, which the account now holds.
+func recordAccount(state *saga.State, accountID uuid.UUID, accountNumber, ledgerCode string) {
+	state.Data[onboardingDataAccountID] = accountID.String()
+	state.Data[onboardingDataAccountNumber] = accountNumber
+	state.Data[onboardingDataLedgerCode] = ledgerCode
+	delete(state.Data, onboardingDataFirstName)
+	delete(state.Data, onboardingDataLastName)
+	delete(state.Data, onboardingDataEmail)
+}
+
+// onboardingIDs returns the verification and tenant the saga onboards.
+func onboardingIDs(state *saga.State) (verificationID, tenantID uuid.UUID, err error) {
+	verificationID, err = uuid.Parse(state.CorrelationID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid onboarding saga correlation ID %q: %w", state.CorrelationID, err)
+	}
+	tenantID, err = uuid.Parse(state.Data[onboardingDataTenantID])
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid onboarding tenant ID %q: %w", state.Data[onboardingDataTenantID], err)
+	}
+	return verificationID, tenantID, nil
+}
@@ -0,0 +1,200 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/saga"
+	"github.com/bibbank/bib/services/account-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/account-service/internal/domain/event"
+	"github.com/bibbank/bib/services/account-service/internal/domain/model"
+	"github.com/bibbank/bib/services/account-service/internal/domain/valueobject"
+)
+
+// onboardingMockAccountRepository keeps saved accounts in memory.
+type onboardingMockAccountRepository struct {
+	accounts map[uuid.UUID]model.CustomerAccount
+}
+
+func newOnboardingMockAccountRepository(accounts ...model.CustomerAccount) *onboardingMockAccountRepository {
+	repo := &onboardingMockAccountRepository{accounts: make(map[uuid.UUID]model.CustomerAccount)}
+	for _, account := range accounts {
+		repo.accounts[account.ID()] = account
+	}
+	return repo
+}
+
+func (m *onboardingMockAccountRepository) Save(_ context.Context, account model.CustomerAccount) error {
+	m.accounts[account.ID()] = account
+	return nil
+}
+
+func (m *onboardingMockAccountRepository) FindByID(_ context.Context, id uuid.UUID) (model.CustomerAccount, error) {
+	account, ok := m.accounts[id]
+	if !ok {
+		return model.CustomerAccount{}, fmt.Errorf("account not found")
+	}
+	return account, nil
+}
+
+func (m *onboardingMockAccountRepository) FindByAccountNumber(_ context.Context, _ valueobject.AccountNumber) (model.CustomerAccount, error) {
+	return model.CustomerAccount{}, fmt.Errorf("not implemented")
+}
+
+func (m *onboardingMockAccountRepository) ListByTenant(_ context.Context, _ uuid.UUID, _, _ int) ([]model.CustomerAccount, int, error) {
+	return nil, 0, nil
+}
+
+func (m *onboardingMockAccountRepository) ListByHolder(_ context.Context, _ uuid.UUID, _, _ int) ([]model.CustomerAccount, int, error) {
+	return nil, 0, nil
+}
+
+func (m *onboardingMockAccountRepository) ListByIdentityVerification(_ context.Context, verificationID uuid.UUID) ([]model.CustomerAccount, error) {
+	var accounts []model.CustomerAccount
+	for _, account := range m.accounts {
+		if account.Holder().IdentityVerificationID() == verificationID {
+			accounts = append(accounts, account)
+		}
+	}
+	return accounts, nil
+}
+
+var testOnboardingConfig = usecase.OnboardingConfig{
+	AccountType: "CHECKING",
+	Currency:    "USD",
+	StepTimeout: time.Second,
+	MaxAttempts: 3,
+}
+
+func verificationCompletedPayload(tenantID, verificationID uuid.UUID, previous string) []byte {
+	return []byte(fmt.Sprintf(`{"specversion":"1.0","id":%q,"source":"/bib/identity-service","type":"identity.verification.completed",`+
+		`"data":{"tenant_id":%q,"verification_id":%q,"applicant_first_name":"Jane","applicant_last_name":"Smith",`+
+		`"applicant_email":"jane@example.com"%s}}`, uuid.New(), tenantID, verificationID, previous))
+}
+
+func publishedTypes(publisher *mockEventPublisher) []string {
+	types := make([]string, 0, len(publisher.publishedEvents))
+	for _, e := range publisher.publishedEvents {
+		types = append(types, e.EventType())
+	}
+	return types
+}
+
+func TestOnboardCustomer_Execute(t *testing.T) {
+	t.Run("opens the account and its ledger account", func(t *testing.T) {
+		tenantID, verificationID := uuid.New(), uuid.New()
+		repo := newOnboardingMockAccountRepository()
+		publisher := &mockEventPublisher{}
+		ledger := &mockLedgerClient{}
+		store := saga.NewMemoryStore()
+		uc := usecase.NewOnboardCustomer(repo, publisher, ledger, store, testOnboardingConfig, testLogger())
+
+		err := uc.Execute(context.Background(), "identity.verification.completed", verificationCompletedPayload(tenantID, verificationID, ""))
+		require.NoError(t, err)
+
+		require.Len(t, repo.accounts, 1)
+		var account model.CustomerAccount
+		for _, a := range repo.accounts {
+			account = a
+		}
+		assert.Equal(t, tenantID, account.TenantID())
+		assert.Equal(t, model.AccountStatusActive, account.Status())
+		assert.Equal(t, verificationID, account.Holder().IdentityVerificationID())
+		assert.Equal(t, "Jane", account.Holder().FirstName())
+		assert.Equal(t, "jane@example.com", account.Holder().Email())
+
+		assert.True(t, ledger.createCalled)
+		assert.Equal(t, account.LedgerAccountCode(), ledger.createdCode)
+		assert.Equal(t, "USD", ledger.createdCurrency)
+
+		types := publishedTypes(publisher)
+		require.NotEmpty(t, types)
+		assert.Equal(t, "onboarding.completed", types[len(types)-1])
+		completed, ok := publisher.publishedEvents[len(types)-1].(event.OnboardingCompleted)
+		require.True(t, ok)
+		assert.Equal(t, account.ID(), completed.AccountID)
+		assert.Equal(t, verificationID, completed.VerificationID)
+
+		state, err := store.FindByCorrelation(context.Background(), usecase.OnboardingSagaType, verificationID.String())
+		require.NoError(t, err)
+		assert.Equal(t, saga.StatusCompleted, state.Status)
+		assert.NotContains(t, state.Data, "email", "applicant details are dropped once the account is open")
+	})
+
+	t.Run("closes the account when the ledger account cannot be opened", func(t *testing.T) {
+		tenantID, verificationID := uuid.New(), uuid.New()
+		repo := newOnboardingMockAccountRepository()
+		publisher := &mockEventPublisher{}
+		ledger := &mockLedgerClient{createErr: errors.New("ledger rejected account")}
+		uc := usecase.NewOnboardCustomer(repo, publisher, ledger, saga.NewMemoryStore(), testOnboardingConfig, testLogger())
+
+		err := uc.Execute(context.Background(), "identity.verification.completed", verificationCompletedPayload(tenantID, verificationID, ""))
+		require.NoError(t, err)
+
+		require.Len(t, repo.accounts, 1)
+		for _, account := range repo.accounts {
+			assert.Equal(t, model.AccountStatusClosed, account.Status())
+		}
+		types := publishedTypes(publisher)
+		assert.Contains(t, types, "account.closed")
+		assert.Equal(t, "onboarding.failed", types[len(types)-1])
+		failed, ok := publisher.publishedEvents[len(types)-1].(event.OnboardingFailed)
+		require.True(t, ok)
+		assert.Equal(t, usecase.OnboardingStepCreateLedgerAccount, failed.FailedStep)
+		assert.Contains(t, failed.Reason, "ledger rejected account")
+		require.NotNil(t, failed.AccountID)
+	})
+
+	t.Run("redelivered event does not open a second account", func(t *testing.T) {
+		tenantID, verificationID := uuid.New(), uuid.New()
+		repo := newOnboardingMockAccountRepository()
+		uc := usecase.NewOnboardCustomer(repo, &mockEventPublisher{}, &mockLedgerClient{}, saga.NewMemoryStore(), testOnboardingConfig, testLogger())
+		payload := verificationCompletedPayload(tenantID, verificationID, "")
+
+		require.NoError(t, uc.Execute(context.Background(), "identity.verification.completed", payload))
+		require.NoError(t, uc.Execute(context.Background(), "identity.verification.completed", payload))
+
+		assert.Len(t, repo.accounts, 1)
+	})
+
+	t.Run("adopts an account opened before onboarding and leaves it open on failure", func(t *testing.T) {
+		tenantID, verificationID := uuid.New(), uuid.New()
+		existing := verifiedAccount(tenantID, verificationID, model.AccountStatusActive, "")
+		repo := newOnboardingMockAccountRepository(existing)
+		publisher := &mockEventPublisher{}
+		ledger := &mockLedgerClient{createErr: errors.New("ledger unavailable")}
+		uc := usecase.NewOnboardCustomer(repo, publisher, ledger, saga.NewMemoryStore(), testOnboardingConfig, testLogger())
+
+		err := uc.Execute(context.Background(), "identity.verification.completed", verificationCompletedPayload(tenantID, verificationID, ""))
+		require.NoError(t, err)
+
+		require.Len(t, repo.accounts, 1)
+		assert.Equal(t, model.AccountStatusActive, repo.accounts[existing.ID()].Status())
+		types := publishedTypes(publisher)
+		assert.Equal(t, []string{"onboarding.failed"}, types)
+		failed, ok := publisher.publishedEvents[0].(event.OnboardingFailed)
+		require.True(t, ok)
+		assert.Nil(t, failed.AccountID)
+	})
+
+	t.Run("ignores re-verifications and other events", func(t *testing.T) {
+		tenantID, verificationID := uuid.New(), uuid.New()
+		repo := newOnboardingMockAccountRepository()
+		publisher := &mockEventPublisher{}
+		uc := usecase.NewOnboardCustomer(repo, publisher, &mockLedgerClient{}, saga.NewMemoryStore(), testOnboardingConfig, testLogger())
+
+		previous := fmt.Sprintf(`,"previous_verification_id":%q`, uuid.New())
+		require.NoError(t, uc.Execute(context.Background(), "identity.verification.completed", verificationCompletedPayload(tenantID, verificationID, previous)))
+		require.NoError(t, uc.Execute(context.Background(), "identity.verification.expired", verificationCompletedPayload(tenantID, verificationID, "")))
+
+		assert.Empty(t, repo.accounts)
+		assert.Empty(t, publisher.publishedEvents)
+	})
+}
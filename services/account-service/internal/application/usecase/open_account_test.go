@@ -53,6 +53,10 @@ func (m *mockAccountRepository) ListByHolder(_ context.Context, _ uuid.UUID, _,
 	return nil, 0, fmt.Errorf("not implemented")
 }
 
+func (m *mockAccountRepository) ListByIdentityVerification(_ context.Context, _ uuid.UUID) ([]model.CustomerAccount, error) {
+	return nil, nil
+}
+
 type mockEventPublisher struct {
 	publishErr      error
 	publishedTopic  string
@@ -0,0 +1,17 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[AccountOpened]("account.opened", 1, "Emitted when a new customer account is created."),
+		events.Declare[AccountActivated]("account.activated", 1, "Emitted when an account transitions to ACTIVE status."),
+		events.Declare[AccountFrozen]("account.frozen", 1, "Emitted when an account is frozen."),
+		events.Declare[AccountUnfrozen]("account.unfrozen", 1, "Emitted when a frozen account is unfrozen."),
+		events.Declare[AccountClosed]("account.closed", 1, "Emitted when an account is closed."),
+		events.Declare[OnboardingCompleted]("onboarding.completed", 1, "Emitted when a newly verified customer's account and ledger account have been opened."),
+		events.Declare[OnboardingFailed]("onboarding.failed", 1, "Emitted when onboarding a newly verified customer failed and any account opened for them was closed."),
+	}
+}
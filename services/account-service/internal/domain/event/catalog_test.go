@@ -0,0 +1,12 @@
+package event_test
+
+import (
+	"testing"
+
+	"github.com/bibbank/bib/pkg/events/catalogtest"
+	"github.com/bibbank/bib/services/account-service/internal/domain/event"
+)
+
+func TestEventCatalog(t *testing.T) {
+	catalogtest.CheckCatalog(t, "../../../../../events/account-service.json", "account-service", event.Catalog())
+}
@@ -14,11 +14,12 @@ type DomainEvent = events.DomainEvent
 // AccountOpened is emitted when a new customer account is created.
 type AccountOpened struct {
 	events.BaseEvent
-	AccountNumber string `json:"account_number"`
-	AccountType   string `json:"account_type"`
-	Currency      string `json:"currency"`
-	HolderName    string `json:"holder_name"`
-	HolderEmail   string `json:"holder_email"`
+	AccountNumber string    `json:"account_number"`
+	AccountType   string    `json:"account_type"`
+	Currency      string    `json:"currency"`
+	HolderName    string    `json:"holder_name"`
+	HolderEmail   string    `json:"holder_email"`
+	HolderID      uuid.UUID `json:"holder_id"`
 }
 
 // NewAccountOpened creates a new AccountOpened event.
@@ -28,6 +29,7 @@ func NewAccountOpened(
 	accountNumber string,
 	accountType string,
 	currency string,
+	holderID uuid.UUID,
 	holderName string,
 	holderEmail string,
 ) AccountOpened {
@@ -38,22 +40,26 @@ func NewAccountOpened(
 		Currency:      currency,
 		HolderName:    holderName,
 		HolderEmail:   holderEmail,
+		HolderID:      holderID,
 	}
 }
 
 // AccountActivated is emitted when an account transitions to ACTIVE status.
+// LedgerAccountCode is the ledger account the account posts to.
 type AccountActivated struct {
 	ActivatedAt time.Time `json:"activated_at"`
 	events.BaseEvent
-	AccountNumber string `json:"account_number"`
+	AccountNumber     string `json:"account_number"`
+	LedgerAccountCode string `json:"ledger_account_code"`
 }
 
 // NewAccountActivated creates a new AccountActivated event.
-func NewAccountActivated(accountID uuid.UUID, tenantID uuid.UUID, accountNumber string, activatedAt time.Time) AccountActivated {
+func NewAccountActivated(accountID uuid.UUID, tenantID uuid.UUID, accountNumber, ledgerAccountCode string, activatedAt time.Time) AccountActivated {
 	return AccountActivated{
-		BaseEvent:     events.NewBaseEvent("account.activated", accountID.String(), "CustomerAccount", tenantID.String()),
-		AccountNumber: accountNumber,
-		ActivatedAt:   activatedAt,
+		BaseEvent:         events.NewBaseEvent("account.activated", accountID.String(), "CustomerAccount", tenantID.String()),
+		AccountNumber:     accountNumber,
+		LedgerAccountCode: ledgerAccountCode,
+		ActivatedAt:       activatedAt,
 	}
 }
 
@@ -108,3 +114,61 @@ func NewAccountClosed(accountID uuid.UUID, tenantID uuid.UUID, accountNumber str
 		ClosedAt:      closedAt,
 	}
 }
+
+// OnboardingCompleted is emitted when a newly verified customer's account
+// and its ledger account have been opened.
+type OnboardingCompleted struct {
+	events.BaseEvent
+	AccountNumber     string    `json:"account_number"`
+	AccountType       string    `json:"account_type"`
+	Currency          string    `json:"currency"`
+	LedgerAccountCode string    `json:"ledger_account_code"`
+	AccountID         uuid.UUID `json:"account_id"`
+	VerificationID    uuid.UUID `json:"verification_id"`
+}
+
+// NewOnboardingCompleted creates a new OnboardingCompleted event.
+func NewOnboardingCompleted(
+	verificationID uuid.UUID,
+	tenantID uuid.UUID,
+	accountID uuid.UUID,
+	accountNumber string,
+	accountType string,
+	currency string,
+	ledgerAccountCode string,
+) OnboardingCompleted {
+	return OnboardingCompleted{
+		BaseEvent:         events.NewBaseEvent("onboarding.completed", verificationID.String(), "Onboarding", tenantID.String()),
+		VerificationID:    verificationID,
+		AccountID:         accountID,
+		AccountNumber:     accountNumber,
+		AccountType:       accountType,
+		Currency:          currency,
+		LedgerAccountCode: ledgerAccountCode,
+	}
+}
+
+// OnboardingFailed is emitted when a newly verified customer could not be
+// onboarded and any account opened for them has been closed again.
+// AccountID is nil when no account was opened.
+type OnboardingFailed struct {
+	events.BaseEvent
+	AccountID      *uuid.UUID `json:"account_id,omitempty"`
+	FailedStep     string     `json:"failed_step"`
+	Reason         string     `json:"reason"`
+	VerificationID uuid.UUID  `json:"verification_id"`
+}
+
+// NewOnboardingFailed creates a new OnboardingFailed event.
+func NewOnboardingFailed(verificationID uuid.UUID, tenantID uuid.UUID, accountID uuid.UUID, failedStep string, reason string) OnboardingFailed {
+	e := OnboardingFailed{
+		BaseEvent:      events.NewBaseEvent("onboarding.failed", verificationID.String(), "Onboarding", tenantID.String()),
+		VerificationID: verificationID,
+		FailedStep:     failedStep,
+		Reason:         reason,
+	}
+	if accountID != uuid.Nil {
+		e.AccountID = &accountID
+	}
+	return e
+}
@@ -104,3 +104,10 @@ func (h AccountHolder) Email() string {
 func (h AccountHolder) IdentityVerificationID() uuid.UUID {
 	return h.identityVerificationID
 }
+
+// WithIdentityVerification returns a copy of the holder linked to the given
+// identity verification record.
+func (h AccountHolder) WithIdentityVerification(identityVerificationID uuid.UUID) AccountHolder {
+	h.identityVerificationID = identityVerificationID
+	return h
+}
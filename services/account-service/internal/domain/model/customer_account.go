@@ -21,6 +21,11 @@ const (
 	AccountStatusClosed  AccountStatus = "CLOSED"
 )
 
+// FreezeReasonKYCLapsed is the freeze reason recorded when the holder's
+// identity verification expires. Only accounts frozen for this reason are
+// unfrozen automatically once the holder is re-verified.
+const FreezeReasonKYCLapsed = "KYC_LAPSED"
+
 // CustomerAccount is the main aggregate root for the account domain.
 // It is immutable; all state transitions return a new instance.
 type CustomerAccount struct {
@@ -31,6 +36,7 @@ type CustomerAccount struct {
 	status            AccountStatus
 	currency          string
 	ledgerAccountCode string
+	freezeReason      string
 	domainEvents      []events.DomainEvent
 	holder            AccountHolder
 	version           int
@@ -82,6 +88,7 @@ func NewCustomerAccount(
 		accountNumber.String(),
 		accountType.String(),
 		currency,
+		holder.ID(),
 		holder.FullName(),
 		holder.Email(),
 	))
@@ -100,6 +107,7 @@ func ReconstructCustomerAccount(
 	currency string,
 	holder AccountHolder,
 	ledgerAccountCode string,
+	freezeReason string,
 	version int,
 	createdAt time.Time,
 	updatedAt time.Time,
@@ -113,6 +121,7 @@ func ReconstructCustomerAccount(
 		currency:          currency,
 		holder:            holder,
 		ledgerAccountCode: ledgerAccountCode,
+		freezeReason:      freezeReason,
 		version:           version,
 		createdAt:         createdAt,
 		updatedAt:         updatedAt,
@@ -135,6 +144,7 @@ func (a CustomerAccount) Activate(now time.Time) (CustomerAccount, error) {
 		a.id,
 		a.tenantID,
 		a.accountNumber.String(),
+		a.ledgerAccountCode,
 		now,
 	))
 
@@ -153,6 +163,7 @@ func (a CustomerAccount) Freeze(reason string, now time.Time) (CustomerAccount,
 
 	updated := a.clone()
 	updated.status = AccountStatusFrozen
+	updated.freezeReason = reason
 	updated.updatedAt = now
 	updated.version = a.version + 1
 
@@ -176,6 +187,7 @@ func (a CustomerAccount) Unfreeze(now time.Time) (CustomerAccount, error) {
 
 	updated := a.clone()
 	updated.status = AccountStatusActive
+	updated.freezeReason = ""
 	updated.updatedAt = now
 	updated.version = a.version + 1
 
@@ -231,6 +243,24 @@ func (a CustomerAccount) AssignLedgerCode(code string, now time.Time) (CustomerA
 	return updated, nil
 }
 
+// RelinkIdentityVerification points the account holder at a new identity
+// verification, such as a KYC refresh that replaces an expired one.
+// Returns a new CustomerAccount with the updated holder.
+func (a CustomerAccount) RelinkIdentityVerification(verificationID uuid.UUID, now time.Time) (CustomerAccount, error) {
+	if a.status == AccountStatusClosed {
+		return CustomerAccount{}, fmt.Errorf("cannot relink identity verification of a CLOSED account")
+	}
+	if verificationID == uuid.Nil {
+		return CustomerAccount{}, fmt.Errorf("identity verification ID is required")
+	}
+
+	updated := a.clone()
+	updated.holder = a.holder.WithIdentityVerification(verificationID)
+	updated.updatedAt = now
+	updated.version = a.version + 1
+	return updated, nil
+}
+
 // --- Accessors ---
 
 // ID returns the account's unique identifier.
@@ -257,6 +287,9 @@ func (a CustomerAccount) Holder() AccountHolder { return a.holder }
 // LedgerAccountCode returns the linked ledger account code.
 func (a CustomerAccount) LedgerAccountCode() string { return a.ledgerAccountCode }
 
+// FreezeReason returns why the account was frozen; empty unless FROZEN.
+func (a CustomerAccount) FreezeReason() string { return a.freezeReason }
+
 // Version returns the current version for optimistic concurrency.
 func (a CustomerAccount) Version() int { return a.version }
 
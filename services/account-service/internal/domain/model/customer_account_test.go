@@ -169,6 +169,7 @@ func TestCustomerAccount_Freeze(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t, model.AccountStatusFrozen, frozen.Status())
+		assert.Equal(t, "suspicious activity", frozen.FreezeReason())
 		assert.Equal(t, now, frozen.UpdatedAt())
 		assert.Equal(t, activated.Version()+1, frozen.Version())
 	})
@@ -224,6 +225,7 @@ func TestCustomerAccount_Unfreeze(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t, model.AccountStatusActive, unfrozen.Status())
+		assert.Empty(t, unfrozen.FreezeReason())
 		assert.Equal(t, now, unfrozen.UpdatedAt())
 		assert.Equal(t, frozen.Version()+1, unfrozen.Version())
 	})
@@ -350,6 +352,39 @@ func TestCustomerAccount_AssignLedgerCode(t *testing.T) {
 	})
 }
 
+func TestCustomerAccount_RelinkIdentityVerification(t *testing.T) {
+	t.Run("relinks holder to new verification", func(t *testing.T) {
+		account := newTestAccount(t)
+		activated, _ := account.Activate(time.Now())
+		newVerificationID := uuid.New()
+		now := time.Now()
+
+		relinked, err := activated.RelinkIdentityVerification(newVerificationID, now)
+		require.NoError(t, err)
+
+		assert.Equal(t, newVerificationID, relinked.Holder().IdentityVerificationID())
+		assert.Equal(t, activated.Holder().ID(), relinked.Holder().ID())
+		assert.Equal(t, activated.Version()+1, relinked.Version())
+		assert.NotEqual(t, newVerificationID, activated.Holder().IdentityVerificationID())
+	})
+
+	t.Run("rejects nil verification ID", func(t *testing.T) {
+		account := newTestAccount(t)
+		_, err := account.RelinkIdentityVerification(uuid.Nil, time.Now())
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects CLOSED account", func(t *testing.T) {
+		account := newTestAccount(t)
+		activated, _ := account.Activate(time.Now())
+		closed, _ := activated.Close("customer request", time.Now())
+
+		_, err := closed.RelinkIdentityVerification(uuid.New(), time.Now())
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "CLOSED")
+	})
+}
+
 func TestCustomerAccount_DomainEvents(t *testing.T) {
 	t.Run("returns defensive copy", func(t *testing.T) {
 		account := newTestAccount(t)
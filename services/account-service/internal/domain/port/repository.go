@@ -29,6 +29,10 @@ type AccountRepository interface {
 	// ListByHolder retrieves all accounts for a given holder with pagination.
 	// Returns the accounts, total count, and any error.
 	ListByHolder(ctx context.Context, holderID uuid.UUID, limit, offset int) ([]model.CustomerAccount, int, error)
+
+	// ListByIdentityVerification retrieves all accounts whose holder is linked
+	// to the given identity verification.
+	ListByIdentityVerification(ctx context.Context, verificationID uuid.UUID) ([]model.CustomerAccount, error)
 }
 
 // EventPublisher defines the port for publishing domain events.
@@ -0,0 +1,128 @@
+// Package client implements the account service's clients of other services.
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	ledgerv1 "github.com/bibbank/bib/api/gen/go/bib/ledger/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/account-service/internal/domain/port"
+)
+
+// Compile-time interface check.
+var _ port.LedgerClient = (*LedgerGRPCClient)(nil)
+
+// Config configures the ledger client.
+type Config struct {
+	// MaxRetries is the maximum number of retry attempts on transient failures.
+	MaxRetries int
+	// RetryBackoff is the base backoff between retries, doubled on each attempt.
+	RetryBackoff time.Duration
+	// Timeout bounds each call.
+	Timeout time.Duration
+}
+
+// LedgerGRPCClient implements the LedgerClient port against the ledger
+// service.
+type LedgerGRPCClient struct {
+	conn   grpc.ClientConnInterface
+	signer *auth.JWTService
+	config Config
+}
+
+// NewLedgerGRPCClient creates a new LedgerGRPCClient. signer may be nil, in
+// which case the caller's token, if any, is forwarded.
+func NewLedgerGRPCClient(conn grpc.ClientConnInterface, signer *auth.JWTService, config Config) *LedgerGRPCClient {
+	return &LedgerGRPCClient{conn: conn, signer: signer, config: config}
+}
+
+// CreateLedgerAccount opens the ledger account. The ledger opens an account
+// once however often it is asked, so transient failures are retried.
+func (c *LedgerGRPCClient) CreateLedgerAccount(ctx context.Context, tenantID uuid.UUID, accountCode string, currency string) error {
+	ctx, err := c.withTenantToken(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+
+	req := &ledgerv1.OpenAccountRequest{AccountCode: accountCode, Currency: currency}
+	var resp ledgerv1.OpenAccountResponse
+	if err := c.invokeWithRetry(ctx, ledgerv1.LedgerService_OpenAccount_FullMethodName, req, &resp); err != nil {
+		return fmt.Errorf("ledger OpenAccount: %w", err)
+	}
+	return nil
+}
+
+// invokeWithRetry calls a ledger method, retrying transient failures with
+// exponential backoff.
+func (c *LedgerGRPCClient) invokeWithRetry(ctx context.Context, method string, req, resp any) error {
+	var lastErr error
+	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			wait := c.config.RetryBackoff * (1 << uint(attempt-1)) //nolint:gosec // retry count is small
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+
+		err := c.invoke(ctx, method, req, resp)
+		if err == nil {
+			return nil
+		}
+		if !isTransient(err) {
+			return err
+		}
+		lastErr = err
+	}
+	return fmt.Errorf("exhausted %d retries: %w", c.config.MaxRetries, lastErr)
+}
+
+func (c *LedgerGRPCClient) invoke(ctx context.Context, method string, req, resp any) error {
+	if c.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.config.Timeout)
+		defer cancel()
+	}
+	return c.conn.Invoke(ctx, method, req, resp)
+}
+
+// withTenantToken authorizes outgoing calls for the tenant. Onboarding runs
+// from Kafka events and the saga recovery loop, where there is no caller
+// token to forward, so the account service mints a short-lived operator
+// token signed with the platform's issuing key. Without a signer the
+// caller's token, if any, is forwarded instead.
+func (c *LedgerGRPCClient) withTenantToken(ctx context.Context, tenantID uuid.UUID) (context.Context, error) {
+	if c.signer == nil {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if ok {
+			if values := md.Get("authorization"); len(values) > 0 {
+				return metadata.AppendToOutgoingContext(ctx, "authorization", values[0]), nil
+			}
+		}
+		return ctx, nil
+	}
+	token, err := c.signer.GenerateToken(uuid.Nil, tenantID, []string{auth.RoleOperator})
+	if err != nil {
+		return nil, fmt.Errorf("mint service token: %w", err)
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token), nil
+}
+
+// isTransient reports whether a failed call may succeed if retried.
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,109 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/account-service/internal/infrastructure/client"
+)
+
+// fakeConn answers every call with an opened account and fails the first
+// failures calls with failWith.
+type fakeConn struct {
+	failWith error
+	methods  []string
+	requests []map[string]any
+	authz    []string
+	failures int
+}
+
+func (c *fakeConn) Invoke(ctx context.Context, method string, args, reply any, _ ...grpc.CallOption) error {
+	c.methods = append(c.methods, method)
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		c.authz = append(c.authz, md.Get("authorization")...)
+	}
+	if c.failures > 0 {
+		c.failures--
+		return c.failWith
+	}
+
+	raw, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(args.(proto.Message))
+	if err != nil {
+		return err
+	}
+	var req map[string]any
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return err
+	}
+	c.requests = append(c.requests, req)
+	return protojson.Unmarshal([]byte(`{"account_code":"2000-123","currency":"USD","balance":"0"}`), reply.(proto.Message))
+}
+
+func (c *fakeConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not supported")
+}
+
+var testConfig = client.Config{MaxRetries: 2, RetryBackoff: time.Millisecond, Timeout: time.Second}
+
+func TestLedgerGRPCClient_CreateLedgerAccount(t *testing.T) {
+	conn := &fakeConn{}
+	c := client.NewLedgerGRPCClient(conn, nil, testConfig)
+
+	err := c.CreateLedgerAccount(context.Background(), uuid.New(), "2000-123", "USD")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/bib.ledger.v1.LedgerService/OpenAccount"}, conn.methods)
+	require.Len(t, conn.requests, 1)
+	assert.Equal(t, "2000-123", conn.requests[0]["account_code"])
+	assert.Equal(t, "USD", conn.requests[0]["currency"])
+}
+
+func TestLedgerGRPCClient_RetriesTransientFailures(t *testing.T) {
+	conn := &fakeConn{failWith: status.Error(codes.Unavailable, "ledger down"), failures: 2}
+	c := client.NewLedgerGRPCClient(conn, nil, testConfig)
+
+	require.NoError(t, c.CreateLedgerAccount(context.Background(), uuid.New(), "2000-123", "USD"))
+	assert.Len(t, conn.methods, 3)
+}
+
+func TestLedgerGRPCClient_DoesNotRetryRejections(t *testing.T) {
+	conn := &fakeConn{failWith: status.Error(codes.InvalidArgument, "invalid account code"), failures: 1}
+	c := client.NewLedgerGRPCClient(conn, nil, testConfig)
+
+	err := c.CreateLedgerAccount(context.Background(), uuid.New(), "bad", "USD")
+
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Len(t, conn.methods, 1)
+}
+
+func TestLedgerGRPCClient_SignsTenantToken(t *testing.T) {
+	signer, err := auth.NewJWTService(auth.JWTConfig{Secret: "test-secret", Issuer: "bib-gateway", Expiration: time.Minute})
+	require.NoError(t, err)
+	conn := &fakeConn{}
+	tenantID := uuid.New()
+	c := client.NewLedgerGRPCClient(conn, signer, testConfig)
+
+	require.NoError(t, c.CreateLedgerAccount(context.Background(), tenantID, "2000-123", "USD"))
+
+	require.Len(t, conn.authz, 1)
+	claims, err := signer.ValidateToken(strings.TrimPrefix(conn.authz[0], "Bearer "))
+	require.NoError(t, err)
+	assert.Equal(t, tenantID, claims.TenantID)
+	assert.True(t, claims.HasRole(auth.RoleOperator))
+}
@@ -4,25 +4,37 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/pkg/crypto"
 )
 
 // Config holds all configuration for the account service.
 type Config struct {
+	PII         crypto.KMSConfig
 	Database    DatabaseConfig
 	ServiceName string
 	Kafka       KafkaConfig
+	Onboarding  OnboardingConfig
+	Outbox      OutboxConfig
 	GRPCPort    int
 	HTTPPort    int
 }
 
-// DatabaseConfig holds PostgreSQL connection settings.
+// DatabaseConfig holds PostgreSQL connection settings. Account listings
+// are read from ReplicaHosts when any is healthy and no more than
+// MaxReplicaLag behind the primary.
 type DatabaseConfig struct {
-	Host     string
-	User     string
-	Password string
-	Database string
-	SSLMode  string
-	Port     int
+	Host                 string
+	User                 string
+	Password             string
+	Database             string
+	SSLMode              string
+	ReplicaHosts         []string
+	Port                 int
+	MaxReplicaLag        time.Duration
+	ReplicaCheckInterval time.Duration
 }
 
 // DSN returns the PostgreSQL data source name.
@@ -35,7 +47,25 @@ func (c DatabaseConfig) DSN() string {
 
 // KafkaConfig holds Kafka connection settings.
 type KafkaConfig struct {
-	Brokers []string
+	ConsumerGroup string
+	Brokers       []string
+}
+
+// OnboardingConfig configures the saga that opens an account, and its
+// ledger account, for each customer whose first identity verification is
+// approved. Without LedgerGRPCAddr no ledger account is opened.
+type OnboardingConfig struct {
+	LedgerGRPCAddr   string
+	SigningKeyFile   string
+	AccountType      string
+	Currency         string
+	StepTimeout      time.Duration
+	RecoveryInterval time.Duration
+	StaleAfter       time.Duration
+	RetryBackoff     time.Duration
+	MaxAttempts      int
+	MaxRetries       int
+	Enabled          bool
 }
 
 // Validate checks required configuration values.
@@ -45,6 +75,12 @@ func (c Config) Validate() {
 	}
 }
 
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
 // Load reads configuration from environment variables with defaults.
 func Load() Config {
 	return Config{
@@ -58,9 +94,39 @@ func Load() Config {
 			Password: getEnv("DB_PASSWORD", ""),
 			Database: getEnv("DB_NAME", "bib_account"),
 			SSLMode:  getEnv("DB_SSLMODE", "require"),
+
+			ReplicaHosts:         getEnvList("DB_REPLICA_HOSTS"),
+			MaxReplicaLag:        getEnvDuration("DB_MAX_REPLICA_LAG", 5*time.Second),
+			ReplicaCheckInterval: getEnvDuration("DB_REPLICA_CHECK_INTERVAL", 10*time.Second),
 		},
 		Kafka: KafkaConfig{
-			Brokers: []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+			Brokers:       []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+			ConsumerGroup: getEnv("KAFKA_CONSUMER_GROUP", "account-service"),
+		},
+		Onboarding: OnboardingConfig{
+			Enabled:          getEnvBool("ONBOARDING_ENABLED", true),
+			LedgerGRPCAddr:   getEnv("LEDGER_SERVICE_ADDR", ""),
+			SigningKeyFile:   getEnv("ONBOARDING_SIGNING_KEY_FILE", ""),
+			AccountType:      getEnv("ONBOARDING_ACCOUNT_TYPE", "CHECKING"),
+			Currency:         getEnv("ONBOARDING_CURRENCY", "USD"),
+			StepTimeout:      getEnvDuration("ONBOARDING_STEP_TIMEOUT", 30*time.Second),
+			RecoveryInterval: getEnvDuration("ONBOARDING_RECOVERY_INTERVAL", time.Minute),
+			StaleAfter:       getEnvDuration("ONBOARDING_STALE_AFTER", 5*time.Minute),
+			MaxAttempts:      getEnvInt("ONBOARDING_MAX_ATTEMPTS", 10),
+			MaxRetries:       getEnvInt("ONBOARDING_MAX_RETRIES", 3),
+			RetryBackoff:     getEnvDuration("ONBOARDING_RETRY_BACKOFF", 200*time.Millisecond),
+		},
+		PII: crypto.KMSConfig{
+			MasterKey:    getEnv("PII_MASTER_KEY", ""),
+			AWSKeyID:     getEnv("PII_KMS_KEY_ID", ""),
+			AWSEndpoint:  getEnv("PII_KMS_ENDPOINT", ""),
+			AWSRegion:    getEnv("PII_KMS_REGION", "us-east-1"),
+			AWSAccessKey: getEnv("PII_KMS_ACCESS_KEY", ""),
+			AWSSecretKey: getEnv("PII_KMS_SECRET_KEY", ""),
+		},
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
 		},
 	}
 }
@@ -72,6 +138,16 @@ func getEnv(key, defaultVal string) string {
 	return defaultVal
 }
 
+func getEnvList(key string) []string {
+	var list []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
 func getEnvInt(key string, defaultVal int) int {
 	if val := os.Getenv(key); val != "" {
 		if i, err := strconv.Atoi(val); err == nil {
@@ -80,3 +156,49 @@ func getEnvInt(key string, defaultVal int) int {
 	}
 	return defaultVal
 }
+
+func getEnvBool(key string, defaultVal bool) bool {
+	if val := os.Getenv(key); val != "" {
+		if b, err := strconv.ParseBool(val); err == nil {
+			return b
+		}
+	}
+	return defaultVal
+}
+
+func getEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	if val := os.Getenv(key); val != "" {
+		if d, err := time.ParseDuration(val); err == nil {
+			return d
+		}
+	}
+	return defaultVal
+}
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
@@ -10,18 +10,33 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/bibbank/bib/pkg/crypto"
+	pgpkg "github.com/bibbank/bib/pkg/postgres"
 	"github.com/bibbank/bib/services/account-service/internal/domain/model"
 	"github.com/bibbank/bib/services/account-service/internal/domain/valueobject"
 )
 
 // AccountRepository implements port.AccountRepository using PostgreSQL.
+// Holder emails are stored encrypted under the tenant's data key.
+//
+// Listings are served by reader, which may be a read replica; a listing's
+// count and page are read from the same one. Lookups of a single account
+// stay on the primary: callers load an account to modify it, and a replica
+// may not have their last write yet.
 type AccountRepository struct {
-	pool *pgxpool.Pool
+	pool   *pgxpool.Pool
+	reader pgpkg.Reader
+	pii    *crypto.Cipher
 }
 
 // NewAccountRepository creates a new PostgreSQL-backed AccountRepository.
-func NewAccountRepository(pool *pgxpool.Pool) *AccountRepository {
-	return &AccountRepository{pool: pool}
+// With a nil reader listings are read from pool. With a nil pii cipher
+// holder emails are stored in plaintext.
+func NewAccountRepository(pool *pgxpool.Pool, reader pgpkg.Reader, pii *crypto.Cipher) *AccountRepository {
+	if reader == nil && pool != nil {
+		reader = pgpkg.PoolReader(pool)
+	}
+	return &AccountRepository{pool: pool, reader: reader, pii: pii}
 }
 
 // Save persists a CustomerAccount using an upsert with optimistic concurrency control.
@@ -37,11 +52,12 @@ func (r *AccountRepository) Save(ctx context.Context, account model.CustomerAcco
 	const upsertAccountSQL = `
 		INSERT INTO customer_accounts (
 			id, tenant_id, account_number, account_type, status,
-			currency, ledger_account_code, version, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			currency, ledger_account_code, freeze_reason, version, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		ON CONFLICT (id) DO UPDATE SET
 			status = EXCLUDED.status,
 			ledger_account_code = EXCLUDED.ledger_account_code,
+			freeze_reason = EXCLUDED.freeze_reason,
 			version = EXCLUDED.version,
 			updated_at = EXCLUDED.updated_at
 		WHERE customer_accounts.version = EXCLUDED.version - 1
@@ -55,6 +71,7 @@ func (r *AccountRepository) Save(ctx context.Context, account model.CustomerAcco
 		string(account.Status()),
 		account.Currency(),
 		account.LedgerAccountCode(),
+		account.FreezeReason(),
 		account.Version(),
 		account.CreatedAt(),
 		account.UpdatedAt(),
@@ -90,7 +107,7 @@ func (r *AccountRepository) Save(ctx context.Context, account model.CustomerAcco
 		account.ID(),
 		holder.FirstName(),
 		holder.LastName(),
-		holder.Email(),
+		r.pii.Arg(ctx, account.TenantID(), holder.Email()),
 		identityVerificationID,
 	)
 	if err != nil {
@@ -105,15 +122,17 @@ func (r *AccountRepository) Save(ctx context.Context, account model.CustomerAcco
 		}
 
 		const insertOutboxSQL = `
-			INSERT INTO outbox (aggregate_id, aggregate_type, event_type, payload)
-			VALUES ($1, $2, $3, $4)
+			INSERT INTO outbox (id, aggregate_id, aggregate_type, event_type, payload, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
 		`
 
 		_, err = tx.Exec(ctx, insertOutboxSQL,
+			evt.EventID(),
 			account.ID(),
 			"CustomerAccount",
 			evt.EventType(),
 			payload,
+			evt.OccurredAt(),
 		)
 		if err != nil {
 			return fmt.Errorf("failed to insert outbox event: %w", err)
@@ -132,7 +151,7 @@ func (r *AccountRepository) FindByID(ctx context.Context, id uuid.UUID) (model.C
 	const query = `
 		SELECT
 			ca.id, ca.tenant_id, ca.account_number, ca.account_type, ca.status,
-			ca.currency, ca.ledger_account_code, ca.version, ca.created_at, ca.updated_at,
+			ca.currency, ca.ledger_account_code, ca.freeze_reason, ca.version, ca.created_at, ca.updated_at,
 			ah.id, ah.first_name, ah.last_name, ah.email, ah.identity_verification_id
 		FROM customer_accounts ca
 		JOIN account_holders ah ON ah.account_id = ca.id
@@ -147,7 +166,7 @@ func (r *AccountRepository) FindByAccountNumber(ctx context.Context, number valu
 	const query = `
 		SELECT
 			ca.id, ca.tenant_id, ca.account_number, ca.account_type, ca.status,
-			ca.currency, ca.ledger_account_code, ca.version, ca.created_at, ca.updated_at,
+			ca.currency, ca.ledger_account_code, ca.freeze_reason, ca.version, ca.created_at, ca.updated_at,
 			ah.id, ah.first_name, ah.last_name, ah.email, ah.identity_verification_id
 		FROM customer_accounts ca
 		JOIN account_holders ah ON ah.account_id = ca.id
@@ -163,7 +182,7 @@ func (r *AccountRepository) ListByTenant(ctx context.Context, tenantID uuid.UUID
 	const listQuery = `
 		SELECT
 			ca.id, ca.tenant_id, ca.account_number, ca.account_type, ca.status,
-			ca.currency, ca.ledger_account_code, ca.version, ca.created_at, ca.updated_at,
+			ca.currency, ca.ledger_account_code, ca.freeze_reason, ca.version, ca.created_at, ca.updated_at,
 			ah.id, ah.first_name, ah.last_name, ah.email, ah.identity_verification_id
 		FROM customer_accounts ca
 		JOIN account_holders ah ON ah.account_id = ca.id
@@ -172,12 +191,18 @@ func (r *AccountRepository) ListByTenant(ctx context.Context, tenantID uuid.UUID
 		LIMIT $2 OFFSET $3
 	`
 
-	var total int
-	if err := r.pool.QueryRow(ctx, countQuery, tenantID).Scan(&total); err != nil {
-		return nil, 0, fmt.Errorf("failed to count accounts: %w", err)
-	}
-
-	accounts, err := r.scanAccounts(ctx, listQuery, tenantID, limit, offset)
+	var (
+		accounts []model.CustomerAccount
+		total    int
+	)
+	err := r.reader.Read(ctx, func(q pgpkg.Querier) error {
+		if err := q.QueryRow(ctx, countQuery, tenantID).Scan(&total); err != nil {
+			return fmt.Errorf("failed to count accounts: %w", err)
+		}
+		var err error
+		accounts, err = r.scanAccounts(ctx, q, listQuery, tenantID, limit, offset)
+		return err
+	})
 	if err != nil {
 		return nil, 0, err
 	}
@@ -196,7 +221,7 @@ func (r *AccountRepository) ListByHolder(ctx context.Context, holderID uuid.UUID
 	const listQuery = `
 		SELECT
 			ca.id, ca.tenant_id, ca.account_number, ca.account_type, ca.status,
-			ca.currency, ca.ledger_account_code, ca.version, ca.created_at, ca.updated_at,
+			ca.currency, ca.ledger_account_code, ca.freeze_reason, ca.version, ca.created_at, ca.updated_at,
 			ah.id, ah.first_name, ah.last_name, ah.email, ah.identity_verification_id
 		FROM customer_accounts ca
 		JOIN account_holders ah ON ah.account_id = ca.id
@@ -205,12 +230,18 @@ func (r *AccountRepository) ListByHolder(ctx context.Context, holderID uuid.UUID
 		LIMIT $2 OFFSET $3
 	`
 
-	var total int
-	if err := r.pool.QueryRow(ctx, countQuery, holderID).Scan(&total); err != nil {
-		return nil, 0, fmt.Errorf("failed to count accounts: %w", err)
-	}
-
-	accounts, err := r.scanAccounts(ctx, listQuery, holderID, limit, offset)
+	var (
+		accounts []model.CustomerAccount
+		total    int
+	)
+	err := r.reader.Read(ctx, func(q pgpkg.Querier) error {
+		if err := q.QueryRow(ctx, countQuery, holderID).Scan(&total); err != nil {
+			return fmt.Errorf("failed to count accounts: %w", err)
+		}
+		var err error
+		accounts, err = r.scanAccounts(ctx, q, listQuery, holderID, limit, offset)
+		return err
+	})
 	if err != nil {
 		return nil, 0, err
 	}
@@ -218,6 +249,23 @@ func (r *AccountRepository) ListByHolder(ctx context.Context, holderID uuid.UUID
 	return accounts, total, nil
 }
 
+// ListByIdentityVerification retrieves all accounts whose holder is linked to
+// the given identity verification.
+func (r *AccountRepository) ListByIdentityVerification(ctx context.Context, verificationID uuid.UUID) ([]model.CustomerAccount, error) {
+	const query = `
+		SELECT
+			ca.id, ca.tenant_id, ca.account_number, ca.account_type, ca.status,
+			ca.currency, ca.ledger_account_code, ca.freeze_reason, ca.version, ca.created_at, ca.updated_at,
+			ah.id, ah.first_name, ah.last_name, ah.email, ah.identity_verification_id
+		FROM customer_accounts ca
+		JOIN account_holders ah ON ah.account_id = ca.id
+		WHERE ah.identity_verification_id = $1
+		ORDER BY ca.created_at
+	`
+
+	return r.scanAccounts(ctx, r.pool, query, verificationID)
+}
+
 // scanAccount scans a single account row from a query result.
 func (r *AccountRepository) scanAccount(ctx context.Context, query string, args ...interface{}) (model.CustomerAccount, error) {
 	row := r.pool.QueryRow(ctx, query, args...)
@@ -230,6 +278,7 @@ func (r *AccountRepository) scanAccount(ctx context.Context, query string, args
 		statusStr              string
 		currency               string
 		ledgerAccountCode      string
+		freezeReason           string
 		version                int
 		createdAt              time.Time
 		updatedAt              time.Time
@@ -242,8 +291,8 @@ func (r *AccountRepository) scanAccount(ctx context.Context, query string, args
 
 	err := row.Scan(
 		&id, &tenantID, &accountNumberStr, &accountTypeStr, &statusStr,
-		&currency, &ledgerAccountCode, &version, &createdAt, &updatedAt,
-		&holderID, &firstName, &lastName, &email, &identityVerificationID,
+		&currency, &ledgerAccountCode, &freezeReason, &version, &createdAt, &updatedAt,
+		&holderID, &firstName, &lastName, r.pii.Dest(ctx, &email), &identityVerificationID,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -254,14 +303,14 @@ func (r *AccountRepository) scanAccount(ctx context.Context, query string, args
 
 	return reconstructAccount(
 		id, tenantID, accountNumberStr, accountTypeStr, statusStr,
-		currency, ledgerAccountCode, version, createdAt, updatedAt,
+		currency, ledgerAccountCode, freezeReason, version, createdAt, updatedAt,
 		holderID, firstName, lastName, email, identityVerificationID,
 	)
 }
 
-// scanAccounts scans multiple account rows from a query result.
-func (r *AccountRepository) scanAccounts(ctx context.Context, query string, args ...interface{}) ([]model.CustomerAccount, error) {
-	rows, err := r.pool.Query(ctx, query, args...)
+// scanAccounts scans multiple account rows from a query run on q.
+func (r *AccountRepository) scanAccounts(ctx context.Context, q pgpkg.Querier, query string, args ...interface{}) ([]model.CustomerAccount, error) {
+	rows, err := q.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query accounts: %w", err)
 	}
@@ -277,6 +326,7 @@ func (r *AccountRepository) scanAccounts(ctx context.Context, query string, args
 			statusStr              string
 			currency               string
 			ledgerAccountCode      string
+			freezeReason           string
 			version                int
 			createdAt              time.Time
 			updatedAt              time.Time
@@ -289,8 +339,8 @@ func (r *AccountRepository) scanAccounts(ctx context.Context, query string, args
 
 		err := rows.Scan(
 			&id, &tenantID, &accountNumberStr, &accountTypeStr, &statusStr,
-			&currency, &ledgerAccountCode, &version, &createdAt, &updatedAt,
-			&holderID, &firstName, &lastName, &email, &identityVerificationID,
+			&currency, &ledgerAccountCode, &freezeReason, &version, &createdAt, &updatedAt,
+			&holderID, &firstName, &lastName, r.pii.Dest(ctx, &email), &identityVerificationID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan account row: %w", err)
@@ -298,7 +348,7 @@ func (r *AccountRepository) scanAccounts(ctx context.Context, query string, args
 
 		account, err := reconstructAccount(
 			id, tenantID, accountNumberStr, accountTypeStr, statusStr,
-			currency, ledgerAccountCode, version, createdAt, updatedAt,
+			currency, ledgerAccountCode, freezeReason, version, createdAt, updatedAt,
 			holderID, firstName, lastName, email, identityVerificationID,
 		)
 		if err != nil {
@@ -319,7 +369,7 @@ func (r *AccountRepository) scanAccounts(ctx context.Context, query string, args
 func reconstructAccount(
 	id, tenantID uuid.UUID,
 	accountNumberStr, accountTypeStr, statusStr string,
-	currency, ledgerAccountCode string,
+	currency, ledgerAccountCode, freezeReason string,
 	version int,
 	createdAt, updatedAt time.Time,
 	holderID uuid.UUID,
@@ -358,6 +408,7 @@ func reconstructAccount(
 		currency,
 		holder,
 		ledgerAccountCode,
+		freezeReason,
 		version,
 		createdAt,
 		updatedAt,
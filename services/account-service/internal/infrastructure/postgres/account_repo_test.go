@@ -25,7 +25,7 @@ func TestReconstructAccount(t *testing.T) {
 		account, err := reconstructAccount(
 			id, tenantID,
 			"BIB-ABCD-1234-WXYZ", "CHECKING", "ACTIVE",
-			"USD", "2000-100",
+			"USD", "2000-100", "",
 			2, now, now,
 			holderID, "Jane", "Smith", "jane@example.com", &verificationID,
 		)
@@ -57,7 +57,7 @@ func TestReconstructAccount(t *testing.T) {
 		account, err := reconstructAccount(
 			id, tenantID,
 			"BIB-ABCD-1234-WXYZ", "SAVINGS", "PENDING",
-			"EUR", "2100-200",
+			"EUR", "2100-200", "",
 			1, now, now,
 			holderID, "John", "Doe", "john@example.com", nil,
 		)
@@ -77,7 +77,7 @@ func TestReconstructAccount(t *testing.T) {
 		_, err := reconstructAccount(
 			id, tenantID,
 			"INVALID-NUMBER", "CHECKING", "ACTIVE",
-			"USD", "2000-100",
+			"USD", "2000-100", "",
 			1, now, now,
 			holderID, "Jane", "Smith", "jane@example.com", nil,
 		)
@@ -95,7 +95,7 @@ func TestReconstructAccount(t *testing.T) {
 		_, err := reconstructAccount(
 			id, tenantID,
 			"BIB-ABCD-1234-WXYZ", "INVALID_TYPE", "ACTIVE",
-			"USD", "2000-100",
+			"USD", "2000-100", "",
 			1, now, now,
 			holderID, "Jane", "Smith", "jane@example.com", nil,
 		)
@@ -125,7 +125,7 @@ func TestReconstructAccount(t *testing.T) {
 				account, err := reconstructAccount(
 					id, tenantID,
 					"BIB-ABCD-1234-WXYZ", "CHECKING", tc.input,
-					"USD", "2000-100",
+					"USD", "2000-100", "",
 					1, now, now,
 					holderID, "Jane", "Smith", "jane@example.com", nil,
 				)
@@ -149,7 +149,7 @@ func TestReconstructAccount(t *testing.T) {
 				account, err := reconstructAccount(
 					id, tenantID,
 					"BIB-ABCD-1234-WXYZ", at, "ACTIVE",
-					"USD", "2000-100",
+					"USD", "2000-100", "",
 					1, now, now,
 					holderID, "Jane", "Smith", "jane@example.com", nil,
 				)
@@ -164,9 +164,10 @@ func TestReconstructAccount(t *testing.T) {
 // TestNewAccountRepository tests the constructor.
 func TestNewAccountRepository(t *testing.T) {
 	t.Run("creates repository with nil pool", func(t *testing.T) {
-		repo := NewAccountRepository(nil)
+		repo := NewAccountRepository(nil, nil, nil)
 		assert.NotNil(t, repo)
 		assert.Nil(t, repo.pool)
+		assert.Nil(t, repo.reader)
 	})
 }
 
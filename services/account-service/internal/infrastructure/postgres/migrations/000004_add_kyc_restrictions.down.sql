@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_account_holders_identity_verification;
+
+ALTER TABLE customer_accounts DROP COLUMN IF EXISTS freeze_reason;
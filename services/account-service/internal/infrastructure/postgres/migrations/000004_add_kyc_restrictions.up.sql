@@ -0,0 +1,4 @@
+-- Freeze reason lets KYC-lapse freezes be lifted automatically on re-verification.
+ALTER TABLE customer_accounts ADD COLUMN freeze_reason TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX idx_account_holders_identity_verification ON account_holders (identity_verification_id);
@@ -0,0 +1,4 @@
+-- Sealed values are longer than the old column and unreadable without
+-- data_keys: decrypt them before rolling back.
+ALTER TABLE account_holders ALTER COLUMN email TYPE VARCHAR(255);
+DROP TABLE IF EXISTS data_keys;
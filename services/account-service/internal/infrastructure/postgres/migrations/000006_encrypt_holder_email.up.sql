@@ -0,0 +1,13 @@
+-- Wrapped per-tenant data keys personal data is encrypted under (see
+-- pkg/crypto). Not subject to row-level security: a value names the tenant
+-- whose key opens it.
+CREATE TABLE IF NOT EXISTS data_keys (
+    tenant_id UUID NOT NULL,
+    version INT NOT NULL,
+    wrapped_key BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, version)
+);
+
+-- Holder emails are stored encrypted, which is longer than the address.
+ALTER TABLE account_holders ALTER COLUMN email TYPE TEXT;
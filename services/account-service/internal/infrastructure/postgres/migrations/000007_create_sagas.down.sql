@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_sagas_failed;
+DROP INDEX IF EXISTS idx_sagas_stalled;
+DROP INDEX IF EXISTS uq_sagas_correlation;
+DROP TABLE IF EXISTS sagas;
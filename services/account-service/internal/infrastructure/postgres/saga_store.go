@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/pkg/saga"
+)
+
+// Compile-time interface check.
+var _ saga.Store = (*SagaStore)(nil)
+
+// uniqueViolation is the PostgreSQL error code for a unique constraint violation.
+const uniqueViolation = "23505"
+
+const sagaColumns = `id, saga_type, correlation_id, status, step_index,
+	completed_steps, data, failed_step, failure_reason, last_error,
+	attempts, version, created_at, updated_at`
+
+// SagaStore implements saga.Store using PostgreSQL.
+type SagaStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewSagaStore creates a new PostgreSQL-backed SagaStore.
+func NewSagaStore(pool *pgxpool.Pool) *SagaStore {
+	return &SagaStore{pool: pool}
+}
+
+func (s *SagaStore) Create(ctx context.Context, state saga.State) error {
+	completedSteps, data, err := marshalSagaFields(state)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.pool.Exec(ctx, `
+		INSERT INTO sagas (`+sagaColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	`,
+		state.ID, state.Type, state.CorrelationID, string(state.Status), state.StepIndex,
+		completedSteps, data, state.FailedStep, state.FailureReason, state.LastError,
+		state.Attempts, state.Version, state.CreatedAt, state.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return saga.ErrAlreadyExists
+		}
+		return fmt.Errorf("insert saga: %w", err)
+	}
+	return nil
+}
+
+func (s *SagaStore) Update(ctx context.Context, state saga.State) error {
+	completedSteps, data, err := marshalSagaFields(state)
+	if err != nil {
+		return err
+	}
+
+	tag, err := s.pool.Exec(ctx, `
+		UPDATE sagas SET
+			status = $2, step_index = $3, completed_steps = $4, data = $5,
+			failed_step = $6, failure_reason = $7, last_error = $8,
+			attempts = $9, version = $10, updated_at = $11
+		WHERE id = $1 AND version = $10 - 1
+	`,
+		state.ID, string(state.Status), state.StepIndex, completedSteps, data,
+		state.FailedStep, state.FailureReason, state.LastError,
+		state.Attempts, state.Version, state.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("update saga: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return saga.ErrVersionConflict
+	}
+	return nil
+}
+
+func (s *SagaStore) Find(ctx context.Context, id uuid.UUID) (saga.State, error) {
+	return scanSaga(s.pool.QueryRow(ctx, `SELECT `+sagaColumns+` FROM sagas WHERE id = $1`, id))
+}
+
+func (s *SagaStore) FindByCorrelation(ctx context.Context, sagaType, correlationID string) (saga.State, error) {
+	return scanSaga(s.pool.QueryRow(ctx, `
+		SELECT `+sagaColumns+` FROM sagas WHERE saga_type = $1 AND correlation_id = $2
+	`, sagaType, correlationID))
+}
+
+func (s *SagaStore) FindStalled(ctx context.Context, before time.Time, limit int) ([]saga.State, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT `+sagaColumns+` FROM sagas
+		WHERE status IN ('RUNNING', 'COMPENSATING') AND updated_at < $1
+		ORDER BY updated_at
+		LIMIT $2
+	`, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query stalled sagas: %w", err)
+	}
+	defer rows.Close()
+
+	var states []saga.State
+	for rows.Next() {
+		state, scanErr := scanSaga(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		states = append(states, state)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate stalled sagas: %w", err)
+	}
+	return states, nil
+}
+
+func (s *SagaStore) FindFailed(ctx context.Context, limit int) ([]saga.State, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT `+sagaColumns+` FROM sagas
+		WHERE status = 'FAILED'
+		ORDER BY updated_at
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query failed sagas: %w", err)
+	}
+	defer rows.Close()
+
+	var states []saga.State
+	for rows.Next() {
+		state, scanErr := scanSaga(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		states = append(states, state)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate failed sagas: %w", err)
+	}
+	return states, nil
+}
+
+func scanSaga(row pgx.Row) (saga.State, error) {
+	var (
+		state          saga.State
+		status         string
+		completedSteps []byte
+		data           []byte
+	)
+	err := row.Scan(
+		&state.ID, &state.Type, &state.CorrelationID, &status, &state.StepIndex,
+		&completedSteps, &data, &state.FailedStep, &state.FailureReason, &state.LastError,
+		&state.Attempts, &state.Version, &state.CreatedAt, &state.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return saga.State{}, saga.ErrNotFound
+		}
+		return saga.State{}, fmt.Errorf("scan saga: %w", err)
+	}
+	state.Status = saga.Status(status)
+	if err := json.Unmarshal(completedSteps, &state.CompletedSteps); err != nil {
+		return saga.State{}, fmt.Errorf("unmarshal saga completed steps: %w", err)
+	}
+	if err := json.Unmarshal(data, &state.Data); err != nil {
+		return saga.State{}, fmt.Errorf("unmarshal saga data: %w", err)
+	}
+	if state.Data == nil {
+		state.Data = make(map[string]string)
+	}
+	return state, nil
+}
+
+func marshalSagaFields(state saga.State) (completedSteps, data []byte, err error) {
+	steps := state.CompletedSteps
+	if steps == nil {
+		steps = []string{}
+	}
+	completedSteps, err = json.Marshal(steps)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal saga completed steps: %w", err)
+	}
+	data, err = json.Marshal(state.Data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal saga data: %w", err)
+	}
+	return completedSteps, data, nil
+}
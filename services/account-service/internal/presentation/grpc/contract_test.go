@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	accountv1 "github.com/bibbank/bib/api/gen/go/bib/account/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/account-service/internal/domain/model"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	h, repo := buildTestHandler()
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { accountv1.RegisterAccountServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-account-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) {
+			repo.findByIDFunc, repo.listFunc = nil, nil
+		},
+		"an active account exists": func(*testing.T) {
+			account := makeActiveAccount(contractTenantID)
+			repo.findByIDFunc = func(context.Context, uuid.UUID) (model.CustomerAccount, error) {
+				return account, nil
+			}
+			repo.listFunc = func(context.Context, uuid.UUID, int, int) ([]model.CustomerAccount, int, error) {
+				return []model.CustomerAccount{account}, 1, nil
+			}
+		},
+	})
+}
@@ -5,11 +5,16 @@ import (
 	"fmt"
 	"log/slog"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	accountv1 "github.com/bibbank/bib/api/gen/go/bib/account/v1"
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
 	"github.com/bibbank/bib/pkg/auth"
 	"github.com/bibbank/bib/services/account-service/internal/application/dto"
 	"github.com/bibbank/bib/services/account-service/internal/application/usecase"
@@ -41,11 +46,11 @@ func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
 }
 
 // Compile-time assertion that AccountHandler implements AccountServiceServer.
-var _ AccountServiceServer = (*AccountHandler)(nil)
+var _ accountv1.AccountServiceServer = (*AccountHandler)(nil)
 
 // AccountHandler implements the gRPC AccountServiceServer interface.
 type AccountHandler struct {
-	UnimplementedAccountServiceServer
+	accountv1.UnimplementedAccountServiceServer
 	openAccount   *usecase.OpenAccountUseCase
 	getAccount    *usecase.GetAccountUseCase
 	freezeAccount *usecase.FreezeAccountUseCase
@@ -74,82 +79,8 @@ func NewAccountHandler(
 		logger: logger}
 }
 
-// OpenAccountRequest represents the proto OpenAccountRequest message.
-type OpenAccountRequest struct {
-	TenantID               string `json:"tenant_id"`
-	AccountType            string `json:"account_type"`
-	Currency               string `json:"currency"`
-	HolderFirstName        string `json:"holder_first_name"`
-	HolderLastName         string `json:"holder_last_name"`
-	HolderEmail            string `json:"holder_email"`
-	IdentityVerificationID string `json:"identity_verification_id"`
-}
-
-// OpenAccountResponse represents the proto OpenAccountResponse message.
-type OpenAccountResponse struct {
-	AccountID         string `json:"account_id"`
-	AccountNumber     string `json:"account_number"`
-	Status            string `json:"status"`
-	LedgerAccountCode string `json:"ledger_account_code"`
-}
-
-// GetAccountRequest represents the proto GetAccountRequest message.
-type GetAccountRequest struct {
-	ID string `json:"account_id"`
-}
-
-// GetAccountResponse represents the proto GetAccountResponse message (flat, matching gateway).
-type GetAccountResponse = AccountMsg
-
-// FreezeAccountRequest represents the proto FreezeAccountRequest message.
-type FreezeAccountRequest struct {
-	ID     string `json:"account_id"`
-	Reason string `json:"reason"`
-}
-
-// FreezeAccountResponse represents the proto FreezeAccountResponse message (flat, matching gateway).
-type FreezeAccountResponse = AccountMsg
-
-// CloseAccountRequest represents the proto CloseAccountRequest message.
-type CloseAccountRequest struct {
-	ID     string `json:"account_id"`
-	Reason string `json:"reason"`
-}
-
-// CloseAccountResponse represents the proto CloseAccountResponse message (flat, matching gateway).
-type CloseAccountResponse = AccountMsg
-
-// ListAccountsRequest represents the proto ListAccountsRequest message.
-type ListAccountsRequest struct {
-	TenantID  string `json:"tenant_id"`
-	HolderID  string `json:"holder_id"`
-	PageToken string `json:"page_token"`
-	PageSize  int32  `json:"page_size"`
-}
-
-// ListAccountsResponse represents the proto ListAccountsResponse message.
-type ListAccountsResponse struct {
-	Accounts   []*AccountMsg `json:"accounts"`
-	TotalCount int32         `json:"total_count"`
-}
-
-// AccountMsg represents the proto Account message.
-type AccountMsg struct {
-	AccountID         string `json:"account_id"`
-	TenantID          string `json:"tenant_id"`
-	AccountNumber     string `json:"account_number"`
-	AccountType       string `json:"account_type"`
-	Status            string `json:"status"`
-	Currency          string `json:"currency"`
-	LedgerAccountCode string `json:"ledger_account_code"`
-	HolderFirstName   string `json:"holder_first_name"`
-	HolderLastName    string `json:"holder_last_name"`
-	HolderEmail       string `json:"holder_email"`
-	Version           int32  `json:"version"`
-}
-
 // OpenAccount handles the gRPC OpenAccount request.
-func (h *AccountHandler) OpenAccount(ctx context.Context, req *OpenAccountRequest) (*OpenAccountResponse, error) {
+func (h *AccountHandler) OpenAccount(ctx context.Context, req *accountv1.OpenAccountRequest) (*accountv1.OpenAccountResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -169,19 +100,21 @@ func (h *AccountHandler) OpenAccount(ctx context.Context, req *OpenAccountReques
 	if !currencyCodeRE.MatchString(req.Currency) {
 		return nil, status.Error(codes.InvalidArgument, "currency must be a 3-letter uppercase ISO code")
 	}
-	if req.HolderFirstName == "" {
-		return nil, status.Error(codes.InvalidArgument, "holder_first_name is required")
+	holder := req.GetHolder()
+	if holder.GetFirstName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "holder.first_name is required")
 	}
-	if req.HolderLastName == "" {
-		return nil, status.Error(codes.InvalidArgument, "holder_last_name is required")
+	if holder.GetLastName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "holder.last_name is required")
 	}
-	if req.AccountType == "" {
-		return nil, status.Error(codes.InvalidArgument, "account_type is required")
+	accountType := enumName(req.Type.String(), "ACCOUNT_TYPE_")
+	if accountType == "" {
+		return nil, status.Error(codes.InvalidArgument, "type is required")
 	}
 
 	var identityVerificationID uuid.UUID
-	if req.IdentityVerificationID != "" {
-		identityVerificationID, err = uuid.Parse(req.IdentityVerificationID)
+	if holder.GetIdentityVerificationId() != "" {
+		identityVerificationID, err = uuid.Parse(holder.GetIdentityVerificationId())
 		if err != nil {
 			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid identity_verification_id: %v", err))
 		}
@@ -189,27 +122,32 @@ func (h *AccountHandler) OpenAccount(ctx context.Context, req *OpenAccountReques
 
 	result, err := h.openAccount.Execute(ctx, dto.OpenAccountRequest{
 		TenantID:               tenantID,
-		AccountType:            req.AccountType,
+		AccountType:            accountType,
 		Currency:               req.Currency,
-		HolderFirstName:        req.HolderFirstName,
-		HolderLastName:         req.HolderLastName,
-		HolderEmail:            req.HolderEmail,
+		HolderFirstName:        holder.GetFirstName(),
+		HolderLastName:         holder.GetLastName(),
+		HolderEmail:            holder.GetEmail(),
 		IdentityVerificationID: identityVerificationID,
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	return &OpenAccountResponse{
-		AccountID:         result.AccountID.String(),
+	return &accountv1.OpenAccountResponse{Account: &accountv1.Account{
+		Id:                result.AccountID.String(),
+		TenantId:          tenantID.String(),
 		AccountNumber:     result.AccountNumber,
-		Status:            result.Status,
+		Type:              req.Type,
+		Status:            accountv1.AccountStatus(accountv1.AccountStatus_value["ACCOUNT_STATUS_"+result.Status]),
+		Currency:          req.Currency,
+		Holder:            holder,
 		LedgerAccountCode: result.LedgerAccountCode,
-	}, nil
+		Audit:             &commonv1.AuditInfo{CreatedAt: timestamppb.New(result.CreatedAt), Version: 1},
+	}}, nil
 }
 
 // GetAccount handles the gRPC GetAccount request.
-func (h *AccountHandler) GetAccount(ctx context.Context, req *GetAccountRequest) (*GetAccountResponse, error) {
+func (h *AccountHandler) GetAccount(ctx context.Context, req *accountv1.GetAccountRequest) (*accountv1.GetAccountResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -218,7 +156,7 @@ func (h *AccountHandler) GetAccount(ctx context.Context, req *GetAccountRequest)
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
 
-	accountID, err := uuid.Parse(req.ID)
+	accountID, err := uuid.Parse(req.Id)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid id: %v", err))
 	}
@@ -230,11 +168,11 @@ func (h *AccountHandler) GetAccount(ctx context.Context, req *GetAccountRequest)
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
-	return toAccountMsg(result), nil
+	return &accountv1.GetAccountResponse{Account: toAccountMsg(result)}, nil
 }
 
 // FreezeAccount handles the gRPC FreezeAccount request.
-func (h *AccountHandler) FreezeAccount(ctx context.Context, req *FreezeAccountRequest) (*FreezeAccountResponse, error) {
+func (h *AccountHandler) FreezeAccount(ctx context.Context, req *accountv1.FreezeAccountRequest) (*accountv1.FreezeAccountResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
 		return nil, err
 	}
@@ -243,7 +181,7 @@ func (h *AccountHandler) FreezeAccount(ctx context.Context, req *FreezeAccountRe
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
 
-	accountID, err := uuid.Parse(req.ID)
+	accountID, err := uuid.Parse(req.Id)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid id: %v", err))
 	}
@@ -256,11 +194,11 @@ func (h *AccountHandler) FreezeAccount(ctx context.Context, req *FreezeAccountRe
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	return toAccountMsg(result), nil
+	return &accountv1.FreezeAccountResponse{Account: toAccountMsg(result)}, nil
 }
 
 // CloseAccount handles the gRPC CloseAccount request.
-func (h *AccountHandler) CloseAccount(ctx context.Context, req *CloseAccountRequest) (*CloseAccountResponse, error) {
+func (h *AccountHandler) CloseAccount(ctx context.Context, req *accountv1.CloseAccountRequest) (*accountv1.CloseAccountResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
 		return nil, err
 	}
@@ -269,7 +207,7 @@ func (h *AccountHandler) CloseAccount(ctx context.Context, req *CloseAccountRequ
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
 
-	accountID, err := uuid.Parse(req.ID)
+	accountID, err := uuid.Parse(req.Id)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid id: %v", err))
 	}
@@ -282,11 +220,11 @@ func (h *AccountHandler) CloseAccount(ctx context.Context, req *CloseAccountRequ
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	return toAccountMsg(result), nil
+	return &accountv1.CloseAccountResponse{Account: toAccountMsg(result)}, nil
 }
 
 // ListAccounts handles the gRPC ListAccounts request.
-func (h *AccountHandler) ListAccounts(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
+func (h *AccountHandler) ListAccounts(ctx context.Context, req *accountv1.ListAccountsRequest) (*accountv1.ListAccountsResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -295,7 +233,7 @@ func (h *AccountHandler) ListAccounts(ctx context.Context, req *ListAccountsRequ
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
 
-	pageSize := req.PageSize
+	pageSize := req.GetPagination().GetPageSize()
 	if pageSize == 0 {
 		pageSize = 20
 	}
@@ -303,14 +241,25 @@ func (h *AccountHandler) ListAccounts(ctx context.Context, req *ListAccountsRequ
 		return nil, status.Error(codes.InvalidArgument, "page_size must be between 1 and 100")
 	}
 
+	// The page token is the offset of the page, as the reporting service
+	// pages through a tenant's accounts.
+	var offset int
+	if token := req.GetPagination().GetPageToken(); token != "" {
+		n, err := strconv.Atoi(token)
+		if err != nil || n < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		offset = n
+	}
+
 	tenantID, err := tenantIDFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	var holderID uuid.UUID
-	if req.HolderID != "" {
-		holderID, err = uuid.Parse(req.HolderID)
+	if req.HolderId != "" {
+		holderID, err = uuid.Parse(req.HolderId)
 		if err != nil {
 			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid holder_id: %v", err))
 		}
@@ -320,35 +269,55 @@ func (h *AccountHandler) ListAccounts(ctx context.Context, req *ListAccountsRequ
 		TenantID: tenantID,
 		HolderID: holderID,
 		Limit:    int(pageSize),
-		Offset:   0,
+		Offset:   offset,
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	accounts := make([]*AccountMsg, 0, len(result.Accounts))
+	accounts := make([]*accountv1.Account, 0, len(result.Accounts))
 	for _, a := range result.Accounts {
 		accounts = append(accounts, toAccountMsg(a))
 	}
 
-	return &ListAccountsResponse{
-		Accounts:   accounts,
+	page := &commonv1.PaginationResponse{
 		TotalCount: int32(result.TotalCount), //nolint:gosec // bounded by DB query limits
-	}, nil
+	}
+	if next := offset + len(accounts); len(accounts) > 0 && next < result.TotalCount {
+		page.NextPageToken = strconv.Itoa(next)
+	}
+	return &accountv1.ListAccountsResponse{Accounts: accounts, Pagination: page}, nil
 }
 
-func toAccountMsg(a dto.AccountResponse) *AccountMsg {
-	return &AccountMsg{
-		AccountID:         a.AccountID.String(),
-		TenantID:          a.TenantID.String(),
-		AccountNumber:     a.AccountNumber,
-		AccountType:       a.AccountType,
-		Status:            a.Status,
-		Currency:          a.Currency,
+func toAccountMsg(a dto.AccountResponse) *accountv1.Account {
+	return &accountv1.Account{
+		Id:            a.AccountID.String(),
+		TenantId:      a.TenantID.String(),
+		AccountNumber: a.AccountNumber,
+		Type:          accountv1.AccountType(accountv1.AccountType_value["ACCOUNT_TYPE_"+a.AccountType]),
+		Status:        accountv1.AccountStatus(accountv1.AccountStatus_value["ACCOUNT_STATUS_"+a.Status]),
+		Currency:      a.Currency,
+		Holder: &accountv1.AccountHolder{
+			Id:        a.HolderID.String(),
+			FirstName: a.HolderFirstName,
+			LastName:  a.HolderLastName,
+			Email:     a.HolderEmail,
+		},
 		LedgerAccountCode: a.LedgerAccountCode,
-		HolderFirstName:   a.HolderFirstName,
-		HolderLastName:    a.HolderLastName,
-		HolderEmail:       a.HolderEmail,
-		Version:           int32(a.Version), //nolint:gosec // bounded by DB query limits
+		Audit: &commonv1.AuditInfo{
+			CreatedAt: timestamppb.New(a.CreatedAt),
+			UpdatedAt: timestamppb.New(a.UpdatedAt),
+			Version:   int32(a.Version), //nolint:gosec // bounded by DB query limits
+		},
+	}
+}
+
+// enumName strips prefix from a proto enum value name, returning "" for the
+// unspecified value.
+func enumName(name, prefix string) string {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "UNSPECIFIED" {
+		return ""
 	}
+	return name
 }
@@ -14,6 +14,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	accountv1 "github.com/bibbank/bib/api/gen/go/bib/account/v1"
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
 	"github.com/bibbank/bib/pkg/auth"
 	"github.com/bibbank/bib/services/account-service/internal/application/dto"
 	"github.com/bibbank/bib/services/account-service/internal/application/usecase"
@@ -64,6 +66,10 @@ func (m *mockAccountRepo) ListByHolder(ctx context.Context, holderID uuid.UUID,
 	return nil, 0, nil
 }
 
+func (m *mockAccountRepo) ListByIdentityVerification(_ context.Context, _ uuid.UUID) ([]model.CustomerAccount, error) {
+	return nil, nil
+}
+
 type mockEventPublisher struct {
 	publishErr error
 }
@@ -120,7 +126,7 @@ func makeActiveAccount(tenantID uuid.UUID) model.CustomerAccount {
 	return model.ReconstructCustomerAccount(
 		uuid.New(), tenantID, an, at,
 		model.AccountStatusActive, "USD", holder,
-		"2000-100", 1, now, now,
+		"2000-100", "", 1, now, now,
 	)
 }
 
@@ -135,13 +141,10 @@ func TestOpenAccount(t *testing.T) {
 
 	t.Run("missing currency returns InvalidArgument", func(t *testing.T) {
 		h, _ := buildTestHandler()
-		_, err := h.OpenAccount(contextWithClaims(), &OpenAccountRequest{
-			TenantID:        uuid.New().String(),
-			AccountType:     "CHECKING",
-			Currency:        "",
-			HolderFirstName: "Jane",
-			HolderLastName:  "Smith",
-			HolderEmail:     "jane@example.com",
+		_, err := h.OpenAccount(contextWithClaims(), &accountv1.OpenAccountRequest{
+			Type:     accountv1.AccountType_ACCOUNT_TYPE_CHECKING,
+			Currency: "",
+			Holder:   &accountv1.AccountHolder{FirstName: "Jane", LastName: "Smith", Email: "jane@example.com"},
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 		assert.Contains(t, err.Error(), "currency is required")
@@ -149,14 +152,10 @@ func TestOpenAccount(t *testing.T) {
 
 	t.Run("invalid identity_verification_id returns InvalidArgument", func(t *testing.T) {
 		h, _ := buildTestHandler()
-		_, err := h.OpenAccount(contextWithClaims(), &OpenAccountRequest{
-			TenantID:               uuid.New().String(),
-			AccountType:            "CHECKING",
-			Currency:               "USD",
-			HolderFirstName:        "Jane",
-			HolderLastName:         "Smith",
-			HolderEmail:            "jane@example.com",
-			IdentityVerificationID: "bad-uuid",
+		_, err := h.OpenAccount(contextWithClaims(), &accountv1.OpenAccountRequest{
+			Type:     accountv1.AccountType_ACCOUNT_TYPE_CHECKING,
+			Currency: "USD",
+			Holder:   &accountv1.AccountHolder{FirstName: "Jane", LastName: "Smith", Email: "jane@example.com", IdentityVerificationId: "bad-uuid"},
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 		assert.Contains(t, err.Error(), "invalid identity_verification_id")
@@ -164,19 +163,16 @@ func TestOpenAccount(t *testing.T) {
 
 	t.Run("happy path returns account info", func(t *testing.T) {
 		h, _ := buildTestHandler()
-		resp, err := h.OpenAccount(contextWithClaims(), &OpenAccountRequest{
-			TenantID:        uuid.New().String(),
-			AccountType:     "CHECKING",
-			Currency:        "USD",
-			HolderFirstName: "Jane",
-			HolderLastName:  "Smith",
-			HolderEmail:     "jane@example.com",
+		resp, err := h.OpenAccount(contextWithClaims(), &accountv1.OpenAccountRequest{
+			Type:     accountv1.AccountType_ACCOUNT_TYPE_CHECKING,
+			Currency: "USD",
+			Holder:   &accountv1.AccountHolder{FirstName: "Jane", LastName: "Smith", Email: "jane@example.com"},
 		})
 		require.NoError(t, err)
-		assert.NotEmpty(t, resp.AccountID)
-		assert.NotEmpty(t, resp.AccountNumber)
-		assert.Equal(t, "ACTIVE", resp.Status)
-		assert.NotEmpty(t, resp.LedgerAccountCode)
+		assert.NotEmpty(t, resp.Account.Id)
+		assert.NotEmpty(t, resp.Account.AccountNumber)
+		assert.Equal(t, accountv1.AccountStatus_ACCOUNT_STATUS_ACTIVE, resp.Account.Status)
+		assert.NotEmpty(t, resp.Account.LedgerAccountCode)
 	})
 
 	t.Run("use case error returns Internal", func(t *testing.T) {
@@ -194,13 +190,10 @@ func TestOpenAccount(t *testing.T) {
 			logger,
 		)
 
-		_, err := h.OpenAccount(contextWithClaims(), &OpenAccountRequest{
-			TenantID:        uuid.New().String(),
-			AccountType:     "CHECKING",
-			Currency:        "USD",
-			HolderFirstName: "Jane",
-			HolderLastName:  "Smith",
-			HolderEmail:     "jane@example.com",
+		_, err := h.OpenAccount(contextWithClaims(), &accountv1.OpenAccountRequest{
+			Type:     accountv1.AccountType_ACCOUNT_TYPE_CHECKING,
+			Currency: "USD",
+			Holder:   &accountv1.AccountHolder{FirstName: "Jane", LastName: "Smith", Email: "jane@example.com"},
 		})
 		requireGRPCCode(t, err, codes.Internal)
 	})
@@ -215,7 +208,7 @@ func TestGetAccount(t *testing.T) {
 
 	t.Run("invalid id returns InvalidArgument", func(t *testing.T) {
 		h, _ := buildTestHandler()
-		_, err := h.GetAccount(contextWithClaims(), &GetAccountRequest{ID: "bad"})
+		_, err := h.GetAccount(contextWithClaims(), &accountv1.GetAccountRequest{Id: "bad"})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 	})
 
@@ -231,16 +224,16 @@ func TestGetAccount(t *testing.T) {
 			return model.CustomerAccount{}, fmt.Errorf("not found")
 		}
 
-		resp, err := h.GetAccount(contextWithClaims(), &GetAccountRequest{ID: account.ID().String()})
+		resp, err := h.GetAccount(contextWithClaims(), &accountv1.GetAccountRequest{Id: account.ID().String()})
 		require.NoError(t, err)
 		require.NotNil(t, resp)
-		assert.Equal(t, account.ID().String(), resp.AccountID)
-		assert.Equal(t, "ACTIVE", resp.Status)
+		assert.Equal(t, account.ID().String(), resp.Account.Id)
+		assert.Equal(t, accountv1.AccountStatus_ACCOUNT_STATUS_ACTIVE, resp.Account.Status)
 	})
 
 	t.Run("not found returns NotFound", func(t *testing.T) {
 		h, _ := buildTestHandler()
-		_, err := h.GetAccount(contextWithClaims(), &GetAccountRequest{ID: uuid.New().String()})
+		_, err := h.GetAccount(contextWithClaims(), &accountv1.GetAccountRequest{Id: uuid.New().String()})
 		requireGRPCCode(t, err, codes.NotFound)
 	})
 }
@@ -254,7 +247,7 @@ func TestFreezeAccount(t *testing.T) {
 
 	t.Run("invalid id returns InvalidArgument", func(t *testing.T) {
 		h, _ := buildTestHandler()
-		_, err := h.FreezeAccount(contextWithClaims(), &FreezeAccountRequest{ID: "bad", Reason: "fraud"})
+		_, err := h.FreezeAccount(contextWithClaims(), &accountv1.FreezeAccountRequest{Id: "bad", Reason: "fraud"})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 	})
 
@@ -266,13 +259,13 @@ func TestFreezeAccount(t *testing.T) {
 			return account, nil
 		}
 
-		resp, err := h.FreezeAccount(contextWithClaims(), &FreezeAccountRequest{
-			ID:     account.ID().String(),
+		resp, err := h.FreezeAccount(contextWithClaims(), &accountv1.FreezeAccountRequest{
+			Id:     account.ID().String(),
 			Reason: "suspected fraud",
 		})
 		require.NoError(t, err)
 		require.NotNil(t, resp)
-		assert.Equal(t, "FROZEN", resp.Status)
+		assert.Equal(t, accountv1.AccountStatus_ACCOUNT_STATUS_FROZEN, resp.Account.Status)
 	})
 }
 
@@ -285,7 +278,7 @@ func TestCloseAccount(t *testing.T) {
 
 	t.Run("invalid id returns InvalidArgument", func(t *testing.T) {
 		h, _ := buildTestHandler()
-		_, err := h.CloseAccount(contextWithClaims(), &CloseAccountRequest{ID: "bad", Reason: "closing"})
+		_, err := h.CloseAccount(contextWithClaims(), &accountv1.CloseAccountRequest{Id: "bad", Reason: "closing"})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 	})
 
@@ -297,13 +290,13 @@ func TestCloseAccount(t *testing.T) {
 			return account, nil
 		}
 
-		resp, err := h.CloseAccount(contextWithClaims(), &CloseAccountRequest{
-			ID:     account.ID().String(),
+		resp, err := h.CloseAccount(contextWithClaims(), &accountv1.CloseAccountRequest{
+			Id:     account.ID().String(),
 			Reason: "customer request",
 		})
 		require.NoError(t, err)
 		require.NotNil(t, resp)
-		assert.Equal(t, "CLOSED", resp.Status)
+		assert.Equal(t, accountv1.AccountStatus_ACCOUNT_STATUS_CLOSED, resp.Account.Status)
 	})
 }
 
@@ -316,7 +309,7 @@ func TestListAccounts(t *testing.T) {
 
 	t.Run("invalid page_size returns InvalidArgument", func(t *testing.T) {
 		h, _ := buildTestHandler()
-		_, err := h.ListAccounts(contextWithClaims(), &ListAccountsRequest{TenantID: uuid.New().String(), PageSize: -1})
+		_, err := h.ListAccounts(contextWithClaims(), &accountv1.ListAccountsRequest{TenantId: uuid.New().String(), Pagination: &commonv1.Pagination{PageSize: -1}})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 	})
 
@@ -330,13 +323,13 @@ func TestListAccounts(t *testing.T) {
 			return []model.CustomerAccount{account1, account2}, 2, nil
 		}
 
-		resp, err := h.ListAccounts(contextWithClaims(), &ListAccountsRequest{
-			TenantID: tenantID.String(),
-			PageSize: 10,
+		resp, err := h.ListAccounts(contextWithClaims(), &accountv1.ListAccountsRequest{
+			TenantId:   tenantID.String(),
+			Pagination: &commonv1.Pagination{PageSize: 10},
 		})
 		require.NoError(t, err)
 		assert.Len(t, resp.Accounts, 2)
-		assert.Equal(t, int32(2), resp.TotalCount)
+		assert.Equal(t, int32(2), resp.Pagination.TotalCount)
 	})
 
 	t.Run("empty holder_id ignored, uses tenant_id", func(t *testing.T) {
@@ -347,13 +340,39 @@ func TestListAccounts(t *testing.T) {
 			return nil, 0, nil
 		}
 
-		resp, err := h.ListAccounts(contextWithClaims(), &ListAccountsRequest{
-			TenantID: tenantID.String(),
-			PageSize: 10,
+		resp, err := h.ListAccounts(contextWithClaims(), &accountv1.ListAccountsRequest{
+			TenantId:   tenantID.String(),
+			Pagination: &commonv1.Pagination{PageSize: 10},
 		})
 		require.NoError(t, err)
 		assert.Empty(t, resp.Accounts)
 	})
+
+	t.Run("page token is the offset of the page", func(t *testing.T) {
+		h, repo := buildTestHandler()
+		account := makeActiveAccount(uuid.New())
+
+		var gotOffset int
+		repo.listFunc = func(_ context.Context, _ uuid.UUID, _, offset int) ([]model.CustomerAccount, int, error) {
+			gotOffset = offset
+			return []model.CustomerAccount{account}, 3, nil
+		}
+
+		resp, err := h.ListAccounts(contextWithClaims(), &accountv1.ListAccountsRequest{
+			Pagination: &commonv1.Pagination{PageSize: 1, PageToken: "1"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, gotOffset)
+		assert.Equal(t, "2", resp.Pagination.NextPageToken)
+	})
+
+	t.Run("invalid page token returns InvalidArgument", func(t *testing.T) {
+		h, _ := buildTestHandler()
+		_, err := h.ListAccounts(contextWithClaims(), &accountv1.ListAccountsRequest{
+			Pagination: &commonv1.Pagination{PageToken: "next"},
+		})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
 }
 
 func TestToAccountMsg(t *testing.T) {
@@ -374,14 +393,15 @@ func TestToAccountMsg(t *testing.T) {
 		Version:           3,
 	})
 
-	assert.Equal(t, accountID.String(), msg.AccountID)
-	assert.Equal(t, tenantID.String(), msg.TenantID)
+	assert.Equal(t, accountID.String(), msg.Id)
+	assert.Equal(t, tenantID.String(), msg.TenantId)
 	assert.Equal(t, "BIB-AAAA-BBBB-CCCC", msg.AccountNumber)
-	assert.Equal(t, "CHECKING", msg.AccountType)
-	assert.Equal(t, "ACTIVE", msg.Status)
+	assert.Equal(t, accountv1.AccountType_ACCOUNT_TYPE_CHECKING, msg.Type)
+	assert.Equal(t, accountv1.AccountStatus_ACCOUNT_STATUS_ACTIVE, msg.Status)
 	assert.Equal(t, "USD", msg.Currency)
+	assert.Equal(t, "Jane", msg.Holder.FirstName)
 	assert.Equal(t, "2000-100", msg.LedgerAccountCode)
-	assert.Equal(t, int32(3), msg.Version)
+	assert.Equal(t, int32(3), msg.Audit.Version)
 }
 
 // requireGRPCCode asserts that an error is a gRPC status error with the given code.
@@ -3,100 +3,51 @@ package grpc
 import (
 	"fmt"
 	"log/slog"
-	"net"
-	"os"
 
-	"github.com/bibbank/bib/pkg/auth"
-	"github.com/bibbank/bib/pkg/tlsutil"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/health"
-	healthpb "google.golang.org/grpc/health/grpc_health_v1"
-	"google.golang.org/grpc/reflection"
+
+	accountv1 "github.com/bibbank/bib/api/gen/go/bib/account/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/grpcserver"
 )
 
-// Server wraps the gRPC server with account service handlers.
+// Server wraps the gRPC server for account-service.
 type Server struct {
-	grpcServer   *grpc.Server
-	healthServer *health.Server
-	handler      *AccountHandler
-	logger       *slog.Logger
-	port         int
+	server  *grpcserver.Server
+	handler *AccountHandler
+	port    int
 }
 
-// NewServer creates a new gRPC server with the provided handler.
-func NewServer(handler *AccountHandler, port int, logger *slog.Logger, jwtService *auth.JWTService) *Server {
-	// Add auth interceptor, skipping health check methods.
-	authInterceptor := auth.UnaryAuthInterceptor(jwtService, []string{
-		"/grpc.health.v1.Health/Check",
-		"/grpc.health.v1.Health/Watch",
-	})
-
-	var serverOpts []grpc.ServerOption
-	serverOpts = append(serverOpts, grpc.UnaryInterceptor(authInterceptor))
-
-	// Optional TLS: set GRPC_TLS_CERT_FILE and GRPC_TLS_KEY_FILE to enable.
-	if certFile, keyFile := os.Getenv("GRPC_TLS_CERT_FILE"), os.Getenv("GRPC_TLS_KEY_FILE"); certFile != "" && keyFile != "" {
-		creds, err := tlsutil.ServerTLSConfig(certFile, keyFile)
-		if err != nil {
-			logger.Error("failed to load TLS credentials, starting without TLS", "error", err)
-		} else {
-			serverOpts = append(serverOpts, grpc.Creds(creds))
-			logger.Info("gRPC TLS enabled", "cert", certFile, "key", keyFile)
-		}
-	} else {
-		logger.Info("gRPC TLS not configured, running without TLS")
-	}
-
-	grpcServer := grpc.NewServer(serverOpts...)
-	healthServer := health.NewServer()
-
-	// Register health check service.
-	healthpb.RegisterHealthServer(grpcServer, healthServer)
-
-	// Register the AccountService handler.
-	RegisterAccountServiceServer(grpcServer, handler)
-
-	// Only enable reflection when GRPC_REFLECTION=true.
-	if os.Getenv("GRPC_REFLECTION") == "true" {
-		reflection.Register(grpcServer)
+// NewServer creates a new gRPC server with the given handler.
+func NewServer(handler *AccountHandler, port int, logger *slog.Logger, jwtService *auth.JWTService) (*Server, error) {
+	srv, err := grpcserver.New(grpcserver.Config{
+		ServiceName: "account-service",
+		JWTService:  jwtService,
+	}, logger)
+	if err != nil {
+		return nil, err
 	}
+	accountv1.RegisterAccountServiceServer(srv, handler)
 
 	return &Server{
-		grpcServer:   grpcServer,
-		healthServer: healthServer,
-		handler:      handler,
-		port:         port,
-		logger:       logger,
-	}
+		server:  srv,
+		handler: handler,
+		port:    port,
+	}, nil
 }
 
-// Start begins listening for gRPC connections.
+// Start begins listening for gRPC connections on the configured port.
 func (s *Server) Start() error {
-	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
-	if err != nil {
-		return fmt.Errorf("failed to listen on port %d: %w", s.port, err)
-	}
-
-	s.logger.Info("gRPC server starting", "port", s.port)
-
-	// Mark the service as healthy.
-	s.healthServer.SetServingStatus("account-service", healthpb.HealthCheckResponse_SERVING)
-
-	if err := s.grpcServer.Serve(listener); err != nil {
-		return fmt.Errorf("gRPC server failed: %w", err)
-	}
-
-	return nil
+	return s.server.ListenAndServe(fmt.Sprintf(":%d", s.port))
 }
 
-// Stop gracefully shuts down the gRPC server.
+// Stop gracefully stops the gRPC server.
 func (s *Server) Stop() {
-	s.logger.Info("stopping gRPC server")
-	s.healthServer.SetServingStatus("account-service", healthpb.HealthCheckResponse_NOT_SERVING)
-	s.grpcServer.GracefulStop()
+	s.server.Stop()
 }
 
-// GRPCServer returns the underlying grpc.Server for additional registration.
-func (s *Server) GRPCServer() *grpc.Server {
-	return s.grpcServer
+// RegisterService registers another service on the server, such as the
+// outbox administration service.
+func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	s.server.RegisterService(desc, impl)
 }
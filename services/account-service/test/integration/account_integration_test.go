@@ -65,7 +65,7 @@ func newTestAccount(t *testing.T, tenantID uuid.UUID) model.CustomerAccount {
 
 func TestAccountRepository_SaveAndGet(t *testing.T) {
 	pool := setupTestDB(t)
-	repo := postgres.NewAccountRepository(pool)
+	repo := postgres.NewAccountRepository(pool, nil, nil)
 	ctx := context.Background()
 
 	tenantID := uuid.New()
@@ -97,7 +97,7 @@ func TestAccountRepository_SaveAndGet(t *testing.T) {
 
 func TestAccountRepository_ListByTenant(t *testing.T) {
 	pool := setupTestDB(t)
-	repo := postgres.NewAccountRepository(pool)
+	repo := postgres.NewAccountRepository(pool, nil, nil)
 	ctx := context.Background()
 
 	tenantA := uuid.New()
@@ -136,7 +136,7 @@ func TestAccountRepository_ListByTenant(t *testing.T) {
 
 func TestAccountRepository_OptimisticLocking(t *testing.T) {
 	pool := setupTestDB(t)
-	repo := postgres.NewAccountRepository(pool)
+	repo := postgres.NewAccountRepository(pool, nil, nil)
 	ctx := context.Background()
 
 	tenantID := uuid.New()
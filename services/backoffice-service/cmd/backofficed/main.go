@@ -0,0 +1,290 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/bibbank/bib/pkg/auth"
+	pkgkafka "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
+	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
+	pkgpostgres "github.com/bibbank/bib/pkg/postgres"
+	"github.com/bibbank/bib/services/backoffice-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/backoffice-service/internal/infrastructure/client"
+	"github.com/bibbank/bib/services/backoffice-service/internal/infrastructure/config"
+	"github.com/bibbank/bib/services/backoffice-service/internal/infrastructure/postgres"
+	grpcpresentation "github.com/bibbank/bib/services/backoffice-service/internal/presentation/grpc"
+	"github.com/bibbank/bib/services/backoffice-service/internal/presentation/rest"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// Load configuration.
+	cfg := config.Load()
+
+	// Initialize structured logger via shared observability package.
+	logger := observability.InitLogger(observability.LogConfig{
+		Level:  "info",
+		Format: "json",
+	})
+	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
+
+	logger.Info("starting backoffice-service",
+		"http_port", cfg.HTTPPort,
+		"grpc_port", cfg.GRPCPort,
+	)
+
+	// Initialize tracing.
+	shutdown, err := observability.InitTracer(ctx, observability.TracingConfig{
+		ServiceName: cfg.ServiceName,
+		Endpoint:    getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4317"),
+		Insecure:    true,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
+	} else {
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
+	}
+
+	// Database connection.
+	dbCtx, dbCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer dbCancel()
+
+	pool, err := pkgpostgres.NewPool(dbCtx, pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	})
+	if err != nil {
+		logger.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
+	logger.Info("connected to database")
+
+	// Run database migrations.
+	migDSN := pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	}.DSN()
+	if migErr := pkgpostgres.RunMigrations(migDSN, "file://internal/infrastructure/postgres/migrations"); migErr != nil {
+		logger.Warn("migration warning", "error", migErr)
+	}
+
+	// Wire infrastructure adapters.
+	taskRepo := postgres.NewTaskRepo(pool)
+	timelineRepo := postgres.NewTimelineRepo(pool)
+	kafkaProducer := pkgkafka.NewProducer(pkgkafka.Config{
+		Brokers: cfg.Kafka.Brokers,
+	})
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(kafkaProducer.Close))
+
+	// Outbox relay: events are written to the outbox with the task and
+	// published from it, so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     outbox.StaticRoute("backoffice-events"),
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: cfg.ServiceName,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, kafkaProducer, relayCfg, logger)
+	if err != nil {
+		logger.Error("failed to create outbox relay", "error", err)
+		os.Exit(1)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+	eventPublisher := postgres.NewOutboxPublisher(outbox.NewPublisher(outboxStore), "backoffice-events")
+
+	// JWT service for gRPC auth. Operators authenticate in the backoffice
+	// realm, whose tokens have their own issuer and key, so a customer's
+	// platform token is never accepted here.
+	jwtCfg := auth.JWTConfig{
+		Issuer: cfg.Realm.Issuer,
+	}
+	switch {
+	case cfg.Realm.PublicKey != "":
+		jwtCfg.PublicKeyPEM = cfg.Realm.PublicKey
+	case cfg.Realm.PublicKeyFile != "":
+		keyData, loadErr := auth.LoadKeyFromFile(cfg.Realm.PublicKeyFile)
+		if loadErr != nil {
+			logger.Error("failed to load backoffice JWT public key file", "error", loadErr)
+			os.Exit(1)
+		}
+		jwtCfg.PublicKeyPEM = string(keyData)
+	case cfg.Realm.Secret != "":
+		jwtCfg.Secret = cfg.Realm.Secret
+	default:
+		logger.Error("BACKOFFICE_JWT_PUBLIC_KEY, BACKOFFICE_JWT_PUBLIC_KEY_FILE or BACKOFFICE_JWT_SECRET is required")
+		os.Exit(1)
+	}
+	jwtSvc, err := auth.NewJWTService(jwtCfg)
+	if err != nil {
+		logger.Error("failed to initialize JWT service", "error", err)
+		os.Exit(1)
+	}
+
+	// Calls to the fraud and identity services carry platform tokens for
+	// the operator, signed like the gateway's.
+	var signer *auth.JWTService
+	switch {
+	case cfg.Upstream.SigningKeyFile != "":
+		keyData, loadErr := auth.LoadKeyFromFile(cfg.Upstream.SigningKeyFile)
+		if loadErr != nil {
+			logger.Error("failed to load backoffice signing key file", "error", loadErr)
+			os.Exit(1)
+		}
+		signer, err = auth.NewJWTService(auth.JWTConfig{
+			PrivateKeyPEM: string(keyData),
+			Issuer:        "bib-gateway",
+			Expiration:    15 * time.Minute,
+		})
+	case os.Getenv("JWT_SECRET") != "":
+		signer, err = auth.NewJWTService(auth.JWTConfig{
+			Secret:     os.Getenv("JWT_SECRET"),
+			Issuer:     "bib-gateway",
+			Expiration: 15 * time.Minute,
+		})
+	default:
+		err = fmt.Errorf("BACKOFFICE_SIGNING_KEY_FILE is required when JWT_SECRET is not set")
+	}
+	if err != nil {
+		logger.Error("failed to initialize backoffice token signer", "error", err)
+		os.Exit(1)
+	}
+	clientConfig := client.Config{
+		MaxRetries:   cfg.Upstream.MaxRetries,
+		RetryBackoff: cfg.Upstream.RetryBackoff,
+		Timeout:      cfg.Upstream.Timeout,
+	}
+	dial := func(name, addr string) *grpc.ClientConn {
+		conn, dialErr := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if dialErr != nil {
+			logger.Error("failed to create "+name+" service client", "addr", addr, "error", dialErr)
+			os.Exit(1)
+		}
+		return conn
+	}
+	fraudConn := dial("fraud", cfg.Upstream.FraudGRPCAddr)
+	lc.OnStop(lifecycle.PhaseResources, "fraud client", lifecycle.Close(fraudConn.Close))
+	identityConn := dial("identity", cfg.Upstream.IdentityGRPCAddr)
+	lc.OnStop(lifecycle.PhaseResources, "identity client", lifecycle.Close(identityConn.Close))
+	caseSystems := usecase.CaseSystems{
+		valueobject.QueueFraudReview: client.NewFraudGRPCClient(fraudConn, signer, clientConfig),
+		valueobject.QueueKYCReview:   client.NewIdentityGRPCClient(identityConn, signer, clientConfig),
+	}
+
+	// Wire use cases.
+	listTasksUC := usecase.NewListTasksUseCase(taskRepo)
+	getTaskUC := usecase.NewGetTaskUseCase(taskRepo)
+	openTaskUC := usecase.NewOpenTaskUseCase(taskRepo, eventPublisher)
+	claimTaskUC := usecase.NewClaimTaskUseCase(taskRepo, caseSystems, eventPublisher)
+	releaseTaskUC := usecase.NewReleaseTaskUseCase(taskRepo, eventPublisher)
+	addTaskNoteUC := usecase.NewAddTaskNoteUseCase(taskRepo)
+	resolveTaskUC := usecase.NewResolveTaskUseCase(taskRepo, caseSystems, eventPublisher)
+	getQueueSummaryUC := usecase.NewGetQueueSummaryUseCase(taskRepo)
+	ingestEventUC := usecase.NewIngestEventUseCase(taskRepo, eventPublisher, usecase.IngestConfig{
+		PaymentRepairSLA: cfg.Queues.PaymentRepairSLA,
+		CollectionSLA:    cfg.Queues.CollectionSLA,
+	}, logger)
+	recordTimelineUC := usecase.NewRecordTimelineEventUseCase(timelineRepo, logger)
+	getTimelineUC := usecase.NewGetTransactionTimelineUseCase(timelineRepo)
+
+	// Raise and close tasks from the events of the services whose work
+	// needs an operator, and record the journeys of payments and card
+	// transactions for support.
+	for _, topic := range usecase.SourceTopics {
+		consumer := pkgkafka.NewConsumer(pkgkafka.Config{
+			Brokers:       cfg.Kafka.Brokers,
+			ConsumerGroup: cfg.Kafka.ConsumerGroup,
+		}, topic, func(ctx context.Context, msg pkgkafka.Message) error {
+			if err := ingestEventUC.Execute(ctx, msg.Headers["event_type"], msg.Value); err != nil {
+				return err
+			}
+			return recordTimelineUC.Execute(ctx, msg.Headers["event_type"], msg.Value)
+		}, logger)
+		lc.Go(lifecycle.PhaseConsumers, topic+" consumer", consumer.Start)
+		lc.OnStop(lifecycle.PhaseResources, topic+" consumer", lifecycle.Close(consumer.Close))
+	}
+
+	// gRPC server.
+	grpcHandler := grpcpresentation.NewHandler(
+		listTasksUC, getTaskUC, openTaskUC, claimTaskUC, releaseTaskUC, addTaskNoteUC,
+		resolveTaskUC, getQueueSummaryUC, getTimelineUC, logger,
+	)
+	grpcServer, err := grpcpresentation.NewServer(grpcHandler, logger, jwtSvc, relayCfg.MeterProvider)
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
+
+	// HTTP server (health checks and metrics).
+	healthHandler := rest.NewHealthHandler(logger)
+	httpMux := http.NewServeMux()
+	healthHandler.RegisterRoutes(httpMux)
+	if metricsHandler != nil {
+		httpMux.Handle("/metrics", metricsHandler)
+	}
+
+	httpServer := &http.Server{
+		Addr:              cfg.HTTPAddr(),
+		Handler:           httpMux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Start servers.
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", func(context.Context) error {
+		return grpcServer.Start(cfg.GRPCAddr())
+	})
+	lc.OnStop(lifecycle.PhaseIngress, "gRPC server", lifecycle.Func(grpcServer.Stop))
+	lc.ServeHTTP("HTTP server", httpServer)
+
+	logger.Info("backoffice-service is running",
+		"grpc_addr", cfg.GRPCAddr(),
+		"http_addr", cfg.HTTPAddr(),
+	)
+
+	// Wait for a shutdown signal or a component failure, then drain
+	// requests and consumers before flushing the outbox.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
+	}
+	logger.Info("backoffice-service stopped")
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
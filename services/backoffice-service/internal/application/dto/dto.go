@@ -0,0 +1,146 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskResponse is an operations task.
+type TaskResponse struct {
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DueAt          *time.Time
+	ClaimedAt      *time.Time
+	ResolvedAt     *time.Time
+	ClaimedBy      *uuid.UUID
+	ResolvedBy     *uuid.UUID
+	Details        map[string]string
+	Queue          string
+	SourceID       string
+	Summary        string
+	Status         string
+	Outcome        string
+	ResolutionNote string
+	Version        int
+	Overdue        bool
+	ID             uuid.UUID
+	TenantID       uuid.UUID
+}
+
+// AuditEntryResponse is one action on a task.
+type AuditEntryResponse struct {
+	OccurredAt time.Time
+	ActorID    *uuid.UUID
+	Action     string
+	Detail     string
+	ID         uuid.UUID
+}
+
+// ListTasksRequest is the input for listing a tenant's tasks. Empty
+// fields do not filter.
+type ListTasksRequest struct {
+	ClaimedBy   *uuid.UUID
+	Queue       string
+	Status      string
+	OverdueOnly bool
+	Limit       int
+	Offset      int
+	TenantID    uuid.UUID
+}
+
+// ListTasksResponse is one page of tasks.
+type ListTasksResponse struct {
+	Tasks      []TaskResponse
+	TotalCount int
+}
+
+// GetTaskResponse is a task with its audit trail, oldest first.
+type GetTaskResponse struct {
+	AuditTrail []AuditEntryResponse
+	Task       TaskResponse
+}
+
+// OpenTaskRequest raises a task by hand.
+type OpenTaskRequest struct {
+	DueAt      *time.Time
+	Details    map[string]string
+	Queue      string
+	SourceID   string
+	Summary    string
+	TenantID   uuid.UUID
+	OperatorID uuid.UUID
+}
+
+// TaskActionRequest identifies the task an operator acts on.
+type TaskActionRequest struct {
+	TenantID   uuid.UUID
+	TaskID     uuid.UUID
+	OperatorID uuid.UUID
+}
+
+// ReleaseTaskRequest hands a claimed task back to its queue. Supervisor
+// lets the operator release a task another operator holds.
+type ReleaseTaskRequest struct {
+	Reason     string
+	Supervisor bool
+	TenantID   uuid.UUID
+	TaskID     uuid.UUID
+	OperatorID uuid.UUID
+}
+
+// AddTaskNoteRequest records an operator's note on a task.
+type AddTaskNoteRequest struct {
+	Note       string
+	TenantID   uuid.UUID
+	TaskID     uuid.UUID
+	OperatorID uuid.UUID
+}
+
+// ResolveTaskRequest closes the operator's claimed task.
+type ResolveTaskRequest struct {
+	Outcome    string
+	Note       string
+	TenantID   uuid.UUID
+	TaskID     uuid.UUID
+	OperatorID uuid.UUID
+}
+
+// QueueSummaryRequest is the input for summarising a tenant's queues.
+type QueueSummaryRequest struct {
+	TenantID   uuid.UUID
+	OperatorID uuid.UUID
+}
+
+// QueueSummary is how much work is waiting in one queue.
+type QueueSummary struct {
+	Queue           string
+	Open            int
+	Claimed         int
+	Overdue         int
+	ClaimedByCaller int
+}
+
+// TimelineRequest identifies the payment or card transaction whose
+// timeline is requested.
+type TimelineRequest struct {
+	TenantID      uuid.UUID
+	TransactionID uuid.UUID
+}
+
+// TimelineEventResponse is one event of a transaction's journey.
+type TimelineEventResponse struct {
+	OccurredAt time.Time
+	Details    map[string]string
+	EventID    string
+	EventType  string
+	Source     string
+	Stage      string
+	Summary    string
+}
+
+// TimelineResponse is a transaction's journey, oldest event first.
+type TimelineResponse struct {
+	Events        []TimelineEventResponse
+	TransactionID uuid.UUID
+}
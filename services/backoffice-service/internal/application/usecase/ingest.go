@@ -0,0 +1,249 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/model"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/port"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/valueobject"
+)
+
+// Topics tasks are raised and transaction timelines recorded from.
+const (
+	FraudEventsTopic           = "fraud-events"
+	IdentityVerificationsTopic = "bib.identity.verifications"
+	PaymentOrdersTopic         = "bib.payment.orders"
+	LendingEventsTopic         = "lending-events"
+	CardEventsTopic            = "card-events"
+	LedgerEntriesTopic         = "bib.ledger.entries"
+)
+
+// SourceTopics lists the topics tasks are raised and transaction timelines
+// recorded from.
+var SourceTopics = []string{
+	FraudEventsTopic,
+	IdentityVerificationsTopic,
+	PaymentOrdersTopic,
+	LendingEventsTopic,
+	CardEventsTopic,
+	LedgerEntriesTopic,
+}
+
+// IngestConfig sets the deadlines of tasks whose source events carry none.
+type IngestConfig struct {
+	// PaymentRepairSLA is how long after a payment fails it is to be
+	// repaired.
+	PaymentRepairSLA time.Duration
+	// CollectionSLA is how long after a loan falls behind a collector is to
+	// have acted on it.
+	CollectionSLA time.Duration
+}
+
+// sourceEvent holds the fields of the events tasks are raised from and
+// closed by. Each event sets the subset it carries.
+type sourceEvent struct {
+	OccurredAt         time.Time       `json:"occurred_at"`
+	SLADueAt           time.Time       `json:"sla_due_at"`
+	DueAt              time.Time       `json:"due_at"`
+	TenantID           string          `json:"tenant_id"`
+	AggregateID        string          `json:"aggregate_id"`
+	Reason             string          `json:"reason"`
+	Disposition        string          `json:"disposition"`
+	Outcome            string          `json:"outcome"`
+	FailureReason      string          `json:"failure_reason"`
+	AMLScenario        string          `json:"aml_scenario"`
+	OutstandingBalance decimal.Decimal `json:"outstanding_balance"`
+	RiskScore          int             `json:"risk_score"`
+	RequiresFourEyes   bool            `json:"requires_four_eyes"`
+	CaseID             uuid.UUID       `json:"case_id"`
+	VerificationID     uuid.UUID       `json:"verification_id"`
+	TransactionID      uuid.UUID       `json:"transaction_id"`
+	AccountID          uuid.UUID       `json:"account_id"`
+	PaymentID          uuid.UUID       `json:"payment_id"`
+}
+
+// IngestEventUseCase raises tasks from the events of the services whose
+// work needs an operator, and closes them when the work is done in those
+// services. A source has at most one unresolved task per queue, so
+// redelivered events raise nothing new. Events that need no operator are
+// ignored.
+type IngestEventUseCase struct {
+	tasks     port.TaskRepository
+	publisher port.EventPublisher
+	logger    *slog.Logger
+	config    IngestConfig
+}
+
+// NewIngestEventUseCase creates a new IngestEventUseCase.
+func NewIngestEventUseCase(tasks port.TaskRepository, publisher port.EventPublisher, config IngestConfig, logger *slog.Logger) *IngestEventUseCase {
+	return &IngestEventUseCase{tasks: tasks, publisher: publisher, config: config, logger: logger}
+}
+
+// Execute applies an event of the given type. The payload is a CloudEvents
+// envelope or the bare event.
+func (uc *IngestEventUseCase) Execute(ctx context.Context, eventType string, payload []byte) error {
+	switch eventType {
+	case "fraud.case.opened", "fraud.case.resolved",
+		"identity.review.opened", "identity.review.decided",
+		"payment.order.failed",
+		"lending.loan.delinquent", "lending.loan.default", "lending.loan.paid_off":
+	default:
+		return nil
+	}
+
+	data, err := events.EventData(payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	var evt sourceEvent
+	if err := json.Unmarshal(data, &evt); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	tenantID, err := uuid.Parse(evt.TenantID)
+	if err != nil {
+		return fmt.Errorf("%s event has invalid tenant ID %q: %w", eventType, evt.TenantID, err)
+	}
+	at := evt.OccurredAt.UTC()
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+
+	switch eventType {
+	case "fraud.case.opened":
+		summary := fmt.Sprintf("Review transaction %s (risk score %d)", evt.TransactionID, evt.RiskScore)
+		details := map[string]string{
+			"transaction_id": evt.TransactionID.String(),
+			"account_id":     evt.AccountID.String(),
+			"risk_score":     strconv.Itoa(evt.RiskScore),
+		}
+		if evt.AMLScenario != "" {
+			summary = fmt.Sprintf("Investigate %s alert on account %s (risk score %d)", evt.AMLScenario, evt.AccountID, evt.RiskScore)
+			details["aml_scenario"] = evt.AMLScenario
+		}
+		return uc.open(ctx, tenantID, valueobject.QueueFraudReview, evt.CaseID.String(), summary, details, optionalTime(evt.SLADueAt), at)
+	case "identity.review.opened":
+		return uc.open(ctx, tenantID, valueobject.QueueKYCReview, evt.CaseID.String(),
+			fmt.Sprintf("Review verification %s: %s", evt.VerificationID, evt.Reason),
+			map[string]string{
+				"verification_id":    evt.VerificationID.String(),
+				"reason":             evt.Reason,
+				"requires_four_eyes": strconv.FormatBool(evt.RequiresFourEyes),
+			}, optionalTime(evt.DueAt), at)
+	case "payment.order.failed":
+		dueAt := at.Add(uc.config.PaymentRepairSLA)
+		return uc.open(ctx, tenantID, valueobject.QueuePaymentRepair, evt.PaymentID.String(),
+			fmt.Sprintf("Repair payment %s: %s", evt.PaymentID, evt.FailureReason),
+			map[string]string{"failure_reason": evt.FailureReason}, &dueAt, at)
+	case "lending.loan.delinquent", "lending.loan.default":
+		stage := "delinquent"
+		if eventType == "lending.loan.default" {
+			stage = "default"
+		}
+		dueAt := at.Add(uc.config.CollectionSLA)
+		return uc.open(ctx, tenantID, valueobject.QueueLoanCollection, evt.AggregateID,
+			fmt.Sprintf("Collect loan %s in %s, %s outstanding", evt.AggregateID, stage, evt.OutstandingBalance),
+			map[string]string{
+				"stage":               stage,
+				"outstanding_balance": evt.OutstandingBalance.String(),
+			}, &dueAt, at)
+	case "fraud.case.resolved":
+		return uc.closeAtSource(ctx, tenantID, valueobject.QueueFraudReview, evt.CaseID.String(), evt.Disposition, "case resolved in fraud service", at)
+	case "identity.review.decided":
+		return uc.closeAtSource(ctx, tenantID, valueobject.QueueKYCReview, evt.CaseID.String(), evt.Outcome, evt.Reason, at)
+	default: // lending.loan.paid_off
+		return uc.closeAtSource(ctx, tenantID, valueobject.QueueLoanCollection, evt.AggregateID, "RECOVERED", "loan paid off", at)
+	}
+}
+
+// open raises a task for the source unless it already has one; a source
+// of a queue whose work recurs gets a new task once its last is resolved.
+func (uc *IngestEventUseCase) open(
+	ctx context.Context,
+	tenantID uuid.UUID,
+	queue valueobject.Queue,
+	sourceID, summary string,
+	details map[string]string,
+	dueAt *time.Time,
+	now time.Time,
+) error {
+	existing, err := uc.tasks.FindLatestBySource(ctx, tenantID, queue, sourceID)
+	switch {
+	case err == nil && (!existing.IsResolved() || !queue.Recurs()):
+		return nil
+	case err != nil && !errors.Is(err, port.ErrTaskNotFound):
+		return fmt.Errorf("failed to find task: %w", err)
+	}
+
+	task, err := model.NewTask(tenantID, queue, sourceID, summary, details, dueAt, nil, now)
+	if err != nil {
+		return fmt.Errorf("failed to open %s task for %s: %w", queue, sourceID, err)
+	}
+	if err := uc.tasks.Save(ctx, task); err != nil {
+		if errors.Is(err, port.ErrVersionConflict) {
+			// Opened concurrently from a redelivered event.
+			return nil
+		}
+		return fmt.Errorf("failed to save task: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, task.DomainEvents()); err != nil {
+		return fmt.Errorf("failed to publish events: %w", err)
+	}
+	uc.logger.Info("task opened", "queue", queue.String(), "source_id", sourceID, "task_id", task.ID())
+	return nil
+}
+
+// closeAtSource resolves the source's unresolved task, if it has one,
+// because the work was done in the service that owns the source.
+func (uc *IngestEventUseCase) closeAtSource(
+	ctx context.Context,
+	tenantID uuid.UUID,
+	queue valueobject.Queue,
+	sourceID, outcome, detail string,
+	now time.Time,
+) error {
+	var task model.Task
+	err := retryOnConflict(func() error {
+		existing, err := uc.tasks.FindLatestBySource(ctx, tenantID, queue, sourceID)
+		if errors.Is(err, port.ErrTaskNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to find task: %w", err)
+		}
+		if existing.IsResolved() {
+			return nil
+		}
+		if task, err = existing.CloseAtSource(outcome, detail, now); err != nil {
+			return err
+		}
+		if err := uc.tasks.Save(ctx, task); err != nil {
+			return fmt.Errorf("failed to save task: %w", err)
+		}
+		return nil
+	})
+	if err != nil || len(task.DomainEvents()) == 0 {
+		return err
+	}
+	if err := uc.publisher.Publish(ctx, task.DomainEvents()); err != nil {
+		return fmt.Errorf("failed to publish events: %w", err)
+	}
+	return nil
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
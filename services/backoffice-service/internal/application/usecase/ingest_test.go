@@ -0,0 +1,117 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/backoffice-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/valueobject"
+)
+
+func payload(t *testing.T, fields map[string]any) []byte {
+	t.Helper()
+	b, err := json.Marshal(fields)
+	require.NoError(t, err)
+	return b
+}
+
+func newIngest(c *console) *usecase.IngestEventUseCase {
+	return usecase.NewIngestEventUseCase(c.repo, c.publisher, usecase.IngestConfig{
+		PaymentRepairSLA: 24 * time.Hour,
+		CollectionSLA:    72 * time.Hour,
+	}, slog.Default())
+}
+
+func TestIngestEvent_FraudCase(t *testing.T) {
+	c := newConsole()
+	ingest := newIngest(c)
+	caseID := uuid.New()
+	due := time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)
+	opened := payload(t, map[string]any{
+		"tenant_id":      c.tenantID.String(),
+		"case_id":        caseID,
+		"transaction_id": uuid.New(),
+		"risk_score":     82,
+		"sla_due_at":     due,
+	})
+
+	require.NoError(t, ingest.Execute(context.Background(), "fraud.case.opened", opened))
+	require.NoError(t, ingest.Execute(context.Background(), "fraud.case.opened", opened), "redelivered")
+	require.Len(t, c.repo.tasks, 1)
+	task := c.repo.tasks[0]
+	assert.Equal(t, valueobject.QueueFraudReview, task.Queue())
+	assert.Equal(t, caseID.String(), task.SourceID())
+	assert.Equal(t, "82", task.Details()["risk_score"])
+	require.NotNil(t, task.DueAt())
+	assert.Equal(t, due, *task.DueAt())
+
+	require.NoError(t, ingest.Execute(context.Background(), "fraud.case.resolved", payload(t, map[string]any{
+		"tenant_id":   c.tenantID.String(),
+		"case_id":     caseID,
+		"disposition": "CONFIRMED_FRAUD",
+	})))
+	closed := c.repo.tasks[0]
+	assert.True(t, closed.IsResolved())
+	assert.Equal(t, "CONFIRMED_FRAUD", closed.Outcome())
+	assert.Equal(t, []string{"backoffice.task.opened", "backoffice.task.resolved"}, c.publisher.types)
+
+	require.NoError(t, ingest.Execute(context.Background(), "fraud.case.opened", opened))
+	assert.Len(t, c.repo.tasks, 1, "a resolved fraud case is not reopened")
+
+	accountID := uuid.New()
+	require.NoError(t, ingest.Execute(context.Background(), "fraud.case.opened", payload(t, map[string]any{
+		"tenant_id":      c.tenantID.String(),
+		"case_id":        uuid.New(),
+		"transaction_id": uuid.New(),
+		"account_id":     accountID,
+		"risk_score":     70,
+		"aml_scenario":   "STRUCTURING",
+		"sla_due_at":     due,
+	})))
+	require.Len(t, c.repo.tasks, 2)
+	alert := c.repo.tasks[1]
+	assert.Equal(t, "Investigate STRUCTURING alert on account "+accountID.String()+" (risk score 70)", alert.Summary())
+	assert.Equal(t, "STRUCTURING", alert.Details()["aml_scenario"])
+}
+
+func TestIngestEvent_LoanCollectionRecurs(t *testing.T) {
+	c := newConsole()
+	ingest := newIngest(c)
+	loanID := uuid.NewString()
+	delinquent := payload(t, map[string]any{
+		"tenant_id":           c.tenantID.String(),
+		"aggregate_id":        loanID,
+		"outstanding_balance": "1200.50",
+		"occurred_at":         time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+	})
+
+	require.NoError(t, ingest.Execute(context.Background(), "lending.loan.delinquent", delinquent))
+	require.Len(t, c.repo.tasks, 1)
+	assert.Equal(t, time.Date(2026, 3, 13, 0, 0, 0, 0, time.UTC), *c.repo.tasks[0].DueAt())
+
+	require.NoError(t, ingest.Execute(context.Background(), "lending.loan.paid_off", payload(t, map[string]any{
+		"tenant_id":    c.tenantID.String(),
+		"aggregate_id": loanID,
+	})))
+	assert.Equal(t, "RECOVERED", c.repo.tasks[0].Outcome())
+
+	require.NoError(t, ingest.Execute(context.Background(), "lending.loan.delinquent", delinquent))
+	assert.Len(t, c.repo.tasks, 2, "a loan falling behind again is collected again")
+}
+
+func TestIngestEvent_Ignored(t *testing.T) {
+	c := newConsole()
+	ingest := newIngest(c)
+	require.NoError(t, ingest.Execute(context.Background(), "payment.order.settled", []byte(`{}`)))
+	assert.Empty(t, c.repo.tasks)
+
+	err := ingest.Execute(context.Background(), "payment.order.failed", payload(t, map[string]any{"tenant_id": "nope"}))
+	assert.Error(t, err)
+}
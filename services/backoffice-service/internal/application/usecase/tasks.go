@@ -0,0 +1,386 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/backoffice-service/internal/application/dto"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/model"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/port"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/valueobject"
+)
+
+// ErrInvalidRequest is returned when a request is malformed.
+var ErrInvalidRequest = errors.New("invalid request")
+
+// ErrTaskExists is returned when a task is opened for a source that
+// already has an unresolved task in the queue.
+var ErrTaskExists = errors.New("task already open")
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// maxAttempts bounds how often a change to a task is retried when another
+// change to it was saved first.
+const maxAttempts = 3
+
+// CaseSystems are the services whose cases the tasks of each queue stand
+// for. Queues without one are worked in the console alone.
+type CaseSystems map[valueobject.Queue]port.CaseSystem
+
+// ListTasksUseCase lists a tenant's tasks.
+type ListTasksUseCase struct {
+	tasks port.TaskRepository
+}
+
+// NewListTasksUseCase creates a new ListTasksUseCase.
+func NewListTasksUseCase(tasks port.TaskRepository) *ListTasksUseCase {
+	return &ListTasksUseCase{tasks: tasks}
+}
+
+// Execute returns one page of the tasks matching the request.
+func (uc *ListTasksUseCase) Execute(ctx context.Context, req dto.ListTasksRequest) (dto.ListTasksResponse, error) {
+	filter := port.TaskFilter{TenantID: req.TenantID, ClaimedBy: req.ClaimedBy}
+	var err error
+	if req.Queue != "" {
+		if filter.Queue, err = valueobject.NewQueue(req.Queue); err != nil {
+			return dto.ListTasksResponse{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+	}
+	if req.Status != "" {
+		if filter.Status, err = valueobject.NewTaskStatus(req.Status); err != nil {
+			return dto.ListTasksResponse{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+	}
+	now := time.Now().UTC()
+	if req.OverdueOnly {
+		filter.OverdueAt = &now
+	}
+
+	limit, offset := page(req.Limit, req.Offset)
+	tasks, total, err := uc.tasks.List(ctx, filter, limit, offset)
+	if err != nil {
+		return dto.ListTasksResponse{}, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	resp := dto.ListTasksResponse{
+		Tasks:      make([]dto.TaskResponse, 0, len(tasks)),
+		TotalCount: total,
+	}
+	for _, t := range tasks {
+		resp.Tasks = append(resp.Tasks, toTaskResponse(t, now))
+	}
+	return resp, nil
+}
+
+// GetTaskUseCase retrieves a task with its audit trail.
+type GetTaskUseCase struct {
+	tasks port.TaskRepository
+}
+
+// NewGetTaskUseCase creates a new GetTaskUseCase.
+func NewGetTaskUseCase(tasks port.TaskRepository) *GetTaskUseCase {
+	return &GetTaskUseCase{tasks: tasks}
+}
+
+// Execute returns the task and its audit trail, oldest first.
+func (uc *GetTaskUseCase) Execute(ctx context.Context, req dto.TaskActionRequest) (dto.GetTaskResponse, error) {
+	task, err := uc.tasks.FindByID(ctx, req.TenantID, req.TaskID)
+	if err != nil {
+		return dto.GetTaskResponse{}, fmt.Errorf("failed to find task: %w", err)
+	}
+	entries, err := uc.tasks.ListAudit(ctx, req.TenantID, req.TaskID)
+	if err != nil {
+		return dto.GetTaskResponse{}, fmt.Errorf("failed to list audit trail: %w", err)
+	}
+	resp := dto.GetTaskResponse{
+		Task:       toTaskResponse(task, time.Now().UTC()),
+		AuditTrail: make([]dto.AuditEntryResponse, 0, len(entries)),
+	}
+	for _, e := range entries {
+		resp.AuditTrail = append(resp.AuditTrail, dto.AuditEntryResponse{
+			ID:         e.ID,
+			Action:     e.Action,
+			ActorID:    e.ActorID,
+			Detail:     e.Detail,
+			OccurredAt: e.OccurredAt,
+		})
+	}
+	return resp, nil
+}
+
+// OpenTaskUseCase raises a task by hand, such as a reconciliation break
+// found by an operator.
+type OpenTaskUseCase struct {
+	tasks     port.TaskRepository
+	publisher port.EventPublisher
+}
+
+// NewOpenTaskUseCase creates a new OpenTaskUseCase.
+func NewOpenTaskUseCase(tasks port.TaskRepository, publisher port.EventPublisher) *OpenTaskUseCase {
+	return &OpenTaskUseCase{tasks: tasks, publisher: publisher}
+}
+
+// Execute opens the task, unless the source already has an unresolved
+// task in the queue.
+func (uc *OpenTaskUseCase) Execute(ctx context.Context, req dto.OpenTaskRequest) (dto.TaskResponse, error) {
+	queue, err := valueobject.NewQueue(req.Queue)
+	if err != nil {
+		return dto.TaskResponse{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+	now := time.Now().UTC()
+	task, err := model.NewTask(req.TenantID, queue, req.SourceID, req.Summary, req.Details, req.DueAt, &req.OperatorID, now)
+	if err != nil {
+		return dto.TaskResponse{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+
+	existing, err := uc.tasks.FindLatestBySource(ctx, req.TenantID, queue, req.SourceID)
+	switch {
+	case err == nil && !existing.IsResolved():
+		return dto.TaskResponse{}, fmt.Errorf("%w: task %s for %s", ErrTaskExists, existing.ID(), req.SourceID)
+	case err != nil && !errors.Is(err, port.ErrTaskNotFound):
+		return dto.TaskResponse{}, fmt.Errorf("failed to find task: %w", err)
+	}
+	if err := uc.tasks.Save(ctx, task); err != nil {
+		if errors.Is(err, port.ErrVersionConflict) {
+			return dto.TaskResponse{}, fmt.Errorf("%w: for %s", ErrTaskExists, req.SourceID)
+		}
+		return dto.TaskResponse{}, fmt.Errorf("failed to save task: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, task.DomainEvents()); err != nil {
+		return dto.TaskResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toTaskResponse(task, now), nil
+}
+
+// ClaimTaskUseCase takes a task for an operator.
+type ClaimTaskUseCase struct {
+	tasks     port.TaskRepository
+	systems   CaseSystems
+	publisher port.EventPublisher
+}
+
+// NewClaimTaskUseCase creates a new ClaimTaskUseCase.
+func NewClaimTaskUseCase(tasks port.TaskRepository, systems CaseSystems, publisher port.EventPublisher) *ClaimTaskUseCase {
+	return &ClaimTaskUseCase{tasks: tasks, systems: systems, publisher: publisher}
+}
+
+// Execute claims the task, assigning its case to the operator in the
+// service that owns it first, so that the operator can decide it there.
+func (uc *ClaimTaskUseCase) Execute(ctx context.Context, req dto.TaskActionRequest) (dto.TaskResponse, error) {
+	task, err := uc.tasks.FindByID(ctx, req.TenantID, req.TaskID)
+	if err != nil {
+		return dto.TaskResponse{}, fmt.Errorf("failed to find task: %w", err)
+	}
+	now := time.Now().UTC()
+	task, changed, err := task.Claim(req.OperatorID, now)
+	if err != nil {
+		return dto.TaskResponse{}, domainError(err)
+	}
+	if !changed {
+		return toTaskResponse(task, now), nil
+	}
+	if system, ok := uc.systems[task.Queue()]; ok {
+		if err := system.Claim(ctx, req.TenantID, req.OperatorID, task.SourceID()); err != nil {
+			return dto.TaskResponse{}, fmt.Errorf("failed to claim %s case %s: %w", task.Queue(), task.SourceID(), err)
+		}
+	}
+	return save(ctx, uc.tasks, uc.publisher, task, now)
+}
+
+// ReleaseTaskUseCase hands a claimed task back to its queue.
+type ReleaseTaskUseCase struct {
+	tasks     port.TaskRepository
+	publisher port.EventPublisher
+}
+
+// NewReleaseTaskUseCase creates a new ReleaseTaskUseCase.
+func NewReleaseTaskUseCase(tasks port.TaskRepository, publisher port.EventPublisher) *ReleaseTaskUseCase {
+	return &ReleaseTaskUseCase{tasks: tasks, publisher: publisher}
+}
+
+// Execute releases the task. Its case stays assigned in the service that
+// owns it until the next operator claims the task.
+func (uc *ReleaseTaskUseCase) Execute(ctx context.Context, req dto.ReleaseTaskRequest) (dto.TaskResponse, error) {
+	task, err := uc.tasks.FindByID(ctx, req.TenantID, req.TaskID)
+	if err != nil {
+		return dto.TaskResponse{}, fmt.Errorf("failed to find task: %w", err)
+	}
+	now := time.Now().UTC()
+	if task, err = task.Release(req.OperatorID, req.Reason, req.Supervisor, now); err != nil {
+		return dto.TaskResponse{}, domainError(err)
+	}
+	return save(ctx, uc.tasks, uc.publisher, task, now)
+}
+
+// AddTaskNoteUseCase records an operator's note on a task.
+type AddTaskNoteUseCase struct {
+	tasks port.TaskRepository
+}
+
+// NewAddTaskNoteUseCase creates a new AddTaskNoteUseCase.
+func NewAddTaskNoteUseCase(tasks port.TaskRepository) *AddTaskNoteUseCase {
+	return &AddTaskNoteUseCase{tasks: tasks}
+}
+
+// Execute adds the note to the task's audit trail.
+func (uc *AddTaskNoteUseCase) Execute(ctx context.Context, req dto.AddTaskNoteRequest) (dto.TaskResponse, error) {
+	var task model.Task
+	var now time.Time
+	err := retryOnConflict(func() error {
+		found, err := uc.tasks.FindByID(ctx, req.TenantID, req.TaskID)
+		if err != nil {
+			return fmt.Errorf("failed to find task: %w", err)
+		}
+		now = time.Now().UTC()
+		if task, err = found.AddNote(req.OperatorID, req.Note, now); err != nil {
+			return domainError(err)
+		}
+		if err := uc.tasks.Save(ctx, task); err != nil {
+			return fmt.Errorf("failed to save task: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dto.TaskResponse{}, err
+	}
+	return toTaskResponse(task, now), nil
+}
+
+// ResolveTaskUseCase closes an operator's claimed task with an outcome.
+type ResolveTaskUseCase struct {
+	tasks     port.TaskRepository
+	systems   CaseSystems
+	publisher port.EventPublisher
+}
+
+// NewResolveTaskUseCase creates a new ResolveTaskUseCase.
+func NewResolveTaskUseCase(tasks port.TaskRepository, systems CaseSystems, publisher port.EventPublisher) *ResolveTaskUseCase {
+	return &ResolveTaskUseCase{tasks: tasks, systems: systems, publisher: publisher}
+}
+
+// Execute resolves the task, deciding its case in the service that owns it
+// first. When that decision needs a second operator's approval, the task
+// goes back to its queue for them instead.
+func (uc *ResolveTaskUseCase) Execute(ctx context.Context, req dto.ResolveTaskRequest) (dto.TaskResponse, error) {
+	task, err := uc.tasks.FindByID(ctx, req.TenantID, req.TaskID)
+	if err != nil {
+		return dto.TaskResponse{}, fmt.Errorf("failed to find task: %w", err)
+	}
+	now := time.Now().UTC()
+	resolved, err := task.Resolve(req.OperatorID, req.Outcome, req.Note, now)
+	if err != nil {
+		return dto.TaskResponse{}, domainError(err)
+	}
+	if system, ok := uc.systems[task.Queue()]; ok {
+		final, err := system.Resolve(ctx, req.TenantID, req.OperatorID, task.SourceID(), req.Outcome, req.Note)
+		if err != nil {
+			return dto.TaskResponse{}, fmt.Errorf("failed to resolve %s case %s: %w", task.Queue(), task.SourceID(), err)
+		}
+		if !final {
+			if resolved, err = task.RecordFirstApproval(req.OperatorID, req.Outcome, req.Note, now); err != nil {
+				return dto.TaskResponse{}, domainError(err)
+			}
+		}
+	}
+	return save(ctx, uc.tasks, uc.publisher, resolved, now)
+}
+
+// GetQueueSummaryUseCase counts the work waiting in each queue.
+type GetQueueSummaryUseCase struct {
+	tasks port.TaskRepository
+}
+
+// NewGetQueueSummaryUseCase creates a new GetQueueSummaryUseCase.
+func NewGetQueueSummaryUseCase(tasks port.TaskRepository) *GetQueueSummaryUseCase {
+	return &GetQueueSummaryUseCase{tasks: tasks}
+}
+
+// Execute returns every queue's counts, including empty queues.
+func (uc *GetQueueSummaryUseCase) Execute(ctx context.Context, req dto.QueueSummaryRequest) ([]dto.QueueSummary, error) {
+	counts, err := uc.tasks.CountByQueue(ctx, req.TenantID, req.OperatorID, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count tasks: %w", err)
+	}
+	byQueue := make(map[valueobject.Queue]port.QueueCounts, len(counts))
+	for _, c := range counts {
+		byQueue[c.Queue] = c
+	}
+	summary := make([]dto.QueueSummary, 0, len(valueobject.Queues))
+	for _, q := range valueobject.Queues {
+		c := byQueue[q]
+		summary = append(summary, dto.QueueSummary{
+			Queue:           q.String(),
+			Open:            c.Open,
+			Claimed:         c.Claimed,
+			Overdue:         c.Overdue,
+			ClaimedByCaller: c.ClaimedByCaller,
+		})
+	}
+	return summary, nil
+}
+
+// save persists a changed task and publishes its events.
+func save(ctx context.Context, tasks port.TaskRepository, publisher port.EventPublisher, task model.Task, now time.Time) (dto.TaskResponse, error) {
+	if err := tasks.Save(ctx, task); err != nil {
+		return dto.TaskResponse{}, fmt.Errorf("failed to save task: %w", err)
+	}
+	if err := publisher.Publish(ctx, task.DomainEvents()); err != nil {
+		return dto.TaskResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toTaskResponse(task, now), nil
+}
+
+// domainError marks a task's refusal of an action as an invalid request,
+// unless it is a conflict with the task's status.
+func domainError(err error) error {
+	if errors.Is(err, model.ErrInvalidTransition) || errors.Is(err, model.ErrClaimedByAnother) {
+		return err
+	}
+	return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+}
+
+// retryOnConflict runs fn again while it fails with a version conflict, up
+// to maxAttempts times.
+func retryOnConflict(fn func() error) error {
+	var err error
+	for range maxAttempts {
+		if err = fn(); !errors.Is(err, port.ErrVersionConflict) {
+			return err
+		}
+	}
+	return err
+}
+
+func page(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	return min(limit, maxLimit), max(offset, 0)
+}
+
+func toTaskResponse(t model.Task, now time.Time) dto.TaskResponse {
+	return dto.TaskResponse{
+		ID:             t.ID(),
+		TenantID:       t.TenantID(),
+		Queue:          t.Queue().String(),
+		SourceID:       t.SourceID(),
+		Summary:        t.Summary(),
+		Details:        t.Details(),
+		Status:         t.Status().String(),
+		ClaimedBy:      t.ClaimedBy(),
+		ClaimedAt:      t.ClaimedAt(),
+		DueAt:          t.DueAt(),
+		Overdue:        t.IsOverdue(now),
+		Outcome:        t.Outcome(),
+		ResolutionNote: t.ResolutionNote(),
+		ResolvedBy:     t.ResolvedBy(),
+		ResolvedAt:     t.ResolvedAt(),
+		Version:        t.Version(),
+		CreatedAt:      t.CreatedAt(),
+		UpdatedAt:      t.UpdatedAt(),
+	}
+}
@@ -0,0 +1,236 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/backoffice-service/internal/application/dto"
+	"github.com/bibbank/bib/services/backoffice-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/model"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/port"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/valueobject"
+)
+
+type console struct {
+	repo      *inMemoryTaskRepo
+	publisher *recordingPublisher
+	fraud     *fakeCaseSystem
+	identity  *fakeCaseSystem
+	tenantID  uuid.UUID
+}
+
+func newConsole() *console {
+	return &console{
+		repo:      &inMemoryTaskRepo{},
+		publisher: &recordingPublisher{},
+		fraud:     &fakeCaseSystem{},
+		identity:  &fakeCaseSystem{fourEyes: true},
+		tenantID:  uuid.New(),
+	}
+}
+
+func (c *console) systems() usecase.CaseSystems {
+	return usecase.CaseSystems{
+		valueobject.QueueFraudReview: c.fraud,
+		valueobject.QueueKYCReview:   c.identity,
+	}
+}
+
+func (c *console) open(t *testing.T, queue, sourceID string, dueAt *time.Time) dto.TaskResponse {
+	t.Helper()
+	resp, err := usecase.NewOpenTaskUseCase(c.repo, c.publisher).Execute(context.Background(), dto.OpenTaskRequest{
+		TenantID:   c.tenantID,
+		OperatorID: uuid.New(),
+		Queue:      queue,
+		SourceID:   sourceID,
+		Summary:    "Work " + sourceID,
+		DueAt:      dueAt,
+	})
+	require.NoError(t, err)
+	return resp
+}
+
+func (c *console) claim(operatorID, taskID uuid.UUID) (dto.TaskResponse, error) {
+	return usecase.NewClaimTaskUseCase(c.repo, c.systems(), c.publisher).Execute(context.Background(), dto.TaskActionRequest{
+		TenantID: c.tenantID, TaskID: taskID, OperatorID: operatorID,
+	})
+}
+
+func (c *console) resolve(operatorID, taskID uuid.UUID, outcome string) (dto.TaskResponse, error) {
+	return usecase.NewResolveTaskUseCase(c.repo, c.systems(), c.publisher).Execute(context.Background(), dto.ResolveTaskRequest{
+		TenantID: c.tenantID, TaskID: taskID, OperatorID: operatorID, Outcome: outcome, Note: "checked",
+	})
+}
+
+func TestOpenTask(t *testing.T) {
+	c := newConsole()
+	task := c.open(t, "RECONCILIATION_BREAK", "stmt-1", nil)
+	assert.Equal(t, "OPEN", task.Status)
+	assert.Equal(t, []string{"backoffice.task.opened"}, c.publisher.types)
+
+	_, err := usecase.NewOpenTaskUseCase(c.repo, c.publisher).Execute(context.Background(), dto.OpenTaskRequest{
+		TenantID: c.tenantID, Queue: "RECONCILIATION_BREAK", SourceID: "stmt-1", Summary: "again",
+	})
+	assert.ErrorIs(t, err, usecase.ErrTaskExists)
+
+	_, err = usecase.NewOpenTaskUseCase(c.repo, c.publisher).Execute(context.Background(), dto.OpenTaskRequest{
+		TenantID: c.tenantID, Queue: "COMPLAINTS", SourceID: "x", Summary: "y",
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidRequest)
+}
+
+func TestClaimTask(t *testing.T) {
+	c := newConsole()
+	alice, bob := uuid.New(), uuid.New()
+	task := c.open(t, "FRAUD_REVIEW", "case-1", nil)
+
+	claimed, err := c.claim(alice, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "CLAIMED", claimed.Status)
+	assert.Equal(t, alice, c.fraud.claimed["case-1"], "the case is assigned in the fraud service")
+
+	_, err = c.claim(bob, task.ID)
+	assert.ErrorIs(t, err, model.ErrClaimedByAnother)
+
+	again, err := c.claim(alice, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, claimed.Version, again.Version, "claiming again changes nothing")
+}
+
+func TestClaimTask_RejectedAtSource(t *testing.T) {
+	c := newConsole()
+	c.fraud.err = port.ErrSourceRejected
+	task := c.open(t, "FRAUD_REVIEW", "case-1", nil)
+
+	_, err := c.claim(uuid.New(), task.ID)
+	assert.ErrorIs(t, err, port.ErrSourceRejected)
+	stored, err := c.repo.FindByID(context.Background(), c.tenantID, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.TaskStatusOpen, stored.Status(), "the task stays in its queue")
+}
+
+func TestReleaseTask(t *testing.T) {
+	c := newConsole()
+	alice, admin := uuid.New(), uuid.New()
+	task := c.open(t, "PAYMENT_REPAIR", uuid.NewString(), nil)
+	_, err := c.claim(alice, task.ID)
+	require.NoError(t, err)
+
+	release := usecase.NewReleaseTaskUseCase(c.repo, c.publisher)
+	_, err = release.Execute(context.Background(), dto.ReleaseTaskRequest{TenantID: c.tenantID, TaskID: task.ID, OperatorID: admin})
+	assert.ErrorIs(t, err, model.ErrClaimedByAnother)
+
+	released, err := release.Execute(context.Background(), dto.ReleaseTaskRequest{
+		TenantID: c.tenantID, TaskID: task.ID, OperatorID: admin, Supervisor: true, Reason: "rebalancing",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "OPEN", released.Status)
+	assert.Nil(t, released.ClaimedBy)
+}
+
+func TestResolveTask(t *testing.T) {
+	c := newConsole()
+	alice := uuid.New()
+	task := c.open(t, "FRAUD_REVIEW", "case-1", nil)
+	_, err := c.claim(alice, task.ID)
+	require.NoError(t, err)
+
+	_, err = c.resolve(alice, task.ID, "APPROVE")
+	assert.ErrorIs(t, err, usecase.ErrInvalidRequest, "not a fraud outcome")
+
+	resolved, err := c.resolve(alice, task.ID, "FALSE_POSITIVE")
+	require.NoError(t, err)
+	assert.Equal(t, "RESOLVED", resolved.Status)
+	assert.Equal(t, "FALSE_POSITIVE", c.fraud.decided["case-1"])
+	assert.Contains(t, c.publisher.types, "backoffice.task.resolved")
+
+	got, err := usecase.NewGetTaskUseCase(c.repo).Execute(context.Background(), dto.TaskActionRequest{TenantID: c.tenantID, TaskID: task.ID})
+	require.NoError(t, err)
+	var trail []string
+	for _, e := range got.AuditTrail {
+		trail = append(trail, e.Action)
+	}
+	assert.Equal(t, []string{"OPENED", "CLAIMED", "RESOLVED"}, trail)
+}
+
+func TestResolveTask_FourEyes(t *testing.T) {
+	c := newConsole()
+	alice, bob := uuid.New(), uuid.New()
+	task := c.open(t, "KYC_REVIEW", "review-1", nil)
+	_, err := c.claim(alice, task.ID)
+	require.NoError(t, err)
+
+	first, err := c.resolve(alice, task.ID, "APPROVE")
+	require.NoError(t, err)
+	assert.Equal(t, "OPEN", first.Status, "back in the queue for a second approver")
+	assert.Empty(t, c.identity.decided)
+
+	_, err = c.claim(bob, task.ID)
+	require.NoError(t, err)
+	second, err := c.resolve(bob, task.ID, "APPROVE")
+	require.NoError(t, err)
+	assert.Equal(t, "RESOLVED", second.Status)
+	assert.Equal(t, "APPROVE", c.identity.decided["review-1"])
+}
+
+func TestAddTaskNote(t *testing.T) {
+	c := newConsole()
+	task := c.open(t, "RECONCILIATION_BREAK", "stmt-1", nil)
+
+	noted, err := usecase.NewAddTaskNoteUseCase(c.repo).Execute(context.Background(), dto.AddTaskNoteRequest{
+		TenantID: c.tenantID, TaskID: task.ID, OperatorID: uuid.New(), Note: "bank statement requested",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, task.Version+1, noted.Version)
+	assert.Len(t, c.repo.audit[task.ID], 2)
+}
+
+func TestListTasks(t *testing.T) {
+	c := newConsole()
+	alice := uuid.New()
+	past := time.Now().UTC().Add(-time.Hour)
+	overdue := c.open(t, "PAYMENT_REPAIR", uuid.NewString(), &past)
+	fraud := c.open(t, "FRAUD_REVIEW", "case-1", nil)
+	_, err := c.claim(alice, fraud.ID)
+	require.NoError(t, err)
+
+	list := usecase.NewListTasksUseCase(c.repo)
+	resp, err := list.Execute(context.Background(), dto.ListTasksRequest{TenantID: c.tenantID, OverdueOnly: true})
+	require.NoError(t, err)
+	require.Len(t, resp.Tasks, 1)
+	assert.Equal(t, overdue.ID, resp.Tasks[0].ID)
+	assert.True(t, resp.Tasks[0].Overdue)
+
+	resp, err = list.Execute(context.Background(), dto.ListTasksRequest{TenantID: c.tenantID, ClaimedBy: &alice})
+	require.NoError(t, err)
+	require.Len(t, resp.Tasks, 1)
+	assert.Equal(t, fraud.ID, resp.Tasks[0].ID)
+
+	_, err = list.Execute(context.Background(), dto.ListTasksRequest{TenantID: c.tenantID, Status: "DONE"})
+	assert.ErrorIs(t, err, usecase.ErrInvalidRequest)
+}
+
+func TestGetQueueSummary(t *testing.T) {
+	c := newConsole()
+	alice := uuid.New()
+	past := time.Now().UTC().Add(-time.Hour)
+	c.open(t, "PAYMENT_REPAIR", uuid.NewString(), &past)
+	c.open(t, "PAYMENT_REPAIR", uuid.NewString(), nil)
+	fraud := c.open(t, "FRAUD_REVIEW", "case-1", nil)
+	_, err := c.claim(alice, fraud.ID)
+	require.NoError(t, err)
+
+	summary, err := usecase.NewGetQueueSummaryUseCase(c.repo).Execute(context.Background(), dto.QueueSummaryRequest{
+		TenantID: c.tenantID, OperatorID: alice,
+	})
+	require.NoError(t, err)
+	require.Len(t, summary, len(valueobject.Queues), "every queue, including empty ones")
+	assert.Equal(t, dto.QueueSummary{Queue: "FRAUD_REVIEW", Claimed: 1, ClaimedByCaller: 1}, summary[0])
+	assert.Equal(t, dto.QueueSummary{Queue: "PAYMENT_REPAIR", Open: 2, Overdue: 1}, summary[1])
+	assert.Equal(t, dto.QueueSummary{Queue: "RECONCILIATION_BREAK"}, summary[2])
+}
@@ -0,0 +1,288 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/backoffice-service/internal/application/dto"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/model"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/port"
+)
+
+// timelineStages maps the events transaction timelines are recorded from to
+// the stage of the journey each belongs to.
+var timelineStages = map[string]string{
+	"payment.order.initiated":     model.TimelineStageInitiation,
+	"fraud.assessment.completed":  model.TimelineStageFraudAssessment,
+	"fraud.high_risk.detected":    model.TimelineStageFraudAssessment,
+	"fraud.screening.hit":         model.TimelineStageFraudAssessment,
+	"fraud.case.opened":           model.TimelineStageFraudAssessment,
+	"fraud.case.resolved":         model.TimelineStageFraudAssessment,
+	"card.transaction.authorized": model.TimelineStageAuthorization,
+	"card.transaction.declined":   model.TimelineStageAuthorization,
+	"ledger.entry.posted":         model.TimelineStageLedgerPosting,
+	"ledger.entry.reversed":       model.TimelineStageLedgerPosting,
+	"payment.order.processing":    model.TimelineStageSettlement,
+	"payment.order.settled":       model.TimelineStageSettlement,
+	"payment.order.failed":        model.TimelineStageSettlement,
+	"payment.order.reversed":      model.TimelineStageDispute,
+	"fraud.label.recorded":        model.TimelineStageDispute,
+}
+
+type timelinePosting struct {
+	DebitAccount  string          `json:"debit_account"`
+	CreditAccount string          `json:"credit_account"`
+	Currency      string          `json:"currency"`
+	Amount        decimal.Decimal `json:"amount"`
+}
+
+// timelineEvent holds the fields of the events transaction timelines are
+// recorded from. Each event sets the subset it carries.
+type timelineEvent struct {
+	OccurredAt           time.Time         `json:"occurred_at"`
+	EventID              string            `json:"event_id"`
+	TenantID             string            `json:"tenant_id"`
+	Currency             string            `json:"currency"`
+	Rail                 string            `json:"rail"`
+	Reason               string            `json:"reason"`
+	FailureReason        string            `json:"failure_reason"`
+	Decision             string            `json:"decision"`
+	RiskLevel            string            `json:"risk_level"`
+	Disposition          string            `json:"disposition"`
+	Label                string            `json:"label"`
+	Source               string            `json:"source"`
+	SourceRef            string            `json:"source_ref"`
+	MerchantName         string            `json:"merchant_name"`
+	AuthCode             string            `json:"auth_code"`
+	Reference            string            `json:"reference"`
+	Postings             []timelinePosting `json:"postings"`
+	Amount               decimal.Decimal   `json:"amount"`
+	RiskScore            int               `json:"risk_score"`
+	MatchCount           int               `json:"match_count"`
+	TransactionID        uuid.UUID         `json:"transaction_id"`
+	PaymentID            uuid.UUID         `json:"payment_id"`
+	SourceAccountID      uuid.UUID         `json:"source_account_id"`
+	DestinationAccountID uuid.UUID         `json:"destination_account_id"`
+	CaseID               uuid.UUID         `json:"case_id"`
+	CardID               uuid.UUID         `json:"card_id"`
+	EntryID              uuid.UUID         `json:"entry_id"`
+	ReversalEntryID      uuid.UUID         `json:"reversal_entry_id"`
+}
+
+// RecordTimelineEventUseCase records the events of payments and card
+// transactions on their timelines, so support can follow one through
+// initiation, fraud assessment, authorization, ledger postings, settlement
+// and disputes without querying each service. Payment events belong to
+// their payment, fraud and card events to their transaction, and ledger
+// entries to the payment or transaction their reference names. Events
+// that belong to no transaction are ignored, as are redelivered ones.
+type RecordTimelineEventUseCase struct {
+	timeline port.TimelineRepository
+	logger   *slog.Logger
+}
+
+// NewRecordTimelineEventUseCase creates a new RecordTimelineEventUseCase.
+func NewRecordTimelineEventUseCase(timeline port.TimelineRepository, logger *slog.Logger) *RecordTimelineEventUseCase {
+	return &RecordTimelineEventUseCase{timeline: timeline, logger: logger}
+}
+
+// Execute records an event of the given type. The payload is a CloudEvents
+// envelope or the bare event.
+func (uc *RecordTimelineEventUseCase) Execute(ctx context.Context, eventType string, payload []byte) error {
+	stage, ok := timelineStages[eventType]
+	if !ok {
+		return nil
+	}
+
+	data, err := events.EventData(payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	var evt timelineEvent
+	if err := json.Unmarshal(data, &evt); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	tenantID, err := uuid.Parse(evt.TenantID)
+	if err != nil {
+		return fmt.Errorf("%s event has invalid tenant ID %q: %w", eventType, evt.TenantID, err)
+	}
+	if evt.EventID == "" {
+		return fmt.Errorf("%s event has no event ID", eventType)
+	}
+
+	service, _, _ := strings.Cut(eventType, ".")
+	var transactionID uuid.UUID
+	switch service {
+	case "payment":
+		transactionID = evt.PaymentID
+	case "ledger":
+		transactionID = referencedTransaction(evt.Reference)
+	default:
+		transactionID = evt.TransactionID
+	}
+	if transactionID == uuid.Nil {
+		return nil
+	}
+	at := evt.OccurredAt.UTC()
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+
+	summary, details := describeTimelineEvent(eventType, evt)
+	if err := uc.timeline.Append(ctx, model.TimelineEvent{
+		TenantID:      tenantID,
+		TransactionID: transactionID,
+		EventID:       evt.EventID,
+		EventType:     eventType,
+		Source:        service + "-service",
+		Stage:         stage,
+		Summary:       summary,
+		Details:       details,
+		OccurredAt:    at,
+	}); err != nil {
+		return fmt.Errorf("failed to record %s event: %w", eventType, err)
+	}
+	uc.logger.Debug("timeline event recorded", "event_type", eventType, "transaction_id", transactionID)
+	return nil
+}
+
+// referencedTransaction returns the payment or transaction ID a journal
+// entry's reference names, such as the order ID of payment/<id>/<leg>, or
+// uuid.Nil if it names none.
+func referencedTransaction(reference string) uuid.UUID {
+	for _, segment := range strings.Split(reference, "/") {
+		if id, err := uuid.Parse(segment); err == nil {
+			return id
+		}
+	}
+	return uuid.Nil
+}
+
+// describeTimelineEvent returns a one-line summary of the event for support
+// staff, and the fields worth showing alongside it.
+func describeTimelineEvent(eventType string, evt timelineEvent) (string, map[string]string) {
+	switch eventType {
+	case "payment.order.initiated":
+		return fmt.Sprintf("Payment of %s %s initiated on %s", evt.Amount, evt.Currency, evt.Rail),
+			map[string]string{
+				"amount":                 evt.Amount.String(),
+				"currency":               evt.Currency,
+				"rail":                   evt.Rail,
+				"source_account_id":      evt.SourceAccountID.String(),
+				"destination_account_id": evt.DestinationAccountID.String(),
+			}
+	case "payment.order.processing":
+		return fmt.Sprintf("Submitted to %s for settlement", evt.Rail), map[string]string{"rail": evt.Rail}
+	case "payment.order.settled":
+		return "Payment settled", nil
+	case "payment.order.failed":
+		return "Payment failed: " + evt.FailureReason, map[string]string{"failure_reason": evt.FailureReason}
+	case "payment.order.reversed":
+		return "Payment reversed: " + evt.Reason, map[string]string{"reason": evt.Reason}
+	case "fraud.assessment.completed":
+		return fmt.Sprintf("Assessed %s, risk score %d (%s)", evt.Decision, evt.RiskScore, evt.RiskLevel),
+			map[string]string{
+				"decision":   evt.Decision,
+				"risk_score": strconv.Itoa(evt.RiskScore),
+				"risk_level": evt.RiskLevel,
+			}
+	case "fraud.high_risk.detected":
+		return fmt.Sprintf("High risk detected, risk score %d", evt.RiskScore),
+			map[string]string{"risk_score": strconv.Itoa(evt.RiskScore)}
+	case "fraud.screening.hit":
+		return fmt.Sprintf("Sanctions screening matched %d list entries", evt.MatchCount),
+			map[string]string{"match_count": strconv.Itoa(evt.MatchCount)}
+	case "fraud.case.opened":
+		return fmt.Sprintf("Sent to manual review, risk score %d", evt.RiskScore),
+			map[string]string{"case_id": evt.CaseID.String(), "risk_score": strconv.Itoa(evt.RiskScore)}
+	case "fraud.case.resolved":
+		return "Review closed as " + evt.Disposition,
+			map[string]string{"case_id": evt.CaseID.String(), "disposition": evt.Disposition}
+	case "fraud.label.recorded":
+		return fmt.Sprintf("Labelled %s from %s", evt.Label, evt.Source),
+			map[string]string{"label": evt.Label, "source": evt.Source, "source_ref": evt.SourceRef}
+	case "card.transaction.authorized":
+		return fmt.Sprintf("Authorized %s %s at %s", evt.Amount, evt.Currency, evt.MerchantName),
+			map[string]string{
+				"card_id":       evt.CardID.String(),
+				"amount":        evt.Amount.String(),
+				"currency":      evt.Currency,
+				"merchant_name": evt.MerchantName,
+				"auth_code":     evt.AuthCode,
+			}
+	case "card.transaction.declined":
+		return fmt.Sprintf("Declined %s %s at %s: %s", evt.Amount, evt.Currency, evt.MerchantName, evt.Reason),
+			map[string]string{
+				"card_id":       evt.CardID.String(),
+				"amount":        evt.Amount.String(),
+				"currency":      evt.Currency,
+				"merchant_name": evt.MerchantName,
+				"reason":        evt.Reason,
+			}
+	case "ledger.entry.posted":
+		return fmt.Sprintf("Journal entry %s posted", evt.Reference), postingDetails(evt, evt.EntryID)
+	default: // ledger.entry.reversed
+		details := postingDetails(evt, evt.ReversalEntryID)
+		details["reversed_entry_id"] = evt.EntryID.String()
+		return fmt.Sprintf("Journal entry %s reversed", evt.Reference), details
+	}
+}
+
+// postingDetails describes a journal entry's postings, one debit/credit
+// pair per detail.
+func postingDetails(evt timelineEvent, entryID uuid.UUID) map[string]string {
+	details := map[string]string{"entry_id": entryID.String(), "reference": evt.Reference}
+	for i, p := range evt.Postings {
+		details["posting_"+strconv.Itoa(i+1)] = fmt.Sprintf("debit %s, credit %s, %s %s",
+			p.DebitAccount, p.CreditAccount, p.Amount, p.Currency)
+	}
+	return details
+}
+
+// GetTransactionTimelineUseCase retrieves a transaction's timeline.
+type GetTransactionTimelineUseCase struct {
+	timeline port.TimelineRepository
+}
+
+// NewGetTransactionTimelineUseCase creates a new
+// GetTransactionTimelineUseCase.
+func NewGetTransactionTimelineUseCase(timeline port.TimelineRepository) *GetTransactionTimelineUseCase {
+	return &GetTransactionTimelineUseCase{timeline: timeline}
+}
+
+// Execute returns the transaction's events, oldest first. A transaction
+// with none recorded is not found.
+func (uc *GetTransactionTimelineUseCase) Execute(ctx context.Context, req dto.TimelineRequest) (dto.TimelineResponse, error) {
+	recorded, err := uc.timeline.ListByTransaction(ctx, req.TenantID, req.TransactionID)
+	if err != nil {
+		return dto.TimelineResponse{}, fmt.Errorf("failed to list timeline: %w", err)
+	}
+	if len(recorded) == 0 {
+		return dto.TimelineResponse{}, port.ErrTimelineNotFound
+	}
+	resp := dto.TimelineResponse{
+		TransactionID: req.TransactionID,
+		Events:        make([]dto.TimelineEventResponse, 0, len(recorded)),
+	}
+	for _, e := range recorded {
+		resp.Events = append(resp.Events, dto.TimelineEventResponse{
+			OccurredAt: e.OccurredAt,
+			Details:    e.Details,
+			EventID:    e.EventID,
+			EventType:  e.EventType,
+			Source:     e.Source,
+			Stage:      e.Stage,
+			Summary:    e.Summary,
+		})
+	}
+	return resp, nil
+}
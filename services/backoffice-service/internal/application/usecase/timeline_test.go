@@ -0,0 +1,136 @@
+package usecase_test
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/backoffice-service/internal/application/dto"
+	"github.com/bibbank/bib/services/backoffice-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/model"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/port"
+)
+
+// inMemoryTimelineRepo is an in-memory TimelineRepository.
+type inMemoryTimelineRepo struct {
+	events []model.TimelineEvent
+}
+
+func (r *inMemoryTimelineRepo) Append(_ context.Context, e model.TimelineEvent) error {
+	if slices.ContainsFunc(r.events, func(x model.TimelineEvent) bool { return x.EventID == e.EventID }) {
+		return nil
+	}
+	r.events = append(r.events, e)
+	return nil
+}
+
+func (r *inMemoryTimelineRepo) ListByTransaction(_ context.Context, tenantID, transactionID uuid.UUID) ([]model.TimelineEvent, error) {
+	var out []model.TimelineEvent
+	for _, e := range r.events {
+		if e.TenantID == tenantID && e.TransactionID == transactionID {
+			out = append(out, e)
+		}
+	}
+	slices.SortStableFunc(out, func(a, b model.TimelineEvent) int { return a.OccurredAt.Compare(b.OccurredAt) })
+	return out, nil
+}
+
+func TestTransactionTimeline_StitchesPaymentJourney(t *testing.T) {
+	repo := &inMemoryTimelineRepo{}
+	record := usecase.NewRecordTimelineEventUseCase(repo, slog.Default())
+	ctx := context.Background()
+	tenantID, paymentID := uuid.New(), uuid.New()
+	start := time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC)
+	emit := func(eventType string, offset time.Duration, fields map[string]any) []byte {
+		fields["event_id"] = uuid.NewString()
+		fields["tenant_id"] = tenantID.String()
+		fields["occurred_at"] = start.Add(offset)
+		p := payload(t, fields)
+		require.NoError(t, record.Execute(ctx, eventType, p))
+		return p
+	}
+
+	// Delivered out of order, from different topics.
+	emit("payment.order.settled", 3*time.Minute, map[string]any{"payment_id": paymentID})
+	emit("ledger.entry.posted", 2*time.Minute, map[string]any{
+		"entry_id":  uuid.New(),
+		"reference": "payment/" + paymentID.String() + "/debit",
+		"postings": []map[string]any{
+			{"debit_account": "2100-001", "credit_account": "1000-001", "amount": "25.00", "currency": "USD"},
+		},
+	})
+	initiated := emit("payment.order.initiated", 0, map[string]any{
+		"payment_id": paymentID, "amount": "25.00", "currency": "USD", "rail": "ACH",
+		"source_account_id": uuid.New(), "destination_account_id": uuid.New(),
+	})
+	emit("fraud.assessment.completed", time.Minute, map[string]any{
+		"transaction_id": paymentID, "decision": "APPROVE", "risk_score": 12, "risk_level": "LOW",
+	})
+	emit("fraud.label.recorded", time.Hour, map[string]any{
+		"transaction_id": paymentID, "label": "FRAUD", "source": "CHARGEBACK", "source_ref": "cb-1",
+	})
+
+	// Redelivered events, events of no transaction and events the
+	// timeline does not record are ignored.
+	require.NoError(t, record.Execute(ctx, "payment.order.initiated", initiated))
+	emit("ledger.entry.posted", time.Minute, map[string]any{"entry_id": uuid.New(), "reference": "interest-accrual/2026-05"})
+	emit("fraud.policy.changed", time.Minute, map[string]any{})
+
+	timeline, err := usecase.NewGetTransactionTimelineUseCase(repo).Execute(ctx, dto.TimelineRequest{
+		TenantID:      tenantID,
+		TransactionID: paymentID,
+	})
+	require.NoError(t, err)
+	var stages, summaries []string
+	for _, e := range timeline.Events {
+		stages = append(stages, e.Stage)
+		summaries = append(summaries, e.Summary)
+	}
+	assert.Equal(t, []string{
+		model.TimelineStageInitiation,
+		model.TimelineStageFraudAssessment,
+		model.TimelineStageLedgerPosting,
+		model.TimelineStageSettlement,
+		model.TimelineStageDispute,
+	}, stages)
+	assert.Equal(t, "Payment of 25 USD initiated on ACH", summaries[0])
+	assert.Equal(t, "Assessed APPROVE, risk score 12 (LOW)", summaries[1])
+	assert.Equal(t, "ledger-service", timeline.Events[2].Source)
+	assert.Equal(t, "debit 2100-001, credit 1000-001, 25 USD", timeline.Events[2].Details["posting_1"])
+	assert.Equal(t, "Labelled FRAUD from CHARGEBACK", summaries[4])
+}
+
+func TestTransactionTimeline_CardTransaction(t *testing.T) {
+	repo := &inMemoryTimelineRepo{}
+	record := usecase.NewRecordTimelineEventUseCase(repo, slog.Default())
+	ctx := context.Background()
+	tenantID, transactionID := uuid.New(), uuid.New()
+
+	require.NoError(t, record.Execute(ctx, "card.transaction.declined", payload(t, map[string]any{
+		"event_id":       uuid.NewString(),
+		"tenant_id":      tenantID.String(),
+		"transaction_id": transactionID,
+		"card_id":        uuid.New(),
+		"amount":         "80.00",
+		"currency":       "EUR",
+		"merchant_name":  "Electronics Store",
+		"reason":         "daily spending limit exceeded",
+	})))
+
+	get := usecase.NewGetTransactionTimelineUseCase(repo)
+	timeline, err := get.Execute(ctx, dto.TimelineRequest{TenantID: tenantID, TransactionID: transactionID})
+	require.NoError(t, err)
+	require.Len(t, timeline.Events, 1)
+	assert.Equal(t, model.TimelineStageAuthorization, timeline.Events[0].Stage)
+	assert.Equal(t, "Declined 80 EUR at Electronics Store: daily spending limit exceeded", timeline.Events[0].Summary)
+
+	// Another tenant cannot see it.
+	_, err = get.Execute(ctx, dto.TimelineRequest{TenantID: uuid.New(), TransactionID: transactionID})
+	assert.ErrorIs(t, err, port.ErrTimelineNotFound)
+}
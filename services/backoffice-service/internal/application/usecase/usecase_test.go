@@ -0,0 +1,164 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/event"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/model"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/port"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/valueobject"
+)
+
+// inMemoryTaskRepo is an in-memory TaskRepository.
+type inMemoryTaskRepo struct {
+	audit map[uuid.UUID][]model.AuditEntry
+	tasks []model.Task
+}
+
+func (r *inMemoryTaskRepo) Save(_ context.Context, task model.Task) error {
+	if r.audit == nil {
+		r.audit = map[uuid.UUID][]model.AuditEntry{}
+	}
+	i := slices.IndexFunc(r.tasks, func(t model.Task) bool { return t.ID() == task.ID() })
+	if i < 0 {
+		for _, t := range r.tasks {
+			if t.Queue() == task.Queue() && t.SourceID() == task.SourceID() && !t.IsResolved() {
+				return port.ErrVersionConflict
+			}
+		}
+	} else if r.tasks[i].Version() >= task.Version() {
+		return port.ErrVersionConflict
+	}
+	r.audit[task.ID()] = append(r.audit[task.ID()], task.PendingAudit()...)
+	// Events and pending audit entries are not persisted on the task.
+	task = task.ClearDomainEvents()
+	if i < 0 {
+		r.tasks = append(r.tasks, task)
+	} else {
+		r.tasks[i] = task
+	}
+	return nil
+}
+
+func (r *inMemoryTaskRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.Task, error) {
+	for _, t := range r.tasks {
+		if t.TenantID() == tenantID && t.ID() == id {
+			return t, nil
+		}
+	}
+	return model.Task{}, port.ErrTaskNotFound
+}
+
+func (r *inMemoryTaskRepo) FindLatestBySource(_ context.Context, tenantID uuid.UUID, queue valueobject.Queue, sourceID string) (model.Task, error) {
+	for _, t := range slices.Backward(r.tasks) {
+		if t.TenantID() == tenantID && t.Queue() == queue && t.SourceID() == sourceID {
+			return t, nil
+		}
+	}
+	return model.Task{}, port.ErrTaskNotFound
+}
+
+func (r *inMemoryTaskRepo) List(_ context.Context, filter port.TaskFilter, limit, offset int) ([]model.Task, int, error) {
+	var out []model.Task
+	for _, t := range r.tasks {
+		switch {
+		case t.TenantID() != filter.TenantID,
+			!filter.Queue.IsZero() && t.Queue() != filter.Queue,
+			!filter.Status.IsZero() && t.Status() != filter.Status,
+			filter.ClaimedBy != nil && !t.IsClaimedBy(*filter.ClaimedBy),
+			filter.OverdueAt != nil && (t.IsResolved() || !t.IsOverdue(*filter.OverdueAt)):
+			continue
+		}
+		out = append(out, t)
+	}
+	total := len(out)
+	return out[min(offset, total):min(offset+limit, total)], total, nil
+}
+
+func (r *inMemoryTaskRepo) ListAudit(_ context.Context, _, taskID uuid.UUID) ([]model.AuditEntry, error) {
+	return r.audit[taskID], nil
+}
+
+func (r *inMemoryTaskRepo) CountByQueue(_ context.Context, tenantID, operatorID uuid.UUID, now time.Time) ([]port.QueueCounts, error) {
+	byQueue := map[valueobject.Queue]*port.QueueCounts{}
+	var counts []port.QueueCounts
+	for _, t := range r.tasks {
+		if t.TenantID() != tenantID || t.IsResolved() {
+			continue
+		}
+		c, ok := byQueue[t.Queue()]
+		if !ok {
+			c = &port.QueueCounts{Queue: t.Queue()}
+			byQueue[t.Queue()] = c
+		}
+		if t.Status() == valueobject.TaskStatusOpen {
+			c.Open++
+		} else {
+			c.Claimed++
+		}
+		if t.IsOverdue(now) {
+			c.Overdue++
+		}
+		if t.IsClaimedBy(operatorID) {
+			c.ClaimedByCaller++
+		}
+	}
+	for _, c := range byQueue {
+		counts = append(counts, *c)
+	}
+	return counts, nil
+}
+
+// recordingPublisher records the types of the events published.
+type recordingPublisher struct {
+	types []string
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, events []event.DomainEvent) error {
+	for _, e := range events {
+		p.types = append(p.types, e.EventType())
+	}
+	return nil
+}
+
+// fakeCaseSystem records the cases claimed and decided in it. Decisions
+// are final unless fourEyes is set, in which case the first is not.
+type fakeCaseSystem struct {
+	err      error
+	claimed  map[string]uuid.UUID
+	approved map[string]uuid.UUID
+	decided  map[string]string
+	fourEyes bool
+}
+
+func (f *fakeCaseSystem) Claim(_ context.Context, _, operatorID uuid.UUID, sourceID string) error {
+	if f.err != nil {
+		return f.err
+	}
+	if f.claimed == nil {
+		f.claimed = map[string]uuid.UUID{}
+	}
+	f.claimed[sourceID] = operatorID
+	return nil
+}
+
+func (f *fakeCaseSystem) Resolve(_ context.Context, _, operatorID uuid.UUID, sourceID, outcome, _ string) (bool, error) {
+	if f.err != nil {
+		return false, f.err
+	}
+	if f.approved == nil {
+		f.approved, f.decided = map[string]uuid.UUID{}, map[string]string{}
+	}
+	if first, ok := f.approved[sourceID]; f.fourEyes && !ok {
+		f.approved[sourceID] = operatorID
+		return false, nil
+	} else if ok && first == operatorID {
+		return false, port.ErrSourceRejected
+	}
+	f.decided[sourceID] = outcome
+	return true, nil
+}
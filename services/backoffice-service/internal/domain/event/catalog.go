@@ -0,0 +1,14 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[TaskOpened]("backoffice.task.opened", 1, "Emitted when a task joins its queue."),
+		events.Declare[TaskClaimed]("backoffice.task.claimed", 1, "Emitted when an operator takes a task."),
+		events.Declare[TaskReleased]("backoffice.task.released", 1, "Emitted when a claimed task goes back to its queue."),
+		events.Declare[TaskResolved]("backoffice.task.resolved", 1, "Emitted when a task is closed with an outcome, by an operator or, with a nil ResolvedBy, by the service that owns its source."),
+	}
+}
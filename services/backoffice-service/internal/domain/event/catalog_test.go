@@ -0,0 +1,12 @@
+package event_test
+
+import (
+	"testing"
+
+	"github.com/bibbank/bib/pkg/events/catalogtest"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/event"
+)
+
+func TestEventCatalog(t *testing.T) {
+	catalogtest.CheckCatalog(t, "../../../../../events/backoffice-service.json", "backoffice-service", event.Catalog())
+}
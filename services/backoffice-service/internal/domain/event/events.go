@@ -0,0 +1,92 @@
+package event
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// DomainEvent is an alias for the shared pkg/events.DomainEvent interface.
+type DomainEvent = events.DomainEvent
+
+// AggregateTypeTask is the aggregate type of backoffice events.
+const AggregateTypeTask = "Task"
+
+// TaskOpened is emitted when a task joins its queue.
+type TaskOpened struct {
+	events.BaseEvent
+	Queue    string `json:"queue"`
+	SourceID string `json:"source_id"`
+	Summary  string `json:"summary"`
+}
+
+// NewTaskOpened creates a new TaskOpened event.
+func NewTaskOpened(taskID, tenantID uuid.UUID, queue, sourceID, summary string) TaskOpened {
+	return TaskOpened{
+		BaseEvent: events.NewBaseEvent("backoffice.task.opened", taskID.String(), AggregateTypeTask, tenantID.String()),
+		Queue:     queue,
+		SourceID:  sourceID,
+		Summary:   summary,
+	}
+}
+
+// TaskClaimed is emitted when an operator takes a task.
+type TaskClaimed struct {
+	events.BaseEvent
+	Queue      string    `json:"queue"`
+	SourceID   string    `json:"source_id"`
+	OperatorID uuid.UUID `json:"operator_id"`
+}
+
+// NewTaskClaimed creates a new TaskClaimed event.
+func NewTaskClaimed(taskID, tenantID uuid.UUID, queue, sourceID string, operatorID uuid.UUID) TaskClaimed {
+	return TaskClaimed{
+		BaseEvent:  events.NewBaseEvent("backoffice.task.claimed", taskID.String(), AggregateTypeTask, tenantID.String()),
+		Queue:      queue,
+		SourceID:   sourceID,
+		OperatorID: operatorID,
+	}
+}
+
+// TaskReleased is emitted when a claimed task goes back to its queue.
+type TaskReleased struct {
+	events.BaseEvent
+	Queue      string    `json:"queue"`
+	SourceID   string    `json:"source_id"`
+	Reason     string    `json:"reason,omitempty"`
+	OperatorID uuid.UUID `json:"operator_id"`
+}
+
+// NewTaskReleased creates a new TaskReleased event.
+func NewTaskReleased(taskID, tenantID uuid.UUID, queue, sourceID string, operatorID uuid.UUID, reason string) TaskReleased {
+	return TaskReleased{
+		BaseEvent:  events.NewBaseEvent("backoffice.task.released", taskID.String(), AggregateTypeTask, tenantID.String()),
+		Queue:      queue,
+		SourceID:   sourceID,
+		OperatorID: operatorID,
+		Reason:     reason,
+	}
+}
+
+// TaskResolved is emitted when a task is closed with an outcome, by an
+// operator or, with a nil ResolvedBy, by the service that owns its source.
+type TaskResolved struct {
+	events.BaseEvent
+	Queue      string     `json:"queue"`
+	SourceID   string     `json:"source_id"`
+	Outcome    string     `json:"outcome"`
+	ResolvedBy *uuid.UUID `json:"resolved_by,omitempty"`
+	Overdue    bool       `json:"overdue"`
+}
+
+// NewTaskResolved creates a new TaskResolved event.
+func NewTaskResolved(taskID, tenantID uuid.UUID, queue, sourceID, outcome string, resolvedBy *uuid.UUID, overdue bool) TaskResolved {
+	return TaskResolved{
+		BaseEvent:  events.NewBaseEvent("backoffice.task.resolved", taskID.String(), AggregateTypeTask, tenantID.String()),
+		Queue:      queue,
+		SourceID:   sourceID,
+		Outcome:    outcome,
+		ResolvedBy: resolvedBy,
+		Overdue:    overdue,
+	}
+}
@@ -0,0 +1,354 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/event"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/valueobject"
+)
+
+var (
+	// ErrInvalidTransition is returned when a task cannot move to the
+	// requested status from the one it is in.
+	ErrInvalidTransition = errors.New("invalid status transition")
+	// ErrClaimedByAnother is returned when an operator acts on a task
+	// another operator has claimed.
+	ErrClaimedByAnother = errors.New("task is claimed by another operator")
+)
+
+// Actions recorded on a task's audit trail.
+const (
+	AuditActionOpened   = "OPENED"
+	AuditActionClaimed  = "CLAIMED"
+	AuditActionReleased = "RELEASED"
+	AuditActionNoted    = "NOTED"
+	AuditActionResolved = "RESOLVED"
+	// AuditActionFirstApproval records the first of the two approvals a
+	// four-eyes decision needs; the task goes back to its queue for the
+	// second.
+	AuditActionFirstApproval = "FIRST_APPROVAL"
+	// AuditActionResolvedAtSource records that the service owning the
+	// task's source closed it, such as an analyst deciding a case there.
+	AuditActionResolvedAtSource = "RESOLVED_AT_SOURCE"
+)
+
+// AuditEntry records one action on a task. ActorID is nil for actions the
+// platform took rather than an operator.
+type AuditEntry struct {
+	OccurredAt time.Time
+	ActorID    *uuid.UUID
+	Action     string
+	Detail     string
+	ID         uuid.UUID
+}
+
+// Task is the aggregate root for one piece of operations work: a fraud
+// case to disposition, a failed payment to repair, a reconciliation break,
+// a KYC review or a loan to collect. Tasks are raised from other services'
+// events, or by hand, and worked through the console: an operator claims
+// an OPEN task, works it and resolves it with one of its queue's outcomes.
+// Every action is recorded on the task's audit trail, saved with the task.
+type Task struct {
+	createdAt      time.Time
+	updatedAt      time.Time
+	dueAt          *time.Time
+	claimedAt      *time.Time
+	resolvedAt     *time.Time
+	claimedBy      *uuid.UUID
+	resolvedBy     *uuid.UUID
+	details        map[string]string
+	queue          valueobject.Queue
+	status         valueobject.TaskStatus
+	sourceID       string
+	summary        string
+	outcome        string
+	resolutionNote string
+	pendingAudit   []AuditEntry
+	domainEvents   []events.DomainEvent
+	version        int
+	id             uuid.UUID
+	tenantID       uuid.UUID
+}
+
+// NewTask opens a task in its queue. openedBy is nil for a task raised
+// from another service's event, and dueAt nil for a task with no deadline.
+func NewTask(
+	tenantID uuid.UUID,
+	queue valueobject.Queue,
+	sourceID, summary string,
+	details map[string]string,
+	dueAt *time.Time,
+	openedBy *uuid.UUID,
+	now time.Time,
+) (Task, error) {
+	if tenantID == uuid.Nil {
+		return Task{}, fmt.Errorf("tenant ID is required")
+	}
+	if queue.IsZero() {
+		return Task{}, fmt.Errorf("queue is required")
+	}
+	if strings.TrimSpace(sourceID) == "" {
+		return Task{}, fmt.Errorf("source ID is required")
+	}
+	if strings.TrimSpace(summary) == "" {
+		return Task{}, fmt.Errorf("summary is required")
+	}
+	t := Task{
+		id:        uuid.New(),
+		tenantID:  tenantID,
+		queue:     queue,
+		sourceID:  sourceID,
+		summary:   summary,
+		details:   maps.Clone(details),
+		dueAt:     dueAt,
+		status:    valueobject.TaskStatusOpen,
+		version:   1,
+		createdAt: now,
+		updatedAt: now,
+	}
+	t.record(AuditActionOpened, openedBy, summary, now)
+	t.domainEvents = append(t.domainEvents, event.NewTaskOpened(t.id, tenantID, queue.String(), sourceID, summary))
+	return t, nil
+}
+
+// ReconstructTask recreates a Task from persisted data without validation
+// or events.
+func ReconstructTask(
+	id, tenantID uuid.UUID,
+	queue valueobject.Queue,
+	sourceID, summary string,
+	details map[string]string,
+	dueAt *time.Time,
+	status valueobject.TaskStatus,
+	claimedBy *uuid.UUID,
+	claimedAt *time.Time,
+	outcome, resolutionNote string,
+	resolvedBy *uuid.UUID,
+	resolvedAt *time.Time,
+	version int,
+	createdAt, updatedAt time.Time,
+) Task {
+	return Task{
+		id:             id,
+		tenantID:       tenantID,
+		queue:          queue,
+		sourceID:       sourceID,
+		summary:        summary,
+		details:        details,
+		dueAt:          dueAt,
+		status:         status,
+		claimedBy:      claimedBy,
+		claimedAt:      claimedAt,
+		outcome:        outcome,
+		resolutionNote: resolutionNote,
+		resolvedBy:     resolvedBy,
+		resolvedAt:     resolvedAt,
+		version:        version,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}
+}
+
+// Claim takes an open task for the operator, reporting whether anything
+// changed: claiming a task the operator already holds changes nothing.
+func (t Task) Claim(operatorID uuid.UUID, now time.Time) (Task, bool, error) {
+	if operatorID == uuid.Nil {
+		return t, false, fmt.Errorf("operator ID is required")
+	}
+	switch {
+	case t.status == valueobject.TaskStatusResolved:
+		return t, false, fmt.Errorf("task %s is resolved: %w", t.id, ErrInvalidTransition)
+	case t.IsClaimedBy(operatorID):
+		return t, false, nil
+	case t.status == valueobject.TaskStatusClaimed:
+		return t, false, fmt.Errorf("task %s: %w", t.id, ErrClaimedByAnother)
+	}
+	t.status = valueobject.TaskStatusClaimed
+	t.claimedBy = &operatorID
+	t.claimedAt = &now
+	t.touch(now)
+	t.record(AuditActionClaimed, &operatorID, "", now)
+	t.domainEvents = append(t.domainEvents, event.NewTaskClaimed(t.id, t.tenantID, t.queue.String(), t.sourceID, operatorID))
+	return t, true, nil
+}
+
+// Release hands a claimed task back to its queue. Only the operator holding
+// it may release it, unless supervisor is set, such as for an admin
+// freeing the tasks of an operator who is away.
+func (t Task) Release(operatorID uuid.UUID, reason string, supervisor bool, now time.Time) (Task, error) {
+	if t.status != valueobject.TaskStatusClaimed {
+		return t, fmt.Errorf("task %s is %s, not claimed: %w", t.id, t.status, ErrInvalidTransition)
+	}
+	if !t.IsClaimedBy(operatorID) && !supervisor {
+		return t, fmt.Errorf("task %s: %w", t.id, ErrClaimedByAnother)
+	}
+	t.unclaim(now)
+	t.record(AuditActionReleased, &operatorID, reason, now)
+	t.domainEvents = append(t.domainEvents, event.NewTaskReleased(t.id, t.tenantID, t.queue.String(), t.sourceID, operatorID, reason))
+	return t, nil
+}
+
+// AddNote records an operator's note on an unresolved task. Notes are only
+// kept on the audit trail.
+func (t Task) AddNote(operatorID uuid.UUID, note string, now time.Time) (Task, error) {
+	if strings.TrimSpace(note) == "" {
+		return t, fmt.Errorf("note is required")
+	}
+	if t.status == valueobject.TaskStatusResolved {
+		return t, fmt.Errorf("task %s is resolved: %w", t.id, ErrInvalidTransition)
+	}
+	if t.status == valueobject.TaskStatusClaimed && !t.IsClaimedBy(operatorID) {
+		return t, fmt.Errorf("task %s: %w", t.id, ErrClaimedByAnother)
+	}
+	t.touch(now)
+	t.record(AuditActionNoted, &operatorID, note, now)
+	return t, nil
+}
+
+// Resolve closes the operator's claimed task with one of its queue's
+// outcomes and a note saying why.
+func (t Task) Resolve(operatorID uuid.UUID, outcome, note string, now time.Time) (Task, error) {
+	if err := t.checkResolvable(operatorID, outcome, note); err != nil {
+		return t, err
+	}
+	return t.resolve(&operatorID, outcome, note, AuditActionResolved, now), nil
+}
+
+// RecordFirstApproval records the operator's approval of a task whose
+// decision needs a second, different operator to approve it as well, and
+// hands the task back to its queue for them.
+func (t Task) RecordFirstApproval(operatorID uuid.UUID, outcome, note string, now time.Time) (Task, error) {
+	if err := t.checkResolvable(operatorID, outcome, note); err != nil {
+		return t, err
+	}
+	t.unclaim(now)
+	t.record(AuditActionFirstApproval, &operatorID, outcome+": "+note, now)
+	t.domainEvents = append(t.domainEvents, event.NewTaskReleased(t.id, t.tenantID, t.queue.String(), t.sourceID, operatorID, "awaiting second approval"))
+	return t, nil
+}
+
+// CloseAtSource resolves the task because the service owning its source
+// closed it, whoever holds the task.
+func (t Task) CloseAtSource(outcome, detail string, now time.Time) (Task, error) {
+	if t.status == valueobject.TaskStatusResolved {
+		return t, fmt.Errorf("task %s is already resolved: %w", t.id, ErrInvalidTransition)
+	}
+	return t.resolve(nil, outcome, detail, AuditActionResolvedAtSource, now), nil
+}
+
+func (t Task) checkResolvable(operatorID uuid.UUID, outcome, note string) error {
+	if t.status == valueobject.TaskStatusResolved {
+		return fmt.Errorf("task %s is already resolved: %w", t.id, ErrInvalidTransition)
+	}
+	if t.status != valueobject.TaskStatusClaimed {
+		return fmt.Errorf("task %s must be claimed before it is resolved: %w", t.id, ErrInvalidTransition)
+	}
+	if !t.IsClaimedBy(operatorID) {
+		return fmt.Errorf("task %s: %w", t.id, ErrClaimedByAnother)
+	}
+	if !t.queue.AllowsOutcome(outcome) {
+		return fmt.Errorf("outcome of a %s task must be one of %s", t.queue, strings.Join(t.queue.Outcomes(), ", "))
+	}
+	if strings.TrimSpace(note) == "" {
+		return fmt.Errorf("a note is required to resolve a task")
+	}
+	return nil
+}
+
+func (t Task) resolve(resolvedBy *uuid.UUID, outcome, note, action string, now time.Time) Task {
+	overdue := t.IsOverdue(now)
+	t.status = valueobject.TaskStatusResolved
+	t.outcome = outcome
+	t.resolutionNote = note
+	t.resolvedBy = resolvedBy
+	t.resolvedAt = &now
+	t.touch(now)
+	detail := outcome
+	if note != "" {
+		detail += ": " + note
+	}
+	t.record(action, resolvedBy, detail, now)
+	t.domainEvents = append(t.domainEvents, event.NewTaskResolved(t.id, t.tenantID, t.queue.String(), t.sourceID, outcome, resolvedBy, overdue))
+	return t
+}
+
+func (t *Task) unclaim(now time.Time) {
+	t.status = valueobject.TaskStatusOpen
+	t.claimedBy = nil
+	t.claimedAt = nil
+	t.touch(now)
+}
+
+func (t *Task) touch(now time.Time) {
+	t.version++
+	t.updatedAt = now
+}
+
+func (t *Task) record(action string, actorID *uuid.UUID, detail string, now time.Time) {
+	t.pendingAudit = append(t.pendingAudit, AuditEntry{
+		ID:         uuid.New(),
+		Action:     action,
+		ActorID:    actorID,
+		Detail:     detail,
+		OccurredAt: now,
+	})
+}
+
+// IsClaimedBy reports whether the operator holds the task.
+func (t Task) IsClaimedBy(operatorID uuid.UUID) bool {
+	return t.claimedBy != nil && *t.claimedBy == operatorID
+}
+
+// IsResolved reports whether the task is closed.
+func (t Task) IsResolved() bool {
+	return t.status == valueobject.TaskStatusResolved
+}
+
+// IsOverdue reports whether the unresolved task is past its deadline at
+// now, or whether the resolved task was resolved past it.
+func (t Task) IsOverdue(now time.Time) bool {
+	if t.dueAt == nil {
+		return false
+	}
+	if t.resolvedAt != nil {
+		now = *t.resolvedAt
+	}
+	return now.After(*t.dueAt)
+}
+
+// --- Accessors ---
+
+func (t Task) ID() uuid.UUID                      { return t.id }
+func (t Task) TenantID() uuid.UUID                { return t.tenantID }
+func (t Task) Queue() valueobject.Queue           { return t.queue }
+func (t Task) SourceID() string                   { return t.sourceID }
+func (t Task) Summary() string                    { return t.summary }
+func (t Task) Details() map[string]string         { return maps.Clone(t.details) }
+func (t Task) DueAt() *time.Time                  { return t.dueAt }
+func (t Task) Status() valueobject.TaskStatus     { return t.status }
+func (t Task) ClaimedBy() *uuid.UUID              { return t.claimedBy }
+func (t Task) ClaimedAt() *time.Time              { return t.claimedAt }
+func (t Task) Outcome() string                    { return t.outcome }
+func (t Task) ResolutionNote() string             { return t.resolutionNote }
+func (t Task) ResolvedBy() *uuid.UUID             { return t.resolvedBy }
+func (t Task) ResolvedAt() *time.Time             { return t.resolvedAt }
+func (t Task) Version() int                       { return t.version }
+func (t Task) CreatedAt() time.Time               { return t.createdAt }
+func (t Task) UpdatedAt() time.Time               { return t.updatedAt }
+func (t Task) PendingAudit() []AuditEntry         { return t.pendingAudit }
+func (t Task) DomainEvents() []events.DomainEvent { return t.domainEvents }
+
+// ClearDomainEvents returns a copy of the task with no uncommitted events
+// or audit entries.
+func (t Task) ClearDomainEvents() Task {
+	t.domainEvents = nil
+	t.pendingAudit = nil
+	return t
+}
@@ -0,0 +1,173 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/model"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/valueobject"
+)
+
+var now = time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+func openTask(t *testing.T, queue valueobject.Queue, dueAt *time.Time) model.Task {
+	t.Helper()
+	task, err := model.NewTask(uuid.New(), queue, uuid.NewString(), "Review transaction", nil, dueAt, nil, now)
+	require.NoError(t, err)
+	return task.ClearDomainEvents()
+}
+
+func actions(entries []model.AuditEntry) []string {
+	out := make([]string, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, e.Action)
+	}
+	return out
+}
+
+func TestNewTask(t *testing.T) {
+	tenantID, operator := uuid.New(), uuid.New()
+	task, err := model.NewTask(tenantID, valueobject.QueueReconciliationBreak, "stmt-42", "Unmatched entry",
+		map[string]string{"amount": "10.00"}, nil, &operator, now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.TaskStatusOpen, task.Status())
+	assert.Equal(t, 1, task.Version())
+	assert.Equal(t, "10.00", task.Details()["amount"])
+	require.Len(t, task.PendingAudit(), 1)
+	assert.Equal(t, model.AuditActionOpened, task.PendingAudit()[0].Action)
+	assert.Equal(t, &operator, task.PendingAudit()[0].ActorID)
+	require.Len(t, task.DomainEvents(), 1)
+	assert.Equal(t, "backoffice.task.opened", task.DomainEvents()[0].EventType())
+
+	_, err = model.NewTask(uuid.Nil, valueobject.QueueKYCReview, "x", "y", nil, nil, nil, now)
+	assert.Error(t, err, "no tenant")
+	_, err = model.NewTask(tenantID, valueobject.Queue{}, "x", "y", nil, nil, nil, now)
+	assert.Error(t, err, "no queue")
+	_, err = model.NewTask(tenantID, valueobject.QueueKYCReview, " ", "y", nil, nil, nil, now)
+	assert.Error(t, err, "no source")
+	_, err = model.NewTask(tenantID, valueobject.QueueKYCReview, "x", "", nil, nil, nil, now)
+	assert.Error(t, err, "no summary")
+}
+
+func TestTask_Claim(t *testing.T) {
+	task := openTask(t, valueobject.QueueFraudReview, nil)
+	alice, bob := uuid.New(), uuid.New()
+
+	claimed, changed, err := task.Claim(alice, now)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, valueobject.TaskStatusClaimed, claimed.Status())
+	assert.True(t, claimed.IsClaimedBy(alice))
+	assert.Equal(t, 2, claimed.Version())
+	assert.Equal(t, []string{model.AuditActionClaimed}, actions(claimed.PendingAudit()))
+
+	again, changed, err := claimed.ClearDomainEvents().Claim(alice, now)
+	require.NoError(t, err)
+	assert.False(t, changed, "claiming a held task again changes nothing")
+	assert.Empty(t, again.PendingAudit())
+
+	_, _, err = claimed.Claim(bob, now)
+	assert.ErrorIs(t, err, model.ErrClaimedByAnother)
+}
+
+func TestTask_Release(t *testing.T) {
+	alice, bob := uuid.New(), uuid.New()
+	task, _, err := openTask(t, valueobject.QueuePaymentRepair, nil).Claim(alice, now)
+	require.NoError(t, err)
+
+	_, err = task.Release(bob, "", false, now)
+	assert.ErrorIs(t, err, model.ErrClaimedByAnother)
+
+	released, err := task.Release(bob, "alice is on leave", true, now)
+	require.NoError(t, err, "a supervisor releases another operator's task")
+	assert.Equal(t, valueobject.TaskStatusOpen, released.Status())
+	assert.Nil(t, released.ClaimedBy())
+
+	_, err = released.Release(alice, "", false, now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+}
+
+func TestTask_AddNote(t *testing.T) {
+	alice, bob := uuid.New(), uuid.New()
+	task := openTask(t, valueobject.QueueReconciliationBreak, nil)
+
+	noted, err := task.AddNote(bob, "looking into it", now)
+	require.NoError(t, err, "anyone may note an unclaimed task")
+	assert.Equal(t, []string{model.AuditActionNoted}, actions(noted.PendingAudit()))
+
+	claimed, _, err := task.Claim(alice, now)
+	require.NoError(t, err)
+	_, err = claimed.AddNote(bob, "mine now", now)
+	assert.ErrorIs(t, err, model.ErrClaimedByAnother)
+	_, err = claimed.AddNote(alice, "  ", now)
+	assert.Error(t, err)
+}
+
+func TestTask_Resolve(t *testing.T) {
+	alice := uuid.New()
+	dueAt := now.Add(time.Hour)
+	task := openTask(t, valueobject.QueueFraudReview, &dueAt)
+
+	_, err := task.Resolve(alice, "FALSE_POSITIVE", "customer confirmed", now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition, "must be claimed first")
+
+	claimed, _, err := task.Claim(alice, now)
+	require.NoError(t, err)
+	_, err = claimed.Resolve(alice, "WRITTEN_OFF", "note", now)
+	assert.Error(t, err, "not an outcome of the queue")
+	_, err = claimed.Resolve(alice, "FALSE_POSITIVE", "", now)
+	assert.Error(t, err, "a note is required")
+
+	later := now.Add(2 * time.Hour)
+	resolved, err := claimed.ClearDomainEvents().Resolve(alice, "FALSE_POSITIVE", "customer confirmed", later)
+	require.NoError(t, err)
+	assert.True(t, resolved.IsResolved())
+	assert.Equal(t, "FALSE_POSITIVE", resolved.Outcome())
+	assert.Equal(t, &alice, resolved.ResolvedBy())
+	assert.True(t, resolved.IsOverdue(later.Add(24*time.Hour)), "resolved past its deadline")
+	require.Len(t, resolved.DomainEvents(), 1)
+	assert.Equal(t, "backoffice.task.resolved", resolved.DomainEvents()[0].EventType())
+
+	_, err = resolved.Resolve(alice, "FALSE_POSITIVE", "again", later)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+}
+
+func TestTask_RecordFirstApproval(t *testing.T) {
+	alice := uuid.New()
+	task, _, err := openTask(t, valueobject.QueueKYCReview, nil).Claim(alice, now)
+	require.NoError(t, err)
+
+	approved, err := task.ClearDomainEvents().RecordFirstApproval(alice, "APPROVE", "documents match", now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.TaskStatusOpen, approved.Status(), "back in the queue for the second approver")
+	assert.Nil(t, approved.ClaimedBy())
+	assert.Equal(t, []string{model.AuditActionFirstApproval}, actions(approved.PendingAudit()))
+	require.Len(t, approved.DomainEvents(), 1)
+	assert.Equal(t, "backoffice.task.released", approved.DomainEvents()[0].EventType())
+}
+
+func TestTask_CloseAtSource(t *testing.T) {
+	task, _, err := openTask(t, valueobject.QueueFraudReview, nil).Claim(uuid.New(), now)
+	require.NoError(t, err)
+
+	closed, err := task.CloseAtSource("CONFIRMED_FRAUD", "case resolved in fraud service", now)
+	require.NoError(t, err, "whoever holds the task")
+	assert.True(t, closed.IsResolved())
+	assert.Nil(t, closed.ResolvedBy())
+	assert.Contains(t, actions(closed.PendingAudit()), model.AuditActionResolvedAtSource)
+
+	_, err = closed.CloseAtSource("CONFIRMED_FRAUD", "", now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+}
+
+func TestTask_IsOverdue(t *testing.T) {
+	dueAt := now.Add(time.Hour)
+	task := openTask(t, valueobject.QueuePaymentRepair, &dueAt)
+	assert.False(t, task.IsOverdue(now))
+	assert.True(t, task.IsOverdue(now.Add(2*time.Hour)))
+	assert.False(t, openTask(t, valueobject.QueuePaymentRepair, nil).IsOverdue(now.AddDate(1, 0, 0)), "no deadline")
+}
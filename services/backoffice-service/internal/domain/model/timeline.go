@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Stages of a transaction's journey.
+const (
+	TimelineStageInitiation      = "INITIATION"
+	TimelineStageFraudAssessment = "FRAUD_ASSESSMENT"
+	TimelineStageAuthorization   = "AUTHORIZATION"
+	TimelineStageLedgerPosting   = "LEDGER_POSTING"
+	TimelineStageSettlement      = "SETTLEMENT"
+	TimelineStageDispute         = "DISPUTE"
+)
+
+// TimelineEvent is one step of a payment's or card transaction's journey
+// through the platform, recorded from an event of the service that took
+// it. TransactionID is the payment ID or card transaction ID the event
+// belongs to, and Source the service that emitted it.
+type TimelineEvent struct {
+	OccurredAt    time.Time
+	Details       map[string]string
+	EventID       string
+	EventType     string
+	Source        string
+	Stage         string
+	Summary       string
+	TransactionID uuid.UUID
+	TenantID      uuid.UUID
+}
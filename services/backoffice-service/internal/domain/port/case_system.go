@@ -0,0 +1,27 @@
+package port
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ErrSourceRejected is returned when the service owning a task's source
+// refuses the action, such as a case another analyst holds there or one
+// already decided.
+var ErrSourceRejected = errors.New("rejected by source system")
+
+// CaseSystem is the port for a service whose cases a queue's tasks stand
+// for, so that claiming and resolving a task does the same to its case.
+// Queues without one, such as reconciliation breaks, are worked in the
+// console alone.
+type CaseSystem interface {
+	// Claim assigns the case to the operator.
+	Claim(ctx context.Context, tenantID, operatorID uuid.UUID, sourceID string) error
+
+	// Resolve decides the case with the operator's outcome and note,
+	// reporting whether the decision is final. It is not when the case
+	// needs a second operator's approval as well.
+	Resolve(ctx context.Context, tenantID, operatorID uuid.UUID, sourceID, outcome, note string) (bool, error)
+}
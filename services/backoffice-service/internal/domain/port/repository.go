@@ -0,0 +1,88 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/event"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/model"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/valueobject"
+)
+
+// ErrTaskNotFound is returned when a task does not exist.
+var ErrTaskNotFound = errors.New("task not found")
+
+// ErrTimelineNotFound is returned when no events of a transaction have
+// been recorded.
+var ErrTimelineNotFound = errors.New("transaction timeline not found")
+
+// ErrVersionConflict is returned when a task was modified concurrently, or
+// a second unresolved task was opened for the same source.
+var ErrVersionConflict = errors.New("version conflict")
+
+// TaskFilter narrows a listing of a tenant's tasks. Zero fields do not
+// filter.
+type TaskFilter struct {
+	ClaimedBy *uuid.UUID
+	// OverdueAt, when set, only lists unresolved tasks past their deadline
+	// at that time.
+	OverdueAt *time.Time
+	Queue     valueobject.Queue
+	Status    valueobject.TaskStatus
+	TenantID  uuid.UUID
+}
+
+// QueueCounts is how much work is waiting in one queue.
+type QueueCounts struct {
+	Queue           valueobject.Queue
+	Open            int
+	Claimed         int
+	Overdue         int
+	ClaimedByCaller int
+}
+
+// TaskRepository defines the persistence port for tasks.
+type TaskRepository interface {
+	// Save persists a task with optimistic locking on its version, along
+	// with its pending audit entries, in one transaction.
+	Save(ctx context.Context, task model.Task) error
+
+	// FindByID retrieves a tenant's task.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Task, error)
+
+	// FindLatestBySource retrieves the most recently opened task of a
+	// queue for a source, resolved or not.
+	FindLatestBySource(ctx context.Context, tenantID uuid.UUID, queue valueobject.Queue, sourceID string) (model.Task, error)
+
+	// List returns a page of the tasks matching the filter, those due
+	// soonest first, and the total number of them.
+	List(ctx context.Context, filter TaskFilter, limit, offset int) ([]model.Task, int, error)
+
+	// ListAudit returns a task's audit trail, oldest first.
+	ListAudit(ctx context.Context, tenantID, taskID uuid.UUID) ([]model.AuditEntry, error)
+
+	// CountByQueue returns the unresolved tasks of each queue of the
+	// tenant that has any, counting as overdue those past their deadline
+	// at now and as the caller's those operatorID claimed.
+	CountByQueue(ctx context.Context, tenantID, operatorID uuid.UUID, now time.Time) ([]QueueCounts, error)
+}
+
+// TimelineRepository defines the persistence port for transaction
+// timelines.
+type TimelineRepository interface {
+	// Append records an event on its transaction's timeline. Events
+	// already recorded are ignored.
+	Append(ctx context.Context, e model.TimelineEvent) error
+
+	// ListByTransaction returns a tenant's transaction's timeline, oldest
+	// first.
+	ListByTransaction(ctx context.Context, tenantID, transactionID uuid.UUID) ([]model.TimelineEvent, error)
+}
+
+// EventPublisher defines the port for publishing domain events.
+type EventPublisher interface {
+	Publish(ctx context.Context, events []event.DomainEvent) error
+}
@@ -0,0 +1,100 @@
+package valueobject
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Queue is the kind of operations work a task is. Each queue has the
+// outcomes a task in it can be resolved with.
+// It is an immutable value object.
+type Queue struct {
+	value string
+}
+
+const (
+	queueFraudReview         = "FRAUD_REVIEW"
+	queuePaymentRepair       = "PAYMENT_REPAIR"
+	queueReconciliationBreak = "RECONCILIATION_BREAK"
+	queueKYCReview           = "KYC_REVIEW"
+	queueLoanCollection      = "LOAN_COLLECTION"
+)
+
+var (
+	QueueFraudReview         = Queue{value: queueFraudReview}
+	QueuePaymentRepair       = Queue{value: queuePaymentRepair}
+	QueueReconciliationBreak = Queue{value: queueReconciliationBreak}
+	QueueKYCReview           = Queue{value: queueKYCReview}
+	QueueLoanCollection      = Queue{value: queueLoanCollection}
+)
+
+// Queues lists every queue, in the order the console shows them.
+var Queues = []Queue{
+	QueueFraudReview,
+	QueuePaymentRepair,
+	QueueReconciliationBreak,
+	QueueKYCReview,
+	QueueLoanCollection,
+}
+
+var validQueues = map[string]Queue{
+	queueFraudReview:         QueueFraudReview,
+	queuePaymentRepair:       QueuePaymentRepair,
+	queueReconciliationBreak: QueueReconciliationBreak,
+	queueKYCReview:           QueueKYCReview,
+	queueLoanCollection:      QueueLoanCollection,
+}
+
+// queueOutcomes are the outcomes a task of each queue is resolved with.
+// Fraud and KYC outcomes are the dispositions and decisions of the fraud
+// and identity services.
+var queueOutcomes = map[string][]string{
+	queueFraudReview:         {"CONFIRMED_FRAUD", "FALSE_POSITIVE"},
+	queuePaymentRepair:       {"RESUBMITTED", "RETURNED", "CANCELLED"},
+	queueReconciliationBreak: {"MATCHED", "ADJUSTED", "WRITTEN_OFF"},
+	queueKYCReview:           {"APPROVE", "REJECT"},
+	queueLoanCollection:      {"PAYMENT_ARRANGED", "RECOVERED", "WRITTEN_OFF", "REFERRED_EXTERNAL"},
+}
+
+// NewQueue creates a Queue from a string, validating it is known.
+func NewQueue(s string) (Queue, error) {
+	v, ok := validQueues[s]
+	if !ok {
+		return Queue{}, fmt.Errorf("invalid queue: %q", s)
+	}
+	return v, nil
+}
+
+// Outcomes returns the outcomes a task of the queue is resolved with.
+func (v Queue) Outcomes() []string {
+	return slices.Clone(queueOutcomes[v.value])
+}
+
+// AllowsOutcome reports whether a task of the queue can be resolved with
+// outcome.
+func (v Queue) AllowsOutcome(outcome string) bool {
+	return slices.Contains(queueOutcomes[v.value], outcome)
+}
+
+// Recurs reports whether a new task is opened for a source whose task was
+// resolved, rather than treating the second report as a duplicate. A loan
+// brought current can fall behind again; a fraud case, review or failed
+// payment is only worked once.
+func (v Queue) Recurs() bool {
+	return v.value == queueLoanCollection
+}
+
+// String returns the string representation of the Queue.
+func (v Queue) String() string {
+	return v.value
+}
+
+// IsZero returns true if the Queue has not been set.
+func (v Queue) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two Queue values are equal.
+func (v Queue) Equal(other Queue) bool {
+	return v.value == other.value
+}
@@ -0,0 +1,52 @@
+package valueobject
+
+import "fmt"
+
+// TaskStatus is where a task is in its lifecycle: OPEN in its queue,
+// CLAIMED by an operator working it, or RESOLVED.
+// It is an immutable value object.
+type TaskStatus struct {
+	value string
+}
+
+const (
+	taskStatusOpen     = "OPEN"
+	taskStatusClaimed  = "CLAIMED"
+	taskStatusResolved = "RESOLVED"
+)
+
+var (
+	TaskStatusOpen     = TaskStatus{value: taskStatusOpen}
+	TaskStatusClaimed  = TaskStatus{value: taskStatusClaimed}
+	TaskStatusResolved = TaskStatus{value: taskStatusResolved}
+)
+
+var validTaskStatuses = map[string]TaskStatus{
+	taskStatusOpen:     TaskStatusOpen,
+	taskStatusClaimed:  TaskStatusClaimed,
+	taskStatusResolved: TaskStatusResolved,
+}
+
+// NewTaskStatus creates a TaskStatus from a string, validating it is known.
+func NewTaskStatus(s string) (TaskStatus, error) {
+	v, ok := validTaskStatuses[s]
+	if !ok {
+		return TaskStatus{}, fmt.Errorf("invalid task status: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the TaskStatus.
+func (v TaskStatus) String() string {
+	return v.value
+}
+
+// IsZero returns true if the TaskStatus has not been set.
+func (v TaskStatus) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two TaskStatus values are equal.
+func (v TaskStatus) Equal(other TaskStatus) bool {
+	return v.value == other.value
+}
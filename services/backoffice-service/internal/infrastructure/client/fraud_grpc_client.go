@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	fraudv1 "github.com/bibbank/bib/api/gen/go/bib/fraud/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/port"
+)
+
+// Compile-time interface check.
+var _ port.CaseSystem = (*FraudGRPCClient)(nil)
+
+// FraudGRPCClient implements the CaseSystem port for fraud review tasks
+// against the fraud service's case management.
+type FraudGRPCClient struct {
+	conn   grpc.ClientConnInterface
+	signer *auth.JWTService
+	config Config
+}
+
+// NewFraudGRPCClient creates a new FraudGRPCClient.
+func NewFraudGRPCClient(conn grpc.ClientConnInterface, signer *auth.JWTService, config Config) *FraudGRPCClient {
+	return &FraudGRPCClient{conn: conn, signer: signer, config: config}
+}
+
+// Claim assigns the fraud case to the operator, taking it from any analyst
+// it was assigned to.
+func (c *FraudGRPCClient) Claim(ctx context.Context, tenantID, operatorID uuid.UUID, caseID string) error {
+	ctx, err := withOperatorToken(ctx, c.signer, tenantID, operatorID, auth.RoleOperator)
+	if err != nil {
+		return err
+	}
+	req := &fraudv1.AssignCaseRequest{CaseId: caseID, AnalystId: operatorID.String()}
+	var resp fraudv1.CaseResponse
+	if err := invokeWithRetry(ctx, c.conn, fraudv1.FraudCaseService_AssignCase_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout); err != nil {
+		return mapRejected(err)
+	}
+	return nil
+}
+
+// Resolve dispositions the fraud case. A fraud disposition is always
+// final.
+func (c *FraudGRPCClient) Resolve(ctx context.Context, tenantID, operatorID uuid.UUID, caseID, outcome, note string) (bool, error) {
+	ctx, err := withOperatorToken(ctx, c.signer, tenantID, operatorID, auth.RoleOperator)
+	if err != nil {
+		return false, err
+	}
+	req := &fraudv1.ResolveCaseRequest{
+		CaseId:      caseID,
+		Disposition: fraudv1.CaseDisposition(fraudv1.CaseDisposition_value["CASE_DISPOSITION_"+outcome]),
+		Note:        note,
+	}
+	var resp fraudv1.CaseResponse
+	// Resolving is not retried: a retry after a lost response would be
+	// refused as the case is already resolved.
+	if err := invoke(ctx, c.conn, fraudv1.FraudCaseService_ResolveCase_FullMethodName, req, &resp, c.config.Timeout); err != nil {
+		return false, mapRejected(err)
+	}
+	return true, nil
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	identityv1 "github.com/bibbank/bib/api/gen/go/bib/identity/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/port"
+)
+
+// Compile-time interface check.
+var _ port.CaseSystem = (*IdentityGRPCClient)(nil)
+
+// reviewStatusDecided is the status of a review case whose decision is
+// final.
+const reviewStatusDecided = "DECIDED"
+
+// IdentityGRPCClient implements the CaseSystem port for KYC review tasks
+// against the identity service's manual review cases.
+type IdentityGRPCClient struct {
+	conn   grpc.ClientConnInterface
+	signer *auth.JWTService
+	config Config
+}
+
+// NewIdentityGRPCClient creates a new IdentityGRPCClient.
+func NewIdentityGRPCClient(conn grpc.ClientConnInterface, signer *auth.JWTService, config Config) *IdentityGRPCClient {
+	return &IdentityGRPCClient{conn: conn, signer: signer, config: config}
+}
+
+// Claim assigns the review case to the operator. The task claim decides
+// who works a case, so the case is reassigned rather than claimed, which
+// the identity service refuses while another analyst holds it; assigning
+// takes an admin token. The identity service still refuses a second
+// approval from the analyst who gave the first.
+func (c *IdentityGRPCClient) Claim(ctx context.Context, tenantID, operatorID uuid.UUID, caseID string) error {
+	ctx, err := withOperatorToken(ctx, c.signer, tenantID, operatorID, auth.RoleAdmin)
+	if err != nil {
+		return err
+	}
+	req := &identityv1.AssignReviewCaseRequest{Id: caseID, AssigneeId: operatorID.String()}
+	var resp identityv1.ReviewCaseResponse
+	if err := invokeWithRetry(ctx, c.conn, identityv1.IdentityService_AssignReviewCase_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout); err != nil {
+		return mapRejected(err)
+	}
+	return nil
+}
+
+// Resolve decides the review case, reporting whether the decision is
+// final: an approval needing four eyes waits for a second analyst's.
+func (c *IdentityGRPCClient) Resolve(ctx context.Context, tenantID, operatorID uuid.UUID, caseID, outcome, note string) (bool, error) {
+	ctx, err := withOperatorToken(ctx, c.signer, tenantID, operatorID, auth.RoleOperator)
+	if err != nil {
+		return false, err
+	}
+	req := &identityv1.DecideReviewCaseRequest{Id: caseID, Outcome: outcome, Reason: note}
+	var resp identityv1.ReviewCaseResponse
+	// Deciding is not retried: a retry after a lost response could be
+	// taken for a second approval.
+	if err := invoke(ctx, c.conn, identityv1.IdentityService_DecideReviewCase_FullMethodName, req, &resp, c.config.Timeout); err != nil {
+		return false, mapRejected(err)
+	}
+	return resp.GetCase() == nil || resp.GetCase().GetStatus() == reviewStatusDecided, nil
+}
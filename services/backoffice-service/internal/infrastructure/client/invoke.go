@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/port"
+)
+
+// Config holds configuration shared by the gRPC clients of the services
+// whose cases the backoffice works.
+type Config struct {
+	// MaxRetries is the maximum number of retry attempts on transient failures.
+	MaxRetries int
+	// RetryBackoff is the base backoff between retries, doubled on each attempt.
+	RetryBackoff time.Duration
+	// Timeout bounds each call.
+	Timeout time.Duration
+}
+
+// invokeWithRetry calls a unary method of another service, retrying transient
+// failures with exponential backoff from backoff. Each attempt is bounded by
+// timeout when it is positive.
+func invokeWithRetry(
+	ctx context.Context,
+	conn grpc.ClientConnInterface,
+	method string,
+	req, resp any,
+	maxRetries int,
+	backoff, timeout time.Duration,
+) error {
+	var lastErr error
+
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			wait := backoff * (1 << uint(attempt-1)) //nolint:gosec // retry count is small
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+
+		err := invoke(ctx, conn, method, req, resp, timeout)
+		if err == nil {
+			return nil
+		}
+		if !isTransient(err) {
+			return err
+		}
+		lastErr = err
+	}
+
+	return fmt.Errorf("exhausted %d retries: %w", maxRetries, lastErr)
+}
+
+func invoke(ctx context.Context, conn grpc.ClientConnInterface, method string, req, resp any, timeout time.Duration) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return conn.Invoke(ctx, method, req, resp)
+}
+
+// isTransient reports whether a failed call may succeed if retried.
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// withOperatorToken authorizes outgoing calls as the operator. Backoffice
+// tokens are of a separate realm the other services do not trust, so the
+// backoffice mints a short-lived platform token for the operator, signed
+// with the platform's issuing key, with roles enough for the call.
+func withOperatorToken(ctx context.Context, signer *auth.JWTService, tenantID, operatorID uuid.UUID, roles ...string) (context.Context, error) {
+	token, err := signer.GenerateToken(operatorID, tenantID, roles)
+	if err != nil {
+		return nil, fmt.Errorf("mint operator token: %w", err)
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token), nil
+}
+
+// mapRejected translates a status refusing the call for good into
+// port.ErrSourceRejected, so callers can tell a refused action from a
+// failed call.
+func mapRejected(err error) error {
+	switch status.Code(err) {
+	case codes.NotFound, codes.InvalidArgument, codes.FailedPrecondition, codes.PermissionDenied:
+		return fmt.Errorf("%w: %s", port.ErrSourceRejected, status.Convert(err).Message())
+	default:
+		return err
+	}
+}
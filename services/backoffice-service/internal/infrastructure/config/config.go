@@ -0,0 +1,174 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+type DatabaseConfig struct {
+	Host     string
+	User     string
+	Password string
+	Name     string
+	SSLMode  string
+	Port     int
+}
+
+type KafkaConfig struct {
+	ConsumerGroup string
+	Brokers       []string
+}
+
+// RealmConfig configures the backoffice's own authentication realm:
+// operators sign in to the console with tokens of issuer Issuer, which
+// customer and API client tokens are not. The realm is verified with
+// PublicKey (or the key in PublicKeyFile), or with Secret.
+type RealmConfig struct {
+	Issuer        string
+	PublicKey     string
+	PublicKeyFile string
+	Secret        string
+}
+
+// UpstreamConfig configures the calls to the fraud and identity services
+// whose cases fraud and KYC tasks stand for. SigningKeyFile holds the key
+// used to sign the platform tokens those calls carry; JWT_SECRET is used
+// when it is empty.
+type UpstreamConfig struct {
+	FraudGRPCAddr    string
+	IdentityGRPCAddr string
+	SigningKeyFile   string
+	Timeout          time.Duration
+	MaxRetries       int
+	RetryBackoff     time.Duration
+}
+
+// QueueConfig sets the deadlines of tasks raised from events that carry
+// none.
+type QueueConfig struct {
+	PaymentRepairSLA time.Duration
+	CollectionSLA    time.Duration
+}
+
+type Config struct {
+	DB          DatabaseConfig
+	ServiceName string
+	Realm       RealmConfig
+	Kafka       KafkaConfig
+	Upstream    UpstreamConfig
+	Queues      QueueConfig
+	Outbox      OutboxConfig
+	GRPCPort    int
+	HTTPPort    int
+}
+
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+func Load() Config {
+	return Config{
+		GRPCPort: getEnvInt("GRPC_PORT", 9103),
+		HTTPPort: getEnvInt("HTTP_PORT", 8103),
+		DB: DatabaseConfig{
+			Host:     getEnv("DB_HOST", "localhost"),
+			Port:     getEnvInt("DB_PORT", 5432),
+			User:     getEnv("DB_USER", "bib"),
+			Password: getEnv("DB_PASSWORD", ""),
+			Name:     getEnv("DB_NAME", "bib_backoffice"),
+			SSLMode:  getEnv("DB_SSLMODE", "require"),
+		},
+		Kafka: KafkaConfig{
+			Brokers:       []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+			ConsumerGroup: getEnv("KAFKA_CONSUMER_GROUP", "backoffice-service"),
+		},
+		Realm: RealmConfig{
+			Issuer:        getEnv("BACKOFFICE_JWT_ISSUER", "bib-backoffice"),
+			PublicKey:     getEnv("BACKOFFICE_JWT_PUBLIC_KEY", ""),
+			PublicKeyFile: getEnv("BACKOFFICE_JWT_PUBLIC_KEY_FILE", ""),
+			Secret:        getEnv("BACKOFFICE_JWT_SECRET", ""),
+		},
+		Upstream: UpstreamConfig{
+			FraudGRPCAddr:    getEnv("FRAUD_SERVICE_ADDR", "localhost:9088"),
+			IdentityGRPCAddr: getEnv("IDENTITY_SERVICE_ADDR", "localhost:9085"),
+			SigningKeyFile:   getEnv("BACKOFFICE_SIGNING_KEY_FILE", ""),
+			Timeout:          getEnvDuration("BACKOFFICE_UPSTREAM_TIMEOUT", 10*time.Second),
+			MaxRetries:       getEnvInt("BACKOFFICE_UPSTREAM_MAX_RETRIES", 3),
+			RetryBackoff:     getEnvDuration("BACKOFFICE_UPSTREAM_RETRY_BACKOFF", 200*time.Millisecond),
+		},
+		Queues: QueueConfig{
+			PaymentRepairSLA: getEnvDuration("BACKOFFICE_PAYMENT_REPAIR_SLA", 24*time.Hour),
+			CollectionSLA:    getEnvDuration("BACKOFFICE_COLLECTION_SLA", 72*time.Hour),
+		},
+		ServiceName: "backoffice-service",
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
+	}
+}
+
+func (c Config) GRPCAddr() string {
+	return fmt.Sprintf(":%d", c.GRPCPort)
+}
+
+func (c Config) HTTPAddr() string {
+	return fmt.Sprintf(":%d", c.HTTPPort)
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) int {
+	if v := os.Getenv(key); v != "" {
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+	return fallback
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return fallback
+}
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
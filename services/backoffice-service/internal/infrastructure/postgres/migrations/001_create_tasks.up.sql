@@ -0,0 +1,27 @@
+-- Operations tasks across the backoffice queues, raised from other
+-- services' events or by hand.
+CREATE TABLE IF NOT EXISTS tasks (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    queue VARCHAR(30) NOT NULL,
+    source_id VARCHAR(255) NOT NULL,
+    summary TEXT NOT NULL,
+    details JSONB NOT NULL DEFAULT '{}',
+    due_at TIMESTAMPTZ,
+    status VARCHAR(20) NOT NULL,
+    claimed_by UUID,
+    claimed_at TIMESTAMPTZ,
+    outcome VARCHAR(30) NOT NULL DEFAULT '',
+    resolution_note TEXT NOT NULL DEFAULT '',
+    resolved_by UUID,
+    resolved_at TIMESTAMPTZ,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A source has at most one unresolved task in a queue.
+CREATE UNIQUE INDEX uq_tasks_unresolved_source ON tasks (tenant_id, queue, source_id) WHERE status <> 'RESOLVED';
+
+CREATE INDEX idx_tasks_source ON tasks (tenant_id, queue, source_id, created_at DESC);
+CREATE INDEX idx_tasks_queue_due ON tasks (tenant_id, queue, status, due_at);
@@ -0,0 +1,12 @@
+-- The audit trail of every action on a task, written with the task.
+CREATE TABLE IF NOT EXISTS task_audit (
+    id UUID PRIMARY KEY,
+    task_id UUID NOT NULL REFERENCES tasks (id),
+    tenant_id UUID NOT NULL,
+    action VARCHAR(30) NOT NULL,
+    actor_id UUID,
+    detail TEXT NOT NULL DEFAULT '',
+    occurred_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_task_audit_task ON task_audit (task_id, occurred_at);
@@ -0,0 +1,16 @@
+-- The journey of each payment and card transaction, recorded from the
+-- events of the services it passes through. Events are keyed by their ID
+-- so redelivered ones are recorded once.
+CREATE TABLE IF NOT EXISTS transaction_timeline (
+    event_id VARCHAR(64) PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    transaction_id UUID NOT NULL,
+    stage VARCHAR(30) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    source VARCHAR(50) NOT NULL,
+    summary TEXT NOT NULL,
+    details JSONB NOT NULL DEFAULT '{}',
+    occurred_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_transaction_timeline_transaction ON transaction_timeline (tenant_id, transaction_id, occurred_at);
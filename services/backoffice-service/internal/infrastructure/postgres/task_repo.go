@@ -0,0 +1,285 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/model"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/port"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/valueobject"
+)
+
+// uniqueViolation is the PostgreSQL error code for a unique constraint
+// violation.
+const uniqueViolation = "23505"
+
+const taskColumns = `
+	id, tenant_id, queue, source_id, summary, details, due_at, status, claimed_by, claimed_at,
+	outcome, resolution_note, resolved_by, resolved_at, version, created_at, updated_at`
+
+// TaskRepo is the PostgreSQL implementation of TaskRepository.
+type TaskRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewTaskRepo creates a new TaskRepo.
+func NewTaskRepo(pool *pgxpool.Pool) *TaskRepo {
+	return &TaskRepo{pool: pool}
+}
+
+// Save persists a task with optimistic locking on its version, with its
+// pending audit entries and domain events, in one transaction. A second
+// unresolved task for the same source is a version conflict.
+func (r *TaskRepo) Save(ctx context.Context, t model.Task) error {
+	details, err := json.Marshal(t.Details())
+	if err != nil {
+		return fmt.Errorf("marshal task details: %w", err)
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	result, err := tx.Exec(ctx, `
+		INSERT INTO tasks (`+taskColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			claimed_by = EXCLUDED.claimed_by,
+			claimed_at = EXCLUDED.claimed_at,
+			outcome = EXCLUDED.outcome,
+			resolution_note = EXCLUDED.resolution_note,
+			resolved_by = EXCLUDED.resolved_by,
+			resolved_at = EXCLUDED.resolved_at,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE tasks.version = EXCLUDED.version - 1
+	`, t.ID(), t.TenantID(), t.Queue().String(), t.SourceID(), t.Summary(), details, t.DueAt(),
+		t.Status().String(), t.ClaimedBy(), t.ClaimedAt(), t.Outcome(), t.ResolutionNote(),
+		t.ResolvedBy(), t.ResolvedAt(), t.Version(), t.CreatedAt(), t.UpdatedAt(),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: %s task for %s opened concurrently", port.ErrVersionConflict, t.Queue(), t.SourceID())
+	}
+	if err != nil {
+		return fmt.Errorf("upsert task: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: task %s", port.ErrVersionConflict, t.ID())
+	}
+
+	for _, e := range t.PendingAudit() {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO task_audit (id, task_id, tenant_id, action, actor_id, detail, occurred_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`, e.ID, t.ID(), t.TenantID(), e.Action, e.ActorID, e.Detail, e.OccurredAt)
+		if err != nil {
+			return fmt.Errorf("insert audit entry: %w", err)
+		}
+	}
+
+	// Write domain events to the outbox; the publisher gives them their
+	// topic.
+	for _, evt := range t.DomainEvents() {
+		entry := events.NewOutboxEntry(evt)
+		_, err = tx.Exec(ctx, `
+			INSERT INTO outbox (id, aggregate_id, aggregate_type, event_type, payload, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`, entry.ID, entry.AggregateID, entry.AggregateType, entry.EventType, entry.Payload, entry.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("insert outbox event: %w", err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// FindByID retrieves a tenant's task.
+func (r *TaskRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Task, error) {
+	return r.findOne(ctx, `SELECT `+taskColumns+` FROM tasks WHERE id = $1 AND tenant_id = $2`, id, tenantID)
+}
+
+// FindLatestBySource retrieves the most recently opened task of a queue
+// for a source, resolved or not.
+func (r *TaskRepo) FindLatestBySource(ctx context.Context, tenantID uuid.UUID, queue valueobject.Queue, sourceID string) (model.Task, error) {
+	return r.findOne(ctx, `SELECT `+taskColumns+` FROM tasks
+		WHERE tenant_id = $1 AND queue = $2 AND source_id = $3
+		ORDER BY created_at DESC
+		LIMIT 1
+	`, tenantID, queue.String(), sourceID)
+}
+
+// List returns a page of the tasks matching the filter, those due soonest
+// first, and the total number of them.
+func (r *TaskRepo) List(ctx context.Context, filter port.TaskFilter, limit, offset int) ([]model.Task, int, error) {
+	where := []string{"tenant_id = $1"}
+	args := []any{filter.TenantID}
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		where = append(where, strings.ReplaceAll(cond, "?", "$"+strconv.Itoa(len(args))))
+	}
+	if !filter.Queue.IsZero() {
+		add("queue = ?", filter.Queue.String())
+	}
+	if !filter.Status.IsZero() {
+		add("status = ?", filter.Status.String())
+	}
+	if filter.ClaimedBy != nil {
+		add("claimed_by = ?", *filter.ClaimedBy)
+	}
+	if filter.OverdueAt != nil {
+		add("status <> 'RESOLVED' AND due_at < ?", *filter.OverdueAt)
+	}
+	cond := strings.Join(where, " AND ")
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM tasks WHERE `+cond, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count tasks: %w", err)
+	}
+	n := len(args)
+	rows, err := r.pool.Query(ctx, `SELECT `+taskColumns+` FROM tasks WHERE `+cond+`
+		ORDER BY due_at NULLS LAST, created_at, id
+		LIMIT $`+strconv.Itoa(n+1)+` OFFSET $`+strconv.Itoa(n+2),
+		append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []model.Task
+	for rows.Next() {
+		t, err := scanTask(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan task row: %w", err)
+		}
+		tasks = append(tasks, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("row iteration error: %w", err)
+	}
+	return tasks, total, nil
+}
+
+// ListAudit returns a task's audit trail, oldest first.
+func (r *TaskRepo) ListAudit(ctx context.Context, tenantID, taskID uuid.UUID) ([]model.AuditEntry, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, action, actor_id, detail, occurred_at FROM task_audit
+		WHERE task_id = $1 AND tenant_id = $2
+		ORDER BY occurred_at, id
+	`, taskID, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit trail: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []model.AuditEntry
+	for rows.Next() {
+		var e model.AuditEntry
+		if err := rows.Scan(&e.ID, &e.Action, &e.ActorID, &e.Detail, &e.OccurredAt); err != nil {
+			return nil, fmt.Errorf("failed to scan audit row: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return entries, nil
+}
+
+// CountByQueue returns the unresolved tasks of each queue of the tenant
+// that has any.
+func (r *TaskRepo) CountByQueue(ctx context.Context, tenantID, operatorID uuid.UUID, now time.Time) ([]port.QueueCounts, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT queue,
+			COUNT(*) FILTER (WHERE status = 'OPEN'),
+			COUNT(*) FILTER (WHERE status = 'CLAIMED'),
+			COUNT(*) FILTER (WHERE due_at < $3),
+			COUNT(*) FILTER (WHERE claimed_by = $2)
+		FROM tasks
+		WHERE tenant_id = $1 AND status <> 'RESOLVED'
+		GROUP BY queue
+	`, tenantID, operatorID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []port.QueueCounts
+	for rows.Next() {
+		var (
+			queueStr string
+			c        port.QueueCounts
+		)
+		if err := rows.Scan(&queueStr, &c.Open, &c.Claimed, &c.Overdue, &c.ClaimedByCaller); err != nil {
+			return nil, fmt.Errorf("failed to scan count row: %w", err)
+		}
+		if c.Queue, err = valueobject.NewQueue(queueStr); err != nil {
+			return nil, fmt.Errorf("invalid queue in database: %w", err)
+		}
+		counts = append(counts, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return counts, nil
+}
+
+func (r *TaskRepo) findOne(ctx context.Context, query string, args ...any) (model.Task, error) {
+	t, err := scanTask(r.pool.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Task{}, port.ErrTaskNotFound
+	}
+	if err != nil {
+		return model.Task{}, fmt.Errorf("failed to scan task: %w", err)
+	}
+	return t, nil
+}
+
+func scanTask(row pgx.Row) (model.Task, error) {
+	var (
+		id, tenantID                 uuid.UUID
+		queueStr, sourceID, summary  string
+		detailsJSON                  []byte
+		dueAt, claimedAt, resolvedAt *time.Time
+		statusStr, outcome, note     string
+		claimedBy, resolvedBy        *uuid.UUID
+		version                      int
+		createdAt, updatedAt         time.Time
+	)
+
+	err := row.Scan(&id, &tenantID, &queueStr, &sourceID, &summary, &detailsJSON, &dueAt, &statusStr,
+		&claimedBy, &claimedAt, &outcome, &note, &resolvedBy, &resolvedAt, &version, &createdAt, &updatedAt)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	queue, err := valueobject.NewQueue(queueStr)
+	if err != nil {
+		return model.Task{}, fmt.Errorf("invalid queue in database: %w", err)
+	}
+	status, err := valueobject.NewTaskStatus(statusStr)
+	if err != nil {
+		return model.Task{}, fmt.Errorf("invalid task status in database: %w", err)
+	}
+	var details map[string]string
+	if err := json.Unmarshal(detailsJSON, &details); err != nil {
+		return model.Task{}, fmt.Errorf("invalid task details in database: %w", err)
+	}
+
+	return model.ReconstructTask(id, tenantID, queue, sourceID, summary, details, dueAt, status,
+		claimedBy, claimedAt, outcome, note, resolvedBy, resolvedAt, version, createdAt, updatedAt), nil
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/model"
+)
+
+// TimelineRepo is the PostgreSQL implementation of TimelineRepository.
+type TimelineRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewTimelineRepo creates a new TimelineRepo.
+func NewTimelineRepo(pool *pgxpool.Pool) *TimelineRepo {
+	return &TimelineRepo{pool: pool}
+}
+
+// Append records an event on its transaction's timeline, ignoring an event
+// already recorded.
+func (r *TimelineRepo) Append(ctx context.Context, e model.TimelineEvent) error {
+	details, err := json.Marshal(e.Details)
+	if err != nil {
+		return fmt.Errorf("marshal timeline details: %w", err)
+	}
+	if e.Details == nil {
+		details = []byte("{}")
+	}
+	_, err = r.pool.Exec(ctx, `
+		INSERT INTO transaction_timeline (
+			event_id, tenant_id, transaction_id, stage, event_type, source, summary, details, occurred_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (event_id) DO NOTHING
+	`, e.EventID, e.TenantID, e.TransactionID, e.Stage, e.EventType, e.Source, e.Summary, details, e.OccurredAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert timeline event: %w", err)
+	}
+	return nil
+}
+
+// ListByTransaction returns a tenant's transaction's timeline, oldest
+// first.
+func (r *TimelineRepo) ListByTransaction(ctx context.Context, tenantID, transactionID uuid.UUID) ([]model.TimelineEvent, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT event_id, stage, event_type, source, summary, details, occurred_at
+		FROM transaction_timeline
+		WHERE tenant_id = $1 AND transaction_id = $2
+		ORDER BY occurred_at, event_id
+	`, tenantID, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query timeline: %w", err)
+	}
+	defer rows.Close()
+
+	var out []model.TimelineEvent
+	for rows.Next() {
+		e := model.TimelineEvent{TenantID: tenantID, TransactionID: transactionID}
+		var details []byte
+		if err := rows.Scan(&e.EventID, &e.Stage, &e.EventType, &e.Source, &e.Summary, &details, &e.OccurredAt); err != nil {
+			return nil, fmt.Errorf("failed to scan timeline row: %w", err)
+		}
+		if err := json.Unmarshal(details, &e.Details); err != nil {
+			return nil, fmt.Errorf("unmarshal timeline details: %w", err)
+		}
+		out = append(out, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return out, nil
+}
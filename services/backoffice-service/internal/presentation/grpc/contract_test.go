@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	backofficev1 "github.com/bibbank/bib/api/gen/go/bib/backoffice/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/backoffice-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/event"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/model"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/port"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractTaskRepo holds at most one task, whose audit trail is the entries
+// it was created with.
+type contractTaskRepo struct {
+	task *model.Task
+}
+
+func (r *contractTaskRepo) Save(context.Context, model.Task) error { return nil }
+
+func (r *contractTaskRepo) FindByID(context.Context, uuid.UUID, uuid.UUID) (model.Task, error) {
+	if r.task == nil {
+		return model.Task{}, port.ErrTaskNotFound
+	}
+	return *r.task, nil
+}
+
+func (r *contractTaskRepo) FindLatestBySource(context.Context, uuid.UUID, valueobject.Queue, string) (model.Task, error) {
+	return model.Task{}, port.ErrTaskNotFound
+}
+
+func (r *contractTaskRepo) List(context.Context, port.TaskFilter, int, int) ([]model.Task, int, error) {
+	return nil, 0, nil
+}
+
+func (r *contractTaskRepo) ListAudit(context.Context, uuid.UUID, uuid.UUID) ([]model.AuditEntry, error) {
+	if r.task == nil {
+		return nil, nil
+	}
+	return r.task.PendingAudit(), nil
+}
+
+func (r *contractTaskRepo) CountByQueue(context.Context, uuid.UUID, uuid.UUID, time.Time) ([]port.QueueCounts, error) {
+	return nil, nil
+}
+
+type discardPublisher struct{}
+
+func (discardPublisher) Publish(context.Context, []event.DomainEvent) error { return nil }
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	tasks := &contractTaskRepo{}
+	h := NewHandler(
+		nil, usecase.NewGetTaskUseCase(tasks), usecase.NewOpenTaskUseCase(tasks, discardPublisher{}),
+		nil, nil, nil, nil, nil, nil,
+		slog.Default(),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { backofficev1.RegisterBackofficeServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				UserID:   uuid.New(),
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-backoffice-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { tasks.task = nil },
+		"a reconciliation break is open": func(t *testing.T) {
+			queue, err := valueobject.NewQueue("RECONCILIATION_BREAK")
+			if err != nil {
+				t.Fatal(err)
+			}
+			task, err := model.NewTask(contractTenantID, queue, "REC-2026-0115", "Nostro statement differs by 12.50 EUR",
+				map[string]string{"difference": "12.50"}, nil, nil, time.Now().UTC())
+			if err != nil {
+				t.Fatal(err)
+			}
+			tasks.task = &task
+		},
+	})
+}
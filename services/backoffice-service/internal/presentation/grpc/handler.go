@@ -0,0 +1,435 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	backofficev1 "github.com/bibbank/bib/api/gen/go/bib/backoffice/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/backoffice-service/internal/application/dto"
+	"github.com/bibbank/bib/services/backoffice-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/model"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/port"
+)
+
+// readRoles may see the queues and tasks; workerRoles may claim, release,
+// note and resolve tasks; openerRoles may raise tasks by hand. An admin may
+// also release a task another operator holds.
+var (
+	readRoles   = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleCompliance, auth.RoleAuditor}
+	workerRoles = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleCompliance}
+	openerRoles = []string{auth.RoleAdmin, auth.RoleOperator}
+)
+
+// requireRole checks that the caller has at least one of the given roles,
+// returning the caller's claims.
+func requireRole(ctx context.Context, roles ...string) (*auth.Claims, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return claims, nil
+		}
+	}
+	return nil, status.Error(codes.PermissionDenied, "insufficient permissions")
+}
+
+// enumName strips an enum value's prefix, returning "" for the unspecified
+// value.
+func enumName(name, prefix string) string {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "UNSPECIFIED" {
+		return ""
+	}
+	return name
+}
+
+// uuidString formats an optional ID, returning "" when it is nil.
+func uuidString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+// Compile-time assertion that Handler implements BackofficeServiceServer.
+var _ backofficev1.BackofficeServiceServer = (*Handler)(nil)
+
+// Handler implements the BackofficeServiceServer gRPC interface.
+type Handler struct {
+	backofficev1.UnimplementedBackofficeServiceServer
+	listTasks       *usecase.ListTasksUseCase
+	getTask         *usecase.GetTaskUseCase
+	openTask        *usecase.OpenTaskUseCase
+	claimTask       *usecase.ClaimTaskUseCase
+	releaseTask     *usecase.ReleaseTaskUseCase
+	addTaskNote     *usecase.AddTaskNoteUseCase
+	resolveTask     *usecase.ResolveTaskUseCase
+	getQueueSummary *usecase.GetQueueSummaryUseCase
+	getTimeline     *usecase.GetTransactionTimelineUseCase
+	logger          *slog.Logger
+}
+
+// NewHandler creates a new gRPC Handler.
+func NewHandler(
+	listTasks *usecase.ListTasksUseCase,
+	getTask *usecase.GetTaskUseCase,
+	openTask *usecase.OpenTaskUseCase,
+	claimTask *usecase.ClaimTaskUseCase,
+	releaseTask *usecase.ReleaseTaskUseCase,
+	addTaskNote *usecase.AddTaskNoteUseCase,
+	resolveTask *usecase.ResolveTaskUseCase,
+	getQueueSummary *usecase.GetQueueSummaryUseCase,
+	getTimeline *usecase.GetTransactionTimelineUseCase,
+	logger *slog.Logger,
+) *Handler {
+	return &Handler{
+		listTasks:       listTasks,
+		getTask:         getTask,
+		openTask:        openTask,
+		claimTask:       claimTask,
+		releaseTask:     releaseTask,
+		addTaskNote:     addTaskNote,
+		resolveTask:     resolveTask,
+		getQueueSummary: getQueueSummary,
+		getTimeline:     getTimeline,
+		logger:          logger,
+	}
+}
+
+// ListTasks lists the tenant's tasks, those due soonest first.
+func (h *Handler) ListTasks(ctx context.Context, req *backofficev1.ListTasksRequest) (*backofficev1.ListTasksResponse, error) {
+	claims, err := requireRole(ctx, readRoles...)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	var claimedBy *uuid.UUID
+	switch req.ClaimedBy {
+	case "":
+	case "me":
+		claimedBy = &claims.UserID
+	default:
+		id, err := uuid.Parse(req.ClaimedBy)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid claimed_by")
+		}
+		claimedBy = &id
+	}
+
+	resp, err := h.listTasks.Execute(ctx, dto.ListTasksRequest{
+		TenantID:    claims.TenantID,
+		Queue:       enumName(req.Queue.String(), "QUEUE_"),
+		Status:      enumName(req.Status.String(), "TASK_STATUS_"),
+		ClaimedBy:   claimedBy,
+		OverdueOnly: req.OverdueOnly,
+		Limit:       int(req.PageSize),
+		Offset:      int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &backofficev1.ListTasksResponse{
+		Tasks:      make([]*backofficev1.Task, 0, len(resp.Tasks)),
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, t := range resp.Tasks {
+		out.Tasks = append(out.Tasks, toTaskMsg(t))
+	}
+	return out, nil
+}
+
+// GetTask retrieves a task with its audit trail.
+func (h *Handler) GetTask(ctx context.Context, req *backofficev1.GetTaskRequest) (*backofficev1.GetTaskResponse, error) {
+	claims, err := requireRole(ctx, readRoles...)
+	if err != nil {
+		return nil, err
+	}
+	taskID, err := parseTaskID(req.GetTaskId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.getTask.Execute(ctx, dto.TaskActionRequest{
+		TenantID:   claims.TenantID,
+		TaskID:     taskID,
+		OperatorID: claims.UserID,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	out := &backofficev1.GetTaskResponse{
+		Task:       toTaskMsg(resp.Task),
+		AuditTrail: make([]*backofficev1.TaskAuditEntry, 0, len(resp.AuditTrail)),
+	}
+	for _, e := range resp.AuditTrail {
+		out.AuditTrail = append(out.AuditTrail, &backofficev1.TaskAuditEntry{
+			EntryId:    e.ID.String(),
+			Action:     e.Action,
+			ActorId:    uuidString(e.ActorID),
+			Detail:     e.Detail,
+			OccurredAt: timestamppb.New(e.OccurredAt),
+		})
+	}
+	return out, nil
+}
+
+// OpenTask raises a task by hand.
+func (h *Handler) OpenTask(ctx context.Context, req *backofficev1.OpenTaskRequest) (*backofficev1.TaskResponse, error) {
+	claims, err := requireRole(ctx, openerRoles...)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	open := dto.OpenTaskRequest{
+		TenantID:   claims.TenantID,
+		OperatorID: claims.UserID,
+		Queue:      enumName(req.Queue.String(), "QUEUE_"),
+		SourceID:   req.SourceId,
+		Summary:    req.Summary,
+		Details:    req.Details,
+	}
+	if req.DueAt != nil {
+		dueAt := req.DueAt.AsTime()
+		open.DueAt = &dueAt
+	}
+
+	resp, err := h.openTask.Execute(ctx, open)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &backofficev1.TaskResponse{Task: toTaskMsg(resp)}, nil
+}
+
+// ClaimTask takes an open task for the caller.
+func (h *Handler) ClaimTask(ctx context.Context, req *backofficev1.ClaimTaskRequest) (*backofficev1.TaskResponse, error) {
+	claims, err := requireRole(ctx, workerRoles...)
+	if err != nil {
+		return nil, err
+	}
+	taskID, err := parseTaskID(req.GetTaskId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.claimTask.Execute(ctx, dto.TaskActionRequest{
+		TenantID:   claims.TenantID,
+		TaskID:     taskID,
+		OperatorID: claims.UserID,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &backofficev1.TaskResponse{Task: toTaskMsg(resp)}, nil
+}
+
+// ReleaseTask hands the caller's claimed task back to its queue. An admin
+// may release any operator's task.
+func (h *Handler) ReleaseTask(ctx context.Context, req *backofficev1.ReleaseTaskRequest) (*backofficev1.TaskResponse, error) {
+	claims, err := requireRole(ctx, workerRoles...)
+	if err != nil {
+		return nil, err
+	}
+	taskID, err := parseTaskID(req.GetTaskId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.releaseTask.Execute(ctx, dto.ReleaseTaskRequest{
+		TenantID:   claims.TenantID,
+		TaskID:     taskID,
+		OperatorID: claims.UserID,
+		Reason:     req.Reason,
+		Supervisor: claims.HasRole(auth.RoleAdmin),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &backofficev1.TaskResponse{Task: toTaskMsg(resp)}, nil
+}
+
+// AddTaskNote records the caller's note on a task.
+func (h *Handler) AddTaskNote(ctx context.Context, req *backofficev1.AddTaskNoteRequest) (*backofficev1.TaskResponse, error) {
+	claims, err := requireRole(ctx, workerRoles...)
+	if err != nil {
+		return nil, err
+	}
+	taskID, err := parseTaskID(req.GetTaskId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.addTaskNote.Execute(ctx, dto.AddTaskNoteRequest{
+		TenantID:   claims.TenantID,
+		TaskID:     taskID,
+		OperatorID: claims.UserID,
+		Note:       req.Note,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &backofficev1.TaskResponse{Task: toTaskMsg(resp)}, nil
+}
+
+// ResolveTask closes the caller's claimed task with an outcome.
+func (h *Handler) ResolveTask(ctx context.Context, req *backofficev1.ResolveTaskRequest) (*backofficev1.TaskResponse, error) {
+	claims, err := requireRole(ctx, workerRoles...)
+	if err != nil {
+		return nil, err
+	}
+	taskID, err := parseTaskID(req.GetTaskId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.resolveTask.Execute(ctx, dto.ResolveTaskRequest{
+		TenantID:   claims.TenantID,
+		TaskID:     taskID,
+		OperatorID: claims.UserID,
+		Outcome:    req.Outcome,
+		Note:       req.Note,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &backofficev1.TaskResponse{Task: toTaskMsg(resp)}, nil
+}
+
+// GetQueueSummary counts the work waiting in each queue.
+func (h *Handler) GetQueueSummary(ctx context.Context, _ *backofficev1.GetQueueSummaryRequest) (*backofficev1.GetQueueSummaryResponse, error) {
+	claims, err := requireRole(ctx, readRoles...)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := h.getQueueSummary.Execute(ctx, dto.QueueSummaryRequest{
+		TenantID:   claims.TenantID,
+		OperatorID: claims.UserID,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	out := &backofficev1.GetQueueSummaryResponse{
+		Queues: make([]*backofficev1.QueueSummary, 0, len(summary)),
+	}
+	for _, q := range summary {
+		out.Queues = append(out.Queues, &backofficev1.QueueSummary{
+			Queue:           backofficev1.Queue(backofficev1.Queue_value["QUEUE_"+q.Queue]),
+			Open:            int32(q.Open),            //nolint:gosec // bounded by row count
+			Claimed:         int32(q.Claimed),         //nolint:gosec // bounded by row count
+			Overdue:         int32(q.Overdue),         //nolint:gosec // bounded by row count
+			ClaimedByCaller: int32(q.ClaimedByCaller), //nolint:gosec // bounded by row count
+		})
+	}
+	return out, nil
+}
+
+// GetTransactionTimeline returns a payment's or card transaction's journey
+// through the platform.
+func (h *Handler) GetTransactionTimeline(ctx context.Context, req *backofficev1.GetTransactionTimelineRequest) (*backofficev1.GetTransactionTimelineResponse, error) {
+	claims, err := requireRole(ctx, readRoles...)
+	if err != nil {
+		return nil, err
+	}
+	transactionID, err := uuid.Parse(req.GetTransactionId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid transaction ID")
+	}
+
+	resp, err := h.getTimeline.Execute(ctx, dto.TimelineRequest{
+		TenantID:      claims.TenantID,
+		TransactionID: transactionID,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	out := &backofficev1.GetTransactionTimelineResponse{
+		TransactionId: resp.TransactionID.String(),
+		Events:        make([]*backofficev1.TimelineEvent, 0, len(resp.Events)),
+	}
+	for _, e := range resp.Events {
+		out.Events = append(out.Events, &backofficev1.TimelineEvent{
+			EventId:    e.EventID,
+			Stage:      backofficev1.TimelineStage(backofficev1.TimelineStage_value["TIMELINE_STAGE_"+e.Stage]),
+			EventType:  e.EventType,
+			Source:     e.Source,
+			Summary:    e.Summary,
+			Details:    e.Details,
+			OccurredAt: timestamppb.New(e.OccurredAt),
+		})
+	}
+	return out, nil
+}
+
+func parseTaskID(raw string) (uuid.UUID, error) {
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid task ID")
+	}
+	return id, nil
+}
+
+func (h *Handler) toStatus(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrTaskExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, model.ErrInvalidTransition), errors.Is(err, model.ErrClaimedByAnother),
+		errors.Is(err, port.ErrSourceRejected):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, port.ErrTaskNotFound):
+		return status.Error(codes.NotFound, "task not found")
+	case errors.Is(err, port.ErrTimelineNotFound):
+		return status.Error(codes.NotFound, "no events recorded for transaction")
+	case errors.Is(err, port.ErrVersionConflict):
+		return status.Error(codes.Aborted, "modified concurrently, retry")
+	default:
+		h.logger.Error("handler error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func toTaskMsg(t dto.TaskResponse) *backofficev1.Task {
+	msg := &backofficev1.Task{
+		TaskId:         t.ID.String(),
+		TenantId:       t.TenantID.String(),
+		Queue:          backofficev1.Queue(backofficev1.Queue_value["QUEUE_"+t.Queue]),
+		SourceId:       t.SourceID,
+		Summary:        t.Summary,
+		Details:        t.Details,
+		Status:         backofficev1.TaskStatus(backofficev1.TaskStatus_value["TASK_STATUS_"+t.Status]),
+		ClaimedBy:      uuidString(t.ClaimedBy),
+		Overdue:        t.Overdue,
+		Outcome:        t.Outcome,
+		ResolutionNote: t.ResolutionNote,
+		ResolvedBy:     uuidString(t.ResolvedBy),
+		Version:        int32(t.Version), //nolint:gosec // version counter
+		CreatedAt:      timestamppb.New(t.CreatedAt),
+		UpdatedAt:      timestamppb.New(t.UpdatedAt),
+	}
+	if t.ClaimedAt != nil {
+		msg.ClaimedAt = timestamppb.New(*t.ClaimedAt)
+	}
+	if t.DueAt != nil {
+		msg.DueAt = timestamppb.New(*t.DueAt)
+	}
+	if t.ResolvedAt != nil {
+		msg.ResolvedAt = timestamppb.New(*t.ResolvedAt)
+	}
+	return msg
+}
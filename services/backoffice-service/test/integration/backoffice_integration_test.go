@@ -0,0 +1,79 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/model"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/port"
+	"github.com/bibbank/bib/services/backoffice-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/backoffice-service/internal/infrastructure/postgres"
+)
+
+func TestTaskRepo_SaveWritesAuditAndOutboxInSameTransaction(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewTaskRepo(env.Pool())
+	ctx := context.Background()
+	tenantID, operatorID := uuid.New(), uuid.New()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	task, err := model.NewTask(tenantID, valueobject.QueueFraudReview, "case-1", "Review flagged card payment",
+		map[string]string{"score": "91"}, nil, nil, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, task))
+
+	claimed, _, err := task.ClearDomainEvents().Claim(operatorID, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, claimed))
+
+	// Saving the stale copy again conflicts on the version.
+	assert.ErrorIs(t, repo.Save(ctx, claimed), port.ErrVersionConflict)
+
+	found, err := repo.FindByID(ctx, tenantID, task.ID())
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.TaskStatusClaimed, found.Status())
+	assert.True(t, found.IsClaimedBy(operatorID))
+	assert.Equal(t, "91", found.Details()["score"])
+
+	audit, err := repo.ListAudit(ctx, tenantID, task.ID())
+	require.NoError(t, err)
+	assert.Len(t, audit, 2, "opened and claimed are audited")
+
+	var pending int
+	require.NoError(t, env.Pool().QueryRow(ctx,
+		`SELECT count(*) FROM outbox WHERE aggregate_id = $1 AND published_at IS NULL`, task.ID()).Scan(&pending))
+	assert.Equal(t, 2, pending, "TaskOpened and TaskClaimed are queued for relay")
+}
+
+func TestTaskRepo_OneUnresolvedTaskPerSource(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewTaskRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+	now := time.Now().UTC()
+
+	first, err := model.NewTask(tenantID, valueobject.QueuePaymentRepair, "payment-1", "Repair rejected payment", nil, nil, nil, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, first))
+
+	second, err := model.NewTask(tenantID, valueobject.QueuePaymentRepair, "payment-1", "Repair rejected payment", nil, nil, nil, now)
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Save(ctx, second), port.ErrVersionConflict)
+
+	_, err = repo.FindByID(ctx, uuid.New(), first.ID())
+	assert.ErrorIs(t, err, port.ErrTaskNotFound, "tasks are scoped to their tenant")
+
+	tasks, total, err := repo.List(ctx, port.TaskFilter{TenantID: tenantID}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, first.ID(), tasks[0].ID())
+}
@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
@@ -11,16 +10,22 @@ import (
 	"time"
 
 	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
 
+	cardv1 "github.com/bibbank/bib/api/gen/go/bib/card/v1"
+	"github.com/bibbank/bib/pkg/archive"
 	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/enrichment"
+	"github.com/bibbank/bib/pkg/idempotency"
 	pkgkafka "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
 	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
 	pkgpostgres "github.com/bibbank/bib/pkg/postgres"
 	"github.com/bibbank/bib/services/card-service/internal/application/usecase"
 	"github.com/bibbank/bib/services/card-service/internal/domain/service"
 	"github.com/bibbank/bib/services/card-service/internal/infrastructure/adapter"
 	"github.com/bibbank/bib/services/card-service/internal/infrastructure/config"
-	"github.com/bibbank/bib/services/card-service/internal/infrastructure/kafka"
 	"github.com/bibbank/bib/services/card-service/internal/infrastructure/postgres"
 	grpcpresentation "github.com/bibbank/bib/services/card-service/internal/presentation/grpc"
 	"github.com/bibbank/bib/services/card-service/internal/presentation/rest"
@@ -39,6 +44,7 @@ func main() {
 		Format: "json",
 	})
 	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
 
 	logger.Info("starting card-service",
 		"http_port", cfg.HTTPPort,
@@ -54,7 +60,7 @@ func main() {
 	if err != nil {
 		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
 	} else {
-		defer func() { _ = shutdown(ctx) }() //nolint:errcheck
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
 	}
 
 	// Database connection.
@@ -73,7 +79,7 @@ func main() {
 		logger.Error("failed to connect to database", "error", err)
 		os.Exit(1)
 	}
-	defer pool.Close()
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
 	logger.Info("connected to database")
 
 	// Run database migrations.
@@ -94,8 +100,50 @@ func main() {
 	kafkaProducer := pkgkafka.NewProducer(pkgkafka.Config{
 		Brokers: cfg.Kafka.Brokers,
 	})
-	defer kafkaProducer.Close()
-	eventPublisher := kafka.NewEventPublisher(kafkaProducer, "card-events", logger)
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(kafkaProducer.Close))
+
+	// Outbox relay: events are written to the outbox and published from it,
+	// so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     outbox.StaticRoute("card-events"),
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: cfg.ServiceName,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, kafkaProducer, relayCfg, logger)
+	if err != nil {
+		logger.Error("failed to create outbox relay", "error", err)
+		os.Exit(1)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+
+	// Monthly partitions are created ahead of time and old ones archived.
+	partitions := pkgpostgres.NewPartitionManager(pool, postgres.PartitionPolicies(), logger)
+	lc.Go(lifecycle.PhaseWorkers, "partition manager", func(ctx context.Context) error {
+		partitions.Run(ctx, time.Hour)
+		return nil
+	})
+
+	// Months past the database's retention go to cold storage when an
+	// archive is configured.
+	if store := archive.NewStore(cfg.Archive); store != nil {
+		archiver := archive.NewArchiver(pool, store, archive.Config{Prefix: "cards", Policies: postgres.ArchivePolicies()}, logger)
+		lc.Go(lifecycle.PhaseWorkers, "archiver", func(ctx context.Context) error {
+			archiver.Run(ctx, time.Hour)
+			return nil
+		})
+	}
+
+	eventPublisher := postgres.NewOutboxPublisher(outbox.NewPublisher(outboxStore), "card-events")
 	cardProcessor := adapter.NewStubCardProcessor(logger)
 	balanceClient := adapter.NewStubAccountBalanceClient(logger, decimal.NewFromInt(100000))
 
@@ -104,15 +152,19 @@ func main() {
 
 	// Wire use cases.
 	issueCardUC := usecase.NewIssueCardUseCase(cardRepo, eventPublisher, cardProcessor)
-	authorizeUC := usecase.NewAuthorizeTransactionUseCase(cardRepo, eventPublisher, balanceClient, jitFundingService)
+	authorizeUC := usecase.NewAuthorizeTransactionUseCase(cardRepo, eventPublisher, balanceClient, jitFundingService, enrichment.Default)
 	getCardUC := usecase.NewGetCardUseCase(cardRepo)
+	listCardsUC := usecase.NewListCardsUseCase(cardRepo)
 	freezeCardUC := usecase.NewFreezeCardUseCase(cardRepo, eventPublisher)
+	controlsUC := usecase.NewUpdateSpendingControlsUseCase(cardRepo)
 
 	// JWT service for gRPC auth (validation-only: public key preferred, secret as fallback).
 	jwtCfg := auth.JWTConfig{
 		Issuer: "bib-gateway",
 	}
 	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
 	case os.Getenv("JWT_PUBLIC_KEY") != "":
 		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
 	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
@@ -136,13 +188,36 @@ func main() {
 	}
 
 	// gRPC server.
-	grpcHandler := grpcpresentation.NewCardServiceHandler(issueCardUC, authorizeUC, getCardUC, freezeCardUC, logger)
-	grpcServer := grpcpresentation.NewServer(grpcHandler, logger, jwtSvc)
+	grpcHandler := grpcpresentation.NewCardServiceHandler(issueCardUC, authorizeUC, getCardUC, listCardsUC, freezeCardUC, controlsUC, logger)
+	// Retries of IssueCard carrying an idempotency key replay the first
+	// response instead of running it again.
+	idempotencyStore := idempotency.NewPostgresStore(pool)
+	lc.Go(lifecycle.PhaseWorkers, "idempotency purge", func(ctx context.Context) error {
+		idempotencyStore.RunPurge(ctx, time.Hour, logger)
+		return nil
+	})
+	idempotencyInterceptor := idempotency.UnaryServerInterceptor(idempotency.Config{
+		Store: idempotencyStore,
+		Methods: map[string]func() any{
+			"/bib.card.v1.CardService/IssueCard": idempotency.Response[cardv1.IssueCardResponse](),
+		},
+		Logger: logger,
+	})
+	grpcServer, err := grpcpresentation.NewServer(grpcHandler, logger, jwtSvc,
+		grpc.ChainUnaryInterceptor(idempotencyInterceptor))
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
 
 	// HTTP server (health checks).
 	healthHandler := rest.NewHealthHandler(logger)
 	httpMux := http.NewServeMux()
 	healthHandler.RegisterRoutes(httpMux)
+	if metricsHandler != nil {
+		httpMux.Handle("/metrics", metricsHandler)
+	}
 
 	httpServer := &http.Server{
 		Addr:              cfg.HTTPAddr(),
@@ -151,44 +226,22 @@ func main() {
 	}
 
 	// Start servers.
-	errCh := make(chan error, 2)
-
-	go func() {
-		if err := grpcServer.Start(cfg.GRPCAddr()); err != nil {
-			errCh <- fmt.Errorf("gRPC server error: %w", err)
-		}
-	}()
-
-	go func() {
-		logger.Info("HTTP server starting", "addr", cfg.HTTPAddr())
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			errCh <- fmt.Errorf("HTTP server error: %w", err)
-		}
-	}()
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", func(context.Context) error {
+		return grpcServer.Start(cfg.GRPCAddr())
+	})
+	lc.OnStop(lifecycle.PhaseIngress, "gRPC server", lifecycle.Func(grpcServer.Stop))
+	lc.ServeHTTP("HTTP server", httpServer)
 
 	logger.Info("card-service is running",
 		"grpc_addr", cfg.GRPCAddr(),
 		"http_addr", cfg.HTTPAddr(),
 	)
 
-	// Wait for shutdown signal.
-	select {
-	case <-ctx.Done():
-		logger.Info("shutdown signal received")
-	case err := <-errCh:
-		logger.Error("server error", "error", err)
-	}
-
-	// Graceful shutdown.
-	grpcServer.Stop()
-
-	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 15*time.Second)
-	defer shutdownCancel()
-
-	if err := httpServer.Shutdown(shutdownCtx); err != nil {
-		logger.Error("HTTP server shutdown error", "error", err)
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
 	}
-
 	logger.Info("card-service stopped")
 }
 
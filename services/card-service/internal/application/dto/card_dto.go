@@ -34,14 +34,25 @@ type AuthorizeTransactionRequest struct {
 	Currency         string          `json:"currency"`
 	MerchantName     string          `json:"merchant_name"`
 	MerchantCategory string          `json:"merchant_category"`
+	MerchantCountry  string          `json:"merchant_country"`
 	CardID           uuid.UUID       `json:"card_id"`
 }
 
 // AuthorizeTransactionResponse is the output DTO after transaction authorization.
 type AuthorizeTransactionResponse struct {
-	AuthCode string `json:"auth_code,omitempty"`
-	Reason   string `json:"reason,omitempty"`
-	Approved bool   `json:"approved"`
+	AuthCode      string           `json:"auth_code,omitempty"`
+	Reason        string           `json:"reason,omitempty"`
+	Merchant      MerchantResponse `json:"merchant"`
+	TransactionID uuid.UUID        `json:"transaction_id,omitempty"`
+	Approved      bool             `json:"approved"`
+}
+
+// MerchantResponse is a transaction's merchant as shown to customers. Logo
+// is empty when the merchant's brand is not recognized.
+type MerchantResponse struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Logo     string `json:"logo,omitempty"`
 }
 
 // GetCardRequest is the input DTO for retrieving a card.
@@ -51,21 +62,32 @@ type GetCardRequest struct {
 
 // CardResponse is the general output DTO for card details.
 type CardResponse struct {
-	CreatedAt    time.Time       `json:"created_at"`
-	UpdatedAt    time.Time       `json:"updated_at"`
-	ExpiryMonth  string          `json:"expiry_month"`
-	CardType     string          `json:"card_type"`
-	Status       string          `json:"status"`
-	LastFour     string          `json:"last_four"`
-	ExpiryYear   string          `json:"expiry_year"`
-	Currency     string          `json:"currency"`
-	DailyLimit   decimal.Decimal `json:"daily_limit"`
-	MonthlyLimit decimal.Decimal `json:"monthly_limit"`
-	DailySpent   decimal.Decimal `json:"daily_spent"`
-	MonthlySpent decimal.Decimal `json:"monthly_spent"`
-	ID           uuid.UUID       `json:"id"`
-	AccountID    uuid.UUID       `json:"account_id"`
-	TenantID     uuid.UUID       `json:"tenant_id"`
+	CreatedAt    time.Time        `json:"created_at"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+	ExpiryMonth  string           `json:"expiry_month"`
+	CardType     string           `json:"card_type"`
+	Status       string           `json:"status"`
+	LastFour     string           `json:"last_four"`
+	ExpiryYear   string           `json:"expiry_year"`
+	Currency     string           `json:"currency"`
+	DailyLimit   decimal.Decimal  `json:"daily_limit"`
+	MonthlyLimit decimal.Decimal  `json:"monthly_limit"`
+	DailySpent   decimal.Decimal  `json:"daily_spent"`
+	MonthlySpent decimal.Decimal  `json:"monthly_spent"`
+	Controls     SpendingControls `json:"spending_controls"`
+	ID           uuid.UUID        `json:"id"`
+	AccountID    uuid.UUID        `json:"account_id"`
+	TenantID     uuid.UUID        `json:"tenant_id"`
+}
+
+// ListCardsRequest is the input DTO for listing a tenant's cards.
+type ListCardsRequest struct {
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+// ListCardsResponse is the output DTO listing a tenant's cards.
+type ListCardsResponse struct {
+	Cards []CardResponse `json:"cards"`
 }
 
 // FreezeCardRequest is the input DTO for freezing a card.
@@ -78,3 +100,29 @@ type FreezeCardResponse struct {
 	Status string    `json:"status"`
 	CardID uuid.UUID `json:"card_id"`
 }
+
+// SpendingControls restricts where, and how quickly, a card can be spent.
+// Empty lists and zero amounts restrict nothing.
+type SpendingControls struct {
+	AllowedMCCs       []string        `json:"allowed_mccs"`
+	BlockedMCCs       []string        `json:"blocked_mccs"`
+	AllowedCountries  []string        `json:"allowed_countries"`
+	BlockedCountries  []string        `json:"blocked_countries"`
+	VelocityLimits    []VelocityLimit `json:"velocity_limits"`
+	MaxPerTransaction decimal.Decimal `json:"max_per_transaction"`
+}
+
+// VelocityLimit caps the transactions a card authorizes in a rolling HOUR
+// or DAY window.
+type VelocityLimit struct {
+	Window    string          `json:"window"`
+	MaxAmount decimal.Decimal `json:"max_amount"`
+	MaxCount  int             `json:"max_count"`
+}
+
+// UpdateSpendingControlsRequest is the input DTO for replacing a card's
+// spending controls.
+type UpdateSpendingControlsRequest struct {
+	Controls SpendingControls `json:"spending_controls"`
+	CardID   uuid.UUID        `json:"card_id"`
+}
@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/enrichment"
 	"github.com/bibbank/bib/services/card-service/internal/application/dto"
 	"github.com/bibbank/bib/services/card-service/internal/domain/port"
 	"github.com/bibbank/bib/services/card-service/internal/domain/service"
+	"github.com/bibbank/bib/services/card-service/internal/domain/valueobject"
 )
 
 // AuthorizeTransactionUseCase handles card transaction authorization with JIT funding.
@@ -16,25 +20,30 @@ type AuthorizeTransactionUseCase struct {
 	eventPublisher port.EventPublisher
 	balanceClient  port.AccountBalanceClient
 	jitFunding     *service.JITFundingService
+	enricher       *enrichment.Enricher
 }
 
 // NewAuthorizeTransactionUseCase creates a new AuthorizeTransactionUseCase.
+// enricher turns the merchant descriptors transactions arrive with into the
+// merchants customers see.
 func NewAuthorizeTransactionUseCase(
 	cardRepo port.CardRepository,
 	eventPublisher port.EventPublisher,
 	balanceClient port.AccountBalanceClient,
 	jitFunding *service.JITFundingService,
+	enricher *enrichment.Enricher,
 ) *AuthorizeTransactionUseCase {
 	return &AuthorizeTransactionUseCase{
 		cardRepo:       cardRepo,
 		eventPublisher: eventPublisher,
 		balanceClient:  balanceClient,
 		jitFunding:     jitFunding,
+		enricher:       enricher,
 	}
 }
 
 // Execute authorizes a card transaction.
-// Flow: check JIT funding -> authorize on card aggregate -> persist -> publish events.
+// Flow: enrich merchant -> check JIT funding -> load velocity -> authorize on card aggregate -> persist -> publish events.
 func (uc *AuthorizeTransactionUseCase) Execute(ctx context.Context, req dto.AuthorizeTransactionRequest) (dto.AuthorizeTransactionResponse, error) {
 	// 1. Retrieve the card.
 	card, err := uc.cardRepo.FindByID(ctx, req.CardID)
@@ -45,6 +54,9 @@ func (uc *AuthorizeTransactionUseCase) Execute(ctx context.Context, req dto.Auth
 		}, fmt.Errorf("failed to find card: %w", err)
 	}
 
+	merchant := uc.enricher.Merchant(req.MerchantName, req.MerchantCategory)
+	merchantResp := dto.MerchantResponse{Name: merchant.Name, Category: merchant.Category.String(), Logo: merchant.Logo}
+
 	// 2. JIT Funding: check available balance on the linked account.
 	availableBalance, err := uc.balanceClient.GetAvailableBalance(ctx, card.AccountID())
 	if err != nil {
@@ -59,27 +71,47 @@ func (uc *AuthorizeTransactionUseCase) Execute(ctx context.Context, req dto.Auth
 		return dto.AuthorizeTransactionResponse{
 			Approved: false,
 			Reason:   fundingResult.DeclineReason,
+			Merchant: merchantResp,
 		}, nil
 	}
 
-	// 3. Authorize on the card aggregate (checks status, expiry, limits).
+	// 3. Load what the card authorized in each of its velocity windows.
 	now := time.Now().UTC()
+	limits := card.SpendingControls().VelocityLimits()
+	usage := make([]valueobject.VelocityUsage, 0, len(limits))
+	for _, limit := range limits {
+		count, amount, err := uc.cardRepo.AuthorizedSince(ctx, card.ID(), now.Add(-limit.Window.Duration()))
+		if err != nil {
+			return dto.AuthorizeTransactionResponse{
+				Approved: false,
+				Reason:   "internal error",
+			}, fmt.Errorf("failed to load transaction velocity: %w", err)
+		}
+		usage = append(usage, valueobject.VelocityUsage{Window: limit.Window, Count: count, Amount: amount})
+	}
+
+	// 4. Authorize on the card aggregate (checks status, expiry, controls, limits).
+	transactionID := uuid.New()
 	updatedCard, authCode, err := card.AuthorizeTransaction(
+		transactionID,
 		req.Amount,
-		req.MerchantName,
-		req.MerchantCategory,
+		merchant,
+		req.MerchantCountry,
+		usage,
 		now,
 	)
 	if err != nil {
 		// Publish decline events even on failure.
 		_ = uc.eventPublisher.Publish(ctx, updatedCard.DomainEvents()) //nolint:errcheck
 		return dto.AuthorizeTransactionResponse{
-			Approved: false,
-			Reason:   err.Error(),
+			TransactionID: transactionID,
+			Approved:      false,
+			Reason:        err.Error(),
+			Merchant:      merchantResp,
 		}, nil
 	}
 
-	// 4. Persist the updated card and transaction record.
+	// 5. Persist the updated card and transaction record.
 	if err := uc.cardRepo.Update(ctx, updatedCard); err != nil {
 		return dto.AuthorizeTransactionResponse{
 			Approved: false,
@@ -89,11 +121,11 @@ func (uc *AuthorizeTransactionUseCase) Execute(ctx context.Context, req dto.Auth
 
 	if err := uc.cardRepo.SaveTransaction(
 		ctx,
+		transactionID,
 		updatedCard.ID(),
 		req.Amount,
 		req.Currency,
-		req.MerchantName,
-		req.MerchantCategory,
+		merchant,
 		authCode,
 		"AUTHORIZED",
 	); err != nil {
@@ -103,14 +135,16 @@ func (uc *AuthorizeTransactionUseCase) Execute(ctx context.Context, req dto.Auth
 		}, fmt.Errorf("failed to save transaction: %w", err)
 	}
 
-	// 5. Publish domain events.
+	// 6. Publish domain events.
 	if err := uc.eventPublisher.Publish(ctx, updatedCard.DomainEvents()); err != nil {
 		// Log but don't fail the authorization -- transaction is committed.
 		_ = err
 	}
 
 	return dto.AuthorizeTransactionResponse{
-		Approved: true,
-		AuthCode: authCode,
+		TransactionID: transactionID,
+		Approved:      true,
+		AuthCode:      authCode,
+		Merchant:      merchantResp,
 	}, nil
 }
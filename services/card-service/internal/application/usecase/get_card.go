@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/bibbank/bib/services/card-service/internal/application/dto"
+	"github.com/bibbank/bib/services/card-service/internal/domain/model"
 	"github.com/bibbank/bib/services/card-service/internal/domain/port"
 )
 
@@ -27,6 +28,10 @@ func (uc *GetCardUseCase) Execute(ctx context.Context, req dto.GetCardRequest) (
 		return dto.CardResponse{}, fmt.Errorf("failed to find card: %w", err)
 	}
 
+	return toCardResponse(card), nil
+}
+
+func toCardResponse(card model.Card) dto.CardResponse {
 	return dto.CardResponse{
 		ID:           card.ID(),
 		TenantID:     card.TenantID(),
@@ -41,7 +46,8 @@ func (uc *GetCardUseCase) Execute(ctx context.Context, req dto.GetCardRequest) (
 		MonthlyLimit: card.MonthlyLimit(),
 		DailySpent:   card.DailySpent(),
 		MonthlySpent: card.MonthlySpent(),
+		Controls:     toSpendingControlsResponse(card.SpendingControls()),
 		CreatedAt:    card.CreatedAt(),
 		UpdatedAt:    card.UpdatedAt(),
-	}, nil
+	}
 }
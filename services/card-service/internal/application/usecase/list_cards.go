@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/card-service/internal/application/dto"
+	"github.com/bibbank/bib/services/card-service/internal/domain/port"
+)
+
+// ListCardsUseCase handles listing a tenant's cards with their limits and
+// the spend authorized against them.
+type ListCardsUseCase struct {
+	cardRepo port.CardRepository
+}
+
+// NewListCardsUseCase creates a new ListCardsUseCase.
+func NewListCardsUseCase(cardRepo port.CardRepository) *ListCardsUseCase {
+	return &ListCardsUseCase{
+		cardRepo: cardRepo,
+	}
+}
+
+// Execute lists every card of the tenant, newest first.
+func (uc *ListCardsUseCase) Execute(ctx context.Context, req dto.ListCardsRequest) (dto.ListCardsResponse, error) {
+	cards, err := uc.cardRepo.FindByTenantID(ctx, req.TenantID)
+	if err != nil {
+		return dto.ListCardsResponse{}, fmt.Errorf("failed to list cards: %w", err)
+	}
+
+	resp := dto.ListCardsResponse{Cards: make([]dto.CardResponse, 0, len(cards))}
+	for _, card := range cards {
+		resp.Cards = append(resp.Cards, toCardResponse(card))
+	}
+	return resp, nil
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/card-service/internal/application/dto"
+	"github.com/bibbank/bib/services/card-service/internal/domain/port"
+	"github.com/bibbank/bib/services/card-service/internal/domain/valueobject"
+)
+
+// ErrInvalidSpendingControls is returned when requested spending controls
+// fail validation.
+var ErrInvalidSpendingControls = errors.New("invalid spending controls")
+
+// UpdateSpendingControlsUseCase handles replacing a card's spending controls.
+type UpdateSpendingControlsUseCase struct {
+	cardRepo port.CardRepository
+}
+
+// NewUpdateSpendingControlsUseCase creates a new UpdateSpendingControlsUseCase.
+func NewUpdateSpendingControlsUseCase(cardRepo port.CardRepository) *UpdateSpendingControlsUseCase {
+	return &UpdateSpendingControlsUseCase{
+		cardRepo: cardRepo,
+	}
+}
+
+// Execute replaces the card's spending controls with those requested.
+func (uc *UpdateSpendingControlsUseCase) Execute(ctx context.Context, req dto.UpdateSpendingControlsRequest) (dto.CardResponse, error) {
+	controls, err := toSpendingControl(req.Controls)
+	if err != nil {
+		return dto.CardResponse{}, fmt.Errorf("%w: %w", ErrInvalidSpendingControls, err)
+	}
+
+	card, err := uc.cardRepo.FindByID(ctx, req.CardID)
+	if err != nil {
+		return dto.CardResponse{}, fmt.Errorf("failed to find card: %w", err)
+	}
+
+	updatedCard, err := card.UpdateSpendingControls(controls, time.Now().UTC())
+	if err != nil {
+		return dto.CardResponse{}, fmt.Errorf("failed to update spending controls: %w", err)
+	}
+
+	if err := uc.cardRepo.Update(ctx, updatedCard); err != nil {
+		return dto.CardResponse{}, fmt.Errorf("failed to update card: %w", err)
+	}
+
+	return toCardResponse(updatedCard), nil
+}
+
+func toSpendingControl(c dto.SpendingControls) (valueobject.SpendingControl, error) {
+	limits := make([]valueobject.VelocityLimit, 0, len(c.VelocityLimits))
+	for _, l := range c.VelocityLimits {
+		window, err := valueobject.NewVelocityWindow(l.Window)
+		if err != nil {
+			return valueobject.SpendingControl{}, err
+		}
+		limits = append(limits, valueobject.VelocityLimit{
+			Window:    window,
+			MaxCount:  l.MaxCount,
+			MaxAmount: l.MaxAmount,
+		})
+	}
+	return valueobject.NewSpendingControl(
+		c.AllowedMCCs, c.BlockedMCCs,
+		c.MaxPerTransaction,
+		c.AllowedCountries, c.BlockedCountries,
+		limits,
+	)
+}
+
+func toSpendingControlsResponse(sc valueobject.SpendingControl) dto.SpendingControls {
+	limits := make([]dto.VelocityLimit, 0, len(sc.VelocityLimits()))
+	for _, l := range sc.VelocityLimits() {
+		limits = append(limits, dto.VelocityLimit{
+			Window:    l.Window.String(),
+			MaxCount:  l.MaxCount,
+			MaxAmount: l.MaxAmount,
+		})
+	}
+	return dto.SpendingControls{
+		AllowedMCCs:       sc.AllowedMCCs(),
+		BlockedMCCs:       sc.BlockedMCCs(),
+		MaxPerTransaction: sc.MaxPerTransaction(),
+		AllowedCountries:  sc.AllowedCountries(),
+		BlockedCountries:  sc.BlockedCountries(),
+		VelocityLimits:    limits,
+	}
+}
@@ -0,0 +1,16 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[CardIssued]("card.issued", 1, "Emitted when a new card is created."),
+		events.Declare[CardActivated]("card.activated", 1, "Emitted when a card transitions to ACTIVE status."),
+		events.Declare[TransactionAuthorized]("card.transaction.authorized", 1, "Emitted when a transaction is successfully authorized."),
+		events.Declare[TransactionDeclined]("card.transaction.declined", 1, "Emitted when a transaction is declined."),
+		events.Declare[CardFrozen]("card.frozen", 1, "Emitted when a card is frozen."),
+		events.Declare[CardCanceled]("card.cancelled", 1, "Emitted when a card is canceled."),
+	}
+}
@@ -0,0 +1,12 @@
+package event_test
+
+import (
+	"testing"
+
+	"github.com/bibbank/bib/pkg/events/catalogtest"
+	"github.com/bibbank/bib/services/card-service/internal/domain/event"
+)
+
+func TestEventCatalog(t *testing.T) {
+	catalogtest.CheckCatalog(t, "../../../../../events/card-service.json", "card-service", event.Catalog())
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 
+	"github.com/bibbank/bib/pkg/enrichment"
 	"github.com/bibbank/bib/pkg/events"
 )
 
@@ -53,29 +54,41 @@ func NewCardActivated(cardID, tenantID, accountID uuid.UUID, activatedAt time.Ti
 }
 
 // TransactionAuthorized is emitted when a transaction is successfully authorized.
+// TransactionID identifies the transaction across the services it passes
+// through. MerchantName and MerchantCategory are the descriptor and MCC as
+// received; MerchantDisplayName, Category and MerchantLogo are the merchant
+// as shown to customers.
 type TransactionAuthorized struct {
 	AuthorizedAt time.Time `json:"authorized_at"`
 	events.BaseEvent
-	Amount           decimal.Decimal `json:"amount"`
-	Currency         string          `json:"currency"`
-	MerchantName     string          `json:"merchant_name"`
-	MerchantCategory string          `json:"merchant_category"`
-	AuthCode         string          `json:"auth_code"`
-	CardID           uuid.UUID       `json:"card_id"`
-	AccountID        uuid.UUID       `json:"account_id"`
+	Amount              decimal.Decimal `json:"amount"`
+	Currency            string          `json:"currency"`
+	MerchantName        string          `json:"merchant_name"`
+	MerchantCategory    string          `json:"merchant_category"`
+	MerchantDisplayName string          `json:"merchant_display_name"`
+	Category            string          `json:"category"`
+	MerchantLogo        string          `json:"merchant_logo,omitempty"`
+	AuthCode            string          `json:"auth_code"`
+	TransactionID       uuid.UUID       `json:"transaction_id"`
+	CardID              uuid.UUID       `json:"card_id"`
+	AccountID           uuid.UUID       `json:"account_id"`
 }
 
-func NewTransactionAuthorized(cardID, tenantID, accountID uuid.UUID, amount decimal.Decimal, currency, merchantName, merchantCategory, authCode string, authorizedAt time.Time) TransactionAuthorized {
+func NewTransactionAuthorized(transactionID, cardID, tenantID, accountID uuid.UUID, amount decimal.Decimal, currency string, merchant enrichment.Merchant, authCode string, authorizedAt time.Time) TransactionAuthorized {
 	return TransactionAuthorized{
-		BaseEvent:        events.NewBaseEvent("card.transaction.authorized", cardID.String(), "Card", tenantID.String()),
-		CardID:           cardID,
-		AccountID:        accountID,
-		Amount:           amount,
-		Currency:         currency,
-		MerchantName:     merchantName,
-		MerchantCategory: merchantCategory,
-		AuthCode:         authCode,
-		AuthorizedAt:     authorizedAt,
+		BaseEvent:           events.NewBaseEvent("card.transaction.authorized", cardID.String(), "Card", tenantID.String()),
+		TransactionID:       transactionID,
+		CardID:              cardID,
+		AccountID:           accountID,
+		Amount:              amount,
+		Currency:            currency,
+		MerchantName:        merchant.RawName,
+		MerchantCategory:    merchant.MCC,
+		MerchantDisplayName: merchant.Name,
+		Category:            merchant.Category.String(),
+		MerchantLogo:        merchant.Logo,
+		AuthCode:            authCode,
+		AuthorizedAt:        authorizedAt,
 	}
 }
 
@@ -83,22 +96,24 @@ func NewTransactionAuthorized(cardID, tenantID, accountID uuid.UUID, amount deci
 type TransactionDeclined struct {
 	DeclinedAt time.Time `json:"declined_at"`
 	events.BaseEvent
-	Amount       decimal.Decimal `json:"amount"`
-	Currency     string          `json:"currency"`
-	MerchantName string          `json:"merchant_name"`
-	Reason       string          `json:"reason"`
-	CardID       uuid.UUID       `json:"card_id"`
+	Amount        decimal.Decimal `json:"amount"`
+	Currency      string          `json:"currency"`
+	MerchantName  string          `json:"merchant_name"`
+	Reason        string          `json:"reason"`
+	TransactionID uuid.UUID       `json:"transaction_id"`
+	CardID        uuid.UUID       `json:"card_id"`
 }
 
-func NewTransactionDeclined(cardID, tenantID uuid.UUID, amount decimal.Decimal, currency, merchantName, reason string, declinedAt time.Time) TransactionDeclined {
+func NewTransactionDeclined(transactionID, cardID, tenantID uuid.UUID, amount decimal.Decimal, currency, merchantName, reason string, declinedAt time.Time) TransactionDeclined {
 	return TransactionDeclined{
-		BaseEvent:    events.NewBaseEvent("card.transaction.declined", cardID.String(), "Card", tenantID.String()),
-		CardID:       cardID,
-		Amount:       amount,
-		Currency:     currency,
-		MerchantName: merchantName,
-		Reason:       reason,
-		DeclinedAt:   declinedAt,
+		BaseEvent:     events.NewBaseEvent("card.transaction.declined", cardID.String(), "Card", tenantID.String()),
+		TransactionID: transactionID,
+		CardID:        cardID,
+		Amount:        amount,
+		Currency:      currency,
+		MerchantName:  merchantName,
+		Reason:        reason,
+		DeclinedAt:    declinedAt,
 	}
 }
 
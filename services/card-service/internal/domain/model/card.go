@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 
+	"github.com/bibbank/bib/pkg/enrichment"
 	"github.com/bibbank/bib/pkg/events"
 	"github.com/bibbank/bib/services/card-service/internal/domain/event"
 	"github.com/bibbank/bib/services/card-service/internal/domain/valueobject"
@@ -27,6 +28,7 @@ type Card struct {
 	monthlyLimit decimal.Decimal
 	dailySpent   decimal.Decimal
 	monthlySpent decimal.Decimal
+	controls     valueobject.SpendingControl
 	domainEvents []events.DomainEvent
 	version      int
 	id           uuid.UUID
@@ -111,6 +113,7 @@ func Reconstruct(
 	currency string,
 	dailyLimit, monthlyLimit decimal.Decimal,
 	dailySpent, monthlySpent decimal.Decimal,
+	controls valueobject.SpendingControl,
 	version int,
 	createdAt, updatedAt time.Time,
 ) Card {
@@ -126,6 +129,7 @@ func Reconstruct(
 		monthlyLimit: monthlyLimit,
 		dailySpent:   dailySpent,
 		monthlySpent: monthlySpent,
+		controls:     controls,
 		version:      version,
 		createdAt:    createdAt,
 		updatedAt:    updatedAt,
@@ -207,17 +211,38 @@ func (c Card) Cancel(now time.Time) (Card, error) {
 	return c, nil
 }
 
+// UpdateSpendingControls replaces the card's spending controls. Canceled
+// cards cannot be changed.
+func (c Card) UpdateSpendingControls(controls valueobject.SpendingControl, now time.Time) (Card, error) {
+	if c.status == valueobject.CardStatusCanceled {
+		return c, fmt.Errorf("cannot update spending controls of a canceled card")
+	}
+
+	c.controls = controls
+	c.updatedAt = now.UTC()
+	c.version++
+
+	return c, nil
+}
+
 // AuthorizeTransaction attempts to authorize a transaction against this card.
-// It checks status, expiry, and spending limits before approving.
+// It checks status, expiry, spending controls and spending limits before
+// approving. country is the merchant's ISO country code and usage what the
+// card authorized in each of its velocity windows.
+// transactionID identifies the transaction in the events it raises, which
+// carry the merchant as received and as enriched for customers.
 // Returns the updated card, an authorization code, and any error.
 func (c Card) AuthorizeTransaction(
+	transactionID uuid.UUID,
 	amount decimal.Decimal,
-	merchantName, merchantCategory string,
+	merchant enrichment.Merchant,
+	country string,
+	usage []valueobject.VelocityUsage,
 	now time.Time,
 ) (Card, string, error) {
 	if !c.status.IsUsable() {
 		c.domainEvents = append(c.cloneEvents(), event.NewTransactionDeclined(
-			c.id, c.tenantID, amount, c.currency, merchantName,
+			transactionID, c.id, c.tenantID, amount, c.currency, merchant.RawName,
 			fmt.Sprintf("card is in %s status", c.status), now.UTC(),
 		))
 		return c, "", fmt.Errorf("card is not usable, current status: %s", c.status)
@@ -225,7 +250,7 @@ func (c Card) AuthorizeTransaction(
 
 	if c.cardNumber.IsExpired(now) {
 		c.domainEvents = append(c.cloneEvents(), event.NewTransactionDeclined(
-			c.id, c.tenantID, amount, c.currency, merchantName,
+			transactionID, c.id, c.tenantID, amount, c.currency, merchant.RawName,
 			"card is expired", now.UTC(),
 		))
 		return c, "", fmt.Errorf("card is expired")
@@ -235,10 +260,18 @@ func (c Card) AuthorizeTransaction(
 		return c, "", fmt.Errorf("transaction amount must be positive")
 	}
 
+	if err := c.controls.Check(amount, merchant.MCC, country, usage); err != nil {
+		c.domainEvents = append(c.cloneEvents(), event.NewTransactionDeclined(
+			transactionID, c.id, c.tenantID, amount, c.currency, merchant.RawName,
+			err.Error(), now.UTC(),
+		))
+		return c, "", err
+	}
+
 	newDailySpent := c.dailySpent.Add(amount)
 	if newDailySpent.GreaterThan(c.dailyLimit) {
 		c.domainEvents = append(c.cloneEvents(), event.NewTransactionDeclined(
-			c.id, c.tenantID, amount, c.currency, merchantName,
+			transactionID, c.id, c.tenantID, amount, c.currency, merchant.RawName,
 			"daily spending limit exceeded", now.UTC(),
 		))
 		return c, "", fmt.Errorf("daily spending limit exceeded: spent %s + %s > limit %s",
@@ -248,7 +281,7 @@ func (c Card) AuthorizeTransaction(
 	newMonthlySpent := c.monthlySpent.Add(amount)
 	if newMonthlySpent.GreaterThan(c.monthlyLimit) {
 		c.domainEvents = append(c.cloneEvents(), event.NewTransactionDeclined(
-			c.id, c.tenantID, amount, c.currency, merchantName,
+			transactionID, c.id, c.tenantID, amount, c.currency, merchant.RawName,
 			"monthly spending limit exceeded", now.UTC(),
 		))
 		return c, "", fmt.Errorf("monthly spending limit exceeded: spent %s + %s > limit %s",
@@ -263,8 +296,8 @@ func (c Card) AuthorizeTransaction(
 	authCode := generateAuthCode()
 
 	c.domainEvents = append(c.cloneEvents(), event.NewTransactionAuthorized(
-		c.id, c.tenantID, c.accountID, amount, c.currency,
-		merchantName, merchantCategory, authCode, now.UTC(),
+		transactionID, c.id, c.tenantID, c.accountID, amount, c.currency,
+		merchant, authCode, now.UTC(),
 	))
 
 	return c, authCode, nil
@@ -286,20 +319,21 @@ func (c Card) ResetMonthlySpend(now time.Time) Card {
 
 // --- Getters ---
 
-func (c Card) ID() uuid.UUID                      { return c.id }
-func (c Card) TenantID() uuid.UUID                { return c.tenantID }
-func (c Card) AccountID() uuid.UUID               { return c.accountID }
-func (c Card) CardType() valueobject.CardType     { return c.cardType }
-func (c Card) Status() valueobject.CardStatus     { return c.status }
-func (c Card) CardNumber() valueobject.CardNumber { return c.cardNumber }
-func (c Card) Currency() string                   { return c.currency }
-func (c Card) DailyLimit() decimal.Decimal        { return c.dailyLimit }
-func (c Card) MonthlyLimit() decimal.Decimal      { return c.monthlyLimit }
-func (c Card) DailySpent() decimal.Decimal        { return c.dailySpent }
-func (c Card) MonthlySpent() decimal.Decimal      { return c.monthlySpent }
-func (c Card) Version() int                       { return c.version }
-func (c Card) CreatedAt() time.Time               { return c.createdAt }
-func (c Card) UpdatedAt() time.Time               { return c.updatedAt }
+func (c Card) ID() uuid.UUID                                 { return c.id }
+func (c Card) TenantID() uuid.UUID                           { return c.tenantID }
+func (c Card) AccountID() uuid.UUID                          { return c.accountID }
+func (c Card) CardType() valueobject.CardType                { return c.cardType }
+func (c Card) Status() valueobject.CardStatus                { return c.status }
+func (c Card) CardNumber() valueobject.CardNumber            { return c.cardNumber }
+func (c Card) Currency() string                              { return c.currency }
+func (c Card) DailyLimit() decimal.Decimal                   { return c.dailyLimit }
+func (c Card) MonthlyLimit() decimal.Decimal                 { return c.monthlyLimit }
+func (c Card) DailySpent() decimal.Decimal                   { return c.dailySpent }
+func (c Card) MonthlySpent() decimal.Decimal                 { return c.monthlySpent }
+func (c Card) SpendingControls() valueobject.SpendingControl { return c.controls }
+func (c Card) Version() int                                  { return c.version }
+func (c Card) CreatedAt() time.Time                          { return c.createdAt }
+func (c Card) UpdatedAt() time.Time                          { return c.updatedAt }
 
 // DomainEvents returns all uncommitted domain events.
 func (c Card) DomainEvents() []events.DomainEvent {
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 
+	"github.com/bibbank/bib/pkg/enrichment"
 	"github.com/bibbank/bib/services/card-service/internal/domain/valueobject"
 )
 
@@ -61,9 +62,10 @@ func TestCard_ConcurrentAuthorizeTransaction(t *testing.T) {
 		go func(idx int) {
 			defer wg.Done()
 			c, code, err := card.AuthorizeTransaction(
+				uuid.New(),
 				txnAmount,
-				"Test Merchant",
-				"RETAIL",
+				enrichment.Default.Merchant("Test Merchant", "RETAIL"),
+				"", nil,
 				now,
 			)
 			results[idx] = result{card: c, authCode: code, err: err}
@@ -112,7 +114,8 @@ func TestCard_ConcurrentAuthorizeTransaction(t *testing.T) {
 	cardNearLimit := createActiveTestCard(t)
 	// Spend up to $950 on the card (limit is $1000).
 	spentCard, _, err := cardNearLimit.AuthorizeTransaction(
-		decimal.NewFromInt(950), "Big Store", "RETAIL", now,
+		uuid.New(),
+		decimal.NewFromInt(950), enrichment.Default.Merchant("Big Store", "RETAIL"), "", nil, now,
 	)
 	if err != nil {
 		t.Fatalf("failed to spend on card: %v", err)
@@ -127,9 +130,10 @@ func TestCard_ConcurrentAuthorizeTransaction(t *testing.T) {
 		go func(idx int) {
 			defer wg2.Done()
 			c, code, err := spentCard.AuthorizeTransaction(
+				uuid.New(),
 				decimal.NewFromInt(100),
-				"Another Store",
-				"RETAIL",
+				enrichment.Default.Merchant("Another Store", "RETAIL"),
+				"", nil,
 				now,
 			)
 			failResults[idx] = result{card: c, authCode: code, err: err}
@@ -174,9 +178,10 @@ func TestCard_ConcurrentFreezeAndAuthorize(t *testing.T) {
 		go func(idx int) {
 			defer wg.Done()
 			_, _, err := frozenCard.AuthorizeTransaction(
+				uuid.New(),
 				decimal.NewFromInt(10),
-				"Test Merchant",
-				"RETAIL",
+				enrichment.Default.Merchant("Test Merchant", "RETAIL"),
+				"", nil,
 				now,
 			)
 			authResults[idx] = authResult{err: err}
@@ -214,9 +219,10 @@ func TestCard_ConcurrentFreezeAndAuthorize(t *testing.T) {
 		go func(idx int) {
 			defer wg2.Done()
 			_, _, err := card.AuthorizeTransaction(
+				uuid.New(),
 				decimal.NewFromInt(10),
-				"Test Merchant",
-				"RETAIL",
+				enrichment.Default.Merchant("Test Merchant", "RETAIL"),
+				"", nil,
 				now,
 			)
 			mixedResults[idx].authErr = err
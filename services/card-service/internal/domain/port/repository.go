@@ -2,14 +2,20 @@ package port
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 
+	"github.com/bibbank/bib/pkg/enrichment"
 	"github.com/bibbank/bib/services/card-service/internal/domain/event"
 	"github.com/bibbank/bib/services/card-service/internal/domain/model"
 )
 
+// ErrCardNotFound is returned when no card has the given ID.
+var ErrCardNotFound = errors.New("card not found")
+
 // CardRepository defines the persistence port for card aggregates.
 type CardRepository interface {
 	// Save persists a new card aggregate.
@@ -28,8 +34,13 @@ type CardRepository interface {
 	// FindByTenantID retrieves all cards belonging to a tenant.
 	FindByTenantID(ctx context.Context, tenantID uuid.UUID) ([]model.Card, error)
 
-	// SaveTransaction records a card transaction.
-	SaveTransaction(ctx context.Context, cardID uuid.UUID, amount decimal.Decimal, currency, merchantName, merchantCategory, authCode, status string) error
+	// SaveTransaction records a card transaction under transactionID, with
+	// its merchant as received and as enriched for customers.
+	SaveTransaction(ctx context.Context, transactionID, cardID uuid.UUID, amount decimal.Decimal, currency string, merchant enrichment.Merchant, authCode, status string) error
+
+	// AuthorizedSince returns the number and total amount of transactions
+	// authorized on a card since the given time, for velocity limits.
+	AuthorizedSince(ctx context.Context, cardID uuid.UUID, since time.Time) (int, decimal.Decimal, error)
 }
 
 // EventPublisher defines the port for publishing domain events.
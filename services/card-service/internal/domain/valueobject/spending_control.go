@@ -0,0 +1,199 @@
+package valueobject
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	mccRegex         = regexp.MustCompile(`^\d{4}$`)
+	countryCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// VelocityWindow is the rolling period a velocity limit counts over.
+type VelocityWindow string
+
+const (
+	VelocityWindowHour VelocityWindow = "HOUR"
+	VelocityWindowDay  VelocityWindow = "DAY"
+)
+
+// NewVelocityWindow creates a validated VelocityWindow from a string.
+func NewVelocityWindow(s string) (VelocityWindow, error) {
+	w := VelocityWindow(s)
+	if w != VelocityWindowHour && w != VelocityWindowDay {
+		return "", fmt.Errorf("invalid velocity window: %q", s)
+	}
+	return w, nil
+}
+
+// String returns the string representation of the VelocityWindow.
+func (w VelocityWindow) String() string {
+	return string(w)
+}
+
+// Duration returns the length of the window.
+func (w VelocityWindow) Duration() time.Duration {
+	if w == VelocityWindowHour {
+		return time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// adjective names the window in decline reasons.
+func (w VelocityWindow) adjective() string {
+	if w == VelocityWindowHour {
+		return "hourly"
+	}
+	return "daily"
+}
+
+// VelocityLimit caps the transactions a card authorizes in a rolling
+// window by count, amount or both. A zero MaxCount or MaxAmount is no cap.
+type VelocityLimit struct {
+	MaxAmount decimal.Decimal
+	Window    VelocityWindow
+	MaxCount  int
+}
+
+// VelocityUsage is what a card authorized in a window leading up to a
+// transaction.
+type VelocityUsage struct {
+	Amount decimal.Decimal
+	Window VelocityWindow
+	Count  int
+}
+
+// SpendingControl restricts where, and how quickly, a card can be spent.
+// Empty allow lists allow everything; deny lists win over allow lists. A
+// zero MaxPerTransaction is no cap. The zero SpendingControl restricts
+// nothing.
+// This is an immutable value object.
+type SpendingControl struct {
+	maxPerTransaction decimal.Decimal
+	allowedMCCs       []string
+	blockedMCCs       []string
+	allowedCountries  []string
+	blockedCountries  []string
+	velocityLimits    []VelocityLimit
+}
+
+// NewSpendingControl creates a validated SpendingControl. MCCs are four
+// digits and countries ISO 3166-1 alpha-2 codes; each window may have at
+// most one velocity limit.
+func NewSpendingControl(
+	allowedMCCs, blockedMCCs []string,
+	maxPerTransaction decimal.Decimal,
+	allowedCountries, blockedCountries []string,
+	velocityLimits []VelocityLimit,
+) (SpendingControl, error) {
+	for _, mcc := range slices.Concat(allowedMCCs, blockedMCCs) {
+		if !mccRegex.MatchString(mcc) {
+			return SpendingControl{}, fmt.Errorf("merchant category code must be 4 digits, got: %q", mcc)
+		}
+	}
+	for _, country := range slices.Concat(allowedCountries, blockedCountries) {
+		if !countryCodeRegex.MatchString(country) {
+			return SpendingControl{}, fmt.Errorf("country must be a 2-letter uppercase ISO code, got: %q", country)
+		}
+	}
+	if maxPerTransaction.IsNegative() {
+		return SpendingControl{}, fmt.Errorf("per-transaction limit cannot be negative")
+	}
+
+	seen := make(map[VelocityWindow]bool, len(velocityLimits))
+	for _, limit := range velocityLimits {
+		if _, err := NewVelocityWindow(limit.Window.String()); err != nil {
+			return SpendingControl{}, err
+		}
+		if seen[limit.Window] {
+			return SpendingControl{}, fmt.Errorf("duplicate velocity limit for window %s", limit.Window)
+		}
+		seen[limit.Window] = true
+		if limit.MaxCount < 0 || limit.MaxAmount.IsNegative() {
+			return SpendingControl{}, fmt.Errorf("velocity limit for window %s cannot be negative", limit.Window)
+		}
+		if limit.MaxCount == 0 && limit.MaxAmount.IsZero() {
+			return SpendingControl{}, fmt.Errorf("velocity limit for window %s needs a max count or max amount", limit.Window)
+		}
+	}
+
+	return SpendingControl{
+		allowedMCCs:       slices.Clone(allowedMCCs),
+		blockedMCCs:       slices.Clone(blockedMCCs),
+		maxPerTransaction: maxPerTransaction,
+		allowedCountries:  slices.Clone(allowedCountries),
+		blockedCountries:  slices.Clone(blockedCountries),
+		velocityLimits:    slices.Clone(velocityLimits),
+	}, nil
+}
+
+// Check returns why a transaction of amount at a merchant with the given
+// MCC in country breaks the controls, or nil if it does not. usage is what
+// the card authorized in each of the controls' velocity windows; windows
+// without usage count as unused.
+func (sc SpendingControl) Check(amount decimal.Decimal, mcc, country string, usage []VelocityUsage) error {
+	if slices.Contains(sc.blockedMCCs, mcc) {
+		return fmt.Errorf("merchant category %s is blocked", mcc)
+	}
+	if len(sc.allowedMCCs) > 0 && !slices.Contains(sc.allowedMCCs, mcc) {
+		return fmt.Errorf("merchant category %q is not allowed", mcc)
+	}
+	if sc.maxPerTransaction.IsPositive() && amount.GreaterThan(sc.maxPerTransaction) {
+		return fmt.Errorf("per-transaction limit exceeded: %s > limit %s", amount.String(), sc.maxPerTransaction.String())
+	}
+	if slices.Contains(sc.blockedCountries, country) {
+		return fmt.Errorf("merchant country %s is blocked", country)
+	}
+	if len(sc.allowedCountries) > 0 && !slices.Contains(sc.allowedCountries, country) {
+		return fmt.Errorf("merchant country %q is not allowed", country)
+	}
+
+	for _, limit := range sc.velocityLimits {
+		var used VelocityUsage
+		for _, u := range usage {
+			if u.Window == limit.Window {
+				used = u
+			}
+		}
+		if limit.MaxCount > 0 && used.Count+1 > limit.MaxCount {
+			return fmt.Errorf("%s transaction count limit exceeded: %d transactions already authorized, limit %d",
+				limit.Window.adjective(), used.Count, limit.MaxCount)
+		}
+		if limit.MaxAmount.IsPositive() && used.Amount.Add(amount).GreaterThan(limit.MaxAmount) {
+			return fmt.Errorf("%s velocity limit exceeded: spent %s + %s > limit %s",
+				limit.Window.adjective(), used.Amount.String(), amount.String(), limit.MaxAmount.String())
+		}
+	}
+	return nil
+}
+
+// IsZero reports whether the controls restrict nothing.
+func (sc SpendingControl) IsZero() bool {
+	return len(sc.allowedMCCs) == 0 && len(sc.blockedMCCs) == 0 &&
+		sc.maxPerTransaction.IsZero() &&
+		len(sc.allowedCountries) == 0 && len(sc.blockedCountries) == 0 &&
+		len(sc.velocityLimits) == 0
+}
+
+// AllowedMCCs returns the merchant category codes the card may be used at.
+func (sc SpendingControl) AllowedMCCs() []string { return slices.Clone(sc.allowedMCCs) }
+
+// BlockedMCCs returns the merchant category codes the card may not be used at.
+func (sc SpendingControl) BlockedMCCs() []string { return slices.Clone(sc.blockedMCCs) }
+
+// MaxPerTransaction returns the largest single transaction allowed.
+func (sc SpendingControl) MaxPerTransaction() decimal.Decimal { return sc.maxPerTransaction }
+
+// AllowedCountries returns the merchant countries the card may be used in.
+func (sc SpendingControl) AllowedCountries() []string { return slices.Clone(sc.allowedCountries) }
+
+// BlockedCountries returns the merchant countries the card may not be used in.
+func (sc SpendingControl) BlockedCountries() []string { return slices.Clone(sc.blockedCountries) }
+
+// VelocityLimits returns the rolling window limits.
+func (sc SpendingControl) VelocityLimits() []VelocityLimit { return slices.Clone(sc.velocityLimits) }
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
+
+	"github.com/bibbank/bib/pkg/archive"
 )
 
 type DatabaseConfig struct {
@@ -20,9 +23,11 @@ type KafkaConfig struct {
 }
 
 type Config struct {
+	Archive     archive.StoreConfig
 	DB          DatabaseConfig
 	ServiceName string
 	Kafka       KafkaConfig
+	Outbox      OutboxConfig
 	GRPCPort    int
 	HTTPPort    int
 }
@@ -33,6 +38,12 @@ func (c Config) Validate() {
 	}
 }
 
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
 func Load() Config {
 	return Config{
 		GRPCPort: getEnvInt("GRPC_PORT", 9089),
@@ -45,10 +56,22 @@ func Load() Config {
 			Name:     getEnv("DB_NAME", "bib_card"),
 			SSLMode:  getEnv("DB_SSLMODE", "require"),
 		},
+		Archive: archive.StoreConfig{
+			Dir:         getEnv("ARCHIVE_DIR", ""),
+			S3Endpoint:  getEnv("ARCHIVE_S3_ENDPOINT", "https://s3.amazonaws.com"),
+			S3Region:    getEnv("ARCHIVE_S3_REGION", "us-east-1"),
+			S3Bucket:    getEnv("ARCHIVE_S3_BUCKET", ""),
+			S3AccessKey: getEnv("ARCHIVE_S3_ACCESS_KEY", ""),
+			S3SecretKey: getEnv("ARCHIVE_S3_SECRET_KEY", ""),
+		},
 		Kafka: KafkaConfig{
 			Brokers: []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
 		},
 		ServiceName: "card-service",
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
 	}
 }
 
@@ -75,3 +98,31 @@ func getEnvInt(key string, fallback int) int {
 	}
 	return fallback
 }
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
@@ -0,0 +1,26 @@
+-- Restore unpartitioned card transactions, bringing back the rows of every
+-- attached partition. Archived partitions are left in the archive schema.
+
+CREATE TABLE card_transactions_unpartitioned (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    card_id UUID NOT NULL REFERENCES cards(id),
+    amount NUMERIC(19,4) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    merchant_name VARCHAR(255) NOT NULL,
+    merchant_category VARCHAR(10) NOT NULL DEFAULT '',
+    auth_code VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO card_transactions_unpartitioned (id, card_id, amount, currency, merchant_name, merchant_category, auth_code, status, created_at)
+SELECT id, card_id, amount, currency, merchant_name, merchant_category, auth_code, status, created_at
+FROM card_transactions;
+
+DROP TABLE card_transactions;
+
+ALTER TABLE card_transactions_unpartitioned RENAME TO card_transactions;
+ALTER TABLE card_transactions RENAME CONSTRAINT card_transactions_unpartitioned_pkey TO card_transactions_pkey;
+ALTER TABLE card_transactions RENAME CONSTRAINT card_transactions_unpartitioned_card_id_fkey TO card_transactions_card_id_fkey;
+
+CREATE INDEX idx_card_txns_card ON card_transactions (card_id);
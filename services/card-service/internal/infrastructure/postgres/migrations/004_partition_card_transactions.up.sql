@@ -0,0 +1,54 @@
+-- Partition card transactions by month of creation, so that months past
+-- retention can be archived without deleting rows. Card transactions carry
+-- no tenant, so they are not bucketed further.
+--
+-- Postgres requires a partitioned table's primary key to include its
+-- partition key, so it is (id, created_at).
+--
+-- Partitions covering existing rows and the next three months are created
+-- here; the partition manager creates later months ahead of time. The
+-- DEFAULT partition only catches rows if it falls behind.
+
+CREATE TABLE card_transactions_partitioned (
+    id UUID NOT NULL DEFAULT gen_random_uuid(),
+    card_id UUID NOT NULL REFERENCES cards(id),
+    amount NUMERIC(19,4) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    merchant_name VARCHAR(255) NOT NULL,
+    merchant_category VARCHAR(10) NOT NULL DEFAULT '',
+    auth_code VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id, created_at)
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE card_transactions_default PARTITION OF card_transactions_partitioned DEFAULT;
+
+-- Monthly partitions, with UTC bounds to match the partition manager.
+DO $$
+DECLARE
+    m TIMESTAMP;
+BEGIN
+    FOR m IN
+        SELECT generate_series(
+            date_trunc('month', LEAST(COALESCE(MIN(created_at), NOW()), NOW()) AT TIME ZONE 'UTC'),
+            date_trunc('month', NOW() AT TIME ZONE 'UTC') + INTERVAL '3 months',
+            INTERVAL '1 month')
+        FROM card_transactions
+    LOOP
+        EXECUTE format('CREATE TABLE %I PARTITION OF card_transactions_partitioned FOR VALUES FROM (%L) TO (%L)',
+            'card_transactions_p' || to_char(m, 'YYYYMM'), m || '+00', (m + INTERVAL '1 month') || '+00');
+    END LOOP;
+END $$;
+
+INSERT INTO card_transactions_partitioned (id, card_id, amount, currency, merchant_name, merchant_category, auth_code, status, created_at)
+SELECT id, card_id, amount, currency, merchant_name, merchant_category, auth_code, status, created_at
+FROM card_transactions;
+
+DROP TABLE card_transactions;
+
+ALTER TABLE card_transactions_partitioned RENAME TO card_transactions;
+ALTER TABLE card_transactions RENAME CONSTRAINT card_transactions_partitioned_pkey TO card_transactions_pkey;
+ALTER TABLE card_transactions RENAME CONSTRAINT card_transactions_partitioned_card_id_fkey TO card_transactions_card_id_fkey;
+
+CREATE INDEX idx_card_txns_card ON card_transactions (card_id);
@@ -0,0 +1,4 @@
+ALTER TABLE card_transactions
+    DROP COLUMN IF EXISTS merchant_logo,
+    DROP COLUMN IF EXISTS category,
+    DROP COLUMN IF EXISTS merchant_display_name;
@@ -0,0 +1,6 @@
+-- The merchant as shown to customers, alongside the descriptor and MCC the
+-- network sent. Transactions recorded before enrichment keep empty values.
+ALTER TABLE card_transactions
+    ADD COLUMN merchant_display_name VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN category VARCHAR(20) NOT NULL DEFAULT '',
+    ADD COLUMN merchant_logo VARCHAR(64) NOT NULL DEFAULT '';
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_card_txns_card_created;
+
+ALTER TABLE cards
+    DROP COLUMN IF EXISTS spending_controls;
@@ -0,0 +1,8 @@
+-- Per-card spending controls: MCC and country allow/deny lists, a
+-- per-transaction cap and rolling velocity limits. Existing cards get
+-- controls that restrict nothing.
+ALTER TABLE cards
+    ADD COLUMN spending_controls JSONB NOT NULL DEFAULT '{}';
+
+-- Velocity limits count a card's recent authorizations.
+CREATE INDEX idx_card_txns_card_created ON card_transactions (card_id, created_at);
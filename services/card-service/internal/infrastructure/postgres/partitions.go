@@ -0,0 +1,23 @@
+package postgres
+
+import (
+	"github.com/bibbank/bib/pkg/archive"
+	pkgpostgres "github.com/bibbank/bib/pkg/postgres"
+)
+
+// PartitionPolicies are the card service's monthly partitioned tables,
+// created by migration 004. Card transactions are archived after eighteen
+// months, past the window in which they can be disputed.
+func PartitionPolicies() []pkgpostgres.PartitionPolicy {
+	return []pkgpostgres.PartitionPolicy{
+		{Table: "card_transactions", Retain: 18, Expire: pkgpostgres.ExpireArchive},
+	}
+}
+
+// ArchivePolicies move archived months of card transactions to cold
+// storage after seven years.
+func ArchivePolicies() []archive.Policy {
+	return []archive.Policy{
+		{Table: "card_transactions", ColdAfter: 84},
+	}
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,7 +12,9 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/shopspring/decimal"
 
+	"github.com/bibbank/bib/pkg/enrichment"
 	"github.com/bibbank/bib/services/card-service/internal/domain/model"
+	"github.com/bibbank/bib/services/card-service/internal/domain/port"
 	"github.com/bibbank/bib/services/card-service/internal/domain/valueobject"
 )
 
@@ -38,10 +41,15 @@ func (r *CardRepository) Save(ctx context.Context, card model.Card) error {
 			id, tenant_id, account_id, card_type, status,
 			last_four, expiry_month, expiry_year, currency,
 			daily_limit, monthly_limit, daily_spent, monthly_spent,
-			version, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+			spending_controls, version, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 	`
 
+	controls, err := marshalSpendingControls(card.SpendingControls())
+	if err != nil {
+		return err
+	}
+
 	_, err = tx.Exec(ctx, query,
 		card.ID(),
 		card.TenantID(),
@@ -56,6 +64,7 @@ func (r *CardRepository) Save(ctx context.Context, card model.Card) error {
 		card.MonthlyLimit(),
 		card.DailySpent(),
 		card.MonthlySpent(),
+		controls,
 		card.Version(),
 		card.CreatedAt(),
 		card.UpdatedAt(),
@@ -89,15 +98,22 @@ func (r *CardRepository) Update(ctx context.Context, card model.Card) error {
 			status = $1,
 			daily_spent = $2,
 			monthly_spent = $3,
-			version = $4,
-			updated_at = $5
-		WHERE id = $6 AND version = $7
+			spending_controls = $4,
+			version = $5,
+			updated_at = $6
+		WHERE id = $7 AND version = $8
 	`
 
+	controls, err := marshalSpendingControls(card.SpendingControls())
+	if err != nil {
+		return err
+	}
+
 	result, err := tx.Exec(ctx, query,
 		card.Status().String(),
 		card.DailySpent(),
 		card.MonthlySpent(),
+		controls,
 		card.Version(),
 		card.UpdatedAt(),
 		card.ID(),
@@ -129,7 +145,7 @@ func (r *CardRepository) FindByID(ctx context.Context, id uuid.UUID) (model.Card
 		SELECT id, tenant_id, account_id, card_type, status,
 			   last_four, expiry_month, expiry_year, currency,
 			   daily_limit, monthly_limit, daily_spent, monthly_spent,
-			   version, created_at, updated_at
+			   spending_controls, version, created_at, updated_at
 		FROM cards WHERE id = $1
 	`
 
@@ -142,7 +158,7 @@ func (r *CardRepository) FindByAccountID(ctx context.Context, accountID uuid.UUI
 		SELECT id, tenant_id, account_id, card_type, status,
 			   last_four, expiry_month, expiry_year, currency,
 			   daily_limit, monthly_limit, daily_spent, monthly_spent,
-			   version, created_at, updated_at
+			   spending_controls, version, created_at, updated_at
 		FROM cards WHERE account_id = $1
 		ORDER BY created_at DESC
 	`
@@ -162,7 +178,7 @@ func (r *CardRepository) FindByTenantID(ctx context.Context, tenantID uuid.UUID)
 		SELECT id, tenant_id, account_id, card_type, status,
 			   last_four, expiry_month, expiry_year, currency,
 			   daily_limit, monthly_limit, daily_spent, monthly_spent,
-			   version, created_at, updated_at
+			   spending_controls, version, created_at, updated_at
 		FROM cards WHERE tenant_id = $1
 		ORDER BY created_at DESC
 	`
@@ -179,16 +195,20 @@ func (r *CardRepository) FindByTenantID(ctx context.Context, tenantID uuid.UUID)
 // SaveTransaction records a card transaction.
 func (r *CardRepository) SaveTransaction(
 	ctx context.Context,
-	cardID uuid.UUID,
+	transactionID, cardID uuid.UUID,
 	amount decimal.Decimal,
-	currency, merchantName, merchantCategory, authCode, status string,
+	currency string,
+	merchant enrichment.Merchant,
+	authCode, status string,
 ) error {
 	query := `
-		INSERT INTO card_transactions (card_id, amount, currency, merchant_name, merchant_category, auth_code, status)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO card_transactions (id, card_id, amount, currency, merchant_name, merchant_category,
+			merchant_display_name, category, merchant_logo, auth_code, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 	`
 
-	_, err := r.pool.Exec(ctx, query, cardID, amount, currency, merchantName, merchantCategory, authCode, status)
+	_, err := r.pool.Exec(ctx, query, transactionID, cardID, amount, currency, merchant.RawName, merchant.MCC,
+		merchant.Name, merchant.Category.String(), merchant.Logo, authCode, status)
 	if err != nil {
 		return fmt.Errorf("failed to insert card transaction: %w", err)
 	}
@@ -196,6 +216,84 @@ func (r *CardRepository) SaveTransaction(
 	return nil
 }
 
+// AuthorizedSince returns the number and total amount of transactions
+// authorized on a card since the given time.
+func (r *CardRepository) AuthorizedSince(ctx context.Context, cardID uuid.UUID, since time.Time) (int, decimal.Decimal, error) {
+	query := `
+		SELECT COUNT(*), COALESCE(SUM(amount), 0)
+		FROM card_transactions
+		WHERE card_id = $1 AND status = 'AUTHORIZED' AND created_at >= $2
+	`
+
+	var (
+		count  int
+		amount decimal.Decimal
+	)
+	if err := r.pool.QueryRow(ctx, query, cardID, since).Scan(&count, &amount); err != nil {
+		return 0, decimal.Zero, fmt.Errorf("failed to query card transaction velocity: %w", err)
+	}
+	return count, amount, nil
+}
+
+// spendingControlsRecord is the JSON persisted in cards.spending_controls.
+type spendingControlsRecord struct {
+	MaxPerTransaction decimal.Decimal       `json:"max_per_transaction"`
+	AllowedMCCs       []string              `json:"allowed_mccs,omitempty"`
+	BlockedMCCs       []string              `json:"blocked_mccs,omitempty"`
+	AllowedCountries  []string              `json:"allowed_countries,omitempty"`
+	BlockedCountries  []string              `json:"blocked_countries,omitempty"`
+	VelocityLimits    []velocityLimitRecord `json:"velocity_limits,omitempty"`
+}
+
+type velocityLimitRecord struct {
+	Window    string          `json:"window"`
+	MaxAmount decimal.Decimal `json:"max_amount"`
+	MaxCount  int             `json:"max_count"`
+}
+
+func marshalSpendingControls(sc valueobject.SpendingControl) ([]byte, error) {
+	rec := spendingControlsRecord{
+		AllowedMCCs:       sc.AllowedMCCs(),
+		BlockedMCCs:       sc.BlockedMCCs(),
+		MaxPerTransaction: sc.MaxPerTransaction(),
+		AllowedCountries:  sc.AllowedCountries(),
+		BlockedCountries:  sc.BlockedCountries(),
+	}
+	for _, l := range sc.VelocityLimits() {
+		rec.VelocityLimits = append(rec.VelocityLimits, velocityLimitRecord{
+			Window:    l.Window.String(),
+			MaxCount:  l.MaxCount,
+			MaxAmount: l.MaxAmount,
+		})
+	}
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal spending controls: %w", err)
+	}
+	return data, nil
+}
+
+func unmarshalSpendingControls(data []byte) (valueobject.SpendingControl, error) {
+	var rec spendingControlsRecord
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return valueobject.SpendingControl{}, err
+	}
+	limits := make([]valueobject.VelocityLimit, 0, len(rec.VelocityLimits))
+	for _, l := range rec.VelocityLimits {
+		window, err := valueobject.NewVelocityWindow(l.Window)
+		if err != nil {
+			return valueobject.SpendingControl{}, err
+		}
+		limits = append(limits, valueobject.VelocityLimit{Window: window, MaxCount: l.MaxCount, MaxAmount: l.MaxAmount})
+	}
+	return valueobject.NewSpendingControl(
+		rec.AllowedMCCs, rec.BlockedMCCs,
+		rec.MaxPerTransaction,
+		rec.AllowedCountries, rec.BlockedCountries,
+		limits,
+	)
+}
+
 // scanCard scans a single row into a Card aggregate.
 func (r *CardRepository) scanCard(row pgx.Row) (model.Card, error) {
 	var (
@@ -212,6 +310,7 @@ func (r *CardRepository) scanCard(row pgx.Row) (model.Card, error) {
 		monthlyLimit decimal.Decimal
 		dailySpent   decimal.Decimal
 		monthlySpent decimal.Decimal
+		controlsJSON []byte
 		version      int
 		createdAt    time.Time
 		updatedAt    time.Time
@@ -221,8 +320,11 @@ func (r *CardRepository) scanCard(row pgx.Row) (model.Card, error) {
 		&id, &tenantID, &accountID, &cardTypeStr, &statusStr,
 		&lastFour, &expiryMonth, &expiryYear, &currency,
 		&dailyLimit, &monthlyLimit, &dailySpent, &monthlySpent,
-		&version, &createdAt, &updatedAt,
+		&controlsJSON, &version, &createdAt, &updatedAt,
 	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Card{}, fmt.Errorf("card %s: %w", id, port.ErrCardNotFound)
+	}
 	if err != nil {
 		return model.Card{}, fmt.Errorf("failed to scan card: %w", err)
 	}
@@ -242,11 +344,16 @@ func (r *CardRepository) scanCard(row pgx.Row) (model.Card, error) {
 		return model.Card{}, fmt.Errorf("invalid card number in DB: %w", err)
 	}
 
+	controls, err := unmarshalSpendingControls(controlsJSON)
+	if err != nil {
+		return model.Card{}, fmt.Errorf("invalid spending controls in DB: %w", err)
+	}
+
 	return model.Reconstruct(
 		id, tenantID, accountID,
 		cardType, status, cardNumber,
 		currency, dailyLimit, monthlyLimit,
-		dailySpent, monthlySpent,
+		dailySpent, monthlySpent, controls,
 		version, createdAt, updatedAt,
 	), nil
 }
@@ -276,11 +383,11 @@ func (r *CardRepository) writeOutbox(ctx context.Context, tx pgx.Tx, card model.
 		}
 
 		query := `
-			INSERT INTO outbox (aggregate_id, aggregate_type, event_type, payload)
-			VALUES ($1, $2, $3, $4)
+			INSERT INTO outbox (id, aggregate_id, aggregate_type, event_type, payload, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
 		`
 
-		_, err = tx.Exec(ctx, query, card.ID(), "Card", evt.EventType(), payload)
+		_, err = tx.Exec(ctx, query, evt.EventID(), card.ID(), "Card", evt.EventType(), payload, evt.OccurredAt())
 		if err != nil {
 			return fmt.Errorf("failed to insert outbox event: %w", err)
 		}
@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	cardv1 "github.com/bibbank/bib/api/gen/go/bib/card/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/card-service/internal/domain/model"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	repo := &mockCardRepo{}
+	h := buildHandlerWithRepo(repo)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { cardv1.RegisterCardServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-card-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { repo.findByIDFunc = nil },
+		"an active card exists": func(*testing.T) {
+			card := makeTestCard()
+			repo.findByIDFunc = func(context.Context, uuid.UUID) (model.Card, error) { return card, nil }
+		},
+	})
+}
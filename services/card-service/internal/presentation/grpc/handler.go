@@ -2,20 +2,29 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"regexp"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	cardv1 "github.com/bibbank/bib/api/gen/go/bib/card/v1"
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
 	"github.com/bibbank/bib/pkg/auth"
 	"github.com/bibbank/bib/services/card-service/internal/application/dto"
 	"github.com/bibbank/bib/services/card-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/card-service/internal/domain/port"
 )
 
-var currencyCodeRE = regexp.MustCompile(`^[A-Z]{3}$`)
+var (
+	currencyCodeRE = regexp.MustCompile(`^[A-Z]{3}$`)
+	countryCodeRE  = regexp.MustCompile(`^[A-Z]{2}$`)
+)
 
 // requireRole checks that the caller has at least one of the given roles.
 func requireRole(ctx context.Context, roles ...string) error {
@@ -41,15 +50,17 @@ func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
 }
 
 // Compile-time assertion that CardServiceHandler implements CardServiceServer.
-var _ CardServiceServer = (*CardServiceHandler)(nil)
+var _ cardv1.CardServiceServer = (*CardServiceHandler)(nil)
 
 // CardServiceHandler implements the gRPC CardServiceServer interface.
 type CardServiceHandler struct {
-	UnimplementedCardServiceServer
+	cardv1.UnimplementedCardServiceServer
 	issueCardUC  *usecase.IssueCardUseCase
 	authorizeUC  *usecase.AuthorizeTransactionUseCase
 	getCardUC    *usecase.GetCardUseCase
+	listCardsUC  *usecase.ListCardsUseCase
 	freezeCardUC *usecase.FreezeCardUseCase
+	controlsUC   *usecase.UpdateSpendingControlsUseCase
 	logger       *slog.Logger
 }
 
@@ -58,73 +69,24 @@ func NewCardServiceHandler(
 	issueCardUC *usecase.IssueCardUseCase,
 	authorizeUC *usecase.AuthorizeTransactionUseCase,
 	getCardUC *usecase.GetCardUseCase,
+	listCardsUC *usecase.ListCardsUseCase,
 	freezeCardUC *usecase.FreezeCardUseCase,
+	controlsUC *usecase.UpdateSpendingControlsUseCase,
 	logger *slog.Logger,
 ) *CardServiceHandler {
 	return &CardServiceHandler{
 		issueCardUC:  issueCardUC,
 		authorizeUC:  authorizeUC,
 		getCardUC:    getCardUC,
+		listCardsUC:  listCardsUC,
 		freezeCardUC: freezeCardUC,
+		controlsUC:   controlsUC,
 		logger:       logger,
 	}
 }
 
-// Proto-aligned request/response message types.
-
-// IssueCardRequest represents the proto IssueCardRequest message.
-type IssueCardRequest struct {
-	TenantID     string `json:"tenant_id"`
-	AccountID    string `json:"account_id"`
-	CardType     string `json:"card_type"`
-	Currency     string `json:"currency"`
-	DailyLimit   string `json:"daily_limit"`
-	MonthlyLimit string `json:"monthly_limit"`
-}
-
-// IssueCardResponse represents the proto IssueCardResponse message.
-type IssueCardResponse struct {
-	CardID string `json:"card_id"`
-	Status string `json:"status"`
-}
-
-// AuthorizeTransactionRequest represents the proto AuthorizeTransactionRequest message.
-type AuthorizeTransactionRequest struct {
-	CardID           string `json:"card_id"`
-	Amount           string `json:"amount"`
-	Currency         string `json:"currency"`
-	MerchantName     string `json:"merchant_name"`
-	MerchantCategory string `json:"merchant_category"`
-}
-
-// AuthorizeTransactionResponse represents the proto AuthorizeTransactionResponse message.
-type AuthorizeTransactionResponse struct {
-	DeclineReason     string `json:"decline_reason"`
-	AuthorizationCode string `json:"authorization_code"`
-	Approved          bool   `json:"approved"`
-}
-
-// GetCardRequest represents the proto GetCardRequest message.
-type GetCardRequest struct {
-	ID string `json:"card_id"`
-}
-
-// GetCardResponse represents the proto GetCardResponse message.
-type GetCardResponse struct {
-	CardID       string `json:"card_id"`
-	TenantID     string `json:"tenant_id"`
-	AccountID    string `json:"account_id"`
-	CardType     string `json:"card_type"`
-	Status       string `json:"status"`
-	Currency     string `json:"currency"`
-	DailyLimit   string `json:"daily_limit"`
-	MonthlyLimit string `json:"monthly_limit"`
-	MaskedPan    string `json:"masked_pan"`
-	Version      int32  `json:"version"`
-}
-
 // IssueCard handles the gRPC request to issue a new card.
-func (h *CardServiceHandler) IssueCard(ctx context.Context, req *IssueCardRequest) (*IssueCardResponse, error) {
+func (h *CardServiceHandler) IssueCard(ctx context.Context, req *cardv1.IssueCardRequest) (*cardv1.IssueCardResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -138,12 +100,12 @@ func (h *CardServiceHandler) IssueCard(ctx context.Context, req *IssueCardReques
 		return nil, err
 	}
 
-	accountUUID, err := uuid.Parse(req.AccountID)
+	accountUUID, err := uuid.Parse(req.AccountId)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid account_id: %v", err)
 	}
 
-	if req.CardType == "" {
+	if req.Type == cardv1.CardType_CARD_TYPE_UNSPECIFIED {
 		return nil, status.Error(codes.InvalidArgument, "card_type is required")
 	}
 
@@ -177,7 +139,7 @@ func (h *CardServiceHandler) IssueCard(ctx context.Context, req *IssueCardReques
 	dtoReq := dto.IssueCardRequest{
 		TenantID:     tenantID,
 		AccountID:    accountUUID,
-		CardType:     req.CardType,
+		CardType:     strings.TrimPrefix(req.Type.String(), "CARD_TYPE_"),
 		Currency:     currency,
 		DailyLimit:   dailyLimit,
 		MonthlyLimit: monthlyLimit,
@@ -188,14 +150,23 @@ func (h *CardServiceHandler) IssueCard(ctx context.Context, req *IssueCardReques
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	return &IssueCardResponse{
-		CardID: resp.CardID.String(),
-		Status: resp.Status,
+	return &cardv1.IssueCardResponse{
+		Card: &cardv1.Card{
+			Id:          resp.CardID.String(),
+			TenantId:    tenantID.String(),
+			AccountId:   accountUUID.String(),
+			Type:        cardTypeToProto(resp.CardType),
+			Status:      cardStatusToProto(resp.Status),
+			LastFour:    resp.LastFour,
+			ExpiryMonth: resp.ExpiryMonth,
+			ExpiryYear:  resp.ExpiryYear,
+			Audit:       &commonv1.AuditInfo{CreatedAt: timestamppb.New(resp.CreatedAt)},
+		},
 	}, nil
 }
 
 // AuthorizeTransaction handles the gRPC request to authorize a card transaction.
-func (h *CardServiceHandler) AuthorizeTransaction(ctx context.Context, req *AuthorizeTransactionRequest) (*AuthorizeTransactionResponse, error) {
+func (h *CardServiceHandler) AuthorizeTransaction(ctx context.Context, req *cardv1.AuthorizeTransactionRequest) (*cardv1.AuthorizeTransactionResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -204,22 +175,22 @@ func (h *CardServiceHandler) AuthorizeTransaction(ctx context.Context, req *Auth
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
 
-	cardUUID, err := uuid.Parse(req.CardID)
+	cardUUID, err := uuid.Parse(req.CardId)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid card_id: %v", err)
 	}
 
-	if req.Amount == "" {
+	if req.GetAmount().GetAmount() == "" {
 		return nil, status.Error(codes.InvalidArgument, "amount is required")
 	}
-	amount, err := decimal.NewFromString(req.Amount)
+	amount, err := decimal.NewFromString(req.GetAmount().GetAmount())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
 	}
 	if !amount.IsPositive() {
 		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
 	}
-	currency := req.Currency
+	currency := req.GetAmount().GetCurrency()
 	if currency == "" {
 		return nil, status.Error(codes.InvalidArgument, "currency is required")
 	}
@@ -229,6 +200,9 @@ func (h *CardServiceHandler) AuthorizeTransaction(ctx context.Context, req *Auth
 	if req.MerchantName == "" {
 		return nil, status.Error(codes.InvalidArgument, "merchant_name is required")
 	}
+	if req.MerchantCountry != "" && !countryCodeRE.MatchString(req.MerchantCountry) {
+		return nil, status.Error(codes.InvalidArgument, "merchant_country must be a 2-letter uppercase ISO code")
+	}
 
 	dtoReq := dto.AuthorizeTransactionRequest{
 		CardID:           cardUUID,
@@ -236,22 +210,34 @@ func (h *CardServiceHandler) AuthorizeTransaction(ctx context.Context, req *Auth
 		Currency:         currency,
 		MerchantName:     req.MerchantName,
 		MerchantCategory: req.MerchantCategory,
+		MerchantCountry:  req.MerchantCountry,
 	}
 
 	resp, err := h.authorizeUC.Execute(ctx, dtoReq)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "internal error")
 	}
+	// Transactions declined before reaching the card have no ID.
+	var transactionID string
+	if resp.TransactionID != uuid.Nil {
+		transactionID = resp.TransactionID.String()
+	}
 
-	return &AuthorizeTransactionResponse{
+	return &cardv1.AuthorizeTransactionResponse{
 		Approved:          resp.Approved,
 		DeclineReason:     resp.Reason,
 		AuthorizationCode: resp.AuthCode,
+		TransactionId:     transactionID,
+		Merchant: &cardv1.Merchant{
+			Name:     resp.Merchant.Name,
+			Category: resp.Merchant.Category,
+			Logo:     resp.Merchant.Logo,
+		},
 	}, nil
 }
 
 // GetCard handles the gRPC request to retrieve card details.
-func (h *CardServiceHandler) GetCard(ctx context.Context, req *GetCardRequest) (*GetCardResponse, error) {
+func (h *CardServiceHandler) GetCard(ctx context.Context, req *cardv1.GetCardRequest) (*cardv1.GetCardResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -260,7 +246,7 @@ func (h *CardServiceHandler) GetCard(ctx context.Context, req *GetCardRequest) (
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
 
-	cardUUID, err := uuid.Parse(req.ID)
+	cardUUID, err := uuid.Parse(req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
 	}
@@ -268,26 +254,46 @@ func (h *CardServiceHandler) GetCard(ctx context.Context, req *GetCardRequest) (
 	resp, err := h.getCardUC.Execute(ctx, dto.GetCardRequest{
 		CardID: cardUUID,
 	})
+	if errors.Is(err, port.ErrCardNotFound) {
+		return nil, status.Error(codes.NotFound, "card not found")
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	return &GetCardResponse{
-		CardID:       resp.ID.String(),
-		TenantID:     resp.TenantID.String(),
-		AccountID:    resp.AccountID.String(),
-		CardType:     resp.CardType,
-		Status:       resp.Status,
-		Currency:     resp.Currency,
-		DailyLimit:   resp.DailyLimit.StringFixed(2),
-		MonthlyLimit: resp.MonthlyLimit.StringFixed(2),
-		MaskedPan:    resp.LastFour,
-		Version:      1,
-	}, nil
+	return &cardv1.GetCardResponse{Card: toCardMsg(resp)}, nil
+}
+
+// ListCards handles the gRPC request to list the tenant's cards.
+func (h *CardServiceHandler) ListCards(ctx context.Context, req *cardv1.ListCardsRequest) (*cardv1.ListCardsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listCardsUC.Execute(ctx, dto.ListCardsRequest{TenantID: tenantID})
+	if err != nil {
+		h.logger.Error("failed to list cards", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	cards := make([]*cardv1.Card, 0, len(resp.Cards))
+	for _, c := range resp.Cards {
+		cards = append(cards, toCardMsg(c))
+	}
+	return &cardv1.ListCardsResponse{Cards: cards}, nil
 }
 
 // FreezeCard handles the gRPC request to freeze a card.
-func (h *CardServiceHandler) FreezeCard(ctx context.Context, req *FreezeCardGRPCRequest) (*FreezeCardGRPCResponse, error) {
+func (h *CardServiceHandler) FreezeCard(ctx context.Context, req *cardv1.FreezeCardRequest) (*cardv1.FreezeCardResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
 		return nil, err
 	}
@@ -296,7 +302,7 @@ func (h *CardServiceHandler) FreezeCard(ctx context.Context, req *FreezeCardGRPC
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
 
-	cardUUID, err := uuid.Parse(req.CardID)
+	cardUUID, err := uuid.Parse(req.CardId)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid card_id: %v", err)
 	}
@@ -304,12 +310,154 @@ func (h *CardServiceHandler) FreezeCard(ctx context.Context, req *FreezeCardGRPC
 	resp, err := h.freezeCardUC.Execute(ctx, dto.FreezeCardRequest{
 		CardID: cardUUID,
 	})
+	if errors.Is(err, port.ErrCardNotFound) {
+		return nil, status.Error(codes.NotFound, "card not found")
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	return &FreezeCardGRPCResponse{
-		CardID: resp.CardID.String(),
-		Status: resp.Status,
+	return &cardv1.FreezeCardResponse{
+		CardId: resp.CardID.String(),
+		Status: cardStatusToProto(resp.Status),
 	}, nil
 }
+
+// UpdateSpendingControls handles the gRPC request to replace a card's
+// spending controls.
+func (h *CardServiceHandler) UpdateSpendingControls(ctx context.Context, req *cardv1.UpdateSpendingControlsRequest) (*cardv1.UpdateSpendingControlsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	cardUUID, err := uuid.Parse(req.CardId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid card_id: %v", err)
+	}
+
+	controls, err := fromSpendingControlsMsg(req.SpendingControls)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.controlsUC.Execute(ctx, dto.UpdateSpendingControlsRequest{
+		CardID:   cardUUID,
+		Controls: controls,
+	})
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidSpendingControls) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		h.logger.Error("failed to update spending controls", "card_id", cardUUID, "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &cardv1.UpdateSpendingControlsResponse{
+		CardId:           resp.ID.String(),
+		SpendingControls: toSpendingControlsMsg(resp.Controls),
+	}, nil
+}
+
+// fromSpendingControlsMsg parses the amounts of a SpendingControls message.
+// A nil message clears all controls.
+func fromSpendingControlsMsg(msg *cardv1.SpendingControls) (dto.SpendingControls, error) {
+	if msg == nil {
+		return dto.SpendingControls{}, nil
+	}
+
+	controls := dto.SpendingControls{
+		AllowedMCCs:      msg.AllowedMccs,
+		BlockedMCCs:      msg.BlockedMccs,
+		AllowedCountries: msg.AllowedCountries,
+		BlockedCountries: msg.BlockedCountries,
+	}
+	if msg.MaxPerTransaction != "" {
+		amount, err := decimal.NewFromString(msg.MaxPerTransaction)
+		if err != nil {
+			return dto.SpendingControls{}, status.Errorf(codes.InvalidArgument, "invalid max_per_transaction amount: %v", err)
+		}
+		controls.MaxPerTransaction = amount
+	}
+	for _, l := range msg.VelocityLimits {
+		// An unspecified window is passed on empty, for the use case to reject.
+		window := strings.TrimPrefix(l.Window.String(), "VELOCITY_WINDOW_")
+		if l.Window == cardv1.VelocityWindow_VELOCITY_WINDOW_UNSPECIFIED {
+			window = ""
+		}
+		limit := dto.VelocityLimit{Window: window, MaxCount: int(l.MaxCount)}
+		if l.MaxAmount != "" {
+			amount, err := decimal.NewFromString(l.MaxAmount)
+			if err != nil {
+				return dto.SpendingControls{}, status.Errorf(codes.InvalidArgument, "invalid velocity max_amount: %v", err)
+			}
+			limit.MaxAmount = amount
+		}
+		controls.VelocityLimits = append(controls.VelocityLimits, limit)
+	}
+	return controls, nil
+}
+
+func toSpendingControlsMsg(c dto.SpendingControls) *cardv1.SpendingControls {
+	msg := &cardv1.SpendingControls{
+		AllowedMccs:      c.AllowedMCCs,
+		BlockedMccs:      c.BlockedMCCs,
+		AllowedCountries: c.AllowedCountries,
+		BlockedCountries: c.BlockedCountries,
+	}
+	if c.MaxPerTransaction.IsPositive() {
+		msg.MaxPerTransaction = c.MaxPerTransaction.StringFixed(2)
+	}
+	for _, l := range c.VelocityLimits {
+		limit := &cardv1.VelocityLimit{
+			Window:   cardv1.VelocityWindow(cardv1.VelocityWindow_value["VELOCITY_WINDOW_"+l.Window]),
+			MaxCount: int32(l.MaxCount), //nolint:gosec // validated when the controls are set
+		}
+		if l.MaxAmount.IsPositive() {
+			limit.MaxAmount = l.MaxAmount.StringFixed(2)
+		}
+		msg.VelocityLimits = append(msg.VelocityLimits, limit)
+	}
+	return msg
+}
+
+func toCardMsg(c dto.CardResponse) *cardv1.Card {
+	money := func(d decimal.Decimal) *commonv1.Money {
+		return &commonv1.Money{Amount: d.StringFixed(2), Currency: c.Currency}
+	}
+	return &cardv1.Card{
+		Id:               c.ID.String(),
+		TenantId:         c.TenantID.String(),
+		AccountId:        c.AccountID.String(),
+		Type:             cardTypeToProto(c.CardType),
+		Status:           cardStatusToProto(c.Status),
+		LastFour:         c.LastFour,
+		ExpiryMonth:      c.ExpiryMonth,
+		ExpiryYear:       c.ExpiryYear,
+		DailyLimit:       money(c.DailyLimit),
+		MonthlyLimit:     money(c.MonthlyLimit),
+		DailySpent:       money(c.DailySpent),
+		MonthlySpent:     money(c.MonthlySpent),
+		SpendingControls: toSpendingControlsMsg(c.Controls),
+		Audit: &commonv1.AuditInfo{
+			CreatedAt: timestamppb.New(c.CreatedAt),
+			UpdatedAt: timestamppb.New(c.UpdatedAt),
+			Version:   1,
+		},
+	}
+}
+
+// cardTypeToProto maps a domain card type such as "VIRTUAL" to its proto
+// enum value.
+func cardTypeToProto(s string) cardv1.CardType {
+	return cardv1.CardType(cardv1.CardType_value["CARD_TYPE_"+s])
+}
+
+// cardStatusToProto maps a domain card status such as "FROZEN" to its proto
+// enum value.
+func cardStatusToProto(s string) cardv1.CardStatus {
+	return cardv1.CardStatus(cardv1.CardStatus_value["CARD_STATUS_"+s])
+}
@@ -13,11 +13,16 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
+	cardv1 "github.com/bibbank/bib/api/gen/go/bib/card/v1"
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
 	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/enrichment"
 	"github.com/bibbank/bib/services/card-service/internal/application/usecase"
 	"github.com/bibbank/bib/services/card-service/internal/domain/event"
 	"github.com/bibbank/bib/services/card-service/internal/domain/model"
+	"github.com/bibbank/bib/services/card-service/internal/domain/port"
 	"github.com/bibbank/bib/services/card-service/internal/domain/service"
 	"github.com/bibbank/bib/services/card-service/internal/domain/valueobject"
 )
@@ -29,6 +34,7 @@ type mockCardRepo struct {
 	updateErr    error
 	findByIDFunc func(ctx context.Context, id uuid.UUID) (model.Card, error)
 	saveTxnErr   error
+	tenantCards  []model.Card
 }
 
 func (m *mockCardRepo) Save(_ context.Context, _ model.Card) error {
@@ -43,7 +49,7 @@ func (m *mockCardRepo) FindByID(ctx context.Context, id uuid.UUID) (model.Card,
 	if m.findByIDFunc != nil {
 		return m.findByIDFunc(ctx, id)
 	}
-	return model.Card{}, fmt.Errorf("card not found")
+	return model.Card{}, port.ErrCardNotFound
 }
 
 func (m *mockCardRepo) FindByAccountID(_ context.Context, _ uuid.UUID) ([]model.Card, error) {
@@ -51,13 +57,17 @@ func (m *mockCardRepo) FindByAccountID(_ context.Context, _ uuid.UUID) ([]model.
 }
 
 func (m *mockCardRepo) FindByTenantID(_ context.Context, _ uuid.UUID) ([]model.Card, error) {
-	return nil, nil
+	return m.tenantCards, nil
 }
 
-func (m *mockCardRepo) SaveTransaction(_ context.Context, _ uuid.UUID, _ decimal.Decimal, _, _, _, _, _ string) error {
+func (m *mockCardRepo) SaveTransaction(_ context.Context, _, _ uuid.UUID, _ decimal.Decimal, _ string, _ enrichment.Merchant, _, _ string) error {
 	return m.saveTxnErr
 }
 
+func (m *mockCardRepo) AuthorizedSince(_ context.Context, _ uuid.UUID, _ time.Time) (int, decimal.Decimal, error) {
+	return 0, decimal.Zero, nil
+}
+
 type mockEventPublisher struct {
 	publishErr error
 }
@@ -109,9 +119,11 @@ func buildTestHandler() *CardServiceHandler {
 
 	return NewCardServiceHandler(
 		usecase.NewIssueCardUseCase(repo, publisher, processor),
-		usecase.NewAuthorizeTransactionUseCase(repo, publisher, balanceClient, jitFunding),
+		usecase.NewAuthorizeTransactionUseCase(repo, publisher, balanceClient, jitFunding, enrichment.Default),
 		usecase.NewGetCardUseCase(repo),
+		usecase.NewListCardsUseCase(repo),
 		usecase.NewFreezeCardUseCase(repo, publisher),
+		usecase.NewUpdateSpendingControlsUseCase(repo),
 		logger,
 	)
 }
@@ -125,9 +137,11 @@ func buildHandlerWithRepo(repo *mockCardRepo) *CardServiceHandler {
 
 	return NewCardServiceHandler(
 		usecase.NewIssueCardUseCase(repo, publisher, processor),
-		usecase.NewAuthorizeTransactionUseCase(repo, publisher, balanceClient, jitFunding),
+		usecase.NewAuthorizeTransactionUseCase(repo, publisher, balanceClient, jitFunding, enrichment.Default),
 		usecase.NewGetCardUseCase(repo),
+		usecase.NewListCardsUseCase(repo),
 		usecase.NewFreezeCardUseCase(repo, publisher),
+		usecase.NewUpdateSpendingControlsUseCase(repo),
 		logger,
 	)
 }
@@ -141,7 +155,7 @@ func makeTestCard() model.Card {
 		uuid.New(), uuid.New(), uuid.New(),
 		ct, cs, cn,
 		"USD", decimal.NewFromInt(5000), decimal.NewFromInt(20000),
-		decimal.Zero, decimal.Zero,
+		decimal.Zero, decimal.Zero, valueobject.SpendingControl{},
 		1, time.Now().UTC(), time.Now().UTC(),
 	)
 }
@@ -157,10 +171,9 @@ func TestIssueCard(t *testing.T) {
 
 	t.Run("missing card_type returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.IssueCard(contextWithClaims(), &IssueCardRequest{
-			TenantID:  uuid.New().String(),
-			AccountID: uuid.New().String(),
-			CardType:  "",
+		_, err := h.IssueCard(contextWithClaims(), &cardv1.IssueCardRequest{
+			TenantId:  uuid.New().String(),
+			AccountId: uuid.New().String(),
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 		assert.Contains(t, err.Error(), "card_type is required")
@@ -168,10 +181,10 @@ func TestIssueCard(t *testing.T) {
 
 	t.Run("invalid account_id returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.IssueCard(contextWithClaims(), &IssueCardRequest{
-			TenantID:  uuid.New().String(),
-			AccountID: "bad-uuid",
-			CardType:  "VIRTUAL",
+		_, err := h.IssueCard(contextWithClaims(), &cardv1.IssueCardRequest{
+			TenantId:  uuid.New().String(),
+			AccountId: "bad-uuid",
+			Type:      cardv1.CardType_CARD_TYPE_VIRTUAL,
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 		assert.Contains(t, err.Error(), "invalid account_id")
@@ -179,10 +192,10 @@ func TestIssueCard(t *testing.T) {
 
 	t.Run("invalid daily_limit amount returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.IssueCard(contextWithClaims(), &IssueCardRequest{
-			TenantID:   uuid.New().String(),
-			AccountID:  uuid.New().String(),
-			CardType:   "VIRTUAL",
+		_, err := h.IssueCard(contextWithClaims(), &cardv1.IssueCardRequest{
+			TenantId:   uuid.New().String(),
+			AccountId:  uuid.New().String(),
+			Type:       cardv1.CardType_CARD_TYPE_VIRTUAL,
 			Currency:   "USD",
 			DailyLimit: "not-a-number",
 		})
@@ -192,27 +205,28 @@ func TestIssueCard(t *testing.T) {
 
 	t.Run("happy path issues a card", func(t *testing.T) {
 		h := buildTestHandler()
-		resp, err := h.IssueCard(contextWithClaims(), &IssueCardRequest{
-			TenantID:     uuid.New().String(),
-			AccountID:    uuid.New().String(),
-			CardType:     "VIRTUAL",
+		resp, err := h.IssueCard(contextWithClaims(), &cardv1.IssueCardRequest{
+			TenantId:     uuid.New().String(),
+			AccountId:    uuid.New().String(),
+			Type:         cardv1.CardType_CARD_TYPE_VIRTUAL,
 			Currency:     "USD",
 			DailyLimit:   "5000",
 			MonthlyLimit: "20000",
 		})
 		require.NoError(t, err)
-		assert.NotEmpty(t, resp.CardID)
-		assert.NotEmpty(t, resp.Status)
+		assert.NotEmpty(t, resp.GetCard().GetId())
+		assert.Equal(t, cardv1.CardType_CARD_TYPE_VIRTUAL, resp.GetCard().GetType())
+		assert.NotEqual(t, cardv1.CardStatus_CARD_STATUS_UNSPECIFIED, resp.GetCard().GetStatus())
 	})
 
 	t.Run("save failure returns Internal", func(t *testing.T) {
 		repo := &mockCardRepo{saveErr: fmt.Errorf("db error")}
 		h := buildHandlerWithRepo(repo)
 
-		_, err := h.IssueCard(contextWithClaims(), &IssueCardRequest{
-			TenantID:     uuid.New().String(),
-			AccountID:    uuid.New().String(),
-			CardType:     "VIRTUAL",
+		_, err := h.IssueCard(contextWithClaims(), &cardv1.IssueCardRequest{
+			TenantId:     uuid.New().String(),
+			AccountId:    uuid.New().String(),
+			Type:         cardv1.CardType_CARD_TYPE_VIRTUAL,
 			Currency:     "USD",
 			DailyLimit:   "1000",
 			MonthlyLimit: "5000",
@@ -230,8 +244,8 @@ func TestAuthorizeTransaction(t *testing.T) {
 
 	t.Run("invalid card_id returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.AuthorizeTransaction(contextWithClaims(), &AuthorizeTransactionRequest{
-			CardID: "bad-uuid",
+		_, err := h.AuthorizeTransaction(contextWithClaims(), &cardv1.AuthorizeTransactionRequest{
+			CardId: "bad-uuid",
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 		assert.Contains(t, err.Error(), "invalid card_id")
@@ -239,10 +253,9 @@ func TestAuthorizeTransaction(t *testing.T) {
 
 	t.Run("invalid amount returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.AuthorizeTransaction(contextWithClaims(), &AuthorizeTransactionRequest{
-			CardID:   uuid.New().String(),
-			Amount:   "not-a-number",
-			Currency: "USD",
+		_, err := h.AuthorizeTransaction(contextWithClaims(), &cardv1.AuthorizeTransactionRequest{
+			CardId: uuid.New().String(),
+			Amount: &commonv1.Money{Amount: "not-a-number", Currency: "USD"},
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 		assert.Contains(t, err.Error(), "invalid amount")
@@ -258,7 +271,7 @@ func TestGetCard(t *testing.T) {
 
 	t.Run("invalid id returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.GetCard(contextWithClaims(), &GetCardRequest{ID: "bad-uuid"})
+		_, err := h.GetCard(contextWithClaims(), &cardv1.GetCardRequest{Id: "bad-uuid"})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 	})
 
@@ -271,27 +284,52 @@ func TestGetCard(t *testing.T) {
 		}
 		h := buildHandlerWithRepo(repo)
 
-		resp, err := h.GetCard(contextWithClaims(), &GetCardRequest{ID: card.ID().String()})
+		resp, err := h.GetCard(contextWithClaims(), &cardv1.GetCardRequest{Id: card.ID().String()})
 		require.NoError(t, err)
-		assert.Equal(t, card.ID().String(), resp.CardID)
-		assert.Equal(t, "VIRTUAL", resp.CardType)
-		assert.Equal(t, "ACTIVE", resp.Status)
-		assert.Equal(t, "1234", resp.MaskedPan)
-		assert.Equal(t, "5000.00", resp.DailyLimit)
-		assert.Equal(t, "20000.00", resp.MonthlyLimit)
+		got := resp.GetCard()
+		assert.Equal(t, card.ID().String(), got.GetId())
+		assert.Equal(t, cardv1.CardType_CARD_TYPE_VIRTUAL, got.GetType())
+		assert.Equal(t, cardv1.CardStatus_CARD_STATUS_ACTIVE, got.GetStatus())
+		assert.Equal(t, "1234", got.GetLastFour())
+		assert.Equal(t, "5000.00", got.GetDailyLimit().GetAmount())
+		assert.Equal(t, "20000.00", got.GetMonthlyLimit().GetAmount())
 	})
 
-	t.Run("not found returns Internal", func(t *testing.T) {
+	t.Run("not found returns NotFound", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.GetCard(contextWithClaims(), &GetCardRequest{ID: uuid.New().String()})
-		requireGRPCCode(t, err, codes.Internal)
+		_, err := h.GetCard(contextWithClaims(), &cardv1.GetCardRequest{Id: uuid.New().String()})
+		requireGRPCCode(t, err, codes.NotFound)
+	})
+}
+
+func TestListCards(t *testing.T) {
+	t.Run("customer is denied", func(t *testing.T) {
+		ctx := auth.ContextWithClaims(context.Background(), &auth.Claims{
+			UserID: uuid.New(), TenantID: uuid.New(), Roles: []string{auth.RoleCustomer},
+		})
+		_, err := buildTestHandler().ListCards(ctx, &cardv1.ListCardsRequest{})
+		requireGRPCCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("happy path lists limits and spend", func(t *testing.T) {
+		card, _, err := makeTestCard().AuthorizeTransaction(uuid.New(), decimal.NewFromInt(120), enrichment.Default.Merchant("Coffee", "5814"), "", nil, time.Now().UTC())
+		require.NoError(t, err)
+		h := buildHandlerWithRepo(&mockCardRepo{tenantCards: []model.Card{card}})
+
+		resp, err := h.ListCards(contextWithClaims(), &cardv1.ListCardsRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Cards, 1)
+		assert.Equal(t, card.ID().String(), resp.Cards[0].GetId())
+		assert.Equal(t, "5000.00", resp.Cards[0].GetDailyLimit().GetAmount())
+		assert.Equal(t, "120.00", resp.Cards[0].GetDailySpent().GetAmount())
+		assert.Equal(t, "120.00", resp.Cards[0].GetMonthlySpent().GetAmount())
 	})
 }
 
 func TestFreezeCard(t *testing.T) {
 	t.Run("invalid card_id returns error", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.FreezeCard(contextWithClaims(), &FreezeCardGRPCRequest{CardID: "bad-uuid"})
+		_, err := h.FreezeCard(contextWithClaims(), &cardv1.FreezeCardRequest{CardId: "bad-uuid"})
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "invalid card_id")
 	})
@@ -305,10 +343,61 @@ func TestFreezeCard(t *testing.T) {
 		}
 		h := buildHandlerWithRepo(repo)
 
-		resp, err := h.FreezeCard(contextWithClaims(), &FreezeCardGRPCRequest{CardID: card.ID().String()})
+		resp, err := h.FreezeCard(contextWithClaims(), &cardv1.FreezeCardRequest{CardId: card.ID().String()})
 		require.NoError(t, err)
 		require.NotNil(t, resp)
-		assert.Equal(t, "FROZEN", resp.Status)
+		assert.Equal(t, cardv1.CardStatus_CARD_STATUS_FROZEN, resp.Status)
+	})
+}
+
+func TestUpdateSpendingControls(t *testing.T) {
+	card := makeTestCard()
+	repo := &mockCardRepo{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.Card, error) {
+			return card, nil
+		},
+	}
+	h := buildHandlerWithRepo(repo)
+
+	t.Run("invalid controls return InvalidArgument", func(t *testing.T) {
+		_, err := h.UpdateSpendingControls(contextWithClaims(), &cardv1.UpdateSpendingControlsRequest{
+			CardId:           card.ID().String(),
+			SpendingControls: &cardv1.SpendingControls{VelocityLimits: []*cardv1.VelocityLimit{{MaxCount: 5}}},
+		})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+
+		_, err = h.UpdateSpendingControls(contextWithClaims(), &cardv1.UpdateSpendingControlsRequest{
+			CardId:           card.ID().String(),
+			SpendingControls: &cardv1.SpendingControls{MaxPerTransaction: "lots"},
+		})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("customers cannot change controls", func(t *testing.T) {
+		ctx := auth.ContextWithClaims(context.Background(), &auth.Claims{UserID: uuid.New(), TenantID: uuid.New(), Roles: []string{auth.RoleCustomer}})
+		_, err := h.UpdateSpendingControls(ctx, &cardv1.UpdateSpendingControlsRequest{CardId: card.ID().String()})
+		requireGRPCCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("happy path returns the new controls", func(t *testing.T) {
+		resp, err := h.UpdateSpendingControls(contextWithClaims(), &cardv1.UpdateSpendingControlsRequest{
+			CardId: card.ID().String(),
+			SpendingControls: &cardv1.SpendingControls{
+				BlockedMccs:       []string{"7995"},
+				MaxPerTransaction: "250",
+				AllowedCountries:  []string{"US"},
+				VelocityLimits:    []*cardv1.VelocityLimit{{Window: cardv1.VelocityWindow_VELOCITY_WINDOW_HOUR, MaxCount: 5, MaxAmount: "500"}},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, card.ID().String(), resp.CardId)
+		want := &cardv1.SpendingControls{
+			BlockedMccs:       []string{"7995"},
+			MaxPerTransaction: "250.00",
+			AllowedCountries:  []string{"US"},
+			VelocityLimits:    []*cardv1.VelocityLimit{{Window: cardv1.VelocityWindow_VELOCITY_WINDOW_HOUR, MaxCount: 5, MaxAmount: "500.00"}},
+		}
+		assert.True(t, proto.Equal(want, resp.SpendingControls), "controls = %v", resp.SpendingControls)
 	})
 }
 
@@ -2,83 +2,58 @@ package grpc
 
 import (
 	"log/slog"
-	"net"
-	"os"
+	"time"
 
-	"github.com/bibbank/bib/pkg/auth"
-	"github.com/bibbank/bib/pkg/tlsutil"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/health"
-	healthpb "google.golang.org/grpc/health/grpc_health_v1"
-	"google.golang.org/grpc/reflection"
+
+	cardv1 "github.com/bibbank/bib/api/gen/go/bib/card/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/grpcserver"
 )
 
 // Server wraps the gRPC server for card-service.
 type Server struct {
-	grpcServer *grpc.Server
-	handler    *CardServiceHandler
-	logger     *slog.Logger
+	server  *grpcserver.Server
+	handler *CardServiceHandler
 }
 
-// NewServer creates a new gRPC server with the given handler.
-func NewServer(handler *CardServiceHandler, logger *slog.Logger, jwtService *auth.JWTService) *Server {
-	// Add auth interceptor, skipping health check methods.
-	authInterceptor := auth.UnaryAuthInterceptor(jwtService, []string{
-		"/grpc.health.v1.Health/Check",
-		"/grpc.health.v1.Health/Watch",
-	})
-
-	var serverOpts []grpc.ServerOption
-	serverOpts = append(serverOpts, grpc.UnaryInterceptor(authInterceptor))
-
-	// Optional TLS: set GRPC_TLS_CERT_FILE and GRPC_TLS_KEY_FILE to enable.
-	if certFile, keyFile := os.Getenv("GRPC_TLS_CERT_FILE"), os.Getenv("GRPC_TLS_KEY_FILE"); certFile != "" && keyFile != "" {
-		creds, err := tlsutil.ServerTLSConfig(certFile, keyFile)
-		if err != nil {
-			logger.Error("failed to load TLS credentials, starting without TLS", "error", err)
-		} else {
-			serverOpts = append(serverOpts, grpc.Creds(creds))
-			logger.Info("gRPC TLS enabled", "cert", certFile, "key", keyFile)
-		}
-	} else {
-		logger.Info("gRPC TLS not configured, running without TLS")
-	}
-
-	grpcServer := grpc.NewServer(serverOpts...)
-
-	// Register gRPC health check.
-	healthServer := health.NewServer()
-	healthpb.RegisterHealthServer(grpcServer, healthServer)
-	healthServer.SetServingStatus("card-service", healthpb.HealthCheckResponse_SERVING)
-
-	// Register the CardService handler.
-	RegisterCardServiceServer(grpcServer, handler)
-
-	// Only enable reflection when GRPC_REFLECTION=true.
-	if os.Getenv("GRPC_REFLECTION") == "true" {
-		reflection.Register(grpcServer)
+// NewServer creates a new gRPC server with the given handler. opts are
+// applied after the standard interceptors, so chained interceptors see the
+// caller's claims.
+func NewServer(handler *CardServiceHandler, logger *slog.Logger, jwtService *auth.JWTService, opts ...grpc.ServerOption) (*Server, error) {
+	srv, err := grpcserver.New(grpcserver.Config{
+		ServiceName:   "card-service",
+		JWTService:    jwtService,
+		ServerOptions: opts,
+		// Authorizations answer a card network that times out in seconds;
+		// one that cannot finish by then should fail, not queue.
+		MethodTimeouts: map[string]time.Duration{
+			"/bib.card.v1.CardService/AuthorizeTransaction": 5 * time.Second,
+		},
+	}, logger)
+	if err != nil {
+		return nil, err
 	}
+	cardv1.RegisterCardServiceServer(srv, handler)
 
 	return &Server{
-		grpcServer: grpcServer,
-		handler:    handler,
-		logger:     logger,
-	}
+		server:  srv,
+		handler: handler,
+	}, nil
 }
 
 // Start begins listening on the specified address.
 func (s *Server) Start(addr string) error {
-	listener, err := net.Listen("tcp", addr)
-	if err != nil {
-		return err
-	}
-
-	s.logger.Info("gRPC server starting", slog.String("addr", addr))
-	return s.grpcServer.Serve(listener)
+	return s.server.ListenAndServe(addr)
 }
 
 // Stop gracefully stops the gRPC server.
 func (s *Server) Stop() {
-	s.logger.Info("gRPC server stopping")
-	s.grpcServer.GracefulStop()
+	s.server.Stop()
+}
+
+// RegisterService registers another service on the server, such as the
+// outbox administration service.
+func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	s.server.RegisterService(desc, impl)
 }
@@ -0,0 +1,74 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/card-service/internal/domain/model"
+	"github.com/bibbank/bib/services/card-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/card-service/internal/infrastructure/postgres"
+)
+
+func newCard(t *testing.T, tenantID, accountID uuid.UUID) model.Card {
+	t.Helper()
+	card, err := model.NewCard(tenantID, accountID, valueobject.CardTypeVirtual, "USD",
+		decimal.NewFromInt(500), decimal.NewFromInt(5000))
+	require.NoError(t, err)
+	return card
+}
+
+func TestCardRepository_UpdateWritesOutboxInSameTransaction(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewCardRepository(env.Pool())
+	ctx := context.Background()
+
+	card := newCard(t, uuid.New(), uuid.New())
+	require.NoError(t, repo.Save(ctx, card))
+
+	active, err := card.Activate(time.Now())
+	require.NoError(t, err)
+	require.NoError(t, repo.Update(ctx, active))
+
+	found, err := repo.FindByID(ctx, card.ID())
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.CardStatusActive, found.Status())
+	assert.Equal(t, 2, found.Version())
+	assert.Equal(t, card.CardNumber().LastFour(), found.CardNumber().LastFour())
+
+	// A stale write is rejected by the version check.
+	require.Error(t, repo.Update(ctx, active))
+
+	var pending int
+	require.NoError(t, env.Pool().QueryRow(ctx,
+		`SELECT count(*) FROM outbox WHERE aggregate_id = $1 AND published_at IS NULL`, card.ID()).Scan(&pending))
+	assert.Equal(t, 2, pending, "CardIssued and CardActivated are queued for relay")
+}
+
+func TestCardRepository_FindByTenantIDIsScopedToTenant(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewCardRepository(env.Pool())
+	ctx := context.Background()
+	tenantID, accountID := uuid.New(), uuid.New()
+
+	require.NoError(t, repo.Save(ctx, newCard(t, tenantID, accountID)))
+	require.NoError(t, repo.Save(ctx, newCard(t, tenantID, uuid.New())))
+	require.NoError(t, repo.Save(ctx, newCard(t, uuid.New(), uuid.New())))
+
+	cards, err := repo.FindByTenantID(ctx, tenantID)
+	require.NoError(t, err)
+	assert.Len(t, cards, 2, "other tenants' cards are excluded")
+
+	cards, err = repo.FindByAccountID(ctx, accountID)
+	require.NoError(t, err)
+	require.Len(t, cards, 1)
+	assert.Equal(t, accountID, cards[0].AccountID())
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/bibbank/bib/pkg/enrichment"
 	"github.com/bibbank/bib/services/card-service/internal/application/dto"
 	"github.com/bibbank/bib/services/card-service/internal/application/usecase"
 	"github.com/bibbank/bib/services/card-service/internal/domain/event"
@@ -28,13 +29,13 @@ type mockCardRepository struct {
 }
 
 type mockTransaction struct {
-	Amount           decimal.Decimal
-	Currency         string
-	MerchantName     string
-	MerchantCategory string
-	AuthCode         string
-	Status           string
-	CardID           uuid.UUID
+	At       time.Time
+	Amount   decimal.Decimal
+	Currency string
+	Merchant enrichment.Merchant
+	AuthCode string
+	Status   string
+	CardID   uuid.UUID
 }
 
 func newMockCardRepository() *mockCardRepository {
@@ -84,19 +85,30 @@ func (r *mockCardRepository) FindByTenantID(_ context.Context, tenantID uuid.UUI
 	return result, nil
 }
 
-func (r *mockCardRepository) SaveTransaction(_ context.Context, cardID uuid.UUID, amount decimal.Decimal, currency, merchantName, merchantCategory, authCode, status string) error {
+func (r *mockCardRepository) SaveTransaction(_ context.Context, _, cardID uuid.UUID, amount decimal.Decimal, currency string, merchant enrichment.Merchant, authCode, status string) error {
 	r.transactions = append(r.transactions, mockTransaction{
-		CardID:           cardID,
-		Amount:           amount,
-		Currency:         currency,
-		MerchantName:     merchantName,
-		MerchantCategory: merchantCategory,
-		AuthCode:         authCode,
-		Status:           status,
+		At:       time.Now().UTC(),
+		CardID:   cardID,
+		Amount:   amount,
+		Currency: currency,
+		Merchant: merchant,
+		AuthCode: authCode,
+		Status:   status,
 	})
 	return nil
 }
 
+func (r *mockCardRepository) AuthorizedSince(_ context.Context, cardID uuid.UUID, since time.Time) (int, decimal.Decimal, error) {
+	count, amount := 0, decimal.Zero
+	for _, txn := range r.transactions {
+		if txn.CardID == cardID && txn.Status == "AUTHORIZED" && !txn.At.Before(since) {
+			count++
+			amount = amount.Add(txn.Amount)
+		}
+	}
+	return count, amount, nil
+}
+
 // mockEventPublisher captures published events for assertion.
 type mockEventPublisher struct {
 	publishedEvents []event.DomainEvent
@@ -134,7 +146,7 @@ func TestAuthorizeTransactionUseCase_Success(t *testing.T) {
 	balanceClient := newMockBalanceClient(decimal.NewFromInt(10000))
 	jitFunding := service.NewJITFundingService()
 
-	uc := usecase.NewAuthorizeTransactionUseCase(repo, publisher, balanceClient, jitFunding)
+	uc := usecase.NewAuthorizeTransactionUseCase(repo, publisher, balanceClient, jitFunding, enrichment.Default)
 
 	// Create and activate a card in the repo.
 	card := createAndStoreActiveCard(t, repo)
@@ -162,6 +174,42 @@ func TestAuthorizeTransactionUseCase_Success(t *testing.T) {
 	assert.NotEmpty(t, publisher.publishedEvents)
 }
 
+func TestAuthorizeTransactionUseCase_EnrichesMerchant(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockCardRepository()
+	publisher := newMockEventPublisher()
+	uc := usecase.NewAuthorizeTransactionUseCase(repo, publisher,
+		newMockBalanceClient(decimal.NewFromInt(10000)), service.NewJITFundingService(), enrichment.Default)
+	card := createAndStoreActiveCard(t, repo)
+
+	resp, err := uc.Execute(ctx, dto.AuthorizeTransactionRequest{
+		CardID:           card.ID(),
+		Amount:           decimal.NewFromInt(12),
+		Currency:         "USD",
+		MerchantName:     "SQ *BLUE BOTTLE COFFEE #0412",
+		MerchantCategory: "5814",
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Approved)
+	assert.Equal(t, dto.MerchantResponse{Name: "Blue Bottle Coffee", Category: "DINING"}, resp.Merchant)
+
+	require.Len(t, repo.transactions, 1)
+	assert.Equal(t, "SQ *BLUE BOTTLE COFFEE #0412", repo.transactions[0].Merchant.RawName)
+	assert.Equal(t, "Blue Bottle Coffee", repo.transactions[0].Merchant.Name)
+
+	var authorized *event.TransactionAuthorized
+	for _, evt := range publisher.publishedEvents {
+		if a, ok := evt.(event.TransactionAuthorized); ok {
+			authorized = &a
+		}
+	}
+	require.NotNil(t, authorized)
+	assert.Equal(t, "SQ *BLUE BOTTLE COFFEE #0412", authorized.MerchantName, "the descriptor is kept as received")
+	assert.Equal(t, "5814", authorized.MerchantCategory)
+	assert.Equal(t, "Blue Bottle Coffee", authorized.MerchantDisplayName)
+	assert.Equal(t, "DINING", authorized.Category)
+}
+
 func TestAuthorizeTransactionUseCase_InsufficientFunds(t *testing.T) {
 	ctx := context.Background()
 	repo := newMockCardRepository()
@@ -169,7 +217,7 @@ func TestAuthorizeTransactionUseCase_InsufficientFunds(t *testing.T) {
 	balanceClient := newMockBalanceClient(decimal.NewFromInt(10)) // Only 10 available.
 	jitFunding := service.NewJITFundingService()
 
-	uc := usecase.NewAuthorizeTransactionUseCase(repo, publisher, balanceClient, jitFunding)
+	uc := usecase.NewAuthorizeTransactionUseCase(repo, publisher, balanceClient, jitFunding, enrichment.Default)
 
 	card := createAndStoreActiveCard(t, repo)
 
@@ -199,7 +247,7 @@ func TestAuthorizeTransactionUseCase_CardNotFound(t *testing.T) {
 	balanceClient := newMockBalanceClient(decimal.NewFromInt(10000))
 	jitFunding := service.NewJITFundingService()
 
-	uc := usecase.NewAuthorizeTransactionUseCase(repo, publisher, balanceClient, jitFunding)
+	uc := usecase.NewAuthorizeTransactionUseCase(repo, publisher, balanceClient, jitFunding, enrichment.Default)
 
 	req := dto.AuthorizeTransactionRequest{
 		CardID:           uuid.New(), // Non-existent card.
@@ -221,7 +269,7 @@ func TestAuthorizeTransactionUseCase_ExceedsDailyLimit(t *testing.T) {
 	balanceClient := newMockBalanceClient(decimal.NewFromInt(100000))
 	jitFunding := service.NewJITFundingService()
 
-	uc := usecase.NewAuthorizeTransactionUseCase(repo, publisher, balanceClient, jitFunding)
+	uc := usecase.NewAuthorizeTransactionUseCase(repo, publisher, balanceClient, jitFunding, enrichment.Default)
 
 	card := createAndStoreActiveCard(t, repo)
 
@@ -260,7 +308,7 @@ func TestAuthorizeTransactionUseCase_FrozenCard(t *testing.T) {
 	balanceClient := newMockBalanceClient(decimal.NewFromInt(10000))
 	jitFunding := service.NewJITFundingService()
 
-	uc := usecase.NewAuthorizeTransactionUseCase(repo, publisher, balanceClient, jitFunding)
+	uc := usecase.NewAuthorizeTransactionUseCase(repo, publisher, balanceClient, jitFunding, enrichment.Default)
 
 	// Create, activate, then freeze.
 	card := createAndStoreActiveCard(t, repo)
@@ -283,6 +331,67 @@ func TestAuthorizeTransactionUseCase_FrozenCard(t *testing.T) {
 }
 
 // createAndStoreActiveCard creates an active card and stores it in the mock repo.
+func TestAuthorizeTransactionUseCase_SpendingControls(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockCardRepository()
+	uc := usecase.NewAuthorizeTransactionUseCase(repo, newMockEventPublisher(),
+		newMockBalanceClient(decimal.NewFromInt(10000)), service.NewJITFundingService(), enrichment.Default)
+	card := createAndStoreActiveCard(t, repo)
+
+	_, err := usecase.NewUpdateSpendingControlsUseCase(repo).Execute(ctx, dto.UpdateSpendingControlsRequest{
+		CardID: card.ID(),
+		Controls: dto.SpendingControls{
+			BlockedMCCs:       []string{"7995"},
+			MaxPerTransaction: decimal.NewFromInt(200),
+			BlockedCountries:  []string{"KP"},
+			VelocityLimits: []dto.VelocityLimit{
+				{Window: "HOUR", MaxCount: 2},
+				{Window: "DAY", MaxAmount: decimal.NewFromInt(250)},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	authorize := func(amount int64, mcc, country string) dto.AuthorizeTransactionResponse {
+		t.Helper()
+		resp, err := uc.Execute(ctx, dto.AuthorizeTransactionRequest{
+			CardID:           card.ID(),
+			Amount:           decimal.NewFromInt(amount),
+			Currency:         "USD",
+			MerchantName:     "Test Merchant",
+			MerchantCategory: mcc,
+			MerchantCountry:  country,
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := authorize(10, "7995", "US")
+	assert.False(t, resp.Approved)
+	assert.Contains(t, resp.Reason, "merchant category 7995 is blocked")
+
+	resp = authorize(201, "5411", "US")
+	assert.False(t, resp.Approved)
+	assert.Contains(t, resp.Reason, "per-transaction limit exceeded")
+
+	resp = authorize(10, "5411", "KP")
+	assert.False(t, resp.Approved)
+	assert.Contains(t, resp.Reason, "merchant country KP is blocked")
+
+	assert.True(t, authorize(150, "5411", "US").Approved)
+
+	resp = authorize(150, "5411", "US")
+	assert.False(t, resp.Approved)
+	assert.Contains(t, resp.Reason, "daily velocity limit exceeded")
+
+	assert.True(t, authorize(50, "5411", "US").Approved)
+
+	resp = authorize(10, "5411", "US")
+	assert.False(t, resp.Approved)
+	assert.Contains(t, resp.Reason, "hourly transaction count limit exceeded")
+	assert.Len(t, repo.transactions, 2)
+}
+
 func createAndStoreActiveCard(t *testing.T, repo *mockCardRepository) model.Card {
 	t.Helper()
 
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/bibbank/bib/pkg/enrichment"
 	"github.com/bibbank/bib/services/card-service/internal/domain/event"
 	"github.com/bibbank/bib/services/card-service/internal/domain/model"
 	"github.com/bibbank/bib/services/card-service/internal/domain/valueobject"
@@ -60,7 +61,7 @@ func TestCardFullLifecycle(t *testing.T) {
 
 	// --- Step 3: Authorize transaction within limits ---
 	amount := decimal.NewFromInt(500)
-	card, authCode, err := card.AuthorizeTransaction(amount, "Coffee Shop", "5814", now)
+	card, authCode, err := card.AuthorizeTransaction(uuid.New(), amount, enrichment.Default.Merchant("Coffee Shop", "5814"), "", nil, now)
 	require.NoError(t, err)
 
 	assert.NotEmpty(t, authCode)
@@ -81,7 +82,7 @@ func TestCardFullLifecycle(t *testing.T) {
 
 	// --- Step 4: Authorize transaction that exceeds daily limit ---
 	overLimitAmount := decimal.NewFromInt(600) // 500 + 600 = 1100 > 1000 daily limit
-	card, _, err = card.AuthorizeTransaction(overLimitAmount, "Electronics Store", "5732", now)
+	card, _, err = card.AuthorizeTransaction(uuid.New(), overLimitAmount, enrichment.Default.Merchant("Electronics Store", "5732"), "", nil, now)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "daily spending limit exceeded")
 
@@ -110,7 +111,7 @@ func TestCardFullLifecycle(t *testing.T) {
 	card = card.ClearEvents()
 
 	// --- Step 6: Authorize transaction on frozen card -> error ---
-	card, _, err = card.AuthorizeTransaction(decimal.NewFromInt(10), "Grocery", "5411", now)
+	card, _, err = card.AuthorizeTransaction(uuid.New(), decimal.NewFromInt(10), enrichment.Default.Merchant("Grocery", "5411"), "", nil, now)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "card is not usable")
 
@@ -243,7 +244,7 @@ func TestCard_ResetSpending(t *testing.T) {
 	card := createActiveCard(t)
 
 	// Authorize a transaction.
-	card, _, err := card.AuthorizeTransaction(decimal.NewFromInt(100), "Test", "0000", now)
+	card, _, err := card.AuthorizeTransaction(uuid.New(), decimal.NewFromInt(100), enrichment.Default.Merchant("Test", "0000"), "", nil, now)
 	require.NoError(t, err)
 	assert.True(t, card.DailySpent().Equal(decimal.NewFromInt(100)))
 	assert.True(t, card.MonthlySpent().Equal(decimal.NewFromInt(100)))
@@ -0,0 +1,90 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/enrichment"
+	"github.com/bibbank/bib/services/card-service/internal/domain/valueobject"
+)
+
+func TestSpendingControl_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		allowed []string
+		country []string
+		max     decimal.Decimal
+		limits  []valueobject.VelocityLimit
+	}{
+		{name: "short MCC", allowed: []string{"541"}},
+		{name: "lowercase country", country: []string{"us"}},
+		{name: "negative per-transaction limit", max: decimal.NewFromInt(-1)},
+		{name: "unknown window", limits: []valueobject.VelocityLimit{{Window: "WEEK", MaxCount: 1}}},
+		{name: "empty velocity limit", limits: []valueobject.VelocityLimit{{Window: valueobject.VelocityWindowDay}}},
+		{name: "duplicate window", limits: []valueobject.VelocityLimit{
+			{Window: valueobject.VelocityWindowHour, MaxCount: 1},
+			{Window: valueobject.VelocityWindowHour, MaxCount: 2},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := valueobject.NewSpendingControl(tt.allowed, nil, tt.max, tt.country, nil, tt.limits)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSpendingControl_Check(t *testing.T) {
+	sc, err := valueobject.NewSpendingControl(
+		[]string{"5411", "5814"}, []string{"5814"},
+		decimal.NewFromInt(100),
+		[]string{"US", "CA"}, nil,
+		[]valueobject.VelocityLimit{{Window: valueobject.VelocityWindowHour, MaxCount: 3, MaxAmount: decimal.NewFromInt(150)}},
+	)
+	require.NoError(t, err)
+
+	hour := func(count, amount int64) []valueobject.VelocityUsage {
+		return []valueobject.VelocityUsage{{Window: valueobject.VelocityWindowHour, Count: int(count), Amount: decimal.NewFromInt(amount)}}
+	}
+
+	assert.NoError(t, sc.Check(decimal.NewFromInt(50), "5411", "US", nil))
+	assert.ErrorContains(t, sc.Check(decimal.NewFromInt(50), "5814", "US", nil), "blocked", "deny lists win over allow lists")
+	assert.ErrorContains(t, sc.Check(decimal.NewFromInt(50), "5732", "US", nil), "not allowed")
+	assert.ErrorContains(t, sc.Check(decimal.NewFromInt(50), "5411", "", nil), "not allowed", "unknown countries are outside allow lists")
+	assert.ErrorContains(t, sc.Check(decimal.NewFromInt(101), "5411", "CA", nil), "per-transaction limit exceeded")
+	assert.NoError(t, sc.Check(decimal.NewFromInt(50), "5411", "US", hour(2, 100)))
+	assert.ErrorContains(t, sc.Check(decimal.NewFromInt(10), "5411", "US", hour(3, 30)), "hourly transaction count limit exceeded")
+	assert.ErrorContains(t, sc.Check(decimal.NewFromInt(60), "5411", "US", hour(1, 100)), "hourly velocity limit exceeded")
+
+	var none valueobject.SpendingControl
+	assert.True(t, none.IsZero())
+	assert.NoError(t, none.Check(decimal.NewFromInt(1_000_000), "", "", nil))
+}
+
+func TestCard_AuthorizeTransaction_SpendingControls(t *testing.T) {
+	now := time.Now().UTC()
+	card := createActiveCard(t)
+
+	sc, err := valueobject.NewSpendingControl(nil, []string{"7995"}, decimal.Zero, nil, nil, nil)
+	require.NoError(t, err)
+	card, err = card.UpdateSpendingControls(sc, now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"7995"}, card.SpendingControls().BlockedMCCs())
+
+	declined, _, err := card.AuthorizeTransaction(uuid.New(), decimal.NewFromInt(10), enrichment.Default.Merchant("Casino", "7995"), "US", nil, now)
+	assert.ErrorContains(t, err, "merchant category 7995 is blocked")
+	assert.True(t, declined.DailySpent().IsZero())
+	require.NotEmpty(t, declined.DomainEvents())
+	assert.Equal(t, "card.transaction.declined", declined.DomainEvents()[len(declined.DomainEvents())-1].EventType())
+
+	canceled, err := card.Cancel(now)
+	require.NoError(t, err)
+	_, err = canceled.UpdateSpendingControls(valueobject.SpendingControl{}, now)
+	assert.Error(t, err)
+}
@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PeriodRequest names a tenant's accounting period, as YYYY-MM.
+type PeriodRequest struct {
+	Period   string
+	TenantID uuid.UUID
+}
+
+// CompleteItemRequest is the input for completing a checklist item.
+type CompleteItemRequest struct {
+	Period   string
+	ItemKey  string
+	Note     string
+	TenantID uuid.UUID
+	UserID   uuid.UUID
+}
+
+// ReopenItemRequest is the input for reopening a completed checklist item.
+type ReopenItemRequest struct {
+	Period   string
+	ItemKey  string
+	Reason   string
+	TenantID uuid.UUID
+	UserID   uuid.UUID
+}
+
+// SignOffRequest is the input for signing off a period's checklist as its
+// preparer or reviewer.
+type SignOffRequest struct {
+	Period   string
+	Role     string
+	TenantID uuid.UUID
+	UserID   uuid.UUID
+}
+
+// ClosePeriodRequest is the input for closing a signed-off period.
+type ClosePeriodRequest struct {
+	Period   string
+	TenantID uuid.UUID
+	UserID   uuid.UUID
+}
+
+// ChecklistItemResponse is a checklist item.
+type ChecklistItemResponse struct {
+	CompletedAt *time.Time
+	CompletedBy *uuid.UUID
+	Key         string
+	Title       string
+	Note        string
+}
+
+// SignOffResponse is a sign-off of a period's checklist.
+type SignOffResponse struct {
+	SignedAt time.Time
+	Role     string
+	UserID   uuid.UUID
+}
+
+// PeriodCloseResponse is the close of a period. Version is 0 for a close
+// not yet started, whose checklist is all open.
+type PeriodCloseResponse struct {
+	ClosedAt *time.Time
+	ClosedBy *uuid.UUID
+	Period   string
+	Status   string
+	Items    []ChecklistItemResponse
+	SignOffs []SignOffResponse
+	Version  int
+}
+
+// CheckResponse is the outcome of one of the checks a period must pass
+// before it is closed. Count is how many things the check found
+// outstanding.
+type CheckResponse struct {
+	Name   string
+	Status string
+	Detail string
+	Count  int
+}
+
+// Names of the close checks.
+const (
+	CheckUnpostedInterest     = "unposted_interest"
+	CheckReconciliationBreaks = "reconciliation_breaks"
+	CheckFailedAccruals       = "failed_accruals"
+)
+
+// DashboardResponse is the close status of a period across the
+// subsystems. LedgerStatus is OPEN or CLOSED, or empty when the ledger
+// could not be asked.
+type DashboardResponse struct {
+	LedgerStatus string
+	Checks       []CheckResponse
+	Close        PeriodCloseResponse
+	ReadyToClose bool
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/close-service/internal/domain/model"
+	"github.com/bibbank/bib/services/close-service/internal/domain/port"
+)
+
+// BackofficeEventsTopic is the topic reconciliation breaks are kept from.
+const BackofficeEventsTopic = "backoffice-events"
+
+// reconciliationBreakQueue is the backoffice queue reconciliation breaks
+// are worked in.
+const reconciliationBreakQueue = "RECONCILIATION_BREAK"
+
+// taskEvent is the part of a backoffice task event the close service
+// reads.
+type taskEvent struct {
+	OccurredAt  time.Time `json:"occurred_at"`
+	TenantID    string    `json:"tenant_id"`
+	AggregateID string    `json:"aggregate_id"`
+	Queue       string    `json:"queue"`
+	SourceID    string    `json:"source_id"`
+	Summary     string    `json:"summary"`
+}
+
+// RecordBreakEventUseCase keeps the unresolved reconciliation breaks from
+// the backoffice's task events: a break is recorded when its task opens
+// and removed when it is resolved.
+type RecordBreakEventUseCase struct {
+	breaks port.BreakRepository
+}
+
+// NewRecordBreakEventUseCase creates a new RecordBreakEventUseCase.
+func NewRecordBreakEventUseCase(breaks port.BreakRepository) *RecordBreakEventUseCase {
+	return &RecordBreakEventUseCase{breaks: breaks}
+}
+
+// Execute applies a backoffice event. Events of other types and of tasks
+// of other queues are ignored, and redelivered events change nothing.
+func (uc *RecordBreakEventUseCase) Execute(ctx context.Context, eventType string, payload []byte) error {
+	switch eventType {
+	case "backoffice.task.opened", "backoffice.task.resolved":
+	default:
+		return nil
+	}
+
+	data, err := events.EventData(payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	var evt taskEvent
+	if err := json.Unmarshal(data, &evt); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	if evt.Queue != reconciliationBreakQueue {
+		return nil
+	}
+	tenantID, err := uuid.Parse(evt.TenantID)
+	if err != nil {
+		return fmt.Errorf("%s event has invalid tenant ID %q: %w", eventType, evt.TenantID, err)
+	}
+	taskID, err := uuid.Parse(evt.AggregateID)
+	if err != nil {
+		return fmt.Errorf("%s event has invalid task ID %q: %w", eventType, evt.AggregateID, err)
+	}
+
+	if eventType == "backoffice.task.resolved" {
+		if err := uc.breaks.Resolve(ctx, tenantID, taskID); err != nil {
+			return fmt.Errorf("failed to resolve reconciliation break: %w", err)
+		}
+		return nil
+	}
+	openedAt := evt.OccurredAt.UTC()
+	if openedAt.IsZero() {
+		openedAt = time.Now().UTC()
+	}
+	err = uc.breaks.Open(ctx, model.ReconciliationBreak{
+		TaskID:   taskID,
+		TenantID: tenantID,
+		SourceID: evt.SourceID,
+		Summary:  evt.Summary,
+		OpenedAt: openedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record reconciliation break: %w", err)
+	}
+	return nil
+}
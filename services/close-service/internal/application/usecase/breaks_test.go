@@ -0,0 +1,92 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/close-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/close-service/internal/domain/model"
+)
+
+// inMemoryBreakRepo is an in-memory BreakRepository.
+type inMemoryBreakRepo struct {
+	breaks   []model.ReconciliationBreak
+	resolved map[uuid.UUID]bool
+	err      error
+}
+
+func (r *inMemoryBreakRepo) Open(_ context.Context, b model.ReconciliationBreak) error {
+	if r.resolved[b.TaskID] || slices.ContainsFunc(r.breaks, func(o model.ReconciliationBreak) bool { return o.TaskID == b.TaskID }) {
+		return nil
+	}
+	r.breaks = append(r.breaks, b)
+	return nil
+}
+
+func (r *inMemoryBreakRepo) Resolve(_ context.Context, _, taskID uuid.UUID) error {
+	if r.resolved == nil {
+		r.resolved = map[uuid.UUID]bool{}
+	}
+	r.resolved[taskID] = true
+	return nil
+}
+
+func (r *inMemoryBreakRepo) ListOpen(_ context.Context, tenantID uuid.UUID) ([]model.ReconciliationBreak, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	var out []model.ReconciliationBreak
+	for _, b := range r.breaks {
+		if b.TenantID == tenantID && !r.resolved[b.TaskID] {
+			out = append(out, b)
+		}
+	}
+	return out, nil
+}
+
+func taskEvent(tenantID, taskID uuid.UUID, queue, summary string) []byte {
+	payload, _ := json.Marshal(map[string]any{
+		"event_id":       uuid.NewString(),
+		"aggregate_id":   taskID.String(),
+		"aggregate_type": "Task",
+		"tenant_id":      tenantID.String(),
+		"occurred_at":    "2026-03-31T17:00:00Z",
+		"queue":          queue,
+		"source_id":      "stmt-2026-03-31/USD",
+		"summary":        summary,
+	})
+	return payload
+}
+
+func TestRecordBreakEvent(t *testing.T) {
+	ctx := context.Background()
+	breaks := &inMemoryBreakRepo{}
+	tenantID := uuid.New()
+	record := usecase.NewRecordBreakEventUseCase(breaks)
+	breakID := uuid.New()
+
+	require.NoError(t, record.Execute(ctx, "backoffice.task.opened", taskEvent(tenantID, uuid.New(), "PAYMENT_REPAIR", "Repair payment")))
+	require.NoError(t, record.Execute(ctx, "backoffice.task.claimed", taskEvent(tenantID, breakID, "RECONCILIATION_BREAK", "")))
+	require.NoError(t, record.Execute(ctx, "backoffice.task.opened", taskEvent(tenantID, breakID, "RECONCILIATION_BREAK", "Nostro EUR difference")))
+	require.NoError(t, record.Execute(ctx, "backoffice.task.opened", taskEvent(tenantID, breakID, "RECONCILIATION_BREAK", "Nostro EUR difference")))
+
+	open, err := breaks.ListOpen(ctx, tenantID)
+	require.NoError(t, err)
+	require.Len(t, open, 1, "only breaks are kept, once")
+	assert.Equal(t, breakID, open[0].TaskID)
+	assert.Equal(t, "stmt-2026-03-31/USD", open[0].SourceID)
+
+	require.NoError(t, record.Execute(ctx, "backoffice.task.resolved", taskEvent(tenantID, breakID, "RECONCILIATION_BREAK", "")))
+	open, err = breaks.ListOpen(ctx, tenantID)
+	require.NoError(t, err)
+	assert.Empty(t, open)
+
+	err = record.Execute(ctx, "backoffice.task.opened", []byte(`{"tenant_id":"not-a-uuid","queue":"RECONCILIATION_BREAK"}`))
+	assert.Error(t, err)
+}
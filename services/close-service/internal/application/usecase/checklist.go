@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/close-service/internal/application/dto"
+	"github.com/bibbank/bib/services/close-service/internal/domain/model"
+	"github.com/bibbank/bib/services/close-service/internal/domain/port"
+	"github.com/bibbank/bib/services/close-service/internal/domain/valueobject"
+)
+
+// ErrInvalidRequest is returned when a close request is malformed.
+var ErrInvalidRequest = errors.New("invalid request")
+
+// CompleteItemUseCase completes an item of a period's close checklist.
+type CompleteItemUseCase struct {
+	closes    port.PeriodCloseRepository
+	publisher port.EventPublisher
+}
+
+// NewCompleteItemUseCase creates a new CompleteItemUseCase.
+func NewCompleteItemUseCase(closes port.PeriodCloseRepository, publisher port.EventPublisher) *CompleteItemUseCase {
+	return &CompleteItemUseCase{closes: closes, publisher: publisher}
+}
+
+// Execute completes the item, starting the period's close if it is the
+// first item done.
+func (uc *CompleteItemUseCase) Execute(ctx context.Context, req dto.CompleteItemRequest) (dto.PeriodCloseResponse, error) {
+	period, err := valueobject.ParseAccountingPeriod(req.Period)
+	if err != nil {
+		return dto.PeriodCloseResponse{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+	now := time.Now().UTC()
+	pc, err := findOrStart(ctx, uc.closes, req.TenantID, period, now)
+	if err != nil {
+		return dto.PeriodCloseResponse{}, err
+	}
+	pc, err = pc.CompleteItem(req.ItemKey, req.UserID, req.Note, now)
+	if err != nil {
+		return dto.PeriodCloseResponse{}, domainError(err)
+	}
+	return save(ctx, uc.closes, uc.publisher, pc)
+}
+
+// ReopenItemUseCase reopens a completed item of a period's close
+// checklist.
+type ReopenItemUseCase struct {
+	closes    port.PeriodCloseRepository
+	publisher port.EventPublisher
+}
+
+// NewReopenItemUseCase creates a new ReopenItemUseCase.
+func NewReopenItemUseCase(closes port.PeriodCloseRepository, publisher port.EventPublisher) *ReopenItemUseCase {
+	return &ReopenItemUseCase{closes: closes, publisher: publisher}
+}
+
+// Execute reopens the item, withdrawing the checklist's sign-offs.
+func (uc *ReopenItemUseCase) Execute(ctx context.Context, req dto.ReopenItemRequest) (dto.PeriodCloseResponse, error) {
+	period, err := valueobject.ParseAccountingPeriod(req.Period)
+	if err != nil {
+		return dto.PeriodCloseResponse{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+	now := time.Now().UTC()
+	pc, err := findOrStart(ctx, uc.closes, req.TenantID, period, now)
+	if err != nil {
+		return dto.PeriodCloseResponse{}, err
+	}
+	pc, err = pc.ReopenItem(req.ItemKey, req.UserID, req.Reason, now)
+	if err != nil {
+		return dto.PeriodCloseResponse{}, domainError(err)
+	}
+	return save(ctx, uc.closes, uc.publisher, pc)
+}
+
+// SignOffUseCase signs off a period's close checklist.
+type SignOffUseCase struct {
+	closes    port.PeriodCloseRepository
+	publisher port.EventPublisher
+}
+
+// NewSignOffUseCase creates a new SignOffUseCase.
+func NewSignOffUseCase(closes port.PeriodCloseRepository, publisher port.EventPublisher) *SignOffUseCase {
+	return &SignOffUseCase{closes: closes, publisher: publisher}
+}
+
+// Execute records the user's sign-off in the requested role.
+func (uc *SignOffUseCase) Execute(ctx context.Context, req dto.SignOffRequest) (dto.PeriodCloseResponse, error) {
+	period, err := valueobject.ParseAccountingPeriod(req.Period)
+	if err != nil {
+		return dto.PeriodCloseResponse{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+	role, err := valueobject.NewSignOffRole(req.Role)
+	if err != nil {
+		return dto.PeriodCloseResponse{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+	now := time.Now().UTC()
+	pc, err := findOrStart(ctx, uc.closes, req.TenantID, period, now)
+	if err != nil {
+		return dto.PeriodCloseResponse{}, err
+	}
+	pc, err = pc.SignOff(role, req.UserID, now)
+	if err != nil {
+		return dto.PeriodCloseResponse{}, domainError(err)
+	}
+	return save(ctx, uc.closes, uc.publisher, pc)
+}
+
+func save(ctx context.Context, closes port.PeriodCloseRepository, publisher port.EventPublisher, pc model.PeriodClose) (dto.PeriodCloseResponse, error) {
+	if err := closes.Save(ctx, pc); err != nil {
+		return dto.PeriodCloseResponse{}, fmt.Errorf("failed to save period close: %w", err)
+	}
+	if err := publisher.Publish(ctx, pc.DomainEvents()); err != nil {
+		return dto.PeriodCloseResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toPeriodCloseResponse(pc), nil
+}
+
+// domainError marks a close's refusal of a step as an invalid request,
+// unless it is a conflict with the close's state.
+func domainError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrInvalidTransition),
+		errors.Is(err, model.ErrChecklistIncomplete),
+		errors.Is(err, model.ErrSameSignatory),
+		errors.Is(err, model.ErrUnknownItem):
+		return err
+	default:
+		return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+}
+
+func toPeriodCloseResponse(pc model.PeriodClose) dto.PeriodCloseResponse {
+	resp := dto.PeriodCloseResponse{
+		Period:   pc.Period().String(),
+		Status:   pc.Status().String(),
+		ClosedBy: pc.ClosedBy(),
+		ClosedAt: pc.ClosedAt(),
+		Version:  pc.Version(),
+	}
+	for _, item := range pc.Items() {
+		resp.Items = append(resp.Items, dto.ChecklistItemResponse{
+			Key:         item.Key,
+			Title:       item.Title,
+			CompletedBy: item.CompletedBy,
+			CompletedAt: item.CompletedAt,
+			Note:        item.Note,
+		})
+	}
+	for _, s := range pc.SignOffs() {
+		resp.SignOffs = append(resp.SignOffs, dto.SignOffResponse{
+			Role:     s.Role.String(),
+			UserID:   s.UserID,
+			SignedAt: s.SignedAt,
+		})
+	}
+	return resp
+}
@@ -0,0 +1,46 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/close-service/internal/application/dto"
+	"github.com/bibbank/bib/services/close-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/close-service/internal/domain/model"
+)
+
+func TestReopenItem_WithdrawsSignOffs(t *testing.T) {
+	f := newCloseFixture()
+	f.signedOff(t)
+	ctx := context.Background()
+	reopen := usecase.NewReopenItemUseCase(f.closes, f.publisher)
+	req := dto.ReopenItemRequest{
+		TenantID: f.tenantID,
+		Period:   period,
+		ItemKey:  "accrual-review",
+		UserID:   f.reviewer,
+	}
+
+	_, err := reopen.Execute(ctx, req)
+	assert.ErrorIs(t, err, usecase.ErrInvalidRequest, "a reason is required")
+
+	req.Reason = "loan accruals not tied out to the sub-ledger"
+	resp, err := reopen.Execute(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "OPEN", resp.Status)
+	assert.Empty(t, resp.SignOffs)
+
+	_, err = f.closePeriod()
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+
+	_, err = usecase.NewCompleteItemUseCase(f.closes, f.publisher).Execute(ctx, dto.CompleteItemRequest{
+		TenantID: f.tenantID,
+		Period:   period,
+		ItemKey:  "no-such-item",
+		UserID:   f.preparer,
+	})
+	assert.ErrorIs(t, err, model.ErrUnknownItem)
+}
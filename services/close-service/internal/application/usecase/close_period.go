@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/services/close-service/internal/application/dto"
+	"github.com/bibbank/bib/services/close-service/internal/domain/port"
+	"github.com/bibbank/bib/services/close-service/internal/domain/valueobject"
+)
+
+// ErrCloseBlocked is returned when a signed-off period has checks holding
+// up its close.
+var ErrCloseBlocked = errors.New("period close is blocked")
+
+// ClosePeriodUseCase closes a signed-off period in the ledger.
+type ClosePeriodUseCase struct {
+	closes    port.PeriodCloseRepository
+	checks    *Checks
+	ledger    port.Ledger
+	publisher port.EventPublisher
+}
+
+// NewClosePeriodUseCase creates a new ClosePeriodUseCase.
+func NewClosePeriodUseCase(closes port.PeriodCloseRepository, checks *Checks, ledger port.Ledger, publisher port.EventPublisher) *ClosePeriodUseCase {
+	return &ClosePeriodUseCase{closes: closes, checks: checks, ledger: ledger, publisher: publisher}
+}
+
+// Execute closes the tenant's period once its checklist is signed off and
+// no check holds it up. The ledger posts the period's interest true-ups
+// and stops accepting entries dated in it. A period the ledger has
+// already closed, such as when a previous attempt failed after closing
+// it, is recorded as closed.
+func (uc *ClosePeriodUseCase) Execute(ctx context.Context, req dto.ClosePeriodRequest) (dto.PeriodCloseResponse, error) {
+	period, err := valueobject.ParseAccountingPeriod(req.Period)
+	if err != nil {
+		return dto.PeriodCloseResponse{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+	now := time.Now().UTC()
+	pc, err := findOrStart(ctx, uc.closes, req.TenantID, period, now)
+	if err != nil {
+		return dto.PeriodCloseResponse{}, err
+	}
+	pc, err = pc.Close(req.UserID, now)
+	if err != nil {
+		return dto.PeriodCloseResponse{}, domainError(err)
+	}
+
+	ledgerStatus, checks := uc.checks.run(ctx, req.TenantID, period)
+	if names := blockers(checks); len(names) > 0 {
+		return dto.PeriodCloseResponse{}, fmt.Errorf("%w by %s", ErrCloseBlocked, strings.Join(names, ", "))
+	}
+	if ledgerStatus != ledgerStatusClosed {
+		if err := uc.ledger.ClosePeriod(ctx, period); err != nil && !errors.Is(err, port.ErrLedgerPeriodClosed) {
+			return dto.PeriodCloseResponse{}, fmt.Errorf("failed to close period in the ledger: %w", err)
+		}
+	}
+	return save(ctx, uc.closes, uc.publisher, pc)
+}
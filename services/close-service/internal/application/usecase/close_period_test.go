@@ -0,0 +1,212 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/close-service/internal/application/dto"
+	"github.com/bibbank/bib/services/close-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/close-service/internal/domain/event"
+	"github.com/bibbank/bib/services/close-service/internal/domain/model"
+	"github.com/bibbank/bib/services/close-service/internal/domain/port"
+	"github.com/bibbank/bib/services/close-service/internal/domain/valueobject"
+)
+
+const period = "2026-03"
+
+// inMemoryCloseRepo is an in-memory PeriodCloseRepository.
+type inMemoryCloseRepo struct {
+	closes []model.PeriodClose
+}
+
+func (r *inMemoryCloseRepo) Save(_ context.Context, pc model.PeriodClose) error {
+	for i, c := range r.closes {
+		if c.TenantID() == pc.TenantID() && c.Period() == pc.Period() {
+			if c.ID() != pc.ID() || c.Version() != pc.Version()-1 {
+				return port.ErrVersionConflict
+			}
+			r.closes[i] = pc.ClearDomainEvents()
+			return nil
+		}
+	}
+	r.closes = append(r.closes, pc.ClearDomainEvents())
+	return nil
+}
+
+func (r *inMemoryCloseRepo) Find(_ context.Context, tenantID uuid.UUID, p valueobject.AccountingPeriod) (model.PeriodClose, error) {
+	for _, c := range r.closes {
+		if c.TenantID() == tenantID && c.Period() == p {
+			return c, nil
+		}
+	}
+	return model.PeriodClose{}, port.ErrPeriodCloseNotFound
+}
+
+// recordingPublisher is an EventPublisher that records what it publishes.
+type recordingPublisher struct {
+	events []event.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, events []event.DomainEvent) error {
+	p.events = append(p.events, events...)
+	return nil
+}
+
+func (p *recordingPublisher) eventTypes() []string {
+	types := make([]string, len(p.events))
+	for i, e := range p.events {
+		types[i] = e.EventType()
+	}
+	return types
+}
+
+// closeFixture wires the close use cases over in-memory adapters for one
+// tenant's period.
+type closeFixture struct {
+	closes    *inMemoryCloseRepo
+	breaks    *inMemoryBreakRepo
+	ledger    *fakeLedger
+	accruals  *fakeAccruals
+	publisher *recordingPublisher
+	tenantID  uuid.UUID
+	preparer  uuid.UUID
+	reviewer  uuid.UUID
+}
+
+func newCloseFixture() *closeFixture {
+	return &closeFixture{
+		closes:    &inMemoryCloseRepo{},
+		breaks:    &inMemoryBreakRepo{},
+		ledger:    &fakeLedger{},
+		accruals:  &fakeAccruals{},
+		publisher: &recordingPublisher{},
+		tenantID:  uuid.New(),
+		preparer:  uuid.New(),
+		reviewer:  uuid.New(),
+	}
+}
+
+func (f *closeFixture) checks() *usecase.Checks {
+	return usecase.NewChecks(f.ledger, f.breaks, f.accruals)
+}
+
+func (f *closeFixture) dashboard(t *testing.T) dto.DashboardResponse {
+	t.Helper()
+	resp, err := usecase.NewGetCloseDashboardUseCase(f.closes, f.checks()).Execute(context.Background(), dto.PeriodRequest{
+		TenantID: f.tenantID,
+		Period:   period,
+	})
+	require.NoError(t, err)
+	return resp
+}
+
+func (f *closeFixture) closePeriod() (dto.PeriodCloseResponse, error) {
+	return usecase.NewClosePeriodUseCase(f.closes, f.checks(), f.ledger, f.publisher).Execute(context.Background(), dto.ClosePeriodRequest{
+		TenantID: f.tenantID,
+		Period:   period,
+		UserID:   f.reviewer,
+	})
+}
+
+func (f *closeFixture) signOff(role string, userID uuid.UUID) (dto.PeriodCloseResponse, error) {
+	return usecase.NewSignOffUseCase(f.closes, f.publisher).Execute(context.Background(), dto.SignOffRequest{
+		TenantID: f.tenantID,
+		Period:   period,
+		Role:     role,
+		UserID:   userID,
+	})
+}
+
+// completeChecklist has the preparer complete every item of the period's
+// checklist.
+func (f *closeFixture) completeChecklist(t *testing.T) {
+	t.Helper()
+	for _, item := range model.DefaultChecklist {
+		_, err := usecase.NewCompleteItemUseCase(f.closes, f.publisher).Execute(context.Background(), dto.CompleteItemRequest{
+			TenantID: f.tenantID,
+			Period:   period,
+			ItemKey:  item.Key,
+			UserID:   f.preparer,
+		})
+		require.NoError(t, err)
+	}
+}
+
+// signedOff completes the checklist and has it signed off by the preparer
+// and the reviewer.
+func (f *closeFixture) signedOff(t *testing.T) {
+	t.Helper()
+	f.completeChecklist(t)
+	_, err := f.signOff("PREPARER", f.preparer)
+	require.NoError(t, err)
+	_, err = f.signOff("REVIEWER", f.reviewer)
+	require.NoError(t, err)
+}
+
+func TestClosePeriod_ChecklistSignOffsAndLedgerClose(t *testing.T) {
+	f := newCloseFixture()
+
+	resp := f.dashboard(t)
+	assert.Equal(t, "OPEN", resp.Close.Status)
+	assert.Equal(t, 0, resp.Close.Version, "a close not started shows its checklist")
+	assert.Len(t, resp.Close.Items, len(model.DefaultChecklist))
+	assert.Equal(t, "OPEN", resp.LedgerStatus)
+	assert.False(t, resp.ReadyToClose)
+
+	_, err := f.signOff("PREPARER", f.preparer)
+	assert.ErrorIs(t, err, model.ErrChecklistIncomplete)
+
+	f.completeChecklist(t)
+	_, err = f.signOff("REVIEWER", f.reviewer)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition, "the preparer signs first")
+	_, err = f.signOff("PREPARER", f.preparer)
+	require.NoError(t, err)
+	_, err = f.signOff("REVIEWER", f.preparer)
+	assert.ErrorIs(t, err, model.ErrSameSignatory)
+
+	_, err = f.closePeriod()
+	assert.ErrorIs(t, err, model.ErrInvalidTransition, "the reviewer has not signed off")
+
+	signed, err := f.signOff("REVIEWER", f.reviewer)
+	require.NoError(t, err)
+	assert.Equal(t, "SIGNED_OFF", signed.Status)
+	assert.True(t, f.dashboard(t).ReadyToClose)
+
+	closed, err := f.closePeriod()
+	require.NoError(t, err)
+	assert.Equal(t, "CLOSED", closed.Status)
+	assert.Equal(t, &f.reviewer, closed.ClosedBy)
+	assert.True(t, f.ledger.closed[mustPeriod(t)], "the period is closed in the ledger")
+
+	resp = f.dashboard(t)
+	assert.Equal(t, "CLOSED", resp.LedgerStatus)
+	assert.False(t, resp.ReadyToClose)
+
+	_, err = f.closePeriod()
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+	assert.Equal(t, []string{
+		"close.item.completed", "close.item.completed", "close.item.completed", "close.item.completed", "close.item.completed",
+		"close.signed_off", "close.signed_off", "close.period.closed",
+	}, f.publisher.eventTypes())
+}
+
+func TestClosePeriod_RecordsPeriodTheLedgerClosedAlready(t *testing.T) {
+	f := newCloseFixture()
+	f.signedOff(t)
+	require.NoError(t, f.ledger.ClosePeriod(context.Background(), mustPeriod(t)))
+
+	resp, err := f.closePeriod()
+	require.NoError(t, err)
+	assert.Equal(t, "CLOSED", resp.Status)
+}
+
+func mustPeriod(t *testing.T) valueobject.AccountingPeriod {
+	t.Helper()
+	p, err := valueobject.ParseAccountingPeriod(period)
+	require.NoError(t, err)
+	return p
+}
@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/close-service/internal/application/dto"
+	"github.com/bibbank/bib/services/close-service/internal/domain/model"
+	"github.com/bibbank/bib/services/close-service/internal/domain/port"
+	"github.com/bibbank/bib/services/close-service/internal/domain/valueobject"
+)
+
+// Ledger states of a period.
+const (
+	ledgerStatusOpen   = "OPEN"
+	ledgerStatusClosed = "CLOSED"
+)
+
+// maxDetailLines bounds how many outstanding things a check lists in its
+// detail.
+const maxDetailLines = 5
+
+// Checks asks the subsystems whether a period is ready to close.
+type Checks struct {
+	ledger   port.Ledger
+	breaks   port.BreakRepository
+	accruals port.AccrualRuns
+}
+
+// NewChecks creates a new Checks.
+func NewChecks(ledger port.Ledger, breaks port.BreakRepository, accruals port.AccrualRuns) *Checks {
+	return &Checks{ledger: ledger, breaks: breaks, accruals: accruals}
+}
+
+// run makes each check of the tenant's period, returning the ledger's
+// state of the period, or "" when the ledger could not be asked. A
+// subsystem that fails makes its check UNAVAILABLE rather than failing
+// the others.
+func (c *Checks) run(ctx context.Context, tenantID uuid.UUID, period valueobject.AccountingPeriod) (string, []dto.CheckResponse) {
+	ledgerStatus := ""
+	checks := make([]dto.CheckResponse, 0, 3)
+
+	lp, err := c.ledger.PeriodStatus(ctx, period)
+	if err != nil {
+		checks = append(checks, unavailable(dto.CheckUnpostedInterest, err))
+	} else {
+		ledgerStatus = ledgerStatusOpen
+		if lp.Closed {
+			ledgerStatus = ledgerStatusClosed
+		}
+		lines := make([]string, len(lp.UnpostedInterest))
+		for i, u := range lp.UnpostedInterest {
+			lines[i] = fmt.Sprintf("%s %s %s", u.Kind, u.Currency, u.Amount)
+		}
+		// Unposted interest holds nothing up: the ledger posts it as a
+		// true-up when it closes the period.
+		checks = append(checks, outstanding(dto.CheckUnpostedInterest, valueobject.CheckWarning,
+			"posted as a true-up when the period closes", lines))
+	}
+
+	breaks, err := c.breaks.ListOpen(ctx, tenantID)
+	if err != nil {
+		checks = append(checks, unavailable(dto.CheckReconciliationBreaks, err))
+	} else {
+		lines := make([]string, len(breaks))
+		for i, b := range breaks {
+			lines[i] = b.Summary
+		}
+		checks = append(checks, outstanding(dto.CheckReconciliationBreaks, valueobject.CheckBlocking,
+			"unresolved in the backoffice", lines))
+	}
+
+	failed, err := c.accruals.FailedAccruals(ctx, period)
+	if err != nil {
+		checks = append(checks, unavailable(dto.CheckFailedAccruals, err))
+	} else {
+		lines := make([]string, len(failed))
+		for i, f := range failed {
+			lines[i] = fmt.Sprintf("%s %s: %s", f.BusinessDate.Format(time.DateOnly), f.Step, f.Error)
+		}
+		checks = append(checks, outstanding(dto.CheckFailedAccruals, valueobject.CheckBlocking,
+			"resume the end-of-day runs to accrue", lines))
+	}
+
+	return ledgerStatus, checks
+}
+
+// outstanding makes a check that is CLEAR when nothing is outstanding and
+// of status otherwise, listing the first few outstanding things.
+func outstanding(name string, status valueobject.CheckStatus, action string, lines []string) dto.CheckResponse {
+	if len(lines) == 0 {
+		return dto.CheckResponse{Name: name, Status: valueobject.CheckClear.String()}
+	}
+	detail := strings.Join(lines[:min(len(lines), maxDetailLines)], "; ")
+	if more := len(lines) - maxDetailLines; more > 0 {
+		detail += fmt.Sprintf("; and %d more", more)
+	}
+	return dto.CheckResponse{
+		Name:   name,
+		Status: status.String(),
+		Count:  len(lines),
+		Detail: action + ": " + detail,
+	}
+}
+
+func unavailable(name string, err error) dto.CheckResponse {
+	return dto.CheckResponse{Name: name, Status: valueobject.CheckUnavailable.String(), Detail: err.Error()}
+}
+
+// blockers returns the names of the checks that hold up the close.
+func blockers(checks []dto.CheckResponse) []string {
+	var names []string
+	for _, c := range checks {
+		if c.Status == valueobject.CheckBlocking.String() || c.Status == valueobject.CheckUnavailable.String() {
+			names = append(names, c.Name)
+		}
+	}
+	return names
+}
+
+// GetCloseDashboardUseCase reports a period's close status across the
+// subsystems, with its checklist and sign-offs.
+type GetCloseDashboardUseCase struct {
+	closes port.PeriodCloseRepository
+	checks *Checks
+}
+
+// NewGetCloseDashboardUseCase creates a new GetCloseDashboardUseCase.
+func NewGetCloseDashboardUseCase(closes port.PeriodCloseRepository, checks *Checks) *GetCloseDashboardUseCase {
+	return &GetCloseDashboardUseCase{closes: closes, checks: checks}
+}
+
+// Execute reports the close status of the tenant's period. A period whose
+// close has not been started shows the checklist it starts with.
+func (uc *GetCloseDashboardUseCase) Execute(ctx context.Context, req dto.PeriodRequest) (dto.DashboardResponse, error) {
+	period, err := valueobject.ParseAccountingPeriod(req.Period)
+	if err != nil {
+		return dto.DashboardResponse{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+	pc, err := findOrStart(ctx, uc.closes, req.TenantID, period, time.Now().UTC())
+	if err != nil {
+		return dto.DashboardResponse{}, err
+	}
+
+	resp := dto.DashboardResponse{Close: toPeriodCloseResponse(pc)}
+	if pc.Status() == valueobject.CloseStatusClosed {
+		// A closed period passed its checks when it was closed in the
+		// ledger.
+		resp.LedgerStatus = ledgerStatusClosed
+		return resp, nil
+	}
+	resp.LedgerStatus, resp.Checks = uc.checks.run(ctx, req.TenantID, period)
+	resp.ReadyToClose = pc.Status() == valueobject.CloseStatusSignedOff && len(blockers(resp.Checks)) == 0
+	return resp, nil
+}
+
+// findOrStart loads the close of the tenant's period, starting one with
+// the default checklist if it has not been started.
+func findOrStart(ctx context.Context, closes port.PeriodCloseRepository, tenantID uuid.UUID, period valueobject.AccountingPeriod, now time.Time) (model.PeriodClose, error) {
+	pc, err := closes.Find(ctx, tenantID, period)
+	if errors.Is(err, port.ErrPeriodCloseNotFound) {
+		pc, err = model.NewPeriodClose(tenantID, period, model.DefaultChecklist, now)
+		if err != nil {
+			return model.PeriodClose{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+		return pc, nil
+	}
+	if err != nil {
+		return model.PeriodClose{}, fmt.Errorf("failed to find period close: %w", err)
+	}
+	return pc, nil
+}
@@ -0,0 +1,120 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/close-service/internal/application/dto"
+	"github.com/bibbank/bib/services/close-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/close-service/internal/domain/port"
+	"github.com/bibbank/bib/services/close-service/internal/domain/valueobject"
+)
+
+var errUnavailable = errors.New("connection refused")
+
+// fakeLedger is a Ledger of one tenant's periods.
+type fakeLedger struct {
+	closed   map[valueobject.AccountingPeriod]bool
+	unposted []port.UnpostedInterest
+	err      error
+}
+
+func (l *fakeLedger) PeriodStatus(_ context.Context, p valueobject.AccountingPeriod) (port.LedgerPeriod, error) {
+	if l.err != nil {
+		return port.LedgerPeriod{}, l.err
+	}
+	if l.closed[p] {
+		return port.LedgerPeriod{Closed: true}, nil
+	}
+	return port.LedgerPeriod{UnpostedInterest: l.unposted}, nil
+}
+
+func (l *fakeLedger) ClosePeriod(_ context.Context, p valueobject.AccountingPeriod) error {
+	if l.closed[p] {
+		return port.ErrLedgerPeriodClosed
+	}
+	if l.closed == nil {
+		l.closed = map[valueobject.AccountingPeriod]bool{}
+	}
+	l.closed[p] = true
+	return nil
+}
+
+// fakeAccruals is an AccrualRuns reporting fixed failures.
+type fakeAccruals struct {
+	failed []port.FailedAccrual
+}
+
+func (a *fakeAccruals) FailedAccruals(context.Context, valueobject.AccountingPeriod) ([]port.FailedAccrual, error) {
+	return a.failed, nil
+}
+
+// checkByName returns the named check of a dashboard.
+func checkByName(t *testing.T, resp dto.DashboardResponse, name string) dto.CheckResponse {
+	t.Helper()
+	for _, c := range resp.Checks {
+		if c.Name == name {
+			return c
+		}
+	}
+	t.Fatalf("dashboard has no %s check", name)
+	return dto.CheckResponse{}
+}
+
+func TestDashboard_ChecksAcrossSubsystems(t *testing.T) {
+	f := newCloseFixture()
+	f.signedOff(t)
+	ctx := context.Background()
+	f.ledger.unposted = []port.UnpostedInterest{{Kind: "DEPOSIT", Currency: "USD", Amount: "12.34"}}
+	f.accruals.failed = []port.FailedAccrual{{
+		BusinessDate: time.Date(2026, time.March, 14, 0, 0, 0, 0, time.UTC),
+		Step:         "loans.accrue",
+		Error:        "deadline exceeded",
+	}}
+	record := usecase.NewRecordBreakEventUseCase(f.breaks)
+	taskID := uuid.New()
+	require.NoError(t, record.Execute(ctx, "backoffice.task.opened", taskEvent(f.tenantID, taskID, "RECONCILIATION_BREAK", "Nostro USD difference of 250.00")))
+
+	resp := f.dashboard(t)
+	assert.Equal(t, dto.CheckResponse{
+		Name:   dto.CheckUnpostedInterest,
+		Status: "WARNING",
+		Count:  1,
+		Detail: "posted as a true-up when the period closes: DEPOSIT USD 12.34",
+	}, checkByName(t, resp, dto.CheckUnpostedInterest))
+	assert.Equal(t, dto.CheckResponse{
+		Name:   dto.CheckReconciliationBreaks,
+		Status: "BLOCKING",
+		Count:  1,
+		Detail: "unresolved in the backoffice: Nostro USD difference of 250.00",
+	}, checkByName(t, resp, dto.CheckReconciliationBreaks))
+	assert.Equal(t, "BLOCKING", checkByName(t, resp, dto.CheckFailedAccruals).Status)
+	assert.Equal(t, "resume the end-of-day runs to accrue: 2026-03-14 loans.accrue: deadline exceeded",
+		checkByName(t, resp, dto.CheckFailedAccruals).Detail)
+	assert.False(t, resp.ReadyToClose)
+
+	_, err := f.closePeriod()
+	assert.ErrorIs(t, err, usecase.ErrCloseBlocked)
+	assert.EqualError(t, err, "period close is blocked by reconciliation_breaks, failed_accruals")
+	assert.False(t, f.ledger.closed[mustPeriod(t)])
+
+	require.NoError(t, record.Execute(ctx, "backoffice.task.resolved", taskEvent(f.tenantID, taskID, "RECONCILIATION_BREAK", "")))
+	f.accruals.failed = nil
+	f.ledger.err = errUnavailable
+	resp = f.dashboard(t)
+	assert.Equal(t, "", resp.LedgerStatus)
+	assert.Equal(t, "UNAVAILABLE", checkByName(t, resp, dto.CheckUnpostedInterest).Status)
+	assert.Equal(t, "CLEAR", checkByName(t, resp, dto.CheckReconciliationBreaks).Status)
+	assert.False(t, resp.ReadyToClose, "a check that cannot be made holds up the close")
+
+	f.ledger.err = nil
+	assert.True(t, f.dashboard(t).ReadyToClose)
+	_, err = f.closePeriod()
+	require.NoError(t, err)
+}
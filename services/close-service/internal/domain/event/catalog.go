@@ -0,0 +1,14 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[ItemCompleted]("close.item.completed", 1, "Emitted when a close checklist item is completed."),
+		events.Declare[ItemReopened]("close.item.reopened", 1, "Emitted when a completed checklist item is reopened, withdrawing any sign-offs."),
+		events.Declare[SignedOff]("close.signed_off", 1, "Emitted when the preparer or the reviewer signs off a period's close checklist."),
+		events.Declare[PeriodClosed]("close.period.closed", 1, "Emitted when a signed-off period is closed in the ledger."),
+	}
+}
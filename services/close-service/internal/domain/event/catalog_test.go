@@ -0,0 +1,12 @@
+package event_test
+
+import (
+	"testing"
+
+	"github.com/bibbank/bib/pkg/events/catalogtest"
+	"github.com/bibbank/bib/services/close-service/internal/domain/event"
+)
+
+func TestEventCatalog(t *testing.T) {
+	catalogtest.CheckCatalog(t, "../../../../../events/close-service.json", "close-service", event.Catalog())
+}
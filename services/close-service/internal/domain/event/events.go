@@ -0,0 +1,90 @@
+package event
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// DomainEvent is an alias for the shared pkg/events.DomainEvent interface.
+type DomainEvent = events.DomainEvent
+
+// AggregateTypePeriodClose is the aggregate type of close events.
+const AggregateTypePeriodClose = "PeriodClose"
+
+// ItemCompleted is emitted when a close checklist item is completed.
+type ItemCompleted struct {
+	events.BaseEvent
+	Period      string    `json:"period"`
+	ItemKey     string    `json:"item_key"`
+	Note        string    `json:"note,omitempty"`
+	CompletedBy uuid.UUID `json:"completed_by"`
+}
+
+// NewItemCompleted creates a new ItemCompleted event.
+func NewItemCompleted(closeID, tenantID uuid.UUID, period, itemKey, note string, completedBy uuid.UUID) ItemCompleted {
+	return ItemCompleted{
+		BaseEvent:   events.NewBaseEvent("close.item.completed", closeID.String(), AggregateTypePeriodClose, tenantID.String()),
+		Period:      period,
+		ItemKey:     itemKey,
+		Note:        note,
+		CompletedBy: completedBy,
+	}
+}
+
+// ItemReopened is emitted when a completed close checklist item is
+// reopened, withdrawing any sign-offs.
+type ItemReopened struct {
+	events.BaseEvent
+	Period     string    `json:"period"`
+	ItemKey    string    `json:"item_key"`
+	Reason     string    `json:"reason"`
+	ReopenedBy uuid.UUID `json:"reopened_by"`
+}
+
+// NewItemReopened creates a new ItemReopened event.
+func NewItemReopened(closeID, tenantID uuid.UUID, period, itemKey, reason string, reopenedBy uuid.UUID) ItemReopened {
+	return ItemReopened{
+		BaseEvent:  events.NewBaseEvent("close.item.reopened", closeID.String(), AggregateTypePeriodClose, tenantID.String()),
+		Period:     period,
+		ItemKey:    itemKey,
+		Reason:     reason,
+		ReopenedBy: reopenedBy,
+	}
+}
+
+// SignedOff is emitted when a close checklist is signed off by its
+// preparer or reviewer.
+type SignedOff struct {
+	events.BaseEvent
+	Period string    `json:"period"`
+	Role   string    `json:"role"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// NewSignedOff creates a new SignedOff event.
+func NewSignedOff(closeID, tenantID uuid.UUID, period, role string, userID uuid.UUID) SignedOff {
+	return SignedOff{
+		BaseEvent: events.NewBaseEvent("close.signed_off", closeID.String(), AggregateTypePeriodClose, tenantID.String()),
+		Period:    period,
+		Role:      role,
+		UserID:    userID,
+	}
+}
+
+// PeriodClosed is emitted when a signed-off period is closed in the
+// ledger.
+type PeriodClosed struct {
+	events.BaseEvent
+	Period   string    `json:"period"`
+	ClosedBy uuid.UUID `json:"closed_by"`
+}
+
+// NewPeriodClosed creates a new PeriodClosed event.
+func NewPeriodClosed(closeID, tenantID uuid.UUID, period string, closedBy uuid.UUID) PeriodClosed {
+	return PeriodClosed{
+		BaseEvent: events.NewBaseEvent("close.period.closed", closeID.String(), AggregateTypePeriodClose, tenantID.String()),
+		Period:    period,
+		ClosedBy:  closedBy,
+	}
+}
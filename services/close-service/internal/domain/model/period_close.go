@@ -0,0 +1,300 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/close-service/internal/domain/event"
+	"github.com/bibbank/bib/services/close-service/internal/domain/valueobject"
+)
+
+var (
+	// ErrInvalidTransition is returned when a close cannot take the
+	// requested step from the status it is in.
+	ErrInvalidTransition = errors.New("invalid close transition")
+	// ErrChecklistIncomplete is returned when a checklist with items still
+	// open is signed off.
+	ErrChecklistIncomplete = errors.New("close checklist is incomplete")
+	// ErrSameSignatory is returned when the preparer of a checklist also
+	// tries to review it.
+	ErrSameSignatory = errors.New("reviewer must differ from preparer")
+	// ErrUnknownItem is returned when a checklist has no item of the key.
+	ErrUnknownItem = errors.New("unknown checklist item")
+)
+
+// ChecklistItem is one task of a period's close, such as reconciling the
+// nostro accounts. CompletedBy and CompletedAt are nil while it is open.
+type ChecklistItem struct {
+	CompletedAt *time.Time
+	CompletedBy *uuid.UUID
+	Key         string
+	Title       string
+	Note        string
+}
+
+// Completed reports whether the item is done.
+func (i ChecklistItem) Completed() bool {
+	return i.CompletedAt != nil
+}
+
+// SignOff records the preparer or the reviewer signing off a checklist.
+type SignOff struct {
+	SignedAt time.Time
+	Role     valueobject.SignOffRole
+	UserID   uuid.UUID
+}
+
+// DefaultChecklist is the checklist a period's close starts with.
+var DefaultChecklist = []ChecklistItem{
+	{Key: "nostro-reconciliation", Title: "Reconcile nostro and settlement accounts"},
+	{Key: "suspense-clearing", Title: "Clear suspense and clearing accounts"},
+	{Key: "accrual-review", Title: "Review deposit and loan interest accruals"},
+	{Key: "fx-revaluation", Title: "Review month-end FX revaluation"},
+	{Key: "regulatory-returns", Title: "Prepare regulatory returns"},
+}
+
+// PeriodClose is the aggregate root for a tenant's month-end close of one
+// accounting period. Finance works through its checklist, the preparer
+// signs it off, a different reviewer signs it off in turn, and the period
+// is then closed in the ledger. Reopening an item withdraws the sign-offs,
+// so they always cover the checklist as it stands.
+type PeriodClose struct {
+	createdAt    time.Time
+	updatedAt    time.Time
+	closedAt     *time.Time
+	closedBy     *uuid.UUID
+	period       valueobject.AccountingPeriod
+	status       valueobject.CloseStatus
+	items        []ChecklistItem
+	signOffs     []SignOff
+	domainEvents []events.DomainEvent
+	version      int
+	id           uuid.UUID
+	tenantID     uuid.UUID
+}
+
+// NewPeriodClose starts the close of a tenant's period with a checklist of
+// the given items, all open.
+func NewPeriodClose(tenantID uuid.UUID, period valueobject.AccountingPeriod, checklist []ChecklistItem, now time.Time) (PeriodClose, error) {
+	if tenantID == uuid.Nil {
+		return PeriodClose{}, fmt.Errorf("tenant ID is required")
+	}
+	if period.IsZero() {
+		return PeriodClose{}, fmt.Errorf("period is required")
+	}
+	if len(checklist) == 0 {
+		return PeriodClose{}, fmt.Errorf("checklist is required")
+	}
+	items := make([]ChecklistItem, len(checklist))
+	for i, item := range checklist {
+		items[i] = ChecklistItem{Key: item.Key, Title: item.Title}
+	}
+	return PeriodClose{
+		id:        uuid.New(),
+		tenantID:  tenantID,
+		period:    period,
+		status:    valueobject.CloseStatusOpen,
+		items:     items,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+// ReconstructPeriodClose recreates a PeriodClose from persisted data
+// without validation or events.
+func ReconstructPeriodClose(
+	id, tenantID uuid.UUID,
+	period valueobject.AccountingPeriod,
+	status valueobject.CloseStatus,
+	items []ChecklistItem,
+	signOffs []SignOff,
+	closedBy *uuid.UUID,
+	closedAt *time.Time,
+	version int,
+	createdAt, updatedAt time.Time,
+) PeriodClose {
+	return PeriodClose{
+		id:        id,
+		tenantID:  tenantID,
+		period:    period,
+		status:    status,
+		items:     items,
+		signOffs:  signOffs,
+		closedBy:  closedBy,
+		closedAt:  closedAt,
+		version:   version,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// CompleteItem marks a checklist item done. Items cannot change once the
+// checklist has been signed off; reopen one to withdraw the sign-offs
+// first.
+func (c PeriodClose) CompleteItem(key string, userID uuid.UUID, note string, now time.Time) (PeriodClose, error) {
+	if userID == uuid.Nil {
+		return c, fmt.Errorf("user ID is required")
+	}
+	if c.status != valueobject.CloseStatusOpen || len(c.signOffs) > 0 {
+		return c, fmt.Errorf("close of %s is signed off: %w", c.period, ErrInvalidTransition)
+	}
+	i, err := c.item(key)
+	if err != nil {
+		return c, err
+	}
+	if c.items[i].Completed() {
+		return c, fmt.Errorf("item %s is already complete: %w", key, ErrInvalidTransition)
+	}
+	c.items = slices.Clone(c.items)
+	c.items[i].CompletedBy = &userID
+	c.items[i].CompletedAt = &now
+	c.items[i].Note = note
+	c.touch(now)
+	c.domainEvents = append(c.domainEvents, event.NewItemCompleted(c.id, c.tenantID, c.period.String(), key, note, userID))
+	return c, nil
+}
+
+// ReopenItem marks a completed checklist item open again, such as when the
+// reviewer finds it was not done properly. Any sign-offs are withdrawn.
+func (c PeriodClose) ReopenItem(key string, userID uuid.UUID, reason string, now time.Time) (PeriodClose, error) {
+	if userID == uuid.Nil {
+		return c, fmt.Errorf("user ID is required")
+	}
+	if strings.TrimSpace(reason) == "" {
+		return c, fmt.Errorf("a reason is required to reopen an item")
+	}
+	if c.status == valueobject.CloseStatusClosed {
+		return c, fmt.Errorf("period %s is closed: %w", c.period, ErrInvalidTransition)
+	}
+	i, err := c.item(key)
+	if err != nil {
+		return c, err
+	}
+	if !c.items[i].Completed() {
+		return c, fmt.Errorf("item %s is not complete: %w", key, ErrInvalidTransition)
+	}
+	c.items = slices.Clone(c.items)
+	c.items[i].CompletedBy = nil
+	c.items[i].CompletedAt = nil
+	c.items[i].Note = ""
+	c.signOffs = nil
+	c.status = valueobject.CloseStatusOpen
+	c.touch(now)
+	c.domainEvents = append(c.domainEvents, event.NewItemReopened(c.id, c.tenantID, c.period.String(), key, reason, userID))
+	return c, nil
+}
+
+// SignOff records the user signing off the completed checklist. The
+// preparer signs first; the reviewer, who must be someone else, signs
+// second and the close is then ready for the period to be closed.
+func (c PeriodClose) SignOff(role valueobject.SignOffRole, userID uuid.UUID, now time.Time) (PeriodClose, error) {
+	if userID == uuid.Nil {
+		return c, fmt.Errorf("user ID is required")
+	}
+	if c.status != valueobject.CloseStatusOpen {
+		return c, fmt.Errorf("close of %s is %s: %w", c.period, c.status, ErrInvalidTransition)
+	}
+	if open := c.OpenItems(); open > 0 {
+		return c, fmt.Errorf("%w: %d of %d items open", ErrChecklistIncomplete, open, len(c.items))
+	}
+	preparer, prepared := c.SignOffOf(valueobject.SignOffPreparer)
+	switch role {
+	case valueobject.SignOffPreparer:
+		if prepared {
+			return c, fmt.Errorf("close of %s already has a preparer sign-off: %w", c.period, ErrInvalidTransition)
+		}
+	case valueobject.SignOffReviewer:
+		if !prepared {
+			return c, fmt.Errorf("close of %s needs the preparer's sign-off first: %w", c.period, ErrInvalidTransition)
+		}
+		if preparer.UserID == userID {
+			return c, ErrSameSignatory
+		}
+		c.status = valueobject.CloseStatusSignedOff
+	default:
+		return c, fmt.Errorf("sign-off role is required")
+	}
+	c.signOffs = append(slices.Clone(c.signOffs), SignOff{Role: role, UserID: userID, SignedAt: now})
+	c.touch(now)
+	c.domainEvents = append(c.domainEvents, event.NewSignedOff(c.id, c.tenantID, c.period.String(), role.String(), userID))
+	return c, nil
+}
+
+// Close records the signed-off period as closed in the ledger.
+func (c PeriodClose) Close(userID uuid.UUID, now time.Time) (PeriodClose, error) {
+	if userID == uuid.Nil {
+		return c, fmt.Errorf("user ID is required")
+	}
+	if c.status != valueobject.CloseStatusSignedOff {
+		return c, fmt.Errorf("close of %s is %s, not signed off: %w", c.period, c.status, ErrInvalidTransition)
+	}
+	c.status = valueobject.CloseStatusClosed
+	c.closedBy = &userID
+	c.closedAt = &now
+	c.touch(now)
+	c.domainEvents = append(c.domainEvents, event.NewPeriodClosed(c.id, c.tenantID, c.period.String(), userID))
+	return c, nil
+}
+
+func (c PeriodClose) item(key string) (int, error) {
+	i := slices.IndexFunc(c.items, func(item ChecklistItem) bool { return item.Key == key })
+	if i < 0 {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownItem, key)
+	}
+	return i, nil
+}
+
+func (c *PeriodClose) touch(now time.Time) {
+	c.version++
+	c.updatedAt = now
+}
+
+// OpenItems returns the number of checklist items not yet done.
+func (c PeriodClose) OpenItems() int {
+	open := 0
+	for _, item := range c.items {
+		if !item.Completed() {
+			open++
+		}
+	}
+	return open
+}
+
+// SignOffOf returns the sign-off given in the role, if there is one.
+func (c PeriodClose) SignOffOf(role valueobject.SignOffRole) (SignOff, bool) {
+	for _, s := range c.signOffs {
+		if s.Role == role {
+			return s, true
+		}
+	}
+	return SignOff{}, false
+}
+
+// --- Accessors ---
+
+func (c PeriodClose) ID() uuid.UUID                        { return c.id }
+func (c PeriodClose) TenantID() uuid.UUID                  { return c.tenantID }
+func (c PeriodClose) Period() valueobject.AccountingPeriod { return c.period }
+func (c PeriodClose) Status() valueobject.CloseStatus      { return c.status }
+func (c PeriodClose) Items() []ChecklistItem               { return slices.Clone(c.items) }
+func (c PeriodClose) SignOffs() []SignOff                  { return slices.Clone(c.signOffs) }
+func (c PeriodClose) ClosedBy() *uuid.UUID                 { return c.closedBy }
+func (c PeriodClose) ClosedAt() *time.Time                 { return c.closedAt }
+func (c PeriodClose) CreatedAt() time.Time                 { return c.createdAt }
+func (c PeriodClose) UpdatedAt() time.Time                 { return c.updatedAt }
+func (c PeriodClose) DomainEvents() []events.DomainEvent   { return c.domainEvents }
+
+// Version is 0 for a close never saved.
+func (c PeriodClose) Version() int { return c.version }
+
+// ClearDomainEvents returns a copy of the close with no uncommitted events.
+func (c PeriodClose) ClearDomainEvents() PeriodClose {
+	c.domainEvents = nil
+	return c
+}
@@ -0,0 +1,93 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/close-service/internal/domain/model"
+	"github.com/bibbank/bib/services/close-service/internal/domain/valueobject"
+)
+
+var now = time.Date(2026, time.April, 2, 9, 0, 0, 0, time.UTC)
+
+func newClose(t *testing.T) model.PeriodClose {
+	t.Helper()
+	period, err := valueobject.NewAccountingPeriod(2026, time.March)
+	require.NoError(t, err)
+	pc, err := model.NewPeriodClose(uuid.New(), period, []model.ChecklistItem{
+		{Key: "nostro-reconciliation", Title: "Reconcile nostro accounts"},
+		{Key: "accrual-review", Title: "Review accruals"},
+	}, now)
+	require.NoError(t, err)
+	return pc
+}
+
+func TestPeriodClose_SignOffNeedsTwoPeopleAndACompleteChecklist(t *testing.T) {
+	preparer, reviewer := uuid.New(), uuid.New()
+	pc := newClose(t)
+
+	pc, err := pc.CompleteItem("nostro-reconciliation", preparer, "tied out to statements", now)
+	require.NoError(t, err)
+	_, err = pc.CompleteItem("nostro-reconciliation", preparer, "", now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+	_, err = pc.SignOff(valueobject.SignOffPreparer, preparer, now)
+	assert.ErrorIs(t, err, model.ErrChecklistIncomplete)
+
+	pc, err = pc.CompleteItem("accrual-review", preparer, "", now)
+	require.NoError(t, err)
+	pc, err = pc.SignOff(valueobject.SignOffPreparer, preparer, now)
+	require.NoError(t, err)
+	_, err = pc.CompleteItem("accrual-review", preparer, "", now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition, "items are frozen once signed off")
+	_, err = pc.SignOff(valueobject.SignOffPreparer, reviewer, now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+	_, err = pc.SignOff(valueobject.SignOffReviewer, preparer, now)
+	assert.ErrorIs(t, err, model.ErrSameSignatory)
+
+	pc, err = pc.SignOff(valueobject.SignOffReviewer, reviewer, now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.CloseStatusSignedOff, pc.Status())
+	assert.Len(t, pc.SignOffs(), 2)
+	assert.Equal(t, 4, pc.Version())
+}
+
+func TestPeriodClose_ReopenedItemWithdrawsSignOffs(t *testing.T) {
+	preparer, reviewer := uuid.New(), uuid.New()
+	pc := newClose(t)
+	for _, key := range []string{"nostro-reconciliation", "accrual-review"} {
+		var err error
+		pc, err = pc.CompleteItem(key, preparer, "", now)
+		require.NoError(t, err)
+	}
+	pc, err := pc.SignOff(valueobject.SignOffPreparer, preparer, now)
+	require.NoError(t, err)
+	pc, err = pc.SignOff(valueobject.SignOffReviewer, reviewer, now)
+	require.NoError(t, err)
+
+	reopened, err := pc.ReopenItem("accrual-review", reviewer, "loan accruals do not tie out", now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.CloseStatusOpen, reopened.Status())
+	assert.Empty(t, reopened.SignOffs())
+	assert.Equal(t, 1, reopened.OpenItems())
+	assert.Len(t, pc.SignOffs(), 2, "the original is unchanged")
+
+	closed, err := pc.Close(reviewer, now)
+	require.NoError(t, err)
+	_, err = closed.ReopenItem("accrual-review", reviewer, "too late", now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+	assert.Equal(t, []string{
+		"close.item.completed", "close.item.completed", "close.signed_off", "close.signed_off", "close.period.closed",
+	}, eventTypes(closed))
+}
+
+func eventTypes(pc model.PeriodClose) []string {
+	var types []string
+	for _, e := range pc.DomainEvents() {
+		types = append(types, e.EventType())
+	}
+	return types
+}
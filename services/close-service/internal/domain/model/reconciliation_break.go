@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReconciliationBreak is an unresolved reconciliation break, as worked in
+// the backoffice's RECONCILIATION_BREAK queue. The close service keeps
+// them from the backoffice's task events, since a period with breaks
+// still open is not ready to close.
+type ReconciliationBreak struct {
+	OpenedAt time.Time
+	SourceID string
+	Summary  string
+	TaskID   uuid.UUID
+	TenantID uuid.UUID
+}
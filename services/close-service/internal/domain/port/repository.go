@@ -0,0 +1,50 @@
+package port
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/close-service/internal/domain/event"
+	"github.com/bibbank/bib/services/close-service/internal/domain/model"
+	"github.com/bibbank/bib/services/close-service/internal/domain/valueobject"
+)
+
+// ErrPeriodCloseNotFound is returned when the close of a period has not
+// been started.
+var ErrPeriodCloseNotFound = errors.New("period close not found")
+
+// ErrVersionConflict is returned when a close was modified concurrently.
+var ErrVersionConflict = errors.New("version conflict")
+
+// PeriodCloseRepository defines the persistence port for period closes.
+type PeriodCloseRepository interface {
+	// Save persists a close with optimistic locking on its version.
+	Save(ctx context.Context, pc model.PeriodClose) error
+
+	// Find retrieves the close of a tenant's period, returning
+	// ErrPeriodCloseNotFound when it has not been started.
+	Find(ctx context.Context, tenantID uuid.UUID, period valueobject.AccountingPeriod) (model.PeriodClose, error)
+}
+
+// BreakRepository defines the persistence port for the unresolved
+// reconciliation breaks kept from the backoffice's events.
+type BreakRepository interface {
+	// Open records a break. Breaks already recorded, resolved or not, are
+	// ignored.
+	Open(ctx context.Context, b model.ReconciliationBreak) error
+
+	// Resolve marks a break resolved. A break resolved before it is
+	// recorded stays resolved.
+	Resolve(ctx context.Context, tenantID, taskID uuid.UUID) error
+
+	// ListOpen returns a tenant's unresolved breaks, oldest first.
+	ListOpen(ctx context.Context, tenantID uuid.UUID) ([]model.ReconciliationBreak, error)
+}
+
+// EventPublisher defines the port for publishing domain events.
+type EventPublisher interface {
+	// Publish sends domain events to the event bus.
+	Publish(ctx context.Context, events []event.DomainEvent) error
+}
@@ -0,0 +1,53 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bibbank/bib/services/close-service/internal/domain/valueobject"
+)
+
+// ErrLedgerPeriodClosed is returned when the ledger is asked to close a
+// period it has already closed.
+var ErrLedgerPeriodClosed = errors.New("ledger period already closed")
+
+// UnpostedInterest is interest accrued in a period that the ledger has not
+// yet recognized, which its close posts as a true-up.
+type UnpostedInterest struct {
+	Kind     string
+	Currency string
+	Amount   string
+}
+
+// LedgerPeriod is the ledger's state of an accounting period.
+type LedgerPeriod struct {
+	UnpostedInterest []UnpostedInterest
+	Closed           bool
+}
+
+// Ledger is the port to the ledger's accounting periods. Calls act for the
+// caller's tenant.
+type Ledger interface {
+	// PeriodStatus reports the ledger's state of the period.
+	PeriodStatus(ctx context.Context, period valueobject.AccountingPeriod) (LedgerPeriod, error)
+
+	// ClosePeriod closes the period in the ledger, returning
+	// ErrLedgerPeriodClosed when it already is.
+	ClosePeriod(ctx context.Context, period valueobject.AccountingPeriod) error
+}
+
+// FailedAccrual is an interest accrual step of an end of day that failed
+// and has not been resumed successfully.
+type FailedAccrual struct {
+	BusinessDate time.Time
+	Step         string
+	Error        string
+}
+
+// AccrualRuns is the port to the scheduler's end-of-day runs.
+type AccrualRuns interface {
+	// FailedAccruals returns the failed accrual steps of the end-of-day
+	// runs of the period's business dates, latest first.
+	FailedAccruals(ctx context.Context, period valueobject.AccountingPeriod) ([]FailedAccrual, error)
+}
@@ -0,0 +1,53 @@
+package valueobject
+
+import (
+	"fmt"
+	"time"
+)
+
+// AccountingPeriod is a calendar month of the books, such as 2026-03.
+// It is an immutable value object.
+type AccountingPeriod struct {
+	year  int
+	month time.Month
+}
+
+// NewAccountingPeriod creates an AccountingPeriod, validating the year and
+// month.
+func NewAccountingPeriod(year int, month time.Month) (AccountingPeriod, error) {
+	if year < 2000 || year > 2100 {
+		return AccountingPeriod{}, fmt.Errorf("invalid accounting year %d: must be between 2000 and 2100", year)
+	}
+	if month < time.January || month > time.December {
+		return AccountingPeriod{}, fmt.Errorf("invalid month %d", month)
+	}
+	return AccountingPeriod{year: year, month: month}, nil
+}
+
+// ParseAccountingPeriod parses a period written as YYYY-MM.
+func ParseAccountingPeriod(s string) (AccountingPeriod, error) {
+	t, err := time.Parse("2006-01", s)
+	if err != nil {
+		return AccountingPeriod{}, fmt.Errorf("invalid accounting period %q: must be YYYY-MM", s)
+	}
+	return NewAccountingPeriod(t.Year(), t.Month())
+}
+
+func (p AccountingPeriod) Year() int         { return p.year }
+func (p AccountingPeriod) Month() time.Month { return p.month }
+func (p AccountingPeriod) IsZero() bool      { return p.year == 0 }
+
+// String returns the period as YYYY-MM.
+func (p AccountingPeriod) String() string {
+	return fmt.Sprintf("%d-%02d", p.year, p.month)
+}
+
+// Start returns the first day of the period.
+func (p AccountingPeriod) Start() time.Time {
+	return time.Date(p.year, p.month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Contains reports whether the date falls in the period.
+func (p AccountingPeriod) Contains(date time.Time) bool {
+	return date.Year() == p.year && date.Month() == p.month
+}
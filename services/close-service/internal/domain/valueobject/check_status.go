@@ -0,0 +1,32 @@
+package valueobject
+
+// CheckStatus is the outcome of one of the checks a period must pass
+// before it is closed.
+// It is an immutable value object.
+type CheckStatus struct {
+	value string
+}
+
+var (
+	// CheckClear is a check with nothing outstanding.
+	CheckClear = CheckStatus{value: "CLEAR"}
+	// CheckWarning is a check with something to review that does not hold
+	// up the close.
+	CheckWarning = CheckStatus{value: "WARNING"}
+	// CheckBlocking is a check with something outstanding that must be
+	// dealt with before the period is closed.
+	CheckBlocking = CheckStatus{value: "BLOCKING"}
+	// CheckUnavailable is a check whose subsystem could not be asked.
+	CheckUnavailable = CheckStatus{value: "UNAVAILABLE"}
+)
+
+// Blocks returns true if the check holds up the close: a check that could
+// not be made is not taken to have passed.
+func (s CheckStatus) Blocks() bool {
+	return s == CheckBlocking || s == CheckUnavailable
+}
+
+// String returns the string representation of the CheckStatus.
+func (s CheckStatus) String() string {
+	return s.value
+}
@@ -0,0 +1,50 @@
+package valueobject
+
+import "fmt"
+
+// CloseStatus is how far a period's close has got.
+// It is an immutable value object.
+type CloseStatus struct {
+	value string
+}
+
+const (
+	closeStatusOpen      = "OPEN"
+	closeStatusSignedOff = "SIGNED_OFF"
+	closeStatusClosed    = "CLOSED"
+)
+
+var (
+	// CloseStatusOpen is a close whose checklist is being worked.
+	CloseStatusOpen = CloseStatus{value: closeStatusOpen}
+	// CloseStatusSignedOff is a close whose checklist the preparer and the
+	// reviewer have both signed off, ready for the period to be closed.
+	CloseStatusSignedOff = CloseStatus{value: closeStatusSignedOff}
+	// CloseStatusClosed is a close whose period is closed in the ledger.
+	CloseStatusClosed = CloseStatus{value: closeStatusClosed}
+)
+
+// NewCloseStatus creates a CloseStatus from a string, validating it is
+// known.
+func NewCloseStatus(s string) (CloseStatus, error) {
+	switch s {
+	case closeStatusOpen:
+		return CloseStatusOpen, nil
+	case closeStatusSignedOff:
+		return CloseStatusSignedOff, nil
+	case closeStatusClosed:
+		return CloseStatusClosed, nil
+	default:
+		return CloseStatus{}, fmt.Errorf("invalid close status: %q", s)
+	}
+}
+
+// String returns the string representation of the CloseStatus.
+func (s CloseStatus) String() string {
+	return s.value
+}
+
+// IsZero returns true if the CloseStatus has not been set.
+func (s CloseStatus) IsZero() bool {
+	return s.value == ""
+}
@@ -0,0 +1,45 @@
+package valueobject
+
+import "fmt"
+
+// SignOffRole is the capacity in which someone signs off a period's close
+// checklist. The preparer signs first and a different reviewer second.
+// It is an immutable value object.
+type SignOffRole struct {
+	value string
+}
+
+const (
+	signOffPreparer = "PREPARER"
+	signOffReviewer = "REVIEWER"
+)
+
+var (
+	// SignOffPreparer is the sign-off of whoever completed the checklist.
+	SignOffPreparer = SignOffRole{value: signOffPreparer}
+	// SignOffReviewer is the second, independent sign-off.
+	SignOffReviewer = SignOffRole{value: signOffReviewer}
+)
+
+// NewSignOffRole creates a SignOffRole from a string, validating it is
+// known.
+func NewSignOffRole(s string) (SignOffRole, error) {
+	switch s {
+	case signOffPreparer:
+		return SignOffPreparer, nil
+	case signOffReviewer:
+		return SignOffReviewer, nil
+	default:
+		return SignOffRole{}, fmt.Errorf("invalid sign-off role: %q", s)
+	}
+}
+
+// String returns the string representation of the SignOffRole.
+func (r SignOffRole) String() string {
+	return r.value
+}
+
+// IsZero returns true if the SignOffRole has not been set.
+func (r SignOffRole) IsZero() bool {
+	return r.value == ""
+}
@@ -0,0 +1,34 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// invoke calls a unary method of another service as the caller, bounded by
+// timeout when it is positive.
+func invoke(ctx context.Context, conn grpc.ClientConnInterface, method string, req, resp any, timeout time.Duration) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return conn.Invoke(forwardAuthorization(ctx), method, req, resp)
+}
+
+// forwardAuthorization passes the caller's bearer token on to the called
+// service, which authorizes the request against it and scopes it to the
+// caller's tenant.
+func forwardAuthorization(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	if values := md.Get("authorization"); len(values) > 0 {
+		return metadata.AppendToOutgoingContext(ctx, "authorization", values[0])
+	}
+	return ctx
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ledgerv1 "github.com/bibbank/bib/api/gen/go/bib/ledger/v1"
+	"github.com/bibbank/bib/services/close-service/internal/domain/port"
+	"github.com/bibbank/bib/services/close-service/internal/domain/valueobject"
+)
+
+// Compile-time interface check.
+var _ port.Ledger = (*LedgerGRPCClient)(nil)
+
+// ledgerPeriodClosed is the ledger's status of a closed period.
+const ledgerPeriodClosed = "CLOSED"
+
+// LedgerGRPCClient implements the Ledger port against the ledger service's
+// accounting periods. Calls carry the caller's bearer token.
+type LedgerGRPCClient struct {
+	conn    grpc.ClientConnInterface
+	timeout time.Duration
+}
+
+// NewLedgerGRPCClient creates a new LedgerGRPCClient. timeout bounds each
+// call when it is positive.
+func NewLedgerGRPCClient(conn grpc.ClientConnInterface, timeout time.Duration) *LedgerGRPCClient {
+	return &LedgerGRPCClient{conn: conn, timeout: timeout}
+}
+
+// PeriodStatus reports whether the ledger has closed the period and the
+// interest its close would post.
+func (c *LedgerGRPCClient) PeriodStatus(ctx context.Context, period valueobject.AccountingPeriod) (port.LedgerPeriod, error) {
+	year, month := periodOf(period)
+	req := &ledgerv1.GetPeriodStatusRequest{Year: year, Month: month}
+	var resp ledgerv1.GetPeriodStatusResponse
+	if err := invoke(ctx, c.conn, ledgerv1.LedgerService_GetPeriodStatus_FullMethodName, req, &resp, c.timeout); err != nil {
+		return port.LedgerPeriod{}, fmt.Errorf("get ledger status of %s: %w", period, err)
+	}
+	lp := port.LedgerPeriod{Closed: resp.GetStatus() == ledgerPeriodClosed}
+	for _, u := range resp.GetUnpostedInterest() {
+		lp.UnpostedInterest = append(lp.UnpostedInterest, port.UnpostedInterest{
+			Kind:     u.GetKind(),
+			Currency: u.GetCurrency(),
+			Amount:   u.GetAmount(),
+		})
+	}
+	return lp, nil
+}
+
+// ClosePeriod closes the period in the ledger.
+func (c *LedgerGRPCClient) ClosePeriod(ctx context.Context, period valueobject.AccountingPeriod) error {
+	year, month := periodOf(period)
+	req := &ledgerv1.ClosePeriodRequest{Year: year, Month: month}
+	var resp ledgerv1.ClosePeriodResponse
+	// The ledger refuses to close a period it has closed already.
+	err := invoke(ctx, c.conn, ledgerv1.LedgerService_ClosePeriod_FullMethodName, req, &resp, c.timeout)
+	if status.Code(err) == codes.FailedPrecondition {
+		return fmt.Errorf("%w: %s", port.ErrLedgerPeriodClosed, status.Convert(err).Message())
+	}
+	if err != nil {
+		return fmt.Errorf("close ledger period %s: %w", period, err)
+	}
+	return nil
+}
+
+func periodOf(period valueobject.AccountingPeriod) (year, month int32) {
+	year = int32(period.Year())   //nolint:gosec // validated to 2000-2100
+	month = int32(period.Month()) //nolint:gosec // 1-12
+	return year, month
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+
+	schedulerv1 "github.com/bibbank/bib/api/gen/go/bib/scheduler/v1"
+	"github.com/bibbank/bib/services/close-service/internal/domain/port"
+	"github.com/bibbank/bib/services/close-service/internal/domain/valueobject"
+)
+
+// Compile-time interface check.
+var _ port.AccrualRuns = (*SchedulerGRPCClient)(nil)
+
+// eodRunsPageSize is how many end-of-day runs are listed per call, the
+// scheduler's largest page.
+const eodRunsPageSize = 100
+
+// accrualStepSuffix ends the names of the end-of-day steps that accrue
+// interest, such as deposits.accrue.
+const accrualStepSuffix = ".accrue"
+
+// SchedulerGRPCClient implements the AccrualRuns port against the
+// scheduler service's end-of-day runs. Calls carry the caller's bearer
+// token.
+type SchedulerGRPCClient struct {
+	conn    grpc.ClientConnInterface
+	timeout time.Duration
+}
+
+// NewSchedulerGRPCClient creates a new SchedulerGRPCClient. timeout bounds
+// each call when it is positive.
+func NewSchedulerGRPCClient(conn grpc.ClientConnInterface, timeout time.Duration) *SchedulerGRPCClient {
+	return &SchedulerGRPCClient{conn: conn, timeout: timeout}
+}
+
+// FailedAccruals pages through the end-of-day runs, latest first, until
+// those before the period, collecting the accrual steps of the period's
+// runs that are failed.
+func (c *SchedulerGRPCClient) FailedAccruals(ctx context.Context, period valueobject.AccountingPeriod) ([]port.FailedAccrual, error) {
+	var failed []port.FailedAccrual
+	for offset := 0; ; offset += eodRunsPageSize {
+		req := &schedulerv1.ListEODRunsRequest{PageSize: eodRunsPageSize, Offset: int32(offset)} //nolint:gosec // one run per business date
+		var resp schedulerv1.ListEODRunsResponse
+		if err := invoke(ctx, c.conn, schedulerv1.SchedulerService_ListEODRuns_FullMethodName, req, &resp, c.timeout); err != nil {
+			return nil, fmt.Errorf("list end-of-day runs: %w", err)
+		}
+		for _, run := range resp.GetRuns() {
+			date, err := time.Parse(time.DateOnly, run.GetBusinessDate())
+			if err != nil {
+				return nil, fmt.Errorf("end-of-day run has invalid business date %q: %w", run.GetBusinessDate(), err)
+			}
+			if date.Before(period.Start()) {
+				return failed, nil
+			}
+			if !period.Contains(date) {
+				continue
+			}
+			for _, step := range run.GetSteps() {
+				if step.GetStatus() == schedulerv1.EODStepStatus_EOD_STEP_STATUS_FAILED && strings.HasSuffix(step.GetName(), accrualStepSuffix) {
+					failed = append(failed, port.FailedAccrual{BusinessDate: date, Step: step.GetName(), Error: step.GetError()})
+				}
+			}
+		}
+		if len(resp.GetRuns()) < eodRunsPageSize {
+			return failed, nil
+		}
+	}
+}
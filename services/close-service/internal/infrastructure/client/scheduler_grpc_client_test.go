@@ -0,0 +1,54 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	schedulerv1 "github.com/bibbank/bib/api/gen/go/bib/scheduler/v1"
+	"github.com/bibbank/bib/services/close-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/close-service/internal/infrastructure/client"
+)
+
+// fakeConn answers every call with reply, as the called service's JSON.
+type fakeConn struct {
+	reply   string
+	methods []string
+}
+
+func (c *fakeConn) Invoke(_ context.Context, method string, _, reply any, _ ...grpc.CallOption) error {
+	c.methods = append(c.methods, method)
+	return protojson.Unmarshal([]byte(c.reply), reply.(proto.Message))
+}
+
+func (c *fakeConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not supported")
+}
+
+func TestSchedulerGRPCClient_FailedAccruals(t *testing.T) {
+	conn := &fakeConn{reply: `{"runs":[` +
+		`{"business_date":"2026-04-01","steps":[{"name":"deposits.accrue","status":"EOD_STEP_STATUS_FAILED"}]},` +
+		`{"business_date":"2026-03-31","steps":[` +
+		`{"name":"deposits.accrue","status":"EOD_STEP_STATUS_FAILED","error":"ledger unavailable"},` +
+		`{"name":"loans.accrue","status":"EOD_STEP_STATUS_SUCCEEDED"},` +
+		`{"name":"statements.generate","status":"EOD_STEP_STATUS_FAILED"}]},` +
+		`{"business_date":"2026-02-28","steps":[{"name":"loans.accrue","status":"EOD_STEP_STATUS_FAILED"}]}]}`}
+	period, err := valueobject.NewAccountingPeriod(2026, time.March)
+	require.NoError(t, err)
+
+	failed, err := client.NewSchedulerGRPCClient(conn, time.Second).FailedAccruals(context.Background(), period)
+	require.NoError(t, err)
+
+	require.Len(t, failed, 1)
+	assert.Equal(t, "deposits.accrue", failed[0].Step)
+	assert.Equal(t, "ledger unavailable", failed[0].Error)
+	assert.Equal(t, time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC), failed[0].BusinessDate)
+	assert.Equal(t, []string{schedulerv1.SchedulerService_ListEODRuns_FullMethodName}, conn.methods)
+}
@@ -0,0 +1,136 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+type DatabaseConfig struct {
+	Host     string
+	User     string
+	Password string
+	Name     string
+	SSLMode  string
+	Port     int
+}
+
+type KafkaConfig struct {
+	ConsumerGroup string
+	Brokers       []string
+}
+
+// UpstreamConfig configures the calls to the ledger and scheduler services
+// the close checks ask. Timeout bounds each call.
+type UpstreamConfig struct {
+	LedgerGRPCAddr    string
+	SchedulerGRPCAddr string
+	Timeout           time.Duration
+}
+
+type Config struct {
+	DB          DatabaseConfig
+	ServiceName string
+	Kafka       KafkaConfig
+	Upstream    UpstreamConfig
+	Outbox      OutboxConfig
+	GRPCPort    int
+	HTTPPort    int
+}
+
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+func Load() Config {
+	return Config{
+		GRPCPort: getEnvInt("GRPC_PORT", 9106),
+		HTTPPort: getEnvInt("HTTP_PORT", 8106),
+		DB: DatabaseConfig{
+			Host:     getEnv("DB_HOST", "localhost"),
+			Port:     getEnvInt("DB_PORT", 5432),
+			User:     getEnv("DB_USER", "bib"),
+			Password: getEnv("DB_PASSWORD", ""),
+			Name:     getEnv("DB_NAME", "bib_close"),
+			SSLMode:  getEnv("DB_SSLMODE", "require"),
+		},
+		Kafka: KafkaConfig{
+			Brokers:       []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+			ConsumerGroup: getEnv("KAFKA_CONSUMER_GROUP", "close-service"),
+		},
+		Upstream: UpstreamConfig{
+			LedgerGRPCAddr:    getEnv("LEDGER_SERVICE_ADDR", "localhost:9081"),
+			SchedulerGRPCAddr: getEnv("SCHEDULER_SERVICE_ADDR", "localhost:9095"),
+			Timeout:           getEnvDuration("CLOSE_UPSTREAM_TIMEOUT", 5*time.Second),
+		},
+		ServiceName: "close-service",
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
+	}
+}
+
+func (c Config) GRPCAddr() string {
+	return fmt.Sprintf(":%d", c.GRPCPort)
+}
+
+func (c Config) HTTPAddr() string {
+	return fmt.Sprintf(":%d", c.HTTPPort)
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) int {
+	if v := os.Getenv(key); v != "" {
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+	return fallback
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return fallback
+}
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/close-service/internal/domain/model"
+)
+
+// BreakRepo is the PostgreSQL implementation of BreakRepository.
+type BreakRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewBreakRepo creates a new BreakRepo.
+func NewBreakRepo(pool *pgxpool.Pool) *BreakRepo {
+	return &BreakRepo{pool: pool}
+}
+
+// Open records a break, unless it is already recorded. A break whose
+// resolution arrived first gets its details but stays resolved.
+func (r *BreakRepo) Open(ctx context.Context, b model.ReconciliationBreak) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO reconciliation_breaks (task_id, tenant_id, source_id, summary, opened_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (task_id) DO UPDATE SET
+			source_id = EXCLUDED.source_id,
+			summary = EXCLUDED.summary,
+			opened_at = EXCLUDED.opened_at
+		WHERE reconciliation_breaks.opened_at IS NULL
+	`, b.TaskID, b.TenantID, b.SourceID, b.Summary, b.OpenedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert reconciliation break: %w", err)
+	}
+	return nil
+}
+
+// Resolve marks a break resolved, recording it resolved if it is not yet
+// recorded.
+func (r *BreakRepo) Resolve(ctx context.Context, tenantID, taskID uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO reconciliation_breaks (task_id, tenant_id, resolved_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (task_id) DO UPDATE SET
+			resolved_at = COALESCE(reconciliation_breaks.resolved_at, NOW())
+	`, taskID, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve reconciliation break: %w", err)
+	}
+	return nil
+}
+
+// ListOpen returns a tenant's unresolved breaks, oldest first.
+func (r *BreakRepo) ListOpen(ctx context.Context, tenantID uuid.UUID) ([]model.ReconciliationBreak, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT task_id, tenant_id, source_id, summary, opened_at FROM reconciliation_breaks
+		WHERE tenant_id = $1 AND resolved_at IS NULL
+		ORDER BY opened_at, task_id
+	`, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reconciliation breaks: %w", err)
+	}
+	defer rows.Close()
+
+	var breaks []model.ReconciliationBreak
+	for rows.Next() {
+		var b model.ReconciliationBreak
+		if err := rows.Scan(&b.TaskID, &b.TenantID, &b.SourceID, &b.Summary, &b.OpenedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan reconciliation break row: %w", err)
+		}
+		breaks = append(breaks, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return breaks, nil
+}
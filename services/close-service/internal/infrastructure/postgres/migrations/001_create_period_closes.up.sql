@@ -0,0 +1,16 @@
+-- Month-end closes of the tenants' accounting periods, with their
+-- checklists and sign-offs.
+CREATE TABLE IF NOT EXISTS period_closes (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    period CHAR(7) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    items JSONB NOT NULL,
+    sign_offs JSONB NOT NULL DEFAULT '[]',
+    closed_by UUID,
+    closed_at TIMESTAMPTZ,
+    version INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_period_closes_period UNIQUE (tenant_id, period)
+);
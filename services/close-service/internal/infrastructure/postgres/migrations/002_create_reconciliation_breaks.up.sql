@@ -0,0 +1,12 @@
+-- Reconciliation breaks kept from the backoffice's task events. Resolved
+-- breaks are kept, so a redelivered opening does not reopen them.
+CREATE TABLE IF NOT EXISTS reconciliation_breaks (
+    task_id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    source_id VARCHAR(255) NOT NULL DEFAULT '',
+    summary TEXT NOT NULL DEFAULT '',
+    opened_at TIMESTAMPTZ,
+    resolved_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_reconciliation_breaks_open ON reconciliation_breaks (tenant_id, opened_at) WHERE resolved_at IS NULL;
@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/close-service/internal/domain/model"
+	"github.com/bibbank/bib/services/close-service/internal/domain/port"
+	"github.com/bibbank/bib/services/close-service/internal/domain/valueobject"
+)
+
+// uniqueViolation is the PostgreSQL error code for a unique constraint
+// violation.
+const uniqueViolation = "23505"
+
+const periodCloseColumns = `
+	id, tenant_id, period, status, items, sign_offs, closed_by, closed_at, version, created_at, updated_at`
+
+// itemRecord is the stored form of a checklist item.
+type itemRecord struct {
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CompletedBy *uuid.UUID `json:"completed_by,omitempty"`
+	Key         string     `json:"key"`
+	Title       string     `json:"title"`
+	Note        string     `json:"note,omitempty"`
+}
+
+// signOffRecord is the stored form of a sign-off.
+type signOffRecord struct {
+	SignedAt time.Time `json:"signed_at"`
+	Role     string    `json:"role"`
+	UserID   uuid.UUID `json:"user_id"`
+}
+
+// PeriodCloseRepo is the PostgreSQL implementation of
+// PeriodCloseRepository.
+type PeriodCloseRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewPeriodCloseRepo creates a new PeriodCloseRepo.
+func NewPeriodCloseRepo(pool *pgxpool.Pool) *PeriodCloseRepo {
+	return &PeriodCloseRepo{pool: pool}
+}
+
+// Save persists a close with optimistic locking on its version. A second
+// close started concurrently for the same period is a version conflict.
+func (r *PeriodCloseRepo) Save(ctx context.Context, pc model.PeriodClose) error {
+	items := make([]itemRecord, 0, len(pc.Items()))
+	for _, item := range pc.Items() {
+		items = append(items, itemRecord(item))
+	}
+	itemsJSON, err := json.Marshal(items)
+	if err != nil {
+		return fmt.Errorf("marshal checklist items: %w", err)
+	}
+	signOffs := make([]signOffRecord, 0, len(pc.SignOffs()))
+	for _, s := range pc.SignOffs() {
+		signOffs = append(signOffs, signOffRecord{Role: s.Role.String(), UserID: s.UserID, SignedAt: s.SignedAt})
+	}
+	signOffsJSON, err := json.Marshal(signOffs)
+	if err != nil {
+		return fmt.Errorf("marshal sign-offs: %w", err)
+	}
+
+	result, err := r.pool.Exec(ctx, `
+		INSERT INTO period_closes (`+periodCloseColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			items = EXCLUDED.items,
+			sign_offs = EXCLUDED.sign_offs,
+			closed_by = EXCLUDED.closed_by,
+			closed_at = EXCLUDED.closed_at,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE period_closes.version = EXCLUDED.version - 1
+	`, pc.ID(), pc.TenantID(), pc.Period().String(), pc.Status().String(), itemsJSON, signOffsJSON,
+		pc.ClosedBy(), pc.ClosedAt(), pc.Version(), pc.CreatedAt(), pc.UpdatedAt(),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: close of %s started concurrently", port.ErrVersionConflict, pc.Period())
+	}
+	if err != nil {
+		return fmt.Errorf("upsert period close: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: close of %s", port.ErrVersionConflict, pc.Period())
+	}
+	return nil
+}
+
+// Find retrieves the close of a tenant's period.
+func (r *PeriodCloseRepo) Find(ctx context.Context, tenantID uuid.UUID, period valueobject.AccountingPeriod) (model.PeriodClose, error) {
+	row := r.pool.QueryRow(ctx, `SELECT `+periodCloseColumns+` FROM period_closes
+		WHERE tenant_id = $1 AND period = $2`, tenantID, period.String())
+
+	var (
+		id, tenant             uuid.UUID
+		periodStr, statusStr   string
+		itemsJSON, signOffJSON []byte
+		closedBy               *uuid.UUID
+		closedAt               *time.Time
+		version                int
+		createdAt, updatedAt   time.Time
+	)
+	err := row.Scan(&id, &tenant, &periodStr, &statusStr, &itemsJSON, &signOffJSON,
+		&closedBy, &closedAt, &version, &createdAt, &updatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.PeriodClose{}, fmt.Errorf("%w: %s", port.ErrPeriodCloseNotFound, period)
+	}
+	if err != nil {
+		return model.PeriodClose{}, fmt.Errorf("failed to query period close: %w", err)
+	}
+
+	p, err := valueobject.ParseAccountingPeriod(periodStr)
+	if err != nil {
+		return model.PeriodClose{}, err
+	}
+	status, err := valueobject.NewCloseStatus(statusStr)
+	if err != nil {
+		return model.PeriodClose{}, err
+	}
+	var itemRecords []itemRecord
+	if err := json.Unmarshal(itemsJSON, &itemRecords); err != nil {
+		return model.PeriodClose{}, fmt.Errorf("unmarshal checklist items: %w", err)
+	}
+	items := make([]model.ChecklistItem, 0, len(itemRecords))
+	for _, rec := range itemRecords {
+		items = append(items, model.ChecklistItem(rec))
+	}
+	var signOffRecords []signOffRecord
+	if err := json.Unmarshal(signOffJSON, &signOffRecords); err != nil {
+		return model.PeriodClose{}, fmt.Errorf("unmarshal sign-offs: %w", err)
+	}
+	var signOffs []model.SignOff
+	for _, rec := range signOffRecords {
+		role, err := valueobject.NewSignOffRole(rec.Role)
+		if err != nil {
+			return model.PeriodClose{}, err
+		}
+		signOffs = append(signOffs, model.SignOff{Role: role, UserID: rec.UserID, SignedAt: rec.SignedAt})
+	}
+
+	return model.ReconstructPeriodClose(id, tenant, p, status, items, signOffs, closedBy, closedAt,
+		version, createdAt, updatedAt), nil
+}
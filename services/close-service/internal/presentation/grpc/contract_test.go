@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	closev1 "github.com/bibbank/bib/api/gen/go/bib/close/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/close-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/close-service/internal/domain/event"
+	"github.com/bibbank/bib/services/close-service/internal/domain/model"
+	"github.com/bibbank/bib/services/close-service/internal/domain/port"
+	"github.com/bibbank/bib/services/close-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractCloseRepo holds at most one period's close.
+type contractCloseRepo struct {
+	close *model.PeriodClose
+}
+
+func (r *contractCloseRepo) Save(context.Context, model.PeriodClose) error { return nil }
+
+func (r *contractCloseRepo) Find(context.Context, uuid.UUID, valueobject.AccountingPeriod) (model.PeriodClose, error) {
+	if r.close == nil {
+		return model.PeriodClose{}, port.ErrPeriodCloseNotFound
+	}
+	return *r.close, nil
+}
+
+type discardPublisher struct{}
+
+func (discardPublisher) Publish(context.Context, []event.DomainEvent) error { return nil }
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	closes := &contractCloseRepo{}
+	h := NewHandler(
+		nil, usecase.NewCompleteItemUseCase(closes, discardPublisher{}), nil,
+		usecase.NewSignOffUseCase(closes, discardPublisher{}), nil,
+		slog.Default(),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { closev1.RegisterCloseServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				UserID:   uuid.New(),
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-close-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { closes.close = nil },
+		"every checklist item is complete": func(t *testing.T) {
+			period, err := valueobject.ParseAccountingPeriod("2026-01")
+			if err != nil {
+				t.Fatal(err)
+			}
+			now := time.Now().UTC()
+			pc, err := model.NewPeriodClose(contractTenantID, period, model.DefaultChecklist, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, item := range model.DefaultChecklist {
+				if pc, err = pc.CompleteItem(item.Key, uuid.New(), "", now); err != nil {
+					t.Fatal(err)
+				}
+			}
+			closes.close = &pc
+		},
+	})
+}
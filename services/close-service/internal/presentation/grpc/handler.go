@@ -0,0 +1,273 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	closev1 "github.com/bibbank/bib/api/gen/go/bib/close/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/close-service/internal/application/dto"
+	"github.com/bibbank/bib/services/close-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/close-service/internal/domain/model"
+	"github.com/bibbank/bib/services/close-service/internal/domain/port"
+)
+
+// readRoles may view a period's close; closeRoles may work its checklist,
+// sign it off and close it. The dashboard asks the ledger and the scheduler
+// with the caller's token, which grant these roles their reads too.
+var (
+	readRoles  = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor}
+	closeRoles = []string{auth.RoleAdmin, auth.RoleOperator}
+)
+
+// requireRole checks that the caller has at least one of the given roles,
+// returning their claims.
+func requireRole(ctx context.Context, roles ...string) (*auth.Claims, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return claims, nil
+		}
+	}
+	return nil, status.Error(codes.PermissionDenied, "insufficient permissions")
+}
+
+// optionalTimestamp converts an optional time, returning nil when it is nil.
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// enumName strips an enum value's prefix, returning "" for the unspecified
+// value.
+func enumName(name, prefix string) string {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "UNSPECIFIED" {
+		return ""
+	}
+	return name
+}
+
+// uuidString formats an optional ID, returning "" when it is nil.
+func uuidString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+// Compile-time assertion that Handler implements CloseServiceServer.
+var _ closev1.CloseServiceServer = (*Handler)(nil)
+
+// Handler implements the CloseServiceServer gRPC interface.
+type Handler struct {
+	closev1.UnimplementedCloseServiceServer
+	getDashboard *usecase.GetCloseDashboardUseCase
+	completeItem *usecase.CompleteItemUseCase
+	reopenItem   *usecase.ReopenItemUseCase
+	signOff      *usecase.SignOffUseCase
+	closePeriod  *usecase.ClosePeriodUseCase
+	logger       *slog.Logger
+}
+
+// NewHandler creates a new gRPC Handler.
+func NewHandler(
+	getDashboard *usecase.GetCloseDashboardUseCase,
+	completeItem *usecase.CompleteItemUseCase,
+	reopenItem *usecase.ReopenItemUseCase,
+	signOff *usecase.SignOffUseCase,
+	closePeriod *usecase.ClosePeriodUseCase,
+	logger *slog.Logger,
+) *Handler {
+	return &Handler{
+		getDashboard: getDashboard,
+		completeItem: completeItem,
+		reopenItem:   reopenItem,
+		signOff:      signOff,
+		closePeriod:  closePeriod,
+		logger:       logger,
+	}
+}
+
+// GetCloseDashboard reports the caller's period's close status across the
+// subsystems, with its checklist and sign-offs.
+func (h *Handler) GetCloseDashboard(ctx context.Context, req *closev1.GetCloseDashboardRequest) (*closev1.GetCloseDashboardResponse, error) {
+	claims, err := requireRole(ctx, readRoles...)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	resp, err := h.getDashboard.Execute(ctx, dto.PeriodRequest{TenantID: claims.TenantID, Period: req.Period})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	out := &closev1.GetCloseDashboardResponse{
+		Close:        toPeriodCloseMsg(resp.Close),
+		LedgerStatus: resp.LedgerStatus,
+		Checks:       make([]*closev1.CloseCheck, 0, len(resp.Checks)),
+		ReadyToClose: resp.ReadyToClose,
+	}
+	for _, c := range resp.Checks {
+		out.Checks = append(out.Checks, &closev1.CloseCheck{
+			Name:   c.Name,
+			Status: closev1.CheckStatus(closev1.CheckStatus_value["CHECK_STATUS_"+c.Status]),
+			Count:  int32(c.Count), //nolint:gosec // bounded by outstanding items
+			Detail: c.Detail,
+		})
+	}
+	return out, nil
+}
+
+// CompleteChecklistItem completes an item of the caller's period's close
+// checklist.
+func (h *Handler) CompleteChecklistItem(ctx context.Context, req *closev1.CompleteChecklistItemRequest) (*closev1.CompleteChecklistItemResponse, error) {
+	claims, err := requireRole(ctx, closeRoles...)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	resp, err := h.completeItem.Execute(ctx, dto.CompleteItemRequest{
+		TenantID: claims.TenantID,
+		UserID:   claims.UserID,
+		Period:   req.Period,
+		ItemKey:  req.ItemKey,
+		Note:     req.Note,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &closev1.CompleteChecklistItemResponse{Close: toPeriodCloseMsg(resp)}, nil
+}
+
+// ReopenChecklistItem reopens a completed item of the caller's period's
+// close checklist, withdrawing its sign-offs.
+func (h *Handler) ReopenChecklistItem(ctx context.Context, req *closev1.ReopenChecklistItemRequest) (*closev1.ReopenChecklistItemResponse, error) {
+	claims, err := requireRole(ctx, closeRoles...)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	resp, err := h.reopenItem.Execute(ctx, dto.ReopenItemRequest{
+		TenantID: claims.TenantID,
+		UserID:   claims.UserID,
+		Period:   req.Period,
+		ItemKey:  req.ItemKey,
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &closev1.ReopenChecklistItemResponse{Close: toPeriodCloseMsg(resp)}, nil
+}
+
+// SignOffChecklist signs off the caller's period's close checklist as its
+// preparer or reviewer.
+func (h *Handler) SignOffChecklist(ctx context.Context, req *closev1.SignOffChecklistRequest) (*closev1.SignOffChecklistResponse, error) {
+	claims, err := requireRole(ctx, closeRoles...)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	resp, err := h.signOff.Execute(ctx, dto.SignOffRequest{
+		TenantID: claims.TenantID,
+		UserID:   claims.UserID,
+		Period:   req.Period,
+		Role:     enumName(req.Role.String(), "SIGN_OFF_ROLE_"),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &closev1.SignOffChecklistResponse{Close: toPeriodCloseMsg(resp)}, nil
+}
+
+// ClosePeriod closes the caller's signed-off period in the ledger.
+func (h *Handler) ClosePeriod(ctx context.Context, req *closev1.ClosePeriodRequest) (*closev1.ClosePeriodResponse, error) {
+	claims, err := requireRole(ctx, closeRoles...)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	resp, err := h.closePeriod.Execute(ctx, dto.ClosePeriodRequest{
+		TenantID: claims.TenantID,
+		UserID:   claims.UserID,
+		Period:   req.Period,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &closev1.ClosePeriodResponse{Close: toPeriodCloseMsg(resp)}, nil
+}
+
+func (h *Handler) toStatus(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrUnknownItem):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrInvalidTransition), errors.Is(err, model.ErrChecklistIncomplete),
+		errors.Is(err, model.ErrSameSignatory), errors.Is(err, usecase.ErrCloseBlocked):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, port.ErrVersionConflict):
+		return status.Error(codes.Aborted, "close modified concurrently, retry")
+	default:
+		h.logger.Error("handler error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func toPeriodCloseMsg(c dto.PeriodCloseResponse) *closev1.PeriodClose {
+	msg := &closev1.PeriodClose{
+		Period:   c.Period,
+		Status:   closev1.CloseStatus(closev1.CloseStatus_value["CLOSE_STATUS_"+c.Status]),
+		Items:    make([]*closev1.ChecklistItem, 0, len(c.Items)),
+		SignOffs: make([]*closev1.SignOff, 0, len(c.SignOffs)),
+		ClosedBy: uuidString(c.ClosedBy),
+		ClosedAt: optionalTimestamp(c.ClosedAt),
+		Version:  int32(c.Version), //nolint:gosec // one change per step
+	}
+	for _, item := range c.Items {
+		msg.Items = append(msg.Items, &closev1.ChecklistItem{
+			Key:         item.Key,
+			Title:       item.Title,
+			CompletedBy: uuidString(item.CompletedBy),
+			CompletedAt: optionalTimestamp(item.CompletedAt),
+			Note:        item.Note,
+		})
+	}
+	for _, s := range c.SignOffs {
+		msg.SignOffs = append(msg.SignOffs, &closev1.SignOff{
+			Role:     closev1.SignOffRole(closev1.SignOffRole_value["SIGN_OFF_ROLE_"+s.Role]),
+			UserId:   s.UserID.String(),
+			SignedAt: timestamppb.New(s.SignedAt),
+		})
+	}
+	return msg
+}
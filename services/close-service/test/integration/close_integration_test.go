@@ -0,0 +1,189 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/outbox"
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/close-service/internal/application/dto"
+	"github.com/bibbank/bib/services/close-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/close-service/internal/domain/model"
+	"github.com/bibbank/bib/services/close-service/internal/domain/port"
+	"github.com/bibbank/bib/services/close-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/close-service/internal/infrastructure/postgres"
+)
+
+const (
+	topic  = "close-events"
+	period = "2026-03"
+)
+
+// openLedger is a Ledger whose periods are all open, with nothing
+// unposted.
+type openLedger struct {
+	closed bool
+}
+
+func (l *openLedger) PeriodStatus(context.Context, valueobject.AccountingPeriod) (port.LedgerPeriod, error) {
+	return port.LedgerPeriod{Closed: l.closed}, nil
+}
+
+func (l *openLedger) ClosePeriod(context.Context, valueobject.AccountingPeriod) error {
+	l.closed = true
+	return nil
+}
+
+// noFailedAccruals is an AccrualRuns whose runs all succeeded.
+type noFailedAccruals struct{}
+
+func (noFailedAccruals) FailedAccruals(context.Context, valueobject.AccountingPeriod) ([]port.FailedAccrual, error) {
+	return nil, nil
+}
+
+// suite wires the use cases over the real repositories and outbox.
+type suite struct {
+	env       *testutil.Env
+	closes    *postgres.PeriodCloseRepo
+	breaks    *postgres.BreakRepo
+	publisher *postgres.OutboxPublisher
+	ledger    *openLedger
+	tenantID  uuid.UUID
+	preparer  uuid.UUID
+	reviewer  uuid.UUID
+}
+
+func setup(t *testing.T, withKafka bool) *suite {
+	t.Helper()
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir(), Kafka: withKafka})
+	return &suite{
+		env:       env,
+		closes:    postgres.NewPeriodCloseRepo(env.Pool()),
+		breaks:    postgres.NewBreakRepo(env.Pool()),
+		publisher: postgres.NewOutboxPublisher(outbox.NewPublisher(outbox.NewStore(env.Pool())), topic),
+		ledger:    &openLedger{},
+		tenantID:  uuid.New(),
+		preparer:  uuid.New(),
+		reviewer:  uuid.New(),
+	}
+}
+
+func (s *suite) checks() *usecase.Checks {
+	return usecase.NewChecks(s.ledger, s.breaks, noFailedAccruals{})
+}
+
+// signOff completes the checklist and has the preparer and the reviewer
+// sign it off.
+func (s *suite) signOff(t *testing.T) {
+	t.Helper()
+	ctx := context.Background()
+	for _, item := range model.DefaultChecklist {
+		_, err := usecase.NewCompleteItemUseCase(s.closes, s.publisher).Execute(ctx, dto.CompleteItemRequest{
+			TenantID: s.tenantID,
+			Period:   period,
+			ItemKey:  item.Key,
+			UserID:   s.preparer,
+			Note:     "done",
+		})
+		require.NoError(t, err)
+	}
+	signOff := usecase.NewSignOffUseCase(s.closes, s.publisher)
+	_, err := signOff.Execute(ctx, dto.SignOffRequest{
+		TenantID: s.tenantID, Period: period, Role: "PREPARER", UserID: s.preparer,
+	})
+	require.NoError(t, err)
+	_, err = signOff.Execute(ctx, dto.SignOffRequest{
+		TenantID: s.tenantID, Period: period, Role: "REVIEWER", UserID: s.reviewer,
+	})
+	require.NoError(t, err)
+}
+
+func TestPeriodClose_RoundTripAndClose(t *testing.T) {
+	s := setup(t, false)
+	ctx := context.Background()
+	s.signOff(t)
+
+	p, err := valueobject.ParseAccountingPeriod(period)
+	require.NoError(t, err)
+	pc, err := s.closes.Find(ctx, s.tenantID, p)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.CloseStatusSignedOff, pc.Status())
+	assert.Equal(t, 0, pc.OpenItems())
+	assert.Equal(t, "done", pc.Items()[0].Note)
+	reviewer, ok := pc.SignOffOf(valueobject.SignOffReviewer)
+	require.True(t, ok)
+	assert.Equal(t, s.reviewer, reviewer.UserID)
+
+	// A stale copy of the close is refused.
+	stale, err := pc.Close(s.reviewer, time.Now().UTC())
+	require.NoError(t, err)
+
+	resp, err := usecase.NewClosePeriodUseCase(s.closes, s.checks(), s.ledger, s.publisher).Execute(ctx, dto.ClosePeriodRequest{
+		TenantID: s.tenantID, Period: period, UserID: s.reviewer,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "CLOSED", resp.Status)
+	assert.True(t, s.ledger.closed)
+
+	err = s.closes.Save(ctx, stale)
+	assert.ErrorIs(t, err, port.ErrVersionConflict)
+}
+
+func TestBreaks_ResolvedBreaksStayResolved(t *testing.T) {
+	s := setup(t, false)
+	ctx := context.Background()
+	first, second := uuid.New(), uuid.New()
+	openedAt := time.Date(2026, time.March, 31, 17, 0, 0, 0, time.UTC)
+
+	require.NoError(t, s.breaks.Open(ctx, model.ReconciliationBreak{TaskID: first, TenantID: s.tenantID, SourceID: "stmt-1", Summary: "USD break", OpenedAt: openedAt}))
+	require.NoError(t, s.breaks.Open(ctx, model.ReconciliationBreak{TaskID: first, TenantID: s.tenantID, SourceID: "stmt-1", Summary: "USD break", OpenedAt: openedAt}))
+	// The resolution of the second break arrives before its opening.
+	require.NoError(t, s.breaks.Resolve(ctx, s.tenantID, second))
+	require.NoError(t, s.breaks.Open(ctx, model.ReconciliationBreak{TaskID: second, TenantID: s.tenantID, SourceID: "stmt-2", Summary: "EUR break", OpenedAt: openedAt}))
+
+	open, err := s.breaks.ListOpen(ctx, s.tenantID)
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	assert.Equal(t, first, open[0].TaskID)
+
+	require.NoError(t, s.breaks.Resolve(ctx, s.tenantID, first))
+	open, err = s.breaks.ListOpen(ctx, s.tenantID)
+	require.NoError(t, err)
+	assert.Empty(t, open)
+}
+
+func TestOutbox_RelaysEventsToKafka(t *testing.T) {
+	s := setup(t, true)
+	ctx := context.Background()
+	s.signOff(t)
+
+	producer := kafka.NewProducer(kafka.Config{Brokers: s.env.Brokers()})
+	t.Cleanup(func() { _ = producer.Close() })
+	relay, err := outbox.NewRelay(outbox.NewStore(s.env.Pool()), producer,
+		outbox.RelayConfig{Route: outbox.StaticRoute(topic)}, slog.Default())
+	require.NoError(t, err)
+
+	published, err := relay.Drain(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(model.DefaultChecklist)+2, published)
+
+	messages := s.env.Kafka.ReadMessages(t, topic, published, 30*time.Second)
+	var signOffs int
+	for _, msg := range messages {
+		for _, h := range msg.Headers {
+			if h.Key == "event_type" && string(h.Value) == "close.signed_off" {
+				signOffs++
+			}
+		}
+	}
+	assert.Equal(t, 2, signOffs)
+}
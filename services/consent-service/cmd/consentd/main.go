@@ -0,0 +1,210 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/bibbank/bib/pkg/auth"
+	pkgkafka "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
+	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
+	pkgpostgres "github.com/bibbank/bib/pkg/postgres"
+	"github.com/bibbank/bib/services/consent-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/consent-service/internal/infrastructure/config"
+	"github.com/bibbank/bib/services/consent-service/internal/infrastructure/postgres"
+	grpcpresentation "github.com/bibbank/bib/services/consent-service/internal/presentation/grpc"
+	"github.com/bibbank/bib/services/consent-service/internal/presentation/rest"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// Load configuration.
+	cfg := config.Load()
+
+	// Initialize structured logger via shared observability package.
+	logger := observability.InitLogger(observability.LogConfig{
+		Level:  "info",
+		Format: "json",
+	})
+	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
+
+	logger.Info("starting consent-service",
+		"http_port", cfg.HTTPPort,
+		"grpc_port", cfg.GRPCPort,
+	)
+
+	// Initialize tracing.
+	shutdown, err := observability.InitTracer(ctx, observability.TracingConfig{
+		ServiceName: cfg.ServiceName,
+		Endpoint:    getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4317"),
+		Insecure:    true,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
+	} else {
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
+	}
+
+	// Database connection.
+	dbCtx, dbCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer dbCancel()
+
+	pool, err := pkgpostgres.NewPool(dbCtx, pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	})
+	if err != nil {
+		logger.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
+	logger.Info("connected to database")
+
+	// Run database migrations.
+	migDSN := pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	}.DSN()
+	if migErr := pkgpostgres.RunMigrations(migDSN, "file://internal/infrastructure/postgres/migrations"); migErr != nil {
+		logger.Warn("migration warning", "error", migErr)
+	}
+
+	// Wire infrastructure adapters.
+	disclosureRepo := postgres.NewDisclosureRepo(pool)
+	receiptRepo := postgres.NewReceiptRepo(pool)
+	kafkaProducer := pkgkafka.NewProducer(pkgkafka.Config{
+		Brokers: cfg.Kafka.Brokers,
+	})
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(kafkaProducer.Close))
+
+	// Outbox relay: events are written to the outbox and published from it,
+	// so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     outbox.StaticRoute("consent-events"),
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: cfg.ServiceName,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, kafkaProducer, relayCfg, logger)
+	if err != nil {
+		logger.Error("failed to create outbox relay", "error", err)
+		os.Exit(1)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+	eventPublisher := postgres.NewOutboxPublisher(outbox.NewPublisher(outboxStore), "consent-events")
+
+	// JWT service for gRPC auth (validation-only: public key preferred, secret as fallback).
+	jwtCfg := auth.JWTConfig{
+		Issuer: "bib-gateway",
+	}
+	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
+	case os.Getenv("JWT_PUBLIC_KEY") != "":
+		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
+	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
+		keyData, loadErr := auth.LoadKeyFromFile(os.Getenv("JWT_PUBLIC_KEY_FILE"))
+		if loadErr != nil {
+			logger.Error("failed to load JWT public key file", "error", loadErr)
+			os.Exit(1)
+		}
+		jwtCfg.PublicKeyPEM = string(keyData)
+	default:
+		jwtSecret := os.Getenv("JWT_SECRET")
+		if jwtSecret == "" {
+			jwtSecret = "test-e2e-secret" // Match gateway default for E2E tests
+		}
+		jwtCfg.Secret = jwtSecret
+	}
+	jwtSvc, err := auth.NewJWTService(jwtCfg)
+	if err != nil {
+		logger.Error("failed to initialize JWT service", "error", err)
+		os.Exit(1)
+	}
+
+	// Wire use cases.
+	publishDisclosureUC := usecase.NewPublishDisclosureUseCase(disclosureRepo, eventPublisher)
+	listDisclosuresUC := usecase.NewListDisclosuresUseCase(disclosureRepo)
+	recordConsentUC := usecase.NewRecordConsentUseCase(receiptRepo, disclosureRepo, eventPublisher, cfg.Consent.CreditPullValidity)
+	checkConsentUC := usecase.NewCheckConsentUseCase(receiptRepo, disclosureRepo)
+	listConsentsUC := usecase.NewListConsentsUseCase(receiptRepo, disclosureRepo)
+	listReceiptsUC := usecase.NewListReceiptsUseCase(receiptRepo)
+	getReceiptUC := usecase.NewGetReceiptUseCase(receiptRepo)
+
+	// gRPC server.
+	grpcHandler := grpcpresentation.NewHandler(
+		publishDisclosureUC, listDisclosuresUC, recordConsentUC, checkConsentUC, listConsentsUC, listReceiptsUC, getReceiptUC, logger,
+	)
+	grpcServer, err := grpcpresentation.NewServer(grpcHandler, logger, jwtSvc, relayCfg.MeterProvider)
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
+
+	// HTTP server (health checks and metrics).
+	healthHandler := rest.NewHealthHandler(logger)
+	httpMux := http.NewServeMux()
+	healthHandler.RegisterRoutes(httpMux)
+	if metricsHandler != nil {
+		httpMux.Handle("/metrics", metricsHandler)
+	}
+
+	httpServer := &http.Server{
+		Addr:              cfg.HTTPAddr(),
+		Handler:           httpMux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Start servers.
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", func(context.Context) error {
+		return grpcServer.Start(cfg.GRPCAddr())
+	})
+	lc.OnStop(lifecycle.PhaseIngress, "gRPC server", lifecycle.Func(grpcServer.Stop))
+	lc.ServeHTTP("HTTP server", httpServer)
+
+	logger.Info("consent-service is running",
+		"grpc_addr", cfg.GRPCAddr(),
+		"http_addr", cfg.HTTPAddr(),
+	)
+
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
+	}
+	logger.Info("consent-service stopped")
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
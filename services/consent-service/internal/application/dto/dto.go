@@ -0,0 +1,115 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PublishDisclosureRequest is the input for publishing a disclosure
+// version. A zero EffectiveFrom publishes a version in effect at once.
+type PublishDisclosureRequest struct {
+	EffectiveFrom time.Time
+	ConsentType   string
+	Version       string
+	ContentHash   string
+	URL           string
+	TenantID      uuid.UUID
+}
+
+// ListDisclosuresRequest is the input for listing a tenant's disclosures.
+// An empty ConsentType does not filter.
+type ListDisclosuresRequest struct {
+	ConsentType string
+	Limit       int
+	Offset      int
+	TenantID    uuid.UUID
+}
+
+// DisclosureResponse is a disclosure version.
+type DisclosureResponse struct {
+	EffectiveFrom time.Time
+	PublishedAt   time.Time
+	ConsentType   string
+	Version       string
+	ContentHash   string
+	URL           string
+	ID            uuid.UUID
+	TenantID      uuid.UUID
+}
+
+// ListDisclosuresResponse is one page of disclosures.
+type ListDisclosuresResponse struct {
+	Disclosures []DisclosureResponse
+	TotalCount  int
+}
+
+// RecordConsentRequest is the input for recording a customer granting or
+// withdrawing consent. A grant without a DisclosureID cites the disclosure
+// of its type in effect; a nil ExpiresAt takes the type's default. A zero
+// CapturedAt means now.
+type RecordConsentRequest struct {
+	CapturedAt   time.Time
+	ExpiresAt    *time.Time
+	DisclosureID *uuid.UUID
+	ConsentType  string
+	Action       string
+	Channel      string
+	Evidence     string
+	TenantID     uuid.UUID
+	CustomerID   uuid.UUID
+}
+
+// ReceiptResponse is a consent receipt. Verified is only set when the
+// receipt was checked against its chain.
+type ReceiptResponse struct {
+	CapturedAt        time.Time
+	RecordedAt        time.Time
+	ExpiresAt         *time.Time
+	DisclosureID      *uuid.UUID
+	ConsentType       string
+	Action            string
+	DisclosureVersion string
+	ContentHash       string
+	Channel           string
+	Evidence          string
+	PreviousHash      string
+	Hash              string
+	Sequence          int
+	Verified          bool
+	ID                uuid.UUID
+	TenantID          uuid.UUID
+	CustomerID        uuid.UUID
+}
+
+// CheckConsentResponse is whether a customer's consent of a type stands.
+// ReceiptID is nil when the customer never gave consent.
+type CheckConsentResponse struct {
+	ReceiptID *uuid.UUID
+	Status    string
+	Granted   bool
+}
+
+// ConsentStateResponse is a customer's standing consent of one type.
+// Receipt is nil when the customer never gave consent.
+type ConsentStateResponse struct {
+	Receipt     *ReceiptResponse
+	ConsentType string
+	Status      string
+}
+
+// ListReceiptsRequest is the input for listing a customer's consent
+// receipts. An empty ConsentType does not filter.
+type ListReceiptsRequest struct {
+	ConsentType string
+	Limit       int
+	Offset      int
+	TenantID    uuid.UUID
+	CustomerID  uuid.UUID
+}
+
+// ListReceiptsResponse is one page of consent receipts.
+type ListReceiptsResponse struct {
+	Receipts   []ReceiptResponse
+	TotalCount int
+}
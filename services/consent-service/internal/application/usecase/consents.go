@@ -0,0 +1,323 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/consent-service/internal/application/dto"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/model"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/port"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/valueobject"
+)
+
+// ErrInvalidConsent is returned when a consent request is malformed.
+var ErrInvalidConsent = errors.New("invalid consent")
+
+// ErrNoDisclosure is returned when consent is granted for a type whose
+// tenant has not published a disclosure in effect.
+var ErrNoDisclosure = errors.New("no disclosure in effect")
+
+// RecordConsentUseCase records a customer granting or withdrawing consent.
+type RecordConsentUseCase struct {
+	receipts    port.ReceiptRepository
+	disclosures port.DisclosureRepository
+	publisher   port.EventPublisher
+	// creditPullValidity is how long credit pull consent stands when the
+	// request does not say.
+	creditPullValidity time.Duration
+}
+
+// NewRecordConsentUseCase creates a new RecordConsentUseCase.
+func NewRecordConsentUseCase(
+	receipts port.ReceiptRepository,
+	disclosures port.DisclosureRepository,
+	publisher port.EventPublisher,
+	creditPullValidity time.Duration,
+) *RecordConsentUseCase {
+	return &RecordConsentUseCase{
+		receipts:           receipts,
+		disclosures:        disclosures,
+		publisher:          publisher,
+		creditPullValidity: creditPullValidity,
+	}
+}
+
+// Execute appends the receipt to the customer's chain of the consent type.
+// It returns model.ErrConsentNotGranted for the withdrawal of consent not
+// given, and port.ErrReceiptConflict when a receipt was recorded for the
+// customer and type at the same time.
+func (uc *RecordConsentUseCase) Execute(ctx context.Context, req dto.RecordConsentRequest) (dto.ReceiptResponse, error) {
+	consentType, err := valueobject.NewConsentType(req.ConsentType)
+	if err != nil {
+		return dto.ReceiptResponse{}, fmt.Errorf("%w: %w", ErrInvalidConsent, err)
+	}
+	action, err := valueobject.NewConsentAction(req.Action)
+	if err != nil {
+		return dto.ReceiptResponse{}, fmt.Errorf("%w: %w", ErrInvalidConsent, err)
+	}
+	channel, err := valueobject.NewCaptureChannel(req.Channel)
+	if err != nil {
+		return dto.ReceiptResponse{}, fmt.Errorf("%w: %w", ErrInvalidConsent, err)
+	}
+	capture := model.Capture{CapturedAt: req.CapturedAt, Channel: channel, Evidence: req.Evidence}
+	now := time.Now().UTC()
+
+	previous, err := findLatest(ctx, uc.receipts, req.TenantID, req.CustomerID, consentType)
+	if err != nil {
+		return dto.ReceiptResponse{}, err
+	}
+
+	var receipt model.ConsentReceipt
+	if action.Equal(valueobject.ActionWithdrawn) {
+		receipt, err = model.WithdrawConsent(previous, req.TenantID, req.CustomerID, consentType, capture, now)
+	} else {
+		var disclosure *model.Disclosure
+		disclosure, err = uc.disclosure(ctx, req, consentType, now)
+		if err != nil {
+			return dto.ReceiptResponse{}, err
+		}
+		expiresAt := req.ExpiresAt
+		if expiresAt == nil && consentType.Equal(valueobject.ConsentCreditPull) && uc.creditPullValidity > 0 {
+			capturedAt := req.CapturedAt
+			if capturedAt.IsZero() {
+				capturedAt = now
+			}
+			t := capturedAt.Add(uc.creditPullValidity)
+			expiresAt = &t
+		}
+		receipt, err = model.GrantConsent(previous, req.TenantID, req.CustomerID, consentType, disclosure, capture, expiresAt, now)
+	}
+	if errors.Is(err, model.ErrConsentNotGranted) {
+		return dto.ReceiptResponse{}, err
+	}
+	if err != nil {
+		return dto.ReceiptResponse{}, fmt.Errorf("%w: %w", ErrInvalidConsent, err)
+	}
+
+	if err := uc.receipts.Append(ctx, receipt); err != nil {
+		return dto.ReceiptResponse{}, fmt.Errorf("failed to save consent receipt: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, receipt.DomainEvents()); err != nil {
+		return dto.ReceiptResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toReceiptResponse(receipt), nil
+}
+
+// disclosure returns the disclosure a grant cites: the one the request
+// names, or else the one of its type in effect. It returns nil for types
+// without disclosures.
+func (uc *RecordConsentUseCase) disclosure(ctx context.Context, req dto.RecordConsentRequest, consentType valueobject.ConsentType, now time.Time) (*model.Disclosure, error) {
+	if req.DisclosureID != nil {
+		d, err := uc.disclosures.FindByID(ctx, req.TenantID, *req.DisclosureID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find disclosure: %w", err)
+		}
+		return &d, nil
+	}
+	if !consentType.RequiresDisclosure() {
+		return nil, nil
+	}
+	d, err := uc.disclosures.FindCurrent(ctx, req.TenantID, consentType, now)
+	if errors.Is(err, port.ErrDisclosureNotFound) {
+		return nil, fmt.Errorf("%w for %s consent", ErrNoDisclosure, consentType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find disclosure: %w", err)
+	}
+	return &d, nil
+}
+
+// CheckConsentUseCase reports whether a customer's consent stands.
+type CheckConsentUseCase struct {
+	receipts    port.ReceiptRepository
+	disclosures port.DisclosureRepository
+}
+
+// NewCheckConsentUseCase creates a new CheckConsentUseCase.
+func NewCheckConsentUseCase(receipts port.ReceiptRepository, disclosures port.DisclosureRepository) *CheckConsentUseCase {
+	return &CheckConsentUseCase{receipts: receipts, disclosures: disclosures}
+}
+
+// Execute returns the status of the customer's consent of the type, with
+// the receipt it derives from.
+func (uc *CheckConsentUseCase) Execute(ctx context.Context, tenantID, customerID uuid.UUID, consentType string) (dto.CheckConsentResponse, error) {
+	t, err := valueobject.NewConsentType(consentType)
+	if err != nil {
+		return dto.CheckConsentResponse{}, fmt.Errorf("%w: %w", ErrInvalidConsent, err)
+	}
+	receipt, status, err := consentStatus(ctx, uc.receipts, uc.disclosures, tenantID, customerID, t, time.Now().UTC())
+	if err != nil {
+		return dto.CheckConsentResponse{}, err
+	}
+	resp := dto.CheckConsentResponse{Granted: status.Granted(), Status: status.String()}
+	if receipt != nil {
+		id := receipt.ID()
+		resp.ReceiptID = &id
+	}
+	return resp, nil
+}
+
+// ListConsentsUseCase reports a customer's standing consent of every type.
+type ListConsentsUseCase struct {
+	receipts    port.ReceiptRepository
+	disclosures port.DisclosureRepository
+}
+
+// NewListConsentsUseCase creates a new ListConsentsUseCase.
+func NewListConsentsUseCase(receipts port.ReceiptRepository, disclosures port.DisclosureRepository) *ListConsentsUseCase {
+	return &ListConsentsUseCase{receipts: receipts, disclosures: disclosures}
+}
+
+// Execute returns one state per consent type.
+func (uc *ListConsentsUseCase) Execute(ctx context.Context, tenantID, customerID uuid.UUID) ([]dto.ConsentStateResponse, error) {
+	now := time.Now().UTC()
+	states := make([]dto.ConsentStateResponse, 0, len(valueobject.ConsentTypes))
+	for _, t := range valueobject.ConsentTypes {
+		receipt, status, err := consentStatus(ctx, uc.receipts, uc.disclosures, tenantID, customerID, t, now)
+		if err != nil {
+			return nil, err
+		}
+		state := dto.ConsentStateResponse{ConsentType: t.String(), Status: status.String()}
+		if receipt != nil {
+			r := toReceiptResponse(*receipt)
+			state.Receipt = &r
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// ListReceiptsUseCase lists a customer's consent receipts.
+type ListReceiptsUseCase struct {
+	receipts port.ReceiptRepository
+}
+
+// NewListReceiptsUseCase creates a new ListReceiptsUseCase.
+func NewListReceiptsUseCase(receipts port.ReceiptRepository) *ListReceiptsUseCase {
+	return &ListReceiptsUseCase{receipts: receipts}
+}
+
+// Execute returns one page of the customer's receipts, newest first.
+func (uc *ListReceiptsUseCase) Execute(ctx context.Context, req dto.ListReceiptsRequest) (dto.ListReceiptsResponse, error) {
+	filter := port.ReceiptFilter{TenantID: req.TenantID, CustomerID: req.CustomerID}
+	if req.ConsentType != "" {
+		t, err := valueobject.NewConsentType(req.ConsentType)
+		if err != nil {
+			return dto.ListReceiptsResponse{}, fmt.Errorf("%w: %w", ErrInvalidConsent, err)
+		}
+		filter.ConsentType = t
+	}
+	limit, offset := page(req.Limit, req.Offset)
+	receipts, total, err := uc.receipts.List(ctx, filter, limit, offset)
+	if err != nil {
+		return dto.ListReceiptsResponse{}, fmt.Errorf("failed to list consent receipts: %w", err)
+	}
+	resp := dto.ListReceiptsResponse{
+		Receipts:   make([]dto.ReceiptResponse, 0, len(receipts)),
+		TotalCount: total,
+	}
+	for _, r := range receipts {
+		resp.Receipts = append(resp.Receipts, toReceiptResponse(r))
+	}
+	return resp, nil
+}
+
+// GetReceiptUseCase retrieves a consent receipt and verifies it.
+type GetReceiptUseCase struct {
+	receipts port.ReceiptRepository
+}
+
+// NewGetReceiptUseCase creates a new GetReceiptUseCase.
+func NewGetReceiptUseCase(receipts port.ReceiptRepository) *GetReceiptUseCase {
+	return &GetReceiptUseCase{receipts: receipts}
+}
+
+// Execute retrieves the receipt and checks its hash against its content and
+// the receipt before it.
+func (uc *GetReceiptUseCase) Execute(ctx context.Context, tenantID, receiptID uuid.UUID) (dto.ReceiptResponse, error) {
+	receipt, err := uc.receipts.FindByID(ctx, tenantID, receiptID)
+	if err != nil {
+		return dto.ReceiptResponse{}, fmt.Errorf("failed to find consent receipt: %w", err)
+	}
+	var previous *model.ConsentReceipt
+	if receipt.Sequence() > 1 {
+		p, err := uc.receipts.FindBySequence(ctx, tenantID, receipt.CustomerID(), receipt.ConsentType(), receipt.Sequence()-1)
+		switch {
+		case errors.Is(err, port.ErrReceiptNotFound):
+			// A missing predecessor breaks the chain, so the receipt
+			// fails to verify.
+			return toReceiptResponse(receipt), nil
+		case err != nil:
+			return dto.ReceiptResponse{}, fmt.Errorf("failed to find previous consent receipt: %w", err)
+		}
+		previous = &p
+	}
+	resp := toReceiptResponse(receipt)
+	resp.Verified = receipt.Verify(previous)
+	return resp, nil
+}
+
+// findLatest returns the customer's latest receipt of the type, or nil when
+// they have none.
+func findLatest(ctx context.Context, receipts port.ReceiptRepository, tenantID, customerID uuid.UUID, consentType valueobject.ConsentType) (*model.ConsentReceipt, error) {
+	r, err := receipts.FindLatest(ctx, tenantID, customerID, consentType)
+	if errors.Is(err, port.ErrReceiptNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find consent receipt: %w", err)
+	}
+	return &r, nil
+}
+
+// consentStatus returns the customer's latest receipt of the type, nil when
+// they have none, and the status of their consent at now.
+func consentStatus(
+	ctx context.Context,
+	receipts port.ReceiptRepository,
+	disclosures port.DisclosureRepository,
+	tenantID, customerID uuid.UUID,
+	consentType valueobject.ConsentType,
+	now time.Time,
+) (*model.ConsentReceipt, valueobject.ConsentStatus, error) {
+	receipt, err := findLatest(ctx, receipts, tenantID, customerID, consentType)
+	if err != nil || receipt == nil {
+		return nil, valueobject.StatusNotGiven, err
+	}
+	var current *model.Disclosure
+	if consentType.RequiresCurrentVersion() {
+		d, err := disclosures.FindCurrent(ctx, tenantID, consentType, now)
+		switch {
+		case err == nil:
+			current = &d
+		case !errors.Is(err, port.ErrDisclosureNotFound):
+			return nil, valueobject.ConsentStatus{}, fmt.Errorf("failed to find disclosure: %w", err)
+		}
+	}
+	return receipt, receipt.Status(current, now), nil
+}
+
+func toReceiptResponse(r model.ConsentReceipt) dto.ReceiptResponse {
+	return dto.ReceiptResponse{
+		ID:                r.ID(),
+		TenantID:          r.TenantID(),
+		CustomerID:        r.CustomerID(),
+		ConsentType:       r.ConsentType().String(),
+		Action:            r.Action().String(),
+		DisclosureID:      r.DisclosureID(),
+		DisclosureVersion: r.DisclosureVersion(),
+		ContentHash:       r.ContentHash(),
+		Channel:           r.Channel().String(),
+		Evidence:          r.Evidence(),
+		CapturedAt:        r.CapturedAt(),
+		ExpiresAt:         r.ExpiresAt(),
+		Sequence:          r.Sequence(),
+		PreviousHash:      r.PreviousHash(),
+		Hash:              r.Hash(),
+		RecordedAt:        r.RecordedAt(),
+	}
+}
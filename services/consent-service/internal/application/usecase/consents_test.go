@@ -0,0 +1,271 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/consent-service/internal/application/dto"
+	"github.com/bibbank/bib/services/consent-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/model"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/port"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/valueobject"
+)
+
+// inMemoryReceiptRepo is an in-memory ReceiptRepository.
+type inMemoryReceiptRepo struct {
+	receipts []model.ConsentReceipt
+}
+
+func (r *inMemoryReceiptRepo) Append(ctx context.Context, receipt model.ConsentReceipt) error {
+	if _, err := r.FindBySequence(ctx, receipt.TenantID(), receipt.CustomerID(), receipt.ConsentType(), receipt.Sequence()); err == nil {
+		return port.ErrReceiptConflict
+	}
+	r.receipts = append(r.receipts, receipt.ClearDomainEvents())
+	return nil
+}
+
+func (r *inMemoryReceiptRepo) FindLatest(_ context.Context, tenantID, customerID uuid.UUID, consentType valueobject.ConsentType) (model.ConsentReceipt, error) {
+	var found *model.ConsentReceipt
+	for _, rc := range r.receipts {
+		if rc.TenantID() == tenantID && rc.CustomerID() == customerID && rc.ConsentType() == consentType &&
+			(found == nil || rc.Sequence() > found.Sequence()) {
+			found = &rc
+		}
+	}
+	if found == nil {
+		return model.ConsentReceipt{}, port.ErrReceiptNotFound
+	}
+	return *found, nil
+}
+
+func (r *inMemoryReceiptRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.ConsentReceipt, error) {
+	for _, rc := range r.receipts {
+		if rc.TenantID() == tenantID && rc.ID() == id {
+			return rc, nil
+		}
+	}
+	return model.ConsentReceipt{}, port.ErrReceiptNotFound
+}
+
+func (r *inMemoryReceiptRepo) FindBySequence(_ context.Context, tenantID, customerID uuid.UUID, consentType valueobject.ConsentType, sequence int) (model.ConsentReceipt, error) {
+	for _, rc := range r.receipts {
+		if rc.TenantID() == tenantID && rc.CustomerID() == customerID && rc.ConsentType() == consentType && rc.Sequence() == sequence {
+			return rc, nil
+		}
+	}
+	return model.ConsentReceipt{}, port.ErrReceiptNotFound
+}
+
+func (r *inMemoryReceiptRepo) List(_ context.Context, filter port.ReceiptFilter, limit, offset int) ([]model.ConsentReceipt, int, error) {
+	var out []model.ConsentReceipt
+	for _, rc := range slices.Backward(r.receipts) {
+		if rc.TenantID() == filter.TenantID && rc.CustomerID() == filter.CustomerID &&
+			(filter.ConsentType.IsZero() || rc.ConsentType() == filter.ConsentType) {
+			out = append(out, rc)
+		}
+	}
+	total := len(out)
+	return out[min(offset, total):min(offset+limit, total)], total, nil
+}
+
+// consentFixture wires the consent use cases over in-memory adapters for
+// one customer of one tenant.
+type consentFixture struct {
+	disclosures *inMemoryDisclosureRepo
+	receipts    *inMemoryReceiptRepo
+	publisher   *recordingPublisher
+	tenantID    uuid.UUID
+	customerID  uuid.UUID
+}
+
+func newConsentFixture() *consentFixture {
+	return &consentFixture{
+		disclosures: &inMemoryDisclosureRepo{},
+		receipts:    &inMemoryReceiptRepo{},
+		publisher:   &recordingPublisher{},
+		tenantID:    uuid.New(),
+		customerID:  uuid.New(),
+	}
+}
+
+// publish publishes a disclosure of the type in effect at once.
+func (f *consentFixture) publish(t *testing.T, consentType, version string) dto.DisclosureResponse {
+	t.Helper()
+	d, err := usecase.NewPublishDisclosureUseCase(f.disclosures, f.publisher).Execute(context.Background(), dto.PublishDisclosureRequest{
+		TenantID:    f.tenantID,
+		ConsentType: consentType,
+		Version:     version,
+		ContentHash: contentHash,
+	})
+	require.NoError(t, err)
+	return d
+}
+
+func (f *consentFixture) record() *usecase.RecordConsentUseCase {
+	return usecase.NewRecordConsentUseCase(f.receipts, f.disclosures, f.publisher, 30*24*time.Hour)
+}
+
+func (f *consentFixture) check() *usecase.CheckConsentUseCase {
+	return usecase.NewCheckConsentUseCase(f.receipts, f.disclosures)
+}
+
+func (f *consentFixture) recordRequest(consentType, action string) dto.RecordConsentRequest {
+	return recordRequest(f.tenantID, f.customerID, consentType, action)
+}
+
+func recordRequest(tenantID, customerID uuid.UUID, consentType, action string) dto.RecordConsentRequest {
+	return dto.RecordConsentRequest{
+		TenantID:    tenantID,
+		CustomerID:  customerID,
+		ConsentType: consentType,
+		Action:      action,
+		Channel:     "ONLINE",
+		Evidence:    "settings page, consent toggle",
+	}
+}
+
+func TestRecordConsent_GrantCitesCurrentDisclosure(t *testing.T) {
+	f := newConsentFixture()
+	ctx := context.Background()
+	v1 := f.publish(t, "TERMS_OF_SERVICE", "2026-01")
+
+	receipt, err := f.record().Execute(ctx, f.recordRequest("TERMS_OF_SERVICE", "GRANTED"))
+	require.NoError(t, err)
+	require.NotNil(t, receipt.DisclosureID)
+	assert.Equal(t, v1.ID, *receipt.DisclosureID)
+	assert.Equal(t, "2026-01", receipt.DisclosureVersion)
+	assert.Equal(t, contentHash, receipt.ContentHash)
+	assert.Nil(t, receipt.ExpiresAt)
+
+	check, err := f.check().Execute(ctx, f.tenantID, f.customerID, "TERMS_OF_SERVICE")
+	require.NoError(t, err)
+	assert.True(t, check.Granted)
+	assert.Equal(t, receipt.ID, *check.ReceiptID)
+
+	// A new version of the terms outdates the acceptance until the
+	// customer accepts it.
+	f.publish(t, "TERMS_OF_SERVICE", "2026-06")
+	check, err = f.check().Execute(ctx, f.tenantID, f.customerID, "TERMS_OF_SERVICE")
+	require.NoError(t, err)
+	assert.False(t, check.Granted)
+	assert.Equal(t, "OUTDATED", check.Status)
+
+	_, err = f.record().Execute(ctx, f.recordRequest("TERMS_OF_SERVICE", "GRANTED"))
+	require.NoError(t, err)
+	check, err = f.check().Execute(ctx, f.tenantID, f.customerID, "TERMS_OF_SERVICE")
+	require.NoError(t, err)
+	assert.True(t, check.Granted)
+
+	assert.Equal(t, []string{
+		"consent.disclosure.published", "consent.granted", "consent.disclosure.published", "consent.granted",
+	}, f.publisher.eventTypes())
+}
+
+func TestRecordConsent_RequiresDisclosure(t *testing.T) {
+	f := newConsentFixture()
+	_, err := f.record().Execute(context.Background(), f.recordRequest("CREDIT_PULL", "GRANTED"))
+	assert.ErrorIs(t, err, usecase.ErrNoDisclosure)
+	assert.Empty(t, f.receipts.receipts)
+}
+
+func TestRecordConsent_CreditPullLapses(t *testing.T) {
+	f := newConsentFixture()
+	f.publish(t, "CREDIT_PULL", "v1")
+
+	receipt, err := f.record().Execute(context.Background(), f.recordRequest("CREDIT_PULL", "GRANTED"))
+	require.NoError(t, err)
+	require.NotNil(t, receipt.ExpiresAt)
+	assert.WithinDuration(t, receipt.CapturedAt.Add(30*24*time.Hour), *receipt.ExpiresAt, time.Second)
+
+	req := f.recordRequest("CREDIT_PULL", "GRANTED")
+	expiresAt := time.Now().Add(time.Hour)
+	req.ExpiresAt = &expiresAt
+	receipt, err = f.record().Execute(context.Background(), req)
+	require.NoError(t, err)
+	assert.WithinDuration(t, expiresAt, *receipt.ExpiresAt, time.Microsecond)
+}
+
+func TestRecordConsent_Withdraw(t *testing.T) {
+	f := newConsentFixture()
+	ctx := context.Background()
+
+	_, err := f.record().Execute(ctx, f.recordRequest("MARKETING_EMAIL", "WITHDRAWN"))
+	assert.ErrorIs(t, err, model.ErrConsentNotGranted)
+
+	_, err = f.record().Execute(ctx, f.recordRequest("MARKETING_EMAIL", "GRANTED"))
+	require.NoError(t, err)
+	withdrawn, err := f.record().Execute(ctx, f.recordRequest("MARKETING_EMAIL", "WITHDRAWN"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, withdrawn.Sequence)
+
+	check, err := f.check().Execute(ctx, f.tenantID, f.customerID, "MARKETING_EMAIL")
+	require.NoError(t, err)
+	assert.False(t, check.Granted)
+	assert.Equal(t, "WITHDRAWN", check.Status)
+	assert.Equal(t, []string{"consent.granted", "consent.withdrawn"}, f.publisher.eventTypes())
+}
+
+func TestRecordConsent_Validation(t *testing.T) {
+	f := newConsentFixture()
+	badChannel := f.recordRequest("MARKETING_SMS", "GRANTED")
+	badChannel.Channel = "EMAIL"
+	for name, req := range map[string]dto.RecordConsentRequest{
+		"unknown type":    f.recordRequest("MARKETING_FAX", "GRANTED"),
+		"unknown action":  f.recordRequest("MARKETING_SMS", "REVOKED"),
+		"unknown channel": badChannel,
+	} {
+		_, err := f.record().Execute(context.Background(), req)
+		assert.ErrorIs(t, err, usecase.ErrInvalidConsent, name)
+	}
+}
+
+func TestListConsents(t *testing.T) {
+	f := newConsentFixture()
+	ctx := context.Background()
+	_, err := f.record().Execute(ctx, f.recordRequest("MARKETING_PUSH", "GRANTED"))
+	require.NoError(t, err)
+
+	states, err := usecase.NewListConsentsUseCase(f.receipts, f.disclosures).Execute(ctx, f.tenantID, f.customerID)
+	require.NoError(t, err)
+	require.Len(t, states, 6)
+	for _, s := range states {
+		if s.ConsentType == "MARKETING_PUSH" {
+			assert.Equal(t, "GRANTED", s.Status)
+			assert.NotNil(t, s.Receipt)
+		} else {
+			assert.Equal(t, "NOT_GIVEN", s.Status, s.ConsentType)
+			assert.Nil(t, s.Receipt)
+		}
+	}
+}
+
+func TestGetReceipt_Verifies(t *testing.T) {
+	f := newConsentFixture()
+	ctx := context.Background()
+	_, err := f.record().Execute(ctx, f.recordRequest("MARKETING_SMS", "GRANTED"))
+	require.NoError(t, err)
+	second, err := f.record().Execute(ctx, f.recordRequest("MARKETING_SMS", "WITHDRAWN"))
+	require.NoError(t, err)
+
+	got, err := usecase.NewGetReceiptUseCase(f.receipts).Execute(ctx, f.tenantID, second.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Verified)
+
+	// Removing the first receipt breaks the chain.
+	f.receipts.receipts = f.receipts.receipts[1:]
+	got, err = usecase.NewGetReceiptUseCase(f.receipts).Execute(ctx, f.tenantID, second.ID)
+	require.NoError(t, err)
+	assert.False(t, got.Verified)
+
+	list, err := usecase.NewListReceiptsUseCase(f.receipts).Execute(ctx, dto.ListReceiptsRequest{
+		TenantID: f.tenantID, CustomerID: f.customerID, ConsentType: "MARKETING_SMS",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, list.TotalCount)
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/consent-service/internal/application/dto"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/model"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/port"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/valueobject"
+)
+
+// ErrInvalidDisclosure is returned when a disclosure request is malformed.
+var ErrInvalidDisclosure = errors.New("invalid disclosure")
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// PublishDisclosureUseCase publishes a disclosure version.
+type PublishDisclosureUseCase struct {
+	disclosures port.DisclosureRepository
+	publisher   port.EventPublisher
+}
+
+// NewPublishDisclosureUseCase creates a new PublishDisclosureUseCase.
+func NewPublishDisclosureUseCase(disclosures port.DisclosureRepository, publisher port.EventPublisher) *PublishDisclosureUseCase {
+	return &PublishDisclosureUseCase{disclosures: disclosures, publisher: publisher}
+}
+
+// Execute publishes the disclosure. From its effective date on, grants
+// cite it by default, and consent of types that require the current
+// version stands only for it.
+func (uc *PublishDisclosureUseCase) Execute(ctx context.Context, req dto.PublishDisclosureRequest) (dto.DisclosureResponse, error) {
+	consentType, err := valueobject.NewConsentType(req.ConsentType)
+	if err != nil {
+		return dto.DisclosureResponse{}, fmt.Errorf("%w: %w", ErrInvalidDisclosure, err)
+	}
+	disclosure, err := model.NewDisclosure(req.TenantID, consentType, req.Version, req.ContentHash, req.URL, req.EffectiveFrom, time.Now().UTC())
+	if err != nil {
+		return dto.DisclosureResponse{}, fmt.Errorf("%w: %w", ErrInvalidDisclosure, err)
+	}
+
+	if err := uc.disclosures.Save(ctx, disclosure); err != nil {
+		return dto.DisclosureResponse{}, fmt.Errorf("failed to save disclosure: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, disclosure.DomainEvents()); err != nil {
+		return dto.DisclosureResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toDisclosureResponse(disclosure), nil
+}
+
+// ListDisclosuresUseCase lists a tenant's disclosures.
+type ListDisclosuresUseCase struct {
+	disclosures port.DisclosureRepository
+}
+
+// NewListDisclosuresUseCase creates a new ListDisclosuresUseCase.
+func NewListDisclosuresUseCase(disclosures port.DisclosureRepository) *ListDisclosuresUseCase {
+	return &ListDisclosuresUseCase{disclosures: disclosures}
+}
+
+// Execute returns one page of the tenant's disclosures.
+func (uc *ListDisclosuresUseCase) Execute(ctx context.Context, req dto.ListDisclosuresRequest) (dto.ListDisclosuresResponse, error) {
+	var consentType valueobject.ConsentType
+	if req.ConsentType != "" {
+		t, err := valueobject.NewConsentType(req.ConsentType)
+		if err != nil {
+			return dto.ListDisclosuresResponse{}, fmt.Errorf("%w: %w", ErrInvalidDisclosure, err)
+		}
+		consentType = t
+	}
+	limit, offset := page(req.Limit, req.Offset)
+	disclosures, total, err := uc.disclosures.List(ctx, req.TenantID, consentType, limit, offset)
+	if err != nil {
+		return dto.ListDisclosuresResponse{}, fmt.Errorf("failed to list disclosures: %w", err)
+	}
+	resp := dto.ListDisclosuresResponse{
+		Disclosures: make([]dto.DisclosureResponse, 0, len(disclosures)),
+		TotalCount:  total,
+	}
+	for _, d := range disclosures {
+		resp.Disclosures = append(resp.Disclosures, toDisclosureResponse(d))
+	}
+	return resp, nil
+}
+
+func page(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	return min(limit, maxLimit), max(offset, 0)
+}
+
+func toDisclosureResponse(d model.Disclosure) dto.DisclosureResponse {
+	return dto.DisclosureResponse{
+		ID:            d.ID(),
+		TenantID:      d.TenantID(),
+		ConsentType:   d.ConsentType().String(),
+		Version:       d.Version(),
+		ContentHash:   d.ContentHash(),
+		URL:           d.URL(),
+		EffectiveFrom: d.EffectiveFrom(),
+		PublishedAt:   d.PublishedAt(),
+	}
+}
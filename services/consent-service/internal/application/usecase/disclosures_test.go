@@ -0,0 +1,121 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/consent-service/internal/application/dto"
+	"github.com/bibbank/bib/services/consent-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/event"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/model"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/port"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/valueobject"
+)
+
+const contentHash = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+// inMemoryDisclosureRepo is an in-memory DisclosureRepository.
+type inMemoryDisclosureRepo struct {
+	disclosures []model.Disclosure
+}
+
+func (r *inMemoryDisclosureRepo) Save(_ context.Context, disclosure model.Disclosure) error {
+	for _, d := range r.disclosures {
+		if d.TenantID() == disclosure.TenantID() && d.ConsentType() == disclosure.ConsentType() && d.Version() == disclosure.Version() {
+			return port.ErrDisclosureExists
+		}
+	}
+	r.disclosures = append(r.disclosures, disclosure.ClearDomainEvents())
+	return nil
+}
+
+func (r *inMemoryDisclosureRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.Disclosure, error) {
+	for _, d := range r.disclosures {
+		if d.TenantID() == tenantID && d.ID() == id {
+			return d, nil
+		}
+	}
+	return model.Disclosure{}, port.ErrDisclosureNotFound
+}
+
+func (r *inMemoryDisclosureRepo) FindCurrent(_ context.Context, tenantID uuid.UUID, consentType valueobject.ConsentType, at time.Time) (model.Disclosure, error) {
+	var found *model.Disclosure
+	for _, d := range r.disclosures {
+		if d.TenantID() == tenantID && d.ConsentType() == consentType && d.InEffect(at) &&
+			(found == nil || !d.EffectiveFrom().Before(found.EffectiveFrom())) {
+			found = &d
+		}
+	}
+	if found == nil {
+		return model.Disclosure{}, port.ErrDisclosureNotFound
+	}
+	return *found, nil
+}
+
+func (r *inMemoryDisclosureRepo) List(_ context.Context, tenantID uuid.UUID, consentType valueobject.ConsentType, limit, offset int) ([]model.Disclosure, int, error) {
+	var out []model.Disclosure
+	for _, d := range r.disclosures {
+		if d.TenantID() == tenantID && (consentType.IsZero() || d.ConsentType() == consentType) {
+			out = append(out, d)
+		}
+	}
+	total := len(out)
+	return out[min(offset, total):min(offset+limit, total)], total, nil
+}
+
+// recordingPublisher is an EventPublisher that records what it publishes.
+type recordingPublisher struct {
+	events []event.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, events []event.DomainEvent) error {
+	p.events = append(p.events, events...)
+	return nil
+}
+
+func (p *recordingPublisher) eventTypes() []string {
+	types := make([]string, len(p.events))
+	for i, e := range p.events {
+		types[i] = e.EventType()
+	}
+	return types
+}
+
+func TestPublishDisclosure(t *testing.T) {
+	ctx := context.Background()
+	disclosures, publisher := &inMemoryDisclosureRepo{}, &recordingPublisher{}
+	tenantID := uuid.New()
+	publish := usecase.NewPublishDisclosureUseCase(disclosures, publisher)
+	req := dto.PublishDisclosureRequest{
+		TenantID:      tenantID,
+		ConsentType:   "ESIGN",
+		Version:       "v2",
+		ContentHash:   contentHash,
+		URL:           "https://bank.example/esign/v2",
+		EffectiveFrom: time.Now().Add(24 * time.Hour),
+	}
+	_, err := publish.Execute(ctx, req)
+	require.NoError(t, err)
+
+	_, err = publish.Execute(ctx, req)
+	assert.ErrorIs(t, err, port.ErrDisclosureExists)
+
+	req.ConsentType = "MARKETING_EMAIL"
+	_, err = publish.Execute(ctx, req)
+	assert.ErrorIs(t, err, usecase.ErrInvalidDisclosure)
+
+	// A disclosure taking effect tomorrow cannot be consented to today.
+	record := usecase.NewRecordConsentUseCase(&inMemoryReceiptRepo{}, disclosures, publisher, 30*24*time.Hour)
+	_, err = record.Execute(ctx, recordRequest(tenantID, uuid.New(), "ESIGN", "GRANTED"))
+	assert.ErrorIs(t, err, usecase.ErrNoDisclosure)
+
+	list, err := usecase.NewListDisclosuresUseCase(disclosures).Execute(ctx, dto.ListDisclosuresRequest{TenantID: tenantID, ConsentType: "ESIGN"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, list.TotalCount)
+	assert.Equal(t, []string{"consent.disclosure.published"}, publisher.eventTypes())
+}
@@ -0,0 +1,13 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[DisclosurePublished]("consent.disclosure.published", 1, "Emitted when a disclosure version is published."),
+		events.Declare[ConsentGranted]("consent.granted", 1, "Emitted when a customer gives consent."),
+		events.Declare[ConsentWithdrawn]("consent.withdrawn", 1, "Emitted when a customer withdraws consent."),
+	}
+}
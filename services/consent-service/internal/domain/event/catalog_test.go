@@ -0,0 +1,12 @@
+package event_test
+
+import (
+	"testing"
+
+	"github.com/bibbank/bib/pkg/events/catalogtest"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/event"
+)
+
+func TestEventCatalog(t *testing.T) {
+	catalogtest.CheckCatalog(t, "../../../../../events/consent-service.json", "consent-service", event.Catalog())
+}
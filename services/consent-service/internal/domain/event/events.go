@@ -0,0 +1,96 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// DomainEvent is an alias for the shared pkg/events.DomainEvent interface.
+type DomainEvent = events.DomainEvent
+
+// Aggregate types of consent events. Consent events are keyed by customer,
+// so a customer's grants and withdrawals are consumed in order.
+const (
+	AggregateTypeDisclosure = "Disclosure"
+	AggregateTypeConsent    = "CustomerConsent"
+)
+
+// DisclosurePublished is emitted when a disclosure version is published.
+type DisclosurePublished struct {
+	EffectiveFrom time.Time `json:"effective_from"`
+	events.BaseEvent
+	ConsentType string `json:"consent_type"`
+	Version     string `json:"version"`
+	ContentHash string `json:"content_hash"`
+	URL         string `json:"url,omitempty"`
+}
+
+// NewDisclosurePublished creates a new DisclosurePublished event.
+func NewDisclosurePublished(disclosureID, tenantID uuid.UUID, consentType, version, contentHash, url string, effectiveFrom time.Time) DisclosurePublished {
+	return DisclosurePublished{
+		BaseEvent:     events.NewBaseEvent("consent.disclosure.published", disclosureID.String(), AggregateTypeDisclosure, tenantID.String()),
+		ConsentType:   consentType,
+		Version:       version,
+		ContentHash:   contentHash,
+		URL:           url,
+		EffectiveFrom: effectiveFrom,
+	}
+}
+
+// ConsentGranted is emitted when a customer gives consent. DisclosureID is
+// nil for consent types without disclosures, and ExpiresAt for consent
+// that does not lapse.
+type ConsentGranted struct {
+	CapturedAt   time.Time  `json:"captured_at"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	DisclosureID *uuid.UUID `json:"disclosure_id,omitempty"`
+	events.BaseEvent
+	ReceiptID         string `json:"receipt_id"`
+	ConsentType       string `json:"consent_type"`
+	DisclosureVersion string `json:"disclosure_version,omitempty"`
+	Channel           string `json:"channel"`
+}
+
+// NewConsentGranted creates a new ConsentGranted event.
+func NewConsentGranted(
+	customerID, tenantID, receiptID uuid.UUID,
+	consentType string,
+	disclosureID *uuid.UUID,
+	disclosureVersion, channel string,
+	capturedAt time.Time,
+	expiresAt *time.Time,
+) ConsentGranted {
+	return ConsentGranted{
+		BaseEvent:         events.NewBaseEvent("consent.granted", customerID.String(), AggregateTypeConsent, tenantID.String()),
+		ReceiptID:         receiptID.String(),
+		ConsentType:       consentType,
+		DisclosureID:      disclosureID,
+		DisclosureVersion: disclosureVersion,
+		Channel:           channel,
+		CapturedAt:        capturedAt,
+		ExpiresAt:         expiresAt,
+	}
+}
+
+// ConsentWithdrawn is emitted when a customer withdraws consent.
+type ConsentWithdrawn struct {
+	CapturedAt time.Time `json:"captured_at"`
+	events.BaseEvent
+	ReceiptID   string `json:"receipt_id"`
+	ConsentType string `json:"consent_type"`
+	Channel     string `json:"channel"`
+}
+
+// NewConsentWithdrawn creates a new ConsentWithdrawn event.
+func NewConsentWithdrawn(customerID, tenantID, receiptID uuid.UUID, consentType, channel string, capturedAt time.Time) ConsentWithdrawn {
+	return ConsentWithdrawn{
+		BaseEvent:   events.NewBaseEvent("consent.withdrawn", customerID.String(), AggregateTypeConsent, tenantID.String()),
+		ReceiptID:   receiptID.String(),
+		ConsentType: consentType,
+		Channel:     channel,
+		CapturedAt:  capturedAt,
+	}
+}
@@ -0,0 +1,315 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/event"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/valueobject"
+)
+
+// MaxEvidenceLength bounds the evidence recorded with a receipt.
+const MaxEvidenceLength = 2000
+
+// ErrConsentNotGranted is returned when a customer withdraws consent they
+// have not given.
+var ErrConsentNotGranted = errors.New("consent not granted")
+
+// Capture describes how a customer gave or withdrew consent. A zero
+// CapturedAt means at the time the receipt is recorded.
+type Capture struct {
+	CapturedAt time.Time
+	Channel    valueobject.CaptureChannel
+	Evidence   string
+}
+
+// ConsentReceipt is the immutable record of a customer granting or
+// withdrawing consent of a type. A customer's receipts of a type form a
+// hash chain: each receipt's hash covers its content and the hash of the
+// receipt before it, so an altered or removed receipt breaks the chain.
+// The latest receipt is the customer's standing consent.
+type ConsentReceipt struct {
+	capturedAt        time.Time
+	recordedAt        time.Time
+	expiresAt         *time.Time
+	disclosureID      *uuid.UUID
+	consentType       valueobject.ConsentType
+	action            valueobject.ConsentAction
+	channel           valueobject.CaptureChannel
+	disclosureVersion string
+	contentHash       string
+	evidence          string
+	previousHash      string
+	hash              string
+	domainEvents      []events.DomainEvent
+	sequence          int
+	id                uuid.UUID
+	tenantID          uuid.UUID
+	customerID        uuid.UUID
+}
+
+// GrantConsent records a customer giving consent of a type, following
+// previous, the customer's latest receipt of the type, or nil for their
+// first. disclosure is the version the customer was shown, required for
+// types with disclosures and nil otherwise. expiresAt is nil for consent
+// that does not lapse.
+func GrantConsent(
+	previous *ConsentReceipt,
+	tenantID, customerID uuid.UUID,
+	consentType valueobject.ConsentType,
+	disclosure *Disclosure,
+	capture Capture,
+	expiresAt *time.Time,
+	now time.Time,
+) (ConsentReceipt, error) {
+	r, err := newReceipt(previous, tenantID, customerID, consentType, valueobject.ActionGranted, capture, now)
+	if err != nil {
+		return ConsentReceipt{}, err
+	}
+	switch {
+	case consentType.RequiresDisclosure() && disclosure == nil:
+		return ConsentReceipt{}, fmt.Errorf("%s consent must cite a disclosure", consentType)
+	case !consentType.RequiresDisclosure() && disclosure != nil:
+		return ConsentReceipt{}, fmt.Errorf("%s consent has no disclosures", consentType)
+	}
+	if disclosure != nil {
+		if disclosure.tenantID != tenantID || !disclosure.consentType.Equal(consentType) {
+			return ConsentReceipt{}, fmt.Errorf("disclosure %s is not a %s disclosure", disclosure.id, consentType)
+		}
+		if !disclosure.InEffect(r.capturedAt) {
+			return ConsentReceipt{}, fmt.Errorf("disclosure %s takes effect at %s", disclosure.version, disclosure.effectiveFrom.Format(time.RFC3339))
+		}
+		id := disclosure.id
+		r.disclosureID = &id
+		r.disclosureVersion = disclosure.version
+		r.contentHash = disclosure.contentHash
+	}
+	if expiresAt != nil {
+		t := expiresAt.UTC().Truncate(time.Microsecond)
+		if !t.After(r.capturedAt) {
+			return ConsentReceipt{}, fmt.Errorf("consent must expire after it is given")
+		}
+		r.expiresAt = &t
+	}
+	r.hash = r.computeHash()
+	r.domainEvents = append(r.domainEvents, event.NewConsentGranted(
+		customerID, tenantID, r.id, consentType.String(), r.disclosureID, r.disclosureVersion,
+		r.channel.String(), r.capturedAt, r.expiresAt))
+	return r, nil
+}
+
+// WithdrawConsent records a customer withdrawing consent of a type,
+// following previous, the customer's latest receipt of the type. It
+// returns ErrConsentNotGranted when the customer never gave consent or
+// already withdrew it.
+func WithdrawConsent(
+	previous *ConsentReceipt,
+	tenantID, customerID uuid.UUID,
+	consentType valueobject.ConsentType,
+	capture Capture,
+	now time.Time,
+) (ConsentReceipt, error) {
+	if previous == nil || !previous.action.Equal(valueobject.ActionGranted) {
+		return ConsentReceipt{}, fmt.Errorf("%w: %s", ErrConsentNotGranted, consentType)
+	}
+	r, err := newReceipt(previous, tenantID, customerID, consentType, valueobject.ActionWithdrawn, capture, now)
+	if err != nil {
+		return ConsentReceipt{}, err
+	}
+	r.hash = r.computeHash()
+	r.domainEvents = append(r.domainEvents, event.NewConsentWithdrawn(
+		customerID, tenantID, r.id, consentType.String(), r.channel.String(), r.capturedAt))
+	return r, nil
+}
+
+func newReceipt(
+	previous *ConsentReceipt,
+	tenantID, customerID uuid.UUID,
+	consentType valueobject.ConsentType,
+	action valueobject.ConsentAction,
+	capture Capture,
+	now time.Time,
+) (ConsentReceipt, error) {
+	if tenantID == uuid.Nil {
+		return ConsentReceipt{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	if customerID == uuid.Nil {
+		return ConsentReceipt{}, fmt.Errorf("customer ID must not be empty")
+	}
+	if consentType.IsZero() {
+		return ConsentReceipt{}, fmt.Errorf("consent type must not be empty")
+	}
+	if capture.Channel.IsZero() {
+		return ConsentReceipt{}, fmt.Errorf("capture channel must not be empty")
+	}
+	if len(capture.Evidence) > MaxEvidenceLength {
+		return ConsentReceipt{}, fmt.Errorf("evidence must not exceed %d bytes", MaxEvidenceLength)
+	}
+	// Timestamps are kept to the microsecond the database stores, so a
+	// receipt's hash verifies after a round trip.
+	now = now.UTC().Truncate(time.Microsecond)
+	capturedAt := capture.CapturedAt.UTC().Truncate(time.Microsecond)
+	if capture.CapturedAt.IsZero() {
+		capturedAt = now
+	}
+	if capturedAt.After(now) {
+		return ConsentReceipt{}, fmt.Errorf("consent cannot be captured in the future")
+	}
+
+	r := ConsentReceipt{
+		id:          uuid.New(),
+		tenantID:    tenantID,
+		customerID:  customerID,
+		consentType: consentType,
+		action:      action,
+		channel:     capture.Channel,
+		evidence:    capture.Evidence,
+		capturedAt:  capturedAt,
+		sequence:    1,
+		recordedAt:  now,
+	}
+	if previous != nil {
+		if previous.tenantID != tenantID || previous.customerID != customerID || !previous.consentType.Equal(consentType) {
+			return ConsentReceipt{}, fmt.Errorf("receipt %s is not the customer's %s consent", previous.id, consentType)
+		}
+		r.sequence = previous.sequence + 1
+		r.previousHash = previous.hash
+	}
+	return r, nil
+}
+
+// ReconstructConsentReceipt recreates a ConsentReceipt from persisted data
+// without validation or events.
+func ReconstructConsentReceipt(
+	id, tenantID, customerID uuid.UUID,
+	consentType valueobject.ConsentType,
+	action valueobject.ConsentAction,
+	disclosureID *uuid.UUID,
+	disclosureVersion, contentHash string,
+	channel valueobject.CaptureChannel,
+	evidence string,
+	capturedAt time.Time,
+	expiresAt *time.Time,
+	sequence int,
+	previousHash, hash string,
+	recordedAt time.Time,
+) ConsentReceipt {
+	return ConsentReceipt{
+		id:                id,
+		tenantID:          tenantID,
+		customerID:        customerID,
+		consentType:       consentType,
+		action:            action,
+		disclosureID:      disclosureID,
+		disclosureVersion: disclosureVersion,
+		contentHash:       contentHash,
+		channel:           channel,
+		evidence:          evidence,
+		capturedAt:        capturedAt,
+		expiresAt:         expiresAt,
+		sequence:          sequence,
+		previousHash:      previousHash,
+		hash:              hash,
+		recordedAt:        recordedAt,
+	}
+}
+
+// Verify returns true if the receipt's hash matches its content and it
+// links to previous, the receipt before it in the chain, or nil for the
+// first.
+func (r ConsentReceipt) Verify(previous *ConsentReceipt) bool {
+	if r.hash != r.computeHash() {
+		return false
+	}
+	if previous == nil {
+		return r.sequence == 1 && r.previousHash == ""
+	}
+	return r.sequence == previous.sequence+1 && r.previousHash == previous.hash
+}
+
+// Status returns whether the consent the receipt records stands at now.
+// current is the disclosure of the receipt's type in effect, or nil when
+// there is none; consent to an earlier version of a type that requires the
+// current one is outdated.
+func (r ConsentReceipt) Status(current *Disclosure, now time.Time) valueobject.ConsentStatus {
+	switch {
+	case r.action.Equal(valueobject.ActionWithdrawn):
+		return valueobject.StatusWithdrawn
+	case r.expiresAt != nil && !now.Before(*r.expiresAt):
+		return valueobject.StatusExpired
+	case r.consentType.RequiresCurrentVersion() && current != nil &&
+		(r.disclosureID == nil || *r.disclosureID != current.id):
+		return valueobject.StatusOutdated
+	default:
+		return valueobject.StatusGranted
+	}
+}
+
+// computeHash returns the hex SHA-256 of the receipt's content and the
+// previous receipt's hash. The fields are encoded as a JSON array, so no
+// field's content can be mistaken for another's.
+func (r ConsentReceipt) computeHash() string {
+	var disclosureID, expiresAt string
+	if r.disclosureID != nil {
+		disclosureID = r.disclosureID.String()
+	}
+	if r.expiresAt != nil {
+		expiresAt = r.expiresAt.UTC().Format(time.RFC3339Nano)
+	}
+	b, _ := json.Marshal([]string{ //nolint:errchkjson // a string slice always encodes
+		r.id.String(),
+		r.tenantID.String(),
+		r.customerID.String(),
+		r.consentType.String(),
+		r.action.String(),
+		disclosureID,
+		r.disclosureVersion,
+		r.contentHash,
+		r.channel.String(),
+		r.evidence,
+		r.capturedAt.UTC().Format(time.RFC3339Nano),
+		expiresAt,
+		strconv.Itoa(r.sequence),
+		r.previousHash,
+	})
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// --- Accessors ---
+
+func (r ConsentReceipt) ID() uuid.UUID                        { return r.id }
+func (r ConsentReceipt) TenantID() uuid.UUID                  { return r.tenantID }
+func (r ConsentReceipt) CustomerID() uuid.UUID                { return r.customerID }
+func (r ConsentReceipt) ConsentType() valueobject.ConsentType { return r.consentType }
+func (r ConsentReceipt) Action() valueobject.ConsentAction    { return r.action }
+func (r ConsentReceipt) DisclosureID() *uuid.UUID             { return r.disclosureID }
+func (r ConsentReceipt) DisclosureVersion() string            { return r.disclosureVersion }
+func (r ConsentReceipt) ContentHash() string                  { return r.contentHash }
+func (r ConsentReceipt) Channel() valueobject.CaptureChannel  { return r.channel }
+func (r ConsentReceipt) Evidence() string                     { return r.evidence }
+func (r ConsentReceipt) CapturedAt() time.Time                { return r.capturedAt }
+func (r ConsentReceipt) ExpiresAt() *time.Time                { return r.expiresAt }
+func (r ConsentReceipt) Sequence() int                        { return r.sequence }
+func (r ConsentReceipt) PreviousHash() string                 { return r.previousHash }
+func (r ConsentReceipt) Hash() string                         { return r.hash }
+func (r ConsentReceipt) RecordedAt() time.Time                { return r.recordedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (r ConsentReceipt) DomainEvents() []events.DomainEvent {
+	return r.domainEvents
+}
+
+// ClearDomainEvents returns a copy with cleared domain events.
+func (r ConsentReceipt) ClearDomainEvents() ConsentReceipt {
+	r.domainEvents = nil
+	return r
+}
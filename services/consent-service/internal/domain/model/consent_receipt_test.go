@@ -0,0 +1,119 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/consent-service/internal/domain/model"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/valueobject"
+)
+
+var (
+	now        = time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	tenantID   = uuid.New()
+	customerID = uuid.New()
+	online     = model.Capture{Channel: valueobject.CaptureOnline, Evidence: "signup page, accept checkbox"}
+)
+
+const contentHash = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+func disclosure(t *testing.T, consentType valueobject.ConsentType, version string, at time.Time) model.Disclosure {
+	t.Helper()
+	d, err := model.NewDisclosure(tenantID, consentType, version, contentHash, "https://bank.example/terms/"+version, time.Time{}, at)
+	require.NoError(t, err)
+	return d
+}
+
+func TestNewDisclosure_Validation(t *testing.T) {
+	_, err := model.NewDisclosure(tenantID, valueobject.ConsentMarketingEmail, "v1", contentHash, "", time.Time{}, now)
+	assert.Error(t, err, "marketing consent has no disclosures")
+	_, err = model.NewDisclosure(tenantID, valueobject.ConsentTermsOfService, "v1", "abc", "", time.Time{}, now)
+	assert.Error(t, err, "content hash is not a SHA-256")
+	_, err = model.NewDisclosure(tenantID, valueobject.ConsentTermsOfService, "v1", contentHash, "", now.Add(-time.Hour), now)
+	assert.Error(t, err, "effective in the past")
+
+	d, err := model.NewDisclosure(tenantID, valueobject.ConsentTermsOfService, "v2", contentHash, "", now.Add(24*time.Hour), now)
+	require.NoError(t, err)
+	assert.False(t, d.InEffect(now))
+	assert.Len(t, d.DomainEvents(), 1)
+}
+
+func TestGrantConsent_HashChain(t *testing.T) {
+	tos := disclosure(t, valueobject.ConsentTermsOfService, "v1", now)
+
+	first, err := model.GrantConsent(nil, tenantID, customerID, valueobject.ConsentTermsOfService, &tos, online, nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.Sequence())
+	assert.Empty(t, first.PreviousHash())
+	assert.Equal(t, "v1", first.DisclosureVersion())
+	assert.True(t, first.Verify(nil))
+
+	second, err := model.WithdrawConsent(&first, tenantID, customerID, valueobject.ConsentTermsOfService, online, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 2, second.Sequence())
+	assert.Equal(t, first.Hash(), second.PreviousHash())
+	assert.True(t, second.Verify(&first))
+
+	// Altering the evidence breaks the hash, and a receipt does not link
+	// to any receipt but its predecessor.
+	tampered := model.ReconstructConsentReceipt(
+		first.ID(), tenantID, customerID, first.ConsentType(), first.Action(), first.DisclosureID(),
+		first.DisclosureVersion(), first.ContentHash(), first.Channel(), "branch, signed form",
+		first.CapturedAt(), first.ExpiresAt(), first.Sequence(), first.PreviousHash(), first.Hash(), first.RecordedAt())
+	assert.False(t, tampered.Verify(nil))
+	assert.False(t, second.Verify(nil))
+}
+
+func TestGrantConsent_Validation(t *testing.T) {
+	tos := disclosure(t, valueobject.ConsentTermsOfService, "v1", now)
+
+	_, err := model.GrantConsent(nil, tenantID, customerID, valueobject.ConsentTermsOfService, nil, online, nil, now)
+	assert.Error(t, err, "no disclosure")
+	_, err = model.GrantConsent(nil, tenantID, customerID, valueobject.ConsentESign, &tos, online, nil, now)
+	assert.Error(t, err, "disclosure of another type")
+	_, err = model.GrantConsent(nil, tenantID, customerID, valueobject.ConsentMarketingSMS, &tos, online, nil, now)
+	assert.Error(t, err, "marketing consent cites a disclosure")
+	_, err = model.GrantConsent(nil, tenantID, customerID, valueobject.ConsentMarketingSMS, nil,
+		model.Capture{Channel: valueobject.CaptureOnline, CapturedAt: now.Add(time.Hour)}, nil, now)
+	assert.Error(t, err, "captured in the future")
+	past := now.Add(-time.Hour)
+	_, err = model.GrantConsent(nil, tenantID, customerID, valueobject.ConsentMarketingSMS, nil, online, &past, now)
+	assert.Error(t, err, "expires before it is given")
+}
+
+func TestWithdrawConsent_RequiresGrant(t *testing.T) {
+	_, err := model.WithdrawConsent(nil, tenantID, customerID, valueobject.ConsentMarketingEmail, online, now)
+	assert.ErrorIs(t, err, model.ErrConsentNotGranted)
+
+	granted, err := model.GrantConsent(nil, tenantID, customerID, valueobject.ConsentMarketingEmail, nil, online, nil, now)
+	require.NoError(t, err)
+	withdrawn, err := model.WithdrawConsent(&granted, tenantID, customerID, valueobject.ConsentMarketingEmail, online, now)
+	require.NoError(t, err)
+	_, err = model.WithdrawConsent(&withdrawn, tenantID, customerID, valueobject.ConsentMarketingEmail, online, now)
+	assert.ErrorIs(t, err, model.ErrConsentNotGranted)
+}
+
+func TestConsentReceipt_Status(t *testing.T) {
+	v1 := disclosure(t, valueobject.ConsentTermsOfService, "v1", now)
+	v2 := disclosure(t, valueobject.ConsentTermsOfService, "v2", now)
+	tos, err := model.GrantConsent(nil, tenantID, customerID, valueobject.ConsentTermsOfService, &v1, online, nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.StatusGranted, tos.Status(&v1, now))
+	assert.Equal(t, valueobject.StatusOutdated, tos.Status(&v2, now))
+
+	credit := disclosure(t, valueobject.ConsentCreditPull, "v1", now)
+	expiresAt := now.Add(30 * 24 * time.Hour)
+	pull, err := model.GrantConsent(nil, tenantID, customerID, valueobject.ConsentCreditPull, &credit, online, &expiresAt, now)
+	require.NoError(t, err)
+	later := disclosure(t, valueobject.ConsentCreditPull, "v2", now)
+	assert.Equal(t, valueobject.StatusGranted, pull.Status(&later, now), "credit pull consent stands for earlier versions")
+	assert.Equal(t, valueobject.StatusExpired, pull.Status(&later, expiresAt))
+
+	withdrawn, err := model.WithdrawConsent(&pull, tenantID, customerID, valueobject.ConsentCreditPull, online, now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.StatusWithdrawn, withdrawn.Status(&credit, now))
+}
@@ -0,0 +1,126 @@
+package model
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/event"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/valueobject"
+)
+
+// Disclosure is a published version of the text a customer consents to,
+// such as the terms of service. It is identified by the SHA-256 of its
+// content and never changes once published; a later version supersedes it
+// from its effective date.
+type Disclosure struct {
+	effectiveFrom time.Time
+	publishedAt   time.Time
+	consentType   valueobject.ConsentType
+	version       string
+	contentHash   string
+	url           string
+	domainEvents  []events.DomainEvent
+	id            uuid.UUID
+	tenantID      uuid.UUID
+}
+
+// NewDisclosure publishes a disclosure version taking effect at
+// effectiveFrom, or at now when effectiveFrom is zero. contentHash is the
+// hex SHA-256 of the disclosure's content.
+func NewDisclosure(
+	tenantID uuid.UUID,
+	consentType valueobject.ConsentType,
+	version, contentHash, url string,
+	effectiveFrom, now time.Time,
+) (Disclosure, error) {
+	if tenantID == uuid.Nil {
+		return Disclosure{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	if consentType.IsZero() {
+		return Disclosure{}, fmt.Errorf("consent type must not be empty")
+	}
+	if !consentType.RequiresDisclosure() {
+		return Disclosure{}, fmt.Errorf("%s consent has no disclosures", consentType)
+	}
+	version = strings.TrimSpace(version)
+	if version == "" {
+		return Disclosure{}, fmt.Errorf("version must not be empty")
+	}
+	contentHash = strings.ToLower(contentHash)
+	if b, err := hex.DecodeString(contentHash); err != nil || len(b) != 32 {
+		return Disclosure{}, fmt.Errorf("content hash must be a hex SHA-256")
+	}
+	now = now.UTC().Truncate(time.Microsecond)
+	if effectiveFrom.IsZero() {
+		effectiveFrom = now
+	}
+	if effectiveFrom.Before(now) {
+		return Disclosure{}, fmt.Errorf("effective date %s is in the past", effectiveFrom.Format(time.RFC3339))
+	}
+
+	d := Disclosure{
+		id:            uuid.New(),
+		tenantID:      tenantID,
+		consentType:   consentType,
+		version:       version,
+		contentHash:   contentHash,
+		url:           url,
+		effectiveFrom: effectiveFrom,
+		publishedAt:   now,
+	}
+	d.domainEvents = append(d.domainEvents, event.NewDisclosurePublished(
+		d.id, tenantID, consentType.String(), version, contentHash, url, effectiveFrom))
+	return d, nil
+}
+
+// ReconstructDisclosure recreates a Disclosure from persisted data without
+// validation or events.
+func ReconstructDisclosure(
+	id, tenantID uuid.UUID,
+	consentType valueobject.ConsentType,
+	version, contentHash, url string,
+	effectiveFrom, publishedAt time.Time,
+) Disclosure {
+	return Disclosure{
+		id:            id,
+		tenantID:      tenantID,
+		consentType:   consentType,
+		version:       version,
+		contentHash:   contentHash,
+		url:           url,
+		effectiveFrom: effectiveFrom,
+		publishedAt:   publishedAt,
+	}
+}
+
+// InEffect returns true if the disclosure has taken effect at t.
+func (d Disclosure) InEffect(t time.Time) bool {
+	return !d.effectiveFrom.After(t)
+}
+
+// --- Accessors ---
+
+func (d Disclosure) ID() uuid.UUID                        { return d.id }
+func (d Disclosure) TenantID() uuid.UUID                  { return d.tenantID }
+func (d Disclosure) ConsentType() valueobject.ConsentType { return d.consentType }
+func (d Disclosure) Version() string                      { return d.version }
+func (d Disclosure) ContentHash() string                  { return d.contentHash }
+func (d Disclosure) URL() string                          { return d.url }
+func (d Disclosure) EffectiveFrom() time.Time             { return d.effectiveFrom }
+func (d Disclosure) PublishedAt() time.Time               { return d.publishedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (d Disclosure) DomainEvents() []events.DomainEvent {
+	return d.domainEvents
+}
+
+// ClearDomainEvents returns a copy with cleared domain events.
+func (d Disclosure) ClearDomainEvents() Disclosure {
+	d.domainEvents = nil
+	return d
+}
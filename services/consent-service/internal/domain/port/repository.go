@@ -0,0 +1,84 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/consent-service/internal/domain/event"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/model"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/valueobject"
+)
+
+// ErrDisclosureNotFound is returned when a disclosure does not exist.
+var ErrDisclosureNotFound = errors.New("disclosure not found")
+
+// ErrDisclosureExists is returned when a disclosure is saved with a version
+// its type already has.
+var ErrDisclosureExists = errors.New("disclosure already exists")
+
+// ErrReceiptNotFound is returned when a consent receipt does not exist.
+var ErrReceiptNotFound = errors.New("consent receipt not found")
+
+// ErrReceiptConflict is returned when a consent receipt is appended to a
+// chain that another receipt was appended to first.
+var ErrReceiptConflict = errors.New("consent receipt conflict")
+
+// DisclosureRepository defines the persistence port for disclosures.
+type DisclosureRepository interface {
+	// Save inserts a disclosure, returning ErrDisclosureExists when its
+	// type already has the version.
+	Save(ctx context.Context, disclosure model.Disclosure) error
+
+	// FindByID retrieves a tenant's disclosure.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Disclosure, error)
+
+	// FindCurrent retrieves the disclosure of a type in effect at a time,
+	// the one taking effect latest at or before it, returning
+	// ErrDisclosureNotFound when there is none.
+	FindCurrent(ctx context.Context, tenantID uuid.UUID, consentType valueobject.ConsentType, at time.Time) (model.Disclosure, error)
+
+	// List returns a page of a tenant's disclosures, of one type when
+	// consentType is not zero, by type and then latest effective first, and
+	// the total number of them.
+	List(ctx context.Context, tenantID uuid.UUID, consentType valueobject.ConsentType, limit, offset int) ([]model.Disclosure, int, error)
+}
+
+// ReceiptFilter narrows a listing of a customer's consent receipts. A zero
+// ConsentType does not filter.
+type ReceiptFilter struct {
+	ConsentType valueobject.ConsentType
+	TenantID    uuid.UUID
+	CustomerID  uuid.UUID
+}
+
+// ReceiptRepository defines the persistence port for consent receipts.
+// Receipts are only ever appended.
+type ReceiptRepository interface {
+	// Append inserts a receipt, returning ErrReceiptConflict when the
+	// customer's chain of its type already has a receipt at its sequence.
+	Append(ctx context.Context, receipt model.ConsentReceipt) error
+
+	// FindLatest retrieves a customer's latest receipt of a type,
+	// returning ErrReceiptNotFound when they have none.
+	FindLatest(ctx context.Context, tenantID, customerID uuid.UUID, consentType valueobject.ConsentType) (model.ConsentReceipt, error)
+
+	// FindByID retrieves a tenant's receipt.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.ConsentReceipt, error)
+
+	// FindBySequence retrieves the receipt at a position of a customer's
+	// chain of a type.
+	FindBySequence(ctx context.Context, tenantID, customerID uuid.UUID, consentType valueobject.ConsentType, sequence int) (model.ConsentReceipt, error)
+
+	// List returns a page of the receipts matching the filter, newest
+	// first, and the total number of them.
+	List(ctx context.Context, filter ReceiptFilter, limit, offset int) ([]model.ConsentReceipt, int, error)
+}
+
+// EventPublisher defines the port for publishing domain events.
+type EventPublisher interface {
+	// Publish sends domain events to the event bus.
+	Publish(ctx context.Context, events []event.DomainEvent) error
+}
@@ -0,0 +1,63 @@
+package valueobject
+
+import "fmt"
+
+// CaptureChannel is how a customer gave or withdrew consent.
+// It is an immutable value object.
+type CaptureChannel struct {
+	value string
+}
+
+const (
+	captureOnline = "ONLINE"
+	captureMobile = "MOBILE"
+	captureBranch = "BRANCH"
+	capturePhone  = "PHONE"
+	capturePaper  = "PAPER"
+)
+
+var (
+	// CaptureOnline is consent captured in online banking.
+	CaptureOnline = CaptureChannel{value: captureOnline}
+	// CaptureMobile is consent captured in the mobile app.
+	CaptureMobile = CaptureChannel{value: captureMobile}
+	// CaptureBranch is consent captured by staff in a branch.
+	CaptureBranch = CaptureChannel{value: captureBranch}
+	// CapturePhone is consent captured by staff on a recorded call.
+	CapturePhone = CaptureChannel{value: capturePhone}
+	// CapturePaper is consent captured on a signed paper form.
+	CapturePaper = CaptureChannel{value: capturePaper}
+)
+
+var validCaptureChannels = map[string]CaptureChannel{
+	captureOnline: CaptureOnline,
+	captureMobile: CaptureMobile,
+	captureBranch: CaptureBranch,
+	capturePhone:  CapturePhone,
+	capturePaper:  CapturePaper,
+}
+
+// NewCaptureChannel creates a CaptureChannel from a string, validating it
+// is known.
+func NewCaptureChannel(s string) (CaptureChannel, error) {
+	v, ok := validCaptureChannels[s]
+	if !ok {
+		return CaptureChannel{}, fmt.Errorf("invalid capture channel: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the CaptureChannel.
+func (c CaptureChannel) String() string {
+	return c.value
+}
+
+// IsZero returns true if the CaptureChannel has not been set.
+func (c CaptureChannel) IsZero() bool {
+	return c.value == ""
+}
+
+// Equal returns true if two CaptureChannel values are equal.
+func (c CaptureChannel) Equal(other CaptureChannel) bool {
+	return c.value == other.value
+}
@@ -0,0 +1,50 @@
+package valueobject
+
+import "fmt"
+
+// ConsentAction is what a consent receipt records: consent given or
+// withdrawn.
+// It is an immutable value object.
+type ConsentAction struct {
+	value string
+}
+
+const (
+	actionGranted   = "GRANTED"
+	actionWithdrawn = "WITHDRAWN"
+)
+
+var (
+	// ActionGranted records consent given.
+	ActionGranted = ConsentAction{value: actionGranted}
+	// ActionWithdrawn records consent withdrawn.
+	ActionWithdrawn = ConsentAction{value: actionWithdrawn}
+)
+
+// NewConsentAction creates a ConsentAction from a string, validating it is
+// known.
+func NewConsentAction(s string) (ConsentAction, error) {
+	switch s {
+	case actionGranted:
+		return ActionGranted, nil
+	case actionWithdrawn:
+		return ActionWithdrawn, nil
+	default:
+		return ConsentAction{}, fmt.Errorf("invalid consent action: %q", s)
+	}
+}
+
+// String returns the string representation of the ConsentAction.
+func (a ConsentAction) String() string {
+	return a.value
+}
+
+// IsZero returns true if the ConsentAction has not been set.
+func (a ConsentAction) IsZero() bool {
+	return a.value == ""
+}
+
+// Equal returns true if two ConsentAction values are equal.
+func (a ConsentAction) Equal(other ConsentAction) bool {
+	return a.value == other.value
+}
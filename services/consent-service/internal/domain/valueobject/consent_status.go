@@ -0,0 +1,32 @@
+package valueobject
+
+// ConsentStatus is whether a customer's consent of a type stands, and why
+// not when it does not.
+// It is an immutable value object.
+type ConsentStatus struct {
+	value string
+}
+
+var (
+	// StatusGranted is consent that stands.
+	StatusGranted = ConsentStatus{value: "GRANTED"}
+	// StatusNotGiven is consent the customer never gave.
+	StatusNotGiven = ConsentStatus{value: "NOT_GIVEN"}
+	// StatusWithdrawn is consent the customer withdrew.
+	StatusWithdrawn = ConsentStatus{value: "WITHDRAWN"}
+	// StatusExpired is consent that lapsed.
+	StatusExpired = ConsentStatus{value: "EXPIRED"}
+	// StatusOutdated is consent to a disclosure version that has since been
+	// superseded.
+	StatusOutdated = ConsentStatus{value: "OUTDATED"}
+)
+
+// Granted returns true if the consent stands.
+func (s ConsentStatus) Granted() bool {
+	return s == StatusGranted
+}
+
+// String returns the string representation of the ConsentStatus.
+func (s ConsentStatus) String() string {
+	return s.value
+}
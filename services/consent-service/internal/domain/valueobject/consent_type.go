@@ -0,0 +1,84 @@
+package valueobject
+
+import "fmt"
+
+// ConsentType is the kind of consent a customer gives.
+// It is an immutable value object.
+type ConsentType struct {
+	value string
+}
+
+const (
+	consentTermsOfService = "TERMS_OF_SERVICE"
+	consentESign          = "ESIGN"
+	consentCreditPull     = "CREDIT_PULL"
+	consentMarketingEmail = "MARKETING_EMAIL"
+	consentMarketingSMS   = "MARKETING_SMS"
+	consentMarketingPush  = "MARKETING_PUSH"
+)
+
+var (
+	// ConsentTermsOfService is acceptance of the tenant's terms of service.
+	ConsentTermsOfService = ConsentType{value: consentTermsOfService}
+	// ConsentESign is consent to receive disclosures and sign
+	// electronically.
+	ConsentESign = ConsentType{value: consentESign}
+	// ConsentCreditPull is authorization to pull the customer's credit
+	// report from a bureau.
+	ConsentCreditPull = ConsentType{value: consentCreditPull}
+	// ConsentMarketingEmail is consent to marketing by email.
+	ConsentMarketingEmail = ConsentType{value: consentMarketingEmail}
+	// ConsentMarketingSMS is consent to marketing by SMS.
+	ConsentMarketingSMS = ConsentType{value: consentMarketingSMS}
+	// ConsentMarketingPush is consent to marketing by push notification.
+	ConsentMarketingPush = ConsentType{value: consentMarketingPush}
+)
+
+// ConsentTypes lists every consent type, in the order they are reported.
+var ConsentTypes = []ConsentType{
+	ConsentTermsOfService,
+	ConsentESign,
+	ConsentCreditPull,
+	ConsentMarketingEmail,
+	ConsentMarketingSMS,
+	ConsentMarketingPush,
+}
+
+// NewConsentType creates a ConsentType from a string, validating it is
+// known.
+func NewConsentType(s string) (ConsentType, error) {
+	for _, t := range ConsentTypes {
+		if t.value == s {
+			return t, nil
+		}
+	}
+	return ConsentType{}, fmt.Errorf("invalid consent type: %q", s)
+}
+
+// RequiresDisclosure returns true if consent of this type must cite the
+// disclosure the customer was shown.
+func (t ConsentType) RequiresDisclosure() bool {
+	return t == ConsentTermsOfService || t == ConsentESign || t == ConsentCreditPull
+}
+
+// RequiresCurrentVersion returns true if consent of this type only stands
+// for the disclosure version in effect, so a new version must be consented
+// to again.
+func (t ConsentType) RequiresCurrentVersion() bool {
+	return t == ConsentTermsOfService || t == ConsentESign
+}
+
+// String returns the string representation of the ConsentType.
+func (t ConsentType) String() string {
+	return t.value
+}
+
+// IsZero returns true if the ConsentType has not been set.
+func (t ConsentType) IsZero() bool {
+	return t.value == ""
+}
+
+// Equal returns true if two ConsentType values are equal.
+func (t ConsentType) Equal(other ConsentType) bool {
+	return t.value == other.value
+}
@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/consent-service/internal/domain/model"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/port"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/valueobject"
+)
+
+// uniqueViolation is the PostgreSQL error code for a unique constraint
+// violation.
+const uniqueViolation = "23505"
+
+const disclosureColumns = `
+	id, tenant_id, consent_type, version, content_hash, url, effective_from, published_at`
+
+// DisclosureRepo is the PostgreSQL implementation of DisclosureRepository.
+type DisclosureRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewDisclosureRepo creates a new DisclosureRepo.
+func NewDisclosureRepo(pool *pgxpool.Pool) *DisclosureRepo {
+	return &DisclosureRepo{pool: pool}
+}
+
+// Save inserts a disclosure.
+func (r *DisclosureRepo) Save(ctx context.Context, d model.Disclosure) error {
+	query := `INSERT INTO disclosures (` + disclosureColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err := r.pool.Exec(ctx, query,
+		d.ID(), d.TenantID(), d.ConsentType().String(), d.Version(), d.ContentHash(), d.URL(),
+		d.EffectiveFrom(), d.PublishedAt(),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: %s %s", port.ErrDisclosureExists, d.ConsentType(), d.Version())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert disclosure: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a tenant's disclosure.
+func (r *DisclosureRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Disclosure, error) {
+	return r.findOne(ctx, `SELECT `+disclosureColumns+` FROM disclosures WHERE id = $1 AND tenant_id = $2`, id, tenantID)
+}
+
+// FindCurrent retrieves the disclosure of a type in effect at a time.
+func (r *DisclosureRepo) FindCurrent(ctx context.Context, tenantID uuid.UUID, consentType valueobject.ConsentType, at time.Time) (model.Disclosure, error) {
+	query := `SELECT ` + disclosureColumns + ` FROM disclosures
+		WHERE tenant_id = $1 AND consent_type = $2 AND effective_from <= $3
+		ORDER BY effective_from DESC, published_at DESC
+		LIMIT 1`
+	return r.findOne(ctx, query, tenantID, consentType.String(), at)
+}
+
+// List returns a page of a tenant's disclosures, by type and then latest
+// effective first, and the total number of them.
+func (r *DisclosureRepo) List(ctx context.Context, tenantID uuid.UUID, consentType valueobject.ConsentType, limit, offset int) ([]model.Disclosure, int, error) {
+	where := `WHERE tenant_id = $1 AND ($2 = '' OR consent_type = $2)`
+	args := []any{tenantID, consentType.String()}
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM disclosures `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count disclosures: %w", err)
+	}
+	rows, err := r.pool.Query(ctx, `SELECT `+disclosureColumns+` FROM disclosures `+where+`
+		ORDER BY consent_type, effective_from DESC, id
+		LIMIT $3 OFFSET $4
+	`, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query disclosures: %w", err)
+	}
+	defer rows.Close()
+
+	var disclosures []model.Disclosure
+	for rows.Next() {
+		d, err := scanDisclosure(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan disclosure row: %w", err)
+		}
+		disclosures = append(disclosures, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("row iteration error: %w", err)
+	}
+	return disclosures, total, nil
+}
+
+func (r *DisclosureRepo) findOne(ctx context.Context, query string, args ...any) (model.Disclosure, error) {
+	d, err := scanDisclosure(r.pool.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Disclosure{}, port.ErrDisclosureNotFound
+	}
+	if err != nil {
+		return model.Disclosure{}, fmt.Errorf("failed to scan disclosure: %w", err)
+	}
+	return d, nil
+}
+
+func scanDisclosure(row pgx.Row) (model.Disclosure, error) {
+	var (
+		id, tenantID                       uuid.UUID
+		typeStr, version, contentHash, url string
+		effectiveFrom, publishedAt         time.Time
+	)
+
+	err := row.Scan(&id, &tenantID, &typeStr, &version, &contentHash, &url, &effectiveFrom, &publishedAt)
+	if err != nil {
+		return model.Disclosure{}, err
+	}
+
+	consentType, err := valueobject.NewConsentType(typeStr)
+	if err != nil {
+		return model.Disclosure{}, fmt.Errorf("invalid consent type in database: %w", err)
+	}
+	return model.ReconstructDisclosure(id, tenantID, consentType, version, contentHash, url, effectiveFrom, publishedAt), nil
+}
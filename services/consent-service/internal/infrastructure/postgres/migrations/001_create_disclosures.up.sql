@@ -0,0 +1,16 @@
+-- Published versions of the texts customers consent to, identified by the
+-- SHA-256 of their content. A type's version in effect is the one taking
+-- effect latest at or before now. Rows are never updated.
+CREATE TABLE IF NOT EXISTS disclosures (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    consent_type VARCHAR(30) NOT NULL,
+    version VARCHAR(64) NOT NULL,
+    content_hash CHAR(64) NOT NULL,
+    url TEXT NOT NULL DEFAULT '',
+    effective_from TIMESTAMPTZ NOT NULL,
+    published_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, consent_type, version)
+);
+
+CREATE INDEX idx_disclosures_effective ON disclosures (tenant_id, consent_type, effective_from);
@@ -0,0 +1,25 @@
+-- Customers granting and withdrawing consent. A customer's receipts of a
+-- consent type form a hash chain ordered by sequence, each hash covering
+-- the receipt's content and the previous receipt's hash; the latest is the
+-- customer's standing consent. Rows are only ever inserted.
+CREATE TABLE IF NOT EXISTS consent_receipts (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    customer_id UUID NOT NULL,
+    consent_type VARCHAR(30) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    disclosure_id UUID REFERENCES disclosures (id),
+    disclosure_version VARCHAR(64) NOT NULL DEFAULT '',
+    content_hash VARCHAR(64) NOT NULL DEFAULT '',
+    channel VARCHAR(20) NOT NULL,
+    evidence TEXT NOT NULL DEFAULT '',
+    captured_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ,
+    sequence INT NOT NULL,
+    previous_hash VARCHAR(64) NOT NULL DEFAULT '',
+    hash CHAR(64) NOT NULL,
+    recorded_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, customer_id, consent_type, sequence)
+);
+
+CREATE INDEX idx_consent_receipts_customer ON consent_receipts (tenant_id, customer_id, recorded_at);
@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/consent-service/internal/domain/model"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/port"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/valueobject"
+)
+
+const receiptColumns = `
+	id, tenant_id, customer_id, consent_type, action, disclosure_id, disclosure_version, content_hash,
+	channel, evidence, captured_at, expires_at, sequence, previous_hash, hash, recorded_at`
+
+// ReceiptRepo is the PostgreSQL implementation of ReceiptRepository.
+type ReceiptRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewReceiptRepo creates a new ReceiptRepo.
+func NewReceiptRepo(pool *pgxpool.Pool) *ReceiptRepo {
+	return &ReceiptRepo{pool: pool}
+}
+
+// Append inserts a consent receipt. The unique sequence of a customer's
+// chain of a type keeps two receipts from following the same one.
+func (r *ReceiptRepo) Append(ctx context.Context, rc model.ConsentReceipt) error {
+	query := `INSERT INTO consent_receipts (` + receiptColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`
+	_, err := r.pool.Exec(ctx, query,
+		rc.ID(), rc.TenantID(), rc.CustomerID(), rc.ConsentType().String(), rc.Action().String(),
+		rc.DisclosureID(), rc.DisclosureVersion(), rc.ContentHash(), rc.Channel().String(), rc.Evidence(),
+		rc.CapturedAt(), rc.ExpiresAt(), rc.Sequence(), rc.PreviousHash(), rc.Hash(), rc.RecordedAt(),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: %s consent of customer %s at %d", port.ErrReceiptConflict, rc.ConsentType(), rc.CustomerID(), rc.Sequence())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert consent receipt: %w", err)
+	}
+	return nil
+}
+
+// FindLatest retrieves a customer's latest receipt of a type.
+func (r *ReceiptRepo) FindLatest(ctx context.Context, tenantID, customerID uuid.UUID, consentType valueobject.ConsentType) (model.ConsentReceipt, error) {
+	query := `SELECT ` + receiptColumns + ` FROM consent_receipts
+		WHERE tenant_id = $1 AND customer_id = $2 AND consent_type = $3
+		ORDER BY sequence DESC
+		LIMIT 1`
+	return r.findOne(ctx, query, tenantID, customerID, consentType.String())
+}
+
+// FindByID retrieves a tenant's receipt.
+func (r *ReceiptRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.ConsentReceipt, error) {
+	return r.findOne(ctx, `SELECT `+receiptColumns+` FROM consent_receipts WHERE id = $1 AND tenant_id = $2`, id, tenantID)
+}
+
+// FindBySequence retrieves the receipt at a position of a customer's chain
+// of a type.
+func (r *ReceiptRepo) FindBySequence(ctx context.Context, tenantID, customerID uuid.UUID, consentType valueobject.ConsentType, sequence int) (model.ConsentReceipt, error) {
+	query := `SELECT ` + receiptColumns + ` FROM consent_receipts
+		WHERE tenant_id = $1 AND customer_id = $2 AND consent_type = $3 AND sequence = $4`
+	return r.findOne(ctx, query, tenantID, customerID, consentType.String(), sequence)
+}
+
+// List returns a page of the receipts matching the filter, newest first,
+// and the total number of them.
+func (r *ReceiptRepo) List(ctx context.Context, filter port.ReceiptFilter, limit, offset int) ([]model.ConsentReceipt, int, error) {
+	where := `WHERE tenant_id = $1 AND customer_id = $2 AND ($3 = '' OR consent_type = $3)`
+	args := []any{filter.TenantID, filter.CustomerID, filter.ConsentType.String()}
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM consent_receipts `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count consent receipts: %w", err)
+	}
+	rows, err := r.pool.Query(ctx, `SELECT `+receiptColumns+` FROM consent_receipts `+where+`
+		ORDER BY recorded_at DESC, sequence DESC
+		LIMIT $4 OFFSET $5
+	`, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query consent receipts: %w", err)
+	}
+	defer rows.Close()
+
+	var receipts []model.ConsentReceipt
+	for rows.Next() {
+		rc, err := scanReceipt(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan consent receipt row: %w", err)
+		}
+		receipts = append(receipts, rc)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("row iteration error: %w", err)
+	}
+	return receipts, total, nil
+}
+
+func (r *ReceiptRepo) findOne(ctx context.Context, query string, args ...any) (model.ConsentReceipt, error) {
+	rc, err := scanReceipt(r.pool.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.ConsentReceipt{}, port.ErrReceiptNotFound
+	}
+	if err != nil {
+		return model.ConsentReceipt{}, fmt.Errorf("failed to scan consent receipt: %w", err)
+	}
+	return rc, nil
+}
+
+func scanReceipt(row pgx.Row) (model.ConsentReceipt, error) {
+	var (
+		id, tenantID, customerID                 uuid.UUID
+		disclosureID                             *uuid.UUID
+		typeStr, actionStr, channelStr           string
+		disclosureVersion, contentHash, evidence string
+		previousHash, hash                       string
+		capturedAt, recordedAt                   time.Time
+		expiresAt                                *time.Time
+		sequence                                 int
+	)
+
+	err := row.Scan(&id, &tenantID, &customerID, &typeStr, &actionStr, &disclosureID, &disclosureVersion,
+		&contentHash, &channelStr, &evidence, &capturedAt, &expiresAt, &sequence, &previousHash, &hash, &recordedAt)
+	if err != nil {
+		return model.ConsentReceipt{}, err
+	}
+
+	consentType, err := valueobject.NewConsentType(typeStr)
+	if err != nil {
+		return model.ConsentReceipt{}, fmt.Errorf("invalid consent type in database: %w", err)
+	}
+	action, err := valueobject.NewConsentAction(actionStr)
+	if err != nil {
+		return model.ConsentReceipt{}, fmt.Errorf("invalid consent action in database: %w", err)
+	}
+	channel, err := valueobject.NewCaptureChannel(channelStr)
+	if err != nil {
+		return model.ConsentReceipt{}, fmt.Errorf("invalid capture channel in database: %w", err)
+	}
+
+	return model.ReconstructConsentReceipt(
+		id, tenantID, customerID, consentType, action, disclosureID, disclosureVersion, contentHash,
+		channel, evidence, capturedAt, expiresAt, sequence, previousHash, hash, recordedAt,
+	), nil
+}
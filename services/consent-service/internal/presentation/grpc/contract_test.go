@@ -0,0 +1,121 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	consentv1 "github.com/bibbank/bib/api/gen/go/bib/consent/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/consent-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/event"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/model"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/port"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractDisclosureRepo accepts any disclosure and holds none.
+type contractDisclosureRepo struct{}
+
+func (contractDisclosureRepo) Save(context.Context, model.Disclosure) error { return nil }
+
+func (contractDisclosureRepo) FindByID(context.Context, uuid.UUID, uuid.UUID) (model.Disclosure, error) {
+	return model.Disclosure{}, port.ErrDisclosureNotFound
+}
+
+func (contractDisclosureRepo) FindCurrent(context.Context, uuid.UUID, valueobject.ConsentType, time.Time) (model.Disclosure, error) {
+	return model.Disclosure{}, port.ErrDisclosureNotFound
+}
+
+func (contractDisclosureRepo) List(context.Context, uuid.UUID, valueobject.ConsentType, int, int) ([]model.Disclosure, int, error) {
+	return nil, 0, nil
+}
+
+// contractReceiptRepo holds at most one receipt.
+type contractReceiptRepo struct {
+	receipt *model.ConsentReceipt
+}
+
+func (r *contractReceiptRepo) Append(context.Context, model.ConsentReceipt) error { return nil }
+
+func (r *contractReceiptRepo) FindLatest(context.Context, uuid.UUID, uuid.UUID, valueobject.ConsentType) (model.ConsentReceipt, error) {
+	if r.receipt == nil {
+		return model.ConsentReceipt{}, port.ErrReceiptNotFound
+	}
+	return *r.receipt, nil
+}
+
+func (r *contractReceiptRepo) FindByID(context.Context, uuid.UUID, uuid.UUID) (model.ConsentReceipt, error) {
+	if r.receipt == nil {
+		return model.ConsentReceipt{}, port.ErrReceiptNotFound
+	}
+	return *r.receipt, nil
+}
+
+func (r *contractReceiptRepo) FindBySequence(context.Context, uuid.UUID, uuid.UUID, valueobject.ConsentType, int) (model.ConsentReceipt, error) {
+	return model.ConsentReceipt{}, port.ErrReceiptNotFound
+}
+
+func (r *contractReceiptRepo) List(context.Context, port.ReceiptFilter, int, int) ([]model.ConsentReceipt, int, error) {
+	return nil, 0, nil
+}
+
+type discardPublisher struct{}
+
+func (discardPublisher) Publish(context.Context, []event.DomainEvent) error { return nil }
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	receipts := &contractReceiptRepo{}
+	h := NewHandler(
+		usecase.NewPublishDisclosureUseCase(contractDisclosureRepo{}, discardPublisher{}), nil, nil, nil, nil, nil,
+		usecase.NewGetReceiptUseCase(receipts),
+		slog.Default(),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { consentv1.RegisterConsentServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-consent-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { receipts.receipt = nil },
+		"the customer accepted the terms of service": func(t *testing.T) {
+			terms, err := valueobject.NewConsentType("TERMS_OF_SERVICE")
+			if err != nil {
+				t.Fatal(err)
+			}
+			online, err := valueobject.NewCaptureChannel("ONLINE")
+			if err != nil {
+				t.Fatal(err)
+			}
+			published := time.Now().UTC().Add(-time.Hour)
+			disclosure, err := model.NewDisclosure(contractTenantID, terms, "2026.1",
+				"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", "https://bank.example.com/terms/2026.1",
+				published, published)
+			if err != nil {
+				t.Fatal(err)
+			}
+			now := time.Now().UTC()
+			receipt, err := model.GrantConsent(nil, contractTenantID, uuid.MustParse("a4c6e8b0-2d4f-4a1c-9e3b-5d7f9b1d3f6a"), terms,
+				&disclosure, model.Capture{CapturedAt: now, Channel: online, Evidence: "signup page checkbox"}, nil, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			receipts.receipt = &receipt
+		},
+	})
+}
@@ -0,0 +1,469 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	consentv1 "github.com/bibbank/bib/api/gen/go/bib/consent/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/consent-service/internal/application/dto"
+	"github.com/bibbank/bib/services/consent-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/model"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/port"
+)
+
+// staffRoles may act on any customer of their tenant; customers act only on
+// themselves. disclosureRoles may publish disclosures; recordRoles, which
+// include the channels capturing consent, may record it; readerRoles may
+// check and list it.
+var (
+	staffRoles      = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCompliance, auth.RoleAPIClient}
+	disclosureRoles = []string{auth.RoleAdmin, auth.RoleCompliance}
+	recordRoles     = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient, auth.RoleCustomer}
+	readerRoles     = append(slices.Clone(staffRoles), auth.RoleCustomer)
+)
+
+// requireRole checks that the caller has at least one of the given roles.
+func requireRole(ctx context.Context, roles ...string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "insufficient permissions")
+}
+
+// tenantIDFromContext extracts the tenant ID from JWT claims in the context.
+func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return claims.TenantID, nil
+}
+
+// isStaff returns true if the caller may act on any customer of their
+// tenant.
+func isStaff(claims *auth.Claims) bool {
+	return slices.ContainsFunc(staffRoles, claims.HasRole)
+}
+
+// customerIDFromRequest resolves the customer a request is about. Staff may
+// name any customer of their tenant; customers only ever act on themselves,
+// and default to themselves when they name no one.
+func customerIDFromRequest(ctx context.Context, requested string) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	staff := isStaff(claims)
+	if requested == "" {
+		if staff {
+			return uuid.Nil, status.Error(codes.InvalidArgument, "customer_id is required")
+		}
+		return claims.UserID, nil
+	}
+	customerID, err := uuid.Parse(requested)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid customer_id")
+	}
+	if !staff && customerID != claims.UserID {
+		return uuid.Nil, status.Error(codes.PermissionDenied, "customers may only access their own consent")
+	}
+	return customerID, nil
+}
+
+// optionalUUID parses an optional ID, returning nil when it is empty.
+func optionalUUID(raw, field string) (*uuid.UUID, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s", field)
+	}
+	return &id, nil
+}
+
+// optionalTime converts an optional timestamp, returning the zero time when
+// it is unset.
+func optionalTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// optionalTimestamp converts an optional time, returning nil when it is nil.
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// enumName strips an enum value's prefix, returning "" for the unspecified
+// value.
+func enumName(name, prefix string) string {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "UNSPECIFIED" {
+		return ""
+	}
+	return name
+}
+
+// uuidString formats an optional ID, returning "" when it is nil.
+func uuidString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+// Compile-time assertion that Handler implements ConsentServiceServer.
+var _ consentv1.ConsentServiceServer = (*Handler)(nil)
+
+// Handler implements the ConsentServiceServer gRPC interface.
+type Handler struct {
+	consentv1.UnimplementedConsentServiceServer
+	publishDisclosure *usecase.PublishDisclosureUseCase
+	listDisclosures   *usecase.ListDisclosuresUseCase
+	recordConsent     *usecase.RecordConsentUseCase
+	checkConsent      *usecase.CheckConsentUseCase
+	listConsents      *usecase.ListConsentsUseCase
+	listReceipts      *usecase.ListReceiptsUseCase
+	getReceipt        *usecase.GetReceiptUseCase
+	logger            *slog.Logger
+}
+
+// NewHandler creates a new gRPC Handler.
+func NewHandler(
+	publishDisclosure *usecase.PublishDisclosureUseCase,
+	listDisclosures *usecase.ListDisclosuresUseCase,
+	recordConsent *usecase.RecordConsentUseCase,
+	checkConsent *usecase.CheckConsentUseCase,
+	listConsents *usecase.ListConsentsUseCase,
+	listReceipts *usecase.ListReceiptsUseCase,
+	getReceipt *usecase.GetReceiptUseCase,
+	logger *slog.Logger,
+) *Handler {
+	return &Handler{
+		publishDisclosure: publishDisclosure,
+		listDisclosures:   listDisclosures,
+		recordConsent:     recordConsent,
+		checkConsent:      checkConsent,
+		listConsents:      listConsents,
+		listReceipts:      listReceipts,
+		getReceipt:        getReceipt,
+		logger:            logger,
+	}
+}
+
+// PublishDisclosure publishes a version of a consent type's disclosure.
+func (h *Handler) PublishDisclosure(ctx context.Context, req *consentv1.PublishDisclosureRequest) (*consentv1.PublishDisclosureResponse, error) {
+	if err := requireRole(ctx, disclosureRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.publishDisclosure.Execute(ctx, dto.PublishDisclosureRequest{
+		TenantID:      tenantID,
+		ConsentType:   enumName(req.Type.String(), "CONSENT_TYPE_"),
+		Version:       req.Version,
+		ContentHash:   req.ContentHash,
+		URL:           req.Url,
+		EffectiveFrom: optionalTime(req.EffectiveFrom),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &consentv1.PublishDisclosureResponse{Disclosure: toDisclosureMsg(resp)}, nil
+}
+
+// ListDisclosures lists the tenant's disclosures, latest effective first
+// within a type.
+func (h *Handler) ListDisclosures(ctx context.Context, req *consentv1.ListDisclosuresRequest) (*consentv1.ListDisclosuresResponse, error) {
+	if err := requireRole(ctx, readerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listDisclosures.Execute(ctx, dto.ListDisclosuresRequest{
+		TenantID:    tenantID,
+		ConsentType: enumName(req.Type.String(), "CONSENT_TYPE_"),
+		Limit:       int(req.PageSize),
+		Offset:      int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &consentv1.ListDisclosuresResponse{
+		Disclosures: make([]*consentv1.Disclosure, 0, len(resp.Disclosures)),
+		TotalCount:  int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, d := range resp.Disclosures {
+		out.Disclosures = append(out.Disclosures, toDisclosureMsg(d))
+	}
+	return out, nil
+}
+
+// RecordConsent records a customer granting or withdrawing consent.
+func (h *Handler) RecordConsent(ctx context.Context, req *consentv1.RecordConsentRequest) (*consentv1.RecordConsentResponse, error) {
+	if err := requireRole(ctx, recordRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	customerID, err := customerIDFromRequest(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	disclosureID, err := optionalUUID(req.DisclosureId, "disclosure ID")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		expiresAt = &t
+	}
+
+	resp, err := h.recordConsent.Execute(ctx, dto.RecordConsentRequest{
+		TenantID:     tenantID,
+		CustomerID:   customerID,
+		ConsentType:  enumName(req.Type.String(), "CONSENT_TYPE_"),
+		Action:       enumName(req.Action.String(), "CONSENT_ACTION_"),
+		DisclosureID: disclosureID,
+		Channel:      enumName(req.Channel.String(), "CAPTURE_CHANNEL_"),
+		Evidence:     req.Evidence,
+		CapturedAt:   optionalTime(req.CapturedAt),
+		ExpiresAt:    expiresAt,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &consentv1.RecordConsentResponse{Receipt: toReceiptMsg(resp)}, nil
+}
+
+// CheckConsent reports whether a customer's consent of a type stands.
+func (h *Handler) CheckConsent(ctx context.Context, req *consentv1.CheckConsentRequest) (*consentv1.CheckConsentResponse, error) {
+	if err := requireRole(ctx, readerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	customerID, err := customerIDFromRequest(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.checkConsent.Execute(ctx, tenantID, customerID, enumName(req.Type.String(), "CONSENT_TYPE_"))
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &consentv1.CheckConsentResponse{
+		Granted:   resp.Granted,
+		Status:    toStatusEnum(resp.Status),
+		ReceiptId: uuidString(resp.ReceiptID),
+	}, nil
+}
+
+// ListConsents reports a customer's standing consent of every type.
+func (h *Handler) ListConsents(ctx context.Context, req *consentv1.ListConsentsRequest) (*consentv1.ListConsentsResponse, error) {
+	if err := requireRole(ctx, readerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	customerID, err := customerIDFromRequest(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	states, err := h.listConsents.Execute(ctx, tenantID, customerID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	out := &consentv1.ListConsentsResponse{Consents: make([]*consentv1.ConsentState, 0, len(states))}
+	for _, s := range states {
+		state := &consentv1.ConsentState{
+			Type:   toTypeEnum(s.ConsentType),
+			Status: toStatusEnum(s.Status),
+		}
+		if s.Receipt != nil {
+			state.Receipt = toReceiptMsg(*s.Receipt)
+		}
+		out.Consents = append(out.Consents, state)
+	}
+	return out, nil
+}
+
+// ListReceipts lists a customer's consent receipts, newest first.
+func (h *Handler) ListReceipts(ctx context.Context, req *consentv1.ListReceiptsRequest) (*consentv1.ListReceiptsResponse, error) {
+	if err := requireRole(ctx, readerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	customerID, err := customerIDFromRequest(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listReceipts.Execute(ctx, dto.ListReceiptsRequest{
+		TenantID:    tenantID,
+		CustomerID:  customerID,
+		ConsentType: enumName(req.Type.String(), "CONSENT_TYPE_"),
+		Limit:       int(req.PageSize),
+		Offset:      int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &consentv1.ListReceiptsResponse{
+		Receipts:   make([]*consentv1.ConsentReceipt, 0, len(resp.Receipts)),
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, r := range resp.Receipts {
+		out.Receipts = append(out.Receipts, toReceiptMsg(r))
+	}
+	return out, nil
+}
+
+// GetReceipt retrieves a consent receipt and verifies it against its
+// chain.
+func (h *Handler) GetReceipt(ctx context.Context, req *consentv1.GetReceiptRequest) (*consentv1.GetReceiptResponse, error) {
+	if err := requireRole(ctx, readerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	receiptID, err := uuid.Parse(req.ReceiptId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid receipt ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.getReceipt.Execute(ctx, tenantID, receiptID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	// Customers only see their own receipts; others' are not found.
+	if claims, _ := auth.ClaimsFromContext(ctx); !isStaff(claims) && resp.CustomerID != claims.UserID {
+		return nil, status.Error(codes.NotFound, "consent receipt not found")
+	}
+	return &consentv1.GetReceiptResponse{Receipt: toReceiptMsg(resp), Verified: resp.Verified}, nil
+}
+
+func (h *Handler) toStatus(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidDisclosure), errors.Is(err, usecase.ErrInvalidConsent):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrNoDisclosure), errors.Is(err, model.ErrConsentNotGranted):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, port.ErrDisclosureExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, port.ErrDisclosureNotFound):
+		return status.Error(codes.NotFound, "disclosure not found")
+	case errors.Is(err, port.ErrReceiptNotFound):
+		return status.Error(codes.NotFound, "consent receipt not found")
+	case errors.Is(err, port.ErrReceiptConflict):
+		return status.Error(codes.Aborted, "consent recorded concurrently, retry")
+	default:
+		h.logger.Error("handler error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func toTypeEnum(s string) consentv1.ConsentType {
+	return consentv1.ConsentType(consentv1.ConsentType_value["CONSENT_TYPE_"+s])
+}
+
+func toStatusEnum(s string) consentv1.ConsentStatus {
+	return consentv1.ConsentStatus(consentv1.ConsentStatus_value["CONSENT_STATUS_"+s])
+}
+
+func toDisclosureMsg(d dto.DisclosureResponse) *consentv1.Disclosure {
+	return &consentv1.Disclosure{
+		DisclosureId:  d.ID.String(),
+		TenantId:      d.TenantID.String(),
+		Type:          toTypeEnum(d.ConsentType),
+		Version:       d.Version,
+		ContentHash:   d.ContentHash,
+		Url:           d.URL,
+		EffectiveFrom: timestamppb.New(d.EffectiveFrom),
+		PublishedAt:   timestamppb.New(d.PublishedAt),
+	}
+}
+
+func toReceiptMsg(r dto.ReceiptResponse) *consentv1.ConsentReceipt {
+	return &consentv1.ConsentReceipt{
+		ReceiptId:         r.ID.String(),
+		TenantId:          r.TenantID.String(),
+		CustomerId:        r.CustomerID.String(),
+		Type:              toTypeEnum(r.ConsentType),
+		Action:            consentv1.ConsentAction(consentv1.ConsentAction_value["CONSENT_ACTION_"+r.Action]),
+		DisclosureId:      uuidString(r.DisclosureID),
+		DisclosureVersion: r.DisclosureVersion,
+		ContentHash:       r.ContentHash,
+		Channel:           consentv1.CaptureChannel(consentv1.CaptureChannel_value["CAPTURE_CHANNEL_"+r.Channel]),
+		Evidence:          r.Evidence,
+		CapturedAt:        timestamppb.New(r.CapturedAt),
+		ExpiresAt:         optionalTimestamp(r.ExpiresAt),
+		Sequence:          int32(r.Sequence), //nolint:gosec // chain length
+		PreviousHash:      r.PreviousHash,
+		Hash:              r.Hash,
+		RecordedAt:        timestamppb.New(r.RecordedAt),
+	}
+}
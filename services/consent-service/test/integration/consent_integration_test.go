@@ -0,0 +1,144 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/outbox"
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/consent-service/internal/application/dto"
+	"github.com/bibbank/bib/services/consent-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/port"
+	"github.com/bibbank/bib/services/consent-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/consent-service/internal/infrastructure/postgres"
+)
+
+const (
+	topic       = "consent-events"
+	contentHash = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+)
+
+// suite wires the use cases over the real repositories and outbox.
+type suite struct {
+	env         *testutil.Env
+	disclosures *postgres.DisclosureRepo
+	receipts    *postgres.ReceiptRepo
+	publisher   *postgres.OutboxPublisher
+	tenantID    uuid.UUID
+	customerID  uuid.UUID
+}
+
+func setup(t *testing.T, withKafka bool) *suite {
+	t.Helper()
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir(), Kafka: withKafka})
+	return &suite{
+		env:         env,
+		disclosures: postgres.NewDisclosureRepo(env.Pool()),
+		receipts:    postgres.NewReceiptRepo(env.Pool()),
+		publisher:   postgres.NewOutboxPublisher(outbox.NewPublisher(outbox.NewStore(env.Pool())), topic),
+		tenantID:    uuid.New(),
+		customerID:  uuid.New(),
+	}
+}
+
+func (s *suite) publish(t *testing.T, consentType, version string) dto.DisclosureResponse {
+	t.Helper()
+	resp, err := usecase.NewPublishDisclosureUseCase(s.disclosures, s.publisher).Execute(context.Background(), dto.PublishDisclosureRequest{
+		TenantID:    s.tenantID,
+		ConsentType: consentType,
+		Version:     version,
+		ContentHash: contentHash,
+	})
+	require.NoError(t, err)
+	return resp
+}
+
+func (s *suite) record(consentType, action string) (dto.ReceiptResponse, error) {
+	uc := usecase.NewRecordConsentUseCase(s.receipts, s.disclosures, s.publisher, 30*24*time.Hour)
+	return uc.Execute(context.Background(), dto.RecordConsentRequest{
+		TenantID:    s.tenantID,
+		CustomerID:  s.customerID,
+		ConsentType: consentType,
+		Action:      action,
+		Channel:     "MOBILE",
+		Evidence:    "loan application, credit check screen",
+	})
+}
+
+func TestReceipts_ChainVerifiesAfterRoundTrip(t *testing.T) {
+	s := setup(t, false)
+	ctx := context.Background()
+	s.publish(t, "CREDIT_PULL", "2026-01")
+
+	granted, err := s.record("CREDIT_PULL", "GRANTED")
+	require.NoError(t, err)
+	require.NotNil(t, granted.ExpiresAt)
+	withdrawn, err := s.record("CREDIT_PULL", "WITHDRAWN")
+	require.NoError(t, err)
+
+	for _, id := range []uuid.UUID{granted.ID, withdrawn.ID} {
+		got, err := usecase.NewGetReceiptUseCase(s.receipts).Execute(ctx, s.tenantID, id)
+		require.NoError(t, err)
+		assert.True(t, got.Verified, "receipt %d", got.Sequence)
+	}
+
+	check, err := usecase.NewCheckConsentUseCase(s.receipts, s.disclosures).Execute(ctx, s.tenantID, s.customerID, "CREDIT_PULL")
+	require.NoError(t, err)
+	assert.Equal(t, "WITHDRAWN", check.Status)
+
+	list, err := usecase.NewListReceiptsUseCase(s.receipts).Execute(ctx, dto.ListReceiptsRequest{TenantID: s.tenantID, CustomerID: s.customerID})
+	require.NoError(t, err)
+	require.Equal(t, 2, list.TotalCount)
+	assert.Equal(t, withdrawn.ID, list.Receipts[0].ID)
+}
+
+func TestReceipts_AppendConflict(t *testing.T) {
+	s := setup(t, false)
+	ctx := context.Background()
+	_, err := s.record("MARKETING_EMAIL", "GRANTED")
+	require.NoError(t, err)
+
+	// A receipt following the same one as another is refused.
+	latest, err := s.receipts.FindLatest(ctx, s.tenantID, s.customerID, valueobject.ConsentMarketingEmail)
+	require.NoError(t, err)
+	err = s.receipts.Append(ctx, latest)
+	assert.ErrorIs(t, err, port.ErrReceiptConflict)
+}
+
+func TestOutbox_RelaysEventsToKafka(t *testing.T) {
+	s := setup(t, true)
+	ctx := context.Background()
+	s.publish(t, "TERMS_OF_SERVICE", "2026-01")
+	_, err := s.record("TERMS_OF_SERVICE", "GRANTED")
+	require.NoError(t, err)
+
+	producer := kafka.NewProducer(kafka.Config{Brokers: s.env.Brokers()})
+	t.Cleanup(func() { _ = producer.Close() })
+	relay, err := outbox.NewRelay(outbox.NewStore(s.env.Pool()), producer,
+		outbox.RelayConfig{Route: outbox.StaticRoute(topic)}, slog.Default())
+	require.NoError(t, err)
+
+	published, err := relay.Drain(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, published)
+
+	messages := s.env.Kafka.ReadMessages(t, topic, published, 30*time.Second)
+	var types []string
+	for _, msg := range messages {
+		for _, h := range msg.Headers {
+			if h.Key == "event_type" {
+				types = append(types, string(h.Value))
+			}
+		}
+	}
+	assert.Equal(t, []string{"consent.disclosure.published", "consent.granted"}, types)
+}
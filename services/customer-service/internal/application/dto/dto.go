@@ -0,0 +1,122 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AddressDTO is a postal address.
+type AddressDTO struct {
+	Type       string `json:"type"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+}
+
+// PartyDetailsDTO holds the descriptive fields of a party.
+type PartyDetailsDTO struct {
+	Type        string       `json:"type"`
+	GivenName   string       `json:"given_name,omitempty"`
+	FamilyName  string       `json:"family_name,omitempty"`
+	LegalName   string       `json:"legal_name,omitempty"`
+	DateOfBirth string       `json:"date_of_birth,omitempty"`
+	Email       string       `json:"email,omitempty"`
+	Phone       string       `json:"phone,omitempty"`
+	Addresses   []AddressDTO `json:"addresses"`
+}
+
+// CreatePartyRequest is the input DTO for creating a party.
+type CreatePartyRequest struct {
+	Details  PartyDetailsDTO `json:"details"`
+	TenantID uuid.UUID       `json:"tenant_id"`
+}
+
+// UpdatePartyRequest is the input DTO for replacing a party's details.
+type UpdatePartyRequest struct {
+	Details  PartyDetailsDTO `json:"details"`
+	TenantID uuid.UUID       `json:"tenant_id"`
+	PartyID  uuid.UUID       `json:"party_id"`
+}
+
+// RelationshipDTO relates a party to another party.
+type RelationshipDTO struct {
+	Type    string    `json:"type"`
+	PartyID uuid.UUID `json:"party_id"`
+}
+
+// RelationshipRequest is the input DTO for adding or removing a relationship.
+type RelationshipRequest struct {
+	Relationship RelationshipDTO `json:"relationship"`
+	TenantID     uuid.UUID       `json:"tenant_id"`
+	PartyID      uuid.UUID       `json:"party_id"`
+}
+
+// ExternalRefDTO identifies a record in another service that refers to a party.
+type ExternalRefDTO struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id"`
+}
+
+// LinkExternalRefRequest is the input DTO for linking an external reference.
+type LinkExternalRefRequest struct {
+	Ref      ExternalRefDTO `json:"ref"`
+	TenantID uuid.UUID      `json:"tenant_id"`
+	PartyID  uuid.UUID      `json:"party_id"`
+}
+
+// LinkKYCRequest is the input DTO for linking a party to an identity
+// verification.
+type LinkKYCRequest struct {
+	KYCStatus      string    `json:"kyc_status"`
+	TenantID       uuid.UUID `json:"tenant_id"`
+	PartyID        uuid.UUID `json:"party_id"`
+	VerificationID uuid.UUID `json:"verification_id"`
+}
+
+// MergePartiesRequest is the input DTO for merging a duplicate party into
+// the surviving party.
+type MergePartiesRequest struct {
+	TenantID    uuid.UUID `json:"tenant_id"`
+	SurvivorID  uuid.UUID `json:"survivor_id"`
+	DuplicateID uuid.UUID `json:"duplicate_id"`
+}
+
+// DuplicateMatchDTO is a party that may duplicate another.
+type DuplicateMatchDTO struct {
+	Reasons []string  `json:"reasons"`
+	Score   float64   `json:"score"`
+	PartyID uuid.UUID `json:"party_id"`
+}
+
+// PartyResponse is the output DTO for a party.
+type PartyResponse struct {
+	CreatedAt         time.Time         `json:"created_at"`
+	UpdatedAt         time.Time         `json:"updated_at"`
+	KYCVerificationID *uuid.UUID        `json:"kyc_verification_id,omitempty"`
+	MergedInto        *uuid.UUID        `json:"merged_into,omitempty"`
+	Details           PartyDetailsDTO   `json:"details"`
+	DisplayName       string            `json:"display_name"`
+	Status            string            `json:"status"`
+	KYCStatus         string            `json:"kyc_status"`
+	Relationships     []RelationshipDTO `json:"relationships"`
+	ExternalRefs      []ExternalRefDTO  `json:"external_refs"`
+	Version           int               `json:"version"`
+	ID                uuid.UUID         `json:"id"`
+}
+
+// CreatePartyResponse is the output DTO for a created party, with the
+// existing parties it may duplicate.
+type CreatePartyResponse struct {
+	Duplicates []DuplicateMatchDTO `json:"duplicates"`
+	Party      PartyResponse       `json:"party"`
+}
+
+// MergePartiesResponse is the output DTO for a merge.
+type MergePartiesResponse struct {
+	Survivor PartyResponse `json:"survivor"`
+	Merged   PartyResponse `json:"merged"`
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/port"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/valueobject"
+)
+
+// verificationOutcomes maps identity verification events to the KYC status
+// they give the parties linked to the verification.
+var verificationOutcomes = map[string]valueobject.KYCStatus{
+	"identity.verification.completed":          valueobject.KYCStatusVerified,
+	"identity.verification.rejected":           valueobject.KYCStatusRejected,
+	"identity.verification.expired":            valueobject.KYCStatusExpired,
+	"identity.verification.flagged_for_review": valueobject.KYCStatusPending,
+}
+
+type verificationEvent struct {
+	EventType      string `json:"event_type"`
+	TenantID       string `json:"tenant_id"`
+	VerificationID string `json:"verification_id"`
+}
+
+// HandleVerificationEventUseCase keeps the KYC status of parties in step
+// with the outcomes of the identity verifications linked to them.
+type HandleVerificationEventUseCase struct {
+	parties   port.PartyRepository
+	publisher port.EventPublisher
+}
+
+// NewHandleVerificationEventUseCase creates a new HandleVerificationEventUseCase.
+func NewHandleVerificationEventUseCase(parties port.PartyRepository, publisher port.EventPublisher) *HandleVerificationEventUseCase {
+	return &HandleVerificationEventUseCase{parties: parties, publisher: publisher}
+}
+
+// Execute records the outcome an identity verification event carries on the
+// linked parties, falling back to the type the event names when the message
+// carries none. Events without an outcome are ignored. It returns the number
+// of parties updated.
+func (uc *HandleVerificationEventUseCase) Execute(ctx context.Context, eventType string, payload []byte) (int, error) {
+	data, err := events.EventData(payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode event: %w", err)
+	}
+	var evt verificationEvent
+	if err := json.Unmarshal(data, &evt); err != nil {
+		return 0, fmt.Errorf("failed to decode event: %w", err)
+	}
+	if eventType == "" {
+		eventType = evt.EventType
+	}
+	status, ok := verificationOutcomes[eventType]
+	if !ok {
+		return 0, nil
+	}
+
+	tenantID, err := uuid.Parse(evt.TenantID)
+	if err != nil {
+		return 0, fmt.Errorf("%s event has invalid tenant ID %q: %w", eventType, evt.TenantID, err)
+	}
+	verificationID, err := uuid.Parse(evt.VerificationID)
+	if err != nil {
+		return 0, fmt.Errorf("%s event has invalid verification ID %q: %w", eventType, evt.VerificationID, err)
+	}
+
+	linked, err := uc.parties.FindByVerificationID(ctx, tenantID, verificationID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find parties: %w", err)
+	}
+	updated := 0
+	for _, p := range linked {
+		p, changed, err := p.RecordKYCOutcome(status, time.Now().UTC())
+		if err != nil {
+			return updated, fmt.Errorf("party %s: %w", p.ID(), err)
+		}
+		if !changed {
+			continue
+		}
+		if err := saveAndPublish(ctx, uc.parties, uc.publisher, p); err != nil {
+			return updated, err
+		}
+		updated++
+	}
+	return updated, nil
+}
@@ -0,0 +1,54 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/customer-service/internal/application/dto"
+	"github.com/bibbank/bib/services/customer-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/service"
+)
+
+func TestHandleVerificationEvent_UpdatesLinkedParties(t *testing.T) {
+	ctx := context.Background()
+	repo := &inMemoryPartyRepo{}
+	pub := &recordingPublisher{}
+	create := usecase.NewCreatePartyUseCase(repo, pub, service.NewDuplicateDetector(0))
+	linkKYC := usecase.NewLinkKYCUseCase(repo, pub)
+	get := usecase.NewGetPartyUseCase(repo)
+	uc := usecase.NewHandleVerificationEventUseCase(repo, pub)
+	tenantID, verificationID := uuid.New(), uuid.New()
+
+	party := createParty(t, create, tenantID, dto.PartyDetailsDTO{Type: "INDIVIDUAL", GivenName: "Jon", FamilyName: "Smith"})
+	linked, err := linkKYC.Execute(ctx, dto.LinkKYCRequest{TenantID: tenantID, PartyID: party.Party.ID, VerificationID: verificationID})
+	require.NoError(t, err)
+	assert.Equal(t, "PENDING", linked.KYCStatus)
+
+	payload, err := json.Marshal(map[string]string{
+		"event_type":      "identity.verification.completed",
+		"tenant_id":       tenantID.String(),
+		"verification_id": verificationID.String(),
+	})
+	require.NoError(t, err)
+
+	n, err := uc.Execute(ctx, "identity.verification.completed", payload)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	got, err := get.Execute(ctx, tenantID, party.Party.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "VERIFIED", got.KYCStatus)
+
+	n, err = uc.Execute(ctx, "identity.verification.completed", payload)
+	require.NoError(t, err)
+	assert.Zero(t, n, "redelivered events change nothing")
+
+	n, err = uc.Execute(ctx, "identity.verification.initiated", payload)
+	require.NoError(t, err)
+	assert.Zero(t, n, "events without an outcome are ignored")
+}
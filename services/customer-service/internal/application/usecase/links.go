@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/customer-service/internal/application/dto"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/model"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/port"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/valueobject"
+)
+
+// LinkExternalRefUseCase links a record in another service to the party it
+// refers to. A reference is linked to at most one party.
+type LinkExternalRefUseCase struct {
+	parties   port.PartyRepository
+	publisher port.EventPublisher
+}
+
+// NewLinkExternalRefUseCase creates a new LinkExternalRefUseCase.
+func NewLinkExternalRefUseCase(parties port.PartyRepository, publisher port.EventPublisher) *LinkExternalRefUseCase {
+	return &LinkExternalRefUseCase{parties: parties, publisher: publisher}
+}
+
+// Execute links the reference, failing with port.ErrExternalRefTaken when
+// another party holds it.
+func (uc *LinkExternalRefUseCase) Execute(ctx context.Context, req dto.LinkExternalRefRequest) (dto.PartyResponse, error) {
+	ref := model.ExternalRef{Kind: req.Ref.Kind, ID: req.Ref.ID}
+	return modifyParty(ctx, uc.parties, uc.publisher, req.TenantID, req.PartyID, func(p model.Party) (model.Party, error) {
+		return p.LinkExternalRef(ref, time.Now().UTC())
+	})
+}
+
+// LinkKYCUseCase links a party to the identity verification that verified it.
+type LinkKYCUseCase struct {
+	parties   port.PartyRepository
+	publisher port.EventPublisher
+}
+
+// NewLinkKYCUseCase creates a new LinkKYCUseCase.
+func NewLinkKYCUseCase(parties port.PartyRepository, publisher port.EventPublisher) *LinkKYCUseCase {
+	return &LinkKYCUseCase{parties: parties, publisher: publisher}
+}
+
+// Execute links the verification with its current outcome, PENDING when
+// none is given. Later outcomes arrive from identity verification events.
+func (uc *LinkKYCUseCase) Execute(ctx context.Context, req dto.LinkKYCRequest) (dto.PartyResponse, error) {
+	status := valueobject.KYCStatusPending
+	if req.KYCStatus != "" {
+		var err error
+		if status, err = valueobject.NewKYCStatus(req.KYCStatus); err != nil {
+			return dto.PartyResponse{}, fmt.Errorf("%w: %w", ErrInvalidParty, err)
+		}
+	}
+	return modifyParty(ctx, uc.parties, uc.publisher, req.TenantID, req.PartyID, func(p model.Party) (model.Party, error) {
+		return p.LinkKYC(req.VerificationID, status, time.Now().UTC())
+	})
+}
+
+// AddRelationshipUseCase relates a party to another active party.
+type AddRelationshipUseCase struct {
+	parties   port.PartyRepository
+	publisher port.EventPublisher
+}
+
+// NewAddRelationshipUseCase creates a new AddRelationshipUseCase.
+func NewAddRelationshipUseCase(parties port.PartyRepository, publisher port.EventPublisher) *AddRelationshipUseCase {
+	return &AddRelationshipUseCase{parties: parties, publisher: publisher}
+}
+
+// Execute adds the relationship.
+func (uc *AddRelationshipUseCase) Execute(ctx context.Context, req dto.RelationshipRequest) (dto.PartyResponse, error) {
+	rel, err := toRelationship(req.Relationship)
+	if err != nil {
+		return dto.PartyResponse{}, err
+	}
+	related, err := uc.parties.FindByID(ctx, req.TenantID, rel.PartyID)
+	if err != nil {
+		return dto.PartyResponse{}, fmt.Errorf("failed to find related party: %w", err)
+	}
+	if !related.Status().Equal(valueobject.PartyStatusActive) {
+		return dto.PartyResponse{}, fmt.Errorf("related party %s: %w", related.ID(), model.ErrPartyMerged)
+	}
+	return modifyParty(ctx, uc.parties, uc.publisher, req.TenantID, req.PartyID, func(p model.Party) (model.Party, error) {
+		return p.AddRelationship(rel, time.Now().UTC())
+	})
+}
+
+// RemoveRelationshipUseCase removes a relationship between parties.
+type RemoveRelationshipUseCase struct {
+	parties   port.PartyRepository
+	publisher port.EventPublisher
+}
+
+// NewRemoveRelationshipUseCase creates a new RemoveRelationshipUseCase.
+func NewRemoveRelationshipUseCase(parties port.PartyRepository, publisher port.EventPublisher) *RemoveRelationshipUseCase {
+	return &RemoveRelationshipUseCase{parties: parties, publisher: publisher}
+}
+
+// Execute removes the relationship.
+func (uc *RemoveRelationshipUseCase) Execute(ctx context.Context, req dto.RelationshipRequest) (dto.PartyResponse, error) {
+	rel, err := toRelationship(req.Relationship)
+	if err != nil {
+		return dto.PartyResponse{}, err
+	}
+	return modifyParty(ctx, uc.parties, uc.publisher, req.TenantID, req.PartyID, func(p model.Party) (model.Party, error) {
+		return p.RemoveRelationship(rel, time.Now().UTC())
+	})
+}
+
+func toRelationship(r dto.RelationshipDTO) (model.Relationship, error) {
+	relType, err := valueobject.NewRelationshipType(r.Type)
+	if err != nil {
+		return model.Relationship{}, fmt.Errorf("%w: %w", ErrInvalidParty, err)
+	}
+	return model.Relationship{Type: relType, PartyID: r.PartyID}, nil
+}
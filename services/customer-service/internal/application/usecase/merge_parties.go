@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/customer-service/internal/application/dto"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/model"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/port"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/service"
+)
+
+// FindDuplicatesUseCase finds the parties that may duplicate a party.
+type FindDuplicatesUseCase struct {
+	parties  port.PartyRepository
+	detector *service.DuplicateDetector
+}
+
+// NewFindDuplicatesUseCase creates a new FindDuplicatesUseCase.
+func NewFindDuplicatesUseCase(parties port.PartyRepository, detector *service.DuplicateDetector) *FindDuplicatesUseCase {
+	return &FindDuplicatesUseCase{parties: parties, detector: detector}
+}
+
+// Execute returns the party's possible duplicates, strongest first.
+func (uc *FindDuplicatesUseCase) Execute(ctx context.Context, tenantID, partyID uuid.UUID) ([]dto.DuplicateMatchDTO, error) {
+	party, err := uc.parties.FindByID(ctx, tenantID, partyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find party: %w", err)
+	}
+	candidates, err := uc.parties.FindCandidates(ctx, party, maxDuplicateCandidates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate candidates: %w", err)
+	}
+	return toDuplicateMatchDTOs(uc.detector.Detect(party, candidates)), nil
+}
+
+// MergePartiesUseCase merges a duplicate party into the surviving party.
+// The survivor takes over the duplicate's references, and relationships
+// other parties hold to the duplicate are re-pointed at the survivor, all in
+// one transaction.
+type MergePartiesUseCase struct {
+	parties   port.PartyRepository
+	publisher port.EventPublisher
+}
+
+// NewMergePartiesUseCase creates a new MergePartiesUseCase.
+func NewMergePartiesUseCase(parties port.PartyRepository, publisher port.EventPublisher) *MergePartiesUseCase {
+	return &MergePartiesUseCase{parties: parties, publisher: publisher}
+}
+
+// Execute merges the parties.
+func (uc *MergePartiesUseCase) Execute(ctx context.Context, req dto.MergePartiesRequest) (dto.MergePartiesResponse, error) {
+	survivor, err := uc.parties.FindByID(ctx, req.TenantID, req.SurvivorID)
+	if err != nil {
+		return dto.MergePartiesResponse{}, fmt.Errorf("failed to find surviving party: %w", err)
+	}
+	duplicate, err := uc.parties.FindByID(ctx, req.TenantID, req.DuplicateID)
+	if err != nil {
+		return dto.MergePartiesResponse{}, fmt.Errorf("failed to find duplicate party: %w", err)
+	}
+
+	now := time.Now().UTC()
+	survivor, duplicate, err = model.Merge(survivor, duplicate, now)
+	if err != nil {
+		return dto.MergePartiesResponse{}, fmt.Errorf("%w: %w", ErrInvalidParty, err)
+	}
+
+	changed := []model.Party{survivor, duplicate}
+	related, err := uc.parties.FindRelatedTo(ctx, req.TenantID, duplicate.ID())
+	if err != nil {
+		return dto.MergePartiesResponse{}, fmt.Errorf("failed to find related parties: %w", err)
+	}
+	for _, p := range related {
+		if p.ID() == survivor.ID() {
+			continue
+		}
+		if p, ok := p.RepointRelationships(duplicate.ID(), survivor.ID(), now); ok {
+			changed = append(changed, p)
+		}
+	}
+
+	if err := saveAndPublish(ctx, uc.parties, uc.publisher, changed...); err != nil {
+		return dto.MergePartiesResponse{}, err
+	}
+	return dto.MergePartiesResponse{
+		Survivor: toPartyResponse(survivor),
+		Merged:   toPartyResponse(duplicate),
+	}, nil
+}
@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/customer-service/internal/application/dto"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/model"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/port"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/service"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/valueobject"
+)
+
+// ErrInvalidParty is returned when a party, or a change to it, is malformed.
+var ErrInvalidParty = errors.New("invalid party")
+
+// maxDuplicateCandidates bounds the parties duplicate detection compares
+// one party against.
+const maxDuplicateCandidates = 200
+
+// CreatePartyUseCase creates a party and reports the existing parties it
+// may duplicate.
+type CreatePartyUseCase struct {
+	parties   port.PartyRepository
+	publisher port.EventPublisher
+	detector  *service.DuplicateDetector
+}
+
+// NewCreatePartyUseCase creates a new CreatePartyUseCase.
+func NewCreatePartyUseCase(parties port.PartyRepository, publisher port.EventPublisher, detector *service.DuplicateDetector) *CreatePartyUseCase {
+	return &CreatePartyUseCase{parties: parties, publisher: publisher, detector: detector}
+}
+
+// Execute creates the party. Possible duplicates do not block creation;
+// they are returned for review and merging.
+func (uc *CreatePartyUseCase) Execute(ctx context.Context, req dto.CreatePartyRequest) (dto.CreatePartyResponse, error) {
+	details, err := toDetails(req.Details)
+	if err != nil {
+		return dto.CreatePartyResponse{}, err
+	}
+	party, err := model.NewParty(req.TenantID, details, time.Now().UTC())
+	if err != nil {
+		return dto.CreatePartyResponse{}, fmt.Errorf("%w: %w", ErrInvalidParty, err)
+	}
+
+	candidates, err := uc.parties.FindCandidates(ctx, party, maxDuplicateCandidates)
+	if err != nil {
+		return dto.CreatePartyResponse{}, fmt.Errorf("failed to find duplicate candidates: %w", err)
+	}
+	matches := uc.detector.Detect(party, candidates)
+
+	if err := saveAndPublish(ctx, uc.parties, uc.publisher, party); err != nil {
+		return dto.CreatePartyResponse{}, err
+	}
+	return dto.CreatePartyResponse{
+		Party:      toPartyResponse(party),
+		Duplicates: toDuplicateMatchDTOs(matches),
+	}, nil
+}
+
+// UpdatePartyUseCase replaces a party's details.
+type UpdatePartyUseCase struct {
+	parties   port.PartyRepository
+	publisher port.EventPublisher
+}
+
+// NewUpdatePartyUseCase creates a new UpdatePartyUseCase.
+func NewUpdatePartyUseCase(parties port.PartyRepository, publisher port.EventPublisher) *UpdatePartyUseCase {
+	return &UpdatePartyUseCase{parties: parties, publisher: publisher}
+}
+
+// Execute replaces the party's details.
+func (uc *UpdatePartyUseCase) Execute(ctx context.Context, req dto.UpdatePartyRequest) (dto.PartyResponse, error) {
+	details, err := toDetails(req.Details)
+	if err != nil {
+		return dto.PartyResponse{}, err
+	}
+	return modifyParty(ctx, uc.parties, uc.publisher, req.TenantID, req.PartyID, func(p model.Party) (model.Party, error) {
+		return p.Update(details, time.Now().UTC())
+	})
+}
+
+// GetPartyUseCase retrieves a party. A merged party is returned as is; its
+// MergedInto names the survivor.
+type GetPartyUseCase struct {
+	parties port.PartyRepository
+}
+
+// NewGetPartyUseCase creates a new GetPartyUseCase.
+func NewGetPartyUseCase(parties port.PartyRepository) *GetPartyUseCase {
+	return &GetPartyUseCase{parties: parties}
+}
+
+// Execute retrieves the party.
+func (uc *GetPartyUseCase) Execute(ctx context.Context, tenantID, partyID uuid.UUID) (dto.PartyResponse, error) {
+	party, err := uc.parties.FindByID(ctx, tenantID, partyID)
+	if err != nil {
+		return dto.PartyResponse{}, fmt.Errorf("failed to find party: %w", err)
+	}
+	return toPartyResponse(party), nil
+}
+
+// FindPartyByRefUseCase resolves a record in another service, such as an
+// account holder, to the party it refers to.
+type FindPartyByRefUseCase struct {
+	parties port.PartyRepository
+}
+
+// NewFindPartyByRefUseCase creates a new FindPartyByRefUseCase.
+func NewFindPartyByRefUseCase(parties port.PartyRepository) *FindPartyByRefUseCase {
+	return &FindPartyByRefUseCase{parties: parties}
+}
+
+// Execute retrieves the party the reference is linked to.
+func (uc *FindPartyByRefUseCase) Execute(ctx context.Context, tenantID uuid.UUID, ref dto.ExternalRefDTO) (dto.PartyResponse, error) {
+	if ref.Kind == "" || ref.ID == "" {
+		return dto.PartyResponse{}, fmt.Errorf("%w: reference kind and ID must not be empty", ErrInvalidParty)
+	}
+	party, err := uc.parties.FindByExternalRef(ctx, tenantID, model.ExternalRef{Kind: ref.Kind, ID: ref.ID})
+	if err != nil {
+		return dto.PartyResponse{}, fmt.Errorf("failed to find party: %w", err)
+	}
+	return toPartyResponse(party), nil
+}
+
+// modifyParty loads a party, applies a change and saves and publishes the
+// result. Changes to merged parties fail with model.ErrPartyMerged; other
+// rejected changes with ErrInvalidParty.
+func modifyParty(
+	ctx context.Context,
+	parties port.PartyRepository,
+	publisher port.EventPublisher,
+	tenantID, partyID uuid.UUID,
+	change func(model.Party) (model.Party, error),
+) (dto.PartyResponse, error) {
+	party, err := parties.FindByID(ctx, tenantID, partyID)
+	if err != nil {
+		return dto.PartyResponse{}, fmt.Errorf("failed to find party: %w", err)
+	}
+	party, err = change(party)
+	if errors.Is(err, model.ErrPartyMerged) {
+		return dto.PartyResponse{}, err
+	}
+	if err != nil {
+		return dto.PartyResponse{}, fmt.Errorf("%w: %w", ErrInvalidParty, err)
+	}
+	if len(party.DomainEvents()) == 0 {
+		return toPartyResponse(party), nil
+	}
+	if err := saveAndPublish(ctx, parties, publisher, party); err != nil {
+		return dto.PartyResponse{}, err
+	}
+	return toPartyResponse(party), nil
+}
+
+// saveAndPublish saves the parties atomically, then publishes their events.
+func saveAndPublish(ctx context.Context, parties port.PartyRepository, publisher port.EventPublisher, changed ...model.Party) error {
+	if err := parties.Save(ctx, changed...); err != nil {
+		return fmt.Errorf("failed to save party: %w", err)
+	}
+	for _, p := range changed {
+		if events := p.DomainEvents(); len(events) > 0 {
+			if err := publisher.Publish(ctx, events); err != nil {
+				return fmt.Errorf("failed to publish events: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func toDetails(d dto.PartyDetailsDTO) (model.PartyDetails, error) {
+	partyType, err := valueobject.NewPartyType(d.Type)
+	if err != nil {
+		return model.PartyDetails{}, fmt.Errorf("%w: %w", ErrInvalidParty, err)
+	}
+	details := model.PartyDetails{
+		Type:        partyType,
+		GivenName:   d.GivenName,
+		FamilyName:  d.FamilyName,
+		LegalName:   d.LegalName,
+		DateOfBirth: d.DateOfBirth,
+		Email:       d.Email,
+		Phone:       d.Phone,
+		Addresses:   make([]model.Address, 0, len(d.Addresses)),
+	}
+	for _, a := range d.Addresses {
+		addressType, err := valueobject.NewAddressType(a.Type)
+		if err != nil {
+			return model.PartyDetails{}, fmt.Errorf("%w: %w", ErrInvalidParty, err)
+		}
+		details.Addresses = append(details.Addresses, model.Address{
+			Type:       addressType,
+			Line1:      a.Line1,
+			Line2:      a.Line2,
+			City:       a.City,
+			Region:     a.Region,
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+		})
+	}
+	return details, nil
+}
+
+func toPartyResponse(p model.Party) dto.PartyResponse {
+	d := p.Details()
+	addresses := make([]dto.AddressDTO, 0, len(d.Addresses))
+	for _, a := range d.Addresses {
+		addresses = append(addresses, dto.AddressDTO{
+			Type:       a.Type.String(),
+			Line1:      a.Line1,
+			Line2:      a.Line2,
+			City:       a.City,
+			Region:     a.Region,
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+		})
+	}
+	relationships := make([]dto.RelationshipDTO, 0, len(p.Relationships()))
+	for _, rel := range p.Relationships() {
+		relationships = append(relationships, dto.RelationshipDTO{Type: rel.Type.String(), PartyID: rel.PartyID})
+	}
+	refs := make([]dto.ExternalRefDTO, 0, len(p.ExternalRefs()))
+	for _, ref := range p.ExternalRefs() {
+		refs = append(refs, dto.ExternalRefDTO{Kind: ref.Kind, ID: ref.ID})
+	}
+	return dto.PartyResponse{
+		ID:          p.ID(),
+		DisplayName: p.DisplayName(),
+		Details: dto.PartyDetailsDTO{
+			Type:        d.Type.String(),
+			GivenName:   d.GivenName,
+			FamilyName:  d.FamilyName,
+			LegalName:   d.LegalName,
+			DateOfBirth: d.DateOfBirth,
+			Email:       d.Email,
+			Phone:       d.Phone,
+			Addresses:   addresses,
+		},
+		Status:            p.Status().String(),
+		KYCVerificationID: p.KYCVerificationID(),
+		KYCStatus:         p.KYCStatus().String(),
+		Relationships:     relationships,
+		ExternalRefs:      refs,
+		MergedInto:        p.MergedInto(),
+		Version:           p.Version(),
+		CreatedAt:         p.CreatedAt(),
+		UpdatedAt:         p.UpdatedAt(),
+	}
+}
+
+func toDuplicateMatchDTOs(matches []model.DuplicateMatch) []dto.DuplicateMatchDTO {
+	out := make([]dto.DuplicateMatchDTO, 0, len(matches))
+	for _, m := range matches {
+		out = append(out, dto.DuplicateMatchDTO{PartyID: m.PartyID, Reasons: m.Reasons, Score: m.Score})
+	}
+	return out
+}
@@ -0,0 +1,121 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/customer-service/internal/application/dto"
+	"github.com/bibbank/bib/services/customer-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/port"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/service"
+)
+
+func createParty(t *testing.T, uc *usecase.CreatePartyUseCase, tenantID uuid.UUID, d dto.PartyDetailsDTO) dto.CreatePartyResponse {
+	t.Helper()
+	resp, err := uc.Execute(context.Background(), dto.CreatePartyRequest{TenantID: tenantID, Details: d})
+	require.NoError(t, err)
+	return resp
+}
+
+func TestCreateParty_ReportsDuplicates(t *testing.T) {
+	repo := &inMemoryPartyRepo{}
+	pub := &recordingPublisher{}
+	uc := usecase.NewCreatePartyUseCase(repo, pub, service.NewDuplicateDetector(0))
+	tenantID := uuid.New()
+
+	first := createParty(t, uc, tenantID, dto.PartyDetailsDTO{
+		Type: "INDIVIDUAL", GivenName: "Jon", FamilyName: "Smith", DateOfBirth: "1980-04-01",
+	})
+	assert.Empty(t, first.Duplicates)
+
+	second := createParty(t, uc, tenantID, dto.PartyDetailsDTO{
+		Type: "INDIVIDUAL", GivenName: "John", FamilyName: "Smith", DateOfBirth: "1980-04-01",
+	})
+	require.Len(t, second.Duplicates, 1, "possible duplicates do not block creation")
+	assert.Equal(t, first.Party.ID, second.Duplicates[0].PartyID)
+	assert.Len(t, repo.parties, 2)
+	assert.Equal(t, []string{"party.created", "party.created"}, pub.eventTypes())
+
+	_, err := uc.Execute(context.Background(), dto.CreatePartyRequest{
+		TenantID: tenantID, Details: dto.PartyDetailsDTO{Type: "TRUST", LegalName: "x"},
+	})
+	assert.True(t, errors.Is(err, usecase.ErrInvalidParty))
+}
+
+func TestLinkExternalRef_RefersToOneParty(t *testing.T) {
+	repo := &inMemoryPartyRepo{}
+	pub := &recordingPublisher{}
+	create := usecase.NewCreatePartyUseCase(repo, pub, service.NewDuplicateDetector(0))
+	link := usecase.NewLinkExternalRefUseCase(repo, pub)
+	find := usecase.NewFindPartyByRefUseCase(repo)
+	tenantID := uuid.New()
+	ref := dto.ExternalRefDTO{Kind: "account_holder", ID: "acc-1"}
+
+	a := createParty(t, create, tenantID, dto.PartyDetailsDTO{Type: "ORGANIZATION", LegalName: "Acme Ltd"})
+	b := createParty(t, create, tenantID, dto.PartyDetailsDTO{Type: "ORGANIZATION", LegalName: "Globex plc"})
+
+	_, err := link.Execute(context.Background(), dto.LinkExternalRefRequest{TenantID: tenantID, PartyID: a.Party.ID, Ref: ref})
+	require.NoError(t, err)
+
+	found, err := find.Execute(context.Background(), tenantID, ref)
+	require.NoError(t, err)
+	assert.Equal(t, a.Party.ID, found.ID)
+
+	_, err = link.Execute(context.Background(), dto.LinkExternalRefRequest{TenantID: tenantID, PartyID: b.Party.ID, Ref: ref})
+	assert.True(t, errors.Is(err, port.ErrExternalRefTaken))
+}
+
+func TestMergeParties(t *testing.T) {
+	ctx := context.Background()
+	repo := &inMemoryPartyRepo{}
+	pub := &recordingPublisher{}
+	create := usecase.NewCreatePartyUseCase(repo, pub, service.NewDuplicateDetector(0))
+	link := usecase.NewLinkExternalRefUseCase(repo, pub)
+	relate := usecase.NewAddRelationshipUseCase(repo, pub)
+	merge := usecase.NewMergePartiesUseCase(repo, pub)
+	find := usecase.NewFindPartyByRefUseCase(repo)
+	get := usecase.NewGetPartyUseCase(repo)
+	tenantID := uuid.New()
+
+	survivor := createParty(t, create, tenantID, dto.PartyDetailsDTO{Type: "INDIVIDUAL", GivenName: "Jon", FamilyName: "Smith"})
+	duplicate := createParty(t, create, tenantID, dto.PartyDetailsDTO{Type: "INDIVIDUAL", GivenName: "John", FamilyName: "Smith"})
+	spouse := createParty(t, create, tenantID, dto.PartyDetailsDTO{Type: "INDIVIDUAL", GivenName: "Jane", FamilyName: "Smith"})
+
+	ref := dto.ExternalRefDTO{Kind: "loan_applicant", ID: "app-7"}
+	_, err := link.Execute(ctx, dto.LinkExternalRefRequest{TenantID: tenantID, PartyID: duplicate.Party.ID, Ref: ref})
+	require.NoError(t, err)
+	_, err = relate.Execute(ctx, dto.RelationshipRequest{
+		TenantID: tenantID, PartyID: spouse.Party.ID,
+		Relationship: dto.RelationshipDTO{Type: "SPOUSE", PartyID: duplicate.Party.ID},
+	})
+	require.NoError(t, err)
+
+	resp, err := merge.Execute(ctx, dto.MergePartiesRequest{
+		TenantID: tenantID, SurvivorID: survivor.Party.ID, DuplicateID: duplicate.Party.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "MERGED", resp.Merged.Status)
+	require.NotNil(t, resp.Merged.MergedInto)
+	assert.Equal(t, survivor.Party.ID, *resp.Merged.MergedInto)
+
+	found, err := find.Execute(ctx, tenantID, ref)
+	require.NoError(t, err)
+	assert.Equal(t, survivor.Party.ID, found.ID, "references move to the survivor")
+
+	related, err := get.Execute(ctx, tenantID, spouse.Party.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []dto.RelationshipDTO{{Type: "SPOUSE", PartyID: survivor.Party.ID}}, related.Relationships,
+		"relationships to the duplicate are re-pointed at the survivor")
+	assert.Contains(t, pub.eventTypes(), "party.merged")
+
+	_, err = relate.Execute(ctx, dto.RelationshipRequest{
+		TenantID: tenantID, PartyID: spouse.Party.ID,
+		Relationship: dto.RelationshipDTO{Type: "JOINT_HOLDER", PartyID: duplicate.Party.ID},
+	})
+	assert.Error(t, err, "merged parties cannot be related to")
+}
@@ -0,0 +1,132 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/customer-service/internal/domain/event"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/model"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/port"
+)
+
+type inMemoryPartyRepo struct {
+	parties []model.Party
+}
+
+func (r *inMemoryPartyRepo) Save(_ context.Context, parties ...model.Party) error {
+	for _, p := range parties {
+		for _, ref := range p.ExternalRefs() {
+			for _, other := range r.parties {
+				if other.ID() != p.ID() && !slices.ContainsFunc(parties, func(q model.Party) bool { return q.ID() == other.ID() }) &&
+					slices.Contains(other.ExternalRefs(), ref) {
+					return port.ErrExternalRefTaken
+				}
+			}
+		}
+	}
+	for _, p := range parties {
+		p = p.ClearDomainEvents()
+		i := slices.IndexFunc(r.parties, func(existing model.Party) bool { return existing.ID() == p.ID() })
+		if i < 0 {
+			r.parties = append(r.parties, p)
+			continue
+		}
+		r.parties[i] = p
+	}
+	return nil
+}
+
+func (r *inMemoryPartyRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.Party, error) {
+	for _, p := range r.parties {
+		if p.TenantID() == tenantID && p.ID() == id {
+			return p, nil
+		}
+	}
+	return model.Party{}, port.ErrPartyNotFound
+}
+
+func (r *inMemoryPartyRepo) FindByExternalRef(_ context.Context, tenantID uuid.UUID, ref model.ExternalRef) (model.Party, error) {
+	for _, p := range r.parties {
+		if p.TenantID() == tenantID && slices.Contains(p.ExternalRefs(), ref) {
+			return p, nil
+		}
+	}
+	return model.Party{}, port.ErrPartyNotFound
+}
+
+func (r *inMemoryPartyRepo) FindByVerificationID(_ context.Context, tenantID, verificationID uuid.UUID) ([]model.Party, error) {
+	var result []model.Party
+	for _, p := range r.active(tenantID) {
+		if id := p.KYCVerificationID(); id != nil && *id == verificationID {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+func (r *inMemoryPartyRepo) FindRelatedTo(_ context.Context, tenantID, partyID uuid.UUID) ([]model.Party, error) {
+	var result []model.Party
+	for _, p := range r.active(tenantID) {
+		if slices.ContainsFunc(p.Relationships(), func(rel model.Relationship) bool { return rel.PartyID == partyID }) {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+func (r *inMemoryPartyRepo) FindCandidates(_ context.Context, party model.Party, limit int) ([]model.Party, error) {
+	d := party.Details()
+	var result []model.Party
+	for _, p := range r.active(party.TenantID()) {
+		cd := p.Details()
+		if p.ID() == party.ID() || !p.Type().Equal(party.Type()) {
+			continue
+		}
+		if (d.Email != "" && cd.Email == d.Email) || (d.Phone != "" && cd.Phone == d.Phone) ||
+			(d.DateOfBirth != "" && cd.DateOfBirth == d.DateOfBirth) ||
+			(d.LegalName != "" && len(cd.LegalName) >= 4 && len(d.LegalName) >= 4 &&
+				strings.EqualFold(cd.LegalName[:4], d.LegalName[:4])) {
+			result = append(result, p)
+		}
+		if len(result) == limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (r *inMemoryPartyRepo) active(tenantID uuid.UUID) []model.Party {
+	var result []model.Party
+	for _, p := range r.parties {
+		if p.TenantID() == tenantID && p.MergedInto() == nil {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+type recordingPublisher struct {
+	mu     sync.Mutex
+	events []event.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, events []event.DomainEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, events...)
+	return nil
+}
+
+func (p *recordingPublisher) eventTypes() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	types := make([]string, 0, len(p.events))
+	for _, e := range p.events {
+		types = append(types, e.EventType())
+	}
+	return types
+}
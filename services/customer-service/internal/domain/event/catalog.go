@@ -0,0 +1,14 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[PartyCreated]("party.created", 1, "Emitted when a party record is created."),
+		events.Declare[PartyUpdated]("party.updated", 1, "Emitted when a party's details, relationships or references to it from other services change."),
+		events.Declare[PartyKYCUpdated]("party.kyc_updated", 1, "Emitted when a party is linked to an identity verification, or the linked verification's outcome changes."),
+		events.Declare[PartyMerged]("party.merged", 1, "Emitted on the surviving party when a duplicate is merged into it."),
+	}
+}
@@ -0,0 +1,85 @@
+package event
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// DomainEvent is an alias for the shared pkg/events.DomainEvent interface.
+type DomainEvent = events.DomainEvent
+
+// AggregateTypeParty is the aggregate type of party events.
+const AggregateTypeParty = "Party"
+
+// PartyCreated is emitted when a party record is created.
+type PartyCreated struct {
+	events.BaseEvent
+	PartyType   string `json:"party_type"`
+	DisplayName string `json:"display_name"`
+}
+
+// NewPartyCreated creates a new PartyCreated event.
+func NewPartyCreated(partyID, tenantID uuid.UUID, partyType, displayName string) PartyCreated {
+	return PartyCreated{
+		BaseEvent:   events.NewBaseEvent("party.created", partyID.String(), AggregateTypeParty, tenantID.String()),
+		PartyType:   partyType,
+		DisplayName: displayName,
+	}
+}
+
+// PartyUpdated is emitted when a party's details, relationships or
+// references to it from other services change.
+type PartyUpdated struct {
+	events.BaseEvent
+	Version int `json:"version"`
+}
+
+// NewPartyUpdated creates a new PartyUpdated event.
+func NewPartyUpdated(partyID, tenantID uuid.UUID, version int) PartyUpdated {
+	return PartyUpdated{
+		BaseEvent: events.NewBaseEvent("party.updated", partyID.String(), AggregateTypeParty, tenantID.String()),
+		Version:   version,
+	}
+}
+
+// PartyKYCUpdated is emitted when a party is linked to an identity
+// verification, or the linked verification's outcome changes.
+type PartyKYCUpdated struct {
+	events.BaseEvent
+	VerificationID string `json:"verification_id"`
+	KYCStatus      string `json:"kyc_status"`
+}
+
+// NewPartyKYCUpdated creates a new PartyKYCUpdated event.
+func NewPartyKYCUpdated(partyID, tenantID, verificationID uuid.UUID, kycStatus string) PartyKYCUpdated {
+	return PartyKYCUpdated{
+		BaseEvent:      events.NewBaseEvent("party.kyc_updated", partyID.String(), AggregateTypeParty, tenantID.String()),
+		VerificationID: verificationID.String(),
+		KYCStatus:      kycStatus,
+	}
+}
+
+// ExternalRef is a record in another service that refers to a party.
+type ExternalRef struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id"`
+}
+
+// PartyMerged is emitted on the surviving party when a duplicate is merged
+// into it. Services holding the merged party's ID, or one of the moved
+// references, re-point them at the survivor.
+type PartyMerged struct {
+	events.BaseEvent
+	MergedPartyID string        `json:"merged_party_id"`
+	MovedRefs     []ExternalRef `json:"moved_refs"`
+}
+
+// NewPartyMerged creates a new PartyMerged event.
+func NewPartyMerged(survivorID, tenantID, mergedID uuid.UUID, movedRefs []ExternalRef) PartyMerged {
+	return PartyMerged{
+		BaseEvent:     events.NewBaseEvent("party.merged", survivorID.String(), AggregateTypeParty, tenantID.String()),
+		MergedPartyID: mergedID.String(),
+		MovedRefs:     movedRefs,
+	}
+}
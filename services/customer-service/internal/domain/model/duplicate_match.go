@@ -0,0 +1,12 @@
+package model
+
+import "github.com/google/uuid"
+
+// DuplicateMatch is an existing party that may be the same person or
+// organisation as another, with the identifiers that matched and a score in
+// (0, 1].
+type DuplicateMatch struct {
+	Reasons []string
+	Score   float64
+	PartyID uuid.UUID
+}
@@ -0,0 +1,441 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/event"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/valueobject"
+)
+
+var (
+	e164RE    = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+	countryRE = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// ErrPartyMerged is returned when changing a party that has been merged
+// into another.
+var ErrPartyMerged = errors.New("party has been merged")
+
+// Address is a postal address of a party.
+type Address struct {
+	Type       valueobject.AddressType
+	Line1      string
+	Line2      string
+	City       string
+	Region     string
+	PostalCode string
+	// Country is an ISO 3166-1 alpha-2 code.
+	Country string
+}
+
+// Relationship links a party to another party of the same tenant.
+type Relationship struct {
+	Type    valueobject.RelationshipType
+	PartyID uuid.UUID
+}
+
+// ExternalRef identifies a record in another service that refers to a
+// party, such as an account holder, loan applicant or card holder.
+type ExternalRef struct {
+	// Kind names the referring record, e.g. "account_holder".
+	Kind string
+	ID   string
+}
+
+// PartyDetails are the descriptive fields of a party. Individuals have a
+// given and family name and optionally a date of birth; organisations have a
+// legal name.
+type PartyDetails struct {
+	Type        valueobject.PartyType
+	GivenName   string
+	FamilyName  string
+	LegalName   string
+	DateOfBirth string // YYYY-MM-DD
+	Email       string
+	Phone       string // E.164
+	Addresses   []Address
+}
+
+// Party is the master record of a customer: a person or organisation the
+// bank has a relationship with. Other services refer to it by ID and link
+// their own records to it as external references. A party found to
+// duplicate another is merged into it and kept only as a pointer to the
+// survivor.
+type Party struct {
+	createdAt         time.Time
+	updatedAt         time.Time
+	mergedInto        *uuid.UUID
+	kycVerificationID *uuid.UUID
+	details           PartyDetails
+	status            valueobject.PartyStatus
+	kycStatus         valueobject.KYCStatus
+	relationships     []Relationship
+	externalRefs      []ExternalRef
+	domainEvents      []events.DomainEvent
+	version           int
+	id                uuid.UUID
+	tenantID          uuid.UUID
+}
+
+// NewParty creates an ACTIVE, unverified party.
+func NewParty(tenantID uuid.UUID, details PartyDetails, now time.Time) (Party, error) {
+	if tenantID == uuid.Nil {
+		return Party{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	details, err := validateDetails(details, now)
+	if err != nil {
+		return Party{}, err
+	}
+	p := Party{
+		id:        uuid.New(),
+		tenantID:  tenantID,
+		details:   details,
+		status:    valueobject.PartyStatusActive,
+		kycStatus: valueobject.KYCStatusUnverified,
+		version:   1,
+		createdAt: now,
+		updatedAt: now,
+	}
+	p.domainEvents = append(p.domainEvents, event.NewPartyCreated(p.id, tenantID, details.Type.String(), p.DisplayName()))
+	return p, nil
+}
+
+// ReconstructParty recreates a Party from persisted data without validation
+// or events.
+func ReconstructParty(
+	id, tenantID uuid.UUID,
+	details PartyDetails,
+	status valueobject.PartyStatus,
+	kycVerificationID *uuid.UUID,
+	kycStatus valueobject.KYCStatus,
+	relationships []Relationship,
+	externalRefs []ExternalRef,
+	mergedInto *uuid.UUID,
+	version int,
+	createdAt, updatedAt time.Time,
+) Party {
+	return Party{
+		id:                id,
+		tenantID:          tenantID,
+		details:           details,
+		status:            status,
+		kycVerificationID: kycVerificationID,
+		kycStatus:         kycStatus,
+		relationships:     relationships,
+		externalRefs:      externalRefs,
+		mergedInto:        mergedInto,
+		version:           version,
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
+	}
+}
+
+// Update replaces the party's details. A party's type never changes.
+func (p Party) Update(details PartyDetails, now time.Time) (Party, error) {
+	if err := p.requireActive("update"); err != nil {
+		return p, err
+	}
+	if !details.Type.Equal(p.details.Type) {
+		return p, fmt.Errorf("cannot change party type from %s to %s", p.details.Type, details.Type)
+	}
+	details, err := validateDetails(details, now)
+	if err != nil {
+		return p, err
+	}
+	p.details = details
+	return p.touch(now), nil
+}
+
+// LinkKYC links the party to an identity verification and records its
+// current outcome, replacing any earlier link.
+func (p Party) LinkKYC(verificationID uuid.UUID, status valueobject.KYCStatus, now time.Time) (Party, error) {
+	if err := p.requireActive("link KYC"); err != nil {
+		return p, err
+	}
+	if verificationID == uuid.Nil {
+		return p, fmt.Errorf("verification ID must not be empty")
+	}
+	if status.IsZero() || status.Equal(valueobject.KYCStatusUnverified) {
+		return p, fmt.Errorf("a linked verification must have an outcome, got %q", status)
+	}
+	p.kycVerificationID = &verificationID
+	p.kycStatus = status
+	p.version++
+	p.updatedAt = now
+	p.domainEvents = append(p.domainEvents, event.NewPartyKYCUpdated(p.id, p.tenantID, verificationID, status.String()))
+	return p, nil
+}
+
+// RecordKYCOutcome records a new outcome of the linked verification. It
+// reports false, leaving the party unchanged, when the outcome is already
+// recorded.
+func (p Party) RecordKYCOutcome(status valueobject.KYCStatus, now time.Time) (Party, bool, error) {
+	if p.kycVerificationID == nil {
+		return p, false, fmt.Errorf("party %s has no linked verification", p.id)
+	}
+	if p.kycStatus.Equal(status) {
+		return p, false, nil
+	}
+	p, err := p.LinkKYC(*p.kycVerificationID, status, now)
+	return p, err == nil, err
+}
+
+// AddRelationship relates the party to another party. Adding an existing
+// relationship leaves the party unchanged.
+func (p Party) AddRelationship(rel Relationship, now time.Time) (Party, error) {
+	if err := p.requireActive("add relationship"); err != nil {
+		return p, err
+	}
+	if rel.Type.IsZero() || rel.PartyID == uuid.Nil {
+		return p, fmt.Errorf("relationship type and party must not be empty")
+	}
+	if rel.PartyID == p.id {
+		return p, fmt.Errorf("a party cannot be related to itself")
+	}
+	if slices.Contains(p.relationships, rel) {
+		return p, nil
+	}
+	p.relationships = append(slices.Clone(p.relationships), rel)
+	return p.touch(now), nil
+}
+
+// RemoveRelationship removes a relationship. Removing a missing
+// relationship leaves the party unchanged.
+func (p Party) RemoveRelationship(rel Relationship, now time.Time) (Party, error) {
+	if err := p.requireActive("remove relationship"); err != nil {
+		return p, err
+	}
+	i := slices.Index(p.relationships, rel)
+	if i < 0 {
+		return p, nil
+	}
+	p.relationships = slices.Delete(slices.Clone(p.relationships), i, i+1)
+	return p.touch(now), nil
+}
+
+// LinkExternalRef records that a record in another service refers to the
+// party. Linking an existing reference leaves the party unchanged.
+func (p Party) LinkExternalRef(ref ExternalRef, now time.Time) (Party, error) {
+	if err := p.requireActive("link reference"); err != nil {
+		return p, err
+	}
+	if ref.Kind == "" || ref.ID == "" {
+		return p, fmt.Errorf("reference kind and ID must not be empty")
+	}
+	if slices.Contains(p.externalRefs, ref) {
+		return p, nil
+	}
+	p.externalRefs = append(slices.Clone(p.externalRefs), ref)
+	return p.touch(now), nil
+}
+
+// Merge merges a duplicate party into the survivor. The survivor keeps its
+// own details and fills the gaps from the duplicate's; it takes over the
+// duplicate's addresses, relationships and external references, and its
+// verification when the survivor is not verified itself. The duplicate is
+// left MERGED, pointing at the survivor.
+func Merge(survivor, duplicate Party, now time.Time) (Party, Party, error) {
+	if err := survivor.requireActive("merge into"); err != nil {
+		return survivor, duplicate, err
+	}
+	if err := duplicate.requireActive("merge"); err != nil {
+		return survivor, duplicate, err
+	}
+	if survivor.id == duplicate.id {
+		return survivor, duplicate, fmt.Errorf("cannot merge a party into itself")
+	}
+	if survivor.tenantID != duplicate.tenantID {
+		return survivor, duplicate, fmt.Errorf("cannot merge parties of different tenants")
+	}
+	if !survivor.details.Type.Equal(duplicate.details.Type) {
+		return survivor, duplicate, fmt.Errorf("cannot merge a %s party into a %s party", duplicate.details.Type, survivor.details.Type)
+	}
+
+	d := survivor.details
+	if d.DateOfBirth == "" {
+		d.DateOfBirth = duplicate.details.DateOfBirth
+	}
+	if d.Email == "" {
+		d.Email = duplicate.details.Email
+	}
+	if d.Phone == "" {
+		d.Phone = duplicate.details.Phone
+	}
+	d.Addresses = slices.Clone(d.Addresses)
+	for _, a := range duplicate.details.Addresses {
+		if !slices.Contains(d.Addresses, a) {
+			d.Addresses = append(d.Addresses, a)
+		}
+	}
+	survivor.details = d
+
+	// Relationships between the two parties would become self-relationships.
+	rels := make([]Relationship, 0, len(survivor.relationships)+len(duplicate.relationships))
+	for _, rel := range slices.Concat(survivor.relationships, duplicate.relationships) {
+		if rel.PartyID != survivor.id && rel.PartyID != duplicate.id && !slices.Contains(rels, rel) {
+			rels = append(rels, rel)
+		}
+	}
+	survivor.relationships = rels
+
+	moved := make([]event.ExternalRef, 0, len(duplicate.externalRefs))
+	survivor.externalRefs = slices.Clone(survivor.externalRefs)
+	for _, ref := range duplicate.externalRefs {
+		if !slices.Contains(survivor.externalRefs, ref) {
+			survivor.externalRefs = append(survivor.externalRefs, ref)
+		}
+		moved = append(moved, event.ExternalRef{Kind: ref.Kind, ID: ref.ID})
+	}
+
+	if duplicate.kycVerificationID != nil && !survivor.kycStatus.Equal(valueobject.KYCStatusVerified) &&
+		(survivor.kycVerificationID == nil || duplicate.kycStatus.Equal(valueobject.KYCStatusVerified)) {
+		survivor.kycVerificationID = duplicate.kycVerificationID
+		survivor.kycStatus = duplicate.kycStatus
+	}
+
+	survivor.version++
+	survivor.updatedAt = now
+	survivor.domainEvents = append(survivor.domainEvents, event.NewPartyMerged(survivor.id, survivor.tenantID, duplicate.id, moved))
+
+	survivorID := survivor.id
+	duplicate.status = valueobject.PartyStatusMerged
+	duplicate.mergedInto = &survivorID
+	duplicate.externalRefs = nil
+	duplicate.version++
+	duplicate.updatedAt = now
+	return survivor, duplicate, nil
+}
+
+// RepointRelationships re-points the party's relationships to a merged
+// party at the survivor, reporting whether any changed.
+func (p Party) RepointRelationships(mergedID, survivorID uuid.UUID, now time.Time) (Party, bool) {
+	rels := make([]Relationship, 0, len(p.relationships))
+	changed := false
+	for _, rel := range p.relationships {
+		if rel.PartyID == mergedID {
+			rel.PartyID = survivorID
+			changed = true
+		}
+		if rel.PartyID != p.id && !slices.Contains(rels, rel) {
+			rels = append(rels, rel)
+		}
+	}
+	if !changed {
+		return p, false
+	}
+	p.relationships = rels
+	return p.touch(now), true
+}
+
+// DisplayName is the party's name for display: the legal name of an
+// organisation, or an individual's given and family names.
+func (p Party) DisplayName() string {
+	if p.details.Type.Equal(valueobject.PartyTypeOrganization) {
+		return p.details.LegalName
+	}
+	return strings.TrimSpace(p.details.GivenName + " " + p.details.FamilyName)
+}
+
+func (p Party) requireActive(action string) error {
+	if !p.status.Equal(valueobject.PartyStatusActive) {
+		return fmt.Errorf("cannot %s party %s: %w", action, p.id, ErrPartyMerged)
+	}
+	return nil
+}
+
+func (p Party) touch(now time.Time) Party {
+	p.version++
+	p.updatedAt = now
+	p.domainEvents = append(p.domainEvents, event.NewPartyUpdated(p.id, p.tenantID, p.version))
+	return p
+}
+
+func validateDetails(d PartyDetails, now time.Time) (PartyDetails, error) {
+	d.GivenName = strings.TrimSpace(d.GivenName)
+	d.FamilyName = strings.TrimSpace(d.FamilyName)
+	d.LegalName = strings.TrimSpace(d.LegalName)
+	switch {
+	case d.Type.Equal(valueobject.PartyTypeIndividual):
+		if d.GivenName == "" || d.FamilyName == "" {
+			return d, fmt.Errorf("an individual requires a given and family name")
+		}
+		if d.DateOfBirth != "" {
+			dob, err := time.Parse(time.DateOnly, d.DateOfBirth)
+			if err != nil {
+				return d, fmt.Errorf("date of birth %q must be YYYY-MM-DD", d.DateOfBirth)
+			}
+			if dob.After(now) {
+				return d, fmt.Errorf("date of birth must not be in the future")
+			}
+		}
+	case d.Type.Equal(valueobject.PartyTypeOrganization):
+		if d.LegalName == "" {
+			return d, fmt.Errorf("an organisation requires a legal name")
+		}
+		if d.DateOfBirth != "" {
+			return d, fmt.Errorf("an organisation has no date of birth")
+		}
+	default:
+		return d, fmt.Errorf("party type must not be empty")
+	}
+
+	if d.Email != "" {
+		addr, err := mail.ParseAddress(d.Email)
+		if err != nil || addr.Address != d.Email {
+			return d, fmt.Errorf("invalid email address %q", d.Email)
+		}
+		d.Email = strings.ToLower(d.Email)
+	}
+	if d.Phone != "" && !e164RE.MatchString(d.Phone) {
+		return d, fmt.Errorf("phone number %q must be in E.164 format", d.Phone)
+	}
+
+	for i, a := range d.Addresses {
+		if a.Type.IsZero() {
+			return d, fmt.Errorf("address %d: type must not be empty", i+1)
+		}
+		if strings.TrimSpace(a.Line1) == "" || strings.TrimSpace(a.City) == "" {
+			return d, fmt.Errorf("address %d: line 1 and city are required", i+1)
+		}
+		if !countryRE.MatchString(a.Country) {
+			return d, fmt.Errorf("address %d: country %q must be an ISO 3166-1 alpha-2 code", i+1, a.Country)
+		}
+	}
+	return d, nil
+}
+
+// --- Accessors ---
+
+func (p Party) ID() uuid.UUID                    { return p.id }
+func (p Party) TenantID() uuid.UUID              { return p.tenantID }
+func (p Party) Details() PartyDetails            { return p.details }
+func (p Party) Type() valueobject.PartyType      { return p.details.Type }
+func (p Party) Status() valueobject.PartyStatus  { return p.status }
+func (p Party) KYCVerificationID() *uuid.UUID    { return p.kycVerificationID }
+func (p Party) KYCStatus() valueobject.KYCStatus { return p.kycStatus }
+func (p Party) Relationships() []Relationship    { return p.relationships }
+func (p Party) ExternalRefs() []ExternalRef      { return p.externalRefs }
+func (p Party) MergedInto() *uuid.UUID           { return p.mergedInto }
+func (p Party) Version() int                     { return p.version }
+func (p Party) CreatedAt() time.Time             { return p.createdAt }
+func (p Party) UpdatedAt() time.Time             { return p.updatedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (p Party) DomainEvents() []events.DomainEvent {
+	return p.domainEvents
+}
+
+// ClearDomainEvents returns a copy of the party with no uncommitted events.
+func (p Party) ClearDomainEvents() Party {
+	p.domainEvents = nil
+	return p
+}
@@ -0,0 +1,216 @@
+package model_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/customer-service/internal/domain/model"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/valueobject"
+)
+
+func individual(given, family, dob string) model.PartyDetails {
+	return model.PartyDetails{
+		Type:        valueobject.PartyTypeIndividual,
+		GivenName:   given,
+		FamilyName:  family,
+		DateOfBirth: dob,
+	}
+}
+
+func TestNewParty_Validation(t *testing.T) {
+	now := time.Now().UTC()
+	tenantID := uuid.New()
+
+	p, err := model.NewParty(tenantID, model.PartyDetails{
+		Type:       valueobject.PartyTypeIndividual,
+		GivenName:  " Ada ",
+		FamilyName: "Lovelace",
+		Email:      "Ada@Example.com",
+		Phone:      "+447700900123",
+		Addresses: []model.Address{{
+			Type: valueobject.AddressTypeResidential, Line1: "1 St James's Square", City: "London", Country: "GB",
+		}},
+	}, now)
+	require.NoError(t, err)
+	assert.Equal(t, "Ada Lovelace", p.DisplayName())
+	assert.Equal(t, "ada@example.com", p.Details().Email, "emails are lowercased")
+	assert.Equal(t, valueobject.PartyStatusActive, p.Status())
+	assert.Equal(t, valueobject.KYCStatusUnverified, p.KYCStatus())
+	assert.Equal(t, 1, p.Version())
+	require.Len(t, p.DomainEvents(), 1)
+	assert.Equal(t, "party.created", p.DomainEvents()[0].EventType())
+
+	_, err = model.NewParty(tenantID, individual("Ada", "", ""), now)
+	assert.Error(t, err, "individuals need a family name")
+
+	_, err = model.NewParty(tenantID, individual("Ada", "Lovelace", now.AddDate(0, 0, 2).Format(time.DateOnly)), now)
+	assert.Error(t, err, "dates of birth must not be in the future")
+
+	_, err = model.NewParty(tenantID, model.PartyDetails{
+		Type: valueobject.PartyTypeOrganization, LegalName: "Acme Ltd", DateOfBirth: "2000-01-01",
+	}, now)
+	assert.Error(t, err, "organisations have no date of birth")
+
+	d := individual("Ada", "Lovelace", "")
+	d.Phone = "07700900123"
+	_, err = model.NewParty(tenantID, d, now)
+	assert.Error(t, err, "phones must be E.164")
+
+	d = individual("Ada", "Lovelace", "")
+	d.Addresses = []model.Address{{Type: valueobject.AddressTypeMailing, Line1: "x", City: "London", Country: "GBR"}}
+	_, err = model.NewParty(tenantID, d, now)
+	assert.Error(t, err, "countries must be alpha-2")
+}
+
+func TestParty_Update_KeepsType(t *testing.T) {
+	now := time.Now().UTC()
+	p, err := model.NewParty(uuid.New(), individual("Ada", "Lovelace", "1815-12-10"), now)
+	require.NoError(t, err)
+	p = p.ClearDomainEvents()
+
+	_, err = p.Update(model.PartyDetails{Type: valueobject.PartyTypeOrganization, LegalName: "Ada Ltd"}, now)
+	assert.Error(t, err)
+
+	p, err = p.Update(individual("Ada", "King", "1815-12-10"), now)
+	require.NoError(t, err)
+	assert.Equal(t, "Ada King", p.DisplayName())
+	assert.Equal(t, 2, p.Version())
+	require.Len(t, p.DomainEvents(), 1)
+	assert.Equal(t, "party.updated", p.DomainEvents()[0].EventType())
+}
+
+func TestParty_Relationships(t *testing.T) {
+	now := time.Now().UTC()
+	p, err := model.NewParty(uuid.New(), individual("Ada", "Lovelace", ""), now)
+	require.NoError(t, err)
+	p = p.ClearDomainEvents()
+
+	spouse := model.Relationship{Type: valueobject.RelationshipTypeSpouse, PartyID: uuid.New()}
+	p, err = p.AddRelationship(spouse, now)
+	require.NoError(t, err)
+	assert.Equal(t, []model.Relationship{spouse}, p.Relationships())
+
+	p = p.ClearDomainEvents()
+	p, err = p.AddRelationship(spouse, now)
+	require.NoError(t, err)
+	assert.Empty(t, p.DomainEvents(), "adding an existing relationship changes nothing")
+
+	_, err = p.AddRelationship(model.Relationship{Type: valueobject.RelationshipTypeSpouse, PartyID: p.ID()}, now)
+	assert.Error(t, err, "a party cannot be related to itself")
+
+	p, err = p.RemoveRelationship(spouse, now)
+	require.NoError(t, err)
+	assert.Empty(t, p.Relationships())
+}
+
+func TestParty_KYC(t *testing.T) {
+	now := time.Now().UTC()
+	p, err := model.NewParty(uuid.New(), individual("Ada", "Lovelace", ""), now)
+	require.NoError(t, err)
+
+	_, _, err = p.RecordKYCOutcome(valueobject.KYCStatusVerified, now)
+	assert.Error(t, err, "outcomes need a linked verification")
+
+	verificationID := uuid.New()
+	p, err = p.LinkKYC(verificationID, valueobject.KYCStatusPending, now)
+	require.NoError(t, err)
+	require.NotNil(t, p.KYCVerificationID())
+	assert.Equal(t, verificationID, *p.KYCVerificationID())
+
+	p, changed, err := p.RecordKYCOutcome(valueobject.KYCStatusVerified, now)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, valueobject.KYCStatusVerified, p.KYCStatus())
+
+	_, changed, err = p.RecordKYCOutcome(valueobject.KYCStatusVerified, now)
+	require.NoError(t, err)
+	assert.False(t, changed, "a recorded outcome changes nothing")
+}
+
+func TestMerge(t *testing.T) {
+	now := time.Now().UTC()
+	tenantID := uuid.New()
+	other := uuid.New()
+
+	survivor, err := model.NewParty(tenantID, individual("Ada", "Lovelace", ""), now)
+	require.NoError(t, err)
+	dupDetails := individual("Ada", "Lovelace", "1815-12-10")
+	dupDetails.Email = "ada@example.com"
+	duplicate, err := model.NewParty(tenantID, dupDetails, now)
+	require.NoError(t, err)
+
+	survivor, err = survivor.AddRelationship(model.Relationship{Type: valueobject.RelationshipTypeJointHolder, PartyID: duplicate.ID()}, now)
+	require.NoError(t, err)
+	duplicate, err = duplicate.AddRelationship(model.Relationship{Type: valueobject.RelationshipTypeSpouse, PartyID: other}, now)
+	require.NoError(t, err)
+	duplicate, err = duplicate.LinkExternalRef(model.ExternalRef{Kind: "account_holder", ID: "acc-1"}, now)
+	require.NoError(t, err)
+	verificationID := uuid.New()
+	duplicate, err = duplicate.LinkKYC(verificationID, valueobject.KYCStatusVerified, now)
+	require.NoError(t, err)
+	survivor, duplicate = survivor.ClearDomainEvents(), duplicate.ClearDomainEvents()
+
+	survivor, duplicate, err = model.Merge(survivor, duplicate, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1815-12-10", survivor.Details().DateOfBirth, "gaps are filled from the duplicate")
+	assert.Equal(t, "ada@example.com", survivor.Details().Email)
+	assert.Equal(t, []model.Relationship{{Type: valueobject.RelationshipTypeSpouse, PartyID: other}}, survivor.Relationships(),
+		"the relationship between the two parties is dropped")
+	assert.Equal(t, []model.ExternalRef{{Kind: "account_holder", ID: "acc-1"}}, survivor.ExternalRefs())
+	assert.Equal(t, valueobject.KYCStatusVerified, survivor.KYCStatus())
+	require.Len(t, survivor.DomainEvents(), 1)
+	assert.Equal(t, "party.merged", survivor.DomainEvents()[0].EventType())
+
+	assert.Equal(t, valueobject.PartyStatusMerged, duplicate.Status())
+	require.NotNil(t, duplicate.MergedInto())
+	assert.Equal(t, survivor.ID(), *duplicate.MergedInto())
+	assert.Empty(t, duplicate.ExternalRefs())
+
+	_, err = duplicate.Update(dupDetails, now)
+	assert.True(t, errors.Is(err, model.ErrPartyMerged), "merged parties cannot change")
+	_, _, err = model.Merge(survivor, duplicate, now)
+	assert.True(t, errors.Is(err, model.ErrPartyMerged))
+}
+
+func TestMerge_Validation(t *testing.T) {
+	now := time.Now().UTC()
+	tenantID := uuid.New()
+	person, err := model.NewParty(tenantID, individual("Ada", "Lovelace", ""), now)
+	require.NoError(t, err)
+	org, err := model.NewParty(tenantID, model.PartyDetails{Type: valueobject.PartyTypeOrganization, LegalName: "Acme Ltd"}, now)
+	require.NoError(t, err)
+	stranger, err := model.NewParty(uuid.New(), individual("Ada", "Lovelace", ""), now)
+	require.NoError(t, err)
+
+	_, _, err = model.Merge(person, person, now)
+	assert.Error(t, err, "a party cannot merge into itself")
+	_, _, err = model.Merge(person, org, now)
+	assert.Error(t, err, "types must match")
+	_, _, err = model.Merge(person, stranger, now)
+	assert.Error(t, err, "tenants must match")
+}
+
+func TestParty_RepointRelationships(t *testing.T) {
+	now := time.Now().UTC()
+	mergedID, survivorID := uuid.New(), uuid.New()
+	p, err := model.NewParty(uuid.New(), individual("Ada", "Lovelace", ""), now)
+	require.NoError(t, err)
+	p, err = p.AddRelationship(model.Relationship{Type: valueobject.RelationshipTypeSpouse, PartyID: mergedID}, now)
+	require.NoError(t, err)
+	p, err = p.AddRelationship(model.Relationship{Type: valueobject.RelationshipTypeSpouse, PartyID: survivorID}, now)
+	require.NoError(t, err)
+
+	p, changed := p.RepointRelationships(mergedID, survivorID, now)
+	assert.True(t, changed)
+	assert.Equal(t, []model.Relationship{{Type: valueobject.RelationshipTypeSpouse, PartyID: survivorID}}, p.Relationships(),
+		"re-pointed relationships are deduplicated")
+
+	_, changed = p.RepointRelationships(mergedID, survivorID, now)
+	assert.False(t, changed)
+}
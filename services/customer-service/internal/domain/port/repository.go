@@ -0,0 +1,52 @@
+package port
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/customer-service/internal/domain/event"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/model"
+)
+
+// ErrPartyNotFound is returned when a party does not exist.
+var ErrPartyNotFound = errors.New("party not found")
+
+// ErrExternalRefTaken is returned when an external reference is already
+// linked to another party.
+var ErrExternalRefTaken = errors.New("external reference is linked to another party")
+
+// ErrVersionConflict is returned when a party was modified concurrently.
+var ErrVersionConflict = errors.New("party was modified concurrently")
+
+// PartyRepository defines the persistence port for parties.
+type PartyRepository interface {
+	// Save persists the parties atomically, failing with ErrVersionConflict
+	// if any was modified since it was loaded.
+	Save(ctx context.Context, parties ...model.Party) error
+
+	// FindByID retrieves a tenant's party.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Party, error)
+
+	// FindByExternalRef retrieves the party an external reference is linked to.
+	FindByExternalRef(ctx context.Context, tenantID uuid.UUID, ref model.ExternalRef) (model.Party, error)
+
+	// FindByVerificationID retrieves the active parties linked to an
+	// identity verification.
+	FindByVerificationID(ctx context.Context, tenantID, verificationID uuid.UUID) ([]model.Party, error)
+
+	// FindRelatedTo retrieves the active parties with a relationship to the
+	// given party.
+	FindRelatedTo(ctx context.Context, tenantID, partyID uuid.UUID) ([]model.Party, error)
+
+	// FindCandidates retrieves the active parties of the same type that
+	// share a contact, date of birth or legal name prefix with the party:
+	// the candidates duplicate detection compares it against.
+	FindCandidates(ctx context.Context, party model.Party, limit int) ([]model.Party, error)
+}
+
+// EventPublisher defines the port for publishing domain events.
+type EventPublisher interface {
+	Publish(ctx context.Context, events []event.DomainEvent) error
+}
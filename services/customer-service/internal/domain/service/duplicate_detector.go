@@ -0,0 +1,179 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/bibbank/bib/services/customer-service/internal/domain/model"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/valueobject"
+)
+
+// Duplicate match reasons.
+const (
+	DuplicateReasonEmail   = "email"
+	DuplicateReasonPhone   = "phone"
+	DuplicateReasonNameDOB = "name_dob"
+	DuplicateReasonName    = "legal_name"
+)
+
+// DefaultNameSimilarityThreshold is the name similarity at or above which
+// names match.
+const DefaultNameSimilarityThreshold = 0.92
+
+// DuplicateDetector is a domain service that finds the parties of a tenant
+// that may be the same person or organisation as another. Parties match on
+// the same email address or phone number; individuals also match on a fuzzy
+// name match with the same date of birth, and organisations on a fuzzy legal
+// name match. Only active parties of the same type are compared.
+type DuplicateDetector struct {
+	nameThreshold float64
+}
+
+// NewDuplicateDetector creates a detector. The threshold is a similarity in
+// (0, 1]; a non-positive threshold falls back to the default.
+func NewDuplicateDetector(nameThreshold float64) *DuplicateDetector {
+	if nameThreshold <= 0 || nameThreshold > 1 {
+		nameThreshold = DefaultNameSimilarityThreshold
+	}
+	return &DuplicateDetector{nameThreshold: nameThreshold}
+}
+
+// Detect returns the candidates that match the party, strongest first. The
+// score is the highest similarity among the matched identifiers; exact
+// contact matches score 1.
+func (d *DuplicateDetector) Detect(party model.Party, candidates []model.Party) []model.DuplicateMatch {
+	pd := party.Details()
+	var matches []model.DuplicateMatch
+	for _, c := range candidates {
+		if c.ID() == party.ID() || !c.Status().Equal(valueobject.PartyStatusActive) || !c.Type().Equal(party.Type()) {
+			continue
+		}
+		cd := c.Details()
+
+		var reasons []string
+		score := 0.0
+		if pd.Email != "" && strings.EqualFold(cd.Email, pd.Email) {
+			reasons = append(reasons, DuplicateReasonEmail)
+			score = 1
+		}
+		if pd.Phone != "" && cd.Phone == pd.Phone {
+			reasons = append(reasons, DuplicateReasonPhone)
+			score = 1
+		}
+		switch {
+		case party.Type().Equal(valueobject.PartyTypeIndividual):
+			if pd.DateOfBirth != "" && cd.DateOfBirth == pd.DateOfBirth {
+				similarity := NameSimilarity(pd.GivenName+" "+pd.FamilyName, cd.GivenName+" "+cd.FamilyName)
+				if similarity >= d.nameThreshold {
+					reasons = append(reasons, DuplicateReasonNameDOB)
+					score = math.Max(score, similarity)
+				}
+			}
+		case party.Type().Equal(valueobject.PartyTypeOrganization):
+			if similarity := NameSimilarity(pd.LegalName, cd.LegalName); similarity >= d.nameThreshold {
+				reasons = append(reasons, DuplicateReasonName)
+				score = math.Max(score, similarity)
+			}
+		}
+
+		if len(reasons) > 0 {
+			matches = append(matches, model.DuplicateMatch{
+				PartyID: c.ID(),
+				Reasons: reasons,
+				Score:   score,
+			})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	return matches
+}
+
+// NormalizeName folds a person's name into a canonical form for matching:
+// diacritics and punctuation are removed, case is folded and tokens are
+// sorted so word order does not matter ("SMITH, John" and "John Smith"
+// normalise identically).
+func NormalizeName(name string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(name) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// Drop combining marks left over from decomposing accented letters.
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(' ')
+		}
+	}
+	tokens := strings.Fields(b.String())
+	sort.Strings(tokens)
+	return strings.Join(tokens, " ")
+}
+
+// NameSimilarity returns the Jaro-Winkler similarity of two names after
+// normalisation, in [0, 1]. Identical names score 1.
+func NameSimilarity(a, b string) float64 {
+	return jaroWinkler(NormalizeName(a), NormalizeName(b))
+}
+
+// jaroWinkler computes the Jaro-Winkler similarity of two strings. It
+// rewards a shared prefix, which suits transliteration variants of names.
+func jaroWinkler(a, b string) float64 {
+	s1, s2 := []rune(a), []rune(b)
+	if len(s1) == 0 || len(s2) == 0 {
+		if len(s1) == len(s2) {
+			return 1
+		}
+		return 0
+	}
+
+	window := max(len(s1), len(s2))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+
+	matched1 := make([]bool, len(s1))
+	matched2 := make([]bool, len(s2))
+	matches := 0
+	for i := range s1 {
+		lo, hi := max(0, i-window), min(len(s2), i+window+1)
+		for j := lo; j < hi; j++ {
+			if !matched2[j] && s1[i] == s2[j] {
+				matched1[i], matched2[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions := 0
+	j := 0
+	for i := range s1 {
+		if !matched1[i] {
+			continue
+		}
+		for !matched2[j] {
+			j++
+		}
+		if s1[i] != s2[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(s1)) + m/float64(len(s2)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for prefix < min(4, len(s1), len(s2)) && s1[prefix] == s2[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
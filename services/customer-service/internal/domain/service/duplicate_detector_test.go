@@ -0,0 +1,70 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/customer-service/internal/domain/model"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/service"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/valueobject"
+)
+
+func newParty(t *testing.T, tenantID uuid.UUID, d model.PartyDetails) model.Party {
+	t.Helper()
+	p, err := model.NewParty(tenantID, d, time.Now().UTC())
+	require.NoError(t, err)
+	return p
+}
+
+func TestDuplicateDetector_Individuals(t *testing.T) {
+	tenantID := uuid.New()
+	party := newParty(t, tenantID, model.PartyDetails{
+		Type: valueobject.PartyTypeIndividual, GivenName: "Jon", FamilyName: "Smith",
+		DateOfBirth: "1980-04-01", Email: "jon@example.com",
+	})
+	sameEmail := newParty(t, tenantID, model.PartyDetails{
+		Type: valueobject.PartyTypeIndividual, GivenName: "Jonathan", FamilyName: "Smyth", Email: "JON@example.com",
+	})
+	nameAndDOB := newParty(t, tenantID, model.PartyDetails{
+		Type: valueobject.PartyTypeIndividual, GivenName: "John", FamilyName: "Smith", DateOfBirth: "1980-04-01",
+	})
+	sameDOBOnly := newParty(t, tenantID, model.PartyDetails{
+		Type: valueobject.PartyTypeIndividual, GivenName: "Mary", FamilyName: "Jones", DateOfBirth: "1980-04-01",
+	})
+
+	matches := service.NewDuplicateDetector(0).Detect(party, []model.Party{party, sameDOBOnly, nameAndDOB, sameEmail})
+	require.Len(t, matches, 2)
+	assert.Equal(t, sameEmail.ID(), matches[0].PartyID, "exact contact matches rank first")
+	assert.Equal(t, []string{service.DuplicateReasonEmail}, matches[0].Reasons)
+	assert.Equal(t, 1.0, matches[0].Score)
+	assert.Equal(t, nameAndDOB.ID(), matches[1].PartyID)
+	assert.Equal(t, []string{service.DuplicateReasonNameDOB}, matches[1].Reasons)
+}
+
+func TestDuplicateDetector_Organisations(t *testing.T) {
+	tenantID := uuid.New()
+	party := newParty(t, tenantID, model.PartyDetails{Type: valueobject.PartyTypeOrganization, LegalName: "Acme Widgets Ltd"})
+	similar := newParty(t, tenantID, model.PartyDetails{Type: valueobject.PartyTypeOrganization, LegalName: "ACME Widgets Ltd."})
+	different := newParty(t, tenantID, model.PartyDetails{Type: valueobject.PartyTypeOrganization, LegalName: "Acorn Holdings plc"})
+
+	matches := service.NewDuplicateDetector(0).Detect(party, []model.Party{similar, different})
+	require.Len(t, matches, 1)
+	assert.Equal(t, similar.ID(), matches[0].PartyID)
+	assert.Equal(t, []string{service.DuplicateReasonName}, matches[0].Reasons)
+}
+
+func TestDuplicateDetector_SkipsOtherTypes(t *testing.T) {
+	tenantID := uuid.New()
+	party := newParty(t, tenantID, model.PartyDetails{
+		Type: valueobject.PartyTypeIndividual, GivenName: "Jon", FamilyName: "Smith", Email: "jon@example.com",
+	})
+	org := newParty(t, tenantID, model.PartyDetails{
+		Type: valueobject.PartyTypeOrganization, LegalName: "Smith Ltd", Email: "jon@example.com",
+	})
+
+	assert.Empty(t, service.NewDuplicateDetector(0).Detect(party, []model.Party{org}))
+}
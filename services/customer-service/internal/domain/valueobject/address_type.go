@@ -0,0 +1,51 @@
+package valueobject
+
+import "fmt"
+
+// AddressType is what an address is used for.
+// It is an immutable value object.
+type AddressType struct {
+	value string
+}
+
+const (
+	addressTypeResidential = "RESIDENTIAL"
+	addressTypeMailing     = "MAILING"
+	addressTypeRegistered  = "REGISTERED"
+)
+
+var (
+	AddressTypeResidential = AddressType{value: addressTypeResidential}
+	AddressTypeMailing     = AddressType{value: addressTypeMailing}
+	AddressTypeRegistered  = AddressType{value: addressTypeRegistered}
+)
+
+var validAddressTypes = map[string]AddressType{
+	addressTypeResidential: AddressTypeResidential,
+	addressTypeMailing:     AddressTypeMailing,
+	addressTypeRegistered:  AddressTypeRegistered,
+}
+
+// NewAddressType creates a AddressType from a string, validating it is known.
+func NewAddressType(s string) (AddressType, error) {
+	v, ok := validAddressTypes[s]
+	if !ok {
+		return AddressType{}, fmt.Errorf("invalid address type: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the AddressType.
+func (v AddressType) String() string {
+	return v.value
+}
+
+// IsZero returns true if the AddressType has not been set.
+func (v AddressType) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two AddressType values are equal.
+func (v AddressType) Equal(other AddressType) bool {
+	return v.value == other.value
+}
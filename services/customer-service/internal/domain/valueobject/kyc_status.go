@@ -0,0 +1,57 @@
+package valueobject
+
+import "fmt"
+
+// KYCStatus is the outcome of the identity verification linked to a party.
+// It is an immutable value object.
+type KYCStatus struct {
+	value string
+}
+
+const (
+	kycStatusUnverified = "UNVERIFIED"
+	kycStatusPending    = "PENDING"
+	kycStatusVerified   = "VERIFIED"
+	kycStatusRejected   = "REJECTED"
+	kycStatusExpired    = "EXPIRED"
+)
+
+var (
+	KYCStatusUnverified = KYCStatus{value: kycStatusUnverified}
+	KYCStatusPending    = KYCStatus{value: kycStatusPending}
+	KYCStatusVerified   = KYCStatus{value: kycStatusVerified}
+	KYCStatusRejected   = KYCStatus{value: kycStatusRejected}
+	KYCStatusExpired    = KYCStatus{value: kycStatusExpired}
+)
+
+var validKYCStatuses = map[string]KYCStatus{
+	kycStatusUnverified: KYCStatusUnverified,
+	kycStatusPending:    KYCStatusPending,
+	kycStatusVerified:   KYCStatusVerified,
+	kycStatusRejected:   KYCStatusRejected,
+	kycStatusExpired:    KYCStatusExpired,
+}
+
+// NewKYCStatus creates a KYCStatus from a string, validating it is known.
+func NewKYCStatus(s string) (KYCStatus, error) {
+	v, ok := validKYCStatuses[s]
+	if !ok {
+		return KYCStatus{}, fmt.Errorf("invalid KYC status: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the KYCStatus.
+func (v KYCStatus) String() string {
+	return v.value
+}
+
+// IsZero returns true if the KYCStatus has not been set.
+func (v KYCStatus) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two KYCStatus values are equal.
+func (v KYCStatus) Equal(other KYCStatus) bool {
+	return v.value == other.value
+}
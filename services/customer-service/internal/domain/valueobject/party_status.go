@@ -0,0 +1,49 @@
+package valueobject
+
+import "fmt"
+
+// PartyStatus is the lifecycle state of a party record. A MERGED party was
+// found to duplicate another and lives on only as a pointer to it.
+// It is an immutable value object.
+type PartyStatus struct {
+	value string
+}
+
+const (
+	partyStatusActive = "ACTIVE"
+	partyStatusMerged = "MERGED"
+)
+
+var (
+	PartyStatusActive = PartyStatus{value: partyStatusActive}
+	PartyStatusMerged = PartyStatus{value: partyStatusMerged}
+)
+
+var validPartyStatuses = map[string]PartyStatus{
+	partyStatusActive: PartyStatusActive,
+	partyStatusMerged: PartyStatusMerged,
+}
+
+// NewPartyStatus creates a PartyStatus from a string, validating it is known.
+func NewPartyStatus(s string) (PartyStatus, error) {
+	v, ok := validPartyStatuses[s]
+	if !ok {
+		return PartyStatus{}, fmt.Errorf("invalid party status: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the PartyStatus.
+func (v PartyStatus) String() string {
+	return v.value
+}
+
+// IsZero returns true if the PartyStatus has not been set.
+func (v PartyStatus) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two PartyStatus values are equal.
+func (v PartyStatus) Equal(other PartyStatus) bool {
+	return v.value == other.value
+}
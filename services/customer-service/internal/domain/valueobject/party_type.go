@@ -0,0 +1,48 @@
+package valueobject
+
+import "fmt"
+
+// PartyType is whether a party is a natural person or an organisation.
+// It is an immutable value object.
+type PartyType struct {
+	value string
+}
+
+const (
+	partyTypeIndividual   = "INDIVIDUAL"
+	partyTypeOrganization = "ORGANIZATION"
+)
+
+var (
+	PartyTypeIndividual   = PartyType{value: partyTypeIndividual}
+	PartyTypeOrganization = PartyType{value: partyTypeOrganization}
+)
+
+var validPartyTypes = map[string]PartyType{
+	partyTypeIndividual:   PartyTypeIndividual,
+	partyTypeOrganization: PartyTypeOrganization,
+}
+
+// NewPartyType creates a PartyType from a string, validating it is known.
+func NewPartyType(s string) (PartyType, error) {
+	v, ok := validPartyTypes[s]
+	if !ok {
+		return PartyType{}, fmt.Errorf("invalid party type: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the PartyType.
+func (v PartyType) String() string {
+	return v.value
+}
+
+// IsZero returns true if the PartyType has not been set.
+func (v PartyType) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two PartyType values are equal.
+func (v PartyType) Equal(other PartyType) bool {
+	return v.value == other.value
+}
@@ -0,0 +1,60 @@
+package valueobject
+
+import "fmt"
+
+// RelationshipType is how one party relates to another.
+// It is an immutable value object.
+type RelationshipType struct {
+	value string
+}
+
+const (
+	relationshipTypeJointHolder         = "JOINT_HOLDER"
+	relationshipTypeSpouse              = "SPOUSE"
+	relationshipTypeGuardian            = "GUARDIAN"
+	relationshipTypeDirector            = "DIRECTOR"
+	relationshipTypeBeneficialOwner     = "BENEFICIAL_OWNER"
+	relationshipTypeAuthorisedSignatory = "AUTHORISED_SIGNATORY"
+)
+
+var (
+	RelationshipTypeJointHolder         = RelationshipType{value: relationshipTypeJointHolder}
+	RelationshipTypeSpouse              = RelationshipType{value: relationshipTypeSpouse}
+	RelationshipTypeGuardian            = RelationshipType{value: relationshipTypeGuardian}
+	RelationshipTypeDirector            = RelationshipType{value: relationshipTypeDirector}
+	RelationshipTypeBeneficialOwner     = RelationshipType{value: relationshipTypeBeneficialOwner}
+	RelationshipTypeAuthorisedSignatory = RelationshipType{value: relationshipTypeAuthorisedSignatory}
+)
+
+var validRelationshipTypes = map[string]RelationshipType{
+	relationshipTypeJointHolder:         RelationshipTypeJointHolder,
+	relationshipTypeSpouse:              RelationshipTypeSpouse,
+	relationshipTypeGuardian:            RelationshipTypeGuardian,
+	relationshipTypeDirector:            RelationshipTypeDirector,
+	relationshipTypeBeneficialOwner:     RelationshipTypeBeneficialOwner,
+	relationshipTypeAuthorisedSignatory: RelationshipTypeAuthorisedSignatory,
+}
+
+// NewRelationshipType creates a RelationshipType from a string, validating it is known.
+func NewRelationshipType(s string) (RelationshipType, error) {
+	v, ok := validRelationshipTypes[s]
+	if !ok {
+		return RelationshipType{}, fmt.Errorf("invalid relationship type: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the RelationshipType.
+func (v RelationshipType) String() string {
+	return v.value
+}
+
+// IsZero returns true if the RelationshipType has not been set.
+func (v RelationshipType) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two RelationshipType values are equal.
+func (v RelationshipType) Equal(other RelationshipType) bool {
+	return v.value == other.value
+}
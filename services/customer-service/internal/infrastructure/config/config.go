@@ -0,0 +1,96 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+type DatabaseConfig struct {
+	Host     string
+	User     string
+	Password string
+	Name     string
+	SSLMode  string
+	Port     int
+}
+
+type KafkaConfig struct {
+	ConsumerGroup string
+	Brokers       []string
+	// VerificationTopic carries the identity verification outcomes that
+	// update the KYC status of linked parties.
+	VerificationTopic string
+}
+
+// DuplicateConfig configures duplicate detection. Names whose
+// Jaro-Winkler similarity reaches NameThreshold are treated as matching.
+type DuplicateConfig struct {
+	NameThreshold float64
+}
+
+type Config struct {
+	DB          DatabaseConfig
+	ServiceName string
+	Kafka       KafkaConfig
+	Duplicates  DuplicateConfig
+	GRPCPort    int
+	HTTPPort    int
+}
+
+func Load() Config {
+	return Config{
+		GRPCPort: getEnvInt("GRPC_PORT", 9093),
+		HTTPPort: getEnvInt("HTTP_PORT", 8093),
+		DB: DatabaseConfig{
+			Host:     getEnv("DB_HOST", "localhost"),
+			Port:     getEnvInt("DB_PORT", 5432),
+			User:     getEnv("DB_USER", "bib"),
+			Password: getEnv("DB_PASSWORD", ""),
+			Name:     getEnv("DB_NAME", "bib_customer"),
+			SSLMode:  getEnv("DB_SSLMODE", "require"),
+		},
+		Kafka: KafkaConfig{
+			Brokers:           []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+			ConsumerGroup:     getEnv("KAFKA_CONSUMER_GROUP", "customer-service"),
+			VerificationTopic: getEnv("CUSTOMER_VERIFICATION_TOPIC", "bib.identity.verifications"),
+		},
+		Duplicates: DuplicateConfig{
+			NameThreshold: getEnvFloat("CUSTOMER_DUPLICATE_NAME_THRESHOLD", 0.92),
+		},
+		ServiceName: "customer-service",
+	}
+}
+
+func (c Config) GRPCAddr() string {
+	return fmt.Sprintf(":%d", c.GRPCPort)
+}
+
+func (c Config) HTTPAddr() string {
+	return fmt.Sprintf(":%d", c.HTTPPort)
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) int {
+	if v := os.Getenv(key); v != "" {
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+	return fallback
+}
+
+func getEnvFloat(key string, fallback float64) float64 {
+	if v := os.Getenv(key); v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+	}
+	return fallback
+}
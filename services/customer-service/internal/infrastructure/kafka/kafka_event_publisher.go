@@ -7,17 +7,17 @@ import (
 	"log/slog"
 
 	pkgkafka "github.com/bibbank/bib/pkg/kafka"
-	"github.com/bibbank/bib/services/lending-service/internal/domain/event"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/event"
 )
 
-// EventPublisher implements port.EventPublisher by writing events to Kafka.
+// EventPublisher implements the EventPublisher port using Kafka.
 type EventPublisher struct {
 	producer *pkgkafka.Producer
 	logger   *slog.Logger
 	topic    string
 }
 
-// NewEventPublisher creates a publisher targeting the given Kafka producer and topic.
+// NewEventPublisher creates a new EventPublisher.
 func NewEventPublisher(producer *pkgkafka.Producer, topic string, logger *slog.Logger) *EventPublisher {
 	return &EventPublisher{
 		producer: producer,
@@ -26,21 +26,19 @@ func NewEventPublisher(producer *pkgkafka.Producer, topic string, logger *slog.L
 	}
 }
 
-// Publish serializes and sends domain events to Kafka.
-func (p *EventPublisher) Publish(ctx context.Context, events ...event.DomainEvent) error {
+// Publish sends domain events to Kafka.
+func (p *EventPublisher) Publish(ctx context.Context, events []event.DomainEvent) error {
 	messages := make([]pkgkafka.Message, 0, len(events))
 	for _, evt := range events {
 		payload, err := json.Marshal(evt)
 		if err != nil {
-			return fmt.Errorf("marshal event %s: %w", evt.EventType(), err)
+			return fmt.Errorf("failed to marshal event %s: %w", evt.EventType(), err)
 		}
 
-		p.logger.DebugContext(ctx, "publishing domain event",
-			"event_type", evt.EventType(),
-			"aggregate_id", evt.AggregateID(),
-			"tenant_id", evt.TenantID(),
-			"topic", p.topic,
-			"payload_size", len(payload),
+		p.logger.DebugContext(ctx, "publishing event to Kafka",
+			slog.String("topic", p.topic),
+			slog.String("event_type", evt.EventType()),
+			slog.Int("payload_size", len(payload)),
 		)
 
 		messages = append(messages, pkgkafka.Message{
@@ -48,8 +46,6 @@ func (p *EventPublisher) Publish(ctx context.Context, events ...event.DomainEven
 			Value: payload,
 			Headers: map[string]string{
 				"event_type": evt.EventType(),
-				"event_id":   evt.EventID(),
-				"tenant_id":  evt.TenantID(),
 			},
 		})
 	}
@@ -61,5 +57,6 @@ func (p *EventPublisher) Publish(ctx context.Context, events ...event.DomainEven
 	if err := p.producer.Publish(ctx, p.topic, messages...); err != nil {
 		return fmt.Errorf("failed to publish events to topic %s: %w", p.topic, err)
 	}
+
 	return nil
 }
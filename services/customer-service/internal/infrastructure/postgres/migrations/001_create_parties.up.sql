@@ -0,0 +1,30 @@
+-- Parties are the bank's customers, individuals and organisations, shared
+-- by every product service. Addresses and relationships to other parties
+-- are held as JSON arrays; a merged party is kept, pointing at its survivor.
+CREATE TABLE IF NOT EXISTS parties (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    party_type VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'ACTIVE',
+    given_name VARCHAR(200) NOT NULL DEFAULT '',
+    family_name VARCHAR(200) NOT NULL DEFAULT '',
+    legal_name VARCHAR(300) NOT NULL DEFAULT '',
+    date_of_birth DATE,
+    email VARCHAR(320) NOT NULL DEFAULT '',
+    phone VARCHAR(20) NOT NULL DEFAULT '',
+    addresses JSONB NOT NULL DEFAULT '[]',
+    relationships JSONB NOT NULL DEFAULT '[]',
+    kyc_verification_id UUID,
+    kyc_status VARCHAR(20) NOT NULL DEFAULT 'UNVERIFIED',
+    merged_into UUID REFERENCES parties(id),
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_parties_tenant_email ON parties(tenant_id, email) WHERE email <> '';
+CREATE INDEX idx_parties_tenant_phone ON parties(tenant_id, phone) WHERE phone <> '';
+CREATE INDEX idx_parties_tenant_dob ON parties(tenant_id, date_of_birth) WHERE date_of_birth IS NOT NULL;
+CREATE INDEX idx_parties_tenant_legal_name ON parties(tenant_id, lower(left(legal_name, 4))) WHERE legal_name <> '';
+CREATE INDEX idx_parties_kyc_verification ON parties(tenant_id, kyc_verification_id) WHERE kyc_verification_id IS NOT NULL;
+CREATE INDEX idx_parties_relationships ON parties USING GIN (relationships jsonb_path_ops);
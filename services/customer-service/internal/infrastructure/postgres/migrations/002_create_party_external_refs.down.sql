@@ -0,0 +1 @@
+DROP TABLE IF EXISTS party_external_refs;
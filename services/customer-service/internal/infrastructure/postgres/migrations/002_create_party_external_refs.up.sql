@@ -0,0 +1,11 @@
+-- Records in other services (account holders, loan applicants, card
+-- holders) linked to the party they refer to. A record refers to one party.
+CREATE TABLE IF NOT EXISTS party_external_refs (
+    tenant_id UUID NOT NULL,
+    kind VARCHAR(50) NOT NULL,
+    ref_id VARCHAR(100) NOT NULL,
+    party_id UUID NOT NULL REFERENCES parties(id),
+    PRIMARY KEY (tenant_id, kind, ref_id)
+);
+
+CREATE INDEX idx_party_external_refs_party ON party_external_refs(party_id);
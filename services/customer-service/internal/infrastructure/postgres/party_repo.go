@@ -0,0 +1,434 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/customer-service/internal/domain/model"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/port"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/valueobject"
+)
+
+// partyColumns selects a party with its external references aggregated
+// from party_external_refs.
+const partyColumns = `
+	p.id, p.tenant_id, p.party_type, p.status, p.given_name, p.family_name,
+	p.legal_name, p.date_of_birth, p.email, p.phone, p.addresses,
+	p.relationships, p.kyc_verification_id, p.kyc_status, p.merged_into,
+	p.version, p.created_at, p.updated_at,
+	COALESCE((
+		SELECT json_agg(json_build_object('kind', r.kind, 'id', r.ref_id) ORDER BY r.kind, r.ref_id)
+		FROM party_external_refs r
+		WHERE r.party_id = p.id
+	), '[]'::json)`
+
+// uniqueViolation is the PostgreSQL error code for a unique constraint
+// violation.
+const uniqueViolation = "23505"
+
+type addressRow struct {
+	Type       string `json:"type"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+}
+
+type relationshipRow struct {
+	Type    string    `json:"type"`
+	PartyID uuid.UUID `json:"party_id"`
+}
+
+type externalRefRow struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id"`
+}
+
+// PartyRepo is the PostgreSQL implementation of PartyRepository.
+type PartyRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewPartyRepo creates a new PartyRepo.
+func NewPartyRepo(pool *pgxpool.Pool) *PartyRepo {
+	return &PartyRepo{pool: pool}
+}
+
+// Save persists the parties and their external references in one
+// transaction, with optimistic locking on each party's version.
+func (r *PartyRepo) Save(ctx context.Context, parties ...model.Party) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	// References move between parties on merge, so every given party's
+	// references are cleared before any are re-inserted.
+	ids := make([]uuid.UUID, 0, len(parties))
+	for _, p := range parties {
+		ids = append(ids, p.ID())
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM party_external_refs WHERE party_id = ANY($1)`, ids); err != nil {
+		return fmt.Errorf("failed to delete external references: %w", err)
+	}
+
+	const upsertPartySQL = `
+		INSERT INTO parties (
+			id, tenant_id, party_type, status, given_name, family_name,
+			legal_name, date_of_birth, email, phone, addresses,
+			relationships, kyc_verification_id, kyc_status, merged_into,
+			version, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			given_name = EXCLUDED.given_name,
+			family_name = EXCLUDED.family_name,
+			legal_name = EXCLUDED.legal_name,
+			date_of_birth = EXCLUDED.date_of_birth,
+			email = EXCLUDED.email,
+			phone = EXCLUDED.phone,
+			addresses = EXCLUDED.addresses,
+			relationships = EXCLUDED.relationships,
+			kyc_verification_id = EXCLUDED.kyc_verification_id,
+			kyc_status = EXCLUDED.kyc_status,
+			merged_into = EXCLUDED.merged_into,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE parties.version = EXCLUDED.version - 1
+	`
+
+	for _, p := range parties {
+		d := p.Details()
+		dateOfBirth, err := parseDateOfBirth(d.DateOfBirth)
+		if err != nil {
+			return err
+		}
+		addresses, relationships, err := marshalPartyCollections(p)
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.Exec(ctx, upsertPartySQL,
+			p.ID(),
+			p.TenantID(),
+			p.Type().String(),
+			p.Status().String(),
+			d.GivenName,
+			d.FamilyName,
+			d.LegalName,
+			dateOfBirth,
+			d.Email,
+			d.Phone,
+			addresses,
+			relationships,
+			p.KYCVerificationID(),
+			p.KYCStatus().String(),
+			p.MergedInto(),
+			p.Version(),
+			p.CreatedAt(),
+			p.UpdatedAt(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to upsert party: %w", err)
+		}
+		if result.RowsAffected() == 0 {
+			return fmt.Errorf("%w: party %s", port.ErrVersionConflict, p.ID())
+		}
+
+		for _, ref := range p.ExternalRefs() {
+			_, err := tx.Exec(ctx, `
+				INSERT INTO party_external_refs (tenant_id, kind, ref_id, party_id)
+				VALUES ($1, $2, $3, $4)
+			`, p.TenantID(), ref.Kind, ref.ID, p.ID())
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+				return fmt.Errorf("%w: %s %s", port.ErrExternalRefTaken, ref.Kind, ref.ID)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to insert external reference: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a tenant's party.
+func (r *PartyRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Party, error) {
+	query := `SELECT ` + partyColumns + `
+		FROM parties p
+		WHERE p.tenant_id = $1 AND p.id = $2
+	`
+
+	p, err := scanParty(r.pool.QueryRow(ctx, query, tenantID, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Party{}, port.ErrPartyNotFound
+	}
+	if err != nil {
+		return model.Party{}, fmt.Errorf("failed to scan party: %w", err)
+	}
+	return p, nil
+}
+
+// FindByExternalRef retrieves the party an external reference is linked to.
+func (r *PartyRepo) FindByExternalRef(ctx context.Context, tenantID uuid.UUID, ref model.ExternalRef) (model.Party, error) {
+	query := `SELECT ` + partyColumns + `
+		FROM party_external_refs x
+		JOIN parties p ON p.id = x.party_id
+		WHERE x.tenant_id = $1 AND x.kind = $2 AND x.ref_id = $3
+	`
+
+	p, err := scanParty(r.pool.QueryRow(ctx, query, tenantID, ref.Kind, ref.ID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Party{}, port.ErrPartyNotFound
+	}
+	if err != nil {
+		return model.Party{}, fmt.Errorf("failed to scan party: %w", err)
+	}
+	return p, nil
+}
+
+// FindByVerificationID retrieves the active parties linked to an identity
+// verification.
+func (r *PartyRepo) FindByVerificationID(ctx context.Context, tenantID, verificationID uuid.UUID) ([]model.Party, error) {
+	query := `SELECT ` + partyColumns + `
+		FROM parties p
+		WHERE p.tenant_id = $1 AND p.kyc_verification_id = $2 AND p.status = 'ACTIVE'
+		ORDER BY p.created_at
+	`
+	return r.queryParties(ctx, query, tenantID, verificationID)
+}
+
+// FindRelatedTo retrieves the active parties with a relationship to the
+// given party.
+func (r *PartyRepo) FindRelatedTo(ctx context.Context, tenantID, partyID uuid.UUID) ([]model.Party, error) {
+	related, err := json.Marshal([]map[string]uuid.UUID{{"party_id": partyID}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal relationship filter: %w", err)
+	}
+
+	query := `SELECT ` + partyColumns + `
+		FROM parties p
+		WHERE p.tenant_id = $1 AND p.relationships @> $2 AND p.status = 'ACTIVE'
+		ORDER BY p.created_at
+	`
+	return r.queryParties(ctx, query, tenantID, related)
+}
+
+// FindCandidates retrieves the active parties of the same type that share
+// an email, phone, date of birth or the first four letters of the legal name
+// with the party.
+func (r *PartyRepo) FindCandidates(ctx context.Context, party model.Party, limit int) ([]model.Party, error) {
+	d := party.Details()
+	dateOfBirth, err := parseDateOfBirth(d.DateOfBirth)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + partyColumns + `
+		FROM parties p
+		WHERE p.tenant_id = $1 AND p.party_type = $2 AND p.status = 'ACTIVE' AND p.id <> $3
+		  AND (
+			($4 <> '' AND p.email = $4)
+			OR ($5 <> '' AND p.phone = $5)
+			OR ($6::date IS NOT NULL AND p.date_of_birth = $6)
+			OR ($7 <> '' AND lower(left(p.legal_name, 4)) = $7)
+		  )
+		ORDER BY p.created_at
+		LIMIT $8
+	`
+	return r.queryParties(ctx, query,
+		party.TenantID(), party.Type().String(), party.ID(),
+		d.Email, d.Phone, dateOfBirth, legalNamePrefix(d.LegalName), limit,
+	)
+}
+
+func (r *PartyRepo) queryParties(ctx context.Context, query string, args ...any) ([]model.Party, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query parties: %w", err)
+	}
+	defer rows.Close()
+
+	var parties []model.Party
+	for rows.Next() {
+		p, err := scanParty(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan party row: %w", err)
+		}
+		parties = append(parties, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return parties, nil
+}
+
+func marshalPartyCollections(p model.Party) (addresses, relationships []byte, err error) {
+	addressRows := make([]addressRow, 0, len(p.Details().Addresses))
+	for _, a := range p.Details().Addresses {
+		addressRows = append(addressRows, addressRow{
+			Type:       a.Type.String(),
+			Line1:      a.Line1,
+			Line2:      a.Line2,
+			City:       a.City,
+			Region:     a.Region,
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+		})
+	}
+	if addresses, err = json.Marshal(addressRows); err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal addresses: %w", err)
+	}
+
+	relationshipRows := make([]relationshipRow, 0, len(p.Relationships()))
+	for _, rel := range p.Relationships() {
+		relationshipRows = append(relationshipRows, relationshipRow{Type: rel.Type.String(), PartyID: rel.PartyID})
+	}
+	if relationships, err = json.Marshal(relationshipRows); err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal relationships: %w", err)
+	}
+	return addresses, relationships, nil
+}
+
+// legalNamePrefix returns the lowercased first four letters of a legal
+// name, the block organisations are compared within.
+func legalNamePrefix(name string) string {
+	runes := []rune(strings.ToLower(strings.TrimSpace(name)))
+	return string(runes[:min(4, len(runes))])
+}
+
+// parseDateOfBirth converts a YYYY-MM-DD date of birth to a nullable DATE.
+func parseDateOfBirth(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date of birth %q: %w", s, err)
+	}
+	return &t, nil
+}
+
+func scanParty(row pgx.Row) (model.Party, error) {
+	var (
+		id                uuid.UUID
+		tenantID          uuid.UUID
+		partyTypeStr      string
+		statusStr         string
+		givenName         string
+		familyName        string
+		legalName         string
+		dateOfBirth       *time.Time
+		email             string
+		phone             string
+		addressesJSON     []byte
+		relationshipsJSON []byte
+		kycVerificationID *uuid.UUID
+		kycStatusStr      string
+		mergedInto        *uuid.UUID
+		version           int
+		createdAt         time.Time
+		updatedAt         time.Time
+		refsJSON          []byte
+	)
+
+	err := row.Scan(&id, &tenantID, &partyTypeStr, &statusStr, &givenName, &familyName,
+		&legalName, &dateOfBirth, &email, &phone, &addressesJSON,
+		&relationshipsJSON, &kycVerificationID, &kycStatusStr, &mergedInto,
+		&version, &createdAt, &updatedAt, &refsJSON)
+	if err != nil {
+		return model.Party{}, err
+	}
+
+	partyType, err := valueobject.NewPartyType(partyTypeStr)
+	if err != nil {
+		return model.Party{}, fmt.Errorf("invalid party type in database: %w", err)
+	}
+	status, err := valueobject.NewPartyStatus(statusStr)
+	if err != nil {
+		return model.Party{}, fmt.Errorf("invalid party status in database: %w", err)
+	}
+	kycStatus, err := valueobject.NewKYCStatus(kycStatusStr)
+	if err != nil {
+		return model.Party{}, fmt.Errorf("invalid KYC status in database: %w", err)
+	}
+
+	var addressRows []addressRow
+	if err := json.Unmarshal(addressesJSON, &addressRows); err != nil {
+		return model.Party{}, fmt.Errorf("failed to unmarshal addresses: %w", err)
+	}
+	addresses := make([]model.Address, 0, len(addressRows))
+	for _, a := range addressRows {
+		addressType, err := valueobject.NewAddressType(a.Type)
+		if err != nil {
+			return model.Party{}, fmt.Errorf("invalid address type in database: %w", err)
+		}
+		addresses = append(addresses, model.Address{
+			Type:       addressType,
+			Line1:      a.Line1,
+			Line2:      a.Line2,
+			City:       a.City,
+			Region:     a.Region,
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+		})
+	}
+
+	var relationshipRows []relationshipRow
+	if err := json.Unmarshal(relationshipsJSON, &relationshipRows); err != nil {
+		return model.Party{}, fmt.Errorf("failed to unmarshal relationships: %w", err)
+	}
+	relationships := make([]model.Relationship, 0, len(relationshipRows))
+	for _, rel := range relationshipRows {
+		relType, err := valueobject.NewRelationshipType(rel.Type)
+		if err != nil {
+			return model.Party{}, fmt.Errorf("invalid relationship type in database: %w", err)
+		}
+		relationships = append(relationships, model.Relationship{Type: relType, PartyID: rel.PartyID})
+	}
+
+	var refRows []externalRefRow
+	if err := json.Unmarshal(refsJSON, &refRows); err != nil {
+		return model.Party{}, fmt.Errorf("failed to unmarshal external references: %w", err)
+	}
+	refs := make([]model.ExternalRef, 0, len(refRows))
+	for _, ref := range refRows {
+		refs = append(refs, model.ExternalRef{Kind: ref.Kind, ID: ref.ID})
+	}
+
+	var dob string
+	if dateOfBirth != nil {
+		dob = dateOfBirth.Format(time.DateOnly)
+	}
+
+	return model.ReconstructParty(
+		id, tenantID,
+		model.PartyDetails{
+			Type:        partyType,
+			GivenName:   givenName,
+			FamilyName:  familyName,
+			LegalName:   legalName,
+			DateOfBirth: dob,
+			Email:       email,
+			Phone:       phone,
+			Addresses:   addresses,
+		},
+		status, kycVerificationID, kycStatus, relationships, refs, mergedInto,
+		version, createdAt, updatedAt,
+	), nil
+}
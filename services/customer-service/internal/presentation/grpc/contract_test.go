@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	customerv1 "github.com/bibbank/bib/api/gen/go/bib/customer/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/customer-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/event"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/model"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/port"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/service"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractPartyRepo holds at most one party and finds no duplicates.
+type contractPartyRepo struct {
+	party *model.Party
+}
+
+func (r *contractPartyRepo) Save(context.Context, ...model.Party) error { return nil }
+
+func (r *contractPartyRepo) FindByID(context.Context, uuid.UUID, uuid.UUID) (model.Party, error) {
+	if r.party == nil {
+		return model.Party{}, port.ErrPartyNotFound
+	}
+	return *r.party, nil
+}
+
+func (r *contractPartyRepo) FindByExternalRef(context.Context, uuid.UUID, model.ExternalRef) (model.Party, error) {
+	return model.Party{}, port.ErrPartyNotFound
+}
+
+func (r *contractPartyRepo) FindByVerificationID(context.Context, uuid.UUID, uuid.UUID) ([]model.Party, error) {
+	return nil, nil
+}
+
+func (r *contractPartyRepo) FindRelatedTo(context.Context, uuid.UUID, uuid.UUID) ([]model.Party, error) {
+	return nil, nil
+}
+
+func (r *contractPartyRepo) FindCandidates(context.Context, model.Party, int) ([]model.Party, error) {
+	return nil, nil
+}
+
+type discardPublisher struct{}
+
+func (discardPublisher) Publish(context.Context, []event.DomainEvent) error { return nil }
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	parties := &contractPartyRepo{}
+	h := NewCustomerServiceHandler(
+		usecase.NewCreatePartyUseCase(parties, discardPublisher{}, service.NewDuplicateDetector(0)),
+		nil, usecase.NewGetPartyUseCase(parties), nil, nil, nil, nil, nil, nil, nil,
+		slog.Default(),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { customerv1.RegisterCustomerServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-customer-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { parties.party = nil },
+		"an individual is a customer": func(t *testing.T) {
+			party, err := model.NewParty(contractTenantID, model.PartyDetails{
+				Type:       valueobject.PartyTypeIndividual,
+				GivenName:  "Ada",
+				FamilyName: "Lovelace",
+				Email:      "ada@example.com",
+			}, time.Now().UTC())
+			if err != nil {
+				t.Fatal(err)
+			}
+			parties.party = &party
+		},
+	})
+}
@@ -0,0 +1,538 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	customerv1 "github.com/bibbank/bib/api/gen/go/bib/customer/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/customer-service/internal/application/dto"
+	"github.com/bibbank/bib/services/customer-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/model"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/port"
+)
+
+// requireRole checks that the caller has at least one of the given roles.
+func requireRole(ctx context.Context, roles ...string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "insufficient permissions")
+}
+
+// tenantIDFromContext extracts the tenant ID from JWT claims in the context.
+func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return claims.TenantID, nil
+}
+
+// Compile-time assertion that CustomerServiceHandler implements CustomerServiceServer.
+var _ customerv1.CustomerServiceServer = (*CustomerServiceHandler)(nil)
+
+// CustomerServiceHandler implements the gRPC CustomerServiceServer interface.
+type CustomerServiceHandler struct {
+	customerv1.UnimplementedCustomerServiceServer
+	createPartyUC        *usecase.CreatePartyUseCase
+	updatePartyUC        *usecase.UpdatePartyUseCase
+	getPartyUC           *usecase.GetPartyUseCase
+	findPartyByRefUC     *usecase.FindPartyByRefUseCase
+	findDuplicatesUC     *usecase.FindDuplicatesUseCase
+	mergePartiesUC       *usecase.MergePartiesUseCase
+	addRelationshipUC    *usecase.AddRelationshipUseCase
+	removeRelationshipUC *usecase.RemoveRelationshipUseCase
+	linkExternalRefUC    *usecase.LinkExternalRefUseCase
+	linkKYCUC            *usecase.LinkKYCUseCase
+	logger               *slog.Logger
+}
+
+// NewCustomerServiceHandler creates a new CustomerServiceHandler.
+func NewCustomerServiceHandler(
+	createPartyUC *usecase.CreatePartyUseCase,
+	updatePartyUC *usecase.UpdatePartyUseCase,
+	getPartyUC *usecase.GetPartyUseCase,
+	findPartyByRefUC *usecase.FindPartyByRefUseCase,
+	findDuplicatesUC *usecase.FindDuplicatesUseCase,
+	mergePartiesUC *usecase.MergePartiesUseCase,
+	addRelationshipUC *usecase.AddRelationshipUseCase,
+	removeRelationshipUC *usecase.RemoveRelationshipUseCase,
+	linkExternalRefUC *usecase.LinkExternalRefUseCase,
+	linkKYCUC *usecase.LinkKYCUseCase,
+	logger *slog.Logger,
+) *CustomerServiceHandler {
+	return &CustomerServiceHandler{
+		createPartyUC:        createPartyUC,
+		updatePartyUC:        updatePartyUC,
+		getPartyUC:           getPartyUC,
+		findPartyByRefUC:     findPartyByRefUC,
+		findDuplicatesUC:     findDuplicatesUC,
+		mergePartiesUC:       mergePartiesUC,
+		addRelationshipUC:    addRelationshipUC,
+		removeRelationshipUC: removeRelationshipUC,
+		linkExternalRefUC:    linkExternalRefUC,
+		linkKYCUC:            linkKYCUC,
+		logger:               logger,
+	}
+}
+
+// CreateParty handles the gRPC request to create a party.
+func (h *CustomerServiceHandler) CreateParty(ctx context.Context, req *customerv1.CreatePartyRequest) (*customerv1.CreatePartyResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil || req.Details == nil {
+		return nil, status.Error(codes.InvalidArgument, "details are required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.createPartyUC.Execute(ctx, dto.CreatePartyRequest{
+		TenantID: tenantID,
+		Details:  fromPartyDetailsMsg(req.Details),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &customerv1.CreatePartyResponse{
+		Party:      toPartyMsg(resp.Party),
+		Duplicates: toDuplicateMatchMsgs(resp.Duplicates),
+	}, nil
+}
+
+// UpdateParty handles the gRPC request to replace a party's details.
+func (h *CustomerServiceHandler) UpdateParty(ctx context.Context, req *customerv1.UpdatePartyRequest) (*customerv1.UpdatePartyResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil || req.Details == nil {
+		return nil, status.Error(codes.InvalidArgument, "details are required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	partyID, err := uuid.Parse(req.PartyId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid party ID")
+	}
+
+	resp, err := h.updatePartyUC.Execute(ctx, dto.UpdatePartyRequest{
+		TenantID: tenantID,
+		PartyID:  partyID,
+		Details:  fromPartyDetailsMsg(req.Details),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &customerv1.UpdatePartyResponse{Party: toPartyMsg(resp)}, nil
+}
+
+// GetParty handles the gRPC request to retrieve a party.
+func (h *CustomerServiceHandler) GetParty(ctx context.Context, req *customerv1.GetPartyRequest) (*customerv1.GetPartyResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	partyID, err := uuid.Parse(req.PartyId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid party ID")
+	}
+
+	resp, err := h.getPartyUC.Execute(ctx, tenantID, partyID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &customerv1.GetPartyResponse{Party: toPartyMsg(resp)}, nil
+}
+
+// FindPartyByRef handles the gRPC request to resolve an external reference
+// to its party.
+func (h *CustomerServiceHandler) FindPartyByRef(ctx context.Context, req *customerv1.FindPartyByRefRequest) (*customerv1.FindPartyByRefResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.findPartyByRefUC.Execute(ctx, tenantID, dto.ExternalRefDTO{Kind: req.Kind, ID: req.Id})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &customerv1.FindPartyByRefResponse{Party: toPartyMsg(resp)}, nil
+}
+
+// FindDuplicates handles the gRPC request to list a party's possible duplicates.
+func (h *CustomerServiceHandler) FindDuplicates(ctx context.Context, req *customerv1.FindDuplicatesRequest) (*customerv1.FindDuplicatesResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	partyID, err := uuid.Parse(req.PartyId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid party ID")
+	}
+
+	matches, err := h.findDuplicatesUC.Execute(ctx, tenantID, partyID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &customerv1.FindDuplicatesResponse{Duplicates: toDuplicateMatchMsgs(matches)}, nil
+}
+
+// MergeParties handles the gRPC request to merge a duplicate party into the
+// surviving party.
+func (h *CustomerServiceHandler) MergeParties(ctx context.Context, req *customerv1.MergePartiesRequest) (*customerv1.MergePartiesResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	survivorID, err := uuid.Parse(req.SurvivorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid survivor ID")
+	}
+	duplicateID, err := uuid.Parse(req.DuplicateId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid duplicate ID")
+	}
+
+	resp, err := h.mergePartiesUC.Execute(ctx, dto.MergePartiesRequest{
+		TenantID:    tenantID,
+		SurvivorID:  survivorID,
+		DuplicateID: duplicateID,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &customerv1.MergePartiesResponse{
+		Survivor: toPartyMsg(resp.Survivor),
+		Merged:   toPartyMsg(resp.Merged),
+	}, nil
+}
+
+// AddRelationship handles the gRPC request to relate a party to another party.
+func (h *CustomerServiceHandler) AddRelationship(ctx context.Context, req *customerv1.AddRelationshipRequest) (*customerv1.AddRelationshipResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil || req.Relationship == nil {
+		return nil, status.Error(codes.InvalidArgument, "relationship is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	relReq, err := toRelationshipRequest(tenantID, req.PartyId, req.Relationship)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.addRelationshipUC.Execute(ctx, relReq)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &customerv1.AddRelationshipResponse{Party: toPartyMsg(resp)}, nil
+}
+
+// RemoveRelationship handles the gRPC request to remove a relationship
+// between parties.
+func (h *CustomerServiceHandler) RemoveRelationship(ctx context.Context, req *customerv1.RemoveRelationshipRequest) (*customerv1.RemoveRelationshipResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil || req.Relationship == nil {
+		return nil, status.Error(codes.InvalidArgument, "relationship is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	relReq, err := toRelationshipRequest(tenantID, req.PartyId, req.Relationship)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.removeRelationshipUC.Execute(ctx, relReq)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &customerv1.RemoveRelationshipResponse{Party: toPartyMsg(resp)}, nil
+}
+
+// LinkExternalRef handles the gRPC request to link a record in another
+// service to a party.
+func (h *CustomerServiceHandler) LinkExternalRef(ctx context.Context, req *customerv1.LinkExternalRefRequest) (*customerv1.LinkExternalRefResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil || req.Ref == nil {
+		return nil, status.Error(codes.InvalidArgument, "ref is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	partyID, err := uuid.Parse(req.PartyId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid party ID")
+	}
+
+	resp, err := h.linkExternalRefUC.Execute(ctx, dto.LinkExternalRefRequest{
+		TenantID: tenantID,
+		PartyID:  partyID,
+		Ref:      dto.ExternalRefDTO{Kind: req.Ref.Kind, ID: req.Ref.Id},
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &customerv1.LinkExternalRefResponse{Party: toPartyMsg(resp)}, nil
+}
+
+// LinkKYC handles the gRPC request to link a party to an identity verification.
+func (h *CustomerServiceHandler) LinkKYC(ctx context.Context, req *customerv1.LinkKYCRequest) (*customerv1.LinkKYCResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	partyID, err := uuid.Parse(req.PartyId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid party ID")
+	}
+	verificationID, err := uuid.Parse(req.VerificationId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid verification ID")
+	}
+
+	resp, err := h.linkKYCUC.Execute(ctx, dto.LinkKYCRequest{
+		TenantID:       tenantID,
+		PartyID:        partyID,
+		VerificationID: verificationID,
+		KYCStatus:      fromEnum(req.KycStatus, "KYC_STATUS_"),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &customerv1.LinkKYCResponse{Party: toPartyMsg(resp)}, nil
+}
+
+// toStatus maps use case errors to gRPC statuses.
+func (h *CustomerServiceHandler) toStatus(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidParty):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrPartyMerged):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, port.ErrPartyNotFound):
+		return status.Error(codes.NotFound, "party not found")
+	case errors.Is(err, port.ErrExternalRefTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, port.ErrVersionConflict):
+		return status.Error(codes.Aborted, "party was modified concurrently, retry")
+	default:
+		h.logger.Error("handler error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func toRelationshipRequest(tenantID uuid.UUID, partyIDStr string, rel *customerv1.Relationship) (dto.RelationshipRequest, error) {
+	partyID, err := uuid.Parse(partyIDStr)
+	if err != nil {
+		return dto.RelationshipRequest{}, status.Error(codes.InvalidArgument, "invalid party ID")
+	}
+	relatedID, err := uuid.Parse(rel.PartyId)
+	if err != nil {
+		return dto.RelationshipRequest{}, status.Error(codes.InvalidArgument, "invalid related party ID")
+	}
+	return dto.RelationshipRequest{
+		TenantID:     tenantID,
+		PartyID:      partyID,
+		Relationship: dto.RelationshipDTO{Type: fromEnum(rel.Type, "RELATIONSHIP_TYPE_"), PartyID: relatedID},
+	}, nil
+}
+
+func fromPartyDetailsMsg(d *customerv1.PartyDetails) dto.PartyDetailsDTO {
+	addresses := make([]dto.AddressDTO, 0, len(d.Addresses))
+	for _, a := range d.Addresses {
+		if a == nil {
+			continue
+		}
+		addresses = append(addresses, dto.AddressDTO{
+			Type:       fromEnum(a.Type, "ADDRESS_TYPE_"),
+			Line1:      a.Line1,
+			Line2:      a.Line2,
+			City:       a.City,
+			Region:     a.Region,
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+		})
+	}
+	return dto.PartyDetailsDTO{
+		Type:        fromEnum(d.Type, "PARTY_TYPE_"),
+		GivenName:   d.GivenName,
+		FamilyName:  d.FamilyName,
+		LegalName:   d.LegalName,
+		DateOfBirth: d.DateOfBirth,
+		Email:       d.Email,
+		Phone:       d.Phone,
+		Addresses:   addresses,
+	}
+}
+
+func toPartyMsg(p dto.PartyResponse) *customerv1.Party {
+	addresses := make([]*customerv1.Address, 0, len(p.Details.Addresses))
+	for _, a := range p.Details.Addresses {
+		addresses = append(addresses, &customerv1.Address{
+			Type:       customerv1.AddressType(customerv1.AddressType_value["ADDRESS_TYPE_"+a.Type]),
+			Line1:      a.Line1,
+			Line2:      a.Line2,
+			City:       a.City,
+			Region:     a.Region,
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+		})
+	}
+	relationships := make([]*customerv1.Relationship, 0, len(p.Relationships))
+	for _, rel := range p.Relationships {
+		relationships = append(relationships, &customerv1.Relationship{
+			Type:    customerv1.RelationshipType(customerv1.RelationshipType_value["RELATIONSHIP_TYPE_"+rel.Type]),
+			PartyId: rel.PartyID.String(),
+		})
+	}
+	refs := make([]*customerv1.ExternalRef, 0, len(p.ExternalRefs))
+	for _, ref := range p.ExternalRefs {
+		refs = append(refs, &customerv1.ExternalRef{Kind: ref.Kind, Id: ref.ID})
+	}
+
+	msg := &customerv1.Party{
+		PartyId:     p.ID.String(),
+		DisplayName: p.DisplayName,
+		Details: &customerv1.PartyDetails{
+			Type:        customerv1.PartyType(customerv1.PartyType_value["PARTY_TYPE_"+p.Details.Type]),
+			GivenName:   p.Details.GivenName,
+			FamilyName:  p.Details.FamilyName,
+			LegalName:   p.Details.LegalName,
+			DateOfBirth: p.Details.DateOfBirth,
+			Email:       p.Details.Email,
+			Phone:       p.Details.Phone,
+			Addresses:   addresses,
+		},
+		Status:        customerv1.PartyStatus(customerv1.PartyStatus_value["PARTY_STATUS_"+p.Status]),
+		KycStatus:     customerv1.KYCStatus(customerv1.KYCStatus_value["KYC_STATUS_"+p.KYCStatus]),
+		Relationships: relationships,
+		ExternalRefs:  refs,
+		Version:       int32(p.Version), //nolint:gosec // versions are small
+		CreatedAt:     timestamppb.New(p.CreatedAt),
+		UpdatedAt:     timestamppb.New(p.UpdatedAt),
+	}
+	if p.KYCVerificationID != nil {
+		msg.KycVerificationId = p.KYCVerificationID.String()
+	}
+	if p.MergedInto != nil {
+		msg.MergedInto = p.MergedInto.String()
+	}
+	return msg
+}
+
+func toDuplicateMatchMsgs(matches []dto.DuplicateMatchDTO) []*customerv1.DuplicateMatch {
+	out := make([]*customerv1.DuplicateMatch, 0, len(matches))
+	for _, m := range matches {
+		out = append(out, &customerv1.DuplicateMatch{PartyId: m.PartyID.String(), Reasons: m.Reasons, Score: m.Score})
+	}
+	return out
+}
+
+// fromEnum maps an enum value to its domain name by dropping the enum's
+// prefix. Unspecified values map to "", which the use cases reject or
+// default.
+func fromEnum[E interface {
+	~int32
+	String() string
+}](e E, prefix string) string {
+	if e == 0 {
+		return ""
+	}
+	return strings.TrimPrefix(e.String(), prefix)
+}
@@ -0,0 +1,16 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	customerv1 "github.com/bibbank/bib/api/gen/go/bib/customer/v1"
+)
+
+func TestFromEnum(t *testing.T) {
+	assert.Equal(t, "AUTHORISED_SIGNATORY", fromEnum(customerv1.RelationshipType_RELATIONSHIP_TYPE_AUTHORISED_SIGNATORY, "RELATIONSHIP_TYPE_"))
+	assert.Equal(t, "ORGANIZATION", fromEnum(customerv1.PartyType_PARTY_TYPE_ORGANIZATION, "PARTY_TYPE_"))
+	// LinkKYC defaults an unspecified status to PENDING.
+	assert.Equal(t, "", fromEnum(customerv1.KYCStatus_KYC_STATUS_UNSPECIFIED, "KYC_STATUS_"))
+}
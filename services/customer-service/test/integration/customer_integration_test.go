@@ -0,0 +1,80 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/model"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/port"
+	"github.com/bibbank/bib/services/customer-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/customer-service/internal/infrastructure/postgres"
+)
+
+func newParty(t *testing.T, tenantID uuid.UUID, given, email string) model.Party {
+	t.Helper()
+	p, err := model.NewParty(tenantID, model.PartyDetails{
+		Type:        valueobject.PartyTypeIndividual,
+		GivenName:   given,
+		FamilyName:  "Okafor",
+		DateOfBirth: "1988-04-12",
+		Email:       email,
+	}, time.Now().UTC())
+	require.NoError(t, err)
+	return p
+}
+
+func TestPartyRepo_ExternalRefsRoundTripAndAreUniquePerTenant(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewPartyRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+	ref := model.ExternalRef{Kind: "account_holder", ID: uuid.NewString()}
+
+	party := newParty(t, tenantID, "Ada", "ada@example.com")
+	require.NoError(t, repo.Save(ctx, party))
+	linked, err := party.LinkExternalRef(ref, time.Now().UTC())
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, linked))
+
+	found, err := repo.FindByExternalRef(ctx, tenantID, ref)
+	require.NoError(t, err)
+	assert.Equal(t, party.ID(), found.ID())
+	assert.Equal(t, linked.Version(), found.Version())
+	assert.Equal(t, "1988-04-12", found.Details().DateOfBirth)
+
+	// Saving the stale copy again conflicts on the version.
+	assert.ErrorIs(t, repo.Save(ctx, linked), port.ErrVersionConflict)
+
+	other := newParty(t, tenantID, "Bola", "bola@example.com")
+	other, err = other.LinkExternalRef(ref, time.Now().UTC())
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Save(ctx, other), port.ErrExternalRefTaken)
+
+	_, err = repo.FindByExternalRef(ctx, uuid.New(), ref)
+	assert.ErrorIs(t, err, port.ErrPartyNotFound, "references are scoped to their tenant")
+}
+
+func TestPartyRepo_FindCandidatesMatchesSharedDetails(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewPartyRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+
+	original := newParty(t, tenantID, "Ada", "ada@example.com")
+	duplicate := newParty(t, tenantID, "Adaeze", "ada@example.com")
+	require.NoError(t, repo.Save(ctx, original, duplicate))
+	require.NoError(t, repo.Save(ctx, newParty(t, uuid.New(), "Ada", "ada@example.com")))
+
+	candidates, err := repo.FindCandidates(ctx, duplicate, 10)
+	require.NoError(t, err)
+	require.Len(t, candidates, 1, "the party itself and other tenants' parties are excluded")
+	assert.Equal(t, original.ID(), candidates[0].ID())
+}
@@ -10,14 +10,17 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/bibbank/bib/pkg/archive"
 	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/events"
 	kafkapkg "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
 	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
 	pgpkg "github.com/bibbank/bib/pkg/postgres"
 	"github.com/bibbank/bib/services/deposit-service/internal/application/usecase"
 	"github.com/bibbank/bib/services/deposit-service/internal/domain/service"
 	"github.com/bibbank/bib/services/deposit-service/internal/infrastructure/config"
-	"github.com/bibbank/bib/services/deposit-service/internal/infrastructure/kafka"
 	infraPG "github.com/bibbank/bib/services/deposit-service/internal/infrastructure/postgres"
 	grpcPresentation "github.com/bibbank/bib/services/deposit-service/internal/presentation/grpc"
 	"github.com/bibbank/bib/services/deposit-service/internal/presentation/rest"
@@ -36,6 +39,7 @@ func main() {
 		Format: cfg.LogFormat,
 	})
 	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
 
 	logger.Info("starting deposit-service",
 		"http_port", cfg.HTTPPort,
@@ -51,7 +55,7 @@ func main() {
 	if err != nil {
 		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
 	} else {
-		defer func() { _ = shutdown(ctx) }() //nolint:errcheck
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
 	}
 
 	// Initialize database
@@ -69,7 +73,7 @@ func main() {
 		logger.Error("failed to connect to database", "error", err)
 		os.Exit(1)
 	}
-	defer pool.Close()
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
 
 	// Run migrations
 	dsn := pgpkg.Config{
@@ -88,12 +92,46 @@ func main() {
 	producer := kafkapkg.NewProducer(kafkapkg.Config{
 		Brokers: cfg.Kafka.Brokers,
 	})
-	defer producer.Close()
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(producer.Close))
+
+	// Outbox relay: events are written to the outbox and published from it,
+	// so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     depositTopic,
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: cfg.Telemetry.ServiceName,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, producer, relayCfg, logger)
+	if err != nil {
+		logger.Error("failed to create outbox relay", "error", err)
+		os.Exit(1)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+
+	// Months past the database's retention go to cold storage when an
+	// archive is configured.
+	if store := archive.NewStore(cfg.Archive); store != nil {
+		archiver := archive.NewArchiver(pool, store, archive.Config{Prefix: "deposits", Policies: infraPG.ArchivePolicies()}, logger)
+		lc.Go(lifecycle.PhaseWorkers, "archiver", func(ctx context.Context) error {
+			archiver.Run(ctx, time.Hour)
+			return nil
+		})
+	}
 
 	// Wire dependencies (DI via constructors)
 	productRepo := infraPG.NewProductRepo(pool)
 	positionRepo := infraPG.NewPositionRepo(pool)
-	publisher := kafka.NewPublisher(producer)
+	publisher := outbox.NewPublisher(outboxStore)
 	accrualEngine := service.NewAccrualEngine()
 
 	// Use cases
@@ -107,6 +145,8 @@ func main() {
 		Issuer: "bib-gateway",
 	}
 	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
 	case os.Getenv("JWT_PUBLIC_KEY") != "":
 		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
 	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
@@ -132,12 +172,20 @@ func main() {
 	// gRPC server
 	handler := grpcPresentation.NewDepositHandler(createProductUC, openPositionUC, getPositionUC, accrueInterestUC,
 		logger)
-	grpcServer := grpcPresentation.NewServer(handler, cfg.GRPCPort, logger, jwtSvc)
+	grpcServer, err := grpcPresentation.NewServer(handler, cfg.GRPCPort, logger, jwtSvc)
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
 
 	// HTTP server (health checks + metrics)
 	mux := http.NewServeMux()
 	healthHandler := rest.NewHealthHandler()
 	healthHandler.RegisterRoutes(mux)
+	if metricsHandler != nil {
+		mux.Handle("/metrics", metricsHandler)
+	}
 
 	httpServer := &http.Server{
 		Addr:              fmt.Sprintf(":%d", cfg.HTTPPort),
@@ -146,29 +194,22 @@ func main() {
 	}
 
 	// Start servers
-	errCh := make(chan error, 2)
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", grpcServer.Start)
+	lc.ServeHTTP("HTTP server", httpServer)
 
-	go func() {
-		errCh <- grpcServer.Start(ctx)
-	}()
-
-	go func() {
-		logger.Info("HTTP server starting", "port", cfg.HTTPPort)
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			errCh <- err
-		}
-	}()
-
-	// Wait for shutdown
-	select {
-	case <-ctx.Done():
-		logger.Info("shutdown signal received")
-	case err := <-errCh:
-		logger.Error("server error", "error", err)
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
 	}
-
-	// Graceful shutdown
-	_ = httpServer.Shutdown(context.Background()) //nolint:errcheck
-	grpcServer.Stop()
 	logger.Info("deposit-service stopped")
 }
+
+// depositTopic routes deposit events the repository wrote to the outbox to
+// the topic their use case publishes them to.
+func depositTopic(entry events.OutboxEntry) string {
+	if entry.EventType == "deposit.interest.accrued" {
+		return usecase.TopicDepositInterest
+	}
+	return usecase.TopicDepositEvents
+}
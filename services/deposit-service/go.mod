@@ -3,12 +3,17 @@ module github.com/bibbank/bib/services/deposit-service
 go 1.24
 
 require (
-	github.com/bibbank/bib/pkg/auth v0.0.0-00010101000000-000000000000
+	github.com/bibbank/bib/api/gen/go v0.0.0
+	github.com/bibbank/bib/pkg/archive v0.0.0
+	github.com/bibbank/bib/pkg/auth v0.0.0
 	github.com/bibbank/bib/pkg/events v0.0.0
+	github.com/bibbank/bib/pkg/grpcserver v0.0.0
 	github.com/bibbank/bib/pkg/kafka v0.0.0
+	github.com/bibbank/bib/pkg/lifecycle v0.0.0
 	github.com/bibbank/bib/pkg/observability v0.0.0
+	github.com/bibbank/bib/pkg/outbox v0.0.0
 	github.com/bibbank/bib/pkg/postgres v0.0.0
-	github.com/bibbank/bib/pkg/tlsutil v0.0.0-00010101000000-000000000000
+	github.com/bibbank/bib/pkg/testutil v0.0.0
 	github.com/google/uuid v1.6.0
 	github.com/jackc/pgx/v5 v5.7.2
 	github.com/shopspring/decimal v1.4.0
@@ -19,6 +24,7 @@ require (
 
 require (
 	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/bibbank/bib/pkg/tlsutil v0.0.0 // indirect
 	github.com/cenkalti/backoff/v4 v4.3.0 // indirect
 	github.com/cespare/xxhash/v2 v2.3.0 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
@@ -66,11 +72,17 @@ require (
 )
 
 replace (
+	github.com/bibbank/bib/api/gen/go => ../../api/gen/go
+	github.com/bibbank/bib/pkg/archive => ../../pkg/archive
 	github.com/bibbank/bib/pkg/auth => ../../pkg/auth
 	github.com/bibbank/bib/pkg/events => ../../pkg/events
+	github.com/bibbank/bib/pkg/grpcserver => ../../pkg/grpcserver
 	github.com/bibbank/bib/pkg/kafka => ../../pkg/kafka
+	github.com/bibbank/bib/pkg/lifecycle => ../../pkg/lifecycle
 	github.com/bibbank/bib/pkg/money => ../../pkg/money
 	github.com/bibbank/bib/pkg/observability => ../../pkg/observability
+	github.com/bibbank/bib/pkg/outbox => ../../pkg/outbox
 	github.com/bibbank/bib/pkg/postgres => ../../pkg/postgres
+	github.com/bibbank/bib/pkg/testutil => ../../pkg/testutil
 	github.com/bibbank/bib/pkg/tlsutil => ../../pkg/tlsutil
 )
@@ -0,0 +1,14 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[DepositOpened]("deposit.position.opened", 1, "Emitted when a new deposit position is opened."),
+		events.Declare[InterestAccrued]("deposit.interest.accrued", 1, "Emitted when interest is accrued on a deposit position."),
+		events.Declare[DepositMatured]("deposit.position.matured", 1, "Emitted when a term deposit reaches maturity."),
+		events.Declare[DepositClosed]("deposit.position.closed", 1, "Emitted when a deposit position is closed."),
+	}
+}
@@ -1,17 +1,23 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"time"
+
+	"github.com/bibbank/bib/pkg/archive"
 )
 
 // Config holds all service configuration loaded from environment variables.
 type Config struct {
+	Archive   archive.StoreConfig
 	Telemetry TelemetryConfig
 	LogLevel  string
 	LogFormat string
 	Kafka     KafkaConfig
 	DB        DBConfig
+	Outbox    OutboxConfig
 	HTTPPort  int
 	GRPCPort  int
 }
@@ -46,6 +52,12 @@ func (c Config) Validate() {
 	}
 }
 
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
 // Load reads configuration from environment variables with defaults.
 func Load() Config {
 	return Config{
@@ -61,6 +73,14 @@ func Load() Config {
 			MaxConns: int32(getEnvInt("DB_MAX_CONNS", 20)), //nolint:gosec // bounded by env config
 			MinConns: int32(getEnvInt("DB_MIN_CONNS", 5)),  //nolint:gosec // bounded by env config
 		},
+		Archive: archive.StoreConfig{
+			Dir:         getEnv("ARCHIVE_DIR", ""),
+			S3Endpoint:  getEnv("ARCHIVE_S3_ENDPOINT", "https://s3.amazonaws.com"),
+			S3Region:    getEnv("ARCHIVE_S3_REGION", "us-east-1"),
+			S3Bucket:    getEnv("ARCHIVE_S3_BUCKET", ""),
+			S3AccessKey: getEnv("ARCHIVE_S3_ACCESS_KEY", ""),
+			S3SecretKey: getEnv("ARCHIVE_S3_SECRET_KEY", ""),
+		},
 		Kafka: KafkaConfig{
 			Brokers: []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
 		},
@@ -70,6 +90,10 @@ func Load() Config {
 		},
 		LogLevel:  getEnv("LOG_LEVEL", "info"),
 		LogFormat: getEnv("LOG_FORMAT", "json"),
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
 	}
 }
 
@@ -88,3 +112,31 @@ func getEnvInt(key string, defaultVal int) int {
 	}
 	return defaultVal
 }
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
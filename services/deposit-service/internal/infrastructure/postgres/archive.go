@@ -0,0 +1,18 @@
+package postgres
+
+import "github.com/bibbank/bib/pkg/archive"
+
+// ArchivePolicies move closed deposit positions out of deposit_positions
+// two years after they close, by month of closing, and on to cold storage
+// after seven years.
+func ArchivePolicies() []archive.Policy {
+	return []archive.Policy{
+		{
+			Table:      "deposit_positions",
+			Sweep:      "status = 'CLOSED'",
+			SweepBy:    "updated_at",
+			SweepAfter: 24,
+			ColdAfter:  84,
+		},
+	}
+}
@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	grpclib "google.golang.org/grpc"
+
+	depositv1 "github.com/bibbank/bib/api/gen/go/bib/deposit/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/deposit-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/deposit-service/internal/domain/model"
+	"github.com/bibbank/bib/services/deposit-service/internal/domain/service"
+	"github.com/bibbank/bib/services/deposit-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractProductRepo holds the one product a contract state sets up.
+type contractProductRepo struct {
+	product *model.DepositProduct
+}
+
+func (r *contractProductRepo) Save(context.Context, model.DepositProduct) error { return nil }
+
+func (r *contractProductRepo) FindByID(context.Context, uuid.UUID) (model.DepositProduct, error) {
+	if r.product == nil {
+		return model.DepositProduct{}, errors.New("product not found")
+	}
+	return *r.product, nil
+}
+
+func (r *contractProductRepo) ListByTenant(context.Context, uuid.UUID) ([]model.DepositProduct, error) {
+	return nil, nil
+}
+
+// contractPositionRepo holds the one position a contract state sets up.
+type contractPositionRepo struct {
+	position *model.DepositPosition
+}
+
+func (r *contractPositionRepo) Save(context.Context, model.DepositPosition) error { return nil }
+
+func (r *contractPositionRepo) FindByID(context.Context, uuid.UUID) (model.DepositPosition, error) {
+	if r.position == nil {
+		return model.DepositPosition{}, errors.New("position not found")
+	}
+	return *r.position, nil
+}
+
+func (r *contractPositionRepo) FindActiveByTenant(context.Context, uuid.UUID) ([]model.DepositPosition, error) {
+	return nil, nil
+}
+
+func (r *contractPositionRepo) FindByAccount(context.Context, uuid.UUID) ([]model.DepositPosition, error) {
+	return nil, nil
+}
+
+type discardPublisher struct{}
+
+func (discardPublisher) Publish(context.Context, string, ...events.DomainEvent) error { return nil }
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	products, positions := &contractProductRepo{}, &contractPositionRepo{}
+	h := NewDepositHandler(
+		usecase.NewCreateDepositProduct(products),
+		usecase.NewOpenDepositPosition(products, positions, discardPublisher{}),
+		usecase.NewGetDepositPosition(positions),
+		usecase.NewAccrueInterest(products, positions, discardPublisher{}, service.NewAccrualEngine()),
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { depositv1.RegisterDepositServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	savingsProduct := func(t *testing.T) model.DepositProduct {
+		tier, err := valueobject.NewInterestTier(decimal.Zero, decimal.NewFromInt(10000), 150)
+		if err != nil {
+			t.Fatal(err)
+		}
+		product, err := model.NewDepositProduct(contractTenantID, "Easy Saver", "USD", []valueobject.InterestTier{tier}, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return product
+	}
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-deposit-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) {
+			products.product, positions.position = nil, nil
+		},
+		"an active savings product exists": func(t *testing.T) {
+			product := savingsProduct(t)
+			products.product = &product
+		},
+		"a deposit position exists": func(t *testing.T) {
+			product := savingsProduct(t)
+			position, err := model.NewDepositPosition(contractTenantID, uuid.New(), product.ID(),
+				decimal.NewFromInt(5000), "USD", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			positions.position = &position
+		},
+	})
+}
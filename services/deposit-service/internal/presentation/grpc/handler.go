@@ -5,13 +5,17 @@ import (
 	"log/slog"
 	"time"
 
-	"github.com/bibbank/bib/pkg/auth"
-	"github.com/bibbank/bib/services/deposit-service/internal/application/dto"
-	"github.com/bibbank/bib/services/deposit-service/internal/application/usecase"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	depositv1 "github.com/bibbank/bib/api/gen/go/bib/deposit/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/deposit-service/internal/application/dto"
+	"github.com/bibbank/bib/services/deposit-service/internal/application/usecase"
 )
 
 // requireRole checks that the caller has at least one of the given roles.
@@ -38,11 +42,11 @@ func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
 }
 
 // Compile-time assertion that DepositHandler implements DepositServiceServer.
-var _ DepositServiceServer = (*DepositHandler)(nil)
+var _ depositv1.DepositServiceServer = (*DepositHandler)(nil)
 
 // DepositHandler implements the gRPC DepositServiceServer interface.
 type DepositHandler struct {
-	UnimplementedDepositServiceServer
+	depositv1.UnimplementedDepositServiceServer
 	createProduct  *usecase.CreateDepositProduct
 	openPosition   *usecase.OpenDepositPosition
 	getPosition    *usecase.GetDepositPosition
@@ -67,87 +71,8 @@ func NewDepositHandler(
 		logger: logger}
 }
 
-// Proto-aligned request/response message types.
-
-type CreateDepositProductRequest struct {
-	TenantID string             `json:"tenant_id"`
-	Name     string             `json:"name"`
-	Currency string             `json:"currency"`
-	Tiers    []*InterestTierMsg `json:"tiers"`
-	TermDays int32              `json:"term_days"`
-}
-
-type InterestTierMsg struct {
-	MinBalance string `json:"min_balance"`
-	MaxBalance string `json:"max_balance"`
-	RateBps    int32  `json:"rate_bps"`
-}
-
-type DepositProductMsg struct {
-	ID        string             `json:"id"`
-	TenantID  string             `json:"tenant_id"`
-	Name      string             `json:"name"`
-	Currency  string             `json:"currency"`
-	CreatedAt string             `json:"created_at"`
-	UpdatedAt string             `json:"updated_at"`
-	Tiers     []*InterestTierMsg `json:"tiers"`
-	TermDays  int32              `json:"term_days"`
-	Version   int32              `json:"version"`
-	IsActive  bool               `json:"is_active"`
-}
-
-type CreateDepositProductResponse struct {
-	Product *DepositProductMsg `json:"product"`
-}
-
-type OpenDepositPositionRequest struct {
-	TenantID  string `json:"tenant_id"`
-	AccountID string `json:"account_id"`
-	ProductID string `json:"product_id"`
-	Principal string `json:"principal"`
-}
-
-type DepositPositionMsg struct {
-	ID              string `json:"id"`
-	TenantID        string `json:"tenant_id"`
-	AccountID       string `json:"account_id"`
-	ProductID       string `json:"product_id"`
-	Principal       string `json:"principal"`
-	Currency        string `json:"currency"`
-	AccruedInterest string `json:"accrued_interest"`
-	Status          string `json:"status"`
-	OpenedAt        string `json:"opened_at"`
-	LastAccrualDate string `json:"last_accrual_date"`
-	MaturityDate    string `json:"maturity_date,omitempty"`
-	CreatedAt       string `json:"created_at"`
-	UpdatedAt       string `json:"updated_at"`
-	Version         int32  `json:"version"`
-}
-
-type OpenDepositPositionResponse struct {
-	Position *DepositPositionMsg `json:"position"`
-}
-
-type GetDepositPositionRequest struct {
-	ID string `json:"id"`
-}
-
-type GetDepositPositionResponse struct {
-	Position *DepositPositionMsg `json:"position"`
-}
-
-type AccrueInterestRequest struct {
-	AsOfDate string `json:"as_of_date"`
-	TenantID string `json:"tenant_id"`
-}
-
-type AccrueInterestResponse struct {
-	TotalAccrued       string `json:"total_accrued"`
-	PositionsProcessed int32  `json:"positions_processed"`
-}
-
-// CreateDepositProduct processes product creation requests.
-func (h *DepositHandler) CreateDepositProduct(ctx context.Context, req *CreateDepositProductRequest) (*CreateDepositProductResponse, error) {
+// CreateProduct processes product creation requests.
+func (h *DepositHandler) CreateProduct(ctx context.Context, req *depositv1.CreateDepositProductRequest) (*depositv1.CreateDepositProductResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -196,13 +121,13 @@ func (h *DepositHandler) CreateDepositProduct(ctx context.Context, req *CreateDe
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	return &CreateDepositProductResponse{
+	return &depositv1.CreateDepositProductResponse{
 		Product: toDepositProductMsg(result),
 	}, nil
 }
 
-// OpenDepositPosition processes position opening requests.
-func (h *DepositHandler) OpenDepositPosition(ctx context.Context, req *OpenDepositPositionRequest) (*OpenDepositPositionResponse, error) {
+// OpenPosition processes position opening requests.
+func (h *DepositHandler) OpenPosition(ctx context.Context, req *depositv1.OpenDepositPositionRequest) (*depositv1.OpenDepositPositionResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -216,11 +141,11 @@ func (h *DepositHandler) OpenDepositPosition(ctx context.Context, req *OpenDepos
 		return nil, err
 	}
 
-	accountID, err := uuid.Parse(req.AccountID)
+	accountID, err := uuid.Parse(req.AccountId)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid account_id: %v", err)
 	}
-	productID, err := uuid.Parse(req.ProductID)
+	productID, err := uuid.Parse(req.ProductId)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid product_id: %v", err)
 	}
@@ -242,13 +167,13 @@ func (h *DepositHandler) OpenDepositPosition(ctx context.Context, req *OpenDepos
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	return &OpenDepositPositionResponse{
+	return &depositv1.OpenDepositPositionResponse{
 		Position: toPositionMsg(result),
 	}, nil
 }
 
-// GetDepositPosition processes position retrieval requests.
-func (h *DepositHandler) GetDepositPosition(ctx context.Context, req *GetDepositPositionRequest) (*GetDepositPositionResponse, error) {
+// GetPosition processes position retrieval requests.
+func (h *DepositHandler) GetPosition(ctx context.Context, req *depositv1.GetDepositPositionRequest) (*depositv1.GetDepositPositionResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -257,7 +182,7 @@ func (h *DepositHandler) GetDepositPosition(ctx context.Context, req *GetDeposit
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
 
-	positionID, err := uuid.Parse(req.ID)
+	positionID, err := uuid.Parse(req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
 	}
@@ -269,13 +194,13 @@ func (h *DepositHandler) GetDepositPosition(ctx context.Context, req *GetDeposit
 		return nil, status.Errorf(codes.NotFound, "position not found: %v", err)
 	}
 
-	return &GetDepositPositionResponse{
+	return &depositv1.GetDepositPositionResponse{
 		Position: toPositionMsg(result),
 	}, nil
 }
 
 // AccrueInterest processes batch interest accrual requests.
-func (h *DepositHandler) AccrueInterest(ctx context.Context, req *AccrueInterestRequest) (*AccrueInterestResponse, error) {
+func (h *DepositHandler) AccrueInterest(ctx context.Context, req *depositv1.AccrueInterestRequest) (*depositv1.AccrueInterestResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
 		return nil, err
 	}
@@ -289,15 +214,12 @@ func (h *DepositHandler) AccrueInterest(ctx context.Context, req *AccrueInterest
 		return nil, err
 	}
 
-	var asOf time.Time
-	if req.AsOfDate != "" {
-		var parseErr error
-		asOf, parseErr = time.Parse(time.RFC3339, req.AsOfDate)
-		if parseErr != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid as_of_date: %v", parseErr)
+	asOf := time.Now()
+	if req.AsOfDate != nil {
+		if err := req.AsOfDate.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid as_of_date: %v", err)
 		}
-	} else {
-		asOf = time.Now()
+		asOf = req.AsOfDate.AsTime()
 	}
 
 	result, err := h.accrueInterest.Execute(ctx, dto.AccrueInterestRequest{
@@ -308,53 +230,62 @@ func (h *DepositHandler) AccrueInterest(ctx context.Context, req *AccrueInterest
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	return &AccrueInterestResponse{
+	return &depositv1.AccrueInterestResponse{
 		PositionsProcessed: int32(result.PositionsProcessed), //nolint:gosec
 		TotalAccrued:       result.TotalAccrued.String(),
 	}, nil
 }
 
-func toDepositProductMsg(r dto.DepositProductResponse) *DepositProductMsg {
-	var tiers []*InterestTierMsg
+func toDepositProductMsg(r dto.DepositProductResponse) *depositv1.DepositProduct {
+	var tiers []*depositv1.InterestTier
 	for _, t := range r.Tiers {
-		tiers = append(tiers, &InterestTierMsg{
+		tiers = append(tiers, &depositv1.InterestTier{
 			MinBalance: t.MinBalance.String(),
 			MaxBalance: t.MaxBalance.String(),
 			RateBps:    int32(t.RateBps), //nolint:gosec
 		})
 	}
-	return &DepositProductMsg{
-		ID:        r.ID.String(),
-		TenantID:  r.TenantID.String(),
-		Name:      r.Name,
-		Currency:  r.Currency,
-		Tiers:     tiers,
-		TermDays:  int32(r.TermDays), //nolint:gosec
-		CreatedAt: r.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: r.UpdatedAt.Format(time.RFC3339),
-		Version:   int32(r.Version), //nolint:gosec
-		IsActive:  r.IsActive,
+	return &depositv1.DepositProduct{
+		Id:       r.ID.String(),
+		TenantId: r.TenantID.String(),
+		Name:     r.Name,
+		Currency: r.Currency,
+		Tiers:    tiers,
+		TermDays: int32(r.TermDays), //nolint:gosec
+		Audit: &commonv1.AuditInfo{
+			CreatedAt: timestamppb.New(r.CreatedAt),
+			UpdatedAt: timestamppb.New(r.UpdatedAt),
+			Version:   int32(r.Version), //nolint:gosec
+		},
+		IsActive: r.IsActive,
 	}
 }
 
-func toPositionMsg(r dto.DepositPositionResponse) *DepositPositionMsg {
-	msg := &DepositPositionMsg{
-		ID:              r.ID.String(),
-		TenantID:        r.TenantID.String(),
-		AccountID:       r.AccountID.String(),
-		ProductID:       r.ProductID.String(),
-		Principal:       r.Principal.StringFixed(2),
-		Currency:        r.Currency,
-		AccruedInterest: r.AccruedInterest.StringFixed(2),
-		Status:          r.Status,
-		OpenedAt:        r.OpenedAt.Format(time.RFC3339),
-		LastAccrualDate: r.LastAccrualDate.Format(time.RFC3339),
-		CreatedAt:       r.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:       r.UpdatedAt.Format(time.RFC3339),
-		Version:         int32(r.Version), //nolint:gosec
+func toPositionMsg(r dto.DepositPositionResponse) *depositv1.DepositPosition {
+	msg := &depositv1.DepositPosition{
+		Id:              r.ID.String(),
+		TenantId:        r.TenantID.String(),
+		AccountId:       r.AccountID.String(),
+		ProductId:       r.ProductID.String(),
+		Principal:       &commonv1.Money{Amount: r.Principal.StringFixed(2), Currency: r.Currency},
+		AccruedInterest: &commonv1.Money{Amount: r.AccruedInterest.StringFixed(2), Currency: r.Currency},
+		Status:          positionStatusToProto(r.Status),
+		OpenedAt:        timestamppb.New(r.OpenedAt),
+		LastAccrualDate: timestamppb.New(r.LastAccrualDate),
+		Audit: &commonv1.AuditInfo{
+			CreatedAt: timestamppb.New(r.CreatedAt),
+			UpdatedAt: timestamppb.New(r.UpdatedAt),
+			Version:   int32(r.Version), //nolint:gosec
+		},
 	}
 	if r.MaturityDate != nil {
-		msg.MaturityDate = r.MaturityDate.Format(time.RFC3339)
+		msg.MaturityDate = timestamppb.New(*r.MaturityDate)
 	}
 	return msg
 }
+
+// positionStatusToProto maps a domain position status such as "ACTIVE" to
+// its proto enum value.
+func positionStatusToProto(s string) depositv1.DepositPositionStatus {
+	return depositv1.DepositPositionStatus(depositv1.DepositPositionStatus_value["DEPOSIT_POSITION_STATUS_"+s])
+}
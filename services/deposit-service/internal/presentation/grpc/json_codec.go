@@ -4,19 +4,30 @@ import (
 	"encoding/json"
 
 	"google.golang.org/grpc/encoding"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 func init() {
 	encoding.RegisterCodec(jsonCodec{})
 }
 
+// jsonCodec serves callers that send JSON rather than protobuf. Generated
+// messages are encoded with protojson using their proto field names, so the
+// JSON on the wire is the same as before the service used generated code.
 type jsonCodec struct{}
 
 func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	if m, ok := v.(proto.Message); ok {
+		return protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	}
 	return json.Marshal(v)
 }
 
 func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	if m, ok := v.(proto.Message); ok {
+		return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, m)
+	}
 	return json.Unmarshal(data, v)
 }
 
@@ -0,0 +1,24 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	depositv1 "github.com/bibbank/bib/api/gen/go/bib/deposit/v1"
+)
+
+func TestJSONCodec_AccrueInterestRequest(t *testing.T) {
+	// bibctl sends the accrual date as an RFC 3339 string.
+	var req depositv1.AccrueInterestRequest
+	err := jsonCodec{}.Unmarshal([]byte(`{"as_of_date":"2026-03-10T00:00:00Z"}`), &req)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), req.GetAsOfDate().AsTime())
+}
+
+func TestPositionStatusToProto(t *testing.T) {
+	assert.Equal(t, depositv1.DepositPositionStatus_DEPOSIT_POSITION_STATUS_MATURED, positionStatusToProto("MATURED"))
+	assert.Equal(t, depositv1.DepositPositionStatus_DEPOSIT_POSITION_STATUS_UNSPECIFIED, positionStatusToProto("UNKNOWN"))
+}
@@ -0,0 +1,84 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/deposit-service/internal/domain/model"
+	"github.com/bibbank/bib/services/deposit-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/deposit-service/internal/infrastructure/postgres"
+)
+
+func saveProduct(t *testing.T, repo *postgres.ProductRepo, tenantID uuid.UUID) model.DepositProduct {
+	t.Helper()
+	low, err := valueobject.NewInterestTier(decimal.Zero, decimal.NewFromInt(10000), 150)
+	require.NoError(t, err)
+	high, err := valueobject.NewInterestTier(decimal.NewFromInt(10000), decimal.NewFromInt(1000000), 225)
+	require.NoError(t, err)
+	product, err := model.NewDepositProduct(tenantID, "Easy Saver", "USD", []valueobject.InterestTier{low, high}, 0)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(context.Background(), product))
+	return product
+}
+
+func TestProductRepo_TiersRoundTripInOrder(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewProductRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+
+	product := saveProduct(t, repo, tenantID)
+	saveProduct(t, repo, uuid.New())
+
+	found, err := repo.FindByID(ctx, product.ID())
+	require.NoError(t, err)
+	require.Len(t, found.Tiers(), 2)
+	assert.Equal(t, 150, found.Tiers()[0].RateBps())
+	assert.Equal(t, 225, found.Tiers()[1].RateBps())
+
+	products, err := repo.ListByTenant(ctx, tenantID)
+	require.NoError(t, err)
+	require.Len(t, products, 1, "other tenants' products are excluded")
+	assert.Equal(t, product.ID(), products[0].ID())
+}
+
+func TestPositionRepo_AccrualWritesOutboxInSameTransaction(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	positions := postgres.NewPositionRepo(env.Pool())
+	ctx := context.Background()
+	tenantID, accountID := uuid.New(), uuid.New()
+	product := saveProduct(t, postgres.NewProductRepo(env.Pool()), tenantID)
+
+	position, err := model.NewDepositPosition(tenantID, accountID, product.ID(), decimal.NewFromInt(5000), "USD", nil)
+	require.NoError(t, err)
+	require.NoError(t, positions.Save(ctx, position))
+
+	loaded, err := positions.FindByID(ctx, position.ID())
+	require.NoError(t, err)
+	accrued, err := loaded.AccrueInterest(decimal.RequireFromString("0.0001"), loaded.LastAccrualDate().Add(48*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, positions.Save(ctx, accrued))
+
+	found, err := positions.FindByID(ctx, position.ID())
+	require.NoError(t, err)
+	assert.Equal(t, 2, found.Version())
+	assert.True(t, decimal.NewFromInt(1).Equal(found.AccruedInterest()), found.AccruedInterest().String())
+
+	active, err := positions.FindActiveByTenant(ctx, tenantID)
+	require.NoError(t, err)
+	assert.Len(t, active, 1)
+
+	var pending int
+	require.NoError(t, env.Pool().QueryRow(ctx,
+		`SELECT count(*) FROM outbox WHERE aggregate_id = $1 AND published_at IS NULL`, position.ID()).Scan(&pending))
+	assert.Equal(t, 2, pending, "DepositOpened and InterestAccrued are queued for relay")
+}
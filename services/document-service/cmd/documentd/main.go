@@ -0,0 +1,263 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/bibbank/bib/pkg/archive"
+	"github.com/bibbank/bib/pkg/auth"
+	pkgkafka "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
+	"github.com/bibbank/bib/pkg/lock"
+	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
+	pkgpostgres "github.com/bibbank/bib/pkg/postgres"
+	"github.com/bibbank/bib/services/document-service/internal/application/dto"
+	"github.com/bibbank/bib/services/document-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/document-service/internal/infrastructure/config"
+	"github.com/bibbank/bib/services/document-service/internal/infrastructure/objectstore"
+	"github.com/bibbank/bib/services/document-service/internal/infrastructure/postgres"
+	"github.com/bibbank/bib/services/document-service/internal/infrastructure/signing"
+	grpcpresentation "github.com/bibbank/bib/services/document-service/internal/presentation/grpc"
+	"github.com/bibbank/bib/services/document-service/internal/presentation/rest"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// Load configuration.
+	cfg := config.Load()
+
+	// Initialize structured logger via shared observability package.
+	logger := observability.InitLogger(observability.LogConfig{
+		Level:  "info",
+		Format: "json",
+	})
+	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
+
+	logger.Info("starting document-service",
+		"http_port", cfg.HTTPPort,
+		"grpc_port", cfg.GRPCPort,
+	)
+
+	// Initialize tracing.
+	shutdown, err := observability.InitTracer(ctx, observability.TracingConfig{
+		ServiceName: cfg.ServiceName,
+		Endpoint:    getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4317"),
+		Insecure:    true,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
+	} else {
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
+	}
+
+	// Database connection.
+	dbCtx, dbCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer dbCancel()
+
+	pool, err := pkgpostgres.NewPool(dbCtx, pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	})
+	if err != nil {
+		logger.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
+	logger.Info("connected to database")
+
+	// Run database migrations.
+	migDSN := pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	}.DSN()
+	if migErr := pkgpostgres.RunMigrations(migDSN, "file://internal/infrastructure/postgres/migrations"); migErr != nil {
+		logger.Warn("migration warning", "error", migErr)
+	}
+
+	// Wire infrastructure adapters.
+	documentRepo := postgres.NewDocumentRepo(pool)
+	templateRepo := postgres.NewTemplateRepo(pool)
+	kafkaProducer := pkgkafka.NewProducer(pkgkafka.Config{
+		Brokers: cfg.Kafka.Brokers,
+	})
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(kafkaProducer.Close))
+
+	// Outbox relay: events are written to the outbox and published from it,
+	// so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     outbox.StaticRoute("document-events"),
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: cfg.ServiceName,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, kafkaProducer, relayCfg, logger)
+	if err != nil {
+		logger.Error("failed to create outbox relay", "error", err)
+		os.Exit(1)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+	eventPublisher := postgres.NewOutboxPublisher(outbox.NewPublisher(outboxStore), "document-events")
+
+	// JWT service for gRPC auth (validation-only: public key preferred, secret as fallback).
+	jwtCfg := auth.JWTConfig{
+		Issuer: "bib-gateway",
+	}
+	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
+	case os.Getenv("JWT_PUBLIC_KEY") != "":
+		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
+	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
+		keyData, loadErr := auth.LoadKeyFromFile(os.Getenv("JWT_PUBLIC_KEY_FILE"))
+		if loadErr != nil {
+			logger.Error("failed to load JWT public key file", "error", loadErr)
+			os.Exit(1)
+		}
+		jwtCfg.PublicKeyPEM = string(keyData)
+	default:
+		jwtSecret := os.Getenv("JWT_SECRET")
+		if jwtSecret == "" {
+			jwtSecret = "test-e2e-secret" // Match gateway default for E2E tests
+		}
+		jwtCfg.Secret = jwtSecret
+	}
+	jwtSvc, err := auth.NewJWTService(jwtCfg)
+	if err != nil {
+		logger.Error("failed to initialize JWT service", "error", err)
+		os.Exit(1)
+	}
+
+	// Document content goes to S3 when a bucket is configured, otherwise to
+	// the local document directory.
+	store := objectstore.New(archive.NewStore(cfg.Storage))
+
+	// Download URLs must verify on every replica, so the signing key is
+	// shared configuration; a random key only suits a single replica.
+	signingKey := []byte(cfg.Download.SigningKey)
+	if len(signingKey) == 0 {
+		logger.Warn("DOCUMENT_SIGNING_KEY not set, download URLs will not survive a restart")
+		signingKey = make([]byte, 32)
+		if _, err := rand.Read(signingKey); err != nil {
+			logger.Error("failed to generate download signing key", "error", err)
+			os.Exit(1)
+		}
+	}
+	signer := signing.NewHMACSigner(cfg.Download.BaseURL, signingKey)
+
+	// Wire use cases.
+	upsertTemplateUC := usecase.NewUpsertTemplateUseCase(templateRepo)
+	getTemplateUC := usecase.NewGetTemplateUseCase(templateRepo)
+	listTemplatesUC := usecase.NewListTemplatesUseCase(templateRepo)
+	renderUC := usecase.NewRenderDocumentUseCase(templateRepo, documentRepo, store, eventPublisher, logger)
+	getUC := usecase.NewGetDocumentUseCase(documentRepo)
+	listUC := usecase.NewListDocumentsUseCase(documentRepo)
+	downloadURLUC := usecase.NewGetDownloadURLUseCase(documentRepo, signer)
+	downloadUC := usecase.NewDownloadDocumentUseCase(documentRepo, store, signer)
+	purgeUC := usecase.NewPurgeExpiredDocumentsUseCase(documentRepo, store, eventPublisher, logger)
+
+	// Purge expired documents on the elected replica only.
+	elector := lock.NewElector(lock.NewPostgresLocker(pool), "document.retention", lock.ElectorConfig{}, logger)
+	lc.Go(lifecycle.PhaseWorkers, "retention purge", func(ctx context.Context) error {
+		elector.Run(ctx, func(ctx context.Context) {
+			ticker := time.NewTicker(cfg.Retention.PurgeInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case now := <-ticker.C:
+					result, purgeErr := purgeUC.Execute(ctx, now.UTC())
+					if purgeErr != nil {
+						logger.Error("document purge pass failed", "error", purgeErr)
+					}
+					if result != (dto.PurgeResult{}) {
+						logger.Info("document purge pass",
+							"purged", result.Purged,
+							"failed", result.Failed,
+						)
+					}
+				}
+			}
+		})
+		return nil
+	})
+
+	// gRPC server.
+	grpcHandler := grpcpresentation.NewHandler(
+		upsertTemplateUC, getTemplateUC, listTemplatesUC, renderUC, getUC, listUC, downloadURLUC, purgeUC, logger,
+	)
+	grpcServer, err := grpcpresentation.NewServer(grpcHandler, logger, jwtSvc)
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
+
+	// HTTP server (health checks, metrics and signed downloads).
+	healthHandler := rest.NewHealthHandler(logger)
+	httpMux := http.NewServeMux()
+	healthHandler.RegisterRoutes(httpMux)
+	rest.NewDownloadHandler(downloadUC, logger).RegisterRoutes(httpMux)
+	if metricsHandler != nil {
+		httpMux.Handle("/metrics", metricsHandler)
+	}
+
+	httpServer := &http.Server{
+		Addr:              cfg.HTTPAddr(),
+		Handler:           httpMux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Start servers.
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", func(context.Context) error {
+		return grpcServer.Start(cfg.GRPCAddr())
+	})
+	lc.OnStop(lifecycle.PhaseIngress, "gRPC server", lifecycle.Func(grpcServer.Stop))
+	lc.ServeHTTP("HTTP server", httpServer)
+
+	logger.Info("document-service is running",
+		"grpc_addr", cfg.GRPCAddr(),
+		"http_addr", cfg.HTTPAddr(),
+	)
+
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
+	}
+	logger.Info("document-service stopped")
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,129 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UpsertTemplateRequest is the input DTO for creating or changing a
+// tenant's document template.
+type UpsertTemplateRequest struct {
+	Name          string    `json:"name"`
+	Title         string    `json:"title"`
+	Body          string    `json:"body"`
+	RetentionDays int       `json:"retention_days"`
+	TenantID      uuid.UUID `json:"tenant_id"`
+}
+
+// TemplateResponse is the output DTO for a document template.
+type TemplateResponse struct {
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	Name          string    `json:"name"`
+	Title         string    `json:"title"`
+	Body          string    `json:"body"`
+	RetentionDays int       `json:"retention_days"`
+	Version       int       `json:"version"`
+	ID            uuid.UUID `json:"id"`
+	TenantID      uuid.UUID `json:"tenant_id"`
+}
+
+// ListTemplatesRequest is the input DTO for listing a tenant's templates.
+type ListTemplatesRequest struct {
+	Limit    int       `json:"limit"`
+	Offset   int       `json:"offset"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+// ListTemplatesResponse is the output DTO for listing templates.
+type ListTemplatesResponse struct {
+	Templates  []TemplateResponse `json:"templates"`
+	TotalCount int                `json:"total_count"`
+}
+
+// RenderDocumentRequest is the input DTO for rendering a document from a
+// template. RetentionDays defaults to the template's retention.
+type RenderDocumentRequest struct {
+	Data           map[string]any `json:"data"`
+	TemplateName   string         `json:"template_name"`
+	OwnerReference string         `json:"owner_reference"`
+	RetentionDays  int            `json:"retention_days"`
+	TenantID       uuid.UUID      `json:"tenant_id"`
+	CustomerID     uuid.UUID      `json:"customer_id"`
+}
+
+// DocumentResponse is the output DTO for a document.
+type DocumentResponse struct {
+	CreatedAt       time.Time `json:"created_at"`
+	RetainUntil     time.Time `json:"retain_until"`
+	PurgedAt        time.Time `json:"purged_at"`
+	TemplateName    string    `json:"template_name"`
+	OwnerReference  string    `json:"owner_reference"`
+	Title           string    `json:"title"`
+	Status          string    `json:"status"`
+	ContentType     string    `json:"content_type"`
+	SHA256          string    `json:"sha256"`
+	SizeBytes       int64     `json:"size_bytes"`
+	TemplateVersion int       `json:"template_version"`
+	PageCount       int       `json:"page_count"`
+	Version         int       `json:"version"`
+	ID              uuid.UUID `json:"id"`
+	TenantID        uuid.UUID `json:"tenant_id"`
+	CustomerID      uuid.UUID `json:"customer_id"`
+}
+
+// ListDocumentsRequest is the input DTO for listing a tenant's documents,
+// optionally those of one customer or owner reference.
+type ListDocumentsRequest struct {
+	OwnerReference string    `json:"owner_reference"`
+	Limit          int       `json:"limit"`
+	Offset         int       `json:"offset"`
+	TenantID       uuid.UUID `json:"tenant_id"`
+	CustomerID     uuid.UUID `json:"customer_id"`
+}
+
+// ListDocumentsResponse is the output DTO for listing documents.
+type ListDocumentsResponse struct {
+	Documents  []DocumentResponse `json:"documents"`
+	TotalCount int                `json:"total_count"`
+}
+
+// DownloadURLRequest is the input DTO for a signed download URL. TTL
+// defaults to 15 minutes; CustomerID, when set, limits the request to that
+// customer's documents.
+type DownloadURLRequest struct {
+	TTL        time.Duration `json:"ttl"`
+	TenantID   uuid.UUID     `json:"tenant_id"`
+	DocumentID uuid.UUID     `json:"document_id"`
+	CustomerID uuid.UUID     `json:"customer_id"`
+}
+
+// DownloadURLResponse is the output DTO for a signed download URL.
+type DownloadURLResponse struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	URL       string    `json:"url"`
+}
+
+// DownloadRequest is the input DTO for downloading a document through a
+// signed URL.
+type DownloadRequest struct {
+	ExpiresAt  time.Time `json:"expires_at"`
+	Signature  string    `json:"signature"`
+	TenantID   uuid.UUID `json:"tenant_id"`
+	DocumentID uuid.UUID `json:"document_id"`
+}
+
+// DocumentContentResponse is the output DTO for a document's content.
+type DocumentContentResponse struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Content     []byte `json:"content"`
+}
+
+// PurgeResult counts the documents a purge pass deleted and failed to
+// delete.
+type PurgeResult struct {
+	Purged int `json:"purged"`
+	Failed int `json:"failed"`
+}
@@ -0,0 +1,262 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/document-service/internal/application/dto"
+	"github.com/bibbank/bib/services/document-service/internal/domain/model"
+	"github.com/bibbank/bib/services/document-service/internal/domain/port"
+	"github.com/bibbank/bib/services/document-service/internal/domain/service"
+	"github.com/bibbank/bib/services/document-service/internal/domain/valueobject"
+)
+
+// ErrInvalidDocument is returned when a document request is malformed or its
+// data does not satisfy the template.
+var ErrInvalidDocument = errors.New("invalid document")
+
+// ErrDocumentPurged is returned when the content of a document whose
+// retention period ended is requested.
+var ErrDocumentPurged = errors.New("document was purged")
+
+const (
+	defaultDownloadTTL = 15 * time.Minute
+	maxDownloadTTL     = 24 * time.Hour
+)
+
+// RenderDocumentUseCase renders a document from a template and stores it.
+type RenderDocumentUseCase struct {
+	templates port.TemplateRepository
+	documents port.DocumentRepository
+	store     port.ObjectStore
+	publisher port.EventPublisher
+	logger    *slog.Logger
+}
+
+// NewRenderDocumentUseCase creates a new RenderDocumentUseCase.
+func NewRenderDocumentUseCase(
+	templates port.TemplateRepository,
+	documents port.DocumentRepository,
+	store port.ObjectStore,
+	publisher port.EventPublisher,
+	logger *slog.Logger,
+) *RenderDocumentUseCase {
+	return &RenderDocumentUseCase{
+		templates: templates, documents: documents, store: store, publisher: publisher, logger: logger,
+	}
+}
+
+// Execute renders the template against the request's data as a PDF, stores
+// it and records the document.
+func (uc *RenderDocumentUseCase) Execute(ctx context.Context, req dto.RenderDocumentRequest) (dto.DocumentResponse, error) {
+	name, err := valueobject.NewTemplateName(req.TemplateName)
+	if err != nil {
+		return dto.DocumentResponse{}, fmt.Errorf("%w: %w", ErrInvalidDocument, err)
+	}
+	template, err := uc.templates.FindByName(ctx, req.TenantID, name)
+	if err != nil {
+		return dto.DocumentResponse{}, fmt.Errorf("failed to find template: %w", err)
+	}
+	retentionDays := req.RetentionDays
+	if retentionDays == 0 {
+		retentionDays = template.RetentionDays()
+	}
+	now := time.Now().UTC()
+	document, err := model.NewDocument(template, req.CustomerID, req.OwnerReference, retentionDays, now)
+	if err != nil {
+		return dto.DocumentResponse{}, fmt.Errorf("%w: %w", ErrInvalidDocument, err)
+	}
+
+	blocks, err := template.Execute(req.Data)
+	if err != nil {
+		return dto.DocumentResponse{}, fmt.Errorf("%w: %w", ErrInvalidDocument, err)
+	}
+	content, pages := service.RenderPDF(template.Title(), blocks)
+
+	key := objectKey(document)
+	if err := uc.store.Put(ctx, key, content, model.ContentTypePDF); err != nil {
+		return dto.DocumentResponse{}, fmt.Errorf("failed to store document: %w", err)
+	}
+	sum := sha256.Sum256(content)
+	document, err = document.MarkStored(key, hex.EncodeToString(sum[:]), int64(len(content)), pages)
+	if err != nil {
+		return dto.DocumentResponse{}, err
+	}
+
+	if err := uc.documents.Save(ctx, document); err != nil {
+		// Nothing refers to the object without the document, so remove it
+		// rather than leave it to outlive any retention period.
+		if delErr := uc.store.Delete(ctx, key); delErr != nil {
+			uc.logger.Warn("failed to delete unrecorded document", "key", key, "error", delErr)
+		}
+		return dto.DocumentResponse{}, fmt.Errorf("failed to save document: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, document.DomainEvents()); err != nil {
+		return dto.DocumentResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toDocumentResponse(document), nil
+}
+
+// objectKey returns the key a document's content is stored under.
+func objectKey(d model.Document) string {
+	return fmt.Sprintf("documents/%s/%s/%s.pdf", d.TenantID(), d.TemplateName(), d.ID())
+}
+
+// GetDocumentUseCase retrieves a document.
+type GetDocumentUseCase struct {
+	documents port.DocumentRepository
+}
+
+// NewGetDocumentUseCase creates a new GetDocumentUseCase.
+func NewGetDocumentUseCase(documents port.DocumentRepository) *GetDocumentUseCase {
+	return &GetDocumentUseCase{documents: documents}
+}
+
+// Execute retrieves one of the tenant's documents.
+func (uc *GetDocumentUseCase) Execute(ctx context.Context, tenantID, documentID uuid.UUID) (dto.DocumentResponse, error) {
+	document, err := uc.documents.FindByID(ctx, tenantID, documentID)
+	if err != nil {
+		return dto.DocumentResponse{}, fmt.Errorf("failed to find document: %w", err)
+	}
+	return toDocumentResponse(document), nil
+}
+
+// ListDocumentsUseCase lists a tenant's documents.
+type ListDocumentsUseCase struct {
+	documents port.DocumentRepository
+}
+
+// NewListDocumentsUseCase creates a new ListDocumentsUseCase.
+func NewListDocumentsUseCase(documents port.DocumentRepository) *ListDocumentsUseCase {
+	return &ListDocumentsUseCase{documents: documents}
+}
+
+// Execute lists the tenant's documents matching the request, newest first.
+func (uc *ListDocumentsUseCase) Execute(ctx context.Context, req dto.ListDocumentsRequest) (dto.ListDocumentsResponse, error) {
+	limit, offset := page(req.Limit, req.Offset)
+	filter := port.DocumentFilter{CustomerID: req.CustomerID, OwnerReference: req.OwnerReference}
+	documents, total, err := uc.documents.List(ctx, req.TenantID, filter, limit, offset)
+	if err != nil {
+		return dto.ListDocumentsResponse{}, fmt.Errorf("failed to list documents: %w", err)
+	}
+	resp := dto.ListDocumentsResponse{
+		Documents:  make([]dto.DocumentResponse, 0, len(documents)),
+		TotalCount: total,
+	}
+	for _, d := range documents {
+		resp.Documents = append(resp.Documents, toDocumentResponse(d))
+	}
+	return resp, nil
+}
+
+// GetDownloadURLUseCase issues signed download URLs for documents.
+type GetDownloadURLUseCase struct {
+	documents port.DocumentRepository
+	signer    port.DownloadSigner
+}
+
+// NewGetDownloadURLUseCase creates a new GetDownloadURLUseCase.
+func NewGetDownloadURLUseCase(documents port.DocumentRepository, signer port.DownloadSigner) *GetDownloadURLUseCase {
+	return &GetDownloadURLUseCase{documents: documents, signer: signer}
+}
+
+// Execute returns a URL that downloads the document without further
+// authentication until it expires.
+func (uc *GetDownloadURLUseCase) Execute(ctx context.Context, req dto.DownloadURLRequest) (dto.DownloadURLResponse, error) {
+	ttl := req.TTL
+	if ttl == 0 {
+		ttl = defaultDownloadTTL
+	}
+	if ttl < 0 || ttl > maxDownloadTTL {
+		return dto.DownloadURLResponse{}, fmt.Errorf("%w: download URLs must expire within %s", ErrInvalidDocument, maxDownloadTTL)
+	}
+	document, err := uc.documents.FindByID(ctx, req.TenantID, req.DocumentID)
+	if err != nil {
+		return dto.DownloadURLResponse{}, fmt.Errorf("failed to find document: %w", err)
+	}
+	if req.CustomerID != uuid.Nil && req.CustomerID != document.CustomerID() {
+		return dto.DownloadURLResponse{}, fmt.Errorf("document %s: %w", req.DocumentID, port.ErrDocumentNotFound)
+	}
+	if !document.Status().Equal(valueobject.DocumentStatusStored) {
+		return dto.DownloadURLResponse{}, fmt.Errorf("document %s: %w", req.DocumentID, ErrDocumentPurged)
+	}
+
+	// Signatures carry whole seconds.
+	expiresAt := time.Now().UTC().Add(ttl).Truncate(time.Second)
+	return dto.DownloadURLResponse{
+		URL:       uc.signer.SignURL(document.TenantID(), document.ID(), expiresAt),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// DownloadDocumentUseCase serves a document's content through a signed URL.
+type DownloadDocumentUseCase struct {
+	documents port.DocumentRepository
+	store     port.ObjectStore
+	signer    port.DownloadSigner
+}
+
+// NewDownloadDocumentUseCase creates a new DownloadDocumentUseCase.
+func NewDownloadDocumentUseCase(
+	documents port.DocumentRepository,
+	store port.ObjectStore,
+	signer port.DownloadSigner,
+) *DownloadDocumentUseCase {
+	return &DownloadDocumentUseCase{documents: documents, store: store, signer: signer}
+}
+
+// Execute checks the download's signature and reads the document from object
+// storage, checking it against the checksum recorded when it was stored.
+func (uc *DownloadDocumentUseCase) Execute(ctx context.Context, req dto.DownloadRequest) (dto.DocumentContentResponse, error) {
+	if err := uc.signer.Verify(req.TenantID, req.DocumentID, req.ExpiresAt, req.Signature, time.Now()); err != nil {
+		return dto.DocumentContentResponse{}, err
+	}
+	document, err := uc.documents.FindByID(ctx, req.TenantID, req.DocumentID)
+	if err != nil {
+		return dto.DocumentContentResponse{}, fmt.Errorf("failed to find document: %w", err)
+	}
+	if !document.Status().Equal(valueobject.DocumentStatusStored) {
+		return dto.DocumentContentResponse{}, fmt.Errorf("document %s: %w", req.DocumentID, ErrDocumentPurged)
+	}
+	content, err := uc.store.Get(ctx, document.ObjectKey())
+	if err != nil {
+		return dto.DocumentContentResponse{}, fmt.Errorf("failed to read document %s: %w", req.DocumentID, err)
+	}
+	sum := sha256.Sum256(content)
+	if hex.EncodeToString(sum[:]) != document.SHA256() {
+		return dto.DocumentContentResponse{}, fmt.Errorf("document %s does not match its checksum", req.DocumentID)
+	}
+	return dto.DocumentContentResponse{
+		FileName:    document.FileName(),
+		ContentType: model.ContentTypePDF,
+		Content:     content,
+	}, nil
+}
+
+func toDocumentResponse(d model.Document) dto.DocumentResponse {
+	return dto.DocumentResponse{
+		ID:              d.ID(),
+		TenantID:        d.TenantID(),
+		CustomerID:      d.CustomerID(),
+		TemplateName:    d.TemplateName().String(),
+		TemplateVersion: d.TemplateVersion(),
+		OwnerReference:  d.OwnerReference(),
+		Title:           d.Title(),
+		Status:          d.Status().String(),
+		ContentType:     model.ContentTypePDF,
+		SHA256:          d.SHA256(),
+		SizeBytes:       d.SizeBytes(),
+		PageCount:       d.PageCount(),
+		CreatedAt:       d.CreatedAt(),
+		RetainUntil:     d.RetainUntil(),
+		PurgedAt:        d.PurgedAt(),
+		Version:         d.Version(),
+	}
+}
@@ -0,0 +1,232 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/document-service/internal/application/dto"
+	"github.com/bibbank/bib/services/document-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/document-service/internal/domain/port"
+	"github.com/bibbank/bib/services/document-service/internal/infrastructure/signing"
+)
+
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+type fixture struct {
+	templates *inMemoryTemplateRepo
+	documents *inMemoryDocumentRepo
+	store     *memStore
+	publisher *recordingPublisher
+	signer    *signing.HMACSigner
+	tenantID  uuid.UUID
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	f := &fixture{
+		templates: &inMemoryTemplateRepo{},
+		documents: &inMemoryDocumentRepo{},
+		store:     newMemStore(),
+		publisher: &recordingPublisher{},
+		signer:    signing.NewHMACSigner("https://docs.example.com", []byte("test-key")),
+		tenantID:  uuid.New(),
+	}
+	_, err := usecase.NewUpsertTemplateUseCase(f.templates).Execute(context.Background(), dto.UpsertTemplateRequest{
+		TenantID:      f.tenantID,
+		Name:          "payoff-letter",
+		Title:         "Loan payoff letter",
+		Body:          "# Payoff letter\nLoan {{.loan}} is paid off with {{.amount}}.\n",
+		RetentionDays: 365,
+	})
+	require.NoError(t, err)
+	return f
+}
+
+func (f *fixture) render(t *testing.T, req dto.RenderDocumentRequest) (dto.DocumentResponse, error) {
+	t.Helper()
+	req.TenantID = f.tenantID
+	if req.TemplateName == "" {
+		req.TemplateName = "payoff-letter"
+	}
+	return usecase.NewRenderDocumentUseCase(f.templates, f.documents, f.store, f.publisher, discardLogger).
+		Execute(context.Background(), req)
+}
+
+func payoffData() map[string]any {
+	return map[string]any{"loan": "LN-7", "amount": "1200.00 USD"}
+}
+
+func TestUpsertTemplate_CreatesThenVersions(t *testing.T) {
+	f := newFixture(t)
+	uc := usecase.NewUpsertTemplateUseCase(f.templates)
+
+	resp, err := uc.Execute(context.Background(), dto.UpsertTemplateRequest{
+		TenantID: f.tenantID, Name: "payoff-letter", Title: "Payoff letter", Body: "{{.loan}}", RetentionDays: 730,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Version)
+	assert.Equal(t, 730, resp.RetentionDays)
+	require.Len(t, f.templates.templates, 1)
+
+	_, err = uc.Execute(context.Background(), dto.UpsertTemplateRequest{
+		TenantID: f.tenantID, Name: "payoff-letter", Title: "Payoff letter", Body: "{{if}}", RetentionDays: 730,
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidTemplate)
+	_, err = uc.Execute(context.Background(), dto.UpsertTemplateRequest{
+		TenantID: f.tenantID, Name: "Payoff Letter", Title: "Payoff letter", Body: "x", RetentionDays: 730,
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidTemplate)
+}
+
+func TestRenderDocument_StoresPDF(t *testing.T) {
+	f := newFixture(t)
+	customerID := uuid.New()
+
+	doc, err := f.render(t, dto.RenderDocumentRequest{
+		Data: payoffData(), CustomerID: customerID, OwnerReference: "LN-7",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "STORED", doc.Status)
+	assert.Equal(t, "Loan payoff letter", doc.Title)
+	assert.Equal(t, 1, doc.TemplateVersion)
+	assert.Equal(t, 1, doc.PageCount)
+	assert.Equal(t, doc.CreatedAt.AddDate(0, 0, 365), doc.RetainUntil, "template retention applies by default")
+	assert.Equal(t, []string{"document.stored"}, f.publisher.eventTypes())
+
+	require.Len(t, f.store.objects, 1)
+	for _, content := range f.store.objects {
+		assert.True(t, bytes.HasPrefix(content, []byte("%PDF")))
+		assert.Contains(t, string(content), "Loan LN-7 is paid off with 1200.00 USD.")
+	}
+
+	short, err := f.render(t, dto.RenderDocumentRequest{Data: payoffData(), RetentionDays: 30})
+	require.NoError(t, err)
+	assert.Equal(t, short.CreatedAt.AddDate(0, 0, 30), short.RetainUntil)
+
+	list, err := usecase.NewListDocumentsUseCase(f.documents).Execute(context.Background(), dto.ListDocumentsRequest{
+		TenantID: f.tenantID, CustomerID: customerID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, list.TotalCount)
+	assert.Equal(t, doc.ID, list.Documents[0].ID)
+}
+
+func TestRenderDocument_Failures(t *testing.T) {
+	f := newFixture(t)
+
+	_, err := f.render(t, dto.RenderDocumentRequest{Data: map[string]any{"loan": "LN-7"}})
+	assert.ErrorIs(t, err, usecase.ErrInvalidDocument, "missing template data")
+
+	_, err = f.render(t, dto.RenderDocumentRequest{TemplateName: "adverse-action", Data: payoffData()})
+	assert.ErrorIs(t, err, port.ErrTemplateNotFound)
+
+	f.store.failing = true
+	_, err = f.render(t, dto.RenderDocumentRequest{Data: payoffData()})
+	assert.ErrorIs(t, err, errStoreDown)
+	f.store.failing = false
+
+	f.documents.err = errors.New("database unavailable")
+	_, err = f.render(t, dto.RenderDocumentRequest{Data: payoffData()})
+	require.Error(t, err)
+	assert.Empty(t, f.store.objects, "content of an unrecorded document is removed")
+	assert.Empty(t, f.documents.documents)
+	assert.Empty(t, f.publisher.events)
+}
+
+func TestDownloadURL_RoundTrip(t *testing.T) {
+	f := newFixture(t)
+	customerID := uuid.New()
+	doc, err := f.render(t, dto.RenderDocumentRequest{Data: payoffData(), CustomerID: customerID})
+	require.NoError(t, err)
+
+	urlUC := usecase.NewGetDownloadURLUseCase(f.documents, f.signer)
+	link, err := urlUC.Execute(context.Background(), dto.DownloadURLRequest{
+		TenantID: f.tenantID, DocumentID: doc.ID, CustomerID: customerID,
+	})
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(15*time.Minute), link.ExpiresAt, 2*time.Second)
+
+	_, err = urlUC.Execute(context.Background(), dto.DownloadURLRequest{
+		TenantID: f.tenantID, DocumentID: doc.ID, CustomerID: uuid.New(),
+	})
+	assert.ErrorIs(t, err, port.ErrDocumentNotFound, "another customer's document")
+	_, err = urlUC.Execute(context.Background(), dto.DownloadURLRequest{
+		TenantID: f.tenantID, DocumentID: doc.ID, TTL: 48 * time.Hour,
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidDocument)
+
+	u, err := url.Parse(link.URL)
+	require.NoError(t, err)
+	expires, err := strconv.ParseInt(u.Query().Get("expires"), 10, 64)
+	require.NoError(t, err)
+	req := dto.DownloadRequest{
+		TenantID:   f.tenantID,
+		DocumentID: doc.ID,
+		ExpiresAt:  time.Unix(expires, 0),
+		Signature:  u.Query().Get("signature"),
+	}
+
+	downloadUC := usecase.NewDownloadDocumentUseCase(f.documents, f.store, f.signer)
+	content, err := downloadUC.Execute(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "application/pdf", content.ContentType)
+	assert.Equal(t, "payoff-letter-"+doc.ID.String()+".pdf", content.FileName)
+
+	tampered := req
+	tampered.Signature = "00" + req.Signature[2:]
+	_, err = downloadUC.Execute(context.Background(), tampered)
+	assert.ErrorIs(t, err, port.ErrInvalidSignature)
+
+	for key := range f.store.objects {
+		f.store.objects[key] = []byte("%PDF-1.4 forged")
+	}
+	_, err = downloadUC.Execute(context.Background(), req)
+	assert.ErrorContains(t, err, "does not match its checksum")
+}
+
+func TestPurgeExpiredDocuments(t *testing.T) {
+	f := newFixture(t)
+	doc, err := f.render(t, dto.RenderDocumentRequest{Data: payoffData(), RetentionDays: 1})
+	require.NoError(t, err)
+	kept, err := f.render(t, dto.RenderDocumentRequest{Data: payoffData(), RetentionDays: 30})
+	require.NoError(t, err)
+
+	purgeUC := usecase.NewPurgeExpiredDocumentsUseCase(f.documents, f.store, f.publisher, discardLogger)
+	later := doc.RetainUntil.Add(time.Minute)
+
+	f.store.failing = true
+	result, err := purgeUC.Execute(context.Background(), later)
+	require.NoError(t, err)
+	assert.Equal(t, dto.PurgeResult{Failed: 1}, result, "a failed deletion leaves the document stored")
+	f.store.failing = false
+
+	result, err = purgeUC.Execute(context.Background(), later)
+	require.NoError(t, err)
+	assert.Equal(t, dto.PurgeResult{Purged: 1}, result)
+	assert.Len(t, f.store.objects, 1)
+	assert.Equal(t, []string{"document.stored", "document.stored", "document.purged"}, f.publisher.eventTypes())
+
+	get := usecase.NewGetDocumentUseCase(f.documents)
+	purged, err := get.Execute(context.Background(), f.tenantID, doc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "PURGED", purged.Status)
+	remaining, err := get.Execute(context.Background(), f.tenantID, kept.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "STORED", remaining.Status)
+
+	_, err = usecase.NewGetDownloadURLUseCase(f.documents, f.signer).Execute(context.Background(), dto.DownloadURLRequest{
+		TenantID: f.tenantID, DocumentID: doc.ID,
+	})
+	assert.ErrorIs(t, err, usecase.ErrDocumentPurged)
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/bibbank/bib/services/document-service/internal/application/dto"
+	"github.com/bibbank/bib/services/document-service/internal/domain/model"
+	"github.com/bibbank/bib/services/document-service/internal/domain/port"
+)
+
+// purgeBatchSize is how many expired documents a purge pass loads at a time.
+const purgeBatchSize = 100
+
+// PurgeExpiredDocumentsUseCase deletes the content of documents whose
+// retention period has ended.
+type PurgeExpiredDocumentsUseCase struct {
+	documents port.DocumentRepository
+	store     port.ObjectStore
+	publisher port.EventPublisher
+	logger    *slog.Logger
+}
+
+// NewPurgeExpiredDocumentsUseCase creates a new PurgeExpiredDocumentsUseCase.
+func NewPurgeExpiredDocumentsUseCase(
+	documents port.DocumentRepository,
+	store port.ObjectStore,
+	publisher port.EventPublisher,
+	logger *slog.Logger,
+) *PurgeExpiredDocumentsUseCase {
+	return &PurgeExpiredDocumentsUseCase{documents: documents, store: store, publisher: publisher, logger: logger}
+}
+
+// Execute purges every document expired by now. A document that cannot be
+// purged is logged, counted and left for the next pass.
+func (uc *PurgeExpiredDocumentsUseCase) Execute(ctx context.Context, now time.Time) (dto.PurgeResult, error) {
+	var result dto.PurgeResult
+	for {
+		documents, err := uc.documents.ListExpired(ctx, now, purgeBatchSize)
+		if err != nil {
+			return result, fmt.Errorf("failed to list expired documents: %w", err)
+		}
+		purged := 0
+		for _, d := range documents {
+			if err := uc.purge(ctx, d, now); err != nil {
+				uc.logger.Error("failed to purge document", "document_id", d.ID(), "error", err)
+				result.Failed++
+				continue
+			}
+			purged++
+		}
+		result.Purged += purged
+		// A batch that purged nothing would be loaded again unchanged.
+		if len(documents) < purgeBatchSize || purged == 0 {
+			return result, nil
+		}
+	}
+}
+
+// purge deletes the document's content before recording it as purged, so a
+// failure leaves it stored and retried rather than recorded as gone while
+// its content remains.
+func (uc *PurgeExpiredDocumentsUseCase) purge(ctx context.Context, document model.Document, now time.Time) error {
+	if err := uc.store.Delete(ctx, document.ObjectKey()); err != nil {
+		return fmt.Errorf("failed to delete content: %w", err)
+	}
+	document, err := document.Purge(now)
+	if err != nil {
+		return err
+	}
+	if err := uc.documents.Save(ctx, document); err != nil {
+		return fmt.Errorf("failed to save document: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, document.DomainEvents()); err != nil {
+		return fmt.Errorf("failed to publish events: %w", err)
+	}
+	return nil
+}
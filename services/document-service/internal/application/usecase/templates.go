@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/document-service/internal/application/dto"
+	"github.com/bibbank/bib/services/document-service/internal/domain/model"
+	"github.com/bibbank/bib/services/document-service/internal/domain/port"
+	"github.com/bibbank/bib/services/document-service/internal/domain/valueobject"
+)
+
+// ErrInvalidTemplate is returned when a template request is malformed or its
+// body does not parse.
+var ErrInvalidTemplate = errors.New("invalid document template")
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// UpsertTemplateUseCase creates or changes a tenant's document template.
+type UpsertTemplateUseCase struct {
+	templates port.TemplateRepository
+}
+
+// NewUpsertTemplateUseCase creates a new UpsertTemplateUseCase.
+func NewUpsertTemplateUseCase(templates port.TemplateRepository) *UpsertTemplateUseCase {
+	return &UpsertTemplateUseCase{templates: templates}
+}
+
+// Execute creates the template, or updates it to a new version when the
+// tenant already has one by that name.
+func (uc *UpsertTemplateUseCase) Execute(ctx context.Context, req dto.UpsertTemplateRequest) (dto.TemplateResponse, error) {
+	name, err := valueobject.NewTemplateName(req.Name)
+	if err != nil {
+		return dto.TemplateResponse{}, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+	now := time.Now().UTC()
+
+	template, err := uc.templates.FindByName(ctx, req.TenantID, name)
+	switch {
+	case errors.Is(err, port.ErrTemplateNotFound):
+		template, err = model.NewTemplate(req.TenantID, name, req.Title, req.Body, req.RetentionDays, now)
+	case err != nil:
+		return dto.TemplateResponse{}, fmt.Errorf("failed to find template: %w", err)
+	default:
+		template, err = template.Update(req.Title, req.Body, req.RetentionDays, now)
+	}
+	if err != nil {
+		return dto.TemplateResponse{}, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+
+	if err := uc.templates.Save(ctx, template); err != nil {
+		return dto.TemplateResponse{}, fmt.Errorf("failed to save template: %w", err)
+	}
+	return toTemplateResponse(template), nil
+}
+
+// GetTemplateUseCase retrieves a document template.
+type GetTemplateUseCase struct {
+	templates port.TemplateRepository
+}
+
+// NewGetTemplateUseCase creates a new GetTemplateUseCase.
+func NewGetTemplateUseCase(templates port.TemplateRepository) *GetTemplateUseCase {
+	return &GetTemplateUseCase{templates: templates}
+}
+
+// Execute retrieves one of the tenant's templates by name.
+func (uc *GetTemplateUseCase) Execute(ctx context.Context, tenantID uuid.UUID, rawName string) (dto.TemplateResponse, error) {
+	name, err := valueobject.NewTemplateName(rawName)
+	if err != nil {
+		return dto.TemplateResponse{}, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+	template, err := uc.templates.FindByName(ctx, tenantID, name)
+	if err != nil {
+		return dto.TemplateResponse{}, fmt.Errorf("failed to find template: %w", err)
+	}
+	return toTemplateResponse(template), nil
+}
+
+// ListTemplatesUseCase lists a tenant's document templates.
+type ListTemplatesUseCase struct {
+	templates port.TemplateRepository
+}
+
+// NewListTemplatesUseCase creates a new ListTemplatesUseCase.
+func NewListTemplatesUseCase(templates port.TemplateRepository) *ListTemplatesUseCase {
+	return &ListTemplatesUseCase{templates: templates}
+}
+
+// Execute lists the tenant's templates by name.
+func (uc *ListTemplatesUseCase) Execute(ctx context.Context, req dto.ListTemplatesRequest) (dto.ListTemplatesResponse, error) {
+	limit, offset := page(req.Limit, req.Offset)
+	templates, total, err := uc.templates.List(ctx, req.TenantID, limit, offset)
+	if err != nil {
+		return dto.ListTemplatesResponse{}, fmt.Errorf("failed to list templates: %w", err)
+	}
+	resp := dto.ListTemplatesResponse{
+		Templates:  make([]dto.TemplateResponse, 0, len(templates)),
+		TotalCount: total,
+	}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, toTemplateResponse(t))
+	}
+	return resp, nil
+}
+
+func page(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	return min(limit, maxLimit), max(offset, 0)
+}
+
+func toTemplateResponse(t model.Template) dto.TemplateResponse {
+	return dto.TemplateResponse{
+		ID:            t.ID(),
+		TenantID:      t.TenantID(),
+		Name:          t.Name().String(),
+		Title:         t.Title(),
+		Body:          t.Body(),
+		RetentionDays: t.RetentionDays(),
+		Version:       t.Version(),
+		CreatedAt:     t.CreatedAt(),
+		UpdatedAt:     t.UpdatedAt(),
+	}
+}
@@ -0,0 +1,172 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/document-service/internal/domain/event"
+	"github.com/bibbank/bib/services/document-service/internal/domain/model"
+	"github.com/bibbank/bib/services/document-service/internal/domain/port"
+	"github.com/bibbank/bib/services/document-service/internal/domain/valueobject"
+)
+
+// inMemoryTemplateRepo is an in-memory TemplateRepository.
+type inMemoryTemplateRepo struct {
+	templates []model.Template
+}
+
+func (r *inMemoryTemplateRepo) Save(_ context.Context, template model.Template) error {
+	for i, t := range r.templates {
+		if t.ID() == template.ID() {
+			if t.Version() != template.Version()-1 {
+				return port.ErrVersionConflict
+			}
+			r.templates[i] = template
+			return nil
+		}
+		if t.TenantID() == template.TenantID() && t.Name().Equal(template.Name()) {
+			return port.ErrVersionConflict
+		}
+	}
+	r.templates = append(r.templates, template)
+	return nil
+}
+
+func (r *inMemoryTemplateRepo) FindByName(_ context.Context, tenantID uuid.UUID, name valueobject.TemplateName) (model.Template, error) {
+	for _, t := range r.templates {
+		if t.TenantID() == tenantID && t.Name().Equal(name) {
+			return t, nil
+		}
+	}
+	return model.Template{}, port.ErrTemplateNotFound
+}
+
+func (r *inMemoryTemplateRepo) List(_ context.Context, tenantID uuid.UUID, limit, offset int) ([]model.Template, int, error) {
+	var out []model.Template
+	for _, t := range r.templates {
+		if t.TenantID() == tenantID {
+			out = append(out, t)
+		}
+	}
+	slices.SortFunc(out, func(a, b model.Template) int { return strings.Compare(a.Name().String(), b.Name().String()) })
+	total := len(out)
+	out = out[min(offset, total):min(offset+limit, total)]
+	return out, total, nil
+}
+
+// inMemoryDocumentRepo is an in-memory DocumentRepository.
+type inMemoryDocumentRepo struct {
+	documents []model.Document
+	err       error
+}
+
+func (r *inMemoryDocumentRepo) Save(_ context.Context, document model.Document) error {
+	if r.err != nil {
+		return r.err
+	}
+	// Events are not persisted.
+	document = document.ClearDomainEvents()
+	for i, d := range r.documents {
+		if d.ID() == document.ID() {
+			r.documents[i] = document
+			return nil
+		}
+	}
+	r.documents = append(r.documents, document)
+	return nil
+}
+
+func (r *inMemoryDocumentRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.Document, error) {
+	for _, d := range r.documents {
+		if d.TenantID() == tenantID && d.ID() == id {
+			return d, nil
+		}
+	}
+	return model.Document{}, port.ErrDocumentNotFound
+}
+
+func (r *inMemoryDocumentRepo) List(_ context.Context, tenantID uuid.UUID, filter port.DocumentFilter, limit, offset int) ([]model.Document, int, error) {
+	var out []model.Document
+	for _, d := range r.documents {
+		if d.TenantID() != tenantID ||
+			filter.CustomerID != uuid.Nil && d.CustomerID() != filter.CustomerID ||
+			filter.OwnerReference != "" && d.OwnerReference() != filter.OwnerReference {
+			continue
+		}
+		out = append(out, d)
+	}
+	total := len(out)
+	out = out[min(offset, total):min(offset+limit, total)]
+	return out, total, nil
+}
+
+func (r *inMemoryDocumentRepo) ListExpired(_ context.Context, now time.Time, limit int) ([]model.Document, error) {
+	var out []model.Document
+	for _, d := range r.documents {
+		if d.Status().Equal(valueobject.DocumentStatusStored) && d.Expired(now) {
+			out = append(out, d)
+		}
+	}
+	return out[:min(limit, len(out))], nil
+}
+
+// errStoreDown is returned by a memStore with failing set.
+var errStoreDown = errors.New("object store unavailable")
+
+// memStore is an in-memory ObjectStore.
+type memStore struct {
+	objects map[string][]byte
+	failing bool
+}
+
+func newMemStore() *memStore {
+	return &memStore{objects: make(map[string][]byte)}
+}
+
+func (s *memStore) Put(_ context.Context, key string, data []byte, _ string) error {
+	if s.failing {
+		return errStoreDown
+	}
+	s.objects[key] = data
+	return nil
+}
+
+func (s *memStore) Get(_ context.Context, key string) ([]byte, error) {
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", port.ErrObjectNotFound, key)
+	}
+	return data, nil
+}
+
+func (s *memStore) Delete(_ context.Context, key string) error {
+	if s.failing {
+		return errStoreDown
+	}
+	delete(s.objects, key)
+	return nil
+}
+
+// recordingPublisher is an EventPublisher that records what it publishes.
+type recordingPublisher struct {
+	events []event.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, events []event.DomainEvent) error {
+	p.events = append(p.events, events...)
+	return nil
+}
+
+func (p *recordingPublisher) eventTypes() []string {
+	types := make([]string, len(p.events))
+	for i, e := range p.events {
+		types[i] = e.EventType()
+	}
+	return types
+}
@@ -0,0 +1,12 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[DocumentStored]("document.stored", 1, "Emitted when a document has been rendered and stored."),
+		events.Declare[DocumentPurged]("document.purged", 1, "Emitted when a document's content was deleted at the end of its retention period."),
+	}
+}
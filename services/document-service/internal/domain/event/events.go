@@ -0,0 +1,70 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// DomainEvent is an alias for the shared pkg/events.DomainEvent interface.
+type DomainEvent = events.DomainEvent
+
+// AggregateTypeDocument is the aggregate type of document events.
+const AggregateTypeDocument = "Document"
+
+// DocumentStored is emitted when a document has been rendered and stored.
+type DocumentStored struct {
+	events.BaseEvent
+	RetainUntil     time.Time `json:"retain_until"`
+	TemplateName    string    `json:"template_name"`
+	OwnerReference  string    `json:"owner_reference,omitempty"`
+	SHA256          string    `json:"sha256"`
+	CustomerID      uuid.UUID `json:"customer_id,omitempty"`
+	SizeBytes       int64     `json:"size_bytes"`
+	TemplateVersion int       `json:"template_version"`
+	PageCount       int       `json:"page_count"`
+}
+
+// NewDocumentStored creates a new DocumentStored event.
+func NewDocumentStored(
+	documentID, tenantID, customerID uuid.UUID,
+	templateName string,
+	templateVersion int,
+	ownerReference, sha256 string,
+	sizeBytes int64,
+	pageCount int,
+	retainUntil time.Time,
+) DocumentStored {
+	return DocumentStored{
+		BaseEvent:       events.NewBaseEvent("document.stored", documentID.String(), AggregateTypeDocument, tenantID.String()),
+		CustomerID:      customerID,
+		TemplateName:    templateName,
+		TemplateVersion: templateVersion,
+		OwnerReference:  ownerReference,
+		SHA256:          sha256,
+		SizeBytes:       sizeBytes,
+		PageCount:       pageCount,
+		RetainUntil:     retainUntil,
+	}
+}
+
+// DocumentPurged is emitted when a document's content was deleted at the
+// end of its retention period.
+type DocumentPurged struct {
+	events.BaseEvent
+	RetainUntil    time.Time `json:"retain_until"`
+	OwnerReference string    `json:"owner_reference,omitempty"`
+	CustomerID     uuid.UUID `json:"customer_id,omitempty"`
+}
+
+// NewDocumentPurged creates a new DocumentPurged event.
+func NewDocumentPurged(documentID, tenantID, customerID uuid.UUID, ownerReference string, retainUntil time.Time) DocumentPurged {
+	return DocumentPurged{
+		BaseEvent:      events.NewBaseEvent("document.purged", documentID.String(), AggregateTypeDocument, tenantID.String()),
+		CustomerID:     customerID,
+		OwnerReference: ownerReference,
+		RetainUntil:    retainUntil,
+	}
+}
@@ -0,0 +1,192 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/document-service/internal/domain/event"
+	"github.com/bibbank/bib/services/document-service/internal/domain/valueobject"
+)
+
+// ContentTypePDF is the content type of every rendered document.
+const ContentTypePDF = "application/pdf"
+
+// maxOwnerReferenceLength bounds a caller's owner reference.
+const maxOwnerReferenceLength = 128
+
+// ErrInvalidTransition is returned when a document is stored or purged out
+// of turn.
+var ErrInvalidTransition = errors.New("invalid document transition")
+
+// Document is the aggregate root for a PDF rendered from a template and kept
+// in object storage until its retention period ends. A new document has no
+// status until MarkStored records where its content was written; Purge
+// records that the content was deleted.
+type Document struct {
+	createdAt       time.Time
+	retainUntil     time.Time
+	purgedAt        time.Time
+	templateName    valueobject.TemplateName
+	status          valueobject.DocumentStatus
+	ownerReference  string
+	title           string
+	objectKey       string
+	sha256          string
+	domainEvents    []events.DomainEvent
+	sizeBytes       int64
+	templateVersion int
+	pageCount       int
+	version         int
+	id              uuid.UUID
+	tenantID        uuid.UUID
+	customerID      uuid.UUID
+}
+
+// NewDocument creates a document rendered from the template, kept for
+// retentionDays from now. customerID is uuid.Nil for documents about no
+// particular customer.
+func NewDocument(
+	template Template,
+	customerID uuid.UUID,
+	ownerReference string,
+	retentionDays int,
+	now time.Time,
+) (Document, error) {
+	if retentionDays <= 0 || retentionDays > MaxRetentionDays {
+		return Document{}, fmt.Errorf("retention must be between 1 and %d days", MaxRetentionDays)
+	}
+	if len(ownerReference) > maxOwnerReferenceLength {
+		return Document{}, fmt.Errorf("owner reference must not exceed %d characters", maxOwnerReferenceLength)
+	}
+	return Document{
+		id:              uuid.New(),
+		tenantID:        template.TenantID(),
+		customerID:      customerID,
+		templateName:    template.Name(),
+		templateVersion: template.Version(),
+		ownerReference:  ownerReference,
+		title:           template.Title(),
+		createdAt:       now,
+		retainUntil:     now.AddDate(0, 0, retentionDays),
+		version:         1,
+	}, nil
+}
+
+// ReconstructDocument recreates a Document from persisted data without
+// validation or events.
+func ReconstructDocument(
+	id, tenantID, customerID uuid.UUID,
+	templateName valueobject.TemplateName,
+	templateVersion int,
+	ownerReference, title string,
+	status valueobject.DocumentStatus,
+	objectKey, sha256 string,
+	sizeBytes int64,
+	pageCount int,
+	createdAt, retainUntil, purgedAt time.Time,
+	version int,
+) Document {
+	return Document{
+		id:              id,
+		tenantID:        tenantID,
+		customerID:      customerID,
+		templateName:    templateName,
+		templateVersion: templateVersion,
+		ownerReference:  ownerReference,
+		title:           title,
+		status:          status,
+		objectKey:       objectKey,
+		sha256:          sha256,
+		sizeBytes:       sizeBytes,
+		pageCount:       pageCount,
+		createdAt:       createdAt,
+		retainUntil:     retainUntil,
+		purgedAt:        purgedAt,
+		version:         version,
+	}
+}
+
+// MarkStored records that the document's content was stored under objectKey.
+func (d Document) MarkStored(objectKey, sha256 string, sizeBytes int64, pageCount int) (Document, error) {
+	if !d.status.IsZero() {
+		return d, fmt.Errorf("cannot store %s document %s: %w", d.status, d.id, ErrInvalidTransition)
+	}
+	if objectKey == "" {
+		return d, fmt.Errorf("object key must not be empty")
+	}
+	d.status = valueobject.DocumentStatusStored
+	d.objectKey = objectKey
+	d.sha256 = sha256
+	d.sizeBytes = sizeBytes
+	d.pageCount = pageCount
+	d.version++
+	d.domainEvents = append(d.domainEvents, event.NewDocumentStored(
+		d.id, d.tenantID, d.customerID, d.templateName.String(), d.templateVersion,
+		d.ownerReference, sha256, sizeBytes, pageCount, d.retainUntil,
+	))
+	return d, nil
+}
+
+// Expired reports whether the document's retention period has ended.
+func (d Document) Expired(now time.Time) bool {
+	return !now.Before(d.retainUntil)
+}
+
+// Purge records that the document's content was deleted at the end of its
+// retention period.
+func (d Document) Purge(now time.Time) (Document, error) {
+	if !d.status.Equal(valueobject.DocumentStatusStored) {
+		return d, fmt.Errorf("cannot purge %s document %s: %w", d.status, d.id, ErrInvalidTransition)
+	}
+	if !d.Expired(now) {
+		return d, fmt.Errorf("cannot purge document %s retained until %s: %w",
+			d.id, d.retainUntil.Format(time.RFC3339), ErrInvalidTransition)
+	}
+	d.status = valueobject.DocumentStatusPurged
+	d.purgedAt = now
+	d.version++
+	d.domainEvents = append(d.domainEvents, event.NewDocumentPurged(
+		d.id, d.tenantID, d.customerID, d.ownerReference, d.retainUntil,
+	))
+	return d, nil
+}
+
+// FileName returns the name the document is downloaded as.
+func (d Document) FileName() string {
+	return fmt.Sprintf("%s-%s.pdf", d.templateName, d.id)
+}
+
+// --- Accessors ---
+
+func (d Document) ID() uuid.UUID                          { return d.id }
+func (d Document) TenantID() uuid.UUID                    { return d.tenantID }
+func (d Document) CustomerID() uuid.UUID                  { return d.customerID }
+func (d Document) TemplateName() valueobject.TemplateName { return d.templateName }
+func (d Document) TemplateVersion() int                   { return d.templateVersion }
+func (d Document) OwnerReference() string                 { return d.ownerReference }
+func (d Document) Title() string                          { return d.title }
+func (d Document) Status() valueobject.DocumentStatus     { return d.status }
+func (d Document) ObjectKey() string                      { return d.objectKey }
+func (d Document) SHA256() string                         { return d.sha256 }
+func (d Document) SizeBytes() int64                       { return d.sizeBytes }
+func (d Document) PageCount() int                         { return d.pageCount }
+func (d Document) CreatedAt() time.Time                   { return d.createdAt }
+func (d Document) RetainUntil() time.Time                 { return d.retainUntil }
+func (d Document) PurgedAt() time.Time                    { return d.purgedAt }
+func (d Document) Version() int                           { return d.version }
+
+// DomainEvents returns the uncommitted domain events.
+func (d Document) DomainEvents() []events.DomainEvent {
+	return d.domainEvents
+}
+
+// ClearDomainEvents returns a copy of the document with no uncommitted
+// events.
+func (d Document) ClearDomainEvents() Document {
+	d.domainEvents = nil
+	return d
+}
@@ -0,0 +1,103 @@
+package model_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/document-service/internal/domain/model"
+	"github.com/bibbank/bib/services/document-service/internal/domain/valueobject"
+)
+
+var now = time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+func newTemplate(t *testing.T) model.Template {
+	t.Helper()
+	name, err := valueobject.NewTemplateName("cd-certificate")
+	require.NoError(t, err)
+	tmpl, err := model.NewTemplate(uuid.New(), name, "Certificate of deposit", "# {{.holder}}\n", 365, now)
+	require.NoError(t, err)
+	return tmpl
+}
+
+func TestNewTemplate_Validation(t *testing.T) {
+	tmpl := newTemplate(t)
+	assert.Equal(t, 1, tmpl.Version())
+	assert.Equal(t, "Certificate of deposit", tmpl.Title())
+
+	name := tmpl.Name()
+	_, err := model.NewTemplate(uuid.Nil, name, "Title", "body", 365, now)
+	assert.Error(t, err, "nil tenant")
+	_, err = model.NewTemplate(uuid.New(), name, " ", "body", 365, now)
+	assert.Error(t, err, "blank title")
+	_, err = model.NewTemplate(uuid.New(), name, "Title", "body", 0, now)
+	assert.Error(t, err, "no retention")
+	_, err = model.NewTemplate(uuid.New(), name, "Title", "body", model.MaxRetentionDays+1, now)
+	assert.Error(t, err, "retention too long")
+	_, err = model.NewTemplate(uuid.New(), name, "Title", "{{.holder", 365, now)
+	assert.Error(t, err, "unparsable body")
+
+	_, err = valueobject.NewTemplateName("CD Certificate")
+	assert.Error(t, err)
+}
+
+func TestTemplate_UpdateBumpsVersion(t *testing.T) {
+	tmpl := newTemplate(t)
+	updated, err := tmpl.Update("Certificate", "# {{.holder}}\n{{.amount}}\n", 730, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Version())
+	assert.Equal(t, 730, updated.RetentionDays())
+	assert.Equal(t, now, updated.CreatedAt())
+	assert.Equal(t, now.Add(time.Hour), updated.UpdatedAt())
+
+	_, err = tmpl.Update("Certificate", "{{end}}", 730, now)
+	assert.Error(t, err)
+}
+
+func TestDocument_Lifecycle(t *testing.T) {
+	tmpl := newTemplate(t)
+	customerID := uuid.New()
+	d, err := model.NewDocument(tmpl, customerID, "cd-42", 30, now)
+	require.NoError(t, err)
+	assert.True(t, d.Status().IsZero())
+	assert.Equal(t, tmpl.TenantID(), d.TenantID())
+	assert.Equal(t, 1, d.TemplateVersion())
+	assert.Equal(t, now.AddDate(0, 0, 30), d.RetainUntil())
+
+	stored, err := d.MarkStored("documents/key.pdf", "abc", 1024, 2)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.DocumentStatusStored, stored.Status())
+	assert.Equal(t, 2, stored.Version())
+	require.Len(t, stored.DomainEvents(), 1)
+	assert.Equal(t, "document.stored", stored.DomainEvents()[0].EventType())
+
+	_, err = stored.MarkStored("documents/other.pdf", "def", 1, 1)
+	assert.True(t, errors.Is(err, model.ErrInvalidTransition))
+
+	_, err = stored.Purge(now.AddDate(0, 0, 29))
+	assert.True(t, errors.Is(err, model.ErrInvalidTransition), "still retained")
+
+	expiry := now.AddDate(0, 0, 30)
+	assert.True(t, stored.Expired(expiry))
+	purged, err := stored.ClearDomainEvents().Purge(expiry)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.DocumentStatusPurged, purged.Status())
+	assert.Equal(t, expiry, purged.PurgedAt())
+	require.Len(t, purged.DomainEvents(), 1)
+	assert.Equal(t, "document.purged", purged.DomainEvents()[0].EventType())
+
+	_, err = purged.Purge(expiry)
+	assert.True(t, errors.Is(err, model.ErrInvalidTransition))
+}
+
+func TestNewDocument_Validation(t *testing.T) {
+	tmpl := newTemplate(t)
+	_, err := model.NewDocument(tmpl, uuid.Nil, "", 0, now)
+	assert.Error(t, err)
+	_, err = model.NewDocument(tmpl, uuid.Nil, string(make([]byte, 129)), 30, now)
+	assert.Error(t, err)
+}
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/document-service/internal/domain/service"
+	"github.com/bibbank/bib/services/document-service/internal/domain/valueobject"
+)
+
+// MaxRetentionDays bounds how long a document may be kept: ten years, the
+// longest record-keeping period the bank is subject to.
+const MaxRetentionDays = 3660
+
+// Template is the aggregate root for one of a tenant's document templates.
+// Changing a template increments its version; documents record the version
+// they were rendered from.
+type Template struct {
+	createdAt     time.Time
+	updatedAt     time.Time
+	name          valueobject.TemplateName
+	title         string
+	body          string
+	retentionDays int
+	version       int
+	id            uuid.UUID
+	tenantID      uuid.UUID
+}
+
+// NewTemplate creates a template, checking its body parses.
+func NewTemplate(tenantID uuid.UUID, name valueobject.TemplateName, title, body string, retentionDays int, now time.Time) (Template, error) {
+	if tenantID == uuid.Nil {
+		return Template{}, fmt.Errorf("tenant ID must not be nil")
+	}
+	if name.IsZero() {
+		return Template{}, fmt.Errorf("template name must not be empty")
+	}
+	t := Template{
+		id:        uuid.New(),
+		tenantID:  tenantID,
+		name:      name,
+		createdAt: now,
+	}
+	return t.apply(title, body, retentionDays, now)
+}
+
+// ReconstructTemplate recreates a Template from persisted data without
+// validation.
+func ReconstructTemplate(
+	id, tenantID uuid.UUID,
+	name valueobject.TemplateName,
+	title, body string,
+	retentionDays, version int,
+	createdAt, updatedAt time.Time,
+) Template {
+	return Template{
+		id:            id,
+		tenantID:      tenantID,
+		name:          name,
+		title:         title,
+		body:          body,
+		retentionDays: retentionDays,
+		version:       version,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
+
+// Update replaces the template's title, body and retention, checking the
+// body parses.
+func (t Template) Update(title, body string, retentionDays int, now time.Time) (Template, error) {
+	return t.apply(title, body, retentionDays, now)
+}
+
+func (t Template) apply(title, body string, retentionDays int, now time.Time) (Template, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return t, fmt.Errorf("template title must not be empty")
+	}
+	if retentionDays <= 0 || retentionDays > MaxRetentionDays {
+		return t, fmt.Errorf("retention must be between 1 and %d days", MaxRetentionDays)
+	}
+	if _, err := service.ParseTemplate(t.name.String(), body); err != nil {
+		return t, err
+	}
+	t.title = title
+	t.body = body
+	t.retentionDays = retentionDays
+	t.updatedAt = now
+	t.version++
+	return t, nil
+}
+
+// Execute executes the template against data.
+func (t Template) Execute(data map[string]any) ([]service.Block, error) {
+	return service.ExecuteTemplate(t.name.String(), t.body, data)
+}
+
+// --- Accessors ---
+
+func (t Template) ID() uuid.UUID                  { return t.id }
+func (t Template) TenantID() uuid.UUID            { return t.tenantID }
+func (t Template) Name() valueobject.TemplateName { return t.name }
+func (t Template) Title() string                  { return t.title }
+func (t Template) Body() string                   { return t.body }
+func (t Template) RetentionDays() int             { return t.retentionDays }
+func (t Template) Version() int                   { return t.version }
+func (t Template) CreatedAt() time.Time           { return t.createdAt }
+func (t Template) UpdatedAt() time.Time           { return t.updatedAt }
@@ -0,0 +1,97 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/document-service/internal/domain/event"
+	"github.com/bibbank/bib/services/document-service/internal/domain/model"
+	"github.com/bibbank/bib/services/document-service/internal/domain/valueobject"
+)
+
+// ErrDocumentNotFound is returned when a document does not exist.
+var ErrDocumentNotFound = errors.New("document not found")
+
+// ErrTemplateNotFound is returned when a document template does not exist.
+var ErrTemplateNotFound = errors.New("document template not found")
+
+// ErrVersionConflict is returned when a document or template was modified
+// concurrently.
+var ErrVersionConflict = errors.New("modified concurrently")
+
+// DocumentFilter narrows a listing of a tenant's documents. Zero fields do
+// not filter.
+type DocumentFilter struct {
+	OwnerReference string
+	CustomerID     uuid.UUID
+}
+
+// DocumentRepository defines the persistence port for documents.
+type DocumentRepository interface {
+	// Save persists the document, failing with ErrVersionConflict if it was
+	// modified since it was loaded.
+	Save(ctx context.Context, document model.Document) error
+
+	// FindByID retrieves one of a tenant's documents.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Document, error)
+
+	// List returns a tenant's documents matching the filter, newest first,
+	// and the total number of them.
+	List(ctx context.Context, tenantID uuid.UUID, filter DocumentFilter, limit, offset int) ([]model.Document, int, error)
+
+	// ListExpired returns up to limit stored documents of every tenant whose
+	// retention period ended by now, longest expired first.
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]model.Document, error)
+}
+
+// TemplateRepository defines the persistence port for document templates.
+type TemplateRepository interface {
+	// Save persists the template, failing with ErrVersionConflict if it was
+	// modified since it was loaded or another template has its name.
+	Save(ctx context.Context, template model.Template) error
+
+	// FindByName retrieves one of a tenant's templates.
+	FindByName(ctx context.Context, tenantID uuid.UUID, name valueobject.TemplateName) (model.Template, error)
+
+	// List returns a tenant's templates by name and the total number of
+	// them.
+	List(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]model.Template, int, error)
+}
+
+// ErrObjectNotFound is returned when object storage has no object under a key.
+var ErrObjectNotFound = errors.New("object not found")
+
+// ObjectStore defines the port for the object storage document content is
+// kept in.
+type ObjectStore interface {
+	// Put writes data under key, replacing any object already there.
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get reads the object under key. It returns ErrObjectNotFound when
+	// there is none.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete removes the object under key. Deleting a missing object is not
+	// an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// ErrInvalidSignature is returned when a download URL's signature does not
+// match or the URL has expired.
+var ErrInvalidSignature = errors.New("invalid or expired download signature")
+
+// DownloadSigner defines the port for the signed URLs documents are
+// downloaded from without further authentication.
+type DownloadSigner interface {
+	// SignURL returns a URL that downloads the document until expiresAt.
+	SignURL(tenantID, documentID uuid.UUID, expiresAt time.Time) string
+	// Verify checks a download's signature, failing with ErrInvalidSignature
+	// when it does not match or expiresAt has passed.
+	Verify(tenantID, documentID uuid.UUID, expiresAt time.Time, signature string, now time.Time) error
+}
+
+// EventPublisher defines the port for publishing domain events.
+type EventPublisher interface {
+	Publish(ctx context.Context, events []event.DomainEvent) error
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	pdfTop    = 780
+	pdfBottom = 60
+	pdfLeft   = 56
+	// Characters of Helvetica that fit across the A4 text width, per font
+	// size; wider lines wrap.
+	pdfParagraphWidth  = 95
+	pdfHeadingWidth    = 55
+	pdfSubheadingWidth = 75
+)
+
+// pdfWriter lays text out top to bottom on A4 pages, starting a new page
+// when one is full.
+type pdfWriter struct {
+	pages []*strings.Builder
+	y     int
+}
+
+func (w *pdfWriter) newPage() {
+	w.pages = append(w.pages, &strings.Builder{})
+	w.y = pdfTop
+}
+
+// line reserves a line of the given height, starting a new page when it
+// would not fit.
+func (w *pdfWriter) line(height int) int {
+	if len(w.pages) == 0 || w.y-height < pdfBottom {
+		w.newPage()
+	}
+	y := w.y
+	w.y -= height
+	return y
+}
+
+func (w *pdfWriter) text(font string, size, x, y int, s string) {
+	page := w.pages[len(w.pages)-1]
+	page.WriteString(fmt.Sprintf("BT /%s %d Tf %d %d Td (%s) Tj ET\n", font, size, x, y, escapePDF(s)))
+}
+
+// RenderPDF lays the blocks out as an A4 PDF using the standard Helvetica
+// fonts, which every PDF reader provides, and returns it with its number of
+// pages. Each page is footed with the title and its page number.
+func RenderPDF(title string, blocks []Block) ([]byte, int) {
+	w := &pdfWriter{}
+	w.newPage()
+	for _, b := range blocks {
+		switch b.Kind {
+		case BlockPageBreak:
+			// Breaking an empty page would leave it blank.
+			if w.y < pdfTop {
+				w.newPage()
+			}
+		case BlockHeading:
+			for _, l := range wrap(b.Text, pdfHeadingWidth) {
+				w.text("F2", 16, pdfLeft, w.line(24), l)
+			}
+		case BlockSubheading:
+			for _, l := range wrap(b.Text, pdfSubheadingWidth) {
+				w.text("F2", 12, pdfLeft, w.line(18), l)
+			}
+		default:
+			if b.Text == "" {
+				w.line(14)
+				continue
+			}
+			for _, l := range wrap(b.Text, pdfParagraphWidth) {
+				w.text("F1", 10, pdfLeft, w.line(14), l)
+			}
+		}
+	}
+
+	for i, page := range w.pages {
+		page.WriteString(fmt.Sprintf("BT /F1 8 Tf %d 30 Td (%s) Tj ET\n", pdfLeft, escapePDF(title)))
+		page.WriteString(fmt.Sprintf("BT /F1 8 Tf 500 30 Td (%s) Tj ET\n",
+			escapePDF(fmt.Sprintf("Page %d of %d", i+1, len(w.pages)))))
+	}
+	return assemblePDF(title, w.pages), len(w.pages)
+}
+
+// assemblePDF writes the pages' content streams out as a PDF document.
+func assemblePDF(title string, pages []*strings.Builder) []byte {
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 6+2*i)
+	}
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold >>",
+		fmt.Sprintf("<< /Title (%s) /Producer (bib document-service) >>", escapePDF(title)),
+	}
+	for i, page := range pages {
+		stream := page.String()
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] /Contents %d 0 R /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> >>", 7+2*i),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(stream), stream),
+		)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		buf.WriteString(fmt.Sprintf("%d 0 obj\n%s\nendobj\n", i+1, obj))
+	}
+	xref := buf.Len()
+	buf.WriteString(fmt.Sprintf("xref\n0 %d\n0000000000 65535 f \n", len(objects)+1))
+	for _, off := range offsets {
+		buf.WriteString(fmt.Sprintf("%010d 00000 n \n", off))
+	}
+	buf.WriteString(fmt.Sprintf("trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref))
+	return buf.Bytes()
+}
+
+// escapePDF escapes the characters that delimit PDF string literals.
+func escapePDF(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`).Replace(s)
+}
+
+// wrap breaks s into lines of at most width runes, between words where it
+// can.
+func wrap(s string, width int) []string {
+	var lines []string
+	var line []rune
+	for _, word := range strings.Fields(s) {
+		r := []rune(word)
+		for len(r) > width {
+			if len(line) > 0 {
+				lines = append(lines, string(line))
+				line = nil
+			}
+			lines = append(lines, string(r[:width]))
+			r = r[width:]
+		}
+		switch {
+		case len(line) == 0:
+			line = r
+		case len(line)+1+len(r) <= width:
+			line = append(append(line, ' '), r...)
+		default:
+			lines = append(lines, string(line))
+			line = r
+		}
+	}
+	if len(line) > 0 {
+		lines = append(lines, string(line))
+	}
+	return lines
+}
@@ -0,0 +1,106 @@
+// Package service holds the document service's domain logic: executing
+// templates and laying their output out as PDF.
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// MaxTemplateBodyBytes bounds the size of a template body.
+const MaxTemplateBodyBytes = 64 << 10
+
+// BlockKind is the kind of a laid-out block of a document.
+type BlockKind int
+
+const (
+	// BlockParagraph is a line of body text, wrapped to the page width.
+	BlockParagraph BlockKind = iota
+	// BlockHeading is a "# " line.
+	BlockHeading
+	// BlockSubheading is a "## " line.
+	BlockSubheading
+	// BlockPageBreak is a "---" line.
+	BlockPageBreak
+)
+
+// Block is one block of an executed template.
+type Block struct {
+	Text string
+	Kind BlockKind
+}
+
+// templateFuncs are the functions templates may call besides the built-ins.
+var templateFuncs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	// date formats an RFC 3339 timestamp, as JSON data carries them, as
+	// YYYY-MM-DD; other values are returned unchanged.
+	"date": func(v any) string {
+		s := fmt.Sprint(v)
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			return t.UTC().Format(time.DateOnly)
+		}
+		return s
+	},
+	// default returns def when v is missing or empty.
+	"default": func(def, v any) any {
+		if v == nil || v == "" {
+			return def
+		}
+		return v
+	},
+}
+
+// ParseTemplate parses a template body, reporting syntax errors.
+func ParseTemplate(name, body string) (*template.Template, error) {
+	if len(body) > MaxTemplateBodyBytes {
+		return nil, fmt.Errorf("template body must not exceed %d bytes", MaxTemplateBodyBytes)
+	}
+	if strings.TrimSpace(body) == "" {
+		return nil, fmt.Errorf("template body must not be empty")
+	}
+	tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("parse template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// ExecuteTemplate executes a template body against data and splits the
+// output into blocks. Referencing data that is missing is an error, so a
+// caller's incomplete data does not silently render a blank field.
+func ExecuteTemplate(name, body string, data map[string]any) ([]Block, error) {
+	tmpl, err := ParseTemplate(name, body)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("execute template: %w", err)
+	}
+
+	var blocks []Block
+	for _, line := range strings.Split(buf.String(), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		switch {
+		case line == "---":
+			blocks = append(blocks, Block{Kind: BlockPageBreak})
+		case strings.HasPrefix(line, "## "):
+			blocks = append(blocks, Block{Kind: BlockSubheading, Text: strings.TrimSpace(line[3:])})
+		case strings.HasPrefix(line, "# "):
+			blocks = append(blocks, Block{Kind: BlockHeading, Text: strings.TrimSpace(line[2:])})
+		default:
+			blocks = append(blocks, Block{Kind: BlockParagraph, Text: line})
+		}
+	}
+	// Trailing blank lines, usually left by the template's final newline,
+	// would only add empty space or an empty page.
+	for len(blocks) > 0 && blocks[len(blocks)-1].Kind == BlockParagraph && blocks[len(blocks)-1].Text == "" {
+		blocks = blocks[:len(blocks)-1]
+	}
+	return blocks, nil
+}
@@ -0,0 +1,74 @@
+package service_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/document-service/internal/domain/service"
+)
+
+const certificateBody = `# Certificate of deposit
+## {{upper .holder}}
+Principal {{.principal}} {{.currency}}, maturing {{date .maturity}}.
+{{range .terms}}- {{.}}
+{{end}}---
+Branch: {{default "Head office" .branch}}
+`
+
+func TestExecuteTemplate_Blocks(t *testing.T) {
+	blocks, err := service.ExecuteTemplate("cd-certificate", certificateBody, map[string]any{
+		"holder":    "Ada Lovelace",
+		"principal": "10000.00",
+		"currency":  "USD",
+		"maturity":  "2027-03-01T00:00:00Z",
+		"terms":     []any{"Fixed rate", "No early withdrawal"},
+		"branch":    "",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []service.Block{
+		{Kind: service.BlockHeading, Text: "Certificate of deposit"},
+		{Kind: service.BlockSubheading, Text: "ADA LOVELACE"},
+		{Kind: service.BlockParagraph, Text: "Principal 10000.00 USD, maturing 2027-03-01."},
+		{Kind: service.BlockParagraph, Text: "- Fixed rate"},
+		{Kind: service.BlockParagraph, Text: "- No early withdrawal"},
+		{Kind: service.BlockPageBreak},
+		{Kind: service.BlockParagraph, Text: "Branch: Head office"},
+	}, blocks)
+}
+
+func TestExecuteTemplate_MissingDataIsAnError(t *testing.T) {
+	_, err := service.ExecuteTemplate("cd-certificate", certificateBody, map[string]any{"holder": "Ada"})
+	assert.Error(t, err)
+}
+
+func TestParseTemplate_Limits(t *testing.T) {
+	_, err := service.ParseTemplate("t", "  \n")
+	assert.Error(t, err)
+	_, err = service.ParseTemplate("t", strings.Repeat("x", service.MaxTemplateBodyBytes+1))
+	assert.Error(t, err)
+}
+
+func TestRenderPDF_WrapsAndPaginates(t *testing.T) {
+	blocks := []service.Block{
+		{Kind: service.BlockPageBreak}, // a leading break adds no blank page
+		{Kind: service.BlockHeading, Text: "Payoff letter (final)"},
+		{Kind: service.BlockParagraph, Text: strings.Repeat("word ", 40)},
+	}
+	for range 60 {
+		blocks = append(blocks, service.Block{Kind: service.BlockParagraph, Text: "line"})
+	}
+	blocks = append(blocks, service.Block{Kind: service.BlockPageBreak}, service.Block{Kind: service.BlockParagraph, Text: "last"})
+
+	content, pages := service.RenderPDF("Payoff letter", blocks)
+	assert.Equal(t, 3, pages)
+	assert.True(t, bytes.HasPrefix(content, []byte("%PDF-1.4")))
+	assert.Contains(t, string(content), "/Count 3")
+	assert.Contains(t, string(content), `(Payoff letter \(final\))`)
+	assert.Contains(t, string(content), "(Page 3 of 3)")
+	assert.Contains(t, string(content), "/Title (Payoff letter)")
+}
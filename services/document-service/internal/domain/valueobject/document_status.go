@@ -0,0 +1,50 @@
+package valueobject
+
+import "fmt"
+
+// DocumentStatus is the lifecycle state of a document: STORED while its
+// content is kept, PURGED once its retention period ended and the content
+// was deleted. It is an immutable value object.
+type DocumentStatus struct {
+	value string
+}
+
+const (
+	documentStatusStored = "STORED"
+	documentStatusPurged = "PURGED"
+)
+
+var (
+	DocumentStatusStored = DocumentStatus{value: documentStatusStored}
+	DocumentStatusPurged = DocumentStatus{value: documentStatusPurged}
+)
+
+var validDocumentStatuses = map[string]DocumentStatus{
+	documentStatusStored: DocumentStatusStored,
+	documentStatusPurged: DocumentStatusPurged,
+}
+
+// NewDocumentStatus creates a DocumentStatus from a string, validating it is
+// known.
+func NewDocumentStatus(s string) (DocumentStatus, error) {
+	v, ok := validDocumentStatuses[s]
+	if !ok {
+		return DocumentStatus{}, fmt.Errorf("invalid document status: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the DocumentStatus.
+func (v DocumentStatus) String() string {
+	return v.value
+}
+
+// IsZero returns true if the DocumentStatus has not been set.
+func (v DocumentStatus) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two DocumentStatus values are equal.
+func (v DocumentStatus) Equal(other DocumentStatus) bool {
+	return v.value == other.value
+}
@@ -0,0 +1,42 @@
+package valueobject
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// templateNamePattern allows lowercase names of letters, digits and inner
+// hyphens, such as "cd-certificate".
+var templateNamePattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+const maxTemplateNameLength = 64
+
+// TemplateName identifies a document template within a tenant.
+// It is an immutable value object.
+type TemplateName struct {
+	value string
+}
+
+// NewTemplateName creates a TemplateName from a string, validating its form.
+func NewTemplateName(s string) (TemplateName, error) {
+	if len(s) > maxTemplateNameLength || !templateNamePattern.MatchString(s) {
+		return TemplateName{}, fmt.Errorf("invalid template name %q: must be at most %d lowercase letters, digits and hyphens",
+			s, maxTemplateNameLength)
+	}
+	return TemplateName{value: s}, nil
+}
+
+// String returns the string representation of the TemplateName.
+func (v TemplateName) String() string {
+	return v.value
+}
+
+// IsZero returns true if the TemplateName has not been set.
+func (v TemplateName) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two TemplateName values are equal.
+func (v TemplateName) Equal(other TemplateName) bool {
+	return v.value == other.value
+}
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/bibbank/bib/pkg/archive"
+)
+
+type DatabaseConfig struct {
+	Host     string
+	User     string
+	Password string
+	Name     string
+	SSLMode  string
+	Port     int
+}
+
+type KafkaConfig struct {
+	Brokers []string
+}
+
+// DownloadConfig configures signed download URLs.
+type DownloadConfig struct {
+	// BaseURL is where the service's HTTP port is reachable by whoever
+	// downloads documents.
+	BaseURL string
+	// SigningKey signs download URLs. Every replica must share it.
+	SigningKey string
+}
+
+// RetentionConfig configures the purging of expired documents.
+type RetentionConfig struct {
+	// PurgeInterval is how often the leader purges expired documents.
+	PurgeInterval time.Duration
+}
+
+type Config struct {
+	DB          DatabaseConfig
+	ServiceName string
+	Kafka       KafkaConfig
+	// Storage is where document content is kept: the S3 bucket S3Bucket when it
+	// is set, and under Dir otherwise.
+	Storage   archive.StoreConfig
+	Download  DownloadConfig
+	Retention RetentionConfig
+	Outbox    OutboxConfig
+	GRPCPort  int
+	HTTPPort  int
+}
+
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+func Load() Config {
+	return Config{
+		GRPCPort: getEnvInt("GRPC_PORT", 9097),
+		HTTPPort: getEnvInt("HTTP_PORT", 8097),
+		DB: DatabaseConfig{
+			Host:     getEnv("DB_HOST", "localhost"),
+			Port:     getEnvInt("DB_PORT", 5432),
+			User:     getEnv("DB_USER", "bib"),
+			Password: getEnv("DB_PASSWORD", ""),
+			Name:     getEnv("DB_NAME", "bib_document"),
+			SSLMode:  getEnv("DB_SSLMODE", "require"),
+		},
+		Kafka: KafkaConfig{
+			Brokers: []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+		},
+		Storage: archive.StoreConfig{
+			Dir:         getEnv("DOCUMENT_STORAGE_DIR", "/var/lib/bib/documents"),
+			S3Endpoint:  getEnv("DOCUMENT_S3_ENDPOINT", "https://s3.amazonaws.com"),
+			S3Region:    getEnv("DOCUMENT_S3_REGION", "us-east-1"),
+			S3Bucket:    getEnv("DOCUMENT_S3_BUCKET", ""),
+			S3AccessKey: getEnv("DOCUMENT_S3_ACCESS_KEY", ""),
+			S3SecretKey: getEnv("DOCUMENT_S3_SECRET_KEY", ""),
+		},
+		Download: DownloadConfig{
+			BaseURL:    getEnv("DOCUMENT_DOWNLOAD_BASE_URL", "http://localhost:8097"),
+			SigningKey: getEnv("DOCUMENT_SIGNING_KEY", ""),
+		},
+		Retention: RetentionConfig{
+			PurgeInterval: getEnvDuration("DOCUMENT_PURGE_INTERVAL", time.Hour),
+		},
+		ServiceName: "document-service",
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
+	}
+}
+
+func (c Config) GRPCAddr() string {
+	return fmt.Sprintf(":%d", c.GRPCPort)
+}
+
+func (c Config) HTTPAddr() string {
+	return fmt.Sprintf(":%d", c.HTTPPort)
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) int {
+	if v := os.Getenv(key); v != "" {
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+	return fallback
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return fallback
+}
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
@@ -0,0 +1,56 @@
+// Package objectstore provides the ObjectStore adapter for rendered
+// documents.
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/bibbank/bib/pkg/archive"
+	"github.com/bibbank/bib/services/document-service/internal/domain/port"
+)
+
+// Compile-time interface check.
+var _ port.ObjectStore = (*Store)(nil)
+
+// Store implements port.ObjectStore on the platform's object storage: an S3
+// bucket, or a local directory for development and for deployments that
+// mount their storage.
+type Store struct {
+	store archive.Store
+}
+
+// New creates a Store keeping objects in store.
+func New(store archive.Store) *Store {
+	return &Store{store: store}
+}
+
+// Put writes data under key, replacing any existing object.
+func (s *Store) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	return s.store.Put(ctx, key, bytes.NewReader(data), contentType)
+}
+
+// Get reads the object under key.
+func (s *Store) Get(ctx context.Context, key string) ([]byte, error) {
+	obj, err := s.store.Get(ctx, key)
+	if errors.Is(err, archive.ErrNotFound) {
+		return nil, fmt.Errorf("%w: %s", port.ErrObjectNotFound, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+	return data, nil
+}
+
+// Delete removes the object under key.
+func (s *Store) Delete(ctx context.Context, key string) error {
+	return s.store.Delete(ctx, key)
+}
@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/document-service/internal/domain/model"
+	"github.com/bibbank/bib/services/document-service/internal/domain/port"
+	"github.com/bibbank/bib/services/document-service/internal/domain/valueobject"
+)
+
+const documentColumns = `
+	id, tenant_id, customer_id, template_name, template_version, owner_reference,
+	title, status, object_key, sha256, size_bytes, page_count, created_at,
+	retain_until, purged_at, version`
+
+// DocumentRepo is the PostgreSQL implementation of DocumentRepository.
+type DocumentRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewDocumentRepo creates a new DocumentRepo.
+func NewDocumentRepo(pool *pgxpool.Pool) *DocumentRepo {
+	return &DocumentRepo{pool: pool}
+}
+
+// Save persists the document with optimistic locking on its version.
+func (r *DocumentRepo) Save(ctx context.Context, d model.Document) error {
+	query := `
+		INSERT INTO documents (` + documentColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			purged_at = EXCLUDED.purged_at,
+			version = EXCLUDED.version
+		WHERE documents.version = EXCLUDED.version - 1
+	`
+
+	var customerID *uuid.UUID
+	if d.CustomerID() != uuid.Nil {
+		id := d.CustomerID()
+		customerID = &id
+	}
+	var purgedAt *time.Time
+	if !d.PurgedAt().IsZero() {
+		t := d.PurgedAt()
+		purgedAt = &t
+	}
+
+	result, err := r.pool.Exec(ctx, query,
+		d.ID(), d.TenantID(), customerID, d.TemplateName().String(), d.TemplateVersion(), d.OwnerReference(),
+		d.Title(), d.Status().String(), d.ObjectKey(), d.SHA256(), d.SizeBytes(), d.PageCount(), d.CreatedAt(),
+		d.RetainUntil(), purgedAt, d.Version(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert document: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: document %s", port.ErrVersionConflict, d.ID())
+	}
+	return nil
+}
+
+// FindByID retrieves one of a tenant's documents.
+func (r *DocumentRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Document, error) {
+	query := `SELECT ` + documentColumns + ` FROM documents WHERE id = $1 AND tenant_id = $2`
+
+	d, err := scanDocument(r.pool.QueryRow(ctx, query, id, tenantID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Document{}, fmt.Errorf("%w: %s", port.ErrDocumentNotFound, id)
+	}
+	if err != nil {
+		return model.Document{}, fmt.Errorf("failed to scan document: %w", err)
+	}
+	return d, nil
+}
+
+// List returns a tenant's documents matching the filter, newest first, and
+// the total number of them.
+func (r *DocumentRepo) List(ctx context.Context, tenantID uuid.UUID, filter port.DocumentFilter, limit, offset int) ([]model.Document, int, error) {
+	const where = ` WHERE tenant_id = $1
+		AND ($2 = '00000000-0000-0000-0000-000000000000'::uuid OR customer_id = $2)
+		AND ($3 = '' OR owner_reference = $3)`
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM documents`+where,
+		tenantID, filter.CustomerID, filter.OwnerReference).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count documents: %w", err)
+	}
+	documents, err := r.query(ctx, `SELECT `+documentColumns+` FROM documents`+where+`
+		ORDER BY created_at DESC
+		LIMIT $4 OFFSET $5
+	`, tenantID, filter.CustomerID, filter.OwnerReference, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return documents, total, nil
+}
+
+// ListExpired returns up to limit stored documents of every tenant whose
+// retention period ended by now, longest expired first.
+func (r *DocumentRepo) ListExpired(ctx context.Context, now time.Time, limit int) ([]model.Document, error) {
+	return r.query(ctx, `SELECT `+documentColumns+` FROM documents
+		WHERE status = 'STORED' AND retain_until <= $1
+		ORDER BY retain_until
+		LIMIT $2
+	`, now, limit)
+}
+
+func (r *DocumentRepo) query(ctx context.Context, query string, args ...any) ([]model.Document, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query documents: %w", err)
+	}
+	defer rows.Close()
+
+	var documents []model.Document
+	for rows.Next() {
+		d, err := scanDocument(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan document row: %w", err)
+		}
+		documents = append(documents, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return documents, nil
+}
+
+func scanDocument(row pgx.Row) (model.Document, error) {
+	var (
+		id, tenantID               uuid.UUID
+		customerID                 *uuid.UUID
+		templateNameStr, ownerRef  string
+		title, statusStr           string
+		objectKey, sha256          string
+		sizeBytes                  int64
+		templateVersion, pageCount int
+		createdAt, retainUntil     time.Time
+		purgedAt                   *time.Time
+		version                    int
+	)
+
+	err := row.Scan(&id, &tenantID, &customerID, &templateNameStr, &templateVersion, &ownerRef,
+		&title, &statusStr, &objectKey, &sha256, &sizeBytes, &pageCount, &createdAt,
+		&retainUntil, &purgedAt, &version)
+	if err != nil {
+		return model.Document{}, err
+	}
+
+	templateName, err := valueobject.NewTemplateName(templateNameStr)
+	if err != nil {
+		return model.Document{}, fmt.Errorf("invalid template name in database: %w", err)
+	}
+	status, err := valueobject.NewDocumentStatus(statusStr)
+	if err != nil {
+		return model.Document{}, fmt.Errorf("invalid status in database: %w", err)
+	}
+	var customer uuid.UUID
+	if customerID != nil {
+		customer = *customerID
+	}
+	var purged time.Time
+	if purgedAt != nil {
+		purged = purgedAt.UTC()
+	}
+
+	return model.ReconstructDocument(
+		id, tenantID, customer, templateName, templateVersion, ownerRef, title,
+		status, objectKey, sha256, sizeBytes, pageCount,
+		createdAt.UTC(), retainUntil.UTC(), purged, version,
+	), nil
+}
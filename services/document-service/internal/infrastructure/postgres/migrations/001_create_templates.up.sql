@@ -0,0 +1,15 @@
+-- Document templates lay out the documents rendered from them. Names are
+-- unique within a tenant; each change increments the version.
+CREATE TABLE IF NOT EXISTS document_templates (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    title TEXT NOT NULL,
+    body TEXT NOT NULL,
+    retention_days INT NOT NULL,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_document_templates_name ON document_templates(tenant_id, name);
@@ -0,0 +1,25 @@
+-- Documents are rendered PDFs. Their content is kept in object storage under
+-- object_key until retain_until, when it is purged.
+CREATE TABLE IF NOT EXISTS documents (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    customer_id UUID,
+    template_name VARCHAR(64) NOT NULL,
+    template_version INT NOT NULL,
+    owner_reference VARCHAR(128) NOT NULL DEFAULT '',
+    title TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    object_key TEXT NOT NULL,
+    sha256 VARCHAR(64) NOT NULL,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    page_count INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL,
+    retain_until TIMESTAMPTZ NOT NULL,
+    purged_at TIMESTAMPTZ,
+    version INT NOT NULL DEFAULT 1
+);
+
+CREATE INDEX idx_documents_tenant ON documents(tenant_id, created_at DESC);
+CREATE INDEX idx_documents_customer ON documents(tenant_id, customer_id, created_at DESC);
+CREATE INDEX idx_documents_owner ON documents(tenant_id, owner_reference) WHERE owner_reference <> '';
+CREATE INDEX idx_documents_expiry ON documents(retain_until) WHERE status = 'STORED';
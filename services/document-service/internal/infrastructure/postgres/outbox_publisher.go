@@ -0,0 +1,25 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/bibbank/bib/pkg/outbox"
+	"github.com/bibbank/bib/services/document-service/internal/domain/event"
+)
+
+// OutboxPublisher implements port.EventPublisher by writing events to the
+// outbox, from which the relay publishes them to topic.
+type OutboxPublisher struct {
+	publisher *outbox.Publisher
+	topic     string
+}
+
+// NewOutboxPublisher creates a new OutboxPublisher.
+func NewOutboxPublisher(publisher *outbox.Publisher, topic string) *OutboxPublisher {
+	return &OutboxPublisher{publisher: publisher, topic: topic}
+}
+
+// Publish writes the events to the outbox.
+func (p *OutboxPublisher) Publish(ctx context.Context, events []event.DomainEvent) error {
+	return p.publisher.Publish(ctx, p.topic, events...)
+}
@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/document-service/internal/domain/model"
+	"github.com/bibbank/bib/services/document-service/internal/domain/port"
+	"github.com/bibbank/bib/services/document-service/internal/domain/valueobject"
+)
+
+const templateColumns = `
+	id, tenant_id, name, title, body, retention_days, version, created_at, updated_at`
+
+// uniqueViolation is the PostgreSQL error code for a unique constraint
+// violation.
+const uniqueViolation = "23505"
+
+// TemplateRepo is the PostgreSQL implementation of TemplateRepository.
+type TemplateRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewTemplateRepo creates a new TemplateRepo.
+func NewTemplateRepo(pool *pgxpool.Pool) *TemplateRepo {
+	return &TemplateRepo{pool: pool}
+}
+
+// Save persists the template with optimistic locking on its version. A
+// template created concurrently with the same name is a version conflict.
+func (r *TemplateRepo) Save(ctx context.Context, t model.Template) error {
+	query := `
+		INSERT INTO document_templates (` + templateColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (id) DO UPDATE SET
+			title = EXCLUDED.title,
+			body = EXCLUDED.body,
+			retention_days = EXCLUDED.retention_days,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE document_templates.version = EXCLUDED.version - 1
+	`
+
+	result, err := r.pool.Exec(ctx, query,
+		t.ID(), t.TenantID(), t.Name().String(), t.Title(), t.Body(), t.RetentionDays(),
+		t.Version(), t.CreatedAt(), t.UpdatedAt(),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: template %s", port.ErrVersionConflict, t.Name())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to upsert document template: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: template %s", port.ErrVersionConflict, t.ID())
+	}
+	return nil
+}
+
+// FindByName retrieves one of a tenant's templates.
+func (r *TemplateRepo) FindByName(ctx context.Context, tenantID uuid.UUID, name valueobject.TemplateName) (model.Template, error) {
+	query := `SELECT ` + templateColumns + ` FROM document_templates WHERE tenant_id = $1 AND name = $2`
+
+	t, err := scanTemplate(r.pool.QueryRow(ctx, query, tenantID, name.String()))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Template{}, fmt.Errorf("%w: %s", port.ErrTemplateNotFound, name)
+	}
+	if err != nil {
+		return model.Template{}, fmt.Errorf("failed to scan document template: %w", err)
+	}
+	return t, nil
+}
+
+// List returns a tenant's templates by name and the total number of them.
+func (r *TemplateRepo) List(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]model.Template, int, error) {
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM document_templates WHERE tenant_id = $1`, tenantID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count document templates: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, `SELECT `+templateColumns+` FROM document_templates
+		WHERE tenant_id = $1
+		ORDER BY name
+		LIMIT $2 OFFSET $3
+	`, tenantID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query document templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []model.Template
+	for rows.Next() {
+		t, err := scanTemplate(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan document template row: %w", err)
+		}
+		templates = append(templates, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("row iteration error: %w", err)
+	}
+	return templates, total, nil
+}
+
+func scanTemplate(row pgx.Row) (model.Template, error) {
+	var (
+		id, tenantID           uuid.UUID
+		nameStr, title, body   string
+		retentionDays, version int
+		createdAt, updatedAt   time.Time
+	)
+
+	err := row.Scan(&id, &tenantID, &nameStr, &title, &body, &retentionDays, &version, &createdAt, &updatedAt)
+	if err != nil {
+		return model.Template{}, err
+	}
+
+	name, err := valueobject.NewTemplateName(nameStr)
+	if err != nil {
+		return model.Template{}, fmt.Errorf("invalid template name in database: %w", err)
+	}
+
+	return model.ReconstructTemplate(id, tenantID, name, title, body, retentionDays, version, createdAt, updatedAt), nil
+}
@@ -0,0 +1,52 @@
+package signing_test
+
+import (
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/document-service/internal/domain/port"
+	"github.com/bibbank/bib/services/document-service/internal/infrastructure/signing"
+)
+
+func TestHMACSigner_RoundTrip(t *testing.T) {
+	signer := signing.NewHMACSigner("https://docs.example.com/", []byte("key"))
+	tenantID, documentID := uuid.New(), uuid.New()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	expires := now.Add(15 * time.Minute)
+
+	raw := signer.SignURL(tenantID, documentID, expires)
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+	assert.Equal(t, "docs.example.com", u.Host)
+	assert.Equal(t, "/v1/documents/"+documentID.String()+"/content", u.Path)
+	assert.Equal(t, tenantID.String(), u.Query().Get("tenant"))
+	unix, err := strconv.ParseInt(u.Query().Get("expires"), 10, 64)
+	require.NoError(t, err)
+	sig := u.Query().Get("signature")
+
+	require.NoError(t, signer.Verify(tenantID, documentID, time.Unix(unix, 0), sig, now))
+
+	t.Run("expired", func(t *testing.T) {
+		err := signer.Verify(tenantID, documentID, expires, sig, expires)
+		assert.ErrorIs(t, err, port.ErrInvalidSignature)
+	})
+	t.Run("other document", func(t *testing.T) {
+		err := signer.Verify(tenantID, uuid.New(), expires, sig, now)
+		assert.ErrorIs(t, err, port.ErrInvalidSignature)
+	})
+	t.Run("extended expiry", func(t *testing.T) {
+		err := signer.Verify(tenantID, documentID, expires.Add(time.Hour), sig, now)
+		assert.ErrorIs(t, err, port.ErrInvalidSignature)
+	})
+	t.Run("other key", func(t *testing.T) {
+		other := signing.NewHMACSigner("https://docs.example.com", []byte("other"))
+		err := other.Verify(tenantID, documentID, expires, sig, now)
+		assert.ErrorIs(t, err, port.ErrInvalidSignature)
+	})
+}
@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	documentv1 "github.com/bibbank/bib/api/gen/go/bib/document/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/document-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/document-service/internal/domain/model"
+	"github.com/bibbank/bib/services/document-service/internal/domain/port"
+	"github.com/bibbank/bib/services/document-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractDocumentRepo holds at most one document.
+type contractDocumentRepo struct {
+	document *model.Document
+}
+
+func (r *contractDocumentRepo) Save(context.Context, model.Document) error { return nil }
+
+func (r *contractDocumentRepo) FindByID(context.Context, uuid.UUID, uuid.UUID) (model.Document, error) {
+	if r.document == nil {
+		return model.Document{}, port.ErrDocumentNotFound
+	}
+	return *r.document, nil
+}
+
+func (r *contractDocumentRepo) List(context.Context, uuid.UUID, port.DocumentFilter, int, int) ([]model.Document, int, error) {
+	return nil, 0, nil
+}
+
+func (r *contractDocumentRepo) ListExpired(context.Context, time.Time, int) ([]model.Document, error) {
+	return nil, nil
+}
+
+// contractSigner signs every URL with the same placeholder signature.
+type contractSigner struct{}
+
+func (contractSigner) SignURL(_, documentID uuid.UUID, _ time.Time) string {
+	return "https://documents.example/download/" + documentID.String() + "?sig=contract"
+}
+
+func (contractSigner) Verify(uuid.UUID, uuid.UUID, time.Time, string, time.Time) error { return nil }
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	documents := &contractDocumentRepo{}
+	h := NewHandler(
+		nil, nil, nil, nil,
+		usecase.NewGetDocumentUseCase(documents), usecase.NewListDocumentsUseCase(documents),
+		usecase.NewGetDownloadURLUseCase(documents, contractSigner{}), nil,
+		slog.Default(),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { documentv1.RegisterDocumentServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-document-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { documents.document = nil },
+		"a document was rendered": func(t *testing.T) {
+			name, err := valueobject.NewTemplateName("loan-agreement")
+			if err != nil {
+				t.Fatal(err)
+			}
+			createdAt := time.Date(2026, 2, 1, 2, 0, 0, 0, time.UTC)
+			doc := model.ReconstructDocument(uuid.New(), contractTenantID, uuid.New(), name, 1,
+				"", "Loan agreement", valueobject.DocumentStatusStored, "documents/contract.pdf", "e3b0c442",
+				2048, 2, createdAt, createdAt.AddDate(7, 0, 0), time.Time{}, 1)
+			documents.document = &doc
+		},
+	})
+}
@@ -0,0 +1,427 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	documentv1 "github.com/bibbank/bib/api/gen/go/bib/document/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/document-service/internal/application/dto"
+	"github.com/bibbank/bib/services/document-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/document-service/internal/domain/port"
+)
+
+// staffRoles may act on any document of their tenant; a caller with only the
+// customer role is limited to documents about them. readerRoles may read
+// documents.
+var (
+	staffRoles  = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleAPIClient}
+	readerRoles = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleAPIClient, auth.RoleCustomer}
+)
+
+var statusToProto = map[string]documentv1.DocumentStatus{
+	"STORED": documentv1.DocumentStatus_DOCUMENT_STATUS_STORED,
+	"PURGED": documentv1.DocumentStatus_DOCUMENT_STATUS_PURGED,
+}
+
+// requireRole checks that the caller has at least one of the given roles.
+func requireRole(ctx context.Context, roles ...string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "insufficient permissions")
+}
+
+// tenantIDFromContext extracts the tenant ID from JWT claims in the context.
+func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return claims.TenantID, nil
+}
+
+// ownCustomerID returns the customer a customer-only caller is limited to, or
+// uuid.Nil for staff callers.
+func ownCustomerID(ctx context.Context) uuid.UUID {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil
+	}
+	for _, role := range staffRoles {
+		if claims.HasRole(role) {
+			return uuid.Nil
+		}
+	}
+	return claims.UserID
+}
+
+// optionalUUID parses an optional ID, returning uuid.Nil when it is empty.
+func optionalUUID(raw, field string) (uuid.UUID, error) {
+	if raw == "" {
+		return uuid.Nil, nil
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid %s", field)
+	}
+	return id, nil
+}
+
+// Compile-time assertion that Handler implements DocumentServiceServer.
+var _ documentv1.DocumentServiceServer = (*Handler)(nil)
+
+// Handler implements the DocumentServiceServer gRPC interface.
+type Handler struct {
+	documentv1.UnimplementedDocumentServiceServer
+	upsertTemplate *usecase.UpsertTemplateUseCase
+	getTemplate    *usecase.GetTemplateUseCase
+	listTemplates  *usecase.ListTemplatesUseCase
+	render         *usecase.RenderDocumentUseCase
+	get            *usecase.GetDocumentUseCase
+	list           *usecase.ListDocumentsUseCase
+	downloadURL    *usecase.GetDownloadURLUseCase
+	purge          *usecase.PurgeExpiredDocumentsUseCase
+	logger         *slog.Logger
+}
+
+// NewHandler creates a new gRPC Handler.
+func NewHandler(
+	upsertTemplate *usecase.UpsertTemplateUseCase,
+	getTemplate *usecase.GetTemplateUseCase,
+	listTemplates *usecase.ListTemplatesUseCase,
+	render *usecase.RenderDocumentUseCase,
+	get *usecase.GetDocumentUseCase,
+	list *usecase.ListDocumentsUseCase,
+	downloadURL *usecase.GetDownloadURLUseCase,
+	purge *usecase.PurgeExpiredDocumentsUseCase,
+	logger *slog.Logger,
+) *Handler {
+	return &Handler{
+		upsertTemplate: upsertTemplate,
+		getTemplate:    getTemplate,
+		listTemplates:  listTemplates,
+		render:         render,
+		get:            get,
+		list:           list,
+		downloadURL:    downloadURL,
+		purge:          purge,
+		logger:         logger,
+	}
+}
+
+// UpsertTemplate creates or changes one of the tenant's document templates.
+func (h *Handler) UpsertTemplate(ctx context.Context, req *documentv1.UpsertTemplateRequest) (*documentv1.UpsertTemplateResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.upsertTemplate.Execute(ctx, dto.UpsertTemplateRequest{
+		TenantID:      tenantID,
+		Name:          req.Name,
+		Title:         req.Title,
+		Body:          req.Body,
+		RetentionDays: int(req.RetentionDays),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &documentv1.UpsertTemplateResponse{Template: toTemplateMsg(resp)}, nil
+}
+
+// GetTemplate retrieves one of the tenant's document templates.
+func (h *Handler) GetTemplate(ctx context.Context, req *documentv1.GetTemplateRequest) (*documentv1.GetTemplateResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.getTemplate.Execute(ctx, tenantID, req.Name)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &documentv1.GetTemplateResponse{Template: toTemplateMsg(resp)}, nil
+}
+
+// ListTemplates lists the tenant's document templates by name.
+func (h *Handler) ListTemplates(ctx context.Context, req *documentv1.ListTemplatesRequest) (*documentv1.ListTemplatesResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listTemplates.Execute(ctx, dto.ListTemplatesRequest{
+		TenantID: tenantID,
+		Limit:    int(req.PageSize),
+		Offset:   int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &documentv1.ListTemplatesResponse{
+		Templates:  make([]*documentv1.DocumentTemplate, 0, len(resp.Templates)),
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, t := range resp.Templates {
+		out.Templates = append(out.Templates, toTemplateMsg(t))
+	}
+	return out, nil
+}
+
+// RenderDocument renders a document from one of the tenant's templates and
+// stores it.
+func (h *Handler) RenderDocument(ctx context.Context, req *documentv1.RenderDocumentRequest) (*documentv1.RenderDocumentResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	var data map[string]any
+	if req.DataJson != "" {
+		if err := json.Unmarshal([]byte(req.DataJson), &data); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "data_json must be a JSON object")
+		}
+	}
+	customerID, err := optionalUUID(req.CustomerId, "customer ID")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.render.Execute(ctx, dto.RenderDocumentRequest{
+		TenantID:       tenantID,
+		TemplateName:   req.TemplateName,
+		Data:           data,
+		CustomerID:     customerID,
+		OwnerReference: req.OwnerReference,
+		RetentionDays:  int(req.RetentionDays),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &documentv1.RenderDocumentResponse{Document: toDocumentMsg(resp)}, nil
+}
+
+// GetDocument retrieves a document.
+func (h *Handler) GetDocument(ctx context.Context, req *documentv1.GetDocumentRequest) (*documentv1.GetDocumentResponse, error) {
+	if err := requireRole(ctx, readerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	documentID, err := uuid.Parse(req.DocumentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid document ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.get.Execute(ctx, tenantID, documentID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	if own := ownCustomerID(ctx); own != uuid.Nil && own != resp.CustomerID {
+		return nil, status.Error(codes.NotFound, "document not found")
+	}
+	return &documentv1.GetDocumentResponse{Document: toDocumentMsg(resp)}, nil
+}
+
+// ListDocuments lists the tenant's documents, newest first. A customer-only
+// caller sees only documents about them.
+func (h *Handler) ListDocuments(ctx context.Context, req *documentv1.ListDocumentsRequest) (*documentv1.ListDocumentsResponse, error) {
+	if err := requireRole(ctx, readerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	customerID, err := optionalUUID(req.CustomerId, "customer ID")
+	if err != nil {
+		return nil, err
+	}
+	if own := ownCustomerID(ctx); own != uuid.Nil {
+		if customerID != uuid.Nil && customerID != own {
+			return nil, status.Error(codes.PermissionDenied, "customers may only access their own documents")
+		}
+		customerID = own
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.list.Execute(ctx, dto.ListDocumentsRequest{
+		TenantID:       tenantID,
+		CustomerID:     customerID,
+		OwnerReference: req.OwnerReference,
+		Limit:          int(req.PageSize),
+		Offset:         int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &documentv1.ListDocumentsResponse{
+		Documents:  make([]*documentv1.Document, 0, len(resp.Documents)),
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, d := range resp.Documents {
+		out.Documents = append(out.Documents, toDocumentMsg(d))
+	}
+	return out, nil
+}
+
+// GetDownloadURL returns a signed URL that downloads a document without
+// further authentication until it expires.
+func (h *Handler) GetDownloadURL(ctx context.Context, req *documentv1.GetDownloadURLRequest) (*documentv1.GetDownloadURLResponse, error) {
+	if err := requireRole(ctx, readerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	documentID, err := uuid.Parse(req.DocumentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid document ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.downloadURL.Execute(ctx, dto.DownloadURLRequest{
+		TenantID:   tenantID,
+		DocumentID: documentID,
+		CustomerID: ownCustomerID(ctx),
+		TTL:        time.Duration(req.TtlSeconds) * time.Second,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &documentv1.GetDownloadURLResponse{
+		Url:       resp.URL,
+		ExpiresAt: timestamppb.New(resp.ExpiresAt),
+	}, nil
+}
+
+// PurgeExpiredDocuments deletes the content of every document whose
+// retention period has ended.
+func (h *Handler) PurgeExpiredDocuments(ctx context.Context, _ *documentv1.PurgeExpiredDocumentsRequest) (*documentv1.PurgeExpiredDocumentsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	result, err := h.purge.Execute(ctx, time.Now().UTC())
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	h.logger.Info("PurgeExpiredDocuments completed", "purged", result.Purged, "failed", result.Failed)
+	return &documentv1.PurgeExpiredDocumentsResponse{
+		Purged: int32(result.Purged), //nolint:gosec // bounded by document count
+		Failed: int32(result.Failed), //nolint:gosec // bounded by document count
+	}, nil
+}
+
+func (h *Handler) toStatus(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidTemplate), errors.Is(err, usecase.ErrInvalidDocument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrDocumentPurged):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, port.ErrDocumentNotFound):
+		return status.Error(codes.NotFound, "document not found")
+	case errors.Is(err, port.ErrTemplateNotFound):
+		return status.Error(codes.NotFound, "document template not found")
+	case errors.Is(err, port.ErrVersionConflict):
+		return status.Error(codes.Aborted, "modified concurrently, retry")
+	default:
+		h.logger.Error("handler error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func toTemplateMsg(t dto.TemplateResponse) *documentv1.DocumentTemplate {
+	return &documentv1.DocumentTemplate{
+		TemplateId:    t.ID.String(),
+		TenantId:      t.TenantID.String(),
+		Name:          t.Name,
+		Title:         t.Title,
+		Body:          t.Body,
+		RetentionDays: int32(t.RetentionDays), //nolint:gosec // bounded by MaxRetentionDays
+		Version:       int32(t.Version),       //nolint:gosec // version counter
+		CreatedAt:     timestamppb.New(t.CreatedAt),
+		UpdatedAt:     timestamppb.New(t.UpdatedAt),
+	}
+}
+
+func toDocumentMsg(d dto.DocumentResponse) *documentv1.Document {
+	msg := &documentv1.Document{
+		DocumentId:      d.ID.String(),
+		TenantId:        d.TenantID.String(),
+		TemplateName:    d.TemplateName,
+		TemplateVersion: int32(d.TemplateVersion), //nolint:gosec // version counter
+		OwnerReference:  d.OwnerReference,
+		Title:           d.Title,
+		Status:          statusToProto[d.Status],
+		ContentType:     d.ContentType,
+		SizeBytes:       d.SizeBytes,
+		Sha256:          d.SHA256,
+		PageCount:       int32(d.PageCount), //nolint:gosec // bounded by document size
+		CreatedAt:       timestamppb.New(d.CreatedAt),
+		RetainUntil:     timestamppb.New(d.RetainUntil),
+		Version:         int32(d.Version), //nolint:gosec // version counter
+	}
+	if d.CustomerID != uuid.Nil {
+		msg.CustomerId = d.CustomerID.String()
+	}
+	if !d.PurgedAt.IsZero() {
+		msg.PurgedAt = timestamppb.New(d.PurgedAt)
+	}
+	return msg
+}
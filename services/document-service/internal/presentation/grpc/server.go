@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"log/slog"
+
+	"google.golang.org/grpc"
+
+	documentv1 "github.com/bibbank/bib/api/gen/go/bib/document/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/grpcserver"
+)
+
+// Server wraps the gRPC server for document-service.
+type Server struct {
+	server  *grpcserver.Server
+	handler *Handler
+}
+
+// NewServer creates a new gRPC server with the given handler.
+func NewServer(handler *Handler, logger *slog.Logger, jwtService *auth.JWTService) (*Server, error) {
+	srv, err := grpcserver.New(grpcserver.Config{
+		ServiceName: "document-service",
+		JWTService:  jwtService,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+	documentv1.RegisterDocumentServiceServer(srv, handler)
+
+	return &Server{
+		server:  srv,
+		handler: handler,
+	}, nil
+}
+
+// Start begins listening on the specified address.
+func (s *Server) Start(addr string) error {
+	return s.server.ListenAndServe(addr)
+}
+
+// Stop gracefully stops the gRPC server.
+func (s *Server) Stop() {
+	s.server.Stop()
+}
+
+// RegisterService registers another service on the server, such as the
+// outbox administration service.
+func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	s.server.RegisterService(desc, impl)
+}
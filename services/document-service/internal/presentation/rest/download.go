@@ -0,0 +1,76 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/document-service/internal/application/dto"
+	"github.com/bibbank/bib/services/document-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/document-service/internal/domain/port"
+)
+
+// DownloadHandler serves documents through the signed URLs GetDownloadURL
+// issues. The signature stands in for authentication, so the route needs no
+// token.
+type DownloadHandler struct {
+	download *usecase.DownloadDocumentUseCase
+	logger   *slog.Logger
+}
+
+// NewDownloadHandler creates a new DownloadHandler.
+func NewDownloadHandler(download *usecase.DownloadDocumentUseCase, logger *slog.Logger) *DownloadHandler {
+	return &DownloadHandler{download: download, logger: logger}
+}
+
+// RegisterRoutes registers the download route on the given mux.
+func (h *DownloadHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/documents/{id}/content", h.Download)
+}
+
+// Download handles GET /v1/documents/{id}/content?tenant=&expires=&signature=.
+func (h *DownloadHandler) Download(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	documentID, idErr := uuid.Parse(r.PathValue("id"))
+	tenantID, tenantErr := uuid.Parse(q.Get("tenant"))
+	expires, expiresErr := strconv.ParseInt(q.Get("expires"), 10, 64)
+	if idErr != nil || tenantErr != nil || expiresErr != nil || q.Get("signature") == "" {
+		http.Error(w, "malformed download link", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.download.Execute(r.Context(), dto.DownloadRequest{
+		TenantID:   tenantID,
+		DocumentID: documentID,
+		ExpiresAt:  time.Unix(expires, 0),
+		Signature:  q.Get("signature"),
+	})
+	switch {
+	case errors.Is(err, port.ErrInvalidSignature):
+		http.Error(w, "download link is invalid or has expired", http.StatusForbidden)
+		return
+	case errors.Is(err, port.ErrDocumentNotFound):
+		http.Error(w, "document not found", http.StatusNotFound)
+		return
+	case errors.Is(err, usecase.ErrDocumentPurged):
+		http.Error(w, "document is no longer retained", http.StatusGone)
+		return
+	case err != nil:
+		h.logger.Error("document download failed", "document_id", documentID, "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", resp.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(resp.Content)))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.FileName))
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(resp.Content) //nolint:errcheck // client disconnects are not actionable
+}
@@ -0,0 +1,88 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/document-service/internal/domain/model"
+	"github.com/bibbank/bib/services/document-service/internal/domain/port"
+	"github.com/bibbank/bib/services/document-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/document-service/internal/infrastructure/postgres"
+)
+
+func newTemplate(t *testing.T, tenantID uuid.UUID, retentionDays int) model.Template {
+	t.Helper()
+	name, err := valueobject.NewTemplateName("welcome-letter")
+	require.NoError(t, err)
+	tmpl, err := model.NewTemplate(tenantID, name, "Welcome", "Dear {{.name}}", retentionDays, time.Now().UTC())
+	require.NoError(t, err)
+	return tmpl
+}
+
+func TestTemplateRepo_NameIsUniquePerTenant(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewTemplateRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+
+	tmpl := newTemplate(t, tenantID, 365)
+	require.NoError(t, repo.Save(ctx, tmpl))
+	require.NoError(t, repo.Save(ctx, newTemplate(t, uuid.New(), 365)))
+
+	assert.ErrorIs(t, repo.Save(ctx, newTemplate(t, tenantID, 30)), port.ErrVersionConflict,
+		"a second template with the same name conflicts")
+
+	found, err := repo.FindByName(ctx, tenantID, tmpl.Name())
+	require.NoError(t, err)
+	assert.Equal(t, tmpl.ID(), found.ID())
+	assert.Equal(t, "Dear {{.name}}", found.Body())
+	assert.Equal(t, 365, found.RetentionDays())
+}
+
+func TestDocumentRepo_ListExpiredReturnsDocumentsPastRetention(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewDocumentRepo(env.Pool())
+	ctx := context.Background()
+	tenantID, customerID := uuid.New(), uuid.New()
+	tmpl := newTemplate(t, tenantID, 30)
+	created := time.Now().UTC().AddDate(0, 0, -60).Truncate(time.Microsecond)
+
+	expired, err := model.NewDocument(tmpl, customerID, "account:BIB-0001", 30, created)
+	require.NoError(t, err)
+	stored, err := expired.MarkStored("documents/"+expired.ID().String()+".pdf", "abc123", 2048, 1)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, stored))
+
+	// Saving the stale copy again conflicts on the version.
+	assert.ErrorIs(t, repo.Save(ctx, stored), port.ErrVersionConflict)
+
+	retained, err := model.NewDocument(tmpl, customerID, "", 365, created)
+	require.NoError(t, err)
+	retained, err = retained.MarkStored("documents/retained.pdf", "def456", 1024, 1)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, retained))
+
+	due, err := repo.ListExpired(ctx, time.Now().UTC(), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, expired.ID(), due[0].ID())
+	assert.Equal(t, valueobject.DocumentStatusStored, due[0].Status())
+	assert.Equal(t, int64(2048), due[0].SizeBytes())
+
+	docs, total, err := repo.List(ctx, tenantID, port.DocumentFilter{OwnerReference: "account:BIB-0001"}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, docs, 1)
+	assert.Equal(t, customerID, docs[0].CustomerID())
+
+	_, err = repo.FindByID(ctx, uuid.New(), expired.ID())
+	assert.ErrorIs(t, err, port.ErrDocumentNotFound, "documents are scoped to their tenant")
+}
@@ -10,16 +10,23 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/bibbank/bib/pkg/archive"
 	"github.com/bibbank/bib/pkg/auth"
 	pkgkafka "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
+	"github.com/bibbank/bib/pkg/lock"
 	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
 	pkgpostgres "github.com/bibbank/bib/pkg/postgres"
 	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
 	"github.com/bibbank/bib/services/fraud-service/internal/domain/service"
 	"github.com/bibbank/bib/services/fraud-service/internal/infrastructure/config"
-	"github.com/bibbank/bib/services/fraud-service/internal/infrastructure/kafka"
+	"github.com/bibbank/bib/services/fraud-service/internal/infrastructure/ipintel"
 	"github.com/bibbank/bib/services/fraud-service/internal/infrastructure/ml"
+	"github.com/bibbank/bib/services/fraud-service/internal/infrastructure/objectstore"
 	"github.com/bibbank/bib/services/fraud-service/internal/infrastructure/postgres"
+	"github.com/bibbank/bib/services/fraud-service/internal/infrastructure/watchlist"
 	grpcpresentation "github.com/bibbank/bib/services/fraud-service/internal/presentation/grpc"
 	"github.com/bibbank/bib/services/fraud-service/internal/presentation/rest"
 )
@@ -37,6 +44,7 @@ func main() {
 		Format: "json",
 	})
 	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
 
 	logger.Info("starting fraud-service",
 		"http_port", cfg.HTTPPort,
@@ -52,7 +60,7 @@ func main() {
 	if err != nil {
 		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
 	} else {
-		defer func() { _ = shutdown(ctx) }() //nolint:errcheck // best-effort tracer shutdown
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
 	}
 
 	// Database connection.
@@ -71,7 +79,7 @@ func main() {
 		logger.Error("failed to connect to database", "error", err)
 		os.Exit(1)
 	}
-	defer pool.Close()
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
 	logger.Info("connected to database")
 
 	// Run database migrations.
@@ -92,32 +100,234 @@ func main() {
 	kafkaProducer := pkgkafka.NewProducer(pkgkafka.Config{
 		Brokers: cfg.Kafka.Brokers,
 	})
-	defer kafkaProducer.Close()
-	eventPublisher := kafka.NewPublisher(
-		kafkaProducer,
-		"fraud-events",
-		logger,
-	)
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(kafkaProducer.Close))
+
+	// Outbox relay: events are written to the outbox and published from it,
+	// so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     outbox.StaticRoute("fraud-events"),
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: "fraud-service",
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, kafkaProducer, relayCfg, logger)
+	if err != nil {
+		logger.Error("failed to create outbox relay", "error", err)
+		os.Exit(1)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+	eventPublisher := postgres.NewOutboxPublisher(outbox.NewPublisher(outboxStore), "fraud-events")
+
+	ruleRepo := postgres.NewRuleRepository(pool)
+	ruleHitRepo := postgres.NewRuleHitRepository(pool)
+	caseRepo := postgres.NewCaseRepository(pool)
+	labelRepo := postgres.NewLabelRepository(pool)
+	datasetStore := objectstore.New(archive.NewFileStore(cfg.DatasetDir))
+	screeningRepo := postgres.NewScreeningRepository(pool)
+	watchlistSource := watchlist.NewFileSource(cfg.Screening.WatchlistDir)
+	deviceRepo := postgres.NewDeviceProfileRepository(pool)
+	policyRepo := postgres.NewDecisionPolicyRepository(pool)
+	linkRepo := postgres.NewLinkGraphRepository(pool)
+	authAnomalyRepo := postgres.NewAuthAnomalyRepository(pool)
+	amlTxnRepo := postgres.NewAMLTransactionRepository(pool)
+	flaggedPartyRepo := postgres.NewFlaggedPartyRepository(pool)
+
+	var ipIntel port.IPIntelligence = ipintel.NoopLookup{}
+	if cfg.IPIntel.Endpoint != "" {
+		ipIntel = ipintel.NewHTTPLookup(cfg.IPIntel.Endpoint, cfg.IPIntel.APIKey, cfg.IPIntel.Timeout, cfg.IPIntel.CacheTTL)
+		logger.Info("IP intelligence enrichment enabled", "endpoint", cfg.IPIntel.Endpoint)
+	}
 
 	// Wire domain services.
-	riskScorer := service.NewRiskScorer()
+	ruleEngine := service.NewRuleEngine()
+	screener := service.NewSanctionsScreener(cfg.Screening.Threshold)
+	policyResolver := service.NewPolicyResolver()
+	amlMonitor := service.NewAMLMonitor(service.AMLScenarios{
+		StructuringThreshold:       cfg.AML.StructuringThreshold,
+		StructuringMargin:          cfg.AML.StructuringMargin,
+		StructuringWindow:          cfg.AML.StructuringWindow,
+		StructuringMinCount:        cfg.AML.StructuringMinCount,
+		RapidMovementMinAmount:     cfg.AML.RapidMovementMinAmount,
+		RapidMovementRatio:         cfg.AML.RapidMovementRatio,
+		RapidMovementWindow:        cfg.AML.RapidMovementWindow,
+		HighRiskCountries:          cfg.AML.HighRiskCountries,
+		HighRiskGeographyThreshold: cfg.AML.HighRiskGeographyThreshold,
+		HighRiskGeographyWindow:    cfg.AML.HighRiskGeographyWindow,
+	})
 
-	var scorer service.Scorer = riskScorer
-	if getEnv("FRAUD_ML_ENABLED", "false") == "true" {
-		mlClient := ml.NewStubModelClient(logger)
-		scorer = service.NewHybridScorer(riskScorer, mlClient, 0.3, logger)
-		logger.Info("ML-enhanced hybrid scoring enabled")
+	var scorer service.Scorer = ruleEngine
+	if cfg.ML.Enabled {
+		mlClient, err := newMLClient(cfg.ML, logger)
+		if err != nil {
+			logger.Error("invalid ML configuration", "error", err)
+			os.Exit(1)
+		}
+		scorer = service.NewHybridScorer(ruleEngine, mlClient, cfg.ML.Weight, logger)
+		logger.Info("ML-enhanced hybrid scoring enabled",
+			"endpoint", cfg.ML.Endpoint,
+			"model_version", cfg.ML.ModelVersion,
+			"candidate_version", cfg.ML.CandidateVersion,
+			"candidate_percent", cfg.ML.CandidatePercent,
+		)
 	}
 
 	// Wire use cases.
-	assessTransactionUC := usecase.NewAssessTransaction(assessmentRepo, eventPublisher, scorer)
+	enrichTransactionUC := usecase.NewEnrichTransaction(deviceRepo, ipIntel, linkRepo, authAnomalyRepo, logger)
+	assessTransactionUC := usecase.NewAssessTransaction(assessmentRepo, eventPublisher, scorer, ruleHitRepo, caseRepo, screener, screeningRepo, flaggedPartyRepo, enrichTransactionUC, policyResolver, logger)
 	getAssessmentUC := usecase.NewGetAssessment(assessmentRepo)
+	listAssessmentsUC := usecase.NewListAssessments(assessmentRepo)
+	getAssessmentMetricsUC := usecase.NewGetAssessmentMetrics(assessmentRepo)
+	reloadRulesUC := usecase.NewReloadRules(ruleRepo, ruleEngine)
+	createRuleUC := usecase.NewCreateRule(ruleRepo, eventPublisher, reloadRulesUC, logger)
+	updateRuleUC := usecase.NewUpdateRule(ruleRepo, eventPublisher, reloadRulesUC, logger)
+	disableRuleUC := usecase.NewDisableRule(ruleRepo, eventPublisher, reloadRulesUC, logger)
+	listRulesUC := usecase.NewListRules(ruleRepo)
+	listRuleVersionsUC := usecase.NewListRuleVersions(ruleRepo)
+	getRuleMetricsUC := usecase.NewGetRuleMetrics(ruleRepo, ruleHitRepo)
+	dryRunRuleUC := usecase.NewDryRunRule(ruleEngine)
+	listCasesUC := usecase.NewListCases(caseRepo)
+	getCaseUC := usecase.NewGetCase(caseRepo)
+	assignCaseUC := usecase.NewAssignCase(caseRepo, eventPublisher)
+	addCaseNoteUC := usecase.NewAddCaseNote(caseRepo)
+	escalateCaseUC := usecase.NewEscalateCase(caseRepo)
+	recordLabelUC := usecase.NewRecordLabel(assessmentRepo, labelRepo, eventPublisher)
+	resolveCaseUC := usecase.NewResolveCase(caseRepo, eventPublisher, recordLabelUC)
+	linkCaseAssessmentUC := usecase.NewLinkCaseAssessment(caseRepo, assessmentRepo)
+	detectSLABreachesUC := usecase.NewDetectCaseSLABreaches(caseRepo, eventPublisher)
+	recordChargebackUC := usecase.NewRecordChargeback(assessmentRepo, recordLabelUC)
+	exportDatasetUC := usecase.NewExportTrainingDataset(labelRepo, datasetStore)
+	reloadWatchlistsUC := usecase.NewReloadWatchlists(watchlistSource, screener)
+	getScreeningLogUC := usecase.NewGetScreeningLog(screeningRepo)
+	reloadPoliciesUC := usecase.NewReloadDecisionPolicies(policyRepo, policyResolver)
+	setPolicyUC := usecase.NewSetDecisionPolicy(policyRepo, eventPublisher, reloadPoliciesUC, logger)
+	getPolicyUC := usecase.NewGetDecisionPolicy(policyRepo)
+	listPolicyVersionsUC := usecase.NewListDecisionPolicyVersions(policyRepo)
+	simulatePolicyUC := usecase.NewSimulateDecisionPolicy(assessmentRepo)
+	getAccountLinksUC := usecase.NewGetAccountLinks(linkRepo)
+	recordAuthAnomalyUC := usecase.NewRecordAuthAnomaly(authAnomalyRepo, logger)
+	monitorTransactionsUC := usecase.NewMonitorTransactions(amlTxnRepo, caseRepo, eventPublisher, amlMonitor, logger)
+	recordFlaggedPartyUC := usecase.NewRecordFlaggedParty(flaggedPartyRepo, logger)
+
+	// Consume the gateway's failed-authentication events to score
+	// transactions from the same IP address with them.
+	authEventConsumer := pkgkafka.NewConsumer(pkgkafka.Config{
+		Brokers:       cfg.Kafka.Brokers,
+		ConsumerGroup: cfg.Kafka.ConsumerGroup,
+	}, usecase.SecurityAuthEventsTopic, func(ctx context.Context, msg pkgkafka.Message) error {
+		return recordAuthAnomalyUC.Execute(ctx, msg.Headers["event_type"], msg.Value)
+	}, logger)
+	lc.OnStop(lifecycle.PhaseResources, "auth event consumer", lifecycle.Close(authEventConsumer.Close))
+
+	// Run the AML monitoring scenarios over the payment event stream,
+	// queueing their alerts for investigation.
+	paymentEventConsumer := pkgkafka.NewConsumer(pkgkafka.Config{
+		Brokers:       cfg.Kafka.Brokers,
+		ConsumerGroup: cfg.Kafka.ConsumerGroup,
+	}, usecase.PaymentOrdersTopic, func(ctx context.Context, msg pkgkafka.Message) error {
+		return monitorTransactionsUC.Execute(ctx, msg.Headers["event_type"], msg.Value)
+	}, logger)
+	lc.OnStop(lifecycle.PhaseResources, "payment event consumer", lifecycle.Close(paymentEventConsumer.Close))
+
+	// Flag customers identity screening finds on sanctions lists, so payments
+	// naming them as counterparty are held for review.
+	identityEventConsumer := pkgkafka.NewConsumer(pkgkafka.Config{
+		Brokers:       cfg.Kafka.Brokers,
+		ConsumerGroup: cfg.Kafka.ConsumerGroup,
+	}, usecase.IdentityVerificationsTopic, func(ctx context.Context, msg pkgkafka.Message) error {
+		return recordFlaggedPartyUC.Execute(ctx, msg.Headers["event_type"], msg.Value)
+	}, logger)
+	lc.OnStop(lifecycle.PhaseResources, "identity event consumer", lifecycle.Close(identityEventConsumer.Close))
+
+	// Load fraud rules and decision policies, then keep them fresh in the background.
+	if n, reloadErr := reloadRulesUC.Execute(ctx); reloadErr != nil {
+		logger.Warn("failed to load fraud rules, using default rules", "error", reloadErr)
+	} else {
+		logger.Info("fraud rules loaded", "count", n)
+	}
+	if n, reloadErr := reloadPoliciesUC.Execute(ctx); reloadErr != nil {
+		logger.Warn("failed to load decision policies, using default thresholds", "error", reloadErr)
+	} else {
+		logger.Info("decision policies loaded", "count", n)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "rule reload", func(ctx context.Context) error {
+		ticker := time.NewTicker(cfg.RuleReloadInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				if _, reloadErr := reloadRulesUC.Execute(ctx); reloadErr != nil {
+					logger.Warn("failed to reload fraud rules", "error", reloadErr)
+				}
+				if _, reloadErr := reloadPoliciesUC.Execute(ctx); reloadErr != nil {
+					logger.Warn("failed to reload decision policies", "error", reloadErr)
+				}
+			}
+		}
+	})
+
+	// Announce review cases that pass their SLA deadline, on the elected
+	// replica only so each breach is published once.
+	slaElector := lock.NewElector(lock.NewPostgresLocker(pool), "fraud.case-sla", lock.ElectorConfig{}, logger)
+	lc.Go(lifecycle.PhaseWorkers, "case SLA sweep", func(ctx context.Context) error {
+		slaElector.Run(ctx, func(ctx context.Context) {
+			ticker := time.NewTicker(cfg.CaseSLACheck)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case now := <-ticker.C:
+					marked, sweepErr := detectSLABreachesUC.Execute(ctx, now.UTC())
+					if sweepErr != nil {
+						logger.Error("case SLA sweep failed", "error", sweepErr)
+					} else if marked > 0 {
+						logger.Info("review cases breached their SLA", "count", marked)
+					}
+				}
+			}
+		})
+		return nil
+	})
+
+	// Load sanctions lists, then pick up republished lists in the background.
+	if n, reloadErr := reloadWatchlistsUC.Execute(ctx); reloadErr != nil {
+		logger.Warn("failed to load sanctions watchlists, screening will not match until lists load", "error", reloadErr)
+	} else {
+		logger.Info("sanctions watchlists loaded", "entries", n)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "watchlist reload", func(ctx context.Context) error {
+		ticker := time.NewTicker(cfg.Screening.ReloadInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				if _, reloadErr := reloadWatchlistsUC.Execute(ctx); reloadErr != nil {
+					logger.Warn("failed to reload sanctions watchlists", "error", reloadErr)
+				}
+			}
+		}
+	})
 
 	// JWT service (validation-only: public key preferred, secret as fallback).
 	jwtCfg := auth.JWTConfig{
 		Issuer: "bib-gateway",
 	}
 	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
 	case os.Getenv("JWT_PUBLIC_KEY") != "":
 		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
 	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
@@ -141,13 +351,31 @@ func main() {
 	}
 
 	// gRPC server.
-	grpcHandler := grpcpresentation.NewFraudServiceHandler(assessTransactionUC, getAssessmentUC, logger)
-	grpcServer := grpcpresentation.NewServer(grpcHandler, cfg.GRPCAddr(), logger, jwtSvc)
+	grpcHandler := grpcpresentation.NewFraudServiceHandler(assessTransactionUC, getAssessmentUC, listAssessmentsUC, getAssessmentMetricsUC, logger)
+	ruleHandler := grpcpresentation.NewFraudRuleHandler(
+		createRuleUC, updateRuleUC, disableRuleUC, listRulesUC, listRuleVersionsUC, getRuleMetricsUC, dryRunRuleUC, logger,
+	)
+	caseHandler := grpcpresentation.NewFraudCaseHandler(
+		listCasesUC, getCaseUC, assignCaseUC, addCaseNoteUC, escalateCaseUC, resolveCaseUC, linkCaseAssessmentUC, logger,
+	)
+	labelHandler := grpcpresentation.NewFraudLabelHandler(recordChargebackUC, exportDatasetUC, logger)
+	screeningHandler := grpcpresentation.NewFraudScreeningHandler(getScreeningLogUC, logger)
+	policyHandler := grpcpresentation.NewFraudPolicyHandler(setPolicyUC, getPolicyUC, listPolicyVersionsUC, simulatePolicyUC, logger)
+	linkHandler := grpcpresentation.NewFraudLinkHandler(getAccountLinksUC, logger)
+	grpcServer, err := grpcpresentation.NewServer(grpcHandler, ruleHandler, caseHandler, labelHandler, screeningHandler, policyHandler, linkHandler, cfg.GRPCAddr(), logger, jwtSvc)
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
 
 	// HTTP server (health checks).
 	healthHandler := rest.NewHealthHandler(logger)
 	httpMux := http.NewServeMux()
 	healthHandler.RegisterRoutes(httpMux)
+	if metricsHandler != nil {
+		httpMux.Handle("/metrics", metricsHandler)
+	}
 
 	httpServer := &http.Server{
 		Addr:         cfg.HTTPAddr(),
@@ -158,20 +386,14 @@ func main() {
 	}
 
 	// Start servers.
-	errCh := make(chan error, 2)
-
-	go func() {
-		if err := grpcServer.Start(); err != nil {
-			errCh <- fmt.Errorf("gRPC server error: %w", err)
-		}
-	}()
-
-	go func() {
-		logger.Info("HTTP server starting", "address", cfg.HTTPAddr())
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			errCh <- fmt.Errorf("HTTP server error: %w", err)
-		}
-	}()
+	lc.Go(lifecycle.PhaseConsumers, "auth event consumer", authEventConsumer.Start)
+	lc.Go(lifecycle.PhaseConsumers, "payment event consumer", paymentEventConsumer.Start)
+	lc.Go(lifecycle.PhaseConsumers, "identity event consumer", identityEventConsumer.Start)
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", func(context.Context) error {
+		return grpcServer.Start()
+	})
+	lc.OnStop(lifecycle.PhaseIngress, "gRPC server", lifecycle.Func(grpcServer.Stop))
+	lc.ServeHTTP("HTTP server", httpServer)
 
 	logger.Info("fraud-service started",
 		"grpc_address", cfg.GRPCAddr(),
@@ -179,27 +401,33 @@ func main() {
 		"environment", cfg.Environment,
 	)
 
-	// Wait for shutdown signal.
-	select {
-	case <-ctx.Done():
-		logger.Info("shutdown signal received")
-	case err := <-errCh:
-		logger.Error("server error", "error", err)
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
 	}
+	logger.Info("fraud-service stopped")
+}
 
-	// Graceful shutdown.
-	logger.Info("shutting down fraud-service")
-
-	grpcServer.Stop()
-
-	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 15*time.Second)
-	defer shutdownCancel()
-
-	if err := httpServer.Shutdown(shutdownCtx); err != nil {
-		logger.Error("HTTP server shutdown error", "error", err)
+// newMLClient builds the model client from configuration. Without an endpoint
+// the stub client is used; with a candidate endpoint, traffic is split between
+// the primary and candidate models.
+func newMLClient(cfg config.MLConfig, logger *slog.Logger) (port.MLModelClient, error) {
+	if cfg.Endpoint == "" {
+		return ml.NewStubModelClient(logger), nil
 	}
-
-	logger.Info("fraud-service stopped")
+	primary := ml.NewHTTPModelClient(cfg.Endpoint, cfg.ModelVersion, cfg.Timeout)
+	if cfg.CandidateEndpoint == "" || cfg.CandidatePercent <= 0 {
+		return primary, nil
+	}
+	if cfg.CandidatePercent > 100 {
+		return nil, fmt.Errorf("FRAUD_ML_CANDIDATE_PERCENT must be between 0 and 100, got %d", cfg.CandidatePercent)
+	}
+	candidate := ml.NewHTTPModelClient(cfg.CandidateEndpoint, cfg.CandidateVersion, cfg.Timeout)
+	return ml.NewABRouter(
+		ml.Variant{Name: cfg.ModelVersion, Client: primary, Weight: 100 - cfg.CandidatePercent},
+		ml.Variant{Name: cfg.CandidateVersion, Client: candidate, Weight: cfg.CandidatePercent},
+	)
 }
 
 func getEnv(key, fallback string) string {
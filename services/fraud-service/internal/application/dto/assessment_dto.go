@@ -15,26 +15,69 @@ type AssessTransactionRequest struct {
 	Amount          decimal.Decimal   `json:"amount"`
 	Currency        string            `json:"currency"`
 	TransactionType string            `json:"transaction_type"`
-	TenantID        uuid.UUID         `json:"tenant_id"`
-	TransactionID   uuid.UUID         `json:"transaction_id"`
-	AccountID       uuid.UUID         `json:"account_id"`
+	// CounterpartyName and DestinationCountry (ISO 3166 alpha-2) are screened
+	// against sanctions lists when present.
+	CounterpartyName   string `json:"counterparty_name,omitempty"`
+	DestinationCountry string `json:"destination_country,omitempty"`
+	// DeviceFingerprint and IPAddress identify where the transaction was
+	// initiated; they drive device history and IP intelligence features.
+	DeviceFingerprint string `json:"device_fingerprint,omitempty"`
+	IPAddress         string `json:"ip_address,omitempty"`
+	// DestinationAccount identifies the account receiving the funds; it links
+	// accounts paying the same beneficiary in the link graph.
+	DestinationAccount string    `json:"destination_account,omitempty"`
+	TenantID           uuid.UUID `json:"tenant_id"`
+	TransactionID      uuid.UUID `json:"transaction_id"`
+	AccountID          uuid.UUID `json:"account_id"`
 }
 
 // AssessmentResponse is the output DTO returned after an assessment.
 type AssessmentResponse struct {
-	CreatedAt       time.Time `json:"created_at"`
-	AssessedAt      time.Time `json:"assessed_at"`
-	TransactionType string    `json:"transaction_type"`
-	Decision        string    `json:"decision"`
-	RiskLevel       string    `json:"risk_level"`
-	Amount          string    `json:"amount"`
-	Currency        string    `json:"currency"`
-	RiskSignals     []string  `json:"risk_signals"`
-	RiskScore       int       `json:"risk_score"`
-	ID              uuid.UUID `json:"id"`
-	AccountID       uuid.UUID `json:"account_id"`
-	TransactionID   uuid.UUID `json:"transaction_id"`
-	TenantID        uuid.UUID `json:"tenant_id"`
+	// RuleHits lists the rules that fired, SHADOW ones included. It is set
+	// on the result of AssessTransaction only.
+	RuleHits        []RuleHitResponse `json:"rule_hits,omitempty"`
+	CreatedAt       time.Time         `json:"created_at"`
+	AssessedAt      time.Time         `json:"assessed_at"`
+	TransactionType string            `json:"transaction_type"`
+	Decision        string            `json:"decision"`
+	RiskLevel       string            `json:"risk_level"`
+	Amount          string            `json:"amount"`
+	Currency        string            `json:"currency"`
+	ModelVersion    string            `json:"model_version,omitempty"`
+	RiskSignals     []string          `json:"risk_signals"`
+	RiskScore       int               `json:"risk_score"`
+	PolicyVersion   int               `json:"policy_version"`
+	ID              uuid.UUID         `json:"id"`
+	AccountID       uuid.UUID         `json:"account_id"`
+	TransactionID   uuid.UUID         `json:"transaction_id"`
+	TenantID        uuid.UUID         `json:"tenant_id"`
+}
+
+// RuleHitResponse reports a rule that fired while scoring a transaction,
+// and the version of its definition that did.
+type RuleHitResponse struct {
+	RuleName    string    `json:"rule_name"`
+	Signal      string    `json:"signal"`
+	ScoreImpact int       `json:"score_impact"`
+	RuleVersion int       `json:"rule_version"`
+	Shadow      bool      `json:"shadow"`
+	RuleID      uuid.UUID `json:"rule_id"`
+}
+
+// FromRuleHits maps the rule hits of a scoring to response DTOs.
+func FromRuleHits(hits []model.RuleHit) []RuleHitResponse {
+	out := make([]RuleHitResponse, 0, len(hits))
+	for _, h := range hits {
+		out = append(out, RuleHitResponse{
+			RuleID:      h.RuleID,
+			RuleName:    h.RuleName,
+			RuleVersion: h.RuleVersion,
+			Signal:      h.Signal,
+			ScoreImpact: h.ScoreImpact,
+			Shadow:      h.Shadow,
+		})
+	}
+	return out
 }
 
 // GetAssessmentRequest is the input DTO for retrieving an assessment.
@@ -57,7 +100,68 @@ func FromModel(a *model.TransactionAssessment) AssessmentResponse {
 		RiskScore:       a.RiskScore(),
 		Decision:        a.Decision().String(),
 		RiskSignals:     a.RiskSignals(),
+		ModelVersion:    a.ModelVersion(),
+		PolicyVersion:   a.PolicyVersion(),
 		AssessedAt:      a.AssessedAt(),
 		CreatedAt:       a.CreatedAt(),
 	}
 }
+
+// ListAssessmentsRequest is the input DTO for searching assessments. Empty
+// filters match everything; From is inclusive and To exclusive.
+type ListAssessmentsRequest struct {
+	From      time.Time `json:"from"`
+	To        time.Time `json:"to"`
+	Decision  string    `json:"decision,omitempty"`
+	RiskLevel string    `json:"risk_level,omitempty"`
+	PageSize  int       `json:"page_size"`
+	Offset    int       `json:"offset"`
+	TenantID  uuid.UUID `json:"tenant_id"`
+	AccountID uuid.UUID `json:"account_id,omitempty"`
+}
+
+// ListAssessmentsResponse is the output DTO for a page of assessments.
+type ListAssessmentsResponse struct {
+	Assessments []AssessmentResponse `json:"assessments"`
+	TotalCount  int                  `json:"total_count"`
+}
+
+// AssessmentMetricsRequest is the input DTO for aggregate assessment metrics
+// over [From, To).
+type AssessmentMetricsRequest struct {
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+// ScoreBand counts assessments whose risk score falls in [Min, Max].
+type ScoreBand struct {
+	Min   int `json:"min"`
+	Max   int `json:"max"`
+	Count int `json:"count"`
+}
+
+// DailyDecisions is one day of the decision time series.
+type DailyDecisions struct {
+	Day      time.Time `json:"day"`
+	Approved int       `json:"approved"`
+	Review   int       `json:"review"`
+	Declined int       `json:"declined"`
+}
+
+// AssessmentMetricsResponse is the output DTO for assessment metrics. Rates
+// are fractions of Total in [0, 1].
+type AssessmentMetricsResponse struct {
+	From              time.Time        `json:"from"`
+	To                time.Time        `json:"to"`
+	Decisions         map[string]int   `json:"decisions"`
+	RiskLevels        map[string]int   `json:"risk_levels"`
+	ScoreHistogram    []ScoreBand      `json:"score_histogram"`
+	Daily             []DailyDecisions `json:"daily"`
+	ApprovalRate      float64          `json:"approval_rate"`
+	ReviewRate        float64          `json:"review_rate"`
+	DeclineRate       float64          `json:"decline_rate"`
+	AverageScore      float64          `json:"average_score"`
+	Total             int              `json:"total"`
+	SanctionsHitCount int              `json:"sanctions_hit_count"`
+}
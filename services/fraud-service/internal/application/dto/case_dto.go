@@ -0,0 +1,144 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+// ListCasesRequest is the input DTO for listing the manual review queue.
+type ListCasesRequest struct {
+	Status      string    `json:"status"`
+	PageSize    int       `json:"page_size"`
+	Offset      int       `json:"offset"`
+	TenantID    uuid.UUID `json:"tenant_id"`
+	AssigneeID  uuid.UUID `json:"assignee_id"`
+	OverdueOnly bool      `json:"overdue_only"`
+}
+
+// ListCasesResponse is the output DTO for a page of review cases.
+type ListCasesResponse struct {
+	Cases      []CaseResponse `json:"cases"`
+	TotalCount int            `json:"total_count"`
+}
+
+// GetCaseRequest is the input DTO for retrieving a single case.
+type GetCaseRequest struct {
+	TenantID uuid.UUID `json:"tenant_id"`
+	CaseID   uuid.UUID `json:"case_id"`
+}
+
+// AssignCaseRequest is the input DTO for assigning a case to an analyst.
+type AssignCaseRequest struct {
+	TenantID  uuid.UUID `json:"tenant_id"`
+	CaseID    uuid.UUID `json:"case_id"`
+	AnalystID uuid.UUID `json:"analyst_id"`
+}
+
+// AddCaseNoteRequest is the input DTO for adding an analyst note.
+type AddCaseNoteRequest struct {
+	Body     string    `json:"body"`
+	TenantID uuid.UUID `json:"tenant_id"`
+	CaseID   uuid.UUID `json:"case_id"`
+	AuthorID uuid.UUID `json:"author_id"`
+}
+
+// EscalateCaseRequest is the input DTO for escalating a case.
+type EscalateCaseRequest struct {
+	Reason   string    `json:"reason"`
+	TenantID uuid.UUID `json:"tenant_id"`
+	CaseID   uuid.UUID `json:"case_id"`
+	ActorID  uuid.UUID `json:"actor_id"`
+}
+
+// ResolveCaseRequest is the input DTO for recording a final disposition.
+type ResolveCaseRequest struct {
+	Disposition string    `json:"disposition"`
+	Note        string    `json:"note"`
+	TenantID    uuid.UUID `json:"tenant_id"`
+	CaseID      uuid.UUID `json:"case_id"`
+	ActorID     uuid.UUID `json:"actor_id"`
+}
+
+// LinkCaseAssessmentRequest is the input DTO for linking an assessment to a case.
+type LinkCaseAssessmentRequest struct {
+	TenantID     uuid.UUID `json:"tenant_id"`
+	CaseID       uuid.UUID `json:"case_id"`
+	AssessmentID uuid.UUID `json:"assessment_id"`
+	ActorID      uuid.UUID `json:"actor_id"`
+}
+
+// CaseNoteResponse is the output DTO for a case note.
+type CaseNoteResponse struct {
+	CreatedAt time.Time `json:"created_at"`
+	Body      string    `json:"body"`
+	ID        uuid.UUID `json:"id"`
+	AuthorID  uuid.UUID `json:"author_id"`
+}
+
+// CaseResponse is the output DTO for a fraud review case.
+type CaseResponse struct {
+	SLADueAt      time.Time          `json:"sla_due_at"`
+	ResolvedAt    *time.Time         `json:"resolved_at,omitempty"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+	Status        string             `json:"status"`
+	Disposition   string             `json:"disposition,omitempty"`
+	RiskLevel     string             `json:"risk_level"`
+	AMLScenario   string             `json:"aml_scenario,omitempty"`
+	Notes         []CaseNoteResponse `json:"notes"`
+	AlertTxnIDs   []uuid.UUID        `json:"alert_transaction_ids,omitempty"`
+	LinkedIDs     []uuid.UUID        `json:"linked_assessment_ids,omitempty"`
+	RiskScore     int                `json:"risk_score"`
+	Version       int                `json:"version"`
+	ID            uuid.UUID          `json:"id"`
+	TenantID      uuid.UUID          `json:"tenant_id"`
+	AssessmentID  uuid.UUID          `json:"assessment_id"`
+	TransactionID uuid.UUID          `json:"transaction_id"`
+	AccountID     uuid.UUID          `json:"account_id"`
+	AssigneeID    uuid.UUID          `json:"assignee_id"`
+	SLABreached   bool               `json:"sla_breached"`
+}
+
+// FromCaseModel maps a domain case to the response DTO. SLA breach is
+// evaluated as of now.
+func FromCaseModel(c *model.FraudCase, now time.Time) CaseResponse {
+	notes := make([]CaseNoteResponse, 0, len(c.Notes()))
+	for _, n := range c.Notes() {
+		notes = append(notes, CaseNoteResponse{
+			ID:        n.ID,
+			AuthorID:  n.AuthorID,
+			Body:      n.Body,
+			CreatedAt: n.CreatedAt,
+		})
+	}
+
+	resp := CaseResponse{
+		ID:            c.ID(),
+		TenantID:      c.TenantID(),
+		AssessmentID:  c.AssessmentID(),
+		TransactionID: c.TransactionID(),
+		AccountID:     c.AccountID(),
+		RiskScore:     c.RiskScore(),
+		RiskLevel:     c.RiskLevel().String(),
+		AMLScenario:   c.AMLScenario(),
+		AlertTxnIDs:   c.AlertTransactionIDs(),
+		LinkedIDs:     c.LinkedAssessmentIDs(),
+		Status:        c.Status().String(),
+		Disposition:   c.Disposition().String(),
+		AssigneeID:    c.AssigneeID(),
+		Notes:         notes,
+		SLADueAt:      c.SLADueAt(),
+		SLABreached:   c.SLABreached(now),
+		Version:       c.Version(),
+		CreatedAt:     c.CreatedAt(),
+		UpdatedAt:     c.UpdatedAt(),
+	}
+	if !c.ResolvedAt().IsZero() {
+		resolvedAt := c.ResolvedAt()
+		resp.ResolvedAt = &resolvedAt
+	}
+	return resp
+}
@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+// RecordLabelRequest is the input DTO for attaching a ground-truth label to an assessment.
+type RecordLabelRequest struct {
+	Label        string    `json:"label"`
+	Source       string    `json:"source"`
+	SourceRef    string    `json:"source_ref"`
+	TenantID     uuid.UUID `json:"tenant_id"`
+	AssessmentID uuid.UUID `json:"assessment_id"`
+	LabeledBy    uuid.UUID `json:"labeled_by"`
+}
+
+// RecordChargebackRequest is the input DTO for labeling a transaction that
+// received a fraud chargeback.
+type RecordChargebackRequest struct {
+	ChargebackID  string    `json:"chargeback_id"`
+	ReasonCode    string    `json:"reason_code"`
+	TenantID      uuid.UUID `json:"tenant_id"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	ReportedBy    uuid.UUID `json:"reported_by"`
+}
+
+// LabelResponse is the output DTO for an assessment label.
+type LabelResponse struct {
+	LabeledAt    time.Time `json:"labeled_at"`
+	Label        string    `json:"label"`
+	Source       string    `json:"source"`
+	SourceRef    string    `json:"source_ref"`
+	AssessmentID uuid.UUID `json:"assessment_id"`
+	LabeledBy    uuid.UUID `json:"labeled_by"`
+}
+
+// ExportDatasetRequest is the input DTO for exporting a labeled training dataset.
+// Labels recorded in [From, To) are included.
+type ExportDatasetRequest struct {
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+// ExportDatasetResponse summarises an exported dataset.
+type ExportDatasetResponse struct {
+	URI            string `json:"uri"`
+	Rows           int    `json:"rows"`
+	FraudRows      int    `json:"fraud_rows"`
+	LegitimateRows int    `json:"legitimate_rows"`
+}
+
+// FromLabelModel maps a domain label to the response DTO.
+func FromLabelModel(l *model.AssessmentLabel) LabelResponse {
+	return LabelResponse{
+		AssessmentID: l.AssessmentID(),
+		Label:        l.Label().String(),
+		Source:       l.Source().String(),
+		SourceRef:    l.SourceRef(),
+		LabeledBy:    l.LabeledBy(),
+		LabeledAt:    l.LabeledAt(),
+	}
+}
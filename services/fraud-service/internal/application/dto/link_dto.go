@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+// GetAccountLinksRequest is the input DTO for exploring an account's links.
+type GetAccountLinksRequest struct {
+	// Limit caps the number of linked accounts; zero selects the default.
+	Limit int `json:"limit"`
+	// FraudOnly restricts the result to confirmed-fraud accounts.
+	FraudOnly bool      `json:"fraud_only"`
+	TenantID  uuid.UUID `json:"tenant_id"`
+	AccountID uuid.UUID `json:"account_id"`
+}
+
+// LinkEntityResponse is the output DTO for an entity shared between accounts.
+type LinkEntityResponse struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// LinkedAccountResponse is the output DTO for one linked account.
+type LinkedAccountResponse struct {
+	Shared         []LinkEntityResponse `json:"shared"`
+	AccountID      uuid.UUID            `json:"account_id"`
+	ConfirmedFraud bool                 `json:"confirmed_fraud"`
+}
+
+// AccountLinksResponse is the output DTO listing the accounts linked to an account.
+type AccountLinksResponse struct {
+	Linked              []LinkedAccountResponse `json:"linked"`
+	ConfirmedFraudCount int                     `json:"confirmed_fraud_count"`
+	AccountID           uuid.UUID               `json:"account_id"`
+}
+
+// FromLinkedAccount maps a linked account to the response DTO.
+func FromLinkedAccount(a model.LinkedAccount) LinkedAccountResponse {
+	resp := LinkedAccountResponse{
+		AccountID:      a.AccountID,
+		ConfirmedFraud: a.ConfirmedFraud,
+		Shared:         make([]LinkEntityResponse, 0, len(a.Shared)),
+	}
+	for _, e := range a.Shared {
+		resp.Shared = append(resp.Shared, LinkEntityResponse{Type: e.Type, Value: e.Value})
+	}
+	return resp
+}
@@ -0,0 +1,128 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// PolicyThresholds carries decision policy cutoffs. Each field is the lowest
+// score that reaches that risk level or decision.
+type PolicyThresholds struct {
+	MediumAt   int `json:"medium_at"`
+	HighAt     int `json:"high_at"`
+	CriticalAt int `json:"critical_at"`
+	ReviewAt   int `json:"review_at"`
+	DeclineAt  int `json:"decline_at"`
+}
+
+// ToValueObject converts the DTO to domain thresholds.
+func (t PolicyThresholds) ToValueObject() valueobject.DecisionThresholds {
+	return valueobject.DecisionThresholds{
+		MediumAt:   t.MediumAt,
+		HighAt:     t.HighAt,
+		CriticalAt: t.CriticalAt,
+		ReviewAt:   t.ReviewAt,
+		DeclineAt:  t.DeclineAt,
+	}
+}
+
+// FromThresholds maps domain thresholds to the DTO.
+func FromThresholds(t valueobject.DecisionThresholds) PolicyThresholds {
+	return PolicyThresholds{
+		MediumAt:   t.MediumAt,
+		HighAt:     t.HighAt,
+		CriticalAt: t.CriticalAt,
+		ReviewAt:   t.ReviewAt,
+		DeclineAt:  t.DeclineAt,
+	}
+}
+
+// SetDecisionPolicyRequest is the input DTO for recording a new policy version.
+type SetDecisionPolicyRequest struct {
+	// EffectiveFrom is when the policy takes effect; zero means immediately.
+	EffectiveFrom time.Time        `json:"effective_from"`
+	Reason        string           `json:"reason"`
+	Thresholds    PolicyThresholds `json:"thresholds"`
+	TenantID      uuid.UUID        `json:"tenant_id"`
+	ActorID       uuid.UUID        `json:"actor_id"`
+}
+
+// GetDecisionPolicyRequest is the input DTO for retrieving a tenant's policy.
+type GetDecisionPolicyRequest struct {
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+// DecisionPolicyResponse is the output DTO for one policy version.
+// Version 0 denotes the global default policy.
+type DecisionPolicyResponse struct {
+	EffectiveFrom time.Time        `json:"effective_from"`
+	CreatedAt     time.Time        `json:"created_at"`
+	Reason        string           `json:"reason"`
+	Thresholds    PolicyThresholds `json:"thresholds"`
+	Version       int              `json:"version"`
+	CreatedBy     uuid.UUID        `json:"created_by"`
+}
+
+// CurrentDecisionPolicyResponse is the output DTO describing the policy in
+// force now and any versions scheduled to take effect later.
+type CurrentDecisionPolicyResponse struct {
+	Effective DecisionPolicyResponse   `json:"effective"`
+	Scheduled []DecisionPolicyResponse `json:"scheduled"`
+}
+
+// DecisionPolicyVersionsResponse is the output DTO listing the policy audit trail, newest first.
+type DecisionPolicyVersionsResponse struct {
+	Versions []DecisionPolicyResponse `json:"versions"`
+}
+
+// SimulateDecisionPolicyRequest is the input DTO for replaying recent
+// traffic under proposed thresholds.
+type SimulateDecisionPolicyRequest struct {
+	Thresholds PolicyThresholds `json:"thresholds"`
+	// Days is how much recent traffic to replay; zero selects the default.
+	Days     int       `json:"days"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+// DecisionTransition counts assessments whose decision would change from
+// From to To under the proposed policy.
+type DecisionTransition struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Count int    `json:"count"`
+}
+
+// SimulateDecisionPolicyResponse is the output DTO of a policy simulation.
+type SimulateDecisionPolicyResponse struct {
+	From        time.Time            `json:"from"`
+	To          time.Time            `json:"to"`
+	Actual      map[string]int       `json:"actual"`
+	Proposed    map[string]int       `json:"proposed"`
+	Transitions []DecisionTransition `json:"transitions"`
+	Total       int                  `json:"total"`
+	Changed     int                  `json:"changed"`
+}
+
+// FromDecisionPolicyModel maps a policy version to the response DTO.
+func FromDecisionPolicyModel(p *model.DecisionPolicy) DecisionPolicyResponse {
+	return DecisionPolicyResponse{
+		Version:       p.Version(),
+		Thresholds:    FromThresholds(p.Thresholds()),
+		EffectiveFrom: p.EffectiveFrom(),
+		Reason:        p.Reason(),
+		CreatedBy:     p.CreatedBy(),
+		CreatedAt:     p.CreatedAt(),
+	}
+}
+
+// DefaultDecisionPolicyResponse describes the global default policy.
+func DefaultDecisionPolicyResponse() DecisionPolicyResponse {
+	return DecisionPolicyResponse{
+		Thresholds: FromThresholds(valueobject.DefaultDecisionThresholds()),
+		Reason:     "global default",
+	}
+}
@@ -0,0 +1,146 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+// CreateRuleRequest is the input DTO for the CreateRule use case.
+type CreateRuleRequest struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Condition   string    `json:"condition"`
+	Signal      string    `json:"signal"`
+	Mode        string    `json:"mode"`
+	ScoreImpact int       `json:"score_impact"`
+	TenantID    uuid.UUID `json:"tenant_id"`
+	ActorID     uuid.UUID `json:"actor_id"`
+}
+
+// UpdateRuleRequest is the input DTO for the UpdateRule use case.
+// It replaces the full rule definition and creates a new version.
+type UpdateRuleRequest struct {
+	Description string    `json:"description"`
+	Condition   string    `json:"condition"`
+	Signal      string    `json:"signal"`
+	Mode        string    `json:"mode"`
+	ScoreImpact int       `json:"score_impact"`
+	TenantID    uuid.UUID `json:"tenant_id"`
+	RuleID      uuid.UUID `json:"rule_id"`
+	ActorID     uuid.UUID `json:"actor_id"`
+}
+
+// DisableRuleRequest is the input DTO for the DisableRule use case.
+type DisableRuleRequest struct {
+	TenantID uuid.UUID `json:"tenant_id"`
+	RuleID   uuid.UUID `json:"rule_id"`
+	ActorID  uuid.UUID `json:"actor_id"`
+}
+
+// ListRulesRequest is the input DTO for listing a tenant's rules.
+type ListRulesRequest struct {
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+// ListRuleVersionsRequest is the input DTO for retrieving a rule's history.
+type ListRuleVersionsRequest struct {
+	TenantID uuid.UUID `json:"tenant_id"`
+	RuleID   uuid.UUID `json:"rule_id"`
+}
+
+// DryRunRuleRequest evaluates a proposed rule definition against a sample
+// transaction without saving it.
+type DryRunRuleRequest struct {
+	Metadata        map[string]string `json:"metadata"`
+	Amount          decimal.Decimal   `json:"amount"`
+	Condition       string            `json:"condition"`
+	Signal          string            `json:"signal"`
+	Currency        string            `json:"currency"`
+	TransactionType string            `json:"transaction_type"`
+	ScoreImpact     int               `json:"score_impact"`
+	TenantID        uuid.UUID         `json:"tenant_id"`
+}
+
+// DryRunRuleResponse reports whether the proposed rule matched and how the
+// tenant's current rules score the sample.
+type DryRunRuleResponse struct {
+	CurrentSignals []string `json:"current_signals"`
+	CurrentScore   int      `json:"current_score"`
+	ProjectedScore int      `json:"projected_score"`
+	Matched        bool     `json:"matched"`
+}
+
+// RuleResponse is the output DTO for a fraud rule.
+type RuleResponse struct {
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Condition   string    `json:"condition"`
+	Signal      string    `json:"signal"`
+	Mode        string    `json:"mode"`
+	ScoreImpact int       `json:"score_impact"`
+	Version     int       `json:"version"`
+	ID          uuid.UUID `json:"id"`
+	TenantID    uuid.UUID `json:"tenant_id"`
+	UpdatedBy   uuid.UUID `json:"updated_by"`
+}
+
+// RuleVersionResponse is the output DTO for one entry of a rule's history.
+type RuleVersionResponse struct {
+	ChangedAt   time.Time `json:"changed_at"`
+	Description string    `json:"description"`
+	Condition   string    `json:"condition"`
+	Signal      string    `json:"signal"`
+	Mode        string    `json:"mode"`
+	ScoreImpact int       `json:"score_impact"`
+	Version     int       `json:"version"`
+	ChangedBy   uuid.UUID `json:"changed_by"`
+}
+
+// RuleMetricsResponse is the output DTO for a rule's hit counters.
+type RuleMetricsResponse struct {
+	LastHitAt  time.Time `json:"last_hit_at"`
+	Name       string    `json:"name"`
+	Signal     string    `json:"signal"`
+	Mode       string    `json:"mode"`
+	Hits       int64     `json:"hits"`
+	ShadowHits int64     `json:"shadow_hits"`
+	RuleID     uuid.UUID `json:"rule_id"`
+}
+
+// FromRuleModel maps a domain rule to the response DTO.
+func FromRuleModel(r *model.FraudRule) RuleResponse {
+	return RuleResponse{
+		ID:          r.ID(),
+		TenantID:    r.TenantID(),
+		Name:        r.Name(),
+		Description: r.Description(),
+		Condition:   r.Condition(),
+		Signal:      r.Signal(),
+		ScoreImpact: r.ScoreImpact(),
+		Mode:        r.Mode().String(),
+		Version:     r.Version(),
+		UpdatedBy:   r.UpdatedBy(),
+		CreatedAt:   r.CreatedAt(),
+		UpdatedAt:   r.UpdatedAt(),
+	}
+}
+
+// FromRuleVersion maps a rule version snapshot to the response DTO.
+func FromRuleVersion(v model.RuleVersion) RuleVersionResponse {
+	return RuleVersionResponse{
+		Version:     v.Version,
+		Description: v.Definition.Description,
+		Condition:   v.Definition.Condition,
+		Signal:      v.Definition.Signal,
+		ScoreImpact: v.Definition.ScoreImpact,
+		Mode:        v.Definition.Mode.String(),
+		ChangedBy:   v.ChangedBy,
+		ChangedAt:   v.ChangedAt,
+	}
+}
@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+// GetScreeningLogRequest is the input DTO for retrieving the screening log of a transaction.
+type GetScreeningLogRequest struct {
+	TenantID      uuid.UUID `json:"tenant_id"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+}
+
+// ScreeningRecordResponse is the output DTO for one screening of a transaction.
+type ScreeningRecordResponse struct {
+	ScreenedAt    time.Time                `json:"screened_at"`
+	ListVersion   string                   `json:"list_version"`
+	Subjects      []model.ScreeningSubject `json:"subjects"`
+	Matches       []model.ScreeningMatch   `json:"matches"`
+	Threshold     float64                  `json:"threshold"`
+	Hit           bool                     `json:"hit"`
+	ID            uuid.UUID                `json:"id"`
+	AssessmentID  uuid.UUID                `json:"assessment_id"`
+	TransactionID uuid.UUID                `json:"transaction_id"`
+}
+
+// ScreeningLogResponse is the output DTO listing the screenings of a transaction.
+type ScreeningLogResponse struct {
+	Records []ScreeningRecordResponse `json:"records"`
+}
+
+// FromScreeningModel maps a screening record to the response DTO.
+func FromScreeningModel(r *model.ScreeningRecord) ScreeningRecordResponse {
+	return ScreeningRecordResponse{
+		ID:            r.ID(),
+		AssessmentID:  r.AssessmentID(),
+		TransactionID: r.TransactionID(),
+		Subjects:      r.Subjects(),
+		Matches:       r.Matches(),
+		ListVersion:   r.ListVersion(),
+		Threshold:     r.Threshold(),
+		Hit:           r.Hit(),
+		ScreenedAt:    r.ScreenedAt(),
+	}
+}
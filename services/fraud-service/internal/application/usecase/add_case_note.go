@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// AddCaseNote is the use case for recording an analyst note on a case.
+type AddCaseNote struct {
+	repo port.CaseRepository
+}
+
+// NewAddCaseNote creates a new AddCaseNote use case.
+func NewAddCaseNote(repo port.CaseRepository) *AddCaseNote {
+	return &AddCaseNote{repo: repo}
+}
+
+// Execute appends the note and returns the updated case.
+func (uc *AddCaseNote) Execute(ctx context.Context, req dto.AddCaseNoteRequest) (dto.CaseResponse, error) {
+	c, err := loadCase(ctx, uc.repo, req.TenantID, req.CaseID)
+	if err != nil {
+		return dto.CaseResponse{}, err
+	}
+
+	if _, err := c.AddNote(req.AuthorID, req.Body); err != nil {
+		return dto.CaseResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	if err := uc.repo.Save(ctx, c); err != nil {
+		return dto.CaseResponse{}, fmt.Errorf("failed to save case: %w", err)
+	}
+	return dto.FromCaseModel(c, time.Now().UTC()), nil
+}
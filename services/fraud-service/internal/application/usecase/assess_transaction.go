@@ -3,6 +3,9 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"strings"
+	"time"
 
 	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
 	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
@@ -12,9 +15,17 @@ import (
 
 // AssessTransaction is the use case for scoring and assessing a transaction.
 type AssessTransaction struct {
-	repo      port.AssessmentRepository
-	publisher port.EventPublisher
-	scorer    service.Scorer
+	repo       port.AssessmentRepository
+	publisher  port.EventPublisher
+	scorer     service.Scorer
+	hits       port.RuleHitRepository
+	cases      port.CaseRepository
+	screener   *service.SanctionsScreener
+	screenings port.ScreeningRepository
+	flagged    port.FlaggedPartyRepository
+	enrich     *EnrichTransaction
+	policies   *service.PolicyResolver
+	logger     *slog.Logger
 }
 
 // NewAssessTransaction creates a new AssessTransaction use case.
@@ -22,15 +33,35 @@ func NewAssessTransaction(
 	repo port.AssessmentRepository,
 	publisher port.EventPublisher,
 	scorer service.Scorer,
+	hits port.RuleHitRepository,
+	cases port.CaseRepository,
+	screener *service.SanctionsScreener,
+	screenings port.ScreeningRepository,
+	flagged port.FlaggedPartyRepository,
+	enrich *EnrichTransaction,
+	policies *service.PolicyResolver,
+	logger *slog.Logger,
 ) *AssessTransaction {
 	return &AssessTransaction{
-		repo:      repo,
-		publisher: publisher,
-		scorer:    scorer,
+		repo:       repo,
+		publisher:  publisher,
+		scorer:     scorer,
+		hits:       hits,
+		cases:      cases,
+		screener:   screener,
+		screenings: screenings,
+		flagged:    flagged,
+		enrich:     enrich,
+		policies:   policies,
+		logger:     logger,
 	}
 }
 
-// Execute performs risk scoring, creates the assessment, persists it, and publishes events.
+// Execute screens the counterparty and destination against sanctions lists,
+// and the counterparty against the tenant's flagged parties, performs risk
+// scoring, creates the assessment, persists it, opens a review
+// case for REVIEW decisions, and publishes events. A sanctions hit always
+// results in a REVIEW decision so the transaction is held for an analyst.
 func (uc *AssessTransaction) Execute(ctx context.Context, req dto.AssessTransactionRequest) (dto.AssessmentResponse, error) {
 	// 1. Create the assessment aggregate.
 	assessment, err := model.NewTransactionAssessment(
@@ -45,18 +76,39 @@ func (uc *AssessTransaction) Execute(ctx context.Context, req dto.AssessTransact
 		return dto.AssessmentResponse{}, fmt.Errorf("failed to create assessment: %w", err)
 	}
 
-	// 2. Run risk scoring via the domain service.
+	// 2. Enrich with device and IP intelligence, then run risk scoring via
+	// the domain service.
+	enrichment := uc.enrich.Execute(ctx, req)
 	riskInput := service.RiskInput{
+		TenantID:        req.TenantID,
 		Amount:          req.Amount,
 		Currency:        req.Currency,
 		AccountID:       req.AccountID,
 		TransactionType: req.TransactionType,
-		Metadata:        req.Metadata,
+		Metadata:        withEnrichment(req.Metadata, enrichment),
 	}
 	riskOutput := uc.scorer.Score(riskInput)
 
-	// 3. Apply the score to the assessment (this determines risk level and decision).
-	if err := assessment.Assess(riskOutput.Score, riskOutput.Signals); err != nil {
+	subjects := screeningSubjects(req)
+	var screening service.ScreeningResult
+	if len(subjects) > 0 {
+		screening = uc.screener.Screen(subjects)
+		if req.CounterpartyName != "" {
+			parties, err := uc.flagged.ListByTenant(ctx, req.TenantID)
+			if err != nil {
+				return dto.AssessmentResponse{}, fmt.Errorf("failed to list flagged parties: %w", err)
+			}
+			screening.Matches = append(screening.Matches, uc.screener.ScreenFlaggedParties(subjects, parties)...)
+		}
+		if len(screening.Matches) > 0 {
+			riskOutput.Signals = append(riskOutput.Signals, model.SignalSanctionsHit)
+		}
+	}
+
+	// 3. Apply the score under the tenant's decision policy (this determines
+	// risk level and decision).
+	thresholds, policyVersion := uc.policies.Resolve(req.TenantID, time.Now().UTC())
+	if err := assessment.AssessWithPolicy(riskOutput.Score, riskOutput.Signals, riskOutput.ModelVersion, thresholds, policyVersion); err != nil {
 		return dto.AssessmentResponse{}, fmt.Errorf("failed to assess transaction: %w", err)
 	}
 
@@ -65,13 +117,72 @@ func (uc *AssessTransaction) Execute(ctx context.Context, req dto.AssessTransact
 		return dto.AssessmentResponse{}, fmt.Errorf("failed to save assessment: %w", err)
 	}
 
-	// 5. Publish domain events.
 	events := assessment.DomainEvents()
+
+	// 4a. Append to the screening audit log. Screening evidence is a
+	// compliance record, so failing to persist it fails the assessment.
+	if len(subjects) > 0 {
+		record, err := model.NewScreeningRecord(assessment, subjects, screening.Matches, screening.ListVersion, screening.Threshold)
+		if err != nil {
+			return dto.AssessmentResponse{}, fmt.Errorf("failed to record screening: %w", err)
+		}
+		if err := uc.screenings.Save(ctx, record); err != nil {
+			return dto.AssessmentResponse{}, fmt.Errorf("failed to save screening: %w", err)
+		}
+		events = append(events, record.DomainEvents()...)
+	}
+
+	// 5. Queue REVIEW decisions for manual review by an analyst.
+	if assessment.Decision().IsReview() {
+		fraudCase, err := model.OpenCaseForAssessment(assessment)
+		if err != nil {
+			return dto.AssessmentResponse{}, fmt.Errorf("failed to open review case: %w", err)
+		}
+		if err := uc.cases.Save(ctx, fraudCase); err != nil {
+			return dto.AssessmentResponse{}, fmt.Errorf("failed to save review case: %w", err)
+		}
+		events = append(events, fraudCase.DomainEvents()...)
+	}
+
+	// 6. Publish domain events.
 	if len(events) > 0 {
 		if err := uc.publisher.Publish(ctx, events...); err != nil {
 			return dto.AssessmentResponse{}, fmt.Errorf("failed to publish events: %w", err)
 		}
 	}
 
-	return dto.FromModel(assessment), nil
+	// 7. Record the device sighting, link graph edges and rule hit metrics.
+	// These feed future scoring and observability, so a failure must not
+	// fail the assessment.
+	if err := uc.enrich.Record(ctx, enrichment); err != nil {
+		uc.logger.Warn("failed to record enrichment",
+			"assessment_id", assessment.ID(),
+			"error", err,
+		)
+	}
+	if len(riskOutput.RuleHits) > 0 {
+		if err := uc.hits.RecordHits(ctx, req.TenantID, riskOutput.RuleHits); err != nil {
+			uc.logger.Warn("failed to record rule hits",
+				"assessment_id", assessment.ID(),
+				"error", err,
+			)
+		}
+	}
+
+	result := dto.FromModel(assessment)
+	result.RuleHits = dto.FromRuleHits(riskOutput.RuleHits)
+	return result, nil
+}
+
+// screeningSubjects collects the values of the request that are screened
+// against sanctions lists.
+func screeningSubjects(req dto.AssessTransactionRequest) []model.ScreeningSubject {
+	var subjects []model.ScreeningSubject
+	if name := strings.TrimSpace(req.CounterpartyName); name != "" {
+		subjects = append(subjects, model.ScreeningSubject{Role: model.SubjectCounterparty, Value: name})
+	}
+	if country := strings.TrimSpace(req.DestinationCountry); country != "" {
+		subjects = append(subjects, model.ScreeningSubject{Role: model.SubjectDestinationCountry, Value: strings.ToUpper(country)})
+	}
+	return subjects
 }
@@ -3,7 +3,9 @@ package usecase_test
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -15,15 +17,22 @@ import (
 	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
 	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
 	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
 	"github.com/bibbank/bib/services/fraud-service/internal/domain/service"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
 )
 
 // --- Mock implementations ---
 
 type mockAssessmentRepository struct {
 	savedAssessment *model.TransactionAssessment
+	buckets         []model.ScoreBucket
+	volumes         []model.DecisionVolume
+	listed          []*model.TransactionAssessment
+	lastFilter      port.AssessmentFilter
 	saveFunc        func(ctx context.Context, assessment *model.TransactionAssessment) error
 	findByIDFunc    func(ctx context.Context, tenantID, id uuid.UUID) (*model.TransactionAssessment, error)
+	findByTxnFunc   func(ctx context.Context, tenantID, transactionID uuid.UUID) (*model.TransactionAssessment, error)
 }
 
 func (m *mockAssessmentRepository) Save(ctx context.Context, assessment *model.TransactionAssessment) error {
@@ -41,7 +50,10 @@ func (m *mockAssessmentRepository) FindByID(ctx context.Context, tenantID, id uu
 	return nil, fmt.Errorf("assessment not found")
 }
 
-func (m *mockAssessmentRepository) FindByTransactionID(_ context.Context, _, _ uuid.UUID) (*model.TransactionAssessment, error) {
+func (m *mockAssessmentRepository) FindByTransactionID(ctx context.Context, tenantID, transactionID uuid.UUID) (*model.TransactionAssessment, error) {
+	if m.findByTxnFunc != nil {
+		return m.findByTxnFunc(ctx, tenantID, transactionID)
+	}
 	return nil, nil
 }
 
@@ -49,6 +61,19 @@ func (m *mockAssessmentRepository) FindByAccountID(_ context.Context, _, _ uuid.
 	return nil, nil
 }
 
+func (m *mockAssessmentRepository) ScoreDistribution(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]model.ScoreBucket, error) {
+	return m.buckets, nil
+}
+
+func (m *mockAssessmentRepository) List(_ context.Context, filter port.AssessmentFilter) ([]*model.TransactionAssessment, int, error) {
+	m.lastFilter = filter
+	return m.listed, len(m.listed), nil
+}
+
+func (m *mockAssessmentRepository) DailyDecisionCounts(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]model.DecisionVolume, error) {
+	return m.volumes, nil
+}
+
 type mockFraudEventPublisher struct {
 	publishFunc     func(ctx context.Context, evts ...events.DomainEvent) error
 	publishedEvents []events.DomainEvent
@@ -62,6 +87,132 @@ func (m *mockFraudEventPublisher) Publish(ctx context.Context, evts ...events.Do
 	return nil
 }
 
+type mockRuleHitRepository struct {
+	recorded []model.RuleHit
+}
+
+func (m *mockRuleHitRepository) RecordHits(_ context.Context, _ uuid.UUID, hits []model.RuleHit) error {
+	m.recorded = append(m.recorded, hits...)
+	return nil
+}
+
+func (m *mockRuleHitRepository) Stats(_ context.Context, _ uuid.UUID) ([]model.RuleHitStats, error) {
+	return nil, nil
+}
+
+type mockCaseRepository struct {
+	saveFunc func(ctx context.Context, c *model.FraudCase) error
+	cases    map[uuid.UUID]*model.FraudCase
+}
+
+func newMockCaseRepository() *mockCaseRepository {
+	return &mockCaseRepository{cases: make(map[uuid.UUID]*model.FraudCase)}
+}
+
+func (m *mockCaseRepository) Save(ctx context.Context, c *model.FraudCase) error {
+	if m.saveFunc != nil {
+		return m.saveFunc(ctx, c)
+	}
+	m.cases[c.ID()] = c
+	return nil
+}
+
+func (m *mockCaseRepository) FindByID(_ context.Context, tenantID, id uuid.UUID) (*model.FraudCase, error) {
+	c, ok := m.cases[id]
+	if !ok || c.TenantID() != tenantID {
+		return nil, nil
+	}
+	return c, nil
+}
+
+func (m *mockCaseRepository) FindByAssessmentID(_ context.Context, tenantID, assessmentID uuid.UUID) (*model.FraudCase, error) {
+	for _, c := range m.cases {
+		if c.TenantID() == tenantID && c.AssessmentID() == assessmentID {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockCaseRepository) FindOpenAMLCase(_ context.Context, tenantID, accountID uuid.UUID, scenario string) (*model.FraudCase, error) {
+	for _, c := range m.cases {
+		if c.TenantID() == tenantID && c.AccountID() == accountID && c.AMLScenario() == scenario && !c.Status().IsTerminal() {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockCaseRepository) List(_ context.Context, filter port.CaseFilter) ([]*model.FraudCase, int, error) {
+	var result []*model.FraudCase
+	for _, c := range m.cases {
+		if c.TenantID() == filter.TenantID {
+			result = append(result, c)
+		}
+	}
+	return result, len(result), nil
+}
+
+func (m *mockCaseRepository) ListSLABreached(_ context.Context, now time.Time, limit int) ([]*model.FraudCase, error) {
+	var result []*model.FraudCase
+	for _, c := range m.cases {
+		if !c.Status().IsTerminal() && c.SLABreachedAt().IsZero() && c.SLADueAt().Before(now) && len(result) < limit {
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+type mockFlaggedPartyRepository struct {
+	parties []model.FlaggedParty
+}
+
+func (m *mockFlaggedPartyRepository) Flag(_ context.Context, p model.FlaggedParty) error {
+	_ = m.Clear(context.Background(), p.TenantID, p.VerificationID)
+	m.parties = append(m.parties, p)
+	return nil
+}
+
+func (m *mockFlaggedPartyRepository) Clear(_ context.Context, tenantID, verificationID uuid.UUID) error {
+	kept := m.parties[:0]
+	for _, p := range m.parties {
+		if p.TenantID != tenantID || p.VerificationID != verificationID {
+			kept = append(kept, p)
+		}
+	}
+	m.parties = kept
+	return nil
+}
+
+func (m *mockFlaggedPartyRepository) ListByTenant(_ context.Context, tenantID uuid.UUID) ([]model.FlaggedParty, error) {
+	var result []model.FlaggedParty
+	for _, p := range m.parties {
+		if p.TenantID == tenantID {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+type mockScreeningRepository struct {
+	records []*model.ScreeningRecord
+}
+
+func (m *mockScreeningRepository) Save(_ context.Context, r *model.ScreeningRecord) error {
+	m.records = append(m.records, r)
+	return nil
+}
+
+func (m *mockScreeningRepository) ListByTransactionID(_ context.Context, tenantID, transactionID uuid.UUID) ([]*model.ScreeningRecord, error) {
+	var result []*model.ScreeningRecord
+	for _, r := range m.records {
+		if r.TenantID() == tenantID && r.TransactionID() == transactionID {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
 // --- Tests ---
 
 func validAssessRequest() dto.AssessTransactionRequest {
@@ -81,7 +232,7 @@ func TestAssessTransaction_Execute(t *testing.T) {
 		publisher := &mockFraudEventPublisher{}
 		scorer := service.NewRiskScorer()
 
-		uc := usecase.NewAssessTransaction(repo, publisher, scorer)
+		uc := usecase.NewAssessTransaction(repo, publisher, scorer, &mockRuleHitRepository{}, newMockCaseRepository(), service.NewSanctionsScreener(0), &mockScreeningRepository{}, &mockFlaggedPartyRepository{}, noEnrichment(), service.NewPolicyResolver(), slog.Default())
 
 		req := validAssessRequest()
 		resp, err := uc.Execute(context.Background(), req)
@@ -101,7 +252,7 @@ func TestAssessTransaction_Execute(t *testing.T) {
 		publisher := &mockFraudEventPublisher{}
 		scorer := service.NewRiskScorer()
 
-		uc := usecase.NewAssessTransaction(repo, publisher, scorer)
+		uc := usecase.NewAssessTransaction(repo, publisher, scorer, &mockRuleHitRepository{}, newMockCaseRepository(), service.NewSanctionsScreener(0), &mockScreeningRepository{}, &mockFlaggedPartyRepository{}, noEnrichment(), service.NewPolicyResolver(), slog.Default())
 
 		req := validAssessRequest()
 		req.Amount = decimal.NewFromInt(55000) // very high value
@@ -113,12 +264,167 @@ func TestAssessTransaction_Execute(t *testing.T) {
 		assert.NotEmpty(t, resp.RiskSignals)
 	})
 
+	t.Run("records and reports rule hits from the rule engine", func(t *testing.T) {
+		repo := &mockAssessmentRepository{}
+		publisher := &mockFraudEventPublisher{}
+		hits := &mockRuleHitRepository{}
+
+		uc := usecase.NewAssessTransaction(repo, publisher, service.NewRuleEngine(), hits, newMockCaseRepository(), service.NewSanctionsScreener(0), &mockScreeningRepository{}, &mockFlaggedPartyRepository{}, noEnrichment(), service.NewPolicyResolver(), slog.Default())
+
+		req := validAssessRequest()
+		req.Amount = decimal.NewFromInt(15000)
+		resp, err := uc.Execute(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"high_value"}, resp.RiskSignals)
+		require.Len(t, hits.recorded, 1)
+		assert.Equal(t, "high_value", hits.recorded[0].Signal)
+		require.Len(t, resp.RuleHits, 1)
+		assert.Equal(t, "High-value transaction", resp.RuleHits[0].RuleName)
+		assert.Equal(t, hits.recorded[0].RuleID, resp.RuleHits[0].RuleID)
+		assert.Equal(t, 20, resp.RuleHits[0].ScoreImpact)
+		assert.False(t, resp.RuleHits[0].Shadow)
+	})
+
+	t.Run("opens a review case for a REVIEW decision", func(t *testing.T) {
+		repo := &mockAssessmentRepository{}
+		publisher := &mockFraudEventPublisher{}
+		cases := newMockCaseRepository()
+
+		uc := usecase.NewAssessTransaction(repo, publisher, service.NewRuleEngine(), &mockRuleHitRepository{}, cases, service.NewSanctionsScreener(0), &mockScreeningRepository{}, &mockFlaggedPartyRepository{}, noEnrichment(), service.NewPolicyResolver(), slog.Default())
+
+		req := validAssessRequest()
+		req.TransactionType = "crypto_purchase" // 10 + 20 = 30 -> REVIEW
+		resp, err := uc.Execute(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "REVIEW", resp.Decision)
+		require.Len(t, cases.cases, 1)
+		for _, c := range cases.cases {
+			assert.Equal(t, resp.ID, c.AssessmentID())
+			assert.Equal(t, "OPEN", c.Status().String())
+		}
+
+		var eventTypes []string
+		for _, e := range publisher.publishedEvents {
+			eventTypes = append(eventTypes, e.EventType())
+		}
+		assert.Contains(t, eventTypes, "fraud.case.opened")
+	})
+
+	t.Run("does not open a case for an APPROVE decision", func(t *testing.T) {
+		cases := newMockCaseRepository()
+		uc := usecase.NewAssessTransaction(&mockAssessmentRepository{}, &mockFraudEventPublisher{}, service.NewRuleEngine(), &mockRuleHitRepository{}, cases, service.NewSanctionsScreener(0), &mockScreeningRepository{}, &mockFlaggedPartyRepository{}, noEnrichment(), service.NewPolicyResolver(), slog.Default())
+
+		_, err := uc.Execute(context.Background(), validAssessRequest())
+
+		require.NoError(t, err)
+		assert.Empty(t, cases.cases)
+	})
+
+	t.Run("holds a sanctions hit for review and logs the screening", func(t *testing.T) {
+		publisher := &mockFraudEventPublisher{}
+		cases := newMockCaseRepository()
+		screenings := &mockScreeningRepository{}
+		screener := service.NewSanctionsScreener(0)
+		screener.Load([]model.WatchlistEntry{
+			{List: valueobject.WatchlistOFAC, EntryID: "SDN-1", Type: model.EntryTypeParty, Name: "Ivan PETROV"},
+		}, "v1")
+
+		uc := usecase.NewAssessTransaction(&mockAssessmentRepository{}, publisher, service.NewRuleEngine(), &mockRuleHitRepository{}, cases, screener, screenings, &mockFlaggedPartyRepository{}, noEnrichment(), service.NewPolicyResolver(), slog.Default())
+
+		req := validAssessRequest() // low value: would otherwise be APPROVE
+		req.CounterpartyName = "Petrov, Ivan"
+		resp, err := uc.Execute(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "REVIEW", resp.Decision)
+		assert.Equal(t, "CRITICAL", resp.RiskLevel)
+		assert.Contains(t, resp.RiskSignals, model.SignalSanctionsHit)
+		assert.Len(t, cases.cases, 1)
+
+		require.Len(t, screenings.records, 1)
+		rec := screenings.records[0]
+		assert.True(t, rec.Hit())
+		assert.Equal(t, "v1", rec.ListVersion())
+		assert.Equal(t, resp.ID, rec.AssessmentID())
+		require.Len(t, rec.Matches(), 1)
+		assert.Equal(t, "SDN-1", rec.Matches()[0].EntryID)
+
+		var eventTypes []string
+		for _, e := range publisher.publishedEvents {
+			eventTypes = append(eventTypes, e.EventType())
+		}
+		assert.Contains(t, eventTypes, "fraud.screening.hit")
+	})
+
+	t.Run("holds a payment to a flagged party for review", func(t *testing.T) {
+		req := validAssessRequest()
+		req.CounterpartyName = "Ivan Petrov"
+		flagged := &mockFlaggedPartyRepository{}
+		require.NoError(t, flagged.Flag(context.Background(), model.FlaggedParty{
+			TenantID: req.TenantID, VerificationID: uuid.New(), Name: "PETROV, Ivan", Matches: []string{"OFAC_SDN:7012"},
+		}))
+		require.NoError(t, flagged.Flag(context.Background(), model.FlaggedParty{
+			TenantID: uuid.New(), VerificationID: uuid.New(), Name: "Jane Doe",
+		}))
+		screenings := &mockScreeningRepository{}
+
+		uc := usecase.NewAssessTransaction(&mockAssessmentRepository{}, &mockFraudEventPublisher{}, service.NewRuleEngine(), &mockRuleHitRepository{}, newMockCaseRepository(), service.NewSanctionsScreener(0), screenings, flagged, noEnrichment(), service.NewPolicyResolver(), slog.Default())
+
+		resp, err := uc.Execute(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, "REVIEW", resp.Decision)
+		assert.Contains(t, resp.RiskSignals, model.SignalSanctionsHit)
+		require.Len(t, screenings.records, 1)
+		require.Len(t, screenings.records[0].Matches(), 1)
+		assert.Equal(t, model.FlaggedPartyList, screenings.records[0].Matches()[0].List)
+
+		// Another tenant's flagged parties are not screened against.
+		req = validAssessRequest()
+		req.CounterpartyName = "Jane Doe"
+		resp, err = uc.Execute(context.Background(), req)
+		require.NoError(t, err)
+		assert.NotContains(t, resp.RiskSignals, model.SignalSanctionsHit)
+	})
+
+	t.Run("logs clear screenings without changing the decision", func(t *testing.T) {
+		screenings := &mockScreeningRepository{}
+		screener := service.NewSanctionsScreener(0)
+		screener.Load([]model.WatchlistEntry{
+			{List: valueobject.WatchlistUN, EntryID: "KP", Type: model.EntryTypeJurisdiction, Name: "KP"},
+		}, "v1")
+
+		uc := usecase.NewAssessTransaction(&mockAssessmentRepository{}, &mockFraudEventPublisher{}, service.NewRuleEngine(), &mockRuleHitRepository{}, newMockCaseRepository(), screener, screenings, &mockFlaggedPartyRepository{}, noEnrichment(), service.NewPolicyResolver(), slog.Default())
+
+		req := validAssessRequest()
+		req.CounterpartyName = "Jane Doe"
+		req.DestinationCountry = "gb"
+		resp, err := uc.Execute(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "APPROVE", resp.Decision)
+		require.Len(t, screenings.records, 1)
+		assert.False(t, screenings.records[0].Hit())
+		assert.Len(t, screenings.records[0].Subjects(), 2)
+	})
+
+	t.Run("skips screening when there is nothing to screen", func(t *testing.T) {
+		screenings := &mockScreeningRepository{}
+		uc := usecase.NewAssessTransaction(&mockAssessmentRepository{}, &mockFraudEventPublisher{}, service.NewRuleEngine(), &mockRuleHitRepository{}, newMockCaseRepository(), service.NewSanctionsScreener(0), screenings, &mockFlaggedPartyRepository{}, noEnrichment(), service.NewPolicyResolver(), slog.Default())
+
+		_, err := uc.Execute(context.Background(), validAssessRequest())
+
+		require.NoError(t, err)
+		assert.Empty(t, screenings.records)
+	})
+
 	t.Run("fails with invalid request data", func(t *testing.T) {
 		repo := &mockAssessmentRepository{}
 		publisher := &mockFraudEventPublisher{}
 		scorer := service.NewRiskScorer()
 
-		uc := usecase.NewAssessTransaction(repo, publisher, scorer)
+		uc := usecase.NewAssessTransaction(repo, publisher, scorer, &mockRuleHitRepository{}, newMockCaseRepository(), service.NewSanctionsScreener(0), &mockScreeningRepository{}, &mockFlaggedPartyRepository{}, noEnrichment(), service.NewPolicyResolver(), slog.Default())
 
 		req := validAssessRequest()
 		req.TransactionID = uuid.Nil // invalid
@@ -137,7 +443,7 @@ func TestAssessTransaction_Execute(t *testing.T) {
 		publisher := &mockFraudEventPublisher{}
 		scorer := service.NewRiskScorer()
 
-		uc := usecase.NewAssessTransaction(repo, publisher, scorer)
+		uc := usecase.NewAssessTransaction(repo, publisher, scorer, &mockRuleHitRepository{}, newMockCaseRepository(), service.NewSanctionsScreener(0), &mockScreeningRepository{}, &mockFlaggedPartyRepository{}, noEnrichment(), service.NewPolicyResolver(), slog.Default())
 
 		req := validAssessRequest()
 		_, err := uc.Execute(context.Background(), req)
@@ -155,7 +461,7 @@ func TestAssessTransaction_Execute(t *testing.T) {
 		}
 		scorer := service.NewRiskScorer()
 
-		uc := usecase.NewAssessTransaction(repo, publisher, scorer)
+		uc := usecase.NewAssessTransaction(repo, publisher, scorer, &mockRuleHitRepository{}, newMockCaseRepository(), service.NewSanctionsScreener(0), &mockScreeningRepository{}, &mockFlaggedPartyRepository{}, noEnrichment(), service.NewPolicyResolver(), slog.Default())
 
 		req := validAssessRequest()
 		_, err := uc.Execute(context.Background(), req)
@@ -163,4 +469,30 @@ func TestAssessTransaction_Execute(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to publish events")
 	})
+
+	t.Run("applies the tenant's decision policy", func(t *testing.T) {
+		req := validAssessRequest()
+		policy, err := model.NewDecisionPolicy(req.TenantID, valueobject.DecisionThresholds{
+			MediumAt: 2, HighAt: 3, CriticalAt: 4, ReviewAt: 1, DeclineAt: 2,
+		}, time.Time{}, "block everything", uuid.New(), nil)
+		require.NoError(t, err)
+		resolver := service.NewPolicyResolver()
+		resolver.Load([]*model.DecisionPolicy{policy})
+
+		repo := &mockAssessmentRepository{}
+		req.Amount = decimal.NewFromInt(15000)
+		uc := usecase.NewAssessTransaction(repo, &mockFraudEventPublisher{}, service.NewRiskScorer(), &mockRuleHitRepository{}, newMockCaseRepository(), service.NewSanctionsScreener(0), &mockScreeningRepository{}, &mockFlaggedPartyRepository{}, noEnrichment(), resolver, slog.Default())
+
+		resp, err := uc.Execute(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, "DECLINE", resp.Decision)
+		assert.Equal(t, 1, resp.PolicyVersion)
+
+		other := validAssessRequest()
+		other.Amount = req.Amount
+		resp, err = uc.Execute(context.Background(), other)
+		require.NoError(t, err)
+		assert.NotEqual(t, "DECLINE", resp.Decision, "other tenants keep the default policy")
+		assert.Equal(t, 0, resp.PolicyVersion)
+	})
 }
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// AssignCase is the use case for assigning a review case to an analyst.
+type AssignCase struct {
+	repo      port.CaseRepository
+	publisher port.EventPublisher
+}
+
+// NewAssignCase creates a new AssignCase use case.
+func NewAssignCase(repo port.CaseRepository, publisher port.EventPublisher) *AssignCase {
+	return &AssignCase{repo: repo, publisher: publisher}
+}
+
+// Execute assigns the case and moves it into review.
+func (uc *AssignCase) Execute(ctx context.Context, req dto.AssignCaseRequest) (dto.CaseResponse, error) {
+	c, err := loadCase(ctx, uc.repo, req.TenantID, req.CaseID)
+	if err != nil {
+		return dto.CaseResponse{}, err
+	}
+
+	if err := c.Assign(req.AnalystID); err != nil {
+		return dto.CaseResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	if err := saveCase(ctx, uc.repo, uc.publisher, c); err != nil {
+		return dto.CaseResponse{}, err
+	}
+	return dto.FromCaseModel(c, time.Now().UTC()), nil
+}
+
+// saveCase persists a case and publishes any events it accumulated.
+func saveCase(ctx context.Context, repo port.CaseRepository, publisher port.EventPublisher, c *model.FraudCase) error {
+	if err := repo.Save(ctx, c); err != nil {
+		return fmt.Errorf("failed to save case: %w", err)
+	}
+	if events := c.DomainEvents(); len(events) > 0 {
+		if err := publisher.Publish(ctx, events...); err != nil {
+			return fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// CreateRule is the use case for adding a new fraud rule.
+type CreateRule struct {
+	repo      port.RuleRepository
+	publisher port.EventPublisher
+	reload    *ReloadRules
+	logger    *slog.Logger
+}
+
+// NewCreateRule creates a new CreateRule use case.
+func NewCreateRule(repo port.RuleRepository, publisher port.EventPublisher, reload *ReloadRules, logger *slog.Logger) *CreateRule {
+	return &CreateRule{
+		repo:      repo,
+		publisher: publisher,
+		reload:    reload,
+		logger:    logger,
+	}
+}
+
+// Execute validates and persists the rule, publishes its events and reloads
+// the local rule engine.
+func (uc *CreateRule) Execute(ctx context.Context, req dto.CreateRuleRequest) (dto.RuleResponse, error) {
+	def, err := ruleDefinition(req.Description, req.Condition, req.Signal, req.Mode, req.ScoreImpact)
+	if err != nil {
+		return dto.RuleResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	rule, err := model.NewFraudRule(req.TenantID, req.Name, def, req.ActorID)
+	if err != nil {
+		return dto.RuleResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	if err := uc.repo.Save(ctx, rule); err != nil {
+		return dto.RuleResponse{}, fmt.Errorf("failed to save rule: %w", err)
+	}
+
+	if events := rule.DomainEvents(); len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, events...); err != nil {
+			return dto.RuleResponse{}, fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+
+	reloadAfterChange(ctx, uc.reload, uc.logger)
+
+	return dto.FromRuleModel(rule), nil
+}
+
+// ruleDefinition builds a domain rule definition from request fields.
+// An empty mode defaults to ACTIVE.
+func ruleDefinition(description, condition, signal, mode string, scoreImpact int) (model.RuleDefinition, error) {
+	def := model.RuleDefinition{
+		Description: description,
+		Condition:   condition,
+		Signal:      signal,
+		ScoreImpact: scoreImpact,
+	}
+	if mode != "" {
+		m, err := valueobject.RuleModeFromString(mode)
+		if err != nil {
+			return model.RuleDefinition{}, err
+		}
+		def.Mode = m
+	}
+	return def, nil
+}
+
+// reloadAfterChange refreshes the local engine after a rule change. A
+// failure is not fatal: the periodic reload will pick the change up.
+func reloadAfterChange(ctx context.Context, reload *ReloadRules, logger *slog.Logger) {
+	if _, err := reload.Execute(ctx); err != nil {
+		logger.Warn("failed to reload fraud rules after change", "error", err)
+	}
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// slaBreachBatchSize bounds how many cases one sweep marks.
+const slaBreachBatchSize = 100
+
+// DetectCaseSLABreaches is the use case that announces review cases which
+// passed their SLA deadline unresolved.
+type DetectCaseSLABreaches struct {
+	repo      port.CaseRepository
+	publisher port.EventPublisher
+}
+
+// NewDetectCaseSLABreaches creates a new DetectCaseSLABreaches use case.
+func NewDetectCaseSLABreaches(repo port.CaseRepository, publisher port.EventPublisher) *DetectCaseSLABreaches {
+	return &DetectCaseSLABreaches{repo: repo, publisher: publisher}
+}
+
+// Execute marks the cases in breach as of now, publishing
+// fraud.case.sla.breached for each, and returns how many it marked.
+func (uc *DetectCaseSLABreaches) Execute(ctx context.Context, now time.Time) (int, error) {
+	cases, err := uc.repo.ListSLABreached(ctx, now, slaBreachBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list cases in breach: %w", err)
+	}
+	var marked int
+	for _, c := range cases {
+		if !c.MarkSLABreached(now) {
+			continue
+		}
+		if err := saveCase(ctx, uc.repo, uc.publisher, c); err != nil {
+			return marked, err
+		}
+		marked++
+	}
+	return marked, nil
+}
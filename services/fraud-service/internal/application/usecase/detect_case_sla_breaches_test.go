@@ -0,0 +1,36 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+)
+
+func TestDetectCaseSLABreaches_Execute(t *testing.T) {
+	repo := newMockCaseRepository()
+	publisher := &mockFraudEventPublisher{}
+	c, _ := seedReviewCase(t, repo, newMockLabelRepository())
+	uc := usecase.NewDetectCaseSLABreaches(repo, publisher)
+
+	marked, err := uc.Execute(context.Background(), time.Now().UTC())
+	require.NoError(t, err)
+	assert.Zero(t, marked, "the case is still within its SLA")
+
+	overdue := c.SLADueAt().Add(time.Minute)
+	marked, err = uc.Execute(context.Background(), overdue)
+	require.NoError(t, err)
+	assert.Equal(t, 1, marked)
+	assert.Equal(t, overdue, repo.cases[c.ID()].SLABreachedAt())
+	require.Len(t, publisher.publishedEvents, 1)
+	assert.Equal(t, "fraud.case.sla.breached", publisher.publishedEvents[0].EventType())
+
+	marked, err = uc.Execute(context.Background(), overdue.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Zero(t, marked, "a breach is announced once")
+	assert.Len(t, publisher.publishedEvents, 1)
+}
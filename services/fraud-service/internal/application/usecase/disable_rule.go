@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// DisableRule is the use case for taking a fraud rule out of scoring.
+type DisableRule struct {
+	repo      port.RuleRepository
+	publisher port.EventPublisher
+	reload    *ReloadRules
+	logger    *slog.Logger
+}
+
+// NewDisableRule creates a new DisableRule use case.
+func NewDisableRule(repo port.RuleRepository, publisher port.EventPublisher, reload *ReloadRules, logger *slog.Logger) *DisableRule {
+	return &DisableRule{
+		repo:      repo,
+		publisher: publisher,
+		reload:    reload,
+		logger:    logger,
+	}
+}
+
+// Execute disables the rule, creating a new version, and reloads the engine
+// so the rule stops firing at once.
+func (uc *DisableRule) Execute(ctx context.Context, req dto.DisableRuleRequest) (dto.RuleResponse, error) {
+	rule, err := uc.repo.FindByID(ctx, req.TenantID, req.RuleID)
+	if err != nil {
+		return dto.RuleResponse{}, fmt.Errorf("failed to find rule: %w", err)
+	}
+	if rule == nil {
+		return dto.RuleResponse{}, fmt.Errorf("%w: rule %s", ErrNotFound, req.RuleID)
+	}
+
+	if err := rule.Disable(req.ActorID); err != nil {
+		return dto.RuleResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	if err := uc.repo.Save(ctx, rule); err != nil {
+		return dto.RuleResponse{}, fmt.Errorf("failed to save rule: %w", err)
+	}
+
+	if events := rule.DomainEvents(); len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, events...); err != nil {
+			return dto.RuleResponse{}, fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+
+	reloadAfterChange(ctx, uc.reload, uc.logger)
+
+	return dto.FromRuleModel(rule), nil
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/service"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// DryRunRule is the use case for testing a proposed rule against a sample
+// transaction before saving it. For evaluation against live traffic, save
+// the rule in SHADOW mode instead.
+type DryRunRule struct {
+	engine *service.RuleEngine
+}
+
+// NewDryRunRule creates a new DryRunRule use case.
+func NewDryRunRule(engine *service.RuleEngine) *DryRunRule {
+	return &DryRunRule{engine: engine}
+}
+
+// Execute compiles the proposed rule and evaluates it alongside the tenant's
+// current rules.
+func (uc *DryRunRule) Execute(_ context.Context, req dto.DryRunRuleRequest) (dto.DryRunRuleResponse, error) {
+	rule, err := model.NewFraudRule(req.TenantID, "dry-run", model.RuleDefinition{
+		Condition:   req.Condition,
+		Signal:      req.Signal,
+		ScoreImpact: req.ScoreImpact,
+		Mode:        valueobject.RuleModeActive,
+	}, uuid.Nil)
+	if err != nil {
+		return dto.DryRunRuleResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	input := service.RiskInput{
+		TenantID:        req.TenantID,
+		Amount:          req.Amount,
+		Currency:        req.Currency,
+		TransactionType: req.TransactionType,
+		Metadata:        req.Metadata,
+	}
+	current := uc.engine.Score(input)
+	matched := service.EvaluateRule(rule, input)
+
+	projected := current.Score
+	if matched {
+		projected = min(max(projected+rule.ScoreImpact(), 0), 100)
+	}
+
+	return dto.DryRunRuleResponse{
+		Matched:        matched,
+		CurrentScore:   current.Score,
+		CurrentSignals: current.Signals,
+		ProjectedScore: projected,
+	}, nil
+}
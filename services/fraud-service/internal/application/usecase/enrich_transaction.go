@@ -0,0 +1,224 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// Feature namespaces written by enrichment. Rule conditions read them as
+// device.<feature>, ip.<feature>, link.<feature> and auth.<feature>.
+const (
+	deviceFeaturePrefix = "device_"
+	ipFeaturePrefix     = "ip_"
+	linkFeaturePrefix   = "link_"
+	authFeaturePrefix   = "auth_"
+)
+
+// authAnomalyLookback is how far back failed-authentication anomalies from
+// the transaction's IP address count towards its risk.
+const authAnomalyLookback = 24 * time.Hour
+
+// Enrichment is the device, IP and link graph context derived for a transaction.
+type Enrichment struct {
+	// Features are merged into the transaction metadata before scoring.
+	Features map[string]string
+	// Device is the account's device profile with this sighting applied, or
+	// nil when the request carried no fingerprint.
+	Device *model.DeviceProfile
+	// Links are the link graph edges the transaction adds.
+	Links []model.AccountLink
+}
+
+// EnrichTransaction derives device, IP and link risk features for an
+// assessment request: whether the device is new to the account, how widely
+// it is shared across accounts, the geo, ASN and proxy status of the IP
+// address, how many confirmed-fraud accounts share the device, IP or
+// destination account, and whether the IP address was recently stepped up
+// or locked out at the gateway for failing authentication.
+//
+// Enrichment is best-effort. Lookup failures are logged and the transaction
+// is scored on the features that could be derived.
+type EnrichTransaction struct {
+	devices       port.DeviceProfileRepository
+	ipIntel       port.IPIntelligence
+	links         port.LinkGraphRepository
+	authAnomalies port.AuthAnomalyRepository
+	logger        *slog.Logger
+}
+
+// NewEnrichTransaction creates a new EnrichTransaction use case.
+func NewEnrichTransaction(devices port.DeviceProfileRepository, ipIntel port.IPIntelligence, links port.LinkGraphRepository, authAnomalies port.AuthAnomalyRepository, logger *slog.Logger) *EnrichTransaction {
+	return &EnrichTransaction{devices: devices, ipIntel: ipIntel, links: links, authAnomalies: authAnomalies, logger: logger}
+}
+
+// Execute derives the enrichment features for req.
+func (uc *EnrichTransaction) Execute(ctx context.Context, req dto.AssessTransactionRequest) Enrichment {
+	out := Enrichment{Features: make(map[string]string)}
+	now := time.Now().UTC()
+
+	var ipInfo model.IPInfo
+	ip := strings.TrimSpace(req.IPAddress)
+	var addr netip.Addr
+	if ip != "" {
+		var err error
+		if addr, err = netip.ParseAddr(ip); err != nil {
+			uc.logger.Warn("ignoring invalid IP address", "transaction_id", req.TransactionID, "error", err)
+			ip = ""
+		}
+	}
+	if ip != "" {
+		info, err := uc.ipIntel.Lookup(ctx, ip)
+		if err != nil {
+			uc.logger.Warn("IP intelligence lookup failed", "transaction_id", req.TransactionID, "error", err)
+		} else {
+			ipInfo = info
+			addIPFeatures(out.Features, info, req.Metadata["source_country"])
+		}
+
+		summary, err := uc.authAnomalies.SummarizeIP(ctx, addr.String(), now.Add(-authAnomalyLookback))
+		if err != nil {
+			uc.logger.Warn("auth anomaly lookup failed", "transaction_id", req.TransactionID, "error", err)
+		} else {
+			out.Features[authFeaturePrefix+"ip_step_ups"] = strconv.Itoa(summary.StepUps)
+			out.Features[authFeaturePrefix+"ip_lockouts"] = strconv.Itoa(summary.Lockouts)
+			out.Features[authFeaturePrefix+"ip_max_failures"] = strconv.Itoa(summary.MaxFailures)
+		}
+	}
+
+	fingerprint := strings.TrimSpace(req.DeviceFingerprint)
+	out.Links = model.TransactionLinks(req.TenantID, req.AccountID, fingerprint, ip, req.DestinationAccount, now)
+	if len(out.Links) > 0 {
+		linked, err := uc.links.FraudLinkedAccounts(ctx, req.TenantID, req.AccountID, model.Entities(out.Links))
+		if err != nil {
+			uc.logger.Warn("link graph lookup failed", "transaction_id", req.TransactionID, "error", err)
+		} else {
+			addLinkFeatures(out.Features, linked)
+		}
+	}
+
+	if fingerprint == "" {
+		return out
+	}
+
+	profile, err := uc.devices.Find(ctx, req.TenantID, req.AccountID, fingerprint)
+	if err != nil {
+		uc.logger.Warn("device profile lookup failed", "transaction_id", req.TransactionID, "error", err)
+		return out
+	}
+
+	if profile == nil {
+		profile, err = model.NewDeviceProfile(req.TenantID, req.AccountID, fingerprint, ip, ipInfo.Country, now)
+		if err != nil {
+			uc.logger.Warn("failed to create device profile", "transaction_id", req.TransactionID, "error", err)
+			return out
+		}
+		out.Features[deviceFeaturePrefix+"new"] = "true"
+		out.Features[deviceFeaturePrefix+"seen_count"] = "0"
+		out.Features[deviceFeaturePrefix+"age_hours"] = "0"
+	} else {
+		out.Features[deviceFeaturePrefix+"new"] = "false"
+		out.Features[deviceFeaturePrefix+"seen_count"] = strconv.Itoa(profile.SeenCount())
+		out.Features[deviceFeaturePrefix+"age_hours"] = strconv.Itoa(int(profile.Age(now).Hours()))
+		if ip != "" && profile.LastIPCountry() != "" && ipInfo.Country != "" {
+			out.Features[deviceFeaturePrefix+"country_changed"] = strconv.FormatBool(!strings.EqualFold(profile.LastIPCountry(), ipInfo.Country))
+		}
+		profile.RecordSighting(ip, ipInfo.Country, now)
+	}
+	out.Device = profile
+
+	accounts, err := uc.devices.CountAccounts(ctx, req.TenantID, fingerprint)
+	if err != nil {
+		uc.logger.Warn("device account count failed", "transaction_id", req.TransactionID, "error", err)
+	} else {
+		if out.Features[deviceFeaturePrefix+"new"] == "true" {
+			accounts++
+		}
+		out.Features[deviceFeaturePrefix+"account_count"] = strconv.Itoa(accounts)
+	}
+
+	return out
+}
+
+// Record persists the device sighting and link graph edges from an enrichment.
+func (uc *EnrichTransaction) Record(ctx context.Context, e Enrichment) error {
+	var errs []error
+	if e.Device != nil {
+		if err := uc.devices.Save(ctx, e.Device); err != nil {
+			errs = append(errs, fmt.Errorf("failed to save device profile: %w", err))
+		}
+	}
+	if len(e.Links) > 0 {
+		if err := uc.links.RecordLinks(ctx, e.Links); err != nil {
+			errs = append(errs, fmt.Errorf("failed to record account links: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// addLinkFeatures counts the distinct confirmed-fraud accounts linked to the
+// transaction, in total and per shared entity type.
+func addLinkFeatures(features map[string]string, linked []model.LinkedAccount) {
+	byType := map[string]int{
+		model.LinkDevice:             0,
+		model.LinkIP:                 0,
+		model.LinkDestinationAccount: 0,
+	}
+	for _, a := range linked {
+		seen := make(map[string]bool, len(a.Shared))
+		for _, e := range a.Shared {
+			if !seen[e.Type] {
+				seen[e.Type] = true
+				byType[e.Type]++
+			}
+		}
+	}
+	features[linkFeaturePrefix+"fraud_accounts"] = strconv.Itoa(len(linked))
+	features[linkFeaturePrefix+"fraud_device_accounts"] = strconv.Itoa(byType[model.LinkDevice])
+	features[linkFeaturePrefix+"fraud_ip_accounts"] = strconv.Itoa(byType[model.LinkIP])
+	features[linkFeaturePrefix+"fraud_destination_accounts"] = strconv.Itoa(byType[model.LinkDestinationAccount])
+}
+
+func addIPFeatures(features map[string]string, info model.IPInfo, sourceCountry string) {
+	if info.Country != "" {
+		features[ipFeaturePrefix+"country"] = info.Country
+		if sourceCountry != "" {
+			features[ipFeaturePrefix+"country_mismatch"] = strconv.FormatBool(!strings.EqualFold(info.Country, sourceCountry))
+		}
+	}
+	if info.ASN != 0 {
+		features[ipFeaturePrefix+"asn"] = strconv.Itoa(info.ASN)
+	}
+	features[ipFeaturePrefix+"proxy"] = strconv.FormatBool(info.IsProxy)
+	features[ipFeaturePrefix+"vpn"] = strconv.FormatBool(info.IsVPN)
+	features[ipFeaturePrefix+"tor"] = strconv.FormatBool(info.IsTor)
+	features[ipFeaturePrefix+"hosting"] = strconv.FormatBool(info.IsHosting)
+	features[ipFeaturePrefix+"anonymized"] = strconv.FormatBool(info.Anonymized())
+}
+
+// withEnrichment returns the request metadata merged with enrichment
+// features. Caller-supplied keys in the enrichment namespaces are dropped so
+// clients cannot vouch for their own device, IP, links or authentication
+// history.
+func withEnrichment(metadata map[string]string, e Enrichment) map[string]string {
+	merged := make(map[string]string, len(metadata)+len(e.Features))
+	for k, v := range metadata {
+		if strings.HasPrefix(k, deviceFeaturePrefix) || strings.HasPrefix(k, ipFeaturePrefix) || strings.HasPrefix(k, linkFeaturePrefix) || strings.HasPrefix(k, authFeaturePrefix) {
+			continue
+		}
+		merged[k] = v
+	}
+	for k, v := range e.Features {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,336 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/service"
+)
+
+type mockDeviceRepository struct {
+	profiles map[string]*model.DeviceProfile
+}
+
+func newMockDeviceRepository() *mockDeviceRepository {
+	return &mockDeviceRepository{profiles: make(map[string]*model.DeviceProfile)}
+}
+
+func deviceKey(tenantID, accountID uuid.UUID, fingerprint string) string {
+	return tenantID.String() + "/" + accountID.String() + "/" + fingerprint
+}
+
+func (m *mockDeviceRepository) Save(_ context.Context, d *model.DeviceProfile) error {
+	m.profiles[deviceKey(d.TenantID(), d.AccountID(), d.Fingerprint())] = d
+	return nil
+}
+
+func (m *mockDeviceRepository) Find(_ context.Context, tenantID, accountID uuid.UUID, fingerprint string) (*model.DeviceProfile, error) {
+	return m.profiles[deviceKey(tenantID, accountID, fingerprint)], nil
+}
+
+func (m *mockDeviceRepository) CountAccounts(_ context.Context, tenantID uuid.UUID, fingerprint string) (int, error) {
+	n := 0
+	for _, d := range m.profiles {
+		if d.TenantID() == tenantID && d.Fingerprint() == fingerprint {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (m *mockDeviceRepository) ListByAccount(_ context.Context, tenantID, accountID uuid.UUID) ([]*model.DeviceProfile, error) {
+	var result []*model.DeviceProfile
+	for _, d := range m.profiles {
+		if d.TenantID() == tenantID && d.AccountID() == accountID {
+			result = append(result, d)
+		}
+	}
+	return result, nil
+}
+
+type stubIPIntel struct {
+	err  error
+	info model.IPInfo
+}
+
+func (s *stubIPIntel) Lookup(_ context.Context, _ string) (model.IPInfo, error) {
+	return s.info, s.err
+}
+
+type stubAuthAnomalies struct {
+	err     error
+	summary model.AuthAnomalySummary
+	ip      string
+}
+
+func (s *stubAuthAnomalies) Record(_ context.Context, _ model.AuthAnomaly) error { return nil }
+
+func (s *stubAuthAnomalies) SummarizeIP(_ context.Context, ip string, _ time.Time) (model.AuthAnomalySummary, error) {
+	s.ip = ip
+	return s.summary, s.err
+}
+
+// mockLinkGraph links accounts through the recorded edges. Accounts in
+// fraud are treated as confirmed fraud.
+type mockLinkGraph struct {
+	err   error
+	fraud map[uuid.UUID]bool
+	links []model.AccountLink
+}
+
+func (m *mockLinkGraph) RecordLinks(_ context.Context, links []model.AccountLink) error {
+	m.links = append(m.links, links...)
+	return m.err
+}
+
+func (m *mockLinkGraph) FraudLinkedAccounts(_ context.Context, tenantID, accountID uuid.UUID, entities []model.LinkEntity) ([]model.LinkedAccount, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	wanted := make(map[model.LinkEntity]bool, len(entities))
+	for _, e := range entities {
+		wanted[e] = true
+	}
+	var out []model.LinkedAccount
+	for _, a := range m.linked(tenantID, accountID, wanted) {
+		if a.ConfirmedFraud {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockLinkGraph) LinkedAccounts(_ context.Context, tenantID, accountID uuid.UUID, limit int) ([]model.LinkedAccount, error) {
+	own := make(map[model.LinkEntity]bool)
+	for _, l := range m.links {
+		if l.TenantID == tenantID && l.AccountID == accountID {
+			own[l.Entity] = true
+		}
+	}
+	out := m.linked(tenantID, accountID, own)
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (m *mockLinkGraph) linked(tenantID, accountID uuid.UUID, entities map[model.LinkEntity]bool) []model.LinkedAccount {
+	byAccount := make(map[uuid.UUID]*model.LinkedAccount)
+	var order []uuid.UUID
+	for _, l := range m.links {
+		if l.TenantID != tenantID || l.AccountID == accountID || !entities[l.Entity] {
+			continue
+		}
+		a, ok := byAccount[l.AccountID]
+		if !ok {
+			a = &model.LinkedAccount{AccountID: l.AccountID, ConfirmedFraud: m.fraud[l.AccountID]}
+			byAccount[l.AccountID] = a
+			order = append(order, l.AccountID)
+		}
+		a.Shared = append(a.Shared, l.Entity)
+	}
+	out := make([]model.LinkedAccount, 0, len(order))
+	for _, id := range order {
+		out = append(out, *byAccount[id])
+	}
+	return out
+}
+
+// noEnrichment returns an enricher with no device history, IP intelligence or links.
+func noEnrichment() *usecase.EnrichTransaction {
+	return usecase.NewEnrichTransaction(newMockDeviceRepository(), &stubIPIntel{}, &mockLinkGraph{}, &stubAuthAnomalies{}, slog.Default())
+}
+
+func TestEnrichTransaction_Execute(t *testing.T) {
+	t.Run("flags a device new to the account", func(t *testing.T) {
+		devices := newMockDeviceRepository()
+		uc := usecase.NewEnrichTransaction(devices, &stubIPIntel{}, &mockLinkGraph{}, &stubAuthAnomalies{}, slog.Default())
+
+		req := validAssessRequest()
+		req.DeviceFingerprint = "fp-1"
+		e := uc.Execute(context.Background(), req)
+
+		assert.Equal(t, "true", e.Features["device_new"])
+		assert.Equal(t, "1", e.Features["device_account_count"])
+		require.NotNil(t, e.Device)
+		assert.Equal(t, 1, e.Device.SeenCount())
+	})
+
+	t.Run("reports history for a known device", func(t *testing.T) {
+		devices := newMockDeviceRepository()
+		req := validAssessRequest()
+		req.DeviceFingerprint = "fp-1"
+		req.IPAddress = "203.0.113.7"
+		known, err := model.NewDeviceProfile(req.TenantID, req.AccountID, "fp-1", "198.51.100.2", "GB", time.Now().UTC().Add(-48*time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, devices.Save(context.Background(), known))
+
+		uc := usecase.NewEnrichTransaction(devices, &stubIPIntel{info: model.IPInfo{Country: "NG"}}, &mockLinkGraph{}, &stubAuthAnomalies{}, slog.Default())
+		e := uc.Execute(context.Background(), req)
+
+		assert.Equal(t, "false", e.Features["device_new"])
+		assert.Equal(t, "1", e.Features["device_seen_count"])
+		assert.Equal(t, "48", e.Features["device_age_hours"])
+		assert.Equal(t, "true", e.Features["device_country_changed"])
+		assert.Equal(t, 2, e.Device.SeenCount())
+		assert.Equal(t, "NG", e.Device.LastIPCountry())
+	})
+
+	t.Run("adds IP intelligence features", func(t *testing.T) {
+		uc := usecase.NewEnrichTransaction(newMockDeviceRepository(), &stubIPIntel{
+			info: model.IPInfo{Country: "RU", ASN: 12345, IsTor: true},
+		}, &mockLinkGraph{}, &stubAuthAnomalies{}, slog.Default())
+
+		req := validAssessRequest()
+		req.IPAddress = "203.0.113.7"
+		req.Metadata = map[string]string{"source_country": "US"}
+		e := uc.Execute(context.Background(), req)
+
+		assert.Equal(t, "RU", e.Features["ip_country"])
+		assert.Equal(t, "12345", e.Features["ip_asn"])
+		assert.Equal(t, "true", e.Features["ip_tor"])
+		assert.Equal(t, "true", e.Features["ip_anonymized"])
+		assert.Equal(t, "true", e.Features["ip_country_mismatch"])
+		assert.Nil(t, e.Device)
+	})
+
+	t.Run("degrades gracefully when lookups fail", func(t *testing.T) {
+		uc := usecase.NewEnrichTransaction(newMockDeviceRepository(), &stubIPIntel{err: fmt.Errorf("timeout")}, &mockLinkGraph{err: fmt.Errorf("timeout")}, &stubAuthAnomalies{err: fmt.Errorf("timeout")}, slog.Default())
+
+		req := validAssessRequest()
+		req.IPAddress = "not-an-ip"
+		e := uc.Execute(context.Background(), req)
+		assert.Empty(t, e.Features)
+
+		req.IPAddress = "203.0.113.7"
+		e = uc.Execute(context.Background(), req)
+		assert.Empty(t, e.Features)
+	})
+}
+
+func TestAssessTransaction_DeviceRisk(t *testing.T) {
+	t.Run("raises risk for a new device with a high amount", func(t *testing.T) {
+		devices := newMockDeviceRepository()
+		enrich := usecase.NewEnrichTransaction(devices, &stubIPIntel{}, &mockLinkGraph{}, &stubAuthAnomalies{}, slog.Default())
+		uc := usecase.NewAssessTransaction(&mockAssessmentRepository{}, &mockFraudEventPublisher{}, service.NewRuleEngine(), &mockRuleHitRepository{}, newMockCaseRepository(), service.NewSanctionsScreener(0), &mockScreeningRepository{}, &mockFlaggedPartyRepository{}, enrich, service.NewPolicyResolver(), slog.Default())
+
+		req := validAssessRequest()
+		req.Amount = decimal.NewFromInt(8000)
+		req.DeviceFingerprint = "fp-new"
+		resp, err := uc.Execute(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.Contains(t, resp.RiskSignals, "new_device_high_value")
+		assert.Len(t, devices.profiles, 1, "the device sighting is persisted")
+
+		// The same device is no longer new on the next transaction.
+		req.TransactionID = uuid.New()
+		resp, err = uc.Execute(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.NotContains(t, resp.RiskSignals, "new_device_high_value")
+	})
+
+	t.Run("ignores client-supplied enrichment features", func(t *testing.T) {
+		uc := usecase.NewAssessTransaction(&mockAssessmentRepository{}, &mockFraudEventPublisher{}, service.NewRuleEngine(), &mockRuleHitRepository{}, newMockCaseRepository(), service.NewSanctionsScreener(0), &mockScreeningRepository{}, &mockFlaggedPartyRepository{}, noEnrichment(), service.NewPolicyResolver(), slog.Default())
+
+		req := validAssessRequest()
+		req.Metadata = map[string]string{"ip_anonymized": "true"}
+		resp, err := uc.Execute(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.NotContains(t, resp.RiskSignals, "anonymized_ip")
+	})
+}
+
+func TestAssessTransaction_AuthAnomalyRisk(t *testing.T) {
+	anomalies := &stubAuthAnomalies{summary: model.AuthAnomalySummary{StepUps: 1, Lockouts: 1, MaxFailures: 12}}
+	enrich := usecase.NewEnrichTransaction(newMockDeviceRepository(), &stubIPIntel{}, &mockLinkGraph{}, anomalies, slog.Default())
+
+	t.Run("derives auth features from the IP address", func(t *testing.T) {
+		req := validAssessRequest()
+		req.IPAddress = " 2001:DB8::1 "
+		e := enrich.Execute(context.Background(), req)
+
+		assert.Equal(t, "2001:db8::1", anomalies.ip)
+		assert.Equal(t, "1", e.Features["auth_ip_step_ups"])
+		assert.Equal(t, "1", e.Features["auth_ip_lockouts"])
+		assert.Equal(t, "12", e.Features["auth_ip_max_failures"])
+	})
+
+	t.Run("raises risk for a locked-out IP", func(t *testing.T) {
+		uc := usecase.NewAssessTransaction(&mockAssessmentRepository{}, &mockFraudEventPublisher{}, service.NewRuleEngine(), &mockRuleHitRepository{}, newMockCaseRepository(), service.NewSanctionsScreener(0), &mockScreeningRepository{}, &mockFlaggedPartyRepository{}, enrich, service.NewPolicyResolver(), slog.Default())
+
+		req := validAssessRequest()
+		req.IPAddress = "203.0.113.7"
+		resp, err := uc.Execute(context.Background(), req)
+		require.NoError(t, err)
+		assert.Contains(t, resp.RiskSignals, "auth_lockout_ip")
+
+		// Without an IP address there is nothing to correlate.
+		req.TransactionID = uuid.New()
+		req.IPAddress = ""
+		req.Metadata = map[string]string{"auth_ip_lockouts": "3"}
+		resp, err = uc.Execute(context.Background(), req)
+		require.NoError(t, err)
+		assert.NotContains(t, resp.RiskSignals, "auth_lockout_ip")
+	})
+}
+
+func TestAssessTransaction_LinkRisk(t *testing.T) {
+	tenantID := uuid.New()
+	fraudster := uuid.New()
+	graph := &mockLinkGraph{fraud: map[uuid.UUID]bool{fraudster: true}}
+	require.NoError(t, graph.RecordLinks(context.Background(), model.TransactionLinks(tenantID, fraudster, "fp-shared", "203.0.113.7", "mule-001", time.Now())))
+
+	enrich := usecase.NewEnrichTransaction(newMockDeviceRepository(), &stubIPIntel{}, graph, &stubAuthAnomalies{}, slog.Default())
+	uc := usecase.NewAssessTransaction(&mockAssessmentRepository{}, &mockFraudEventPublisher{}, service.NewRuleEngine(), &mockRuleHitRepository{}, newMockCaseRepository(), service.NewSanctionsScreener(0), &mockScreeningRepository{}, &mockFlaggedPartyRepository{}, enrich, service.NewPolicyResolver(), slog.Default())
+
+	t.Run("derives link features from confirmed-fraud neighbours", func(t *testing.T) {
+		req := validAssessRequest()
+		req.TenantID = tenantID
+		req.DeviceFingerprint = "fp-shared"
+		req.IPAddress = "203.0.113.7"
+		e := enrich.Execute(context.Background(), req)
+
+		assert.Equal(t, "1", e.Features["link_fraud_accounts"])
+		assert.Equal(t, "1", e.Features["link_fraud_device_accounts"])
+		assert.Equal(t, "1", e.Features["link_fraud_ip_accounts"])
+		assert.Equal(t, "0", e.Features["link_fraud_destination_accounts"])
+		assert.Len(t, e.Links, 2)
+	})
+
+	t.Run("flags a payment to a beneficiary used by a fraud account", func(t *testing.T) {
+		req := validAssessRequest()
+		req.TenantID = tenantID
+		req.DestinationAccount = "mule-001"
+		resp, err := uc.Execute(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.Contains(t, resp.RiskSignals, "fraud_link")
+
+		linked, err := graph.LinkedAccounts(context.Background(), tenantID, req.AccountID, 10)
+		require.NoError(t, err)
+		require.Len(t, linked, 1, "the transaction's edges are recorded")
+		assert.Equal(t, fraudster, linked[0].AccountID)
+	})
+
+	t.Run("other tenants are not linked", func(t *testing.T) {
+		req := validAssessRequest()
+		req.DestinationAccount = "mule-001"
+		resp, err := uc.Execute(context.Background(), req)
+
+		require.NoError(t, err)
+		assert.NotContains(t, resp.RiskSignals, "fraud_link")
+	})
+}
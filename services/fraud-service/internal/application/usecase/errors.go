@@ -0,0 +1,10 @@
+package usecase
+
+import "errors"
+
+var (
+	// ErrNotFound is returned when a requested resource does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidInput is returned when a request fails domain validation.
+	ErrInvalidInput = errors.New("invalid input")
+)
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// EscalateCase is the use case for escalating a case to senior review.
+type EscalateCase struct {
+	repo port.CaseRepository
+}
+
+// NewEscalateCase creates a new EscalateCase use case.
+func NewEscalateCase(repo port.CaseRepository) *EscalateCase {
+	return &EscalateCase{repo: repo}
+}
+
+// Execute escalates the case, recording the reason as a note.
+func (uc *EscalateCase) Execute(ctx context.Context, req dto.EscalateCaseRequest) (dto.CaseResponse, error) {
+	c, err := loadCase(ctx, uc.repo, req.TenantID, req.CaseID)
+	if err != nil {
+		return dto.CaseResponse{}, err
+	}
+
+	if err := c.Escalate(req.ActorID, req.Reason); err != nil {
+		return dto.CaseResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	if err := uc.repo.Save(ctx, c); err != nil {
+		return dto.CaseResponse{}, fmt.Errorf("failed to save case: %w", err)
+	}
+	return dto.FromCaseModel(c, time.Now().UTC()), nil
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// maxExportWindow bounds a single export so it fits comfortably in memory.
+const maxExportWindow = 366 * 24 * time.Hour
+
+// ExportTrainingDataset is the use case for exporting labeled assessments
+// as a JSON Lines dataset for model retraining.
+type ExportTrainingDataset struct {
+	labels port.LabelRepository
+	store  port.DatasetStore
+}
+
+// NewExportTrainingDataset creates a new ExportTrainingDataset use case.
+func NewExportTrainingDataset(labels port.LabelRepository, store port.DatasetStore) *ExportTrainingDataset {
+	return &ExportTrainingDataset{labels: labels, store: store}
+}
+
+// Execute writes one JSON object per labeled assessment to the dataset store.
+func (uc *ExportTrainingDataset) Execute(ctx context.Context, req dto.ExportDatasetRequest) (dto.ExportDatasetResponse, error) {
+	if req.From.IsZero() || req.To.IsZero() || !req.From.Before(req.To) {
+		return dto.ExportDatasetResponse{}, fmt.Errorf("%w: from must be before to", ErrInvalidInput)
+	}
+	if req.To.Sub(req.From) > maxExportWindow {
+		return dto.ExportDatasetResponse{}, fmt.Errorf("%w: export window cannot exceed %s", ErrInvalidInput, maxExportWindow)
+	}
+
+	examples, err := uc.labels.ListTrainingExamples(ctx, req.TenantID, req.From, req.To)
+	if err != nil {
+		return dto.ExportDatasetResponse{}, fmt.Errorf("failed to list training examples: %w", err)
+	}
+
+	var (
+		buf  bytes.Buffer
+		resp dto.ExportDatasetResponse
+	)
+	enc := json.NewEncoder(&buf)
+	for _, ex := range examples {
+		if err := enc.Encode(ex); err != nil {
+			return dto.ExportDatasetResponse{}, fmt.Errorf("failed to encode training example: %w", err)
+		}
+		resp.Rows++
+		switch ex.Label {
+		case valueobject.LabelFraud.String():
+			resp.FraudRows++
+		case valueobject.LabelLegitimate.String():
+			resp.LegitimateRows++
+		}
+	}
+
+	key := fmt.Sprintf("fraud-training/%s/%s_%s/%s.jsonl",
+		req.TenantID,
+		req.From.UTC().Format("20060102"),
+		req.To.UTC().Format("20060102"),
+		time.Now().UTC().Format("20060102T150405Z"),
+	)
+	uri, err := uc.store.Put(ctx, key, buf.Bytes())
+	if err != nil {
+		return dto.ExportDatasetResponse{}, fmt.Errorf("failed to write dataset: %w", err)
+	}
+	resp.URI = uri
+
+	return resp, nil
+}
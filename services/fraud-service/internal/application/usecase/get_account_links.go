@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+const (
+	// defaultAccountLinksLimit is the number of linked accounts returned when
+	// the request leaves the limit unset.
+	defaultAccountLinksLimit = 50
+	// maxAccountLinksLimit bounds the result for hub entities such as a
+	// shared office IP.
+	maxAccountLinksLimit = 500
+)
+
+// GetAccountLinks is the use case for exploring the link graph around an
+// account: the accounts sharing a device, IP address or destination account
+// with it, and which of them are confirmed fraud.
+type GetAccountLinks struct {
+	repo port.LinkGraphRepository
+}
+
+// NewGetAccountLinks creates a new GetAccountLinks use case.
+func NewGetAccountLinks(repo port.LinkGraphRepository) *GetAccountLinks {
+	return &GetAccountLinks{repo: repo}
+}
+
+// Execute returns the accounts linked to the requested account,
+// confirmed-fraud accounts first.
+func (uc *GetAccountLinks) Execute(ctx context.Context, req dto.GetAccountLinksRequest) (dto.AccountLinksResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultAccountLinksLimit
+	}
+	if limit < 0 || limit > maxAccountLinksLimit {
+		return dto.AccountLinksResponse{}, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidInput, maxAccountLinksLimit)
+	}
+
+	linked, err := uc.repo.LinkedAccounts(ctx, req.TenantID, req.AccountID, limit)
+	if err != nil {
+		return dto.AccountLinksResponse{}, fmt.Errorf("failed to list linked accounts: %w", err)
+	}
+
+	resp := dto.AccountLinksResponse{
+		AccountID: req.AccountID,
+		Linked:    make([]dto.LinkedAccountResponse, 0, len(linked)),
+	}
+	for _, a := range linked {
+		if a.ConfirmedFraud {
+			resp.ConfirmedFraudCount++
+		} else if req.FraudOnly {
+			continue
+		}
+		resp.Linked = append(resp.Linked, dto.FromLinkedAccount(a))
+	}
+	return resp, nil
+}
@@ -0,0 +1,55 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+func TestGetAccountLinks_Execute(t *testing.T) {
+	tenantID := uuid.New()
+	account, fraudster, sibling := uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+
+	graph := &mockLinkGraph{fraud: map[uuid.UUID]bool{fraudster: true}}
+	for _, links := range [][]model.AccountLink{
+		model.TransactionLinks(tenantID, account, "fp-1", "198.51.100.4", "", now),
+		model.TransactionLinks(tenantID, fraudster, "fp-1", "", "", now),
+		model.TransactionLinks(tenantID, sibling, "", "198.51.100.4", "", now),
+	} {
+		require.NoError(t, graph.RecordLinks(context.Background(), links))
+	}
+	uc := usecase.NewGetAccountLinks(graph)
+
+	t.Run("lists accounts sharing an entity", func(t *testing.T) {
+		resp, err := uc.Execute(context.Background(), dto.GetAccountLinksRequest{TenantID: tenantID, AccountID: account})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, resp.ConfirmedFraudCount)
+		require.Len(t, resp.Linked, 2)
+		assert.Equal(t, fraudster, resp.Linked[0].AccountID)
+		assert.True(t, resp.Linked[0].ConfirmedFraud)
+		assert.Equal(t, []dto.LinkEntityResponse{{Type: model.LinkDevice, Value: "fp-1"}}, resp.Linked[0].Shared)
+	})
+
+	t.Run("filters to confirmed fraud", func(t *testing.T) {
+		resp, err := uc.Execute(context.Background(), dto.GetAccountLinksRequest{TenantID: tenantID, AccountID: account, FraudOnly: true})
+		require.NoError(t, err)
+		require.Len(t, resp.Linked, 1)
+		assert.Equal(t, fraudster, resp.Linked[0].AccountID)
+	})
+
+	t.Run("rejects an out-of-range limit", func(t *testing.T) {
+		_, err := uc.Execute(context.Background(), dto.GetAccountLinksRequest{TenantID: tenantID, AccountID: account, Limit: 10000})
+		assert.True(t, errors.Is(err, usecase.ErrInvalidInput))
+	})
+}
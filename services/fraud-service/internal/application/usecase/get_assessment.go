@@ -25,7 +25,7 @@ func (uc *GetAssessment) Execute(ctx context.Context, req dto.GetAssessmentReque
 		return dto.AssessmentResponse{}, fmt.Errorf("failed to find assessment: %w", err)
 	}
 	if assessment == nil {
-		return dto.AssessmentResponse{}, fmt.Errorf("assessment not found: %s", req.AssessmentID)
+		return dto.AssessmentResponse{}, fmt.Errorf("%w: assessment %s", ErrNotFound, req.AssessmentID)
 	}
 
 	return dto.FromModel(assessment), nil
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+const (
+	// defaultMetricsPeriod is the reporting window when the request leaves it unset.
+	defaultMetricsPeriod = 7 * 24 * time.Hour
+	// maxMetricsPeriod bounds the reporting window to keep the queries cheap.
+	maxMetricsPeriod = 366 * 24 * time.Hour
+	// scoreBandWidth is the width of each score histogram band.
+	scoreBandWidth = 10
+)
+
+// GetAssessmentMetrics is the use case for the aggregate decision and score
+// metrics behind the fraud monitoring dashboards.
+type GetAssessmentMetrics struct {
+	repo port.AssessmentRepository
+}
+
+// NewGetAssessmentMetrics creates a new GetAssessmentMetrics use case.
+func NewGetAssessmentMetrics(repo port.AssessmentRepository) *GetAssessmentMetrics {
+	return &GetAssessmentMetrics{repo: repo}
+}
+
+// Execute aggregates the tenant's assessments in [From, To). An unset To
+// means now and an unset From means seven days before To.
+func (uc *GetAssessmentMetrics) Execute(ctx context.Context, req dto.AssessmentMetricsRequest) (dto.AssessmentMetricsResponse, error) {
+	to := req.To
+	if to.IsZero() {
+		to = time.Now().UTC()
+	}
+	from := req.From
+	if from.IsZero() {
+		from = to.Add(-defaultMetricsPeriod)
+	}
+	if !from.Before(to) {
+		return dto.AssessmentMetricsResponse{}, fmt.Errorf("%w: from must be before to", ErrInvalidInput)
+	}
+	if to.Sub(from) > maxMetricsPeriod {
+		return dto.AssessmentMetricsResponse{}, fmt.Errorf("%w: period must not exceed 366 days", ErrInvalidInput)
+	}
+
+	buckets, err := uc.repo.ScoreDistribution(ctx, req.TenantID, from, to)
+	if err != nil {
+		return dto.AssessmentMetricsResponse{}, fmt.Errorf("failed to load score distribution: %w", err)
+	}
+	volumes, err := uc.repo.DailyDecisionCounts(ctx, req.TenantID, from, to)
+	if err != nil {
+		return dto.AssessmentMetricsResponse{}, fmt.Errorf("failed to load daily decision counts: %w", err)
+	}
+
+	resp := dto.AssessmentMetricsResponse{
+		From:           from,
+		To:             to,
+		Decisions:      make(map[string]int),
+		RiskLevels:     make(map[string]int),
+		ScoreHistogram: make([]dto.ScoreBand, 0, 100/scoreBandWidth),
+		Daily:          make([]dto.DailyDecisions, 0),
+	}
+	for lo := 0; lo < 100; lo += scoreBandWidth {
+		hi := lo + scoreBandWidth - 1
+		if hi == 99 {
+			hi = 100
+		}
+		resp.ScoreHistogram = append(resp.ScoreHistogram, dto.ScoreBand{Min: lo, Max: hi})
+	}
+
+	var scoreSum int
+	for _, b := range buckets {
+		resp.Total += b.Count
+		resp.Decisions[b.Decision.String()] += b.Count
+		resp.RiskLevels[b.RiskLevel.String()] += b.Count
+		scoreSum += b.Score * b.Count
+		if b.SanctionsHit {
+			resp.SanctionsHitCount += b.Count
+		}
+		band := min(max(b.Score, 0)/scoreBandWidth, len(resp.ScoreHistogram)-1)
+		resp.ScoreHistogram[band].Count += b.Count
+	}
+	if resp.Total > 0 {
+		total := float64(resp.Total)
+		resp.ApprovalRate = float64(resp.Decisions[valueobject.DecisionApprove.String()]) / total
+		resp.ReviewRate = float64(resp.Decisions[valueobject.DecisionReview.String()]) / total
+		resp.DeclineRate = float64(resp.Decisions[valueobject.DecisionDecline.String()]) / total
+		resp.AverageScore = float64(scoreSum) / total
+	}
+
+	for _, v := range volumes {
+		n := len(resp.Daily)
+		if n == 0 || !resp.Daily[n-1].Day.Equal(v.Day) {
+			resp.Daily = append(resp.Daily, dto.DailyDecisions{Day: v.Day})
+			n++
+		}
+		day := &resp.Daily[n-1]
+		switch v.Decision {
+		case valueobject.DecisionApprove:
+			day.Approved += v.Count
+		case valueobject.DecisionReview:
+			day.Review += v.Count
+		case valueobject.DecisionDecline:
+			day.Declined += v.Count
+		}
+	}
+
+	return resp, nil
+}
@@ -27,7 +27,7 @@ func TestGetAssessment_Execute(t *testing.T) {
 			assessmentID, tenantID, uuid.New(), uuid.New(),
 			decimal.NewFromInt(1000), "USD", "transfer",
 			valueobject.RiskLevelLow, 10, valueobject.DecisionApprove,
-			[]string{}, now, 1, now, now,
+			[]string{}, "", 0, now, 1, now, now,
 		)
 
 		repo := &mockAssessmentRepository{
@@ -78,7 +78,6 @@ func TestGetAssessment_Execute(t *testing.T) {
 		req := dto.GetAssessmentRequest{TenantID: uuid.New(), AssessmentID: uuid.New()}
 		_, err := uc.Execute(context.Background(), req)
 
-		require.Error(t, err)
-		assert.Contains(t, err.Error(), "assessment not found")
+		require.ErrorIs(t, err, usecase.ErrNotFound)
 	})
 }
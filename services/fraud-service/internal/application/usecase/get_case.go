@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// GetCase is the use case for retrieving a review case with its notes.
+type GetCase struct {
+	repo port.CaseRepository
+}
+
+// NewGetCase creates a new GetCase use case.
+func NewGetCase(repo port.CaseRepository) *GetCase {
+	return &GetCase{repo: repo}
+}
+
+// Execute retrieves the case.
+func (uc *GetCase) Execute(ctx context.Context, req dto.GetCaseRequest) (dto.CaseResponse, error) {
+	c, err := loadCase(ctx, uc.repo, req.TenantID, req.CaseID)
+	if err != nil {
+		return dto.CaseResponse{}, err
+	}
+	return dto.FromCaseModel(c, time.Now().UTC()), nil
+}
+
+// loadCase fetches a case and maps a missing case to ErrNotFound.
+func loadCase(ctx context.Context, repo port.CaseRepository, tenantID, caseID uuid.UUID) (*model.FraudCase, error) {
+	c, err := repo.FindByID(ctx, tenantID, caseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find case: %w", err)
+	}
+	if c == nil {
+		return nil, fmt.Errorf("%w: case %s", ErrNotFound, caseID)
+	}
+	return c, nil
+}
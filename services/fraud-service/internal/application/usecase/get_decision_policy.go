@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// GetDecisionPolicy is the use case for retrieving a tenant's current
+// decision policy.
+type GetDecisionPolicy struct {
+	repo port.DecisionPolicyRepository
+}
+
+// NewGetDecisionPolicy creates a new GetDecisionPolicy use case.
+func NewGetDecisionPolicy(repo port.DecisionPolicyRepository) *GetDecisionPolicy {
+	return &GetDecisionPolicy{repo: repo}
+}
+
+// Execute returns the policy in force now, falling back to the global
+// default, and any later versions scheduled to take effect in the future.
+func (uc *GetDecisionPolicy) Execute(ctx context.Context, req dto.GetDecisionPolicyRequest) (dto.CurrentDecisionPolicyResponse, error) {
+	versions, err := uc.repo.ListVersions(ctx, req.TenantID)
+	if err != nil {
+		return dto.CurrentDecisionPolicyResponse{}, fmt.Errorf("failed to list decision policies: %w", err)
+	}
+
+	now := time.Now().UTC()
+	resp := dto.CurrentDecisionPolicyResponse{
+		Effective: dto.DefaultDecisionPolicyResponse(),
+		Scheduled: make([]dto.DecisionPolicyResponse, 0),
+	}
+
+	effective := model.EffectivePolicy(versions, now)
+	if effective != nil {
+		resp.Effective = dto.FromDecisionPolicyModel(effective)
+	}
+	for _, p := range versions {
+		if p.EffectiveFrom().After(now) && (effective == nil || p.Version() > effective.Version()) {
+			resp.Scheduled = append(resp.Scheduled, dto.FromDecisionPolicyModel(p))
+		}
+	}
+	return resp, nil
+}
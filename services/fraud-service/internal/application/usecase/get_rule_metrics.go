@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/service"
+)
+
+// GetRuleMetrics is the use case for reporting per-rule hit counts.
+type GetRuleMetrics struct {
+	rules port.RuleRepository
+	hits  port.RuleHitRepository
+}
+
+// NewGetRuleMetrics creates a new GetRuleMetrics use case.
+func NewGetRuleMetrics(rules port.RuleRepository, hits port.RuleHitRepository) *GetRuleMetrics {
+	return &GetRuleMetrics{rules: rules, hits: hits}
+}
+
+// Execute returns hit counters for every rule of the tenant, including rules
+// that have never fired, followed by any built-in default rules that have
+// fired for the tenant.
+func (uc *GetRuleMetrics) Execute(ctx context.Context, req dto.ListRulesRequest) ([]dto.RuleMetricsResponse, error) {
+	rules, err := uc.rules.ListByTenant(ctx, req.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rules: %w", err)
+	}
+
+	stats, err := uc.hits.Stats(ctx, req.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rule hit stats: %w", err)
+	}
+	byRule := make(map[uuid.UUID]model.RuleHitStats, len(stats))
+	for _, s := range stats {
+		byRule[s.RuleID] = s
+	}
+
+	resp := make([]dto.RuleMetricsResponse, 0, len(rules))
+	for _, r := range rules {
+		s := byRule[r.ID()]
+		resp = append(resp, dto.RuleMetricsResponse{
+			RuleID:     r.ID(),
+			Name:       r.Name(),
+			Signal:     r.Signal(),
+			Mode:       r.Mode().String(),
+			Hits:       s.Hits,
+			ShadowHits: s.ShadowHits,
+			LastHitAt:  s.LastHitAt,
+		})
+	}
+	for _, r := range service.DefaultRules() {
+		s, ok := byRule[r.ID()]
+		if !ok {
+			continue
+		}
+		resp = append(resp, dto.RuleMetricsResponse{
+			RuleID:     r.ID(),
+			Name:       r.Name(),
+			Signal:     r.Signal(),
+			Mode:       r.Mode().String(),
+			Hits:       s.Hits,
+			ShadowHits: s.ShadowHits,
+			LastHitAt:  s.LastHitAt,
+		})
+	}
+	return resp, nil
+}
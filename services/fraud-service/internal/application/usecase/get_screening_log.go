@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// GetScreeningLog is the use case for retrieving the sanctions screening
+// audit log of a transaction.
+type GetScreeningLog struct {
+	repo port.ScreeningRepository
+}
+
+// NewGetScreeningLog creates a new GetScreeningLog use case.
+func NewGetScreeningLog(repo port.ScreeningRepository) *GetScreeningLog {
+	return &GetScreeningLog{repo: repo}
+}
+
+// Execute lists the screenings of the transaction, oldest first.
+func (uc *GetScreeningLog) Execute(ctx context.Context, req dto.GetScreeningLogRequest) (dto.ScreeningLogResponse, error) {
+	records, err := uc.repo.ListByTransactionID(ctx, req.TenantID, req.TransactionID)
+	if err != nil {
+		return dto.ScreeningLogResponse{}, fmt.Errorf("failed to list screenings: %w", err)
+	}
+	if len(records) == 0 {
+		return dto.ScreeningLogResponse{}, fmt.Errorf("%w: no screenings for transaction %s", ErrNotFound, req.TransactionID)
+	}
+
+	resp := dto.ScreeningLogResponse{Records: make([]dto.ScreeningRecordResponse, 0, len(records))}
+	for _, r := range records {
+		resp.Records = append(resp.Records, dto.FromScreeningModel(r))
+	}
+	return resp, nil
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// LinkCaseAssessment is the use case for adding an assessment to a case's
+// investigation.
+type LinkCaseAssessment struct {
+	repo        port.CaseRepository
+	assessments port.AssessmentRepository
+}
+
+// NewLinkCaseAssessment creates a new LinkCaseAssessment use case.
+func NewLinkCaseAssessment(repo port.CaseRepository, assessments port.AssessmentRepository) *LinkCaseAssessment {
+	return &LinkCaseAssessment{repo: repo, assessments: assessments}
+}
+
+// Execute links the assessment and returns the updated case.
+func (uc *LinkCaseAssessment) Execute(ctx context.Context, req dto.LinkCaseAssessmentRequest) (dto.CaseResponse, error) {
+	c, err := loadCase(ctx, uc.repo, req.TenantID, req.CaseID)
+	if err != nil {
+		return dto.CaseResponse{}, err
+	}
+	assessment, err := uc.assessments.FindByID(ctx, req.TenantID, req.AssessmentID)
+	if err != nil {
+		return dto.CaseResponse{}, fmt.Errorf("failed to find assessment: %w", err)
+	}
+	if assessment == nil {
+		return dto.CaseResponse{}, fmt.Errorf("%w: assessment %s", ErrNotFound, req.AssessmentID)
+	}
+
+	if err := c.LinkAssessment(req.ActorID, assessment); err != nil {
+		return dto.CaseResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	if err := uc.repo.Save(ctx, c); err != nil {
+		return dto.CaseResponse{}, fmt.Errorf("failed to save case: %w", err)
+	}
+	return dto.FromCaseModel(c, time.Now().UTC()), nil
+}
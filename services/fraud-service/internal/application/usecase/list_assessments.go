@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// ListAssessments is the use case for searching a tenant's assessments by
+// account, decision, risk band and period.
+type ListAssessments struct {
+	repo port.AssessmentRepository
+}
+
+// NewListAssessments creates a new ListAssessments use case.
+func NewListAssessments(repo port.AssessmentRepository) *ListAssessments {
+	return &ListAssessments{repo: repo}
+}
+
+// Execute returns a page of matching assessments, newest first.
+func (uc *ListAssessments) Execute(ctx context.Context, req dto.ListAssessmentsRequest) (dto.ListAssessmentsResponse, error) {
+	filter := port.AssessmentFilter{
+		TenantID:  req.TenantID,
+		AccountID: req.AccountID,
+		From:      req.From,
+		To:        req.To,
+		Offset:    req.Offset,
+	}
+
+	if req.Decision != "" {
+		decision, err := valueobject.AssessmentDecisionFromString(strings.ToUpper(req.Decision))
+		if err != nil {
+			return dto.ListAssessmentsResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		filter.Decision = decision.String()
+	}
+	if req.RiskLevel != "" {
+		level, err := valueobject.RiskLevelFromString(strings.ToUpper(req.RiskLevel))
+		if err != nil {
+			return dto.ListAssessmentsResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		filter.RiskLevel = level.String()
+	}
+	if !req.From.IsZero() && !req.To.IsZero() && !req.From.Before(req.To) {
+		return dto.ListAssessmentsResponse{}, fmt.Errorf("%w: from must be before to", ErrInvalidInput)
+	}
+	if req.Offset < 0 {
+		return dto.ListAssessmentsResponse{}, fmt.Errorf("%w: offset must not be negative", ErrInvalidInput)
+	}
+
+	filter.Limit = req.PageSize
+	if filter.Limit <= 0 {
+		filter.Limit = 20
+	}
+	if filter.Limit > 100 {
+		filter.Limit = 100
+	}
+
+	assessments, total, err := uc.repo.List(ctx, filter)
+	if err != nil {
+		return dto.ListAssessmentsResponse{}, fmt.Errorf("failed to list assessments: %w", err)
+	}
+
+	resp := dto.ListAssessmentsResponse{
+		Assessments: make([]dto.AssessmentResponse, 0, len(assessments)),
+		TotalCount:  total,
+	}
+	for _, a := range assessments {
+		resp.Assessments = append(resp.Assessments, dto.FromModel(a))
+	}
+	return resp, nil
+}
@@ -0,0 +1,117 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+func TestListAssessments_Execute(t *testing.T) {
+	t.Run("normalizes filters and caps page size", func(t *testing.T) {
+		a, err := model.NewTransactionAssessment(uuid.New(), uuid.New(), uuid.New(), decimal.NewFromInt(500), "USD", "transfer")
+		require.NoError(t, err)
+		repo := &mockAssessmentRepository{listed: []*model.TransactionAssessment{a}}
+		uc := usecase.NewListAssessments(repo)
+
+		accountID := uuid.New()
+		from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		resp, err := uc.Execute(context.Background(), dto.ListAssessmentsRequest{
+			TenantID:  uuid.New(),
+			AccountID: accountID,
+			Decision:  "review",
+			RiskLevel: "high",
+			From:      from,
+			PageSize:  500,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, resp.TotalCount)
+		require.Len(t, resp.Assessments, 1)
+		assert.Equal(t, a.ID(), resp.Assessments[0].ID)
+		assert.Equal(t, "REVIEW", repo.lastFilter.Decision)
+		assert.Equal(t, "HIGH", repo.lastFilter.RiskLevel)
+		assert.Equal(t, accountID, repo.lastFilter.AccountID)
+		assert.Equal(t, from, repo.lastFilter.From)
+		assert.Equal(t, 100, repo.lastFilter.Limit)
+	})
+
+	t.Run("rejects invalid filters", func(t *testing.T) {
+		uc := usecase.NewListAssessments(&mockAssessmentRepository{})
+		now := time.Now()
+
+		for name, req := range map[string]dto.ListAssessmentsRequest{
+			"decision":   {Decision: "MAYBE"},
+			"risk level": {RiskLevel: "EXTREME"},
+			"period":     {From: now, To: now.Add(-time.Hour)},
+			"offset":     {Offset: -1},
+		} {
+			_, err := uc.Execute(context.Background(), req)
+			assert.True(t, errors.Is(err, usecase.ErrInvalidInput), name)
+		}
+	})
+}
+
+func TestGetAssessmentMetrics_Execute(t *testing.T) {
+	day1 := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	repo := &mockAssessmentRepository{
+		buckets: []model.ScoreBucket{
+			{Score: 5, RiskLevel: valueobject.RiskLevelLow, Decision: valueobject.DecisionApprove, Count: 6},
+			{Score: 55, RiskLevel: valueobject.RiskLevelMedium, Decision: valueobject.DecisionReview, Count: 2},
+			{Score: 10, RiskLevel: valueobject.RiskLevelLow, Decision: valueobject.DecisionReview, Count: 1, SanctionsHit: true},
+			{Score: 100, RiskLevel: valueobject.RiskLevelCritical, Decision: valueobject.DecisionDecline, Count: 1},
+		},
+		volumes: []model.DecisionVolume{
+			{Day: day1, Decision: valueobject.DecisionApprove, Count: 4},
+			{Day: day1, Decision: valueobject.DecisionReview, Count: 3},
+			{Day: day2, Decision: valueobject.DecisionApprove, Count: 2},
+			{Day: day2, Decision: valueobject.DecisionDecline, Count: 1},
+		},
+	}
+	uc := usecase.NewGetAssessmentMetrics(repo)
+
+	t.Run("aggregates rates, histogram and daily series", func(t *testing.T) {
+		resp, err := uc.Execute(context.Background(), dto.AssessmentMetricsRequest{TenantID: uuid.New()})
+		require.NoError(t, err)
+
+		assert.Equal(t, 7*24, int(resp.To.Sub(resp.From).Hours()), "defaults to 7 days")
+		assert.Equal(t, 10, resp.Total)
+		assert.InDelta(t, 0.6, resp.ApprovalRate, 1e-9)
+		assert.InDelta(t, 0.3, resp.ReviewRate, 1e-9)
+		assert.InDelta(t, 0.1, resp.DeclineRate, 1e-9)
+		assert.InDelta(t, 25.0, resp.AverageScore, 1e-9)
+		assert.Equal(t, 1, resp.SanctionsHitCount)
+		assert.Equal(t, map[string]int{"LOW": 7, "MEDIUM": 2, "CRITICAL": 1}, resp.RiskLevels)
+
+		require.Len(t, resp.ScoreHistogram, 10)
+		assert.Equal(t, dto.ScoreBand{Min: 0, Max: 9, Count: 6}, resp.ScoreHistogram[0])
+		assert.Equal(t, dto.ScoreBand{Min: 10, Max: 19, Count: 1}, resp.ScoreHistogram[1])
+		assert.Equal(t, dto.ScoreBand{Min: 50, Max: 59, Count: 2}, resp.ScoreHistogram[5])
+		assert.Equal(t, dto.ScoreBand{Min: 90, Max: 100, Count: 1}, resp.ScoreHistogram[9])
+
+		assert.Equal(t, []dto.DailyDecisions{
+			{Day: day1, Approved: 4, Review: 3},
+			{Day: day2, Approved: 2, Declined: 1},
+		}, resp.Daily)
+	})
+
+	t.Run("rejects invalid periods", func(t *testing.T) {
+		now := time.Now()
+		_, err := uc.Execute(context.Background(), dto.AssessmentMetricsRequest{From: now, To: now})
+		assert.True(t, errors.Is(err, usecase.ErrInvalidInput))
+
+		_, err = uc.Execute(context.Background(), dto.AssessmentMetricsRequest{From: now.AddDate(-2, 0, 0), To: now})
+		assert.True(t, errors.Is(err, usecase.ErrInvalidInput))
+	})
+}
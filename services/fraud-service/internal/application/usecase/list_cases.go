@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// ListCases is the use case for browsing the manual review queue.
+type ListCases struct {
+	repo port.CaseRepository
+}
+
+// NewListCases creates a new ListCases use case.
+func NewListCases(repo port.CaseRepository) *ListCases {
+	return &ListCases{repo: repo}
+}
+
+// Execute returns a page of cases, most urgent SLA first.
+func (uc *ListCases) Execute(ctx context.Context, req dto.ListCasesRequest) (dto.ListCasesResponse, error) {
+	if req.Status != "" {
+		if _, err := valueobject.CaseStatusFromString(req.Status); err != nil {
+			return dto.ListCasesResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	cases, total, err := uc.repo.List(ctx, port.CaseFilter{
+		TenantID:    req.TenantID,
+		Status:      req.Status,
+		AssigneeID:  req.AssigneeID,
+		OverdueOnly: req.OverdueOnly,
+		Limit:       pageSize,
+		Offset:      req.Offset,
+	})
+	if err != nil {
+		return dto.ListCasesResponse{}, fmt.Errorf("failed to list cases: %w", err)
+	}
+
+	now := time.Now().UTC()
+	resp := dto.ListCasesResponse{
+		Cases:      make([]dto.CaseResponse, 0, len(cases)),
+		TotalCount: total,
+	}
+	for _, c := range cases {
+		resp.Cases = append(resp.Cases, dto.FromCaseModel(c, now))
+	}
+	return resp, nil
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// ListDecisionPolicyVersions is the use case for retrieving the audit trail
+// of a tenant's decision policy changes.
+type ListDecisionPolicyVersions struct {
+	repo port.DecisionPolicyRepository
+}
+
+// NewListDecisionPolicyVersions creates a new ListDecisionPolicyVersions use case.
+func NewListDecisionPolicyVersions(repo port.DecisionPolicyRepository) *ListDecisionPolicyVersions {
+	return &ListDecisionPolicyVersions{repo: repo}
+}
+
+// Execute returns every policy version for the tenant, newest first. A
+// tenant that never changed its policy has an empty history.
+func (uc *ListDecisionPolicyVersions) Execute(ctx context.Context, req dto.GetDecisionPolicyRequest) (dto.DecisionPolicyVersionsResponse, error) {
+	versions, err := uc.repo.ListVersions(ctx, req.TenantID)
+	if err != nil {
+		return dto.DecisionPolicyVersionsResponse{}, fmt.Errorf("failed to list decision policies: %w", err)
+	}
+
+	resp := dto.DecisionPolicyVersionsResponse{Versions: make([]dto.DecisionPolicyResponse, 0, len(versions))}
+	for _, p := range versions {
+		resp.Versions = append(resp.Versions, dto.FromDecisionPolicyModel(p))
+	}
+	return resp, nil
+}
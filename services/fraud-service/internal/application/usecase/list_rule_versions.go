@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// ListRuleVersions is the use case for retrieving a rule's revision history.
+type ListRuleVersions struct {
+	repo port.RuleRepository
+}
+
+// NewListRuleVersions creates a new ListRuleVersions use case.
+func NewListRuleVersions(repo port.RuleRepository) *ListRuleVersions {
+	return &ListRuleVersions{repo: repo}
+}
+
+// Execute returns every version of the rule, newest first.
+func (uc *ListRuleVersions) Execute(ctx context.Context, req dto.ListRuleVersionsRequest) ([]dto.RuleVersionResponse, error) {
+	versions, err := uc.repo.ListVersions(ctx, req.TenantID, req.RuleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rule versions: %w", err)
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("%w: rule %s", ErrNotFound, req.RuleID)
+	}
+
+	resp := make([]dto.RuleVersionResponse, 0, len(versions))
+	for _, v := range versions {
+		resp = append(resp, dto.FromRuleVersion(v))
+	}
+	return resp, nil
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// ListRules is the use case for listing a tenant's fraud rules.
+type ListRules struct {
+	repo port.RuleRepository
+}
+
+// NewListRules creates a new ListRules use case.
+func NewListRules(repo port.RuleRepository) *ListRules {
+	return &ListRules{repo: repo}
+}
+
+// Execute returns all rules configured for the tenant.
+func (uc *ListRules) Execute(ctx context.Context, req dto.ListRulesRequest) ([]dto.RuleResponse, error) {
+	rules, err := uc.repo.ListByTenant(ctx, req.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rules: %w", err)
+	}
+
+	resp := make([]dto.RuleResponse, 0, len(rules))
+	for _, r := range rules {
+		resp = append(resp, dto.FromRuleModel(r))
+	}
+	return resp, nil
+}
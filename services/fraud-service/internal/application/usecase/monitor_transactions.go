@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/service"
+)
+
+// PaymentOrdersTopic is the payment service topic carrying payment order
+// lifecycle events.
+const PaymentOrdersTopic = "bib.payment.orders"
+
+// paymentInitiatedEvent holds the payment.order.initiated fields AML
+// monitoring uses.
+type paymentInitiatedEvent struct {
+	OccurredAt           time.Time       `json:"occurred_at"`
+	EventID              string          `json:"event_id"`
+	TenantID             string          `json:"tenant_id"`
+	Currency             string          `json:"currency"`
+	DestinationCountry   string          `json:"destination_country"`
+	Amount               decimal.Decimal `json:"amount"`
+	PaymentID            uuid.UUID       `json:"payment_id"`
+	SourceAccountID      uuid.UUID       `json:"source_account_id"`
+	DestinationAccountID uuid.UUID       `json:"destination_account_id"`
+}
+
+// MonitorTransactions runs the AML monitoring scenarios over the payment
+// event stream. Each payment is recorded against the accounts it moves funds
+// on, and every scenario it completes opens a case in the review queue,
+// unless the account already has an open case for that scenario. Other
+// event types are ignored.
+type MonitorTransactions struct {
+	txns      port.AMLTransactionRepository
+	cases     port.CaseRepository
+	publisher port.EventPublisher
+	monitor   *service.AMLMonitor
+	logger    *slog.Logger
+}
+
+// NewMonitorTransactions creates a new MonitorTransactions use case.
+func NewMonitorTransactions(
+	txns port.AMLTransactionRepository,
+	cases port.CaseRepository,
+	publisher port.EventPublisher,
+	monitor *service.AMLMonitor,
+	logger *slog.Logger,
+) *MonitorTransactions {
+	return &MonitorTransactions{txns: txns, cases: cases, publisher: publisher, monitor: monitor, logger: logger}
+}
+
+// Execute monitors a payment event of the given type. The payload is a
+// CloudEvents envelope or the bare event. A redelivered event is recorded
+// once and raises no further alerts.
+func (uc *MonitorTransactions) Execute(ctx context.Context, eventType string, payload []byte) error {
+	if eventType != "payment.order.initiated" {
+		return nil
+	}
+
+	data, err := events.EventData(payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	var evt paymentInitiatedEvent
+	if err := json.Unmarshal(data, &evt); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	tenantID, err := uuid.Parse(evt.TenantID)
+	if err != nil || evt.EventID == "" || evt.SourceAccountID == uuid.Nil || !evt.Amount.IsPositive() {
+		// Redelivering a malformed event cannot fix it.
+		uc.logger.Warn("dropping malformed payment event", "event_type", eventType, "event_id", evt.EventID)
+		return nil
+	}
+
+	txn := model.MonitoredTransaction{
+		EventID:       evt.EventID,
+		TenantID:      tenantID,
+		TransactionID: evt.PaymentID,
+		Amount:        evt.Amount,
+		Currency:      strings.ToUpper(evt.Currency),
+		OccurredAt:    evt.OccurredAt.UTC(),
+	}
+	out := txn
+	out.Direction, out.AccountID, out.Country = model.FlowOut, evt.SourceAccountID, strings.ToUpper(evt.DestinationCountry)
+	if err := uc.record(ctx, out); err != nil {
+		return err
+	}
+	if evt.DestinationAccountID != uuid.Nil {
+		in := txn
+		in.Direction, in.AccountID = model.FlowIn, evt.DestinationAccountID
+		if err := uc.record(ctx, in); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// record opens a case for each scenario txn completes, then records it.
+// Recording last means a failure leaves the transaction to be monitored
+// again on redelivery; alerts raised the first time fold into their cases.
+func (uc *MonitorTransactions) record(ctx context.Context, txn model.MonitoredTransaction) error {
+	history, err := uc.txns.ListByAccount(ctx, txn.TenantID, txn.AccountID, txn.OccurredAt.Add(-uc.monitor.Lookback()))
+	if err != nil {
+		return err
+	}
+	for _, h := range history {
+		if h.EventID == txn.EventID && h.Direction == txn.Direction {
+			return nil
+		}
+	}
+
+	for _, alert := range uc.monitor.Evaluate(txn, history) {
+		if err := uc.openCase(ctx, alert); err != nil {
+			return err
+		}
+	}
+	return uc.txns.Record(ctx, txn)
+}
+
+// openCase queues an alert for investigation, folding it into the account's
+// open case for the scenario if there is one.
+func (uc *MonitorTransactions) openCase(ctx context.Context, alert model.AMLAlert) error {
+	existing, err := uc.cases.FindOpenAMLCase(ctx, alert.TenantID, alert.AccountID, alert.Scenario)
+	if err != nil {
+		return fmt.Errorf("failed to find open AML case: %w", err)
+	}
+	if existing != nil {
+		uc.logger.Info("AML alert folded into open case",
+			"scenario", alert.Scenario, "account_id", alert.AccountID, "case_id", existing.ID())
+		return nil
+	}
+
+	c, err := model.OpenCaseForAMLAlert(alert)
+	if err != nil {
+		return fmt.Errorf("failed to open AML case: %w", err)
+	}
+	if err := saveCase(ctx, uc.cases, uc.publisher, c); err != nil {
+		return err
+	}
+	uc.logger.Info("AML alert raised",
+		"scenario", alert.Scenario, "account_id", alert.AccountID, "case_id", c.ID())
+	return nil
+}
@@ -0,0 +1,140 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/service"
+)
+
+type mockAMLTransactionRepository struct {
+	txns []model.MonitoredTransaction
+}
+
+func (m *mockAMLTransactionRepository) Record(_ context.Context, txn model.MonitoredTransaction) error {
+	m.txns = append(m.txns, txn)
+	return nil
+}
+
+func (m *mockAMLTransactionRepository) ListByAccount(_ context.Context, tenantID, accountID uuid.UUID, since time.Time) ([]model.MonitoredTransaction, error) {
+	var out []model.MonitoredTransaction
+	for _, t := range m.txns {
+		if t.TenantID == tenantID && t.AccountID == accountID && !t.OccurredAt.Before(since) {
+			out = append(out, t)
+		}
+	}
+	return out, nil
+}
+
+func paymentInitiated(t *testing.T, tenantID, source, destination uuid.UUID, amount int64, at time.Time) []byte {
+	t.Helper()
+	payload, err := json.Marshal(map[string]any{
+		"event_id":               uuid.NewString(),
+		"event_type":             "payment.order.initiated",
+		"tenant_id":              tenantID.String(),
+		"occurred_at":            at,
+		"payment_id":             uuid.New(),
+		"source_account_id":      source,
+		"destination_account_id": destination,
+		"amount":                 decimal.NewFromInt(amount),
+		"currency":               "usd",
+	})
+	require.NoError(t, err)
+	return payload
+}
+
+func TestMonitorTransactions_Execute(t *testing.T) {
+	scenarios := service.AMLScenarios{
+		StructuringThreshold: decimal.NewFromInt(10000),
+		StructuringMargin:    decimal.NewFromFloat(0.1),
+		StructuringWindow:    24 * time.Hour,
+		StructuringMinCount:  3,
+	}
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	newUseCase := func() (*usecase.MonitorTransactions, *mockAMLTransactionRepository, *mockCaseRepository, *mockFraudEventPublisher) {
+		txns, cases, publisher := &mockAMLTransactionRepository{}, newMockCaseRepository(), &mockFraudEventPublisher{}
+		return usecase.NewMonitorTransactions(txns, cases, publisher, service.NewAMLMonitor(scenarios), slog.Default()), txns, cases, publisher
+	}
+
+	t.Run("structured payments open a case", func(t *testing.T) {
+		uc, txns, cases, publisher := newUseCase()
+		tenantID, account := uuid.New(), uuid.New()
+
+		var last []byte
+		for i := range 3 {
+			last = paymentInitiated(t, tenantID, account, uuid.Nil, 9500, start.Add(time.Duration(i)*time.Hour))
+			require.NoError(t, uc.Execute(context.Background(), "payment.order.initiated", last))
+		}
+
+		require.Len(t, cases.cases, 1)
+		for _, c := range cases.cases {
+			assert.Equal(t, model.AMLScenarioStructuring, c.AMLScenario())
+			assert.Equal(t, account, c.AccountID())
+			assert.Len(t, c.AlertTransactionIDs(), 3)
+		}
+		require.Len(t, publisher.publishedEvents, 1)
+		assert.Equal(t, "fraud.case.opened", publisher.publishedEvents[0].EventType())
+		assert.Len(t, txns.txns, 3)
+
+		require.NoError(t, uc.Execute(context.Background(), "payment.order.initiated", last))
+		assert.Len(t, txns.txns, 3, "a redelivered event is recorded once")
+		assert.Len(t, publisher.publishedEvents, 1)
+	})
+
+	t.Run("further alerts fold into the open case", func(t *testing.T) {
+		uc, _, cases, publisher := newUseCase()
+		tenantID, account := uuid.New(), uuid.New()
+
+		for i := range 5 {
+			payload := paymentInitiated(t, tenantID, account, uuid.Nil, 9500, start.Add(time.Duration(i)*time.Hour))
+			require.NoError(t, uc.Execute(context.Background(), "payment.order.initiated", payload))
+		}
+
+		assert.Len(t, cases.cases, 1)
+		assert.Len(t, publisher.publishedEvents, 1)
+	})
+
+	t.Run("records internal transfers on both accounts", func(t *testing.T) {
+		uc, txns, _, _ := newUseCase()
+		tenantID, source, destination := uuid.New(), uuid.New(), uuid.New()
+
+		require.NoError(t, uc.Execute(context.Background(), "payment.order.initiated",
+			paymentInitiated(t, tenantID, source, destination, 500, start)))
+
+		require.Len(t, txns.txns, 2)
+		assert.Equal(t, source, txns.txns[0].AccountID)
+		assert.Equal(t, model.FlowOut, txns.txns[0].Direction)
+		assert.Equal(t, destination, txns.txns[1].AccountID)
+		assert.Equal(t, model.FlowIn, txns.txns[1].Direction)
+		assert.Equal(t, "USD", txns.txns[1].Currency)
+	})
+
+	t.Run("ignores other event types", func(t *testing.T) {
+		uc, txns, _, _ := newUseCase()
+
+		require.NoError(t, uc.Execute(context.Background(), "payment.order.settled",
+			paymentInitiated(t, uuid.New(), uuid.New(), uuid.Nil, 9500, start)))
+
+		assert.Empty(t, txns.txns)
+	})
+
+	t.Run("drops malformed events", func(t *testing.T) {
+		uc, txns, _, _ := newUseCase()
+
+		require.NoError(t, uc.Execute(context.Background(), "payment.order.initiated",
+			paymentInitiated(t, uuid.New(), uuid.Nil, uuid.Nil, 9500, start)))
+
+		assert.Empty(t, txns.txns)
+	})
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/netip"
+	"time"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// SecurityAuthEventsTopic is the gateway topic carrying failed-authentication
+// security events.
+const SecurityAuthEventsTopic = "bib.security.auth-events"
+
+// authEventKinds maps the gateway's security event types to anomaly kinds.
+var authEventKinds = map[string]string{
+	"security.auth.step_up_required": model.AuthAnomalyStepUp,
+	"security.auth.locked_out":       model.AuthAnomalyLockout,
+}
+
+// authSecurityEvent holds the gateway security event fields this service keeps.
+type authSecurityEvent struct {
+	OccurredAt time.Time `json:"occurred_at"`
+	EventID    string    `json:"event_id"`
+	ClientIP   string    `json:"client_ip"`
+	UserID     string    `json:"user_id"`
+	Failures   int       `json:"failures"`
+}
+
+// RecordAuthAnomaly stores the failed-authentication thresholds the gateway
+// reports clients crossing, so that transactions from the same IP address
+// can be scored with them. Other event types are ignored.
+type RecordAuthAnomaly struct {
+	repo   port.AuthAnomalyRepository
+	logger *slog.Logger
+}
+
+// NewRecordAuthAnomaly creates a new RecordAuthAnomaly use case.
+func NewRecordAuthAnomaly(repo port.AuthAnomalyRepository, logger *slog.Logger) *RecordAuthAnomaly {
+	return &RecordAuthAnomaly{repo: repo, logger: logger}
+}
+
+// Execute records a security event of the given type. The payload is a
+// CloudEvents envelope or the bare event. Recording is idempotent.
+func (uc *RecordAuthAnomaly) Execute(ctx context.Context, eventType string, payload []byte) error {
+	kind, ok := authEventKinds[eventType]
+	if !ok {
+		return nil
+	}
+
+	data, err := events.EventData(payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	var evt authSecurityEvent
+	if err := json.Unmarshal(data, &evt); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	ip, err := netip.ParseAddr(evt.ClientIP)
+	if err != nil || evt.EventID == "" {
+		// Redelivering a malformed event cannot fix it.
+		uc.logger.Warn("dropping malformed security event", "event_type", eventType, "event_id", evt.EventID)
+		return nil
+	}
+
+	return uc.repo.Record(ctx, model.AuthAnomaly{
+		EventID:    evt.EventID,
+		Kind:       kind,
+		ClientIP:   ip.String(),
+		UserID:     evt.UserID,
+		Failures:   evt.Failures,
+		OccurredAt: evt.OccurredAt,
+	})
+}
@@ -0,0 +1,90 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+type mockAuthAnomalyRepository struct {
+	recorded map[string]model.AuthAnomaly
+}
+
+func (m *mockAuthAnomalyRepository) Record(_ context.Context, a model.AuthAnomaly) error {
+	if m.recorded == nil {
+		m.recorded = make(map[string]model.AuthAnomaly)
+	}
+	m.recorded[a.EventID] = a
+	return nil
+}
+
+func (m *mockAuthAnomalyRepository) SummarizeIP(_ context.Context, _ string, _ time.Time) (model.AuthAnomalySummary, error) {
+	return model.AuthAnomalySummary{}, nil
+}
+
+func authEventPayload(t *testing.T, fields map[string]any) []byte {
+	t.Helper()
+	data, err := json.Marshal(fields)
+	require.NoError(t, err)
+	payload, err := json.Marshal(events.CloudEvent{
+		SpecVersion: events.CloudEventsSpecVersion,
+		ID:          "evt-1",
+		Source:      "/bib/gateway",
+		Type:        "security.auth.locked_out",
+		Data:        data,
+	})
+	require.NoError(t, err)
+	return payload
+}
+
+func TestRecordAuthAnomaly(t *testing.T) {
+	occurred := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("records a lockout", func(t *testing.T) {
+		repo := &mockAuthAnomalyRepository{}
+		uc := usecase.NewRecordAuthAnomaly(repo, slog.Default())
+
+		err := uc.Execute(context.Background(), "security.auth.locked_out", authEventPayload(t, map[string]any{
+			"event_id": "evt-1", "occurred_at": occurred, "client_ip": "2001:DB8::1", "user_id": "u-1", "failures": 10,
+		}))
+
+		require.NoError(t, err)
+		assert.Equal(t, model.AuthAnomaly{
+			EventID: "evt-1", Kind: model.AuthAnomalyLockout, ClientIP: "2001:db8::1", UserID: "u-1", Failures: 10, OccurredAt: occurred,
+		}, repo.recorded["evt-1"])
+	})
+
+	t.Run("ignores other event types", func(t *testing.T) {
+		repo := &mockAuthAnomalyRepository{}
+		uc := usecase.NewRecordAuthAnomaly(repo, slog.Default())
+
+		require.NoError(t, uc.Execute(context.Background(), "security.auth.other", []byte("{}")))
+		assert.Empty(t, repo.recorded)
+	})
+
+	t.Run("drops events without a valid IP address", func(t *testing.T) {
+		repo := &mockAuthAnomalyRepository{}
+		uc := usecase.NewRecordAuthAnomaly(repo, slog.Default())
+
+		err := uc.Execute(context.Background(), "security.auth.step_up_required", authEventPayload(t, map[string]any{
+			"event_id": "evt-2", "client_ip": "not-an-ip",
+		}))
+
+		require.NoError(t, err)
+		assert.Empty(t, repo.recorded)
+	})
+
+	t.Run("fails on an undecodable payload", func(t *testing.T) {
+		uc := usecase.NewRecordAuthAnomaly(&mockAuthAnomalyRepository{}, slog.Default())
+		assert.Error(t, uc.Execute(context.Background(), "security.auth.locked_out", []byte("not json")))
+	})
+}
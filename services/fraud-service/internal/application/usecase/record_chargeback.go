@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// RecordChargeback is the use case for labeling a transaction as fraud
+// after the card network reports a fraud chargeback.
+type RecordChargeback struct {
+	assessments port.AssessmentRepository
+	recordLabel *RecordLabel
+}
+
+// NewRecordChargeback creates a new RecordChargeback use case.
+func NewRecordChargeback(assessments port.AssessmentRepository, recordLabel *RecordLabel) *RecordChargeback {
+	return &RecordChargeback{assessments: assessments, recordLabel: recordLabel}
+}
+
+// Execute labels the assessment for the charged-back transaction as FRAUD.
+func (uc *RecordChargeback) Execute(ctx context.Context, req dto.RecordChargebackRequest) (dto.LabelResponse, error) {
+	chargebackID := strings.TrimSpace(req.ChargebackID)
+	if chargebackID == "" {
+		return dto.LabelResponse{}, fmt.Errorf("%w: chargeback ID is required", ErrInvalidInput)
+	}
+
+	assessment, err := uc.assessments.FindByTransactionID(ctx, req.TenantID, req.TransactionID)
+	if err != nil {
+		return dto.LabelResponse{}, fmt.Errorf("failed to find assessment: %w", err)
+	}
+	if assessment == nil {
+		return dto.LabelResponse{}, fmt.Errorf("%w: no assessment for transaction %s", ErrNotFound, req.TransactionID)
+	}
+
+	sourceRef := chargebackID
+	if code := strings.TrimSpace(req.ReasonCode); code != "" {
+		sourceRef += ":" + code
+	}
+
+	return uc.recordLabel.record(ctx, assessment, valueobject.LabelFraud, valueobject.LabelSourceChargeback, dto.RecordLabelRequest{
+		SourceRef: sourceRef,
+		LabeledBy: req.ReportedBy,
+	})
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// IdentityVerificationsTopic is the identity-service topic carrying
+// verification, screening and review events.
+const IdentityVerificationsTopic = "bib.identity.verifications"
+
+// identityScreeningHit holds the identity.screening.hit fields this service keeps.
+type identityScreeningHit struct {
+	OccurredAt         time.Time `json:"occurred_at"`
+	TenantID           string    `json:"tenant_id"`
+	CheckType          string    `json:"check_type"`
+	ApplicantFirstName string    `json:"applicant_first_name"`
+	ApplicantLastName  string    `json:"applicant_last_name"`
+	ApplicantCountry   string    `json:"applicant_country"`
+	Matches            []struct {
+		EntryID string   `json:"entry_id"`
+		Sources []string `json:"sources"`
+	} `json:"matches"`
+	VerificationID uuid.UUID `json:"verification_id"`
+}
+
+// identityReviewDecided holds the identity.review.decided and
+// identity.verification.erased fields this service keeps.
+type identityReviewDecided struct {
+	TenantID       string    `json:"tenant_id"`
+	Outcome        string    `json:"outcome"`
+	VerificationID uuid.UUID `json:"verification_id"`
+}
+
+// RecordFlaggedParty keeps the tenant's flagged parties in step with
+// identity screening: a customer with a sanctions screening hit is flagged,
+// and the flag is cleared when an analyst approves the verification on
+// review, or when the applicant's data is erased. Other event types, and PEP
+// and adverse-media hits, are ignored.
+type RecordFlaggedParty struct {
+	repo   port.FlaggedPartyRepository
+	logger *slog.Logger
+}
+
+// NewRecordFlaggedParty creates a new RecordFlaggedParty use case.
+func NewRecordFlaggedParty(repo port.FlaggedPartyRepository, logger *slog.Logger) *RecordFlaggedParty {
+	return &RecordFlaggedParty{repo: repo, logger: logger}
+}
+
+// Execute applies an identity event of the given type. The payload is a
+// CloudEvents envelope or the bare event. Applying an event twice is a no-op.
+func (uc *RecordFlaggedParty) Execute(ctx context.Context, eventType string, payload []byte) error {
+	switch eventType {
+	case "identity.screening.hit":
+		return uc.flag(ctx, eventType, payload)
+	case "identity.review.decided", "identity.verification.erased":
+		return uc.clear(ctx, eventType, payload)
+	default:
+		return nil
+	}
+}
+
+func (uc *RecordFlaggedParty) flag(ctx context.Context, eventType string, payload []byte) error {
+	var evt identityScreeningHit
+	if err := decodeIdentityEvent(eventType, payload, &evt); err != nil {
+		return err
+	}
+	if evt.CheckType != "SANCTIONS" {
+		return nil
+	}
+	tenantID, err := uuid.Parse(evt.TenantID)
+	name := strings.TrimSpace(evt.ApplicantFirstName + " " + evt.ApplicantLastName)
+	if err != nil || evt.VerificationID == uuid.Nil || name == "" {
+		// Redelivering a malformed event cannot fix it.
+		uc.logger.Warn("dropping malformed identity event", "event_type", eventType, "verification_id", evt.VerificationID)
+		return nil
+	}
+
+	party := model.FlaggedParty{
+		TenantID:       tenantID,
+		VerificationID: evt.VerificationID,
+		Name:           name,
+		Country:        evt.ApplicantCountry,
+		FlaggedAt:      evt.OccurredAt,
+	}
+	for _, m := range evt.Matches {
+		for _, src := range m.Sources {
+			party.Matches = append(party.Matches, src+":"+m.EntryID)
+		}
+	}
+	return uc.repo.Flag(ctx, party)
+}
+
+func (uc *RecordFlaggedParty) clear(ctx context.Context, eventType string, payload []byte) error {
+	var evt identityReviewDecided
+	if err := decodeIdentityEvent(eventType, payload, &evt); err != nil {
+		return err
+	}
+	if eventType == "identity.review.decided" && evt.Outcome != "APPROVE" {
+		return nil
+	}
+	tenantID, err := uuid.Parse(evt.TenantID)
+	if err != nil || evt.VerificationID == uuid.Nil {
+		uc.logger.Warn("dropping malformed identity event", "event_type", eventType, "verification_id", evt.VerificationID)
+		return nil
+	}
+	return uc.repo.Clear(ctx, tenantID, evt.VerificationID)
+}
+
+func decodeIdentityEvent(eventType string, payload []byte, v any) error {
+	data, err := events.EventData(payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	return nil
+}
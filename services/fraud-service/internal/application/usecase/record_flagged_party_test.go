@@ -0,0 +1,115 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+)
+
+func identityEventPayload(t *testing.T, eventType string, fields map[string]any) []byte {
+	t.Helper()
+	data, err := json.Marshal(fields)
+	require.NoError(t, err)
+	payload, err := json.Marshal(events.CloudEvent{
+		SpecVersion: events.CloudEventsSpecVersion,
+		ID:          uuid.NewString(),
+		Source:      "/bib/identity-service",
+		Type:        eventType,
+		Data:        data,
+	})
+	require.NoError(t, err)
+	return payload
+}
+
+func screeningHitPayload(t *testing.T, tenantID, verificationID uuid.UUID, checkType string) []byte {
+	return identityEventPayload(t, "identity.screening.hit", map[string]any{
+		"tenant_id": tenantID, "occurred_at": time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+		"verification_id": verificationID, "check_type": checkType,
+		"applicant_first_name": "Ivan", "applicant_last_name": "Petrov", "applicant_country": "RU",
+		"matches": []map[string]any{{"name": "PETROV, Ivan", "entry_id": "7012", "sources": []string{"OFAC_SDN"}, "score": 0.97}},
+	})
+}
+
+func TestRecordFlaggedParty(t *testing.T) {
+	tenantID, verificationID := uuid.New(), uuid.New()
+
+	t.Run("flags a customer with a sanctions hit", func(t *testing.T) {
+		repo := &mockFlaggedPartyRepository{}
+		uc := usecase.NewRecordFlaggedParty(repo, slog.Default())
+
+		require.NoError(t, uc.Execute(context.Background(), "identity.screening.hit", screeningHitPayload(t, tenantID, verificationID, "SANCTIONS")))
+		// Redelivery replaces the flag rather than duplicating it.
+		require.NoError(t, uc.Execute(context.Background(), "identity.screening.hit", screeningHitPayload(t, tenantID, verificationID, "SANCTIONS")))
+
+		require.Len(t, repo.parties, 1)
+		p := repo.parties[0]
+		assert.Equal(t, tenantID, p.TenantID)
+		assert.Equal(t, verificationID, p.VerificationID)
+		assert.Equal(t, "Ivan Petrov", p.Name)
+		assert.Equal(t, "RU", p.Country)
+		assert.Equal(t, []string{"OFAC_SDN:7012"}, p.Matches)
+	})
+
+	t.Run("ignores PEP and adverse-media hits", func(t *testing.T) {
+		repo := &mockFlaggedPartyRepository{}
+		uc := usecase.NewRecordFlaggedParty(repo, slog.Default())
+
+		require.NoError(t, uc.Execute(context.Background(), "identity.screening.hit", screeningHitPayload(t, tenantID, verificationID, "PEP")))
+		assert.Empty(t, repo.parties)
+	})
+
+	t.Run("clears the flag when review approves the verification", func(t *testing.T) {
+		repo := &mockFlaggedPartyRepository{}
+		uc := usecase.NewRecordFlaggedParty(repo, slog.Default())
+		require.NoError(t, uc.Execute(context.Background(), "identity.screening.hit", screeningHitPayload(t, tenantID, verificationID, "SANCTIONS")))
+
+		decided := func(outcome string) []byte {
+			return identityEventPayload(t, "identity.review.decided", map[string]any{
+				"tenant_id": tenantID, "case_id": uuid.New(), "verification_id": verificationID, "outcome": outcome,
+			})
+		}
+		require.NoError(t, uc.Execute(context.Background(), "identity.review.decided", decided("REJECT")))
+		assert.Len(t, repo.parties, 1)
+
+		require.NoError(t, uc.Execute(context.Background(), "identity.review.decided", decided("APPROVE")))
+		assert.Empty(t, repo.parties)
+	})
+
+	t.Run("clears the flag when the applicant's data is erased", func(t *testing.T) {
+		repo := &mockFlaggedPartyRepository{}
+		uc := usecase.NewRecordFlaggedParty(repo, slog.Default())
+		require.NoError(t, uc.Execute(context.Background(), "identity.screening.hit", screeningHitPayload(t, tenantID, verificationID, "SANCTIONS")))
+
+		require.NoError(t, uc.Execute(context.Background(), "identity.verification.erased", identityEventPayload(t, "identity.verification.erased", map[string]any{
+			"tenant_id": tenantID, "verification_id": verificationID, "reason": "RETENTION_EXPIRED",
+		})))
+		assert.Empty(t, repo.parties)
+	})
+
+	t.Run("drops malformed events", func(t *testing.T) {
+		repo := &mockFlaggedPartyRepository{}
+		uc := usecase.NewRecordFlaggedParty(repo, slog.Default())
+
+		err := uc.Execute(context.Background(), "identity.screening.hit", identityEventPayload(t, "identity.screening.hit", map[string]any{
+			"tenant_id": "not-a-uuid", "verification_id": verificationID, "check_type": "SANCTIONS", "applicant_last_name": "Petrov",
+		}))
+		require.NoError(t, err)
+		assert.Empty(t, repo.parties)
+
+		assert.Error(t, uc.Execute(context.Background(), "identity.screening.hit", []byte("not json")))
+	})
+
+	t.Run("ignores other identity events", func(t *testing.T) {
+		uc := usecase.NewRecordFlaggedParty(&mockFlaggedPartyRepository{}, slog.Default())
+		assert.NoError(t, uc.Execute(context.Background(), "identity.verification.completed", []byte("{}")))
+	})
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// RecordLabel is the use case for attaching a ground-truth label to an
+// assessment. It backs both case resolution and chargeback intake.
+type RecordLabel struct {
+	assessments port.AssessmentRepository
+	labels      port.LabelRepository
+	publisher   port.EventPublisher
+}
+
+// NewRecordLabel creates a new RecordLabel use case.
+func NewRecordLabel(assessments port.AssessmentRepository, labels port.LabelRepository, publisher port.EventPublisher) *RecordLabel {
+	return &RecordLabel{
+		assessments: assessments,
+		labels:      labels,
+		publisher:   publisher,
+	}
+}
+
+// Execute records the label. If the assessment already carries a label from
+// a more authoritative source, that label is kept and returned unchanged.
+func (uc *RecordLabel) Execute(ctx context.Context, req dto.RecordLabelRequest) (dto.LabelResponse, error) {
+	label, err := valueobject.FraudLabelFromString(req.Label)
+	if err != nil {
+		return dto.LabelResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	source, err := valueobject.LabelSourceFromString(req.Source)
+	if err != nil {
+		return dto.LabelResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	assessment, err := uc.assessments.FindByID(ctx, req.TenantID, req.AssessmentID)
+	if err != nil {
+		return dto.LabelResponse{}, fmt.Errorf("failed to find assessment: %w", err)
+	}
+	if assessment == nil {
+		return dto.LabelResponse{}, fmt.Errorf("%w: assessment %s", ErrNotFound, req.AssessmentID)
+	}
+
+	return uc.record(ctx, assessment, label, source, req)
+}
+
+func (uc *RecordLabel) record(
+	ctx context.Context,
+	assessment *model.TransactionAssessment,
+	label valueobject.FraudLabel,
+	source valueobject.LabelSource,
+	req dto.RecordLabelRequest,
+) (dto.LabelResponse, error) {
+	l, err := model.NewAssessmentLabel(assessment, label, source, req.SourceRef, req.LabeledBy)
+	if err != nil {
+		return dto.LabelResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	existing, err := uc.labels.FindByAssessmentID(ctx, assessment.TenantID(), assessment.ID())
+	if err != nil {
+		return dto.LabelResponse{}, fmt.Errorf("failed to find label: %w", err)
+	}
+	if !l.Supersedes(existing) {
+		return dto.FromLabelModel(existing), nil
+	}
+
+	if err := uc.labels.Save(ctx, l); err != nil {
+		return dto.LabelResponse{}, fmt.Errorf("failed to save label: %w", err)
+	}
+
+	if events := l.DomainEvents(); len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, events...); err != nil {
+			return dto.LabelResponse{}, fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+
+	return dto.FromLabelModel(l), nil
+}
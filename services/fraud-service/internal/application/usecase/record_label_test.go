@@ -0,0 +1,135 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+func TestRecordChargeback_Execute(t *testing.T) {
+	a, err := model.NewTransactionAssessment(uuid.New(), uuid.New(), uuid.New(), decimal.NewFromInt(200), "USD", "card_purchase")
+	require.NoError(t, err)
+	require.NoError(t, a.Assess(45, nil))
+
+	assessments := &mockAssessmentRepository{
+		findByIDFunc: func(_ context.Context, _, _ uuid.UUID) (*model.TransactionAssessment, error) {
+			return a, nil
+		},
+		findByTxnFunc: func(_ context.Context, tenantID, txnID uuid.UUID) (*model.TransactionAssessment, error) {
+			if tenantID == a.TenantID() && txnID == a.TransactionID() {
+				return a, nil
+			}
+			return nil, nil
+		},
+	}
+	labels := newMockLabelRepository()
+	recordLabel := usecase.NewRecordLabel(assessments, labels, &mockFraudEventPublisher{})
+
+	t.Run("case review label is recorded", func(t *testing.T) {
+		resp, err := recordLabel.Execute(context.Background(), dto.RecordLabelRequest{
+			TenantID:     a.TenantID(),
+			AssessmentID: a.ID(),
+			Label:        "LEGITIMATE",
+			Source:       "CASE_REVIEW",
+			SourceRef:    uuid.NewString(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "LEGITIMATE", resp.Label)
+	})
+
+	t.Run("chargeback overrides case review", func(t *testing.T) {
+		chargebacks := usecase.NewRecordChargeback(assessments, recordLabel)
+
+		resp, err := chargebacks.Execute(context.Background(), dto.RecordChargebackRequest{
+			TenantID:      a.TenantID(),
+			TransactionID: a.TransactionID(),
+			ChargebackID:  "CB-1001",
+			ReasonCode:    "10.4",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "FRAUD", resp.Label)
+		assert.Equal(t, "CHARGEBACK", resp.Source)
+		assert.Equal(t, "CB-1001:10.4", resp.SourceRef)
+	})
+
+	t.Run("case review does not override chargeback", func(t *testing.T) {
+		resp, err := recordLabel.Execute(context.Background(), dto.RecordLabelRequest{
+			TenantID:     a.TenantID(),
+			AssessmentID: a.ID(),
+			Label:        "LEGITIMATE",
+			Source:       "CASE_REVIEW",
+			SourceRef:    uuid.NewString(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "FRAUD", resp.Label)
+		assert.Equal(t, "FRAUD", labels.labels[a.ID()].Label().String())
+	})
+
+	t.Run("unknown transaction is not found", func(t *testing.T) {
+		chargebacks := usecase.NewRecordChargeback(assessments, recordLabel)
+		_, err := chargebacks.Execute(context.Background(), dto.RecordChargebackRequest{
+			TenantID:      a.TenantID(),
+			TransactionID: uuid.New(),
+			ChargebackID:  "CB-2",
+		})
+		require.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+}
+
+type memDatasetStore struct {
+	objects map[string][]byte
+}
+
+func (m *memDatasetStore) Put(_ context.Context, key string, data []byte) (string, error) {
+	m.objects[key] = data
+	return "mem://" + key, nil
+}
+
+func TestExportTrainingDataset_Execute(t *testing.T) {
+	tenantID := uuid.New()
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	t.Run("writes one JSON line per example", func(t *testing.T) {
+		labels := newMockLabelRepository()
+		labels.examples = []model.TrainingExample{
+			{AssessmentID: uuid.New(), Amount: decimal.NewFromInt(10), Label: "FRAUD", Signals: []string{"high_value"}},
+			{AssessmentID: uuid.New(), Amount: decimal.NewFromInt(20), Label: "LEGITIMATE", Signals: []string{}},
+			{AssessmentID: uuid.New(), Amount: decimal.NewFromInt(30), Label: "LEGITIMATE", Signals: []string{}},
+		}
+		store := &memDatasetStore{objects: make(map[string][]byte)}
+
+		resp, err := usecase.NewExportTrainingDataset(labels, store).Execute(context.Background(), dto.ExportDatasetRequest{
+			TenantID: tenantID, From: from, To: to,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, resp.Rows)
+		assert.Equal(t, 1, resp.FraudRows)
+		assert.Equal(t, 2, resp.LegitimateRows)
+		require.Len(t, store.objects, 1)
+		for key, data := range store.objects {
+			assert.True(t, strings.HasPrefix(key, "fraud-training/"+tenantID.String()+"/20260101_20260201/"))
+			assert.Equal(t, "mem://"+key, resp.URI)
+			assert.Len(t, strings.Split(strings.TrimSpace(string(data)), "\n"), 3)
+		}
+	})
+
+	t.Run("rejects an inverted window", func(t *testing.T) {
+		store := &memDatasetStore{objects: make(map[string][]byte)}
+		_, err := usecase.NewExportTrainingDataset(newMockLabelRepository(), store).Execute(context.Background(), dto.ExportDatasetRequest{
+			TenantID: tenantID, From: to, To: from,
+		})
+		require.ErrorIs(t, err, usecase.ErrInvalidInput)
+	})
+}
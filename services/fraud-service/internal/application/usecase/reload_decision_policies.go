@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/service"
+)
+
+// ReloadDecisionPolicies refreshes the in-memory policy resolver from the
+// policy repository. Like ReloadRules, it runs on a timer and immediately
+// after a policy change made through this replica.
+type ReloadDecisionPolicies struct {
+	repo     port.DecisionPolicyRepository
+	resolver *service.PolicyResolver
+}
+
+// NewReloadDecisionPolicies creates a new ReloadDecisionPolicies use case.
+func NewReloadDecisionPolicies(repo port.DecisionPolicyRepository, resolver *service.PolicyResolver) *ReloadDecisionPolicies {
+	return &ReloadDecisionPolicies{repo: repo, resolver: resolver}
+}
+
+// Execute loads every policy version and swaps them into the resolver.
+// It returns the number of versions loaded.
+func (uc *ReloadDecisionPolicies) Execute(ctx context.Context) (int, error) {
+	policies, err := uc.repo.ListAll(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load decision policies: %w", err)
+	}
+	uc.resolver.Load(policies)
+	return len(policies), nil
+}
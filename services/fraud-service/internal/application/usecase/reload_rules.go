@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/service"
+)
+
+// ReloadRules refreshes the in-memory rule engine from the rule repository.
+// It runs on a timer so changes made through any replica take effect
+// everywhere, and immediately after a rule is created or revised locally.
+type ReloadRules struct {
+	repo   port.RuleRepository
+	engine *service.RuleEngine
+}
+
+// NewReloadRules creates a new ReloadRules use case.
+func NewReloadRules(repo port.RuleRepository, engine *service.RuleEngine) *ReloadRules {
+	return &ReloadRules{repo: repo, engine: engine}
+}
+
+// Execute loads all evaluated rules and swaps them into the engine.
+// It returns the number of rules loaded.
+func (uc *ReloadRules) Execute(ctx context.Context) (int, error) {
+	rules, err := uc.repo.ListEvaluated(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load fraud rules: %w", err)
+	}
+	uc.engine.Load(rules)
+	return len(rules), nil
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/service"
+)
+
+// ReloadWatchlists refreshes the in-memory sanctions screener from the
+// watchlist source. It runs on a timer so list publications from OFAC, the
+// UN and the EU are picked up without a restart.
+type ReloadWatchlists struct {
+	source   port.WatchlistSource
+	screener *service.SanctionsScreener
+}
+
+// NewReloadWatchlists creates a new ReloadWatchlists use case.
+func NewReloadWatchlists(source port.WatchlistSource, screener *service.SanctionsScreener) *ReloadWatchlists {
+	return &ReloadWatchlists{source: source, screener: screener}
+}
+
+// Execute fetches the lists and swaps them into the screener. It returns the
+// number of entries loaded. On failure the previously loaded lists stay in use.
+func (uc *ReloadWatchlists) Execute(ctx context.Context) (int, error) {
+	entries, version, err := uc.source.Fetch(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch watchlists: %w", err)
+	}
+	if len(entries) == 0 {
+		return 0, fmt.Errorf("watchlist source returned no entries")
+	}
+	uc.screener.Load(entries, version)
+	return len(entries), nil
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// ResolveCase is the use case for closing a case with a final disposition.
+type ResolveCase struct {
+	repo        port.CaseRepository
+	publisher   port.EventPublisher
+	recordLabel *RecordLabel
+}
+
+// NewResolveCase creates a new ResolveCase use case.
+func NewResolveCase(repo port.CaseRepository, publisher port.EventPublisher, recordLabel *RecordLabel) *ResolveCase {
+	return &ResolveCase{repo: repo, publisher: publisher, recordLabel: recordLabel}
+}
+
+// Execute resolves the case as CONFIRMED_FRAUD or FALSE_POSITIVE and feeds
+// the outcome back as a training label for the assessment, if it has one.
+func (uc *ResolveCase) Execute(ctx context.Context, req dto.ResolveCaseRequest) (dto.CaseResponse, error) {
+	disposition, err := valueobject.CaseDispositionFromString(req.Disposition)
+	if err != nil {
+		return dto.CaseResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	c, err := loadCase(ctx, uc.repo, req.TenantID, req.CaseID)
+	if err != nil {
+		return dto.CaseResponse{}, err
+	}
+
+	if err := c.Resolve(req.ActorID, disposition, req.Note); err != nil {
+		return dto.CaseResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	// The label is recorded before the case is saved so a failure leaves the
+	// case open and the whole resolution can be retried. AML cases have no
+	// assessment to label.
+	if !c.IsAMLAlert() {
+		label, err := valueobject.FraudLabelFromDisposition(disposition)
+		if err != nil {
+			return dto.CaseResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		if _, err := uc.recordLabel.Execute(ctx, dto.RecordLabelRequest{
+			TenantID:     c.TenantID(),
+			AssessmentID: c.AssessmentID(),
+			Label:        label.String(),
+			Source:       valueobject.LabelSourceCaseReview.String(),
+			SourceRef:    c.ID().String(),
+			LabeledBy:    req.ActorID,
+		}); err != nil {
+			return dto.CaseResponse{}, fmt.Errorf("failed to record label: %w", err)
+		}
+	}
+
+	if err := saveCase(ctx, uc.repo, uc.publisher, c); err != nil {
+		return dto.CaseResponse{}, err
+	}
+	return dto.FromCaseModel(c, time.Now().UTC()), nil
+}
@@ -0,0 +1,157 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+type mockLabelRepository struct {
+	labels   map[uuid.UUID]*model.AssessmentLabel
+	examples []model.TrainingExample
+}
+
+func newMockLabelRepository() *mockLabelRepository {
+	return &mockLabelRepository{labels: make(map[uuid.UUID]*model.AssessmentLabel)}
+}
+
+func (m *mockLabelRepository) Save(_ context.Context, l *model.AssessmentLabel) error {
+	m.labels[l.AssessmentID()] = l
+	return nil
+}
+
+func (m *mockLabelRepository) FindByAssessmentID(_ context.Context, _, assessmentID uuid.UUID) (*model.AssessmentLabel, error) {
+	return m.labels[assessmentID], nil
+}
+
+func (m *mockLabelRepository) ListTrainingExamples(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]model.TrainingExample, error) {
+	return m.examples, nil
+}
+
+// seedReviewCase stores a REVIEW assessment and its open case, returning a
+// RecordLabel use case wired to the same assessment.
+func seedReviewCase(t *testing.T, repo *mockCaseRepository, labels *mockLabelRepository) (*model.FraudCase, *usecase.RecordLabel) {
+	t.Helper()
+	a, err := model.NewTransactionAssessment(uuid.New(), uuid.New(), uuid.New(), decimal.NewFromInt(1000), "USD", "transfer")
+	require.NoError(t, err)
+	require.NoError(t, a.Assess(45, nil))
+	a.DomainEvents()
+	c, err := model.OpenCaseForAssessment(a)
+	require.NoError(t, err)
+	c.DomainEvents()
+	repo.cases[c.ID()] = c
+
+	assessments := &mockAssessmentRepository{
+		findByIDFunc: func(_ context.Context, tenantID, id uuid.UUID) (*model.TransactionAssessment, error) {
+			if tenantID == a.TenantID() && id == a.ID() {
+				return a, nil
+			}
+			return nil, nil
+		},
+	}
+	return c, usecase.NewRecordLabel(assessments, labels, &mockFraudEventPublisher{})
+}
+
+func TestResolveCase_Execute(t *testing.T) {
+	t.Run("assigns and resolves a case", func(t *testing.T) {
+		repo := newMockCaseRepository()
+		publisher := &mockFraudEventPublisher{}
+		labels := newMockLabelRepository()
+		c, recordLabel := seedReviewCase(t, repo, labels)
+		analyst := uuid.New()
+
+		_, err := usecase.NewAssignCase(repo, publisher).Execute(context.Background(), dto.AssignCaseRequest{
+			TenantID: c.TenantID(), CaseID: c.ID(), AnalystID: analyst,
+		})
+		require.NoError(t, err)
+
+		resp, err := usecase.NewResolveCase(repo, publisher, recordLabel).Execute(context.Background(), dto.ResolveCaseRequest{
+			TenantID:    c.TenantID(),
+			CaseID:      c.ID(),
+			ActorID:     analyst,
+			Disposition: "CONFIRMED_FRAUD",
+			Note:        "card reported stolen",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "RESOLVED", resp.Status)
+		assert.Equal(t, "CONFIRMED_FRAUD", resp.Disposition)
+		assert.Len(t, resp.Notes, 1)
+		require.NotNil(t, resp.ResolvedAt)
+		require.Len(t, publisher.publishedEvents, 2)
+		assert.Equal(t, "fraud.case.resolved", publisher.publishedEvents[1].EventType())
+
+		label := labels.labels[c.AssessmentID()]
+		require.NotNil(t, label, "resolution feeds a training label")
+		assert.Equal(t, "FRAUD", label.Label().String())
+		assert.Equal(t, "CASE_REVIEW", label.Source().String())
+	})
+
+	t.Run("resolves an AML case without a training label", func(t *testing.T) {
+		repo := newMockCaseRepository()
+		labels := newMockLabelRepository()
+		_, recordLabel := seedReviewCase(t, repo, labels)
+		c, err := model.OpenCaseForAMLAlert(model.AMLAlert{
+			Scenario:       model.AMLScenarioRapidMovement,
+			TransactionIDs: []uuid.UUID{uuid.New()},
+			RiskScore:      70,
+			TenantID:       uuid.New(),
+			AccountID:      uuid.New(),
+		})
+		require.NoError(t, err)
+		repo.cases[c.ID()] = c
+		analyst := uuid.New()
+		require.NoError(t, c.Assign(analyst))
+
+		resp, err := usecase.NewResolveCase(repo, &mockFraudEventPublisher{}, recordLabel).Execute(context.Background(), dto.ResolveCaseRequest{
+			TenantID: c.TenantID(), CaseID: c.ID(), ActorID: analyst, Disposition: "CONFIRMED_FRAUD",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "RESOLVED", resp.Status)
+		assert.Equal(t, "RAPID_MOVEMENT", resp.AMLScenario)
+		assert.Empty(t, labels.labels)
+	})
+
+	t.Run("rejects unknown disposition", func(t *testing.T) {
+		repo := newMockCaseRepository()
+		c, recordLabel := seedReviewCase(t, repo, newMockLabelRepository())
+
+		_, err := usecase.NewResolveCase(repo, &mockFraudEventPublisher{}, recordLabel).Execute(context.Background(), dto.ResolveCaseRequest{
+			TenantID: c.TenantID(), CaseID: c.ID(), ActorID: uuid.New(), Disposition: "MAYBE",
+		})
+
+		require.ErrorIs(t, err, usecase.ErrInvalidInput)
+	})
+
+	t.Run("rejects resolving an unassigned case", func(t *testing.T) {
+		repo := newMockCaseRepository()
+		c, recordLabel := seedReviewCase(t, repo, newMockLabelRepository())
+
+		_, err := usecase.NewResolveCase(repo, &mockFraudEventPublisher{}, recordLabel).Execute(context.Background(), dto.ResolveCaseRequest{
+			TenantID: c.TenantID(), CaseID: c.ID(), ActorID: uuid.New(), Disposition: "FALSE_POSITIVE",
+		})
+
+		require.ErrorIs(t, err, usecase.ErrInvalidInput)
+	})
+
+	t.Run("returns not found for another tenant's case", func(t *testing.T) {
+		repo := newMockCaseRepository()
+		c, recordLabel := seedReviewCase(t, repo, newMockLabelRepository())
+
+		_, err := usecase.NewResolveCase(repo, &mockFraudEventPublisher{}, recordLabel).Execute(context.Background(), dto.ResolveCaseRequest{
+			TenantID: uuid.New(), CaseID: c.ID(), ActorID: uuid.New(), Disposition: "FALSE_POSITIVE",
+		})
+
+		require.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+}
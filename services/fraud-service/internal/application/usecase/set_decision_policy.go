@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// SetDecisionPolicy is the use case for recording a new version of a
+// tenant's decision policy.
+type SetDecisionPolicy struct {
+	repo      port.DecisionPolicyRepository
+	publisher port.EventPublisher
+	reload    *ReloadDecisionPolicies
+	logger    *slog.Logger
+}
+
+// NewSetDecisionPolicy creates a new SetDecisionPolicy use case.
+func NewSetDecisionPolicy(repo port.DecisionPolicyRepository, publisher port.EventPublisher, reload *ReloadDecisionPolicies, logger *slog.Logger) *SetDecisionPolicy {
+	return &SetDecisionPolicy{
+		repo:      repo,
+		publisher: publisher,
+		reload:    reload,
+		logger:    logger,
+	}
+}
+
+// Execute validates the thresholds, appends the policy as the tenant's next
+// version, publishes the change and reloads the local resolver.
+func (uc *SetDecisionPolicy) Execute(ctx context.Context, req dto.SetDecisionPolicyRequest) (dto.DecisionPolicyResponse, error) {
+	previous, err := uc.repo.FindLatest(ctx, req.TenantID)
+	if err != nil {
+		return dto.DecisionPolicyResponse{}, fmt.Errorf("failed to find current policy: %w", err)
+	}
+
+	policy, err := model.NewDecisionPolicy(req.TenantID, req.Thresholds.ToValueObject(), req.EffectiveFrom, req.Reason, req.ActorID, previous)
+	if err != nil {
+		return dto.DecisionPolicyResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	if err := uc.repo.Save(ctx, policy); err != nil {
+		return dto.DecisionPolicyResponse{}, fmt.Errorf("failed to save decision policy: %w", err)
+	}
+
+	if events := policy.DomainEvents(); len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, events...); err != nil {
+			return dto.DecisionPolicyResponse{}, fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+
+	// A failed reload is not fatal: the periodic reload will pick the change up.
+	if _, err := uc.reload.Execute(ctx); err != nil {
+		uc.logger.Warn("failed to reload decision policies after change", "error", err)
+	}
+
+	return dto.FromDecisionPolicyModel(policy), nil
+}
@@ -0,0 +1,124 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/event"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/service"
+)
+
+type mockDecisionPolicyRepository struct {
+	policies []*model.DecisionPolicy
+}
+
+func (m *mockDecisionPolicyRepository) Save(_ context.Context, p *model.DecisionPolicy) error {
+	m.policies = append(m.policies, p)
+	return nil
+}
+
+func (m *mockDecisionPolicyRepository) FindLatest(ctx context.Context, tenantID uuid.UUID) (*model.DecisionPolicy, error) {
+	versions, _ := m.ListVersions(ctx, tenantID)
+	if len(versions) == 0 {
+		return nil, nil
+	}
+	return versions[0], nil
+}
+
+func (m *mockDecisionPolicyRepository) ListVersions(_ context.Context, tenantID uuid.UUID) ([]*model.DecisionPolicy, error) {
+	var out []*model.DecisionPolicy
+	for _, p := range m.policies {
+		if p.TenantID() == tenantID {
+			out = append(out, p)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Version() > out[j].Version() })
+	return out, nil
+}
+
+func (m *mockDecisionPolicyRepository) ListAll(_ context.Context) ([]*model.DecisionPolicy, error) {
+	return m.policies, nil
+}
+
+func strictPolicy() dto.PolicyThresholds {
+	return dto.PolicyThresholds{MediumAt: 20, HighAt: 40, CriticalAt: 60, ReviewAt: 15, DeclineAt: 50}
+}
+
+func TestSetDecisionPolicy_Execute(t *testing.T) {
+	tenantID := uuid.New()
+	actor := uuid.New()
+
+	t.Run("records versions, publishes and reloads the resolver", func(t *testing.T) {
+		repo := &mockDecisionPolicyRepository{}
+		publisher := &mockFraudEventPublisher{}
+		resolver := service.NewPolicyResolver()
+		uc := usecase.NewSetDecisionPolicy(repo, publisher, usecase.NewReloadDecisionPolicies(repo, resolver), slog.Default())
+
+		resp, err := uc.Execute(context.Background(), dto.SetDecisionPolicyRequest{
+			TenantID: tenantID, ActorID: actor, Thresholds: strictPolicy(), Reason: "card testing attack",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, resp.Version)
+		assert.Equal(t, actor, resp.CreatedBy)
+		require.Len(t, publisher.publishedEvents, 1)
+		assert.Equal(t, event.EventTypeDecisionPolicyChanged, publisher.publishedEvents[0].EventType())
+
+		thresholds, version := resolver.Resolve(tenantID, time.Now().Add(time.Second))
+		assert.Equal(t, 1, version)
+		assert.Equal(t, 50, thresholds.DeclineAt)
+
+		resp, err = uc.Execute(context.Background(), dto.SetDecisionPolicyRequest{
+			TenantID: tenantID, ActorID: actor, Thresholds: strictPolicy(),
+			EffectiveFrom: time.Now().Add(24 * time.Hour), Reason: "scheduled review",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, resp.Version)
+
+		_, version = resolver.Resolve(tenantID, time.Now().Add(time.Second))
+		assert.Equal(t, 1, version, "scheduled version is not yet in force")
+
+		current, err := usecase.NewGetDecisionPolicy(repo).Execute(context.Background(), dto.GetDecisionPolicyRequest{TenantID: tenantID})
+		require.NoError(t, err)
+		assert.Equal(t, 1, current.Effective.Version)
+		require.Len(t, current.Scheduled, 1)
+		assert.Equal(t, 2, current.Scheduled[0].Version)
+
+		history, err := usecase.NewListDecisionPolicyVersions(repo).Execute(context.Background(), dto.GetDecisionPolicyRequest{TenantID: tenantID})
+		require.NoError(t, err)
+		require.Len(t, history.Versions, 2)
+		assert.Equal(t, "scheduled review", history.Versions[0].Reason)
+	})
+
+	t.Run("rejects invalid thresholds", func(t *testing.T) {
+		repo := &mockDecisionPolicyRepository{}
+		uc := usecase.NewSetDecisionPolicy(repo, &mockFraudEventPublisher{}, usecase.NewReloadDecisionPolicies(repo, service.NewPolicyResolver()), slog.Default())
+
+		bad := strictPolicy()
+		bad.HighAt = bad.CriticalAt
+		_, err := uc.Execute(context.Background(), dto.SetDecisionPolicyRequest{
+			TenantID: tenantID, ActorID: actor, Thresholds: bad, Reason: "typo",
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, usecase.ErrInvalidInput))
+		assert.Empty(t, repo.policies)
+	})
+}
+
+func TestGetDecisionPolicy_DefaultsWithoutPolicy(t *testing.T) {
+	resp, err := usecase.NewGetDecisionPolicy(&mockDecisionPolicyRepository{}).Execute(context.Background(), dto.GetDecisionPolicyRequest{TenantID: uuid.New()})
+	require.NoError(t, err)
+	assert.Equal(t, 0, resp.Effective.Version)
+	assert.Equal(t, 71, resp.Effective.Thresholds.DeclineAt)
+	assert.Empty(t, resp.Scheduled)
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+const (
+	// defaultSimulationDays is the replay window when the request leaves it unset.
+	defaultSimulationDays = 30
+	// maxSimulationDays bounds the replay window to keep the query cheap.
+	maxSimulationDays = 90
+)
+
+// SimulateDecisionPolicy is the use case for replaying recent traffic under
+// proposed thresholds before they are adopted.
+type SimulateDecisionPolicy struct {
+	assessments port.AssessmentRepository
+}
+
+// NewSimulateDecisionPolicy creates a new SimulateDecisionPolicy use case.
+func NewSimulateDecisionPolicy(assessments port.AssessmentRepository) *SimulateDecisionPolicy {
+	return &SimulateDecisionPolicy{assessments: assessments}
+}
+
+// Execute re-decides the tenant's assessments from the last N days under the
+// proposed thresholds and reports how the decision mix would change. Scores
+// are replayed as recorded; the sanctions override still applies.
+func (uc *SimulateDecisionPolicy) Execute(ctx context.Context, req dto.SimulateDecisionPolicyRequest) (dto.SimulateDecisionPolicyResponse, error) {
+	days := req.Days
+	if days == 0 {
+		days = defaultSimulationDays
+	}
+	if days < 0 || days > maxSimulationDays {
+		return dto.SimulateDecisionPolicyResponse{}, fmt.Errorf("%w: days must be between 1 and %d", ErrInvalidInput, maxSimulationDays)
+	}
+
+	proposed := req.Thresholds.ToValueObject()
+	if err := proposed.Validate(); err != nil {
+		return dto.SimulateDecisionPolicyResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	to := time.Now().UTC()
+	from := to.AddDate(0, 0, -days)
+	buckets, err := uc.assessments.ScoreDistribution(ctx, req.TenantID, from, to)
+	if err != nil {
+		return dto.SimulateDecisionPolicyResponse{}, fmt.Errorf("failed to load score distribution: %w", err)
+	}
+
+	resp := dto.SimulateDecisionPolicyResponse{
+		From:        from,
+		To:          to,
+		Actual:      make(map[string]int),
+		Proposed:    make(map[string]int),
+		Transitions: make([]dto.DecisionTransition, 0),
+	}
+	transitions := make(map[[2]string]int)
+	for _, b := range buckets {
+		actual := b.Decision.String()
+		next := b.DecisionUnder(proposed).String()
+		resp.Total += b.Count
+		resp.Actual[actual] += b.Count
+		resp.Proposed[next] += b.Count
+		if actual != next {
+			resp.Changed += b.Count
+			transitions[[2]string{actual, next}] += b.Count
+		}
+	}
+
+	for k, n := range transitions {
+		resp.Transitions = append(resp.Transitions, dto.DecisionTransition{From: k[0], To: k[1], Count: n})
+	}
+	sort.Slice(resp.Transitions, func(i, j int) bool {
+		a, b := resp.Transitions[i], resp.Transitions[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		return a.To < b.To
+	})
+
+	return resp, nil
+}
@@ -0,0 +1,56 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+func TestSimulateDecisionPolicy_Execute(t *testing.T) {
+	repo := &mockAssessmentRepository{buckets: []model.ScoreBucket{
+		{Score: 10, Decision: valueobject.DecisionApprove, Count: 50},
+		{Score: 20, Decision: valueobject.DecisionApprove, Count: 7},
+		{Score: 55, Decision: valueobject.DecisionReview, Count: 4},
+		{Score: 90, Decision: valueobject.DecisionDecline, Count: 2},
+		{Score: 5, Decision: valueobject.DecisionReview, Count: 1, SanctionsHit: true},
+	}}
+	uc := usecase.NewSimulateDecisionPolicy(repo)
+
+	t.Run("reports decision changes under proposed thresholds", func(t *testing.T) {
+		resp, err := uc.Execute(context.Background(), dto.SimulateDecisionPolicyRequest{
+			TenantID: uuid.New(), Thresholds: strictPolicy(),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 30*24, int(resp.To.Sub(resp.From).Hours()), "defaults to 30 days")
+		assert.Equal(t, 64, resp.Total)
+		assert.Equal(t, 11, resp.Changed)
+		assert.Equal(t, map[string]int{"APPROVE": 57, "REVIEW": 5, "DECLINE": 2}, resp.Actual)
+		assert.Equal(t, map[string]int{"APPROVE": 50, "REVIEW": 8, "DECLINE": 6}, resp.Proposed)
+		assert.Equal(t, []dto.DecisionTransition{
+			{From: "APPROVE", To: "REVIEW", Count: 7},
+			{From: "REVIEW", To: "DECLINE", Count: 4},
+		}, resp.Transitions)
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		_, err := uc.Execute(context.Background(), dto.SimulateDecisionPolicyRequest{
+			TenantID: uuid.New(), Thresholds: strictPolicy(), Days: 365,
+		})
+		assert.True(t, errors.Is(err, usecase.ErrInvalidInput))
+
+		_, err = uc.Execute(context.Background(), dto.SimulateDecisionPolicyRequest{
+			TenantID: uuid.New(), Thresholds: dto.PolicyThresholds{},
+		})
+		assert.True(t, errors.Is(err, usecase.ErrInvalidInput))
+	})
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// UpdateRule is the use case for revising an existing fraud rule.
+type UpdateRule struct {
+	repo      port.RuleRepository
+	publisher port.EventPublisher
+	reload    *ReloadRules
+	logger    *slog.Logger
+}
+
+// NewUpdateRule creates a new UpdateRule use case.
+func NewUpdateRule(repo port.RuleRepository, publisher port.EventPublisher, reload *ReloadRules, logger *slog.Logger) *UpdateRule {
+	return &UpdateRule{
+		repo:      repo,
+		publisher: publisher,
+		reload:    reload,
+		logger:    logger,
+	}
+}
+
+// Execute replaces the rule definition, creating a new version.
+func (uc *UpdateRule) Execute(ctx context.Context, req dto.UpdateRuleRequest) (dto.RuleResponse, error) {
+	rule, err := uc.repo.FindByID(ctx, req.TenantID, req.RuleID)
+	if err != nil {
+		return dto.RuleResponse{}, fmt.Errorf("failed to find rule: %w", err)
+	}
+	if rule == nil {
+		return dto.RuleResponse{}, fmt.Errorf("%w: rule %s", ErrNotFound, req.RuleID)
+	}
+
+	def, err := ruleDefinition(req.Description, req.Condition, req.Signal, req.Mode, req.ScoreImpact)
+	if err != nil {
+		return dto.RuleResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	if err := rule.Revise(def, req.ActorID); err != nil {
+		return dto.RuleResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	if err := uc.repo.Save(ctx, rule); err != nil {
+		return dto.RuleResponse{}, fmt.Errorf("failed to save rule: %w", err)
+	}
+
+	if events := rule.DomainEvents(); len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, events...); err != nil {
+			return dto.RuleResponse{}, fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+
+	reloadAfterChange(ctx, uc.reload, uc.logger)
+
+	return dto.FromRuleModel(rule), nil
+}
@@ -0,0 +1,22 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[AssessmentCompleted](EventTypeAssessmentCompleted, 1, "Published when a fraud assessment has been completed for a transaction."),
+		events.Declare[HighRiskDetected](EventTypeHighRiskDetected, 1, "Published when a transaction is assessed with CRITICAL risk level, triggering alerts and potential account freezes."),
+		events.Declare[RuleChanged](EventTypeRuleCreated, 1, "Published whenever a fraud rule is created or revised, so other scoring replicas can reload and auditors can trace rule history."),
+		events.Declare[RuleChanged](EventTypeRuleRevised, 1, "Published whenever a fraud rule is created or revised, so other scoring replicas can reload and auditors can trace rule history."),
+		events.Declare[RuleChanged](EventTypeRuleDisabled, 1, "Published when an operator disables a fraud rule, taking it out of scoring."),
+		events.Declare[CaseOpened](EventTypeCaseOpened, 1, "Published when an assessment lands in the REVIEW band or an AML scenario raises an alert, and a case is added to the manual review queue."),
+		events.Declare[CaseAssigned](EventTypeCaseAssigned, 1, "Published when a case is assigned or reassigned to an analyst."),
+		events.Declare[CaseResolved](EventTypeCaseResolved, 1, "Published when a review case is closed."),
+		events.Declare[CaseSLABreached](EventTypeCaseSLABreached, 1, "Published once when a review case passes its SLA deadline without being resolved."),
+		events.Declare[LabelRecorded](EventTypeLabelRecorded, 1, "Published when an assessment receives a ground-truth fraud label, from case review or a chargeback."),
+		events.Declare[ScreeningHit](EventTypeScreeningHit, 1, "Published when a counterparty or destination of a transaction matches a sanctions list."),
+		events.Declare[DecisionPolicyChanged](EventTypeDecisionPolicyChanged, 1, "Published when a new version of a tenant's decision policy is recorded."),
+	}
+}
@@ -17,6 +17,26 @@ const (
 
 	// EventTypeHighRiskDetected is emitted when a CRITICAL risk level is detected.
 	EventTypeHighRiskDetected = "fraud.high_risk.detected"
+	// EventTypeRuleCreated is emitted when an operator creates a fraud rule.
+	EventTypeRuleCreated = "fraud.rule.created"
+	// EventTypeRuleRevised is emitted when a fraud rule definition changes.
+	EventTypeRuleRevised = "fraud.rule.revised"
+	// EventTypeRuleDisabled is emitted when an operator takes a fraud rule out of scoring.
+	EventTypeRuleDisabled = "fraud.rule.disabled"
+	// EventTypeCaseOpened is emitted when a REVIEW assessment or an AML alert is queued for manual review.
+	EventTypeCaseOpened = "fraud.case.opened"
+	// EventTypeCaseAssigned is emitted when a case is assigned to an analyst.
+	EventTypeCaseAssigned = "fraud.case.assigned"
+	// EventTypeCaseResolved is emitted when an analyst records a final disposition.
+	EventTypeCaseResolved = "fraud.case.resolved"
+	// EventTypeCaseSLABreached is emitted when a case passes its review deadline unresolved.
+	EventTypeCaseSLABreached = "fraud.case.sla.breached"
+	// EventTypeLabelRecorded is emitted when a ground-truth label is attached to an assessment.
+	EventTypeLabelRecorded = "fraud.label.recorded"
+	// EventTypeScreeningHit is emitted when a transaction matches a sanctions list.
+	EventTypeScreeningHit = "fraud.screening.hit"
+	// EventTypeDecisionPolicyChanged is emitted when a tenant's decision policy gets a new version.
+	EventTypeDecisionPolicyChanged = "fraud.policy.changed"
 )
 
 // AssessmentCompleted is published when a fraud assessment has been completed
@@ -26,6 +46,7 @@ type AssessmentCompleted struct {
 	events.BaseEvent
 	RiskLevel     string    `json:"risk_level"`
 	Decision      string    `json:"decision"`
+	ModelVersion  string    `json:"model_version,omitempty"`
 	Signals       []string  `json:"signals"`
 	RiskScore     int       `json:"risk_score"`
 	AssessmentID  uuid.UUID `json:"assessment_id"`
@@ -33,7 +54,7 @@ type AssessmentCompleted struct {
 	AccountID     uuid.UUID `json:"account_id"`
 }
 
-func NewAssessmentCompleted(assessmentID, tenantID, transactionID, accountID uuid.UUID, riskScore int, riskLevel, decision string, signals []string, assessedAt time.Time) AssessmentCompleted {
+func NewAssessmentCompleted(assessmentID, tenantID, transactionID, accountID uuid.UUID, riskScore int, riskLevel, decision string, signals []string, modelVersion string, assessedAt time.Time) AssessmentCompleted {
 	return AssessmentCompleted{
 		BaseEvent:     events.NewBaseEvent(EventTypeAssessmentCompleted, assessmentID.String(), "FraudAssessment", tenantID.String()),
 		AssessedAt:    assessedAt,
@@ -43,6 +64,7 @@ func NewAssessmentCompleted(assessmentID, tenantID, transactionID, accountID uui
 		AccountID:     accountID,
 		RiskLevel:     riskLevel,
 		Decision:      decision,
+		ModelVersion:  modelVersion,
 		RiskScore:     riskScore,
 	}
 }
@@ -70,3 +92,219 @@ func NewHighRiskDetected(assessmentID, tenantID, transactionID, accountID uuid.U
 		RiskScore:     riskScore,
 	}
 }
+
+// RuleChanged is published whenever a fraud rule is created, revised or
+// disabled, so other scoring replicas can reload and auditors can trace rule
+// history.
+type RuleChanged struct {
+	ChangedAt time.Time `json:"changed_at"`
+	events.BaseEvent
+	Name      string    `json:"name"`
+	Mode      string    `json:"mode"`
+	Version   int       `json:"version"`
+	RuleID    uuid.UUID `json:"rule_id"`
+	ChangedBy uuid.UUID `json:"changed_by"`
+}
+
+func NewRuleChanged(eventType string, ruleID, tenantID uuid.UUID, name, mode string, version int, changedBy uuid.UUID, changedAt time.Time) RuleChanged {
+	return RuleChanged{
+		BaseEvent: events.NewBaseEvent(eventType, ruleID.String(), "FraudRule", tenantID.String()),
+		ChangedAt: changedAt,
+		RuleID:    ruleID,
+		Name:      name,
+		Mode:      mode,
+		Version:   version,
+		ChangedBy: changedBy,
+	}
+}
+
+// CaseOpened is published when an assessment lands in the REVIEW band or an
+// AML scenario raises an alert, and a case is added to the manual review
+// queue. AssessmentID is uuid.Nil and AMLScenario set for AML alerts.
+type CaseOpened struct {
+	SLADueAt time.Time `json:"sla_due_at"`
+	events.BaseEvent
+	AMLScenario   string    `json:"aml_scenario,omitempty"`
+	RiskScore     int       `json:"risk_score"`
+	CaseID        uuid.UUID `json:"case_id"`
+	AssessmentID  uuid.UUID `json:"assessment_id"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	AccountID     uuid.UUID `json:"account_id"`
+}
+
+func NewCaseOpened(caseID, tenantID, assessmentID, transactionID, accountID uuid.UUID, riskScore int, amlScenario string, slaDueAt time.Time) CaseOpened {
+	return CaseOpened{
+		BaseEvent:     events.NewBaseEvent(EventTypeCaseOpened, caseID.String(), "FraudCase", tenantID.String()),
+		SLADueAt:      slaDueAt,
+		AMLScenario:   amlScenario,
+		RiskScore:     riskScore,
+		CaseID:        caseID,
+		AssessmentID:  assessmentID,
+		TransactionID: transactionID,
+		AccountID:     accountID,
+	}
+}
+
+// CaseAssigned is published when a case is assigned or reassigned to an analyst.
+type CaseAssigned struct {
+	AssignedAt time.Time `json:"assigned_at"`
+	events.BaseEvent
+	CaseID     uuid.UUID `json:"case_id"`
+	AssigneeID uuid.UUID `json:"assignee_id"`
+}
+
+func NewCaseAssigned(caseID, tenantID, assigneeID uuid.UUID, assignedAt time.Time) CaseAssigned {
+	return CaseAssigned{
+		BaseEvent:  events.NewBaseEvent(EventTypeCaseAssigned, caseID.String(), "FraudCase", tenantID.String()),
+		AssignedAt: assignedAt,
+		CaseID:     caseID,
+		AssigneeID: assigneeID,
+	}
+}
+
+// CaseResolved is published when a review case is closed. Downstream
+// consumers use the disposition to release or reverse held transactions and
+// to label training data for the ML model.
+type CaseResolved struct {
+	ResolvedAt time.Time `json:"resolved_at"`
+	events.BaseEvent
+	Disposition   string    `json:"disposition"`
+	SLABreached   bool      `json:"sla_breached"`
+	CaseID        uuid.UUID `json:"case_id"`
+	AssessmentID  uuid.UUID `json:"assessment_id"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	AccountID     uuid.UUID `json:"account_id"`
+	ResolvedBy    uuid.UUID `json:"resolved_by"`
+}
+
+func NewCaseResolved(caseID, tenantID, assessmentID, transactionID, accountID uuid.UUID, disposition string, resolvedBy uuid.UUID, slaBreached bool, resolvedAt time.Time) CaseResolved {
+	return CaseResolved{
+		BaseEvent:     events.NewBaseEvent(EventTypeCaseResolved, caseID.String(), "FraudCase", tenantID.String()),
+		ResolvedAt:    resolvedAt,
+		Disposition:   disposition,
+		SLABreached:   slaBreached,
+		CaseID:        caseID,
+		AssessmentID:  assessmentID,
+		TransactionID: transactionID,
+		AccountID:     accountID,
+		ResolvedBy:    resolvedBy,
+	}
+}
+
+// CaseSLABreached is published once when a case passes its review deadline
+// without being resolved, so its queue can be escalated. AssigneeID is
+// uuid.Nil for unassigned cases.
+type CaseSLABreached struct {
+	SLADueAt   time.Time `json:"sla_due_at"`
+	BreachedAt time.Time `json:"breached_at"`
+	events.BaseEvent
+	Status       string    `json:"status"`
+	CaseID       uuid.UUID `json:"case_id"`
+	AssessmentID uuid.UUID `json:"assessment_id"`
+	AccountID    uuid.UUID `json:"account_id"`
+	AssigneeID   uuid.UUID `json:"assignee_id"`
+}
+
+func NewCaseSLABreached(caseID, tenantID, assessmentID, accountID, assigneeID uuid.UUID, status string, slaDueAt, breachedAt time.Time) CaseSLABreached {
+	return CaseSLABreached{
+		BaseEvent:    events.NewBaseEvent(EventTypeCaseSLABreached, caseID.String(), "FraudCase", tenantID.String()),
+		SLADueAt:     slaDueAt,
+		BreachedAt:   breachedAt,
+		Status:       status,
+		CaseID:       caseID,
+		AssessmentID: assessmentID,
+		AccountID:    accountID,
+		AssigneeID:   assigneeID,
+	}
+}
+
+// LabelRecorded is published when an assessment receives a ground-truth
+// fraud label, from case review or a chargeback.
+type LabelRecorded struct {
+	LabeledAt time.Time `json:"labeled_at"`
+	events.BaseEvent
+	Label         string    `json:"label"`
+	Source        string    `json:"source"`
+	SourceRef     string    `json:"source_ref"`
+	AssessmentID  uuid.UUID `json:"assessment_id"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+}
+
+func NewLabelRecorded(assessmentID, tenantID, transactionID uuid.UUID, label, source, sourceRef string, labeledAt time.Time) LabelRecorded {
+	return LabelRecorded{
+		BaseEvent:     events.NewBaseEvent(EventTypeLabelRecorded, assessmentID.String(), "FraudAssessment", tenantID.String()),
+		LabeledAt:     labeledAt,
+		Label:         label,
+		Source:        source,
+		SourceRef:     sourceRef,
+		AssessmentID:  assessmentID,
+		TransactionID: transactionID,
+	}
+}
+
+// ScreeningHit is published when a counterparty or destination of a
+// transaction matches a sanctions list. The transaction is held for review.
+type ScreeningHit struct {
+	ScreenedAt time.Time `json:"screened_at"`
+	events.BaseEvent
+	Lists         []string  `json:"lists"`
+	MatchCount    int       `json:"match_count"`
+	ScreeningID   uuid.UUID `json:"screening_id"`
+	AssessmentID  uuid.UUID `json:"assessment_id"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	AccountID     uuid.UUID `json:"account_id"`
+}
+
+func NewScreeningHit(screeningID, tenantID, assessmentID, transactionID, accountID uuid.UUID, lists []string, matchCount int, screenedAt time.Time) ScreeningHit {
+	return ScreeningHit{
+		BaseEvent:     events.NewBaseEvent(EventTypeScreeningHit, assessmentID.String(), "FraudAssessment", tenantID.String()),
+		ScreenedAt:    screenedAt,
+		Lists:         lists,
+		MatchCount:    matchCount,
+		ScreeningID:   screeningID,
+		AssessmentID:  assessmentID,
+		TransactionID: transactionID,
+		AccountID:     accountID,
+	}
+}
+
+// DecisionPolicyChanged is published when a new version of a tenant's
+// decision policy is recorded.
+type DecisionPolicyChanged struct {
+	EffectiveFrom time.Time `json:"effective_from"`
+	ChangedAt     time.Time `json:"changed_at"`
+	events.BaseEvent
+	Reason     string    `json:"reason"`
+	Version    int       `json:"version"`
+	MediumAt   int       `json:"medium_at"`
+	HighAt     int       `json:"high_at"`
+	CriticalAt int       `json:"critical_at"`
+	ReviewAt   int       `json:"review_at"`
+	DeclineAt  int       `json:"decline_at"`
+	PolicyID   uuid.UUID `json:"policy_id"`
+	ChangedBy  uuid.UUID `json:"changed_by"`
+}
+
+func NewDecisionPolicyChanged(
+	policyID, tenantID uuid.UUID,
+	version, mediumAt, highAt, criticalAt, reviewAt, declineAt int,
+	effectiveFrom time.Time,
+	reason string,
+	changedBy uuid.UUID,
+	changedAt time.Time,
+) DecisionPolicyChanged {
+	return DecisionPolicyChanged{
+		BaseEvent:     events.NewBaseEvent(EventTypeDecisionPolicyChanged, tenantID.String(), "DecisionPolicy", tenantID.String()),
+		EffectiveFrom: effectiveFrom,
+		ChangedAt:     changedAt,
+		Reason:        reason,
+		Version:       version,
+		MediumAt:      mediumAt,
+		HighAt:        highAt,
+		CriticalAt:    criticalAt,
+		ReviewAt:      reviewAt,
+		DeclineAt:     declineAt,
+		PolicyID:      policyID,
+		ChangedBy:     changedBy,
+	}
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Link entity types: the things an account can share with other accounts in
+// the link graph.
+const (
+	LinkDevice             = "DEVICE"
+	LinkIP                 = "IP"
+	LinkDestinationAccount = "DESTINATION_ACCOUNT"
+)
+
+// LinkEntity is a non-account node of the link graph.
+type LinkEntity struct {
+	Type  string
+	Value string
+}
+
+// AccountLink is an edge of the link graph: an account used an entity.
+// Two accounts are linked when they share an entity.
+type AccountLink struct {
+	SeenAt    time.Time
+	Entity    LinkEntity
+	TenantID  uuid.UUID
+	AccountID uuid.UUID
+}
+
+// LinkedAccount is an account connected to another through the entities in
+// Shared. ConfirmedFraud is set when one of its assessments carries a FRAUD label.
+type LinkedAccount struct {
+	Shared         []LinkEntity
+	AccountID      uuid.UUID
+	ConfirmedFraud bool
+}
+
+// TransactionLinks returns the edges a transaction adds to the graph. Empty
+// values are skipped; a payment to the account itself is not a link.
+func TransactionLinks(tenantID, accountID uuid.UUID, fingerprint, ip, destinationAccount string, at time.Time) []AccountLink {
+	var links []AccountLink
+	add := func(entityType, value string) {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return
+		}
+		links = append(links, AccountLink{
+			TenantID:  tenantID,
+			AccountID: accountID,
+			Entity:    LinkEntity{Type: entityType, Value: value},
+			SeenAt:    at,
+		})
+	}
+	add(LinkDevice, fingerprint)
+	add(LinkIP, ip)
+	if !strings.EqualFold(strings.TrimSpace(destinationAccount), accountID.String()) {
+		add(LinkDestinationAccount, destinationAccount)
+	}
+	return links
+}
+
+// Entities returns the entities of the given links.
+func Entities(links []AccountLink) []LinkEntity {
+	entities := make([]LinkEntity, 0, len(links))
+	for _, l := range links {
+		entities = append(entities, l.Entity)
+	}
+	return entities
+}
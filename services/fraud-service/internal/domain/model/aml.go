@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// AML monitoring scenarios: the patterns of account activity that raise an
+// alert into the case queue.
+const (
+	// AMLScenarioStructuring is several payments each just below the
+	// reporting threshold, splitting a sum to avoid a report.
+	AMLScenarioStructuring = "STRUCTURING"
+	// AMLScenarioRapidMovement is funds paid out of an account soon after
+	// they were paid in.
+	AMLScenarioRapidMovement = "RAPID_MOVEMENT"
+	// AMLScenarioHighRiskGeography is the value an account sends to
+	// high-risk countries adding up past a threshold.
+	AMLScenarioHighRiskGeography = "HIGH_RISK_GEOGRAPHY"
+)
+
+// Directions of a monitored transaction, relative to its account.
+const (
+	FlowIn  = "IN"
+	FlowOut = "OUT"
+)
+
+// MonitoredTransaction is a movement of funds on an account, taken from the
+// payment event stream for AML monitoring. An internal transfer is recorded
+// twice: out of the source account and into the destination account.
+// Country is the ISO 3166 alpha-2 destination of outgoing payments, empty
+// when it is not known.
+type MonitoredTransaction struct {
+	OccurredAt    time.Time
+	EventID       string
+	Direction     string
+	Currency      string
+	Country       string
+	Amount        decimal.Decimal
+	TenantID      uuid.UUID
+	AccountID     uuid.UUID
+	TransactionID uuid.UUID
+}
+
+// AMLAlert is a monitoring scenario matched on an account. TransactionIDs
+// are the transactions that make up the match, oldest first; the last one
+// raised the alert.
+type AMLAlert struct {
+	Scenario       string
+	Summary        string
+	TransactionIDs []uuid.UUID
+	RiskScore      int
+	TenantID       uuid.UUID
+	AccountID      uuid.UUID
+}
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/event"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// AssessmentLabel records the ground-truth outcome of an assessed
+// transaction. There is at most one label per assessment; a more
+// authoritative source replaces a less authoritative one.
+type AssessmentLabel struct {
+	labeledAt    time.Time
+	label        valueobject.FraudLabel
+	source       valueobject.LabelSource
+	sourceRef    string
+	domainEvents []events.DomainEvent
+	assessmentID uuid.UUID
+	tenantID     uuid.UUID
+	labeledBy    uuid.UUID
+}
+
+// NewAssessmentLabel labels an assessment. sourceRef identifies the
+// originating record, e.g. the case or chargeback ID.
+func NewAssessmentLabel(
+	assessment *TransactionAssessment,
+	label valueobject.FraudLabel,
+	source valueobject.LabelSource,
+	sourceRef string,
+	labeledBy uuid.UUID,
+) (*AssessmentLabel, error) {
+	if assessment == nil {
+		return nil, fmt.Errorf("assessment is required")
+	}
+	if label.IsZero() {
+		return nil, fmt.Errorf("label is required")
+	}
+	if source.IsZero() {
+		return nil, fmt.Errorf("label source is required")
+	}
+	sourceRef = strings.TrimSpace(sourceRef)
+	if sourceRef == "" {
+		return nil, fmt.Errorf("source reference is required")
+	}
+
+	l := &AssessmentLabel{
+		assessmentID: assessment.ID(),
+		tenantID:     assessment.TenantID(),
+		label:        label,
+		source:       source,
+		sourceRef:    sourceRef,
+		labeledBy:    labeledBy,
+		labeledAt:    time.Now().UTC(),
+	}
+
+	l.domainEvents = append(l.domainEvents, event.NewLabelRecorded(
+		l.assessmentID, l.tenantID, assessment.TransactionID(),
+		label.String(), source.String(), sourceRef, l.labeledAt,
+	))
+
+	return l, nil
+}
+
+// Supersedes reports whether l should replace an existing label for the
+// same assessment.
+func (l *AssessmentLabel) Supersedes(existing *AssessmentLabel) bool {
+	if existing == nil {
+		return true
+	}
+	return l.source.Precedence() >= existing.source.Precedence()
+}
+
+// ReconstructAssessmentLabel rebuilds an AssessmentLabel from persisted data (no validation, no events).
+func ReconstructAssessmentLabel(
+	assessmentID, tenantID uuid.UUID,
+	label valueobject.FraudLabel,
+	source valueobject.LabelSource,
+	sourceRef string,
+	labeledBy uuid.UUID,
+	labeledAt time.Time,
+) *AssessmentLabel {
+	return &AssessmentLabel{
+		assessmentID: assessmentID,
+		tenantID:     tenantID,
+		label:        label,
+		source:       source,
+		sourceRef:    sourceRef,
+		labeledBy:    labeledBy,
+		labeledAt:    labeledAt,
+		domainEvents: make([]events.DomainEvent, 0),
+	}
+}
+
+// --- Accessors ---
+
+func (l *AssessmentLabel) AssessmentID() uuid.UUID         { return l.assessmentID }
+func (l *AssessmentLabel) TenantID() uuid.UUID             { return l.tenantID }
+func (l *AssessmentLabel) Label() valueobject.FraudLabel   { return l.label }
+func (l *AssessmentLabel) Source() valueobject.LabelSource { return l.source }
+func (l *AssessmentLabel) SourceRef() string               { return l.sourceRef }
+func (l *AssessmentLabel) LabeledBy() uuid.UUID            { return l.labeledBy }
+func (l *AssessmentLabel) LabeledAt() time.Time            { return l.labeledAt }
+
+// DomainEvents returns all accumulated domain events and clears them.
+func (l *AssessmentLabel) DomainEvents() []events.DomainEvent {
+	evts := l.domainEvents
+	l.domainEvents = make([]events.DomainEvent, 0)
+	return evts
+}
+
+// TrainingExample is one row of a labeled training dataset: the features
+// captured at assessment time joined with the ground-truth label.
+type TrainingExample struct {
+	AssessedAt      time.Time       `json:"assessed_at"`
+	LabeledAt       time.Time       `json:"labeled_at"`
+	Amount          decimal.Decimal `json:"amount"`
+	Currency        string          `json:"currency"`
+	TransactionType string          `json:"transaction_type"`
+	RiskLevel       string          `json:"risk_level"`
+	Decision        string          `json:"decision"`
+	Label           string          `json:"label"`
+	LabelSource     string          `json:"label_source"`
+	Signals         []string        `json:"signals"`
+	RiskScore       int             `json:"risk_score"`
+	AssessmentID    uuid.UUID       `json:"assessment_id"`
+	TransactionID   uuid.UUID       `json:"transaction_id"`
+	AccountID       uuid.UUID       `json:"account_id"`
+}
@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// Auth anomaly kinds: the failed-authentication thresholds the gateway
+// reports a client crossing.
+const (
+	AuthAnomalyStepUp  = "STEP_UP_REQUIRED"
+	AuthAnomalyLockout = "LOCKED_OUT"
+)
+
+// AuthAnomaly is a client crossing a failed-authentication threshold at the
+// gateway. ClientIP is the address of the attempt that crossed it; UserID,
+// when known, is the user the rejected tokens claimed and is unverified.
+type AuthAnomaly struct {
+	OccurredAt time.Time
+	EventID    string
+	Kind       string
+	ClientIP   string
+	UserID     string
+	Failures   int
+}
+
+// AuthAnomalySummary counts the auth anomalies recorded for an IP address.
+type AuthAnomalySummary struct {
+	StepUps     int
+	Lockouts    int
+	MaxFailures int
+}
@@ -0,0 +1,174 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/event"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// policyBackdateTolerance allows for clock skew between the caller and the
+// service when a policy is made effective "now".
+const policyBackdateTolerance = time.Minute
+
+// DecisionPolicy is one version of a tenant's decision policy: the score
+// cutoffs in force from effectiveFrom until the next version takes effect.
+// Versions are immutable; every change creates a new version, so the
+// version history is the audit trail.
+type DecisionPolicy struct {
+	effectiveFrom time.Time
+	createdAt     time.Time
+	reason        string
+	thresholds    valueobject.DecisionThresholds
+	domainEvents  []events.DomainEvent
+	version       int
+	createdBy     uuid.UUID
+	tenantID      uuid.UUID
+	id            uuid.UUID
+}
+
+// NewDecisionPolicy creates the next version of a tenant's policy. previous
+// is the tenant's latest version, or nil for the first policy. A zero
+// effectiveFrom means immediately; policies cannot be backdated.
+func NewDecisionPolicy(
+	tenantID uuid.UUID,
+	thresholds valueobject.DecisionThresholds,
+	effectiveFrom time.Time,
+	reason string,
+	createdBy uuid.UUID,
+	previous *DecisionPolicy,
+) (*DecisionPolicy, error) {
+	if tenantID == uuid.Nil {
+		return nil, fmt.Errorf("tenant ID is required")
+	}
+	if err := thresholds.Validate(); err != nil {
+		return nil, err
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, fmt.Errorf("a reason for the policy change is required")
+	}
+
+	now := time.Now().UTC()
+	if effectiveFrom.IsZero() {
+		effectiveFrom = now
+	}
+	effectiveFrom = effectiveFrom.UTC()
+	if effectiveFrom.Before(now.Add(-policyBackdateTolerance)) {
+		return nil, fmt.Errorf("effective_from cannot be in the past")
+	}
+
+	version := 1
+	if previous != nil {
+		if previous.tenantID != tenantID {
+			return nil, fmt.Errorf("previous policy belongs to another tenant")
+		}
+		version = previous.version + 1
+	}
+
+	p := &DecisionPolicy{
+		id:            uuid.New(),
+		tenantID:      tenantID,
+		version:       version,
+		thresholds:    thresholds,
+		effectiveFrom: effectiveFrom,
+		reason:        reason,
+		createdBy:     createdBy,
+		createdAt:     now,
+	}
+
+	p.domainEvents = append(p.domainEvents, event.NewDecisionPolicyChanged(
+		p.id, tenantID, version,
+		thresholds.MediumAt, thresholds.HighAt, thresholds.CriticalAt, thresholds.ReviewAt, thresholds.DeclineAt,
+		effectiveFrom, reason, createdBy, now,
+	))
+
+	return p, nil
+}
+
+// ReconstructDecisionPolicy rebuilds a DecisionPolicy from persisted data (no validation, no events).
+func ReconstructDecisionPolicy(
+	id, tenantID uuid.UUID,
+	version int,
+	thresholds valueobject.DecisionThresholds,
+	effectiveFrom time.Time,
+	reason string,
+	createdBy uuid.UUID,
+	createdAt time.Time,
+) *DecisionPolicy {
+	return &DecisionPolicy{
+		id:            id,
+		tenantID:      tenantID,
+		version:       version,
+		thresholds:    thresholds,
+		effectiveFrom: effectiveFrom,
+		reason:        reason,
+		createdBy:     createdBy,
+		createdAt:     createdAt,
+		domainEvents:  make([]events.DomainEvent, 0),
+	}
+}
+
+// --- Accessors ---
+
+func (p *DecisionPolicy) ID() uuid.UUID                              { return p.id }
+func (p *DecisionPolicy) TenantID() uuid.UUID                        { return p.tenantID }
+func (p *DecisionPolicy) Version() int                               { return p.version }
+func (p *DecisionPolicy) Thresholds() valueobject.DecisionThresholds { return p.thresholds }
+func (p *DecisionPolicy) EffectiveFrom() time.Time                   { return p.effectiveFrom }
+func (p *DecisionPolicy) Reason() string                             { return p.reason }
+func (p *DecisionPolicy) CreatedBy() uuid.UUID                       { return p.createdBy }
+func (p *DecisionPolicy) CreatedAt() time.Time                       { return p.createdAt }
+
+// DomainEvents returns all accumulated domain events and clears them.
+func (p *DecisionPolicy) DomainEvents() []events.DomainEvent {
+	evts := p.domainEvents
+	p.domainEvents = make([]events.DomainEvent, 0)
+	return evts
+}
+
+// EffectivePolicy returns the policy in force at the given time: the highest
+// version already effective, so a newer version supersedes an older one that
+// is still scheduled. It returns nil if no policy is in force.
+func EffectivePolicy(policies []*DecisionPolicy, at time.Time) *DecisionPolicy {
+	var best *DecisionPolicy
+	for _, p := range policies {
+		if p.effectiveFrom.After(at) {
+			continue
+		}
+		if best == nil || p.version > best.version {
+			best = p
+		}
+	}
+	return best
+}
+
+// ScoreBucket counts historical assessments that share a score, risk level,
+// decision and sanctions status. Policy simulation replays buckets rather
+// than individual assessments; assessment metrics aggregate them.
+type ScoreBucket struct {
+	RiskLevel    valueobject.RiskLevel
+	Decision     valueobject.AssessmentDecision
+	Score        int
+	Count        int
+	SanctionsHit bool
+}
+
+// DecisionUnder returns the decision the bucket's assessments would receive
+// under the given thresholds.
+func (b ScoreBucket) DecisionUnder(t valueobject.DecisionThresholds) valueobject.AssessmentDecision {
+	_, decision := decide(t, b.Score, b.SanctionsHit)
+	return decision
+}
+
+// DecisionVolume counts the assessments that received a decision on a UTC day.
+type DecisionVolume struct {
+	Day      time.Time
+	Decision valueobject.AssessmentDecision
+	Count    int
+}
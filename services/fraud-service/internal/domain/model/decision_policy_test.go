@@ -0,0 +1,106 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/event"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+func strictThresholds() valueobject.DecisionThresholds {
+	return valueobject.DecisionThresholds{MediumAt: 20, HighAt: 40, CriticalAt: 60, ReviewAt: 15, DeclineAt: 50}
+}
+
+func TestNewDecisionPolicy_Versions(t *testing.T) {
+	tenantID := uuid.New()
+	actor := uuid.New()
+
+	v1, err := model.NewDecisionPolicy(tenantID, strictThresholds(), time.Time{}, "tighten after card testing attack", actor, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v1.Version())
+	assert.WithinDuration(t, time.Now(), v1.EffectiveFrom(), time.Second, "zero effective_from means now")
+
+	evts := v1.DomainEvents()
+	require.Len(t, evts, 1)
+	assert.Equal(t, event.EventTypeDecisionPolicyChanged, evts[0].EventType())
+
+	v2, err := model.NewDecisionPolicy(tenantID, valueobject.DefaultDecisionThresholds(), time.Now().Add(24*time.Hour), "revert", actor, v1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, v2.Version())
+}
+
+func TestNewDecisionPolicy_Validation(t *testing.T) {
+	tenantID := uuid.New()
+	other, err := model.NewDecisionPolicy(uuid.New(), strictThresholds(), time.Time{}, "other tenant", uuid.New(), nil)
+	require.NoError(t, err)
+
+	unordered := strictThresholds()
+	unordered.ReviewAt = unordered.DeclineAt
+
+	tests := []struct {
+		name          string
+		tenantID      uuid.UUID
+		thresholds    valueobject.DecisionThresholds
+		effectiveFrom time.Time
+		reason        string
+		previous      *model.DecisionPolicy
+		wantErr       string
+	}{
+		{"missing tenant", uuid.Nil, strictThresholds(), time.Time{}, "r", nil, "tenant ID is required"},
+		{"out of range", tenantID, valueobject.DecisionThresholds{MediumAt: 0, HighAt: 40, CriticalAt: 60, ReviewAt: 15, DeclineAt: 50}, time.Time{}, "r", nil, "medium_at must be between 1 and 100"},
+		{"review not below decline", tenantID, unordered, time.Time{}, "r", nil, "review_at must be below decline_at"},
+		{"missing reason", tenantID, strictThresholds(), time.Time{}, "  ", nil, "reason"},
+		{"backdated", tenantID, strictThresholds(), time.Now().Add(-time.Hour), "r", nil, "cannot be in the past"},
+		{"previous from another tenant", tenantID, strictThresholds(), time.Time{}, "r", other, "another tenant"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.NewDecisionPolicy(tt.tenantID, tt.thresholds, tt.effectiveFrom, tt.reason, uuid.New(), tt.previous)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestEffectivePolicy(t *testing.T) {
+	tenantID := uuid.New()
+	now := time.Now().UTC()
+	v1 := model.ReconstructDecisionPolicy(uuid.New(), tenantID, 1, strictThresholds(), now.Add(-48*time.Hour), "v1", uuid.New(), now)
+	v2 := model.ReconstructDecisionPolicy(uuid.New(), tenantID, 2, strictThresholds(), now.Add(24*time.Hour), "v2", uuid.New(), now)
+	v3 := model.ReconstructDecisionPolicy(uuid.New(), tenantID, 3, strictThresholds(), now.Add(-time.Hour), "v3", uuid.New(), now)
+
+	assert.Nil(t, model.EffectivePolicy(nil, now))
+	assert.Nil(t, model.EffectivePolicy([]*model.DecisionPolicy{v2}, now), "scheduled policies are not yet in force")
+	assert.Equal(t, 1, model.EffectivePolicy([]*model.DecisionPolicy{v1, v2}, now).Version())
+	assert.Equal(t, 3, model.EffectivePolicy([]*model.DecisionPolicy{v1, v2, v3}, now).Version())
+	assert.Equal(t, 3, model.EffectivePolicy([]*model.DecisionPolicy{v1, v2, v3}, now.Add(48*time.Hour)).Version(),
+		"a newer version supersedes an older scheduled one")
+}
+
+func TestAssessWithPolicy(t *testing.T) {
+	a, err := model.NewTransactionAssessment(uuid.New(), uuid.New(), uuid.New(), decimal.NewFromInt(100), "USD", "transfer")
+	require.NoError(t, err)
+
+	require.NoError(t, a.AssessWithPolicy(55, nil, "", strictThresholds(), 4))
+	assert.Equal(t, valueobject.RiskLevelHigh, a.RiskLevel())
+	assert.Equal(t, valueobject.DecisionDecline, a.Decision())
+	assert.Equal(t, 4, a.PolicyVersion())
+}
+
+func TestScoreBucket_DecisionUnder(t *testing.T) {
+	b := model.ScoreBucket{Score: 20, Decision: valueobject.DecisionApprove, Count: 3}
+	assert.Equal(t, valueobject.DecisionReview, b.DecisionUnder(strictThresholds()))
+	assert.Equal(t, valueobject.DecisionApprove, b.DecisionUnder(valueobject.DefaultDecisionThresholds()))
+
+	b.SanctionsHit = true
+	assert.Equal(t, valueobject.DecisionReview, b.DecisionUnder(valueobject.DecisionThresholds{MediumAt: 97, HighAt: 98, CriticalAt: 99, ReviewAt: 99, DeclineAt: 100}),
+		"sanctions hits are held for review under any policy")
+}
@@ -0,0 +1,117 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IPInfo is the intelligence known about an IP address.
+type IPInfo struct {
+	Country string
+	ASNOrg  string
+	ASN     int
+	// IsProxy covers open and residential proxies.
+	IsProxy bool
+	IsVPN   bool
+	IsTor   bool
+	// IsHosting marks data-centre address space, rarely used by real customers.
+	IsHosting bool
+}
+
+// Anonymized reports whether the address hides the customer's real location.
+func (i IPInfo) Anonymized() bool {
+	return i.IsProxy || i.IsVPN || i.IsTor
+}
+
+// DeviceProfile tracks a device, identified by its fingerprint, that an
+// account has transacted from. Profiles are per account: the same device
+// used by two accounts has two profiles.
+type DeviceProfile struct {
+	firstSeenAt   time.Time
+	lastSeenAt    time.Time
+	fingerprint   string
+	lastIP        string
+	lastIPCountry string
+	seenCount     int
+	accountID     uuid.UUID
+	tenantID      uuid.UUID
+	id            uuid.UUID
+}
+
+// NewDeviceProfile registers the first sighting of a device on an account.
+func NewDeviceProfile(tenantID, accountID uuid.UUID, fingerprint, ip, ipCountry string, seenAt time.Time) (*DeviceProfile, error) {
+	if tenantID == uuid.Nil {
+		return nil, fmt.Errorf("tenant ID is required")
+	}
+	if accountID == uuid.Nil {
+		return nil, fmt.Errorf("account ID is required")
+	}
+	fingerprint = strings.TrimSpace(fingerprint)
+	if fingerprint == "" {
+		return nil, fmt.Errorf("device fingerprint is required")
+	}
+
+	return &DeviceProfile{
+		id:            uuid.New(),
+		tenantID:      tenantID,
+		accountID:     accountID,
+		fingerprint:   fingerprint,
+		lastIP:        ip,
+		lastIPCountry: ipCountry,
+		seenCount:     1,
+		firstSeenAt:   seenAt,
+		lastSeenAt:    seenAt,
+	}, nil
+}
+
+// RecordSighting notes another transaction from the device.
+func (d *DeviceProfile) RecordSighting(ip, ipCountry string, seenAt time.Time) {
+	d.seenCount++
+	if ip != "" {
+		d.lastIP = ip
+		d.lastIPCountry = ipCountry
+	}
+	if seenAt.After(d.lastSeenAt) {
+		d.lastSeenAt = seenAt
+	}
+}
+
+// Age returns how long the device has been known on the account.
+func (d *DeviceProfile) Age(now time.Time) time.Duration {
+	return now.Sub(d.firstSeenAt)
+}
+
+// ReconstructDeviceProfile rebuilds a DeviceProfile from persisted data (no validation).
+func ReconstructDeviceProfile(
+	id, tenantID, accountID uuid.UUID,
+	fingerprint, lastIP, lastIPCountry string,
+	seenCount int,
+	firstSeenAt, lastSeenAt time.Time,
+) *DeviceProfile {
+	return &DeviceProfile{
+		id:            id,
+		tenantID:      tenantID,
+		accountID:     accountID,
+		fingerprint:   fingerprint,
+		lastIP:        lastIP,
+		lastIPCountry: lastIPCountry,
+		seenCount:     seenCount,
+		firstSeenAt:   firstSeenAt,
+		lastSeenAt:    lastSeenAt,
+	}
+}
+
+// --- Accessors ---
+
+func (d *DeviceProfile) ID() uuid.UUID          { return d.id }
+func (d *DeviceProfile) TenantID() uuid.UUID    { return d.tenantID }
+func (d *DeviceProfile) AccountID() uuid.UUID   { return d.accountID }
+func (d *DeviceProfile) Fingerprint() string    { return d.fingerprint }
+func (d *DeviceProfile) LastIP() string         { return d.lastIP }
+func (d *DeviceProfile) LastIPCountry() string  { return d.lastIPCountry }
+func (d *DeviceProfile) SeenCount() int         { return d.seenCount }
+func (d *DeviceProfile) FirstSeenAt() time.Time { return d.firstSeenAt }
+func (d *DeviceProfile) LastSeenAt() time.Time  { return d.lastSeenAt }
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FlaggedPartyList is the list recorded on screening matches against
+// flagged parties.
+const FlaggedPartyList = "IDENTITY_SCREENING"
+
+// FlaggedParty is a customer whose identity verification returned a
+// sanctions screening hit. Payments to or from a counterparty with the same
+// name are held for review like a watchlist match, until an analyst clears
+// the verification. Matches lists the watchlist entries identity-service
+// matched, e.g. "OFAC_SDN:7012".
+type FlaggedParty struct {
+	FlaggedAt      time.Time
+	Name           string
+	Country        string
+	Matches        []string
+	TenantID       uuid.UUID
+	VerificationID uuid.UUID
+}
@@ -0,0 +1,372 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/event"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+const (
+	// urgentReviewSLA applies to REVIEW-band assessments at HIGH risk or above.
+	urgentReviewSLA = 4 * time.Hour
+	// standardReviewSLA applies to all other REVIEW-band assessments.
+	standardReviewSLA = 24 * time.Hour
+	// amlAlertSLA applies to AML alerts, which investigate an account's
+	// activity rather than hold a payment.
+	amlAlertSLA = 72 * time.Hour
+)
+
+// CaseNote is an analyst comment attached to a fraud case. Notes written by
+// the monitoring system have a nil author.
+type CaseNote struct {
+	CreatedAt time.Time
+	Body      string
+	ID        uuid.UUID
+	AuthorID  uuid.UUID
+}
+
+// FraudCase is the aggregate root for the manual review of an assessment
+// that landed in the REVIEW band, or of an AML alert raised on an account.
+// AML cases have no assessment; they record the scenario matched and the
+// transactions that make up the match. Analysts can link further
+// assessments that belong to the same investigation.
+type FraudCase struct {
+	slaDueAt      time.Time
+	slaBreachedAt time.Time
+	resolvedAt    time.Time
+	createdAt     time.Time
+	updatedAt     time.Time
+	status        valueobject.CaseStatus
+	disposition   valueobject.CaseDisposition
+	riskLevel     valueobject.RiskLevel
+	amlScenario   string
+	notes         []CaseNote
+	alertTxnIDs   []uuid.UUID
+	linkedIDs     []uuid.UUID
+	domainEvents  []events.DomainEvent
+	riskScore     int
+	version       int
+	assigneeID    uuid.UUID
+	assessmentID  uuid.UUID
+	transactionID uuid.UUID
+	accountID     uuid.UUID
+	tenantID      uuid.UUID
+	id            uuid.UUID
+}
+
+// OpenCaseForAssessment opens a review case for an assessment. Only
+// assessments with a REVIEW decision are eligible.
+func OpenCaseForAssessment(a *TransactionAssessment) (*FraudCase, error) {
+	if a == nil {
+		return nil, fmt.Errorf("assessment is required")
+	}
+	if !a.Decision().IsReview() {
+		return nil, fmt.Errorf("only REVIEW assessments can be opened as cases, got %s", a.Decision().String())
+	}
+
+	now := time.Now().UTC()
+	sla := standardReviewSLA
+	if level := a.RiskLevel(); level.Equal(valueobject.RiskLevelHigh) || level.Equal(valueobject.RiskLevelCritical) {
+		sla = urgentReviewSLA
+	}
+
+	c := &FraudCase{
+		id:            uuid.New(),
+		tenantID:      a.TenantID(),
+		assessmentID:  a.ID(),
+		transactionID: a.TransactionID(),
+		accountID:     a.AccountID(),
+		riskScore:     a.RiskScore(),
+		riskLevel:     a.RiskLevel(),
+		status:        valueobject.CaseStatusOpen,
+		notes:         make([]CaseNote, 0),
+		slaDueAt:      now.Add(sla),
+		version:       1,
+		createdAt:     now,
+		updatedAt:     now,
+	}
+
+	c.domainEvents = append(c.domainEvents, event.NewCaseOpened(
+		c.id, c.tenantID, c.assessmentID, c.transactionID, c.accountID, c.riskScore, "", c.slaDueAt,
+	))
+
+	return c, nil
+}
+
+// OpenCaseForAMLAlert opens an investigation case for an AML alert. The
+// alert's summary is kept as the case's first note, and its last
+// transaction, the one that raised it, as the case's transaction.
+func OpenCaseForAMLAlert(alert AMLAlert) (*FraudCase, error) {
+	if alert.TenantID == uuid.Nil || alert.AccountID == uuid.Nil {
+		return nil, fmt.Errorf("tenant and account IDs are required")
+	}
+	if alert.Scenario == "" {
+		return nil, fmt.Errorf("AML scenario is required")
+	}
+	if len(alert.TransactionIDs) == 0 {
+		return nil, fmt.Errorf("an AML alert needs at least one transaction")
+	}
+
+	now := time.Now().UTC()
+	c := &FraudCase{
+		id:            uuid.New(),
+		tenantID:      alert.TenantID,
+		transactionID: alert.TransactionIDs[len(alert.TransactionIDs)-1],
+		accountID:     alert.AccountID,
+		riskScore:     alert.RiskScore,
+		riskLevel:     valueobject.RiskLevelFromScore(alert.RiskScore),
+		amlScenario:   alert.Scenario,
+		alertTxnIDs:   append([]uuid.UUID(nil), alert.TransactionIDs...),
+		status:        valueobject.CaseStatusOpen,
+		notes:         make([]CaseNote, 0, 1),
+		slaDueAt:      now.Add(amlAlertSLA),
+		version:       1,
+		createdAt:     now,
+		updatedAt:     now,
+	}
+	if summary := strings.TrimSpace(alert.Summary); summary != "" {
+		c.notes = append(c.notes, CaseNote{ID: uuid.New(), Body: summary, CreatedAt: now})
+	}
+
+	c.domainEvents = append(c.domainEvents, event.NewCaseOpened(
+		c.id, c.tenantID, uuid.Nil, c.transactionID, c.accountID, c.riskScore, c.amlScenario, c.slaDueAt,
+	))
+
+	return c, nil
+}
+
+// Assign hands the case to an analyst and moves it into review.
+// Reassigning an in-review or escalated case keeps its status.
+func (c *FraudCase) Assign(analystID uuid.UUID) error {
+	if analystID == uuid.Nil {
+		return fmt.Errorf("analyst ID is required")
+	}
+	if c.status.IsTerminal() {
+		return fmt.Errorf("cannot assign a %s case", c.status.String())
+	}
+
+	c.assigneeID = analystID
+	if c.status.Equal(valueobject.CaseStatusOpen) {
+		c.status = valueobject.CaseStatusInReview
+	}
+	c.touch()
+
+	c.domainEvents = append(c.domainEvents, event.NewCaseAssigned(c.id, c.tenantID, analystID, c.updatedAt))
+	return nil
+}
+
+// AddNote appends an analyst note. Notes can be added until the case is resolved.
+func (c *FraudCase) AddNote(authorID uuid.UUID, body string) (CaseNote, error) {
+	if authorID == uuid.Nil {
+		return CaseNote{}, fmt.Errorf("author ID is required")
+	}
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return CaseNote{}, fmt.Errorf("note body is required")
+	}
+	if c.status.IsTerminal() {
+		return CaseNote{}, fmt.Errorf("cannot add notes to a %s case", c.status.String())
+	}
+
+	c.touch()
+	note := CaseNote{
+		ID:        uuid.New(),
+		AuthorID:  authorID,
+		Body:      body,
+		CreatedAt: c.updatedAt,
+	}
+	c.notes = append(c.notes, note)
+	return note, nil
+}
+
+// Escalate flags the case for senior review. The reason is kept as a note.
+func (c *FraudCase) Escalate(actorID uuid.UUID, reason string) error {
+	if !c.status.Equal(valueobject.CaseStatusInReview) {
+		return fmt.Errorf("only IN_REVIEW cases can be escalated, got %s", c.status.String())
+	}
+	if _, err := c.AddNote(actorID, "Escalated: "+strings.TrimSpace(reason)); err != nil {
+		return err
+	}
+	c.status = valueobject.CaseStatusEscalated
+	return nil
+}
+
+// Resolve records the final disposition and closes the case.
+func (c *FraudCase) Resolve(actorID uuid.UUID, disposition valueobject.CaseDisposition, note string) error {
+	if c.status.IsTerminal() {
+		return fmt.Errorf("case is already %s", c.status.String())
+	}
+	if c.status.Equal(valueobject.CaseStatusOpen) {
+		return fmt.Errorf("case must be assigned before it can be resolved")
+	}
+	if disposition.IsZero() {
+		return fmt.Errorf("disposition is required")
+	}
+	if strings.TrimSpace(note) != "" {
+		if _, err := c.AddNote(actorID, note); err != nil {
+			return err
+		}
+	}
+
+	c.disposition = disposition
+	c.status = valueobject.CaseStatusResolved
+	c.touch()
+	c.resolvedAt = c.updatedAt
+
+	c.domainEvents = append(c.domainEvents, event.NewCaseResolved(
+		c.id, c.tenantID, c.assessmentID, c.transactionID, c.accountID,
+		disposition.String(), actorID, c.SLABreached(c.resolvedAt), c.resolvedAt,
+	))
+	return nil
+}
+
+// LinkAssessment adds an assessment to the investigation, recording the
+// link as a note. The case's own assessment and assessments already linked
+// are rejected.
+func (c *FraudCase) LinkAssessment(actorID uuid.UUID, a *TransactionAssessment) error {
+	if a == nil {
+		return fmt.Errorf("assessment is required")
+	}
+	if a.TenantID() != c.tenantID {
+		return fmt.Errorf("assessment belongs to another tenant")
+	}
+	if a.ID() == c.assessmentID || slices.Contains(c.linkedIDs, a.ID()) {
+		return fmt.Errorf("assessment %s is already part of the case", a.ID())
+	}
+	if _, err := c.AddNote(actorID, fmt.Sprintf("Linked assessment %s (transaction %s)", a.ID(), a.TransactionID())); err != nil {
+		return err
+	}
+	c.linkedIDs = append(c.linkedIDs, a.ID())
+	return nil
+}
+
+// MarkSLABreached records that the case passed its review deadline
+// unresolved, raising fraud.case.sla.breached. It reports false, and does
+// nothing, when the case is resolved, still within its SLA, or was already
+// marked.
+func (c *FraudCase) MarkSLABreached(now time.Time) bool {
+	if c.status.IsTerminal() || !c.slaBreachedAt.IsZero() || !now.After(c.slaDueAt) {
+		return false
+	}
+	c.slaBreachedAt = now.UTC()
+	c.touch()
+
+	c.domainEvents = append(c.domainEvents, event.NewCaseSLABreached(
+		c.id, c.tenantID, c.assessmentID, c.accountID, c.assigneeID, c.status.String(), c.slaDueAt, c.slaBreachedAt,
+	))
+	return true
+}
+
+// SLABreached reports whether the case missed its review deadline as of now.
+// Resolved cases are judged by their resolution time.
+func (c *FraudCase) SLABreached(now time.Time) bool {
+	if !c.resolvedAt.IsZero() {
+		return c.resolvedAt.After(c.slaDueAt)
+	}
+	return now.After(c.slaDueAt)
+}
+
+func (c *FraudCase) touch() {
+	c.updatedAt = time.Now().UTC()
+	c.version++
+}
+
+// ReconstructFraudCase rebuilds a FraudCase from persisted data (no validation, no events).
+func ReconstructFraudCase(
+	id, tenantID, assessmentID, transactionID, accountID uuid.UUID,
+	riskScore int,
+	riskLevel valueobject.RiskLevel,
+	amlScenario string,
+	alertTxnIDs, linkedIDs []uuid.UUID,
+	status valueobject.CaseStatus,
+	disposition valueobject.CaseDisposition,
+	assigneeID uuid.UUID,
+	notes []CaseNote,
+	slaDueAt, slaBreachedAt, resolvedAt time.Time,
+	version int,
+	createdAt, updatedAt time.Time,
+) *FraudCase {
+	if notes == nil {
+		notes = make([]CaseNote, 0)
+	}
+	return &FraudCase{
+		id:            id,
+		tenantID:      tenantID,
+		assessmentID:  assessmentID,
+		transactionID: transactionID,
+		accountID:     accountID,
+		riskScore:     riskScore,
+		riskLevel:     riskLevel,
+		amlScenario:   amlScenario,
+		alertTxnIDs:   alertTxnIDs,
+		linkedIDs:     linkedIDs,
+		status:        status,
+		disposition:   disposition,
+		assigneeID:    assigneeID,
+		notes:         notes,
+		slaDueAt:      slaDueAt,
+		slaBreachedAt: slaBreachedAt,
+		resolvedAt:    resolvedAt,
+		version:       version,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+		domainEvents:  make([]events.DomainEvent, 0),
+	}
+}
+
+// --- Accessors ---
+
+func (c *FraudCase) ID() uuid.UUID                            { return c.id }
+func (c *FraudCase) TenantID() uuid.UUID                      { return c.tenantID }
+func (c *FraudCase) AssessmentID() uuid.UUID                  { return c.assessmentID }
+func (c *FraudCase) TransactionID() uuid.UUID                 { return c.transactionID }
+func (c *FraudCase) AccountID() uuid.UUID                     { return c.accountID }
+func (c *FraudCase) RiskScore() int                           { return c.riskScore }
+func (c *FraudCase) RiskLevel() valueobject.RiskLevel         { return c.riskLevel }
+func (c *FraudCase) AMLScenario() string                      { return c.amlScenario }
+func (c *FraudCase) Status() valueobject.CaseStatus           { return c.status }
+func (c *FraudCase) Disposition() valueobject.CaseDisposition { return c.disposition }
+func (c *FraudCase) AssigneeID() uuid.UUID                    { return c.assigneeID }
+func (c *FraudCase) SLADueAt() time.Time                      { return c.slaDueAt }
+func (c *FraudCase) SLABreachedAt() time.Time                 { return c.slaBreachedAt }
+func (c *FraudCase) ResolvedAt() time.Time                    { return c.resolvedAt }
+func (c *FraudCase) Version() int                             { return c.version }
+func (c *FraudCase) CreatedAt() time.Time                     { return c.createdAt }
+func (c *FraudCase) UpdatedAt() time.Time                     { return c.updatedAt }
+
+// Notes returns a copy of the case notes, oldest first.
+func (c *FraudCase) Notes() []CaseNote {
+	notes := make([]CaseNote, len(c.notes))
+	copy(notes, c.notes)
+	return notes
+}
+
+// AlertTransactionIDs returns a copy of the IDs of the transactions that
+// make up an AML alert, oldest first. It is empty for assessment cases.
+func (c *FraudCase) AlertTransactionIDs() []uuid.UUID {
+	return append([]uuid.UUID(nil), c.alertTxnIDs...)
+}
+
+// LinkedAssessmentIDs returns a copy of the IDs of the assessments linked
+// to the case, in the order they were linked.
+func (c *FraudCase) LinkedAssessmentIDs() []uuid.UUID {
+	return append([]uuid.UUID(nil), c.linkedIDs...)
+}
+
+// IsAMLAlert reports whether the case investigates an AML alert rather than
+// an assessment.
+func (c *FraudCase) IsAMLAlert() bool { return c.amlScenario != "" }
+
+// DomainEvents returns all accumulated domain events and clears them.
+func (c *FraudCase) DomainEvents() []events.DomainEvent {
+	evts := c.domainEvents
+	c.domainEvents = make([]events.DomainEvent, 0)
+	return evts
+}
@@ -0,0 +1,227 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/event"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+func assessedWithScore(t *testing.T, score int) *model.TransactionAssessment {
+	t.Helper()
+	a, err := model.NewTransactionAssessment(uuid.New(), uuid.New(), uuid.New(), decimal.NewFromInt(1000), "USD", "transfer")
+	require.NoError(t, err)
+	require.NoError(t, a.Assess(score, []string{"test"}))
+	a.DomainEvents()
+	return a
+}
+
+func openCase(t *testing.T) *model.FraudCase {
+	t.Helper()
+	c, err := model.OpenCaseForAssessment(assessedWithScore(t, 40))
+	require.NoError(t, err)
+	c.DomainEvents()
+	return c
+}
+
+func TestOpenCaseForAssessment(t *testing.T) {
+	a := assessedWithScore(t, 40)
+	c, err := model.OpenCaseForAssessment(a)
+	require.NoError(t, err)
+
+	assert.Equal(t, a.ID(), c.AssessmentID())
+	assert.Equal(t, a.TenantID(), c.TenantID())
+	assert.True(t, valueobject.CaseStatusOpen.Equal(c.Status()))
+	assert.WithinDuration(t, c.CreatedAt().Add(24*time.Hour), c.SLADueAt(), time.Second)
+
+	evts := c.DomainEvents()
+	require.Len(t, evts, 1)
+	assert.Equal(t, event.EventTypeCaseOpened, evts[0].EventType())
+}
+
+func TestOpenCaseForAssessment_HighRiskHasShorterSLA(t *testing.T) {
+	c, err := model.OpenCaseForAssessment(assessedWithScore(t, 65))
+	require.NoError(t, err)
+	assert.WithinDuration(t, c.CreatedAt().Add(4*time.Hour), c.SLADueAt(), time.Second)
+}
+
+func TestOpenCaseForAssessment_RejectsNonReview(t *testing.T) {
+	_, err := model.OpenCaseForAssessment(assessedWithScore(t, 10))
+	require.Error(t, err)
+
+	_, err = model.OpenCaseForAssessment(assessedWithScore(t, 90))
+	require.Error(t, err)
+}
+
+func TestOpenCaseForAMLAlert(t *testing.T) {
+	first, last := uuid.New(), uuid.New()
+	alert := model.AMLAlert{
+		Scenario:       model.AMLScenarioStructuring,
+		Summary:        "3 payments sent just below 10000.00 USD.",
+		TransactionIDs: []uuid.UUID{first, last},
+		RiskScore:      70,
+		TenantID:       uuid.New(),
+		AccountID:      uuid.New(),
+	}
+	c, err := model.OpenCaseForAMLAlert(alert)
+	require.NoError(t, err)
+
+	assert.True(t, c.IsAMLAlert())
+	assert.Equal(t, uuid.Nil, c.AssessmentID())
+	assert.Equal(t, last, c.TransactionID(), "the last transaction raised the alert")
+	assert.Equal(t, []uuid.UUID{first, last}, c.AlertTransactionIDs())
+	assert.True(t, valueobject.RiskLevelHigh.Equal(c.RiskLevel()))
+	assert.WithinDuration(t, c.CreatedAt().Add(72*time.Hour), c.SLADueAt(), time.Second)
+	require.Len(t, c.Notes(), 1)
+	assert.Equal(t, alert.Summary, c.Notes()[0].Body)
+	assert.Equal(t, uuid.Nil, c.Notes()[0].AuthorID)
+
+	evts := c.DomainEvents()
+	require.Len(t, evts, 1)
+	opened, ok := evts[0].(event.CaseOpened)
+	require.True(t, ok)
+	assert.Equal(t, model.AMLScenarioStructuring, opened.AMLScenario)
+
+	alert.TransactionIDs = nil
+	_, err = model.OpenCaseForAMLAlert(alert)
+	assert.Error(t, err)
+}
+
+func TestFraudCase_Workflow(t *testing.T) {
+	c := openCase(t)
+	analyst := uuid.New()
+
+	require.NoError(t, c.Assign(analyst))
+	assert.True(t, valueobject.CaseStatusInReview.Equal(c.Status()))
+	assert.Equal(t, analyst, c.AssigneeID())
+
+	_, err := c.AddNote(analyst, "  Customer confirmed travel  ")
+	require.NoError(t, err)
+	require.Len(t, c.Notes(), 1)
+	assert.Equal(t, "Customer confirmed travel", c.Notes()[0].Body)
+
+	require.NoError(t, c.Escalate(analyst, "amount over analyst limit"))
+	assert.True(t, valueobject.CaseStatusEscalated.Equal(c.Status()))
+	assert.Len(t, c.Notes(), 2)
+
+	senior := uuid.New()
+	require.NoError(t, c.Assign(senior))
+	assert.True(t, valueobject.CaseStatusEscalated.Equal(c.Status()), "reassignment keeps escalated status")
+
+	require.NoError(t, c.Resolve(senior, valueobject.DispositionFalsePositive, "verified with customer"))
+	assert.True(t, valueobject.CaseStatusResolved.Equal(c.Status()))
+	assert.True(t, valueobject.DispositionFalsePositive.Equal(c.Disposition()))
+	assert.False(t, c.ResolvedAt().IsZero())
+	assert.False(t, c.SLABreached(time.Now().Add(48*time.Hour)), "resolved cases are judged by resolution time")
+
+	var types []string
+	for _, e := range c.DomainEvents() {
+		types = append(types, e.EventType())
+	}
+	assert.Equal(t, []string{event.EventTypeCaseAssigned, event.EventTypeCaseAssigned, event.EventTypeCaseResolved}, types)
+}
+
+func TestFraudCase_InvalidTransitions(t *testing.T) {
+	actor := uuid.New()
+
+	t.Run("cannot resolve unassigned case", func(t *testing.T) {
+		c := openCase(t)
+		assert.Error(t, c.Resolve(actor, valueobject.DispositionConfirmedFraud, ""))
+	})
+
+	t.Run("cannot escalate open case", func(t *testing.T) {
+		c := openCase(t)
+		assert.Error(t, c.Escalate(actor, "reason"))
+	})
+
+	t.Run("resolved case is immutable", func(t *testing.T) {
+		c := openCase(t)
+		require.NoError(t, c.Assign(actor))
+		require.NoError(t, c.Resolve(actor, valueobject.DispositionConfirmedFraud, ""))
+
+		assert.Error(t, c.Assign(uuid.New()))
+		_, err := c.AddNote(actor, "late note")
+		assert.Error(t, err)
+		assert.Error(t, c.Resolve(actor, valueobject.DispositionFalsePositive, ""))
+	})
+
+	t.Run("requires disposition", func(t *testing.T) {
+		c := openCase(t)
+		require.NoError(t, c.Assign(actor))
+		assert.Error(t, c.Resolve(actor, valueobject.CaseDisposition{}, ""))
+	})
+
+	t.Run("rejects empty note", func(t *testing.T) {
+		c := openCase(t)
+		_, err := c.AddNote(actor, "   ")
+		assert.Error(t, err)
+	})
+}
+
+func TestFraudCase_SLABreached(t *testing.T) {
+	c := openCase(t)
+	assert.False(t, c.SLABreached(time.Now()))
+	assert.True(t, c.SLABreached(c.SLADueAt().Add(time.Minute)))
+}
+
+func TestFraudCase_MarkSLABreached(t *testing.T) {
+	t.Run("marks an overdue case once", func(t *testing.T) {
+		c := openCase(t)
+		assert.False(t, c.MarkSLABreached(time.Now()), "not yet due")
+
+		breachedAt := c.SLADueAt().Add(time.Minute)
+		require.True(t, c.MarkSLABreached(breachedAt))
+		assert.Equal(t, breachedAt, c.SLABreachedAt())
+
+		evts := c.DomainEvents()
+		require.Len(t, evts, 1)
+		breached, ok := evts[0].(event.CaseSLABreached)
+		require.True(t, ok)
+		assert.Equal(t, event.EventTypeCaseSLABreached, breached.EventType())
+		assert.Equal(t, c.ID(), breached.CaseID)
+		assert.Equal(t, "OPEN", breached.Status)
+
+		assert.False(t, c.MarkSLABreached(breachedAt.Add(time.Hour)), "already marked")
+		assert.Empty(t, c.DomainEvents())
+	})
+
+	t.Run("ignores resolved cases", func(t *testing.T) {
+		c := openCase(t)
+		actor := uuid.New()
+		require.NoError(t, c.Assign(actor))
+		require.NoError(t, c.Resolve(actor, valueobject.DispositionFalsePositive, ""))
+		c.DomainEvents()
+
+		assert.False(t, c.MarkSLABreached(c.SLADueAt().Add(time.Hour)))
+		assert.Empty(t, c.DomainEvents())
+	})
+}
+
+func TestFraudCase_LinkAssessment(t *testing.T) {
+	actor := uuid.New()
+	own := assessedWithScore(t, 40)
+	c, err := model.OpenCaseForAssessment(own)
+	require.NoError(t, err)
+	related, err := model.NewTransactionAssessment(c.TenantID(), uuid.New(), c.AccountID(), decimal.NewFromInt(500), "USD", "transfer")
+	require.NoError(t, err)
+
+	require.NoError(t, c.LinkAssessment(actor, related))
+	assert.Equal(t, []uuid.UUID{related.ID()}, c.LinkedAssessmentIDs())
+	require.Len(t, c.Notes(), 1)
+	assert.Contains(t, c.Notes()[0].Body, related.ID().String())
+
+	assert.Error(t, c.LinkAssessment(actor, related), "already linked")
+
+	assert.Error(t, c.LinkAssessment(actor, own), "the case's own assessment")
+
+	other := assessedWithScore(t, 40)
+	assert.Error(t, c.LinkAssessment(actor, other), "another tenant's assessment")
+	assert.Len(t, c.LinkedAssessmentIDs(), 1)
+}
@@ -0,0 +1,244 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/event"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/ruledsl"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// FraudRule is the aggregate root for an operator-managed scoring rule.
+// Each revision bumps the version so assessments can be traced back to the
+// exact rule definition that fired.
+type FraudRule struct {
+	createdAt    time.Time
+	updatedAt    time.Time
+	expression   *ruledsl.Expression
+	mode         valueobject.RuleMode
+	name         string
+	description  string
+	condition    string
+	signal       string
+	domainEvents []events.DomainEvent
+	scoreImpact  int
+	version      int
+	updatedBy    uuid.UUID
+	tenantID     uuid.UUID
+	id           uuid.UUID
+}
+
+// RuleDefinition holds the editable parts of a fraud rule.
+type RuleDefinition struct {
+	Mode        valueobject.RuleMode
+	Description string
+	Condition   string
+	Signal      string
+	ScoreImpact int
+}
+
+// NewFraudRule creates a new rule for a tenant. The condition is compiled
+// immediately so invalid rules are rejected at creation time.
+func NewFraudRule(tenantID uuid.UUID, name string, def RuleDefinition, actorID uuid.UUID) (*FraudRule, error) {
+	if tenantID == uuid.Nil {
+		return nil, fmt.Errorf("tenant ID is required")
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("rule name is required")
+	}
+	expr, err := validateDefinition(&def)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	r := &FraudRule{
+		id:          uuid.New(),
+		tenantID:    tenantID,
+		name:        name,
+		description: def.Description,
+		condition:   def.Condition,
+		expression:  expr,
+		signal:      def.Signal,
+		scoreImpact: def.ScoreImpact,
+		mode:        def.Mode,
+		version:     1,
+		updatedBy:   actorID,
+		createdAt:   now,
+		updatedAt:   now,
+	}
+
+	r.domainEvents = append(r.domainEvents, event.NewRuleChanged(
+		event.EventTypeRuleCreated, r.id, r.tenantID, r.name, r.mode.String(), r.version, actorID, now,
+	))
+
+	return r, nil
+}
+
+// Revise replaces the rule definition and increments the version.
+func (r *FraudRule) Revise(def RuleDefinition, actorID uuid.UUID) error {
+	expr, err := validateDefinition(&def)
+	if err != nil {
+		return err
+	}
+
+	r.description = def.Description
+	r.condition = def.Condition
+	r.expression = expr
+	r.signal = def.Signal
+	r.scoreImpact = def.ScoreImpact
+	r.mode = def.Mode
+	r.updatedBy = actorID
+	r.updatedAt = time.Now().UTC()
+	r.version++
+
+	r.domainEvents = append(r.domainEvents, event.NewRuleChanged(
+		event.EventTypeRuleRevised, r.id, r.tenantID, r.name, r.mode.String(), r.version, actorID, r.updatedAt,
+	))
+
+	return nil
+}
+
+// Disable takes the rule out of scoring, keeping its definition and history.
+// A disabled rule can be brought back with Revise.
+func (r *FraudRule) Disable(actorID uuid.UUID) error {
+	if r.mode.Equal(valueobject.RuleModeDisabled) {
+		return fmt.Errorf("rule %s is already disabled", r.id)
+	}
+
+	r.mode = valueobject.RuleModeDisabled
+	r.updatedBy = actorID
+	r.updatedAt = time.Now().UTC()
+	r.version++
+
+	r.domainEvents = append(r.domainEvents, event.NewRuleChanged(
+		event.EventTypeRuleDisabled, r.id, r.tenantID, r.name, r.mode.String(), r.version, actorID, r.updatedAt,
+	))
+
+	return nil
+}
+
+// Matches reports whether the rule condition holds for the given facts.
+// Disabled rules never match.
+func (r *FraudRule) Matches(facts ruledsl.Facts) bool {
+	if !r.mode.IsEvaluated() {
+		return false
+	}
+	return r.expression.Evaluate(facts)
+}
+
+func validateDefinition(def *RuleDefinition) (*ruledsl.Expression, error) {
+	def.Signal = strings.TrimSpace(def.Signal)
+	if def.Signal == "" {
+		return nil, fmt.Errorf("rule signal is required")
+	}
+	if def.ScoreImpact < -100 || def.ScoreImpact > 100 {
+		return nil, fmt.Errorf("score impact must be between -100 and 100, got %d", def.ScoreImpact)
+	}
+	if def.Mode.IsZero() {
+		def.Mode = valueobject.RuleModeActive
+	}
+	expr, err := ruledsl.Parse(def.Condition)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rule condition: %w", err)
+	}
+	return expr, nil
+}
+
+// ReconstructFraudRule rebuilds a FraudRule from persisted data (no events).
+// The stored condition is recompiled; an error indicates corrupt data.
+func ReconstructFraudRule(
+	id, tenantID uuid.UUID,
+	name string,
+	def RuleDefinition,
+	version int,
+	updatedBy uuid.UUID,
+	createdAt, updatedAt time.Time,
+) (*FraudRule, error) {
+	expr, err := ruledsl.Parse(def.Condition)
+	if err != nil {
+		return nil, fmt.Errorf("stored rule %s has invalid condition: %w", id, err)
+	}
+	return &FraudRule{
+		id:           id,
+		tenantID:     tenantID,
+		name:         name,
+		description:  def.Description,
+		condition:    def.Condition,
+		expression:   expr,
+		signal:       def.Signal,
+		scoreImpact:  def.ScoreImpact,
+		mode:         def.Mode,
+		version:      version,
+		updatedBy:    updatedBy,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+		domainEvents: make([]events.DomainEvent, 0),
+	}, nil
+}
+
+// --- Accessors ---
+
+func (r *FraudRule) ID() uuid.UUID              { return r.id }
+func (r *FraudRule) TenantID() uuid.UUID        { return r.tenantID }
+func (r *FraudRule) Name() string               { return r.name }
+func (r *FraudRule) Description() string        { return r.description }
+func (r *FraudRule) Condition() string          { return r.condition }
+func (r *FraudRule) Signal() string             { return r.signal }
+func (r *FraudRule) ScoreImpact() int           { return r.scoreImpact }
+func (r *FraudRule) Mode() valueobject.RuleMode { return r.mode }
+func (r *FraudRule) Version() int               { return r.version }
+func (r *FraudRule) UpdatedBy() uuid.UUID       { return r.updatedBy }
+func (r *FraudRule) CreatedAt() time.Time       { return r.createdAt }
+func (r *FraudRule) UpdatedAt() time.Time       { return r.updatedAt }
+
+// Definition returns the current editable definition.
+func (r *FraudRule) Definition() RuleDefinition {
+	return RuleDefinition{
+		Description: r.description,
+		Condition:   r.condition,
+		Signal:      r.signal,
+		ScoreImpact: r.scoreImpact,
+		Mode:        r.mode,
+	}
+}
+
+// DomainEvents returns all accumulated domain events and clears them.
+func (r *FraudRule) DomainEvents() []events.DomainEvent {
+	evts := r.domainEvents
+	r.domainEvents = make([]events.DomainEvent, 0)
+	return evts
+}
+
+// RuleVersion is an immutable snapshot of a rule at a given version.
+type RuleVersion struct {
+	ChangedAt  time.Time
+	Definition RuleDefinition
+	Version    int
+	ChangedBy  uuid.UUID
+	RuleID     uuid.UUID
+}
+
+// RuleHit records that a rule fired while scoring a transaction.
+type RuleHit struct {
+	RuleName    string
+	Signal      string
+	ScoreImpact int
+	RuleVersion int
+	Shadow      bool
+	RuleID      uuid.UUID
+}
+
+// RuleHitStats aggregates hit counts for a single rule.
+type RuleHitStats struct {
+	LastHitAt  time.Time
+	Hits       int64
+	ShadowHits int64
+	RuleID     uuid.UUID
+}
@@ -0,0 +1,131 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/event"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/ruledsl"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+func validRuleDefinition() model.RuleDefinition {
+	return model.RuleDefinition{
+		Description: "Large wires",
+		Condition:   "amount > 10000 AND transaction_type = 'wire_transfer'",
+		Signal:      "large_wire",
+		ScoreImpact: 25,
+	}
+}
+
+func TestNewFraudRule_Valid(t *testing.T) {
+	actor := uuid.New()
+	r, err := model.NewFraudRule(uuid.New(), "Large wire", validRuleDefinition(), actor)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, uuid.Nil, r.ID())
+	assert.Equal(t, 1, r.Version())
+	assert.True(t, valueobject.RuleModeActive.Equal(r.Mode()), "mode defaults to ACTIVE")
+	assert.Equal(t, actor, r.UpdatedBy())
+
+	evts := r.DomainEvents()
+	require.Len(t, evts, 1)
+	assert.Equal(t, event.EventTypeRuleCreated, evts[0].EventType())
+}
+
+func TestNewFraudRule_Validation(t *testing.T) {
+	tests := []struct {
+		mutate   func(*model.RuleDefinition)
+		name     string
+		ruleName string
+		wantErr  string
+		tenantID uuid.UUID
+	}{
+		{name: "nil tenant", ruleName: "r", wantErr: "tenant ID is required"},
+		{name: "empty name", ruleName: " ", tenantID: uuid.New(), wantErr: "rule name is required"},
+		{
+			name: "empty signal", ruleName: "r", tenantID: uuid.New(), wantErr: "rule signal is required",
+			mutate: func(d *model.RuleDefinition) { d.Signal = "" },
+		},
+		{
+			name: "impact out of range", ruleName: "r", tenantID: uuid.New(), wantErr: "score impact must be between",
+			mutate: func(d *model.RuleDefinition) { d.ScoreImpact = 150 },
+		},
+		{
+			name: "bad condition", ruleName: "r", tenantID: uuid.New(), wantErr: "invalid rule condition",
+			mutate: func(d *model.RuleDefinition) { d.Condition = "amount >>" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := validRuleDefinition()
+			if tt.mutate != nil {
+				tt.mutate(&def)
+			}
+			_, err := model.NewFraudRule(tt.tenantID, tt.ruleName, def, uuid.New())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestFraudRule_Revise(t *testing.T) {
+	r, err := model.NewFraudRule(uuid.New(), "Large wire", validRuleDefinition(), uuid.New())
+	require.NoError(t, err)
+	r.DomainEvents()
+
+	def := validRuleDefinition()
+	def.Condition = "amount > 20000"
+	def.Mode = valueobject.RuleModeShadow
+	editor := uuid.New()
+	require.NoError(t, r.Revise(def, editor))
+
+	assert.Equal(t, 2, r.Version())
+	assert.Equal(t, "amount > 20000", r.Condition())
+	assert.True(t, valueobject.RuleModeShadow.Equal(r.Mode()))
+	assert.Equal(t, editor, r.UpdatedBy())
+
+	evts := r.DomainEvents()
+	require.Len(t, evts, 1)
+	changed, ok := evts[0].(event.RuleChanged)
+	require.True(t, ok)
+	assert.Equal(t, event.EventTypeRuleRevised, changed.EventType())
+	assert.Equal(t, 2, changed.Version)
+}
+
+func TestFraudRule_ReviseRejectsInvalidDefinition(t *testing.T) {
+	r, err := model.NewFraudRule(uuid.New(), "Large wire", validRuleDefinition(), uuid.New())
+	require.NoError(t, err)
+
+	def := validRuleDefinition()
+	def.Condition = "nonsense"
+	require.Error(t, r.Revise(def, uuid.New()))
+	assert.Equal(t, 1, r.Version(), "failed revision must not bump the version")
+}
+
+func TestFraudRule_Disable(t *testing.T) {
+	r, err := model.NewFraudRule(uuid.New(), "Large wire", validRuleDefinition(), uuid.New())
+	require.NoError(t, err)
+	r.DomainEvents()
+
+	operator := uuid.New()
+	require.NoError(t, r.Disable(operator))
+
+	assert.Equal(t, 2, r.Version())
+	assert.True(t, valueobject.RuleModeDisabled.Equal(r.Mode()))
+	assert.Equal(t, validRuleDefinition().Condition, r.Condition(), "disabling keeps the definition")
+	assert.False(t, r.Matches(ruledsl.Facts{Amount: decimal.NewFromInt(50000), TransactionType: "wire_transfer"}))
+
+	evts := r.DomainEvents()
+	require.Len(t, evts, 1)
+	assert.Equal(t, event.EventTypeRuleDisabled, evts[0].EventType())
+
+	require.Error(t, r.Disable(operator), "disabling twice")
+	assert.Equal(t, 2, r.Version())
+}
@@ -0,0 +1,171 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/event"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// SignalSanctionsHit is the risk signal added to an assessment when a
+// counterparty or destination matches a sanctions list. It always holds the
+// transaction for manual review, whatever the risk score.
+const SignalSanctionsHit = "sanctions_hit"
+
+// Watchlist entry types.
+const (
+	// EntryTypeParty is a sanctioned individual or organisation, matched by name.
+	EntryTypeParty = "PARTY"
+	// EntryTypeJurisdiction is a sanctioned country, matched by ISO 3166 code.
+	EntryTypeJurisdiction = "JURISDICTION"
+)
+
+// Screening subject roles.
+const (
+	// SubjectCounterparty is the name of the party on the other side of the payment.
+	SubjectCounterparty = "COUNTERPARTY"
+	// SubjectDestinationCountry is the ISO 3166 country the payment is sent to.
+	SubjectDestinationCountry = "DESTINATION_COUNTRY"
+)
+
+// WatchlistEntry is a single record from a sanctions list.
+type WatchlistEntry struct {
+	List    valueobject.Watchlist
+	EntryID string
+	Type    string
+	Name    string
+	Aliases []string
+}
+
+// ScreeningSubject is a value from a transaction that is screened.
+type ScreeningSubject struct {
+	Role  string `json:"role"`
+	Value string `json:"value"`
+}
+
+// ScreeningMatch is a subject that matched a watchlist entry.
+type ScreeningMatch struct {
+	Subject     ScreeningSubject `json:"subject"`
+	List        string           `json:"list"`
+	EntryID     string           `json:"entry_id"`
+	MatchedName string           `json:"matched_name"`
+	// Score is the name similarity in [0, 1]; exact matches score 1.
+	Score float64 `json:"score"`
+}
+
+// ScreeningRecord is the audit log entry for one sanctions screening of a
+// transaction. It records what was screened, against which list version, at
+// what threshold, and what matched, so a decision can be reproduced later.
+type ScreeningRecord struct {
+	screenedAt    time.Time
+	listVersion   string
+	subjects      []ScreeningSubject
+	matches       []ScreeningMatch
+	domainEvents  []events.DomainEvent
+	threshold     float64
+	id            uuid.UUID
+	tenantID      uuid.UUID
+	assessmentID  uuid.UUID
+	transactionID uuid.UUID
+}
+
+// NewScreeningRecord records the outcome of screening an assessed transaction.
+func NewScreeningRecord(
+	a *TransactionAssessment,
+	subjects []ScreeningSubject,
+	matches []ScreeningMatch,
+	listVersion string,
+	threshold float64,
+) (*ScreeningRecord, error) {
+	if a == nil {
+		return nil, fmt.Errorf("assessment is required")
+	}
+	if len(subjects) == 0 {
+		return nil, fmt.Errorf("at least one screening subject is required")
+	}
+	if matches == nil {
+		matches = make([]ScreeningMatch, 0)
+	}
+
+	r := &ScreeningRecord{
+		id:            uuid.New(),
+		tenantID:      a.TenantID(),
+		assessmentID:  a.ID(),
+		transactionID: a.TransactionID(),
+		subjects:      subjects,
+		matches:       matches,
+		listVersion:   listVersion,
+		threshold:     threshold,
+		screenedAt:    time.Now().UTC(),
+	}
+
+	if r.Hit() {
+		lists := make([]string, 0, len(matches))
+		seen := make(map[string]bool)
+		for _, m := range matches {
+			if !seen[m.List] {
+				seen[m.List] = true
+				lists = append(lists, m.List)
+			}
+		}
+		r.domainEvents = append(r.domainEvents, event.NewScreeningHit(
+			r.id, r.tenantID, r.assessmentID, r.transactionID, a.AccountID(), lists, len(matches), r.screenedAt,
+		))
+	}
+
+	return r, nil
+}
+
+// Hit reports whether any subject matched a watchlist entry.
+func (r *ScreeningRecord) Hit() bool {
+	return len(r.matches) > 0
+}
+
+// ReconstructScreeningRecord rebuilds a ScreeningRecord from persisted data (no validation, no events).
+func ReconstructScreeningRecord(
+	id, tenantID, assessmentID, transactionID uuid.UUID,
+	subjects []ScreeningSubject,
+	matches []ScreeningMatch,
+	listVersion string,
+	threshold float64,
+	screenedAt time.Time,
+) *ScreeningRecord {
+	if matches == nil {
+		matches = make([]ScreeningMatch, 0)
+	}
+	return &ScreeningRecord{
+		id:            id,
+		tenantID:      tenantID,
+		assessmentID:  assessmentID,
+		transactionID: transactionID,
+		subjects:      subjects,
+		matches:       matches,
+		listVersion:   listVersion,
+		threshold:     threshold,
+		screenedAt:    screenedAt,
+		domainEvents:  make([]events.DomainEvent, 0),
+	}
+}
+
+// --- Accessors ---
+
+func (r *ScreeningRecord) ID() uuid.UUID                { return r.id }
+func (r *ScreeningRecord) TenantID() uuid.UUID          { return r.tenantID }
+func (r *ScreeningRecord) AssessmentID() uuid.UUID      { return r.assessmentID }
+func (r *ScreeningRecord) TransactionID() uuid.UUID     { return r.transactionID }
+func (r *ScreeningRecord) Subjects() []ScreeningSubject { return r.subjects }
+func (r *ScreeningRecord) Matches() []ScreeningMatch    { return r.matches }
+func (r *ScreeningRecord) ListVersion() string          { return r.listVersion }
+func (r *ScreeningRecord) Threshold() float64           { return r.threshold }
+func (r *ScreeningRecord) ScreenedAt() time.Time        { return r.screenedAt }
+
+// DomainEvents returns all accumulated domain events and clears them.
+func (r *ScreeningRecord) DomainEvents() []events.DomainEvent {
+	evts := r.domainEvents
+	r.domainEvents = make([]events.DomainEvent, 0)
+	return evts
+}
@@ -22,6 +22,8 @@ type TransactionAssessment struct {
 	decision        valueobject.AssessmentDecision
 	riskLevel       valueobject.RiskLevel
 	transactionType string
+	modelVersion    string
+	policyVersion   int
 	riskSignals     []string
 	domainEvents    []events.DomainEvent
 	riskScore       int
@@ -84,14 +86,35 @@ func NewTransactionAssessment(
 // Assess applies a risk score and signals to the assessment, determining the
 // risk level and decision. This is the core domain operation.
 func (a *TransactionAssessment) Assess(riskScore int, signals []string) error {
+	return a.AssessWithModel(riskScore, signals, "")
+}
+
+// AssessWithModel is Assess for scores that include an ML prediction. The
+// model version is recorded so every decision can be traced to the model
+// that contributed to it. An empty version means rules-only scoring.
+func (a *TransactionAssessment) AssessWithModel(riskScore int, signals []string, modelVersion string) error {
+	return a.AssessWithPolicy(riskScore, signals, modelVersion, valueobject.DefaultDecisionThresholds(), 0)
+}
+
+// AssessWithPolicy is AssessWithModel using the cutoffs of a tenant decision
+// policy. policyVersion is recorded alongside the decision; 0 means the
+// global default cutoffs.
+func (a *TransactionAssessment) AssessWithPolicy(
+	riskScore int,
+	signals []string,
+	modelVersion string,
+	thresholds valueobject.DecisionThresholds,
+	policyVersion int,
+) error {
 	if riskScore < 0 || riskScore > 100 {
 		return fmt.Errorf("risk score must be between 0 and 100, got %d", riskScore)
 	}
 
 	a.riskScore = riskScore
 	a.riskSignals = signals
-	a.riskLevel = valueobject.RiskLevelFromScore(riskScore)
-	a.decision = valueobject.DecisionFromScore(riskScore)
+	a.modelVersion = modelVersion
+	a.policyVersion = policyVersion
+	a.riskLevel, a.decision = decide(thresholds, riskScore, hasSignal(signals, SignalSanctionsHit))
 	a.assessedAt = time.Now().UTC()
 	a.updatedAt = a.assessedAt
 	a.version++
@@ -100,7 +123,7 @@ func (a *TransactionAssessment) Assess(riskScore int, signals []string) error {
 	a.domainEvents = append(a.domainEvents, event.NewAssessmentCompleted(
 		a.id, a.tenantID, a.transactionID, a.accountID,
 		a.riskScore, a.riskLevel.String(), a.decision.String(),
-		a.riskSignals, a.assessedAt,
+		a.riskSignals, a.modelVersion, a.assessedAt,
 	))
 
 	// Emit HighRiskDetected if the risk level is CRITICAL.
@@ -123,6 +146,8 @@ func Reconstruct(
 	riskScore int,
 	decision valueobject.AssessmentDecision,
 	riskSignals []string,
+	modelVersion string,
+	policyVersion int,
 	assessedAt time.Time,
 	version int,
 	createdAt, updatedAt time.Time,
@@ -139,6 +164,8 @@ func Reconstruct(
 		riskScore:       riskScore,
 		decision:        decision,
 		riskSignals:     riskSignals,
+		modelVersion:    modelVersion,
+		policyVersion:   policyVersion,
 		assessedAt:      assessedAt,
 		version:         version,
 		createdAt:       createdAt,
@@ -160,6 +187,8 @@ func (a *TransactionAssessment) RiskLevel() valueobject.RiskLevel         { retu
 func (a *TransactionAssessment) RiskScore() int                           { return a.riskScore }
 func (a *TransactionAssessment) Decision() valueobject.AssessmentDecision { return a.decision }
 func (a *TransactionAssessment) RiskSignals() []string                    { return a.riskSignals }
+func (a *TransactionAssessment) ModelVersion() string                     { return a.modelVersion }
+func (a *TransactionAssessment) PolicyVersion() int                       { return a.policyVersion }
 func (a *TransactionAssessment) AssessedAt() time.Time                    { return a.assessedAt }
 func (a *TransactionAssessment) Version() int                             { return a.version }
 func (a *TransactionAssessment) CreatedAt() time.Time                     { return a.createdAt }
@@ -171,3 +200,22 @@ func (a *TransactionAssessment) DomainEvents() []events.DomainEvent {
 	a.domainEvents = make([]events.DomainEvent, 0)
 	return evts
 }
+
+// decide maps a score to a risk level and decision under the thresholds.
+// Sanctions hits are never auto-approved or auto-declined: the transaction
+// is held until an analyst clears or confirms the match.
+func decide(t valueobject.DecisionThresholds, score int, sanctionsHit bool) (valueobject.RiskLevel, valueobject.AssessmentDecision) {
+	if sanctionsHit {
+		return valueobject.RiskLevelCritical, valueobject.DecisionReview
+	}
+	return t.RiskLevel(score), t.Decision(score)
+}
+
+func hasSignal(signals []string, signal string) bool {
+	for _, s := range signals {
+		if s == signal {
+			return true
+		}
+	}
+	return false
+}
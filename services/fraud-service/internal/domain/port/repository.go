@@ -2,6 +2,7 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -22,6 +23,190 @@ type AssessmentRepository interface {
 
 	// FindByAccountID retrieves all assessments for a given account.
 	FindByAccountID(ctx context.Context, tenantID, accountID uuid.UUID, limit, offset int) ([]*model.TransactionAssessment, error)
+
+	// List returns a page of assessments matching the filter, newest first,
+	// plus the total match count.
+	List(ctx context.Context, filter AssessmentFilter) ([]*model.TransactionAssessment, int, error)
+
+	// DailyDecisionCounts counts the tenant's assessments in [from, to) by
+	// UTC day and decision.
+	DailyDecisionCounts(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]model.DecisionVolume, error)
+
+	// ScoreDistribution counts the tenant's assessments in [from, to) by
+	// score, risk level, decision and sanctions status.
+	ScoreDistribution(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]model.ScoreBucket, error)
+}
+
+// RuleRepository defines the persistence port for fraud rules.
+type RuleRepository interface {
+	// Save persists a new or revised rule and records a version snapshot.
+	Save(ctx context.Context, rule *model.FraudRule) error
+	// FindByID retrieves a rule by its unique identifier.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.FraudRule, error)
+	// ListByTenant retrieves all rules configured for a tenant.
+	ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*model.FraudRule, error)
+	// ListEvaluated retrieves ACTIVE and SHADOW rules across all tenants,
+	// used to (re)load the scoring engine.
+	ListEvaluated(ctx context.Context) ([]*model.FraudRule, error)
+	// ListVersions retrieves the revision history of a rule, newest first.
+	ListVersions(ctx context.Context, tenantID, ruleID uuid.UUID) ([]model.RuleVersion, error)
+}
+
+// RuleHitRepository defines the persistence port for per-rule hit metrics.
+type RuleHitRepository interface {
+	// RecordHits increments hit counters for the rules that fired on an assessment.
+	RecordHits(ctx context.Context, tenantID uuid.UUID, hits []model.RuleHit) error
+	// Stats returns aggregated hit counts for a tenant's rules.
+	Stats(ctx context.Context, tenantID uuid.UUID) ([]model.RuleHitStats, error)
+}
+
+// AssessmentFilter narrows an assessment search. Zero values mean "any";
+// From is inclusive and To exclusive.
+type AssessmentFilter struct {
+	From      time.Time
+	To        time.Time
+	Decision  string
+	RiskLevel string
+	Limit     int
+	Offset    int
+	TenantID  uuid.UUID
+	AccountID uuid.UUID
+}
+
+// CaseFilter narrows a review queue listing. Zero values mean "any".
+type CaseFilter struct {
+	Status      string
+	Limit       int
+	Offset      int
+	TenantID    uuid.UUID
+	AssigneeID  uuid.UUID
+	OverdueOnly bool
+}
+
+// CaseRepository defines the persistence port for fraud review cases.
+type CaseRepository interface {
+	// Save persists a new or updated case together with its notes.
+	Save(ctx context.Context, c *model.FraudCase) error
+	// FindByID retrieves a case by its unique identifier.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.FraudCase, error)
+	// FindByAssessmentID retrieves the case opened for an assessment, if any.
+	FindByAssessmentID(ctx context.Context, tenantID, assessmentID uuid.UUID) (*model.FraudCase, error)
+	// FindOpenAMLCase retrieves the account's unresolved case for an AML
+	// scenario, if any.
+	FindOpenAMLCase(ctx context.Context, tenantID, accountID uuid.UUID, scenario string) (*model.FraudCase, error)
+	// List returns a page of cases ordered by SLA deadline, plus the total match count.
+	List(ctx context.Context, filter CaseFilter) ([]*model.FraudCase, int, error)
+	// ListSLABreached returns up to limit unresolved cases, across tenants,
+	// whose SLA deadline is before now and whose breach has not yet been
+	// recorded, oldest deadline first.
+	ListSLABreached(ctx context.Context, now time.Time, limit int) ([]*model.FraudCase, error)
+}
+
+// LabelRepository defines the persistence port for ground-truth fraud labels.
+type LabelRepository interface {
+	// Save inserts or replaces the label for an assessment.
+	Save(ctx context.Context, label *model.AssessmentLabel) error
+	// FindByAssessmentID retrieves the current label for an assessment, if any.
+	FindByAssessmentID(ctx context.Context, tenantID, assessmentID uuid.UUID) (*model.AssessmentLabel, error)
+	// ListTrainingExamples returns labeled assessments whose label was
+	// recorded in [from, to), oldest first.
+	ListTrainingExamples(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]model.TrainingExample, error)
+}
+
+// DatasetStore defines the port for writing exported datasets to object storage.
+type DatasetStore interface {
+	// Put writes an object and returns its URI.
+	Put(ctx context.Context, key string, data []byte) (string, error)
+}
+
+// ScreeningRepository defines the persistence port for the sanctions
+// screening audit log. Records are append-only.
+type ScreeningRepository interface {
+	Save(ctx context.Context, record *model.ScreeningRecord) error
+	// ListByTransactionID returns every screening of a transaction, oldest first.
+	ListByTransactionID(ctx context.Context, tenantID, transactionID uuid.UUID) ([]*model.ScreeningRecord, error)
+}
+
+// WatchlistSource defines the port for fetching sanctions lists.
+type WatchlistSource interface {
+	// Fetch returns all entries across the configured lists together with a
+	// version that changes whenever the list contents change.
+	Fetch(ctx context.Context) ([]model.WatchlistEntry, string, error)
+}
+
+// DeviceProfileRepository defines the persistence port for per-account device profiles.
+type DeviceProfileRepository interface {
+	Save(ctx context.Context, profile *model.DeviceProfile) error
+	// Find returns the account's profile for a fingerprint, or nil if the
+	// device has not been seen on the account.
+	Find(ctx context.Context, tenantID, accountID uuid.UUID, fingerprint string) (*model.DeviceProfile, error)
+	// CountAccounts returns how many accounts in the tenant have used the fingerprint.
+	CountAccounts(ctx context.Context, tenantID uuid.UUID, fingerprint string) (int, error)
+	ListByAccount(ctx context.Context, tenantID, accountID uuid.UUID) ([]*model.DeviceProfile, error)
+}
+
+// IPIntelligence defines the port for geo, ASN and proxy-detection lookups.
+type IPIntelligence interface {
+	// Lookup returns what is known about ip. Implementations must honour
+	// ctx cancellation; enrichment is best-effort and must not stall scoring.
+	Lookup(ctx context.Context, ip string) (model.IPInfo, error)
+}
+
+// DecisionPolicyRepository defines the persistence port for tenant decision
+// policies. Versions are append-only.
+type DecisionPolicyRepository interface {
+	Save(ctx context.Context, policy *model.DecisionPolicy) error
+	// FindLatest returns the tenant's highest policy version, or nil if none exists.
+	FindLatest(ctx context.Context, tenantID uuid.UUID) (*model.DecisionPolicy, error)
+	// ListVersions returns the tenant's policy versions, newest first.
+	ListVersions(ctx context.Context, tenantID uuid.UUID) ([]*model.DecisionPolicy, error)
+	// ListAll returns every policy version across tenants, for loading the resolver.
+	ListAll(ctx context.Context) ([]*model.DecisionPolicy, error)
+}
+
+// LinkGraphRepository persists the account link graph: the devices, IP
+// addresses and destination accounts each account has used.
+type LinkGraphRepository interface {
+	// RecordLinks upserts edges, refreshing their last-seen time.
+	RecordLinks(ctx context.Context, links []model.AccountLink) error
+	// FraudLinkedAccounts returns the other accounts with a confirmed fraud
+	// label that share any of the given entities, with the entities they share.
+	FraudLinkedAccounts(ctx context.Context, tenantID, accountID uuid.UUID, entities []model.LinkEntity) ([]model.LinkedAccount, error)
+	// LinkedAccounts returns up to limit accounts sharing at least one entity
+	// with the account, confirmed-fraud accounts first.
+	LinkedAccounts(ctx context.Context, tenantID, accountID uuid.UUID, limit int) ([]model.LinkedAccount, error)
+}
+
+// AuthAnomalyRepository persists the failed-authentication anomalies the
+// gateway reports.
+type AuthAnomalyRepository interface {
+	// Record stores an anomaly. Recording the same event twice is a no-op.
+	Record(ctx context.Context, anomaly model.AuthAnomaly) error
+	// SummarizeIP counts the anomalies from ip since the given time.
+	SummarizeIP(ctx context.Context, ip string, since time.Time) (model.AuthAnomalySummary, error)
+}
+
+// FlaggedPartyRepository persists the customers identity screening found on
+// sanctions lists.
+type FlaggedPartyRepository interface {
+	// Flag stores a flagged party, replacing an earlier flag of the same
+	// verification.
+	Flag(ctx context.Context, party model.FlaggedParty) error
+	// Clear removes the flag of a verification. Clearing an unflagged
+	// verification is a no-op.
+	Clear(ctx context.Context, tenantID, verificationID uuid.UUID) error
+	// ListByTenant returns the tenant's flagged parties.
+	ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]model.FlaggedParty, error)
+}
+
+// AMLTransactionRepository persists the transactions monitored for AML
+// scenarios.
+type AMLTransactionRepository interface {
+	// Record stores a transaction. Recording the same transaction twice is a no-op.
+	Record(ctx context.Context, txn model.MonitoredTransaction) error
+	// ListByAccount returns the account's transactions that occurred at or
+	// after since, oldest first.
+	ListByAccount(ctx context.Context, tenantID, accountID uuid.UUID, since time.Time) ([]model.MonitoredTransaction, error)
 }
 
 // EventPublisher defines the port for publishing domain events.
@@ -30,9 +215,19 @@ type EventPublisher interface {
 	Publish(ctx context.Context, events ...events.DomainEvent) error
 }
 
+// MLPrediction is a fraud probability returned by an ML model.
+type MLPrediction struct {
+	// ModelVersion identifies the model that produced the score, e.g. "fraud-gbm:v12".
+	ModelVersion string
+	// Score is the fraud probability in [0, 1].
+	Score float64
+}
+
 // MLModelClient defines the port for integrating with an external ML model
-// for AI-powered risk scoring (future integration).
+// for AI-powered risk scoring.
 type MLModelClient interface {
-	// Predict sends feature data to an ML model and returns a risk score.
-	Predict(ctx context.Context, features map[string]interface{}) (score float64, err error)
+	// Predict sends feature data to an ML model and returns its prediction.
+	// Implementations must honour ctx cancellation so a slow model cannot
+	// stall the assessment path.
+	Predict(ctx context.Context, features map[string]interface{}) (MLPrediction, error)
 }
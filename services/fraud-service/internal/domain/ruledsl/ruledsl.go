@@ -0,0 +1,499 @@
+// Package ruledsl implements the small expression language used by fraud
+// rules. A rule condition is a boolean expression over transaction fields,
+// for example:
+//
+//	amount > 10000 AND destination_country IN ('KP', 'IR', 'SY')
+//	transaction_type = 'crypto_purchase' OR velocity.txn_count_1h >= 10
+//	metadata.account_age = 'new' AND NOT (currency IN ('USD', 'EUR'))
+//	device.new = 'true' AND amount > 5000
+//	merchant_category IN ('7995', '6051') AND amount > 1000
+//
+// Supported fields are amount, currency, transaction_type, source_country,
+// destination_country, merchant_category (the ISO 18245 merchant category
+// code, read like the countries from the metadata key of the same name),
+// velocity.<feature>, device.<feature>, ip.<feature>,
+// link.<feature> and auth.<feature> (read from the "velocity_<feature>",
+// "device_<feature>", "ip_<feature>", "link_<feature>" and "auth_<feature>"
+// metadata keys) and metadata.<key>. Comparison operators are =, !=, >, >=,
+// <, <=, IN and NOT IN; conditions combine with AND, OR, NOT and parentheses.
+// The right-hand side of a comparison may be a literal or another field.
+package ruledsl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Facts is the evaluation context for a rule condition.
+type Facts struct {
+	Metadata        map[string]string
+	Amount          decimal.Decimal
+	Currency        string
+	TransactionType string
+}
+
+// Expression is a parsed, evaluable rule condition.
+type Expression struct {
+	root   node
+	source string
+}
+
+// Parse compiles a rule condition. It returns an error describing the first
+// syntax problem encountered.
+func Parse(source string) (*Expression, error) {
+	if strings.TrimSpace(source) == "" {
+		return nil, fmt.Errorf("condition is required")
+	}
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.at(tokEOF) {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.peek().text, p.peek().pos)
+	}
+	return &Expression{root: root, source: source}, nil
+}
+
+// Source returns the original condition text.
+func (e *Expression) Source() string {
+	return e.source
+}
+
+// Evaluate reports whether the condition holds for the given facts.
+// Comparisons involving a missing field evaluate to false.
+func (e *Expression) Evaluate(facts Facts) bool {
+	return e.root.eval(facts)
+}
+
+// --- AST ---
+
+type node interface {
+	eval(f Facts) bool
+}
+
+type andNode struct{ left, right node }
+
+func (n andNode) eval(f Facts) bool { return n.left.eval(f) && n.right.eval(f) }
+
+type orNode struct{ left, right node }
+
+func (n orNode) eval(f Facts) bool { return n.left.eval(f) || n.right.eval(f) }
+
+type notNode struct{ inner node }
+
+func (n notNode) eval(f Facts) bool { return !n.inner.eval(f) }
+
+// operand is either a field reference or a literal.
+type operand struct {
+	field   string
+	literal string
+	isField bool
+}
+
+func (o operand) resolve(f Facts) (string, bool) {
+	if !o.isField {
+		return o.literal, true
+	}
+	return lookup(o.field, f)
+}
+
+type compareNode struct {
+	op    string
+	left  operand
+	right operand
+}
+
+func (n compareNode) eval(f Facts) bool {
+	lv, ok := n.left.resolve(f)
+	if !ok {
+		return false
+	}
+	rv, ok := n.right.resolve(f)
+	if !ok {
+		return false
+	}
+
+	ld, lerr := decimal.NewFromString(lv)
+	rd, rerr := decimal.NewFromString(rv)
+	numeric := lerr == nil && rerr == nil
+
+	switch n.op {
+	case "=":
+		if numeric {
+			return ld.Equal(rd)
+		}
+		return strings.EqualFold(lv, rv)
+	case "!=":
+		if numeric {
+			return !ld.Equal(rd)
+		}
+		return !strings.EqualFold(lv, rv)
+	case ">":
+		return numeric && ld.GreaterThan(rd)
+	case ">=":
+		return numeric && ld.GreaterThanOrEqual(rd)
+	case "<":
+		return numeric && ld.LessThan(rd)
+	case "<=":
+		return numeric && ld.LessThanOrEqual(rd)
+	}
+	return false
+}
+
+type inNode struct {
+	left    operand
+	values  []string
+	negated bool
+}
+
+func (n inNode) eval(f Facts) bool {
+	lv, ok := n.left.resolve(f)
+	if !ok {
+		return false
+	}
+	found := false
+	for _, v := range n.values {
+		if strings.EqualFold(lv, v) {
+			found = true
+			break
+		}
+	}
+	return found != n.negated
+}
+
+// featureNamespaces are the derived-feature field prefixes. A field
+// "<ns>.<feature>" reads the "<ns>_<feature>" metadata key populated by
+// enrichment before scoring.
+var featureNamespaces = []string{"velocity", "device", "ip", "link", "auth"}
+
+// lookup resolves a field name against the facts.
+func lookup(field string, f Facts) (string, bool) {
+	switch field {
+	case "amount":
+		return f.Amount.String(), true
+	case "currency":
+		return f.Currency, f.Currency != ""
+	case "transaction_type":
+		return f.TransactionType, f.TransactionType != ""
+	case "source_country", "destination_country", "merchant_category":
+		v, ok := f.Metadata[field]
+		return v, ok && v != ""
+	}
+	for _, ns := range featureNamespaces {
+		if key, ok := strings.CutPrefix(field, ns+"."); ok {
+			v, ok := f.Metadata[ns+"_"+key]
+			return v, ok && v != ""
+		}
+	}
+	if key, ok := strings.CutPrefix(field, "metadata."); ok {
+		v, ok := f.Metadata[key]
+		return v, ok
+	}
+	return "", false
+}
+
+// validField reports whether a field name is addressable in a condition.
+func validField(name string) bool {
+	switch name {
+	case "amount", "currency", "transaction_type", "source_country", "destination_country", "merchant_category":
+		return true
+	}
+	for _, prefix := range []string{"velocity.", "device.", "ip.", "link.", "auth.", "metadata."} {
+		if rest, ok := strings.CutPrefix(name, prefix); ok {
+			return rest != ""
+		}
+	}
+	return false
+}
+
+// --- Lexer ---
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokString
+	tokNumber
+	tokOp
+	tokLParen
+	tokRParen
+	tokLBracket
+	tokRBracket
+	tokComma
+	tokAnd
+	tokOr
+	tokNot
+	tokIn
+)
+
+type token struct {
+	text string
+	kind tokenKind
+	pos  int
+}
+
+func tokenize(src string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokLParen, text: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokRParen, text: ")", pos: i})
+			i++
+		case c == '[':
+			tokens = append(tokens, token{kind: tokLBracket, text: "[", pos: i})
+			i++
+		case c == ']':
+			tokens = append(tokens, token{kind: tokRBracket, text: "]", pos: i})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{kind: tokComma, text: ",", pos: i})
+			i++
+		case c == '=' || c == '!' || c == '<' || c == '>':
+			start := i
+			i++
+			if i < len(src) && src[i] == '=' {
+				i++
+			}
+			op := src[start:i]
+			if op == "!" {
+				return nil, fmt.Errorf("unexpected '!' at position %d", start)
+			}
+			if op == "==" {
+				op = "="
+			}
+			tokens = append(tokens, token{kind: tokOp, text: op, pos: start})
+		case c == '\'' || c == '"':
+			start := i
+			i++
+			for i < len(src) && src[i] != c {
+				i++
+			}
+			if i >= len(src) {
+				return nil, fmt.Errorf("unterminated string at position %d", start)
+			}
+			tokens = append(tokens, token{kind: tokString, text: src[start+1 : i], pos: start})
+			i++
+		case c == '-' || (c >= '0' && c <= '9'):
+			start := i
+			i++
+			for i < len(src) && (src[i] == '.' || (src[i] >= '0' && src[i] <= '9')) {
+				i++
+			}
+			text := src[start:i]
+			if _, err := decimal.NewFromString(text); err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", text, start)
+			}
+			tokens = append(tokens, token{kind: tokNumber, text: text, pos: start})
+		case isIdentStart(c):
+			start := i
+			for i < len(src) && isIdentPart(src[i]) {
+				i++
+			}
+			text := src[start:i]
+			kind := tokIdent
+			switch strings.ToUpper(text) {
+			case "AND":
+				kind = tokAnd
+			case "OR":
+				kind = tokOr
+			case "NOT":
+				kind = tokNot
+			case "IN":
+				kind = tokIn
+			}
+			tokens = append(tokens, token{kind: kind, text: text, pos: start})
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+		}
+	}
+	tokens = append(tokens, token{kind: tokEOF, text: "end of condition", pos: len(src)})
+	return tokens, nil
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || c == '.' || (c >= '0' && c <= '9')
+}
+
+// --- Parser ---
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token { return p.tokens[p.pos] }
+
+func (p *parser) at(kind tokenKind) bool { return p.tokens[p.pos].kind == kind }
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) expect(kind tokenKind, what string) (token, error) {
+	t := p.next()
+	if t.kind != kind {
+		return t, fmt.Errorf("expected %s at position %d, got %q", what, t.pos, t.text)
+	}
+	return t, nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.at(tokOr) {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.at(tokAnd) {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	switch {
+	case p.at(tokNot):
+		p.next()
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{inner: inner}, nil
+	case p.at(tokLParen):
+		p.next()
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokRParen, "')'"); err != nil {
+			return nil, err
+		}
+		return inner, nil
+	default:
+		return p.parseComparison()
+	}
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case p.at(tokIn):
+		p.next()
+		values, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		return inNode{left: left, values: values}, nil
+	case p.at(tokNot):
+		p.next()
+		if _, err := p.expect(tokIn, "IN after NOT"); err != nil {
+			return nil, err
+		}
+		values, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		return inNode{left: left, values: values, negated: true}, nil
+	case p.at(tokOp):
+		op := p.next().text
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return compareNode{op: op, left: left, right: right}, nil
+	default:
+		t := p.peek()
+		return nil, fmt.Errorf("expected comparison operator at position %d, got %q", t.pos, t.text)
+	}
+}
+
+func (p *parser) parseOperand() (operand, error) {
+	t := p.next()
+	switch t.kind {
+	case tokIdent:
+		if !validField(t.text) {
+			return operand{}, fmt.Errorf("unknown field %q at position %d", t.text, t.pos)
+		}
+		return operand{field: t.text, isField: true}, nil
+	case tokString, tokNumber:
+		return operand{literal: t.text}, nil
+	default:
+		return operand{}, fmt.Errorf("expected field or value at position %d, got %q", t.pos, t.text)
+	}
+}
+
+func (p *parser) parseList() ([]string, error) {
+	closing := tokRParen
+	switch {
+	case p.at(tokLParen):
+	case p.at(tokLBracket):
+		closing = tokRBracket
+	default:
+		t := p.peek()
+		return nil, fmt.Errorf("expected list at position %d, got %q", t.pos, t.text)
+	}
+	p.next()
+
+	var values []string
+	for {
+		t := p.next()
+		if t.kind != tokString && t.kind != tokNumber {
+			return nil, fmt.Errorf("expected list value at position %d, got %q", t.pos, t.text)
+		}
+		values = append(values, t.text)
+		if p.at(tokComma) {
+			p.next()
+			continue
+		}
+		if _, err := p.expect(closing, "end of list"); err != nil {
+			return nil, err
+		}
+		return values, nil
+	}
+}
@@ -0,0 +1,104 @@
+package ruledsl_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/ruledsl"
+)
+
+func sampleFacts() ruledsl.Facts {
+	return ruledsl.Facts{
+		Amount:          decimal.NewFromInt(15000),
+		Currency:        "USD",
+		TransactionType: "wire_transfer",
+		Metadata: map[string]string{
+			"source_country":        "US",
+			"destination_country":   "IR",
+			"merchant_category":     "7995",
+			"account_age":           "new",
+			"velocity_txn_count_1h": "12",
+			"device_new":            "true",
+			"ip_proxy":              "false",
+			"link_fraud_accounts":   "2",
+		},
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		condition string
+		want      bool
+	}{
+		{"amount > 10000", true},
+		{"amount <= 10000", false},
+		{"amount >= 15000.00", true},
+		{"currency = 'usd'", true},
+		{"currency != \"USD\"", false},
+		{"transaction_type == 'wire_transfer'", true},
+		{"destination_country IN ('KP', 'IR')", true},
+		{"destination_country NOT IN ['KP', 'IR']", false},
+		{"source_country != destination_country", true},
+		{"merchant_category IN ('7995', '6051')", true},
+		{"merchant_category = '5411'", false},
+		{"velocity.txn_count_1h >= 10", true},
+		{"velocity.txn_count_24h >= 10", false},
+		{"device.new = 'true' AND amount > 5000", true},
+		{"ip.proxy = 'true' OR ip.tor = 'true'", false},
+		{"link.fraud_accounts >= 1", true},
+		{"metadata.account_age = 'new' AND amount > 1000", true},
+		{"metadata.account_age = 'old' OR amount > 1000", true},
+		{"NOT (currency IN ('USD', 'EUR'))", false},
+		{"amount > 100 AND (currency = 'EUR' OR transaction_type = 'wire_transfer')", true},
+		{"metadata.missing = 'x'", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			expr, err := ruledsl.Parse(tt.condition)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, expr.Evaluate(sampleFacts()))
+			assert.Equal(t, tt.condition, expr.Source())
+		})
+	}
+}
+
+func TestEvaluate_MissingFieldIsFalse(t *testing.T) {
+	expr, err := ruledsl.Parse("source_country != destination_country")
+	require.NoError(t, err)
+
+	facts := sampleFacts()
+	delete(facts.Metadata, "source_country")
+	assert.False(t, expr.Evaluate(facts))
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		condition string
+		wantErr   string
+	}{
+		{"", "condition is required"},
+		{"amount >", "expected field or value"},
+		{"balance > 10", "unknown field"},
+		{"amount 10", "expected comparison operator"},
+		{"currency IN 'USD'", "expected list"},
+		{"currency IN ('USD'", "expected end of list"},
+		{"(amount > 10", "expected ')'"},
+		{"currency = 'USD", "unterminated string"},
+		{"amount > 10 amount", "unexpected"},
+		{"amount ! 10", "unexpected '!'"},
+		{"currency NOT 'USD'", "expected IN after NOT"},
+		{"metadata. = 'x'", "unknown field"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			_, err := ruledsl.Parse(tt.condition)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+// Risk scores of the cases each AML scenario opens.
+const (
+	structuringRiskScore       = 70
+	rapidMovementRiskScore     = 70
+	highRiskGeographyRiskScore = 80
+)
+
+// AMLScenarios configures the AML monitoring scenarios. Amounts apply to each
+// currency separately. A scenario with a zero threshold is disabled.
+type AMLScenarios struct {
+	// StructuringThreshold is the reporting threshold structured payments
+	// stay below, and StructuringMargin how far below it, as a fraction of
+	// the threshold, a payment must be to count. StructuringMinCount such
+	// payments in the same direction within StructuringWindow raise an alert.
+	StructuringThreshold decimal.Decimal
+	StructuringMargin    decimal.Decimal
+	StructuringWindow    time.Duration
+	StructuringMinCount  int
+
+	// RapidMovementMinAmount is the least an account must be paid within
+	// RapidMovementWindow before its payments out are considered; an alert
+	// is raised once it has paid out RapidMovementRatio of what it was paid.
+	RapidMovementMinAmount decimal.Decimal
+	RapidMovementRatio     decimal.Decimal
+	RapidMovementWindow    time.Duration
+
+	// HighRiskCountries are the ISO 3166 alpha-2 codes of high-risk
+	// jurisdictions. An alert is raised once an account has sent at least
+	// HighRiskGeographyThreshold to them within HighRiskGeographyWindow.
+	HighRiskCountries          []string
+	HighRiskGeographyThreshold decimal.Decimal
+	HighRiskGeographyWindow    time.Duration
+}
+
+// AMLMonitor evaluates the AML scenarios over an account's recent
+// transactions. It is stateless: callers supply the account's history.
+type AMLMonitor struct {
+	highRisk  map[string]bool
+	scenarios AMLScenarios
+}
+
+// NewAMLMonitor creates a monitor evaluating the given scenarios.
+func NewAMLMonitor(scenarios AMLScenarios) *AMLMonitor {
+	highRisk := make(map[string]bool, len(scenarios.HighRiskCountries))
+	for _, c := range scenarios.HighRiskCountries {
+		if c = strings.ToUpper(strings.TrimSpace(c)); c != "" {
+			highRisk[c] = true
+		}
+	}
+	return &AMLMonitor{scenarios: scenarios, highRisk: highRisk}
+}
+
+// Lookback is how far back an account's history must reach for Evaluate to
+// see every transaction the scenarios consider.
+func (m *AMLMonitor) Lookback() time.Duration {
+	return max(m.scenarios.StructuringWindow, m.scenarios.RapidMovementWindow, m.scenarios.HighRiskGeographyWindow)
+}
+
+// Evaluate returns the scenarios txn completes on its account. history holds
+// the account's transactions from txn.OccurredAt-Lookback() up to txn, and
+// may include txn itself. Only matches txn takes part in are returned, so
+// transactions that merely follow a pattern do not raise it again.
+func (m *AMLMonitor) Evaluate(txn model.MonitoredTransaction, history []model.MonitoredTransaction) []model.AMLAlert {
+	var related []model.MonitoredTransaction
+	for _, h := range history {
+		if h.AccountID == txn.AccountID && h.Currency == txn.Currency &&
+			!h.OccurredAt.After(txn.OccurredAt) && !sameTransaction(h, txn) {
+			related = append(related, h)
+		}
+	}
+	related = append(related, txn)
+	sort.SliceStable(related, func(i, j int) bool { return related[i].OccurredAt.Before(related[j].OccurredAt) })
+
+	var alerts []model.AMLAlert
+	for _, alert := range []*model.AMLAlert{
+		m.structuring(txn, related),
+		m.rapidMovement(txn, related),
+		m.highRiskGeography(txn, related),
+	} {
+		if alert != nil {
+			alert.TenantID, alert.AccountID = txn.TenantID, txn.AccountID
+			alerts = append(alerts, *alert)
+		}
+	}
+	return alerts
+}
+
+func (m *AMLMonitor) structuring(txn model.MonitoredTransaction, related []model.MonitoredTransaction) *model.AMLAlert {
+	s := m.scenarios
+	if !s.StructuringThreshold.IsPositive() || s.StructuringMinCount < 2 {
+		return nil
+	}
+	floor := s.StructuringThreshold.Sub(s.StructuringThreshold.Mul(s.StructuringMargin))
+	structured := func(t model.MonitoredTransaction) bool {
+		return t.Direction == txn.Direction && t.Amount.GreaterThanOrEqual(floor) && t.Amount.LessThan(s.StructuringThreshold)
+	}
+	if !structured(txn) {
+		return nil
+	}
+
+	matched := within(related, txn.OccurredAt.Add(-s.StructuringWindow), structured)
+	if len(matched) < s.StructuringMinCount {
+		return nil
+	}
+	return &model.AMLAlert{
+		Scenario: model.AMLScenarioStructuring,
+		Summary: fmt.Sprintf("%d payments %s between %s and %s %s within %s, totalling %s %s.",
+			len(matched), directionWord(txn.Direction), floor.StringFixed(2), s.StructuringThreshold.StringFixed(2),
+			txn.Currency, window(s.StructuringWindow), total(matched).StringFixed(2), txn.Currency),
+		TransactionIDs: transactionIDs(matched),
+		RiskScore:      structuringRiskScore,
+	}
+}
+
+func (m *AMLMonitor) rapidMovement(txn model.MonitoredTransaction, related []model.MonitoredTransaction) *model.AMLAlert {
+	s := m.scenarios
+	if !s.RapidMovementMinAmount.IsPositive() || txn.Direction != model.FlowOut {
+		return nil
+	}
+	since := txn.OccurredAt.Add(-s.RapidMovementWindow)
+	in := within(related, since, func(t model.MonitoredTransaction) bool { return t.Direction == model.FlowIn })
+	out := within(related, since, func(t model.MonitoredTransaction) bool { return t.Direction == model.FlowOut })
+	paidIn, paidOut := total(in), total(out)
+	if paidIn.LessThan(s.RapidMovementMinAmount) || paidOut.LessThan(paidIn.Mul(s.RapidMovementRatio)) {
+		return nil
+	}
+	// Only the payment crossing the ratio raises the alert.
+	if paidOut.Sub(txn.Amount).GreaterThanOrEqual(paidIn.Mul(s.RapidMovementRatio)) {
+		return nil
+	}
+
+	matched := within(related, since, func(model.MonitoredTransaction) bool { return true })
+	return &model.AMLAlert{
+		Scenario: model.AMLScenarioRapidMovement,
+		Summary: fmt.Sprintf("%s %s paid out of %s %s paid in within %s.",
+			paidOut.StringFixed(2), txn.Currency, paidIn.StringFixed(2), txn.Currency, window(s.RapidMovementWindow)),
+		TransactionIDs: transactionIDs(matched),
+		RiskScore:      rapidMovementRiskScore,
+	}
+}
+
+func (m *AMLMonitor) highRiskGeography(txn model.MonitoredTransaction, related []model.MonitoredTransaction) *model.AMLAlert {
+	s := m.scenarios
+	if !s.HighRiskGeographyThreshold.IsPositive() || txn.Direction != model.FlowOut || !m.highRisk[txn.Country] {
+		return nil
+	}
+	matched := within(related, txn.OccurredAt.Add(-s.HighRiskGeographyWindow), func(t model.MonitoredTransaction) bool {
+		return t.Direction == model.FlowOut && m.highRisk[t.Country]
+	})
+	sent := total(matched)
+	if sent.LessThan(s.HighRiskGeographyThreshold) || sent.Sub(txn.Amount).GreaterThanOrEqual(s.HighRiskGeographyThreshold) {
+		return nil
+	}
+
+	countries := make([]string, 0, len(matched))
+	seen := make(map[string]bool)
+	for _, t := range matched {
+		if !seen[t.Country] {
+			seen[t.Country] = true
+			countries = append(countries, t.Country)
+		}
+	}
+	return &model.AMLAlert{
+		Scenario: model.AMLScenarioHighRiskGeography,
+		Summary: fmt.Sprintf("%s %s sent to high-risk jurisdictions (%s) within %s.",
+			sent.StringFixed(2), txn.Currency, strings.Join(countries, ", "), window(s.HighRiskGeographyWindow)),
+		TransactionIDs: transactionIDs(matched),
+		RiskScore:      highRiskGeographyRiskScore,
+	}
+}
+
+// within returns the transactions at or after since that match keep.
+func within(txns []model.MonitoredTransaction, since time.Time, keep func(model.MonitoredTransaction) bool) []model.MonitoredTransaction {
+	var out []model.MonitoredTransaction
+	for _, t := range txns {
+		if !t.OccurredAt.Before(since) && keep(t) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+func sameTransaction(a, b model.MonitoredTransaction) bool {
+	return a.EventID == b.EventID && a.Direction == b.Direction
+}
+
+func total(txns []model.MonitoredTransaction) decimal.Decimal {
+	sum := decimal.Zero
+	for _, t := range txns {
+		sum = sum.Add(t.Amount)
+	}
+	return sum
+}
+
+func transactionIDs(txns []model.MonitoredTransaction) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(txns))
+	for _, t := range txns {
+		ids = append(ids, t.TransactionID)
+	}
+	return ids
+}
+
+// window formats a scenario window for alert summaries, in days when it is
+// a whole number of them.
+func window(d time.Duration) string {
+	const day = 24 * time.Hour
+	switch {
+	case d == day:
+		return "1 day"
+	case d > day && d%day == 0:
+		return fmt.Sprintf("%d days", d/day)
+	default:
+		return d.String()
+	}
+}
+
+func directionWord(direction string) string {
+	if direction == model.FlowIn {
+		return "received"
+	}
+	return "sent"
+}
@@ -0,0 +1,131 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/service"
+)
+
+func amlScenarios() service.AMLScenarios {
+	return service.AMLScenarios{
+		StructuringThreshold:       decimal.NewFromInt(10000),
+		StructuringMargin:          decimal.NewFromFloat(0.1),
+		StructuringWindow:          24 * time.Hour,
+		StructuringMinCount:        3,
+		RapidMovementMinAmount:     decimal.NewFromInt(10000),
+		RapidMovementRatio:         decimal.NewFromFloat(0.9),
+		RapidMovementWindow:        48 * time.Hour,
+		HighRiskCountries:          []string{"ir", "KP"},
+		HighRiskGeographyThreshold: decimal.NewFromInt(10000),
+		HighRiskGeographyWindow:    30 * 24 * time.Hour,
+	}
+}
+
+type amlAccount struct {
+	start     time.Time
+	history   []model.MonitoredTransaction
+	tenantID  uuid.UUID
+	accountID uuid.UUID
+}
+
+func newAMLAccount() *amlAccount {
+	return &amlAccount{
+		start:     time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+		tenantID:  uuid.New(),
+		accountID: uuid.New(),
+	}
+}
+
+// pay evaluates a transaction on the account at the given offset from the
+// start and adds it to the account's history.
+func (a *amlAccount) pay(m *service.AMLMonitor, at time.Duration, direction string, amount int64, country string) []model.AMLAlert {
+	txn := model.MonitoredTransaction{
+		EventID:       uuid.NewString(),
+		TenantID:      a.tenantID,
+		AccountID:     a.accountID,
+		TransactionID: uuid.New(),
+		Direction:     direction,
+		Amount:        decimal.NewFromInt(amount),
+		Currency:      "USD",
+		Country:       country,
+		OccurredAt:    a.start.Add(at),
+	}
+	alerts := m.Evaluate(txn, a.history)
+	a.history = append(a.history, txn)
+	return alerts
+}
+
+func TestAMLMonitor_Structuring(t *testing.T) {
+	m := service.NewAMLMonitor(amlScenarios())
+	a := newAMLAccount()
+
+	assert.Empty(t, a.pay(m, 0, model.FlowOut, 9500, ""))
+	assert.Empty(t, a.pay(m, time.Hour, model.FlowOut, 12000, ""), "above the threshold")
+	assert.Empty(t, a.pay(m, 2*time.Hour, model.FlowIn, 9900, ""), "other direction")
+	assert.Empty(t, a.pay(m, 3*time.Hour, model.FlowOut, 5000, ""), "well below the threshold")
+	assert.Empty(t, a.pay(m, 4*time.Hour, model.FlowOut, 9000, ""))
+
+	alerts := a.pay(m, 5*time.Hour, model.FlowOut, 9999, "")
+	require.Len(t, alerts, 1)
+	alert := alerts[0]
+	assert.Equal(t, model.AMLScenarioStructuring, alert.Scenario)
+	assert.Equal(t, a.tenantID, alert.TenantID)
+	assert.Equal(t, a.accountID, alert.AccountID)
+	assert.Equal(t, []uuid.UUID{a.history[0].TransactionID, a.history[4].TransactionID, a.history[5].TransactionID}, alert.TransactionIDs)
+	assert.Equal(t, "3 payments sent between 9000.00 and 10000.00 USD within 1 day, totalling 28499.00 USD.", alert.Summary)
+	assert.Equal(t, 70, alert.RiskScore)
+
+	assert.Empty(t, a.pay(m, 30*time.Hour, model.FlowOut, 9800, ""), "the first payments fall out of the window")
+}
+
+func TestAMLMonitor_RapidMovement(t *testing.T) {
+	m := service.NewAMLMonitor(amlScenarios())
+	a := newAMLAccount()
+
+	assert.Empty(t, a.pay(m, 0, model.FlowOut, 30000, ""), "nothing was paid in")
+	assert.Empty(t, a.pay(m, 49*time.Hour, model.FlowIn, 20000, ""))
+	assert.Empty(t, a.pay(m, 50*time.Hour, model.FlowOut, 12000, ""))
+
+	alerts := a.pay(m, 60*time.Hour, model.FlowOut, 7000, "")
+	require.Len(t, alerts, 1)
+	assert.Equal(t, model.AMLScenarioRapidMovement, alerts[0].Scenario)
+	assert.Equal(t, "19000.00 USD paid out of 20000.00 USD paid in within 2 days.", alerts[0].Summary)
+	assert.Len(t, alerts[0].TransactionIDs, 3)
+
+	assert.Empty(t, a.pay(m, 61*time.Hour, model.FlowOut, 500, ""), "only the payment crossing the ratio alerts")
+}
+
+func TestAMLMonitor_HighRiskGeography(t *testing.T) {
+	m := service.NewAMLMonitor(amlScenarios())
+	a := newAMLAccount()
+
+	assert.Empty(t, a.pay(m, 0, model.FlowOut, 6000, "IR"))
+	assert.Empty(t, a.pay(m, 24*time.Hour, model.FlowOut, 50000, "DE"))
+	alerts := a.pay(m, 10*24*time.Hour, model.FlowOut, 4500, "KP")
+	require.Len(t, alerts, 1)
+	assert.Equal(t, model.AMLScenarioHighRiskGeography, alerts[0].Scenario)
+	assert.Equal(t, "10500.00 USD sent to high-risk jurisdictions (IR, KP) within 30 days.", alerts[0].Summary)
+	assert.Equal(t, 80, alerts[0].RiskScore)
+
+	assert.Empty(t, a.pay(m, 11*24*time.Hour, model.FlowOut, 1000, "IR"), "the threshold was already crossed")
+}
+
+func TestAMLMonitor_DisabledScenarios(t *testing.T) {
+	scenarios := amlScenarios()
+	scenarios.StructuringThreshold = decimal.Zero
+	scenarios.HighRiskGeographyThreshold = decimal.Zero
+	m := service.NewAMLMonitor(scenarios)
+	a := newAMLAccount()
+
+	for i := range 4 {
+		assert.Empty(t, a.pay(m, time.Duration(i)*time.Hour, model.FlowOut, 9900, "IR"))
+	}
+	assert.Equal(t, 30*24*time.Hour, m.Lookback())
+}
@@ -10,7 +10,7 @@ import (
 // HybridScorer combines rule-based scoring with ML model predictions.
 // If the ML model fails, it falls back to rules-only scoring.
 type HybridScorer struct {
-	rules    *RiskScorer
+	rules    Scorer
 	ml       port.MLModelClient
 	logger   *slog.Logger
 	mlWeight float64
@@ -18,7 +18,7 @@ type HybridScorer struct {
 
 // NewHybridScorer creates a HybridScorer with the given ML weight (0.0–1.0).
 // A weight of 0.0 means rules-only; 1.0 means ML-only.
-func NewHybridScorer(rules *RiskScorer, ml port.MLModelClient, mlWeight float64, logger *slog.Logger) *HybridScorer {
+func NewHybridScorer(rules Scorer, ml port.MLModelClient, mlWeight float64, logger *slog.Logger) *HybridScorer {
 	return &HybridScorer{
 		rules:    rules,
 		ml:       ml,
@@ -45,14 +45,21 @@ func (h *HybridScorer) Score(input RiskInput) RiskOutput {
 		}
 	}
 
-	mlScore, err := h.ml.Predict(context.Background(), features)
+	prediction, err := h.ml.Predict(context.Background(), features)
 	if err != nil {
 		h.logger.Warn("ML prediction failed, using rules-only scoring", "error", err)
 		return rulesOutput
 	}
+	if prediction.Score < 0 || prediction.Score > 1 {
+		h.logger.Warn("ML prediction out of range, using rules-only scoring",
+			"score", prediction.Score,
+			"model_version", prediction.ModelVersion,
+		)
+		return rulesOutput
+	}
 
 	// Blend scores: combined = (1 - mlWeight) * rules + mlWeight * ml
-	mlScoreInt := int(mlScore * 100)
+	mlScoreInt := int(prediction.Score * 100)
 	combined := int(float64(rulesOutput.Score)*(1-h.mlWeight) + float64(mlScoreInt)*h.mlWeight)
 
 	// Cap at 100.
@@ -65,7 +72,9 @@ func (h *HybridScorer) Score(input RiskInput) RiskOutput {
 	signals = append(signals, "ml_enhanced")
 
 	return RiskOutput{
-		Score:   combined,
-		Signals: signals,
+		Score:        combined,
+		Signals:      signals,
+		RuleHits:     rulesOutput.RuleHits,
+		ModelVersion: prediction.ModelVersion,
 	}
 }
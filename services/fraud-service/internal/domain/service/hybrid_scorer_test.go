@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
 	"github.com/bibbank/bib/services/fraud-service/internal/domain/service"
 )
 
@@ -19,8 +20,8 @@ type mockMLClient struct {
 	score float64
 }
 
-func (m *mockMLClient) Predict(_ context.Context, _ map[string]interface{}) (float64, error) {
-	return m.score, m.err
+func (m *mockMLClient) Predict(_ context.Context, _ map[string]interface{}) (port.MLPrediction, error) {
+	return port.MLPrediction{Score: m.score, ModelVersion: "test-model:v1"}, m.err
 }
 
 func TestHybridScorer_CombinedScoring(t *testing.T) {
@@ -42,6 +43,7 @@ func TestHybridScorer_CombinedScoring(t *testing.T) {
 	// Rules base = 10, ML = 80, weight 0.5 → (10*0.5 + 80*0.5) = 45
 	assert.Equal(t, 45, output.Score)
 	assert.Contains(t, output.Signals, "ml_enhanced")
+	assert.Equal(t, "test-model:v1", output.ModelVersion)
 }
 
 func TestHybridScorer_FallbackOnMLError(t *testing.T) {
@@ -63,6 +65,7 @@ func TestHybridScorer_FallbackOnMLError(t *testing.T) {
 	// Should fall back to rules-only: base score 10
 	assert.Equal(t, 10, output.Score)
 	assert.NotContains(t, output.Signals, "ml_enhanced")
+	assert.Empty(t, output.ModelVersion)
 }
 
 func TestHybridScorer_ZeroWeightEqualsRulesOnly(t *testing.T) {
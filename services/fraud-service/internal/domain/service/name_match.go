@@ -0,0 +1,109 @@
+package service
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// legalSuffixes are organisation suffixes that carry no identifying weight
+// and are dropped before names are compared.
+var legalSuffixes = map[string]bool{
+	"co": true, "corp": true, "corporation": true, "company": true,
+	"inc": true, "llc": true, "ltd": true, "limited": true, "plc": true,
+	"gmbh": true, "ag": true, "sa": true, "srl": true, "bv": true, "the": true,
+}
+
+// NormalizeName folds a name into a canonical form for matching: diacritics
+// and punctuation are removed, case is folded, legal suffixes are dropped and
+// tokens are sorted so word order does not matter ("SMITH, John" and
+// "John Smith" normalise identically).
+func NormalizeName(name string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(name) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// Drop combining marks left over from decomposing accented letters.
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(' ')
+		}
+	}
+
+	tokens := strings.Fields(b.String())
+	kept := tokens[:0]
+	for _, t := range tokens {
+		if !legalSuffixes[t] {
+			kept = append(kept, t)
+		}
+	}
+	sort.Strings(kept)
+	return strings.Join(kept, " ")
+}
+
+// NameSimilarity returns the Jaro-Winkler similarity of two names after
+// normalisation, in [0, 1]. Identical names score 1.
+func NameSimilarity(a, b string) float64 {
+	return jaroWinkler(NormalizeName(a), NormalizeName(b))
+}
+
+// jaroWinkler computes the Jaro-Winkler similarity of two strings. It
+// rewards a shared prefix, which suits transliteration variants of names.
+func jaroWinkler(a, b string) float64 {
+	s1, s2 := []rune(a), []rune(b)
+	if len(s1) == 0 || len(s2) == 0 {
+		if len(s1) == len(s2) {
+			return 1
+		}
+		return 0
+	}
+
+	window := max(len(s1), len(s2))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+
+	matched1 := make([]bool, len(s1))
+	matched2 := make([]bool, len(s2))
+	matches := 0
+	for i := range s1 {
+		lo, hi := max(0, i-window), min(len(s2), i+window+1)
+		for j := lo; j < hi; j++ {
+			if !matched2[j] && s1[i] == s2[j] {
+				matched1[i], matched2[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions := 0
+	j := 0
+	for i := range s1 {
+		if !matched1[i] {
+			continue
+		}
+		for !matched2[j] {
+			j++
+		}
+		if s1[i] != s2[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(s1)) + m/float64(len(s2)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for prefix < min(4, len(s1), len(s2)) && s1[prefix] == s2[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// PolicyResolver picks the decision policy in force for a tenant at a point
+// in time. Like RuleEngine, policies are held in an immutable snapshot that
+// is swapped atomically on Load.
+type PolicyResolver struct {
+	policies atomic.Pointer[map[uuid.UUID][]*model.DecisionPolicy]
+}
+
+// NewPolicyResolver creates a resolver that applies the default thresholds
+// until policies are loaded.
+func NewPolicyResolver() *PolicyResolver {
+	r := &PolicyResolver{}
+	r.Load(nil)
+	return r
+}
+
+// Load replaces the resolver's policies.
+func (r *PolicyResolver) Load(policies []*model.DecisionPolicy) {
+	byTenant := make(map[uuid.UUID][]*model.DecisionPolicy)
+	for _, p := range policies {
+		byTenant[p.TenantID()] = append(byTenant[p.TenantID()], p)
+	}
+	r.policies.Store(&byTenant)
+}
+
+// Resolve returns the thresholds in force for the tenant at the given time,
+// with the policy version they come from (see model.EffectivePolicy).
+// Without a policy in force, the default thresholds apply and the version is 0.
+func (r *PolicyResolver) Resolve(tenantID uuid.UUID, at time.Time) (valueobject.DecisionThresholds, int) {
+	p := model.EffectivePolicy((*r.policies.Load())[tenantID], at)
+	if p == nil {
+		return valueobject.DefaultDecisionThresholds(), 0
+	}
+	return p.Thresholds(), p.Version()
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
 )
 
 // RiskInput contains the data required for risk scoring.
@@ -12,15 +14,22 @@ type RiskInput struct {
 	Currency        string
 	TransactionType string
 	AccountID       uuid.UUID
+	TenantID        uuid.UUID
 }
 
 // RiskOutput contains the result of risk scoring.
 type RiskOutput struct {
-	Signals []string
-	Score   int
+	// ModelVersion is the ML model that contributed to Score, or empty for
+	// rules-only scoring.
+	ModelVersion string
+	Signals      []string
+	RuleHits     []model.RuleHit
+	Score        int
 }
 
-// RiskScorer is a domain service that calculates risk scores using rule-based logic.
+// RiskScorer is a domain service that calculates risk scores using fixed
+// rule-based logic. RuleEngine supersedes it for configurable scoring; its
+// DefaultRules reproduce these heuristics.
 type RiskScorer struct{}
 
 // NewRiskScorer creates a new RiskScorer instance.
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/ruledsl"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// ruleEngineBaseScore is the score every transaction starts from before
+// rule impacts are applied. It matches the legacy RiskScorer baseline.
+const ruleEngineBaseScore = 10
+
+// defaultRuleNamespace seeds deterministic IDs for the built-in rules so
+// their hit metrics are stable across restarts.
+var defaultRuleNamespace = uuid.MustParse("5b0f4c1e-9d0a-4e7e-8f0c-2f3a1d6c7b10")
+
+// ruleSet is an immutable snapshot of the rules the engine evaluates.
+type ruleSet struct {
+	active map[uuid.UUID][]*model.FraudRule
+	shadow map[uuid.UUID][]*model.FraudRule
+}
+
+// RuleEngine scores transactions using operator-managed fraud rules.
+// Rules are held in an immutable snapshot that is swapped atomically on
+// Load, so reloading never blocks or races with in-flight scoring.
+//
+// A tenant with no ACTIVE rules is scored with DefaultRules. SHADOW rules
+// are always evaluated; their hits are reported but never change the score.
+type RuleEngine struct {
+	rules    atomic.Pointer[ruleSet]
+	defaults []*model.FraudRule
+}
+
+// NewRuleEngine creates a RuleEngine that falls back to DefaultRules until
+// tenant rules are loaded.
+func NewRuleEngine() *RuleEngine {
+	e := &RuleEngine{defaults: DefaultRules()}
+	e.rules.Store(&ruleSet{})
+	return e
+}
+
+// Load replaces the engine's rule set. DISABLED rules are ignored.
+func (e *RuleEngine) Load(rules []*model.FraudRule) {
+	set := &ruleSet{
+		active: make(map[uuid.UUID][]*model.FraudRule),
+		shadow: make(map[uuid.UUID][]*model.FraudRule),
+	}
+	for _, r := range rules {
+		switch {
+		case r.Mode().Equal(valueobject.RuleModeActive):
+			set.active[r.TenantID()] = append(set.active[r.TenantID()], r)
+		case r.Mode().Equal(valueobject.RuleModeShadow):
+			set.shadow[r.TenantID()] = append(set.shadow[r.TenantID()], r)
+		}
+	}
+	e.rules.Store(set)
+}
+
+// Score evaluates the tenant's rules against the transaction.
+func (e *RuleEngine) Score(input RiskInput) RiskOutput {
+	set := e.rules.Load()
+
+	active := set.active[input.TenantID]
+	if len(active) == 0 {
+		active = e.defaults
+	}
+
+	facts := factsFrom(input)
+
+	score := ruleEngineBaseScore
+	signals := make([]string, 0)
+	var hits []model.RuleHit
+
+	for _, r := range active {
+		if !r.Matches(facts) {
+			continue
+		}
+		score += r.ScoreImpact()
+		signals = append(signals, r.Signal())
+		hits = append(hits, ruleHit(r, false))
+	}
+
+	for _, r := range set.shadow[input.TenantID] {
+		if r.Matches(facts) {
+			hits = append(hits, ruleHit(r, true))
+		}
+	}
+
+	// Clamp score to [0, 100].
+	if score > 100 {
+		score = 100
+	}
+	if score < 0 {
+		score = 0
+	}
+
+	return RiskOutput{
+		Score:    score,
+		Signals:  signals,
+		RuleHits: hits,
+	}
+}
+
+// EvaluateRule runs a single rule against a transaction outside the engine.
+// It backs the admin dry-run endpoint.
+func EvaluateRule(rule *model.FraudRule, input RiskInput) bool {
+	return rule.Matches(factsFrom(input))
+}
+
+func factsFrom(input RiskInput) ruledsl.Facts {
+	return ruledsl.Facts{
+		Amount:          input.Amount,
+		Currency:        input.Currency,
+		TransactionType: input.TransactionType,
+		Metadata:        input.Metadata,
+	}
+}
+
+func ruleHit(r *model.FraudRule, shadow bool) model.RuleHit {
+	return model.RuleHit{
+		RuleID:      r.ID(),
+		RuleName:    r.Name(),
+		RuleVersion: r.Version(),
+		Signal:      r.Signal(),
+		ScoreImpact: r.ScoreImpact(),
+		Shadow:      shadow,
+	}
+}
+
+// DefaultRules returns the built-in rule set, equivalent to the heuristics
+// of RiskScorer. It applies to tenants that have not configured ACTIVE rules.
+func DefaultRules() []*model.FraudRule {
+	defs := []struct {
+		name      string
+		condition string
+		signal    string
+		impact    int
+	}{
+		{"High-value transaction", "amount > 10000", "high_value", 20},
+		{"Very high-value transaction", "amount > 50000", "very_high_value", 15},
+		{"Cross-border transaction", "source_country != destination_country", "cross_border", 15},
+		{"Wire transfer", "transaction_type = 'wire_transfer'", "wire_transfer", 10},
+		{"Crypto purchase", "transaction_type = 'crypto_purchase'", "crypto_transaction", 20},
+		{"Cash withdrawal", "transaction_type = 'cash_withdrawal'", "cash_withdrawal", 5},
+		{"New account", "metadata.account_age = 'new'", "new_account", 10},
+		{"Unusual currency", "currency IN ('XMR', 'BTC', 'ETH')", "unusual_currency", 10},
+		{"High-risk destination country", "destination_country IN ('KP', 'IR', 'SY', 'CU')", "high_risk_country", 25},
+		{"Rapid successive transactions", "metadata.rapid_transactions = 'true'", "rapid_transactions", 15},
+		{"New device with high-value transaction", "device.new = 'true' AND amount > 5000", "new_device_high_value", 25},
+		{"Anonymizing proxy, VPN or Tor", "ip.anonymized = 'true'", "anonymized_ip", 15},
+		{"Device shared across many accounts", "device.account_count >= 5", "shared_device", 20},
+		{"Device or beneficiary shared with a confirmed-fraud account", "link.fraud_device_accounts >= 1 OR link.fraud_destination_accounts >= 1", "fraud_link", 30},
+		{"IP locked out for failed authentication", "auth.ip_lockouts >= 1", "auth_lockout_ip", 25},
+	}
+
+	rules := make([]*model.FraudRule, 0, len(defs))
+	for _, d := range defs {
+		r, err := model.ReconstructFraudRule(
+			uuid.NewSHA1(defaultRuleNamespace, []byte(d.signal)),
+			uuid.Nil,
+			d.name,
+			model.RuleDefinition{
+				Condition:   d.condition,
+				Signal:      d.signal,
+				ScoreImpact: d.impact,
+				Mode:        valueobject.RuleModeActive,
+			},
+			1, uuid.Nil, time.Time{}, time.Time{},
+		)
+		if err != nil {
+			panic(fmt.Sprintf("invalid default fraud rule %q: %v", d.name, err))
+		}
+		rules = append(rules, r)
+	}
+	return rules
+}
@@ -0,0 +1,133 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/service"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+func newRule(t *testing.T, tenantID uuid.UUID, name, condition, signal string, impact int, mode valueobject.RuleMode) *model.FraudRule {
+	t.Helper()
+	r, err := model.NewFraudRule(tenantID, name, model.RuleDefinition{
+		Condition:   condition,
+		Signal:      signal,
+		ScoreImpact: impact,
+		Mode:        mode,
+	}, uuid.New())
+	require.NoError(t, err)
+	return r
+}
+
+func TestRuleEngine_DefaultRulesMatchRiskScorer(t *testing.T) {
+	inputs := []service.RiskInput{
+		{Amount: decimal.NewFromInt(100), Currency: "USD", TransactionType: "transfer"},
+		{Amount: decimal.NewFromInt(15000), Currency: "USD", TransactionType: "transfer"},
+		{Amount: decimal.NewFromInt(75000), Currency: "USD", TransactionType: "wire_transfer"},
+		{Amount: decimal.NewFromInt(500), Currency: "BTC", TransactionType: "crypto_purchase"},
+		{Amount: decimal.NewFromInt(500), Currency: "USD", TransactionType: "cash_withdrawal"},
+		{
+			Amount: decimal.NewFromInt(500), Currency: "USD", TransactionType: "transfer",
+			Metadata: map[string]string{"source_country": "US", "destination_country": "KP"},
+		},
+		{
+			Amount: decimal.NewFromInt(500), Currency: "USD", TransactionType: "transfer",
+			Metadata: map[string]string{"source_country": "US", "destination_country": "US"},
+		},
+		{
+			Amount: decimal.NewFromInt(75000), Currency: "XMR", TransactionType: "crypto_purchase",
+			Metadata: map[string]string{
+				"account_age": "new", "source_country": "US",
+				"destination_country": "IR", "rapid_transactions": "true",
+			},
+		},
+	}
+
+	legacy := service.NewRiskScorer()
+	engine := service.NewRuleEngine()
+
+	for _, in := range inputs {
+		in.AccountID = uuid.New()
+		in.TenantID = uuid.New()
+		want := legacy.Score(in)
+		got := engine.Score(in)
+		assert.Equal(t, want.Score, got.Score, "input %+v", in)
+		assert.Equal(t, want.Signals, got.Signals, "input %+v", in)
+	}
+}
+
+func TestRuleEngine_TenantRulesReplaceDefaults(t *testing.T) {
+	tenantID := uuid.New()
+	engine := service.NewRuleEngine()
+	engine.Load([]*model.FraudRule{
+		newRule(t, tenantID, "Large EUR", "amount > 1000 AND currency = 'EUR'", "large_eur", 40, valueobject.RuleModeActive),
+	})
+
+	out := engine.Score(service.RiskInput{
+		TenantID: tenantID, Amount: decimal.NewFromInt(20000), Currency: "EUR", TransactionType: "transfer",
+	})
+	assert.Equal(t, 50, out.Score)
+	assert.Equal(t, []string{"large_eur"}, out.Signals)
+	require.Len(t, out.RuleHits, 1)
+	assert.False(t, out.RuleHits[0].Shadow)
+
+	// Another tenant still gets the defaults.
+	other := engine.Score(service.RiskInput{
+		TenantID: uuid.New(), Amount: decimal.NewFromInt(20000), Currency: "EUR", TransactionType: "transfer",
+	})
+	assert.Equal(t, []string{"high_value"}, other.Signals)
+}
+
+func TestRuleEngine_ShadowRulesDoNotAffectScore(t *testing.T) {
+	tenantID := uuid.New()
+	shadow := newRule(t, tenantID, "Trial", "amount > 100", "trial_signal", 50, valueobject.RuleModeShadow)
+	engine := service.NewRuleEngine()
+	engine.Load([]*model.FraudRule{shadow})
+
+	out := engine.Score(service.RiskInput{
+		TenantID: tenantID, Amount: decimal.NewFromInt(500), Currency: "USD", TransactionType: "transfer",
+	})
+
+	// Defaults still apply since the tenant has no ACTIVE rules.
+	assert.Equal(t, 10, out.Score)
+	assert.NotContains(t, out.Signals, "trial_signal")
+	require.Len(t, out.RuleHits, 1)
+	assert.Equal(t, shadow.ID(), out.RuleHits[0].RuleID)
+	assert.True(t, out.RuleHits[0].Shadow)
+}
+
+func TestRuleEngine_DisabledRulesIgnoredAndScoreClamped(t *testing.T) {
+	tenantID := uuid.New()
+	engine := service.NewRuleEngine()
+	engine.Load([]*model.FraudRule{
+		newRule(t, tenantID, "Allowlist", "metadata.trusted = 'true'", "trusted", -50, valueobject.RuleModeActive),
+		newRule(t, tenantID, "Off", "amount > 0", "off", 90, valueobject.RuleModeDisabled),
+	})
+
+	out := engine.Score(service.RiskInput{
+		TenantID: tenantID, Amount: decimal.NewFromInt(500), Currency: "USD", TransactionType: "transfer",
+		Metadata: map[string]string{"trusted": "true"},
+	})
+	assert.Equal(t, 0, out.Score)
+	assert.Equal(t, []string{"trusted"}, out.Signals)
+}
+
+func TestRuleEngine_LoadReplacesRules(t *testing.T) {
+	tenantID := uuid.New()
+	engine := service.NewRuleEngine()
+	input := service.RiskInput{TenantID: tenantID, Amount: decimal.NewFromInt(500), Currency: "USD", TransactionType: "transfer"}
+
+	engine.Load([]*model.FraudRule{
+		newRule(t, tenantID, "Everything", "amount > 0", "everything", 30, valueobject.RuleModeActive),
+	})
+	assert.Equal(t, 40, engine.Score(input).Score)
+
+	engine.Load(nil)
+	assert.Equal(t, 10, engine.Score(input).Score)
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+// DefaultScreeningThreshold is the name similarity at or above which a
+// counterparty is treated as a potential sanctions match.
+const DefaultScreeningThreshold = 0.92
+
+// watchlistSnapshot is an immutable, pre-normalised copy of the loaded lists.
+type watchlistSnapshot struct {
+	version       string
+	parties       []normalizedEntry
+	jurisdictions map[string]model.WatchlistEntry
+}
+
+type normalizedEntry struct {
+	entry model.WatchlistEntry
+	names []normalizedName
+}
+
+type normalizedName struct {
+	display    string
+	normalized string
+}
+
+// ScreeningResult is the outcome of screening a set of subjects.
+type ScreeningResult struct {
+	ListVersion string
+	Matches     []model.ScreeningMatch
+	Threshold   float64
+}
+
+// SanctionsScreener matches transaction counterparties and destinations
+// against sanctions lists. Party names are fuzzy-matched so transliteration
+// and word-order variants are caught; jurisdictions match on exact ISO code.
+//
+// Like RuleEngine, lists are held in an immutable snapshot that is swapped
+// atomically on Load.
+type SanctionsScreener struct {
+	lists     atomic.Pointer[watchlistSnapshot]
+	threshold float64
+}
+
+// NewSanctionsScreener creates a screener with no lists loaded. A threshold
+// outside (0, 1] selects DefaultScreeningThreshold.
+func NewSanctionsScreener(threshold float64) *SanctionsScreener {
+	if threshold <= 0 || threshold > 1 {
+		threshold = DefaultScreeningThreshold
+	}
+	s := &SanctionsScreener{threshold: threshold}
+	s.lists.Store(&watchlistSnapshot{jurisdictions: make(map[string]model.WatchlistEntry)})
+	return s
+}
+
+// Load replaces the screener's lists. version identifies the list contents
+// and is recorded on every screening for auditability.
+func (s *SanctionsScreener) Load(entries []model.WatchlistEntry, version string) {
+	snap := &watchlistSnapshot{
+		version:       version,
+		jurisdictions: make(map[string]model.WatchlistEntry),
+	}
+	for _, e := range entries {
+		switch e.Type {
+		case model.EntryTypeJurisdiction:
+			snap.jurisdictions[strings.ToUpper(strings.TrimSpace(e.Name))] = e
+		default:
+			ne := normalizedEntry{entry: e}
+			for _, n := range append([]string{e.Name}, e.Aliases...) {
+				if norm := NormalizeName(n); norm != "" {
+					ne.names = append(ne.names, normalizedName{display: n, normalized: norm})
+				}
+			}
+			if len(ne.names) > 0 {
+				snap.parties = append(snap.parties, ne)
+			}
+		}
+	}
+	s.lists.Store(snap)
+}
+
+// Size returns the number of loaded entries.
+func (s *SanctionsScreener) Size() int {
+	snap := s.lists.Load()
+	return len(snap.parties) + len(snap.jurisdictions)
+}
+
+// Screen checks each subject against the loaded lists. For each subject, the
+// best-scoring name of every matching entry is reported, highest score first.
+func (s *SanctionsScreener) Screen(subjects []model.ScreeningSubject) ScreeningResult {
+	snap := s.lists.Load()
+	result := ScreeningResult{
+		ListVersion: snap.version,
+		Threshold:   s.threshold,
+		Matches:     make([]model.ScreeningMatch, 0),
+	}
+
+	for _, subj := range subjects {
+		switch subj.Role {
+		case model.SubjectDestinationCountry:
+			if e, ok := snap.jurisdictions[strings.ToUpper(strings.TrimSpace(subj.Value))]; ok {
+				result.Matches = append(result.Matches, model.ScreeningMatch{
+					Subject:     subj,
+					List:        e.List.String(),
+					EntryID:     e.EntryID,
+					MatchedName: e.Name,
+					Score:       1,
+				})
+			}
+		default:
+			result.Matches = append(result.Matches, s.screenName(snap, subj)...)
+		}
+	}
+	return result
+}
+
+func (s *SanctionsScreener) screenName(snap *watchlistSnapshot, subj model.ScreeningSubject) []model.ScreeningMatch {
+	norm := NormalizeName(subj.Value)
+	if norm == "" {
+		return nil
+	}
+
+	var matches []model.ScreeningMatch
+	for _, ne := range snap.parties {
+		best := model.ScreeningMatch{}
+		for _, n := range ne.names {
+			if score := jaroWinkler(norm, n.normalized); score > best.Score {
+				best = model.ScreeningMatch{
+					Subject:     subj,
+					List:        ne.entry.List.String(),
+					EntryID:     ne.entry.EntryID,
+					MatchedName: n.display,
+					Score:       score,
+				}
+			}
+		}
+		if best.Score >= s.threshold {
+			matches = append(matches, best)
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	return matches
+}
+
+// ScreenFlaggedParties matches counterparty subjects against the tenant's
+// flagged parties: customers identity screening found on a sanctions list.
+// Names are matched at the screener's threshold; each match names the
+// flagged verification as its entry.
+func (s *SanctionsScreener) ScreenFlaggedParties(subjects []model.ScreeningSubject, parties []model.FlaggedParty) []model.ScreeningMatch {
+	var matches []model.ScreeningMatch
+	for _, subj := range subjects {
+		if subj.Role != model.SubjectCounterparty {
+			continue
+		}
+		norm := NormalizeName(subj.Value)
+		if norm == "" {
+			continue
+		}
+		for _, p := range parties {
+			if score := jaroWinkler(norm, NormalizeName(p.Name)); score >= s.threshold {
+				matches = append(matches, model.ScreeningMatch{
+					Subject:     subj,
+					List:        model.FlaggedPartyList,
+					EntryID:     p.VerificationID.String(),
+					MatchedName: p.Name,
+					Score:       score,
+				})
+			}
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	return matches
+}
@@ -0,0 +1,107 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/service"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+func TestNameSimilarity(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  string
+		match bool
+	}{
+		{"identical", "Ivan Petrov", "Ivan Petrov", true},
+		{"word order and punctuation", "PETROV, Ivan", "Ivan Petrov", true},
+		{"diacritics", "José Muñoz", "Jose Munoz", true},
+		{"transliteration variant", "Mohammed Al-Rashid", "Muhammed Al Rashid", true},
+		{"legal suffix", "Acme Holdings Ltd.", "ACME HOLDINGS", true},
+		{"unrelated names", "Jane Doe", "Ivan Petrov", false},
+		{"shared surname only", "Maria Petrov", "Ivan Petrov", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := service.NameSimilarity(tt.a, tt.b)
+			assert.Equal(t, tt.match, score >= service.DefaultScreeningThreshold, "score %.3f", score)
+		})
+	}
+}
+
+func TestSanctionsScreener_Screen(t *testing.T) {
+	screener := service.NewSanctionsScreener(0)
+	screener.Load([]model.WatchlistEntry{
+		{List: valueobject.WatchlistOFAC, EntryID: "SDN-1", Type: model.EntryTypeParty, Name: "Ivan Petrov", Aliases: []string{"Ivan Petroff"}},
+		{List: valueobject.WatchlistEU, EntryID: "EU-7", Type: model.EntryTypeParty, Name: "Globex Trading LLC"},
+		{List: valueobject.WatchlistOFAC, EntryID: "IR", Type: model.EntryTypeJurisdiction, Name: "IR"},
+	}, "v42")
+	require.Equal(t, 3, screener.Size())
+
+	t.Run("matches names against primary names and aliases", func(t *testing.T) {
+		result := screener.Screen([]model.ScreeningSubject{
+			{Role: model.SubjectCounterparty, Value: "IVAN PETROFF"},
+		})
+
+		assert.Equal(t, "v42", result.ListVersion)
+		assert.Equal(t, service.DefaultScreeningThreshold, result.Threshold)
+		require.Len(t, result.Matches, 1)
+		assert.Equal(t, "SDN-1", result.Matches[0].EntryID)
+		assert.Equal(t, "OFAC", result.Matches[0].List)
+		assert.Equal(t, "Ivan Petroff", result.Matches[0].MatchedName)
+		assert.InDelta(t, 1.0, result.Matches[0].Score, 1e-9)
+	})
+
+	t.Run("matches destination countries exactly", func(t *testing.T) {
+		result := screener.Screen([]model.ScreeningSubject{
+			{Role: model.SubjectDestinationCountry, Value: "ir"},
+			{Role: model.SubjectDestinationCountry, Value: "IE"},
+		})
+
+		require.Len(t, result.Matches, 1)
+		assert.Equal(t, "IR", result.Matches[0].EntryID)
+	})
+
+	t.Run("returns no matches for clean subjects", func(t *testing.T) {
+		result := screener.Screen([]model.ScreeningSubject{
+			{Role: model.SubjectCounterparty, Value: "Jane Doe"},
+			{Role: model.SubjectDestinationCountry, Value: "GB"},
+		})
+
+		assert.Empty(t, result.Matches)
+	})
+
+	t.Run("reload swaps lists and version", func(t *testing.T) {
+		s := service.NewSanctionsScreener(0.95)
+		s.Load([]model.WatchlistEntry{{List: valueobject.WatchlistUN, EntryID: "1", Name: "Old Name"}}, "a")
+		s.Load([]model.WatchlistEntry{{List: valueobject.WatchlistUN, EntryID: "2", Name: "New Name"}}, "b")
+
+		result := s.Screen([]model.ScreeningSubject{{Role: model.SubjectCounterparty, Value: "Old Name"}})
+
+		assert.Empty(t, result.Matches)
+		assert.Equal(t, "b", result.ListVersion)
+		assert.Equal(t, 0.95, result.Threshold)
+	})
+}
+
+func TestSanctionsScreener_ScreenFlaggedParties(t *testing.T) {
+	screener := service.NewSanctionsScreener(0)
+	flagged := model.FlaggedParty{TenantID: uuid.New(), VerificationID: uuid.New(), Name: "Ivan Petrov"}
+
+	matches := screener.ScreenFlaggedParties([]model.ScreeningSubject{
+		{Role: model.SubjectCounterparty, Value: "PETROV, Ivan"},
+		{Role: model.SubjectDestinationCountry, Value: "RU"},
+	}, []model.FlaggedParty{flagged})
+	require.Len(t, matches, 1)
+	assert.Equal(t, model.FlaggedPartyList, matches[0].List)
+	assert.Equal(t, flagged.VerificationID.String(), matches[0].EntryID)
+
+	assert.Empty(t, screener.ScreenFlaggedParties([]model.ScreeningSubject{
+		{Role: model.SubjectCounterparty, Value: "Jane Doe"},
+	}, []model.FlaggedParty{flagged}))
+}
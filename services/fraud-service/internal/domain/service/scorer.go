@@ -1,7 +1,8 @@
 package service
 
 // Scorer defines the interface for risk scoring strategies.
-// Both RiskScorer (rule-based) and HybridScorer (rules + ML) implement this.
+// RiskScorer (fixed rules), RuleEngine (configurable rules) and HybridScorer
+// (rules + ML) implement this.
 type Scorer interface {
 	Score(input RiskInput) RiskOutput
 }
@@ -0,0 +1,90 @@
+package valueobject
+
+import "fmt"
+
+// CaseStatus is an immutable value object representing where a fraud case
+// is in the manual review workflow.
+type CaseStatus struct {
+	value string
+}
+
+var (
+	CaseStatusOpen      = CaseStatus{value: "OPEN"}
+	CaseStatusInReview  = CaseStatus{value: "IN_REVIEW"}
+	CaseStatusEscalated = CaseStatus{value: "ESCALATED"}
+	CaseStatusResolved  = CaseStatus{value: "RESOLVED"}
+)
+
+// CaseStatusFromString reconstructs a CaseStatus from its string representation.
+func CaseStatusFromString(s string) (CaseStatus, error) {
+	switch s {
+	case "OPEN":
+		return CaseStatusOpen, nil
+	case "IN_REVIEW":
+		return CaseStatusInReview, nil
+	case "ESCALATED":
+		return CaseStatusEscalated, nil
+	case "RESOLVED":
+		return CaseStatusResolved, nil
+	default:
+		return CaseStatus{}, fmt.Errorf("invalid case status: %s", s)
+	}
+}
+
+// String returns the string representation.
+func (s CaseStatus) String() string {
+	return s.value
+}
+
+// IsZero returns true if the status has not been set.
+func (s CaseStatus) IsZero() bool {
+	return s.value == ""
+}
+
+// Equal checks equality with another CaseStatus.
+func (s CaseStatus) Equal(other CaseStatus) bool {
+	return s.value == other.value
+}
+
+// IsTerminal returns true if no further workflow transitions are allowed.
+func (s CaseStatus) IsTerminal() bool {
+	return s.value == "RESOLVED"
+}
+
+// CaseDisposition is an immutable value object recording the analyst's final
+// verdict on a fraud case.
+type CaseDisposition struct {
+	value string
+}
+
+var (
+	DispositionConfirmedFraud = CaseDisposition{value: "CONFIRMED_FRAUD"}
+	DispositionFalsePositive  = CaseDisposition{value: "FALSE_POSITIVE"}
+)
+
+// CaseDispositionFromString reconstructs a CaseDisposition from its string representation.
+func CaseDispositionFromString(s string) (CaseDisposition, error) {
+	switch s {
+	case "CONFIRMED_FRAUD":
+		return DispositionConfirmedFraud, nil
+	case "FALSE_POSITIVE":
+		return DispositionFalsePositive, nil
+	default:
+		return CaseDisposition{}, fmt.Errorf("invalid case disposition: %s", s)
+	}
+}
+
+// String returns the string representation.
+func (d CaseDisposition) String() string {
+	return d.value
+}
+
+// IsZero returns true if no disposition has been recorded.
+func (d CaseDisposition) IsZero() bool {
+	return d.value == ""
+}
+
+// Equal checks equality with another CaseDisposition.
+func (d CaseDisposition) Equal(other CaseDisposition) bool {
+	return d.value == other.value
+}
@@ -0,0 +1,71 @@
+package valueobject
+
+import "fmt"
+
+// DecisionThresholds is an immutable value object holding the score cutoffs
+// that map a risk score (0-100) to a risk level and a decision. Each field is
+// the lowest score that reaches that level or decision.
+type DecisionThresholds struct {
+	MediumAt   int
+	HighAt     int
+	CriticalAt int
+	ReviewAt   int
+	DeclineAt  int
+}
+
+// DefaultDecisionThresholds returns the global cutoffs applied to tenants
+// without a decision policy. They match RiskLevelFromScore and DecisionFromScore.
+func DefaultDecisionThresholds() DecisionThresholds {
+	return DecisionThresholds{
+		MediumAt:   35,
+		HighAt:     60,
+		CriticalAt: 80,
+		ReviewAt:   30,
+		DeclineAt:  71,
+	}
+}
+
+// Validate checks that the cutoffs are within 1-100 and ordered.
+func (t DecisionThresholds) Validate() error {
+	for name, v := range map[string]int{
+		"medium_at": t.MediumAt, "high_at": t.HighAt, "critical_at": t.CriticalAt,
+		"review_at": t.ReviewAt, "decline_at": t.DeclineAt,
+	} {
+		if v < 1 || v > 100 {
+			return fmt.Errorf("%s must be between 1 and 100, got %d", name, v)
+		}
+	}
+	if t.MediumAt >= t.HighAt || t.HighAt >= t.CriticalAt {
+		return fmt.Errorf("risk level cutoffs must increase: medium_at < high_at < critical_at")
+	}
+	if t.ReviewAt >= t.DeclineAt {
+		return fmt.Errorf("review_at must be below decline_at")
+	}
+	return nil
+}
+
+// RiskLevel derives the risk level for a score.
+func (t DecisionThresholds) RiskLevel(score int) RiskLevel {
+	switch {
+	case score >= t.CriticalAt:
+		return RiskLevelCritical
+	case score >= t.HighAt:
+		return RiskLevelHigh
+	case score >= t.MediumAt:
+		return RiskLevelMedium
+	default:
+		return RiskLevelLow
+	}
+}
+
+// Decision derives the decision for a score.
+func (t DecisionThresholds) Decision(score int) AssessmentDecision {
+	switch {
+	case score >= t.DeclineAt:
+		return DecisionDecline
+	case score >= t.ReviewAt:
+		return DecisionReview
+	default:
+		return DecisionApprove
+	}
+}
@@ -0,0 +1,106 @@
+package valueobject
+
+import "fmt"
+
+// FraudLabel is an immutable value object representing the ground-truth
+// outcome of an assessed transaction, used to train the ML model.
+type FraudLabel struct {
+	value string
+}
+
+var (
+	LabelFraud      = FraudLabel{value: "FRAUD"}
+	LabelLegitimate = FraudLabel{value: "LEGITIMATE"}
+)
+
+// FraudLabelFromString reconstructs a FraudLabel from its string representation.
+func FraudLabelFromString(s string) (FraudLabel, error) {
+	switch s {
+	case "FRAUD":
+		return LabelFraud, nil
+	case "LEGITIMATE":
+		return LabelLegitimate, nil
+	default:
+		return FraudLabel{}, fmt.Errorf("invalid fraud label: %s", s)
+	}
+}
+
+// FraudLabelFromDisposition maps a case disposition to its training label.
+func FraudLabelFromDisposition(d CaseDisposition) (FraudLabel, error) {
+	switch {
+	case d.Equal(DispositionConfirmedFraud):
+		return LabelFraud, nil
+	case d.Equal(DispositionFalsePositive):
+		return LabelLegitimate, nil
+	default:
+		return FraudLabel{}, fmt.Errorf("disposition %q has no label", d.String())
+	}
+}
+
+// String returns the string representation.
+func (l FraudLabel) String() string {
+	return l.value
+}
+
+// IsZero returns true if the label has not been set.
+func (l FraudLabel) IsZero() bool {
+	return l.value == ""
+}
+
+// Equal returns true if both labels are the same.
+func (l FraudLabel) Equal(other FraudLabel) bool {
+	return l.value == other.value
+}
+
+// LabelSource is an immutable value object identifying where a label came from.
+type LabelSource struct {
+	value string
+}
+
+var (
+	// LabelSourceCaseReview labels come from an analyst resolving a review case.
+	LabelSourceCaseReview = LabelSource{value: "CASE_REVIEW"}
+	// LabelSourceChargeback labels come from a fraud chargeback filed by the
+	// card network, which is treated as ground truth.
+	LabelSourceChargeback = LabelSource{value: "CHARGEBACK"}
+)
+
+// LabelSourceFromString reconstructs a LabelSource from its string representation.
+func LabelSourceFromString(s string) (LabelSource, error) {
+	switch s {
+	case "CASE_REVIEW":
+		return LabelSourceCaseReview, nil
+	case "CHARGEBACK":
+		return LabelSourceChargeback, nil
+	default:
+		return LabelSource{}, fmt.Errorf("invalid label source: %s", s)
+	}
+}
+
+// String returns the string representation.
+func (s LabelSource) String() string {
+	return s.value
+}
+
+// IsZero returns true if the source has not been set.
+func (s LabelSource) IsZero() bool {
+	return s.value == ""
+}
+
+// Equal returns true if both sources are the same.
+func (s LabelSource) Equal(other LabelSource) bool {
+	return s.value == other.value
+}
+
+// Precedence ranks how authoritative a source is. A label is only replaced
+// by one from a source of equal or higher precedence.
+func (s LabelSource) Precedence() int {
+	switch s.value {
+	case "CHARGEBACK":
+		return 2
+	case "CASE_REVIEW":
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,53 @@
+package valueobject
+
+import "fmt"
+
+// RuleMode is an immutable value object describing how a fraud rule takes
+// part in scoring.
+type RuleMode struct {
+	value string
+}
+
+var (
+	// RuleModeActive rules contribute to the risk score.
+	RuleModeActive = RuleMode{value: "ACTIVE"}
+	// RuleModeShadow rules are evaluated and their hits recorded, but they do
+	// not affect the score. Used to dry-run a rule against live traffic.
+	RuleModeShadow = RuleMode{value: "SHADOW"}
+	// RuleModeDisabled rules are not evaluated.
+	RuleModeDisabled = RuleMode{value: "DISABLED"}
+)
+
+// RuleModeFromString reconstructs a RuleMode from its string representation.
+func RuleModeFromString(s string) (RuleMode, error) {
+	switch s {
+	case "ACTIVE":
+		return RuleModeActive, nil
+	case "SHADOW":
+		return RuleModeShadow, nil
+	case "DISABLED":
+		return RuleModeDisabled, nil
+	default:
+		return RuleMode{}, fmt.Errorf("invalid rule mode: %s", s)
+	}
+}
+
+// String returns the string representation.
+func (m RuleMode) String() string {
+	return m.value
+}
+
+// IsZero returns true if the mode has not been set.
+func (m RuleMode) IsZero() bool {
+	return m.value == ""
+}
+
+// Equal checks equality with another RuleMode.
+func (m RuleMode) Equal(other RuleMode) bool {
+	return m.value == other.value
+}
+
+// IsEvaluated returns true if rules in this mode run during scoring.
+func (m RuleMode) IsEvaluated() bool {
+	return m.value == "ACTIVE" || m.value == "SHADOW"
+}
@@ -0,0 +1,47 @@
+package valueobject
+
+import "fmt"
+
+// Watchlist is an immutable value object identifying a sanctions list that
+// transactions are screened against.
+type Watchlist struct {
+	value string
+}
+
+var (
+	// WatchlistOFAC is the US Treasury OFAC Specially Designated Nationals list.
+	WatchlistOFAC = Watchlist{value: "OFAC"}
+	// WatchlistUN is the UN Security Council Consolidated List.
+	WatchlistUN = Watchlist{value: "UN"}
+	// WatchlistEU is the EU Consolidated Financial Sanctions List.
+	WatchlistEU = Watchlist{value: "EU"}
+)
+
+// WatchlistFromString reconstructs a Watchlist from its string representation.
+func WatchlistFromString(s string) (Watchlist, error) {
+	switch s {
+	case "OFAC":
+		return WatchlistOFAC, nil
+	case "UN":
+		return WatchlistUN, nil
+	case "EU":
+		return WatchlistEU, nil
+	default:
+		return Watchlist{}, fmt.Errorf("invalid watchlist: %s", s)
+	}
+}
+
+// String returns the string representation.
+func (w Watchlist) String() string {
+	return w.value
+}
+
+// IsZero returns true if the watchlist has not been set.
+func (w Watchlist) IsZero() bool {
+	return w.value == ""
+}
+
+// Equal checks equality with another Watchlist.
+func (w Watchlist) Equal(other Watchlist) bool {
+	return w.value == other.value
+}
@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 type DatabaseConfig struct {
@@ -16,17 +20,68 @@ type DatabaseConfig struct {
 }
 
 type KafkaConfig struct {
-	Brokers []string
+	ConsumerGroup string
+	Brokers       []string
+}
+
+// MLConfig configures the external model server used for hybrid scoring.
+type MLConfig struct {
+	Endpoint          string
+	ModelVersion      string
+	CandidateEndpoint string
+	CandidateVersion  string
+	Timeout           time.Duration
+	Weight            float64
+	CandidatePercent  int
+	Enabled           bool
+}
+
+// ScreeningConfig configures sanctions and watchlist screening.
+type ScreeningConfig struct {
+	WatchlistDir   string
+	ReloadInterval time.Duration
+	Threshold      float64
+}
+
+// IPIntelConfig configures the IP intelligence provider used for enrichment.
+type IPIntelConfig struct {
+	Endpoint string
+	APIKey   string
+	Timeout  time.Duration
+	CacheTTL time.Duration
+}
+
+// AMLConfig configures the AML transaction monitoring scenarios. Amounts
+// apply to each currency separately; a zero threshold disables a scenario.
+type AMLConfig struct {
+	StructuringThreshold       decimal.Decimal
+	StructuringMargin          decimal.Decimal
+	RapidMovementMinAmount     decimal.Decimal
+	RapidMovementRatio         decimal.Decimal
+	HighRiskGeographyThreshold decimal.Decimal
+	HighRiskCountries          []string
+	StructuringWindow          time.Duration
+	RapidMovementWindow        time.Duration
+	HighRiskGeographyWindow    time.Duration
+	StructuringMinCount        int
 }
 
 type Config struct {
-	ServiceName string
-	Environment string
-	LogLevel    string
-	DB          DatabaseConfig
-	Kafka       KafkaConfig
-	GRPCPort    int
-	HTTPPort    int
+	ServiceName        string
+	Environment        string
+	LogLevel           string
+	DB                 DatabaseConfig
+	Kafka              KafkaConfig
+	ML                 MLConfig
+	Screening          ScreeningConfig
+	IPIntel            IPIntelConfig
+	AML                AMLConfig
+	Outbox             OutboxConfig
+	GRPCPort           int
+	HTTPPort           int
+	DatasetDir         string
+	RuleReloadInterval time.Duration
+	CaseSLACheck       time.Duration
 }
 
 func (c Config) Validate() {
@@ -35,6 +90,12 @@ func (c Config) Validate() {
 	}
 }
 
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
 func Load() Config {
 	return Config{
 		GRPCPort: getEnvInt("GRPC_PORT", 9088),
@@ -48,11 +109,66 @@ func Load() Config {
 			SSLMode:  getEnv("DB_SSLMODE", "require"),
 		},
 		Kafka: KafkaConfig{
-			Brokers: []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+			Brokers:       []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+			ConsumerGroup: getEnv("KAFKA_CONSUMER_GROUP", "fraud-service"),
 		},
 		ServiceName: "fraud-service",
 		Environment: getEnv("ENVIRONMENT", "development"),
 		LogLevel:    getEnv("LOG_LEVEL", "info"),
+		// How often fraud rules are reloaded from the database so changes
+		// made through other replicas take effect.
+		RuleReloadInterval: getEnvDuration("FRAUD_RULE_RELOAD_INTERVAL", 30*time.Second),
+		// How often review cases past their SLA deadline are looked for.
+		CaseSLACheck: getEnvDuration("FRAUD_CASE_SLA_CHECK_INTERVAL", time.Minute),
+		// Root of the object storage mount that training datasets are exported to.
+		DatasetDir: getEnv("FRAUD_DATASET_DIR", "./data/datasets"),
+		ML: MLConfig{
+			Enabled:      getEnv("FRAUD_ML_ENABLED", "false") == "true",
+			Endpoint:     getEnv("FRAUD_ML_ENDPOINT", ""),
+			ModelVersion: getEnv("FRAUD_ML_MODEL_VERSION", "unversioned"),
+			// Predictions slower than this fall back to rules-only scoring.
+			Timeout: getEnvDuration("FRAUD_ML_TIMEOUT", 150*time.Millisecond),
+			Weight:  getEnvFloat("FRAUD_ML_WEIGHT", 0.3),
+			// Optional challenger model; receives CandidatePercent of accounts.
+			CandidateEndpoint: getEnv("FRAUD_ML_CANDIDATE_ENDPOINT", ""),
+			CandidateVersion:  getEnv("FRAUD_ML_CANDIDATE_VERSION", ""),
+			CandidatePercent:  getEnvInt("FRAUD_ML_CANDIDATE_PERCENT", 0),
+		},
+		Screening: ScreeningConfig{
+			// Directory holding the normalised ofac.json, un.json and eu.json lists.
+			WatchlistDir:   getEnv("FRAUD_WATCHLIST_DIR", "./data/watchlists"),
+			ReloadInterval: getEnvDuration("FRAUD_WATCHLIST_RELOAD_INTERVAL", time.Hour),
+			// Minimum name similarity (0-1) treated as a potential match.
+			Threshold: getEnvFloat("FRAUD_SCREENING_THRESHOLD", 0.92),
+		},
+		IPIntel: IPIntelConfig{
+			// Leave unset to score without IP intelligence.
+			Endpoint: getEnv("FRAUD_IPINTEL_ENDPOINT", ""),
+			APIKey:   getEnv("FRAUD_IPINTEL_API_KEY", ""),
+			Timeout:  getEnvDuration("FRAUD_IPINTEL_TIMEOUT", 100*time.Millisecond),
+			CacheTTL: getEnvDuration("FRAUD_IPINTEL_CACHE_TTL", time.Hour),
+		},
+		AML: AMLConfig{
+			// Payments just below the reporting threshold, by at most the
+			// margin (a fraction of it), count towards structuring.
+			StructuringThreshold: getEnvDecimal("FRAUD_AML_STRUCTURING_THRESHOLD", decimal.NewFromInt(10000)),
+			StructuringMargin:    getEnvDecimal("FRAUD_AML_STRUCTURING_MARGIN", decimal.NewFromFloat(0.1)),
+			StructuringMinCount:  getEnvInt("FRAUD_AML_STRUCTURING_MIN_COUNT", 3),
+			StructuringWindow:    getEnvDuration("FRAUD_AML_STRUCTURING_WINDOW", 24*time.Hour),
+			// Share of the funds paid in that, paid out within the window,
+			// is rapid movement.
+			RapidMovementMinAmount: getEnvDecimal("FRAUD_AML_RAPID_MOVEMENT_MIN_AMOUNT", decimal.NewFromInt(10000)),
+			RapidMovementRatio:     getEnvDecimal("FRAUD_AML_RAPID_MOVEMENT_RATIO", decimal.NewFromFloat(0.9)),
+			RapidMovementWindow:    getEnvDuration("FRAUD_AML_RAPID_MOVEMENT_WINDOW", 48*time.Hour),
+			// Comma-separated ISO 3166 alpha-2 codes.
+			HighRiskCountries:          getEnvList("FRAUD_AML_HIGH_RISK_COUNTRIES", []string{"IR", "KP", "MM"}),
+			HighRiskGeographyThreshold: getEnvDecimal("FRAUD_AML_HIGH_RISK_GEOGRAPHY_THRESHOLD", decimal.NewFromInt(10000)),
+			HighRiskGeographyWindow:    getEnvDuration("FRAUD_AML_HIGH_RISK_GEOGRAPHY_WINDOW", 30*24*time.Hour),
+		},
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
 	}
 }
 
@@ -79,3 +195,72 @@ func getEnvInt(key string, fallback int) int {
 	}
 	return fallback
 }
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return fallback
+}
+
+func getEnvFloat(key string, fallback float64) float64 {
+	if v := os.Getenv(key); v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+	}
+	return fallback
+}
+
+func getEnvDecimal(key string, fallback decimal.Decimal) decimal.Decimal {
+	if v := os.Getenv(key); v != "" {
+		if d, err := decimal.NewFromString(v); err == nil {
+			return d
+		}
+	}
+	return fallback
+}
+
+func getEnvList(key string, fallback []string) []string {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	var list []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
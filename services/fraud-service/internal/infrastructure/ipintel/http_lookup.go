@@ -0,0 +1,127 @@
+// Package ipintel provides IPIntelligence adapters.
+package ipintel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+// maxCacheEntries bounds the lookup cache; it is cleared when full.
+const maxCacheEntries = 10000
+
+// HTTPLookup implements port.IPIntelligence against an IP intelligence API
+// that serves GET <endpoint>/<ip> with a JSON body:
+//
+//	{"country": "DE", "asn": 3320, "as_org": "Deutsche Telekom AG",
+//	 "proxy": false, "vpn": false, "tor": false, "hosting": false}
+//
+// Most commercial providers (ipinfo, MaxMind minFraud, IPQS) can be fronted
+// by a thin shim producing this shape. Results are cached for ttl.
+type HTTPLookup struct {
+	client   *http.Client
+	cache    map[string]cachedInfo
+	endpoint string
+	apiKey   string
+	ttl      time.Duration
+	mu       sync.Mutex
+}
+
+type cachedInfo struct {
+	expiresAt time.Time
+	info      model.IPInfo
+}
+
+type lookupResponse struct {
+	Country string `json:"country"`
+	ASOrg   string `json:"as_org"`
+	ASN     int    `json:"asn"`
+	Proxy   bool   `json:"proxy"`
+	VPN     bool   `json:"vpn"`
+	Tor     bool   `json:"tor"`
+	Hosting bool   `json:"hosting"`
+}
+
+// NewHTTPLookup creates an HTTPLookup. apiKey, if set, is sent as a bearer token.
+func NewHTTPLookup(endpoint, apiKey string, timeout, ttl time.Duration) *HTTPLookup {
+	return &HTTPLookup{
+		client:   &http.Client{Timeout: timeout},
+		cache:    make(map[string]cachedInfo),
+		endpoint: strings.TrimRight(endpoint, "/"),
+		apiKey:   apiKey,
+		ttl:      ttl,
+	}
+}
+
+// Lookup returns intelligence for ip, from cache when fresh.
+func (l *HTTPLookup) Lookup(ctx context.Context, ip string) (model.IPInfo, error) {
+	now := time.Now()
+	l.mu.Lock()
+	if c, ok := l.cache[ip]; ok && now.Before(c.expiresAt) {
+		l.mu.Unlock()
+		return c.info, nil
+	}
+	l.mu.Unlock()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, l.endpoint+"/"+url.PathEscape(ip), nil)
+	if err != nil {
+		return model.IPInfo{}, fmt.Errorf("failed to build IP lookup request: %w", err)
+	}
+	if l.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+l.apiKey)
+	}
+
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return model.IPInfo{}, fmt.Errorf("IP lookup failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 16<<10))
+	if err != nil {
+		return model.IPInfo{}, fmt.Errorf("failed to read IP lookup response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return model.IPInfo{}, fmt.Errorf("IP lookup returned %d", resp.StatusCode)
+	}
+
+	var out lookupResponse
+	if err := json.Unmarshal(data, &out); err != nil {
+		return model.IPInfo{}, fmt.Errorf("failed to decode IP lookup response: %w", err)
+	}
+	info := model.IPInfo{
+		Country:   strings.ToUpper(out.Country),
+		ASN:       out.ASN,
+		ASNOrg:    out.ASOrg,
+		IsProxy:   out.Proxy,
+		IsVPN:     out.VPN,
+		IsTor:     out.Tor,
+		IsHosting: out.Hosting,
+	}
+
+	l.mu.Lock()
+	if len(l.cache) >= maxCacheEntries {
+		l.cache = make(map[string]cachedInfo)
+	}
+	l.cache[ip] = cachedInfo{info: info, expiresAt: now.Add(l.ttl)}
+	l.mu.Unlock()
+
+	return info, nil
+}
+
+// NoopLookup implements port.IPIntelligence when no provider is configured.
+// It reports nothing about any address.
+type NoopLookup struct{}
+
+// Lookup returns an empty IPInfo.
+func (NoopLookup) Lookup(_ context.Context, _ string) (model.IPInfo, error) {
+	return model.IPInfo{}, nil
+}
@@ -0,0 +1,51 @@
+package ipintel_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/infrastructure/ipintel"
+)
+
+func TestHTTPLookup_Lookup(t *testing.T) {
+	t.Run("maps the response and caches it", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			assert.Equal(t, "/203.0.113.7", r.URL.Path)
+			assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(`{"country": "nl", "asn": 60781, "as_org": "LeaseWeb", "vpn": true, "hosting": true}`))
+		}))
+		defer srv.Close()
+
+		l := ipintel.NewHTTPLookup(srv.URL+"/", "secret", time.Second, time.Minute)
+		info, err := l.Lookup(context.Background(), "203.0.113.7")
+		require.NoError(t, err)
+		_, err = l.Lookup(context.Background(), "203.0.113.7")
+		require.NoError(t, err)
+
+		assert.Equal(t, "NL", info.Country)
+		assert.Equal(t, 60781, info.ASN)
+		assert.True(t, info.IsVPN)
+		assert.True(t, info.IsHosting)
+		assert.True(t, info.Anonymized())
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("fails on non-200 status", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer srv.Close()
+
+		_, err := ipintel.NewHTTPLookup(srv.URL, "", time.Second, time.Minute).Lookup(context.Background(), "198.51.100.1")
+		assert.Error(t, err)
+	})
+}
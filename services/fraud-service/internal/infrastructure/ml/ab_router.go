@@ -0,0 +1,76 @@
+package ml
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// routingFeature is the feature used to pin a caller to a model variant, so
+// every transaction on an account is scored by the same model.
+const routingFeature = "account_id"
+
+// Variant is one model version taking part in an A/B test.
+type Variant struct {
+	Client port.MLModelClient
+	Name   string
+	// Weight is the variant's share of traffic relative to the other variants.
+	Weight int
+}
+
+// ABRouter implements port.MLModelClient by splitting traffic between model
+// variants. Routing is deterministic per account: hashing the account ID
+// keeps an account on one variant for the lifetime of the experiment.
+type ABRouter struct {
+	variants []Variant
+	total    int
+}
+
+// NewABRouter creates a router over the given variants. Variants with a zero
+// weight receive no traffic.
+func NewABRouter(variants ...Variant) (*ABRouter, error) {
+	r := &ABRouter{}
+	for _, v := range variants {
+		if v.Client == nil {
+			return nil, fmt.Errorf("variant %q has no client", v.Name)
+		}
+		if v.Weight < 0 {
+			return nil, fmt.Errorf("variant %q has negative weight", v.Name)
+		}
+		if v.Weight == 0 {
+			continue
+		}
+		r.variants = append(r.variants, v)
+		r.total += v.Weight
+	}
+	if r.total == 0 {
+		return nil, fmt.Errorf("at least one variant must have a positive weight")
+	}
+	return r, nil
+}
+
+// Predict routes the request to a variant and returns its prediction.
+func (r *ABRouter) Predict(ctx context.Context, features map[string]interface{}) (port.MLPrediction, error) {
+	v := r.route(features)
+	pred, err := v.Client.Predict(ctx, features)
+	if err != nil {
+		return port.MLPrediction{}, fmt.Errorf("variant %s: %w", v.Name, err)
+	}
+	return pred, nil
+}
+
+func (r *ABRouter) route(features map[string]interface{}) Variant {
+	h := fnv.New32a()
+	_, _ = fmt.Fprint(h, features[routingFeature])
+	bucket := int(h.Sum32() % uint32(r.total))
+
+	for _, v := range r.variants {
+		if bucket < v.Weight {
+			return v
+		}
+		bucket -= v.Weight
+	}
+	return r.variants[len(r.variants)-1]
+}
@@ -0,0 +1,68 @@
+package ml_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+	"github.com/bibbank/bib/services/fraud-service/internal/infrastructure/ml"
+)
+
+type fixedModel struct {
+	version string
+}
+
+func (m fixedModel) Predict(_ context.Context, _ map[string]interface{}) (port.MLPrediction, error) {
+	return port.MLPrediction{Score: 0.5, ModelVersion: m.version}, nil
+}
+
+func TestABRouter(t *testing.T) {
+	t.Run("splits traffic by weight and is sticky per account", func(t *testing.T) {
+		router, err := ml.NewABRouter(
+			ml.Variant{Name: "champion", Client: fixedModel{"champion"}, Weight: 80},
+			ml.Variant{Name: "challenger", Client: fixedModel{"challenger"}, Weight: 20},
+		)
+		require.NoError(t, err)
+
+		counts := map[string]int{}
+		for i := 0; i < 2000; i++ {
+			features := map[string]interface{}{"account_id": fmt.Sprintf("acct-%d", i)}
+			first, err := router.Predict(context.Background(), features)
+			require.NoError(t, err)
+			again, err := router.Predict(context.Background(), features)
+			require.NoError(t, err)
+			assert.Equal(t, first.ModelVersion, again.ModelVersion)
+			counts[first.ModelVersion]++
+		}
+
+		assert.InDelta(t, 1600, counts["champion"], 120)
+		assert.InDelta(t, 400, counts["challenger"], 120)
+	})
+
+	t.Run("ignores zero-weight variants", func(t *testing.T) {
+		router, err := ml.NewABRouter(
+			ml.Variant{Name: "a", Client: fixedModel{"a"}, Weight: 1},
+			ml.Variant{Name: "b", Client: fixedModel{"b"}, Weight: 0},
+		)
+		require.NoError(t, err)
+
+		pred, err := router.Predict(context.Background(), map[string]interface{}{"account_id": "x"})
+		require.NoError(t, err)
+		assert.Equal(t, "a", pred.ModelVersion)
+	})
+
+	t.Run("rejects invalid configurations", func(t *testing.T) {
+		_, err := ml.NewABRouter()
+		assert.Error(t, err)
+
+		_, err = ml.NewABRouter(ml.Variant{Name: "a", Weight: 1})
+		assert.Error(t, err)
+
+		_, err = ml.NewABRouter(ml.Variant{Name: "a", Client: fixedModel{"a"}, Weight: -1})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,90 @@
+package ml
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// HTTPModelClient implements port.MLModelClient against a model server that
+// exposes a JSON prediction endpoint, such as a Python (FastAPI, BentoML)
+// service or a Triton/KServe deployment behind a thin JSON shim.
+//
+// Request:  POST <endpoint> {"features": {...}}
+// Response: 200 {"score": 0.83, "model_version": "fraud-gbm:v12"}
+type HTTPModelClient struct {
+	client       *http.Client
+	endpoint     string
+	modelVersion string
+}
+
+// NewHTTPModelClient creates a client for the model server at endpoint.
+// modelVersion is reported when the server does not return one. timeout
+// bounds each prediction; on expiry the caller falls back to rules-only.
+func NewHTTPModelClient(endpoint, modelVersion string, timeout time.Duration) *HTTPModelClient {
+	return &HTTPModelClient{
+		client:       &http.Client{Timeout: timeout},
+		endpoint:     endpoint,
+		modelVersion: modelVersion,
+	}
+}
+
+type predictRequest struct {
+	Features map[string]interface{} `json:"features"`
+}
+
+type predictResponse struct {
+	ModelVersion string   `json:"model_version"`
+	Score        *float64 `json:"score"`
+}
+
+// Predict sends the features to the model server and returns its prediction.
+func (c *HTTPModelClient) Predict(ctx context.Context, features map[string]interface{}) (port.MLPrediction, error) {
+	body, err := json.Marshal(predictRequest{Features: features})
+	if err != nil {
+		return port.MLPrediction{}, fmt.Errorf("failed to encode features: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return port.MLPrediction{}, fmt.Errorf("failed to build prediction request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return port.MLPrediction{}, fmt.Errorf("prediction request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return port.MLPrediction{}, fmt.Errorf("failed to read prediction response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return port.MLPrediction{}, fmt.Errorf("model server returned %d: %s", resp.StatusCode, bytes.TrimSpace(data))
+	}
+
+	var out predictResponse
+	if err := json.Unmarshal(data, &out); err != nil {
+		return port.MLPrediction{}, fmt.Errorf("failed to decode prediction response: %w", err)
+	}
+	if out.Score == nil {
+		return port.MLPrediction{}, fmt.Errorf("model server response is missing score")
+	}
+	if *out.Score < 0 || *out.Score > 1 {
+		return port.MLPrediction{}, fmt.Errorf("model score %v is outside [0, 1]", *out.Score)
+	}
+
+	version := out.ModelVersion
+	if version == "" {
+		version = c.modelVersion
+	}
+	return port.MLPrediction{Score: *out.Score, ModelVersion: version}, nil
+}
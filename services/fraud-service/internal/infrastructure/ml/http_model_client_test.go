@@ -0,0 +1,83 @@
+package ml_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/infrastructure/ml"
+)
+
+func TestHTTPModelClient_Predict(t *testing.T) {
+	t.Run("returns score and server model version", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Features map[string]interface{} `json:"features"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "acct-1", body.Features["account_id"])
+			_, _ = w.Write([]byte(`{"score": 0.82, "model_version": "gbm:v12"}`))
+		}))
+		defer srv.Close()
+
+		client := ml.NewHTTPModelClient(srv.URL, "configured", time.Second)
+		pred, err := client.Predict(context.Background(), map[string]interface{}{"account_id": "acct-1"})
+
+		require.NoError(t, err)
+		assert.InDelta(t, 0.82, pred.Score, 1e-9)
+		assert.Equal(t, "gbm:v12", pred.ModelVersion)
+	})
+
+	t.Run("falls back to configured model version", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"score": 0.1}`))
+		}))
+		defer srv.Close()
+
+		pred, err := ml.NewHTTPModelClient(srv.URL, "configured", time.Second).Predict(context.Background(), nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "configured", pred.ModelVersion)
+	})
+
+	t.Run("rejects out of range and missing scores", func(t *testing.T) {
+		for _, body := range []string{`{"score": 1.5}`, `{"model_version": "x"}`} {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(body))
+			}))
+			_, err := ml.NewHTTPModelClient(srv.URL, "v", time.Second).Predict(context.Background(), nil)
+			srv.Close()
+			assert.Error(t, err, body)
+		}
+	})
+
+	t.Run("fails on non-200 status", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "model not loaded", http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		_, err := ml.NewHTTPModelClient(srv.URL, "v", time.Second).Predict(context.Background(), nil)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "503")
+	})
+
+	t.Run("times out slow model servers", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+			_, _ = w.Write([]byte(`{"score": 0.5}`))
+		}))
+		defer srv.Close()
+
+		_, err := ml.NewHTTPModelClient(srv.URL, "v", 20*time.Millisecond).Predict(context.Background(), nil)
+
+		require.Error(t, err)
+	})
+}
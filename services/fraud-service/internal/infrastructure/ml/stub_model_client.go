@@ -3,10 +3,15 @@ package ml
 import (
 	"context"
 	"log/slog"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
 )
 
+// stubModelVersion is reported for predictions from StubModelClient.
+const stubModelVersion = "stub"
+
 // StubModelClient implements port.MLModelClient as a stub for development.
-// In production, this would call an external ML model service (e.g., SageMaker, Vertex AI).
+// In production, use HTTPModelClient against the model server.
 type StubModelClient struct {
 	logger *slog.Logger
 }
@@ -16,13 +21,12 @@ func NewStubModelClient(logger *slog.Logger) *StubModelClient {
 	return &StubModelClient{logger: logger}
 }
 
-// Predict returns a default risk score. This is a stub implementation.
-// In production, this would send features to an ML model and receive a prediction.
-func (c *StubModelClient) Predict(_ context.Context, features map[string]interface{}) (float64, error) {
+// Predict returns a neutral score without calling a model.
+func (c *StubModelClient) Predict(_ context.Context, features map[string]interface{}) (port.MLPrediction, error) {
 	c.logger.Debug("stub ML model prediction requested",
 		slog.Int("feature_count", len(features)),
 	)
 
 	// Return a neutral score; the rule-based RiskScorer handles actual scoring.
-	return 0.5, nil
+	return port.MLPrediction{Score: 0.5, ModelVersion: stubModelVersion}, nil
 }
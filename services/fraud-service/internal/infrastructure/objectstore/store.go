@@ -0,0 +1,32 @@
+// Package objectstore provides the DatasetStore adapter.
+package objectstore
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/bibbank/bib/pkg/archive"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+)
+
+// Compile-time interface check.
+var _ port.DatasetStore = (*Store)(nil)
+
+// Store implements port.DatasetStore on the platform's object storage: the
+// bucket the ML team reads from, or a directory it mounts.
+type Store struct {
+	store archive.Store
+}
+
+// New creates a Store keeping objects in store.
+func New(store archive.Store) *Store {
+	return &Store{store: store}
+}
+
+// Put writes data under key and returns the object's URI.
+func (s *Store) Put(ctx context.Context, key string, data []byte) (string, error) {
+	if err := s.store.Put(ctx, key, bytes.NewReader(data), "application/x-ndjson"); err != nil {
+		return "", err
+	}
+	return s.store.URI(key), nil
+}
@@ -0,0 +1,36 @@
+package objectstore_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/archive"
+	"github.com/bibbank/bib/services/fraud-service/internal/infrastructure/objectstore"
+)
+
+func TestStore_Put(t *testing.T) {
+	root := t.TempDir()
+	store := objectstore.New(archive.NewFileStore(root))
+
+	uri, err := store.Put(context.Background(), "fraud-training/t1/data.jsonl", []byte("{}\n"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(uri, "file://"))
+	assert.True(t, strings.HasSuffix(uri, "/fraud-training/t1/data.jsonl"))
+
+	data, err := os.ReadFile(filepath.Join(root, "fraud-training", "t1", "data.jsonl"))
+	require.NoError(t, err)
+	assert.Equal(t, "{}\n", string(data))
+}
+
+func TestStore_RejectsTraversal(t *testing.T) {
+	store := objectstore.New(archive.NewFileStore(t.TempDir()))
+
+	_, err := store.Put(context.Background(), "../escape.jsonl", []byte("x"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+// AMLTransactionRepository implements port.AMLTransactionRepository using PostgreSQL.
+type AMLTransactionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAMLTransactionRepository creates a new PostgreSQL-backed AML transaction repository.
+func NewAMLTransactionRepository(pool *pgxpool.Pool) *AMLTransactionRepository {
+	return &AMLTransactionRepository{pool: pool}
+}
+
+// Record stores the transaction, ignoring a redelivered event.
+func (r *AMLTransactionRepository) Record(ctx context.Context, t model.MonitoredTransaction) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO aml_transactions (
+			event_id, direction, tenant_id, account_id, transaction_id,
+			amount, currency, country, occurred_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (event_id, direction) DO NOTHING`,
+		t.EventID, t.Direction, t.TenantID, t.AccountID, t.TransactionID,
+		t.Amount, t.Currency, t.Country, t.OccurredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record AML transaction: %w", err)
+	}
+	return nil
+}
+
+// ListByAccount returns the account's transactions since the given time,
+// oldest first.
+func (r *AMLTransactionRepository) ListByAccount(ctx context.Context, tenantID, accountID uuid.UUID, since time.Time) ([]model.MonitoredTransaction, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT event_id, direction, tenant_id, account_id, transaction_id,
+			amount, currency, country, occurred_at
+		FROM aml_transactions
+		WHERE tenant_id = $1 AND account_id = $2 AND occurred_at >= $3
+		ORDER BY occurred_at ASC`,
+		tenantID, accountID, since,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query AML transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var txns []model.MonitoredTransaction
+	for rows.Next() {
+		var t model.MonitoredTransaction
+		if err := rows.Scan(
+			&t.EventID, &t.Direction, &t.TenantID, &t.AccountID, &t.TransactionID,
+			&t.Amount, &t.Currency, &t.Country, &t.OccurredAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan AML transaction: %w", err)
+		}
+		txns = append(txns, t)
+	}
+	return txns, rows.Err()
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,6 +12,7 @@ import (
 	"github.com/shopspring/decimal"
 
 	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
 	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
 )
 
@@ -37,13 +39,15 @@ func (r *AssessmentRepository) Save(ctx context.Context, assessment *model.Trans
 		INSERT INTO transaction_assessments (
 			id, tenant_id, transaction_id, account_id,
 			amount, currency, transaction_type,
-			risk_level, risk_score, decision,
+			risk_level, risk_score, decision, model_version, policy_version,
 			assessed_at, version, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
 		ON CONFLICT (tenant_id, transaction_id) DO UPDATE SET
 			risk_level = EXCLUDED.risk_level,
 			risk_score = EXCLUDED.risk_score,
 			decision = EXCLUDED.decision,
+			model_version = EXCLUDED.model_version,
+			policy_version = EXCLUDED.policy_version,
 			assessed_at = EXCLUDED.assessed_at,
 			version = EXCLUDED.version,
 			updated_at = EXCLUDED.updated_at
@@ -60,6 +64,8 @@ func (r *AssessmentRepository) Save(ctx context.Context, assessment *model.Trans
 		assessment.RiskLevel().String(),
 		assessment.RiskScore(),
 		assessment.Decision().String(),
+		assessment.ModelVersion(),
+		assessment.PolicyVersion(),
 		assessment.AssessedAt(),
 		assessment.Version(),
 		assessment.CreatedAt(),
@@ -97,7 +103,7 @@ func (r *AssessmentRepository) FindByID(ctx context.Context, tenantID, id uuid.U
 	query := `
 		SELECT id, tenant_id, transaction_id, account_id,
 			amount, currency, transaction_type,
-			risk_level, risk_score, decision,
+			risk_level, risk_score, decision, model_version, policy_version,
 			assessed_at, version, created_at, updated_at
 		FROM transaction_assessments
 		WHERE tenant_id = $1 AND id = $2
@@ -116,7 +122,7 @@ func (r *AssessmentRepository) FindByTransactionID(ctx context.Context, tenantID
 	query := `
 		SELECT id, tenant_id, transaction_id, account_id,
 			amount, currency, transaction_type,
-			risk_level, risk_score, decision,
+			risk_level, risk_score, decision, model_version, policy_version,
 			assessed_at, version, created_at, updated_at
 		FROM transaction_assessments
 		WHERE tenant_id = $1 AND transaction_id = $2
@@ -135,7 +141,7 @@ func (r *AssessmentRepository) FindByAccountID(ctx context.Context, tenantID, ac
 	query := `
 		SELECT id, tenant_id, transaction_id, account_id,
 			amount, currency, transaction_type,
-			risk_level, risk_score, decision,
+			risk_level, risk_score, decision, model_version, policy_version,
 			assessed_at, version, created_at, updated_at
 		FROM transaction_assessments
 		WHERE tenant_id = $1 AND account_id = $2
@@ -161,6 +167,151 @@ func (r *AssessmentRepository) FindByAccountID(ctx context.Context, tenantID, ac
 	return assessments, nil
 }
 
+// List returns a page of assessments matching the filter, newest first.
+func (r *AssessmentRepository) List(ctx context.Context, filter port.AssessmentFilter) ([]*model.TransactionAssessment, int, error) {
+	conds := []string{"tenant_id = $1"}
+	args := []any{filter.TenantID}
+
+	if filter.AccountID != uuid.Nil {
+		args = append(args, filter.AccountID)
+		conds = append(conds, fmt.Sprintf("account_id = $%d", len(args)))
+	}
+	if filter.Decision != "" {
+		args = append(args, filter.Decision)
+		conds = append(conds, fmt.Sprintf("decision = $%d", len(args)))
+	}
+	if filter.RiskLevel != "" {
+		args = append(args, filter.RiskLevel)
+		conds = append(conds, fmt.Sprintf("risk_level = $%d", len(args)))
+	}
+	if !filter.From.IsZero() {
+		args = append(args, filter.From)
+		conds = append(conds, fmt.Sprintf("assessed_at >= $%d", len(args)))
+	}
+	if !filter.To.IsZero() {
+		args = append(args, filter.To)
+		conds = append(conds, fmt.Sprintf("assessed_at < $%d", len(args)))
+	}
+	where := strings.Join(conds, " AND ")
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM transaction_assessments WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count assessments: %w", err)
+	}
+
+	args = append(args, filter.Limit, filter.Offset)
+	query := fmt.Sprintf(`
+		SELECT id, tenant_id, transaction_id, account_id,
+			amount, currency, transaction_type,
+			risk_level, risk_score, decision, model_version, policy_version,
+			assessed_at, version, created_at, updated_at
+		FROM transaction_assessments
+		WHERE %s
+		ORDER BY assessed_at DESC, id
+		LIMIT $%d OFFSET $%d`, where, len(args)-1, len(args))
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query assessments: %w", err)
+	}
+	defer rows.Close()
+
+	var assessments []*model.TransactionAssessment
+	for rows.Next() {
+		assessment, err := r.scanAssessmentFromRows(ctx, rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		assessments = append(assessments, assessment)
+	}
+	return assessments, total, rows.Err()
+}
+
+// DailyDecisionCounts counts the tenant's assessments in [from, to) by UTC
+// day and decision.
+func (r *AssessmentRepository) DailyDecisionCounts(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]model.DecisionVolume, error) {
+	query := `
+		SELECT date_trunc('day', assessed_at AT TIME ZONE 'UTC') AS day, decision, COUNT(*)
+		FROM transaction_assessments
+		WHERE tenant_id = $1 AND assessed_at >= $2 AND assessed_at < $3
+		GROUP BY 1, 2
+		ORDER BY 1, 2
+	`
+
+	rows, err := r.pool.Query(ctx, query, tenantID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query daily decision counts: %w", err)
+	}
+	defer rows.Close()
+
+	var volumes []model.DecisionVolume
+	for rows.Next() {
+		var (
+			v           model.DecisionVolume
+			decisionStr string
+		)
+		if err := rows.Scan(&v.Day, &decisionStr, &v.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan decision count: %w", err)
+		}
+		v.Day = time.Date(v.Day.Year(), v.Day.Month(), v.Day.Day(), 0, 0, 0, 0, time.UTC)
+		v.Decision, err = valueobject.AssessmentDecisionFromString(decisionStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse decision: %w", err)
+		}
+		volumes = append(volumes, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate decision counts: %w", err)
+	}
+	return volumes, nil
+}
+
+// ScoreDistribution counts the tenant's assessments in [from, to) by score,
+// risk level, decision and whether a sanctions hit was recorded.
+func (r *AssessmentRepository) ScoreDistribution(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]model.ScoreBucket, error) {
+	query := `
+		SELECT a.risk_score, a.risk_level, a.decision,
+			EXISTS (
+				SELECT 1 FROM risk_signals s
+				WHERE s.assessment_id = a.id AND s.signal = $4
+			) AS sanctions_hit,
+			COUNT(*)
+		FROM transaction_assessments a
+		WHERE a.tenant_id = $1 AND a.assessed_at >= $2 AND a.assessed_at < $3
+		GROUP BY 1, 2, 3, 4
+	`
+
+	rows, err := r.pool.Query(ctx, query, tenantID, from, to, model.SignalSanctionsHit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query score distribution: %w", err)
+	}
+	defer rows.Close()
+
+	var buckets []model.ScoreBucket
+	for rows.Next() {
+		var (
+			b                     model.ScoreBucket
+			levelStr, decisionStr string
+		)
+		if err := rows.Scan(&b.Score, &levelStr, &decisionStr, &b.SanctionsHit, &b.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan score bucket: %w", err)
+		}
+		b.RiskLevel, err = valueobject.RiskLevelFromString(levelStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse risk level: %w", err)
+		}
+		b.Decision, err = valueobject.AssessmentDecisionFromString(decisionStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse decision: %w", err)
+		}
+		buckets = append(buckets, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate score distribution: %w", err)
+	}
+	return buckets, nil
+}
+
 func (r *AssessmentRepository) scanAssessment(ctx context.Context, row pgx.Row) (*model.TransactionAssessment, error) {
 	var (
 		id              uuid.UUID
@@ -173,6 +324,8 @@ func (r *AssessmentRepository) scanAssessment(ctx context.Context, row pgx.Row)
 		riskLevelStr    string
 		riskScore       int
 		decisionStr     string
+		modelVersion    string
+		policyVersion   int
 		assessedAt      *time.Time
 		version         int
 		createdAt       time.Time
@@ -182,7 +335,7 @@ func (r *AssessmentRepository) scanAssessment(ctx context.Context, row pgx.Row)
 	err := row.Scan(
 		&id, &tenantID, &transactionID, &accountID,
 		&amount, &currency, &transactionType,
-		&riskLevelStr, &riskScore, &decisionStr,
+		&riskLevelStr, &riskScore, &decisionStr, &modelVersion, &policyVersion,
 		&assessedAt, &version, &createdAt, &updatedAt,
 	)
 	if err != nil {
@@ -216,7 +369,7 @@ func (r *AssessmentRepository) scanAssessment(ctx context.Context, row pgx.Row)
 	return model.Reconstruct(
 		id, tenantID, transactionID, accountID,
 		amount, currency, transactionType,
-		riskLevel, riskScore, decision, signals,
+		riskLevel, riskScore, decision, signals, modelVersion, policyVersion,
 		assessedAtVal, version, createdAt, updatedAt,
 	), nil
 }
@@ -233,6 +386,8 @@ func (r *AssessmentRepository) scanAssessmentFromRows(ctx context.Context, rows
 		riskLevelStr    string
 		riskScore       int
 		decisionStr     string
+		modelVersion    string
+		policyVersion   int
 		assessedAt      *time.Time
 		version         int
 		createdAt       time.Time
@@ -242,7 +397,7 @@ func (r *AssessmentRepository) scanAssessmentFromRows(ctx context.Context, rows
 	err := rows.Scan(
 		&id, &tenantID, &transactionID, &accountID,
 		&amount, &currency, &transactionType,
-		&riskLevelStr, &riskScore, &decisionStr,
+		&riskLevelStr, &riskScore, &decisionStr, &modelVersion, &policyVersion,
 		&assessedAt, &version, &createdAt, &updatedAt,
 	)
 	if err != nil {
@@ -272,7 +427,7 @@ func (r *AssessmentRepository) scanAssessmentFromRows(ctx context.Context, rows
 	return model.Reconstruct(
 		id, tenantID, transactionID, accountID,
 		amount, currency, transactionType,
-		riskLevel, riskScore, decision, signals,
+		riskLevel, riskScore, decision, signals, modelVersion, policyVersion,
 		assessedAtVal, version, createdAt, updatedAt,
 	), nil
 }
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+// AuthAnomalyRepository implements port.AuthAnomalyRepository using PostgreSQL.
+type AuthAnomalyRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAuthAnomalyRepository creates a new PostgreSQL-backed auth anomaly repository.
+func NewAuthAnomalyRepository(pool *pgxpool.Pool) *AuthAnomalyRepository {
+	return &AuthAnomalyRepository{pool: pool}
+}
+
+// Record stores the anomaly, ignoring a redelivered event.
+func (r *AuthAnomalyRepository) Record(ctx context.Context, a model.AuthAnomaly) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO auth_anomalies (event_id, kind, client_ip, user_id, failures, occurred_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (event_id) DO NOTHING`,
+		a.EventID, a.Kind, a.ClientIP, a.UserID, a.Failures, a.OccurredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record auth anomaly: %w", err)
+	}
+	return nil
+}
+
+// SummarizeIP counts the anomalies from ip since the given time.
+func (r *AuthAnomalyRepository) SummarizeIP(ctx context.Context, ip string, since time.Time) (model.AuthAnomalySummary, error) {
+	var s model.AuthAnomalySummary
+	err := r.pool.QueryRow(ctx, `
+		SELECT
+			COUNT(*) FILTER (WHERE kind = $3),
+			COUNT(*) FILTER (WHERE kind = $4),
+			COALESCE(MAX(failures), 0)
+		FROM auth_anomalies
+		WHERE client_ip = $1 AND occurred_at >= $2`,
+		ip, since, model.AuthAnomalyStepUp, model.AuthAnomalyLockout,
+	).Scan(&s.StepUps, &s.Lockouts, &s.MaxFailures)
+	if err != nil {
+		return model.AuthAnomalySummary{}, fmt.Errorf("failed to summarize auth anomalies: %w", err)
+	}
+	return s, nil
+}
@@ -0,0 +1,323 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// CaseRepository implements port.CaseRepository using PostgreSQL.
+type CaseRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCaseRepository creates a new PostgreSQL-backed fraud case repository.
+func NewCaseRepository(pool *pgxpool.Pool) *CaseRepository {
+	return &CaseRepository{pool: pool}
+}
+
+const caseColumns = `
+	id, tenant_id, assessment_id, transaction_id, account_id,
+	risk_score, risk_level, aml_scenario, alert_transaction_ids, linked_assessment_ids,
+	status, disposition, assignee_id,
+	sla_due_at, sla_breached_at, resolved_at, version, created_at, updated_at
+`
+
+// Save upserts the case and appends any notes not yet persisted.
+func (r *CaseRepository) Save(ctx context.Context, c *model.FraudCase) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	alertTxnIDs := c.AlertTransactionIDs()
+	if alertTxnIDs == nil {
+		alertTxnIDs = []uuid.UUID{}
+	}
+	linkedIDs := c.LinkedAssessmentIDs()
+	if linkedIDs == nil {
+		linkedIDs = []uuid.UUID{}
+	}
+	_, err = tx.Exec(ctx, `
+		INSERT INTO fraud_cases (`+caseColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+		ON CONFLICT (id) DO UPDATE SET
+			linked_assessment_ids = EXCLUDED.linked_assessment_ids,
+			status = EXCLUDED.status,
+			disposition = EXCLUDED.disposition,
+			assignee_id = EXCLUDED.assignee_id,
+			sla_breached_at = EXCLUDED.sla_breached_at,
+			resolved_at = EXCLUDED.resolved_at,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE fraud_cases.version < EXCLUDED.version`,
+		c.ID(), c.TenantID(), nullableUUID(c.AssessmentID()), c.TransactionID(), c.AccountID(),
+		c.RiskScore(), c.RiskLevel().String(), c.AMLScenario(), alertTxnIDs, linkedIDs, c.Status().String(),
+		nullableString(c.Disposition().String()), nullableUUID(c.AssigneeID()),
+		c.SLADueAt(), nullableTime(c.SLABreachedAt()), nullableTime(c.ResolvedAt()), c.Version(), c.CreatedAt(), c.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save case: %w", err)
+	}
+
+	for _, n := range c.Notes() {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO fraud_case_notes (id, case_id, tenant_id, author_id, body, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (id) DO NOTHING`,
+			n.ID, c.ID(), c.TenantID(), n.AuthorID, n.Body, n.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save case note: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a case and its notes by the case ID.
+func (r *CaseRepository) FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.FraudCase, error) {
+	return r.findOne(ctx,
+		`SELECT `+caseColumns+` FROM fraud_cases WHERE tenant_id = $1 AND id = $2`,
+		tenantID, id,
+	)
+}
+
+// FindByAssessmentID retrieves the case opened for an assessment.
+func (r *CaseRepository) FindByAssessmentID(ctx context.Context, tenantID, assessmentID uuid.UUID) (*model.FraudCase, error) {
+	return r.findOne(ctx,
+		`SELECT `+caseColumns+` FROM fraud_cases WHERE tenant_id = $1 AND assessment_id = $2`,
+		tenantID, assessmentID,
+	)
+}
+
+// FindOpenAMLCase retrieves the account's unresolved case for an AML
+// scenario.
+func (r *CaseRepository) FindOpenAMLCase(ctx context.Context, tenantID, accountID uuid.UUID, scenario string) (*model.FraudCase, error) {
+	return r.findOne(ctx,
+		`SELECT `+caseColumns+` FROM fraud_cases
+		WHERE tenant_id = $1 AND account_id = $2 AND aml_scenario = $3 AND status <> 'RESOLVED'
+		ORDER BY created_at DESC LIMIT 1`,
+		tenantID, accountID, scenario,
+	)
+}
+
+// List returns a filtered page of cases ordered by SLA deadline. Notes are
+// not loaded for listings; use FindByID for the full case.
+func (r *CaseRepository) List(ctx context.Context, filter port.CaseFilter) ([]*model.FraudCase, int, error) {
+	conds := []string{"tenant_id = $1"}
+	args := []any{filter.TenantID}
+
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conds = append(conds, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if filter.AssigneeID != uuid.Nil {
+		args = append(args, filter.AssigneeID)
+		conds = append(conds, fmt.Sprintf("assignee_id = $%d", len(args)))
+	}
+	if filter.OverdueOnly {
+		conds = append(conds, "status <> 'RESOLVED' AND sla_due_at < NOW()")
+	}
+	where := strings.Join(conds, " AND ")
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM fraud_cases WHERE `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count cases: %w", err)
+	}
+
+	args = append(args, filter.Limit, filter.Offset)
+	rows, err := r.pool.Query(ctx,
+		fmt.Sprintf(`SELECT %s FROM fraud_cases WHERE %s ORDER BY sla_due_at ASC, created_at ASC LIMIT $%d OFFSET $%d`,
+			caseColumns, where, len(args)-1, len(args)),
+		args...,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query cases: %w", err)
+	}
+	cases, err := collectCases(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return cases, total, nil
+}
+
+// ListSLABreached returns unresolved cases past their SLA deadline whose
+// breach has not been recorded. Notes are not loaded.
+func (r *CaseRepository) ListSLABreached(ctx context.Context, now time.Time, limit int) ([]*model.FraudCase, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT `+caseColumns+` FROM fraud_cases
+		WHERE status <> 'RESOLVED' AND sla_breached_at IS NULL AND sla_due_at < $1
+		ORDER BY sla_due_at ASC
+		LIMIT $2`,
+		now, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query cases: %w", err)
+	}
+	return collectCases(rows)
+}
+
+func collectCases(rows pgx.Rows) ([]*model.FraudCase, error) {
+	defer rows.Close()
+
+	var cases []*model.FraudCase
+	for rows.Next() {
+		c, err := scanCase(rows)
+		if err != nil {
+			return nil, err
+		}
+		cases = append(cases, c)
+	}
+	return cases, rows.Err()
+}
+
+func (r *CaseRepository) findOne(ctx context.Context, query string, args ...any) (*model.FraudCase, error) {
+	c, err := scanCase(r.pool.QueryRow(ctx, query, args...))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	notes, err := r.loadNotes(ctx, c.ID())
+	if err != nil {
+		return nil, err
+	}
+	return withNotes(c, notes), nil
+}
+
+func (r *CaseRepository) loadNotes(ctx context.Context, caseID uuid.UUID) ([]model.CaseNote, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, author_id, body, created_at
+		FROM fraud_case_notes
+		WHERE case_id = $1
+		ORDER BY created_at ASC`,
+		caseID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query case notes: %w", err)
+	}
+	defer rows.Close()
+
+	var notes []model.CaseNote
+	for rows.Next() {
+		var n model.CaseNote
+		if err := rows.Scan(&n.ID, &n.AuthorID, &n.Body, &n.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan case note: %w", err)
+		}
+		notes = append(notes, n)
+	}
+	return notes, rows.Err()
+}
+
+// withNotes rebuilds a case with its notes attached.
+func withNotes(c *model.FraudCase, notes []model.CaseNote) *model.FraudCase {
+	return model.ReconstructFraudCase(
+		c.ID(), c.TenantID(), c.AssessmentID(), c.TransactionID(), c.AccountID(),
+		c.RiskScore(), c.RiskLevel(), c.AMLScenario(), c.AlertTransactionIDs(), c.LinkedAssessmentIDs(),
+		c.Status(), c.Disposition(), c.AssigneeID(), notes, c.SLADueAt(), c.SLABreachedAt(), c.ResolvedAt(),
+		c.Version(), c.CreatedAt(), c.UpdatedAt(),
+	)
+}
+
+// scanCase scans a fraud_cases row. Notes are loaded separately.
+func scanCase(row pgx.Row) (*model.FraudCase, error) {
+	var (
+		id, tenantID                 uuid.UUID
+		transactionID, accountID     uuid.UUID
+		assessmentID, assigneeID     *uuid.UUID
+		alertTxnIDs, linkedIDs       []uuid.UUID
+		riskScore, version           int
+		riskLevelStr, statusStr      string
+		amlScenario                  string
+		dispositionStr               *string
+		slaDueAt, createdAt, updated time.Time
+		slaBreachedAt, resolvedAt    *time.Time
+	)
+	err := row.Scan(
+		&id, &tenantID, &assessmentID, &transactionID, &accountID,
+		&riskScore, &riskLevelStr, &amlScenario, &alertTxnIDs, &linkedIDs, &statusStr, &dispositionStr, &assigneeID,
+		&slaDueAt, &slaBreachedAt, &resolvedAt, &version, &createdAt, &updated,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan case: %w", err)
+	}
+
+	riskLevel, err := valueobject.RiskLevelFromString(riskLevelStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse risk level: %w", err)
+	}
+	status, err := valueobject.CaseStatusFromString(statusStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse case status: %w", err)
+	}
+	var disposition valueobject.CaseDisposition
+	if dispositionStr != nil {
+		disposition, err = valueobject.CaseDispositionFromString(*dispositionStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse case disposition: %w", err)
+		}
+	}
+
+	var assessment uuid.UUID
+	if assessmentID != nil {
+		assessment = *assessmentID
+	}
+	var assignee uuid.UUID
+	if assigneeID != nil {
+		assignee = *assigneeID
+	}
+	var breached time.Time
+	if slaBreachedAt != nil {
+		breached = *slaBreachedAt
+	}
+	var resolved time.Time
+	if resolvedAt != nil {
+		resolved = *resolvedAt
+	}
+
+	return model.ReconstructFraudCase(
+		id, tenantID, assessment, transactionID, accountID,
+		riskScore, riskLevel, amlScenario, alertTxnIDs, linkedIDs,
+		status, disposition, assignee, nil, slaDueAt, breached, resolved, version, createdAt, updated,
+	), nil
+}
+
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func nullableUUID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
+
+func nullableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// DecisionPolicyRepository implements port.DecisionPolicyRepository using PostgreSQL.
+type DecisionPolicyRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDecisionPolicyRepository creates a new PostgreSQL-backed decision policy repository.
+func NewDecisionPolicyRepository(pool *pgxpool.Pool) *DecisionPolicyRepository {
+	return &DecisionPolicyRepository{pool: pool}
+}
+
+const decisionPolicyColumns = `id, tenant_id, version, medium_at, high_at, critical_at, review_at, decline_at, effective_from, reason, created_by, created_at`
+
+// Save appends a policy version. The (tenant_id, version) constraint rejects
+// a concurrent writer that raced to the same version.
+func (r *DecisionPolicyRepository) Save(ctx context.Context, p *model.DecisionPolicy) error {
+	t := p.Thresholds()
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO decision_policies (`+decisionPolicyColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		p.ID(), p.TenantID(), p.Version(),
+		t.MediumAt, t.HighAt, t.CriticalAt, t.ReviewAt, t.DeclineAt,
+		p.EffectiveFrom(), p.Reason(), p.CreatedBy(), p.CreatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save decision policy: %w", err)
+	}
+	return nil
+}
+
+// FindLatest returns the tenant's highest policy version.
+func (r *DecisionPolicyRepository) FindLatest(ctx context.Context, tenantID uuid.UUID) (*model.DecisionPolicy, error) {
+	row := r.pool.QueryRow(ctx, `
+		SELECT `+decisionPolicyColumns+`
+		FROM decision_policies
+		WHERE tenant_id = $1
+		ORDER BY version DESC
+		LIMIT 1`,
+		tenantID,
+	)
+	p, err := scanDecisionPolicy(row)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find decision policy: %w", err)
+	}
+	return p, nil
+}
+
+// ListVersions returns the tenant's policy versions, newest first.
+func (r *DecisionPolicyRepository) ListVersions(ctx context.Context, tenantID uuid.UUID) ([]*model.DecisionPolicy, error) {
+	return r.list(ctx, `
+		SELECT `+decisionPolicyColumns+`
+		FROM decision_policies
+		WHERE tenant_id = $1
+		ORDER BY version DESC`,
+		tenantID,
+	)
+}
+
+// ListAll returns every policy version across tenants.
+func (r *DecisionPolicyRepository) ListAll(ctx context.Context) ([]*model.DecisionPolicy, error) {
+	return r.list(ctx, `
+		SELECT `+decisionPolicyColumns+`
+		FROM decision_policies
+		ORDER BY tenant_id, version`,
+	)
+}
+
+func (r *DecisionPolicyRepository) list(ctx context.Context, query string, args ...any) ([]*model.DecisionPolicy, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list decision policies: %w", err)
+	}
+	defer rows.Close()
+
+	var policies []*model.DecisionPolicy
+	for rows.Next() {
+		p, err := scanDecisionPolicy(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan decision policy: %w", err)
+		}
+		policies = append(policies, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate decision policies: %w", err)
+	}
+	return policies, nil
+}
+
+func scanDecisionPolicy(row pgx.Row) (*model.DecisionPolicy, error) {
+	var (
+		id, tenantID, createdBy  uuid.UUID
+		version                  int
+		t                        valueobject.DecisionThresholds
+		effectiveFrom, createdAt time.Time
+		reason                   string
+	)
+	if err := row.Scan(
+		&id, &tenantID, &version,
+		&t.MediumAt, &t.HighAt, &t.CriticalAt, &t.ReviewAt, &t.DeclineAt,
+		&effectiveFrom, &reason, &createdBy, &createdAt,
+	); err != nil {
+		return nil, err
+	}
+	return model.ReconstructDecisionPolicy(id, tenantID, version, t, effectiveFrom, reason, createdBy, createdAt), nil
+}
@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+// DeviceProfileRepository implements port.DeviceProfileRepository using PostgreSQL.
+type DeviceProfileRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDeviceProfileRepository creates a new PostgreSQL-backed device profile repository.
+func NewDeviceProfileRepository(pool *pgxpool.Pool) *DeviceProfileRepository {
+	return &DeviceProfileRepository{pool: pool}
+}
+
+const deviceProfileColumns = `id, tenant_id, account_id, fingerprint, last_ip, last_ip_country, seen_count, first_seen_at, last_seen_at`
+
+// Save inserts or updates a device profile. Concurrent sightings of the same
+// device are merged by incrementing the stored count rather than overwriting it.
+func (r *DeviceProfileRepository) Save(ctx context.Context, d *model.DeviceProfile) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO device_profiles (`+deviceProfileColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (tenant_id, account_id, fingerprint) DO UPDATE SET
+			last_ip = CASE WHEN EXCLUDED.last_ip <> '' THEN EXCLUDED.last_ip ELSE device_profiles.last_ip END,
+			last_ip_country = CASE WHEN EXCLUDED.last_ip <> '' THEN EXCLUDED.last_ip_country ELSE device_profiles.last_ip_country END,
+			seen_count = device_profiles.seen_count + 1,
+			last_seen_at = GREATEST(device_profiles.last_seen_at, EXCLUDED.last_seen_at)`,
+		d.ID(), d.TenantID(), d.AccountID(), d.Fingerprint(), d.LastIP(), d.LastIPCountry(),
+		d.SeenCount(), d.FirstSeenAt(), d.LastSeenAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save device profile: %w", err)
+	}
+	return nil
+}
+
+// Find retrieves an account's profile for a device fingerprint.
+func (r *DeviceProfileRepository) Find(ctx context.Context, tenantID, accountID uuid.UUID, fingerprint string) (*model.DeviceProfile, error) {
+	row := r.pool.QueryRow(ctx, `
+		SELECT `+deviceProfileColumns+`
+		FROM device_profiles
+		WHERE tenant_id = $1 AND account_id = $2 AND fingerprint = $3`,
+		tenantID, accountID, fingerprint,
+	)
+	d, err := scanDeviceProfile(row)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find device profile: %w", err)
+	}
+	return d, nil
+}
+
+// CountAccounts returns how many accounts in the tenant have used the fingerprint.
+func (r *DeviceProfileRepository) CountAccounts(ctx context.Context, tenantID uuid.UUID, fingerprint string) (int, error) {
+	var n int
+	err := r.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM device_profiles WHERE tenant_id = $1 AND fingerprint = $2`,
+		tenantID, fingerprint,
+	).Scan(&n)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count device accounts: %w", err)
+	}
+	return n, nil
+}
+
+// ListByAccount returns the account's devices, most recently seen first.
+func (r *DeviceProfileRepository) ListByAccount(ctx context.Context, tenantID, accountID uuid.UUID) ([]*model.DeviceProfile, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+deviceProfileColumns+`
+		FROM device_profiles
+		WHERE tenant_id = $1 AND account_id = $2
+		ORDER BY last_seen_at DESC`,
+		tenantID, accountID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list device profiles: %w", err)
+	}
+	defer rows.Close()
+
+	var profiles []*model.DeviceProfile
+	for rows.Next() {
+		d, err := scanDeviceProfile(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan device profile: %w", err)
+		}
+		profiles = append(profiles, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate device profiles: %w", err)
+	}
+	return profiles, nil
+}
+
+func scanDeviceProfile(row pgx.Row) (*model.DeviceProfile, error) {
+	var (
+		id, tenantID, accountID            uuid.UUID
+		fingerprint, lastIP, lastIPCountry string
+		seenCount                          int
+		firstSeenAt, lastSeenAt            time.Time
+	)
+	if err := row.Scan(&id, &tenantID, &accountID, &fingerprint, &lastIP, &lastIPCountry, &seenCount, &firstSeenAt, &lastSeenAt); err != nil {
+		return nil, err
+	}
+	return model.ReconstructDeviceProfile(id, tenantID, accountID, fingerprint, lastIP, lastIPCountry, seenCount, firstSeenAt, lastSeenAt), nil
+}
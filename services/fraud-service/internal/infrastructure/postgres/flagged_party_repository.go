@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+// FlaggedPartyRepository implements port.FlaggedPartyRepository using PostgreSQL.
+type FlaggedPartyRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewFlaggedPartyRepository creates a new PostgreSQL-backed flagged party repository.
+func NewFlaggedPartyRepository(pool *pgxpool.Pool) *FlaggedPartyRepository {
+	return &FlaggedPartyRepository{pool: pool}
+}
+
+// Flag upserts the flag of the party's verification.
+func (r *FlaggedPartyRepository) Flag(ctx context.Context, p model.FlaggedParty) error {
+	matches := p.Matches
+	if matches == nil {
+		matches = []string{}
+	}
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO flagged_parties (tenant_id, verification_id, name, country, matches, flagged_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (tenant_id, verification_id) DO UPDATE SET
+			name = EXCLUDED.name,
+			country = EXCLUDED.country,
+			matches = EXCLUDED.matches,
+			flagged_at = EXCLUDED.flagged_at`,
+		p.TenantID, p.VerificationID, p.Name, p.Country, matches, p.FlaggedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to flag party: %w", err)
+	}
+	return nil
+}
+
+// Clear removes the flag of a verification.
+func (r *FlaggedPartyRepository) Clear(ctx context.Context, tenantID, verificationID uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, `
+		DELETE FROM flagged_parties WHERE tenant_id = $1 AND verification_id = $2`,
+		tenantID, verificationID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to clear flagged party: %w", err)
+	}
+	return nil
+}
+
+// ListByTenant returns the tenant's flagged parties.
+func (r *FlaggedPartyRepository) ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]model.FlaggedParty, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT verification_id, name, country, matches, flagged_at
+		FROM flagged_parties
+		WHERE tenant_id = $1`,
+		tenantID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list flagged parties: %w", err)
+	}
+	defer rows.Close()
+
+	var parties []model.FlaggedParty
+	for rows.Next() {
+		p := model.FlaggedParty{TenantID: tenantID}
+		if err := rows.Scan(&p.VerificationID, &p.Name, &p.Country, &p.Matches, &p.FlaggedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan flagged party: %w", err)
+		}
+		parties = append(parties, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list flagged parties: %w", err)
+	}
+	return parties, nil
+}
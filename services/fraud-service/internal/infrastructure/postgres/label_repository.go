@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// LabelRepository implements port.LabelRepository using PostgreSQL.
+type LabelRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewLabelRepository creates a new PostgreSQL-backed label repository.
+func NewLabelRepository(pool *pgxpool.Pool) *LabelRepository {
+	return &LabelRepository{pool: pool}
+}
+
+// Save inserts or replaces the label for an assessment.
+func (r *LabelRepository) Save(ctx context.Context, l *model.AssessmentLabel) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO assessment_labels (assessment_id, tenant_id, label, source, source_ref, labeled_by, labeled_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (assessment_id) DO UPDATE SET
+			label = EXCLUDED.label,
+			source = EXCLUDED.source,
+			source_ref = EXCLUDED.source_ref,
+			labeled_by = EXCLUDED.labeled_by,
+			labeled_at = EXCLUDED.labeled_at`,
+		l.AssessmentID(), l.TenantID(), l.Label().String(), l.Source().String(),
+		l.SourceRef(), nullableUUID(l.LabeledBy()), l.LabeledAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save label: %w", err)
+	}
+	return nil
+}
+
+// FindByAssessmentID retrieves the current label for an assessment.
+func (r *LabelRepository) FindByAssessmentID(ctx context.Context, tenantID, assessmentID uuid.UUID) (*model.AssessmentLabel, error) {
+	var (
+		labelStr, sourceStr, sourceRef string
+		labeledBy                      *uuid.UUID
+		labeledAt                      time.Time
+	)
+	err := r.pool.QueryRow(ctx, `
+		SELECT label, source, source_ref, labeled_by, labeled_at
+		FROM assessment_labels
+		WHERE tenant_id = $1 AND assessment_id = $2`,
+		tenantID, assessmentID,
+	).Scan(&labelStr, &sourceStr, &sourceRef, &labeledBy, &labeledAt)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find label: %w", err)
+	}
+
+	label, err := valueobject.FraudLabelFromString(labelStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse label: %w", err)
+	}
+	source, err := valueobject.LabelSourceFromString(sourceStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse label source: %w", err)
+	}
+	var labeledByVal uuid.UUID
+	if labeledBy != nil {
+		labeledByVal = *labeledBy
+	}
+
+	return model.ReconstructAssessmentLabel(assessmentID, tenantID, label, source, sourceRef, labeledByVal, labeledAt), nil
+}
+
+// ListTrainingExamples joins labels with the assessment features captured at
+// scoring time.
+func (r *LabelRepository) ListTrainingExamples(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]model.TrainingExample, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT a.id, a.transaction_id, a.account_id, a.amount, a.currency, a.transaction_type,
+			a.risk_score, a.risk_level, a.decision, a.assessed_at,
+			COALESCE(array_agg(s.signal ORDER BY s.signal) FILTER (WHERE s.signal IS NOT NULL), '{}'),
+			l.label, l.source, l.labeled_at
+		FROM assessment_labels l
+		JOIN transaction_assessments a ON a.id = l.assessment_id
+		LEFT JOIN risk_signals s ON s.assessment_id = a.id
+		WHERE l.tenant_id = $1 AND l.labeled_at >= $2 AND l.labeled_at < $3
+		GROUP BY a.id, l.assessment_id
+		ORDER BY l.labeled_at ASC`,
+		tenantID, from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query training examples: %w", err)
+	}
+	defer rows.Close()
+
+	var examples []model.TrainingExample
+	for rows.Next() {
+		var (
+			ex         model.TrainingExample
+			assessedAt *time.Time
+		)
+		if err := rows.Scan(
+			&ex.AssessmentID, &ex.TransactionID, &ex.AccountID, &ex.Amount, &ex.Currency, &ex.TransactionType,
+			&ex.RiskScore, &ex.RiskLevel, &ex.Decision, &assessedAt,
+			&ex.Signals,
+			&ex.Label, &ex.LabelSource, &ex.LabeledAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan training example: %w", err)
+		}
+		if assessedAt != nil {
+			ex.AssessedAt = *assessedAt
+		}
+		examples = append(examples, ex)
+	}
+	return examples, rows.Err()
+}
@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// LinkGraphRepository implements port.LinkGraphRepository using PostgreSQL.
+// The graph is stored as account-to-entity edges; account-to-account links
+// are found by self-joining on the entity.
+type LinkGraphRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewLinkGraphRepository creates a new PostgreSQL-backed link graph repository.
+func NewLinkGraphRepository(pool *pgxpool.Pool) *LinkGraphRepository {
+	return &LinkGraphRepository{pool: pool}
+}
+
+// confirmedFraudAccount returns a predicate on an account_links row aliased
+// "l": the account has an assessment carrying the label bound to param.
+func confirmedFraudAccount(param string) string {
+	return `EXISTS (
+		SELECT 1
+		FROM transaction_assessments a
+		JOIN assessment_labels lb ON lb.assessment_id = a.id
+		WHERE a.tenant_id = l.tenant_id AND a.account_id = l.account_id AND lb.label = ` + param + `
+	)`
+}
+
+// RecordLinks upserts the edges.
+func (r *LinkGraphRepository) RecordLinks(ctx context.Context, links []model.AccountLink) error {
+	batch := &pgx.Batch{}
+	for _, l := range links {
+		batch.Queue(`
+			INSERT INTO account_links (tenant_id, account_id, entity_type, entity_value, first_seen_at, last_seen_at)
+			VALUES ($1, $2, $3, $4, $5, $5)
+			ON CONFLICT (tenant_id, account_id, entity_type, entity_value) DO UPDATE SET
+				seen_count = account_links.seen_count + 1,
+				last_seen_at = GREATEST(account_links.last_seen_at, EXCLUDED.last_seen_at)`,
+			l.TenantID, l.AccountID, l.Entity.Type, l.Entity.Value, l.SeenAt,
+		)
+	}
+
+	if err := r.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to record account links: %w", err)
+	}
+	return nil
+}
+
+// FraudLinkedAccounts returns the confirmed-fraud accounts, other than
+// accountID, that share any of the entities.
+func (r *LinkGraphRepository) FraudLinkedAccounts(ctx context.Context, tenantID, accountID uuid.UUID, entities []model.LinkEntity) ([]model.LinkedAccount, error) {
+	types := make([]string, 0, len(entities))
+	values := make([]string, 0, len(entities))
+	for _, e := range entities {
+		types = append(types, e.Type)
+		values = append(values, e.Value)
+	}
+
+	query := `
+		SELECT l.account_id, array_agg(l.entity_type), array_agg(l.entity_value), TRUE
+		FROM account_links l
+		JOIN unnest($3::text[], $4::text[]) AS e(entity_type, entity_value)
+			ON l.entity_type = e.entity_type AND l.entity_value = e.entity_value
+		WHERE l.tenant_id = $1 AND l.account_id <> $2 AND ` + confirmedFraudAccount("$5") + `
+		GROUP BY l.account_id`
+
+	return r.queryLinked(ctx, query, tenantID, accountID, types, values, valueobject.LabelFraud.String())
+}
+
+// LinkedAccounts returns up to limit accounts sharing an entity with the
+// account, confirmed-fraud accounts first.
+func (r *LinkGraphRepository) LinkedAccounts(ctx context.Context, tenantID, accountID uuid.UUID, limit int) ([]model.LinkedAccount, error) {
+	query := `
+		SELECT l.account_id, array_agg(l.entity_type), array_agg(l.entity_value), ` + confirmedFraudAccount("$4") + ` AS confirmed_fraud
+		FROM account_links s
+		JOIN account_links l
+			ON l.tenant_id = s.tenant_id AND l.entity_type = s.entity_type AND l.entity_value = s.entity_value
+		WHERE s.tenant_id = $1 AND s.account_id = $2 AND l.account_id <> $2
+		GROUP BY l.tenant_id, l.account_id
+		ORDER BY confirmed_fraud DESC, COUNT(*) DESC, l.account_id
+		LIMIT $3`
+
+	return r.queryLinked(ctx, query, tenantID, accountID, limit, valueobject.LabelFraud.String())
+}
+
+func (r *LinkGraphRepository) queryLinked(ctx context.Context, query string, args ...any) ([]model.LinkedAccount, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query linked accounts: %w", err)
+	}
+	defer rows.Close()
+
+	var linked []model.LinkedAccount
+	for rows.Next() {
+		var (
+			a             model.LinkedAccount
+			types, values []string
+		)
+		if err := rows.Scan(&a.AccountID, &types, &values, &a.ConfirmedFraud); err != nil {
+			return nil, fmt.Errorf("failed to scan linked account: %w", err)
+		}
+		a.Shared = make([]model.LinkEntity, 0, len(types))
+		for i := range types {
+			a.Shared = append(a.Shared, model.LinkEntity{Type: types[i], Value: values[i]})
+		}
+		linked = append(linked, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate linked accounts: %w", err)
+	}
+	return linked, nil
+}
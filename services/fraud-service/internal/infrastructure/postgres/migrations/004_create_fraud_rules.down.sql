@@ -0,0 +1,5 @@
+-- 004_create_fraud_rules.down.sql
+
+DROP TABLE IF EXISTS fraud_rule_hits;
+DROP TABLE IF EXISTS fraud_rule_versions;
+DROP TABLE IF EXISTS fraud_rules;
@@ -0,0 +1,49 @@
+-- 004_create_fraud_rules.up.sql
+-- Operator-managed fraud rules, their revision history and hit counters.
+
+CREATE TABLE IF NOT EXISTS fraud_rules (
+    id              UUID PRIMARY KEY,
+    tenant_id       UUID NOT NULL,
+    name            VARCHAR(200) NOT NULL,
+    description     TEXT NOT NULL DEFAULT '',
+    condition       TEXT NOT NULL,
+    signal          VARCHAR(100) NOT NULL,
+    score_impact    INTEGER NOT NULL CHECK (score_impact >= -100 AND score_impact <= 100),
+    mode            VARCHAR(20) NOT NULL DEFAULT 'ACTIVE',
+    version         INTEGER NOT NULL DEFAULT 1,
+    updated_by      UUID,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_fraud_rules_tenant_name ON fraud_rules(tenant_id, name);
+CREATE INDEX idx_fraud_rules_mode ON fraud_rules(mode) WHERE mode <> 'DISABLED';
+
+-- Every saved revision is kept so assessments can be traced to the exact
+-- rule definition that fired.
+CREATE TABLE IF NOT EXISTS fraud_rule_versions (
+    rule_id         UUID NOT NULL REFERENCES fraud_rules(id) ON DELETE CASCADE,
+    version         INTEGER NOT NULL,
+    tenant_id       UUID NOT NULL,
+    description     TEXT NOT NULL DEFAULT '',
+    condition       TEXT NOT NULL,
+    signal          VARCHAR(100) NOT NULL,
+    score_impact    INTEGER NOT NULL,
+    mode            VARCHAR(20) NOT NULL,
+    changed_by      UUID,
+    changed_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (rule_id, version)
+);
+
+CREATE INDEX idx_fraud_rule_versions_tenant_id ON fraud_rule_versions(tenant_id);
+
+-- Hit counters are keyed by rule rather than referencing fraud_rules, since
+-- built-in default rules also report hits.
+CREATE TABLE IF NOT EXISTS fraud_rule_hits (
+    tenant_id       UUID NOT NULL,
+    rule_id         UUID NOT NULL,
+    hits            BIGINT NOT NULL DEFAULT 0,
+    shadow_hits     BIGINT NOT NULL DEFAULT 0,
+    last_hit_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, rule_id)
+);
@@ -0,0 +1,4 @@
+-- 005_create_fraud_cases.down.sql
+
+DROP TABLE IF EXISTS fraud_case_notes;
+DROP TABLE IF EXISTS fraud_cases;
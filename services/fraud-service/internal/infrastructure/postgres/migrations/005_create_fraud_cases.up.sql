@@ -0,0 +1,36 @@
+-- 005_create_fraud_cases.up.sql
+-- Manual review queue for assessments that land in the REVIEW band.
+
+CREATE TABLE IF NOT EXISTS fraud_cases (
+    id              UUID PRIMARY KEY,
+    tenant_id       UUID NOT NULL,
+    assessment_id   UUID NOT NULL REFERENCES transaction_assessments(id),
+    transaction_id  UUID NOT NULL,
+    account_id      UUID NOT NULL,
+    risk_score      INTEGER NOT NULL,
+    risk_level      VARCHAR(20) NOT NULL,
+    status          VARCHAR(20) NOT NULL DEFAULT 'OPEN',
+    disposition     VARCHAR(30),
+    assignee_id     UUID,
+    sla_due_at      TIMESTAMPTZ NOT NULL,
+    resolved_at     TIMESTAMPTZ,
+    version         INTEGER NOT NULL DEFAULT 1,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_fraud_cases_assessment ON fraud_cases(tenant_id, assessment_id);
+CREATE INDEX idx_fraud_cases_queue ON fraud_cases(tenant_id, status, sla_due_at);
+CREATE INDEX idx_fraud_cases_assignee ON fraud_cases(tenant_id, assignee_id) WHERE assignee_id IS NOT NULL;
+
+-- Notes are append-only; they form the analyst audit trail for a case.
+CREATE TABLE IF NOT EXISTS fraud_case_notes (
+    id              UUID PRIMARY KEY,
+    case_id         UUID NOT NULL REFERENCES fraud_cases(id) ON DELETE CASCADE,
+    tenant_id       UUID NOT NULL,
+    author_id       UUID NOT NULL,
+    body            TEXT NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_fraud_case_notes_case_id ON fraud_case_notes(case_id, created_at);
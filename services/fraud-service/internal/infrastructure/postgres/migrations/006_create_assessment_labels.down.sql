@@ -0,0 +1,3 @@
+-- 006_create_assessment_labels.down.sql
+
+DROP TABLE IF EXISTS assessment_labels;
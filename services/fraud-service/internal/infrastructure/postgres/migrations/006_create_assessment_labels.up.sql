@@ -0,0 +1,14 @@
+-- 006_create_assessment_labels.up.sql
+-- Ground-truth fraud labels used to build ML training datasets.
+
+CREATE TABLE IF NOT EXISTS assessment_labels (
+    assessment_id   UUID PRIMARY KEY REFERENCES transaction_assessments(id) ON DELETE CASCADE,
+    tenant_id       UUID NOT NULL,
+    label           VARCHAR(20) NOT NULL,
+    source          VARCHAR(20) NOT NULL,
+    source_ref      VARCHAR(200) NOT NULL,
+    labeled_by      UUID,
+    labeled_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_assessment_labels_export ON assessment_labels(tenant_id, labeled_at);
@@ -0,0 +1,4 @@
+-- 007_add_assessment_model_version.down.sql
+
+DROP INDEX IF EXISTS idx_transaction_assessments_model_version;
+ALTER TABLE transaction_assessments DROP COLUMN IF EXISTS model_version;
@@ -0,0 +1,8 @@
+-- 007_add_assessment_model_version.up.sql
+-- Records which ML model version contributed to each assessment ('' = rules only).
+
+ALTER TABLE transaction_assessments
+    ADD COLUMN IF NOT EXISTS model_version VARCHAR(100) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_transaction_assessments_model_version
+    ON transaction_assessments(tenant_id, model_version) WHERE model_version <> '';
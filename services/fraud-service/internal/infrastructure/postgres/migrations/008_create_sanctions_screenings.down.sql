@@ -0,0 +1,3 @@
+-- 008_create_sanctions_screenings.down.sql
+
+DROP TABLE IF EXISTS sanctions_screenings;
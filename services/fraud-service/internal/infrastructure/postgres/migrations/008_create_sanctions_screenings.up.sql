@@ -0,0 +1,18 @@
+-- 008_create_sanctions_screenings.up.sql
+-- Append-only audit log of sanctions screenings, one row per screened transaction attempt.
+
+CREATE TABLE IF NOT EXISTS sanctions_screenings (
+    id              UUID PRIMARY KEY,
+    tenant_id       UUID NOT NULL,
+    assessment_id   UUID NOT NULL REFERENCES transaction_assessments(id) ON DELETE RESTRICT,
+    transaction_id  UUID NOT NULL,
+    subjects        JSONB NOT NULL,
+    matches         JSONB NOT NULL DEFAULT '[]',
+    hit             BOOLEAN NOT NULL,
+    list_version    VARCHAR(100) NOT NULL,
+    threshold       NUMERIC(4,3) NOT NULL,
+    screened_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_sanctions_screenings_transaction ON sanctions_screenings(tenant_id, transaction_id, screened_at);
+CREATE INDEX idx_sanctions_screenings_hits ON sanctions_screenings(tenant_id, screened_at) WHERE hit;
@@ -0,0 +1,3 @@
+-- 009_create_device_profiles.down.sql
+
+DROP TABLE IF EXISTS device_profiles;
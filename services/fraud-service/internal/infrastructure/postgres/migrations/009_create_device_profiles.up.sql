@@ -0,0 +1,17 @@
+-- 009_create_device_profiles.up.sql
+-- Devices each account has transacted from, keyed by device fingerprint.
+
+CREATE TABLE IF NOT EXISTS device_profiles (
+    id               UUID PRIMARY KEY,
+    tenant_id        UUID NOT NULL,
+    account_id       UUID NOT NULL,
+    fingerprint      VARCHAR(256) NOT NULL,
+    last_ip          VARCHAR(45) NOT NULL DEFAULT '',
+    last_ip_country  VARCHAR(2) NOT NULL DEFAULT '',
+    seen_count       INTEGER NOT NULL DEFAULT 1,
+    first_seen_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_seen_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, account_id, fingerprint)
+);
+
+CREATE INDEX idx_device_profiles_fingerprint ON device_profiles(tenant_id, fingerprint);
@@ -0,0 +1,4 @@
+-- 010_create_decision_policies.down.sql
+
+ALTER TABLE transaction_assessments DROP COLUMN IF EXISTS policy_version;
+DROP TABLE IF EXISTS decision_policies;
@@ -0,0 +1,24 @@
+-- 010_create_decision_policies.up.sql
+-- Per-tenant decision policy versions. Rows are append-only; the version
+-- history is the audit trail of threshold changes.
+
+CREATE TABLE IF NOT EXISTS decision_policies (
+    id              UUID PRIMARY KEY,
+    tenant_id       UUID NOT NULL,
+    version         INTEGER NOT NULL,
+    medium_at       INTEGER NOT NULL,
+    high_at         INTEGER NOT NULL,
+    critical_at     INTEGER NOT NULL,
+    review_at       INTEGER NOT NULL,
+    decline_at      INTEGER NOT NULL,
+    effective_from  TIMESTAMPTZ NOT NULL,
+    reason          TEXT NOT NULL,
+    created_by      UUID NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, version)
+);
+
+CREATE INDEX idx_decision_policies_effective ON decision_policies(tenant_id, effective_from);
+
+-- Policy version applied to each assessment; 0 means the global default.
+ALTER TABLE transaction_assessments ADD COLUMN IF NOT EXISTS policy_version INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,3 @@
+-- 011_create_account_links.down.sql
+
+DROP TABLE IF EXISTS account_links;
@@ -0,0 +1,16 @@
+-- 011_create_account_links.up.sql
+-- Link graph edges: the devices, IP addresses and destination accounts each
+-- account has used. Accounts sharing an entity are linked.
+
+CREATE TABLE IF NOT EXISTS account_links (
+    tenant_id       UUID NOT NULL,
+    account_id      UUID NOT NULL,
+    entity_type     VARCHAR(30) NOT NULL,
+    entity_value    VARCHAR(256) NOT NULL,
+    seen_count      INTEGER NOT NULL DEFAULT 1,
+    first_seen_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_seen_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, account_id, entity_type, entity_value)
+);
+
+CREATE INDEX idx_account_links_entity ON account_links(tenant_id, entity_type, entity_value);
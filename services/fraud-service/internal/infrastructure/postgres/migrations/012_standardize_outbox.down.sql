@@ -0,0 +1,8 @@
+-- 012_standardize_outbox.down.sql
+
+DROP INDEX IF EXISTS idx_outbox_unpublished;
+ALTER TABLE outbox DROP COLUMN IF EXISTS topic;
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS published BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE outbox SET published = TRUE WHERE published_at IS NOT NULL;
+
+CREATE INDEX idx_outbox_unpublished ON outbox(published, created_at) WHERE published = FALSE;
@@ -0,0 +1,10 @@
+-- 012_standardize_outbox.up.sql
+-- Align the outbox with the shared relay: published_at records publication
+-- and events are routed by topic.
+
+DROP INDEX IF EXISTS idx_outbox_unpublished;
+ALTER TABLE outbox DROP COLUMN IF EXISTS published;
+ALTER TABLE outbox ALTER COLUMN tenant_id DROP NOT NULL;
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS topic VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_outbox_unpublished ON outbox(created_at) WHERE published_at IS NULL;
@@ -0,0 +1,3 @@
+-- 013_create_auth_anomalies.down.sql
+
+DROP TABLE IF EXISTS auth_anomalies;
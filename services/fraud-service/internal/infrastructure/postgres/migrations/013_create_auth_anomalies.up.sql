@@ -0,0 +1,15 @@
+-- 013_create_auth_anomalies.up.sql
+-- Failed-authentication thresholds crossed at the gateway, consumed from
+-- bib.security.auth-events and correlated with transactions by IP address.
+
+CREATE TABLE IF NOT EXISTS auth_anomalies (
+    event_id        VARCHAR(64) PRIMARY KEY,
+    kind            VARCHAR(30) NOT NULL,
+    client_ip       VARCHAR(64) NOT NULL,
+    user_id         VARCHAR(64) NOT NULL DEFAULT '',
+    failures        INTEGER NOT NULL,
+    occurred_at     TIMESTAMPTZ NOT NULL,
+    recorded_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_auth_anomalies_ip ON auth_anomalies(client_ip, occurred_at);
@@ -0,0 +1,9 @@
+-- 014_create_aml_monitoring.down.sql
+
+DROP INDEX IF EXISTS idx_fraud_cases_aml_alerts;
+DELETE FROM fraud_cases WHERE assessment_id IS NULL;
+ALTER TABLE fraud_cases
+    DROP COLUMN IF EXISTS alert_transaction_ids,
+    DROP COLUMN IF EXISTS aml_scenario;
+ALTER TABLE fraud_cases ALTER COLUMN assessment_id SET NOT NULL;
+DROP TABLE IF EXISTS aml_transactions;
@@ -0,0 +1,28 @@
+-- 014_create_aml_monitoring.up.sql
+-- Payments consumed from bib.payment.orders for AML transaction monitoring,
+-- one row per account a payment moves funds on.
+
+CREATE TABLE IF NOT EXISTS aml_transactions (
+    event_id        VARCHAR(64) NOT NULL,
+    direction       VARCHAR(3) NOT NULL,
+    tenant_id       UUID NOT NULL,
+    account_id      UUID NOT NULL,
+    transaction_id  UUID NOT NULL,
+    amount          NUMERIC(19,4) NOT NULL,
+    currency        VARCHAR(3) NOT NULL,
+    country         VARCHAR(2) NOT NULL DEFAULT '',
+    occurred_at     TIMESTAMPTZ NOT NULL,
+    recorded_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, direction)
+);
+
+CREATE INDEX idx_aml_transactions_account ON aml_transactions(tenant_id, account_id, occurred_at);
+
+-- AML alerts open cases without an assessment.
+ALTER TABLE fraud_cases ALTER COLUMN assessment_id DROP NOT NULL;
+ALTER TABLE fraud_cases
+    ADD COLUMN IF NOT EXISTS aml_scenario VARCHAR(30) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS alert_transaction_ids UUID[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_fraud_cases_aml_alerts
+    ON fraud_cases(tenant_id, account_id, aml_scenario) WHERE aml_scenario <> '' AND status <> 'RESOLVED';
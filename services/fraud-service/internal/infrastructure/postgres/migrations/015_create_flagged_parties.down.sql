@@ -0,0 +1,3 @@
+-- 015_create_flagged_parties.down.sql
+
+DROP TABLE IF EXISTS flagged_parties;
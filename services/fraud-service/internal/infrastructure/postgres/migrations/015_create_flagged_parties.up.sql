@@ -0,0 +1,14 @@
+-- 015_create_flagged_parties.up.sql
+-- Customers with a sanctions screening hit, consumed from identity-service's
+-- identity.screening.hit events. Counterparty names are screened against
+-- them until an analyst clears the verification.
+
+CREATE TABLE IF NOT EXISTS flagged_parties (
+    tenant_id       UUID NOT NULL,
+    verification_id UUID NOT NULL,
+    name            VARCHAR(300) NOT NULL,
+    country         VARCHAR(2) NOT NULL DEFAULT '',
+    matches         TEXT[] NOT NULL DEFAULT '{}',
+    flagged_at      TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (tenant_id, verification_id)
+);
@@ -0,0 +1,7 @@
+-- 016_add_case_links_and_sla_breaches.down.sql
+
+DROP INDEX IF EXISTS idx_fraud_cases_sla_pending;
+DROP INDEX IF EXISTS idx_fraud_cases_linked_assessments;
+ALTER TABLE fraud_cases
+    DROP COLUMN IF EXISTS sla_breached_at,
+    DROP COLUMN IF EXISTS linked_assessment_ids;
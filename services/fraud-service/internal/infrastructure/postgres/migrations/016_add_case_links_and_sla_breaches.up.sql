@@ -0,0 +1,12 @@
+-- 016_add_case_links_and_sla_breaches.up.sql
+-- Analysts link further assessments to a case, and the SLA sweep records
+-- when an unresolved case passed its deadline so the breach is only
+-- announced once.
+
+ALTER TABLE fraud_cases
+    ADD COLUMN IF NOT EXISTS linked_assessment_ids UUID[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS sla_breached_at TIMESTAMPTZ;
+
+CREATE INDEX idx_fraud_cases_linked_assessments ON fraud_cases USING GIN (linked_assessment_ids);
+CREATE INDEX idx_fraud_cases_sla_pending
+    ON fraud_cases(sla_due_at) WHERE status <> 'RESOLVED' AND sla_breached_at IS NULL;
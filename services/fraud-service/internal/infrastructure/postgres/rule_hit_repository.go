@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+// RuleHitRepository implements port.RuleHitRepository using PostgreSQL.
+type RuleHitRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRuleHitRepository creates a new PostgreSQL-backed rule hit repository.
+func NewRuleHitRepository(pool *pgxpool.Pool) *RuleHitRepository {
+	return &RuleHitRepository{pool: pool}
+}
+
+// RecordHits increments the hit counters for each rule that fired.
+func (r *RuleHitRepository) RecordHits(ctx context.Context, tenantID uuid.UUID, hits []model.RuleHit) error {
+	batch := &pgx.Batch{}
+	for _, h := range hits {
+		live, shadow := 1, 0
+		if h.Shadow {
+			live, shadow = 0, 1
+		}
+		batch.Queue(`
+			INSERT INTO fraud_rule_hits (tenant_id, rule_id, hits, shadow_hits, last_hit_at)
+			VALUES ($1, $2, $3, $4, NOW())
+			ON CONFLICT (tenant_id, rule_id) DO UPDATE SET
+				hits = fraud_rule_hits.hits + EXCLUDED.hits,
+				shadow_hits = fraud_rule_hits.shadow_hits + EXCLUDED.shadow_hits,
+				last_hit_at = EXCLUDED.last_hit_at`,
+			tenantID, h.RuleID, live, shadow,
+		)
+	}
+
+	if err := r.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to record rule hits: %w", err)
+	}
+	return nil
+}
+
+// Stats returns aggregated hit counts for a tenant's rules.
+func (r *RuleHitRepository) Stats(ctx context.Context, tenantID uuid.UUID) ([]model.RuleHitStats, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT rule_id, hits, shadow_hits, last_hit_at FROM fraud_rule_hits WHERE tenant_id = $1`,
+		tenantID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rule hits: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []model.RuleHitStats
+	for rows.Next() {
+		var s model.RuleHitStats
+		if err := rows.Scan(&s.RuleID, &s.Hits, &s.ShadowHits, &s.LastHitAt); err != nil {
+			return nil, fmt.Errorf("failed to scan rule hits: %w", err)
+		}
+		stats = append(stats, s)
+	}
+	return stats, rows.Err()
+}
@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// RuleRepository implements port.RuleRepository using PostgreSQL.
+type RuleRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewRuleRepository creates a new PostgreSQL-backed fraud rule repository.
+func NewRuleRepository(pool *pgxpool.Pool) *RuleRepository {
+	return &RuleRepository{pool: pool}
+}
+
+const ruleColumns = `
+	id, tenant_id, name, description, condition, signal,
+	score_impact, mode, version, updated_by, created_at, updated_at
+`
+
+// Save upserts the rule and records a snapshot of the current version.
+func (r *RuleRepository) Save(ctx context.Context, rule *model.FraudRule) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO fraud_rules (`+ruleColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (id) DO UPDATE SET
+			description = EXCLUDED.description,
+			condition = EXCLUDED.condition,
+			signal = EXCLUDED.signal,
+			score_impact = EXCLUDED.score_impact,
+			mode = EXCLUDED.mode,
+			version = EXCLUDED.version,
+			updated_by = EXCLUDED.updated_by,
+			updated_at = EXCLUDED.updated_at
+		WHERE fraud_rules.version < EXCLUDED.version`,
+		rule.ID(), rule.TenantID(), rule.Name(), rule.Description(), rule.Condition(), rule.Signal(),
+		rule.ScoreImpact(), rule.Mode().String(), rule.Version(), rule.UpdatedBy(),
+		rule.CreatedAt(), rule.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save rule: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO fraud_rule_versions (
+			rule_id, version, tenant_id, description, condition, signal,
+			score_impact, mode, changed_by, changed_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (rule_id, version) DO NOTHING`,
+		rule.ID(), rule.Version(), rule.TenantID(), rule.Description(), rule.Condition(), rule.Signal(),
+		rule.ScoreImpact(), rule.Mode().String(), rule.UpdatedBy(), rule.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save rule version: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a rule by its unique identifier.
+func (r *RuleRepository) FindByID(ctx context.Context, tenantID, id uuid.UUID) (*model.FraudRule, error) {
+	row := r.pool.QueryRow(ctx,
+		`SELECT `+ruleColumns+` FROM fraud_rules WHERE tenant_id = $1 AND id = $2`,
+		tenantID, id,
+	)
+	rule, err := scanRule(row)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return rule, err
+}
+
+// ListByTenant retrieves all rules configured for a tenant.
+func (r *RuleRepository) ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*model.FraudRule, error) {
+	return r.queryRules(ctx,
+		`SELECT `+ruleColumns+` FROM fraud_rules WHERE tenant_id = $1 ORDER BY created_at, name`,
+		tenantID,
+	)
+}
+
+// ListEvaluated retrieves ACTIVE and SHADOW rules across all tenants.
+func (r *RuleRepository) ListEvaluated(ctx context.Context) ([]*model.FraudRule, error) {
+	return r.queryRules(ctx,
+		`SELECT `+ruleColumns+` FROM fraud_rules WHERE mode <> 'DISABLED' ORDER BY tenant_id, created_at, name`,
+	)
+}
+
+// ListVersions retrieves the revision history of a rule, newest first.
+func (r *RuleRepository) ListVersions(ctx context.Context, tenantID, ruleID uuid.UUID) ([]model.RuleVersion, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT version, description, condition, signal, score_impact, mode, changed_by, changed_at
+		FROM fraud_rule_versions
+		WHERE tenant_id = $1 AND rule_id = $2
+		ORDER BY version DESC`,
+		tenantID, ruleID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rule versions: %w", err)
+	}
+	defer rows.Close()
+
+	var versions []model.RuleVersion
+	for rows.Next() {
+		var (
+			v         model.RuleVersion
+			modeStr   string
+			changedBy *uuid.UUID
+		)
+		if err := rows.Scan(
+			&v.Version, &v.Definition.Description, &v.Definition.Condition, &v.Definition.Signal,
+			&v.Definition.ScoreImpact, &modeStr, &changedBy, &v.ChangedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan rule version: %w", err)
+		}
+		mode, err := valueobject.RuleModeFromString(modeStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse rule mode: %w", err)
+		}
+		v.Definition.Mode = mode
+		v.RuleID = ruleID
+		if changedBy != nil {
+			v.ChangedBy = *changedBy
+		}
+		versions = append(versions, v)
+	}
+	return versions, rows.Err()
+}
+
+func (r *RuleRepository) queryRules(ctx context.Context, query string, args ...any) ([]*model.FraudRule, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []*model.FraudRule
+	for rows.Next() {
+		rule, err := scanRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+
+func scanRule(row pgx.Row) (*model.FraudRule, error) {
+	var (
+		id, tenantID uuid.UUID
+		name         string
+		def          model.RuleDefinition
+		modeStr      string
+		version      int
+		updatedBy    *uuid.UUID
+		createdAt    time.Time
+		updatedAt    time.Time
+	)
+	err := row.Scan(
+		&id, &tenantID, &name, &def.Description, &def.Condition, &def.Signal,
+		&def.ScoreImpact, &modeStr, &version, &updatedBy, &createdAt, &updatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan rule: %w", err)
+	}
+
+	mode, err := valueobject.RuleModeFromString(modeStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rule mode: %w", err)
+	}
+	def.Mode = mode
+
+	var updatedByVal uuid.UUID
+	if updatedBy != nil {
+		updatedByVal = *updatedBy
+	}
+
+	return model.ReconstructFraudRule(id, tenantID, name, def, version, updatedByVal, createdAt, updatedAt)
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+// ScreeningRepository implements port.ScreeningRepository using PostgreSQL.
+type ScreeningRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewScreeningRepository creates a new PostgreSQL-backed screening repository.
+func NewScreeningRepository(pool *pgxpool.Pool) *ScreeningRepository {
+	return &ScreeningRepository{pool: pool}
+}
+
+// Save appends a screening record to the audit log.
+func (r *ScreeningRepository) Save(ctx context.Context, rec *model.ScreeningRecord) error {
+	subjects, err := json.Marshal(rec.Subjects())
+	if err != nil {
+		return fmt.Errorf("failed to marshal screening subjects: %w", err)
+	}
+	matches, err := json.Marshal(rec.Matches())
+	if err != nil {
+		return fmt.Errorf("failed to marshal screening matches: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, `
+		INSERT INTO sanctions_screenings (
+			id, tenant_id, assessment_id, transaction_id, subjects, matches,
+			hit, list_version, threshold, screened_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		rec.ID(), rec.TenantID(), rec.AssessmentID(), rec.TransactionID(), subjects, matches,
+		rec.Hit(), rec.ListVersion(), rec.Threshold(), rec.ScreenedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save screening: %w", err)
+	}
+	return nil
+}
+
+// ListByTransactionID returns every screening of a transaction, oldest first.
+func (r *ScreeningRepository) ListByTransactionID(ctx context.Context, tenantID, transactionID uuid.UUID) ([]*model.ScreeningRecord, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, assessment_id, subjects, matches, list_version, threshold, screened_at
+		FROM sanctions_screenings
+		WHERE tenant_id = $1 AND transaction_id = $2
+		ORDER BY screened_at`,
+		tenantID, transactionID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list screenings: %w", err)
+	}
+	defer rows.Close()
+
+	var records []*model.ScreeningRecord
+	for rows.Next() {
+		var (
+			id, assessmentID      uuid.UUID
+			subjectsRaw, matchRaw []byte
+			listVersion           string
+			threshold             float64
+			screenedAt            time.Time
+		)
+		if err := rows.Scan(&id, &assessmentID, &subjectsRaw, &matchRaw, &listVersion, &threshold, &screenedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan screening: %w", err)
+		}
+
+		var subjects []model.ScreeningSubject
+		if err := json.Unmarshal(subjectsRaw, &subjects); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal screening subjects: %w", err)
+		}
+		var matches []model.ScreeningMatch
+		if err := json.Unmarshal(matchRaw, &matches); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal screening matches: %w", err)
+		}
+
+		records = append(records, model.ReconstructScreeningRecord(
+			id, tenantID, assessmentID, transactionID, subjects, matches, listVersion, threshold, screenedAt,
+		))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate screenings: %w", err)
+	}
+	return records, nil
+}
@@ -0,0 +1,100 @@
+// Package watchlist provides WatchlistSource adapters.
+package watchlist
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+)
+
+// listFiles maps each supported list to its file name in the source directory.
+var listFiles = []struct {
+	list valueobject.Watchlist
+	file string
+}{
+	{valueobject.WatchlistOFAC, "ofac.json"},
+	{valueobject.WatchlistUN, "un.json"},
+	{valueobject.WatchlistEU, "eu.json"},
+}
+
+// fileEntry is the on-disk format of a list entry. The files are produced
+// by the list sync job, which normalises the OFAC SDN, UN consolidated and
+// EU consolidated publications into this shape.
+type fileEntry struct {
+	ID      string   `json:"id"`
+	Type    string   `json:"type"`
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
+
+// FileSource implements port.WatchlistSource by reading one JSON file per
+// list from a directory. Lists without a file are skipped.
+type FileSource struct {
+	dir string
+}
+
+// NewFileSource creates a FileSource reading from dir.
+func NewFileSource(dir string) *FileSource {
+	return &FileSource{dir: dir}
+}
+
+// Fetch reads all list files. The returned version is a digest of their
+// contents, so it changes exactly when a list is republished.
+func (s *FileSource) Fetch(_ context.Context) ([]model.WatchlistEntry, string, error) {
+	var entries []model.WatchlistEntry
+	digest := sha256.New()
+	loaded := 0
+
+	for _, lf := range listFiles {
+		data, err := os.ReadFile(filepath.Join(s.dir, lf.file))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read %s list: %w", lf.list.String(), err)
+		}
+
+		var raw []fileEntry
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, "", fmt.Errorf("failed to parse %s list: %w", lf.list.String(), err)
+		}
+		for i, e := range raw {
+			entryType := strings.ToUpper(e.Type)
+			if entryType == "" {
+				entryType = model.EntryTypeParty
+			}
+			if entryType != model.EntryTypeParty && entryType != model.EntryTypeJurisdiction {
+				return nil, "", fmt.Errorf("%s list entry %d: invalid type %q", lf.list.String(), i, e.Type)
+			}
+			if strings.TrimSpace(e.Name) == "" {
+				return nil, "", fmt.Errorf("%s list entry %d: name is required", lf.list.String(), i)
+			}
+			entries = append(entries, model.WatchlistEntry{
+				List:    lf.list,
+				EntryID: e.ID,
+				Type:    entryType,
+				Name:    e.Name,
+				Aliases: e.Aliases,
+			})
+		}
+
+		digest.Write([]byte(lf.file))
+		digest.Write(data)
+		loaded++
+	}
+
+	if loaded == 0 {
+		return nil, "", fmt.Errorf("no watchlist files found in %s", s.dir)
+	}
+	return entries, hex.EncodeToString(digest.Sum(nil))[:16], nil
+}
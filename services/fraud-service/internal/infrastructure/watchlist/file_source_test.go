@@ -0,0 +1,63 @@
+package watchlist_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/infrastructure/watchlist"
+)
+
+func writeList(t *testing.T, dir, name, body string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(body), 0o600))
+}
+
+func TestFileSource_Fetch(t *testing.T) {
+	t.Run("loads available lists and versions by content", func(t *testing.T) {
+		dir := t.TempDir()
+		writeList(t, dir, "ofac.json", `[
+			{"id": "SDN-1", "name": "Ivan Petrov", "aliases": ["Ivan Petroff"]},
+			{"id": "CU", "type": "jurisdiction", "name": "CU"}
+		]`)
+		writeList(t, dir, "un.json", `[{"id": "QDi.1", "type": "PARTY", "name": "Acme Holdings"}]`)
+
+		src := watchlist.NewFileSource(dir)
+		entries, version, err := src.Fetch(context.Background())
+
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		assert.Equal(t, "OFAC", entries[0].List.String())
+		assert.Equal(t, model.EntryTypeParty, entries[0].Type)
+		assert.Equal(t, model.EntryTypeJurisdiction, entries[1].Type)
+		assert.Equal(t, "UN", entries[2].List.String())
+		assert.NotEmpty(t, version)
+
+		_, same, err := src.Fetch(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, version, same)
+
+		writeList(t, dir, "eu.json", `[{"id": "EU.1", "name": "Globex"}]`)
+		_, changed, err := src.Fetch(context.Background())
+		require.NoError(t, err)
+		assert.NotEqual(t, version, changed)
+	})
+
+	t.Run("fails when no list files exist", func(t *testing.T) {
+		_, _, err := watchlist.NewFileSource(t.TempDir()).Fetch(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects malformed entries", func(t *testing.T) {
+		dir := t.TempDir()
+		writeList(t, dir, "ofac.json", `[{"id": "X", "type": "VESSEL", "name": "Sea Star"}]`)
+
+		_, _, err := watchlist.NewFileSource(dir).Fetch(context.Background())
+		assert.Error(t, err)
+	})
+}
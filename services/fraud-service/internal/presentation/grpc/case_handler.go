@@ -0,0 +1,330 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	fraudv1 "github.com/bibbank/bib/api/gen/go/bib/fraud/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+)
+
+// Compile-time assertion that FraudCaseHandler implements FraudCaseServiceServer.
+var _ fraudv1.FraudCaseServiceServer = (*FraudCaseHandler)(nil)
+
+// FraudCaseHandler implements the gRPC FraudCaseServiceServer interface,
+// the ops console API for the manual review queue.
+type FraudCaseHandler struct {
+	fraudv1.UnimplementedFraudCaseServiceServer
+	listCases    *usecase.ListCases
+	getCase      *usecase.GetCase
+	assignCase   *usecase.AssignCase
+	addCaseNote  *usecase.AddCaseNote
+	escalateCase *usecase.EscalateCase
+	resolveCase  *usecase.ResolveCase
+	linkCase     *usecase.LinkCaseAssessment
+	logger       *slog.Logger
+}
+
+// NewFraudCaseHandler creates a new gRPC case management handler.
+func NewFraudCaseHandler(
+	listCases *usecase.ListCases,
+	getCase *usecase.GetCase,
+	assignCase *usecase.AssignCase,
+	addCaseNote *usecase.AddCaseNote,
+	escalateCase *usecase.EscalateCase,
+	resolveCase *usecase.ResolveCase,
+	linkCase *usecase.LinkCaseAssessment,
+	logger *slog.Logger,
+) *FraudCaseHandler {
+	return &FraudCaseHandler{
+		listCases:    listCases,
+		getCase:      getCase,
+		assignCase:   assignCase,
+		addCaseNote:  addCaseNote,
+		escalateCase: escalateCase,
+		resolveCase:  resolveCase,
+		linkCase:     linkCase,
+		logger:       logger,
+	}
+}
+
+// ListCases handles a review queue listing request.
+func (h *FraudCaseHandler) ListCases(ctx context.Context, req *fraudv1.ListCasesRequest) (*fraudv1.ListCasesResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var assigneeID uuid.UUID
+	if req.GetAssigneeId() != "" {
+		assigneeID, err = uuid.Parse(req.GetAssigneeId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid assignee_id: %v", err)
+		}
+	}
+
+	result, err := h.listCases.Execute(ctx, dto.ListCasesRequest{
+		TenantID:    tenantID,
+		Status:      enumName(req.GetStatus().String(), "CASE_STATUS_"),
+		AssigneeID:  assigneeID,
+		OverdueOnly: req.GetOverdueOnly(),
+		PageSize:    int(req.GetPageSize()),
+		Offset:      int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to list cases", err)
+	}
+
+	cases := make([]*fraudv1.FraudCase, 0, len(result.Cases))
+	for _, c := range result.Cases {
+		cases = append(cases, toCaseMsg(c))
+	}
+	return &fraudv1.ListCasesResponse{
+		Cases:      cases,
+		TotalCount: int32(result.TotalCount), //nolint:gosec // bounded by the case count
+	}, nil
+}
+
+// GetCase handles a request for a single case with its notes.
+func (h *FraudCaseHandler) GetCase(ctx context.Context, req *fraudv1.GetCaseRequest) (*fraudv1.CaseResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	caseID, err := parseCaseID(req.GetCaseId())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.getCase.Execute(ctx, dto.GetCaseRequest{TenantID: tenantID, CaseID: caseID})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to get case", err)
+	}
+	return &fraudv1.CaseResponse{Case: toCaseMsg(result)}, nil
+}
+
+// AssignCase handles a request to assign a case to an analyst.
+func (h *FraudCaseHandler) AssignCase(ctx context.Context, req *fraudv1.AssignCaseRequest) (*fraudv1.CaseResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	caseID, err := parseCaseID(req.GetCaseId())
+	if err != nil {
+		return nil, err
+	}
+
+	claims, _ := auth.ClaimsFromContext(ctx)
+
+	analystID := claims.UserID
+	if req.GetAnalystId() != "" {
+		analystID, err = uuid.Parse(req.GetAnalystId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid analyst_id: %v", err)
+		}
+	}
+
+	result, err := h.assignCase.Execute(ctx, dto.AssignCaseRequest{
+		TenantID:  claims.TenantID,
+		CaseID:    caseID,
+		AnalystID: analystID,
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to assign case", err)
+	}
+	return &fraudv1.CaseResponse{Case: toCaseMsg(result)}, nil
+}
+
+// AddCaseNote handles a request to add an analyst note to a case.
+func (h *FraudCaseHandler) AddCaseNote(ctx context.Context, req *fraudv1.AddCaseNoteRequest) (*fraudv1.CaseResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	caseID, err := parseCaseID(req.GetCaseId())
+	if err != nil {
+		return nil, err
+	}
+
+	claims, _ := auth.ClaimsFromContext(ctx)
+
+	result, err := h.addCaseNote.Execute(ctx, dto.AddCaseNoteRequest{
+		TenantID: claims.TenantID,
+		CaseID:   caseID,
+		AuthorID: claims.UserID,
+		Body:     req.GetBody(),
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to add case note", err)
+	}
+	return &fraudv1.CaseResponse{Case: toCaseMsg(result)}, nil
+}
+
+// EscalateCase handles a request to escalate a case to senior review.
+func (h *FraudCaseHandler) EscalateCase(ctx context.Context, req *fraudv1.EscalateCaseRequest) (*fraudv1.CaseResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	caseID, err := parseCaseID(req.GetCaseId())
+	if err != nil {
+		return nil, err
+	}
+
+	claims, _ := auth.ClaimsFromContext(ctx)
+
+	result, err := h.escalateCase.Execute(ctx, dto.EscalateCaseRequest{
+		TenantID: claims.TenantID,
+		CaseID:   caseID,
+		ActorID:  claims.UserID,
+		Reason:   req.GetReason(),
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to escalate case", err)
+	}
+	return &fraudv1.CaseResponse{Case: toCaseMsg(result)}, nil
+}
+
+// ResolveCase handles a request to close a case with a final disposition.
+func (h *FraudCaseHandler) ResolveCase(ctx context.Context, req *fraudv1.ResolveCaseRequest) (*fraudv1.CaseResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	caseID, err := parseCaseID(req.GetCaseId())
+	if err != nil {
+		return nil, err
+	}
+
+	claims, _ := auth.ClaimsFromContext(ctx)
+
+	result, err := h.resolveCase.Execute(ctx, dto.ResolveCaseRequest{
+		TenantID:    claims.TenantID,
+		CaseID:      caseID,
+		ActorID:     claims.UserID,
+		Disposition: enumName(req.GetDisposition().String(), "CASE_DISPOSITION_"),
+		Note:        req.GetNote(),
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to resolve case", err)
+	}
+	return &fraudv1.CaseResponse{Case: toCaseMsg(result)}, nil
+}
+
+// LinkCaseAssessment handles a request to add an assessment to a case.
+func (h *FraudCaseHandler) LinkCaseAssessment(ctx context.Context, req *fraudv1.LinkCaseAssessmentRequest) (*fraudv1.CaseResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	caseID, err := parseCaseID(req.GetCaseId())
+	if err != nil {
+		return nil, err
+	}
+	assessmentID, err := uuid.Parse(req.GetAssessmentId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid assessment_id: %v", err)
+	}
+
+	claims, _ := auth.ClaimsFromContext(ctx)
+
+	result, err := h.linkCase.Execute(ctx, dto.LinkCaseAssessmentRequest{
+		TenantID:     claims.TenantID,
+		CaseID:       caseID,
+		AssessmentID: assessmentID,
+		ActorID:      claims.UserID,
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to link assessment to case", err)
+	}
+	return &fraudv1.CaseResponse{Case: toCaseMsg(result)}, nil
+}
+
+func parseCaseID(s string) (uuid.UUID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid case_id: %v", err)
+	}
+	return id, nil
+}
+
+func toCaseMsg(c dto.CaseResponse) *fraudv1.FraudCase {
+	notes := make([]*fraudv1.FraudCaseNote, 0, len(c.Notes))
+	for _, n := range c.Notes {
+		notes = append(notes, &fraudv1.FraudCaseNote{
+			Id:        n.ID.String(),
+			AuthorId:  n.AuthorID.String(),
+			Body:      n.Body,
+			CreatedAt: timestamppb.New(n.CreatedAt),
+		})
+	}
+
+	msg := &fraudv1.FraudCase{
+		Id:            c.ID.String(),
+		TransactionId: c.TransactionID.String(),
+		AccountId:     c.AccountID.String(),
+		RiskScore:     int32(c.RiskScore), //nolint:gosec // risk scores are 0-100
+		RiskLevel:     fraudv1.RiskLevel(fraudv1.RiskLevel_value["RISK_LEVEL_"+c.RiskLevel]),
+		AmlScenario:   c.AMLScenario,
+		Status:        fraudv1.CaseStatus(fraudv1.CaseStatus_value["CASE_STATUS_"+c.Status]),
+		Disposition:   fraudv1.CaseDisposition(fraudv1.CaseDisposition_value["CASE_DISPOSITION_"+c.Disposition]),
+		SlaDueAt:      timestamppb.New(c.SLADueAt),
+		SlaBreached:   c.SLABreached,
+		Notes:         notes,
+		Version:       int32(c.Version), //nolint:gosec // case versions are small
+		CreatedAt:     timestamppb.New(c.CreatedAt),
+		UpdatedAt:     timestamppb.New(c.UpdatedAt),
+	}
+	if c.AssessmentID != uuid.Nil {
+		msg.AssessmentId = c.AssessmentID.String()
+	}
+	for _, id := range c.AlertTxnIDs {
+		msg.AlertTransactionIds = append(msg.AlertTransactionIds, id.String())
+	}
+	for _, id := range c.LinkedIDs {
+		msg.LinkedAssessmentIds = append(msg.LinkedAssessmentIds, id.String())
+	}
+	if c.AssigneeID != uuid.Nil {
+		msg.AssigneeId = c.AssigneeID.String()
+	}
+	if c.ResolvedAt != nil {
+		msg.ResolvedAt = timestamppb.New(*c.ResolvedAt)
+	}
+	return msg
+}
@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	fraudv1 "github.com/bibbank/bib/api/gen/go/bib/fraud/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	repo := &mockAssessmentRepo{}
+	h := buildHandlerWithRepo(repo)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { fraudv1.RegisterFraudServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-fraud-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) {
+			// The repository reports a missing assessment as nil.
+			repo.findByIDFunc = func(context.Context, uuid.UUID, uuid.UUID) (*model.TransactionAssessment, error) {
+				return nil, nil
+			}
+		},
+		"a transfer was assessed": func(*testing.T) {
+			assessment := createTestAssessment()
+			repo.findByIDFunc = func(context.Context, uuid.UUID, uuid.UUID) (*model.TransactionAssessment, error) {
+				return assessment, nil
+			}
+		},
+	})
+}
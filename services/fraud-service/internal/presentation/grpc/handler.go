@@ -3,12 +3,17 @@ package grpc
 import (
 	"context"
 	"log/slog"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	fraudv1 "github.com/bibbank/bib/api/gen/go/bib/fraud/v1"
 	"github.com/bibbank/bib/pkg/auth"
 	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
 	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
@@ -38,13 +43,15 @@ func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
 }
 
 // Compile-time assertion that FraudServiceHandler implements FraudServiceServer.
-var _ FraudServiceServer = (*FraudServiceHandler)(nil)
+var _ fraudv1.FraudServiceServer = (*FraudServiceHandler)(nil)
 
 // FraudServiceHandler implements the gRPC FraudServiceServer interface.
 type FraudServiceHandler struct {
-	UnimplementedFraudServiceServer
+	fraudv1.UnimplementedFraudServiceServer
 	assessTransaction *usecase.AssessTransaction
 	getAssessment     *usecase.GetAssessment
+	listAssessments   *usecase.ListAssessments
+	getMetrics        *usecase.GetAssessmentMetrics
 	logger            *slog.Logger
 }
 
@@ -52,59 +59,21 @@ type FraudServiceHandler struct {
 func NewFraudServiceHandler(
 	assessTransaction *usecase.AssessTransaction,
 	getAssessment *usecase.GetAssessment,
+	listAssessments *usecase.ListAssessments,
+	getMetrics *usecase.GetAssessmentMetrics,
 	logger *slog.Logger,
 ) *FraudServiceHandler {
 	return &FraudServiceHandler{
 		assessTransaction: assessTransaction,
 		getAssessment:     getAssessment,
+		listAssessments:   listAssessments,
+		getMetrics:        getMetrics,
 		logger:            logger,
 	}
 }
 
-// Proto-aligned request/response message types.
-
-// AssessTransactionRequest represents the proto AssessTransactionRequest message.
-type AssessTransactionRequest struct {
-	Metadata        map[string]string `json:"metadata"`
-	TenantID        string            `json:"tenant_id"`
-	TransactionID   string            `json:"transaction_id"`
-	AccountID       string            `json:"account_id"`
-	Amount          string            `json:"amount"`
-	Currency        string            `json:"currency"`
-	TransactionType string            `json:"transaction_type"`
-}
-
-// AssessTransactionResponse represents the proto AssessTransactionResponse message.
-type AssessTransactionResponse struct {
-	AssessmentID string   `json:"assessment_id"`
-	RiskLevel    string   `json:"risk_level"`
-	Decision     string   `json:"decision"`
-	Signals      []string `json:"signals"`
-	RiskScore    int      `json:"risk_score"`
-}
-
-// GetAssessmentRequest represents the proto GetAssessmentRequest message.
-type GetAssessmentRequest struct {
-	TenantID     string `json:"tenant_id"`
-	AssessmentID string `json:"assessment_id"`
-}
-
-// GetAssessmentResponse represents the proto GetAssessmentResponse message.
-type GetAssessmentResponse struct {
-	AssessmentID    string   `json:"assessment_id"`
-	TransactionID   string   `json:"transaction_id"`
-	AccountID       string   `json:"account_id"`
-	Amount          string   `json:"amount"`
-	Currency        string   `json:"currency"`
-	TransactionType string   `json:"transaction_type"`
-	RiskLevel       string   `json:"risk_level"`
-	Decision        string   `json:"decision"`
-	Signals         []string `json:"signals"`
-	RiskScore       int      `json:"risk_score"`
-}
-
 // AssessTransaction handles a transaction assessment request.
-func (h *FraudServiceHandler) AssessTransaction(ctx context.Context, req *AssessTransactionRequest) (*AssessTransactionResponse, error) {
+func (h *FraudServiceHandler) AssessTransaction(ctx context.Context, req *fraudv1.AssessTransactionRequest) (*fraudv1.AssessTransactionResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -118,21 +87,21 @@ func (h *FraudServiceHandler) AssessTransaction(ctx context.Context, req *Assess
 		return nil, err
 	}
 
-	transactionID, err := uuid.Parse(req.TransactionID)
+	transactionID, err := uuid.Parse(req.GetTransactionId())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction_id: %v", err)
 	}
 
-	accountID, err := uuid.Parse(req.AccountID)
+	accountID, err := uuid.Parse(req.GetAccountId())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid account_id: %v", err)
 	}
 
-	amount, err := decimal.NewFromString(req.Amount)
+	amount, err := decimal.NewFromString(req.GetAmount().GetAmount())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
 	}
-	currency := req.Currency
+	currency := req.GetAmount().GetCurrency()
 
 	h.logger.Info("assessing transaction",
 		slog.String("tenant_id", tenantID.String()),
@@ -140,13 +109,18 @@ func (h *FraudServiceHandler) AssessTransaction(ctx context.Context, req *Assess
 	)
 
 	result, err := h.assessTransaction.Execute(ctx, dto.AssessTransactionRequest{
-		TenantID:        tenantID,
-		TransactionID:   transactionID,
-		AccountID:       accountID,
-		Amount:          amount,
-		Currency:        currency,
-		TransactionType: req.TransactionType,
-		Metadata:        req.Metadata,
+		TenantID:           tenantID,
+		TransactionID:      transactionID,
+		AccountID:          accountID,
+		Amount:             amount,
+		Currency:           currency,
+		TransactionType:    req.GetTransactionType(),
+		CounterpartyName:   req.GetCounterpartyName(),
+		DestinationCountry: req.GetDestinationCountry(),
+		DeviceFingerprint:  req.GetDeviceFingerprint(),
+		IPAddress:          req.GetIpAddress(),
+		DestinationAccount: req.GetDestinationAccount(),
+		Metadata:           req.GetMetadata(),
 	})
 	if err != nil {
 		h.logger.Error("failed to assess transaction",
@@ -156,17 +130,26 @@ func (h *FraudServiceHandler) AssessTransaction(ctx context.Context, req *Assess
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	return &AssessTransactionResponse{
-		AssessmentID: result.ID.String(),
-		RiskLevel:    result.RiskLevel,
-		Decision:     result.Decision,
-		Signals:      result.RiskSignals,
-		RiskScore:    result.RiskScore,
+	hits := make([]*fraudv1.RuleHit, 0, len(result.RuleHits))
+	for _, hit := range result.RuleHits {
+		hits = append(hits, &fraudv1.RuleHit{
+			RuleId:      hit.RuleID.String(),
+			RuleName:    hit.RuleName,
+			Signal:      hit.Signal,
+			ScoreImpact: int32(hit.ScoreImpact), //nolint:gosec // score impacts are small
+			RuleVersion: int32(hit.RuleVersion), //nolint:gosec // rule versions are small
+			Shadow:      hit.Shadow,
+		})
+	}
+
+	return &fraudv1.AssessTransactionResponse{
+		Assessment: toTransactionAssessmentMsg(result),
+		RuleHits:   hits,
 	}, nil
 }
 
 // GetAssessment handles a get assessment request.
-func (h *FraudServiceHandler) GetAssessment(ctx context.Context, req *GetAssessmentRequest) (*GetAssessmentResponse, error) {
+func (h *FraudServiceHandler) GetAssessment(ctx context.Context, req *fraudv1.GetAssessmentRequest) (*fraudv1.GetAssessmentResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -180,7 +163,7 @@ func (h *FraudServiceHandler) GetAssessment(ctx context.Context, req *GetAssessm
 		return nil, err
 	}
 
-	assessmentID, err := uuid.Parse(req.AssessmentID)
+	assessmentID, err := uuid.Parse(req.GetId())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid assessment_id: %v", err)
 	}
@@ -190,19 +173,176 @@ func (h *FraudServiceHandler) GetAssessment(ctx context.Context, req *GetAssessm
 		AssessmentID: assessmentID,
 	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, statusFromError(h.logger, "get assessment failed", err)
+	}
+
+	return &fraudv1.GetAssessmentResponse{Assessment: toTransactionAssessmentMsg(result)}, nil
+}
+
+// ListAssessments handles an assessment search request.
+func (h *FraudServiceHandler) ListAssessments(ctx context.Context, req *fraudv1.ListAssessmentsRequest) (*fraudv1.ListAssessmentsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var accountID uuid.UUID
+	if req.GetAccountId() != "" {
+		accountID, err = uuid.Parse(req.GetAccountId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid account_id: %v", err)
+		}
+	}
+	from, err := optionalTime("from", req.GetFrom())
+	if err != nil {
+		return nil, err
+	}
+	to, err := optionalTime("to", req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.listAssessments.Execute(ctx, dto.ListAssessmentsRequest{
+		TenantID:  tenantID,
+		AccountID: accountID,
+		Decision:  enumName(req.GetDecision().String(), "ASSESSMENT_DECISION_"),
+		RiskLevel: enumName(req.GetRiskLevel().String(), "RISK_LEVEL_"),
+		From:      from,
+		To:        to,
+		PageSize:  int(req.GetPageSize()),
+		Offset:    int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to list assessments", err)
+	}
+
+	assessments := make([]*fraudv1.TransactionAssessment, 0, len(result.Assessments))
+	for _, a := range result.Assessments {
+		assessments = append(assessments, toTransactionAssessmentMsg(a))
+	}
+	return &fraudv1.ListAssessmentsResponse{
+		Assessments: assessments,
+		TotalCount:  int32(result.TotalCount), //nolint:gosec // bounded by the assessment count
+	}, nil
+}
+
+// GetAssessmentMetrics handles a request for aggregate decision metrics.
+func (h *FraudServiceHandler) GetAssessmentMetrics(ctx context.Context, req *fraudv1.GetAssessmentMetricsRequest) (*fraudv1.GetAssessmentMetricsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from, err := optionalTime("from", req.GetFrom())
+	if err != nil {
+		return nil, err
+	}
+	to, err := optionalTime("to", req.GetTo())
+	if err != nil {
+		return nil, err
 	}
 
-	return &GetAssessmentResponse{
-		AssessmentID:    result.ID.String(),
-		TransactionID:   result.TransactionID.String(),
-		AccountID:       result.AccountID.String(),
-		Amount:          result.Amount,
-		Currency:        result.Currency,
-		TransactionType: result.TransactionType,
-		RiskLevel:       result.RiskLevel,
-		Decision:        result.Decision,
-		Signals:         result.RiskSignals,
-		RiskScore:       result.RiskScore,
+	result, err := h.getMetrics.Execute(ctx, dto.AssessmentMetricsRequest{
+		TenantID: tenantID,
+		From:     from,
+		To:       to,
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to get assessment metrics", err)
+	}
+
+	histogram := make([]*fraudv1.ScoreBand, 0, len(result.ScoreHistogram))
+	for _, b := range result.ScoreHistogram {
+		histogram = append(histogram, &fraudv1.ScoreBand{
+			Min:   int32(b.Min),   //nolint:gosec // risk scores are 0-100
+			Max:   int32(b.Max),   //nolint:gosec // risk scores are 0-100
+			Count: int32(b.Count), //nolint:gosec // bounded by the assessment count
+		})
+	}
+	daily := make([]*fraudv1.DailyDecisions, 0, len(result.Daily))
+	for _, d := range result.Daily {
+		daily = append(daily, &fraudv1.DailyDecisions{
+			Day:      d.Day.Format(time.DateOnly),
+			Approved: int32(d.Approved), //nolint:gosec // bounded by the assessment count
+			Review:   int32(d.Review),   //nolint:gosec // bounded by the assessment count
+			Declined: int32(d.Declined), //nolint:gosec // bounded by the assessment count
+		})
+	}
+
+	return &fraudv1.GetAssessmentMetricsResponse{
+		From:              timestamppb.New(result.From),
+		To:                timestamppb.New(result.To),
+		Total:             int32(result.Total), //nolint:gosec // bounded by the assessment count
+		Decisions:         counts(result.Decisions),
+		RiskLevels:        counts(result.RiskLevels),
+		ApprovalRate:      result.ApprovalRate,
+		ReviewRate:        result.ReviewRate,
+		DeclineRate:       result.DeclineRate,
+		AverageScore:      result.AverageScore,
+		SanctionsHitCount: int32(result.SanctionsHitCount), //nolint:gosec // bounded by the assessment count
+		ScoreHistogram:    histogram,
+		Daily:             daily,
 	}, nil
 }
+
+// toTransactionAssessmentMsg maps an assessment DTO to its proto message.
+func toTransactionAssessmentMsg(a dto.AssessmentResponse) *fraudv1.TransactionAssessment {
+	return &fraudv1.TransactionAssessment{
+		Id:              a.ID.String(),
+		TenantId:        a.TenantID.String(),
+		TransactionId:   a.TransactionID.String(),
+		AccountId:       a.AccountID.String(),
+		Amount:          &commonv1.Money{Amount: a.Amount, Currency: a.Currency},
+		TransactionType: a.TransactionType,
+		RiskLevel:       fraudv1.RiskLevel(fraudv1.RiskLevel_value["RISK_LEVEL_"+a.RiskLevel]),
+		RiskScore:       int32(a.RiskScore), //nolint:gosec // risk scores are 0-100
+		Decision:        assessmentDecision(a.Decision),
+		RiskSignals:     a.RiskSignals,
+		AssessedAt:      timestamppb.New(a.AssessedAt),
+		Audit:           &commonv1.AuditInfo{CreatedAt: timestamppb.New(a.CreatedAt)},
+		ModelVersion:    a.ModelVersion,
+		PolicyVersion:   int32(a.PolicyVersion), //nolint:gosec // policy versions are small
+	}
+}
+
+// counts converts a tally map to its proto form.
+func counts(m map[string]int) map[string]int32 {
+	out := make(map[string]int32, len(m))
+	for k, v := range m {
+		out[k] = int32(v) //nolint:gosec // bounded by the assessment count
+	}
+	return out
+}
+
+// optionalTime converts a timestamp, treating an unset one as the zero time.
+func optionalTime(field string, ts *timestamppb.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "invalid %s: %v", field, err)
+	}
+	return ts.AsTime(), nil
+}
+
+// enumName strips prefix from a proto enum name, mapping UNSPECIFIED to "".
+func enumName(name, prefix string) string {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "UNSPECIFIED" {
+		return ""
+	}
+	return name
+}
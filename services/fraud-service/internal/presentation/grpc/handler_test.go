@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/bibbank/bib/pkg/events"
 	"github.com/google/uuid"
@@ -15,10 +16,13 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	fraudv1 "github.com/bibbank/bib/api/gen/go/bib/fraud/v1"
 	"github.com/bibbank/bib/pkg/auth"
 	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
 	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
 	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/port"
 	"github.com/bibbank/bib/services/fraud-service/internal/domain/service"
 )
 
@@ -44,6 +48,18 @@ func (m *mockAssessmentRepo) FindByTransactionID(_ context.Context, _, _ uuid.UU
 	return nil, fmt.Errorf("not implemented")
 }
 
+func (m *mockAssessmentRepo) ScoreDistribution(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]model.ScoreBucket, error) {
+	return nil, nil
+}
+
+func (m *mockAssessmentRepo) List(_ context.Context, _ port.AssessmentFilter) ([]*model.TransactionAssessment, int, error) {
+	return nil, 0, nil
+}
+
+func (m *mockAssessmentRepo) DailyDecisionCounts(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]model.DecisionVolume, error) {
+	return nil, nil
+}
+
 func (m *mockAssessmentRepo) FindByAccountID(_ context.Context, _, _ uuid.UUID, _, _ int) ([]*model.TransactionAssessment, error) {
 	return nil, nil
 }
@@ -56,6 +72,86 @@ func (m *mockEventPublisher) Publish(_ context.Context, _ ...events.DomainEvent)
 	return m.publishErr
 }
 
+type mockCaseRepo struct{}
+
+func (m *mockCaseRepo) Save(_ context.Context, _ *model.FraudCase) error { return nil }
+func (m *mockCaseRepo) FindByID(_ context.Context, _, _ uuid.UUID) (*model.FraudCase, error) {
+	return nil, nil
+}
+func (m *mockCaseRepo) FindByAssessmentID(_ context.Context, _, _ uuid.UUID) (*model.FraudCase, error) {
+	return nil, nil
+}
+func (m *mockCaseRepo) FindOpenAMLCase(_ context.Context, _, _ uuid.UUID, _ string) (*model.FraudCase, error) {
+	return nil, nil
+}
+func (m *mockCaseRepo) List(_ context.Context, _ port.CaseFilter) ([]*model.FraudCase, int, error) {
+	return nil, 0, nil
+}
+func (m *mockCaseRepo) ListSLABreached(_ context.Context, _ time.Time, _ int) ([]*model.FraudCase, error) {
+	return nil, nil
+}
+
+type mockDeviceRepo struct{}
+
+func (m *mockDeviceRepo) Save(_ context.Context, _ *model.DeviceProfile) error { return nil }
+func (m *mockDeviceRepo) Find(_ context.Context, _, _ uuid.UUID, _ string) (*model.DeviceProfile, error) {
+	return nil, nil
+}
+func (m *mockDeviceRepo) CountAccounts(_ context.Context, _ uuid.UUID, _ string) (int, error) {
+	return 0, nil
+}
+func (m *mockDeviceRepo) ListByAccount(_ context.Context, _, _ uuid.UUID) ([]*model.DeviceProfile, error) {
+	return nil, nil
+}
+
+type mockIPIntel struct{}
+
+func (m *mockIPIntel) Lookup(_ context.Context, _ string) (model.IPInfo, error) {
+	return model.IPInfo{}, nil
+}
+
+type mockLinkRepo struct{}
+
+func (m *mockLinkRepo) RecordLinks(_ context.Context, _ []model.AccountLink) error { return nil }
+func (m *mockLinkRepo) FraudLinkedAccounts(_ context.Context, _, _ uuid.UUID, _ []model.LinkEntity) ([]model.LinkedAccount, error) {
+	return nil, nil
+}
+func (m *mockLinkRepo) LinkedAccounts(_ context.Context, _, _ uuid.UUID, _ int) ([]model.LinkedAccount, error) {
+	return nil, nil
+}
+
+type mockAuthAnomalyRepo struct{}
+
+func (m *mockAuthAnomalyRepo) Record(_ context.Context, _ model.AuthAnomaly) error { return nil }
+func (m *mockAuthAnomalyRepo) SummarizeIP(_ context.Context, _ string, _ time.Time) (model.AuthAnomalySummary, error) {
+	return model.AuthAnomalySummary{}, nil
+}
+
+type mockScreeningRepo struct{}
+
+func (m *mockScreeningRepo) Save(_ context.Context, _ *model.ScreeningRecord) error { return nil }
+func (m *mockScreeningRepo) ListByTransactionID(_ context.Context, _, _ uuid.UUID) ([]*model.ScreeningRecord, error) {
+	return nil, nil
+}
+
+type mockFlaggedPartyRepo struct{}
+
+func (m *mockFlaggedPartyRepo) Flag(_ context.Context, _ model.FlaggedParty) error { return nil }
+func (m *mockFlaggedPartyRepo) Clear(_ context.Context, _, _ uuid.UUID) error      { return nil }
+func (m *mockFlaggedPartyRepo) ListByTenant(_ context.Context, _ uuid.UUID) ([]model.FlaggedParty, error) {
+	return nil, nil
+}
+
+type mockRuleHitRepo struct{}
+
+func (m *mockRuleHitRepo) RecordHits(_ context.Context, _ uuid.UUID, _ []model.RuleHit) error {
+	return nil
+}
+
+func (m *mockRuleHitRepo) Stats(_ context.Context, _ uuid.UUID) ([]model.RuleHitStats, error) {
+	return nil, nil
+}
+
 // --- Helpers ---
 
 func contextWithClaims() context.Context {
@@ -78,8 +174,10 @@ func buildTestHandler() *FraudServiceHandler {
 	logger := testLogger()
 
 	return NewFraudServiceHandler(
-		usecase.NewAssessTransaction(repo, publisher, scorer),
+		usecase.NewAssessTransaction(repo, publisher, scorer, &mockRuleHitRepo{}, &mockCaseRepo{}, service.NewSanctionsScreener(0), &mockScreeningRepo{}, &mockFlaggedPartyRepo{}, usecase.NewEnrichTransaction(&mockDeviceRepo{}, &mockIPIntel{}, &mockLinkRepo{}, &mockAuthAnomalyRepo{}, logger), service.NewPolicyResolver(), logger),
 		usecase.NewGetAssessment(repo),
+		usecase.NewListAssessments(repo),
+		usecase.NewGetAssessmentMetrics(repo),
 		logger,
 	)
 }
@@ -90,8 +188,10 @@ func buildHandlerWithRepo(repo *mockAssessmentRepo) *FraudServiceHandler {
 	logger := testLogger()
 
 	return NewFraudServiceHandler(
-		usecase.NewAssessTransaction(repo, publisher, scorer),
+		usecase.NewAssessTransaction(repo, publisher, scorer, &mockRuleHitRepo{}, &mockCaseRepo{}, service.NewSanctionsScreener(0), &mockScreeningRepo{}, &mockFlaggedPartyRepo{}, usecase.NewEnrichTransaction(&mockDeviceRepo{}, &mockIPIntel{}, &mockLinkRepo{}, &mockAuthAnomalyRepo{}, logger), service.NewPolicyResolver(), logger),
 		usecase.NewGetAssessment(repo),
+		usecase.NewListAssessments(repo),
+		usecase.NewGetAssessmentMetrics(repo),
 		logger,
 	)
 }
@@ -107,10 +207,10 @@ func TestAssessTransaction(t *testing.T) {
 
 	t.Run("empty transaction_id returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.AssessTransaction(contextWithClaims(), &AssessTransactionRequest{
-			TenantID:      uuid.New().String(),
-			TransactionID: "",
-			AccountID:     uuid.New().String(),
+		_, err := h.AssessTransaction(contextWithClaims(), &fraudv1.AssessTransactionRequest{
+			TenantId:      uuid.New().String(),
+			TransactionId: "",
+			AccountId:     uuid.New().String(),
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 		assert.Contains(t, err.Error(), "invalid transaction_id")
@@ -118,10 +218,10 @@ func TestAssessTransaction(t *testing.T) {
 
 	t.Run("invalid transaction_id returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.AssessTransaction(contextWithClaims(), &AssessTransactionRequest{
-			TenantID:      uuid.New().String(),
-			TransactionID: "bad-uuid",
-			AccountID:     uuid.New().String(),
+		_, err := h.AssessTransaction(contextWithClaims(), &fraudv1.AssessTransactionRequest{
+			TenantId:      uuid.New().String(),
+			TransactionId: "bad-uuid",
+			AccountId:     uuid.New().String(),
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 		assert.Contains(t, err.Error(), "invalid transaction_id")
@@ -129,10 +229,10 @@ func TestAssessTransaction(t *testing.T) {
 
 	t.Run("invalid account_id returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.AssessTransaction(contextWithClaims(), &AssessTransactionRequest{
-			TenantID:      uuid.New().String(),
-			TransactionID: uuid.New().String(),
-			AccountID:     "bad-uuid",
+		_, err := h.AssessTransaction(contextWithClaims(), &fraudv1.AssessTransactionRequest{
+			TenantId:      uuid.New().String(),
+			TransactionId: uuid.New().String(),
+			AccountId:     "bad-uuid",
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 		assert.Contains(t, err.Error(), "invalid account_id")
@@ -140,12 +240,11 @@ func TestAssessTransaction(t *testing.T) {
 
 	t.Run("invalid amount returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.AssessTransaction(contextWithClaims(), &AssessTransactionRequest{
-			TenantID:        uuid.New().String(),
-			TransactionID:   uuid.New().String(),
-			AccountID:       uuid.New().String(),
-			Amount:          "not-a-number",
-			Currency:        "USD",
+		_, err := h.AssessTransaction(contextWithClaims(), &fraudv1.AssessTransactionRequest{
+			TenantId:        uuid.New().String(),
+			TransactionId:   uuid.New().String(),
+			AccountId:       uuid.New().String(),
+			Amount:          &commonv1.Money{Amount: "not-a-number", Currency: "USD"},
 			TransactionType: "TRANSFER",
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
@@ -154,30 +253,28 @@ func TestAssessTransaction(t *testing.T) {
 
 	t.Run("happy path returns assessment", func(t *testing.T) {
 		h := buildTestHandler()
-		resp, err := h.AssessTransaction(contextWithClaims(), &AssessTransactionRequest{
-			TenantID:        uuid.New().String(),
-			TransactionID:   uuid.New().String(),
-			AccountID:       uuid.New().String(),
-			Amount:          "100.00",
-			Currency:        "USD",
+		resp, err := h.AssessTransaction(contextWithClaims(), &fraudv1.AssessTransactionRequest{
+			TenantId:        uuid.New().String(),
+			TransactionId:   uuid.New().String(),
+			AccountId:       uuid.New().String(),
+			Amount:          &commonv1.Money{Amount: "100.00", Currency: "USD"},
 			TransactionType: "TRANSFER",
 		})
 		require.NoError(t, err)
-		assert.NotEmpty(t, resp.AssessmentID)
-		assert.NotEmpty(t, resp.RiskLevel)
-		assert.NotEmpty(t, resp.Decision)
+		assert.NotEmpty(t, resp.GetAssessment().GetId())
+		assert.NotZero(t, resp.GetAssessment().GetRiskLevel())
+		assert.NotZero(t, resp.GetAssessment().GetDecision())
 	})
 
 	t.Run("save failure returns Internal", func(t *testing.T) {
 		repo := &mockAssessmentRepo{saveErr: fmt.Errorf("db error")}
 		h := buildHandlerWithRepo(repo)
 
-		_, err := h.AssessTransaction(contextWithClaims(), &AssessTransactionRequest{
-			TenantID:        uuid.New().String(),
-			TransactionID:   uuid.New().String(),
-			AccountID:       uuid.New().String(),
-			Amount:          "50.00",
-			Currency:        "USD",
+		_, err := h.AssessTransaction(contextWithClaims(), &fraudv1.AssessTransactionRequest{
+			TenantId:        uuid.New().String(),
+			TransactionId:   uuid.New().String(),
+			AccountId:       uuid.New().String(),
+			Amount:          &commonv1.Money{Amount: "50.00", Currency: "USD"},
 			TransactionType: "PAYMENT",
 		})
 		requireGRPCCode(t, err, codes.Internal)
@@ -193,14 +290,14 @@ func TestGetAssessment(t *testing.T) {
 
 	t.Run("invalid assessment_id returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.GetAssessment(contextWithClaims(), &GetAssessmentRequest{AssessmentID: "bad-uuid"})
+		_, err := h.GetAssessment(contextWithClaims(), &fraudv1.GetAssessmentRequest{Id: "bad-uuid"})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 	})
 
 	t.Run("not found returns Internal", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.GetAssessment(contextWithClaims(), &GetAssessmentRequest{
-			AssessmentID: uuid.New().String(),
+		_, err := h.GetAssessment(contextWithClaims(), &fraudv1.GetAssessmentRequest{
+			Id: uuid.New().String(),
 		})
 		requireGRPCCode(t, err, codes.Internal)
 	})
@@ -214,12 +311,12 @@ func TestGetAssessment(t *testing.T) {
 		h := buildHandlerWithRepo(repo)
 
 		assessmentID := uuid.New()
-		resp, err := h.GetAssessment(contextWithClaims(), &GetAssessmentRequest{
-			AssessmentID: assessmentID.String(),
+		resp, err := h.GetAssessment(contextWithClaims(), &fraudv1.GetAssessmentRequest{
+			Id: assessmentID.String(),
 		})
 		require.NoError(t, err)
-		assert.NotEmpty(t, resp.AssessmentID)
-		assert.NotEmpty(t, resp.Decision)
+		assert.NotEmpty(t, resp.GetAssessment().GetId())
+		assert.NotZero(t, resp.GetAssessment().GetDecision())
 	})
 }
 
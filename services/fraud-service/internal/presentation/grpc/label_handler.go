@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	fraudv1 "github.com/bibbank/bib/api/gen/go/bib/fraud/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+)
+
+// Compile-time assertion that FraudLabelHandler implements FraudLabelServiceServer.
+var _ fraudv1.FraudLabelServiceServer = (*FraudLabelHandler)(nil)
+
+// FraudLabelHandler implements the gRPC FraudLabelServiceServer interface:
+// chargeback label intake and training dataset export.
+type FraudLabelHandler struct {
+	fraudv1.UnimplementedFraudLabelServiceServer
+	recordChargeback *usecase.RecordChargeback
+	exportDataset    *usecase.ExportTrainingDataset
+	logger           *slog.Logger
+}
+
+// NewFraudLabelHandler creates a new gRPC label handler.
+func NewFraudLabelHandler(
+	recordChargeback *usecase.RecordChargeback,
+	exportDataset *usecase.ExportTrainingDataset,
+	logger *slog.Logger,
+) *FraudLabelHandler {
+	return &FraudLabelHandler{
+		recordChargeback: recordChargeback,
+		exportDataset:    exportDataset,
+		logger:           logger,
+	}
+}
+
+// RecordChargeback handles a fraud chargeback notification.
+func (h *FraudLabelHandler) RecordChargeback(ctx context.Context, req *fraudv1.RecordChargebackRequest) (*fraudv1.RecordChargebackResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	txnID, err := uuid.Parse(req.GetTransactionId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction_id: %v", err)
+	}
+
+	claims, _ := auth.ClaimsFromContext(ctx)
+
+	result, err := h.recordChargeback.Execute(ctx, dto.RecordChargebackRequest{
+		TenantID:      claims.TenantID,
+		TransactionID: txnID,
+		ChargebackID:  req.GetChargebackId(),
+		ReasonCode:    req.GetReasonCode(),
+		ReportedBy:    claims.UserID,
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to record chargeback", err)
+	}
+
+	return &fraudv1.RecordChargebackResponse{Label: &fraudv1.AssessmentLabel{
+		AssessmentId: result.AssessmentID.String(),
+		Label:        fraudv1.FraudLabel(fraudv1.FraudLabel_value["FRAUD_LABEL_"+result.Label]),
+		Source:       fraudv1.LabelSource(fraudv1.LabelSource_value["LABEL_SOURCE_"+result.Source]),
+		SourceRef:    result.SourceRef,
+		LabeledBy:    result.LabeledBy.String(),
+		LabeledAt:    timestamppb.New(result.LabeledAt),
+	}}, nil
+}
+
+// ExportTrainingDataset handles a request to export a labeled dataset.
+func (h *FraudLabelHandler) ExportTrainingDataset(ctx context.Context, req *fraudv1.ExportTrainingDatasetRequest) (*fraudv1.ExportTrainingDatasetResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.GetFrom().CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from: %v", err)
+	}
+	if err := req.GetTo().CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to: %v", err)
+	}
+	from, to := req.GetFrom().AsTime(), req.GetTo().AsTime()
+
+	result, err := h.exportDataset.Execute(ctx, dto.ExportDatasetRequest{
+		TenantID: tenantID,
+		From:     from,
+		To:       to,
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to export training dataset", err)
+	}
+
+	return &fraudv1.ExportTrainingDatasetResponse{
+		Uri:            result.URI,
+		Rows:           int32(result.Rows),           //nolint:gosec // bounded by the assessment count
+		FraudRows:      int32(result.FraudRows),      //nolint:gosec // bounded by the assessment count
+		LegitimateRows: int32(result.LegitimateRows), //nolint:gosec // bounded by the assessment count
+	}, nil
+}
@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	fraudv1 "github.com/bibbank/bib/api/gen/go/bib/fraud/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+)
+
+// Compile-time assertion that FraudLinkHandler implements FraudLinkServiceServer.
+var _ fraudv1.FraudLinkServiceServer = (*FraudLinkHandler)(nil)
+
+// FraudLinkHandler implements the gRPC FraudLinkServiceServer interface:
+// analyst access to the account link graph.
+type FraudLinkHandler struct {
+	fraudv1.UnimplementedFraudLinkServiceServer
+	getAccountLinks *usecase.GetAccountLinks
+	logger          *slog.Logger
+}
+
+// NewFraudLinkHandler creates a new gRPC link analysis handler.
+func NewFraudLinkHandler(getAccountLinks *usecase.GetAccountLinks, logger *slog.Logger) *FraudLinkHandler {
+	return &FraudLinkHandler{
+		getAccountLinks: getAccountLinks,
+		logger:          logger,
+	}
+}
+
+// GetAccountLinks returns the accounts sharing a device, IP address or
+// destination account with an account.
+func (h *FraudLinkHandler) GetAccountLinks(ctx context.Context, req *fraudv1.GetAccountLinksRequest) (*fraudv1.GetAccountLinksResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := uuid.Parse(req.GetAccountId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account_id: %v", err)
+	}
+
+	result, err := h.getAccountLinks.Execute(ctx, dto.GetAccountLinksRequest{
+		TenantID:  tenantID,
+		AccountID: accountID,
+		Limit:     int(req.GetLimit()),
+		FraudOnly: req.GetFraudOnly(),
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to get account links", err)
+	}
+
+	resp := &fraudv1.GetAccountLinksResponse{
+		AccountId:           result.AccountID.String(),
+		ConfirmedFraudCount: int32(result.ConfirmedFraudCount), //nolint:gosec // bounded by the link limit
+		Linked:              make([]*fraudv1.LinkedAccount, 0, len(result.Linked)),
+	}
+	for _, a := range result.Linked {
+		msg := &fraudv1.LinkedAccount{
+			AccountId:      a.AccountID.String(),
+			ConfirmedFraud: a.ConfirmedFraud,
+			Shared:         make([]*fraudv1.LinkEntity, 0, len(a.Shared)),
+		}
+		for _, e := range a.Shared {
+			msg.Shared = append(msg.Shared, &fraudv1.LinkEntity{Type: e.Type, Value: e.Value})
+		}
+		resp.Linked = append(resp.Linked, msg)
+	}
+	return resp, nil
+}
@@ -0,0 +1,202 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	fraudv1 "github.com/bibbank/bib/api/gen/go/bib/fraud/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+)
+
+// Compile-time assertion that FraudPolicyHandler implements FraudPolicyServiceServer.
+var _ fraudv1.FraudPolicyServiceServer = (*FraudPolicyHandler)(nil)
+
+// FraudPolicyHandler implements the gRPC FraudPolicyServiceServer interface:
+// per-tenant decision thresholds, their change history and simulation.
+type FraudPolicyHandler struct {
+	fraudv1.UnimplementedFraudPolicyServiceServer
+	setPolicy    *usecase.SetDecisionPolicy
+	getPolicy    *usecase.GetDecisionPolicy
+	listVersions *usecase.ListDecisionPolicyVersions
+	simulate     *usecase.SimulateDecisionPolicy
+	logger       *slog.Logger
+}
+
+// NewFraudPolicyHandler creates a new gRPC decision policy handler.
+func NewFraudPolicyHandler(
+	setPolicy *usecase.SetDecisionPolicy,
+	getPolicy *usecase.GetDecisionPolicy,
+	listVersions *usecase.ListDecisionPolicyVersions,
+	simulate *usecase.SimulateDecisionPolicy,
+	logger *slog.Logger,
+) *FraudPolicyHandler {
+	return &FraudPolicyHandler{
+		setPolicy:    setPolicy,
+		getPolicy:    getPolicy,
+		listVersions: listVersions,
+		simulate:     simulate,
+		logger:       logger,
+	}
+}
+
+// SetDecisionPolicy records a new version of the caller's tenant policy.
+func (h *FraudPolicyHandler) SetDecisionPolicy(ctx context.Context, req *fraudv1.SetDecisionPolicyRequest) (*fraudv1.DecisionPolicyResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	effectiveFrom, err := optionalTime("effective_from", req.GetEffectiveFrom())
+	if err != nil {
+		return nil, err
+	}
+
+	claims, _ := auth.ClaimsFromContext(ctx)
+
+	result, err := h.setPolicy.Execute(ctx, dto.SetDecisionPolicyRequest{
+		TenantID:      claims.TenantID,
+		ActorID:       claims.UserID,
+		Thresholds:    fromThresholdsMsg(req.GetThresholds()),
+		EffectiveFrom: effectiveFrom,
+		Reason:        req.GetReason(),
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to set decision policy", err)
+	}
+	return &fraudv1.DecisionPolicyResponse{Policy: toDecisionPolicyMsg(result)}, nil
+}
+
+// GetDecisionPolicy returns the policy in force for the caller's tenant and
+// any scheduled versions.
+func (h *FraudPolicyHandler) GetDecisionPolicy(ctx context.Context, _ *fraudv1.GetDecisionPolicyRequest) (*fraudv1.GetDecisionPolicyResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.getPolicy.Execute(ctx, dto.GetDecisionPolicyRequest{TenantID: tenantID})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to get decision policy", err)
+	}
+
+	resp := &fraudv1.GetDecisionPolicyResponse{
+		Effective: toDecisionPolicyMsg(result.Effective),
+		Scheduled: make([]*fraudv1.DecisionPolicy, 0, len(result.Scheduled)),
+	}
+	for _, p := range result.Scheduled {
+		resp.Scheduled = append(resp.Scheduled, toDecisionPolicyMsg(p))
+	}
+	return resp, nil
+}
+
+// ListDecisionPolicyVersions returns the audit trail of policy changes for
+// the caller's tenant, newest first.
+func (h *FraudPolicyHandler) ListDecisionPolicyVersions(ctx context.Context, _ *fraudv1.ListDecisionPolicyVersionsRequest) (*fraudv1.ListDecisionPolicyVersionsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.listVersions.Execute(ctx, dto.GetDecisionPolicyRequest{TenantID: tenantID})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to list decision policy versions", err)
+	}
+
+	resp := &fraudv1.ListDecisionPolicyVersionsResponse{Versions: make([]*fraudv1.DecisionPolicy, 0, len(result.Versions))}
+	for _, p := range result.Versions {
+		resp.Versions = append(resp.Versions, toDecisionPolicyMsg(p))
+	}
+	return resp, nil
+}
+
+// SimulateDecisionPolicy replays recent traffic under proposed thresholds.
+func (h *FraudPolicyHandler) SimulateDecisionPolicy(ctx context.Context, req *fraudv1.SimulateDecisionPolicyRequest) (*fraudv1.SimulateDecisionPolicyResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.simulate.Execute(ctx, dto.SimulateDecisionPolicyRequest{
+		TenantID:   tenantID,
+		Thresholds: fromThresholdsMsg(req.GetThresholds()),
+		Days:       int(req.GetDays()),
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to simulate decision policy", err)
+	}
+
+	resp := &fraudv1.SimulateDecisionPolicyResponse{
+		From:        timestamppb.New(result.From),
+		To:          timestamppb.New(result.To),
+		Actual:      counts(result.Actual),
+		Proposed:    counts(result.Proposed),
+		Total:       int32(result.Total),   //nolint:gosec // bounded by the assessment count
+		Changed:     int32(result.Changed), //nolint:gosec // bounded by the assessment count
+		Transitions: make([]*fraudv1.DecisionTransition, 0, len(result.Transitions)),
+	}
+	for _, t := range result.Transitions {
+		resp.Transitions = append(resp.Transitions, &fraudv1.DecisionTransition{
+			From:  assessmentDecision(t.From),
+			To:    assessmentDecision(t.To),
+			Count: int32(t.Count), //nolint:gosec // bounded by the assessment count
+		})
+	}
+	return resp, nil
+}
+
+func fromThresholdsMsg(m *fraudv1.DecisionThresholds) dto.PolicyThresholds {
+	return dto.PolicyThresholds{
+		MediumAt:   int(m.GetMediumAt()),
+		HighAt:     int(m.GetHighAt()),
+		CriticalAt: int(m.GetCriticalAt()),
+		ReviewAt:   int(m.GetReviewAt()),
+		DeclineAt:  int(m.GetDeclineAt()),
+	}
+}
+
+//nolint:gosec // thresholds are risk scores in 0-100
+func toDecisionPolicyMsg(p dto.DecisionPolicyResponse) *fraudv1.DecisionPolicy {
+	msg := &fraudv1.DecisionPolicy{
+		Version: int32(p.Version),
+		Reason:  p.Reason,
+		Thresholds: &fraudv1.DecisionThresholds{
+			MediumAt:   int32(p.Thresholds.MediumAt),
+			HighAt:     int32(p.Thresholds.HighAt),
+			CriticalAt: int32(p.Thresholds.CriticalAt),
+			ReviewAt:   int32(p.Thresholds.ReviewAt),
+			DeclineAt:  int32(p.Thresholds.DeclineAt),
+		},
+	}
+	// The global default (version 0) has no effective date or author.
+	if p.Version > 0 {
+		msg.EffectiveFrom = timestamppb.New(p.EffectiveFrom)
+		msg.CreatedBy = p.CreatedBy.String()
+		msg.CreatedAt = timestamppb.New(p.CreatedAt)
+	}
+	return msg
+}
+
+// assessmentDecision maps a domain decision to its proto enum.
+func assessmentDecision(s string) fraudv1.AssessmentDecision {
+	return fraudv1.AssessmentDecision(fraudv1.AssessmentDecision_value["ASSESSMENT_DECISION_"+s])
+}
@@ -0,0 +1,323 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	fraudv1 "github.com/bibbank/bib/api/gen/go/bib/fraud/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+)
+
+// Compile-time assertion that FraudRuleHandler implements FraudRuleServiceServer.
+var _ fraudv1.FraudRuleServiceServer = (*FraudRuleHandler)(nil)
+
+// FraudRuleHandler implements the gRPC FraudRuleServiceServer interface,
+// the admin API for managing scoring rules.
+type FraudRuleHandler struct {
+	fraudv1.UnimplementedFraudRuleServiceServer
+	createRule       *usecase.CreateRule
+	updateRule       *usecase.UpdateRule
+	disableRule      *usecase.DisableRule
+	listRules        *usecase.ListRules
+	listRuleVersions *usecase.ListRuleVersions
+	getRuleMetrics   *usecase.GetRuleMetrics
+	dryRunRule       *usecase.DryRunRule
+	logger           *slog.Logger
+}
+
+// NewFraudRuleHandler creates a new gRPC rule admin handler.
+func NewFraudRuleHandler(
+	createRule *usecase.CreateRule,
+	updateRule *usecase.UpdateRule,
+	disableRule *usecase.DisableRule,
+	listRules *usecase.ListRules,
+	listRuleVersions *usecase.ListRuleVersions,
+	getRuleMetrics *usecase.GetRuleMetrics,
+	dryRunRule *usecase.DryRunRule,
+	logger *slog.Logger,
+) *FraudRuleHandler {
+	return &FraudRuleHandler{
+		createRule:       createRule,
+		updateRule:       updateRule,
+		disableRule:      disableRule,
+		listRules:        listRules,
+		listRuleVersions: listRuleVersions,
+		getRuleMetrics:   getRuleMetrics,
+		dryRunRule:       dryRunRule,
+		logger:           logger,
+	}
+}
+
+// CreateRule handles a create rule request.
+func (h *FraudRuleHandler) CreateRule(ctx context.Context, req *fraudv1.CreateRuleRequest) (*fraudv1.RuleResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	claims, _ := auth.ClaimsFromContext(ctx)
+
+	result, err := h.createRule.Execute(ctx, dto.CreateRuleRequest{
+		TenantID:    claims.TenantID,
+		ActorID:     claims.UserID,
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Condition:   req.GetCondition(),
+		Signal:      req.GetSignal(),
+		Mode:        enumName(req.GetMode().String(), "RULE_MODE_"),
+		ScoreImpact: int(req.GetScoreImpact()),
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to create rule", err)
+	}
+
+	return &fraudv1.RuleResponse{Rule: toRuleMsg(result)}, nil
+}
+
+// UpdateRule handles an update rule request.
+func (h *FraudRuleHandler) UpdateRule(ctx context.Context, req *fraudv1.UpdateRuleRequest) (*fraudv1.RuleResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	ruleID, err := uuid.Parse(req.GetRuleId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid rule_id: %v", err)
+	}
+
+	claims, _ := auth.ClaimsFromContext(ctx)
+
+	result, err := h.updateRule.Execute(ctx, dto.UpdateRuleRequest{
+		TenantID:    claims.TenantID,
+		ActorID:     claims.UserID,
+		RuleID:      ruleID,
+		Description: req.GetDescription(),
+		Condition:   req.GetCondition(),
+		Signal:      req.GetSignal(),
+		Mode:        enumName(req.GetMode().String(), "RULE_MODE_"),
+		ScoreImpact: int(req.GetScoreImpact()),
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to update rule", err)
+	}
+
+	return &fraudv1.RuleResponse{Rule: toRuleMsg(result)}, nil
+}
+
+// DisableRule handles a request to take a rule out of scoring.
+func (h *FraudRuleHandler) DisableRule(ctx context.Context, req *fraudv1.DisableRuleRequest) (*fraudv1.RuleResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	ruleID, err := uuid.Parse(req.GetRuleId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid rule_id: %v", err)
+	}
+
+	claims, _ := auth.ClaimsFromContext(ctx)
+
+	result, err := h.disableRule.Execute(ctx, dto.DisableRuleRequest{
+		TenantID: claims.TenantID,
+		ActorID:  claims.UserID,
+		RuleID:   ruleID,
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to disable rule", err)
+	}
+
+	return &fraudv1.RuleResponse{Rule: toRuleMsg(result)}, nil
+}
+
+// ListRules handles a list rules request.
+func (h *FraudRuleHandler) ListRules(ctx context.Context, _ *fraudv1.ListRulesRequest) (*fraudv1.ListRulesResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.listRules.Execute(ctx, dto.ListRulesRequest{TenantID: tenantID})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to list rules", err)
+	}
+
+	rules := make([]*fraudv1.FraudRule, 0, len(result))
+	for _, r := range result {
+		rules = append(rules, toRuleMsg(r))
+	}
+	return &fraudv1.ListRulesResponse{Rules: rules}, nil
+}
+
+// ListRuleVersions handles a rule history request.
+func (h *FraudRuleHandler) ListRuleVersions(ctx context.Context, req *fraudv1.ListRuleVersionsRequest) (*fraudv1.ListRuleVersionsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleID, err := uuid.Parse(req.GetRuleId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid rule_id: %v", err)
+	}
+
+	result, err := h.listRuleVersions.Execute(ctx, dto.ListRuleVersionsRequest{
+		TenantID: tenantID,
+		RuleID:   ruleID,
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to list rule versions", err)
+	}
+
+	versions := make([]*fraudv1.FraudRuleVersion, 0, len(result))
+	for _, v := range result {
+		versions = append(versions, &fraudv1.FraudRuleVersion{
+			Version:     int32(v.Version), //nolint:gosec // rule versions are small
+			Description: v.Description,
+			Condition:   v.Condition,
+			Signal:      v.Signal,
+			ScoreImpact: int32(v.ScoreImpact), //nolint:gosec // score impacts are small
+			Mode:        ruleMode(v.Mode),
+			ChangedBy:   v.ChangedBy.String(),
+			ChangedAt:   timestamppb.New(v.ChangedAt),
+		})
+	}
+	return &fraudv1.ListRuleVersionsResponse{Versions: versions}, nil
+}
+
+// GetRuleMetrics handles a rule hit metrics request.
+func (h *FraudRuleHandler) GetRuleMetrics(ctx context.Context, _ *fraudv1.GetRuleMetricsRequest) (*fraudv1.GetRuleMetricsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.getRuleMetrics.Execute(ctx, dto.ListRulesRequest{TenantID: tenantID})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to get rule metrics", err)
+	}
+
+	rules := make([]*fraudv1.FraudRuleMetrics, 0, len(result))
+	for _, m := range result {
+		msg := &fraudv1.FraudRuleMetrics{
+			RuleId:     m.RuleID.String(),
+			Name:       m.Name,
+			Signal:     m.Signal,
+			Mode:       ruleMode(m.Mode),
+			Hits:       m.Hits,
+			ShadowHits: m.ShadowHits,
+		}
+		if !m.LastHitAt.IsZero() {
+			msg.LastHitAt = timestamppb.New(m.LastHitAt)
+		}
+		rules = append(rules, msg)
+	}
+	return &fraudv1.GetRuleMetricsResponse{Rules: rules}, nil
+}
+
+// DryRunRule handles a request to evaluate a proposed rule without saving it.
+func (h *FraudRuleHandler) DryRunRule(ctx context.Context, req *fraudv1.DryRunRuleRequest) (*fraudv1.DryRunRuleResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := decimal.NewFromString(req.GetAmount().GetAmount())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
+	}
+
+	result, err := h.dryRunRule.Execute(ctx, dto.DryRunRuleRequest{
+		TenantID:        tenantID,
+		Condition:       req.GetCondition(),
+		Signal:          req.GetSignal(),
+		ScoreImpact:     int(req.GetScoreImpact()),
+		Amount:          amount,
+		Currency:        req.GetAmount().GetCurrency(),
+		TransactionType: req.GetTransactionType(),
+		Metadata:        req.GetMetadata(),
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to dry-run rule", err)
+	}
+
+	return &fraudv1.DryRunRuleResponse{
+		Matched:        result.Matched,
+		CurrentScore:   int32(result.CurrentScore), //nolint:gosec // risk scores are 0-100
+		CurrentSignals: result.CurrentSignals,
+		ProjectedScore: int32(result.ProjectedScore), //nolint:gosec // risk scores are 0-100
+	}, nil
+}
+
+// statusFromError maps a use case error to a gRPC status, hiding internal details.
+func statusFromError(logger *slog.Logger, msg string, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		logger.Error(msg, slog.String("error", err.Error()))
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func toRuleMsg(r dto.RuleResponse) *fraudv1.FraudRule {
+	return &fraudv1.FraudRule{
+		Id:          r.ID.String(),
+		TenantId:    r.TenantID.String(),
+		Name:        r.Name,
+		Description: r.Description,
+		Condition:   r.Condition,
+		Signal:      r.Signal,
+		ScoreImpact: int32(r.ScoreImpact), //nolint:gosec // score impacts are small
+		Mode:        ruleMode(r.Mode),
+		Version:     int32(r.Version), //nolint:gosec // rule versions are small
+		UpdatedBy:   r.UpdatedBy.String(),
+		CreatedAt:   timestamppb.New(r.CreatedAt),
+		UpdatedAt:   timestamppb.New(r.UpdatedAt),
+	}
+}
+
+// ruleMode maps a domain rule mode to its proto enum.
+func ruleMode(s string) fraudv1.RuleMode {
+	return fraudv1.RuleMode(fraudv1.RuleMode_value["RULE_MODE_"+s])
+}
@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	fraudv1 "github.com/bibbank/bib/api/gen/go/bib/fraud/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/dto"
+	"github.com/bibbank/bib/services/fraud-service/internal/application/usecase"
+)
+
+// Compile-time assertion that FraudScreeningHandler implements FraudScreeningServiceServer.
+var _ fraudv1.FraudScreeningServiceServer = (*FraudScreeningHandler)(nil)
+
+// FraudScreeningHandler implements the gRPC FraudScreeningServiceServer
+// interface: read access to the sanctions screening audit log.
+type FraudScreeningHandler struct {
+	fraudv1.UnimplementedFraudScreeningServiceServer
+	getScreeningLog *usecase.GetScreeningLog
+	logger          *slog.Logger
+}
+
+// NewFraudScreeningHandler creates a new gRPC screening handler.
+func NewFraudScreeningHandler(getScreeningLog *usecase.GetScreeningLog, logger *slog.Logger) *FraudScreeningHandler {
+	return &FraudScreeningHandler{
+		getScreeningLog: getScreeningLog,
+		logger:          logger,
+	}
+}
+
+// GetScreeningLog returns every sanctions screening of a transaction.
+func (h *FraudScreeningHandler) GetScreeningLog(ctx context.Context, req *fraudv1.GetScreeningLogRequest) (*fraudv1.GetScreeningLogResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	txnID, err := uuid.Parse(req.GetTransactionId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction_id: %v", err)
+	}
+
+	result, err := h.getScreeningLog.Execute(ctx, dto.GetScreeningLogRequest{
+		TenantID:      tenantID,
+		TransactionID: txnID,
+	})
+	if err != nil {
+		return nil, statusFromError(h.logger, "failed to get screening log", err)
+	}
+
+	resp := &fraudv1.GetScreeningLogResponse{Records: make([]*fraudv1.ScreeningRecord, 0, len(result.Records))}
+	for _, r := range result.Records {
+		resp.Records = append(resp.Records, toScreeningRecordMsg(r))
+	}
+	return resp, nil
+}
+
+func toScreeningRecordMsg(r dto.ScreeningRecordResponse) *fraudv1.ScreeningRecord {
+	msg := &fraudv1.ScreeningRecord{
+		Id:            r.ID.String(),
+		AssessmentId:  r.AssessmentID.String(),
+		TransactionId: r.TransactionID.String(),
+		ListVersion:   r.ListVersion,
+		ScreenedAt:    timestamppb.New(r.ScreenedAt),
+		Threshold:     r.Threshold,
+		Hit:           r.Hit,
+		Subjects:      make([]*fraudv1.ScreeningSubject, 0, len(r.Subjects)),
+		Matches:       make([]*fraudv1.ScreeningMatch, 0, len(r.Matches)),
+	}
+	for _, s := range r.Subjects {
+		msg.Subjects = append(msg.Subjects, &fraudv1.ScreeningSubject{Role: s.Role, Value: s.Value})
+	}
+	for _, m := range r.Matches {
+		msg.Matches = append(msg.Matches, &fraudv1.ScreeningMatch{
+			Subject:     &fraudv1.ScreeningSubject{Role: m.Subject.Role, Value: m.Subject.Value},
+			List:        m.List,
+			EntryId:     m.EntryID,
+			MatchedName: m.MatchedName,
+			Score:       m.Score,
+		})
+	}
+	return msg
+}
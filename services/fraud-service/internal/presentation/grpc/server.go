@@ -1,90 +1,58 @@
 package grpc
 
 import (
-	"fmt"
 	"log/slog"
-	"net"
-	"os"
 
-	"github.com/bibbank/bib/pkg/auth"
-	"github.com/bibbank/bib/pkg/tlsutil"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/health"
-	healthpb "google.golang.org/grpc/health/grpc_health_v1"
-	"google.golang.org/grpc/reflection"
+
+	fraudv1 "github.com/bibbank/bib/api/gen/go/bib/fraud/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/grpcserver"
 )
 
-// Server wraps the gRPC server with fraud service handlers.
+// Server wraps the gRPC server for fraud-service.
 type Server struct {
-	grpcServer *grpc.Server
-	handler    *FraudServiceHandler
-	logger     *slog.Logger
-	address    string
+	server  *grpcserver.Server
+	handler *FraudServiceHandler
+	address string
 }
 
-// NewServer creates a new gRPC server for the fraud service.
-func NewServer(handler *FraudServiceHandler, address string, logger *slog.Logger, jwtService *auth.JWTService) *Server {
-	// Add auth interceptor, skipping health check methods.
-	authInterceptor := auth.UnaryAuthInterceptor(jwtService, []string{
-		"/grpc.health.v1.Health/Check",
-		"/grpc.health.v1.Health/Watch",
-	})
-
-	var serverOpts []grpc.ServerOption
-	serverOpts = append(serverOpts, grpc.UnaryInterceptor(authInterceptor))
-
-	// Optional TLS: set GRPC_TLS_CERT_FILE and GRPC_TLS_KEY_FILE to enable.
-	if certFile, keyFile := os.Getenv("GRPC_TLS_CERT_FILE"), os.Getenv("GRPC_TLS_KEY_FILE"); certFile != "" && keyFile != "" {
-		creds, err := tlsutil.ServerTLSConfig(certFile, keyFile)
-		if err != nil {
-			logger.Error("failed to load TLS credentials, starting without TLS", "error", err)
-		} else {
-			serverOpts = append(serverOpts, grpc.Creds(creds))
-			logger.Info("gRPC TLS enabled", "cert", certFile, "key", keyFile)
-		}
-	} else {
-		logger.Info("gRPC TLS not configured, running without TLS")
-	}
-
-	grpcServer := grpc.NewServer(serverOpts...)
-
-	// Register health check service.
-	healthServer := health.NewServer()
-	healthpb.RegisterHealthServer(grpcServer, healthServer)
-	healthServer.SetServingStatus("fraud-service", healthpb.HealthCheckResponse_SERVING)
-
-	// Register the FraudService handler.
-	RegisterFraudServiceServer(grpcServer, handler)
-
-	// Only enable reflection when GRPC_REFLECTION=true.
-	if os.Getenv("GRPC_REFLECTION") == "true" {
-		reflection.Register(grpcServer)
+// NewServer creates a new gRPC server with the fraud handlers.
+func NewServer(handler *FraudServiceHandler, ruleHandler *FraudRuleHandler, caseHandler *FraudCaseHandler, labelHandler *FraudLabelHandler, screeningHandler *FraudScreeningHandler, policyHandler *FraudPolicyHandler, linkHandler *FraudLinkHandler, address string, logger *slog.Logger, jwtService *auth.JWTService) (*Server, error) {
+	srv, err := grpcserver.New(grpcserver.Config{
+		ServiceName: "fraud-service",
+		JWTService:  jwtService,
+	}, logger)
+	if err != nil {
+		return nil, err
 	}
+	fraudv1.RegisterFraudServiceServer(srv, handler)
+	fraudv1.RegisterFraudRuleServiceServer(srv, ruleHandler)
+	fraudv1.RegisterFraudCaseServiceServer(srv, caseHandler)
+	fraudv1.RegisterFraudLabelServiceServer(srv, labelHandler)
+	fraudv1.RegisterFraudScreeningServiceServer(srv, screeningHandler)
+	fraudv1.RegisterFraudPolicyServiceServer(srv, policyHandler)
+	fraudv1.RegisterFraudLinkServiceServer(srv, linkHandler)
 
 	return &Server{
-		grpcServer: grpcServer,
-		handler:    handler,
-		logger:     logger,
-		address:    address,
-	}
+		server:  srv,
+		handler: handler,
+		address: address,
+	}, nil
 }
 
 // Start begins listening and serving gRPC requests.
 func (s *Server) Start() error {
-	listener, err := net.Listen("tcp", s.address)
-	if err != nil {
-		return fmt.Errorf("failed to listen on %s: %w", s.address, err)
-	}
-
-	s.logger.Info("gRPC server starting",
-		slog.String("address", s.address),
-	)
-
-	return s.grpcServer.Serve(listener)
+	return s.server.ListenAndServe(s.address)
 }
 
 // Stop gracefully stops the gRPC server.
 func (s *Server) Stop() {
-	s.logger.Info("gRPC server shutting down")
-	s.grpcServer.GracefulStop()
+	s.server.Stop()
+}
+
+// RegisterService registers another service on the server, such as the
+// outbox administration service.
+func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	s.server.RegisterService(desc, impl)
 }
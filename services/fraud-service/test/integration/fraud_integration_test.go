@@ -0,0 +1,105 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fraud-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/fraud-service/internal/infrastructure/postgres"
+)
+
+func newAssessment(t *testing.T, tenantID uuid.UUID, score int, signals ...string) *model.TransactionAssessment {
+	t.Helper()
+	a, err := model.NewTransactionAssessment(tenantID, uuid.New(), uuid.New(), decimal.NewFromInt(950), "USD", "CARD_PAYMENT")
+	require.NoError(t, err)
+	require.NoError(t, a.Assess(score, signals))
+	return a
+}
+
+func TestAssessmentRepo_SignalsRoundTripAndAreScopedToTenant(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewAssessmentRepository(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+
+	assessment := newAssessment(t, tenantID, 45, "new_device", "velocity_spike")
+	require.NoError(t, repo.Save(ctx, assessment))
+
+	found, err := repo.FindByTransactionID(ctx, tenantID, assessment.TransactionID())
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, assessment.ID(), found.ID())
+	assert.Equal(t, 45, found.RiskScore())
+	assert.True(t, found.Decision().IsReview())
+	assert.ElementsMatch(t, []string{"new_device", "velocity_spike"}, found.RiskSignals())
+
+	other, err := repo.FindByID(ctx, uuid.New(), assessment.ID())
+	require.NoError(t, err)
+	assert.Nil(t, other, "assessments are scoped to their tenant")
+}
+
+func TestCaseRepo_NotesAndStatusRoundTrip(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	assessments := postgres.NewAssessmentRepository(env.Pool())
+	cases := postgres.NewCaseRepository(env.Pool())
+	ctx := context.Background()
+	tenantID, analystID := uuid.New(), uuid.New()
+
+	assessment := newAssessment(t, tenantID, 45, "new_device")
+	require.NoError(t, assessments.Save(ctx, assessment))
+	fraudCase, err := model.OpenCaseForAssessment(assessment)
+	require.NoError(t, err)
+	require.NoError(t, cases.Save(ctx, fraudCase))
+
+	require.NoError(t, fraudCase.Assign(analystID))
+	_, err = fraudCase.AddNote(analystID, "Customer confirmed the purchase by phone")
+	require.NoError(t, err)
+	require.NoError(t, cases.Save(ctx, fraudCase))
+
+	found, err := cases.FindByAssessmentID(ctx, tenantID, assessment.ID())
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, fraudCase.ID(), found.ID())
+	assert.Equal(t, valueobject.CaseStatusInReview, found.Status())
+	assert.Equal(t, analystID, found.AssigneeID())
+	require.Len(t, found.Notes(), 1)
+	assert.Equal(t, "Customer confirmed the purchase by phone", found.Notes()[0].Body)
+}
+
+func TestCaseRepo_FindOpenAMLCaseMatchesScenario(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	cases := postgres.NewCaseRepository(env.Pool())
+	ctx := context.Background()
+	tenantID, accountID := uuid.New(), uuid.New()
+
+	fraudCase, err := model.OpenCaseForAMLAlert(model.AMLAlert{
+		TenantID:       tenantID,
+		AccountID:      accountID,
+		Scenario:       "STRUCTURING",
+		Summary:        "Five cash deposits just under the reporting threshold",
+		TransactionIDs: []uuid.UUID{uuid.New(), uuid.New()},
+		RiskScore:      70,
+	})
+	require.NoError(t, err)
+	require.NoError(t, cases.Save(ctx, fraudCase))
+
+	found, err := cases.FindOpenAMLCase(ctx, tenantID, accountID, "STRUCTURING")
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, fraudCase.ID(), found.ID())
+	assert.Len(t, found.AlertTransactionIDs(), 2)
+	assert.Len(t, found.Notes(), 1)
+
+	missing, err := cases.FindOpenAMLCase(ctx, tenantID, accountID, "RAPID_MOVEMENT")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
@@ -11,14 +11,15 @@ import (
 
 	"github.com/bibbank/bib/pkg/auth"
 	"github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
 	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
 	"github.com/bibbank/bib/pkg/postgres"
 
 	"github.com/bibbank/bib/services/fx-service/internal/application/usecase"
 	"github.com/bibbank/bib/services/fx-service/internal/domain/port"
 	"github.com/bibbank/bib/services/fx-service/internal/domain/service"
 	"github.com/bibbank/bib/services/fx-service/internal/infrastructure/config"
-	infraKafka "github.com/bibbank/bib/services/fx-service/internal/infrastructure/kafka"
 	infraPostgres "github.com/bibbank/bib/services/fx-service/internal/infrastructure/postgres"
 	"github.com/bibbank/bib/services/fx-service/internal/infrastructure/provider"
 	grpcPresentation "github.com/bibbank/bib/services/fx-service/internal/presentation/grpc"
@@ -45,8 +46,9 @@ func run() error {
 		"grpc_port", cfg.GRPCPort,
 		"http_port", cfg.HTTPPort,
 	)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
 
 	// Database pool.
@@ -63,7 +65,7 @@ func run() error {
 	if err != nil {
 		return fmt.Errorf("create database pool: %w", err)
 	}
-	defer pool.Close()
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
 	logger.Info("database pool created")
 
 	// Run database migrations.
@@ -83,22 +85,68 @@ func run() error {
 	kafkaProducer := kafka.NewProducer(kafka.Config{
 		Brokers: cfg.Kafka.Brokers,
 	})
-	defer kafkaProducer.Close()
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(kafkaProducer.Close))
 	logger.Info("kafka producer created")
 
+	// Outbox relay: events are written to the outbox and published from it,
+	// so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     outbox.StaticRoute(usecase.TopicFXRates),
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: cfg.Telemetry.ServiceName,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, kafkaProducer, relayCfg, logger)
+	if err != nil {
+		return fmt.Errorf("create outbox relay: %w", err)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+
 	// Repositories and infrastructure.
 	rateRepo := infraPostgres.NewExchangeRateRepo(pool)
-	publisher := infraKafka.NewPublisher(kafkaProducer)
+	publisher := outbox.NewPublisher(outboxStore)
 
 	// Domain services.
 	revalEngine := service.NewRevaluationEngine()
 
-	// Rate provider: use static rates when FX_RATE_PROVIDER=static (for dev/CI),
-	// otherwise nil (production should wire an HTTP-based external API provider).
+	// Rate provider: the external rate API by default, static rates in
+	// sandbox mode and when FX_RATE_PROVIDER=static (for dev/CI).
 	var rateProvider port.RateProvider
-	if os.Getenv("FX_RATE_PROVIDER") == "static" {
+	switch {
+	case cfg.Sandbox:
+		rateProvider = provider.NewStaticRateProvider()
+		logger.Warn("sandbox mode, using static rate provider")
+	case cfg.Rates.Provider == "static":
 		rateProvider = provider.NewStaticRateProvider()
 		logger.Info("using static rate provider")
+	case cfg.Rates.Provider == "http":
+		httpProvider, err := provider.NewHTTPRateProvider(provider.HTTPRateProviderConfig{
+			BaseURL:          cfg.Rates.URL,
+			APIKey:           cfg.Rates.APIKey,
+			APIKeyHeader:     cfg.Rates.APIKeyHeader,
+			Path:             cfg.Rates.Path,
+			RateField:        cfg.Rates.RateField,
+			Timeout:          cfg.Rates.Timeout,
+			CacheTTL:         cfg.Rates.CacheTTL,
+			FailureThreshold: cfg.Rates.FailureThreshold,
+			Cooldown:         cfg.Rates.Cooldown,
+		})
+		if err != nil {
+			return fmt.Errorf("create rate provider (set FX_RATE_PROVIDER_URL): %w", err)
+		}
+		rateProvider = httpProvider
+		logger.Info("using HTTP rate provider", "url", cfg.Rates.URL)
+	default:
+		return fmt.Errorf("unknown FX_RATE_PROVIDER %q, want http or static", cfg.Rates.Provider)
 	}
 
 	// Use cases.
@@ -111,6 +159,8 @@ func run() error {
 		Issuer: "bib-gateway",
 	}
 	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
 	case os.Getenv("JWT_PUBLIC_KEY") != "":
 		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
 	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
@@ -134,12 +184,20 @@ func run() error {
 
 	// gRPC server.
 	handler := grpcPresentation.NewHandler(getExchangeRate, convertAmount, revaluate, logger)
-	grpcServer := grpcPresentation.NewServer(handler, logger, cfg.GRPCPort, jwtSvc)
+	grpcServer, err := grpcPresentation.NewServer(handler, logger, cfg.GRPCPort, jwtSvc)
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
 
 	// HTTP health server.
 	healthHandler := rest.NewHealthHandler(pool, logger)
 	mux := http.NewServeMux()
 	healthHandler.RegisterRoutes(mux)
+	if metricsHandler != nil {
+		mux.Handle("/metrics", metricsHandler)
+	}
 	httpServer := &http.Server{
 		Addr:         fmt.Sprintf(":%d", cfg.HTTPPort),
 		Handler:      mux,
@@ -149,44 +207,15 @@ func run() error {
 	}
 
 	// Start servers.
-	errCh := make(chan error, 2)
-
-	go func() {
-		errCh <- grpcServer.Start()
-	}()
-
-	go func() {
-		logger.Info("HTTP health server starting", "addr", httpServer.Addr)
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			errCh <- fmt.Errorf("http server: %w", err)
-		}
-	}()
-
-	// Graceful shutdown.
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", func(context.Context) error {
+		return grpcServer.Start()
+	})
+	lc.OnStop(lifecycle.PhaseIngress, "gRPC server", lifecycle.Func(grpcServer.Stop))
+	lc.ServeHTTP("HTTP health server", httpServer)
 
-	select {
-	case sig := <-sigCh:
-		logger.Info("received shutdown signal", "signal", sig.String())
-	case err := <-errCh:
-		logger.Error("server error", "error", err)
+	if err := lc.Wait(ctx); err != nil {
 		return err
 	}
-
-	// Shutdown sequence.
-	logger.Info("shutting down")
-
-	grpcServer.Stop()
-
-	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer shutdownCancel()
-
-	if err := httpServer.Shutdown(shutdownCtx); err != nil {
-		logger.Error("http server shutdown error", "error", err)
-	}
-
-	cancel()
 	logger.Info("fx-service stopped")
 	return nil
 }
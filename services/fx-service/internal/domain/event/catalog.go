@@ -0,0 +1,12 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[RateUpdated]("fx.rate.updated", 1, "Emitted when an exchange rate is updated."),
+		events.Declare[RevaluationCompleted]("fx.revaluation.completed", 1, "Emitted when an FX revaluation run finishes."),
+	}
+}
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"time"
 )
 
 // Config holds all service configuration loaded from environment variables.
@@ -12,8 +14,13 @@ type Config struct {
 	LogFormat string
 	Kafka     KafkaConfig
 	DB        DBConfig
+	Rates     RateProviderConfig
+	Outbox    OutboxConfig
 	HTTPPort  int
 	GRPCPort  int
+	// Sandbox runs the service for sandbox tenants, on static rates
+	// whatever the rate provider setting.
+	Sandbox bool
 }
 
 // DBConfig holds database connection parameters.
@@ -33,6 +40,21 @@ type KafkaConfig struct {
 	Brokers []string
 }
 
+// RateProviderConfig selects and configures the source of spot rates.
+type RateProviderConfig struct {
+	// Provider is "http" (the default) or "static" for dev and CI.
+	Provider         string
+	URL              string
+	APIKey           string
+	APIKeyHeader     string
+	Path             string
+	RateField        string
+	Timeout          time.Duration
+	CacheTTL         time.Duration
+	Cooldown         time.Duration
+	FailureThreshold int
+}
+
 // TelemetryConfig holds OpenTelemetry configuration.
 type TelemetryConfig struct {
 	OTLPEndpoint string
@@ -46,6 +68,12 @@ func (c Config) Validate() {
 	}
 }
 
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
 // Load reads configuration from environment variables with defaults.
 func Load() Config {
 	return Config{
@@ -64,12 +92,29 @@ func Load() Config {
 		Kafka: KafkaConfig{
 			Brokers: []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
 		},
+		Rates: RateProviderConfig{
+			Provider:         getEnv("FX_RATE_PROVIDER", "http"),
+			URL:              getEnv("FX_RATE_PROVIDER_URL", ""),
+			APIKey:           getEnv("FX_RATE_PROVIDER_API_KEY", ""),
+			APIKeyHeader:     getEnv("FX_RATE_PROVIDER_API_KEY_HEADER", "Authorization"),
+			Path:             getEnv("FX_RATE_PROVIDER_PATH", ""),
+			RateField:        getEnv("FX_RATE_PROVIDER_RATE_FIELD", ""),
+			Timeout:          getEnvDuration("FX_RATE_PROVIDER_TIMEOUT", 2*time.Second),
+			CacheTTL:         getEnvDuration("FX_RATE_PROVIDER_CACHE_TTL", time.Minute),
+			FailureThreshold: getEnvInt("FX_RATE_PROVIDER_FAILURE_THRESHOLD", 5),
+			Cooldown:         getEnvDuration("FX_RATE_PROVIDER_COOLDOWN", 30*time.Second),
+		},
 		Telemetry: TelemetryConfig{
 			OTLPEndpoint: getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4317"),
 			ServiceName:  "fx-service",
 		},
+		Sandbox:   getEnv("SANDBOX_MODE", "false") == "true",
 		LogLevel:  getEnv("LOG_LEVEL", "info"),
 		LogFormat: getEnv("LOG_FORMAT", "json"),
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
 	}
 }
 
@@ -88,3 +133,40 @@ func getEnvInt(key string, defaultVal int) int {
 	}
 	return defaultVal
 }
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return fallback
+}
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker_OnlyTrialFreesTrialSlot(t *testing.T) {
+	b := &circuitBreaker{threshold: 1, cooldown: time.Minute}
+	now := time.Now()
+
+	// A call let through while closed is still in flight when another fails.
+	allowed, stale := b.allow(now)
+	assert.True(t, allowed)
+	assert.False(t, stale)
+	b.failure(now, false)
+
+	now = now.Add(time.Minute)
+	allowed, trial := b.allow(now)
+	assert.True(t, allowed)
+	assert.True(t, trial)
+
+	// The earlier call ending must not let a second trial through.
+	b.release(stale)
+	allowed, _ = b.allow(now)
+	assert.False(t, allowed, "second trial let through while the first is in flight")
+	b.failure(now, stale)
+	allowed, _ = b.allow(now.Add(2 * time.Minute))
+	assert.False(t, allowed, "second trial let through while the first is in flight")
+
+	b.release(trial)
+	allowed, trial = b.allow(now.Add(2 * time.Minute))
+	assert.True(t, allowed)
+	assert.True(t, trial)
+}
@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/fx-service/internal/domain/valueobject"
+)
+
+// ErrCircuitOpen is returned by HTTPRateProvider while the rate API is
+// considered down and calls to it are skipped.
+var ErrCircuitOpen = errors.New("rate provider circuit open")
+
+// maxCachedRates bounds the rate cache; it is cleared when full.
+const maxCachedRates = 1000
+
+// Defaults for HTTPRateProviderConfig fields left zero. The path and rate
+// field match the /latest endpoint most rate APIs (exchangerate.host,
+// Frankfurter, Open Exchange Rates) serve:
+//
+//	GET /latest?base=EUR&symbols=USD -> {"base": "EUR", "rates": {"USD": 1.085}}
+const (
+	DefaultRatePath             = "/latest?base={base}&symbols={quote}"
+	DefaultRateField            = "rates.{quote}"
+	defaultRateTimeout          = 2 * time.Second
+	defaultRateCacheTTL         = time.Minute
+	defaultRateFailureThreshold = 5
+	defaultRateCooldown         = 30 * time.Second
+)
+
+// HTTPRateProviderConfig configures an HTTPRateProvider.
+type HTTPRateProviderConfig struct {
+	// BaseURL is the rate API's root, such as https://api.rates.example.
+	BaseURL string
+	// APIKey, if set, is sent in APIKeyHeader, as a bearer token when the
+	// header is Authorization (the default).
+	APIKey       string
+	APIKeyHeader string
+	// Path is appended to BaseURL, with {base} and {quote} replaced by the
+	// pair's currency codes.
+	Path string
+	// RateField is the dotted path of the rate in the JSON response, with
+	// {base} and {quote} replaced as in Path. The rate may be a JSON number
+	// or a string.
+	RateField string
+	Timeout   time.Duration
+	// CacheTTL is how long a fetched rate is served without calling the API.
+	CacheTTL time.Duration
+	// FailureThreshold consecutive failed calls open the circuit for
+	// Cooldown, after which one trial call decides whether it closes again.
+	FailureThreshold int
+	Cooldown         time.Duration
+}
+
+// HTTPRateProvider implements port.RateProvider against an external rate
+// API. Rates are cached per pair, and a circuit breaker stops calls to an
+// API that keeps failing so lookups fall back to stored rates at once.
+type HTTPRateProvider struct {
+	client    *http.Client
+	cache     map[string]cachedRate
+	breaker   *circuitBreaker
+	baseURL   string
+	apiKey    string
+	keyHeader string
+	path      string
+	rateField string
+	ttl       time.Duration
+	mu        sync.Mutex
+}
+
+type cachedRate struct {
+	expiresAt time.Time
+	rate      valueobject.SpotRate
+}
+
+// NewHTTPRateProvider creates an HTTPRateProvider, defaulting zero fields of
+// cfg.
+func NewHTTPRateProvider(cfg HTTPRateProviderConfig) (*HTTPRateProvider, error) {
+	if cfg.BaseURL == "" {
+		return nil, errors.New("rate provider base URL is required")
+	}
+	if _, err := url.Parse(cfg.BaseURL); err != nil {
+		return nil, fmt.Errorf("invalid rate provider base URL: %w", err)
+	}
+	if cfg.APIKeyHeader == "" {
+		cfg.APIKeyHeader = "Authorization"
+	}
+	if cfg.Path == "" {
+		cfg.Path = DefaultRatePath
+	}
+	if cfg.RateField == "" {
+		cfg.RateField = DefaultRateField
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultRateTimeout
+	}
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = defaultRateCacheTTL
+	}
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = defaultRateFailureThreshold
+	}
+	if cfg.Cooldown <= 0 {
+		cfg.Cooldown = defaultRateCooldown
+	}
+
+	return &HTTPRateProvider{
+		client:    &http.Client{Timeout: cfg.Timeout},
+		cache:     make(map[string]cachedRate),
+		breaker:   &circuitBreaker{threshold: cfg.FailureThreshold, cooldown: cfg.Cooldown},
+		baseURL:   strings.TrimRight(cfg.BaseURL, "/"),
+		apiKey:    cfg.APIKey,
+		keyHeader: cfg.APIKeyHeader,
+		path:      cfg.Path,
+		rateField: cfg.RateField,
+		ttl:       cfg.CacheTTL,
+	}, nil
+}
+
+// FetchRate returns the rate for the pair, from cache when fresh.
+func (p *HTTPRateProvider) FetchRate(ctx context.Context, base, quote string) (valueobject.SpotRate, error) {
+	base, quote = strings.ToUpper(base), strings.ToUpper(quote)
+	key := base + "/" + quote
+
+	now := time.Now()
+	p.mu.Lock()
+	if c, ok := p.cache[key]; ok && now.Before(c.expiresAt) {
+		p.mu.Unlock()
+		return c.rate, nil
+	}
+	p.mu.Unlock()
+
+	allowed, trial := p.breaker.allow(now)
+	if !allowed {
+		return valueobject.SpotRate{}, fmt.Errorf("fetch rate %s: %w", key, ErrCircuitOpen)
+	}
+	rate, err := p.fetch(ctx, base, quote)
+	if err != nil {
+		// A caller giving up says nothing about the API's health.
+		if ctx.Err() == nil && !errors.Is(err, errNoRate) {
+			p.breaker.failure(time.Now(), trial)
+		} else {
+			p.breaker.release(trial)
+		}
+		return valueobject.SpotRate{}, fmt.Errorf("fetch rate %s: %w", key, err)
+	}
+	p.breaker.success()
+
+	p.mu.Lock()
+	if len(p.cache) >= maxCachedRates {
+		p.cache = make(map[string]cachedRate)
+	}
+	p.cache[key] = cachedRate{rate: rate, expiresAt: now.Add(p.ttl)}
+	p.mu.Unlock()
+
+	return rate, nil
+}
+
+// errNoRate reports a response without a usable rate for the pair, such as
+// for a currency the API does not quote. It does not count against the API.
+var errNoRate = errors.New("no rate in response")
+
+func (p *HTTPRateProvider) fetch(ctx context.Context, base, quote string) (valueobject.SpotRate, error) {
+	expand := strings.NewReplacer("{base}", url.QueryEscape(base), "{quote}", url.QueryEscape(quote))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+expand.Replace(p.path), nil)
+	if err != nil {
+		return valueobject.SpotRate{}, fmt.Errorf("failed to build rate request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if p.apiKey != "" {
+		if strings.EqualFold(p.keyHeader, "Authorization") {
+			req.Header.Set("Authorization", "Bearer "+p.apiKey)
+		} else {
+			req.Header.Set(p.keyHeader, p.apiKey)
+		}
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return valueobject.SpotRate{}, fmt.Errorf("rate request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return valueobject.SpotRate{}, fmt.Errorf("failed to read rate response: %w", err)
+	}
+	switch {
+	case resp.StatusCode == http.StatusOK:
+	case resp.StatusCode == http.StatusNotFound:
+		return valueobject.SpotRate{}, fmt.Errorf("%w: rate API returned %d", errNoRate, resp.StatusCode)
+	default:
+		return valueobject.SpotRate{}, fmt.Errorf("rate API returned %d", resp.StatusCode)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var body any
+	if err := dec.Decode(&body); err != nil {
+		return valueobject.SpotRate{}, fmt.Errorf("failed to decode rate response: %w", err)
+	}
+
+	field := strings.NewReplacer("{base}", base, "{quote}", quote).Replace(p.rateField)
+	value := body
+	for _, name := range strings.Split(field, ".") {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return valueobject.SpotRate{}, fmt.Errorf("%w: %s not found", errNoRate, field)
+		}
+		if value, ok = obj[name]; !ok {
+			return valueobject.SpotRate{}, fmt.Errorf("%w: %s not found", errNoRate, field)
+		}
+	}
+
+	var raw string
+	switch v := value.(type) {
+	case json.Number:
+		raw = v.String()
+	case string:
+		raw = v
+	default:
+		return valueobject.SpotRate{}, fmt.Errorf("rate field %s is not a number", field)
+	}
+	d, err := decimal.NewFromString(raw)
+	if err != nil {
+		return valueobject.SpotRate{}, fmt.Errorf("rate field %s: %w", field, err)
+	}
+	return valueobject.NewSpotRate(d)
+}
+
+// circuitBreaker opens after threshold consecutive failures and rejects
+// calls for cooldown. It then lets a single trial call through: success
+// closes it, failure opens it for another cooldown.
+type circuitBreaker struct {
+	openUntil time.Time
+	threshold int
+	failures  int
+	cooldown  time.Duration
+	trial     bool
+	mu        sync.Mutex
+}
+
+// allow reports whether a call may be made at now and whether it is the
+// trial call, which must hand its slot back through success, failure or
+// release.
+func (b *circuitBreaker) allow(now time.Time) (allowed, trial bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold {
+		return true, false
+	}
+	if b.trial || now.Before(b.openUntil) {
+		return false, false
+	}
+	b.trial = true
+	return true, true
+}
+
+func (b *circuitBreaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.trial = false
+}
+
+func (b *circuitBreaker) failure(now time.Time, trial bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	if trial {
+		b.trial = false
+	}
+	if b.failures >= b.threshold {
+		b.openUntil = now.Add(b.cooldown)
+	}
+}
+
+// release ends a call that neither succeeded nor failed against the API,
+// freeing the trial slot if it held it.
+func (b *circuitBreaker) release(trial bool) {
+	if !trial {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trial = false
+}
@@ -0,0 +1,133 @@
+package provider_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/fx-service/internal/infrastructure/provider"
+)
+
+func TestHTTPRateProvider_FetchRate(t *testing.T) {
+	t.Run("maps the default response shape and caches it", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			assert.Equal(t, "/latest", r.URL.Path)
+			assert.Equal(t, "EUR", r.URL.Query().Get("base"))
+			assert.Equal(t, "USD", r.URL.Query().Get("symbols"))
+			assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(`{"base": "EUR", "rates": {"USD": 1.0853}}`))
+		}))
+		defer srv.Close()
+
+		p, err := provider.NewHTTPRateProvider(provider.HTTPRateProviderConfig{BaseURL: srv.URL + "/", APIKey: "secret"})
+		require.NoError(t, err)
+
+		rate, err := p.FetchRate(context.Background(), "eur", "usd")
+		require.NoError(t, err)
+		_, err = p.FetchRate(context.Background(), "EUR", "USD")
+		require.NoError(t, err)
+
+		assert.Equal(t, "1.0853", rate.Rate().String())
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("follows a custom path, key header and rate field", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v2/quotes/GBP/JPY", r.URL.Path)
+			assert.Equal(t, "secret", r.Header.Get("X-API-Key"))
+			assert.Empty(t, r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(`{"data": {"GBPJPY": {"mid": "189.10"}}}`))
+		}))
+		defer srv.Close()
+
+		p, err := provider.NewHTTPRateProvider(provider.HTTPRateProviderConfig{
+			BaseURL:      srv.URL,
+			APIKey:       "secret",
+			APIKeyHeader: "X-API-Key",
+			Path:         "/v2/quotes/{base}/{quote}",
+			RateField:    "data.{base}{quote}.mid",
+		})
+		require.NoError(t, err)
+
+		rate, err := p.FetchRate(context.Background(), "GBP", "JPY")
+		require.NoError(t, err)
+		assert.Equal(t, "189.1", rate.Rate().String())
+	})
+
+	t.Run("rejects responses without a positive rate", func(t *testing.T) {
+		bodies := []string{`{"rates": {}}`, `{"rates": {"USD": "n/a"}}`, `{"rates": {"USD": 0}}`, `not json`}
+		for _, body := range bodies {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(body))
+			}))
+			p, err := provider.NewHTTPRateProvider(provider.HTTPRateProviderConfig{BaseURL: srv.URL})
+			require.NoError(t, err)
+
+			_, err = p.FetchRate(context.Background(), "EUR", "USD")
+			assert.Error(t, err, body)
+			srv.Close()
+		}
+	})
+
+	t.Run("requires a base URL", func(t *testing.T) {
+		_, err := provider.NewHTTPRateProvider(provider.HTTPRateProviderConfig{})
+		assert.Error(t, err)
+	})
+}
+
+func TestHTTPRateProvider_CircuitBreaker(t *testing.T) {
+	var calls atomic.Int32
+	var healthy atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"rates": {"USD": 1.08}}`))
+	}))
+	defer srv.Close()
+
+	p, err := provider.NewHTTPRateProvider(provider.HTTPRateProviderConfig{
+		BaseURL:          srv.URL,
+		FailureThreshold: 2,
+		Cooldown:         50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for range 2 {
+		_, err = p.FetchRate(ctx, "EUR", "USD")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, provider.ErrCircuitOpen)
+	}
+	_, err = p.FetchRate(ctx, "EUR", "USD")
+	require.ErrorIs(t, err, provider.ErrCircuitOpen)
+	assert.Equal(t, int32(2), calls.Load(), "open circuit must not call the API")
+
+	// After the cooldown a failing trial call opens the circuit again.
+	time.Sleep(60 * time.Millisecond)
+	_, err = p.FetchRate(ctx, "EUR", "USD")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, provider.ErrCircuitOpen)
+	_, err = p.FetchRate(ctx, "EUR", "USD")
+	require.ErrorIs(t, err, provider.ErrCircuitOpen)
+
+	// A successful trial call closes it.
+	healthy.Store(true)
+	time.Sleep(60 * time.Millisecond)
+	_, err = p.FetchRate(ctx, "EUR", "USD")
+	require.NoError(t, err)
+	_, err = p.FetchRate(ctx, "USD", "EUR")
+	assert.Error(t, err, "unquoted pair")
+	assert.NotErrorIs(t, err, provider.ErrCircuitOpen)
+	assert.Equal(t, int32(5), calls.Load())
+}
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	grpclib "google.golang.org/grpc"
+
+	fxv1 "github.com/bibbank/bib/api/gen/go/bib/fx/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/fx-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/fx-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fx-service/internal/domain/service"
+	"github.com/bibbank/bib/services/fx-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// cachedRateRepo serves the rates a contract state has cached.
+type cachedRateRepo struct {
+	rates map[string]model.ExchangeRate
+}
+
+func (r *cachedRateRepo) Save(_ context.Context, rate model.ExchangeRate) error {
+	r.rates[rate.Pair().String()] = rate
+	return nil
+}
+
+func (r *cachedRateRepo) FindByPair(_ context.Context, _ uuid.UUID, pair valueobject.CurrencyPair) (model.ExchangeRate, error) {
+	rate, ok := r.rates[pair.String()]
+	if !ok {
+		return model.ExchangeRate{}, errors.New("rate not found")
+	}
+	return rate, nil
+}
+
+func (r *cachedRateRepo) FindLatest(ctx context.Context, pair valueobject.CurrencyPair) (model.ExchangeRate, error) {
+	return r.FindByPair(ctx, uuid.Nil, pair)
+}
+
+func (r *cachedRateRepo) ListByBase(context.Context, uuid.UUID, string, time.Time) ([]model.ExchangeRate, error) {
+	return nil, nil
+}
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	repo := &cachedRateRepo{rates: map[string]model.ExchangeRate{}}
+	h := NewHandler(
+		usecase.NewGetExchangeRate(repo, nil, nil),
+		usecase.NewConvertAmount(repo, nil),
+		usecase.NewRevaluate(repo, nil, service.NewRevaluationEngine()),
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { fxv1.RegisterFXServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-fx-service.json", map[string]func(*testing.T){
+		"a USD/EUR rate is quoted": func(t *testing.T) {
+			pair, err := valueobject.NewCurrencyPair("USD", "EUR")
+			if err != nil {
+				t.Fatal(err)
+			}
+			spot, err := valueobject.NewSpotRate(decimal.RequireFromString("0.92"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			now := time.Now().UTC()
+			rate, err := model.NewExchangeRate(contractTenantID, pair, spot, "ecb", now, now.Add(time.Hour))
+			if err != nil {
+				t.Fatal(err)
+			}
+			repo.rates = map[string]model.ExchangeRate{pair.String(): rate}
+		},
+	})
+}
@@ -9,7 +9,10 @@ import (
 	"github.com/shopspring/decimal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	fxv1 "github.com/bibbank/bib/api/gen/go/bib/fx/v1"
 	"github.com/bibbank/bib/pkg/auth"
 	"github.com/bibbank/bib/services/fx-service/internal/application/dto"
 	"github.com/bibbank/bib/services/fx-service/internal/application/usecase"
@@ -41,11 +44,11 @@ func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
 }
 
 // Compile-time assertion that Handler implements FXServiceServer.
-var _ FXServiceServer = (*Handler)(nil)
+var _ fxv1.FXServiceServer = (*Handler)(nil)
 
 // Handler implements the FXServiceServer gRPC interface.
 type Handler struct {
-	UnimplementedFXServiceServer
+	fxv1.UnimplementedFXServiceServer
 	getRate   *usecase.GetExchangeRate
 	convert   *usecase.ConvertAmount
 	revaluate *usecase.Revaluate
@@ -67,85 +70,8 @@ func NewHandler(
 	}
 }
 
-// Proto-aligned request/response message types.
-
-// MoneyMsg represents the proto Money message.
-type MoneyMsg struct {
-	Amount   string `json:"amount"`
-	Currency string `json:"currency"`
-}
-
-// ExchangeRateMsg represents the proto ExchangeRate message.
-type ExchangeRateMsg struct {
-	ID            string `json:"id"`
-	TenantID      string `json:"tenant_id"`
-	BaseCurrency  string `json:"base_currency"`
-	QuoteCurrency string `json:"quote_currency"`
-	Rate          string `json:"rate"`
-	InverseRate   string `json:"inverse_rate"`
-	Provider      string `json:"provider"`
-}
-
-// GetExchangeRateRequest represents the proto GetExchangeRateRequest message.
-type GetExchangeRateRequest struct {
-	BaseCurrency  string `json:"base_currency"`
-	QuoteCurrency string `json:"quote_currency"`
-}
-
-// GetExchangeRateResponse represents the proto GetExchangeRateResponse message.
-type GetExchangeRateResponse struct {
-	BaseCurrency  string `json:"base_currency"`
-	QuoteCurrency string `json:"quote_currency"`
-	Rate          string `json:"rate"`
-	Timestamp     string `json:"timestamp"`
-}
-
-// ConvertAmountRequest represents the proto ConvertAmountRequest message.
-type ConvertAmountRequest struct {
-	TenantID     string `json:"tenant_id"`
-	FromCurrency string `json:"from_currency"`
-	ToCurrency   string `json:"to_currency"`
-	Amount       string `json:"amount"`
-}
-
-// ConvertAmountResponse represents the proto ConvertAmountResponse message.
-type ConvertAmountResponse struct {
-	OriginalAmount  string `json:"original_amount"`
-	ConvertedAmount string `json:"converted_amount"`
-	FromCurrency    string `json:"from_currency"`
-	ToCurrency      string `json:"to_currency"`
-	Rate            string `json:"rate"`
-}
-
-// ListExchangeRatesRequest represents the proto ListExchangeRatesRequest message.
-type ListExchangeRatesRequest struct {
-	BaseCurrency string `json:"base_currency"`
-	PageToken    string `json:"page_token"`
-	PageSize     int32  `json:"page_size"`
-}
-
-// ListExchangeRatesResponse represents the proto ListExchangeRatesResponse message.
-type ListExchangeRatesResponse struct {
-	NextPageToken string             `json:"next_page_token"`
-	Rates         []*ExchangeRateMsg `json:"rates"`
-	TotalCount    int32              `json:"total_count"`
-}
-
-// RevaluateRequest represents the proto RevaluateRequest message.
-type RevaluateRequest struct {
-	TenantID           string `json:"tenant_id"`
-	AsOfDate           string `json:"as_of_date"`
-	FunctionalCurrency string `json:"functional_currency"`
-}
-
-// RevaluateResponse represents the proto RevaluateResponse message.
-type RevaluateResponse struct {
-	TotalGainLoss     *MoneyMsg `json:"total_gain_loss"`
-	AccountsProcessed int32     `json:"accounts_processed"`
-}
-
 // GetExchangeRate returns the current exchange rate for a currency pair.
-func (h *Handler) GetExchangeRate(ctx context.Context, req *GetExchangeRateRequest) (*GetExchangeRateResponse, error) {
+func (h *Handler) GetExchangeRate(ctx context.Context, req *fxv1.GetExchangeRateRequest) (*fxv1.GetExchangeRateResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -185,16 +111,16 @@ func (h *Handler) GetExchangeRate(ctx context.Context, req *GetExchangeRateReque
 	}
 
 	h.logger.Info("GetExchangeRate succeeded", "pair", req.BaseCurrency+"/"+req.QuoteCurrency, "rate", resp.Rate.String())
-	return &GetExchangeRateResponse{
+	return &fxv1.GetExchangeRateResponse{
 		BaseCurrency:  resp.BaseCurrency,
 		QuoteCurrency: resp.QuoteCurrency,
 		Rate:          resp.Rate.String(),
-		Timestamp:     resp.EffectiveAt.UTC().Format("2006-01-02T15:04:05Z"),
+		EffectiveAt:   timestamppb.New(resp.EffectiveAt),
 	}, nil
 }
 
 // ConvertAmount converts an amount between two currencies.
-func (h *Handler) ConvertAmount(ctx context.Context, req *ConvertAmountRequest) (*ConvertAmountResponse, error) {
+func (h *Handler) ConvertAmount(ctx context.Context, req *fxv1.ConvertAmountRequest) (*fxv1.ConvertAmountResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -252,7 +178,7 @@ func (h *Handler) ConvertAmount(ctx context.Context, req *ConvertAmountRequest)
 		"original", resp.OriginalAmount.String(),
 		"converted", resp.ConvertedAmount.String(),
 	)
-	return &ConvertAmountResponse{
+	return &fxv1.ConvertAmountResponse{
 		OriginalAmount:  resp.OriginalAmount.StringFixed(2),
 		ConvertedAmount: resp.ConvertedAmount.StringFixed(2),
 		FromCurrency:    resp.FromCurrency,
@@ -262,7 +188,7 @@ func (h *Handler) ConvertAmount(ctx context.Context, req *ConvertAmountRequest)
 }
 
 // ListExchangeRates returns available exchange rates.
-func (h *Handler) ListExchangeRates(ctx context.Context, req *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error) {
+func (h *Handler) ListExchangeRates(ctx context.Context, req *fxv1.ListExchangeRatesRequest) (*fxv1.ListExchangeRatesResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -280,7 +206,7 @@ func (h *Handler) ListExchangeRates(ctx context.Context, req *ListExchangeRatesR
 }
 
 // Revaluate runs an ASC 830 FX revaluation.
-func (h *Handler) Revaluate(ctx context.Context, req *RevaluateRequest) (*RevaluateResponse, error) {
+func (h *Handler) Revaluate(ctx context.Context, req *fxv1.RevaluateRequest) (*fxv1.RevaluateResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
 		return nil, err
 	}
@@ -319,9 +245,9 @@ func (h *Handler) Revaluate(ctx context.Context, req *RevaluateRequest) (*Revalu
 		"total_gain_loss", resp.TotalGainLoss.String(),
 		"entries", len(resp.Entries),
 	)
-	return &RevaluateResponse{
+	return &fxv1.RevaluateResponse{
 		AccountsProcessed: int32(len(resp.Entries)), //nolint:gosec // bounded by slice length
-		TotalGainLoss: &MoneyMsg{
+		TotalGainLoss: &commonv1.Money{
 			Amount:   resp.TotalGainLoss.String(),
 			Currency: req.FunctionalCurrency,
 		},
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	fxv1 "github.com/bibbank/bib/api/gen/go/bib/fx/v1"
+)
+
+func TestJSONCodec_GeneratedMessages(t *testing.T) {
+	codec := jsonCodec{}
+
+	// JSON callers send snake_case fields, and may send fields the message
+	// does not have.
+	var req fxv1.ConvertAmountRequest
+	err := codec.Unmarshal([]byte(`{"tenant_id":"t1","from_currency":"USD","to_currency":"EUR","amount":"10.50","note":"x"}`), &req)
+	require.NoError(t, err)
+	assert.Equal(t, "USD", req.GetFromCurrency())
+	assert.Equal(t, "10.50", req.GetAmount())
+
+	data, err := codec.Marshal(&fxv1.GetExchangeRateResponse{
+		BaseCurrency:  "USD",
+		QuoteCurrency: "EUR",
+		Rate:          "0.92",
+		EffectiveAt:   timestamppb.New(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"base_currency":"USD","quote_currency":"EUR","rate":"0.92","effective_at":"2026-03-10T12:00:00Z"}`, string(data))
+}
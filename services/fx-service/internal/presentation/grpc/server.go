@@ -3,94 +3,56 @@ package grpc
 import (
 	"fmt"
 	"log/slog"
-	"net"
-	"os"
 
-	"github.com/bibbank/bib/pkg/auth"
-	"github.com/bibbank/bib/pkg/tlsutil"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/health"
-	healthpb "google.golang.org/grpc/health/grpc_health_v1"
-	"google.golang.org/grpc/reflection"
+
+	fxv1 "github.com/bibbank/bib/api/gen/go/bib/fx/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/grpcserver"
 )
 
-// Server wraps a gRPC server with health checks and the FX handler.
+// Server wraps the gRPC server for fx-service.
 type Server struct {
-	grpcServer *grpc.Server
-	handler    *Handler
-	logger     *slog.Logger
-	port       int
+	server  *grpcserver.Server
+	handler *Handler
+	port    int
 }
 
-// NewServer creates a new gRPC Server with health checking and reflection enabled.
-func NewServer(handler *Handler, logger *slog.Logger, port int, jwtService *auth.JWTService) *Server {
-	// Add auth interceptor, skipping health check methods.
-	authInterceptor := auth.UnaryAuthInterceptor(jwtService, []string{
-		"/grpc.health.v1.Health/Check",
-		"/grpc.health.v1.Health/Watch",
-	})
-
-	var serverOpts []grpc.ServerOption
-	serverOpts = append(serverOpts, grpc.UnaryInterceptor(authInterceptor))
-
-	// Optional TLS: set GRPC_TLS_CERT_FILE and GRPC_TLS_KEY_FILE to enable.
-	if certFile, keyFile := os.Getenv("GRPC_TLS_CERT_FILE"), os.Getenv("GRPC_TLS_KEY_FILE"); certFile != "" && keyFile != "" {
-		creds, err := tlsutil.ServerTLSConfig(certFile, keyFile)
-		if err != nil {
-			logger.Error("failed to load TLS credentials, starting without TLS", "error", err)
-		} else {
-			serverOpts = append(serverOpts, grpc.Creds(creds))
-			logger.Info("gRPC TLS enabled", "cert", certFile, "key", keyFile)
-		}
-	} else {
-		logger.Info("gRPC TLS not configured, running without TLS")
-	}
-
-	grpcServer := grpc.NewServer(serverOpts...)
-
-	// Register health service.
-	healthSrv := health.NewServer()
-	healthpb.RegisterHealthServer(grpcServer, healthSrv)
-	healthSrv.SetServingStatus("fx-service", healthpb.HealthCheckResponse_SERVING)
-
-	// Register the FXService handler.
-	RegisterFXServiceServer(grpcServer, handler)
-
-	// Only enable reflection when GRPC_REFLECTION=true.
-	if os.Getenv("GRPC_REFLECTION") == "true" {
-		reflection.Register(grpcServer)
+// NewServer creates a new gRPC server with the given handler.
+func NewServer(handler *Handler, logger *slog.Logger, port int, jwtService *auth.JWTService) (*Server, error) {
+	srv, err := grpcserver.New(grpcserver.Config{
+		ServiceName: "fx-service",
+		JWTService:  jwtService,
+	}, logger)
+	if err != nil {
+		return nil, err
 	}
+	fxv1.RegisterFXServiceServer(srv, handler)
 
 	return &Server{
-		grpcServer: grpcServer,
-		handler:    handler,
-		logger:     logger,
-		port:       port,
-	}
+		server:  srv,
+		handler: handler,
+		port:    port,
+	}, nil
 }
 
-// Start begins listening for gRPC connections.
+// Start begins listening for gRPC connections on the configured port.
 func (s *Server) Start() error {
-	addr := fmt.Sprintf(":%d", s.port)
-	lis, err := net.Listen("tcp", addr)
-	if err != nil {
-		return fmt.Errorf("grpc listen on %s: %w", addr, err)
-	}
-
-	s.logger.Info("gRPC server starting", "addr", addr)
-	if err := s.grpcServer.Serve(lis); err != nil {
-		return fmt.Errorf("grpc serve: %w", err)
-	}
-	return nil
+	return s.server.ListenAndServe(fmt.Sprintf(":%d", s.port))
 }
 
 // Stop gracefully stops the gRPC server.
 func (s *Server) Stop() {
-	s.logger.Info("gRPC server stopping")
-	s.grpcServer.GracefulStop()
+	s.server.Stop()
 }
 
 // Handler returns the registered FX handler.
 func (s *Server) Handler() *Handler {
 	return s.handler
 }
+
+// RegisterService registers another service on the server, such as the
+// outbox administration service.
+func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	s.server.RegisterService(desc, impl)
+}
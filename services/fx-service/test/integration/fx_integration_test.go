@@ -0,0 +1,82 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/fx-service/internal/domain/model"
+	"github.com/bibbank/bib/services/fx-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/fx-service/internal/infrastructure/postgres"
+)
+
+func newRate(t *testing.T, tenantID uuid.UUID, base, quote, rate string, effectiveAt time.Time) model.ExchangeRate {
+	t.Helper()
+	pair, err := valueobject.NewCurrencyPair(base, quote)
+	require.NoError(t, err)
+	spot, err := valueobject.NewSpotRate(decimal.RequireFromString(rate))
+	require.NoError(t, err)
+	er, err := model.NewExchangeRate(tenantID, pair, spot, "ecb", effectiveAt, effectiveAt.Add(time.Hour))
+	require.NoError(t, err)
+	return er
+}
+
+func TestExchangeRateRepo_UpdateWritesOutboxInSameTransaction(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewExchangeRateRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+	start := time.Now().UTC().Truncate(time.Second).Add(-time.Hour)
+
+	rate := newRate(t, tenantID, "USD", "EUR", "0.92", start)
+	require.NoError(t, repo.Save(ctx, rate))
+
+	spot, err := valueobject.NewSpotRate(decimal.RequireFromString("0.93"))
+	require.NoError(t, err)
+	updated, err := rate.Update(spot, "reuters", start.Add(time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, updated))
+
+	found, err := repo.FindByPair(ctx, tenantID, rate.Pair())
+	require.NoError(t, err)
+	assert.Equal(t, rate.ID(), found.ID())
+	assert.Equal(t, 2, found.Version())
+	assert.Equal(t, "reuters", found.Provider())
+	assert.True(t, decimal.RequireFromString("0.93").Equal(found.Rate().Rate()))
+
+	var pending int
+	require.NoError(t, env.Pool().QueryRow(ctx,
+		`SELECT count(*) FROM outbox WHERE aggregate_id = $1 AND published_at IS NULL`, rate.ID()).Scan(&pending))
+	assert.Equal(t, 1, pending, "the update's RateUpdated event is queued for relay")
+}
+
+func TestExchangeRateRepo_ListByBaseIsScopedToTenantAndTime(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewExchangeRateRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, repo.Save(ctx, newRate(t, tenantID, "USD", "EUR", "0.91", now.Add(-2*time.Hour))))
+	require.NoError(t, repo.Save(ctx, newRate(t, tenantID, "USD", "EUR", "0.92", now.Add(-time.Hour))))
+	require.NoError(t, repo.Save(ctx, newRate(t, tenantID, "USD", "GBP", "0.79", now.Add(-time.Hour))))
+	require.NoError(t, repo.Save(ctx, newRate(t, tenantID, "USD", "JPY", "150.10", now.Add(time.Hour))))
+	require.NoError(t, repo.Save(ctx, newRate(t, uuid.New(), "USD", "CHF", "0.88", now.Add(-time.Hour))))
+
+	rates, err := repo.ListByBase(ctx, tenantID, "USD", now)
+	require.NoError(t, err)
+	require.Len(t, rates, 2, "future rates and other tenants' rates are excluded")
+	want := map[string]string{"EUR": "0.92", "GBP": "0.79"}
+	for _, r := range rates {
+		assert.True(t, decimal.RequireFromString(want[r.Pair().Quote()]).Equal(r.Rate().Rate()),
+			"%s rate = %s", r.Pair(), r.Rate())
+	}
+}
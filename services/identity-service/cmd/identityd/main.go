@@ -10,18 +10,29 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/bibbank/bib/pkg/archive"
 	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/crypto"
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/pkg/events"
 	kafkapkg "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
 	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
 	pgpkg "github.com/bibbank/bib/pkg/postgres"
 	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
 	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/service"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
 	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/config"
-	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/kafka"
+	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/encryption"
+	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/objectstore"
 	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/postgres"
 	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/provider"
+	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/watchlist"
 	grpcPresentation "github.com/bibbank/bib/services/identity-service/internal/presentation/grpc"
 	"github.com/bibbank/bib/services/identity-service/internal/presentation/rest"
+	"google.golang.org/grpc"
 )
 
 func main() {
@@ -37,6 +48,7 @@ func main() {
 		Format: cfg.LogFormat,
 	})
 	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
 
 	logger.Info("starting identity-service",
 		"http_port", cfg.HTTPPort,
@@ -52,7 +64,7 @@ func main() {
 	if err != nil {
 		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
 	} else {
-		defer func() { _ = shutdown(ctx) }() //nolint:errcheck // best-effort tracer shutdown
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
 	}
 
 	// Initialize database
@@ -70,7 +82,7 @@ func main() {
 		logger.Error("failed to connect to database", "error", err)
 		os.Exit(1)
 	}
-	defer pool.Close()
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
 
 	// Run migrations
 	dsn := pgpkg.Config{
@@ -89,30 +101,229 @@ func main() {
 	producer := kafkapkg.NewProducer(kafkapkg.Config{
 		Brokers: cfg.Kafka.Brokers,
 	})
-	defer producer.Close()
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(producer.Close))
+
+	// Outbox relay: events are written to the outbox and published from it,
+	// so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     outbox.StaticRoute(usecase.TopicIdentityVerifications),
+		Encode:    outbox.CloudEventsEncoder("/bib/identity-service"),
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: cfg.Telemetry.ServiceName,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, producer, relayCfg, logger)
+	if err != nil {
+		logger.Error("failed to create outbox relay", "error", err)
+		os.Exit(1)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+
+	// Applicants' dates of birth are encrypted under per-tenant data keys
+	// when a KMS is configured.
+	kms, err := crypto.NewKMS(cfg.PII)
+	if err != nil {
+		logger.Error("invalid PII encryption config", "error", err)
+		os.Exit(1)
+	}
+	var pii *crypto.Cipher
+	if kms != nil {
+		pii = crypto.NewCipher(kms, crypto.NewPostgresKeyStore(pool))
+	} else {
+		logger.Warn("no KMS configured, storing personal data unencrypted")
+	}
+
+	// In sandbox mode no check reaches a live provider.
+	if cfg.Sandbox {
+		cfg.Onfido.Enabled, cfg.Persona.Enabled, cfg.Middesk.Enabled = false, false, false
+		cfg.Screening.ComplyAdvantage.Enabled, cfg.Duplicate.FaceEmbedding.Enabled = false, false
+		logger.Warn("sandbox mode, simulating all identity providers")
+	}
 
 	// Wire dependencies (DI via constructors)
-	verificationRepo := postgres.NewVerificationRepo(pool)
-	var verificationProvider port.VerificationProvider
-	if cfg.Persona.Enabled {
-		verificationProvider = provider.NewPersonaClient(cfg.Persona.APIKey, cfg.Persona.BaseURL)
+	verificationRepo := postgres.NewVerificationRepo(pool, pii)
+	var verificationProvider port.WebhookProvider
+	switch {
+	case cfg.Onfido.Enabled:
+		verificationProvider = provider.NewOnfidoClient(cfg.Onfido.APIToken, cfg.Onfido.BaseURL, cfg.Onfido.WebhookToken)
+		logger.Info("using Onfido API for identity verification")
+		if cfg.Onfido.WebhookToken == "" {
+			logger.Warn("ONFIDO_WEBHOOK_TOKEN not set, provider webhooks will be rejected")
+		}
+	case cfg.Persona.Enabled:
+		verificationProvider = provider.NewPersonaClient(cfg.Persona.APIKey, cfg.Persona.BaseURL, cfg.Persona.WebhookSecret)
 		logger.Info("using Persona API for identity verification")
-	} else {
+		if cfg.Persona.WebhookSecret == "" {
+			logger.Warn("PERSONA_WEBHOOK_SECRET not set, provider webhooks will be rejected")
+		}
+	default:
 		verificationProvider = provider.NewPersonaStub()
 	}
-	publisher := kafka.NewPublisher(producer)
+	publisher := outbox.NewPublisher(outboxStore)
+
+	businessRepo := postgres.NewBusinessVerificationRepo(pool)
+	var businessProvider port.BusinessVerificationProvider
+	if cfg.Middesk.Enabled {
+		businessProvider = provider.NewMiddeskClient(cfg.Middesk.APIKey, cfg.Middesk.BaseURL)
+		logger.Info("using Middesk API for business verification")
+	} else {
+		businessProvider = provider.NewBusinessStub()
+	}
+
+	screeningRepo := postgres.NewScreeningResultRepo(pool)
+	var screeningProvider port.ScreeningProvider
+	if cfg.Screening.ComplyAdvantage.Enabled {
+		ca := cfg.Screening.ComplyAdvantage
+		screeningProvider = provider.NewComplyAdvantageClient(ca.APIKey, ca.BaseURL, ca.Fuzziness)
+		logger.Info("using ComplyAdvantage API for PEP, sanctions and adverse-media screening")
+	} else {
+		screeningProvider = provider.NewScreeningStub()
+	}
+	var reloadWatchlistsUC *usecase.ReloadWatchlists
+	if wl := cfg.Screening.Watchlist; wl.Enabled() {
+		listClient := &http.Client{Timeout: 5 * time.Minute}
+		var sources []port.WatchlistSource
+		if wl.OFACSDNLocation != "" {
+			sources = append(sources, watchlist.NewOFACSDNSource(wl.OFACSDNLocation, wl.OFACAltLocation, listClient))
+		}
+		if wl.EULocation != "" {
+			sources = append(sources, watchlist.NewEUConsolidatedSource(wl.EULocation, listClient))
+		}
+		watchlistScreener := service.NewWatchlistScreener(wl.Threshold)
+		reloadWatchlistsUC = usecase.NewReloadWatchlists(watchlistScreener, sources...)
+		screeningProvider = provider.NewWatchlistProvider(watchlistScreener, screeningProvider)
+		logger.Info("screening sanctions checks against published watchlists", "lists", len(sources))
+	}
+
+	fingerprintRepo := postgres.NewFingerprintRepo(pool)
+	var faceEmbedder port.FaceEmbedder
+	if cfg.Duplicate.FaceEmbedding.Enabled {
+		faceEmbedder = provider.NewFaceEmbeddingClient(cfg.Duplicate.FaceEmbedding.APIKey, cfg.Duplicate.FaceEmbedding.BaseURL)
+		logger.Info("using face embedding API for selfie duplicate detection")
+	} else {
+		faceEmbedder = provider.NewFaceEmbedderStub()
+	}
+
+	// Document storage: content is encrypted in-process before it reaches the store.
+	documentRepo := postgres.NewDocumentRepo(pool)
+	var documentStore port.DocumentStore
+	switch cfg.Documents.Backend {
+	case "s3":
+		documentStore = objectstore.New(archive.NewS3Store(archive.S3Config(cfg.Documents.S3)))
+		logger.Info("storing identity documents in S3", "endpoint", cfg.Documents.S3.Endpoint, "bucket", cfg.Documents.S3.Bucket)
+	default:
+		documentStore = objectstore.New(archive.NewFileStore(cfg.Documents.Dir))
+		logger.Info("storing identity documents on local disk", "dir", cfg.Documents.Dir)
+	}
+	documentKeys, activeKeyID, err := encryption.ParseKeys(cfg.Documents.EncryptionKeys)
+	if err != nil {
+		logger.Error("invalid DOCUMENT_ENCRYPTION_KEYS", "error", err)
+		os.Exit(1)
+	}
+	documentCipher, err := encryption.NewKeyring(documentKeys, activeKeyID)
+	if err != nil {
+		logger.Error("failed to initialize document encryption", "error", err)
+		os.Exit(1)
+	}
+	documentRetention := time.Duration(cfg.Documents.RetentionDays) * 24 * time.Hour
+
+	const day = 24 * time.Hour
+	kycValidity := map[valueobject.RiskTier]time.Duration{
+		valueobject.RiskTierLow:    time.Duration(cfg.KYC.ValidityDaysLow) * day,
+		valueobject.RiskTierMedium: time.Duration(cfg.KYC.ValidityDaysMedium) * day,
+		valueobject.RiskTierHigh:   time.Duration(cfg.KYC.ValidityDaysHigh) * day,
+	}
 
 	// Use cases
-	initiateVerificationUC := usecase.NewInitiateVerification(verificationRepo, verificationProvider, publisher)
+	screenVerificationUC := usecase.NewScreenVerification(verificationRepo, screeningRepo, screeningProvider, publisher)
+	rescreenDueUC := usecase.NewRescreenDueVerifications(verificationRepo, screeningRepo, screenVerificationUC,
+		time.Duration(cfg.Screening.RescreenIntervalHours)*time.Hour)
+	getScreeningResultsUC := usecase.NewGetScreeningResults(verificationRepo, screeningRepo)
+	detectDuplicatesUC := usecase.NewDetectDuplicateIdentity(fingerprintRepo,
+		service.NewDuplicateDetector(cfg.Duplicate.NameThreshold, cfg.Duplicate.SelfieThreshold), faceEmbedder)
+	getDuplicateMatchesUC := usecase.NewGetDuplicateMatches(verificationRepo, fingerprintRepo)
+	initiateVerificationUC := usecase.NewInitiateVerification(verificationRepo, verificationProvider, publisher, screenVerificationUC, detectDuplicatesUC, kycValidity)
+	reverifyVerificationUC := usecase.NewReverifyVerification(verificationRepo, initiateVerificationUC)
+	refreshKYCUC := usecase.NewRefreshKYC(verificationRepo, publisher, time.Duration(cfg.KYC.ExpiryNoticeDays)*day)
 	getVerificationUC := usecase.NewGetVerification(verificationRepo)
 	completeCheckUC := usecase.NewCompleteCheck(verificationRepo, publisher)
 	listVerificationsUC := usecase.NewListVerifications(verificationRepo)
+	handleWebhookUC := usecase.NewHandleProviderWebhook(verificationRepo, verificationProvider, completeCheckUC)
+	uploadDocumentUC := usecase.NewUploadDocument(verificationRepo, documentRepo, documentStore, documentCipher, publisher, detectDuplicatesUC, documentRetention)
+	getDocumentUC := usecase.NewGetDocument(documentRepo, documentStore, documentCipher)
+	listDocumentsUC := usecase.NewListDocuments(documentRepo)
+	purgeDocumentsUC := usecase.NewPurgeExpiredDocuments(documentRepo, documentStore, publisher)
+	eraseApplicantUC := usecase.NewEraseApplicantData(verificationRepo, documentRepo, documentStore, fingerprintRepo, publisher)
+	eraseExpiredApplicantsUC := usecase.NewEraseExpiredApplicantData(verificationRepo, eraseApplicantUC,
+		time.Duration(cfg.Privacy.RetentionDays)*day)
+	exportApplicantUC := usecase.NewExportApplicantData(verificationRepo, documentRepo, screeningRepo, fingerprintRepo)
+	initiateBusinessUC := usecase.NewInitiateBusinessVerification(businessRepo, businessProvider, publisher)
+	getBusinessUC := usecase.NewGetBusinessVerification(businessRepo)
+	completeBusinessCheckUC := usecase.NewCompleteBusinessCheck(businessRepo, publisher)
+	reviewCaseRepo := postgres.NewReviewCaseRepo(pool)
+	openReviewCaseUC := usecase.NewOpenReviewCase(verificationRepo, reviewCaseRepo, publisher,
+		time.Duration(cfg.Review.SLAHours)*time.Hour, cfg.Review.FourEyes)
+	listReviewQueueUC := usecase.NewListReviewQueue(reviewCaseRepo)
+	getReviewCaseUC := usecase.NewGetReviewCase(reviewCaseRepo)
+	claimReviewCaseUC := usecase.NewClaimReviewCase(reviewCaseRepo, publisher)
+	assignReviewCaseUC := usecase.NewAssignReviewCase(reviewCaseRepo, publisher)
+	decideReviewCaseUC := usecase.NewDecideReviewCase(verificationRepo, reviewCaseRepo, publisher)
+	getReviewMetricsUC := usecase.NewGetReviewMetrics(reviewCaseRepo)
+	assessRiskUC := usecase.NewAssessApplicantRisk(verificationRepo, documentRepo, screeningRepo, publisher,
+		service.NewApplicantRiskScorer(cfg.Risk.HighRiskCountries), kycValidity)
+
+	// Consume our own verification events to queue verifications in REVIEW
+	// for analysts and to re-score applicants as risk evidence arrives.
+	eventConsumer := kafkapkg.NewConsumer(kafkapkg.Config{
+		Brokers:       cfg.Kafka.Brokers,
+		ConsumerGroup: cfg.Kafka.ConsumerGroup,
+	}, usecase.TopicIdentityVerifications, func(ctx context.Context, msg kafkapkg.Message) error {
+		eventType := msg.Headers["event_type"]
+		payload, err := events.EventData(msg.Value)
+		if err != nil {
+			return err
+		}
+		if err := openReviewCaseUC.Execute(ctx, eventType, payload); err != nil {
+			return err
+		}
+		return assessRiskUC.Execute(ctx, eventType, payload)
+	}, logger)
+	lc.OnStop(lifecycle.PhaseResources, "identity event consumer", lifecycle.Close(eventConsumer.Close))
+
+	// Take part in erasures coordinated by the privacy service.
+	erasureParticipant := erasure.NewParticipant("identity-service", []string{usecase.CategoryApplicantData},
+		usecase.NewApplicantDataEraser(eraseApplicantUC, eraseExpiredApplicantsUC), publisher, logger)
+	if err := erasureParticipant.Register(ctx); err != nil {
+		logger.Warn("failed to register with the privacy coordinator", "error", err)
+	}
+	erasureConsumer := kafkapkg.NewConsumer(kafkapkg.Config{
+		Brokers:       cfg.Kafka.Brokers,
+		ConsumerGroup: cfg.Kafka.ConsumerGroup,
+	}, erasure.Topic, func(ctx context.Context, msg kafkapkg.Message) error {
+		payload, err := events.EventData(msg.Value)
+		if err != nil {
+			return err
+		}
+		return erasureParticipant.Handle(ctx, msg.Headers["event_type"], payload)
+	}, logger)
+	lc.OnStop(lifecycle.PhaseResources, "erasure consumer", lifecycle.Close(erasureConsumer.Close))
 
 	// JWT service (validation-only: public key preferred, secret as fallback).
 	jwtCfg := auth.JWTConfig{
 		Issuer: "bib-gateway",
 	}
 	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
 	case os.Getenv("JWT_PUBLIC_KEY") != "":
 		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
 	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
@@ -141,14 +352,45 @@ func main() {
 		getVerificationUC,
 		completeCheckUC,
 		listVerificationsUC,
+		uploadDocumentUC,
+		getDocumentUC,
+		listDocumentsUC,
+		initiateBusinessUC,
+		getBusinessUC,
+		completeBusinessCheckUC,
+		getScreeningResultsUC,
+		screenVerificationUC,
+		reverifyVerificationUC,
+		listReviewQueueUC,
+		getReviewCaseUC,
+		claimReviewCaseUC,
+		assignReviewCaseUC,
+		decideReviewCaseUC,
+		getReviewMetricsUC,
+		getDuplicateMatchesUC,
+		eraseApplicantUC,
+		exportApplicantUC,
 		logger,
 	)
-	grpcServer := grpcPresentation.NewServer(handler, cfg.GRPCPort, logger, jwtSvc)
+	grpcServer, err := grpcPresentation.NewServer(handler, cfg.GRPCPort, logger, jwtSvc,
+		grpc.MaxRecvMsgSize(grpcPresentation.MaxDocumentMessageBytes),
+		grpc.MaxSendMsgSize(grpcPresentation.MaxDocumentMessageBytes),
+	)
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
 
-	// HTTP server (health checks + metrics)
+	// HTTP server (health checks, metrics and provider webhooks)
 	mux := http.NewServeMux()
 	healthHandler := rest.NewHealthHandler()
 	healthHandler.RegisterRoutes(mux)
+	if metricsHandler != nil {
+		mux.Handle("/metrics", metricsHandler)
+	}
+	webhookHandler := rest.NewWebhookHandler(handleWebhookUC, verificationProvider.Name(), verificationProvider.SignatureHeader(), logger)
+	webhookHandler.RegisterRoutes(mux)
 
 	httpServer := &http.Server{
 		Addr:              fmt.Sprintf(":%d", cfg.HTTPPort),
@@ -156,30 +398,120 @@ func main() {
 		ReadHeaderTimeout: 10 * time.Second,
 	}
 
-	// Start servers
-	errCh := make(chan error, 2)
+	// Start consumers and background jobs
+	lc.Go(lifecycle.PhaseConsumers, "identity event consumer", eventConsumer.Start)
+	lc.Go(lifecycle.PhaseConsumers, "erasure consumer", erasureConsumer.Start)
 
-	go func() {
-		errCh <- grpcServer.Start(ctx)
-	}()
+	// Destroy document content once its retention period ends.
+	lc.Go(lifecycle.PhaseWorkers, "document retention purge", func(ctx context.Context) error {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case now := <-ticker.C:
+				purged, purgeErr := purgeDocumentsUC.Execute(ctx, now.UTC())
+				if purgeErr != nil {
+					logger.Error("document retention purge failed", "error", purgeErr)
+				} else if purged > 0 {
+					logger.Info("purged expired identity documents", "count", purged)
+				}
+			}
+		}
+	})
 
-	go func() {
-		logger.Info("HTTP server starting", "port", cfg.HTTPPort)
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			errCh <- err
+	// Erase applicant data of decided verifications once their retention period ends.
+	lc.Go(lifecycle.PhaseWorkers, "applicant data erasure", func(ctx context.Context) error {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case now := <-ticker.C:
+				erased, eraseErr := eraseExpiredApplicantsUC.Execute(ctx, now.UTC())
+				if eraseErr != nil {
+					logger.Error("applicant data retention erasure failed", "error", eraseErr)
+				}
+				if erased.Erased > 0 {
+					logger.Info("erased applicant data past retention", "verifications", erased.Erased, "documents", erased.DocumentsErased)
+				}
+			}
 		}
-	}()
+	})
 
-	// Wait for shutdown
-	select {
-	case <-ctx.Done():
-		logger.Info("shutdown signal received")
-	case err := <-errCh:
-		logger.Error("server error", "error", err)
+	// Load sanctions lists, then pick up republished lists in the background.
+	if reloadWatchlistsUC != nil {
+		if n, reloadErr := reloadWatchlistsUC.Execute(ctx); reloadErr != nil {
+			logger.Warn("failed to load sanctions watchlists, sanctions checks will fail until lists load", "error", reloadErr)
+		} else {
+			logger.Info("sanctions watchlists loaded", "entries", n)
+		}
+		lc.Go(lifecycle.PhaseWorkers, "watchlist reload", func(ctx context.Context) error {
+			ticker := time.NewTicker(time.Duration(cfg.Screening.Watchlist.ReloadIntervalHours) * time.Hour)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+					if _, reloadErr := reloadWatchlistsUC.Execute(ctx); reloadErr != nil {
+						logger.Warn("failed to reload sanctions watchlists", "error", reloadErr)
+					}
+				}
+			}
+		})
 	}
 
-	// Graceful shutdown
-	_ = httpServer.Shutdown(context.Background()) //nolint:errcheck // best-effort shutdown
-	grpcServer.Stop()
+	// Ongoing monitoring: re-screen approved verifications whose last screening is stale.
+	lc.Go(lifecycle.PhaseWorkers, "ongoing screening", func(ctx context.Context) error {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case now := <-ticker.C:
+				rescreened, rescreenErr := rescreenDueUC.Execute(ctx, now.UTC())
+				if rescreenErr != nil {
+					logger.Error("ongoing screening failed", "error", rescreenErr)
+				}
+				if rescreened > 0 {
+					logger.Info("re-screened approved verifications", "count", rescreened)
+				}
+			}
+		}
+	})
+
+	// Periodic KYC refresh: warn ahead of expiry, then expire lapsed approvals.
+	lc.Go(lifecycle.PhaseWorkers, "KYC refresh", func(ctx context.Context) error {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case now := <-ticker.C:
+				refreshed, refreshErr := refreshKYCUC.Execute(ctx, now.UTC())
+				if refreshErr != nil {
+					logger.Error("KYC refresh failed", "error", refreshErr)
+				}
+				if refreshed.Flagged > 0 || refreshed.Expired > 0 {
+					logger.Info("refreshed KYC verifications", "flagged", refreshed.Flagged, "expired", refreshed.Expired)
+				}
+			}
+		}
+	})
+
+	// Start servers
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", grpcServer.Start)
+	lc.ServeHTTP("HTTP server", httpServer)
+
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
+	}
 	logger.Info("identity-service stopped")
 }
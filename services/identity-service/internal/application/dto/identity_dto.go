@@ -7,18 +7,28 @@ import (
 )
 
 // InitiateVerificationRequest is the input DTO for initiating a new verification.
+// ScreeningChecks optionally adds PEP, SANCTIONS or ADVERSE_MEDIA checks to
+// the default checks. RiskTier defaults to MEDIUM and is the floor for the
+// scored tier. PreviousVerificationID is set when the verification
+// re-verifies an earlier one. DocumentNumber is the applicant's identity
+// document number, used only to detect duplicate identities.
 type InitiateVerificationRequest struct {
-	FirstName   string
-	LastName    string
-	Email       string
-	DateOfBirth string
-	Country     string
-	TenantID    uuid.UUID
+	FirstName              string
+	LastName               string
+	Email                  string
+	DateOfBirth            string
+	Country                string
+	RiskTier               string
+	DocumentNumber         string
+	ScreeningChecks        []string
+	TenantID               uuid.UUID
+	PreviousVerificationID uuid.UUID
 }
 
 // GetVerificationRequest is the input DTO for retrieving a verification.
 type GetVerificationRequest struct {
-	ID uuid.UUID
+	TenantID uuid.UUID
+	ID       uuid.UUID
 }
 
 // CompleteCheckRequest is the input DTO for completing a verification check (webhook callback).
@@ -47,24 +57,368 @@ type VerificationCheckDTO struct {
 	ID                uuid.UUID
 }
 
-// VerificationResponse is the output DTO for a verification.
+// VerificationResponse is the output DTO for a verification. ExpiresAt is
+// set once the verification is approved. RiskScore and RiskFactors explain
+// the applicant's current risk tier.
 type VerificationResponse struct {
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+	ExpiresAt              *time.Time
+	ErasedAt               *time.Time
+	ApplicantFirstName     string
+	ApplicantLastName      string
+	ApplicantEmail         string
+	ApplicantDOB           string
+	ApplicantCountry       string
+	Status                 string
+	RiskTier               string
+	RiskFactors            []string
+	Checks                 []VerificationCheckDTO
+	RiskScore              int
+	Version                int
+	ID                     uuid.UUID
+	TenantID               uuid.UUID
+	PreviousVerificationID uuid.UUID
+}
+
+// ListVerificationsResponse is the output DTO for listing verifications.
+type ListVerificationsResponse struct {
+	Verifications []VerificationResponse
+	TotalCount    int
+}
+
+// ProviderWebhookRequest is the input DTO for a KYC provider webhook callback.
+type ProviderWebhookRequest struct {
+	Signature string
+	Payload   []byte
+}
+
+// ProviderWebhookResponse is the output DTO for a provider webhook. Applied
+// is false when the callback was acknowledged without changing any check:
+// non-check events, interim statuses and redelivered results.
+type ProviderWebhookResponse struct {
+	Status         string
+	Applied        bool
+	VerificationID uuid.UUID
+	CheckID        uuid.UUID
+}
+
+// UploadDocumentRequest is the input DTO for uploading an identity document.
+// CheckID is optional; when nil the document is linked to the check its type
+// evidences.
+type UploadDocumentRequest struct {
+	DocumentType   string
+	Content        []byte
+	TenantID       uuid.UUID
+	VerificationID uuid.UUID
+	CheckID        uuid.UUID
+}
+
+// GetDocumentRequest is the input DTO for retrieving a document. Content is
+// decrypted and returned only when IncludeContent is set.
+type GetDocumentRequest struct {
+	IncludeContent bool
+	TenantID       uuid.UUID
+	DocumentID     uuid.UUID
+}
+
+// ListDocumentsRequest is the input DTO for listing a verification's documents.
+type ListDocumentsRequest struct {
+	TenantID       uuid.UUID
+	VerificationID uuid.UUID
+}
+
+// DocumentResponse is the output DTO for document metadata.
+type DocumentResponse struct {
+	UploadedAt     time.Time
+	RetainUntil    time.Time
+	PurgedAt       *time.Time
+	DocumentType   string
+	ContentType    string
+	SHA256         string
+	SizeBytes      int64
+	ID             uuid.UUID
+	VerificationID uuid.UUID
+	CheckID        uuid.UUID
+}
+
+// GetDocumentResponse is the output DTO for a document with optional content.
+type GetDocumentResponse struct {
+	Content  []byte
+	Document DocumentResponse
+}
+
+// ListDocumentsResponse is the output DTO for a verification's documents.
+type ListDocumentsResponse struct {
+	Documents []DocumentResponse
+}
+
+// BeneficialOwnerDTO transfers beneficial owner data across layer boundaries.
+type BeneficialOwnerDTO struct {
+	FirstName        string
+	LastName         string
+	DateOfBirth      string
+	Country          string
+	OwnershipPercent float64
+}
+
+// InitiateBusinessVerificationRequest is the input DTO for starting a business (KYB) verification.
+type InitiateBusinessVerificationRequest struct {
+	LegalName          string
+	RegistrationNumber string
+	Country            string
+	BeneficialOwners   []BeneficialOwnerDTO
+	TenantID           uuid.UUID
+}
+
+// GetBusinessVerificationRequest is the input DTO for retrieving a business verification.
+type GetBusinessVerificationRequest struct {
+	TenantID uuid.UUID
+	ID       uuid.UUID
+}
+
+// CompleteBusinessCheckRequest is the input DTO for completing a business verification check.
+type CompleteBusinessCheckRequest struct {
+	Status         string
+	FailureReason  string
+	TenantID       uuid.UUID
+	VerificationID uuid.UUID
+	CheckID        uuid.UUID
+}
+
+// BusinessVerificationResponse is the output DTO for a business verification.
+type BusinessVerificationResponse struct {
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
-	ApplicantFirstName string
-	ApplicantLastName  string
-	ApplicantEmail     string
-	ApplicantDOB       string
-	ApplicantCountry   string
+	LegalName          string
+	RegistrationNumber string
+	Country            string
 	Status             string
+	BeneficialOwners   []BeneficialOwnerDTO
 	Checks             []VerificationCheckDTO
 	Version            int
 	ID                 uuid.UUID
 	TenantID           uuid.UUID
 }
 
-// ListVerificationsResponse is the output DTO for listing verifications.
-type ListVerificationsResponse struct {
-	Verifications []VerificationResponse
-	TotalCount    int
+// RescreenVerificationRequest is the input DTO for re-running a verification's screening checks.
+type RescreenVerificationRequest struct {
+	TenantID       uuid.UUID
+	VerificationID uuid.UUID
+}
+
+// ReverifyVerificationRequest is the input DTO for re-verifying an applicant
+// whose verification is expiring or has expired.
+type ReverifyVerificationRequest struct {
+	TenantID       uuid.UUID
+	VerificationID uuid.UUID
+}
+
+// RefreshKYCResult reports what one KYC refresh run did.
+type RefreshKYCResult struct {
+	Flagged int
+	Expired int
+}
+
+// GetScreeningResultsRequest is the input DTO for listing a verification's screening results.
+type GetScreeningResultsRequest struct {
+	TenantID       uuid.UUID
+	VerificationID uuid.UUID
+}
+
+// ScreeningMatchDTO transfers a screening match across layer boundaries.
+type ScreeningMatchDTO struct {
+	Name       string
+	Categories []string
+	Sources    []string
+	EntryID    string
+	Score      float64
+}
+
+// ScreeningResultDTO is the output DTO for one screening run.
+type ScreeningResultDTO struct {
+	ScreenedAt        time.Time
+	CheckType         string
+	Provider          string
+	ProviderReference string
+	Trigger           string
+	Outcome           string
+	Matches           []ScreeningMatchDTO
+	ID                uuid.UUID
+	CheckID           uuid.UUID
+}
+
+// ScreeningResultsResponse is the output DTO for a verification's screening results.
+type ScreeningResultsResponse struct {
+	Results []ScreeningResultDTO
+}
+
+// ListReviewQueueRequest is the input DTO for listing a tenant's review cases.
+// Status and AssigneeID optionally filter the queue.
+type ListReviewQueueRequest struct {
+	Status     string
+	TenantID   uuid.UUID
+	AssigneeID uuid.UUID
+	PageSize   int
+	Offset     int
+}
+
+// GetReviewCaseRequest is the input DTO for retrieving a review case.
+type GetReviewCaseRequest struct {
+	TenantID uuid.UUID
+	CaseID   uuid.UUID
+}
+
+// ClaimReviewCaseRequest is the input DTO for an analyst claiming a review case.
+type ClaimReviewCaseRequest struct {
+	TenantID  uuid.UUID
+	CaseID    uuid.UUID
+	AnalystID uuid.UUID
+}
+
+// AssignReviewCaseRequest is the input DTO for assigning a review case to an analyst.
+type AssignReviewCaseRequest struct {
+	TenantID   uuid.UUID
+	CaseID     uuid.UUID
+	AssigneeID uuid.UUID
+	AssignedBy uuid.UUID
+}
+
+// DecideReviewCaseRequest is the input DTO for recording an analyst's
+// APPROVE or REJECT decision on a review case.
+type DecideReviewCaseRequest struct {
+	Outcome   string
+	Reason    string
+	TenantID  uuid.UUID
+	CaseID    uuid.UUID
+	AnalystID uuid.UUID
+}
+
+// ReviewDecisionDTO transfers a recorded review decision across layer boundaries.
+type ReviewDecisionDTO struct {
+	DecidedAt time.Time
+	Outcome   string
+	Reason    string
+	AnalystID uuid.UUID
+}
+
+// ReviewCaseResponse is the output DTO for a review case.
+type ReviewCaseResponse struct {
+	OpenedAt         time.Time
+	DueAt            time.Time
+	ClaimedAt        *time.Time
+	DecidedAt        *time.Time
+	Status           string
+	Reason           string
+	Outcome          string
+	Decisions        []ReviewDecisionDTO
+	RequiresFourEyes bool
+	Overdue          bool
+	ID               uuid.UUID
+	VerificationID   uuid.UUID
+	AssigneeID       uuid.UUID
+}
+
+// ListReviewQueueResponse is the output DTO for a review queue listing.
+type ListReviewQueueResponse struct {
+	Cases      []ReviewCaseResponse
+	TotalCount int
+}
+
+// GetReviewMetricsRequest is the input DTO for review SLA metrics. Since
+// starts the window for decision metrics and defaults to 30 days ago.
+type GetReviewMetricsRequest struct {
+	Since    time.Time
+	TenantID uuid.UUID
+}
+
+// ReviewMetricsResponse is the output DTO for review SLA metrics.
+type ReviewMetricsResponse struct {
+	Since                  time.Time
+	AvgTimeToDecision      time.Duration
+	P95TimeToDecision      time.Duration
+	WithinSLARate          float64
+	Open                   int
+	AwaitingSecondApproval int
+	Overdue                int
+	Decided                int
+	DecidedWithinSLA       int
+}
+
+// GetDuplicateMatchesRequest is the input DTO for retrieving the other
+// verifications a verification's applicant matched.
+type GetDuplicateMatchesRequest struct {
+	TenantID       uuid.UUID
+	VerificationID uuid.UUID
+}
+
+// DuplicateMatchResponse is the output DTO for one matched verification.
+// Reasons lists the identifiers that matched: document_number, name_dob or
+// selfie.
+type DuplicateMatchResponse struct {
+	Reasons        []string
+	Score          float64
+	VerificationID uuid.UUID
+}
+
+// DuplicateMatchesResponse is the output DTO for a verification's duplicate
+// matches.
+type DuplicateMatchesResponse struct {
+	Matches        []DuplicateMatchResponse
+	VerificationID uuid.UUID
+	ApplicantID    uuid.UUID
+}
+
+// EraseApplicantDataRequest is the input DTO for erasing a data subject's
+// personal data on their verified request. RequestReference identifies the
+// verified request, e.g. the privacy ticket.
+type EraseApplicantDataRequest struct {
+	ApplicantEmail   string
+	RequestReference string
+	TenantID         uuid.UUID
+}
+
+// RetainedVerificationDTO is a verification kept out of an erasure, with the
+// reason it must be retained.
+type RetainedVerificationDTO struct {
+	Status         string
+	Reason         string
+	VerificationID uuid.UUID
+}
+
+// EraseApplicantDataResponse is the output DTO for a data subject erasure.
+type EraseApplicantDataResponse struct {
+	ErasedVerificationIDs []uuid.UUID
+	Retained              []RetainedVerificationDTO
+	DocumentsErased       int
+}
+
+// ExportApplicantDataRequest is the input DTO for exporting a data subject's
+// personal data.
+type ExportApplicantDataRequest struct {
+	ApplicantEmail string
+	TenantID       uuid.UUID
+}
+
+// ApplicantVerificationExport is everything held about one of the data
+// subject's verifications. Document content is not inlined; it can be
+// retrieved per document until it is purged.
+type ApplicantVerificationExport struct {
+	Documents        []DocumentResponse
+	ScreeningResults []ScreeningResultDTO
+	DuplicateMatches []DuplicateMatchResponse
+	Verification     VerificationResponse
+}
+
+// ApplicantDataExportResponse is the output DTO for a data subject export.
+type ApplicantDataExportResponse struct {
+	ExportedAt     time.Time
+	ApplicantEmail string
+	Verifications  []ApplicantVerificationExport
+}
+
+// EraseExpiredApplicantDataResult summarizes one retention erasure run.
+type EraseExpiredApplicantDataResult struct {
+	Erased          int
+	DocumentsErased int
 }
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+)
+
+// CategoryApplicantData is the data category of applicants' personal data:
+// their details on verifications and their documents.
+const CategoryApplicantData = "identity.applicant_data"
+
+// maxRetentionBatches bounds the batches one retention request erases, so
+// a request cannot hold the consumer indefinitely; the remainder is erased
+// by the next request or the service's own retention job.
+const maxRetentionBatches = 50
+
+// ApplicantDataEraser takes part in erasures coordinated by the privacy
+// service. Subject requests erase the applicant's data by email, as
+// EraseApplicantData does; retention requests erase the tenant's decided
+// verifications older than the request's cutoff.
+type ApplicantDataEraser struct {
+	subject   *EraseApplicantData
+	retention *EraseExpiredApplicantData
+}
+
+func NewApplicantDataEraser(subject *EraseApplicantData, retention *EraseExpiredApplicantData) *ApplicantDataEraser {
+	return &ApplicantDataEraser{subject: subject, retention: retention}
+}
+
+func (e *ApplicantDataEraser) Erase(ctx context.Context, req erasure.Request) (erasure.Result, error) {
+	switch req.Kind {
+	case erasure.KindSubject:
+		return e.eraseSubject(ctx, req)
+	case erasure.KindRetention:
+		return e.eraseRetained(ctx, req)
+	default:
+		return erasure.Result{}, fmt.Errorf("%w: kind %q", erasure.ErrUnsupported, req.Kind)
+	}
+}
+
+func (e *ApplicantDataEraser) eraseSubject(ctx context.Context, req erasure.Request) (erasure.Result, error) {
+	// Applicants are only known by email.
+	if req.Subject.Email == "" {
+		return erasure.Result{}, nil
+	}
+	resp, err := e.subject.Execute(ctx, dto.EraseApplicantDataRequest{
+		TenantID:         req.TenantID,
+		ApplicantEmail:   req.Subject.Email,
+		RequestReference: req.Reference,
+	})
+	if errors.Is(err, ErrNotFound) {
+		return erasure.Result{}, nil
+	}
+	result := erasure.Result{Erased: len(resp.ErasedVerificationIDs)}
+	for _, r := range resp.Retained {
+		result.Retained = append(result.Retained, erasure.Retained{
+			Record: "identity_verification/" + r.VerificationID.String(),
+			Reason: r.Reason,
+		})
+	}
+	return result, err
+}
+
+func (e *ApplicantDataEraser) eraseRetained(ctx context.Context, req erasure.Request) (erasure.Result, error) {
+	if req.Cutoff.IsZero() {
+		return erasure.Result{}, fmt.Errorf("%w: retention cutoff is required", ErrInvalidInput)
+	}
+	var result erasure.Result
+	for range maxRetentionBatches {
+		batch, err := e.retention.ExecuteBefore(ctx, req.TenantID, req.Cutoff, time.Now().UTC())
+		result.Erased += batch.Erased
+		if err != nil {
+			return result, err
+		}
+		if batch.Erased < erasureBatchSize {
+			break
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/service"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// riskEvidenceEvents are the identity events after which new risk evidence
+// may be available: the applicant's country at initiation, uploaded
+// documents, screening hits and provider results that flag a review,
+// duplicate identity matches, and the final approval.
+var riskEvidenceEvents = map[string]bool{
+	"identity.verification.initiated":          true,
+	"identity.document.uploaded":               true,
+	"identity.verification.flagged_for_review": true,
+	"identity.verification.duplicate_detected": true,
+	"identity.verification.completed":          true,
+}
+
+// AssessApplicantRisk consumes identity events and re-scores the applicant
+// from everything known about them. A changed score or tier is saved and
+// published as VerificationRiskTiered. Provider signals are kept once seen,
+// since the checks that raised them lose their reason when resolved.
+type AssessApplicantRisk struct {
+	repo       port.VerificationRepository
+	documents  port.DocumentRepository
+	screenings port.ScreeningResultRepository
+	publisher  port.EventPublisher
+	scorer     *service.ApplicantRiskScorer
+	validity   map[valueobject.RiskTier]time.Duration
+}
+
+func NewAssessApplicantRisk(
+	repo port.VerificationRepository,
+	documents port.DocumentRepository,
+	screenings port.ScreeningResultRepository,
+	publisher port.EventPublisher,
+	scorer *service.ApplicantRiskScorer,
+	validity map[valueobject.RiskTier]time.Duration,
+) *AssessApplicantRisk {
+	return &AssessApplicantRisk{
+		repo:       repo,
+		documents:  documents,
+		screenings: screenings,
+		publisher:  publisher,
+		scorer:     scorer,
+		validity:   validity,
+	}
+}
+
+// riskEvidenceEvent holds the field shared by the events that trigger re-scoring.
+type riskEvidenceEvent struct {
+	VerificationID uuid.UUID `json:"verification_id"`
+}
+
+// Execute applies an identity event of the given type; other event types are ignored.
+func (uc *AssessApplicantRisk) Execute(ctx context.Context, eventType string, payload []byte) error {
+	if !riskEvidenceEvents[eventType] {
+		return nil
+	}
+	var evt riskEvidenceEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+
+	verification, err := uc.repo.FindByID(ctx, evt.VerificationID)
+	if err != nil {
+		return fmt.Errorf("failed to find verification: %w", err)
+	}
+	// A decided rejection or lapsed approval no longer carries a live tier.
+	if verification.Status().Equal(valueobject.StatusRejected) || verification.Status().Equal(valueobject.StatusExpired) {
+		return nil
+	}
+
+	input, err := uc.riskInput(ctx, verification)
+	if err != nil {
+		return err
+	}
+	assessment := uc.scorer.Score(input)
+
+	assessed, err := verification.ApplyRiskAssessment(assessment.Tier, assessment.Score, assessment.Factors,
+		func(tier valueobject.RiskTier) time.Duration { return tierValidity(uc.validity, tier) }, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to apply risk assessment: %w", err)
+	}
+	if assessed.Version() == verification.Version() {
+		return nil
+	}
+
+	if err := uc.repo.Save(ctx, assessed); err != nil {
+		return fmt.Errorf("failed to save verification: %w", err)
+	}
+	if events := assessed.DomainEvents(); len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, TopicIdentityVerifications, events...); err != nil {
+			return fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+	return nil
+}
+
+// riskInput gathers the risk evidence for a verification.
+func (uc *AssessApplicantRisk) riskInput(ctx context.Context, v model.IdentityVerification) (service.ApplicantRiskInput, error) {
+	input := service.ApplicantRiskInput{Country: v.ApplicantCountry()}
+
+	documents, err := uc.documents.ListByVerification(ctx, v.TenantID(), v.ID())
+	if err != nil {
+		return service.ApplicantRiskInput{}, fmt.Errorf("failed to list documents: %w", err)
+	}
+	for _, d := range documents {
+		input.DocumentTypes = append(input.DocumentTypes, d.DocumentType())
+	}
+
+	// A match counts once seen, even if a later re-screen comes back clear.
+	results, err := uc.screenings.ListByVerification(ctx, v.TenantID(), v.ID())
+	if err != nil {
+		return service.ApplicantRiskInput{}, fmt.Errorf("failed to list screening results: %w", err)
+	}
+	for _, r := range results {
+		if r.Outcome() == model.ScreeningOutcomeHit {
+			input.ScreeningHits = append(input.ScreeningHits, r.CheckType())
+		}
+	}
+
+	for _, f := range v.RiskFactors() {
+		if signal, ok := strings.CutPrefix(f, service.ProviderFactorPrefix); ok {
+			input.ProviderSignals = append(input.ProviderSignals, signal)
+		}
+	}
+	for _, c := range v.Checks() {
+		flagged := c.Status().Equal(valueobject.StatusReview) || c.Status().Equal(valueobject.StatusRejected)
+		if c.CheckType().Equal(valueobject.CheckTypeDuplicate) {
+			input.DuplicateIdentity = input.DuplicateIdentity || flagged
+			continue
+		}
+		if c.CheckType().IsScreening() || c.FailureReason() == "" {
+			continue
+		}
+		if flagged {
+			input.ProviderSignals = append(input.ProviderSignals, c.FailureReason())
+		}
+	}
+	return input, nil
+}
@@ -0,0 +1,100 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/event"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/service"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+func newAssessApplicantRisk(v model.IdentityVerification, documents *mockDocumentRepository, screenings *mockScreeningResultRepository) (*usecase.AssessApplicantRisk, *mockVerificationRepository, *mockEventPublisher) {
+	repo := &mockVerificationRepository{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) { return v, nil },
+	}
+	publisher := &mockEventPublisher{}
+	uc := usecase.NewAssessApplicantRisk(repo, documents, screenings, publisher,
+		service.NewApplicantRiskScorer([]string{"IR"}), map[valueobject.RiskTier]time.Duration{
+			valueobject.RiskTierHigh: 180 * 24 * time.Hour,
+		})
+	return uc, repo, publisher
+}
+
+func verificationPayload(v model.IdentityVerification) []byte {
+	return []byte(`{"verification_id":"` + v.ID().String() + `"}`)
+}
+
+func TestAssessApplicantRisk_ScreeningHitRaisesTier(t *testing.T) {
+	v := inProgressVerification()
+	screenings := &mockScreeningResultRepository{}
+	hit, err := model.NewScreeningResult(v.TenantID(), v.ID(), uuid.New(), valueobject.CheckTypeSanctions,
+		"complyadvantage", "search-1", model.ScreeningTriggerInitial, sanctionsHit()[valueobject.CheckTypeSanctions], time.Now().UTC())
+	require.NoError(t, err)
+	screenings.saved = append(screenings.saved, hit)
+	uc, repo, publisher := newAssessApplicantRisk(v, newMockDocumentRepository(), screenings)
+
+	require.NoError(t, uc.Execute(context.Background(), "identity.verification.flagged_for_review", verificationPayload(v)))
+
+	require.Len(t, repo.savedVerifications, 1)
+	saved := repo.savedVerifications[0]
+	assert.Equal(t, valueobject.RiskTierHigh, saved.RiskTier())
+	assert.Equal(t, 70, saved.RiskScore())
+	assert.Equal(t, []string{"sanctions_hit"}, saved.RiskFactors())
+	assert.Equal(t, 180*24*time.Hour, saved.Validity())
+
+	require.NotEmpty(t, publisher.publishedEvents)
+	evt, ok := publisher.publishedEvents[len(publisher.publishedEvents)-1].(event.VerificationRiskTiered)
+	require.True(t, ok)
+	assert.Equal(t, "HIGH", evt.RiskTier)
+	assert.Equal(t, "MEDIUM", evt.PreviousRiskTier)
+}
+
+func TestAssessApplicantRisk_KeepsProviderSignals(t *testing.T) {
+	v := verificationInReview(t)
+	documents := newMockDocumentRepository()
+	doc, err := model.NewIdentityDocument(v.TenantID(), v.ID(), v.Checks()[0].ID(), valueobject.DocumentTypeDriversLicense,
+		"image/jpeg", 1024, strings.Repeat("a", 64), 24*time.Hour, time.Now().UTC())
+	require.NoError(t, err)
+	documents.documents[doc.ID()] = doc
+	uc, repo, _ := newAssessApplicantRisk(v, documents, &mockScreeningResultRepository{})
+
+	require.NoError(t, uc.Execute(context.Background(), "identity.document.uploaded", verificationPayload(v)))
+
+	require.Len(t, repo.savedVerifications, 1)
+	saved := repo.savedVerifications[0]
+	assert.Equal(t, 35, saved.RiskScore())
+	assert.Equal(t, []string{"no_primary_id_document", "provider:onfido_result_consider"}, saved.RiskFactors())
+	assert.Equal(t, valueobject.RiskTierMedium, saved.RiskTier())
+}
+
+func TestAssessApplicantRisk_UnchangedAssessmentIsNotSaved(t *testing.T) {
+	v := inProgressVerification()
+	v, err := v.ApplyRiskAssessment(valueobject.RiskTierLow, 10, nil, func(tier valueobject.RiskTier) time.Duration {
+		return tier.DefaultValidity()
+	}, time.Now().UTC())
+	require.NoError(t, err)
+	uc, repo, publisher := newAssessApplicantRisk(v, newMockDocumentRepository(), &mockScreeningResultRepository{})
+
+	require.NoError(t, uc.Execute(context.Background(), "identity.verification.completed", verificationPayload(v)))
+
+	assert.Empty(t, repo.savedVerifications)
+	assert.Empty(t, publisher.publishedEvents)
+}
+
+func TestAssessApplicantRisk_IgnoresOtherEvents(t *testing.T) {
+	v := inProgressVerification()
+	uc, repo, _ := newAssessApplicantRisk(v, newMockDocumentRepository(), &mockScreeningResultRepository{})
+
+	require.NoError(t, uc.Execute(context.Background(), "identity.verification.risk_tiered", verificationPayload(v)))
+
+	assert.Empty(t, repo.savedVerifications)
+}
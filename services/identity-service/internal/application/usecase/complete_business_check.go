@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// CompleteBusinessCheck records the outcome of a business verification check.
+type CompleteBusinessCheck struct {
+	repo      port.BusinessVerificationRepository
+	publisher port.EventPublisher
+}
+
+func NewCompleteBusinessCheck(
+	repo port.BusinessVerificationRepository,
+	publisher port.EventPublisher,
+) *CompleteBusinessCheck {
+	return &CompleteBusinessCheck{
+		repo:      repo,
+		publisher: publisher,
+	}
+}
+
+func (uc *CompleteBusinessCheck) Execute(ctx context.Context, req dto.CompleteBusinessCheckRequest) (dto.BusinessVerificationResponse, error) {
+	status, err := valueobject.NewVerificationStatus(req.Status)
+	if err != nil {
+		return dto.BusinessVerificationResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	business, err := findTenantBusiness(ctx, uc.repo, req.TenantID, req.VerificationID)
+	if err != nil {
+		return dto.BusinessVerificationResponse{}, err
+	}
+
+	business, err = business.CompleteCheck(req.CheckID, status, req.FailureReason, time.Now().UTC())
+	if err != nil {
+		return dto.BusinessVerificationResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	if err := uc.repo.Save(ctx, business); err != nil {
+		return dto.BusinessVerificationResponse{}, fmt.Errorf("failed to save business verification: %w", err)
+	}
+
+	if events := business.DomainEvents(); len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, TopicIdentityVerifications, events...); err != nil {
+			return dto.BusinessVerificationResponse{}, fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+
+	return toBusinessVerificationResponse(business), nil
+}
@@ -11,7 +11,8 @@ import (
 )
 
 // CompleteCheck handles webhook callbacks from the verification provider
-// to mark individual checks as complete.
+// to mark individual checks as complete. A REVIEW status sends the check to
+// analyst review instead, with the failure reason as the review reason.
 type CompleteCheck struct {
 	repo      port.VerificationRepository
 	publisher port.EventPublisher
@@ -40,9 +41,13 @@ func (uc *CompleteCheck) Execute(ctx context.Context, req dto.CompleteCheckReque
 		return dto.VerificationResponse{}, fmt.Errorf("failed to find verification: %w", err)
 	}
 
-	// Complete the check
+	// Complete the check, or send it to review
 	now := time.Now().UTC()
-	verification, err = verification.CompleteCheck(req.CheckID, status, req.FailureReason, now)
+	if status.Equal(valueobject.StatusReview) {
+		verification, err = verification.ReviewCheck(req.CheckID, req.FailureReason, now)
+	} else {
+		verification, err = verification.CompleteCheck(req.CheckID, status, req.FailureReason, now)
+	}
 	if err != nil {
 		return dto.VerificationResponse{}, fmt.Errorf("failed to complete check: %w", err)
 	}
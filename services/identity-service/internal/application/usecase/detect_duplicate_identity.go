@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/service"
+)
+
+// maxDuplicateCandidates caps how many fingerprints are compared against an
+// applicant.
+const maxDuplicateCandidates = 200
+
+// DetectDuplicateIdentity fingerprints each applicant by document number,
+// name and date of birth, and selfie, and compares the fingerprint with the
+// tenant's other applicants. A verification matching another applicant is
+// held for review with a DUPLICATE check, so the same person cannot silently
+// open several accounts. Re-verifications of the same applicant never match
+// each other. Selfies are only compared when an embedder is configured.
+type DetectDuplicateIdentity struct {
+	fingerprints port.ApplicantFingerprintRepository
+	detector     *service.DuplicateDetector
+	embedder     port.FaceEmbedder
+}
+
+func NewDetectDuplicateIdentity(
+	fingerprints port.ApplicantFingerprintRepository,
+	detector *service.DuplicateDetector,
+	embedder port.FaceEmbedder,
+) *DetectDuplicateIdentity {
+	return &DetectDuplicateIdentity{
+		fingerprints: fingerprints,
+		detector:     detector,
+		embedder:     embedder,
+	}
+}
+
+// fingerprint builds the fingerprint of a new verification and flags the
+// verification if it matches another applicant. A re-verification inherits
+// the applicant of the verification it replaces. The fingerprint must be
+// saved once the verification is.
+func (uc *DetectDuplicateIdentity) fingerprint(
+	ctx context.Context,
+	verification model.IdentityVerification,
+	documentNumber string,
+	now time.Time,
+) (model.IdentityVerification, model.ApplicantFingerprint, error) {
+	applicantID := verification.ID()
+	if prev := verification.PreviousVerificationID(); prev != uuid.Nil {
+		previous, err := uc.fingerprints.FindByVerification(ctx, prev)
+		switch {
+		case err == nil:
+			applicantID = previous.ApplicantID()
+		case errors.Is(err, port.ErrFingerprintNotFound):
+			// Verified before fingerprinting; the chain starts here.
+			applicantID = prev
+		default:
+			return model.IdentityVerification{}, model.ApplicantFingerprint{}, fmt.Errorf("failed to find previous fingerprint: %w", err)
+		}
+	}
+
+	fingerprint, err := model.NewApplicantFingerprint(
+		verification.TenantID(), verification.ID(), applicantID,
+		verification.ApplicantFirstName(), verification.ApplicantLastName(), verification.ApplicantDOB(),
+		documentNumber, now,
+	)
+	if err != nil {
+		return model.IdentityVerification{}, model.ApplicantFingerprint{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	return uc.detect(ctx, verification, fingerprint, now)
+}
+
+// matchSelfie adds the embedding of an uploaded selfie to the verification's
+// fingerprint, saves it and flags the verification if the face matches
+// another applicant. Verifications without a fingerprint are left alone.
+func (uc *DetectDuplicateIdentity) matchSelfie(
+	ctx context.Context,
+	verification model.IdentityVerification,
+	selfie []byte,
+	now time.Time,
+) (model.IdentityVerification, error) {
+	if uc.embedder == nil {
+		return verification, nil
+	}
+	fingerprint, err := uc.fingerprints.FindByVerification(ctx, verification.ID())
+	if errors.Is(err, port.ErrFingerprintNotFound) {
+		return verification, nil
+	}
+	if err != nil {
+		return model.IdentityVerification{}, fmt.Errorf("failed to find fingerprint: %w", err)
+	}
+
+	embedding, err := uc.embedder.Embed(ctx, selfie)
+	if err != nil {
+		return model.IdentityVerification{}, fmt.Errorf("failed to embed selfie: %w", err)
+	}
+	verification, fingerprint, err = uc.detect(ctx, verification, fingerprint.WithSelfieEmbedding(embedding, now), now)
+	if err != nil {
+		return model.IdentityVerification{}, err
+	}
+	if err := uc.save(ctx, fingerprint); err != nil {
+		return model.IdentityVerification{}, err
+	}
+	return verification, nil
+}
+
+// detect compares a fingerprint with its candidates and records the
+// matches on it. The verification is flagged only for verifications it had
+// not matched before, so re-running detection does not reopen a cleared
+// DUPLICATE check.
+func (uc *DetectDuplicateIdentity) detect(
+	ctx context.Context,
+	verification model.IdentityVerification,
+	fingerprint model.ApplicantFingerprint,
+	now time.Time,
+) (model.IdentityVerification, model.ApplicantFingerprint, error) {
+	candidates, err := uc.fingerprints.FindCandidates(ctx, fingerprint, maxDuplicateCandidates)
+	if err != nil {
+		return model.IdentityVerification{}, model.ApplicantFingerprint{}, fmt.Errorf("failed to find duplicate candidates: %w", err)
+	}
+	matches := uc.detector.Detect(fingerprint, candidates)
+	if len(matches) == 0 {
+		return verification, fingerprint, nil
+	}
+
+	// Keep earlier matches that were not found again, and refresh the rest.
+	found := make(map[uuid.UUID]bool, len(matches))
+	for _, m := range matches {
+		found[m.VerificationID] = true
+	}
+	known := make(map[uuid.UUID]bool)
+	merged := append([]model.DuplicateMatch(nil), matches...)
+	for _, m := range fingerprint.Matches() {
+		known[m.VerificationID] = true
+		if !found[m.VerificationID] {
+			merged = append(merged, m)
+		}
+	}
+	var fresh []model.DuplicateMatch
+	for _, m := range matches {
+		if !known[m.VerificationID] {
+			fresh = append(fresh, m)
+		}
+	}
+	fingerprint = fingerprint.WithMatches(merged, now)
+	if len(fresh) == 0 {
+		return verification, fingerprint, nil
+	}
+
+	verification, err = verification.FlagDuplicate(fresh, now)
+	if err != nil {
+		return model.IdentityVerification{}, model.ApplicantFingerprint{}, fmt.Errorf("failed to flag duplicate: %w", err)
+	}
+	return verification, fingerprint, nil
+}
+
+// save persists a fingerprint.
+func (uc *DetectDuplicateIdentity) save(ctx context.Context, fingerprint model.ApplicantFingerprint) error {
+	if err := uc.fingerprints.Save(ctx, fingerprint); err != nil {
+		return fmt.Errorf("failed to save fingerprint: %w", err)
+	}
+	return nil
+}
+
+// GetDuplicateMatches retrieves the other verifications a verification's
+// applicant matched.
+type GetDuplicateMatches struct {
+	verifications port.VerificationRepository
+	fingerprints  port.ApplicantFingerprintRepository
+}
+
+func NewGetDuplicateMatches(
+	verifications port.VerificationRepository,
+	fingerprints port.ApplicantFingerprintRepository,
+) *GetDuplicateMatches {
+	return &GetDuplicateMatches{
+		verifications: verifications,
+		fingerprints:  fingerprints,
+	}
+}
+
+func (uc *GetDuplicateMatches) Execute(ctx context.Context, req dto.GetDuplicateMatchesRequest) (dto.DuplicateMatchesResponse, error) {
+	verification, err := uc.verifications.FindByID(ctx, req.VerificationID)
+	if err != nil || verification.TenantID() != req.TenantID {
+		return dto.DuplicateMatchesResponse{}, fmt.Errorf("%w: verification %s", ErrNotFound, req.VerificationID)
+	}
+	fingerprint, err := uc.fingerprints.FindByVerification(ctx, req.VerificationID)
+	if errors.Is(err, port.ErrFingerprintNotFound) {
+		return dto.DuplicateMatchesResponse{VerificationID: req.VerificationID}, nil
+	}
+	if err != nil {
+		return dto.DuplicateMatchesResponse{}, fmt.Errorf("failed to find fingerprint: %w", err)
+	}
+	return toDuplicateMatchesResponse(fingerprint), nil
+}
@@ -0,0 +1,246 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/service"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// --- Mock implementations ---
+
+// mockFingerprintRepository implements port.ApplicantFingerprintRepository
+// in memory. FindCandidates returns every other applicant in the tenant.
+type mockFingerprintRepository struct {
+	fingerprints map[uuid.UUID]model.ApplicantFingerprint
+}
+
+func newMockFingerprintRepository() *mockFingerprintRepository {
+	return &mockFingerprintRepository{fingerprints: make(map[uuid.UUID]model.ApplicantFingerprint)}
+}
+
+func (m *mockFingerprintRepository) Save(_ context.Context, f model.ApplicantFingerprint) error {
+	m.fingerprints[f.VerificationID()] = f
+	return nil
+}
+
+func (m *mockFingerprintRepository) FindByVerification(_ context.Context, verificationID uuid.UUID) (model.ApplicantFingerprint, error) {
+	f, ok := m.fingerprints[verificationID]
+	if !ok {
+		return model.ApplicantFingerprint{}, port.ErrFingerprintNotFound
+	}
+	return f, nil
+}
+
+func (m *mockFingerprintRepository) FindCandidates(_ context.Context, f model.ApplicantFingerprint, limit int) ([]model.ApplicantFingerprint, error) {
+	var out []model.ApplicantFingerprint
+	for _, c := range m.fingerprints {
+		if c.TenantID() == f.TenantID() && c.ApplicantID() != f.ApplicantID() && len(out) < limit {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+// mockFaceEmbedder implements port.FaceEmbedder, returning the embedding
+// registered for an image.
+type mockFaceEmbedder struct {
+	embeddings map[string][]float32
+}
+
+func (m *mockFaceEmbedder) Embed(_ context.Context, image []byte) ([]float32, error) {
+	if e, ok := m.embeddings[string(image)]; ok {
+		return e, nil
+	}
+	return nil, errors.New("no face found")
+}
+
+func seedFingerprint(t *testing.T, repo *mockFingerprintRepository, tenantID uuid.UUID, first, last, dob, documentNumber string, embedding []float32) model.ApplicantFingerprint {
+	t.Helper()
+	f, err := model.NewApplicantFingerprint(tenantID, uuid.New(), uuid.Nil, first, last, dob, documentNumber, time.Now().UTC())
+	require.NoError(t, err)
+	if embedding != nil {
+		f = f.WithSelfieEmbedding(embedding, time.Now().UTC())
+	}
+	require.NoError(t, repo.Save(context.Background(), f))
+	return f
+}
+
+func hasDuplicateCheckInReview(resp dto.VerificationResponse) bool {
+	for _, c := range resp.Checks {
+		if c.CheckType == valueobject.CheckTypeDuplicate.String() && c.Status == valueobject.StatusReview.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// --- Tests ---
+
+func TestInitiateVerification_FlagsDuplicateDocumentNumber(t *testing.T) {
+	fingerprints := newMockFingerprintRepository()
+	req := validInitiateRequest()
+	req.DocumentNumber = "P-1234 5678"
+	existing := seedFingerprint(t, fingerprints, req.TenantID, "Someone", "Else", "1970-01-01", "p12345678", nil)
+
+	repo := &mockVerificationRepository{}
+	publisher := &mockEventPublisher{}
+	duplicates := usecase.NewDetectDuplicateIdentity(fingerprints, service.NewDuplicateDetector(0, 0), nil)
+	uc := usecase.NewInitiateVerification(repo, &mockVerificationProvider{}, publisher, nil, duplicates, nil)
+
+	resp, err := uc.Execute(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.True(t, hasDuplicateCheckInReview(resp))
+	assert.Equal(t, "IN_PROGRESS", resp.Status, "provider checks are still running")
+
+	var detected bool
+	for _, e := range publisher.publishedEvents {
+		detected = detected || e.EventType() == "identity.verification.duplicate_detected"
+	}
+	assert.True(t, detected)
+
+	saved, err := fingerprints.FindByVerification(context.Background(), resp.ID)
+	require.NoError(t, err)
+	require.Len(t, saved.Matches(), 1)
+	assert.Equal(t, existing.VerificationID(), saved.Matches()[0].VerificationID)
+	assert.Equal(t, []string{service.DuplicateReasonDocumentNumber}, saved.Matches()[0].Reasons)
+}
+
+func TestInitiateVerification_NoDuplicateForDistinctApplicant(t *testing.T) {
+	fingerprints := newMockFingerprintRepository()
+	req := validInitiateRequest()
+	seedFingerprint(t, fingerprints, req.TenantID, "Mary", "Major", req.DateOfBirth, "", nil)
+	seedFingerprint(t, fingerprints, uuid.New(), req.FirstName, req.LastName, req.DateOfBirth, "", nil)
+
+	duplicates := usecase.NewDetectDuplicateIdentity(fingerprints, service.NewDuplicateDetector(0, 0), nil)
+	uc := usecase.NewInitiateVerification(&mockVerificationRepository{}, &mockVerificationProvider{}, &mockEventPublisher{}, nil, duplicates, nil)
+
+	resp, err := uc.Execute(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.False(t, hasDuplicateCheckInReview(resp))
+	saved, err := fingerprints.FindByVerification(context.Background(), resp.ID)
+	require.NoError(t, err)
+	assert.Empty(t, saved.Matches())
+	assert.Equal(t, resp.ID, saved.ApplicantID())
+}
+
+func TestInitiateVerification_ReverificationIsNotDuplicate(t *testing.T) {
+	fingerprints := newMockFingerprintRepository()
+	req := validInitiateRequest()
+	req.DocumentNumber = "P12345678"
+	previous := seedFingerprint(t, fingerprints, req.TenantID, req.FirstName, req.LastName, req.DateOfBirth, req.DocumentNumber, nil)
+	req.PreviousVerificationID = previous.VerificationID()
+
+	duplicates := usecase.NewDetectDuplicateIdentity(fingerprints, service.NewDuplicateDetector(0, 0), nil)
+	uc := usecase.NewInitiateVerification(&mockVerificationRepository{}, &mockVerificationProvider{}, &mockEventPublisher{}, nil, duplicates, nil)
+
+	resp, err := uc.Execute(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.False(t, hasDuplicateCheckInReview(resp))
+	saved, err := fingerprints.FindByVerification(context.Background(), resp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, previous.ApplicantID(), saved.ApplicantID())
+}
+
+func TestUploadDocument_FlagsDuplicateSelfie(t *testing.T) {
+	v := inProgressVerification()
+	fingerprints := newMockFingerprintRepository()
+	own, err := model.NewApplicantFingerprint(v.TenantID(), v.ID(), uuid.Nil,
+		v.ApplicantFirstName(), v.ApplicantLastName(), v.ApplicantDOB(), "", time.Now().UTC())
+	require.NoError(t, err)
+	require.NoError(t, fingerprints.Save(context.Background(), own))
+	existing := seedFingerprint(t, fingerprints, v.TenantID(), "Anna", "Other", "1980-05-05", "", []float32{0.9, 0.1, 0.4})
+
+	verifications := &mockVerificationRepository{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) {
+			return v, nil
+		},
+	}
+	embedder := &mockFaceEmbedder{embeddings: map[string][]float32{string(pngBytes): {0.88, 0.12, 0.41}}}
+	duplicates := usecase.NewDetectDuplicateIdentity(fingerprints, service.NewDuplicateDetector(0, 0), embedder)
+	publisher := &mockIdentityEventPublisher{}
+	uc := usecase.NewUploadDocument(verifications, newMockDocumentRepository(), newMockDocumentStore(),
+		mockDocumentCipher{}, publisher, duplicates, time.Hour)
+
+	upload := dto.UploadDocumentRequest{
+		TenantID: v.TenantID(), VerificationID: v.ID(), DocumentType: "SELFIE", Content: pngBytes,
+	}
+	_, err = uc.Execute(context.Background(), upload)
+	require.NoError(t, err)
+
+	require.Len(t, verifications.savedVerifications, 1)
+	var flagged bool
+	for _, c := range verifications.savedVerifications[0].Checks() {
+		flagged = flagged || (c.CheckType().Equal(valueobject.CheckTypeDuplicate) && c.Status().Equal(valueobject.StatusReview))
+	}
+	assert.True(t, flagged)
+	var eventTypes []string
+	for _, e := range publisher.publishedEvents {
+		eventTypes = append(eventTypes, e.EventType())
+	}
+	assert.Contains(t, eventTypes, "identity.document.uploaded")
+	assert.Contains(t, eventTypes, "identity.verification.duplicate_detected")
+
+	saved, err := fingerprints.FindByVerification(context.Background(), v.ID())
+	require.NoError(t, err)
+	require.Len(t, saved.Matches(), 1)
+	assert.Equal(t, existing.VerificationID(), saved.Matches()[0].VerificationID)
+	assert.NotEmpty(t, saved.SelfieEmbedding())
+
+	// Uploading the selfie again does not re-flag a known match.
+	_, err = uc.Execute(context.Background(), upload)
+	require.NoError(t, err)
+	assert.Len(t, verifications.savedVerifications, 1)
+}
+
+func TestGetDuplicateMatches_Execute(t *testing.T) {
+	v := inProgressVerification()
+	verifications := &mockVerificationRepository{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) {
+			return v, nil
+		},
+	}
+	fingerprints := newMockFingerprintRepository()
+	uc := usecase.NewGetDuplicateMatches(verifications, fingerprints)
+
+	t.Run("returns no matches before fingerprinting", func(t *testing.T) {
+		resp, err := uc.Execute(context.Background(), dto.GetDuplicateMatchesRequest{TenantID: v.TenantID(), VerificationID: v.ID()})
+		require.NoError(t, err)
+		assert.Equal(t, v.ID(), resp.VerificationID)
+		assert.Empty(t, resp.Matches)
+	})
+
+	t.Run("returns recorded matches", func(t *testing.T) {
+		matched := uuid.New()
+		f, err := model.NewApplicantFingerprint(v.TenantID(), v.ID(), uuid.Nil, "Jane", "Smith", "1990-01-01", "", time.Now().UTC())
+		require.NoError(t, err)
+		f = f.WithMatches([]model.DuplicateMatch{{VerificationID: matched, Reasons: []string{"name_dob"}, Score: 0.95}}, time.Now().UTC())
+		require.NoError(t, fingerprints.Save(context.Background(), f))
+
+		resp, err := uc.Execute(context.Background(), dto.GetDuplicateMatchesRequest{TenantID: v.TenantID(), VerificationID: v.ID()})
+		require.NoError(t, err)
+		assert.Equal(t, v.ID(), resp.ApplicantID)
+		require.Len(t, resp.Matches, 1)
+		assert.Equal(t, matched, resp.Matches[0].VerificationID)
+		assert.Equal(t, []string{"name_dob"}, resp.Matches[0].Reasons)
+	})
+
+	t.Run("hides other tenants' verifications", func(t *testing.T) {
+		_, err := uc.Execute(context.Background(), dto.GetDuplicateMatchesRequest{TenantID: uuid.New(), VerificationID: v.ID()})
+		assert.True(t, errors.Is(err, usecase.ErrNotFound))
+	})
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// erasureBatchSize bounds how many verifications one retention erasure run handles.
+const erasureBatchSize = 100
+
+// Reasons a verification is kept out of a data subject erasure.
+const (
+	RetainedActiveApproval = "active_approval"
+	RetainedInProgress     = "verification_in_progress"
+)
+
+// EraseApplicantData erases a data subject's personal data on their verified
+// request. Document content is deleted and the applicant's details are
+// removed from the verification and its fingerprint; checks, decisions, risk
+// assessments, screening results and content digests are kept as the audit
+// record. Verifications still in progress or backing a valid approval are
+// retained and reported.
+type EraseApplicantData struct {
+	verifications port.VerificationRepository
+	documents     port.DocumentRepository
+	store         port.DocumentStore
+	fingerprints  port.ApplicantFingerprintRepository
+	publisher     port.EventPublisher
+}
+
+func NewEraseApplicantData(
+	verifications port.VerificationRepository,
+	documents port.DocumentRepository,
+	store port.DocumentStore,
+	fingerprints port.ApplicantFingerprintRepository,
+	publisher port.EventPublisher,
+) *EraseApplicantData {
+	return &EraseApplicantData{
+		verifications: verifications,
+		documents:     documents,
+		store:         store,
+		fingerprints:  fingerprints,
+		publisher:     publisher,
+	}
+}
+
+func (uc *EraseApplicantData) Execute(ctx context.Context, req dto.EraseApplicantDataRequest) (dto.EraseApplicantDataResponse, error) {
+	email := strings.TrimSpace(req.ApplicantEmail)
+	if email == "" {
+		return dto.EraseApplicantDataResponse{}, fmt.Errorf("%w: applicant email is required", ErrInvalidInput)
+	}
+	if strings.TrimSpace(req.RequestReference) == "" {
+		return dto.EraseApplicantDataResponse{}, fmt.Errorf("%w: request reference is required", ErrInvalidInput)
+	}
+
+	verifications, err := uc.verifications.ListByApplicantEmail(ctx, req.TenantID, email)
+	if err != nil {
+		return dto.EraseApplicantDataResponse{}, fmt.Errorf("failed to list applicant verifications: %w", err)
+	}
+	if len(verifications) == 0 {
+		return dto.EraseApplicantDataResponse{}, fmt.Errorf("%w: no verifications for applicant", ErrNotFound)
+	}
+
+	now := time.Now().UTC()
+	var resp dto.EraseApplicantDataResponse
+	for _, v := range verifications {
+		if reason := retentionReason(v.Status()); reason != "" {
+			resp.Retained = append(resp.Retained, dto.RetainedVerificationDTO{
+				VerificationID: v.ID(),
+				Status:         v.Status().String(),
+				Reason:         reason,
+			})
+			continue
+		}
+		documents, err := uc.erase(ctx, v, model.ErasureReasonSubjectRequest, req.RequestReference, now)
+		resp.DocumentsErased += documents
+		if err != nil {
+			return resp, fmt.Errorf("verification %s: %w", v.ID(), err)
+		}
+		resp.ErasedVerificationIDs = append(resp.ErasedVerificationIDs, v.ID())
+	}
+	return resp, nil
+}
+
+// retentionReason returns why a verification in status must be retained, or
+// "" when it can be erased.
+func retentionReason(status valueobject.VerificationStatus) string {
+	switch status {
+	case valueobject.StatusRejected, valueobject.StatusExpired:
+		return ""
+	case valueobject.StatusApproved, valueobject.StatusReview:
+		return RetainedActiveApproval
+	default:
+		return RetainedInProgress
+	}
+}
+
+// erase deletes the verification's document content, anonymizes its
+// fingerprint and erases the applicant's details, returning how many
+// documents were erased. Each step is saved as it completes, so a failed
+// erasure can simply be retried.
+func (uc *EraseApplicantData) erase(ctx context.Context, v model.IdentityVerification, reason, requestReference string, now time.Time) (int, error) {
+	documents, err := uc.documents.ListByVerification(ctx, v.TenantID(), v.ID())
+	if err != nil {
+		return 0, fmt.Errorf("failed to list documents: %w", err)
+	}
+	erased := 0
+	for _, d := range documents {
+		if d.IsPurged() {
+			continue
+		}
+		if err := uc.store.Delete(ctx, d.StorageKey()); err != nil {
+			return erased, fmt.Errorf("failed to delete document %s: %w", d.ID(), err)
+		}
+		d, err = d.MarkErased(now)
+		if err != nil {
+			return erased, err
+		}
+		if err := uc.documents.Save(ctx, d); err != nil {
+			return erased, fmt.Errorf("failed to save document %s: %w", d.ID(), err)
+		}
+		if err := uc.publisher.Publish(ctx, TopicIdentityVerifications, d.DomainEvents()...); err != nil {
+			return erased, fmt.Errorf("failed to publish events: %w", err)
+		}
+		erased++
+	}
+
+	fingerprint, err := uc.fingerprints.FindByVerification(ctx, v.ID())
+	switch {
+	case errors.Is(err, port.ErrFingerprintNotFound):
+	case err != nil:
+		return erased, fmt.Errorf("failed to find fingerprint: %w", err)
+	default:
+		if err := uc.fingerprints.Save(ctx, fingerprint.Anonymize(now)); err != nil {
+			return erased, fmt.Errorf("failed to save fingerprint: %w", err)
+		}
+	}
+
+	v, err = v.Erase(reason, requestReference, now)
+	if err != nil {
+		return erased, err
+	}
+	if err := uc.verifications.Save(ctx, v); err != nil {
+		return erased, fmt.Errorf("failed to save verification: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, TopicIdentityVerifications, v.DomainEvents()...); err != nil {
+		return erased, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return erased, nil
+}
+
+// EraseExpiredApplicantData erases the applicant data of rejected and expired
+// verifications once the retention period has passed since they were decided.
+type EraseExpiredApplicantData struct {
+	verifications port.VerificationRepository
+	erase         *EraseApplicantData
+	retention     time.Duration
+}
+
+func NewEraseExpiredApplicantData(verifications port.VerificationRepository, erase *EraseApplicantData, retention time.Duration) *EraseExpiredApplicantData {
+	return &EraseExpiredApplicantData{verifications: verifications, erase: erase, retention: retention}
+}
+
+// Execute erases up to one batch of verifications past retention. A failure
+// on one verification does not stop the batch; failures are returned together.
+func (uc *EraseExpiredApplicantData) Execute(ctx context.Context, now time.Time) (dto.EraseExpiredApplicantDataResult, error) {
+	return uc.ExecuteBefore(ctx, uuid.Nil, now.Add(-uc.retention), now)
+}
+
+// ExecuteBefore erases up to one batch of a tenant's verifications decided
+// before cutoff, for tenants whose retention policy is shorter than the
+// service's. A uuid.Nil tenantID covers all tenants.
+func (uc *EraseExpiredApplicantData) ExecuteBefore(ctx context.Context, tenantID uuid.UUID, cutoff, now time.Time) (dto.EraseExpiredApplicantDataResult, error) {
+	due, err := uc.verifications.ListDueForErasure(ctx, tenantID, cutoff, erasureBatchSize)
+	if err != nil {
+		return dto.EraseExpiredApplicantDataResult{}, fmt.Errorf("failed to list verifications due for erasure: %w", err)
+	}
+
+	var (
+		result dto.EraseExpiredApplicantDataResult
+		errs   []error
+	)
+	for _, v := range due {
+		documents, err := uc.erase.erase(ctx, v, model.ErasureReasonRetention, "", now)
+		result.DocumentsErased += documents
+		if err != nil {
+			errs = append(errs, fmt.Errorf("verification %s: %w", v.ID(), err))
+			continue
+		}
+		result.Erased++
+	}
+	return result, errors.Join(errs...)
+}
@@ -0,0 +1,227 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/event"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+func rejectedApplicantVerification(t *testing.T) model.IdentityVerification {
+	t.Helper()
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "US")
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	v, err = v.StartProcessing(now)
+	require.NoError(t, err)
+	v, err = v.CompleteCheck(v.Checks()[0].ID(), valueobject.StatusRejected, "document_expired", now)
+	require.NoError(t, err)
+	return v
+}
+
+// erasureFixture holds a rejected verification with a stored selfie and a
+// fingerprint, ready to be erased.
+type erasureFixture struct {
+	verification model.IdentityVerification
+	document     model.IdentityDocument
+	documents    *mockDocumentRepository
+	store        *mockDocumentStore
+	fingerprints *mockFingerprintRepository
+	publisher    *mockEventPublisher
+}
+
+func newErasureFixture(t *testing.T) erasureFixture {
+	t.Helper()
+	v := rejectedApplicantVerification(t)
+	now := time.Now().UTC()
+
+	f := erasureFixture{
+		verification: v,
+		documents:    newMockDocumentRepository(),
+		store:        newMockDocumentStore(),
+		fingerprints: newMockFingerprintRepository(),
+		publisher:    &mockEventPublisher{},
+	}
+	d, err := model.NewIdentityDocument(v.TenantID(), v.ID(), v.Checks()[1].ID(), valueobject.DocumentTypeSelfie,
+		"image/png", int64(len(pngBytes)), strings.Repeat("a", 64), 365*24*time.Hour, now)
+	require.NoError(t, err)
+	f.document = d
+	f.documents.documents[d.ID()] = d
+	f.store.objects[d.StorageKey()] = pngBytes
+
+	fp, err := model.NewApplicantFingerprint(v.TenantID(), v.ID(), uuid.Nil, "John", "Doe", "1990-01-15", "AB123456", now)
+	require.NoError(t, err)
+	f.fingerprints.fingerprints[v.ID()] = fp.WithSelfieEmbedding([]float32{0.1, 0.2}, now)
+	return f
+}
+
+func (f erasureFixture) useCase(repo *mockVerificationRepository) *usecase.EraseApplicantData {
+	return usecase.NewEraseApplicantData(repo, f.documents, f.store, f.fingerprints, f.publisher)
+}
+
+func (f erasureFixture) assertErased(t *testing.T, repo *mockVerificationRepository) {
+	t.Helper()
+	require.Len(t, repo.savedVerifications, 1)
+	saved := repo.savedVerifications[0]
+	assert.True(t, saved.IsErased())
+	assert.Empty(t, saved.ApplicantEmail())
+	assert.Equal(t, f.verification.Checks(), saved.Checks())
+
+	assert.Empty(t, f.store.objects, "document content must be deleted")
+	d := f.documents.documents[f.document.ID()]
+	assert.True(t, d.IsPurged())
+	assert.Equal(t, f.document.SHA256(), d.SHA256())
+
+	fp := f.fingerprints.fingerprints[f.verification.ID()]
+	assert.Empty(t, fp.FirstName())
+	assert.Empty(t, fp.SelfieEmbedding())
+	assert.NotEmpty(t, fp.DocumentNumberHash())
+}
+
+func TestEraseApplicantData_ErasesDecidedAndRetainsActive(t *testing.T) {
+	f := newErasureFixture(t)
+	approved := approvedScreenedVerification(t)
+
+	var gotEmail string
+	repo := &mockVerificationRepository{
+		listByEmailFunc: func(_ context.Context, tenantID uuid.UUID, email string) ([]model.IdentityVerification, error) {
+			gotEmail = email
+			return []model.IdentityVerification{f.verification, approved}, nil
+		},
+	}
+
+	resp, err := f.useCase(repo).Execute(context.Background(), dto.EraseApplicantDataRequest{
+		TenantID:         f.verification.TenantID(),
+		ApplicantEmail:   " john@example.com ",
+		RequestReference: "DSR-42",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "john@example.com", gotEmail)
+	assert.Equal(t, []uuid.UUID{f.verification.ID()}, resp.ErasedVerificationIDs)
+	assert.Equal(t, 1, resp.DocumentsErased)
+	require.Len(t, resp.Retained, 1)
+	assert.Equal(t, approved.ID(), resp.Retained[0].VerificationID)
+	assert.Equal(t, usecase.RetainedActiveApproval, resp.Retained[0].Reason)
+	f.assertErased(t, repo)
+
+	var erased *event.ApplicantDataErased
+	for _, e := range f.publisher.publishedEvents {
+		if ev, ok := e.(event.ApplicantDataErased); ok {
+			erased = &ev
+		}
+	}
+	require.NotNil(t, erased)
+	assert.Equal(t, model.ErasureReasonSubjectRequest, erased.Reason)
+	assert.Equal(t, "DSR-42", erased.RequestReference)
+}
+
+func TestEraseApplicantData_InvalidRequest(t *testing.T) {
+	uc := usecase.NewEraseApplicantData(&mockVerificationRepository{}, newMockDocumentRepository(), newMockDocumentStore(),
+		newMockFingerprintRepository(), &mockEventPublisher{})
+
+	_, err := uc.Execute(context.Background(), dto.EraseApplicantDataRequest{TenantID: uuid.New(), ApplicantEmail: "john@example.com"})
+	assert.ErrorIs(t, err, usecase.ErrInvalidInput)
+
+	_, err = uc.Execute(context.Background(), dto.EraseApplicantDataRequest{TenantID: uuid.New(), RequestReference: "DSR-42"})
+	assert.ErrorIs(t, err, usecase.ErrInvalidInput)
+
+	_, err = uc.Execute(context.Background(), dto.EraseApplicantDataRequest{
+		TenantID: uuid.New(), ApplicantEmail: "nobody@example.com", RequestReference: "DSR-42",
+	})
+	assert.ErrorIs(t, err, usecase.ErrNotFound)
+}
+
+func TestEraseExpiredApplicantData_ErasesPastRetention(t *testing.T) {
+	f := newErasureFixture(t)
+	now := time.Now().UTC()
+	retention := 1825 * 24 * time.Hour
+
+	var gotCutoff time.Time
+	repo := &mockVerificationRepository{
+		listErasureFunc: func(_ context.Context, tenantID uuid.UUID, cutoff time.Time, _ int) ([]model.IdentityVerification, error) {
+			assert.Equal(t, uuid.Nil, tenantID, "retention applies to every tenant")
+			gotCutoff = cutoff
+			return []model.IdentityVerification{f.verification}, nil
+		},
+	}
+
+	result, err := usecase.NewEraseExpiredApplicantData(repo, f.useCase(repo), retention).Execute(context.Background(), now)
+	require.NoError(t, err)
+
+	assert.Equal(t, now.Add(-retention), gotCutoff)
+	assert.Equal(t, 1, result.Erased)
+	assert.Equal(t, 1, result.DocumentsErased)
+	f.assertErased(t, repo)
+	assert.True(t, repo.savedVerifications[0].Status().Equal(valueobject.StatusRejected))
+}
+
+func TestApplicantDataEraser_SubjectRequest(t *testing.T) {
+	f := newErasureFixture(t)
+	repo := &mockVerificationRepository{
+		listByEmailFunc: func(_ context.Context, tenantID uuid.UUID, email string) ([]model.IdentityVerification, error) {
+			if tenantID != f.verification.TenantID() {
+				return nil, nil
+			}
+			return []model.IdentityVerification{f.verification}, nil
+		},
+	}
+	eraser := usecase.NewApplicantDataEraser(f.useCase(repo), usecase.NewEraseExpiredApplicantData(repo, f.useCase(repo), time.Hour))
+	ctx := context.Background()
+
+	result, err := eraser.Erase(ctx, erasure.Request{
+		JobID: uuid.New(), TenantID: f.verification.TenantID(), Kind: erasure.KindSubject,
+		Subject: erasure.Subject{Email: "john@example.com"}, Reference: "DSR-42",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, erasure.Result{Erased: 1}, result)
+	f.assertErased(t, repo)
+
+	result, err = eraser.Erase(ctx, erasure.Request{
+		JobID: uuid.New(), TenantID: uuid.New(), Kind: erasure.KindSubject,
+		Subject: erasure.Subject{Email: "john@example.com"}, Reference: "DSR-43",
+	})
+	require.NoError(t, err, "applicants unknown to the service have nothing to erase")
+	assert.Zero(t, result)
+
+	result, err = eraser.Erase(ctx, erasure.Request{JobID: uuid.New(), Kind: erasure.KindSubject, Subject: erasure.Subject{CustomerID: uuid.New()}})
+	require.NoError(t, err)
+	assert.Zero(t, result)
+}
+
+func TestApplicantDataEraser_RetentionRequest(t *testing.T) {
+	f := newErasureFixture(t)
+	cutoff := time.Now().UTC().AddDate(0, -6, 0)
+	repo := &mockVerificationRepository{}
+	repo.listErasureFunc = func(_ context.Context, tenantID uuid.UUID, got time.Time, _ int) ([]model.IdentityVerification, error) {
+		assert.Equal(t, f.verification.TenantID(), tenantID)
+		assert.Equal(t, cutoff, got)
+		if len(repo.savedVerifications) > 0 {
+			return nil, nil
+		}
+		return []model.IdentityVerification{f.verification}, nil
+	}
+	eraser := usecase.NewApplicantDataEraser(f.useCase(repo), usecase.NewEraseExpiredApplicantData(repo, f.useCase(repo), time.Hour))
+
+	result, err := eraser.Erase(context.Background(), erasure.Request{
+		JobID: uuid.New(), TenantID: f.verification.TenantID(), Kind: erasure.KindRetention,
+		Category: usecase.CategoryApplicantData, Cutoff: cutoff,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Erased)
+	f.assertErased(t, repo)
+
+	_, err = eraser.Erase(context.Background(), erasure.Request{Kind: erasure.KindRetention})
+	assert.ErrorIs(t, err, usecase.ErrInvalidInput)
+}
@@ -0,0 +1,15 @@
+package usecase
+
+import "errors"
+
+var (
+	// ErrNotFound is returned when the requested resource does not exist for the tenant.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidInput is returned when a request fails validation.
+	ErrInvalidInput = errors.New("invalid input")
+	// ErrInvalidState is returned when a request conflicts with the resource's
+	// current state, e.g. a review case claimed by another analyst.
+	ErrInvalidState = errors.New("invalid state")
+	// ErrDocumentPurged is returned when a document's content was deleted at the end of its retention period.
+	ErrDocumentPurged = errors.New("document content has been purged")
+)
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+)
+
+// ExportApplicantData gathers everything held about a data subject across
+// the tenant's verifications: applicant details, checks and decisions,
+// document metadata, screening results and duplicate matches. Erased
+// verifications no longer hold the subject's details and are not included.
+type ExportApplicantData struct {
+	verifications port.VerificationRepository
+	documents     port.DocumentRepository
+	screenings    port.ScreeningResultRepository
+	fingerprints  port.ApplicantFingerprintRepository
+}
+
+func NewExportApplicantData(
+	verifications port.VerificationRepository,
+	documents port.DocumentRepository,
+	screenings port.ScreeningResultRepository,
+	fingerprints port.ApplicantFingerprintRepository,
+) *ExportApplicantData {
+	return &ExportApplicantData{
+		verifications: verifications,
+		documents:     documents,
+		screenings:    screenings,
+		fingerprints:  fingerprints,
+	}
+}
+
+func (uc *ExportApplicantData) Execute(ctx context.Context, req dto.ExportApplicantDataRequest) (dto.ApplicantDataExportResponse, error) {
+	email := strings.TrimSpace(req.ApplicantEmail)
+	if email == "" {
+		return dto.ApplicantDataExportResponse{}, fmt.Errorf("%w: applicant email is required", ErrInvalidInput)
+	}
+
+	verifications, err := uc.verifications.ListByApplicantEmail(ctx, req.TenantID, email)
+	if err != nil {
+		return dto.ApplicantDataExportResponse{}, fmt.Errorf("failed to list applicant verifications: %w", err)
+	}
+	if len(verifications) == 0 {
+		return dto.ApplicantDataExportResponse{}, fmt.Errorf("%w: no verifications for applicant", ErrNotFound)
+	}
+
+	resp := dto.ApplicantDataExportResponse{
+		ApplicantEmail: email,
+		ExportedAt:     time.Now().UTC(),
+		Verifications:  make([]dto.ApplicantVerificationExport, 0, len(verifications)),
+	}
+	for _, v := range verifications {
+		export := dto.ApplicantVerificationExport{Verification: toVerificationResponse(v)}
+
+		documents, err := uc.documents.ListByVerification(ctx, v.TenantID(), v.ID())
+		if err != nil {
+			return dto.ApplicantDataExportResponse{}, fmt.Errorf("failed to list documents: %w", err)
+		}
+		for _, d := range documents {
+			export.Documents = append(export.Documents, toDocumentResponse(d))
+		}
+
+		results, err := uc.screenings.ListByVerification(ctx, v.TenantID(), v.ID())
+		if err != nil {
+			return dto.ApplicantDataExportResponse{}, fmt.Errorf("failed to list screening results: %w", err)
+		}
+		for _, r := range results {
+			export.ScreeningResults = append(export.ScreeningResults, toScreeningResultDTO(r))
+		}
+
+		fingerprint, err := uc.fingerprints.FindByVerification(ctx, v.ID())
+		switch {
+		case errors.Is(err, port.ErrFingerprintNotFound):
+		case err != nil:
+			return dto.ApplicantDataExportResponse{}, fmt.Errorf("failed to find fingerprint: %w", err)
+		default:
+			export.DuplicateMatches = toDuplicateMatchesResponse(fingerprint).Matches
+		}
+
+		resp.Verifications = append(resp.Verifications, export)
+	}
+	return resp, nil
+}
@@ -0,0 +1,71 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+func TestExportApplicantData_GathersEverythingHeld(t *testing.T) {
+	f := newErasureFixture(t)
+	v := f.verification
+	now := time.Now().UTC()
+
+	screenings := &mockScreeningResultRepository{}
+	result, err := model.NewScreeningResult(v.TenantID(), v.ID(), uuid.New(), valueobject.CheckTypeSanctions,
+		"complyadvantage", "ref-1", model.ScreeningTriggerInitial, sanctionsHit()[valueobject.CheckTypeSanctions], now)
+	require.NoError(t, err)
+	screenings.saved = append(screenings.saved, result)
+
+	matched := uuid.New()
+	fp := f.fingerprints.fingerprints[v.ID()]
+	f.fingerprints.fingerprints[v.ID()] = fp.WithMatches([]model.DuplicateMatch{
+		{VerificationID: matched, Reasons: []string{"document_number"}, Score: 1},
+	}, now)
+
+	repo := &mockVerificationRepository{
+		listByEmailFunc: func(_ context.Context, _ uuid.UUID, _ string) ([]model.IdentityVerification, error) {
+			return []model.IdentityVerification{v}, nil
+		},
+	}
+	uc := usecase.NewExportApplicantData(repo, f.documents, screenings, f.fingerprints)
+
+	export, err := uc.Execute(context.Background(), dto.ExportApplicantDataRequest{
+		TenantID:       v.TenantID(),
+		ApplicantEmail: "john@example.com",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "john@example.com", export.ApplicantEmail)
+	require.Len(t, export.Verifications, 1)
+	got := export.Verifications[0]
+	assert.Equal(t, v.ID(), got.Verification.ID)
+	assert.Equal(t, "John", got.Verification.ApplicantFirstName)
+	assert.Equal(t, "1990-01-15", got.Verification.ApplicantDOB)
+	require.Len(t, got.Documents, 1)
+	assert.Equal(t, f.document.ID(), got.Documents[0].ID)
+	require.Len(t, got.ScreeningResults, 1)
+	assert.Equal(t, model.ScreeningOutcomeHit, got.ScreeningResults[0].Outcome)
+	require.Len(t, got.DuplicateMatches, 1)
+	assert.Equal(t, matched, got.DuplicateMatches[0].VerificationID)
+}
+
+func TestExportApplicantData_InvalidRequest(t *testing.T) {
+	uc := usecase.NewExportApplicantData(&mockVerificationRepository{}, newMockDocumentRepository(),
+		&mockScreeningResultRepository{}, newMockFingerprintRepository())
+
+	_, err := uc.Execute(context.Background(), dto.ExportApplicantDataRequest{TenantID: uuid.New()})
+	assert.ErrorIs(t, err, usecase.ErrInvalidInput)
+
+	_, err = uc.Execute(context.Background(), dto.ExportApplicantDataRequest{TenantID: uuid.New(), ApplicantEmail: "nobody@example.com"})
+	assert.ErrorIs(t, err, usecase.ErrNotFound)
+}
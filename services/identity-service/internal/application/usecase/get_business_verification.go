@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+)
+
+// GetBusinessVerification retrieves a single business verification by ID.
+type GetBusinessVerification struct {
+	repo port.BusinessVerificationRepository
+}
+
+func NewGetBusinessVerification(repo port.BusinessVerificationRepository) *GetBusinessVerification {
+	return &GetBusinessVerification{repo: repo}
+}
+
+func (uc *GetBusinessVerification) Execute(ctx context.Context, req dto.GetBusinessVerificationRequest) (dto.BusinessVerificationResponse, error) {
+	business, err := findTenantBusiness(ctx, uc.repo, req.TenantID, req.ID)
+	if err != nil {
+		return dto.BusinessVerificationResponse{}, err
+	}
+	return toBusinessVerificationResponse(business), nil
+}
+
+// findTenantBusiness loads a business verification, reporting one owned by
+// another tenant as not found.
+func findTenantBusiness(ctx context.Context, repo port.BusinessVerificationRepository, tenantID, id uuid.UUID) (model.BusinessVerification, error) {
+	business, err := repo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, port.ErrBusinessVerificationNotFound) {
+			return model.BusinessVerification{}, fmt.Errorf("%w: business verification %s", ErrNotFound, id)
+		}
+		return model.BusinessVerification{}, fmt.Errorf("failed to find business verification: %w", err)
+	}
+	if business.TenantID() != tenantID {
+		return model.BusinessVerification{}, fmt.Errorf("%w: business verification %s", ErrNotFound, id)
+	}
+	return business, nil
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+)
+
+// GetDocument retrieves a document's metadata and, for audit, its decrypted content.
+type GetDocument struct {
+	documents port.DocumentRepository
+	store     port.DocumentStore
+	cipher    port.DocumentCipher
+}
+
+func NewGetDocument(documents port.DocumentRepository, store port.DocumentStore, cipher port.DocumentCipher) *GetDocument {
+	return &GetDocument{documents: documents, store: store, cipher: cipher}
+}
+
+func (uc *GetDocument) Execute(ctx context.Context, req dto.GetDocumentRequest) (dto.GetDocumentResponse, error) {
+	document, err := uc.documents.FindByID(ctx, req.TenantID, req.DocumentID)
+	if err != nil {
+		if errors.Is(err, port.ErrDocumentNotFound) {
+			return dto.GetDocumentResponse{}, fmt.Errorf("%w: document %s", ErrNotFound, req.DocumentID)
+		}
+		return dto.GetDocumentResponse{}, fmt.Errorf("failed to find document: %w", err)
+	}
+
+	resp := dto.GetDocumentResponse{Document: toDocumentResponse(document)}
+	if !req.IncludeContent {
+		return resp, nil
+	}
+	if document.IsPurged() {
+		return dto.GetDocumentResponse{}, ErrDocumentPurged
+	}
+
+	ciphertext, err := uc.store.Get(ctx, document.StorageKey())
+	if err != nil {
+		return dto.GetDocumentResponse{}, fmt.Errorf("failed to load document: %w", err)
+	}
+	content, err := uc.cipher.Decrypt(ciphertext, documentAAD(document.ID()))
+	if err != nil {
+		return dto.GetDocumentResponse{}, err
+	}
+	digest := sha256.Sum256(content)
+	if hex.EncodeToString(digest[:]) != document.SHA256() {
+		return dto.GetDocumentResponse{}, fmt.Errorf("document %s failed integrity check", document.ID())
+	}
+
+	resp.Content = content
+	return resp, nil
+}
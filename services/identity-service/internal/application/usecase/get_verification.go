@@ -2,13 +2,15 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
 	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
 )
 
-// GetVerification retrieves a single identity verification by ID.
+// GetVerification retrieves a single identity verification by ID. A
+// verification owned by another tenant is reported as not found.
 type GetVerification struct {
 	repo port.VerificationRepository
 }
@@ -20,8 +22,14 @@ func NewGetVerification(repo port.VerificationRepository) *GetVerification {
 func (uc *GetVerification) Execute(ctx context.Context, req dto.GetVerificationRequest) (dto.VerificationResponse, error) {
 	verification, err := uc.repo.FindByID(ctx, req.ID)
 	if err != nil {
+		if errors.Is(err, port.ErrVerificationNotFound) {
+			return dto.VerificationResponse{}, fmt.Errorf("%w: verification %s", ErrNotFound, req.ID)
+		}
 		return dto.VerificationResponse{}, fmt.Errorf("failed to find verification: %w", err)
 	}
+	if verification.TenantID() != req.TenantID {
+		return dto.VerificationResponse{}, fmt.Errorf("%w: verification %s", ErrNotFound, req.ID)
+	}
 
 	return toVerificationResponse(verification), nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
 	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
 	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
 )
 
 func TestGetVerification_Execute(t *testing.T) {
@@ -28,7 +29,7 @@ func TestGetVerification_Execute(t *testing.T) {
 
 		uc := usecase.NewGetVerification(repo)
 
-		req := dto.GetVerificationRequest{ID: v.ID()}
+		req := dto.GetVerificationRequest{TenantID: v.TenantID(), ID: v.ID()}
 		resp, err := uc.Execute(context.Background(), req)
 
 		require.NoError(t, err)
@@ -56,4 +57,32 @@ func TestGetVerification_Execute(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to find verification")
 	})
+
+	t.Run("reports a missing verification as not found", func(t *testing.T) {
+		repo := &mockVerificationRepository{
+			findByIDFunc: func(_ context.Context, id uuid.UUID) (model.IdentityVerification, error) {
+				return model.IdentityVerification{}, fmt.Errorf("%w: %s", port.ErrVerificationNotFound, id)
+			},
+		}
+
+		_, err := usecase.NewGetVerification(repo).Execute(context.Background(), dto.GetVerificationRequest{ID: uuid.New()})
+
+		require.ErrorIs(t, err, usecase.ErrNotFound)
+	})
+
+	t.Run("reports another tenant's verification as not found", func(t *testing.T) {
+		v, _ := model.NewIdentityVerification(
+			uuid.New(), "Jane", "Smith", "jane@example.com", "1990-01-01", "US",
+		)
+		repo := &mockVerificationRepository{
+			findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) {
+				return v, nil
+			},
+		}
+
+		_, err := usecase.NewGetVerification(repo).Execute(context.Background(),
+			dto.GetVerificationRequest{TenantID: uuid.New(), ID: v.ID()})
+
+		require.ErrorIs(t, err, usecase.ErrNotFound)
+	})
 }
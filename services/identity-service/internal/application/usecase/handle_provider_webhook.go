@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// HandleProviderWebhook verifies a KYC provider callback and applies the
+// reported result to the matching check through CompleteCheck. Borderline
+// results, such as Onfido's "consider", send the check to analyst review.
+type HandleProviderWebhook struct {
+	repo          port.VerificationRepository
+	provider      port.WebhookProvider
+	completeCheck *CompleteCheck
+}
+
+func NewHandleProviderWebhook(
+	repo port.VerificationRepository,
+	provider port.WebhookProvider,
+	completeCheck *CompleteCheck,
+) *HandleProviderWebhook {
+	return &HandleProviderWebhook{
+		repo:          repo,
+		provider:      provider,
+		completeCheck: completeCheck,
+	}
+}
+
+// Execute applies the callback. Unknown provider references return
+// port.ErrCheckNotFound so the provider retries; a callback can arrive before
+// the verification that started the check has been saved.
+func (uc *HandleProviderWebhook) Execute(ctx context.Context, req dto.ProviderWebhookRequest) (dto.ProviderWebhookResponse, error) {
+	result, err := uc.provider.ParseWebhook(ctx, req.Payload, req.Signature)
+	if err != nil {
+		return dto.ProviderWebhookResponse{}, fmt.Errorf("failed to parse %s webhook: %w", uc.provider.Name(), err)
+	}
+	if result.ProviderRef == "" {
+		return dto.ProviderWebhookResponse{}, nil
+	}
+
+	verification, checkID, err := uc.repo.FindByProviderReference(ctx, uc.provider.Name(), result.ProviderRef)
+	if err != nil {
+		return dto.ProviderWebhookResponse{}, fmt.Errorf("failed to find check: %w", err)
+	}
+
+	resp := dto.ProviderWebhookResponse{
+		VerificationID: verification.ID(),
+		CheckID:        checkID,
+		Status:         result.Status.String(),
+	}
+	// Interim statuses, and results for a verification that another check
+	// has already decided, are acknowledged without a transition.
+	review := result.Status.Equal(valueobject.StatusReview)
+	if (!result.Status.IsTerminal() && !review) || verification.Status().IsTerminal() {
+		return resp, nil
+	}
+	for _, c := range verification.Checks() {
+		if c.ID() == checkID && (c.Status().IsTerminal() || c.Status().Equal(valueobject.StatusReview)) {
+			resp.Status = c.Status().String()
+			return resp, nil
+		}
+	}
+
+	if _, err := uc.completeCheck.Execute(ctx, dto.CompleteCheckRequest{
+		VerificationID: verification.ID(),
+		CheckID:        checkID,
+		Status:         result.Status.String(),
+		FailureReason:  result.FailureReason,
+	}); err != nil {
+		return dto.ProviderWebhookResponse{}, err
+	}
+	resp.Applied = true
+	return resp, nil
+}
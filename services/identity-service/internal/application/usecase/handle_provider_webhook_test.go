@@ -0,0 +1,126 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// mockWebhookProvider implements port.WebhookProvider for testing.
+type mockWebhookProvider struct {
+	mockVerificationProvider
+	result port.CheckResult
+	err    error
+}
+
+func (m *mockWebhookProvider) SignatureHeader() string { return "X-Signature" }
+
+func (m *mockWebhookProvider) ParseWebhook(_ context.Context, _ []byte, _ string) (port.CheckResult, error) {
+	return m.result, m.err
+}
+
+func newWebhookUseCase(v model.IdentityVerification, checkID uuid.UUID, result port.CheckResult) (*usecase.HandleProviderWebhook, *mockVerificationRepository) {
+	repo := &mockVerificationRepository{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) {
+			return v, nil
+		},
+		findByRefFunc: func(_ context.Context, provider, ref string) (model.IdentityVerification, uuid.UUID, error) {
+			if provider != "persona" || ref != "inq_1" {
+				return model.IdentityVerification{}, uuid.Nil, port.ErrCheckNotFound
+			}
+			return v, checkID, nil
+		},
+	}
+	completeCheck := usecase.NewCompleteCheck(repo, &mockIdentityEventPublisher{})
+	return usecase.NewHandleProviderWebhook(repo, &mockWebhookProvider{result: result}, completeCheck), repo
+}
+
+func TestHandleProviderWebhook_Execute(t *testing.T) {
+	t.Run("applies a terminal result to the matching check", func(t *testing.T) {
+		v := inProgressVerification()
+		checkID := v.Checks()[0].ID()
+		uc, repo := newWebhookUseCase(v, checkID, port.CheckResult{
+			ProviderRef: "inq_1", Status: valueobject.StatusRejected, FailureReason: "verification_declined",
+		})
+
+		resp, err := uc.Execute(context.Background(), dto.ProviderWebhookRequest{Payload: []byte(`{}`)})
+		require.NoError(t, err)
+		assert.True(t, resp.Applied)
+		assert.Equal(t, checkID, resp.CheckID)
+		require.Len(t, repo.savedVerifications, 1)
+		assert.Equal(t, valueobject.StatusRejected, repo.savedVerifications[0].Status())
+	})
+
+	t.Run("acknowledges interim statuses without a transition", func(t *testing.T) {
+		v := inProgressVerification()
+		uc, repo := newWebhookUseCase(v, v.Checks()[0].ID(), port.CheckResult{
+			ProviderRef: "inq_1", Status: valueobject.StatusInProgress,
+		})
+
+		resp, err := uc.Execute(context.Background(), dto.ProviderWebhookRequest{})
+		require.NoError(t, err)
+		assert.False(t, resp.Applied)
+		assert.Empty(t, repo.savedVerifications)
+	})
+
+	t.Run("acknowledges redelivered results", func(t *testing.T) {
+		v := inProgressVerification()
+		checkID := v.Checks()[0].ID()
+		v, err := v.CompleteCheck(checkID, valueobject.StatusApproved, "", v.UpdatedAt())
+		require.NoError(t, err)
+		uc, repo := newWebhookUseCase(v, checkID, port.CheckResult{
+			ProviderRef: "inq_1", Status: valueobject.StatusApproved,
+		})
+
+		resp, err := uc.Execute(context.Background(), dto.ProviderWebhookRequest{})
+		require.NoError(t, err)
+		assert.False(t, resp.Applied)
+		assert.Equal(t, "APPROVED", resp.Status)
+		assert.Empty(t, repo.savedVerifications)
+	})
+
+	t.Run("sends borderline results to review", func(t *testing.T) {
+		v := inProgressVerification()
+		checkID := v.Checks()[0].ID()
+		uc, repo := newWebhookUseCase(v, checkID, port.CheckResult{
+			ProviderRef: "inq_1", Status: valueobject.StatusReview, FailureReason: "onfido_result_consider",
+		})
+
+		resp, err := uc.Execute(context.Background(), dto.ProviderWebhookRequest{})
+		require.NoError(t, err)
+		assert.True(t, resp.Applied)
+		require.Len(t, repo.savedVerifications, 1)
+		check := repo.savedVerifications[0].Checks()[0]
+		assert.True(t, check.Status().Equal(valueobject.StatusReview))
+		assert.Equal(t, "onfido_result_consider", check.FailureReason())
+	})
+
+	t.Run("reports unknown references", func(t *testing.T) {
+		v := inProgressVerification()
+		uc, _ := newWebhookUseCase(v, v.Checks()[0].ID(), port.CheckResult{
+			ProviderRef: "inq_unknown", Status: valueobject.StatusApproved,
+		})
+
+		_, err := uc.Execute(context.Background(), dto.ProviderWebhookRequest{})
+		assert.ErrorIs(t, err, port.ErrCheckNotFound)
+	})
+
+	t.Run("propagates signature failures", func(t *testing.T) {
+		repo := &mockVerificationRepository{}
+		uc := usecase.NewHandleProviderWebhook(repo,
+			&mockWebhookProvider{err: port.ErrInvalidWebhookSignature},
+			usecase.NewCompleteCheck(repo, &mockIdentityEventPublisher{}))
+
+		_, err := uc.Execute(context.Background(), dto.ProviderWebhookRequest{})
+		assert.ErrorIs(t, err, port.ErrInvalidWebhookSignature)
+	})
+}
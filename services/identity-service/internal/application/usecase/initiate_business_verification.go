@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// InitiateBusinessVerification creates a business (KYB) verification and
+// submits the business to the KYB provider.
+type InitiateBusinessVerification struct {
+	repo      port.BusinessVerificationRepository
+	provider  port.BusinessVerificationProvider
+	publisher port.EventPublisher
+}
+
+func NewInitiateBusinessVerification(
+	repo port.BusinessVerificationRepository,
+	provider port.BusinessVerificationProvider,
+	publisher port.EventPublisher,
+) *InitiateBusinessVerification {
+	return &InitiateBusinessVerification{
+		repo:      repo,
+		provider:  provider,
+		publisher: publisher,
+	}
+}
+
+func (uc *InitiateBusinessVerification) Execute(ctx context.Context, req dto.InitiateBusinessVerificationRequest) (dto.BusinessVerificationResponse, error) {
+	owners := make([]model.BeneficialOwner, 0, len(req.BeneficialOwners))
+	ownerInfo := make([]port.ApplicantInfo, 0, len(req.BeneficialOwners))
+	for _, o := range req.BeneficialOwners {
+		owner, err := model.NewBeneficialOwner(o.FirstName, o.LastName, o.DateOfBirth, o.Country, o.OwnershipPercent)
+		if err != nil {
+			return dto.BusinessVerificationResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		owners = append(owners, owner)
+		ownerInfo = append(ownerInfo, port.ApplicantInfo{
+			FirstName:   o.FirstName,
+			LastName:    o.LastName,
+			DateOfBirth: o.DateOfBirth,
+			Country:     o.Country,
+		})
+	}
+
+	business, err := model.NewBusinessVerification(req.TenantID, req.LegalName, req.RegistrationNumber, req.Country, owners)
+	if err != nil {
+		return dto.BusinessVerificationResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	// Submit the business once for all of its checks.
+	checks := business.Checks()
+	checkTypes := make([]valueobject.CheckType, 0, len(checks))
+	for _, c := range checks {
+		checkTypes = append(checkTypes, c.CheckType())
+	}
+	refs, err := uc.provider.InitiateBusinessChecks(ctx, port.BusinessInfo{
+		LegalName:          business.LegalName(),
+		RegistrationNumber: business.RegistrationNumber(),
+		Country:            business.Country(),
+		BeneficialOwners:   ownerInfo,
+	}, checkTypes)
+	if err != nil {
+		return dto.BusinessVerificationResponse{}, fmt.Errorf("failed to initiate business checks: %w", err)
+	}
+	for _, c := range checks {
+		ref, ok := refs[c.CheckType()]
+		if !ok {
+			return dto.BusinessVerificationResponse{}, fmt.Errorf("provider %s did not start a %s check", uc.provider.Name(), c.CheckType().String())
+		}
+		business, err = business.UpdateCheckProvider(c.ID(), uc.provider.Name(), ref)
+		if err != nil {
+			return dto.BusinessVerificationResponse{}, fmt.Errorf("failed to update check provider: %w", err)
+		}
+	}
+
+	business, err = business.StartProcessing(business.CreatedAt())
+	if err != nil {
+		return dto.BusinessVerificationResponse{}, fmt.Errorf("failed to start processing: %w", err)
+	}
+
+	if err := uc.repo.Save(ctx, business); err != nil {
+		return dto.BusinessVerificationResponse{}, fmt.Errorf("failed to save business verification: %w", err)
+	}
+
+	if events := business.DomainEvents(); len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, TopicIdentityVerifications, events...); err != nil {
+			return dto.BusinessVerificationResponse{}, fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+
+	return toBusinessVerificationResponse(business), nil
+}
@@ -0,0 +1,134 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// --- Mock implementations ---
+
+// mockBusinessRepository implements port.BusinessVerificationRepository in memory.
+type mockBusinessRepository struct {
+	businesses map[uuid.UUID]model.BusinessVerification
+}
+
+func (m *mockBusinessRepository) Save(_ context.Context, b model.BusinessVerification) error {
+	if m.businesses == nil {
+		m.businesses = make(map[uuid.UUID]model.BusinessVerification)
+	}
+	m.businesses[b.ID()] = b
+	return nil
+}
+
+func (m *mockBusinessRepository) FindByID(_ context.Context, id uuid.UUID) (model.BusinessVerification, error) {
+	b, ok := m.businesses[id]
+	if !ok {
+		return model.BusinessVerification{}, port.ErrBusinessVerificationNotFound
+	}
+	return b, nil
+}
+
+// mockBusinessProvider implements port.BusinessVerificationProvider for testing.
+type mockBusinessProvider struct {
+	submitted []port.BusinessInfo
+}
+
+func (m *mockBusinessProvider) Name() string { return "middesk" }
+
+func (m *mockBusinessProvider) InitiateBusinessChecks(_ context.Context, business port.BusinessInfo, checkTypes []valueobject.CheckType) (map[valueobject.CheckType]string, error) {
+	m.submitted = append(m.submitted, business)
+	refs := make(map[valueobject.CheckType]string, len(checkTypes))
+	for _, ct := range checkTypes {
+		refs[ct] = fmt.Sprintf("bus_1:%s", ct.String())
+	}
+	return refs, nil
+}
+
+func businessRequest(tenantID uuid.UUID) dto.InitiateBusinessVerificationRequest {
+	return dto.InitiateBusinessVerificationRequest{
+		TenantID:           tenantID,
+		LegalName:          "Acme Ltd",
+		RegistrationNumber: "01234567",
+		Country:            "GB",
+		BeneficialOwners: []dto.BeneficialOwnerDTO{
+			{FirstName: "Jane", LastName: "Smith", DateOfBirth: "1980-02-03", Country: "GB", OwnershipPercent: 75},
+		},
+	}
+}
+
+// --- Tests ---
+
+func TestInitiateBusinessVerification_Execute(t *testing.T) {
+	t.Run("submits the business once and starts every check", func(t *testing.T) {
+		repo := &mockBusinessRepository{}
+		provider := &mockBusinessProvider{}
+		publisher := &mockIdentityEventPublisher{}
+		uc := usecase.NewInitiateBusinessVerification(repo, provider, publisher)
+
+		resp, err := uc.Execute(context.Background(), businessRequest(uuid.New()))
+		require.NoError(t, err)
+
+		assert.Equal(t, "IN_PROGRESS", resp.Status)
+		require.Len(t, provider.submitted, 1)
+		require.Len(t, provider.submitted[0].BeneficialOwners, 1)
+		require.Len(t, resp.Checks, 3)
+		for _, c := range resp.Checks {
+			assert.Equal(t, "middesk", c.Provider)
+			assert.Equal(t, "bus_1:"+c.CheckType, c.ProviderReference)
+			assert.Equal(t, "IN_PROGRESS", c.Status)
+		}
+		assert.Len(t, repo.businesses, 1)
+		require.Len(t, publisher.publishedEvents, 1)
+		assert.Equal(t, "identity.business_verification.initiated", publisher.publishedEvents[0].EventType())
+	})
+
+	t.Run("rejects invalid beneficial owners", func(t *testing.T) {
+		uc := usecase.NewInitiateBusinessVerification(&mockBusinessRepository{}, &mockBusinessProvider{}, &mockIdentityEventPublisher{})
+
+		req := businessRequest(uuid.New())
+		req.BeneficialOwners[0].OwnershipPercent = 150
+		_, err := uc.Execute(context.Background(), req)
+		assert.True(t, errors.Is(err, usecase.ErrInvalidInput))
+	})
+}
+
+func TestCompleteBusinessCheck_Execute(t *testing.T) {
+	repo := &mockBusinessRepository{}
+	publisher := &mockIdentityEventPublisher{}
+	tenantID := uuid.New()
+	created, err := usecase.NewInitiateBusinessVerification(repo, &mockBusinessProvider{}, publisher).
+		Execute(context.Background(), businessRequest(tenantID))
+	require.NoError(t, err)
+
+	uc := usecase.NewCompleteBusinessCheck(repo, publisher)
+
+	t.Run("hides other tenants' verifications", func(t *testing.T) {
+		_, err := uc.Execute(context.Background(), dto.CompleteBusinessCheckRequest{
+			TenantID: uuid.New(), VerificationID: created.ID, CheckID: created.Checks[0].ID, Status: "APPROVED",
+		})
+		assert.True(t, errors.Is(err, usecase.ErrNotFound))
+	})
+
+	t.Run("approves the business when every check passes", func(t *testing.T) {
+		var resp dto.BusinessVerificationResponse
+		for _, c := range created.Checks {
+			resp, err = uc.Execute(context.Background(), dto.CompleteBusinessCheckRequest{
+				TenantID: tenantID, VerificationID: created.ID, CheckID: c.ID, Status: "APPROVED",
+			})
+			require.NoError(t, err)
+		}
+		assert.Equal(t, "APPROVED", resp.Status)
+	})
+}
@@ -3,35 +3,67 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
 	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
 	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
 )
 
 const TopicIdentityVerifications = "bib.identity.verifications"
 
 // InitiateVerification handles the creation of a new identity verification
-// and initiates checks via the external provider.
+// and initiates checks via the external provider. Requested screening checks
+// are run synchronously by the screener. An approval stays valid for the
+// period configured for the applicant's risk tier, falling back to the
+// tier's default validity. When duplicate detection is configured, the
+// applicant is fingerprinted and held for review if they match another.
 type InitiateVerification struct {
-	repo      port.VerificationRepository
-	provider  port.VerificationProvider
-	publisher port.EventPublisher
+	repo       port.VerificationRepository
+	provider   port.VerificationProvider
+	publisher  port.EventPublisher
+	screener   *ScreenVerification
+	duplicates *DetectDuplicateIdentity
+	validity   map[valueobject.RiskTier]time.Duration
 }
 
 func NewInitiateVerification(
 	repo port.VerificationRepository,
 	provider port.VerificationProvider,
 	publisher port.EventPublisher,
+	screener *ScreenVerification,
+	duplicates *DetectDuplicateIdentity,
+	validity map[valueobject.RiskTier]time.Duration,
 ) *InitiateVerification {
 	return &InitiateVerification{
-		repo:      repo,
-		provider:  provider,
-		publisher: publisher,
+		repo:       repo,
+		provider:   provider,
+		publisher:  publisher,
+		screener:   screener,
+		duplicates: duplicates,
+		validity:   validity,
 	}
 }
 
 func (uc *InitiateVerification) Execute(ctx context.Context, req dto.InitiateVerificationRequest) (dto.VerificationResponse, error) {
+	screeningChecks, err := parseScreeningChecks(req.ScreeningChecks)
+	if err != nil {
+		return dto.VerificationResponse{}, err
+	}
+	riskTier := valueobject.RiskTierMedium
+	if req.RiskTier != "" {
+		riskTier, err = valueobject.NewRiskTier(req.RiskTier)
+		if err != nil {
+			return dto.VerificationResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+	}
+	if len(screeningChecks) > 0 && uc.screener == nil {
+		return dto.VerificationResponse{}, fmt.Errorf("%w: screening is not configured", ErrInvalidInput)
+	}
+
 	// Create the verification aggregate
 	verification, err := model.NewIdentityVerification(
 		req.TenantID,
@@ -40,9 +72,20 @@ func (uc *InitiateVerification) Execute(ctx context.Context, req dto.InitiateVer
 		req.Email,
 		req.DateOfBirth,
 		req.Country,
+		screeningChecks...,
 	)
 	if err != nil {
-		return dto.VerificationResponse{}, fmt.Errorf("failed to create verification: %w", err)
+		return dto.VerificationResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	verification, err = verification.AssignRiskTier(riskTier, uc.validityFor(riskTier))
+	if err != nil {
+		return dto.VerificationResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	if req.PreviousVerificationID != uuid.Nil {
+		verification, err = verification.LinkPreviousVerification(req.PreviousVerificationID)
+		if err != nil {
+			return dto.VerificationResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
 	}
 
 	// Initiate checks via the external provider
@@ -55,11 +98,14 @@ func (uc *InitiateVerification) Execute(ctx context.Context, req dto.InitiateVer
 	}
 
 	for _, check := range verification.Checks() {
+		if check.CheckType().IsScreening() {
+			continue
+		}
 		providerRef, provErr := uc.provider.InitiateCheck(ctx, check.CheckType(), applicant)
 		if provErr != nil {
 			return dto.VerificationResponse{}, fmt.Errorf("failed to initiate %s check: %w", check.CheckType().String(), provErr)
 		}
-		verification, err = verification.UpdateCheckProvider(check.ID(), "persona", providerRef)
+		verification, err = verification.UpdateCheckProvider(check.ID(), uc.provider.Name(), providerRef)
 		if err != nil {
 			return dto.VerificationResponse{}, fmt.Errorf("failed to update check provider: %w", err)
 		}
@@ -72,10 +118,38 @@ func (uc *InitiateVerification) Execute(ctx context.Context, req dto.InitiateVer
 		return dto.VerificationResponse{}, fmt.Errorf("failed to start processing: %w", err)
 	}
 
+	// Run screening checks
+	var results []model.ScreeningResult
+	if len(screeningChecks) > 0 {
+		verification, results, err = uc.screener.screen(ctx, verification, model.ScreeningTriggerInitial, now)
+		if err != nil {
+			return dto.VerificationResponse{}, err
+		}
+	}
+
+	// Detect duplicate identities
+	var fingerprint model.ApplicantFingerprint
+	if uc.duplicates != nil {
+		verification, fingerprint, err = uc.duplicates.fingerprint(ctx, verification, req.DocumentNumber, now)
+		if err != nil {
+			return dto.VerificationResponse{}, err
+		}
+	}
+
 	// Persist
 	if err := uc.repo.Save(ctx, verification); err != nil {
 		return dto.VerificationResponse{}, fmt.Errorf("failed to save verification: %w", err)
 	}
+	if uc.duplicates != nil {
+		if err := uc.duplicates.save(ctx, fingerprint); err != nil {
+			return dto.VerificationResponse{}, err
+		}
+	}
+	if len(results) > 0 {
+		if err := uc.screener.saveResults(ctx, results); err != nil {
+			return dto.VerificationResponse{}, err
+		}
+	}
 
 	// Publish domain events
 	if events := verification.DomainEvents(); len(events) > 0 {
@@ -86,3 +160,17 @@ func (uc *InitiateVerification) Execute(ctx context.Context, req dto.InitiateVer
 
 	return toVerificationResponse(verification), nil
 }
+
+// validityFor returns the configured validity period for a risk tier.
+func (uc *InitiateVerification) validityFor(tier valueobject.RiskTier) time.Duration {
+	return tierValidity(uc.validity, tier)
+}
+
+// tierValidity returns the validity period configured for a risk tier,
+// falling back to the tier's default.
+func tierValidity(validity map[valueobject.RiskTier]time.Duration, tier valueobject.RiskTier) time.Duration {
+	if d, ok := validity[tier]; ok && d > 0 {
+		return d
+	}
+	return tier.DefaultValidity()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -23,6 +24,10 @@ import (
 type mockVerificationRepository struct {
 	findByIDFunc       func(ctx context.Context, id uuid.UUID) (model.IdentityVerification, error)
 	saveFunc           func(ctx context.Context, v model.IdentityVerification) error
+	findByRefFunc      func(ctx context.Context, provider, providerRef string) (model.IdentityVerification, uuid.UUID, error)
+	listDueFunc        func(ctx context.Context, now, noticeBefore time.Time, limit int) ([]model.IdentityVerification, error)
+	listByEmailFunc    func(ctx context.Context, tenantID uuid.UUID, email string) ([]model.IdentityVerification, error)
+	listErasureFunc    func(ctx context.Context, tenantID uuid.UUID, cutoff time.Time, limit int) ([]model.IdentityVerification, error)
 	savedVerifications []model.IdentityVerification
 }
 
@@ -45,6 +50,34 @@ func (m *mockVerificationRepository) ListByTenant(_ context.Context, _ uuid.UUID
 	return nil, 0, nil
 }
 
+func (m *mockVerificationRepository) FindByProviderReference(ctx context.Context, provider, providerRef string) (model.IdentityVerification, uuid.UUID, error) {
+	if m.findByRefFunc != nil {
+		return m.findByRefFunc(ctx, provider, providerRef)
+	}
+	return model.IdentityVerification{}, uuid.Nil, port.ErrCheckNotFound
+}
+
+func (m *mockVerificationRepository) ListDueForRefresh(ctx context.Context, now, noticeBefore time.Time, limit int) ([]model.IdentityVerification, error) {
+	if m.listDueFunc != nil {
+		return m.listDueFunc(ctx, now, noticeBefore, limit)
+	}
+	return nil, nil
+}
+
+func (m *mockVerificationRepository) ListByApplicantEmail(ctx context.Context, tenantID uuid.UUID, email string) ([]model.IdentityVerification, error) {
+	if m.listByEmailFunc != nil {
+		return m.listByEmailFunc(ctx, tenantID, email)
+	}
+	return nil, nil
+}
+
+func (m *mockVerificationRepository) ListDueForErasure(ctx context.Context, tenantID uuid.UUID, cutoff time.Time, limit int) ([]model.IdentityVerification, error) {
+	if m.listErasureFunc != nil {
+		return m.listErasureFunc(ctx, tenantID, cutoff, limit)
+	}
+	return nil, nil
+}
+
 // mockVerificationProvider implements port.VerificationProvider for testing.
 type mockVerificationProvider struct {
 	initiateCheckFunc  func(ctx context.Context, checkType valueobject.CheckType, applicant port.ApplicantInfo) (string, error)
@@ -58,6 +91,8 @@ type initiatedCheck struct {
 	Reference string
 }
 
+func (m *mockVerificationProvider) Name() string { return "persona" }
+
 func (m *mockVerificationProvider) InitiateCheck(ctx context.Context, checkType valueobject.CheckType, applicant port.ApplicantInfo) (string, error) {
 	if m.initiateCheckFunc != nil {
 		return m.initiateCheckFunc(ctx, checkType, applicant)
@@ -110,7 +145,7 @@ func TestInitiateVerification_Success(t *testing.T) {
 	provider := &mockVerificationProvider{}
 	publisher := &mockEventPublisher{}
 
-	uc := usecase.NewInitiateVerification(repo, provider, publisher)
+	uc := usecase.NewInitiateVerification(repo, provider, publisher, nil, nil, nil)
 
 	req := validInitiateRequest()
 	resp, err := uc.Execute(context.Background(), req)
@@ -149,7 +184,7 @@ func TestInitiateVerification_MissingFirstName(t *testing.T) {
 	provider := &mockVerificationProvider{}
 	publisher := &mockEventPublisher{}
 
-	uc := usecase.NewInitiateVerification(repo, provider, publisher)
+	uc := usecase.NewInitiateVerification(repo, provider, publisher, nil, nil, nil)
 
 	req := validInitiateRequest()
 	req.FirstName = ""
@@ -169,7 +204,7 @@ func TestInitiateVerification_ProviderError(t *testing.T) {
 	}
 	publisher := &mockEventPublisher{}
 
-	uc := usecase.NewInitiateVerification(repo, provider, publisher)
+	uc := usecase.NewInitiateVerification(repo, provider, publisher, nil, nil, nil)
 
 	req := validInitiateRequest()
 	_, err := uc.Execute(context.Background(), req)
@@ -189,7 +224,7 @@ func TestInitiateVerification_RepoSaveError(t *testing.T) {
 	provider := &mockVerificationProvider{}
 	publisher := &mockEventPublisher{}
 
-	uc := usecase.NewInitiateVerification(repo, provider, publisher)
+	uc := usecase.NewInitiateVerification(repo, provider, publisher, nil, nil, nil)
 
 	req := validInitiateRequest()
 	_, err := uc.Execute(context.Background(), req)
@@ -209,7 +244,7 @@ func TestInitiateVerification_PublishError(t *testing.T) {
 		},
 	}
 
-	uc := usecase.NewInitiateVerification(repo, provider, publisher)
+	uc := usecase.NewInitiateVerification(repo, provider, publisher, nil, nil, nil)
 
 	req := validInitiateRequest()
 	_, err := uc.Execute(context.Background(), req)
@@ -224,7 +259,7 @@ func TestInitiateVerification_CheckTypes(t *testing.T) {
 	provider := &mockVerificationProvider{}
 	publisher := &mockEventPublisher{}
 
-	uc := usecase.NewInitiateVerification(repo, provider, publisher)
+	uc := usecase.NewInitiateVerification(repo, provider, publisher, nil, nil, nil)
 
 	req := validInitiateRequest()
 	resp, err := uc.Execute(context.Background(), req)
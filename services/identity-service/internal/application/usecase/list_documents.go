@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+)
+
+// ListDocuments returns the documents uploaded for a verification.
+type ListDocuments struct {
+	documents port.DocumentRepository
+}
+
+func NewListDocuments(documents port.DocumentRepository) *ListDocuments {
+	return &ListDocuments{documents: documents}
+}
+
+func (uc *ListDocuments) Execute(ctx context.Context, req dto.ListDocumentsRequest) (dto.ListDocumentsResponse, error) {
+	documents, err := uc.documents.ListByVerification(ctx, req.TenantID, req.VerificationID)
+	if err != nil {
+		return dto.ListDocumentsResponse{}, fmt.Errorf("failed to list documents: %w", err)
+	}
+
+	resp := dto.ListDocumentsResponse{Documents: make([]dto.DocumentResponse, 0, len(documents))}
+	for _, d := range documents {
+		resp.Documents = append(resp.Documents, toDocumentResponse(d))
+	}
+	return resp, nil
+}
@@ -1,15 +1,69 @@
 package usecase
 
 import (
+	"time"
+
 	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
 	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
 )
 
 // toVerificationResponse maps a domain model to a response DTO.
 func toVerificationResponse(v model.IdentityVerification) dto.VerificationResponse {
-	var checks []dto.VerificationCheckDTO
-	for _, c := range v.Checks() {
-		checks = append(checks, dto.VerificationCheckDTO{
+	return dto.VerificationResponse{
+		ID:                     v.ID(),
+		TenantID:               v.TenantID(),
+		ApplicantFirstName:     v.ApplicantFirstName(),
+		ApplicantLastName:      v.ApplicantLastName(),
+		ApplicantEmail:         v.ApplicantEmail(),
+		ApplicantDOB:           v.ApplicantDOB(),
+		ApplicantCountry:       v.ApplicantCountry(),
+		Status:                 v.Status().String(),
+		RiskTier:               v.RiskTier().String(),
+		RiskScore:              v.RiskScore(),
+		RiskFactors:            v.RiskFactors(),
+		Checks:                 toCheckDTOs(v.Checks()),
+		ExpiresAt:              v.ExpiresAt(),
+		ErasedAt:               v.ErasedAt(),
+		PreviousVerificationID: v.PreviousVerificationID(),
+		Version:                v.Version(),
+		CreatedAt:              v.CreatedAt(),
+		UpdatedAt:              v.UpdatedAt(),
+	}
+}
+
+// toBusinessVerificationResponse maps a business verification to a response DTO.
+func toBusinessVerificationResponse(b model.BusinessVerification) dto.BusinessVerificationResponse {
+	owners := make([]dto.BeneficialOwnerDTO, 0, len(b.BeneficialOwners()))
+	for _, o := range b.BeneficialOwners() {
+		owners = append(owners, dto.BeneficialOwnerDTO{
+			FirstName:        o.FirstName(),
+			LastName:         o.LastName(),
+			DateOfBirth:      o.DateOfBirth(),
+			Country:          o.Country(),
+			OwnershipPercent: o.OwnershipPercent(),
+		})
+	}
+
+	return dto.BusinessVerificationResponse{
+		ID:                 b.ID(),
+		TenantID:           b.TenantID(),
+		LegalName:          b.LegalName(),
+		RegistrationNumber: b.RegistrationNumber(),
+		Country:            b.Country(),
+		Status:             b.Status().String(),
+		BeneficialOwners:   owners,
+		Checks:             toCheckDTOs(b.Checks()),
+		Version:            b.Version(),
+		CreatedAt:          b.CreatedAt(),
+		UpdatedAt:          b.UpdatedAt(),
+	}
+}
+
+// toCheckDTOs maps verification checks to DTOs.
+func toCheckDTOs(checks []model.VerificationCheck) []dto.VerificationCheckDTO {
+	var out []dto.VerificationCheckDTO
+	for _, c := range checks {
+		out = append(out, dto.VerificationCheckDTO{
 			ID:                c.ID(),
 			CheckType:         c.CheckType().String(),
 			Status:            c.Status().String(),
@@ -19,19 +73,94 @@ func toVerificationResponse(v model.IdentityVerification) dto.VerificationRespon
 			FailureReason:     c.FailureReason(),
 		})
 	}
+	return out
+}
+
+// toDocumentResponse maps a document to a response DTO.
+func toDocumentResponse(d model.IdentityDocument) dto.DocumentResponse {
+	return dto.DocumentResponse{
+		ID:             d.ID(),
+		VerificationID: d.VerificationID(),
+		CheckID:        d.CheckID(),
+		DocumentType:   d.DocumentType().String(),
+		ContentType:    d.ContentType(),
+		SizeBytes:      d.SizeBytes(),
+		SHA256:         d.SHA256(),
+		UploadedAt:     d.UploadedAt(),
+		RetainUntil:    d.RetainUntil(),
+		PurgedAt:       d.PurgedAt(),
+	}
+}
 
-	return dto.VerificationResponse{
-		ID:                 v.ID(),
-		TenantID:           v.TenantID(),
-		ApplicantFirstName: v.ApplicantFirstName(),
-		ApplicantLastName:  v.ApplicantLastName(),
-		ApplicantEmail:     v.ApplicantEmail(),
-		ApplicantDOB:       v.ApplicantDOB(),
-		ApplicantCountry:   v.ApplicantCountry(),
-		Status:             v.Status().String(),
-		Checks:             checks,
-		Version:            v.Version(),
-		CreatedAt:          v.CreatedAt(),
-		UpdatedAt:          v.UpdatedAt(),
+// toScreeningResultDTO maps a screening result to a DTO.
+func toScreeningResultDTO(r model.ScreeningResult) dto.ScreeningResultDTO {
+	matches := make([]dto.ScreeningMatchDTO, 0, len(r.Matches()))
+	for _, m := range r.Matches() {
+		matches = append(matches, dto.ScreeningMatchDTO{
+			Name:       m.Name,
+			Categories: m.Categories,
+			Sources:    m.Sources,
+			EntryID:    m.EntryID,
+			Score:      m.Score,
+		})
+	}
+
+	return dto.ScreeningResultDTO{
+		ID:                r.ID(),
+		CheckID:           r.CheckID(),
+		CheckType:         r.CheckType().String(),
+		Provider:          r.Provider(),
+		ProviderReference: r.ProviderReference(),
+		Trigger:           r.Trigger(),
+		Outcome:           r.Outcome(),
+		Matches:           matches,
+		ScreenedAt:        r.ScreenedAt(),
+	}
+}
+
+// toReviewCaseResponse maps a review case to a DTO, judging overdue at now.
+func toReviewCaseResponse(c model.ReviewCase, now time.Time) dto.ReviewCaseResponse {
+	decisions := make([]dto.ReviewDecisionDTO, 0, len(c.Decisions()))
+	for _, d := range c.Decisions() {
+		decisions = append(decisions, dto.ReviewDecisionDTO{
+			AnalystID: d.AnalystID,
+			Outcome:   d.Outcome,
+			Reason:    d.Reason,
+			DecidedAt: d.DecidedAt,
+		})
+	}
+
+	return dto.ReviewCaseResponse{
+		ID:               c.ID(),
+		VerificationID:   c.VerificationID(),
+		Status:           c.Status(),
+		Reason:           c.Reason(),
+		Outcome:          c.Outcome(),
+		RequiresFourEyes: c.RequiresFourEyes(),
+		AssigneeID:       c.AssigneeID(),
+		Decisions:        decisions,
+		OpenedAt:         c.OpenedAt(),
+		DueAt:            c.DueAt(),
+		ClaimedAt:        c.ClaimedAt(),
+		DecidedAt:        c.DecidedAt(),
+		Overdue:          c.IsOverdue(now),
+	}
+}
+
+// toDuplicateMatchesResponse maps a fingerprint's matches to a DTO.
+func toDuplicateMatchesResponse(f model.ApplicantFingerprint) dto.DuplicateMatchesResponse {
+	matches := make([]dto.DuplicateMatchResponse, 0, len(f.Matches()))
+	for _, m := range f.Matches() {
+		matches = append(matches, dto.DuplicateMatchResponse{
+			VerificationID: m.VerificationID,
+			Reasons:        m.Reasons,
+			Score:          m.Score,
+		})
+	}
+
+	return dto.DuplicateMatchesResponse{
+		VerificationID: f.VerificationID(),
+		ApplicantID:    f.ApplicantID(),
+		Matches:        matches,
 	}
 }
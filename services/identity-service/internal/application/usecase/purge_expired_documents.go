@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+)
+
+// purgeBatchSize bounds how many documents one purge run deletes.
+const purgeBatchSize = 100
+
+// PurgeExpiredDocuments deletes document content whose retention period has
+// ended. Metadata is kept, marked purged, so audits can show what was held
+// and when it was destroyed.
+type PurgeExpiredDocuments struct {
+	documents port.DocumentRepository
+	store     port.DocumentStore
+	publisher port.EventPublisher
+}
+
+func NewPurgeExpiredDocuments(documents port.DocumentRepository, store port.DocumentStore, publisher port.EventPublisher) *PurgeExpiredDocuments {
+	return &PurgeExpiredDocuments{documents: documents, store: store, publisher: publisher}
+}
+
+// Execute purges up to one batch of expired documents and returns how many
+// were purged.
+func (uc *PurgeExpiredDocuments) Execute(ctx context.Context, now time.Time) (int, error) {
+	expired, err := uc.documents.ListExpired(ctx, now, purgeBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired documents: %w", err)
+	}
+
+	purged := 0
+	for _, d := range expired {
+		if err := uc.store.Delete(ctx, d.StorageKey()); err != nil {
+			return purged, fmt.Errorf("failed to delete document %s: %w", d.ID(), err)
+		}
+		d, err = d.MarkPurged(now)
+		if err != nil {
+			return purged, err
+		}
+		if err := uc.documents.Save(ctx, d); err != nil {
+			return purged, fmt.Errorf("failed to save document %s: %w", d.ID(), err)
+		}
+		if err := uc.publisher.Publish(ctx, TopicIdentityVerifications, d.DomainEvents()...); err != nil {
+			return purged, fmt.Errorf("failed to publish events: %w", err)
+		}
+		purged++
+	}
+	return purged, nil
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// refreshBatchSize bounds how many verifications one KYC refresh run handles.
+const refreshBatchSize = 100
+
+// RefreshKYC is the periodic KYC refresh job. Approved verifications whose
+// validity ends within the notice period are flagged as expiring once, so the
+// applicant can be re-verified in time; those past their expiry are expired.
+// Account-service restricts accounts on the resulting expired event.
+type RefreshKYC struct {
+	repo      port.VerificationRepository
+	publisher port.EventPublisher
+	notice    time.Duration
+}
+
+func NewRefreshKYC(repo port.VerificationRepository, publisher port.EventPublisher, notice time.Duration) *RefreshKYC {
+	return &RefreshKYC{repo: repo, publisher: publisher, notice: notice}
+}
+
+// Execute processes up to one batch of due verifications. A failure on one
+// verification does not stop the batch; failures are returned together.
+func (uc *RefreshKYC) Execute(ctx context.Context, now time.Time) (dto.RefreshKYCResult, error) {
+	due, err := uc.repo.ListDueForRefresh(ctx, now, now.Add(uc.notice), refreshBatchSize)
+	if err != nil {
+		return dto.RefreshKYCResult{}, fmt.Errorf("failed to list verifications due for refresh: %w", err)
+	}
+
+	var (
+		result dto.RefreshKYCResult
+		errs   []error
+	)
+	for _, v := range due {
+		expired := !now.Before(*v.ExpiresAt())
+		if err := uc.refreshOne(ctx, v, expired, now); err != nil {
+			errs = append(errs, fmt.Errorf("verification %s: %w", v.ID(), err))
+			continue
+		}
+		if expired {
+			result.Expired++
+		} else {
+			result.Flagged++
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+func (uc *RefreshKYC) refreshOne(ctx context.Context, v model.IdentityVerification, expired bool, now time.Time) error {
+	var err error
+	if expired {
+		v, err = v.Expire(now)
+	} else {
+		v, err = v.FlagExpiring(now)
+	}
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.Save(ctx, v); err != nil {
+		return fmt.Errorf("failed to save verification: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, TopicIdentityVerifications, v.DomainEvents()...); err != nil {
+		return fmt.Errorf("failed to publish events: %w", err)
+	}
+	return nil
+}
+
+// ReverifyVerification starts a fresh verification for the applicant of an
+// approved, expiring or expired verification. The new verification keeps the
+// applicant's details, risk tier and screening checks and links back to the
+// one it replaces, so that its approval lifts KYC restrictions downstream.
+type ReverifyVerification struct {
+	repo     port.VerificationRepository
+	initiate *InitiateVerification
+}
+
+func NewReverifyVerification(repo port.VerificationRepository, initiate *InitiateVerification) *ReverifyVerification {
+	return &ReverifyVerification{repo: repo, initiate: initiate}
+}
+
+func (uc *ReverifyVerification) Execute(ctx context.Context, req dto.ReverifyVerificationRequest) (dto.VerificationResponse, error) {
+	previous, err := uc.repo.FindByID(ctx, req.VerificationID)
+	if err != nil || previous.TenantID() != req.TenantID {
+		return dto.VerificationResponse{}, fmt.Errorf("%w: verification %s", ErrNotFound, req.VerificationID)
+	}
+	if !reverifiable(previous.Status()) {
+		return dto.VerificationResponse{}, fmt.Errorf("%w: verification %s is %s and cannot be re-verified",
+			ErrInvalidInput, req.VerificationID, previous.Status().String())
+	}
+	if previous.IsErased() {
+		return dto.VerificationResponse{}, fmt.Errorf("%w: verification %s has been erased and cannot be re-verified",
+			ErrInvalidInput, req.VerificationID)
+	}
+
+	var screeningChecks []string
+	for _, c := range previous.Checks() {
+		if c.CheckType().IsScreening() {
+			screeningChecks = append(screeningChecks, c.CheckType().String())
+		}
+	}
+
+	return uc.initiate.Execute(ctx, dto.InitiateVerificationRequest{
+		TenantID:               previous.TenantID(),
+		FirstName:              previous.ApplicantFirstName(),
+		LastName:               previous.ApplicantLastName(),
+		Email:                  previous.ApplicantEmail(),
+		DateOfBirth:            previous.ApplicantDOB(),
+		Country:                previous.ApplicantCountry(),
+		RiskTier:               previous.RiskTier().String(),
+		ScreeningChecks:        screeningChecks,
+		PreviousVerificationID: previous.ID(),
+	})
+}
+
+// reverifiable reports whether a verification in the given status can be
+// replaced by a re-verification.
+func reverifiable(s valueobject.VerificationStatus) bool {
+	return s.Equal(valueobject.StatusApproved) || s.Equal(valueobject.StatusReview) || s.Equal(valueobject.StatusExpired)
+}
@@ -0,0 +1,161 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+func TestRefreshKYC_FlagsExpiringAndExpiresLapsed(t *testing.T) {
+	expiring := approvedScreenedVerification(t)
+	lapsed := approvedScreenedVerification(t)
+	now := lapsed.ExpiresAt().Add(time.Hour)
+	expiringSoon := model.Reconstruct(expiring.ID(), expiring.TenantID(), "John", "Doe", "john@example.com", "1990-01-15", "US",
+		expiring.Status(), expiring.Checks(), expiring.RiskTier(), expiring.RiskScore(), expiring.RiskFactors(), expiring.Validity(), timePtr(now.Add(10*24*time.Hour)), nil, nil,
+		uuid.Nil, expiring.Version(), expiring.CreatedAt(), expiring.UpdatedAt())
+
+	var gotNow, gotNoticeBefore time.Time
+	repo := &mockVerificationRepository{
+		listDueFunc: func(_ context.Context, now, noticeBefore time.Time, _ int) ([]model.IdentityVerification, error) {
+			gotNow, gotNoticeBefore = now, noticeBefore
+			return []model.IdentityVerification{lapsed, expiringSoon}, nil
+		},
+	}
+	publisher := &mockEventPublisher{}
+	uc := usecase.NewRefreshKYC(repo, publisher, 30*24*time.Hour)
+
+	result, err := uc.Execute(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, dto.RefreshKYCResult{Flagged: 1, Expired: 1}, result)
+	assert.Equal(t, now, gotNow)
+	assert.Equal(t, now.Add(30*24*time.Hour), gotNoticeBefore)
+
+	require.Len(t, repo.savedVerifications, 2)
+	assert.True(t, repo.savedVerifications[0].Status().Equal(valueobject.StatusExpired))
+	assert.True(t, repo.savedVerifications[1].Status().Equal(valueobject.StatusApproved))
+	assert.NotNil(t, repo.savedVerifications[1].ExpiryNotifiedAt())
+
+	require.Len(t, publisher.publishedEvents, 2)
+	assert.Equal(t, "identity.verification.expired", publisher.publishedEvents[0].EventType())
+	assert.Equal(t, "identity.verification.expiring", publisher.publishedEvents[1].EventType())
+}
+
+func TestRefreshKYC_ContinuesPastFailures(t *testing.T) {
+	first := approvedScreenedVerification(t)
+	second := approvedScreenedVerification(t)
+	now := second.ExpiresAt().Add(time.Hour)
+
+	repo := &mockVerificationRepository{
+		listDueFunc: func(_ context.Context, _, _ time.Time, _ int) ([]model.IdentityVerification, error) {
+			return []model.IdentityVerification{first, second}, nil
+		},
+	}
+	saved := 0
+	repo.saveFunc = func(_ context.Context, v model.IdentityVerification) error {
+		if v.ID() == first.ID() {
+			return errors.New("db down")
+		}
+		saved++
+		return nil
+	}
+	uc := usecase.NewRefreshKYC(repo, &mockEventPublisher{}, 30*24*time.Hour)
+
+	result, err := uc.Execute(context.Background(), now)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), first.ID().String())
+	assert.Equal(t, 1, result.Expired)
+	assert.Equal(t, 1, saved)
+}
+
+func TestReverifyVerification_StartsLinkedVerification(t *testing.T) {
+	previous := approvedScreenedVerification(t)
+	repo := &mockVerificationRepository{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) { return previous, nil },
+	}
+	publisher := &mockEventPublisher{}
+	screener := usecase.NewScreenVerification(repo, &mockScreeningResultRepository{}, &mockScreeningProvider{}, publisher)
+	initiate := usecase.NewInitiateVerification(repo, &mockVerificationProvider{}, publisher, screener, nil, nil)
+	uc := usecase.NewReverifyVerification(repo, initiate)
+
+	resp, err := uc.Execute(context.Background(), dto.ReverifyVerificationRequest{
+		TenantID:       previous.TenantID(),
+		VerificationID: previous.ID(),
+	})
+	require.NoError(t, err)
+
+	assert.NotEqual(t, previous.ID(), resp.ID)
+	assert.Equal(t, previous.ID(), resp.PreviousVerificationID)
+	assert.Equal(t, previous.RiskTier().String(), resp.RiskTier)
+	assert.Equal(t, "IN_PROGRESS", resp.Status)
+	require.Len(t, resp.Checks, 4)
+	assert.Equal(t, "SANCTIONS", resp.Checks[3].CheckType)
+}
+
+func TestReverifyVerification_OtherTenant_NotFound(t *testing.T) {
+	previous := approvedScreenedVerification(t)
+	repo := &mockVerificationRepository{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) { return previous, nil },
+	}
+	initiate := usecase.NewInitiateVerification(repo, &mockVerificationProvider{}, &mockEventPublisher{}, nil, nil, nil)
+	uc := usecase.NewReverifyVerification(repo, initiate)
+
+	_, err := uc.Execute(context.Background(), dto.ReverifyVerificationRequest{
+		TenantID:       uuid.New(),
+		VerificationID: previous.ID(),
+	})
+	require.ErrorIs(t, err, usecase.ErrNotFound)
+	assert.Empty(t, repo.savedVerifications)
+}
+
+func TestReverifyVerification_InProgress_InvalidInput(t *testing.T) {
+	previous := inProgressVerification()
+	repo := &mockVerificationRepository{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) { return previous, nil },
+	}
+	initiate := usecase.NewInitiateVerification(repo, &mockVerificationProvider{}, &mockEventPublisher{}, nil, nil, nil)
+	uc := usecase.NewReverifyVerification(repo, initiate)
+
+	_, err := uc.Execute(context.Background(), dto.ReverifyVerificationRequest{
+		TenantID:       previous.TenantID(),
+		VerificationID: previous.ID(),
+	})
+	require.ErrorIs(t, err, usecase.ErrInvalidInput)
+}
+
+func TestInitiateVerification_RiskTierSetsValidity(t *testing.T) {
+	repo := &mockVerificationRepository{}
+	validity := map[valueobject.RiskTier]time.Duration{valueobject.RiskTierHigh: 180 * 24 * time.Hour}
+	uc := usecase.NewInitiateVerification(repo, &mockVerificationProvider{}, &mockEventPublisher{}, nil, nil, validity)
+
+	req := validInitiateRequest()
+	req.RiskTier = "HIGH"
+	resp, err := uc.Execute(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, "HIGH", resp.RiskTier)
+	require.Len(t, repo.savedVerifications, 1)
+	assert.Equal(t, 180*24*time.Hour, repo.savedVerifications[0].Validity())
+}
+
+func TestInitiateVerification_UnknownRiskTier_InvalidInput(t *testing.T) {
+	repo := &mockVerificationRepository{}
+	uc := usecase.NewInitiateVerification(repo, &mockVerificationProvider{}, &mockEventPublisher{}, nil, nil, nil)
+
+	req := validInitiateRequest()
+	req.RiskTier = "EXTREME"
+	_, err := uc.Execute(context.Background(), req)
+	require.ErrorIs(t, err, usecase.ErrInvalidInput)
+	assert.Empty(t, repo.savedVerifications)
+}
+
+func timePtr(t time.Time) *time.Time { return &t }
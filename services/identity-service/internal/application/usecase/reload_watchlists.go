@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/service"
+)
+
+// ReloadWatchlists refreshes the in-memory watchlist screener from the
+// configured list sources. It runs on a timer so OFAC and EU publications
+// are picked up without a restart.
+type ReloadWatchlists struct {
+	screener *service.WatchlistScreener
+	sources  []port.WatchlistSource
+}
+
+// NewReloadWatchlists creates a new ReloadWatchlists use case.
+func NewReloadWatchlists(screener *service.WatchlistScreener, sources ...port.WatchlistSource) *ReloadWatchlists {
+	return &ReloadWatchlists{screener: screener, sources: sources}
+}
+
+// Execute fetches every list and swaps them into the screener together. It
+// returns the number of entries loaded. If any list fails, the previously
+// loaded lists stay in use, so a screening never runs against a subset.
+// The loaded version names each list's publication, e.g.
+// "OFAC_SDN:3f2a...;EU_CONSOLIDATED:9c1b...", and becomes the reference of
+// every screening run against it.
+func (uc *ReloadWatchlists) Execute(ctx context.Context) (int, error) {
+	var entries []model.WatchlistEntry
+	versions := make([]string, 0, len(uc.sources))
+	for _, src := range uc.sources {
+		listEntries, version, err := src.Fetch(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to fetch %s watchlist: %w", src.List(), err)
+		}
+		if len(listEntries) == 0 {
+			return 0, fmt.Errorf("%s watchlist source returned no entries", src.List())
+		}
+		entries = append(entries, listEntries...)
+		versions = append(versions, src.List()+":"+version)
+	}
+	if len(entries) == 0 {
+		return 0, fmt.Errorf("no watchlist sources configured")
+	}
+	uc.screener.Load(entries, strings.Join(versions, ";"))
+	return len(entries), nil
+}
@@ -0,0 +1,59 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/service"
+)
+
+type mockWatchlistSource struct {
+	err     error
+	list    string
+	version string
+	entries []model.WatchlistEntry
+}
+
+func (m *mockWatchlistSource) List() string { return m.list }
+
+func (m *mockWatchlistSource) Fetch(_ context.Context) ([]model.WatchlistEntry, string, error) {
+	return m.entries, m.version, m.err
+}
+
+func TestReloadWatchlists_LoadsAllLists(t *testing.T) {
+	screener := service.NewWatchlistScreener(0)
+	ofac := &mockWatchlistSource{list: model.WatchlistOFACSDN, version: "a1", entries: []model.WatchlistEntry{
+		{List: model.WatchlistOFACSDN, EntryID: "1", Type: model.WatchlistEntryIndividual, Name: "Ivan Petrov"},
+	}}
+	eu := &mockWatchlistSource{list: model.WatchlistEUConsolidated, version: "b2", entries: []model.WatchlistEntry{
+		{List: model.WatchlistEUConsolidated, EntryID: "13", Type: model.WatchlistEntryIndividual, Name: "Saddam Hussein"},
+	}}
+
+	n, err := usecase.NewReloadWatchlists(screener, ofac, eu).Execute(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, 2, screener.Size())
+	assert.Equal(t, "OFAC_SDN:a1;EU_CONSOLIDATED:b2", screener.Version())
+}
+
+func TestReloadWatchlists_FailureKeepsPreviousLists(t *testing.T) {
+	screener := service.NewWatchlistScreener(0)
+	screener.Load([]model.WatchlistEntry{
+		{List: model.WatchlistOFACSDN, EntryID: "1", Type: model.WatchlistEntryIndividual, Name: "Ivan Petrov"},
+	}, "old")
+	ofac := &mockWatchlistSource{list: model.WatchlistOFACSDN, version: "a1", entries: []model.WatchlistEntry{
+		{List: model.WatchlistOFACSDN, EntryID: "2", Type: model.WatchlistEntryIndividual, Name: "Olga Ivanova"},
+	}}
+	eu := &mockWatchlistSource{list: model.WatchlistEUConsolidated, err: errors.New("download failed")}
+
+	_, err := usecase.NewReloadWatchlists(screener, ofac, eu).Execute(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "EU_CONSOLIDATED")
+	assert.Equal(t, "old", screener.Version())
+}
@@ -0,0 +1,353 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+const verificationFlaggedForReview = "identity.verification.flagged_for_review"
+
+// OpenReviewCase consumes VerificationFlaggedForReview events and queues the
+// verification for an analyst. Handling is idempotent: a verification with an
+// undecided case keeps it, so redelivered events and further flagged checks
+// do not open duplicates.
+type OpenReviewCase struct {
+	repo      port.VerificationRepository
+	cases     port.ReviewCaseRepository
+	publisher port.EventPublisher
+	sla       time.Duration
+	fourEyes  bool
+}
+
+func NewOpenReviewCase(
+	repo port.VerificationRepository,
+	cases port.ReviewCaseRepository,
+	publisher port.EventPublisher,
+	sla time.Duration,
+	fourEyes bool,
+) *OpenReviewCase {
+	return &OpenReviewCase{
+		repo:      repo,
+		cases:     cases,
+		publisher: publisher,
+		sla:       sla,
+		fourEyes:  fourEyes,
+	}
+}
+
+// flaggedForReviewEvent holds the VerificationFlaggedForReview fields needed to open a case.
+type flaggedForReviewEvent struct {
+	Reason         string    `json:"reason"`
+	VerificationID uuid.UUID `json:"verification_id"`
+}
+
+// Execute applies an identity event of the given type; other event types are ignored.
+func (uc *OpenReviewCase) Execute(ctx context.Context, eventType string, payload []byte) error {
+	if eventType != verificationFlaggedForReview {
+		return nil
+	}
+	var evt flaggedForReviewEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+
+	if _, err := uc.cases.FindActiveByVerification(ctx, evt.VerificationID); err == nil {
+		return nil
+	} else if !errors.Is(err, port.ErrReviewCaseNotFound) {
+		return fmt.Errorf("failed to find review case: %w", err)
+	}
+
+	verification, err := uc.repo.FindByID(ctx, evt.VerificationID)
+	if err != nil {
+		return fmt.Errorf("failed to find verification: %w", err)
+	}
+	// The verification may have been decided before the event was consumed.
+	if !verification.Status().Equal(valueobject.StatusReview) {
+		return nil
+	}
+
+	c, err := model.NewReviewCase(verification.TenantID(), verification.ID(), evt.Reason, uc.fourEyes, uc.sla, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to open review case: %w", err)
+	}
+	return saveReviewCase(ctx, uc.cases, uc.publisher, c)
+}
+
+// ListReviewQueue lists a tenant's review cases, soonest due first.
+type ListReviewQueue struct {
+	cases port.ReviewCaseRepository
+}
+
+func NewListReviewQueue(cases port.ReviewCaseRepository) *ListReviewQueue {
+	return &ListReviewQueue{cases: cases}
+}
+
+func (uc *ListReviewQueue) Execute(ctx context.Context, req dto.ListReviewQueueRequest) (dto.ListReviewQueueResponse, error) {
+	if req.Status != "" && !validReviewStatuses[req.Status] {
+		return dto.ListReviewQueueResponse{}, fmt.Errorf("%w: unknown review status %q", ErrInvalidInput, req.Status)
+	}
+
+	cases, total, err := uc.cases.ListQueue(ctx, req.TenantID, port.ReviewQueueFilter{
+		Status:     req.Status,
+		AssigneeID: req.AssigneeID,
+	}, req.PageSize, req.Offset)
+	if err != nil {
+		return dto.ListReviewQueueResponse{}, fmt.Errorf("failed to list review cases: %w", err)
+	}
+
+	now := time.Now().UTC()
+	responses := make([]dto.ReviewCaseResponse, 0, len(cases))
+	for _, c := range cases {
+		responses = append(responses, toReviewCaseResponse(c, now))
+	}
+	return dto.ListReviewQueueResponse{Cases: responses, TotalCount: total}, nil
+}
+
+var validReviewStatuses = map[string]bool{
+	model.ReviewStatusOpen:                   true,
+	model.ReviewStatusClaimed:                true,
+	model.ReviewStatusAwaitingSecondApproval: true,
+	model.ReviewStatusDecided:                true,
+}
+
+// GetReviewCase retrieves a single review case.
+type GetReviewCase struct {
+	cases port.ReviewCaseRepository
+}
+
+func NewGetReviewCase(cases port.ReviewCaseRepository) *GetReviewCase {
+	return &GetReviewCase{cases: cases}
+}
+
+func (uc *GetReviewCase) Execute(ctx context.Context, req dto.GetReviewCaseRequest) (dto.ReviewCaseResponse, error) {
+	c, err := findReviewCase(ctx, uc.cases, req.TenantID, req.CaseID)
+	if err != nil {
+		return dto.ReviewCaseResponse{}, err
+	}
+	return toReviewCaseResponse(c, time.Now().UTC()), nil
+}
+
+// ClaimReviewCase lets an analyst take an unassigned case from the queue.
+type ClaimReviewCase struct {
+	cases     port.ReviewCaseRepository
+	publisher port.EventPublisher
+}
+
+func NewClaimReviewCase(cases port.ReviewCaseRepository, publisher port.EventPublisher) *ClaimReviewCase {
+	return &ClaimReviewCase{cases: cases, publisher: publisher}
+}
+
+func (uc *ClaimReviewCase) Execute(ctx context.Context, req dto.ClaimReviewCaseRequest) (dto.ReviewCaseResponse, error) {
+	c, err := findReviewCase(ctx, uc.cases, req.TenantID, req.CaseID)
+	if err != nil {
+		return dto.ReviewCaseResponse{}, err
+	}
+
+	now := time.Now().UTC()
+	c, err = c.Claim(req.AnalystID, now)
+	if err != nil {
+		return dto.ReviewCaseResponse{}, fmt.Errorf("%w: %w", ErrInvalidState, err)
+	}
+	if err := saveReviewCase(ctx, uc.cases, uc.publisher, c); err != nil {
+		return dto.ReviewCaseResponse{}, err
+	}
+	return toReviewCaseResponse(c, now), nil
+}
+
+// AssignReviewCase lets a supervisor hand a case to an analyst.
+type AssignReviewCase struct {
+	cases     port.ReviewCaseRepository
+	publisher port.EventPublisher
+}
+
+func NewAssignReviewCase(cases port.ReviewCaseRepository, publisher port.EventPublisher) *AssignReviewCase {
+	return &AssignReviewCase{cases: cases, publisher: publisher}
+}
+
+func (uc *AssignReviewCase) Execute(ctx context.Context, req dto.AssignReviewCaseRequest) (dto.ReviewCaseResponse, error) {
+	if req.AssigneeID == uuid.Nil {
+		return dto.ReviewCaseResponse{}, fmt.Errorf("%w: assignee is required", ErrInvalidInput)
+	}
+	c, err := findReviewCase(ctx, uc.cases, req.TenantID, req.CaseID)
+	if err != nil {
+		return dto.ReviewCaseResponse{}, err
+	}
+
+	now := time.Now().UTC()
+	c, err = c.Assign(req.AssigneeID, req.AssignedBy, now)
+	if err != nil {
+		return dto.ReviewCaseResponse{}, fmt.Errorf("%w: %w", ErrInvalidState, err)
+	}
+	if err := saveReviewCase(ctx, uc.cases, uc.publisher, c); err != nil {
+		return dto.ReviewCaseResponse{}, err
+	}
+	return toReviewCaseResponse(c, now), nil
+}
+
+// DecideReviewCase records an analyst's decision. Once the case is decided,
+// the outcome resolves the verification's checks under review: approval
+// clears them and rejection rejects the verification. A verification that
+// has left REVIEW in the meantime, e.g. because it expired, is left unchanged.
+type DecideReviewCase struct {
+	repo      port.VerificationRepository
+	cases     port.ReviewCaseRepository
+	publisher port.EventPublisher
+}
+
+func NewDecideReviewCase(
+	repo port.VerificationRepository,
+	cases port.ReviewCaseRepository,
+	publisher port.EventPublisher,
+) *DecideReviewCase {
+	return &DecideReviewCase{
+		repo:      repo,
+		cases:     cases,
+		publisher: publisher,
+	}
+}
+
+func (uc *DecideReviewCase) Execute(ctx context.Context, req dto.DecideReviewCaseRequest) (dto.ReviewCaseResponse, error) {
+	if req.Outcome != model.ReviewOutcomeApprove && req.Outcome != model.ReviewOutcomeReject {
+		return dto.ReviewCaseResponse{}, fmt.Errorf("%w: outcome must be APPROVE or REJECT", ErrInvalidInput)
+	}
+	if req.Reason == "" {
+		return dto.ReviewCaseResponse{}, fmt.Errorf("%w: reason is required", ErrInvalidInput)
+	}
+	c, err := findReviewCase(ctx, uc.cases, req.TenantID, req.CaseID)
+	if err != nil {
+		return dto.ReviewCaseResponse{}, err
+	}
+
+	now := time.Now().UTC()
+	c, err = c.RecordDecision(req.AnalystID, req.Outcome, req.Reason, now)
+	if err != nil {
+		return dto.ReviewCaseResponse{}, fmt.Errorf("%w: %w", ErrInvalidState, err)
+	}
+
+	if c.Status() == model.ReviewStatusDecided {
+		if err := uc.resolveVerification(ctx, c, now); err != nil {
+			return dto.ReviewCaseResponse{}, err
+		}
+	}
+	if err := saveReviewCase(ctx, uc.cases, uc.publisher, c); err != nil {
+		return dto.ReviewCaseResponse{}, err
+	}
+	return toReviewCaseResponse(c, now), nil
+}
+
+// resolveVerification completes the verification's checks under review with
+// the case outcome.
+func (uc *DecideReviewCase) resolveVerification(ctx context.Context, c model.ReviewCase, now time.Time) error {
+	verification, err := uc.repo.FindByID(ctx, c.VerificationID())
+	if err != nil {
+		return fmt.Errorf("failed to find verification: %w", err)
+	}
+	if !verification.Status().Equal(valueobject.StatusReview) {
+		return nil
+	}
+
+	status, failureReason := valueobject.StatusApproved, ""
+	if c.Outcome() == model.ReviewOutcomeReject {
+		decisions := c.Decisions()
+		status, failureReason = valueobject.StatusRejected, decisions[len(decisions)-1].Reason
+	}
+	for _, check := range verification.Checks() {
+		if !check.Status().Equal(valueobject.StatusReview) {
+			continue
+		}
+		verification, err = verification.CompleteCheck(check.ID(), status, failureReason, now)
+		if err != nil {
+			return fmt.Errorf("failed to resolve %s check: %w", check.CheckType().String(), err)
+		}
+		if verification.Status().IsTerminal() {
+			break
+		}
+	}
+
+	if err := uc.repo.Save(ctx, verification); err != nil {
+		return fmt.Errorf("failed to save verification: %w", err)
+	}
+	if events := verification.DomainEvents(); len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, TopicIdentityVerifications, events...); err != nil {
+			return fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+	return nil
+}
+
+// defaultReviewMetricsWindow is how far back decision metrics look by default.
+const defaultReviewMetricsWindow = 30 * 24 * time.Hour
+
+// GetReviewMetrics reports a tenant's review queue against its SLA.
+type GetReviewMetrics struct {
+	cases port.ReviewCaseRepository
+}
+
+func NewGetReviewMetrics(cases port.ReviewCaseRepository) *GetReviewMetrics {
+	return &GetReviewMetrics{cases: cases}
+}
+
+func (uc *GetReviewMetrics) Execute(ctx context.Context, req dto.GetReviewMetricsRequest) (dto.ReviewMetricsResponse, error) {
+	now := time.Now().UTC()
+	since := req.Since
+	if since.IsZero() {
+		since = now.Add(-defaultReviewMetricsWindow)
+	}
+	if since.After(now) {
+		return dto.ReviewMetricsResponse{}, fmt.Errorf("%w: since must not be in the future", ErrInvalidInput)
+	}
+
+	m, err := uc.cases.Metrics(ctx, req.TenantID, since, now)
+	if err != nil {
+		return dto.ReviewMetricsResponse{}, fmt.Errorf("failed to compute review metrics: %w", err)
+	}
+
+	resp := dto.ReviewMetricsResponse{
+		Since:                  since,
+		Open:                   m.Open,
+		AwaitingSecondApproval: m.AwaitingSecond,
+		Overdue:                m.Overdue,
+		Decided:                m.Decided,
+		DecidedWithinSLA:       m.DecidedWithinSLA,
+		AvgTimeToDecision:      m.AvgTimeToDecision,
+		P95TimeToDecision:      m.P95TimeToDecision,
+	}
+	if m.Decided > 0 {
+		resp.WithinSLARate = float64(m.DecidedWithinSLA) / float64(m.Decided)
+	}
+	return resp, nil
+}
+
+func findReviewCase(ctx context.Context, cases port.ReviewCaseRepository, tenantID, id uuid.UUID) (model.ReviewCase, error) {
+	c, err := cases.FindByID(ctx, tenantID, id)
+	if err != nil {
+		if errors.Is(err, port.ErrReviewCaseNotFound) {
+			return model.ReviewCase{}, fmt.Errorf("%w: review case %s", ErrNotFound, id)
+		}
+		return model.ReviewCase{}, fmt.Errorf("failed to find review case: %w", err)
+	}
+	return c, nil
+}
+
+// saveReviewCase persists a review case and publishes its events.
+func saveReviewCase(ctx context.Context, cases port.ReviewCaseRepository, publisher port.EventPublisher, c model.ReviewCase) error {
+	if err := cases.Save(ctx, c); err != nil {
+		return fmt.Errorf("failed to save review case: %w", err)
+	}
+	if events := c.DomainEvents(); len(events) > 0 {
+		if err := publisher.Publish(ctx, TopicIdentityVerifications, events...); err != nil {
+			return fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// mockReviewCaseRepository keeps review cases in memory.
+type mockReviewCaseRepository struct {
+	cases   map[uuid.UUID]model.ReviewCase
+	metrics port.ReviewMetrics
+}
+
+func newMockReviewCaseRepository(cases ...model.ReviewCase) *mockReviewCaseRepository {
+	m := &mockReviewCaseRepository{cases: make(map[uuid.UUID]model.ReviewCase)}
+	for _, c := range cases {
+		m.cases[c.ID()] = c
+	}
+	return m
+}
+
+func (m *mockReviewCaseRepository) Save(_ context.Context, c model.ReviewCase) error {
+	m.cases[c.ID()] = c
+	return nil
+}
+
+func (m *mockReviewCaseRepository) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.ReviewCase, error) {
+	c, ok := m.cases[id]
+	if !ok || c.TenantID() != tenantID {
+		return model.ReviewCase{}, fmt.Errorf("%w: %s", port.ErrReviewCaseNotFound, id)
+	}
+	return c, nil
+}
+
+func (m *mockReviewCaseRepository) FindActiveByVerification(_ context.Context, verificationID uuid.UUID) (model.ReviewCase, error) {
+	for _, c := range m.cases {
+		if c.VerificationID() == verificationID && c.Status() != model.ReviewStatusDecided {
+			return c, nil
+		}
+	}
+	return model.ReviewCase{}, port.ErrReviewCaseNotFound
+}
+
+func (m *mockReviewCaseRepository) ListQueue(_ context.Context, tenantID uuid.UUID, filter port.ReviewQueueFilter, _, _ int) ([]model.ReviewCase, int, error) {
+	var out []model.ReviewCase
+	for _, c := range m.cases {
+		if c.TenantID() == tenantID && (filter.Status == "" || c.Status() == filter.Status) {
+			out = append(out, c)
+		}
+	}
+	return out, len(out), nil
+}
+
+func (m *mockReviewCaseRepository) Metrics(_ context.Context, _ uuid.UUID, _, _ time.Time) (port.ReviewMetrics, error) {
+	return m.metrics, nil
+}
+
+// verificationInReview returns a verification whose document check awaits review.
+func verificationInReview(t *testing.T) model.IdentityVerification {
+	t.Helper()
+	v := inProgressVerification()
+	now := time.Now().UTC()
+	checks := v.Checks()
+	v, err := v.ReviewCheck(checks[0].ID(), "onfido_result_consider", now)
+	require.NoError(t, err)
+	for _, c := range checks[1:] {
+		v, err = v.CompleteCheck(c.ID(), valueobject.StatusApproved, "", now)
+		require.NoError(t, err)
+	}
+	require.True(t, v.Status().Equal(valueobject.StatusReview))
+	return v
+}
+
+func flaggedPayload(v model.IdentityVerification) []byte {
+	return []byte(fmt.Sprintf(`{"verification_id":%q,"reason":"onfido_result_consider"}`, v.ID()))
+}
+
+func TestOpenReviewCase_OpensOneCasePerVerification(t *testing.T) {
+	v := verificationInReview(t)
+	repo := &mockVerificationRepository{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) { return v, nil },
+	}
+	cases := newMockReviewCaseRepository()
+	publisher := &mockEventPublisher{}
+	uc := usecase.NewOpenReviewCase(repo, cases, publisher, 24*time.Hour, true)
+
+	require.NoError(t, uc.Execute(context.Background(), "identity.verification.flagged_for_review", flaggedPayload(v)))
+	require.NoError(t, uc.Execute(context.Background(), "identity.verification.flagged_for_review", flaggedPayload(v)))
+
+	require.Len(t, cases.cases, 1)
+	for _, c := range cases.cases {
+		assert.Equal(t, v.TenantID(), c.TenantID())
+		assert.Equal(t, "onfido_result_consider", c.Reason())
+		assert.True(t, c.RequiresFourEyes())
+	}
+	require.Len(t, publisher.publishedEvents, 1)
+	assert.Equal(t, "identity.review.opened", publisher.publishedEvents[0].EventType())
+}
+
+func TestOpenReviewCase_IgnoresVerificationsNoLongerInReview(t *testing.T) {
+	v := inProgressVerification()
+	repo := &mockVerificationRepository{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) { return v, nil },
+	}
+	cases := newMockReviewCaseRepository()
+	uc := usecase.NewOpenReviewCase(repo, cases, &mockEventPublisher{}, 24*time.Hour, false)
+
+	require.NoError(t, uc.Execute(context.Background(), "identity.verification.flagged_for_review", flaggedPayload(v)))
+	require.NoError(t, uc.Execute(context.Background(), "identity.verification.completed", []byte("not json")))
+	assert.Empty(t, cases.cases)
+}
+
+func TestDecideReviewCase_FourEyesApprovalClearsVerification(t *testing.T) {
+	v := verificationInReview(t)
+	c, err := model.NewReviewCase(v.TenantID(), v.ID(), "onfido_result_consider", true, 24*time.Hour, time.Now().UTC())
+	require.NoError(t, err)
+	repo := &mockVerificationRepository{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) { return v, nil },
+	}
+	cases := newMockReviewCaseRepository(c)
+	publisher := &mockEventPublisher{}
+	claim := usecase.NewClaimReviewCase(cases, publisher)
+	decide := usecase.NewDecideReviewCase(repo, cases, publisher)
+	ctx := context.Background()
+	first, second := uuid.New(), uuid.New()
+
+	_, err = claim.Execute(ctx, dto.ClaimReviewCaseRequest{TenantID: v.TenantID(), CaseID: c.ID(), AnalystID: first})
+	require.NoError(t, err)
+	resp, err := decide.Execute(ctx, dto.DecideReviewCaseRequest{
+		TenantID: v.TenantID(), CaseID: c.ID(), AnalystID: first, Outcome: "APPROVE", Reason: "document genuine",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.ReviewStatusAwaitingSecondApproval, resp.Status)
+	assert.Empty(t, repo.savedVerifications)
+
+	_, err = claim.Execute(ctx, dto.ClaimReviewCaseRequest{TenantID: v.TenantID(), CaseID: c.ID(), AnalystID: first})
+	require.ErrorIs(t, err, usecase.ErrInvalidState)
+
+	_, err = claim.Execute(ctx, dto.ClaimReviewCaseRequest{TenantID: v.TenantID(), CaseID: c.ID(), AnalystID: second})
+	require.NoError(t, err)
+	resp, err = decide.Execute(ctx, dto.DecideReviewCaseRequest{
+		TenantID: v.TenantID(), CaseID: c.ID(), AnalystID: second, Outcome: "APPROVE", Reason: "agree",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.ReviewStatusDecided, resp.Status)
+	assert.Len(t, resp.Decisions, 2)
+
+	require.Len(t, repo.savedVerifications, 1)
+	assert.True(t, repo.savedVerifications[0].Status().Equal(valueobject.StatusApproved))
+	assert.NotNil(t, repo.savedVerifications[0].ExpiresAt())
+}
+
+func TestDecideReviewCase_RejectionRejectsVerification(t *testing.T) {
+	v := verificationInReview(t)
+	analyst := uuid.New()
+	c, err := model.NewReviewCase(v.TenantID(), v.ID(), "onfido_result_consider", false, 24*time.Hour, time.Now().UTC())
+	require.NoError(t, err)
+	c, err = c.Claim(analyst, time.Now().UTC())
+	require.NoError(t, err)
+	repo := &mockVerificationRepository{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) { return v, nil },
+	}
+	uc := usecase.NewDecideReviewCase(repo, newMockReviewCaseRepository(c), &mockEventPublisher{})
+
+	_, err = uc.Execute(context.Background(), dto.DecideReviewCaseRequest{
+		TenantID: v.TenantID(), CaseID: c.ID(), AnalystID: analyst, Outcome: "REJECT", Reason: "document altered",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, repo.savedVerifications, 1)
+	saved := repo.savedVerifications[0]
+	assert.True(t, saved.Status().Equal(valueobject.StatusRejected))
+	assert.Equal(t, "document altered", saved.Checks()[0].FailureReason())
+}
+
+func TestDecideReviewCase_Validation(t *testing.T) {
+	tenantID := uuid.New()
+	uc := usecase.NewDecideReviewCase(&mockVerificationRepository{}, newMockReviewCaseRepository(), &mockEventPublisher{})
+
+	_, err := uc.Execute(context.Background(), dto.DecideReviewCaseRequest{
+		TenantID: tenantID, CaseID: uuid.New(), AnalystID: uuid.New(), Outcome: "MAYBE", Reason: "unsure",
+	})
+	require.ErrorIs(t, err, usecase.ErrInvalidInput)
+
+	_, err = uc.Execute(context.Background(), dto.DecideReviewCaseRequest{
+		TenantID: tenantID, CaseID: uuid.New(), AnalystID: uuid.New(), Outcome: "APPROVE", Reason: "fine",
+	})
+	require.ErrorIs(t, err, usecase.ErrNotFound)
+}
+
+func TestGetReviewMetrics_ComputesWithinSLARate(t *testing.T) {
+	cases := newMockReviewCaseRepository()
+	cases.metrics = port.ReviewMetrics{Open: 3, Overdue: 1, Decided: 4, DecidedWithinSLA: 3, AvgTimeToDecision: 2 * time.Hour}
+	uc := usecase.NewGetReviewMetrics(cases)
+
+	resp, err := uc.Execute(context.Background(), dto.GetReviewMetricsRequest{TenantID: uuid.New()})
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.Open)
+	assert.Equal(t, 1, resp.Overdue)
+	assert.InDelta(t, 0.75, resp.WithinSLARate, 0.0001)
+	assert.Equal(t, 2*time.Hour, resp.AvgTimeToDecision)
+	assert.WithinDuration(t, time.Now().Add(-30*24*time.Hour), resp.Since, time.Minute)
+}
@@ -0,0 +1,275 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// ScreenVerification runs a verification's PEP, sanctions and adverse-media
+// checks against the screening provider. A clear result approves an
+// in-progress check; a hit moves the check to REVIEW, which reopens an
+// already approved verification. Execute re-screens on demand; the initial
+// screen and scheduled re-screens share the same logic.
+type ScreenVerification struct {
+	repo      port.VerificationRepository
+	results   port.ScreeningResultRepository
+	provider  port.ScreeningProvider
+	publisher port.EventPublisher
+}
+
+func NewScreenVerification(
+	repo port.VerificationRepository,
+	results port.ScreeningResultRepository,
+	provider port.ScreeningProvider,
+	publisher port.EventPublisher,
+) *ScreenVerification {
+	return &ScreenVerification{
+		repo:      repo,
+		results:   results,
+		provider:  provider,
+		publisher: publisher,
+	}
+}
+
+func (uc *ScreenVerification) Execute(ctx context.Context, req dto.RescreenVerificationRequest) (dto.VerificationResponse, error) {
+	verification, err := uc.repo.FindByID(ctx, req.VerificationID)
+	if err != nil || verification.TenantID() != req.TenantID {
+		return dto.VerificationResponse{}, fmt.Errorf("%w: verification %s", ErrNotFound, req.VerificationID)
+	}
+	if !screenable(verification) {
+		return dto.VerificationResponse{}, fmt.Errorf("%w: verification %s has no screening checks to re-screen", ErrInvalidInput, req.VerificationID)
+	}
+
+	verification, err = uc.rescreen(ctx, verification, time.Now().UTC())
+	if err != nil {
+		return dto.VerificationResponse{}, err
+	}
+	return toVerificationResponse(verification), nil
+}
+
+// rescreen screens an existing verification, then persists it, its results
+// and any resulting events.
+func (uc *ScreenVerification) rescreen(ctx context.Context, verification model.IdentityVerification, now time.Time) (model.IdentityVerification, error) {
+	verification, results, err := uc.screen(ctx, verification, model.ScreeningTriggerRescreen, now)
+	if err != nil {
+		return model.IdentityVerification{}, err
+	}
+	if err := uc.repo.Save(ctx, verification); err != nil {
+		return model.IdentityVerification{}, fmt.Errorf("failed to save verification: %w", err)
+	}
+	if err := uc.saveResults(ctx, results); err != nil {
+		return model.IdentityVerification{}, err
+	}
+	if events := verification.DomainEvents(); len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, TopicIdentityVerifications, events...); err != nil {
+			return model.IdentityVerification{}, fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+	return verification, nil
+}
+
+// screen runs every in-progress or approved screening check and applies the
+// outcomes to the verification. The results are returned rather than saved
+// because they reference the verification, which the caller persists first.
+func (uc *ScreenVerification) screen(
+	ctx context.Context,
+	verification model.IdentityVerification,
+	trigger string,
+	now time.Time,
+) (model.IdentityVerification, []model.ScreeningResult, error) {
+	applicant := port.ApplicantInfo{
+		FirstName:   verification.ApplicantFirstName(),
+		LastName:    verification.ApplicantLastName(),
+		Email:       verification.ApplicantEmail(),
+		DateOfBirth: verification.ApplicantDOB(),
+		Country:     verification.ApplicantCountry(),
+	}
+
+	var results []model.ScreeningResult
+	for _, check := range verification.Checks() {
+		if !screeningDue(check) {
+			continue
+		}
+
+		report, err := uc.provider.Screen(ctx, check.CheckType(), applicant)
+		if err != nil {
+			return model.IdentityVerification{}, nil, fmt.Errorf("failed to screen %s check: %w", check.CheckType().String(), err)
+		}
+		providerName := report.Provider
+		if providerName == "" {
+			providerName = uc.provider.Name()
+		}
+		result, err := model.NewScreeningResult(
+			verification.TenantID(), verification.ID(), check.ID(), check.CheckType(),
+			providerName, report.Reference, trigger, report.Matches, now,
+		)
+		if err != nil {
+			return model.IdentityVerification{}, nil, err
+		}
+		results = append(results, result)
+
+		verification, err = verification.UpdateCheckProvider(check.ID(), providerName, report.Reference)
+		if err != nil {
+			return model.IdentityVerification{}, nil, fmt.Errorf("failed to update check provider: %w", err)
+		}
+
+		switch {
+		case result.Outcome() == model.ScreeningOutcomeHit:
+			verification, err = verification.ReviewCheck(check.ID(), hitReason(result), now)
+			if err == nil {
+				verification, err = verification.RecordScreeningHit(result)
+			}
+		case check.Status().Equal(valueobject.StatusInProgress):
+			verification, err = verification.CompleteCheck(check.ID(), valueobject.StatusApproved, "", now)
+		}
+		if err != nil {
+			return model.IdentityVerification{}, nil, fmt.Errorf("failed to apply %s screening result: %w", check.CheckType().String(), err)
+		}
+	}
+	return verification, results, nil
+}
+
+func (uc *ScreenVerification) saveResults(ctx context.Context, results []model.ScreeningResult) error {
+	for _, r := range results {
+		if err := uc.results.Save(ctx, r); err != nil {
+			return fmt.Errorf("failed to save screening result: %w", err)
+		}
+	}
+	return nil
+}
+
+// screenable reports whether a verification has screening checks that can
+// be re-screened.
+func screenable(v model.IdentityVerification) bool {
+	if v.Status().IsTerminal() && !v.Status().Equal(valueobject.StatusApproved) {
+		return false
+	}
+	for _, c := range v.Checks() {
+		if screeningDue(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// screeningDue reports whether a check is a screening check awaiting a result
+// or an approved one subject to ongoing monitoring. Checks already under
+// review are left to the analyst.
+func screeningDue(c model.VerificationCheck) bool {
+	return c.CheckType().IsScreening() &&
+		(c.Status().Equal(valueobject.StatusInProgress) || c.Status().Equal(valueobject.StatusApproved))
+}
+
+// hitReason summarizes a screening hit for the check's review reason.
+func hitReason(r model.ScreeningResult) string {
+	names := make([]string, 0, len(r.Matches()))
+	for _, m := range r.Matches() {
+		names = append(names, m.Name)
+	}
+	return fmt.Sprintf("%s screening returned %d potential match(es): %s",
+		r.CheckType().String(), len(names), strings.Join(names, ", "))
+}
+
+// parseScreeningChecks validates requested screening check types.
+func parseScreeningChecks(requested []string) ([]valueobject.CheckType, error) {
+	checks := make([]valueobject.CheckType, 0, len(requested))
+	for _, s := range requested {
+		ct, err := valueobject.NewCheckType(s)
+		if err != nil || !ct.IsScreening() {
+			return nil, fmt.Errorf("%w: %q is not a screening check", ErrInvalidInput, s)
+		}
+		checks = append(checks, ct)
+	}
+	return checks, nil
+}
+
+// RescreenDueVerifications is the ongoing-monitoring job: it re-screens
+// approved verifications whose last screening is older than the interval.
+type RescreenDueVerifications struct {
+	repo     port.VerificationRepository
+	results  port.ScreeningResultRepository
+	screener *ScreenVerification
+	interval time.Duration
+}
+
+func NewRescreenDueVerifications(
+	repo port.VerificationRepository,
+	results port.ScreeningResultRepository,
+	screener *ScreenVerification,
+	interval time.Duration,
+) *RescreenDueVerifications {
+	return &RescreenDueVerifications{repo: repo, results: results, screener: screener, interval: interval}
+}
+
+// rescreenBatchSize bounds how many verifications one monitoring run re-screens.
+const rescreenBatchSize = 100
+
+// Execute re-screens up to one batch of due verifications and returns how
+// many were re-screened. A failure on one verification does not stop the
+// batch; failures are returned together.
+func (uc *RescreenDueVerifications) Execute(ctx context.Context, now time.Time) (int, error) {
+	due, err := uc.results.ListDueForRescreen(ctx, now.Add(-uc.interval), rescreenBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list verifications due for re-screening: %w", err)
+	}
+
+	var errs []error
+	rescreened := 0
+	for _, id := range due {
+		if err := uc.rescreenOne(ctx, id, now); err != nil {
+			errs = append(errs, fmt.Errorf("verification %s: %w", id, err))
+			continue
+		}
+		rescreened++
+	}
+	return rescreened, errors.Join(errs...)
+}
+
+func (uc *RescreenDueVerifications) rescreenOne(ctx context.Context, id uuid.UUID, now time.Time) error {
+	verification, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to find verification: %w", err)
+	}
+	if !screenable(verification) {
+		return nil
+	}
+	_, err = uc.screener.rescreen(ctx, verification, now)
+	return err
+}
+
+// GetScreeningResults lists the screening history of a verification.
+type GetScreeningResults struct {
+	repo    port.VerificationRepository
+	results port.ScreeningResultRepository
+}
+
+func NewGetScreeningResults(repo port.VerificationRepository, results port.ScreeningResultRepository) *GetScreeningResults {
+	return &GetScreeningResults{repo: repo, results: results}
+}
+
+func (uc *GetScreeningResults) Execute(ctx context.Context, req dto.GetScreeningResultsRequest) (dto.ScreeningResultsResponse, error) {
+	verification, err := uc.repo.FindByID(ctx, req.VerificationID)
+	if err != nil || verification.TenantID() != req.TenantID {
+		return dto.ScreeningResultsResponse{}, fmt.Errorf("%w: verification %s", ErrNotFound, req.VerificationID)
+	}
+
+	results, err := uc.results.ListByVerification(ctx, req.TenantID, req.VerificationID)
+	if err != nil {
+		return dto.ScreeningResultsResponse{}, fmt.Errorf("failed to list screening results: %w", err)
+	}
+	out := make([]dto.ScreeningResultDTO, 0, len(results))
+	for _, r := range results {
+		out = append(out, toScreeningResultDTO(r))
+	}
+	return dto.ScreeningResultsResponse{Results: out}, nil
+}
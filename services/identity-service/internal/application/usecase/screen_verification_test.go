@@ -0,0 +1,209 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// mockScreeningProvider implements port.ScreeningProvider for testing.
+type mockScreeningProvider struct {
+	matches  map[valueobject.CheckType][]model.ScreeningMatch
+	screened []valueobject.CheckType
+}
+
+func (m *mockScreeningProvider) Name() string { return "complyadvantage" }
+
+func (m *mockScreeningProvider) Screen(_ context.Context, checkType valueobject.CheckType, _ port.ApplicantInfo) (port.ScreeningReport, error) {
+	m.screened = append(m.screened, checkType)
+	return port.ScreeningReport{Reference: "search-" + checkType.String(), Matches: m.matches[checkType]}, nil
+}
+
+// mockScreeningResultRepository implements port.ScreeningResultRepository for testing.
+type mockScreeningResultRepository struct {
+	due   []uuid.UUID
+	saved []model.ScreeningResult
+}
+
+func (m *mockScreeningResultRepository) Save(_ context.Context, r model.ScreeningResult) error {
+	m.saved = append(m.saved, r)
+	return nil
+}
+
+func (m *mockScreeningResultRepository) ListByVerification(_ context.Context, _, verificationID uuid.UUID) ([]model.ScreeningResult, error) {
+	var out []model.ScreeningResult
+	for _, r := range m.saved {
+		if r.VerificationID() == verificationID {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockScreeningResultRepository) ListDueForRescreen(_ context.Context, _ time.Time, _ int) ([]uuid.UUID, error) {
+	return m.due, nil
+}
+
+func sanctionsHit() map[valueobject.CheckType][]model.ScreeningMatch {
+	return map[valueobject.CheckType][]model.ScreeningMatch{
+		valueobject.CheckTypeSanctions: {{Name: "John Doe", Score: 0.93, Categories: []string{"sanction"}}},
+	}
+}
+
+func checkOfType(t *testing.T, checks []dto.VerificationCheckDTO, checkType string) dto.VerificationCheckDTO {
+	t.Helper()
+	for _, c := range checks {
+		if c.CheckType == checkType {
+			return c
+		}
+	}
+	t.Fatalf("no %s check", checkType)
+	return dto.VerificationCheckDTO{}
+}
+
+func TestInitiateVerification_ScreeningChecks_Clear(t *testing.T) {
+	repo := &mockVerificationRepository{}
+	provider := &mockVerificationProvider{}
+	publisher := &mockEventPublisher{}
+	screening := &mockScreeningProvider{}
+	results := &mockScreeningResultRepository{}
+	screener := usecase.NewScreenVerification(repo, results, screening, publisher)
+	uc := usecase.NewInitiateVerification(repo, provider, publisher, screener, nil, nil)
+
+	req := validInitiateRequest()
+	req.ScreeningChecks = []string{"PEP", "SANCTIONS"}
+	resp, err := uc.Execute(context.Background(), req)
+	require.NoError(t, err)
+
+	// KYC provider runs only the default checks; screening goes to the screener.
+	assert.Len(t, provider.initiatedChecks, 3)
+	assert.Equal(t, []valueobject.CheckType{valueobject.CheckTypePEP, valueobject.CheckTypeSanctions}, screening.screened)
+
+	assert.Equal(t, "IN_PROGRESS", resp.Status)
+	pep := checkOfType(t, resp.Checks, "PEP")
+	assert.Equal(t, "APPROVED", pep.Status)
+	assert.Equal(t, "complyadvantage", pep.Provider)
+	assert.Equal(t, "search-PEP", pep.ProviderReference)
+
+	require.Len(t, results.saved, 2)
+	assert.Equal(t, model.ScreeningTriggerInitial, results.saved[0].Trigger())
+	assert.Equal(t, model.ScreeningOutcomeClear, results.saved[0].Outcome())
+}
+
+func TestInitiateVerification_ScreeningChecks_HitGoesToReview(t *testing.T) {
+	repo := &mockVerificationRepository{}
+	publisher := &mockEventPublisher{}
+	results := &mockScreeningResultRepository{}
+	screener := usecase.NewScreenVerification(repo, results, &mockScreeningProvider{matches: sanctionsHit()}, publisher)
+	uc := usecase.NewInitiateVerification(repo, &mockVerificationProvider{}, publisher, screener, nil, nil)
+
+	req := validInitiateRequest()
+	req.ScreeningChecks = []string{"SANCTIONS"}
+	resp, err := uc.Execute(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, "IN_PROGRESS", resp.Status)
+	sanctions := checkOfType(t, resp.Checks, "SANCTIONS")
+	assert.Equal(t, "REVIEW", sanctions.Status)
+	assert.Contains(t, sanctions.FailureReason, "John Doe")
+	require.Len(t, results.saved, 1)
+	assert.Equal(t, model.ScreeningOutcomeHit, results.saved[0].Outcome())
+}
+
+func TestInitiateVerification_InvalidScreeningCheck(t *testing.T) {
+	repo := &mockVerificationRepository{}
+	publisher := &mockEventPublisher{}
+	screener := usecase.NewScreenVerification(repo, &mockScreeningResultRepository{}, &mockScreeningProvider{}, publisher)
+	uc := usecase.NewInitiateVerification(repo, &mockVerificationProvider{}, publisher, screener, nil, nil)
+
+	req := validInitiateRequest()
+	req.ScreeningChecks = []string{"DOCUMENT"}
+	_, err := uc.Execute(context.Background(), req)
+	require.ErrorIs(t, err, usecase.ErrInvalidInput)
+	assert.Empty(t, repo.savedVerifications)
+}
+
+// approvedScreenedVerification returns an approved verification with a cleared sanctions check.
+func approvedScreenedVerification(t *testing.T) model.IdentityVerification {
+	t.Helper()
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "US",
+		valueobject.CheckTypeSanctions)
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	v, err = v.StartProcessing(now)
+	require.NoError(t, err)
+	for _, c := range v.Checks() {
+		v, err = v.CompleteCheck(c.ID(), valueobject.StatusApproved, "", now)
+		require.NoError(t, err)
+	}
+	return model.Reconstruct(v.ID(), v.TenantID(), "John", "Doe", "john@example.com", "1990-01-15", "US",
+		v.Status(), v.Checks(), v.RiskTier(), v.RiskScore(), v.RiskFactors(), v.Validity(), v.ExpiresAt(), v.ExpiryNotifiedAt(), v.ErasedAt(),
+		v.PreviousVerificationID(), v.Version(), v.CreatedAt(), v.UpdatedAt())
+}
+
+func TestRescreenDueVerifications_HitFlipsApprovedToReview(t *testing.T) {
+	v := approvedScreenedVerification(t)
+	repo := &mockVerificationRepository{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) { return v, nil },
+	}
+	publisher := &mockEventPublisher{}
+	results := &mockScreeningResultRepository{due: []uuid.UUID{v.ID()}}
+	screener := usecase.NewScreenVerification(repo, results, &mockScreeningProvider{matches: sanctionsHit()}, publisher)
+	uc := usecase.NewRescreenDueVerifications(repo, results, screener, 24*time.Hour)
+
+	count, err := uc.Execute(context.Background(), time.Now().UTC())
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	require.Len(t, repo.savedVerifications, 1)
+	assert.True(t, repo.savedVerifications[0].Status().Equal(valueobject.StatusReview))
+	require.Len(t, results.saved, 1)
+	assert.Equal(t, model.ScreeningTriggerRescreen, results.saved[0].Trigger())
+	require.Len(t, publisher.publishedEvents, 2)
+	assert.Equal(t, "identity.verification.flagged_for_review", publisher.publishedEvents[0].EventType())
+	assert.Equal(t, "identity.screening.hit", publisher.publishedEvents[1].EventType())
+}
+
+func TestRescreenDueVerifications_ClearKeepsApproved(t *testing.T) {
+	v := approvedScreenedVerification(t)
+	repo := &mockVerificationRepository{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) { return v, nil },
+	}
+	publisher := &mockEventPublisher{}
+	results := &mockScreeningResultRepository{due: []uuid.UUID{v.ID()}}
+	screener := usecase.NewScreenVerification(repo, results, &mockScreeningProvider{}, publisher)
+	uc := usecase.NewRescreenDueVerifications(repo, results, screener, 24*time.Hour)
+
+	count, err := uc.Execute(context.Background(), time.Now().UTC())
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.True(t, repo.savedVerifications[0].Status().Equal(valueobject.StatusApproved))
+	assert.Empty(t, publisher.publishedEvents)
+	require.Len(t, results.saved, 1)
+	assert.Equal(t, model.ScreeningOutcomeClear, results.saved[0].Outcome())
+}
+
+func TestScreenVerification_OtherTenant_NotFound(t *testing.T) {
+	v := approvedScreenedVerification(t)
+	repo := &mockVerificationRepository{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) { return v, nil },
+	}
+	screener := usecase.NewScreenVerification(repo, &mockScreeningResultRepository{}, &mockScreeningProvider{}, &mockEventPublisher{})
+
+	_, err := screener.Execute(context.Background(), dto.RescreenVerificationRequest{
+		TenantID:       uuid.New(),
+		VerificationID: v.ID(),
+	})
+	require.ErrorIs(t, err, usecase.ErrNotFound)
+	assert.Empty(t, repo.savedVerifications)
+}
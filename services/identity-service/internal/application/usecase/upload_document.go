@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// MaxDocumentBytes caps the size of an uploaded document.
+const MaxDocumentBytes = 10 << 20
+
+// allowedContentTypes are the sniffed content types accepted for upload.
+var allowedContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"application/pdf": true,
+}
+
+// UploadDocument stores an identity document encrypted in object storage and
+// links it to a verification check. When duplicate detection is configured,
+// an uploaded selfie is matched against the tenant's other applicants.
+type UploadDocument struct {
+	verifications port.VerificationRepository
+	documents     port.DocumentRepository
+	store         port.DocumentStore
+	cipher        port.DocumentCipher
+	publisher     port.EventPublisher
+	duplicates    *DetectDuplicateIdentity
+	retention     time.Duration
+}
+
+func NewUploadDocument(
+	verifications port.VerificationRepository,
+	documents port.DocumentRepository,
+	store port.DocumentStore,
+	cipher port.DocumentCipher,
+	publisher port.EventPublisher,
+	duplicates *DetectDuplicateIdentity,
+	retention time.Duration,
+) *UploadDocument {
+	return &UploadDocument{
+		verifications: verifications,
+		documents:     documents,
+		store:         store,
+		cipher:        cipher,
+		publisher:     publisher,
+		duplicates:    duplicates,
+		retention:     retention,
+	}
+}
+
+func (uc *UploadDocument) Execute(ctx context.Context, req dto.UploadDocumentRequest) (dto.DocumentResponse, error) {
+	documentType, err := valueobject.NewDocumentType(req.DocumentType)
+	if err != nil {
+		return dto.DocumentResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	if len(req.Content) == 0 {
+		return dto.DocumentResponse{}, fmt.Errorf("%w: document is empty", ErrInvalidInput)
+	}
+	if len(req.Content) > MaxDocumentBytes {
+		return dto.DocumentResponse{}, fmt.Errorf("%w: document exceeds %d bytes", ErrInvalidInput, MaxDocumentBytes)
+	}
+	// Trust the bytes, not the client's declared type.
+	contentType := http.DetectContentType(req.Content)
+	if !allowedContentTypes[contentType] {
+		return dto.DocumentResponse{}, fmt.Errorf("%w: unsupported content type %s", ErrInvalidInput, contentType)
+	}
+
+	verification, err := uc.verifications.FindByID(ctx, req.VerificationID)
+	if err != nil || verification.TenantID() != req.TenantID {
+		return dto.DocumentResponse{}, fmt.Errorf("%w: verification %s", ErrNotFound, req.VerificationID)
+	}
+	if verification.Status().IsTerminal() {
+		return dto.DocumentResponse{}, fmt.Errorf("%w: verification is already %s", ErrInvalidInput, verification.Status().String())
+	}
+	checkID, err := linkedCheck(verification, req.CheckID, documentType)
+	if err != nil {
+		return dto.DocumentResponse{}, err
+	}
+
+	digest := sha256.Sum256(req.Content)
+	document, err := model.NewIdentityDocument(
+		req.TenantID, verification.ID(), checkID,
+		documentType, contentType, int64(len(req.Content)),
+		hex.EncodeToString(digest[:]), uc.retention, time.Now().UTC(),
+	)
+	if err != nil {
+		return dto.DocumentResponse{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	ciphertext, err := uc.cipher.Encrypt(req.Content, documentAAD(document.ID()))
+	if err != nil {
+		return dto.DocumentResponse{}, fmt.Errorf("failed to encrypt document: %w", err)
+	}
+	if err := uc.store.Put(ctx, document.StorageKey(), ciphertext); err != nil {
+		return dto.DocumentResponse{}, fmt.Errorf("failed to store document: %w", err)
+	}
+	if err := uc.documents.Save(ctx, document); err != nil {
+		// Do not leave an unreferenced object behind.
+		if delErr := uc.store.Delete(ctx, document.StorageKey()); delErr != nil {
+			err = errors.Join(err, delErr)
+		}
+		return dto.DocumentResponse{}, fmt.Errorf("failed to save document: %w", err)
+	}
+
+	events := document.DomainEvents()
+	if uc.duplicates != nil && documentType.Equal(valueobject.DocumentTypeSelfie) {
+		matched, err := uc.duplicates.matchSelfie(ctx, verification, req.Content, document.UploadedAt())
+		if err != nil {
+			return dto.DocumentResponse{}, err
+		}
+		if matched.Version() != verification.Version() {
+			if err := uc.verifications.Save(ctx, matched); err != nil {
+				return dto.DocumentResponse{}, fmt.Errorf("failed to save verification: %w", err)
+			}
+			events = append(events, matched.DomainEvents()...)
+		}
+	}
+
+	if len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, TopicIdentityVerifications, events...); err != nil {
+			return dto.DocumentResponse{}, fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+
+	return toDocumentResponse(document), nil
+}
+
+// linkedCheck returns the check a document is attached to: the requested
+// check, or the verification's check for the document type.
+func linkedCheck(v model.IdentityVerification, requested uuid.UUID, documentType valueobject.DocumentType) (uuid.UUID, error) {
+	for _, c := range v.Checks() {
+		if requested != uuid.Nil && c.ID() == requested {
+			return c.ID(), nil
+		}
+		if requested == uuid.Nil && c.CheckType().Equal(documentType.CheckType()) {
+			return c.ID(), nil
+		}
+	}
+	if requested != uuid.Nil {
+		return uuid.Nil, fmt.Errorf("%w: check %s is not part of verification %s", ErrInvalidInput, requested, v.ID())
+	}
+	return uuid.Nil, fmt.Errorf("%w: verification %s has no %s check", ErrInvalidInput, v.ID(), documentType.CheckType().String())
+}
+
+// documentAAD binds a document's ciphertext to its ID.
+func documentAAD(id uuid.UUID) []byte {
+	return []byte("identity-document:" + id.String())
+}
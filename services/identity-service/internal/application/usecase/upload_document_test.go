@@ -0,0 +1,248 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+)
+
+// --- Mock implementations ---
+
+// mockDocumentRepository implements port.DocumentRepository in memory.
+type mockDocumentRepository struct {
+	saveErr   error
+	documents map[uuid.UUID]model.IdentityDocument
+}
+
+func newMockDocumentRepository() *mockDocumentRepository {
+	return &mockDocumentRepository{documents: make(map[uuid.UUID]model.IdentityDocument)}
+}
+
+func (m *mockDocumentRepository) Save(_ context.Context, d model.IdentityDocument) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	m.documents[d.ID()] = d
+	return nil
+}
+
+func (m *mockDocumentRepository) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.IdentityDocument, error) {
+	d, ok := m.documents[id]
+	if !ok || d.TenantID() != tenantID {
+		return model.IdentityDocument{}, port.ErrDocumentNotFound
+	}
+	return d, nil
+}
+
+func (m *mockDocumentRepository) ListByVerification(_ context.Context, tenantID, verificationID uuid.UUID) ([]model.IdentityDocument, error) {
+	var out []model.IdentityDocument
+	for _, d := range m.documents {
+		if d.TenantID() == tenantID && d.VerificationID() == verificationID {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockDocumentRepository) ListExpired(_ context.Context, cutoff time.Time, _ int) ([]model.IdentityDocument, error) {
+	var out []model.IdentityDocument
+	for _, d := range m.documents {
+		if !d.IsPurged() && !cutoff.Before(d.RetainUntil()) {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+// mockDocumentStore implements port.DocumentStore in memory.
+type mockDocumentStore struct {
+	objects map[string][]byte
+}
+
+func newMockDocumentStore() *mockDocumentStore {
+	return &mockDocumentStore{objects: make(map[string][]byte)}
+}
+
+func (m *mockDocumentStore) Put(_ context.Context, key string, data []byte) error {
+	m.objects[key] = data
+	return nil
+}
+
+func (m *mockDocumentStore) Get(_ context.Context, key string) ([]byte, error) {
+	data, ok := m.objects[key]
+	if !ok {
+		return nil, errors.New("object not found")
+	}
+	return data, nil
+}
+
+func (m *mockDocumentStore) Delete(_ context.Context, key string) error {
+	delete(m.objects, key)
+	return nil
+}
+
+// mockDocumentCipher "encrypts" by prefixing the associated data, which is
+// enough to prove the use cases bind ciphertext to the document.
+type mockDocumentCipher struct{}
+
+func (mockDocumentCipher) Encrypt(plaintext, aad []byte) ([]byte, error) {
+	return append(append([]byte{}, aad...), plaintext...), nil
+}
+
+func (mockDocumentCipher) Decrypt(ciphertext, aad []byte) ([]byte, error) {
+	if !bytes.HasPrefix(ciphertext, aad) {
+		return nil, errors.New("message authentication failed")
+	}
+	return ciphertext[len(aad):], nil
+}
+
+// pngBytes is a minimal payload that sniffs as image/png.
+var pngBytes = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 64)...)
+
+// --- Tests ---
+
+func TestUploadDocument_Execute(t *testing.T) {
+	setup := func() (*usecase.UploadDocument, *mockDocumentRepository, *mockDocumentStore, *mockIdentityEventPublisher, model.IdentityVerification) {
+		v := inProgressVerification()
+		verifications := &mockVerificationRepository{
+			findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) {
+				return v, nil
+			},
+		}
+		docs := newMockDocumentRepository()
+		store := newMockDocumentStore()
+		publisher := &mockIdentityEventPublisher{}
+		uc := usecase.NewUploadDocument(verifications, docs, store, mockDocumentCipher{}, publisher, nil, 24*time.Hour)
+		return uc, docs, store, publisher, v
+	}
+
+	t.Run("stores an encrypted selfie linked to the selfie check", func(t *testing.T) {
+		uc, docs, store, publisher, v := setup()
+
+		resp, err := uc.Execute(context.Background(), dto.UploadDocumentRequest{
+			TenantID:       v.TenantID(),
+			VerificationID: v.ID(),
+			DocumentType:   "SELFIE",
+			Content:        pngBytes,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "image/png", resp.ContentType)
+		assert.Equal(t, int64(len(pngBytes)), resp.SizeBytes)
+		assert.Equal(t, resp.UploadedAt.Add(24*time.Hour), resp.RetainUntil)
+		for _, c := range v.Checks() {
+			if c.ID() == resp.CheckID {
+				assert.Equal(t, "SELFIE", c.CheckType().String())
+			}
+		}
+
+		require.Len(t, docs.documents, 1)
+		stored := store.objects[docs.documents[resp.ID].StorageKey()]
+		assert.NotEqual(t, pngBytes, stored, "content must not be stored in the clear")
+		require.Len(t, publisher.publishedEvents, 1)
+		assert.Equal(t, "identity.document.uploaded", publisher.publishedEvents[0].EventType())
+
+		got, err := usecase.NewGetDocument(docs, store, mockDocumentCipher{}).Execute(context.Background(), dto.GetDocumentRequest{
+			TenantID: v.TenantID(), DocumentID: resp.ID, IncludeContent: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, pngBytes, got.Content)
+	})
+
+	t.Run("rejects unsupported content", func(t *testing.T) {
+		uc, _, _, _, v := setup()
+
+		_, err := uc.Execute(context.Background(), dto.UploadDocumentRequest{
+			TenantID:       v.TenantID(),
+			VerificationID: v.ID(),
+			DocumentType:   "PASSPORT",
+			Content:        []byte("<html><script>alert(1)</script></html>"),
+		})
+		assert.True(t, errors.Is(err, usecase.ErrInvalidInput))
+	})
+
+	t.Run("rejects a check from another verification", func(t *testing.T) {
+		uc, _, _, _, v := setup()
+
+		_, err := uc.Execute(context.Background(), dto.UploadDocumentRequest{
+			TenantID:       v.TenantID(),
+			VerificationID: v.ID(),
+			CheckID:        uuid.New(),
+			DocumentType:   "PASSPORT",
+			Content:        pngBytes,
+		})
+		assert.True(t, errors.Is(err, usecase.ErrInvalidInput))
+	})
+
+	t.Run("hides verifications of other tenants", func(t *testing.T) {
+		uc, _, _, _, v := setup()
+
+		_, err := uc.Execute(context.Background(), dto.UploadDocumentRequest{
+			TenantID:       uuid.New(),
+			VerificationID: v.ID(),
+			DocumentType:   "PASSPORT",
+			Content:        pngBytes,
+		})
+		assert.True(t, errors.Is(err, usecase.ErrNotFound))
+	})
+
+	t.Run("removes the stored object when saving fails", func(t *testing.T) {
+		uc, docs, store, _, v := setup()
+		docs.saveErr = errors.New("db down")
+
+		_, err := uc.Execute(context.Background(), dto.UploadDocumentRequest{
+			TenantID:       v.TenantID(),
+			VerificationID: v.ID(),
+			DocumentType:   "PASSPORT",
+			Content:        pngBytes,
+		})
+		require.Error(t, err)
+		assert.Empty(t, store.objects)
+	})
+}
+
+func TestPurgeExpiredDocuments_Execute(t *testing.T) {
+	v := inProgressVerification()
+	verifications := &mockVerificationRepository{
+		findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.IdentityVerification, error) {
+			return v, nil
+		},
+	}
+	docs := newMockDocumentRepository()
+	store := newMockDocumentStore()
+	publisher := &mockIdentityEventPublisher{}
+	upload := usecase.NewUploadDocument(verifications, docs, store, mockDocumentCipher{}, publisher, nil, time.Hour)
+
+	resp, err := upload.Execute(context.Background(), dto.UploadDocumentRequest{
+		TenantID: v.TenantID(), VerificationID: v.ID(), DocumentType: "PASSPORT", Content: pngBytes,
+	})
+	require.NoError(t, err)
+
+	purge := usecase.NewPurgeExpiredDocuments(docs, store, publisher)
+
+	purged, err := purge.Execute(context.Background(), time.Now().UTC())
+	require.NoError(t, err)
+	assert.Zero(t, purged, "documents inside their retention period are kept")
+
+	purged, err = purge.Execute(context.Background(), time.Now().UTC().Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+	assert.Empty(t, store.objects)
+	assert.True(t, docs.documents[resp.ID].IsPurged())
+
+	_, err = usecase.NewGetDocument(docs, store, mockDocumentCipher{}).Execute(context.Background(), dto.GetDocumentRequest{
+		TenantID: v.TenantID(), DocumentID: resp.ID, IncludeContent: true,
+	})
+	assert.True(t, errors.Is(err, usecase.ErrDocumentPurged))
+}
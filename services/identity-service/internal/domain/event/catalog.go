@@ -0,0 +1,29 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[VerificationInitiated]("identity.verification.initiated", 1, "Emitted when a new identity verification is created."),
+		events.Declare[VerificationCheckCompleted]("identity.verification.check_completed", 1, "Emitted when a provider or analyst decides a single check."),
+		events.Declare[VerificationCompleted]("identity.verification.completed", 1, "Emitted when all checks pass and the verification is approved."),
+		events.Declare[VerificationExpiring]("identity.verification.expiring", 1, "Emitted once when an approved verification enters its refresh notice period."),
+		events.Declare[VerificationExpired]("identity.verification.expired", 1, "Emitted when an approval lapses without a refresh."),
+		events.Declare[VerificationRejected]("identity.verification.rejected", 1, "Emitted when one or more checks fail and the verification is rejected."),
+		events.Declare[VerificationFlaggedForReview]("identity.verification.flagged_for_review", 1, "Emitted when a verification moves to REVIEW: a provider returned a borderline result, or screening found a potential match, possibly on an already approved verification."),
+		events.Declare[VerificationRiskTiered]("identity.verification.risk_tiered", 1, "Emitted when an applicant's risk score or tier changes."),
+		events.Declare[DuplicateIdentityDetected]("identity.verification.duplicate_detected", 1, "Emitted when a verification's applicant matches other verifications in the tenant."),
+		events.Declare[ScreeningHit]("identity.screening.hit", 1, "Emitted when a PEP, sanctions or adverse-media screening returns potential matches, on the initial screen or a re-screen."),
+		events.Declare[ApplicantDataErased]("identity.verification.erased", 1, "Emitted when a verification's applicant details are erased, at the end of the retention period or on the data subject's verified request."),
+		events.Declare[DocumentUploaded]("identity.document.uploaded", 1, "Emitted when an identity document is stored for a verification."),
+		events.Declare[DocumentPurged]("identity.document.purged", 1, "Emitted when a document's content is deleted at the end of its retention period or when the applicant's data is erased."),
+		events.Declare[BusinessVerificationInitiated]("identity.business_verification.initiated", 1, "Emitted when a new business (KYB) verification is created."),
+		events.Declare[BusinessVerificationCompleted]("identity.business_verification.completed", 1, "Emitted when all business checks pass."),
+		events.Declare[BusinessVerificationRejected]("identity.business_verification.rejected", 1, "Emitted when a business check fails."),
+		events.Declare[ReviewCaseOpened]("identity.review.opened", 1, "Emitted when a verification in REVIEW enters the analyst queue."),
+		events.Declare[ReviewCaseAssigned]("identity.review.assigned", 1, "Emitted when an analyst claims a review case or is assigned one."),
+		events.Declare[ReviewCaseDecided]("identity.review.decided", 1, "Emitted when a review case reaches its final outcome."),
+	}
+}
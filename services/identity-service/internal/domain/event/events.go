@@ -1,6 +1,8 @@
 package event
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 
 	"github.com/bibbank/bib/pkg/events"
@@ -11,44 +13,418 @@ const AggregateTypeIdentityVerification = "IdentityVerification"
 // VerificationInitiated is emitted when a new identity verification is created.
 type VerificationInitiated struct {
 	events.BaseEvent
-	ApplicantEmail string    `json:"applicant_email"`
-	VerificationID uuid.UUID `json:"verification_id"`
+	ApplicantEmail   string    `json:"applicant_email"`
+	ApplicantCountry string    `json:"applicant_country"`
+	CheckTypes       []string  `json:"check_types"`
+	VerificationID   uuid.UUID `json:"verification_id"`
 }
 
-func NewVerificationInitiated(verificationID, tenantID uuid.UUID, email string) VerificationInitiated {
+func NewVerificationInitiated(verificationID, tenantID uuid.UUID, email, country string, checkTypes []string) VerificationInitiated {
 	return VerificationInitiated{
-		BaseEvent:      events.NewBaseEvent("identity.verification.initiated", verificationID.String(), AggregateTypeIdentityVerification, tenantID.String()),
+		BaseEvent:        events.NewBaseEvent("identity.verification.initiated", verificationID.String(), AggregateTypeIdentityVerification, tenantID.String()),
+		VerificationID:   verificationID,
+		ApplicantEmail:   email,
+		ApplicantCountry: country,
+		CheckTypes:       checkTypes,
+	}
+}
+
+// VerificationCheckCompleted is emitted when a provider or analyst decides a
+// single check. The verification's overall outcome follows in a separate
+// completed or rejected event once every check is decided.
+type VerificationCheckCompleted struct {
+	events.BaseEvent
+	CheckType      string    `json:"check_type"`
+	Status         string    `json:"status"`
+	FailureReason  string    `json:"failure_reason,omitempty"`
+	Provider       string    `json:"provider,omitempty"`
+	VerificationID uuid.UUID `json:"verification_id"`
+	CheckID        uuid.UUID `json:"check_id"`
+}
+
+func NewVerificationCheckCompleted(verificationID, tenantID, checkID uuid.UUID, checkType, status, failureReason, provider string) VerificationCheckCompleted {
+	return VerificationCheckCompleted{
+		BaseEvent:      events.NewBaseEvent("identity.verification.check_completed", verificationID.String(), AggregateTypeIdentityVerification, tenantID.String()),
 		VerificationID: verificationID,
-		ApplicantEmail: email,
+		CheckID:        checkID,
+		CheckType:      checkType,
+		Status:         status,
+		FailureReason:  failureReason,
+		Provider:       provider,
 	}
 }
 
-// VerificationCompleted is emitted when all checks pass and the verification is approved.
+// VerificationCompleted is emitted when all checks pass and the verification
+// is approved. PreviousVerificationID is set when the approval refreshes an
+// earlier verification, so consumers can move links over to this one. The
+// applicant's name and email let the account service onboard a newly
+// verified customer.
 type VerificationCompleted struct {
 	events.BaseEvent
+	ExpiresAt              time.Time  `json:"expires_at"`
+	PreviousVerificationID *uuid.UUID `json:"previous_verification_id,omitempty"`
+	ApplicantFirstName     string     `json:"applicant_first_name"`
+	ApplicantLastName      string     `json:"applicant_last_name"`
+	ApplicantEmail         string     `json:"applicant_email"`
+	RiskTier               string     `json:"risk_tier"`
+	VerificationID         uuid.UUID  `json:"verification_id"`
+}
+
+func NewVerificationCompleted(verificationID, tenantID uuid.UUID, firstName, lastName, email, riskTier string, previousVerificationID uuid.UUID, expiresAt time.Time) VerificationCompleted {
+	e := VerificationCompleted{
+		BaseEvent:          events.NewBaseEvent("identity.verification.completed", verificationID.String(), AggregateTypeIdentityVerification, tenantID.String()),
+		VerificationID:     verificationID,
+		ApplicantFirstName: firstName,
+		ApplicantLastName:  lastName,
+		ApplicantEmail:     email,
+		RiskTier:           riskTier,
+		ExpiresAt:          expiresAt,
+	}
+	if previousVerificationID != uuid.Nil {
+		e.PreviousVerificationID = &previousVerificationID
+	}
+	return e
+}
+
+// VerificationExpiring is emitted once when an approved verification enters
+// its refresh notice period.
+type VerificationExpiring struct {
+	events.BaseEvent
+	ExpiresAt      time.Time `json:"expires_at"`
 	ApplicantEmail string    `json:"applicant_email"`
+	RiskTier       string    `json:"risk_tier"`
 	VerificationID uuid.UUID `json:"verification_id"`
 }
 
-func NewVerificationCompleted(verificationID, tenantID uuid.UUID, email string) VerificationCompleted {
-	return VerificationCompleted{
-		BaseEvent:      events.NewBaseEvent("identity.verification.completed", verificationID.String(), AggregateTypeIdentityVerification, tenantID.String()),
+func NewVerificationExpiring(verificationID, tenantID uuid.UUID, email, riskTier string, expiresAt time.Time) VerificationExpiring {
+	return VerificationExpiring{
+		BaseEvent:      events.NewBaseEvent("identity.verification.expiring", verificationID.String(), AggregateTypeIdentityVerification, tenantID.String()),
 		VerificationID: verificationID,
 		ApplicantEmail: email,
+		RiskTier:       riskTier,
+		ExpiresAt:      expiresAt,
 	}
 }
 
-// VerificationRejected is emitted when one or more checks fail and the verification is rejected.
-type VerificationRejected struct {
+// VerificationExpired is emitted when an approval lapses without a refresh.
+// Account-service restricts the accounts of holders linked to the verification.
+type VerificationExpired struct {
 	events.BaseEvent
+	ExpiredAt      time.Time `json:"expired_at"`
 	ApplicantEmail string    `json:"applicant_email"`
+	RiskTier       string    `json:"risk_tier"`
 	VerificationID uuid.UUID `json:"verification_id"`
 }
 
-func NewVerificationRejected(verificationID, tenantID uuid.UUID, email string) VerificationRejected {
-	return VerificationRejected{
-		BaseEvent:      events.NewBaseEvent("identity.verification.rejected", verificationID.String(), AggregateTypeIdentityVerification, tenantID.String()),
+func NewVerificationExpired(verificationID, tenantID uuid.UUID, email, riskTier string, expiredAt time.Time) VerificationExpired {
+	return VerificationExpired{
+		BaseEvent:      events.NewBaseEvent("identity.verification.expired", verificationID.String(), AggregateTypeIdentityVerification, tenantID.String()),
 		VerificationID: verificationID,
 		ApplicantEmail: email,
+		RiskTier:       riskTier,
+		ExpiredAt:      expiredAt,
+	}
+}
+
+// VerificationRejected is emitted when one or more checks fail and the
+// verification is rejected. RejectedCheckType and FailureReason describe the
+// check that failed.
+type VerificationRejected struct {
+	events.BaseEvent
+	ApplicantEmail    string    `json:"applicant_email"`
+	RejectedCheckType string    `json:"rejected_check_type"`
+	FailureReason     string    `json:"failure_reason,omitempty"`
+	VerificationID    uuid.UUID `json:"verification_id"`
+}
+
+func NewVerificationRejected(verificationID, tenantID uuid.UUID, email, rejectedCheckType, failureReason string) VerificationRejected {
+	return VerificationRejected{
+		BaseEvent:         events.NewBaseEvent("identity.verification.rejected", verificationID.String(), AggregateTypeIdentityVerification, tenantID.String()),
+		VerificationID:    verificationID,
+		ApplicantEmail:    email,
+		RejectedCheckType: rejectedCheckType,
+		FailureReason:     failureReason,
+	}
+}
+
+// VerificationFlaggedForReview is emitted when a verification moves to
+// REVIEW: a provider returned a borderline result, or screening found a
+// potential match, possibly on an already approved verification.
+type VerificationFlaggedForReview struct {
+	events.BaseEvent
+	CheckType      string    `json:"check_type"`
+	Reason         string    `json:"reason"`
+	VerificationID uuid.UUID `json:"verification_id"`
+	CheckID        uuid.UUID `json:"check_id"`
+}
+
+func NewVerificationFlaggedForReview(verificationID, tenantID, checkID uuid.UUID, checkType, reason string) VerificationFlaggedForReview {
+	return VerificationFlaggedForReview{
+		BaseEvent:      events.NewBaseEvent("identity.verification.flagged_for_review", verificationID.String(), AggregateTypeIdentityVerification, tenantID.String()),
+		VerificationID: verificationID,
+		CheckID:        checkID,
+		CheckType:      checkType,
+		Reason:         reason,
+	}
+}
+
+// VerificationRiskTiered is emitted when an applicant's risk score or tier
+// changes. Account-service and lending apply tier-based limits from it.
+type VerificationRiskTiered struct {
+	events.BaseEvent
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+	RiskTier         string     `json:"risk_tier"`
+	PreviousRiskTier string     `json:"previous_risk_tier"`
+	RiskFactors      []string   `json:"risk_factors"`
+	RiskScore        int        `json:"risk_score"`
+	VerificationID   uuid.UUID  `json:"verification_id"`
+}
+
+func NewVerificationRiskTiered(verificationID, tenantID uuid.UUID, riskTier, previousRiskTier string, riskScore int, riskFactors []string, expiresAt *time.Time) VerificationRiskTiered {
+	return VerificationRiskTiered{
+		BaseEvent:        events.NewBaseEvent("identity.verification.risk_tiered", verificationID.String(), AggregateTypeIdentityVerification, tenantID.String()),
+		VerificationID:   verificationID,
+		RiskTier:         riskTier,
+		PreviousRiskTier: previousRiskTier,
+		RiskScore:        riskScore,
+		RiskFactors:      riskFactors,
+		ExpiresAt:        expiresAt,
+	}
+}
+
+// DuplicateIdentityDetected is emitted when a verification's applicant
+// matches other verifications in the tenant. The verification is held in
+// REVIEW so a possible duplicate or synthetic identity cannot open further
+// accounts unnoticed.
+type DuplicateIdentityDetected struct {
+	events.BaseEvent
+	Reasons                []string    `json:"reasons"`
+	MatchedVerificationIDs []uuid.UUID `json:"matched_verification_ids"`
+	VerificationID         uuid.UUID   `json:"verification_id"`
+}
+
+func NewDuplicateIdentityDetected(verificationID, tenantID uuid.UUID, matchedVerificationIDs []uuid.UUID, reasons []string) DuplicateIdentityDetected {
+	return DuplicateIdentityDetected{
+		BaseEvent:              events.NewBaseEvent("identity.verification.duplicate_detected", verificationID.String(), AggregateTypeIdentityVerification, tenantID.String()),
+		VerificationID:         verificationID,
+		MatchedVerificationIDs: matchedVerificationIDs,
+		Reasons:                reasons,
+	}
+}
+
+// ScreeningHitMatch is one watchlist or register entry a screening matched.
+type ScreeningHitMatch struct {
+	Name    string   `json:"name"`
+	EntryID string   `json:"entry_id,omitempty"`
+	Sources []string `json:"sources,omitempty"`
+	Score   float64  `json:"score"`
+}
+
+// ScreeningHit is emitted when a PEP, sanctions or adverse-media screening
+// returns potential matches, on the initial screen or a re-screen. It carries
+// the applicant's name so fraud-service can screen payment counterparties
+// against customers with a sanctions hit.
+type ScreeningHit struct {
+	events.BaseEvent
+	CheckType          string              `json:"check_type"`
+	Provider           string              `json:"provider"`
+	Trigger            string              `json:"trigger"`
+	ApplicantFirstName string              `json:"applicant_first_name"`
+	ApplicantLastName  string              `json:"applicant_last_name"`
+	ApplicantCountry   string              `json:"applicant_country"`
+	Matches            []ScreeningHitMatch `json:"matches"`
+	VerificationID     uuid.UUID           `json:"verification_id"`
+	CheckID            uuid.UUID           `json:"check_id"`
+	ScreeningResultID  uuid.UUID           `json:"screening_result_id"`
+}
+
+func NewScreeningHit(
+	verificationID, tenantID, checkID, screeningResultID uuid.UUID,
+	checkType, provider, trigger, applicantFirstName, applicantLastName, applicantCountry string,
+	matches []ScreeningHitMatch,
+) ScreeningHit {
+	return ScreeningHit{
+		BaseEvent:          events.NewBaseEvent("identity.screening.hit", verificationID.String(), AggregateTypeIdentityVerification, tenantID.String()),
+		VerificationID:     verificationID,
+		CheckID:            checkID,
+		ScreeningResultID:  screeningResultID,
+		CheckType:          checkType,
+		Provider:           provider,
+		Trigger:            trigger,
+		ApplicantFirstName: applicantFirstName,
+		ApplicantLastName:  applicantLastName,
+		ApplicantCountry:   applicantCountry,
+		Matches:            matches,
+	}
+}
+
+// ApplicantDataErased is emitted when a verification's applicant details are
+// erased, at the end of the retention period or on the data subject's
+// verified request. Consumers holding copies of the applicant's personal data
+// for this verification should erase them too.
+type ApplicantDataErased struct {
+	events.BaseEvent
+	Reason           string    `json:"reason"`
+	RequestReference string    `json:"request_reference,omitempty"`
+	VerificationID   uuid.UUID `json:"verification_id"`
+}
+
+func NewApplicantDataErased(verificationID, tenantID uuid.UUID, reason, requestReference string) ApplicantDataErased {
+	return ApplicantDataErased{
+		BaseEvent:        events.NewBaseEvent("identity.verification.erased", verificationID.String(), AggregateTypeIdentityVerification, tenantID.String()),
+		VerificationID:   verificationID,
+		Reason:           reason,
+		RequestReference: requestReference,
+	}
+}
+
+const AggregateTypeIdentityDocument = "IdentityDocument"
+
+// DocumentUploaded is emitted when an identity document is stored for a verification.
+type DocumentUploaded struct {
+	events.BaseEvent
+	DocumentType   string    `json:"document_type"`
+	DocumentID     uuid.UUID `json:"document_id"`
+	VerificationID uuid.UUID `json:"verification_id"`
+	CheckID        uuid.UUID `json:"check_id"`
+}
+
+func NewDocumentUploaded(documentID, tenantID, verificationID, checkID uuid.UUID, documentType string) DocumentUploaded {
+	return DocumentUploaded{
+		BaseEvent:      events.NewBaseEvent("identity.document.uploaded", documentID.String(), AggregateTypeIdentityDocument, tenantID.String()),
+		DocumentID:     documentID,
+		VerificationID: verificationID,
+		CheckID:        checkID,
+		DocumentType:   documentType,
+	}
+}
+
+// DocumentPurged is emitted when a document's content is deleted at the end
+// of its retention period or when the applicant's data is erased.
+type DocumentPurged struct {
+	events.BaseEvent
+	DocumentID     uuid.UUID `json:"document_id"`
+	VerificationID uuid.UUID `json:"verification_id"`
+}
+
+func NewDocumentPurged(documentID, tenantID, verificationID uuid.UUID) DocumentPurged {
+	return DocumentPurged{
+		BaseEvent:      events.NewBaseEvent("identity.document.purged", documentID.String(), AggregateTypeIdentityDocument, tenantID.String()),
+		DocumentID:     documentID,
+		VerificationID: verificationID,
+	}
+}
+
+const AggregateTypeBusinessVerification = "BusinessVerification"
+
+// BusinessVerificationInitiated is emitted when a new business (KYB) verification is created.
+type BusinessVerificationInitiated struct {
+	events.BaseEvent
+	LegalName          string    `json:"legal_name"`
+	RegistrationNumber string    `json:"registration_number"`
+	Country            string    `json:"country"`
+	VerificationID     uuid.UUID `json:"verification_id"`
+}
+
+func NewBusinessVerificationInitiated(verificationID, tenantID uuid.UUID, legalName, registrationNumber, country string) BusinessVerificationInitiated {
+	return BusinessVerificationInitiated{
+		BaseEvent:          events.NewBaseEvent("identity.business_verification.initiated", verificationID.String(), AggregateTypeBusinessVerification, tenantID.String()),
+		VerificationID:     verificationID,
+		LegalName:          legalName,
+		RegistrationNumber: registrationNumber,
+		Country:            country,
+	}
+}
+
+// BusinessVerificationCompleted is emitted when all business checks pass.
+type BusinessVerificationCompleted struct {
+	events.BaseEvent
+	RegistrationNumber string    `json:"registration_number"`
+	VerificationID     uuid.UUID `json:"verification_id"`
+}
+
+func NewBusinessVerificationCompleted(verificationID, tenantID uuid.UUID, registrationNumber string) BusinessVerificationCompleted {
+	return BusinessVerificationCompleted{
+		BaseEvent:          events.NewBaseEvent("identity.business_verification.completed", verificationID.String(), AggregateTypeBusinessVerification, tenantID.String()),
+		VerificationID:     verificationID,
+		RegistrationNumber: registrationNumber,
+	}
+}
+
+// BusinessVerificationRejected is emitted when a business check fails.
+type BusinessVerificationRejected struct {
+	events.BaseEvent
+	RegistrationNumber string    `json:"registration_number"`
+	VerificationID     uuid.UUID `json:"verification_id"`
+}
+
+func NewBusinessVerificationRejected(verificationID, tenantID uuid.UUID, registrationNumber string) BusinessVerificationRejected {
+	return BusinessVerificationRejected{
+		BaseEvent:          events.NewBaseEvent("identity.business_verification.rejected", verificationID.String(), AggregateTypeBusinessVerification, tenantID.String()),
+		VerificationID:     verificationID,
+		RegistrationNumber: registrationNumber,
+	}
+}
+
+const AggregateTypeReviewCase = "ReviewCase"
+
+// ReviewCaseOpened is emitted when a verification in REVIEW enters the analyst queue.
+type ReviewCaseOpened struct {
+	events.BaseEvent
+	DueAt            time.Time `json:"due_at"`
+	Reason           string    `json:"reason"`
+	RequiresFourEyes bool      `json:"requires_four_eyes"`
+	CaseID           uuid.UUID `json:"case_id"`
+	VerificationID   uuid.UUID `json:"verification_id"`
+}
+
+func NewReviewCaseOpened(caseID, tenantID, verificationID uuid.UUID, reason string, requiresFourEyes bool, dueAt time.Time) ReviewCaseOpened {
+	return ReviewCaseOpened{
+		BaseEvent:        events.NewBaseEvent("identity.review.opened", caseID.String(), AggregateTypeReviewCase, tenantID.String()),
+		CaseID:           caseID,
+		VerificationID:   verificationID,
+		Reason:           reason,
+		RequiresFourEyes: requiresFourEyes,
+		DueAt:            dueAt,
+	}
+}
+
+// ReviewCaseAssigned is emitted when an analyst claims a review case or is assigned one.
+type ReviewCaseAssigned struct {
+	events.BaseEvent
+	CaseID         uuid.UUID `json:"case_id"`
+	VerificationID uuid.UUID `json:"verification_id"`
+	AssigneeID     uuid.UUID `json:"assignee_id"`
+	AssignedBy     uuid.UUID `json:"assigned_by"`
+}
+
+func NewReviewCaseAssigned(caseID, tenantID, verificationID, assigneeID, assignedBy uuid.UUID) ReviewCaseAssigned {
+	return ReviewCaseAssigned{
+		BaseEvent:      events.NewBaseEvent("identity.review.assigned", caseID.String(), AggregateTypeReviewCase, tenantID.String()),
+		CaseID:         caseID,
+		VerificationID: verificationID,
+		AssigneeID:     assigneeID,
+		AssignedBy:     assignedBy,
+	}
+}
+
+// ReviewCaseDecided is emitted when a review case reaches its final outcome.
+type ReviewCaseDecided struct {
+	events.BaseEvent
+	Outcome        string    `json:"outcome"`
+	Reason         string    `json:"reason"`
+	WithinSLA      bool      `json:"within_sla"`
+	CaseID         uuid.UUID `json:"case_id"`
+	VerificationID uuid.UUID `json:"verification_id"`
+}
+
+func NewReviewCaseDecided(caseID, tenantID, verificationID uuid.UUID, outcome, reason string, withinSLA bool) ReviewCaseDecided {
+	return ReviewCaseDecided{
+		BaseEvent:      events.NewBaseEvent("identity.review.decided", caseID.String(), AggregateTypeReviewCase, tenantID.String()),
+		CaseID:         caseID,
+		VerificationID: verificationID,
+		Outcome:        outcome,
+		Reason:         reason,
+		WithinSLA:      withinSLA,
 	}
 }
@@ -0,0 +1,161 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// DuplicateMatch is another verification in the tenant that appears to
+// belong to the same applicant. Reasons lists the identifiers that matched:
+// "document_number", "name_dob" and "selfie".
+type DuplicateMatch struct {
+	Reasons        []string  `json:"reasons"`
+	Score          float64   `json:"score"`
+	VerificationID uuid.UUID `json:"verification_id"`
+}
+
+// ApplicantFingerprint holds the identifiers used to spot the same person
+// behind different verifications in a tenant. The document number is kept
+// only as a tenant-scoped hash. ApplicantID is the first verification of the
+// applicant: re-verifications inherit it so they never match themselves.
+type ApplicantFingerprint struct {
+	createdAt          time.Time
+	updatedAt          time.Time
+	documentNumberHash string
+	firstName          string
+	lastName           string
+	dateOfBirth        string
+	selfieEmbedding    []float32
+	matches            []DuplicateMatch
+	verificationID     uuid.UUID
+	tenantID           uuid.UUID
+	applicantID        uuid.UUID
+}
+
+// NewApplicantFingerprint fingerprints a verification's applicant. The
+// documentNumber may be empty when the applicant has not supplied one.
+func NewApplicantFingerprint(
+	tenantID, verificationID, applicantID uuid.UUID,
+	firstName, lastName, dateOfBirth, documentNumber string,
+	now time.Time,
+) (ApplicantFingerprint, error) {
+	if tenantID == uuid.Nil {
+		return ApplicantFingerprint{}, fmt.Errorf("tenant ID is required")
+	}
+	if verificationID == uuid.Nil {
+		return ApplicantFingerprint{}, fmt.Errorf("verification ID is required")
+	}
+	if applicantID == uuid.Nil {
+		applicantID = verificationID
+	}
+	return ApplicantFingerprint{
+		tenantID:           tenantID,
+		verificationID:     verificationID,
+		applicantID:        applicantID,
+		firstName:          firstName,
+		lastName:           lastName,
+		dateOfBirth:        dateOfBirth,
+		documentNumberHash: HashDocumentNumber(tenantID, documentNumber),
+		createdAt:          now,
+		updatedAt:          now,
+	}, nil
+}
+
+// ReconstructApplicantFingerprint recreates an ApplicantFingerprint from persistence (no validation).
+func ReconstructApplicantFingerprint(
+	tenantID, verificationID, applicantID uuid.UUID,
+	firstName, lastName, dateOfBirth, documentNumberHash string,
+	selfieEmbedding []float32,
+	matches []DuplicateMatch,
+	createdAt, updatedAt time.Time,
+) ApplicantFingerprint {
+	return ApplicantFingerprint{
+		tenantID:           tenantID,
+		verificationID:     verificationID,
+		applicantID:        applicantID,
+		firstName:          firstName,
+		lastName:           lastName,
+		dateOfBirth:        dateOfBirth,
+		documentNumberHash: documentNumberHash,
+		selfieEmbedding:    selfieEmbedding,
+		matches:            matches,
+		createdAt:          createdAt,
+		updatedAt:          updatedAt,
+	}
+}
+
+// HashDocumentNumber returns a tenant-scoped SHA-256 of a document number
+// with case, spaces and punctuation removed, or "" for an empty number.
+func HashDocumentNumber(tenantID uuid.UUID, documentNumber string) string {
+	var b strings.Builder
+	for _, r := range documentNumber {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToUpper(r))
+		}
+	}
+	if b.Len() == 0 {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(tenantID.String() + ":" + b.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// WithSelfieEmbedding records the face embedding of the applicant's selfie
+// (immutable - returns new copy).
+func (f ApplicantFingerprint) WithSelfieEmbedding(embedding []float32, now time.Time) ApplicantFingerprint {
+	updated := f
+	updated.selfieEmbedding = append([]float32(nil), embedding...)
+	updated.updatedAt = now
+	return updated
+}
+
+// WithMatches records the latest duplicate matches (immutable - returns new copy).
+func (f ApplicantFingerprint) WithMatches(matches []DuplicateMatch, now time.Time) ApplicantFingerprint {
+	updated := f
+	updated.matches = append([]DuplicateMatch(nil), matches...)
+	updated.updatedAt = now
+	return updated
+}
+
+// Anonymize removes the applicant's name, date of birth and selfie embedding
+// once their verification is erased (immutable - returns new copy). The
+// document number hash and recorded matches are kept for audit.
+func (f ApplicantFingerprint) Anonymize(now time.Time) ApplicantFingerprint {
+	updated := f
+	updated.firstName = ""
+	updated.lastName = ""
+	updated.dateOfBirth = ""
+	updated.selfieEmbedding = nil
+	updated.updatedAt = now
+	return updated
+}
+
+// Accessors
+
+func (f ApplicantFingerprint) TenantID() uuid.UUID        { return f.tenantID }
+func (f ApplicantFingerprint) VerificationID() uuid.UUID  { return f.verificationID }
+func (f ApplicantFingerprint) ApplicantID() uuid.UUID     { return f.applicantID }
+func (f ApplicantFingerprint) FirstName() string          { return f.firstName }
+func (f ApplicantFingerprint) LastName() string           { return f.lastName }
+func (f ApplicantFingerprint) DateOfBirth() string        { return f.dateOfBirth }
+func (f ApplicantFingerprint) DocumentNumberHash() string { return f.documentNumberHash }
+func (f ApplicantFingerprint) CreatedAt() time.Time       { return f.createdAt }
+func (f ApplicantFingerprint) UpdatedAt() time.Time       { return f.updatedAt }
+
+func (f ApplicantFingerprint) SelfieEmbedding() []float32 {
+	result := make([]float32, len(f.selfieEmbedding))
+	copy(result, f.selfieEmbedding)
+	return result
+}
+
+func (f ApplicantFingerprint) Matches() []DuplicateMatch {
+	result := make([]DuplicateMatch, len(f.matches))
+	copy(result, f.matches)
+	return result
+}
@@ -0,0 +1,302 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/event"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// BeneficialOwner is a natural person who ultimately owns or controls a
+// business. It is a value object within the BusinessVerification aggregate.
+type BeneficialOwner struct {
+	firstName        string
+	lastName         string
+	dateOfBirth      string
+	country          string
+	ownershipPercent float64
+}
+
+// NewBeneficialOwner creates a validated beneficial owner. ownershipPercent
+// is the share of the business held, in (0, 100].
+func NewBeneficialOwner(firstName, lastName, dateOfBirth, country string, ownershipPercent float64) (BeneficialOwner, error) {
+	if firstName == "" || lastName == "" {
+		return BeneficialOwner{}, fmt.Errorf("beneficial owner name is required")
+	}
+	if dateOfBirth == "" {
+		return BeneficialOwner{}, fmt.Errorf("beneficial owner date of birth is required")
+	}
+	if country == "" {
+		return BeneficialOwner{}, fmt.Errorf("beneficial owner country is required")
+	}
+	if ownershipPercent <= 0 || ownershipPercent > 100 {
+		return BeneficialOwner{}, fmt.Errorf("beneficial owner ownership must be between 0 and 100, got %v", ownershipPercent)
+	}
+	return BeneficialOwner{
+		firstName:        firstName,
+		lastName:         lastName,
+		dateOfBirth:      dateOfBirth,
+		country:          country,
+		ownershipPercent: ownershipPercent,
+	}, nil
+}
+
+func (o BeneficialOwner) FirstName() string         { return o.firstName }
+func (o BeneficialOwner) LastName() string          { return o.lastName }
+func (o BeneficialOwner) DateOfBirth() string       { return o.dateOfBirth }
+func (o BeneficialOwner) Country() string           { return o.country }
+func (o BeneficialOwner) OwnershipPercent() float64 { return o.ownershipPercent }
+
+// BusinessVerification is the aggregate root for know-your-business (KYB)
+// verification. It sits alongside IdentityVerification and reuses its check
+// lifecycle, with business-specific check types.
+type BusinessVerification struct {
+	createdAt          time.Time
+	updatedAt          time.Time
+	status             valueobject.VerificationStatus
+	legalName          string
+	registrationNumber string
+	country            string
+	beneficialOwners   []BeneficialOwner
+	checks             []VerificationCheck
+	domainEvents       []events.DomainEvent
+	version            int
+	id                 uuid.UUID
+	tenantID           uuid.UUID
+}
+
+// NewBusinessVerification creates a new business verification in PENDING
+// status with the default set of business checks. At least one beneficial
+// owner must be declared and their combined ownership cannot exceed 100%.
+func NewBusinessVerification(
+	tenantID uuid.UUID,
+	legalName, registrationNumber, country string,
+	owners []BeneficialOwner,
+) (BusinessVerification, error) {
+	if tenantID == uuid.Nil {
+		return BusinessVerification{}, fmt.Errorf("tenant ID is required")
+	}
+	legalName = strings.TrimSpace(legalName)
+	if legalName == "" {
+		return BusinessVerification{}, fmt.Errorf("business legal name is required")
+	}
+	registrationNumber = strings.TrimSpace(registrationNumber)
+	if registrationNumber == "" {
+		return BusinessVerification{}, fmt.Errorf("business registration number is required")
+	}
+	if country == "" {
+		return BusinessVerification{}, fmt.Errorf("business country is required")
+	}
+	if len(owners) == 0 {
+		return BusinessVerification{}, fmt.Errorf("at least one beneficial owner is required")
+	}
+	var total float64
+	for _, o := range owners {
+		total += o.OwnershipPercent()
+	}
+	if total > 100 {
+		return BusinessVerification{}, fmt.Errorf("beneficial ownership totals %v%%, exceeding 100%%", total)
+	}
+
+	id := uuid.New()
+	now := time.Now().UTC()
+
+	var checks []VerificationCheck
+	for _, ct := range valueobject.DefaultBusinessCheckTypes() {
+		checks = append(checks, NewVerificationCheck(ct))
+	}
+
+	b := BusinessVerification{
+		id:                 id,
+		tenantID:           tenantID,
+		legalName:          legalName,
+		registrationNumber: registrationNumber,
+		country:            country,
+		beneficialOwners:   append([]BeneficialOwner(nil), owners...),
+		status:             valueobject.StatusPending,
+		checks:             checks,
+		version:            1,
+		createdAt:          now,
+		updatedAt:          now,
+	}
+
+	b.domainEvents = append(b.domainEvents,
+		event.NewBusinessVerificationInitiated(id, tenantID, legalName, registrationNumber, country))
+
+	return b, nil
+}
+
+// ReconstructBusinessVerification recreates a BusinessVerification from persistence (no validation, no events).
+func ReconstructBusinessVerification(
+	id, tenantID uuid.UUID,
+	legalName, registrationNumber, country string,
+	owners []BeneficialOwner,
+	status valueobject.VerificationStatus,
+	checks []VerificationCheck,
+	version int,
+	createdAt, updatedAt time.Time,
+) BusinessVerification {
+	return BusinessVerification{
+		id:                 id,
+		tenantID:           tenantID,
+		legalName:          legalName,
+		registrationNumber: registrationNumber,
+		country:            country,
+		beneficialOwners:   owners,
+		status:             status,
+		checks:             checks,
+		version:            version,
+		createdAt:          createdAt,
+		updatedAt:          updatedAt,
+	}
+}
+
+// ReconstructBeneficialOwner recreates a BeneficialOwner from persistence (no validation).
+func ReconstructBeneficialOwner(firstName, lastName, dateOfBirth, country string, ownershipPercent float64) BeneficialOwner {
+	return BeneficialOwner{
+		firstName:        firstName,
+		lastName:         lastName,
+		dateOfBirth:      dateOfBirth,
+		country:          country,
+		ownershipPercent: ownershipPercent,
+	}
+}
+
+// StartProcessing transitions the verification from PENDING to IN_PROGRESS (immutable - returns new copy).
+func (b BusinessVerification) StartProcessing(now time.Time) (BusinessVerification, error) {
+	if b.status != valueobject.StatusPending {
+		return BusinessVerification{}, fmt.Errorf("can only start processing verifications in PENDING status, current: %s", b.status.String())
+	}
+
+	updated := b
+	updated.status = valueobject.StatusInProgress
+	updated.updatedAt = now
+	updated.version++
+	updated.domainEvents = copyEvents(b.domainEvents)
+
+	newChecks := make([]VerificationCheck, len(b.checks))
+	for i, c := range b.checks {
+		if c.Status().Equal(valueobject.StatusPending) {
+			started, err := c.SetInProgress()
+			if err != nil {
+				return BusinessVerification{}, fmt.Errorf("failed to start check %s: %w", c.ID(), err)
+			}
+			newChecks[i] = started
+		} else {
+			newChecks[i] = c
+		}
+	}
+	updated.checks = newChecks
+
+	return updated, nil
+}
+
+// UpdateCheckProvider sets the provider and reference on a specific check (immutable).
+func (b BusinessVerification) UpdateCheckProvider(checkID uuid.UUID, provider, providerRef string) (BusinessVerification, error) {
+	updated := b
+	updated.domainEvents = copyEvents(b.domainEvents)
+
+	found := false
+	newChecks := make([]VerificationCheck, len(b.checks))
+	for i, c := range b.checks {
+		if c.ID() == checkID {
+			newChecks[i] = c.SetProvider(provider, providerRef)
+			found = true
+		} else {
+			newChecks[i] = c
+		}
+	}
+	if !found {
+		return BusinessVerification{}, fmt.Errorf("check %s not found in business verification %s", checkID, b.id)
+	}
+	updated.checks = newChecks
+	return updated, nil
+}
+
+// CompleteCheck completes a business check and evaluates the overall status:
+// any rejected check rejects the business, and it is approved once every
+// check is approved. This is immutable - returns a new copy of the aggregate.
+func (b BusinessVerification) CompleteCheck(
+	checkID uuid.UUID,
+	status valueobject.VerificationStatus,
+	failureReason string,
+	now time.Time,
+) (BusinessVerification, error) {
+	if b.status.IsTerminal() {
+		return BusinessVerification{}, fmt.Errorf("business verification %s is already in terminal status %s", b.id, b.status.String())
+	}
+
+	updated := b
+	updated.updatedAt = now
+	updated.version++
+	updated.domainEvents = copyEvents(b.domainEvents)
+
+	found := false
+	newChecks := make([]VerificationCheck, len(b.checks))
+	for i, c := range b.checks {
+		if c.ID() == checkID {
+			completed, err := c.Complete(status, failureReason, now)
+			if err != nil {
+				return BusinessVerification{}, fmt.Errorf("failed to complete check: %w", err)
+			}
+			newChecks[i] = completed
+			found = true
+		} else {
+			newChecks[i] = c
+		}
+	}
+	if !found {
+		return BusinessVerification{}, fmt.Errorf("check %s not found in business verification %s", checkID, b.id)
+	}
+	updated.checks = newChecks
+
+	allApproved := true
+	for _, c := range newChecks {
+		if c.Status().Equal(valueobject.StatusRejected) {
+			updated.status = valueobject.StatusRejected
+			updated.domainEvents = append(updated.domainEvents,
+				event.NewBusinessVerificationRejected(b.id, b.tenantID, b.registrationNumber))
+			return updated, nil
+		}
+		if !c.Status().Equal(valueobject.StatusApproved) {
+			allApproved = false
+		}
+	}
+	if allApproved {
+		updated.status = valueobject.StatusApproved
+		updated.domainEvents = append(updated.domainEvents,
+			event.NewBusinessVerificationCompleted(b.id, b.tenantID, b.registrationNumber))
+	}
+
+	return updated, nil
+}
+
+// Accessors
+
+func (b BusinessVerification) ID() uuid.UUID                          { return b.id }
+func (b BusinessVerification) TenantID() uuid.UUID                    { return b.tenantID }
+func (b BusinessVerification) LegalName() string                      { return b.legalName }
+func (b BusinessVerification) RegistrationNumber() string             { return b.registrationNumber }
+func (b BusinessVerification) Country() string                        { return b.country }
+func (b BusinessVerification) Status() valueobject.VerificationStatus { return b.status }
+func (b BusinessVerification) Version() int                           { return b.version }
+func (b BusinessVerification) CreatedAt() time.Time                   { return b.createdAt }
+func (b BusinessVerification) UpdatedAt() time.Time                   { return b.updatedAt }
+func (b BusinessVerification) DomainEvents() []events.DomainEvent     { return b.domainEvents }
+
+func (b BusinessVerification) BeneficialOwners() []BeneficialOwner {
+	result := make([]BeneficialOwner, len(b.beneficialOwners))
+	copy(result, b.beneficialOwners)
+	return result
+}
+
+func (b BusinessVerification) Checks() []VerificationCheck {
+	result := make([]VerificationCheck, len(b.checks))
+	copy(result, b.checks)
+	return result
+}
@@ -0,0 +1,92 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+func owner(t *testing.T, pct float64) model.BeneficialOwner {
+	t.Helper()
+	o, err := model.NewBeneficialOwner("Jane", "Smith", "1980-02-03", "GB", pct)
+	require.NoError(t, err)
+	return o
+}
+
+func TestNewBusinessVerification_Valid(t *testing.T) {
+	tenantID := uuid.New()
+
+	b, err := model.NewBusinessVerification(tenantID, " Acme Ltd ", "01234567", "GB", []model.BeneficialOwner{owner(t, 60)})
+	require.NoError(t, err)
+
+	assert.Equal(t, tenantID, b.TenantID())
+	assert.Equal(t, "Acme Ltd", b.LegalName())
+	assert.Equal(t, "01234567", b.RegistrationNumber())
+	assert.True(t, b.Status().Equal(valueobject.StatusPending))
+	require.Len(t, b.BeneficialOwners(), 1)
+
+	checks := b.Checks()
+	require.Len(t, checks, 3)
+	assert.True(t, checks[0].CheckType().Equal(valueobject.CheckTypeBusinessRegistry))
+	assert.True(t, checks[1].CheckType().Equal(valueobject.CheckTypeBeneficialOwners))
+	assert.True(t, checks[2].CheckType().Equal(valueobject.CheckTypeBusinessWatchlist))
+
+	events := b.DomainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "identity.business_verification.initiated", events[0].EventType())
+}
+
+func TestNewBusinessVerification_Invalid(t *testing.T) {
+	tenantID := uuid.New()
+
+	_, err := model.NewBusinessVerification(tenantID, "Acme Ltd", "", "GB", []model.BeneficialOwner{owner(t, 60)})
+	assert.Error(t, err, "registration number is required")
+
+	_, err = model.NewBusinessVerification(tenantID, "Acme Ltd", "01234567", "GB", nil)
+	assert.Error(t, err, "beneficial owners are required")
+
+	_, err = model.NewBusinessVerification(tenantID, "Acme Ltd", "01234567", "GB", []model.BeneficialOwner{owner(t, 60), owner(t, 50)})
+	assert.Error(t, err, "ownership cannot exceed 100%")
+
+	_, err = model.NewBeneficialOwner("Jane", "Smith", "1980-02-03", "GB", 0)
+	assert.Error(t, err)
+}
+
+func TestBusinessVerification_CompleteChecks(t *testing.T) {
+	start := func() model.BusinessVerification {
+		b, err := model.NewBusinessVerification(uuid.New(), "Acme Ltd", "01234567", "GB", []model.BeneficialOwner{owner(t, 100)})
+		require.NoError(t, err)
+		b, err = b.StartProcessing(time.Now())
+		require.NoError(t, err)
+		return b
+	}
+
+	t.Run("approved once every check passes", func(t *testing.T) {
+		b := start()
+		var err error
+		for _, c := range b.Checks() {
+			b, err = b.CompleteCheck(c.ID(), valueobject.StatusApproved, "", time.Now())
+			require.NoError(t, err)
+		}
+		assert.True(t, b.Status().Equal(valueobject.StatusApproved))
+		events := b.DomainEvents()
+		assert.Equal(t, "identity.business_verification.completed", events[len(events)-1].EventType())
+	})
+
+	t.Run("rejected when any check fails", func(t *testing.T) {
+		b := start()
+		watchlist := b.Checks()[2]
+		b, err := b.CompleteCheck(watchlist.ID(), valueobject.StatusRejected, "sanctions match", time.Now())
+		require.NoError(t, err)
+		assert.True(t, b.Status().Equal(valueobject.StatusRejected))
+
+		_, err = b.CompleteCheck(b.Checks()[0].ID(), valueobject.StatusApproved, "", time.Now())
+		assert.Error(t, err, "terminal verifications cannot change")
+	})
+}
@@ -0,0 +1,167 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/event"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// IdentityDocument is an uploaded identity artifact (ID scan, selfie, proof of
+// address) linked to a verification check. The content lives encrypted in
+// object storage under StorageKey; this aggregate holds its metadata and
+// retention state.
+type IdentityDocument struct {
+	uploadedAt     time.Time
+	retainUntil    time.Time
+	purgedAt       *time.Time
+	documentType   valueobject.DocumentType
+	contentType    string
+	storageKey     string
+	sha256         string
+	domainEvents   []events.DomainEvent
+	sizeBytes      int64
+	id             uuid.UUID
+	tenantID       uuid.UUID
+	verificationID uuid.UUID
+	checkID        uuid.UUID
+}
+
+// NewIdentityDocument records a document uploaded for a verification check.
+// It is retained until uploadedAt + retention.
+func NewIdentityDocument(
+	tenantID, verificationID, checkID uuid.UUID,
+	documentType valueobject.DocumentType,
+	contentType string,
+	sizeBytes int64,
+	sha256Hex string,
+	retention time.Duration,
+	uploadedAt time.Time,
+) (IdentityDocument, error) {
+	if tenantID == uuid.Nil {
+		return IdentityDocument{}, fmt.Errorf("tenant ID is required")
+	}
+	if verificationID == uuid.Nil {
+		return IdentityDocument{}, fmt.Errorf("verification ID is required")
+	}
+	if checkID == uuid.Nil {
+		return IdentityDocument{}, fmt.Errorf("check ID is required")
+	}
+	if contentType == "" {
+		return IdentityDocument{}, fmt.Errorf("content type is required")
+	}
+	if sizeBytes <= 0 {
+		return IdentityDocument{}, fmt.Errorf("document must not be empty")
+	}
+	if len(sha256Hex) != 64 {
+		return IdentityDocument{}, fmt.Errorf("content digest must be a hex SHA-256")
+	}
+	if retention <= 0 {
+		return IdentityDocument{}, fmt.Errorf("retention must be positive")
+	}
+
+	id := uuid.New()
+	d := IdentityDocument{
+		id:             id,
+		tenantID:       tenantID,
+		verificationID: verificationID,
+		checkID:        checkID,
+		documentType:   documentType,
+		contentType:    contentType,
+		sizeBytes:      sizeBytes,
+		sha256:         sha256Hex,
+		storageKey:     fmt.Sprintf("identity/%s/%s/%s", tenantID, verificationID, id),
+		uploadedAt:     uploadedAt,
+		retainUntil:    uploadedAt.Add(retention),
+	}
+	d.domainEvents = append(d.domainEvents,
+		event.NewDocumentUploaded(id, tenantID, verificationID, checkID, documentType.String()))
+
+	return d, nil
+}
+
+// ReconstructDocument recreates an IdentityDocument from persistence (no validation, no events).
+func ReconstructDocument(
+	id, tenantID, verificationID, checkID uuid.UUID,
+	documentType valueobject.DocumentType,
+	contentType string,
+	sizeBytes int64,
+	sha256Hex, storageKey string,
+	uploadedAt, retainUntil time.Time,
+	purgedAt *time.Time,
+) IdentityDocument {
+	return IdentityDocument{
+		id:             id,
+		tenantID:       tenantID,
+		verificationID: verificationID,
+		checkID:        checkID,
+		documentType:   documentType,
+		contentType:    contentType,
+		sizeBytes:      sizeBytes,
+		sha256:         sha256Hex,
+		storageKey:     storageKey,
+		uploadedAt:     uploadedAt,
+		retainUntil:    retainUntil,
+		purgedAt:       purgedAt,
+	}
+}
+
+// MarkPurged records that the document content was deleted at the end of its
+// retention period (immutable - returns new copy). Metadata is kept for audit.
+func (d IdentityDocument) MarkPurged(now time.Time) (IdentityDocument, error) {
+	if d.purgedAt != nil {
+		return IdentityDocument{}, fmt.Errorf("document %s is already purged", d.id)
+	}
+	if now.Before(d.retainUntil) {
+		return IdentityDocument{}, fmt.Errorf("document %s is retained until %s", d.id, d.retainUntil.Format(time.RFC3339))
+	}
+	return d.purge(now), nil
+}
+
+// MarkErased records that the document content was deleted ahead of its
+// retention period because the applicant's data was erased (immutable -
+// returns new copy). Metadata, including the content digest, is kept for audit.
+func (d IdentityDocument) MarkErased(now time.Time) (IdentityDocument, error) {
+	if d.purgedAt != nil {
+		return IdentityDocument{}, fmt.Errorf("document %s is already purged", d.id)
+	}
+	return d.purge(now), nil
+}
+
+func (d IdentityDocument) purge(now time.Time) IdentityDocument {
+	updated := d
+	updated.purgedAt = &now
+	updated.domainEvents = append(copyEvents(d.domainEvents),
+		event.NewDocumentPurged(d.id, d.tenantID, d.verificationID))
+	return updated
+}
+
+// IsPurged reports whether the document content has been deleted.
+func (d IdentityDocument) IsPurged() bool { return d.purgedAt != nil }
+
+// Accessors
+
+func (d IdentityDocument) ID() uuid.UUID                          { return d.id }
+func (d IdentityDocument) TenantID() uuid.UUID                    { return d.tenantID }
+func (d IdentityDocument) VerificationID() uuid.UUID              { return d.verificationID }
+func (d IdentityDocument) CheckID() uuid.UUID                     { return d.checkID }
+func (d IdentityDocument) DocumentType() valueobject.DocumentType { return d.documentType }
+func (d IdentityDocument) ContentType() string                    { return d.contentType }
+func (d IdentityDocument) SizeBytes() int64                       { return d.sizeBytes }
+func (d IdentityDocument) SHA256() string                         { return d.sha256 }
+func (d IdentityDocument) StorageKey() string                     { return d.storageKey }
+func (d IdentityDocument) UploadedAt() time.Time                  { return d.uploadedAt }
+func (d IdentityDocument) RetainUntil() time.Time                 { return d.retainUntil }
+func (d IdentityDocument) DomainEvents() []events.DomainEvent     { return d.domainEvents }
+
+func (d IdentityDocument) PurgedAt() *time.Time {
+	if d.purgedAt == nil {
+		return nil
+	}
+	t := *d.purgedAt
+	return &t
+}
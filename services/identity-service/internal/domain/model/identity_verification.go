@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,27 +14,50 @@ import (
 
 // IdentityVerification is the root aggregate for the identity bounded context.
 // It orchestrates KYC/AML verification for an applicant.
+//
+// An approved verification is valid for the period its risk tier allows.
+// Periodic KYC refresh flags it shortly before expiresAt and expires it once
+// that time passes; the applicant then re-verifies through a new
+// verification that links back to this one.
+//
+// The risk tier is scored from the applicant's country, documents, screening
+// hits and provider signals as evidence arrives. Within a verification the
+// tier only ever rises, so a tier assigned up front acts as a floor.
+//
+// Once a decided verification is no longer needed, or its applicant asks for
+// erasure, the applicant's personal details are erased. The checks, decisions
+// and risk assessment are kept as the audit record of what was verified.
 type IdentityVerification struct {
-	createdAt          time.Time
-	updatedAt          time.Time
-	applicantDOB       string
-	applicantFirstName string
-	applicantLastName  string
-	applicantEmail     string
-	applicantCountry   string
-	status             valueobject.VerificationStatus
-	domainEvents       []events.DomainEvent
-	checks             []VerificationCheck
-	version            int
-	id                 uuid.UUID
-	tenantID           uuid.UUID
+	createdAt              time.Time
+	updatedAt              time.Time
+	expiresAt              *time.Time
+	expiryNotifiedAt       *time.Time
+	erasedAt               *time.Time
+	applicantDOB           string
+	applicantFirstName     string
+	applicantLastName      string
+	applicantEmail         string
+	applicantCountry       string
+	status                 valueobject.VerificationStatus
+	riskTier               valueobject.RiskTier
+	domainEvents           []events.DomainEvent
+	checks                 []VerificationCheck
+	riskFactors            []string
+	validity               time.Duration
+	riskScore              int
+	version                int
+	id                     uuid.UUID
+	tenantID               uuid.UUID
+	previousVerificationID uuid.UUID
 }
 
 // NewIdentityVerification creates a new verification in PENDING status
-// with the default set of checks (DOCUMENT, SELFIE, WATCHLIST).
+// with the default set of checks (DOCUMENT, SELFIE, WATCHLIST) followed by
+// any additional checks, such as screening checks, not already included.
 func NewIdentityVerification(
 	tenantID uuid.UUID,
 	firstName, lastName, email, dob, country string,
+	additionalChecks ...valueobject.CheckType,
 ) (IdentityVerification, error) {
 	if tenantID == uuid.Nil {
 		return IdentityVerification{}, fmt.Errorf("tenant ID is required")
@@ -57,9 +81,14 @@ func NewIdentityVerification(
 	id := uuid.New()
 	now := time.Now().UTC()
 
-	// Create default checks
+	// Create default and additional checks
 	var checks []VerificationCheck
-	for _, ct := range valueobject.DefaultCheckTypes() {
+	seen := make(map[valueobject.CheckType]bool)
+	for _, ct := range append(valueobject.DefaultCheckTypes(), additionalChecks...) {
+		if seen[ct] {
+			continue
+		}
+		seen[ct] = true
 		checks = append(checks, NewVerificationCheck(ct))
 	}
 
@@ -72,13 +101,19 @@ func NewIdentityVerification(
 		applicantDOB:       dob,
 		applicantCountry:   country,
 		status:             valueobject.StatusPending,
+		riskTier:           valueobject.RiskTierMedium,
+		validity:           valueobject.RiskTierMedium.DefaultValidity(),
 		checks:             checks,
 		version:            1,
 		createdAt:          now,
 		updatedAt:          now,
 	}
 
-	v.domainEvents = append(v.domainEvents, event.NewVerificationInitiated(id, tenantID, email))
+	checkTypes := make([]string, 0, len(checks))
+	for _, c := range checks {
+		checkTypes = append(checkTypes, c.CheckType().String())
+	}
+	v.domainEvents = append(v.domainEvents, event.NewVerificationInitiated(id, tenantID, email, country, checkTypes))
 
 	return v, nil
 }
@@ -89,23 +124,208 @@ func Reconstruct(
 	firstName, lastName, email, dob, country string,
 	status valueobject.VerificationStatus,
 	checks []VerificationCheck,
+	riskTier valueobject.RiskTier,
+	riskScore int,
+	riskFactors []string,
+	validity time.Duration,
+	expiresAt, expiryNotifiedAt, erasedAt *time.Time,
+	previousVerificationID uuid.UUID,
 	version int,
 	createdAt, updatedAt time.Time,
 ) IdentityVerification {
 	return IdentityVerification{
-		id:                 id,
-		tenantID:           tenantID,
-		applicantFirstName: firstName,
-		applicantLastName:  lastName,
-		applicantEmail:     email,
-		applicantDOB:       dob,
-		applicantCountry:   country,
-		status:             status,
-		checks:             checks,
-		version:            version,
-		createdAt:          createdAt,
-		updatedAt:          updatedAt,
+		id:                     id,
+		tenantID:               tenantID,
+		applicantFirstName:     firstName,
+		applicantLastName:      lastName,
+		applicantEmail:         email,
+		applicantDOB:           dob,
+		applicantCountry:       country,
+		status:                 status,
+		checks:                 checks,
+		riskTier:               riskTier,
+		riskScore:              riskScore,
+		riskFactors:            riskFactors,
+		validity:               validity,
+		expiresAt:              expiresAt,
+		expiryNotifiedAt:       expiryNotifiedAt,
+		erasedAt:               erasedAt,
+		previousVerificationID: previousVerificationID,
+		version:                version,
+		createdAt:              createdAt,
+		updatedAt:              updatedAt,
+	}
+}
+
+// AssignRiskTier sets the applicant's risk tier and how long an approval
+// stays valid. It is only allowed before processing starts (immutable -
+// returns new copy).
+func (v IdentityVerification) AssignRiskTier(tier valueobject.RiskTier, validity time.Duration) (IdentityVerification, error) {
+	if v.status != valueobject.StatusPending {
+		return IdentityVerification{}, fmt.Errorf("can only assign a risk tier to verifications in PENDING status, current: %s", v.status.String())
+	}
+	if validity <= 0 {
+		return IdentityVerification{}, fmt.Errorf("validity period must be positive")
+	}
+	updated := v
+	updated.domainEvents = copyEvents(v.domainEvents)
+	updated.riskTier = tier
+	updated.validity = validity
+	return updated, nil
+}
+
+// ApplyRiskAssessment records the applicant's latest risk score and factors.
+// The tier is raised to the assessed tier, never lowered, and the validity
+// follows the resulting tier; an approval already granted is re-based onto
+// the new validity. Emits VerificationRiskTiered when anything changed; an
+// unchanged assessment returns the verification as is (immutable - returns
+// new copy).
+func (v IdentityVerification) ApplyRiskAssessment(
+	tier valueobject.RiskTier,
+	score int,
+	factors []string,
+	validity func(valueobject.RiskTier) time.Duration,
+	now time.Time,
+) (IdentityVerification, error) {
+	if v.status.Equal(valueobject.StatusRejected) || v.status.Equal(valueobject.StatusExpired) {
+		return IdentityVerification{}, fmt.Errorf("cannot assess risk of verification %s in status %s", v.id, v.status.String())
+	}
+
+	newTier := v.riskTier
+	if tier.Above(v.riskTier) {
+		newTier = tier
+	}
+	if newTier == v.riskTier && score == v.riskScore && slices.Equal(factors, v.riskFactors) {
+		return v, nil
+	}
+
+	updated := v
+	updated.domainEvents = copyEvents(v.domainEvents)
+	updated.riskScore = score
+	updated.riskFactors = append([]string(nil), factors...)
+	if newTier != v.riskTier {
+		newValidity := validity(newTier)
+		if newValidity <= 0 {
+			return IdentityVerification{}, fmt.Errorf("validity period must be positive")
+		}
+		updated.riskTier = newTier
+		updated.validity = newValidity
+		if v.expiresAt != nil {
+			expiresAt := v.expiresAt.Add(newValidity - v.validity)
+			updated.expiresAt = &expiresAt
+		}
+	}
+	updated.updatedAt = now
+	updated.version++
+	updated.domainEvents = append(updated.domainEvents,
+		event.NewVerificationRiskTiered(v.id, v.tenantID, updated.riskTier.String(), v.riskTier.String(),
+			score, updated.RiskFactors(), updated.ExpiresAt()))
+	return updated, nil
+}
+
+// LinkPreviousVerification records that this verification re-verifies the
+// applicant of an earlier one (immutable - returns new copy).
+func (v IdentityVerification) LinkPreviousVerification(previousID uuid.UUID) (IdentityVerification, error) {
+	if v.status != valueobject.StatusPending {
+		return IdentityVerification{}, fmt.Errorf("can only link verifications in PENDING status, current: %s", v.status.String())
 	}
+	if previousID == uuid.Nil || previousID == v.id {
+		return IdentityVerification{}, fmt.Errorf("invalid previous verification ID")
+	}
+	updated := v
+	updated.domainEvents = copyEvents(v.domainEvents)
+	updated.previousVerificationID = previousID
+	return updated, nil
+}
+
+// FlagExpiring records that the applicant has been warned of the upcoming
+// expiry and emits VerificationExpiring. Each approval is flagged once
+// (immutable - returns new copy).
+func (v IdentityVerification) FlagExpiring(now time.Time) (IdentityVerification, error) {
+	if !v.isValidApproval() {
+		return IdentityVerification{}, fmt.Errorf("can only flag approved verifications for expiry, current: %s", v.status.String())
+	}
+	if v.expiryNotifiedAt != nil {
+		return IdentityVerification{}, fmt.Errorf("verification %s was already flagged for expiry", v.id)
+	}
+
+	updated := v
+	updated.expiryNotifiedAt = &now
+	updated.updatedAt = now
+	updated.version++
+	updated.domainEvents = append(copyEvents(v.domainEvents),
+		event.NewVerificationExpiring(v.id, v.tenantID, v.applicantEmail, v.riskTier.String(), *v.expiresAt))
+	return updated, nil
+}
+
+// Expire moves an approved verification whose validity has lapsed to
+// EXPIRED and emits VerificationExpired (immutable - returns new copy).
+func (v IdentityVerification) Expire(now time.Time) (IdentityVerification, error) {
+	if !v.isValidApproval() {
+		return IdentityVerification{}, fmt.Errorf("can only expire approved verifications, current: %s", v.status.String())
+	}
+	if now.Before(*v.expiresAt) {
+		return IdentityVerification{}, fmt.Errorf("verification %s is valid until %s", v.id, v.expiresAt.Format(time.RFC3339))
+	}
+
+	updated := v
+	updated.status = valueobject.StatusExpired
+	updated.updatedAt = now
+	updated.version++
+	updated.domainEvents = append(copyEvents(v.domainEvents),
+		event.NewVerificationExpired(v.id, v.tenantID, v.applicantEmail, v.riskTier.String(), *v.expiresAt))
+	return updated, nil
+}
+
+// Erasure reasons record why an applicant's personal details were erased.
+const (
+	ErasureReasonRetention      = "RETENTION_EXPIRED"
+	ErasureReasonSubjectRequest = "SUBJECT_REQUEST"
+)
+
+// Erase removes the applicant's name, email and date of birth and emits
+// ApplicantDataErased. The country, checks, decisions and risk assessment are
+// kept as the audit record. Only rejected or expired verifications can be
+// erased: a valid approval backs a customer relationship that must stay on
+// record until it lapses. A subject request must carry the reference of the
+// verified request (immutable - returns new copy).
+func (v IdentityVerification) Erase(reason, requestReference string, now time.Time) (IdentityVerification, error) {
+	switch reason {
+	case ErasureReasonRetention:
+	case ErasureReasonSubjectRequest:
+		if requestReference == "" {
+			return IdentityVerification{}, fmt.Errorf("a subject request erasure requires the request reference")
+		}
+	default:
+		return IdentityVerification{}, fmt.Errorf("unknown erasure reason: %q", reason)
+	}
+	if v.erasedAt != nil {
+		return IdentityVerification{}, fmt.Errorf("verification %s was already erased", v.id)
+	}
+	if v.status != valueobject.StatusRejected && v.status != valueobject.StatusExpired {
+		return IdentityVerification{}, fmt.Errorf("can only erase rejected or expired verifications, current: %s", v.status.String())
+	}
+
+	updated := v
+	updated.applicantFirstName = ""
+	updated.applicantLastName = ""
+	updated.applicantEmail = ""
+	updated.applicantDOB = ""
+	updated.erasedAt = &now
+	updated.updatedAt = now
+	updated.version++
+	updated.domainEvents = append(copyEvents(v.domainEvents),
+		event.NewApplicantDataErased(v.id, v.tenantID, reason, requestReference))
+	return updated, nil
+}
+
+// IsErased reports whether the applicant's personal details have been erased.
+func (v IdentityVerification) IsErased() bool { return v.erasedAt != nil }
+
+// isValidApproval reports whether the verification holds an approval that
+// can lapse. A verification reopened for review keeps its expiry.
+func (v IdentityVerification) isValidApproval() bool {
+	return (v.status == valueobject.StatusApproved || v.status == valueobject.StatusReview) && v.expiresAt != nil
 }
 
 // StartProcessing transitions the verification from PENDING to IN_PROGRESS (immutable - returns new copy).
@@ -166,6 +386,9 @@ func (v IdentityVerification) CompleteCheck(
 			}
 			newChecks[i] = completed
 			found = true
+			updated.domainEvents = append(updated.domainEvents,
+				event.NewVerificationCheckCompleted(v.id, v.tenantID, c.ID(), c.CheckType().String(),
+					completed.Status().String(), completed.FailureReason(), completed.Provider()))
 		} else {
 			newChecks[i] = c
 		}
@@ -203,15 +426,146 @@ func (v IdentityVerification) UpdateCheckProvider(checkID uuid.UUID, provider, p
 	return updated, nil
 }
 
+// ReviewCheck moves a check to REVIEW after a screening hit or a borderline
+// provider result. An approved verification is reopened as REVIEW; an
+// in-progress one moves to REVIEW once its other checks have passed.
+// Reviewed checks are resolved through CompleteCheck. This is immutable -
+// returns a new copy.
+func (v IdentityVerification) ReviewCheck(checkID uuid.UUID, reason string, now time.Time) (IdentityVerification, error) {
+	if v.status != valueobject.StatusInProgress && v.status != valueobject.StatusApproved && v.status != valueobject.StatusReview {
+		return IdentityVerification{}, fmt.Errorf("cannot review checks of verification %s in status %s", v.id, v.status.String())
+	}
+
+	updated := v
+	updated.updatedAt = now
+	updated.version++
+	updated.domainEvents = copyEvents(v.domainEvents)
+
+	found := false
+	newChecks := make([]VerificationCheck, len(v.checks))
+	for i, c := range v.checks {
+		if c.ID() == checkID {
+			marked, err := c.MarkForReview(reason)
+			if err != nil {
+				return IdentityVerification{}, fmt.Errorf("failed to review check: %w", err)
+			}
+			newChecks[i] = marked
+			found = true
+		} else {
+			newChecks[i] = c
+		}
+	}
+	if !found {
+		return IdentityVerification{}, fmt.Errorf("check %s not found in verification %s", checkID, v.id)
+	}
+	updated.checks = newChecks
+
+	return updated.evaluateOverallStatus(), nil
+}
+
+// RecordScreeningHit emits ScreeningHit for a screening result of this
+// verification that returned matches. The check itself is moved to REVIEW
+// through ReviewCheck (immutable - returns new copy).
+func (v IdentityVerification) RecordScreeningHit(result ScreeningResult) (IdentityVerification, error) {
+	if result.VerificationID() != v.id {
+		return IdentityVerification{}, fmt.Errorf("screening result %s belongs to verification %s, not %s", result.ID(), result.VerificationID(), v.id)
+	}
+	if result.Outcome() != ScreeningOutcomeHit {
+		return IdentityVerification{}, fmt.Errorf("screening result %s has no matches", result.ID())
+	}
+
+	matches := make([]event.ScreeningHitMatch, 0, len(result.matches))
+	for _, m := range result.matches {
+		matches = append(matches, event.ScreeningHitMatch{Name: m.Name, EntryID: m.EntryID, Sources: m.Sources, Score: m.Score})
+	}
+	updated := v
+	updated.domainEvents = append(copyEvents(v.domainEvents),
+		event.NewScreeningHit(v.id, v.tenantID, result.CheckID(), result.ID(),
+			result.CheckType().String(), result.Provider(), result.Trigger(),
+			v.applicantFirstName, v.applicantLastName, v.applicantCountry, matches))
+	return updated, nil
+}
+
+// DuplicateReviewReason is the review reason of a DUPLICATE check.
+const DuplicateReviewReason = "possible_duplicate_identity"
+
+// FlagDuplicate holds the verification for review because its applicant
+// matches other verifications. A DUPLICATE check is added in REVIEW, or an
+// earlier one that an analyst cleared is reopened; the verification cannot
+// be approved until it is resolved. Emits DuplicateIdentityDetected. This is
+// immutable - returns a new copy.
+func (v IdentityVerification) FlagDuplicate(matches []DuplicateMatch, now time.Time) (IdentityVerification, error) {
+	if len(matches) == 0 {
+		return IdentityVerification{}, fmt.Errorf("at least one duplicate match is required")
+	}
+
+	updated := v
+	updated.domainEvents = copyEvents(v.domainEvents)
+
+	var duplicate *VerificationCheck
+	for _, c := range v.checks {
+		if c.CheckType().Equal(valueobject.CheckTypeDuplicate) {
+			dc := c
+			duplicate = &dc
+			break
+		}
+	}
+	if duplicate == nil {
+		check, err := NewVerificationCheck(valueobject.CheckTypeDuplicate).SetInProgress()
+		if err != nil {
+			return IdentityVerification{}, err
+		}
+		updated.checks = append(v.Checks(), check)
+		duplicate = &check
+	}
+
+	if !duplicate.Status().Equal(valueobject.StatusReview) {
+		var err error
+		updated, err = updated.ReviewCheck(duplicate.ID(), DuplicateReviewReason, now)
+		if err != nil {
+			return IdentityVerification{}, err
+		}
+	} else {
+		updated.updatedAt = now
+		updated.version++
+	}
+
+	matchedIDs := make([]uuid.UUID, 0, len(matches))
+	seen := make(map[string]bool)
+	var reasons []string
+	for _, m := range matches {
+		matchedIDs = append(matchedIDs, m.VerificationID)
+		for _, r := range m.Reasons {
+			if !seen[r] {
+				seen[r] = true
+				reasons = append(reasons, r)
+			}
+		}
+	}
+	updated.domainEvents = append(updated.domainEvents,
+		event.NewDuplicateIdentityDetected(v.id, v.tenantID, matchedIDs, reasons))
+	return updated, nil
+}
+
 // evaluateOverallStatus determines the aggregate status based on individual check results.
 // If any check is REJECTED -> overall REJECTED.
 // If all checks are APPROVED -> overall APPROVED.
+// If every other check is decided and some await review -> overall REVIEW.
 // Otherwise the status remains unchanged.
 func (v IdentityVerification) evaluateOverallStatus() IdentityVerification {
 	allTerminal := true
 	allApproved := true
+	var reviewed *VerificationCheck
 
 	for _, c := range v.checks {
+		if c.Status().Equal(valueobject.StatusReview) {
+			if reviewed == nil {
+				rc := c
+				reviewed = &rc
+			}
+			allApproved = false
+			continue
+		}
 		if !c.Status().IsTerminal() {
 			allTerminal = false
 			allApproved = false
@@ -222,7 +576,7 @@ func (v IdentityVerification) evaluateOverallStatus() IdentityVerification {
 			result := v
 			result.status = valueobject.StatusRejected
 			result.domainEvents = append(result.domainEvents,
-				event.NewVerificationRejected(v.id, v.tenantID, v.applicantEmail))
+				event.NewVerificationRejected(v.id, v.tenantID, v.applicantEmail, c.CheckType().String(), c.FailureReason()))
 			return result
 		}
 		if !c.Status().Equal(valueobject.StatusApproved) {
@@ -233,8 +587,21 @@ func (v IdentityVerification) evaluateOverallStatus() IdentityVerification {
 	if allTerminal && allApproved {
 		result := v
 		result.status = valueobject.StatusApproved
+		// Clearing a review does not extend the original approval.
+		if result.expiresAt == nil {
+			expiresAt := v.updatedAt.Add(v.validity)
+			result.expiresAt = &expiresAt
+		}
 		result.domainEvents = append(result.domainEvents,
-			event.NewVerificationCompleted(v.id, v.tenantID, v.applicantEmail))
+			event.NewVerificationCompleted(v.id, v.tenantID, v.applicantFirstName, v.applicantLastName, v.applicantEmail, v.riskTier.String(), v.previousVerificationID, *result.expiresAt))
+		return result
+	}
+
+	if allTerminal && reviewed != nil && v.status != valueobject.StatusReview {
+		result := v
+		result.status = valueobject.StatusReview
+		result.domainEvents = append(result.domainEvents,
+			event.NewVerificationFlaggedForReview(v.id, v.tenantID, reviewed.ID(), reviewed.CheckType().String(), reviewed.FailureReason()))
 		return result
 	}
 
@@ -261,11 +628,45 @@ func (v IdentityVerification) ApplicantEmail() string                 { return v
 func (v IdentityVerification) ApplicantDOB() string                   { return v.applicantDOB }
 func (v IdentityVerification) ApplicantCountry() string               { return v.applicantCountry }
 func (v IdentityVerification) Status() valueobject.VerificationStatus { return v.status }
+func (v IdentityVerification) RiskTier() valueobject.RiskTier         { return v.riskTier }
+func (v IdentityVerification) RiskScore() int                         { return v.riskScore }
+func (v IdentityVerification) Validity() time.Duration                { return v.validity }
+func (v IdentityVerification) PreviousVerificationID() uuid.UUID      { return v.previousVerificationID }
 func (v IdentityVerification) Version() int                           { return v.version }
 func (v IdentityVerification) CreatedAt() time.Time                   { return v.createdAt }
 func (v IdentityVerification) UpdatedAt() time.Time                   { return v.updatedAt }
 func (v IdentityVerification) DomainEvents() []events.DomainEvent     { return v.domainEvents }
 
+func (v IdentityVerification) ExpiresAt() *time.Time {
+	if v.expiresAt == nil {
+		return nil
+	}
+	t := *v.expiresAt
+	return &t
+}
+
+func (v IdentityVerification) ExpiryNotifiedAt() *time.Time {
+	if v.expiryNotifiedAt == nil {
+		return nil
+	}
+	t := *v.expiryNotifiedAt
+	return &t
+}
+
+func (v IdentityVerification) ErasedAt() *time.Time {
+	if v.erasedAt == nil {
+		return nil
+	}
+	t := *v.erasedAt
+	return &t
+}
+
+func (v IdentityVerification) RiskFactors() []string {
+	result := make([]string, len(v.riskFactors))
+	copy(result, v.riskFactors)
+	return result
+}
+
 func (v IdentityVerification) Checks() []VerificationCheck {
 	result := make([]VerificationCheck, len(v.checks))
 	copy(result, v.checks)
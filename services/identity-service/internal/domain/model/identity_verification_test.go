@@ -1,6 +1,7 @@
 package model_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/bibbank/bib/services/identity-service/internal/domain/event"
 	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
 	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
 )
@@ -278,6 +280,7 @@ func TestIdentityVerification_Reconstruct(t *testing.T) {
 		"Jane", "Smith", "jane@example.com", "1985-06-20", "GB",
 		valueobject.StatusApproved,
 		[]model.VerificationCheck{check},
+		valueobject.RiskTierMedium, 40, []string{"pep_hit"}, 730*24*time.Hour, nil, nil, nil, uuid.Nil,
 		3, createdAt, updatedAt,
 	)
 
@@ -290,6 +293,8 @@ func TestIdentityVerification_Reconstruct(t *testing.T) {
 	assert.Equal(t, "GB", v.ApplicantCountry())
 	assert.True(t, v.Status().Equal(valueobject.StatusApproved))
 	assert.Len(t, v.Checks(), 1)
+	assert.Equal(t, 40, v.RiskScore())
+	assert.Equal(t, []string{"pep_hit"}, v.RiskFactors())
 	assert.Equal(t, 3, v.Version())
 	assert.Equal(t, createdAt, v.CreatedAt())
 	assert.Equal(t, updatedAt, v.UpdatedAt())
@@ -513,3 +518,552 @@ func TestVerificationCheck_SetProvider(t *testing.T) {
 	assert.Empty(t, check.Provider())
 	assert.Empty(t, check.ProviderReference())
 }
+
+func TestNewIdentityVerification_AdditionalScreeningChecks(t *testing.T) {
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "US",
+		valueobject.CheckTypeSanctions, valueobject.CheckTypePEP, valueobject.CheckTypeSanctions)
+	require.NoError(t, err)
+
+	var types []string
+	for _, c := range v.Checks() {
+		types = append(types, c.CheckType().String())
+	}
+	assert.Equal(t, []string{"DOCUMENT", "SELFIE", "WATCHLIST", "SANCTIONS", "PEP"}, types)
+}
+
+func approvedWithSanctionsCheck(t *testing.T) (model.IdentityVerification, uuid.UUID) {
+	t.Helper()
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "US",
+		valueobject.CheckTypeSanctions)
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	v, err = v.StartProcessing(now)
+	require.NoError(t, err)
+
+	var sanctionsID uuid.UUID
+	for _, c := range v.Checks() {
+		v, err = v.CompleteCheck(c.ID(), valueobject.StatusApproved, "", now)
+		require.NoError(t, err)
+		if c.CheckType() == valueobject.CheckTypeSanctions {
+			sanctionsID = c.ID()
+		}
+	}
+	require.True(t, v.Status().Equal(valueobject.StatusApproved))
+	return v, sanctionsID
+}
+
+func TestIdentityVerification_ReviewCheck_ReopensApprovedVerification(t *testing.T) {
+	v, checkID := approvedWithSanctionsCheck(t)
+	eventCount := len(v.DomainEvents())
+
+	reviewed, err := v.ReviewCheck(checkID, "sanctions hit", time.Now().UTC())
+	require.NoError(t, err)
+
+	assert.True(t, reviewed.Status().Equal(valueobject.StatusReview))
+	for _, c := range reviewed.Checks() {
+		if c.ID() == checkID {
+			assert.True(t, c.Status().Equal(valueobject.StatusReview))
+			assert.Equal(t, "sanctions hit", c.FailureReason())
+			assert.Nil(t, c.CompletedAt())
+		}
+	}
+	events := reviewed.DomainEvents()
+	require.Len(t, events, eventCount+1)
+	assert.Equal(t, "identity.verification.flagged_for_review", events[len(events)-1].EventType())
+
+	// The original is unchanged.
+	assert.True(t, v.Status().Equal(valueobject.StatusApproved))
+
+	// An analyst clearing the check approves the verification again.
+	cleared, err := reviewed.CompleteCheck(checkID, valueobject.StatusApproved, "", time.Now().UTC())
+	require.NoError(t, err)
+	assert.True(t, cleared.Status().Equal(valueobject.StatusApproved))
+}
+
+func TestIdentityVerification_ReviewCheck_InProgressStaysInProgress(t *testing.T) {
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "US",
+		valueobject.CheckTypePEP)
+	require.NoError(t, err)
+	v, err = v.StartProcessing(time.Now().UTC())
+	require.NoError(t, err)
+	checks := v.Checks()
+	pep := checks[len(checks)-1]
+
+	reviewed, err := v.ReviewCheck(pep.ID(), "pep hit", time.Now().UTC())
+	require.NoError(t, err)
+	assert.True(t, reviewed.Status().Equal(valueobject.StatusInProgress))
+	assert.Len(t, reviewed.DomainEvents(), len(v.DomainEvents()))
+}
+
+func TestIdentityVerification_ReviewCheck_RejectedVerification_Error(t *testing.T) {
+	v, checkID := approvedWithSanctionsCheck(t)
+	v = model.Reconstruct(v.ID(), v.TenantID(), "John", "Doe", "john@example.com", "1990-01-15", "US",
+		valueobject.StatusRejected, v.Checks(), v.RiskTier(), v.RiskScore(), v.RiskFactors(), v.Validity(), v.ExpiresAt(), v.ExpiryNotifiedAt(), v.ErasedAt(),
+		v.PreviousVerificationID(), v.Version(), v.CreatedAt(), v.UpdatedAt())
+
+	_, err := v.ReviewCheck(checkID, "hit", time.Now().UTC())
+	assert.Error(t, err)
+}
+
+func TestIdentityVerification_RecordScreeningHit(t *testing.T) {
+	v, checkID := approvedWithSanctionsCheck(t)
+	matches := []model.ScreeningMatch{{Name: "John Doe", Sources: []string{model.WatchlistOFACSDN}, EntryID: "1234", Score: 0.95}}
+	result, err := model.NewScreeningResult(v.TenantID(), v.ID(), checkID, valueobject.CheckTypeSanctions,
+		"watchlist", "OFAC_SDN@abc", model.ScreeningTriggerRescreen, matches, time.Now().UTC())
+	require.NoError(t, err)
+
+	recorded, err := v.RecordScreeningHit(result)
+	require.NoError(t, err)
+
+	events := recorded.DomainEvents()
+	require.Len(t, events, len(v.DomainEvents())+1)
+	hit, ok := events[len(events)-1].(event.ScreeningHit)
+	require.True(t, ok)
+	assert.Equal(t, "identity.screening.hit", hit.EventType())
+	assert.Equal(t, result.ID(), hit.ScreeningResultID)
+	assert.Equal(t, checkID, hit.CheckID)
+	assert.Equal(t, "SANCTIONS", hit.CheckType)
+	assert.Equal(t, model.ScreeningTriggerRescreen, hit.Trigger)
+	assert.Equal(t, "John", hit.ApplicantFirstName)
+	require.Len(t, hit.Matches, 1)
+	assert.Equal(t, "1234", hit.Matches[0].EntryID)
+
+	clear, err := model.NewScreeningResult(v.TenantID(), v.ID(), checkID, valueobject.CheckTypeSanctions,
+		"watchlist", "", model.ScreeningTriggerRescreen, nil, time.Now().UTC())
+	require.NoError(t, err)
+	_, err = v.RecordScreeningHit(clear)
+	assert.Error(t, err)
+}
+
+func TestNewScreeningResult(t *testing.T) {
+	now := time.Now().UTC()
+
+	clear, err := model.NewScreeningResult(uuid.New(), uuid.New(), uuid.New(), valueobject.CheckTypePEP,
+		"complyadvantage", "123", model.ScreeningTriggerInitial, nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, model.ScreeningOutcomeClear, clear.Outcome())
+
+	hit, err := model.NewScreeningResult(uuid.New(), uuid.New(), uuid.New(), valueobject.CheckTypeSanctions,
+		"complyadvantage", "456", model.ScreeningTriggerRescreen,
+		[]model.ScreeningMatch{{Name: "John Doe", Score: 0.92, Categories: []string{"sanction"}}}, now)
+	require.NoError(t, err)
+	assert.Equal(t, model.ScreeningOutcomeHit, hit.Outcome())
+
+	_, err = model.NewScreeningResult(uuid.New(), uuid.New(), uuid.New(), valueobject.CheckTypeDocument,
+		"complyadvantage", "789", model.ScreeningTriggerInitial, nil, now)
+	assert.Error(t, err)
+}
+
+func approvedHighRisk(t *testing.T, approvedAt time.Time) model.IdentityVerification {
+	t.Helper()
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "US")
+	require.NoError(t, err)
+	v, err = v.AssignRiskTier(valueobject.RiskTierHigh, 365*24*time.Hour)
+	require.NoError(t, err)
+	v, err = v.StartProcessing(approvedAt)
+	require.NoError(t, err)
+	for _, c := range v.Checks() {
+		v, err = v.CompleteCheck(c.ID(), valueobject.StatusApproved, "", approvedAt)
+		require.NoError(t, err)
+	}
+	require.True(t, v.Status().Equal(valueobject.StatusApproved))
+	return v
+}
+
+func TestIdentityVerification_DefaultsToMediumRisk(t *testing.T) {
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "US")
+	require.NoError(t, err)
+
+	assert.True(t, v.RiskTier().Equal(valueobject.RiskTierMedium))
+	assert.Equal(t, valueobject.RiskTierMedium.DefaultValidity(), v.Validity())
+	assert.Nil(t, v.ExpiresAt())
+}
+
+func TestIdentityVerification_ApprovalSetsExpiryFromRiskTier(t *testing.T) {
+	approvedAt := time.Date(2025, time.January, 10, 9, 0, 0, 0, time.UTC)
+	v := approvedHighRisk(t, approvedAt)
+
+	require.NotNil(t, v.ExpiresAt())
+	assert.Equal(t, approvedAt.Add(365*24*time.Hour), *v.ExpiresAt())
+}
+
+func TestIdentityVerification_AssignRiskTier_AfterProcessing_Error(t *testing.T) {
+	v := approvedHighRisk(t, time.Now().UTC())
+
+	_, err := v.AssignRiskTier(valueobject.RiskTierLow, 24*time.Hour)
+	assert.Error(t, err)
+}
+
+func TestIdentityVerification_LinkPreviousVerification(t *testing.T) {
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "US")
+	require.NoError(t, err)
+	previousID := uuid.New()
+
+	linked, err := v.LinkPreviousVerification(previousID)
+	require.NoError(t, err)
+	assert.Equal(t, previousID, linked.PreviousVerificationID())
+
+	_, err = v.LinkPreviousVerification(v.ID())
+	assert.Error(t, err)
+}
+
+func TestIdentityVerification_FlagExpiring_OnlyOnce(t *testing.T) {
+	approvedAt := time.Date(2025, time.January, 10, 9, 0, 0, 0, time.UTC)
+	v := approvedHighRisk(t, approvedAt)
+	eventCount := len(v.DomainEvents())
+	now := approvedAt.Add(340 * 24 * time.Hour)
+
+	flagged, err := v.FlagExpiring(now)
+	require.NoError(t, err)
+	assert.True(t, flagged.Status().Equal(valueobject.StatusApproved))
+	require.NotNil(t, flagged.ExpiryNotifiedAt())
+	assert.Equal(t, now, *flagged.ExpiryNotifiedAt())
+	require.Len(t, flagged.DomainEvents(), eventCount+1)
+	assert.Equal(t, "identity.verification.expiring", flagged.DomainEvents()[eventCount].EventType())
+
+	_, err = flagged.FlagExpiring(now.Add(time.Hour))
+	assert.Error(t, err)
+}
+
+func TestIdentityVerification_Expire(t *testing.T) {
+	approvedAt := time.Date(2025, time.January, 10, 9, 0, 0, 0, time.UTC)
+	v := approvedHighRisk(t, approvedAt)
+	eventCount := len(v.DomainEvents())
+
+	_, err := v.Expire(approvedAt.Add(364 * 24 * time.Hour))
+	assert.Error(t, err, "verification is still valid")
+
+	expired, err := v.Expire(approvedAt.Add(366 * 24 * time.Hour))
+	require.NoError(t, err)
+	assert.True(t, expired.Status().Equal(valueobject.StatusExpired))
+	require.Len(t, expired.DomainEvents(), eventCount+1)
+	assert.Equal(t, "identity.verification.expired", expired.DomainEvents()[eventCount].EventType())
+
+	_, err = expired.Expire(approvedAt.Add(367 * 24 * time.Hour))
+	assert.Error(t, err)
+}
+
+func TestIdentityVerification_FlagExpiring_NotApproved_Error(t *testing.T) {
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "US")
+	require.NoError(t, err)
+
+	_, err = v.FlagExpiring(time.Now().UTC())
+	assert.Error(t, err)
+}
+
+func TestIdentityVerification_ReviewCheck_InProgressMovesToReviewOnceOthersPass(t *testing.T) {
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "US")
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	v, err = v.StartProcessing(now)
+	require.NoError(t, err)
+	checks := v.Checks()
+
+	v, err = v.ReviewCheck(checks[0].ID(), "onfido_result_consider", now)
+	require.NoError(t, err)
+	assert.True(t, v.Status().Equal(valueobject.StatusInProgress))
+
+	v, err = v.CompleteCheck(checks[1].ID(), valueobject.StatusApproved, "", now)
+	require.NoError(t, err)
+	v, err = v.CompleteCheck(checks[2].ID(), valueobject.StatusApproved, "", now)
+	require.NoError(t, err)
+
+	assert.True(t, v.Status().Equal(valueobject.StatusReview))
+	assert.Nil(t, v.ExpiresAt())
+	events := v.DomainEvents()
+	assert.Equal(t, "identity.verification.flagged_for_review", events[len(events)-1].EventType())
+
+	// Rejecting the reviewed check rejects the verification.
+	rejected, err := v.CompleteCheck(checks[0].ID(), valueobject.StatusRejected, "document altered", now)
+	require.NoError(t, err)
+	assert.True(t, rejected.Status().Equal(valueobject.StatusRejected))
+}
+
+func riskValidity(tier valueobject.RiskTier) time.Duration {
+	return tier.DefaultValidity()
+}
+
+func TestIdentityVerification_ApplyRiskAssessment_RaisesTier(t *testing.T) {
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "US")
+	require.NoError(t, err)
+	now := time.Now().UTC()
+
+	assessed, err := v.ApplyRiskAssessment(valueobject.RiskTierHigh, 70, []string{"sanctions_hit"}, riskValidity, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, valueobject.RiskTierHigh, assessed.RiskTier())
+	assert.Equal(t, 70, assessed.RiskScore())
+	assert.Equal(t, []string{"sanctions_hit"}, assessed.RiskFactors())
+	assert.Equal(t, valueobject.RiskTierHigh.DefaultValidity(), assessed.Validity())
+	assert.Equal(t, v.Version()+1, assessed.Version())
+
+	evt, ok := assessed.DomainEvents()[len(assessed.DomainEvents())-1].(event.VerificationRiskTiered)
+	require.True(t, ok)
+	assert.Equal(t, "HIGH", evt.RiskTier)
+	assert.Equal(t, "MEDIUM", evt.PreviousRiskTier)
+	assert.Equal(t, 70, evt.RiskScore)
+	assert.Equal(t, valueobject.RiskTierMedium, v.RiskTier(), "original should be unchanged")
+}
+
+func TestIdentityVerification_ApplyRiskAssessment_NeverLowersTier(t *testing.T) {
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "US")
+	require.NoError(t, err)
+
+	assessed, err := v.ApplyRiskAssessment(valueobject.RiskTierLow, 10, nil, riskValidity, time.Now().UTC())
+	require.NoError(t, err)
+
+	assert.Equal(t, valueobject.RiskTierMedium, assessed.RiskTier())
+	assert.Equal(t, 10, assessed.RiskScore())
+	assert.Equal(t, valueobject.RiskTierMedium.DefaultValidity(), assessed.Validity())
+}
+
+func TestIdentityVerification_ApplyRiskAssessment_UnchangedIsNoOp(t *testing.T) {
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "US")
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	v, err = v.ApplyRiskAssessment(valueobject.RiskTierMedium, 35, []string{"pep_hit"}, riskValidity, now)
+	require.NoError(t, err)
+
+	again, err := v.ApplyRiskAssessment(valueobject.RiskTierMedium, 35, []string{"pep_hit"}, riskValidity, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, v.Version(), again.Version())
+	assert.Len(t, again.DomainEvents(), len(v.DomainEvents()))
+}
+
+func TestIdentityVerification_ApplyRiskAssessment_RebasesApprovalExpiry(t *testing.T) {
+	v, _ := approvedWithSanctionsCheck(t)
+	expiresAt := *v.ExpiresAt()
+
+	assessed, err := v.ApplyRiskAssessment(valueobject.RiskTierHigh, 60, []string{"high_risk_country"}, riskValidity, time.Now().UTC())
+	require.NoError(t, err)
+
+	want := expiresAt.Add(valueobject.RiskTierHigh.DefaultValidity() - valueobject.RiskTierMedium.DefaultValidity())
+	require.NotNil(t, assessed.ExpiresAt())
+	assert.Equal(t, want, *assessed.ExpiresAt())
+}
+
+func TestIdentityVerification_ApplyRiskAssessment_RejectedVerification_Error(t *testing.T) {
+	v, _ := approvedWithSanctionsCheck(t)
+	v = model.Reconstruct(v.ID(), v.TenantID(), "John", "Doe", "john@example.com", "1990-01-15", "US",
+		valueobject.StatusRejected, v.Checks(), v.RiskTier(), v.RiskScore(), v.RiskFactors(), v.Validity(), v.ExpiresAt(), v.ExpiryNotifiedAt(), v.ErasedAt(),
+		v.PreviousVerificationID(), v.Version(), v.CreatedAt(), v.UpdatedAt())
+
+	_, err := v.ApplyRiskAssessment(valueobject.RiskTierHigh, 70, []string{"sanctions_hit"}, riskValidity, time.Now().UTC())
+	assert.Error(t, err)
+}
+
+func duplicateChecks(v model.IdentityVerification) []model.VerificationCheck {
+	var out []model.VerificationCheck
+	for _, c := range v.Checks() {
+		if c.CheckType().Equal(valueobject.CheckTypeDuplicate) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func TestIdentityVerification_FlagDuplicate_HoldsApprovalForReview(t *testing.T) {
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "US")
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	v, err = v.StartProcessing(now)
+	require.NoError(t, err)
+	matched := uuid.New()
+
+	flagged, err := v.FlagDuplicate([]model.DuplicateMatch{
+		{VerificationID: matched, Reasons: []string{"document_number", "name_dob"}, Score: 1},
+	}, now)
+	require.NoError(t, err)
+
+	dups := duplicateChecks(flagged)
+	require.Len(t, dups, 1)
+	assert.True(t, dups[0].Status().Equal(valueobject.StatusReview))
+	assert.Equal(t, model.DuplicateReviewReason, dups[0].FailureReason())
+	events := flagged.DomainEvents()
+	detected, ok := events[len(events)-1].(event.DuplicateIdentityDetected)
+	require.True(t, ok)
+	assert.Equal(t, []uuid.UUID{matched}, detected.MatchedVerificationIDs)
+	assert.Equal(t, []string{"document_number", "name_dob"}, detected.Reasons)
+
+	// Passing every provider check leaves the verification in REVIEW, not approved.
+	for _, c := range flagged.Checks() {
+		if c.CheckType().Equal(valueobject.CheckTypeDuplicate) {
+			continue
+		}
+		flagged, err = flagged.CompleteCheck(c.ID(), valueobject.StatusApproved, "", now)
+		require.NoError(t, err)
+	}
+	assert.True(t, flagged.Status().Equal(valueobject.StatusReview))
+}
+
+func TestIdentityVerification_FlagDuplicate_ReusesCheck(t *testing.T) {
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "US")
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	v, err = v.StartProcessing(now)
+	require.NoError(t, err)
+
+	v, err = v.FlagDuplicate([]model.DuplicateMatch{{VerificationID: uuid.New(), Reasons: []string{"name_dob"}}}, now)
+	require.NoError(t, err)
+	version := v.Version()
+	v, err = v.FlagDuplicate([]model.DuplicateMatch{{VerificationID: uuid.New(), Reasons: []string{"selfie"}}}, now)
+	require.NoError(t, err)
+
+	assert.Len(t, duplicateChecks(v), 1)
+	assert.Equal(t, version+1, v.Version())
+	events := v.DomainEvents()
+	assert.Equal(t, "identity.verification.duplicate_detected", events[len(events)-1].EventType())
+}
+
+func TestIdentityVerification_FlagDuplicate_ReopensApprovedVerification(t *testing.T) {
+	v, _ := approvedWithSanctionsCheck(t)
+
+	flagged, err := v.FlagDuplicate([]model.DuplicateMatch{{VerificationID: uuid.New(), Reasons: []string{"selfie"}}}, time.Now().UTC())
+	require.NoError(t, err)
+
+	assert.True(t, flagged.Status().Equal(valueobject.StatusReview))
+}
+
+func TestIdentityVerification_FlagDuplicate_RequiresMatches(t *testing.T) {
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "US")
+	require.NoError(t, err)
+
+	_, err = v.FlagDuplicate(nil, time.Now().UTC())
+	assert.Error(t, err)
+}
+
+func TestHashDocumentNumber_NormalizesAndScopesToTenant(t *testing.T) {
+	tenantID := uuid.New()
+
+	assert.Equal(t, model.HashDocumentNumber(tenantID, "ab 123-456"), model.HashDocumentNumber(tenantID, "AB123456"))
+	assert.NotEqual(t, model.HashDocumentNumber(tenantID, "AB123456"), model.HashDocumentNumber(uuid.New(), "AB123456"))
+	assert.Empty(t, model.HashDocumentNumber(tenantID, " - "))
+}
+
+func TestIdentityVerification_CompleteCheck_EmitsLifecycleEvents(t *testing.T) {
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "GB")
+	require.NoError(t, err)
+	initiated, ok := v.DomainEvents()[0].(event.VerificationInitiated)
+	require.True(t, ok)
+	assert.Equal(t, "GB", initiated.ApplicantCountry)
+	assert.Equal(t, []string{"DOCUMENT", "SELFIE", "WATCHLIST"}, initiated.CheckTypes)
+
+	now := time.Now().UTC()
+	v, err = v.StartProcessing(now)
+	require.NoError(t, err)
+	checks := v.Checks()
+
+	v, err = v.CompleteCheck(checks[0].ID(), valueobject.StatusApproved, "", now)
+	require.NoError(t, err)
+	evts := v.DomainEvents()
+	completed, ok := evts[len(evts)-1].(event.VerificationCheckCompleted)
+	require.True(t, ok)
+	assert.Equal(t, checks[0].ID(), completed.CheckID)
+	assert.Equal(t, "DOCUMENT", completed.CheckType)
+	assert.Equal(t, "APPROVED", completed.Status)
+
+	v, err = v.CompleteCheck(checks[1].ID(), valueobject.StatusRejected, "face_mismatch", now)
+	require.NoError(t, err)
+	evts = v.DomainEvents()
+	require.GreaterOrEqual(t, len(evts), 2)
+	assert.Equal(t, "identity.verification.check_completed", evts[len(evts)-2].EventType())
+	rejected, ok := evts[len(evts)-1].(event.VerificationRejected)
+	require.True(t, ok)
+	assert.Equal(t, "SELFIE", rejected.RejectedCheckType)
+	assert.Equal(t, "face_mismatch", rejected.FailureReason)
+}
+
+func rejectedVerification(t *testing.T) model.IdentityVerification {
+	t.Helper()
+	v, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "GB")
+	require.NoError(t, err)
+	v, err = v.StartProcessing(time.Now().UTC())
+	require.NoError(t, err)
+	v, err = v.CompleteCheck(v.Checks()[0].ID(), valueobject.StatusRejected, "document_expired", time.Now().UTC())
+	require.NoError(t, err)
+	return v
+}
+
+func TestIdentityVerification_Erase_KeepsAuditRecord(t *testing.T) {
+	v := rejectedVerification(t)
+	now := time.Now().UTC()
+
+	erased, err := v.Erase(model.ErasureReasonSubjectRequest, "DSR-42", now)
+	require.NoError(t, err)
+
+	assert.Empty(t, erased.ApplicantFirstName())
+	assert.Empty(t, erased.ApplicantLastName())
+	assert.Empty(t, erased.ApplicantEmail())
+	assert.Empty(t, erased.ApplicantDOB())
+	assert.Equal(t, "GB", erased.ApplicantCountry())
+	assert.True(t, erased.Status().Equal(valueobject.StatusRejected))
+	assert.Equal(t, v.Checks(), erased.Checks())
+	assert.True(t, erased.IsErased())
+	assert.Equal(t, now, *erased.ErasedAt())
+	assert.Equal(t, v.Version()+1, erased.Version())
+	assert.Equal(t, "john@example.com", v.ApplicantEmail(), "original must be unchanged")
+
+	evts := erased.DomainEvents()
+	evt, ok := evts[len(evts)-1].(event.ApplicantDataErased)
+	require.True(t, ok)
+	assert.Equal(t, model.ErasureReasonSubjectRequest, evt.Reason)
+	assert.Equal(t, "DSR-42", evt.RequestReference)
+
+	_, err = erased.Erase(model.ErasureReasonRetention, "", now)
+	assert.Error(t, err, "already erased")
+}
+
+func TestIdentityVerification_Erase_Rejects(t *testing.T) {
+	now := time.Now().UTC()
+
+	_, err := rejectedVerification(t).Erase(model.ErasureReasonSubjectRequest, "", now)
+	assert.Error(t, err, "subject request without reference")
+
+	_, err = rejectedVerification(t).Erase("FORGOTTEN", "", now)
+	assert.Error(t, err, "unknown reason")
+
+	approved, _ := approvedWithSanctionsCheck(t)
+	_, err = approved.Erase(model.ErasureReasonRetention, "", now)
+	assert.Error(t, err, "valid approval")
+
+	pending, err := model.NewIdentityVerification(uuid.New(), "John", "Doe", "john@example.com", "1990-01-15", "GB")
+	require.NoError(t, err)
+	_, err = pending.Erase(model.ErasureReasonRetention, "", now)
+	assert.Error(t, err, "in progress")
+}
+
+func TestIdentityDocument_MarkErased_IgnoresRetention(t *testing.T) {
+	now := time.Now().UTC()
+	d, err := model.NewIdentityDocument(uuid.New(), uuid.New(), uuid.New(), valueobject.DocumentTypeSelfie,
+		"image/png", 10, strings.Repeat("a", 64), 24*time.Hour, now)
+	require.NoError(t, err)
+
+	_, err = d.MarkPurged(now)
+	require.Error(t, err)
+
+	erased, err := d.MarkErased(now)
+	require.NoError(t, err)
+	assert.True(t, erased.IsPurged())
+	assert.Equal(t, d.SHA256(), erased.SHA256())
+
+	_, err = erased.MarkErased(now)
+	assert.Error(t, err)
+}
+
+func TestApplicantFingerprint_Anonymize(t *testing.T) {
+	tenantID := uuid.New()
+	now := time.Now().UTC()
+	f, err := model.NewApplicantFingerprint(tenantID, uuid.New(), uuid.Nil, "John", "Doe", "1990-01-15", "AB123456", now)
+	require.NoError(t, err)
+	f = f.WithSelfieEmbedding([]float32{0.1, 0.2}, now).
+		WithMatches([]model.DuplicateMatch{{VerificationID: uuid.New(), Reasons: []string{"document_number"}, Score: 1}}, now)
+
+	anon := f.Anonymize(now)
+	assert.Empty(t, anon.FirstName())
+	assert.Empty(t, anon.LastName())
+	assert.Empty(t, anon.DateOfBirth())
+	assert.Empty(t, anon.SelfieEmbedding())
+	assert.Equal(t, model.HashDocumentNumber(tenantID, "AB123456"), anon.DocumentNumberHash())
+	assert.Equal(t, f.Matches(), anon.Matches())
+}
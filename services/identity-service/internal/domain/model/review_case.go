@@ -0,0 +1,264 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/event"
+)
+
+// Review case statuses.
+const (
+	ReviewStatusOpen                   = "OPEN"
+	ReviewStatusClaimed                = "CLAIMED"
+	ReviewStatusAwaitingSecondApproval = "AWAITING_SECOND_APPROVAL"
+	ReviewStatusDecided                = "DECIDED"
+)
+
+// Review decision outcomes.
+const (
+	ReviewOutcomeApprove = "APPROVE"
+	ReviewOutcomeReject  = "REJECT"
+)
+
+// ReviewDecision is one analyst's recorded decision on a review case.
+type ReviewDecision struct {
+	DecidedAt time.Time `json:"decided_at"`
+	Outcome   string    `json:"outcome"`
+	Reason    string    `json:"reason"`
+	AnalystID uuid.UUID `json:"analyst_id"`
+}
+
+// ReviewCase queues a verification in REVIEW for an analyst. An analyst
+// claims the case, or is assigned it, and records a decision with a reason.
+//
+// With four-eyes review an approval must be confirmed by a second, different
+// analyst: the first approval moves the case to AWAITING_SECOND_APPROVAL and
+// releases it back to the queue. A rejection is final at any stage, since it
+// is the conservative outcome. The case is due within its SLA of being opened.
+type ReviewCase struct {
+	openedAt         time.Time
+	dueAt            time.Time
+	updatedAt        time.Time
+	claimedAt        *time.Time
+	decidedAt        *time.Time
+	status           string
+	reason           string
+	outcome          string
+	decisions        []ReviewDecision
+	domainEvents     []events.DomainEvent
+	version          int
+	requiresFourEyes bool
+	id               uuid.UUID
+	tenantID         uuid.UUID
+	verificationID   uuid.UUID
+	assigneeID       uuid.UUID
+}
+
+// NewReviewCase opens a review case for a verification, due sla after now.
+func NewReviewCase(
+	tenantID, verificationID uuid.UUID,
+	reason string,
+	requiresFourEyes bool,
+	sla time.Duration,
+	now time.Time,
+) (ReviewCase, error) {
+	if tenantID == uuid.Nil {
+		return ReviewCase{}, fmt.Errorf("tenant ID is required")
+	}
+	if verificationID == uuid.Nil {
+		return ReviewCase{}, fmt.Errorf("verification ID is required")
+	}
+	if sla <= 0 {
+		return ReviewCase{}, fmt.Errorf("review SLA must be positive")
+	}
+
+	c := ReviewCase{
+		id:               uuid.New(),
+		tenantID:         tenantID,
+		verificationID:   verificationID,
+		reason:           reason,
+		status:           ReviewStatusOpen,
+		requiresFourEyes: requiresFourEyes,
+		openedAt:         now,
+		dueAt:            now.Add(sla),
+		updatedAt:        now,
+		version:          1,
+	}
+	c.domainEvents = append(c.domainEvents,
+		event.NewReviewCaseOpened(c.id, tenantID, verificationID, reason, requiresFourEyes, c.dueAt))
+	return c, nil
+}
+
+// ReconstructReviewCase recreates a ReviewCase from persistence (no validation, no events).
+func ReconstructReviewCase(
+	id, tenantID, verificationID uuid.UUID,
+	status, reason, outcome string,
+	requiresFourEyes bool,
+	assigneeID uuid.UUID,
+	decisions []ReviewDecision,
+	openedAt, dueAt time.Time,
+	claimedAt, decidedAt *time.Time,
+	version int,
+	updatedAt time.Time,
+) ReviewCase {
+	return ReviewCase{
+		id:               id,
+		tenantID:         tenantID,
+		verificationID:   verificationID,
+		status:           status,
+		reason:           reason,
+		outcome:          outcome,
+		requiresFourEyes: requiresFourEyes,
+		assigneeID:       assigneeID,
+		decisions:        decisions,
+		openedAt:         openedAt,
+		dueAt:            dueAt,
+		claimedAt:        claimedAt,
+		decidedAt:        decidedAt,
+		version:          version,
+		updatedAt:        updatedAt,
+	}
+}
+
+// Claim assigns an unassigned case to the analyst taking it from the queue
+// (immutable - returns new copy).
+func (c ReviewCase) Claim(analystID uuid.UUID, now time.Time) (ReviewCase, error) {
+	if c.assigneeID != uuid.Nil && c.assigneeID != analystID {
+		return ReviewCase{}, fmt.Errorf("review case %s is already claimed by another analyst", c.id)
+	}
+	return c.assign(analystID, analystID, now)
+}
+
+// Assign hands the case to an analyst, replacing any current assignee
+// (immutable - returns new copy).
+func (c ReviewCase) Assign(analystID, assignedBy uuid.UUID, now time.Time) (ReviewCase, error) {
+	return c.assign(analystID, assignedBy, now)
+}
+
+func (c ReviewCase) assign(analystID, assignedBy uuid.UUID, now time.Time) (ReviewCase, error) {
+	if c.status == ReviewStatusDecided {
+		return ReviewCase{}, fmt.Errorf("review case %s is already decided", c.id)
+	}
+	if analystID == uuid.Nil {
+		return ReviewCase{}, fmt.Errorf("analyst ID is required")
+	}
+	if c.status == ReviewStatusAwaitingSecondApproval && c.decidedBy(analystID) {
+		return ReviewCase{}, fmt.Errorf("second approval of review case %s must come from a different analyst", c.id)
+	}
+
+	updated := c
+	updated.domainEvents = copyEvents(c.domainEvents)
+	updated.assigneeID = analystID
+	if c.status == ReviewStatusOpen {
+		updated.status = ReviewStatusClaimed
+	}
+	if c.claimedAt == nil {
+		updated.claimedAt = &now
+	}
+	updated.updatedAt = now
+	updated.version++
+	updated.domainEvents = append(updated.domainEvents,
+		event.NewReviewCaseAssigned(c.id, c.tenantID, c.verificationID, analystID, assignedBy))
+	return updated, nil
+}
+
+// RecordDecision records the assigned analyst's decision and reason. The
+// decision is final unless it is a first approval under four-eyes review
+// (immutable - returns new copy).
+func (c ReviewCase) RecordDecision(analystID uuid.UUID, outcome, reason string, now time.Time) (ReviewCase, error) {
+	if c.status == ReviewStatusDecided {
+		return ReviewCase{}, fmt.Errorf("review case %s is already decided", c.id)
+	}
+	if outcome != ReviewOutcomeApprove && outcome != ReviewOutcomeReject {
+		return ReviewCase{}, fmt.Errorf("unknown review outcome: %q", outcome)
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return ReviewCase{}, fmt.Errorf("a reason is required for review decisions")
+	}
+	if c.assigneeID == uuid.Nil || c.assigneeID != analystID {
+		return ReviewCase{}, fmt.Errorf("review case %s must be claimed by the analyst before deciding", c.id)
+	}
+
+	updated := c
+	updated.domainEvents = copyEvents(c.domainEvents)
+	updated.decisions = append(append([]ReviewDecision(nil), c.decisions...), ReviewDecision{
+		AnalystID: analystID,
+		Outcome:   outcome,
+		Reason:    reason,
+		DecidedAt: now,
+	})
+	updated.updatedAt = now
+	updated.version++
+
+	if outcome == ReviewOutcomeApprove && c.requiresFourEyes && c.status != ReviewStatusAwaitingSecondApproval {
+		updated.status = ReviewStatusAwaitingSecondApproval
+		updated.assigneeID = uuid.Nil
+		return updated, nil
+	}
+
+	updated.status = ReviewStatusDecided
+	updated.outcome = outcome
+	updated.decidedAt = &now
+	updated.domainEvents = append(updated.domainEvents,
+		event.NewReviewCaseDecided(c.id, c.tenantID, c.verificationID, outcome, reason, !now.After(c.dueAt)))
+	return updated, nil
+}
+
+// IsOverdue reports whether the case is still undecided past its due time.
+func (c ReviewCase) IsOverdue(now time.Time) bool {
+	return c.status != ReviewStatusDecided && now.After(c.dueAt)
+}
+
+// decidedBy reports whether the analyst has already recorded a decision.
+func (c ReviewCase) decidedBy(analystID uuid.UUID) bool {
+	for _, d := range c.decisions {
+		if d.AnalystID == analystID {
+			return true
+		}
+	}
+	return false
+}
+
+// Accessors
+
+func (c ReviewCase) ID() uuid.UUID                      { return c.id }
+func (c ReviewCase) TenantID() uuid.UUID                { return c.tenantID }
+func (c ReviewCase) VerificationID() uuid.UUID          { return c.verificationID }
+func (c ReviewCase) Status() string                     { return c.status }
+func (c ReviewCase) Reason() string                     { return c.reason }
+func (c ReviewCase) Outcome() string                    { return c.outcome }
+func (c ReviewCase) RequiresFourEyes() bool             { return c.requiresFourEyes }
+func (c ReviewCase) AssigneeID() uuid.UUID              { return c.assigneeID }
+func (c ReviewCase) OpenedAt() time.Time                { return c.openedAt }
+func (c ReviewCase) DueAt() time.Time                   { return c.dueAt }
+func (c ReviewCase) Version() int                       { return c.version }
+func (c ReviewCase) UpdatedAt() time.Time               { return c.updatedAt }
+func (c ReviewCase) DomainEvents() []events.DomainEvent { return c.domainEvents }
+
+func (c ReviewCase) ClaimedAt() *time.Time {
+	if c.claimedAt == nil {
+		return nil
+	}
+	t := *c.claimedAt
+	return &t
+}
+
+func (c ReviewCase) DecidedAt() *time.Time {
+	if c.decidedAt == nil {
+		return nil
+	}
+	t := *c.decidedAt
+	return &t
+}
+
+func (c ReviewCase) Decisions() []ReviewDecision {
+	result := make([]ReviewDecision, len(c.decisions))
+	copy(result, c.decisions)
+	return result
+}
@@ -0,0 +1,119 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+)
+
+func openReviewCase(t *testing.T, fourEyes bool, now time.Time) model.ReviewCase {
+	t.Helper()
+	c, err := model.NewReviewCase(uuid.New(), uuid.New(), "onfido_result_consider", fourEyes, 24*time.Hour, now)
+	require.NoError(t, err)
+	return c
+}
+
+func TestNewReviewCase(t *testing.T) {
+	now := time.Now().UTC()
+	c := openReviewCase(t, false, now)
+
+	assert.Equal(t, model.ReviewStatusOpen, c.Status())
+	assert.Equal(t, now.Add(24*time.Hour), c.DueAt())
+	assert.Equal(t, uuid.Nil, c.AssigneeID())
+	require.Len(t, c.DomainEvents(), 1)
+	assert.Equal(t, "identity.review.opened", c.DomainEvents()[0].EventType())
+
+	_, err := model.NewReviewCase(uuid.New(), uuid.New(), "reason", false, 0, now)
+	assert.Error(t, err)
+}
+
+func TestReviewCase_Claim(t *testing.T) {
+	now := time.Now().UTC()
+	analyst, other := uuid.New(), uuid.New()
+
+	claimed, err := openReviewCase(t, false, now).Claim(analyst, now)
+	require.NoError(t, err)
+	assert.Equal(t, model.ReviewStatusClaimed, claimed.Status())
+	assert.Equal(t, analyst, claimed.AssigneeID())
+	assert.NotNil(t, claimed.ClaimedAt())
+
+	_, err = claimed.Claim(other, now)
+	assert.Error(t, err, "a claimed case cannot be taken by another analyst")
+
+	reassigned, err := claimed.Assign(other, uuid.New(), now)
+	require.NoError(t, err)
+	assert.Equal(t, other, reassigned.AssigneeID())
+	assert.Equal(t, "identity.review.assigned", reassigned.DomainEvents()[len(reassigned.DomainEvents())-1].EventType())
+}
+
+func TestReviewCase_RecordDecision_SingleReviewer(t *testing.T) {
+	now := time.Now().UTC()
+	analyst := uuid.New()
+	c, err := openReviewCase(t, false, now).Claim(analyst, now)
+	require.NoError(t, err)
+
+	_, err = c.RecordDecision(uuid.New(), model.ReviewOutcomeApprove, "looks fine", now)
+	assert.Error(t, err, "only the assignee may decide")
+	_, err = c.RecordDecision(analyst, model.ReviewOutcomeApprove, "  ", now)
+	assert.Error(t, err, "a reason is required")
+
+	decided, err := c.RecordDecision(analyst, model.ReviewOutcomeApprove, "document genuine on manual inspection", now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, model.ReviewStatusDecided, decided.Status())
+	assert.Equal(t, model.ReviewOutcomeApprove, decided.Outcome())
+	require.Len(t, decided.Decisions(), 1)
+	assert.False(t, decided.IsOverdue(now.Add(48*time.Hour)))
+
+	_, err = decided.RecordDecision(analyst, model.ReviewOutcomeReject, "changed my mind", now)
+	assert.Error(t, err)
+}
+
+func TestReviewCase_RecordDecision_FourEyes(t *testing.T) {
+	now := time.Now().UTC()
+	first, second := uuid.New(), uuid.New()
+	c, err := openReviewCase(t, true, now).Claim(first, now)
+	require.NoError(t, err)
+
+	c, err = c.RecordDecision(first, model.ReviewOutcomeApprove, "matches selfie", now)
+	require.NoError(t, err)
+	assert.Equal(t, model.ReviewStatusAwaitingSecondApproval, c.Status())
+	assert.Equal(t, uuid.Nil, c.AssigneeID())
+	assert.Empty(t, c.Outcome())
+
+	_, err = c.Claim(first, now)
+	assert.Error(t, err, "the first approver cannot confirm their own approval")
+
+	c, err = c.Claim(second, now)
+	require.NoError(t, err)
+	c, err = c.RecordDecision(second, model.ReviewOutcomeApprove, "agree", now)
+	require.NoError(t, err)
+	assert.Equal(t, model.ReviewStatusDecided, c.Status())
+	assert.Equal(t, model.ReviewOutcomeApprove, c.Outcome())
+	assert.Len(t, c.Decisions(), 2)
+	assert.Equal(t, "identity.review.decided", c.DomainEvents()[len(c.DomainEvents())-1].EventType())
+}
+
+func TestReviewCase_RecordDecision_FourEyesRejectionIsFinal(t *testing.T) {
+	now := time.Now().UTC()
+	analyst := uuid.New()
+	c, err := openReviewCase(t, true, now).Claim(analyst, now)
+	require.NoError(t, err)
+
+	c, err = c.RecordDecision(analyst, model.ReviewOutcomeReject, "document altered", now)
+	require.NoError(t, err)
+	assert.Equal(t, model.ReviewStatusDecided, c.Status())
+	assert.Equal(t, model.ReviewOutcomeReject, c.Outcome())
+}
+
+func TestReviewCase_IsOverdue(t *testing.T) {
+	now := time.Now().UTC()
+	c := openReviewCase(t, false, now)
+
+	assert.False(t, c.IsOverdue(now.Add(23*time.Hour)))
+	assert.True(t, c.IsOverdue(now.Add(25*time.Hour)))
+}
@@ -0,0 +1,125 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// Screening triggers record why a screening ran.
+const (
+	ScreeningTriggerInitial  = "INITIAL"
+	ScreeningTriggerRescreen = "RESCREEN"
+)
+
+// Screening outcomes.
+const (
+	ScreeningOutcomeClear = "CLEAR"
+	ScreeningOutcomeHit   = "HIT"
+)
+
+// ScreeningMatch is a single potential match returned by a screening
+// provider. EntryID identifies the matched entry on its list, when the
+// provider reports it.
+type ScreeningMatch struct {
+	Name       string   `json:"name"`
+	Categories []string `json:"categories,omitempty"`
+	Sources    []string `json:"sources,omitempty"`
+	EntryID    string   `json:"entry_id,omitempty"`
+	Score      float64  `json:"score"`
+}
+
+// ScreeningResult records one screening run for a PEP, sanctions or
+// adverse-media check. Results are append-only: every initial screen and
+// re-screen is kept so reviewers can see when a match first appeared.
+type ScreeningResult struct {
+	screenedAt        time.Time
+	checkType         valueobject.CheckType
+	provider          string
+	providerReference string
+	trigger           string
+	matches           []ScreeningMatch
+	id                uuid.UUID
+	tenantID          uuid.UUID
+	verificationID    uuid.UUID
+	checkID           uuid.UUID
+}
+
+// NewScreeningResult creates a screening result for a check.
+func NewScreeningResult(
+	tenantID, verificationID, checkID uuid.UUID,
+	checkType valueobject.CheckType,
+	provider, providerReference, trigger string,
+	matches []ScreeningMatch,
+	screenedAt time.Time,
+) (ScreeningResult, error) {
+	if !checkType.IsScreening() {
+		return ScreeningResult{}, fmt.Errorf("%s is not a screening check", checkType.String())
+	}
+	if trigger != ScreeningTriggerInitial && trigger != ScreeningTriggerRescreen {
+		return ScreeningResult{}, fmt.Errorf("unknown screening trigger: %q", trigger)
+	}
+	return ScreeningResult{
+		id:                uuid.New(),
+		tenantID:          tenantID,
+		verificationID:    verificationID,
+		checkID:           checkID,
+		checkType:         checkType,
+		provider:          provider,
+		providerReference: providerReference,
+		trigger:           trigger,
+		matches:           append([]ScreeningMatch(nil), matches...),
+		screenedAt:        screenedAt,
+	}, nil
+}
+
+// ReconstructScreeningResult recreates a ScreeningResult from persistence (no validation).
+func ReconstructScreeningResult(
+	id, tenantID, verificationID, checkID uuid.UUID,
+	checkType valueobject.CheckType,
+	provider, providerReference, trigger string,
+	matches []ScreeningMatch,
+	screenedAt time.Time,
+) ScreeningResult {
+	return ScreeningResult{
+		id:                id,
+		tenantID:          tenantID,
+		verificationID:    verificationID,
+		checkID:           checkID,
+		checkType:         checkType,
+		provider:          provider,
+		providerReference: providerReference,
+		trigger:           trigger,
+		matches:           matches,
+		screenedAt:        screenedAt,
+	}
+}
+
+// Outcome is HIT when the provider returned any match, CLEAR otherwise.
+func (r ScreeningResult) Outcome() string {
+	if len(r.matches) > 0 {
+		return ScreeningOutcomeHit
+	}
+	return ScreeningOutcomeClear
+}
+
+// Accessors
+
+func (r ScreeningResult) ID() uuid.UUID                    { return r.id }
+func (r ScreeningResult) TenantID() uuid.UUID              { return r.tenantID }
+func (r ScreeningResult) VerificationID() uuid.UUID        { return r.verificationID }
+func (r ScreeningResult) CheckID() uuid.UUID               { return r.checkID }
+func (r ScreeningResult) CheckType() valueobject.CheckType { return r.checkType }
+func (r ScreeningResult) Provider() string                 { return r.provider }
+func (r ScreeningResult) ProviderReference() string        { return r.providerReference }
+func (r ScreeningResult) Trigger() string                  { return r.trigger }
+func (r ScreeningResult) ScreenedAt() time.Time            { return r.screenedAt }
+
+func (r ScreeningResult) Matches() []ScreeningMatch {
+	result := make([]ScreeningMatch, len(r.matches))
+	copy(result, r.matches)
+	return result
+}
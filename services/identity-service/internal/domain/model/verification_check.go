@@ -85,6 +85,19 @@ func (vc VerificationCheck) SetInProgress() (VerificationCheck, error) {
 	return updated, nil
 }
 
+// MarkForReview moves an in-progress or approved check to REVIEW, clearing
+// any completion, so an analyst can resolve it (immutable - returns new copy).
+func (vc VerificationCheck) MarkForReview(reason string) (VerificationCheck, error) {
+	if vc.status != valueobject.StatusInProgress && vc.status != valueobject.StatusApproved {
+		return VerificationCheck{}, fmt.Errorf("can only review checks in IN_PROGRESS or APPROVED status, current: %s", vc.status.String())
+	}
+	updated := vc
+	updated.status = valueobject.StatusReview
+	updated.failureReason = reason
+	updated.completedAt = nil
+	return updated, nil
+}
+
 // Accessors
 
 func (vc VerificationCheck) ID() uuid.UUID                          { return vc.id }
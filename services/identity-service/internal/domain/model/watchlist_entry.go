@@ -0,0 +1,30 @@
+package model
+
+// Watchlists applicants are screened against by the in-house screening
+// engine.
+const (
+	// WatchlistOFACSDN is the US Treasury OFAC Specially Designated Nationals list.
+	WatchlistOFACSDN = "OFAC_SDN"
+	// WatchlistEUConsolidated is the EU Consolidated Financial Sanctions List.
+	WatchlistEUConsolidated = "EU_CONSOLIDATED"
+)
+
+// Watchlist entry types.
+const (
+	WatchlistEntryIndividual = "INDIVIDUAL"
+	WatchlistEntryEntity     = "ENTITY"
+)
+
+// WatchlistEntry is a single designation on a sanctions list. BirthYears and
+// Countries (ISO 3166 alpha-2 nationalities and citizenships) are empty when
+// the list does not give them.
+type WatchlistEntry struct {
+	List       string
+	EntryID    string
+	Type       string
+	Name       string
+	Aliases    []string
+	Programs   []string
+	Countries  []string
+	BirthYears []int
+}
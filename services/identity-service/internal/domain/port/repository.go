@@ -2,6 +2,8 @@ package port
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -18,8 +20,136 @@ type VerificationRepository interface {
 	FindByID(ctx context.Context, id uuid.UUID) (model.IdentityVerification, error)
 	// ListByTenant returns verifications for a tenant with pagination.
 	ListByTenant(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]model.IdentityVerification, int, error)
+	// FindByProviderReference retrieves the verification owning the check that
+	// the given provider knows by providerRef, together with that check's ID.
+	// It returns ErrCheckNotFound when no check carries the reference.
+	FindByProviderReference(ctx context.Context, provider, providerRef string) (model.IdentityVerification, uuid.UUID, error)
+	// ListDueForRefresh returns up to limit approved verifications that expired
+	// by now, or expire by noticeBefore and have not been flagged yet.
+	ListDueForRefresh(ctx context.Context, now, noticeBefore time.Time, limit int) ([]model.IdentityVerification, error)
+	// ListByApplicantEmail returns a tenant's unerased verifications of the
+	// applicant with the given email, matched case-insensitively, oldest first.
+	ListByApplicantEmail(ctx context.Context, tenantID uuid.UUID, email string) ([]model.IdentityVerification, error)
+	// ListDueForErasure returns up to limit unerased rejected or expired
+	// verifications last updated before cutoff, oldest first, of one tenant
+	// or, when tenantID is uuid.Nil, of all tenants.
+	ListDueForErasure(ctx context.Context, tenantID uuid.UUID, cutoff time.Time, limit int) ([]model.IdentityVerification, error)
 }
 
+// BusinessVerificationRepository defines persistence operations for business (KYB) verifications.
+type BusinessVerificationRepository interface {
+	// Save persists a business verification (insert or update).
+	Save(ctx context.Context, b model.BusinessVerification) error
+	// FindByID retrieves a business verification by its unique identifier.
+	// It returns ErrBusinessVerificationNotFound when missing.
+	FindByID(ctx context.Context, id uuid.UUID) (model.BusinessVerification, error)
+}
+
+// DocumentRepository defines persistence operations for identity document metadata.
+type DocumentRepository interface {
+	// Save persists a document (insert or update).
+	Save(ctx context.Context, d model.IdentityDocument) error
+	// FindByID retrieves a tenant's document. It returns ErrDocumentNotFound when missing.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.IdentityDocument, error)
+	// ListByVerification returns a verification's documents, oldest first.
+	ListByVerification(ctx context.Context, tenantID, verificationID uuid.UUID) ([]model.IdentityDocument, error)
+	// ListExpired returns up to limit unpurged documents retained until before cutoff.
+	ListExpired(ctx context.Context, cutoff time.Time, limit int) ([]model.IdentityDocument, error)
+}
+
+// ScreeningResultRepository defines persistence operations for screening results.
+type ScreeningResultRepository interface {
+	// Save appends a screening result.
+	Save(ctx context.Context, r model.ScreeningResult) error
+	// ListByVerification returns a verification's screening results, newest first.
+	ListByVerification(ctx context.Context, tenantID, verificationID uuid.UUID) ([]model.ScreeningResult, error)
+	// ListDueForRescreen returns up to limit approved verifications whose most
+	// recent screening ran before cutoff, least recently screened first.
+	ListDueForRescreen(ctx context.Context, cutoff time.Time, limit int) ([]uuid.UUID, error)
+}
+
+// ReviewQueueFilter narrows a review queue listing. Zero values match any
+// status or assignee.
+type ReviewQueueFilter struct {
+	Status     string
+	AssigneeID uuid.UUID
+}
+
+// ReviewMetrics summarizes a tenant's review queue against its SLA. Decision
+// times cover cases decided since the start of the reporting window.
+type ReviewMetrics struct {
+	AvgTimeToDecision time.Duration
+	P95TimeToDecision time.Duration
+	Open              int
+	AwaitingSecond    int
+	Overdue           int
+	Decided           int
+	DecidedWithinSLA  int
+}
+
+// ReviewCaseRepository defines persistence operations for analyst review cases.
+type ReviewCaseRepository interface {
+	// Save persists a review case (insert or update).
+	Save(ctx context.Context, c model.ReviewCase) error
+	// FindByID retrieves a tenant's review case. It returns ErrReviewCaseNotFound when missing.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.ReviewCase, error)
+	// FindActiveByVerification retrieves the undecided review case of a
+	// verification. It returns ErrReviewCaseNotFound when there is none.
+	FindActiveByVerification(ctx context.Context, verificationID uuid.UUID) (model.ReviewCase, error)
+	// ListQueue returns a tenant's review cases matching filter, soonest due first,
+	// with the total count.
+	ListQueue(ctx context.Context, tenantID uuid.UUID, filter ReviewQueueFilter, limit, offset int) ([]model.ReviewCase, int, error)
+	// Metrics summarizes the tenant's queue at now, with decisions made since.
+	Metrics(ctx context.Context, tenantID uuid.UUID, since, now time.Time) (ReviewMetrics, error)
+}
+
+// ApplicantFingerprintRepository defines persistence operations for the
+// identifiers used in duplicate identity detection.
+type ApplicantFingerprintRepository interface {
+	// Save persists a fingerprint (insert or update), keyed by verification.
+	Save(ctx context.Context, f model.ApplicantFingerprint) error
+	// FindByVerification retrieves a verification's fingerprint. It returns
+	// ErrFingerprintNotFound when the verification has none.
+	FindByVerification(ctx context.Context, verificationID uuid.UUID) (model.ApplicantFingerprint, error)
+	// FindCandidates returns other applicants' fingerprints in the tenant
+	// that could match f: the same document number or date of birth, or,
+	// when f has a selfie embedding, the most recent ones with an embedding.
+	FindCandidates(ctx context.Context, f model.ApplicantFingerprint, limit int) ([]model.ApplicantFingerprint, error)
+}
+
+// DocumentStore holds encrypted document content in object storage.
+type DocumentStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// DocumentCipher encrypts document content at rest. The associated data binds
+// a ciphertext to its document so objects cannot be swapped between records.
+type DocumentCipher interface {
+	Encrypt(plaintext, associatedData []byte) ([]byte, error)
+	Decrypt(ciphertext, associatedData []byte) ([]byte, error)
+}
+
+var (
+	// ErrDocumentNotFound is returned when a document does not exist for the tenant.
+	ErrDocumentNotFound = errors.New("document not found")
+	// ErrVerificationNotFound is returned when an identity verification does not exist.
+	ErrVerificationNotFound = errors.New("verification not found")
+	// ErrBusinessVerificationNotFound is returned when a business verification does not exist.
+	ErrBusinessVerificationNotFound = errors.New("business verification not found")
+	// ErrFingerprintNotFound is returned when a verification has no applicant fingerprint.
+	ErrFingerprintNotFound = errors.New("applicant fingerprint not found")
+	// ErrReviewCaseNotFound is returned when a review case does not exist for the tenant.
+	ErrReviewCaseNotFound = errors.New("review case not found")
+	// ErrCheckNotFound is returned when no verification check carries a provider reference.
+	ErrCheckNotFound = errors.New("verification check not found")
+	// ErrInvalidWebhookSignature is returned when a provider callback fails signature verification.
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+	// ErrInvalidWebhookPayload is returned when a provider callback cannot be decoded.
+	ErrInvalidWebhookPayload = errors.New("invalid webhook payload")
+)
+
 // ApplicantInfo holds the applicant data needed by a verification provider.
 type ApplicantInfo struct {
 	FirstName   string
@@ -31,12 +161,89 @@ type ApplicantInfo struct {
 
 // VerificationProvider defines the interface for external KYC/AML providers.
 type VerificationProvider interface {
+	// Name identifies the provider on the checks it runs, e.g. "persona".
+	Name() string
 	// InitiateCheck starts a check with the external provider and returns a provider reference.
 	InitiateCheck(ctx context.Context, checkType valueobject.CheckType, applicant ApplicantInfo) (providerRef string, err error)
 	// GetCheckResult retrieves the result of a previously initiated check.
 	GetCheckResult(ctx context.Context, providerRef string) (valueobject.VerificationStatus, string, error)
 }
 
+// BusinessInfo holds the business data needed by a KYB provider.
+type BusinessInfo struct {
+	LegalName          string
+	RegistrationNumber string
+	Country            string
+	BeneficialOwners   []ApplicantInfo
+}
+
+// BusinessVerificationProvider defines the interface for external KYB providers.
+type BusinessVerificationProvider interface {
+	// Name identifies the provider on the checks it runs, e.g. "middesk".
+	Name() string
+	// InitiateBusinessChecks submits the business for the given checks and
+	// returns a provider reference per check type. Providers that verify a
+	// business as a whole may derive every reference from one submission.
+	InitiateBusinessChecks(ctx context.Context, business BusinessInfo, checkTypes []valueobject.CheckType) (map[valueobject.CheckType]string, error)
+}
+
+// ScreeningReport is a screening provider's answer for one check. No matches
+// means the applicant screened clear. Provider names the provider that
+// screened the check when it is not the one called, such as when a
+// provider hands check types it does not cover to another.
+type ScreeningReport struct {
+	Reference string
+	Provider  string
+	Matches   []model.ScreeningMatch
+}
+
+// ScreeningProvider defines the interface for PEP, sanctions and adverse-media
+// screening providers. Screening is synchronous: the provider answers with
+// its matches rather than calling back later.
+type ScreeningProvider interface {
+	// Name identifies the provider on the checks it runs, e.g. "complyadvantage".
+	Name() string
+	// Screen searches the provider's lists for the applicant.
+	Screen(ctx context.Context, checkType valueobject.CheckType, applicant ApplicantInfo) (ScreeningReport, error)
+}
+
+// WatchlistSource fetches the sanctions lists applicants are screened
+// against, such as the OFAC SDN list or the EU consolidated list.
+type WatchlistSource interface {
+	// List names the list the source publishes, e.g. "OFAC_SDN".
+	List() string
+	// Fetch returns the list's current entries and a version identifying
+	// the publication they were read from.
+	Fetch(ctx context.Context) ([]model.WatchlistEntry, string, error)
+}
+
+// FaceEmbedder turns a selfie into a face embedding so that the same face
+// can be recognised across verifications.
+type FaceEmbedder interface {
+	// Embed returns the embedding of the face in image, a JPEG or PNG.
+	Embed(ctx context.Context, image []byte) ([]float32, error)
+}
+
+// CheckResult is a provider's outcome for one check, reported by a webhook.
+// An empty ProviderRef means the callback does not concern a check.
+type CheckResult struct {
+	Status        valueobject.VerificationStatus
+	ProviderRef   string
+	FailureReason string
+}
+
+// WebhookProvider is a VerificationProvider that reports check results
+// through signed webhook callbacks.
+type WebhookProvider interface {
+	VerificationProvider
+	// SignatureHeader is the HTTP header carrying the callback signature.
+	SignatureHeader() string
+	// ParseWebhook verifies the signature over payload and returns the check
+	// outcome it reports. It returns ErrInvalidWebhookSignature or
+	// ErrInvalidWebhookPayload when the callback cannot be trusted or read.
+	ParseWebhook(ctx context.Context, payload []byte, signature string) (CheckResult, error)
+}
+
 // EventPublisher publishes domain events to a message broker.
 type EventPublisher interface {
 	Publish(ctx context.Context, topic string, events ...events.DomainEvent) error
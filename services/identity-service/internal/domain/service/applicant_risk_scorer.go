@@ -0,0 +1,141 @@
+package service
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// Applicant risk score thresholds: scores below MediumRiskScore are LOW,
+// scores below HighRiskScore are MEDIUM and anything higher is HIGH.
+const (
+	MediumRiskScore = 30
+	HighRiskScore   = 60
+)
+
+// ProviderFactorPrefix marks risk factors raised by provider signals.
+const ProviderFactorPrefix = "provider:"
+
+// ApplicantRiskInput contains the applicant data used for risk scoring.
+type ApplicantRiskInput struct {
+	// Country is the applicant's ISO 3166-1 alpha-2 country of residence.
+	Country string
+	// DocumentTypes are the identity documents the applicant uploaded.
+	DocumentTypes []valueobject.DocumentType
+	// ScreeningHits are the screening checks that returned potential matches.
+	ScreeningHits []valueobject.CheckType
+	// ProviderSignals are the reasons KYC providers gave for borderline results.
+	ProviderSignals []string
+	// DuplicateIdentity is set while the applicant is held as a possible
+	// duplicate of another applicant.
+	DuplicateIdentity bool
+}
+
+// ApplicantRiskAssessment is the result of scoring an applicant.
+type ApplicantRiskAssessment struct {
+	Tier    valueobject.RiskTier
+	Factors []string
+	Score   int
+}
+
+// ApplicantRiskScorer is a domain service that rates an applicant's risk from
+// their country, identity documents, screening hits and provider signals.
+type ApplicantRiskScorer struct {
+	highRiskCountries map[string]bool
+}
+
+// NewApplicantRiskScorer creates a scorer that treats applicants resident in
+// any of highRiskCountries as high risk.
+func NewApplicantRiskScorer(highRiskCountries []string) *ApplicantRiskScorer {
+	countries := make(map[string]bool, len(highRiskCountries))
+	for _, c := range highRiskCountries {
+		if c = strings.ToUpper(strings.TrimSpace(c)); c != "" {
+			countries[c] = true
+		}
+	}
+	return &ApplicantRiskScorer{highRiskCountries: countries}
+}
+
+// Score rates the applicant. The base score is 10 and each factor adds
+// points; a high-risk country or a sanctions hit alone makes the applicant
+// HIGH risk. Factors are returned sorted so repeated scoring is stable.
+func (s *ApplicantRiskScorer) Score(input ApplicantRiskInput) ApplicantRiskAssessment {
+	score := 10
+	factors := make(map[string]bool)
+	add := func(points int, factor string) {
+		if factors[factor] {
+			return
+		}
+		factors[factor] = true
+		score += points
+	}
+
+	// Country of residence.
+	if s.highRiskCountries[strings.ToUpper(input.Country)] {
+		add(50, "high_risk_country")
+	}
+
+	// Documents: a driving licence alone is weaker evidence than a passport
+	// or national ID card.
+	if len(input.DocumentTypes) > 0 {
+		hasPrimaryID := false
+		for _, dt := range input.DocumentTypes {
+			if dt == valueobject.DocumentTypePassport || dt == valueobject.DocumentTypeNationalID {
+				hasPrimaryID = true
+				break
+			}
+		}
+		if !hasPrimaryID {
+			add(10, "no_primary_id_document")
+		}
+	}
+
+	// Screening hits.
+	for _, ct := range input.ScreeningHits {
+		switch ct {
+		case valueobject.CheckTypeSanctions:
+			add(60, "sanctions_hit")
+		case valueobject.CheckTypePEP:
+			add(35, "pep_hit")
+		case valueobject.CheckTypeAdverseMedia:
+			add(25, "adverse_media_hit")
+		}
+	}
+
+	// A possible duplicate or synthetic identity.
+	if input.DuplicateIdentity {
+		add(40, "duplicate_identity")
+	}
+
+	// Provider signals, such as a borderline document or selfie result.
+	for _, signal := range input.ProviderSignals {
+		if signal = strings.TrimSpace(signal); signal != "" {
+			add(15, ProviderFactorPrefix+signal)
+		}
+	}
+
+	result := make([]string, 0, len(factors))
+	for f := range factors {
+		result = append(result, f)
+	}
+	sort.Strings(result)
+
+	return ApplicantRiskAssessment{
+		Score:   score,
+		Tier:    TierForScore(score),
+		Factors: result,
+	}
+}
+
+// TierForScore maps a risk score to its tier.
+func TierForScore(score int) valueobject.RiskTier {
+	switch {
+	case score >= HighRiskScore:
+		return valueobject.RiskTierHigh
+	case score >= MediumRiskScore:
+		return valueobject.RiskTierMedium
+	default:
+		return valueobject.RiskTierLow
+	}
+}
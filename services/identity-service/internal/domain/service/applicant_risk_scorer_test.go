@@ -0,0 +1,90 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/service"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+func TestApplicantRiskScorer_BaseScoreIsLow(t *testing.T) {
+	scorer := service.NewApplicantRiskScorer([]string{"IR"})
+
+	output := scorer.Score(service.ApplicantRiskInput{
+		Country:       "US",
+		DocumentTypes: []valueobject.DocumentType{valueobject.DocumentTypePassport, valueobject.DocumentTypeSelfie},
+	})
+
+	assert.Equal(t, 10, output.Score)
+	assert.Equal(t, valueobject.RiskTierLow, output.Tier)
+	assert.Empty(t, output.Factors)
+}
+
+func TestApplicantRiskScorer_HighRiskCountryIsHigh(t *testing.T) {
+	scorer := service.NewApplicantRiskScorer([]string{" ir "})
+
+	output := scorer.Score(service.ApplicantRiskInput{Country: "IR"})
+
+	assert.Equal(t, 60, output.Score)
+	assert.Equal(t, valueobject.RiskTierHigh, output.Tier)
+	assert.Equal(t, []string{"high_risk_country"}, output.Factors)
+}
+
+func TestApplicantRiskScorer_SecondaryDocumentAndProviderSignalIsMedium(t *testing.T) {
+	scorer := service.NewApplicantRiskScorer(nil)
+
+	output := scorer.Score(service.ApplicantRiskInput{
+		Country:         "GB",
+		DocumentTypes:   []valueobject.DocumentType{valueobject.DocumentTypeDriversLicense},
+		ProviderSignals: []string{"onfido_result_consider", "onfido_result_consider"},
+	})
+
+	assert.Equal(t, 35, output.Score)
+	assert.Equal(t, valueobject.RiskTierMedium, output.Tier)
+	assert.Equal(t, []string{"no_primary_id_document", "provider:onfido_result_consider"}, output.Factors)
+}
+
+func TestApplicantRiskScorer_ScreeningHits(t *testing.T) {
+	scorer := service.NewApplicantRiskScorer(nil)
+
+	tests := []struct {
+		name   string
+		hits   []valueobject.CheckType
+		score  int
+		tier   valueobject.RiskTier
+		factor string
+	}{
+		{"sanctions", []valueobject.CheckType{valueobject.CheckTypeSanctions}, 70, valueobject.RiskTierHigh, "sanctions_hit"},
+		{"pep", []valueobject.CheckType{valueobject.CheckTypePEP}, 45, valueobject.RiskTierMedium, "pep_hit"},
+		{"adverse media", []valueobject.CheckType{valueobject.CheckTypeAdverseMedia}, 35, valueobject.RiskTierMedium, "adverse_media_hit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := scorer.Score(service.ApplicantRiskInput{Country: "US", ScreeningHits: tt.hits})
+
+			assert.Equal(t, tt.score, output.Score)
+			assert.Equal(t, tt.tier, output.Tier)
+			assert.Contains(t, output.Factors, tt.factor)
+		})
+	}
+}
+
+func TestTierForScore(t *testing.T) {
+	assert.Equal(t, valueobject.RiskTierLow, service.TierForScore(29))
+	assert.Equal(t, valueobject.RiskTierMedium, service.TierForScore(30))
+	assert.Equal(t, valueobject.RiskTierMedium, service.TierForScore(59))
+	assert.Equal(t, valueobject.RiskTierHigh, service.TierForScore(60))
+}
+
+func TestApplicantRiskScorer_DuplicateIdentityIsMedium(t *testing.T) {
+	scorer := service.NewApplicantRiskScorer(nil)
+
+	output := scorer.Score(service.ApplicantRiskInput{Country: "GB", DuplicateIdentity: true})
+
+	assert.Equal(t, 50, output.Score)
+	assert.Equal(t, valueobject.RiskTierMedium, output.Tier)
+	assert.Equal(t, []string{"duplicate_identity"}, output.Factors)
+}
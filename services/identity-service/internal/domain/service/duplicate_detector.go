@@ -0,0 +1,194 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+)
+
+// Duplicate match reasons.
+const (
+	DuplicateReasonDocumentNumber = "document_number"
+	DuplicateReasonNameDOB        = "name_dob"
+	DuplicateReasonSelfie         = "selfie"
+)
+
+// Default duplicate detection thresholds.
+const (
+	DefaultNameSimilarityThreshold   = 0.92
+	DefaultSelfieSimilarityThreshold = 0.85
+)
+
+// DuplicateDetector is a domain service that compares an applicant's
+// fingerprint against others in the tenant. Applicants match on the same
+// document number, a fuzzy name match with the same date of birth, or a
+// selfie whose face embedding is close to another's. Verifications of the
+// same applicant (a re-verification chain) never match each other.
+type DuplicateDetector struct {
+	nameThreshold   float64
+	selfieThreshold float64
+}
+
+// NewDuplicateDetector creates a detector. Thresholds are similarities in
+// (0, 1]; a non-positive threshold falls back to the default.
+func NewDuplicateDetector(nameThreshold, selfieThreshold float64) *DuplicateDetector {
+	if nameThreshold <= 0 || nameThreshold > 1 {
+		nameThreshold = DefaultNameSimilarityThreshold
+	}
+	if selfieThreshold <= 0 || selfieThreshold > 1 {
+		selfieThreshold = DefaultSelfieSimilarityThreshold
+	}
+	return &DuplicateDetector{nameThreshold: nameThreshold, selfieThreshold: selfieThreshold}
+}
+
+// Detect returns the candidates that match the applicant, strongest first.
+// The score is the highest similarity among the matched identifiers.
+func (d *DuplicateDetector) Detect(applicant model.ApplicantFingerprint, candidates []model.ApplicantFingerprint) []model.DuplicateMatch {
+	var matches []model.DuplicateMatch
+	for _, c := range candidates {
+		if c.VerificationID() == applicant.VerificationID() || c.ApplicantID() == applicant.ApplicantID() {
+			continue
+		}
+
+		var reasons []string
+		score := 0.0
+		if applicant.DocumentNumberHash() != "" && c.DocumentNumberHash() == applicant.DocumentNumberHash() {
+			reasons = append(reasons, DuplicateReasonDocumentNumber)
+			score = 1
+		}
+		if applicant.DateOfBirth() != "" && c.DateOfBirth() == applicant.DateOfBirth() {
+			similarity := NameSimilarity(fullName(applicant), fullName(c))
+			if similarity >= d.nameThreshold {
+				reasons = append(reasons, DuplicateReasonNameDOB)
+				score = math.Max(score, similarity)
+			}
+		}
+		if similarity := CosineSimilarity(applicant.SelfieEmbedding(), c.SelfieEmbedding()); similarity >= d.selfieThreshold {
+			reasons = append(reasons, DuplicateReasonSelfie)
+			score = math.Max(score, similarity)
+		}
+
+		if len(reasons) > 0 {
+			matches = append(matches, model.DuplicateMatch{
+				VerificationID: c.VerificationID(),
+				Reasons:        reasons,
+				Score:          score,
+			})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	return matches
+}
+
+func fullName(f model.ApplicantFingerprint) string {
+	return f.FirstName() + " " + f.LastName()
+}
+
+// NormalizeName folds a person's name into a canonical form for matching:
+// diacritics and punctuation are removed, case is folded and tokens are
+// sorted so word order does not matter ("SMITH, John" and "John Smith"
+// normalise identically).
+func NormalizeName(name string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(name) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// Drop combining marks left over from decomposing accented letters.
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(' ')
+		}
+	}
+	tokens := strings.Fields(b.String())
+	sort.Strings(tokens)
+	return strings.Join(tokens, " ")
+}
+
+// NameSimilarity returns the Jaro-Winkler similarity of two names after
+// normalisation, in [0, 1]. Identical names score 1.
+func NameSimilarity(a, b string) float64 {
+	return jaroWinkler(NormalizeName(a), NormalizeName(b))
+}
+
+// CosineSimilarity returns the cosine similarity of two embeddings, or 0
+// when either is empty or their dimensions differ.
+func CosineSimilarity(a, b []float32) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// jaroWinkler computes the Jaro-Winkler similarity of two strings. It
+// rewards a shared prefix, which suits transliteration variants of names.
+func jaroWinkler(a, b string) float64 {
+	s1, s2 := []rune(a), []rune(b)
+	if len(s1) == 0 || len(s2) == 0 {
+		if len(s1) == len(s2) {
+			return 1
+		}
+		return 0
+	}
+
+	window := max(len(s1), len(s2))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+
+	matched1 := make([]bool, len(s1))
+	matched2 := make([]bool, len(s2))
+	matches := 0
+	for i := range s1 {
+		lo, hi := max(0, i-window), min(len(s2), i+window+1)
+		for j := lo; j < hi; j++ {
+			if !matched2[j] && s1[i] == s2[j] {
+				matched1[i], matched2[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions := 0
+	j := 0
+	for i := range s1 {
+		if !matched1[i] {
+			continue
+		}
+		for !matched2[j] {
+			j++
+		}
+		if s1[i] != s2[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(s1)) + m/float64(len(s2)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for prefix < min(4, len(s1), len(s2)) && s1[prefix] == s2[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
@@ -0,0 +1,106 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/service"
+)
+
+func fingerprint(t *testing.T, tenantID uuid.UUID, first, last, dob, documentNumber string) model.ApplicantFingerprint {
+	t.Helper()
+	f, err := model.NewApplicantFingerprint(tenantID, uuid.New(), uuid.Nil, first, last, dob, documentNumber, time.Now())
+	require.NoError(t, err)
+	return f
+}
+
+func TestDuplicateDetector_SameDocumentNumber(t *testing.T) {
+	tenantID := uuid.New()
+	applicant := fingerprint(t, tenantID, "Jane", "Roe", "1985-03-02", "X1234567")
+	other := fingerprint(t, tenantID, "Mary", "Major", "1970-11-20", "x123-4567")
+
+	matches := service.NewDuplicateDetector(0, 0).Detect(applicant, []model.ApplicantFingerprint{other})
+
+	require.Len(t, matches, 1)
+	assert.Equal(t, other.VerificationID(), matches[0].VerificationID)
+	assert.Equal(t, []string{service.DuplicateReasonDocumentNumber}, matches[0].Reasons)
+	assert.InDelta(t, 1.0, matches[0].Score, 1e-9)
+}
+
+func TestDuplicateDetector_FuzzyNameWithSameDOB(t *testing.T) {
+	tenantID := uuid.New()
+	applicant := fingerprint(t, tenantID, "José", "García", "1985-03-02", "")
+	reordered := fingerprint(t, tenantID, "GARCIA,", "Jose", "1985-03-02", "")
+	differentDOB := fingerprint(t, tenantID, "José", "García", "1985-03-03", "")
+	differentName := fingerprint(t, tenantID, "Maria", "Lopez", "1985-03-02", "")
+
+	matches := service.NewDuplicateDetector(0, 0).Detect(applicant,
+		[]model.ApplicantFingerprint{reordered, differentDOB, differentName})
+
+	require.Len(t, matches, 1)
+	assert.Equal(t, reordered.VerificationID(), matches[0].VerificationID)
+	assert.Equal(t, []string{service.DuplicateReasonNameDOB}, matches[0].Reasons)
+}
+
+func TestDuplicateDetector_SelfieEmbedding(t *testing.T) {
+	tenantID := uuid.New()
+	now := time.Now()
+	applicant := fingerprint(t, tenantID, "Jane", "Roe", "1985-03-02", "").
+		WithSelfieEmbedding([]float32{0.9, 0.1, 0.4}, now)
+	sameFace := fingerprint(t, tenantID, "Anna", "Smith", "1991-07-14", "").
+		WithSelfieEmbedding([]float32{0.88, 0.12, 0.41}, now)
+	otherFace := fingerprint(t, tenantID, "Kim", "Lee", "1991-07-14", "").
+		WithSelfieEmbedding([]float32{-0.2, 0.9, 0.1}, now)
+
+	matches := service.NewDuplicateDetector(0, 0).Detect(applicant,
+		[]model.ApplicantFingerprint{otherFace, sameFace})
+
+	require.Len(t, matches, 1)
+	assert.Equal(t, sameFace.VerificationID(), matches[0].VerificationID)
+	assert.Equal(t, []string{service.DuplicateReasonSelfie}, matches[0].Reasons)
+}
+
+func TestDuplicateDetector_IgnoresSameApplicant(t *testing.T) {
+	tenantID := uuid.New()
+	original := fingerprint(t, tenantID, "Jane", "Roe", "1985-03-02", "X1234567")
+	reverification, err := model.NewApplicantFingerprint(tenantID, uuid.New(), original.ApplicantID(),
+		"Jane", "Roe", "1985-03-02", "X1234567", time.Now())
+	require.NoError(t, err)
+
+	matches := service.NewDuplicateDetector(0, 0).Detect(reverification,
+		[]model.ApplicantFingerprint{original, reverification})
+
+	assert.Empty(t, matches)
+}
+
+func TestDuplicateDetector_OrdersByScore(t *testing.T) {
+	tenantID := uuid.New()
+	applicant := fingerprint(t, tenantID, "Jonathan", "Roe", "1985-03-02", "X1234567")
+	nameOnly := fingerprint(t, tenantID, "Jonathon", "Roe", "1985-03-02", "")
+	document := fingerprint(t, tenantID, "Someone", "Else", "1960-01-01", "X1234567")
+
+	matches := service.NewDuplicateDetector(0, 0).Detect(applicant,
+		[]model.ApplicantFingerprint{nameOnly, document})
+
+	require.Len(t, matches, 2)
+	assert.Equal(t, document.VerificationID(), matches[0].VerificationID)
+	assert.Equal(t, nameOnly.VerificationID(), matches[1].VerificationID)
+}
+
+func TestNormalizeName(t *testing.T) {
+	assert.Equal(t, "garcia jose", service.NormalizeName("José GARCÍA"))
+	assert.Equal(t, "garcia jose", service.NormalizeName("García, José"))
+	assert.Equal(t, "anne marie neil o", service.NormalizeName("Anne-Marie O'Neil"))
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	assert.InDelta(t, 1.0, service.CosineSimilarity([]float32{1, 2}, []float32{2, 4}), 1e-9)
+	assert.InDelta(t, 0.0, service.CosineSimilarity([]float32{1, 0}, []float32{0, 1}), 1e-9)
+	assert.Zero(t, service.CosineSimilarity(nil, []float32{1}))
+	assert.Zero(t, service.CosineSimilarity([]float32{1, 2}, []float32{1}))
+}
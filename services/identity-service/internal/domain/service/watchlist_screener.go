@@ -0,0 +1,177 @@
+package service
+
+import (
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+)
+
+// DefaultWatchlistThreshold is the match score at or above which an
+// applicant is reported as a potential match of a watchlist entry.
+const DefaultWatchlistThreshold = 0.88
+
+const (
+	// tokenMatchThreshold is the similarity at which two name tokens are
+	// taken to be the same name.
+	tokenMatchThreshold = 0.8
+	// birthYearMismatchPenalty is taken off the name score of an entry
+	// whose listed birth years all differ from the applicant's.
+	birthYearMismatchPenalty = 0.2
+)
+
+// WatchlistSubject is the applicant details screened against watchlists.
+// BirthYear is 0 when unknown.
+type WatchlistSubject struct {
+	FirstName string
+	LastName  string
+	BirthYear int
+}
+
+// watchlistSnapshot is an immutable, pre-normalised copy of the loaded lists.
+type watchlistSnapshot struct {
+	version string
+	entries []normalizedWatchlistEntry
+}
+
+type normalizedWatchlistEntry struct {
+	entry model.WatchlistEntry
+	names []normalizedWatchlistName
+}
+
+type normalizedWatchlistName struct {
+	display    string
+	normalized string
+	tokens     []string
+}
+
+// WatchlistScreener is a domain service that screens applicants against
+// sanctions lists. Names are fuzzy-matched, whole and token by token, so
+// transliteration variants, word order and missing middle names are
+// caught; a listed birth year that differs from the applicant's lowers the
+// score. Only individuals are matched, as applicants are people.
+//
+// Lists are held in an immutable snapshot that is swapped atomically on
+// Load, so screening never sees a partly loaded list.
+type WatchlistScreener struct {
+	lists     atomic.Pointer[watchlistSnapshot]
+	threshold float64
+}
+
+// NewWatchlistScreener creates a screener with no lists loaded. A threshold
+// outside (0, 1] selects DefaultWatchlistThreshold.
+func NewWatchlistScreener(threshold float64) *WatchlistScreener {
+	if threshold <= 0 || threshold > 1 {
+		threshold = DefaultWatchlistThreshold
+	}
+	s := &WatchlistScreener{threshold: threshold}
+	s.lists.Store(&watchlistSnapshot{})
+	return s
+}
+
+// Load replaces the screener's lists. version identifies the list contents
+// and is recorded as the reference of every screening.
+func (s *WatchlistScreener) Load(entries []model.WatchlistEntry, version string) {
+	snap := &watchlistSnapshot{version: version}
+	for _, e := range entries {
+		if e.Type == model.WatchlistEntryEntity {
+			continue
+		}
+		ne := normalizedWatchlistEntry{entry: e}
+		for _, n := range append([]string{e.Name}, e.Aliases...) {
+			if norm := NormalizeName(n); norm != "" {
+				ne.names = append(ne.names, normalizedWatchlistName{display: n, normalized: norm, tokens: strings.Fields(norm)})
+			}
+		}
+		if len(ne.names) > 0 {
+			snap.entries = append(snap.entries, ne)
+		}
+	}
+	s.lists.Store(snap)
+}
+
+// Size returns the number of entries loaded for screening.
+func (s *WatchlistScreener) Size() int {
+	return len(s.lists.Load().entries)
+}
+
+// Version returns the version of the loaded lists, empty before the first
+// Load.
+func (s *WatchlistScreener) Version() string {
+	return s.lists.Load().version
+}
+
+// Screen returns the entries the subject potentially matches, highest
+// score first, each with its best-scoring name.
+func (s *WatchlistScreener) Screen(subject WatchlistSubject) []model.ScreeningMatch {
+	norm := NormalizeName(subject.FirstName + " " + subject.LastName)
+	if norm == "" {
+		return nil
+	}
+	tokens := strings.Fields(norm)
+
+	matches := make([]model.ScreeningMatch, 0)
+	for _, ne := range s.lists.Load().entries {
+		best, bestName := 0.0, ""
+		for _, n := range ne.names {
+			if score := nameScore(norm, tokens, n); score > best {
+				best, bestName = score, n.display
+			}
+		}
+		if subject.BirthYear != 0 && len(ne.entry.BirthYears) > 0 && !birthYearListed(subject.BirthYear, ne.entry.BirthYears) {
+			best -= birthYearMismatchPenalty
+		}
+		if best < s.threshold {
+			continue
+		}
+		matches = append(matches, model.ScreeningMatch{
+			Name:       bestName,
+			Categories: []string{"sanction"},
+			Sources:    []string{ne.entry.List},
+			EntryID:    ne.entry.EntryID,
+			Score:      best,
+		})
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	return matches
+}
+
+// nameScore is the similarity of the applicant's name to a listed name:
+// the better of the whole-name similarity and, for names of at least two
+// tokens, the share of the shorter name's tokens found in the longer one,
+// so "John Smith" matches "SMITH, John Michael".
+func nameScore(norm string, tokens []string, listed normalizedWatchlistName) float64 {
+	score := jaroWinkler(norm, listed.normalized)
+	shorter, longer := tokens, listed.tokens
+	if len(shorter) > len(longer) {
+		shorter, longer = longer, shorter
+	}
+	if len(shorter) < 2 {
+		return score
+	}
+
+	total := 0.0
+	for _, t := range shorter {
+		best := 0.0
+		for _, u := range longer {
+			best = max(best, jaroWinkler(t, u))
+		}
+		if best < tokenMatchThreshold {
+			return score
+		}
+		total += best
+	}
+	return max(score, total/float64(len(shorter)))
+}
+
+// birthYearListed reports whether year is one of the listed birth years,
+// allowing a year either way for the approximate dates lists often give.
+func birthYearListed(year int, listed []int) bool {
+	for _, y := range listed {
+		if y-1 <= year && year <= y+1 {
+			return true
+		}
+	}
+	return false
+}
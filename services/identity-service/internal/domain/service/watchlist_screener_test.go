@@ -0,0 +1,71 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/service"
+)
+
+func testWatchlist() []model.WatchlistEntry {
+	return []model.WatchlistEntry{
+		{
+			List: model.WatchlistOFACSDN, EntryID: "36", Type: model.WatchlistEntryIndividual,
+			Name: "AL-RASHID, Ahmad Khalil", Aliases: []string{"RASHID, Ahmed"}, BirthYears: []int{1962},
+		},
+		{
+			List: model.WatchlistEUConsolidated, EntryID: "EU.118.25", Type: model.WatchlistEntryIndividual,
+			Name: "Ivan Petrovich SOKOLOV",
+		},
+		{List: model.WatchlistOFACSDN, EntryID: "912", Type: model.WatchlistEntryEntity, Name: "Sokolov Trading LLC"},
+	}
+}
+
+func TestWatchlistScreener_Screen(t *testing.T) {
+	s := service.NewWatchlistScreener(0)
+	s.Load(testWatchlist(), "v1")
+	require.Equal(t, 2, s.Size(), "entities are not screened against applicants")
+	assert.Equal(t, "v1", s.Version())
+
+	t.Run("matches an alias in another word order", func(t *testing.T) {
+		matches := s.Screen(service.WatchlistSubject{FirstName: "Ahmed", LastName: "Rashid", BirthYear: 1962})
+		require.Len(t, matches, 1)
+		assert.Equal(t, "36", matches[0].EntryID)
+		assert.Equal(t, "RASHID, Ahmed", matches[0].Name)
+		assert.Equal(t, []string{model.WatchlistOFACSDN}, matches[0].Sources)
+		assert.InDelta(t, 1, matches[0].Score, 0.001)
+	})
+
+	t.Run("matches without the middle name", func(t *testing.T) {
+		matches := s.Screen(service.WatchlistSubject{FirstName: "Ivan", LastName: "Sokolov"})
+		require.Len(t, matches, 1)
+		assert.Equal(t, "EU.118.25", matches[0].EntryID)
+	})
+
+	t.Run("matches a transliteration variant", func(t *testing.T) {
+		matches := s.Screen(service.WatchlistSubject{FirstName: "Iwan", LastName: "Sokolow"})
+		require.Len(t, matches, 1)
+		assert.Equal(t, "EU.118.25", matches[0].EntryID)
+		assert.Less(t, matches[0].Score, 1.0)
+	})
+
+	t.Run("a different birth year lowers the score below the threshold", func(t *testing.T) {
+		assert.Empty(t, s.Screen(service.WatchlistSubject{FirstName: "Ahmed", LastName: "Rashid", BirthYear: 1990}))
+		assert.NotEmpty(t, s.Screen(service.WatchlistSubject{FirstName: "Ahmed", LastName: "Rashid", BirthYear: 1963}))
+	})
+
+	t.Run("unrelated and partial names do not match", func(t *testing.T) {
+		assert.Empty(t, s.Screen(service.WatchlistSubject{FirstName: "Jane", LastName: "Doe"}))
+		assert.Empty(t, s.Screen(service.WatchlistSubject{FirstName: "Maria", LastName: "Sokolov"}))
+		assert.Empty(t, s.Screen(service.WatchlistSubject{}))
+	})
+}
+
+func TestWatchlistScreener_NoListsLoaded(t *testing.T) {
+	s := service.NewWatchlistScreener(0.9)
+	assert.Zero(t, s.Size())
+	assert.Empty(t, s.Screen(service.WatchlistSubject{FirstName: "Ivan", LastName: "Sokolov"}))
+}
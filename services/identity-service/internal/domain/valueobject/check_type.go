@@ -12,6 +12,20 @@ var (
 	CheckTypeSelfie    = CheckType{"SELFIE"}
 	CheckTypeWatchlist = CheckType{"WATCHLIST"}
 	CheckTypeAddress   = CheckType{"ADDRESS"}
+
+	// Screening checks, run against a screening provider rather than the KYC provider.
+	CheckTypePEP          = CheckType{"PEP"}
+	CheckTypeSanctions    = CheckType{"SANCTIONS"}
+	CheckTypeAdverseMedia = CheckType{"ADVERSE_MEDIA"}
+
+	// CheckTypeDuplicate is raised internally when the applicant matches
+	// another verification in the tenant; it is never sent to a provider.
+	CheckTypeDuplicate = CheckType{"DUPLICATE"}
+
+	// Business (KYB) checks.
+	CheckTypeBusinessRegistry  = CheckType{"BUSINESS_REGISTRY"}
+	CheckTypeBeneficialOwners  = CheckType{"BENEFICIAL_OWNERS"}
+	CheckTypeBusinessWatchlist = CheckType{"BUSINESS_WATCHLIST"}
 )
 
 // validCheckTypes is the set of all known check types.
@@ -20,6 +34,16 @@ var validCheckTypes = map[string]CheckType{
 	"SELFIE":    CheckTypeSelfie,
 	"WATCHLIST": CheckTypeWatchlist,
 	"ADDRESS":   CheckTypeAddress,
+
+	"PEP":           CheckTypePEP,
+	"SANCTIONS":     CheckTypeSanctions,
+	"ADVERSE_MEDIA": CheckTypeAdverseMedia,
+
+	"DUPLICATE": CheckTypeDuplicate,
+
+	"BUSINESS_REGISTRY":  CheckTypeBusinessRegistry,
+	"BENEFICIAL_OWNERS":  CheckTypeBeneficialOwners,
+	"BUSINESS_WATCHLIST": CheckTypeBusinessWatchlist,
 }
 
 // NewCheckType creates a CheckType from a string, returning an error for unknown types.
@@ -41,6 +65,11 @@ func (ct CheckType) Equal(other CheckType) bool {
 	return ct.value == other.value
 }
 
+// IsScreening returns true for PEP, sanctions and adverse-media checks.
+func (ct CheckType) IsScreening() bool {
+	return ct == CheckTypePEP || ct == CheckTypeSanctions || ct == CheckTypeAdverseMedia
+}
+
 // DefaultCheckTypes returns the standard set of checks applied to a new verification.
 func DefaultCheckTypes() []CheckType {
 	return []CheckType{
@@ -49,3 +78,15 @@ func DefaultCheckTypes() []CheckType {
 		CheckTypeWatchlist,
 	}
 }
+
+// DefaultBusinessCheckTypes returns the standard set of checks applied to a
+// new business verification: registry lookup of the registration number,
+// verification of the declared beneficial owners, and watchlist screening of
+// the business.
+func DefaultBusinessCheckTypes() []CheckType {
+	return []CheckType{
+		CheckTypeBusinessRegistry,
+		CheckTypeBeneficialOwners,
+		CheckTypeBusinessWatchlist,
+	}
+}
@@ -0,0 +1,56 @@
+package valueobject
+
+import "fmt"
+
+// DocumentType represents the kind of identity artifact an applicant uploads.
+type DocumentType struct {
+	value string
+}
+
+var (
+	DocumentTypePassport       = DocumentType{"PASSPORT"}
+	DocumentTypeNationalID     = DocumentType{"NATIONAL_ID"}
+	DocumentTypeDriversLicense = DocumentType{"DRIVERS_LICENSE"}
+	DocumentTypeSelfie         = DocumentType{"SELFIE"}
+	DocumentTypeProofOfAddress = DocumentType{"PROOF_OF_ADDRESS"}
+)
+
+// validDocumentTypes is the set of all known document types.
+var validDocumentTypes = map[string]DocumentType{
+	"PASSPORT":         DocumentTypePassport,
+	"NATIONAL_ID":      DocumentTypeNationalID,
+	"DRIVERS_LICENSE":  DocumentTypeDriversLicense,
+	"SELFIE":           DocumentTypeSelfie,
+	"PROOF_OF_ADDRESS": DocumentTypeProofOfAddress,
+}
+
+// NewDocumentType creates a DocumentType from a string, returning an error for unknown types.
+func NewDocumentType(s string) (DocumentType, error) {
+	dt, ok := validDocumentTypes[s]
+	if !ok {
+		return DocumentType{}, fmt.Errorf("unknown document type: %q", s)
+	}
+	return dt, nil
+}
+
+// String returns the string representation of the document type.
+func (dt DocumentType) String() string {
+	return dt.value
+}
+
+// Equal returns true if two document types are the same.
+func (dt DocumentType) Equal(other DocumentType) bool {
+	return dt.value == other.value
+}
+
+// CheckType returns the verification check this kind of document evidences.
+func (dt DocumentType) CheckType() CheckType {
+	switch dt {
+	case DocumentTypeSelfie:
+		return CheckTypeSelfie
+	case DocumentTypeProofOfAddress:
+		return CheckTypeAddress
+	default:
+		return CheckTypeDocument
+	}
+}
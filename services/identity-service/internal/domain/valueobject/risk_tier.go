@@ -0,0 +1,71 @@
+package valueobject
+
+import (
+	"fmt"
+	"time"
+)
+
+// RiskTier is the customer risk rating that decides how long an approved
+// verification stays valid before KYC must be refreshed.
+type RiskTier struct {
+	value string
+}
+
+var (
+	RiskTierLow    = RiskTier{"LOW"}
+	RiskTierMedium = RiskTier{"MEDIUM"}
+	RiskTierHigh   = RiskTier{"HIGH"}
+)
+
+// validRiskTiers is the set of all known risk tiers.
+var validRiskTiers = map[string]RiskTier{
+	"LOW":    RiskTierLow,
+	"MEDIUM": RiskTierMedium,
+	"HIGH":   RiskTierHigh,
+}
+
+// riskTierRanks orders tiers from lowest to highest risk.
+var riskTierRanks = map[RiskTier]int{
+	RiskTierLow:    1,
+	RiskTierMedium: 2,
+	RiskTierHigh:   3,
+}
+
+// NewRiskTier creates a RiskTier from a string, returning an error for unknown tiers.
+func NewRiskTier(s string) (RiskTier, error) {
+	rt, ok := validRiskTiers[s]
+	if !ok {
+		return RiskTier{}, fmt.Errorf("unknown risk tier: %q", s)
+	}
+	return rt, nil
+}
+
+// String returns the string representation of the risk tier.
+func (rt RiskTier) String() string {
+	return rt.value
+}
+
+// Equal returns true if two risk tiers are the same.
+func (rt RiskTier) Equal(other RiskTier) bool {
+	return rt.value == other.value
+}
+
+// Above returns true if this tier carries more risk than other.
+func (rt RiskTier) Above(other RiskTier) bool {
+	return riskTierRanks[rt] > riskTierRanks[other]
+}
+
+// DefaultValidity is how long a verification of this tier stays valid when
+// no validity period is configured: three years for low risk, two for
+// medium and one for high.
+func (rt RiskTier) DefaultValidity() time.Duration {
+	const year = 365 * 24 * time.Hour
+	switch rt {
+	case RiskTierLow:
+		return 3 * year
+	case RiskTierHigh:
+		return year
+	default:
+		return 2 * year
+	}
+}
@@ -13,6 +13,9 @@ var (
 	StatusApproved   = VerificationStatus{"APPROVED"}
 	StatusRejected   = VerificationStatus{"REJECTED"}
 	StatusExpired    = VerificationStatus{"EXPIRED"}
+	// StatusReview marks a verification or check awaiting analyst review,
+	// e.g. after a screening hit. It is not terminal.
+	StatusReview = VerificationStatus{"REVIEW"}
 )
 
 // validStatuses is the set of all known verification statuses.
@@ -22,6 +25,7 @@ var validStatuses = map[string]VerificationStatus{
 	"APPROVED":    StatusApproved,
 	"REJECTED":    StatusRejected,
 	"EXPIRED":     StatusExpired,
+	"REVIEW":      StatusReview,
 }
 
 // NewVerificationStatus creates a VerificationStatus from a string, returning an error for unknown values.
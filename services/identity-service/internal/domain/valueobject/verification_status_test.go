@@ -19,6 +19,7 @@ func TestNewVerificationStatus_ValidValues(t *testing.T) {
 		{"APPROVED", valueobject.StatusApproved},
 		{"REJECTED", valueobject.StatusRejected},
 		{"EXPIRED", valueobject.StatusExpired},
+		{"REVIEW", valueobject.StatusReview},
 	}
 
 	for _, tt := range tests {
@@ -59,6 +60,7 @@ func TestVerificationStatus_IsTerminal(t *testing.T) {
 		{valueobject.StatusApproved, true},
 		{valueobject.StatusRejected, true},
 		{valueobject.StatusExpired, true},
+		{valueobject.StatusReview, false},
 	}
 
 	for _, tt := range tests {
@@ -1,20 +1,39 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/pkg/crypto"
 )
 
 // Config holds all service configuration loaded from environment variables.
 type Config struct {
 	Telemetry TelemetryConfig
 	Persona   PersonaConfig
+	Onfido    OnfidoConfig
+	Middesk   MiddeskConfig
+	Screening ScreeningConfig
+	KYC       KYCRefreshConfig
+	Review    ReviewConfig
+	Risk      RiskConfig
+	Duplicate DuplicateConfig
+	Documents DocumentsConfig
+	Privacy   PrivacyConfig
+	PII       crypto.KMSConfig
 	LogLevel  string
 	LogFormat string
 	Kafka     KafkaConfig
 	DB        DBConfig
+	Outbox    OutboxConfig
 	HTTPPort  int
 	GRPCPort  int
+	// Sandbox runs the service for sandbox tenants: every external provider
+	// is simulated, whatever the provider settings.
+	Sandbox bool
 }
 
 type DBConfig struct {
@@ -29,7 +48,8 @@ type DBConfig struct {
 }
 
 type KafkaConfig struct {
-	Brokers []string
+	ConsumerGroup string
+	Brokers       []string
 }
 
 type TelemetryConfig struct {
@@ -38,11 +58,125 @@ type TelemetryConfig struct {
 }
 
 type PersonaConfig struct {
+	APIKey        string
+	BaseURL       string
+	WebhookSecret string
+	Enabled       bool
+}
+
+type OnfidoConfig struct {
+	APIToken     string
+	BaseURL      string
+	WebhookToken string
+	Enabled      bool
+}
+
+type MiddeskConfig struct {
+	APIKey  string
+	BaseURL string
+	Enabled bool
+}
+
+// ScreeningConfig configures PEP, sanctions and adverse-media screening.
+// Approved verifications are re-screened once their last screening is older
+// than RescreenIntervalHours.
+type ScreeningConfig struct {
+	ComplyAdvantage       ComplyAdvantageConfig
+	Watchlist             WatchlistConfig
+	RescreenIntervalHours int
+}
+
+// WatchlistConfig configures in-house sanctions screening against the
+// published lists. Each location is an http(s) URL or a file path; a list
+// without one is not loaded, and with none set sanctions checks go to the
+// screening provider. Lists are reloaded every ReloadIntervalHours, and
+// names scoring Threshold (0-1) or more are reported as matches.
+type WatchlistConfig struct {
+	OFACSDNLocation     string
+	OFACAltLocation     string
+	EULocation          string
+	Threshold           float64
+	ReloadIntervalHours int
+}
+
+// Enabled reports whether any sanctions list is configured.
+func (c WatchlistConfig) Enabled() bool {
+	return c.OFACSDNLocation != "" || c.EULocation != ""
+}
+
+// KYCRefreshConfig sets how long an approved verification stays valid per
+// risk tier, and how many days before expiry the applicant is flagged for
+// re-verification.
+type KYCRefreshConfig struct {
+	ValidityDaysLow    int
+	ValidityDaysMedium int
+	ValidityDaysHigh   int
+	ExpiryNoticeDays   int
+}
+
+// ReviewConfig configures the analyst queue for verifications in REVIEW.
+// Cases are due SLAHours after they open; with FourEyes set, approvals need
+// a second analyst's confirmation.
+type ReviewConfig struct {
+	SLAHours int
+	FourEyes bool
+}
+
+// RiskConfig configures applicant risk scoring. HighRiskCountries is a
+// comma-separated list of ISO 3166-1 alpha-2 codes whose residents are
+// scored HIGH risk.
+type RiskConfig struct {
+	HighRiskCountries []string
+}
+
+// DuplicateConfig configures duplicate identity detection. Two applicants
+// match when their name similarity with the same date of birth reaches
+// NameThreshold, or their selfie embeddings' cosine similarity reaches
+// SelfieThreshold. Selfies are only compared when FaceEmbedding is enabled.
+type DuplicateConfig struct {
+	FaceEmbedding   FaceEmbeddingConfig
+	NameThreshold   float64
+	SelfieThreshold float64
+}
+
+type FaceEmbeddingConfig struct {
 	APIKey  string
 	BaseURL string
 	Enabled bool
 }
 
+type ComplyAdvantageConfig struct {
+	APIKey    string
+	BaseURL   string
+	Fuzziness float64
+	Enabled   bool
+}
+
+// DocumentsConfig configures encrypted storage of uploaded identity documents.
+// EncryptionKeys is a comma-separated list of id:base64-key pairs; the first
+// entry encrypts new documents and the rest remain available for decryption.
+type DocumentsConfig struct {
+	Backend        string
+	Dir            string
+	EncryptionKeys string
+	S3             S3Config
+	RetentionDays  int
+}
+
+// PrivacyConfig configures erasure of applicant personal data. Rejected and
+// expired verifications are erased RetentionDays after they were decided.
+type PrivacyConfig struct {
+	RetentionDays int
+}
+
+type S3Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+}
+
 // Validate checks required configuration values.
 func (c Config) Validate() {
 	if c.DB.Password == "" {
@@ -50,6 +184,12 @@ func (c Config) Validate() {
 	}
 }
 
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
 // Load reads configuration from environment variables with defaults.
 func Load() Config {
 	return Config{
@@ -66,19 +206,99 @@ func Load() Config {
 			MinConns: int32(getEnvInt("DB_MIN_CONNS", 5)),  //nolint:gosec // bounded by env config
 		},
 		Kafka: KafkaConfig{
-			Brokers: []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+			Brokers:       []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+			ConsumerGroup: getEnv("KAFKA_CONSUMER_GROUP", "identity-service"),
 		},
 		Telemetry: TelemetryConfig{
 			OTLPEndpoint: getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4317"),
 			ServiceName:  "identity-service",
 		},
+		Sandbox: getEnv("SANDBOX_MODE", "false") == "true",
 		Persona: PersonaConfig{
-			APIKey:  getEnv("PERSONA_API_KEY", ""),
-			BaseURL: getEnv("PERSONA_BASE_URL", "https://api.withpersona.com/api/v1"),
-			Enabled: getEnv("PERSONA_ENABLED", "false") == "true",
+			APIKey:        getEnv("PERSONA_API_KEY", ""),
+			BaseURL:       getEnv("PERSONA_BASE_URL", "https://api.withpersona.com/api/v1"),
+			WebhookSecret: getEnv("PERSONA_WEBHOOK_SECRET", ""),
+			Enabled:       getEnv("PERSONA_ENABLED", "false") == "true",
+		},
+		Onfido: OnfidoConfig{
+			APIToken:     getEnv("ONFIDO_API_TOKEN", ""),
+			BaseURL:      getEnv("ONFIDO_BASE_URL", "https://api.eu.onfido.com/v3.6"),
+			WebhookToken: getEnv("ONFIDO_WEBHOOK_TOKEN", ""),
+			Enabled:      getEnv("ONFIDO_ENABLED", "false") == "true",
+		},
+		Middesk: MiddeskConfig{
+			APIKey:  getEnv("MIDDESK_API_KEY", ""),
+			BaseURL: getEnv("MIDDESK_BASE_URL", "https://api.middesk.com/v1"),
+			Enabled: getEnv("MIDDESK_ENABLED", "false") == "true",
+		},
+		Screening: ScreeningConfig{
+			RescreenIntervalHours: getEnvInt("SCREENING_RESCREEN_INTERVAL_HOURS", 24),
+			Watchlist: WatchlistConfig{
+				OFACSDNLocation:     getEnv("SCREENING_OFAC_SDN_URL", ""),
+				OFACAltLocation:     getEnv("SCREENING_OFAC_ALT_URL", ""),
+				EULocation:          getEnv("SCREENING_EU_CONSOLIDATED_URL", ""),
+				Threshold:           getEnvFloat("SCREENING_WATCHLIST_THRESHOLD", 0.88),
+				ReloadIntervalHours: getEnvInt("SCREENING_WATCHLIST_RELOAD_INTERVAL_HOURS", 6),
+			},
+			ComplyAdvantage: ComplyAdvantageConfig{
+				APIKey:    getEnv("COMPLYADVANTAGE_API_KEY", ""),
+				BaseURL:   getEnv("COMPLYADVANTAGE_BASE_URL", "https://api.complyadvantage.com"),
+				Fuzziness: getEnvFloat("COMPLYADVANTAGE_FUZZINESS", 0.6),
+				Enabled:   getEnv("COMPLYADVANTAGE_ENABLED", "false") == "true",
+			},
+		},
+		KYC: KYCRefreshConfig{
+			ValidityDaysLow:    getEnvInt("KYC_VALIDITY_DAYS_LOW", 1095),
+			ValidityDaysMedium: getEnvInt("KYC_VALIDITY_DAYS_MEDIUM", 730),
+			ValidityDaysHigh:   getEnvInt("KYC_VALIDITY_DAYS_HIGH", 365),
+			ExpiryNoticeDays:   getEnvInt("KYC_EXPIRY_NOTICE_DAYS", 30),
+		},
+		Review: ReviewConfig{
+			SLAHours: getEnvInt("REVIEW_SLA_HOURS", 24),
+			FourEyes: getEnv("REVIEW_FOUR_EYES", "false") == "true",
+		},
+		Risk: RiskConfig{
+			HighRiskCountries: strings.Split(getEnv("RISK_HIGH_RISK_COUNTRIES", "KP,IR,MM"), ","),
+		},
+		Duplicate: DuplicateConfig{
+			NameThreshold:   getEnvFloat("DUPLICATE_NAME_THRESHOLD", 0.92),
+			SelfieThreshold: getEnvFloat("DUPLICATE_SELFIE_THRESHOLD", 0.85),
+			FaceEmbedding: FaceEmbeddingConfig{
+				APIKey:  getEnv("FACE_EMBEDDING_API_KEY", ""),
+				BaseURL: getEnv("FACE_EMBEDDING_BASE_URL", "http://localhost:8090/v1"),
+				Enabled: getEnv("FACE_EMBEDDING_ENABLED", "false") == "true",
+			},
+		},
+		Documents: DocumentsConfig{
+			Backend:        getEnv("DOCUMENT_STORE", "file"),
+			Dir:            getEnv("DOCUMENT_STORE_DIR", "/var/lib/identity-service/documents"),
+			EncryptionKeys: getEnv("DOCUMENT_ENCRYPTION_KEYS", ""),
+			RetentionDays:  getEnvInt("DOCUMENT_RETENTION_DAYS", 1825),
+			S3: S3Config{
+				Endpoint:  getEnv("S3_ENDPOINT", "http://localhost:9000"),
+				Region:    getEnv("S3_REGION", "us-east-1"),
+				Bucket:    getEnv("S3_BUCKET", "identity-documents"),
+				AccessKey: getEnv("S3_ACCESS_KEY", ""),
+				SecretKey: getEnv("S3_SECRET_KEY", ""),
+			},
+		},
+		Privacy: PrivacyConfig{
+			RetentionDays: getEnvInt("APPLICANT_DATA_RETENTION_DAYS", 1825),
+		},
+		PII: crypto.KMSConfig{
+			MasterKey:    getEnv("PII_MASTER_KEY", ""),
+			AWSKeyID:     getEnv("PII_KMS_KEY_ID", ""),
+			AWSEndpoint:  getEnv("PII_KMS_ENDPOINT", ""),
+			AWSRegion:    getEnv("PII_KMS_REGION", "us-east-1"),
+			AWSAccessKey: getEnv("PII_KMS_ACCESS_KEY", ""),
+			AWSSecretKey: getEnv("PII_KMS_SECRET_KEY", ""),
 		},
 		LogLevel:  getEnv("LOG_LEVEL", "info"),
 		LogFormat: getEnv("LOG_FORMAT", "json"),
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
 	}
 }
 
@@ -97,3 +317,40 @@ func getEnvInt(key string, defaultVal int) int {
 	}
 	return defaultVal
 }
+
+func getEnvFloat(key string, defaultVal float64) float64 {
+	if val := os.Getenv(key); val != "" {
+		if f, err := strconv.ParseFloat(val, 64); err == nil {
+			return f
+		}
+	}
+	return defaultVal
+}
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
@@ -0,0 +1,128 @@
+// Package encryption provides the DocumentCipher used to encrypt identity
+// documents before they leave the service.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+)
+
+// Compile-time interface check.
+var _ port.DocumentCipher = (*Keyring)(nil)
+
+// Keyring implements port.DocumentCipher with AES-256-GCM. Ciphertexts carry
+// the ID of the key that produced them, so keys can be rotated: new content
+// is sealed with the active key and older content stays readable as long as
+// its key remains in the ring.
+//
+// Layout: len(keyID) (1 byte) | keyID | nonce (12 bytes) | sealed content.
+type Keyring struct {
+	aeads    map[string]cipher.AEAD
+	activeID string
+}
+
+// NewKeyring builds a keyring from base64-encoded 32-byte keys indexed by
+// key ID. activeID selects the key used for encryption.
+func NewKeyring(keys map[string]string, activeID string) (*Keyring, error) {
+	if _, ok := keys[activeID]; !ok {
+		return nil, fmt.Errorf("active key %q is not in the keyring", activeID)
+	}
+
+	aeads := make(map[string]cipher.AEAD, len(keys))
+	for id, encoded := range keys {
+		if id == "" || len(id) > 255 {
+			return nil, fmt.Errorf("invalid key ID %q", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key %q is not valid base64: %w", id, err)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("key %q must be 32 bytes, got %d", id, len(key))
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", id, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", id, err)
+		}
+		aeads[id] = aead
+	}
+
+	return &Keyring{aeads: aeads, activeID: activeID}, nil
+}
+
+// ParseKeys parses "id:base64key,id:base64key" into a key map. The first
+// entry is returned as the active key ID.
+func ParseKeys(spec string) (map[string]string, string, error) {
+	keys := make(map[string]string)
+	var activeID string
+	for i, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, key, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, "", fmt.Errorf("key entry %d must be id:base64key", i+1)
+		}
+		if activeID == "" {
+			activeID = id
+		}
+		keys[id] = key
+	}
+	if activeID == "" {
+		return nil, "", fmt.Errorf("no encryption keys configured")
+	}
+	return keys, activeID, nil
+}
+
+// Encrypt seals plaintext with the active key.
+func (k *Keyring) Encrypt(plaintext, associatedData []byte) ([]byte, error) {
+	aead := k.aeads[k.activeID]
+
+	header := make([]byte, 0, 1+len(k.activeID)+aead.NonceSize())
+	header = append(header, byte(len(k.activeID)))
+	header = append(header, k.activeID...)
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	header = append(header, nonce...)
+
+	return aead.Seal(header, nonce, plaintext, associatedData), nil
+}
+
+// Decrypt opens a ciphertext produced by Encrypt with any key in the ring.
+func (k *Keyring) Decrypt(ciphertext, associatedData []byte) ([]byte, error) {
+	if len(ciphertext) < 1 {
+		return nil, fmt.Errorf("ciphertext is empty")
+	}
+	idLen := int(ciphertext[0])
+	if len(ciphertext) < 1+idLen {
+		return nil, fmt.Errorf("ciphertext is truncated")
+	}
+	keyID := string(ciphertext[1 : 1+idLen])
+	aead, ok := k.aeads[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown encryption key %q", keyID)
+	}
+
+	rest := ciphertext[1+idLen:]
+	if len(rest) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext is truncated")
+	}
+	plaintext, err := aead.Open(nil, rest[:aead.NonceSize()], rest[aead.NonceSize():], associatedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt document: %w", err)
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,73 @@
+package encryption_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/encryption"
+)
+
+const (
+	keyA = "YWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWE=" // 32 x 'a'
+	keyB = "YmJiYmJiYmJiYmJiYmJiYmJiYmJiYmJiYmJiYmJiYmI=" // 32 x 'b'
+)
+
+func TestKeyring_RoundTrip(t *testing.T) {
+	ring, err := encryption.NewKeyring(map[string]string{"k1": keyA}, "k1")
+	require.NoError(t, err)
+
+	plaintext := []byte("passport scan")
+	ciphertext, err := ring.Encrypt(plaintext, []byte("doc-1"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(ciphertext), "passport scan")
+
+	got, err := ring.Decrypt(ciphertext, []byte("doc-1"))
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, got)
+
+	_, err = ring.Decrypt(ciphertext, []byte("doc-2"))
+	assert.Error(t, err, "ciphertext must be bound to its associated data")
+
+	ciphertext[len(ciphertext)-1] ^= 0xff
+	_, err = ring.Decrypt(ciphertext, []byte("doc-1"))
+	assert.Error(t, err, "tampering must be detected")
+}
+
+func TestKeyring_Rotation(t *testing.T) {
+	oldRing, err := encryption.NewKeyring(map[string]string{"k1": keyA}, "k1")
+	require.NoError(t, err)
+	sealed, err := oldRing.Encrypt([]byte("selfie"), nil)
+	require.NoError(t, err)
+
+	keys, active, err := encryption.ParseKeys("k2:" + keyB + ", k1:" + keyA)
+	require.NoError(t, err)
+	assert.Equal(t, "k2", active)
+	rotated, err := encryption.NewKeyring(keys, active)
+	require.NoError(t, err)
+
+	got, err := rotated.Decrypt(sealed, nil)
+	require.NoError(t, err, "content sealed with a retired key stays readable")
+	assert.Equal(t, []byte("selfie"), got)
+
+	resealed, err := rotated.Encrypt([]byte("selfie"), nil)
+	require.NoError(t, err)
+	_, err = oldRing.Decrypt(resealed, nil)
+	assert.Error(t, err, "new content uses the active key")
+}
+
+func TestKeyring_InvalidConfig(t *testing.T) {
+	_, err := encryption.NewKeyring(map[string]string{"k1": "c2hvcnQ="}, "k1")
+	assert.Error(t, err)
+
+	_, err = encryption.NewKeyring(map[string]string{"k1": keyA}, "k2")
+	assert.Error(t, err)
+
+	_, _, err = encryption.ParseKeys("")
+	assert.Error(t, err)
+
+	_, _, err = encryption.ParseKeys(keyA)
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), keyA, "errors must not echo key material")
+}
@@ -0,0 +1,52 @@
+// Package objectstore provides the DocumentStore adapter.
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/bibbank/bib/pkg/archive"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+)
+
+// Compile-time interface check.
+var _ port.DocumentStore = (*Store)(nil)
+
+// Store implements port.DocumentStore on the platform's object storage: an
+// S3 bucket, where objects are also encrypted server-side beneath the
+// application encryption, or a local directory for development.
+type Store struct {
+	store archive.Store
+}
+
+// New creates a Store keeping objects in store.
+func New(store archive.Store) *Store {
+	return &Store{store: store}
+}
+
+// Put writes data under key, replacing any existing object.
+func (s *Store) Put(ctx context.Context, key string, data []byte) error {
+	return s.store.Put(ctx, key, bytes.NewReader(data), "application/octet-stream")
+}
+
+// Get reads the object stored under key.
+func (s *Store) Get(ctx context.Context, key string) ([]byte, error) {
+	obj, err := s.store.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+	return data, nil
+}
+
+// Delete removes the object stored under key. Deleting a missing object is
+// not an error.
+func (s *Store) Delete(ctx context.Context, key string) error {
+	return s.store.Delete(ctx, key)
+}
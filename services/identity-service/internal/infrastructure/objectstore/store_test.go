@@ -0,0 +1,37 @@
+package objectstore_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/archive"
+	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/objectstore"
+)
+
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	store := objectstore.New(archive.NewFileStore(dir))
+	ctx := context.Background()
+
+	require.NoError(t, store.Put(ctx, "identity/t1/v1/d1", []byte("sealed")))
+
+	info, err := os.Stat(filepath.Join(dir, "identity/t1/v1/d1"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	data, err := store.Get(ctx, "identity/t1/v1/d1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("sealed"), data)
+
+	require.NoError(t, store.Delete(ctx, "identity/t1/v1/d1"))
+	require.NoError(t, store.Delete(ctx, "identity/t1/v1/d1"), "deleting twice is not an error")
+	_, err = store.Get(ctx, "identity/t1/v1/d1")
+	assert.Error(t, err)
+
+	assert.Error(t, store.Put(ctx, "../escape", []byte("x")))
+}
@@ -0,0 +1,203 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// Compile-time interface check
+var _ port.BusinessVerificationRepository = (*BusinessVerificationRepo)(nil)
+
+// BusinessVerificationRepo implements BusinessVerificationRepository using PostgreSQL.
+type BusinessVerificationRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewBusinessVerificationRepo(pool *pgxpool.Pool) *BusinessVerificationRepo {
+	return &BusinessVerificationRepo{pool: pool}
+}
+
+// beneficialOwnerRow is the JSONB representation of a beneficial owner.
+type beneficialOwnerRow struct {
+	FirstName        string  `json:"first_name"`
+	LastName         string  `json:"last_name"`
+	DateOfBirth      string  `json:"date_of_birth"`
+	Country          string  `json:"country"`
+	OwnershipPercent float64 `json:"ownership_percent"`
+}
+
+func (r *BusinessVerificationRepo) Save(ctx context.Context, b model.BusinessVerification) error {
+	owners := make([]beneficialOwnerRow, 0, len(b.BeneficialOwners()))
+	for _, o := range b.BeneficialOwners() {
+		owners = append(owners, beneficialOwnerRow{
+			FirstName:        o.FirstName(),
+			LastName:         o.LastName(),
+			DateOfBirth:      o.DateOfBirth(),
+			Country:          o.Country(),
+			OwnershipPercent: o.OwnershipPercent(),
+		})
+	}
+	ownersJSON, err := json.Marshal(owners)
+	if err != nil {
+		return fmt.Errorf("marshal beneficial owners: %w", err)
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO business_verifications (id, tenant_id, legal_name, registration_number,
+			country, beneficial_owners, status, version, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+	`, b.ID(), b.TenantID(), b.LegalName(), b.RegistrationNumber(),
+		b.Country(), ownersJSON, b.Status().String(), b.Version(), b.CreatedAt(), b.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf("upsert business verification: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM business_verification_checks WHERE business_verification_id = $1`, b.ID())
+	if err != nil {
+		return fmt.Errorf("delete existing checks: %w", err)
+	}
+
+	for _, c := range b.Checks() {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO business_verification_checks (id, business_verification_id, check_type, status,
+				provider, provider_reference, completed_at, failure_reason)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`, c.ID(), b.ID(), c.CheckType().String(), c.Status().String(),
+			c.Provider(), c.ProviderReference(), c.CompletedAt(), c.FailureReason())
+		if err != nil {
+			return fmt.Errorf("insert check %s: %w", c.ID(), err)
+		}
+	}
+
+	// Write domain events to outbox
+	for _, evt := range b.DomainEvents() {
+		payload, merr := json.Marshal(evt)
+		if merr != nil {
+			return fmt.Errorf("marshal outbox event: %w", merr)
+		}
+		_, err = tx.Exec(ctx, `
+			INSERT INTO outbox (id, aggregate_id, aggregate_type, event_type, payload, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`, evt.EventID(), evt.AggregateID(), evt.AggregateType(), evt.EventType(), payload, evt.OccurredAt())
+		if err != nil {
+			return fmt.Errorf("insert outbox event: %w", err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *BusinessVerificationRepo) FindByID(ctx context.Context, id uuid.UUID) (model.BusinessVerification, error) {
+	var (
+		bID                uuid.UUID
+		tenantID           uuid.UUID
+		legalName          string
+		registrationNumber string
+		country            string
+		ownersJSON         []byte
+		status             string
+		version            int
+		createdAt          time.Time
+		updatedAt          time.Time
+	)
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT id, tenant_id, legal_name, registration_number, country,
+			beneficial_owners, status, version, created_at, updated_at
+		FROM business_verifications WHERE id = $1
+	`, id).Scan(&bID, &tenantID, &legalName, &registrationNumber, &country,
+		&ownersJSON, &status, &version, &createdAt, &updatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return model.BusinessVerification{}, fmt.Errorf("%w: %s", port.ErrBusinessVerificationNotFound, id)
+		}
+		return model.BusinessVerification{}, fmt.Errorf("query business verification: %w", err)
+	}
+
+	var rows []beneficialOwnerRow
+	if err := json.Unmarshal(ownersJSON, &rows); err != nil {
+		return model.BusinessVerification{}, fmt.Errorf("invalid beneficial owners in DB: %w", err)
+	}
+	owners := make([]model.BeneficialOwner, 0, len(rows))
+	for _, o := range rows {
+		owners = append(owners, model.ReconstructBeneficialOwner(o.FirstName, o.LastName, o.DateOfBirth, o.Country, o.OwnershipPercent))
+	}
+
+	checks, err := r.findChecks(ctx, id)
+	if err != nil {
+		return model.BusinessVerification{}, err
+	}
+
+	verificationStatus, err := valueobject.NewVerificationStatus(status)
+	if err != nil {
+		return model.BusinessVerification{}, fmt.Errorf("invalid verification status in DB: %w", err)
+	}
+
+	return model.ReconstructBusinessVerification(
+		bID, tenantID,
+		legalName, registrationNumber, country,
+		owners, verificationStatus, checks,
+		version, createdAt, updatedAt,
+	), nil
+}
+
+func (r *BusinessVerificationRepo) findChecks(ctx context.Context, verificationID uuid.UUID) ([]model.VerificationCheck, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, check_type, status, provider, provider_reference, completed_at, failure_reason
+		FROM business_verification_checks WHERE business_verification_id = $1
+		ORDER BY id
+	`, verificationID)
+	if err != nil {
+		return nil, fmt.Errorf("query checks: %w", err)
+	}
+	defer rows.Close()
+
+	var checks []model.VerificationCheck
+	for rows.Next() {
+		var (
+			id            uuid.UUID
+			checkTypeStr  string
+			statusStr     string
+			provider      string
+			providerRef   string
+			completedAt   *time.Time
+			failureReason string
+		)
+		if err := rows.Scan(&id, &checkTypeStr, &statusStr, &provider, &providerRef, &completedAt, &failureReason); err != nil {
+			return nil, fmt.Errorf("scan check: %w", err)
+		}
+
+		checkType, err := valueobject.NewCheckType(checkTypeStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid check type in DB: %w", err)
+		}
+		status, err := valueobject.NewVerificationStatus(statusStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid check status in DB: %w", err)
+		}
+
+		checks = append(checks, model.ReconstructCheck(id, checkType, status, provider, providerRef, completedAt, failureReason))
+	}
+
+	return checks, rows.Err()
+}
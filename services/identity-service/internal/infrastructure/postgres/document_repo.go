@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// Compile-time interface check
+var _ port.DocumentRepository = (*DocumentRepo)(nil)
+
+// DocumentRepo implements DocumentRepository using PostgreSQL.
+type DocumentRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewDocumentRepo(pool *pgxpool.Pool) *DocumentRepo {
+	return &DocumentRepo{pool: pool}
+}
+
+const documentColumns = `id, tenant_id, verification_id, check_id, document_type, content_type,
+	size_bytes, sha256, storage_key, uploaded_at, retain_until, purged_at`
+
+func (r *DocumentRepo) Save(ctx context.Context, d model.IdentityDocument) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO identity_documents (`+documentColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (id) DO UPDATE SET
+			purged_at = EXCLUDED.purged_at
+	`, d.ID(), d.TenantID(), d.VerificationID(), d.CheckID(), d.DocumentType().String(), d.ContentType(),
+		d.SizeBytes(), d.SHA256(), d.StorageKey(), d.UploadedAt(), d.RetainUntil(), d.PurgedAt())
+	if err != nil {
+		return fmt.Errorf("upsert identity document: %w", err)
+	}
+	return nil
+}
+
+func (r *DocumentRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.IdentityDocument, error) {
+	row := r.pool.QueryRow(ctx, `
+		SELECT `+documentColumns+`
+		FROM identity_documents WHERE tenant_id = $1 AND id = $2
+	`, tenantID, id)
+	d, err := scanDocument(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return model.IdentityDocument{}, fmt.Errorf("%w: %s", port.ErrDocumentNotFound, id)
+		}
+		return model.IdentityDocument{}, err
+	}
+	return d, nil
+}
+
+func (r *DocumentRepo) ListByVerification(ctx context.Context, tenantID, verificationID uuid.UUID) ([]model.IdentityDocument, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+documentColumns+`
+		FROM identity_documents
+		WHERE tenant_id = $1 AND verification_id = $2
+		ORDER BY uploaded_at, id
+	`, tenantID, verificationID)
+	if err != nil {
+		return nil, fmt.Errorf("query documents: %w", err)
+	}
+	return collectDocuments(rows)
+}
+
+func (r *DocumentRepo) ListExpired(ctx context.Context, cutoff time.Time, limit int) ([]model.IdentityDocument, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+documentColumns+`
+		FROM identity_documents
+		WHERE purged_at IS NULL AND retain_until < $1
+		ORDER BY retain_until
+		LIMIT $2
+	`, cutoff, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query expired documents: %w", err)
+	}
+	return collectDocuments(rows)
+}
+
+func collectDocuments(rows pgx.Rows) ([]model.IdentityDocument, error) {
+	defer rows.Close()
+
+	var documents []model.IdentityDocument
+	for rows.Next() {
+		d, err := scanDocument(rows)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate documents: %w", err)
+	}
+	return documents, nil
+}
+
+func scanDocument(row pgx.Row) (model.IdentityDocument, error) {
+	var (
+		id, tenantID, verificationID, checkID uuid.UUID
+		documentTypeStr, contentType          string
+		sizeBytes                             int64
+		sha256Hex, storageKey                 string
+		uploadedAt, retainUntil               time.Time
+		purgedAt                              *time.Time
+	)
+	if err := row.Scan(&id, &tenantID, &verificationID, &checkID, &documentTypeStr, &contentType,
+		&sizeBytes, &sha256Hex, &storageKey, &uploadedAt, &retainUntil, &purgedAt); err != nil {
+		if err == pgx.ErrNoRows {
+			return model.IdentityDocument{}, err
+		}
+		return model.IdentityDocument{}, fmt.Errorf("scan document: %w", err)
+	}
+
+	documentType, err := valueobject.NewDocumentType(documentTypeStr)
+	if err != nil {
+		return model.IdentityDocument{}, fmt.Errorf("invalid document type in DB: %w", err)
+	}
+
+	return model.ReconstructDocument(
+		id, tenantID, verificationID, checkID,
+		documentType, contentType, sizeBytes,
+		sha256Hex, storageKey,
+		uploadedAt, retainUntil, purgedAt,
+	), nil
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+)
+
+// Compile-time interface check
+var _ port.ApplicantFingerprintRepository = (*FingerprintRepo)(nil)
+
+// FingerprintRepo implements ApplicantFingerprintRepository using PostgreSQL.
+type FingerprintRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewFingerprintRepo(pool *pgxpool.Pool) *FingerprintRepo {
+	return &FingerprintRepo{pool: pool}
+}
+
+const fingerprintColumns = `verification_id, tenant_id, applicant_id, first_name, last_name, date_of_birth,
+	document_number_hash, selfie_embedding, matches, created_at, updated_at`
+
+func (r *FingerprintRepo) Save(ctx context.Context, f model.ApplicantFingerprint) error {
+	matches, err := json.Marshal(f.Matches())
+	if err != nil {
+		return fmt.Errorf("marshal duplicate matches: %w", err)
+	}
+	var embedding []float32
+	if e := f.SelfieEmbedding(); len(e) > 0 {
+		embedding = e
+	}
+	_, err = r.pool.Exec(ctx, `
+		INSERT INTO applicant_fingerprints (`+fingerprintColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (verification_id) DO UPDATE SET
+			first_name = EXCLUDED.first_name,
+			last_name = EXCLUDED.last_name,
+			date_of_birth = EXCLUDED.date_of_birth,
+			selfie_embedding = EXCLUDED.selfie_embedding,
+			matches = EXCLUDED.matches,
+			updated_at = EXCLUDED.updated_at
+	`, f.VerificationID(), f.TenantID(), f.ApplicantID(), f.FirstName(), f.LastName(), f.DateOfBirth(),
+		f.DocumentNumberHash(), embedding, matches, f.CreatedAt(), f.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf("upsert applicant fingerprint: %w", err)
+	}
+	return nil
+}
+
+func (r *FingerprintRepo) FindByVerification(ctx context.Context, verificationID uuid.UUID) (model.ApplicantFingerprint, error) {
+	row := r.pool.QueryRow(ctx, `
+		SELECT `+fingerprintColumns+`
+		FROM applicant_fingerprints WHERE verification_id = $1
+	`, verificationID)
+	f, err := scanFingerprint(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return model.ApplicantFingerprint{}, fmt.Errorf("%w: verification %s", port.ErrFingerprintNotFound, verificationID)
+		}
+		return model.ApplicantFingerprint{}, err
+	}
+	return f, nil
+}
+
+func (r *FingerprintRepo) FindCandidates(ctx context.Context, f model.ApplicantFingerprint, limit int) ([]model.ApplicantFingerprint, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+fingerprintColumns+` FROM (
+			SELECT `+fingerprintColumns+` FROM applicant_fingerprints
+			WHERE tenant_id = $1 AND applicant_id <> $2 AND $3 <> '' AND document_number_hash = $3
+			UNION
+			SELECT `+fingerprintColumns+` FROM applicant_fingerprints
+			WHERE tenant_id = $1 AND applicant_id <> $2 AND date_of_birth = $4
+			UNION
+			(SELECT `+fingerprintColumns+` FROM applicant_fingerprints
+			WHERE tenant_id = $1 AND applicant_id <> $2 AND $5 AND selfie_embedding IS NOT NULL
+			ORDER BY updated_at DESC LIMIT $6)
+		) candidates
+		ORDER BY updated_at DESC
+		LIMIT $6
+	`, f.TenantID(), f.ApplicantID(), f.DocumentNumberHash(), f.DateOfBirth(), len(f.SelfieEmbedding()) > 0, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query fingerprint candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []model.ApplicantFingerprint
+	for rows.Next() {
+		c, err := scanFingerprint(rows)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate fingerprint candidates: %w", err)
+	}
+	return candidates, nil
+}
+
+func scanFingerprint(row pgx.Row) (model.ApplicantFingerprint, error) {
+	var (
+		verificationID, tenantID, applicantID uuid.UUID
+		firstName, lastName, dob, docHash     string
+		embedding                             []float32
+		matchesJSON                           []byte
+		createdAt, updatedAt                  time.Time
+	)
+	if err := row.Scan(&verificationID, &tenantID, &applicantID, &firstName, &lastName, &dob,
+		&docHash, &embedding, &matchesJSON, &createdAt, &updatedAt); err != nil {
+		if err == pgx.ErrNoRows {
+			return model.ApplicantFingerprint{}, err
+		}
+		return model.ApplicantFingerprint{}, fmt.Errorf("scan applicant fingerprint: %w", err)
+	}
+
+	var matches []model.DuplicateMatch
+	if err := json.Unmarshal(matchesJSON, &matches); err != nil {
+		return model.ApplicantFingerprint{}, fmt.Errorf("unmarshal duplicate matches: %w", err)
+	}
+
+	return model.ReconstructApplicantFingerprint(
+		tenantID, verificationID, applicantID,
+		firstName, lastName, dob, docHash,
+		embedding, matches,
+		createdAt, updatedAt,
+	), nil
+}
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_checks_provider_reference;
@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_checks_provider_reference
+    ON verification_checks (provider, provider_reference)
+    WHERE provider_reference <> '';
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS identity_documents (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    verification_id UUID NOT NULL REFERENCES identity_verifications(id),
+    check_id UUID NOT NULL,
+    document_type VARCHAR(30) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    sha256 CHAR(64) NOT NULL,
+    storage_key VARCHAR(512) NOT NULL,
+    uploaded_at TIMESTAMPTZ NOT NULL,
+    retain_until TIMESTAMPTZ NOT NULL,
+    purged_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_documents_verification ON identity_documents (tenant_id, verification_id, uploaded_at);
+CREATE INDEX idx_documents_retention ON identity_documents (retain_until) WHERE purged_at IS NULL;
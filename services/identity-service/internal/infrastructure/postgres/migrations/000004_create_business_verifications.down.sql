@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS business_verification_checks;
+DROP TABLE IF EXISTS business_verifications;
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS business_verifications (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    legal_name VARCHAR(255) NOT NULL,
+    registration_number VARCHAR(100) NOT NULL,
+    country VARCHAR(3) NOT NULL,
+    beneficial_owners JSONB NOT NULL DEFAULT '[]',
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS business_verification_checks (
+    id UUID PRIMARY KEY,
+    business_verification_id UUID NOT NULL REFERENCES business_verifications(id),
+    check_type VARCHAR(30) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    provider VARCHAR(50) NOT NULL DEFAULT '',
+    provider_reference VARCHAR(255) NOT NULL DEFAULT '',
+    completed_at TIMESTAMPTZ,
+    failure_reason TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_business_verifications_tenant ON business_verifications (tenant_id);
+CREATE INDEX idx_business_verifications_registration ON business_verifications (tenant_id, country, registration_number);
+CREATE INDEX idx_business_checks_verification ON business_verification_checks (business_verification_id);
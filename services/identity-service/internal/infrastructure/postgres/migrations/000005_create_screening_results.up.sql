@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS screening_results (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    verification_id UUID NOT NULL REFERENCES identity_verifications(id),
+    check_id UUID NOT NULL REFERENCES verification_checks(id),
+    check_type VARCHAR(20) NOT NULL,
+    provider VARCHAR(50) NOT NULL,
+    provider_reference VARCHAR(255) NOT NULL DEFAULT '',
+    trigger VARCHAR(20) NOT NULL,
+    outcome VARCHAR(10) NOT NULL,
+    matches JSONB NOT NULL DEFAULT '[]',
+    screened_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_screening_results_verification ON screening_results (tenant_id, verification_id, screened_at DESC);
+CREATE INDEX idx_screening_results_screened ON screening_results (verification_id, screened_at);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_verifications_expires_at;
+
+ALTER TABLE identity_verifications
+    DROP COLUMN IF EXISTS previous_verification_id,
+    DROP COLUMN IF EXISTS expiry_notified_at,
+    DROP COLUMN IF EXISTS expires_at,
+    DROP COLUMN IF EXISTS validity_days,
+    DROP COLUMN IF EXISTS risk_tier;
@@ -0,0 +1,14 @@
+ALTER TABLE identity_verifications
+    ADD COLUMN risk_tier VARCHAR(10) NOT NULL DEFAULT 'MEDIUM',
+    ADD COLUMN validity_days INT NOT NULL DEFAULT 730,
+    ADD COLUMN expires_at TIMESTAMPTZ,
+    ADD COLUMN expiry_notified_at TIMESTAMPTZ,
+    ADD COLUMN previous_verification_id UUID REFERENCES identity_verifications(id);
+
+-- Existing approvals lapse one validity period after they were last updated.
+UPDATE identity_verifications
+SET expires_at = updated_at + make_interval(days => validity_days)
+WHERE status = 'APPROVED';
+
+CREATE INDEX idx_verifications_expires_at ON identity_verifications (expires_at)
+    WHERE status IN ('APPROVED', 'REVIEW');
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS review_cases (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    verification_id UUID NOT NULL REFERENCES identity_verifications(id),
+    status VARCHAR(30) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    outcome VARCHAR(10) NOT NULL DEFAULT '',
+    requires_four_eyes BOOLEAN NOT NULL DEFAULT FALSE,
+    assignee_id UUID,
+    decisions JSONB NOT NULL DEFAULT '[]',
+    opened_at TIMESTAMPTZ NOT NULL,
+    due_at TIMESTAMPTZ NOT NULL,
+    claimed_at TIMESTAMPTZ,
+    decided_at TIMESTAMPTZ,
+    version INT NOT NULL DEFAULT 1,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- At most one undecided case per verification.
+CREATE UNIQUE INDEX idx_review_cases_active_verification ON review_cases (verification_id)
+    WHERE status <> 'DECIDED';
+CREATE INDEX idx_review_cases_queue ON review_cases (tenant_id, status, due_at);
+CREATE INDEX idx_review_cases_decided ON review_cases (tenant_id, decided_at)
+    WHERE decided_at IS NOT NULL;
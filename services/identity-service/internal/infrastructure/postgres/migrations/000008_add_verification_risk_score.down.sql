@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_verifications_risk_tier;
+
+ALTER TABLE identity_verifications
+    DROP COLUMN IF EXISTS risk_factors,
+    DROP COLUMN IF EXISTS risk_score;
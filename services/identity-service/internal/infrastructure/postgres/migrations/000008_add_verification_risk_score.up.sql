@@ -0,0 +1,5 @@
+ALTER TABLE identity_verifications
+    ADD COLUMN risk_score INT NOT NULL DEFAULT 0,
+    ADD COLUMN risk_factors TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_verifications_risk_tier ON identity_verifications (tenant_id, risk_tier);
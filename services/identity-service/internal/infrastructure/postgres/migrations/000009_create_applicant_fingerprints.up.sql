@@ -0,0 +1,19 @@
+CREATE TABLE applicant_fingerprints (
+    verification_id UUID PRIMARY KEY REFERENCES identity_verifications(id),
+    tenant_id UUID NOT NULL,
+    applicant_id UUID NOT NULL,
+    first_name VARCHAR(255) NOT NULL,
+    last_name VARCHAR(255) NOT NULL,
+    date_of_birth VARCHAR(10) NOT NULL,
+    document_number_hash VARCHAR(64) NOT NULL DEFAULT '',
+    selfie_embedding REAL[],
+    matches JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_fingerprints_document_number ON applicant_fingerprints (tenant_id, document_number_hash)
+    WHERE document_number_hash <> '';
+CREATE INDEX idx_fingerprints_dob ON applicant_fingerprints (tenant_id, date_of_birth);
+CREATE INDEX idx_fingerprints_selfie ON applicant_fingerprints (tenant_id, updated_at DESC)
+    WHERE selfie_embedding IS NOT NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_verifications_erasure;
+DROP INDEX IF EXISTS idx_verifications_applicant_email;
+
+ALTER TABLE identity_verifications
+    DROP COLUMN IF EXISTS erased_at;
@@ -0,0 +1,7 @@
+ALTER TABLE identity_verifications
+    ADD COLUMN erased_at TIMESTAMPTZ;
+
+CREATE INDEX idx_verifications_applicant_email ON identity_verifications (tenant_id, lower(applicant_email))
+    WHERE erased_at IS NULL;
+CREATE INDEX idx_verifications_erasure ON identity_verifications (updated_at)
+    WHERE erased_at IS NULL AND status IN ('REJECTED', 'EXPIRED');
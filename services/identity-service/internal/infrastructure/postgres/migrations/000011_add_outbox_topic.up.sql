@@ -0,0 +1,3 @@
+-- Topic the relay publishes each outbox event to. Events written by a
+-- repository before the use case published them have none until then.
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS topic VARCHAR(255) NOT NULL DEFAULT '';
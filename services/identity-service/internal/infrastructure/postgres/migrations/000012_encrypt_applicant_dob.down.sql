@@ -0,0 +1,4 @@
+-- Sealed values are longer than the old column and unreadable without
+-- data_keys: decrypt them before rolling back.
+ALTER TABLE identity_verifications ALTER COLUMN applicant_dob TYPE VARCHAR(10);
+DROP TABLE IF EXISTS data_keys;
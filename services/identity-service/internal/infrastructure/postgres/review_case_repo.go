@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+)
+
+// Compile-time interface check
+var _ port.ReviewCaseRepository = (*ReviewCaseRepo)(nil)
+
+// ReviewCaseRepo implements ReviewCaseRepository using PostgreSQL.
+type ReviewCaseRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewReviewCaseRepo(pool *pgxpool.Pool) *ReviewCaseRepo {
+	return &ReviewCaseRepo{pool: pool}
+}
+
+const reviewCaseColumns = `id, tenant_id, verification_id, status, reason, outcome, requires_four_eyes,
+	assignee_id, decisions, opened_at, due_at, claimed_at, decided_at, version, updated_at`
+
+func (r *ReviewCaseRepo) Save(ctx context.Context, c model.ReviewCase) error {
+	decisions, err := json.Marshal(c.Decisions())
+	if err != nil {
+		return fmt.Errorf("marshal review decisions: %w", err)
+	}
+	_, err = r.pool.Exec(ctx, `
+		INSERT INTO review_cases (`+reviewCaseColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			outcome = EXCLUDED.outcome,
+			assignee_id = EXCLUDED.assignee_id,
+			decisions = EXCLUDED.decisions,
+			claimed_at = EXCLUDED.claimed_at,
+			decided_at = EXCLUDED.decided_at,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+	`, c.ID(), c.TenantID(), c.VerificationID(), c.Status(), c.Reason(), c.Outcome(), c.RequiresFourEyes(),
+		nullableUUID(c.AssigneeID()), decisions, c.OpenedAt(), c.DueAt(), c.ClaimedAt(), c.DecidedAt(),
+		c.Version(), c.UpdatedAt())
+	if err != nil {
+		return fmt.Errorf("upsert review case: %w", err)
+	}
+	return nil
+}
+
+func (r *ReviewCaseRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.ReviewCase, error) {
+	row := r.pool.QueryRow(ctx, `
+		SELECT `+reviewCaseColumns+`
+		FROM review_cases WHERE tenant_id = $1 AND id = $2
+	`, tenantID, id)
+	c, err := scanReviewCase(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return model.ReviewCase{}, fmt.Errorf("%w: %s", port.ErrReviewCaseNotFound, id)
+		}
+		return model.ReviewCase{}, err
+	}
+	return c, nil
+}
+
+func (r *ReviewCaseRepo) FindActiveByVerification(ctx context.Context, verificationID uuid.UUID) (model.ReviewCase, error) {
+	row := r.pool.QueryRow(ctx, `
+		SELECT `+reviewCaseColumns+`
+		FROM review_cases WHERE verification_id = $1 AND status <> $2
+	`, verificationID, model.ReviewStatusDecided)
+	c, err := scanReviewCase(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return model.ReviewCase{}, fmt.Errorf("%w: verification %s", port.ErrReviewCaseNotFound, verificationID)
+		}
+		return model.ReviewCase{}, err
+	}
+	return c, nil
+}
+
+func (r *ReviewCaseRepo) ListQueue(ctx context.Context, tenantID uuid.UUID, filter port.ReviewQueueFilter, limit, offset int) ([]model.ReviewCase, int, error) {
+	const where = `WHERE tenant_id = $1 AND ($2 = '' OR status = $2) AND ($3::uuid IS NULL OR assignee_id = $3)`
+	assignee := nullableUUID(filter.AssigneeID)
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM review_cases `+where,
+		tenantID, filter.Status, assignee).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count review cases: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+reviewCaseColumns+`
+		FROM review_cases `+where+`
+		ORDER BY due_at, id
+		LIMIT $4 OFFSET $5
+	`, tenantID, filter.Status, assignee, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query review cases: %w", err)
+	}
+	defer rows.Close()
+
+	var cases []model.ReviewCase
+	for rows.Next() {
+		c, err := scanReviewCase(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		cases = append(cases, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("iterate review cases: %w", err)
+	}
+	return cases, total, nil
+}
+
+func (r *ReviewCaseRepo) Metrics(ctx context.Context, tenantID uuid.UUID, since, now time.Time) (port.ReviewMetrics, error) {
+	var (
+		m              port.ReviewMetrics
+		avgSec, p95Sec float64
+	)
+	err := r.pool.QueryRow(ctx, `
+		SELECT
+			COUNT(*) FILTER (WHERE status <> $2),
+			COUNT(*) FILTER (WHERE status = $3),
+			COUNT(*) FILTER (WHERE status <> $2 AND due_at < $5),
+			COUNT(*) FILTER (WHERE decided_at >= $4),
+			COUNT(*) FILTER (WHERE decided_at >= $4 AND decided_at <= due_at),
+			COALESCE(AVG(EXTRACT(EPOCH FROM decided_at - opened_at)) FILTER (WHERE decided_at >= $4), 0)::float8,
+			COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM decided_at - opened_at))
+				FILTER (WHERE decided_at >= $4), 0)::float8
+		FROM review_cases
+		WHERE tenant_id = $1
+	`, tenantID, model.ReviewStatusDecided, model.ReviewStatusAwaitingSecondApproval, since, now).Scan(
+		&m.Open, &m.AwaitingSecond, &m.Overdue, &m.Decided, &m.DecidedWithinSLA, &avgSec, &p95Sec)
+	if err != nil {
+		return port.ReviewMetrics{}, fmt.Errorf("query review metrics: %w", err)
+	}
+	m.AvgTimeToDecision = time.Duration(avgSec * float64(time.Second))
+	m.P95TimeToDecision = time.Duration(p95Sec * float64(time.Second))
+	return m, nil
+}
+
+func scanReviewCase(row pgx.Row) (model.ReviewCase, error) {
+	var (
+		id, tenantID, verificationID uuid.UUID
+		status, reason, outcome      string
+		requiresFourEyes             bool
+		assigneeID                   *uuid.UUID
+		decisionsJSON                []byte
+		openedAt, dueAt, updatedAt   time.Time
+		claimedAt, decidedAt         *time.Time
+		version                      int
+	)
+	if err := row.Scan(&id, &tenantID, &verificationID, &status, &reason, &outcome, &requiresFourEyes,
+		&assigneeID, &decisionsJSON, &openedAt, &dueAt, &claimedAt, &decidedAt, &version, &updatedAt); err != nil {
+		if err == pgx.ErrNoRows {
+			return model.ReviewCase{}, err
+		}
+		return model.ReviewCase{}, fmt.Errorf("scan review case: %w", err)
+	}
+
+	var decisions []model.ReviewDecision
+	if err := json.Unmarshal(decisionsJSON, &decisions); err != nil {
+		return model.ReviewCase{}, fmt.Errorf("unmarshal review decisions: %w", err)
+	}
+	var assignee uuid.UUID
+	if assigneeID != nil {
+		assignee = *assigneeID
+	}
+
+	return model.ReconstructReviewCase(
+		id, tenantID, verificationID,
+		status, reason, outcome, requiresFourEyes,
+		assignee, decisions,
+		openedAt, dueAt, claimedAt, decidedAt,
+		version, updatedAt,
+	), nil
+}
@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// Compile-time interface check
+var _ port.ScreeningResultRepository = (*ScreeningResultRepo)(nil)
+
+// ScreeningResultRepo implements ScreeningResultRepository using PostgreSQL.
+type ScreeningResultRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewScreeningResultRepo(pool *pgxpool.Pool) *ScreeningResultRepo {
+	return &ScreeningResultRepo{pool: pool}
+}
+
+const screeningResultColumns = `id, tenant_id, verification_id, check_id, check_type, provider,
+	provider_reference, trigger, matches, screened_at`
+
+func (r *ScreeningResultRepo) Save(ctx context.Context, s model.ScreeningResult) error {
+	matches, err := json.Marshal(s.Matches())
+	if err != nil {
+		return fmt.Errorf("marshal screening matches: %w", err)
+	}
+	_, err = r.pool.Exec(ctx, `
+		INSERT INTO screening_results (`+screeningResultColumns+`, outcome)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`, s.ID(), s.TenantID(), s.VerificationID(), s.CheckID(), s.CheckType().String(), s.Provider(),
+		s.ProviderReference(), s.Trigger(), matches, s.ScreenedAt(), s.Outcome())
+	if err != nil {
+		return fmt.Errorf("insert screening result: %w", err)
+	}
+	return nil
+}
+
+func (r *ScreeningResultRepo) ListByVerification(ctx context.Context, tenantID, verificationID uuid.UUID) ([]model.ScreeningResult, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+screeningResultColumns+`
+		FROM screening_results
+		WHERE tenant_id = $1 AND verification_id = $2
+		ORDER BY screened_at DESC, id
+	`, tenantID, verificationID)
+	if err != nil {
+		return nil, fmt.Errorf("query screening results: %w", err)
+	}
+	defer rows.Close()
+
+	var results []model.ScreeningResult
+	for rows.Next() {
+		s, err := scanScreeningResult(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate screening results: %w", err)
+	}
+	return results, nil
+}
+
+func (r *ScreeningResultRepo) ListDueForRescreen(ctx context.Context, cutoff time.Time, limit int) ([]uuid.UUID, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT s.verification_id
+		FROM screening_results s
+		JOIN identity_verifications v ON v.id = s.verification_id
+		WHERE v.status = $1
+		GROUP BY s.verification_id
+		HAVING MAX(s.screened_at) < $2
+		ORDER BY MAX(s.screened_at)
+		LIMIT $3
+	`, valueobject.StatusApproved.String(), cutoff, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query verifications due for re-screening: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan verification id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate verification ids: %w", err)
+	}
+	return ids, nil
+}
+
+func scanScreeningResult(row pgx.Row) (model.ScreeningResult, error) {
+	var (
+		id, tenantID, verificationID, checkID uuid.UUID
+		checkTypeStr, provider, providerRef   string
+		trigger                               string
+		matchesJSON                           []byte
+		screenedAt                            time.Time
+	)
+	if err := row.Scan(&id, &tenantID, &verificationID, &checkID, &checkTypeStr, &provider,
+		&providerRef, &trigger, &matchesJSON, &screenedAt); err != nil {
+		return model.ScreeningResult{}, fmt.Errorf("scan screening result: %w", err)
+	}
+
+	checkType, err := valueobject.NewCheckType(checkTypeStr)
+	if err != nil {
+		return model.ScreeningResult{}, fmt.Errorf("invalid check type in DB: %w", err)
+	}
+	var matches []model.ScreeningMatch
+	if err := json.Unmarshal(matchesJSON, &matches); err != nil {
+		return model.ScreeningResult{}, fmt.Errorf("unmarshal screening matches: %w", err)
+	}
+
+	return model.ReconstructScreeningResult(
+		id, tenantID, verificationID, checkID, checkType,
+		provider, providerRef, trigger, matches, screenedAt,
+	), nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/bibbank/bib/pkg/crypto"
 	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
 	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
 	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
@@ -19,12 +20,15 @@ import (
 var _ port.VerificationRepository = (*VerificationRepo)(nil)
 
 // VerificationRepo implements VerificationRepository using PostgreSQL.
+// Applicants' dates of birth are stored encrypted under the tenant's data
+// key; with a nil pii cipher they are stored in plaintext.
 type VerificationRepo struct {
 	pool *pgxpool.Pool
+	pii  *crypto.Cipher
 }
 
-func NewVerificationRepo(pool *pgxpool.Pool) *VerificationRepo {
-	return &VerificationRepo{pool: pool}
+func NewVerificationRepo(pool *pgxpool.Pool, pii *crypto.Cipher) *VerificationRepo {
+	return &VerificationRepo{pool: pool, pii: pii}
 }
 
 func (r *VerificationRepo) Save(ctx context.Context, v model.IdentityVerification) error {
@@ -37,15 +41,29 @@ func (r *VerificationRepo) Save(ctx context.Context, v model.IdentityVerificatio
 	// Upsert identity verification
 	_, err = tx.Exec(ctx, `
 		INSERT INTO identity_verifications (id, tenant_id, applicant_first_name, applicant_last_name,
-			applicant_email, applicant_dob, applicant_country, status, version, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			applicant_email, applicant_dob, applicant_country, status, risk_tier, risk_score, risk_factors,
+			validity_days, expires_at, expiry_notified_at, erased_at, previous_verification_id, version, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
 		ON CONFLICT (id) DO UPDATE SET
+			applicant_first_name = EXCLUDED.applicant_first_name,
+			applicant_last_name = EXCLUDED.applicant_last_name,
+			applicant_email = EXCLUDED.applicant_email,
+			applicant_dob = EXCLUDED.applicant_dob,
 			status = EXCLUDED.status,
+			risk_tier = EXCLUDED.risk_tier,
+			risk_score = EXCLUDED.risk_score,
+			risk_factors = EXCLUDED.risk_factors,
+			validity_days = EXCLUDED.validity_days,
+			expires_at = EXCLUDED.expires_at,
+			expiry_notified_at = EXCLUDED.expiry_notified_at,
+			erased_at = EXCLUDED.erased_at,
 			version = EXCLUDED.version,
 			updated_at = EXCLUDED.updated_at
 	`, v.ID(), v.TenantID(), v.ApplicantFirstName(), v.ApplicantLastName(),
-		v.ApplicantEmail(), v.ApplicantDOB(), v.ApplicantCountry(),
-		v.Status().String(), v.Version(), v.CreatedAt(), v.UpdatedAt())
+		v.ApplicantEmail(), r.pii.Arg(ctx, v.TenantID(), v.ApplicantDOB()), v.ApplicantCountry(),
+		v.Status().String(), v.RiskTier().String(), v.RiskScore(), v.RiskFactors(), int(v.Validity()/(24*time.Hour)),
+		v.ExpiresAt(), v.ExpiryNotifiedAt(), v.ErasedAt(), nullableUUID(v.PreviousVerificationID()),
+		v.Version(), v.CreatedAt(), v.UpdatedAt())
 	if err != nil {
 		return fmt.Errorf("upsert identity verification: %w", err)
 	}
@@ -69,6 +87,17 @@ func (r *VerificationRepo) Save(ctx context.Context, v model.IdentityVerificatio
 		}
 	}
 
+	// Events recorded before erasure carry the applicant's email.
+	if v.IsErased() {
+		_, err = tx.Exec(ctx, `
+			UPDATE outbox SET payload = payload - 'applicant_email'
+			WHERE aggregate_id = $1 AND payload ? 'applicant_email'
+		`, v.ID())
+		if err != nil {
+			return fmt.Errorf("redact outbox events: %w", err)
+		}
+	}
+
 	// Write domain events to outbox
 	for _, evt := range v.DomainEvents() {
 		payload, merr := json.Marshal(evt)
@@ -89,29 +118,39 @@ func (r *VerificationRepo) Save(ctx context.Context, v model.IdentityVerificatio
 
 func (r *VerificationRepo) FindByID(ctx context.Context, id uuid.UUID) (model.IdentityVerification, error) {
 	var (
-		vID       uuid.UUID
-		tenantID  uuid.UUID
-		firstName string
-		lastName  string
-		email     string
-		dob       string
-		country   string
-		status    string
-		version   int
-		createdAt time.Time
-		updatedAt time.Time
+		vID              uuid.UUID
+		tenantID         uuid.UUID
+		firstName        string
+		lastName         string
+		email            string
+		dob              string
+		country          string
+		status           string
+		riskTierStr      string
+		riskScore        int
+		riskFactors      []string
+		validityDays     int
+		expiresAt        *time.Time
+		expiryNotifiedAt *time.Time
+		erasedAt         *time.Time
+		previousID       *uuid.UUID
+		version          int
+		createdAt        time.Time
+		updatedAt        time.Time
 	)
 
 	err := r.pool.QueryRow(ctx, `
 		SELECT id, tenant_id, applicant_first_name, applicant_last_name,
 			applicant_email, applicant_dob, applicant_country,
-			status, version, created_at, updated_at
+			status, risk_tier, risk_score, risk_factors, validity_days, expires_at, expiry_notified_at,
+			erased_at, previous_verification_id, version, created_at, updated_at
 		FROM identity_verifications WHERE id = $1
-	`, id).Scan(&vID, &tenantID, &firstName, &lastName, &email, &dob, &country,
-		&status, &version, &createdAt, &updatedAt)
+	`, id).Scan(&vID, &tenantID, &firstName, &lastName, &email, r.pii.Dest(ctx, &dob), &country,
+		&status, &riskTierStr, &riskScore, &riskFactors, &validityDays, &expiresAt, &expiryNotifiedAt,
+		&erasedAt, &previousID, &version, &createdAt, &updatedAt)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return model.IdentityVerification{}, fmt.Errorf("verification %s not found", id)
+			return model.IdentityVerification{}, fmt.Errorf("%w: %s", port.ErrVerificationNotFound, id)
 		}
 		return model.IdentityVerification{}, fmt.Errorf("query verification: %w", err)
 	}
@@ -126,11 +165,21 @@ func (r *VerificationRepo) FindByID(ctx context.Context, id uuid.UUID) (model.Id
 	if err != nil {
 		return model.IdentityVerification{}, fmt.Errorf("invalid verification status in DB: %w", err)
 	}
+	riskTier, err := valueobject.NewRiskTier(riskTierStr)
+	if err != nil {
+		return model.IdentityVerification{}, fmt.Errorf("invalid risk tier in DB: %w", err)
+	}
+	var previousVerificationID uuid.UUID
+	if previousID != nil {
+		previousVerificationID = *previousID
+	}
 
 	return model.Reconstruct(
 		vID, tenantID,
 		firstName, lastName, email, dob, country,
 		verificationStatus, checks,
+		riskTier, riskScore, riskFactors, time.Duration(validityDays)*24*time.Hour,
+		expiresAt, expiryNotifiedAt, erasedAt, previousVerificationID,
 		version, createdAt, updatedAt,
 	), nil
 }
@@ -178,6 +227,109 @@ func (r *VerificationRepo) ListByTenant(ctx context.Context, tenantID uuid.UUID,
 	return verifications, total, nil
 }
 
+// ListDueForRefresh returns approved verifications that have lapsed by now
+// or entered the notice period without being flagged, soonest expiry first.
+func (r *VerificationRepo) ListDueForRefresh(ctx context.Context, now, noticeBefore time.Time, limit int) ([]model.IdentityVerification, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id FROM identity_verifications
+		WHERE status IN ($1, $2) AND expires_at IS NOT NULL
+			AND (expires_at <= $3 OR (expires_at <= $4 AND expiry_notified_at IS NULL))
+		ORDER BY expires_at
+		LIMIT $5
+	`, valueobject.StatusApproved.String(), valueobject.StatusReview.String(), now, noticeBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query verifications due for refresh: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan verification id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate verification ids: %w", err)
+	}
+
+	var verifications []model.IdentityVerification
+	for _, id := range ids {
+		v, err := r.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		verifications = append(verifications, v)
+	}
+	return verifications, nil
+}
+
+// ListByApplicantEmail returns the tenant's unerased verifications of the
+// applicant with the given email, oldest first.
+func (r *VerificationRepo) ListByApplicantEmail(ctx context.Context, tenantID uuid.UUID, email string) ([]model.IdentityVerification, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id FROM identity_verifications
+		WHERE tenant_id = $1 AND lower(applicant_email) = lower($2) AND erased_at IS NULL
+		ORDER BY created_at, id
+	`, tenantID, email)
+	if err != nil {
+		return nil, fmt.Errorf("query applicant verifications: %w", err)
+	}
+	return r.findAll(ctx, rows)
+}
+
+// ListDueForErasure returns unerased rejected or expired verifications last
+// updated before cutoff, oldest first, of one tenant or all of them.
+func (r *VerificationRepo) ListDueForErasure(ctx context.Context, tenantID uuid.UUID, cutoff time.Time, limit int) ([]model.IdentityVerification, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id FROM identity_verifications
+		WHERE status IN ($1, $2) AND erased_at IS NULL AND updated_at < $3
+		  AND ($5 = '00000000-0000-0000-0000-000000000000'::uuid OR tenant_id = $5)
+		ORDER BY updated_at
+		LIMIT $4
+	`, valueobject.StatusRejected.String(), valueobject.StatusExpired.String(), cutoff, limit, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("query verifications due for erasure: %w", err)
+	}
+	return r.findAll(ctx, rows)
+}
+
+// findAll loads the verifications whose IDs rows returns, in order.
+func (r *VerificationRepo) findAll(ctx context.Context, rows pgx.Rows) ([]model.IdentityVerification, error) {
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan verification id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate verification ids: %w", err)
+	}
+
+	var verifications []model.IdentityVerification
+	for _, id := range ids {
+		v, err := r.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		verifications = append(verifications, v)
+	}
+	return verifications, nil
+}
+
+// nullableUUID maps uuid.Nil to SQL NULL.
+func nullableUUID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
+
 func (r *VerificationRepo) findChecksByVerificationID(ctx context.Context, verificationID uuid.UUID) ([]model.VerificationCheck, error) {
 	rows, err := r.pool.Query(ctx, `
 		SELECT id, check_type, status, provider, provider_reference, completed_at, failure_reason
@@ -218,3 +370,25 @@ func (r *VerificationRepo) findChecksByVerificationID(ctx context.Context, verif
 
 	return checks, nil
 }
+
+// FindByProviderReference retrieves the verification owning the check with
+// the given provider reference, and that check's ID.
+func (r *VerificationRepo) FindByProviderReference(ctx context.Context, provider, providerRef string) (model.IdentityVerification, uuid.UUID, error) {
+	var verificationID, checkID uuid.UUID
+	err := r.pool.QueryRow(ctx, `
+		SELECT verification_id, id FROM verification_checks
+		WHERE provider = $1 AND provider_reference = $2
+	`, provider, providerRef).Scan(&verificationID, &checkID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return model.IdentityVerification{}, uuid.Nil, fmt.Errorf("%w: %s reference %s", port.ErrCheckNotFound, provider, providerRef)
+		}
+		return model.IdentityVerification{}, uuid.Nil, fmt.Errorf("query check by provider reference: %w", err)
+	}
+
+	v, err := r.FindByID(ctx, verificationID)
+	if err != nil {
+		return model.IdentityVerification{}, uuid.Nil, err
+	}
+	return v, checkID, nil
+}
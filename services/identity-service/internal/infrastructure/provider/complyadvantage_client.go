@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// Compile-time interface check.
+var _ port.ScreeningProvider = (*ComplyAdvantageClient)(nil)
+
+// ComplyAdvantageProviderName identifies ComplyAdvantage on screening checks.
+const ComplyAdvantageProviderName = "complyadvantage"
+
+// complyAdvantageFilters maps screening check types to ComplyAdvantage search filter types.
+var complyAdvantageFilters = map[valueobject.CheckType][]string{
+	valueobject.CheckTypePEP:          {"pep"},
+	valueobject.CheckTypeSanctions:    {"sanction"},
+	valueobject.CheckTypeAdverseMedia: {"adverse-media"},
+}
+
+// ComplyAdvantageClient implements port.ScreeningProvider using the
+// ComplyAdvantage searches API. Each check runs as its own search, filtered
+// to the lists the check covers; the search ID is the check's reference.
+type ComplyAdvantageClient struct {
+	client    *http.Client
+	apiKey    string
+	baseURL   string
+	fuzziness float64
+}
+
+// NewComplyAdvantageClient creates a new ComplyAdvantage API client.
+// fuzziness (0-1) controls how loosely names are matched.
+func NewComplyAdvantageClient(apiKey, baseURL string, fuzziness float64) *ComplyAdvantageClient {
+	return &ComplyAdvantageClient{
+		apiKey:    apiKey,
+		baseURL:   strings.TrimRight(baseURL, "/"),
+		fuzziness: fuzziness,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// Name returns the provider name recorded on checks.
+func (c *ComplyAdvantageClient) Name() string { return ComplyAdvantageProviderName }
+
+// complyAdvantageSearchRequest represents the ComplyAdvantage create-search request.
+type complyAdvantageSearchRequest struct {
+	SearchTerm string                       `json:"search_term"`
+	Filters    complyAdvantageSearchFilters `json:"filters"`
+	Fuzziness  float64                      `json:"fuzziness"`
+}
+
+type complyAdvantageSearchFilters struct {
+	BirthYear string   `json:"birth_year,omitempty"`
+	Types     []string `json:"types"`
+}
+
+// complyAdvantageSearchResponse represents the fields read from a search response.
+type complyAdvantageSearchResponse struct {
+	Content struct {
+		Data struct {
+			ID   json.Number            `json:"id"`
+			Hits []complyAdvantageMatch `json:"hits"`
+		} `json:"data"`
+	} `json:"content"`
+}
+
+type complyAdvantageMatch struct {
+	Doc struct {
+		Name    string   `json:"name"`
+		Types   []string `json:"types"`
+		Sources []string `json:"sources"`
+	} `json:"doc"`
+	Score float64 `json:"score"`
+}
+
+// Screen runs a ComplyAdvantage search for the applicant and maps its hits
+// to screening matches.
+func (c *ComplyAdvantageClient) Screen(ctx context.Context, checkType valueobject.CheckType, applicant port.ApplicantInfo) (port.ScreeningReport, error) {
+	filterTypes, ok := complyAdvantageFilters[checkType]
+	if !ok {
+		return port.ScreeningReport{}, fmt.Errorf("unsupported screening check type: %s", checkType.String())
+	}
+
+	req := complyAdvantageSearchRequest{
+		SearchTerm: strings.TrimSpace(applicant.FirstName + " " + applicant.LastName),
+		Fuzziness:  c.fuzziness,
+		Filters:    complyAdvantageSearchFilters{Types: filterTypes},
+	}
+	if len(applicant.DateOfBirth) >= 4 {
+		req.Filters.BirthYear = applicant.DateOfBirth[:4]
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return port.ScreeningReport{}, fmt.Errorf("failed to encode request: %w", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/searches", bytes.NewReader(payload))
+	if err != nil {
+		return port.ScreeningReport{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Authorization", "Token "+c.apiKey)
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return port.ScreeningReport{}, fmt.Errorf("complyadvantage API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return port.ScreeningReport{}, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return port.ScreeningReport{}, fmt.Errorf("complyadvantage API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+	var search complyAdvantageSearchResponse
+	if err := json.Unmarshal(respBody, &search); err != nil {
+		return port.ScreeningReport{}, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if search.Content.Data.ID == "" {
+		return port.ScreeningReport{}, fmt.Errorf("complyadvantage response did not include a search ID")
+	}
+
+	report := port.ScreeningReport{Reference: search.Content.Data.ID.String()}
+	for _, hit := range search.Content.Data.Hits {
+		report.Matches = append(report.Matches, model.ScreeningMatch{
+			Name:       hit.Doc.Name,
+			Score:      hit.Score,
+			Categories: hit.Doc.Types,
+			Sources:    hit.Doc.Sources,
+		})
+	}
+	return report, nil
+}
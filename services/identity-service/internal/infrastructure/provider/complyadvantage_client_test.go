@@ -0,0 +1,75 @@
+package provider_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/provider"
+)
+
+func TestComplyAdvantageClient_Screen(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/searches", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "Token test-api-key", r.Header.Get("Authorization"))
+
+		var body struct {
+			SearchTerm string `json:"search_term"`
+			Filters    struct {
+				BirthYear string   `json:"birth_year"`
+				Types     []string `json:"types"`
+			} `json:"filters"`
+			Fuzziness float64 `json:"fuzziness"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "John Doe", body.SearchTerm)
+		assert.Equal(t, "1990", body.Filters.BirthYear)
+		assert.Equal(t, []string{"sanction"}, body.Filters.Types)
+		assert.InDelta(t, 0.6, body.Fuzziness, 0.0001)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"content":{"data":{"id":1234,"hits":[
+			{"score":1.7,"doc":{"name":"John Doe","types":["sanction"],"sources":["ofac-sdn-list"]}}
+		]}}}`))
+	}))
+	defer server.Close()
+
+	client := provider.NewComplyAdvantageClient("test-api-key", server.URL, 0.6)
+	report, err := client.Screen(context.Background(), valueobject.CheckTypeSanctions, port.ApplicantInfo{
+		FirstName: "John", LastName: "Doe", DateOfBirth: "1990-01-15",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "1234", report.Reference)
+	require.Len(t, report.Matches, 1)
+	assert.Equal(t, "John Doe", report.Matches[0].Name)
+	assert.Equal(t, []string{"sanction"}, report.Matches[0].Categories)
+	assert.Equal(t, []string{"ofac-sdn-list"}, report.Matches[0].Sources)
+}
+
+func TestComplyAdvantageClient_Screen_NoHits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"content":{"data":{"id":99,"hits":[]}}}`))
+	}))
+	defer server.Close()
+
+	client := provider.NewComplyAdvantageClient("test-api-key", server.URL, 0.6)
+	report, err := client.Screen(context.Background(), valueobject.CheckTypePEP, port.ApplicantInfo{FirstName: "Jane", LastName: "Roe"})
+	require.NoError(t, err)
+	assert.Equal(t, "99", report.Reference)
+	assert.Empty(t, report.Matches)
+}
+
+func TestComplyAdvantageClient_Screen_UnsupportedCheck(t *testing.T) {
+	client := provider.NewComplyAdvantageClient("test-api-key", "http://unused", 0.6)
+	_, err := client.Screen(context.Background(), valueobject.CheckTypeDocument, port.ApplicantInfo{})
+	assert.Error(t, err)
+}
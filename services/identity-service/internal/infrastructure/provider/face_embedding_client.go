@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+)
+
+// Compile-time interface check.
+var _ port.FaceEmbedder = (*FaceEmbeddingClient)(nil)
+
+// FaceEmbeddingClient implements port.FaceEmbedder against a face embedding
+// service that accepts a base64 image and returns the embedding of the
+// single face it contains.
+type FaceEmbeddingClient struct {
+	client  *http.Client
+	apiKey  string
+	baseURL string
+}
+
+// NewFaceEmbeddingClient creates a new face embedding service client.
+func NewFaceEmbeddingClient(apiKey, baseURL string) *FaceEmbeddingClient {
+	return &FaceEmbeddingClient{
+		apiKey:  apiKey,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+type faceEmbeddingRequest struct {
+	Image string `json:"image"`
+}
+
+type faceEmbeddingResponse struct {
+	Embedding []float32 `json:"embedding"`
+}
+
+// Embed returns the embedding of the face in image.
+func (c *FaceEmbeddingClient) Embed(ctx context.Context, image []byte) ([]float32, error) {
+	payload, err := json.Marshal(faceEmbeddingRequest{Image: base64.StdEncoding.EncodeToString(image)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/embeddings", bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("face embedding request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("face embedding API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+	var out faceEmbeddingResponse
+	if err := json.Unmarshal(respBody, &out); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if len(out.Embedding) == 0 {
+		return nil, fmt.Errorf("face embedding response did not include an embedding")
+	}
+	return out.Embedding, nil
+}
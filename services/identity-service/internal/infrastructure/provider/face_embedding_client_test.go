@@ -0,0 +1,56 @@
+package provider_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/provider"
+)
+
+func TestFaceEmbeddingClient_Embed(t *testing.T) {
+	image := []byte("\xff\xd8\xff selfie")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/embeddings", r.URL.Path)
+		assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+		var body struct {
+			Image string `json:"image"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, base64.StdEncoding.EncodeToString(image), body.Image)
+		_, _ = w.Write([]byte(`{"embedding":[0.1,0.2,0.3]}`))
+	}))
+	defer srv.Close()
+
+	embedding, err := provider.NewFaceEmbeddingClient("test-key", srv.URL).Embed(context.Background(), image)
+	require.NoError(t, err)
+	assert.Equal(t, []float32{0.1, 0.2, 0.3}, embedding)
+}
+
+func TestFaceEmbeddingClient_APIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "no face found", http.StatusUnprocessableEntity)
+	}))
+	defer srv.Close()
+
+	_, err := provider.NewFaceEmbeddingClient("test-key", srv.URL).Embed(context.Background(), []byte("img"))
+	assert.ErrorContains(t, err, "status 422")
+}
+
+func TestFaceEmbedderStub_IdenticalImagesMatch(t *testing.T) {
+	stub := provider.NewFaceEmbedderStub()
+
+	a, err := stub.Embed(context.Background(), []byte("selfie"))
+	require.NoError(t, err)
+	b, err := stub.Embed(context.Background(), []byte("selfie"))
+	require.NoError(t, err)
+
+	assert.Len(t, a, 32)
+	assert.Equal(t, a, b)
+}
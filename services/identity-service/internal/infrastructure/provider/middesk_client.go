@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// Compile-time interface check.
+var _ port.BusinessVerificationProvider = (*MiddeskClient)(nil)
+
+// MiddeskProviderName identifies Middesk on business verification checks.
+const MiddeskProviderName = "middesk"
+
+// MiddeskClient implements port.BusinessVerificationProvider using the
+// Middesk API. Middesk verifies a business as a whole, so one submission
+// covers every business check; each check's reference is the Middesk
+// business ID suffixed with the check type.
+type MiddeskClient struct {
+	client  *http.Client
+	apiKey  string
+	baseURL string
+}
+
+// NewMiddeskClient creates a new Middesk API client.
+func NewMiddeskClient(apiKey, baseURL string) *MiddeskClient {
+	return &MiddeskClient{
+		apiKey:  apiKey,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// Name returns the provider name recorded on checks.
+func (c *MiddeskClient) Name() string { return MiddeskProviderName }
+
+// middeskBusinessRequest represents the Middesk create-business request.
+type middeskBusinessRequest struct {
+	Name   string          `json:"name"`
+	TIN    middeskTIN      `json:"tin"`
+	People []middeskPerson `json:"people"`
+}
+
+type middeskTIN struct {
+	TIN string `json:"tin"`
+}
+
+type middeskPerson struct {
+	Name string `json:"name"`
+	DOB  string `json:"dob,omitempty"`
+}
+
+// middeskBusiness represents the fields read from a Middesk business response.
+type middeskBusiness struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// InitiateBusinessChecks creates a Middesk business for the applicant
+// business and its beneficial owners and derives a reference per check.
+func (c *MiddeskClient) InitiateBusinessChecks(ctx context.Context, business port.BusinessInfo, checkTypes []valueobject.CheckType) (map[valueobject.CheckType]string, error) {
+	req := middeskBusinessRequest{
+		Name: business.LegalName,
+		TIN:  middeskTIN{TIN: business.RegistrationNumber},
+	}
+	for _, o := range business.BeneficialOwners {
+		req.People = append(req.People, middeskPerson{
+			Name: strings.TrimSpace(o.FirstName + " " + o.LastName),
+			DOB:  o.DateOfBirth,
+		})
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/businesses", bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("middesk API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("middesk API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+	var created middeskBusiness
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if created.ID == "" {
+		return nil, fmt.Errorf("middesk response did not include a business ID")
+	}
+
+	refs := make(map[valueobject.CheckType]string, len(checkTypes))
+	for _, ct := range checkTypes {
+		refs[ct] = created.ID + ":" + strings.ToLower(ct.String())
+	}
+	return refs, nil
+}
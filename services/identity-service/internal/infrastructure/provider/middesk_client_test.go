@@ -0,0 +1,73 @@
+package provider_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/provider"
+)
+
+func TestMiddeskClient_InitiateBusinessChecks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/businesses", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "Bearer test-api-key", r.Header.Get("Authorization"))
+
+		var body struct {
+			Name string `json:"name"`
+			TIN  struct {
+				TIN string `json:"tin"`
+			} `json:"tin"`
+			People []struct {
+				Name string `json:"name"`
+			} `json:"people"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "Acme Ltd", body.Name)
+		assert.Equal(t, "12-3456789", body.TIN.TIN)
+		require.Len(t, body.People, 1)
+		assert.Equal(t, "Jane Smith", body.People[0].Name)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": "bus_123", "status": "open"})
+	}))
+	defer server.Close()
+
+	client := provider.NewMiddeskClient("test-api-key", server.URL)
+	refs, err := client.InitiateBusinessChecks(context.Background(), port.BusinessInfo{
+		LegalName:          "Acme Ltd",
+		RegistrationNumber: "12-3456789",
+		Country:            "US",
+		BeneficialOwners:   []port.ApplicantInfo{{FirstName: "Jane", LastName: "Smith", DateOfBirth: "1980-02-03"}},
+	}, valueobject.DefaultBusinessCheckTypes())
+	require.NoError(t, err)
+
+	assert.Equal(t, map[valueobject.CheckType]string{
+		valueobject.CheckTypeBusinessRegistry:  "bus_123:business_registry",
+		valueobject.CheckTypeBeneficialOwners:  "bus_123:beneficial_owners",
+		valueobject.CheckTypeBusinessWatchlist: "bus_123:business_watchlist",
+	}, refs)
+}
+
+func TestMiddeskClient_InitiateBusinessChecks_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"errors":[{"message":"tin is invalid"}]}`))
+	}))
+	defer server.Close()
+
+	client := provider.NewMiddeskClient("test-api-key", server.URL)
+	_, err := client.InitiateBusinessChecks(context.Background(), port.BusinessInfo{
+		LegalName: "Acme Ltd", RegistrationNumber: "bad",
+	}, valueobject.DefaultBusinessCheckTypes())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "422")
+}
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// Compile-time interface check.
+var _ port.WebhookProvider = (*OnfidoClient)(nil)
+
+// OnfidoProviderName identifies Onfido on verification checks.
+const OnfidoProviderName = "onfido"
+
+// onfidoReports maps domain check types to Onfido report names.
+var onfidoReports = map[valueobject.CheckType]string{
+	valueobject.CheckTypeDocument:  "document",
+	valueobject.CheckTypeSelfie:    "facial_similarity_photo",
+	valueobject.CheckTypeWatchlist: "watchlist_standard",
+	valueobject.CheckTypeAddress:   "proof_of_address",
+}
+
+// OnfidoClient implements port.WebhookProvider using the Onfido API.
+// Each domain check becomes an Onfido check with a single report.
+type OnfidoClient struct {
+	client       *http.Client
+	apiToken     string
+	baseURL      string
+	webhookToken string
+}
+
+// NewOnfidoClient creates a new Onfido API client. webhookToken is the token
+// Onfido signs webhooks with; callbacks are rejected without it.
+func NewOnfidoClient(apiToken, baseURL, webhookToken string) *OnfidoClient {
+	return &OnfidoClient{
+		apiToken:     apiToken,
+		baseURL:      strings.TrimRight(baseURL, "/"),
+		webhookToken: webhookToken,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// Name returns the provider name recorded on checks.
+func (c *OnfidoClient) Name() string { return OnfidoProviderName }
+
+// SignatureHeader returns the header Onfido signs webhooks with.
+func (c *OnfidoClient) SignatureHeader() string { return "X-SHA2-Signature" }
+
+// onfidoApplicantRequest represents the Onfido create-applicant request.
+type onfidoApplicantRequest struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+	DOB       string `json:"dob"`
+	Address   struct {
+		Country string `json:"country"`
+	} `json:"address"`
+}
+
+// onfidoCheckRequest represents the Onfido create-check request.
+type onfidoCheckRequest struct {
+	ApplicantID string   `json:"applicant_id"`
+	ReportNames []string `json:"report_names"`
+}
+
+// onfidoResource represents the fields read from Onfido applicant and check responses.
+type onfidoResource struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Result string `json:"result"`
+}
+
+// InitiateCheck creates an Onfido applicant and a check running the report
+// for checkType. The check ID is the provider reference.
+func (c *OnfidoClient) InitiateCheck(ctx context.Context, checkType valueobject.CheckType, applicant port.ApplicantInfo) (string, error) {
+	report, ok := onfidoReports[checkType]
+	if !ok {
+		return "", fmt.Errorf("onfido does not support %s checks", checkType.String())
+	}
+
+	applicantReq := onfidoApplicantRequest{
+		FirstName: applicant.FirstName,
+		LastName:  applicant.LastName,
+		Email:     applicant.Email,
+		DOB:       applicant.DateOfBirth,
+	}
+	applicantReq.Address.Country = applicant.Country
+
+	var created onfidoResource
+	if err := c.do(ctx, http.MethodPost, "/applicants", applicantReq, &created); err != nil {
+		return "", fmt.Errorf("failed to create applicant: %w", err)
+	}
+
+	var check onfidoResource
+	if err := c.do(ctx, http.MethodPost, "/checks", onfidoCheckRequest{
+		ApplicantID: created.ID,
+		ReportNames: []string{report},
+	}, &check); err != nil {
+		return "", fmt.Errorf("failed to create check: %w", err)
+	}
+
+	return check.ID, nil
+}
+
+// GetCheckResult retrieves the result of a previously initiated check.
+func (c *OnfidoClient) GetCheckResult(ctx context.Context, providerRef string) (valueobject.VerificationStatus, string, error) {
+	var check onfidoResource
+	if err := c.do(ctx, http.MethodGet, "/checks/"+providerRef, nil, &check); err != nil {
+		return valueobject.VerificationStatus{}, "", err
+	}
+	status, failureReason := mapOnfidoCheck(check.Status, check.Result)
+	return status, failureReason, nil
+}
+
+// onfidoWebhookEvent represents an Onfido webhook callback.
+type onfidoWebhookEvent struct {
+	Payload struct {
+		ResourceType string `json:"resource_type"`
+		Action       string `json:"action"`
+		Object       struct {
+			ID     string `json:"id"`
+			Status string `json:"status"`
+		} `json:"object"`
+	} `json:"payload"`
+}
+
+// ParseWebhook verifies an Onfido webhook and returns the check outcome.
+// The signature is the hex HMAC-SHA256 of the raw body under the webhook
+// token. Callbacks carry no result, so completed checks are fetched.
+func (c *OnfidoClient) ParseWebhook(ctx context.Context, payload []byte, signature string) (port.CheckResult, error) {
+	if !validHMAC(c.webhookToken, payload, signature) {
+		return port.CheckResult{}, port.ErrInvalidWebhookSignature
+	}
+
+	var evt onfidoWebhookEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		return port.CheckResult{}, fmt.Errorf("%w: %w", port.ErrInvalidWebhookPayload, err)
+	}
+	obj := evt.Payload.Object
+	if evt.Payload.ResourceType != "check" || obj.ID == "" {
+		return port.CheckResult{}, nil
+	}
+
+	status, failureReason := mapOnfidoCheck(obj.Status, "")
+	if obj.Status == "complete" {
+		var err error
+		status, failureReason, err = c.GetCheckResult(ctx, obj.ID)
+		if err != nil {
+			return port.CheckResult{}, fmt.Errorf("failed to fetch check result: %w", err)
+		}
+	}
+	return port.CheckResult{Status: status, ProviderRef: obj.ID, FailureReason: failureReason}, nil
+}
+
+// do sends a JSON request to the Onfido API and decodes the response into out.
+func (c *OnfidoClient) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Token token="+c.apiToken)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("onfido API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("onfido API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
+
+// mapOnfidoCheck maps an Onfido check status and result to domain VerificationStatus values.
+func mapOnfidoCheck(status, result string) (valueobject.VerificationStatus, string) {
+	switch status {
+	case "complete":
+		switch result {
+		case "clear":
+			return valueobject.StatusApproved, ""
+		case "consider":
+			// Borderline results go to an analyst rather than rejecting outright.
+			return valueobject.StatusReview, "onfido_result_consider"
+		}
+		return valueobject.StatusRejected, "onfido_result_" + result
+	case "withdrawn":
+		return valueobject.StatusExpired, "check_withdrawn"
+	case "awaiting_applicant":
+		return valueobject.StatusPending, ""
+	default:
+		return valueobject.StatusInProgress, ""
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,26 +15,38 @@ import (
 )
 
 // Compile-time interface check.
-var _ port.VerificationProvider = (*PersonaClient)(nil)
+var _ port.WebhookProvider = (*PersonaClient)(nil)
 
-// PersonaClient implements port.VerificationProvider using the Persona API.
+// PersonaProviderName identifies Persona on verification checks.
+const PersonaProviderName = "persona"
+
+// PersonaClient implements port.WebhookProvider using the Persona API.
 type PersonaClient struct {
-	client  *http.Client
-	apiKey  string
-	baseURL string
+	client        *http.Client
+	apiKey        string
+	baseURL       string
+	webhookSecret string
 }
 
-// NewPersonaClient creates a new Persona API client.
-func NewPersonaClient(apiKey, baseURL string) *PersonaClient {
+// NewPersonaClient creates a new Persona API client. webhookSecret is the
+// signing secret of the Persona webhook; callbacks are rejected without it.
+func NewPersonaClient(apiKey, baseURL, webhookSecret string) *PersonaClient {
 	return &PersonaClient{
-		apiKey:  apiKey,
-		baseURL: strings.TrimRight(baseURL, "/"),
+		apiKey:        apiKey,
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		webhookSecret: webhookSecret,
 		client: &http.Client{
 			Timeout: 30 * time.Second,
 		},
 	}
 }
 
+// Name returns the provider name recorded on checks.
+func (c *PersonaClient) Name() string { return PersonaProviderName }
+
+// SignatureHeader returns the header Persona signs webhooks with.
+func (c *PersonaClient) SignatureHeader() string { return "Persona-Signature" }
+
 // personaInquiryResponse represents the Persona API inquiry response.
 type personaInquiryResponse struct {
 	Data struct {
@@ -132,6 +145,8 @@ func mapPersonaStatus(personaStatus string) (valueobject.VerificationStatus, str
 		return valueobject.StatusApproved, ""
 	case "declined", "failed":
 		return valueobject.StatusRejected, "verification_" + personaStatus
+	case "needs_review":
+		return valueobject.StatusReview, "verification_needs_review"
 	case "expired":
 		return valueobject.StatusExpired, "inquiry_expired"
 	case "pending", "created":
@@ -140,3 +155,69 @@ func mapPersonaStatus(personaStatus string) (valueobject.VerificationStatus, str
 		return valueobject.StatusInProgress, ""
 	}
 }
+
+// personaWebhookEvent represents a Persona webhook event envelope.
+type personaWebhookEvent struct {
+	Data struct {
+		Attributes struct {
+			Name    string `json:"name"`
+			Payload struct {
+				Data struct {
+					Type       string `json:"type"`
+					ID         string `json:"id"`
+					Attributes struct {
+						Status string `json:"status"`
+					} `json:"attributes"`
+				} `json:"data"`
+			} `json:"payload"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+// ParseWebhook verifies a Persona webhook and returns the inquiry outcome.
+// The signature header has the form "t=<unix>,v1=<hex>[ v1=<hex>...]" and
+// signs "<t>.<payload>"; several v1 values appear while a secret rotates.
+func (c *PersonaClient) ParseWebhook(_ context.Context, payload []byte, signature string) (port.CheckResult, error) {
+	var timestamp string
+	var sigs []string
+	for _, part := range strings.FieldsFunc(signature, func(r rune) bool { return r == ',' || r == ' ' }) {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			sigs = append(sigs, value)
+		}
+	}
+
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return port.CheckResult{}, fmt.Errorf("%w: missing timestamp", port.ErrInvalidWebhookSignature)
+	}
+	if skew := time.Now().Unix() - ts; skew > maxWebhookSkew || skew < -maxWebhookSkew {
+		return port.CheckResult{}, fmt.Errorf("%w: timestamp outside tolerance", port.ErrInvalidWebhookSignature)
+	}
+	message := append([]byte(timestamp+"."), payload...)
+	valid := false
+	for _, sig := range sigs {
+		if validHMAC(c.webhookSecret, message, sig) {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return port.CheckResult{}, port.ErrInvalidWebhookSignature
+	}
+
+	var evt personaWebhookEvent
+	if err := json.Unmarshal(payload, &evt); err != nil {
+		return port.CheckResult{}, fmt.Errorf("%w: %w", port.ErrInvalidWebhookPayload, err)
+	}
+	inquiry := evt.Data.Attributes.Payload.Data
+	if inquiry.Type != "inquiry" || inquiry.ID == "" {
+		return port.CheckResult{}, nil
+	}
+
+	status, failureReason := mapPersonaStatus(inquiry.Attributes.Status)
+	return port.CheckResult{Status: status, ProviderRef: inquiry.ID, FailureReason: failureReason}, nil
+}
@@ -34,7 +34,7 @@ func TestPersonaClient_InitiateCheck(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := provider.NewPersonaClient("test-api-key", server.URL)
+	client := provider.NewPersonaClient("test-api-key", server.URL, "")
 
 	ref, err := client.InitiateCheck(context.Background(), valueobject.CheckTypeDocument, port.ApplicantInfo{
 		FirstName:   "John",
@@ -66,7 +66,7 @@ func TestPersonaClient_GetCheckResult_Approved(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := provider.NewPersonaClient("test-api-key", server.URL)
+	client := provider.NewPersonaClient("test-api-key", server.URL, "")
 
 	status, reason, err := client.GetCheckResult(context.Background(), "inq_abc123")
 
@@ -82,7 +82,7 @@ func TestPersonaClient_APIError(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client := provider.NewPersonaClient("bad-key", server.URL)
+	client := provider.NewPersonaClient("bad-key", server.URL, "")
 
 	_, err := client.InitiateCheck(context.Background(), valueobject.CheckTypeDocument, port.ApplicantInfo{
 		FirstName: "John",
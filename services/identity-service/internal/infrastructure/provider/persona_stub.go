@@ -2,16 +2,20 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
 	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
 	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
 )
 
 // Compile-time interface check
-var _ port.VerificationProvider = (*PersonaStub)(nil)
+var _ port.WebhookProvider = (*PersonaStub)(nil)
 
 // PersonaStub is a stub implementation of the Persona KYC/AML provider.
 // It returns successful results for all checks in development/test environments.
@@ -21,6 +25,12 @@ func NewPersonaStub() *PersonaStub {
 	return &PersonaStub{}
 }
 
+// Name returns the provider name recorded on checks.
+func (p *PersonaStub) Name() string { return PersonaProviderName }
+
+// SignatureHeader returns the header the stub would read signatures from.
+func (p *PersonaStub) SignatureHeader() string { return "Persona-Signature" }
+
 // InitiateCheck starts a check and returns a synthetic provider reference.
 func (p *PersonaStub) InitiateCheck(_ context.Context, checkType valueobject.CheckType, applicant port.ApplicantInfo) (string, error) {
 	if applicant.Email == "" {
@@ -41,3 +51,116 @@ func (p *PersonaStub) GetCheckResult(_ context.Context, providerRef string) (val
 	// Stub always returns APPROVED with no failure reason
 	return valueobject.StatusApproved, "", nil
 }
+
+// stubWebhook is the unsigned callback body accepted by the stub, so local
+// environments can drive checks to completion by hand.
+type stubWebhook struct {
+	Reference     string `json:"reference"`
+	Status        string `json:"status"`
+	FailureReason string `json:"failure_reason"`
+}
+
+// ParseWebhook decodes an unsigned stub callback. Signatures are not checked;
+// the stub must never be enabled in production.
+func (p *PersonaStub) ParseWebhook(_ context.Context, payload []byte, _ string) (port.CheckResult, error) {
+	var hook stubWebhook
+	if err := json.Unmarshal(payload, &hook); err != nil {
+		return port.CheckResult{}, fmt.Errorf("%w: %w", port.ErrInvalidWebhookPayload, err)
+	}
+	status, err := valueobject.NewVerificationStatus(hook.Status)
+	if err != nil {
+		return port.CheckResult{}, fmt.Errorf("%w: %w", port.ErrInvalidWebhookPayload, err)
+	}
+	return port.CheckResult{Status: status, ProviderRef: hook.Reference, FailureReason: hook.FailureReason}, nil
+}
+
+// Compile-time interface check
+var _ port.BusinessVerificationProvider = (*BusinessStub)(nil)
+
+// BusinessStub is a stub KYB provider for development/test environments.
+type BusinessStub struct{}
+
+func NewBusinessStub() *BusinessStub {
+	return &BusinessStub{}
+}
+
+// Name returns the provider name recorded on business checks.
+func (p *BusinessStub) Name() string { return "kyb-stub" }
+
+// InitiateBusinessChecks returns a synthetic provider reference per check.
+func (p *BusinessStub) InitiateBusinessChecks(_ context.Context, business port.BusinessInfo, checkTypes []valueobject.CheckType) (map[valueobject.CheckType]string, error) {
+	if business.RegistrationNumber == "" {
+		return nil, fmt.Errorf("business registration number is required")
+	}
+
+	refs := make(map[valueobject.CheckType]string, len(checkTypes))
+	for _, ct := range checkTypes {
+		refs[ct] = fmt.Sprintf("kyb-%s-%s", ct.String(), uuid.New().String()[:8])
+	}
+	return refs, nil
+}
+
+// Compile-time interface check.
+var _ port.ScreeningProvider = (*ScreeningStub)(nil)
+
+// ScreeningStub is a stub screening provider for development/test
+// environments and the sandbox. Applicants screen clear unless their last
+// name is one of the test names in stubScreeningHits, which match the check
+// type it names, so integrators can exercise screening reviews.
+type ScreeningStub struct{}
+
+// stubScreeningHits maps test last names to the check type they match.
+var stubScreeningHits = map[string]valueobject.CheckType{
+	"sanctioned": valueobject.CheckTypeSanctions,
+	"exposed":    valueobject.CheckTypePEP,
+	"adverse":    valueobject.CheckTypeAdverseMedia,
+}
+
+func NewScreeningStub() *ScreeningStub {
+	return &ScreeningStub{}
+}
+
+// Name returns the provider name recorded on screening checks.
+func (p *ScreeningStub) Name() string { return "screening-stub" }
+
+// Screen returns a report with a synthetic reference, matching the
+// applicant when their last name is a test name for the check type.
+func (p *ScreeningStub) Screen(_ context.Context, checkType valueobject.CheckType, applicant port.ApplicantInfo) (port.ScreeningReport, error) {
+	report := port.ScreeningReport{
+		Reference: fmt.Sprintf("screen-%s-%s", checkType.String(), uuid.New().String()[:8]),
+	}
+	if hit, ok := stubScreeningHits[strings.ToLower(strings.TrimSpace(applicant.LastName))]; ok && hit == checkType {
+		report.Matches = []model.ScreeningMatch{{
+			Name:       strings.TrimSpace(applicant.FirstName + " " + applicant.LastName),
+			Categories: []string{strings.ToLower(checkType.String())},
+			Sources:    []string{"screening-stub"},
+			Score:      1,
+		}}
+	}
+	return report, nil
+}
+
+// Compile-time interface check.
+var _ port.FaceEmbedder = (*FaceEmbedderStub)(nil)
+
+// FaceEmbedderStub is a stub face embedder for development/test
+// environments. It derives the embedding from the image bytes, so only
+// byte-identical selfies match each other.
+type FaceEmbedderStub struct{}
+
+func NewFaceEmbedderStub() *FaceEmbedderStub {
+	return &FaceEmbedderStub{}
+}
+
+// Embed returns a 32-dimensional embedding of the image's SHA-256 digest.
+func (p *FaceEmbedderStub) Embed(_ context.Context, image []byte) ([]float32, error) {
+	if len(image) == 0 {
+		return nil, fmt.Errorf("image is empty")
+	}
+	digest := sha256.Sum256(image)
+	embedding := make([]float32, len(digest))
+	for i, b := range digest {
+		embedding[i] = float32(b)/127.5 - 1
+	}
+	return embedding, nil
+}
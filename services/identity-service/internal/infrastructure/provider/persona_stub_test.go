@@ -0,0 +1,38 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/provider"
+)
+
+func TestScreeningStub_TestNamesMatchTheirCheckType(t *testing.T) {
+	stub := provider.NewScreeningStub()
+	applicant := port.ApplicantInfo{FirstName: "Sam", LastName: "Sanctioned"}
+
+	report, err := stub.Screen(context.Background(), valueobject.CheckTypeSanctions, applicant)
+	require.NoError(t, err)
+	require.Len(t, report.Matches, 1)
+	assert.Equal(t, "Sam Sanctioned", report.Matches[0].Name)
+
+	report, err = stub.Screen(context.Background(), valueobject.CheckTypePEP, applicant)
+	require.NoError(t, err)
+	assert.Empty(t, report.Matches)
+}
+
+func TestScreeningStub_OtherApplicantsScreenClear(t *testing.T) {
+	stub := provider.NewScreeningStub()
+
+	for _, checkType := range []valueobject.CheckType{valueobject.CheckTypePEP, valueobject.CheckTypeSanctions, valueobject.CheckTypeAdverseMedia} {
+		report, err := stub.Screen(context.Background(), checkType, port.ApplicantInfo{FirstName: "Jane", LastName: "Doe"})
+		require.NoError(t, err)
+		assert.Empty(t, report.Matches, checkType.String())
+		assert.NotEmpty(t, report.Reference)
+	}
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/service"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+)
+
+// Compile-time interface check.
+var _ port.ScreeningProvider = (*WatchlistProvider)(nil)
+
+// WatchlistProviderName identifies the in-house watchlist screening engine
+// on screening checks.
+const WatchlistProviderName = "watchlist"
+
+// ErrWatchlistsNotLoaded is returned for sanctions checks until the first
+// list load succeeds, so applicants are never cleared against empty lists.
+var ErrWatchlistsNotLoaded = errors.New("sanctions watchlists are not loaded")
+
+// WatchlistProvider implements port.ScreeningProvider by screening SANCTIONS
+// checks in-house against the loaded sanctions lists. The lists do not cover
+// PEPs or adverse media, so those checks are handed to the fallback
+// provider; their reports name the fallback as the provider. The reference
+// of a sanctions screening is the version of the lists it ran against.
+type WatchlistProvider struct {
+	screener *service.WatchlistScreener
+	fallback port.ScreeningProvider
+}
+
+// NewWatchlistProvider creates a watchlist screening provider.
+func NewWatchlistProvider(screener *service.WatchlistScreener, fallback port.ScreeningProvider) *WatchlistProvider {
+	return &WatchlistProvider{screener: screener, fallback: fallback}
+}
+
+// Name returns the provider name recorded on checks.
+func (p *WatchlistProvider) Name() string { return WatchlistProviderName }
+
+// Screen screens the applicant for one check.
+func (p *WatchlistProvider) Screen(ctx context.Context, checkType valueobject.CheckType, applicant port.ApplicantInfo) (port.ScreeningReport, error) {
+	if !checkType.Equal(valueobject.CheckTypeSanctions) {
+		report, err := p.fallback.Screen(ctx, checkType, applicant)
+		if err != nil {
+			return port.ScreeningReport{}, err
+		}
+		if report.Provider == "" {
+			report.Provider = p.fallback.Name()
+		}
+		return report, nil
+	}
+
+	version := p.screener.Version()
+	if version == "" {
+		return port.ScreeningReport{}, ErrWatchlistsNotLoaded
+	}
+	return port.ScreeningReport{
+		Reference: version,
+		Matches: p.screener.Screen(service.WatchlistSubject{
+			FirstName: applicant.FirstName,
+			LastName:  applicant.LastName,
+			BirthYear: birthYear(applicant.DateOfBirth),
+		}),
+	}, nil
+}
+
+// birthYear returns the year of a YYYY-MM-DD date of birth, or 0.
+func birthYear(dob string) int {
+	if len(dob) < 4 {
+		return 0
+	}
+	year, err := strconv.Atoi(dob[:4])
+	if err != nil {
+		return 0
+	}
+	return year
+}
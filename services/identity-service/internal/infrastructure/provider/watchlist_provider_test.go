@@ -0,0 +1,49 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/service"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/provider"
+)
+
+func TestWatchlistProvider_ScreensSanctionsAgainstLoadedLists(t *testing.T) {
+	screener := service.NewWatchlistScreener(0)
+	p := provider.NewWatchlistProvider(screener, provider.NewScreeningStub())
+	applicant := port.ApplicantInfo{FirstName: "Ivan", LastName: "Petrov", DateOfBirth: "1965-03-02"}
+
+	_, err := p.Screen(context.Background(), valueobject.CheckTypeSanctions, applicant)
+	require.ErrorIs(t, err, provider.ErrWatchlistsNotLoaded)
+
+	screener.Load([]model.WatchlistEntry{{
+		List: model.WatchlistOFACSDN, EntryID: "7012", Type: model.WatchlistEntryIndividual,
+		Name: "PETROV, Ivan Sergeyevich", BirthYears: []int{1965},
+	}}, "v1")
+
+	report, err := p.Screen(context.Background(), valueobject.CheckTypeSanctions, applicant)
+	require.NoError(t, err)
+	assert.Equal(t, "v1", report.Reference)
+	assert.Empty(t, report.Provider)
+	require.Len(t, report.Matches, 1)
+	assert.Equal(t, "7012", report.Matches[0].EntryID)
+
+	report, err = p.Screen(context.Background(), valueobject.CheckTypeSanctions, port.ApplicantInfo{FirstName: "Jane", LastName: "Doe"})
+	require.NoError(t, err)
+	assert.Empty(t, report.Matches)
+}
+
+func TestWatchlistProvider_HandsOtherChecksToFallback(t *testing.T) {
+	p := provider.NewWatchlistProvider(service.NewWatchlistScreener(0), provider.NewScreeningStub())
+
+	report, err := p.Screen(context.Background(), valueobject.CheckTypePEP, port.ApplicantInfo{FirstName: "Pat", LastName: "Politician"})
+	require.NoError(t, err)
+	assert.Equal(t, "screening-stub", report.Provider)
+	assert.Equal(t, provider.WatchlistProviderName, p.Name())
+}
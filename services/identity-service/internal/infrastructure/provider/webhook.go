@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// maxWebhookSkew bounds how old a timestamped webhook signature may be,
+// limiting replay of captured callbacks.
+const maxWebhookSkew = 5 * 60
+
+// validHMAC reports whether sigHex is the hex HMAC-SHA256 of message under
+// secret. An empty secret never validates, so webhooks are rejected until one
+// is configured.
+func validHMAC(secret string, message []byte, sigHex string) bool {
+	if secret == "" || sigHex == "" {
+		return false
+	}
+	sig, err := hex.DecodeString(sigHex)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(message)
+	return hmac.Equal(sig, mac.Sum(nil))
+}
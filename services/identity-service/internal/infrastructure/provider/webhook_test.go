@@ -0,0 +1,131 @@
+package provider_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/provider"
+)
+
+func sign(secret string, message []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(message)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func personaSignature(secret string, ts time.Time, payload []byte) string {
+	t := strconv.FormatInt(ts.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", t, sign(secret, append([]byte(t+"."), payload...)))
+}
+
+const personaInquiryDeclined = `{"data":{"type":"event","attributes":{"name":"inquiry.declined",` +
+	`"payload":{"data":{"type":"inquiry","id":"inq_abc123","attributes":{"status":"declined"}}}}}}`
+
+func TestPersonaClient_ParseWebhook(t *testing.T) {
+	client := provider.NewPersonaClient("key", "http://unused", "whsec")
+	payload := []byte(personaInquiryDeclined)
+
+	t.Run("maps a signed inquiry event", func(t *testing.T) {
+		result, err := client.ParseWebhook(context.Background(), payload, personaSignature("whsec", time.Now(), payload))
+		require.NoError(t, err)
+		assert.Equal(t, "inq_abc123", result.ProviderRef)
+		assert.True(t, result.Status.Equal(valueobject.StatusRejected))
+		assert.Equal(t, "verification_declined", result.FailureReason)
+	})
+
+	t.Run("accepts any signature during secret rotation", func(t *testing.T) {
+		sig := personaSignature("whsec", time.Now(), payload) + " v1=" + sign("old", payload)
+		_, err := client.ParseWebhook(context.Background(), payload, sig)
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects bad, stale and missing signatures", func(t *testing.T) {
+		for name, sig := range map[string]string{
+			"wrong secret": personaSignature("other", time.Now(), payload),
+			"stale":        personaSignature("whsec", time.Now().Add(-time.Hour), payload),
+			"missing":      "",
+		} {
+			_, err := client.ParseWebhook(context.Background(), payload, sig)
+			assert.ErrorIs(t, err, port.ErrInvalidWebhookSignature, name)
+		}
+	})
+
+	t.Run("rejects all callbacks without a secret", func(t *testing.T) {
+		unsigned := provider.NewPersonaClient("key", "http://unused", "")
+		_, err := unsigned.ParseWebhook(context.Background(), payload, personaSignature("", time.Now(), payload))
+		assert.ErrorIs(t, err, port.ErrInvalidWebhookSignature)
+	})
+}
+
+func TestOnfidoClient_InitiateCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Token token=onfido-token", r.Header.Get("Authorization"))
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		switch r.URL.Path {
+		case "/applicants":
+			assert.Equal(t, "Jane", body["first_name"])
+			json.NewEncoder(w).Encode(map[string]string{"id": "app_1"})
+		case "/checks":
+			assert.Equal(t, "app_1", body["applicant_id"])
+			assert.Equal(t, []any{"watchlist_standard"}, body["report_names"])
+			json.NewEncoder(w).Encode(map[string]string{"id": "chk_1", "status": "in_progress"})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := provider.NewOnfidoClient("onfido-token", server.URL, "")
+	ref, err := client.InitiateCheck(context.Background(), valueobject.CheckTypeWatchlist, port.ApplicantInfo{
+		FirstName: "Jane", LastName: "Doe", Email: "jane@example.com", DateOfBirth: "1990-01-01", Country: "GBR",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "chk_1", ref)
+}
+
+func TestOnfidoClient_ParseWebhook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/checks/chk_1", r.URL.Path)
+		json.NewEncoder(w).Encode(map[string]string{"id": "chk_1", "status": "complete", "result": "consider"})
+	}))
+	defer server.Close()
+	client := provider.NewOnfidoClient("onfido-token", server.URL, "whtoken")
+
+	t.Run("fetches the result of a completed check", func(t *testing.T) {
+		payload := []byte(`{"payload":{"resource_type":"check","action":"check.completed","object":{"id":"chk_1","status":"complete"}}}`)
+		result, err := client.ParseWebhook(context.Background(), payload, sign("whtoken", payload))
+		require.NoError(t, err)
+		assert.Equal(t, "chk_1", result.ProviderRef)
+		assert.True(t, result.Status.Equal(valueobject.StatusReview))
+		assert.Equal(t, "onfido_result_consider", result.FailureReason)
+	})
+
+	t.Run("ignores non-check resources", func(t *testing.T) {
+		payload := []byte(`{"payload":{"resource_type":"report","action":"report.completed","object":{"id":"rep_1"}}}`)
+		result, err := client.ParseWebhook(context.Background(), payload, sign("whtoken", payload))
+		require.NoError(t, err)
+		assert.Empty(t, result.ProviderRef)
+	})
+
+	t.Run("rejects an invalid signature", func(t *testing.T) {
+		payload := []byte(`{}`)
+		_, err := client.ParseWebhook(context.Background(), payload, sign("other", payload))
+		assert.ErrorIs(t, err, port.ErrInvalidWebhookSignature)
+	})
+}
@@ -0,0 +1,130 @@
+package watchlist
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+)
+
+// Compile-time interface check.
+var _ port.WatchlistSource = (*EUConsolidatedSource)(nil)
+
+// euExport is the subset of the EU Financial Sanctions Files (FSF) XML
+// export that screening uses.
+type euExport struct {
+	Entities []euSanctionEntity `xml:"sanctionEntity"`
+}
+
+type euSanctionEntity struct {
+	LogicalID   string `xml:"logicalId,attr"`
+	SubjectType struct {
+		Code string `xml:"code,attr"`
+	} `xml:"subjectType"`
+	Regulations []struct {
+		Programme string `xml:"programme,attr"`
+	} `xml:"regulation"`
+	NameAliases []struct {
+		WholeName string `xml:"wholeName,attr"`
+	} `xml:"nameAlias"`
+	Birthdates []struct {
+		Year int `xml:"year,attr"`
+	} `xml:"birthdate"`
+	Citizenships []struct {
+		CountryISO2Code string `xml:"countryIso2Code,attr"`
+	} `xml:"citizenship"`
+}
+
+// EUConsolidatedSource implements port.WatchlistSource for the EU
+// Consolidated Financial Sanctions List, in its FSF XML format.
+type EUConsolidatedSource struct {
+	client   *http.Client
+	location string
+}
+
+// NewEUConsolidatedSource creates a source reading the FSF XML export from
+// the given URL or file path.
+func NewEUConsolidatedSource(location string, client *http.Client) *EUConsolidatedSource {
+	return &EUConsolidatedSource{client: client, location: location}
+}
+
+// List returns model.WatchlistEUConsolidated.
+func (s *EUConsolidatedSource) List() string { return model.WatchlistEUConsolidated }
+
+// Fetch downloads and parses the consolidated list.
+func (s *EUConsolidatedSource) Fetch(ctx context.Context) ([]model.WatchlistEntry, string, error) {
+	data, err := read(ctx, s.client, s.location)
+	if err != nil {
+		return nil, "", err
+	}
+	entries, err := ParseEUConsolidated(data)
+	if err != nil {
+		return nil, "", err
+	}
+	return entries, version(data), nil
+}
+
+// ParseEUConsolidated parses the FSF XML export. The first name alias is
+// the entry's name and the rest its aliases.
+func ParseEUConsolidated(data []byte) ([]model.WatchlistEntry, error) {
+	var export euExport
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&export); err != nil {
+		return nil, fmt.Errorf("failed to parse EU consolidated list: %w", err)
+	}
+
+	entries := make([]model.WatchlistEntry, 0, len(export.Entities))
+	for _, e := range export.Entities {
+		var names []string
+		for _, a := range e.NameAliases {
+			if name := strings.TrimSpace(a.WholeName); name != "" {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("EU consolidated list entry %s: name is required", e.LogicalID)
+		}
+
+		entryType := model.WatchlistEntryEntity
+		if e.SubjectType.Code == "person" {
+			entryType = model.WatchlistEntryIndividual
+		}
+		entry := model.WatchlistEntry{
+			List:    model.WatchlistEUConsolidated,
+			EntryID: e.LogicalID,
+			Type:    entryType,
+			Name:    names[0],
+			Aliases: names[1:],
+		}
+		for _, r := range e.Regulations {
+			if r.Programme != "" {
+				entry.Programs = appendUnique(entry.Programs, r.Programme)
+			}
+		}
+		for _, b := range e.Birthdates {
+			if b.Year > 0 {
+				entry.BirthYears = append(entry.BirthYears, b.Year)
+			}
+		}
+		for _, c := range e.Citizenships {
+			if c.CountryISO2Code != "" && c.CountryISO2Code != "00" {
+				entry.Countries = appendUnique(entry.Countries, c.CountryISO2Code)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, existing := range list {
+		if existing == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
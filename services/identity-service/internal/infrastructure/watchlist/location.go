@@ -0,0 +1,63 @@
+// Package watchlist provides port.WatchlistSource adapters for the
+// published sanctions lists.
+package watchlist
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// maxListSize bounds how much of a list publication is read. The OFAC SDN
+// and EU consolidated files are a few tens of megabytes.
+const maxListSize = 256 << 20
+
+// read returns the contents of a list publication. location is an http(s)
+// URL, such as the publisher's download link, or a local file path for a
+// mirrored copy.
+func read(ctx context.Context, client *http.Client, location string) ([]byte, error) {
+	if !strings.HasPrefix(location, "http://") && !strings.HasPrefix(location, "https://") {
+		data, err := os.ReadFile(location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", location, err)
+		}
+		return data, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", location, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %s: status %d", location, resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxListSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", location, err)
+	}
+	if len(data) > maxListSize {
+		return nil, fmt.Errorf("%s exceeds %d bytes", location, maxListSize)
+	}
+	return data, nil
+}
+
+// version is a digest of a publication's files, so it changes exactly when
+// the list is republished.
+func version(files ...[]byte) string {
+	digest := sha256.New()
+	for _, f := range files {
+		digest.Write(f)
+	}
+	return hex.EncodeToString(digest.Sum(nil))[:16]
+}
@@ -0,0 +1,178 @@
+package watchlist
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+)
+
+// Compile-time interface check.
+var _ port.WatchlistSource = (*OFACSDNSource)(nil)
+
+// ofacNull is how the SDN files write an empty field.
+const ofacNull = "-0-"
+
+// ofacDOB finds the birth years in the "DOB ..." clauses of an SDN entry's
+// remarks, e.g. "DOB 12 Jan 1961; alt. DOB circa 1958".
+var ofacDOB = regexp.MustCompile(`DOB [^;]*`)
+
+var fourDigitYear = regexp.MustCompile(`\b(1[89]|20)\d{2}\b`)
+
+// OFACSDNSource implements port.WatchlistSource for the US Treasury OFAC
+// Specially Designated Nationals list, in its legacy CSV format: sdn.csv
+// holds one row per designation and alt.csv its aliases.
+type OFACSDNSource struct {
+	client      *http.Client
+	sdnLocation string
+	altLocation string
+}
+
+// NewOFACSDNSource creates a source reading sdn.csv and alt.csv from the
+// given URLs or file paths. altLocation may be empty to load primary names
+// only.
+func NewOFACSDNSource(sdnLocation, altLocation string, client *http.Client) *OFACSDNSource {
+	return &OFACSDNSource{client: client, sdnLocation: sdnLocation, altLocation: altLocation}
+}
+
+// List returns model.WatchlistOFACSDN.
+func (s *OFACSDNSource) List() string { return model.WatchlistOFACSDN }
+
+// Fetch downloads and parses the SDN list.
+func (s *OFACSDNSource) Fetch(ctx context.Context) ([]model.WatchlistEntry, string, error) {
+	sdn, err := read(ctx, s.client, s.sdnLocation)
+	if err != nil {
+		return nil, "", err
+	}
+	var alt []byte
+	if s.altLocation != "" {
+		if alt, err = read(ctx, s.client, s.altLocation); err != nil {
+			return nil, "", err
+		}
+	}
+	entries, err := ParseOFACSDN(sdn, alt)
+	if err != nil {
+		return nil, "", err
+	}
+	return entries, version(sdn, alt), nil
+}
+
+// ParseOFACSDN parses the SDN list's sdn.csv and alt.csv files. Vessels and
+// aircraft are skipped; every other non-individual designation is an entity.
+func ParseOFACSDN(sdn, alt []byte) ([]model.WatchlistEntry, error) {
+	var entries []model.WatchlistEntry
+	index := make(map[string]int)
+	err := readOFACCSV(sdn, func(row []string) error {
+		if len(row) < 4 {
+			return fmt.Errorf("sdn.csv entry %s: expected at least 4 fields, got %d", row[0], len(row))
+		}
+		entryType := model.WatchlistEntryEntity
+		switch strings.ToLower(ofacField(row[2])) {
+		case "individual":
+			entryType = model.WatchlistEntryIndividual
+		case "vessel", "aircraft":
+			return nil
+		}
+		name := ofacField(row[1])
+		if name == "" {
+			return fmt.Errorf("sdn.csv entry %s: name is required", row[0])
+		}
+
+		entry := model.WatchlistEntry{
+			List:     model.WatchlistOFACSDN,
+			EntryID:  row[0],
+			Type:     entryType,
+			Name:     name,
+			Programs: ofacPrograms(ofacField(row[3])),
+		}
+		if len(row) >= 12 {
+			entry.BirthYears = ofacBirthYears(ofacField(row[11]))
+		}
+		index[entry.EntryID] = len(entries)
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OFAC sdn.csv: %w", err)
+	}
+
+	err = readOFACCSV(alt, func(row []string) error {
+		if len(row) < 4 {
+			return fmt.Errorf("alt.csv entry %s: expected at least 4 fields, got %d", row[0], len(row))
+		}
+		i, ok := index[row[0]]
+		if name := ofacField(row[3]); ok && name != "" {
+			entries[i].Aliases = append(entries[i].Aliases, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OFAC alt.csv: %w", err)
+	}
+	return entries, nil
+}
+
+// readOFACCSV calls fn for each row of an SDN CSV file. The files have no
+// header; rows whose first field is not an entry number, such as the
+// trailing end-of-file marker, are skipped.
+func readOFACCSV(data []byte, fn func(row []string) error) error {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	r.TrimLeadingSpace = true
+	for {
+		row, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		row[0] = strings.TrimSpace(row[0])
+		if _, err := strconv.Atoi(row[0]); err != nil {
+			continue
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+}
+
+func ofacField(s string) string {
+	s = strings.TrimSpace(s)
+	if s == ofacNull {
+		return ""
+	}
+	return s
+}
+
+// ofacPrograms splits a program field such as "SDGT] [IRGC".
+func ofacPrograms(field string) []string {
+	var programs []string
+	for _, p := range strings.Split(field, "] [") {
+		if p = strings.Trim(p, "[] "); p != "" {
+			programs = append(programs, p)
+		}
+	}
+	return programs
+}
+
+func ofacBirthYears(remarks string) []int {
+	var years []int
+	for _, dob := range ofacDOB.FindAllString(remarks, -1) {
+		for _, y := range fourDigitYear.FindAllString(dob, -1) {
+			year, _ := strconv.Atoi(y)
+			years = append(years, year)
+		}
+	}
+	return years
+}
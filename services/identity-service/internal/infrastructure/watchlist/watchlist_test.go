@@ -0,0 +1,134 @@
+package watchlist_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/watchlist"
+)
+
+const sdnCSV = `36,"AEROCARIBBEAN AIRLINES",-0- ,"CUBA",-0- ,-0- ,-0- ,-0- ,-0- ,-0- ,-0- ,"Havana, Cuba."
+2674,"HUSSEIN, Saddam",individual,"IRAQ2] [SDGT",-0- ,-0- ,-0- ,-0- ,-0- ,-0- ,-0- ,"DOB 28 Apr 1937; POB al-Awja, near Tikrit, Iraq; nationality Iraq."
+7012,"PETROV, Ivan Sergeyevich",individual,"UKRAINE-EO13660",-0- ,-0- ,-0- ,-0- ,-0- ,-0- ,-0- ,"DOB circa 1965; alt. DOB 1966."
+15036,"ARCTIC SUN",vessel,"IRAN",-0- ,"9MHD6",-0- ,-0- ,-0- ,-0- ,-0- ,-0-
+` + "\x1a\n"
+
+const altCSV = `2674,1,"a.k.a.","AL-TIKRITI, Saddam Hussein",-0-
+7012,2,"a.k.a.","PETROFF, Ivan",-0-
+9999,3,"a.k.a.","UNKNOWN ENTRY",-0-
+`
+
+const euXML = `<?xml version="1.0" encoding="UTF-8"?>
+<export xmlns="http://eu.europa.ec/fpi/fsd/export" generationDate="2026-10-01T10:00:00.000+02:00">
+  <sanctionEntity logicalId="13" designationDate="2003-07-07">
+    <regulation programme="IRQ"/>
+    <subjectType code="person" classificationCode="P"/>
+    <nameAlias firstName="Saddam" lastName="Hussein Al-Tikriti" wholeName="Saddam Hussein Al-Tikriti"/>
+    <nameAlias wholeName="Abu Ali"/>
+    <birthdate birthdate="1937-04-28" year="1937"/>
+    <citizenship countryIso2Code="IQ"/>
+  </sanctionEntity>
+  <sanctionEntity logicalId="118">
+    <regulation programme="IRN"/>
+    <subjectType code="enterprise" classificationCode="E"/>
+    <nameAlias wholeName="Acme Trading LLC"/>
+  </sanctionEntity>
+</export>`
+
+func TestParseOFACSDN(t *testing.T) {
+	entries, err := watchlist.ParseOFACSDN([]byte(sdnCSV), []byte(altCSV))
+	require.NoError(t, err)
+	require.Len(t, entries, 3, "vessels and the end-of-file marker are skipped")
+
+	assert.Equal(t, model.WatchlistEntry{
+		List: model.WatchlistOFACSDN, EntryID: "36", Type: model.WatchlistEntryEntity,
+		Name: "AEROCARIBBEAN AIRLINES", Programs: []string{"CUBA"},
+	}, entries[0])
+
+	saddam := entries[1]
+	assert.Equal(t, "2674", saddam.EntryID)
+	assert.Equal(t, model.WatchlistEntryIndividual, saddam.Type)
+	assert.Equal(t, "HUSSEIN, Saddam", saddam.Name)
+	assert.Equal(t, []string{"AL-TIKRITI, Saddam Hussein"}, saddam.Aliases)
+	assert.Equal(t, []string{"IRAQ2", "SDGT"}, saddam.Programs)
+	assert.Equal(t, []int{1937}, saddam.BirthYears)
+
+	assert.Equal(t, []int{1965, 1966}, entries[2].BirthYears)
+	assert.Equal(t, []string{"PETROFF, Ivan"}, entries[2].Aliases)
+}
+
+func TestParseOFACSDN_Malformed(t *testing.T) {
+	_, err := watchlist.ParseOFACSDN([]byte(`12,-0- ,individual,"SDGT"`), nil)
+	assert.Error(t, err)
+}
+
+func TestParseEUConsolidated(t *testing.T) {
+	entries, err := watchlist.ParseEUConsolidated([]byte(euXML))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, model.WatchlistEntry{
+		List: model.WatchlistEUConsolidated, EntryID: "13", Type: model.WatchlistEntryIndividual,
+		Name: "Saddam Hussein Al-Tikriti", Aliases: []string{"Abu Ali"},
+		Programs: []string{"IRQ"}, Countries: []string{"IQ"}, BirthYears: []int{1937},
+	}, entries[0])
+	assert.Equal(t, model.WatchlistEntryEntity, entries[1].Type)
+	assert.Empty(t, entries[1].Aliases)
+}
+
+func TestParseEUConsolidated_Malformed(t *testing.T) {
+	_, err := watchlist.ParseEUConsolidated([]byte(`<export><sanctionEntity logicalId="1"></sanctionEntity></export>`))
+	assert.Error(t, err)
+
+	_, err = watchlist.ParseEUConsolidated([]byte(`not xml`))
+	assert.Error(t, err)
+}
+
+func TestOFACSDNSource_FetchFromFiles(t *testing.T) {
+	dir := t.TempDir()
+	sdnPath := filepath.Join(dir, "sdn.csv")
+	altPath := filepath.Join(dir, "alt.csv")
+	require.NoError(t, os.WriteFile(sdnPath, []byte(sdnCSV), 0o600))
+	require.NoError(t, os.WriteFile(altPath, []byte(altCSV), 0o600))
+
+	src := watchlist.NewOFACSDNSource(sdnPath, altPath, http.DefaultClient)
+	assert.Equal(t, model.WatchlistOFACSDN, src.List())
+	entries, version, err := src.Fetch(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, entries, 3)
+	assert.NotEmpty(t, version)
+
+	// The version changes when the list is republished.
+	require.NoError(t, os.WriteFile(altPath, []byte(""), 0o600))
+	_, republished, err := src.Fetch(context.Background())
+	require.NoError(t, err)
+	assert.NotEqual(t, version, republished)
+}
+
+func TestEUConsolidatedSource_FetchOverHTTP(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/fsf.xml" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(euXML))
+	}))
+	defer srv.Close()
+
+	src := watchlist.NewEUConsolidatedSource(srv.URL+"/fsf.xml", srv.Client())
+	entries, version, err := src.Fetch(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.NotEmpty(t, version)
+
+	_, _, err = watchlist.NewEUConsolidatedSource(srv.URL+"/missing.xml", srv.Client()).Fetch(context.Background())
+	assert.Error(t, err)
+}
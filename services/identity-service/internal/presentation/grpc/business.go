@@ -0,0 +1,148 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	identityv1 "github.com/bibbank/bib/api/gen/go/bib/identity/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+)
+
+func (h *IdentityHandler) InitiateBusinessVerification(ctx context.Context, req *identityv1.InitiateBusinessVerificationRequest) (*identityv1.BusinessVerificationResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make([]dto.BeneficialOwnerDTO, 0, len(req.GetBeneficialOwners()))
+	for _, o := range req.GetBeneficialOwners() {
+		if o == nil {
+			continue
+		}
+		owners = append(owners, dto.BeneficialOwnerDTO{
+			FirstName:        o.GetFirstName(),
+			LastName:         o.GetLastName(),
+			DateOfBirth:      o.GetDateOfBirth(),
+			Country:          o.GetCountry(),
+			OwnershipPercent: o.GetOwnershipPercent(),
+		})
+	}
+
+	result, err := h.initiateBusiness.Execute(ctx, dto.InitiateBusinessVerificationRequest{
+		TenantID:           tenantID,
+		LegalName:          req.GetLegalName(),
+		RegistrationNumber: req.GetRegistrationNumber(),
+		Country:            req.GetCountry(),
+		BeneficialOwners:   owners,
+	})
+	if err != nil {
+		return nil, h.useCaseError("initiate business verification failed", err)
+	}
+
+	return &identityv1.BusinessVerificationResponse{Verification: toBusinessVerificationMsg(result)}, nil
+}
+
+func (h *IdentityHandler) GetBusinessVerification(ctx context.Context, req *identityv1.GetBusinessVerificationRequest) (*identityv1.BusinessVerificationResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+
+	result, err := h.getBusiness.Execute(ctx, dto.GetBusinessVerificationRequest{
+		TenantID: tenantID,
+		ID:       id,
+	})
+	if err != nil {
+		return nil, h.useCaseError("get business verification failed", err)
+	}
+
+	return &identityv1.BusinessVerificationResponse{Verification: toBusinessVerificationMsg(result)}, nil
+}
+
+func (h *IdentityHandler) CompleteBusinessCheck(ctx context.Context, req *identityv1.CompleteBusinessCheckRequest) (*identityv1.BusinessVerificationResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationID, err := uuid.Parse(req.GetVerificationId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid verification_id: %v", err)
+	}
+
+	checkID, err := uuid.Parse(req.GetCheckId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid check_id: %v", err)
+	}
+
+	result, err := h.completeBusinessCheck.Execute(ctx, dto.CompleteBusinessCheckRequest{
+		TenantID:       tenantID,
+		VerificationID: verificationID,
+		CheckID:        checkID,
+		Status:         enumName(req.GetStatus().String(), "VERIFICATION_STATUS_"),
+		FailureReason:  req.GetFailureReason(),
+	})
+	if err != nil {
+		return nil, h.useCaseError("complete business check failed", err)
+	}
+
+	return &identityv1.BusinessVerificationResponse{Verification: toBusinessVerificationMsg(result)}, nil
+}
+
+func toBusinessVerificationMsg(r dto.BusinessVerificationResponse) *identityv1.BusinessVerification {
+	owners := make([]*identityv1.BeneficialOwner, 0, len(r.BeneficialOwners))
+	for _, o := range r.BeneficialOwners {
+		owners = append(owners, &identityv1.BeneficialOwner{
+			FirstName:        o.FirstName,
+			LastName:         o.LastName,
+			DateOfBirth:      o.DateOfBirth,
+			Country:          o.Country,
+			OwnershipPercent: o.OwnershipPercent,
+		})
+	}
+
+	return &identityv1.BusinessVerification{
+		Id:                 r.ID.String(),
+		TenantId:           r.TenantID.String(),
+		LegalName:          r.LegalName,
+		RegistrationNumber: r.RegistrationNumber,
+		Country:            r.Country,
+		Status:             verificationStatus(r.Status),
+		BeneficialOwners:   owners,
+		Checks:             toCheckMsgs(r.Checks),
+		Audit:              toAuditInfo(r.CreatedAt, r.UpdatedAt, r.Version),
+	}
+}
@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	identityv1 "github.com/bibbank/bib/api/gen/go/bib/identity/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/provider"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractVerificationRepo holds the one verification a contract state sets up.
+type contractVerificationRepo struct {
+	verification *model.IdentityVerification
+}
+
+func (r *contractVerificationRepo) Save(context.Context, model.IdentityVerification) error { return nil }
+
+func (r *contractVerificationRepo) FindByID(_ context.Context, id uuid.UUID) (model.IdentityVerification, error) {
+	if r.verification == nil {
+		return model.IdentityVerification{}, fmt.Errorf("%w: %s", port.ErrVerificationNotFound, id)
+	}
+	return *r.verification, nil
+}
+
+func (r *contractVerificationRepo) ListByTenant(context.Context, uuid.UUID, int, int) ([]model.IdentityVerification, int, error) {
+	return nil, 0, nil
+}
+
+func (r *contractVerificationRepo) FindByProviderReference(context.Context, string, string) (model.IdentityVerification, uuid.UUID, error) {
+	return model.IdentityVerification{}, uuid.Nil, port.ErrCheckNotFound
+}
+
+func (r *contractVerificationRepo) ListDueForRefresh(context.Context, time.Time, time.Time, int) ([]model.IdentityVerification, error) {
+	return nil, nil
+}
+
+func (r *contractVerificationRepo) ListByApplicantEmail(context.Context, uuid.UUID, string) ([]model.IdentityVerification, error) {
+	return nil, nil
+}
+
+func (r *contractVerificationRepo) ListDueForErasure(context.Context, uuid.UUID, time.Time, int) ([]model.IdentityVerification, error) {
+	return nil, nil
+}
+
+// contractReviewCaseRepo lists the review cases a contract state sets up.
+type contractReviewCaseRepo struct {
+	cases []model.ReviewCase
+}
+
+func (r *contractReviewCaseRepo) Save(context.Context, model.ReviewCase) error { return nil }
+
+func (r *contractReviewCaseRepo) FindByID(context.Context, uuid.UUID, uuid.UUID) (model.ReviewCase, error) {
+	return model.ReviewCase{}, port.ErrReviewCaseNotFound
+}
+
+func (r *contractReviewCaseRepo) FindActiveByVerification(context.Context, uuid.UUID) (model.ReviewCase, error) {
+	return model.ReviewCase{}, port.ErrReviewCaseNotFound
+}
+
+func (r *contractReviewCaseRepo) ListQueue(context.Context, uuid.UUID, port.ReviewQueueFilter, int, int) ([]model.ReviewCase, int, error) {
+	return r.cases, len(r.cases), nil
+}
+
+func (r *contractReviewCaseRepo) Metrics(context.Context, uuid.UUID, time.Time, time.Time) (port.ReviewMetrics, error) {
+	return port.ReviewMetrics{}, nil
+}
+
+type discardPublisher struct{}
+
+func (discardPublisher) Publish(context.Context, string, ...events.DomainEvent) error { return nil }
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/. Only the use
+// cases behind the recorded calls are wired.
+func TestGatewayContract(t *testing.T) {
+	verifications, cases := &contractVerificationRepo{}, &contractReviewCaseRepo{}
+	h := NewIdentityHandler(
+		usecase.NewInitiateVerification(verifications, provider.NewPersonaStub(), discardPublisher{}, nil, nil, nil),
+		usecase.NewGetVerification(verifications),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		usecase.NewListReviewQueue(cases),
+		nil, nil, nil, nil, nil, nil, nil, nil,
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { identityv1.RegisterIdentityServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-identity-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) {
+			verifications.verification, cases.cases = nil, nil
+		},
+		"a verification is in progress": func(t *testing.T) {
+			v, err := model.NewIdentityVerification(contractTenantID, "Jane", "Smith", "jane@example.com", "1990-01-01", "US")
+			if err != nil {
+				t.Fatal(err)
+			}
+			verifications.verification = &v
+		},
+		"a review case is open": func(t *testing.T) {
+			c, err := model.NewReviewCase(contractTenantID, uuid.New(), "document mismatch", false, 24*time.Hour, time.Now().UTC())
+			if err != nil {
+				t.Fatal(err)
+			}
+			cases.cases = []model.ReviewCase{c}
+		},
+	})
+}
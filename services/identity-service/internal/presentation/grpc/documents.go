@@ -0,0 +1,168 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	identityv1 "github.com/bibbank/bib/api/gen/go/bib/identity/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
+)
+
+// MaxDocumentMessageBytes bounds document RPC messages. Content travels
+// base64-encoded under the JSON codec, so it allows for the largest accepted
+// document inflated by 4/3 plus room for the envelope.
+const MaxDocumentMessageBytes = usecase.MaxDocumentBytes*4/3 + 1<<20
+
+func (h *IdentityHandler) UploadDocument(ctx context.Context, req *identityv1.UploadDocumentRequest) (*identityv1.UploadDocumentResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationID, err := uuid.Parse(req.GetVerificationId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid verification_id: %v", err)
+	}
+
+	var checkID uuid.UUID
+	if req.GetCheckId() != "" {
+		checkID, err = uuid.Parse(req.GetCheckId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid check_id: %v", err)
+		}
+	}
+
+	result, err := h.uploadDocument.Execute(ctx, dto.UploadDocumentRequest{
+		TenantID:       tenantID,
+		VerificationID: verificationID,
+		CheckID:        checkID,
+		DocumentType:   enumName(req.GetDocumentType().String(), "DOCUMENT_TYPE_"),
+		Content:        req.GetContent(),
+	})
+	if err != nil {
+		return nil, h.useCaseError("upload document failed", err)
+	}
+
+	return &identityv1.UploadDocumentResponse{Document: toDocumentMsg(result)}, nil
+}
+
+func (h *IdentityHandler) GetDocument(ctx context.Context, req *identityv1.GetDocumentRequest) (*identityv1.GetDocumentResponse, error) {
+	roles := []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleAPIClient}
+	if req != nil && req.GetIncludeContent() {
+		// Raw identity documents are restricted to staff and auditors.
+		roles = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor}
+	}
+	if err := requireRole(ctx, roles...); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+
+	result, err := h.getDocument.Execute(ctx, dto.GetDocumentRequest{
+		TenantID:       tenantID,
+		DocumentID:     id,
+		IncludeContent: req.GetIncludeContent(),
+	})
+	if err != nil {
+		return nil, h.useCaseError("get document failed", err)
+	}
+
+	return &identityv1.GetDocumentResponse{
+		Document: toDocumentMsg(result.Document),
+		Content:  result.Content,
+	}, nil
+}
+
+func (h *IdentityHandler) ListDocuments(ctx context.Context, req *identityv1.ListDocumentsRequest) (*identityv1.ListDocumentsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationID, err := uuid.Parse(req.GetVerificationId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid verification_id: %v", err)
+	}
+
+	result, err := h.listDocuments.Execute(ctx, dto.ListDocumentsRequest{
+		TenantID:       tenantID,
+		VerificationID: verificationID,
+	})
+	if err != nil {
+		return nil, h.useCaseError("list documents failed", err)
+	}
+
+	docs := make([]*identityv1.IdentityDocument, 0, len(result.Documents))
+	for _, d := range result.Documents {
+		docs = append(docs, toDocumentMsg(d))
+	}
+	return &identityv1.ListDocumentsResponse{Documents: docs}, nil
+}
+
+// useCaseError maps use-case errors to gRPC status codes.
+func (h *IdentityHandler) useCaseError(msg string, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, usecase.ErrDocumentPurged), errors.Is(err, usecase.ErrInvalidState):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		h.logger.Error(msg, "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func toDocumentMsg(d dto.DocumentResponse) *identityv1.IdentityDocument {
+	msg := &identityv1.IdentityDocument{
+		Id:             d.ID.String(),
+		VerificationId: d.VerificationID.String(),
+		CheckId:        d.CheckID.String(),
+		DocumentType:   identityv1.DocumentType(identityv1.DocumentType_value["DOCUMENT_TYPE_"+d.DocumentType]),
+		ContentType:    d.ContentType,
+		Sha256:         d.SHA256,
+		SizeBytes:      d.SizeBytes,
+		UploadedAt:     timestamppb.New(d.UploadedAt),
+		RetainUntil:    timestamppb.New(d.RetainUntil),
+	}
+	if d.PurgedAt != nil {
+		msg.PurgedAt = timestamppb.New(*d.PurgedAt)
+	}
+	return msg
+}
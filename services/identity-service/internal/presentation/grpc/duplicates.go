@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	identityv1 "github.com/bibbank/bib/api/gen/go/bib/identity/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+)
+
+func (h *IdentityHandler) GetDuplicateMatches(ctx context.Context, req *identityv1.GetDuplicateMatchesRequest) (*identityv1.GetDuplicateMatchesResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationID, err := uuid.Parse(req.GetVerificationId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid verification_id: %v", err)
+	}
+
+	result, err := h.getDuplicateMatches.Execute(ctx, dto.GetDuplicateMatchesRequest{
+		TenantID:       tenantID,
+		VerificationID: verificationID,
+	})
+	if err != nil {
+		return nil, h.useCaseError("get duplicate matches failed", err)
+	}
+
+	resp := &identityv1.GetDuplicateMatchesResponse{
+		VerificationId: result.VerificationID.String(),
+		Matches:        make([]*identityv1.DuplicateMatch, 0, len(result.Matches)),
+	}
+	if result.ApplicantID != uuid.Nil {
+		resp.ApplicantId = result.ApplicantID.String()
+	}
+	for _, m := range result.Matches {
+		resp.Matches = append(resp.Matches, toDuplicateMatchMsg(m))
+	}
+	return resp, nil
+}
+
+func toDuplicateMatchMsg(m dto.DuplicateMatchResponse) *identityv1.DuplicateMatch {
+	return &identityv1.DuplicateMatch{
+		VerificationId: m.VerificationID.String(),
+		Reasons:        m.Reasons,
+		Score:          m.Score,
+	}
+}
@@ -3,14 +3,19 @@ package grpc
 import (
 	"context"
 	"log/slog"
+	"strings"
 	"time"
 
-	"github.com/bibbank/bib/pkg/auth"
-	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
-	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	identityv1 "github.com/bibbank/bib/api/gen/go/bib/identity/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
 )
 
 // requireRole checks that the caller has at least one of the given roles.
@@ -36,17 +41,44 @@ func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
 	return claims.TenantID, nil
 }
 
+// userIDFromContext extracts the calling user's ID from JWT claims in the context.
+func userIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok || claims.UserID == uuid.Nil {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return claims.UserID, nil
+}
+
 // Compile-time assertion that IdentityHandler implements IdentityServiceServer.
-var _ IdentityServiceServer = (*IdentityHandler)(nil)
+var _ identityv1.IdentityServiceServer = (*IdentityHandler)(nil)
 
 // IdentityHandler implements the gRPC IdentityService server.
 type IdentityHandler struct {
-	UnimplementedIdentityServiceServer
-	initiateVerification *usecase.InitiateVerification
-	getVerification      *usecase.GetVerification
-	completeCheck        *usecase.CompleteCheck
-	listVerifications    *usecase.ListVerifications
-	logger               *slog.Logger
+	identityv1.UnimplementedIdentityServiceServer
+	initiateVerification  *usecase.InitiateVerification
+	getVerification       *usecase.GetVerification
+	completeCheck         *usecase.CompleteCheck
+	listVerifications     *usecase.ListVerifications
+	uploadDocument        *usecase.UploadDocument
+	getDocument           *usecase.GetDocument
+	listDocuments         *usecase.ListDocuments
+	initiateBusiness      *usecase.InitiateBusinessVerification
+	getBusiness           *usecase.GetBusinessVerification
+	completeBusinessCheck *usecase.CompleteBusinessCheck
+	getScreeningResults   *usecase.GetScreeningResults
+	screenVerification    *usecase.ScreenVerification
+	reverifyVerification  *usecase.ReverifyVerification
+	listReviewQueue       *usecase.ListReviewQueue
+	getReviewCase         *usecase.GetReviewCase
+	claimReviewCase       *usecase.ClaimReviewCase
+	assignReviewCase      *usecase.AssignReviewCase
+	decideReviewCase      *usecase.DecideReviewCase
+	getReviewMetrics      *usecase.GetReviewMetrics
+	getDuplicateMatches   *usecase.GetDuplicateMatches
+	eraseApplicant        *usecase.EraseApplicantData
+	exportApplicant       *usecase.ExportApplicantData
+	logger                *slog.Logger
 }
 
 func NewIdentityHandler(
@@ -54,92 +86,54 @@ func NewIdentityHandler(
 	getVerification *usecase.GetVerification,
 	completeCheck *usecase.CompleteCheck,
 	listVerifications *usecase.ListVerifications,
+	uploadDocument *usecase.UploadDocument,
+	getDocument *usecase.GetDocument,
+	listDocuments *usecase.ListDocuments,
+	initiateBusiness *usecase.InitiateBusinessVerification,
+	getBusiness *usecase.GetBusinessVerification,
+	completeBusinessCheck *usecase.CompleteBusinessCheck,
+	getScreeningResults *usecase.GetScreeningResults,
+	screenVerification *usecase.ScreenVerification,
+	reverifyVerification *usecase.ReverifyVerification,
+	listReviewQueue *usecase.ListReviewQueue,
+	getReviewCase *usecase.GetReviewCase,
+	claimReviewCase *usecase.ClaimReviewCase,
+	assignReviewCase *usecase.AssignReviewCase,
+	decideReviewCase *usecase.DecideReviewCase,
+	getReviewMetrics *usecase.GetReviewMetrics,
+	getDuplicateMatches *usecase.GetDuplicateMatches,
+	eraseApplicant *usecase.EraseApplicantData,
+	exportApplicant *usecase.ExportApplicantData,
 	logger *slog.Logger,
 ) *IdentityHandler {
 	return &IdentityHandler{
-		initiateVerification: initiateVerification,
-		getVerification:      getVerification,
-		completeCheck:        completeCheck,
-		listVerifications:    listVerifications,
-		logger:               logger,
+		initiateVerification:  initiateVerification,
+		getVerification:       getVerification,
+		completeCheck:         completeCheck,
+		listVerifications:     listVerifications,
+		uploadDocument:        uploadDocument,
+		getDocument:           getDocument,
+		listDocuments:         listDocuments,
+		initiateBusiness:      initiateBusiness,
+		getBusiness:           getBusiness,
+		completeBusinessCheck: completeBusinessCheck,
+		getScreeningResults:   getScreeningResults,
+		screenVerification:    screenVerification,
+		reverifyVerification:  reverifyVerification,
+		listReviewQueue:       listReviewQueue,
+		getReviewCase:         getReviewCase,
+		claimReviewCase:       claimReviewCase,
+		assignReviewCase:      assignReviewCase,
+		decideReviewCase:      decideReviewCase,
+		getReviewMetrics:      getReviewMetrics,
+		getDuplicateMatches:   getDuplicateMatches,
+		eraseApplicant:        eraseApplicant,
+		exportApplicant:       exportApplicant,
+		logger:                logger,
 	}
 }
 
-// InitiateVerification implements IdentityServiceServer by delegating to HandleInitiateVerification.
-func (h *IdentityHandler) InitiateVerification(ctx context.Context, req *InitiateVerificationRequest) (*InitiateVerificationResponse, error) {
-	return h.HandleInitiateVerification(ctx, req)
-}
-
-// GetVerification implements IdentityServiceServer by delegating to HandleGetVerification.
-func (h *IdentityHandler) GetVerification(ctx context.Context, req *GetVerificationRequest) (*GetVerificationResponse, error) {
-	return h.HandleGetVerification(ctx, req)
-}
-
-// CompleteCheck implements IdentityServiceServer by delegating to HandleCompleteCheck.
-func (h *IdentityHandler) CompleteCheck(ctx context.Context, req *CompleteCheckRequest) (*CompleteCheckResponse, error) {
-	return h.HandleCompleteCheck(ctx, req)
-}
-
-// Temporary gRPC message types until proto generation is wired.
-
-type InitiateVerificationRequest struct {
-	TenantID    string `json:"tenant_id"`
-	FirstName   string `json:"first_name"`
-	LastName    string `json:"last_name"`
-	Email       string `json:"email"`
-	DateOfBirth string `json:"date_of_birth"`
-	Country     string `json:"country"`
-}
-
-type InitiateVerificationResponse struct {
-	Verification *VerificationMsg `json:"verification"`
-}
-
-type GetVerificationRequest struct {
-	ID string `json:"id"`
-}
-
-type GetVerificationResponse struct {
-	Verification *VerificationMsg `json:"verification"`
-}
-
-type CompleteCheckRequest struct {
-	VerificationID string `json:"verification_id"`
-	CheckID        string `json:"check_id"`
-	Status         string `json:"status"`
-	FailureReason  string `json:"failure_reason"`
-}
-
-type CompleteCheckResponse struct {
-	Verification *VerificationMsg `json:"verification"`
-}
-
-type VerificationMsg struct {
-	ID                 string      `json:"id"`
-	TenantID           string      `json:"tenant_id"`
-	ApplicantFirstName string      `json:"applicant_first_name"`
-	ApplicantLastName  string      `json:"applicant_last_name"`
-	ApplicantEmail     string      `json:"applicant_email"`
-	ApplicantDOB       string      `json:"applicant_dob"`
-	ApplicantCountry   string      `json:"applicant_country"`
-	Status             string      `json:"status"`
-	CreatedAt          string      `json:"created_at"`
-	UpdatedAt          string      `json:"updated_at"`
-	Checks             []*CheckMsg `json:"checks"`
-	Version            int32       `json:"version"`
-}
-
-type CheckMsg struct {
-	ID                string `json:"id"`
-	CheckType         string `json:"check_type"`
-	Status            string `json:"status"`
-	Provider          string `json:"provider"`
-	ProviderReference string `json:"provider_reference"`
-	CompletedAt       string `json:"completed_at,omitempty"`
-	FailureReason     string `json:"failure_reason,omitempty"`
-}
-
-func (h *IdentityHandler) HandleInitiateVerification(ctx context.Context, req *InitiateVerificationRequest) (*InitiateVerificationResponse, error) {
+func (h *IdentityHandler) InitiateVerification(ctx context.Context, req *identityv1.InitiateVerificationRequest) (*identityv1.InitiateVerificationResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -153,25 +147,32 @@ func (h *IdentityHandler) HandleInitiateVerification(ctx context.Context, req *I
 		return nil, err
 	}
 
+	screeningChecks := make([]string, 0, len(req.GetScreeningChecks()))
+	for _, c := range req.GetScreeningChecks() {
+		screeningChecks = append(screeningChecks, enumName(c.String(), "CHECK_TYPE_"))
+	}
+
 	result, err := h.initiateVerification.Execute(ctx, dto.InitiateVerificationRequest{
-		TenantID:    tenantID,
-		FirstName:   req.FirstName,
-		LastName:    req.LastName,
-		Email:       req.Email,
-		DateOfBirth: req.DateOfBirth,
-		Country:     req.Country,
+		TenantID:        tenantID,
+		FirstName:       req.GetFirstName(),
+		LastName:        req.GetLastName(),
+		Email:           req.GetEmail(),
+		DateOfBirth:     req.GetDateOfBirth(),
+		Country:         req.GetCountry(),
+		RiskTier:        enumName(req.GetRiskTier().String(), "RISK_TIER_"),
+		ScreeningChecks: screeningChecks,
+		DocumentNumber:  req.GetDocumentNumber(),
 	})
 	if err != nil {
-		h.logger.Error("initiate verification failed", "error", err)
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, h.useCaseError("initiate verification failed", err)
 	}
 
-	return &InitiateVerificationResponse{
+	return &identityv1.InitiateVerificationResponse{
 		Verification: toVerificationMsg(result),
 	}, nil
 }
 
-func (h *IdentityHandler) HandleGetVerification(ctx context.Context, req *GetVerificationRequest) (*GetVerificationResponse, error) {
+func (h *IdentityHandler) GetVerification(ctx context.Context, req *identityv1.GetVerificationRequest) (*identityv1.GetVerificationResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -180,25 +181,30 @@ func (h *IdentityHandler) HandleGetVerification(ctx context.Context, req *GetVer
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
 
-	id, err := uuid.Parse(req.ID)
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.GetId())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
 	}
 
 	result, err := h.getVerification.Execute(ctx, dto.GetVerificationRequest{
-		ID: id,
+		TenantID: tenantID,
+		ID:       id,
 	})
 	if err != nil {
-		h.logger.Error("get verification failed", "error", err)
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, h.useCaseError("get verification failed", err)
 	}
 
-	return &GetVerificationResponse{
+	return &identityv1.GetVerificationResponse{
 		Verification: toVerificationMsg(result),
 	}, nil
 }
 
-func (h *IdentityHandler) HandleCompleteCheck(ctx context.Context, req *CompleteCheckRequest) (*CompleteCheckResponse, error) {
+func (h *IdentityHandler) CompleteCheck(ctx context.Context, req *identityv1.CompleteCheckRequest) (*identityv1.CompleteCheckResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
 		return nil, err
 	}
@@ -207,12 +213,12 @@ func (h *IdentityHandler) HandleCompleteCheck(ctx context.Context, req *Complete
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
 
-	verificationID, err := uuid.Parse(req.VerificationID)
+	verificationID, err := uuid.Parse(req.GetVerificationId())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid verification_id: %v", err)
 	}
 
-	checkID, err := uuid.Parse(req.CheckID)
+	checkID, err := uuid.Parse(req.GetCheckId())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid check_id: %v", err)
 	}
@@ -220,48 +226,131 @@ func (h *IdentityHandler) HandleCompleteCheck(ctx context.Context, req *Complete
 	result, err := h.completeCheck.Execute(ctx, dto.CompleteCheckRequest{
 		VerificationID: verificationID,
 		CheckID:        checkID,
-		Status:         req.Status,
-		FailureReason:  req.FailureReason,
+		Status:         enumName(req.GetStatus().String(), "VERIFICATION_STATUS_"),
+		FailureReason:  req.GetFailureReason(),
 	})
 	if err != nil {
 		h.logger.Error("handler error", "error", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	return &CompleteCheckResponse{
+	return &identityv1.CompleteCheckResponse{
 		Verification: toVerificationMsg(result),
 	}, nil
 }
 
-func toVerificationMsg(r dto.VerificationResponse) *VerificationMsg {
-	var checks []*CheckMsg
-	for _, c := range r.Checks {
-		cm := &CheckMsg{
-			ID:                c.ID.String(),
-			CheckType:         c.CheckType,
-			Status:            c.Status,
+func (h *IdentityHandler) ReverifyVerification(ctx context.Context, req *identityv1.ReverifyVerificationRequest) (*identityv1.InitiateVerificationResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationID, err := uuid.Parse(req.GetVerificationId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid verification_id: %v", err)
+	}
+
+	result, err := h.reverifyVerification.Execute(ctx, dto.ReverifyVerificationRequest{
+		TenantID:       tenantID,
+		VerificationID: verificationID,
+	})
+	if err != nil {
+		return nil, h.useCaseError("reverify verification failed", err)
+	}
+
+	return &identityv1.InitiateVerificationResponse{
+		Verification: toVerificationMsg(result),
+	}, nil
+}
+
+func toVerificationMsg(r dto.VerificationResponse) *identityv1.IdentityVerification {
+	msg := &identityv1.IdentityVerification{
+		Id:                   r.ID.String(),
+		TenantId:             r.TenantID.String(),
+		ApplicantFirstName:   r.ApplicantFirstName,
+		ApplicantLastName:    r.ApplicantLastName,
+		ApplicantEmail:       r.ApplicantEmail,
+		ApplicantDateOfBirth: r.ApplicantDOB,
+		ApplicantCountry:     r.ApplicantCountry,
+		Status:               verificationStatus(r.Status),
+		RiskTier:             identityv1.RiskTier(identityv1.RiskTier_value["RISK_TIER_"+r.RiskTier]),
+		RiskScore:            int32(r.RiskScore), //nolint:gosec // scores range from 0 to 100
+		RiskFactors:          r.RiskFactors,
+		Checks:               toCheckMsgs(r.Checks),
+		Audit:                toAuditInfo(r.CreatedAt, r.UpdatedAt, r.Version),
+	}
+	if r.ExpiresAt != nil {
+		msg.ExpiresAt = timestamppb.New(*r.ExpiresAt)
+	}
+	if r.ErasedAt != nil {
+		msg.ErasedAt = timestamppb.New(*r.ErasedAt)
+	}
+	if r.PreviousVerificationID != uuid.Nil {
+		msg.PreviousVerificationId = r.PreviousVerificationID.String()
+	}
+	return msg
+}
+
+func toCheckMsgs(in []dto.VerificationCheckDTO) []*identityv1.VerificationCheck {
+	checks := make([]*identityv1.VerificationCheck, 0, len(in))
+	for _, c := range in {
+		cm := &identityv1.VerificationCheck{
+			Id:                c.ID.String(),
+			Type:              checkType(c.CheckType),
+			Status:            verificationStatus(c.Status),
 			Provider:          c.Provider,
 			ProviderReference: c.ProviderReference,
 			FailureReason:     c.FailureReason,
 		}
 		if c.CompletedAt != nil {
-			cm.CompletedAt = c.CompletedAt.Format(time.RFC3339)
+			cm.CompletedAt = timestamppb.New(*c.CompletedAt)
 		}
 		checks = append(checks, cm)
 	}
 
-	return &VerificationMsg{
-		ID:                 r.ID.String(),
-		TenantID:           r.TenantID.String(),
-		ApplicantFirstName: r.ApplicantFirstName,
-		ApplicantLastName:  r.ApplicantLastName,
-		ApplicantEmail:     r.ApplicantEmail,
-		ApplicantDOB:       r.ApplicantDOB,
-		ApplicantCountry:   r.ApplicantCountry,
-		Status:             r.Status,
-		Checks:             checks,
-		Version:            int32(r.Version), //nolint:gosec
-		CreatedAt:          r.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:          r.UpdatedAt.Format(time.RFC3339),
+	return checks
+}
+
+func toAuditInfo(createdAt, updatedAt time.Time, version int) *commonv1.AuditInfo {
+	return &commonv1.AuditInfo{
+		CreatedAt: timestamppb.New(createdAt),
+		UpdatedAt: timestamppb.New(updatedAt),
+		Version:   int32(version), //nolint:gosec // versions stay far below MaxInt32
+	}
+}
+
+func verificationStatus(s string) identityv1.VerificationStatus {
+	return identityv1.VerificationStatus(identityv1.VerificationStatus_value["VERIFICATION_STATUS_"+s])
+}
+
+func checkType(s string) identityv1.CheckType {
+	return identityv1.CheckType(identityv1.CheckType_value["CHECK_TYPE_"+s])
+}
+
+// optionalTime converts a timestamp, treating an unset one as the zero time.
+func optionalTime(field string, ts *timestamppb.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "invalid %s: %v", field, err)
+	}
+	return ts.AsTime(), nil
+}
+
+// enumName strips prefix from a proto enum name, mapping UNSPECIFIED to "".
+func enumName(name, prefix string) string {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "UNSPECIFIED" {
+		return ""
 	}
+	return name
 }
@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	identityv1 "github.com/bibbank/bib/api/gen/go/bib/identity/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+)
+
+func (h *IdentityHandler) EraseApplicantData(ctx context.Context, req *identityv1.EraseApplicantDataRequest) (*identityv1.EraseApplicantDataResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.eraseApplicant.Execute(ctx, dto.EraseApplicantDataRequest{
+		TenantID:         tenantID,
+		ApplicantEmail:   req.GetApplicantEmail(),
+		RequestReference: req.GetRequestReference(),
+	})
+	if err != nil {
+		return nil, h.useCaseError("erase applicant data failed", err)
+	}
+
+	resp := &identityv1.EraseApplicantDataResponse{
+		ErasedVerificationIds: make([]string, 0, len(result.ErasedVerificationIDs)),
+		Retained:              make([]*identityv1.RetainedVerification, 0, len(result.Retained)),
+		DocumentsErased:       int32(result.DocumentsErased), //nolint:gosec
+	}
+	for _, id := range result.ErasedVerificationIDs {
+		resp.ErasedVerificationIds = append(resp.ErasedVerificationIds, id.String())
+	}
+	for _, r := range result.Retained {
+		resp.Retained = append(resp.Retained, &identityv1.RetainedVerification{
+			VerificationId: r.VerificationID.String(),
+			Status:         verificationStatus(r.Status),
+			Reason:         r.Reason,
+		})
+	}
+	return resp, nil
+}
+
+func (h *IdentityHandler) ExportApplicantData(ctx context.Context, req *identityv1.ExportApplicantDataRequest) (*identityv1.ExportApplicantDataResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.exportApplicant.Execute(ctx, dto.ExportApplicantDataRequest{
+		TenantID:       tenantID,
+		ApplicantEmail: req.GetApplicantEmail(),
+	})
+	if err != nil {
+		return nil, h.useCaseError("export applicant data failed", err)
+	}
+
+	resp := &identityv1.ExportApplicantDataResponse{
+		ApplicantEmail: result.ApplicantEmail,
+		ExportedAt:     timestamppb.New(result.ExportedAt),
+		Verifications:  make([]*identityv1.ApplicantVerificationExport, 0, len(result.Verifications)),
+	}
+	for _, v := range result.Verifications {
+		msg := &identityv1.ApplicantVerificationExport{
+			Verification:     toVerificationMsg(v.Verification),
+			Documents:        make([]*identityv1.IdentityDocument, 0, len(v.Documents)),
+			ScreeningResults: make([]*identityv1.ScreeningResult, 0, len(v.ScreeningResults)),
+			DuplicateMatches: make([]*identityv1.DuplicateMatch, 0, len(v.DuplicateMatches)),
+		}
+		for _, d := range v.Documents {
+			msg.Documents = append(msg.Documents, toDocumentMsg(d))
+		}
+		for _, r := range v.ScreeningResults {
+			msg.ScreeningResults = append(msg.ScreeningResults, toScreeningResultMsg(r))
+		}
+		for _, m := range v.DuplicateMatches {
+			msg.DuplicateMatches = append(msg.DuplicateMatches, toDuplicateMatchMsg(m))
+		}
+		resp.Verifications = append(resp.Verifications, msg)
+	}
+	return resp, nil
+}
@@ -0,0 +1,285 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	identityv1 "github.com/bibbank/bib/api/gen/go/bib/identity/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+)
+
+// defaultReviewPageSize applies when a queue listing omits or exceeds the page size limit.
+const defaultReviewPageSize = 50
+
+func (h *IdentityHandler) ListReviewQueue(ctx context.Context, req *identityv1.ListReviewQueueRequest) (*identityv1.ListReviewQueueResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var assigneeID uuid.UUID
+	if req.GetAssigneeId() != "" {
+		assigneeID, err = uuid.Parse(req.GetAssigneeId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid assignee_id: %v", err)
+		}
+	}
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = defaultReviewPageSize
+	}
+	if req.GetOffset() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset must not be negative")
+	}
+
+	result, err := h.listReviewQueue.Execute(ctx, dto.ListReviewQueueRequest{
+		TenantID:   tenantID,
+		Status:     req.GetStatus(),
+		AssigneeID: assigneeID,
+		PageSize:   pageSize,
+		Offset:     int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, h.useCaseError("list review queue failed", err)
+	}
+
+	resp := &identityv1.ListReviewQueueResponse{
+		Cases:      make([]*identityv1.ReviewCase, 0, len(result.Cases)),
+		TotalCount: int32(result.TotalCount), //nolint:gosec // bounded by queue size
+	}
+	for _, c := range result.Cases {
+		resp.Cases = append(resp.Cases, toReviewCaseMsg(c))
+	}
+	return resp, nil
+}
+
+func (h *IdentityHandler) GetReviewCase(ctx context.Context, req *identityv1.GetReviewCaseRequest) (*identityv1.ReviewCaseResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	caseID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+
+	result, err := h.getReviewCase.Execute(ctx, dto.GetReviewCaseRequest{
+		TenantID: tenantID,
+		CaseID:   caseID,
+	})
+	if err != nil {
+		return nil, h.useCaseError("get review case failed", err)
+	}
+
+	return &identityv1.ReviewCaseResponse{Case: toReviewCaseMsg(result)}, nil
+}
+
+func (h *IdentityHandler) ClaimReviewCase(ctx context.Context, req *identityv1.ClaimReviewCaseRequest) (*identityv1.ReviewCaseResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	analystID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	caseID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+
+	result, err := h.claimReviewCase.Execute(ctx, dto.ClaimReviewCaseRequest{
+		TenantID:  tenantID,
+		CaseID:    caseID,
+		AnalystID: analystID,
+	})
+	if err != nil {
+		return nil, h.useCaseError("claim review case failed", err)
+	}
+
+	return &identityv1.ReviewCaseResponse{Case: toReviewCaseMsg(result)}, nil
+}
+
+func (h *IdentityHandler) AssignReviewCase(ctx context.Context, req *identityv1.AssignReviewCaseRequest) (*identityv1.ReviewCaseResponse, error) {
+	// Assigning work to other analysts is a supervisor action.
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	assignedBy, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	caseID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+	assigneeID, err := uuid.Parse(req.GetAssigneeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid assignee_id: %v", err)
+	}
+
+	result, err := h.assignReviewCase.Execute(ctx, dto.AssignReviewCaseRequest{
+		TenantID:   tenantID,
+		CaseID:     caseID,
+		AssigneeID: assigneeID,
+		AssignedBy: assignedBy,
+	})
+	if err != nil {
+		return nil, h.useCaseError("assign review case failed", err)
+	}
+
+	return &identityv1.ReviewCaseResponse{Case: toReviewCaseMsg(result)}, nil
+}
+
+func (h *IdentityHandler) DecideReviewCase(ctx context.Context, req *identityv1.DecideReviewCaseRequest) (*identityv1.ReviewCaseResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	analystID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	caseID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+
+	result, err := h.decideReviewCase.Execute(ctx, dto.DecideReviewCaseRequest{
+		TenantID:  tenantID,
+		CaseID:    caseID,
+		AnalystID: analystID,
+		Outcome:   req.GetOutcome(),
+		Reason:    req.GetReason(),
+	})
+	if err != nil {
+		return nil, h.useCaseError("decide review case failed", err)
+	}
+
+	return &identityv1.ReviewCaseResponse{Case: toReviewCaseMsg(result)}, nil
+}
+
+func (h *IdentityHandler) GetReviewMetrics(ctx context.Context, req *identityv1.GetReviewMetricsRequest) (*identityv1.GetReviewMetricsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	since, err := optionalTime("since", req.GetSince())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.getReviewMetrics.Execute(ctx, dto.GetReviewMetricsRequest{
+		TenantID: tenantID,
+		Since:    since,
+	})
+	if err != nil {
+		return nil, h.useCaseError("get review metrics failed", err)
+	}
+
+	//nolint:gosec // counts are bounded by queue size
+	return &identityv1.GetReviewMetricsResponse{
+		Since:                    timestamppb.New(result.Since),
+		Open:                     int32(result.Open),
+		AwaitingSecondApproval:   int32(result.AwaitingSecondApproval),
+		Overdue:                  int32(result.Overdue),
+		Decided:                  int32(result.Decided),
+		DecidedWithinSla:         int32(result.DecidedWithinSLA),
+		WithinSlaRate:            result.WithinSLARate,
+		AvgTimeToDecisionSeconds: int64(result.AvgTimeToDecision / time.Second),
+		P95TimeToDecisionSeconds: int64(result.P95TimeToDecision / time.Second),
+	}, nil
+}
+
+func toReviewCaseMsg(c dto.ReviewCaseResponse) *identityv1.ReviewCase {
+	msg := &identityv1.ReviewCase{
+		Id:               c.ID.String(),
+		VerificationId:   c.VerificationID.String(),
+		Status:           c.Status,
+		Reason:           c.Reason,
+		Outcome:          c.Outcome,
+		OpenedAt:         timestamppb.New(c.OpenedAt),
+		DueAt:            timestamppb.New(c.DueAt),
+		RequiresFourEyes: c.RequiresFourEyes,
+		Overdue:          c.Overdue,
+		Decisions:        make([]*identityv1.ReviewDecision, 0, len(c.Decisions)),
+	}
+	if c.AssigneeID != uuid.Nil {
+		msg.AssigneeId = c.AssigneeID.String()
+	}
+	if c.ClaimedAt != nil {
+		msg.ClaimedAt = timestamppb.New(*c.ClaimedAt)
+	}
+	if c.DecidedAt != nil {
+		msg.DecidedAt = timestamppb.New(*c.DecidedAt)
+	}
+	for _, d := range c.Decisions {
+		msg.Decisions = append(msg.Decisions, &identityv1.ReviewDecision{
+			AnalystId: d.AnalystID.String(),
+			Outcome:   d.Outcome,
+			Reason:    d.Reason,
+			DecidedAt: timestamppb.New(d.DecidedAt),
+		})
+	}
+	return msg
+}
@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	identityv1 "github.com/bibbank/bib/api/gen/go/bib/identity/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+)
+
+func (h *IdentityHandler) GetScreeningResults(ctx context.Context, req *identityv1.GetScreeningResultsRequest) (*identityv1.GetScreeningResultsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationID, err := uuid.Parse(req.GetVerificationId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid verification_id: %v", err)
+	}
+
+	result, err := h.getScreeningResults.Execute(ctx, dto.GetScreeningResultsRequest{
+		TenantID:       tenantID,
+		VerificationID: verificationID,
+	})
+	if err != nil {
+		return nil, h.useCaseError("get screening results failed", err)
+	}
+
+	resp := &identityv1.GetScreeningResultsResponse{Results: make([]*identityv1.ScreeningResult, 0, len(result.Results))}
+	for _, r := range result.Results {
+		resp.Results = append(resp.Results, toScreeningResultMsg(r))
+	}
+	return resp, nil
+}
+
+func (h *IdentityHandler) RescreenVerification(ctx context.Context, req *identityv1.RescreenVerificationRequest) (*identityv1.GetVerificationResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationID, err := uuid.Parse(req.GetVerificationId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid verification_id: %v", err)
+	}
+
+	result, err := h.screenVerification.Execute(ctx, dto.RescreenVerificationRequest{
+		TenantID:       tenantID,
+		VerificationID: verificationID,
+	})
+	if err != nil {
+		return nil, h.useCaseError("rescreen verification failed", err)
+	}
+
+	return &identityv1.GetVerificationResponse{Verification: toVerificationMsg(result)}, nil
+}
+
+func toScreeningResultMsg(r dto.ScreeningResultDTO) *identityv1.ScreeningResult {
+	msg := &identityv1.ScreeningResult{
+		Id:                r.ID.String(),
+		CheckId:           r.CheckID.String(),
+		CheckType:         checkType(r.CheckType),
+		Provider:          r.Provider,
+		ProviderReference: r.ProviderReference,
+		Trigger:           r.Trigger,
+		Outcome:           r.Outcome,
+		ScreenedAt:        timestamppb.New(r.ScreenedAt),
+		Matches:           make([]*identityv1.ScreeningMatch, 0, len(r.Matches)),
+	}
+	for _, m := range r.Matches {
+		msg.Matches = append(msg.Matches, &identityv1.ScreeningMatch{
+			Name:       m.Name,
+			Categories: m.Categories,
+			Sources:    m.Sources,
+			EntryId:    m.EntryID,
+			Score:      m.Score,
+		})
+	}
+	return msg
+}
@@ -0,0 +1,89 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/bibbank/bib/services/identity-service/internal/application/dto"
+	"github.com/bibbank/bib/services/identity-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/port"
+)
+
+// maxWebhookBodyBytes caps provider callback bodies.
+const maxWebhookBodyBytes = 1 << 20
+
+// WebhookHandler receives signed result callbacks from the KYC provider.
+type WebhookHandler struct {
+	handleWebhook   *usecase.HandleProviderWebhook
+	logger          *slog.Logger
+	provider        string
+	signatureHeader string
+}
+
+func NewWebhookHandler(handleWebhook *usecase.HandleProviderWebhook, provider, signatureHeader string, logger *slog.Logger) *WebhookHandler {
+	return &WebhookHandler{
+		handleWebhook:   handleWebhook,
+		provider:        provider,
+		signatureHeader: signatureHeader,
+		logger:          logger,
+	}
+}
+
+func (h *WebhookHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /webhooks/kyc/{provider}", h.ProviderWebhook)
+}
+
+// ProviderWebhook handles POST /webhooks/kyc/{provider}. Only the configured
+// provider is accepted. Non-2xx responses make the provider retry delivery.
+func (h *WebhookHandler) ProviderWebhook(w http.ResponseWriter, r *http.Request) {
+	if r.PathValue("provider") != h.provider {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "unknown provider"})
+		return
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodyBytes))
+	if err != nil {
+		writeJSON(w, http.StatusRequestEntityTooLarge, map[string]string{"error": "payload too large"})
+		return
+	}
+
+	resp, err := h.handleWebhook.Execute(r.Context(), dto.ProviderWebhookRequest{
+		Payload:   payload,
+		Signature: r.Header.Get(h.signatureHeader),
+	})
+	switch {
+	case errors.Is(err, port.ErrInvalidWebhookSignature):
+		h.logger.Warn("rejected KYC webhook with invalid signature", "provider", h.provider)
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid signature"})
+		return
+	case errors.Is(err, port.ErrInvalidWebhookPayload):
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid payload"})
+		return
+	case errors.Is(err, port.ErrCheckNotFound):
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "check not found"})
+		return
+	case err != nil:
+		h.logger.Error("failed to handle KYC webhook", "provider", h.provider, "error", err)
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "internal error"})
+		return
+	}
+
+	if resp.Applied {
+		h.logger.Info("applied KYC check result",
+			"provider", h.provider,
+			"verification_id", resp.VerificationID,
+			"check_id", resp.CheckID,
+			"status", resp.Status,
+		)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"applied": resp.Applied})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body) //nolint:errcheck // best-effort HTTP response encoding
+}
@@ -0,0 +1,68 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/crypto"
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/identity-service/internal/domain/model"
+	"github.com/bibbank/bib/services/identity-service/internal/infrastructure/postgres"
+)
+
+func TestVerificationRepo_EncryptsDateOfBirthAndWritesOutbox(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	kms, err := crypto.NewLocalKMS(make([]byte, 32))
+	require.NoError(t, err)
+	repo := postgres.NewVerificationRepo(env.Pool(), crypto.NewCipher(kms, crypto.NewPostgresKeyStore(env.Pool())))
+	ctx := context.Background()
+
+	v, err := model.NewIdentityVerification(uuid.New(), "Ada", "Lovelace", "ada@example.com", "1990-12-10", "GB")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, v))
+
+	var storedDOB string
+	require.NoError(t, env.Pool().QueryRow(ctx,
+		`SELECT applicant_dob FROM identity_verifications WHERE id = $1`, v.ID()).Scan(&storedDOB))
+	assert.True(t, crypto.IsEncrypted(storedDOB), "the date of birth is sealed at rest")
+
+	found, err := repo.FindByID(ctx, v.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "1990-12-10", found.ApplicantDOB())
+	assert.Equal(t, "ada@example.com", found.ApplicantEmail())
+	assert.Len(t, found.Checks(), len(v.Checks()))
+
+	var pending int
+	require.NoError(t, env.Pool().QueryRow(ctx,
+		`SELECT count(*) FROM outbox WHERE aggregate_id = $1 AND published_at IS NULL`, v.ID()).Scan(&pending))
+	assert.Equal(t, 1, pending, "VerificationInitiated is queued for relay")
+}
+
+func TestVerificationRepo_ListByApplicantEmailIsScopedToTenant(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewVerificationRepo(env.Pool(), nil)
+	ctx := context.Background()
+	tenantID := uuid.New()
+
+	first, err := model.NewIdentityVerification(tenantID, "Ada", "Lovelace", "ada@example.com", "1990-12-10", "GB")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, first))
+	second, err := model.NewIdentityVerification(tenantID, "Ada", "Lovelace", "Ada@Example.com", "1990-12-10", "GB")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, second))
+	other, err := model.NewIdentityVerification(uuid.New(), "Ada", "Lovelace", "ada@example.com", "1990-12-10", "GB")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, other))
+
+	found, err := repo.ListByApplicantEmail(ctx, tenantID, "ADA@example.com")
+	require.NoError(t, err)
+	require.Len(t, found, 2, "emails match case-insensitively within the tenant")
+	assert.Equal(t, first.ID(), found[0].ID())
+	assert.Equal(t, second.ID(), found[1].ID())
+}
@@ -10,14 +10,22 @@ import (
 	"syscall"
 	"time"
 
+	"google.golang.org/grpc"
+
+	ledgerv1 "github.com/bibbank/bib/api/gen/go/bib/ledger/v1"
+	"github.com/bibbank/bib/pkg/approval"
+	"github.com/bibbank/bib/pkg/archive"
 	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/idempotency"
 	kafkapkg "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
+	"github.com/bibbank/bib/pkg/lock"
 	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
 	pgpkg "github.com/bibbank/bib/pkg/postgres"
 	"github.com/bibbank/bib/services/ledger-service/internal/application/usecase"
 	"github.com/bibbank/bib/services/ledger-service/internal/domain/service"
 	"github.com/bibbank/bib/services/ledger-service/internal/infrastructure/config"
-	infraKafka "github.com/bibbank/bib/services/ledger-service/internal/infrastructure/kafka"
 	infraPG "github.com/bibbank/bib/services/ledger-service/internal/infrastructure/postgres"
 	grpcPresentation "github.com/bibbank/bib/services/ledger-service/internal/presentation/grpc"
 	"github.com/bibbank/bib/services/ledger-service/internal/presentation/rest"
@@ -36,6 +44,7 @@ func main() {
 		Format: cfg.LogFormat,
 	})
 	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
 
 	logger.Info("starting ledger-service",
 		"http_port", cfg.HTTPPort,
@@ -51,7 +60,7 @@ func main() {
 	if err != nil {
 		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
 	} else {
-		defer func() { _ = shutdown(ctx) }() //nolint:errcheck // best-effort tracer shutdown
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
 	}
 
 	// Initialize database
@@ -69,7 +78,7 @@ func main() {
 		logger.Error("failed to connect to database", "error", err)
 		os.Exit(1)
 	}
-	defer pool.Close()
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
 
 	// Run migrations
 	dsn := pgpkg.Config{
@@ -88,28 +97,147 @@ func main() {
 	producer := kafkapkg.NewProducer(kafkapkg.Config{
 		Brokers: cfg.Kafka.Brokers,
 	})
-	defer producer.Close()
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(producer.Close))
+
+	// Outbox relay: events are written to the outbox and published from it,
+	// so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     outbox.StaticRoute(usecase.TopicLedgerEntries),
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: cfg.Telemetry.ServiceName,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, producer, relayCfg, logger)
+	if err != nil {
+		logger.Error("failed to create outbox relay", "error", err)
+		os.Exit(1)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+
+	// Monthly partitions are created ahead of time and old ones archived.
+	partitions := pgpkg.NewPartitionManager(pool, infraPG.PartitionPolicies(), logger)
+	lc.Go(lifecycle.PhaseWorkers, "partition manager", func(ctx context.Context) error {
+		partitions.Run(ctx, time.Hour)
+		return nil
+	})
+
+	// Months past the database's retention go to cold storage when an
+	// archive is configured. Audit log exports are written there too.
+	archiveStore := archive.NewStore(cfg.Archive)
+	if archiveStore != nil {
+		archiver := archive.NewArchiver(pool, archiveStore, archive.Config{Prefix: "ledger", Policies: infraPG.ArchivePolicies()}, logger)
+		lc.Go(lifecycle.PhaseWorkers, "archiver", func(ctx context.Context) error {
+			archiver.Run(ctx, time.Hour)
+			return nil
+		})
+	}
 
 	// Wire dependencies (DI via constructors)
 	journalRepo := infraPG.NewJournalRepo(pool)
 	balanceRepo := infraPG.NewBalanceRepo(pool)
+	snapshotRepo := infraPG.NewBalanceSnapshotRepo(pool)
 	periodRepo := infraPG.NewFiscalPeriodRepo(pool)
-	publisher := infraKafka.NewPublisher(producer)
+	accrualRepo := infraPG.NewInterestAccrualRepo(pool)
+	auditRepo := infraPG.NewAuditLogRepo(pool)
+	publisher := outbox.NewPublisher(outboxStore)
 	validator := service.NewPostingValidator()
 
 	// Use cases
 	postEntryUC := usecase.NewPostJournalEntry(journalRepo, balanceRepo, publisher, validator)
 	getEntryUC := usecase.NewGetJournalEntry(journalRepo)
-	getBalanceUC := usecase.NewGetBalance(balanceRepo)
+	getBalanceUC := usecase.NewGetBalance(balanceRepo, snapshotRepo)
+	openAccountUC := usecase.NewOpenAccount(balanceRepo)
 	listEntriesUC := usecase.NewListJournalEntries(journalRepo)
 	backvalueUC := usecase.NewBackvalueEntry(journalRepo)
-	periodCloseUC := usecase.NewPeriodClose(periodRepo, publisher)
+	interestAccounts, err := service.NewInterestAccounts(
+		cfg.Interest.DepositExpenseAccount,
+		cfg.Interest.DepositPayableAccount,
+		cfg.Interest.LoanReceivableAccount,
+		cfg.Interest.LoanIncomeAccount,
+	)
+	if err != nil {
+		logger.Error("invalid interest accounts", "error", err)
+		os.Exit(1)
+	}
+	recognizeInterestUC := usecase.NewRecognizeInterest(accrualRepo, postEntryUC, interestAccounts)
+	periodCloseUC := usecase.NewPeriodClose(periodRepo, snapshotRepo, publisher, recognizeInterestUC)
+	periodStatusUC := usecase.NewGetPeriodStatus(periodRepo, accrualRepo)
+	trialBalanceUC := usecase.NewGetTrialBalance(journalRepo)
+	streamAuditUC := usecase.NewStreamAuditLog(auditRepo)
+	exportAuditUC := usecase.NewExportAuditLog(auditRepo, archiveStore)
+
+	// Balances are snapshotted at the end of each period, on the elected
+	// replica only, so balance queries and period closes sum only the
+	// postings since the last snapshot.
+	snapshotBalancesUC := usecase.NewSnapshotBalances(snapshotRepo)
+	snapshotElector := lock.NewElector(lock.NewPostgresLocker(pool), "ledger.balance-snapshots", lock.ElectorConfig{}, logger)
+	lc.Go(lifecycle.PhaseWorkers, "balance snapshots", func(ctx context.Context) error {
+		snapshotElector.Run(ctx, func(ctx context.Context) {
+			ticker := time.NewTicker(time.Hour)
+			defer ticker.Stop()
+			for {
+				taken, snapErr := snapshotBalancesUC.Execute(ctx, time.Now().UTC())
+				if snapErr != nil {
+					logger.Error("balance snapshot failed", "error", snapErr)
+				} else if taken > 0 {
+					logger.Info("balances snapshotted", "periods", taken)
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		})
+		return nil
+	})
+
+	// Reopening a closed period needs a second admin's approval.
+	approvalManager := approval.NewManager(approval.NewPostgresStore(pool), usecase.ApprovalPolicy)
+	lc.Go(lifecycle.PhaseWorkers, "approval expiry", func(ctx context.Context) error {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for {
+			if _, err := approvalManager.ExpirePending(ctx); err != nil {
+				logger.Warn("failed to expire approval requests", "error", err)
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	})
+	reopenPeriodUC := usecase.NewReopenPeriod(periodRepo, publisher, approvalManager)
+
+	// Deposit and loan interest accruals are posted as they are published.
+	for _, topic := range usecase.InterestTopics {
+		consumer := kafkapkg.NewConsumer(kafkapkg.Config{
+			Brokers:       cfg.Kafka.Brokers,
+			ConsumerGroup: cfg.Kafka.ConsumerGroup,
+		}, topic, func(ctx context.Context, msg kafkapkg.Message) error {
+			return recognizeInterestUC.Execute(ctx, msg.Headers["event_type"], msg.Value)
+		}, logger)
+		lc.Go(lifecycle.PhaseConsumers, topic+" consumer", consumer.Start)
+		lc.OnStop(lifecycle.PhaseResources, topic+" consumer", lifecycle.Close(consumer.Close))
+	}
 
 	// JWT service (validation-only: public key preferred, secret as fallback).
 	jwtCfg := auth.JWTConfig{
 		Issuer: "bib-gateway",
 	}
 	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
 	case os.Getenv("JWT_PUBLIC_KEY") != "":
 		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
 	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
@@ -133,14 +261,38 @@ func main() {
 	}
 
 	// gRPC server
-	handler := grpcPresentation.NewLedgerHandler(postEntryUC, getEntryUC, getBalanceUC, listEntriesUC, backvalueUC, periodCloseUC,
-		logger)
-	grpcServer := grpcPresentation.NewServer(handler, cfg.GRPCPort, logger, jwtSvc)
+	handler := grpcPresentation.NewLedgerHandler(postEntryUC, getEntryUC, getBalanceUC, listEntriesUC, backvalueUC, periodCloseUC, periodStatusUC,
+		trialBalanceUC, reopenPeriodUC, openAccountUC, streamAuditUC, exportAuditUC, logger)
+	// Retries of PostJournalEntry carrying an idempotency key replay the first
+	// response instead of running it again.
+	idempotencyStore := idempotency.NewPostgresStore(pool)
+	lc.Go(lifecycle.PhaseWorkers, "idempotency purge", func(ctx context.Context) error {
+		idempotencyStore.RunPurge(ctx, time.Hour, logger)
+		return nil
+	})
+	idempotencyInterceptor := idempotency.UnaryServerInterceptor(idempotency.Config{
+		Store: idempotencyStore,
+		Methods: map[string]func() any{
+			"/bib.ledger.v1.LedgerService/PostJournalEntry": idempotency.Response[ledgerv1.PostJournalEntryResponse](),
+		},
+		Logger: logger,
+	})
+	grpcServer, err := grpcPresentation.NewServer(handler, cfg.GRPCPort, logger, jwtSvc,
+		grpc.ChainUnaryInterceptor(idempotencyInterceptor))
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	approval.Register(grpcServer, "bib.ledger.v1.LedgerApprovals", approval.NewServer(approvalManager))
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
 
 	// HTTP server (health checks + metrics)
 	mux := http.NewServeMux()
 	healthHandler := rest.NewHealthHandler()
 	healthHandler.RegisterRoutes(mux)
+	if metricsHandler != nil {
+		mux.Handle("/metrics", metricsHandler)
+	}
 
 	httpServer := &http.Server{
 		Addr:              fmt.Sprintf(":%d", cfg.HTTPPort),
@@ -149,31 +301,13 @@ func main() {
 	}
 
 	// Start servers
-	errCh := make(chan error, 2)
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", grpcServer.Start)
+	lc.ServeHTTP("HTTP server", httpServer)
 
-	go func() {
-		errCh <- grpcServer.Start(ctx)
-	}()
-
-	go func() {
-		logger.Info("HTTP server starting", "port", cfg.HTTPPort)
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			errCh <- err
-		}
-	}()
-
-	// Wait for shutdown
-	select {
-	case <-ctx.Done():
-		logger.Info("shutdown signal received")
-	case err := <-errCh:
-		logger.Error("server error", "error", err)
-	}
-
-	// Graceful shutdown
-	if err := httpServer.Shutdown(context.Background()); err != nil {
-		logger.Error("HTTP server shutdown error", "error", err)
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
 	}
-	grpcServer.Stop()
 	logger.Info("ledger-service stopped")
 }
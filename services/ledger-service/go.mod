@@ -3,10 +3,18 @@ module github.com/bibbank/bib/services/ledger-service
 go 1.24
 
 require (
+	github.com/bibbank/bib/api/gen/go v0.0.0
+	github.com/bibbank/bib/pkg/approval v0.0.0
+	github.com/bibbank/bib/pkg/archive v0.0.0
 	github.com/bibbank/bib/pkg/auth v0.0.0-00010101000000-000000000000
 	github.com/bibbank/bib/pkg/events v0.0.0
+	github.com/bibbank/bib/pkg/grpcserver v0.0.0
+	github.com/bibbank/bib/pkg/idempotency v0.0.0
 	github.com/bibbank/bib/pkg/kafka v0.0.0
+	github.com/bibbank/bib/pkg/lifecycle v0.0.0
+	github.com/bibbank/bib/pkg/lock v0.0.0
 	github.com/bibbank/bib/pkg/observability v0.0.0
+	github.com/bibbank/bib/pkg/outbox v0.0.0
 	github.com/bibbank/bib/pkg/postgres v0.0.0
 	github.com/bibbank/bib/pkg/testutil v0.0.0
 	github.com/bibbank/bib/pkg/tlsutil v0.0.0-00010101000000-000000000000
@@ -107,10 +115,18 @@ require (
 )
 
 replace (
+	github.com/bibbank/bib/api/gen/go => ../../api/gen/go
+	github.com/bibbank/bib/pkg/approval => ../../pkg/approval
+	github.com/bibbank/bib/pkg/archive => ../../pkg/archive
 	github.com/bibbank/bib/pkg/auth => ../../pkg/auth
 	github.com/bibbank/bib/pkg/events => ../../pkg/events
+	github.com/bibbank/bib/pkg/grpcserver => ../../pkg/grpcserver
+	github.com/bibbank/bib/pkg/idempotency => ../../pkg/idempotency
 	github.com/bibbank/bib/pkg/kafka => ../../pkg/kafka
+	github.com/bibbank/bib/pkg/lifecycle => ../../pkg/lifecycle
+	github.com/bibbank/bib/pkg/lock => ../../pkg/lock
 	github.com/bibbank/bib/pkg/observability => ../../pkg/observability
+	github.com/bibbank/bib/pkg/outbox => ../../pkg/outbox
 	github.com/bibbank/bib/pkg/postgres => ../../pkg/postgres
 	github.com/bibbank/bib/pkg/testutil => ../../pkg/testutil
 	github.com/bibbank/bib/pkg/tlsutil => ../../pkg/tlsutil
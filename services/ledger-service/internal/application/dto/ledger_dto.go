@@ -5,14 +5,21 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/approval"
 )
 
 // PostJournalEntryRequest is the input DTO for posting a journal entry.
+// The entry's legs are those of Postings, each a debit leg and a credit
+// leg, followed by Legs. ActorID is the caller, recorded in the audit log;
+// nil for entries the platform posts.
 type PostJournalEntryRequest struct {
 	EffectiveDate time.Time
+	ActorID       *uuid.UUID
 	Description   string
 	Reference     string
 	Postings      []PostingPairDTO
+	Legs          []PostingLegDTO
 	TenantID      uuid.UUID
 }
 
@@ -25,7 +32,17 @@ type PostingPairDTO struct {
 	Description   string
 }
 
-// JournalEntryResponse is the output DTO for a journal entry.
+// PostingLegDTO transfers posting leg data. Side is DEBIT or CREDIT.
+type PostingLegDTO struct {
+	AccountCode string
+	Side        string
+	Amount      decimal.Decimal
+	Currency    string
+	Description string
+}
+
+// JournalEntryResponse is the output DTO for a journal entry. Postings are
+// its legs matched into debit/credit pairs.
 type JournalEntryResponse struct {
 	EffectiveDate time.Time
 	CreatedAt     time.Time
@@ -34,6 +51,7 @@ type JournalEntryResponse struct {
 	Description   string
 	Reference     string
 	Postings      []PostingPairDTO
+	Legs          []PostingLegDTO
 	Version       int
 	ID            uuid.UUID
 	TenantID      uuid.UUID
@@ -44,6 +62,7 @@ type GetBalanceRequest struct {
 	AsOf        time.Time
 	AccountCode string
 	Currency    string
+	TenantID    uuid.UUID
 }
 
 // BalanceResponse is the output DTO for balance queries.
@@ -54,9 +73,16 @@ type BalanceResponse struct {
 	Currency    string
 }
 
+// OpenAccountRequest is the input DTO for opening a ledger account.
+type OpenAccountRequest struct {
+	AccountCode string
+	Currency    string
+}
+
 // BackvalueEntryRequest is the input DTO for back-valuation.
 type BackvalueEntryRequest struct {
 	NewDate time.Time
+	ActorID *uuid.UUID
 	EntryID uuid.UUID
 }
 
@@ -67,6 +93,49 @@ type PeriodCloseRequest struct {
 	Month    int
 }
 
+// PeriodReopenRequest is the input DTO for reopening a closed fiscal period.
+// Without an ApprovalID it asks for approval to reopen it.
+type PeriodReopenRequest struct {
+	Reason     string
+	TenantID   uuid.UUID
+	ApprovalID uuid.UUID
+	Year       int
+	Month      int
+}
+
+// PeriodReopenResponse is the output DTO for reopening a fiscal period.
+// Approval is the request submitted for approval when the period was not
+// reopened yet.
+type PeriodReopenResponse struct {
+	Approval *approval.Request
+	Period   string
+	Status   string
+}
+
+// PeriodStatusRequest is the input DTO for a fiscal period's status.
+type PeriodStatusRequest struct {
+	TenantID uuid.UUID
+	Year     int
+	Month    int
+}
+
+// PeriodStatusResponse is the output DTO for a fiscal period's status.
+// UnpostedInterest is what the period's interest true-up would post, by
+// kind and currency; it is empty once the period is closed.
+type PeriodStatusResponse struct {
+	Period           string
+	Status           string
+	UnpostedInterest []UnpostedInterestDTO
+}
+
+// UnpostedInterestDTO is interest accrued in a period but not yet posted.
+// A negative amount is interest posted in excess.
+type UnpostedInterestDTO struct {
+	Kind     string
+	Currency string
+	Amount   decimal.Decimal
+}
+
 // ListEntriesRequest is the input DTO for listing journal entries.
 type ListEntriesRequest struct {
 	FromDate    time.Time
@@ -82,3 +151,83 @@ type ListEntriesResponse struct {
 	Entries    []JournalEntryResponse
 	TotalCount int
 }
+
+// TrialBalanceRequest is the input DTO for a trial balance. Period is a
+// month ("2026-03"), quarter ("2026-Q1") or year ("2026"). PageToken is
+// empty for the first page.
+type TrialBalanceRequest struct {
+	Period    string
+	PageToken string
+	PageSize  int
+	TenantID  uuid.UUID
+}
+
+// TrialBalanceResponse is the output DTO for a trial balance. Lines holds
+// one page; Totals and Balanced cover the whole period. NextPageToken is
+// empty on the last page.
+type TrialBalanceResponse struct {
+	Period        string
+	NextPageToken string
+	Lines         []TrialBalanceLineDTO
+	Totals        []TrialBalanceTotalDTO
+	Balanced      bool
+}
+
+// TrialBalanceLineDTO holds the debits and credits posted to an account in
+// a currency.
+type TrialBalanceLineDTO struct {
+	AccountCode string
+	Currency    string
+	Debit       decimal.Decimal
+	Credit      decimal.Decimal
+}
+
+// TrialBalanceTotalDTO sums a trial balance in a currency.
+type TrialBalanceTotalDTO struct {
+	Currency string
+	Debit    decimal.Decimal
+	Credit   decimal.Decimal
+	Balanced bool
+}
+
+// AuditLogRequest is the input DTO for reading the audit log: a tenant's
+// journal entry transitions that occurred within [From, To), after the
+// record with sequence AfterSequence. A zero From reads from the start of
+// the log and a zero To up to now.
+type AuditLogRequest struct {
+	From          time.Time
+	To            time.Time
+	AfterSequence int64
+	TenantID      uuid.UUID
+}
+
+// AuditRecordDTO is one state transition of a journal entry. ActorID is
+// nil for transitions the platform made. FromStatus is empty for CREATED.
+type AuditRecordDTO struct {
+	OccurredAt    time.Time
+	EffectiveDate time.Time
+	ActorID       *uuid.UUID
+	Action        string
+	FromStatus    string
+	ToStatus      string
+	Detail        string
+	Sequence      int64
+	EntryVersion  int
+	ID            uuid.UUID
+	EntryID       uuid.UUID
+}
+
+// ExportAuditLogRequest is the input DTO for exporting the audit log of a
+// tenant's journal entry transitions that occurred within [From, To).
+type ExportAuditLogRequest struct {
+	From     time.Time
+	To       time.Time
+	TenantID uuid.UUID
+}
+
+// ExportAuditLogResponse is the output DTO for an audit log export: where
+// it was written and how many records it holds.
+type ExportAuditLogResponse struct {
+	URI     string
+	Records int
+}
@@ -25,7 +25,7 @@ func (uc *BackvalueEntry) Execute(ctx context.Context, req dto.BackvalueEntryReq
 	}
 
 	now := time.Now().UTC()
-	backvalued, err := entry.Backvalue(req.NewDate, now)
+	backvalued, err := entry.WithActor(req.ActorID).Backvalue(req.NewDate, now)
 	if err != nil {
 		return dto.JournalEntryResponse{}, fmt.Errorf("failed to backvalue entry: %w", err)
 	}
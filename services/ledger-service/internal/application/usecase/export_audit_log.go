@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/application/dto"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/port"
+)
+
+// ErrAuditExportDisabled is returned when no export store is configured.
+var ErrAuditExportDisabled = errors.New("audit log export is not configured")
+
+// maxAuditExportWindow bounds a single export so it fits comfortably in
+// memory. A fiscal year fits.
+const maxAuditExportWindow = 366 * 24 * time.Hour
+
+// auditLogLine is an audit record as written to an export, one JSON object
+// per line.
+type auditLogLine struct {
+	OccurredAt    time.Time  `json:"occurred_at"`
+	EffectiveDate time.Time  `json:"effective_date"`
+	ActorID       *uuid.UUID `json:"actor_id,omitempty"`
+	Action        string     `json:"action"`
+	FromStatus    string     `json:"from_status,omitempty"`
+	ToStatus      string     `json:"to_status"`
+	Detail        string     `json:"detail,omitempty"`
+	Sequence      int64      `json:"sequence"`
+	EntryVersion  int        `json:"entry_version"`
+	ID            uuid.UUID  `json:"id"`
+	TenantID      uuid.UUID  `json:"tenant_id"`
+	EntryID       uuid.UUID  `json:"entry_id"`
+}
+
+// ExportAuditLog writes a tenant's audit log over a date range to a JSON
+// Lines file in the export store, for regulators and auditors.
+type ExportAuditLog struct {
+	auditRepo port.AuditLogRepository
+	store     port.AuditExportStore
+}
+
+// NewExportAuditLog creates an ExportAuditLog. With a nil store, exports
+// fail with ErrAuditExportDisabled.
+func NewExportAuditLog(auditRepo port.AuditLogRepository, store port.AuditExportStore) *ExportAuditLog {
+	return &ExportAuditLog{auditRepo: auditRepo, store: store}
+}
+
+// Execute writes one JSON object per audit record that occurred within
+// [From, To), in the order they were appended.
+func (uc *ExportAuditLog) Execute(ctx context.Context, req dto.ExportAuditLogRequest) (dto.ExportAuditLogResponse, error) {
+	if uc.store == nil {
+		return dto.ExportAuditLogResponse{}, ErrAuditExportDisabled
+	}
+	if req.From.IsZero() || req.To.IsZero() || !req.From.Before(req.To) {
+		return dto.ExportAuditLogResponse{}, fmt.Errorf("%w: from must be before to", ErrInvalidRange)
+	}
+	if req.To.Sub(req.From) > maxAuditExportWindow {
+		return dto.ExportAuditLogResponse{}, fmt.Errorf("%w: export window cannot exceed %s", ErrInvalidRange, maxAuditExportWindow)
+	}
+
+	var (
+		buf  bytes.Buffer
+		resp dto.ExportAuditLogResponse
+	)
+	enc := json.NewEncoder(&buf)
+	err := scanAuditLog(ctx, uc.auditRepo, req.TenantID, req.From, req.To, 0, func(rec model.AuditRecord) error {
+		if err := enc.Encode(auditLogLine{
+			Sequence:      rec.Sequence,
+			ID:            rec.ID,
+			TenantID:      rec.TenantID,
+			EntryID:       rec.EntryID,
+			Action:        string(rec.Action),
+			FromStatus:    string(rec.FromStatus),
+			ToStatus:      string(rec.ToStatus),
+			EntryVersion:  rec.EntryVersion,
+			EffectiveDate: rec.EffectiveDate,
+			ActorID:       rec.ActorID,
+			Detail:        rec.Detail,
+			OccurredAt:    rec.OccurredAt,
+		}); err != nil {
+			return fmt.Errorf("failed to encode audit record: %w", err)
+		}
+		resp.Records++
+		return nil
+	})
+	if err != nil {
+		return dto.ExportAuditLogResponse{}, err
+	}
+
+	key := fmt.Sprintf("ledger-audit/%s/%s_%s/%s.jsonl",
+		req.TenantID,
+		req.From.UTC().Format("20060102"),
+		req.To.UTC().Format("20060102"),
+		time.Now().UTC().Format("20060102T150405Z"),
+	)
+	if err := uc.store.Put(ctx, key, bytes.NewReader(buf.Bytes()), "application/x-ndjson"); err != nil {
+		return dto.ExportAuditLogResponse{}, fmt.Errorf("failed to write audit log export: %w", err)
+	}
+	resp.URI = uc.store.URI(key)
+
+	return resp, nil
+}
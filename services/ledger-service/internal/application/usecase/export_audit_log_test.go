@@ -0,0 +1,108 @@
+package usecase_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/application/dto"
+	"github.com/bibbank/bib/services/ledger-service/internal/application/usecase"
+)
+
+// memoryExportStore implements port.AuditExportStore in memory.
+type memoryExportStore struct {
+	objects map[string][]byte
+}
+
+func (s *memoryExportStore) Put(_ context.Context, key string, body io.ReadSeeker, _ string) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	if s.objects == nil {
+		s.objects = make(map[string][]byte)
+	}
+	s.objects[key] = data
+	return nil
+}
+
+func (s *memoryExportStore) URI(key string) string {
+	return "mem://" + key
+}
+
+func TestExportAuditLog_WritesJSONLines(t *testing.T) {
+	tenantID := uuid.New()
+	start := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	store := &memoryExportStore{}
+	uc := usecase.NewExportAuditLog(auditLogOf(tenantID, start, 700), store)
+
+	resp, err := uc.Execute(context.Background(), dto.ExportAuditLogRequest{
+		TenantID: tenantID,
+		From:     start.Add(100 * time.Hour),
+		To:       start.Add(700 * time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 600, resp.Records)
+	require.Len(t, store.objects, 1)
+
+	var (
+		key  string
+		data []byte
+	)
+	for k, v := range store.objects {
+		key, data = k, v
+	}
+	assert.Equal(t, "mem://"+key, resp.URI)
+	assert.Contains(t, key, "ledger-audit/"+tenantID.String()+"/20260105_20260130/")
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lines := 0
+	for scanner.Scan() {
+		var line map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		if lines == 0 {
+			assert.Equal(t, float64(101), line["sequence"])
+			assert.Equal(t, "POSTED", line["action"])
+			assert.Equal(t, tenantID.String(), line["tenant_id"])
+			assert.NotContains(t, line, "actor_id")
+		}
+		lines++
+	}
+	assert.Equal(t, 600, lines)
+}
+
+func TestExportAuditLog_ValidatesRange(t *testing.T) {
+	uc := usecase.NewExportAuditLog(&mockAuditLogRepository{}, &memoryExportStore{})
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+	}{
+		{name: "missing from", to: now},
+		{name: "inverted", from: now, to: now.Add(-time.Hour)},
+		{name: "longer than a year", from: now.AddDate(-2, 0, 0), to: now},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Execute(context.Background(), dto.ExportAuditLogRequest{TenantID: uuid.New(), From: tt.from, To: tt.to})
+			require.ErrorIs(t, err, usecase.ErrInvalidRange)
+		})
+	}
+}
+
+func TestExportAuditLog_Disabled(t *testing.T) {
+	uc := usecase.NewExportAuditLog(&mockAuditLogRepository{}, nil)
+	now := time.Now().UTC()
+
+	_, err := uc.Execute(context.Background(), dto.ExportAuditLogRequest{TenantID: uuid.New(), From: now.Add(-time.Hour), To: now})
+	assert.ErrorIs(t, err, usecase.ErrAuditExportDisabled)
+}
@@ -5,18 +5,23 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/bibbank/bib/services/ledger-service/internal/application/dto"
 	"github.com/bibbank/bib/services/ledger-service/internal/domain/port"
 	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
 )
 
 // GetBalance retrieves the current or historical balance of an account.
+// The current balance is the running balance; a balance as of a past day
+// is read from the tenant's balance snapshots.
 type GetBalance struct {
 	balanceRepo port.BalanceRepository
+	snapshots   port.BalanceSnapshotRepository
 }
 
-func NewGetBalance(balanceRepo port.BalanceRepository) *GetBalance {
-	return &GetBalance{balanceRepo: balanceRepo}
+func NewGetBalance(balanceRepo port.BalanceRepository, snapshots port.BalanceSnapshotRepository) *GetBalance {
+	return &GetBalance{balanceRepo: balanceRepo, snapshots: snapshots}
 }
 
 func (uc *GetBalance) Execute(ctx context.Context, req dto.GetBalanceRequest) (dto.BalanceResponse, error) {
@@ -25,12 +30,19 @@ func (uc *GetBalance) Execute(ctx context.Context, req dto.GetBalanceRequest) (d
 		return dto.BalanceResponse{}, fmt.Errorf("invalid account code: %w", err)
 	}
 
+	now := time.Now().UTC()
 	asOf := req.AsOf
 	if asOf.IsZero() {
-		asOf = time.Now().UTC()
+		asOf = now
 	}
 
-	balance, err := uc.balanceRepo.GetBalance(ctx, accountCode, req.Currency, asOf)
+	var balance decimal.Decimal
+	if day := asOf.UTC().Truncate(24 * time.Hour); day.Before(now.Truncate(24 * time.Hour)) {
+		// Everything effective on or before the day
+		balance, err = uc.snapshots.BalanceBefore(ctx, req.TenantID, accountCode, req.Currency, day.AddDate(0, 0, 1))
+	} else {
+		balance, err = uc.balanceRepo.GetBalance(ctx, accountCode, req.Currency, asOf)
+	}
 	if err != nil {
 		return dto.BalanceResponse{}, fmt.Errorf("failed to get balance: %w", err)
 	}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,7 +26,7 @@ func TestGetBalance_Execute(t *testing.T) {
 			},
 		}
 
-		uc := usecase.NewGetBalance(balanceRepo)
+		uc := usecase.NewGetBalance(balanceRepo, &mockBalanceSnapshotRepository{})
 
 		req := dto.GetBalanceRequest{
 			AccountCode: "1000",
@@ -48,7 +49,7 @@ func TestGetBalance_Execute(t *testing.T) {
 			},
 		}
 
-		uc := usecase.NewGetBalance(balanceRepo)
+		uc := usecase.NewGetBalance(balanceRepo, &mockBalanceSnapshotRepository{})
 
 		req := dto.GetBalanceRequest{
 			AccountCode: "1000",
@@ -60,10 +61,37 @@ func TestGetBalance_Execute(t *testing.T) {
 		assert.True(t, decimal.NewFromInt(100).Equal(resp.Amount))
 	})
 
+	t.Run("reads a past day's balance from snapshots", func(t *testing.T) {
+		tenantID := uuid.New()
+		asOf := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+		snapshots := &mockBalanceSnapshotRepository{
+			balanceBeforeFunc: func(_ context.Context, tenant uuid.UUID, account valueobject.AccountCode, currency string, before time.Time) (decimal.Decimal, error) {
+				assert.Equal(t, tenantID, tenant)
+				assert.Equal(t, "1000", account.Code())
+				assert.Equal(t, "USD", currency)
+				assert.Equal(t, time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC), before, "the as-of day's entries count")
+				return decimal.NewFromInt(750), nil
+			},
+		}
+
+		uc := usecase.NewGetBalance(&mockBalanceRepository{}, snapshots)
+
+		resp, err := uc.Execute(context.Background(), dto.GetBalanceRequest{
+			TenantID:    tenantID,
+			AccountCode: "1000",
+			Currency:    "USD",
+			AsOf:        asOf,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(750).Equal(resp.Amount))
+		assert.Equal(t, asOf, resp.AsOf)
+	})
+
 	t.Run("fails with invalid account code", func(t *testing.T) {
 		balanceRepo := &mockBalanceRepository{}
 
-		uc := usecase.NewGetBalance(balanceRepo)
+		uc := usecase.NewGetBalance(balanceRepo, &mockBalanceSnapshotRepository{})
 
 		req := dto.GetBalanceRequest{
 			AccountCode: "INVALID",
@@ -82,7 +110,7 @@ func TestGetBalance_Execute(t *testing.T) {
 			},
 		}
 
-		uc := usecase.NewGetBalance(balanceRepo)
+		uc := usecase.NewGetBalance(balanceRepo, &mockBalanceSnapshotRepository{})
 
 		req := dto.GetBalanceRequest{
 			AccountCode: "1000",
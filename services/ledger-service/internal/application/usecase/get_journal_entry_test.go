@@ -27,7 +27,7 @@ func sampleJournalEntry() model.JournalEntry {
 
 	return model.Reconstruct(
 		id, tenantID, now,
-		[]valueobject.PostingPair{posting},
+		posting.Legs(),
 		model.EntryStatusPosted, "Test entry", "REF-001",
 		1, now, now,
 	)
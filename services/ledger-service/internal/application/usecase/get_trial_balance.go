@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/application/dto"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/port"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+// ErrInvalidPageToken is returned for a page token the ledger did not issue.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// GetTrialBalance aggregates a tenant's postings over a reporting period
+// into the debits and credits of each account, the figures balance sheets
+// and regulatory reports are built from.
+type GetTrialBalance struct {
+	trialBalances port.TrialBalanceRepository
+}
+
+// NewGetTrialBalance creates a GetTrialBalance.
+func NewGetTrialBalance(trialBalances port.TrialBalanceRepository) *GetTrialBalance {
+	return &GetTrialBalance{trialBalances: trialBalances}
+}
+
+func (uc *GetTrialBalance) Execute(ctx context.Context, req dto.TrialBalanceRequest) (dto.TrialBalanceResponse, error) {
+	first, last, err := valueobject.ParseReportingPeriod(req.Period)
+	if err != nil {
+		return dto.TrialBalanceResponse{}, fmt.Errorf("%w: %w", ErrInvalidPeriod, err)
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 500
+	}
+	if pageSize > 1000 {
+		pageSize = 1000
+	}
+	offset := 0
+	if req.PageToken != "" {
+		offset, err = strconv.Atoi(req.PageToken)
+		if err != nil || offset < 0 {
+			return dto.TrialBalanceResponse{}, fmt.Errorf("%w: %q", ErrInvalidPageToken, req.PageToken)
+		}
+	}
+
+	lines, err := uc.trialBalances.TrialBalance(ctx, req.TenantID, first.StartDate(), last.Next().StartDate())
+	if err != nil {
+		return dto.TrialBalanceResponse{}, fmt.Errorf("failed to aggregate trial balance: %w", err)
+	}
+
+	resp := dto.TrialBalanceResponse{Period: req.Period, Balanced: true}
+	for _, t := range model.TrialBalanceTotals(lines) {
+		resp.Totals = append(resp.Totals, dto.TrialBalanceTotalDTO{
+			Currency: t.Currency,
+			Debit:    t.Debit,
+			Credit:   t.Credit,
+			Balanced: t.Balanced(),
+		})
+		resp.Balanced = resp.Balanced && t.Balanced()
+	}
+
+	end := min(offset+pageSize, len(lines))
+	if offset < end {
+		for _, l := range lines[offset:end] {
+			resp.Lines = append(resp.Lines, dto.TrialBalanceLineDTO{
+				AccountCode: l.AccountCode.Code(),
+				Currency:    l.Currency,
+				Debit:       l.Debit,
+				Credit:      l.Credit,
+			})
+		}
+	}
+	if end < len(lines) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return resp, nil
+}
@@ -0,0 +1,102 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/application/dto"
+	"github.com/bibbank/bib/services/ledger-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+// mockTrialBalanceRepository implements port.TrialBalanceRepository for testing.
+type mockTrialBalanceRepository struct {
+	lines    []model.TrialBalanceLine
+	from, to time.Time
+}
+
+func (m *mockTrialBalanceRepository) TrialBalance(_ context.Context, _ uuid.UUID, from, to time.Time) ([]model.TrialBalanceLine, error) {
+	m.from, m.to = from, to
+	return m.lines, nil
+}
+
+func trialBalanceLine(code, currency string, debit, credit int64) model.TrialBalanceLine {
+	return model.TrialBalanceLine{
+		AccountCode: valueobject.MustAccountCode(code),
+		Currency:    currency,
+		Debit:       decimal.NewFromInt(debit),
+		Credit:      decimal.NewFromInt(credit),
+	}
+}
+
+func TestGetTrialBalance_Execute(t *testing.T) {
+	t.Run("aggregates the quarter and pages its lines", func(t *testing.T) {
+		repo := &mockTrialBalanceRepository{lines: []model.TrialBalanceLine{
+			trialBalanceLine("1000", "EUR", 900, 100),
+			trialBalanceLine("1000", "USD", 50, 0),
+			trialBalanceLine("2000", "EUR", 0, 700),
+			trialBalanceLine("2000", "USD", 0, 50),
+			trialBalanceLine("4000", "EUR", 0, 100),
+		}}
+		uc := usecase.NewGetTrialBalance(repo)
+
+		resp, err := uc.Execute(context.Background(), dto.TrialBalanceRequest{
+			TenantID: uuid.New(),
+			Period:   "2026-Q1",
+			PageSize: 2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), repo.from)
+		assert.Equal(t, time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC), repo.to)
+		assert.True(t, resp.Balanced)
+		require.Len(t, resp.Totals, 2)
+		assert.Equal(t, "EUR", resp.Totals[0].Currency)
+		assert.Equal(t, "900", resp.Totals[0].Debit.String())
+		assert.Equal(t, "900", resp.Totals[0].Credit.String())
+		require.Len(t, resp.Lines, 2)
+		assert.Equal(t, "2", resp.NextPageToken)
+
+		var codes []string
+		for resp.NextPageToken != "" {
+			resp, err = uc.Execute(context.Background(), dto.TrialBalanceRequest{
+				Period:    "2026-Q1",
+				PageToken: resp.NextPageToken,
+				PageSize:  2,
+			})
+			require.NoError(t, err)
+			for _, l := range resp.Lines {
+				codes = append(codes, l.AccountCode+"/"+l.Currency)
+			}
+		}
+		assert.Equal(t, []string{"2000/EUR", "2000/USD", "4000/EUR"}, codes)
+	})
+
+	t.Run("reports a currency that does not balance", func(t *testing.T) {
+		repo := &mockTrialBalanceRepository{lines: []model.TrialBalanceLine{
+			trialBalanceLine("1000", "EUR", 100, 0),
+			trialBalanceLine("2000", "EUR", 0, 90),
+		}}
+
+		resp, err := usecase.NewGetTrialBalance(repo).Execute(context.Background(), dto.TrialBalanceRequest{Period: "2026-03"})
+		require.NoError(t, err)
+		assert.False(t, resp.Balanced)
+		assert.False(t, resp.Totals[0].Balanced)
+	})
+
+	t.Run("rejects invalid periods and page tokens", func(t *testing.T) {
+		uc := usecase.NewGetTrialBalance(&mockTrialBalanceRepository{})
+
+		_, err := uc.Execute(context.Background(), dto.TrialBalanceRequest{Period: "2026-Q5"})
+		assert.ErrorIs(t, err, usecase.ErrInvalidPeriod)
+
+		_, err = uc.Execute(context.Background(), dto.TrialBalanceRequest{Period: "2026", PageToken: "next"})
+		assert.ErrorIs(t, err, usecase.ErrInvalidPageToken)
+	})
+}
@@ -29,6 +29,10 @@ func (m *listMockJournalRepository) FindByID(_ context.Context, _ uuid.UUID) (mo
 	return model.JournalEntry{}, fmt.Errorf("not implemented")
 }
 
+func (m *listMockJournalRepository) FindByReference(_ context.Context, _ uuid.UUID, _ string) (model.JournalEntry, error) {
+	return model.JournalEntry{}, fmt.Errorf("not implemented")
+}
+
 func (m *listMockJournalRepository) ListByAccount(ctx context.Context, tenantID uuid.UUID, account valueobject.AccountCode, from, to time.Time, limit, offset int) ([]model.JournalEntry, int, error) {
 	if m.listByAccountFunc != nil {
 		return m.listByAccountFunc(ctx, tenantID, account, from, to, limit, offset)
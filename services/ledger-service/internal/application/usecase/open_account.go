@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/application/dto"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/port"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+// OpenAccount opens a ledger account for a customer account, so that it
+// appears in balance queries with a zero balance before anything is posted
+// to it. Opening an account that is already open returns its balance
+// unchanged, so callers may retry it.
+type OpenAccount struct {
+	balanceRepo port.BalanceRepository
+}
+
+func NewOpenAccount(balanceRepo port.BalanceRepository) *OpenAccount {
+	return &OpenAccount{balanceRepo: balanceRepo}
+}
+
+func (uc *OpenAccount) Execute(ctx context.Context, req dto.OpenAccountRequest) (dto.BalanceResponse, error) {
+	accountCode, err := valueobject.NewAccountCode(req.AccountCode)
+	if err != nil {
+		return dto.BalanceResponse{}, fmt.Errorf("invalid account code: %w", err)
+	}
+
+	balance, err := uc.balanceRepo.OpenAccount(ctx, accountCode, req.Currency)
+	if err != nil {
+		return dto.BalanceResponse{}, fmt.Errorf("failed to open account: %w", err)
+	}
+
+	return dto.BalanceResponse{
+		AccountCode: accountCode.Code(),
+		Amount:      balance,
+		Currency:    req.Currency,
+		AsOf:        time.Now().UTC(),
+	}, nil
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,23 +12,37 @@ import (
 	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
 )
 
-// PeriodClose closes a fiscal period, preventing further postings.
+// ErrInvalidPeriod is returned for a fiscal period that does not exist.
+var ErrInvalidPeriod = errors.New("invalid fiscal period")
+
+// ErrPeriodClosed is returned when a closed fiscal period is closed again.
+var ErrPeriodClosed = errors.New("fiscal period is already closed")
+
+// PeriodClose closes a fiscal period, preventing further postings. Before
+// closing it trues up the period's interest accruals; on closing it freezes
+// the period's balances for audit.
 type PeriodClose struct {
 	periodRepo port.FiscalPeriodRepository
+	snapshots  port.BalanceSnapshotRepository
 	publisher  port.EventPublisher
+	interest   *RecognizeInterest
 }
 
-func NewPeriodClose(periodRepo port.FiscalPeriodRepository, publisher port.EventPublisher) *PeriodClose {
+// NewPeriodClose creates a PeriodClose. interest may be nil, in which case
+// periods close without an interest true-up.
+func NewPeriodClose(periodRepo port.FiscalPeriodRepository, snapshots port.BalanceSnapshotRepository, publisher port.EventPublisher, interest *RecognizeInterest) *PeriodClose {
 	return &PeriodClose{
 		periodRepo: periodRepo,
+		snapshots:  snapshots,
 		publisher:  publisher,
+		interest:   interest,
 	}
 }
 
 func (uc *PeriodClose) Execute(ctx context.Context, req dto.PeriodCloseRequest) error {
 	period, err := valueobject.NewFiscalPeriod(req.Year, time.Month(req.Month))
 	if err != nil {
-		return fmt.Errorf("invalid fiscal period: %w", err)
+		return fmt.Errorf("%w: %w", ErrInvalidPeriod, err)
 	}
 
 	// Check current status
@@ -36,11 +51,22 @@ func (uc *PeriodClose) Execute(ctx context.Context, req dto.PeriodCloseRequest)
 		return fmt.Errorf("failed to get period status: %w", err)
 	}
 	if status == valueobject.PeriodStatusClosed {
-		return fmt.Errorf("period %s is already closed", period)
+		return fmt.Errorf("%w: %s", ErrPeriodClosed, period)
+	}
+
+	// Post what the period's daily interest accruals left unposted
+	if uc.interest != nil {
+		if _, err := uc.interest.TrueUp(ctx, req.TenantID, period); err != nil {
+			return fmt.Errorf("failed to true up interest: %w", err)
+		}
 	}
 
-	// Close the period
-	if err := uc.periodRepo.ClosePeriod(ctx, req.TenantID, period); err != nil {
+	// Close the period with its balances, true-up included
+	balances, err := uc.snapshots.PeriodBalances(ctx, req.TenantID, period)
+	if err != nil {
+		return fmt.Errorf("failed to get period balances: %w", err)
+	}
+	if err := uc.periodRepo.ClosePeriod(ctx, req.TenantID, period, balances); err != nil {
 		return fmt.Errorf("failed to close period: %w", err)
 	}
 
@@ -52,3 +78,47 @@ func (uc *PeriodClose) Execute(ctx context.Context, req dto.PeriodCloseRequest)
 
 	return nil
 }
+
+// GetPeriodStatus reports whether a fiscal period is closed and the interest
+// its close would still post.
+type GetPeriodStatus struct {
+	periodRepo port.FiscalPeriodRepository
+	accruals   port.InterestAccrualRepository
+}
+
+// NewGetPeriodStatus creates a GetPeriodStatus.
+func NewGetPeriodStatus(periodRepo port.FiscalPeriodRepository, accruals port.InterestAccrualRepository) *GetPeriodStatus {
+	return &GetPeriodStatus{periodRepo: periodRepo, accruals: accruals}
+}
+
+func (uc *GetPeriodStatus) Execute(ctx context.Context, req dto.PeriodStatusRequest) (dto.PeriodStatusResponse, error) {
+	period, err := valueobject.NewFiscalPeriod(req.Year, time.Month(req.Month))
+	if err != nil {
+		return dto.PeriodStatusResponse{}, fmt.Errorf("%w: %w", ErrInvalidPeriod, err)
+	}
+
+	status, err := uc.periodRepo.GetPeriodStatus(ctx, req.TenantID, period)
+	if err != nil {
+		return dto.PeriodStatusResponse{}, fmt.Errorf("failed to get period status: %w", err)
+	}
+	resp := dto.PeriodStatusResponse{Period: period.String(), Status: string(status)}
+
+	// A closed period's true-up has been posted.
+	if status == valueobject.PeriodStatusClosed {
+		return resp, nil
+	}
+	totals, err := uc.accruals.PeriodTotals(ctx, req.TenantID, period)
+	if err != nil {
+		return dto.PeriodStatusResponse{}, fmt.Errorf("failed to total interest accruals: %w", err)
+	}
+	for _, t := range totals {
+		if adj := t.Adjustment(); !adj.IsZero() {
+			resp.UnpostedInterest = append(resp.UnpostedInterest, dto.UnpostedInterestDTO{
+				Kind:     string(t.Kind),
+				Currency: t.Currency,
+				Amount:   adj,
+			})
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,44 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/application/dto"
+	"github.com/bibbank/bib/services/ledger-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+func TestPeriodClose_FreezesClosingBalances(t *testing.T) {
+	cash, err := valueobject.NewAccountCode("1000")
+	require.NoError(t, err)
+	balances := []model.PeriodBalance{{
+		AccountCode: cash,
+		Currency:    "USD",
+		Opening:     decimal.NewFromInt(100),
+		Debit:       decimal.NewFromInt(50),
+		Credit:      decimal.NewFromInt(30),
+	}}
+	periods := &mockFiscalPeriodRepository{}
+	uc := usecase.NewPeriodClose(periods, &mockBalanceSnapshotRepository{balances: balances}, &mockEventPublisher{}, nil)
+
+	err = uc.Execute(context.Background(), dto.PeriodCloseRequest{TenantID: uuid.New(), Year: 2026, Month: 3})
+
+	require.NoError(t, err)
+	require.Len(t, periods.closed, 1)
+	assert.Equal(t, "2026-03", periods.closed[0].String())
+	require.Len(t, periods.frozen, 1)
+	assert.Equal(t, balances, periods.frozen[0])
+	assert.True(t, decimal.NewFromInt(120).Equal(periods.frozen[0][0].Closing()))
+
+	err = uc.Execute(context.Background(), dto.PeriodCloseRequest{TenantID: uuid.New(), Year: 2026, Month: int(time.March)})
+	require.ErrorIs(t, err, usecase.ErrPeriodClosed)
+	assert.Len(t, periods.frozen, 1, "a closed period's balances are not frozen again")
+}
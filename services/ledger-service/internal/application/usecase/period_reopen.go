@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/approval"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/ledger-service/internal/application/dto"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/event"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/port"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+// ActionReopenPeriod is the approval action for reopening a closed fiscal
+// period. Its subject is the period, as YYYY-MM.
+const ActionReopenPeriod = "ledger.period_reopen"
+
+// ApprovalPolicy puts the ledger's sensitive operations under dual control.
+var ApprovalPolicy = approval.Policy{
+	ActionReopenPeriod: {ApproverRoles: []string{auth.RoleAdmin}, TTL: 24 * time.Hour},
+}
+
+var (
+	// ErrPeriodOpen is returned when reopening a fiscal period that is not
+	// closed.
+	ErrPeriodOpen = errors.New("fiscal period is not closed")
+	// ErrReasonRequired is returned when asking to reopen a fiscal period
+	// without a reason.
+	ErrReasonRequired = errors.New("a reason is required to reopen a fiscal period")
+	// ErrApprovalMismatch is returned when reopening a fiscal period under
+	// an approval for another action or period.
+	ErrApprovalMismatch = errors.New("approval is not for reopening this period")
+	// ErrNotApproved is returned when reopening a fiscal period under an
+	// approval that has not been approved.
+	ErrNotApproved = errors.New("approval request is not approved")
+)
+
+// reopenPayload is the approval payload of a period reopening.
+type reopenPayload struct {
+	Year  int `json:"year"`
+	Month int `json:"month"`
+}
+
+// ReopenPeriod reopens a closed fiscal period so corrections can be posted
+// to it. Reopening is under dual control: a first call submits a request
+// for approval, and once a checker has approved it a second call carrying
+// the request's ID reopens the period. Each approval reopens a period once.
+type ReopenPeriod struct {
+	periodRepo port.FiscalPeriodRepository
+	publisher  port.EventPublisher
+	approvals  *approval.Manager
+}
+
+// NewReopenPeriod creates a ReopenPeriod.
+func NewReopenPeriod(periodRepo port.FiscalPeriodRepository, publisher port.EventPublisher, approvals *approval.Manager) *ReopenPeriod {
+	return &ReopenPeriod{
+		periodRepo: periodRepo,
+		publisher:  publisher,
+		approvals:  approvals,
+	}
+}
+
+func (uc *ReopenPeriod) Execute(ctx context.Context, req dto.PeriodReopenRequest) (dto.PeriodReopenResponse, error) {
+	period, err := valueobject.NewFiscalPeriod(req.Year, time.Month(req.Month))
+	if err != nil {
+		return dto.PeriodReopenResponse{}, fmt.Errorf("%w: %w", ErrInvalidPeriod, err)
+	}
+
+	status, err := uc.periodRepo.GetPeriodStatus(ctx, req.TenantID, period)
+	if err != nil {
+		return dto.PeriodReopenResponse{}, fmt.Errorf("failed to get period status: %w", err)
+	}
+	if status != valueobject.PeriodStatusClosed {
+		return dto.PeriodReopenResponse{}, fmt.Errorf("%w: %s", ErrPeriodOpen, period)
+	}
+
+	if req.ApprovalID == uuid.Nil {
+		if req.Reason == "" {
+			return dto.PeriodReopenResponse{}, ErrReasonRequired
+		}
+		r, err := uc.approvals.Submit(ctx, ActionReopenPeriod, period.String(), req.Reason,
+			reopenPayload{Year: period.Year(), Month: int(period.Month())})
+		if err != nil {
+			return dto.PeriodReopenResponse{}, fmt.Errorf("failed to submit reopen request: %w", err)
+		}
+		return dto.PeriodReopenResponse{Period: period.String(), Status: string(status), Approval: &r}, nil
+	}
+
+	r, err := uc.approvals.Get(ctx, req.ApprovalID)
+	if err != nil {
+		return dto.PeriodReopenResponse{}, fmt.Errorf("failed to get approval request: %w", err)
+	}
+	if r.Action != ActionReopenPeriod || r.Subject != period.String() {
+		return dto.PeriodReopenResponse{}, fmt.Errorf("%w: %s", ErrApprovalMismatch, period)
+	}
+	if r.Status != approval.StatusApproved {
+		return dto.PeriodReopenResponse{}, fmt.Errorf("%w: %s", ErrNotApproved, r.Status)
+	}
+
+	if err := uc.periodRepo.ReopenPeriod(ctx, req.TenantID, period, r.ID, r.Reason); err != nil {
+		if errors.Is(err, port.ErrPeriodNotClosed) {
+			return dto.PeriodReopenResponse{}, fmt.Errorf("%w: %s", ErrPeriodOpen, period)
+		}
+		return dto.PeriodReopenResponse{}, fmt.Errorf("failed to reopen period: %w", err)
+	}
+
+	evt := event.NewPeriodReopened(req.TenantID, period.String(), r.ID, r.Reason)
+	if err := uc.publisher.Publish(ctx, TopicLedgerEntries, evt); err != nil {
+		return dto.PeriodReopenResponse{}, fmt.Errorf("failed to publish period reopened event: %w", err)
+	}
+
+	return dto.PeriodReopenResponse{Period: period.String(), Status: string(valueobject.PeriodStatusOpen)}, nil
+}
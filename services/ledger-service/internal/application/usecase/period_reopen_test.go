@@ -0,0 +1,130 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/approval"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/ledger-service/internal/application/dto"
+	"github.com/bibbank/bib/services/ledger-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/port"
+)
+
+type reopenFixture struct {
+	periods   *mockFiscalPeriodRepository
+	publisher *mockEventPublisher
+	approvals *approval.Manager
+	uc        *usecase.ReopenPeriod
+	tenantID  uuid.UUID
+}
+
+func newReopenFixture() reopenFixture {
+	f := reopenFixture{
+		periods:   &mockFiscalPeriodRepository{isClosed: true},
+		publisher: &mockEventPublisher{},
+		approvals: approval.NewManager(approval.NewMemoryStore(), usecase.ApprovalPolicy),
+		tenantID:  uuid.New(),
+	}
+	f.uc = usecase.NewReopenPeriod(f.periods, f.publisher, f.approvals)
+	return f
+}
+
+// caller returns the context of a new admin in the fixture's tenant.
+func (f reopenFixture) caller() context.Context {
+	return auth.ContextWithClaims(context.Background(), &auth.Claims{
+		UserID:   uuid.New(),
+		TenantID: f.tenantID,
+		Roles:    []string{auth.RoleAdmin},
+	})
+}
+
+func (f reopenFixture) request(approvalID uuid.UUID) dto.PeriodReopenRequest {
+	return dto.PeriodReopenRequest{
+		TenantID:   f.tenantID,
+		Year:       2026,
+		Month:      9,
+		Reason:     "late supplier invoice",
+		ApprovalID: approvalID,
+	}
+}
+
+func TestReopenPeriod_ReopensUnderApprovedRequest(t *testing.T) {
+	f := newReopenFixture()
+	maker := f.caller()
+
+	submitted, err := f.uc.Execute(maker, f.request(uuid.Nil))
+	require.NoError(t, err)
+	require.NotNil(t, submitted.Approval)
+	assert.Equal(t, "CLOSED", submitted.Status)
+	assert.Equal(t, usecase.ActionReopenPeriod, submitted.Approval.Action)
+	assert.Equal(t, "2026-09", submitted.Approval.Subject)
+
+	_, err = f.uc.Execute(maker, f.request(submitted.Approval.ID))
+	require.ErrorIs(t, err, usecase.ErrNotApproved)
+
+	_, err = f.approvals.Approve(f.caller(), submitted.Approval.ID, "")
+	require.NoError(t, err)
+
+	reopened, err := f.uc.Execute(maker, f.request(submitted.Approval.ID))
+	require.NoError(t, err)
+	assert.Equal(t, "OPEN", reopened.Status)
+	assert.Nil(t, reopened.Approval)
+	assert.Equal(t, []uuid.UUID{submitted.Approval.ID}, f.periods.reopened)
+	require.Len(t, f.publisher.publishedEvents, 1)
+	assert.Equal(t, "ledger.period.reopened", f.publisher.publishedEvents[0].EventType())
+}
+
+func TestReopenPeriod_ApprovalReopensOnce(t *testing.T) {
+	f := newReopenFixture()
+	maker := f.caller()
+	submitted, err := f.uc.Execute(maker, f.request(uuid.Nil))
+	require.NoError(t, err)
+	_, err = f.approvals.Approve(f.caller(), submitted.Approval.ID, "")
+	require.NoError(t, err)
+	_, err = f.uc.Execute(maker, f.request(submitted.Approval.ID))
+	require.NoError(t, err)
+
+	f.periods.isClosed = true
+	_, err = f.uc.Execute(maker, f.request(submitted.Approval.ID))
+
+	assert.ErrorIs(t, err, port.ErrApprovalUsed)
+}
+
+func TestReopenPeriod_RejectsApprovalForAnotherPeriod(t *testing.T) {
+	f := newReopenFixture()
+	maker := f.caller()
+	submitted, err := f.uc.Execute(maker, f.request(uuid.Nil))
+	require.NoError(t, err)
+	_, err = f.approvals.Approve(f.caller(), submitted.Approval.ID, "")
+	require.NoError(t, err)
+
+	req := f.request(submitted.Approval.ID)
+	req.Month = 8
+	_, err = f.uc.Execute(maker, req)
+
+	assert.ErrorIs(t, err, usecase.ErrApprovalMismatch)
+}
+
+func TestReopenPeriod_RequiresReason(t *testing.T) {
+	f := newReopenFixture()
+	req := f.request(uuid.Nil)
+	req.Reason = ""
+
+	_, err := f.uc.Execute(f.caller(), req)
+
+	assert.ErrorIs(t, err, usecase.ErrReasonRequired)
+}
+
+func TestReopenPeriod_OpenPeriod(t *testing.T) {
+	f := newReopenFixture()
+	f.periods.isClosed = false
+
+	_, err := f.uc.Execute(f.caller(), f.request(uuid.Nil))
+
+	assert.ErrorIs(t, err, usecase.ErrPeriodOpen)
+}
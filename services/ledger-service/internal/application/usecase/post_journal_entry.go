@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -14,7 +15,13 @@ import (
 
 const TopicLedgerEntries = "bib.ledger.entries"
 
-// PostJournalEntry handles the creation and posting of journal entries.
+// ErrInvalidEntry is returned by PostJournalEntry for postings or legs that
+// do not form a valid entry, such as legs that do not balance.
+var ErrInvalidEntry = errors.New("invalid journal entry")
+
+// PostJournalEntry handles the creation and posting of journal entries. A
+// tenant's entries have unique references: posting under a reference
+// already posted returns the entry first posted under it, posting nothing.
 type PostJournalEntry struct {
 	journalRepo port.JournalRepository
 	balanceRepo port.BalanceRepository
@@ -42,29 +49,46 @@ func (uc *PostJournalEntry) Execute(ctx context.Context, req dto.PostJournalEntr
 	for _, p := range req.Postings {
 		debit, err := valueobject.NewAccountCode(p.DebitAccount)
 		if err != nil {
-			return dto.JournalEntryResponse{}, fmt.Errorf("invalid debit account: %w", err)
+			return dto.JournalEntryResponse{}, fmt.Errorf("%w: invalid debit account: %w", ErrInvalidEntry, err)
 		}
 		credit, err := valueobject.NewAccountCode(p.CreditAccount)
 		if err != nil {
-			return dto.JournalEntryResponse{}, fmt.Errorf("invalid credit account: %w", err)
+			return dto.JournalEntryResponse{}, fmt.Errorf("%w: invalid credit account: %w", ErrInvalidEntry, err)
 		}
 		pair, err := valueobject.NewPostingPair(debit, credit, p.Amount, p.Currency, p.Description)
 		if err != nil {
-			return dto.JournalEntryResponse{}, fmt.Errorf("invalid posting pair: %w", err)
+			return dto.JournalEntryResponse{}, fmt.Errorf("%w: invalid posting pair: %w", ErrInvalidEntry, err)
 		}
 		postings = append(postings, pair)
 	}
+	legs := valueobject.LegsOf(postings)
+	for _, l := range req.Legs {
+		account, err := valueobject.NewAccountCode(l.AccountCode)
+		if err != nil {
+			return dto.JournalEntryResponse{}, fmt.Errorf("%w: invalid leg account: %w", ErrInvalidEntry, err)
+		}
+		side, err := valueobject.ParsePostingSide(l.Side)
+		if err != nil {
+			return dto.JournalEntryResponse{}, fmt.Errorf("%w: invalid posting leg: %w", ErrInvalidEntry, err)
+		}
+		leg, err := valueobject.NewPostingLeg(account, side, l.Amount, l.Currency, l.Description)
+		if err != nil {
+			return dto.JournalEntryResponse{}, fmt.Errorf("%w: invalid posting leg: %w", ErrInvalidEntry, err)
+		}
+		legs = append(legs, leg)
+	}
 
-	// Validate postings
-	if err := uc.validator.ValidatePostings(postings); err != nil {
-		return dto.JournalEntryResponse{}, fmt.Errorf("posting validation failed: %w", err)
+	// Validate legs
+	if err := uc.validator.ValidateLegs(legs); err != nil {
+		return dto.JournalEntryResponse{}, fmt.Errorf("%w: posting validation failed: %w", ErrInvalidEntry, err)
 	}
 
 	// Create journal entry
-	entry, err := model.NewJournalEntry(req.TenantID, req.EffectiveDate, postings, req.Description, req.Reference)
+	entry, err := model.NewJournalEntryFromLegs(req.TenantID, req.EffectiveDate, legs, req.Description, req.Reference)
 	if err != nil {
 		return dto.JournalEntryResponse{}, fmt.Errorf("failed to create journal entry: %w", err)
 	}
+	entry = entry.WithActor(req.ActorID)
 
 	// Post the entry
 	now := time.Now().UTC()
@@ -74,18 +98,29 @@ func (uc *PostJournalEntry) Execute(ctx context.Context, req dto.PostJournalEntr
 	}
 
 	// Persist
-	if err := uc.journalRepo.Save(ctx, posted); err != nil {
+	err = uc.journalRepo.Save(ctx, posted)
+	if errors.Is(err, port.ErrDuplicateReference) {
+		existing, findErr := uc.journalRepo.FindByReference(ctx, req.TenantID, req.Reference)
+		if findErr != nil {
+			return dto.JournalEntryResponse{}, fmt.Errorf("failed to find entry posted under %s: %w", req.Reference, findErr)
+		}
+		return toJournalEntryResponse(existing), nil
+	}
+	if err != nil {
 		return dto.JournalEntryResponse{}, fmt.Errorf("failed to save entry: %w", err)
 	}
 
-	// Update balances for each posting
-	for _, p := range posted.Postings() {
-		// Debit increases debit-normal accounts
-		if err := uc.balanceRepo.UpdateBalance(ctx, p.DebitAccount(), p.Currency(), p.Amount()); err != nil {
-			return dto.JournalEntryResponse{}, fmt.Errorf("failed to update debit balance: %w", err)
+	// Update balances for each leg
+	for _, l := range posted.Legs() {
+		if l.IsDebit() {
+			// Debit increases debit-normal accounts
+			if err := uc.balanceRepo.UpdateBalance(ctx, l.Account(), l.Currency(), l.Amount()); err != nil {
+				return dto.JournalEntryResponse{}, fmt.Errorf("failed to update debit balance: %w", err)
+			}
+			continue
 		}
 		// Credit decreases (negative delta) debit-normal accounts
-		if err := uc.balanceRepo.UpdateBalance(ctx, p.CreditAccount(), p.Currency(), p.Amount().Neg()); err != nil {
+		if err := uc.balanceRepo.UpdateBalance(ctx, l.Account(), l.Currency(), l.Amount().Neg()); err != nil {
 			return dto.JournalEntryResponse{}, fmt.Errorf("failed to update credit balance: %w", err)
 		}
 	}
@@ -111,11 +146,22 @@ func toJournalEntryResponse(entry model.JournalEntry) dto.JournalEntryResponse {
 			Description:   p.Description(),
 		})
 	}
+	legs := make([]dto.PostingLegDTO, 0, len(entry.Legs()))
+	for _, l := range entry.Legs() {
+		legs = append(legs, dto.PostingLegDTO{
+			AccountCode: l.Account().Code(),
+			Side:        string(l.Side()),
+			Amount:      l.Amount(),
+			Currency:    l.Currency(),
+			Description: l.Description(),
+		})
+	}
 	return dto.JournalEntryResponse{
 		ID:            entry.ID(),
 		TenantID:      entry.TenantID(),
 		EffectiveDate: entry.EffectiveDate(),
 		Postings:      postings,
+		Legs:          legs,
 		Status:        string(entry.Status()),
 		Description:   entry.Description(),
 		Reference:     entry.Reference(),
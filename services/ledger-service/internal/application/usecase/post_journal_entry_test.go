@@ -15,6 +15,7 @@ import (
 	"github.com/bibbank/bib/services/ledger-service/internal/application/dto"
 	"github.com/bibbank/bib/services/ledger-service/internal/application/usecase"
 	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/port"
 	"github.com/bibbank/bib/services/ledger-service/internal/domain/service"
 	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
 )
@@ -23,9 +24,10 @@ import (
 
 // mockJournalRepository implements port.JournalRepository for testing.
 type mockJournalRepository struct {
-	findByIDFunc func(ctx context.Context, id uuid.UUID) (model.JournalEntry, error)
-	saveFunc     func(ctx context.Context, entry model.JournalEntry) error
-	savedEntries []model.JournalEntry
+	findByIDFunc        func(ctx context.Context, id uuid.UUID) (model.JournalEntry, error)
+	findByReferenceFunc func(ctx context.Context, tenantID uuid.UUID, reference string) (model.JournalEntry, error)
+	saveFunc            func(ctx context.Context, entry model.JournalEntry) error
+	savedEntries        []model.JournalEntry
 }
 
 func (m *mockJournalRepository) Save(ctx context.Context, entry model.JournalEntry) error {
@@ -43,6 +45,13 @@ func (m *mockJournalRepository) FindByID(ctx context.Context, id uuid.UUID) (mod
 	return model.JournalEntry{}, fmt.Errorf("entry not found: %s", id)
 }
 
+func (m *mockJournalRepository) FindByReference(ctx context.Context, tenantID uuid.UUID, reference string) (model.JournalEntry, error) {
+	if m.findByReferenceFunc != nil {
+		return m.findByReferenceFunc(ctx, tenantID, reference)
+	}
+	return model.JournalEntry{}, fmt.Errorf("entry not found: %s", reference)
+}
+
 func (m *mockJournalRepository) ListByAccount(_ context.Context, _ uuid.UUID, _ valueobject.AccountCode, _, _ time.Time, _, _ int) ([]model.JournalEntry, int, error) {
 	return nil, 0, nil
 }
@@ -55,6 +64,7 @@ func (m *mockJournalRepository) ListByTenant(_ context.Context, _ uuid.UUID, _,
 type mockBalanceRepository struct {
 	updateFunc     func(ctx context.Context, account valueobject.AccountCode, currency string, delta decimal.Decimal) error
 	getBalanceFunc func(ctx context.Context, account valueobject.AccountCode, currency string, asOf time.Time) (decimal.Decimal, error)
+	openFunc       func(ctx context.Context, account valueobject.AccountCode, currency string) (decimal.Decimal, error)
 	updates        []balanceUpdate
 }
 
@@ -79,6 +89,13 @@ func (m *mockBalanceRepository) GetBalance(ctx context.Context, account valueobj
 	return decimal.Zero, nil
 }
 
+func (m *mockBalanceRepository) OpenAccount(ctx context.Context, account valueobject.AccountCode, currency string) (decimal.Decimal, error) {
+	if m.openFunc != nil {
+		return m.openFunc(ctx, account, currency)
+	}
+	return decimal.Zero, nil
+}
+
 // mockEventPublisher implements port.EventPublisher for testing.
 type mockEventPublisher struct {
 	publishFunc     func(ctx context.Context, topic string, events ...events.DomainEvent) error
@@ -212,6 +229,37 @@ func TestPostJournalEntry_RepoSaveError(t *testing.T) {
 	assert.Empty(t, publisher.publishedEvents)
 }
 
+func TestPostJournalEntry_DuplicateReference(t *testing.T) {
+	req := validPostRequest()
+	pair, err := valueobject.NewPostingPair(valueobject.MustAccountCode("1000"), valueobject.MustAccountCode("2000"), decimal.NewFromInt(500), "USD", "")
+	require.NoError(t, err)
+	first, err := model.NewJournalEntry(req.TenantID, req.EffectiveDate, []valueobject.PostingPair{pair}, req.Description, req.Reference)
+	require.NoError(t, err)
+
+	journalRepo := &mockJournalRepository{
+		saveFunc: func(_ context.Context, _ model.JournalEntry) error {
+			return fmt.Errorf("%w: %s", port.ErrDuplicateReference, req.Reference)
+		},
+		findByReferenceFunc: func(_ context.Context, tenantID uuid.UUID, reference string) (model.JournalEntry, error) {
+			assert.Equal(t, req.TenantID, tenantID)
+			assert.Equal(t, req.Reference, reference)
+			return first, nil
+		},
+	}
+	balanceRepo := &mockBalanceRepository{}
+	publisher := &mockEventPublisher{}
+
+	uc := usecase.NewPostJournalEntry(journalRepo, balanceRepo, publisher, service.NewPostingValidator())
+	resp, err := uc.Execute(context.Background(), req)
+
+	// A posting under a reference already posted returns the first entry
+	// and posts nothing.
+	require.NoError(t, err)
+	assert.Equal(t, first.ID(), resp.ID)
+	assert.Empty(t, balanceRepo.updates)
+	assert.Empty(t, publisher.publishedEvents)
+}
+
 func TestPostJournalEntry_BalanceUpdateError(t *testing.T) {
 	journalRepo := &mockJournalRepository{}
 	balanceRepo := &mockBalanceRepository{
@@ -292,3 +340,79 @@ func TestPostJournalEntry_MultiplePostings(t *testing.T) {
 	// 2 postings x 2 balance updates each = 4 total
 	require.Len(t, balanceRepo.updates, 4)
 }
+
+func TestPostJournalEntry_MultiLeg(t *testing.T) {
+	journalRepo := &mockJournalRepository{}
+	balanceRepo := &mockBalanceRepository{}
+	publisher := &mockEventPublisher{}
+	uc := usecase.NewPostJournalEntry(journalRepo, balanceRepo, publisher, service.NewPostingValidator())
+
+	req := dto.PostJournalEntryRequest{
+		TenantID:      uuid.New(),
+		EffectiveDate: time.Now().UTC(),
+		Legs: []dto.PostingLegDTO{
+			{AccountCode: "1000", Side: "DEBIT", Amount: decimal.NewFromInt(100), Currency: "USD"},
+			{AccountCode: "2000", Side: "CREDIT", Amount: decimal.NewFromInt(97), Currency: "USD"},
+			{AccountCode: "4100", Side: "CREDIT", Amount: decimal.NewFromInt(3), Currency: "USD"},
+		},
+		Description: "Card payment with fee",
+	}
+
+	resp, err := uc.Execute(context.Background(), req)
+	require.NoError(t, err)
+
+	require.Len(t, resp.Legs, 3)
+	assert.Equal(t, "4100", resp.Legs[2].AccountCode)
+	assert.Equal(t, "CREDIT", resp.Legs[2].Side)
+	require.Len(t, resp.Postings, 2)
+	assert.Equal(t, "2000", resp.Postings[0].CreditAccount)
+	assert.True(t, decimal.NewFromInt(97).Equal(resp.Postings[0].Amount))
+	assert.Equal(t, "4100", resp.Postings[1].CreditAccount)
+	assert.True(t, decimal.NewFromInt(3).Equal(resp.Postings[1].Amount))
+
+	// One balance update per leg
+	require.Len(t, balanceRepo.updates, 3)
+	assert.True(t, decimal.NewFromInt(100).Equal(balanceRepo.updates[0].Delta))
+	assert.True(t, decimal.NewFromInt(-97).Equal(balanceRepo.updates[1].Delta))
+	assert.True(t, decimal.NewFromInt(-3).Equal(balanceRepo.updates[2].Delta))
+}
+
+func TestPostJournalEntry_UnbalancedLegs(t *testing.T) {
+	journalRepo := &mockJournalRepository{}
+	uc := usecase.NewPostJournalEntry(journalRepo, &mockBalanceRepository{}, &mockEventPublisher{}, service.NewPostingValidator())
+
+	req := dto.PostJournalEntryRequest{
+		TenantID:      uuid.New(),
+		EffectiveDate: time.Now().UTC(),
+		Legs: []dto.PostingLegDTO{
+			{AccountCode: "1000", Side: "DEBIT", Amount: decimal.NewFromInt(100), Currency: "USD"},
+			{AccountCode: "2000", Side: "CREDIT", Amount: decimal.NewFromInt(90), Currency: "USD"},
+		},
+	}
+
+	_, err := uc.Execute(context.Background(), req)
+	require.ErrorIs(t, err, usecase.ErrInvalidEntry)
+	assert.Contains(t, err.Error(), "does not balance in USD")
+	assert.Empty(t, journalRepo.savedEntries)
+}
+
+func TestPostJournalEntry_RecordsActorForAudit(t *testing.T) {
+	journalRepo := &mockJournalRepository{}
+	uc := usecase.NewPostJournalEntry(journalRepo, &mockBalanceRepository{}, &mockEventPublisher{}, service.NewPostingValidator())
+
+	actorID := uuid.New()
+	req := validPostRequest()
+	req.ActorID = &actorID
+	_, err := uc.Execute(context.Background(), req)
+	require.NoError(t, err)
+
+	require.Len(t, journalRepo.savedEntries, 1)
+	records := journalRepo.savedEntries[0].PendingAudit()
+	require.Len(t, records, 2)
+	assert.Equal(t, model.AuditActionCreated, records[0].Action)
+	assert.Equal(t, model.AuditActionPosted, records[1].Action)
+	for _, r := range records {
+		require.NotNil(t, r.ActorID)
+		assert.Equal(t, actorID, *r.ActorID)
+	}
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/ledger-service/internal/application/dto"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/port"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/service"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+// Topics interest accruals are recognized from.
+const (
+	TopicDepositInterest = "bib.deposit.interest"
+	TopicLendingEvents   = "lending-events"
+)
+
+// InterestTopics lists the topics interest accruals are recognized from.
+var InterestTopics = []string{
+	TopicDepositInterest,
+	TopicLendingEvents,
+}
+
+// accrualEvent holds the fields of the deposit and loan interest accrual
+// events. Each sets the ID of its own instrument.
+type accrualEvent struct {
+	AsOf       time.Time `json:"as_of"`
+	EventID    string    `json:"event_id"`
+	TenantID   string    `json:"tenant_id"`
+	Amount     string    `json:"amount"`
+	Currency   string    `json:"currency"`
+	PositionID uuid.UUID `json:"position_id"`
+	LoanID     uuid.UUID `json:"loan_id"`
+}
+
+// RecognizeInterest posts the interest deposits and loans accrue, so the
+// income statement carries interest as it is earned and incurred rather than
+// when it is paid. Each accrual is posted once, rounded to the posting
+// precision; at period end the true-up posts what the roundings left, so
+// the period carries the exact interest accrued.
+type RecognizeInterest struct {
+	accruals  port.InterestAccrualRepository
+	postEntry *PostJournalEntry
+	accounts  service.InterestAccounts
+}
+
+func NewRecognizeInterest(
+	accruals port.InterestAccrualRepository,
+	postEntry *PostJournalEntry,
+	accounts service.InterestAccounts,
+) *RecognizeInterest {
+	return &RecognizeInterest{
+		accruals:  accruals,
+		postEntry: postEntry,
+		accounts:  accounts,
+	}
+}
+
+// Execute recognizes an accrual event of the given type. The payload is a
+// CloudEvents envelope or the bare event. Redelivered events are recognized
+// once, and other events are ignored.
+func (uc *RecognizeInterest) Execute(ctx context.Context, eventType string, payload []byte) error {
+	var kind model.InterestKind
+	switch eventType {
+	case "deposit.interest.accrued":
+		kind = model.InterestKindDepositExpense
+	case "lending.loan.interest_accrued":
+		kind = model.InterestKindLoanIncome
+	default:
+		return nil
+	}
+
+	data, err := events.EventData(payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	var evt accrualEvent
+	if err := json.Unmarshal(data, &evt); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	tenantID, err := uuid.Parse(evt.TenantID)
+	if err != nil {
+		return fmt.Errorf("%s event has invalid tenant ID %q: %w", eventType, evt.TenantID, err)
+	}
+	eventID, err := uuid.Parse(evt.EventID)
+	if err != nil {
+		return fmt.Errorf("%s event has invalid event ID %q: %w", eventType, evt.EventID, err)
+	}
+	amount, err := decimal.NewFromString(evt.Amount)
+	if err != nil {
+		return fmt.Errorf("%s event has invalid amount %q: %w", eventType, evt.Amount, err)
+	}
+	instrumentID := evt.PositionID
+	if kind == model.InterestKindLoanIncome {
+		instrumentID = evt.LoanID
+	}
+
+	recorded, err := uc.accruals.Exists(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to check accrual %s: %w", eventID, err)
+	}
+	if recorded {
+		return nil
+	}
+	accrual, err := model.NewInterestAccrual(tenantID, eventID, instrumentID, kind, amount, evt.Currency, evt.AsOf, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("invalid %s event %s: %w", eventType, eventID, err)
+	}
+	description := fmt.Sprintf("Interest accrual %s %s", instrumentID, accrual.AccruedOn().Format(time.DateOnly))
+	return uc.record(ctx, accrual, description)
+}
+
+// TrueUp posts, for each kind and currency, the difference between the
+// interest accrued in the period and the amount posted for it. It returns
+// the number of adjustments posted; a period already trued up, or whose
+// accruals posted exactly, needs none.
+func (uc *RecognizeInterest) TrueUp(ctx context.Context, tenantID uuid.UUID, period valueobject.FiscalPeriod) (int, error) {
+	totals, err := uc.accruals.PeriodTotals(ctx, tenantID, period)
+	if err != nil {
+		return 0, fmt.Errorf("failed to total interest accruals: %w", err)
+	}
+	posted := 0
+	for _, total := range totals {
+		if total.Adjustment().IsZero() {
+			continue
+		}
+		trueUp := model.NewInterestTrueUp(tenantID, period, total, time.Now().UTC())
+		recorded, err := uc.accruals.Exists(ctx, trueUp.SourceID())
+		if err != nil {
+			return posted, fmt.Errorf("failed to check true-up %s: %w", trueUp.SourceID(), err)
+		}
+		if recorded {
+			continue
+		}
+		description := fmt.Sprintf("Interest true-up %s %s %s", period, total.Kind, total.Currency)
+		if err := uc.record(ctx, trueUp, description); err != nil {
+			return posted, err
+		}
+		posted++
+	}
+	return posted, nil
+}
+
+// record posts the accrual, when it posts anything, and saves it.
+func (uc *RecognizeInterest) record(ctx context.Context, accrual model.InterestAccrual, description string) error {
+	if !accrual.Posted().IsZero() {
+		pair, err := uc.accounts.InterestPosting(accrual.Kind(), accrual.Posted(), accrual.Currency(), description)
+		if err != nil {
+			return fmt.Errorf("failed to build interest posting: %w", err)
+		}
+		entry, err := uc.postEntry.Execute(ctx, dto.PostJournalEntryRequest{
+			TenantID:      accrual.TenantID(),
+			EffectiveDate: accrual.AccruedOn(),
+			Postings: []dto.PostingPairDTO{{
+				DebitAccount:  pair.DebitAccount().Code(),
+				CreditAccount: pair.CreditAccount().Code(),
+				Amount:        pair.Amount(),
+				Currency:      pair.Currency(),
+				Description:   pair.Description(),
+			}},
+			Description: description,
+			Reference:   "interest:" + accrual.SourceID().String(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to post interest for %s: %w", accrual.SourceID(), err)
+		}
+		accrual = accrual.WithEntry(entry.ID)
+	}
+	if err := uc.accruals.Save(ctx, accrual); err != nil {
+		return fmt.Errorf("failed to save interest accrual %s: %w", accrual.SourceID(), err)
+	}
+	return nil
+}
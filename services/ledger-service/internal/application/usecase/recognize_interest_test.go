@@ -0,0 +1,285 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/application/dto"
+	"github.com/bibbank/bib/services/ledger-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/port"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/service"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+// mockInterestAccrualRepository implements port.InterestAccrualRepository
+// for testing, totalling accruals the way the database does.
+type mockInterestAccrualRepository struct {
+	accruals map[uuid.UUID]model.InterestAccrual
+}
+
+func (m *mockInterestAccrualRepository) Exists(_ context.Context, sourceID uuid.UUID) (bool, error) {
+	_, ok := m.accruals[sourceID]
+	return ok, nil
+}
+
+func (m *mockInterestAccrualRepository) Save(_ context.Context, accrual model.InterestAccrual) error {
+	if m.accruals == nil {
+		m.accruals = make(map[uuid.UUID]model.InterestAccrual)
+	}
+	m.accruals[accrual.SourceID()] = accrual
+	return nil
+}
+
+func (m *mockInterestAccrualRepository) PeriodTotals(_ context.Context, tenantID uuid.UUID, period valueobject.FiscalPeriod) ([]model.InterestAccrualTotal, error) {
+	type key struct {
+		kind     model.InterestKind
+		currency string
+	}
+	sums := make(map[key]*model.InterestAccrualTotal)
+	var order []key
+	for _, a := range m.accruals {
+		if a.TenantID() != tenantID || !period.Contains(a.AccruedOn()) {
+			continue
+		}
+		k := key{a.Kind(), a.Currency()}
+		if sums[k] == nil {
+			sums[k] = &model.InterestAccrualTotal{Kind: a.Kind(), Currency: a.Currency()}
+			order = append(order, k)
+		}
+		sums[k].Accrued = sums[k].Accrued.Add(a.Amount())
+		sums[k].Posted = sums[k].Posted.Add(a.Posted())
+	}
+	totals := make([]model.InterestAccrualTotal, 0, len(order))
+	for _, k := range order {
+		totals = append(totals, *sums[k])
+	}
+	return totals, nil
+}
+
+// mockFiscalPeriodRepository implements port.FiscalPeriodRepository for
+// testing.
+type mockFiscalPeriodRepository struct {
+	closed   []valueobject.FiscalPeriod
+	frozen   [][]model.PeriodBalance
+	reopened []uuid.UUID
+	isClosed bool
+}
+
+func (m *mockFiscalPeriodRepository) GetPeriodStatus(_ context.Context, _ uuid.UUID, _ valueobject.FiscalPeriod) (valueobject.PeriodStatus, error) {
+	if m.isClosed {
+		return valueobject.PeriodStatusClosed, nil
+	}
+	return valueobject.PeriodStatusOpen, nil
+}
+
+func (m *mockFiscalPeriodRepository) ClosePeriod(_ context.Context, _ uuid.UUID, period valueobject.FiscalPeriod, balances []model.PeriodBalance) error {
+	m.closed = append(m.closed, period)
+	m.frozen = append(m.frozen, balances)
+	m.isClosed = true
+	return nil
+}
+
+func (m *mockFiscalPeriodRepository) ReopenPeriod(_ context.Context, _ uuid.UUID, _ valueobject.FiscalPeriod, approvalID uuid.UUID, _ string) error {
+	if slices.Contains(m.reopened, approvalID) {
+		return port.ErrApprovalUsed
+	}
+	if !m.isClosed {
+		return port.ErrPeriodNotClosed
+	}
+	m.reopened = append(m.reopened, approvalID)
+	m.isClosed = false
+	return nil
+}
+
+type interestFixture struct {
+	journal  *mockJournalRepository
+	accruals *mockInterestAccrualRepository
+	uc       *usecase.RecognizeInterest
+}
+
+func newInterestFixture(t *testing.T) interestFixture {
+	t.Helper()
+	accounts, err := service.NewInterestAccounts("5100-001", "2010-001", "1210-001", "4000-001")
+	require.NoError(t, err)
+	f := interestFixture{
+		journal:  &mockJournalRepository{},
+		accruals: &mockInterestAccrualRepository{},
+	}
+	postEntry := usecase.NewPostJournalEntry(f.journal, &mockBalanceRepository{}, &mockEventPublisher{}, service.NewPostingValidator())
+	f.uc = usecase.NewRecognizeInterest(f.accruals, postEntry, accounts)
+	return f
+}
+
+func accrualPayload(t *testing.T, tenantID uuid.UUID, instrumentField, amount string, asOf time.Time) (uuid.UUID, []byte) {
+	t.Helper()
+	eventID := uuid.New()
+	payload, err := json.Marshal(map[string]any{
+		"event_id":      eventID.String(),
+		"tenant_id":     tenantID.String(),
+		instrumentField: uuid.New().String(),
+		"amount":        amount,
+		"currency":      "EUR",
+		"as_of":         asOf,
+	})
+	require.NoError(t, err)
+	return eventID, payload
+}
+
+func TestRecognizeInterest_PostsDepositAccrualAsExpense(t *testing.T) {
+	f := newInterestFixture(t)
+	tenantID := uuid.New()
+	asOf := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	_, payload := accrualPayload(t, tenantID, "position_id", "12.3456", asOf)
+
+	require.NoError(t, f.uc.Execute(context.Background(), "deposit.interest.accrued", payload))
+
+	require.Len(t, f.journal.savedEntries, 1)
+	entry := f.journal.savedEntries[0]
+	assert.Equal(t, tenantID, entry.TenantID())
+	assert.Equal(t, asOf, entry.EffectiveDate())
+	require.Len(t, entry.Postings(), 1)
+	p := entry.Postings()[0]
+	assert.Equal(t, "5100-001", p.DebitAccount().Code())
+	assert.Equal(t, "2010-001", p.CreditAccount().Code())
+	assert.True(t, p.Amount().Equal(decimal.RequireFromString("12.35")), "posted %s", p.Amount())
+}
+
+func TestRecognizeInterest_PostsLoanAccrualAsIncome(t *testing.T) {
+	f := newInterestFixture(t)
+	_, payload := accrualPayload(t, uuid.New(), "loan_id", "8.50", time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+
+	require.NoError(t, f.uc.Execute(context.Background(), "lending.loan.interest_accrued", payload))
+
+	require.Len(t, f.journal.savedEntries, 1)
+	p := f.journal.savedEntries[0].Postings()[0]
+	assert.Equal(t, "1210-001", p.DebitAccount().Code())
+	assert.Equal(t, "4000-001", p.CreditAccount().Code())
+}
+
+func TestRecognizeInterest_RedeliveredEventPostsOnce(t *testing.T) {
+	f := newInterestFixture(t)
+	_, payload := accrualPayload(t, uuid.New(), "position_id", "1.00", time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+
+	require.NoError(t, f.uc.Execute(context.Background(), "deposit.interest.accrued", payload))
+	require.NoError(t, f.uc.Execute(context.Background(), "deposit.interest.accrued", payload))
+
+	assert.Len(t, f.journal.savedEntries, 1)
+	assert.Len(t, f.accruals.accruals, 1)
+}
+
+func TestRecognizeInterest_IgnoresOtherEvents(t *testing.T) {
+	f := newInterestFixture(t)
+
+	require.NoError(t, f.uc.Execute(context.Background(), "lending.loan.disbursed", []byte(`{}`)))
+
+	assert.Empty(t, f.journal.savedEntries)
+}
+
+func TestRecognizeInterest_RoundedAwayAccrualIsRecordedWithoutPosting(t *testing.T) {
+	f := newInterestFixture(t)
+	eventID, payload := accrualPayload(t, uuid.New(), "position_id", "0.0040", time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+
+	require.NoError(t, f.uc.Execute(context.Background(), "deposit.interest.accrued", payload))
+
+	assert.Empty(t, f.journal.savedEntries)
+	require.Contains(t, f.accruals.accruals, eventID)
+	assert.Equal(t, uuid.Nil, f.accruals.accruals[eventID].EntryID())
+}
+
+func TestRecognizeInterest_TrueUpPostsRoundingDifferenceOnce(t *testing.T) {
+	f := newInterestFixture(t)
+	tenantID := uuid.New()
+	// Three days accruing 1.0040 each post 1.00 a day: the period accrued
+	// 3.012, so the true-up posts the missing 0.01.
+	for day := 1; day <= 3; day++ {
+		_, payload := accrualPayload(t, tenantID, "position_id", "1.0040", time.Date(2026, 3, day, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, f.uc.Execute(context.Background(), "deposit.interest.accrued", payload))
+	}
+	period, err := valueobject.NewFiscalPeriod(2026, time.March)
+	require.NoError(t, err)
+
+	n, err := f.uc.TrueUp(context.Background(), tenantID, period)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	require.Len(t, f.journal.savedEntries, 4)
+	trueUp := f.journal.savedEntries[3]
+	assert.Equal(t, period.EndDate(), trueUp.EffectiveDate())
+	p := trueUp.Postings()[0]
+	assert.Equal(t, "5100-001", p.DebitAccount().Code())
+	assert.True(t, p.Amount().Equal(decimal.RequireFromString("0.01")), "true-up %s", p.Amount())
+
+	n, err = f.uc.TrueUp(context.Background(), tenantID, period)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Len(t, f.journal.savedEntries, 4)
+}
+
+func TestRecognizeInterest_TrueUpReversesOverstatedInterest(t *testing.T) {
+	f := newInterestFixture(t)
+	tenantID := uuid.New()
+	// Two days accruing 0.005 each post 0.01 a day: the period accrued
+	// 0.01, so the true-up takes back 0.01.
+	for day := 1; day <= 2; day++ {
+		_, payload := accrualPayload(t, tenantID, "loan_id", "0.0050", time.Date(2026, 3, day, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, f.uc.Execute(context.Background(), "lending.loan.interest_accrued", payload))
+	}
+	period, err := valueobject.NewFiscalPeriod(2026, time.March)
+	require.NoError(t, err)
+
+	n, err := f.uc.TrueUp(context.Background(), tenantID, period)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	p := f.journal.savedEntries[2].Postings()[0]
+	assert.Equal(t, "4000-001", p.DebitAccount().Code())
+	assert.Equal(t, "1210-001", p.CreditAccount().Code())
+	assert.True(t, p.Amount().Equal(decimal.RequireFromString("0.01")), "true-up %s", p.Amount())
+}
+
+func TestPeriodClose_TruesUpInterestBeforeClosing(t *testing.T) {
+	f := newInterestFixture(t)
+	tenantID := uuid.New()
+	_, payload := accrualPayload(t, tenantID, "position_id", "0.0040", time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, f.uc.Execute(context.Background(), "deposit.interest.accrued", payload))
+	_, payload = accrualPayload(t, tenantID, "position_id", "0.0040", time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, f.uc.Execute(context.Background(), "deposit.interest.accrued", payload))
+	periods := &mockFiscalPeriodRepository{}
+
+	err := usecase.NewPeriodClose(periods, &mockBalanceSnapshotRepository{}, &mockEventPublisher{}, f.uc).Execute(context.Background(), dto.PeriodCloseRequest{
+		TenantID: tenantID, Year: 2026, Month: 3,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, periods.closed, 1)
+	require.Len(t, f.journal.savedEntries, 1)
+	assert.True(t, f.journal.savedEntries[0].Postings()[0].Amount().Equal(decimal.RequireFromString("0.01")))
+}
+
+func TestGetPeriodStatus_ReportsInterestTheCloseWillPost(t *testing.T) {
+	f := newInterestFixture(t)
+	tenantID := uuid.New()
+	for day := 1; day <= 3; day++ {
+		_, payload := accrualPayload(t, tenantID, "position_id", "1.0040", time.Date(2026, 3, day, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, f.uc.Execute(context.Background(), "deposit.interest.accrued", payload))
+	}
+	uc := usecase.NewGetPeriodStatus(&mockFiscalPeriodRepository{}, f.accruals)
+
+	status, err := uc.Execute(context.Background(), dto.PeriodStatusRequest{TenantID: tenantID, Year: 2026, Month: 3})
+	require.NoError(t, err)
+	assert.Equal(t, "2026-03", status.Period)
+	assert.Equal(t, "OPEN", status.Status)
+	require.Len(t, status.UnpostedInterest, 1)
+	assert.Equal(t, "DEPOSIT_EXPENSE", status.UnpostedInterest[0].Kind)
+	assert.True(t, status.UnpostedInterest[0].Amount.Equal(decimal.RequireFromString("0.01")))
+
+	_, err = uc.Execute(context.Background(), dto.PeriodStatusRequest{TenantID: tenantID, Year: 2026, Month: 13})
+	assert.ErrorIs(t, err, usecase.ErrInvalidPeriod)
+}
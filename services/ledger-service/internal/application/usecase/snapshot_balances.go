@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/port"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+// snapshotSettle is how long after a period ends it is snapshotted, so
+// entries being posted into it as it ended have committed.
+const snapshotSettle = time.Hour
+
+// SnapshotBalances takes the balance snapshots of ended fiscal periods that
+// are missing, or that entries backdated into them made stale.
+type SnapshotBalances struct {
+	snapshots port.BalanceSnapshotRepository
+}
+
+// NewSnapshotBalances creates a SnapshotBalances.
+func NewSnapshotBalances(snapshots port.BalanceSnapshotRepository) *SnapshotBalances {
+	return &SnapshotBalances{snapshots: snapshots}
+}
+
+// Execute snapshots each tenant's periods that ended by now, in order from
+// the first stale one, and returns how many it took. A tenant that fails is
+// reported and the rest are still snapshotted.
+func (uc *SnapshotBalances) Execute(ctx context.Context, now time.Time) (int, error) {
+	through := valueobject.FiscalPeriodFromTime(now.Add(-snapshotSettle).UTC())
+	cursors, err := uc.snapshots.StaleSnapshots(ctx, through)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list stale snapshots: %w", err)
+	}
+
+	var (
+		taken int
+		errs  []error
+	)
+	for _, cursor := range cursors {
+		n, err := uc.snapshotTenant(ctx, cursor.TenantID, cursor.StaleFrom, through)
+		taken += n
+		if err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", cursor.TenantID, err))
+			continue
+		}
+		// An entry backdated meanwhile leaves the cursor for the next run.
+		if _, err := uc.snapshots.AdvanceSnapshots(ctx, cursor, through); err != nil {
+			errs = append(errs, fmt.Errorf("tenant %s: %w", cursor.TenantID, err))
+		}
+	}
+	return taken, errors.Join(errs...)
+}
+
+func (uc *SnapshotBalances) snapshotTenant(ctx context.Context, tenantID uuid.UUID, from, through valueobject.FiscalPeriod) (int, error) {
+	taken := 0
+	for p := from; p.StartDate().Before(through.StartDate()); p = p.Next() {
+		if err := uc.snapshots.SnapshotPeriod(ctx, tenantID, p); err != nil {
+			return taken, fmt.Errorf("failed to snapshot %s: %w", p, err)
+		}
+		taken++
+	}
+	return taken, nil
+}
@@ -0,0 +1,156 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+// mockBalanceSnapshotRepository implements port.BalanceSnapshotRepository
+// for testing.
+type mockBalanceSnapshotRepository struct {
+	cursors     []model.SnapshotCursor
+	snapshotErr map[uuid.UUID]error
+	backdated   map[uuid.UUID]bool
+	taken       map[uuid.UUID][]string
+	advanced    map[uuid.UUID]valueobject.FiscalPeriod
+	balances    []model.PeriodBalance
+
+	balanceBeforeFunc func(ctx context.Context, tenantID uuid.UUID, account valueobject.AccountCode, currency string, before time.Time) (decimal.Decimal, error)
+}
+
+func (m *mockBalanceSnapshotRepository) StaleSnapshots(_ context.Context, before valueobject.FiscalPeriod) ([]model.SnapshotCursor, error) {
+	var stale []model.SnapshotCursor
+	for _, c := range m.cursors {
+		if c.StaleFrom.StartDate().Before(before.StartDate()) {
+			stale = append(stale, c)
+		}
+	}
+	return stale, nil
+}
+
+func (m *mockBalanceSnapshotRepository) SnapshotPeriod(_ context.Context, tenantID uuid.UUID, period valueobject.FiscalPeriod) error {
+	if err := m.snapshotErr[tenantID]; err != nil {
+		return err
+	}
+	if m.taken == nil {
+		m.taken = make(map[uuid.UUID][]string)
+	}
+	m.taken[tenantID] = append(m.taken[tenantID], period.String())
+	return nil
+}
+
+func (m *mockBalanceSnapshotRepository) AdvanceSnapshots(_ context.Context, cursor model.SnapshotCursor, to valueobject.FiscalPeriod) (bool, error) {
+	if m.backdated[cursor.TenantID] {
+		return false, nil
+	}
+	if m.advanced == nil {
+		m.advanced = make(map[uuid.UUID]valueobject.FiscalPeriod)
+	}
+	m.advanced[cursor.TenantID] = to
+	return true, nil
+}
+
+func (m *mockBalanceSnapshotRepository) BalanceBefore(ctx context.Context, tenantID uuid.UUID, account valueobject.AccountCode, currency string, before time.Time) (decimal.Decimal, error) {
+	if m.balanceBeforeFunc != nil {
+		return m.balanceBeforeFunc(ctx, tenantID, account, currency, before)
+	}
+	return decimal.Zero, nil
+}
+
+func (m *mockBalanceSnapshotRepository) PeriodBalances(_ context.Context, _ uuid.UUID, _ valueobject.FiscalPeriod) ([]model.PeriodBalance, error) {
+	return m.balances, nil
+}
+
+func mustPeriod(t *testing.T, year int, month time.Month) valueobject.FiscalPeriod {
+	t.Helper()
+	p, err := valueobject.NewFiscalPeriod(year, month)
+	require.NoError(t, err)
+	return p
+}
+
+func TestSnapshotBalances_Execute(t *testing.T) {
+	now := time.Date(2026, 4, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("snapshots ended periods from the first stale one", func(t *testing.T) {
+		tenantID := uuid.New()
+		repo := &mockBalanceSnapshotRepository{cursors: []model.SnapshotCursor{
+			{TenantID: tenantID, StaleFrom: mustPeriod(t, 2026, time.January), Version: 3},
+		}}
+
+		taken, err := usecase.NewSnapshotBalances(repo).Execute(context.Background(), now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, taken)
+		assert.Equal(t, []string{"2026-01", "2026-02", "2026-03"}, repo.taken[tenantID])
+		assert.Equal(t, "2026-04", repo.advanced[tenantID].String())
+	})
+
+	t.Run("skips tenants whose snapshots are current", func(t *testing.T) {
+		tenantID := uuid.New()
+		repo := &mockBalanceSnapshotRepository{cursors: []model.SnapshotCursor{
+			{TenantID: tenantID, StaleFrom: mustPeriod(t, 2026, time.April)},
+		}}
+
+		taken, err := usecase.NewSnapshotBalances(repo).Execute(context.Background(), now)
+
+		require.NoError(t, err)
+		assert.Zero(t, taken)
+		assert.Empty(t, repo.taken)
+	})
+
+	t.Run("waits for entries posted as a period ends to commit", func(t *testing.T) {
+		tenantID := uuid.New()
+		repo := &mockBalanceSnapshotRepository{cursors: []model.SnapshotCursor{
+			{TenantID: tenantID, StaleFrom: mustPeriod(t, 2026, time.March)},
+		}}
+
+		taken, err := usecase.NewSnapshotBalances(repo).Execute(context.Background(), time.Date(2026, 4, 1, 0, 30, 0, 0, time.UTC))
+
+		require.NoError(t, err)
+		assert.Zero(t, taken)
+	})
+
+	t.Run("leaves the cursor of a tenant with a failed snapshot", func(t *testing.T) {
+		failing, ok := uuid.New(), uuid.New()
+		repo := &mockBalanceSnapshotRepository{
+			cursors: []model.SnapshotCursor{
+				{TenantID: failing, StaleFrom: mustPeriod(t, 2026, time.March)},
+				{TenantID: ok, StaleFrom: mustPeriod(t, 2026, time.March)},
+			},
+			snapshotErr: map[uuid.UUID]error{failing: fmt.Errorf("database unavailable")},
+		}
+
+		taken, err := usecase.NewSnapshotBalances(repo).Execute(context.Background(), now)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), failing.String())
+		assert.Equal(t, 1, taken)
+		assert.NotContains(t, repo.advanced, failing)
+		assert.Contains(t, repo.advanced, ok)
+	})
+
+	t.Run("a backdated entry keeps the cursor for the next run", func(t *testing.T) {
+		tenantID := uuid.New()
+		repo := &mockBalanceSnapshotRepository{
+			cursors:   []model.SnapshotCursor{{TenantID: tenantID, StaleFrom: mustPeriod(t, 2026, time.March)}},
+			backdated: map[uuid.UUID]bool{tenantID: true},
+		}
+
+		taken, err := usecase.NewSnapshotBalances(repo).Execute(context.Background(), now)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, taken)
+		assert.NotContains(t, repo.advanced, tenantID)
+	})
+}
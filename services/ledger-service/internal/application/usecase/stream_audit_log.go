@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/application/dto"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/port"
+)
+
+// auditPageSize is how many audit records are read from the log at a time.
+const auditPageSize = 500
+
+// ErrInvalidRange is returned for an audit log date range that ends before
+// it starts.
+var ErrInvalidRange = errors.New("invalid date range")
+
+// StreamAuditLog reads a tenant's audit log, the append-only record of
+// every state transition of its journal entries, in the order it was
+// appended.
+type StreamAuditLog struct {
+	auditRepo port.AuditLogRepository
+}
+
+// NewStreamAuditLog creates a StreamAuditLog.
+func NewStreamAuditLog(auditRepo port.AuditLogRepository) *StreamAuditLog {
+	return &StreamAuditLog{auditRepo: auditRepo}
+}
+
+// Execute calls send with each record the request selects, stopping at
+// send's first error, which it returns.
+func (uc *StreamAuditLog) Execute(ctx context.Context, req dto.AuditLogRequest, send func(dto.AuditRecordDTO) error) error {
+	to := req.To
+	if to.IsZero() {
+		to = time.Now().UTC()
+	}
+	if !req.From.Before(to) {
+		return fmt.Errorf("%w: from must be before to", ErrInvalidRange)
+	}
+	return scanAuditLog(ctx, uc.auditRepo, req.TenantID, req.From, to, req.AfterSequence, func(rec model.AuditRecord) error {
+		return send(toAuditRecordDTO(rec))
+	})
+}
+
+// scanAuditLog calls fn with each of a tenant's audit records that occurred
+// within [from, to) after sequence afterSeq, reading the log a page at a
+// time.
+func scanAuditLog(ctx context.Context, repo port.AuditLogRepository, tenantID uuid.UUID, from, to time.Time, afterSeq int64, fn func(model.AuditRecord) error) error {
+	for {
+		records, err := repo.ListAuditLog(ctx, tenantID, from, to, afterSeq, auditPageSize)
+		if err != nil {
+			return fmt.Errorf("failed to list audit log: %w", err)
+		}
+		for _, rec := range records {
+			if err := fn(rec); err != nil {
+				return err
+			}
+			afterSeq = rec.Sequence
+		}
+		if len(records) < auditPageSize {
+			return nil
+		}
+	}
+}
+
+func toAuditRecordDTO(rec model.AuditRecord) dto.AuditRecordDTO {
+	return dto.AuditRecordDTO{
+		Sequence:      rec.Sequence,
+		ID:            rec.ID,
+		EntryID:       rec.EntryID,
+		Action:        string(rec.Action),
+		FromStatus:    string(rec.FromStatus),
+		ToStatus:      string(rec.ToStatus),
+		EntryVersion:  rec.EntryVersion,
+		EffectiveDate: rec.EffectiveDate,
+		ActorID:       rec.ActorID,
+		Detail:        rec.Detail,
+		OccurredAt:    rec.OccurredAt,
+	}
+}
@@ -0,0 +1,119 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/application/dto"
+	"github.com/bibbank/bib/services/ledger-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
+)
+
+// mockAuditLogRepository implements port.AuditLogRepository over records
+// held in sequence order.
+type mockAuditLogRepository struct {
+	records []model.AuditRecord
+	calls   int
+}
+
+func (m *mockAuditLogRepository) ListAuditLog(_ context.Context, tenantID uuid.UUID, from, to time.Time, afterSeq int64, limit int) ([]model.AuditRecord, error) {
+	m.calls++
+	var out []model.AuditRecord
+	for _, r := range m.records {
+		if r.TenantID != tenantID || r.Sequence <= afterSeq || r.OccurredAt.Before(from) || !r.OccurredAt.Before(to) {
+			continue
+		}
+		if len(out) == limit {
+			break
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+// auditLogOf returns a log of n of a tenant's records, an hour apart from
+// start.
+func auditLogOf(tenantID uuid.UUID, start time.Time, n int) *mockAuditLogRepository {
+	repo := &mockAuditLogRepository{}
+	for i := 0; i < n; i++ {
+		repo.records = append(repo.records, model.AuditRecord{
+			Sequence:   int64(i + 1),
+			ID:         uuid.New(),
+			TenantID:   tenantID,
+			EntryID:    uuid.New(),
+			Action:     model.AuditActionPosted,
+			FromStatus: model.EntryStatusPending,
+			ToStatus:   model.EntryStatusPosted,
+			OccurredAt: start.Add(time.Duration(i) * time.Hour),
+		})
+	}
+	return repo
+}
+
+func TestStreamAuditLog_StreamsInOrderAcrossPages(t *testing.T) {
+	tenantID := uuid.New()
+	start := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	repo := auditLogOf(tenantID, start, 1200)
+	uc := usecase.NewStreamAuditLog(repo)
+
+	var got []int64
+	err := uc.Execute(context.Background(), dto.AuditLogRequest{TenantID: tenantID, AfterSequence: 100}, func(r dto.AuditRecordDTO) error {
+		got = append(got, r.Sequence)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 1100)
+	assert.Equal(t, int64(101), got[0])
+	assert.Equal(t, int64(1200), got[len(got)-1])
+	assert.Equal(t, 3, repo.calls)
+}
+
+func TestStreamAuditLog_FiltersByDateRange(t *testing.T) {
+	tenantID := uuid.New()
+	start := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	uc := usecase.NewStreamAuditLog(auditLogOf(tenantID, start, 48))
+
+	var got []dto.AuditRecordDTO
+	err := uc.Execute(context.Background(), dto.AuditLogRequest{
+		TenantID: tenantID,
+		From:     start.Add(10 * time.Hour),
+		To:       start.Add(20 * time.Hour),
+	}, func(r dto.AuditRecordDTO) error {
+		got = append(got, r)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 10)
+	assert.Equal(t, start.Add(10*time.Hour), got[0].OccurredAt)
+	assert.Equal(t, "POSTED", got[0].Action)
+}
+
+func TestStreamAuditLog_StopsAtSendError(t *testing.T) {
+	tenantID := uuid.New()
+	uc := usecase.NewStreamAuditLog(auditLogOf(tenantID, time.Now().Add(-time.Hour*24), 5))
+	sendErr := errors.New("client gone")
+
+	sent := 0
+	err := uc.Execute(context.Background(), dto.AuditLogRequest{TenantID: tenantID}, func(dto.AuditRecordDTO) error {
+		sent++
+		return sendErr
+	})
+	require.ErrorIs(t, err, sendErr)
+	assert.Equal(t, 1, sent)
+}
+
+func TestStreamAuditLog_RejectsInvertedRange(t *testing.T) {
+	uc := usecase.NewStreamAuditLog(&mockAuditLogRepository{})
+	now := time.Now()
+
+	err := uc.Execute(context.Background(), dto.AuditLogRequest{TenantID: uuid.New(), From: now, To: now.Add(-time.Hour)}, func(dto.AuditRecordDTO) error {
+		return nil
+	})
+	require.ErrorIs(t, err, usecase.ErrInvalidRange)
+}
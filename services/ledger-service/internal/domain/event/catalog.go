@@ -0,0 +1,14 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[EntryPosted]("ledger.entry.posted", 1, "Emitted when a journal entry is posted."),
+		events.Declare[EntryReversed]("ledger.entry.reversed", 1, "Emitted when a journal entry is reversed."),
+		events.Declare[PeriodClosed]("ledger.period.closed", 1, "Emitted when a fiscal period is closed."),
+		events.Declare[PeriodReopened]("ledger.period.reopened", 1, "Emitted when a closed fiscal period is reopened under an approved request."),
+	}
+}
@@ -4,39 +4,63 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 
 	"github.com/bibbank/bib/pkg/events"
 )
 
 const AggregateTypeJournalEntry = "JournalEntry"
 
-// EntryPosted is emitted when a journal entry is posted.
+// Posting is one debit/credit pair of an entry, as carried by its events so
+// consumers can follow account movements without querying the ledger. The
+// legs of a multi-leg entry are matched into pairs (see
+// valueobject.PairLegs).
+type Posting struct {
+	DebitAccount  string          `json:"debit_account"`
+	CreditAccount string          `json:"credit_account"`
+	Currency      string          `json:"currency"`
+	Amount        decimal.Decimal `json:"amount"`
+}
+
+// EntryPosted is emitted when a journal entry is posted. Reference is the
+// entry's business reference, such as payment/<order id>/<leg> for the
+// legs of a payment.
 type EntryPosted struct {
 	EffectiveDate time.Time `json:"effective_date"`
 	events.BaseEvent
-	EntryID uuid.UUID `json:"entry_id"`
+	Reference string    `json:"reference"`
+	Postings  []Posting `json:"postings"`
+	EntryID   uuid.UUID `json:"entry_id"`
 }
 
-func NewEntryPosted(entryID, tenantID uuid.UUID, effectiveDate time.Time) EntryPosted {
+func NewEntryPosted(entryID, tenantID uuid.UUID, effectiveDate time.Time, reference string, postings []Posting) EntryPosted {
 	return EntryPosted{
 		BaseEvent:     events.NewBaseEvent("ledger.entry.posted", entryID.String(), AggregateTypeJournalEntry, tenantID.String()),
 		EntryID:       entryID,
 		EffectiveDate: effectiveDate,
+		Reference:     reference,
+		Postings:      postings,
 	}
 }
 
-// EntryReversed is emitted when a journal entry is reversed.
+// EntryReversed is emitted when a journal entry is reversed. Postings are
+// those of the reversal entry, which is posted without an EntryPosted event;
+// Reference is that of the reversed entry.
 type EntryReversed struct {
 	events.BaseEvent
+	Reference       string    `json:"reference"`
+	Postings        []Posting `json:"postings"`
 	EntryID         uuid.UUID `json:"entry_id"`
 	ReversalEntryID uuid.UUID `json:"reversal_entry_id"`
 }
 
-func NewEntryReversed(entryID, reversalEntryID, tenantID uuid.UUID) EntryReversed {
+func NewEntryReversed(entryID, reversalEntryID, tenantID uuid.UUID, reference string, postings []Posting) EntryReversed {
 	return EntryReversed{
 		BaseEvent:       events.NewBaseEvent("ledger.entry.reversed", entryID.String(), AggregateTypeJournalEntry, tenantID.String()),
 		EntryID:         entryID,
 		ReversalEntryID: reversalEntryID,
+		Reference:       reference,
+		Postings:        postings,
 	}
 }
 
@@ -53,3 +77,22 @@ func NewPeriodClosed(tenantID uuid.UUID, period string) PeriodClosed {
 		Period:    period,
 	}
 }
+
+// PeriodReopened is emitted when a closed fiscal period is reopened under an
+// approved request.
+type PeriodReopened struct {
+	events.BaseEvent
+	Period     string `json:"period"`
+	ApprovalID string `json:"approval_id"`
+	Reason     string `json:"reason"`
+}
+
+func NewPeriodReopened(tenantID uuid.UUID, period string, approvalID uuid.UUID, reason string) PeriodReopened {
+	id := uuid.New()
+	return PeriodReopened{
+		BaseEvent:  events.NewBaseEvent("ledger.period.reopened", id.String(), "FiscalPeriod", tenantID.String()),
+		Period:     period,
+		ApprovalID: approvalID.String(),
+		Reason:     reason,
+	}
+}
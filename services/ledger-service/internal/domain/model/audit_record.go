@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditAction is a state transition of a journal entry.
+type AuditAction string
+
+const (
+	// AuditActionCreated records an entry's creation. Reversal entries are
+	// created POSTED.
+	AuditActionCreated AuditAction = "CREATED"
+	// AuditActionPosted records an entry moving from PENDING to POSTED.
+	AuditActionPosted AuditAction = "POSTED"
+	// AuditActionBackvalued records a PENDING entry being re-dated.
+	AuditActionBackvalued AuditAction = "BACKVALUED"
+	// AuditActionReversed records a POSTED entry being reversed.
+	AuditActionReversed AuditAction = "REVERSED"
+)
+
+// AuditRecord is one state transition of a journal entry, as kept in the
+// ledger's append-only audit log. ActorID is nil for transitions the
+// platform made rather than a caller, such as entries posted from other
+// services' events. FromStatus is empty for CREATED.
+type AuditRecord struct {
+	OccurredAt    time.Time
+	EffectiveDate time.Time
+	ActorID       *uuid.UUID
+	Action        AuditAction
+	FromStatus    EntryStatus
+	ToStatus      EntryStatus
+	Detail        string
+	// Sequence orders the tenant's log. It is assigned when the record is
+	// appended, in the order the tenant's records commit.
+	Sequence     int64
+	EntryVersion int
+	ID           uuid.UUID
+	TenantID     uuid.UUID
+	EntryID      uuid.UUID
+}
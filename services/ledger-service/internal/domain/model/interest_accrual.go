@@ -0,0 +1,161 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+// InterestKind distinguishes the interest the bank pays on deposits from the
+// interest it earns on loans.
+type InterestKind string
+
+const (
+	InterestKindDepositExpense InterestKind = "DEPOSIT_EXPENSE"
+	InterestKindLoanIncome     InterestKind = "LOAN_INCOME"
+)
+
+// PostingPlaces is the precision interest is posted at. Accruals are
+// computed more precisely; the remainder is posted by the period-end
+// true-up.
+const PostingPlaces = 2
+
+// InterestAccrual records interest the ledger recognized: the exact amount a
+// deposit or loan accrued and the amount posted for it, rounded to
+// PostingPlaces. A true-up is an accrual of its own that accrues nothing and
+// posts the difference the period's accruals left.
+type InterestAccrual struct {
+	accruedOn    time.Time
+	recordedAt   time.Time
+	kind         InterestKind
+	currency     string
+	amount       decimal.Decimal
+	posted       decimal.Decimal
+	sourceID     uuid.UUID
+	instrumentID uuid.UUID
+	entryID      uuid.UUID
+	tenantID     uuid.UUID
+}
+
+// NewInterestAccrual records an accrual reported by the event sourceID for
+// the deposit position or loan instrumentID.
+func NewInterestAccrual(
+	tenantID, sourceID, instrumentID uuid.UUID,
+	kind InterestKind,
+	amount decimal.Decimal,
+	currency string,
+	accruedOn, now time.Time,
+) (InterestAccrual, error) {
+	if tenantID == uuid.Nil {
+		return InterestAccrual{}, fmt.Errorf("tenant ID is required")
+	}
+	if sourceID == uuid.Nil {
+		return InterestAccrual{}, fmt.Errorf("source event ID is required")
+	}
+	if kind != InterestKindDepositExpense && kind != InterestKindLoanIncome {
+		return InterestAccrual{}, fmt.Errorf("unknown interest kind %q", kind)
+	}
+	if amount.IsNegative() {
+		return InterestAccrual{}, fmt.Errorf("accrued interest must not be negative, got %s", amount)
+	}
+	if currency == "" {
+		return InterestAccrual{}, fmt.Errorf("currency is required")
+	}
+	if accruedOn.IsZero() {
+		return InterestAccrual{}, fmt.Errorf("accrual date is required")
+	}
+	return InterestAccrual{
+		tenantID:     tenantID,
+		sourceID:     sourceID,
+		instrumentID: instrumentID,
+		kind:         kind,
+		amount:       amount,
+		posted:       amount.Round(PostingPlaces),
+		currency:     currency,
+		accruedOn:    accruedOn.UTC(),
+		recordedAt:   now,
+	}, nil
+}
+
+// NewInterestTrueUp records the true-up of a period's accruals of one kind
+// and currency from their totals. It posts the exact total, rounded, less
+// what the accruals posted; the adjustment is negative when the daily
+// roundings overstated the interest. It is dated the period's last day and
+// identified by the period, so a period is trued up at most once.
+func NewInterestTrueUp(tenantID uuid.UUID, period valueobject.FiscalPeriod, total InterestAccrualTotal, now time.Time) InterestAccrual {
+	return InterestAccrual{
+		tenantID:   tenantID,
+		sourceID:   TrueUpID(tenantID, period, total.Kind, total.Currency),
+		kind:       total.Kind,
+		amount:     decimal.Zero,
+		posted:     total.Adjustment(),
+		currency:   total.Currency,
+		accruedOn:  period.EndDate(),
+		recordedAt: now,
+	}
+}
+
+// TrueUpID identifies the true-up of a tenant's period for a kind and
+// currency.
+func TrueUpID(tenantID uuid.UUID, period valueobject.FiscalPeriod, kind InterestKind, currency string) uuid.UUID {
+	return uuid.NewSHA1(tenantID, []byte(fmt.Sprintf("interest-true-up:%s:%s:%s", period, kind, currency)))
+}
+
+// WithEntry returns a copy linked to the journal entry that posted it.
+func (a InterestAccrual) WithEntry(entryID uuid.UUID) InterestAccrual {
+	linked := a
+	linked.entryID = entryID
+	return linked
+}
+
+// ReconstructInterestAccrual recreates an InterestAccrual from persistence.
+func ReconstructInterestAccrual(
+	tenantID, sourceID, instrumentID uuid.UUID,
+	kind InterestKind,
+	amount, posted decimal.Decimal,
+	currency string,
+	accruedOn time.Time,
+	entryID uuid.UUID,
+	recordedAt time.Time,
+) InterestAccrual {
+	return InterestAccrual{
+		tenantID:     tenantID,
+		sourceID:     sourceID,
+		instrumentID: instrumentID,
+		kind:         kind,
+		amount:       amount,
+		posted:       posted,
+		currency:     currency,
+		accruedOn:    accruedOn,
+		entryID:      entryID,
+		recordedAt:   recordedAt,
+	}
+}
+
+func (a InterestAccrual) TenantID() uuid.UUID     { return a.tenantID }
+func (a InterestAccrual) SourceID() uuid.UUID     { return a.sourceID }
+func (a InterestAccrual) InstrumentID() uuid.UUID { return a.instrumentID }
+func (a InterestAccrual) Kind() InterestKind      { return a.kind }
+func (a InterestAccrual) Amount() decimal.Decimal { return a.amount }
+func (a InterestAccrual) Posted() decimal.Decimal { return a.posted }
+func (a InterestAccrual) Currency() string        { return a.currency }
+func (a InterestAccrual) AccruedOn() time.Time    { return a.accruedOn }
+func (a InterestAccrual) EntryID() uuid.UUID      { return a.entryID }
+func (a InterestAccrual) RecordedAt() time.Time   { return a.recordedAt }
+
+// InterestAccrualTotal sums a period's accruals of one kind and currency.
+type InterestAccrualTotal struct {
+	Kind     InterestKind
+	Currency string
+	Accrued  decimal.Decimal
+	Posted   decimal.Decimal
+}
+
+// Adjustment returns what a true-up must post for the totals to agree.
+func (t InterestAccrualTotal) Adjustment() decimal.Decimal {
+	return t.Accrued.Round(PostingPlaces).Sub(t.Posted)
+}
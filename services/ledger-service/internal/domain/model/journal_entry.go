@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/shopspring/decimal"
 
 	"github.com/bibbank/bib/pkg/events"
 	"github.com/bibbank/bib/services/ledger-service/internal/domain/event"
@@ -22,27 +21,46 @@ const (
 )
 
 // JournalEntry is the root aggregate for the ledger bounded context.
-// It represents an immutable double-entry accounting transaction.
+// It represents an immutable double-entry accounting transaction: any
+// number of debit and credit legs, balanced per currency. Every state
+// transition is recorded for the audit log, saved with the entry.
 type JournalEntry struct {
 	effectiveDate time.Time
 	createdAt     time.Time
 	updatedAt     time.Time
+	actorID       *uuid.UUID
 	status        EntryStatus
 	description   string
 	reference     string
-	postings      []valueobject.PostingPair
+	legs          []valueobject.PostingLeg
 	domainEvents  []events.DomainEvent
+	pendingAudit  []AuditRecord
 	version       int
 	id            uuid.UUID
 	tenantID      uuid.UUID
 }
 
-// NewJournalEntry creates a new journal entry in PENDING status.
+// NewJournalEntry creates a new journal entry in PENDING status from
+// posting pairs, each a debit leg and a credit leg of the same amount.
 func NewJournalEntry(
 	tenantID uuid.UUID,
 	effectiveDate time.Time,
 	postings []valueobject.PostingPair,
 	description, reference string,
+) (JournalEntry, error) {
+	if len(postings) == 0 {
+		return JournalEntry{}, fmt.Errorf("at least one posting pair is required")
+	}
+	return NewJournalEntryFromLegs(tenantID, effectiveDate, valueobject.LegsOf(postings), description, reference)
+}
+
+// NewJournalEntryFromLegs creates a new journal entry in PENDING status from
+// its legs, which must balance per currency.
+func NewJournalEntryFromLegs(
+	tenantID uuid.UUID,
+	effectiveDate time.Time,
+	legs []valueobject.PostingLeg,
+	description, reference string,
 ) (JournalEntry, error) {
 	if tenantID == uuid.Nil {
 		return JournalEntry{}, fmt.Errorf("tenant ID is required")
@@ -50,40 +68,36 @@ func NewJournalEntry(
 	if effectiveDate.IsZero() {
 		return JournalEntry{}, fmt.Errorf("effective date is required")
 	}
-	if len(postings) == 0 {
-		return JournalEntry{}, fmt.Errorf("at least one posting pair is required")
+	if len(legs) < 2 {
+		return JournalEntry{}, fmt.Errorf("at least two posting legs are required")
 	}
 
 	// Validate that debits equal credits per currency
-	debits := make(map[string]decimal.Decimal)
-	credits := make(map[string]decimal.Decimal)
-	for _, p := range postings {
-		debits[p.Currency()] = debits[p.Currency()].Add(p.Amount())
-		credits[p.Currency()] = credits[p.Currency()].Add(p.Amount())
+	if _, err := valueobject.PairLegs(legs); err != nil {
+		return JournalEntry{}, fmt.Errorf("unbalanced journal entry: %w", err)
 	}
-	// For posting pairs, debits always equal credits by construction.
-	// But we validate the total is balanced across all pairs.
 
 	now := time.Now().UTC()
-	return JournalEntry{
+	je := JournalEntry{
 		id:            uuid.New(),
 		tenantID:      tenantID,
 		effectiveDate: effectiveDate,
-		postings:      postings,
+		legs:          legs,
 		status:        EntryStatusPending,
 		description:   description,
 		reference:     reference,
 		version:       1,
 		createdAt:     now,
 		updatedAt:     now,
-	}, nil
+	}
+	return je.record(AuditActionCreated, "", "", now), nil
 }
 
-// Reconstruct recreates a JournalEntry from persistence (no validation, no events).
+// Reconstruct recreates a JournalEntry from persistence (no validation, no events, no audit records).
 func Reconstruct(
 	id, tenantID uuid.UUID,
 	effectiveDate time.Time,
-	postings []valueobject.PostingPair,
+	legs []valueobject.PostingLeg,
 	status EntryStatus,
 	description, reference string,
 	version int,
@@ -93,7 +107,7 @@ func Reconstruct(
 		id:            id,
 		tenantID:      tenantID,
 		effectiveDate: effectiveDate,
-		postings:      postings,
+		legs:          legs,
 		status:        status,
 		description:   description,
 		reference:     reference,
@@ -114,8 +128,8 @@ func (je JournalEntry) Post(now time.Time) (JournalEntry, error) {
 	posted.updatedAt = now
 	posted.version++
 	posted.domainEvents = append([]events.DomainEvent{}, je.domainEvents...)
-	posted.domainEvents = append(posted.domainEvents, event.NewEntryPosted(je.id, je.tenantID, je.effectiveDate))
-	return posted, nil
+	posted.domainEvents = append(posted.domainEvents, event.NewEntryPosted(je.id, je.tenantID, je.effectiveDate, je.reference, eventPostings(je.legs)))
+	return posted.record(AuditActionPosted, je.status, "", now), nil
 }
 
 // Reverse transitions the entry from POSTED to REVERSED and creates a reversal entry.
@@ -130,41 +144,49 @@ func (je JournalEntry) Reverse(now time.Time, reason string) (reversed JournalEn
 	reversed.updatedAt = now
 	reversed.version++
 
-	// Create reversal entry (swap debit/credit in each posting)
-	var reversalPostings []valueobject.PostingPair
-	for _, p := range je.postings {
-		rp, rpErr := valueobject.NewPostingPair(
-			p.CreditAccount(), // swap
-			p.DebitAccount(),  // swap
-			p.Amount(),
-			p.Currency(),
-			fmt.Sprintf("Reversal: %s", p.Description()),
-		)
-		if rpErr != nil {
-			return JournalEntry{}, JournalEntry{}, fmt.Errorf("failed to create reversal posting: %w", rpErr)
-		}
-		reversalPostings = append(reversalPostings, rp)
+	// Create reversal entry (swap debit/credit in each leg)
+	reversalLegs := make([]valueobject.PostingLeg, len(je.legs))
+	for i, l := range je.legs {
+		reversalLegs[i] = l.Reversed(fmt.Sprintf("Reversal: %s", l.Description()))
 	}
 
 	reversal = JournalEntry{
 		id:            uuid.New(),
 		tenantID:      je.tenantID,
 		effectiveDate: now,
-		postings:      reversalPostings,
+		legs:          reversalLegs,
 		status:        EntryStatusPosted,
 		description:   fmt.Sprintf("Reversal of %s: %s", je.id, reason),
 		reference:     je.id.String(),
 		version:       1,
 		createdAt:     now,
 		updatedAt:     now,
+		actorID:       je.actorID,
 	}
+	reversal = reversal.record(AuditActionCreated, "", fmt.Sprintf("reversal of %s", je.id), now)
 
 	reversed.domainEvents = append([]events.DomainEvent{}, je.domainEvents...)
-	reversed.domainEvents = append(reversed.domainEvents, event.NewEntryReversed(je.id, reversal.id, je.tenantID))
+	reversed.domainEvents = append(reversed.domainEvents, event.NewEntryReversed(je.id, reversal.id, je.tenantID, je.reference, eventPostings(reversalLegs)))
+	reversed = reversed.record(AuditActionReversed, je.status, fmt.Sprintf("reversed by %s: %s", reversal.id, reason), now)
 
 	return reversed, reversal, nil
 }
 
+// eventPostings converts legs to their event form, matched into pairs.
+func eventPostings(legs []valueobject.PostingLeg) []event.Posting {
+	postings, _ := valueobject.PairLegs(legs)
+	out := make([]event.Posting, len(postings))
+	for i, p := range postings {
+		out[i] = event.Posting{
+			DebitAccount:  p.DebitAccount().String(),
+			CreditAccount: p.CreditAccount().String(),
+			Amount:        p.Amount(),
+			Currency:      p.Currency(),
+		}
+	}
+	return out
+}
+
 // Backvalue re-dates a PENDING entry to a past effective date.
 func (je JournalEntry) Backvalue(newDate time.Time, now time.Time) (JournalEntry, error) {
 	if je.status != EntryStatusPending {
@@ -178,21 +200,65 @@ func (je JournalEntry) Backvalue(newDate time.Time, now time.Time) (JournalEntry
 	updated.effectiveDate = newDate
 	updated.updatedAt = now
 	updated.version++
-	return updated, nil
+	detail := fmt.Sprintf("effective date %s -> %s", je.effectiveDate.Format(time.DateOnly), newDate.Format(time.DateOnly))
+	return updated.record(AuditActionBackvalued, je.status, detail, now), nil
+}
+
+// WithActor returns a copy of the entry whose transitions are attributed to
+// actorID, the caller making them: those to come and those recorded since
+// the entry was created or loaded.
+func (je JournalEntry) WithActor(actorID *uuid.UUID) JournalEntry {
+	je.actorID = actorID
+	je.pendingAudit = append([]AuditRecord{}, je.pendingAudit...)
+	for i := range je.pendingAudit {
+		je.pendingAudit[i].ActorID = actorID
+	}
+	return je
+}
+
+// record returns a copy of the entry with its transition from status from
+// to its current status recorded for the audit log.
+func (je JournalEntry) record(action AuditAction, from EntryStatus, detail string, now time.Time) JournalEntry {
+	je.pendingAudit = append(append([]AuditRecord{}, je.pendingAudit...), AuditRecord{
+		ID:            uuid.New(),
+		TenantID:      je.tenantID,
+		EntryID:       je.id,
+		Action:        action,
+		FromStatus:    from,
+		ToStatus:      je.status,
+		EntryVersion:  je.version,
+		EffectiveDate: je.effectiveDate,
+		ActorID:       je.actorID,
+		Detail:        detail,
+		OccurredAt:    now,
+	})
+	return je
 }
 
 // Accessors
-func (je JournalEntry) ID() uuid.UUID                       { return je.id }
-func (je JournalEntry) TenantID() uuid.UUID                 { return je.tenantID }
-func (je JournalEntry) EffectiveDate() time.Time            { return je.effectiveDate }
-func (je JournalEntry) Postings() []valueobject.PostingPair { return je.postings }
-func (je JournalEntry) Status() EntryStatus                 { return je.status }
-func (je JournalEntry) Description() string                 { return je.description }
-func (je JournalEntry) Reference() string                   { return je.reference }
-func (je JournalEntry) Version() int                        { return je.version }
-func (je JournalEntry) CreatedAt() time.Time                { return je.createdAt }
-func (je JournalEntry) UpdatedAt() time.Time                { return je.updatedAt }
-func (je JournalEntry) DomainEvents() []events.DomainEvent  { return je.domainEvents }
+func (je JournalEntry) ID() uuid.UUID                      { return je.id }
+func (je JournalEntry) TenantID() uuid.UUID                { return je.tenantID }
+func (je JournalEntry) EffectiveDate() time.Time           { return je.effectiveDate }
+func (je JournalEntry) Legs() []valueobject.PostingLeg     { return je.legs }
+func (je JournalEntry) Status() EntryStatus                { return je.status }
+func (je JournalEntry) Description() string                { return je.description }
+func (je JournalEntry) Reference() string                  { return je.reference }
+func (je JournalEntry) Version() int                       { return je.version }
+func (je JournalEntry) CreatedAt() time.Time               { return je.createdAt }
+func (je JournalEntry) UpdatedAt() time.Time               { return je.updatedAt }
+func (je JournalEntry) DomainEvents() []events.DomainEvent { return je.domainEvents }
+
+// PendingAudit returns the transitions recorded since the entry was created
+// or loaded, which Save appends to the audit log.
+func (je JournalEntry) PendingAudit() []AuditRecord { return je.pendingAudit }
+
+// Postings returns the entry's legs matched into debit/credit pairs, as
+// valueobject.PairLegs does. An entry created from pairs returns the same
+// pairs.
+func (je JournalEntry) Postings() []valueobject.PostingPair {
+	postings, _ := valueobject.PairLegs(je.legs)
+	return postings
+}
 
 // ClearDomainEvents returns the collected domain events and a new JournalEntry with events cleared.
 func (je JournalEntry) ClearDomainEvents() ([]events.DomainEvent, JournalEntry) {
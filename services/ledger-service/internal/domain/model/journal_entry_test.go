@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/event"
 	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
 	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
 )
@@ -132,6 +133,14 @@ func TestJournalEntry_Post_GeneratesDomainEvent(t *testing.T) {
 	require.Len(t, events, 1)
 	assert.Equal(t, "ledger.entry.posted", events[0].EventType())
 	assert.Equal(t, entry.ID().String(), events[0].AggregateID())
+
+	evt, ok := events[0].(event.EntryPosted)
+	require.True(t, ok)
+	require.Len(t, evt.Postings, 1)
+	assert.Equal(t, "1000", evt.Postings[0].DebitAccount)
+	assert.Equal(t, "2000", evt.Postings[0].CreditAccount)
+	assert.True(t, decimal.NewFromInt(100).Equal(evt.Postings[0].Amount))
+	assert.Equal(t, "USD", evt.Postings[0].Currency)
 }
 
 func TestJournalEntry_Post_FromPosted_Error(t *testing.T) {
@@ -235,6 +244,12 @@ func TestJournalEntry_Reverse_GeneratesDomainEvent(t *testing.T) {
 	require.Len(t, events, 2)
 	assert.Equal(t, "ledger.entry.posted", events[0].EventType())
 	assert.Equal(t, "ledger.entry.reversed", events[1].EventType())
+
+	evt, ok := events[1].(event.EntryReversed)
+	require.True(t, ok)
+	require.Len(t, evt.Postings, 1)
+	assert.Equal(t, "2000", evt.Postings[0].DebitAccount, "the reversal swaps debit and credit")
+	assert.Equal(t, "1000", evt.Postings[0].CreditAccount)
 }
 
 func TestJournalEntry_Reverse_FromPending_Error(t *testing.T) {
@@ -336,7 +351,7 @@ func TestJournalEntry_Reconstruct(t *testing.T) {
 	updatedAt := time.Date(2024, time.March, 14, 11, 0, 0, 0, time.UTC)
 
 	entry := model.Reconstruct(
-		id, tenantID, effectiveDate, postings,
+		id, tenantID, effectiveDate, valueobject.LegsOf(postings),
 		model.EntryStatusPosted, "Reconstructed", "REF-R",
 		3, createdAt, updatedAt,
 	)
@@ -344,6 +359,7 @@ func TestJournalEntry_Reconstruct(t *testing.T) {
 	assert.Equal(t, id, entry.ID())
 	assert.Equal(t, tenantID, entry.TenantID())
 	assert.Equal(t, effectiveDate, entry.EffectiveDate())
+	assert.Len(t, entry.Legs(), 2)
 	assert.Len(t, entry.Postings(), 1)
 	assert.Equal(t, model.EntryStatusPosted, entry.Status())
 	assert.Equal(t, "Reconstructed", entry.Description())
@@ -398,3 +414,100 @@ func TestEntryStatus_Constants(t *testing.T) {
 	assert.Equal(t, model.EntryStatus("POSTED"), model.EntryStatusPosted)
 	assert.Equal(t, model.EntryStatus("REVERSED"), model.EntryStatusReversed)
 }
+
+func TestNewJournalEntryFromLegs(t *testing.T) {
+	tenantID := uuid.New()
+	effectiveDate := time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC)
+	leg := func(code string, side valueobject.PostingSide, amount int64) valueobject.PostingLeg {
+		l, err := valueobject.NewPostingLeg(valueobject.MustAccountCode(code), side, decimal.NewFromInt(amount), "USD", "fee split")
+		require.NoError(t, err)
+		return l
+	}
+
+	t.Run("posts and reverses a multi-leg entry", func(t *testing.T) {
+		legs := []valueobject.PostingLeg{
+			leg("1000", valueobject.SideDebit, 100),
+			leg("2000", valueobject.SideCredit, 97),
+			leg("4100", valueobject.SideCredit, 3),
+		}
+		entry, err := model.NewJournalEntryFromLegs(tenantID, effectiveDate, legs, "Payment", "REF")
+		require.NoError(t, err)
+		assert.Equal(t, legs, entry.Legs())
+
+		posted, err := entry.Post(effectiveDate)
+		require.NoError(t, err)
+		evt, ok := posted.DomainEvents()[0].(event.EntryPosted)
+		require.True(t, ok)
+		require.Len(t, evt.Postings, 2)
+		assert.Equal(t, "4100", evt.Postings[1].CreditAccount)
+		assert.True(t, decimal.NewFromInt(3).Equal(evt.Postings[1].Amount))
+
+		_, reversal, err := posted.Reverse(effectiveDate.Add(time.Hour), "mistake")
+		require.NoError(t, err)
+		require.Len(t, reversal.Legs(), 3)
+		for i, l := range reversal.Legs() {
+			assert.True(t, l.Account().Equal(legs[i].Account()))
+			assert.Equal(t, legs[i].Side().Opposite(), l.Side())
+			assert.True(t, l.Amount().Equal(legs[i].Amount()))
+		}
+	})
+
+	t.Run("rejects unbalanced legs", func(t *testing.T) {
+		_, err := model.NewJournalEntryFromLegs(tenantID, effectiveDate, []valueobject.PostingLeg{
+			leg("1000", valueobject.SideDebit, 100),
+			leg("2000", valueobject.SideCredit, 90),
+		}, "Payment", "REF")
+		assert.Error(t, err)
+	})
+
+	t.Run("requires two legs", func(t *testing.T) {
+		_, err := model.NewJournalEntryFromLegs(tenantID, effectiveDate, []valueobject.PostingLeg{
+			leg("1000", valueobject.SideDebit, 100),
+		}, "Payment", "REF")
+		assert.Error(t, err)
+	})
+}
+
+func TestJournalEntry_RecordsTransitionsForAudit(t *testing.T) {
+	tenantID := uuid.New()
+	effectiveDate := time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC)
+	entry, err := model.NewJournalEntry(tenantID, effectiveDate, newTestPostings(t), "Test", "REF")
+	require.NoError(t, err)
+
+	actorID := uuid.New()
+	now := time.Now().UTC()
+	posted, err := entry.WithActor(&actorID).Post(now)
+	require.NoError(t, err)
+
+	records := posted.PendingAudit()
+	require.Len(t, records, 2)
+	assert.Equal(t, model.AuditActionCreated, records[0].Action)
+	assert.Equal(t, model.EntryStatus(""), records[0].FromStatus)
+	assert.Equal(t, model.EntryStatusPending, records[0].ToStatus)
+	assert.Equal(t, model.AuditActionPosted, records[1].Action)
+	assert.Equal(t, model.EntryStatusPending, records[1].FromStatus)
+	assert.Equal(t, model.EntryStatusPosted, records[1].ToStatus)
+	assert.Equal(t, 2, records[1].EntryVersion)
+	assert.Equal(t, now, records[1].OccurredAt)
+	for _, r := range records {
+		assert.Equal(t, posted.ID(), r.EntryID)
+		assert.Equal(t, tenantID, r.TenantID)
+		require.NotNil(t, r.ActorID, "record %s has no actor", r.Action)
+		assert.Equal(t, actorID, *r.ActorID)
+	}
+	// The entry the transitions were made on is unchanged.
+	require.Len(t, entry.PendingAudit(), 1)
+	assert.Nil(t, entry.PendingAudit()[0].ActorID)
+
+	// A loaded entry records only the transitions made since.
+	loaded := model.Reconstruct(posted.ID(), tenantID, effectiveDate, posted.Legs(), posted.Status(),
+		posted.Description(), posted.Reference(), posted.Version(), posted.CreatedAt(), posted.UpdatedAt())
+	reversed, reversal, err := loaded.Reverse(now, "duplicate")
+	require.NoError(t, err)
+	require.Len(t, reversed.PendingAudit(), 1)
+	assert.Equal(t, model.AuditActionReversed, reversed.PendingAudit()[0].Action)
+	assert.Nil(t, reversed.PendingAudit()[0].ActorID)
+	require.Len(t, reversal.PendingAudit(), 1)
+	assert.Equal(t, model.AuditActionCreated, reversal.PendingAudit()[0].Action)
+	assert.Equal(t, model.EntryStatusPosted, reversal.PendingAudit()[0].ToStatus)
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+// PeriodBalance holds one account's balance in one currency over a fiscal
+// period: its balance at the start of the period and the debits and
+// credits posted in it. Balances are debits less credits.
+type PeriodBalance struct {
+	AccountCode valueobject.AccountCode
+	Currency    string
+	Opening     decimal.Decimal
+	Debit       decimal.Decimal
+	Credit      decimal.Decimal
+}
+
+// Closing returns the balance at the end of the period.
+func (b PeriodBalance) Closing() decimal.Decimal {
+	return b.Opening.Add(b.Debit).Sub(b.Credit)
+}
+
+// SnapshotCursor marks how far a tenant's balance snapshots are current:
+// every period before StaleFrom has an up-to-date snapshot, and StaleFrom
+// and later periods have none or one an entry backdated into them made
+// stale. Version changes whenever StaleFrom is moved back, so the snapshot
+// job can tell that an entry was backdated while it worked.
+type SnapshotCursor struct {
+	TenantID  uuid.UUID
+	StaleFrom valueobject.FiscalPeriod
+	Version   int64
+}
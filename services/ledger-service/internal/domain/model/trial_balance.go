@@ -0,0 +1,52 @@
+package model
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+// TrialBalanceLine holds the debits and credits posted to one account in
+// one currency over a period.
+type TrialBalanceLine struct {
+	AccountCode valueobject.AccountCode
+	Currency    string
+	Debit       decimal.Decimal
+	Credit      decimal.Decimal
+}
+
+// TrialBalanceTotal sums a trial balance's lines in one currency.
+type TrialBalanceTotal struct {
+	Currency string
+	Debit    decimal.Decimal
+	Credit   decimal.Decimal
+}
+
+// TrialBalanceTotals sums the lines by currency, ordered by currency.
+func TrialBalanceTotals(lines []TrialBalanceLine) []TrialBalanceTotal {
+	byCurrency := make(map[string]*TrialBalanceTotal)
+	for _, l := range lines {
+		t, ok := byCurrency[l.Currency]
+		if !ok {
+			t = &TrialBalanceTotal{Currency: l.Currency}
+			byCurrency[l.Currency] = t
+		}
+		t.Debit = t.Debit.Add(l.Debit)
+		t.Credit = t.Credit.Add(l.Credit)
+	}
+	totals := make([]TrialBalanceTotal, 0, len(byCurrency))
+	for _, t := range byCurrency {
+		totals = append(totals, *t)
+	}
+	sort.Slice(totals, func(i, j int) bool { return totals[i].Currency < totals[j].Currency })
+	return totals
+}
+
+// Balanced reports whether the currency's debits equal its credits. Every
+// posting debits and credits the same amount, so a currency that does not
+// balance means the books are corrupt.
+func (t TrialBalanceTotal) Balanced() bool {
+	return t.Debit.Equal(t.Credit)
+}
@@ -2,6 +2,8 @@ package port
 
 import (
 	"context"
+	"errors"
+	"io"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,32 +14,124 @@ import (
 	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
 )
 
+var (
+	// ErrEntryNotFound is returned when a journal entry does not exist.
+	ErrEntryNotFound = errors.New("journal entry not found")
+	// ErrPeriodNotClosed is returned when reopening a fiscal period that is
+	// not closed.
+	ErrPeriodNotClosed = errors.New("fiscal period is not closed")
+	// ErrApprovalUsed is returned when reopening a fiscal period under an
+	// approval that already reopened one.
+	ErrApprovalUsed = errors.New("approval was already used to reopen a period")
+	// ErrDuplicateReference is returned when saving a new journal entry
+	// under a reference another of the tenant's entries was posted under.
+	ErrDuplicateReference = errors.New("journal entry reference already used")
+)
+
 // JournalRepository defines persistence operations for journal entries.
 type JournalRepository interface {
-	// Save persists a journal entry (insert or update).
+	// Save persists a journal entry (insert or update). A tenant's
+	// references are unique: it returns ErrDuplicateReference for an entry
+	// whose non-empty reference another entry has.
 	Save(ctx context.Context, entry model.JournalEntry) error
 	// FindByID retrieves a journal entry by its unique identifier.
 	FindByID(ctx context.Context, id uuid.UUID) (model.JournalEntry, error)
+	// FindByReference retrieves the tenant's journal entry with the given
+	// reference.
+	FindByReference(ctx context.Context, tenantID uuid.UUID, reference string) (model.JournalEntry, error)
 	// ListByAccount returns journal entries filtered by account code within a date range.
 	ListByAccount(ctx context.Context, tenantID uuid.UUID, account valueobject.AccountCode, from, to time.Time, limit, offset int) ([]model.JournalEntry, int, error)
 	// ListByTenant returns journal entries for a tenant within a date range.
 	ListByTenant(ctx context.Context, tenantID uuid.UUID, from, to time.Time, limit, offset int) ([]model.JournalEntry, int, error)
 }
 
+// AuditLogRepository reads the append-only audit log of journal entry
+// state transitions, which JournalRepository.Save appends to.
+type AuditLogRepository interface {
+	// ListAuditLog returns up to limit of a tenant's audit records that
+	// occurred within [from, to), ordered by sequence, starting after the
+	// record with sequence afterSeq.
+	ListAuditLog(ctx context.Context, tenantID uuid.UUID, from, to time.Time, afterSeq int64, limit int) ([]model.AuditRecord, error)
+}
+
+// TrialBalanceRepository aggregates the postings of journal entries.
+type TrialBalanceRepository interface {
+	// TrialBalance sums the debits and credits posted to each account, by
+	// currency, by a tenant's posted and reversed entries effective within
+	// [from, to), ordered by account code and currency. A reversed entry is
+	// offset by its reversal, which is posted.
+	TrialBalance(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]model.TrialBalanceLine, error)
+}
+
 // BalanceRepository defines persistence operations for account balances.
 type BalanceRepository interface {
 	// UpdateBalance atomically adjusts the balance for an account/currency by delta.
 	UpdateBalance(ctx context.Context, account valueobject.AccountCode, currency string, delta decimal.Decimal) error
 	// GetBalance retrieves the balance for an account/currency as of a given time.
 	GetBalance(ctx context.Context, account valueobject.AccountCode, currency string, asOf time.Time) (decimal.Decimal, error)
+	// OpenAccount records a zero balance for an account/currency that has
+	// none and returns the account's balance.
+	OpenAccount(ctx context.Context, account valueobject.AccountCode, currency string) (decimal.Decimal, error)
+}
+
+// BalanceSnapshotRepository keeps a snapshot of each tenant's balances at
+// the end of every ended fiscal period, so a balance is read as a snapshot
+// plus the postings since rather than summed over the whole journal.
+// Posting an entry backdated into a snapshotted period makes that period's
+// snapshot and every later one stale until they are taken again.
+type BalanceSnapshotRepository interface {
+	// StaleSnapshots returns the cursor of each tenant with a period before
+	// before whose snapshot is missing or stale.
+	StaleSnapshots(ctx context.Context, before valueobject.FiscalPeriod) ([]model.SnapshotCursor, error)
+	// SnapshotPeriod takes a tenant's snapshot at the end of period from the
+	// previous period's snapshot, which must be current, and the period's
+	// postings.
+	SnapshotPeriod(ctx context.Context, tenantID uuid.UUID, period valueobject.FiscalPeriod) error
+	// AdvanceSnapshots marks the cursor's tenant's snapshots current up to,
+	// not including, to. It reports false, leaving the cursor, when an entry
+	// was backdated since the cursor was read.
+	AdvanceSnapshots(ctx context.Context, cursor model.SnapshotCursor, to valueobject.FiscalPeriod) (bool, error)
+	// BalanceBefore returns an account's balance in a currency from a
+	// tenant's posted and reversed entries effective before before.
+	BalanceBefore(ctx context.Context, tenantID uuid.UUID, account valueobject.AccountCode, currency string, before time.Time) (decimal.Decimal, error)
+	// PeriodBalances returns each account's balance over a tenant's fiscal
+	// period, by currency, ordered by account code and currency.
+	PeriodBalances(ctx context.Context, tenantID uuid.UUID, period valueobject.FiscalPeriod) ([]model.PeriodBalance, error)
 }
 
 // FiscalPeriodRepository defines persistence operations for fiscal periods.
 type FiscalPeriodRepository interface {
 	// GetPeriodStatus returns the current status of a fiscal period.
 	GetPeriodStatus(ctx context.Context, tenantID uuid.UUID, period valueobject.FiscalPeriod) (valueobject.PeriodStatus, error)
-	// ClosePeriod marks a fiscal period as closed.
-	ClosePeriod(ctx context.Context, tenantID uuid.UUID, period valueobject.FiscalPeriod) error
+	// ClosePeriod marks a fiscal period as closed and freezes its balances,
+	// replacing those frozen by an earlier close of the period.
+	ClosePeriod(ctx context.Context, tenantID uuid.UUID, period valueobject.FiscalPeriod, balances []model.PeriodBalance) error
+	// ReopenPeriod marks a closed fiscal period as open again under the
+	// approved request approvalID, recording the reopening. It returns
+	// ErrPeriodNotClosed if the period is not closed and ErrApprovalUsed if
+	// the request already reopened a period.
+	ReopenPeriod(ctx context.Context, tenantID uuid.UUID, period valueobject.FiscalPeriod, approvalID uuid.UUID, reason string) error
+}
+
+// InterestAccrualRepository defines persistence operations for the interest
+// accruals the ledger recognized.
+type InterestAccrualRepository interface {
+	// Exists reports whether the accrual reported by sourceID was recorded.
+	Exists(ctx context.Context, sourceID uuid.UUID) (bool, error)
+	// Save records an accrual.
+	Save(ctx context.Context, accrual model.InterestAccrual) error
+	// PeriodTotals sums a tenant's accruals dated in the period, by kind and
+	// currency.
+	PeriodTotals(ctx context.Context, tenantID uuid.UUID, period valueobject.FiscalPeriod) ([]model.InterestAccrualTotal, error)
+}
+
+// AuditExportStore is the object storage audit log exports are written to,
+// the platform's archive store.
+type AuditExportStore interface {
+	// Put writes body under key, replacing any object already there.
+	Put(ctx context.Context, key string, body io.ReadSeeker, contentType string) error
+	// URI returns the URI of the object under key, for auditors.
+	URI(key string) string
 }
 
 // EventPublisher publishes domain events to a message broker.
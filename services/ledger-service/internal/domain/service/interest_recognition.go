@@ -0,0 +1,63 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+// InterestAccounts are the ledger accounts accrued interest is recognized
+// in. Deposit interest is an expense owed to depositors until paid; loan
+// interest is income receivable from borrowers until paid.
+type InterestAccounts struct {
+	DepositExpense valueobject.AccountCode
+	DepositPayable valueobject.AccountCode
+	LoanReceivable valueobject.AccountCode
+	LoanIncome     valueobject.AccountCode
+}
+
+// NewInterestAccounts parses and validates the interest account codes.
+func NewInterestAccounts(depositExpense, depositPayable, loanReceivable, loanIncome string) (InterestAccounts, error) {
+	var (
+		accounts InterestAccounts
+		err      error
+	)
+	for _, a := range []struct {
+		dst  *valueobject.AccountCode
+		code string
+		name string
+	}{
+		{&accounts.DepositExpense, depositExpense, "deposit interest expense"},
+		{&accounts.DepositPayable, depositPayable, "deposit interest payable"},
+		{&accounts.LoanReceivable, loanReceivable, "loan interest receivable"},
+		{&accounts.LoanIncome, loanIncome, "loan interest income"},
+	} {
+		if *a.dst, err = valueobject.NewAccountCode(a.code); err != nil {
+			return InterestAccounts{}, fmt.Errorf("invalid %s account: %w", a.name, err)
+		}
+	}
+	return accounts, nil
+}
+
+// InterestPosting returns the posting that recognizes amount of interest of
+// the kind: debit expense and credit payable for deposits, debit receivable
+// and credit income for loans. A negative amount, from a true-up reducing
+// the interest recognized, posts the reverse.
+func (a InterestAccounts) InterestPosting(kind model.InterestKind, amount decimal.Decimal, currency, description string) (valueobject.PostingPair, error) {
+	var debit, credit valueobject.AccountCode
+	switch kind {
+	case model.InterestKindDepositExpense:
+		debit, credit = a.DepositExpense, a.DepositPayable
+	case model.InterestKindLoanIncome:
+		debit, credit = a.LoanReceivable, a.LoanIncome
+	default:
+		return valueobject.PostingPair{}, fmt.Errorf("unknown interest kind %q", kind)
+	}
+	if amount.IsNegative() {
+		debit, credit = credit, debit
+	}
+	return valueobject.NewPostingPair(debit, credit, amount.Abs(), currency, description)
+}
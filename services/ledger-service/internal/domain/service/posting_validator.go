@@ -8,7 +8,8 @@ import (
 	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
 )
 
-// PostingValidator is a domain service that validates posting pairs.
+// PostingValidator is a domain service that validates the postings of a
+// journal entry.
 type PostingValidator struct{}
 
 func NewPostingValidator() *PostingValidator {
@@ -20,21 +21,43 @@ func (v *PostingValidator) ValidatePostings(postings []valueobject.PostingPair)
 	if len(postings) == 0 {
 		return fmt.Errorf("at least one posting pair is required")
 	}
+	return v.ValidateLegs(valueobject.LegsOf(postings))
+}
 
-	// Each PostingPair is balanced by definition (same amount on both sides),
-	// so we just validate the overall structure.
-	totals := make(map[string]decimal.Decimal)
-	for _, p := range postings {
-		totals[p.Currency()] = totals[p.Currency()].Add(p.Amount())
+// ValidateLegs ensures the legs of an entry debit and credit at least one
+// account each and balance per currency, and that each debit can be matched
+// against credits to other accounts, so the entry can be published as
+// posting pairs.
+func (v *PostingValidator) ValidateLegs(legs []valueobject.PostingLeg) error {
+	debits := make(map[string]decimal.Decimal)
+	credits := make(map[string]decimal.Decimal)
+	var currencies []string
+	for _, l := range legs {
+		_, seenDebit := debits[l.Currency()]
+		_, seenCredit := credits[l.Currency()]
+		if !seenDebit && !seenCredit {
+			currencies = append(currencies, l.Currency())
+		}
+		if l.IsDebit() {
+			debits[l.Currency()] = debits[l.Currency()].Add(l.Amount())
+		} else {
+			credits[l.Currency()] = credits[l.Currency()].Add(l.Amount())
+		}
+	}
+	if len(debits) == 0 || len(credits) == 0 {
+		return fmt.Errorf("at least one debit leg and one credit leg are required")
 	}
 
-	// Ensure no negative totals
-	for currency, total := range totals {
-		if total.LessThanOrEqual(decimal.Zero) {
-			return fmt.Errorf("total for currency %s must be positive, got %s", currency, total)
+	for _, currency := range currencies {
+		if !debits[currency].Equal(credits[currency]) {
+			return fmt.Errorf("entry does not balance in %s: debits %s, credits %s",
+				currency, debits[currency], credits[currency])
 		}
 	}
 
+	if _, err := valueobject.PairLegs(legs); err != nil {
+		return fmt.Errorf("legs cannot be matched into posting pairs: %w", err)
+	}
 	return nil
 }
 
@@ -109,3 +109,64 @@ func TestPostingValidator_ValidateNotSelfPosting_MultipleValid(t *testing.T) {
 	err = validator.ValidateNotSelfPosting([]valueobject.PostingPair{pp1, pp2})
 	assert.NoError(t, err)
 }
+
+func TestPostingValidator_ValidateLegs(t *testing.T) {
+	validator := service.NewPostingValidator()
+	leg := func(code string, side valueobject.PostingSide, amount int64, currency string) valueobject.PostingLeg {
+		l, err := valueobject.NewPostingLeg(valueobject.MustAccountCode(code), side, decimal.NewFromInt(amount), currency, "")
+		require.NoError(t, err)
+		return l
+	}
+
+	t.Run("balanced per currency", func(t *testing.T) {
+		err := validator.ValidateLegs([]valueobject.PostingLeg{
+			leg("1000", valueobject.SideDebit, 100, "USD"),
+			leg("2000", valueobject.SideCredit, 60, "USD"),
+			leg("2100", valueobject.SideCredit, 40, "USD"),
+			leg("1100", valueobject.SideDebit, 90, "EUR"),
+			leg("2000", valueobject.SideCredit, 90, "EUR"),
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("account both debited and credited", func(t *testing.T) {
+		err := validator.ValidateLegs([]valueobject.PostingLeg{
+			leg("1000", valueobject.SideDebit, 100, "USD"),
+			leg("1100", valueobject.SideDebit, 100, "USD"),
+			leg("2000", valueobject.SideCredit, 100, "USD"),
+			leg("1100", valueobject.SideCredit, 100, "USD"),
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("unbalanced currency", func(t *testing.T) {
+		err := validator.ValidateLegs([]valueobject.PostingLeg{
+			leg("1000", valueobject.SideDebit, 100, "USD"),
+			leg("2000", valueobject.SideCredit, 100, "USD"),
+			leg("1100", valueobject.SideDebit, 90, "EUR"),
+			leg("2000", valueobject.SideCredit, 80, "EUR"),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not balance in EUR")
+	})
+
+	t.Run("one side only", func(t *testing.T) {
+		err := validator.ValidateLegs([]valueobject.PostingLeg{
+			leg("1000", valueobject.SideDebit, 100, "USD"),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one debit leg and one credit leg")
+
+		err = validator.ValidateLegs(nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("account balanced only by itself", func(t *testing.T) {
+		err := validator.ValidateLegs([]valueobject.PostingLeg{
+			leg("1000", valueobject.SideDebit, 100, "USD"),
+			leg("1000", valueobject.SideCredit, 100, "USD"),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "only balanced by itself")
+	})
+}
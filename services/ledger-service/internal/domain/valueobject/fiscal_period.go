@@ -2,6 +2,8 @@ package valueobject
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -59,6 +61,36 @@ func (fp FiscalPeriod) Previous() FiscalPeriod {
 	return FiscalPeriod{year: fp.year, month: fp.month - 1}
 }
 
+// ParseReportingPeriod parses the period a report covers: a month
+// ("2026-03"), a quarter ("2026-Q1") or a year ("2026"). It returns the
+// first and last fiscal periods the report spans.
+func ParseReportingPeriod(s string) (first, last FiscalPeriod, err error) {
+	year, rest, _ := strings.Cut(s, "-")
+	y, err := strconv.Atoi(year)
+	if err != nil {
+		return FiscalPeriod{}, FiscalPeriod{}, fmt.Errorf("invalid reporting period %q: must be YYYY, YYYY-MM or YYYY-Qn", s)
+	}
+	firstMonth, lastMonth := time.January, time.December
+	switch {
+	case rest == "" && !strings.Contains(s, "-"):
+	case len(rest) == 2 && rest[0] == 'Q' && rest[1] >= '1' && rest[1] <= '4':
+		firstMonth = time.Month(int(rest[1]-'1')*3 + 1)
+		lastMonth = firstMonth + 2
+	case len(rest) == 2:
+		m, convErr := strconv.Atoi(rest)
+		if convErr != nil {
+			return FiscalPeriod{}, FiscalPeriod{}, fmt.Errorf("invalid reporting period %q: must be YYYY, YYYY-MM or YYYY-Qn", s)
+		}
+		firstMonth, lastMonth = time.Month(m), time.Month(m)
+	default:
+		return FiscalPeriod{}, FiscalPeriod{}, fmt.Errorf("invalid reporting period %q: must be YYYY, YYYY-MM or YYYY-Qn", s)
+	}
+	if first, err = NewFiscalPeriod(y, firstMonth); err != nil {
+		return FiscalPeriod{}, FiscalPeriod{}, err
+	}
+	return first, FiscalPeriod{year: y, month: lastMonth}, nil
+}
+
 // PeriodStatus tracks whether a fiscal period is open or closed.
 type PeriodStatus string
 
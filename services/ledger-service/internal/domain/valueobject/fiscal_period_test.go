@@ -187,3 +187,28 @@ func TestPeriodStatus_Constants(t *testing.T) {
 	assert.Equal(t, valueobject.PeriodStatus("OPEN"), valueobject.PeriodStatusOpen)
 	assert.Equal(t, valueobject.PeriodStatus("CLOSED"), valueobject.PeriodStatusClosed)
 }
+
+func TestParseReportingPeriod(t *testing.T) {
+	tests := []struct {
+		period      string
+		first, last string
+	}{
+		{period: "2026-03", first: "2026-03", last: "2026-03"},
+		{period: "2026-Q1", first: "2026-01", last: "2026-03"},
+		{period: "2026-Q4", first: "2026-10", last: "2026-12"},
+		{period: "2026", first: "2026-01", last: "2026-12"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.period, func(t *testing.T) {
+			first, last, err := valueobject.ParseReportingPeriod(tt.period)
+			require.NoError(t, err)
+			assert.Equal(t, tt.first, first.String())
+			assert.Equal(t, tt.last, last.String())
+		})
+	}
+
+	for _, period := range []string{"", "2026-", "2026-13", "2026-Q5", "2026-3", "1999-01", "March 2026"} {
+		_, _, err := valueobject.ParseReportingPeriod(period)
+		assert.Error(t, err, period)
+	}
+}
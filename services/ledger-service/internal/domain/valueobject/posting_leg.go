@@ -0,0 +1,222 @@
+package valueobject
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// PostingSide is the side of the ledger a leg is posted to.
+type PostingSide string
+
+const (
+	SideDebit  PostingSide = "DEBIT"
+	SideCredit PostingSide = "CREDIT"
+)
+
+// ParsePostingSide parses a side as stored and sent on the wire.
+func ParsePostingSide(s string) (PostingSide, error) {
+	switch side := PostingSide(s); side {
+	case SideDebit, SideCredit:
+		return side, nil
+	default:
+		return "", fmt.Errorf("posting side must be DEBIT or CREDIT, got %q", s)
+	}
+}
+
+// Opposite returns the other side.
+func (s PostingSide) Opposite() PostingSide {
+	if s == SideDebit {
+		return SideCredit
+	}
+	return SideDebit
+}
+
+// PostingLeg is one line of a journal entry: an amount debited or credited
+// to a single account. An entry has any number of legs, balanced per
+// currency. Immutable value object.
+type PostingLeg struct {
+	account     AccountCode
+	side        PostingSide
+	amount      decimal.Decimal
+	currency    string
+	description string
+}
+
+func NewPostingLeg(account AccountCode, side PostingSide, amount decimal.Decimal, currency, description string) (PostingLeg, error) {
+	if account.IsZero() {
+		return PostingLeg{}, fmt.Errorf("account code is required")
+	}
+	if _, err := ParsePostingSide(string(side)); err != nil {
+		return PostingLeg{}, err
+	}
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return PostingLeg{}, fmt.Errorf("posting amount must be positive, got %s", amount.String())
+	}
+	if currency == "" {
+		return PostingLeg{}, fmt.Errorf("currency is required")
+	}
+	return PostingLeg{
+		account:     account,
+		side:        side,
+		amount:      amount,
+		currency:    currency,
+		description: description,
+	}, nil
+}
+
+func (l PostingLeg) Account() AccountCode    { return l.account }
+func (l PostingLeg) Side() PostingSide       { return l.side }
+func (l PostingLeg) Amount() decimal.Decimal { return l.amount }
+func (l PostingLeg) Currency() string        { return l.currency }
+func (l PostingLeg) Description() string     { return l.description }
+func (l PostingLeg) IsDebit() bool           { return l.side == SideDebit }
+
+// Reversed returns the leg posted to the opposite side, as in a reversal
+// entry.
+func (l PostingLeg) Reversed(description string) PostingLeg {
+	l.side = l.side.Opposite()
+	l.description = description
+	return l
+}
+
+func (l PostingLeg) String() string {
+	side := "DR"
+	if !l.IsDebit() {
+		side = "CR"
+	}
+	return fmt.Sprintf("%s %s: %s %s", side, l.account, l.amount, l.currency)
+}
+
+// Legs returns the pair as its debit leg and credit leg.
+func (p PostingPair) Legs() []PostingLeg {
+	return []PostingLeg{
+		{account: p.debitAccount, side: SideDebit, amount: p.amount, currency: p.currency, description: p.description},
+		{account: p.creditAccount, side: SideCredit, amount: p.amount, currency: p.currency, description: p.description},
+	}
+}
+
+// LegsOf returns the legs of pairs, each pair's debit leg then its credit leg.
+func LegsOf(pairs []PostingPair) []PostingLeg {
+	legs := make([]PostingLeg, 0, 2*len(pairs))
+	for _, p := range pairs {
+		legs = append(legs, p.Legs()...)
+	}
+	return legs
+}
+
+// PairLegs matches balanced legs into posting pairs, for consumers that
+// follow account movements as debit/credit pairs. Debit legs are taken in
+// order and matched against the credit legs of their currency in order,
+// skipping credits to the debited account and credits that would leave
+// another account only balanced by itself, and splitting a leg across pairs
+// where amounts differ, so legs made from pairs by LegsOf are matched back
+// into the same pairs. It fails if the legs do not balance per currency or
+// an account's debits and credits together exceed the currency's total, as
+// they can then only be balanced by each other.
+func PairLegs(legs []PostingLeg) ([]PostingPair, error) {
+	credits := make(map[string][]PostingLeg)
+	books := make(map[string]*legBook)
+	for _, l := range legs {
+		b, ok := books[l.currency]
+		if !ok {
+			b = &legBook{load: make(map[string]decimal.Decimal)}
+			books[l.currency] = b
+		}
+		b.load[l.account.Code()] = b.load[l.account.Code()].Add(l.amount)
+		if l.IsDebit() {
+			b.left = b.left.Add(l.amount)
+		} else {
+			b.credited = b.credited.Add(l.amount)
+			credits[l.currency] = append(credits[l.currency], l)
+		}
+	}
+	for _, l := range legs {
+		b := books[l.currency]
+		switch {
+		case b.left.GreaterThan(b.credited):
+			return nil, fmt.Errorf("debits exceed credits in %s", l.currency)
+		case b.credited.GreaterThan(b.left):
+			return nil, fmt.Errorf("credits exceed debits in %s", l.currency)
+		case b.load[l.account.Code()].GreaterThan(b.left):
+			return nil, fmt.Errorf("account %s is only balanced by itself in %s", l.account, l.currency)
+		}
+	}
+
+	var pairs []PostingPair
+	for _, d := range legs {
+		if !d.IsDebit() {
+			continue
+		}
+		b := books[d.currency]
+		for remaining := d.amount; remaining.IsPositive(); {
+			queue := credits[d.currency]
+			i, amount := b.match(d.account, remaining, queue)
+			if i < 0 {
+				return nil, fmt.Errorf("account %s is only balanced by itself in %s", d.account, d.currency)
+			}
+			c := queue[i]
+			pair, err := NewPostingPair(d.account, c.account, amount, d.currency, d.description)
+			if err != nil {
+				return nil, err
+			}
+			pairs = append(pairs, pair)
+			b.take(d.account, c.account, amount)
+
+			remaining = remaining.Sub(amount)
+			if c.amount = c.amount.Sub(amount); c.amount.IsZero() {
+				queue = append(queue[:i:i], queue[i+1:]...)
+			} else {
+				queue[i] = c
+			}
+			credits[d.currency] = queue
+		}
+	}
+	return pairs, nil
+}
+
+// legBook tracks the legs of one currency: the total credited, the debits
+// left to match and each account's load, its debits and credits left to
+// match. The legs left can be matched into pairs as long as no account's
+// load exceeds left, since an account cannot be paired with itself.
+type legBook struct {
+	load     map[string]decimal.Decimal
+	left     decimal.Decimal
+	credited decimal.Decimal
+}
+
+// match returns the index in queue of the first credit a debit to account
+// can be paired with, and the amount to pair, at most remaining. The amount
+// is capped so that no other account's load exceeds left afterwards. It
+// returns -1 if no credit can be paired.
+func (b *legBook) match(account AccountCode, remaining decimal.Decimal, queue []PostingLeg) (int, decimal.Decimal) {
+	for i, c := range queue {
+		if c.account.Equal(account) {
+			continue
+		}
+		amount := decimal.Min(remaining, c.amount, b.left.Sub(b.maxLoadExcept(account, c.account)))
+		if amount.IsPositive() {
+			return i, amount
+		}
+	}
+	return -1, decimal.Zero
+}
+
+// maxLoadExcept returns the largest load of the accounts other than debit
+// and credit.
+func (b *legBook) maxLoadExcept(debit, credit AccountCode) decimal.Decimal {
+	highest := decimal.Zero
+	for code, load := range b.load {
+		if code != debit.Code() && code != credit.Code() && load.GreaterThan(highest) {
+			highest = load
+		}
+	}
+	return highest
+}
+
+// take records amount debited to debit and credited to credit as matched.
+func (b *legBook) take(debit, credit AccountCode, amount decimal.Decimal) {
+	b.load[debit.Code()] = b.load[debit.Code()].Sub(amount)
+	b.load[credit.Code()] = b.load[credit.Code()].Sub(amount)
+	b.left = b.left.Sub(amount)
+}
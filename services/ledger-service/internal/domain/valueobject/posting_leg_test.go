@@ -0,0 +1,182 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+func mustLeg(t *testing.T, code string, side valueobject.PostingSide, amount int64, currency string) valueobject.PostingLeg {
+	t.Helper()
+	l, err := valueobject.NewPostingLeg(valueobject.MustAccountCode(code), side, decimal.NewFromInt(amount), currency, "leg "+code)
+	require.NoError(t, err)
+	return l
+}
+
+func TestNewPostingLeg_Invalid(t *testing.T) {
+	account := valueobject.MustAccountCode("1000")
+
+	_, err := valueobject.NewPostingLeg(valueobject.AccountCode{}, valueobject.SideDebit, decimal.NewFromInt(1), "USD", "")
+	assert.Error(t, err)
+	_, err = valueobject.NewPostingLeg(account, "DR", decimal.NewFromInt(1), "USD", "")
+	assert.Error(t, err)
+	_, err = valueobject.NewPostingLeg(account, valueobject.SideCredit, decimal.Zero, "USD", "")
+	assert.Error(t, err)
+	_, err = valueobject.NewPostingLeg(account, valueobject.SideCredit, decimal.NewFromInt(1), "", "")
+	assert.Error(t, err)
+}
+
+func TestPairLegs(t *testing.T) {
+	t.Run("round-trips posting pairs", func(t *testing.T) {
+		pp1, err := valueobject.NewPostingPair(valueobject.MustAccountCode("1000"), valueobject.MustAccountCode("2000"), decimal.NewFromInt(10), "USD", "first")
+		require.NoError(t, err)
+		pp2, err := valueobject.NewPostingPair(valueobject.MustAccountCode("2000"), valueobject.MustAccountCode("1000"), decimal.NewFromInt(5), "EUR", "second")
+		require.NoError(t, err)
+		pp3, err := valueobject.NewPostingPair(valueobject.MustAccountCode("2000"), valueobject.MustAccountCode("1000"), decimal.NewFromInt(7), "USD", "third")
+		require.NoError(t, err)
+		pairs := []valueobject.PostingPair{pp1, pp2, pp3}
+
+		legs := valueobject.LegsOf(pairs)
+		require.Len(t, legs, 6)
+		assert.Equal(t, valueobject.SideDebit, legs[0].Side())
+		assert.Equal(t, valueobject.SideCredit, legs[1].Side())
+
+		got, err := valueobject.PairLegs(legs)
+		require.NoError(t, err)
+		assert.Equal(t, pairs, got)
+	})
+
+	t.Run("splits legs across pairs", func(t *testing.T) {
+		got, err := valueobject.PairLegs([]valueobject.PostingLeg{
+			mustLeg(t, "1000", valueobject.SideDebit, 60, "USD"),
+			mustLeg(t, "1100", valueobject.SideDebit, 40, "USD"),
+			mustLeg(t, "2000", valueobject.SideCredit, 70, "USD"),
+			mustLeg(t, "2100", valueobject.SideCredit, 30, "USD"),
+		})
+		require.NoError(t, err)
+
+		var lines []string
+		for _, p := range got {
+			lines = append(lines, p.String())
+		}
+		assert.Equal(t, []string{
+			"DR 1000 / CR 2000: 60 USD",
+			"DR 1100 / CR 2000: 10 USD",
+			"DR 1100 / CR 2100: 30 USD",
+		}, lines)
+	})
+
+	t.Run("skips credits to the debited account", func(t *testing.T) {
+		got, err := valueobject.PairLegs([]valueobject.PostingLeg{
+			mustLeg(t, "1000", valueobject.SideDebit, 10, "USD"),
+			mustLeg(t, "2000", valueobject.SideDebit, 10, "USD"),
+			mustLeg(t, "1000", valueobject.SideCredit, 10, "USD"),
+			mustLeg(t, "2000", valueobject.SideCredit, 10, "USD"),
+		})
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, "DR 1000 / CR 2000: 10 USD", got[0].String())
+		assert.Equal(t, "DR 2000 / CR 1000: 10 USD", got[1].String())
+	})
+
+	t.Run("matches balanced legs whatever their order", func(t *testing.T) {
+		tests := []struct {
+			name string
+			legs []valueobject.PostingLeg
+			want []string
+		}{
+			{
+				name: "credit to a later debited account",
+				legs: []valueobject.PostingLeg{
+					mustLeg(t, "1000", valueobject.SideDebit, 100, "USD"),
+					mustLeg(t, "1100", valueobject.SideDebit, 100, "USD"),
+					mustLeg(t, "2000", valueobject.SideCredit, 100, "USD"),
+					mustLeg(t, "1100", valueobject.SideCredit, 100, "USD"),
+				},
+				want: []string{
+					"DR 1000 / CR 1100: 100 USD",
+					"DR 1100 / CR 2000: 100 USD",
+				},
+			},
+			{
+				name: "account both debited and credited, split",
+				legs: []valueobject.PostingLeg{
+					mustLeg(t, "1000", valueobject.SideDebit, 50, "USD"),
+					mustLeg(t, "1100", valueobject.SideDebit, 100, "USD"),
+					mustLeg(t, "2000", valueobject.SideCredit, 100, "USD"),
+					mustLeg(t, "1100", valueobject.SideCredit, 50, "USD"),
+				},
+				want: []string{
+					"DR 1000 / CR 1100: 50 USD",
+					"DR 1100 / CR 2000: 100 USD",
+				},
+			},
+			{
+				name: "three accounts each debited and credited",
+				legs: []valueobject.PostingLeg{
+					mustLeg(t, "1000", valueobject.SideDebit, 10, "USD"),
+					mustLeg(t, "1100", valueobject.SideDebit, 10, "USD"),
+					mustLeg(t, "1200", valueobject.SideDebit, 10, "USD"),
+					mustLeg(t, "1100", valueobject.SideCredit, 10, "USD"),
+					mustLeg(t, "1000", valueobject.SideCredit, 10, "USD"),
+					mustLeg(t, "1200", valueobject.SideCredit, 10, "USD"),
+				},
+				want: []string{
+					"DR 1000 / CR 1100: 10 USD",
+					"DR 1100 / CR 1200: 10 USD",
+					"DR 1200 / CR 1000: 10 USD",
+				},
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				got, err := valueobject.PairLegs(tt.legs)
+				require.NoError(t, err)
+
+				var lines []string
+				for _, p := range got {
+					lines = append(lines, p.String())
+				}
+				assert.Equal(t, tt.want, lines)
+			})
+		}
+	})
+
+	t.Run("fails on an account only balanced by itself", func(t *testing.T) {
+		_, err := valueobject.PairLegs([]valueobject.PostingLeg{
+			mustLeg(t, "1000", valueobject.SideDebit, 100, "USD"),
+			mustLeg(t, "1100", valueobject.SideDebit, 10, "USD"),
+			mustLeg(t, "1000", valueobject.SideCredit, 100, "USD"),
+			mustLeg(t, "2000", valueobject.SideCredit, 10, "USD"),
+		})
+		assert.ErrorContains(t, err, "account 1000 is only balanced by itself")
+	})
+
+	t.Run("fails on unbalanced legs", func(t *testing.T) {
+		_, err := valueobject.PairLegs([]valueobject.PostingLeg{
+			mustLeg(t, "1000", valueobject.SideDebit, 10, "USD"),
+			mustLeg(t, "2000", valueobject.SideCredit, 9, "USD"),
+		})
+		assert.ErrorContains(t, err, "debits exceed credits")
+
+		_, err = valueobject.PairLegs([]valueobject.PostingLeg{
+			mustLeg(t, "1000", valueobject.SideDebit, 10, "USD"),
+			mustLeg(t, "2000", valueobject.SideCredit, 10, "EUR"),
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestPostingLeg_Reversed(t *testing.T) {
+	l := mustLeg(t, "1000", valueobject.SideDebit, 10, "USD")
+	r := l.Reversed("Reversal: leg 1000")
+
+	assert.Equal(t, valueobject.SideCredit, r.Side())
+	assert.Equal(t, "Reversal: leg 1000", r.Description())
+	assert.Equal(t, valueobject.SideDebit, l.Side(), "original is unchanged")
+	assert.Equal(t, "CR 1000: 10 USD", r.String())
+}
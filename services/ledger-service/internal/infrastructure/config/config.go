@@ -1,18 +1,25 @@
 package config
 
 import (
+	"fmt"
 	"math"
 	"os"
 	"strconv"
+	"time"
+
+	"github.com/bibbank/bib/pkg/archive"
 )
 
 // Config holds all service configuration loaded from environment variables.
 type Config struct {
+	Archive   archive.StoreConfig
 	Telemetry TelemetryConfig
+	Interest  InterestConfig
 	LogLevel  string
 	LogFormat string
 	Kafka     KafkaConfig
 	DB        DBConfig
+	Outbox    OutboxConfig
 	HTTPPort  int
 	GRPCPort  int
 }
@@ -29,7 +36,17 @@ type DBConfig struct {
 }
 
 type KafkaConfig struct {
-	Brokers []string
+	ConsumerGroup string
+	Brokers       []string
+}
+
+// InterestConfig holds the ledger accounts deposit and loan interest
+// accruals are posted to.
+type InterestConfig struct {
+	DepositExpenseAccount string
+	DepositPayableAccount string
+	LoanReceivableAccount string
+	LoanIncomeAccount     string
 }
 
 type TelemetryConfig struct {
@@ -44,6 +61,12 @@ func (c Config) Validate() {
 	}
 }
 
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
 // Load reads configuration from environment variables with defaults.
 func Load() Config {
 	return Config{
@@ -59,8 +82,23 @@ func Load() Config {
 			MaxConns: int32(min(getEnvInt("DB_MAX_CONNS", 20), math.MaxInt32)), // #nosec G115
 			MinConns: int32(min(getEnvInt("DB_MIN_CONNS", 5), math.MaxInt32)),  // #nosec G115
 		},
+		Archive: archive.StoreConfig{
+			Dir:         getEnv("ARCHIVE_DIR", ""),
+			S3Endpoint:  getEnv("ARCHIVE_S3_ENDPOINT", "https://s3.amazonaws.com"),
+			S3Region:    getEnv("ARCHIVE_S3_REGION", "us-east-1"),
+			S3Bucket:    getEnv("ARCHIVE_S3_BUCKET", ""),
+			S3AccessKey: getEnv("ARCHIVE_S3_ACCESS_KEY", ""),
+			S3SecretKey: getEnv("ARCHIVE_S3_SECRET_KEY", ""),
+		},
 		Kafka: KafkaConfig{
-			Brokers: []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+			Brokers:       []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+			ConsumerGroup: getEnv("KAFKA_CONSUMER_GROUP", "ledger-service"),
+		},
+		Interest: InterestConfig{
+			DepositExpenseAccount: getEnv("LEDGER_DEPOSIT_INTEREST_EXPENSE_ACCOUNT", "5100-001"),
+			DepositPayableAccount: getEnv("LEDGER_DEPOSIT_INTEREST_PAYABLE_ACCOUNT", "2010-001"),
+			LoanReceivableAccount: getEnv("LEDGER_LOAN_INTEREST_RECEIVABLE_ACCOUNT", "1210-001"),
+			LoanIncomeAccount:     getEnv("LEDGER_LOAN_INTEREST_INCOME_ACCOUNT", "4000-001"),
 		},
 		Telemetry: TelemetryConfig{
 			OTLPEndpoint: getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4317"),
@@ -68,6 +106,10 @@ func Load() Config {
 		},
 		LogLevel:  getEnv("LOG_LEVEL", "info"),
 		LogFormat: getEnv("LOG_FORMAT", "json"),
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
 	}
 }
 
@@ -86,3 +128,31 @@ func getEnvInt(key string, defaultVal int) int {
 	}
 	return defaultVal
 }
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
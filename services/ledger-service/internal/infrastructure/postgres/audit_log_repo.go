@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/port"
+)
+
+var _ port.AuditLogRepository = (*AuditLogRepo)(nil)
+
+// AuditLogRepo implements AuditLogRepository using PostgreSQL. Records are
+// appended by JournalRepo.Save; the table refuses updates and deletes.
+type AuditLogRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewAuditLogRepo(pool *pgxpool.Pool) *AuditLogRepo {
+	return &AuditLogRepo{pool: pool}
+}
+
+func (r *AuditLogRepo) ListAuditLog(ctx context.Context, tenantID uuid.UUID, from, to time.Time, afterSeq int64, limit int) ([]model.AuditRecord, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT seq, id, tenant_id, entry_id, action, from_status, to_status, entry_version, effective_date, actor_id, detail, occurred_at
+		FROM ledger_audit_log
+		WHERE tenant_id = $1 AND occurred_at >= $2 AND occurred_at < $3 AND seq > $4
+		ORDER BY seq
+		LIMIT $5
+	`, tenantID, from, to, afterSeq, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query audit log: %w", err)
+	}
+	defer rows.Close()
+
+	var records []model.AuditRecord
+	for rows.Next() {
+		var (
+			rec                        model.AuditRecord
+			action, fromStatus, status string
+		)
+		if err := rows.Scan(&rec.Sequence, &rec.ID, &rec.TenantID, &rec.EntryID, &action, &fromStatus, &status,
+			&rec.EntryVersion, &rec.EffectiveDate, &rec.ActorID, &rec.Detail, &rec.OccurredAt); err != nil {
+			return nil, fmt.Errorf("scan audit record: %w", err)
+		}
+		rec.Action = model.AuditAction(action)
+		rec.FromStatus = model.EntryStatus(fromStatus)
+		rec.ToStatus = model.EntryStatus(status)
+		records = append(records, rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate audit log: %w", err)
+	}
+	return records, nil
+}
@@ -49,3 +49,24 @@ func (r *BalanceRepo) UpdateBalance(ctx context.Context, accountCode valueobject
 	}
 	return nil
 }
+
+func (r *BalanceRepo) OpenAccount(ctx context.Context, accountCode valueobject.AccountCode, currency string) (decimal.Decimal, error) {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO account_balances (account_code, currency, balance, updated_at)
+		VALUES ($1, $2, 0, $3)
+		ON CONFLICT (account_code, currency) DO NOTHING
+	`, accountCode.Code(), currency, time.Now().UTC())
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("open account: %w", err)
+	}
+
+	var balance decimal.Decimal
+	err = r.pool.QueryRow(ctx, `
+		SELECT balance FROM account_balances
+		WHERE account_code = $1 AND currency = $2
+	`, accountCode.Code(), currency).Scan(&balance)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("read opened account balance: %w", err)
+	}
+	return balance, nil
+}
@@ -0,0 +1,247 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/port"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+var _ port.BalanceSnapshotRepository = (*BalanceSnapshotRepo)(nil)
+
+// windowBalancesSQL sums a tenant's balances over a window from the
+// snapshot at the end of a base period ($2, $3) and the postings effective
+// from the base period's end ($4) up to the window's end ($6). Postings
+// before the window's start ($5) count towards the opening balance, those
+// after towards the window's debits and credits. $7 and $8, when set,
+// restrict it to one account and currency.
+const windowBalancesSQL = `
+	SELECT account_code, currency, SUM(opening), SUM(debit), SUM(credit)
+	FROM (
+		SELECT account_code, currency, balance AS opening, 0 AS debit, 0 AS credit
+		FROM balance_snapshots
+		WHERE tenant_id = $1 AND year = $2 AND month = $3
+		UNION ALL
+		SELECT pl.account_code, pl.currency,
+			CASE WHEN je.effective_date >= $5 THEN 0 WHEN pl.side = 'DEBIT' THEN pl.amount ELSE -pl.amount END,
+			CASE WHEN je.effective_date >= $5 AND pl.side = 'DEBIT' THEN pl.amount ELSE 0 END,
+			CASE WHEN je.effective_date >= $5 AND pl.side = 'CREDIT' THEN pl.amount ELSE 0 END
+		FROM journal_entries je
+		JOIN posting_legs pl ON pl.entry_id = je.id AND pl.entry_created_at = je.created_at
+		WHERE je.tenant_id = $1
+		AND je.status IN ('POSTED', 'REVERSED')
+		AND je.effective_date >= $4 AND je.effective_date < $6
+	) m
+	WHERE ($7::text IS NULL OR (account_code = $7 AND currency = $8))
+	GROUP BY account_code, currency
+	ORDER BY account_code, currency`
+
+// BalanceSnapshotRepo implements BalanceSnapshotRepository using
+// PostgreSQL.
+type BalanceSnapshotRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewBalanceSnapshotRepo(pool *pgxpool.Pool) *BalanceSnapshotRepo {
+	return &BalanceSnapshotRepo{pool: pool}
+}
+
+func (r *BalanceSnapshotRepo) StaleSnapshots(ctx context.Context, before valueobject.FiscalPeriod) ([]model.SnapshotCursor, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT tenant_id, stale_from, version FROM balance_snapshot_cursors
+		WHERE stale_from < $1
+		ORDER BY tenant_id
+	`, before.StartDate())
+	if err != nil {
+		return nil, fmt.Errorf("query stale snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	var cursors []model.SnapshotCursor
+	for rows.Next() {
+		var (
+			c         model.SnapshotCursor
+			staleFrom time.Time
+		)
+		if err := rows.Scan(&c.TenantID, &staleFrom, &c.Version); err != nil {
+			return nil, fmt.Errorf("scan snapshot cursor: %w", err)
+		}
+		c.StaleFrom = valueobject.FiscalPeriodFromTime(staleFrom)
+		cursors = append(cursors, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate snapshot cursors: %w", err)
+	}
+	return cursors, nil
+}
+
+func (r *BalanceSnapshotRepo) SnapshotPeriod(ctx context.Context, tenantID uuid.UUID, period valueobject.FiscalPeriod) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	//nolint:errcheck
+	defer tx.Rollback(ctx)
+
+	balances, err := windowBalances(ctx, tx, tenantID, period.Previous(), period.StartDate(), period.Next().StartDate(), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		DELETE FROM balance_snapshots WHERE tenant_id = $1 AND year = $2 AND month = $3
+	`, tenantID, period.Year(), int(period.Month()))
+	if err != nil {
+		return fmt.Errorf("delete stale snapshot: %w", err)
+	}
+
+	now := time.Now().UTC()
+	for _, b := range balances {
+		closing := b.Closing()
+		if closing.IsZero() && b.Debit.IsZero() && b.Credit.IsZero() {
+			continue
+		}
+		_, err = tx.Exec(ctx, `
+			INSERT INTO balance_snapshots (tenant_id, year, month, account_code, currency, debit, credit, balance, taken_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`, tenantID, period.Year(), int(period.Month()), b.AccountCode.Code(), b.Currency,
+			b.Debit, b.Credit, closing, now)
+		if err != nil {
+			return fmt.Errorf("insert snapshot of %s %s: %w", b.AccountCode.Code(), b.Currency, err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *BalanceSnapshotRepo) AdvanceSnapshots(ctx context.Context, cursor model.SnapshotCursor, to valueobject.FiscalPeriod) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE balance_snapshot_cursors SET stale_from = $2
+		WHERE tenant_id = $1 AND version = $3 AND stale_from < $2
+	`, cursor.TenantID, to.StartDate(), cursor.Version)
+	if err != nil {
+		return false, fmt.Errorf("advance snapshot cursor: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *BalanceSnapshotRepo) BalanceBefore(ctx context.Context, tenantID uuid.UUID, account valueobject.AccountCode, currency string, before time.Time) (decimal.Decimal, error) {
+	// The last period to end by before is the one before before's own.
+	base, err := r.basePeriod(ctx, tenantID, valueobject.FiscalPeriodFromTime(before.UTC()).Previous())
+	if err != nil {
+		return decimal.Zero, err
+	}
+	// An empty window sums only the opening balance.
+	balances, err := windowBalances(ctx, r.pool, tenantID, base, before, before, &accountFilter{account: account, currency: currency})
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if len(balances) == 0 {
+		return decimal.Zero, nil
+	}
+	return balances[0].Opening, nil
+}
+
+func (r *BalanceSnapshotRepo) PeriodBalances(ctx context.Context, tenantID uuid.UUID, period valueobject.FiscalPeriod) ([]model.PeriodBalance, error) {
+	base, err := r.basePeriod(ctx, tenantID, period.Previous())
+	if err != nil {
+		return nil, err
+	}
+	return windowBalances(ctx, r.pool, tenantID, base, period.StartDate(), period.Next().StartDate(), nil)
+}
+
+// basePeriod returns the latest period, no later than latest, whose
+// snapshot of the tenant's balances is current.
+func (r *BalanceSnapshotRepo) basePeriod(ctx context.Context, tenantID uuid.UUID, latest valueobject.FiscalPeriod) (valueobject.FiscalPeriod, error) {
+	var staleFrom time.Time
+	err := r.pool.QueryRow(ctx, `
+		SELECT stale_from FROM balance_snapshot_cursors WHERE tenant_id = $1
+	`, tenantID).Scan(&staleFrom)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			// Nothing was ever posted for the tenant.
+			return latest, nil
+		}
+		return valueobject.FiscalPeriod{}, fmt.Errorf("get snapshot cursor: %w", err)
+	}
+	if current := valueobject.FiscalPeriodFromTime(staleFrom).Previous(); current.StartDate().Before(latest.StartDate()) {
+		return current, nil
+	}
+	return latest, nil
+}
+
+type accountFilter struct {
+	account  valueobject.AccountCode
+	currency string
+}
+
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// windowBalances sums a tenant's balances over [from, to) from the
+// snapshot at the end of base, which must be current and end by from.
+func windowBalances(ctx context.Context, q querier, tenantID uuid.UUID, base valueobject.FiscalPeriod, from, to time.Time, filter *accountFilter) ([]model.PeriodBalance, error) {
+	var account, currency *string
+	if filter != nil {
+		code := filter.account.Code()
+		account, currency = &code, &filter.currency
+	}
+	rows, err := q.Query(ctx, windowBalancesSQL, tenantID, base.Year(), int(base.Month()),
+		base.Next().StartDate(), from, to, account, currency)
+	if err != nil {
+		return nil, fmt.Errorf("query balances: %w", err)
+	}
+	defer rows.Close()
+
+	var balances []model.PeriodBalance
+	for rows.Next() {
+		var (
+			code string
+			b    model.PeriodBalance
+		)
+		if err := rows.Scan(&code, &b.Currency, &b.Opening, &b.Debit, &b.Credit); err != nil {
+			return nil, fmt.Errorf("scan balance: %w", err)
+		}
+		if b.AccountCode, err = valueobject.NewAccountCode(code); err != nil {
+			return nil, fmt.Errorf("invalid account code %q: %w", code, err)
+		}
+		balances = append(balances, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate balances: %w", err)
+	}
+	return balances, nil
+}
+
+// markSnapshotsStale records, in the transaction posting an entry, that
+// the entry makes its tenant's snapshots of its period and later stale
+// when it is backdated into an ended period. An entry in the current
+// period only makes sure the tenant's snapshots will be taken.
+func markSnapshotsStale(ctx context.Context, tx pgx.Tx, tenantID uuid.UUID, effectiveDate time.Time) error {
+	period := valueobject.FiscalPeriodFromTime(effectiveDate.UTC())
+	current := valueobject.FiscalPeriodFromTime(time.Now().UTC())
+
+	sql := `
+		INSERT INTO balance_snapshot_cursors (tenant_id, stale_from) VALUES ($1, $2)
+		ON CONFLICT (tenant_id) DO NOTHING`
+	if period.StartDate().Before(current.StartDate()) {
+		sql = `
+		INSERT INTO balance_snapshot_cursors (tenant_id, stale_from) VALUES ($1, $2)
+		ON CONFLICT (tenant_id) DO UPDATE SET
+			stale_from = LEAST(balance_snapshot_cursors.stale_from, EXCLUDED.stale_from),
+			version = balance_snapshot_cursors.version + 1`
+	}
+	if _, err := tx.Exec(ctx, sql, tenantID, period.StartDate()); err != nil {
+		return fmt.Errorf("mark balance snapshots stale: %w", err)
+	}
+	return nil
+}
@@ -2,19 +2,25 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
 	"github.com/bibbank/bib/services/ledger-service/internal/domain/port"
 	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
 )
 
 var _ port.FiscalPeriodRepository = (*FiscalPeriodRepo)(nil)
 
+// uniqueViolation is the PostgreSQL error code for a unique constraint violation.
+const uniqueViolation = "23505"
+
 type FiscalPeriodRepo struct {
 	pool *pgxpool.Pool
 }
@@ -38,9 +44,15 @@ func (r *FiscalPeriodRepo) GetPeriodStatus(ctx context.Context, tenantID uuid.UU
 	return valueobject.PeriodStatus(status), nil
 }
 
-func (r *FiscalPeriodRepo) ClosePeriod(ctx context.Context, tenantID uuid.UUID, period valueobject.FiscalPeriod) error {
+func (r *FiscalPeriodRepo) ClosePeriod(ctx context.Context, tenantID uuid.UUID, period valueobject.FiscalPeriod, balances []model.PeriodBalance) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }() //nolint:errcheck
+
 	now := time.Now().UTC()
-	_, err := r.pool.Exec(ctx, `
+	_, err = tx.Exec(ctx, `
 		INSERT INTO fiscal_periods (tenant_id, year, month, status, closed_at)
 		VALUES ($1, $2, $3, $4, $5)
 		ON CONFLICT (tenant_id, year, month) DO UPDATE SET
@@ -50,5 +62,57 @@ func (r *FiscalPeriodRepo) ClosePeriod(ctx context.Context, tenantID uuid.UUID,
 	if err != nil {
 		return fmt.Errorf("close period: %w", err)
 	}
-	return nil
+
+	// Freeze the period's balances, replacing any from an earlier close
+	_, err = tx.Exec(ctx, `
+		DELETE FROM period_balances WHERE tenant_id = $1 AND year = $2 AND month = $3
+	`, tenantID, period.Year(), int(period.Month()))
+	if err != nil {
+		return fmt.Errorf("delete period balances: %w", err)
+	}
+	for _, b := range balances {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO period_balances (tenant_id, year, month, account_code, currency, opening, debit, credit, closing, closed_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		`, tenantID, period.Year(), int(period.Month()), b.AccountCode.Code(), b.Currency,
+			b.Opening, b.Debit, b.Credit, b.Closing(), now)
+		if err != nil {
+			return fmt.Errorf("insert period balance of %s %s: %w", b.AccountCode.Code(), b.Currency, err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *FiscalPeriodRepo) ReopenPeriod(ctx context.Context, tenantID uuid.UUID, period valueobject.FiscalPeriod, approvalID uuid.UUID, reason string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }() //nolint:errcheck
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO fiscal_period_reopenings (approval_id, tenant_id, year, month, reason, reopened_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, approvalID, tenantID, period.Year(), int(period.Month()), reason, time.Now().UTC())
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return port.ErrApprovalUsed
+		}
+		return fmt.Errorf("record period reopening: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE fiscal_periods SET status = $4, closed_at = NULL
+		WHERE tenant_id = $1 AND year = $2 AND month = $3 AND status = $5
+	`, tenantID, period.Year(), int(period.Month()),
+		string(valueobject.PeriodStatusOpen), string(valueobject.PeriodStatusClosed))
+	if err != nil {
+		return fmt.Errorf("reopen period: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return port.ErrPeriodNotClosed
+	}
+	return tx.Commit(ctx)
 }
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/port"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+var _ port.InterestAccrualRepository = (*InterestAccrualRepo)(nil)
+
+// InterestAccrualRepo implements InterestAccrualRepository using PostgreSQL.
+type InterestAccrualRepo struct {
+	pool *pgxpool.Pool
+}
+
+func NewInterestAccrualRepo(pool *pgxpool.Pool) *InterestAccrualRepo {
+	return &InterestAccrualRepo{pool: pool}
+}
+
+func (r *InterestAccrualRepo) Exists(ctx context.Context, sourceID uuid.UUID) (bool, error) {
+	var exists bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM interest_accruals WHERE source_id = $1)
+	`, sourceID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("check interest accrual: %w", err)
+	}
+	return exists, nil
+}
+
+func (r *InterestAccrualRepo) Save(ctx context.Context, accrual model.InterestAccrual) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO interest_accruals (source_id, tenant_id, instrument_id, kind, amount, posted, currency, accrued_on, entry_id, recorded_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (source_id) DO NOTHING
+	`, accrual.SourceID(), accrual.TenantID(), nullUUID(accrual.InstrumentID()), string(accrual.Kind()),
+		accrual.Amount(), accrual.Posted(), accrual.Currency(), accrual.AccruedOn(),
+		nullUUID(accrual.EntryID()), accrual.RecordedAt())
+	if err != nil {
+		return fmt.Errorf("save interest accrual: %w", err)
+	}
+	return nil
+}
+
+func (r *InterestAccrualRepo) PeriodTotals(ctx context.Context, tenantID uuid.UUID, period valueobject.FiscalPeriod) ([]model.InterestAccrualTotal, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT kind, currency, SUM(amount), SUM(posted)
+		FROM interest_accruals
+		WHERE tenant_id = $1 AND accrued_on BETWEEN $2 AND $3
+		GROUP BY kind, currency
+		ORDER BY kind, currency
+	`, tenantID, period.StartDate(), period.EndDate())
+	if err != nil {
+		return nil, fmt.Errorf("total interest accruals: %w", err)
+	}
+	defer rows.Close()
+
+	var totals []model.InterestAccrualTotal
+	for rows.Next() {
+		var (
+			t    model.InterestAccrualTotal
+			kind string
+		)
+		if err := rows.Scan(&kind, &t.Currency, &t.Accrued, &t.Posted); err != nil {
+			return nil, fmt.Errorf("scan interest accrual total: %w", err)
+		}
+		t.Kind = model.InterestKind(kind)
+		totals = append(totals, t)
+	}
+	return totals, rows.Err()
+}
+
+// nullUUID stores the nil UUID as NULL.
+func nullUUID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
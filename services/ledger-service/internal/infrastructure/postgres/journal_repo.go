@@ -17,7 +17,10 @@ import (
 )
 
 // Compile-time interface check
-var _ port.JournalRepository = (*JournalRepo)(nil)
+var (
+	_ port.JournalRepository      = (*JournalRepo)(nil)
+	_ port.TrialBalanceRepository = (*JournalRepo)(nil)
+)
 
 // JournalRepo implements JournalRepository using PostgreSQL.
 type JournalRepo struct {
@@ -28,6 +31,38 @@ func NewJournalRepo(pool *pgxpool.Pool) *JournalRepo {
 	return &JournalRepo{pool: pool}
 }
 
+// appendAudit appends records to the tenant's audit log. Their seqs are
+// taken from the tenant's counter row, which stays locked until tx ends, so
+// the tenant's records commit in seq order.
+func appendAudit(ctx context.Context, tx pgx.Tx, tenantID uuid.UUID, records []model.AuditRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	var last int64
+	err := tx.QueryRow(ctx, `
+		INSERT INTO ledger_audit_seq (tenant_id, last_seq) VALUES ($1, $2)
+		ON CONFLICT (tenant_id) DO UPDATE SET last_seq = ledger_audit_seq.last_seq + EXCLUDED.last_seq
+		RETURNING last_seq
+	`, tenantID, len(records)).Scan(&last)
+	if err != nil {
+		return fmt.Errorf("allocate audit seq: %w", err)
+	}
+
+	seq := last - int64(len(records))
+	for _, rec := range records {
+		seq++
+		_, err = tx.Exec(ctx, `
+			INSERT INTO ledger_audit_log (tenant_id, seq, id, entry_id, action, from_status, to_status, entry_version, effective_date, actor_id, detail, occurred_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		`, rec.TenantID, seq, rec.ID, rec.EntryID, string(rec.Action), string(rec.FromStatus), string(rec.ToStatus),
+			rec.EntryVersion, rec.EffectiveDate, rec.ActorID, rec.Detail, rec.OccurredAt)
+		if err != nil {
+			return fmt.Errorf("insert audit record: %w", err)
+		}
+	}
+	return nil
+}
+
 func (r *JournalRepo) Save(ctx context.Context, entry model.JournalEntry) error {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
@@ -36,11 +71,29 @@ func (r *JournalRepo) Save(ctx context.Context, entry model.JournalEntry) error
 	//nolint:errcheck
 	defer tx.Rollback(ctx)
 
+	// Take the reference, unless another entry has it. Of concurrent saves
+	// under one reference, the later waits for the earlier to commit.
+	if entry.Reference() != "" {
+		var owner uuid.UUID
+		err = tx.QueryRow(ctx, `
+			INSERT INTO journal_entry_references (tenant_id, reference, entry_id)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (tenant_id, reference) DO UPDATE SET entry_id = journal_entry_references.entry_id
+			RETURNING entry_id
+		`, entry.TenantID(), entry.Reference(), entry.ID()).Scan(&owner)
+		if err != nil {
+			return fmt.Errorf("take entry reference: %w", err)
+		}
+		if owner != entry.ID() {
+			return fmt.Errorf("%w: %s", port.ErrDuplicateReference, entry.Reference())
+		}
+	}
+
 	// Upsert journal entry
 	_, err = tx.Exec(ctx, `
 		INSERT INTO journal_entries (id, tenant_id, effective_date, status, description, reference, version, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-		ON CONFLICT (id) DO UPDATE SET
+		ON CONFLICT (id, created_at) DO UPDATE SET
 			effective_date = EXCLUDED.effective_date,
 			status = EXCLUDED.status,
 			description = EXCLUDED.description,
@@ -52,21 +105,33 @@ func (r *JournalRepo) Save(ctx context.Context, entry model.JournalEntry) error
 		return fmt.Errorf("upsert journal entry: %w", err)
 	}
 
-	// Delete existing postings (for upsert scenario)
-	_, err = tx.Exec(ctx, `DELETE FROM posting_pairs WHERE entry_id = $1`, entry.ID())
+	// Delete existing legs (for upsert scenario)
+	_, err = tx.Exec(ctx, `DELETE FROM posting_legs WHERE entry_id = $1 AND entry_created_at = $2`, entry.ID(), entry.CreatedAt())
 	if err != nil {
-		return fmt.Errorf("delete existing postings: %w", err)
+		return fmt.Errorf("delete existing legs: %w", err)
 	}
 
-	// Insert posting pairs
-	for i, p := range entry.Postings() {
+	// Insert posting legs
+	for i, l := range entry.Legs() {
 		_, err = tx.Exec(ctx, `
-			INSERT INTO posting_pairs (entry_id, debit_account, credit_account, amount, currency, description, seq_num)
-			VALUES ($1, $2, $3, $4, $5, $6, $7)
-		`, entry.ID(), p.DebitAccount().Code(), p.CreditAccount().Code(),
-			p.Amount(), p.Currency(), p.Description(), i+1)
+			INSERT INTO posting_legs (entry_id, entry_created_at, account_code, side, amount, currency, description, seq_num)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`, entry.ID(), entry.CreatedAt(), l.Account().Code(), string(l.Side()),
+			l.Amount(), l.Currency(), l.Description(), i+1)
 		if err != nil {
-			return fmt.Errorf("insert posting pair %d: %w", i, err)
+			return fmt.Errorf("insert posting leg %d: %w", i, err)
+		}
+	}
+
+	// Append the entry's transitions to the audit log
+	if err := appendAudit(ctx, tx, entry.TenantID(), entry.PendingAudit()); err != nil {
+		return err
+	}
+
+	// Backdated postings make the balance snapshots they fall in stale
+	if entry.Status() == model.EntryStatusPosted {
+		if err := markSnapshotsStale(ctx, tx, entry.TenantID(), entry.EffectiveDate()); err != nil {
+			return err
 		}
 	}
 
@@ -107,46 +172,60 @@ func (r *JournalRepo) FindByID(ctx context.Context, id uuid.UUID) (model.Journal
 	`, id).Scan(&entryID, &tenantID, &effectiveDate, &status, &description, &reference, &version, &createdAt, &updatedAt)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return model.JournalEntry{}, fmt.Errorf("journal entry %s not found", id)
+			return model.JournalEntry{}, fmt.Errorf("%w: %s", port.ErrEntryNotFound, id)
 		}
 		return model.JournalEntry{}, fmt.Errorf("query journal entry: %w", err)
 	}
 
-	// Query posting pairs
+	// Query posting legs
 	rows, err := r.pool.Query(ctx, `
-		SELECT debit_account, credit_account, amount, currency, description
-		FROM posting_pairs WHERE entry_id = $1 ORDER BY seq_num
-	`, id)
+		SELECT account_code, side, amount, currency, description
+		FROM posting_legs WHERE entry_id = $1 AND entry_created_at = $2 ORDER BY seq_num
+	`, id, createdAt)
 	if err != nil {
-		return model.JournalEntry{}, fmt.Errorf("query posting pairs: %w", err)
+		return model.JournalEntry{}, fmt.Errorf("query posting legs: %w", err)
 	}
 	defer rows.Close()
 
-	var postings []valueobject.PostingPair
+	var legs []valueobject.PostingLeg
 	for rows.Next() {
 		var (
-			debitStr, creditStr, currency, desc string
-			amount                              decimal.Decimal
+			codeStr, sideStr, currency, desc string
+			amount                           decimal.Decimal
 		)
-		if err := rows.Scan(&debitStr, &creditStr, &amount, &currency, &desc); err != nil {
-			return model.JournalEntry{}, fmt.Errorf("scan posting pair: %w", err)
+		if err := rows.Scan(&codeStr, &sideStr, &amount, &currency, &desc); err != nil {
+			return model.JournalEntry{}, fmt.Errorf("scan posting leg: %w", err)
 		}
-		debit, debitErr := valueobject.NewAccountCode(debitStr)
-		if debitErr != nil {
-			return model.JournalEntry{}, fmt.Errorf("invalid debit account code %q: %w", debitStr, debitErr)
+		code, codeErr := valueobject.NewAccountCode(codeStr)
+		if codeErr != nil {
+			return model.JournalEntry{}, fmt.Errorf("invalid account code %q: %w", codeStr, codeErr)
 		}
-		credit, creditErr := valueobject.NewAccountCode(creditStr)
-		if creditErr != nil {
-			return model.JournalEntry{}, fmt.Errorf("invalid credit account code %q: %w", creditStr, creditErr)
+		side, sideErr := valueobject.ParsePostingSide(sideStr)
+		if sideErr != nil {
+			return model.JournalEntry{}, fmt.Errorf("invalid posting leg: %w", sideErr)
 		}
-		pair, pairErr := valueobject.NewPostingPair(debit, credit, amount, currency, desc)
-		if pairErr != nil {
-			return model.JournalEntry{}, fmt.Errorf("invalid posting pair: %w", pairErr)
+		leg, legErr := valueobject.NewPostingLeg(code, side, amount, currency, desc)
+		if legErr != nil {
+			return model.JournalEntry{}, fmt.Errorf("invalid posting leg: %w", legErr)
 		}
-		postings = append(postings, pair)
+		legs = append(legs, leg)
 	}
 
-	return model.Reconstruct(entryID, tenantID, effectiveDate, postings, model.EntryStatus(status), description, reference, version, createdAt, updatedAt), nil
+	return model.Reconstruct(entryID, tenantID, effectiveDate, legs, model.EntryStatus(status), description, reference, version, createdAt, updatedAt), nil
+}
+
+func (r *JournalRepo) FindByReference(ctx context.Context, tenantID uuid.UUID, reference string) (model.JournalEntry, error) {
+	var entryID uuid.UUID
+	err := r.pool.QueryRow(ctx, `
+		SELECT entry_id FROM journal_entry_references WHERE tenant_id = $1 AND reference = $2
+	`, tenantID, reference).Scan(&entryID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return model.JournalEntry{}, fmt.Errorf("%w: reference %s", port.ErrEntryNotFound, reference)
+		}
+		return model.JournalEntry{}, fmt.Errorf("query entry reference: %w", err)
+	}
+	return r.FindByID(ctx, entryID)
 }
 
 func (r *JournalRepo) ListByAccount(ctx context.Context, tenantID uuid.UUID, accountCode valueobject.AccountCode, from, to time.Time, limit, offset int) ([]model.JournalEntry, int, error) {
@@ -154,9 +233,9 @@ func (r *JournalRepo) ListByAccount(ctx context.Context, tenantID uuid.UUID, acc
 	var total int
 	err := r.pool.QueryRow(ctx, `
 		SELECT COUNT(DISTINCT je.id) FROM journal_entries je
-		JOIN posting_pairs pp ON pp.entry_id = je.id
+		JOIN posting_legs pl ON pl.entry_id = je.id AND pl.entry_created_at = je.created_at
 		WHERE je.tenant_id = $1
-		AND (pp.debit_account = $2 OR pp.credit_account = $2)
+		AND pl.account_code = $2
 		AND je.effective_date >= $3 AND je.effective_date <= $4
 	`, tenantID, accountCode.Code(), from, to).Scan(&total)
 	if err != nil {
@@ -166,9 +245,9 @@ func (r *JournalRepo) ListByAccount(ctx context.Context, tenantID uuid.UUID, acc
 	// Query entries
 	rows, err := r.pool.Query(ctx, `
 		SELECT DISTINCT je.id FROM journal_entries je
-		JOIN posting_pairs pp ON pp.entry_id = je.id
+		JOIN posting_legs pl ON pl.entry_id = je.id AND pl.entry_created_at = je.created_at
 		WHERE je.tenant_id = $1
-		AND (pp.debit_account = $2 OR pp.credit_account = $2)
+		AND pl.account_code = $2
 		AND je.effective_date >= $3 AND je.effective_date <= $4
 		ORDER BY je.id
 		LIMIT $5 OFFSET $6
@@ -240,3 +319,41 @@ func (r *JournalRepo) ListByTenant(ctx context.Context, tenantID uuid.UUID, from
 
 	return entries, total, nil
 }
+
+func (r *JournalRepo) TrialBalance(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]model.TrialBalanceLine, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT pl.account_code, pl.currency,
+			SUM(CASE WHEN pl.side = 'DEBIT' THEN pl.amount ELSE 0 END),
+			SUM(CASE WHEN pl.side = 'CREDIT' THEN pl.amount ELSE 0 END)
+		FROM journal_entries je
+		JOIN posting_legs pl ON pl.entry_id = je.id AND pl.entry_created_at = je.created_at
+		WHERE je.tenant_id = $1
+		AND je.status IN ('POSTED', 'REVERSED')
+		AND je.effective_date >= $2 AND je.effective_date < $3
+		GROUP BY pl.account_code, pl.currency
+		ORDER BY pl.account_code, pl.currency
+	`, tenantID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("query trial balance: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []model.TrialBalanceLine
+	for rows.Next() {
+		var (
+			code string
+			line model.TrialBalanceLine
+		)
+		if err := rows.Scan(&code, &line.Currency, &line.Debit, &line.Credit); err != nil {
+			return nil, fmt.Errorf("scan trial balance line: %w", err)
+		}
+		if line.AccountCode, err = valueobject.NewAccountCode(code); err != nil {
+			return nil, fmt.Errorf("invalid account code %q: %w", code, err)
+		}
+		lines = append(lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate trial balance: %w", err)
+	}
+	return lines, nil
+}
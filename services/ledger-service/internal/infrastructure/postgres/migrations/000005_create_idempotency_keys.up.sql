@@ -0,0 +1,13 @@
+-- Idempotency keys of retryable gRPC methods and their stored responses.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    scope           VARCHAR(255) NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    fingerprint     BYTEA NOT NULL,
+    response        BYTEA,
+    completed       BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at      TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (scope, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
@@ -0,0 +1,58 @@
+-- Restore unpartitioned journal entries and posting pairs, bringing back
+-- the rows of every attached partition. Archived partitions are left in
+-- the archive schema.
+
+CREATE TABLE journal_entries_unpartitioned (
+    id              UUID PRIMARY KEY,
+    tenant_id       UUID NOT NULL,
+    effective_date  TIMESTAMPTZ NOT NULL,
+    status          VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    description     TEXT NOT NULL DEFAULT '',
+    reference       VARCHAR(255) NOT NULL DEFAULT '',
+    version         INT NOT NULL DEFAULT 1,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE posting_pairs_unpartitioned (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entry_id        UUID NOT NULL REFERENCES journal_entries_unpartitioned(id),
+    debit_account   VARCHAR(10) NOT NULL,
+    credit_account  VARCHAR(10) NOT NULL,
+    amount          NUMERIC(19,4) NOT NULL,
+    currency        VARCHAR(3) NOT NULL,
+    description     TEXT NOT NULL DEFAULT '',
+    seq_num         INT NOT NULL,
+    CONSTRAINT chk_positive_amount CHECK (amount > 0),
+    CONSTRAINT chk_different_accounts CHECK (debit_account != credit_account)
+);
+
+INSERT INTO journal_entries_unpartitioned
+SELECT id, tenant_id, effective_date, status, description, reference, version, created_at, updated_at
+FROM journal_entries;
+
+INSERT INTO posting_pairs_unpartitioned
+SELECT id, entry_id, debit_account, credit_account, amount, currency, description, seq_num
+FROM posting_pairs;
+
+DROP TABLE posting_pairs;
+DROP TABLE journal_entries;
+
+ALTER TABLE journal_entries_unpartitioned RENAME TO journal_entries;
+ALTER TABLE posting_pairs_unpartitioned RENAME TO posting_pairs;
+ALTER TABLE journal_entries RENAME CONSTRAINT journal_entries_unpartitioned_pkey TO journal_entries_pkey;
+ALTER TABLE posting_pairs RENAME CONSTRAINT posting_pairs_unpartitioned_pkey TO posting_pairs_pkey;
+ALTER TABLE posting_pairs RENAME CONSTRAINT posting_pairs_unpartitioned_entry_id_fkey TO posting_pairs_entry_id_fkey;
+
+CREATE INDEX idx_journal_entries_tenant ON journal_entries (tenant_id);
+CREATE INDEX idx_journal_entries_effective_date ON journal_entries (effective_date);
+CREATE INDEX idx_journal_entries_status ON journal_entries (status);
+CREATE INDEX idx_journal_entries_reference ON journal_entries (reference);
+
+CREATE INDEX idx_posting_pairs_entry ON posting_pairs (entry_id);
+CREATE INDEX idx_posting_pairs_debit ON posting_pairs (debit_account);
+CREATE INDEX idx_posting_pairs_credit ON posting_pairs (credit_account);
+
+ALTER TABLE journal_entries ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON journal_entries
+    USING (tenant_id::text = current_setting('app.tenant_id'));
@@ -0,0 +1,96 @@
+-- Partition journal entries and their posting pairs by month of creation,
+-- so that months past retention can be archived without deleting rows.
+-- Posting pairs carry their entry's created_at so both tables partition
+-- on the same months and the foreign key can include the partition key.
+--
+-- The primary keys include created_at, as Postgres requires of a
+-- partitioned table; IDs are still generated as UUIDs and looked up through
+-- idx_journal_entries_id.
+--
+-- Partitions covering existing rows and the next three months are created
+-- here; the partition manager creates later months ahead of time. The
+-- DEFAULT partitions only catch rows if it falls behind.
+
+CREATE TABLE journal_entries_partitioned (
+    id              UUID NOT NULL,
+    tenant_id       UUID NOT NULL,
+    effective_date  TIMESTAMPTZ NOT NULL,
+    status          VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    description     TEXT NOT NULL DEFAULT '',
+    reference       VARCHAR(255) NOT NULL DEFAULT '',
+    version         INT NOT NULL DEFAULT 1,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id, created_at)
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE posting_pairs_partitioned (
+    id                UUID NOT NULL DEFAULT gen_random_uuid(),
+    entry_id          UUID NOT NULL,
+    entry_created_at  TIMESTAMPTZ NOT NULL,
+    debit_account     VARCHAR(10) NOT NULL,
+    credit_account    VARCHAR(10) NOT NULL,
+    amount            NUMERIC(19,4) NOT NULL,
+    currency          VARCHAR(3) NOT NULL,
+    description       TEXT NOT NULL DEFAULT '',
+    seq_num           INT NOT NULL,
+    PRIMARY KEY (id, entry_created_at),
+    CONSTRAINT chk_positive_amount CHECK (amount > 0),
+    CONSTRAINT chk_different_accounts CHECK (debit_account != credit_account)
+) PARTITION BY RANGE (entry_created_at);
+
+CREATE TABLE journal_entries_default PARTITION OF journal_entries_partitioned DEFAULT;
+CREATE TABLE posting_pairs_default PARTITION OF posting_pairs_partitioned DEFAULT;
+
+-- Monthly partitions, with UTC bounds to match the partition manager.
+DO $$
+DECLARE
+    m TIMESTAMP;
+BEGIN
+    FOR m IN
+        SELECT generate_series(
+            date_trunc('month', LEAST(COALESCE(MIN(created_at), NOW()), NOW()) AT TIME ZONE 'UTC'),
+            date_trunc('month', NOW() AT TIME ZONE 'UTC') + INTERVAL '3 months',
+            INTERVAL '1 month')
+        FROM journal_entries
+    LOOP
+        EXECUTE format('CREATE TABLE %I PARTITION OF journal_entries_partitioned FOR VALUES FROM (%L) TO (%L)',
+            'journal_entries_p' || to_char(m, 'YYYYMM'), m || '+00', (m + INTERVAL '1 month') || '+00');
+        EXECUTE format('CREATE TABLE %I PARTITION OF posting_pairs_partitioned FOR VALUES FROM (%L) TO (%L)',
+            'posting_pairs_p' || to_char(m, 'YYYYMM'), m || '+00', (m + INTERVAL '1 month') || '+00');
+    END LOOP;
+END $$;
+
+INSERT INTO journal_entries_partitioned (id, tenant_id, effective_date, status, description, reference, version, created_at, updated_at)
+SELECT id, tenant_id, effective_date, status, description, reference, version, created_at, updated_at
+FROM journal_entries;
+
+INSERT INTO posting_pairs_partitioned (id, entry_id, entry_created_at, debit_account, credit_account, amount, currency, description, seq_num)
+SELECT p.id, p.entry_id, e.created_at, p.debit_account, p.credit_account, p.amount, p.currency, p.description, p.seq_num
+FROM posting_pairs p
+JOIN journal_entries e ON e.id = p.entry_id;
+
+DROP TABLE posting_pairs;
+DROP TABLE journal_entries;
+
+ALTER TABLE journal_entries_partitioned RENAME TO journal_entries;
+ALTER TABLE posting_pairs_partitioned RENAME TO posting_pairs;
+ALTER TABLE journal_entries RENAME CONSTRAINT journal_entries_partitioned_pkey TO journal_entries_pkey;
+ALTER TABLE posting_pairs RENAME CONSTRAINT posting_pairs_partitioned_pkey TO posting_pairs_pkey;
+
+ALTER TABLE posting_pairs
+    ADD CONSTRAINT posting_pairs_entry_fkey FOREIGN KEY (entry_id, entry_created_at) REFERENCES journal_entries (id, created_at);
+
+CREATE INDEX idx_journal_entries_id ON journal_entries (id);
+CREATE INDEX idx_journal_entries_tenant ON journal_entries (tenant_id);
+CREATE INDEX idx_journal_entries_effective_date ON journal_entries (effective_date);
+CREATE INDEX idx_journal_entries_status ON journal_entries (status);
+CREATE INDEX idx_journal_entries_reference ON journal_entries (reference);
+
+CREATE INDEX idx_posting_pairs_entry ON posting_pairs (entry_id);
+CREATE INDEX idx_posting_pairs_debit ON posting_pairs (debit_account);
+CREATE INDEX idx_posting_pairs_credit ON posting_pairs (credit_account);
+
+ALTER TABLE journal_entries ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON journal_entries
+    USING (tenant_id::text = current_setting('app.tenant_id'));
@@ -0,0 +1,17 @@
+-- Interest accruals recognized from deposit and loan accrual events, and
+-- the period-end true-ups of their rounding. source_id is the accrual
+-- event's ID, or the true-up's, so each is recognized once.
+CREATE TABLE IF NOT EXISTS interest_accruals (
+    source_id       UUID PRIMARY KEY,
+    tenant_id       UUID NOT NULL,
+    instrument_id   UUID,
+    kind            VARCHAR(20) NOT NULL,
+    amount          NUMERIC(19,4) NOT NULL,
+    posted          NUMERIC(19,4) NOT NULL,
+    currency        VARCHAR(3) NOT NULL,
+    accrued_on      DATE NOT NULL,
+    entry_id        UUID,
+    recorded_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_interest_accruals_tenant_accrued_on ON interest_accruals (tenant_id, accrued_on);
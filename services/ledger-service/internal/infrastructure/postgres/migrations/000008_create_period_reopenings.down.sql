@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS fiscal_period_reopenings;
+DROP TABLE IF EXISTS approval_requests;
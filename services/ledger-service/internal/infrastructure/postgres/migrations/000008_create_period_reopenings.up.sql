@@ -0,0 +1,41 @@
+-- Maker-checker requests, such as reopening a closed fiscal period. See
+-- pkg/approval.
+CREATE TABLE IF NOT EXISTS approval_requests (
+    id          UUID PRIMARY KEY,
+    tenant_id   UUID NOT NULL,
+    action      VARCHAR(100) NOT NULL,
+    subject     VARCHAR(255) NOT NULL DEFAULT '',
+    payload     JSONB NOT NULL,
+    reason      TEXT NOT NULL DEFAULT '',
+    maker_id    UUID NOT NULL,
+    checker_id  UUID,
+    comment     TEXT NOT NULL DEFAULT '',
+    status      VARCHAR(20) NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL,
+    expires_at  TIMESTAMPTZ NOT NULL,
+    decided_at  TIMESTAMPTZ
+);
+
+CREATE INDEX idx_approval_requests_pending
+    ON approval_requests (tenant_id, action, created_at DESC) WHERE status = 'PENDING';
+
+-- Each reopening of a closed fiscal period, keyed by the approved request it
+-- was made under so a request reopens a period only once.
+CREATE TABLE IF NOT EXISTS fiscal_period_reopenings (
+    approval_id UUID PRIMARY KEY,
+    tenant_id   UUID NOT NULL,
+    year        INT NOT NULL,
+    month       INT NOT NULL,
+    reason      TEXT NOT NULL DEFAULT '',
+    reopened_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_fiscal_period_reopenings_period ON fiscal_period_reopenings (tenant_id, year, month);
+
+ALTER TABLE approval_requests ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON approval_requests
+    USING (tenant_id::text = current_setting('app.tenant_id'));
+
+ALTER TABLE fiscal_period_reopenings ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON fiscal_period_reopenings
+    USING (tenant_id::text = current_setting('app.tenant_id'));
@@ -0,0 +1,67 @@
+-- Restore posting pairs from posting legs. Only entries written as pairs,
+-- a debit leg followed by a credit leg of the same amount and currency,
+-- can be restored; the migration fails if any other entry exists.
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM posting_legs d
+        LEFT JOIN posting_legs c
+            ON c.entry_id = d.entry_id AND c.entry_created_at = d.entry_created_at
+            AND c.seq_num = d.seq_num + 1 AND c.side = 'CREDIT'
+            AND c.amount = d.amount AND c.currency = d.currency
+            AND c.account_code != d.account_code
+        WHERE d.side = 'DEBIT' AND (d.seq_num % 2 = 0 OR c.id IS NULL)
+    ) OR EXISTS (
+        SELECT 1 FROM posting_legs WHERE side = 'CREDIT' AND seq_num % 2 = 1
+    ) THEN
+        RAISE EXCEPTION 'posting_legs holds multi-leg journal entries that cannot be restored as posting pairs';
+    END IF;
+END $$;
+
+CREATE TABLE posting_pairs (
+    id                UUID NOT NULL DEFAULT gen_random_uuid(),
+    entry_id          UUID NOT NULL,
+    entry_created_at  TIMESTAMPTZ NOT NULL,
+    debit_account     VARCHAR(10) NOT NULL,
+    credit_account    VARCHAR(10) NOT NULL,
+    amount            NUMERIC(19,4) NOT NULL,
+    currency          VARCHAR(3) NOT NULL,
+    description       TEXT NOT NULL DEFAULT '',
+    seq_num           INT NOT NULL,
+    PRIMARY KEY (id, entry_created_at),
+    CONSTRAINT chk_positive_amount CHECK (amount > 0),
+    CONSTRAINT chk_different_accounts CHECK (debit_account != credit_account)
+) PARTITION BY RANGE (entry_created_at);
+
+DO $$
+DECLARE
+    part RECORD;
+BEGIN
+    FOR part IN
+        SELECT c.relname, pg_get_expr(c.relpartbound, c.oid) AS bound
+        FROM pg_inherits i
+        JOIN pg_class c ON c.oid = i.inhrelid
+        WHERE i.inhparent = 'posting_legs'::regclass
+    LOOP
+        EXECUTE format('CREATE TABLE %I PARTITION OF posting_pairs %s',
+            replace(part.relname, 'posting_legs', 'posting_pairs'), part.bound);
+    END LOOP;
+END $$;
+
+INSERT INTO posting_pairs (entry_id, entry_created_at, debit_account, credit_account, amount, currency, description, seq_num)
+SELECT d.entry_id, d.entry_created_at, d.account_code, c.account_code, d.amount, d.currency, d.description, (d.seq_num + 1) / 2
+FROM posting_legs d
+JOIN posting_legs c
+    ON c.entry_id = d.entry_id AND c.entry_created_at = d.entry_created_at AND c.seq_num = d.seq_num + 1
+WHERE d.side = 'DEBIT';
+
+DROP TABLE posting_legs;
+
+ALTER TABLE posting_pairs
+    ADD CONSTRAINT posting_pairs_entry_fkey FOREIGN KEY (entry_id, entry_created_at) REFERENCES journal_entries (id, created_at);
+
+CREATE INDEX idx_posting_pairs_entry ON posting_pairs (entry_id);
+CREATE INDEX idx_posting_pairs_debit ON posting_pairs (debit_account);
+CREATE INDEX idx_posting_pairs_credit ON posting_pairs (credit_account);
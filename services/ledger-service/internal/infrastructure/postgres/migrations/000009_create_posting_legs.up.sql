@@ -0,0 +1,53 @@
+-- Replace posting pairs, one debit and one credit account per row, with
+-- posting legs, one account and side per row, so a journal entry can have
+-- any number of legs. Each pair becomes a debit leg and a credit leg, in
+-- that order.
+--
+-- Legs are partitioned like the pairs were (see 000006), with a partition
+-- for each attached pairs partition. Months already archived keep their
+-- pairs in the archive schema.
+
+CREATE TABLE posting_legs (
+    id                UUID NOT NULL DEFAULT gen_random_uuid(),
+    entry_id          UUID NOT NULL,
+    entry_created_at  TIMESTAMPTZ NOT NULL,
+    account_code      VARCHAR(10) NOT NULL,
+    side              VARCHAR(6) NOT NULL,
+    amount            NUMERIC(19,4) NOT NULL,
+    currency          VARCHAR(3) NOT NULL,
+    description       TEXT NOT NULL DEFAULT '',
+    seq_num           INT NOT NULL,
+    PRIMARY KEY (id, entry_created_at),
+    CONSTRAINT chk_leg_positive_amount CHECK (amount > 0),
+    CONSTRAINT chk_leg_side CHECK (side IN ('DEBIT', 'CREDIT'))
+) PARTITION BY RANGE (entry_created_at);
+
+DO $$
+DECLARE
+    part RECORD;
+BEGIN
+    FOR part IN
+        SELECT c.relname, pg_get_expr(c.relpartbound, c.oid) AS bound
+        FROM pg_inherits i
+        JOIN pg_class c ON c.oid = i.inhrelid
+        WHERE i.inhparent = 'posting_pairs'::regclass
+    LOOP
+        EXECUTE format('CREATE TABLE %I PARTITION OF posting_legs %s',
+            replace(part.relname, 'posting_pairs', 'posting_legs'), part.bound);
+    END LOOP;
+END $$;
+
+INSERT INTO posting_legs (entry_id, entry_created_at, account_code, side, amount, currency, description, seq_num)
+SELECT entry_id, entry_created_at, debit_account, 'DEBIT', amount, currency, description, seq_num * 2 - 1
+FROM posting_pairs
+UNION ALL
+SELECT entry_id, entry_created_at, credit_account, 'CREDIT', amount, currency, description, seq_num * 2
+FROM posting_pairs;
+
+DROP TABLE posting_pairs;
+
+ALTER TABLE posting_legs
+    ADD CONSTRAINT posting_legs_entry_fkey FOREIGN KEY (entry_id, entry_created_at) REFERENCES journal_entries (id, created_at);
+
+CREATE INDEX idx_posting_legs_entry ON posting_legs (entry_id);
+CREATE INDEX idx_posting_legs_account ON posting_legs (account_code);
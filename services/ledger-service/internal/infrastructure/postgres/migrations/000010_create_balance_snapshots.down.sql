@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS period_balances;
+DROP TABLE IF EXISTS balance_snapshot_cursors;
+DROP TABLE IF EXISTS balance_snapshots;
@@ -0,0 +1,57 @@
+-- Each tenant's balances at the end of every ended fiscal period, by
+-- account and currency, taken by the snapshot job. A balance is read as
+-- the latest current snapshot plus the postings effective since. Accounts
+-- with a zero balance and no postings in the period are left out.
+CREATE TABLE IF NOT EXISTS balance_snapshots (
+    tenant_id     UUID NOT NULL,
+    year          INT NOT NULL,
+    month         INT NOT NULL,
+    account_code  VARCHAR(10) NOT NULL,
+    currency      VARCHAR(3) NOT NULL,
+    debit         NUMERIC(19,4) NOT NULL,
+    credit        NUMERIC(19,4) NOT NULL,
+    balance       NUMERIC(19,4) NOT NULL,
+    taken_at      TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (tenant_id, year, month, account_code, currency)
+);
+
+-- How far each tenant's snapshots are current: every period before
+-- stale_from has a current snapshot. Posting an entry backdated before it
+-- moves it back and bumps version, so a snapshot job that raced the
+-- posting does not move it forward again.
+CREATE TABLE IF NOT EXISTS balance_snapshot_cursors (
+    tenant_id   UUID PRIMARY KEY,
+    stale_from  DATE NOT NULL,
+    version     BIGINT NOT NULL DEFAULT 0
+);
+
+-- Tenants with entries already posted are snapshotted from their first
+-- period.
+INSERT INTO balance_snapshot_cursors (tenant_id, stale_from)
+SELECT tenant_id, date_trunc('month', MIN(effective_date) AT TIME ZONE 'UTC')::date
+FROM journal_entries
+GROUP BY tenant_id;
+
+-- Balances frozen when a fiscal period closed, kept for audit. Closing a
+-- reopened period again replaces them.
+CREATE TABLE IF NOT EXISTS period_balances (
+    tenant_id     UUID NOT NULL,
+    year          INT NOT NULL,
+    month         INT NOT NULL,
+    account_code  VARCHAR(10) NOT NULL,
+    currency      VARCHAR(3) NOT NULL,
+    opening       NUMERIC(19,4) NOT NULL,
+    debit         NUMERIC(19,4) NOT NULL,
+    credit        NUMERIC(19,4) NOT NULL,
+    closing       NUMERIC(19,4) NOT NULL,
+    closed_at     TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (tenant_id, year, month, account_code, currency)
+);
+
+ALTER TABLE balance_snapshots ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON balance_snapshots
+    USING (tenant_id::text = current_setting('app.tenant_id'));
+
+ALTER TABLE period_balances ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON period_balances
+    USING (tenant_id::text = current_setting('app.tenant_id'));
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS ledger_audit_seq;
+DROP TABLE IF EXISTS ledger_audit_log;
+DROP FUNCTION IF EXISTS ledger_audit_log_append_only();
@@ -0,0 +1,59 @@
+-- Every state transition of every journal entry, appended in the same
+-- transaction as the entry is saved, for regulators. seq orders a tenant's
+-- log, so an export or stream can resume after the last record it read.
+-- actor_id is the caller's user ID from their JWT, and NULL for
+-- transitions the platform made.
+CREATE TABLE IF NOT EXISTS ledger_audit_log (
+    tenant_id       UUID NOT NULL,
+    seq             BIGINT NOT NULL,
+    id              UUID NOT NULL UNIQUE,
+    entry_id        UUID NOT NULL,
+    action          VARCHAR(20) NOT NULL,
+    from_status     VARCHAR(20) NOT NULL DEFAULT '',
+    to_status       VARCHAR(20) NOT NULL,
+    entry_version   INT NOT NULL,
+    effective_date  TIMESTAMPTZ NOT NULL,
+    actor_id        UUID,
+    detail          TEXT NOT NULL DEFAULT '',
+    occurred_at     TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (tenant_id, seq)
+);
+
+-- The last seq handed out per tenant. A saving transaction takes its
+-- tenant's row and holds it until it commits, so a tenant's records become
+-- visible in seq order: a reader resuming after seq N never misses a
+-- record with a lower seq that was still being written. An IDENTITY column
+-- would assign seqs at insert time instead, out of commit order.
+CREATE TABLE IF NOT EXISTS ledger_audit_seq (
+    tenant_id       UUID PRIMARY KEY,
+    last_seq        BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_audit_log_tenant_occurred
+    ON ledger_audit_log (tenant_id, occurred_at, seq);
+CREATE INDEX IF NOT EXISTS idx_ledger_audit_log_entry
+    ON ledger_audit_log (entry_id, seq);
+
+-- The log is append-only: records can be neither changed nor removed, by
+-- the service or anyone else short of dropping the trigger.
+CREATE OR REPLACE FUNCTION ledger_audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'ledger_audit_log is append-only: % is not allowed', TG_OP;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER ledger_audit_log_append_only
+    BEFORE UPDATE OR DELETE ON ledger_audit_log
+    FOR EACH ROW EXECUTE FUNCTION ledger_audit_log_append_only();
+
+CREATE TRIGGER ledger_audit_log_no_truncate
+    BEFORE TRUNCATE ON ledger_audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION ledger_audit_log_append_only();
+
+ALTER TABLE ledger_audit_log ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON ledger_audit_log
+    USING (tenant_id::text = current_setting('app.tenant_id'));
+
+ALTER TABLE ledger_audit_seq ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON ledger_audit_seq
+    USING (tenant_id::text = current_setting('app.tenant_id'));
@@ -0,0 +1,23 @@
+-- The entry each of a tenant's references was first posted under. Journal
+-- entries are partitioned by created_at, so a unique index on them would
+-- have to include it; this table holds the constraint instead. Saving a new
+-- entry under a reference already taken fails, and the ledger returns the
+-- entry that took it, so a caller retrying a posting under the same
+-- reference gets the first posting back however late it retries.
+CREATE TABLE IF NOT EXISTS journal_entry_references (
+    tenant_id   UUID NOT NULL,
+    reference   VARCHAR(255) NOT NULL,
+    entry_id    UUID NOT NULL,
+    PRIMARY KEY (tenant_id, reference)
+);
+
+-- References already posted are taken by their earliest entry.
+INSERT INTO journal_entry_references (tenant_id, reference, entry_id)
+SELECT DISTINCT ON (tenant_id, reference) tenant_id, reference, id
+FROM journal_entries
+WHERE reference <> ''
+ORDER BY tenant_id, reference, created_at;
+
+ALTER TABLE journal_entry_references ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON journal_entry_references
+    USING (tenant_id::text = current_setting('app.tenant_id'));
@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"github.com/bibbank/bib/pkg/archive"
+	pgpkg "github.com/bibbank/bib/pkg/postgres"
+)
+
+// PartitionPolicies are the ledger's monthly partitioned tables, created by
+// migrations 000006 and 000009. Posting legs are listed first: they
+// reference their journal entry, so a month's legs must expire before its
+// entries. Entries are kept attached for two years and then archived, not
+// dropped, since the ledger is a record of account.
+func PartitionPolicies() []pgpkg.PartitionPolicy {
+	return []pgpkg.PartitionPolicy{
+		{Table: "posting_legs", Retain: 24, Expire: pgpkg.ExpireArchive},
+		{Table: "journal_entries", Retain: 24, Expire: pgpkg.ExpireArchive},
+	}
+}
+
+// ArchivePolicies move the ledger's archived months to cold storage after
+// seven years, the record-keeping period for books of account. Months
+// archived before migration 000009 hold posting pairs rather than legs.
+func ArchivePolicies() []archive.Policy {
+	return []archive.Policy{
+		{Table: "posting_legs", ColdAfter: 84},
+		{Table: "posting_pairs", ColdAfter: 84},
+		{Table: "journal_entries", ColdAfter: 84},
+	}
+}
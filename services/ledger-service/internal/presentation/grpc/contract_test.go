@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	grpclib "google.golang.org/grpc"
+
+	ledgerv1 "github.com/bibbank/bib/api/gen/go/bib/ledger/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/model"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	journalRepo := &mockJournalRepo{}
+	trialBalanceRepo := &mockTrialBalanceRepo{}
+	h := buildHandlerWithTrialBalance(journalRepo, &mockBalanceRepo{balance: decimal.NewFromInt(1000)}, trialBalanceRepo)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { ledgerv1.RegisterLedgerServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-ledger-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) {
+			journalRepo.findByIDFunc, trialBalanceRepo.lines = nil, nil
+		},
+		"a journal entry exists": func(t *testing.T) {
+			pair, err := valueobject.NewPostingPair(valueobject.MustAccountCode("1000"), valueobject.MustAccountCode("2000"),
+				decimal.NewFromInt(100), "USD", "Cash in")
+			if err != nil {
+				t.Fatal(err)
+			}
+			entry, err := model.NewJournalEntry(contractTenantID, time.Date(2026, time.January, 15, 0, 0, 0, 0, time.UTC),
+				[]valueobject.PostingPair{pair}, "Customer deposit", "DEP-001")
+			if err != nil {
+				t.Fatal(err)
+			}
+			journalRepo.findByIDFunc = func(context.Context, uuid.UUID) (model.JournalEntry, error) {
+				return entry, nil
+			}
+		},
+		"the period has postings": func(*testing.T) {
+			trialBalanceRepo.lines = []model.TrialBalanceLine{
+				{AccountCode: valueobject.MustAccountCode("1000"), Currency: "USD", Debit: decimal.NewFromInt(100)},
+				{AccountCode: valueobject.MustAccountCode("2000"), Currency: "USD", Credit: decimal.NewFromInt(100)},
+			}
+		},
+	})
+}
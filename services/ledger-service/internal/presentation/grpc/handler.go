@@ -2,19 +2,32 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"math"
 	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	ledgerv1 "github.com/bibbank/bib/api/gen/go/bib/ledger/v1"
+
+	"github.com/bibbank/bib/pkg/approval"
 	"github.com/bibbank/bib/pkg/auth"
 	"github.com/bibbank/bib/services/ledger-service/internal/application/dto"
 	"github.com/bibbank/bib/services/ledger-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/port"
+	"github.com/bibbank/bib/services/ledger-service/internal/domain/valueobject"
 )
 
 // requireRole checks that the caller has at least one of the given roles.
@@ -42,15 +55,34 @@ func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
 	return claims.TenantID, nil
 }
 
+// actorIDFromContext returns the caller's user ID from their JWT claims, to
+// attribute the journal entry transitions they make in the audit log.
+func actorIDFromContext(ctx context.Context) *uuid.UUID {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	actorID := claims.UserID
+	return &actorID
+}
+
+var _ ledgerv1.LedgerServiceServer = (*LedgerHandler)(nil)
+
 // LedgerHandler implements the gRPC LedgerService server.
 type LedgerHandler struct {
-	UnimplementedLedgerServiceServer
+	ledgerv1.UnimplementedLedgerServiceServer
 	postEntry   *usecase.PostJournalEntry
 	getEntry    *usecase.GetJournalEntry
 	getBalance  *usecase.GetBalance
 	listEntries *usecase.ListJournalEntries
 	backvalue   *usecase.BackvalueEntry
 	periodClose *usecase.PeriodClose
+	periodState *usecase.GetPeriodStatus
+	trialBal    *usecase.GetTrialBalance
+	reopen      *usecase.ReopenPeriod
+	openAccount *usecase.OpenAccount
+	streamAudit *usecase.StreamAuditLog
+	exportAudit *usecase.ExportAuditLog
 
 	logger *slog.Logger
 }
@@ -62,6 +94,12 @@ func NewLedgerHandler(
 	listEntries *usecase.ListJournalEntries,
 	backvalue *usecase.BackvalueEntry,
 	periodClose *usecase.PeriodClose,
+	periodState *usecase.GetPeriodStatus,
+	trialBal *usecase.GetTrialBalance,
+	reopen *usecase.ReopenPeriod,
+	openAccount *usecase.OpenAccount,
+	streamAudit *usecase.StreamAuditLog,
+	exportAudit *usecase.ExportAuditLog,
 	logger *slog.Logger,
 ) *LedgerHandler {
 	return &LedgerHandler{
@@ -71,49 +109,19 @@ func NewLedgerHandler(
 		listEntries: listEntries,
 		backvalue:   backvalue,
 		periodClose: periodClose,
+		periodState: periodState,
+		trialBal:    trialBal,
+		reopen:      reopen,
+		openAccount: openAccount,
+		streamAudit: streamAudit,
+		exportAudit: exportAudit,
 
 		logger: logger}
 }
 
-// PostJournalEntry handles gRPC PostJournalEntry calls.
-// Since we don't have generated proto code yet, we define a manual interface.
-// This will be updated when proto generation is wired.
-
-// PostJournalEntryRequest/Response are temporary types until proto gen is wired.
-type PostJournalEntryRequest struct {
-	TenantID      string            `json:"tenant_id"`
-	EffectiveDate string            `json:"effective_date"`
-	Description   string            `json:"description,omitempty"`
-	Reference     string            `json:"reference,omitempty"`
-	Postings      []*PostingPairMsg `json:"postings"`
-}
-
-type PostingPairMsg struct {
-	DebitAccount  string `json:"debit_account"`
-	CreditAccount string `json:"credit_account"`
-	Amount        string `json:"amount"`
-	Currency      string `json:"currency"`
-	Description   string `json:"description,omitempty"`
-}
-
-type JournalEntryMsg struct {
-	ID            string            `json:"id"`
-	TenantID      string            `json:"tenant_id"`
-	EffectiveDate string            `json:"effective_date"`
-	Status        string            `json:"status"`
-	Description   string            `json:"description"`
-	Reference     string            `json:"reference"`
-	CreatedAt     string            `json:"created_at"`
-	UpdatedAt     string            `json:"updated_at"`
-	Postings      []*PostingPairMsg `json:"postings"`
-	Version       int32             `json:"version"`
-}
-
-type PostJournalEntryResponse struct {
-	Entry *JournalEntryMsg `json:"entry"`
-}
-
-func (h *LedgerHandler) HandlePostJournalEntry(ctx context.Context, req *PostJournalEntryRequest) (*PostJournalEntryResponse, error) {
+// PostJournalEntry posts a journal entry given as debit/credit postings, as
+// legs balanced per currency, or both.
+func (h *LedgerHandler) PostJournalEntry(ctx context.Context, req *ledgerv1.PostJournalEntryRequest) (*ledgerv1.PostJournalEntryResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -127,77 +135,140 @@ func (h *LedgerHandler) HandlePostJournalEntry(ctx context.Context, req *PostJou
 		return nil, err
 	}
 
-	effectiveDate, err := time.Parse("2006-01-02", req.EffectiveDate)
-	if err != nil {
+	if req.EffectiveDate == nil {
+		return nil, status.Error(codes.InvalidArgument, "effective_date is required")
+	}
+	if err = req.EffectiveDate.CheckValid(); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid effective_date: %v", err)
 	}
+	effectiveDate := dateOf(req.EffectiveDate)
 
-	if len(req.Postings) == 0 {
+	if len(req.Postings) == 0 && len(req.Legs) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "at least one posting is required")
 	}
 
 	var postings []dto.PostingPairDTO
 	for i, p := range req.Postings {
-		if p.DebitAccount == "" {
+		if p.GetDebitAccount() == "" {
 			return nil, status.Errorf(codes.InvalidArgument, "posting[%d]: debit_account is required", i)
 		}
-		if p.CreditAccount == "" {
+		if p.GetCreditAccount() == "" {
 			return nil, status.Errorf(codes.InvalidArgument, "posting[%d]: credit_account is required", i)
 		}
 		var amount decimal.Decimal
-		amount, err = decimal.NewFromString(p.Amount)
+		amount, err = decimal.NewFromString(p.GetAmount().GetAmount())
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "posting[%d]: invalid amount: %v", i, err)
 		}
 		if !amount.IsPositive() {
 			return nil, status.Errorf(codes.InvalidArgument, "posting[%d]: amount must be positive", i)
 		}
-		if p.Currency == "" {
+		currency := p.GetAmount().GetCurrency()
+		if currency == "" {
 			return nil, status.Errorf(codes.InvalidArgument, "posting[%d]: currency is required", i)
 		}
-		if !currencyCodeRE.MatchString(p.Currency) {
+		if !currencyCodeRE.MatchString(currency) {
 			return nil, status.Errorf(codes.InvalidArgument, "posting[%d]: currency must be a 3-letter uppercase ISO code", i)
 		}
 		postings = append(postings, dto.PostingPairDTO{
-			DebitAccount:  p.DebitAccount,
-			CreditAccount: p.CreditAccount,
+			DebitAccount:  p.GetDebitAccount(),
+			CreditAccount: p.GetCreditAccount(),
 			Amount:        amount,
-			Currency:      p.Currency,
-			Description:   p.Description,
+			Currency:      currency,
+			Description:   p.GetDescription(),
+		})
+	}
+
+	var legs []dto.PostingLegDTO
+	for i, l := range req.Legs {
+		if l.GetAccountCode() == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "leg[%d]: account_code is required", i)
+		}
+		side := enumName(l.GetSide().String(), "POSTING_SIDE_")
+		if side != "DEBIT" && side != "CREDIT" {
+			return nil, status.Errorf(codes.InvalidArgument, "leg[%d]: side must be DEBIT or CREDIT", i)
+		}
+		var amount decimal.Decimal
+		amount, err = decimal.NewFromString(l.GetAmount().GetAmount())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "leg[%d]: invalid amount: %v", i, err)
+		}
+		if !amount.IsPositive() {
+			return nil, status.Errorf(codes.InvalidArgument, "leg[%d]: amount must be positive", i)
+		}
+		currency := l.GetAmount().GetCurrency()
+		if !currencyCodeRE.MatchString(currency) {
+			return nil, status.Errorf(codes.InvalidArgument, "leg[%d]: currency must be a 3-letter uppercase ISO code", i)
+		}
+		legs = append(legs, dto.PostingLegDTO{
+			AccountCode: l.GetAccountCode(),
+			Side:        side,
+			Amount:      amount,
+			Currency:    currency,
+			Description: l.GetDescription(),
 		})
 	}
 
 	result, err := h.postEntry.Execute(ctx, dto.PostJournalEntryRequest{
 		TenantID:      tenantID,
+		ActorID:       actorIDFromContext(ctx),
 		EffectiveDate: effectiveDate,
 		Postings:      postings,
+		Legs:          legs,
 		Description:   req.Description,
 		Reference:     req.Reference,
 	})
+	if errors.Is(err, usecase.ErrInvalidEntry) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		h.logger.Error("handler error", "error", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	return &PostJournalEntryResponse{
+	return &ledgerv1.PostJournalEntryResponse{
 		Entry: toJournalEntryMsg(result),
 	}, nil
 }
 
-type GetBalanceRequest struct {
-	AccountCode string `json:"account_code"`
-	AsOf        string `json:"as_of"`
-	Currency    string `json:"currency"`
-}
+// GetJournalEntry returns one of the caller's journal entries.
+func (h *LedgerHandler) GetJournalEntry(ctx context.Context, req *ledgerv1.GetJournalEntryRequest) (*ledgerv1.GetJournalEntryResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCompliance); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
 
-type GetBalanceResponse struct {
-	AccountCode string `json:"account_code"`
-	Amount      string `json:"amount"`
-	Currency    string `json:"currency"`
-	AsOf        string `json:"as_of"`
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid entry id")
+	}
+
+	result, err := h.getEntry.Execute(ctx, id)
+	if errors.Is(err, port.ErrEntryNotFound) {
+		return nil, status.Error(codes.NotFound, "journal entry not found")
+	}
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	// Another tenant's entry is reported as missing rather than forbidden.
+	if result.TenantID != tenantID {
+		return nil, status.Error(codes.NotFound, "journal entry not found")
+	}
+
+	return &ledgerv1.GetJournalEntryResponse{Entry: toJournalEntryMsg(result)}, nil
 }
 
-func (h *LedgerHandler) HandleGetBalance(ctx context.Context, req *GetBalanceRequest) (*GetBalanceResponse, error) {
+// GetBalance returns an account's balance in a currency, as of a date when
+// as_of is set.
+func (h *LedgerHandler) GetBalance(ctx context.Context, req *ledgerv1.GetBalanceRequest) (*ledgerv1.GetBalanceResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -214,15 +285,20 @@ func (h *LedgerHandler) HandleGetBalance(ctx context.Context, req *GetBalanceReq
 	}
 
 	var asOf time.Time
-	if req.AsOf != "" {
-		var err error
-		asOf, err = time.Parse("2006-01-02", req.AsOf)
-		if err != nil {
+	if req.AsOf != nil {
+		if err := req.AsOf.CheckValid(); err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid as_of date: %v", err)
 		}
+		asOf = dateOf(req.AsOf)
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	result, err := h.getBalance.Execute(ctx, dto.GetBalanceRequest{
+		TenantID:    tenantID,
 		AccountCode: req.AccountCode,
 		Currency:    req.Currency,
 		AsOf:        asOf,
@@ -232,60 +308,512 @@ func (h *LedgerHandler) HandleGetBalance(ctx context.Context, req *GetBalanceReq
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	return &GetBalanceResponse{
+	return &ledgerv1.GetBalanceResponse{
+		AccountCode: result.AccountCode,
+		Balance:     &commonv1.Money{Amount: result.Amount.String(), Currency: result.Currency},
+		AsOf:        timestamppb.New(result.AsOf),
+	}, nil
+}
+
+// ListJournalEntries returns a page of the caller's journal entries effective
+// within [from_date, to_date], optionally only those posting to an account.
+// The page token is the offset of the page.
+func (h *LedgerHandler) ListJournalEntries(ctx context.Context, req *ledgerv1.ListJournalEntriesRequest) (*ledgerv1.ListJournalEntriesResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCompliance); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.TenantId != "" && req.TenantId != tenantID.String() {
+		return nil, status.Error(codes.PermissionDenied, "tenant_id does not match the caller's tenant")
+	}
+
+	if req.FromDate == nil || req.ToDate == nil {
+		return nil, status.Error(codes.InvalidArgument, "from_date and to_date are required")
+	}
+	if err = req.FromDate.CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from_date: %v", err)
+	}
+	if err = req.ToDate.CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to_date: %v", err)
+	}
+	from, to := req.FromDate.AsTime(), req.ToDate.AsTime()
+	if to.Before(from) {
+		return nil, status.Error(codes.InvalidArgument, "to_date must not be before from_date")
+	}
+
+	if req.AccountCode != "" {
+		if _, err = valueobject.NewAccountCode(req.AccountCode); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	pageSize := req.GetPagination().GetPageSize()
+	if pageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+	var offset int
+	if token := req.GetPagination().GetPageToken(); token != "" {
+		offset, err = strconv.Atoi(token)
+		if err != nil || offset < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+	}
+
+	result, err := h.listEntries.Execute(ctx, dto.ListEntriesRequest{
+		TenantID:    tenantID,
+		AccountCode: req.AccountCode,
+		FromDate:    from,
+		ToDate:      to,
+		PageSize:    int(pageSize),
+		Offset:      offset,
+	})
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	entries := make([]*ledgerv1.JournalEntry, 0, len(result.Entries))
+	for _, e := range result.Entries {
+		entries = append(entries, toJournalEntryMsg(e))
+	}
+
+	page := &commonv1.PaginationResponse{
+		TotalCount: int32(min(result.TotalCount, math.MaxInt32)), // #nosec G115
+	}
+	if next := offset + len(entries); len(entries) > 0 && next < result.TotalCount {
+		page.NextPageToken = strconv.Itoa(next)
+	}
+	return &ledgerv1.ListJournalEntriesResponse{Entries: entries, Pagination: page}, nil
+}
+
+// GetPeriodStatus reports whether the caller's fiscal period is closed and
+// the interest its close would still post.
+func (h *LedgerHandler) GetPeriodStatus(ctx context.Context, req *ledgerv1.GetPeriodStatusRequest) (*ledgerv1.GetPeriodStatusResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.periodState.Execute(ctx, dto.PeriodStatusRequest{
+		TenantID: tenantID,
+		Year:     int(req.Year),
+		Month:    int(req.Month),
+	})
+	if err != nil {
+		return nil, h.periodError(err)
+	}
+
+	resp := &ledgerv1.GetPeriodStatusResponse{
+		Period:           result.Period,
+		Status:           result.Status,
+		UnpostedInterest: make([]*ledgerv1.UnpostedInterest, 0, len(result.UnpostedInterest)),
+	}
+	for _, u := range result.UnpostedInterest {
+		resp.UnpostedInterest = append(resp.UnpostedInterest, &ledgerv1.UnpostedInterest{
+			Kind:     u.Kind,
+			Currency: u.Currency,
+			Amount:   u.Amount.String(),
+		})
+	}
+	return resp, nil
+}
+
+// ClosePeriod closes the caller's fiscal period after truing up its
+// interest, preventing further postings to it.
+func (h *LedgerHandler) ClosePeriod(ctx context.Context, req *ledgerv1.ClosePeriodRequest) (*ledgerv1.ClosePeriodResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.periodClose.Execute(ctx, dto.PeriodCloseRequest{
+		TenantID: tenantID,
+		Year:     int(req.Year),
+		Month:    int(req.Month),
+	}); err != nil {
+		return nil, h.periodError(err)
+	}
+
+	return &ledgerv1.ClosePeriodResponse{
+		Period: fmt.Sprintf("%d-%02d", req.Year, req.Month),
+		Status: "CLOSED",
+	}, nil
+}
+
+// ReopenPeriod reopens the caller's closed fiscal period under dual control:
+// the first call submits a request for approval, which a checker decides
+// through the LedgerApprovals service, and a call carrying the approved
+// request's ID reopens the period.
+func (h *LedgerHandler) ReopenPeriod(ctx context.Context, req *ledgerv1.ReopenPeriodRequest) (*ledgerv1.ReopenPeriodResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var approvalID uuid.UUID
+	if req.ApprovalId != "" {
+		if approvalID, err = approval.ParseID(req.ApprovalId); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := h.reopen.Execute(ctx, dto.PeriodReopenRequest{
+		TenantID:   tenantID,
+		Year:       int(req.Year),
+		Month:      int(req.Month),
+		Reason:     req.Reason,
+		ApprovalID: approvalID,
+	})
+	if err != nil {
+		return nil, h.periodError(err)
+	}
+
+	resp := &ledgerv1.ReopenPeriodResponse{Period: result.Period, Status: result.Status}
+	if result.Approval != nil {
+		if resp.Approval, err = toApprovalMsg(*result.Approval); err != nil {
+			h.logger.Error("handler error", "error", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+	return resp, nil
+}
+
+// OpenAccount opens the ledger account backing a customer account. It is
+// called by the account service's onboarding saga, and retried, so opening
+// an account that is already open succeeds and returns its balance.
+func (h *LedgerHandler) OpenAccount(ctx context.Context, req *ledgerv1.OpenAccountRequest) (*ledgerv1.OpenAccountResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if _, err := valueobject.NewAccountCode(req.AccountCode); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !currencyCodeRE.MatchString(req.Currency) {
+		return nil, status.Error(codes.InvalidArgument, "currency must be a 3-letter uppercase ISO code")
+	}
+
+	result, err := h.openAccount.Execute(ctx, dto.OpenAccountRequest{
+		AccountCode: req.AccountCode,
+		Currency:    req.Currency,
+	})
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ledgerv1.OpenAccountResponse{
 		AccountCode: result.AccountCode,
-		Amount:      result.Amount.String(),
 		Currency:    result.Currency,
-		AsOf:        result.AsOf.Format("2006-01-02"),
+		Balance:     result.Amount.String(),
 	}, nil
 }
 
-// GetJournalEntryRequest represents the proto GetJournalEntryRequest message.
-type GetJournalEntryRequest struct {
-	ID string `json:"id"`
+// GetTrialBalance returns a page of the caller's trial balance for a
+// reporting period, with its totals by currency. tenant_id is optional and
+// must be the caller's tenant when set.
+func (h *LedgerHandler) GetTrialBalance(ctx context.Context, req *ledgerv1.GetTrialBalanceRequest) (*ledgerv1.GetTrialBalanceResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCompliance); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.TenantId != "" && req.TenantId != tenantID.String() {
+		return nil, status.Error(codes.PermissionDenied, "tenant_id does not match the caller's tenant")
+	}
+
+	result, err := h.trialBal.Execute(ctx, dto.TrialBalanceRequest{
+		TenantID:  tenantID,
+		Period:    req.Period,
+		PageToken: req.PageToken,
+		PageSize:  int(req.PageSize),
+	})
+	if err != nil {
+		return nil, h.periodError(err)
+	}
+
+	resp := &ledgerv1.GetTrialBalanceResponse{
+		Period:        result.Period,
+		Lines:         make([]*ledgerv1.TrialBalanceLine, 0, len(result.Lines)),
+		Totals:        make([]*ledgerv1.TrialBalanceTotal, 0, len(result.Totals)),
+		Balanced:      result.Balanced,
+		NextPageToken: result.NextPageToken,
+	}
+	for _, l := range result.Lines {
+		resp.Lines = append(resp.Lines, &ledgerv1.TrialBalanceLine{
+			AccountCode: l.AccountCode,
+			Currency:    l.Currency,
+			Debit:       l.Debit.String(),
+			Credit:      l.Credit.String(),
+		})
+	}
+	for _, t := range result.Totals {
+		resp.Totals = append(resp.Totals, &ledgerv1.TrialBalanceTotal{
+			Currency: t.Currency,
+			Debit:    t.Debit.String(),
+			Credit:   t.Credit.String(),
+			Balanced: t.Balanced,
+		})
+	}
+	return resp, nil
+}
+
+// StreamAuditLog streams the caller's audit log, every state transition of
+// its journal entries, in the order they were recorded.
+func (h *LedgerHandler) StreamAuditLog(req *ledgerv1.StreamAuditLogRequest, stream ledgerv1.LedgerService_StreamAuditLogServer) error {
+	ctx := stream.Context()
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleAuditor, auth.RoleCompliance); err != nil {
+		return err
+	}
+
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request is required")
+	}
+	if req.AfterSequence < 0 {
+		return status.Error(codes.InvalidArgument, "after_sequence must not be negative")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var from, to time.Time
+	if req.From != "" {
+		if from, err = time.Parse(time.RFC3339, req.From); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid from: %v", err)
+		}
+	}
+	if req.To != "" {
+		if to, err = time.Parse(time.RFC3339, req.To); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid to: %v", err)
+		}
+	}
+
+	err = h.streamAudit.Execute(ctx, dto.AuditLogRequest{
+		TenantID:      tenantID,
+		From:          from,
+		To:            to,
+		AfterSequence: req.AfterSequence,
+	}, func(r dto.AuditRecordDTO) error {
+		return stream.Send(toAuditRecordMsg(r))
+	})
+	if err != nil {
+		return h.auditError(ctx, err)
+	}
+	return nil
+}
+
+// ExportAuditLog writes the caller's audit log over a date range to a JSON
+// Lines file in the export store, for regulators and auditors.
+func (h *LedgerHandler) ExportAuditLog(ctx context.Context, req *ledgerv1.ExportAuditLogRequest) (*ledgerv1.ExportAuditLogResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleAuditor, auth.RoleCompliance); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := time.Parse(time.RFC3339, req.From)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from: %v", err)
+	}
+	to, err := time.Parse(time.RFC3339, req.To)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to: %v", err)
+	}
+
+	result, err := h.exportAudit.Execute(ctx, dto.ExportAuditLogRequest{
+		TenantID: tenantID,
+		From:     from,
+		To:       to,
+	})
+	if err != nil {
+		return nil, h.auditError(ctx, err)
+	}
+
+	return &ledgerv1.ExportAuditLogResponse{
+		Uri:     result.URI,
+		Records: int32(min(result.Records, math.MaxInt32)), // #nosec G115
+	}, nil
 }
 
-// GetJournalEntryResponse represents the proto GetJournalEntryResponse message.
-type GetJournalEntryResponse struct {
-	Entry *JournalEntryMsg `json:"entry"`
+func (h *LedgerHandler) auditError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidRange):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrAuditExportDisabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case ctx.Err() != nil:
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	// Failures to send to the stream already carry their status.
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	h.logger.Error("handler error", "error", err)
+	return status.Error(codes.Internal, "internal error")
 }
 
-// GetJournalEntry retrieves a journal entry by ID.
-func (h *LedgerHandler) GetJournalEntry(_ context.Context, _ *GetJournalEntryRequest) (*GetJournalEntryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetJournalEntry not implemented")
+func toAuditRecordMsg(r dto.AuditRecordDTO) *ledgerv1.AuditRecord {
+	msg := &ledgerv1.AuditRecord{
+		Sequence:      r.Sequence,
+		Id:            r.ID.String(),
+		EntryId:       r.EntryID.String(),
+		Action:        r.Action,
+		FromStatus:    r.FromStatus,
+		ToStatus:      r.ToStatus,
+		EntryVersion:  int32(min(r.EntryVersion, math.MaxInt32)), // #nosec G115
+		EffectiveDate: r.EffectiveDate.Format("2006-01-02"),
+		Detail:        r.Detail,
+		OccurredAt:    r.OccurredAt.Format(time.RFC3339Nano),
+	}
+	if r.ActorID != nil {
+		msg.ActorId = r.ActorID.String()
+	}
+	return msg
 }
 
-// PostJournalEntry delegates to HandlePostJournalEntry for gRPC interface compatibility.
-func (h *LedgerHandler) PostJournalEntry(ctx context.Context, req *PostJournalEntryRequest) (*PostJournalEntryResponse, error) {
-	return h.HandlePostJournalEntry(ctx, req)
+// toApprovalMsg converts an approval request to its proto form. Its payload
+// is a JSON object.
+func toApprovalMsg(r approval.Request) (*ledgerv1.ApprovalRequest, error) {
+	m := approval.ToMsg(r)
+	msg := &ledgerv1.ApprovalRequest{
+		Id:        m.ID,
+		Action:    m.Action,
+		Subject:   m.Subject,
+		Status:    m.Status,
+		Reason:    m.Reason,
+		Comment:   m.Comment,
+		MakerId:   m.MakerID,
+		CheckerId: m.CheckerID,
+		CreatedAt: m.CreatedAt,
+		ExpiresAt: m.ExpiresAt,
+		DecidedAt: m.DecidedAt,
+	}
+	if len(m.Payload) > 0 {
+		msg.Payload = &structpb.Struct{}
+		if err := protojson.Unmarshal(m.Payload, msg.Payload); err != nil {
+			return nil, fmt.Errorf("approval request %s: decode payload: %w", m.ID, err)
+		}
+	}
+	return msg, nil
 }
 
-// GetBalance delegates to HandleGetBalance for gRPC interface compatibility.
-func (h *LedgerHandler) GetBalance(ctx context.Context, req *GetBalanceRequest) (*GetBalanceResponse, error) {
-	return h.HandleGetBalance(ctx, req)
+func (h *LedgerHandler) periodError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidPeriod), errors.Is(err, usecase.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrReasonRequired), errors.Is(err, usecase.ErrApprovalMismatch):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrPeriodClosed), errors.Is(err, usecase.ErrPeriodOpen),
+		errors.Is(err, usecase.ErrNotApproved), errors.Is(err, port.ErrApprovalUsed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, approval.ErrNotFound), errors.Is(err, approval.ErrUnauthenticated):
+		return approval.StatusError(err)
+	default:
+		h.logger.Error("handler error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
 }
 
-func toJournalEntryMsg(r dto.JournalEntryResponse) *JournalEntryMsg {
-	var postings []*PostingPairMsg
+func toJournalEntryMsg(r dto.JournalEntryResponse) *ledgerv1.JournalEntry {
+	postings := make([]*ledgerv1.PostingPair, 0, len(r.Postings))
 	for _, p := range r.Postings {
-		postings = append(postings, &PostingPairMsg{
+		postings = append(postings, &ledgerv1.PostingPair{
 			DebitAccount:  p.DebitAccount,
 			CreditAccount: p.CreditAccount,
-			Amount:        p.Amount.String(),
-			Currency:      p.Currency,
+			Amount:        &commonv1.Money{Amount: p.Amount.String(), Currency: p.Currency},
 			Description:   p.Description,
 		})
 	}
-	return &JournalEntryMsg{
-		ID:            r.ID.String(),
-		TenantID:      r.TenantID.String(),
-		EffectiveDate: r.EffectiveDate.Format("2006-01-02"),
+	legs := make([]*ledgerv1.PostingLeg, 0, len(r.Legs))
+	for _, l := range r.Legs {
+		legs = append(legs, &ledgerv1.PostingLeg{
+			AccountCode: l.AccountCode,
+			Side:        ledgerv1.PostingSide(ledgerv1.PostingSide_value["POSTING_SIDE_"+l.Side]),
+			Amount:      &commonv1.Money{Amount: l.Amount.String(), Currency: l.Currency},
+			Description: l.Description,
+		})
+	}
+	return &ledgerv1.JournalEntry{
+		Id:            r.ID.String(),
+		TenantId:      r.TenantID.String(),
+		EffectiveDate: timestamppb.New(r.EffectiveDate),
 		Postings:      postings,
-		Status:        r.Status,
+		Legs:          legs,
+		Status:        ledgerv1.EntryStatus(ledgerv1.EntryStatus_value["ENTRY_STATUS_"+r.Status]),
 		Description:   r.Description,
 		Reference:     r.Reference,
-		Version:       int32(min(r.Version, math.MaxInt32)), // #nosec G115
-		CreatedAt:     r.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:     r.UpdatedAt.Format(time.RFC3339),
+		Audit: &commonv1.AuditInfo{
+			CreatedAt: timestamppb.New(r.CreatedAt),
+			UpdatedAt: timestamppb.New(r.UpdatedAt),
+			Version:   int32(min(r.Version, math.MaxInt32)), // #nosec G115
+		},
+	}
+}
+
+// dateOf returns the UTC calendar date of ts, as the ledger books entries
+// and balances by day.
+func dateOf(ts *timestamppb.Timestamp) time.Time {
+	t := ts.AsTime()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// enumName strips prefix from a proto enum value name, returning "" for the
+// unspecified value.
+func enumName(name, prefix string) string {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "UNSPECIFIED" {
+		return ""
 	}
+	return name
 }
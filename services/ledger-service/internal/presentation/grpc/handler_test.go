@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
 	"testing"
 	"time"
@@ -11,9 +12,16 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	grpclib "google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	ledgerv1 "github.com/bibbank/bib/api/gen/go/bib/ledger/v1"
+
+	"github.com/bibbank/bib/pkg/approval"
 	"github.com/bibbank/bib/pkg/auth"
 	"github.com/bibbank/bib/pkg/events"
 	"github.com/bibbank/bib/services/ledger-service/internal/application/dto"
@@ -29,6 +37,7 @@ import (
 type mockJournalRepo struct {
 	saveErr      error
 	findByIDFunc func(ctx context.Context, id uuid.UUID) (model.JournalEntry, error)
+	entries      []model.JournalEntry
 }
 
 func (m *mockJournalRepo) Save(_ context.Context, _ model.JournalEntry) error {
@@ -39,15 +48,20 @@ func (m *mockJournalRepo) FindByID(ctx context.Context, id uuid.UUID) (model.Jou
 	if m.findByIDFunc != nil {
 		return m.findByIDFunc(ctx, id)
 	}
-	return model.JournalEntry{}, fmt.Errorf("not found")
+	return model.JournalEntry{}, fmt.Errorf("%w: %s", port.ErrEntryNotFound, id)
+}
+
+func (m *mockJournalRepo) FindByReference(_ context.Context, _ uuid.UUID, reference string) (model.JournalEntry, error) {
+	return model.JournalEntry{}, fmt.Errorf("%w: reference %s", port.ErrEntryNotFound, reference)
 }
 
 func (m *mockJournalRepo) ListByAccount(_ context.Context, _ uuid.UUID, _ valueobject.AccountCode, _, _ time.Time, _, _ int) ([]model.JournalEntry, int, error) {
 	return nil, 0, nil
 }
 
-func (m *mockJournalRepo) ListByTenant(_ context.Context, _ uuid.UUID, _, _ time.Time, _, _ int) ([]model.JournalEntry, int, error) {
-	return nil, 0, nil
+func (m *mockJournalRepo) ListByTenant(_ context.Context, _ uuid.UUID, _, _ time.Time, limit, offset int) ([]model.JournalEntry, int, error) {
+	page := m.entries[min(offset, len(m.entries)):]
+	return page[:min(limit, len(page))], len(m.entries), nil
 }
 
 type mockBalanceRepo struct {
@@ -67,16 +81,89 @@ func (m *mockBalanceRepo) UpdateBalance(_ context.Context, _ valueobject.Account
 	return m.updateErr
 }
 
-type mockFiscalPeriodRepo struct{}
+func (m *mockBalanceRepo) OpenAccount(_ context.Context, _ valueobject.AccountCode, _ string) (decimal.Decimal, error) {
+	if m.balanceErr != nil {
+		return decimal.Zero, m.balanceErr
+	}
+	return m.balance, nil
+}
+
+type mockTrialBalanceRepo struct {
+	lines []model.TrialBalanceLine
+}
+
+func (m *mockTrialBalanceRepo) TrialBalance(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]model.TrialBalanceLine, error) {
+	return m.lines, nil
+}
+
+type mockFiscalPeriodRepo struct {
+	closed bool
+}
 
 func (m *mockFiscalPeriodRepo) GetPeriodStatus(_ context.Context, _ uuid.UUID, _ valueobject.FiscalPeriod) (valueobject.PeriodStatus, error) {
+	if m.closed {
+		return valueobject.PeriodStatusClosed, nil
+	}
 	return valueobject.PeriodStatusOpen, nil
 }
 
-func (m *mockFiscalPeriodRepo) ClosePeriod(_ context.Context, _ uuid.UUID, _ valueobject.FiscalPeriod) error {
+func (m *mockFiscalPeriodRepo) ClosePeriod(_ context.Context, _ uuid.UUID, _ valueobject.FiscalPeriod, _ []model.PeriodBalance) error {
+	return nil
+}
+
+func (m *mockFiscalPeriodRepo) ReopenPeriod(_ context.Context, _ uuid.UUID, _ valueobject.FiscalPeriod, _ uuid.UUID, _ string) error {
+	m.closed = false
 	return nil
 }
 
+type mockBalanceSnapshotRepo struct {
+	balance decimal.Decimal
+}
+
+func (m *mockBalanceSnapshotRepo) StaleSnapshots(_ context.Context, _ valueobject.FiscalPeriod) ([]model.SnapshotCursor, error) {
+	return nil, nil
+}
+
+func (m *mockBalanceSnapshotRepo) SnapshotPeriod(_ context.Context, _ uuid.UUID, _ valueobject.FiscalPeriod) error {
+	return nil
+}
+
+func (m *mockBalanceSnapshotRepo) AdvanceSnapshots(_ context.Context, _ model.SnapshotCursor, _ valueobject.FiscalPeriod) (bool, error) {
+	return true, nil
+}
+
+func (m *mockBalanceSnapshotRepo) BalanceBefore(_ context.Context, _ uuid.UUID, _ valueobject.AccountCode, _ string, _ time.Time) (decimal.Decimal, error) {
+	return m.balance, nil
+}
+
+func (m *mockBalanceSnapshotRepo) PeriodBalances(_ context.Context, _ uuid.UUID, _ valueobject.FiscalPeriod) ([]model.PeriodBalance, error) {
+	return nil, nil
+}
+
+type mockAuditLogRepo struct {
+	records []model.AuditRecord
+}
+
+func (m *mockAuditLogRepo) ListAuditLog(_ context.Context, tenantID uuid.UUID, _, _ time.Time, afterSeq int64, limit int) ([]model.AuditRecord, error) {
+	var out []model.AuditRecord
+	for _, r := range m.records {
+		if r.TenantID == tenantID && r.Sequence > afterSeq && len(out) < limit {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+type mockExportStore struct{}
+
+func (m *mockExportStore) Put(_ context.Context, _ string, _ io.ReadSeeker, _ string) error {
+	return nil
+}
+
+func (m *mockExportStore) URI(key string) string {
+	return "mem://" + key
+}
+
 type mockEventPublisher struct {
 	publishErr error
 }
@@ -107,15 +194,25 @@ func buildTestHandler() *LedgerHandler {
 	return NewLedgerHandler(
 		usecase.NewPostJournalEntry(journalRepo, balanceRepo, publisher, validator),
 		usecase.NewGetJournalEntry(journalRepo),
-		usecase.NewGetBalance(balanceRepo),
+		usecase.NewGetBalance(balanceRepo, &mockBalanceSnapshotRepo{}),
 		usecase.NewListJournalEntries(journalRepo),
 		usecase.NewBackvalueEntry(journalRepo),
-		usecase.NewPeriodClose(periodRepo, publisher),
+		usecase.NewPeriodClose(periodRepo, &mockBalanceSnapshotRepo{}, publisher, nil),
+		usecase.NewGetPeriodStatus(periodRepo, nil),
+		usecase.NewGetTrialBalance(&mockTrialBalanceRepo{}),
+		usecase.NewReopenPeriod(periodRepo, publisher, approval.NewManager(approval.NewMemoryStore(), usecase.ApprovalPolicy)),
+		usecase.NewOpenAccount(balanceRepo),
+		usecase.NewStreamAuditLog(&mockAuditLogRepo{}),
+		usecase.NewExportAuditLog(&mockAuditLogRepo{}, &mockExportStore{}),
 		logger,
 	)
 }
 
 func buildHandlerWithRepos(journalRepo port.JournalRepository, balanceRepo port.BalanceRepository) *LedgerHandler {
+	return buildHandlerWithTrialBalance(journalRepo, balanceRepo, &mockTrialBalanceRepo{})
+}
+
+func buildHandlerWithTrialBalance(journalRepo port.JournalRepository, balanceRepo port.BalanceRepository, trialBalanceRepo port.TrialBalanceRepository) *LedgerHandler {
 	publisher := &mockEventPublisher{}
 	validator := service.NewPostingValidator()
 	periodRepo := &mockFiscalPeriodRepo{}
@@ -124,38 +221,59 @@ func buildHandlerWithRepos(journalRepo port.JournalRepository, balanceRepo port.
 	return NewLedgerHandler(
 		usecase.NewPostJournalEntry(journalRepo, balanceRepo, publisher, validator),
 		usecase.NewGetJournalEntry(journalRepo),
-		usecase.NewGetBalance(balanceRepo),
+		usecase.NewGetBalance(balanceRepo, &mockBalanceSnapshotRepo{}),
 		usecase.NewListJournalEntries(journalRepo),
 		usecase.NewBackvalueEntry(journalRepo),
-		usecase.NewPeriodClose(periodRepo, publisher),
+		usecase.NewPeriodClose(periodRepo, &mockBalanceSnapshotRepo{}, publisher, nil),
+		usecase.NewGetPeriodStatus(periodRepo, nil),
+		usecase.NewGetTrialBalance(trialBalanceRepo),
+		usecase.NewReopenPeriod(periodRepo, publisher, approval.NewManager(approval.NewMemoryStore(), usecase.ApprovalPolicy)),
+		usecase.NewOpenAccount(balanceRepo),
+		usecase.NewStreamAuditLog(&mockAuditLogRepo{}),
+		usecase.NewExportAuditLog(&mockAuditLogRepo{}, &mockExportStore{}),
 		logger,
 	)
 }
 
 // --- Tests ---
 
-func TestHandlePostJournalEntry(t *testing.T) {
+var effectiveDate = timestamppb.New(time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC))
+
+func money(amount, currency string) *commonv1.Money {
+	return &commonv1.Money{Amount: amount, Currency: currency}
+}
+
+func TestPostJournalEntry(t *testing.T) {
 	t.Run("nil request returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandlePostJournalEntry(contextWithClaims(), nil)
+		_, err := h.PostJournalEntry(contextWithClaims(), nil)
 		requireGRPCCode(t, err, codes.InvalidArgument)
 	})
 
 	t.Run("no postings returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandlePostJournalEntry(contextWithClaims(), &PostJournalEntryRequest{
-			TenantID:      uuid.New().String(),
-			EffectiveDate: "2024-01-15",
+		_, err := h.PostJournalEntry(contextWithClaims(), &ledgerv1.PostJournalEntryRequest{
+			TenantId:      uuid.New().String(),
+			EffectiveDate: effectiveDate,
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 		assert.Contains(t, err.Error(), "at least one posting is required")
 	})
 
+	t.Run("missing effective_date returns InvalidArgument", func(t *testing.T) {
+		h := buildTestHandler()
+		_, err := h.PostJournalEntry(contextWithClaims(), &ledgerv1.PostJournalEntryRequest{
+			TenantId: uuid.New().String(),
+		})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+		assert.Contains(t, err.Error(), "effective_date is required")
+	})
+
 	t.Run("invalid effective_date returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandlePostJournalEntry(contextWithClaims(), &PostJournalEntryRequest{
-			TenantID:      uuid.New().String(),
-			EffectiveDate: "not-a-date",
+		_, err := h.PostJournalEntry(contextWithClaims(), &ledgerv1.PostJournalEntryRequest{
+			TenantId:      uuid.New().String(),
+			EffectiveDate: &timestamppb.Timestamp{Nanos: -1},
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 		assert.Contains(t, err.Error(), "invalid effective_date")
@@ -163,15 +281,14 @@ func TestHandlePostJournalEntry(t *testing.T) {
 
 	t.Run("invalid posting amount returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandlePostJournalEntry(contextWithClaims(), &PostJournalEntryRequest{
-			TenantID:      uuid.New().String(),
-			EffectiveDate: "2024-01-15",
-			Postings: []*PostingPairMsg{
+		_, err := h.PostJournalEntry(contextWithClaims(), &ledgerv1.PostJournalEntryRequest{
+			TenantId:      uuid.New().String(),
+			EffectiveDate: effectiveDate,
+			Postings: []*ledgerv1.PostingPair{
 				{
 					DebitAccount:  "1000",
 					CreditAccount: "2000",
-					Amount:        "not-a-number",
-					Currency:      "USD",
+					Amount:        money("not-a-number", "USD"),
 				},
 			},
 			Description: "test",
@@ -183,15 +300,14 @@ func TestHandlePostJournalEntry(t *testing.T) {
 
 	t.Run("happy path returns journal entry", func(t *testing.T) {
 		h := buildTestHandler()
-		resp, err := h.HandlePostJournalEntry(contextWithClaims(), &PostJournalEntryRequest{
-			TenantID:      uuid.New().String(),
-			EffectiveDate: "2024-01-15",
-			Postings: []*PostingPairMsg{
+		resp, err := h.PostJournalEntry(contextWithClaims(), &ledgerv1.PostJournalEntryRequest{
+			TenantId:      uuid.New().String(),
+			EffectiveDate: effectiveDate,
+			Postings: []*ledgerv1.PostingPair{
 				{
 					DebitAccount:  "1000",
 					CreditAccount: "2000",
-					Amount:        "100.00",
-					Currency:      "USD",
+					Amount:        money("100.00", "USD"),
 					Description:   "test posting",
 				},
 			},
@@ -200,26 +316,69 @@ func TestHandlePostJournalEntry(t *testing.T) {
 		})
 		require.NoError(t, err)
 		require.NotNil(t, resp.Entry)
-		assert.NotEmpty(t, resp.Entry.ID)
-		assert.Equal(t, "POSTED", resp.Entry.Status)
+		assert.NotEmpty(t, resp.Entry.Id)
+		assert.Equal(t, ledgerv1.EntryStatus_ENTRY_STATUS_POSTED, resp.Entry.Status)
 		assert.Equal(t, "Test entry", resp.Entry.Description)
 		assert.Equal(t, "REF-001", resp.Entry.Reference)
 	})
 
+	t.Run("multi-leg entry returns its legs", func(t *testing.T) {
+		h := buildTestHandler()
+		resp, err := h.PostJournalEntry(contextWithClaims(), &ledgerv1.PostJournalEntryRequest{
+			TenantId:      uuid.New().String(),
+			EffectiveDate: effectiveDate,
+			Legs: []*ledgerv1.PostingLeg{
+				{AccountCode: "1000", Side: ledgerv1.PostingSide_POSTING_SIDE_DEBIT, Amount: money("100.00", "USD")},
+				{AccountCode: "2000", Side: ledgerv1.PostingSide_POSTING_SIDE_CREDIT, Amount: money("97.00", "USD")},
+				{AccountCode: "4100", Side: ledgerv1.PostingSide_POSTING_SIDE_CREDIT, Amount: money("3.00", "USD")},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Entry.Legs, 3)
+		assert.Equal(t, ledgerv1.PostingSide_POSTING_SIDE_CREDIT, resp.Entry.Legs[2].Side)
+		assert.Len(t, resp.Entry.Postings, 2)
+	})
+
+	t.Run("invalid leg side returns InvalidArgument", func(t *testing.T) {
+		h := buildTestHandler()
+		_, err := h.PostJournalEntry(contextWithClaims(), &ledgerv1.PostJournalEntryRequest{
+			TenantId:      uuid.New().String(),
+			EffectiveDate: effectiveDate,
+			Legs: []*ledgerv1.PostingLeg{
+				{AccountCode: "1000", Side: ledgerv1.PostingSide_POSTING_SIDE_UNSPECIFIED, Amount: money("100.00", "USD")},
+			},
+		})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+		assert.Contains(t, err.Error(), "side must be DEBIT or CREDIT")
+	})
+
+	t.Run("unbalanced legs return InvalidArgument", func(t *testing.T) {
+		h := buildTestHandler()
+		_, err := h.PostJournalEntry(contextWithClaims(), &ledgerv1.PostJournalEntryRequest{
+			TenantId:      uuid.New().String(),
+			EffectiveDate: effectiveDate,
+			Legs: []*ledgerv1.PostingLeg{
+				{AccountCode: "1000", Side: ledgerv1.PostingSide_POSTING_SIDE_DEBIT, Amount: money("100.00", "USD")},
+				{AccountCode: "2000", Side: ledgerv1.PostingSide_POSTING_SIDE_CREDIT, Amount: money("90.00", "USD")},
+			},
+		})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+		assert.Contains(t, err.Error(), "does not balance")
+	})
+
 	t.Run("use case failure returns Internal", func(t *testing.T) {
 		journalRepo := &mockJournalRepo{saveErr: fmt.Errorf("db error")}
 		balanceRepo := &mockBalanceRepo{balance: decimal.NewFromInt(1000)}
 		h := buildHandlerWithRepos(journalRepo, balanceRepo)
 
-		_, err := h.HandlePostJournalEntry(contextWithClaims(), &PostJournalEntryRequest{
-			TenantID:      uuid.New().String(),
-			EffectiveDate: "2024-01-15",
-			Postings: []*PostingPairMsg{
+		_, err := h.PostJournalEntry(contextWithClaims(), &ledgerv1.PostJournalEntryRequest{
+			TenantId:      uuid.New().String(),
+			EffectiveDate: effectiveDate,
+			Postings: []*ledgerv1.PostingPair{
 				{
 					DebitAccount:  "1000",
 					CreditAccount: "2000",
-					Amount:        "100.00",
-					Currency:      "USD",
+					Amount:        money("100.00", "USD"),
 				},
 			},
 			Description: "Test entry",
@@ -229,19 +388,19 @@ func TestHandlePostJournalEntry(t *testing.T) {
 	})
 }
 
-func TestHandleGetBalance(t *testing.T) {
+func TestGetBalance(t *testing.T) {
 	t.Run("nil request returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandleGetBalance(contextWithClaims(), nil)
+		_, err := h.GetBalance(contextWithClaims(), nil)
 		requireGRPCCode(t, err, codes.InvalidArgument)
 	})
 
 	t.Run("invalid as_of date returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandleGetBalance(contextWithClaims(), &GetBalanceRequest{
+		_, err := h.GetBalance(contextWithClaims(), &ledgerv1.GetBalanceRequest{
 			AccountCode: "1000",
 			Currency:    "USD",
-			AsOf:        "not-a-date",
+			AsOf:        &timestamppb.Timestamp{Nanos: -1},
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 		assert.Contains(t, err.Error(), "invalid as_of date")
@@ -251,30 +410,31 @@ func TestHandleGetBalance(t *testing.T) {
 		balanceRepo := &mockBalanceRepo{balance: decimal.NewFromInt(5000)}
 		h := buildHandlerWithRepos(&mockJournalRepo{}, balanceRepo)
 
-		resp, err := h.HandleGetBalance(contextWithClaims(), &GetBalanceRequest{
+		resp, err := h.GetBalance(contextWithClaims(), &ledgerv1.GetBalanceRequest{
 			AccountCode: "1000",
 			Currency:    "USD",
 		})
 		require.NoError(t, err)
 		assert.Equal(t, "1000", resp.AccountCode)
-		assert.Equal(t, "5000", resp.Amount)
-		assert.Equal(t, "USD", resp.Currency)
+		assert.Equal(t, "5000", resp.Balance.GetAmount())
+		assert.Equal(t, "USD", resp.Balance.GetCurrency())
 	})
 
-	t.Run("happy path with as_of date", func(t *testing.T) {
-		balanceRepo := &mockBalanceRepo{balance: decimal.NewFromInt(3000)}
+	t.Run("happy path with as_of date reads snapshots", func(t *testing.T) {
+		balanceRepo := &mockBalanceRepo{balance: decimal.NewFromInt(5000)}
 		h := buildHandlerWithRepos(&mockJournalRepo{}, balanceRepo)
+		h.getBalance = usecase.NewGetBalance(balanceRepo, &mockBalanceSnapshotRepo{balance: decimal.NewFromInt(3000)})
 
-		resp, err := h.HandleGetBalance(contextWithClaims(), &GetBalanceRequest{
+		resp, err := h.GetBalance(contextWithClaims(), &ledgerv1.GetBalanceRequest{
 			AccountCode: "2000",
 			Currency:    "EUR",
-			AsOf:        "2024-06-30",
+			AsOf:        timestamppb.New(time.Date(2024, time.June, 30, 0, 0, 0, 0, time.UTC)),
 		})
 		require.NoError(t, err)
 		assert.Equal(t, "2000", resp.AccountCode)
-		assert.Equal(t, "3000", resp.Amount)
-		assert.Equal(t, "EUR", resp.Currency)
-		assert.Equal(t, "2024-06-30", resp.AsOf)
+		assert.Equal(t, "3000", resp.Balance.GetAmount())
+		assert.Equal(t, "EUR", resp.Balance.GetCurrency())
+		assert.Equal(t, "2024-06-30", resp.AsOf.AsTime().Format(time.DateOnly))
 	})
 
 	t.Run("use case failure returns Internal", func(t *testing.T) {
@@ -283,7 +443,7 @@ func TestHandleGetBalance(t *testing.T) {
 
 		// GetBalance swallows the error and returns zero when no row found,
 		// but we pass an invalid account code to trigger error path.
-		_, err := h.HandleGetBalance(contextWithClaims(), &GetBalanceRequest{
+		_, err := h.GetBalance(contextWithClaims(), &ledgerv1.GetBalanceRequest{
 			AccountCode: "invalid-code",
 			Currency:    "USD",
 		})
@@ -291,6 +451,325 @@ func TestHandleGetBalance(t *testing.T) {
 	})
 }
 
+func TestListJournalEntries(t *testing.T) {
+	from := timestamppb.New(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+	to := timestamppb.New(time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC))
+	pair, err := valueobject.NewPostingPair(valueobject.MustAccountCode("1000"), valueobject.MustAccountCode("2000"),
+		decimal.NewFromInt(100), "USD", "test posting")
+	require.NoError(t, err)
+	repo := &mockJournalRepo{}
+	for range 3 {
+		entry, err := model.NewJournalEntry(uuid.New(), effectiveDate.AsTime(), []valueobject.PostingPair{pair}, "Test entry", "REF-001")
+		require.NoError(t, err)
+		repo.entries = append(repo.entries, entry)
+	}
+	h := buildHandlerWithRepos(repo, &mockBalanceRepo{})
+
+	t.Run("pages through the caller's entries", func(t *testing.T) {
+		resp, err := h.ListJournalEntries(contextWithClaims(), &ledgerv1.ListJournalEntriesRequest{
+			FromDate:   from,
+			ToDate:     to,
+			Pagination: &commonv1.Pagination{PageSize: 2},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Entries, 2)
+		assert.Equal(t, "100", resp.Entries[0].Postings[0].Amount.GetAmount())
+		assert.Equal(t, "2024-01-15", resp.Entries[0].EffectiveDate.AsTime().Format(time.DateOnly))
+		assert.Equal(t, int32(3), resp.Pagination.GetTotalCount())
+		assert.Equal(t, "2", resp.Pagination.GetNextPageToken())
+
+		resp, err = h.ListJournalEntries(contextWithClaims(), &ledgerv1.ListJournalEntriesRequest{
+			FromDate:   from,
+			ToDate:     to,
+			Pagination: &commonv1.Pagination{PageSize: 2, PageToken: "2"},
+		})
+		require.NoError(t, err)
+		assert.Len(t, resp.Entries, 1)
+		assert.Empty(t, resp.Pagination.GetNextPageToken())
+	})
+
+	t.Run("missing range returns InvalidArgument", func(t *testing.T) {
+		_, err := h.ListJournalEntries(contextWithClaims(), &ledgerv1.ListJournalEntriesRequest{FromDate: from})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("invalid page token returns InvalidArgument", func(t *testing.T) {
+		_, err := h.ListJournalEntries(contextWithClaims(), &ledgerv1.ListJournalEntriesRequest{
+			FromDate:   from,
+			ToDate:     to,
+			Pagination: &commonv1.Pagination{PageToken: "next"},
+		})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("another tenant's entries are denied", func(t *testing.T) {
+		_, err := h.ListJournalEntries(contextWithClaims(), &ledgerv1.ListJournalEntriesRequest{
+			TenantId: uuid.NewString(),
+			FromDate: from,
+			ToDate:   to,
+		})
+		requireGRPCCode(t, err, codes.PermissionDenied)
+	})
+}
+
+func TestGetJournalEntry(t *testing.T) {
+	claims := &auth.Claims{UserID: uuid.New(), TenantID: uuid.New(), Roles: []string{auth.RoleAuditor}}
+	ctx := auth.ContextWithClaims(context.Background(), claims)
+	pair, err := valueobject.NewPostingPair(valueobject.MustAccountCode("1000"), valueobject.MustAccountCode("2000"),
+		decimal.NewFromInt(100), "USD", "test posting")
+	require.NoError(t, err)
+	entry, err := model.NewJournalEntry(claims.TenantID, effectiveDate.AsTime(), []valueobject.PostingPair{pair}, "Test entry", "REF-001")
+	require.NoError(t, err)
+	repo := &mockJournalRepo{findByIDFunc: func(_ context.Context, id uuid.UUID) (model.JournalEntry, error) {
+		if id != entry.ID() {
+			return model.JournalEntry{}, port.ErrEntryNotFound
+		}
+		return entry, nil
+	}}
+	h := buildHandlerWithRepos(repo, &mockBalanceRepo{})
+
+	t.Run("returns the caller's entry", func(t *testing.T) {
+		resp, err := h.GetJournalEntry(ctx, &ledgerv1.GetJournalEntryRequest{Id: entry.ID().String()})
+		require.NoError(t, err)
+		assert.Equal(t, entry.ID().String(), resp.Entry.GetId())
+		assert.Equal(t, "REF-001", resp.Entry.GetReference())
+		require.Len(t, resp.Entry.GetPostings(), 1)
+	})
+
+	t.Run("missing entry returns NotFound", func(t *testing.T) {
+		_, err := h.GetJournalEntry(ctx, &ledgerv1.GetJournalEntryRequest{Id: uuid.NewString()})
+		requireGRPCCode(t, err, codes.NotFound)
+	})
+
+	t.Run("another tenant's entry returns NotFound", func(t *testing.T) {
+		_, err := h.GetJournalEntry(contextWithClaims(), &ledgerv1.GetJournalEntryRequest{Id: entry.ID().String()})
+		requireGRPCCode(t, err, codes.NotFound)
+	})
+
+	t.Run("invalid id returns InvalidArgument", func(t *testing.T) {
+		_, err := h.GetJournalEntry(ctx, &ledgerv1.GetJournalEntryRequest{Id: "entry-1"})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+}
+
+func TestOpenAccount(t *testing.T) {
+	t.Run("invalid account code returns InvalidArgument", func(t *testing.T) {
+		h := buildTestHandler()
+		_, err := h.OpenAccount(contextWithClaims(), &ledgerv1.OpenAccountRequest{
+			AccountCode: "checking",
+			Currency:    "USD",
+		})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("missing currency returns InvalidArgument", func(t *testing.T) {
+		h := buildTestHandler()
+		_, err := h.OpenAccount(contextWithClaims(), &ledgerv1.OpenAccountRequest{AccountCode: "2000-123"})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("customer role is denied", func(t *testing.T) {
+		h := buildTestHandler()
+		ctx := auth.ContextWithClaims(context.Background(), &auth.Claims{
+			TenantID: uuid.New(),
+			Roles:    []string{auth.RoleCustomer},
+		})
+		_, err := h.OpenAccount(ctx, &ledgerv1.OpenAccountRequest{AccountCode: "2000-123", Currency: "USD"})
+		requireGRPCCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("returns the opened account's balance", func(t *testing.T) {
+		h := buildHandlerWithRepos(&mockJournalRepo{}, &mockBalanceRepo{balance: decimal.Zero})
+
+		resp, err := h.OpenAccount(contextWithClaims(), &ledgerv1.OpenAccountRequest{
+			AccountCode: "2000-123",
+			Currency:    "USD",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "2000-123", resp.AccountCode)
+		assert.Equal(t, "USD", resp.Currency)
+		assert.Equal(t, "0", resp.Balance)
+	})
+}
+
+func TestGetTrialBalance(t *testing.T) {
+	newHandler := func(lines ...model.TrialBalanceLine) *LedgerHandler {
+		h := buildTestHandler()
+		h.trialBal = usecase.NewGetTrialBalance(&mockTrialBalanceRepo{lines: lines})
+		return h
+	}
+
+	t.Run("returns the caller's trial balance", func(t *testing.T) {
+		h := newHandler(
+			model.TrialBalanceLine{AccountCode: valueobject.MustAccountCode("1000"), Currency: "USD", Debit: decimal.NewFromInt(250), Credit: decimal.Zero},
+			model.TrialBalanceLine{AccountCode: valueobject.MustAccountCode("2000"), Currency: "USD", Debit: decimal.Zero, Credit: decimal.NewFromInt(250)},
+		)
+
+		resp, err := h.GetTrialBalance(contextWithClaims(), &ledgerv1.GetTrialBalanceRequest{Period: "2026-03"})
+		require.NoError(t, err)
+		assert.Equal(t, "2026-03", resp.Period)
+		assert.True(t, resp.Balanced)
+		require.Len(t, resp.Lines, 2)
+		assert.True(t, proto.Equal(&ledgerv1.TrialBalanceLine{AccountCode: "1000", Currency: "USD", Debit: "250", Credit: "0"}, resp.Lines[0]))
+		require.Len(t, resp.Totals, 1)
+		assert.True(t, proto.Equal(&ledgerv1.TrialBalanceTotal{Currency: "USD", Debit: "250", Credit: "250", Balanced: true}, resp.Totals[0]))
+		assert.Empty(t, resp.NextPageToken)
+	})
+
+	t.Run("another tenant's trial balance is denied", func(t *testing.T) {
+		_, err := newHandler().GetTrialBalance(contextWithClaims(), &ledgerv1.GetTrialBalanceRequest{
+			TenantId: uuid.NewString(),
+			Period:   "2026-03",
+		})
+		requireGRPCCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("invalid period returns InvalidArgument", func(t *testing.T) {
+		_, err := newHandler().GetTrialBalance(contextWithClaims(), &ledgerv1.GetTrialBalanceRequest{Period: "March"})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("customers are denied", func(t *testing.T) {
+		ctx := auth.ContextWithClaims(context.Background(), &auth.Claims{TenantID: uuid.New(), Roles: []string{auth.RoleCustomer}})
+		_, err := newHandler().GetTrialBalance(ctx, &ledgerv1.GetTrialBalanceRequest{Period: "2026-03"})
+		requireGRPCCode(t, err, codes.PermissionDenied)
+	})
+}
+
+func TestReopenPeriod(t *testing.T) {
+	newHandler := func(closed bool) *LedgerHandler {
+		h := buildTestHandler()
+		h.reopen = usecase.NewReopenPeriod(&mockFiscalPeriodRepo{closed: closed}, &mockEventPublisher{},
+			approval.NewManager(approval.NewMemoryStore(), usecase.ApprovalPolicy))
+		return h
+	}
+
+	t.Run("submits a reopening for approval", func(t *testing.T) {
+		resp, err := newHandler(true).ReopenPeriod(contextWithClaims(), &ledgerv1.ReopenPeriodRequest{
+			Year: 2026, Month: 3, Reason: "late supplier invoice",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, resp.Approval)
+		assert.Equal(t, usecase.ActionReopenPeriod, resp.Approval.Action)
+		assert.Equal(t, "PENDING", resp.Approval.Status)
+		assert.Equal(t, "CLOSED", resp.Status)
+	})
+
+	t.Run("an open period returns FailedPrecondition", func(t *testing.T) {
+		_, err := newHandler(false).ReopenPeriod(contextWithClaims(), &ledgerv1.ReopenPeriodRequest{
+			Year: 2026, Month: 3, Reason: "late supplier invoice",
+		})
+		requireGRPCCode(t, err, codes.FailedPrecondition)
+	})
+
+	t.Run("an unknown approval returns NotFound", func(t *testing.T) {
+		_, err := newHandler(true).ReopenPeriod(contextWithClaims(), &ledgerv1.ReopenPeriodRequest{
+			Year: 2026, Month: 3, ApprovalId: uuid.NewString(),
+		})
+		requireGRPCCode(t, err, codes.NotFound)
+	})
+
+	t.Run("customers are denied", func(t *testing.T) {
+		ctx := auth.ContextWithClaims(context.Background(), &auth.Claims{TenantID: uuid.New(), Roles: []string{auth.RoleCustomer}})
+		_, err := newHandler(true).ReopenPeriod(ctx, &ledgerv1.ReopenPeriodRequest{Year: 2026, Month: 3, Reason: "late"})
+		requireGRPCCode(t, err, codes.PermissionDenied)
+	})
+}
+
+// fakeAuditLogStream collects the records a StreamAuditLog call sends.
+type fakeAuditLogStream struct {
+	grpclib.ServerStream
+	ctx  context.Context
+	sent []*ledgerv1.AuditRecord
+}
+
+func (s *fakeAuditLogStream) Context() context.Context { return s.ctx }
+
+func (s *fakeAuditLogStream) Send(m *ledgerv1.AuditRecord) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestStreamAuditLog(t *testing.T) {
+	tenantID := uuid.New()
+	actorID := uuid.New()
+	occurredAt := time.Date(2026, time.March, 2, 10, 0, 0, 0, time.UTC)
+	repo := &mockAuditLogRepo{}
+	for seq := int64(1); seq <= 3; seq++ {
+		repo.records = append(repo.records, model.AuditRecord{
+			Sequence:      seq,
+			ID:            uuid.New(),
+			TenantID:      tenantID,
+			EntryID:       uuid.New(),
+			Action:        model.AuditActionPosted,
+			FromStatus:    model.EntryStatusPending,
+			ToStatus:      model.EntryStatusPosted,
+			EntryVersion:  2,
+			EffectiveDate: occurredAt,
+			ActorID:       &actorID,
+			OccurredAt:    occurredAt,
+		})
+	}
+	h := buildTestHandler()
+	h.streamAudit = usecase.NewStreamAuditLog(repo)
+
+	caller := func(role string) context.Context {
+		return auth.ContextWithClaims(context.Background(), &auth.Claims{
+			UserID:   uuid.New(),
+			TenantID: tenantID,
+			Roles:    []string{role},
+		})
+	}
+
+	t.Run("streams the caller's records after a sequence", func(t *testing.T) {
+		stream := &fakeAuditLogStream{ctx: caller(auth.RoleAuditor)}
+		err := h.StreamAuditLog(&ledgerv1.StreamAuditLogRequest{AfterSequence: 1}, stream)
+		require.NoError(t, err)
+		require.Len(t, stream.sent, 2)
+		assert.Equal(t, int64(2), stream.sent[0].Sequence)
+		assert.Equal(t, "POSTED", stream.sent[0].Action)
+		assert.Equal(t, "PENDING", stream.sent[0].FromStatus)
+		assert.Equal(t, actorID.String(), stream.sent[0].ActorId)
+		assert.Equal(t, "2026-03-02T10:00:00Z", stream.sent[0].OccurredAt)
+	})
+
+	t.Run("other tenants' records are not streamed", func(t *testing.T) {
+		stream := &fakeAuditLogStream{ctx: contextWithClaims()}
+		require.NoError(t, h.StreamAuditLog(&ledgerv1.StreamAuditLogRequest{}, stream))
+		assert.Empty(t, stream.sent)
+	})
+
+	t.Run("customer is denied", func(t *testing.T) {
+		err := h.StreamAuditLog(&ledgerv1.StreamAuditLogRequest{}, &fakeAuditLogStream{ctx: caller(auth.RoleCustomer)})
+		requireGRPCCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("range ending before it starts returns InvalidArgument", func(t *testing.T) {
+		err := h.StreamAuditLog(&ledgerv1.StreamAuditLogRequest{
+			From: "2026-03-02T00:00:00Z",
+			To:   "2026-03-01T00:00:00Z",
+		}, &fakeAuditLogStream{ctx: caller(auth.RoleCompliance)})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+}
+
+func TestExportAuditLog(t *testing.T) {
+	h := buildTestHandler()
+
+	resp, err := h.ExportAuditLog(contextWithClaims(), &ledgerv1.ExportAuditLogRequest{
+		From: "2026-01-01T00:00:00Z",
+		To:   "2026-04-01T00:00:00Z",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Uri, "ledger-audit/")
+
+	_, err = h.ExportAuditLog(contextWithClaims(), &ledgerv1.ExportAuditLogRequest{
+		From: "2024-01-01T00:00:00Z",
+		To:   "2026-01-01T00:00:00Z",
+	})
+	requireGRPCCode(t, err, codes.InvalidArgument)
+}
+
 func TestToJournalEntryMsg(t *testing.T) {
 	now := time.Now().UTC()
 	entryID := uuid.New()
@@ -317,17 +796,17 @@ func TestToJournalEntryMsg(t *testing.T) {
 		UpdatedAt:   now,
 	})
 
-	assert.Equal(t, entryID.String(), msg.ID)
-	assert.Equal(t, tenantID.String(), msg.TenantID)
-	assert.Equal(t, "POSTED", msg.Status)
+	assert.Equal(t, entryID.String(), msg.Id)
+	assert.Equal(t, tenantID.String(), msg.TenantId)
+	assert.Equal(t, ledgerv1.EntryStatus_ENTRY_STATUS_POSTED, msg.Status)
 	assert.Equal(t, "Test entry", msg.Description)
 	assert.Equal(t, "REF-001", msg.Reference)
-	assert.Equal(t, int32(1), msg.Version)
+	assert.Equal(t, int32(1), msg.Audit.GetVersion())
 	require.Len(t, msg.Postings, 1)
 	assert.Equal(t, "1000", msg.Postings[0].DebitAccount)
 	assert.Equal(t, "2000", msg.Postings[0].CreditAccount)
-	assert.Equal(t, "100", msg.Postings[0].Amount)
-	assert.Equal(t, "USD", msg.Postings[0].Currency)
+	assert.Equal(t, "100", msg.Postings[0].Amount.GetAmount())
+	assert.Equal(t, "USD", msg.Postings[0].Amount.GetCurrency())
 }
 
 // requireGRPCCode asserts that an error is a gRPC status error with the given code.
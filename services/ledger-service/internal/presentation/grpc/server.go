@@ -4,92 +4,66 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"net"
-	"os"
 
-	"github.com/bibbank/bib/pkg/auth"
-	"github.com/bibbank/bib/pkg/tlsutil"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/health"
-	"google.golang.org/grpc/health/grpc_health_v1"
-	"google.golang.org/grpc/reflection"
+
+	ledgerv1 "github.com/bibbank/bib/api/gen/go/bib/ledger/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/grpcserver"
 )
 
-// Server wraps a gRPC server for the ledger service.
+// Server wraps the gRPC server for ledger-service.
 type Server struct {
-	server  *grpc.Server
+	server  *grpcserver.Server
 	handler *LedgerHandler
-	logger  *slog.Logger
 	port    int
 }
 
-func NewServer(handler *LedgerHandler, port int, logger *slog.Logger, jwtService *auth.JWTService, opts ...grpc.ServerOption) *Server {
-	// Add auth interceptor, skipping health check methods.
-	authInterceptor := auth.UnaryAuthInterceptor(jwtService, []string{
-		"/grpc.health.v1.Health/Check",
-		"/grpc.health.v1.Health/Watch",
-	})
-	opts = append(opts, grpc.UnaryInterceptor(authInterceptor))
-
-	// Optional TLS: set GRPC_TLS_CERT_FILE and GRPC_TLS_KEY_FILE to enable.
-	if certFile, keyFile := os.Getenv("GRPC_TLS_CERT_FILE"), os.Getenv("GRPC_TLS_KEY_FILE"); certFile != "" && keyFile != "" {
-		creds, err := tlsutil.ServerTLSConfig(certFile, keyFile)
-		if err != nil {
-			logger.Error("failed to load TLS credentials, starting without TLS", "error", err)
-		} else {
-			opts = append(opts, grpc.Creds(creds))
-			logger.Info("gRPC TLS enabled", "cert", certFile, "key", keyFile)
-		}
-	} else {
-		logger.Info("gRPC TLS not configured, running without TLS")
-	}
-
-	srv := grpc.NewServer(opts...)
-
-	// Register health check
-	healthSrv := health.NewServer()
-	grpc_health_v1.RegisterHealthServer(srv, healthSrv)
-	healthSrv.SetServingStatus("ledger-service", grpc_health_v1.HealthCheckResponse_SERVING)
-
-	// Only enable reflection when GRPC_REFLECTION=true.
-	if os.Getenv("GRPC_REFLECTION") == "true" {
-		reflection.Register(srv)
+// NewServer creates a new gRPC server with the given handler. opts are
+// applied after the standard interceptors, so chained interceptors see the
+// caller's claims.
+func NewServer(handler *LedgerHandler, port int, logger *slog.Logger, jwtService *auth.JWTService, opts ...grpc.ServerOption) (*Server, error) {
+	srv, err := grpcserver.New(grpcserver.Config{
+		ServiceName:   "ledger-service",
+		JWTService:    jwtService,
+		ServerOptions: opts,
+	}, logger)
+	if err != nil {
+		return nil, err
 	}
-
-	// Register the LedgerService server.
-	RegisterLedgerServiceServer(srv, handler)
+	ledgerv1.RegisterLedgerServiceServer(srv, handler)
 
 	return &Server{
 		server:  srv,
 		handler: handler,
 		port:    port,
-		logger:  logger,
-	}
+	}, nil
 }
 
+// Start serves on the configured port until ctx is cancelled, then stops
+// gracefully.
 func (s *Server) Start(ctx context.Context) error {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
-	if err != nil {
-		return fmt.Errorf("failed to listen on port %d: %w", s.port, err)
-	}
-
-	s.logger.Info("gRPC server starting", "port", s.port)
-
 	errCh := make(chan error, 1)
 	go func() {
-		errCh <- s.server.Serve(lis)
+		errCh <- s.server.ListenAndServe(fmt.Sprintf(":%d", s.port))
 	}()
 
 	select {
 	case <-ctx.Done():
-		s.logger.Info("shutting down gRPC server")
-		s.server.GracefulStop()
+		s.server.Stop()
 		return nil
 	case err := <-errCh:
 		return err
 	}
 }
 
+// Stop gracefully stops the gRPC server.
 func (s *Server) Stop() {
-	s.server.GracefulStop()
+	s.server.Stop()
+}
+
+// RegisterService registers another service on the server, such as the
+// outbox administration service.
+func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	s.server.RegisterService(desc, impl)
 }
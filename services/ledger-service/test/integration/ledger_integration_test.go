@@ -6,6 +6,7 @@ import (
 	"context"
 	"path/filepath"
 	"runtime"
+	"sync"
 	"testing"
 	"time"
 
@@ -103,6 +104,40 @@ func TestJournalRepository_SaveAndGet(t *testing.T) {
 	assert.Equal(t, "Test posting", p.Description())
 }
 
+func TestJournalRepository_SaveAndGetMultiLeg(t *testing.T) {
+	pool := setupTestDB(t)
+	repo := postgres.NewJournalRepo(pool)
+	ctx := context.Background()
+
+	var legs []valueobject.PostingLeg
+	for _, l := range []struct {
+		code   string
+		side   valueobject.PostingSide
+		amount int64
+	}{
+		{"1000", valueobject.SideDebit, 100},
+		{"2000", valueobject.SideCredit, 97},
+		{"4100", valueobject.SideCredit, 3},
+	} {
+		leg, err := valueobject.NewPostingLeg(valueobject.MustAccountCode(l.code), l.side, decimal.NewFromInt(l.amount), "USD", "Card payment")
+		require.NoError(t, err)
+		legs = append(legs, leg)
+	}
+	entry, err := model.NewJournalEntryFromLegs(uuid.New(), time.Now().UTC(), legs, "Card payment with fee", "REF-ML")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, entry))
+
+	retrieved, err := repo.FindByID(ctx, entry.ID())
+	require.NoError(t, err)
+	require.Len(t, retrieved.Legs(), 3)
+	for i, l := range retrieved.Legs() {
+		assert.True(t, l.Account().Equal(legs[i].Account()))
+		assert.Equal(t, legs[i].Side(), l.Side())
+		assert.True(t, l.Amount().Equal(legs[i].Amount()))
+	}
+	assert.Len(t, retrieved.Postings(), 2)
+}
+
 func TestJournalRepository_List(t *testing.T) {
 	pool := setupTestDB(t)
 	repo := postgres.NewJournalRepo(pool)
@@ -154,6 +189,159 @@ func TestJournalRepository_List(t *testing.T) {
 	}
 }
 
+func TestJournalRepository_TrialBalance(t *testing.T) {
+	pool := setupTestDB(t)
+	repo := postgres.NewJournalRepo(pool)
+	ctx := context.Background()
+	tenantID := uuid.New()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	posted, err := newTestEntry(t, tenantID).Post(now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, posted))
+
+	reversedOriginal, err := newTestEntry(t, tenantID).Post(now)
+	require.NoError(t, err)
+	reversed, reversal, err := reversedOriginal.Reverse(now, "duplicate")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, reversed))
+	require.NoError(t, repo.Save(ctx, reversal))
+
+	// Pending entries and other tenants' entries are left out.
+	require.NoError(t, repo.Save(ctx, newTestEntry(t, tenantID)))
+	other, err := newTestEntry(t, uuid.New()).Post(now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, other))
+
+	lines, err := repo.TrialBalance(ctx, tenantID, now.AddDate(0, 0, -1), now.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "1000", lines[0].AccountCode.Code())
+	assert.Equal(t, "USD", lines[0].Currency)
+	assert.True(t, decimal.NewFromInt(2000).Equal(lines[0].Debit), "debit %s", lines[0].Debit)
+	assert.True(t, decimal.NewFromInt(1000).Equal(lines[0].Credit), "credit %s", lines[0].Credit)
+	assert.Equal(t, "2000", lines[1].AccountCode.Code())
+	assert.True(t, decimal.NewFromInt(1000).Equal(lines[1].Debit), "debit %s", lines[1].Debit)
+	assert.True(t, decimal.NewFromInt(2000).Equal(lines[1].Credit), "credit %s", lines[1].Credit)
+
+	lines, err = repo.TrialBalance(ctx, tenantID, now.AddDate(0, 0, 1), now.AddDate(0, 0, 2))
+	require.NoError(t, err)
+	assert.Empty(t, lines)
+}
+
+func TestAuditLog_AppendOnly(t *testing.T) {
+	pool := setupTestDB(t)
+	repo := postgres.NewJournalRepo(pool)
+	auditRepo := postgres.NewAuditLogRepo(pool)
+	ctx := context.Background()
+	tenantID := uuid.New()
+	actorID := uuid.New()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	posted, err := newTestEntry(t, tenantID).WithActor(&actorID).Post(now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, posted))
+
+	records, err := auditRepo.ListAuditLog(ctx, tenantID, now.Add(-time.Hour), now.Add(time.Hour), 0, 10)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, model.AuditActionCreated, records[0].Action)
+	assert.Equal(t, model.AuditActionPosted, records[1].Action)
+	assert.Equal(t, model.EntryStatusPosted, records[1].ToStatus)
+	require.NotNil(t, records[1].ActorID)
+	assert.Equal(t, actorID, *records[1].ActorID)
+	assert.Greater(t, records[1].Sequence, records[0].Sequence)
+
+	// The log cannot be rewritten.
+	_, err = pool.Exec(ctx, "UPDATE ledger_audit_log SET detail = 'edited' WHERE entry_id = $1", posted.ID())
+	assert.Error(t, err)
+	_, err = pool.Exec(ctx, "DELETE FROM ledger_audit_log WHERE entry_id = $1", posted.ID())
+	assert.Error(t, err)
+}
+
+func TestAuditLog_ConcurrentSavesResumeInOrder(t *testing.T) {
+	pool := setupTestDB(t)
+	repo := postgres.NewJournalRepo(pool)
+	auditRepo := postgres.NewAuditLogRepo(pool)
+	ctx := context.Background()
+	tenantID, otherTenantID := uuid.New(), uuid.New()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	from, to := now.Add(-time.Hour), now.Add(time.Hour)
+
+	// Writers for two tenants save entries concurrently while a reader
+	// tails the first tenant's log, resuming after the last seq it read as
+	// a stream does. A record committed after a higher seq was read would
+	// be skipped.
+	const writers, entriesPerWriter = 8, 5
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*writers)
+	for _, tenant := range []uuid.UUID{tenantID, otherTenantID} {
+		for range writers {
+			entries := make([]model.JournalEntry, entriesPerWriter)
+			for i := range entries {
+				posted, err := newTestEntry(t, tenant).Post(now)
+				require.NoError(t, err)
+				entries[i] = posted
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for _, entry := range entries {
+					if err := repo.Save(ctx, entry); err != nil {
+						errs <- err
+						return
+					}
+				}
+			}()
+		}
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	var (
+		seqs    []int64
+		lastSeq int64
+	)
+	read := func() {
+		records, err := auditRepo.ListAuditLog(ctx, tenantID, from, to, lastSeq, 3)
+		require.NoError(t, err)
+		for _, rec := range records {
+			seqs = append(seqs, rec.Sequence)
+			lastSeq = rec.Sequence
+		}
+	}
+	for tailing := true; tailing; {
+		select {
+		case <-done:
+			tailing = false
+		default:
+			read()
+		}
+	}
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	for {
+		n := len(seqs)
+		read()
+		if len(seqs) == n {
+			break
+		}
+	}
+
+	// Each posted entry appends CREATED and POSTED, numbered from 1 per
+	// tenant with no gaps, and the reader saw every one.
+	want := make([]int64, 2*writers*entriesPerWriter)
+	for i := range want {
+		want[i] = int64(i + 1)
+	}
+	assert.Equal(t, want, seqs)
+}
+
 func TestBalanceRepository_GetBalance(t *testing.T) {
 	pool := setupTestDB(t)
 	balanceRepo := postgres.NewBalanceRepo(pool)
@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
@@ -10,15 +9,20 @@ import (
 	"syscall"
 	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
 	"github.com/bibbank/bib/pkg/auth"
 	pkgkafka "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
 	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
 	pkgpostgres "github.com/bibbank/bib/pkg/postgres"
 	"github.com/bibbank/bib/services/lending-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/lending-service/internal/domain/port"
 	"github.com/bibbank/bib/services/lending-service/internal/domain/service"
 	"github.com/bibbank/bib/services/lending-service/internal/infrastructure/adapter"
 	"github.com/bibbank/bib/services/lending-service/internal/infrastructure/config"
-	"github.com/bibbank/bib/services/lending-service/internal/infrastructure/kafka"
 	pgRepo "github.com/bibbank/bib/services/lending-service/internal/infrastructure/postgres"
 	grpcPresentation "github.com/bibbank/bib/services/lending-service/internal/presentation/grpc"
 	"github.com/bibbank/bib/services/lending-service/internal/presentation/rest"
@@ -37,6 +41,7 @@ func main() {
 		Format: "json",
 	})
 	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
 
 	logger.Info("starting lending-service",
 		"http_port", cfg.HTTPPort,
@@ -52,7 +57,7 @@ func main() {
 	if err != nil {
 		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
 	} else {
-		defer func() { _ = shutdown(ctx) }() //nolint:errcheck // best-effort tracer shutdown
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
 	}
 
 	// Database connection.
@@ -71,7 +76,7 @@ func main() {
 		logger.Error("failed to connect to database", "error", err)
 		os.Exit(1)
 	}
-	defer pool.Close()
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
 	logger.Info("connected to database")
 
 	// Run database migrations.
@@ -93,23 +98,64 @@ func main() {
 	kafkaProducer := pkgkafka.NewProducer(pkgkafka.Config{
 		Brokers: cfg.Kafka.Brokers,
 	})
-	defer kafkaProducer.Close()
-	publisher := kafka.NewEventPublisher(kafkaProducer, "lending-events", logger)
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(kafkaProducer.Close))
+
+	// Outbox relay: events are written to the outbox and published from it,
+	// so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     outbox.StaticRoute("lending-events"),
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: cfg.ServiceName,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, kafkaProducer, relayCfg, logger)
+	if err != nil {
+		logger.Error("failed to create outbox relay", "error", err)
+		os.Exit(1)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+	publisher := pgRepo.NewOutboxPublisher(outbox.NewPublisher(outboxStore), "lending-events")
 	creditClient := adapter.NewStubCreditBureauClient()
+	var consentChecker port.ConsentChecker = adapter.NewStubConsentChecker()
+	if cfg.ConsentAddr != "" {
+		consentConn, dialErr := grpc.NewClient(cfg.ConsentAddr,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if dialErr != nil {
+			logger.Error("failed to create consent service client", "addr", cfg.ConsentAddr, "error", dialErr)
+			os.Exit(1)
+		}
+		lc.OnStop(lifecycle.PhaseResources, "consent client", lifecycle.Close(consentConn.Close))
+		consentChecker = adapter.NewConsentGRPCClient(consentConn, 5*time.Second)
+	} else {
+		logger.Warn("CONSENT_SERVICE_ADDR not set, credit pulls will not be checked for consent")
+	}
 	underwriter := service.NewUnderwritingEngine()
 
 	// Wire use cases.
-	submitAppUC := usecase.NewSubmitLoanApplicationUseCase(appRepo, publisher, creditClient, underwriter)
+	submitAppUC := usecase.NewSubmitLoanApplicationUseCase(appRepo, publisher, creditClient, consentChecker, underwriter)
 	disburseUC := usecase.NewDisburseLoanUseCase(appRepo, loanRepo, publisher)
 	paymentUC := usecase.NewMakePaymentUseCase(loanRepo, publisher)
 	getLoanUC := usecase.NewGetLoanUseCase(loanRepo)
 	getAppUC := usecase.NewGetApplicationUseCase(appRepo)
+	accrueInterestUC := usecase.NewAccrueInterestUseCase(loanRepo, publisher)
 
 	// JWT service (validation-only: public key preferred, secret as fallback).
 	jwtCfg := auth.JWTConfig{
 		Issuer: "bib-gateway",
 	}
 	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
 	case os.Getenv("JWT_PUBLIC_KEY") != "":
 		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
 	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
@@ -134,13 +180,21 @@ func main() {
 
 	// gRPC server.
 	handler := grpcPresentation.NewLendingHandler(submitAppUC, disburseUC, paymentUC, getLoanUC, getAppUC,
-		logger)
-	grpcServer := grpcPresentation.NewServer(handler, logger, jwtSvc)
+		accrueInterestUC, logger)
+	grpcServer, err := grpcPresentation.NewServer(handler, logger, jwtSvc)
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
 
 	// HTTP server (health checks).
 	mux := http.NewServeMux()
 	healthHandler := rest.NewHealthHandler(logger)
 	healthHandler.RegisterRoutes(mux)
+	if metricsHandler != nil {
+		mux.Handle("/metrics", metricsHandler)
+	}
 
 	httpServer := &http.Server{
 		Addr:              cfg.HTTPAddr(),
@@ -149,39 +203,17 @@ func main() {
 	}
 
 	// Start servers.
-	errCh := make(chan error, 2)
-
-	go func() {
-		if err := grpcServer.Serve(cfg.GRPCAddr()); err != nil {
-			errCh <- fmt.Errorf("gRPC server error: %w", err)
-		}
-	}()
-
-	go func() {
-		logger.Info("HTTP server starting", "port", cfg.HTTPPort)
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			errCh <- fmt.Errorf("HTTP server error: %w", err)
-		}
-	}()
-
-	// Wait for shutdown signal.
-	select {
-	case <-ctx.Done():
-		logger.Info("shutdown signal received")
-	case err := <-errCh:
-		logger.Error("server error", "error", err)
-	}
-
-	// Graceful shutdown.
-	grpcServer.GracefulStop()
-
-	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 15*time.Second)
-	defer shutdownCancel()
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", func(context.Context) error {
+		return grpcServer.Serve(cfg.GRPCAddr())
+	})
+	lc.OnStop(lifecycle.PhaseIngress, "gRPC server", lifecycle.Func(grpcServer.GracefulStop))
+	lc.ServeHTTP("HTTP server", httpServer)
 
-	if err := httpServer.Shutdown(shutdownCtx); err != nil {
-		logger.Error("HTTP server shutdown error", "error", err)
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
 	}
-
 	logger.Info("lending-service stopped")
 }
 
@@ -35,6 +35,12 @@ type MakePaymentRequest struct {
 	Amount   decimal.Decimal `json:"amount"`
 }
 
+// AccrueInterestRequest carries the day a tenant's loans accrue interest to.
+type AccrueInterestRequest struct {
+	AsOf     time.Time `json:"as_of"`
+	TenantID string    `json:"tenant_id"`
+}
+
 // GetLoanRequest identifies a loan to retrieve.
 type GetLoanRequest struct {
 	TenantID string `json:"tenant_id"`
@@ -95,6 +101,12 @@ type LoanResponse struct {
 	TermMonths         int                         `json:"term_months"`
 }
 
+// AccrueInterestResponse summarizes an interest accrual run.
+type AccrueInterestResponse struct {
+	TotalAccrued   decimal.Decimal `json:"total_accrued"`
+	LoansProcessed int             `json:"loans_processed"`
+}
+
 // PaymentResponse is the external representation of a payment result.
 type PaymentResponse struct {
 	LoanID             string          `json:"loan_id"`
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/lending-service/internal/application/dto"
+	"github.com/bibbank/bib/services/lending-service/internal/domain/port"
+)
+
+// AccrueInterestUseCase accrues daily interest on a tenant's active and
+// delinquent loans. The events it publishes are posted by the ledger as
+// interest receivable and income.
+type AccrueInterestUseCase struct {
+	loanRepo  port.LoanRepository
+	publisher port.EventPublisher
+}
+
+// NewAccrueInterestUseCase wires dependencies.
+func NewAccrueInterestUseCase(
+	loanRepo port.LoanRepository,
+	publisher port.EventPublisher,
+) *AccrueInterestUseCase {
+	return &AccrueInterestUseCase{
+		loanRepo:  loanRepo,
+		publisher: publisher,
+	}
+}
+
+// Execute accrues interest on every accruing loan up to the request's date.
+// Loans already accrued to that date accrue nothing, so reruns are safe.
+func (uc *AccrueInterestUseCase) Execute(
+	ctx context.Context,
+	req dto.AccrueInterestRequest,
+) (dto.AccrueInterestResponse, error) {
+	loans, err := uc.loanRepo.FindAccruing(ctx, req.TenantID)
+	if err != nil {
+		return dto.AccrueInterestResponse{}, fmt.Errorf("find accruing loans: %w", err)
+	}
+
+	total := decimal.Zero
+	processed := 0
+	for _, loan := range loans {
+		accrued, err := loan.AccrueInterest(req.AsOf)
+		if err != nil {
+			return dto.AccrueInterestResponse{}, fmt.Errorf("accrue interest on loan %s: %w", loan.ID(), err)
+		}
+		processed++
+		if len(accrued.DomainEvents()) == 0 {
+			continue
+		}
+
+		if err := uc.loanRepo.Save(ctx, accrued); err != nil {
+			return dto.AccrueInterestResponse{}, fmt.Errorf("save loan %s: %w", loan.ID(), err)
+		}
+		if err := uc.publisher.Publish(ctx, accrued.DomainEvents()...); err != nil {
+			return dto.AccrueInterestResponse{}, fmt.Errorf("publish events for loan %s: %w", loan.ID(), err)
+		}
+		total = total.Add(accrued.AccruedInterest().Sub(loan.AccruedInterest()))
+	}
+
+	return dto.AccrueInterestResponse{
+		TotalAccrued:   total,
+		LoansProcessed: processed,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/lending-service/internal/application/dto"
+	"github.com/bibbank/bib/services/lending-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/lending-service/internal/domain/event"
+	"github.com/bibbank/bib/services/lending-service/internal/domain/model"
+	"github.com/bibbank/bib/services/lending-service/internal/domain/valueobject"
+)
+
+func accruingLoan(status valueobject.LoanStatus, lastAccrual time.Time) model.Loan {
+	disbursed := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	return model.ReconstructLoan(
+		"loan-001", "tenant-001", "app-001", "account-001",
+		decimal.NewFromInt(36500), "USD", 1000, 12,
+		status,
+		[]model.AmortizationEntry{},
+		decimal.NewFromInt(36500),
+		disbursed.AddDate(0, 1, 0),
+		decimal.Zero, lastAccrual,
+		1, disbursed, disbursed,
+	)
+}
+
+func TestAccrueInterest_Execute(t *testing.T) {
+	t.Run("accrues daily interest since disbursement", func(t *testing.T) {
+		loanRepo := &mockLoanRepository{accruingLoans: []model.Loan{accruingLoan(valueobject.LoanStatusActive, time.Time{})}}
+		publisher := &mockLendingEventPublisher{}
+		uc := usecase.NewAccrueInterestUseCase(loanRepo, publisher)
+
+		resp, err := uc.Execute(context.Background(), dto.AccrueInterestRequest{
+			TenantID: "tenant-001",
+			AsOf:     time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC),
+		})
+
+		require.NoError(t, err)
+		// 36,500 at 10% is 10 a day; three days have passed.
+		assert.True(t, resp.TotalAccrued.Equal(decimal.NewFromInt(30)), "accrued %s", resp.TotalAccrued)
+		assert.Equal(t, 1, resp.LoansProcessed)
+		require.Len(t, loanRepo.savedLoans, 1)
+		assert.True(t, loanRepo.savedLoans[0].AccruedInterest().Equal(decimal.NewFromInt(30)))
+		require.Len(t, publisher.publishedEvents, 1)
+		evt, ok := publisher.publishedEvents[0].(event.InterestAccrued)
+		require.True(t, ok)
+		assert.Equal(t, "lending.loan.interest_accrued", evt.EventType())
+		assert.Equal(t, "loan-001", evt.LoanID)
+		assert.True(t, evt.Amount.Equal(decimal.NewFromInt(30)))
+	})
+
+	t.Run("accrues from the last accrual", func(t *testing.T) {
+		loanRepo := &mockLoanRepository{accruingLoans: []model.Loan{
+			accruingLoan(valueobject.LoanStatusDelinquent, time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)),
+		}}
+		uc := usecase.NewAccrueInterestUseCase(loanRepo, &mockLendingEventPublisher{})
+
+		resp, err := uc.Execute(context.Background(), dto.AccrueInterestRequest{
+			TenantID: "tenant-001",
+			AsOf:     time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC),
+		})
+
+		require.NoError(t, err)
+		assert.True(t, resp.TotalAccrued.Equal(decimal.NewFromInt(10)), "accrued %s", resp.TotalAccrued)
+	})
+
+	t.Run("a loan already accrued to the date accrues nothing", func(t *testing.T) {
+		asOf := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+		loanRepo := &mockLoanRepository{accruingLoans: []model.Loan{accruingLoan(valueobject.LoanStatusActive, asOf)}}
+		publisher := &mockLendingEventPublisher{}
+		uc := usecase.NewAccrueInterestUseCase(loanRepo, publisher)
+
+		resp, err := uc.Execute(context.Background(), dto.AccrueInterestRequest{TenantID: "tenant-001", AsOf: asOf})
+
+		require.NoError(t, err)
+		assert.True(t, resp.TotalAccrued.IsZero())
+		assert.Empty(t, loanRepo.savedLoans)
+		assert.Empty(t, publisher.publishedEvents)
+	})
+
+	t.Run("loans in default do not accrue", func(t *testing.T) {
+		loan := accruingLoan(valueobject.LoanStatusDefault, time.Time{})
+
+		_, err := loan.AccrueInterest(time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC))
+
+		assert.Error(t, err)
+	})
+}
@@ -26,6 +26,7 @@ func TestGetLoanUseCase_Execute(t *testing.T) {
 			[]model.AmortizationEntry{},
 			decimal.NewFromInt(50000),
 			now.AddDate(0, 1, 0),
+			decimal.Zero, time.Time{},
 			1, now, now,
 		)
 
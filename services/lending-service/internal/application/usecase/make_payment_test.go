@@ -26,6 +26,7 @@ func activeLoan() model.Loan {
 		[]model.AmortizationEntry{},
 		decimal.NewFromInt(10000),
 		now.AddDate(0, 1, 0),
+		decimal.Zero, time.Time{},
 		1, now, now,
 	)
 }
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,12 +12,17 @@ import (
 	"github.com/bibbank/bib/services/lending-service/internal/domain/service"
 )
 
+// ErrCreditPullNotConsented is returned when an applicant has not
+// authorized the credit bureau pull underwriting needs.
+var ErrCreditPullNotConsented = errors.New("applicant has not consented to a credit pull")
+
 // SubmitLoanApplicationUseCase orchestrates new loan application submission,
 // credit score fetching, and underwriting.
 type SubmitLoanApplicationUseCase struct {
 	appRepo      port.LoanApplicationRepository
 	publisher    port.EventPublisher
 	creditClient port.CreditBureauClient
+	consents     port.ConsentChecker
 	underwriter  *service.UnderwritingEngine
 }
 
@@ -25,12 +31,14 @@ func NewSubmitLoanApplicationUseCase(
 	appRepo port.LoanApplicationRepository,
 	publisher port.EventPublisher,
 	creditClient port.CreditBureauClient,
+	consents port.ConsentChecker,
 	underwriter *service.UnderwritingEngine,
 ) *SubmitLoanApplicationUseCase {
 	return &SubmitLoanApplicationUseCase{
 		appRepo:      appRepo,
 		publisher:    publisher,
 		creditClient: creditClient,
+		consents:     consents,
 		underwriter:  underwriter,
 	}
 }
@@ -57,7 +65,15 @@ func (uc *SubmitLoanApplicationUseCase) Execute(
 		return dto.LoanApplicationResponse{}, fmt.Errorf("submit for review: %w", err)
 	}
 
-	// 3. Fetch credit score from bureau.
+	// 3. Fetch credit score from bureau, which the applicant must have
+	// authorized.
+	consented, err := uc.consents.CreditPullConsented(ctx, req.ApplicantID)
+	if err != nil {
+		return dto.LoanApplicationResponse{}, fmt.Errorf("check credit pull consent: %w", err)
+	}
+	if !consented {
+		return dto.LoanApplicationResponse{}, ErrCreditPullNotConsented
+	}
 	creditScore, err := uc.creditClient.GetCreditScore(ctx, req.ApplicantID)
 	if err != nil {
 		return dto.LoanApplicationResponse{}, fmt.Errorf("fetch credit score: %w", err)
@@ -44,9 +44,10 @@ func (m *mockLoanApplicationRepository) FindByApplicantID(_ context.Context, _,
 }
 
 type mockLoanRepository struct {
-	saveFunc     func(ctx context.Context, loan model.Loan) error
-	findByIDFunc func(ctx context.Context, tenantID, id string) (model.Loan, error)
-	savedLoans   []model.Loan
+	saveFunc      func(ctx context.Context, loan model.Loan) error
+	findByIDFunc  func(ctx context.Context, tenantID, id string) (model.Loan, error)
+	savedLoans    []model.Loan
+	accruingLoans []model.Loan
 }
 
 func (m *mockLoanRepository) Save(ctx context.Context, loan model.Loan) error {
@@ -72,6 +73,10 @@ func (m *mockLoanRepository) FindByBorrowerAccountID(_ context.Context, _, _ str
 	return nil, nil
 }
 
+func (m *mockLoanRepository) FindAccruing(_ context.Context, _ string) ([]model.Loan, error) {
+	return m.accruingLoans, nil
+}
+
 type mockLendingEventPublisher struct {
 	publishFunc     func(ctx context.Context, events ...event.DomainEvent) error
 	publishedEvents []event.DomainEvent
@@ -96,6 +101,15 @@ func (m *mockCreditBureauClient) GetCreditScore(ctx context.Context, applicantID
 	return "750", nil
 }
 
+type mockConsentChecker struct {
+	err       error
+	consented bool
+}
+
+func (m *mockConsentChecker) CreditPullConsented(_ context.Context, _ string) (bool, error) {
+	return m.consented, m.err
+}
+
 // --- Tests ---
 
 func validSubmitRequest() dto.SubmitApplicationRequest {
@@ -120,7 +134,7 @@ func TestSubmitLoanApplication_Execute(t *testing.T) {
 		}
 		underwriter := service.NewUnderwritingEngine()
 
-		uc := usecase.NewSubmitLoanApplicationUseCase(appRepo, publisher, creditClient, underwriter)
+		uc := usecase.NewSubmitLoanApplicationUseCase(appRepo, publisher, creditClient, &mockConsentChecker{consented: true}, underwriter)
 
 		req := validSubmitRequest()
 		resp, err := uc.Execute(context.Background(), req)
@@ -145,7 +159,7 @@ func TestSubmitLoanApplication_Execute(t *testing.T) {
 		}
 		underwriter := service.NewUnderwritingEngine()
 
-		uc := usecase.NewSubmitLoanApplicationUseCase(appRepo, publisher, creditClient, underwriter)
+		uc := usecase.NewSubmitLoanApplicationUseCase(appRepo, publisher, creditClient, &mockConsentChecker{consented: true}, underwriter)
 
 		req := validSubmitRequest()
 		resp, err := uc.Execute(context.Background(), req)
@@ -161,7 +175,7 @@ func TestSubmitLoanApplication_Execute(t *testing.T) {
 		creditClient := &mockCreditBureauClient{}
 		underwriter := service.NewUnderwritingEngine()
 
-		uc := usecase.NewSubmitLoanApplicationUseCase(appRepo, publisher, creditClient, underwriter)
+		uc := usecase.NewSubmitLoanApplicationUseCase(appRepo, publisher, creditClient, &mockConsentChecker{consented: true}, underwriter)
 
 		req := validSubmitRequest()
 		req.TenantID = "" // invalid
@@ -181,7 +195,7 @@ func TestSubmitLoanApplication_Execute(t *testing.T) {
 		}
 		underwriter := service.NewUnderwritingEngine()
 
-		uc := usecase.NewSubmitLoanApplicationUseCase(appRepo, publisher, creditClient, underwriter)
+		uc := usecase.NewSubmitLoanApplicationUseCase(appRepo, publisher, creditClient, &mockConsentChecker{consented: true}, underwriter)
 
 		req := validSubmitRequest()
 		_, err := uc.Execute(context.Background(), req)
@@ -200,7 +214,7 @@ func TestSubmitLoanApplication_Execute(t *testing.T) {
 		creditClient := &mockCreditBureauClient{}
 		underwriter := service.NewUnderwritingEngine()
 
-		uc := usecase.NewSubmitLoanApplicationUseCase(appRepo, publisher, creditClient, underwriter)
+		uc := usecase.NewSubmitLoanApplicationUseCase(appRepo, publisher, creditClient, &mockConsentChecker{consented: true}, underwriter)
 
 		req := validSubmitRequest()
 		_, err := uc.Execute(context.Background(), req)
@@ -219,7 +233,7 @@ func TestSubmitLoanApplication_Execute(t *testing.T) {
 		creditClient := &mockCreditBureauClient{}
 		underwriter := service.NewUnderwritingEngine()
 
-		uc := usecase.NewSubmitLoanApplicationUseCase(appRepo, publisher, creditClient, underwriter)
+		uc := usecase.NewSubmitLoanApplicationUseCase(appRepo, publisher, creditClient, &mockConsentChecker{consented: true}, underwriter)
 
 		req := validSubmitRequest()
 		_, err := uc.Execute(context.Background(), req)
@@ -227,4 +241,24 @@ func TestSubmitLoanApplication_Execute(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "publish events")
 	})
+	t.Run("refuses an applicant without credit pull consent", func(t *testing.T) {
+		appRepo := &mockLoanApplicationRepository{}
+		publisher := &mockLendingEventPublisher{}
+		pulled := false
+		creditClient := &mockCreditBureauClient{
+			getCreditScoreFunc: func(_ context.Context, _ string) (string, error) {
+				pulled = true
+				return "750", nil
+			},
+		}
+		underwriter := service.NewUnderwritingEngine()
+
+		uc := usecase.NewSubmitLoanApplicationUseCase(appRepo, publisher, creditClient, &mockConsentChecker{}, underwriter)
+
+		_, err := uc.Execute(context.Background(), validSubmitRequest())
+
+		require.ErrorIs(t, err, usecase.ErrCreditPullNotConsented)
+		assert.False(t, pulled, "credit pulled without consent")
+		assert.Empty(t, appRepo.savedApps)
+	})
 }
@@ -0,0 +1,19 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[LoanApplicationSubmitted]("lending.loan_application.submitted", 1, "Raised when a new application enters the system."),
+		events.Declare[LoanApplicationApproved]("lending.loan_application.approved", 1, "Raised when an application is approved."),
+		events.Declare[LoanApplicationRejected]("lending.loan_application.rejected", 1, "Raised when an application is rejected."),
+		events.Declare[LoanDisbursed]("lending.loan.disbursed", 1, "Raised when funds are disbursed to the borrower."),
+		events.Declare[PaymentReceived]("lending.loan.payment_received", 1, "Raised when a payment is applied to a loan."),
+		events.Declare[LoanDelinquent]("lending.loan.delinquent", 1, "Raised when a loan becomes delinquent."),
+		events.Declare[LoanDefault]("lending.loan.default", 1, "Raised when a loan enters default."),
+		events.Declare[LoanPaidOff]("lending.loan.paid_off", 1, "Raised when a loan is fully paid off."),
+		events.Declare[InterestAccrued]("lending.loan.interest_accrued", 1, "Raised when interest accrues on a loan."),
+	}
+}
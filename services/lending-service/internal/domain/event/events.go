@@ -166,3 +166,22 @@ func NewLoanPaidOff(loanID, tenantID string, _ time.Time) LoanPaidOff {
 		BaseEvent: events.NewBaseEvent("lending.loan.paid_off", loanID, "Loan", tenantID),
 	}
 }
+
+// InterestAccrued is raised when interest accrues on a loan.
+type InterestAccrued struct {
+	AsOf time.Time `json:"as_of"`
+	events.BaseEvent
+	LoanID   string          `json:"loan_id"`
+	Amount   decimal.Decimal `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+func NewInterestAccrued(loanID, tenantID string, amount decimal.Decimal, currency string, asOf time.Time) InterestAccrued {
+	return InterestAccrued{
+		BaseEvent: events.NewBaseEvent("lending.loan.interest_accrued", loanID, "Loan", tenantID),
+		LoanID:    loanID,
+		Amount:    amount,
+		Currency:  currency,
+		AsOf:      asOf,
+	}
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,6 +20,7 @@ import (
 // Loan is an immutable aggregate. Mutations return a new copy.
 type Loan struct {
 	nextPaymentDue     time.Time
+	lastAccrualDate    time.Time
 	updatedAt          time.Time
 	createdAt          time.Time
 	status             valueobject.LoanStatus
@@ -26,6 +28,7 @@ type Loan struct {
 	currency           string
 	id                 string
 	outstandingBalance decimal.Decimal
+	accruedInterest    decimal.Decimal
 	borrowerAccountID  string
 	applicationID      string
 	tenantID           string
@@ -112,6 +115,8 @@ func ReconstructLoan(
 	schedule []AmortizationEntry,
 	outstandingBalance decimal.Decimal,
 	nextPaymentDue time.Time,
+	accruedInterest decimal.Decimal,
+	lastAccrualDate time.Time,
 	version int,
 	createdAt, updatedAt time.Time,
 ) Loan {
@@ -128,6 +133,8 @@ func ReconstructLoan(
 		schedule:           schedule,
 		outstandingBalance: outstandingBalance,
 		nextPaymentDue:     nextPaymentDue,
+		accruedInterest:    accruedInterest,
+		lastAccrualDate:    lastAccrualDate,
 		version:            version,
 		createdAt:          createdAt,
 		updatedAt:          updatedAt,
@@ -167,6 +174,44 @@ func (l Loan) MakePayment(amount decimal.Decimal, now time.Time) (Loan, error) {
 	return next, nil
 }
 
+// AccrueInterest accrues interest on the outstanding balance for the days
+// since the last accrual, or since disbursement, up to asOf: balance x rate /
+// 365 per day, rounded to 4 decimal places. Active and delinquent loans
+// accrue; loans in default are non-accrual. It emits InterestAccrued for
+// the ledger to recognize the income.
+func (l Loan) AccrueInterest(asOf time.Time) (Loan, error) {
+	if !l.status.Equal(valueobject.LoanStatusActive) && !l.status.Equal(valueobject.LoanStatusDelinquent) {
+		return l, errors.New("interest accrues only on active or delinquent loans")
+	}
+	from := l.lastAccrualDate
+	if from.IsZero() {
+		from = l.createdAt
+	}
+	days := daysBetween(from, asOf)
+	if days < 0 {
+		return l, fmt.Errorf("accrual date %s is before last accrual date %s", asOf.Format(time.DateOnly), from.Format(time.DateOnly))
+	}
+	if days == 0 {
+		return l, nil
+	}
+
+	interest := l.outstandingBalance.
+		Mul(decimal.NewFromInt(int64(l.interestRateBps))).
+		Div(decimal.NewFromInt(10000 * daysPerYear)).
+		Mul(decimal.NewFromInt(int64(days))).
+		Round(4)
+
+	next := l
+	next.accruedInterest = l.accruedInterest.Add(interest)
+	next.lastAccrualDate = asOf
+	next.updatedAt = asOf
+	next.domainEvents = copyEvents(l.domainEvents)
+	next.domainEvents = append(next.domainEvents, event.NewInterestAccrued(
+		l.id, l.tenantID, interest, l.currency, asOf,
+	))
+	return next, nil
+}
+
 // MarkDelinquent transitions ACTIVE -> DELINQUENT.
 func (l Loan) MarkDelinquent(now time.Time) (Loan, error) {
 	if !l.status.Equal(valueobject.LoanStatusActive) {
@@ -234,6 +279,8 @@ func (l Loan) TermMonths() int                     { return l.termMonths }
 func (l Loan) Status() valueobject.LoanStatus      { return l.status }
 func (l Loan) OutstandingBalance() decimal.Decimal { return l.outstandingBalance }
 func (l Loan) NextPaymentDue() time.Time           { return l.nextPaymentDue }
+func (l Loan) AccruedInterest() decimal.Decimal    { return l.accruedInterest }
+func (l Loan) LastAccrualDate() time.Time          { return l.lastAccrualDate }
 func (l Loan) Version() int                        { return l.version }
 func (l Loan) CreatedAt() time.Time                { return l.createdAt }
 func (l Loan) UpdatedAt() time.Time                { return l.updatedAt }
@@ -249,6 +296,16 @@ func (l Loan) Schedule() []AmortizationEntry {
 	return out
 }
 
+// daysPerYear is the day count interest accrues on.
+const daysPerYear = 365
+
+// daysBetween returns the number of calendar days from one date to another.
+func daysBetween(from, to time.Time) int {
+	f := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	t := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(t.Sub(f).Hours() / 24)
+}
+
 // ClearEvents returns a copy with an empty event list.
 func (l Loan) ClearEvents() Loan {
 	next := l
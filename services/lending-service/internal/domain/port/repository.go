@@ -2,6 +2,7 @@ package port
 
 import (
 	"context"
+	"errors"
 
 	"github.com/bibbank/bib/services/lending-service/internal/domain/event"
 	"github.com/bibbank/bib/services/lending-service/internal/domain/model"
@@ -11,6 +12,13 @@ import (
 // Repository ports (driven/secondary adapters)
 // ---------------------------------------------------------------------------
 
+var (
+	// ErrApplicationNotFound is returned when a tenant has no loan application with the given ID.
+	ErrApplicationNotFound = errors.New("loan application not found")
+	// ErrLoanNotFound is returned when a tenant has no loan with the given ID.
+	ErrLoanNotFound = errors.New("loan not found")
+)
+
 // LoanApplicationRepository persists and retrieves loan applications.
 type LoanApplicationRepository interface {
 	Save(ctx context.Context, app model.LoanApplication) error
@@ -24,6 +32,9 @@ type LoanRepository interface {
 	FindByID(ctx context.Context, tenantID, id string) (model.Loan, error)
 	FindByApplicationID(ctx context.Context, tenantID, applicationID string) (model.Loan, error)
 	FindByBorrowerAccountID(ctx context.Context, tenantID, borrowerAccountID string) ([]model.Loan, error)
+	// FindAccruing returns the tenant's loans that accrue interest: those
+	// active or delinquent.
+	FindAccruing(ctx context.Context, tenantID string) ([]model.Loan, error)
 }
 
 // CollectionCaseRepository persists and retrieves collection cases.
@@ -50,3 +61,11 @@ type EventPublisher interface {
 type CreditBureauClient interface {
 	GetCreditScore(ctx context.Context, applicantID string) (string, error)
 }
+
+// ConsentChecker asks the consent service whether a customer's consent
+// stands.
+type ConsentChecker interface {
+	// CreditPullConsented reports whether the applicant authorizes a credit
+	// bureau pull.
+	CreditPullConsented(ctx context.Context, applicantID string) (bool, error)
+}
@@ -0,0 +1,57 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	consentv1 "github.com/bibbank/bib/api/gen/go/bib/consent/v1"
+)
+
+// ConsentGRPCClient asks the consent service whether an applicant's consent
+// stands. It implements port.ConsentChecker. Calls carry the caller's
+// bearer token, so the consent service scopes them to the caller's tenant.
+type ConsentGRPCClient struct {
+	client  consentv1.ConsentServiceClient
+	timeout time.Duration
+}
+
+// NewConsentGRPCClient creates a new ConsentGRPCClient. timeout bounds each
+// call when it is positive.
+func NewConsentGRPCClient(conn grpc.ClientConnInterface, timeout time.Duration) *ConsentGRPCClient {
+	return &ConsentGRPCClient{client: consentv1.NewConsentServiceClient(conn), timeout: timeout}
+}
+
+// CreditPullConsented reports whether the applicant authorizes a credit
+// bureau pull.
+func (c *ConsentGRPCClient) CreditPullConsented(ctx context.Context, applicantID string) (bool, error) {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	resp, err := c.client.CheckConsent(forwardAuthorization(ctx), &consentv1.CheckConsentRequest{
+		CustomerId: applicantID,
+		Type:       consentv1.ConsentType_CONSENT_TYPE_CREDIT_PULL,
+	})
+	if err != nil {
+		return false, fmt.Errorf("check consent of %s: %w", applicantID, err)
+	}
+	return resp.GetGranted(), nil
+}
+
+// forwardAuthorization passes the caller's bearer token on to the called
+// service, which authorizes the request against it.
+func forwardAuthorization(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	if values := md.Get("authorization"); len(values) > 0 {
+		return metadata.AppendToOutgoingContext(ctx, "authorization", values[0])
+	}
+	return ctx
+}
@@ -0,0 +1,18 @@
+package adapter
+
+import "context"
+
+// StubConsentChecker is a development adapter that treats every applicant
+// as consenting, for running without the consent service.
+// It implements port.ConsentChecker.
+type StubConsentChecker struct{}
+
+// NewStubConsentChecker creates a new stub adapter.
+func NewStubConsentChecker() *StubConsentChecker {
+	return &StubConsentChecker{}
+}
+
+// CreditPullConsented always reports consent.
+func (StubConsentChecker) CreditPullConsented(context.Context, string) (bool, error) {
+	return true, nil
+}
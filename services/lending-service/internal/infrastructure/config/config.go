@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 )
 
 type DatabaseConfig struct {
@@ -22,7 +23,11 @@ type KafkaConfig struct {
 type Config struct {
 	DB          DatabaseConfig
 	ServiceName string
+	// ConsentAddr is the consent service's gRPC address. When empty,
+	// applicants are not checked for credit pull consent.
+	ConsentAddr string
 	Kafka       KafkaConfig
+	Outbox      OutboxConfig
 	GRPCPort    int
 	HTTPPort    int
 }
@@ -33,6 +38,12 @@ func (c Config) Validate() {
 	}
 }
 
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
 func Load() Config {
 	return Config{
 		GRPCPort: getEnvInt("GRPC_PORT", 9087),
@@ -48,7 +59,12 @@ func Load() Config {
 		Kafka: KafkaConfig{
 			Brokers: []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
 		},
+		ConsentAddr: getEnv("CONSENT_SERVICE_ADDR", ""),
 		ServiceName: "lending-service",
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
 	}
 }
 
@@ -75,3 +91,31 @@ func getEnvInt(key string, fallback int) int {
 	}
 	return fallback
 }
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
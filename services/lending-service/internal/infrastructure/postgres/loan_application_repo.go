@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/shopspring/decimal"
 
 	"github.com/bibbank/bib/services/lending-service/internal/domain/model"
+	"github.com/bibbank/bib/services/lending-service/internal/domain/port"
 	"github.com/bibbank/bib/services/lending-service/internal/domain/valueobject"
 )
 
@@ -131,6 +133,9 @@ func scanApplication(s scannable) (model.LoanApplication, error) {
 		&statusStr, &decisionReason, &creditScore,
 		&version, &createdAt, &updatedAt,
 	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.LoanApplication{}, port.ErrApplicationNotFound
+	}
 	if err != nil {
 		return model.LoanApplication{}, fmt.Errorf("scan loan application: %w", err)
 	}
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/shopspring/decimal"
 
 	"github.com/bibbank/bib/services/lending-service/internal/domain/model"
+	"github.com/bibbank/bib/services/lending-service/internal/domain/port"
 	"github.com/bibbank/bib/services/lending-service/internal/domain/valueobject"
 )
 
@@ -36,20 +38,24 @@ func (r *LoanRepo) Save(ctx context.Context, loan model.Loan) error {
 			id, tenant_id, application_id, borrower_account_id,
 			principal, currency, interest_rate_bps, term_months,
 			status, outstanding_balance, next_payment_due,
+			accrued_interest, last_accrual_date,
 			version, created_at, updated_at
-		) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14)
+		) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)
 		ON CONFLICT (id) DO UPDATE SET
 			status              = EXCLUDED.status,
 			outstanding_balance = EXCLUDED.outstanding_balance,
 			next_payment_due    = EXCLUDED.next_payment_due,
+			accrued_interest    = EXCLUDED.accrued_interest,
+			last_accrual_date   = EXCLUDED.last_accrual_date,
 			version             = loans.version + 1,
 			updated_at          = EXCLUDED.updated_at
-		WHERE loans.version = $12
+		WHERE loans.version = $14
 	`
 	tag, err := tx.Exec(ctx, loanQuery,
 		loan.ID(), loan.TenantID(), loan.ApplicationID(), loan.BorrowerAccountID(),
 		loan.Principal(), loan.Currency(), loan.InterestRateBps(), loan.TermMonths(),
 		loan.Status().String(), loan.OutstandingBalance(), loan.NextPaymentDue(),
+		loan.AccruedInterest(), nullTime(loan.LastAccrualDate()),
 		loan.Version(), loan.CreatedAt(), loan.UpdatedAt(),
 	)
 	if err != nil {
@@ -86,6 +92,7 @@ func (r *LoanRepo) FindByID(ctx context.Context, tenantID, id string) (model.Loa
 		SELECT id, tenant_id, application_id, borrower_account_id,
 		       principal, currency, interest_rate_bps, term_months,
 		       status, outstanding_balance, next_payment_due,
+		       accrued_interest, last_accrual_date,
 		       version, created_at, updated_at
 		FROM loans
 		WHERE tenant_id = $1 AND id = $2
@@ -104,6 +111,7 @@ func (r *LoanRepo) FindByID(ctx context.Context, tenantID, id string) (model.Loa
 		loan.ID(), loan.TenantID(), loan.ApplicationID(), loan.BorrowerAccountID(),
 		loan.Principal(), loan.Currency(), loan.InterestRateBps(), loan.TermMonths(),
 		loan.Status(), schedule, loan.OutstandingBalance(), loan.NextPaymentDue(),
+		loan.AccruedInterest(), loan.LastAccrualDate(),
 		loan.Version(), loan.CreatedAt(), loan.UpdatedAt(),
 	), nil
 }
@@ -114,6 +122,7 @@ func (r *LoanRepo) FindByApplicationID(ctx context.Context, tenantID, applicatio
 		SELECT id, tenant_id, application_id, borrower_account_id,
 		       principal, currency, interest_rate_bps, term_months,
 		       status, outstanding_balance, next_payment_due,
+		       accrued_interest, last_accrual_date,
 		       version, created_at, updated_at
 		FROM loans
 		WHERE tenant_id = $1 AND application_id = $2
@@ -132,6 +141,7 @@ func (r *LoanRepo) FindByApplicationID(ctx context.Context, tenantID, applicatio
 		loan.ID(), loan.TenantID(), loan.ApplicationID(), loan.BorrowerAccountID(),
 		loan.Principal(), loan.Currency(), loan.InterestRateBps(), loan.TermMonths(),
 		loan.Status(), schedule, loan.OutstandingBalance(), loan.NextPaymentDue(),
+		loan.AccruedInterest(), loan.LastAccrualDate(),
 		loan.Version(), loan.CreatedAt(), loan.UpdatedAt(),
 	), nil
 }
@@ -142,6 +152,7 @@ func (r *LoanRepo) FindByBorrowerAccountID(ctx context.Context, tenantID, borrow
 		SELECT id, tenant_id, application_id, borrower_account_id,
 		       principal, currency, interest_rate_bps, term_months,
 		       status, outstanding_balance, next_payment_due,
+		       accrued_interest, last_accrual_date,
 		       version, created_at, updated_at
 		FROM loans
 		WHERE tenant_id = $1 AND borrower_account_id = $2
@@ -167,12 +178,44 @@ func (r *LoanRepo) FindByBorrowerAccountID(ctx context.Context, tenantID, borrow
 			loan.ID(), loan.TenantID(), loan.ApplicationID(), loan.BorrowerAccountID(),
 			loan.Principal(), loan.Currency(), loan.InterestRateBps(), loan.TermMonths(),
 			loan.Status(), schedule, loan.OutstandingBalance(), loan.NextPaymentDue(),
+			loan.AccruedInterest(), loan.LastAccrualDate(),
 			loan.Version(), loan.CreatedAt(), loan.UpdatedAt(),
 		))
 	}
 	return loans, rows.Err()
 }
 
+// FindAccruing retrieves the tenant's active and delinquent loans, without
+// their amortization schedules.
+func (r *LoanRepo) FindAccruing(ctx context.Context, tenantID string) ([]model.Loan, error) {
+	query := `
+		SELECT id, tenant_id, application_id, borrower_account_id,
+		       principal, currency, interest_rate_bps, term_months,
+		       status, outstanding_balance, next_payment_due,
+		       accrued_interest, last_accrual_date,
+		       version, created_at, updated_at
+		FROM loans
+		WHERE tenant_id = $1 AND status IN ($2, $3)
+		ORDER BY created_at
+	`
+	rows, err := r.pool.Query(ctx, query, tenantID,
+		valueobject.LoanStatusActive.String(), valueobject.LoanStatusDelinquent.String())
+	if err != nil {
+		return nil, fmt.Errorf("query accruing loans: %w", err)
+	}
+	defer rows.Close()
+
+	var loans []model.Loan
+	for rows.Next() {
+		loan, err := scanLoanRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		loans = append(loans, loan)
+	}
+	return loans, rows.Err()
+}
+
 // ---------------------------------------------------------------------------
 // internal helpers
 // ---------------------------------------------------------------------------
@@ -191,6 +234,8 @@ func scanLoanRow(s scannable) (model.Loan, error) {
 		statusStr                                      string
 		outstandingBalance                             decimal.Decimal
 		nextPaymentDue                                 time.Time
+		accruedInterest                                decimal.Decimal
+		lastAccrualDate                                *time.Time
 		version                                        int
 		createdAt, updatedAt                           time.Time
 	)
@@ -199,8 +244,12 @@ func scanLoanRow(s scannable) (model.Loan, error) {
 		&id, &tenantID, &applicationID, &borrowerAccountID,
 		&principal, &currency, &interestRateBps, &termMonths,
 		&statusStr, &outstandingBalance, &nextPaymentDue,
+		&accruedInterest, &lastAccrualDate,
 		&version, &createdAt, &updatedAt,
 	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Loan{}, port.ErrLoanNotFound
+	}
 	if err != nil {
 		return model.Loan{}, fmt.Errorf("scan loan: %w", err)
 	}
@@ -214,6 +263,7 @@ func scanLoanRow(s scannable) (model.Loan, error) {
 		id, tenantID, applicationID, borrowerAccountID,
 		principal, currency, interestRateBps, termMonths,
 		status, nil, outstandingBalance, nextPaymentDue,
+		accruedInterest, derefTime(lastAccrualDate),
 		version, createdAt, updatedAt,
 	), nil
 }
@@ -0,0 +1,2 @@
+ALTER TABLE loans DROP COLUMN IF EXISTS last_accrual_date;
+ALTER TABLE loans DROP COLUMN IF EXISTS accrued_interest;
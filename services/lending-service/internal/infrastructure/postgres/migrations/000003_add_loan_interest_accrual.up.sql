@@ -0,0 +1,4 @@
+-- Interest accrued on each loan to date, and the day it was last accrued
+-- to; NULL until the loan's first accrual.
+ALTER TABLE loans ADD COLUMN IF NOT EXISTS accrued_interest NUMERIC NOT NULL DEFAULT 0;
+ALTER TABLE loans ADD COLUMN IF NOT EXISTS last_accrual_date TIMESTAMPTZ;
@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	grpclib "google.golang.org/grpc"
+
+	lendingv1 "github.com/bibbank/bib/api/gen/go/bib/lending/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/lending-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/lending-service/internal/domain/event"
+	"github.com/bibbank/bib/services/lending-service/internal/domain/model"
+	"github.com/bibbank/bib/services/lending-service/internal/domain/port"
+	"github.com/bibbank/bib/services/lending-service/internal/domain/service"
+	"github.com/bibbank/bib/services/lending-service/internal/infrastructure/adapter"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractApplicationRepo holds the one application a contract state sets up.
+type contractApplicationRepo struct {
+	app *model.LoanApplication
+}
+
+func (r *contractApplicationRepo) Save(context.Context, model.LoanApplication) error { return nil }
+
+func (r *contractApplicationRepo) FindByID(context.Context, string, string) (model.LoanApplication, error) {
+	if r.app == nil {
+		return model.LoanApplication{}, port.ErrApplicationNotFound
+	}
+	return *r.app, nil
+}
+
+func (r *contractApplicationRepo) FindByApplicantID(context.Context, string, string) ([]model.LoanApplication, error) {
+	return nil, nil
+}
+
+// contractLoanRepo holds the one loan a contract state sets up.
+type contractLoanRepo struct {
+	loan *model.Loan
+}
+
+func (r *contractLoanRepo) Save(context.Context, model.Loan) error { return nil }
+
+func (r *contractLoanRepo) FindByID(context.Context, string, string) (model.Loan, error) {
+	if r.loan == nil {
+		return model.Loan{}, port.ErrLoanNotFound
+	}
+	return *r.loan, nil
+}
+
+func (r *contractLoanRepo) FindByApplicationID(ctx context.Context, tenantID, _ string) (model.Loan, error) {
+	return r.FindByID(ctx, tenantID, "")
+}
+
+func (r *contractLoanRepo) FindByBorrowerAccountID(context.Context, string, string) ([]model.Loan, error) {
+	return nil, nil
+}
+
+func (r *contractLoanRepo) FindAccruing(context.Context, string) ([]model.Loan, error) {
+	return nil, nil
+}
+
+type discardPublisher struct{}
+
+func (discardPublisher) Publish(context.Context, ...event.DomainEvent) error { return nil }
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	apps, loans := &contractApplicationRepo{}, &contractLoanRepo{}
+	h := NewLendingHandler(
+		usecase.NewSubmitLoanApplicationUseCase(apps, discardPublisher{}, adapter.NewStubCreditBureauClient(),
+			adapter.NewStubConsentChecker(), service.NewUnderwritingEngine()),
+		nil,
+		nil,
+		usecase.NewGetLoanUseCase(loans),
+		usecase.NewGetApplicationUseCase(apps),
+		nil,
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { lendingv1.RegisterLendingServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	tenantID, now := contractTenantID.String(), time.Now().UTC()
+	contract.Verify(t, conn, "../../../../../contracts/gateway-lending-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) {
+			apps.app, loans.loan = nil, nil
+		},
+		"a loan application was approved": func(t *testing.T) {
+			app, err := model.NewLoanApplication(tenantID, uuid.NewString(), decimal.NewFromInt(25000), "USD", 36,
+				"home improvement", now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			apps.app = &app
+		},
+		"an active loan exists": func(t *testing.T) {
+			loan, err := model.NewLoan(tenantID, uuid.NewString(), uuid.NewString(), decimal.NewFromInt(25000), "USD",
+				450, 36, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			loans.loan = &loan
+		},
+	})
+}
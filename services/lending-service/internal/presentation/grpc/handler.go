@@ -2,16 +2,22 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"regexp"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	lendingv1 "github.com/bibbank/bib/api/gen/go/bib/lending/v1"
 	"github.com/bibbank/bib/pkg/auth"
 	"github.com/bibbank/bib/services/lending-service/internal/application/dto"
 	"github.com/bibbank/bib/services/lending-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/lending-service/internal/domain/port"
 )
 
 var currencyCodeRE = regexp.MustCompile(`^[A-Z]{3}$`)
@@ -39,100 +45,18 @@ func tenantIDFromContext(ctx context.Context) (string, error) {
 	return claims.TenantID.String(), nil
 }
 
-// ---------------------------------------------------------------------------
-// Request / Response types (stand-in for proto-generated messages)
-// ---------------------------------------------------------------------------
-
-// SubmitApplicationRequest represents the proto SubmitApplicationRequest message.
-type SubmitApplicationRequest struct {
-	TenantID        string `json:"tenant_id"`
-	ApplicantID     string `json:"applicant_id"`
-	RequestedAmount string `json:"requested_amount"`
-	Currency        string `json:"currency"`
-	Purpose         string `json:"purpose"`
-	TermMonths      int    `json:"term_months"`
-}
-
-// SubmitApplicationResponse represents the proto SubmitApplicationResponse message.
-type SubmitApplicationResponse struct {
-	ApplicationID string `json:"application_id"`
-	Status        string `json:"status"`
-	CreatedAt     string `json:"created_at"`
-}
-
-// DisburseLoanRequest represents the proto DisburseLoanRequest message.
-type DisburseLoanRequest struct {
-	TenantID          string `json:"tenant_id"`
-	ApplicationID     string `json:"application_id"`
-	BorrowerAccountID string `json:"borrower_account_id"`
-	InterestRateBps   int    `json:"interest_rate_bps"`
-}
-
-// DisburseLoanResponse represents the proto DisburseLoanResponse message.
-type DisburseLoanResponse struct {
-	LoanID    string `json:"loan_id"`
-	Status    string `json:"status"`
-	Amount    string `json:"amount"`
-	Currency  string `json:"currency"`
-	CreatedAt string `json:"created_at"`
-}
-
-// MakePaymentRequest represents the proto MakePaymentRequest message.
-type MakePaymentRequest struct {
-	TenantID string `json:"tenant_id"`
-	LoanID   string `json:"loan_id"`
-	Amount   string `json:"amount"`
-}
-
-// MakePaymentResponse represents the proto MakePaymentResponse message.
-type MakePaymentResponse struct {
-	PaymentID string `json:"payment_id"`
-	Status    string `json:"status"`
-}
-
-// GetLoanRequest represents the proto GetLoanRequest message.
-type GetLoanRequest struct {
-	TenantID string `json:"tenant_id"`
-	LoanID   string `json:"loan_id"`
-}
-
-// GetLoanResponse represents the proto GetLoanResponse message.
-type GetLoanResponse struct {
-	LoanID    string `json:"loan_id"`
-	Status    string `json:"status"`
-	Amount    string `json:"amount"`
-	Currency  string `json:"currency"`
-	CreatedAt string `json:"created_at"`
-}
-
-// GetApplicationRequest represents the proto GetApplicationRequest message.
-type GetApplicationRequest struct {
-	TenantID      string `json:"tenant_id"`
-	ApplicationID string `json:"application_id"`
-}
-
-// GetApplicationResponse represents the proto GetApplicationResponse message.
-type GetApplicationResponse struct {
-	ApplicationID string `json:"application_id"`
-	Status        string `json:"status"`
-	CreatedAt     string `json:"created_at"`
-}
-
-// ---------------------------------------------------------------------------
-// LendingHandler exposes lending operations over gRPC.
-// In a full implementation this would implement a protobuf-generated interface.
-// Here we define a plain struct that can be easily wired into a gRPC server
-// once the proto definitions are generated.
-// ---------------------------------------------------------------------------
+// Compile-time assertion that LendingHandler implements LendingServiceServer.
+var _ lendingv1.LendingServiceServer = (*LendingHandler)(nil)
 
 // LendingHandler is the gRPC handler for lending operations.
 type LendingHandler struct {
-	UnimplementedLendingServiceServer
+	lendingv1.UnimplementedLendingServiceServer
 	submitApp *usecase.SubmitLoanApplicationUseCase
 	disburse  *usecase.DisburseLoanUseCase
 	payment   *usecase.MakePaymentUseCase
 	getLoan   *usecase.GetLoanUseCase
 	getApp    *usecase.GetApplicationUseCase
+	accrue    *usecase.AccrueInterestUseCase
 
 	logger *slog.Logger
 }
@@ -144,6 +68,7 @@ func NewLendingHandler(
 	payment *usecase.MakePaymentUseCase,
 	getLoan *usecase.GetLoanUseCase,
 	getApp *usecase.GetApplicationUseCase,
+	accrue *usecase.AccrueInterestUseCase,
 	logger *slog.Logger,
 ) *LendingHandler {
 	return &LendingHandler{
@@ -152,12 +77,13 @@ func NewLendingHandler(
 		payment:   payment,
 		getLoan:   getLoan,
 		getApp:    getApp,
+		accrue:    accrue,
 
 		logger: logger}
 }
 
 // SubmitApplication handles a new loan application submission.
-func (h *LendingHandler) SubmitApplication(ctx context.Context, req *SubmitApplicationRequest) (*SubmitApplicationResponse, error) {
+func (h *LendingHandler) SubmitApplication(ctx context.Context, req *lendingv1.SubmitLoanApplicationRequest) (*lendingv1.SubmitLoanApplicationResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
@@ -171,20 +97,21 @@ func (h *LendingHandler) SubmitApplication(ctx context.Context, req *SubmitAppli
 		return nil, err
 	}
 
-	if req.ApplicantID == "" {
+	if req.ApplicantId == "" {
 		return nil, status.Error(codes.InvalidArgument, "applicant_id is required")
 	}
-	amount, err := decimal.NewFromString(req.RequestedAmount)
+	currency := req.GetRequestedAmount().GetCurrency()
+	amount, err := decimal.NewFromString(req.GetRequestedAmount().GetAmount())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
 	}
 	if !amount.IsPositive() {
 		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
 	}
-	if req.Currency == "" {
+	if currency == "" {
 		return nil, status.Error(codes.InvalidArgument, "currency is required")
 	}
-	if !currencyCodeRE.MatchString(req.Currency) {
+	if !currencyCodeRE.MatchString(currency) {
 		return nil, status.Error(codes.InvalidArgument, "currency must be a 3-letter uppercase ISO code")
 	}
 	if req.TermMonths <= 0 {
@@ -193,25 +120,24 @@ func (h *LendingHandler) SubmitApplication(ctx context.Context, req *SubmitAppli
 
 	result, err := h.submitApp.Execute(ctx, dto.SubmitApplicationRequest{
 		TenantID:        tid,
-		ApplicantID:     req.ApplicantID,
+		ApplicantID:     req.ApplicantId,
 		RequestedAmount: amount,
-		Currency:        req.Currency,
-		TermMonths:      req.TermMonths,
+		Currency:        currency,
+		TermMonths:      int(req.TermMonths),
 		Purpose:         req.Purpose,
 	})
+	if errors.Is(err, usecase.ErrCreditPullNotConsented) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
 		h.logger.Error("handler error", "error", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
-	return &SubmitApplicationResponse{
-		ApplicationID: result.ID,
-		Status:        result.Status,
-		CreatedAt:     result.CreatedAt.Format("2006-01-02T15:04:05Z"),
-	}, nil
+	return &lendingv1.SubmitLoanApplicationResponse{Application: toApplicationMsg(result)}, nil
 }
 
 // DisburseLoan handles loan disbursement for an approved application.
-func (h *LendingHandler) DisburseLoan(ctx context.Context, req *DisburseLoanRequest) (*DisburseLoanResponse, error) {
+func (h *LendingHandler) DisburseLoan(ctx context.Context, req *lendingv1.DisburseLoanRequest) (*lendingv1.DisburseLoanResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
@@ -225,10 +151,10 @@ func (h *LendingHandler) DisburseLoan(ctx context.Context, req *DisburseLoanRequ
 		return nil, err
 	}
 
-	if req.ApplicationID == "" {
+	if req.ApplicationId == "" {
 		return nil, status.Error(codes.InvalidArgument, "application_id is required")
 	}
-	if req.BorrowerAccountID == "" {
+	if req.BorrowerAccountId == "" {
 		return nil, status.Error(codes.InvalidArgument, "borrower_account_id is required")
 	}
 	if req.InterestRateBps <= 0 {
@@ -237,25 +163,19 @@ func (h *LendingHandler) DisburseLoan(ctx context.Context, req *DisburseLoanRequ
 
 	result, err := h.disburse.Execute(ctx, dto.DisburseLoanRequest{
 		TenantID:          tid,
-		ApplicationID:     req.ApplicationID,
-		BorrowerAccountID: req.BorrowerAccountID,
-		InterestRateBps:   req.InterestRateBps,
+		ApplicationID:     req.ApplicationId,
+		BorrowerAccountID: req.BorrowerAccountId,
+		InterestRateBps:   int(req.InterestRateBps),
 	})
 	if err != nil {
 		h.logger.Error("handler error", "error", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
-	return &DisburseLoanResponse{
-		LoanID:    result.ID,
-		Status:    result.Status,
-		Amount:    result.Principal.String(),
-		Currency:  result.Currency,
-		CreatedAt: result.CreatedAt.Format("2006-01-02T15:04:05Z"),
-	}, nil
+	return &lendingv1.DisburseLoanResponse{Loan: toLoanMsg(result)}, nil
 }
 
 // MakePayment handles a loan payment.
-func (h *LendingHandler) MakePayment(ctx context.Context, req *MakePaymentRequest) (*MakePaymentResponse, error) {
+func (h *LendingHandler) MakePayment(ctx context.Context, req *lendingv1.MakePaymentRequest) (*lendingv1.MakePaymentResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
@@ -269,7 +189,7 @@ func (h *LendingHandler) MakePayment(ctx context.Context, req *MakePaymentReques
 		return nil, err
 	}
 
-	if req.LoanID == "" {
+	if req.LoanId == "" {
 		return nil, status.Error(codes.InvalidArgument, "loan_id is required")
 	}
 	amt, err := decimal.NewFromString(req.Amount)
@@ -282,21 +202,23 @@ func (h *LendingHandler) MakePayment(ctx context.Context, req *MakePaymentReques
 
 	result, err := h.payment.Execute(ctx, dto.MakePaymentRequest{
 		TenantID: tid,
-		LoanID:   req.LoanID,
+		LoanID:   req.LoanId,
 		Amount:   amt,
 	})
 	if err != nil {
 		h.logger.Error("handler error", "error", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
-	return &MakePaymentResponse{
-		PaymentID: result.LoanID,
-		Status:    result.LoanStatus,
+	return &lendingv1.MakePaymentResponse{
+		LoanId:             result.LoanID,
+		AmountPaid:         result.AmountPaid.String(),
+		OutstandingBalance: result.OutstandingBalance.String(),
+		LoanStatus:         loanStatusToProto(result.LoanStatus),
 	}, nil
 }
 
 // GetLoan retrieves a loan by ID.
-func (h *LendingHandler) GetLoan(ctx context.Context, req *GetLoanRequest) (*GetLoanResponse, error) {
+func (h *LendingHandler) GetLoan(ctx context.Context, req *lendingv1.GetLoanRequest) (*lendingv1.GetLoanResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
@@ -310,29 +232,26 @@ func (h *LendingHandler) GetLoan(ctx context.Context, req *GetLoanRequest) (*Get
 		return nil, err
 	}
 
-	if req.LoanID == "" {
-		return nil, status.Error(codes.InvalidArgument, "loan_id is required")
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
 	result, err := h.getLoan.Execute(ctx, dto.GetLoanRequest{
 		TenantID: tid,
-		LoanID:   req.LoanID,
+		LoanID:   req.Id,
 	})
+	if errors.Is(err, port.ErrLoanNotFound) {
+		return nil, status.Error(codes.NotFound, "loan not found")
+	}
 	if err != nil {
 		h.logger.Error("handler error", "error", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
-	return &GetLoanResponse{
-		LoanID:    result.ID,
-		Status:    result.Status,
-		Amount:    result.Principal.String(),
-		Currency:  result.Currency,
-		CreatedAt: result.CreatedAt.Format("2006-01-02T15:04:05Z"),
-	}, nil
+	return &lendingv1.GetLoanResponse{Loan: toLoanMsg(result)}, nil
 }
 
 // GetApplication retrieves a loan application by ID.
-func (h *LendingHandler) GetApplication(ctx context.Context, req *GetApplicationRequest) (*GetApplicationResponse, error) {
+func (h *LendingHandler) GetApplication(ctx context.Context, req *lendingv1.GetApplicationRequest) (*lendingv1.GetApplicationResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
@@ -346,21 +265,122 @@ func (h *LendingHandler) GetApplication(ctx context.Context, req *GetApplication
 		return nil, err
 	}
 
-	if req.ApplicationID == "" {
-		return nil, status.Error(codes.InvalidArgument, "application_id is required")
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
 	result, err := h.getApp.Execute(ctx, dto.GetApplicationRequest{
 		TenantID:      tid,
-		ApplicationID: req.ApplicationID,
+		ApplicationID: req.Id,
+	})
+	if errors.Is(err, port.ErrApplicationNotFound) {
+		return nil, status.Error(codes.NotFound, "loan application not found")
+	}
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &lendingv1.GetApplicationResponse{Application: toApplicationMsg(result)}, nil
+}
+
+// AccrueInterest accrues daily interest on the caller's tenant's loans up to
+// as_of_date, an RFC 3339 time or a date; it defaults to now.
+func (h *LendingHandler) AccrueInterest(ctx context.Context, req *lendingv1.AccrueInterestRequest) (*lendingv1.AccrueInterestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	tid, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	asOf := time.Now().UTC()
+	if req.AsOfDate != "" {
+		asOf, err = time.Parse(time.RFC3339, req.AsOfDate)
+		if err != nil {
+			asOf, err = time.Parse(time.DateOnly, req.AsOfDate)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid as_of_date %q", req.AsOfDate)
+		}
+	}
+
+	result, err := h.accrue.Execute(ctx, dto.AccrueInterestRequest{
+		TenantID: tid,
+		AsOf:     asOf,
 	})
 	if err != nil {
 		h.logger.Error("handler error", "error", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
-	return &GetApplicationResponse{
-		ApplicationID: result.ID,
-		Status:        result.Status,
-		CreatedAt:     result.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	return &lendingv1.AccrueInterestResponse{
+		TotalAccrued:   result.TotalAccrued.String(),
+		LoansProcessed: int32(result.LoansProcessed), //nolint:gosec // bounded by a tenant's loan count
 	}, nil
 }
+
+func toApplicationMsg(r dto.LoanApplicationResponse) *lendingv1.LoanApplication {
+	return &lendingv1.LoanApplication{
+		Id:              r.ID,
+		TenantId:        r.TenantID,
+		ApplicantId:     r.ApplicantID,
+		RequestedAmount: &commonv1.Money{Amount: r.RequestedAmount.String(), Currency: r.Currency},
+		TermMonths:      int32(r.TermMonths), //nolint:gosec // validated on submission
+		Purpose:         r.Purpose,
+		Status:          lendingv1.LoanApplicationStatus(lendingv1.LoanApplicationStatus_value["LOAN_APPLICATION_STATUS_"+r.Status]),
+		DecisionReason:  r.DecisionReason,
+		CreditScore:     r.CreditScore,
+		Audit: &commonv1.AuditInfo{
+			CreatedAt: timestamppb.New(r.CreatedAt),
+			UpdatedAt: timestamppb.New(r.UpdatedAt),
+		},
+	}
+}
+
+func toLoanMsg(r dto.LoanResponse) *lendingv1.Loan {
+	money := func(d decimal.Decimal) *commonv1.Money {
+		return &commonv1.Money{Amount: d.String(), Currency: r.Currency}
+	}
+	schedule := make([]*lendingv1.AmortizationEntry, 0, len(r.Schedule))
+	for _, e := range r.Schedule {
+		schedule = append(schedule, &lendingv1.AmortizationEntry{
+			Period:           int32(e.Period), //nolint:gosec // bounded by the loan term
+			DueDate:          timestamppb.New(e.DueDate),
+			Principal:        money(e.Principal),
+			Interest:         money(e.Interest),
+			Total:            money(e.Total),
+			RemainingBalance: money(e.RemainingBalance),
+		})
+	}
+	msg := &lendingv1.Loan{
+		Id:                 r.ID,
+		TenantId:           r.TenantID,
+		ApplicationId:      r.ApplicationID,
+		BorrowerAccountId:  r.BorrowerAccountID,
+		Principal:          money(r.Principal),
+		InterestRateBps:    int32(r.InterestRateBps), //nolint:gosec // validated on disbursement
+		TermMonths:         int32(r.TermMonths),      //nolint:gosec // validated on submission
+		Status:             loanStatusToProto(r.Status),
+		Schedule:           schedule,
+		OutstandingBalance: money(r.OutstandingBalance),
+		Audit: &commonv1.AuditInfo{
+			CreatedAt: timestamppb.New(r.CreatedAt),
+			UpdatedAt: timestamppb.New(r.UpdatedAt),
+		},
+	}
+	if !r.NextPaymentDue.IsZero() {
+		msg.NextPaymentDue = timestamppb.New(r.NextPaymentDue)
+	}
+	return msg
+}
+
+// loanStatusToProto maps a domain loan status such as "PAID_OFF" to its
+// proto enum value.
+func loanStatusToProto(s string) lendingv1.LoanStatus {
+	return lendingv1.LoanStatus(lendingv1.LoanStatus_value["LOAN_STATUS_"+s])
+}
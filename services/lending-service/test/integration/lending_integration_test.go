@@ -0,0 +1,107 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/lending-service/internal/domain/model"
+	"github.com/bibbank/bib/services/lending-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/lending-service/internal/infrastructure/postgres"
+)
+
+func newLoan(t *testing.T, tenantID string, principal int64) model.Loan {
+	t.Helper()
+	loan, err := model.NewLoan(tenantID, uuid.NewString(), uuid.NewString(),
+		decimal.NewFromInt(principal), "USD", 650, 12, time.Now().UTC())
+	require.NoError(t, err)
+	return loan
+}
+
+func TestLoanRepo_ScheduleAndPaymentsRoundTrip(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewLoanRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.NewString()
+
+	loan := newLoan(t, tenantID, 12000)
+	require.NoError(t, repo.Save(ctx, loan))
+
+	paid, err := loan.MakePayment(decimal.NewFromInt(1000), time.Now().UTC())
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, paid))
+
+	// Saving the stale copy again fails the version check.
+	require.Error(t, repo.Save(ctx, paid))
+
+	found, err := repo.FindByID(ctx, tenantID, loan.ID())
+	require.NoError(t, err)
+	assert.Equal(t, 2, found.Version())
+	assert.True(t, decimal.NewFromInt(11000).Equal(found.OutstandingBalance()), found.OutstandingBalance().String())
+	require.Len(t, found.Schedule(), 12)
+	assert.Equal(t, loan.Schedule()[0].Period, found.Schedule()[0].Period)
+	assert.True(t, loan.Schedule()[11].RemainingBalance.Equal(found.Schedule()[11].RemainingBalance))
+
+	byApplication, err := repo.FindByApplicationID(ctx, tenantID, loan.ApplicationID())
+	require.NoError(t, err)
+	assert.Equal(t, loan.ID(), byApplication.ID())
+}
+
+func TestLoanRepo_FindAccruingSkipsSettledLoansAndOtherTenants(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewLoanRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.NewString()
+
+	active := newLoan(t, tenantID, 5000)
+	require.NoError(t, repo.Save(ctx, active))
+	settled := newLoan(t, tenantID, 500)
+	require.NoError(t, repo.Save(ctx, settled))
+	settled, err := settled.MakePayment(decimal.NewFromInt(500), time.Now().UTC())
+	require.NoError(t, err)
+	require.Equal(t, valueobject.LoanStatusPaidOff, settled.Status())
+	require.NoError(t, repo.Save(ctx, settled))
+	require.NoError(t, repo.Save(ctx, newLoan(t, uuid.NewString(), 5000)))
+
+	loans, err := repo.FindAccruing(ctx, tenantID)
+	require.NoError(t, err)
+	require.Len(t, loans, 1)
+	assert.Equal(t, active.ID(), loans[0].ID())
+}
+
+func TestLoanApplicationRepo_DecisionRoundTrip(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewLoanApplicationRepo(env.Pool())
+	ctx := context.Background()
+	tenantID, applicantID := uuid.NewString(), uuid.NewString()
+
+	app, err := model.NewLoanApplication(tenantID, applicantID, decimal.NewFromInt(8000), "USD", 24, "car", time.Now().UTC())
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, app))
+	reviewed, err := app.SubmitForReview(time.Now().UTC())
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, reviewed))
+
+	// The version is bumped in the database, so each change starts from
+	// a fresh read.
+	loaded, err := repo.FindByID(ctx, tenantID, app.ID())
+	require.NoError(t, err)
+	approved, err := loaded.Approve("meets policy", "742", time.Now().UTC())
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, approved))
+
+	apps, err := repo.FindByApplicantID(ctx, tenantID, applicantID)
+	require.NoError(t, err)
+	require.Len(t, apps, 1)
+	assert.Equal(t, valueobject.LoanApplicationStatusApproved, apps[0].Status())
+	assert.Equal(t, "742", apps[0].CreditScore())
+	assert.Equal(t, 3, apps[0].Version())
+}
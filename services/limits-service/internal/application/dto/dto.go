@@ -0,0 +1,165 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreateLimitRequest is the input for setting a limit. A nil PartyID sets
+// a tenant-wide limit; a nil ParentID a limit that rolls up into no other.
+type CreateLimitRequest struct {
+	PartyID  *uuid.UUID
+	ParentID *uuid.UUID
+	Category string
+	Currency string
+	Amount   decimal.Decimal
+	TenantID uuid.UUID
+}
+
+// UpdateLimitRequest is the input for changing a limit's amount.
+type UpdateLimitRequest struct {
+	Amount   decimal.Decimal
+	TenantID uuid.UUID
+	LimitID  uuid.UUID
+}
+
+// ListLimitsRequest is the input for listing a tenant's limits, those of
+// one party when PartyID is not nil or only the tenant-wide ones when
+// TenantWide is set.
+type ListLimitsRequest struct {
+	PartyID    *uuid.UUID
+	Currency   string
+	Limit      int
+	Offset     int
+	TenantID   uuid.UUID
+	TenantWide bool
+}
+
+// LimitResponse is a limit and its usage.
+type LimitResponse struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	PartyID   *uuid.UUID
+	ParentID  *uuid.UUID
+	Category  string
+	Currency  string
+	Amount    decimal.Decimal
+	Utilized  decimal.Decimal
+	Reserved  decimal.Decimal
+	Available decimal.Decimal
+	Version   int
+	ID        uuid.UUID
+	TenantID  uuid.UUID
+}
+
+// ListLimitsResponse is one page of limits.
+type ListLimitsResponse struct {
+	Limits     []LimitResponse
+	TotalCount int
+}
+
+// CheckAndReserveRequest is the input for checking whether exposure of a
+// category fits within the limits of a party, or of the tenant when
+// PartyID is nil, and reserving it if so. A dry run only checks. The
+// reference is the caller's for the exposure, such as a payment ID; a
+// repeated request with the same reference returns the reservation first
+// made.
+type CheckAndReserveRequest struct {
+	PartyID   *uuid.UUID
+	Category  string
+	Currency  string
+	Reference string
+	Amount    decimal.Decimal
+	TenantID  uuid.UUID
+	DryRun    bool
+}
+
+// CheckAndReserveResponse is the outcome of a check. When the amount does
+// not fit, BindingLimitID names the limit with the least headroom and
+// nothing is reserved.
+type CheckAndReserveResponse struct {
+	Reservation    *ReservationResponse
+	Headroom       decimal.Decimal
+	BindingLimitID uuid.UUID
+	Approved       bool
+}
+
+// ReduceExposureRequest is the input for reducing what is utilized against
+// the limits of a party, or of the tenant when PartyID is nil, such as
+// when a loan is repaid.
+type ReduceExposureRequest struct {
+	PartyID   *uuid.UUID
+	Category  string
+	Currency  string
+	Reference string
+	Amount    decimal.Decimal
+	TenantID  uuid.UUID
+}
+
+// ListReservationsRequest is the input for listing a tenant's
+// reservations, those of one party when PartyID is not nil and those in
+// one status when Status is not empty.
+type ListReservationsRequest struct {
+	PartyID  *uuid.UUID
+	Status   string
+	Limit    int
+	Offset   int
+	TenantID uuid.UUID
+}
+
+// ReservationResponse is a reservation against a chain of limits.
+type ReservationResponse struct {
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	PartyID   *uuid.UUID
+	Category  string
+	Currency  string
+	Reference string
+	Status    string
+	LimitIDs  []uuid.UUID
+	Amount    decimal.Decimal
+	Version   int
+	ID        uuid.UUID
+	TenantID  uuid.UUID
+}
+
+// ListReservationsResponse is one page of reservations.
+type ListReservationsResponse struct {
+	Reservations []ReservationResponse
+	TotalCount   int
+}
+
+// GetExposureRequest is the input for a party's consolidated exposure, or
+// the tenant's when PartyID is nil.
+type GetExposureRequest struct {
+	PartyID  *uuid.UUID
+	TenantID uuid.UUID
+}
+
+// LimitExposure is a limit with the headroom left for exposure against it
+// once the limits it rolls up into are taken into account.
+type LimitExposure struct {
+	Limit             LimitResponse
+	EffectiveHeadroom decimal.Decimal
+	BindingLimitID    uuid.UUID
+}
+
+// CurrencyExposure is what is utilized and reserved in one currency.
+type CurrencyExposure struct {
+	Currency string
+	Utilized decimal.Decimal
+	Reserved decimal.Decimal
+}
+
+// ExposureResponse is a party's limits and its consolidated exposure per
+// currency: the sum over its limits that roll up into none of its own, so
+// nothing is counted twice.
+type ExposureResponse struct {
+	PartyID  *uuid.UUID
+	Limits   []LimitExposure
+	Totals   []CurrencyExposure
+	TenantID uuid.UUID
+}
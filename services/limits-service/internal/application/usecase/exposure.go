@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/limits-service/internal/application/dto"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/model"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/port"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/valueobject"
+)
+
+// ReduceExposureUseCase reduces what is utilized against the limits
+// capping a party's exposure, such as when a loan is repaid or an overdraft
+// is covered.
+type ReduceExposureUseCase struct {
+	limits    port.LimitRepository
+	publisher port.EventPublisher
+}
+
+// NewReduceExposureUseCase creates a new ReduceExposureUseCase.
+func NewReduceExposureUseCase(limits port.LimitRepository, publisher port.EventPublisher) *ReduceExposureUseCase {
+	return &ReduceExposureUseCase{limits: limits, publisher: publisher}
+}
+
+// Execute reduces utilization of each limit in the chain capping the
+// exposure and returns the most specific of them.
+func (uc *ReduceExposureUseCase) Execute(ctx context.Context, req dto.ReduceExposureRequest) (dto.LimitResponse, error) {
+	category, err := valueobject.NewLimitCategory(req.Category)
+	if err != nil {
+		return dto.LimitResponse{}, fmt.Errorf("%w: %w", ErrInvalidReservation, err)
+	}
+	var chain []model.Limit
+	err = retryOnConflict(func() error {
+		var err error
+		chain, err = resolveChain(ctx, uc.limits, req.TenantID, req.PartyID, category, req.Currency)
+		if err != nil {
+			return err
+		}
+		chain, err = model.ReduceExposure(chain, req.Reference, req.Amount, time.Now().UTC())
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidReservation, err)
+		}
+		if err := uc.limits.Save(ctx, chain...); err != nil {
+			return fmt.Errorf("failed to save limits: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dto.LimitResponse{}, err
+	}
+	if err := uc.publisher.Publish(ctx, chain[0].DomainEvents()); err != nil {
+		return dto.LimitResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toLimitResponse(chain[0]), nil
+}
+
+// GetExposureUseCase consolidates a party's exposure across its limits.
+type GetExposureUseCase struct {
+	limits port.LimitRepository
+}
+
+// NewGetExposureUseCase creates a new GetExposureUseCase.
+func NewGetExposureUseCase(limits port.LimitRepository) *GetExposureUseCase {
+	return &GetExposureUseCase{limits: limits}
+}
+
+// Execute returns the party's limits, each with the headroom the limits it
+// rolls up into leave it, and its exposure per currency.
+func (uc *GetExposureUseCase) Execute(ctx context.Context, req dto.GetExposureRequest) (dto.ExposureResponse, error) {
+	limits, _, err := uc.limits.List(ctx, port.LimitFilter{
+		TenantID:   req.TenantID,
+		PartyID:    req.PartyID,
+		TenantWide: req.PartyID == nil,
+	}, maxLimit, 0)
+	if err != nil {
+		return dto.ExposureResponse{}, fmt.Errorf("failed to list limits: %w", err)
+	}
+
+	resp := dto.ExposureResponse{
+		TenantID: req.TenantID,
+		PartyID:  req.PartyID,
+		Limits:   make([]dto.LimitExposure, 0, len(limits)),
+	}
+	own := make(map[uuid.UUID]bool, len(limits))
+	for _, l := range limits {
+		own[l.ID()] = true
+	}
+	totals := make(map[string]int)
+	for _, l := range limits {
+		chain, err := loadChain(ctx, uc.limits, l)
+		if err != nil {
+			return dto.ExposureResponse{}, err
+		}
+		headroom, binding := model.Headroom(chain)
+		resp.Limits = append(resp.Limits, dto.LimitExposure{
+			Limit:             toLimitResponse(l),
+			EffectiveHeadroom: headroom,
+			BindingLimitID:    binding.ID(),
+		})
+
+		// Exposure against a limit is also exposure against the limits it
+		// rolls up into, so only the party's topmost limits are summed.
+		if l.ParentID() != nil && own[*l.ParentID()] {
+			continue
+		}
+		i, ok := totals[l.Currency()]
+		if !ok {
+			i = len(resp.Totals)
+			totals[l.Currency()] = i
+			resp.Totals = append(resp.Totals, dto.CurrencyExposure{
+				Currency: l.Currency(),
+				Utilized: decimal.Zero,
+				Reserved: decimal.Zero,
+			})
+		}
+		resp.Totals[i].Utilized = resp.Totals[i].Utilized.Add(l.Utilized())
+		resp.Totals[i].Reserved = resp.Totals[i].Reserved.Add(l.Reserved())
+	}
+	return resp, nil
+}
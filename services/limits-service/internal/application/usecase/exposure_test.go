@@ -0,0 +1,61 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/limits-service/internal/application/dto"
+	"github.com/bibbank/bib/services/limits-service/internal/application/usecase"
+)
+
+func TestGetExposure_ConsolidatesWithoutDoubleCounting(t *testing.T) {
+	f := newReservationFixture()
+	ctx := context.Background()
+	tenant, total, cards := f.hierarchy(t)
+	overdraft := f.createLimit(t, true, "OVERDRAFT", 300, &tenant)
+
+	for _, req := range []dto.CheckAndReserveRequest{
+		f.reserveRequest("CARDS", "auth-1", 400),
+		f.reserveRequest("LENDING", "loan-1", 600),
+		f.reserveRequest("OVERDRAFT", "overdraft-1", 250),
+	} {
+		resp, err := f.checkAndReserve().Execute(ctx, req)
+		require.NoError(t, err)
+		require.True(t, resp.Approved, req.Reference)
+		if req.Category == "LENDING" {
+			_, err = usecase.NewCommitReservationUseCase(f.limits, f.reservations, f.publisher).
+				Execute(ctx, f.tenantID, resp.Reservation.ID)
+			require.NoError(t, err)
+		}
+	}
+
+	exposure, err := usecase.NewGetExposureUseCase(f.limits).Execute(ctx, dto.GetExposureRequest{TenantID: f.tenantID, PartyID: &f.partyID})
+	require.NoError(t, err)
+	require.Len(t, exposure.Limits, 3)
+	require.Len(t, exposure.Totals, 1)
+	assert.True(t, d(600).Equal(exposure.Totals[0].Utilized))
+	assert.True(t, d(650).Equal(exposure.Totals[0].Reserved), "the card reservation is counted once, under the total limit")
+
+	byID := make(map[string]dto.LimitExposure)
+	for _, l := range exposure.Limits {
+		byID[l.Limit.ID.String()] = l
+	}
+	assert.True(t, d(500).Equal(byID[total.ID.String()].EffectiveHeadroom))
+	assert.True(t, d(500).Equal(byID[cards.ID.String()].EffectiveHeadroom), "the card limit is bound by the total limit")
+	assert.Equal(t, total.ID, byID[cards.ID.String()].BindingLimitID)
+	assert.True(t, d(50).Equal(byID[overdraft.ID.String()].EffectiveHeadroom))
+
+	reduced, err := usecase.NewReduceExposureUseCase(f.limits, f.publisher).Execute(ctx, dto.ReduceExposureRequest{
+		TenantID: f.tenantID, PartyID: &f.partyID, Category: "LENDING", Currency: "EUR", Reference: "repayment-1", Amount: d(200),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, total.ID, reduced.ID)
+	assert.True(t, d(400).Equal(reduced.Utilized))
+	tenantLimit, err := usecase.NewGetLimitUseCase(f.limits).Execute(ctx, f.tenantID, tenant.ID)
+	require.NoError(t, err)
+	assert.True(t, d(400).Equal(tenantLimit.Utilized))
+	assert.Contains(t, f.publisher.eventTypes(), "limits.exposure.reduced")
+}
@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/limits-service/internal/application/dto"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/model"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/port"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/valueobject"
+)
+
+// ErrInvalidLimit is returned when a limit request is malformed.
+var ErrInvalidLimit = errors.New("invalid limit")
+
+// ErrNoLimit is returned when no limit caps the exposure checked. Exposure
+// is only reserved against a limit, so it is declined.
+var ErrNoLimit = errors.New("no limit applies")
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// maxAttempts bounds how often a change to a chain of limits is retried
+// when another change to one of them was saved first. Tenant-wide limits
+// are shared by every reservation of the tenant, so such races are
+// expected.
+const maxAttempts = 3
+
+// CreateLimitUseCase sets a limit.
+type CreateLimitUseCase struct {
+	limits    port.LimitRepository
+	publisher port.EventPublisher
+}
+
+// NewCreateLimitUseCase creates a new CreateLimitUseCase.
+func NewCreateLimitUseCase(limits port.LimitRepository, publisher port.EventPublisher) *CreateLimitUseCase {
+	return &CreateLimitUseCase{limits: limits, publisher: publisher}
+}
+
+// Execute creates the limit. A party or the tenant has at most one limit
+// per category and currency.
+func (uc *CreateLimitUseCase) Execute(ctx context.Context, req dto.CreateLimitRequest) (dto.LimitResponse, error) {
+	category, err := valueobject.NewLimitCategory(req.Category)
+	if err != nil {
+		return dto.LimitResponse{}, fmt.Errorf("%w: %w", ErrInvalidLimit, err)
+	}
+	var parent *model.Limit
+	if req.ParentID != nil {
+		p, err := uc.limits.FindByID(ctx, req.TenantID, *req.ParentID)
+		if err != nil {
+			return dto.LimitResponse{}, fmt.Errorf("failed to find parent limit: %w", err)
+		}
+		ancestors, err := loadChain(ctx, uc.limits, p)
+		if err != nil {
+			return dto.LimitResponse{}, err
+		}
+		if len(ancestors) == model.MaxDepth {
+			return dto.LimitResponse{}, fmt.Errorf("%w: limits nest at most %d deep", ErrInvalidLimit, model.MaxDepth)
+		}
+		parent = &p
+	}
+	limit, err := model.NewLimit(req.TenantID, req.PartyID, category, req.Currency, req.Amount, parent, time.Now().UTC())
+	if err != nil {
+		return dto.LimitResponse{}, fmt.Errorf("%w: %w", ErrInvalidLimit, err)
+	}
+
+	if err := uc.limits.Save(ctx, limit); err != nil {
+		return dto.LimitResponse{}, fmt.Errorf("failed to save limit: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, limit.DomainEvents()); err != nil {
+		return dto.LimitResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toLimitResponse(limit), nil
+}
+
+// UpdateLimitUseCase changes a limit's amount.
+type UpdateLimitUseCase struct {
+	limits    port.LimitRepository
+	publisher port.EventPublisher
+}
+
+// NewUpdateLimitUseCase creates a new UpdateLimitUseCase.
+func NewUpdateLimitUseCase(limits port.LimitRepository, publisher port.EventPublisher) *UpdateLimitUseCase {
+	return &UpdateLimitUseCase{limits: limits, publisher: publisher}
+}
+
+// Execute updates the limit. Reservations change the limit's usage
+// concurrently, so a conflicting save is retried.
+func (uc *UpdateLimitUseCase) Execute(ctx context.Context, req dto.UpdateLimitRequest) (dto.LimitResponse, error) {
+	var limit model.Limit
+	err := retryOnConflict(func() error {
+		var err error
+		limit, err = uc.limits.FindByID(ctx, req.TenantID, req.LimitID)
+		if err != nil {
+			return fmt.Errorf("failed to find limit: %w", err)
+		}
+		limit, err = limit.SetAmount(req.Amount, time.Now().UTC())
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidLimit, err)
+		}
+		if err := uc.limits.Save(ctx, limit); err != nil {
+			return fmt.Errorf("failed to save limit: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dto.LimitResponse{}, err
+	}
+	if err := uc.publisher.Publish(ctx, limit.DomainEvents()); err != nil {
+		return dto.LimitResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toLimitResponse(limit), nil
+}
+
+// GetLimitUseCase retrieves a limit.
+type GetLimitUseCase struct {
+	limits port.LimitRepository
+}
+
+// NewGetLimitUseCase creates a new GetLimitUseCase.
+func NewGetLimitUseCase(limits port.LimitRepository) *GetLimitUseCase {
+	return &GetLimitUseCase{limits: limits}
+}
+
+// Execute retrieves the limit.
+func (uc *GetLimitUseCase) Execute(ctx context.Context, tenantID, limitID uuid.UUID) (dto.LimitResponse, error) {
+	limit, err := uc.limits.FindByID(ctx, tenantID, limitID)
+	if err != nil {
+		return dto.LimitResponse{}, fmt.Errorf("failed to find limit: %w", err)
+	}
+	return toLimitResponse(limit), nil
+}
+
+// ListLimitsUseCase lists a tenant's limits.
+type ListLimitsUseCase struct {
+	limits port.LimitRepository
+}
+
+// NewListLimitsUseCase creates a new ListLimitsUseCase.
+func NewListLimitsUseCase(limits port.LimitRepository) *ListLimitsUseCase {
+	return &ListLimitsUseCase{limits: limits}
+}
+
+// Execute returns one page of the tenant's limits.
+func (uc *ListLimitsUseCase) Execute(ctx context.Context, req dto.ListLimitsRequest) (dto.ListLimitsResponse, error) {
+	limit, offset := page(req.Limit, req.Offset)
+	filter := port.LimitFilter{
+		TenantID:   req.TenantID,
+		PartyID:    req.PartyID,
+		Currency:   req.Currency,
+		TenantWide: req.TenantWide,
+	}
+	limits, total, err := uc.limits.List(ctx, filter, limit, offset)
+	if err != nil {
+		return dto.ListLimitsResponse{}, fmt.Errorf("failed to list limits: %w", err)
+	}
+	resp := dto.ListLimitsResponse{
+		Limits:     make([]dto.LimitResponse, 0, len(limits)),
+		TotalCount: total,
+	}
+	for _, l := range limits {
+		resp.Limits = append(resp.Limits, toLimitResponse(l))
+	}
+	return resp, nil
+}
+
+// loadChain returns a limit followed by each limit it rolls up into.
+func loadChain(ctx context.Context, limits port.LimitRepository, limit model.Limit) ([]model.Limit, error) {
+	chain := []model.Limit{limit}
+	for parentID := limit.ParentID(); parentID != nil; parentID = chain[len(chain)-1].ParentID() {
+		if len(chain) == model.MaxDepth {
+			return nil, fmt.Errorf("%w: limit %s nests more than %d deep", model.ErrInvalidChain, limit.ID(), model.MaxDepth)
+		}
+		parent, err := limits.FindByID(ctx, limit.TenantID(), *parentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find parent limit %s: %w", *parentID, err)
+		}
+		chain = append(chain, parent)
+	}
+	return chain, nil
+}
+
+// resolveChain returns the chain of limits capping exposure of a category
+// in a currency by a party, or by the tenant when partyID is nil. The most
+// specific limit set starts it: the party's limit for the category, else
+// the party's total limit, else the tenant's for the category, else the
+// tenant's total limit.
+func resolveChain(
+	ctx context.Context,
+	limits port.LimitRepository,
+	tenantID uuid.UUID,
+	partyID *uuid.UUID,
+	category valueobject.LimitCategory,
+	currency string,
+) ([]model.Limit, error) {
+	scopes := []port.LimitScope{
+		{TenantID: tenantID, PartyID: partyID, Category: category, Currency: currency},
+		{TenantID: tenantID, PartyID: partyID, Category: valueobject.LimitCategoryTotal, Currency: currency},
+	}
+	if partyID != nil {
+		scopes = append(scopes,
+			port.LimitScope{TenantID: tenantID, Category: category, Currency: currency},
+			port.LimitScope{TenantID: tenantID, Category: valueobject.LimitCategoryTotal, Currency: currency},
+		)
+	}
+	for _, scope := range scopes {
+		limit, err := limits.FindByScope(ctx, scope)
+		if errors.Is(err, port.ErrLimitNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find limit: %w", err)
+		}
+		return loadChain(ctx, limits, limit)
+	}
+	return nil, fmt.Errorf("%w: no %s limit in %s", ErrNoLimit, category, currency)
+}
+
+// retryOnConflict runs fn again while it fails with a version conflict, up
+// to maxAttempts times.
+func retryOnConflict(fn func() error) error {
+	var err error
+	for range maxAttempts {
+		if err = fn(); !errors.Is(err, port.ErrVersionConflict) {
+			return err
+		}
+	}
+	return err
+}
+
+func page(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	return min(limit, maxLimit), max(offset, 0)
+}
+
+func toLimitResponse(l model.Limit) dto.LimitResponse {
+	return dto.LimitResponse{
+		ID:        l.ID(),
+		TenantID:  l.TenantID(),
+		PartyID:   l.PartyID(),
+		ParentID:  l.ParentID(),
+		Category:  l.Category().String(),
+		Currency:  l.Currency(),
+		Amount:    l.Amount(),
+		Utilized:  l.Utilized(),
+		Reserved:  l.Reserved(),
+		Available: l.Available(),
+		Version:   l.Version(),
+		CreatedAt: l.CreatedAt(),
+		UpdatedAt: l.UpdatedAt(),
+	}
+}
@@ -0,0 +1,148 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/limits-service/internal/application/dto"
+	"github.com/bibbank/bib/services/limits-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/event"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/model"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/port"
+)
+
+func d(v int64) decimal.Decimal { return decimal.NewFromInt(v) }
+
+// inMemoryLimitRepo is an in-memory LimitRepository.
+type inMemoryLimitRepo struct {
+	limits []model.Limit
+	// conflicts is how many saves still fail with a version conflict.
+	conflicts int
+}
+
+func (r *inMemoryLimitRepo) Save(_ context.Context, limits ...model.Limit) error {
+	if r.conflicts > 0 {
+		r.conflicts--
+		return port.ErrVersionConflict
+	}
+	// Check every version before writing any, as one transaction would.
+	for _, limit := range limits {
+		i := r.index(limit.ID())
+		if i < 0 {
+			if _, err := r.FindByScope(context.Background(), scopeOf(limit)); err == nil {
+				return port.ErrLimitExists
+			}
+		} else if r.limits[i].Version() != limit.Version()-1 {
+			return port.ErrVersionConflict
+		}
+	}
+	for _, limit := range limits {
+		// Events are not persisted.
+		limit = limit.ClearDomainEvents()
+		if i := r.index(limit.ID()); i >= 0 {
+			r.limits[i] = limit
+		} else {
+			r.limits = append(r.limits, limit)
+		}
+	}
+	return nil
+}
+
+func (r *inMemoryLimitRepo) index(id uuid.UUID) int {
+	return slices.IndexFunc(r.limits, func(l model.Limit) bool { return l.ID() == id })
+}
+
+func (r *inMemoryLimitRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.Limit, error) {
+	for _, l := range r.limits {
+		if l.TenantID() == tenantID && l.ID() == id {
+			return l, nil
+		}
+	}
+	return model.Limit{}, port.ErrLimitNotFound
+}
+
+func (r *inMemoryLimitRepo) FindByScope(_ context.Context, scope port.LimitScope) (model.Limit, error) {
+	for _, l := range r.limits {
+		if l.TenantID() == scope.TenantID && sameParty(l.PartyID(), scope.PartyID) &&
+			l.Category() == scope.Category && l.Currency() == scope.Currency {
+			return l, nil
+		}
+	}
+	return model.Limit{}, port.ErrLimitNotFound
+}
+
+func scopeOf(l model.Limit) port.LimitScope {
+	return port.LimitScope{TenantID: l.TenantID(), PartyID: l.PartyID(), Category: l.Category(), Currency: l.Currency()}
+}
+
+func sameParty(a, b *uuid.UUID) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+func (r *inMemoryLimitRepo) List(_ context.Context, filter port.LimitFilter, limit, offset int) ([]model.Limit, int, error) {
+	var out []model.Limit
+	for _, l := range r.limits {
+		switch {
+		case l.TenantID() != filter.TenantID,
+			filter.Currency != "" && l.Currency() != filter.Currency,
+			filter.TenantWide && l.PartyID() != nil,
+			filter.PartyID != nil && !sameParty(l.PartyID(), filter.PartyID):
+			continue
+		}
+		out = append(out, l)
+	}
+	total := len(out)
+	return out[min(offset, total):min(offset+limit, total)], total, nil
+}
+
+// recordingPublisher is an EventPublisher that records what it publishes.
+type recordingPublisher struct {
+	events []event.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, events []event.DomainEvent) error {
+	p.events = append(p.events, events...)
+	return nil
+}
+
+func (p *recordingPublisher) eventTypes() []string {
+	types := make([]string, len(p.events))
+	for i, e := range p.events {
+		types[i] = e.EventType()
+	}
+	return types
+}
+
+func TestCreateLimit(t *testing.T) {
+	ctx := context.Background()
+	limits, publisher := &inMemoryLimitRepo{}, &recordingPublisher{}
+	tenantID, partyID := uuid.New(), uuid.New()
+	create := usecase.NewCreateLimitUseCase(limits, publisher)
+	tenant, err := create.Execute(ctx, dto.CreateLimitRequest{TenantID: tenantID, Category: "TOTAL", Currency: "EUR", Amount: d(10000)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"limits.limit.created"}, publisher.eventTypes())
+
+	_, err = create.Execute(ctx, dto.CreateLimitRequest{TenantID: tenantID, Category: "TOTAL", Currency: "EUR", Amount: d(5)})
+	assert.ErrorIs(t, err, port.ErrLimitExists, "one limit per scope")
+	_, err = create.Execute(ctx, dto.CreateLimitRequest{
+		TenantID: tenantID, PartyID: &partyID, ParentID: &tenant.ID, Category: "CARDS", Currency: "USD", Amount: d(5),
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidLimit, "another currency than the parent's")
+
+	updated, err := usecase.NewUpdateLimitUseCase(limits, publisher).Execute(ctx, dto.UpdateLimitRequest{
+		TenantID: tenantID, LimitID: tenant.ID, Amount: d(20000),
+	})
+	require.NoError(t, err)
+	assert.True(t, d(20000).Equal(updated.Amount))
+	assert.Equal(t, 2, updated.Version)
+
+	list, err := usecase.NewListLimitsUseCase(limits).Execute(ctx, dto.ListLimitsRequest{TenantID: tenantID, TenantWide: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, list.TotalCount)
+}
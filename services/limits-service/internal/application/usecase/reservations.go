@@ -0,0 +1,333 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/limits-service/internal/application/dto"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/model"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/port"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/valueobject"
+)
+
+// ErrInvalidReservation is returned when a reservation request is
+// malformed.
+var ErrInvalidReservation = errors.New("invalid reservation")
+
+// expiryBatchSize bounds how many expired reservations one sweep releases.
+const expiryBatchSize = 100
+
+// CheckAndReserveUseCase checks exposure against the limits capping it and
+// reserves it if it fits. Services call it before committing to exposure,
+// and commit or release the reservation once they know the outcome.
+type CheckAndReserveUseCase struct {
+	limits       port.LimitRepository
+	reservations port.ReservationRepository
+	publisher    port.EventPublisher
+	ttl          time.Duration
+}
+
+// NewCheckAndReserveUseCase creates a new CheckAndReserveUseCase whose
+// reservations expire after ttl.
+func NewCheckAndReserveUseCase(
+	limits port.LimitRepository,
+	reservations port.ReservationRepository,
+	publisher port.EventPublisher,
+	ttl time.Duration,
+) *CheckAndReserveUseCase {
+	return &CheckAndReserveUseCase{limits: limits, reservations: reservations, publisher: publisher, ttl: ttl}
+}
+
+// Execute checks the amount against the chain of limits capping the
+// exposure and, unless the request is a dry run, reserves it when every
+// limit has the headroom. An amount that does not fit is declined rather
+// than failed.
+func (uc *CheckAndReserveUseCase) Execute(ctx context.Context, req dto.CheckAndReserveRequest) (dto.CheckAndReserveResponse, error) {
+	category, err := valueobject.NewLimitCategory(req.Category)
+	if err != nil {
+		return dto.CheckAndReserveResponse{}, fmt.Errorf("%w: %w", ErrInvalidReservation, err)
+	}
+	if !req.Amount.IsPositive() {
+		return dto.CheckAndReserveResponse{}, fmt.Errorf("%w: amount must be positive", ErrInvalidReservation)
+	}
+	if !req.DryRun {
+		if resp, found, err := uc.replay(ctx, req.TenantID, req.Reference); found || err != nil {
+			return resp, err
+		}
+	}
+
+	var (
+		resp        dto.CheckAndReserveResponse
+		reservation model.Reservation
+	)
+	err = retryOnConflict(func() error {
+		chain, err := resolveChain(ctx, uc.limits, req.TenantID, req.PartyID, category, req.Currency)
+		if err != nil {
+			return err
+		}
+		headroom, binding := model.Headroom(chain)
+		resp = dto.CheckAndReserveResponse{Headroom: headroom}
+		if req.Amount.GreaterThan(headroom) {
+			resp.BindingLimitID = binding.ID()
+			return nil
+		}
+		resp.Approved = true
+		if req.DryRun {
+			return nil
+		}
+
+		var limits []model.Limit
+		reservation, limits, err = model.Reserve(chain, req.PartyID, category, req.Reference, req.Amount, uc.ttl, time.Now().UTC())
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidReservation, err)
+		}
+		if err := uc.reservations.Save(ctx, reservation, limits...); err != nil {
+			return fmt.Errorf("failed to save reservation: %w", err)
+		}
+		return nil
+	})
+	if errors.Is(err, port.ErrReservationExists) {
+		// The same reference was reserved concurrently.
+		resp, _, err = uc.replay(ctx, req.TenantID, req.Reference)
+		return resp, err
+	}
+	if err != nil || !resp.Approved || req.DryRun {
+		return resp, err
+	}
+
+	if err := uc.publisher.Publish(ctx, reservation.DomainEvents()); err != nil {
+		return dto.CheckAndReserveResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	r := toReservationResponse(reservation)
+	resp.Headroom = resp.Headroom.Sub(req.Amount)
+	resp.Reservation = &r
+	return resp, nil
+}
+
+// replay returns the outcome of the reservation already made with a
+// reference, if any. It is approved while the reservation holds or has
+// committed its amount.
+func (uc *CheckAndReserveUseCase) replay(ctx context.Context, tenantID uuid.UUID, reference string) (dto.CheckAndReserveResponse, bool, error) {
+	existing, err := uc.reservations.FindByReference(ctx, tenantID, reference)
+	if errors.Is(err, port.ErrReservationNotFound) {
+		return dto.CheckAndReserveResponse{}, false, nil
+	}
+	if err != nil {
+		return dto.CheckAndReserveResponse{}, false, fmt.Errorf("failed to find reservation: %w", err)
+	}
+	r := toReservationResponse(existing)
+	status := existing.Status()
+	return dto.CheckAndReserveResponse{
+		Approved:    status == valueobject.ReservationStatusHeld || status == valueobject.ReservationStatusCommitted,
+		Reservation: &r,
+	}, true, nil
+}
+
+// CommitReservationUseCase turns a held reservation into utilization of
+// its limits once the exposure it stands for is committed.
+type CommitReservationUseCase struct {
+	settler reservationSettler
+}
+
+// NewCommitReservationUseCase creates a new CommitReservationUseCase.
+func NewCommitReservationUseCase(
+	limits port.LimitRepository,
+	reservations port.ReservationRepository,
+	publisher port.EventPublisher,
+) *CommitReservationUseCase {
+	return &CommitReservationUseCase{settler: reservationSettler{limits: limits, reservations: reservations, publisher: publisher}}
+}
+
+// Execute commits the reservation.
+func (uc *CommitReservationUseCase) Execute(ctx context.Context, tenantID, reservationID uuid.UUID) (dto.ReservationResponse, error) {
+	return uc.settler.settle(ctx, tenantID, reservationID, model.Reservation.Commit)
+}
+
+// ReleaseReservationUseCase gives a held reservation back to its limits
+// when the exposure it stands for does not go ahead.
+type ReleaseReservationUseCase struct {
+	settler reservationSettler
+}
+
+// NewReleaseReservationUseCase creates a new ReleaseReservationUseCase.
+func NewReleaseReservationUseCase(
+	limits port.LimitRepository,
+	reservations port.ReservationRepository,
+	publisher port.EventPublisher,
+) *ReleaseReservationUseCase {
+	return &ReleaseReservationUseCase{settler: reservationSettler{limits: limits, reservations: reservations, publisher: publisher}}
+}
+
+// Execute releases the reservation.
+func (uc *ReleaseReservationUseCase) Execute(ctx context.Context, tenantID, reservationID uuid.UUID) (dto.ReservationResponse, error) {
+	return uc.settler.settle(ctx, tenantID, reservationID, model.Reservation.Release)
+}
+
+// ExpireReservationsUseCase releases the reservations held past their
+// expiry, such as those of callers that never came back to commit or
+// release them.
+type ExpireReservationsUseCase struct {
+	reservations port.ReservationRepository
+	settler      reservationSettler
+	logger       *slog.Logger
+}
+
+// NewExpireReservationsUseCase creates a new ExpireReservationsUseCase.
+func NewExpireReservationsUseCase(
+	limits port.LimitRepository,
+	reservations port.ReservationRepository,
+	publisher port.EventPublisher,
+	logger *slog.Logger,
+) *ExpireReservationsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ExpireReservationsUseCase{
+		reservations: reservations,
+		settler:      reservationSettler{limits: limits, reservations: reservations, publisher: publisher},
+		logger:       logger,
+	}
+}
+
+// Execute expires up to a batch of reservations held past their expiry at
+// now and returns how many it expired. A reservation that fails to expire
+// is logged and left for the next sweep.
+func (uc *ExpireReservationsUseCase) Execute(ctx context.Context, now time.Time) (int, error) {
+	expired, err := uc.reservations.ListExpired(ctx, now, expiryBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired reservations: %w", err)
+	}
+	count := 0
+	for _, r := range expired {
+		_, err := uc.settler.settle(ctx, r.TenantID(), r.ID(), func(r model.Reservation, chain []model.Limit, _ time.Time) (model.Reservation, []model.Limit, error) {
+			return r.Expire(chain, now)
+		})
+		if err != nil {
+			uc.logger.Error("failed to expire reservation", "reservation_id", r.ID(), "error", err)
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// reservationSettler moves held reservations to a final status.
+type reservationSettler struct {
+	limits       port.LimitRepository
+	reservations port.ReservationRepository
+	publisher    port.EventPublisher
+}
+
+type settleFunc func(model.Reservation, []model.Limit, time.Time) (model.Reservation, []model.Limit, error)
+
+func (s reservationSettler) settle(ctx context.Context, tenantID, reservationID uuid.UUID, settle settleFunc) (dto.ReservationResponse, error) {
+	var reservation model.Reservation
+	err := retryOnConflict(func() error {
+		var err error
+		reservation, err = s.reservations.FindByID(ctx, tenantID, reservationID)
+		if err != nil {
+			return fmt.Errorf("failed to find reservation: %w", err)
+		}
+		chain := make([]model.Limit, 0, len(reservation.LimitIDs()))
+		for _, id := range reservation.LimitIDs() {
+			limit, err := s.limits.FindByID(ctx, tenantID, id)
+			if err != nil {
+				return fmt.Errorf("failed to find limit %s: %w", id, err)
+			}
+			chain = append(chain, limit)
+		}
+		var limits []model.Limit
+		reservation, limits, err = settle(reservation, chain, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+		if err := s.reservations.Save(ctx, reservation, limits...); err != nil {
+			return fmt.Errorf("failed to save reservation: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dto.ReservationResponse{}, err
+	}
+	if err := s.publisher.Publish(ctx, reservation.DomainEvents()); err != nil {
+		return dto.ReservationResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toReservationResponse(reservation), nil
+}
+
+// GetReservationUseCase retrieves a reservation.
+type GetReservationUseCase struct {
+	reservations port.ReservationRepository
+}
+
+// NewGetReservationUseCase creates a new GetReservationUseCase.
+func NewGetReservationUseCase(reservations port.ReservationRepository) *GetReservationUseCase {
+	return &GetReservationUseCase{reservations: reservations}
+}
+
+// Execute retrieves the reservation.
+func (uc *GetReservationUseCase) Execute(ctx context.Context, tenantID, reservationID uuid.UUID) (dto.ReservationResponse, error) {
+	reservation, err := uc.reservations.FindByID(ctx, tenantID, reservationID)
+	if err != nil {
+		return dto.ReservationResponse{}, fmt.Errorf("failed to find reservation: %w", err)
+	}
+	return toReservationResponse(reservation), nil
+}
+
+// ListReservationsUseCase lists a tenant's reservations.
+type ListReservationsUseCase struct {
+	reservations port.ReservationRepository
+}
+
+// NewListReservationsUseCase creates a new ListReservationsUseCase.
+func NewListReservationsUseCase(reservations port.ReservationRepository) *ListReservationsUseCase {
+	return &ListReservationsUseCase{reservations: reservations}
+}
+
+// Execute returns one page of the tenant's reservations.
+func (uc *ListReservationsUseCase) Execute(ctx context.Context, req dto.ListReservationsRequest) (dto.ListReservationsResponse, error) {
+	filter := port.ReservationFilter{TenantID: req.TenantID, PartyID: req.PartyID}
+	if req.Status != "" {
+		status, err := valueobject.NewReservationStatus(req.Status)
+		if err != nil {
+			return dto.ListReservationsResponse{}, fmt.Errorf("%w: %w", ErrInvalidReservation, err)
+		}
+		filter.Status = status
+	}
+	limit, offset := page(req.Limit, req.Offset)
+	reservations, total, err := uc.reservations.List(ctx, filter, limit, offset)
+	if err != nil {
+		return dto.ListReservationsResponse{}, fmt.Errorf("failed to list reservations: %w", err)
+	}
+	resp := dto.ListReservationsResponse{
+		Reservations: make([]dto.ReservationResponse, 0, len(reservations)),
+		TotalCount:   total,
+	}
+	for _, r := range reservations {
+		resp.Reservations = append(resp.Reservations, toReservationResponse(r))
+	}
+	return resp, nil
+}
+
+func toReservationResponse(r model.Reservation) dto.ReservationResponse {
+	return dto.ReservationResponse{
+		ID:        r.ID(),
+		TenantID:  r.TenantID(),
+		PartyID:   r.PartyID(),
+		Category:  r.Category().String(),
+		Currency:  r.Currency(),
+		Reference: r.Reference(),
+		Amount:    r.Amount(),
+		LimitIDs:  r.LimitIDs(),
+		Status:    r.Status().String(),
+		ExpiresAt: r.ExpiresAt(),
+		Version:   r.Version(),
+		CreatedAt: r.CreatedAt(),
+		UpdatedAt: r.UpdatedAt(),
+	}
+}
@@ -0,0 +1,282 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/limits-service/internal/application/dto"
+	"github.com/bibbank/bib/services/limits-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/model"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/port"
+)
+
+// inMemoryReservationRepo is an in-memory ReservationRepository saving
+// limits to an inMemoryLimitRepo.
+type inMemoryReservationRepo struct {
+	limits       *inMemoryLimitRepo
+	reservations []model.Reservation
+}
+
+func (r *inMemoryReservationRepo) Save(ctx context.Context, reservation model.Reservation, limits ...model.Limit) error {
+	i := slices.IndexFunc(r.reservations, func(e model.Reservation) bool { return e.ID() == reservation.ID() })
+	if i < 0 {
+		if _, err := r.FindByReference(ctx, reservation.TenantID(), reservation.Reference()); err == nil {
+			return port.ErrReservationExists
+		}
+	} else if r.reservations[i].Version() != reservation.Version()-1 {
+		return port.ErrVersionConflict
+	}
+	if err := r.limits.Save(ctx, limits...); err != nil {
+		return err
+	}
+	reservation = reservation.ClearDomainEvents()
+	if i >= 0 {
+		r.reservations[i] = reservation
+	} else {
+		r.reservations = append(r.reservations, reservation)
+	}
+	return nil
+}
+
+func (r *inMemoryReservationRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.Reservation, error) {
+	for _, e := range r.reservations {
+		if e.TenantID() == tenantID && e.ID() == id {
+			return e, nil
+		}
+	}
+	return model.Reservation{}, port.ErrReservationNotFound
+}
+
+func (r *inMemoryReservationRepo) FindByReference(_ context.Context, tenantID uuid.UUID, reference string) (model.Reservation, error) {
+	for _, e := range r.reservations {
+		if e.TenantID() == tenantID && e.Reference() == reference {
+			return e, nil
+		}
+	}
+	return model.Reservation{}, port.ErrReservationNotFound
+}
+
+func (r *inMemoryReservationRepo) List(_ context.Context, filter port.ReservationFilter, limit, offset int) ([]model.Reservation, int, error) {
+	var out []model.Reservation
+	for _, e := range r.reservations {
+		switch {
+		case e.TenantID() != filter.TenantID,
+			!filter.Status.IsZero() && e.Status() != filter.Status,
+			filter.PartyID != nil && !sameParty(e.PartyID(), filter.PartyID):
+			continue
+		}
+		out = append(out, e)
+	}
+	total := len(out)
+	return out[min(offset, total):min(offset+limit, total)], total, nil
+}
+
+func (r *inMemoryReservationRepo) ListExpired(_ context.Context, now time.Time, limit int) ([]model.Reservation, error) {
+	var out []model.Reservation
+	for _, e := range r.reservations {
+		if e.IsExpired(now) && len(out) < limit {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+// reservationFixture wires the reservation use cases over in-memory
+// adapters for one party of one tenant.
+type reservationFixture struct {
+	limits       *inMemoryLimitRepo
+	reservations *inMemoryReservationRepo
+	publisher    *recordingPublisher
+	tenantID     uuid.UUID
+	partyID      uuid.UUID
+}
+
+func newReservationFixture() *reservationFixture {
+	limits := &inMemoryLimitRepo{}
+	return &reservationFixture{
+		limits:       limits,
+		reservations: &inMemoryReservationRepo{limits: limits},
+		publisher:    &recordingPublisher{},
+		tenantID:     uuid.New(),
+		partyID:      uuid.New(),
+	}
+}
+
+// createLimit sets a limit on the fixture's party, or on the tenant when
+// party is false.
+func (f *reservationFixture) createLimit(t *testing.T, party bool, category string, amount int64, parent *dto.LimitResponse) dto.LimitResponse {
+	t.Helper()
+	req := dto.CreateLimitRequest{TenantID: f.tenantID, Category: category, Currency: "EUR", Amount: d(amount)}
+	if party {
+		req.PartyID = &f.partyID
+	}
+	if parent != nil {
+		req.ParentID = &parent.ID
+	}
+	limit, err := usecase.NewCreateLimitUseCase(f.limits, f.publisher).Execute(context.Background(), req)
+	require.NoError(t, err)
+	return limit
+}
+
+func (f *reservationFixture) checkAndReserve() *usecase.CheckAndReserveUseCase {
+	return usecase.NewCheckAndReserveUseCase(f.limits, f.reservations, f.publisher, 15*time.Minute)
+}
+
+func (f *reservationFixture) reserveRequest(category, reference string, amount int64) dto.CheckAndReserveRequest {
+	return dto.CheckAndReserveRequest{
+		TenantID:  f.tenantID,
+		PartyID:   &f.partyID,
+		Category:  category,
+		Currency:  "EUR",
+		Reference: reference,
+		Amount:    d(amount),
+	}
+}
+
+// hierarchy sets the tenant's total limit of 10000, the party's total limit
+// of 1500 under it and the party's card limit of 1000 under that.
+func (f *reservationFixture) hierarchy(t *testing.T) (tenant, total, cards dto.LimitResponse) {
+	t.Helper()
+	tenant = f.createLimit(t, false, "TOTAL", 10000, nil)
+	total = f.createLimit(t, true, "TOTAL", 1500, &tenant)
+	cards = f.createLimit(t, true, "CARDS", 1000, &total)
+	f.publisher.events = nil
+	return tenant, total, cards
+}
+
+func TestCheckAndReserve_BoundByTheHierarchy(t *testing.T) {
+	f := newReservationFixture()
+	ctx := context.Background()
+	tenant, total, cards := f.hierarchy(t)
+	uc := f.checkAndReserve()
+
+	resp, err := uc.Execute(ctx, f.reserveRequest("CARDS", "auth-1", 800))
+	require.NoError(t, err)
+	require.True(t, resp.Approved)
+	require.NotNil(t, resp.Reservation)
+	assert.Equal(t, "HELD", resp.Reservation.Status)
+	assert.Equal(t, []uuid.UUID{cards.ID, total.ID, tenant.ID}, resp.Reservation.LimitIDs)
+	assert.True(t, d(200).Equal(resp.Headroom), "what is left under the card limit")
+
+	replayed, err := uc.Execute(ctx, f.reserveRequest("CARDS", "auth-1", 800))
+	require.NoError(t, err)
+	assert.True(t, replayed.Approved)
+	assert.Equal(t, resp.Reservation.ID, replayed.Reservation.ID, "a repeated reference returns the first reservation")
+
+	check := f.reserveRequest("CARDS", "auth-2", 300)
+	check.DryRun = true
+	declined, err := uc.Execute(ctx, check)
+	require.NoError(t, err)
+	assert.False(t, declined.Approved)
+	assert.Nil(t, declined.Reservation)
+	assert.Equal(t, cards.ID, declined.BindingLimitID)
+
+	// The party has no lending limit, so its total limit caps lending.
+	declined, err = uc.Execute(ctx, f.reserveRequest("LENDING", "loan-1", 800))
+	require.NoError(t, err)
+	assert.False(t, declined.Approved)
+	assert.Equal(t, total.ID, declined.BindingLimitID)
+	assert.True(t, d(700).Equal(declined.Headroom))
+	approved, err := uc.Execute(ctx, f.reserveRequest("LENDING", "loan-1", 700))
+	require.NoError(t, err)
+	assert.True(t, approved.Approved)
+	assert.Equal(t, []uuid.UUID{total.ID, tenant.ID}, approved.Reservation.LimitIDs)
+
+	// Another party without limits is capped by the tenant's.
+	other := f.reserveRequest("PAYMENTS", "payment-1", 100)
+	other.PartyID = new(uuid.UUID)
+	*other.PartyID = uuid.New()
+	approved, err = uc.Execute(ctx, other)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{tenant.ID}, approved.Reservation.LimitIDs)
+
+	stored, err := usecase.NewGetLimitUseCase(f.limits).Execute(ctx, f.tenantID, tenant.ID)
+	require.NoError(t, err)
+	assert.True(t, d(1600).Equal(stored.Reserved))
+	assert.True(t, d(8400).Equal(stored.Available))
+	assert.Equal(t, []string{"limits.reservation.held", "limits.reservation.held", "limits.reservation.held"},
+		f.publisher.eventTypes())
+
+	_, err = uc.Execute(ctx, dto.CheckAndReserveRequest{
+		TenantID: uuid.New(), Category: "CARDS", Currency: "EUR", Reference: "auth-3", Amount: d(1),
+	})
+	assert.ErrorIs(t, err, usecase.ErrNoLimit)
+	_, err = uc.Execute(ctx, f.reserveRequest("MORTGAGES", "auth-3", 1))
+	assert.ErrorIs(t, err, usecase.ErrInvalidReservation)
+}
+
+func TestCheckAndReserve_RetriesConflicts(t *testing.T) {
+	f := newReservationFixture()
+	f.hierarchy(t)
+	uc := f.checkAndReserve()
+
+	f.limits.conflicts = 2
+	resp, err := uc.Execute(context.Background(), f.reserveRequest("CARDS", "auth-1", 100))
+	require.NoError(t, err)
+	assert.True(t, resp.Approved)
+
+	f.limits.conflicts = 3
+	_, err = uc.Execute(context.Background(), f.reserveRequest("CARDS", "auth-2", 100))
+	assert.ErrorIs(t, err, port.ErrVersionConflict)
+	_, err = f.reservations.FindByReference(context.Background(), f.tenantID, "auth-2")
+	assert.ErrorIs(t, err, port.ErrReservationNotFound)
+}
+
+func TestSettleReservations(t *testing.T) {
+	f := newReservationFixture()
+	ctx := context.Background()
+	_, _, cards := f.hierarchy(t)
+	uc := f.checkAndReserve()
+	first, err := uc.Execute(ctx, f.reserveRequest("CARDS", "auth-1", 300))
+	require.NoError(t, err)
+	second, err := uc.Execute(ctx, f.reserveRequest("CARDS", "auth-2", 200))
+	require.NoError(t, err)
+	third, err := uc.Execute(ctx, f.reserveRequest("CARDS", "auth-3", 100))
+	require.NoError(t, err)
+
+	commit := usecase.NewCommitReservationUseCase(f.limits, f.reservations, f.publisher)
+	committed, err := commit.Execute(ctx, f.tenantID, first.Reservation.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "COMMITTED", committed.Status)
+	_, err = commit.Execute(ctx, f.tenantID, first.Reservation.ID)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+
+	release := usecase.NewReleaseReservationUseCase(f.limits, f.reservations, f.publisher)
+	released, err := release.Execute(ctx, f.tenantID, second.Reservation.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "RELEASED", released.Status)
+
+	expire := usecase.NewExpireReservationsUseCase(f.limits, f.reservations, f.publisher, nil)
+	expired, err := expire.Execute(ctx, time.Now().UTC())
+	require.NoError(t, err)
+	assert.Zero(t, expired, "still held")
+	expired, err = expire.Execute(ctx, time.Now().UTC().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+
+	limit, err := usecase.NewGetLimitUseCase(f.limits).Execute(ctx, f.tenantID, cards.ID)
+	require.NoError(t, err)
+	assert.True(t, d(300).Equal(limit.Utilized))
+	assert.True(t, limit.Reserved.IsZero())
+
+	got, err := usecase.NewGetReservationUseCase(f.reservations).Execute(ctx, f.tenantID, third.Reservation.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "EXPIRED", got.Status)
+	list, err := usecase.NewListReservationsUseCase(f.reservations).Execute(ctx, dto.ListReservationsRequest{
+		TenantID: f.tenantID, PartyID: &f.partyID, Status: "HELD",
+	})
+	require.NoError(t, err)
+	assert.Zero(t, list.TotalCount)
+
+	// A replayed reference no longer holding its amount is not approved.
+	replayed, err := uc.Execute(ctx, f.reserveRequest("CARDS", "auth-2", 200))
+	require.NoError(t, err)
+	assert.False(t, replayed.Approved)
+	assert.Equal(t, "RELEASED", replayed.Reservation.Status)
+}
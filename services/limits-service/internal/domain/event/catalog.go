@@ -0,0 +1,17 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[LimitCreated]("limits.limit.created", 1, "Emitted when a limit is set."),
+		events.Declare[LimitChanged]("limits.limit.changed", 1, "Emitted when a limit's amount changes."),
+		events.Declare[ReservationHeld]("limits.reservation.held", 1, "Emitted when an amount is set aside on a limit and every limit it rolls up into."),
+		events.Declare[ReservationSettled]("limits.reservation.committed", 1, "Emitted when a held reservation is committed, released or expires, for which its event type is limits.reservation.committed, limits.reservation.released or limits.reservation.expired."),
+		events.Declare[ReservationSettled]("limits.reservation.released", 1, "Emitted when a held reservation is committed, released or expires, for which its event type is limits.reservation.committed, limits.reservation.released or limits.reservation.expired."),
+		events.Declare[ReservationSettled]("limits.reservation.expired", 1, "Emitted when a held reservation is committed, released or expires, for which its event type is limits.reservation.committed, limits.reservation.released or limits.reservation.expired."),
+		events.Declare[ExposureReduced]("limits.exposure.reduced", 1, "Emitted when utilization of a limit and every limit it rolls up into is reduced, such as when a loan is repaid."),
+	}
+}
@@ -0,0 +1,154 @@
+package event
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// DomainEvent is an alias for the shared pkg/events.DomainEvent interface.
+type DomainEvent = events.DomainEvent
+
+// Aggregate types of limits events.
+const (
+	AggregateTypeLimit       = "Limit"
+	AggregateTypeReservation = "Reservation"
+)
+
+// LimitCreated is emitted when a limit is set. PartyID is nil for a
+// tenant-wide limit and ParentID nil for a limit that rolls up into no
+// other.
+type LimitCreated struct {
+	PartyID  *uuid.UUID `json:"party_id,omitempty"`
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+	events.BaseEvent
+	Category string          `json:"category"`
+	Currency string          `json:"currency"`
+	Amount   decimal.Decimal `json:"amount"`
+}
+
+// NewLimitCreated creates a new LimitCreated event.
+func NewLimitCreated(limitID, tenantID uuid.UUID, partyID, parentID *uuid.UUID, category, currency string, amount decimal.Decimal) LimitCreated {
+	return LimitCreated{
+		BaseEvent: events.NewBaseEvent("limits.limit.created", limitID.String(), AggregateTypeLimit, tenantID.String()),
+		PartyID:   partyID,
+		ParentID:  parentID,
+		Category:  category,
+		Currency:  currency,
+		Amount:    amount,
+	}
+}
+
+// LimitChanged is emitted when a limit's amount changes.
+type LimitChanged struct {
+	events.BaseEvent
+	PreviousAmount decimal.Decimal `json:"previous_amount"`
+	Amount         decimal.Decimal `json:"amount"`
+	Utilized       decimal.Decimal `json:"utilized"`
+	Reserved       decimal.Decimal `json:"reserved"`
+}
+
+// NewLimitChanged creates a new LimitChanged event.
+func NewLimitChanged(limitID, tenantID uuid.UUID, previous, amount, utilized, reserved decimal.Decimal) LimitChanged {
+	return LimitChanged{
+		BaseEvent:      events.NewBaseEvent("limits.limit.changed", limitID.String(), AggregateTypeLimit, tenantID.String()),
+		PreviousAmount: previous,
+		Amount:         amount,
+		Utilized:       utilized,
+		Reserved:       reserved,
+	}
+}
+
+// ReservationHeld is emitted when an amount is set aside on a limit and
+// every limit it rolls up into. LimitIDs lists them from the most specific
+// up.
+type ReservationHeld struct {
+	ExpiresAt time.Time  `json:"expires_at"`
+	PartyID   *uuid.UUID `json:"party_id,omitempty"`
+	events.BaseEvent
+	Category  string          `json:"category"`
+	Currency  string          `json:"currency"`
+	Reference string          `json:"reference"`
+	LimitIDs  []uuid.UUID     `json:"limit_ids"`
+	Amount    decimal.Decimal `json:"amount"`
+}
+
+// NewReservationHeld creates a new ReservationHeld event.
+func NewReservationHeld(
+	reservationID, tenantID uuid.UUID,
+	partyID *uuid.UUID,
+	category, currency, reference string,
+	limitIDs []uuid.UUID,
+	amount decimal.Decimal,
+	expiresAt time.Time,
+) ReservationHeld {
+	return ReservationHeld{
+		BaseEvent: events.NewBaseEvent("limits.reservation.held", reservationID.String(), AggregateTypeReservation, tenantID.String()),
+		PartyID:   partyID,
+		Category:  category,
+		Currency:  currency,
+		Reference: reference,
+		LimitIDs:  limitIDs,
+		Amount:    amount,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// ReservationSettled is emitted when a held reservation is committed,
+// released or expires, for which its event type is
+// limits.reservation.committed, limits.reservation.released or
+// limits.reservation.expired.
+type ReservationSettled struct {
+	events.BaseEvent
+	Reference string          `json:"reference"`
+	Currency  string          `json:"currency"`
+	Amount    decimal.Decimal `json:"amount"`
+}
+
+// NewReservationSettled creates a new ReservationSettled event for a
+// reservation's new status: COMMITTED, RELEASED or EXPIRED.
+func NewReservationSettled(reservationID, tenantID uuid.UUID, status, reference, currency string, amount decimal.Decimal) ReservationSettled {
+	eventType := "limits.reservation." + strings.ToLower(status)
+	return ReservationSettled{
+		BaseEvent: events.NewBaseEvent(eventType, reservationID.String(), AggregateTypeReservation, tenantID.String()),
+		Reference: reference,
+		Currency:  currency,
+		Amount:    amount,
+	}
+}
+
+// ExposureReduced is emitted when utilization of a limit and every limit
+// it rolls up into is reduced, such as when a loan is repaid.
+type ExposureReduced struct {
+	PartyID *uuid.UUID `json:"party_id,omitempty"`
+	events.BaseEvent
+	Category  string          `json:"category"`
+	Currency  string          `json:"currency"`
+	Reference string          `json:"reference"`
+	LimitIDs  []uuid.UUID     `json:"limit_ids"`
+	Amount    decimal.Decimal `json:"amount"`
+}
+
+// NewExposureReduced creates a new ExposureReduced event. It is raised on
+// the most specific limit reduced.
+func NewExposureReduced(
+	limitID, tenantID uuid.UUID,
+	partyID *uuid.UUID,
+	category, currency, reference string,
+	limitIDs []uuid.UUID,
+	amount decimal.Decimal,
+) ExposureReduced {
+	return ExposureReduced{
+		BaseEvent: events.NewBaseEvent("limits.exposure.reduced", limitID.String(), AggregateTypeLimit, tenantID.String()),
+		PartyID:   partyID,
+		Category:  category,
+		Currency:  currency,
+		Reference: reference,
+		LimitIDs:  limitIDs,
+		Amount:    amount,
+	}
+}
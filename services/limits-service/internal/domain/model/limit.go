@@ -0,0 +1,270 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/event"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/valueobject"
+)
+
+// MaxDepth bounds how many limits a chain from a limit up through the
+// limits it rolls up into may hold.
+const MaxDepth = 8
+
+// ErrLimitExceeded is returned when an amount does not fit within the
+// headroom of a limit or of a limit it rolls up into.
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// ErrInvalidChain is returned when limits passed as a chain are not a limit
+// followed by each limit it rolls up into, up to one that rolls up into
+// none.
+var ErrInvalidChain = errors.New("invalid limit chain")
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Limit is the aggregate root for a cap on a party's or a whole tenant's
+// exposure of one category in one currency. Its headroom is its amount less
+// what is utilized and what is reserved against it. A limit may roll up
+// into a parent whose headroom also bounds it: a party's card limit into
+// the party's total limit, for instance, and that into the tenant's.
+type Limit struct {
+	createdAt    time.Time
+	updatedAt    time.Time
+	partyID      *uuid.UUID
+	parentID     *uuid.UUID
+	amount       decimal.Decimal
+	utilized     decimal.Decimal
+	reserved     decimal.Decimal
+	currency     string
+	category     valueobject.LimitCategory
+	domainEvents []events.DomainEvent
+	version      int
+	id           uuid.UUID
+	tenantID     uuid.UUID
+}
+
+// NewLimit creates a limit on a party's exposure, or on the whole tenant's
+// when partyID is nil, that rolls up into parent when it is not nil. The
+// parent must be in the same tenant and currency, cover the limit's
+// category, and be tenant-wide or the same party's.
+func NewLimit(
+	tenantID uuid.UUID,
+	partyID *uuid.UUID,
+	category valueobject.LimitCategory,
+	currency string,
+	amount decimal.Decimal,
+	parent *Limit,
+	now time.Time,
+) (Limit, error) {
+	if tenantID == uuid.Nil {
+		return Limit{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	if partyID != nil && *partyID == uuid.Nil {
+		return Limit{}, fmt.Errorf("party ID must not be empty")
+	}
+	if category.IsZero() {
+		return Limit{}, fmt.Errorf("category must not be empty")
+	}
+	if !currencyPattern.MatchString(currency) {
+		return Limit{}, fmt.Errorf("currency must be a 3-letter ISO 4217 code, got %q", currency)
+	}
+	if amount.IsNegative() {
+		return Limit{}, fmt.Errorf("amount must not be negative")
+	}
+
+	l := Limit{
+		id:        uuid.New(),
+		tenantID:  tenantID,
+		partyID:   partyID,
+		category:  category,
+		currency:  currency,
+		amount:    amount,
+		utilized:  decimal.Zero,
+		reserved:  decimal.Zero,
+		version:   1,
+		createdAt: now,
+		updatedAt: now,
+	}
+	if parent != nil {
+		if err := l.checkParent(*parent); err != nil {
+			return Limit{}, err
+		}
+		parentID := parent.id
+		l.parentID = &parentID
+	}
+	l.domainEvents = append(l.domainEvents, event.NewLimitCreated(
+		l.id, tenantID, partyID, l.parentID, category.String(), currency, amount))
+	return l, nil
+}
+
+// ReconstructLimit recreates a Limit from persisted data without validation
+// or events.
+func ReconstructLimit(
+	id, tenantID uuid.UUID,
+	partyID, parentID *uuid.UUID,
+	category valueobject.LimitCategory,
+	currency string,
+	amount, utilized, reserved decimal.Decimal,
+	version int,
+	createdAt, updatedAt time.Time,
+) Limit {
+	return Limit{
+		id:        id,
+		tenantID:  tenantID,
+		partyID:   partyID,
+		parentID:  parentID,
+		category:  category,
+		currency:  currency,
+		amount:    amount,
+		utilized:  utilized,
+		reserved:  reserved,
+		version:   version,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (l Limit) checkParent(parent Limit) error {
+	switch {
+	case parent.tenantID != l.tenantID:
+		return fmt.Errorf("parent limit %s is in another tenant", parent.id)
+	case parent.currency != l.currency:
+		return fmt.Errorf("parent limit is in %s but the limit is in %s", parent.currency, l.currency)
+	case !parent.category.Covers(l.category):
+		return fmt.Errorf("a %s limit cannot roll up into a %s limit", l.category, parent.category)
+	case parent.partyID != nil && (l.partyID == nil || *parent.partyID != *l.partyID):
+		return fmt.Errorf("parent limit %s is another party's", parent.id)
+	}
+	return nil
+}
+
+// SetAmount changes the limit's amount. An amount below what is already
+// utilized and reserved leaves the limit without headroom until exposure
+// falls; nothing already reserved is taken back.
+func (l Limit) SetAmount(amount decimal.Decimal, now time.Time) (Limit, error) {
+	if amount.IsNegative() {
+		return l, fmt.Errorf("amount must not be negative")
+	}
+	previous := l.amount
+	l.amount = amount
+	l = l.touch(now)
+	l.domainEvents = append(l.domainEvents, event.NewLimitChanged(
+		l.id, l.tenantID, previous, amount, l.utilized, l.reserved))
+	return l, nil
+}
+
+// Available returns the limit's own headroom: its amount less what is
+// utilized and reserved. It is negative when the limit was lowered below
+// its exposure.
+func (l Limit) Available() decimal.Decimal {
+	return l.amount.Sub(l.utilized).Sub(l.reserved)
+}
+
+// Exposure returns what is utilized and reserved against the limit.
+func (l Limit) Exposure() decimal.Decimal {
+	return l.utilized.Add(l.reserved)
+}
+
+func (l Limit) touch(now time.Time) Limit {
+	l.version++
+	l.updatedAt = now
+	return l
+}
+
+// Headroom returns the least headroom along a chain of limits and the limit
+// it belongs to, the one binding an amount reserved against the chain. Of
+// limits with the same headroom, the most specific binds.
+func Headroom(chain []Limit) (decimal.Decimal, Limit) {
+	binding := chain[0]
+	for _, l := range chain[1:] {
+		if l.Available().LessThan(binding.Available()) {
+			binding = l
+		}
+	}
+	return binding.Available(), binding
+}
+
+// CheckChain returns an error unless chain is a limit followed by each
+// limit it rolls up into, up to one that rolls up into none.
+func CheckChain(chain []Limit) error {
+	if len(chain) == 0 || len(chain) > MaxDepth {
+		return fmt.Errorf("%w: a chain holds 1 to %d limits", ErrInvalidChain, MaxDepth)
+	}
+	for i, l := range chain {
+		last := i == len(chain)-1
+		switch {
+		case last && l.parentID != nil:
+			return fmt.Errorf("%w: limit %s rolls up into %s", ErrInvalidChain, l.id, *l.parentID)
+		case !last && (l.parentID == nil || *l.parentID != chain[i+1].id):
+			return fmt.Errorf("%w: limit %s does not roll up into %s", ErrInvalidChain, l.id, chain[i+1].id)
+		}
+	}
+	return nil
+}
+
+// ReduceExposure reduces what is utilized against each limit of a chain by
+// amount, such as when a loan is repaid, and returns the changed limits.
+// Utilization does not fall below zero. The event is raised on the first
+// limit.
+func ReduceExposure(chain []Limit, reference string, amount decimal.Decimal, now time.Time) ([]Limit, error) {
+	if err := CheckChain(chain); err != nil {
+		return nil, err
+	}
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+	if err := checkReference(reference); err != nil {
+		return nil, err
+	}
+	changed := make([]Limit, len(chain))
+	for i, l := range chain {
+		l.utilized = decimal.Max(l.utilized.Sub(amount), decimal.Zero)
+		changed[i] = l.touch(now)
+	}
+	leaf := changed[0]
+	changed[0].domainEvents = append(changed[0].domainEvents, event.NewExposureReduced(
+		leaf.id, leaf.tenantID, leaf.partyID, leaf.category.String(), leaf.currency, reference,
+		limitIDs(chain), amount))
+	return changed, nil
+}
+
+func limitIDs(chain []Limit) []uuid.UUID {
+	ids := make([]uuid.UUID, len(chain))
+	for i, l := range chain {
+		ids[i] = l.id
+	}
+	return ids
+}
+
+// --- Accessors ---
+
+func (l Limit) ID() uuid.UUID                       { return l.id }
+func (l Limit) TenantID() uuid.UUID                 { return l.tenantID }
+func (l Limit) PartyID() *uuid.UUID                 { return l.partyID }
+func (l Limit) ParentID() *uuid.UUID                { return l.parentID }
+func (l Limit) Category() valueobject.LimitCategory { return l.category }
+func (l Limit) Currency() string                    { return l.currency }
+func (l Limit) Amount() decimal.Decimal             { return l.amount }
+func (l Limit) Utilized() decimal.Decimal           { return l.utilized }
+func (l Limit) Reserved() decimal.Decimal           { return l.reserved }
+func (l Limit) Version() int                        { return l.version }
+func (l Limit) CreatedAt() time.Time                { return l.createdAt }
+func (l Limit) UpdatedAt() time.Time                { return l.updatedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (l Limit) DomainEvents() []events.DomainEvent {
+	return l.domainEvents
+}
+
+// ClearDomainEvents returns a copy with cleared domain events.
+func (l Limit) ClearDomainEvents() Limit {
+	l.domainEvents = nil
+	return l
+}
@@ -0,0 +1,108 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/limits-service/internal/domain/model"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/valueobject"
+)
+
+var now = time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+func d(v int64) decimal.Decimal { return decimal.NewFromInt(v) }
+
+// partyChain returns a party's card limit of 1000 rolling up into the
+// party's total limit of 1500, and that into the tenant's of 10000.
+func partyChain(t *testing.T) []model.Limit {
+	t.Helper()
+	tenantID, partyID := uuid.New(), uuid.New()
+	tenant, err := model.NewLimit(tenantID, nil, valueobject.LimitCategoryTotal, "EUR", d(10000), nil, now)
+	require.NoError(t, err)
+	total, err := model.NewLimit(tenantID, &partyID, valueobject.LimitCategoryTotal, "EUR", d(1500), &tenant, now)
+	require.NoError(t, err)
+	cards, err := model.NewLimit(tenantID, &partyID, valueobject.LimitCategoryCards, "EUR", d(1000), &total, now)
+	require.NoError(t, err)
+	return []model.Limit{cards.ClearDomainEvents(), total.ClearDomainEvents(), tenant.ClearDomainEvents()}
+}
+
+func TestNewLimit_Hierarchy(t *testing.T) {
+	tenantID, partyID, otherParty := uuid.New(), uuid.New(), uuid.New()
+	tenant, err := model.NewLimit(tenantID, nil, valueobject.LimitCategoryTotal, "EUR", d(10000), nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, tenant.Version())
+	assert.Nil(t, tenant.ParentID())
+	assert.True(t, d(10000).Equal(tenant.Available()))
+	require.Len(t, tenant.DomainEvents(), 1)
+	assert.Equal(t, "limits.limit.created", tenant.DomainEvents()[0].EventType())
+
+	payments, err := model.NewLimit(tenantID, &partyID, valueobject.LimitCategoryPayments, "EUR", d(500), &tenant, now)
+	require.NoError(t, err)
+	require.NotNil(t, payments.ParentID())
+	assert.Equal(t, tenant.ID(), *payments.ParentID())
+	require.NoError(t, model.CheckChain([]model.Limit{payments, tenant}))
+	assert.ErrorIs(t, model.CheckChain([]model.Limit{payments}), model.ErrInvalidChain, "the chain stops short")
+	assert.ErrorIs(t, model.CheckChain([]model.Limit{tenant, payments}), model.ErrInvalidChain, "the chain is reversed")
+
+	_, err = model.NewLimit(tenantID, &partyID, valueobject.LimitCategoryCards, "EUR", d(100), &payments, now)
+	assert.Error(t, err, "a card limit cannot roll up into a payments limit")
+	_, err = model.NewLimit(tenantID, &otherParty, valueobject.LimitCategoryPayments, "EUR", d(100), &payments, now)
+	assert.Error(t, err, "another party's parent")
+	_, err = model.NewLimit(tenantID, nil, valueobject.LimitCategoryPayments, "EUR", d(100), &payments, now)
+	assert.Error(t, err, "a tenant-wide limit cannot roll up into a party's")
+	_, err = model.NewLimit(tenantID, &partyID, valueobject.LimitCategoryPayments, "USD", d(100), &tenant, now)
+	assert.Error(t, err, "another currency")
+	_, err = model.NewLimit(uuid.New(), nil, valueobject.LimitCategoryPayments, "EUR", d(100), &tenant, now)
+	assert.Error(t, err, "another tenant")
+	_, err = model.NewLimit(tenantID, nil, valueobject.LimitCategoryPayments, "EUR", d(-1), nil, now)
+	assert.Error(t, err, "negative amount")
+	_, err = model.NewLimit(tenantID, nil, valueobject.LimitCategoryPayments, "eur", d(100), nil, now)
+	assert.Error(t, err, "invalid currency")
+}
+
+func TestLimit_SetAmountBelowExposure(t *testing.T) {
+	chain := partyChain(t)
+	_, chain, err := model.Reserve(chain, chain[0].PartyID(), valueobject.LimitCategoryCards, "auth-1", d(800), time.Minute, now)
+	require.NoError(t, err)
+
+	cards, err := chain[0].SetAmount(d(600), now)
+	require.NoError(t, err)
+	assert.True(t, d(-200).Equal(cards.Available()), "lowering a limit takes nothing back")
+	assert.True(t, d(800).Equal(cards.Exposure()))
+	assert.Equal(t, 3, cards.Version())
+	assert.Equal(t, "limits.limit.changed", cards.DomainEvents()[0].EventType())
+
+	_, err = cards.SetAmount(d(-1), now)
+	assert.Error(t, err)
+}
+
+func TestReduceExposure(t *testing.T) {
+	chain := partyChain(t)
+	r, chain, err := model.Reserve(chain, chain[0].PartyID(), valueobject.LimitCategoryCards, "auth-1", d(300), time.Minute, now)
+	require.NoError(t, err)
+	_, chain, err = r.Commit(chain, now)
+	require.NoError(t, err)
+
+	chain, err = model.ReduceExposure(chain, "repayment-1", d(100), now)
+	require.NoError(t, err)
+	for _, l := range chain {
+		assert.True(t, d(200).Equal(l.Utilized()), l.Category().String())
+	}
+	require.Len(t, chain[0].DomainEvents(), 1)
+	assert.Equal(t, "limits.exposure.reduced", chain[0].DomainEvents()[0].EventType())
+	assert.Empty(t, chain[1].DomainEvents(), "the event is raised on the first limit only")
+
+	chain, err = model.ReduceExposure(chain, "repayment-2", d(500), now)
+	require.NoError(t, err)
+	assert.True(t, chain[0].Utilized().IsZero(), "utilization does not fall below zero")
+
+	_, err = model.ReduceExposure(chain, "repayment-3", d(0), now)
+	assert.Error(t, err)
+	_, err = model.ReduceExposure(chain[:2], "repayment-3", d(10), now)
+	assert.ErrorIs(t, err, model.ErrInvalidChain)
+}
@@ -0,0 +1,223 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/event"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/valueobject"
+)
+
+// ErrInvalidTransition is returned when a reservation cannot move to the
+// requested status.
+var ErrInvalidTransition = errors.New("invalid reservation transition")
+
+const maxReferenceLength = 100
+
+// Reservation is the aggregate root for an amount set aside on a chain of
+// limits before the exposure it stands for is committed: a payment before
+// it is submitted, say, or a card authorisation before it is cleared. It
+// holds its amount until it is committed, which turns the amount into
+// utilization, or released or expired, which gives it back. Its reference
+// is the caller's, and is unique within a tenant.
+type Reservation struct {
+	expiresAt    time.Time
+	createdAt    time.Time
+	updatedAt    time.Time
+	partyID      *uuid.UUID
+	amount       decimal.Decimal
+	category     valueobject.LimitCategory
+	status       valueobject.ReservationStatus
+	currency     string
+	reference    string
+	limitIDs     []uuid.UUID
+	domainEvents []events.DomainEvent
+	version      int
+	id           uuid.UUID
+	tenantID     uuid.UUID
+}
+
+// Reserve sets amount aside on each limit of a chain for exposure of a
+// category on behalf of a party, or of the tenant itself when partyID is
+// nil, and returns the held reservation and the changed limits. It fails
+// with ErrLimitExceeded unless every limit has the headroom.
+func Reserve(
+	chain []Limit,
+	partyID *uuid.UUID,
+	category valueobject.LimitCategory,
+	reference string,
+	amount decimal.Decimal,
+	ttl time.Duration,
+	now time.Time,
+) (Reservation, []Limit, error) {
+	if err := CheckChain(chain); err != nil {
+		return Reservation{}, nil, err
+	}
+	if !chain[0].category.Covers(category) {
+		return Reservation{}, nil, fmt.Errorf("a %s limit does not cap %s exposure", chain[0].category, category)
+	}
+	if !amount.IsPositive() {
+		return Reservation{}, nil, fmt.Errorf("amount must be positive")
+	}
+	if err := checkReference(reference); err != nil {
+		return Reservation{}, nil, err
+	}
+	if ttl <= 0 {
+		return Reservation{}, nil, fmt.Errorf("time to live must be positive")
+	}
+	if headroom, binding := Headroom(chain); amount.GreaterThan(headroom) {
+		return Reservation{}, nil, fmt.Errorf("%w: %s %s requested but limit %s has %s available",
+			ErrLimitExceeded, amount, binding.currency, binding.id, headroom)
+	}
+
+	changed := make([]Limit, len(chain))
+	for i, l := range chain {
+		l.reserved = l.reserved.Add(amount)
+		changed[i] = l.touch(now)
+	}
+	r := Reservation{
+		id:        uuid.New(),
+		tenantID:  chain[0].tenantID,
+		partyID:   partyID,
+		category:  category,
+		currency:  chain[0].currency,
+		reference: reference,
+		amount:    amount,
+		limitIDs:  limitIDs(chain),
+		status:    valueobject.ReservationStatusHeld,
+		expiresAt: now.Add(ttl),
+		version:   1,
+		createdAt: now,
+		updatedAt: now,
+	}
+	r.domainEvents = append(r.domainEvents, event.NewReservationHeld(
+		r.id, r.tenantID, partyID, category.String(), r.currency, reference, r.limitIDs, amount, r.expiresAt))
+	return r, changed, nil
+}
+
+// ReconstructReservation recreates a Reservation from persisted data
+// without validation or events.
+func ReconstructReservation(
+	id, tenantID uuid.UUID,
+	partyID *uuid.UUID,
+	category valueobject.LimitCategory,
+	currency, reference string,
+	amount decimal.Decimal,
+	limitIDs []uuid.UUID,
+	status valueobject.ReservationStatus,
+	expiresAt time.Time,
+	version int,
+	createdAt, updatedAt time.Time,
+) Reservation {
+	return Reservation{
+		id:        id,
+		tenantID:  tenantID,
+		partyID:   partyID,
+		category:  category,
+		currency:  currency,
+		reference: reference,
+		amount:    amount,
+		limitIDs:  limitIDs,
+		status:    status,
+		expiresAt: expiresAt,
+		version:   version,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// Commit turns the held amount into utilization of the reservation's
+// limits, given as the chain it was reserved against.
+func (r Reservation) Commit(chain []Limit, now time.Time) (Reservation, []Limit, error) {
+	return r.settle(valueobject.ReservationStatusCommitted, chain, now)
+}
+
+// Release gives the held amount back to the reservation's limits.
+func (r Reservation) Release(chain []Limit, now time.Time) (Reservation, []Limit, error) {
+	return r.settle(valueobject.ReservationStatusReleased, chain, now)
+}
+
+// Expire gives the held amount back to the reservation's limits once the
+// reservation has been held past its expiry.
+func (r Reservation) Expire(chain []Limit, now time.Time) (Reservation, []Limit, error) {
+	if !r.IsExpired(now) {
+		return r, nil, fmt.Errorf("%w: reservation is held until %s", ErrInvalidTransition, r.expiresAt.Format(time.RFC3339))
+	}
+	return r.settle(valueobject.ReservationStatusExpired, chain, now)
+}
+
+// IsExpired returns true if the reservation is held past its expiry.
+func (r Reservation) IsExpired(now time.Time) bool {
+	return r.status == valueobject.ReservationStatusHeld && !now.Before(r.expiresAt)
+}
+
+func (r Reservation) settle(status valueobject.ReservationStatus, chain []Limit, now time.Time) (Reservation, []Limit, error) {
+	if r.status.IsFinal() {
+		return r, nil, fmt.Errorf("%w: reservation is %s", ErrInvalidTransition, r.status)
+	}
+	if len(chain) != len(r.limitIDs) {
+		return r, nil, fmt.Errorf("%w: the reservation holds %d limits", ErrInvalidChain, len(r.limitIDs))
+	}
+	for i, l := range chain {
+		if l.id != r.limitIDs[i] {
+			return r, nil, fmt.Errorf("%w: limit %s is not held by the reservation", ErrInvalidChain, l.id)
+		}
+	}
+
+	changed := make([]Limit, len(chain))
+	for i, l := range chain {
+		// A limit's reserved amount is the sum of its held reservations, so
+		// it never falls below the amount of one of them.
+		l.reserved = l.reserved.Sub(r.amount)
+		if status == valueobject.ReservationStatusCommitted {
+			l.utilized = l.utilized.Add(r.amount)
+		}
+		changed[i] = l.touch(now)
+	}
+	r.status = status
+	r.version++
+	r.updatedAt = now
+	r.domainEvents = append(r.domainEvents, event.NewReservationSettled(
+		r.id, r.tenantID, status.String(), r.reference, r.currency, r.amount))
+	return r, changed, nil
+}
+
+func checkReference(reference string) error {
+	if strings.TrimSpace(reference) == "" || len(reference) > maxReferenceLength {
+		return fmt.Errorf("reference must be 1 to %d characters", maxReferenceLength)
+	}
+	return nil
+}
+
+// --- Accessors ---
+
+func (r Reservation) ID() uuid.UUID                         { return r.id }
+func (r Reservation) TenantID() uuid.UUID                   { return r.tenantID }
+func (r Reservation) PartyID() *uuid.UUID                   { return r.partyID }
+func (r Reservation) Category() valueobject.LimitCategory   { return r.category }
+func (r Reservation) Currency() string                      { return r.currency }
+func (r Reservation) Reference() string                     { return r.reference }
+func (r Reservation) Amount() decimal.Decimal               { return r.amount }
+func (r Reservation) LimitIDs() []uuid.UUID                 { return r.limitIDs }
+func (r Reservation) Status() valueobject.ReservationStatus { return r.status }
+func (r Reservation) ExpiresAt() time.Time                  { return r.expiresAt }
+func (r Reservation) Version() int                          { return r.version }
+func (r Reservation) CreatedAt() time.Time                  { return r.createdAt }
+func (r Reservation) UpdatedAt() time.Time                  { return r.updatedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (r Reservation) DomainEvents() []events.DomainEvent {
+	return r.domainEvents
+}
+
+// ClearDomainEvents returns a copy with cleared domain events.
+func (r Reservation) ClearDomainEvents() Reservation {
+	r.domainEvents = nil
+	return r
+}
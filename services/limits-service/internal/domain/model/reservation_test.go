@@ -0,0 +1,79 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/limits-service/internal/domain/model"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/valueobject"
+)
+
+func TestReserve_BoundByEveryLimitInTheChain(t *testing.T) {
+	chain := partyChain(t)
+	partyID := chain[0].PartyID()
+
+	_, _, err := model.Reserve(chain, partyID, valueobject.LimitCategoryCards, "auth-1", d(1001), time.Minute, now)
+	assert.ErrorIs(t, err, model.ErrLimitExceeded, "above the card limit")
+	_, _, err = model.Reserve(chain, partyID, valueobject.LimitCategoryPayments, "auth-1", d(10), time.Minute, now)
+	assert.Error(t, err, "a card limit does not cap payments")
+	_, _, err = model.Reserve(chain[1:], partyID, valueobject.LimitCategoryCards, "", d(10), time.Minute, now)
+	assert.Error(t, err, "no reference")
+
+	r, chain, err := model.Reserve(chain, partyID, valueobject.LimitCategoryCards, "auth-1", d(900), time.Minute, now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.ReservationStatusHeld, r.Status())
+	assert.Equal(t, now.Add(time.Minute), r.ExpiresAt())
+	assert.Equal(t, []uuid.UUID{chain[0].ID(), chain[1].ID(), chain[2].ID()}, r.LimitIDs())
+	assert.Equal(t, "limits.reservation.held", r.DomainEvents()[0].EventType())
+	for _, l := range chain {
+		assert.True(t, d(900).Equal(l.Reserved()), l.Category().String())
+		assert.Equal(t, 2, l.Version())
+	}
+
+	// The party's total limit of 1500 now binds a reservation against its
+	// own headroom of 600.
+	headroom, binding := model.Headroom(chain[1:])
+	assert.True(t, d(600).Equal(headroom))
+	assert.Equal(t, chain[1].ID(), binding.ID())
+	_, _, err = model.Reserve(chain[1:], partyID, valueobject.LimitCategoryLending, "loan-1", d(601), time.Minute, now)
+	assert.ErrorIs(t, err, model.ErrLimitExceeded)
+	_, _, err = model.Reserve(chain[1:], partyID, valueobject.LimitCategoryLending, "loan-1", d(600), time.Minute, now)
+	assert.NoError(t, err)
+}
+
+func TestReservation_Settle(t *testing.T) {
+	chain := partyChain(t)
+	partyID := chain[0].PartyID()
+	r, chain, err := model.Reserve(chain, partyID, valueobject.LimitCategoryCards, "auth-1", d(400), time.Minute, now)
+	require.NoError(t, err)
+
+	_, _, err = r.Commit(chain[1:], now)
+	assert.ErrorIs(t, err, model.ErrInvalidChain, "the reservation holds three limits")
+	_, _, err = r.Expire(chain, now.Add(time.Second))
+	assert.ErrorIs(t, err, model.ErrInvalidTransition, "not yet expired")
+
+	committed, afterCommit, err := r.Commit(chain, now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.ReservationStatusCommitted, committed.Status())
+	assert.Equal(t, 2, committed.Version())
+	assert.Equal(t, "limits.reservation.committed", committed.DomainEvents()[1].EventType())
+	for _, l := range afterCommit {
+		assert.True(t, l.Reserved().IsZero())
+		assert.True(t, d(400).Equal(l.Utilized()))
+	}
+	_, _, err = committed.Release(afterCommit, now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition, "committed reservations are final")
+
+	assert.True(t, r.IsExpired(now.Add(time.Minute)))
+	expired, afterExpiry, err := r.Expire(chain, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.ReservationStatusExpired, expired.Status())
+	assert.Equal(t, "limits.reservation.expired", expired.DomainEvents()[1].EventType())
+	assert.True(t, afterExpiry[0].Reserved().IsZero())
+	assert.True(t, afterExpiry[0].Utilized().IsZero())
+	assert.True(t, d(1000).Equal(afterExpiry[0].Available()))
+}
@@ -0,0 +1,106 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/limits-service/internal/domain/event"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/model"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/valueobject"
+)
+
+// ErrLimitNotFound is returned when a limit does not exist.
+var ErrLimitNotFound = errors.New("limit not found")
+
+// ErrLimitExists is returned when a limit is set on the same party, or on
+// the whole tenant, for a category and currency already capped.
+var ErrLimitExists = errors.New("limit already exists")
+
+// ErrReservationNotFound is returned when a reservation does not exist.
+var ErrReservationNotFound = errors.New("reservation not found")
+
+// ErrReservationExists is returned when a reservation is saved with a
+// reference its tenant already used.
+var ErrReservationExists = errors.New("reservation already exists")
+
+// ErrVersionConflict is returned when a limit or a reservation was modified
+// concurrently.
+var ErrVersionConflict = errors.New("version conflict")
+
+// LimitScope identifies the limit set on a party, or on the whole tenant
+// when PartyID is nil, for a category and currency.
+type LimitScope struct {
+	PartyID  *uuid.UUID
+	Category valueobject.LimitCategory
+	Currency string
+	TenantID uuid.UUID
+}
+
+// LimitFilter narrows a listing of a tenant's limits. Zero fields do not
+// filter.
+type LimitFilter struct {
+	PartyID  *uuid.UUID
+	Currency string
+	TenantID uuid.UUID
+	// TenantWide lists only the limits set on the whole tenant.
+	TenantWide bool
+}
+
+// LimitRepository defines the persistence port for limits.
+type LimitRepository interface {
+	// Save persists limits in one transaction with optimistic locking on
+	// each of their versions.
+	Save(ctx context.Context, limits ...model.Limit) error
+
+	// FindByID retrieves a tenant's limit.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Limit, error)
+
+	// FindByScope retrieves the limit set for a scope, returning
+	// ErrLimitNotFound when there is none.
+	FindByScope(ctx context.Context, scope LimitScope) (model.Limit, error)
+
+	// List returns a page of the limits matching the filter, tenant-wide
+	// limits first and then by party, currency and category, and the total
+	// number of them.
+	List(ctx context.Context, filter LimitFilter, limit, offset int) ([]model.Limit, int, error)
+}
+
+// ReservationFilter narrows a listing of a tenant's reservations. Zero
+// fields do not filter.
+type ReservationFilter struct {
+	PartyID  *uuid.UUID
+	Status   valueobject.ReservationStatus
+	TenantID uuid.UUID
+}
+
+// ReservationRepository defines the persistence port for reservations.
+type ReservationRepository interface {
+	// Save persists a reservation together with the limits whose usage it
+	// changed, in one transaction with optimistic locking on all their
+	// versions.
+	Save(ctx context.Context, reservation model.Reservation, limits ...model.Limit) error
+
+	// FindByID retrieves a tenant's reservation.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Reservation, error)
+
+	// FindByReference retrieves the reservation a tenant made with a
+	// reference.
+	FindByReference(ctx context.Context, tenantID uuid.UUID, reference string) (model.Reservation, error)
+
+	// List returns a page of the reservations matching the filter, newest
+	// first, and the total number of them.
+	List(ctx context.Context, filter ReservationFilter, limit, offset int) ([]model.Reservation, int, error)
+
+	// ListExpired returns up to limit reservations of any tenant held past
+	// their expiry at now, earliest expiry first.
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]model.Reservation, error)
+}
+
+// EventPublisher defines the port for publishing domain events.
+type EventPublisher interface {
+	// Publish sends domain events to the event bus.
+	Publish(ctx context.Context, events []event.DomainEvent) error
+}
@@ -0,0 +1,70 @@
+package valueobject
+
+import "fmt"
+
+// LimitCategory is the kind of exposure a limit caps.
+// It is an immutable value object.
+type LimitCategory struct {
+	value string
+}
+
+const (
+	limitCategoryPayments  = "PAYMENTS"
+	limitCategoryCards     = "CARDS"
+	limitCategoryOverdraft = "OVERDRAFT"
+	limitCategoryLending   = "LENDING"
+	limitCategoryTotal     = "TOTAL"
+)
+
+var (
+	// LimitCategoryPayments caps outgoing payments.
+	LimitCategoryPayments = LimitCategory{value: limitCategoryPayments}
+	// LimitCategoryCards caps card authorisations.
+	LimitCategoryCards = LimitCategory{value: limitCategoryCards}
+	// LimitCategoryOverdraft caps overdrawn account balances.
+	LimitCategoryOverdraft = LimitCategory{value: limitCategoryOverdraft}
+	// LimitCategoryLending caps outstanding loan principal.
+	LimitCategoryLending = LimitCategory{value: limitCategoryLending}
+	// LimitCategoryTotal caps exposure of every category. It is the only
+	// category a limit of another category may roll up into.
+	LimitCategoryTotal = LimitCategory{value: limitCategoryTotal}
+)
+
+var validLimitCategories = map[string]LimitCategory{
+	limitCategoryPayments:  LimitCategoryPayments,
+	limitCategoryCards:     LimitCategoryCards,
+	limitCategoryOverdraft: LimitCategoryOverdraft,
+	limitCategoryLending:   LimitCategoryLending,
+	limitCategoryTotal:     LimitCategoryTotal,
+}
+
+// NewLimitCategory creates a LimitCategory from a string, validating it is
+// known.
+func NewLimitCategory(s string) (LimitCategory, error) {
+	v, ok := validLimitCategories[s]
+	if !ok {
+		return LimitCategory{}, fmt.Errorf("invalid limit category: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the LimitCategory.
+func (c LimitCategory) String() string {
+	return c.value
+}
+
+// IsZero returns true if the LimitCategory has not been set.
+func (c LimitCategory) IsZero() bool {
+	return c.value == ""
+}
+
+// Equal returns true if two LimitCategory values are equal.
+func (c LimitCategory) Equal(other LimitCategory) bool {
+	return c.value == other.value
+}
+
+// Covers returns true if a limit of this category caps exposure of the
+// other category.
+func (c LimitCategory) Covers(other LimitCategory) bool {
+	return c == other || c == LimitCategoryTotal
+}
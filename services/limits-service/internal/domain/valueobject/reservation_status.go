@@ -0,0 +1,67 @@
+package valueobject
+
+import "fmt"
+
+// ReservationStatus is where a reservation against a limit stands.
+// It is an immutable value object.
+type ReservationStatus struct {
+	value string
+}
+
+const (
+	reservationStatusHeld      = "HELD"
+	reservationStatusCommitted = "COMMITTED"
+	reservationStatusReleased  = "RELEASED"
+	reservationStatusExpired   = "EXPIRED"
+)
+
+var (
+	// ReservationStatusHeld is a reservation whose amount is set aside on
+	// its limits until it is committed, released or expires.
+	ReservationStatusHeld = ReservationStatus{value: reservationStatusHeld}
+	// ReservationStatusCommitted is a reservation whose amount became
+	// utilization of its limits.
+	ReservationStatusCommitted = ReservationStatus{value: reservationStatusCommitted}
+	// ReservationStatusReleased is a reservation its caller gave back.
+	ReservationStatusReleased = ReservationStatus{value: reservationStatusReleased}
+	// ReservationStatusExpired is a reservation given back because it was
+	// held too long.
+	ReservationStatusExpired = ReservationStatus{value: reservationStatusExpired}
+)
+
+var validReservationStatuses = map[string]ReservationStatus{
+	reservationStatusHeld:      ReservationStatusHeld,
+	reservationStatusCommitted: ReservationStatusCommitted,
+	reservationStatusReleased:  ReservationStatusReleased,
+	reservationStatusExpired:   ReservationStatusExpired,
+}
+
+// NewReservationStatus creates a ReservationStatus from a string,
+// validating it is known.
+func NewReservationStatus(s string) (ReservationStatus, error) {
+	v, ok := validReservationStatuses[s]
+	if !ok {
+		return ReservationStatus{}, fmt.Errorf("invalid reservation status: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the ReservationStatus.
+func (s ReservationStatus) String() string {
+	return s.value
+}
+
+// IsZero returns true if the ReservationStatus has not been set.
+func (s ReservationStatus) IsZero() bool {
+	return s.value == ""
+}
+
+// Equal returns true if two ReservationStatus values are equal.
+func (s ReservationStatus) Equal(other ReservationStatus) bool {
+	return s.value == other.value
+}
+
+// IsFinal returns true if the reservation no longer holds any amount.
+func (s ReservationStatus) IsFinal() bool {
+	return s != ReservationStatusHeld
+}
@@ -0,0 +1,183 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/limits-service/internal/domain/model"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/port"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/valueobject"
+)
+
+// uniqueViolation is the PostgreSQL error code for a unique constraint
+// violation.
+const uniqueViolation = "23505"
+
+const limitColumns = `
+	id, tenant_id, party_id, parent_id, category, currency, amount, utilized, reserved,
+	version, created_at, updated_at`
+
+// LimitRepo is the PostgreSQL implementation of LimitRepository.
+type LimitRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewLimitRepo creates a new LimitRepo.
+func NewLimitRepo(pool *pgxpool.Pool) *LimitRepo {
+	return &LimitRepo{pool: pool}
+}
+
+// Save persists limits in one transaction with optimistic locking on each
+// of their versions.
+func (r *LimitRepo) Save(ctx context.Context, limits ...model.Limit) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	if err := saveLimits(ctx, tx, limits); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// saveLimits upserts limits within tx, failing on the first whose version
+// was changed concurrently.
+func saveLimits(ctx context.Context, tx pgx.Tx, limits []model.Limit) error {
+	query := `
+		INSERT INTO limits (` + limitColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (id) DO UPDATE SET
+			amount = EXCLUDED.amount,
+			utilized = EXCLUDED.utilized,
+			reserved = EXCLUDED.reserved,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE limits.version = EXCLUDED.version - 1
+	`
+
+	for _, l := range limits {
+		result, err := tx.Exec(ctx, query,
+			l.ID(), l.TenantID(), l.PartyID(), l.ParentID(), l.Category().String(), l.Currency(),
+			l.Amount(), l.Utilized(), l.Reserved(), l.Version(), l.CreatedAt(), l.UpdatedAt(),
+		)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return fmt.Errorf("%w: %s %s", port.ErrLimitExists, l.Category(), l.Currency())
+		}
+		if err != nil {
+			return fmt.Errorf("failed to upsert limit: %w", err)
+		}
+		if result.RowsAffected() == 0 {
+			return fmt.Errorf("%w: limit %s", port.ErrVersionConflict, l.ID())
+		}
+	}
+	return nil
+}
+
+// FindByID retrieves a tenant's limit.
+func (r *LimitRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Limit, error) {
+	return r.findOne(ctx, `SELECT `+limitColumns+` FROM limits WHERE id = $1 AND tenant_id = $2`, id, tenantID)
+}
+
+// FindByScope retrieves the limit set for a scope.
+func (r *LimitRepo) FindByScope(ctx context.Context, scope port.LimitScope) (model.Limit, error) {
+	query := `SELECT ` + limitColumns + ` FROM limits
+		WHERE tenant_id = $1 AND party_id IS NOT DISTINCT FROM $2::uuid AND category = $3 AND currency = $4`
+	return r.findOne(ctx, query, scope.TenantID, scope.PartyID, scope.Category.String(), scope.Currency)
+}
+
+// List returns a page of the limits matching the filter, tenant-wide
+// limits first and then by party, currency and category, and the total
+// number of them.
+func (r *LimitRepo) List(ctx context.Context, filter port.LimitFilter, limit, offset int) ([]model.Limit, int, error) {
+	where := `WHERE tenant_id = $1
+		AND ($2::uuid IS NULL OR party_id = $2)
+		AND ($3 = '' OR currency = $3)
+		AND (NOT $4 OR party_id IS NULL)`
+	args := []any{filter.TenantID, filter.PartyID, filter.Currency, filter.TenantWide}
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM limits `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count limits: %w", err)
+	}
+	limits, err := r.query(ctx, `SELECT `+limitColumns+` FROM limits `+where+`
+		ORDER BY party_id NULLS FIRST, currency, category, id
+		LIMIT $5 OFFSET $6
+	`, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return limits, total, nil
+}
+
+func (r *LimitRepo) findOne(ctx context.Context, query string, args ...any) (model.Limit, error) {
+	l, err := scanLimit(r.pool.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Limit{}, port.ErrLimitNotFound
+	}
+	if err != nil {
+		return model.Limit{}, fmt.Errorf("failed to scan limit: %w", err)
+	}
+	return l, nil
+}
+
+func (r *LimitRepo) query(ctx context.Context, query string, args ...any) ([]model.Limit, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query limits: %w", err)
+	}
+	defer rows.Close()
+
+	var limits []model.Limit
+	for rows.Next() {
+		l, err := scanLimit(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan limit row: %w", err)
+		}
+		limits = append(limits, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return limits, nil
+}
+
+func scanLimit(row pgx.Row) (model.Limit, error) {
+	var (
+		id, tenantID               uuid.UUID
+		partyID, parentID          *uuid.UUID
+		categoryStr, currency      string
+		amount, utilized, reserved decimal.Decimal
+		version                    int
+		createdAt, updatedAt       time.Time
+	)
+
+	err := row.Scan(&id, &tenantID, &partyID, &parentID, &categoryStr, &currency,
+		&amount, &utilized, &reserved, &version, &createdAt, &updatedAt)
+	if err != nil {
+		return model.Limit{}, err
+	}
+
+	category, err := valueobject.NewLimitCategory(categoryStr)
+	if err != nil {
+		return model.Limit{}, fmt.Errorf("invalid limit category in database: %w", err)
+	}
+
+	return model.ReconstructLimit(
+		id, tenantID, partyID, parentID, category, currency,
+		amount, utilized, reserved, version, createdAt, updatedAt,
+	), nil
+}
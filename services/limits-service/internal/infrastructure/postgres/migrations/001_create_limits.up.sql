@@ -0,0 +1,22 @@
+-- Caps on a party's exposure, or on a whole tenant's when party_id is
+-- NULL, of one category in one currency. A limit with a parent is also
+-- bound by the parent's headroom.
+CREATE TABLE IF NOT EXISTS limits (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    party_id UUID,
+    parent_id UUID REFERENCES limits (id),
+    category VARCHAR(20) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    amount NUMERIC(20, 4) NOT NULL,
+    utilized NUMERIC(20, 4) NOT NULL DEFAULT 0,
+    reserved NUMERIC(20, 4) NOT NULL DEFAULT 0,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- One limit per party, or per tenant, category and currency.
+CREATE UNIQUE INDEX uq_limits_scope ON limits (
+    tenant_id, COALESCE(party_id, '00000000-0000-0000-0000-000000000000'), category, currency
+);
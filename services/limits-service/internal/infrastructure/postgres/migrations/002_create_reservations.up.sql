@@ -0,0 +1,21 @@
+-- Amounts set aside on a chain of limits, listed in limit_ids from the
+-- most specific up, until they are committed, released or expire.
+CREATE TABLE IF NOT EXISTS reservations (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    party_id UUID,
+    category VARCHAR(20) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    reference VARCHAR(100) NOT NULL,
+    amount NUMERIC(20, 4) NOT NULL,
+    limit_ids UUID[] NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, reference)
+);
+
+CREATE INDEX idx_reservations_tenant ON reservations (tenant_id, created_at DESC);
+CREATE INDEX idx_reservations_held ON reservations (expires_at) WHERE status = 'HELD';
@@ -0,0 +1,186 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/limits-service/internal/domain/model"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/port"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/valueobject"
+)
+
+const reservationColumns = `
+	id, tenant_id, party_id, category, currency, reference, amount, limit_ids, status,
+	expires_at, version, created_at, updated_at`
+
+// ReservationRepo is the PostgreSQL implementation of
+// ReservationRepository.
+type ReservationRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewReservationRepo creates a new ReservationRepo.
+func NewReservationRepo(pool *pgxpool.Pool) *ReservationRepo {
+	return &ReservationRepo{pool: pool}
+}
+
+// Save persists the reservation together with the limits whose usage it
+// changed, in one transaction with optimistic locking on all their
+// versions.
+func (r *ReservationRepo) Save(ctx context.Context, res model.Reservation, limits ...model.Limit) error {
+	query := `
+		INSERT INTO reservations (` + reservationColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE reservations.version = EXCLUDED.version - 1
+	`
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	result, err := tx.Exec(ctx, query,
+		res.ID(), res.TenantID(), res.PartyID(), res.Category().String(), res.Currency(), res.Reference(),
+		res.Amount(), res.LimitIDs(), res.Status().String(), res.ExpiresAt(),
+		res.Version(), res.CreatedAt(), res.UpdatedAt(),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: reference %q", port.ErrReservationExists, res.Reference())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to upsert reservation: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: reservation %s", port.ErrVersionConflict, res.ID())
+	}
+	if err := saveLimits(ctx, tx, limits); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a tenant's reservation.
+func (r *ReservationRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Reservation, error) {
+	return r.findOne(ctx, `SELECT `+reservationColumns+` FROM reservations WHERE id = $1 AND tenant_id = $2`, id, tenantID)
+}
+
+// FindByReference retrieves the reservation a tenant made with a
+// reference.
+func (r *ReservationRepo) FindByReference(ctx context.Context, tenantID uuid.UUID, reference string) (model.Reservation, error) {
+	return r.findOne(ctx, `SELECT `+reservationColumns+` FROM reservations WHERE tenant_id = $1 AND reference = $2`, tenantID, reference)
+}
+
+// List returns a page of the reservations matching the filter, newest
+// first, and the total number of them.
+func (r *ReservationRepo) List(ctx context.Context, filter port.ReservationFilter, limit, offset int) ([]model.Reservation, int, error) {
+	where := `WHERE tenant_id = $1
+		AND ($2::uuid IS NULL OR party_id = $2)
+		AND ($3 = '' OR status = $3)`
+	args := []any{filter.TenantID, filter.PartyID, filter.Status.String()}
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM reservations `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count reservations: %w", err)
+	}
+	reservations, err := r.query(ctx, `SELECT `+reservationColumns+` FROM reservations `+where+`
+		ORDER BY created_at DESC, id
+		LIMIT $4 OFFSET $5
+	`, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return reservations, total, nil
+}
+
+// ListExpired returns up to limit reservations of any tenant held past
+// their expiry at now, earliest expiry first.
+func (r *ReservationRepo) ListExpired(ctx context.Context, now time.Time, limit int) ([]model.Reservation, error) {
+	return r.query(ctx, `SELECT `+reservationColumns+` FROM reservations
+		WHERE status = 'HELD' AND expires_at <= $1
+		ORDER BY expires_at
+		LIMIT $2
+	`, now, limit)
+}
+
+func (r *ReservationRepo) findOne(ctx context.Context, query string, args ...any) (model.Reservation, error) {
+	res, err := scanReservation(r.pool.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Reservation{}, port.ErrReservationNotFound
+	}
+	if err != nil {
+		return model.Reservation{}, fmt.Errorf("failed to scan reservation: %w", err)
+	}
+	return res, nil
+}
+
+func (r *ReservationRepo) query(ctx context.Context, query string, args ...any) ([]model.Reservation, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reservations: %w", err)
+	}
+	defer rows.Close()
+
+	var reservations []model.Reservation
+	for rows.Next() {
+		res, err := scanReservation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reservation row: %w", err)
+		}
+		reservations = append(reservations, res)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return reservations, nil
+}
+
+func scanReservation(row pgx.Row) (model.Reservation, error) {
+	var (
+		id, tenantID                    uuid.UUID
+		partyID                         *uuid.UUID
+		categoryStr, currency           string
+		reference, statusStr            string
+		amount                          decimal.Decimal
+		limitIDs                        []uuid.UUID
+		expiresAt, createdAt, updatedAt time.Time
+		version                         int
+	)
+
+	err := row.Scan(&id, &tenantID, &partyID, &categoryStr, &currency, &reference, &amount, &limitIDs,
+		&statusStr, &expiresAt, &version, &createdAt, &updatedAt)
+	if err != nil {
+		return model.Reservation{}, err
+	}
+
+	category, err := valueobject.NewLimitCategory(categoryStr)
+	if err != nil {
+		return model.Reservation{}, fmt.Errorf("invalid limit category in database: %w", err)
+	}
+	status, err := valueobject.NewReservationStatus(statusStr)
+	if err != nil {
+		return model.Reservation{}, fmt.Errorf("invalid reservation status in database: %w", err)
+	}
+
+	return model.ReconstructReservation(
+		id, tenantID, partyID, category, currency, reference, amount, limitIDs,
+		status, expiresAt, version, createdAt, updatedAt,
+	), nil
+}
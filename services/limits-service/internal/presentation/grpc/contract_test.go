@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	grpclib "google.golang.org/grpc"
+
+	limitsv1 "github.com/bibbank/bib/api/gen/go/bib/limits/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/limits-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/event"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/model"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/port"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractLimitRepo holds at most one limit.
+type contractLimitRepo struct {
+	limit *model.Limit
+}
+
+func (r *contractLimitRepo) Save(context.Context, ...model.Limit) error { return nil }
+
+func (r *contractLimitRepo) FindByID(context.Context, uuid.UUID, uuid.UUID) (model.Limit, error) {
+	if r.limit == nil {
+		return model.Limit{}, port.ErrLimitNotFound
+	}
+	return *r.limit, nil
+}
+
+func (r *contractLimitRepo) FindByScope(context.Context, port.LimitScope) (model.Limit, error) {
+	return model.Limit{}, port.ErrLimitNotFound
+}
+
+func (r *contractLimitRepo) List(context.Context, port.LimitFilter, int, int) ([]model.Limit, int, error) {
+	return nil, 0, nil
+}
+
+type discardPublisher struct{}
+
+func (discardPublisher) Publish(context.Context, []event.DomainEvent) error { return nil }
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	limits := &contractLimitRepo{}
+	h := NewHandler(
+		usecase.NewCreateLimitUseCase(limits, discardPublisher{}), nil,
+		usecase.NewGetLimitUseCase(limits), nil, nil, nil, nil, nil, nil, nil, nil,
+		slog.Default(),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { limitsv1.RegisterLimitsServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-limits-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { limits.limit = nil },
+		"a customer has a payments limit": func(t *testing.T) {
+			category, err := valueobject.NewLimitCategory("PAYMENTS")
+			if err != nil {
+				t.Fatal(err)
+			}
+			partyID := uuid.MustParse("a4c6e8b0-2d4f-4a1c-9e3b-5d7f9b1d3f6a")
+			limit, err := model.NewLimit(contractTenantID, &partyID, category, "GBP", decimal.NewFromInt(10000), nil, time.Now().UTC())
+			if err != nil {
+				t.Fatal(err)
+			}
+			limits.limit = &limit
+		},
+	})
+}
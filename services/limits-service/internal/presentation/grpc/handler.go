@@ -0,0 +1,581 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	limitsv1 "github.com/bibbank/bib/api/gen/go/bib/limits/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/limits-service/internal/application/dto"
+	"github.com/bibbank/bib/services/limits-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/model"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/port"
+)
+
+// staffRoles may read a tenant's limits, reservations and exposure;
+// limitRoles may set and change limits; exposureRoles, which include the
+// services calling before they commit to exposure, may reserve, commit,
+// release and reduce it.
+var (
+	staffRoles    = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleAPIClient}
+	limitRoles    = []string{auth.RoleAdmin, auth.RoleOperator}
+	exposureRoles = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient}
+)
+
+// requireRole checks that the caller has at least one of the given roles.
+func requireRole(ctx context.Context, roles ...string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "insufficient permissions")
+}
+
+// tenantIDFromContext extracts the tenant ID from JWT claims in the context.
+func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return claims.TenantID, nil
+}
+
+// optionalUUID parses an optional ID, returning nil when it is empty.
+func optionalUUID(raw, field string) (*uuid.UUID, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s", field)
+	}
+	return &id, nil
+}
+
+// parseAmount parses a decimal amount, an empty one being zero.
+func parseAmount(raw, field string) (decimal.Decimal, error) {
+	if raw == "" {
+		return decimal.Zero, nil
+	}
+	amount, err := decimal.NewFromString(raw)
+	if err != nil {
+		return decimal.Decimal{}, status.Errorf(codes.InvalidArgument, "invalid %s", field)
+	}
+	return amount, nil
+}
+
+// enumName strips an enum value's prefix, returning "" for the unspecified
+// value.
+func enumName(name, prefix string) string {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "UNSPECIFIED" {
+		return ""
+	}
+	return name
+}
+
+// uuidString formats an optional ID, returning "" when it is nil.
+func uuidString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+// Compile-time assertion that Handler implements LimitsServiceServer.
+var _ limitsv1.LimitsServiceServer = (*Handler)(nil)
+
+// Handler implements the LimitsServiceServer gRPC interface.
+type Handler struct {
+	limitsv1.UnimplementedLimitsServiceServer
+	createLimit        *usecase.CreateLimitUseCase
+	updateLimit        *usecase.UpdateLimitUseCase
+	getLimit           *usecase.GetLimitUseCase
+	listLimits         *usecase.ListLimitsUseCase
+	checkAndReserve    *usecase.CheckAndReserveUseCase
+	commitReservation  *usecase.CommitReservationUseCase
+	releaseReservation *usecase.ReleaseReservationUseCase
+	getReservation     *usecase.GetReservationUseCase
+	listReservations   *usecase.ListReservationsUseCase
+	reduceExposure     *usecase.ReduceExposureUseCase
+	getExposure        *usecase.GetExposureUseCase
+	logger             *slog.Logger
+}
+
+// NewHandler creates a new gRPC Handler.
+func NewHandler(
+	createLimit *usecase.CreateLimitUseCase,
+	updateLimit *usecase.UpdateLimitUseCase,
+	getLimit *usecase.GetLimitUseCase,
+	listLimits *usecase.ListLimitsUseCase,
+	checkAndReserve *usecase.CheckAndReserveUseCase,
+	commitReservation *usecase.CommitReservationUseCase,
+	releaseReservation *usecase.ReleaseReservationUseCase,
+	getReservation *usecase.GetReservationUseCase,
+	listReservations *usecase.ListReservationsUseCase,
+	reduceExposure *usecase.ReduceExposureUseCase,
+	getExposure *usecase.GetExposureUseCase,
+	logger *slog.Logger,
+) *Handler {
+	return &Handler{
+		createLimit:        createLimit,
+		updateLimit:        updateLimit,
+		getLimit:           getLimit,
+		listLimits:         listLimits,
+		checkAndReserve:    checkAndReserve,
+		commitReservation:  commitReservation,
+		releaseReservation: releaseReservation,
+		getReservation:     getReservation,
+		listReservations:   listReservations,
+		reduceExposure:     reduceExposure,
+		getExposure:        getExposure,
+		logger:             logger,
+	}
+}
+
+// CreateLimit sets a limit on a party or on the whole tenant.
+func (h *Handler) CreateLimit(ctx context.Context, req *limitsv1.CreateLimitRequest) (*limitsv1.CreateLimitResponse, error) {
+	if err := requireRole(ctx, limitRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	partyID, err := optionalUUID(req.PartyId, "party ID")
+	if err != nil {
+		return nil, err
+	}
+	parentID, err := optionalUUID(req.ParentId, "parent limit ID")
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseAmount(req.Amount, "amount")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.createLimit.Execute(ctx, dto.CreateLimitRequest{
+		TenantID: tenantID,
+		PartyID:  partyID,
+		ParentID: parentID,
+		Category: enumName(req.Category.String(), "LIMIT_CATEGORY_"),
+		Currency: req.Currency,
+		Amount:   amount,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &limitsv1.CreateLimitResponse{Limit: toLimitMsg(resp)}, nil
+}
+
+// UpdateLimit changes a limit's amount.
+func (h *Handler) UpdateLimit(ctx context.Context, req *limitsv1.UpdateLimitRequest) (*limitsv1.UpdateLimitResponse, error) {
+	if err := requireRole(ctx, limitRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	limitID, err := uuid.Parse(req.LimitId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid limit ID")
+	}
+	amount, err := parseAmount(req.Amount, "amount")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.updateLimit.Execute(ctx, dto.UpdateLimitRequest{
+		TenantID: tenantID,
+		LimitID:  limitID,
+		Amount:   amount,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &limitsv1.UpdateLimitResponse{Limit: toLimitMsg(resp)}, nil
+}
+
+// GetLimit retrieves a limit.
+func (h *Handler) GetLimit(ctx context.Context, req *limitsv1.GetLimitRequest) (*limitsv1.GetLimitResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	limitID, err := uuid.Parse(req.LimitId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid limit ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.getLimit.Execute(ctx, tenantID, limitID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &limitsv1.GetLimitResponse{Limit: toLimitMsg(resp)}, nil
+}
+
+// ListLimits lists the tenant's limits, tenant-wide limits first.
+func (h *Handler) ListLimits(ctx context.Context, req *limitsv1.ListLimitsRequest) (*limitsv1.ListLimitsResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	partyID, err := optionalUUID(req.PartyId, "party ID")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listLimits.Execute(ctx, dto.ListLimitsRequest{
+		TenantID:   tenantID,
+		PartyID:    partyID,
+		Currency:   req.Currency,
+		TenantWide: req.TenantWide,
+		Limit:      int(req.PageSize),
+		Offset:     int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &limitsv1.ListLimitsResponse{
+		Limits:     make([]*limitsv1.Limit, 0, len(resp.Limits)),
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, l := range resp.Limits {
+		out.Limits = append(out.Limits, toLimitMsg(l))
+	}
+	return out, nil
+}
+
+// CheckAndReserve checks exposure against the limits capping it and
+// reserves it if every one of them has the headroom.
+func (h *Handler) CheckAndReserve(ctx context.Context, req *limitsv1.CheckAndReserveRequest) (*limitsv1.CheckAndReserveResponse, error) {
+	if err := requireRole(ctx, exposureRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	partyID, err := optionalUUID(req.PartyId, "party ID")
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseAmount(req.Amount, "amount")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.checkAndReserve.Execute(ctx, dto.CheckAndReserveRequest{
+		TenantID:  tenantID,
+		PartyID:   partyID,
+		Category:  enumName(req.Category.String(), "LIMIT_CATEGORY_"),
+		Currency:  req.Currency,
+		Reference: req.Reference,
+		Amount:    amount,
+		DryRun:    req.DryRun,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &limitsv1.CheckAndReserveResponse{
+		Approved: resp.Approved,
+		Headroom: resp.Headroom.String(),
+	}
+	if resp.Reservation != nil {
+		out.Reservation = toReservationMsg(*resp.Reservation)
+	}
+	if resp.BindingLimitID != uuid.Nil {
+		out.BindingLimitId = resp.BindingLimitID.String()
+	}
+	return out, nil
+}
+
+// CommitReservation turns a held reservation into utilization of its
+// limits.
+func (h *Handler) CommitReservation(ctx context.Context, req *limitsv1.CommitReservationRequest) (*limitsv1.CommitReservationResponse, error) {
+	if err := requireRole(ctx, exposureRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	reservationID, err := uuid.Parse(req.ReservationId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid reservation ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.commitReservation.Execute(ctx, tenantID, reservationID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &limitsv1.CommitReservationResponse{Reservation: toReservationMsg(resp)}, nil
+}
+
+// ReleaseReservation gives a held reservation back to its limits.
+func (h *Handler) ReleaseReservation(ctx context.Context, req *limitsv1.ReleaseReservationRequest) (*limitsv1.ReleaseReservationResponse, error) {
+	if err := requireRole(ctx, exposureRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	reservationID, err := uuid.Parse(req.ReservationId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid reservation ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.releaseReservation.Execute(ctx, tenantID, reservationID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &limitsv1.ReleaseReservationResponse{Reservation: toReservationMsg(resp)}, nil
+}
+
+// GetReservation retrieves a reservation.
+func (h *Handler) GetReservation(ctx context.Context, req *limitsv1.GetReservationRequest) (*limitsv1.GetReservationResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	reservationID, err := uuid.Parse(req.ReservationId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid reservation ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.getReservation.Execute(ctx, tenantID, reservationID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &limitsv1.GetReservationResponse{Reservation: toReservationMsg(resp)}, nil
+}
+
+// ListReservations lists the tenant's reservations, newest first.
+func (h *Handler) ListReservations(ctx context.Context, req *limitsv1.ListReservationsRequest) (*limitsv1.ListReservationsResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	partyID, err := optionalUUID(req.PartyId, "party ID")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listReservations.Execute(ctx, dto.ListReservationsRequest{
+		TenantID: tenantID,
+		PartyID:  partyID,
+		Status:   enumName(req.Status.String(), "RESERVATION_STATUS_"),
+		Limit:    int(req.PageSize),
+		Offset:   int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &limitsv1.ListReservationsResponse{
+		Reservations: make([]*limitsv1.Reservation, 0, len(resp.Reservations)),
+		TotalCount:   int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, r := range resp.Reservations {
+		out.Reservations = append(out.Reservations, toReservationMsg(r))
+	}
+	return out, nil
+}
+
+// ReduceExposure reduces what is utilized against the limits capping
+// exposure, such as when a loan is repaid.
+func (h *Handler) ReduceExposure(ctx context.Context, req *limitsv1.ReduceExposureRequest) (*limitsv1.ReduceExposureResponse, error) {
+	if err := requireRole(ctx, exposureRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	partyID, err := optionalUUID(req.PartyId, "party ID")
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseAmount(req.Amount, "amount")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.reduceExposure.Execute(ctx, dto.ReduceExposureRequest{
+		TenantID:  tenantID,
+		PartyID:   partyID,
+		Category:  enumName(req.Category.String(), "LIMIT_CATEGORY_"),
+		Currency:  req.Currency,
+		Reference: req.Reference,
+		Amount:    amount,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &limitsv1.ReduceExposureResponse{Limit: toLimitMsg(resp)}, nil
+}
+
+// GetExposure consolidates a party's exposure across its limits.
+func (h *Handler) GetExposure(ctx context.Context, req *limitsv1.GetExposureRequest) (*limitsv1.GetExposureResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	partyID, err := optionalUUID(req.PartyId, "party ID")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.getExposure.Execute(ctx, dto.GetExposureRequest{TenantID: tenantID, PartyID: partyID})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &limitsv1.GetExposureResponse{
+		PartyId: uuidString(resp.PartyID),
+		Limits:  make([]*limitsv1.LimitExposure, 0, len(resp.Limits)),
+		Totals:  make([]*limitsv1.CurrencyExposure, 0, len(resp.Totals)),
+	}
+	for _, l := range resp.Limits {
+		out.Limits = append(out.Limits, &limitsv1.LimitExposure{
+			Limit:             toLimitMsg(l.Limit),
+			EffectiveHeadroom: l.EffectiveHeadroom.String(),
+			BindingLimitId:    l.BindingLimitID.String(),
+		})
+	}
+	for _, t := range resp.Totals {
+		out.Totals = append(out.Totals, &limitsv1.CurrencyExposure{
+			Currency: t.Currency,
+			Utilized: t.Utilized.String(),
+			Reserved: t.Reserved.String(),
+		})
+	}
+	return out, nil
+}
+
+func (h *Handler) toStatus(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidLimit), errors.Is(err, usecase.ErrInvalidReservation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrNoLimit), errors.Is(err, model.ErrInvalidTransition),
+		errors.Is(err, model.ErrInvalidChain):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, port.ErrLimitExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, port.ErrLimitNotFound):
+		return status.Error(codes.NotFound, "limit not found")
+	case errors.Is(err, port.ErrReservationNotFound):
+		return status.Error(codes.NotFound, "reservation not found")
+	case errors.Is(err, port.ErrVersionConflict):
+		return status.Error(codes.Aborted, "modified concurrently, retry")
+	default:
+		h.logger.Error("handler error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func toLimitMsg(l dto.LimitResponse) *limitsv1.Limit {
+	return &limitsv1.Limit{
+		LimitId:   l.ID.String(),
+		TenantId:  l.TenantID.String(),
+		PartyId:   uuidString(l.PartyID),
+		ParentId:  uuidString(l.ParentID),
+		Category:  limitsv1.LimitCategory(limitsv1.LimitCategory_value["LIMIT_CATEGORY_"+l.Category]),
+		Currency:  l.Currency,
+		Amount:    l.Amount.String(),
+		Utilized:  l.Utilized.String(),
+		Reserved:  l.Reserved.String(),
+		Available: l.Available.String(),
+		Version:   int32(l.Version), //nolint:gosec // version counter
+		CreatedAt: timestamppb.New(l.CreatedAt),
+		UpdatedAt: timestamppb.New(l.UpdatedAt),
+	}
+}
+
+func toReservationMsg(r dto.ReservationResponse) *limitsv1.Reservation {
+	limitIDs := make([]string, 0, len(r.LimitIDs))
+	for _, id := range r.LimitIDs {
+		limitIDs = append(limitIDs, id.String())
+	}
+	return &limitsv1.Reservation{
+		ReservationId: r.ID.String(),
+		TenantId:      r.TenantID.String(),
+		PartyId:       uuidString(r.PartyID),
+		Category:      limitsv1.LimitCategory(limitsv1.LimitCategory_value["LIMIT_CATEGORY_"+r.Category]),
+		Currency:      r.Currency,
+		Reference:     r.Reference,
+		Amount:        r.Amount.String(),
+		LimitIds:      limitIDs,
+		Status:        limitsv1.ReservationStatus(limitsv1.ReservationStatus_value["RESERVATION_STATUS_"+r.Status]),
+		ExpiresAt:     timestamppb.New(r.ExpiresAt),
+		Version:       int32(r.Version), //nolint:gosec // version counter
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+		UpdatedAt:     timestamppb.New(r.UpdatedAt),
+	}
+}
@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"log/slog"
+
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+
+	limitsv1 "github.com/bibbank/bib/api/gen/go/bib/limits/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/grpcserver"
+)
+
+// Server wraps the gRPC server for limits-service.
+type Server struct {
+	server  *grpcserver.Server
+	handler *Handler
+}
+
+// NewServer creates a new gRPC server with the given handler. meterProvider
+// may be nil to record call metrics on the global provider.
+func NewServer(handler *Handler, logger *slog.Logger, jwtService *auth.JWTService, meterProvider metric.MeterProvider) (*Server, error) {
+	srv, err := grpcserver.New(grpcserver.Config{
+		ServiceName:   "limits-service",
+		JWTService:    jwtService,
+		MeterProvider: meterProvider,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+	limitsv1.RegisterLimitsServiceServer(srv, handler)
+
+	return &Server{
+		server:  srv,
+		handler: handler,
+	}, nil
+}
+
+// Start begins listening on the specified address.
+func (s *Server) Start(addr string) error {
+	return s.server.ListenAndServe(addr)
+}
+
+// Stop gracefully stops the gRPC server.
+func (s *Server) Stop() {
+	s.server.Stop()
+}
+
+// RegisterService registers another service on the server, such as the
+// outbox administration service.
+func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	s.server.RegisterService(desc, impl)
+}
@@ -0,0 +1,205 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/outbox"
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/limits-service/internal/application/dto"
+	"github.com/bibbank/bib/services/limits-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/port"
+	"github.com/bibbank/bib/services/limits-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/limits-service/internal/infrastructure/postgres"
+)
+
+const topic = "limits-events"
+
+// suite wires the use cases over the real repositories and outbox.
+type suite struct {
+	env          *testutil.Env
+	limits       *postgres.LimitRepo
+	reservations *postgres.ReservationRepo
+	publisher    *postgres.OutboxPublisher
+	tenantID     uuid.UUID
+	partyID      uuid.UUID
+}
+
+func setup(t *testing.T, withKafka bool) *suite {
+	t.Helper()
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir(), Kafka: withKafka})
+	return &suite{
+		env:          env,
+		limits:       postgres.NewLimitRepo(env.Pool()),
+		reservations: postgres.NewReservationRepo(env.Pool()),
+		publisher:    postgres.NewOutboxPublisher(outbox.NewPublisher(outbox.NewStore(env.Pool())), topic),
+		tenantID:     uuid.New(),
+		partyID:      uuid.New(),
+	}
+}
+
+func (s *suite) createLimit(t *testing.T, forParty bool, category string, amount int64, parent *dto.LimitResponse) dto.LimitResponse {
+	t.Helper()
+	req := dto.CreateLimitRequest{
+		TenantID: s.tenantID,
+		Category: category,
+		Currency: "USD",
+		Amount:   decimal.NewFromInt(amount),
+	}
+	if forParty {
+		req.PartyID = &s.partyID
+	}
+	if parent != nil {
+		req.ParentID = &parent.ID
+	}
+	resp, err := usecase.NewCreateLimitUseCase(s.limits, s.publisher).Execute(context.Background(), req)
+	require.NoError(t, err)
+	return resp
+}
+
+func (s *suite) reserve(reference string, amount int64) (dto.CheckAndReserveResponse, error) {
+	uc := usecase.NewCheckAndReserveUseCase(s.limits, s.reservations, s.publisher, time.Minute)
+	return uc.Execute(context.Background(), dto.CheckAndReserveRequest{
+		TenantID:  s.tenantID,
+		PartyID:   &s.partyID,
+		Category:  "CARDS",
+		Currency:  "USD",
+		Reference: reference,
+		Amount:    decimal.NewFromInt(amount),
+	})
+}
+
+func TestLimitRepo_ScopeIsUnique(t *testing.T) {
+	s := setup(t, false)
+	ctx := context.Background()
+
+	tenant := s.createLimit(t, false, "TOTAL", 10000, nil)
+	_, err := usecase.NewCreateLimitUseCase(s.limits, s.publisher).Execute(ctx, dto.CreateLimitRequest{
+		TenantID: s.tenantID,
+		Category: "TOTAL",
+		Currency: "USD",
+		Amount:   decimal.NewFromInt(5000),
+	})
+	assert.ErrorIs(t, err, port.ErrLimitExists, "the tenant-wide limit is unique even with a NULL party")
+
+	category, err := valueobject.NewLimitCategory(tenant.Category)
+	require.NoError(t, err)
+	found, err := s.limits.FindByScope(ctx, port.LimitScope{TenantID: s.tenantID, Category: category, Currency: "USD"})
+	require.NoError(t, err)
+	assert.Equal(t, tenant.ID, found.ID())
+}
+
+func TestCheckAndReserve_ConcurrentReservationsNeverOverrun(t *testing.T) {
+	s := setup(t, false)
+	ctx := context.Background()
+	tenant := s.createLimit(t, false, "TOTAL", 10000, nil)
+	cards := s.createLimit(t, true, "CARDS", 1000, &tenant)
+
+	const callers = 20
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		approved int64
+	)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := s.reserve(uuid.NewString(), 100)
+			if err != nil {
+				// Callers losing every retry to others see a conflict.
+				assert.ErrorIs(t, err, port.ErrVersionConflict, "caller %d", i)
+				return
+			}
+			if resp.Approved {
+				mu.Lock()
+				approved += 100
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, approved, int64(1000))
+	limit, err := s.limits.FindByID(ctx, s.tenantID, cards.ID)
+	require.NoError(t, err)
+	assert.True(t, decimal.NewFromInt(approved).Equal(limit.Exposure()),
+		"the stored exposure matches what was approved: %s vs %d", limit.Exposure(), approved)
+}
+
+func TestReservations_CommitAndExpire(t *testing.T) {
+	s := setup(t, false)
+	ctx := context.Background()
+	tenant := s.createLimit(t, false, "TOTAL", 10000, nil)
+	cards := s.createLimit(t, true, "CARDS", 1000, &tenant)
+
+	committed, err := s.reserve("auth-1", 300)
+	require.NoError(t, err)
+	require.True(t, committed.Approved)
+	_, err = usecase.NewCommitReservationUseCase(s.limits, s.reservations, s.publisher).
+		Execute(ctx, s.tenantID, committed.Reservation.ID)
+	require.NoError(t, err)
+
+	held, err := s.reserve("auth-2", 200)
+	require.NoError(t, err)
+	require.True(t, held.Approved)
+
+	expired, err := usecase.NewExpireReservationsUseCase(s.limits, s.reservations, s.publisher, slog.Default()).
+		Execute(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired, "only the held reservation expires")
+
+	reservation, err := s.reservations.FindByID(ctx, s.tenantID, held.Reservation.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "EXPIRED", reservation.Status().String())
+
+	limit, err := s.limits.FindByID(ctx, s.tenantID, cards.ID)
+	require.NoError(t, err)
+	assert.True(t, decimal.NewFromInt(300).Equal(limit.Utilized()))
+	assert.True(t, limit.Reserved().IsZero())
+}
+
+func TestOutbox_RelaysEventsToKafka(t *testing.T) {
+	s := setup(t, true)
+	ctx := context.Background()
+	tenant := s.createLimit(t, false, "TOTAL", 10000, nil)
+	s.createLimit(t, true, "CARDS", 1000, &tenant)
+	_, err := s.reserve("auth-1", 250)
+	require.NoError(t, err)
+
+	producer := kafka.NewProducer(kafka.Config{Brokers: s.env.Brokers()})
+	t.Cleanup(func() { _ = producer.Close() })
+	relay, err := outbox.NewRelay(outbox.NewStore(s.env.Pool()), producer,
+		outbox.RelayConfig{Route: outbox.StaticRoute(topic)}, slog.Default())
+	require.NoError(t, err)
+
+	published, err := relay.Drain(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, published)
+
+	messages := s.env.Kafka.ReadMessages(t, topic, published, 30*time.Second)
+	var types []string
+	for _, msg := range messages {
+		for _, h := range msg.Headers {
+			if h.Key == "event_type" {
+				types = append(types, string(h.Value))
+			}
+		}
+	}
+	assert.Equal(t, []string{"limits.limit.created", "limits.limit.created", "limits.reservation.held"}, types)
+
+	again, err := relay.Drain(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, again, "published entries are not relayed twice")
+}
@@ -0,0 +1,321 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/bibbank/bib/pkg/auth"
+	pkgkafka "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
+	"github.com/bibbank/bib/pkg/observability"
+	pkgpostgres "github.com/bibbank/bib/pkg/postgres"
+	"github.com/bibbank/bib/services/notification-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/notification-service/internal/infrastructure/client"
+	"github.com/bibbank/bib/services/notification-service/internal/infrastructure/config"
+	"github.com/bibbank/bib/services/notification-service/internal/infrastructure/kafka"
+	"github.com/bibbank/bib/services/notification-service/internal/infrastructure/postgres"
+	"github.com/bibbank/bib/services/notification-service/internal/infrastructure/provider"
+	grpcpresentation "github.com/bibbank/bib/services/notification-service/internal/presentation/grpc"
+	"github.com/bibbank/bib/services/notification-service/internal/presentation/rest"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// Load configuration.
+	cfg := config.Load()
+
+	// Initialize structured logger via shared observability package.
+	logger := observability.InitLogger(observability.LogConfig{
+		Level:  "info",
+		Format: "json",
+	})
+	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
+
+	logger.Info("starting notification-service",
+		"http_port", cfg.HTTPPort,
+		"grpc_port", cfg.GRPCPort,
+	)
+
+	// Initialize tracing.
+	shutdown, err := observability.InitTracer(ctx, observability.TracingConfig{
+		ServiceName: cfg.ServiceName,
+		Endpoint:    getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4317"),
+		Insecure:    true,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
+	} else {
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
+	}
+
+	// Database connection.
+	dbCtx, dbCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer dbCancel()
+
+	pool, err := pkgpostgres.NewPool(dbCtx, pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	})
+	if err != nil {
+		logger.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
+	logger.Info("connected to database")
+
+	// Run database migrations.
+	migDSN := pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	}.DSN()
+	if migErr := pkgpostgres.RunMigrations(migDSN, "file://internal/infrastructure/postgres/migrations"); migErr != nil {
+		logger.Warn("migration warning", "error", migErr)
+	}
+
+	// Wire infrastructure adapters.
+	templateRepo := postgres.NewTemplateRepo(pool)
+	preferenceRepo := postgres.NewPreferenceRepo(pool)
+	notificationRepo := postgres.NewNotificationRepo(pool)
+	kafkaProducer := pkgkafka.NewProducer(pkgkafka.Config{
+		Brokers: cfg.Kafka.Brokers,
+	})
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(kafkaProducer.Close))
+	eventPublisher := kafka.NewEventPublisher(kafkaProducer, "notification-events", logger)
+
+	// Delivery providers; a channel without a configured provider has its
+	// notifications logged instead of sent.
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	var providers []port.Provider
+	if cfg.SMTP.Host != "" {
+		providers = append(providers, provider.NewSMTPProvider(provider.SMTPConfig{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+			From:     cfg.SMTP.From,
+		}))
+	} else {
+		logger.Warn("SMTP_HOST not set, emails will be logged instead of sent")
+		providers = append(providers, provider.NewLogProvider(valueobject.ChannelEmail, logger))
+	}
+	if cfg.Twilio.AccountSID != "" {
+		providers = append(providers, provider.NewTwilioProvider(provider.TwilioConfig{
+			BaseURL:    cfg.Twilio.BaseURL,
+			AccountSID: cfg.Twilio.AccountSID,
+			AuthToken:  cfg.Twilio.AuthToken,
+			From:       cfg.Twilio.From,
+		}, httpClient))
+	} else {
+		logger.Warn("TWILIO_ACCOUNT_SID not set, SMS will be logged instead of sent")
+		providers = append(providers, provider.NewLogProvider(valueobject.ChannelSMS, logger))
+	}
+	if cfg.Push.URL != "" {
+		providers = append(providers, provider.NewPushProvider(provider.PushConfig{
+			URL:    cfg.Push.URL,
+			APIKey: cfg.Push.APIKey,
+		}, httpClient))
+	} else {
+		logger.Warn("PUSH_GATEWAY_URL not set, pushes will be logged instead of sent")
+		providers = append(providers, provider.NewLogProvider(valueobject.ChannelPush, logger))
+	}
+
+	// JWT service for gRPC auth (validation-only: public key preferred, secret as fallback).
+	jwtCfg := auth.JWTConfig{
+		Issuer: "bib-gateway",
+	}
+	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
+	case os.Getenv("JWT_PUBLIC_KEY") != "":
+		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
+	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
+		keyData, loadErr := auth.LoadKeyFromFile(os.Getenv("JWT_PUBLIC_KEY_FILE"))
+		if loadErr != nil {
+			logger.Error("failed to load JWT public key file", "error", loadErr)
+			os.Exit(1)
+		}
+		jwtCfg.PublicKeyPEM = string(keyData)
+	default:
+		jwtSecret := os.Getenv("JWT_SECRET")
+		if jwtSecret == "" {
+			jwtSecret = "test-e2e-secret" // Match gateway default for E2E tests
+		}
+		jwtCfg.Secret = jwtSecret
+	}
+	jwtSvc, err := auth.NewJWTService(jwtCfg)
+	if err != nil {
+		logger.Error("failed to initialize JWT service", "error", err)
+		os.Exit(1)
+	}
+
+	// Marketing consent: calls carry tenant tokens signed like the gateway's,
+	// since events arrive with no caller to forward.
+	var consents port.ConsentChecker = client.NewStubConsentChecker()
+	if cfg.Consent.GRPCAddr != "" {
+		var signer *auth.JWTService
+		switch {
+		case cfg.Consent.SigningKeyFile != "":
+			keyData, loadErr := auth.LoadKeyFromFile(cfg.Consent.SigningKeyFile)
+			if loadErr != nil {
+				logger.Error("failed to load notification signing key file", "error", loadErr)
+				os.Exit(1)
+			}
+			signer, err = auth.NewJWTService(auth.JWTConfig{
+				PrivateKeyPEM: string(keyData),
+				Issuer:        "bib-gateway",
+				Expiration:    15 * time.Minute,
+			})
+		case jwtCfg.Secret != "":
+			signer, err = auth.NewJWTService(auth.JWTConfig{
+				Secret:     jwtCfg.Secret,
+				Issuer:     "bib-gateway",
+				Expiration: 15 * time.Minute,
+			})
+		default:
+			logger.Warn("NOTIFICATION_SIGNING_KEY_FILE not set, consent checks will be unauthenticated")
+		}
+		if err != nil {
+			logger.Error("failed to initialize notification token signer", "error", err)
+			os.Exit(1)
+		}
+		consentConn, dialErr := grpc.NewClient(cfg.Consent.GRPCAddr,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if dialErr != nil {
+			logger.Error("failed to create consent service client", "addr", cfg.Consent.GRPCAddr, "error", dialErr)
+			os.Exit(1)
+		}
+		lc.OnStop(lifecycle.PhaseResources, "consent client", lifecycle.Close(consentConn.Close))
+		consents = client.NewConsentGRPCClient(consentConn, signer, time.Duration(cfg.Consent.TimeoutSeconds)*time.Second)
+	} else {
+		logger.Warn("CONSENT_SERVICE_ADDR not set, marketing templates will not be sent")
+	}
+
+	// Wire use cases.
+	handleEventUC := usecase.NewHandleEventUseCase(templateRepo, preferenceRepo, notificationRepo, consents, logger)
+	dispatchUC := usecase.NewDispatchNotificationsUseCase(notificationRepo, providers, eventPublisher,
+		usecase.RetryPolicy{
+			MaxAttempts: cfg.Dispatch.MaxAttempts,
+			BaseBackoff: time.Duration(cfg.Dispatch.BaseBackoffSeconds) * time.Second,
+			MaxBackoff:  time.Duration(cfg.Dispatch.MaxBackoffSeconds) * time.Second,
+		},
+		time.Duration(cfg.Dispatch.LeaseSeconds)*time.Second,
+		cfg.Dispatch.BatchSize,
+	)
+	upsertTemplateUC := usecase.NewUpsertTemplateUseCase(templateRepo)
+	listTemplatesUC := usecase.NewListTemplatesUseCase(templateRepo)
+	setPreferencesUC := usecase.NewSetPreferencesUseCase(preferenceRepo)
+	getPreferencesUC := usecase.NewGetPreferencesUseCase(preferenceRepo)
+	getNotificationUC := usecase.NewGetNotificationUseCase(notificationRepo)
+	listNotificationsUC := usecase.NewListNotificationsUseCase(notificationRepo)
+
+	// Consume every service's domain events into the notification queue.
+	topics := cfg.Kafka.Topics
+	if len(topics) == 0 {
+		topics = usecase.DefaultTopics
+	}
+	for _, topic := range topics {
+		consumer := pkgkafka.NewConsumer(pkgkafka.Config{
+			Brokers:       cfg.Kafka.Brokers,
+			ConsumerGroup: cfg.Kafka.ConsumerGroup,
+		}, topic, func(ctx context.Context, msg pkgkafka.Message) error {
+			_, handleErr := handleEventUC.Execute(ctx, msg.Headers["event_type"], msg.Value)
+			return handleErr
+		}, logger)
+		lc.Go(lifecycle.PhaseConsumers, topic+" consumer", consumer.Start)
+		lc.OnStop(lifecycle.PhaseResources, topic+" consumer", lifecycle.Close(consumer.Close))
+	}
+
+	// gRPC server.
+	grpcHandler := grpcpresentation.NewNotificationServiceHandler(
+		upsertTemplateUC, listTemplatesUC, setPreferencesUC, getPreferencesUC,
+		getNotificationUC, listNotificationsUC, logger,
+	)
+	grpcServer, err := grpcpresentation.NewServer(grpcHandler, logger, jwtSvc)
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+
+	// HTTP server (health checks).
+	healthHandler := rest.NewHealthHandler(logger)
+	httpMux := http.NewServeMux()
+	healthHandler.RegisterRoutes(httpMux)
+
+	httpServer := &http.Server{
+		Addr:              cfg.HTTPAddr(),
+		Handler:           httpMux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Deliver due notifications, draining full batches before waiting for
+	// the next tick.
+	lc.Go(lifecycle.PhaseWorkers, "notification dispatch", func(ctx context.Context) error {
+		ticker := time.NewTicker(time.Duration(cfg.Dispatch.PollIntervalSeconds) * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				for ctx.Err() == nil {
+					n, dispatchErr := dispatchUC.Execute(ctx)
+					if dispatchErr != nil {
+						logger.Error("notification dispatch failed", "error", dispatchErr)
+					}
+					if n < cfg.Dispatch.BatchSize || dispatchErr != nil {
+						break
+					}
+				}
+			}
+		}
+	})
+
+	// Start servers.
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", func(context.Context) error {
+		return grpcServer.Start(cfg.GRPCAddr())
+	})
+	lc.OnStop(lifecycle.PhaseIngress, "gRPC server", lifecycle.Func(grpcServer.Stop))
+	lc.ServeHTTP("HTTP server", httpServer)
+
+	logger.Info("notification-service is running",
+		"grpc_addr", cfg.GRPCAddr(),
+		"http_addr", cfg.HTTPAddr(),
+		"topics", topics,
+	)
+
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
+	}
+	logger.Info("notification-service stopped")
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UpsertTemplateRequest is the input DTO for creating or revising a
+// tenant's template for an event type and channel.
+type UpsertTemplateRequest struct {
+	EventType string    `json:"event_type"`
+	Channel   string    `json:"channel"`
+	Subject   string    `json:"subject"`
+	Body      string    `json:"body"`
+	TenantID  uuid.UUID `json:"tenant_id"`
+	Marketing bool      `json:"marketing"`
+}
+
+// TemplateResponse is the output DTO for a notification template.
+type TemplateResponse struct {
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	EventType string    `json:"event_type"`
+	Channel   string    `json:"channel"`
+	Subject   string    `json:"subject"`
+	Body      string    `json:"body"`
+	Version   int       `json:"version"`
+	ID        uuid.UUID `json:"id"`
+	Marketing bool      `json:"marketing"`
+}
+
+// SetPreferencesRequest is the input DTO for replacing a customer's channel
+// preferences.
+type SetPreferencesRequest struct {
+	Email       string      `json:"email"`
+	Phone       string      `json:"phone"`
+	PushToken   string      `json:"push_token"`
+	Channels    []string    `json:"channels"`
+	MutedEvents []string    `json:"muted_events"`
+	Subjects    []uuid.UUID `json:"subjects"`
+	TenantID    uuid.UUID   `json:"tenant_id"`
+	CustomerID  uuid.UUID   `json:"customer_id"`
+}
+
+// PreferencesResponse is the output DTO for a customer's channel preferences.
+type PreferencesResponse struct {
+	UpdatedAt   time.Time   `json:"updated_at"`
+	Email       string      `json:"email"`
+	Phone       string      `json:"phone"`
+	PushToken   string      `json:"push_token"`
+	Channels    []string    `json:"channels"`
+	MutedEvents []string    `json:"muted_events"`
+	Subjects    []uuid.UUID `json:"subjects"`
+	Version     int         `json:"version"`
+	CustomerID  uuid.UUID   `json:"customer_id"`
+}
+
+// NotificationResponse is the output DTO for a notification and its delivery.
+type NotificationResponse struct {
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	NextAttemptAt     time.Time  `json:"next_attempt_at"`
+	SentAt            *time.Time `json:"sent_at,omitempty"`
+	EventID           string     `json:"event_id"`
+	EventType         string     `json:"event_type"`
+	Channel           string     `json:"channel"`
+	Recipient         string     `json:"recipient"`
+	Subject           string     `json:"subject,omitempty"`
+	Body              string     `json:"body"`
+	Status            string     `json:"status"`
+	Provider          string     `json:"provider,omitempty"`
+	ProviderMessageID string     `json:"provider_message_id,omitempty"`
+	LastError         string     `json:"last_error,omitempty"`
+	Attempts          int        `json:"attempts"`
+	ID                uuid.UUID  `json:"id"`
+	CustomerID        uuid.UUID  `json:"customer_id"`
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+)
+
+// RetryPolicy is how failed deliveries are retried: up to MaxAttempts in
+// all, backing off exponentially from BaseBackoff up to MaxBackoff.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// backoff returns the wait before the attempt after the given number of
+// failed ones.
+func (p RetryPolicy) backoff(failed int) time.Duration {
+	d := p.BaseBackoff
+	for i := 1; i < failed && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, p.MaxBackoff)
+}
+
+// DispatchNotificationsUseCase delivers due notifications through the
+// provider for their channel. A failed delivery is retried with backoff
+// until the policy's attempts run out or the provider reports a permanent
+// failure, when the notification fails.
+type DispatchNotificationsUseCase struct {
+	notifications port.NotificationRepository
+	providers     map[string]port.Provider
+	publisher     port.EventPublisher
+	policy        RetryPolicy
+	lease         time.Duration
+	batchSize     int
+}
+
+// NewDispatchNotificationsUseCase creates a new DispatchNotificationsUseCase.
+// lease bounds how long one delivery may take before the notification is
+// claimed again.
+func NewDispatchNotificationsUseCase(
+	notifications port.NotificationRepository,
+	providers []port.Provider,
+	publisher port.EventPublisher,
+	policy RetryPolicy,
+	lease time.Duration,
+	batchSize int,
+) *DispatchNotificationsUseCase {
+	byChannel := make(map[string]port.Provider, len(providers))
+	for _, p := range providers {
+		byChannel[p.Channel().String()] = p
+	}
+	return &DispatchNotificationsUseCase{
+		notifications: notifications,
+		providers:     byChannel,
+		publisher:     publisher,
+		policy:        policy,
+		lease:         lease,
+		batchSize:     batchSize,
+	}
+}
+
+// Execute delivers one batch of due notifications and returns how many it
+// attempted. Failures of individual deliveries are recorded on the
+// notifications, not returned.
+func (uc *DispatchNotificationsUseCase) Execute(ctx context.Context) (int, error) {
+	due, err := uc.notifications.ClaimDue(ctx, time.Now().UTC(), uc.lease, uc.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim due notifications: %w", err)
+	}
+
+	var errs []error
+	for _, n := range due {
+		if err := uc.deliver(ctx, n); err != nil {
+			errs = append(errs, fmt.Errorf("notification %s: %w", n.ID(), err))
+		}
+	}
+	return len(due), errors.Join(errs...)
+}
+
+func (uc *DispatchNotificationsUseCase) deliver(ctx context.Context, n model.Notification) error {
+	var (
+		messageID string
+		sendErr   error
+		provider  = uc.providers[n.Channel().String()]
+	)
+	if provider == nil {
+		sendErr = fmt.Errorf("%w: no provider configured for channel %s", port.ErrPermanentFailure, n.Channel())
+	} else {
+		messageID, sendErr = provider.Send(ctx, port.OutboundMessage{
+			IdempotencyKey: n.ID().String(),
+			To:             n.Recipient(),
+			Subject:        n.Subject(),
+			Body:           n.Body(),
+		})
+	}
+
+	now := time.Now().UTC()
+	var err error
+	switch {
+	case sendErr == nil:
+		n, err = n.MarkSent(provider.Name(), messageID, now)
+	case errors.Is(sendErr, port.ErrPermanentFailure) || n.Attempts()+1 >= uc.policy.MaxAttempts:
+		n, err = n.Fail(sendErr.Error(), now)
+	default:
+		n, err = n.RetryAt(sendErr.Error(), now.Add(uc.policy.backoff(n.Attempts()+1)), now)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := uc.notifications.Save(ctx, n); err != nil {
+		return fmt.Errorf("failed to save notification: %w", err)
+	}
+	if events := n.DomainEvents(); len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, events); err != nil {
+			return fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/notification-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+type stubProvider struct {
+	channel valueobject.Channel
+	errs    []error
+	sent    []port.OutboundMessage
+}
+
+func (p *stubProvider) Name() string                 { return "stub" }
+func (p *stubProvider) Channel() valueobject.Channel { return p.channel }
+
+func (p *stubProvider) Send(_ context.Context, msg port.OutboundMessage) (string, error) {
+	p.sent = append(p.sent, msg)
+	if len(p.errs) > 0 {
+		err := p.errs[0]
+		p.errs = p.errs[1:]
+		if err != nil {
+			return "", err
+		}
+	}
+	return fmt.Sprintf("msg-%d", len(p.sent)), nil
+}
+
+func queueNotification(t *testing.T, repo *inMemoryNotificationRepo, channel valueobject.Channel, address string) model.Notification {
+	t.Helper()
+	n, err := model.NewNotification(uuid.New(), uuid.New(), uuid.NewString(), "payment.completed",
+		model.Recipient{Channel: channel, Address: address}, model.Message{Subject: "Paid", Body: "Payment sent"},
+		time.Now().UTC().Add(-time.Second))
+	require.NoError(t, err)
+	_, err = repo.Create(context.Background(), n)
+	require.NoError(t, err)
+	return n
+}
+
+func TestDispatchNotifications_RetriesThenSends(t *testing.T) {
+	ctx := context.Background()
+	repo := &inMemoryNotificationRepo{}
+	n := queueNotification(t, repo, valueobject.ChannelSMS, "+447700900123")
+	sms := &stubProvider{channel: valueobject.ChannelSMS, errs: []error{errors.New("503 service unavailable")}}
+	publisher := &recordingPublisher{}
+	policy := usecase.RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Minute, MaxBackoff: time.Hour}
+	uc := usecase.NewDispatchNotificationsUseCase(repo, []port.Provider{sms}, publisher, policy, time.Minute, 10)
+
+	attempted, err := uc.Execute(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempted)
+	got, err := repo.FindByID(ctx, n.TenantID(), n.ID())
+	require.NoError(t, err)
+	assert.True(t, got.Status().Equal(valueobject.DeliveryStatusPending))
+	assert.Equal(t, 1, got.Attempts())
+	assert.WithinDuration(t, time.Now().Add(time.Minute), got.NextAttemptAt(), 5*time.Second)
+	assert.Empty(t, publisher.events)
+
+	attempted, err = uc.Execute(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, attempted, "the retry waits out its backoff")
+
+	// Bring the retry due.
+	repo.notifications[0] = model.ReconstructNotification(
+		got.ID(), got.TenantID(), got.CustomerID(), got.EventID(), got.EventType(), got.Channel(), got.Recipient(),
+		got.Subject(), got.Body(), got.Status(), got.Attempts(), time.Now().UTC().Add(-time.Second), got.Provider(),
+		got.ProviderMessageID(), got.LastError(), got.SentAt(), got.Version(), got.CreatedAt(), got.UpdatedAt(),
+	)
+	_, err = uc.Execute(ctx)
+	require.NoError(t, err)
+	got, err = repo.FindByID(ctx, n.TenantID(), n.ID())
+	require.NoError(t, err)
+	assert.True(t, got.Status().Equal(valueobject.DeliveryStatusSent))
+	assert.Equal(t, "msg-2", got.ProviderMessageID())
+	require.Len(t, publisher.events, 1)
+	assert.Equal(t, "notification.sent", publisher.events[0].EventType())
+	assert.Equal(t, []string{n.ID().String(), n.ID().String()},
+		[]string{sms.sent[0].IdempotencyKey, sms.sent[1].IdempotencyKey}, "retries reuse the idempotency key")
+}
+
+func TestDispatchNotifications_FailsPermanently(t *testing.T) {
+	ctx := context.Background()
+	repo := &inMemoryNotificationRepo{}
+	rejected := queueNotification(t, repo, valueobject.ChannelSMS, "+447700900123")
+	unrouted := queueNotification(t, repo, valueobject.ChannelPush, "device-token")
+	sms := &stubProvider{channel: valueobject.ChannelSMS, errs: []error{fmt.Errorf("%w: invalid number", port.ErrPermanentFailure)}}
+	publisher := &recordingPublisher{}
+	policy := usecase.RetryPolicy{MaxAttempts: 5, BaseBackoff: time.Minute, MaxBackoff: time.Hour}
+	uc := usecase.NewDispatchNotificationsUseCase(repo, []port.Provider{sms}, publisher, policy, time.Minute, 10)
+
+	attempted, err := uc.Execute(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempted)
+	for _, n := range []model.Notification{rejected, unrouted} {
+		got, err := repo.FindByID(ctx, n.TenantID(), n.ID())
+		require.NoError(t, err)
+		assert.True(t, got.Status().Equal(valueobject.DeliveryStatusFailed), n.Channel().String())
+		assert.Equal(t, 1, got.Attempts())
+	}
+	require.Len(t, publisher.events, 2)
+	assert.Equal(t, "notification.failed", publisher.events[0].EventType())
+}
+
+func TestDispatchNotifications_GivesUpAfterMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	repo := &inMemoryNotificationRepo{}
+	n := queueNotification(t, repo, valueobject.ChannelEmail, "ada@example.com")
+	email := &stubProvider{channel: valueobject.ChannelEmail, errs: []error{errors.New("connection reset")}}
+	policy := usecase.RetryPolicy{MaxAttempts: 1, BaseBackoff: time.Minute, MaxBackoff: time.Hour}
+	uc := usecase.NewDispatchNotificationsUseCase(repo, []port.Provider{email}, &recordingPublisher{}, policy, time.Minute, 10)
+
+	_, err := uc.Execute(ctx)
+	require.NoError(t, err)
+	got, err := repo.FindByID(ctx, n.TenantID(), n.ID())
+	require.NoError(t, err)
+	assert.True(t, got.Status().Equal(valueobject.DeliveryStatusFailed))
+	assert.Equal(t, "connection reset", got.LastError())
+}
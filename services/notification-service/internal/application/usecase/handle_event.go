@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+)
+
+// DefaultTopics lists the domain event topics of every service, consumed
+// unless NOTIFICATION_TOPICS overrides them.
+var DefaultTopics = []string{
+	"account-events",
+	"bib.deposit.events",
+	"bib.deposit.interest",
+	"bib.fx.rates",
+	"bib.fx.revaluation",
+	"bib.identity.verifications",
+	"bib.ledger.entries",
+	"bib.payment.orders",
+	"card-events",
+	"fraud-events",
+	"lending-events",
+	"reporting.report.generated",
+	"reporting.report.submitted",
+	"reporting.report.approved",
+	"reporting.report.changes_requested",
+	"reporting.report.accepted",
+	"reporting.report.rejected",
+	"reporting.report.job_completed",
+	"reporting.schedule.alerts",
+	"reporting.consistency.alerts",
+}
+
+// subjectFields are the event fields naming who or what an event concerns,
+// matched against the subjects of customers' preferences.
+var subjectFields = []string{"customer_id", "applicant_id", "account_id", "aggregate_id"}
+
+// HandleEventUseCase turns a domain event into notifications. The customers
+// concerned are those whose preferences list one of the event's subjects;
+// each is notified on every channel they opted in to for which the tenant
+// has a template for the event type, and, for marketing templates, to which
+// they consent to marketing. Notifications are queued for the
+// dispatcher, once per event, customer and channel however often the event
+// is redelivered.
+type HandleEventUseCase struct {
+	templates     port.TemplateRepository
+	preferences   port.PreferenceRepository
+	notifications port.NotificationRepository
+	consents      port.ConsentChecker
+	logger        *slog.Logger
+}
+
+// NewHandleEventUseCase creates a new HandleEventUseCase.
+func NewHandleEventUseCase(
+	templates port.TemplateRepository,
+	preferences port.PreferenceRepository,
+	notifications port.NotificationRepository,
+	consents port.ConsentChecker,
+	logger *slog.Logger,
+) *HandleEventUseCase {
+	return &HandleEventUseCase{
+		templates:     templates,
+		preferences:   preferences,
+		notifications: notifications,
+		consents:      consents,
+		logger:        logger,
+	}
+}
+
+// Execute handles an event of the given type, falling back to the type the
+// event names when the message carries none. The payload is a CloudEvents
+// envelope or the bare event. It returns the number of notifications queued.
+func (uc *HandleEventUseCase) Execute(ctx context.Context, eventType string, payload []byte) (int, error) {
+	data, err := events.EventData(payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode event: %w", err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return 0, fmt.Errorf("failed to decode event: %w", err)
+	}
+
+	if eventType == "" {
+		eventType, _ = fields["event_type"].(string)
+	}
+	eventID, _ := fields["event_id"].(string)
+	if eventType == "" || eventID == "" {
+		return 0, fmt.Errorf("event is missing its ID or type")
+	}
+	tenant, _ := fields["tenant_id"].(string)
+	tenantID, err := uuid.Parse(tenant)
+	if err != nil {
+		return 0, fmt.Errorf("%s event has invalid tenant ID %q: %w", eventType, tenant, err)
+	}
+
+	var subjects []uuid.UUID
+	for _, f := range subjectFields {
+		if s, ok := fields[f].(string); ok {
+			if id, err := uuid.Parse(s); err == nil {
+				subjects = append(subjects, id)
+			}
+		}
+	}
+	if len(subjects) == 0 {
+		return 0, nil
+	}
+
+	preferences, err := uc.preferences.FindBySubjects(ctx, tenantID, subjects)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find channel preferences: %w", err)
+	}
+
+	queued := 0
+	now := time.Now().UTC()
+	for _, pref := range preferences {
+		for _, recipient := range pref.RecipientsFor(eventType) {
+			tmpl, err := uc.templates.Find(ctx, tenantID, eventType, recipient.Channel)
+			if errors.Is(err, port.ErrTemplateNotFound) {
+				continue
+			}
+			if err != nil {
+				return queued, fmt.Errorf("failed to find template: %w", err)
+			}
+			if tmpl.Marketing() {
+				consented, err := uc.consents.MarketingConsented(ctx, tenantID, pref.CustomerID(), recipient.Channel)
+				if err != nil {
+					return queued, fmt.Errorf("failed to check marketing consent: %w", err)
+				}
+				if !consented {
+					continue
+				}
+			}
+
+			msg, err := tmpl.Render(fields)
+			if err != nil {
+				// A template the event cannot fill is the tenant's to fix;
+				// redelivering the event would not help.
+				uc.logger.WarnContext(ctx, "skipping notification with unrenderable template",
+					"event_type", eventType,
+					"channel", recipient.Channel.String(),
+					"template_id", tmpl.ID().String(),
+					"error", err,
+				)
+				continue
+			}
+
+			n, err := model.NewNotification(tenantID, pref.CustomerID(), eventID, eventType, recipient, msg, now)
+			if err != nil {
+				return queued, fmt.Errorf("failed to create notification: %w", err)
+			}
+			created, err := uc.notifications.Create(ctx, n)
+			if err != nil {
+				return queued, fmt.Errorf("failed to save notification: %w", err)
+			}
+			if created {
+				queued++
+			}
+		}
+	}
+	return queued, nil
+}
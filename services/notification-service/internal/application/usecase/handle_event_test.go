@@ -0,0 +1,124 @@
+package usecase_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/notification-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+func TestHandleEvent_QueuesNotificationPerOptedInChannel(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	tenantID, customerID, accountID := uuid.New(), uuid.New(), uuid.New()
+
+	templates := &inMemoryTemplateRepo{}
+	for _, c := range []valueobject.Channel{valueobject.ChannelEmail, valueobject.ChannelSMS} {
+		tmpl, err := model.NewTemplate(tenantID, "account.frozen", c, "Account frozen", "Account {{.account_id}} was frozen: {{.reason}}", false, now)
+		require.NoError(t, err)
+		require.NoError(t, templates.Save(ctx, tmpl))
+	}
+	preferences := &inMemoryPreferenceRepo{}
+	pref, err := model.NewChannelPreference(tenantID, customerID, model.ChannelPreferenceInput{
+		Email:     "ada@example.com",
+		PushToken: "device-token",
+		Channels:  []valueobject.Channel{valueobject.ChannelEmail, valueobject.ChannelPush},
+		Subjects:  []uuid.UUID{accountID},
+	}, now)
+	require.NoError(t, err)
+	require.NoError(t, preferences.Save(ctx, pref))
+	notifications := &inMemoryNotificationRepo{}
+
+	uc := usecase.NewHandleEventUseCase(templates, preferences, notifications, channelConsents{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	payload := []byte(`{"event_id":"evt-1","event_type":"account.frozen","tenant_id":"` + tenantID.String() +
+		`","account_id":"` + accountID.String() + `","reason":"suspected fraud"}`)
+	queued, err := uc.Execute(ctx, "", payload)
+	require.NoError(t, err)
+	// Email has a template; push has none, and SMS is not opted in to.
+	assert.Equal(t, 1, queued)
+	require.Len(t, notifications.notifications, 1)
+	n := notifications.notifications[0]
+	assert.Equal(t, customerID, n.CustomerID())
+	assert.Equal(t, "ada@example.com", n.Recipient())
+	assert.Equal(t, "Account "+accountID.String()+" was frozen: suspected fraud", n.Body())
+
+	queued, err = uc.Execute(ctx, "account.frozen", payload)
+	require.NoError(t, err)
+	assert.Zero(t, queued, "redelivered events are not notified twice")
+}
+
+func TestHandleEvent_SkipsUnrelatedAndUnrenderableEvents(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	tenantID, customerID := uuid.New(), uuid.New()
+
+	templates := &inMemoryTemplateRepo{}
+	tmpl, err := model.NewTemplate(tenantID, "card.issued", valueobject.ChannelSMS, "", "Card ending {{.last_four}} issued", false, now)
+	require.NoError(t, err)
+	require.NoError(t, templates.Save(ctx, tmpl))
+	preferences := &inMemoryPreferenceRepo{}
+	pref, err := model.NewChannelPreference(tenantID, customerID, model.ChannelPreferenceInput{
+		Phone:    "+447700900123",
+		Channels: []valueobject.Channel{valueobject.ChannelSMS},
+	}, now)
+	require.NoError(t, err)
+	require.NoError(t, preferences.Save(ctx, pref))
+	notifications := &inMemoryNotificationRepo{}
+
+	uc := usecase.NewHandleEventUseCase(templates, preferences, notifications, channelConsents{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	queued, err := uc.Execute(ctx, "card.issued", []byte(`{"event_id":"evt-1","tenant_id":"`+tenantID.String()+
+		`","customer_id":"`+uuid.NewString()+`","last_four":"4242"}`))
+	require.NoError(t, err)
+	assert.Zero(t, queued, "events about other customers are not notified")
+
+	queued, err = uc.Execute(ctx, "card.issued", []byte(`{"event_id":"evt-2","tenant_id":"`+tenantID.String()+
+		`","customer_id":"`+customerID.String()+`"}`))
+	require.NoError(t, err)
+	assert.Zero(t, queued, "events the template cannot render are skipped")
+
+	_, err = uc.Execute(ctx, "card.issued", []byte(`{"event_id":"evt-3","tenant_id":"not-a-uuid"}`))
+	assert.Error(t, err)
+}
+
+func TestHandleEvent_SendsMarketingOnlyWithConsent(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	tenantID, customerID := uuid.New(), uuid.New()
+
+	templates := &inMemoryTemplateRepo{}
+	for _, c := range []valueobject.Channel{valueobject.ChannelEmail, valueobject.ChannelSMS} {
+		tmpl, err := model.NewTemplate(tenantID, "deposit.product.launched", c, "New savings rates", "Our new rate is {{.rate}}", true, now)
+		require.NoError(t, err)
+		require.NoError(t, templates.Save(ctx, tmpl))
+	}
+	preferences := &inMemoryPreferenceRepo{}
+	pref, err := model.NewChannelPreference(tenantID, customerID, model.ChannelPreferenceInput{
+		Email:    "ada@example.com",
+		Phone:    "+447700900123",
+		Channels: []valueobject.Channel{valueobject.ChannelEmail, valueobject.ChannelSMS},
+	}, now)
+	require.NoError(t, err)
+	require.NoError(t, preferences.Save(ctx, pref))
+	notifications := &inMemoryNotificationRepo{}
+
+	uc := usecase.NewHandleEventUseCase(templates, preferences, notifications,
+		channelConsents{valueobject.ChannelSMS}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	queued, err := uc.Execute(ctx, "deposit.product.launched", []byte(`{"event_id":"evt-1","tenant_id":"`+tenantID.String()+
+		`","customer_id":"`+customerID.String()+`","rate":"4.1%"}`))
+	require.NoError(t, err)
+	assert.Equal(t, 1, queued, "marketing goes only to channels the customer consents to")
+	require.Len(t, notifications.notifications, 1)
+	assert.Equal(t, valueobject.ChannelSMS, notifications.notifications[0].Channel())
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/notification-service/internal/application/dto"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+)
+
+// maxListedNotifications caps the notifications listed at once.
+const maxListedNotifications = 100
+
+// GetNotificationUseCase retrieves a notification and its delivery status.
+type GetNotificationUseCase struct {
+	notifications port.NotificationRepository
+}
+
+// NewGetNotificationUseCase creates a new GetNotificationUseCase.
+func NewGetNotificationUseCase(notifications port.NotificationRepository) *GetNotificationUseCase {
+	return &GetNotificationUseCase{notifications: notifications}
+}
+
+// Execute retrieves the tenant's notification.
+func (uc *GetNotificationUseCase) Execute(ctx context.Context, tenantID, id uuid.UUID) (dto.NotificationResponse, error) {
+	n, err := uc.notifications.FindByID(ctx, tenantID, id)
+	if err != nil {
+		return dto.NotificationResponse{}, fmt.Errorf("failed to find notification: %w", err)
+	}
+	return toNotificationResponse(n), nil
+}
+
+// ListNotificationsUseCase lists a customer's notifications, newest first.
+type ListNotificationsUseCase struct {
+	notifications port.NotificationRepository
+}
+
+// NewListNotificationsUseCase creates a new ListNotificationsUseCase.
+func NewListNotificationsUseCase(notifications port.NotificationRepository) *ListNotificationsUseCase {
+	return &ListNotificationsUseCase{notifications: notifications}
+}
+
+// Execute lists up to limit of the customer's notifications; limit defaults
+// to, and is capped at, 100.
+func (uc *ListNotificationsUseCase) Execute(ctx context.Context, tenantID, customerID uuid.UUID, limit int) ([]dto.NotificationResponse, error) {
+	if limit <= 0 || limit > maxListedNotifications {
+		limit = maxListedNotifications
+	}
+	notifications, err := uc.notifications.ListByCustomer(ctx, tenantID, customerID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	resp := make([]dto.NotificationResponse, 0, len(notifications))
+	for _, n := range notifications {
+		resp = append(resp, toNotificationResponse(n))
+	}
+	return resp, nil
+}
+
+func toNotificationResponse(n model.Notification) dto.NotificationResponse {
+	return dto.NotificationResponse{
+		ID:                n.ID(),
+		CustomerID:        n.CustomerID(),
+		EventID:           n.EventID(),
+		EventType:         n.EventType(),
+		Channel:           n.Channel().String(),
+		Recipient:         n.Recipient(),
+		Subject:           n.Subject(),
+		Body:              n.Body(),
+		Status:            n.Status().String(),
+		Attempts:          n.Attempts(),
+		NextAttemptAt:     n.NextAttemptAt(),
+		Provider:          n.Provider(),
+		ProviderMessageID: n.ProviderMessageID(),
+		LastError:         n.LastError(),
+		SentAt:            n.SentAt(),
+		CreatedAt:         n.CreatedAt(),
+		UpdatedAt:         n.UpdatedAt(),
+	}
+}
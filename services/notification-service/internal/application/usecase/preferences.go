@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/notification-service/internal/application/dto"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+// ErrInvalidPreferences is returned when channel preferences are malformed,
+// e.g. a channel is opted in to without an address.
+var ErrInvalidPreferences = errors.New("invalid channel preferences")
+
+// SetPreferencesUseCase replaces a customer's channel preferences.
+type SetPreferencesUseCase struct {
+	preferences port.PreferenceRepository
+}
+
+// NewSetPreferencesUseCase creates a new SetPreferencesUseCase.
+func NewSetPreferencesUseCase(preferences port.PreferenceRepository) *SetPreferencesUseCase {
+	return &SetPreferencesUseCase{preferences: preferences}
+}
+
+// Execute creates or replaces the customer's preferences.
+func (uc *SetPreferencesUseCase) Execute(ctx context.Context, req dto.SetPreferencesRequest) (dto.PreferencesResponse, error) {
+	in := model.ChannelPreferenceInput{
+		Email:       req.Email,
+		Phone:       req.Phone,
+		PushToken:   req.PushToken,
+		MutedEvents: req.MutedEvents,
+		Subjects:    req.Subjects,
+	}
+	for _, c := range req.Channels {
+		channel, err := valueobject.NewChannel(c)
+		if err != nil {
+			return dto.PreferencesResponse{}, fmt.Errorf("%w: %w", ErrInvalidPreferences, err)
+		}
+		in.Channels = append(in.Channels, channel)
+	}
+
+	now := time.Now().UTC()
+	pref, err := uc.preferences.Find(ctx, req.TenantID, req.CustomerID)
+	switch {
+	case errors.Is(err, port.ErrPreferenceNotFound):
+		pref, err = model.NewChannelPreference(req.TenantID, req.CustomerID, in, now)
+	case err != nil:
+		return dto.PreferencesResponse{}, fmt.Errorf("failed to find channel preferences: %w", err)
+	default:
+		pref, err = pref.Update(in, now)
+	}
+	if err != nil {
+		return dto.PreferencesResponse{}, fmt.Errorf("%w: %w", ErrInvalidPreferences, err)
+	}
+
+	if err := uc.preferences.Save(ctx, pref); err != nil {
+		return dto.PreferencesResponse{}, fmt.Errorf("failed to save channel preferences: %w", err)
+	}
+	return toPreferencesResponse(pref), nil
+}
+
+// GetPreferencesUseCase retrieves a customer's channel preferences.
+type GetPreferencesUseCase struct {
+	preferences port.PreferenceRepository
+}
+
+// NewGetPreferencesUseCase creates a new GetPreferencesUseCase.
+func NewGetPreferencesUseCase(preferences port.PreferenceRepository) *GetPreferencesUseCase {
+	return &GetPreferencesUseCase{preferences: preferences}
+}
+
+// Execute retrieves the customer's preferences.
+func (uc *GetPreferencesUseCase) Execute(ctx context.Context, tenantID, customerID uuid.UUID) (dto.PreferencesResponse, error) {
+	pref, err := uc.preferences.Find(ctx, tenantID, customerID)
+	if err != nil {
+		return dto.PreferencesResponse{}, fmt.Errorf("failed to find channel preferences: %w", err)
+	}
+	return toPreferencesResponse(pref), nil
+}
+
+func toPreferencesResponse(p model.ChannelPreference) dto.PreferencesResponse {
+	channels := make([]string, 0, len(p.Channels()))
+	for _, c := range p.Channels() {
+		channels = append(channels, c.String())
+	}
+	return dto.PreferencesResponse{
+		CustomerID:  p.CustomerID(),
+		Email:       p.Email(),
+		Phone:       p.Phone(),
+		PushToken:   p.PushToken(),
+		Channels:    channels,
+		MutedEvents: p.MutedEvents(),
+		Subjects:    p.Subjects(),
+		Version:     p.Version(),
+		UpdatedAt:   p.UpdatedAt(),
+	}
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/notification-service/internal/application/dto"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+// ErrInvalidTemplate is returned when a template is malformed, e.g. its body
+// does not parse.
+var ErrInvalidTemplate = errors.New("invalid notification template")
+
+// UpsertTemplateUseCase creates a tenant's template for an event type and
+// channel, or revises the existing one.
+type UpsertTemplateUseCase struct {
+	templates port.TemplateRepository
+}
+
+// NewUpsertTemplateUseCase creates a new UpsertTemplateUseCase.
+func NewUpsertTemplateUseCase(templates port.TemplateRepository) *UpsertTemplateUseCase {
+	return &UpsertTemplateUseCase{templates: templates}
+}
+
+// Execute creates or revises the template.
+func (uc *UpsertTemplateUseCase) Execute(ctx context.Context, req dto.UpsertTemplateRequest) (dto.TemplateResponse, error) {
+	channel, err := valueobject.NewChannel(req.Channel)
+	if err != nil {
+		return dto.TemplateResponse{}, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+
+	now := time.Now().UTC()
+	tmpl, err := uc.templates.Find(ctx, req.TenantID, req.EventType, channel)
+	switch {
+	case errors.Is(err, port.ErrTemplateNotFound):
+		tmpl, err = model.NewTemplate(req.TenantID, req.EventType, channel, req.Subject, req.Body, req.Marketing, now)
+	case err != nil:
+		return dto.TemplateResponse{}, fmt.Errorf("failed to find template: %w", err)
+	default:
+		tmpl, err = tmpl.Revise(req.Subject, req.Body, req.Marketing, now)
+	}
+	if err != nil {
+		return dto.TemplateResponse{}, fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+
+	if err := uc.templates.Save(ctx, tmpl); err != nil {
+		return dto.TemplateResponse{}, fmt.Errorf("failed to save template: %w", err)
+	}
+	return toTemplateResponse(tmpl), nil
+}
+
+// ListTemplatesUseCase lists a tenant's templates.
+type ListTemplatesUseCase struct {
+	templates port.TemplateRepository
+}
+
+// NewListTemplatesUseCase creates a new ListTemplatesUseCase.
+func NewListTemplatesUseCase(templates port.TemplateRepository) *ListTemplatesUseCase {
+	return &ListTemplatesUseCase{templates: templates}
+}
+
+// Execute lists the tenant's templates.
+func (uc *ListTemplatesUseCase) Execute(ctx context.Context, tenantID uuid.UUID) ([]dto.TemplateResponse, error) {
+	templates, err := uc.templates.ListByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates: %w", err)
+	}
+	resp := make([]dto.TemplateResponse, 0, len(templates))
+	for _, t := range templates {
+		resp = append(resp, toTemplateResponse(t))
+	}
+	return resp, nil
+}
+
+func toTemplateResponse(t model.Template) dto.TemplateResponse {
+	return dto.TemplateResponse{
+		ID:        t.ID(),
+		EventType: t.EventType(),
+		Channel:   t.Channel().String(),
+		Subject:   t.Subject(),
+		Body:      t.Body(),
+		Marketing: t.Marketing(),
+		Version:   t.Version(),
+		CreatedAt: t.CreatedAt(),
+		UpdatedAt: t.UpdatedAt(),
+	}
+}
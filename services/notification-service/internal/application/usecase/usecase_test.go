@@ -0,0 +1,163 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/event"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+type inMemoryTemplateRepo struct {
+	templates []model.Template
+}
+
+func (r *inMemoryTemplateRepo) Save(_ context.Context, t model.Template) error {
+	for i, existing := range r.templates {
+		if existing.ID() == t.ID() {
+			r.templates[i] = t
+			return nil
+		}
+	}
+	r.templates = append(r.templates, t)
+	return nil
+}
+
+func (r *inMemoryTemplateRepo) Find(_ context.Context, tenantID uuid.UUID, eventType string, channel valueobject.Channel) (model.Template, error) {
+	for _, t := range r.templates {
+		if t.TenantID() == tenantID && t.EventType() == eventType && t.Channel().Equal(channel) {
+			return t, nil
+		}
+	}
+	return model.Template{}, port.ErrTemplateNotFound
+}
+
+func (r *inMemoryTemplateRepo) ListByTenant(_ context.Context, tenantID uuid.UUID) ([]model.Template, error) {
+	var result []model.Template
+	for _, t := range r.templates {
+		if t.TenantID() == tenantID {
+			result = append(result, t)
+		}
+	}
+	return result, nil
+}
+
+type inMemoryPreferenceRepo struct {
+	preferences []model.ChannelPreference
+}
+
+func (r *inMemoryPreferenceRepo) Save(_ context.Context, p model.ChannelPreference) error {
+	for i, existing := range r.preferences {
+		if existing.TenantID() == p.TenantID() && existing.CustomerID() == p.CustomerID() {
+			r.preferences[i] = p
+			return nil
+		}
+	}
+	r.preferences = append(r.preferences, p)
+	return nil
+}
+
+func (r *inMemoryPreferenceRepo) Find(_ context.Context, tenantID, customerID uuid.UUID) (model.ChannelPreference, error) {
+	for _, p := range r.preferences {
+		if p.TenantID() == tenantID && p.CustomerID() == customerID {
+			return p, nil
+		}
+	}
+	return model.ChannelPreference{}, port.ErrPreferenceNotFound
+}
+
+func (r *inMemoryPreferenceRepo) FindBySubjects(_ context.Context, tenantID uuid.UUID, subjects []uuid.UUID) ([]model.ChannelPreference, error) {
+	var result []model.ChannelPreference
+	for _, p := range r.preferences {
+		if p.TenantID() != tenantID {
+			continue
+		}
+		if slices.ContainsFunc(p.Subjects(), func(s uuid.UUID) bool { return slices.Contains(subjects, s) }) {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+type inMemoryNotificationRepo struct {
+	notifications []model.Notification
+}
+
+func (r *inMemoryNotificationRepo) Create(_ context.Context, n model.Notification) (bool, error) {
+	for _, existing := range r.notifications {
+		if existing.EventID() == n.EventID() && existing.CustomerID() == n.CustomerID() && existing.Channel().Equal(n.Channel()) {
+			return false, nil
+		}
+	}
+	r.notifications = append(r.notifications, n.ClearDomainEvents())
+	return true, nil
+}
+
+func (r *inMemoryNotificationRepo) Save(_ context.Context, n model.Notification) error {
+	for i, existing := range r.notifications {
+		if existing.ID() == n.ID() {
+			r.notifications[i] = n.ClearDomainEvents()
+			return nil
+		}
+	}
+	return port.ErrNotificationNotFound
+}
+
+func (r *inMemoryNotificationRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.Notification, error) {
+	for _, n := range r.notifications {
+		if n.TenantID() == tenantID && n.ID() == id {
+			return n, nil
+		}
+	}
+	return model.Notification{}, port.ErrNotificationNotFound
+}
+
+func (r *inMemoryNotificationRepo) ListByCustomer(_ context.Context, tenantID, customerID uuid.UUID, limit int) ([]model.Notification, error) {
+	var result []model.Notification
+	for _, n := range r.notifications {
+		if n.TenantID() == tenantID && n.CustomerID() == customerID && len(result) < limit {
+			result = append(result, n)
+		}
+	}
+	return result, nil
+}
+
+func (r *inMemoryNotificationRepo) ClaimDue(_ context.Context, now time.Time, _ time.Duration, limit int) ([]model.Notification, error) {
+	var result []model.Notification
+	for _, n := range r.notifications {
+		if n.Status().Equal(valueobject.DeliveryStatusPending) && !n.NextAttemptAt().After(now) && len(result) < limit {
+			result = append(result, n)
+		}
+	}
+	return result, nil
+}
+
+type recordingPublisher struct {
+	mu     sync.Mutex
+	events []event.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, events []event.DomainEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, events...)
+	return nil
+}
+
+// channelConsents consents to marketing on the listed channels only.
+type channelConsents []valueobject.Channel
+
+func (c channelConsents) MarketingConsented(_ context.Context, _, _ uuid.UUID, channel valueobject.Channel) (bool, error) {
+	for _, consented := range c {
+		if consented.Equal(channel) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
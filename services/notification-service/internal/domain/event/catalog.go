@@ -0,0 +1,12 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[NotificationSent]("notification.sent", 1, "Emitted when a provider accepts a notification for delivery."),
+		events.Declare[NotificationFailed]("notification.failed", 1, "Emitted when delivery of a notification is given up on, because its retries are exhausted or the provider rejected it outright."),
+	}
+}
@@ -0,0 +1,62 @@
+package event
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// DomainEvent is an alias for the shared pkg/events.DomainEvent interface.
+type DomainEvent = events.DomainEvent
+
+// NotificationSent is emitted when a provider accepts a notification for
+// delivery.
+type NotificationSent struct {
+	events.BaseEvent
+	CustomerID        string `json:"customer_id"`
+	SourceEventID     string `json:"source_event_id"`
+	SourceEventType   string `json:"source_event_type"`
+	Channel           string `json:"channel"`
+	Provider          string `json:"provider"`
+	ProviderMessageID string `json:"provider_message_id,omitempty"`
+	Attempts          int    `json:"attempts"`
+}
+
+// NewNotificationSent creates a new NotificationSent event.
+func NewNotificationSent(id, tenantID, customerID uuid.UUID, sourceEventID, sourceEventType, channel, provider, providerMessageID string, attempts int) NotificationSent {
+	return NotificationSent{
+		BaseEvent:         events.NewBaseEvent("notification.sent", id.String(), "Notification", tenantID.String()),
+		CustomerID:        customerID.String(),
+		SourceEventID:     sourceEventID,
+		SourceEventType:   sourceEventType,
+		Channel:           channel,
+		Provider:          provider,
+		ProviderMessageID: providerMessageID,
+		Attempts:          attempts,
+	}
+}
+
+// NotificationFailed is emitted when delivery of a notification is given up
+// on, because its retries are exhausted or the provider rejected it outright.
+type NotificationFailed struct {
+	events.BaseEvent
+	CustomerID      string `json:"customer_id"`
+	SourceEventID   string `json:"source_event_id"`
+	SourceEventType string `json:"source_event_type"`
+	Channel         string `json:"channel"`
+	Reason          string `json:"reason"`
+	Attempts        int    `json:"attempts"`
+}
+
+// NewNotificationFailed creates a new NotificationFailed event.
+func NewNotificationFailed(id, tenantID, customerID uuid.UUID, sourceEventID, sourceEventType, channel, reason string, attempts int) NotificationFailed {
+	return NotificationFailed{
+		BaseEvent:       events.NewBaseEvent("notification.failed", id.String(), "Notification", tenantID.String()),
+		CustomerID:      customerID.String(),
+		SourceEventID:   sourceEventID,
+		SourceEventType: sourceEventType,
+		Channel:         channel,
+		Reason:          reason,
+		Attempts:        attempts,
+	}
+}
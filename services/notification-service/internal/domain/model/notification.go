@@ -0,0 +1,214 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/event"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+// Notification is one message to one customer on one channel, raised by a
+// domain event. It tracks delivery: a PENDING notification is attempted at
+// its next attempt time until a provider accepts it (SENT) or delivery is
+// given up on (FAILED). A customer gets at most one notification per event
+// and channel.
+type Notification struct {
+	createdAt         time.Time
+	updatedAt         time.Time
+	nextAttemptAt     time.Time
+	sentAt            *time.Time
+	eventID           string
+	eventType         string
+	recipient         string
+	subject           string
+	body              string
+	provider          string
+	providerMessageID string
+	lastError         string
+	status            valueobject.DeliveryStatus
+	channel           valueobject.Channel
+	domainEvents      []events.DomainEvent
+	attempts          int
+	version           int
+	id                uuid.UUID
+	tenantID          uuid.UUID
+	customerID        uuid.UUID
+}
+
+// NewNotification creates a PENDING notification of an event, due for
+// delivery now.
+func NewNotification(
+	tenantID, customerID uuid.UUID,
+	eventID, eventType string,
+	recipient Recipient,
+	msg Message,
+	now time.Time,
+) (Notification, error) {
+	if tenantID == uuid.Nil {
+		return Notification{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	if customerID == uuid.Nil {
+		return Notification{}, fmt.Errorf("customer ID must not be empty")
+	}
+	if eventID == "" || eventType == "" {
+		return Notification{}, fmt.Errorf("event ID and type must not be empty")
+	}
+	if recipient.Channel.IsZero() || recipient.Address == "" {
+		return Notification{}, fmt.Errorf("recipient channel and address must not be empty")
+	}
+	if msg.Body == "" {
+		return Notification{}, fmt.Errorf("message body must not be empty")
+	}
+	return Notification{
+		id:            uuid.New(),
+		tenantID:      tenantID,
+		customerID:    customerID,
+		eventID:       eventID,
+		eventType:     eventType,
+		channel:       recipient.Channel,
+		recipient:     recipient.Address,
+		subject:       msg.Subject,
+		body:          msg.Body,
+		status:        valueobject.DeliveryStatusPending,
+		nextAttemptAt: now,
+		version:       1,
+		createdAt:     now,
+		updatedAt:     now,
+	}, nil
+}
+
+// ReconstructNotification recreates a Notification from persisted data without emitting events.
+func ReconstructNotification(
+	id uuid.UUID,
+	tenantID uuid.UUID,
+	customerID uuid.UUID,
+	eventID string,
+	eventType string,
+	channel valueobject.Channel,
+	recipient string,
+	subject string,
+	body string,
+	status valueobject.DeliveryStatus,
+	attempts int,
+	nextAttemptAt time.Time,
+	provider string,
+	providerMessageID string,
+	lastError string,
+	sentAt *time.Time,
+	version int,
+	createdAt time.Time,
+	updatedAt time.Time,
+) Notification {
+	return Notification{
+		id:                id,
+		tenantID:          tenantID,
+		customerID:        customerID,
+		eventID:           eventID,
+		eventType:         eventType,
+		channel:           channel,
+		recipient:         recipient,
+		subject:           subject,
+		body:              body,
+		status:            status,
+		attempts:          attempts,
+		nextAttemptAt:     nextAttemptAt,
+		provider:          provider,
+		providerMessageID: providerMessageID,
+		lastError:         lastError,
+		sentAt:            sentAt,
+		version:           version,
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
+	}
+}
+
+// MarkSent records that the named provider accepted the notification.
+func (n Notification) MarkSent(provider, providerMessageID string, now time.Time) (Notification, error) {
+	if !n.status.Equal(valueobject.DeliveryStatusPending) {
+		return n, fmt.Errorf("cannot mark sent: current status is %s, expected PENDING", n.status)
+	}
+	n.status = valueobject.DeliveryStatusSent
+	n.attempts++
+	n.provider = provider
+	n.providerMessageID = providerMessageID
+	n.lastError = ""
+	n.sentAt = &now
+	n.version++
+	n.updatedAt = now
+	n.domainEvents = append(n.domainEvents, event.NewNotificationSent(
+		n.id, n.tenantID, n.customerID, n.eventID, n.eventType, n.channel.String(), provider, providerMessageID, n.attempts,
+	))
+	return n, nil
+}
+
+// RetryAt records a failed delivery attempt and schedules the next one.
+func (n Notification) RetryAt(reason string, next, now time.Time) (Notification, error) {
+	if !n.status.Equal(valueobject.DeliveryStatusPending) {
+		return n, fmt.Errorf("cannot retry: current status is %s, expected PENDING", n.status)
+	}
+	if reason == "" {
+		return n, fmt.Errorf("failure reason must not be empty")
+	}
+	n.attempts++
+	n.lastError = reason
+	n.nextAttemptAt = next
+	n.version++
+	n.updatedAt = now
+	return n, nil
+}
+
+// Fail records a failed delivery attempt and gives up on the notification.
+func (n Notification) Fail(reason string, now time.Time) (Notification, error) {
+	if !n.status.Equal(valueobject.DeliveryStatusPending) {
+		return n, fmt.Errorf("cannot fail: current status is %s, expected PENDING", n.status)
+	}
+	if reason == "" {
+		return n, fmt.Errorf("failure reason must not be empty")
+	}
+	n.status = valueobject.DeliveryStatusFailed
+	n.attempts++
+	n.lastError = reason
+	n.version++
+	n.updatedAt = now
+	n.domainEvents = append(n.domainEvents, event.NewNotificationFailed(
+		n.id, n.tenantID, n.customerID, n.eventID, n.eventType, n.channel.String(), reason, n.attempts,
+	))
+	return n, nil
+}
+
+// --- Accessors ---
+
+func (n Notification) ID() uuid.UUID                      { return n.id }
+func (n Notification) TenantID() uuid.UUID                { return n.tenantID }
+func (n Notification) CustomerID() uuid.UUID              { return n.customerID }
+func (n Notification) EventID() string                    { return n.eventID }
+func (n Notification) EventType() string                  { return n.eventType }
+func (n Notification) Channel() valueobject.Channel       { return n.channel }
+func (n Notification) Recipient() string                  { return n.recipient }
+func (n Notification) Subject() string                    { return n.subject }
+func (n Notification) Body() string                       { return n.body }
+func (n Notification) Status() valueobject.DeliveryStatus { return n.status }
+func (n Notification) Attempts() int                      { return n.attempts }
+func (n Notification) NextAttemptAt() time.Time           { return n.nextAttemptAt }
+func (n Notification) Provider() string                   { return n.provider }
+func (n Notification) ProviderMessageID() string          { return n.providerMessageID }
+func (n Notification) LastError() string                  { return n.lastError }
+func (n Notification) SentAt() *time.Time                 { return n.sentAt }
+func (n Notification) Version() int                       { return n.version }
+func (n Notification) CreatedAt() time.Time               { return n.createdAt }
+func (n Notification) UpdatedAt() time.Time               { return n.updatedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (n Notification) DomainEvents() []events.DomainEvent {
+	return n.domainEvents
+}
+
+// ClearDomainEvents returns a copy with cleared domain events.
+func (n Notification) ClearDomainEvents() Notification {
+	n.domainEvents = nil
+	return n
+}
@@ -0,0 +1,64 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/event"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+func newTestNotification(t *testing.T, now time.Time) model.Notification {
+	t.Helper()
+	n, err := model.NewNotification(uuid.New(), uuid.New(), "evt-1", "payment.completed",
+		model.Recipient{Channel: valueobject.ChannelSMS, Address: "+447700900123"},
+		model.Message{Body: "Payment sent"}, now)
+	require.NoError(t, err)
+	return n
+}
+
+func TestNotification_RetryThenSent(t *testing.T) {
+	now := time.Now().UTC()
+	n := newTestNotification(t, now)
+	assert.True(t, n.Status().Equal(valueobject.DeliveryStatusPending))
+	assert.Equal(t, now, n.NextAttemptAt())
+
+	next := now.Add(time.Minute)
+	n, err := n.RetryAt("provider unavailable", next, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n.Attempts())
+	assert.Equal(t, next, n.NextAttemptAt())
+	assert.Empty(t, n.DomainEvents())
+
+	n, err = n.MarkSent("twilio", "SM123", next)
+	require.NoError(t, err)
+	assert.True(t, n.Status().Equal(valueobject.DeliveryStatusSent))
+	assert.Equal(t, 2, n.Attempts())
+	assert.Empty(t, n.LastError())
+	require.NotNil(t, n.SentAt())
+	require.Len(t, n.DomainEvents(), 1)
+	sent, ok := n.DomainEvents()[0].(event.NotificationSent)
+	require.True(t, ok)
+	assert.Equal(t, "SM123", sent.ProviderMessageID)
+
+	_, err = n.MarkSent("twilio", "SM124", next)
+	assert.Error(t, err, "a sent notification is final")
+}
+
+func TestNotification_Fail(t *testing.T) {
+	now := time.Now().UTC()
+	n, err := newTestNotification(t, now).Fail("invalid number", now)
+	require.NoError(t, err)
+	assert.True(t, n.Status().Equal(valueobject.DeliveryStatusFailed))
+	assert.Equal(t, "invalid number", n.LastError())
+	require.Len(t, n.DomainEvents(), 1)
+	assert.Equal(t, "notification.failed", n.DomainEvents()[0].EventType())
+
+	_, err = n.RetryAt("again", now, now)
+	assert.Error(t, err)
+}
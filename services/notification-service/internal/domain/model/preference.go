@@ -0,0 +1,195 @@
+package model
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+var e164RE = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+// Recipient is one channel and address a notification is delivered to.
+type Recipient struct {
+	Channel valueobject.Channel
+	Address string
+}
+
+// ChannelPreference is how a customer wants to be told about events: the
+// addresses they are reached at, the channels they opted in to, and the event
+// types they muted. Subjects are the accounts, applications and other
+// aggregates whose events the customer is notified about; the customer's own
+// ID is always one of them.
+type ChannelPreference struct {
+	updatedAt   time.Time
+	email       string
+	phone       string
+	pushToken   string
+	channels    []valueobject.Channel
+	mutedEvents []string
+	subjects    []uuid.UUID
+	version     int
+	tenantID    uuid.UUID
+	customerID  uuid.UUID
+}
+
+// ChannelPreferenceInput carries a customer's requested preferences.
+type ChannelPreferenceInput struct {
+	Email       string
+	Phone       string
+	PushToken   string
+	Channels    []valueobject.Channel
+	MutedEvents []string
+	Subjects    []uuid.UUID
+}
+
+// NewChannelPreference creates a customer's preferences. Every opted-in
+// channel needs an address: an email address, an E.164 phone number or a
+// device push token.
+func NewChannelPreference(tenantID, customerID uuid.UUID, in ChannelPreferenceInput, now time.Time) (ChannelPreference, error) {
+	if tenantID == uuid.Nil {
+		return ChannelPreference{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	if customerID == uuid.Nil {
+		return ChannelPreference{}, fmt.Errorf("customer ID must not be empty")
+	}
+	p := ChannelPreference{
+		tenantID:   tenantID,
+		customerID: customerID,
+	}
+	if err := p.set(in); err != nil {
+		return ChannelPreference{}, err
+	}
+	p.version = 1
+	p.updatedAt = now
+	return p, nil
+}
+
+// ReconstructChannelPreference recreates a ChannelPreference from persisted data.
+func ReconstructChannelPreference(
+	tenantID uuid.UUID,
+	customerID uuid.UUID,
+	email string,
+	phone string,
+	pushToken string,
+	channels []valueobject.Channel,
+	mutedEvents []string,
+	subjects []uuid.UUID,
+	version int,
+	updatedAt time.Time,
+) ChannelPreference {
+	return ChannelPreference{
+		tenantID:    tenantID,
+		customerID:  customerID,
+		email:       email,
+		phone:       phone,
+		pushToken:   pushToken,
+		channels:    channels,
+		mutedEvents: mutedEvents,
+		subjects:    subjects,
+		version:     version,
+		updatedAt:   updatedAt,
+	}
+}
+
+// Update replaces the customer's preferences.
+func (p ChannelPreference) Update(in ChannelPreferenceInput, now time.Time) (ChannelPreference, error) {
+	if err := p.set(in); err != nil {
+		return p, err
+	}
+	p.version++
+	p.updatedAt = now
+	return p, nil
+}
+
+func (p *ChannelPreference) set(in ChannelPreferenceInput) error {
+	if in.Email != "" {
+		addr, err := mail.ParseAddress(in.Email)
+		if err != nil || addr.Address != in.Email {
+			return fmt.Errorf("invalid email address %q", in.Email)
+		}
+	}
+	if in.Phone != "" && !e164RE.MatchString(in.Phone) {
+		return fmt.Errorf("phone number %q must be in E.164 format", in.Phone)
+	}
+
+	channels := make([]valueobject.Channel, 0, len(in.Channels))
+	for _, c := range in.Channels {
+		if c.IsZero() {
+			return fmt.Errorf("channel must not be empty")
+		}
+		if slices.ContainsFunc(channels, c.Equal) {
+			continue
+		}
+		if address(c, in.Email, in.Phone, in.PushToken) == "" {
+			return fmt.Errorf("channel %s requires an address", c)
+		}
+		channels = append(channels, c)
+	}
+
+	muted := make([]string, 0, len(in.MutedEvents))
+	for _, e := range in.MutedEvents {
+		if e != "" && !slices.Contains(muted, e) {
+			muted = append(muted, e)
+		}
+	}
+
+	subjects := []uuid.UUID{p.customerID}
+	for _, s := range in.Subjects {
+		if s != uuid.Nil && !slices.Contains(subjects, s) {
+			subjects = append(subjects, s)
+		}
+	}
+
+	p.email = in.Email
+	p.phone = in.Phone
+	p.pushToken = in.PushToken
+	p.channels = channels
+	p.mutedEvents = muted
+	p.subjects = subjects
+	return nil
+}
+
+func address(c valueobject.Channel, email, phone, pushToken string) string {
+	switch {
+	case c.Equal(valueobject.ChannelEmail):
+		return email
+	case c.Equal(valueobject.ChannelSMS):
+		return phone
+	case c.Equal(valueobject.ChannelPush):
+		return pushToken
+	default:
+		return ""
+	}
+}
+
+// RecipientsFor returns where an event of the given type is delivered: each
+// opted-in channel, unless the customer muted the event type.
+func (p ChannelPreference) RecipientsFor(eventType string) []Recipient {
+	if slices.Contains(p.mutedEvents, eventType) {
+		return nil
+	}
+	recipients := make([]Recipient, 0, len(p.channels))
+	for _, c := range p.channels {
+		recipients = append(recipients, Recipient{Channel: c, Address: address(c, p.email, p.phone, p.pushToken)})
+	}
+	return recipients
+}
+
+// --- Accessors ---
+
+func (p ChannelPreference) TenantID() uuid.UUID             { return p.tenantID }
+func (p ChannelPreference) CustomerID() uuid.UUID           { return p.customerID }
+func (p ChannelPreference) Email() string                   { return p.email }
+func (p ChannelPreference) Phone() string                   { return p.phone }
+func (p ChannelPreference) PushToken() string               { return p.pushToken }
+func (p ChannelPreference) Channels() []valueobject.Channel { return p.channels }
+func (p ChannelPreference) MutedEvents() []string           { return p.mutedEvents }
+func (p ChannelPreference) Subjects() []uuid.UUID           { return p.subjects }
+func (p ChannelPreference) Version() int                    { return p.version }
+func (p ChannelPreference) UpdatedAt() time.Time            { return p.updatedAt }
@@ -0,0 +1,50 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+func TestChannelPreference_RecipientsFor(t *testing.T) {
+	customerID, accountID := uuid.New(), uuid.New()
+	pref, err := model.NewChannelPreference(uuid.New(), customerID, model.ChannelPreferenceInput{
+		Email:       "ada@example.com",
+		Phone:       "+447700900123",
+		Channels:    []valueobject.Channel{valueobject.ChannelEmail, valueobject.ChannelSMS, valueobject.ChannelEmail},
+		MutedEvents: []string{"fx.rate.updated"},
+		Subjects:    []uuid.UUID{accountID},
+	}, time.Now().UTC())
+	require.NoError(t, err)
+
+	assert.Equal(t, []uuid.UUID{customerID, accountID}, pref.Subjects(), "the customer is always a subject")
+	assert.Equal(t, []model.Recipient{
+		{Channel: valueobject.ChannelEmail, Address: "ada@example.com"},
+		{Channel: valueobject.ChannelSMS, Address: "+447700900123"},
+	}, pref.RecipientsFor("payment.completed"))
+	assert.Empty(t, pref.RecipientsFor("fx.rate.updated"), "muted events are not delivered")
+}
+
+func TestNewChannelPreference_Validation(t *testing.T) {
+	now := time.Now().UTC()
+	tests := []struct {
+		name string
+		in   model.ChannelPreferenceInput
+	}{
+		{"invalid email", model.ChannelPreferenceInput{Email: "not-an-email"}},
+		{"phone not in E.164", model.ChannelPreferenceInput{Phone: "07700 900123"}},
+		{"channel without address", model.ChannelPreferenceInput{Channels: []valueobject.Channel{valueobject.ChannelPush}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := model.NewChannelPreference(uuid.New(), uuid.New(), tt.in, now)
+			assert.Error(t, err)
+		})
+	}
+}
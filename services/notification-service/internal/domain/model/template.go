@@ -0,0 +1,170 @@
+package model
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+// Message is a rendered notification: a subject line for email and push,
+// and the body every channel carries.
+type Message struct {
+	Subject string
+	Body    string
+}
+
+// Template is a tenant's wording of the notification sent on one channel
+// when an event of one type occurs. Subject and body are Go text/template
+// sources rendered against the event's fields, e.g. "Your account
+// {{.account_number}} is now active". A tenant without a template for an
+// event type and channel is not notified on that channel. A marketing
+// template is only sent to customers who consent to marketing on its channel.
+type Template struct {
+	createdAt time.Time
+	updatedAt time.Time
+	eventType string
+	subject   string
+	body      string
+	channel   valueobject.Channel
+	version   int
+	id        uuid.UUID
+	tenantID  uuid.UUID
+	marketing bool
+}
+
+// NewTemplate creates a template, validating that its subject and body parse.
+func NewTemplate(tenantID uuid.UUID, eventType string, channel valueobject.Channel, subject, body string, marketing bool, now time.Time) (Template, error) {
+	if tenantID == uuid.Nil {
+		return Template{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	if eventType == "" {
+		return Template{}, fmt.Errorf("event type must not be empty")
+	}
+	if channel.IsZero() {
+		return Template{}, fmt.Errorf("channel must not be empty")
+	}
+	t := Template{
+		id:        uuid.New(),
+		tenantID:  tenantID,
+		eventType: eventType,
+		channel:   channel,
+		marketing: marketing,
+		version:   1,
+		createdAt: now,
+		updatedAt: now,
+	}
+	if err := t.setContent(subject, body); err != nil {
+		return Template{}, err
+	}
+	return t, nil
+}
+
+// ReconstructTemplate recreates a Template from persisted data.
+func ReconstructTemplate(
+	id uuid.UUID,
+	tenantID uuid.UUID,
+	eventType string,
+	channel valueobject.Channel,
+	subject string,
+	body string,
+	marketing bool,
+	version int,
+	createdAt time.Time,
+	updatedAt time.Time,
+) Template {
+	return Template{
+		id:        id,
+		tenantID:  tenantID,
+		eventType: eventType,
+		channel:   channel,
+		subject:   subject,
+		body:      body,
+		marketing: marketing,
+		version:   version,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// Revise replaces the template's subject and body, and whether it is marketing.
+func (t Template) Revise(subject, body string, marketing bool, now time.Time) (Template, error) {
+	if err := t.setContent(subject, body); err != nil {
+		return t, err
+	}
+	t.marketing = marketing
+	t.version++
+	t.updatedAt = now
+	return t, nil
+}
+
+func (t *Template) setContent(subject, body string) error {
+	if strings.TrimSpace(body) == "" {
+		return fmt.Errorf("template body must not be empty")
+	}
+	if t.channel.Equal(valueobject.ChannelEmail) && strings.TrimSpace(subject) == "" {
+		return fmt.Errorf("email templates require a subject")
+	}
+	if _, err := parseTemplate("subject", subject); err != nil {
+		return err
+	}
+	if _, err := parseTemplate("body", body); err != nil {
+		return err
+	}
+	t.subject = subject
+	t.body = body
+	return nil
+}
+
+// Render renders the template against an event's fields. A field the
+// template uses that the event lacks is an error, so customers never see a
+// half-filled message.
+func (t Template) Render(data map[string]any) (Message, error) {
+	subject, err := render("subject", t.subject, data)
+	if err != nil {
+		return Message{}, err
+	}
+	body, err := render("body", t.body, data)
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{Subject: subject, Body: body}, nil
+}
+
+func parseTemplate(name, source string) (*template.Template, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template %s: %w", name, err)
+	}
+	return tmpl, nil
+}
+
+func render(name, source string, data map[string]any) (string, error) {
+	tmpl, err := parseTemplate(name, source)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render template %s: %w", name, err)
+	}
+	return buf.String(), nil
+}
+
+// --- Accessors ---
+
+func (t Template) ID() uuid.UUID                { return t.id }
+func (t Template) TenantID() uuid.UUID          { return t.tenantID }
+func (t Template) EventType() string            { return t.eventType }
+func (t Template) Channel() valueobject.Channel { return t.channel }
+func (t Template) Subject() string              { return t.subject }
+func (t Template) Body() string                 { return t.body }
+func (t Template) Marketing() bool              { return t.marketing }
+func (t Template) Version() int                 { return t.version }
+func (t Template) CreatedAt() time.Time         { return t.createdAt }
+func (t Template) UpdatedAt() time.Time         { return t.updatedAt }
@@ -0,0 +1,50 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+func TestTemplate_Render(t *testing.T) {
+	tmpl, err := model.NewTemplate(uuid.New(), "payment.completed", valueobject.ChannelEmail,
+		"Payment of {{.amount}} {{.currency}} sent", "Your payment {{.payment_id}} has completed.", false, time.Now().UTC())
+	require.NoError(t, err)
+
+	msg, err := tmpl.Render(map[string]any{"amount": "25.00", "currency": "EUR", "payment_id": "p-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "Payment of 25.00 EUR sent", msg.Subject)
+	assert.Equal(t, "Your payment p-1 has completed.", msg.Body)
+
+	_, err = tmpl.Render(map[string]any{"amount": "25.00"})
+	assert.Error(t, err, "fields the event lacks fail rendering")
+}
+
+func TestNewTemplate_Validation(t *testing.T) {
+	now := time.Now().UTC()
+	tenantID := uuid.New()
+
+	_, err := model.NewTemplate(tenantID, "payment.completed", valueobject.ChannelEmail, "", "body", false, now)
+	assert.Error(t, err, "email requires a subject")
+
+	_, err = model.NewTemplate(tenantID, "payment.completed", valueobject.ChannelSMS, "", "{{.amount", false, now)
+	assert.Error(t, err, "the body must parse")
+
+	_, err = model.NewTemplate(tenantID, "", valueobject.ChannelSMS, "", "body", false, now)
+	assert.Error(t, err)
+
+	tmpl, err := model.NewTemplate(tenantID, "payment.completed", valueobject.ChannelSMS, "", "Sent {{.amount}}", false, now)
+	require.NoError(t, err)
+	revised, err := tmpl.Revise("", "Paid {{.amount}}", true, now)
+	require.NoError(t, err)
+	assert.Equal(t, 2, revised.Version())
+	assert.True(t, revised.Marketing())
+	assert.Equal(t, "Paid {{.amount}}", revised.Body())
+	assert.Equal(t, tmpl.ID(), revised.ID())
+}
@@ -0,0 +1,113 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/event"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+// ErrTemplateNotFound is returned when a tenant has no template for an event
+// type and channel.
+var ErrTemplateNotFound = errors.New("notification template not found")
+
+// ErrPreferenceNotFound is returned when a customer has no channel preferences.
+var ErrPreferenceNotFound = errors.New("channel preference not found")
+
+// ErrNotificationNotFound is returned when a notification does not exist.
+var ErrNotificationNotFound = errors.New("notification not found")
+
+// ErrPermanentFailure marks a delivery error that retrying cannot fix, such
+// as an invalid phone number. Providers wrap it; the notification fails
+// without further attempts.
+var ErrPermanentFailure = errors.New("permanent delivery failure")
+
+// TemplateRepository defines the persistence port for notification templates.
+type TemplateRepository interface {
+	// Save persists a template. It uses upsert to handle both create and update.
+	Save(ctx context.Context, template model.Template) error
+
+	// Find retrieves a tenant's template for an event type and channel.
+	Find(ctx context.Context, tenantID uuid.UUID, eventType string, channel valueobject.Channel) (model.Template, error)
+
+	// ListByTenant retrieves all of a tenant's templates.
+	ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]model.Template, error)
+}
+
+// PreferenceRepository defines the persistence port for customer channel preferences.
+type PreferenceRepository interface {
+	// Save persists a customer's preferences. It uses upsert to handle both create and update.
+	Save(ctx context.Context, preference model.ChannelPreference) error
+
+	// Find retrieves a customer's preferences.
+	Find(ctx context.Context, tenantID, customerID uuid.UUID) (model.ChannelPreference, error)
+
+	// FindBySubjects retrieves the preferences of every customer notified
+	// about any of the given subjects.
+	FindBySubjects(ctx context.Context, tenantID uuid.UUID, subjects []uuid.UUID) ([]model.ChannelPreference, error)
+}
+
+// NotificationRepository defines the persistence port for notifications.
+type NotificationRepository interface {
+	// Create persists a new notification. It returns false, without error,
+	// when the customer already has a notification of the same event on the
+	// same channel, so redelivered events are not notified twice.
+	Create(ctx context.Context, notification model.Notification) (bool, error)
+
+	// Save persists changes to an existing notification.
+	Save(ctx context.Context, notification model.Notification) error
+
+	// FindByID retrieves a tenant's notification.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Notification, error)
+
+	// ListByCustomer retrieves a customer's most recent notifications.
+	ListByCustomer(ctx context.Context, tenantID, customerID uuid.UUID, limit int) ([]model.Notification, error)
+
+	// ClaimDue returns up to limit PENDING notifications due by now and
+	// pushes their next attempt back by lease, so no other worker claims them
+	// while they are being delivered and a crashed worker's claims are retried.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]model.Notification, error)
+}
+
+// EventPublisher defines the port for publishing domain events.
+type EventPublisher interface {
+	// Publish sends domain events to the event bus.
+	Publish(ctx context.Context, events []event.DomainEvent) error
+}
+
+// ConsentChecker asks the consent service whether a customer's consent stands.
+type ConsentChecker interface {
+	// MarketingConsented reports whether the customer consents to marketing
+	// on the channel.
+	MarketingConsented(ctx context.Context, tenantID, customerID uuid.UUID, channel valueobject.Channel) (bool, error)
+}
+
+// OutboundMessage is a notification as handed to a delivery provider.
+type OutboundMessage struct {
+	// IdempotencyKey is stable across retries of the same notification, for
+	// providers that deduplicate.
+	IdempotencyKey string
+	To             string
+	Subject        string
+	Body           string
+}
+
+// Provider delivers notifications on one channel, e.g. SMTP for email,
+// Twilio for SMS or a push gateway.
+type Provider interface {
+	// Name identifies the provider in delivery records.
+	Name() string
+
+	// Channel is the channel the provider delivers on.
+	Channel() valueobject.Channel
+
+	// Send hands the message to the provider and returns the provider's
+	// message ID, if it assigns one. Errors wrapping ErrPermanentFailure
+	// are not retried.
+	Send(ctx context.Context, msg OutboundMessage) (string, error)
+}
@@ -0,0 +1,51 @@
+package valueobject
+
+import "fmt"
+
+// Channel is the medium a notification is delivered through.
+// It is an immutable value object.
+type Channel struct {
+	value string
+}
+
+const (
+	channelEmail = "EMAIL"
+	channelSMS   = "SMS"
+	channelPush  = "PUSH"
+)
+
+var (
+	ChannelEmail = Channel{value: channelEmail}
+	ChannelSMS   = Channel{value: channelSMS}
+	ChannelPush  = Channel{value: channelPush}
+)
+
+var validChannels = map[string]Channel{
+	channelEmail: ChannelEmail,
+	channelSMS:   ChannelSMS,
+	channelPush:  ChannelPush,
+}
+
+// NewChannel creates a Channel from a string, validating it is known.
+func NewChannel(s string) (Channel, error) {
+	c, ok := validChannels[s]
+	if !ok {
+		return Channel{}, fmt.Errorf("invalid channel: %q", s)
+	}
+	return c, nil
+}
+
+// String returns the string representation of the Channel.
+func (c Channel) String() string {
+	return c.value
+}
+
+// IsZero returns true if the Channel has not been set.
+func (c Channel) IsZero() bool {
+	return c.value == ""
+}
+
+// Equal returns true if two Channel values are equal.
+func (c Channel) Equal(other Channel) bool {
+	return c.value == other.value
+}
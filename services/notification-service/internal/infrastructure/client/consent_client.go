@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	consentv1 "github.com/bibbank/bib/api/gen/go/bib/consent/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+// marketingConsentTypes maps each channel to the consent that covers
+// marketing on it.
+var marketingConsentTypes = map[valueobject.Channel]consentv1.ConsentType{
+	valueobject.ChannelEmail: consentv1.ConsentType_CONSENT_TYPE_MARKETING_EMAIL,
+	valueobject.ChannelSMS:   consentv1.ConsentType_CONSENT_TYPE_MARKETING_SMS,
+	valueobject.ChannelPush:  consentv1.ConsentType_CONSENT_TYPE_MARKETING_PUSH,
+}
+
+// ConsentGRPCClient asks the consent service whether a customer consents to
+// marketing. It implements port.ConsentChecker. Events are handled with no
+// caller token to forward, so each call carries a short-lived auditor token
+// for the tenant, signed with the platform's issuing key. Without a signer
+// calls go unauthenticated.
+type ConsentGRPCClient struct {
+	client  consentv1.ConsentServiceClient
+	signer  *auth.JWTService
+	timeout time.Duration
+}
+
+// NewConsentGRPCClient creates a new ConsentGRPCClient. signer may be nil;
+// timeout bounds each call when it is positive.
+func NewConsentGRPCClient(conn grpc.ClientConnInterface, signer *auth.JWTService, timeout time.Duration) *ConsentGRPCClient {
+	return &ConsentGRPCClient{client: consentv1.NewConsentServiceClient(conn), signer: signer, timeout: timeout}
+}
+
+// MarketingConsented reports whether the customer consents to marketing on
+// the channel.
+func (c *ConsentGRPCClient) MarketingConsented(ctx context.Context, tenantID, customerID uuid.UUID, channel valueobject.Channel) (bool, error) {
+	consentType, ok := marketingConsentTypes[channel]
+	if !ok {
+		return false, fmt.Errorf("no marketing consent for channel %q", channel)
+	}
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	if c.signer != nil {
+		token, err := c.signer.GenerateToken(uuid.Nil, tenantID, []string{auth.RoleAuditor})
+		if err != nil {
+			return false, fmt.Errorf("mint service token: %w", err)
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	}
+
+	resp, err := c.client.CheckConsent(ctx, &consentv1.CheckConsentRequest{
+		CustomerId: customerID.String(),
+		Type:       consentType,
+	})
+	if err != nil {
+		return false, fmt.Errorf("check marketing consent of %s: %w", customerID, err)
+	}
+	return resp.GetGranted(), nil
+}
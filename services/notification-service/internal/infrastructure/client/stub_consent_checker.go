@@ -0,0 +1,24 @@
+package client
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+// StubConsentChecker is a development adapter that treats no customer as
+// consenting to marketing, for running without the consent service; marketing
+// templates are then never sent. It implements port.ConsentChecker.
+type StubConsentChecker struct{}
+
+// NewStubConsentChecker creates a new stub adapter.
+func NewStubConsentChecker() *StubConsentChecker {
+	return &StubConsentChecker{}
+}
+
+// MarketingConsented always reports no consent.
+func (StubConsentChecker) MarketingConsented(context.Context, uuid.UUID, uuid.UUID, valueobject.Channel) (bool, error) {
+	return false, nil
+}
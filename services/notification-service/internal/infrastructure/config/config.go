@@ -0,0 +1,178 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type DatabaseConfig struct {
+	Host     string
+	User     string
+	Password string
+	Name     string
+	SSLMode  string
+	Port     int
+}
+
+type KafkaConfig struct {
+	ConsumerGroup string
+	Brokers       []string
+	// Topics consumed for events to notify about; every service's domain
+	// event topics when empty.
+	Topics []string
+}
+
+// DispatchConfig configures delivery of queued notifications. Failed
+// deliveries are retried up to MaxAttempts times in all, backing off
+// exponentially from BaseBackoffSeconds up to MaxBackoffSeconds.
+type DispatchConfig struct {
+	PollIntervalSeconds int
+	BatchSize           int
+	MaxAttempts         int
+	BaseBackoffSeconds  int
+	MaxBackoffSeconds   int
+	LeaseSeconds        int
+}
+
+// SMTPConfig configures email delivery; emails are logged, not sent, when
+// Host is empty.
+type SMTPConfig struct {
+	Host     string
+	Username string
+	Password string
+	From     string
+	Port     int
+}
+
+// TwilioConfig configures SMS delivery; messages are logged, not sent, when
+// AccountSID is empty.
+type TwilioConfig struct {
+	AccountSID string
+	AuthToken  string
+	From       string
+	BaseURL    string
+}
+
+// PushConfig configures push delivery through an HTTP push gateway; pushes
+// are logged, not sent, when URL is empty.
+type PushConfig struct {
+	URL    string
+	APIKey string
+}
+
+// ConsentConfig configures the consent checks made before sending marketing
+// templates. Without a consent service no marketing is sent. Calls are
+// authorized with tenant tokens signed by the key in SigningKeyFile; the JWT
+// secret is used when it is empty.
+type ConsentConfig struct {
+	GRPCAddr       string
+	SigningKeyFile string
+	TimeoutSeconds int
+}
+
+type Config struct {
+	DB          DatabaseConfig
+	ServiceName string
+	Kafka       KafkaConfig
+	SMTP        SMTPConfig
+	Twilio      TwilioConfig
+	Push        PushConfig
+	Dispatch    DispatchConfig
+	Consent     ConsentConfig
+	GRPCPort    int
+	HTTPPort    int
+}
+
+func (c Config) Validate() {
+	if c.DB.Password == "" {
+		panic("DB_PASSWORD environment variable is required")
+	}
+}
+
+func Load() Config {
+	return Config{
+		GRPCPort: getEnvInt("GRPC_PORT", 9091),
+		HTTPPort: getEnvInt("HTTP_PORT", 8091),
+		DB: DatabaseConfig{
+			Host:     getEnv("DB_HOST", "localhost"),
+			Port:     getEnvInt("DB_PORT", 5432),
+			User:     getEnv("DB_USER", "bib"),
+			Password: getEnv("DB_PASSWORD", ""),
+			Name:     getEnv("DB_NAME", "bib_notification"),
+			SSLMode:  getEnv("DB_SSLMODE", "require"),
+		},
+		Kafka: KafkaConfig{
+			Brokers:       []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+			ConsumerGroup: getEnv("KAFKA_CONSUMER_GROUP", "notification-service"),
+			Topics:        getEnvList("NOTIFICATION_TOPICS"),
+		},
+		Dispatch: DispatchConfig{
+			PollIntervalSeconds: getEnvInt("NOTIFICATION_POLL_INTERVAL_SECONDS", 5),
+			BatchSize:           getEnvInt("NOTIFICATION_BATCH_SIZE", 50),
+			MaxAttempts:         getEnvInt("NOTIFICATION_MAX_ATTEMPTS", 5),
+			BaseBackoffSeconds:  getEnvInt("NOTIFICATION_BASE_BACKOFF_SECONDS", 30),
+			MaxBackoffSeconds:   getEnvInt("NOTIFICATION_MAX_BACKOFF_SECONDS", 3600),
+			LeaseSeconds:        getEnvInt("NOTIFICATION_LEASE_SECONDS", 120),
+		},
+		SMTP: SMTPConfig{
+			Host:     getEnv("SMTP_HOST", ""),
+			Port:     getEnvInt("SMTP_PORT", 587),
+			Username: getEnv("SMTP_USERNAME", ""),
+			Password: getEnv("SMTP_PASSWORD", ""),
+			From:     getEnv("SMTP_FROM", "no-reply@bib.local"),
+		},
+		Twilio: TwilioConfig{
+			AccountSID: getEnv("TWILIO_ACCOUNT_SID", ""),
+			AuthToken:  getEnv("TWILIO_AUTH_TOKEN", ""),
+			From:       getEnv("TWILIO_FROM_NUMBER", ""),
+			BaseURL:    getEnv("TWILIO_BASE_URL", "https://api.twilio.com"),
+		},
+		Push: PushConfig{
+			URL:    getEnv("PUSH_GATEWAY_URL", ""),
+			APIKey: getEnv("PUSH_GATEWAY_API_KEY", ""),
+		},
+		Consent: ConsentConfig{
+			GRPCAddr:       getEnv("CONSENT_SERVICE_ADDR", ""),
+			SigningKeyFile: getEnv("NOTIFICATION_SIGNING_KEY_FILE", ""),
+			TimeoutSeconds: getEnvInt("CONSENT_TIMEOUT_SECONDS", 5),
+		},
+		ServiceName: "notification-service",
+	}
+}
+
+func (c Config) GRPCAddr() string {
+	return fmt.Sprintf(":%d", c.GRPCPort)
+}
+
+func (c Config) HTTPAddr() string {
+	return fmt.Sprintf(":%d", c.HTTPPort)
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) int {
+	if v := os.Getenv(key); v != "" {
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+	return fallback
+}
+
+// getEnvList reads a comma-separated list, ignoring blank entries.
+func getEnvList(key string) []string {
+	var list []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
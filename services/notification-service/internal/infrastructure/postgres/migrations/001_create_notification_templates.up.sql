@@ -0,0 +1,13 @@
+-- Tenants' wording of the notification sent on each channel for each event type.
+CREATE TABLE IF NOT EXISTS notification_templates (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    channel VARCHAR(10) NOT NULL,
+    subject TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, event_type, channel)
+);
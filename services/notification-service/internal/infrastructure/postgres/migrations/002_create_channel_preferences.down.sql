@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_channel_preferences_subjects;
+DROP TABLE IF EXISTS channel_preferences;
@@ -0,0 +1,17 @@
+-- Customers' addresses and channel opt-ins. Subjects are the accounts,
+-- applications and other aggregates whose events the customer hears about.
+CREATE TABLE IF NOT EXISTS channel_preferences (
+    tenant_id UUID NOT NULL,
+    customer_id UUID NOT NULL,
+    email VARCHAR(320) NOT NULL DEFAULT '',
+    phone VARCHAR(20) NOT NULL DEFAULT '',
+    push_token TEXT NOT NULL DEFAULT '',
+    channels TEXT[] NOT NULL DEFAULT '{}',
+    muted_events TEXT[] NOT NULL DEFAULT '{}',
+    subjects UUID[] NOT NULL DEFAULT '{}',
+    version INT NOT NULL DEFAULT 1,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, customer_id)
+);
+
+CREATE INDEX idx_channel_preferences_subjects ON channel_preferences USING GIN (subjects);
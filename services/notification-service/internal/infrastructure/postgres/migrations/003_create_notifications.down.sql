@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_notifications_due;
+DROP INDEX IF EXISTS idx_notifications_customer_created;
+DROP TABLE IF EXISTS notifications;
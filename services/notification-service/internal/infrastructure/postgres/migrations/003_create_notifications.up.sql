@@ -0,0 +1,28 @@
+-- Notifications and their delivery. A customer gets at most one
+-- notification per event and channel.
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    customer_id UUID NOT NULL,
+    event_id VARCHAR(100) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    channel VARCHAR(10) NOT NULL,
+    recipient TEXT NOT NULL,
+    subject TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'PENDING',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    provider VARCHAR(50) NOT NULL DEFAULT '',
+    provider_message_id VARCHAR(255) NOT NULL DEFAULT '',
+    last_error TEXT NOT NULL DEFAULT '',
+    sent_at TIMESTAMPTZ,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (event_id, customer_id, channel)
+);
+
+CREATE INDEX idx_notifications_customer_created ON notifications (tenant_id, customer_id, created_at DESC);
+CREATE INDEX idx_notifications_due ON notifications (next_attempt_at)
+    WHERE status = 'PENDING';
@@ -0,0 +1 @@
+ALTER TABLE notification_templates DROP COLUMN IF EXISTS marketing;
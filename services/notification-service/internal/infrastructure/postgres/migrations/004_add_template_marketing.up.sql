@@ -0,0 +1,3 @@
+-- Marketing templates are only sent to customers consenting to marketing on
+-- the template's channel.
+ALTER TABLE notification_templates ADD COLUMN IF NOT EXISTS marketing BOOLEAN NOT NULL DEFAULT FALSE;
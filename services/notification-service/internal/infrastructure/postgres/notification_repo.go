@@ -0,0 +1,219 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+const notificationColumns = `
+	id, tenant_id, customer_id, event_id, event_type, channel, recipient,
+	subject, body, status, attempts, next_attempt_at, provider,
+	provider_message_id, last_error, sent_at, version, created_at, updated_at`
+
+// NotificationRepo is the PostgreSQL implementation of NotificationRepository.
+type NotificationRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewNotificationRepo creates a new NotificationRepo.
+func NewNotificationRepo(pool *pgxpool.Pool) *NotificationRepo {
+	return &NotificationRepo{pool: pool}
+}
+
+// Create persists a new notification, skipping it when the customer already
+// has a notification of the same event on the same channel.
+func (r *NotificationRepo) Create(ctx context.Context, n model.Notification) (bool, error) {
+	query := `
+		INSERT INTO notifications (` + notificationColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+		ON CONFLICT (event_id, customer_id, channel) DO NOTHING
+	`
+
+	tag, err := r.pool.Exec(ctx, query,
+		n.ID(),
+		n.TenantID(),
+		n.CustomerID(),
+		n.EventID(),
+		n.EventType(),
+		n.Channel().String(),
+		n.Recipient(),
+		n.Subject(),
+		n.Body(),
+		n.Status().String(),
+		n.Attempts(),
+		n.NextAttemptAt(),
+		n.Provider(),
+		n.ProviderMessageID(),
+		n.LastError(),
+		n.SentAt(),
+		n.Version(),
+		n.CreatedAt(),
+		n.UpdatedAt(),
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to create notification: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// Save persists changes to an existing notification's delivery.
+func (r *NotificationRepo) Save(ctx context.Context, n model.Notification) error {
+	query := `
+		UPDATE notifications SET
+			status = $2,
+			attempts = $3,
+			next_attempt_at = $4,
+			provider = $5,
+			provider_message_id = $6,
+			last_error = $7,
+			sent_at = $8,
+			version = $9,
+			updated_at = $10
+		WHERE id = $1
+	`
+
+	tag, err := r.pool.Exec(ctx, query,
+		n.ID(),
+		n.Status().String(),
+		n.Attempts(),
+		n.NextAttemptAt(),
+		n.Provider(),
+		n.ProviderMessageID(),
+		n.LastError(),
+		n.SentAt(),
+		n.Version(),
+		n.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save notification: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return port.ErrNotificationNotFound
+	}
+	return nil
+}
+
+// FindByID retrieves a tenant's notification.
+func (r *NotificationRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Notification, error) {
+	query := `SELECT ` + notificationColumns + `
+		FROM notifications
+		WHERE id = $1 AND tenant_id = $2
+	`
+
+	n, err := scanNotification(r.pool.QueryRow(ctx, query, id, tenantID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Notification{}, port.ErrNotificationNotFound
+	}
+	if err != nil {
+		return model.Notification{}, fmt.Errorf("failed to scan notification: %w", err)
+	}
+	return n, nil
+}
+
+// ListByCustomer retrieves a customer's most recent notifications.
+func (r *NotificationRepo) ListByCustomer(ctx context.Context, tenantID, customerID uuid.UUID, limit int) ([]model.Notification, error) {
+	query := `SELECT ` + notificationColumns + `
+		FROM notifications
+		WHERE tenant_id = $1 AND customer_id = $2
+		ORDER BY created_at DESC
+		LIMIT $3
+	`
+
+	return r.query(ctx, query, tenantID, customerID, limit)
+}
+
+// ClaimDue claims up to limit due PENDING notifications, skipping those other
+// workers hold, by pushing their next attempt back by the lease.
+func (r *NotificationRepo) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]model.Notification, error) {
+	query := `
+		UPDATE notifications SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM notifications
+			WHERE status = 'PENDING' AND next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + notificationColumns
+
+	return r.query(ctx, query, now, now.Add(lease), limit)
+}
+
+func (r *NotificationRepo) query(ctx context.Context, query string, args ...any) ([]model.Notification, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []model.Notification
+	for rows.Next() {
+		n, err := scanNotification(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notification row: %w", err)
+		}
+		notifications = append(notifications, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return notifications, nil
+}
+
+func scanNotification(row pgx.Row) (model.Notification, error) {
+	var (
+		id                uuid.UUID
+		tenantID          uuid.UUID
+		customerID        uuid.UUID
+		eventID           string
+		eventType         string
+		channelStr        string
+		recipient         string
+		subject           string
+		body              string
+		statusStr         string
+		attempts          int
+		nextAttemptAt     time.Time
+		provider          string
+		providerMessageID string
+		lastError         string
+		sentAt            *time.Time
+		version           int
+		createdAt         time.Time
+		updatedAt         time.Time
+	)
+
+	err := row.Scan(
+		&id, &tenantID, &customerID, &eventID, &eventType, &channelStr, &recipient,
+		&subject, &body, &statusStr, &attempts, &nextAttemptAt, &provider,
+		&providerMessageID, &lastError, &sentAt, &version, &createdAt, &updatedAt,
+	)
+	if err != nil {
+		return model.Notification{}, err
+	}
+
+	channel, err := valueobject.NewChannel(channelStr)
+	if err != nil {
+		return model.Notification{}, fmt.Errorf("invalid channel in database: %w", err)
+	}
+	status, err := valueobject.NewDeliveryStatus(statusStr)
+	if err != nil {
+		return model.Notification{}, fmt.Errorf("invalid delivery status in database: %w", err)
+	}
+
+	return model.ReconstructNotification(
+		id, tenantID, customerID, eventID, eventType, channel, recipient,
+		subject, body, status, attempts, nextAttemptAt, provider,
+		providerMessageID, lastError, sentAt, version, createdAt, updatedAt,
+	), nil
+}
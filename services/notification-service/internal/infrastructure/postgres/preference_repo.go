@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+const preferenceColumns = `
+	tenant_id, customer_id, email, phone, push_token, channels, muted_events,
+	subjects, version, updated_at`
+
+// PreferenceRepo is the PostgreSQL implementation of PreferenceRepository.
+type PreferenceRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewPreferenceRepo creates a new PreferenceRepo.
+func NewPreferenceRepo(pool *pgxpool.Pool) *PreferenceRepo {
+	return &PreferenceRepo{pool: pool}
+}
+
+// Save persists a customer's preferences. It uses upsert to handle both create and update.
+func (r *PreferenceRepo) Save(ctx context.Context, p model.ChannelPreference) error {
+	channels := make([]string, 0, len(p.Channels()))
+	for _, c := range p.Channels() {
+		channels = append(channels, c.String())
+	}
+
+	query := `
+		INSERT INTO channel_preferences (` + preferenceColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (tenant_id, customer_id) DO UPDATE SET
+			email = EXCLUDED.email,
+			phone = EXCLUDED.phone,
+			push_token = EXCLUDED.push_token,
+			channels = EXCLUDED.channels,
+			muted_events = EXCLUDED.muted_events,
+			subjects = EXCLUDED.subjects,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		p.TenantID(),
+		p.CustomerID(),
+		p.Email(),
+		p.Phone(),
+		p.PushToken(),
+		channels,
+		p.MutedEvents(),
+		p.Subjects(),
+		p.Version(),
+		p.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save channel preferences: %w", err)
+	}
+	return nil
+}
+
+// Find retrieves a customer's preferences.
+func (r *PreferenceRepo) Find(ctx context.Context, tenantID, customerID uuid.UUID) (model.ChannelPreference, error) {
+	query := `SELECT ` + preferenceColumns + `
+		FROM channel_preferences
+		WHERE tenant_id = $1 AND customer_id = $2
+	`
+
+	p, err := scanPreference(r.pool.QueryRow(ctx, query, tenantID, customerID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.ChannelPreference{}, port.ErrPreferenceNotFound
+	}
+	if err != nil {
+		return model.ChannelPreference{}, fmt.Errorf("failed to scan channel preferences: %w", err)
+	}
+	return p, nil
+}
+
+// FindBySubjects retrieves the preferences of every customer notified about
+// any of the given subjects.
+func (r *PreferenceRepo) FindBySubjects(ctx context.Context, tenantID uuid.UUID, subjects []uuid.UUID) ([]model.ChannelPreference, error) {
+	query := `SELECT ` + preferenceColumns + `
+		FROM channel_preferences
+		WHERE tenant_id = $1 AND subjects && $2
+		ORDER BY customer_id
+	`
+
+	rows, err := r.pool.Query(ctx, query, tenantID, subjects)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query channel preferences: %w", err)
+	}
+	defer rows.Close()
+
+	var preferences []model.ChannelPreference
+	for rows.Next() {
+		p, err := scanPreference(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan channel preferences row: %w", err)
+		}
+		preferences = append(preferences, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return preferences, nil
+}
+
+func scanPreference(row pgx.Row) (model.ChannelPreference, error) {
+	var (
+		tenantID    uuid.UUID
+		customerID  uuid.UUID
+		email       string
+		phone       string
+		pushToken   string
+		channelStrs []string
+		mutedEvents []string
+		subjects    []uuid.UUID
+		version     int
+		updatedAt   time.Time
+	)
+
+	err := row.Scan(&tenantID, &customerID, &email, &phone, &pushToken, &channelStrs, &mutedEvents,
+		&subjects, &version, &updatedAt)
+	if err != nil {
+		return model.ChannelPreference{}, err
+	}
+
+	channels := make([]valueobject.Channel, 0, len(channelStrs))
+	for _, s := range channelStrs {
+		c, err := valueobject.NewChannel(s)
+		if err != nil {
+			return model.ChannelPreference{}, fmt.Errorf("invalid channel in database: %w", err)
+		}
+		channels = append(channels, c)
+	}
+
+	return model.ReconstructChannelPreference(
+		tenantID, customerID, email, phone, pushToken, channels, mutedEvents, subjects, version, updatedAt,
+	), nil
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+const templateColumns = `id, tenant_id, event_type, channel, subject, body, marketing, version, created_at, updated_at`
+
+// TemplateRepo is the PostgreSQL implementation of TemplateRepository.
+type TemplateRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewTemplateRepo creates a new TemplateRepo.
+func NewTemplateRepo(pool *pgxpool.Pool) *TemplateRepo {
+	return &TemplateRepo{pool: pool}
+}
+
+// Save persists a template. It uses upsert to handle both create and update.
+func (r *TemplateRepo) Save(ctx context.Context, t model.Template) error {
+	query := `
+		INSERT INTO notification_templates (` + templateColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE SET
+			subject = EXCLUDED.subject,
+			body = EXCLUDED.body,
+			marketing = EXCLUDED.marketing,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		t.ID(),
+		t.TenantID(),
+		t.EventType(),
+		t.Channel().String(),
+		t.Subject(),
+		t.Body(),
+		t.Marketing(),
+		t.Version(),
+		t.CreatedAt(),
+		t.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save template: %w", err)
+	}
+	return nil
+}
+
+// Find retrieves a tenant's template for an event type and channel.
+func (r *TemplateRepo) Find(ctx context.Context, tenantID uuid.UUID, eventType string, channel valueobject.Channel) (model.Template, error) {
+	query := `SELECT ` + templateColumns + `
+		FROM notification_templates
+		WHERE tenant_id = $1 AND event_type = $2 AND channel = $3
+	`
+
+	t, err := scanTemplate(r.pool.QueryRow(ctx, query, tenantID, eventType, channel.String()))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Template{}, port.ErrTemplateNotFound
+	}
+	if err != nil {
+		return model.Template{}, fmt.Errorf("failed to scan template: %w", err)
+	}
+	return t, nil
+}
+
+// ListByTenant retrieves all of a tenant's templates.
+func (r *TemplateRepo) ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]model.Template, error) {
+	query := `SELECT ` + templateColumns + `
+		FROM notification_templates
+		WHERE tenant_id = $1
+		ORDER BY event_type, channel
+	`
+
+	rows, err := r.pool.Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []model.Template
+	for rows.Next() {
+		t, err := scanTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan template row: %w", err)
+		}
+		templates = append(templates, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return templates, nil
+}
+
+func scanTemplate(row pgx.Row) (model.Template, error) {
+	var (
+		id         uuid.UUID
+		tenantID   uuid.UUID
+		eventType  string
+		channelStr string
+		subject    string
+		body       string
+		marketing  bool
+		version    int
+		createdAt  time.Time
+		updatedAt  time.Time
+	)
+
+	if err := row.Scan(&id, &tenantID, &eventType, &channelStr, &subject, &body, &marketing, &version, &createdAt, &updatedAt); err != nil {
+		return model.Template{}, err
+	}
+
+	channel, err := valueobject.NewChannel(channelStr)
+	if err != nil {
+		return model.Template{}, fmt.Errorf("invalid channel in database: %w", err)
+	}
+
+	return model.ReconstructTemplate(id, tenantID, eventType, channel, subject, body, marketing, version, createdAt, updatedAt), nil
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+// LogProvider logs notifications instead of delivering them. It stands in
+// for a channel's provider in development and until one is configured.
+type LogProvider struct {
+	logger  *slog.Logger
+	channel valueobject.Channel
+}
+
+// NewLogProvider creates a new LogProvider for the channel.
+func NewLogProvider(channel valueobject.Channel, logger *slog.Logger) *LogProvider {
+	return &LogProvider{channel: channel, logger: logger}
+}
+
+// Name returns "log".
+func (p *LogProvider) Name() string { return "log" }
+
+// Channel returns the channel the provider stands in for.
+func (p *LogProvider) Channel() valueobject.Channel { return p.channel }
+
+// Send logs the message and reports it delivered.
+func (p *LogProvider) Send(ctx context.Context, msg port.OutboundMessage) (string, error) {
+	p.logger.InfoContext(ctx, "notification not delivered: no provider configured",
+		"channel", p.channel.String(),
+		"notification_id", msg.IdempotencyKey,
+		"subject", msg.Subject,
+	)
+	return "", nil
+}
@@ -0,0 +1,28 @@
+// Package provider holds the delivery providers notifications are sent
+// through: SMTP for email, Twilio for SMS and an HTTP push gateway, plus a
+// logging stand-in for channels without a configured provider.
+package provider
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+)
+
+// maxErrorBody bounds how much of an error response is kept on the notification.
+const maxErrorBody = 1 << 10
+
+// responseError turns a non-2xx provider response into an error. Client
+// errors other than throttling are permanent: the request will not succeed
+// however often it is retried.
+func responseError(provider string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody)) //nolint:errcheck // best-effort error detail
+	err := fmt.Errorf("%s returned %d: %s", provider, resp.StatusCode, strings.TrimSpace(string(body)))
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+		return fmt.Errorf("%w: %w", port.ErrPermanentFailure, err)
+	}
+	return err
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+)
+
+func TestTwilioProvider_Send(t *testing.T) {
+	var form map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/2010-04-01/Accounts/AC123/Messages.json", r.URL.Path)
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "AC123", user)
+		assert.Equal(t, "secret", pass)
+		require.NoError(t, r.ParseForm())
+		form = map[string]string{"To": r.PostForm.Get("To"), "From": r.PostForm.Get("From"), "Body": r.PostForm.Get("Body")}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"sid":"SM456"}`))
+	}))
+	defer srv.Close()
+
+	p := NewTwilioProvider(TwilioConfig{BaseURL: srv.URL + "/", AccountSID: "AC123", AuthToken: "secret", From: "+15005550006"}, srv.Client())
+	id, err := p.Send(context.Background(), port.OutboundMessage{IdempotencyKey: "n-1", To: "+447700900123", Body: "Payment sent"})
+	require.NoError(t, err)
+	assert.Equal(t, "SM456", id)
+	assert.Equal(t, map[string]string{"To": "+447700900123", "From": "+15005550006", "Body": "Payment sent"}, form)
+}
+
+func TestTwilioProvider_ClassifiesErrors(t *testing.T) {
+	status := http.StatusBadRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"message":"invalid 'To' phone number"}`))
+	}))
+	defer srv.Close()
+	p := NewTwilioProvider(TwilioConfig{BaseURL: srv.URL, AccountSID: "AC123", AuthToken: "secret"}, srv.Client())
+
+	_, err := p.Send(context.Background(), port.OutboundMessage{To: "+10000000000", Body: "x"})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, port.ErrPermanentFailure), "a rejected message is not retried")
+	assert.Contains(t, err.Error(), "invalid 'To' phone number")
+
+	for _, status = range []int{http.StatusTooManyRequests, http.StatusServiceUnavailable} {
+		_, err = p.Send(context.Background(), port.OutboundMessage{To: "+10000000000", Body: "x"})
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, port.ErrPermanentFailure), "status %d is retried", status)
+	}
+}
+
+func TestPushProvider_Send(t *testing.T) {
+	var got pushRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = w.Write([]byte(`{"id":"push-1"}`))
+	}))
+	defer srv.Close()
+
+	p := NewPushProvider(PushConfig{URL: srv.URL, APIKey: "key"}, srv.Client())
+	id, err := p.Send(context.Background(), port.OutboundMessage{IdempotencyKey: "n-1", To: "device", Subject: "Paid", Body: "Payment sent"})
+	require.NoError(t, err)
+	assert.Equal(t, "push-1", id)
+	assert.Equal(t, pushRequest{Token: "device", Title: "Paid", Body: "Payment sent", IdempotencyKey: "n-1"}, got)
+}
+
+func TestSMTPProvider_Send(t *testing.T) {
+	p := NewSMTPProvider(SMTPConfig{Host: "smtp.example.com", Port: 587, Username: "user", Password: "pass", From: "BIB <no-reply@bib.local>"})
+	var (
+		gotAddr string
+		gotTo   []string
+		gotMsg  string
+	)
+	p.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotTo, gotMsg = addr, to, string(msg)
+		assert.NotNil(t, a)
+		assert.Equal(t, "no-reply@bib.local", from)
+		return nil
+	}
+
+	id, err := p.Send(context.Background(), port.OutboundMessage{IdempotencyKey: "n-1", To: "ada@example.com", Subject: "Paid", Body: "Line one\nLine two"})
+	require.NoError(t, err)
+	assert.Equal(t, "<n-1@smtp.example.com>", id)
+	assert.Equal(t, "smtp.example.com:587", gotAddr)
+	assert.Equal(t, []string{"ada@example.com"}, gotTo)
+	assert.Contains(t, gotMsg, "Message-ID: <n-1@smtp.example.com>\r\n")
+	assert.True(t, strings.HasSuffix(gotMsg, "\r\n\r\nLine one\r\nLine two"))
+
+	p.sendMail = func(string, smtp.Auth, string, []string, []byte) error {
+		return &textproto.Error{Code: 550, Msg: "mailbox unavailable"}
+	}
+	_, err = p.Send(context.Background(), port.OutboundMessage{IdempotencyKey: "n-2", To: "ada@example.com", Body: "x"})
+	assert.True(t, errors.Is(err, port.ErrPermanentFailure))
+
+	p.sendMail = func(string, smtp.Auth, string, []string, []byte) error {
+		return &textproto.Error{Code: 421, Msg: "try again later"}
+	}
+	_, err = p.Send(context.Background(), port.OutboundMessage{IdempotencyKey: "n-3", To: "ada@example.com", Body: "x"})
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, port.ErrPermanentFailure))
+
+	_, err = p.Send(context.Background(), port.OutboundMessage{IdempotencyKey: "n-4", To: "not an address", Body: "x"})
+	assert.True(t, errors.Is(err, port.ErrPermanentFailure))
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+// PushConfig configures the push gateway provider.
+type PushConfig struct {
+	// URL is the gateway endpoint pushes are posted to.
+	URL    string
+	APIKey string
+}
+
+// PushProvider delivers push notifications through an HTTP push gateway
+// fronting APNs and FCM. Each push is posted as
+// {"token", "title", "body", "idempotency_key"}; the gateway answers with
+// {"id"}.
+type PushProvider struct {
+	client *http.Client
+	cfg    PushConfig
+}
+
+// NewPushProvider creates a new PushProvider.
+func NewPushProvider(cfg PushConfig, client *http.Client) *PushProvider {
+	return &PushProvider{cfg: cfg, client: client}
+}
+
+// Name returns "push-gateway".
+func (p *PushProvider) Name() string { return "push-gateway" }
+
+// Channel returns the push channel.
+func (p *PushProvider) Channel() valueobject.Channel { return valueobject.ChannelPush }
+
+type pushRequest struct {
+	Token          string `json:"token"`
+	Title          string `json:"title,omitempty"`
+	Body           string `json:"body"`
+	IdempotencyKey string `json:"idempotency_key"`
+}
+
+type pushResponse struct {
+	ID string `json:"id"`
+}
+
+// Send posts the push to the device token and returns the gateway's ID for it.
+func (p *PushProvider) Send(ctx context.Context, msg port.OutboundMessage) (string, error) {
+	payload, err := json.Marshal(pushRequest{
+		Token:          msg.To,
+		Title:          msg.Subject,
+		Body:           msg.Body,
+		IdempotencyKey: msg.IdempotencyKey,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal push: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.cfg.URL, bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("failed to build push request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if p.cfg.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.cfg.APIKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("push gateway request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }() //nolint:errcheck // response already consumed
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", responseError("push gateway", resp)
+	}
+	var out pushResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", fmt.Errorf("failed to decode push gateway response: %w", err)
+	}
+	return out.ID, nil
+}
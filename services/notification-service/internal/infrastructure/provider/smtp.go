@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+// SMTPConfig configures the SMTP email provider.
+type SMTPConfig struct {
+	Host     string
+	Username string
+	Password string
+	From     string
+	Port     int
+}
+
+// sendMailFunc matches smtp.SendMail.
+type sendMailFunc func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+
+// SMTPProvider delivers email through an SMTP relay, authenticating with
+// PLAIN auth when a username is configured. The relay must offer STARTTLS
+// for credentials to be sent.
+type SMTPProvider struct {
+	sendMail sendMailFunc
+	cfg      SMTPConfig
+}
+
+// NewSMTPProvider creates a new SMTPProvider.
+func NewSMTPProvider(cfg SMTPConfig) *SMTPProvider {
+	return &SMTPProvider{cfg: cfg, sendMail: smtp.SendMail}
+}
+
+// Name returns "smtp".
+func (p *SMTPProvider) Name() string { return "smtp" }
+
+// Channel returns the email channel.
+func (p *SMTPProvider) Channel() valueobject.Channel { return valueobject.ChannelEmail }
+
+// Send relays the email. The notification's idempotency key becomes the
+// Message-ID, which is also returned as the provider's message ID.
+func (p *SMTPProvider) Send(_ context.Context, msg port.OutboundMessage) (string, error) {
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid recipient %q: %w", port.ErrPermanentFailure, msg.To, err)
+	}
+	from, err := mail.ParseAddress(p.cfg.From)
+	if err != nil {
+		return "", fmt.Errorf("invalid sender %q: %w", p.cfg.From, err)
+	}
+
+	messageID := fmt.Sprintf("<%s@%s>", msg.IdempotencyKey, p.cfg.Host)
+	var buf bytes.Buffer
+	for _, h := range [][2]string{
+		{"From", from.String()},
+		{"To", to.String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", msg.Subject)},
+		{"Date", time.Now().UTC().Format(time.RFC1123Z)},
+		{"Message-ID", messageID},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "text/plain; charset=utf-8"},
+		{"Content-Transfer-Encoding", "8bit"},
+	} {
+		fmt.Fprintf(&buf, "%s: %s\r\n", h[0], h[1])
+	}
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	var auth smtp.Auth
+	if p.cfg.Username != "" {
+		auth = smtp.PlainAuth("", p.cfg.Username, p.cfg.Password, p.cfg.Host)
+	}
+	addr := net.JoinHostPort(p.cfg.Host, strconv.Itoa(p.cfg.Port))
+	if err := p.sendMail(addr, auth, from.Address, []string{to.Address}, buf.Bytes()); err != nil {
+		var protoErr *textproto.Error
+		if errors.As(err, &protoErr) && protoErr.Code >= 500 {
+			return "", fmt.Errorf("%w: smtp: %w", port.ErrPermanentFailure, err)
+		}
+		return "", fmt.Errorf("smtp: %w", err)
+	}
+	return messageID, nil
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+// TwilioConfig configures the Twilio SMS provider.
+type TwilioConfig struct {
+	// BaseURL is the Twilio REST API root, https://api.twilio.com.
+	BaseURL    string
+	AccountSID string
+	AuthToken  string
+	// From is the Twilio number messages are sent from, in E.164 format.
+	From string
+}
+
+// TwilioProvider delivers SMS through the Twilio Messages API.
+type TwilioProvider struct {
+	client *http.Client
+	cfg    TwilioConfig
+}
+
+// NewTwilioProvider creates a new TwilioProvider.
+func NewTwilioProvider(cfg TwilioConfig, client *http.Client) *TwilioProvider {
+	cfg.BaseURL = strings.TrimSuffix(cfg.BaseURL, "/")
+	return &TwilioProvider{cfg: cfg, client: client}
+}
+
+// Name returns "twilio".
+func (p *TwilioProvider) Name() string { return "twilio" }
+
+// Channel returns the SMS channel.
+func (p *TwilioProvider) Channel() valueobject.Channel { return valueobject.ChannelSMS }
+
+type twilioMessageResponse struct {
+	SID string `json:"sid"`
+}
+
+// Send creates a message and returns its Twilio SID.
+func (p *TwilioProvider) Send(ctx context.Context, msg port.OutboundMessage) (string, error) {
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", p.cfg.BaseURL, url.PathEscape(p.cfg.AccountSID))
+	form := url.Values{
+		"To":   {msg.To},
+		"From": {p.cfg.From},
+		"Body": {msg.Body},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to build twilio request: %w", err)
+	}
+	req.SetBasicAuth(p.cfg.AccountSID, p.cfg.AuthToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("twilio request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }() //nolint:errcheck // response already consumed
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", responseError("twilio", resp)
+	}
+	var out twilioMessageResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", fmt.Errorf("failed to decode twilio response: %w", err)
+	}
+	return out.SID, nil
+}
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	notificationv1 "github.com/bibbank/bib/api/gen/go/bib/notification/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/notification-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractTemplateRepo has no templates, so every upsert creates one.
+type contractTemplateRepo struct{}
+
+func (contractTemplateRepo) Save(context.Context, model.Template) error { return nil }
+
+func (contractTemplateRepo) Find(context.Context, uuid.UUID, string, valueobject.Channel) (model.Template, error) {
+	return model.Template{}, port.ErrTemplateNotFound
+}
+
+func (contractTemplateRepo) ListByTenant(context.Context, uuid.UUID) ([]model.Template, error) {
+	return nil, nil
+}
+
+// contractPreferenceRepo holds at most one customer's preferences.
+type contractPreferenceRepo struct {
+	pref *model.ChannelPreference
+}
+
+func (r *contractPreferenceRepo) Save(context.Context, model.ChannelPreference) error { return nil }
+
+func (r *contractPreferenceRepo) Find(context.Context, uuid.UUID, uuid.UUID) (model.ChannelPreference, error) {
+	if r.pref == nil {
+		return model.ChannelPreference{}, port.ErrPreferenceNotFound
+	}
+	return *r.pref, nil
+}
+
+func (r *contractPreferenceRepo) FindBySubjects(context.Context, uuid.UUID, []uuid.UUID) ([]model.ChannelPreference, error) {
+	return nil, nil
+}
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	prefs := &contractPreferenceRepo{}
+	h := NewNotificationServiceHandler(
+		usecase.NewUpsertTemplateUseCase(contractTemplateRepo{}), nil, nil,
+		usecase.NewGetPreferencesUseCase(prefs), nil, nil,
+		slog.Default(),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { notificationv1.RegisterNotificationServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-notification-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { prefs.pref = nil },
+		"the customer set channel preferences": func(t *testing.T) {
+			email, err := valueobject.NewChannel("EMAIL")
+			if err != nil {
+				t.Fatal(err)
+			}
+			pref, err := model.NewChannelPreference(contractTenantID, uuid.New(), model.ChannelPreferenceInput{
+				Email:    "ada@example.com",
+				Channels: []valueobject.Channel{email},
+			}, time.Now().UTC())
+			if err != nil {
+				t.Fatal(err)
+			}
+			prefs.pref = &pref
+		},
+	})
+}
@@ -0,0 +1,394 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	notificationv1 "github.com/bibbank/bib/api/gen/go/bib/notification/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/notification-service/internal/application/dto"
+	"github.com/bibbank/bib/services/notification-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/port"
+)
+
+// requireRole checks that the caller has at least one of the given roles.
+func requireRole(ctx context.Context, roles ...string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "insufficient permissions")
+}
+
+// tenantIDFromContext extracts the tenant ID from JWT claims in the context.
+func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return claims.TenantID, nil
+}
+
+// customerIDFromRequest resolves the customer a request is about. Staff may
+// name any customer of their tenant; customers only ever act on themselves,
+// and default to themselves when they name no one.
+func customerIDFromRequest(ctx context.Context, requested string) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	staff := claims.HasRole(auth.RoleAdmin) || claims.HasRole(auth.RoleOperator) || claims.HasRole(auth.RoleAPIClient)
+	if requested == "" {
+		if staff {
+			return uuid.Nil, status.Error(codes.InvalidArgument, "customer_id is required")
+		}
+		return claims.UserID, nil
+	}
+	customerID, err := uuid.Parse(requested)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid customer_id")
+	}
+	if !staff && customerID != claims.UserID {
+		return uuid.Nil, status.Error(codes.PermissionDenied, "customers may only access their own notifications")
+	}
+	return customerID, nil
+}
+
+// Compile-time assertion that NotificationServiceHandler implements NotificationServiceServer.
+var _ notificationv1.NotificationServiceServer = (*NotificationServiceHandler)(nil)
+
+// NotificationServiceHandler implements the gRPC NotificationServiceServer interface.
+type NotificationServiceHandler struct {
+	notificationv1.UnimplementedNotificationServiceServer
+	upsertTemplateUC    *usecase.UpsertTemplateUseCase
+	listTemplatesUC     *usecase.ListTemplatesUseCase
+	setPreferencesUC    *usecase.SetPreferencesUseCase
+	getPreferencesUC    *usecase.GetPreferencesUseCase
+	getNotificationUC   *usecase.GetNotificationUseCase
+	listNotificationsUC *usecase.ListNotificationsUseCase
+	logger              *slog.Logger
+}
+
+// NewNotificationServiceHandler creates a new NotificationServiceHandler.
+func NewNotificationServiceHandler(
+	upsertTemplateUC *usecase.UpsertTemplateUseCase,
+	listTemplatesUC *usecase.ListTemplatesUseCase,
+	setPreferencesUC *usecase.SetPreferencesUseCase,
+	getPreferencesUC *usecase.GetPreferencesUseCase,
+	getNotificationUC *usecase.GetNotificationUseCase,
+	listNotificationsUC *usecase.ListNotificationsUseCase,
+	logger *slog.Logger,
+) *NotificationServiceHandler {
+	return &NotificationServiceHandler{
+		upsertTemplateUC:    upsertTemplateUC,
+		listTemplatesUC:     listTemplatesUC,
+		setPreferencesUC:    setPreferencesUC,
+		getPreferencesUC:    getPreferencesUC,
+		getNotificationUC:   getNotificationUC,
+		listNotificationsUC: listNotificationsUC,
+		logger:              logger,
+	}
+}
+
+// UpsertTemplate handles the gRPC request to create or revise a template.
+func (h *NotificationServiceHandler) UpsertTemplate(ctx context.Context, req *notificationv1.UpsertTemplateRequest) (*notificationv1.UpsertTemplateResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.upsertTemplateUC.Execute(ctx, dto.UpsertTemplateRequest{
+		TenantID:  tenantID,
+		EventType: req.EventType,
+		Channel:   channelFromProto(req.Channel),
+		Subject:   req.Subject,
+		Body:      req.Body,
+		Marketing: req.Marketing,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &notificationv1.UpsertTemplateResponse{Template: toTemplateMsg(resp)}, nil
+}
+
+// ListTemplates handles the gRPC request to list the tenant's templates.
+func (h *NotificationServiceHandler) ListTemplates(ctx context.Context, req *notificationv1.ListTemplatesRequest) (*notificationv1.ListTemplatesResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := h.listTemplatesUC.Execute(ctx, tenantID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	resp := &notificationv1.ListTemplatesResponse{Templates: make([]*notificationv1.Template, 0, len(templates))}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, toTemplateMsg(t))
+	}
+	return resp, nil
+}
+
+// SetPreferences handles the gRPC request to replace a customer's channel preferences.
+func (h *NotificationServiceHandler) SetPreferences(ctx context.Context, req *notificationv1.SetPreferencesRequest) (*notificationv1.SetPreferencesResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	customerID, err := customerIDFromRequest(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+
+	subjects := make([]uuid.UUID, 0, len(req.Subjects))
+	for _, s := range req.Subjects {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid subject %q", s)
+		}
+		subjects = append(subjects, id)
+	}
+
+	resp, err := h.setPreferencesUC.Execute(ctx, dto.SetPreferencesRequest{
+		TenantID:    tenantID,
+		CustomerID:  customerID,
+		Email:       req.Email,
+		Phone:       req.Phone,
+		PushToken:   req.PushToken,
+		Channels:    channelsFromProto(req.Channels),
+		MutedEvents: req.MutedEvents,
+		Subjects:    subjects,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &notificationv1.SetPreferencesResponse{Preferences: toPreferencesMsg(resp)}, nil
+}
+
+// GetPreferences handles the gRPC request to retrieve a customer's channel preferences.
+func (h *NotificationServiceHandler) GetPreferences(ctx context.Context, req *notificationv1.GetPreferencesRequest) (*notificationv1.GetPreferencesResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	customerID, err := customerIDFromRequest(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.getPreferencesUC.Execute(ctx, tenantID, customerID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &notificationv1.GetPreferencesResponse{Preferences: toPreferencesMsg(resp)}, nil
+}
+
+// GetNotification handles the gRPC request to retrieve a notification and its delivery.
+func (h *NotificationServiceHandler) GetNotification(ctx context.Context, req *notificationv1.GetNotificationRequest) (*notificationv1.GetNotificationResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.NotificationId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid notification ID")
+	}
+
+	resp, err := h.getNotificationUC.Execute(ctx, tenantID, id)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &notificationv1.GetNotificationResponse{Notification: toNotificationMsg(resp)}, nil
+}
+
+// ListNotifications handles the gRPC request to list a customer's notifications.
+func (h *NotificationServiceHandler) ListNotifications(ctx context.Context, req *notificationv1.ListNotificationsRequest) (*notificationv1.ListNotificationsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	customerID, err := customerIDFromRequest(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications, err := h.listNotificationsUC.Execute(ctx, tenantID, customerID, int(req.Limit))
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	resp := &notificationv1.ListNotificationsResponse{Notifications: make([]*notificationv1.Notification, 0, len(notifications))}
+	for _, n := range notifications {
+		resp.Notifications = append(resp.Notifications, toNotificationMsg(n))
+	}
+	return resp, nil
+}
+
+// toStatus maps use case errors to gRPC statuses.
+func (h *NotificationServiceHandler) toStatus(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidTemplate), errors.Is(err, usecase.ErrInvalidPreferences):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, port.ErrPreferenceNotFound):
+		return status.Error(codes.NotFound, "channel preferences not found")
+	case errors.Is(err, port.ErrNotificationNotFound):
+		return status.Error(codes.NotFound, "notification not found")
+	default:
+		h.logger.Error("handler error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func toTemplateMsg(t dto.TemplateResponse) *notificationv1.Template {
+	return &notificationv1.Template{
+		TemplateId: t.ID.String(),
+		EventType:  t.EventType,
+		Channel:    channelToProto(t.Channel),
+		Subject:    t.Subject,
+		Body:       t.Body,
+		Marketing:  t.Marketing,
+		Version:    int32(t.Version), //nolint:gosec // versions are small
+		CreatedAt:  timestamppb.New(t.CreatedAt),
+		UpdatedAt:  timestamppb.New(t.UpdatedAt),
+	}
+}
+
+func toPreferencesMsg(p dto.PreferencesResponse) *notificationv1.ChannelPreferences {
+	subjects := make([]string, 0, len(p.Subjects))
+	for _, s := range p.Subjects {
+		subjects = append(subjects, s.String())
+	}
+	channels := make([]notificationv1.Channel, 0, len(p.Channels))
+	for _, c := range p.Channels {
+		channels = append(channels, channelToProto(c))
+	}
+	return &notificationv1.ChannelPreferences{
+		CustomerId:  p.CustomerID.String(),
+		Email:       p.Email,
+		Phone:       p.Phone,
+		PushToken:   p.PushToken,
+		Channels:    channels,
+		MutedEvents: p.MutedEvents,
+		Subjects:    subjects,
+		Version:     int32(p.Version), //nolint:gosec // versions are small
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+	}
+}
+
+func toNotificationMsg(n dto.NotificationResponse) *notificationv1.Notification {
+	msg := &notificationv1.Notification{
+		NotificationId:    n.ID.String(),
+		CustomerId:        n.CustomerID.String(),
+		EventId:           n.EventID,
+		EventType:         n.EventType,
+		Channel:           channelToProto(n.Channel),
+		Recipient:         n.Recipient,
+		Subject:           n.Subject,
+		Body:              n.Body,
+		Status:            notificationv1.DeliveryStatus(notificationv1.DeliveryStatus_value["DELIVERY_STATUS_"+n.Status]),
+		Attempts:          int32(n.Attempts), //nolint:gosec // bounded by the retry policy
+		Provider:          n.Provider,
+		ProviderMessageId: n.ProviderMessageID,
+		LastError:         n.LastError,
+		CreatedAt:         timestamppb.New(n.CreatedAt),
+		UpdatedAt:         timestamppb.New(n.UpdatedAt),
+	}
+	if n.Status == "PENDING" {
+		msg.NextAttemptAt = timestamppb.New(n.NextAttemptAt)
+	}
+	if n.SentAt != nil {
+		msg.SentAt = timestamppb.New(*n.SentAt)
+	}
+	return msg
+}
+
+// channelToProto maps a domain channel name, such as EMAIL, to its enum.
+func channelToProto(c string) notificationv1.Channel {
+	return notificationv1.Channel(notificationv1.Channel_value["CHANNEL_"+c])
+}
+
+// channelFromProto maps a channel enum to its domain name. An unspecified
+// channel maps to "", which the use cases reject.
+func channelFromProto(c notificationv1.Channel) string {
+	if c == notificationv1.Channel_CHANNEL_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(c.String(), "CHANNEL_")
+}
+
+func channelsFromProto(cs []notificationv1.Channel) []string {
+	channels := make([]string, 0, len(cs))
+	for _, c := range cs {
+		channels = append(channels, channelFromProto(c))
+	}
+	return channels
+}
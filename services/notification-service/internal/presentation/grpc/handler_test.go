@@ -0,0 +1,16 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	notificationv1 "github.com/bibbank/bib/api/gen/go/bib/notification/v1"
+)
+
+func TestChannelMapping(t *testing.T) {
+	assert.Equal(t, notificationv1.Channel_CHANNEL_PUSH, channelToProto("PUSH"))
+	assert.Equal(t, "SMS", channelFromProto(notificationv1.Channel_CHANNEL_SMS))
+	// Unspecified channels reach the use cases empty, which reject them.
+	assert.Equal(t, "", channelFromProto(notificationv1.Channel_CHANNEL_UNSPECIFIED))
+}
@@ -0,0 +1,85 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/model"
+	"github.com/bibbank/bib/services/notification-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/notification-service/internal/infrastructure/postgres"
+)
+
+func newNotification(t *testing.T, tenantID, customerID uuid.UUID, eventID string, channel valueobject.Channel, now time.Time) model.Notification {
+	t.Helper()
+	n, err := model.NewNotification(tenantID, customerID, eventID, "payment.order.settled",
+		model.Recipient{Channel: channel, Address: "ada@example.com"},
+		model.Message{Subject: "Payment sent", Body: "Your payment of $25.50 was sent."}, now)
+	require.NoError(t, err)
+	return n
+}
+
+func TestNotificationRepo_CreateIsIdempotentPerEventAndChannel(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewNotificationRepo(env.Pool())
+	ctx := context.Background()
+	tenantID, customerID := uuid.New(), uuid.New()
+	eventID := uuid.NewString()
+	now := time.Now().UTC()
+
+	created, err := repo.Create(ctx, newNotification(t, tenantID, customerID, eventID, valueobject.ChannelEmail, now))
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	created, err = repo.Create(ctx, newNotification(t, tenantID, customerID, eventID, valueobject.ChannelEmail, now))
+	require.NoError(t, err)
+	assert.False(t, created, "a redelivered event does not notify twice")
+
+	created, err = repo.Create(ctx, newNotification(t, tenantID, customerID, eventID, valueobject.ChannelSMS, now))
+	require.NoError(t, err)
+	assert.True(t, created, "each channel is notified once")
+
+	list, err := repo.ListByCustomer(ctx, tenantID, customerID, 10)
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+}
+
+func TestNotificationRepo_ClaimDueLeasesPendingNotifications(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewNotificationRepo(env.Pool())
+	ctx := context.Background()
+	tenantID, customerID := uuid.New(), uuid.New()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	due := newNotification(t, tenantID, customerID, uuid.NewString(), valueobject.ChannelEmail, now.Add(-time.Minute))
+	_, err := repo.Create(ctx, due)
+	require.NoError(t, err)
+	sent := newNotification(t, tenantID, customerID, uuid.NewString(), valueobject.ChannelEmail, now.Add(-time.Minute))
+	_, err = repo.Create(ctx, sent)
+	require.NoError(t, err)
+	sent, err = sent.MarkSent("ses", "msg-1", now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, sent))
+
+	claimed, err := repo.ClaimDue(ctx, now, time.Minute, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1, "sent notifications are not claimed")
+	assert.Equal(t, due.ID(), claimed[0].ID())
+
+	again, err := repo.ClaimDue(ctx, now, time.Minute, 10)
+	require.NoError(t, err)
+	assert.Empty(t, again, "a claimed notification is leased until its next attempt")
+
+	found, err := repo.FindByID(ctx, tenantID, sent.ID())
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.DeliveryStatusSent, found.Status())
+	assert.Equal(t, "msg-1", found.ProviderMessageID())
+	require.NotNil(t, found.SentAt())
+}
@@ -0,0 +1,361 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/bibbank/bib/pkg/auth"
+	pkgkafka "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
+	"github.com/bibbank/bib/pkg/lock"
+	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
+	pkgpostgres "github.com/bibbank/bib/pkg/postgres"
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/openbanking-service/internal/infrastructure/client"
+	"github.com/bibbank/bib/services/openbanking-service/internal/infrastructure/config"
+	"github.com/bibbank/bib/services/openbanking-service/internal/infrastructure/eidas"
+	"github.com/bibbank/bib/services/openbanking-service/internal/infrastructure/postgres"
+	grpcpresentation "github.com/bibbank/bib/services/openbanking-service/internal/presentation/grpc"
+	"github.com/bibbank/bib/services/openbanking-service/internal/presentation/rest"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// Load configuration.
+	cfg := config.Load()
+
+	// Initialize structured logger via shared observability package.
+	logger := observability.InitLogger(observability.LogConfig{
+		Level:  "info",
+		Format: "json",
+	})
+	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
+
+	logger.Info("starting openbanking-service",
+		"http_port", cfg.HTTPPort,
+		"grpc_port", cfg.GRPCPort,
+		"tpp_port", cfg.TPP.Port,
+	)
+
+	// Initialize tracing.
+	shutdown, err := observability.InitTracer(ctx, observability.TracingConfig{
+		ServiceName: cfg.ServiceName,
+		Endpoint:    getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4317"),
+		Insecure:    true,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
+	} else {
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
+	}
+
+	// Database connection.
+	dbCtx, dbCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer dbCancel()
+
+	pool, err := pkgpostgres.NewPool(dbCtx, pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	})
+	if err != nil {
+		logger.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
+	logger.Info("connected to database")
+
+	// Run database migrations.
+	migDSN := pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	}.DSN()
+	if migErr := pkgpostgres.RunMigrations(migDSN, "file://internal/infrastructure/postgres/migrations"); migErr != nil {
+		logger.Warn("migration warning", "error", migErr)
+	}
+
+	// Wire infrastructure adapters.
+	consentRepo := postgres.NewConsentRepo(pool)
+	providerRepo := postgres.NewProviderRepo(pool)
+	kafkaProducer := pkgkafka.NewProducer(pkgkafka.Config{
+		Brokers: cfg.Kafka.Brokers,
+	})
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(kafkaProducer.Close))
+
+	// Outbox relay: events are written to the outbox and published from it,
+	// so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     outbox.StaticRoute("openbanking-events"),
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: cfg.ServiceName,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, kafkaProducer, relayCfg, logger)
+	if err != nil {
+		logger.Error("failed to create outbox relay", "error", err)
+		os.Exit(1)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+	eventPublisher := postgres.NewOutboxPublisher(outbox.NewPublisher(outboxStore), "openbanking-events")
+
+	// JWT service for gRPC auth (validation-only: public key preferred, secret as fallback).
+	jwtCfg := auth.JWTConfig{
+		Issuer: "bib-gateway",
+	}
+	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
+	case os.Getenv("JWT_PUBLIC_KEY") != "":
+		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
+	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
+		keyData, loadErr := auth.LoadKeyFromFile(os.Getenv("JWT_PUBLIC_KEY_FILE"))
+		if loadErr != nil {
+			logger.Error("failed to load JWT public key file", "error", loadErr)
+			os.Exit(1)
+		}
+		jwtCfg.PublicKeyPEM = string(keyData)
+	default:
+		jwtSecret := os.Getenv("JWT_SECRET")
+		if jwtSecret == "" {
+			jwtSecret = "test-e2e-secret" // Match gateway default for E2E tests
+		}
+		jwtCfg.Secret = jwtSecret
+	}
+	jwtSvc, err := auth.NewJWTService(jwtCfg)
+	if err != nil {
+		logger.Error("failed to initialize JWT service", "error", err)
+		os.Exit(1)
+	}
+
+	// Calls to the account, ledger and payment services carry tenant tokens
+	// signed like the gateway's, since a provider's request carries none.
+	var signer *auth.JWTService
+	switch {
+	case cfg.Upstream.SigningKeyFile != "":
+		keyData, loadErr := auth.LoadKeyFromFile(cfg.Upstream.SigningKeyFile)
+		if loadErr != nil {
+			logger.Error("failed to load open banking signing key file", "error", loadErr)
+			os.Exit(1)
+		}
+		signer, err = auth.NewJWTService(auth.JWTConfig{
+			PrivateKeyPEM: string(keyData),
+			Issuer:        "bib-gateway",
+			Expiration:    15 * time.Minute,
+		})
+	case jwtCfg.Secret != "":
+		signer, err = auth.NewJWTService(auth.JWTConfig{
+			Secret:     jwtCfg.Secret,
+			Issuer:     "bib-gateway",
+			Expiration: 15 * time.Minute,
+		})
+	default:
+		err = fmt.Errorf("OPENBANKING_SIGNING_KEY_FILE is required when JWT_SECRET is not set")
+	}
+	if err != nil {
+		logger.Error("failed to initialize open banking token signer", "error", err)
+		os.Exit(1)
+	}
+	clientConfig := client.Config{
+		MaxRetries:   cfg.Upstream.MaxRetries,
+		RetryBackoff: cfg.Upstream.RetryBackoff,
+		Timeout:      cfg.Upstream.Timeout,
+	}
+	dial := func(name, addr string) *grpc.ClientConn {
+		conn, dialErr := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if dialErr != nil {
+			logger.Error("failed to create "+name+" service client", "addr", addr, "error", dialErr)
+			os.Exit(1)
+		}
+		return conn
+	}
+	accountConn := dial("account", cfg.Upstream.AccountGRPCAddr)
+	lc.OnStop(lifecycle.PhaseResources, "account client", lifecycle.Close(accountConn.Close))
+	ledgerConn := dial("ledger", cfg.Upstream.LedgerGRPCAddr)
+	lc.OnStop(lifecycle.PhaseResources, "ledger client", lifecycle.Close(ledgerConn.Close))
+	paymentConn := dial("payment", cfg.Upstream.PaymentGRPCAddr)
+	lc.OnStop(lifecycle.PhaseResources, "payment client", lifecycle.Close(paymentConn.Close))
+	accountClient := client.NewAccountGRPCClient(accountConn, signer, clientConfig)
+	ledgerClient := client.NewLedgerGRPCClient(ledgerConn, signer, clientConfig)
+	paymentClient := client.NewPaymentGRPCClient(paymentConn, signer, clientConfig)
+
+	// Wire use cases.
+	identifyProviderUC := usecase.NewIdentifyProviderUseCase(providerRepo, eventPublisher)
+	listProvidersUC := usecase.NewListProvidersUseCase(providerRepo)
+	setProviderStatusUC := usecase.NewSetProviderStatusUseCase(providerRepo, eventPublisher)
+	createAccountConsentUC := usecase.NewCreateAccountConsentUseCase(consentRepo, eventPublisher)
+	createPaymentConsentUC := usecase.NewCreatePaymentConsentUseCase(consentRepo, eventPublisher)
+	getConsentUC := usecase.NewGetConsentUseCase(consentRepo)
+	listConsentsUC := usecase.NewListConsentsUseCase(consentRepo)
+	authoriseConsentUC := usecase.NewAuthoriseConsentUseCase(consentRepo, accountClient, paymentClient, eventPublisher, logger)
+	rejectConsentUC := usecase.NewRejectConsentUseCase(consentRepo, eventPublisher)
+	revokeConsentUC := usecase.NewRevokeConsentUseCase(consentRepo, eventPublisher)
+	terminateConsentUC := usecase.NewTerminateConsentUseCase(consentRepo, eventPublisher)
+	expireConsentsUC := usecase.NewExpireConsentsUseCase(consentRepo, eventPublisher, cfg.Consents.AuthorisationTTL, logger)
+	listAccountsUC := usecase.NewListAccountsUseCase(consentRepo, accountClient)
+	getAccountUC := usecase.NewGetAccountUseCase(consentRepo, accountClient)
+	getBalancesUC := usecase.NewGetBalancesUseCase(consentRepo, accountClient, ledgerClient)
+	getTransactionsUC := usecase.NewGetTransactionsUseCase(consentRepo, paymentClient)
+	getPaymentUC := usecase.NewGetPaymentUseCase(consentRepo, paymentClient)
+	executePendingPaymentsUC := usecase.NewExecutePendingPaymentsUseCase(consentRepo, paymentClient, eventPublisher, logger)
+
+	// Expire lapsed consents and submit the payments whose submission on
+	// authorisation failed, on the elected replica only.
+	elector := lock.NewElector(lock.NewPostgresLocker(pool), "openbanking.sweep", lock.ElectorConfig{}, logger)
+	lc.Go(lifecycle.PhaseWorkers, "consent sweep", func(ctx context.Context) error {
+		elector.Run(ctx, func(ctx context.Context) {
+			ticker := time.NewTicker(cfg.Consents.SweepInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case now := <-ticker.C:
+					expired, expireErr := expireConsentsUC.Execute(ctx, now.UTC())
+					if expireErr != nil {
+						logger.Error("consent expiry failed", "error", expireErr)
+					}
+					if expired > 0 {
+						logger.Info("consents expired", "count", expired)
+					}
+					submitted, submitErr := executePendingPaymentsUC.Execute(ctx, now.UTC())
+					if submitErr != nil {
+						logger.Error("pending payment submission failed", "error", submitErr)
+					}
+					if submitted > 0 {
+						logger.Info("pending payments submitted", "count", submitted)
+					}
+				}
+			}
+		})
+		return nil
+	})
+
+	// gRPC server.
+	grpcHandler := grpcpresentation.NewHandler(
+		getConsentUC, listConsentsUC, authoriseConsentUC, rejectConsentUC, revokeConsentUC,
+		listProvidersUC, setProviderStatusUC, logger,
+	)
+	grpcServer, err := grpcpresentation.NewServer(grpcHandler, logger, jwtSvc, relayCfg.MeterProvider)
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
+
+	// HTTP server (health checks and metrics).
+	healthHandler := rest.NewHealthHandler(logger)
+	httpMux := http.NewServeMux()
+	healthHandler.RegisterRoutes(httpMux)
+	if metricsHandler != nil {
+		httpMux.Handle("/metrics", metricsHandler)
+	}
+
+	httpServer := &http.Server{
+		Addr:              cfg.HTTPAddr(),
+		Handler:           httpMux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// PSD2 API server. Providers authenticate with eIDAS certificates, so
+	// it only runs with the trust anchors to verify them against.
+	var tppServer *http.Server
+	if cfg.TPP.TrustAnchorsFile != "" {
+		verifier, verifierErr := eidas.LoadVerifier(cfg.TPP.TrustAnchorsFile, cfg.TPP.TrustForwardedCert)
+		if verifierErr != nil {
+			logger.Error("failed to load eIDAS trust anchors", "error", verifierErr)
+			os.Exit(1)
+		}
+		tppHandler := rest.NewTPPHandler(verifier,
+			identifyProviderUC, createAccountConsentUC, getConsentUC, terminateConsentUC,
+			listAccountsUC, getAccountUC, getBalancesUC, getTransactionsUC,
+			createPaymentConsentUC, getPaymentUC, logger,
+		)
+		tppMux := http.NewServeMux()
+		tppHandler.RegisterRoutes(tppMux)
+		tppServer = &http.Server{
+			Addr:              cfg.TPPAddr(),
+			Handler:           tppMux,
+			ReadHeaderTimeout: 10 * time.Second,
+			TLSConfig: &tls.Config{
+				MinVersion: tls.VersionTLS12,
+				ClientAuth: tls.RequireAndVerifyClientCert,
+				ClientCAs:  verifier.Roots(),
+			},
+		}
+	} else {
+		logger.Warn("OPENBANKING_EIDAS_TRUST_ANCHORS_FILE not set, PSD2 API disabled")
+	}
+
+	// Start servers.
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", func(context.Context) error {
+		return grpcServer.Start(cfg.GRPCAddr())
+	})
+	lc.OnStop(lifecycle.PhaseIngress, "gRPC server", lifecycle.Func(grpcServer.Stop))
+	lc.ServeHTTP("HTTP server", httpServer)
+
+	if tppServer != nil {
+		if cfg.TPP.TLSCertFile != "" {
+			lc.Go(lifecycle.PhaseIngress, "PSD2 API server", func(context.Context) error {
+				logger.Info("PSD2 API server starting with mutual TLS", "addr", cfg.TPPAddr())
+				if err := tppServer.ListenAndServeTLS(cfg.TPP.TLSCertFile, cfg.TPP.TLSKeyFile); !errors.Is(err, http.ErrServerClosed) {
+					return err
+				}
+				return nil
+			})
+			lc.OnStop(lifecycle.PhaseIngress, "PSD2 API server", tppServer.Shutdown)
+		} else {
+			logger.Info("PSD2 API server behind a TLS-terminating proxy")
+			lc.ServeHTTP("PSD2 API server", tppServer)
+		}
+	}
+
+	logger.Info("openbanking-service is running",
+		"grpc_addr", cfg.GRPCAddr(),
+		"http_addr", cfg.HTTPAddr(),
+	)
+
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
+	}
+	logger.Info("openbanking-service stopped")
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
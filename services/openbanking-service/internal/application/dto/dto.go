@@ -0,0 +1,230 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// IdentifyProviderRequest is what a TPP's verified eIDAS certificate says
+// about it, for the tenant whose API it calls.
+type IdentifyProviderRequest struct {
+	OrganizationID         string
+	Name                   string
+	NCAName                string
+	NCAID                  string
+	CertificateFingerprint string
+	Roles                  []string
+	TenantID               uuid.UUID
+}
+
+// ProviderResponse is a third party provider.
+type ProviderResponse struct {
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+	OrganizationID         string
+	Name                   string
+	NCAName                string
+	NCAID                  string
+	CertificateFingerprint string
+	Status                 string
+	StatusReason           string
+	Roles                  []string
+	Version                int
+	ID                     uuid.UUID
+	TenantID               uuid.UUID
+}
+
+// ListProvidersRequest is the input for listing a tenant's providers.
+type ListProvidersRequest struct {
+	Limit    int
+	Offset   int
+	TenantID uuid.UUID
+}
+
+// ListProvidersResponse is one page of providers.
+type ListProvidersResponse struct {
+	Providers  []ProviderResponse
+	TotalCount int
+}
+
+// SetProviderStatusRequest suspends a provider, with a reason, or
+// reactivates it.
+type SetProviderStatusRequest struct {
+	Reason     string
+	Suspend    bool
+	TenantID   uuid.UUID
+	ProviderID uuid.UUID
+}
+
+// CreateAccountConsentRequest is a TPP's request for access to a PSU's
+// accounts. Without AccountIDs the PSU chooses the accounts; a zero
+// ValidUntil asks for as long as allowed.
+type CreateAccountConsentRequest struct {
+	ValidUntil      time.Time
+	Permissions     []string
+	AccountIDs      []uuid.UUID
+	FrequencyPerDay int
+	Recurring       bool
+	TenantID        uuid.UUID
+	ProviderID      uuid.UUID
+}
+
+// PaymentDetails is the payment a payment consent stands for. The creditor
+// is either CreditorAccountID, an account at the bank, or an external
+// account identified by CreditorAccountNumber and CreditorRoutingNumber.
+type PaymentDetails struct {
+	Amount                decimal.Decimal
+	CreditorAccountID     *uuid.UUID
+	Product               string
+	CreditorAccountNumber string
+	CreditorRoutingNumber string
+	CreditorName          string
+	CreditorCountry       string
+	Currency              string
+	RemittanceInformation string
+	DebtorAccountID       uuid.UUID
+}
+
+// CreatePaymentConsentRequest is a TPP's request to initiate a payment.
+type CreatePaymentConsentRequest struct {
+	Payment    PaymentDetails
+	TenantID   uuid.UUID
+	ProviderID uuid.UUID
+}
+
+// ConsentResponse is a consent. Payment is set for payment consents and
+// ValidUntil for account consents.
+type ConsentResponse struct {
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	ValidUntil      *time.Time
+	AuthorisedAt    *time.Time
+	PSUID           *uuid.UUID
+	PaymentID       *uuid.UUID
+	Payment         *PaymentDetails
+	Type            string
+	Status          string
+	StatusReason    string
+	Permissions     []string
+	AccountIDs      []uuid.UUID
+	FrequencyPerDay int
+	Version         int
+	Recurring       bool
+	ID              uuid.UUID
+	TenantID        uuid.UUID
+	ProviderID      uuid.UUID
+}
+
+// GetConsentRequest looks up a consent. When ProviderID is set only that
+// TPP's consents are found; when PSUID is set only consents that PSU may
+// see.
+type GetConsentRequest struct {
+	ProviderID *uuid.UUID
+	PSUID      *uuid.UUID
+	TenantID   uuid.UUID
+	ConsentID  uuid.UUID
+}
+
+// ListConsentsRequest is the input for listing a tenant's consents. Zero
+// fields do not filter.
+type ListConsentsRequest struct {
+	ProviderID *uuid.UUID
+	PSUID      *uuid.UUID
+	Status     string
+	Limit      int
+	Offset     int
+	TenantID   uuid.UUID
+}
+
+// ListConsentsResponse is one page of consents.
+type ListConsentsResponse struct {
+	Consents   []ConsentResponse
+	TotalCount int
+}
+
+// AuthoriseConsentRequest is a PSU's authorisation of a consent, for the
+// accounts chosen or, when none are, for those the TPP asked for.
+type AuthoriseConsentRequest struct {
+	AccountIDs []uuid.UUID
+	TenantID   uuid.UUID
+	ConsentID  uuid.UUID
+	PSUID      uuid.UUID
+}
+
+// CloseConsentRequest rejects, revokes or terminates a consent. PSUID is
+// set when the PSU acts and ProviderID when the TPP does; neither is set
+// when staff act on the PSU's behalf.
+type CloseConsentRequest struct {
+	PSUID      *uuid.UUID
+	ProviderID *uuid.UUID
+	Reason     string
+	TenantID   uuid.UUID
+	ConsentID  uuid.UUID
+}
+
+// AccountAccessRequest is a TPP's read of an account under a consent, or
+// of the consent's accounts when AccountID is nil. PSUPresent is set when
+// the PSU is actively asking for the data, which does not count towards
+// the consent's daily limit.
+type AccountAccessRequest struct {
+	AccountID  *uuid.UUID
+	Limit      int
+	Offset     int
+	PSUPresent bool
+	TenantID   uuid.UUID
+	ProviderID uuid.UUID
+	ConsentID  uuid.UUID
+}
+
+// AccountResponse is an account as a TPP sees it.
+type AccountResponse struct {
+	Number     string
+	Type       string
+	Currency   string
+	Status     string
+	HolderName string
+	ID         uuid.UUID
+}
+
+// BalanceResponse is an account's current balance.
+type BalanceResponse struct {
+	ReferenceDate time.Time
+	Amount        decimal.Decimal
+	Currency      string
+	AccountID     uuid.UUID
+}
+
+// TransactionResponse is a payment to or from an account. Amount is
+// negative for debits. Counterparty is the other account's ID or number.
+type TransactionResponse struct {
+	ValueDate    time.Time
+	BookingDate  *time.Time
+	Amount       decimal.Decimal
+	Currency     string
+	Counterparty string
+	Reference    string
+	Description  string
+	Status       string
+	ID           uuid.UUID
+}
+
+// TransactionsResponse is one page of an account's transactions, split into
+// those booked and those still pending.
+type TransactionsResponse struct {
+	Booked     []TransactionResponse
+	Pending    []TransactionResponse
+	TotalCount int
+}
+
+// PaymentInitiationResponse is a payment consent and the status of its
+// payment as an ISO 20022 transaction status code: RCVD before the PSU
+// authorises it, ACTC once authorised, ACSP while the payment is under
+// way, ACSC once settled, RJCT if it was rejected or failed and CANC if it
+// was cancelled.
+type PaymentInitiationResponse struct {
+	Consent           ConsentResponse
+	TransactionStatus string
+	FailureReason     string
+}
@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/dto"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/model"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/valueobject"
+)
+
+// ListAccountsUseCase lists the accounts an account consent covers.
+type ListAccountsUseCase struct {
+	access   consentAccess
+	accounts port.AccountClient
+}
+
+// NewListAccountsUseCase creates a new ListAccountsUseCase.
+func NewListAccountsUseCase(consents port.ConsentRepository, accounts port.AccountClient) *ListAccountsUseCase {
+	return &ListAccountsUseCase{access: consentAccess{consents: consents}, accounts: accounts}
+}
+
+// Execute returns the consent's accounts. Accounts closed since the PSU
+// authorised the consent are left out.
+func (uc *ListAccountsUseCase) Execute(ctx context.Context, req dto.AccountAccessRequest) ([]dto.AccountResponse, error) {
+	req.AccountID = nil
+	consent, err := uc.access.record(ctx, req, valueobject.PermissionReadAccounts)
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]dto.AccountResponse, 0, len(consent.AccountIDs()))
+	for _, id := range consent.AccountIDs() {
+		account, err := uc.accounts.GetAccount(ctx, req.TenantID, id)
+		if errors.Is(err, port.ErrAccountNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get account %s: %w", id, err)
+		}
+		if account.Status == "CLOSED" {
+			continue
+		}
+		accounts = append(accounts, toAccountResponse(account))
+	}
+	return accounts, nil
+}
+
+// GetAccountUseCase retrieves an account a consent covers.
+type GetAccountUseCase struct {
+	access   consentAccess
+	accounts port.AccountClient
+}
+
+// NewGetAccountUseCase creates a new GetAccountUseCase.
+func NewGetAccountUseCase(consents port.ConsentRepository, accounts port.AccountClient) *GetAccountUseCase {
+	return &GetAccountUseCase{access: consentAccess{consents: consents}, accounts: accounts}
+}
+
+// Execute retrieves the account.
+func (uc *GetAccountUseCase) Execute(ctx context.Context, req dto.AccountAccessRequest) (dto.AccountResponse, error) {
+	if _, err := uc.access.record(ctx, req, valueobject.PermissionReadAccounts); err != nil {
+		return dto.AccountResponse{}, err
+	}
+	account, err := uc.accounts.GetAccount(ctx, req.TenantID, *req.AccountID)
+	if err != nil {
+		return dto.AccountResponse{}, fmt.Errorf("failed to get account: %w", err)
+	}
+	return toAccountResponse(account), nil
+}
+
+// GetBalancesUseCase reads the balance of an account a consent covers.
+type GetBalancesUseCase struct {
+	access   consentAccess
+	accounts port.AccountClient
+	ledger   port.LedgerClient
+}
+
+// NewGetBalancesUseCase creates a new GetBalancesUseCase.
+func NewGetBalancesUseCase(consents port.ConsentRepository, accounts port.AccountClient, ledger port.LedgerClient) *GetBalancesUseCase {
+	return &GetBalancesUseCase{access: consentAccess{consents: consents}, accounts: accounts, ledger: ledger}
+}
+
+// Execute returns the account's current balance from the ledger account
+// backing it.
+func (uc *GetBalancesUseCase) Execute(ctx context.Context, req dto.AccountAccessRequest) (dto.BalanceResponse, error) {
+	if _, err := uc.access.record(ctx, req, valueobject.PermissionReadBalances); err != nil {
+		return dto.BalanceResponse{}, err
+	}
+	account, err := uc.accounts.GetAccount(ctx, req.TenantID, *req.AccountID)
+	if err != nil {
+		return dto.BalanceResponse{}, fmt.Errorf("failed to get account: %w", err)
+	}
+	amount, err := uc.ledger.GetBalance(ctx, req.TenantID, account.LedgerAccountCode, account.Currency)
+	if err != nil {
+		return dto.BalanceResponse{}, fmt.Errorf("failed to get balance: %w", err)
+	}
+	return dto.BalanceResponse{
+		AccountID:     account.ID,
+		Amount:        amount,
+		Currency:      account.Currency,
+		ReferenceDate: time.Now().UTC().Truncate(24 * time.Hour),
+	}, nil
+}
+
+// GetTransactionsUseCase reads the transactions of an account a consent
+// covers.
+type GetTransactionsUseCase struct {
+	access   consentAccess
+	payments port.PaymentClient
+}
+
+// NewGetTransactionsUseCase creates a new GetTransactionsUseCase.
+func NewGetTransactionsUseCase(consents port.ConsentRepository, payments port.PaymentClient) *GetTransactionsUseCase {
+	return &GetTransactionsUseCase{access: consentAccess{consents: consents}, payments: payments}
+}
+
+// Execute returns one page of the payments to and from the account, split
+// into those settled, which are booked, and those still under way. Failed
+// and reversed payments moved no money and are left out.
+func (uc *GetTransactionsUseCase) Execute(ctx context.Context, req dto.AccountAccessRequest) (dto.TransactionsResponse, error) {
+	if _, err := uc.access.record(ctx, req, valueobject.PermissionReadTransactions); err != nil {
+		return dto.TransactionsResponse{}, err
+	}
+	limit, offset := page(req.Limit, req.Offset)
+	payments, total, err := uc.payments.ListAccountPayments(ctx, req.TenantID, *req.AccountID, limit, offset)
+	if err != nil {
+		return dto.TransactionsResponse{}, fmt.Errorf("failed to list payments: %w", err)
+	}
+	resp := dto.TransactionsResponse{TotalCount: total}
+	for _, p := range payments {
+		switch p.Status {
+		case "SETTLED":
+			resp.Booked = append(resp.Booked, toTransactionResponse(p, *req.AccountID))
+		case "INITIATED", "PROCESSING":
+			resp.Pending = append(resp.Pending, toTransactionResponse(p, *req.AccountID))
+		}
+	}
+	return resp, nil
+}
+
+// consentAccess checks a TPP's reads against its consent.
+type consentAccess struct {
+	consents port.ConsentRepository
+}
+
+// record checks that the TPP's consent lets it read permission for the
+// requested account and, when the PSU is not present, counts the read
+// towards the consent's daily limit.
+func (a consentAccess) record(ctx context.Context, req dto.AccountAccessRequest, permission valueobject.Permission) (model.Consent, error) {
+	var consent model.Consent
+	err := retryOnConflict(func() error {
+		found, err := findConsent(ctx, a.consents, req.TenantID, req.ConsentID, &req.ProviderID, nil)
+		if err != nil {
+			return err
+		}
+		if consent, err = found.RecordAccess(req.AccountID, permission, req.PSUPresent, time.Now().UTC()); err != nil {
+			return err
+		}
+		if consent.Version() == found.Version() {
+			return nil
+		}
+		if err := a.consents.Save(ctx, consent); err != nil {
+			return fmt.Errorf("failed to save consent: %w", err)
+		}
+		return nil
+	})
+	return consent, err
+}
+
+func toAccountResponse(a port.Account) dto.AccountResponse {
+	return dto.AccountResponse{
+		ID:         a.ID,
+		Number:     a.Number,
+		Type:       a.Type,
+		Currency:   a.Currency,
+		Status:     a.Status,
+		HolderName: a.HolderName,
+	}
+}
+
+// toTransactionResponse describes a payment from accountID's side.
+func toTransactionResponse(p port.Payment, accountID uuid.UUID) dto.TransactionResponse {
+	t := dto.TransactionResponse{
+		ID:          p.ID,
+		Amount:      p.Amount,
+		Currency:    p.Currency,
+		Status:      p.Status,
+		Reference:   p.Reference,
+		Description: p.Description,
+		ValueDate:   p.InitiatedAt,
+		BookingDate: p.SettledAt,
+	}
+	if p.SourceAccountID == accountID {
+		t.Amount = p.Amount.Neg()
+		if p.DestinationAccountID != nil {
+			t.Counterparty = p.DestinationAccountID.String()
+		} else {
+			t.Counterparty = p.ExternalAccountNumber
+		}
+	} else {
+		t.Counterparty = p.SourceAccountID.String()
+	}
+	return t
+}
@@ -0,0 +1,388 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/dto"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/model"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/valueobject"
+)
+
+// expiryBatchSize bounds how many consents one sweep expires.
+const expiryBatchSize = 100
+
+// CreateAccountConsentUseCase records a TPP's request for access to a
+// PSU's accounts.
+type CreateAccountConsentUseCase struct {
+	consents  port.ConsentRepository
+	publisher port.EventPublisher
+}
+
+// NewCreateAccountConsentUseCase creates a new CreateAccountConsentUseCase.
+func NewCreateAccountConsentUseCase(consents port.ConsentRepository, publisher port.EventPublisher) *CreateAccountConsentUseCase {
+	return &CreateAccountConsentUseCase{consents: consents, publisher: publisher}
+}
+
+// Execute creates the consent, RECEIVED until the PSU authorises it. Asking
+// for balances or transactions implies asking for the accounts themselves.
+func (uc *CreateAccountConsentUseCase) Execute(ctx context.Context, req dto.CreateAccountConsentRequest) (dto.ConsentResponse, error) {
+	access := model.AccountAccess{
+		AccountIDs:      req.AccountIDs,
+		ValidUntil:      req.ValidUntil,
+		FrequencyPerDay: req.FrequencyPerDay,
+		Recurring:       req.Recurring,
+	}
+	for _, p := range req.Permissions {
+		permission, err := valueobject.NewPermission(p)
+		if err != nil {
+			return dto.ConsentResponse{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+		if !slices.Contains(access.Permissions, permission) {
+			access.Permissions = append(access.Permissions, permission)
+		}
+	}
+	if len(access.Permissions) > 0 && !slices.Contains(access.Permissions, valueobject.PermissionReadAccounts) {
+		access.Permissions = append([]valueobject.Permission{valueobject.PermissionReadAccounts}, access.Permissions...)
+	}
+	consent, err := model.NewAccountConsent(req.TenantID, req.ProviderID, access, time.Now().UTC())
+	if err != nil {
+		return dto.ConsentResponse{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+	return saveConsent(ctx, uc.consents, uc.publisher, consent)
+}
+
+// CreatePaymentConsentUseCase records a TPP's request to initiate a
+// payment from a PSU's account.
+type CreatePaymentConsentUseCase struct {
+	consents  port.ConsentRepository
+	publisher port.EventPublisher
+}
+
+// NewCreatePaymentConsentUseCase creates a new CreatePaymentConsentUseCase.
+func NewCreatePaymentConsentUseCase(consents port.ConsentRepository, publisher port.EventPublisher) *CreatePaymentConsentUseCase {
+	return &CreatePaymentConsentUseCase{consents: consents, publisher: publisher}
+}
+
+// Execute creates the consent, RECEIVED until the PSU authorises it. The
+// payment is submitted once they do.
+func (uc *CreatePaymentConsentUseCase) Execute(ctx context.Context, req dto.CreatePaymentConsentRequest) (dto.ConsentResponse, error) {
+	consent, err := model.NewPaymentConsent(req.TenantID, req.ProviderID, model.PaymentDetails(req.Payment), time.Now().UTC())
+	if err != nil {
+		return dto.ConsentResponse{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+	return saveConsent(ctx, uc.consents, uc.publisher, consent)
+}
+
+// GetConsentUseCase retrieves a consent.
+type GetConsentUseCase struct {
+	consents port.ConsentRepository
+}
+
+// NewGetConsentUseCase creates a new GetConsentUseCase.
+func NewGetConsentUseCase(consents port.ConsentRepository) *GetConsentUseCase {
+	return &GetConsentUseCase{consents: consents}
+}
+
+// Execute retrieves the consent, as ErrConsentNotFound when the TPP or PSU
+// asking may not see it.
+func (uc *GetConsentUseCase) Execute(ctx context.Context, req dto.GetConsentRequest) (dto.ConsentResponse, error) {
+	consent, err := findConsent(ctx, uc.consents, req.TenantID, req.ConsentID, req.ProviderID, req.PSUID)
+	if err != nil {
+		return dto.ConsentResponse{}, err
+	}
+	return toConsentResponse(consent), nil
+}
+
+// ListConsentsUseCase lists a tenant's consents.
+type ListConsentsUseCase struct {
+	consents port.ConsentRepository
+}
+
+// NewListConsentsUseCase creates a new ListConsentsUseCase.
+func NewListConsentsUseCase(consents port.ConsentRepository) *ListConsentsUseCase {
+	return &ListConsentsUseCase{consents: consents}
+}
+
+// Execute returns one page of the tenant's consents.
+func (uc *ListConsentsUseCase) Execute(ctx context.Context, req dto.ListConsentsRequest) (dto.ListConsentsResponse, error) {
+	filter := port.ConsentFilter{TenantID: req.TenantID, ProviderID: req.ProviderID, PSUID: req.PSUID}
+	if req.Status != "" {
+		status, err := valueobject.NewConsentStatus(req.Status)
+		if err != nil {
+			return dto.ListConsentsResponse{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+		filter.Status = status
+	}
+	limit, offset := page(req.Limit, req.Offset)
+	consents, total, err := uc.consents.List(ctx, filter, limit, offset)
+	if err != nil {
+		return dto.ListConsentsResponse{}, fmt.Errorf("failed to list consents: %w", err)
+	}
+	resp := dto.ListConsentsResponse{
+		Consents:   make([]dto.ConsentResponse, 0, len(consents)),
+		TotalCount: total,
+	}
+	for _, c := range consents {
+		resp.Consents = append(resp.Consents, toConsentResponse(c))
+	}
+	return resp, nil
+}
+
+// AuthoriseConsentUseCase records a PSU's authorisation of a consent.
+type AuthoriseConsentUseCase struct {
+	consents port.ConsentRepository
+	accounts port.AccountClient
+	executor paymentExecutor
+	logger   *slog.Logger
+}
+
+// NewAuthoriseConsentUseCase creates a new AuthoriseConsentUseCase.
+func NewAuthoriseConsentUseCase(
+	consents port.ConsentRepository,
+	accounts port.AccountClient,
+	payments port.PaymentClient,
+	publisher port.EventPublisher,
+	logger *slog.Logger,
+) *AuthoriseConsentUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AuthoriseConsentUseCase{
+		consents: consents,
+		accounts: accounts,
+		executor: paymentExecutor{consents: consents, payments: payments, publisher: publisher},
+		logger:   logger,
+	}
+}
+
+// Execute authorises the consent for accounts the PSU holds and can use.
+// The payment a payment consent stands for is submitted straight away; if
+// that fails transiently, the consent stays VALID and the payment is
+// retried by ExecutePendingPaymentsUseCase.
+func (uc *AuthoriseConsentUseCase) Execute(ctx context.Context, req dto.AuthoriseConsentRequest) (dto.ConsentResponse, error) {
+	consent, err := uc.consents.FindByID(ctx, req.TenantID, req.ConsentID)
+	if err != nil {
+		return dto.ConsentResponse{}, fmt.Errorf("failed to find consent: %w", err)
+	}
+	now := time.Now().UTC()
+	if consent.IsExpiredAt(now) {
+		return dto.ConsentResponse{}, model.ErrConsentExpired
+	}
+
+	held, err := uc.accounts.ListHolderAccounts(ctx, req.TenantID, req.PSUID)
+	if err != nil {
+		return dto.ConsentResponse{}, fmt.Errorf("failed to list the PSU's accounts: %w", err)
+	}
+	chosen := req.AccountIDs
+	if len(chosen) == 0 {
+		chosen = consent.AccountIDs()
+	}
+	for _, id := range chosen {
+		i := slices.IndexFunc(held, func(a port.Account) bool { return a.ID == id })
+		if i < 0 {
+			return dto.ConsentResponse{}, fmt.Errorf("%w: account %s is not held by the PSU", port.ErrAccountNotFound, id)
+		}
+		if !held[i].IsActive() {
+			return dto.ConsentResponse{}, fmt.Errorf("%w: account %s is %s", ErrInvalidRequest, id, held[i].Status)
+		}
+		if p := consent.Payment(); p != nil && held[i].Currency != p.Currency {
+			return dto.ConsentResponse{}, fmt.Errorf("%w: account %s is in %s, not %s", ErrInvalidRequest, id, held[i].Currency, p.Currency)
+		}
+	}
+
+	if consent, err = consent.Authorise(req.PSUID, req.AccountIDs, now); err != nil {
+		return dto.ConsentResponse{}, err
+	}
+	resp, err := saveConsent(ctx, uc.consents, uc.executor.publisher, consent)
+	if err != nil || !consent.IsPayment() {
+		return resp, err
+	}
+	executed, err := uc.executor.execute(ctx, consent.ClearDomainEvents())
+	if err != nil {
+		uc.logger.Warn("payment not submitted, will retry", "consent_id", consent.ID(), "error", err)
+		return resp, nil
+	}
+	return toConsentResponse(executed), nil
+}
+
+// RejectConsentUseCase records that a PSU refused a consent.
+type RejectConsentUseCase struct {
+	closer consentCloser
+}
+
+// NewRejectConsentUseCase creates a new RejectConsentUseCase.
+func NewRejectConsentUseCase(consents port.ConsentRepository, publisher port.EventPublisher) *RejectConsentUseCase {
+	return &RejectConsentUseCase{closer: consentCloser{consents: consents, publisher: publisher}}
+}
+
+// Execute rejects the consent.
+func (uc *RejectConsentUseCase) Execute(ctx context.Context, req dto.CloseConsentRequest) (dto.ConsentResponse, error) {
+	reason := req.Reason
+	if reason == "" {
+		reason = "rejected by the PSU"
+	}
+	return uc.closer.close(ctx, req, func(c model.Consent, now time.Time) (model.Consent, error) {
+		return c.Reject(reason, now)
+	})
+}
+
+// RevokeConsentUseCase withdraws a consent on the PSU's behalf.
+type RevokeConsentUseCase struct {
+	closer consentCloser
+}
+
+// NewRevokeConsentUseCase creates a new RevokeConsentUseCase.
+func NewRevokeConsentUseCase(consents port.ConsentRepository, publisher port.EventPublisher) *RevokeConsentUseCase {
+	return &RevokeConsentUseCase{closer: consentCloser{consents: consents, publisher: publisher}}
+}
+
+// Execute revokes the consent.
+func (uc *RevokeConsentUseCase) Execute(ctx context.Context, req dto.CloseConsentRequest) (dto.ConsentResponse, error) {
+	return uc.closer.close(ctx, req, model.Consent.Revoke)
+}
+
+// TerminateConsentUseCase withdraws a consent on the TPP's behalf.
+type TerminateConsentUseCase struct {
+	closer consentCloser
+}
+
+// NewTerminateConsentUseCase creates a new TerminateConsentUseCase.
+func NewTerminateConsentUseCase(consents port.ConsentRepository, publisher port.EventPublisher) *TerminateConsentUseCase {
+	return &TerminateConsentUseCase{closer: consentCloser{consents: consents, publisher: publisher}}
+}
+
+// Execute terminates the consent.
+func (uc *TerminateConsentUseCase) Execute(ctx context.Context, req dto.CloseConsentRequest) (dto.ConsentResponse, error) {
+	return uc.closer.close(ctx, req, model.Consent.Terminate)
+}
+
+// ExpireConsentsUseCase expires account consents past their validUntil
+// date and consents the PSU did not authorise in time.
+type ExpireConsentsUseCase struct {
+	consents         port.ConsentRepository
+	publisher        port.EventPublisher
+	logger           *slog.Logger
+	authorisationTTL time.Duration
+}
+
+// NewExpireConsentsUseCase creates a new ExpireConsentsUseCase. Consents
+// still RECEIVED authorisationTTL after they were created expire.
+func NewExpireConsentsUseCase(
+	consents port.ConsentRepository,
+	publisher port.EventPublisher,
+	authorisationTTL time.Duration,
+	logger *slog.Logger,
+) *ExpireConsentsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ExpireConsentsUseCase{consents: consents, publisher: publisher, authorisationTTL: authorisationTTL, logger: logger}
+}
+
+// Execute expires up to a batch of consents due at now and returns how
+// many it expired. A consent that fails to expire is logged and left for
+// the next sweep.
+func (uc *ExpireConsentsUseCase) Execute(ctx context.Context, now time.Time) (int, error) {
+	due, err := uc.consents.ListExpirable(ctx, now, now.Add(-uc.authorisationTTL), expiryBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expirable consents: %w", err)
+	}
+	count := 0
+	for _, c := range due {
+		reason := "not authorised in time"
+		if c.Status() == valueobject.ConsentStatusValid {
+			reason = "validUntil passed"
+		}
+		expired, err := c.Expire(reason, now)
+		if err == nil {
+			_, err = saveConsent(ctx, uc.consents, uc.publisher, expired)
+		}
+		if err != nil {
+			uc.logger.Error("failed to expire consent", "consent_id", c.ID(), "error", err)
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// consentCloser moves consents to a final status on behalf of the PSU, the
+// TPP or staff.
+type consentCloser struct {
+	consents  port.ConsentRepository
+	publisher port.EventPublisher
+}
+
+type closeFunc func(model.Consent, time.Time) (model.Consent, error)
+
+func (c consentCloser) close(ctx context.Context, req dto.CloseConsentRequest, closeFn closeFunc) (dto.ConsentResponse, error) {
+	consent, err := findConsent(ctx, c.consents, req.TenantID, req.ConsentID, req.ProviderID, req.PSUID)
+	if err != nil {
+		return dto.ConsentResponse{}, err
+	}
+	if consent, err = closeFn(consent, time.Now().UTC()); err != nil {
+		return dto.ConsentResponse{}, err
+	}
+	return saveConsent(ctx, c.consents, c.publisher, consent)
+}
+
+// findConsent retrieves a consent, as ErrConsentNotFound when providerID or
+// psuID is set and the consent is not theirs to see.
+func findConsent(ctx context.Context, consents port.ConsentRepository, tenantID, consentID uuid.UUID, providerID, psuID *uuid.UUID) (model.Consent, error) {
+	consent, err := consents.FindByID(ctx, tenantID, consentID)
+	if err != nil {
+		return model.Consent{}, fmt.Errorf("failed to find consent: %w", err)
+	}
+	if (providerID != nil && consent.ProviderID() != *providerID) || (psuID != nil && !consent.IsAccessibleBy(*psuID)) {
+		return model.Consent{}, fmt.Errorf("failed to find consent: %w", port.ErrConsentNotFound)
+	}
+	return consent, nil
+}
+
+func saveConsent(ctx context.Context, consents port.ConsentRepository, publisher port.EventPublisher, consent model.Consent) (dto.ConsentResponse, error) {
+	if err := consents.Save(ctx, consent); err != nil {
+		return dto.ConsentResponse{}, fmt.Errorf("failed to save consent: %w", err)
+	}
+	if err := publisher.Publish(ctx, consent.DomainEvents()); err != nil {
+		return dto.ConsentResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toConsentResponse(consent), nil
+}
+
+func toConsentResponse(c model.Consent) dto.ConsentResponse {
+	resp := dto.ConsentResponse{
+		ID:              c.ID(),
+		TenantID:        c.TenantID(),
+		ProviderID:      c.ProviderID(),
+		Type:            c.Type().String(),
+		Status:          c.Status().String(),
+		StatusReason:    c.StatusReason(),
+		AccountIDs:      c.AccountIDs(),
+		FrequencyPerDay: c.FrequencyPerDay(),
+		Recurring:       c.Recurring(),
+		PSUID:           c.PSUID(),
+		PaymentID:       c.PaymentID(),
+		AuthorisedAt:    c.AuthorisedAt(),
+		Version:         c.Version(),
+		CreatedAt:       c.CreatedAt(),
+		UpdatedAt:       c.UpdatedAt(),
+	}
+	for _, p := range c.Permissions() {
+		resp.Permissions = append(resp.Permissions, p.String())
+	}
+	if p := c.Payment(); p != nil {
+		details := dto.PaymentDetails(*p)
+		resp.Payment = &details
+	} else {
+		validUntil := c.ValidUntil()
+		resp.ValidUntil = &validUntil
+	}
+	return resp
+}
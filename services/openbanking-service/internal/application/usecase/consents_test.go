@@ -0,0 +1,159 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/dto"
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/model"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+)
+
+func TestIdentifyProvider(t *testing.T) {
+	ctx := context.Background()
+	providers, publisher := &inMemoryProviderRepo{}, &recordingPublisher{}
+	identify := usecase.NewIdentifyProviderUseCase(providers, publisher)
+	req := dto.IdentifyProviderRequest{
+		TenantID:               uuid.New(),
+		OrganizationID:         "PSDGB-FCA-123456",
+		Name:                   "Budget App Ltd",
+		Roles:                  []string{"PSP_AI"},
+		CertificateFingerprint: "aa",
+	}
+
+	first, err := identify.Execute(ctx, req)
+	require.NoError(t, err)
+	again, err := identify.Execute(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, again.ID, "the provider is registered once")
+	assert.Equal(t, first.Version, again.Version, "an unchanged certificate changes nothing")
+	assert.Equal(t, []string{"openbanking.provider.registered"}, publisher.types)
+
+	req.Roles = []string{"PSP_AI", "PSP_PI"}
+	renewed, err := identify.Execute(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PSP_AI", "PSP_PI"}, renewed.Roles)
+
+	_, err = usecase.NewSetProviderStatusUseCase(providers, publisher).Execute(ctx, dto.SetProviderStatusRequest{
+		TenantID: req.TenantID, ProviderID: first.ID, Suspend: true, Reason: "licence withdrawn",
+	})
+	require.NoError(t, err)
+	_, err = identify.Execute(ctx, req)
+	assert.ErrorIs(t, err, usecase.ErrProviderSuspended)
+
+	req.Roles = []string{"PSP_XX"}
+	_, err = identify.Execute(ctx, req)
+	assert.ErrorIs(t, err, usecase.ErrInvalidRequest)
+}
+
+func TestAccountConsent_AuthoriseAndRead(t *testing.T) {
+	ctx := context.Background()
+	tenantID, providerID, psu := uuid.New(), uuid.New(), uuid.New()
+	consents, publisher := &inMemoryConsentRepo{}, &recordingPublisher{}
+	accounts := &fakeAccounts{}
+	current := accounts.add(tenantID, psu, "EUR")
+	savings := accounts.add(tenantID, psu, "EUR")
+	someoneElses := accounts.add(tenantID, uuid.New(), "EUR")
+	payments := &fakePayments{}
+
+	consent, err := usecase.NewCreateAccountConsentUseCase(consents, publisher).Execute(ctx, dto.CreateAccountConsentRequest{
+		TenantID:        tenantID,
+		ProviderID:      providerID,
+		Permissions:     []string{"READ_BALANCES"},
+		Recurring:       true,
+		FrequencyPerDay: 1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"READ_ACCOUNTS", "READ_BALANCES"}, consent.Permissions, "balances imply accounts")
+	assert.Equal(t, "RECEIVED", consent.Status)
+
+	authorise := usecase.NewAuthoriseConsentUseCase(consents, accounts, payments, publisher, nil)
+	_, err = authorise.Execute(ctx, dto.AuthoriseConsentRequest{TenantID: tenantID, ConsentID: consent.ID, PSUID: psu, AccountIDs: []uuid.UUID{someoneElses.ID}})
+	assert.ErrorIs(t, err, port.ErrAccountNotFound, "an account the PSU does not hold")
+	consent, err = authorise.Execute(ctx, dto.AuthoriseConsentRequest{TenantID: tenantID, ConsentID: consent.ID, PSUID: psu, AccountIDs: []uuid.UUID{current.ID, savings.ID}})
+	require.NoError(t, err)
+	assert.Equal(t, "VALID", consent.Status)
+
+	read := dto.AccountAccessRequest{TenantID: tenantID, ProviderID: providerID, ConsentID: consent.ID, PSUPresent: true}
+	list, err := usecase.NewListAccountsUseCase(consents, accounts).Execute(ctx, read)
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	other := read
+	other.ProviderID = uuid.New()
+	_, err = usecase.NewListAccountsUseCase(consents, accounts).Execute(ctx, other)
+	assert.ErrorIs(t, err, port.ErrConsentNotFound, "another TPP's consent")
+
+	balances := usecase.NewGetBalancesUseCase(consents, accounts, fakeLedger{balance: d(420)})
+	read.AccountID, read.PSUPresent = &current.ID, false
+	balance, err := balances.Execute(ctx, read)
+	require.NoError(t, err)
+	assert.True(t, d(420).Equal(balance.Amount))
+	_, err = balances.Execute(ctx, read)
+	assert.ErrorIs(t, err, model.ErrAccessLimitExceeded, "one unattended read a day")
+
+	_, err = usecase.NewGetTransactionsUseCase(consents, payments).Execute(ctx, read)
+	assert.ErrorIs(t, err, model.ErrNotPermitted, "transactions were not consented to")
+
+	_, err = usecase.NewTerminateConsentUseCase(consents, publisher).Execute(ctx, dto.CloseConsentRequest{
+		TenantID: tenantID, ConsentID: consent.ID, ProviderID: &providerID,
+	})
+	require.NoError(t, err)
+	read.PSUPresent = true
+	_, err = balances.Execute(ctx, read)
+	assert.ErrorIs(t, err, model.ErrConsentNotValid)
+	assert.Equal(t, []string{"openbanking.consent.created", "openbanking.consent.authorised", "openbanking.consent.closed"}, publisher.types)
+}
+
+func TestRevokeConsent_OnlyByItsPSU(t *testing.T) {
+	ctx := context.Background()
+	tenantID, psu := uuid.New(), uuid.New()
+	consents, publisher, accounts := &inMemoryConsentRepo{}, &recordingPublisher{}, &fakeAccounts{}
+	acct := accounts.add(tenantID, psu, "EUR")
+
+	consent, err := usecase.NewCreateAccountConsentUseCase(consents, publisher).Execute(ctx, dto.CreateAccountConsentRequest{
+		TenantID: tenantID, ProviderID: uuid.New(), Permissions: []string{"READ_ACCOUNTS"}, AccountIDs: []uuid.UUID{acct.ID},
+	})
+	require.NoError(t, err)
+	_, err = usecase.NewAuthoriseConsentUseCase(consents, accounts, &fakePayments{}, publisher, nil).Execute(ctx, dto.AuthoriseConsentRequest{
+		TenantID: tenantID, ConsentID: consent.ID, PSUID: psu,
+	})
+	require.NoError(t, err)
+
+	revoke := usecase.NewRevokeConsentUseCase(consents, publisher)
+	stranger := uuid.New()
+	_, err = revoke.Execute(ctx, dto.CloseConsentRequest{TenantID: tenantID, ConsentID: consent.ID, PSUID: &stranger})
+	assert.ErrorIs(t, err, port.ErrConsentNotFound)
+	revoked, err := revoke.Execute(ctx, dto.CloseConsentRequest{TenantID: tenantID, ConsentID: consent.ID, PSUID: &psu})
+	require.NoError(t, err)
+	assert.Equal(t, "REVOKED", revoked.Status)
+}
+
+func TestExpireConsents(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+	consents, publisher := &inMemoryConsentRepo{}, &recordingPublisher{}
+	create := usecase.NewCreateAccountConsentUseCase(consents, publisher)
+	req := dto.CreateAccountConsentRequest{TenantID: tenantID, ProviderID: uuid.New(), Permissions: []string{"READ_ACCOUNTS"}}
+	_, err := create.Execute(ctx, req)
+	require.NoError(t, err)
+
+	expire := usecase.NewExpireConsentsUseCase(consents, publisher, time.Hour, nil)
+	n, err := expire.Execute(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, n, "still within the authorisation window")
+	n, err = expire.Execute(ctx, time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	list, err := usecase.NewListConsentsUseCase(consents).Execute(ctx, dto.ListConsentsRequest{TenantID: tenantID, Status: "EXPIRED"})
+	require.NoError(t, err)
+	require.Len(t, list.Consents, 1)
+	assert.Equal(t, "not authorised in time", list.Consents[0].StatusReason)
+}
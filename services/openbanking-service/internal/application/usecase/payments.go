@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/dto"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/model"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/valueobject"
+)
+
+// executionGrace is how long after a payment consent is authorised the
+// retry sweep leaves it alone, so as not to race the submission made on
+// authorising it.
+const executionGrace = time.Minute
+
+// GetPaymentUseCase retrieves a payment a TPP initiated.
+type GetPaymentUseCase struct {
+	consents port.ConsentRepository
+	payments port.PaymentClient
+}
+
+// NewGetPaymentUseCase creates a new GetPaymentUseCase.
+func NewGetPaymentUseCase(consents port.ConsentRepository, payments port.PaymentClient) *GetPaymentUseCase {
+	return &GetPaymentUseCase{consents: consents, payments: payments}
+}
+
+// Execute retrieves the payment consent and the status of its payment.
+// The TPP knows the payment by the consent's ID.
+func (uc *GetPaymentUseCase) Execute(ctx context.Context, tenantID, providerID, consentID uuid.UUID) (dto.PaymentInitiationResponse, error) {
+	consent, err := findConsent(ctx, uc.consents, tenantID, consentID, &providerID, nil)
+	if err != nil {
+		return dto.PaymentInitiationResponse{}, err
+	}
+	if !consent.IsPayment() {
+		return dto.PaymentInitiationResponse{}, fmt.Errorf("failed to find payment: %w", port.ErrConsentNotFound)
+	}
+	resp := dto.PaymentInitiationResponse{
+		Consent:           toConsentResponse(consent),
+		TransactionStatus: consentTransactionStatus(consent.Status()),
+	}
+	if consent.Status() == valueobject.ConsentStatusRejected {
+		resp.FailureReason = consent.StatusReason()
+	}
+	if consent.PaymentID() == nil {
+		return resp, nil
+	}
+	payment, err := uc.payments.GetPayment(ctx, tenantID, *consent.PaymentID())
+	if err != nil {
+		return dto.PaymentInitiationResponse{}, fmt.Errorf("failed to get payment: %w", err)
+	}
+	resp.TransactionStatus = paymentTransactionStatus(payment.Status)
+	resp.FailureReason = payment.FailureReason
+	return resp, nil
+}
+
+// ExecutePendingPaymentsUseCase submits the payments of authorised payment
+// consents whose submission on authorisation failed transiently.
+type ExecutePendingPaymentsUseCase struct {
+	consents port.ConsentRepository
+	executor paymentExecutor
+	logger   *slog.Logger
+}
+
+// NewExecutePendingPaymentsUseCase creates a new
+// ExecutePendingPaymentsUseCase.
+func NewExecutePendingPaymentsUseCase(
+	consents port.ConsentRepository,
+	payments port.PaymentClient,
+	publisher port.EventPublisher,
+	logger *slog.Logger,
+) *ExecutePendingPaymentsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ExecutePendingPaymentsUseCase{
+		consents: consents,
+		executor: paymentExecutor{consents: consents, payments: payments, publisher: publisher},
+		logger:   logger,
+	}
+}
+
+// Execute submits up to a batch of pending payments and returns how many
+// it submitted. The payment service deduplicates submissions by consent,
+// so a payment submitted before its consent was saved is not paid twice.
+func (uc *ExecutePendingPaymentsUseCase) Execute(ctx context.Context, now time.Time) (int, error) {
+	pending, err := uc.consents.ListUnexecuted(ctx, now.Add(-executionGrace), expiryBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list unexecuted payments: %w", err)
+	}
+	count := 0
+	for _, c := range pending {
+		if _, err := uc.executor.execute(ctx, c); err != nil {
+			uc.logger.Error("failed to submit payment", "consent_id", c.ID(), "error", err)
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// paymentExecutor submits the payment an authorised payment consent
+// stands for.
+type paymentExecutor struct {
+	consents  port.ConsentRepository
+	payments  port.PaymentClient
+	publisher port.EventPublisher
+}
+
+// execute submits the consent's payment, keyed by the consent so that
+// resubmitting it is harmless, and consumes the consent. A payment the
+// payment service refuses rejects the consent instead.
+func (e paymentExecutor) execute(ctx context.Context, consent model.Consent) (model.Consent, error) {
+	details := consent.Payment()
+	payment, err := e.payments.InitiatePayment(ctx, port.PaymentInstruction{
+		IdempotencyKey:        "openbanking:" + consent.ID().String(),
+		TenantID:              consent.TenantID(),
+		SourceAccountID:       details.DebtorAccountID,
+		DestinationAccountID:  details.CreditorAccountID,
+		ExternalAccountNumber: details.CreditorAccountNumber,
+		RoutingNumber:         details.CreditorRoutingNumber,
+		DestinationCountry:    details.CreditorCountry,
+		Amount:                details.Amount,
+		Currency:              details.Currency,
+		Reference:             consent.ID().String(),
+		Description:           details.RemittanceInformation,
+	})
+	now := time.Now().UTC()
+	switch {
+	case errors.Is(err, port.ErrPaymentRejected):
+		consent, err = consent.Reject(err.Error(), now)
+	case err != nil:
+		return consent, fmt.Errorf("failed to initiate payment: %w", err)
+	default:
+		consent, err = consent.Consume(payment.ID, now)
+	}
+	if err != nil {
+		return consent, err
+	}
+	if _, err := saveConsent(ctx, e.consents, e.publisher, consent); err != nil {
+		return consent, err
+	}
+	return consent, nil
+}
+
+// consentTransactionStatus is the ISO 20022 status of a payment not yet
+// submitted, from its consent's status.
+func consentTransactionStatus(status valueobject.ConsentStatus) string {
+	switch status {
+	case valueobject.ConsentStatusReceived:
+		return "RCVD"
+	case valueobject.ConsentStatusValid, valueobject.ConsentStatusConsumed:
+		return "ACTC"
+	case valueobject.ConsentStatusRejected:
+		return "RJCT"
+	default:
+		return "CANC"
+	}
+}
+
+// paymentTransactionStatus is the ISO 20022 status of a submitted payment,
+// from the payment service's status.
+func paymentTransactionStatus(status string) string {
+	switch status {
+	case "SETTLED":
+		return "ACSC"
+	case "FAILED":
+		return "RJCT"
+	case "REVERSED":
+		return "CANC"
+	default:
+		return "ACSP"
+	}
+}
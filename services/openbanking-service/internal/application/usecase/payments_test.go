@@ -0,0 +1,138 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/dto"
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+)
+
+type paymentFixture struct {
+	consents  *inMemoryConsentRepo
+	publisher *recordingPublisher
+	accounts  *fakeAccounts
+	payments  *fakePayments
+	tenantID  uuid.UUID
+	provider  uuid.UUID
+	psu       uuid.UUID
+	debtor    port.Account
+}
+
+func newPaymentFixture() *paymentFixture {
+	f := &paymentFixture{
+		consents:  &inMemoryConsentRepo{},
+		publisher: &recordingPublisher{},
+		accounts:  &fakeAccounts{},
+		payments:  &fakePayments{},
+		tenantID:  uuid.New(),
+		provider:  uuid.New(),
+		psu:       uuid.New(),
+	}
+	f.debtor = f.accounts.add(f.tenantID, f.psu, "EUR")
+	return f
+}
+
+func (f *paymentFixture) initiate(t *testing.T) dto.ConsentResponse {
+	t.Helper()
+	consent, err := usecase.NewCreatePaymentConsentUseCase(f.consents, f.publisher).Execute(context.Background(), dto.CreatePaymentConsentRequest{
+		TenantID:   f.tenantID,
+		ProviderID: f.provider,
+		Payment: dto.PaymentDetails{
+			Product:               "sepa-credit-transfers",
+			DebtorAccountID:       f.debtor.ID,
+			CreditorAccountNumber: "DE89370400440532013000",
+			CreditorName:          "Merchant GmbH",
+			Amount:                d(125),
+			Currency:              "EUR",
+		},
+	})
+	require.NoError(t, err)
+	return consent
+}
+
+func (f *paymentFixture) authorise(t *testing.T, consentID uuid.UUID) dto.ConsentResponse {
+	t.Helper()
+	consent, err := usecase.NewAuthoriseConsentUseCase(f.consents, f.accounts, f.payments, f.publisher, nil).Execute(context.Background(), dto.AuthoriseConsentRequest{
+		TenantID: f.tenantID, ConsentID: consentID, PSUID: f.psu,
+	})
+	require.NoError(t, err)
+	return consent
+}
+
+func (f *paymentFixture) status(t *testing.T, consentID uuid.UUID) dto.PaymentInitiationResponse {
+	t.Helper()
+	resp, err := usecase.NewGetPaymentUseCase(f.consents, f.payments).Execute(context.Background(), f.tenantID, f.provider, consentID)
+	require.NoError(t, err)
+	return resp
+}
+
+func TestPaymentInitiation_ExecutedOnAuthorisation(t *testing.T) {
+	f := newPaymentFixture()
+	consent := f.initiate(t)
+	assert.Equal(t, "RCVD", f.status(t, consent.ID).TransactionStatus)
+
+	consent = f.authorise(t, consent.ID)
+	assert.Equal(t, "CONSUMED", consent.Status)
+	require.NotNil(t, consent.PaymentID)
+	require.Len(t, f.payments.payments, 1)
+	assert.Equal(t, f.debtor.ID, f.payments.payments[0].SourceAccountID)
+	assert.Equal(t, "ACSP", f.status(t, consent.ID).TransactionStatus)
+	assert.Contains(t, f.publisher.types, "openbanking.payment.initiated")
+
+	_, err := usecase.NewGetPaymentUseCase(f.consents, f.payments).Execute(context.Background(), f.tenantID, uuid.New(), consent.ID)
+	assert.ErrorIs(t, err, port.ErrConsentNotFound, "another TPP's payment")
+}
+
+func TestPaymentInitiation_RetriedAfterTransientFailure(t *testing.T) {
+	f := newPaymentFixture()
+	consent := f.initiate(t)
+	f.payments.err = errUnavailable
+	consent = f.authorise(t, consent.ID)
+	assert.Equal(t, "VALID", consent.Status, "authorised, payment pending")
+	assert.Equal(t, "ACTC", f.status(t, consent.ID).TransactionStatus)
+
+	execute := usecase.NewExecutePendingPaymentsUseCase(f.consents, f.payments, f.publisher, nil)
+	n, err := execute.Execute(context.Background(), time.Now().Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.Zero(t, n, "still failing")
+
+	f.payments.err = nil
+	n, err = execute.Execute(context.Background(), time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, n, "left alone just after authorisation")
+	n, err = execute.Execute(context.Background(), time.Now().Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Len(t, f.payments.payments, 1)
+	assert.Equal(t, "ACSP", f.status(t, consent.ID).TransactionStatus)
+}
+
+func TestPaymentInitiation_Rejected(t *testing.T) {
+	f := newPaymentFixture()
+	consent := f.initiate(t)
+	f.payments.err = fmt.Errorf("%w: insufficient funds", port.ErrPaymentRejected)
+	consent = f.authorise(t, consent.ID)
+	assert.Equal(t, "REJECTED", consent.Status)
+
+	resp := f.status(t, consent.ID)
+	assert.Equal(t, "RJCT", resp.TransactionStatus)
+	assert.Contains(t, resp.FailureReason, "insufficient funds")
+}
+
+func TestPaymentInitiation_DebtorCurrencyMustMatch(t *testing.T) {
+	f := newPaymentFixture()
+	f.debtor = f.accounts.add(f.tenantID, f.psu, "USD")
+	consent := f.initiate(t)
+	_, err := usecase.NewAuthoriseConsentUseCase(f.consents, f.accounts, f.payments, f.publisher, nil).Execute(context.Background(), dto.AuthoriseConsentRequest{
+		TenantID: f.tenantID, ConsentID: consent.ID, PSUID: f.psu,
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidRequest)
+}
@@ -0,0 +1,208 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/dto"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/model"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/valueobject"
+)
+
+// ErrInvalidRequest is returned when a request is malformed.
+var ErrInvalidRequest = errors.New("invalid request")
+
+// ErrProviderSuspended is returned when a suspended TPP calls the API.
+var ErrProviderSuspended = errors.New("provider is suspended")
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// maxAttempts bounds how often a change to a consent is retried when
+// another change to it was saved first, such as two reads counting
+// towards its daily limit at once.
+const maxAttempts = 3
+
+// IdentifyProviderUseCase resolves the TPP behind a request from its
+// verified eIDAS certificate, registering it on first contact.
+type IdentifyProviderUseCase struct {
+	providers port.ProviderRepository
+	publisher port.EventPublisher
+}
+
+// NewIdentifyProviderUseCase creates a new IdentifyProviderUseCase.
+func NewIdentifyProviderUseCase(providers port.ProviderRepository, publisher port.EventPublisher) *IdentifyProviderUseCase {
+	return &IdentifyProviderUseCase{providers: providers, publisher: publisher}
+}
+
+// Execute returns the provider for the certificate's organization,
+// registering it or refreshing its identity from the certificate as
+// needed. A suspended provider is refused with ErrProviderSuspended.
+func (uc *IdentifyProviderUseCase) Execute(ctx context.Context, req dto.IdentifyProviderRequest) (dto.ProviderResponse, error) {
+	identity := model.ProviderIdentity{
+		OrganizationID:         req.OrganizationID,
+		Name:                   req.Name,
+		NCAName:                req.NCAName,
+		NCAID:                  req.NCAID,
+		CertificateFingerprint: req.CertificateFingerprint,
+	}
+	for _, r := range req.Roles {
+		role, err := valueobject.NewTPPRole(r)
+		if err != nil {
+			return dto.ProviderResponse{}, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+		identity.Roles = append(identity.Roles, role)
+	}
+
+	var provider model.ThirdPartyProvider
+	err := retryOnConflict(func() error {
+		now := time.Now().UTC()
+		existing, err := uc.providers.FindByOrganizationID(ctx, req.TenantID, identity.OrganizationID)
+		switch {
+		case errors.Is(err, port.ErrProviderNotFound):
+			if provider, err = model.NewThirdPartyProvider(req.TenantID, identity, now); err != nil {
+				return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+			}
+		case err != nil:
+			return fmt.Errorf("failed to find provider: %w", err)
+		default:
+			var changed bool
+			if provider, changed, err = existing.Refresh(identity, now); err != nil {
+				return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+			}
+			if !changed {
+				return nil
+			}
+		}
+		// A provider registered concurrently by another request is a
+		// version conflict, after which it is found and refreshed.
+		if err := uc.providers.Save(ctx, provider); err != nil {
+			return fmt.Errorf("failed to save provider: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dto.ProviderResponse{}, err
+	}
+	if err := uc.publisher.Publish(ctx, provider.DomainEvents()); err != nil {
+		return dto.ProviderResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	if !provider.IsActive() {
+		return dto.ProviderResponse{}, fmt.Errorf("%w: %s", ErrProviderSuspended, provider.StatusReason())
+	}
+	return toProviderResponse(provider), nil
+}
+
+// ListProvidersUseCase lists a tenant's providers.
+type ListProvidersUseCase struct {
+	providers port.ProviderRepository
+}
+
+// NewListProvidersUseCase creates a new ListProvidersUseCase.
+func NewListProvidersUseCase(providers port.ProviderRepository) *ListProvidersUseCase {
+	return &ListProvidersUseCase{providers: providers}
+}
+
+// Execute returns one page of the tenant's providers.
+func (uc *ListProvidersUseCase) Execute(ctx context.Context, req dto.ListProvidersRequest) (dto.ListProvidersResponse, error) {
+	limit, offset := page(req.Limit, req.Offset)
+	providers, total, err := uc.providers.List(ctx, req.TenantID, limit, offset)
+	if err != nil {
+		return dto.ListProvidersResponse{}, fmt.Errorf("failed to list providers: %w", err)
+	}
+	resp := dto.ListProvidersResponse{
+		Providers:  make([]dto.ProviderResponse, 0, len(providers)),
+		TotalCount: total,
+	}
+	for _, p := range providers {
+		resp.Providers = append(resp.Providers, toProviderResponse(p))
+	}
+	return resp, nil
+}
+
+// SetProviderStatusUseCase suspends or reactivates a provider.
+type SetProviderStatusUseCase struct {
+	providers port.ProviderRepository
+	publisher port.EventPublisher
+}
+
+// NewSetProviderStatusUseCase creates a new SetProviderStatusUseCase.
+func NewSetProviderStatusUseCase(providers port.ProviderRepository, publisher port.EventPublisher) *SetProviderStatusUseCase {
+	return &SetProviderStatusUseCase{providers: providers, publisher: publisher}
+}
+
+// Execute suspends or reactivates the provider. A suspended provider's
+// consents are kept, so that it can use them again once reactivated.
+func (uc *SetProviderStatusUseCase) Execute(ctx context.Context, req dto.SetProviderStatusRequest) (dto.ProviderResponse, error) {
+	provider, err := uc.providers.FindByID(ctx, req.TenantID, req.ProviderID)
+	if err != nil {
+		return dto.ProviderResponse{}, fmt.Errorf("failed to find provider: %w", err)
+	}
+	now := time.Now().UTC()
+	if req.Suspend {
+		if strings.TrimSpace(req.Reason) == "" {
+			return dto.ProviderResponse{}, fmt.Errorf("%w: a reason is required to suspend a provider", ErrInvalidRequest)
+		}
+		provider, err = provider.Suspend(req.Reason, now)
+	} else {
+		provider, err = provider.Reactivate(now)
+	}
+	if err != nil {
+		return dto.ProviderResponse{}, err
+	}
+	if err := uc.providers.Save(ctx, provider); err != nil {
+		return dto.ProviderResponse{}, fmt.Errorf("failed to save provider: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, provider.DomainEvents()); err != nil {
+		return dto.ProviderResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toProviderResponse(provider), nil
+}
+
+// retryOnConflict runs fn again while it fails with a version conflict, up
+// to maxAttempts times.
+func retryOnConflict(fn func() error) error {
+	var err error
+	for range maxAttempts {
+		if err = fn(); !errors.Is(err, port.ErrVersionConflict) {
+			return err
+		}
+	}
+	return err
+}
+
+func page(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	return min(limit, maxLimit), max(offset, 0)
+}
+
+func toProviderResponse(p model.ThirdPartyProvider) dto.ProviderResponse {
+	identity := p.Identity()
+	roles := make([]string, 0, len(identity.Roles))
+	for _, r := range identity.Roles {
+		roles = append(roles, r.String())
+	}
+	return dto.ProviderResponse{
+		ID:                     p.ID(),
+		TenantID:               p.TenantID(),
+		OrganizationID:         identity.OrganizationID,
+		Name:                   identity.Name,
+		NCAName:                identity.NCAName,
+		NCAID:                  identity.NCAID,
+		Roles:                  roles,
+		CertificateFingerprint: identity.CertificateFingerprint,
+		Status:                 p.Status().String(),
+		StatusReason:           p.StatusReason(),
+		Version:                p.Version(),
+		CreatedAt:              p.CreatedAt(),
+		UpdatedAt:              p.UpdatedAt(),
+	}
+}
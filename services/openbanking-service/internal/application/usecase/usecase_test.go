@@ -0,0 +1,230 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/event"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/model"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+)
+
+func d(v int64) decimal.Decimal { return decimal.NewFromInt(v) }
+
+// inMemoryConsentRepo is an in-memory ConsentRepository.
+type inMemoryConsentRepo struct {
+	consents []model.Consent
+}
+
+func (r *inMemoryConsentRepo) Save(_ context.Context, consent model.Consent) error {
+	// Events are not persisted.
+	consent = consent.ClearDomainEvents()
+	i := slices.IndexFunc(r.consents, func(c model.Consent) bool { return c.ID() == consent.ID() })
+	if i < 0 {
+		r.consents = append(r.consents, consent)
+		return nil
+	}
+	if r.consents[i].Version() != consent.Version()-1 {
+		return port.ErrVersionConflict
+	}
+	r.consents[i] = consent
+	return nil
+}
+
+func (r *inMemoryConsentRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.Consent, error) {
+	for _, c := range r.consents {
+		if c.TenantID() == tenantID && c.ID() == id {
+			return c, nil
+		}
+	}
+	return model.Consent{}, port.ErrConsentNotFound
+}
+
+func (r *inMemoryConsentRepo) List(_ context.Context, filter port.ConsentFilter, limit, offset int) ([]model.Consent, int, error) {
+	var out []model.Consent
+	for _, c := range r.consents {
+		if c.TenantID() == filter.TenantID && (filter.Status.IsZero() || c.Status() == filter.Status) {
+			out = append(out, c)
+		}
+	}
+	total := len(out)
+	out = out[min(offset, total):min(offset+limit, total)]
+	return out, total, nil
+}
+
+func (r *inMemoryConsentRepo) ListExpirable(_ context.Context, now, receivedBefore time.Time, limit int) ([]model.Consent, error) {
+	var out []model.Consent
+	for _, c := range r.consents {
+		if (c.Status().String() == "VALID" && c.IsExpiredAt(now)) || (c.Status().String() == "RECEIVED" && c.CreatedAt().Before(receivedBefore)) {
+			out = append(out, c)
+		}
+	}
+	return out[:min(limit, len(out))], nil
+}
+
+func (r *inMemoryConsentRepo) ListUnexecuted(_ context.Context, authorisedBefore time.Time, limit int) ([]model.Consent, error) {
+	var out []model.Consent
+	for _, c := range r.consents {
+		if c.IsPayment() && c.Status().String() == "VALID" && c.AuthorisedAt().Before(authorisedBefore) {
+			out = append(out, c)
+		}
+	}
+	return out[:min(limit, len(out))], nil
+}
+
+// inMemoryProviderRepo is an in-memory ProviderRepository.
+type inMemoryProviderRepo struct {
+	providers []model.ThirdPartyProvider
+}
+
+func (r *inMemoryProviderRepo) Save(_ context.Context, provider model.ThirdPartyProvider) error {
+	provider = provider.ClearDomainEvents()
+	i := slices.IndexFunc(r.providers, func(p model.ThirdPartyProvider) bool { return p.ID() == provider.ID() })
+	if i < 0 {
+		r.providers = append(r.providers, provider)
+	} else {
+		r.providers[i] = provider
+	}
+	return nil
+}
+
+func (r *inMemoryProviderRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.ThirdPartyProvider, error) {
+	for _, p := range r.providers {
+		if p.TenantID() == tenantID && p.ID() == id {
+			return p, nil
+		}
+	}
+	return model.ThirdPartyProvider{}, port.ErrProviderNotFound
+}
+
+func (r *inMemoryProviderRepo) FindByOrganizationID(_ context.Context, tenantID uuid.UUID, organizationID string) (model.ThirdPartyProvider, error) {
+	for _, p := range r.providers {
+		if p.TenantID() == tenantID && p.Identity().OrganizationID == organizationID {
+			return p, nil
+		}
+	}
+	return model.ThirdPartyProvider{}, port.ErrProviderNotFound
+}
+
+func (r *inMemoryProviderRepo) List(_ context.Context, tenantID uuid.UUID, limit, offset int) ([]model.ThirdPartyProvider, int, error) {
+	var out []model.ThirdPartyProvider
+	for _, p := range r.providers {
+		if p.TenantID() == tenantID {
+			out = append(out, p)
+		}
+	}
+	total := len(out)
+	return out[min(offset, total):min(offset+limit, total)], total, nil
+}
+
+// recordingPublisher records the types of the events published.
+type recordingPublisher struct {
+	types []string
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, events []event.DomainEvent) error {
+	for _, e := range events {
+		p.types = append(p.types, e.EventType())
+	}
+	return nil
+}
+
+// fakeAccounts serves accounts held by PSUs.
+type fakeAccounts struct {
+	accounts map[uuid.UUID]port.Account
+	holders  map[uuid.UUID][]uuid.UUID
+}
+
+func (f *fakeAccounts) add(tenantID, holderID uuid.UUID, currency string) port.Account {
+	a := port.Account{ID: uuid.New(), TenantID: tenantID, Status: "ACTIVE", Currency: currency, LedgerAccountCode: "2000-" + currency}
+	if f.accounts == nil {
+		f.accounts, f.holders = map[uuid.UUID]port.Account{}, map[uuid.UUID][]uuid.UUID{}
+	}
+	f.accounts[a.ID] = a
+	f.holders[holderID] = append(f.holders[holderID], a.ID)
+	return a
+}
+
+func (f *fakeAccounts) GetAccount(_ context.Context, tenantID, accountID uuid.UUID) (port.Account, error) {
+	a, ok := f.accounts[accountID]
+	if !ok || a.TenantID != tenantID {
+		return port.Account{}, port.ErrAccountNotFound
+	}
+	return a, nil
+}
+
+func (f *fakeAccounts) ListHolderAccounts(_ context.Context, _, holderID uuid.UUID) ([]port.Account, error) {
+	var out []port.Account
+	for _, id := range f.holders[holderID] {
+		out = append(out, f.accounts[id])
+	}
+	return out, nil
+}
+
+// fakeLedger reports the same balance for every account.
+type fakeLedger struct {
+	balance decimal.Decimal
+}
+
+func (f fakeLedger) GetBalance(context.Context, uuid.UUID, string, string) (decimal.Decimal, error) {
+	return f.balance, nil
+}
+
+// fakePayments records submitted payments, deduplicating them by
+// idempotency key as the payment service does.
+type fakePayments struct {
+	err      error
+	byKey    map[string]port.Payment
+	payments []port.Payment
+}
+
+func (f *fakePayments) InitiatePayment(_ context.Context, in port.PaymentInstruction) (port.Payment, error) {
+	if f.err != nil {
+		return port.Payment{}, f.err
+	}
+	if f.byKey == nil {
+		f.byKey = map[string]port.Payment{}
+	}
+	if p, ok := f.byKey[in.IdempotencyKey]; ok {
+		return p, nil
+	}
+	p := port.Payment{
+		ID:                   uuid.New(),
+		SourceAccountID:      in.SourceAccountID,
+		DestinationAccountID: in.DestinationAccountID,
+		Amount:               in.Amount,
+		Currency:             in.Currency,
+		Status:               "INITIATED",
+		InitiatedAt:          time.Now(),
+	}
+	f.byKey[in.IdempotencyKey] = p
+	f.payments = append(f.payments, p)
+	return p, nil
+}
+
+func (f *fakePayments) GetPayment(_ context.Context, _, paymentID uuid.UUID) (port.Payment, error) {
+	for _, p := range f.payments {
+		if p.ID == paymentID {
+			return p, nil
+		}
+	}
+	return port.Payment{}, port.ErrPaymentNotFound
+}
+
+func (f *fakePayments) ListAccountPayments(_ context.Context, _, accountID uuid.UUID, limit, offset int) ([]port.Payment, int, error) {
+	var out []port.Payment
+	for _, p := range f.payments {
+		if p.SourceAccountID == accountID || (p.DestinationAccountID != nil && *p.DestinationAccountID == accountID) {
+			out = append(out, p)
+		}
+	}
+	total := len(out)
+	return out[min(offset, total):min(offset+limit, total)], total, nil
+}
+
+var errUnavailable = errors.New("payment service unavailable")
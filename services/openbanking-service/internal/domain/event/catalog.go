@@ -0,0 +1,16 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[ConsentCreated]("openbanking.consent.created", 1, "Emitted when a TPP requests a consent, before the PSU has authorised it."),
+		events.Declare[ConsentAuthorised]("openbanking.consent.authorised", 1, "Emitted when the PSU authorises a consent for the accounts listed."),
+		events.Declare[ConsentClosed]("openbanking.consent.closed", 1, "Emitted when a consent reaches a final status other than CONSUMED: it was rejected, revoked by the PSU, terminated by the TPP or expired."),
+		events.Declare[PaymentInitiated]("openbanking.payment.initiated", 1, "Emitted when the payment an authorised payment consent stands for is submitted to the payment service."),
+		events.Declare[ProviderRegistered]("openbanking.provider.registered", 1, "Emitted when a TPP first calls the API with a valid eIDAS certificate."),
+		events.Declare[ProviderStatusChanged]("openbanking.provider.status_changed", 1, "Emitted when an operator suspends or reactivates a TPP."),
+	}
+}
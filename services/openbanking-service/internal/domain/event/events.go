@@ -0,0 +1,140 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// DomainEvent is an alias for the shared pkg/events.DomainEvent interface.
+type DomainEvent = events.DomainEvent
+
+// Aggregate types of open banking events.
+const (
+	AggregateTypeConsent  = "Consent"
+	AggregateTypeProvider = "ThirdPartyProvider"
+)
+
+// ConsentCreated is emitted when a TPP requests a consent, before the PSU
+// has authorised it.
+type ConsentCreated struct {
+	ValidUntil *time.Time `json:"valid_until,omitempty"`
+	events.BaseEvent
+	ConsentType string    `json:"consent_type"`
+	Permissions []string  `json:"permissions,omitempty"`
+	ProviderID  uuid.UUID `json:"provider_id"`
+}
+
+// NewConsentCreated creates a new ConsentCreated event.
+func NewConsentCreated(consentID, tenantID, providerID uuid.UUID, consentType string, permissions []string, validUntil *time.Time) ConsentCreated {
+	return ConsentCreated{
+		BaseEvent:   events.NewBaseEvent("openbanking.consent.created", consentID.String(), AggregateTypeConsent, tenantID.String()),
+		ProviderID:  providerID,
+		ConsentType: consentType,
+		Permissions: permissions,
+		ValidUntil:  validUntil,
+	}
+}
+
+// ConsentAuthorised is emitted when the PSU authorises a consent for the
+// accounts listed.
+type ConsentAuthorised struct {
+	events.BaseEvent
+	AccountIDs []uuid.UUID `json:"account_ids"`
+	ProviderID uuid.UUID   `json:"provider_id"`
+	PSUID      uuid.UUID   `json:"psu_id"`
+}
+
+// NewConsentAuthorised creates a new ConsentAuthorised event.
+func NewConsentAuthorised(consentID, tenantID, providerID, psuID uuid.UUID, accountIDs []uuid.UUID) ConsentAuthorised {
+	return ConsentAuthorised{
+		BaseEvent:  events.NewBaseEvent("openbanking.consent.authorised", consentID.String(), AggregateTypeConsent, tenantID.String()),
+		ProviderID: providerID,
+		PSUID:      psuID,
+		AccountIDs: accountIDs,
+	}
+}
+
+// ConsentClosed is emitted when a consent reaches a final status other than
+// CONSUMED: it was rejected, revoked by the PSU, terminated by the TPP or
+// expired.
+type ConsentClosed struct {
+	events.BaseEvent
+	Status     string    `json:"status"`
+	Reason     string    `json:"reason,omitempty"`
+	ProviderID uuid.UUID `json:"provider_id"`
+}
+
+// NewConsentClosed creates a new ConsentClosed event.
+func NewConsentClosed(consentID, tenantID, providerID uuid.UUID, status, reason string) ConsentClosed {
+	return ConsentClosed{
+		BaseEvent:  events.NewBaseEvent("openbanking.consent.closed", consentID.String(), AggregateTypeConsent, tenantID.String()),
+		ProviderID: providerID,
+		Status:     status,
+		Reason:     reason,
+	}
+}
+
+// PaymentInitiated is emitted when the payment an authorised payment
+// consent stands for is submitted to the payment service.
+type PaymentInitiated struct {
+	events.BaseEvent
+	Currency        string          `json:"currency"`
+	Amount          decimal.Decimal `json:"amount"`
+	ProviderID      uuid.UUID       `json:"provider_id"`
+	DebtorAccountID uuid.UUID       `json:"debtor_account_id"`
+	PaymentID       uuid.UUID       `json:"payment_id"`
+}
+
+// NewPaymentInitiated creates a new PaymentInitiated event.
+func NewPaymentInitiated(consentID, tenantID, providerID, debtorAccountID, paymentID uuid.UUID, amount decimal.Decimal, currency string) PaymentInitiated {
+	return PaymentInitiated{
+		BaseEvent:       events.NewBaseEvent("openbanking.payment.initiated", consentID.String(), AggregateTypeConsent, tenantID.String()),
+		ProviderID:      providerID,
+		DebtorAccountID: debtorAccountID,
+		PaymentID:       paymentID,
+		Amount:          amount,
+		Currency:        currency,
+	}
+}
+
+// ProviderRegistered is emitted when a TPP first calls the API with a
+// valid eIDAS certificate.
+type ProviderRegistered struct {
+	events.BaseEvent
+	OrganizationID string   `json:"organization_id"`
+	Name           string   `json:"name"`
+	Roles          []string `json:"roles"`
+}
+
+// NewProviderRegistered creates a new ProviderRegistered event.
+func NewProviderRegistered(providerID, tenantID uuid.UUID, organizationID, name string, roles []string) ProviderRegistered {
+	return ProviderRegistered{
+		BaseEvent:      events.NewBaseEvent("openbanking.provider.registered", providerID.String(), AggregateTypeProvider, tenantID.String()),
+		OrganizationID: organizationID,
+		Name:           name,
+		Roles:          roles,
+	}
+}
+
+// ProviderStatusChanged is emitted when an operator suspends or reactivates
+// a TPP.
+type ProviderStatusChanged struct {
+	events.BaseEvent
+	OrganizationID string `json:"organization_id"`
+	Status         string `json:"status"`
+	Reason         string `json:"reason,omitempty"`
+}
+
+// NewProviderStatusChanged creates a new ProviderStatusChanged event.
+func NewProviderStatusChanged(providerID, tenantID uuid.UUID, organizationID, status, reason string) ProviderStatusChanged {
+	return ProviderStatusChanged{
+		BaseEvent:      events.NewBaseEvent("openbanking.provider.status_changed", providerID.String(), AggregateTypeProvider, tenantID.String()),
+		OrganizationID: organizationID,
+		Status:         status,
+		Reason:         reason,
+	}
+}
@@ -0,0 +1,462 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/event"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/valueobject"
+)
+
+var (
+	// ErrInvalidTransition is returned when a consent or provider cannot
+	// move to the requested status from the one it is in.
+	ErrInvalidTransition = errors.New("invalid status transition")
+	// ErrConsentNotValid is returned when a consent that is not VALID is
+	// used to access an account or initiate a payment.
+	ErrConsentNotValid = errors.New("consent is not valid")
+	// ErrConsentExpired is returned when a consent is used after its
+	// validUntil date.
+	ErrConsentExpired = errors.New("consent has expired")
+	// ErrNotPermitted is returned when a consent does not cover the account
+	// or data a TPP asked for.
+	ErrNotPermitted = errors.New("consent does not permit this access")
+	// ErrAccessLimitExceeded is returned when a TPP reads an account more
+	// often than its consent's frequencyPerDay without the PSU present.
+	ErrAccessLimitExceeded = errors.New("consent access limit exceeded")
+)
+
+const (
+	// MaxConsentValidity is how long an account access consent may last
+	// before the PSU has to authorise it again (RTS Art. 10).
+	MaxConsentValidity = 180 * 24 * time.Hour
+	// MaxFrequencyPerDay is how many times a day a TPP may read an account
+	// without the PSU present (RTS Art. 36(5)).
+	MaxFrequencyPerDay = 4
+)
+
+// PaymentProducts are the payment products a TPP may initiate.
+var PaymentProducts = []string{
+	"sepa-credit-transfers",
+	"instant-sepa-credit-transfers",
+	"cross-border-credit-transfers",
+	"domestic-credit-transfers",
+}
+
+// PaymentDetails is the payment a payment initiation consent stands for.
+// The creditor is either an account at the bank or an external account
+// identified by number and routing number.
+type PaymentDetails struct {
+	Amount                decimal.Decimal
+	CreditorAccountID     *uuid.UUID
+	Product               string
+	CreditorAccountNumber string
+	CreditorRoutingNumber string
+	CreditorName          string
+	CreditorCountry       string
+	Currency              string
+	RemittanceInformation string
+	DebtorAccountID       uuid.UUID
+}
+
+// AccountAccess is what an account access consent asks for.
+type AccountAccess struct {
+	Permissions []valueobject.Permission
+	// AccountIDs are the accounts the TPP asks to read. When empty, the
+	// PSU chooses the accounts on authorising the consent.
+	AccountIDs []uuid.UUID
+	// ValidUntil is the last day the consent can be used. Zero means as
+	// long as allowed.
+	ValidUntil time.Time
+	// FrequencyPerDay is how many times a day the TPP may read the accounts
+	// without the PSU present. A one-off consent may be read once.
+	FrequencyPerDay int
+	Recurring       bool
+}
+
+// Consent is the aggregate root for a PSU's consent to a TPP, either to
+// read accounts (account information) or to initiate one payment (payment
+// initiation). A TPP creates it RECEIVED and the PSU authorises it at the
+// bank, which makes it VALID.
+type Consent struct {
+	createdAt       time.Time
+	updatedAt       time.Time
+	validUntil      time.Time
+	accessDay       time.Time
+	authorisedAt    *time.Time
+	psuID           *uuid.UUID
+	paymentID       *uuid.UUID
+	payment         *PaymentDetails
+	consentType     valueobject.ConsentType
+	status          valueobject.ConsentStatus
+	statusReason    string
+	permissions     []valueobject.Permission
+	accountIDs      []uuid.UUID
+	domainEvents    []events.DomainEvent
+	frequencyPerDay int
+	accessCount     int
+	version         int
+	recurring       bool
+	id              uuid.UUID
+	tenantID        uuid.UUID
+	providerID      uuid.UUID
+}
+
+// NewAccountConsent creates a RECEIVED account access consent.
+func NewAccountConsent(tenantID, providerID uuid.UUID, access AccountAccess, now time.Time) (Consent, error) {
+	if err := validateParties(tenantID, providerID); err != nil {
+		return Consent{}, err
+	}
+	if len(access.Permissions) == 0 {
+		return Consent{}, fmt.Errorf("at least one permission is required")
+	}
+	today := day(now)
+	validUntil := access.ValidUntil
+	if validUntil.IsZero() {
+		validUntil = today.Add(MaxConsentValidity)
+	}
+	validUntil = day(validUntil)
+	if validUntil.Before(today) {
+		return Consent{}, fmt.Errorf("validUntil %s is in the past", validUntil.Format(time.DateOnly))
+	}
+	if validUntil.After(today.Add(MaxConsentValidity)) {
+		return Consent{}, fmt.Errorf("validUntil may be at most %d days ahead", int(MaxConsentValidity.Hours()/24))
+	}
+	frequency := access.FrequencyPerDay
+	if !access.Recurring {
+		frequency = 1
+	}
+	if frequency < 1 || frequency > MaxFrequencyPerDay {
+		return Consent{}, fmt.Errorf("frequencyPerDay must be between 1 and %d", MaxFrequencyPerDay)
+	}
+
+	c := Consent{
+		id:              uuid.New(),
+		tenantID:        tenantID,
+		providerID:      providerID,
+		consentType:     valueobject.ConsentTypeAccounts,
+		status:          valueobject.ConsentStatusReceived,
+		permissions:     slices.Clone(access.Permissions),
+		accountIDs:      slices.Clone(access.AccountIDs),
+		validUntil:      validUntil,
+		frequencyPerDay: frequency,
+		recurring:       access.Recurring,
+		version:         1,
+		createdAt:       now,
+		updatedAt:       now,
+	}
+	permissions := make([]string, 0, len(c.permissions))
+	for _, p := range c.permissions {
+		permissions = append(permissions, p.String())
+	}
+	c.domainEvents = append(c.domainEvents, event.NewConsentCreated(c.id, tenantID, providerID, c.consentType.String(), permissions, &validUntil))
+	return c, nil
+}
+
+// NewPaymentConsent creates a RECEIVED payment initiation consent.
+func NewPaymentConsent(tenantID, providerID uuid.UUID, payment PaymentDetails, now time.Time) (Consent, error) {
+	if err := validateParties(tenantID, providerID); err != nil {
+		return Consent{}, err
+	}
+	if !slices.Contains(PaymentProducts, payment.Product) {
+		return Consent{}, fmt.Errorf("unknown payment product %q", payment.Product)
+	}
+	if !payment.Amount.IsPositive() {
+		return Consent{}, fmt.Errorf("amount must be positive")
+	}
+	if len(payment.Currency) != 3 || strings.ToUpper(payment.Currency) != payment.Currency {
+		return Consent{}, fmt.Errorf("currency must be a 3-letter uppercase ISO code")
+	}
+	if payment.DebtorAccountID == uuid.Nil {
+		return Consent{}, fmt.Errorf("debtor account is required")
+	}
+	external := payment.CreditorAccountNumber != ""
+	if (payment.CreditorAccountID != nil) == external {
+		return Consent{}, fmt.Errorf("exactly one of a creditor account ID or account number is required")
+	}
+	if payment.CreditorAccountID != nil && *payment.CreditorAccountID == payment.DebtorAccountID {
+		return Consent{}, fmt.Errorf("creditor and debtor accounts must differ")
+	}
+	if strings.TrimSpace(payment.CreditorName) == "" {
+		return Consent{}, fmt.Errorf("creditor name is required")
+	}
+
+	c := Consent{
+		id:          uuid.New(),
+		tenantID:    tenantID,
+		providerID:  providerID,
+		consentType: valueobject.ConsentTypePayment,
+		status:      valueobject.ConsentStatusReceived,
+		accountIDs:  []uuid.UUID{payment.DebtorAccountID},
+		payment:     &payment,
+		version:     1,
+		createdAt:   now,
+		updatedAt:   now,
+	}
+	c.domainEvents = append(c.domainEvents, event.NewConsentCreated(c.id, tenantID, providerID, c.consentType.String(), nil, nil))
+	return c, nil
+}
+
+// ReconstructConsent recreates a Consent from persisted data without
+// validation or events.
+func ReconstructConsent(
+	id, tenantID, providerID uuid.UUID,
+	consentType valueobject.ConsentType,
+	status valueobject.ConsentStatus,
+	statusReason string,
+	permissions []valueobject.Permission,
+	accountIDs []uuid.UUID,
+	validUntil time.Time,
+	frequencyPerDay int,
+	recurring bool,
+	accessDay time.Time,
+	accessCount int,
+	payment *PaymentDetails,
+	psuID, paymentID *uuid.UUID,
+	authorisedAt *time.Time,
+	version int,
+	createdAt, updatedAt time.Time,
+) Consent {
+	return Consent{
+		id:              id,
+		tenantID:        tenantID,
+		providerID:      providerID,
+		consentType:     consentType,
+		status:          status,
+		statusReason:    statusReason,
+		permissions:     permissions,
+		accountIDs:      accountIDs,
+		validUntil:      validUntil,
+		frequencyPerDay: frequencyPerDay,
+		recurring:       recurring,
+		accessDay:       accessDay,
+		accessCount:     accessCount,
+		payment:         payment,
+		psuID:           psuID,
+		paymentID:       paymentID,
+		authorisedAt:    authorisedAt,
+		version:         version,
+		createdAt:       createdAt,
+		updatedAt:       updatedAt,
+	}
+}
+
+// Authorise records the PSU's authorisation of a RECEIVED consent, making
+// it VALID. For an account access consent the PSU may narrow the accounts
+// the TPP asked for, and must choose them if the TPP asked for none. A
+// payment consent is always for its debtor account.
+func (c Consent) Authorise(psuID uuid.UUID, accountIDs []uuid.UUID, now time.Time) (Consent, error) {
+	if c.status != valueobject.ConsentStatusReceived {
+		return c, fmt.Errorf("consent is %s: %w", c.status, ErrInvalidTransition)
+	}
+	if psuID == uuid.Nil {
+		return c, fmt.Errorf("PSU ID is required")
+	}
+	if c.IsPayment() {
+		if len(accountIDs) > 0 && !slices.Equal(accountIDs, c.accountIDs) {
+			return c, fmt.Errorf("a payment consent can only be authorised for its debtor account: %w", ErrNotPermitted)
+		}
+	} else {
+		switch {
+		case len(c.accountIDs) == 0 && len(accountIDs) == 0:
+			return c, fmt.Errorf("the PSU must choose at least one account")
+		case len(c.accountIDs) == 0:
+			c.accountIDs = uniqueIDs(accountIDs)
+		case len(accountIDs) > 0:
+			for _, id := range accountIDs {
+				if !slices.Contains(c.accountIDs, id) {
+					return c, fmt.Errorf("account %s was not requested by the TPP: %w", id, ErrNotPermitted)
+				}
+			}
+			c.accountIDs = uniqueIDs(accountIDs)
+		}
+	}
+	c.psuID = &psuID
+	c.authorisedAt = &now
+	c.status = valueobject.ConsentStatusValid
+	c.version++
+	c.updatedAt = now
+	c.domainEvents = append(c.domainEvents, event.NewConsentAuthorised(c.id, c.tenantID, c.providerID, psuID, c.accountIDs))
+	return c, nil
+}
+
+// Reject records that the PSU refused a RECEIVED consent, or that the
+// payment an authorised payment consent stands for was refused.
+func (c Consent) Reject(reason string, now time.Time) (Consent, error) {
+	if c.status != valueobject.ConsentStatusReceived && !(c.IsPayment() && c.status == valueobject.ConsentStatusValid) {
+		return c, fmt.Errorf("consent is %s: %w", c.status, ErrInvalidTransition)
+	}
+	return c.close(valueobject.ConsentStatusRejected, reason, now), nil
+}
+
+// Revoke withdraws a consent on the PSU's behalf. Once authorised, a
+// payment consent can no longer be revoked: its payment is on its way.
+func (c Consent) Revoke(now time.Time) (Consent, error) {
+	if c.status.IsFinal() || (c.IsPayment() && c.status == valueobject.ConsentStatusValid) {
+		return c, fmt.Errorf("consent is %s: %w", c.status, ErrInvalidTransition)
+	}
+	return c.close(valueobject.ConsentStatusRevoked, "revoked by the PSU", now), nil
+}
+
+// Terminate withdraws a consent on the TPP's behalf, under the same rules
+// as Revoke.
+func (c Consent) Terminate(now time.Time) (Consent, error) {
+	if c.status.IsFinal() || (c.IsPayment() && c.status == valueobject.ConsentStatusValid) {
+		return c, fmt.Errorf("consent is %s: %w", c.status, ErrInvalidTransition)
+	}
+	return c.close(valueobject.ConsentStatusTerminated, "terminated by the TPP", now), nil
+}
+
+// Expire closes a consent that has passed its validUntil date or was not
+// authorised in time.
+func (c Consent) Expire(reason string, now time.Time) (Consent, error) {
+	if c.status.IsFinal() || (c.IsPayment() && c.status == valueobject.ConsentStatusValid) {
+		return c, fmt.Errorf("consent is %s: %w", c.status, ErrInvalidTransition)
+	}
+	return c.close(valueobject.ConsentStatusExpired, reason, now), nil
+}
+
+func (c Consent) close(status valueobject.ConsentStatus, reason string, now time.Time) Consent {
+	c.status = status
+	c.statusReason = reason
+	c.version++
+	c.updatedAt = now
+	c.domainEvents = append(c.domainEvents, event.NewConsentClosed(c.id, c.tenantID, c.providerID, status.String(), reason))
+	return c
+}
+
+// Consume records that the payment an authorised payment consent stands
+// for was submitted as paymentID.
+func (c Consent) Consume(paymentID uuid.UUID, now time.Time) (Consent, error) {
+	if !c.IsPayment() || c.status != valueobject.ConsentStatusValid {
+		return c, fmt.Errorf("consent is %s %s: %w", c.status, c.consentType, ErrInvalidTransition)
+	}
+	c.paymentID = &paymentID
+	c.status = valueobject.ConsentStatusConsumed
+	c.version++
+	c.updatedAt = now
+	c.domainEvents = append(c.domainEvents, event.NewPaymentInitiated(c.id, c.tenantID, c.providerID, c.payment.DebtorAccountID, paymentID, c.payment.Amount, c.payment.Currency))
+	return c, nil
+}
+
+// RecordAccess checks that the consent lets the TPP read permission for
+// accountID, or list the consent's accounts when accountID is nil. Reads
+// without the PSU present count towards the consent's frequencyPerDay,
+// which the returned consent tracks.
+func (c Consent) RecordAccess(accountID *uuid.UUID, permission valueobject.Permission, psuPresent bool, now time.Time) (Consent, error) {
+	if c.IsPayment() {
+		return c, fmt.Errorf("payment consents give no account access: %w", ErrNotPermitted)
+	}
+	if c.status == valueobject.ConsentStatusExpired || (c.status == valueobject.ConsentStatusValid && c.IsExpiredAt(now)) {
+		return c, ErrConsentExpired
+	}
+	if c.status != valueobject.ConsentStatusValid {
+		return c, fmt.Errorf("consent is %s: %w", c.status, ErrConsentNotValid)
+	}
+	if !slices.Contains(c.permissions, permission) {
+		return c, fmt.Errorf("consent does not grant %s: %w", permission, ErrNotPermitted)
+	}
+	if accountID != nil && !slices.Contains(c.accountIDs, *accountID) {
+		return c, fmt.Errorf("consent does not cover account %s: %w", accountID, ErrNotPermitted)
+	}
+	if psuPresent {
+		return c, nil
+	}
+	today := day(now)
+	if !c.accessDay.Equal(today) {
+		c.accessDay = today
+		c.accessCount = 0
+	}
+	if c.accessCount >= c.frequencyPerDay {
+		return c, fmt.Errorf("%d accesses today: %w", c.accessCount, ErrAccessLimitExceeded)
+	}
+	c.accessCount++
+	c.version++
+	c.updatedAt = now
+	return c, nil
+}
+
+// IsExpiredAt reports whether an account access consent is past its
+// validUntil date at now.
+func (c Consent) IsExpiredAt(now time.Time) bool {
+	return !c.IsPayment() && !now.Before(c.validUntil.AddDate(0, 0, 1))
+}
+
+// IsPayment reports whether the consent is for a payment initiation.
+func (c Consent) IsPayment() bool {
+	return c.consentType == valueobject.ConsentTypePayment
+}
+
+// IsAccessibleBy reports whether psuID may see the consent: any PSU may
+// look at a consent not yet authorised, but once authorised it is the
+// authorising PSU's.
+func (c Consent) IsAccessibleBy(psuID uuid.UUID) bool {
+	return c.psuID == nil || *c.psuID == psuID
+}
+
+func validateParties(tenantID, providerID uuid.UUID) error {
+	if tenantID == uuid.Nil {
+		return fmt.Errorf("tenant ID is required")
+	}
+	if providerID == uuid.Nil {
+		return fmt.Errorf("provider ID is required")
+	}
+	return nil
+}
+
+// day truncates t to its UTC date.
+func day(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	out := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !slices.Contains(out, id) {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// --- Accessors ---
+
+func (c Consent) ID() uuid.UUID                         { return c.id }
+func (c Consent) TenantID() uuid.UUID                   { return c.tenantID }
+func (c Consent) ProviderID() uuid.UUID                 { return c.providerID }
+func (c Consent) Type() valueobject.ConsentType         { return c.consentType }
+func (c Consent) Status() valueobject.ConsentStatus     { return c.status }
+func (c Consent) StatusReason() string                  { return c.statusReason }
+func (c Consent) Permissions() []valueobject.Permission { return c.permissions }
+func (c Consent) AccountIDs() []uuid.UUID               { return c.accountIDs }
+func (c Consent) ValidUntil() time.Time                 { return c.validUntil }
+func (c Consent) FrequencyPerDay() int                  { return c.frequencyPerDay }
+func (c Consent) Recurring() bool                       { return c.recurring }
+func (c Consent) AccessDay() time.Time                  { return c.accessDay }
+func (c Consent) AccessCount() int                      { return c.accessCount }
+func (c Consent) Payment() *PaymentDetails              { return c.payment }
+func (c Consent) PSUID() *uuid.UUID                     { return c.psuID }
+func (c Consent) PaymentID() *uuid.UUID                 { return c.paymentID }
+func (c Consent) AuthorisedAt() *time.Time              { return c.authorisedAt }
+func (c Consent) Version() int                          { return c.version }
+func (c Consent) CreatedAt() time.Time                  { return c.createdAt }
+func (c Consent) UpdatedAt() time.Time                  { return c.updatedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (c Consent) DomainEvents() []events.DomainEvent {
+	return c.domainEvents
+}
+
+// ClearDomainEvents returns a copy of the consent with no uncommitted events.
+func (c Consent) ClearDomainEvents() Consent {
+	c.domainEvents = nil
+	return c
+}
@@ -0,0 +1,203 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/model"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/valueobject"
+)
+
+var now = time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+func accountConsent(t *testing.T, access model.AccountAccess) model.Consent {
+	t.Helper()
+	c, err := model.NewAccountConsent(uuid.New(), uuid.New(), access, now)
+	require.NoError(t, err)
+	return c.ClearDomainEvents()
+}
+
+func paymentDetails(debtor uuid.UUID) model.PaymentDetails {
+	return model.PaymentDetails{
+		Product:               "sepa-credit-transfers",
+		DebtorAccountID:       debtor,
+		CreditorAccountNumber: "DE89370400440532013000",
+		CreditorRoutingNumber: "COBADEFFXXX",
+		CreditorName:          "Merchant GmbH",
+		Amount:                decimal.NewFromInt(125),
+		Currency:              "EUR",
+	}
+}
+
+func TestNewAccountConsent(t *testing.T) {
+	tenantID, providerID, acct := uuid.New(), uuid.New(), uuid.New()
+	c, err := model.NewAccountConsent(tenantID, providerID, model.AccountAccess{
+		Permissions: []valueobject.Permission{valueobject.PermissionReadAccounts},
+		AccountIDs:  []uuid.UUID{acct},
+	}, now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.ConsentStatusReceived, c.Status())
+	assert.Equal(t, time.Date(2026, 9, 6, 0, 0, 0, 0, time.UTC), c.ValidUntil(), "defaults to the longest validity")
+	assert.Equal(t, 1, c.FrequencyPerDay(), "a one-off consent may be read once a day")
+	require.Len(t, c.DomainEvents(), 1)
+	assert.Equal(t, "openbanking.consent.created", c.DomainEvents()[0].EventType())
+
+	_, err = model.NewAccountConsent(tenantID, providerID, model.AccountAccess{}, now)
+	assert.Error(t, err, "no permissions")
+	_, err = model.NewAccountConsent(tenantID, providerID, model.AccountAccess{
+		Permissions: []valueobject.Permission{valueobject.PermissionReadBalances},
+		ValidUntil:  now.AddDate(1, 0, 0),
+	}, now)
+	assert.Error(t, err, "beyond 180 days")
+	_, err = model.NewAccountConsent(tenantID, providerID, model.AccountAccess{
+		Permissions: []valueobject.Permission{valueobject.PermissionReadBalances},
+		ValidUntil:  now.AddDate(0, 0, -1),
+	}, now)
+	assert.Error(t, err, "in the past")
+	_, err = model.NewAccountConsent(tenantID, providerID, model.AccountAccess{
+		Permissions:     []valueobject.Permission{valueobject.PermissionReadBalances},
+		Recurring:       true,
+		FrequencyPerDay: 5,
+	}, now)
+	assert.Error(t, err, "more than four unattended reads a day")
+}
+
+func TestConsent_Authorise(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+	psu := uuid.New()
+
+	t.Run("PSU narrows the requested accounts", func(t *testing.T) {
+		c := accountConsent(t, model.AccountAccess{Permissions: []valueobject.Permission{valueobject.PermissionReadAccounts}, AccountIDs: []uuid.UUID{a, b}})
+		got, err := c.Authorise(psu, []uuid.UUID{b}, now)
+		require.NoError(t, err)
+		assert.Equal(t, valueobject.ConsentStatusValid, got.Status())
+		assert.Equal(t, []uuid.UUID{b}, got.AccountIDs())
+		assert.True(t, got.IsAccessibleBy(psu))
+		assert.False(t, got.IsAccessibleBy(uuid.New()))
+		require.Len(t, got.DomainEvents(), 1)
+		assert.Equal(t, "openbanking.consent.authorised", got.DomainEvents()[0].EventType())
+
+		_, err = c.Authorise(psu, []uuid.UUID{uuid.New()}, now)
+		assert.ErrorIs(t, err, model.ErrNotPermitted, "an account the TPP did not ask for")
+		_, err = got.Authorise(psu, nil, now)
+		assert.ErrorIs(t, err, model.ErrInvalidTransition)
+	})
+
+	t.Run("PSU chooses the accounts", func(t *testing.T) {
+		c := accountConsent(t, model.AccountAccess{Permissions: []valueobject.Permission{valueobject.PermissionReadAccounts}})
+		_, err := c.Authorise(psu, nil, now)
+		assert.Error(t, err)
+		got, err := c.Authorise(psu, []uuid.UUID{a, a}, now)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{a}, got.AccountIDs())
+	})
+}
+
+func TestConsent_RecordAccess(t *testing.T) {
+	acct := uuid.New()
+	c := accountConsent(t, model.AccountAccess{
+		Permissions:     []valueobject.Permission{valueobject.PermissionReadAccounts, valueobject.PermissionReadBalances},
+		AccountIDs:      []uuid.UUID{acct},
+		Recurring:       true,
+		FrequencyPerDay: 2,
+		ValidUntil:      now.AddDate(0, 0, 30),
+	})
+	_, err := c.RecordAccess(&acct, valueobject.PermissionReadBalances, false, now)
+	assert.ErrorIs(t, err, model.ErrConsentNotValid, "not authorised yet")
+
+	c, err = c.Authorise(uuid.New(), nil, now)
+	require.NoError(t, err)
+
+	_, err = c.RecordAccess(&acct, valueobject.PermissionReadTransactions, true, now)
+	assert.ErrorIs(t, err, model.ErrNotPermitted, "permission not granted")
+	other := uuid.New()
+	_, err = c.RecordAccess(&other, valueobject.PermissionReadBalances, true, now)
+	assert.ErrorIs(t, err, model.ErrNotPermitted, "account not covered")
+
+	for i := 0; i < 2; i++ {
+		c, err = c.RecordAccess(&acct, valueobject.PermissionReadBalances, false, now)
+		require.NoError(t, err)
+	}
+	_, err = c.RecordAccess(&acct, valueobject.PermissionReadBalances, false, now)
+	assert.ErrorIs(t, err, model.ErrAccessLimitExceeded)
+	_, err = c.RecordAccess(nil, valueobject.PermissionReadAccounts, true, now)
+	assert.NoError(t, err, "reads with the PSU present are not limited")
+
+	c, err = c.RecordAccess(&acct, valueobject.PermissionReadBalances, false, now.Add(24*time.Hour))
+	require.NoError(t, err, "the count resets the next day")
+	assert.Equal(t, 1, c.AccessCount())
+
+	_, err = c.RecordAccess(&acct, valueobject.PermissionReadBalances, true, now.AddDate(0, 0, 31))
+	assert.ErrorIs(t, err, model.ErrConsentExpired)
+	assert.False(t, c.IsExpiredAt(now.AddDate(0, 0, 30)), "validUntil is the last day the consent can be used")
+}
+
+func TestConsent_Close(t *testing.T) {
+	c := accountConsent(t, model.AccountAccess{Permissions: []valueobject.Permission{valueobject.PermissionReadAccounts}, AccountIDs: []uuid.UUID{uuid.New()}})
+	c, err := c.Authorise(uuid.New(), nil, now)
+	require.NoError(t, err)
+
+	revoked, err := c.ClearDomainEvents().Revoke(now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.ConsentStatusRevoked, revoked.Status())
+	require.Len(t, revoked.DomainEvents(), 1)
+	assert.Equal(t, "openbanking.consent.closed", revoked.DomainEvents()[0].EventType())
+	_, err = revoked.Terminate(now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+
+	_, err = c.Reject("too late", now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition, "an authorised account consent cannot be rejected")
+	expired, err := c.Expire("validUntil passed", now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.ConsentStatusExpired, expired.Status())
+}
+
+func TestPaymentConsent(t *testing.T) {
+	tenantID, providerID, debtor := uuid.New(), uuid.New(), uuid.New()
+	c, err := model.NewPaymentConsent(tenantID, providerID, paymentDetails(debtor), now)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{debtor}, c.AccountIDs())
+	assert.False(t, c.IsExpiredAt(now.AddDate(1, 0, 0)), "payment consents have no validUntil")
+
+	bad := paymentDetails(debtor)
+	bad.Product = "wire"
+	_, err = model.NewPaymentConsent(tenantID, providerID, bad, now)
+	assert.Error(t, err, "unknown product")
+	bad = paymentDetails(debtor)
+	bad.CreditorAccountID = &debtor
+	_, err = model.NewPaymentConsent(tenantID, providerID, bad, now)
+	assert.Error(t, err, "two creditors")
+	bad = paymentDetails(debtor)
+	bad.Amount = decimal.Zero
+	_, err = model.NewPaymentConsent(tenantID, providerID, bad, now)
+	assert.Error(t, err, "zero amount")
+
+	_, err = c.Consume(uuid.New(), now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition, "not authorised yet")
+	_, err = c.Authorise(uuid.New(), []uuid.UUID{uuid.New()}, now)
+	assert.ErrorIs(t, err, model.ErrNotPermitted, "another debtor account")
+
+	c, err = c.Authorise(uuid.New(), nil, now)
+	require.NoError(t, err)
+	_, err = c.Revoke(now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition, "the payment is on its way")
+	_, err = c.RecordAccess(&debtor, valueobject.PermissionReadAccounts, true, now)
+	assert.ErrorIs(t, err, model.ErrNotPermitted)
+
+	paymentID := uuid.New()
+	consumed, err := c.ClearDomainEvents().Consume(paymentID, now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.ConsentStatusConsumed, consumed.Status())
+	assert.Equal(t, paymentID, *consumed.PaymentID())
+	require.Len(t, consumed.DomainEvents(), 1)
+	assert.Equal(t, "openbanking.payment.initiated", consumed.DomainEvents()[0].EventType())
+
+	rejected, err := c.Reject("insufficient funds", now)
+	require.NoError(t, err, "an authorised payment whose submission failed is rejected")
+	assert.Equal(t, valueobject.ConsentStatusRejected, rejected.Status())
+}
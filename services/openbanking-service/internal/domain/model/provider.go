@@ -0,0 +1,188 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/event"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/valueobject"
+)
+
+// ProviderIdentity is what a TPP's eIDAS certificate says about it.
+type ProviderIdentity struct {
+	// OrganizationID is the PSD2 authorisation number from the
+	// certificate's organizationIdentifier, such as "PSDGB-FCA-123456".
+	OrganizationID string
+	Name           string
+	// NCAName and NCAID identify the competent authority that authorised
+	// the TPP.
+	NCAName string
+	NCAID   string
+	Roles   []valueobject.TPPRole
+	// CertificateFingerprint is the hex SHA-256 of the certificate the TPP
+	// last presented.
+	CertificateFingerprint string
+}
+
+// ThirdPartyProvider is the aggregate root for a TPP calling a tenant's
+// open banking API. It is registered the first time the TPP presents a
+// valid eIDAS certificate, and its identity is refreshed from the
+// certificates it presents later, such as when it renews one or its
+// authorised roles change.
+type ThirdPartyProvider struct {
+	createdAt    time.Time
+	updatedAt    time.Time
+	status       valueobject.ProviderStatus
+	statusReason string
+	identity     ProviderIdentity
+	domainEvents []events.DomainEvent
+	version      int
+	id           uuid.UUID
+	tenantID     uuid.UUID
+}
+
+// NewThirdPartyProvider registers an active TPP for the tenant.
+func NewThirdPartyProvider(tenantID uuid.UUID, identity ProviderIdentity, now time.Time) (ThirdPartyProvider, error) {
+	if tenantID == uuid.Nil {
+		return ThirdPartyProvider{}, fmt.Errorf("tenant ID is required")
+	}
+	if err := validateIdentity(identity); err != nil {
+		return ThirdPartyProvider{}, err
+	}
+	p := ThirdPartyProvider{
+		id:        uuid.New(),
+		tenantID:  tenantID,
+		identity:  identity,
+		status:    valueobject.ProviderStatusActive,
+		version:   1,
+		createdAt: now,
+		updatedAt: now,
+	}
+	p.domainEvents = append(p.domainEvents, event.NewProviderRegistered(p.id, tenantID, identity.OrganizationID, identity.Name, roleNames(identity.Roles)))
+	return p, nil
+}
+
+// ReconstructThirdPartyProvider recreates a ThirdPartyProvider from
+// persisted data without validation or events.
+func ReconstructThirdPartyProvider(
+	id, tenantID uuid.UUID,
+	identity ProviderIdentity,
+	status valueobject.ProviderStatus,
+	statusReason string,
+	version int,
+	createdAt, updatedAt time.Time,
+) ThirdPartyProvider {
+	return ThirdPartyProvider{
+		id:           id,
+		tenantID:     tenantID,
+		identity:     identity,
+		status:       status,
+		statusReason: statusReason,
+		version:      version,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}
+}
+
+// Refresh replaces the provider's identity with the one from the
+// certificate it presented, reporting whether anything changed. The
+// organization a provider is registered for never changes.
+func (p ThirdPartyProvider) Refresh(identity ProviderIdentity, now time.Time) (ThirdPartyProvider, bool, error) {
+	if identity.OrganizationID != p.identity.OrganizationID {
+		return p, false, fmt.Errorf("certificate is for organization %s, not %s", identity.OrganizationID, p.identity.OrganizationID)
+	}
+	if err := validateIdentity(identity); err != nil {
+		return p, false, err
+	}
+	if identity.Name == p.identity.Name && identity.NCAName == p.identity.NCAName && identity.NCAID == p.identity.NCAID &&
+		identity.CertificateFingerprint == p.identity.CertificateFingerprint && slices.Equal(identity.Roles, p.identity.Roles) {
+		return p, false, nil
+	}
+	p.identity = identity
+	p.version++
+	p.updatedAt = now
+	return p, true, nil
+}
+
+// Suspend stops the provider calling the API.
+func (p ThirdPartyProvider) Suspend(reason string, now time.Time) (ThirdPartyProvider, error) {
+	if p.status == valueobject.ProviderStatusSuspended {
+		return p, fmt.Errorf("provider %s is already suspended: %w", p.identity.OrganizationID, ErrInvalidTransition)
+	}
+	if strings.TrimSpace(reason) == "" {
+		return p, fmt.Errorf("a reason is required to suspend a provider")
+	}
+	return p.setStatus(valueobject.ProviderStatusSuspended, reason, now), nil
+}
+
+// Reactivate lets a suspended provider call the API again.
+func (p ThirdPartyProvider) Reactivate(now time.Time) (ThirdPartyProvider, error) {
+	if p.status == valueobject.ProviderStatusActive {
+		return p, fmt.Errorf("provider %s is not suspended: %w", p.identity.OrganizationID, ErrInvalidTransition)
+	}
+	return p.setStatus(valueobject.ProviderStatusActive, "", now), nil
+}
+
+func (p ThirdPartyProvider) setStatus(status valueobject.ProviderStatus, reason string, now time.Time) ThirdPartyProvider {
+	p.status = status
+	p.statusReason = reason
+	p.version++
+	p.updatedAt = now
+	p.domainEvents = append(p.domainEvents, event.NewProviderStatusChanged(p.id, p.tenantID, p.identity.OrganizationID, status.String(), reason))
+	return p
+}
+
+// IsActive reports whether the provider may call the API.
+func (p ThirdPartyProvider) IsActive() bool {
+	return p.status == valueobject.ProviderStatusActive
+}
+
+// HasRole reports whether the provider is authorised for role.
+func (p ThirdPartyProvider) HasRole(role valueobject.TPPRole) bool {
+	return slices.Contains(p.identity.Roles, role)
+}
+
+func validateIdentity(identity ProviderIdentity) error {
+	if identity.OrganizationID == "" {
+		return fmt.Errorf("organization identifier is required")
+	}
+	if len(identity.Roles) == 0 {
+		return fmt.Errorf("provider %s is authorised for no PSD2 role", identity.OrganizationID)
+	}
+	return nil
+}
+
+func roleNames(roles []valueobject.TPPRole) []string {
+	names := make([]string, 0, len(roles))
+	for _, r := range roles {
+		names = append(names, r.String())
+	}
+	return names
+}
+
+// --- Accessors ---
+
+func (p ThirdPartyProvider) ID() uuid.UUID                      { return p.id }
+func (p ThirdPartyProvider) TenantID() uuid.UUID                { return p.tenantID }
+func (p ThirdPartyProvider) Identity() ProviderIdentity         { return p.identity }
+func (p ThirdPartyProvider) Status() valueobject.ProviderStatus { return p.status }
+func (p ThirdPartyProvider) StatusReason() string               { return p.statusReason }
+func (p ThirdPartyProvider) Version() int                       { return p.version }
+func (p ThirdPartyProvider) CreatedAt() time.Time               { return p.createdAt }
+func (p ThirdPartyProvider) UpdatedAt() time.Time               { return p.updatedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (p ThirdPartyProvider) DomainEvents() []events.DomainEvent {
+	return p.domainEvents
+}
+
+// ClearDomainEvents returns a copy of the provider with no uncommitted events.
+func (p ThirdPartyProvider) ClearDomainEvents() ThirdPartyProvider {
+	p.domainEvents = nil
+	return p
+}
@@ -0,0 +1,64 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/model"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/valueobject"
+)
+
+func identity() model.ProviderIdentity {
+	return model.ProviderIdentity{
+		OrganizationID:         "PSDGB-FCA-123456",
+		Name:                   "Budget App Ltd",
+		NCAName:                "Financial Conduct Authority",
+		NCAID:                  "GB-FCA",
+		Roles:                  []valueobject.TPPRole{valueobject.TPPRoleAI},
+		CertificateFingerprint: "aa",
+	}
+}
+
+func TestThirdPartyProvider(t *testing.T) {
+	p, err := model.NewThirdPartyProvider(uuid.New(), identity(), now)
+	require.NoError(t, err)
+	assert.True(t, p.IsActive())
+	assert.True(t, p.HasRole(valueobject.TPPRoleAI))
+	assert.False(t, p.HasRole(valueobject.TPPRolePI))
+	require.Len(t, p.DomainEvents(), 1)
+	assert.Equal(t, "openbanking.provider.registered", p.DomainEvents()[0].EventType())
+
+	same, changed, err := p.Refresh(identity(), now)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, p.Version(), same.Version())
+
+	renewed := identity()
+	renewed.CertificateFingerprint = "bb"
+	renewed.Roles = append(renewed.Roles, valueobject.TPPRolePI)
+	p, changed, err = p.Refresh(renewed, now)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.True(t, p.HasRole(valueobject.TPPRolePI))
+
+	other := identity()
+	other.OrganizationID = "PSDDE-BAFIN-999"
+	_, _, err = p.Refresh(other, now)
+	assert.Error(t, err, "another organization's certificate")
+
+	_, err = p.Suspend("", now)
+	assert.Error(t, err, "a reason is required")
+	p, err = p.ClearDomainEvents().Suspend("NCA withdrew authorisation", now)
+	require.NoError(t, err)
+	assert.False(t, p.IsActive())
+	require.Len(t, p.DomainEvents(), 1)
+	assert.Equal(t, "openbanking.provider.status_changed", p.DomainEvents()[0].EventType())
+	p, err = p.Reactivate(now)
+	require.NoError(t, err)
+	assert.True(t, p.IsActive())
+	_, err = p.Reactivate(now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+}
@@ -0,0 +1,101 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ErrAccountNotFound is returned when an account does not exist in the
+// tenant.
+var ErrAccountNotFound = errors.New("account not found")
+
+// ErrPaymentNotFound is returned when a payment does not exist in the
+// tenant.
+var ErrPaymentNotFound = errors.New("payment not found")
+
+// ErrPaymentRejected is returned when the payment service refuses a
+// payment for good, so that submitting it again cannot succeed.
+var ErrPaymentRejected = errors.New("payment rejected")
+
+// Account is a customer account as the account service reports it.
+type Account struct {
+	ID                uuid.UUID
+	TenantID          uuid.UUID
+	Number            string
+	Type              string
+	Status            string
+	Currency          string
+	LedgerAccountCode string
+	HolderName        string
+}
+
+// IsActive reports whether the account can be read and debited.
+func (a Account) IsActive() bool {
+	return a.Status == "ACTIVE"
+}
+
+// AccountClient is the port for the account service.
+type AccountClient interface {
+	// GetAccount returns a tenant's account, or ErrAccountNotFound.
+	GetAccount(ctx context.Context, tenantID, accountID uuid.UUID) (Account, error)
+
+	// ListHolderAccounts returns the accounts a PSU holds in the tenant.
+	ListHolderAccounts(ctx context.Context, tenantID, holderID uuid.UUID) ([]Account, error)
+}
+
+// LedgerClient is the port for the ledger service.
+type LedgerClient interface {
+	// GetBalance returns the current balance of a ledger account.
+	GetBalance(ctx context.Context, tenantID uuid.UUID, accountCode, currency string) (decimal.Decimal, error)
+}
+
+// PaymentInstruction is a payment submitted to the payment service.
+type PaymentInstruction struct {
+	Amount                decimal.Decimal
+	DestinationAccountID  *uuid.UUID
+	Currency              string
+	RoutingNumber         string
+	ExternalAccountNumber string
+	DestinationCountry    string
+	Reference             string
+	Description           string
+	// IdempotencyKey makes resubmitting the same instruction return the
+	// payment created the first time.
+	IdempotencyKey  string
+	TenantID        uuid.UUID
+	SourceAccountID uuid.UUID
+}
+
+// Payment is a payment order as the payment service reports it.
+type Payment struct {
+	InitiatedAt           time.Time
+	SettledAt             *time.Time
+	DestinationAccountID  *uuid.UUID
+	Amount                decimal.Decimal
+	Currency              string
+	Status                string
+	ExternalAccountNumber string
+	Reference             string
+	Description           string
+	FailureReason         string
+	ID                    uuid.UUID
+	SourceAccountID       uuid.UUID
+}
+
+// PaymentClient is the port for the payment service.
+type PaymentClient interface {
+	// InitiatePayment submits a payment, returning ErrPaymentRejected when
+	// the payment service refuses it for good.
+	InitiatePayment(ctx context.Context, instruction PaymentInstruction) (Payment, error)
+
+	// GetPayment returns a tenant's payment, or ErrPaymentNotFound.
+	GetPayment(ctx context.Context, tenantID, paymentID uuid.UUID) (Payment, error)
+
+	// ListAccountPayments returns a page of the payments to and from an
+	// account, newest first, and the total number of them.
+	ListAccountPayments(ctx context.Context, tenantID, accountID uuid.UUID, limit, offset int) ([]Payment, int, error)
+}
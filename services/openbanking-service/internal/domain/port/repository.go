@@ -0,0 +1,79 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/event"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/model"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/valueobject"
+)
+
+// ErrConsentNotFound is returned when a consent does not exist.
+var ErrConsentNotFound = errors.New("consent not found")
+
+// ErrProviderNotFound is returned when a third party provider does not exist.
+var ErrProviderNotFound = errors.New("provider not found")
+
+// ErrVersionConflict is returned when a consent or provider was modified
+// concurrently.
+var ErrVersionConflict = errors.New("version conflict")
+
+// ConsentFilter narrows a listing of a tenant's consents. Zero fields do
+// not filter.
+type ConsentFilter struct {
+	ProviderID *uuid.UUID
+	PSUID      *uuid.UUID
+	Status     valueobject.ConsentStatus
+	TenantID   uuid.UUID
+}
+
+// ConsentRepository defines the persistence port for consents.
+type ConsentRepository interface {
+	// Save persists a consent with optimistic locking on its version.
+	Save(ctx context.Context, consent model.Consent) error
+
+	// FindByID retrieves a tenant's consent.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Consent, error)
+
+	// List returns a page of the consents matching the filter, newest
+	// first, and the total number of them.
+	List(ctx context.Context, filter ConsentFilter, limit, offset int) ([]model.Consent, int, error)
+
+	// ListExpirable returns up to limit consents of any tenant that should
+	// expire at now: VALID account consents past their validUntil date and
+	// consents still RECEIVED since before receivedBefore.
+	ListExpirable(ctx context.Context, now, receivedBefore time.Time, limit int) ([]model.Consent, error)
+
+	// ListUnexecuted returns up to limit VALID payment consents of any
+	// tenant authorised before authorisedBefore, whose payments were not
+	// yet submitted, earliest first.
+	ListUnexecuted(ctx context.Context, authorisedBefore time.Time, limit int) ([]model.Consent, error)
+}
+
+// ProviderRepository defines the persistence port for third party
+// providers.
+type ProviderRepository interface {
+	// Save persists a provider with optimistic locking on its version.
+	Save(ctx context.Context, provider model.ThirdPartyProvider) error
+
+	// FindByID retrieves a tenant's provider.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.ThirdPartyProvider, error)
+
+	// FindByOrganizationID retrieves the provider registered for a tenant
+	// under a PSD2 organization identifier.
+	FindByOrganizationID(ctx context.Context, tenantID uuid.UUID, organizationID string) (model.ThirdPartyProvider, error)
+
+	// List returns a page of a tenant's providers by name, and the total
+	// number of them.
+	List(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]model.ThirdPartyProvider, int, error)
+}
+
+// EventPublisher defines the port for publishing domain events.
+type EventPublisher interface {
+	// Publish sends domain events to the event bus.
+	Publish(ctx context.Context, events []event.DomainEvent) error
+}
@@ -0,0 +1,73 @@
+package valueobject
+
+import "fmt"
+
+// ConsentStatus is the state of a consent. A consent is RECEIVED until the
+// PSU authorises it, making it VALID, or rejects it. A VALID account access
+// consent stays so until it expires, the PSU revokes it or the TPP
+// terminates it; a VALID payment consent is CONSUMED once its payment is
+// submitted. REJECTED, REVOKED, TERMINATED, EXPIRED and CONSUMED are final.
+// It is an immutable value object.
+type ConsentStatus struct {
+	value string
+}
+
+const (
+	consentStatusReceived   = "RECEIVED"
+	consentStatusValid      = "VALID"
+	consentStatusRejected   = "REJECTED"
+	consentStatusRevoked    = "REVOKED"
+	consentStatusTerminated = "TERMINATED"
+	consentStatusExpired    = "EXPIRED"
+	consentStatusConsumed   = "CONSUMED"
+)
+
+var (
+	ConsentStatusReceived   = ConsentStatus{value: consentStatusReceived}
+	ConsentStatusValid      = ConsentStatus{value: consentStatusValid}
+	ConsentStatusRejected   = ConsentStatus{value: consentStatusRejected}
+	ConsentStatusRevoked    = ConsentStatus{value: consentStatusRevoked}
+	ConsentStatusTerminated = ConsentStatus{value: consentStatusTerminated}
+	ConsentStatusExpired    = ConsentStatus{value: consentStatusExpired}
+	ConsentStatusConsumed   = ConsentStatus{value: consentStatusConsumed}
+)
+
+var validConsentStatuses = map[string]ConsentStatus{
+	consentStatusReceived:   ConsentStatusReceived,
+	consentStatusValid:      ConsentStatusValid,
+	consentStatusRejected:   ConsentStatusRejected,
+	consentStatusRevoked:    ConsentStatusRevoked,
+	consentStatusTerminated: ConsentStatusTerminated,
+	consentStatusExpired:    ConsentStatusExpired,
+	consentStatusConsumed:   ConsentStatusConsumed,
+}
+
+// NewConsentStatus creates a ConsentStatus from a string, validating it is
+// known.
+func NewConsentStatus(s string) (ConsentStatus, error) {
+	v, ok := validConsentStatuses[s]
+	if !ok {
+		return ConsentStatus{}, fmt.Errorf("invalid consent status: %q", s)
+	}
+	return v, nil
+}
+
+// IsFinal reports whether the consent can no longer change.
+func (v ConsentStatus) IsFinal() bool {
+	return v != ConsentStatusReceived && v != ConsentStatusValid
+}
+
+// String returns the string representation of the ConsentStatus.
+func (v ConsentStatus) String() string {
+	return v.value
+}
+
+// IsZero returns true if the ConsentStatus has not been set.
+func (v ConsentStatus) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two ConsentStatus values are equal.
+func (v ConsentStatus) Equal(other ConsentStatus) bool {
+	return v.value == other.value
+}
@@ -0,0 +1,51 @@
+package valueobject
+
+import "fmt"
+
+// ConsentType distinguishes account information (ACCOUNTS) consents, which
+// give a TPP recurring read access to a PSU's accounts, from payment
+// initiation (PAYMENT) consents, which authorise a single payment.
+// It is an immutable value object.
+type ConsentType struct {
+	value string
+}
+
+const (
+	consentTypeAccounts = "ACCOUNTS"
+	consentTypePayment  = "PAYMENT"
+)
+
+var (
+	ConsentTypeAccounts = ConsentType{value: consentTypeAccounts}
+	ConsentTypePayment  = ConsentType{value: consentTypePayment}
+)
+
+var validConsentTypes = map[string]ConsentType{
+	consentTypeAccounts: ConsentTypeAccounts,
+	consentTypePayment:  ConsentTypePayment,
+}
+
+// NewConsentType creates a ConsentType from a string, validating it is
+// known.
+func NewConsentType(s string) (ConsentType, error) {
+	v, ok := validConsentTypes[s]
+	if !ok {
+		return ConsentType{}, fmt.Errorf("invalid consent type: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the ConsentType.
+func (v ConsentType) String() string {
+	return v.value
+}
+
+// IsZero returns true if the ConsentType has not been set.
+func (v ConsentType) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two ConsentType values are equal.
+func (v ConsentType) Equal(other ConsentType) bool {
+	return v.value == other.value
+}
@@ -0,0 +1,53 @@
+package valueobject
+
+import "fmt"
+
+// Permission is what an account information consent lets a TPP read:
+// READ_ACCOUNTS the accounts' details, READ_BALANCES their balances and
+// READ_TRANSACTIONS their transactions.
+// It is an immutable value object.
+type Permission struct {
+	value string
+}
+
+const (
+	permissionReadAccounts     = "READ_ACCOUNTS"
+	permissionReadBalances     = "READ_BALANCES"
+	permissionReadTransactions = "READ_TRANSACTIONS"
+)
+
+var (
+	PermissionReadAccounts     = Permission{value: permissionReadAccounts}
+	PermissionReadBalances     = Permission{value: permissionReadBalances}
+	PermissionReadTransactions = Permission{value: permissionReadTransactions}
+)
+
+var validPermissions = map[string]Permission{
+	permissionReadAccounts:     PermissionReadAccounts,
+	permissionReadBalances:     PermissionReadBalances,
+	permissionReadTransactions: PermissionReadTransactions,
+}
+
+// NewPermission creates a Permission from a string, validating it is known.
+func NewPermission(s string) (Permission, error) {
+	v, ok := validPermissions[s]
+	if !ok {
+		return Permission{}, fmt.Errorf("invalid permission: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the Permission.
+func (v Permission) String() string {
+	return v.value
+}
+
+// IsZero returns true if the Permission has not been set.
+func (v Permission) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two Permission values are equal.
+func (v Permission) Equal(other Permission) bool {
+	return v.value == other.value
+}
@@ -0,0 +1,51 @@
+package valueobject
+
+import "fmt"
+
+// ProviderStatus is whether a third-party provider may call the open
+// banking API. Operators suspend a provider, such as when its authorisation
+// is withdrawn, and reactivate it.
+// It is an immutable value object.
+type ProviderStatus struct {
+	value string
+}
+
+const (
+	providerStatusActive    = "ACTIVE"
+	providerStatusSuspended = "SUSPENDED"
+)
+
+var (
+	ProviderStatusActive    = ProviderStatus{value: providerStatusActive}
+	ProviderStatusSuspended = ProviderStatus{value: providerStatusSuspended}
+)
+
+var validProviderStatuses = map[string]ProviderStatus{
+	providerStatusActive:    ProviderStatusActive,
+	providerStatusSuspended: ProviderStatusSuspended,
+}
+
+// NewProviderStatus creates a ProviderStatus from a string, validating it is
+// known.
+func NewProviderStatus(s string) (ProviderStatus, error) {
+	v, ok := validProviderStatuses[s]
+	if !ok {
+		return ProviderStatus{}, fmt.Errorf("invalid provider status: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the ProviderStatus.
+func (v ProviderStatus) String() string {
+	return v.value
+}
+
+// IsZero returns true if the ProviderStatus has not been set.
+func (v ProviderStatus) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two ProviderStatus values are equal.
+func (v ProviderStatus) Equal(other ProviderStatus) bool {
+	return v.value == other.value
+}
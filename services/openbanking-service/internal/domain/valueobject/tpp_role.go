@@ -0,0 +1,57 @@
+package valueobject
+
+import "fmt"
+
+// TPPRole is a PSD2 role a national competent authority has authorised a
+// third-party provider for, as carried in its eIDAS certificate: PSP_AS
+// (account servicing), PSP_PI (payment initiation), PSP_AI (account
+// information) or PSP_IC (card-based payment instrument issuing).
+// It is an immutable value object.
+type TPPRole struct {
+	value string
+}
+
+const (
+	tppRoleAS = "PSP_AS"
+	tppRolePI = "PSP_PI"
+	tppRoleAI = "PSP_AI"
+	tppRoleIC = "PSP_IC"
+)
+
+var (
+	TPPRoleAS = TPPRole{value: tppRoleAS}
+	TPPRolePI = TPPRole{value: tppRolePI}
+	TPPRoleAI = TPPRole{value: tppRoleAI}
+	TPPRoleIC = TPPRole{value: tppRoleIC}
+)
+
+var validTPPRoles = map[string]TPPRole{
+	tppRoleAS: TPPRoleAS,
+	tppRolePI: TPPRolePI,
+	tppRoleAI: TPPRoleAI,
+	tppRoleIC: TPPRoleIC,
+}
+
+// NewTPPRole creates a TPPRole from a string, validating it is known.
+func NewTPPRole(s string) (TPPRole, error) {
+	v, ok := validTPPRoles[s]
+	if !ok {
+		return TPPRole{}, fmt.Errorf("invalid TPP role: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the TPPRole.
+func (v TPPRole) String() string {
+	return v.value
+}
+
+// IsZero returns true if the TPPRole has not been set.
+func (v TPPRole) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two TPPRole values are equal.
+func (v TPPRole) Equal(other TPPRole) bool {
+	return v.value == other.value
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	accountv1 "github.com/bibbank/bib/api/gen/go/bib/account/v1"
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+)
+
+// Compile-time interface check.
+var _ port.AccountClient = (*AccountGRPCClient)(nil)
+
+// maxHolderAccounts is the most accounts listed for one PSU.
+const maxHolderAccounts = 100
+
+// AccountGRPCClient implements the AccountClient port against the account
+// service.
+type AccountGRPCClient struct {
+	conn   grpc.ClientConnInterface
+	signer *auth.JWTService
+	config Config
+}
+
+// NewAccountGRPCClient creates a new AccountGRPCClient.
+func NewAccountGRPCClient(conn grpc.ClientConnInterface, signer *auth.JWTService, config Config) *AccountGRPCClient {
+	return &AccountGRPCClient{conn: conn, signer: signer, config: config}
+}
+
+// GetAccount returns the account, as ErrAccountNotFound when it is
+// another tenant's.
+func (c *AccountGRPCClient) GetAccount(ctx context.Context, tenantID, accountID uuid.UUID) (port.Account, error) {
+	ctx, err := withTenantToken(ctx, c.signer, tenantID)
+	if err != nil {
+		return port.Account{}, err
+	}
+	var resp accountv1.GetAccountResponse
+	req := &accountv1.GetAccountRequest{Id: accountID.String()}
+	if err := invokeWithRetry(ctx, c.conn, accountv1.AccountService_GetAccount_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout); err != nil {
+		return port.Account{}, fmt.Errorf("get account %s: %w", accountID, mapNotFound(err, port.ErrAccountNotFound))
+	}
+	account, err := toAccount(resp.GetAccount())
+	if err != nil {
+		return port.Account{}, err
+	}
+	if account.TenantID != tenantID {
+		return port.Account{}, fmt.Errorf("get account %s: %w", accountID, port.ErrAccountNotFound)
+	}
+	return account, nil
+}
+
+// ListHolderAccounts returns the accounts the PSU holds.
+func (c *AccountGRPCClient) ListHolderAccounts(ctx context.Context, tenantID, holderID uuid.UUID) ([]port.Account, error) {
+	ctx, err := withTenantToken(ctx, c.signer, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	var resp accountv1.ListAccountsResponse
+	req := &accountv1.ListAccountsRequest{
+		TenantId:   tenantID.String(),
+		HolderId:   holderID.String(),
+		Pagination: &commonv1.Pagination{PageSize: maxHolderAccounts},
+	}
+	if err := invokeWithRetry(ctx, c.conn, accountv1.AccountService_ListAccounts_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout); err != nil {
+		return nil, fmt.Errorf("list accounts of %s: %w", holderID, err)
+	}
+	accounts := make([]port.Account, 0, len(resp.GetAccounts()))
+	for _, msg := range resp.GetAccounts() {
+		account, err := toAccount(msg)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
+func toAccount(msg *accountv1.Account) (port.Account, error) {
+	id, err := uuid.Parse(msg.GetId())
+	if err != nil {
+		return port.Account{}, fmt.Errorf("account service returned account ID %q: %w", msg.GetId(), err)
+	}
+	tenantID, err := uuid.Parse(msg.GetTenantId())
+	if err != nil {
+		return port.Account{}, fmt.Errorf("account service returned tenant ID %q: %w", msg.GetTenantId(), err)
+	}
+	return port.Account{
+		ID:                id,
+		TenantID:          tenantID,
+		Number:            msg.GetAccountNumber(),
+		Type:              strings.TrimPrefix(msg.GetType().String(), "ACCOUNT_TYPE_"),
+		Status:            strings.TrimPrefix(msg.GetStatus().String(), "ACCOUNT_STATUS_"),
+		Currency:          msg.GetCurrency(),
+		LedgerAccountCode: msg.GetLedgerAccountCode(),
+		HolderName:        strings.TrimSpace(msg.GetHolder().GetFirstName() + " " + msg.GetHolder().GetLastName()),
+	}, nil
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// Config holds configuration shared by the gRPC clients of the services
+// the open banking API is a facade over.
+type Config struct {
+	// MaxRetries is the maximum number of retry attempts on transient failures.
+	MaxRetries int
+	// RetryBackoff is the base backoff between retries, doubled on each attempt.
+	RetryBackoff time.Duration
+	// Timeout bounds each call.
+	Timeout time.Duration
+}
+
+// invokeWithRetry calls a unary method of another service, retrying transient
+// failures with exponential backoff from backoff. Each attempt is bounded by
+// timeout when it is positive.
+func invokeWithRetry(
+	ctx context.Context,
+	conn grpc.ClientConnInterface,
+	method string,
+	req, resp any,
+	maxRetries int,
+	backoff, timeout time.Duration,
+) error {
+	var lastErr error
+
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			wait := backoff * (1 << uint(attempt-1)) //nolint:gosec // retry count is small
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+
+		err := invoke(ctx, conn, method, req, resp, timeout)
+		if err == nil {
+			return nil
+		}
+		if !isTransient(err) {
+			return err
+		}
+		lastErr = err
+	}
+
+	return fmt.Errorf("exhausted %d retries: %w", maxRetries, lastErr)
+}
+
+func invoke(ctx context.Context, conn grpc.ClientConnInterface, method string, req, resp any, timeout time.Duration) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return conn.Invoke(ctx, method, req, resp)
+}
+
+// isTransient reports whether a failed call may succeed if retried.
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// withTenantToken authorizes outgoing calls for the tenant. TPPs
+// authenticate with certificates rather than platform tokens, and expiry
+// and payment retries run with no caller at all, so the open banking
+// service mints a short-lived operator token signed with the platform's
+// issuing key for every call.
+func withTenantToken(ctx context.Context, signer *auth.JWTService, tenantID uuid.UUID) (context.Context, error) {
+	token, err := signer.GenerateToken(uuid.Nil, tenantID, []string{auth.RoleOperator})
+	if err != nil {
+		return nil, fmt.Errorf("mint service token: %w", err)
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token), nil
+}
+
+// mapNotFound translates a NotFound status into notFound, so callers can
+// tell a missing resource from a failed call.
+func mapNotFound(err, notFound error) error {
+	if status.Code(err) == codes.NotFound {
+		return fmt.Errorf("%w: %s", notFound, status.Convert(err).Message())
+	}
+	return err
+}
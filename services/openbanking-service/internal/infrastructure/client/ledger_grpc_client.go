@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ledgerv1 "github.com/bibbank/bib/api/gen/go/bib/ledger/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+)
+
+// Compile-time interface check.
+var _ port.LedgerClient = (*LedgerGRPCClient)(nil)
+
+// LedgerGRPCClient implements the LedgerClient port against the ledger
+// service.
+type LedgerGRPCClient struct {
+	conn   grpc.ClientConnInterface
+	signer *auth.JWTService
+	config Config
+}
+
+// NewLedgerGRPCClient creates a new LedgerGRPCClient.
+func NewLedgerGRPCClient(conn grpc.ClientConnInterface, signer *auth.JWTService, config Config) *LedgerGRPCClient {
+	return &LedgerGRPCClient{conn: conn, signer: signer, config: config}
+}
+
+// GetBalance returns the ledger account's balance as of today.
+func (c *LedgerGRPCClient) GetBalance(ctx context.Context, tenantID uuid.UUID, accountCode, currency string) (decimal.Decimal, error) {
+	ctx, err := withTenantToken(ctx, c.signer, tenantID)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	var resp ledgerv1.GetBalanceResponse
+	req := &ledgerv1.GetBalanceRequest{AccountCode: accountCode, AsOf: timestamppb.Now(), Currency: currency}
+	if err := invokeWithRetry(ctx, c.conn, ledgerv1.LedgerService_GetBalance_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout); err != nil {
+		return decimal.Decimal{}, fmt.Errorf("get balance of %s: %w", accountCode, err)
+	}
+	amount, err := decimal.NewFromString(resp.GetBalance().GetAmount())
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("ledger returned balance %q: %w", resp.GetBalance().GetAmount(), err)
+	}
+	return amount, nil
+}
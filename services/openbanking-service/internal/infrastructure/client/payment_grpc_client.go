@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	paymentv1 "github.com/bibbank/bib/api/gen/go/bib/payment/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/idempotency"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+)
+
+// Compile-time interface check.
+var _ port.PaymentClient = (*PaymentGRPCClient)(nil)
+
+// PaymentGRPCClient implements the PaymentClient port against the payment
+// service.
+type PaymentGRPCClient struct {
+	conn   grpc.ClientConnInterface
+	signer *auth.JWTService
+	config Config
+}
+
+// NewPaymentGRPCClient creates a new PaymentGRPCClient.
+func NewPaymentGRPCClient(conn grpc.ClientConnInterface, signer *auth.JWTService, config Config) *PaymentGRPCClient {
+	return &PaymentGRPCClient{conn: conn, signer: signer, config: config}
+}
+
+// InitiatePayment submits the payment under the instruction's idempotency
+// key, so that a retry returns the payment first created. The payment
+// service refusing the instruction as invalid rejects it for good.
+func (c *PaymentGRPCClient) InitiatePayment(ctx context.Context, in port.PaymentInstruction) (port.Payment, error) {
+	ctx, err := withTenantToken(ctx, c.signer, in.TenantID)
+	if err != nil {
+		return port.Payment{}, err
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, idempotency.MetadataKey, in.IdempotencyKey)
+	req := &paymentv1.InitiatePaymentRequest{
+		TenantId:              in.TenantID.String(),
+		SourceAccountId:       in.SourceAccountID.String(),
+		Amount:                &commonv1.Money{Amount: in.Amount.String(), Currency: in.Currency},
+		RoutingNumber:         in.RoutingNumber,
+		ExternalAccountNumber: in.ExternalAccountNumber,
+		DestinationCountry:    in.DestinationCountry,
+		Reference:             in.Reference,
+		Description:           in.Description,
+	}
+	if in.DestinationAccountID != nil {
+		req.DestinationAccountId = in.DestinationAccountID.String()
+	}
+	var resp paymentv1.InitiatePaymentResponse
+	if err := invokeWithRetry(ctx, c.conn, paymentv1.PaymentService_InitiatePayment_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout); err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.FailedPrecondition:
+			return port.Payment{}, fmt.Errorf("%w: %s", port.ErrPaymentRejected, status.Convert(err).Message())
+		}
+		return port.Payment{}, fmt.Errorf("initiate payment: %w", err)
+	}
+	order := resp.GetOrder()
+	id, err := uuid.Parse(order.GetId())
+	if err != nil {
+		return port.Payment{}, fmt.Errorf("payment service returned payment ID %q: %w", order.GetId(), err)
+	}
+	return port.Payment{
+		ID:                   id,
+		SourceAccountID:      in.SourceAccountID,
+		DestinationAccountID: in.DestinationAccountID,
+		Amount:               in.Amount,
+		Currency:             in.Currency,
+		Status:               strings.TrimPrefix(order.GetStatus().String(), "PAYMENT_STATUS_"),
+		InitiatedAt:          order.GetInitiatedAt().AsTime(),
+	}, nil
+}
+
+// GetPayment returns the payment, as ErrPaymentNotFound when it is another
+// tenant's.
+func (c *PaymentGRPCClient) GetPayment(ctx context.Context, tenantID, paymentID uuid.UUID) (port.Payment, error) {
+	ctx, err := withTenantToken(ctx, c.signer, tenantID)
+	if err != nil {
+		return port.Payment{}, err
+	}
+	var resp paymentv1.GetPaymentResponse
+	req := &paymentv1.GetPaymentRequest{Id: paymentID.String()}
+	if err := invokeWithRetry(ctx, c.conn, paymentv1.PaymentService_GetPayment_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout); err != nil {
+		return port.Payment{}, fmt.Errorf("get payment %s: %w", paymentID, mapNotFound(err, port.ErrPaymentNotFound))
+	}
+	if resp.GetOrder().GetTenantId() != tenantID.String() {
+		return port.Payment{}, fmt.Errorf("get payment %s: %w", paymentID, port.ErrPaymentNotFound)
+	}
+	return toPayment(resp.GetOrder())
+}
+
+// ListAccountPayments returns a page of the payments to and from the
+// account.
+func (c *PaymentGRPCClient) ListAccountPayments(ctx context.Context, tenantID, accountID uuid.UUID, limit, offset int) ([]port.Payment, int, error) {
+	ctx, err := withTenantToken(ctx, c.signer, tenantID)
+	if err != nil {
+		return nil, 0, err
+	}
+	var resp paymentv1.ListPaymentsResponse
+	req := &paymentv1.ListPaymentsRequest{
+		TenantId:  tenantID.String(),
+		AccountId: accountID.String(),
+		Pagination: &commonv1.Pagination{
+			PageSize:  int32(limit), //nolint:gosec // bounded by the page size cap
+			PageToken: strconv.Itoa(offset),
+		},
+	}
+	if err := invokeWithRetry(ctx, c.conn, paymentv1.PaymentService_ListPayments_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout); err != nil {
+		return nil, 0, fmt.Errorf("list payments of %s: %w", accountID, err)
+	}
+	payments := make([]port.Payment, 0, len(resp.GetOrders()))
+	for _, msg := range resp.GetOrders() {
+		p, err := toPayment(msg)
+		if err != nil {
+			return nil, 0, err
+		}
+		payments = append(payments, p)
+	}
+	return payments, int(resp.GetPagination().GetTotalCount()), nil
+}
+
+func toPayment(msg *paymentv1.PaymentOrder) (port.Payment, error) {
+	id, err := uuid.Parse(msg.GetId())
+	if err != nil {
+		return port.Payment{}, fmt.Errorf("payment service returned payment ID %q: %w", msg.GetId(), err)
+	}
+	source, err := uuid.Parse(msg.GetSourceAccountId())
+	if err != nil {
+		return port.Payment{}, fmt.Errorf("payment service returned source account %q: %w", msg.GetSourceAccountId(), err)
+	}
+	amount, err := decimal.NewFromString(msg.GetAmount().GetAmount())
+	if err != nil {
+		return port.Payment{}, fmt.Errorf("payment service returned amount %q: %w", msg.GetAmount().GetAmount(), err)
+	}
+	p := port.Payment{
+		ID:                    id,
+		SourceAccountID:       source,
+		Amount:                amount,
+		Currency:              msg.GetAmount().GetCurrency(),
+		Status:                strings.TrimPrefix(msg.GetStatus().String(), "PAYMENT_STATUS_"),
+		ExternalAccountNumber: msg.GetExternalAccountNumber(),
+		Reference:             msg.GetReference(),
+		Description:           msg.GetDescription(),
+		FailureReason:         msg.GetFailureReason(),
+	}
+	if dest, err := uuid.Parse(msg.GetDestinationAccountId()); err == nil && dest != uuid.Nil {
+		p.DestinationAccountID = &dest
+	}
+	if msg.GetInitiatedAt() != nil {
+		p.InitiatedAt = msg.GetInitiatedAt().AsTime()
+	}
+	if msg.GetSettledAt() != nil {
+		settled := msg.GetSettledAt().AsTime()
+		p.SettledAt = &settled
+	}
+	return p, nil
+}
@@ -0,0 +1,114 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+	"github.com/bibbank/bib/services/openbanking-service/internal/infrastructure/client"
+)
+
+// fakeConn answers every call with reply and fails the first failures calls
+// with failWith.
+type fakeConn struct {
+	failWith error
+	reply    string
+	methods  []string
+	requests []map[string]any
+	metadata []metadata.MD
+	failures int
+}
+
+func (c *fakeConn) Invoke(ctx context.Context, method string, args, reply any, _ ...grpc.CallOption) error {
+	c.methods = append(c.methods, method)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	c.metadata = append(c.metadata, md)
+	if c.failures > 0 {
+		c.failures--
+		return c.failWith
+	}
+
+	raw, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(args.(proto.Message))
+	if err != nil {
+		return err
+	}
+	var req map[string]any
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return err
+	}
+	c.requests = append(c.requests, req)
+	return protojson.Unmarshal([]byte(c.reply), reply.(proto.Message))
+}
+
+func (c *fakeConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not supported")
+}
+
+var testConfig = client.Config{MaxRetries: 2, RetryBackoff: time.Millisecond, Timeout: time.Second}
+
+func newSigner(t *testing.T) *auth.JWTService {
+	t.Helper()
+	signer, err := auth.NewJWTService(auth.JWTConfig{Secret: "test-secret", Issuer: "bib", Expiration: time.Minute})
+	require.NoError(t, err)
+	return signer
+}
+
+func TestPaymentGRPCClient_InitiatePayment(t *testing.T) {
+	tenantID, debtor, paymentID := uuid.New(), uuid.New(), uuid.New()
+	conn := &fakeConn{
+		reply:    `{"order":{"id":"` + paymentID.String() + `","status":"PAYMENT_STATUS_INITIATED","initiated_at":"2026-03-10T09:00:00Z"}}`,
+		failWith: status.Error(codes.Unavailable, "down"),
+		failures: 1,
+	}
+	c := client.NewPaymentGRPCClient(conn, newSigner(t), testConfig)
+
+	p, err := c.InitiatePayment(context.Background(), port.PaymentInstruction{
+		IdempotencyKey:        "openbanking:consent-1",
+		TenantID:              tenantID,
+		SourceAccountID:       debtor,
+		ExternalAccountNumber: "DE89370400440532013000",
+		Amount:                decimal.RequireFromString("125.50"),
+		Currency:              "EUR",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, paymentID, p.ID)
+	assert.Equal(t, "INITIATED", p.Status)
+	require.Len(t, conn.methods, 2, "retried once")
+	for _, md := range conn.metadata {
+		assert.Equal(t, []string{"openbanking:consent-1"}, md.Get("idempotency-key"), "every attempt carries the same key")
+		require.Len(t, md.Get("authorization"), 1)
+	}
+	assert.Equal(t, map[string]any{"amount": "125.5", "currency": "EUR"}, conn.requests[0]["amount"])
+	assert.NotContains(t, conn.requests[0], "destination_account_id")
+}
+
+func TestPaymentGRPCClient_InitiatePaymentRejected(t *testing.T) {
+	conn := &fakeConn{failWith: status.Error(codes.InvalidArgument, "amount must be positive"), failures: 1}
+	c := client.NewPaymentGRPCClient(conn, newSigner(t), testConfig)
+	_, err := c.InitiatePayment(context.Background(), port.PaymentInstruction{TenantID: uuid.New(), SourceAccountID: uuid.New()})
+	assert.ErrorIs(t, err, port.ErrPaymentRejected)
+	assert.Len(t, conn.methods, 1, "not retried")
+}
+
+func TestPaymentGRPCClient_GetPaymentOfAnotherTenant(t *testing.T) {
+	paymentID := uuid.New()
+	conn := &fakeConn{reply: `{"order":{"id":"` + paymentID.String() + `","tenant_id":"` + uuid.NewString() + `","source_account_id":"` + uuid.NewString() + `","amount":{"amount":"1","currency":"EUR"},"status":"PAYMENT_STATUS_SETTLED"}}`}
+	c := client.NewPaymentGRPCClient(conn, newSigner(t), testConfig)
+	_, err := c.GetPayment(context.Background(), uuid.New(), paymentID)
+	assert.ErrorIs(t, err, port.ErrPaymentNotFound)
+}
@@ -0,0 +1,194 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+type DatabaseConfig struct {
+	Host     string
+	User     string
+	Password string
+	Name     string
+	SSLMode  string
+	Port     int
+}
+
+type KafkaConfig struct {
+	Brokers []string
+}
+
+// TPPConfig configures the API third party providers call. Providers are
+// identified by their eIDAS QWAC: TrustAnchorsFile holds the PEM roots the
+// certificates must chain to. When TLSCertFile is set the API terminates
+// mutual TLS itself; otherwise it is expected behind a proxy that does, and
+// only then is TrustForwardedCert to be set so that the client certificate
+// the proxy forwards is trusted.
+type TPPConfig struct {
+	TrustAnchorsFile   string
+	TLSCertFile        string
+	TLSKeyFile         string
+	Port               int
+	TrustForwardedCert bool
+}
+
+// UpstreamConfig configures the calls to the account, ledger and payment
+// services the open banking API is a facade over. SigningKeyFile holds the
+// key used to sign the tenant tokens those calls carry; the JWT secret is
+// used when it is empty.
+type UpstreamConfig struct {
+	AccountGRPCAddr string
+	LedgerGRPCAddr  string
+	PaymentGRPCAddr string
+	SigningKeyFile  string
+	Timeout         time.Duration
+	MaxRetries      int
+	RetryBackoff    time.Duration
+}
+
+// ConsentConfig configures consent lifecycle housekeeping.
+type ConsentConfig struct {
+	// AuthorisationTTL is how long a consent may wait for the PSU to
+	// authorise it before it expires.
+	AuthorisationTTL time.Duration
+	// SweepInterval is how often the leader expires lapsed consents and
+	// executes authorised payments whose execution failed.
+	SweepInterval time.Duration
+}
+
+type Config struct {
+	DB          DatabaseConfig
+	ServiceName string
+	Kafka       KafkaConfig
+	TPP         TPPConfig
+	Upstream    UpstreamConfig
+	Consents    ConsentConfig
+	Outbox      OutboxConfig
+	GRPCPort    int
+	HTTPPort    int
+}
+
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+func Load() Config {
+	return Config{
+		GRPCPort: getEnvInt("GRPC_PORT", 9102),
+		HTTPPort: getEnvInt("HTTP_PORT", 8102),
+		DB: DatabaseConfig{
+			Host:     getEnv("DB_HOST", "localhost"),
+			Port:     getEnvInt("DB_PORT", 5432),
+			User:     getEnv("DB_USER", "bib"),
+			Password: getEnv("DB_PASSWORD", ""),
+			Name:     getEnv("DB_NAME", "bib_openbanking"),
+			SSLMode:  getEnv("DB_SSLMODE", "require"),
+		},
+		Kafka: KafkaConfig{
+			Brokers: []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+		},
+		TPP: TPPConfig{
+			Port:               getEnvInt("OPENBANKING_TPP_PORT", 8443),
+			TrustAnchorsFile:   getEnv("OPENBANKING_EIDAS_TRUST_ANCHORS_FILE", ""),
+			TLSCertFile:        getEnv("OPENBANKING_TLS_CERT_FILE", ""),
+			TLSKeyFile:         getEnv("OPENBANKING_TLS_KEY_FILE", ""),
+			TrustForwardedCert: getEnvBool("OPENBANKING_TRUST_FORWARDED_CERT", false),
+		},
+		Upstream: UpstreamConfig{
+			AccountGRPCAddr: getEnv("ACCOUNT_SERVICE_ADDR", "localhost:9082"),
+			LedgerGRPCAddr:  getEnv("LEDGER_SERVICE_ADDR", "localhost:9081"),
+			PaymentGRPCAddr: getEnv("PAYMENT_SERVICE_ADDR", "localhost:9086"),
+			SigningKeyFile:  getEnv("OPENBANKING_SIGNING_KEY_FILE", ""),
+			Timeout:         getEnvDuration("OPENBANKING_UPSTREAM_TIMEOUT", 10*time.Second),
+			MaxRetries:      getEnvInt("OPENBANKING_UPSTREAM_MAX_RETRIES", 3),
+			RetryBackoff:    getEnvDuration("OPENBANKING_UPSTREAM_RETRY_BACKOFF", 200*time.Millisecond),
+		},
+		Consents: ConsentConfig{
+			AuthorisationTTL: getEnvDuration("OPENBANKING_AUTHORISATION_TTL", time.Hour),
+			SweepInterval:    getEnvDuration("OPENBANKING_SWEEP_INTERVAL", time.Minute),
+		},
+		ServiceName: "openbanking-service",
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
+	}
+}
+
+func (c Config) GRPCAddr() string {
+	return fmt.Sprintf(":%d", c.GRPCPort)
+}
+
+func (c Config) HTTPAddr() string {
+	return fmt.Sprintf(":%d", c.HTTPPort)
+}
+
+func (c Config) TPPAddr() string {
+	return fmt.Sprintf(":%d", c.TPP.Port)
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) int {
+	if v := os.Getenv(key); v != "" {
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+	return fallback
+}
+
+func getEnvBool(key string, fallback bool) bool {
+	if v := os.Getenv(key); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+	return fallback
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return fallback
+}
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
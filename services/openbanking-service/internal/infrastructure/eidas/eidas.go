@@ -0,0 +1,242 @@
+// Package eidas verifies the eIDAS qualified website authentication
+// certificates (QWACs) third party providers present to the open banking
+// API, and reads their PSD2 authorisation from them as ETSI TS 119 495
+// specifies.
+package eidas
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+var (
+	// ErrNoCertificate is returned when a request carries no client
+	// certificate.
+	ErrNoCertificate = errors.New("no client certificate")
+	// ErrUntrusted is returned when a certificate does not chain to a
+	// trusted qualified trust service provider, or has expired.
+	ErrUntrusted = errors.New("certificate is not trusted")
+	// ErrNotPSD2 is returned when a trusted certificate carries no PSD2
+	// organization identifier or QCStatement.
+	ErrNotPSD2 = errors.New("certificate is not a PSD2 certificate")
+)
+
+var (
+	// oidOrganizationIdentifier is the X.520 organizationIdentifier
+	// attribute, which holds the PSD2 authorisation number.
+	oidOrganizationIdentifier = asn1.ObjectIdentifier{2, 5, 4, 97}
+	// oidQCStatements is the qualified certificate statements extension
+	// (RFC 3739).
+	oidQCStatements = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 3}
+	// oidPSD2QcStatement identifies the PSD2 QCStatement within it.
+	oidPSD2QcStatement = asn1.ObjectIdentifier{0, 4, 0, 19495, 2}
+)
+
+// roleNames maps the ETSI role OIDs to the role names.
+var roleNames = map[string]string{
+	"0.4.0.19495.1.1": "PSP_AS",
+	"0.4.0.19495.1.2": "PSP_PI",
+	"0.4.0.19495.1.3": "PSP_AI",
+	"0.4.0.19495.1.4": "PSP_IC",
+}
+
+// ForwardedCertHeader is the header a TLS-terminating proxy puts the
+// client's certificate chain in, URL-encoded PEM.
+const ForwardedCertHeader = "X-SSL-Client-Cert"
+
+// Certificate is what a verified PSD2 certificate says about its TPP.
+type Certificate struct {
+	NotAfter       time.Time
+	OrganizationID string
+	Name           string
+	NCAName        string
+	NCAID          string
+	// Fingerprint is the hex SHA-256 of the certificate.
+	Fingerprint string
+	Roles       []string
+}
+
+type qcStatement struct {
+	ID   asn1.ObjectIdentifier
+	Info asn1.RawValue `asn1:"optional"`
+}
+
+type psd2QcType struct {
+	Roles   []roleOfPSP
+	NCAName string `asn1:"utf8"`
+	NCAID   string `asn1:"utf8"`
+}
+
+type roleOfPSP struct {
+	ID   asn1.ObjectIdentifier
+	Name string `asn1:"utf8"`
+}
+
+// Verifier checks TPP certificates against the trusted QTSP roots.
+type Verifier struct {
+	roots *x509.CertPool
+	now   func() time.Time
+	// trustForwarded takes the certificate from ForwardedCertHeader when the
+	// request did not come over mutual TLS.
+	trustForwarded bool
+}
+
+// NewVerifier creates a Verifier trusting the PEM-encoded root
+// certificates. trustForwarded must only be set when the API is reachable
+// solely through a proxy that verifies client certificates and sets
+// ForwardedCertHeader itself.
+func NewVerifier(rootsPEM []byte, trustForwarded bool) (*Verifier, error) {
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(rootsPEM) {
+		return nil, fmt.Errorf("eidas: no trusted root certificates found")
+	}
+	return &Verifier{roots: roots, now: time.Now, trustForwarded: trustForwarded}, nil
+}
+
+// LoadVerifier creates a Verifier trusting the root certificates in the
+// PEM file at path.
+func LoadVerifier(path string, trustForwarded bool) (*Verifier, error) {
+	rootsPEM, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("eidas: read trusted roots: %w", err)
+	}
+	return NewVerifier(rootsPEM, trustForwarded)
+}
+
+// Roots returns the trusted roots, for requesting client certificates
+// during the TLS handshake.
+func (v *Verifier) Roots() *x509.CertPool {
+	return v.roots
+}
+
+// VerifyRequest verifies the certificate the request's client presented.
+func (v *Verifier) VerifyRequest(r *http.Request) (Certificate, error) {
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		return v.Verify(r.TLS.PeerCertificates)
+	}
+	header := r.Header.Get(ForwardedCertHeader)
+	if !v.trustForwarded || header == "" {
+		return Certificate{}, ErrNoCertificate
+	}
+	chain, err := parseForwarded(header)
+	if err != nil {
+		return Certificate{}, err
+	}
+	return v.Verify(chain)
+}
+
+// Verify checks that chain, the client's certificate followed by any
+// intermediates, leads to a trusted root, and reads the PSD2 attributes of
+// the client's certificate.
+func (v *Verifier) Verify(chain []*x509.Certificate) (Certificate, error) {
+	if len(chain) == 0 {
+		return Certificate{}, ErrNoCertificate
+	}
+	leaf := chain[0]
+	intermediates := x509.NewCertPool()
+	for _, c := range chain[1:] {
+		intermediates.AddCert(c)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         v.roots,
+		Intermediates: intermediates,
+		CurrentTime:   v.now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return Certificate{}, fmt.Errorf("%w: %w", ErrUntrusted, err)
+	}
+
+	sum := sha256.Sum256(leaf.Raw)
+	cert := Certificate{
+		Name:        leaf.Subject.CommonName,
+		Fingerprint: hex.EncodeToString(sum[:]),
+		NotAfter:    leaf.NotAfter,
+	}
+	if len(leaf.Subject.Organization) > 0 {
+		cert.Name = leaf.Subject.Organization[0]
+	}
+	for _, name := range leaf.Subject.Names {
+		if name.Type.Equal(oidOrganizationIdentifier) {
+			cert.OrganizationID, _ = name.Value.(string) //nolint:errcheck // a non-string value is rejected below
+		}
+	}
+	if cert.OrganizationID == "" {
+		return Certificate{}, fmt.Errorf("%w: no organizationIdentifier", ErrNotPSD2)
+	}
+
+	qc, err := psd2Statement(leaf)
+	if err != nil {
+		return Certificate{}, err
+	}
+	cert.NCAName, cert.NCAID = qc.NCAName, qc.NCAID
+	for _, role := range qc.Roles {
+		if name, ok := roleNames[role.ID.String()]; ok {
+			cert.Roles = append(cert.Roles, name)
+		}
+	}
+	if len(cert.Roles) == 0 {
+		return Certificate{}, fmt.Errorf("%w: no known PSP role", ErrNotPSD2)
+	}
+	return cert, nil
+}
+
+// psd2Statement reads the PSD2 QCStatement of a certificate.
+func psd2Statement(cert *x509.Certificate) (psd2QcType, error) {
+	for _, ext := range cert.Extensions {
+		if !ext.Id.Equal(oidQCStatements) {
+			continue
+		}
+		var statements []qcStatement
+		if _, err := asn1.Unmarshal(ext.Value, &statements); err != nil {
+			return psd2QcType{}, fmt.Errorf("%w: malformed QCStatements: %w", ErrNotPSD2, err)
+		}
+		for _, s := range statements {
+			if !s.ID.Equal(oidPSD2QcStatement) {
+				continue
+			}
+			var qc psd2QcType
+			if _, err := asn1.Unmarshal(s.Info.FullBytes, &qc); err != nil {
+				return psd2QcType{}, fmt.Errorf("%w: malformed PSD2 QCStatement: %w", ErrNotPSD2, err)
+			}
+			return qc, nil
+		}
+	}
+	return psd2QcType{}, fmt.Errorf("%w: no PSD2 QCStatement", ErrNotPSD2)
+}
+
+// parseForwarded parses a certificate chain from ForwardedCertHeader.
+func parseForwarded(header string) ([]*x509.Certificate, error) {
+	data, err := url.QueryUnescape(header)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed %s header: %w", ErrNoCertificate, ForwardedCertHeader, err)
+	}
+	var chain []*x509.Certificate
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		if block, rest = pem.Decode(rest); block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: malformed certificate: %w", ErrNoCertificate, err)
+		}
+		chain = append(chain, cert)
+	}
+	if len(chain) == 0 {
+		return nil, ErrNoCertificate
+	}
+	return chain, nil
+}
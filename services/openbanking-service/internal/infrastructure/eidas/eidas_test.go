@@ -0,0 +1,161 @@
+package eidas
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// testCA is a qualified trust service provider issuing PSD2 certificates.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test QTSP"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue issues a client certificate for organizationID. With roles it
+// carries a PSD2 QCStatement for them, given as role OIDs.
+func (ca testCA) issue(t *testing.T, organizationID string, roles ...asn1.ObjectIdentifier) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject := pkix.Name{CommonName: "api.budget.example", Organization: []string{"Budget App Ltd"}}
+	if organizationID != "" {
+		subject.ExtraNames = []pkix.AttributeTypeAndValue{{Type: oidOrganizationIdentifier, Value: organizationID}}
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      subject,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if len(roles) > 0 {
+		qc := psd2QcType{NCAName: "Financial Conduct Authority", NCAID: "GB-FCA"}
+		for _, r := range roles {
+			qc.Roles = append(qc.Roles, roleOfPSP{ID: r, Name: roleNames[r.String()]})
+		}
+		info, err := asn1.Marshal(qc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		value, err := asn1.Marshal([]qcStatement{{ID: oidPSD2QcStatement, Info: asn1.RawValue{FullBytes: info}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		template.ExtraExtensions = []pkix.Extension{{Id: oidQCStatements, Value: value}}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return cert
+}
+
+var (
+	roleAI = asn1.ObjectIdentifier{0, 4, 0, 19495, 1, 3}
+	rolePI = asn1.ObjectIdentifier{0, 4, 0, 19495, 1, 2}
+)
+
+func TestVerify(t *testing.T) {
+	ca := newTestCA(t)
+	v, err := NewVerifier(ca.pem, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := v.Verify([]*x509.Certificate{ca.issue(t, "PSDGB-FCA-123456", rolePI, roleAI)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cert.OrganizationID != "PSDGB-FCA-123456" || cert.Name != "Budget App Ltd" || cert.NCAID != "GB-FCA" {
+		t.Fatalf("unexpected attributes %+v", cert)
+	}
+	if len(cert.Roles) != 2 || cert.Roles[0] != "PSP_PI" || cert.Roles[1] != "PSP_AI" {
+		t.Fatalf("expected PSP_PI and PSP_AI, got %v", cert.Roles)
+	}
+	if len(cert.Fingerprint) != 64 {
+		t.Fatalf("expected a hex SHA-256 fingerprint, got %q", cert.Fingerprint)
+	}
+
+	if _, err := v.Verify([]*x509.Certificate{newTestCA(t).issue(t, "PSDGB-FCA-123456", roleAI)}); !errors.Is(err, ErrUntrusted) {
+		t.Fatalf("expected ErrUntrusted for another CA's certificate, got %v", err)
+	}
+	if _, err := v.Verify([]*x509.Certificate{ca.issue(t, "", roleAI)}); !errors.Is(err, ErrNotPSD2) {
+		t.Fatalf("expected ErrNotPSD2 without an organizationIdentifier, got %v", err)
+	}
+	if _, err := v.Verify([]*x509.Certificate{ca.issue(t, "PSDGB-FCA-123456")}); !errors.Is(err, ErrNotPSD2) {
+		t.Fatalf("expected ErrNotPSD2 without a QCStatement, got %v", err)
+	}
+
+	v.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	if _, err := v.Verify([]*x509.Certificate{ca.issue(t, "PSDGB-FCA-123456", roleAI)}); !errors.Is(err, ErrUntrusted) {
+		t.Fatalf("expected ErrUntrusted for an expired certificate, got %v", err)
+	}
+}
+
+func TestVerifyRequest(t *testing.T) {
+	ca := newTestCA(t)
+	leaf := ca.issue(t, "PSDGB-FCA-123456", roleAI)
+	forwarded := url.QueryEscape(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw})))
+
+	strict, _ := NewVerifier(ca.pem, false)
+	proxied, _ := NewVerifier(ca.pem, true)
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{leaf}}
+	if _, err := strict.VerifyRequest(r); err != nil {
+		t.Fatalf("expected the mutual TLS certificate to verify, got %v", err)
+	}
+
+	r = httptest.NewRequest("GET", "/", nil)
+	r.Header.Set(ForwardedCertHeader, forwarded)
+	if _, err := strict.VerifyRequest(r); !errors.Is(err, ErrNoCertificate) {
+		t.Fatalf("expected a forwarded certificate to be ignored, got %v", err)
+	}
+	cert, err := proxied.VerifyRequest(r)
+	if err != nil {
+		t.Fatalf("expected the forwarded certificate to verify, got %v", err)
+	}
+	if cert.OrganizationID != "PSDGB-FCA-123456" {
+		t.Fatalf("unexpected organization %q", cert.OrganizationID)
+	}
+}
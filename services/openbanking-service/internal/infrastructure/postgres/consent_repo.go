@@ -0,0 +1,243 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/model"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/valueobject"
+)
+
+const consentColumns = `
+	id, tenant_id, provider_id, consent_type, status, status_reason, permissions, account_ids,
+	valid_until, frequency_per_day, recurring, access_day, access_count, payment, psu_id,
+	payment_id, authorised_at, version, created_at, updated_at`
+
+// paymentJSON is how a payment consent's payment is stored.
+type paymentJSON struct {
+	Amount                decimal.Decimal `json:"amount"`
+	CreditorAccountID     *uuid.UUID      `json:"creditor_account_id,omitempty"`
+	Product               string          `json:"product"`
+	CreditorAccountNumber string          `json:"creditor_account_number,omitempty"`
+	CreditorRoutingNumber string          `json:"creditor_routing_number,omitempty"`
+	CreditorName          string          `json:"creditor_name"`
+	CreditorCountry       string          `json:"creditor_country,omitempty"`
+	Currency              string          `json:"currency"`
+	RemittanceInformation string          `json:"remittance_information,omitempty"`
+	DebtorAccountID       uuid.UUID       `json:"debtor_account_id"`
+}
+
+// ConsentRepo is the PostgreSQL implementation of ConsentRepository.
+type ConsentRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewConsentRepo creates a new ConsentRepo.
+func NewConsentRepo(pool *pgxpool.Pool) *ConsentRepo {
+	return &ConsentRepo{pool: pool}
+}
+
+// Save persists a consent with optimistic locking on its version.
+func (r *ConsentRepo) Save(ctx context.Context, c model.Consent) error {
+	query := `
+		INSERT INTO consents (` + consentColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			status_reason = EXCLUDED.status_reason,
+			account_ids = EXCLUDED.account_ids,
+			access_day = EXCLUDED.access_day,
+			access_count = EXCLUDED.access_count,
+			psu_id = EXCLUDED.psu_id,
+			payment_id = EXCLUDED.payment_id,
+			authorised_at = EXCLUDED.authorised_at,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE consents.version = EXCLUDED.version - 1
+	`
+	permissions := make([]string, 0, len(c.Permissions()))
+	for _, p := range c.Permissions() {
+		permissions = append(permissions, p.String())
+	}
+	accountIDs := c.AccountIDs()
+	if accountIDs == nil {
+		accountIDs = []uuid.UUID{}
+	}
+	var validUntil, accessDay *time.Time
+	if !c.IsPayment() {
+		v := c.ValidUntil()
+		validUntil = &v
+	}
+	if d := c.AccessDay(); !d.IsZero() {
+		accessDay = &d
+	}
+	var payment []byte
+	if p := c.Payment(); p != nil {
+		var err error
+		if payment, err = json.Marshal(paymentJSON(*p)); err != nil {
+			return fmt.Errorf("failed to marshal payment: %w", err)
+		}
+	}
+
+	result, err := r.pool.Exec(ctx, query,
+		c.ID(), c.TenantID(), c.ProviderID(), c.Type().String(), c.Status().String(), c.StatusReason(),
+		permissions, accountIDs, validUntil, c.FrequencyPerDay(), c.Recurring(), accessDay, c.AccessCount(),
+		payment, c.PSUID(), c.PaymentID(), c.AuthorisedAt(), c.Version(), c.CreatedAt(), c.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert consent: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: consent %s", port.ErrVersionConflict, c.ID())
+	}
+	return nil
+}
+
+// FindByID retrieves a tenant's consent.
+func (r *ConsentRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Consent, error) {
+	c, err := scanConsent(r.pool.QueryRow(ctx, `SELECT `+consentColumns+` FROM consents WHERE id = $1 AND tenant_id = $2`, id, tenantID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Consent{}, port.ErrConsentNotFound
+	}
+	if err != nil {
+		return model.Consent{}, fmt.Errorf("failed to scan consent: %w", err)
+	}
+	return c, nil
+}
+
+// List returns a page of the consents matching the filter, newest first,
+// and the total number of them.
+func (r *ConsentRepo) List(ctx context.Context, filter port.ConsentFilter, limit, offset int) ([]model.Consent, int, error) {
+	where := `WHERE tenant_id = $1
+		AND ($2::uuid IS NULL OR provider_id = $2)
+		AND ($3::uuid IS NULL OR psu_id = $3)
+		AND ($4 = '' OR status = $4)`
+	args := []any{filter.TenantID, filter.ProviderID, filter.PSUID, filter.Status.String()}
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM consents `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count consents: %w", err)
+	}
+	consents, err := r.query(ctx, `SELECT `+consentColumns+` FROM consents `+where+`
+		ORDER BY created_at DESC, id
+		LIMIT $5 OFFSET $6
+	`, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return consents, total, nil
+}
+
+// ListExpirable returns up to limit consents of any tenant that should
+// expire at now.
+func (r *ConsentRepo) ListExpirable(ctx context.Context, now, receivedBefore time.Time, limit int) ([]model.Consent, error) {
+	return r.query(ctx, `SELECT `+consentColumns+` FROM consents
+		WHERE (status = 'VALID' AND consent_type = 'ACCOUNTS' AND valid_until < $1::date)
+			OR (status = 'RECEIVED' AND created_at < $2)
+		ORDER BY created_at
+		LIMIT $3
+	`, now.UTC(), receivedBefore, limit)
+}
+
+// ListUnexecuted returns up to limit VALID payment consents of any tenant
+// authorised before authorisedBefore, earliest first.
+func (r *ConsentRepo) ListUnexecuted(ctx context.Context, authorisedBefore time.Time, limit int) ([]model.Consent, error) {
+	return r.query(ctx, `SELECT `+consentColumns+` FROM consents
+		WHERE status = 'VALID' AND consent_type = 'PAYMENT' AND authorised_at < $1
+		ORDER BY authorised_at
+		LIMIT $2
+	`, authorisedBefore, limit)
+}
+
+func (r *ConsentRepo) query(ctx context.Context, query string, args ...any) ([]model.Consent, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query consents: %w", err)
+	}
+	defer rows.Close()
+
+	var consents []model.Consent
+	for rows.Next() {
+		c, err := scanConsent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan consent row: %w", err)
+		}
+		consents = append(consents, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return consents, nil
+}
+
+func scanConsent(row pgx.Row) (model.Consent, error) {
+	var (
+		id, tenantID, providerID   uuid.UUID
+		typeStr, statusStr, reason string
+		permissionStrs             []string
+		accountIDs                 []uuid.UUID
+		validUntil, accessDay      *time.Time
+		frequency, accessCount     int
+		recurring                  bool
+		paymentData                []byte
+		psuID, paymentID           *uuid.UUID
+		authorisedAt               *time.Time
+		version                    int
+		createdAt, updatedAt       time.Time
+	)
+
+	err := row.Scan(&id, &tenantID, &providerID, &typeStr, &statusStr, &reason, &permissionStrs, &accountIDs,
+		&validUntil, &frequency, &recurring, &accessDay, &accessCount, &paymentData, &psuID,
+		&paymentID, &authorisedAt, &version, &createdAt, &updatedAt)
+	if err != nil {
+		return model.Consent{}, err
+	}
+
+	consentType, err := valueobject.NewConsentType(typeStr)
+	if err != nil {
+		return model.Consent{}, fmt.Errorf("invalid consent type in database: %w", err)
+	}
+	status, err := valueobject.NewConsentStatus(statusStr)
+	if err != nil {
+		return model.Consent{}, fmt.Errorf("invalid consent status in database: %w", err)
+	}
+	permissions := make([]valueobject.Permission, 0, len(permissionStrs))
+	for _, s := range permissionStrs {
+		p, err := valueobject.NewPermission(s)
+		if err != nil {
+			return model.Consent{}, fmt.Errorf("invalid permission in database: %w", err)
+		}
+		permissions = append(permissions, p)
+	}
+	var payment *model.PaymentDetails
+	if paymentData != nil {
+		var p paymentJSON
+		if err := json.Unmarshal(paymentData, &p); err != nil {
+			return model.Consent{}, fmt.Errorf("invalid payment in database: %w", err)
+		}
+		details := model.PaymentDetails(p)
+		payment = &details
+	}
+	var until, day time.Time
+	if validUntil != nil {
+		until = validUntil.UTC()
+	}
+	if accessDay != nil {
+		day = accessDay.UTC()
+	}
+
+	return model.ReconstructConsent(
+		id, tenantID, providerID, consentType, status, reason, permissions, accountIDs,
+		until, frequency, recurring, day, accessCount, payment, psuID, paymentID, authorisedAt,
+		version, createdAt, updatedAt,
+	), nil
+}
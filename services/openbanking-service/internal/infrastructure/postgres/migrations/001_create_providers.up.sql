@@ -0,0 +1,20 @@
+-- Third party providers calling a tenant's open banking API, registered
+-- the first time each presents a valid eIDAS certificate.
+CREATE TABLE IF NOT EXISTS providers (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    organization_id VARCHAR(100) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    nca_name VARCHAR(255) NOT NULL DEFAULT '',
+    nca_id VARCHAR(50) NOT NULL DEFAULT '',
+    roles TEXT[] NOT NULL,
+    certificate_fingerprint VARCHAR(64) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    status_reason TEXT NOT NULL DEFAULT '',
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- One provider per tenant and PSD2 authorisation number.
+CREATE UNIQUE INDEX uq_providers_organization ON providers (tenant_id, organization_id);
@@ -0,0 +1,33 @@
+-- PSUs' consents to TPPs, to read accounts (ACCOUNTS) or to initiate one
+-- payment (PAYMENT), whose details are kept in payment.
+CREATE TABLE IF NOT EXISTS consents (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    provider_id UUID NOT NULL REFERENCES providers (id),
+    consent_type VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    status_reason TEXT NOT NULL DEFAULT '',
+    permissions TEXT[] NOT NULL DEFAULT '{}',
+    account_ids UUID[] NOT NULL DEFAULT '{}',
+    valid_until DATE,
+    frequency_per_day INT NOT NULL DEFAULT 0,
+    recurring BOOLEAN NOT NULL DEFAULT FALSE,
+    -- Reads without the PSU present on access_day, against
+    -- frequency_per_day.
+    access_day DATE,
+    access_count INT NOT NULL DEFAULT 0,
+    payment JSONB,
+    psu_id UUID,
+    payment_id UUID,
+    authorised_at TIMESTAMPTZ,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_consents_tenant ON consents (tenant_id, created_at DESC);
+CREATE INDEX idx_consents_provider ON consents (tenant_id, provider_id);
+CREATE INDEX idx_consents_psu ON consents (tenant_id, psu_id) WHERE psu_id IS NOT NULL;
+
+-- The consents the expiry and payment sweeps look through.
+CREATE INDEX idx_consents_open ON consents (status, created_at) WHERE status IN ('RECEIVED', 'VALID');
@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/model"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/valueobject"
+)
+
+// uniqueViolation is the PostgreSQL error code for a unique constraint
+// violation.
+const uniqueViolation = "23505"
+
+const providerColumns = `
+	id, tenant_id, organization_id, name, nca_name, nca_id, roles, certificate_fingerprint,
+	status, status_reason, version, created_at, updated_at`
+
+// ProviderRepo is the PostgreSQL implementation of ProviderRepository.
+type ProviderRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewProviderRepo creates a new ProviderRepo.
+func NewProviderRepo(pool *pgxpool.Pool) *ProviderRepo {
+	return &ProviderRepo{pool: pool}
+}
+
+// Save persists a provider with optimistic locking on its version. A
+// provider registered concurrently for the same organization is a version
+// conflict.
+func (r *ProviderRepo) Save(ctx context.Context, p model.ThirdPartyProvider) error {
+	query := `
+		INSERT INTO providers (` + providerColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name,
+			nca_name = EXCLUDED.nca_name,
+			nca_id = EXCLUDED.nca_id,
+			roles = EXCLUDED.roles,
+			certificate_fingerprint = EXCLUDED.certificate_fingerprint,
+			status = EXCLUDED.status,
+			status_reason = EXCLUDED.status_reason,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE providers.version = EXCLUDED.version - 1
+	`
+	identity := p.Identity()
+	roles := make([]string, 0, len(identity.Roles))
+	for _, role := range identity.Roles {
+		roles = append(roles, role.String())
+	}
+	result, err := r.pool.Exec(ctx, query,
+		p.ID(), p.TenantID(), identity.OrganizationID, identity.Name, identity.NCAName, identity.NCAID,
+		roles, identity.CertificateFingerprint, p.Status().String(), p.StatusReason(),
+		p.Version(), p.CreatedAt(), p.UpdatedAt(),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: provider %s registered concurrently", port.ErrVersionConflict, identity.OrganizationID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to upsert provider: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: provider %s", port.ErrVersionConflict, p.ID())
+	}
+	return nil
+}
+
+// FindByID retrieves a tenant's provider.
+func (r *ProviderRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.ThirdPartyProvider, error) {
+	return r.findOne(ctx, `SELECT `+providerColumns+` FROM providers WHERE id = $1 AND tenant_id = $2`, id, tenantID)
+}
+
+// FindByOrganizationID retrieves the provider registered for a tenant
+// under a PSD2 organization identifier.
+func (r *ProviderRepo) FindByOrganizationID(ctx context.Context, tenantID uuid.UUID, organizationID string) (model.ThirdPartyProvider, error) {
+	return r.findOne(ctx, `SELECT `+providerColumns+` FROM providers WHERE tenant_id = $1 AND organization_id = $2`, tenantID, organizationID)
+}
+
+// List returns a page of a tenant's providers by name, and the total
+// number of them.
+func (r *ProviderRepo) List(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]model.ThirdPartyProvider, int, error) {
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM providers WHERE tenant_id = $1`, tenantID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count providers: %w", err)
+	}
+	rows, err := r.pool.Query(ctx, `SELECT `+providerColumns+` FROM providers WHERE tenant_id = $1
+		ORDER BY name, id
+		LIMIT $2 OFFSET $3
+	`, tenantID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query providers: %w", err)
+	}
+	defer rows.Close()
+
+	var providers []model.ThirdPartyProvider
+	for rows.Next() {
+		p, err := scanProvider(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan provider row: %w", err)
+		}
+		providers = append(providers, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("row iteration error: %w", err)
+	}
+	return providers, total, nil
+}
+
+func (r *ProviderRepo) findOne(ctx context.Context, query string, args ...any) (model.ThirdPartyProvider, error) {
+	p, err := scanProvider(r.pool.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.ThirdPartyProvider{}, port.ErrProviderNotFound
+	}
+	if err != nil {
+		return model.ThirdPartyProvider{}, fmt.Errorf("failed to scan provider: %w", err)
+	}
+	return p, nil
+}
+
+func scanProvider(row pgx.Row) (model.ThirdPartyProvider, error) {
+	var (
+		id, tenantID         uuid.UUID
+		identity             model.ProviderIdentity
+		roleStrs             []string
+		statusStr, reason    string
+		version              int
+		createdAt, updatedAt time.Time
+	)
+
+	err := row.Scan(&id, &tenantID, &identity.OrganizationID, &identity.Name, &identity.NCAName, &identity.NCAID,
+		&roleStrs, &identity.CertificateFingerprint, &statusStr, &reason, &version, &createdAt, &updatedAt)
+	if err != nil {
+		return model.ThirdPartyProvider{}, err
+	}
+
+	for _, s := range roleStrs {
+		role, err := valueobject.NewTPPRole(s)
+		if err != nil {
+			return model.ThirdPartyProvider{}, fmt.Errorf("invalid TPP role in database: %w", err)
+		}
+		identity.Roles = append(identity.Roles, role)
+	}
+	status, err := valueobject.NewProviderStatus(statusStr)
+	if err != nil {
+		return model.ThirdPartyProvider{}, fmt.Errorf("invalid provider status in database: %w", err)
+	}
+
+	return model.ReconstructThirdPartyProvider(id, tenantID, identity, status, reason, version, createdAt, updatedAt), nil
+}
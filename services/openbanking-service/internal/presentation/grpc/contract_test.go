@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	openbankingv1 "github.com/bibbank/bib/api/gen/go/bib/openbanking/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/event"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/model"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractConsentRepo holds at most one consent.
+type contractConsentRepo struct {
+	consent *model.Consent
+}
+
+func (r *contractConsentRepo) Save(context.Context, model.Consent) error { return nil }
+
+func (r *contractConsentRepo) FindByID(context.Context, uuid.UUID, uuid.UUID) (model.Consent, error) {
+	if r.consent == nil {
+		return model.Consent{}, port.ErrConsentNotFound
+	}
+	return *r.consent, nil
+}
+
+func (r *contractConsentRepo) List(context.Context, port.ConsentFilter, int, int) ([]model.Consent, int, error) {
+	return nil, 0, nil
+}
+
+func (r *contractConsentRepo) ListExpirable(context.Context, time.Time, time.Time, int) ([]model.Consent, error) {
+	return nil, nil
+}
+
+func (r *contractConsentRepo) ListUnexecuted(context.Context, time.Time, int) ([]model.Consent, error) {
+	return nil, nil
+}
+
+type discardPublisher struct{}
+
+func (discardPublisher) Publish(context.Context, []event.DomainEvent) error { return nil }
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	consents := &contractConsentRepo{}
+	h := NewHandler(
+		usecase.NewGetConsentUseCase(consents), nil, nil,
+		usecase.NewRejectConsentUseCase(consents, discardPublisher{}), nil, nil, nil,
+		slog.Default(),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { openbankingv1.RegisterOpenBankingServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-openbanking-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { consents.consent = nil },
+		"a provider requested account access": func(t *testing.T) {
+			consent, err := model.NewAccountConsent(contractTenantID, uuid.New(), model.AccountAccess{
+				Permissions:     []valueobject.Permission{valueobject.PermissionReadBalances},
+				FrequencyPerDay: 4,
+			}, time.Now().UTC())
+			if err != nil {
+				t.Fatal(err)
+			}
+			consents.consent = &consent
+		},
+	})
+}
@@ -0,0 +1,452 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	openbankingv1 "github.com/bibbank/bib/api/gen/go/bib/openbanking/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/dto"
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/model"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+)
+
+// staffRoles may act on any consent of their tenant and see its providers;
+// a caller with only the customer role is limited to consents they are the
+// PSU of. consentRoles may read consents and closerRoles reject and revoke
+// them; providerRoles may suspend and reactivate providers.
+var (
+	staffRoles    = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCompliance}
+	consentRoles  = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCompliance, auth.RoleCustomer}
+	closerRoles   = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleCompliance, auth.RoleCustomer}
+	providerRoles = []string{auth.RoleAdmin, auth.RoleCompliance}
+)
+
+// requireRole checks that the caller has at least one of the given roles.
+func requireRole(ctx context.Context, roles ...string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "insufficient permissions")
+}
+
+// tenantIDFromContext extracts the tenant ID from JWT claims in the context.
+func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return claims.TenantID, nil
+}
+
+// ownPSUID returns the PSU a customer-only caller is limited to, or nil for
+// staff callers.
+func ownPSUID(ctx context.Context) *uuid.UUID {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	for _, role := range staffRoles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+	return &claims.UserID
+}
+
+// optionalUUID parses an optional ID, returning nil when it is empty.
+func optionalUUID(raw, field string) (*uuid.UUID, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s", field)
+	}
+	return &id, nil
+}
+
+// enumName strips an enum value's prefix, returning "" for the unspecified
+// value.
+func enumName(name, prefix string) string {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "UNSPECIFIED" {
+		return ""
+	}
+	return name
+}
+
+// uuidString formats an optional ID, returning "" when it is nil.
+func uuidString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+// Compile-time assertion that Handler implements OpenBankingServiceServer.
+var _ openbankingv1.OpenBankingServiceServer = (*Handler)(nil)
+
+// Handler implements the OpenBankingServiceServer gRPC interface.
+type Handler struct {
+	openbankingv1.UnimplementedOpenBankingServiceServer
+	getConsent        *usecase.GetConsentUseCase
+	listConsents      *usecase.ListConsentsUseCase
+	authoriseConsent  *usecase.AuthoriseConsentUseCase
+	rejectConsent     *usecase.RejectConsentUseCase
+	revokeConsent     *usecase.RevokeConsentUseCase
+	listProviders     *usecase.ListProvidersUseCase
+	setProviderStatus *usecase.SetProviderStatusUseCase
+	logger            *slog.Logger
+}
+
+// NewHandler creates a new gRPC Handler.
+func NewHandler(
+	getConsent *usecase.GetConsentUseCase,
+	listConsents *usecase.ListConsentsUseCase,
+	authoriseConsent *usecase.AuthoriseConsentUseCase,
+	rejectConsent *usecase.RejectConsentUseCase,
+	revokeConsent *usecase.RevokeConsentUseCase,
+	listProviders *usecase.ListProvidersUseCase,
+	setProviderStatus *usecase.SetProviderStatusUseCase,
+	logger *slog.Logger,
+) *Handler {
+	return &Handler{
+		getConsent:        getConsent,
+		listConsents:      listConsents,
+		authoriseConsent:  authoriseConsent,
+		rejectConsent:     rejectConsent,
+		revokeConsent:     revokeConsent,
+		listProviders:     listProviders,
+		setProviderStatus: setProviderStatus,
+		logger:            logger,
+	}
+}
+
+// GetConsent retrieves a consent. A customer sees a consent still waiting
+// for a PSU, so that they can review it before authorising it, and the
+// consents they authorised.
+func (h *Handler) GetConsent(ctx context.Context, req *openbankingv1.GetConsentRequest) (*openbankingv1.GetConsentResponse, error) {
+	if err := requireRole(ctx, consentRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	consentID, err := uuid.Parse(req.ConsentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid consent ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.getConsent.Execute(ctx, dto.GetConsentRequest{
+		TenantID:  tenantID,
+		ConsentID: consentID,
+		PSUID:     ownPSUID(ctx),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &openbankingv1.GetConsentResponse{Consent: toConsentMsg(resp)}, nil
+}
+
+// ListConsents lists the tenant's consents, newest first. A customer only
+// lists the consents they authorised.
+func (h *Handler) ListConsents(ctx context.Context, req *openbankingv1.ListConsentsRequest) (*openbankingv1.ListConsentsResponse, error) {
+	if err := requireRole(ctx, consentRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	providerID, err := optionalUUID(req.ProviderId, "provider ID")
+	if err != nil {
+		return nil, err
+	}
+	psuID, err := optionalUUID(req.PsuId, "PSU ID")
+	if err != nil {
+		return nil, err
+	}
+	if own := ownPSUID(ctx); own != nil {
+		psuID = own
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listConsents.Execute(ctx, dto.ListConsentsRequest{
+		TenantID:   tenantID,
+		ProviderID: providerID,
+		PSUID:      psuID,
+		Status:     enumName(req.Status.String(), "CONSENT_STATUS_"),
+		Limit:      int(req.PageSize),
+		Offset:     int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &openbankingv1.ListConsentsResponse{
+		Consents:   make([]*openbankingv1.Consent, 0, len(resp.Consents)),
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, c := range resp.Consents {
+		out.Consents = append(out.Consents, toConsentMsg(c))
+	}
+	return out, nil
+}
+
+// AuthoriseConsent records the caller's authorisation, as PSU, of a
+// consent. Only the PSU can authorise; staff cannot on their behalf.
+func (h *Handler) AuthoriseConsent(ctx context.Context, req *openbankingv1.AuthoriseConsentRequest) (*openbankingv1.AuthoriseConsentResponse, error) {
+	if err := requireRole(ctx, auth.RoleCustomer); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	consentID, err := uuid.Parse(req.ConsentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid consent ID")
+	}
+	accountIDs := make([]uuid.UUID, 0, len(req.AccountIds))
+	for _, raw := range req.AccountIds {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid account ID")
+		}
+		accountIDs = append(accountIDs, id)
+	}
+	claims, _ := auth.ClaimsFromContext(ctx)
+
+	resp, err := h.authoriseConsent.Execute(ctx, dto.AuthoriseConsentRequest{
+		TenantID:   claims.TenantID,
+		ConsentID:  consentID,
+		PSUID:      claims.UserID,
+		AccountIDs: accountIDs,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &openbankingv1.AuthoriseConsentResponse{Consent: toConsentMsg(resp)}, nil
+}
+
+// RejectConsent records that the PSU refused a consent.
+func (h *Handler) RejectConsent(ctx context.Context, req *openbankingv1.RejectConsentRequest) (*openbankingv1.RejectConsentResponse, error) {
+	if err := requireRole(ctx, closerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	consentID, err := uuid.Parse(req.ConsentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid consent ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.rejectConsent.Execute(ctx, dto.CloseConsentRequest{
+		TenantID:  tenantID,
+		ConsentID: consentID,
+		PSUID:     ownPSUID(ctx),
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &openbankingv1.RejectConsentResponse{Consent: toConsentMsg(resp)}, nil
+}
+
+// RevokeConsent withdraws a consent the PSU authorised.
+func (h *Handler) RevokeConsent(ctx context.Context, req *openbankingv1.RevokeConsentRequest) (*openbankingv1.RevokeConsentResponse, error) {
+	if err := requireRole(ctx, closerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	consentID, err := uuid.Parse(req.ConsentId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid consent ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.revokeConsent.Execute(ctx, dto.CloseConsentRequest{
+		TenantID:  tenantID,
+		ConsentID: consentID,
+		PSUID:     ownPSUID(ctx),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &openbankingv1.RevokeConsentResponse{Consent: toConsentMsg(resp)}, nil
+}
+
+// ListProviders lists the third party providers that have called the
+// tenant's PSD2 API.
+func (h *Handler) ListProviders(ctx context.Context, req *openbankingv1.ListProvidersRequest) (*openbankingv1.ListProvidersResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listProviders.Execute(ctx, dto.ListProvidersRequest{
+		TenantID: tenantID,
+		Limit:    int(req.PageSize),
+		Offset:   int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &openbankingv1.ListProvidersResponse{
+		Providers:  make([]*openbankingv1.Provider, 0, len(resp.Providers)),
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, p := range resp.Providers {
+		out.Providers = append(out.Providers, toProviderMsg(p))
+	}
+	return out, nil
+}
+
+// SetProviderStatus suspends or reactivates a provider.
+func (h *Handler) SetProviderStatus(ctx context.Context, req *openbankingv1.SetProviderStatusRequest) (*openbankingv1.SetProviderStatusResponse, error) {
+	if err := requireRole(ctx, providerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	providerID, err := uuid.Parse(req.ProviderId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.setProviderStatus.Execute(ctx, dto.SetProviderStatusRequest{
+		TenantID:   tenantID,
+		ProviderID: providerID,
+		Suspend:    req.Suspend,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &openbankingv1.SetProviderStatusResponse{Provider: toProviderMsg(resp)}, nil
+}
+
+func (h *Handler) toStatus(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrInvalidTransition), errors.Is(err, model.ErrConsentExpired):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, port.ErrConsentNotFound):
+		return status.Error(codes.NotFound, "consent not found")
+	case errors.Is(err, port.ErrProviderNotFound):
+		return status.Error(codes.NotFound, "provider not found")
+	case errors.Is(err, port.ErrAccountNotFound):
+		return status.Error(codes.NotFound, "account not found")
+	case errors.Is(err, port.ErrVersionConflict):
+		return status.Error(codes.Aborted, "modified concurrently, retry")
+	default:
+		h.logger.Error("handler error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func toConsentMsg(c dto.ConsentResponse) *openbankingv1.Consent {
+	accountIDs := make([]string, 0, len(c.AccountIDs))
+	for _, id := range c.AccountIDs {
+		accountIDs = append(accountIDs, id.String())
+	}
+	msg := &openbankingv1.Consent{
+		ConsentId:       c.ID.String(),
+		TenantId:        c.TenantID.String(),
+		ProviderId:      c.ProviderID.String(),
+		Type:            openbankingv1.ConsentType(openbankingv1.ConsentType_value["CONSENT_TYPE_"+c.Type]),
+		Status:          openbankingv1.ConsentStatus(openbankingv1.ConsentStatus_value["CONSENT_STATUS_"+c.Status]),
+		StatusReason:    c.StatusReason,
+		Permissions:     c.Permissions,
+		AccountIds:      accountIDs,
+		FrequencyPerDay: int32(c.FrequencyPerDay), //nolint:gosec // bounded by the consent limit
+		Recurring:       c.Recurring,
+		PsuId:           uuidString(c.PSUID),
+		PaymentId:       uuidString(c.PaymentID),
+		Version:         int32(c.Version), //nolint:gosec // version counter
+		CreatedAt:       timestamppb.New(c.CreatedAt),
+		UpdatedAt:       timestamppb.New(c.UpdatedAt),
+	}
+	if c.ValidUntil != nil {
+		msg.ValidUntil = timestamppb.New(*c.ValidUntil)
+	}
+	if c.AuthorisedAt != nil {
+		msg.AuthorisedAt = timestamppb.New(*c.AuthorisedAt)
+	}
+	if p := c.Payment; p != nil {
+		msg.Payment = &openbankingv1.PaymentDetails{
+			DebtorAccountId:       p.DebtorAccountID.String(),
+			Product:               p.Product,
+			Amount:                p.Amount.String(),
+			Currency:              p.Currency,
+			CreditorAccountId:     uuidString(p.CreditorAccountID),
+			CreditorAccountNumber: p.CreditorAccountNumber,
+			CreditorRoutingNumber: p.CreditorRoutingNumber,
+			CreditorName:          p.CreditorName,
+			CreditorCountry:       p.CreditorCountry,
+			RemittanceInformation: p.RemittanceInformation,
+		}
+	}
+	return msg
+}
+
+func toProviderMsg(p dto.ProviderResponse) *openbankingv1.Provider {
+	return &openbankingv1.Provider{
+		ProviderId:             p.ID.String(),
+		TenantId:               p.TenantID.String(),
+		OrganizationId:         p.OrganizationID,
+		Name:                   p.Name,
+		NcaName:                p.NCAName,
+		NcaId:                  p.NCAID,
+		Roles:                  p.Roles,
+		CertificateFingerprint: p.CertificateFingerprint,
+		Status:                 openbankingv1.ProviderStatus(openbankingv1.ProviderStatus_value["PROVIDER_STATUS_"+p.Status]),
+		StatusReason:           p.StatusReason,
+		Version:                int32(p.Version), //nolint:gosec // version counter
+		CreatedAt:              timestamppb.New(p.CreatedAt),
+		UpdatedAt:              timestamppb.New(p.UpdatedAt),
+	}
+}
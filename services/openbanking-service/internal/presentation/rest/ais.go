@@ -0,0 +1,358 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/dto"
+)
+
+// consentStatusNames are the PSD2 names of the consent statuses.
+var consentStatusNames = map[string]string{
+	"RECEIVED":   "received",
+	"VALID":      "valid",
+	"REJECTED":   "rejected",
+	"REVOKED":    "revokedByPsu",
+	"TERMINATED": "terminatedByTpp",
+	"EXPIRED":    "expired",
+	"CONSUMED":   "consumed",
+}
+
+// createConsentBody asks for access to a PSU's accounts. Without
+// accountIds the PSU chooses the accounts when authorising; validUntil is
+// a date, and when it is empty access is asked for as long as allowed.
+type createConsentBody struct {
+	ValidUntil         string      `json:"validUntil"`
+	Permissions        []string    `json:"permissions"`
+	AccountIDs         []uuid.UUID `json:"accountIds"`
+	FrequencyPerDay    int         `json:"frequencyPerDay"`
+	RecurringIndicator bool        `json:"recurringIndicator"`
+}
+
+type consentCreatedBody struct {
+	ConsentStatus string          `json:"consentStatus"`
+	Links         map[string]link `json:"_links"`
+	ConsentID     uuid.UUID       `json:"consentId"`
+}
+
+type consentBody struct {
+	ValidUntil         string      `json:"validUntil"`
+	LastActionDate     string      `json:"lastActionDate"`
+	ConsentStatus      string      `json:"consentStatus"`
+	Permissions        []string    `json:"permissions"`
+	AccountIDs         []uuid.UUID `json:"accountIds"`
+	FrequencyPerDay    int         `json:"frequencyPerDay"`
+	RecurringIndicator bool        `json:"recurringIndicator"`
+	ConsentID          uuid.UUID   `json:"consentId"`
+}
+
+type consentStatusBody struct {
+	ConsentStatus string `json:"consentStatus"`
+}
+
+type amountBody struct {
+	Currency string `json:"currency"`
+	Amount   string `json:"amount"`
+}
+
+type accountBody struct {
+	AccountNumber   string    `json:"accountNumber"`
+	Currency        string    `json:"currency"`
+	CashAccountType string    `json:"cashAccountType"`
+	Status          string    `json:"status"`
+	OwnerName       string    `json:"ownerName,omitempty"`
+	ResourceID      uuid.UUID `json:"resourceId"`
+}
+
+type accountReferenceBody struct {
+	ResourceID uuid.UUID `json:"resourceId"`
+}
+
+type balanceBody struct {
+	BalanceAmount amountBody `json:"balanceAmount"`
+	BalanceType   string     `json:"balanceType"`
+	ReferenceDate string     `json:"referenceDate"`
+}
+
+type transactionBody struct {
+	BookingDate                       string     `json:"bookingDate,omitempty"`
+	ValueDate                         string     `json:"valueDate"`
+	TransactionAmount                 amountBody `json:"transactionAmount"`
+	CounterpartyAccount               string     `json:"counterpartyAccount,omitempty"`
+	EntryReference                    string     `json:"entryReference,omitempty"`
+	RemittanceInformationUnstructured string     `json:"remittanceInformationUnstructured,omitempty"`
+	TransactionID                     uuid.UUID  `json:"transactionId"`
+}
+
+type transactionsBody struct {
+	Booked  []transactionBody `json:"booked"`
+	Pending []transactionBody `json:"pending"`
+}
+
+// CreateConsent handles POST /consents: the provider asks for access to a
+// PSU's accounts, which the PSU then authorises through the bank.
+func (h *TPPHandler) CreateConsent(w http.ResponseWriter, r tppRequest) {
+	var body createConsentBody
+	if !readBody(w, r, &body) {
+		return
+	}
+	var validUntil time.Time
+	if body.ValidUntil != "" {
+		var err error
+		if validUntil, err = time.Parse(time.DateOnly, body.ValidUntil); err != nil {
+			writeTPPError(w, http.StatusBadRequest, "FORMAT_ERROR", "validUntil must be a date")
+			return
+		}
+	}
+
+	resp, err := h.createConsent.Execute(r.Context(), dto.CreateAccountConsentRequest{
+		TenantID:        r.TenantID,
+		ProviderID:      r.ProviderID,
+		Permissions:     body.Permissions,
+		AccountIDs:      body.AccountIDs,
+		ValidUntil:      validUntil,
+		FrequencyPerDay: body.FrequencyPerDay,
+		Recurring:       body.RecurringIndicator,
+	})
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	self := tenantPath(r, "/consents/"+resp.ID.String())
+	writeJSON(w, http.StatusCreated, consentCreatedBody{
+		ConsentID:     resp.ID,
+		ConsentStatus: consentStatusNames[resp.Status],
+		Links: map[string]link{
+			"self":   {Href: self},
+			"status": {Href: self + "/status"},
+		},
+	})
+}
+
+// GetConsent handles GET /consents/{consentID}.
+func (h *TPPHandler) GetConsent(w http.ResponseWriter, r tppRequest) {
+	consent, ok := h.findConsent(w, r)
+	if !ok {
+		return
+	}
+	body := consentBody{
+		ConsentID:          consent.ID,
+		ConsentStatus:      consentStatusNames[consent.Status],
+		Permissions:        consent.Permissions,
+		AccountIDs:         consent.AccountIDs,
+		FrequencyPerDay:    consent.FrequencyPerDay,
+		RecurringIndicator: consent.Recurring,
+		LastActionDate:     consent.UpdatedAt.Format(time.DateOnly),
+	}
+	if consent.ValidUntil != nil {
+		body.ValidUntil = consent.ValidUntil.Format(time.DateOnly)
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+// GetConsentStatus handles GET /consents/{consentID}/status.
+func (h *TPPHandler) GetConsentStatus(w http.ResponseWriter, r tppRequest) {
+	consent, ok := h.findConsent(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, consentStatusBody{ConsentStatus: consentStatusNames[consent.Status]})
+}
+
+// DeleteConsent handles DELETE /consents/{consentID}: the provider
+// terminates the consent.
+func (h *TPPHandler) DeleteConsent(w http.ResponseWriter, r tppRequest) {
+	consentID, ok := pathUUID(w, r, "consentID")
+	if !ok {
+		return
+	}
+	_, err := h.terminateConsent.Execute(r.Context(), dto.CloseConsentRequest{
+		TenantID:   r.TenantID,
+		ConsentID:  consentID,
+		ProviderID: &r.ProviderID,
+	})
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListAccounts handles GET /accounts under the consent in Consent-ID.
+func (h *TPPHandler) ListAccounts(w http.ResponseWriter, r tppRequest) {
+	req, ok := accountAccess(w, r, false)
+	if !ok {
+		return
+	}
+	resp, err := h.listAccounts.Execute(r.Context(), req)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	accounts := make([]accountBody, 0, len(resp))
+	for _, a := range resp {
+		accounts = append(accounts, toAccountBody(a))
+	}
+	writeJSON(w, http.StatusOK, map[string][]accountBody{"accounts": accounts})
+}
+
+// GetAccount handles GET /accounts/{accountID}.
+func (h *TPPHandler) GetAccount(w http.ResponseWriter, r tppRequest) {
+	req, ok := accountAccess(w, r, true)
+	if !ok {
+		return
+	}
+	resp, err := h.getAccount.Execute(r.Context(), req)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]accountBody{"account": toAccountBody(resp)})
+}
+
+// GetBalances handles GET /accounts/{accountID}/balances. The balance is
+// what is booked on the account so far today.
+func (h *TPPHandler) GetBalances(w http.ResponseWriter, r tppRequest) {
+	req, ok := accountAccess(w, r, true)
+	if !ok {
+		return
+	}
+	resp, err := h.getBalances.Execute(r.Context(), req)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, struct {
+		Account  accountReferenceBody `json:"account"`
+		Balances []balanceBody        `json:"balances"`
+	}{
+		Account: accountReferenceBody{ResourceID: resp.AccountID},
+		Balances: []balanceBody{{
+			BalanceAmount: amountBody{Currency: resp.Currency, Amount: resp.Amount.StringFixed(2)},
+			BalanceType:   "interimBooked",
+			ReferenceDate: resp.ReferenceDate.Format(time.DateOnly),
+		}},
+	})
+}
+
+// GetTransactions handles GET /accounts/{accountID}/transactions, paged
+// with limit and offset.
+func (h *TPPHandler) GetTransactions(w http.ResponseWriter, r tppRequest) {
+	req, ok := accountAccess(w, r, true)
+	if !ok {
+		return
+	}
+	q := r.URL.Query()
+	var err error
+	if v := q.Get("limit"); v != "" {
+		if req.Limit, err = strconv.Atoi(v); err != nil {
+			writeTPPError(w, http.StatusBadRequest, "FORMAT_ERROR", "limit must be a number")
+			return
+		}
+	}
+	if v := q.Get("offset"); v != "" {
+		if req.Offset, err = strconv.Atoi(v); err != nil {
+			writeTPPError(w, http.StatusBadRequest, "FORMAT_ERROR", "offset must be a number")
+			return
+		}
+	}
+	resp, err := h.getTransactions.Execute(r.Context(), req)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	body := transactionsBody{
+		Booked:  make([]transactionBody, 0, len(resp.Booked)),
+		Pending: make([]transactionBody, 0, len(resp.Pending)),
+	}
+	for _, t := range resp.Booked {
+		body.Booked = append(body.Booked, toTransactionBody(t))
+	}
+	for _, t := range resp.Pending {
+		body.Pending = append(body.Pending, toTransactionBody(t))
+	}
+	writeJSON(w, http.StatusOK, struct {
+		Account      accountReferenceBody `json:"account"`
+		Transactions transactionsBody     `json:"transactions"`
+		TotalCount   int                  `json:"totalCount"`
+	}{
+		Account:      accountReferenceBody{ResourceID: *req.AccountID},
+		Transactions: body,
+		TotalCount:   resp.TotalCount,
+	})
+}
+
+// findConsent retrieves the provider's account consent in the path.
+func (h *TPPHandler) findConsent(w http.ResponseWriter, r tppRequest) (dto.ConsentResponse, bool) {
+	consentID, ok := pathUUID(w, r, "consentID")
+	if !ok {
+		return dto.ConsentResponse{}, false
+	}
+	consent, err := h.getConsent.Execute(r.Context(), dto.GetConsentRequest{
+		TenantID:   r.TenantID,
+		ConsentID:  consentID,
+		ProviderID: &r.ProviderID,
+	})
+	if err == nil && consent.Payment != nil {
+		writeTPPError(w, http.StatusForbidden, "CONSENT_UNKNOWN", "unknown consent")
+		return dto.ConsentResponse{}, false
+	}
+	if err != nil {
+		h.writeError(w, err)
+		return dto.ConsentResponse{}, false
+	}
+	return consent, true
+}
+
+// accountAccess builds the access request of an account route, with the
+// account in the path when withAccount is set.
+func accountAccess(w http.ResponseWriter, r tppRequest, withAccount bool) (dto.AccountAccessRequest, bool) {
+	consentID, err := uuid.Parse(r.Header.Get(headerConsentID))
+	if err != nil {
+		writeTPPError(w, http.StatusBadRequest, "FORMAT_ERROR", "Consent-ID is required")
+		return dto.AccountAccessRequest{}, false
+	}
+	req := dto.AccountAccessRequest{
+		TenantID:   r.TenantID,
+		ProviderID: r.ProviderID,
+		ConsentID:  consentID,
+		PSUPresent: psuPresent(r),
+	}
+	if withAccount {
+		accountID, ok := pathUUID(w, r, "accountID")
+		if !ok {
+			return dto.AccountAccessRequest{}, false
+		}
+		req.AccountID = &accountID
+	}
+	return req, true
+}
+
+func toAccountBody(a dto.AccountResponse) accountBody {
+	return accountBody{
+		ResourceID:      a.ID,
+		AccountNumber:   a.Number,
+		Currency:        a.Currency,
+		CashAccountType: a.Type,
+		Status:          a.Status,
+		OwnerName:       a.HolderName,
+	}
+}
+
+func toTransactionBody(t dto.TransactionResponse) transactionBody {
+	body := transactionBody{
+		TransactionID:                     t.ID,
+		ValueDate:                         t.ValueDate.Format(time.DateOnly),
+		TransactionAmount:                 amountBody{Currency: t.Currency, Amount: t.Amount.StringFixed(2)},
+		CounterpartyAccount:               t.Counterparty,
+		EntryReference:                    t.Reference,
+		RemittanceInformationUnstructured: t.Description,
+	}
+	if t.BookingDate != nil {
+		body.BookingDate = t.BookingDate.Format(time.DateOnly)
+	}
+	return body
+}
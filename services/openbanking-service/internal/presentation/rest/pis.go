@@ -0,0 +1,156 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/dto"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+)
+
+// creditorAccountBody identifies the account paid: resourceId for an
+// account at the bank, accountNumber and routingNumber for an external one.
+type creditorAccountBody struct {
+	ResourceID    *uuid.UUID `json:"resourceId,omitempty"`
+	AccountNumber string     `json:"accountNumber,omitempty"`
+	RoutingNumber string     `json:"routingNumber,omitempty"`
+}
+
+type addressBody struct {
+	Country string `json:"country,omitempty"`
+}
+
+type paymentBody struct {
+	CreditorAddress                   *addressBody         `json:"creditorAddress,omitempty"`
+	InstructedAmount                  amountBody           `json:"instructedAmount"`
+	CreditorAccount                   creditorAccountBody  `json:"creditorAccount"`
+	CreditorName                      string               `json:"creditorName"`
+	RemittanceInformationUnstructured string               `json:"remittanceInformationUnstructured,omitempty"`
+	DebtorAccount                     accountReferenceBody `json:"debtorAccount"`
+}
+
+type paymentCreatedBody struct {
+	TransactionStatus string          `json:"transactionStatus"`
+	Links             map[string]link `json:"_links"`
+	PaymentID         uuid.UUID       `json:"paymentId"`
+}
+
+type paymentStatusBody struct {
+	TransactionStatus string `json:"transactionStatus"`
+	ReasonText        string `json:"reasonText,omitempty"`
+}
+
+// InitiatePayment handles POST /payments/{paymentProduct}: the provider
+// initiates a payment from a PSU's account, which the PSU then authorises
+// through the bank. The payment is known by its consent's ID.
+func (h *TPPHandler) InitiatePayment(w http.ResponseWriter, r tppRequest) {
+	var body paymentBody
+	if !readBody(w, r, &body) {
+		return
+	}
+	amount, err := decimal.NewFromString(body.InstructedAmount.Amount)
+	if err != nil {
+		writeTPPError(w, http.StatusBadRequest, "FORMAT_ERROR", "instructedAmount.amount must be a decimal")
+		return
+	}
+	payment := dto.PaymentDetails{
+		Product:               r.PathValue("paymentProduct"),
+		DebtorAccountID:       body.DebtorAccount.ResourceID,
+		Amount:                amount,
+		Currency:              body.InstructedAmount.Currency,
+		CreditorAccountID:     body.CreditorAccount.ResourceID,
+		CreditorAccountNumber: body.CreditorAccount.AccountNumber,
+		CreditorRoutingNumber: body.CreditorAccount.RoutingNumber,
+		CreditorName:          body.CreditorName,
+		RemittanceInformation: body.RemittanceInformationUnstructured,
+	}
+	if body.CreditorAddress != nil {
+		payment.CreditorCountry = body.CreditorAddress.Country
+	}
+
+	resp, err := h.initiatePayment.Execute(r.Context(), dto.CreatePaymentConsentRequest{
+		TenantID:   r.TenantID,
+		ProviderID: r.ProviderID,
+		Payment:    payment,
+	})
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	self := tenantPath(r, "/payments/"+payment.Product+"/"+resp.ID.String())
+	writeJSON(w, http.StatusCreated, paymentCreatedBody{
+		PaymentID:         resp.ID,
+		TransactionStatus: "RCVD",
+		Links: map[string]link{
+			"self":   {Href: self},
+			"status": {Href: self + "/status"},
+		},
+	})
+}
+
+// GetPayment handles GET /payments/{paymentProduct}/{paymentID}.
+func (h *TPPHandler) GetPayment(w http.ResponseWriter, r tppRequest) {
+	resp, ok := h.findPayment(w, r)
+	if !ok {
+		return
+	}
+	p := resp.Consent.Payment
+	body := struct {
+		paymentBody
+		paymentStatusBody
+	}{
+		paymentBody: paymentBody{
+			DebtorAccount:    accountReferenceBody{ResourceID: p.DebtorAccountID},
+			InstructedAmount: amountBody{Currency: p.Currency, Amount: p.Amount.String()},
+			CreditorAccount: creditorAccountBody{
+				ResourceID:    p.CreditorAccountID,
+				AccountNumber: p.CreditorAccountNumber,
+				RoutingNumber: p.CreditorRoutingNumber,
+			},
+			CreditorName:                      p.CreditorName,
+			RemittanceInformationUnstructured: p.RemittanceInformation,
+		},
+		paymentStatusBody: paymentStatusBody{
+			TransactionStatus: resp.TransactionStatus,
+			ReasonText:        resp.FailureReason,
+		},
+	}
+	if p.CreditorCountry != "" {
+		body.CreditorAddress = &addressBody{Country: p.CreditorCountry}
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+// GetPaymentStatus handles GET /payments/{paymentProduct}/{paymentID}/status.
+func (h *TPPHandler) GetPaymentStatus(w http.ResponseWriter, r tppRequest) {
+	resp, ok := h.findPayment(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, paymentStatusBody{
+		TransactionStatus: resp.TransactionStatus,
+		ReasonText:        resp.FailureReason,
+	})
+}
+
+// findPayment retrieves the provider's payment in the path, which must be
+// of the path's payment product.
+func (h *TPPHandler) findPayment(w http.ResponseWriter, r tppRequest) (dto.PaymentInitiationResponse, bool) {
+	paymentID, ok := pathUUID(w, r, "paymentID")
+	if !ok {
+		return dto.PaymentInitiationResponse{}, false
+	}
+	resp, err := h.getPayment.Execute(r.Context(), r.TenantID, r.ProviderID, paymentID)
+	if err != nil && !errors.Is(err, port.ErrConsentNotFound) {
+		h.writeError(w, err)
+		return dto.PaymentInitiationResponse{}, false
+	}
+	if err != nil || resp.Consent.Payment.Product != r.PathValue("paymentProduct") {
+		writeTPPError(w, http.StatusForbidden, "RESOURCE_UNKNOWN", "unknown payment")
+		return dto.PaymentInitiationResponse{}, false
+	}
+	return resp, true
+}
@@ -0,0 +1,254 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/dto"
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/model"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+	"github.com/bibbank/bib/services/openbanking-service/internal/infrastructure/eidas"
+)
+
+// The PSD2 roles a provider's certificate must carry for account
+// information and for payment initiation.
+const (
+	roleAccountInformation = "PSP_AI"
+	rolePaymentInitiation  = "PSP_PI"
+)
+
+// Headers of the PSD2 API. Every request carries an X-Request-ID, echoed
+// in the response; accounts are read under the consent in Consent-ID; a
+// PSU-IP-Address marks a request the PSU is actively making.
+const (
+	headerRequestID    = "X-Request-ID"
+	headerConsentID    = "Consent-ID"
+	headerPSUIPAddress = "PSU-IP-Address"
+)
+
+// maxBodyBytes bounds request bodies.
+const maxBodyBytes = 64 << 10
+
+// CertificateVerifier verifies the eIDAS certificate a request was made
+// with.
+type CertificateVerifier interface {
+	VerifyRequest(r *http.Request) (eidas.Certificate, error)
+}
+
+// TPPHandler serves the PSD2 API third party providers call: account
+// information (AIS) and payment initiation (PIS) under consents the PSU
+// authorises through the bank. Providers authenticate with their eIDAS
+// certificate, which also tells which of the API they may use. Routes are
+// scoped to a tenant, the bank whose PSUs' data is accessed.
+type TPPHandler struct {
+	verifier         CertificateVerifier
+	identify         *usecase.IdentifyProviderUseCase
+	createConsent    *usecase.CreateAccountConsentUseCase
+	getConsent       *usecase.GetConsentUseCase
+	terminateConsent *usecase.TerminateConsentUseCase
+	listAccounts     *usecase.ListAccountsUseCase
+	getAccount       *usecase.GetAccountUseCase
+	getBalances      *usecase.GetBalancesUseCase
+	getTransactions  *usecase.GetTransactionsUseCase
+	initiatePayment  *usecase.CreatePaymentConsentUseCase
+	getPayment       *usecase.GetPaymentUseCase
+	logger           *slog.Logger
+}
+
+// NewTPPHandler creates a new TPPHandler.
+func NewTPPHandler(
+	verifier CertificateVerifier,
+	identify *usecase.IdentifyProviderUseCase,
+	createConsent *usecase.CreateAccountConsentUseCase,
+	getConsent *usecase.GetConsentUseCase,
+	terminateConsent *usecase.TerminateConsentUseCase,
+	listAccounts *usecase.ListAccountsUseCase,
+	getAccount *usecase.GetAccountUseCase,
+	getBalances *usecase.GetBalancesUseCase,
+	getTransactions *usecase.GetTransactionsUseCase,
+	initiatePayment *usecase.CreatePaymentConsentUseCase,
+	getPayment *usecase.GetPaymentUseCase,
+	logger *slog.Logger,
+) *TPPHandler {
+	return &TPPHandler{
+		verifier:         verifier,
+		identify:         identify,
+		createConsent:    createConsent,
+		getConsent:       getConsent,
+		terminateConsent: terminateConsent,
+		listAccounts:     listAccounts,
+		getAccount:       getAccount,
+		getBalances:      getBalances,
+		getTransactions:  getTransactions,
+		initiatePayment:  initiatePayment,
+		getPayment:       getPayment,
+		logger:           logger,
+	}
+}
+
+// RegisterRoutes registers the PSD2 routes on the given mux.
+func (h *TPPHandler) RegisterRoutes(mux *http.ServeMux) {
+	const prefix = "/open-banking/{tenantID}/v1"
+	ais := func(fn tppHandlerFunc) http.Handler { return h.authenticate(roleAccountInformation, fn) }
+	pis := func(fn tppHandlerFunc) http.Handler { return h.authenticate(rolePaymentInitiation, fn) }
+
+	mux.Handle("POST "+prefix+"/consents", ais(h.CreateConsent))
+	mux.Handle("GET "+prefix+"/consents/{consentID}", ais(h.GetConsent))
+	mux.Handle("GET "+prefix+"/consents/{consentID}/status", ais(h.GetConsentStatus))
+	mux.Handle("DELETE "+prefix+"/consents/{consentID}", ais(h.DeleteConsent))
+	mux.Handle("GET "+prefix+"/accounts", ais(h.ListAccounts))
+	mux.Handle("GET "+prefix+"/accounts/{accountID}", ais(h.GetAccount))
+	mux.Handle("GET "+prefix+"/accounts/{accountID}/balances", ais(h.GetBalances))
+	mux.Handle("GET "+prefix+"/accounts/{accountID}/transactions", ais(h.GetTransactions))
+	mux.Handle("POST "+prefix+"/payments/{paymentProduct}", pis(h.InitiatePayment))
+	mux.Handle("GET "+prefix+"/payments/{paymentProduct}/{paymentID}", pis(h.GetPayment))
+	mux.Handle("GET "+prefix+"/payments/{paymentProduct}/{paymentID}/status", pis(h.GetPaymentStatus))
+}
+
+// tppRequest is an authenticated request of a provider.
+type tppRequest struct {
+	*http.Request
+	TenantID   uuid.UUID
+	ProviderID uuid.UUID
+}
+
+type tppHandlerFunc func(w http.ResponseWriter, r tppRequest)
+
+// authenticate identifies the provider from its certificate and checks that
+// it carries the role the route needs, before handing over to next.
+func (h *TPPHandler) authenticate(role string, next tppHandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(headerRequestID)
+		if _, err := uuid.Parse(requestID); err != nil {
+			writeTPPError(w, http.StatusBadRequest, "FORMAT_ERROR", "X-Request-ID must be a UUID")
+			return
+		}
+		w.Header().Set(headerRequestID, requestID)
+
+		tenantID, err := uuid.Parse(r.PathValue("tenantID"))
+		if err != nil {
+			writeTPPError(w, http.StatusNotFound, "RESOURCE_UNKNOWN", "unknown bank")
+			return
+		}
+		cert, err := h.verifier.VerifyRequest(r)
+		switch {
+		case errors.Is(err, eidas.ErrNoCertificate):
+			writeTPPError(w, http.StatusUnauthorized, "CERTIFICATE_MISSING", "a qualified certificate is required")
+			return
+		case err != nil:
+			writeTPPError(w, http.StatusUnauthorized, "CERTIFICATE_INVALID", err.Error())
+			return
+		}
+		provider, err := h.identify.Execute(r.Context(), dto.IdentifyProviderRequest{
+			TenantID:               tenantID,
+			OrganizationID:         cert.OrganizationID,
+			Name:                   cert.Name,
+			NCAName:                cert.NCAName,
+			NCAID:                  cert.NCAID,
+			CertificateFingerprint: cert.Fingerprint,
+			Roles:                  cert.Roles,
+		})
+		switch {
+		case errors.Is(err, usecase.ErrProviderSuspended):
+			writeTPPError(w, http.StatusUnauthorized, "CERTIFICATE_BLOCKED", "the provider is suspended")
+			return
+		case err != nil:
+			h.writeError(w, err)
+			return
+		}
+		if !slices.Contains(provider.Roles, role) {
+			writeTPPError(w, http.StatusUnauthorized, "ROLE_INVALID", "the certificate does not carry the "+role+" role")
+			return
+		}
+		next(w, tppRequest{Request: r, TenantID: tenantID, ProviderID: provider.ID})
+	})
+}
+
+// pathUUID parses a UUID path parameter, writing a RESOURCE_UNKNOWN error
+// when it is malformed.
+func pathUUID(w http.ResponseWriter, r tppRequest, name string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(r.PathValue(name))
+	if err != nil {
+		writeTPPError(w, http.StatusNotFound, "RESOURCE_UNKNOWN", "unknown resource")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// readBody decodes a JSON request body, writing a FORMAT_ERROR when it is
+// malformed.
+func readBody(w http.ResponseWriter, r tppRequest, v any) bool {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		writeTPPError(w, http.StatusBadRequest, "FORMAT_ERROR", "malformed request body")
+		return false
+	}
+	return true
+}
+
+// psuPresent reports whether the PSU is actively making the request.
+func psuPresent(r tppRequest) bool {
+	return r.Header.Get(headerPSUIPAddress) != ""
+}
+
+// tppMessage is an error as the PSD2 API reports it.
+type tppMessage struct {
+	Category string `json:"category"`
+	Code     string `json:"code"`
+	Text     string `json:"text,omitempty"`
+}
+
+func writeTPPError(w http.ResponseWriter, status int, code, text string) {
+	writeJSON(w, status, map[string][]tppMessage{
+		"tppMessages": {{Category: "ERROR", Code: code, Text: text}},
+	})
+}
+
+// writeError maps a use case error to the PSD2 error codes.
+func (h *TPPHandler) writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidRequest):
+		writeTPPError(w, http.StatusBadRequest, "FORMAT_ERROR", err.Error())
+	case errors.Is(err, port.ErrConsentNotFound):
+		writeTPPError(w, http.StatusForbidden, "CONSENT_UNKNOWN", "unknown consent")
+	case errors.Is(err, port.ErrAccountNotFound):
+		writeTPPError(w, http.StatusNotFound, "RESOURCE_UNKNOWN", "unknown account")
+	case errors.Is(err, model.ErrConsentExpired):
+		writeTPPError(w, http.StatusUnauthorized, "CONSENT_EXPIRED", err.Error())
+	case errors.Is(err, model.ErrConsentNotValid), errors.Is(err, model.ErrNotPermitted):
+		writeTPPError(w, http.StatusUnauthorized, "CONSENT_INVALID", err.Error())
+	case errors.Is(err, model.ErrAccessLimitExceeded):
+		writeTPPError(w, http.StatusTooManyRequests, "ACCESS_EXCEEDED", err.Error())
+	case errors.Is(err, model.ErrInvalidTransition):
+		writeTPPError(w, http.StatusConflict, "STATUS_INVALID", err.Error())
+	case errors.Is(err, port.ErrVersionConflict):
+		writeTPPError(w, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "modified concurrently, retry")
+	default:
+		h.logger.Error("open banking request failed", "error", err)
+		writeTPPError(w, http.StatusInternalServerError, "INTERNAL_SERVER_ERROR", "internal error")
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v) //nolint:errcheck // client disconnects are not actionable
+}
+
+// link is a hypertext link in a response's _links.
+type link struct {
+	Href string `json:"href"`
+}
+
+// tenantPath is the path of a tenant's PSD2 resource.
+func tenantPath(r tppRequest, rest string) string {
+	return "/open-banking/" + r.TenantID.String() + "/v1" + rest
+}
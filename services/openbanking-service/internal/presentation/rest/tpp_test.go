@@ -0,0 +1,380 @@
+package rest_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/dto"
+	"github.com/bibbank/bib/services/openbanking-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/event"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/model"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+	"github.com/bibbank/bib/services/openbanking-service/internal/infrastructure/eidas"
+	"github.com/bibbank/bib/services/openbanking-service/internal/presentation/rest"
+)
+
+// testOrgHeader names the certificate the stub verifier accepts a request
+// as made with.
+const testOrgHeader = "X-Test-Organization"
+
+// stubVerifier accepts the certificates it holds by organization.
+type stubVerifier map[string]eidas.Certificate
+
+func (v stubVerifier) VerifyRequest(r *http.Request) (eidas.Certificate, error) {
+	org := r.Header.Get(testOrgHeader)
+	if org == "" {
+		return eidas.Certificate{}, eidas.ErrNoCertificate
+	}
+	cert, ok := v[org]
+	if !ok {
+		return eidas.Certificate{}, eidas.ErrUntrusted
+	}
+	return cert, nil
+}
+
+type consentRepo struct {
+	consents []model.Consent
+}
+
+func (r *consentRepo) Save(_ context.Context, consent model.Consent) error {
+	consent = consent.ClearDomainEvents()
+	if i := slices.IndexFunc(r.consents, func(c model.Consent) bool { return c.ID() == consent.ID() }); i >= 0 {
+		r.consents[i] = consent
+		return nil
+	}
+	r.consents = append(r.consents, consent)
+	return nil
+}
+
+func (r *consentRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.Consent, error) {
+	for _, c := range r.consents {
+		if c.TenantID() == tenantID && c.ID() == id {
+			return c, nil
+		}
+	}
+	return model.Consent{}, port.ErrConsentNotFound
+}
+
+func (r *consentRepo) List(context.Context, port.ConsentFilter, int, int) ([]model.Consent, int, error) {
+	return r.consents, len(r.consents), nil
+}
+
+func (r *consentRepo) ListExpirable(context.Context, time.Time, time.Time, int) ([]model.Consent, error) {
+	return nil, nil
+}
+
+func (r *consentRepo) ListUnexecuted(context.Context, time.Time, int) ([]model.Consent, error) {
+	return nil, nil
+}
+
+type providerRepo struct {
+	providers []model.ThirdPartyProvider
+}
+
+func (r *providerRepo) Save(_ context.Context, provider model.ThirdPartyProvider) error {
+	provider = provider.ClearDomainEvents()
+	if i := slices.IndexFunc(r.providers, func(p model.ThirdPartyProvider) bool { return p.ID() == provider.ID() }); i >= 0 {
+		r.providers[i] = provider
+		return nil
+	}
+	r.providers = append(r.providers, provider)
+	return nil
+}
+
+func (r *providerRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.ThirdPartyProvider, error) {
+	for _, p := range r.providers {
+		if p.TenantID() == tenantID && p.ID() == id {
+			return p, nil
+		}
+	}
+	return model.ThirdPartyProvider{}, port.ErrProviderNotFound
+}
+
+func (r *providerRepo) FindByOrganizationID(_ context.Context, tenantID uuid.UUID, organizationID string) (model.ThirdPartyProvider, error) {
+	for _, p := range r.providers {
+		if p.TenantID() == tenantID && p.Identity().OrganizationID == organizationID {
+			return p, nil
+		}
+	}
+	return model.ThirdPartyProvider{}, port.ErrProviderNotFound
+}
+
+func (r *providerRepo) List(context.Context, uuid.UUID, int, int) ([]model.ThirdPartyProvider, int, error) {
+	return r.providers, len(r.providers), nil
+}
+
+type nopPublisher struct{}
+
+func (nopPublisher) Publish(context.Context, []event.DomainEvent) error { return nil }
+
+// accountClient serves one PSU's accounts.
+type accountClient struct {
+	psuID    uuid.UUID
+	accounts []port.Account
+}
+
+func (c *accountClient) GetAccount(_ context.Context, tenantID, accountID uuid.UUID) (port.Account, error) {
+	for _, a := range c.accounts {
+		if a.TenantID == tenantID && a.ID == accountID {
+			return a, nil
+		}
+	}
+	return port.Account{}, port.ErrAccountNotFound
+}
+
+func (c *accountClient) ListHolderAccounts(_ context.Context, _, holderID uuid.UUID) ([]port.Account, error) {
+	if holderID != c.psuID {
+		return nil, nil
+	}
+	return c.accounts, nil
+}
+
+type ledgerClient struct{}
+
+func (ledgerClient) GetBalance(context.Context, uuid.UUID, string, string) (decimal.Decimal, error) {
+	return decimal.RequireFromString("1520.5"), nil
+}
+
+type paymentClient struct{}
+
+func (paymentClient) InitiatePayment(context.Context, port.PaymentInstruction) (port.Payment, error) {
+	return port.Payment{ID: uuid.New(), Status: "INITIATED"}, nil
+}
+
+func (paymentClient) GetPayment(_ context.Context, _, paymentID uuid.UUID) (port.Payment, error) {
+	return port.Payment{ID: paymentID, Status: "SETTLED"}, nil
+}
+
+func (paymentClient) ListAccountPayments(context.Context, uuid.UUID, uuid.UUID, int, int) ([]port.Payment, int, error) {
+	return nil, 0, nil
+}
+
+type testAPI struct {
+	mux       *http.ServeMux
+	tenantID  uuid.UUID
+	psuID     uuid.UUID
+	account   port.Account
+	providers *providerRepo
+	authorise *usecase.AuthoriseConsentUseCase
+}
+
+func newTestAPI(t *testing.T) *testAPI {
+	t.Helper()
+	tenantID, psuID := uuid.New(), uuid.New()
+	account := port.Account{
+		ID: uuid.New(), TenantID: tenantID, Number: "DE89370400440532013000", Type: "CURRENT",
+		Status: "ACTIVE", Currency: "EUR", LedgerAccountCode: "2000-001", HolderName: "Ada Lovelace",
+	}
+	consents, providers := &consentRepo{}, &providerRepo{}
+	accounts := &accountClient{psuID: psuID, accounts: []port.Account{account}}
+	verifier := stubVerifier{}
+	for org, roles := range map[string][]string{
+		"PSDDE-BAFIN-111111": {"PSP_AI"},
+		"PSDDE-BAFIN-222222": {"PSP_AI", "PSP_PI"},
+	} {
+		verifier[org] = eidas.Certificate{
+			OrganizationID: org, Name: "TPP " + org, NCAName: "BaFin", NCAID: "DE-BAFIN",
+			Fingerprint: strings.Repeat("ab", 32), Roles: roles,
+		}
+	}
+	logger := slog.New(slog.DiscardHandler)
+
+	handler := rest.NewTPPHandler(verifier,
+		usecase.NewIdentifyProviderUseCase(providers, nopPublisher{}),
+		usecase.NewCreateAccountConsentUseCase(consents, nopPublisher{}),
+		usecase.NewGetConsentUseCase(consents),
+		usecase.NewTerminateConsentUseCase(consents, nopPublisher{}),
+		usecase.NewListAccountsUseCase(consents, accounts),
+		usecase.NewGetAccountUseCase(consents, accounts),
+		usecase.NewGetBalancesUseCase(consents, accounts, ledgerClient{}),
+		usecase.NewGetTransactionsUseCase(consents, paymentClient{}),
+		usecase.NewCreatePaymentConsentUseCase(consents, nopPublisher{}),
+		usecase.NewGetPaymentUseCase(consents, paymentClient{}),
+		logger,
+	)
+	mux := http.NewServeMux()
+	handler.RegisterRoutes(mux)
+	return &testAPI{
+		mux: mux, tenantID: tenantID, psuID: psuID, account: account, providers: providers,
+		authorise: usecase.NewAuthoriseConsentUseCase(consents, accounts, paymentClient{}, nopPublisher{}, logger),
+	}
+}
+
+// do makes a request as the organization, with a consent when consentID is
+// set.
+func (a *testAPI) do(t *testing.T, org, method, path, body string, consentID uuid.UUID) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, "/open-banking/"+a.tenantID.String()+"/v1"+path, strings.NewReader(body))
+	req.Header.Set("X-Request-ID", uuid.NewString())
+	if org != "" {
+		req.Header.Set(testOrgHeader, org)
+	}
+	if consentID != uuid.Nil {
+		req.Header.Set("Consent-ID", consentID.String())
+	}
+	rec := httptest.NewRecorder()
+	a.mux.ServeHTTP(rec, req)
+	return rec
+}
+
+func decode(t *testing.T, rec *httptest.ResponseRecorder) map[string]any {
+	t.Helper()
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body), rec.Body.String())
+	return body
+}
+
+func errorCode(t *testing.T, rec *httptest.ResponseRecorder) string {
+	t.Helper()
+	messages, _ := decode(t, rec)["tppMessages"].([]any)
+	require.Len(t, messages, 1, rec.Body.String())
+	return messages[0].(map[string]any)["code"].(string)
+}
+
+func TestTPP_Authentication(t *testing.T) {
+	api := newTestAPI(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/open-banking/"+api.tenantID.String()+"/v1/accounts", nil)
+	req.Header.Set(testOrgHeader, "PSDDE-BAFIN-111111")
+	rec := httptest.NewRecorder()
+	api.mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "FORMAT_ERROR", errorCode(t, rec), "X-Request-ID is required")
+
+	rec = api.do(t, "", http.MethodGet, "/accounts", "", uuid.New())
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, "CERTIFICATE_MISSING", errorCode(t, rec))
+
+	rec = api.do(t, "PSDDE-BAFIN-999999", http.MethodGet, "/accounts", "", uuid.New())
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, "CERTIFICATE_INVALID", errorCode(t, rec))
+
+	rec = api.do(t, "PSDDE-BAFIN-111111", http.MethodPost, "/payments/sepa-credit-transfers", `{}`, uuid.Nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, "ROLE_INVALID", errorCode(t, rec), "an AISP may not initiate payments")
+	require.Len(t, api.providers.providers, 1, "the provider is registered on first contact")
+	assert.Equal(t, "PSDDE-BAFIN-111111", api.providers.providers[0].Identity().OrganizationID)
+}
+
+func TestTPP_SuspendedProvider(t *testing.T) {
+	api := newTestAPI(t)
+	rec := api.do(t, "PSDDE-BAFIN-111111", http.MethodGet, "/accounts", "", uuid.New())
+	require.Equal(t, "CONSENT_UNKNOWN", errorCode(t, rec))
+
+	suspended, err := api.providers.providers[0].Suspend("licence withdrawn", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, api.providers.Save(context.Background(), suspended))
+
+	rec = api.do(t, "PSDDE-BAFIN-111111", http.MethodGet, "/accounts", "", uuid.New())
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, "CERTIFICATE_BLOCKED", errorCode(t, rec))
+}
+
+func TestTPP_AccountInformation(t *testing.T) {
+	api := newTestAPI(t)
+	const aisp = "PSDDE-BAFIN-111111"
+
+	rec := api.do(t, aisp, http.MethodPost, "/consents",
+		`{"permissions":["READ_BALANCES"],"frequencyPerDay":4,"recurringIndicator":true}`, uuid.Nil)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	created := decode(t, rec)
+	assert.Equal(t, "received", created["consentStatus"])
+	consentID := uuid.MustParse(created["consentId"].(string))
+	assert.NotEmpty(t, rec.Header().Get("X-Request-ID"), "the request ID is echoed")
+
+	rec = api.do(t, aisp, http.MethodGet, "/accounts", "", consentID)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, "CONSENT_INVALID", errorCode(t, rec), "not yet authorised")
+
+	_, err := api.authorise.Execute(context.Background(), dto.AuthoriseConsentRequest{
+		TenantID: api.tenantID, ConsentID: consentID, PSUID: api.psuID, AccountIDs: []uuid.UUID{api.account.ID},
+	})
+	require.NoError(t, err)
+
+	rec = api.do(t, aisp, http.MethodGet, "/consents/"+consentID.String()+"/status", "", uuid.Nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "valid", decode(t, rec)["consentStatus"])
+
+	rec = api.do(t, aisp, http.MethodGet, "/accounts", "", consentID)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	accounts := decode(t, rec)["accounts"].([]any)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, api.account.ID.String(), accounts[0].(map[string]any)["resourceId"])
+	assert.Equal(t, "DE89370400440532013000", accounts[0].(map[string]any)["accountNumber"])
+
+	rec = api.do(t, aisp, http.MethodGet, "/accounts/"+api.account.ID.String()+"/balances", "", consentID)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	balance := decode(t, rec)["balances"].([]any)[0].(map[string]any)
+	assert.Equal(t, map[string]any{"currency": "EUR", "amount": "1520.50"}, balance["balanceAmount"])
+
+	rec = api.do(t, aisp, http.MethodGet, "/accounts/"+uuid.NewString()+"/balances", "", consentID)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, "CONSENT_INVALID", errorCode(t, rec), "an account the consent does not cover")
+
+	rec = api.do(t, "PSDDE-BAFIN-222222", http.MethodGet, "/accounts", "", consentID)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, "CONSENT_UNKNOWN", errorCode(t, rec), "another provider's consent")
+
+	rec = api.do(t, aisp, http.MethodDelete, "/consents/"+consentID.String(), "", uuid.Nil)
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	rec = api.do(t, aisp, http.MethodGet, "/consents/"+consentID.String(), "", uuid.Nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "terminatedByTpp", decode(t, rec)["consentStatus"])
+}
+
+func TestTPP_PaymentInitiation(t *testing.T) {
+	api := newTestAPI(t)
+	const pisp = "PSDDE-BAFIN-222222"
+
+	rec := api.do(t, pisp, http.MethodPost, "/payments/sepa-credit-transfers", `{
+		"debtorAccount": {"resourceId": "`+api.account.ID.String()+`"},
+		"instructedAmount": {"currency": "EUR", "amount": "125.50"},
+		"creditorAccount": {"accountNumber": "FR1420041010050500013M02606"},
+		"creditorName": "Merchant SA",
+		"remittanceInformationUnstructured": "Order 4711"
+	}`, uuid.Nil)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	created := decode(t, rec)
+	assert.Equal(t, "RCVD", created["transactionStatus"])
+	paymentID := created["paymentId"].(string)
+
+	rec = api.do(t, pisp, http.MethodGet, "/payments/instant-sepa-credit-transfers/"+paymentID, "", uuid.Nil)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, "RESOURCE_UNKNOWN", errorCode(t, rec), "the payment is of another product")
+
+	_, err := api.authorise.Execute(context.Background(), dto.AuthoriseConsentRequest{
+		TenantID: api.tenantID, ConsentID: uuid.MustParse(paymentID), PSUID: api.psuID,
+	})
+	require.NoError(t, err)
+
+	rec = api.do(t, pisp, http.MethodGet, "/payments/sepa-credit-transfers/"+paymentID+"/status", "", uuid.Nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "ACSC", decode(t, rec)["transactionStatus"])
+
+	rec = api.do(t, pisp, http.MethodGet, "/payments/sepa-credit-transfers/"+paymentID, "", uuid.Nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	payment := decode(t, rec)
+	assert.Equal(t, "Merchant SA", payment["creditorName"])
+	assert.Equal(t, map[string]any{"currency": "EUR", "amount": "125.5"}, payment["instructedAmount"])
+
+	rec = api.do(t, pisp, http.MethodPost, "/payments/sepa-credit-transfers", `{
+		"debtorAccount": {"resourceId": "`+api.account.ID.String()+`"},
+		"instructedAmount": {"currency": "EUR", "amount": "-1"},
+		"creditorAccount": {"accountNumber": "FR1420041010050500013M02606"},
+		"creditorName": "Merchant SA"
+	}`, uuid.Nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "FORMAT_ERROR", errorCode(t, rec))
+}
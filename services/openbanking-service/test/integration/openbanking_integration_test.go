@@ -0,0 +1,122 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/model"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/port"
+	"github.com/bibbank/bib/services/openbanking-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/openbanking-service/internal/infrastructure/postgres"
+)
+
+func registerProvider(t *testing.T, repo *postgres.ProviderRepo, tenantID uuid.UUID) model.ThirdPartyProvider {
+	t.Helper()
+	p, err := model.NewThirdPartyProvider(tenantID, model.ProviderIdentity{
+		OrganizationID:         "PSDGB-FCA-123456",
+		Name:                   "Budget App Ltd",
+		NCAName:                "Financial Conduct Authority",
+		NCAID:                  "GB-FCA",
+		Roles:                  []valueobject.TPPRole{valueobject.TPPRoleAI, valueobject.TPPRolePI},
+		CertificateFingerprint: "aa",
+	}, time.Now().UTC())
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(context.Background(), p))
+	return p
+}
+
+func TestProviderRepo_OrganizationIsRegisteredOncePerTenant(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewProviderRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+
+	provider := registerProvider(t, repo, tenantID)
+	registerProvider(t, repo, uuid.New())
+
+	duplicate, err := model.NewThirdPartyProvider(tenantID, provider.Identity(), time.Now().UTC())
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Save(ctx, duplicate), port.ErrVersionConflict)
+
+	found, err := repo.FindByOrganizationID(ctx, tenantID, "PSDGB-FCA-123456")
+	require.NoError(t, err)
+	assert.Equal(t, provider.ID(), found.ID())
+	assert.True(t, found.HasRole(valueobject.TPPRolePI))
+}
+
+func TestConsentRepo_AuthorisationRoundTrip(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	consents := postgres.NewConsentRepo(env.Pool())
+	ctx := context.Background()
+	tenantID, psuID, accountID := uuid.New(), uuid.New(), uuid.New()
+	provider := registerProvider(t, postgres.NewProviderRepo(env.Pool()), tenantID)
+	now := time.Now().UTC()
+
+	consent, err := model.NewAccountConsent(tenantID, provider.ID(), model.AccountAccess{
+		Permissions:     []valueobject.Permission{valueobject.PermissionReadAccounts, valueobject.PermissionReadBalances},
+		FrequencyPerDay: 4,
+		Recurring:       true,
+	}, now)
+	require.NoError(t, err)
+	require.NoError(t, consents.Save(ctx, consent))
+
+	authorised, err := consent.Authorise(psuID, []uuid.UUID{accountID}, now)
+	require.NoError(t, err)
+	require.NoError(t, consents.Save(ctx, authorised))
+
+	// Saving the stale copy again conflicts on the version.
+	assert.ErrorIs(t, consents.Save(ctx, authorised), port.ErrVersionConflict)
+
+	found, err := consents.FindByID(ctx, tenantID, consent.ID())
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.ConsentStatusValid, found.Status())
+	assert.Equal(t, []uuid.UUID{accountID}, found.AccountIDs())
+	assert.Len(t, found.Permissions(), 2)
+	require.NotNil(t, found.PSUID())
+	assert.Equal(t, psuID, *found.PSUID())
+
+	list, total, err := consents.List(ctx, port.ConsentFilter{TenantID: tenantID, PSUID: &psuID}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Len(t, list, 1)
+
+	_, err = consents.FindByID(ctx, uuid.New(), consent.ID())
+	assert.ErrorIs(t, err, port.ErrConsentNotFound, "consents are scoped to their tenant")
+}
+
+func TestConsentRepo_PaymentDetailsRoundTrip(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	consents := postgres.NewConsentRepo(env.Pool())
+	ctx := context.Background()
+	tenantID, debtorID := uuid.New(), uuid.New()
+	provider := registerProvider(t, postgres.NewProviderRepo(env.Pool()), tenantID)
+
+	consent, err := model.NewPaymentConsent(tenantID, provider.ID(), model.PaymentDetails{
+		Product:               "sepa-credit-transfers",
+		Amount:                decimal.RequireFromString("125.40"),
+		Currency:              "EUR",
+		DebtorAccountID:       debtorID,
+		CreditorAccountNumber: "DE89370400440532013000",
+		CreditorName:          "Stadtwerke Berlin",
+		RemittanceInformation: "Invoice 2041",
+	}, time.Now().UTC())
+	require.NoError(t, err)
+	require.NoError(t, consents.Save(ctx, consent))
+
+	found, err := consents.FindByID(ctx, tenantID, consent.ID())
+	require.NoError(t, err)
+	require.True(t, found.IsPayment())
+	require.NotNil(t, found.Payment())
+	assert.True(t, decimal.RequireFromString("125.40").Equal(found.Payment().Amount))
+	assert.Equal(t, "DE89370400440532013000", found.Payment().CreditorAccountNumber)
+	assert.Equal(t, []uuid.UUID{debtorID}, found.AccountIDs())
+}
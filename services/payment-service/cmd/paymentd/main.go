@@ -10,15 +10,30 @@ import (
 	"syscall"
 	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	paymentv1 "github.com/bibbank/bib/api/gen/go/bib/payment/v1"
+	"github.com/bibbank/bib/pkg/archive"
 	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/crypto"
+	"github.com/bibbank/bib/pkg/enrichment"
+	"github.com/bibbank/bib/pkg/idempotency"
 	kafkapkg "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
 	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
 	pgpkg "github.com/bibbank/bib/pkg/postgres"
 	"github.com/bibbank/bib/services/payment-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/service"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/payment-service/internal/infrastructure/adapter"
 	"github.com/bibbank/bib/services/payment-service/internal/infrastructure/adapter/ach"
+	"github.com/bibbank/bib/services/payment-service/internal/infrastructure/adapter/swift"
+	"github.com/bibbank/bib/services/payment-service/internal/infrastructure/batchfile"
+	"github.com/bibbank/bib/services/payment-service/internal/infrastructure/client"
 	"github.com/bibbank/bib/services/payment-service/internal/infrastructure/config"
-	"github.com/bibbank/bib/services/payment-service/internal/infrastructure/kafka"
 	infraPG "github.com/bibbank/bib/services/payment-service/internal/infrastructure/postgres"
 	grpcPresentation "github.com/bibbank/bib/services/payment-service/internal/presentation/grpc"
 	"github.com/bibbank/bib/services/payment-service/internal/presentation/rest"
@@ -37,6 +52,7 @@ func main() {
 		Format: cfg.LogFormat,
 	})
 	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
 
 	logger.Info("starting payment-service",
 		"http_port", cfg.HTTPPort,
@@ -52,7 +68,7 @@ func main() {
 	if err != nil {
 		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
 	} else {
-		defer func() { _ = shutdown(ctx) }() //nolint:errcheck
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
 	}
 
 	// Initialize database.
@@ -70,7 +86,7 @@ func main() {
 		logger.Error("failed to connect to database", "error", err)
 		os.Exit(1)
 	}
-	defer pool.Close()
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
 
 	// Run migrations.
 	dsn := pgpkg.Config{
@@ -89,25 +105,113 @@ func main() {
 	producer := kafkapkg.NewProducer(kafkapkg.Config{
 		Brokers: cfg.Kafka.Brokers,
 	})
-	defer producer.Close()
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(producer.Close))
+
+	// Outbox relay: events are written to the outbox and published from it,
+	// so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     outbox.StaticRoute(usecase.TopicPaymentOrders),
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: cfg.Telemetry.ServiceName,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, producer, relayCfg, logger)
+	if err != nil {
+		logger.Error("failed to create outbox relay", "error", err)
+		os.Exit(1)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+
+	// Monthly partitions are created ahead of time and old ones archived.
+	partitions := pgpkg.NewPartitionManager(pool, infraPG.PartitionPolicies(), logger)
+	lc.Go(lifecycle.PhaseWorkers, "partition manager", func(ctx context.Context) error {
+		partitions.Run(ctx, time.Hour)
+		return nil
+	})
+
+	// Months past the database's retention go to cold storage when an
+	// archive is configured.
+	if store := archive.NewStore(cfg.Archive); store != nil {
+		archiver := archive.NewArchiver(pool, store, archive.Config{Prefix: "payments", Policies: infraPG.ArchivePolicies()}, logger)
+		lc.Go(lifecycle.PhaseWorkers, "archiver", func(ctx context.Context) error {
+			archiver.Run(ctx, time.Hour)
+			return nil
+		})
+	}
+
+	// Routing and external account numbers are encrypted under per-tenant
+	// data keys when a KMS is configured.
+	kms, err := crypto.NewKMS(cfg.PII)
+	if err != nil {
+		logger.Error("invalid PII encryption config", "error", err)
+		os.Exit(1)
+	}
+	var pii *crypto.Cipher
+	if kms != nil {
+		pii = crypto.NewCipher(kms, crypto.NewPostgresKeyStore(pool))
+	} else {
+		logger.Warn("no KMS configured, storing personal data unencrypted")
+	}
 
 	// Wire dependencies (DI via constructors).
-	paymentRepo := infraPG.NewPaymentOrderRepo(pool)
-	publisher := kafka.NewPublisher(producer)
+	paymentRepo := infraPG.NewPaymentOrderRepo(pool, pii)
+	scheduleRepo := infraPG.NewPaymentScheduleRepo(pool, pii)
+	batchRepo := infraPG.NewPaymentBatchRepo(pool, pii)
+	publisher := outbox.NewPublisher(outboxStore)
 	routingEngine := service.NewRoutingEngine()
-	achAdapter := ach.NewAdapter(logger)
+	railAdapter, err := newRailAdapter(cfg.SWIFT, cfg.Sandbox, paymentRepo, logger)
+	if err != nil {
+		logger.Error("invalid SWIFT config", "error", err)
+		os.Exit(1)
+	}
 
 	// Use cases.
 	initiatePaymentUC := usecase.NewInitiatePayment(paymentRepo, publisher, routingEngine, nil)
-	getPaymentUC := usecase.NewGetPayment(paymentRepo)
-	listPaymentsUC := usecase.NewListPayments(paymentRepo)
-	_ = usecase.NewProcessPayment(paymentRepo, achAdapter, publisher)
+	getPaymentUC := usecase.NewGetPayment(paymentRepo, enrichment.Default)
+	listPaymentsUC := usecase.NewListPayments(paymentRepo, enrichment.Default)
+	schedulePaymentUC := usecase.NewSchedulePayment(scheduleRepo)
+	listSchedulesUC := usecase.NewListPaymentSchedules(scheduleRepo)
+	cancelScheduleUC := usecase.NewCancelPaymentSchedule(scheduleRepo)
+	runSchedulesUC := usecase.NewRunPaymentSchedules(scheduleRepo, publisher, routingEngine, nil, logger)
+	importBatchUC := usecase.NewImportPaymentBatch(batchRepo, batchfile.NewParser(), publisher, routingEngine, nil, logger)
+	getBatchUC := usecase.NewGetPaymentBatch(batchRepo)
+
+	// Initiate the due runs of payment schedules.
+	lc.Go(lifecycle.PhaseWorkers, "payment scheduler", func(ctx context.Context) error {
+		ticker := time.NewTicker(cfg.Schedule.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				initiated, runErr := runSchedulesUC.Execute(ctx, time.Now().UTC(), cfg.Schedule.BatchSize)
+				if runErr != nil {
+					logger.Error("payment schedule run failed", "error", runErr)
+				}
+				if initiated > 0 {
+					logger.Info("scheduled payments initiated", "count", initiated)
+				}
+			}
+		}
+	})
 
 	// JWT service (validation-only: public key preferred, secret as fallback).
 	jwtCfg := auth.JWTConfig{
 		Issuer: "bib-gateway",
 	}
 	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
 	case os.Getenv("JWT_PUBLIC_KEY") != "":
 		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
 	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
@@ -130,15 +234,135 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Payment saga: the account and ledger calls carry tenant tokens signed
+	// like the gateway's, since the recovery loop has no caller to forward.
+	var signer *auth.JWTService
+	switch {
+	case cfg.Saga.SigningKeyFile != "":
+		keyData, loadErr := auth.LoadKeyFromFile(cfg.Saga.SigningKeyFile)
+		if loadErr != nil {
+			logger.Error("failed to load payment saga signing key file", "error", loadErr)
+			os.Exit(1)
+		}
+		signer, err = auth.NewJWTService(auth.JWTConfig{
+			PrivateKeyPEM: string(keyData),
+			Issuer:        "bib-gateway",
+			Expiration:    15 * time.Minute,
+		})
+		if err != nil {
+			logger.Error("failed to initialize payment saga token signer", "error", err)
+			os.Exit(1)
+		}
+	case jwtCfg.Secret != "":
+		signer, err = auth.NewJWTService(auth.JWTConfig{
+			Secret:     jwtCfg.Secret,
+			Issuer:     "bib-gateway",
+			Expiration: 15 * time.Minute,
+		})
+		if err != nil {
+			logger.Error("failed to initialize payment saga token signer", "error", err)
+			os.Exit(1)
+		}
+	default:
+		logger.Warn("PAYMENT_SAGA_SIGNING_KEY_FILE not set, payment saga calls will be unauthenticated")
+	}
+	sagaClientConfig := client.Config{
+		MaxRetries:   cfg.Saga.MaxRetries,
+		RetryBackoff: cfg.Saga.RetryBackoff,
+		Timeout:      cfg.Saga.StepTimeout,
+	}
+	var accountClient port.AccountClient = client.NewStubAccountClient()
+	if cfg.Saga.AccountGRPCAddr != "" {
+		accountConn, dialErr := grpc.NewClient(cfg.Saga.AccountGRPCAddr,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if dialErr != nil {
+			logger.Error("failed to create account service client", "addr", cfg.Saga.AccountGRPCAddr, "error", dialErr)
+			os.Exit(1)
+		}
+		lc.OnStop(lifecycle.PhaseResources, "account client", lifecycle.Close(accountConn.Close))
+		accountClient = client.NewAccountGRPCClient(accountConn, signer, sagaClientConfig)
+	} else {
+		logger.Warn("ACCOUNT_SERVICE_ADDR not set, using placeholder ledger accounts for payment holds")
+	}
+	var ledgerClient port.LedgerClient = client.NewStubLedgerClient(logger)
+	if cfg.Saga.LedgerGRPCAddr != "" {
+		ledgerConn, dialErr := grpc.NewClient(cfg.Saga.LedgerGRPCAddr,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if dialErr != nil {
+			logger.Error("failed to create ledger service client", "addr", cfg.Saga.LedgerGRPCAddr, "error", dialErr)
+			os.Exit(1)
+		}
+		lc.OnStop(lifecycle.PhaseResources, "ledger client", lifecycle.Close(ledgerConn.Close))
+		ledgerClient = client.NewLedgerGRPCClient(ledgerConn, signer, sagaClientConfig)
+	} else {
+		logger.Warn("LEDGER_SERVICE_ADDR not set, payment saga ledger entries will only be logged")
+	}
+	processPaymentUC := usecase.NewProcessPayment(paymentRepo, railAdapter, publisher, nil,
+		accountClient, ledgerClient, infraPG.NewSagaStore(pool),
+		usecase.PaymentSagaConfig{
+			SuspenseAccount: cfg.Saga.SuspenseAccount,
+			ClearingAccount: cfg.Saga.ClearingAccount,
+			StepTimeout:     cfg.Saga.StepTimeout,
+			MaxAttempts:     cfg.Saga.MaxAttempts,
+		}, logger)
+
+	// Resume payment sagas left unfinished by a crash or a failed retry.
+	lc.Go(lifecycle.PhaseWorkers, "saga recovery", func(ctx context.Context) error {
+		ticker := time.NewTicker(cfg.Saga.RecoveryInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				recovered, recoverErr := processPaymentUC.RecoverStalled(ctx, cfg.Saga.StaleAfter, 100)
+				if recoverErr != nil {
+					logger.Error("payment saga recovery failed", "error", recoverErr)
+				}
+				if recovered > 0 {
+					logger.Info("payment sagas recovered", "count", recovered)
+				}
+			}
+		}
+	})
+
 	// gRPC server.
 	handler := grpcPresentation.NewPaymentHandler(initiatePaymentUC, getPaymentUC, listPaymentsUC,
-		logger)
-	grpcServer := grpcPresentation.NewServer(handler, cfg.GRPCPort, logger, jwtSvc)
+		schedulePaymentUC, listSchedulesUC, cancelScheduleUC, processPaymentUC, importBatchUC, getBatchUC, logger)
+	// Retries of InitiatePayment, SchedulePayment and ImportPaymentBatch
+	// carrying an idempotency key replay the first response instead of
+	// running it again.
+	idempotencyStore := idempotency.NewPostgresStore(pool)
+	lc.Go(lifecycle.PhaseWorkers, "idempotency purge", func(ctx context.Context) error {
+		idempotencyStore.RunPurge(ctx, time.Hour, logger)
+		return nil
+	})
+	idempotencyInterceptor := idempotency.UnaryServerInterceptor(idempotency.Config{
+		Store: idempotencyStore,
+		Methods: map[string]func() any{
+			"/bib.payment.v1.PaymentService/InitiatePayment":    idempotency.Response[paymentv1.InitiatePaymentResponse](),
+			"/bib.payment.v1.PaymentService/SchedulePayment":    idempotency.Response[paymentv1.SchedulePaymentResponse](),
+			"/bib.payment.v1.PaymentService/ImportPaymentBatch": idempotency.Response[paymentv1.ImportPaymentBatchResponse](),
+		},
+		Logger: logger,
+	})
+	grpcServer, err := grpcPresentation.NewServer(handler, cfg.GRPCPort, logger, jwtSvc,
+		grpc.ChainUnaryInterceptor(idempotencyInterceptor))
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
 
 	// HTTP server (health checks + metrics).
 	mux := http.NewServeMux()
 	healthHandler := rest.NewHealthHandler()
 	healthHandler.RegisterRoutes(mux)
+	if metricsHandler != nil {
+		mux.Handle("/metrics", metricsHandler)
+	}
 
 	httpServer := &http.Server{
 		Addr:              fmt.Sprintf(":%d", cfg.HTTPPort),
@@ -147,29 +371,43 @@ func main() {
 	}
 
 	// Start servers.
-	errCh := make(chan error, 2)
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", grpcServer.Start)
+	lc.ServeHTTP("HTTP server", httpServer)
 
-	go func() {
-		errCh <- grpcServer.Start(ctx)
-	}()
-
-	go func() {
-		logger.Info("HTTP server starting", "port", cfg.HTTPPort)
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			errCh <- err
-		}
-	}()
-
-	// Wait for shutdown.
-	select {
-	case <-ctx.Done():
-		logger.Info("shutdown signal received")
-	case err := <-errCh:
-		logger.Error("server error", "error", err)
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
 	}
-
-	// Graceful shutdown.
-	_ = httpServer.Shutdown(context.Background()) //nolint:errcheck
-	grpcServer.Stop()
 	logger.Info("payment-service stopped")
 }
+
+// newRailAdapter returns the adapter payments are submitted through: the
+// SWIFT adapter for SWIFT payments when a SWIFT BIC is configured, and the
+// ACH stub for the rest. In sandbox mode every payment goes to the ACH stub,
+// so no pain.001 file reaches the SWIFT outbox.
+func newRailAdapter(cfg config.SWIFTConfig, sandbox bool, orders port.PaymentOrderRepository, logger *slog.Logger) (port.RailAdapter, error) {
+	achAdapter := ach.NewAdapter(logger)
+	if sandbox {
+		logger.Warn("sandbox mode, submitting all payments to the ACH stub")
+		return achAdapter, nil
+	}
+	if cfg.BIC == "" {
+		logger.Warn("PAYMENT_SWIFT_BIC not set, SWIFT payments will go to the ACH stub")
+		return achAdapter, nil
+	}
+	bic, err := valueobject.NewBIC(cfg.BIC)
+	if err != nil {
+		return nil, err
+	}
+	swiftAdapter, err := swift.NewAdapter(swift.Config{
+		Debtor:    swift.Debtor{Name: cfg.Name, Account: cfg.Account, BIC: bic},
+		OutboxDir: cfg.OutboxDir,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+	return adapter.NewRouter(orders, achAdapter, map[valueobject.PaymentRail]port.RailAdapter{
+		valueobject.RailSWIFT: swiftAdapter,
+	}), nil
+}
@@ -3,11 +3,22 @@ module github.com/bibbank/bib/services/payment-service
 go 1.24
 
 require (
+	github.com/bibbank/bib/api/gen/go v0.0.0
+	github.com/bibbank/bib/pkg/archive v0.0.0
 	github.com/bibbank/bib/pkg/auth v0.0.0-00010101000000-000000000000
+	github.com/bibbank/bib/pkg/cron v0.0.0
+	github.com/bibbank/bib/pkg/crypto v0.0.0
+	github.com/bibbank/bib/pkg/enrichment v0.0.0
+	github.com/bibbank/bib/pkg/contract v0.0.0
 	github.com/bibbank/bib/pkg/events v0.0.0
+	github.com/bibbank/bib/pkg/grpcserver v0.0.0
+	github.com/bibbank/bib/pkg/idempotency v0.0.0
 	github.com/bibbank/bib/pkg/kafka v0.0.0
+	github.com/bibbank/bib/pkg/lifecycle v0.0.0
 	github.com/bibbank/bib/pkg/observability v0.0.0
+	github.com/bibbank/bib/pkg/outbox v0.0.0
 	github.com/bibbank/bib/pkg/postgres v0.0.0
+	github.com/bibbank/bib/pkg/saga v0.0.0
 	github.com/bibbank/bib/pkg/testutil v0.0.0
 	github.com/bibbank/bib/pkg/tlsutil v0.0.0-00010101000000-000000000000
 	github.com/google/uuid v1.6.0
@@ -107,11 +118,22 @@ require (
 )
 
 replace (
+	github.com/bibbank/bib/api/gen/go => ../../api/gen/go
+	github.com/bibbank/bib/pkg/archive => ../../pkg/archive
 	github.com/bibbank/bib/pkg/auth => ../../pkg/auth
+	github.com/bibbank/bib/pkg/cron => ../../pkg/cron
+	github.com/bibbank/bib/pkg/crypto => ../../pkg/crypto
+	github.com/bibbank/bib/pkg/enrichment => ../../pkg/enrichment
+	github.com/bibbank/bib/pkg/contract => ../../pkg/contract
 	github.com/bibbank/bib/pkg/events => ../../pkg/events
+	github.com/bibbank/bib/pkg/grpcserver => ../../pkg/grpcserver
+	github.com/bibbank/bib/pkg/idempotency => ../../pkg/idempotency
 	github.com/bibbank/bib/pkg/kafka => ../../pkg/kafka
+	github.com/bibbank/bib/pkg/lifecycle => ../../pkg/lifecycle
 	github.com/bibbank/bib/pkg/observability => ../../pkg/observability
+	github.com/bibbank/bib/pkg/outbox => ../../pkg/outbox
 	github.com/bibbank/bib/pkg/postgres => ../../pkg/postgres
+	github.com/bibbank/bib/pkg/saga => ../../pkg/saga
 	github.com/bibbank/bib/pkg/testutil => ../../pkg/testutil
 	github.com/bibbank/bib/pkg/tlsutil => ../../pkg/tlsutil
 )
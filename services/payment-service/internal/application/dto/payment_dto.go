@@ -48,6 +48,7 @@ type PaymentOrderResponse struct {
 	Reference             string
 	Description           string
 	FailureReason         string
+	Counterparty          CounterpartyResponse
 	Amount                decimal.Decimal
 	Version               int
 	ID                    uuid.UUID
@@ -56,6 +57,16 @@ type PaymentOrderResponse struct {
 	TenantID              uuid.UUID
 }
 
+// CounterpartyResponse is the other party of a payment as shown to
+// customers. MaskedIdentifier shows the last four characters of the
+// external account; it is empty for payments between the bank's accounts.
+type CounterpartyResponse struct {
+	Name             string
+	MaskedIdentifier string
+	Category         string
+	Logo             string
+}
+
 // ListPaymentsRequest is the input DTO for listing payment orders.
 type ListPaymentsRequest struct {
 	TenantID  uuid.UUID
@@ -69,3 +80,117 @@ type ListPaymentsResponse struct {
 	Payments   []PaymentOrderResponse
 	TotalCount int
 }
+
+// SchedulePaymentRequest is the input DTO for creating a payment schedule.
+type SchedulePaymentRequest struct {
+	StartAt               time.Time
+	EndAt                 *time.Time
+	Amount                decimal.Decimal
+	Currency              string
+	RoutingNumber         string
+	ExternalAccountNumber string
+	DestinationCountry    string
+	Reference             string
+	Description           string
+	Frequency             string
+	CronExpression        string
+	Interval              int
+	MaxRuns               int
+	TenantID              uuid.UUID
+	SourceAccountID       uuid.UUID
+	DestinationAccountID  uuid.UUID
+}
+
+// PaymentScheduleResponse is the output DTO for a payment schedule.
+type PaymentScheduleResponse struct {
+	StartAt               time.Time
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	EndAt                 *time.Time
+	NextRunAt             *time.Time
+	LastRunAt             *time.Time
+	Currency              string
+	RoutingNumber         string
+	ExternalAccountNumber string
+	DestinationCountry    string
+	Reference             string
+	Description           string
+	Frequency             string
+	CronExpression        string
+	Status                string
+	LastFailure           string
+	Amount                decimal.Decimal
+	Interval              int
+	MaxRuns               int
+	RunCount              int
+	ID                    uuid.UUID
+	TenantID              uuid.UUID
+	SourceAccountID       uuid.UUID
+	DestinationAccountID  uuid.UUID
+	LastPaymentID         uuid.UUID
+}
+
+// ListPaymentSchedulesRequest is the input DTO for listing payment schedules.
+type ListPaymentSchedulesRequest struct {
+	TenantID uuid.UUID
+	PageSize int
+	Offset   int
+}
+
+// ListPaymentSchedulesResponse is the output DTO for listing payment schedules.
+type ListPaymentSchedulesResponse struct {
+	Schedules  []PaymentScheduleResponse
+	TotalCount int
+}
+
+// CancelPaymentScheduleRequest is the input DTO for cancelling a payment schedule.
+type CancelPaymentScheduleRequest struct {
+	TenantID   uuid.UUID
+	ScheduleID uuid.UUID
+}
+
+// ImportPaymentBatchRequest is the input DTO for importing a bulk payment
+// file.
+type ImportPaymentBatchRequest struct {
+	Format          string
+	FileName        string
+	Content         []byte
+	TenantID        uuid.UUID
+	SourceAccountID uuid.UUID
+}
+
+// GetPaymentBatchRequest is the input DTO for retrieving a payment batch.
+type GetPaymentBatchRequest struct {
+	BatchID uuid.UUID
+}
+
+// PaymentBatchResponse is the output DTO for a payment batch: its progress
+// and its error report, the entries that were rejected or whose payments
+// failed.
+type PaymentBatchResponse struct {
+	CreatedAt       time.Time
+	Format          string
+	FileName        string
+	Status          string
+	Errors          []BatchEntryErrorResponse
+	TotalEntries    int
+	RejectedEntries int
+	PendingEntries  int
+	SettledEntries  int
+	FailedEntries   int
+	ID              uuid.UUID
+	TenantID        uuid.UUID
+	SourceAccountID uuid.UUID
+}
+
+// BatchEntryErrorResponse is an entry of a payment batch's error report.
+type BatchEntryErrorResponse struct {
+	Reference       string
+	BeneficiaryName string
+	Currency        string
+	Status          string
+	Error           string
+	Amount          decimal.Decimal
+	Sequence        int
+	PaymentID       uuid.UUID
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/payment-service/internal/application/dto"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+)
+
+// CancelPaymentSchedule handles cancelling a payment schedule so no further
+// runs are made. Payments already initiated by it are unaffected.
+type CancelPaymentSchedule struct {
+	scheduleRepo port.PaymentScheduleRepository
+}
+
+func NewCancelPaymentSchedule(scheduleRepo port.PaymentScheduleRepository) *CancelPaymentSchedule {
+	return &CancelPaymentSchedule{scheduleRepo: scheduleRepo}
+}
+
+func (uc *CancelPaymentSchedule) Execute(ctx context.Context, req dto.CancelPaymentScheduleRequest) (dto.PaymentScheduleResponse, error) {
+	schedule, err := uc.scheduleRepo.FindByID(ctx, req.ScheduleID)
+	if err != nil {
+		return dto.PaymentScheduleResponse{}, fmt.Errorf("failed to find payment schedule: %w", err)
+	}
+	if schedule.TenantID() != req.TenantID {
+		return dto.PaymentScheduleResponse{}, fmt.Errorf("failed to find payment schedule: payment schedule %s not found", req.ScheduleID)
+	}
+
+	cancelled, err := schedule.Cancel(time.Now().UTC())
+	if err != nil {
+		return dto.PaymentScheduleResponse{}, fmt.Errorf("failed to cancel payment schedule: %w", err)
+	}
+	if err := uc.scheduleRepo.Save(ctx, cancelled); err != nil {
+		return dto.PaymentScheduleResponse{}, fmt.Errorf("failed to save payment schedule: %w", err)
+	}
+	return toPaymentScheduleResponse(cancelled), nil
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/enrichment"
 	"github.com/bibbank/bib/services/payment-service/internal/application/dto"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
@@ -12,10 +15,13 @@ import (
 // GetPayment handles retrieval of a single payment order by ID.
 type GetPayment struct {
 	paymentRepo port.PaymentOrderRepository
+	enricher    *enrichment.Enricher
 }
 
-func NewGetPayment(paymentRepo port.PaymentOrderRepository) *GetPayment {
-	return &GetPayment{paymentRepo: paymentRepo}
+// NewGetPayment creates a GetPayment describing counterparties with
+// enricher.
+func NewGetPayment(paymentRepo port.PaymentOrderRepository, enricher *enrichment.Enricher) *GetPayment {
+	return &GetPayment{paymentRepo: paymentRepo, enricher: enricher}
 }
 
 func (uc *GetPayment) Execute(ctx context.Context, req dto.GetPaymentRequest) (dto.PaymentOrderResponse, error) {
@@ -23,10 +29,18 @@ func (uc *GetPayment) Execute(ctx context.Context, req dto.GetPaymentRequest) (d
 	if err != nil {
 		return dto.PaymentOrderResponse{}, fmt.Errorf("failed to find payment order: %w", err)
 	}
-	return toPaymentOrderResponse(order), nil
+	return toPaymentOrderResponse(order, uc.enricher), nil
 }
 
-func toPaymentOrderResponse(order model.PaymentOrder) dto.PaymentOrderResponse {
+// toPaymentOrderResponse maps an order to its DTO, enriching its
+// counterparty from the description and the external account. Payments
+// between the bank's accounts have no external account to show.
+func toPaymentOrderResponse(order model.PaymentOrder, enricher *enrichment.Enricher) dto.PaymentOrderResponse {
+	var identifier string
+	if order.DestinationAccountID() == uuid.Nil {
+		identifier = order.RoutingInfo().ExternalAccountNumber()
+	}
+	counterparty := enricher.Counterparty(order.Description(), identifier)
 	return dto.PaymentOrderResponse{
 		ID:                    order.ID(),
 		TenantID:              order.TenantID(),
@@ -46,5 +60,11 @@ func toPaymentOrderResponse(order model.PaymentOrder) dto.PaymentOrderResponse {
 		Version:               order.Version(),
 		CreatedAt:             order.CreatedAt(),
 		UpdatedAt:             order.UpdatedAt(),
+		Counterparty: dto.CounterpartyResponse{
+			Name:             counterparty.Name,
+			MaskedIdentifier: counterparty.MaskedIdentifier,
+			Category:         counterparty.Category.String(),
+			Logo:             counterparty.Logo,
+		},
 	}
 }
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/payment-service/internal/application/dto"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+)
+
+// GetPaymentBatch handles retrieval of a payment batch's progress and error
+// report.
+type GetPaymentBatch struct {
+	batchRepo port.PaymentBatchRepository
+}
+
+func NewGetPaymentBatch(batchRepo port.PaymentBatchRepository) *GetPaymentBatch {
+	return &GetPaymentBatch{batchRepo: batchRepo}
+}
+
+func (uc *GetPaymentBatch) Execute(ctx context.Context, req dto.GetPaymentBatchRequest) (dto.PaymentBatchResponse, error) {
+	batch, err := uc.batchRepo.FindByID(ctx, req.BatchID)
+	if err != nil {
+		return dto.PaymentBatchResponse{}, fmt.Errorf("failed to find payment batch: %w", err)
+	}
+	return toPaymentBatchResponse(batch), nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/bibbank/bib/pkg/enrichment"
 	"github.com/bibbank/bib/services/payment-service/internal/application/dto"
 	"github.com/bibbank/bib/services/payment-service/internal/application/usecase"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
@@ -24,7 +25,7 @@ func samplePaymentOrder() model.PaymentOrder {
 		uuid.New(), uuid.New(), uuid.New(), uuid.Nil,
 		decimal.NewFromInt(1000), "USD",
 		valueobject.RailACH, valueobject.PaymentStatusInitiated,
-		routingInfo, "PAY-001", "ACH payment", "",
+		routingInfo, "PAY-001", "ACH payment", "", "",
 		now, nil, 1, now, now,
 	)
 }
@@ -39,7 +40,7 @@ func TestGetPayment_Execute(t *testing.T) {
 			},
 		}
 
-		uc := usecase.NewGetPayment(repo)
+		uc := usecase.NewGetPayment(repo, enrichment.Default)
 
 		req := dto.GetPaymentRequest{PaymentID: order.ID()}
 		resp, err := uc.Execute(context.Background(), req)
@@ -53,6 +54,35 @@ func TestGetPayment_Execute(t *testing.T) {
 		assert.Equal(t, "USD", resp.Currency)
 		assert.Equal(t, "PAY-001", resp.Reference)
 		assert.Equal(t, "ACH payment", resp.Description)
+		assert.Equal(t, dto.CounterpartyResponse{
+			Name:             "ACH payment",
+			MaskedIdentifier: "••••6789",
+			Category:         "TRANSFERS",
+		}, resp.Counterparty)
+	})
+
+	t.Run("recognizes the counterparty's brand", func(t *testing.T) {
+		now := time.Now().UTC()
+		order := model.Reconstruct(
+			uuid.New(), uuid.New(), uuid.New(), uuid.New(),
+			decimal.NewFromInt(15), "USD",
+			valueobject.RailInternal, valueobject.PaymentStatusSettled,
+			valueobject.RoutingInfo{}, "PAY-002", "NETFLIX.COM subscription", "", "",
+			now, &now, 1, now, now,
+		)
+		repo := &mockPaymentOrderRepository{
+			findByIDFunc: func(_ context.Context, _ uuid.UUID) (model.PaymentOrder, error) {
+				return order, nil
+			},
+		}
+
+		resp, err := usecase.NewGetPayment(repo, enrichment.Default).Execute(context.Background(), dto.GetPaymentRequest{PaymentID: order.ID()})
+		require.NoError(t, err)
+		assert.Equal(t, dto.CounterpartyResponse{
+			Name:     "Netflix",
+			Category: "ENTERTAINMENT",
+			Logo:     "netflix",
+		}, resp.Counterparty, "payments between the bank's accounts show no identifier")
 	})
 
 	t.Run("fails when payment order not found", func(t *testing.T) {
@@ -62,7 +92,7 @@ func TestGetPayment_Execute(t *testing.T) {
 			},
 		}
 
-		uc := usecase.NewGetPayment(repo)
+		uc := usecase.NewGetPayment(repo, enrichment.Default)
 
 		req := dto.GetPaymentRequest{PaymentID: uuid.New()}
 		_, err := uc.Execute(context.Background(), req)
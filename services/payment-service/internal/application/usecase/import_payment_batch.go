@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/bibbank/bib/services/payment-service/internal/application/dto"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/service"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+const (
+	// MaxBatchFileSize is the largest bulk payment file accepted, in bytes.
+	MaxBatchFileSize = 2 << 20
+	// MaxBatchEntries is the most instructions a bulk payment file may hold.
+	MaxBatchEntries = 10000
+)
+
+// ImportPaymentBatch imports bulk payment files, initiating a payment order
+// for each usable instruction. Instructions that cannot be paid, such as
+// those with invalid routing details or rejected by the fraud assessment,
+// are recorded as rejected entries without failing the import.
+type ImportPaymentBatch struct {
+	batchRepo     port.PaymentBatchRepository
+	parser        port.BatchFileParser
+	publisher     port.EventPublisher
+	routingEngine *service.RoutingEngine
+	fraudClient   port.FraudClient // optional, may be nil
+	logger        *slog.Logger
+}
+
+func NewImportPaymentBatch(
+	batchRepo port.PaymentBatchRepository,
+	parser port.BatchFileParser,
+	publisher port.EventPublisher,
+	routingEngine *service.RoutingEngine,
+	fraudClient port.FraudClient,
+	logger *slog.Logger,
+) *ImportPaymentBatch {
+	return &ImportPaymentBatch{
+		batchRepo:     batchRepo,
+		parser:        parser,
+		publisher:     publisher,
+		routingEngine: routingEngine,
+		fraudClient:   fraudClient,
+		logger:        logger,
+	}
+}
+
+// Execute imports a file. It fails, importing nothing, if the file is
+// malformed as a whole, was already imported by the tenant
+// (port.ErrDuplicateBatch), or the fraud assessment is unavailable.
+func (uc *ImportPaymentBatch) Execute(ctx context.Context, req dto.ImportPaymentBatchRequest) (dto.PaymentBatchResponse, error) {
+	format, err := valueobject.NewBatchFileFormat(req.Format)
+	if err != nil {
+		return dto.PaymentBatchResponse{}, err
+	}
+	if len(req.Content) == 0 {
+		return dto.PaymentBatchResponse{}, fmt.Errorf("%w: file is empty", port.ErrInvalidBatchFile)
+	}
+	if len(req.Content) > MaxBatchFileSize {
+		return dto.PaymentBatchResponse{}, fmt.Errorf("%w: file exceeds %d bytes", port.ErrInvalidBatchFile, MaxBatchFileSize)
+	}
+
+	parsed, err := uc.parser.Parse(format, req.Content)
+	if err != nil {
+		return dto.PaymentBatchResponse{}, err
+	}
+	if len(parsed) > MaxBatchEntries {
+		return dto.PaymentBatchResponse{}, fmt.Errorf("%w: file has %d entries, at most %d are accepted", port.ErrInvalidBatchFile, len(parsed), MaxBatchEntries)
+	}
+
+	entries := make([]model.BatchEntry, 0, len(parsed))
+	var orders []model.PaymentOrder
+	for i, p := range parsed {
+		seq := i + 1
+		if p.Err != nil {
+			entries = append(entries, model.NewRejectedBatchEntry(seq, p.Instruction, p.Err.Error()))
+			continue
+		}
+		order, err := newPaymentOrder(ctx, uc.routingEngine, uc.fraudClient, dto.InitiatePaymentRequest{
+			TenantID:              req.TenantID,
+			SourceAccountID:       req.SourceAccountID,
+			Amount:                p.Instruction.Amount,
+			Currency:              p.Instruction.Currency,
+			RoutingNumber:         p.Instruction.RoutingNumber,
+			ExternalAccountNumber: p.Instruction.AccountNumber,
+			Reference:             p.Instruction.Reference,
+			Description:           p.Instruction.Description,
+		})
+		if errors.Is(err, errFraudAssessment) {
+			return dto.PaymentBatchResponse{}, err
+		}
+		if err != nil {
+			entries = append(entries, model.NewRejectedBatchEntry(seq, p.Instruction, err.Error()))
+			continue
+		}
+		entries = append(entries, model.NewAcceptedBatchEntry(seq, p.Instruction, order))
+		orders = append(orders, order)
+	}
+
+	sum := sha256.Sum256(req.Content)
+	batch, err := model.NewPaymentBatch(
+		req.TenantID,
+		req.SourceAccountID,
+		format,
+		req.FileName,
+		hex.EncodeToString(sum[:]),
+		entries,
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return dto.PaymentBatchResponse{}, fmt.Errorf("failed to create payment batch: %w", err)
+	}
+	if err := uc.batchRepo.Save(ctx, batch, orders); err != nil {
+		return dto.PaymentBatchResponse{}, fmt.Errorf("failed to save payment batch: %w", err)
+	}
+
+	// The orders and their outbox events are already stored, so a failed
+	// publish is not an import failure.
+	for _, order := range orders {
+		if events := order.DomainEvents(); len(events) > 0 {
+			if err := uc.publisher.Publish(ctx, TopicPaymentOrders, events...); err != nil {
+				uc.logger.Warn("failed to publish batch payment events", "batch_id", batch.ID(), "payment_id", order.ID(), "error", err)
+			}
+		}
+	}
+	return toPaymentBatchResponse(batch), nil
+}
+
+func toPaymentBatchResponse(b model.PaymentBatch) dto.PaymentBatchResponse {
+	progress := b.Progress()
+	failed := b.FailedEntries()
+	errs := make([]dto.BatchEntryErrorResponse, 0, len(failed))
+	for _, e := range failed {
+		instr := e.Instruction()
+		errs = append(errs, dto.BatchEntryErrorResponse{
+			Sequence:        e.Sequence(),
+			Reference:       instr.Reference,
+			BeneficiaryName: instr.BeneficiaryName,
+			Amount:          instr.Amount,
+			Currency:        instr.Currency,
+			Status:          e.Status(),
+			Error:           e.Error(),
+			PaymentID:       e.PaymentID(),
+		})
+	}
+	return dto.PaymentBatchResponse{
+		ID:              b.ID(),
+		TenantID:        b.TenantID(),
+		SourceAccountID: b.SourceAccountID(),
+		Format:          b.Format().String(),
+		FileName:        b.FileName(),
+		Status:          b.Status().String(),
+		TotalEntries:    progress.Total,
+		RejectedEntries: progress.Rejected,
+		PendingEntries:  progress.Pending,
+		SettledEntries:  progress.Settled,
+		FailedEntries:   progress.Failed,
+		Errors:          errs,
+		CreatedAt:       b.CreatedAt(),
+	}
+}
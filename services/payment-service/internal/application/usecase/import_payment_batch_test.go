@@ -0,0 +1,171 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/payment-service/internal/application/dto"
+	"github.com/bibbank/bib/services/payment-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/service"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+type mockPaymentBatchRepository struct {
+	batches map[uuid.UUID]model.PaymentBatch
+	hashes  map[string]bool
+	orders  []model.PaymentOrder
+}
+
+func newMockPaymentBatchRepository() *mockPaymentBatchRepository {
+	return &mockPaymentBatchRepository{batches: make(map[uuid.UUID]model.PaymentBatch), hashes: make(map[string]bool)}
+}
+
+func (m *mockPaymentBatchRepository) Save(_ context.Context, batch model.PaymentBatch, orders []model.PaymentOrder) error {
+	if m.hashes[batch.FileHash()] {
+		return port.ErrDuplicateBatch
+	}
+	m.hashes[batch.FileHash()] = true
+	m.batches[batch.ID()] = batch
+	m.orders = append(m.orders, orders...)
+	return nil
+}
+
+func (m *mockPaymentBatchRepository) FindByID(_ context.Context, id uuid.UUID) (model.PaymentBatch, error) {
+	if b, ok := m.batches[id]; ok {
+		return b, nil
+	}
+	return model.PaymentBatch{}, fmt.Errorf("payment batch %s not found", id)
+}
+
+type stubBatchFileParser struct {
+	err     error
+	entries []port.BatchFileEntry
+}
+
+func (s stubBatchFileParser) Parse(valueobject.BatchFileFormat, []byte) ([]port.BatchFileEntry, error) {
+	return s.entries, s.err
+}
+
+func batchFileEntry(amount int64, routing, account, ref string) port.BatchFileEntry {
+	return port.BatchFileEntry{Instruction: model.BatchInstruction{
+		Amount:        decimal.NewFromInt(amount),
+		Currency:      "USD",
+		RoutingNumber: routing,
+		AccountNumber: account,
+		Reference:     ref,
+	}}
+}
+
+func importRequest(content string) dto.ImportPaymentBatchRequest {
+	return dto.ImportPaymentBatchRequest{
+		TenantID:        uuid.New(),
+		SourceAccountID: uuid.New(),
+		Format:          "NACHA",
+		FileName:        "payroll.ach",
+		Content:         []byte(content),
+	}
+}
+
+func TestImportPaymentBatch_Execute(t *testing.T) {
+	debit := batchFileEntry(10, "021000021", "555", "3")
+	debit.Err = errors.New("transaction code 27 is not a credit")
+	parser := stubBatchFileParser{entries: []port.BatchFileEntry{
+		batchFileEntry(100, "021000021", "12345678", "1"),
+		batchFileEntry(200, "12345", "12345678", "2"),
+		debit,
+		batchFileEntry(300, "011000015", "98765", "4"),
+	}}
+
+	t.Run("imports usable entries and rejects the rest", func(t *testing.T) {
+		repo := newMockPaymentBatchRepository()
+		publisher := &mockEventPublisher{}
+		uc := usecase.NewImportPaymentBatch(repo, parser, publisher, service.NewRoutingEngine(), nil, slog.Default())
+
+		req := importRequest("file-1")
+		resp, err := uc.Execute(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, "PROCESSING", resp.Status)
+		assert.Equal(t, 4, resp.TotalEntries)
+		assert.Equal(t, 2, resp.PendingEntries)
+		assert.Equal(t, 2, resp.RejectedEntries)
+
+		require.Len(t, resp.Errors, 2)
+		assert.Equal(t, 2, resp.Errors[0].Sequence)
+		assert.Contains(t, resp.Errors[0].Error, "invalid routing info")
+		assert.Equal(t, 3, resp.Errors[1].Sequence)
+		assert.Equal(t, "REJECTED", resp.Errors[1].Status)
+
+		require.Len(t, repo.orders, 2)
+		for _, order := range repo.orders {
+			assert.Equal(t, req.SourceAccountID, order.SourceAccountID())
+			assert.Equal(t, valueobject.RailACH, order.Rail())
+		}
+		assert.Len(t, publisher.publishedEvents, 2)
+
+		// Importing the same file again is refused.
+		_, err = uc.Execute(context.Background(), req)
+		assert.ErrorIs(t, err, port.ErrDuplicateBatch)
+
+		got, err := usecase.NewGetPaymentBatch(repo).Execute(context.Background(), dto.GetPaymentBatchRequest{BatchID: resp.ID})
+		require.NoError(t, err)
+		assert.Equal(t, resp.ID, got.ID)
+		assert.Equal(t, 2, got.RejectedEntries)
+	})
+
+	t.Run("rejects entries the fraud assessment rejects", func(t *testing.T) {
+		repo := newMockPaymentBatchRepository()
+		fraud := &mockFraudClient{
+			assessFunc: func(_ context.Context, _, _ uuid.UUID, amount decimal.Decimal, _ string) (bool, error) {
+				return amount.LessThan(decimal.NewFromInt(300)), nil
+			},
+		}
+		uc := usecase.NewImportPaymentBatch(repo, parser, &mockEventPublisher{}, service.NewRoutingEngine(), fraud, slog.Default())
+
+		resp, err := uc.Execute(context.Background(), importRequest("file-2"))
+		require.NoError(t, err)
+		assert.Equal(t, 1, resp.PendingEntries)
+		require.Len(t, resp.Errors, 3)
+		assert.Equal(t, usecase.ErrPaymentRejected.Error(), resp.Errors[2].Error)
+	})
+
+	t.Run("fails when the fraud assessment is unavailable", func(t *testing.T) {
+		repo := newMockPaymentBatchRepository()
+		fraud := &mockFraudClient{
+			assessFunc: func(context.Context, uuid.UUID, uuid.UUID, decimal.Decimal, string) (bool, error) {
+				return false, errors.New("connection refused")
+			},
+		}
+		uc := usecase.NewImportPaymentBatch(repo, parser, &mockEventPublisher{}, service.NewRoutingEngine(), fraud, slog.Default())
+
+		_, err := uc.Execute(context.Background(), importRequest("file-3"))
+		require.Error(t, err)
+		assert.Empty(t, repo.batches)
+	})
+
+	t.Run("invalid files", func(t *testing.T) {
+		repo := newMockPaymentBatchRepository()
+		invalid := stubBatchFileParser{err: fmt.Errorf("%w: missing file control record", port.ErrInvalidBatchFile)}
+		uc := usecase.NewImportPaymentBatch(repo, invalid, &mockEventPublisher{}, service.NewRoutingEngine(), nil, slog.Default())
+
+		_, err := uc.Execute(context.Background(), importRequest("truncated"))
+		assert.ErrorIs(t, err, port.ErrInvalidBatchFile)
+		_, err = uc.Execute(context.Background(), importRequest(""))
+		assert.ErrorIs(t, err, port.ErrInvalidBatchFile)
+
+		req := importRequest("file")
+		req.Format = "CSV"
+		_, err = uc.Execute(context.Background(), req)
+		assert.Error(t, err)
+		assert.Empty(t, repo.batches)
+	})
+}
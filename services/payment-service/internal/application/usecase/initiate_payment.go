@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -15,6 +16,13 @@ import (
 
 const TopicPaymentOrders = "bib.payment.orders"
 
+// ErrPaymentRejected is returned when the fraud assessment rejects a payment.
+var ErrPaymentRejected = errors.New("payment rejected by fraud assessment")
+
+// errFraudAssessment wraps failures to reach the fraud assessment, as
+// opposed to rejections by it.
+var errFraudAssessment = errors.New("fraud assessment failed")
+
 // InitiatePayment handles the creation of new payment orders.
 type InitiatePayment struct {
 	paymentRepo   port.PaymentOrderRepository
@@ -38,28 +46,66 @@ func NewInitiatePayment(
 }
 
 func (uc *InitiatePayment) Execute(ctx context.Context, req dto.InitiatePaymentRequest) (dto.InitiatePaymentResponse, error) {
+	order, err := newPaymentOrder(ctx, uc.routingEngine, uc.fraudClient, req)
+	if err != nil {
+		return dto.InitiatePaymentResponse{}, err
+	}
+
+	// Persist the order.
+	if err := uc.paymentRepo.Save(ctx, order); err != nil {
+		return dto.InitiatePaymentResponse{}, fmt.Errorf("failed to save payment order: %w", err)
+	}
+
+	// Publish domain events.
+	if events := order.DomainEvents(); len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, TopicPaymentOrders, events...); err != nil {
+			return dto.InitiatePaymentResponse{}, fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+
+	return dto.InitiatePaymentResponse{
+		ID:        order.ID(),
+		Status:    order.Status().String(),
+		Rail:      order.Rail().String(),
+		CreatedAt: order.CreatedAt(),
+	}, nil
+}
+
+// newPaymentOrder assesses the fraud risk of a payment, when fraudClient is
+// set, selects its rail and creates its payment order.
+func newPaymentOrder(
+	ctx context.Context,
+	routingEngine *service.RoutingEngine,
+	fraudClient port.FraudClient,
+	req dto.InitiatePaymentRequest,
+) (model.PaymentOrder, error) {
 	// Validate routing info for external payments.
 	routingInfo, err := valueobject.NewRoutingInfo(req.RoutingNumber, req.ExternalAccountNumber)
 	if err != nil {
-		return dto.InitiatePaymentResponse{}, fmt.Errorf("invalid routing info: %w", err)
+		return model.PaymentOrder{}, fmt.Errorf("invalid routing info: %w", err)
 	}
 
 	// Determine if the payment is internal.
 	isInternal := req.DestinationAccountID != uuid.Nil
 
 	// Optionally assess fraud risk.
-	if uc.fraudClient != nil {
-		approved, assessErr := uc.fraudClient.AssessTransaction(ctx, req.TenantID, req.SourceAccountID, req.Amount, req.Currency)
+	if fraudClient != nil {
+		approved, assessErr := fraudClient.AssessTransaction(ctx, req.TenantID, req.SourceAccountID, req.Amount, req.Currency)
 		if assessErr != nil {
-			return dto.InitiatePaymentResponse{}, fmt.Errorf("fraud assessment failed: %w", assessErr)
+			return model.PaymentOrder{}, fmt.Errorf("%w: %w", errFraudAssessment, assessErr)
 		}
 		if !approved {
-			return dto.InitiatePaymentResponse{}, fmt.Errorf("payment rejected by fraud assessment")
+			return model.PaymentOrder{}, ErrPaymentRejected
 		}
 	}
 
-	// Select optimal payment rail via the routing engine.
-	rail := uc.routingEngine.SelectRail(req.Amount, req.Currency, isInternal, req.DestinationCountry)
+	// Select optimal payment rail via the routing engine. Without a
+	// destination country the beneficiary's IBAN or BIC gives it.
+	destinationCountry := req.DestinationCountry
+	if destinationCountry == "" && !isInternal {
+		destinationCountry = routingInfo.Country()
+	}
+	rail := routingEngine.SelectRailFor(req.Amount, req.Currency, isInternal, destinationCountry, routingInfo)
 
 	// Create the payment order aggregate.
 	order, err := model.NewPaymentOrder(
@@ -72,27 +118,10 @@ func (uc *InitiatePayment) Execute(ctx context.Context, req dto.InitiatePaymentR
 		routingInfo,
 		req.Reference,
 		req.Description,
+		destinationCountry,
 	)
 	if err != nil {
-		return dto.InitiatePaymentResponse{}, fmt.Errorf("failed to create payment order: %w", err)
-	}
-
-	// Persist the order.
-	if err := uc.paymentRepo.Save(ctx, order); err != nil {
-		return dto.InitiatePaymentResponse{}, fmt.Errorf("failed to save payment order: %w", err)
+		return model.PaymentOrder{}, fmt.Errorf("failed to create payment order: %w", err)
 	}
-
-	// Publish domain events.
-	if events := order.DomainEvents(); len(events) > 0 {
-		if err := uc.publisher.Publish(ctx, TopicPaymentOrders, events...); err != nil {
-			return dto.InitiatePaymentResponse{}, fmt.Errorf("failed to publish events: %w", err)
-		}
-	}
-
-	return dto.InitiatePaymentResponse{
-		ID:        order.ID(),
-		Status:    order.Status().String(),
-		Rail:      order.Rail().String(),
-		CreatedAt: order.CreatedAt(),
-	}, nil
+	return order, nil
 }
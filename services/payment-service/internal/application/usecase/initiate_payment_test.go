@@ -161,6 +161,28 @@ func TestInitiatePayment_EURRoutesSEPA(t *testing.T) {
 	assert.Equal(t, "SEPA", resp.Rail)
 }
 
+func TestInitiatePayment_BICRoutesSWIFT(t *testing.T) {
+	repo := &mockPaymentOrderRepository{}
+	publisher := &mockEventPublisher{}
+	engine := service.NewRoutingEngine()
+
+	uc := usecase.NewInitiatePayment(repo, publisher, engine, nil)
+
+	req := validInitiateRequest()
+	req.RoutingNumber = "NWBKGB2L"
+	req.ExternalAccountNumber = "GB82 WEST 1234 5698 7654 32"
+	req.DestinationCountry = ""
+
+	resp, err := uc.Execute(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "SWIFT", resp.Rail)
+	require.Len(t, repo.savedOrders, 1)
+	saved := repo.savedOrders[0]
+	assert.Equal(t, "GB", saved.DestinationCountry()) // from the IBAN
+	assert.Equal(t, "GB82WEST12345698765432", saved.RoutingInfo().IBAN().String())
+}
+
 func TestInitiatePayment_InvalidRoutingInfo(t *testing.T) {
 	repo := &mockPaymentOrderRepository{}
 	publisher := &mockEventPublisher{}
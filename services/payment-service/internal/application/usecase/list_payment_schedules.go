@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/payment-service/internal/application/dto"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+)
+
+// ListPaymentSchedules handles listing a tenant's payment schedules with
+// pagination.
+type ListPaymentSchedules struct {
+	scheduleRepo port.PaymentScheduleRepository
+}
+
+func NewListPaymentSchedules(scheduleRepo port.PaymentScheduleRepository) *ListPaymentSchedules {
+	return &ListPaymentSchedules{scheduleRepo: scheduleRepo}
+}
+
+func (uc *ListPaymentSchedules) Execute(ctx context.Context, req dto.ListPaymentSchedulesRequest) (dto.ListPaymentSchedulesResponse, error) {
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	schedules, total, err := uc.scheduleRepo.ListByTenant(ctx, req.TenantID, pageSize, req.Offset)
+	if err != nil {
+		return dto.ListPaymentSchedulesResponse{}, fmt.Errorf("failed to list payment schedules: %w", err)
+	}
+
+	var responses []dto.PaymentScheduleResponse
+	for _, schedule := range schedules {
+		responses = append(responses, toPaymentScheduleResponse(schedule))
+	}
+
+	return dto.ListPaymentSchedulesResponse{
+		Schedules:  responses,
+		TotalCount: total,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/bibbank/bib/pkg/enrichment"
 	"github.com/bibbank/bib/services/payment-service/internal/application/dto"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
@@ -14,10 +15,13 @@ import (
 // ListPayments handles listing payment orders with pagination.
 type ListPayments struct {
 	paymentRepo port.PaymentOrderRepository
+	enricher    *enrichment.Enricher
 }
 
-func NewListPayments(paymentRepo port.PaymentOrderRepository) *ListPayments {
-	return &ListPayments{paymentRepo: paymentRepo}
+// NewListPayments creates a ListPayments describing counterparties with
+// enricher.
+func NewListPayments(paymentRepo port.PaymentOrderRepository, enricher *enrichment.Enricher) *ListPayments {
+	return &ListPayments{paymentRepo: paymentRepo, enricher: enricher}
 }
 
 func (uc *ListPayments) Execute(ctx context.Context, req dto.ListPaymentsRequest) (dto.ListPaymentsResponse, error) {
@@ -46,7 +50,7 @@ func (uc *ListPayments) Execute(ctx context.Context, req dto.ListPaymentsRequest
 
 	var responses []dto.PaymentOrderResponse
 	for _, order := range orders {
-		responses = append(responses, toPaymentOrderResponse(order))
+		responses = append(responses, toPaymentOrderResponse(order, uc.enricher))
 	}
 
 	return dto.ListPaymentsResponse{
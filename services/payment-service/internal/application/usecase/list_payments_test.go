@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/bibbank/bib/pkg/enrichment"
 	"github.com/bibbank/bib/services/payment-service/internal/application/dto"
 	"github.com/bibbank/bib/services/payment-service/internal/application/usecase"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
@@ -53,7 +54,7 @@ func TestListPayments_Execute(t *testing.T) {
 			},
 		}
 
-		uc := usecase.NewListPayments(repo)
+		uc := usecase.NewListPayments(repo, enrichment.Default)
 
 		req := dto.ListPaymentsRequest{TenantID: tenantID, PageSize: 20}
 		resp, err := uc.Execute(context.Background(), req)
@@ -74,7 +75,7 @@ func TestListPayments_Execute(t *testing.T) {
 			},
 		}
 
-		uc := usecase.NewListPayments(repo)
+		uc := usecase.NewListPayments(repo, enrichment.Default)
 
 		req := dto.ListPaymentsRequest{
 			TenantID:  uuid.New(),
@@ -98,7 +99,7 @@ func TestListPayments_Execute(t *testing.T) {
 			},
 		}
 
-		uc := usecase.NewListPayments(repo)
+		uc := usecase.NewListPayments(repo, enrichment.Default)
 
 		req := dto.ListPaymentsRequest{TenantID: tenantID}
 		_, err := uc.Execute(context.Background(), req)
@@ -116,7 +117,7 @@ func TestListPayments_Execute(t *testing.T) {
 			},
 		}
 
-		uc := usecase.NewListPayments(repo)
+		uc := usecase.NewListPayments(repo, enrichment.Default)
 
 		req := dto.ListPaymentsRequest{TenantID: tenantID, PageSize: 500}
 		_, err := uc.Execute(context.Background(), req)
@@ -131,7 +132,7 @@ func TestListPayments_Execute(t *testing.T) {
 			},
 		}
 
-		uc := usecase.NewListPayments(repo)
+		uc := usecase.NewListPayments(repo, enrichment.Default)
 
 		req := dto.ListPaymentsRequest{TenantID: uuid.New()}
 		_, err := uc.Execute(context.Background(), req)
@@ -2,93 +2,437 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/bibbank/bib/pkg/saga"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
 )
 
-// ProcessPayment handles the processing of payment orders.
-// It is typically triggered by a Kafka consumer after a PaymentInitiated event.
+// Saga types of the payment saga and the reversal saga in the saga store.
+const (
+	PaymentSagaType         = "payment"
+	PaymentReversalSagaType = "payment_reversal"
+)
+
+// Payment saga steps.
+const (
+	SagaStepFraudCheck   = "FRAUD_CHECK"
+	SagaStepReserveFunds = "RESERVE_FUNDS"
+	SagaStepSubmitToRail = "SUBMIT_TO_RAIL"
+	SagaStepPostToLedger = "POST_TO_LEDGER"
+	SagaStepComplete     = "COMPLETE"
+)
+
+// Payment reversal saga steps.
+const (
+	SagaStepPostReversal = "POST_REVERSAL"
+	SagaStepMarkReversed = "MARK_REVERSED"
+)
+
+// Keys of the values the payment saga records between steps.
+const (
+	sagaDataSourceLedgerAccount = "source_ledger_account"
+	sagaDataHoldEntryID         = "hold_entry_id"
+	sagaDataClearingEntryID     = "clearing_entry_id"
+	sagaDataReversalEntryID     = "reversal_entry_id"
+	sagaDataReversalReason      = "reversal_reason"
+	// sagaDataPostingDatePrefix prefixes the key of the date each ledger leg
+	// is posted on.
+	sagaDataPostingDatePrefix = "posting_date_"
+)
+
+var (
+	// ErrPaymentSagaNotFound is returned when reposting a payment that has no
+	// saga, because it was never processed.
+	ErrPaymentSagaNotFound = errors.New("payment saga not found")
+	// ErrPaymentNotFound is returned when reversing a payment the tenant does
+	// not have.
+	ErrPaymentNotFound = errors.New("payment not found")
+	// ErrPaymentNotSettled is returned when reversing a payment that has not
+	// settled.
+	ErrPaymentNotSettled = errors.New("only settled payments can be reversed")
+)
+
+// PaymentSagaConfig configures the payment saga.
+type PaymentSagaConfig struct {
+	// SuspenseAccount is the ledger account funds are held in while the
+	// payment is in flight.
+	SuspenseAccount string
+	// ClearingAccount is the ledger account held funds move to once the rail
+	// has accepted the payment.
+	ClearingAccount string
+	// StepTimeout bounds each step and compensation.
+	StepTimeout time.Duration
+	// MaxAttempts is how often a step after rail submission, or a
+	// compensation, is retried before the saga needs manual intervention.
+	MaxAttempts int
+}
+
+// ProcessPayment moves a payment order's funds through a persisted saga:
+// fraud check, hold the funds in suspense, submit to the rail, move the held
+// funds to rail clearing, and settle the order. Rail submission is the pivot.
+// A failure before it releases any hold and fails the order; steps after it
+// are retried until they succeed. It is typically triggered by a Kafka
+// consumer after a PaymentInitiated event.
+//
+// Every posting carries a reference unique to the order and leg. The ledger
+// keeps one entry per reference, returning it to any later posting under
+// that reference, so the order's journal entries are posted exactly once
+// however often a step is retried, Repost and RepostFailed included.
+// Settled payments are undone by Reverse, which runs its own saga posting
+// the reversing entry.
 type ProcessPayment struct {
-	paymentRepo port.PaymentOrderRepository
-	railAdapter port.RailAdapter
-	publisher   port.EventPublisher
+	paymentRepo   port.PaymentOrderRepository
+	railAdapter   port.RailAdapter
+	publisher     port.EventPublisher
+	fraudClient   port.FraudClient
+	accountClient port.AccountClient
+	ledgerClient  port.LedgerClient
+	sagaStore     saga.Store
+	orchestrator  *saga.Orchestrator
+	config        PaymentSagaConfig
 }
 
+// NewProcessPayment creates a new ProcessPayment. fraudClient may be nil, in
+// which case the fraud check is skipped.
 func NewProcessPayment(
 	paymentRepo port.PaymentOrderRepository,
 	railAdapter port.RailAdapter,
 	publisher port.EventPublisher,
+	fraudClient port.FraudClient,
+	accountClient port.AccountClient,
+	ledgerClient port.LedgerClient,
+	sagaStore saga.Store,
+	config PaymentSagaConfig,
+	logger *slog.Logger,
 ) *ProcessPayment {
-	return &ProcessPayment{
-		paymentRepo: paymentRepo,
-		railAdapter: railAdapter,
-		publisher:   publisher,
+	uc := &ProcessPayment{
+		paymentRepo:   paymentRepo,
+		railAdapter:   railAdapter,
+		publisher:     publisher,
+		fraudClient:   fraudClient,
+		accountClient: accountClient,
+		ledgerClient:  ledgerClient,
+		sagaStore:     sagaStore,
+		orchestrator:  saga.NewOrchestrator(sagaStore, logger),
+		config:        config,
 	}
+	uc.orchestrator.Register(uc.sagaDefinition())
+	uc.orchestrator.Register(uc.reversalSagaDefinition())
+	return uc
 }
 
+// Execute marks the order as processing and runs its payment saga. Running it
+// again for the same order resumes the existing saga. A payment that is
+// declined or rejected by the rail fails the order without returning an
+// error; an error means the saga is waiting to be retried by RecoverStalled.
 func (uc *ProcessPayment) Execute(ctx context.Context, paymentID uuid.UUID) error {
-	// Fetch the order.
 	order, err := uc.paymentRepo.FindByID(ctx, paymentID)
 	if err != nil {
 		return fmt.Errorf("failed to find payment order %s: %w", paymentID, err)
 	}
 
-	now := time.Now().UTC()
+	if order.Status() == valueobject.PaymentStatusInitiated {
+		processing, markErr := order.MarkProcessing(time.Now().UTC())
+		if markErr != nil {
+			return fmt.Errorf("failed to mark processing: %w", markErr)
+		}
+		if saveErr := uc.paymentRepo.Save(ctx, processing); saveErr != nil {
+			return fmt.Errorf("failed to save processing state: %w", saveErr)
+		}
+	}
+
+	if _, err := uc.orchestrator.Start(ctx, PaymentSagaType, paymentID.String(), nil); err != nil {
+		return fmt.Errorf("payment saga for %s: %w", paymentID, err)
+	}
+	return nil
+}
 
-	// Transition to PROCESSING.
-	processing, err := order.MarkProcessing(now)
+// Reverse undoes a settled payment of the tenant, such as one the rail
+// returned: a reversal saga moves the amount from rail clearing back to the
+// source account's ledger account, then marks the order reversed. Reversing
+// a payment again resumes its existing reversal, so a reversal interrupted
+// part way is completed rather than posted twice. Other than
+// ErrPaymentNotFound and ErrPaymentNotSettled, an error means the order
+// could not be loaded or the reversal is waiting to be retried by
+// RecoverStalled.
+func (uc *ProcessPayment) Reverse(ctx context.Context, tenantID, paymentID uuid.UUID, reason string) error {
+	order, err := uc.paymentRepo.FindByID(ctx, paymentID)
 	if err != nil {
-		return fmt.Errorf("failed to mark processing: %w", err)
+		return fmt.Errorf("failed to find payment order %s: %w", paymentID, err)
+	}
+	if order.TenantID() != tenantID {
+		return fmt.Errorf("%w: %s", ErrPaymentNotFound, paymentID)
+	}
+	if order.Status() != valueobject.PaymentStatusSettled && order.Status() != valueobject.PaymentStatusReversed {
+		return fmt.Errorf("%w: %s is %s", ErrPaymentNotSettled, paymentID, order.Status().String())
 	}
 
-	// Persist the PROCESSING state.
-	if saveErr := uc.paymentRepo.Save(ctx, processing); saveErr != nil {
-		return fmt.Errorf("failed to save processing state: %w", saveErr)
+	data := map[string]string{sagaDataReversalReason: reason}
+	if _, err := uc.orchestrator.Start(ctx, PaymentReversalSagaType, paymentID.String(), data); err != nil {
+		return fmt.Errorf("payment reversal saga for %s: %w", paymentID, err)
 	}
+	return nil
+}
 
-	// Submit to the rail adapter.
-	submitErr := uc.railAdapter.Submit(ctx, processing)
+// RecoverStalled resumes up to limit payment and reversal sagas that have
+// not progressed for staleAfter, and returns how many of them finished.
+func (uc *ProcessPayment) RecoverStalled(ctx context.Context, staleAfter time.Duration, limit int) (int, error) {
+	return uc.orchestrator.RecoverStalled(ctx, staleAfter, limit)
+}
 
-	now = time.Now().UTC()
-	if submitErr != nil {
-		// Rail submission failed; mark the order as FAILED.
-		failed, failErr := processing.Fail(submitErr.Error(), now)
-		if failErr != nil {
-			return fmt.Errorf("failed to mark failure after submit error: %w (submit error: %v)", failErr, submitErr)
-		}
+// Repost retries the saga of a payment that ran out of attempts, once
+// whatever made it fail has been fixed. It returns an error if the saga fails
+// again or does not finish.
+func (uc *ProcessPayment) Repost(ctx context.Context, paymentID uuid.UUID) error {
+	state, err := uc.sagaStore.FindByCorrelation(ctx, PaymentSagaType, paymentID.String())
+	if errors.Is(err, saga.ErrNotFound) {
+		return fmt.Errorf("%w: %s", ErrPaymentSagaNotFound, paymentID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find payment saga for %s: %w", paymentID, err)
+	}
+	if _, err := uc.orchestrator.Retry(ctx, state.ID); err != nil {
+		return fmt.Errorf("payment saga for %s: %w", paymentID, err)
+	}
+	return nil
+}
 
-		if saveErr := uc.paymentRepo.Save(ctx, failed); saveErr != nil {
-			return fmt.Errorf("failed to save failed state: %w", saveErr)
-		}
+// RepostFailed retries up to limit payment sagas that ran out of attempts,
+// oldest first, and returns how many of them finished.
+func (uc *ProcessPayment) RepostFailed(ctx context.Context, limit int) (int, error) {
+	return uc.orchestrator.RetryFailed(ctx, limit)
+}
 
-		if events := failed.DomainEvents(); len(events) > 0 {
-			if pubErr := uc.publisher.Publish(ctx, TopicPaymentOrders, events...); pubErr != nil {
-				return fmt.Errorf("failed to publish failure events: %w", pubErr)
-			}
-		}
+func (uc *ProcessPayment) sagaDefinition() saga.Definition {
+	timeout := uc.config.StepTimeout
+	return saga.Definition{
+		Type: PaymentSagaType,
+		Steps: []saga.Step{
+			{Name: SagaStepFraudCheck, Action: uc.checkFraud, Timeout: timeout},
+			{Name: SagaStepReserveFunds, Action: uc.reserveFunds, Compensate: uc.releaseFunds, Timeout: timeout},
+			{Name: SagaStepSubmitToRail, Action: uc.submitToRail, Timeout: timeout},
+			{Name: SagaStepPostToLedger, Action: uc.postToClearing, Timeout: timeout, Retriable: true},
+			{Name: SagaStepComplete, Action: uc.settle, Timeout: timeout, Retriable: true},
+		},
+		OnCompensated: uc.fail,
+		MaxAttempts:   uc.config.MaxAttempts,
+	}
+}
+
+// reversalSagaDefinition returns the reversal saga. The payment has already
+// left through the rail, so there is nothing to compensate: both steps are
+// retried until they succeed.
+func (uc *ProcessPayment) reversalSagaDefinition() saga.Definition {
+	timeout := uc.config.StepTimeout
+	return saga.Definition{
+		Type: PaymentReversalSagaType,
+		Steps: []saga.Step{
+			{Name: SagaStepPostReversal, Action: uc.postReversal, Timeout: timeout, Retriable: true},
+			{Name: SagaStepMarkReversed, Action: uc.markReversed, Timeout: timeout, Retriable: true},
+		},
+		MaxAttempts: uc.config.MaxAttempts,
+	}
+}
+
+func (uc *ProcessPayment) checkFraud(ctx context.Context, state *saga.State) error {
+	if uc.fraudClient == nil {
+		return nil
+	}
+	order, err := uc.sagaOrder(ctx, state)
+	if err != nil {
+		return err
+	}
+	approved, err := uc.fraudClient.AssessTransaction(ctx, order.TenantID(), order.SourceAccountID(), order.Amount(), order.Currency())
+	if err != nil {
+		return fmt.Errorf("fraud check error: %w", err)
+	}
+	if !approved {
+		return errors.New("transaction declined by fraud check")
+	}
+	return nil
+}
+
+// reserveFunds holds the payment amount by moving it from the source
+// account's ledger account into suspense.
+func (uc *ProcessPayment) reserveFunds(ctx context.Context, state *saga.State) error {
+	order, err := uc.sagaOrder(ctx, state)
+	if err != nil {
+		return err
+	}
+	source, err := uc.accountClient.LedgerAccountCode(ctx, order.TenantID(), order.SourceAccountID())
+	if err != nil {
+		return fmt.Errorf("failed to resolve source ledger account: %w", err)
+	}
+	state.Data[sagaDataSourceLedgerAccount] = source
+
+	entryID, err := uc.ledgerClient.PostEntry(ctx, uc.ledgerEntry(state, order, source, uc.config.SuspenseAccount, "hold", "Payment hold"))
+	if err != nil {
+		return fmt.Errorf("failed to hold funds: %w", err)
+	}
+	state.Data[sagaDataHoldEntryID] = entryID
+	return nil
+}
 
+// releaseFunds reverses the hold. A hold whose posting timed out before it
+// was recorded is left to ledger reconciliation against its reference.
+func (uc *ProcessPayment) releaseFunds(ctx context.Context, state *saga.State) error {
+	if state.Data[sagaDataHoldEntryID] == "" {
 		return nil
 	}
+	order, err := uc.sagaOrder(ctx, state)
+	if err != nil {
+		return err
+	}
+	source := state.Data[sagaDataSourceLedgerAccount]
+	if _, err := uc.ledgerClient.PostEntry(ctx, uc.ledgerEntry(state, order, uc.config.SuspenseAccount, source, "release", "Payment hold released")); err != nil {
+		return fmt.Errorf("failed to release funds: %w", err)
+	}
+	return nil
+}
+
+func (uc *ProcessPayment) submitToRail(ctx context.Context, state *saga.State) error {
+	order, err := uc.sagaOrder(ctx, state)
+	if err != nil {
+		return err
+	}
+	if err := uc.railAdapter.Submit(ctx, order); err != nil {
+		return fmt.Errorf("rail submission error: %w", err)
+	}
+	return nil
+}
+
+// postToClearing moves the held funds from suspense to rail clearing.
+func (uc *ProcessPayment) postToClearing(ctx context.Context, state *saga.State) error {
+	order, err := uc.sagaOrder(ctx, state)
+	if err != nil {
+		return err
+	}
+	entryID, err := uc.ledgerClient.PostEntry(ctx, uc.ledgerEntry(state, order, uc.config.SuspenseAccount, uc.config.ClearingAccount, "clearing", "Payment sent to rail"))
+	if err != nil {
+		return fmt.Errorf("failed to post to clearing: %w", err)
+	}
+	state.Data[sagaDataClearingEntryID] = entryID
+	return nil
+}
 
-	// Rail submission succeeded; mark the order as SETTLED.
-	settled, err := processing.Settle(now)
+func (uc *ProcessPayment) settle(ctx context.Context, state *saga.State) error {
+	order, err := uc.sagaOrder(ctx, state)
+	if err != nil {
+		return err
+	}
+	if order.Status() == valueobject.PaymentStatusSettled {
+		return nil
+	}
+	settled, err := order.Settle(time.Now().UTC())
 	if err != nil {
 		return fmt.Errorf("failed to mark settled: %w", err)
 	}
+	return uc.saveAndPublish(ctx, settled)
+}
+
+// postReversal moves the payment's amount from rail clearing back to the
+// source account's ledger account.
+func (uc *ProcessPayment) postReversal(ctx context.Context, state *saga.State) error {
+	order, err := uc.sagaOrder(ctx, state)
+	if err != nil {
+		return err
+	}
+	source, err := uc.accountClient.LedgerAccountCode(ctx, order.TenantID(), order.SourceAccountID())
+	if err != nil {
+		return fmt.Errorf("failed to resolve source ledger account: %w", err)
+	}
+	entryID, err := uc.ledgerClient.PostEntry(ctx, uc.ledgerEntry(state, order, uc.config.ClearingAccount, source, "reversal", "Payment reversed"))
+	if err != nil {
+		return fmt.Errorf("failed to post reversal: %w", err)
+	}
+	state.Data[sagaDataReversalEntryID] = entryID
+	return nil
+}
 
-	if saveErr := uc.paymentRepo.Save(ctx, settled); saveErr != nil {
-		return fmt.Errorf("failed to save settled state: %w", saveErr)
+func (uc *ProcessPayment) markReversed(ctx context.Context, state *saga.State) error {
+	order, err := uc.sagaOrder(ctx, state)
+	if err != nil {
+		return err
+	}
+	if order.Status() == valueobject.PaymentStatusReversed {
+		return nil
+	}
+	reversed, err := order.Reverse(state.Data[sagaDataReversalReason], time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to mark reversed: %w", err)
 	}
+	return uc.saveAndPublish(ctx, reversed)
+}
 
-	if events := settled.DomainEvents(); len(events) > 0 {
-		if pubErr := uc.publisher.Publish(ctx, TopicPaymentOrders, events...); pubErr != nil {
-			return fmt.Errorf("failed to publish settlement events: %w", pubErr)
-		}
+// fail marks the order as failed once the saga has been compensated.
+func (uc *ProcessPayment) fail(ctx context.Context, state *saga.State) error {
+	order, err := uc.sagaOrder(ctx, state)
+	if err != nil {
+		return err
+	}
+	if order.Status() == valueobject.PaymentStatusFailed {
+		return nil
+	}
+	failed, err := order.Fail(state.FailureReason, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to mark failure: %w", err)
 	}
+	return uc.saveAndPublish(ctx, failed)
+}
 
+func (uc *ProcessPayment) saveAndPublish(ctx context.Context, order model.PaymentOrder) error {
+	if err := uc.paymentRepo.Save(ctx, order); err != nil {
+		return fmt.Errorf("failed to save %s state: %w", order.Status().String(), err)
+	}
+	if events := order.DomainEvents(); len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, TopicPaymentOrders, events...); err != nil {
+			return fmt.Errorf("failed to publish %s events: %w", order.Status().String(), err)
+		}
+	}
 	return nil
 }
+
+// sagaOrder loads the payment order the saga is running for.
+func (uc *ProcessPayment) sagaOrder(ctx context.Context, state *saga.State) (model.PaymentOrder, error) {
+	id, err := uuid.Parse(state.CorrelationID)
+	if err != nil {
+		return model.PaymentOrder{}, fmt.Errorf("invalid payment saga correlation ID %q: %w", state.CorrelationID, err)
+	}
+	order, err := uc.paymentRepo.FindByID(ctx, id)
+	if err != nil {
+		return model.PaymentOrder{}, fmt.Errorf("failed to find payment order %s: %w", id, err)
+	}
+	return order, nil
+}
+
+// ledgerEntry builds a posting of the order's amount. Its reference is unique
+// per order and leg, so the ledger, which keeps one entry per reference,
+// posts it once, and it can be reconciled against the saga. The leg's
+// posting date is fixed in the saga the first time it is attempted, so a
+// retry the next day sends the same request.
+func (uc *ProcessPayment) ledgerEntry(state *saga.State, order model.PaymentOrder, debit, credit, leg, description string) port.LedgerEntry {
+	dateKey := sagaDataPostingDatePrefix + leg
+	effective, err := time.Parse(time.DateOnly, state.Data[dateKey])
+	if err != nil {
+		effective = time.Now().UTC().Truncate(24 * time.Hour)
+		state.Data[dateKey] = effective.Format(time.DateOnly)
+	}
+	return port.LedgerEntry{
+		EffectiveDate: effective,
+		TenantID:      order.TenantID(),
+		DebitAccount:  debit,
+		CreditAccount: credit,
+		Amount:        order.Amount(),
+		Currency:      order.Currency(),
+		Reference:     fmt.Sprintf("payment/%s/%s", order.ID(), leg),
+		Description:   description,
+	}
+}
@@ -0,0 +1,372 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/saga"
+	"github.com/bibbank/bib/services/payment-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+type mockRailAdapter struct {
+	submitErr error
+	submitted int
+}
+
+func (m *mockRailAdapter) Submit(_ context.Context, _ model.PaymentOrder) error {
+	m.submitted++
+	return m.submitErr
+}
+
+func (m *mockRailAdapter) GetStatus(_ context.Context, _ uuid.UUID) (valueobject.PaymentStatus, string, error) {
+	return valueobject.PaymentStatusProcessing, "", nil
+}
+
+type mockAccountClient struct {
+	err error
+}
+
+func (m *mockAccountClient) LedgerAccountCode(_ context.Context, _, _ uuid.UUID) (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+	return "2000-001", nil
+}
+
+// mockLedgerClient records posted entries and fails entries whose reference
+// ends in a leg listed in failLegs.
+type mockLedgerClient struct {
+	failLegs map[string]error
+	entries  []port.LedgerEntry
+}
+
+func (m *mockLedgerClient) PostEntry(_ context.Context, entry port.LedgerEntry) (string, error) {
+	for leg, err := range m.failLegs {
+		if strings.HasSuffix(entry.Reference, leg) {
+			return "", err
+		}
+	}
+	m.entries = append(m.entries, entry)
+	return fmt.Sprintf("je-%d", len(m.entries)), nil
+}
+
+// processFixture wires ProcessPayment to mocks over a single stored order.
+type processFixture struct {
+	repo      *mockPaymentOrderRepository
+	publisher *mockEventPublisher
+	rail      *mockRailAdapter
+	accounts  *mockAccountClient
+	ledger    *mockLedgerClient
+	store     *saga.MemoryStore
+	order     model.PaymentOrder
+}
+
+func newProcessFixture() *processFixture {
+	f := &processFixture{
+		publisher: &mockEventPublisher{},
+		rail:      &mockRailAdapter{},
+		accounts:  &mockAccountClient{},
+		ledger:    &mockLedgerClient{},
+		store:     saga.NewMemoryStore(),
+		order:     samplePaymentOrder(),
+	}
+	f.repo = &mockPaymentOrderRepository{
+		findByIDFunc: func(_ context.Context, id uuid.UUID) (model.PaymentOrder, error) {
+			if id != f.order.ID() {
+				return model.PaymentOrder{}, fmt.Errorf("payment order not found: %s", id)
+			}
+			return f.order, nil
+		},
+		saveFunc: func(_ context.Context, order model.PaymentOrder) error {
+			_, f.order = order.ClearDomainEvents()
+			return nil
+		},
+	}
+	return f
+}
+
+func (f *processFixture) useCase(fraud port.FraudClient) *usecase.ProcessPayment {
+	return usecase.NewProcessPayment(f.repo, f.rail, f.publisher, fraud, f.accounts, f.ledger, f.store,
+		usecase.PaymentSagaConfig{
+			SuspenseAccount: "2900-001",
+			ClearingAccount: "1150-001",
+			StepTimeout:     time.Second,
+			MaxAttempts:     3,
+		}, nil)
+}
+
+func (f *processFixture) sagaState(t *testing.T) saga.State {
+	t.Helper()
+	state, err := f.store.FindByCorrelation(context.Background(), usecase.PaymentSagaType, f.order.ID().String())
+	require.NoError(t, err)
+	return state
+}
+
+func (f *processFixture) publishedTypes() []string {
+	types := make([]string, 0, len(f.publisher.publishedEvents))
+	for _, evt := range f.publisher.publishedEvents {
+		types = append(types, evt.EventType())
+	}
+	return types
+}
+
+func TestProcessPayment_SettlesThroughAllSteps(t *testing.T) {
+	f := newProcessFixture()
+	fraud := &mockFraudClient{}
+
+	err := f.useCase(fraud).Execute(context.Background(), f.order.ID())
+
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.PaymentStatusSettled, f.order.Status())
+	assert.Equal(t, 1, f.rail.submitted)
+
+	state := f.sagaState(t)
+	assert.Equal(t, saga.StatusCompleted, state.Status)
+	assert.Equal(t, []string{
+		usecase.SagaStepFraudCheck,
+		usecase.SagaStepReserveFunds,
+		usecase.SagaStepSubmitToRail,
+		usecase.SagaStepPostToLedger,
+		usecase.SagaStepComplete,
+	}, state.CompletedSteps)
+
+	require.Len(t, f.ledger.entries, 2)
+	hold, clearing := f.ledger.entries[0], f.ledger.entries[1]
+	assert.Equal(t, "2000-001", hold.DebitAccount)
+	assert.Equal(t, "2900-001", hold.CreditAccount)
+	assert.Equal(t, "2900-001", clearing.DebitAccount)
+	assert.Equal(t, "1150-001", clearing.CreditAccount)
+	assert.True(t, decimal.NewFromInt(1000).Equal(hold.Amount))
+	assert.Equal(t, fmt.Sprintf("payment/%s/hold", f.order.ID()), hold.Reference)
+
+	assert.Contains(t, f.publishedTypes(), "payment.order.settled")
+}
+
+func TestProcessPayment_FraudDeclineFailsOrder(t *testing.T) {
+	f := newProcessFixture()
+	fraud := &mockFraudClient{
+		assessFunc: func(_ context.Context, _, _ uuid.UUID, _ decimal.Decimal, _ string) (bool, error) {
+			return false, nil
+		},
+	}
+
+	err := f.useCase(fraud).Execute(context.Background(), f.order.ID())
+
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.PaymentStatusFailed, f.order.Status())
+	assert.Contains(t, f.order.FailureReason(), "declined by fraud check")
+	assert.Zero(t, f.rail.submitted)
+	assert.Empty(t, f.ledger.entries)
+
+	state := f.sagaState(t)
+	assert.Equal(t, saga.StatusCompensated, state.Status)
+	assert.Equal(t, usecase.SagaStepFraudCheck, state.FailedStep)
+	assert.Empty(t, state.CompletedSteps)
+	assert.Contains(t, f.publishedTypes(), "payment.order.failed")
+}
+
+func TestProcessPayment_FraudCheckErrorFailsOrder(t *testing.T) {
+	f := newProcessFixture()
+	fraud := &mockFraudClient{
+		assessFunc: func(_ context.Context, _, _ uuid.UUID, _ decimal.Decimal, _ string) (bool, error) {
+			return false, errors.New("fraud service unavailable")
+		},
+	}
+
+	err := f.useCase(fraud).Execute(context.Background(), f.order.ID())
+
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.PaymentStatusFailed, f.order.Status())
+	assert.Contains(t, f.order.FailureReason(), "fraud check error")
+	assert.Contains(t, f.order.FailureReason(), "fraud service unavailable")
+}
+
+func TestProcessPayment_RailFailureReleasesHold(t *testing.T) {
+	f := newProcessFixture()
+	f.rail.submitErr = errors.New("ACH processor rejected")
+
+	err := f.useCase(nil).Execute(context.Background(), f.order.ID())
+
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.PaymentStatusFailed, f.order.Status())
+	assert.Contains(t, f.order.FailureReason(), "rail submission error")
+
+	require.Len(t, f.ledger.entries, 2)
+	hold, release := f.ledger.entries[0], f.ledger.entries[1]
+	assert.Equal(t, hold.DebitAccount, release.CreditAccount)
+	assert.Equal(t, hold.CreditAccount, release.DebitAccount)
+	assert.Equal(t, fmt.Sprintf("payment/%s/release", f.order.ID()), release.Reference)
+
+	state := f.sagaState(t)
+	assert.Equal(t, saga.StatusCompensated, state.Status)
+	assert.Equal(t, usecase.SagaStepSubmitToRail, state.FailedStep)
+}
+
+func TestProcessPayment_NilFraudClientSkipsFraudCheck(t *testing.T) {
+	f := newProcessFixture()
+
+	err := f.useCase(nil).Execute(context.Background(), f.order.ID())
+
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.PaymentStatusSettled, f.order.Status())
+	assert.Contains(t, f.sagaState(t).CompletedSteps, usecase.SagaStepFraudCheck)
+}
+
+func TestProcessPayment_LedgerFailureAfterRailIsRetried(t *testing.T) {
+	f := newProcessFixture()
+	f.ledger.failLegs = map[string]error{"/clearing": errors.New("ledger unavailable")}
+	uc := f.useCase(nil)
+
+	err := uc.Execute(context.Background(), f.order.ID())
+
+	require.Error(t, err)
+	assert.Equal(t, valueobject.PaymentStatusProcessing, f.order.Status())
+	state := f.sagaState(t)
+	assert.Equal(t, saga.StatusRunning, state.Status)
+	assert.Equal(t, 1, f.rail.submitted)
+
+	// The ledger recovers; resuming the saga moves forward without
+	// resubmitting to the rail or releasing the hold.
+	f.ledger.failLegs = nil
+	require.NoError(t, uc.Execute(context.Background(), f.order.ID()))
+
+	assert.Equal(t, valueobject.PaymentStatusSettled, f.order.Status())
+	assert.Equal(t, 1, f.rail.submitted)
+	require.Len(t, f.ledger.entries, 2)
+	assert.Equal(t, "1150-001", f.ledger.entries[1].CreditAccount)
+	assert.Equal(t, saga.StatusCompleted, f.sagaState(t).Status)
+}
+
+func TestProcessPayment_HoldFailureFailsOrder(t *testing.T) {
+	f := newProcessFixture()
+	f.accounts.err = errors.New("account not found")
+
+	err := f.useCase(nil).Execute(context.Background(), f.order.ID())
+
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.PaymentStatusFailed, f.order.Status())
+	assert.Contains(t, f.order.FailureReason(), "failed to resolve source ledger account")
+	assert.Zero(t, f.rail.submitted)
+	assert.Empty(t, f.ledger.entries)
+}
+
+func TestProcessPayment_OrderNotFound(t *testing.T) {
+	f := newProcessFixture()
+
+	err := f.useCase(nil).Execute(context.Background(), uuid.New())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to find payment order")
+}
+
+func TestProcessPayment_RepostRetriesFailedSaga(t *testing.T) {
+	f := newProcessFixture()
+	f.ledger.failLegs = map[string]error{"/clearing": errors.New("ledger unavailable")}
+	uc := f.useCase(nil)
+
+	// The ledger stays down until the saga runs out of attempts.
+	for i := 0; i < 3; i++ {
+		require.Error(t, uc.Execute(context.Background(), f.order.ID()))
+	}
+	require.Equal(t, saga.StatusFailed, f.sagaState(t).Status)
+
+	f.ledger.failLegs = nil
+	require.NoError(t, uc.Repost(context.Background(), f.order.ID()))
+
+	assert.Equal(t, valueobject.PaymentStatusSettled, f.order.Status())
+	assert.Equal(t, 1, f.rail.submitted)
+	assert.Equal(t, saga.StatusCompleted, f.sagaState(t).Status)
+}
+
+func TestProcessPayment_RepostWithoutSaga(t *testing.T) {
+	f := newProcessFixture()
+
+	err := f.useCase(nil).Repost(context.Background(), f.order.ID())
+
+	assert.ErrorIs(t, err, usecase.ErrPaymentSagaNotFound)
+}
+
+// settle runs the fixture's order through the payment saga.
+func (f *processFixture) settle(t *testing.T, uc *usecase.ProcessPayment) {
+	t.Helper()
+	require.NoError(t, uc.Execute(context.Background(), f.order.ID()))
+	require.Equal(t, valueobject.PaymentStatusSettled, f.order.Status())
+}
+
+func TestProcessPayment_ReversePostsReversingEntry(t *testing.T) {
+	f := newProcessFixture()
+	uc := f.useCase(nil)
+	f.settle(t, uc)
+
+	require.NoError(t, uc.Reverse(context.Background(), f.order.TenantID(), f.order.ID(), "returned by rail: R01"))
+
+	assert.Equal(t, valueobject.PaymentStatusReversed, f.order.Status())
+	require.Len(t, f.ledger.entries, 3)
+	reversal := f.ledger.entries[2]
+	assert.Equal(t, "1150-001", reversal.DebitAccount)
+	assert.Equal(t, "2000-001", reversal.CreditAccount)
+	assert.True(t, decimal.NewFromInt(1000).Equal(reversal.Amount))
+	assert.Equal(t, fmt.Sprintf("payment/%s/reversal", f.order.ID()), reversal.Reference)
+	assert.Contains(t, f.publishedTypes(), "payment.order.reversed")
+
+	state, err := f.store.FindByCorrelation(context.Background(), usecase.PaymentReversalSagaType, f.order.ID().String())
+	require.NoError(t, err)
+	assert.Equal(t, saga.StatusCompleted, state.Status)
+
+	// Reversing again resumes the completed saga instead of posting twice.
+	require.NoError(t, uc.Reverse(context.Background(), f.order.TenantID(), f.order.ID(), "returned by rail: R01"))
+	assert.Len(t, f.ledger.entries, 3)
+}
+
+func TestProcessPayment_ReverseRetriesLedgerFailure(t *testing.T) {
+	f := newProcessFixture()
+	uc := f.useCase(nil)
+	f.settle(t, uc)
+
+	f.ledger.failLegs = map[string]error{"/reversal": errors.New("ledger unavailable")}
+	require.Error(t, uc.Reverse(context.Background(), f.order.TenantID(), f.order.ID(), "returned"))
+	assert.Equal(t, valueobject.PaymentStatusSettled, f.order.Status())
+
+	f.ledger.failLegs = nil
+	require.NoError(t, uc.Reverse(context.Background(), f.order.TenantID(), f.order.ID(), "returned"))
+	assert.Equal(t, valueobject.PaymentStatusReversed, f.order.Status())
+	assert.Len(t, f.ledger.entries, 3)
+}
+
+func TestProcessPayment_ReverseRejectsUnsettledAndForeignPayments(t *testing.T) {
+	f := newProcessFixture()
+	uc := f.useCase(nil)
+
+	err := uc.Reverse(context.Background(), f.order.TenantID(), f.order.ID(), "returned")
+	assert.ErrorIs(t, err, usecase.ErrPaymentNotSettled)
+
+	f.settle(t, uc)
+	err = uc.Reverse(context.Background(), uuid.New(), f.order.ID(), "returned")
+	assert.ErrorIs(t, err, usecase.ErrPaymentNotFound)
+	assert.Len(t, f.ledger.entries, 2)
+}
+
+func TestProcessPayment_PinsPostingDatesInSaga(t *testing.T) {
+	f := newProcessFixture()
+	f.settle(t, f.useCase(nil))
+
+	state := f.sagaState(t)
+	today := time.Now().UTC().Format(time.DateOnly)
+	assert.Equal(t, today, state.Data["posting_date_hold"])
+	assert.Equal(t, today, state.Data["posting_date_clearing"])
+	for _, entry := range f.ledger.entries {
+		assert.Equal(t, today, entry.EffectiveDate.Format(time.DateOnly))
+	}
+}
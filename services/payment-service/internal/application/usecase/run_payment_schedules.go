@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/bibbank/bib/services/payment-service/internal/application/dto"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/service"
+)
+
+// RunPaymentSchedules materializes the due runs of payment schedules into
+// payment orders, which are processed like any other initiated payment.
+type RunPaymentSchedules struct {
+	scheduleRepo  port.PaymentScheduleRepository
+	publisher     port.EventPublisher
+	routingEngine *service.RoutingEngine
+	fraudClient   port.FraudClient // optional, may be nil
+	logger        *slog.Logger
+}
+
+func NewRunPaymentSchedules(
+	scheduleRepo port.PaymentScheduleRepository,
+	publisher port.EventPublisher,
+	routingEngine *service.RoutingEngine,
+	fraudClient port.FraudClient,
+	logger *slog.Logger,
+) *RunPaymentSchedules {
+	return &RunPaymentSchedules{
+		scheduleRepo:  scheduleRepo,
+		publisher:     publisher,
+		routingEngine: routingEngine,
+		fraudClient:   fraudClient,
+		logger:        logger,
+	}
+}
+
+// Execute makes the runs of up to limit schedules due at now and returns
+// how many payments it initiated. A run rejected by the fraud assessment is
+// skipped; a run that fails otherwise is retried on the next call. A run
+// made concurrently by another replica is left to it.
+func (uc *RunPaymentSchedules) Execute(ctx context.Context, now time.Time, limit int) (int, error) {
+	schedules, err := uc.scheduleRepo.ListDue(ctx, now, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list due payment schedules: %w", err)
+	}
+
+	initiated := 0
+	for _, schedule := range schedules {
+		ok, err := uc.run(ctx, schedule, now)
+		if err != nil {
+			uc.logger.Error("payment schedule run failed", "schedule_id", schedule.ID(), "error", err)
+			continue
+		}
+		if ok {
+			initiated++
+		}
+	}
+	return initiated, nil
+}
+
+// run makes the due run of schedule, returning true if it initiated a
+// payment.
+func (uc *RunPaymentSchedules) run(ctx context.Context, schedule model.PaymentSchedule, now time.Time) (bool, error) {
+	order, err := newPaymentOrder(ctx, uc.routingEngine, uc.fraudClient, dto.InitiatePaymentRequest{
+		TenantID:              schedule.TenantID(),
+		SourceAccountID:       schedule.SourceAccountID(),
+		DestinationAccountID:  schedule.DestinationAccountID(),
+		Amount:                schedule.Amount(),
+		Currency:              schedule.Currency(),
+		RoutingNumber:         schedule.RoutingInfo().RoutingNumber(),
+		ExternalAccountNumber: schedule.RoutingInfo().ExternalAccountNumber(),
+		DestinationCountry:    schedule.DestinationCountry(),
+		Reference:             schedule.Reference(),
+		Description:           schedule.Description(),
+	})
+	if errors.Is(err, ErrPaymentRejected) {
+		skipped, skipErr := schedule.SkipRun(err.Error(), now)
+		if skipErr != nil {
+			return false, skipErr
+		}
+		if err := uc.scheduleRepo.Save(ctx, skipped); err != nil && !errors.Is(err, port.ErrScheduleConflict) {
+			return false, fmt.Errorf("failed to save payment schedule: %w", err)
+		}
+		uc.logger.Warn("payment schedule run skipped", "schedule_id", schedule.ID(), "reason", err)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := schedule.RecordRun(order.ID(), now)
+	if err != nil {
+		return false, err
+	}
+	if err := uc.scheduleRepo.SaveRun(ctx, updated, order); err != nil {
+		if errors.Is(err, port.ErrScheduleConflict) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to save payment schedule run: %w", err)
+	}
+
+	// The order and its outbox events are already stored, so a failed
+	// publish does not make the run again.
+	if events := order.DomainEvents(); len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, TopicPaymentOrders, events...); err != nil {
+			uc.logger.Warn("failed to publish scheduled payment events", "payment_id", order.ID(), "error", err)
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,229 @@
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/payment-service/internal/application/dto"
+	"github.com/bibbank/bib/services/payment-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/service"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+type mockPaymentScheduleRepository struct {
+	saveRunErr error
+	schedules  map[uuid.UUID]model.PaymentSchedule
+	orders     []model.PaymentOrder
+}
+
+func newMockPaymentScheduleRepository(schedules ...model.PaymentSchedule) *mockPaymentScheduleRepository {
+	m := &mockPaymentScheduleRepository{schedules: make(map[uuid.UUID]model.PaymentSchedule)}
+	for _, s := range schedules {
+		m.schedules[s.ID()] = s
+	}
+	return m
+}
+
+func (m *mockPaymentScheduleRepository) Save(_ context.Context, schedule model.PaymentSchedule) error {
+	m.schedules[schedule.ID()] = schedule
+	return nil
+}
+
+func (m *mockPaymentScheduleRepository) SaveRun(_ context.Context, schedule model.PaymentSchedule, order model.PaymentOrder) error {
+	if m.saveRunErr != nil {
+		return m.saveRunErr
+	}
+	m.schedules[schedule.ID()] = schedule
+	m.orders = append(m.orders, order)
+	return nil
+}
+
+func (m *mockPaymentScheduleRepository) FindByID(_ context.Context, id uuid.UUID) (model.PaymentSchedule, error) {
+	if s, ok := m.schedules[id]; ok {
+		return s, nil
+	}
+	return model.PaymentSchedule{}, fmt.Errorf("payment schedule %s not found", id)
+}
+
+func (m *mockPaymentScheduleRepository) ListByTenant(_ context.Context, tenantID uuid.UUID, _, _ int) ([]model.PaymentSchedule, int, error) {
+	var out []model.PaymentSchedule
+	for _, s := range m.schedules {
+		if s.TenantID() == tenantID {
+			out = append(out, s)
+		}
+	}
+	return out, len(out), nil
+}
+
+func (m *mockPaymentScheduleRepository) ListDue(_ context.Context, now time.Time, limit int) ([]model.PaymentSchedule, error) {
+	var out []model.PaymentSchedule
+	for _, s := range m.schedules {
+		if s.IsDue(now) && len(out) < limit {
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+
+func newDueSchedule(t *testing.T, start time.Time) model.PaymentSchedule {
+	t.Helper()
+	recurrence, err := valueobject.NewRecurrence(valueobject.FrequencyMonthly, 1, "")
+	require.NoError(t, err)
+	routingInfo, err := valueobject.NewRoutingInfo("021000021", "123456789")
+	require.NoError(t, err)
+
+	schedule, err := model.NewPaymentSchedule(
+		uuid.New(), uuid.New(), uuid.Nil,
+		decimal.NewFromInt(1200), "USD", routingInfo,
+		"RENT", "Monthly rent", "US",
+		recurrence, start, nil, 0, start.Add(-time.Hour),
+	)
+	require.NoError(t, err)
+	return schedule
+}
+
+func TestRunPaymentSchedules_Execute(t *testing.T) {
+	start := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("initiates payments for due schedules", func(t *testing.T) {
+		schedule := newDueSchedule(t, start)
+		repo := newMockPaymentScheduleRepository(schedule)
+		publisher := &mockEventPublisher{}
+		uc := usecase.NewRunPaymentSchedules(repo, publisher, service.NewRoutingEngine(), nil, slog.Default())
+
+		initiated, err := uc.Execute(context.Background(), start.Add(time.Minute), 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, initiated)
+
+		require.Len(t, repo.orders, 1)
+		order := repo.orders[0]
+		assert.Equal(t, schedule.SourceAccountID(), order.SourceAccountID())
+		assert.Equal(t, "RENT", order.Reference())
+		assert.Equal(t, valueobject.RailACH, order.Rail())
+
+		updated := repo.schedules[schedule.ID()]
+		assert.Equal(t, order.ID(), updated.LastPaymentID())
+		assert.Equal(t, time.Date(2026, time.April, 1, 9, 0, 0, 0, time.UTC), *updated.NextRunAt())
+
+		require.Len(t, publisher.publishedEvents, 1)
+		assert.Equal(t, "payment.order.initiated", publisher.publishedEvents[0].EventType())
+
+		initiated, err = uc.Execute(context.Background(), start.Add(2*time.Minute), 10)
+		require.NoError(t, err)
+		assert.Zero(t, initiated, "a run is made once")
+	})
+
+	t.Run("skips runs rejected by the fraud assessment", func(t *testing.T) {
+		schedule := newDueSchedule(t, start)
+		repo := newMockPaymentScheduleRepository(schedule)
+		fraud := &mockFraudClient{
+			assessFunc: func(context.Context, uuid.UUID, uuid.UUID, decimal.Decimal, string) (bool, error) {
+				return false, nil
+			},
+		}
+		uc := usecase.NewRunPaymentSchedules(repo, &mockEventPublisher{}, service.NewRoutingEngine(), fraud, slog.Default())
+
+		initiated, err := uc.Execute(context.Background(), start, 10)
+		require.NoError(t, err)
+		assert.Zero(t, initiated)
+		assert.Empty(t, repo.orders)
+
+		updated := repo.schedules[schedule.ID()]
+		assert.Equal(t, usecase.ErrPaymentRejected.Error(), updated.LastFailure())
+		assert.False(t, updated.IsDue(start))
+	})
+
+	t.Run("retries runs that fail otherwise", func(t *testing.T) {
+		schedule := newDueSchedule(t, start)
+		repo := newMockPaymentScheduleRepository(schedule)
+		fraud := &mockFraudClient{
+			assessFunc: func(context.Context, uuid.UUID, uuid.UUID, decimal.Decimal, string) (bool, error) {
+				return false, fmt.Errorf("fraud service unavailable")
+			},
+		}
+		uc := usecase.NewRunPaymentSchedules(repo, &mockEventPublisher{}, service.NewRoutingEngine(), fraud, slog.Default())
+
+		initiated, err := uc.Execute(context.Background(), start, 10)
+		require.NoError(t, err)
+		assert.Zero(t, initiated)
+		assert.True(t, repo.schedules[schedule.ID()].IsDue(start))
+	})
+
+	t.Run("leaves runs made concurrently to the other replica", func(t *testing.T) {
+		schedule := newDueSchedule(t, start)
+		repo := newMockPaymentScheduleRepository(schedule)
+		repo.saveRunErr = port.ErrScheduleConflict
+		publisher := &mockEventPublisher{}
+		uc := usecase.NewRunPaymentSchedules(repo, publisher, service.NewRoutingEngine(), nil, slog.Default())
+
+		initiated, err := uc.Execute(context.Background(), start, 10)
+		require.NoError(t, err)
+		assert.Zero(t, initiated)
+		assert.Empty(t, publisher.publishedEvents)
+	})
+}
+
+func TestSchedulePayment_Execute(t *testing.T) {
+	start := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Minute)
+
+	t.Run("saves an active schedule", func(t *testing.T) {
+		repo := newMockPaymentScheduleRepository()
+		uc := usecase.NewSchedulePayment(repo)
+
+		resp, err := uc.Execute(context.Background(), dto.SchedulePaymentRequest{
+			TenantID:        uuid.New(),
+			SourceAccountID: uuid.New(),
+			Amount:          decimal.NewFromInt(50),
+			Currency:        "USD",
+			Frequency:       "cron",
+			CronExpression:  "0 9 * * 1",
+			StartAt:         start,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "ACTIVE", resp.Status)
+		assert.Equal(t, "CRON", resp.Frequency)
+		require.NotNil(t, resp.NextRunAt)
+		assert.Equal(t, time.Monday, resp.NextRunAt.Weekday())
+		assert.Len(t, repo.schedules, 1)
+	})
+
+	t.Run("invalid routing info", func(t *testing.T) {
+		uc := usecase.NewSchedulePayment(newMockPaymentScheduleRepository())
+
+		_, err := uc.Execute(context.Background(), dto.SchedulePaymentRequest{
+			TenantID:        uuid.New(),
+			SourceAccountID: uuid.New(),
+			Amount:          decimal.NewFromInt(50),
+			Currency:        "USD",
+			RoutingNumber:   "123",
+			Frequency:       "DAILY",
+			StartAt:         start,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid routing info")
+	})
+}
+
+func TestCancelPaymentSchedule_Execute(t *testing.T) {
+	schedule := newDueSchedule(t, time.Now().UTC().Add(time.Hour))
+	repo := newMockPaymentScheduleRepository(schedule)
+	uc := usecase.NewCancelPaymentSchedule(repo)
+
+	_, err := uc.Execute(context.Background(), dto.CancelPaymentScheduleRequest{TenantID: uuid.New(), ScheduleID: schedule.ID()})
+	require.Error(t, err, "another tenant's schedule is not found")
+
+	resp, err := uc.Execute(context.Background(), dto.CancelPaymentScheduleRequest{TenantID: schedule.TenantID(), ScheduleID: schedule.ID()})
+	require.NoError(t, err)
+	assert.Equal(t, "CANCELLED", resp.Status)
+	assert.Equal(t, valueobject.ScheduleStatusCancelled, repo.schedules[schedule.ID()].Status())
+}
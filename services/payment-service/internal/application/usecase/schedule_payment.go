@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/payment-service/internal/application/dto"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+// SchedulePayment handles the creation of payment schedules, which the
+// scheduler turns into payment orders as their runs fall due.
+type SchedulePayment struct {
+	scheduleRepo port.PaymentScheduleRepository
+}
+
+func NewSchedulePayment(scheduleRepo port.PaymentScheduleRepository) *SchedulePayment {
+	return &SchedulePayment{scheduleRepo: scheduleRepo}
+}
+
+func (uc *SchedulePayment) Execute(ctx context.Context, req dto.SchedulePaymentRequest) (dto.PaymentScheduleResponse, error) {
+	routingInfo, err := valueobject.NewRoutingInfo(req.RoutingNumber, req.ExternalAccountNumber)
+	if err != nil {
+		return dto.PaymentScheduleResponse{}, fmt.Errorf("invalid routing info: %w", err)
+	}
+	recurrence, err := valueobject.NewRecurrence(req.Frequency, req.Interval, req.CronExpression)
+	if err != nil {
+		return dto.PaymentScheduleResponse{}, err
+	}
+
+	schedule, err := model.NewPaymentSchedule(
+		req.TenantID,
+		req.SourceAccountID,
+		req.DestinationAccountID,
+		req.Amount,
+		req.Currency,
+		routingInfo,
+		req.Reference,
+		req.Description,
+		req.DestinationCountry,
+		recurrence,
+		req.StartAt,
+		req.EndAt,
+		req.MaxRuns,
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return dto.PaymentScheduleResponse{}, fmt.Errorf("failed to create payment schedule: %w", err)
+	}
+
+	if err := uc.scheduleRepo.Save(ctx, schedule); err != nil {
+		return dto.PaymentScheduleResponse{}, fmt.Errorf("failed to save payment schedule: %w", err)
+	}
+	return toPaymentScheduleResponse(schedule), nil
+}
+
+func toPaymentScheduleResponse(s model.PaymentSchedule) dto.PaymentScheduleResponse {
+	return dto.PaymentScheduleResponse{
+		ID:                    s.ID(),
+		TenantID:              s.TenantID(),
+		SourceAccountID:       s.SourceAccountID(),
+		DestinationAccountID:  s.DestinationAccountID(),
+		Amount:                s.Amount(),
+		Currency:              s.Currency(),
+		RoutingNumber:         s.RoutingInfo().RoutingNumber(),
+		ExternalAccountNumber: s.RoutingInfo().ExternalAccountNumber(),
+		DestinationCountry:    s.DestinationCountry(),
+		Reference:             s.Reference(),
+		Description:           s.Description(),
+		Frequency:             s.Recurrence().Frequency(),
+		Interval:              s.Recurrence().Interval(),
+		CronExpression:        s.Recurrence().CronExpression(),
+		StartAt:               s.StartAt(),
+		EndAt:                 s.EndAt(),
+		MaxRuns:               s.MaxRuns(),
+		Status:                s.Status().String(),
+		NextRunAt:             s.NextRunAt(),
+		RunCount:              s.RunCount(),
+		LastPaymentID:         s.LastPaymentID(),
+		LastRunAt:             s.LastRunAt(),
+		LastFailure:           s.LastFailure(),
+		CreatedAt:             s.CreatedAt(),
+		UpdatedAt:             s.UpdatedAt(),
+	}
+}
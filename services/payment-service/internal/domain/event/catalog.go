@@ -0,0 +1,15 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[PaymentInitiated]("payment.order.initiated", 1, "Emitted when a new payment order is created."),
+		events.Declare[PaymentProcessing]("payment.order.processing", 1, "Emitted when a payment order begins processing via a rail adapter."),
+		events.Declare[PaymentSettled]("payment.order.settled", 1, "Emitted when a payment order is successfully settled."),
+		events.Declare[PaymentFailed]("payment.order.failed", 1, "Emitted when a payment order fails."),
+		events.Declare[PaymentReversed]("payment.order.reversed", 1, "Emitted when a settled payment order is reversed."),
+	}
+}
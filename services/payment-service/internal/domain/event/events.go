@@ -12,21 +12,35 @@ import (
 const AggregateTypePaymentOrder = "PaymentOrder"
 
 // PaymentInitiated is emitted when a new payment order is created.
+// DestinationAccountID is uuid.Nil for payments to external accounts, and
+// DestinationCountry is empty when the country is not known.
 type PaymentInitiated struct {
 	events.BaseEvent
-	Amount    decimal.Decimal `json:"amount"`
-	Currency  string          `json:"currency"`
-	Rail      string          `json:"rail"`
-	PaymentID uuid.UUID       `json:"payment_id"`
+	Amount               decimal.Decimal `json:"amount"`
+	Currency             string          `json:"currency"`
+	Rail                 string          `json:"rail"`
+	Description          string          `json:"description,omitempty"`
+	DestinationCountry   string          `json:"destination_country,omitempty"`
+	PaymentID            uuid.UUID       `json:"payment_id"`
+	SourceAccountID      uuid.UUID       `json:"source_account_id"`
+	DestinationAccountID uuid.UUID       `json:"destination_account_id"`
 }
 
-func NewPaymentInitiated(paymentID, tenantID uuid.UUID, amount decimal.Decimal, currency, rail string) PaymentInitiated {
+func NewPaymentInitiated(
+	paymentID, tenantID, sourceAccountID, destinationAccountID uuid.UUID,
+	amount decimal.Decimal,
+	currency, rail, description, destinationCountry string,
+) PaymentInitiated {
 	return PaymentInitiated{
-		BaseEvent: events.NewBaseEvent("payment.order.initiated", paymentID.String(), AggregateTypePaymentOrder, tenantID.String()),
-		PaymentID: paymentID,
-		Amount:    amount,
-		Currency:  currency,
-		Rail:      rail,
+		BaseEvent:            events.NewBaseEvent("payment.order.initiated", paymentID.String(), AggregateTypePaymentOrder, tenantID.String()),
+		PaymentID:            paymentID,
+		SourceAccountID:      sourceAccountID,
+		DestinationAccountID: destinationAccountID,
+		Amount:               amount,
+		Currency:             currency,
+		Rail:                 rail,
+		Description:          description,
+		DestinationCountry:   destinationCountry,
 	}
 }
 
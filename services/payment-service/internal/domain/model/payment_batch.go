@@ -0,0 +1,248 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+// BatchInstruction is a payment instruction read from a bulk payment file:
+// a credit to a beneficiary's external account.
+type BatchInstruction struct {
+	Amount          decimal.Decimal
+	Currency        string
+	BeneficiaryName string
+	RoutingNumber   string
+	AccountNumber   string
+	// Reference identifies the instruction in the file, such as a NACHA
+	// trace number or a pain.001 end-to-end ID.
+	Reference   string
+	Description string
+}
+
+// BatchEntry is an instruction of a payment batch. An accepted entry was
+// initiated as a payment order, whose status it reports; a rejected entry
+// was not, and records why.
+type BatchEntry struct {
+	instruction   BatchInstruction
+	paymentStatus valueobject.PaymentStatus
+	errorMessage  string
+	sequence      int
+	paymentID     uuid.UUID
+}
+
+// NewAcceptedBatchEntry creates the entry for the instruction at sequence
+// (1-based) in its file, initiated as order.
+func NewAcceptedBatchEntry(sequence int, instruction BatchInstruction, order PaymentOrder) BatchEntry {
+	return BatchEntry{
+		sequence:      sequence,
+		instruction:   instruction,
+		paymentID:     order.ID(),
+		paymentStatus: order.Status(),
+	}
+}
+
+// NewRejectedBatchEntry creates the entry for the instruction at sequence
+// (1-based) in its file, rejected for reason.
+func NewRejectedBatchEntry(sequence int, instruction BatchInstruction, reason string) BatchEntry {
+	return BatchEntry{
+		sequence:     sequence,
+		instruction:  instruction,
+		errorMessage: reason,
+	}
+}
+
+// ReconstructBatchEntry recreates a BatchEntry from persistence (no
+// validation). For an accepted entry, paymentStatus and errorMessage are
+// those of its payment order.
+func ReconstructBatchEntry(
+	sequence int,
+	instruction BatchInstruction,
+	paymentID uuid.UUID,
+	paymentStatus valueobject.PaymentStatus,
+	errorMessage string,
+) BatchEntry {
+	return BatchEntry{
+		sequence:      sequence,
+		instruction:   instruction,
+		paymentID:     paymentID,
+		paymentStatus: paymentStatus,
+		errorMessage:  errorMessage,
+	}
+}
+
+// IsRejected returns true if the entry was not initiated as a payment.
+func (e BatchEntry) IsRejected() bool {
+	return e.paymentID == uuid.Nil
+}
+
+// IsFailed returns true if the entry was rejected or its payment failed or
+// was reversed.
+func (e BatchEntry) IsFailed() bool {
+	return e.IsRejected() ||
+		e.paymentStatus == valueobject.PaymentStatusFailed ||
+		e.paymentStatus == valueobject.PaymentStatusReversed
+}
+
+// Status returns REJECTED for a rejected entry and its payment's status
+// otherwise.
+func (e BatchEntry) Status() string {
+	if e.IsRejected() {
+		return "REJECTED"
+	}
+	return e.paymentStatus.String()
+}
+
+func (e BatchEntry) Sequence() int                            { return e.sequence }
+func (e BatchEntry) Instruction() BatchInstruction            { return e.instruction }
+func (e BatchEntry) PaymentID() uuid.UUID                     { return e.paymentID }
+func (e BatchEntry) PaymentStatus() valueobject.PaymentStatus { return e.paymentStatus }
+func (e BatchEntry) Error() string                            { return e.errorMessage }
+
+// BatchProgress counts the entries of a payment batch by outcome.
+type BatchProgress struct {
+	Total    int
+	Rejected int
+	// Pending counts payments not yet settled or failed.
+	Pending int
+	Settled int
+	// Failed counts payments that failed or were reversed.
+	Failed int
+}
+
+// PaymentBatch is a bulk payment file imported as a set of payment orders,
+// all debiting one source account. Each instruction in the file becomes an
+// entry, accepted as a payment order or rejected. The batch does not change
+// once imported: its progress follows from its payments' statuses.
+type PaymentBatch struct {
+	createdAt       time.Time
+	format          valueobject.BatchFileFormat
+	fileName        string
+	fileHash        string
+	entries         []BatchEntry
+	tenantID        uuid.UUID
+	sourceAccountID uuid.UUID
+	id              uuid.UUID
+}
+
+// NewPaymentBatch creates a payment batch for the file with the given name
+// and content hash, with an entry for each of its instructions.
+func NewPaymentBatch(
+	tenantID uuid.UUID,
+	sourceAccountID uuid.UUID,
+	format valueobject.BatchFileFormat,
+	fileName string,
+	fileHash string,
+	entries []BatchEntry,
+	now time.Time,
+) (PaymentBatch, error) {
+	if tenantID == uuid.Nil {
+		return PaymentBatch{}, fmt.Errorf("tenant ID is required")
+	}
+	if sourceAccountID == uuid.Nil {
+		return PaymentBatch{}, fmt.Errorf("source account ID is required")
+	}
+	if format.IsZero() {
+		return PaymentBatch{}, fmt.Errorf("file format is required")
+	}
+	if fileHash == "" {
+		return PaymentBatch{}, fmt.Errorf("file hash is required")
+	}
+	if len(entries) == 0 {
+		return PaymentBatch{}, fmt.Errorf("batch has no entries")
+	}
+
+	return PaymentBatch{
+		id:              uuid.New(),
+		tenantID:        tenantID,
+		sourceAccountID: sourceAccountID,
+		format:          format,
+		fileName:        fileName,
+		fileHash:        fileHash,
+		entries:         append([]BatchEntry(nil), entries...),
+		createdAt:       now.UTC(),
+	}, nil
+}
+
+// ReconstructPaymentBatch recreates a PaymentBatch from persistence (no
+// validation).
+func ReconstructPaymentBatch(
+	id, tenantID, sourceAccountID uuid.UUID,
+	format valueobject.BatchFileFormat,
+	fileName, fileHash string,
+	entries []BatchEntry,
+	createdAt time.Time,
+) PaymentBatch {
+	return PaymentBatch{
+		id:              id,
+		tenantID:        tenantID,
+		sourceAccountID: sourceAccountID,
+		format:          format,
+		fileName:        fileName,
+		fileHash:        fileHash,
+		entries:         entries,
+		createdAt:       createdAt,
+	}
+}
+
+// Progress counts the batch's entries by outcome.
+func (b PaymentBatch) Progress() BatchProgress {
+	p := BatchProgress{Total: len(b.entries)}
+	for _, e := range b.entries {
+		switch {
+		case e.IsRejected():
+			p.Rejected++
+		case e.paymentStatus == valueobject.PaymentStatusSettled:
+			p.Settled++
+		case e.IsFailed():
+			p.Failed++
+		default:
+			p.Pending++
+		}
+	}
+	return p
+}
+
+// Status derives the batch's status from its progress: REJECTED if no entry
+// was accepted, PROCESSING while payments are pending, and COMPLETED or
+// COMPLETED_WITH_ERRORS once none are.
+func (b PaymentBatch) Status() valueobject.BatchStatus {
+	p := b.Progress()
+	switch {
+	case p.Rejected == p.Total:
+		return valueobject.BatchStatusRejected
+	case p.Pending > 0:
+		return valueobject.BatchStatusProcessing
+	case p.Rejected > 0 || p.Failed > 0:
+		return valueobject.BatchStatusCompletedWithErrors
+	default:
+		return valueobject.BatchStatusCompleted
+	}
+}
+
+// FailedEntries returns the entries that were rejected or whose payments
+// failed or were reversed, in file order: the batch's error report.
+func (b PaymentBatch) FailedEntries() []BatchEntry {
+	var failed []BatchEntry
+	for _, e := range b.entries {
+		if e.IsFailed() {
+			failed = append(failed, e)
+		}
+	}
+	return failed
+}
+
+// Accessors
+
+func (b PaymentBatch) ID() uuid.UUID                       { return b.id }
+func (b PaymentBatch) TenantID() uuid.UUID                 { return b.tenantID }
+func (b PaymentBatch) SourceAccountID() uuid.UUID          { return b.sourceAccountID }
+func (b PaymentBatch) Format() valueobject.BatchFileFormat { return b.format }
+func (b PaymentBatch) FileName() string                    { return b.fileName }
+func (b PaymentBatch) FileHash() string                    { return b.fileHash }
+func (b PaymentBatch) Entries() []BatchEntry               { return b.entries }
+func (b PaymentBatch) CreatedAt() time.Time                { return b.createdAt }
@@ -0,0 +1,90 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+func batchInstruction(ref string) model.BatchInstruction {
+	return model.BatchInstruction{
+		Amount:        decimal.NewFromInt(250),
+		Currency:      "USD",
+		RoutingNumber: "021000021",
+		AccountNumber: "12345678",
+		Reference:     ref,
+	}
+}
+
+func TestNewPaymentBatch(t *testing.T) {
+	tenantID, sourceID := uuid.New(), uuid.New()
+	entry := model.NewRejectedBatchEntry(1, batchInstruction("1"), "debit entries are not supported")
+
+	t.Run("valid", func(t *testing.T) {
+		batch, err := model.NewPaymentBatch(tenantID, sourceID, valueobject.BatchFileFormatNACHA, "payroll.ach", "abc", []model.BatchEntry{entry}, time.Now())
+		require.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, batch.ID())
+		assert.Equal(t, sourceID, batch.SourceAccountID())
+		assert.Len(t, batch.Entries(), 1)
+	})
+
+	t.Run("no entries", func(t *testing.T) {
+		_, err := model.NewPaymentBatch(tenantID, sourceID, valueobject.BatchFileFormatNACHA, "payroll.ach", "abc", nil, time.Now())
+		assert.Error(t, err)
+	})
+
+	t.Run("missing source account", func(t *testing.T) {
+		_, err := model.NewPaymentBatch(tenantID, uuid.Nil, valueobject.BatchFileFormatNACHA, "payroll.ach", "abc", []model.BatchEntry{entry}, time.Now())
+		assert.Error(t, err)
+	})
+}
+
+func TestPaymentBatch_Progress(t *testing.T) {
+	entry := func(seq int, status valueobject.PaymentStatus, errMsg string) model.BatchEntry {
+		return model.ReconstructBatchEntry(seq, batchInstruction("x"), uuid.New(), status, errMsg)
+	}
+	rejected := model.NewRejectedBatchEntry(4, batchInstruction("4"), "invalid routing number")
+	batchOf := func(entries ...model.BatchEntry) model.PaymentBatch {
+		return model.ReconstructPaymentBatch(uuid.New(), uuid.New(), uuid.New(), valueobject.BatchFileFormatNACHA, "f", "h", entries, time.Now())
+	}
+
+	t.Run("processing", func(t *testing.T) {
+		batch := batchOf(
+			entry(1, valueobject.PaymentStatusSettled, ""),
+			entry(2, valueobject.PaymentStatusProcessing, ""),
+			entry(3, valueobject.PaymentStatusFailed, "account closed"),
+			rejected,
+		)
+		assert.Equal(t, model.BatchProgress{Total: 4, Rejected: 1, Pending: 1, Settled: 1, Failed: 1}, batch.Progress())
+		assert.Equal(t, valueobject.BatchStatusProcessing, batch.Status())
+
+		failed := batch.FailedEntries()
+		require.Len(t, failed, 2)
+		assert.Equal(t, 3, failed[0].Sequence())
+		assert.Equal(t, "FAILED", failed[0].Status())
+		assert.Equal(t, "REJECTED", failed[1].Status())
+		assert.Equal(t, "invalid routing number", failed[1].Error())
+	})
+
+	t.Run("completed", func(t *testing.T) {
+		batch := batchOf(entry(1, valueobject.PaymentStatusSettled, ""), entry(2, valueobject.PaymentStatusSettled, ""))
+		assert.Equal(t, valueobject.BatchStatusCompleted, batch.Status())
+		assert.Empty(t, batch.FailedEntries())
+	})
+
+	t.Run("completed with errors", func(t *testing.T) {
+		batch := batchOf(entry(1, valueobject.PaymentStatusSettled, ""), entry(2, valueobject.PaymentStatusReversed, ""))
+		assert.Equal(t, valueobject.BatchStatusCompletedWithErrors, batch.Status())
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		assert.Equal(t, valueobject.BatchStatusRejected, batchOf(rejected).Status())
+	})
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -26,6 +27,7 @@ type PaymentOrder struct {
 	reference            string
 	description          string
 	failureReason        string
+	destinationCountry   string
 	amount               decimal.Decimal
 	domainEvents         []events.DomainEvent
 	version              int
@@ -36,6 +38,8 @@ type PaymentOrder struct {
 }
 
 // NewPaymentOrder creates a new payment order in INITIATED status.
+// destinationCountry is the ISO 3166 alpha-2 country the funds are sent to,
+// or empty when it is not known.
 func NewPaymentOrder(
 	tenantID uuid.UUID,
 	sourceAccountID uuid.UUID,
@@ -46,6 +50,7 @@ func NewPaymentOrder(
 	routingInfo valueobject.RoutingInfo,
 	reference string,
 	description string,
+	destinationCountry string,
 ) (PaymentOrder, error) {
 	if tenantID == uuid.Nil {
 		return PaymentOrder{}, fmt.Errorf("tenant ID is required")
@@ -78,6 +83,7 @@ func NewPaymentOrder(
 		routingInfo:          routingInfo,
 		reference:            reference,
 		description:          description,
+		destinationCountry:   strings.ToUpper(strings.TrimSpace(destinationCountry)),
 		initiatedAt:          now,
 		version:              1,
 		createdAt:            now,
@@ -85,7 +91,7 @@ func NewPaymentOrder(
 	}
 
 	order.domainEvents = append(order.domainEvents,
-		event.NewPaymentInitiated(id, tenantID, amount, currency, rail.String()),
+		event.NewPaymentInitiated(id, tenantID, sourceAccountID, destinationAccountID, amount, currency, rail.String(), description, order.destinationCountry),
 	)
 
 	return order, nil
@@ -99,7 +105,7 @@ func Reconstruct(
 	rail valueobject.PaymentRail,
 	status valueobject.PaymentStatus,
 	routingInfo valueobject.RoutingInfo,
-	reference, description, failureReason string,
+	reference, description, failureReason, destinationCountry string,
 	initiatedAt time.Time,
 	settledAt *time.Time,
 	version int,
@@ -118,6 +124,7 @@ func Reconstruct(
 		reference:            reference,
 		description:          description,
 		failureReason:        failureReason,
+		destinationCountry:   destinationCountry,
 		initiatedAt:          initiatedAt,
 		settledAt:            settledAt,
 		version:              version,
@@ -211,6 +218,7 @@ func (po PaymentOrder) RoutingInfo() valueobject.RoutingInfo { return po.routing
 func (po PaymentOrder) Reference() string                    { return po.reference }
 func (po PaymentOrder) Description() string                  { return po.description }
 func (po PaymentOrder) FailureReason() string                { return po.failureReason }
+func (po PaymentOrder) DestinationCountry() string           { return po.destinationCountry }
 func (po PaymentOrder) InitiatedAt() time.Time               { return po.initiatedAt }
 func (po PaymentOrder) SettledAt() *time.Time                { return po.settledAt }
 func (po PaymentOrder) Version() int                         { return po.version }
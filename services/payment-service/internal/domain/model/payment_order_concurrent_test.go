@@ -30,6 +30,7 @@ func createTestPaymentOrder(t *testing.T) PaymentOrder {
 		routing,
 		"REF-PAY-001",
 		"test payment",
+		"",
 	)
 	if err != nil {
 		t.Fatalf("failed to create payment order: %v", err)
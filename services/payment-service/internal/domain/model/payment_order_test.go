@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/bibbank/bib/services/payment-service/internal/domain/event"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
 )
@@ -28,6 +29,7 @@ func newTestPaymentOrder(t *testing.T) model.PaymentOrder {
 		routingInfo,
 		"REF-001",
 		"Test payment",
+		"",
 	)
 	require.NoError(t, err)
 	return order
@@ -49,6 +51,7 @@ func TestNewPaymentOrder_Valid(t *testing.T) {
 		routingInfo,
 		"REF-001",
 		"Payment for invoice",
+		" de ",
 	)
 	require.NoError(t, err)
 
@@ -64,6 +67,7 @@ func TestNewPaymentOrder_Valid(t *testing.T) {
 	assert.Equal(t, "123456789", order.RoutingInfo().ExternalAccountNumber())
 	assert.Equal(t, "REF-001", order.Reference())
 	assert.Equal(t, "Payment for invoice", order.Description())
+	assert.Equal(t, "DE", order.DestinationCountry())
 	assert.Equal(t, "", order.FailureReason())
 	assert.Nil(t, order.SettledAt())
 	assert.Equal(t, 1, order.Version())
@@ -76,6 +80,7 @@ func TestNewPaymentOrder_Valid(t *testing.T) {
 	require.Len(t, events, 1)
 	assert.Equal(t, "payment.order.initiated", events[0].EventType())
 	assert.Equal(t, order.ID().String(), events[0].AggregateID())
+	assert.Equal(t, "DE", events[0].(event.PaymentInitiated).DestinationCountry)
 }
 
 func TestNewPaymentOrder_InternalTransfer(t *testing.T) {
@@ -92,6 +97,7 @@ func TestNewPaymentOrder_InternalTransfer(t *testing.T) {
 		routingInfo,
 		"INTERNAL-001",
 		"Internal transfer",
+		"",
 	)
 	require.NoError(t, err)
 
@@ -110,6 +116,7 @@ func TestNewPaymentOrder_MissingTenantID(t *testing.T) {
 		valueobject.RailACH,
 		routingInfo,
 		"REF", "desc",
+		"",
 	)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "tenant ID is required")
@@ -126,6 +133,7 @@ func TestNewPaymentOrder_MissingSourceAccount(t *testing.T) {
 		valueobject.RailACH,
 		routingInfo,
 		"REF", "desc",
+		"",
 	)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "source account ID is required")
@@ -138,6 +146,7 @@ func TestNewPaymentOrder_NonPositiveAmount(t *testing.T) {
 		uuid.New(), uuid.New(), uuid.Nil,
 		decimal.NewFromInt(0), "USD", valueobject.RailACH,
 		routingInfo, "REF", "desc",
+		"",
 	)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "amount must be positive")
@@ -146,6 +155,7 @@ func TestNewPaymentOrder_NonPositiveAmount(t *testing.T) {
 		uuid.New(), uuid.New(), uuid.Nil,
 		decimal.NewFromInt(-100), "USD", valueobject.RailACH,
 		routingInfo, "REF", "desc",
+		"",
 	)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "amount must be positive")
@@ -157,6 +167,7 @@ func TestNewPaymentOrder_MissingCurrency(t *testing.T) {
 		uuid.New(), uuid.New(), uuid.Nil,
 		decimal.NewFromInt(100), "", valueobject.RailACH,
 		routingInfo, "REF", "desc",
+		"",
 	)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "currency is required")
@@ -168,6 +179,7 @@ func TestNewPaymentOrder_MissingRail(t *testing.T) {
 		uuid.New(), uuid.New(), uuid.Nil,
 		decimal.NewFromInt(100), "USD", valueobject.PaymentRail{},
 		routingInfo, "REF", "desc",
+		"",
 	)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "payment rail is required")
@@ -353,7 +365,7 @@ func TestPaymentOrder_Reconstruct(t *testing.T) {
 	order := model.Reconstruct(
 		id, tenantID, sourceAcctID, destAcctID,
 		amount, "EUR", valueobject.RailSEPA, valueobject.PaymentStatusSettled,
-		routingInfo, "REF-R", "Reconstructed payment", "",
+		routingInfo, "REF-R", "Reconstructed payment", "", "DE",
 		initiatedAt, &settledAt, 3, createdAt, updatedAt,
 	)
 
@@ -368,6 +380,7 @@ func TestPaymentOrder_Reconstruct(t *testing.T) {
 	assert.Equal(t, "021000021", order.RoutingInfo().RoutingNumber())
 	assert.Equal(t, "REF-R", order.Reference())
 	assert.Equal(t, "Reconstructed payment", order.Description())
+	assert.Equal(t, "DE", order.DestinationCountry())
 	assert.Equal(t, 3, order.Version())
 	assert.Equal(t, createdAt, order.CreatedAt())
 	assert.Equal(t, updatedAt, order.UpdatedAt())
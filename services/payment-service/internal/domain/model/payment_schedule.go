@@ -0,0 +1,266 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+// PaymentSchedule is a standing instruction to make a payment on a
+// recurrence, such as a monthly rent transfer. Each run is materialized into
+// a new PaymentOrder. A run missed while the scheduler was down is made
+// once, not once per missed occurrence. Like PaymentOrder it is immutable;
+// transitions return updated copies.
+type PaymentSchedule struct {
+	startAt              time.Time
+	createdAt            time.Time
+	updatedAt            time.Time
+	endAt                *time.Time
+	nextRunAt            *time.Time
+	lastRunAt            *time.Time
+	recurrence           valueobject.Recurrence
+	routingInfo          valueobject.RoutingInfo
+	status               valueobject.ScheduleStatus
+	currency             string
+	reference            string
+	description          string
+	destinationCountry   string
+	lastFailure          string
+	amount               decimal.Decimal
+	maxRuns              int
+	runCount             int
+	version              int
+	lastPaymentID        uuid.UUID
+	destinationAccountID uuid.UUID
+	sourceAccountID      uuid.UUID
+	tenantID             uuid.UUID
+	id                   uuid.UUID
+}
+
+// NewPaymentSchedule creates a new ACTIVE payment schedule whose first run
+// is the recurrence's first occurrence at or after both startAt and now.
+// endAt, when set, is the last time a run may be made; maxRuns, when
+// positive, caps the number of payments made.
+func NewPaymentSchedule(
+	tenantID uuid.UUID,
+	sourceAccountID uuid.UUID,
+	destinationAccountID uuid.UUID,
+	amount decimal.Decimal,
+	currency string,
+	routingInfo valueobject.RoutingInfo,
+	reference string,
+	description string,
+	destinationCountry string,
+	recurrence valueobject.Recurrence,
+	startAt time.Time,
+	endAt *time.Time,
+	maxRuns int,
+	now time.Time,
+) (PaymentSchedule, error) {
+	if tenantID == uuid.Nil {
+		return PaymentSchedule{}, fmt.Errorf("tenant ID is required")
+	}
+	if sourceAccountID == uuid.Nil {
+		return PaymentSchedule{}, fmt.Errorf("source account ID is required")
+	}
+	if !amount.IsPositive() {
+		return PaymentSchedule{}, fmt.Errorf("amount must be positive, got: %s", amount.String())
+	}
+	if currency == "" {
+		return PaymentSchedule{}, fmt.Errorf("currency is required")
+	}
+	if recurrence.IsZero() {
+		return PaymentSchedule{}, fmt.Errorf("recurrence is required")
+	}
+	if startAt.IsZero() {
+		return PaymentSchedule{}, fmt.Errorf("start time is required")
+	}
+	if maxRuns < 0 {
+		return PaymentSchedule{}, fmt.Errorf("max runs must not be negative, got %d", maxRuns)
+	}
+	startAt = startAt.UTC().Truncate(time.Minute)
+	if endAt != nil {
+		end := endAt.UTC()
+		if end.Before(startAt) {
+			return PaymentSchedule{}, fmt.Errorf("end time %s is before start time %s", end.Format(time.RFC3339), startAt.Format(time.RFC3339))
+		}
+		endAt = &end
+	}
+
+	from := startAt
+	if now.After(from) {
+		from = now
+	}
+	next := recurrence.Next(startAt, from.Add(-time.Nanosecond))
+	if next.IsZero() || (endAt != nil && next.After(*endAt)) {
+		return PaymentSchedule{}, fmt.Errorf("schedule has no run after %s", from.UTC().Format(time.RFC3339))
+	}
+
+	now = now.UTC()
+	return PaymentSchedule{
+		id:                   uuid.New(),
+		tenantID:             tenantID,
+		sourceAccountID:      sourceAccountID,
+		destinationAccountID: destinationAccountID,
+		amount:               amount,
+		currency:             currency,
+		routingInfo:          routingInfo,
+		reference:            reference,
+		description:          description,
+		destinationCountry:   strings.ToUpper(strings.TrimSpace(destinationCountry)),
+		recurrence:           recurrence,
+		startAt:              startAt,
+		endAt:                endAt,
+		maxRuns:              maxRuns,
+		nextRunAt:            &next,
+		status:               valueobject.ScheduleStatusActive,
+		version:              1,
+		createdAt:            now,
+		updatedAt:            now,
+	}, nil
+}
+
+// ReconstructPaymentSchedule recreates a PaymentSchedule from persistence
+// (no validation).
+func ReconstructPaymentSchedule(
+	id, tenantID, sourceAccountID, destinationAccountID uuid.UUID,
+	amount decimal.Decimal,
+	currency string,
+	routingInfo valueobject.RoutingInfo,
+	reference, description, destinationCountry string,
+	recurrence valueobject.Recurrence,
+	startAt time.Time,
+	endAt *time.Time,
+	maxRuns int,
+	status valueobject.ScheduleStatus,
+	nextRunAt *time.Time,
+	runCount int,
+	lastPaymentID uuid.UUID,
+	lastRunAt *time.Time,
+	lastFailure string,
+	version int,
+	createdAt, updatedAt time.Time,
+) PaymentSchedule {
+	return PaymentSchedule{
+		id:                   id,
+		tenantID:             tenantID,
+		sourceAccountID:      sourceAccountID,
+		destinationAccountID: destinationAccountID,
+		amount:               amount,
+		currency:             currency,
+		routingInfo:          routingInfo,
+		reference:            reference,
+		description:          description,
+		destinationCountry:   destinationCountry,
+		recurrence:           recurrence,
+		startAt:              startAt,
+		endAt:                endAt,
+		maxRuns:              maxRuns,
+		status:               status,
+		nextRunAt:            nextRunAt,
+		runCount:             runCount,
+		lastPaymentID:        lastPaymentID,
+		lastRunAt:            lastRunAt,
+		lastFailure:          lastFailure,
+		version:              version,
+		createdAt:            createdAt,
+		updatedAt:            updatedAt,
+	}
+}
+
+// IsDue returns true if the schedule is active and its next run is at or
+// before now.
+func (ps PaymentSchedule) IsDue(now time.Time) bool {
+	return ps.status == valueobject.ScheduleStatusActive && ps.nextRunAt != nil && !ps.nextRunAt.After(now)
+}
+
+// RecordRun records that the due run was made as payment paymentID and
+// advances the schedule to its next run (immutable - returns new copy).
+func (ps PaymentSchedule) RecordRun(paymentID uuid.UUID, now time.Time) (PaymentSchedule, error) {
+	if !ps.IsDue(now) {
+		return PaymentSchedule{}, fmt.Errorf("schedule %s is not due", ps.id)
+	}
+
+	updated := ps
+	updated.runCount++
+	updated.lastPaymentID = paymentID
+	updated.lastFailure = ""
+	return updated.advance(now), nil
+}
+
+// SkipRun records that the due run could not be made, such as when the
+// payment was rejected, and advances the schedule to its next run. Skipped
+// runs do not count towards the schedule's maximum (immutable - returns new
+// copy).
+func (ps PaymentSchedule) SkipRun(reason string, now time.Time) (PaymentSchedule, error) {
+	if !ps.IsDue(now) {
+		return PaymentSchedule{}, fmt.Errorf("schedule %s is not due", ps.id)
+	}
+
+	updated := ps
+	updated.lastFailure = reason
+	return updated.advance(now), nil
+}
+
+// Cancel transitions the schedule from ACTIVE to CANCELLED, so no further
+// runs are made (immutable - returns new copy).
+func (ps PaymentSchedule) Cancel(now time.Time) (PaymentSchedule, error) {
+	if ps.status != valueobject.ScheduleStatusActive {
+		return PaymentSchedule{}, fmt.Errorf("can only cancel an ACTIVE schedule, current: %s", ps.status.String())
+	}
+
+	updated := ps
+	updated.status = valueobject.ScheduleStatusCancelled
+	updated.nextRunAt = nil
+	updated.updatedAt = now
+	updated.version++
+	return updated, nil
+}
+
+// advance moves the schedule past its due run to the first occurrence after
+// now, completing it once it has no runs left.
+func (ps PaymentSchedule) advance(now time.Time) PaymentSchedule {
+	ps.lastRunAt = &now
+	ps.updatedAt = now
+	ps.version++
+
+	next := ps.recurrence.Next(ps.startAt, now)
+	if next.IsZero() || (ps.endAt != nil && next.After(*ps.endAt)) || (ps.maxRuns > 0 && ps.runCount >= ps.maxRuns) {
+		ps.status = valueobject.ScheduleStatusCompleted
+		ps.nextRunAt = nil
+		return ps
+	}
+	ps.nextRunAt = &next
+	return ps
+}
+
+// Accessors
+
+func (ps PaymentSchedule) ID() uuid.UUID                        { return ps.id }
+func (ps PaymentSchedule) TenantID() uuid.UUID                  { return ps.tenantID }
+func (ps PaymentSchedule) SourceAccountID() uuid.UUID           { return ps.sourceAccountID }
+func (ps PaymentSchedule) DestinationAccountID() uuid.UUID      { return ps.destinationAccountID }
+func (ps PaymentSchedule) Amount() decimal.Decimal              { return ps.amount }
+func (ps PaymentSchedule) Currency() string                     { return ps.currency }
+func (ps PaymentSchedule) RoutingInfo() valueobject.RoutingInfo { return ps.routingInfo }
+func (ps PaymentSchedule) Reference() string                    { return ps.reference }
+func (ps PaymentSchedule) Description() string                  { return ps.description }
+func (ps PaymentSchedule) DestinationCountry() string           { return ps.destinationCountry }
+func (ps PaymentSchedule) Recurrence() valueobject.Recurrence   { return ps.recurrence }
+func (ps PaymentSchedule) StartAt() time.Time                   { return ps.startAt }
+func (ps PaymentSchedule) EndAt() *time.Time                    { return ps.endAt }
+func (ps PaymentSchedule) MaxRuns() int                         { return ps.maxRuns }
+func (ps PaymentSchedule) Status() valueobject.ScheduleStatus   { return ps.status }
+func (ps PaymentSchedule) NextRunAt() *time.Time                { return ps.nextRunAt }
+func (ps PaymentSchedule) RunCount() int                        { return ps.runCount }
+func (ps PaymentSchedule) LastPaymentID() uuid.UUID             { return ps.lastPaymentID }
+func (ps PaymentSchedule) LastRunAt() *time.Time                { return ps.lastRunAt }
+func (ps PaymentSchedule) LastFailure() string                  { return ps.lastFailure }
+func (ps PaymentSchedule) Version() int                         { return ps.version }
+func (ps PaymentSchedule) CreatedAt() time.Time                 { return ps.createdAt }
+func (ps PaymentSchedule) UpdatedAt() time.Time                 { return ps.updatedAt }
@@ -0,0 +1,169 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+var scheduleStart = time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+
+func newTestPaymentSchedule(t *testing.T, frequency string, endAt *time.Time, maxRuns int) model.PaymentSchedule {
+	t.Helper()
+	recurrence, err := valueobject.NewRecurrence(frequency, 1, "")
+	require.NoError(t, err)
+
+	schedule, err := model.NewPaymentSchedule(
+		uuid.New(), uuid.New(), uuid.New(),
+		decimal.NewFromInt(1200), "USD", valueobject.RoutingInfo{},
+		"RENT", "Monthly rent", "",
+		recurrence, scheduleStart, endAt, maxRuns,
+		scheduleStart.Add(-24*time.Hour),
+	)
+	require.NoError(t, err)
+	return schedule
+}
+
+func TestNewPaymentSchedule(t *testing.T) {
+	t.Run("first run is the start", func(t *testing.T) {
+		schedule := newTestPaymentSchedule(t, valueobject.FrequencyMonthly, nil, 0)
+
+		assert.Equal(t, valueobject.ScheduleStatusActive, schedule.Status())
+		require.NotNil(t, schedule.NextRunAt())
+		assert.Equal(t, scheduleStart, *schedule.NextRunAt())
+		assert.Equal(t, 1, schedule.Version())
+		assert.False(t, schedule.IsDue(scheduleStart.Add(-time.Minute)))
+		assert.True(t, schedule.IsDue(scheduleStart))
+	})
+
+	t.Run("a past start runs from now", func(t *testing.T) {
+		recurrence, err := valueobject.NewRecurrence(valueobject.FrequencyDaily, 1, "")
+		require.NoError(t, err)
+
+		schedule, err := model.NewPaymentSchedule(
+			uuid.New(), uuid.New(), uuid.Nil, decimal.NewFromInt(10), "USD", valueobject.RoutingInfo{},
+			"", "", "", recurrence, scheduleStart, nil, 0, scheduleStart.Add(50*time.Hour),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, scheduleStart.Add(72*time.Hour), *schedule.NextRunAt())
+	})
+
+	t.Run("rejects a one-off payment in the past", func(t *testing.T) {
+		recurrence, err := valueobject.NewRecurrence(valueobject.FrequencyOnce, 0, "")
+		require.NoError(t, err)
+
+		_, err = model.NewPaymentSchedule(
+			uuid.New(), uuid.New(), uuid.Nil, decimal.NewFromInt(10), "USD", valueobject.RoutingInfo{},
+			"", "", "", recurrence, scheduleStart, nil, 0, scheduleStart.Add(time.Hour),
+		)
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects an end before the start", func(t *testing.T) {
+		recurrence, err := valueobject.NewRecurrence(valueobject.FrequencyDaily, 1, "")
+		require.NoError(t, err)
+		end := scheduleStart.Add(-time.Hour)
+
+		_, err = model.NewPaymentSchedule(
+			uuid.New(), uuid.New(), uuid.Nil, decimal.NewFromInt(10), "USD", valueobject.RoutingInfo{},
+			"", "", "", recurrence, scheduleStart, &end, 0, scheduleStart,
+		)
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects a non-positive amount", func(t *testing.T) {
+		recurrence, err := valueobject.NewRecurrence(valueobject.FrequencyDaily, 1, "")
+		require.NoError(t, err)
+
+		_, err = model.NewPaymentSchedule(
+			uuid.New(), uuid.New(), uuid.Nil, decimal.Zero, "USD", valueobject.RoutingInfo{},
+			"", "", "", recurrence, scheduleStart, nil, 0, scheduleStart,
+		)
+		assert.Error(t, err)
+	})
+}
+
+func TestPaymentSchedule_RecordRun(t *testing.T) {
+	t.Run("advances to the next run", func(t *testing.T) {
+		schedule := newTestPaymentSchedule(t, valueobject.FrequencyMonthly, nil, 0)
+		paymentID := uuid.New()
+
+		updated, err := schedule.RecordRun(paymentID, scheduleStart.Add(time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, 1, updated.RunCount())
+		assert.Equal(t, paymentID, updated.LastPaymentID())
+		assert.Equal(t, time.Date(2026, time.April, 2, 9, 0, 0, 0, time.UTC), *updated.NextRunAt())
+		assert.Equal(t, 2, updated.Version())
+		assert.Equal(t, 0, schedule.RunCount(), "the original is unchanged")
+	})
+
+	t.Run("missed runs are made once", func(t *testing.T) {
+		schedule := newTestPaymentSchedule(t, valueobject.FrequencyDaily, nil, 0)
+		late := scheduleStart.Add(5*24*time.Hour + time.Hour)
+
+		updated, err := schedule.RecordRun(uuid.New(), late)
+		require.NoError(t, err)
+		assert.Equal(t, scheduleStart.Add(6*24*time.Hour), *updated.NextRunAt())
+		assert.False(t, updated.IsDue(late))
+	})
+
+	t.Run("completes after max runs", func(t *testing.T) {
+		schedule := newTestPaymentSchedule(t, valueobject.FrequencyDaily, nil, 2)
+
+		schedule, err := schedule.RecordRun(uuid.New(), scheduleStart)
+		require.NoError(t, err)
+		assert.Equal(t, valueobject.ScheduleStatusActive, schedule.Status())
+
+		schedule, err = schedule.RecordRun(uuid.New(), scheduleStart.Add(24*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, valueobject.ScheduleStatusCompleted, schedule.Status())
+		assert.Nil(t, schedule.NextRunAt())
+	})
+
+	t.Run("completes at the end time", func(t *testing.T) {
+		end := scheduleStart.Add(36 * time.Hour)
+		schedule := newTestPaymentSchedule(t, valueobject.FrequencyDaily, &end, 0)
+
+		schedule, err := schedule.RecordRun(uuid.New(), scheduleStart.Add(24*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, valueobject.ScheduleStatusCompleted, schedule.Status())
+	})
+
+	t.Run("fails when not due", func(t *testing.T) {
+		schedule := newTestPaymentSchedule(t, valueobject.FrequencyDaily, nil, 0)
+
+		_, err := schedule.RecordRun(uuid.New(), scheduleStart.Add(-time.Minute))
+		assert.Error(t, err)
+	})
+}
+
+func TestPaymentSchedule_SkipRun(t *testing.T) {
+	schedule := newTestPaymentSchedule(t, valueobject.FrequencyDaily, nil, 1)
+
+	skipped, err := schedule.SkipRun("rejected", scheduleStart)
+	require.NoError(t, err)
+	assert.Equal(t, "rejected", skipped.LastFailure())
+	assert.Equal(t, 0, skipped.RunCount())
+	assert.Equal(t, valueobject.ScheduleStatusActive, skipped.Status(), "skipped runs do not count towards max runs")
+	assert.Equal(t, scheduleStart.Add(24*time.Hour), *skipped.NextRunAt())
+}
+
+func TestPaymentSchedule_Cancel(t *testing.T) {
+	schedule := newTestPaymentSchedule(t, valueobject.FrequencyDaily, nil, 0)
+
+	cancelled, err := schedule.Cancel(scheduleStart)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.ScheduleStatusCancelled, cancelled.Status())
+	assert.Nil(t, cancelled.NextRunAt())
+	assert.False(t, cancelled.IsDue(scheduleStart))
+
+	_, err = cancelled.Cancel(scheduleStart)
+	assert.Error(t, err)
+}
@@ -2,6 +2,8 @@ package port
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -23,6 +25,64 @@ type PaymentOrderRepository interface {
 	ListByTenant(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]model.PaymentOrder, int, error)
 }
 
+// ErrScheduleConflict is returned when saving a payment schedule that was
+// changed since it was loaded, such as by another scheduler replica.
+var ErrScheduleConflict = errors.New("payment schedule was modified concurrently")
+
+// PaymentScheduleRepository defines persistence operations for payment schedules.
+type PaymentScheduleRepository interface {
+	// Save persists a payment schedule (insert or update). Updates fail if
+	// the schedule was changed since it was loaded.
+	Save(ctx context.Context, schedule model.PaymentSchedule) error
+	// SaveRun atomically persists a schedule advanced past a run together
+	// with the payment order the run created and its outbox events, so a
+	// run is never made twice.
+	SaveRun(ctx context.Context, schedule model.PaymentSchedule, order model.PaymentOrder) error
+	// FindByID retrieves a payment schedule by its unique identifier.
+	FindByID(ctx context.Context, id uuid.UUID) (model.PaymentSchedule, error)
+	// ListByTenant returns payment schedules for a given tenant with pagination.
+	ListByTenant(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]model.PaymentSchedule, int, error)
+	// ListDue returns up to limit active schedules whose next run is at or
+	// before now, earliest first.
+	ListDue(ctx context.Context, now time.Time, limit int) ([]model.PaymentSchedule, error)
+}
+
+// ErrDuplicateBatch is returned when saving a payment batch for a file the
+// tenant already imported.
+var ErrDuplicateBatch = errors.New("payment batch file already imported")
+
+// PaymentBatchRepository defines persistence operations for payment batches.
+type PaymentBatchRepository interface {
+	// Save atomically persists a new payment batch together with the
+	// payment orders of its accepted entries and their outbox events. It
+	// returns ErrDuplicateBatch if the tenant already imported a file with
+	// the same hash.
+	Save(ctx context.Context, batch model.PaymentBatch, orders []model.PaymentOrder) error
+	// FindByID retrieves a payment batch by its unique identifier, with each
+	// accepted entry reporting its payment order's current status.
+	FindByID(ctx context.Context, id uuid.UUID) (model.PaymentBatch, error)
+}
+
+// ErrInvalidBatchFile is returned when a bulk payment file is malformed as a
+// whole, such as when its control totals do not match its entries.
+var ErrInvalidBatchFile = errors.New("invalid batch file")
+
+// BatchFileEntry is an instruction read from a bulk payment file. Err is set
+// when the instruction is unusable, such as a debit in a file of credits;
+// the rest of the file is still imported.
+type BatchFileEntry struct {
+	Err         error
+	Instruction model.BatchInstruction
+}
+
+// BatchFileParser reads the payment instructions of bulk payment files.
+type BatchFileParser interface {
+	// Parse returns the instructions of content, a file in the given
+	// format, in file order. It returns an error wrapping
+	// ErrInvalidBatchFile if the file cannot be imported at all.
+	Parse(format valueobject.BatchFileFormat, content []byte) ([]BatchFileEntry, error)
+}
+
 // RailAdapter is the port for payment rail adapters (ACH, SWIFT, etc.).
 type RailAdapter interface {
 	// Submit sends a payment order to the external payment rail for processing.
@@ -42,3 +102,30 @@ type FraudClient interface {
 	// Returns true if the transaction is approved, false if it is flagged/rejected.
 	AssessTransaction(ctx context.Context, tenantID, accountID uuid.UUID, amount decimal.Decimal, currency string) (bool, error)
 }
+
+// AccountClient is the port for the account service.
+type AccountClient interface {
+	// LedgerAccountCode returns the code of the ledger account backing a customer account.
+	LedgerAccountCode(ctx context.Context, tenantID, accountID uuid.UUID) (string, error)
+}
+
+// LedgerEntry is a single debit/credit pair posted to the ledger. Reference
+// identifies the entry: posting an entry again with the same reference
+// returns the first posting instead of posting twice.
+type LedgerEntry struct {
+	// EffectiveDate is the day the entry takes effect; zero means today.
+	EffectiveDate time.Time
+	Amount        decimal.Decimal
+	DebitAccount  string
+	CreditAccount string
+	Currency      string
+	Reference     string
+	Description   string
+	TenantID      uuid.UUID
+}
+
+// LedgerClient is the port for posting journal entries to the ledger service.
+type LedgerClient interface {
+	// PostEntry posts a journal entry and returns its identifier.
+	PostEntry(ctx context.Context, entry LedgerEntry) (string, error)
+}
@@ -56,6 +56,28 @@ func (e *RoutingEngine) SelectRail(
 	}
 }
 
+// SelectRailFor determines the rail of a payment to the beneficiary of
+// routing. Without a destination country it routes by the country of the
+// beneficiary's IBAN or bank BIC, so a payment to a foreign account is not
+// sent on a domestic rail, and a USD payment to a bank identified only by
+// BIC, which ACH cannot clear, goes via SWIFT.
+func (e *RoutingEngine) SelectRailFor(
+	amount decimal.Decimal,
+	currency string,
+	isInternal bool,
+	destinationCountry string,
+	routing valueobject.RoutingInfo,
+) valueobject.PaymentRail {
+	if destinationCountry == "" {
+		destinationCountry = routing.Country()
+	}
+	rail := e.SelectRail(amount, currency, isInternal, destinationCountry)
+	if rail == valueobject.RailACH && !routing.BIC().IsZero() {
+		return valueobject.RailSWIFT
+	}
+	return rail
+}
+
 // isEurozone returns true if the country code belongs to a Eurozone member state.
 func isEurozone(country string) bool {
 	eurozoneCountries := map[string]bool{
@@ -5,6 +5,7 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/bibbank/bib/services/payment-service/internal/domain/service"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
@@ -94,3 +95,36 @@ func TestRoutingEngine_SelectRail_OtherCurrencies(t *testing.T) {
 		})
 	}
 }
+
+func TestRoutingEngine_SelectRailFor_BeneficiaryCountry(t *testing.T) {
+	engine := service.NewRoutingEngine()
+	amount := decimal.NewFromInt(1000)
+
+	tests := []struct {
+		name          string
+		currency      string
+		country       string
+		routingNumber string
+		account       string
+		expected      valueobject.PaymentRail
+	}{
+		{"USD by ABA", "USD", "", "021000021", "123456789", valueobject.RailACH},
+		{"USD to US bank by BIC", "USD", "", "BOFAUS3N", "123456789", valueobject.RailSWIFT},
+		{"USD to German IBAN", "USD", "", "DEUTDEFF", "DE89370400440532013000", valueobject.RailSWIFT},
+		{"EUR to German IBAN", "EUR", "", "DEUTDEFF", "DE89370400440532013000", valueobject.RailSEPA},
+		{"EUR to UK IBAN", "EUR", "", "NWBKGB2L", "GB82WEST12345698765432", valueobject.RailSWIFT},
+		{"EUR to Japanese bank", "EUR", "", "BOTKJPJT", "1234567", valueobject.RailSWIFT},
+		{"destination country wins", "EUR", "FR", "NWBKGB2L", "GB82WEST12345698765432", valueobject.RailSEPA},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			routing, err := valueobject.NewRoutingInfo(tc.routingNumber, tc.account)
+			require.NoError(t, err)
+			rail := engine.SelectRailFor(amount, tc.currency, false, tc.country, routing)
+			assert.Equal(t, tc.expected, rail)
+		})
+	}
+
+	rail := engine.SelectRailFor(amount, "USD", true, "", valueobject.RoutingInfo{})
+	assert.Equal(t, valueobject.RailInternal, rail)
+}
@@ -0,0 +1,39 @@
+package valueobject
+
+import "fmt"
+
+// BatchFileFormat is the format of a bulk payment file.
+type BatchFileFormat struct {
+	value string
+}
+
+var (
+	// BatchFileFormatNACHA is a NACHA ACH file of fixed-width records.
+	BatchFileFormatNACHA = BatchFileFormat{"NACHA"}
+	// BatchFileFormatPain001 is an ISO 20022 pain.001 customer credit
+	// transfer initiation.
+	BatchFileFormatPain001 = BatchFileFormat{"PAIN001"}
+)
+
+var validBatchFileFormats = map[string]BatchFileFormat{
+	"NACHA":   BatchFileFormatNACHA,
+	"PAIN001": BatchFileFormatPain001,
+}
+
+// NewBatchFileFormat validates and creates a BatchFileFormat from a string.
+func NewBatchFileFormat(s string) (BatchFileFormat, error) {
+	if format, ok := validBatchFileFormats[s]; ok {
+		return format, nil
+	}
+	return BatchFileFormat{}, fmt.Errorf("invalid batch file format: %q", s)
+}
+
+// String returns the string representation of the batch file format.
+func (f BatchFileFormat) String() string {
+	return f.value
+}
+
+// IsZero returns true if the batch file format is uninitialized.
+func (f BatchFileFormat) IsZero() bool {
+	return f.value == ""
+}
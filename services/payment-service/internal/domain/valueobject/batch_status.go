@@ -0,0 +1,29 @@
+package valueobject
+
+// BatchStatus represents the progress of a payment batch. It is derived
+// from the statuses of the batch's entries rather than stored.
+type BatchStatus struct {
+	value string
+}
+
+var (
+	// BatchStatusProcessing is a batch with payments still in flight.
+	BatchStatusProcessing = BatchStatus{"PROCESSING"}
+	// BatchStatusCompleted is a batch whose payments all settled.
+	BatchStatusCompleted = BatchStatus{"COMPLETED"}
+	// BatchStatusCompletedWithErrors is a finished batch with rejected
+	// entries or failed payments.
+	BatchStatusCompletedWithErrors = BatchStatus{"COMPLETED_WITH_ERRORS"}
+	// BatchStatusRejected is a batch none of whose entries was accepted.
+	BatchStatusRejected = BatchStatus{"REJECTED"}
+)
+
+// String returns the string representation of the batch status.
+func (s BatchStatus) String() string {
+	return s.value
+}
+
+// IsZero returns true if the batch status is uninitialized.
+func (s BatchStatus) IsZero() bool {
+	return s.value == ""
+}
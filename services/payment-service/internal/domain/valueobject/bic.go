@@ -0,0 +1,43 @@
+package valueobject
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// BIC is an ISO 9362 Business Identifier Code (SWIFT code): a 4-letter
+// institution code, 2-letter country code, 2-character location code and
+// optional 3-character branch code.
+type BIC struct {
+	value string
+}
+
+var bicPattern = regexp.MustCompile(`^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+
+// NewBIC validates and creates a BIC. Letters are upper-cased.
+func NewBIC(s string) (BIC, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if !bicPattern.MatchString(s) {
+		return BIC{}, fmt.Errorf("invalid BIC: %q", s)
+	}
+	return BIC{value: s}, nil
+}
+
+// String returns the BIC as given, 8 or 11 characters.
+func (b BIC) String() string {
+	return b.value
+}
+
+// Country returns the ISO 3166 country code of the institution.
+func (b BIC) Country() string {
+	if b.value == "" {
+		return ""
+	}
+	return b.value[4:6]
+}
+
+// IsZero returns true if the BIC is uninitialized.
+func (b BIC) IsZero() bool {
+	return b.value == ""
+}
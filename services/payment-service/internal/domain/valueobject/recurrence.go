@@ -0,0 +1,135 @@
+package valueobject
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/pkg/cron"
+)
+
+// Frequencies of a payment schedule's recurrence.
+const (
+	FrequencyOnce    = "ONCE"
+	FrequencyDaily   = "DAILY"
+	FrequencyWeekly  = "WEEKLY"
+	FrequencyMonthly = "MONTHLY"
+	FrequencyCron    = "CRON"
+)
+
+// Recurrence is the rule by which a payment schedule repeats: once, every
+// interval days, weeks or months counted from the schedule's start, or at
+// the times of a cron expression. Monthly runs keep the start's day of the
+// month, falling on the last day of shorter months. Times are in UTC. It is
+// an immutable value object.
+type Recurrence struct {
+	cron      cron.Schedule
+	frequency string
+	interval  int
+}
+
+// NewRecurrence validates and creates a Recurrence. interval applies to the
+// daily, weekly and monthly frequencies and defaults to 1; cronExpr applies
+// to the cron frequency only.
+func NewRecurrence(frequency string, interval int, cronExpr string) (Recurrence, error) {
+	frequency = strings.ToUpper(strings.TrimSpace(frequency))
+	switch frequency {
+	case FrequencyOnce:
+		return Recurrence{frequency: frequency}, nil
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly:
+		if interval < 0 {
+			return Recurrence{}, fmt.Errorf("recurrence interval must not be negative, got %d", interval)
+		}
+		return Recurrence{frequency: frequency, interval: max(interval, 1)}, nil
+	case FrequencyCron:
+		schedule, err := cron.Parse(cronExpr)
+		if err != nil {
+			return Recurrence{}, fmt.Errorf("invalid recurrence: %w", err)
+		}
+		return Recurrence{frequency: frequency, cron: schedule}, nil
+	default:
+		return Recurrence{}, fmt.Errorf("invalid recurrence frequency: %q", frequency)
+	}
+}
+
+// Next returns the first run strictly after after of a schedule starting
+// at start, or the zero time when there is none. A cron recurrence first
+// runs at its first time at or after start.
+func (r Recurrence) Next(start, after time.Time) time.Time {
+	start, after = start.UTC(), after.UTC()
+	switch r.frequency {
+	case FrequencyOnce:
+		if start.After(after) {
+			return start
+		}
+		return time.Time{}
+	case FrequencyCron:
+		if after.Before(start) {
+			return r.cron.Next(start.Add(-time.Nanosecond))
+		}
+		return r.cron.Next(after)
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly:
+		n := 0
+		if after.After(start) {
+			n = r.runsBefore(start, after)
+		}
+		for ; ; n++ {
+			if t := r.run(start, n); t.After(after) {
+				return t
+			}
+		}
+	default:
+		return time.Time{}
+	}
+}
+
+// runsBefore estimates, from below, how many runs of a schedule starting at
+// start fall at or before after.
+func (r Recurrence) runsBefore(start, after time.Time) int {
+	switch r.frequency {
+	case FrequencyDaily:
+		return int(after.Sub(start) / (time.Duration(r.interval) * 24 * time.Hour))
+	case FrequencyWeekly:
+		return int(after.Sub(start) / (time.Duration(r.interval) * 7 * 24 * time.Hour))
+	default:
+		months := (after.Year()-start.Year())*12 + int(after.Month()-start.Month())
+		return max(months/r.interval-1, 0)
+	}
+}
+
+// run returns the nth run, counting from 0, of a schedule starting at start.
+func (r Recurrence) run(start time.Time, n int) time.Time {
+	switch r.frequency {
+	case FrequencyDaily:
+		return start.AddDate(0, 0, n*r.interval)
+	case FrequencyWeekly:
+		return start.AddDate(0, 0, 7*n*r.interval)
+	default:
+		// AddDate would roll January 31st plus a month into March.
+		first := time.Date(start.Year(), start.Month()+time.Month(n*r.interval), 1,
+			start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), time.UTC)
+		lastDay := first.AddDate(0, 1, -1).Day()
+		return first.AddDate(0, 0, min(start.Day(), lastDay)-1)
+	}
+}
+
+// Frequency returns the recurrence's frequency.
+func (r Recurrence) Frequency() string {
+	return r.frequency
+}
+
+// Interval returns the number of days, weeks or months between runs, or 0
+// for the once and cron frequencies.
+func (r Recurrence) Interval() int {
+	return r.interval
+}
+
+// CronExpression returns the cron expression of a cron recurrence.
+func (r Recurrence) CronExpression() string {
+	return r.cron.String()
+}
+
+// IsZero returns true if the recurrence is uninitialized.
+func (r Recurrence) IsZero() bool {
+	return r.frequency == ""
+}
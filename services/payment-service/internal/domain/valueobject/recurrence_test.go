@@ -0,0 +1,89 @@
+package valueobject_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+func TestNewRecurrence(t *testing.T) {
+	t.Run("defaults the interval to 1", func(t *testing.T) {
+		r, err := valueobject.NewRecurrence("weekly", 0, "")
+		require.NoError(t, err)
+		assert.Equal(t, valueobject.FrequencyWeekly, r.Frequency())
+		assert.Equal(t, 1, r.Interval())
+		assert.False(t, r.IsZero())
+	})
+
+	t.Run("parses the cron expression", func(t *testing.T) {
+		r, err := valueobject.NewRecurrence("CRON", 0, "0 9 1 * *")
+		require.NoError(t, err)
+		assert.Equal(t, "0 9 1 * *", r.CronExpression())
+	})
+
+	invalid := []struct {
+		name      string
+		frequency string
+		cron      string
+		interval  int
+	}{
+		{"unknown frequency", "FORTNIGHTLY", "", 0},
+		{"negative interval", "DAILY", "", -1},
+		{"invalid cron expression", "CRON", "0 9 * *", 0},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := valueobject.NewRecurrence(tc.frequency, tc.interval, tc.cron)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRecurrence_Next(t *testing.T) {
+	date := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+	start := date(2026, time.January, 31, 9, 0)
+
+	tests := []struct {
+		name      string
+		frequency string
+		cron      string
+		interval  int
+		after     time.Time
+		want      time.Time
+	}{
+		{"once before start", "ONCE", "", 0, start.Add(-time.Minute), start},
+		{"once after start", "ONCE", "", 0, start, time.Time{}},
+		{"daily first run", "DAILY", "", 1, start.Add(-time.Nanosecond), start},
+		{"daily after a run", "DAILY", "", 1, start, date(2026, time.February, 1, 9, 0)},
+		{"every third day", "DAILY", "", 3, date(2026, time.February, 10, 12, 0), date(2026, time.February, 12, 9, 0)},
+		{"fortnightly", "WEEKLY", "", 2, start, date(2026, time.February, 14, 9, 0)},
+		{"monthly clamps to the month's end", "MONTHLY", "", 1, start, date(2026, time.February, 28, 9, 0)},
+		{"monthly keeps the start's day", "MONTHLY", "", 1, date(2026, time.February, 28, 9, 0), date(2026, time.March, 31, 9, 0)},
+		{"quarterly", "MONTHLY", "", 3, date(2026, time.May, 1, 0, 0), date(2026, time.July, 31, 9, 0)},
+		{"cron fires at start", "CRON", "0 9 * * 6", 0, start.Add(-time.Hour), start},
+		{"cron after start", "CRON", "0 9 * * 6", 0, start, date(2026, time.February, 7, 9, 0)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := valueobject.NewRecurrence(tc.frequency, tc.interval, tc.cron)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, r.Next(start, tc.after))
+		})
+	}
+}
+
+func TestNewScheduleStatus(t *testing.T) {
+	for _, s := range []string{"ACTIVE", "COMPLETED", "CANCELLED"} {
+		status, err := valueobject.NewScheduleStatus(s)
+		require.NoError(t, err)
+		assert.Equal(t, s, status.String())
+	}
+	_, err := valueobject.NewScheduleStatus("PAUSED")
+	assert.Error(t, err)
+}
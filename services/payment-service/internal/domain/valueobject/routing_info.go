@@ -5,35 +5,56 @@ import (
 	"regexp"
 )
 
-// RoutingInfo holds the external routing details for a payment.
+// RoutingInfo holds the external routing details for a payment: the
+// beneficiary's bank, by ABA routing number for US domestic payments or by
+// BIC for SWIFT payments, and the beneficiary's account number or IBAN.
 type RoutingInfo struct {
 	routingNumber         string
 	externalAccountNumber string
+	bic                   BIC
+	iban                  IBAN
 }
 
 var routingNumberPattern = regexp.MustCompile(`^\d{9}$`)
 
 // NewRoutingInfo validates and creates a RoutingInfo value object.
-// The routing number must be exactly 9 digits (ABA routing number format for ACH).
-// The external account number must not be empty.
+// The routing number must be exactly 9 digits (ABA routing number format for
+// ACH) or a BIC. The external account number must not be empty when a
+// routing number is given, and is validated as an IBAN when it has the
+// shape of one.
 func NewRoutingInfo(routingNumber, accountNumber string) (RoutingInfo, error) {
 	if routingNumber == "" && accountNumber == "" {
 		// Empty routing info is valid for internal transfers.
 		return RoutingInfo{}, nil
 	}
+	info := RoutingInfo{
+		routingNumber:         routingNumber,
+		externalAccountNumber: accountNumber,
+	}
 	if routingNumber != "" && !routingNumberPattern.MatchString(routingNumber) {
-		return RoutingInfo{}, fmt.Errorf("routing number must be exactly 9 digits, got: %q", routingNumber)
+		bic, err := NewBIC(routingNumber)
+		if err != nil {
+			return RoutingInfo{}, fmt.Errorf("routing number must be exactly 9 digits or a BIC, got: %q", routingNumber)
+		}
+		info.bic = bic
+		info.routingNumber = bic.String()
 	}
 	if routingNumber != "" && accountNumber == "" {
 		return RoutingInfo{}, fmt.Errorf("external account number is required when routing number is provided")
 	}
-	return RoutingInfo{
-		routingNumber:         routingNumber,
-		externalAccountNumber: accountNumber,
-	}, nil
+	if LooksLikeIBAN(accountNumber) {
+		iban, err := NewIBAN(accountNumber)
+		if err != nil {
+			return RoutingInfo{}, err
+		}
+		info.iban = iban
+		info.externalAccountNumber = iban.String()
+	}
+	return info, nil
 }
 
-// RoutingNumber returns the ABA routing number.
+// RoutingNumber returns the ABA routing number, or the BIC for SWIFT
+// payments.
 func (r RoutingInfo) RoutingNumber() string {
 	return r.routingNumber
 }
@@ -43,6 +64,36 @@ func (r RoutingInfo) ExternalAccountNumber() string {
 	return r.externalAccountNumber
 }
 
+// ABARoutingNumber returns the ABA routing number, empty if the bank is
+// identified by BIC.
+func (r RoutingInfo) ABARoutingNumber() string {
+	if !r.bic.IsZero() {
+		return ""
+	}
+	return r.routingNumber
+}
+
+// BIC returns the BIC of the beneficiary's bank, zero if it is identified
+// by ABA routing number.
+func (r RoutingInfo) BIC() BIC {
+	return r.bic
+}
+
+// IBAN returns the beneficiary's IBAN, zero if the account number is not
+// one.
+func (r RoutingInfo) IBAN() IBAN {
+	return r.iban
+}
+
+// Country returns the country of the beneficiary's account, from its IBAN
+// or else its bank's BIC, or empty if neither is known.
+func (r RoutingInfo) Country() string {
+	if !r.iban.IsZero() {
+		return r.iban.Country()
+	}
+	return r.bic.Country()
+}
+
 // IsEmpty returns true if the routing info has no routing details.
 func (r RoutingInfo) IsEmpty() bool {
 	return r.routingNumber == "" && r.externalAccountNumber == ""
@@ -0,0 +1,91 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+func TestNewBIC(t *testing.T) {
+	for _, input := range []string{"DEUTDEFF", "DEUTDEFF500", "natwgb2l", " BOFAUS3NXXX "} {
+		t.Run(input, func(t *testing.T) {
+			bic, err := valueobject.NewBIC(input)
+			require.NoError(t, err)
+			assert.False(t, bic.IsZero())
+		})
+	}
+
+	bic, err := valueobject.NewBIC("natwgb2l")
+	require.NoError(t, err)
+	assert.Equal(t, "NATWGB2L", bic.String())
+	assert.Equal(t, "GB", bic.Country())
+
+	for _, input := range []string{"", "DEUTDEF", "DEUTDEFF5", "DEUTDEFF50", "1EUTDEFF", "DEUT1EFF", "DEUTDE-F"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := valueobject.NewBIC(input)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewIBAN(t *testing.T) {
+	valid := map[string]string{
+		"DE89370400440532013000":         "DE",
+		"GB82 WEST 1234 5698 7654 32":    "GB",
+		"nl91abna0417164300":             "NL",
+		"FR1420041010050500013M02606":    "FR",
+		"MU17BOMM0101101030300200000MUR": "MU",
+	}
+	for input, country := range valid {
+		t.Run(input, func(t *testing.T) {
+			iban, err := valueobject.NewIBAN(input)
+			require.NoError(t, err)
+			assert.Equal(t, country, iban.Country())
+			assert.NotContains(t, iban.String(), " ")
+		})
+	}
+
+	invalid := []string{
+		"",
+		"DE89370400440532013001", // check digits
+		"DE8937040044053201300",  // length for DE
+		"GB82WEST1234569876543X", // check digits
+		"D989370400440532013000", // format
+	}
+	for _, input := range invalid {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := valueobject.NewIBAN(input)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewRoutingInfo_SWIFT(t *testing.T) {
+	info, err := valueobject.NewRoutingInfo("deutdeff", "DE89 3704 0044 0532 0130 00")
+	require.NoError(t, err)
+	assert.Equal(t, "DEUTDEFF", info.RoutingNumber())
+	assert.Equal(t, "DEUTDEFF", info.BIC().String())
+	assert.Empty(t, info.ABARoutingNumber())
+	assert.Equal(t, "DE89370400440532013000", info.ExternalAccountNumber())
+	assert.Equal(t, "DE", info.Country())
+
+	// A BIC with a domestic account number takes its country from the BIC.
+	info, err = valueobject.NewRoutingInfo("BOTKJPJT", "1234567")
+	require.NoError(t, err)
+	assert.True(t, info.IBAN().IsZero())
+	assert.Equal(t, "JP", info.Country())
+
+	info, err = valueobject.NewRoutingInfo("021000021", "123456789")
+	require.NoError(t, err)
+	assert.Equal(t, "021000021", info.ABARoutingNumber())
+	assert.True(t, info.BIC().IsZero())
+	assert.Empty(t, info.Country())
+
+	_, err = valueobject.NewRoutingInfo("DEUTDEFF", "DE89370400440532013001")
+	assert.Error(t, err)
+	_, err = valueobject.NewRoutingInfo("12345", "123456789")
+	assert.Error(t, err)
+}
@@ -0,0 +1,38 @@
+package valueobject
+
+import "fmt"
+
+// ScheduleStatus represents the lifecycle state of a payment schedule.
+type ScheduleStatus struct {
+	value string
+}
+
+var (
+	ScheduleStatusActive    = ScheduleStatus{"ACTIVE"}
+	ScheduleStatusCompleted = ScheduleStatus{"COMPLETED"}
+	ScheduleStatusCancelled = ScheduleStatus{"CANCELLED"}
+)
+
+var validScheduleStatuses = map[string]ScheduleStatus{
+	"ACTIVE":    ScheduleStatusActive,
+	"COMPLETED": ScheduleStatusCompleted,
+	"CANCELLED": ScheduleStatusCancelled,
+}
+
+// NewScheduleStatus validates and creates a ScheduleStatus from a string.
+func NewScheduleStatus(s string) (ScheduleStatus, error) {
+	if status, ok := validScheduleStatuses[s]; ok {
+		return status, nil
+	}
+	return ScheduleStatus{}, fmt.Errorf("invalid schedule status: %q", s)
+}
+
+// String returns the string representation of the schedule status.
+func (s ScheduleStatus) String() string {
+	return s.value
+}
+
+// IsZero returns true if the schedule status is uninitialized.
+func (s ScheduleStatus) IsZero() bool {
+	return s.value == ""
+}
@@ -0,0 +1,52 @@
+// Package adapter holds the payment rail adapters, one package per rail,
+// and the Router that hands each payment to the adapter of its rail.
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+// Compile-time interface check.
+var _ port.RailAdapter = (*Router)(nil)
+
+// Router is a RailAdapter that submits each payment through the adapter of
+// its rail, and through the fallback adapter for rails without one.
+type Router struct {
+	orders   port.PaymentOrderRepository
+	fallback port.RailAdapter
+	rails    map[valueobject.PaymentRail]port.RailAdapter
+}
+
+// NewRouter creates a Router. orders is used to find the rail of the
+// payments whose status is queried.
+func NewRouter(orders port.PaymentOrderRepository, fallback port.RailAdapter, rails map[valueobject.PaymentRail]port.RailAdapter) *Router {
+	return &Router{orders: orders, fallback: fallback, rails: rails}
+}
+
+// Submit submits the order through the adapter of its rail.
+func (r *Router) Submit(ctx context.Context, order model.PaymentOrder) error {
+	return r.adapterFor(order.Rail()).Submit(ctx, order)
+}
+
+// GetStatus queries the adapter of the payment's rail.
+func (r *Router) GetStatus(ctx context.Context, orderID uuid.UUID) (valueobject.PaymentStatus, string, error) {
+	order, err := r.orders.FindByID(ctx, orderID)
+	if err != nil {
+		return valueobject.PaymentStatus{}, "", fmt.Errorf("failed to find payment %s: %w", orderID, err)
+	}
+	return r.adapterFor(order.Rail()).GetStatus(ctx, orderID)
+}
+
+func (r *Router) adapterFor(rail valueobject.PaymentRail) port.RailAdapter {
+	if a, ok := r.rails[rail]; ok {
+		return a
+	}
+	return r.fallback
+}
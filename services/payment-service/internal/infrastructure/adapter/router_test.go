@@ -0,0 +1,85 @@
+package adapter_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/payment-service/internal/infrastructure/adapter"
+)
+
+type recordingAdapter struct {
+	status    valueobject.PaymentStatus
+	submitted []uuid.UUID
+}
+
+func (a *recordingAdapter) Submit(_ context.Context, order model.PaymentOrder) error {
+	a.submitted = append(a.submitted, order.ID())
+	return nil
+}
+
+func (a *recordingAdapter) GetStatus(context.Context, uuid.UUID) (valueobject.PaymentStatus, string, error) {
+	return a.status, "", nil
+}
+
+type orderStore map[uuid.UUID]model.PaymentOrder
+
+func (s orderStore) Save(_ context.Context, order model.PaymentOrder) error {
+	s[order.ID()] = order
+	return nil
+}
+
+func (s orderStore) FindByID(_ context.Context, id uuid.UUID) (model.PaymentOrder, error) {
+	return s[id], nil
+}
+
+func (s orderStore) ListByAccount(context.Context, uuid.UUID, int, int) ([]model.PaymentOrder, int, error) {
+	return nil, 0, nil
+}
+
+func (s orderStore) ListByTenant(context.Context, uuid.UUID, int, int) ([]model.PaymentOrder, int, error) {
+	return nil, 0, nil
+}
+
+func newOrder(t *testing.T, rail valueobject.PaymentRail) model.PaymentOrder {
+	t.Helper()
+	routing, err := valueobject.NewRoutingInfo("021000021", "123456789")
+	require.NoError(t, err)
+	order, err := model.NewPaymentOrder(uuid.New(), uuid.New(), uuid.Nil, decimal.NewFromInt(100),
+		"USD", rail, routing, "REF", "", "")
+	require.NoError(t, err)
+	return order
+}
+
+func TestRouter(t *testing.T) {
+	ctx := context.Background()
+	ach := &recordingAdapter{status: valueobject.PaymentStatusSettled}
+	swift := &recordingAdapter{status: valueobject.PaymentStatusProcessing}
+	orders := orderStore{}
+	router := adapter.NewRouter(orders, ach, map[valueobject.PaymentRail]port.RailAdapter{
+		valueobject.RailSWIFT: swift,
+	})
+
+	achOrder := newOrder(t, valueobject.RailACH)
+	swiftOrder := newOrder(t, valueobject.RailSWIFT)
+	for _, order := range []model.PaymentOrder{achOrder, swiftOrder} {
+		require.NoError(t, orders.Save(ctx, order))
+		require.NoError(t, router.Submit(ctx, order))
+	}
+	assert.Equal(t, []uuid.UUID{achOrder.ID()}, ach.submitted)
+	assert.Equal(t, []uuid.UUID{swiftOrder.ID()}, swift.submitted)
+
+	status, _, err := router.GetStatus(ctx, swiftOrder.ID())
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.PaymentStatusProcessing, status)
+	status, _, err = router.GetStatus(ctx, achOrder.ID())
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.PaymentStatusSettled, status)
+}
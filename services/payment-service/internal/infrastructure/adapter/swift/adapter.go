@@ -0,0 +1,97 @@
+package swift
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+// Compile-time interface check.
+var _ port.RailAdapter = (*Adapter)(nil)
+
+// Config configures the SWIFT adapter. OutboxDir is the directory the
+// bank's SWIFT connectivity (such as Alliance Lite2 AutoClient) picks
+// pain.001 files up from; when it is empty messages are rendered and logged
+// only.
+type Config struct {
+	Debtor    Debtor
+	OutboxDir string
+}
+
+// Adapter is the SWIFT rail adapter. It renders each payment as an ISO
+// 20022 pain.001 message and drops it in the outbox directory for
+// transmission over SWIFT.
+type Adapter struct {
+	logger *slog.Logger
+	now    func() time.Time
+	cfg    Config
+}
+
+// NewAdapter creates a SWIFT adapter. The debtor's BIC is required.
+func NewAdapter(cfg Config, logger *slog.Logger) (*Adapter, error) {
+	if cfg.Debtor.BIC.IsZero() {
+		return nil, fmt.Errorf("swift: debtor BIC is required")
+	}
+	return &Adapter{logger: logger, now: time.Now, cfg: cfg}, nil
+}
+
+// Submit renders the payment as a pain.001 message and writes it to the
+// outbox as <message id>.xml. The file is written under a temporary name
+// and renamed, so the connectivity never picks up a partial message, and
+// resubmitting a payment replaces its message rather than duplicating it.
+func (a *Adapter) Submit(ctx context.Context, order model.PaymentOrder) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	msg, err := RenderPain001(order, a.cfg.Debtor, a.now())
+	if err != nil {
+		return fmt.Errorf("swift: %w", err)
+	}
+
+	if a.cfg.OutboxDir == "" {
+		a.logger.Info("SWIFT: rendered pain.001, no outbox configured",
+			"payment_id", order.ID(),
+			"amount", order.Amount().String(),
+			"currency", order.Currency(),
+			"creditor_agent", order.RoutingInfo().BIC().String(),
+			"size", len(msg),
+		)
+		return nil
+	}
+
+	name := filepath.Join(a.cfg.OutboxDir, strings.ReplaceAll(order.ID().String(), "-", "")+".xml")
+	tmp := name + ".tmp"
+	if err := os.WriteFile(tmp, msg, 0o600); err != nil {
+		return fmt.Errorf("swift: write pain.001: %w", err)
+	}
+	if err := os.Rename(tmp, name); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("swift: write pain.001: %w", err)
+	}
+
+	a.logger.Info("SWIFT: pain.001 queued for transmission",
+		"payment_id", order.ID(),
+		"amount", order.Amount().String(),
+		"currency", order.Currency(),
+		"creditor_agent", order.RoutingInfo().BIC().String(),
+		"file", name,
+	)
+	return nil
+}
+
+// GetStatus reports a submitted payment as processing: SWIFT payments
+// settle at the correspondent, typically in one to two business days, and
+// their status reports (pain.002) are not yet read back.
+func (a *Adapter) GetStatus(_ context.Context, _ uuid.UUID) (valueobject.PaymentStatus, string, error) {
+	return valueobject.PaymentStatusProcessing, "awaiting correspondent bank processing", nil
+}
@@ -0,0 +1,130 @@
+package swift_test
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/payment-service/internal/infrastructure/adapter/swift"
+)
+
+func newSWIFTOrder(t *testing.T, bic, account string) model.PaymentOrder {
+	t.Helper()
+	routing, err := valueobject.NewRoutingInfo(bic, account)
+	require.NoError(t, err)
+	order, err := model.NewPaymentOrder(uuid.New(), uuid.New(), uuid.Nil,
+		decimal.RequireFromString("1250.50"), "GBP", valueobject.RailSWIFT, routing,
+		"INV-2041", "Consulting fees", routing.Country())
+	require.NoError(t, err)
+	return order
+}
+
+func testDebtor(t *testing.T) swift.Debtor {
+	t.Helper()
+	bic, err := valueobject.NewBIC("BIBBUS33")
+	require.NoError(t, err)
+	return swift.Debtor{Name: "BIB Bank", Account: "9876543210", BIC: bic}
+}
+
+// pain001 is the subset of a pain.001 document the tests check.
+type pain001 struct {
+	XMLName xml.Name `xml:"urn:iso:std:iso:20022:tech:xsd:pain.001.001.09 Document"`
+	GrpHdr  struct {
+		MsgID   string `xml:"MsgId"`
+		NbOfTxs int    `xml:"NbOfTxs"`
+		CtrlSum string `xml:"CtrlSum"`
+	} `xml:"CstmrCdtTrfInitn>GrpHdr"`
+	PmtInf struct {
+		ReqdExctnDt string `xml:"ReqdExctnDt>Dt"`
+		DbtrAcctID  string `xml:"DbtrAcct>Id>Othr>Id"`
+		DbtrAgt     string `xml:"DbtrAgt>FinInstnId>BICFI"`
+		Tx          struct {
+			EndToEndID string `xml:"PmtId>EndToEndId"`
+			UETR       string `xml:"PmtId>UETR"`
+			Amt        struct {
+				Ccy   string `xml:"Ccy,attr"`
+				Value string `xml:",chardata"`
+			} `xml:"Amt>InstdAmt"`
+			CdtrAgt     string `xml:"CdtrAgt>FinInstnId>BICFI"`
+			CdtrCountry string `xml:"Cdtr>PstlAdr>Ctry"`
+			CdtrIBAN    string `xml:"CdtrAcct>Id>IBAN"`
+			Ustrd       string `xml:"RmtInf>Ustrd"`
+		} `xml:"CdtTrfTxInf"`
+	} `xml:"CstmrCdtTrfInitn>PmtInf"`
+}
+
+func TestRenderPain001(t *testing.T) {
+	order := newSWIFTOrder(t, "NWBKGB2L", "GB82 WEST 1234 5698 7654 32")
+	now := time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)
+
+	msg, err := swift.RenderPain001(order, testDebtor(t), now)
+	require.NoError(t, err)
+
+	var doc pain001
+	require.NoError(t, xml.Unmarshal(msg, &doc))
+	assert.Equal(t, strings.ReplaceAll(order.ID().String(), "-", ""), doc.GrpHdr.MsgID)
+	assert.Equal(t, 1, doc.GrpHdr.NbOfTxs)
+	assert.Equal(t, "1250.5", doc.GrpHdr.CtrlSum)
+	assert.Equal(t, "2026-03-02", doc.PmtInf.ReqdExctnDt)
+	assert.Equal(t, "9876543210", doc.PmtInf.DbtrAcctID)
+	assert.Equal(t, "BIBBUS33", doc.PmtInf.DbtrAgt)
+
+	tx := doc.PmtInf.Tx
+	assert.Equal(t, "INV-2041", tx.EndToEndID)
+	assert.Equal(t, order.ID().String(), tx.UETR)
+	assert.Equal(t, "GBP", tx.Amt.Ccy)
+	assert.Equal(t, "1250.5", tx.Amt.Value)
+	assert.Equal(t, "NWBKGB2L", tx.CdtrAgt)
+	assert.Equal(t, "GB", tx.CdtrCountry)
+	assert.Equal(t, "GB82WEST12345698765432", tx.CdtrIBAN)
+	assert.Equal(t, "INV-2041 Consulting fees", tx.Ustrd)
+}
+
+func TestRenderPain001_RequiresBeneficiaryBIC(t *testing.T) {
+	order := newSWIFTOrder(t, "021000021", "123456789")
+
+	_, err := swift.RenderPain001(order, testDebtor(t), time.Now())
+	assert.Error(t, err)
+}
+
+func TestAdapter_Submit(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	dir := t.TempDir()
+	a, err := swift.NewAdapter(swift.Config{Debtor: testDebtor(t), OutboxDir: dir}, logger)
+	require.NoError(t, err)
+
+	order := newSWIFTOrder(t, "DEUTDEFF", "DE89370400440532013000")
+	require.NoError(t, a.Submit(context.Background(), order))
+	// Resubmitting replaces the message rather than sending it twice.
+	require.NoError(t, a.Submit(context.Background(), order))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, strings.ReplaceAll(order.ID().String(), "-", "")+".xml", entries[0].Name())
+
+	msg, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	require.NoError(t, err)
+	assert.Contains(t, string(msg), swift.Pain001Namespace)
+	assert.Contains(t, string(msg), "<IBAN>DE89370400440532013000</IBAN>")
+
+	status, _, err := a.GetStatus(context.Background(), order.ID())
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.PaymentStatusProcessing, status)
+
+	_, err = swift.NewAdapter(swift.Config{}, logger)
+	assert.Error(t, err)
+}
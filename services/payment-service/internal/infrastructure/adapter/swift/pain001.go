@@ -0,0 +1,242 @@
+package swift
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+// Pain001Namespace is the namespace of ISO 20022 customer credit transfer
+// initiation messages, version 9, as used by SWIFT CBPR+.
+const Pain001Namespace = "urn:iso:std:iso:20022:tech:xsd:pain.001.001.09"
+
+// notProvided stands in for the creditor name, which payment orders do not
+// carry, as CBPR+ allows for unknown party names.
+const notProvided = "NOTPROVIDED"
+
+// maxText35 and maxText140 are the lengths of ISO 20022 Max35Text and
+// Max140Text fields.
+const (
+	maxText35  = 35
+	maxText140 = 140
+)
+
+// Debtor is the party whose account a pain.001 debits: the bank's own
+// account at its correspondent, from which it pays out cross-border
+// payments on its customers' behalf.
+type Debtor struct {
+	// Name is the bank's name.
+	Name string
+	// Account is the bank's account, an IBAN or other account number.
+	Account string
+	// BIC identifies the bank as debtor agent.
+	BIC valueobject.BIC
+}
+
+type pain001Document struct {
+	XMLName xml.Name         `xml:"Document"`
+	Xmlns   string           `xml:"xmlns,attr"`
+	Initn   cstmrCdtTrfInitn `xml:"CstmrCdtTrfInitn"`
+}
+
+type cstmrCdtTrfInitn struct {
+	GrpHdr grpHdr `xml:"GrpHdr"`
+	PmtInf pmtInf `xml:"PmtInf"`
+}
+
+type grpHdr struct {
+	MsgID    string    `xml:"MsgId"`
+	CreDtTm  string    `xml:"CreDtTm"`
+	NbOfTxs  int       `xml:"NbOfTxs"`
+	CtrlSum  string    `xml:"CtrlSum"`
+	InitgPty partyName `xml:"InitgPty"`
+}
+
+type pmtInf struct {
+	PmtInfID    string      `xml:"PmtInfId"`
+	PmtMtd      string      `xml:"PmtMtd"`
+	NbOfTxs     int         `xml:"NbOfTxs"`
+	CtrlSum     string      `xml:"CtrlSum"`
+	ReqdExctnDt dateChoice  `xml:"ReqdExctnDt"`
+	Dbtr        partyName   `xml:"Dbtr"`
+	DbtrAcct    account     `xml:"DbtrAcct"`
+	DbtrAgt     agent       `xml:"DbtrAgt"`
+	ChrgBr      string      `xml:"ChrgBr"`
+	CdtTrfTxInf cdtTrfTxInf `xml:"CdtTrfTxInf"`
+}
+
+type dateChoice struct {
+	Dt string `xml:"Dt"`
+}
+
+type partyName struct {
+	Nm string `xml:"Nm"`
+}
+
+type creditor struct {
+	Nm      string      `xml:"Nm"`
+	PstlAdr *postalAddr `xml:"PstlAdr,omitempty"`
+}
+
+type postalAddr struct {
+	Ctry string `xml:"Ctry"`
+}
+
+type account struct {
+	ID accountID `xml:"Id"`
+}
+
+type accountID struct {
+	IBAN string   `xml:"IBAN,omitempty"`
+	Othr *otherID `xml:"Othr,omitempty"`
+}
+
+type otherID struct {
+	ID string `xml:"Id"`
+}
+
+type agent struct {
+	FinInstnID finInstnID `xml:"FinInstnId"`
+}
+
+type finInstnID struct {
+	BICFI string `xml:"BICFI"`
+}
+
+type cdtTrfTxInf struct {
+	PmtID    pmtID    `xml:"PmtId"`
+	Amt      amount   `xml:"Amt"`
+	CdtrAgt  agent    `xml:"CdtrAgt"`
+	Cdtr     creditor `xml:"Cdtr"`
+	CdtrAcct account  `xml:"CdtrAcct"`
+	RmtInf   *rmtInf  `xml:"RmtInf,omitempty"`
+}
+
+type pmtID struct {
+	InstrID    string `xml:"InstrId"`
+	EndToEndID string `xml:"EndToEndId"`
+	UETR       string `xml:"UETR"`
+}
+
+type amount struct {
+	InstdAmt instdAmt `xml:"InstdAmt"`
+}
+
+type instdAmt struct {
+	Ccy   string `xml:"Ccy,attr"`
+	Value string `xml:",chardata"`
+}
+
+type rmtInf struct {
+	Ustrd string `xml:"Ustrd"`
+}
+
+// RenderPain001 renders a SWIFT payment order as a pain.001 customer credit
+// transfer initiation with a single transaction, debiting debtor and
+// crediting the beneficiary of the order's routing info. The order's ID
+// identifies the message, payment and instruction, and is its UETR, so the
+// payment can be traced end to end through SWIFT gpi. It fails if the order
+// has no beneficiary BIC.
+func RenderPain001(order model.PaymentOrder, debtor Debtor, now time.Time) ([]byte, error) {
+	routing := order.RoutingInfo()
+	if routing.BIC().IsZero() {
+		return nil, fmt.Errorf("payment %s has no beneficiary BIC", order.ID())
+	}
+	if routing.ExternalAccountNumber() == "" {
+		return nil, fmt.Errorf("payment %s has no beneficiary account", order.ID())
+	}
+	if debtor.BIC.IsZero() {
+		return nil, fmt.Errorf("debtor BIC is required")
+	}
+
+	id := strings.ReplaceAll(order.ID().String(), "-", "")
+	amt := order.Amount().String()
+	cdtr := creditor{Nm: notProvided}
+	country := order.DestinationCountry()
+	if country == "" {
+		country = routing.Country()
+	}
+	if country != "" {
+		cdtr.PstlAdr = &postalAddr{Ctry: country}
+	}
+
+	doc := pain001Document{
+		Xmlns: Pain001Namespace,
+		Initn: cstmrCdtTrfInitn{
+			GrpHdr: grpHdr{
+				MsgID:    id,
+				CreDtTm:  now.UTC().Format("2006-01-02T15:04:05Z"),
+				NbOfTxs:  1,
+				CtrlSum:  amt,
+				InitgPty: partyName{Nm: truncate(debtor.Name, maxText140)},
+			},
+			PmtInf: pmtInf{
+				PmtInfID:    id,
+				PmtMtd:      "TRF",
+				NbOfTxs:     1,
+				CtrlSum:     amt,
+				ReqdExctnDt: dateChoice{Dt: now.UTC().Format("2006-01-02")},
+				Dbtr:        partyName{Nm: truncate(debtor.Name, maxText140)},
+				DbtrAcct:    accountOf(debtor.Account),
+				DbtrAgt:     agent{FinInstnID: finInstnID{BICFI: debtor.BIC.String()}},
+				ChrgBr:      "SHAR",
+				CdtTrfTxInf: cdtTrfTxInf{
+					PmtID: pmtID{
+						InstrID:    id,
+						EndToEndID: endToEndID(order.Reference(), id),
+						UETR:       order.ID().String(),
+					},
+					Amt:      amount{InstdAmt: instdAmt{Ccy: order.Currency(), Value: amt}},
+					CdtrAgt:  agent{FinInstnID: finInstnID{BICFI: routing.BIC().String()}},
+					Cdtr:     cdtr,
+					CdtrAcct: accountOf(routing.ExternalAccountNumber()),
+					RmtInf:   remittance(order.Reference(), order.Description()),
+				},
+			},
+		},
+	}
+
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to render pain.001: %w", err)
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+// accountOf identifies an account by IBAN, or else by its account number.
+func accountOf(number string) account {
+	if iban, err := valueobject.NewIBAN(number); err == nil {
+		return account{ID: accountID{IBAN: iban.String()}}
+	}
+	return account{ID: accountID{Othr: &otherID{ID: truncate(number, 34)}}}
+}
+
+// endToEndID is the payment's reference, passed on unchanged to the
+// beneficiary, or the order ID if it has none or it is too long.
+func endToEndID(reference, id string) string {
+	if reference == "" || len(reference) > maxText35 {
+		return id
+	}
+	return reference
+}
+
+func remittance(reference, description string) *rmtInf {
+	text := strings.TrimSpace(strings.Join([]string{reference, description}, " "))
+	if text == "" {
+		return nil
+	}
+	return &rmtInf{Ustrd: truncate(text, maxText140)}
+}
+
+// truncate shortens s to at most n runes.
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
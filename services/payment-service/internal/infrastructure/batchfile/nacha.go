@@ -0,0 +1,227 @@
+package batchfile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+)
+
+// nachaRecordLength is the length of every record of a NACHA file.
+const nachaRecordLength = 94
+
+// NACHA transaction codes of credits to checking and savings accounts, the
+// only entries imported as payments.
+const (
+	nachaCheckingCredit = "22"
+	nachaSavingsCredit  = "32"
+)
+
+// nachaBatch accumulates the entries of a batch to check its control
+// record.
+type nachaBatch struct {
+	serviceClass string
+	description  string
+	entryCount   int
+	entryHash    int64
+	totalDebit   int64
+	totalCredit  int64
+}
+
+// ParseNACHA reads the entry detail records of a NACHA file. Every credit
+// entry becomes an instruction in USD; debits and prenotes are returned
+// with an error, as are entries whose routing number fails its check
+// digit. The file is rejected as a whole if its structure is broken or a
+// batch or file control record does not match the entries it totals.
+func ParseNACHA(content []byte) ([]port.BatchFileEntry, error) {
+	var (
+		entries     []port.BatchFileEntry
+		batch       *nachaBatch
+		file        nachaBatch
+		batchCount  int
+		sawHeader   bool
+		sawControl  bool
+		recordCount int
+	)
+	for i, line := range bytes.Split(content, []byte("\n")) {
+		rec := strings.TrimRight(string(line), "\r")
+		if rec == "" {
+			continue
+		}
+		n := i + 1
+		if len(rec) != nachaRecordLength {
+			return nil, invalid("line %d: record is %d characters, want %d", n, len(rec), nachaRecordLength)
+		}
+		if sawControl {
+			// Blocking filler after the file control record.
+			if strings.Trim(rec, "9") == "" {
+				continue
+			}
+			return nil, invalid("line %d: record after file control", n)
+		}
+		recordCount++
+
+		switch rec[0] {
+		case '1':
+			if recordCount != 1 {
+				return nil, invalid("line %d: file header is not the first record", n)
+			}
+			sawHeader = true
+		case '5':
+			if !sawHeader || batch != nil {
+				return nil, invalid("line %d: unexpected batch header", n)
+			}
+			batch = &nachaBatch{
+				serviceClass: rec[1:4],
+				description:  strings.TrimSpace(rec[53:63]),
+			}
+			batchCount++
+		case '6':
+			if batch == nil {
+				return nil, invalid("line %d: entry outside a batch", n)
+			}
+			entry, cents, err := parseNACHAEntry(rec, batch)
+			if err != nil {
+				return nil, invalid("line %d: %v", n, err)
+			}
+			routing, _ := strconv.ParseInt(rec[3:11], 10, 64)
+			batch.entryHash += routing
+			batch.entryCount++
+			if isNACHADebit(rec[1:3]) {
+				batch.totalDebit += cents
+			} else {
+				batch.totalCredit += cents
+			}
+			entries = append(entries, entry)
+		case '7':
+			if batch == nil || batch.entryCount == 0 {
+				return nil, invalid("line %d: addenda without an entry", n)
+			}
+			batch.entryCount++
+		case '8':
+			if batch == nil {
+				return nil, invalid("line %d: batch control without a batch", n)
+			}
+			if err := checkNACHAControl(rec[1:4], rec[4:10], rec[10:20], rec[20:32], rec[32:44], batch); err != nil {
+				return nil, invalid("line %d: batch control: %v", n, err)
+			}
+			file.entryCount += batch.entryCount
+			file.entryHash += batch.entryHash
+			file.totalDebit += batch.totalDebit
+			file.totalCredit += batch.totalCredit
+			batch = nil
+		case '9':
+			if !sawHeader || batch != nil {
+				return nil, invalid("line %d: unexpected file control", n)
+			}
+			if got, err := strconv.Atoi(rec[1:7]); err != nil || got != batchCount {
+				return nil, invalid("line %d: file control: batch count %q, want %d", n, rec[1:7], batchCount)
+			}
+			if err := checkNACHAControl("", rec[13:21], rec[21:31], rec[31:43], rec[43:55], &file); err != nil {
+				return nil, invalid("line %d: file control: %v", n, err)
+			}
+			sawControl = true
+		default:
+			return nil, invalid("line %d: unknown record type %q", n, rec[0])
+		}
+	}
+	if !sawControl {
+		return nil, invalid("missing file control record")
+	}
+	return entries, nil
+}
+
+// parseNACHAEntry reads an entry detail record, returning it with its
+// amount in cents. The error is set on the entry, rather than returned, for
+// entries that are well formed but cannot be paid.
+func parseNACHAEntry(rec string, batch *nachaBatch) (port.BatchFileEntry, int64, error) {
+	code := rec[1:3]
+	routing := rec[3:12]
+	cents, err := strconv.ParseInt(rec[29:39], 10, 64)
+	if err != nil {
+		return port.BatchFileEntry{}, 0, fmt.Errorf("invalid amount %q", rec[29:39])
+	}
+	if _, err := strconv.ParseInt(rec[3:11], 10, 64); err != nil {
+		return port.BatchFileEntry{}, 0, fmt.Errorf("invalid routing number %q", routing)
+	}
+
+	description := batch.description
+	if id := strings.TrimSpace(rec[39:54]); id != "" {
+		description = strings.TrimSpace(description + " " + id)
+	}
+	entry := port.BatchFileEntry{
+		Instruction: model.BatchInstruction{
+			Amount:          decimal.New(cents, -2),
+			Currency:        "USD",
+			BeneficiaryName: strings.TrimSpace(rec[54:76]),
+			RoutingNumber:   routing,
+			AccountNumber:   strings.TrimSpace(rec[12:29]),
+			Reference:       rec[79:94],
+			Description:     description,
+		},
+	}
+	switch {
+	case code != nachaCheckingCredit && code != nachaSavingsCredit:
+		entry.Err = fmt.Errorf("transaction code %s is not a credit; only codes %s and %s are supported", code, nachaCheckingCredit, nachaSavingsCredit)
+	case !validABACheckDigit(routing):
+		entry.Err = fmt.Errorf("routing number %s fails its check digit", routing)
+	case entry.Instruction.AccountNumber == "":
+		entry.Err = errors.New("account number is required")
+	}
+	return entry, cents, nil
+}
+
+// isNACHADebit reports whether a transaction code debits the receiver's
+// account: codes ending in 5 through 9.
+func isNACHADebit(code string) bool {
+	return code[1] >= '5'
+}
+
+// checkNACHAControl compares the counts and totals of a batch or file
+// control record with those of the entries it covers.
+func checkNACHAControl(serviceClass, count, hash, debit, credit string, b *nachaBatch) error {
+	if serviceClass != "" && serviceClass != b.serviceClass {
+		return fmt.Errorf("service class %s, want %s", serviceClass, b.serviceClass)
+	}
+	checks := []struct {
+		name  string
+		field string
+		want  int64
+	}{
+		{"entry/addenda count", count, int64(b.entryCount)},
+		// The entry hash keeps the rightmost ten digits of the sum.
+		{"entry hash", hash, b.entryHash % 1e10},
+		{"total debit", debit, b.totalDebit},
+		{"total credit", credit, b.totalCredit},
+	}
+	for _, c := range checks {
+		got, err := strconv.ParseInt(c.field, 10, 64)
+		if err != nil || got != c.want {
+			return fmt.Errorf("%s %q, want %d", c.name, c.field, c.want)
+		}
+	}
+	return nil
+}
+
+// validABACheckDigit reports whether a 9-digit ABA routing number's last
+// digit matches the weighted sum (3, 7, 1) of its digits.
+func validABACheckDigit(routing string) bool {
+	if len(routing) != 9 {
+		return false
+	}
+	weights := [...]int{3, 7, 1, 3, 7, 1, 3, 7, 1}
+	sum := 0
+	for i, r := range routing {
+		if r < '0' || r > '9' {
+			return false
+		}
+		sum += int(r-'0') * weights[i]
+	}
+	return sum%10 == 0
+}
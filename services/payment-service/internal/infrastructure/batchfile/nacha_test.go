@@ -0,0 +1,113 @@
+package batchfile
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+)
+
+type nachaTestEntry struct {
+	code    string
+	routing string
+	account string
+	name    string
+	trace   string
+	cents   int64
+}
+
+func nachaEntryRecord(e nachaTestEntry) string {
+	return fmt.Sprintf("6%s%s%-17s%010d%-15s%-22s  0%s", e.code, e.routing, e.account, e.cents, "EMP"+e.trace[len(e.trace)-3:], e.name, e.trace)
+}
+
+// nachaTestFile builds a single-batch NACHA file for entries, with correct
+// control records unless corrupt alters the batch control.
+func nachaTestFile(entries []nachaTestEntry, corrupt func(debit, credit int64) (int64, int64)) string {
+	var (
+		lines         []string
+		hash          int64
+		debit, credit int64
+	)
+	lines = append(lines, fmt.Sprintf("%-94s", "101 021000021 0110000152610170900A094101BIB BANK               BIB BANK"))
+	lines = append(lines, fmt.Sprintf("5220%-16s%-20s%-10sPPD%-10s%-6s%-6s%-3s1%-8s%07d", "ACME CORP", "", "1234567890", "PAYROLL", "", "261018", "", "02100002", 1))
+	for _, e := range entries {
+		lines = append(lines, nachaEntryRecord(e))
+		routing, _ := strconv.ParseInt(e.routing[:8], 10, 64)
+		hash += routing
+		if isNACHADebit(e.code) {
+			debit += e.cents
+		} else {
+			credit += e.cents
+		}
+	}
+	batchDebit, batchCredit := debit, credit
+	if corrupt != nil {
+		batchDebit, batchCredit = corrupt(debit, credit)
+	}
+	lines = append(lines, fmt.Sprintf("8220%06d%010d%012d%012d%-10s%-19s%-6s%-8s%07d", len(entries), hash, batchDebit, batchCredit, "1234567890", "", "", "02100002", 1))
+	lines = append(lines, fmt.Sprintf("9%06d%06d%08d%010d%012d%012d%-39s", 1, 1, len(entries), hash, debit, credit, ""))
+	lines = append(lines, strings.Repeat("9", 94))
+	return strings.Join(lines, "\r\n") + "\r\n"
+}
+
+func TestParseNACHA(t *testing.T) {
+	file := nachaTestFile([]nachaTestEntry{
+		{code: "22", routing: "021000021", account: "12345678", name: "JANE DOE", trace: "021000020000001", cents: 150025},
+		{code: "32", routing: "011000015", account: "98765", name: "JOHN ROE", trace: "021000020000002", cents: 9900},
+		{code: "27", routing: "021000021", account: "555", name: "DEBIT", trace: "021000020000003", cents: 100},
+		{code: "22", routing: "021000022", account: "777", name: "BAD CHECK", trace: "021000020000004", cents: 100},
+	}, nil)
+
+	entries, err := ParseNACHA([]byte(file))
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+
+	first := entries[0]
+	require.NoError(t, first.Err)
+	assert.Equal(t, "1500.25", first.Instruction.Amount.StringFixed(2))
+	assert.Equal(t, "USD", first.Instruction.Currency)
+	assert.Equal(t, "021000021", first.Instruction.RoutingNumber)
+	assert.Equal(t, "12345678", first.Instruction.AccountNumber)
+	assert.Equal(t, "JANE DOE", first.Instruction.BeneficiaryName)
+	assert.Equal(t, "021000020000001", first.Instruction.Reference)
+	assert.Equal(t, "PAYROLL EMP001", first.Instruction.Description)
+
+	assert.NoError(t, entries[1].Err)
+	assert.ErrorContains(t, entries[2].Err, "not a credit")
+	assert.ErrorContains(t, entries[3].Err, "check digit")
+}
+
+func TestParseNACHA_InvalidFile(t *testing.T) {
+	entries := []nachaTestEntry{
+		{code: "22", routing: "021000021", account: "12345678", name: "JANE DOE", trace: "021000020000001", cents: 150025},
+	}
+
+	tests := []struct {
+		name string
+		file string
+	}{
+		{"control total mismatch", nachaTestFile(entries, func(d, c int64) (int64, int64) { return d, c + 1 })},
+		{"short record", strings.Replace(nachaTestFile(entries, nil), "JANE DOE  ", "JANE DOE", 1)},
+		{"missing file control", strings.Join(strings.Split(nachaTestFile(entries, nil), "\r\n")[:4], "\n")},
+		{"not a NACHA file", "hello"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseNACHA([]byte(tt.file))
+			assert.True(t, errors.Is(err, port.ErrInvalidBatchFile), "error = %v", err)
+		})
+	}
+}
+
+func TestValidABACheckDigit(t *testing.T) {
+	assert.True(t, validABACheckDigit("021000021"))
+	assert.True(t, validABACheckDigit("011000015"))
+	assert.False(t, validABACheckDigit("021000022"))
+	assert.False(t, validABACheckDigit("02100002"))
+}
@@ -0,0 +1,139 @@
+package batchfile
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+)
+
+// The pain.001 elements read, matched by local name so that any version of
+// the message (pain.001.001.03 through .09) is accepted.
+type pain001Document struct {
+	Initn struct {
+		GrpHdr struct {
+			NbOfTxs string `xml:"NbOfTxs"`
+			CtrlSum string `xml:"CtrlSum"`
+		} `xml:"GrpHdr"`
+		PmtInf []struct {
+			PmtInfID    string          `xml:"PmtInfId"`
+			CdtTrfTxInf []pain001CdtTrf `xml:"CdtTrfTxInf"`
+		} `xml:"PmtInf"`
+	} `xml:"CstmrCdtTrfInitn"`
+}
+
+type pain001CdtTrf struct {
+	EndToEndID string `xml:"PmtId>EndToEndId"`
+	InstdAmt   *struct {
+		Ccy   string `xml:"Ccy,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"Amt>InstdAmt"`
+	CdtrAgt struct {
+		BICFI string `xml:"BICFI"`
+		// BIC is the element's name before pain.001.001.04.
+		BIC   string `xml:"BIC"`
+		MmbID string `xml:"ClrSysMmbId>MmbId"`
+	} `xml:"CdtrAgt>FinInstnId"`
+	CdtrNm string `xml:"Cdtr>Nm"`
+	Acct   struct {
+		IBAN string `xml:"IBAN"`
+		Othr string `xml:"Othr>Id"`
+	} `xml:"CdtrAcct>Id"`
+	Ustrd []string `xml:"RmtInf>Ustrd"`
+}
+
+// ParsePain001 reads the credit transfer transactions of a pain.001
+// customer credit transfer initiation, in document order. A transaction's
+// creditor agent is identified by BIC or, for US domestic payments, by its
+// ABA routing number as clearing system member ID. Transactions without an
+// instructed amount, creditor agent or creditor account are returned with
+// an error. The document is rejected as a whole if its group header's
+// transaction count or control sum does not match its transactions.
+func ParsePain001(content []byte) ([]port.BatchFileEntry, error) {
+	var doc pain001Document
+	dec := xml.NewDecoder(bytes.NewReader(content))
+	if err := dec.Decode(&doc); err != nil {
+		return nil, invalid("malformed XML: %v", err)
+	}
+
+	var (
+		entries []port.BatchFileEntry
+		sum     decimal.Decimal
+	)
+	for _, pmt := range doc.Initn.PmtInf {
+		for _, tx := range pmt.CdtTrfTxInf {
+			entry := parsePain001Tx(tx)
+			sum = sum.Add(entry.Instruction.Amount)
+			entries = append(entries, entry)
+		}
+	}
+	if len(entries) == 0 {
+		return nil, invalid("no credit transfer transactions")
+	}
+
+	hdr := doc.Initn.GrpHdr
+	if strings.TrimSpace(hdr.NbOfTxs) != fmt.Sprint(len(entries)) {
+		return nil, invalid("group header NbOfTxs %q, want %d", hdr.NbOfTxs, len(entries))
+	}
+	if ctrl := strings.TrimSpace(hdr.CtrlSum); ctrl != "" {
+		want, err := decimal.NewFromString(ctrl)
+		if err != nil || !want.Equal(sum) {
+			return nil, invalid("group header CtrlSum %q, want %s", hdr.CtrlSum, sum.String())
+		}
+	}
+	return entries, nil
+}
+
+func parsePain001Tx(tx pain001CdtTrf) port.BatchFileEntry {
+	agent := strings.TrimSpace(tx.CdtrAgt.BICFI)
+	if agent == "" {
+		agent = strings.TrimSpace(tx.CdtrAgt.BIC)
+	}
+	if agent == "" {
+		agent = strings.TrimSpace(tx.CdtrAgt.MmbID)
+	}
+	account := strings.TrimSpace(tx.Acct.IBAN)
+	if account == "" {
+		account = strings.TrimSpace(tx.Acct.Othr)
+	}
+	ustrd := make([]string, 0, len(tx.Ustrd))
+	for _, u := range tx.Ustrd {
+		if u = strings.TrimSpace(u); u != "" {
+			ustrd = append(ustrd, u)
+		}
+	}
+
+	entry := port.BatchFileEntry{
+		Instruction: model.BatchInstruction{
+			BeneficiaryName: strings.TrimSpace(tx.CdtrNm),
+			RoutingNumber:   agent,
+			AccountNumber:   account,
+			Reference:       strings.TrimSpace(tx.EndToEndID),
+			Description:     strings.Join(ustrd, " "),
+		},
+	}
+	if tx.InstdAmt == nil {
+		entry.Err = errors.New("instructed amount is required")
+		return entry
+	}
+	amount, err := decimal.NewFromString(strings.TrimSpace(tx.InstdAmt.Value))
+	if err != nil {
+		entry.Err = fmt.Errorf("invalid instructed amount %q", tx.InstdAmt.Value)
+		return entry
+	}
+	entry.Instruction.Amount = amount
+	entry.Instruction.Currency = strings.TrimSpace(tx.InstdAmt.Ccy)
+	switch {
+	case agent == "":
+		entry.Err = errors.New("creditor agent BIC or clearing system member ID is required")
+	case account == "":
+		entry.Err = errors.New("creditor account is required")
+	}
+	return entry
+}
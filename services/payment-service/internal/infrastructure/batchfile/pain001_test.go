@@ -0,0 +1,95 @@
+package batchfile
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+)
+
+const testPain001 = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pain.001.001.09">
+  <CstmrCdtTrfInitn>
+    <GrpHdr>
+      <MsgId>BATCH-0001</MsgId>
+      <CreDtTm>2026-10-18T09:00:00Z</CreDtTm>
+      <NbOfTxs>3</NbOfTxs>
+      <CtrlSum>1750.50</CtrlSum>
+      <InitgPty><Nm>ACME GMBH</Nm></InitgPty>
+    </GrpHdr>
+    <PmtInf>
+      <PmtInfId>PMT-1</PmtInfId>
+      <PmtMtd>TRF</PmtMtd>
+      <CdtTrfTxInf>
+        <PmtId><EndToEndId>INV-1001</EndToEndId></PmtId>
+        <Amt><InstdAmt Ccy="EUR">1500.50</InstdAmt></Amt>
+        <CdtrAgt><FinInstnId><BICFI>DEUTDEFF</BICFI></FinInstnId></CdtrAgt>
+        <Cdtr><Nm>Lieferant AG</Nm></Cdtr>
+        <CdtrAcct><Id><IBAN>DE89370400440532013000</IBAN></Id></CdtrAcct>
+        <RmtInf><Ustrd>Invoice 1001</Ustrd></RmtInf>
+      </CdtTrfTxInf>
+    </PmtInf>
+    <PmtInf>
+      <PmtInfId>PMT-2</PmtInfId>
+      <PmtMtd>TRF</PmtMtd>
+      <CdtTrfTxInf>
+        <PmtId><EndToEndId>INV-1002</EndToEndId></PmtId>
+        <Amt><InstdAmt Ccy="USD">200.00</InstdAmt></Amt>
+        <CdtrAgt><FinInstnId><ClrSysMmbId><MmbId>021000021</MmbId></ClrSysMmbId></FinInstnId></CdtrAgt>
+        <Cdtr><Nm>Supplier Inc</Nm></Cdtr>
+        <CdtrAcct><Id><Othr><Id>12345678</Id></Othr></Id></CdtrAcct>
+      </CdtTrfTxInf>
+      <CdtTrfTxInf>
+        <PmtId><EndToEndId>INV-1003</EndToEndId></PmtId>
+        <Amt><InstdAmt Ccy="USD">50.00</InstdAmt></Amt>
+        <Cdtr><Nm>No Agent Ltd</Nm></Cdtr>
+        <CdtrAcct><Id><Othr><Id>999</Id></Othr></Id></CdtrAcct>
+      </CdtTrfTxInf>
+    </PmtInf>
+  </CstmrCdtTrfInitn>
+</Document>`
+
+func TestParsePain001(t *testing.T) {
+	entries, err := ParsePain001([]byte(testPain001))
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	first := entries[0]
+	require.NoError(t, first.Err)
+	assert.Equal(t, "1500.5", first.Instruction.Amount.String())
+	assert.Equal(t, "EUR", first.Instruction.Currency)
+	assert.Equal(t, "DEUTDEFF", first.Instruction.RoutingNumber)
+	assert.Equal(t, "DE89370400440532013000", first.Instruction.AccountNumber)
+	assert.Equal(t, "Lieferant AG", first.Instruction.BeneficiaryName)
+	assert.Equal(t, "INV-1001", first.Instruction.Reference)
+	assert.Equal(t, "Invoice 1001", first.Instruction.Description)
+
+	second := entries[1]
+	require.NoError(t, second.Err)
+	assert.Equal(t, "021000021", second.Instruction.RoutingNumber)
+	assert.Equal(t, "12345678", second.Instruction.AccountNumber)
+
+	assert.ErrorContains(t, entries[2].Err, "creditor agent")
+}
+
+func TestParsePain001_InvalidFile(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+	}{
+		{"transaction count mismatch", strings.Replace(testPain001, "<NbOfTxs>3</NbOfTxs>", "<NbOfTxs>4</NbOfTxs>", 1)},
+		{"control sum mismatch", strings.Replace(testPain001, "<CtrlSum>1750.50</CtrlSum>", "<CtrlSum>1750.51</CtrlSum>", 1)},
+		{"malformed XML", testPain001[:200]},
+		{"no transactions", `<Document><CstmrCdtTrfInitn><GrpHdr><NbOfTxs>0</NbOfTxs></GrpHdr></CstmrCdtTrfInitn></Document>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePain001([]byte(tt.doc))
+			assert.True(t, errors.Is(err, port.ErrInvalidBatchFile), "error = %v", err)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Package batchfile reads the payment instructions of bulk payment files:
+// NACHA ACH files and ISO 20022 pain.001 customer credit transfer
+// initiations.
+package batchfile
+
+import (
+	"fmt"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+// Compile-time interface check.
+var _ port.BatchFileParser = Parser{}
+
+// Parser reads NACHA and pain.001 files.
+type Parser struct{}
+
+// NewParser creates a new Parser.
+func NewParser() Parser {
+	return Parser{}
+}
+
+// Parse implements port.BatchFileParser.
+func (Parser) Parse(format valueobject.BatchFileFormat, content []byte) ([]port.BatchFileEntry, error) {
+	switch format {
+	case valueobject.BatchFileFormatNACHA:
+		return ParseNACHA(content)
+	case valueobject.BatchFileFormatPain001:
+		return ParsePain001(content)
+	default:
+		return nil, fmt.Errorf("%w: unsupported format %q", port.ErrInvalidBatchFile, format.String())
+	}
+}
+
+// invalid returns an error wrapping port.ErrInvalidBatchFile.
+func invalid(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", port.ErrInvalidBatchFile, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	accountv1 "github.com/bibbank/bib/api/gen/go/bib/account/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+)
+
+// Compile-time interface check.
+var _ port.AccountClient = (*AccountGRPCClient)(nil)
+
+// AccountGRPCClient implements the AccountClient port against the account
+// service.
+type AccountGRPCClient struct {
+	conn   grpc.ClientConnInterface
+	signer *auth.JWTService
+	config Config
+}
+
+// NewAccountGRPCClient creates a new AccountGRPCClient. signer may be nil.
+func NewAccountGRPCClient(conn grpc.ClientConnInterface, signer *auth.JWTService, config Config) *AccountGRPCClient {
+	return &AccountGRPCClient{conn: conn, signer: signer, config: config}
+}
+
+// LedgerAccountCode returns the ledger account backing the customer account.
+func (c *AccountGRPCClient) LedgerAccountCode(ctx context.Context, tenantID, accountID uuid.UUID) (string, error) {
+	ctx, err := withTenantToken(ctx, c.signer, tenantID)
+	if err != nil {
+		return "", err
+	}
+
+	var resp accountv1.GetAccountResponse
+	req := &accountv1.GetAccountRequest{Id: accountID.String()}
+	if err := invokeWithRetry(ctx, c.conn, accountv1.AccountService_GetAccount_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout); err != nil {
+		return "", fmt.Errorf("get account %s: %w", accountID, err)
+	}
+	code := resp.GetAccount().GetLedgerAccountCode()
+	if code == "" {
+		return "", fmt.Errorf("account %s has no ledger account", accountID)
+	}
+	return code, nil
+}
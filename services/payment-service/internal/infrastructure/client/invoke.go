@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// Config holds configuration shared by the gRPC clients the payment saga
+// calls.
+type Config struct {
+	// MaxRetries is the maximum number of retry attempts on transient failures.
+	MaxRetries int
+	// RetryBackoff is the base backoff between retries, doubled on each attempt.
+	RetryBackoff time.Duration
+	// Timeout bounds each call.
+	Timeout time.Duration
+}
+
+// invokeWithRetry calls a unary method of another service, retrying transient
+// failures with exponential backoff from backoff. Each attempt is bounded by
+// timeout when it is positive.
+func invokeWithRetry(
+	ctx context.Context,
+	conn grpc.ClientConnInterface,
+	method string,
+	req, resp any,
+	maxRetries int,
+	backoff, timeout time.Duration,
+) error {
+	var lastErr error
+
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			wait := backoff * (1 << uint(attempt-1)) //nolint:gosec // retry count is small
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+
+		err := invoke(ctx, conn, method, req, resp, timeout)
+		if err == nil {
+			return nil
+		}
+		if !isTransient(err) {
+			return err
+		}
+		lastErr = err
+	}
+
+	return fmt.Errorf("exhausted %d retries: %w", maxRetries, lastErr)
+}
+
+func invoke(ctx context.Context, conn grpc.ClientConnInterface, method string, req, resp any, timeout time.Duration) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return conn.Invoke(ctx, method, req, resp)
+}
+
+// isTransient reports whether a failed call may succeed if retried.
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// withTenantToken authorizes outgoing calls for the tenant. Saga steps also
+// run from the recovery loop, where there is no caller token to forward, so
+// the payment service mints a short-lived operator token signed with the
+// platform's issuing key. Without a signer the caller's token, if any, is
+// forwarded instead.
+func withTenantToken(ctx context.Context, signer *auth.JWTService, tenantID uuid.UUID) (context.Context, error) {
+	if signer == nil {
+		return forwardAuthorization(ctx), nil
+	}
+	token, err := signer.GenerateToken(uuid.Nil, tenantID, []string{auth.RoleOperator})
+	if err != nil {
+		return nil, fmt.Errorf("mint service token: %w", err)
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token), nil
+}
+
+// forwardAuthorization passes the caller's bearer token on to the called
+// service, which authorizes the request against it.
+func forwardAuthorization(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	if values := md.Get("authorization"); len(values) > 0 {
+		return metadata.AppendToOutgoingContext(ctx, "authorization", values[0])
+	}
+	return ctx
+}
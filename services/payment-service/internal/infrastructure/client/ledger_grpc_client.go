@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	ledgerv1 "github.com/bibbank/bib/api/gen/go/bib/ledger/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/idempotency"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+)
+
+// Compile-time interface check.
+var _ port.LedgerClient = (*LedgerGRPCClient)(nil)
+
+// LedgerGRPCClient implements the LedgerClient port against the ledger
+// service.
+type LedgerGRPCClient struct {
+	conn   grpc.ClientConnInterface
+	signer *auth.JWTService
+	config Config
+}
+
+// NewLedgerGRPCClient creates a new LedgerGRPCClient. signer may be nil.
+func NewLedgerGRPCClient(conn grpc.ClientConnInterface, signer *auth.JWTService, config Config) *LedgerGRPCClient {
+	return &LedgerGRPCClient{conn: conn, signer: signer, config: config}
+}
+
+// PostEntry posts the entry and returns its identifier. The ledger keeps
+// one entry per tenant and reference and returns it to a later posting
+// under the same reference, so an entry is posted once however often or
+// late the call is retried. The reference is also sent as the idempotency
+// key, so that retries within the ledger's key TTL replay the first
+// response without posting again.
+func (c *LedgerGRPCClient) PostEntry(ctx context.Context, entry port.LedgerEntry) (string, error) {
+	ctx, err := withTenantToken(ctx, c.signer, entry.TenantID)
+	if err != nil {
+		return "", err
+	}
+	if entry.Reference != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, idempotency.MetadataKey, entry.Reference)
+	}
+
+	effective := entry.EffectiveDate
+	if effective.IsZero() {
+		effective = time.Now()
+	}
+	// The ledger books entries by UTC calendar date.
+	y, m, d := effective.UTC().Date()
+	req := &ledgerv1.PostJournalEntryRequest{
+		TenantId:      entry.TenantID.String(),
+		EffectiveDate: timestamppb.New(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)),
+		Description:   entry.Description,
+		Reference:     entry.Reference,
+		Postings: []*ledgerv1.PostingPair{{
+			DebitAccount:  entry.DebitAccount,
+			CreditAccount: entry.CreditAccount,
+			Amount:        &commonv1.Money{Amount: entry.Amount.String(), Currency: entry.Currency},
+			Description:   entry.Description,
+		}},
+	}
+	var resp ledgerv1.PostJournalEntryResponse
+	if err := invokeWithRetry(ctx, c.conn, ledgerv1.LedgerService_PostJournalEntry_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout); err != nil {
+		return "", fmt.Errorf("post journal entry %s: %w", entry.Reference, err)
+	}
+	return resp.GetEntry().GetId(), nil
+}
@@ -0,0 +1,173 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+	"github.com/bibbank/bib/services/payment-service/internal/infrastructure/client"
+)
+
+// fakeConn answers every call with reply and fails the first failures calls
+// with failWith.
+type fakeConn struct {
+	failWith error
+	reply    string
+	methods  []string
+	requests []map[string]any
+	authz    []string
+	keys     []string
+	failures int
+}
+
+func (c *fakeConn) Invoke(ctx context.Context, method string, args, reply any, _ ...grpc.CallOption) error {
+	c.methods = append(c.methods, method)
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		c.authz = append(c.authz, md.Get("authorization")...)
+		c.keys = append(c.keys, md.Get("idempotency-key")...)
+	}
+	if c.failures > 0 {
+		c.failures--
+		return c.failWith
+	}
+
+	raw, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(args.(proto.Message))
+	if err != nil {
+		return err
+	}
+	var req map[string]any
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return err
+	}
+	c.requests = append(c.requests, req)
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal([]byte(c.reply), reply.(proto.Message))
+}
+
+func (c *fakeConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not supported")
+}
+
+var testConfig = client.Config{MaxRetries: 2, RetryBackoff: time.Millisecond, Timeout: time.Second}
+
+func TestLedgerGRPCClient_PostEntry(t *testing.T) {
+	conn := &fakeConn{reply: `{"entry":{"id":"je-1"}}`}
+	tenantID := uuid.New()
+	c := client.NewLedgerGRPCClient(conn, nil, testConfig)
+
+	id, err := c.PostEntry(context.Background(), port.LedgerEntry{
+		TenantID:      tenantID,
+		DebitAccount:  "2000-001",
+		CreditAccount: "2900-001",
+		Amount:        decimal.RequireFromString("125.50"),
+		Currency:      "USD",
+		Reference:     "payment-hold-1",
+		Description:   "Payment hold",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "je-1", id)
+	require.Len(t, conn.requests, 1)
+	req := conn.requests[0]
+	assert.Equal(t, "/bib.ledger.v1.LedgerService/PostJournalEntry", conn.methods[0])
+	assert.Equal(t, tenantID.String(), req["tenant_id"])
+	assert.Equal(t, "payment-hold-1", req["reference"])
+	postings, ok := req["postings"].([]any)
+	require.True(t, ok)
+	require.Len(t, postings, 1)
+	assert.Equal(t, map[string]any{
+		"debit_account":  "2000-001",
+		"credit_account": "2900-001",
+		"amount":         map[string]any{"amount": "125.5", "currency": "USD"},
+		"description":    "Payment hold",
+	}, postings[0])
+	assert.Equal(t, time.Now().UTC().Format(time.DateOnly)+"T00:00:00Z", req["effective_date"])
+}
+
+func TestLedgerGRPCClient_RetriesCarryReferenceAsIdempotencyKey(t *testing.T) {
+	conn := &fakeConn{
+		reply:    `{"entry":{"id":"je-1"}}`,
+		failWith: status.Error(codes.DeadlineExceeded, "ledger slow"),
+		failures: 1,
+	}
+	c := client.NewLedgerGRPCClient(conn, nil, testConfig)
+
+	_, err := c.PostEntry(context.Background(), port.LedgerEntry{
+		TenantID:      uuid.New(),
+		Amount:        decimal.NewFromInt(1),
+		Reference:     "payment/p-1/clearing",
+		EffectiveDate: time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"payment/p-1/clearing", "payment/p-1/clearing"}, conn.keys)
+	assert.Equal(t, "2026-03-31T00:00:00Z", conn.requests[0]["effective_date"])
+}
+
+func TestLedgerGRPCClient_RetriesTransientFailures(t *testing.T) {
+	conn := &fakeConn{
+		reply:    `{"entry":{"id":"je-1"}}`,
+		failWith: status.Error(codes.Unavailable, "ledger restarting"),
+		failures: 2,
+	}
+	c := client.NewLedgerGRPCClient(conn, nil, testConfig)
+
+	_, err := c.PostEntry(context.Background(), port.LedgerEntry{TenantID: uuid.New(), Amount: decimal.NewFromInt(1)})
+
+	require.NoError(t, err)
+	assert.Len(t, conn.methods, 3)
+}
+
+func TestLedgerGRPCClient_DoesNotRetryRejections(t *testing.T) {
+	conn := &fakeConn{failWith: status.Error(codes.InvalidArgument, "unbalanced"), failures: 1}
+	c := client.NewLedgerGRPCClient(conn, nil, testConfig)
+
+	_, err := c.PostEntry(context.Background(), port.LedgerEntry{TenantID: uuid.New(), Amount: decimal.NewFromInt(1)})
+
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(errors.Unwrap(err)))
+	assert.Len(t, conn.methods, 1)
+}
+
+func TestAccountGRPCClient_MintsTenantToken(t *testing.T) {
+	signer, err := auth.NewJWTService(auth.JWTConfig{Secret: "test-secret", Issuer: "bib-gateway", Expiration: time.Minute})
+	require.NoError(t, err)
+	conn := &fakeConn{reply: `{"account":{"id":"a-1","ledger_account_code":"2000-042"}}`}
+	tenantID := uuid.New()
+	accountID := uuid.New()
+	c := client.NewAccountGRPCClient(conn, signer, testConfig)
+
+	code, err := c.LedgerAccountCode(context.Background(), tenantID, accountID)
+
+	require.NoError(t, err)
+	assert.Equal(t, "2000-042", code)
+	assert.Equal(t, accountID.String(), conn.requests[0]["id"])
+	require.Len(t, conn.authz, 1)
+	claims, err := signer.ValidateToken(conn.authz[0][len("Bearer "):])
+	require.NoError(t, err)
+	assert.Equal(t, tenantID, claims.TenantID)
+	assert.Contains(t, claims.Roles, auth.RoleOperator)
+}
+
+func TestAccountGRPCClient_RequiresLedgerAccount(t *testing.T) {
+	conn := &fakeConn{reply: `{"account":{"id":"a-1"}}`}
+	c := client.NewAccountGRPCClient(conn, nil, testConfig)
+
+	_, err := c.LedgerAccountCode(context.Background(), uuid.New(), uuid.New())
+
+	assert.ErrorContains(t, err, "has no ledger account")
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+)
+
+// StubAccountClient implements the AccountClient port when no account service
+// address is configured. It derives a placeholder ledger account code from
+// the account ID.
+type StubAccountClient struct{}
+
+// NewStubAccountClient creates a new StubAccountClient.
+func NewStubAccountClient() *StubAccountClient {
+	return &StubAccountClient{}
+}
+
+// LedgerAccountCode returns a placeholder code for the account.
+func (StubAccountClient) LedgerAccountCode(_ context.Context, _, accountID uuid.UUID) (string, error) {
+	return "2000-" + accountID.String()[:8], nil
+}
+
+// StubLedgerClient implements the LedgerClient port when no ledger service
+// address is configured. It logs each entry instead of posting it.
+type StubLedgerClient struct {
+	logger *slog.Logger
+}
+
+// NewStubLedgerClient creates a new StubLedgerClient.
+func NewStubLedgerClient(logger *slog.Logger) *StubLedgerClient {
+	return &StubLedgerClient{logger: logger}
+}
+
+// PostEntry logs the entry and returns a generated identifier.
+func (c *StubLedgerClient) PostEntry(_ context.Context, entry port.LedgerEntry) (string, error) {
+	id := uuid.New().String()
+	c.logger.Info("stub ledger entry posted",
+		"entry_id", id,
+		"reference", entry.Reference,
+		"debit_account", entry.DebitAccount,
+		"credit_account", entry.CreditAccount,
+		"amount", entry.Amount.String(),
+		"currency", entry.Currency,
+	)
+	return id, nil
+}
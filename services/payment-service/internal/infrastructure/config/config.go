@@ -1,19 +1,33 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"time"
+
+	"github.com/bibbank/bib/pkg/archive"
+	"github.com/bibbank/bib/pkg/crypto"
 )
 
 // Config holds all service configuration loaded from environment variables.
 type Config struct {
+	Archive   archive.StoreConfig
+	PII       crypto.KMSConfig
 	Telemetry TelemetryConfig
 	LogLevel  string
 	LogFormat string
 	Kafka     KafkaConfig
+	Saga      SagaConfig
+	Schedule  ScheduleConfig
+	SWIFT     SWIFTConfig
 	DB        DBConfig
+	Outbox    OutboxConfig
 	HTTPPort  int
 	GRPCPort  int
+	// Sandbox runs the service for sandbox tenants: payments go to the stub
+	// rail whatever the SWIFT settings.
+	Sandbox bool
 }
 
 type DBConfig struct {
@@ -31,6 +45,44 @@ type KafkaConfig struct {
 	Brokers []string
 }
 
+// SagaConfig configures the payment saga. The account and ledger calls are
+// stubbed when their address is empty. SigningKeyFile holds the key used to
+// sign the tenant tokens those calls carry; the JWT secret is used when it is
+// empty. SuspenseAccount and ClearingAccount are the ledger account codes
+// funds are held in while a payment is in flight and moved to once the rail
+// has accepted it.
+type SagaConfig struct {
+	AccountGRPCAddr  string
+	LedgerGRPCAddr   string
+	SigningKeyFile   string
+	SuspenseAccount  string
+	ClearingAccount  string
+	StepTimeout      time.Duration
+	RecoveryInterval time.Duration
+	StaleAfter       time.Duration
+	MaxAttempts      int
+	MaxRetries       int
+	RetryBackoff     time.Duration
+}
+
+// ScheduleConfig configures the scheduler that initiates the due runs of
+// payment schedules. Each poll runs at most BatchSize schedules.
+type ScheduleConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// SWIFTConfig configures the SWIFT rail. BIC, Name and Account identify the
+// bank and its account at the correspondent it pays cross-border payments
+// from; SWIFT payments go to the ACH stub when BIC is empty. OutboxDir is
+// where pain.001 files are dropped for the SWIFT connectivity to send.
+type SWIFTConfig struct {
+	BIC       string
+	Name      string
+	Account   string
+	OutboxDir string
+}
+
 type TelemetryConfig struct {
 	OTLPEndpoint string
 	ServiceName  string
@@ -43,6 +95,12 @@ func (c Config) Validate() {
 	}
 }
 
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
 // Load reads configuration from environment variables with defaults.
 func Load() Config {
 	return Config{
@@ -58,15 +116,59 @@ func Load() Config {
 			MaxConns: int32(getEnvInt("DB_MAX_CONNS", 20)), //nolint:gosec // bounded by env config
 			MinConns: int32(getEnvInt("DB_MIN_CONNS", 5)),  //nolint:gosec // bounded by env config
 		},
+		Archive: archive.StoreConfig{
+			Dir:         getEnv("ARCHIVE_DIR", ""),
+			S3Endpoint:  getEnv("ARCHIVE_S3_ENDPOINT", "https://s3.amazonaws.com"),
+			S3Region:    getEnv("ARCHIVE_S3_REGION", "us-east-1"),
+			S3Bucket:    getEnv("ARCHIVE_S3_BUCKET", ""),
+			S3AccessKey: getEnv("ARCHIVE_S3_ACCESS_KEY", ""),
+			S3SecretKey: getEnv("ARCHIVE_S3_SECRET_KEY", ""),
+		},
+		PII: crypto.KMSConfig{
+			MasterKey:    getEnv("PII_MASTER_KEY", ""),
+			AWSKeyID:     getEnv("PII_KMS_KEY_ID", ""),
+			AWSEndpoint:  getEnv("PII_KMS_ENDPOINT", ""),
+			AWSRegion:    getEnv("PII_KMS_REGION", "us-east-1"),
+			AWSAccessKey: getEnv("PII_KMS_ACCESS_KEY", ""),
+			AWSSecretKey: getEnv("PII_KMS_SECRET_KEY", ""),
+		},
 		Kafka: KafkaConfig{
 			Brokers: []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
 		},
+		Saga: SagaConfig{
+			AccountGRPCAddr:  getEnv("ACCOUNT_SERVICE_ADDR", ""),
+			LedgerGRPCAddr:   getEnv("LEDGER_SERVICE_ADDR", ""),
+			SigningKeyFile:   getEnv("PAYMENT_SAGA_SIGNING_KEY_FILE", ""),
+			SuspenseAccount:  getEnv("PAYMENT_SUSPENSE_ACCOUNT", "2900-001"),
+			ClearingAccount:  getEnv("PAYMENT_CLEARING_ACCOUNT", "1150-001"),
+			StepTimeout:      getEnvDuration("PAYMENT_SAGA_STEP_TIMEOUT", 30*time.Second),
+			RecoveryInterval: getEnvDuration("PAYMENT_SAGA_RECOVERY_INTERVAL", time.Minute),
+			StaleAfter:       getEnvDuration("PAYMENT_SAGA_STALE_AFTER", 5*time.Minute),
+			MaxAttempts:      getEnvInt("PAYMENT_SAGA_MAX_ATTEMPTS", 10),
+			MaxRetries:       getEnvInt("PAYMENT_SAGA_MAX_RETRIES", 3),
+			RetryBackoff:     getEnvDuration("PAYMENT_SAGA_RETRY_BACKOFF", 200*time.Millisecond),
+		},
+		Schedule: ScheduleConfig{
+			PollInterval: getEnvDuration("PAYMENT_SCHEDULE_POLL_INTERVAL", time.Minute),
+			BatchSize:    getEnvInt("PAYMENT_SCHEDULE_BATCH_SIZE", 100),
+		},
+		SWIFT: SWIFTConfig{
+			BIC:       getEnv("PAYMENT_SWIFT_BIC", ""),
+			Name:      getEnv("PAYMENT_SWIFT_NAME", "BIB Bank"),
+			Account:   getEnv("PAYMENT_SWIFT_ACCOUNT", ""),
+			OutboxDir: getEnv("PAYMENT_SWIFT_OUTBOX_DIR", ""),
+		},
 		Telemetry: TelemetryConfig{
 			OTLPEndpoint: getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4317"),
 			ServiceName:  "payment-service",
 		},
 		LogLevel:  getEnv("LOG_LEVEL", "info"),
 		LogFormat: getEnv("LOG_FORMAT", "json"),
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
+		Sandbox: getEnv("SANDBOX_MODE", "false") == "true",
 	}
 }
 
@@ -85,3 +187,40 @@ func getEnvInt(key string, defaultVal int) int {
 	}
 	return defaultVal
 }
+
+func getEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	if val := os.Getenv(key); val != "" {
+		if d, err := time.ParseDuration(val); err == nil {
+			return d
+		}
+	}
+	return defaultVal
+}
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
@@ -0,0 +1,21 @@
+-- Persisted state of payment sagas. Sagas span tenants' payments and are read
+-- by the recovery loop, so the table is not tenant-isolated.
+CREATE TABLE IF NOT EXISTS sagas (
+    id UUID PRIMARY KEY,
+    saga_type VARCHAR(100) NOT NULL,
+    correlation_id VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    step_index INT NOT NULL DEFAULT 0,
+    completed_steps JSONB NOT NULL DEFAULT '[]',
+    data JSONB NOT NULL DEFAULT '{}',
+    failed_step VARCHAR(100) NOT NULL DEFAULT '',
+    failure_reason TEXT NOT NULL DEFAULT '',
+    last_error TEXT NOT NULL DEFAULT '',
+    attempts INT NOT NULL DEFAULT 0,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX uq_sagas_correlation ON sagas (saga_type, correlation_id);
+CREATE INDEX idx_sagas_stalled ON sagas (updated_at) WHERE status IN ('RUNNING', 'COMPENSATING');
@@ -0,0 +1,44 @@
+-- Restore unpartitioned payment orders, bringing back the rows of every
+-- attached partition. Archived partitions are left in the archive schema.
+
+CREATE TABLE payment_orders_unpartitioned (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    source_account_id UUID NOT NULL,
+    destination_account_id UUID,
+    amount NUMERIC(19,4) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    rail VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'INITIATED',
+    routing_number VARCHAR(9) NOT NULL DEFAULT '',
+    external_account_number VARCHAR(34) NOT NULL DEFAULT '',
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    failure_reason TEXT NOT NULL DEFAULT '',
+    initiated_at TIMESTAMPTZ NOT NULL,
+    settled_at TIMESTAMPTZ,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO payment_orders_unpartitioned
+SELECT id, tenant_id, source_account_id, destination_account_id,
+       amount, currency, rail, status,
+       routing_number, external_account_number,
+       reference, description, failure_reason,
+       initiated_at, settled_at, version, created_at, updated_at
+FROM payment_orders;
+
+DROP TABLE payment_orders;
+
+ALTER TABLE payment_orders_unpartitioned RENAME TO payment_orders;
+ALTER TABLE payment_orders RENAME CONSTRAINT payment_orders_unpartitioned_pkey TO payment_orders_pkey;
+
+CREATE INDEX idx_payments_tenant ON payment_orders (tenant_id);
+CREATE INDEX idx_payments_source ON payment_orders (source_account_id);
+CREATE INDEX idx_payments_status ON payment_orders (status);
+
+ALTER TABLE payment_orders ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON payment_orders
+    USING (tenant_id::text = current_setting('app.tenant_id'));
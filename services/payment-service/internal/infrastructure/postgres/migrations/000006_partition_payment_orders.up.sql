@@ -0,0 +1,81 @@
+-- Partition payment orders by month of creation, and each month by hash of
+-- tenant, so that a month's orders can be archived once past retention and
+-- a large tenant's orders are spread across buckets.
+--
+-- Postgres requires a partitioned table's primary key to include every
+-- partition key, so it is (id, tenant_id, created_at); IDs are still
+-- generated as UUIDs and looked up through idx_payments_id.
+--
+-- Partitions covering existing rows and the next three months are created
+-- here; the partition manager creates later months ahead of time. The
+-- DEFAULT partition only catches rows if it falls behind.
+
+CREATE TABLE payment_orders_partitioned (
+    id UUID NOT NULL,
+    tenant_id UUID NOT NULL,
+    source_account_id UUID NOT NULL,
+    destination_account_id UUID,
+    amount NUMERIC(19,4) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    rail VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'INITIATED',
+    routing_number VARCHAR(9) NOT NULL DEFAULT '',
+    external_account_number VARCHAR(34) NOT NULL DEFAULT '',
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    failure_reason TEXT NOT NULL DEFAULT '',
+    initiated_at TIMESTAMPTZ NOT NULL,
+    settled_at TIMESTAMPTZ,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id, tenant_id, created_at)
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE payment_orders_default PARTITION OF payment_orders_partitioned DEFAULT;
+
+-- Monthly partitions of four tenant buckets each, with UTC bounds to match
+-- the partition manager.
+DO $$
+DECLARE
+    m TIMESTAMP;
+    month_partition TEXT;
+BEGIN
+    FOR m IN
+        SELECT generate_series(
+            date_trunc('month', LEAST(COALESCE(MIN(created_at), NOW()), NOW()) AT TIME ZONE 'UTC'),
+            date_trunc('month', NOW() AT TIME ZONE 'UTC') + INTERVAL '3 months',
+            INTERVAL '1 month')
+        FROM payment_orders
+    LOOP
+        month_partition := 'payment_orders_p' || to_char(m, 'YYYYMM');
+        EXECUTE format('CREATE TABLE %I PARTITION OF payment_orders_partitioned FOR VALUES FROM (%L) TO (%L) PARTITION BY HASH (tenant_id)',
+            month_partition, m || '+00', (m + INTERVAL '1 month') || '+00');
+        FOR b IN 0..3 LOOP
+            EXECUTE format('CREATE TABLE %I PARTITION OF %I FOR VALUES WITH (MODULUS 4, REMAINDER %s)',
+                month_partition || '_h' || b, month_partition, b);
+        END LOOP;
+    END LOOP;
+END $$;
+
+INSERT INTO payment_orders_partitioned
+SELECT id, tenant_id, source_account_id, destination_account_id,
+       amount, currency, rail, status,
+       routing_number, external_account_number,
+       reference, description, failure_reason,
+       initiated_at, settled_at, version, created_at, updated_at
+FROM payment_orders;
+
+DROP TABLE payment_orders;
+
+ALTER TABLE payment_orders_partitioned RENAME TO payment_orders;
+ALTER TABLE payment_orders RENAME CONSTRAINT payment_orders_partitioned_pkey TO payment_orders_pkey;
+
+CREATE INDEX idx_payments_id ON payment_orders (id);
+CREATE INDEX idx_payments_tenant ON payment_orders (tenant_id);
+CREATE INDEX idx_payments_source ON payment_orders (source_account_id);
+CREATE INDEX idx_payments_status ON payment_orders (status);
+
+ALTER TABLE payment_orders ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON payment_orders
+    USING (tenant_id::text = current_setting('app.tenant_id'));
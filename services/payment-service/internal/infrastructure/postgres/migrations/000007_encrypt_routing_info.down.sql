@@ -0,0 +1,5 @@
+-- Sealed values are longer than the old column and unreadable without
+-- data_keys: decrypt them before rolling back.
+ALTER TABLE payment_orders ALTER COLUMN routing_number TYPE VARCHAR(9),
+    ALTER COLUMN external_account_number TYPE VARCHAR(34);
+DROP TABLE IF EXISTS data_keys;
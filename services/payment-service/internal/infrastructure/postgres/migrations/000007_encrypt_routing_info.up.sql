@@ -0,0 +1,14 @@
+-- Wrapped per-tenant data keys personal data is encrypted under (see
+-- pkg/crypto). Not subject to row-level security: a value names the tenant
+-- whose key opens it.
+CREATE TABLE IF NOT EXISTS data_keys (
+    tenant_id UUID NOT NULL,
+    version INT NOT NULL,
+    wrapped_key BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, version)
+);
+
+-- Routing and external account numbers are stored encrypted.
+ALTER TABLE payment_orders ALTER COLUMN routing_number TYPE TEXT,
+    ALTER COLUMN external_account_number TYPE TEXT;
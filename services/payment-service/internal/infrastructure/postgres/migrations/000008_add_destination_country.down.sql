@@ -0,0 +1 @@
+ALTER TABLE payment_orders DROP COLUMN IF EXISTS destination_country;
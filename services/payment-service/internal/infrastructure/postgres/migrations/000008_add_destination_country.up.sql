@@ -0,0 +1,3 @@
+-- ISO 3166 alpha-2 country a payment's funds are sent to, when the caller
+-- supplied it; carried on payment.order.initiated for transaction monitoring.
+ALTER TABLE payment_orders ADD COLUMN IF NOT EXISTS destination_country VARCHAR(2) NOT NULL DEFAULT '';
@@ -0,0 +1,38 @@
+-- Standing instructions materialized into payment orders by the scheduler.
+-- Routing and external account numbers are stored encrypted, as on
+-- payment_orders.
+CREATE TABLE IF NOT EXISTS payment_schedules (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    source_account_id UUID NOT NULL,
+    destination_account_id UUID,
+    amount NUMERIC(19,4) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    routing_number TEXT NOT NULL DEFAULT '',
+    external_account_number TEXT NOT NULL DEFAULT '',
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    destination_country VARCHAR(2) NOT NULL DEFAULT '',
+    frequency VARCHAR(10) NOT NULL,
+    interval_count INT NOT NULL DEFAULT 0,
+    cron_expression VARCHAR(100) NOT NULL DEFAULT '',
+    start_at TIMESTAMPTZ NOT NULL,
+    end_at TIMESTAMPTZ,
+    max_runs INT NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'ACTIVE',
+    next_run_at TIMESTAMPTZ,
+    run_count INT NOT NULL DEFAULT 0,
+    last_payment_id UUID,
+    last_run_at TIMESTAMPTZ,
+    last_failure TEXT NOT NULL DEFAULT '',
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_payment_schedules_tenant ON payment_schedules (tenant_id);
+CREATE INDEX idx_payment_schedules_due ON payment_schedules (next_run_at) WHERE status = 'ACTIVE';
+
+ALTER TABLE payment_schedules ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON payment_schedules
+    USING (tenant_id::text = current_setting('app.tenant_id'));
@@ -0,0 +1,2 @@
+-- Failed sagas are listed for operators to repost once the cause is fixed.
+CREATE INDEX IF NOT EXISTS idx_sagas_failed ON sagas (updated_at) WHERE status = 'FAILED';
@@ -0,0 +1,40 @@
+-- Bulk payment files imported as sets of payment orders. Each instruction
+-- of a file is an entry, linked to the payment order it was initiated as or
+-- recording why it was rejected. Beneficiary names, routing and account
+-- numbers are stored encrypted, as on payment_orders.
+CREATE TABLE IF NOT EXISTS payment_batches (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    source_account_id UUID NOT NULL,
+    format VARCHAR(10) NOT NULL,
+    file_name VARCHAR(255) NOT NULL DEFAULT '',
+    file_hash VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A file is imported once per tenant.
+CREATE UNIQUE INDEX uq_payment_batches_file ON payment_batches (tenant_id, file_hash);
+
+CREATE TABLE IF NOT EXISTS payment_batch_entries (
+    batch_id UUID NOT NULL REFERENCES payment_batches (id) ON DELETE CASCADE,
+    sequence INT NOT NULL,
+    tenant_id UUID NOT NULL,
+    amount NUMERIC(19,4) NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT '',
+    beneficiary_name TEXT NOT NULL DEFAULT '',
+    routing_number TEXT NOT NULL DEFAULT '',
+    account_number TEXT NOT NULL DEFAULT '',
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    payment_id UUID,
+    error TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (batch_id, sequence)
+);
+
+ALTER TABLE payment_batches ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON payment_batches
+    USING (tenant_id::text = current_setting('app.tenant_id'));
+
+ALTER TABLE payment_batch_entries ENABLE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON payment_batch_entries
+    USING (tenant_id::text = current_setting('app.tenant_id'));
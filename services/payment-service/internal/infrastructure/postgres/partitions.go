@@ -0,0 +1,23 @@
+package postgres
+
+import (
+	"github.com/bibbank/bib/pkg/archive"
+	pgpkg "github.com/bibbank/bib/pkg/postgres"
+)
+
+// PartitionPolicies are the payment service's monthly partitioned tables,
+// created by migration 000006. Orders are split into four tenant buckets a
+// month and archived after two years.
+func PartitionPolicies() []pgpkg.PartitionPolicy {
+	return []pgpkg.PartitionPolicy{
+		{Table: "payment_orders", TenantBuckets: 4, Retain: 24, Expire: pgpkg.ExpireArchive},
+	}
+}
+
+// ArchivePolicies move archived months of payment orders to cold storage
+// after seven years.
+func ArchivePolicies() []archive.Policy {
+	return []archive.Policy{
+		{Table: "payment_orders", ColdAfter: 84},
+	}
+}
@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/crypto"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+// Compile-time interface check.
+var _ port.PaymentBatchRepository = (*PaymentBatchRepo)(nil)
+
+// PaymentBatchRepo implements PaymentBatchRepository using PostgreSQL.
+// Entries' beneficiary names, routing and account numbers are encrypted as
+// in PaymentOrderRepo.
+type PaymentBatchRepo struct {
+	pool *pgxpool.Pool
+	pii  *crypto.Cipher
+}
+
+func NewPaymentBatchRepo(pool *pgxpool.Pool, pii *crypto.Cipher) *PaymentBatchRepo {
+	return &PaymentBatchRepo{pool: pool, pii: pii}
+}
+
+func (r *PaymentBatchRepo) Save(ctx context.Context, batch model.PaymentBatch, orders []model.PaymentOrder) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }() //nolint:errcheck
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO payment_batches (id, tenant_id, source_account_id, format, file_name, file_hash, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`,
+		batch.ID(), batch.TenantID(), batch.SourceAccountID(), batch.Format().String(),
+		batch.FileName(), batch.FileHash(), batch.CreatedAt(),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return port.ErrDuplicateBatch
+	}
+	if err != nil {
+		return fmt.Errorf("insert payment batch: %w", err)
+	}
+
+	for _, e := range batch.Entries() {
+		var paymentID *uuid.UUID
+		if id := e.PaymentID(); id != uuid.Nil {
+			paymentID = &id
+		}
+		instr := e.Instruction()
+		_, err := tx.Exec(ctx, `
+			INSERT INTO payment_batch_entries (
+				batch_id, sequence, tenant_id, amount, currency,
+				beneficiary_name, routing_number, account_number,
+				reference, description, payment_id, error
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		`,
+			batch.ID(), e.Sequence(), batch.TenantID(), instr.Amount, instr.Currency,
+			r.pii.Arg(ctx, batch.TenantID(), instr.BeneficiaryName),
+			r.pii.Arg(ctx, batch.TenantID(), instr.RoutingNumber),
+			r.pii.Arg(ctx, batch.TenantID(), instr.AccountNumber),
+			instr.Reference, instr.Description, paymentID, e.Error(),
+		)
+		if err != nil {
+			return fmt.Errorf("insert payment batch entry %d: %w", e.Sequence(), err)
+		}
+	}
+
+	for _, order := range orders {
+		if err := saveOrder(ctx, tx, r.pii, order); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// FindByID loads a batch with its entries. Accepted entries take the status
+// and failure reason of their payment orders.
+func (r *PaymentBatchRepo) FindByID(ctx context.Context, id uuid.UUID) (model.PaymentBatch, error) {
+	var (
+		batchID, tenantID, sourceAcctID uuid.UUID
+		formatStr, fileName, fileHash   string
+		createdAt                       time.Time
+	)
+	err := r.pool.QueryRow(ctx, `
+		SELECT id, tenant_id, source_account_id, format, file_name, file_hash, created_at
+		FROM payment_batches WHERE id = $1
+	`, id).Scan(&batchID, &tenantID, &sourceAcctID, &formatStr, &fileName, &fileHash, &createdAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.PaymentBatch{}, fmt.Errorf("payment batch %s not found", id)
+	}
+	if err != nil {
+		return model.PaymentBatch{}, fmt.Errorf("query payment batch: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT e.sequence, e.amount, e.currency, e.beneficiary_name, e.routing_number, e.account_number,
+			e.reference, e.description, e.payment_id, COALESCE(o.status, 'INITIATED'),
+			CASE WHEN e.payment_id IS NULL THEN e.error ELSE COALESCE(o.failure_reason, '') END
+		FROM payment_batch_entries e
+		LEFT JOIN payment_orders o ON o.id = e.payment_id AND o.tenant_id = e.tenant_id
+		WHERE e.batch_id = $1
+		ORDER BY e.sequence
+	`, id)
+	if err != nil {
+		return model.PaymentBatch{}, fmt.Errorf("query payment batch entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []model.BatchEntry
+	for rows.Next() {
+		var (
+			sequence             int
+			instr                model.BatchInstruction
+			amount               decimal.Decimal
+			paymentID            *uuid.UUID
+			statusStr, errorText string
+		)
+		err := rows.Scan(
+			&sequence, &amount, &instr.Currency,
+			r.pii.Dest(ctx, &instr.BeneficiaryName), r.pii.Dest(ctx, &instr.RoutingNumber), r.pii.Dest(ctx, &instr.AccountNumber),
+			&instr.Reference, &instr.Description, &paymentID, &statusStr, &errorText,
+		)
+		if err != nil {
+			return model.PaymentBatch{}, fmt.Errorf("scan payment batch entry: %w", err)
+		}
+		instr.Amount = amount
+
+		var (
+			payment uuid.UUID
+			status  valueobject.PaymentStatus
+		)
+		if paymentID != nil {
+			payment = *paymentID
+			status, _ = valueobject.NewPaymentStatus(statusStr) //nolint:errcheck // DB stores valid values
+		}
+		entries = append(entries, model.ReconstructBatchEntry(sequence, instr, payment, status, errorText))
+	}
+	if err := rows.Err(); err != nil {
+		return model.PaymentBatch{}, fmt.Errorf("iterate payment batch entries: %w", err)
+	}
+
+	format, _ := valueobject.NewBatchFileFormat(formatStr) //nolint:errcheck // DB stores valid values
+	return model.ReconstructPaymentBatch(
+		batchID, tenantID, sourceAcctID, format, fileName, fileHash, entries, createdAt,
+	), nil
+}
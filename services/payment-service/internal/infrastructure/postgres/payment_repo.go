@@ -11,6 +11,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/shopspring/decimal"
 
+	"github.com/bibbank/bib/pkg/crypto"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
@@ -20,12 +21,15 @@ import (
 var _ port.PaymentOrderRepository = (*PaymentOrderRepo)(nil)
 
 // PaymentOrderRepo implements PaymentOrderRepository using PostgreSQL.
+// Routing and external account numbers are stored encrypted under the
+// tenant's data key; with a nil pii cipher they are stored in plaintext.
 type PaymentOrderRepo struct {
 	pool *pgxpool.Pool
+	pii  *crypto.Cipher
 }
 
-func NewPaymentOrderRepo(pool *pgxpool.Pool) *PaymentOrderRepo {
-	return &PaymentOrderRepo{pool: pool}
+func NewPaymentOrderRepo(pool *pgxpool.Pool, pii *crypto.Cipher) *PaymentOrderRepo {
+	return &PaymentOrderRepo{pool: pool, pii: pii}
 }
 
 func (r *PaymentOrderRepo) Save(ctx context.Context, order model.PaymentOrder) error {
@@ -35,21 +39,30 @@ func (r *PaymentOrderRepo) Save(ctx context.Context, order model.PaymentOrder) e
 	}
 	defer func() { _ = tx.Rollback(ctx) }() //nolint:errcheck
 
+	if err := saveOrder(ctx, tx, r.pii, order); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// saveOrder upserts order and writes its domain events to the outbox within
+// tx.
+func saveOrder(ctx context.Context, tx pgx.Tx, pii *crypto.Cipher, order model.PaymentOrder) error {
 	var destAcctID *uuid.UUID
 	if order.DestinationAccountID() != uuid.Nil {
 		id := order.DestinationAccountID()
 		destAcctID = &id
 	}
 
-	_, err = tx.Exec(ctx, `
+	_, err := tx.Exec(ctx, `
 		INSERT INTO payment_orders (
 			id, tenant_id, source_account_id, destination_account_id,
 			amount, currency, rail, status,
 			routing_number, external_account_number,
-			reference, description, failure_reason,
+			reference, description, failure_reason, destination_country,
 			initiated_at, settled_at, version, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
-		ON CONFLICT (id) DO UPDATE SET
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+		ON CONFLICT (id, tenant_id, created_at) DO UPDATE SET
 			status = EXCLUDED.status,
 			failure_reason = EXCLUDED.failure_reason,
 			settled_at = EXCLUDED.settled_at,
@@ -58,8 +71,9 @@ func (r *PaymentOrderRepo) Save(ctx context.Context, order model.PaymentOrder) e
 	`,
 		order.ID(), order.TenantID(), order.SourceAccountID(), destAcctID,
 		order.Amount(), order.Currency(), order.Rail().String(), order.Status().String(),
-		order.RoutingInfo().RoutingNumber(), order.RoutingInfo().ExternalAccountNumber(),
-		order.Reference(), order.Description(), order.FailureReason(),
+		pii.Arg(ctx, order.TenantID(), order.RoutingInfo().RoutingNumber()),
+		pii.Arg(ctx, order.TenantID(), order.RoutingInfo().ExternalAccountNumber()),
+		order.Reference(), order.Description(), order.FailureReason(), order.DestinationCountry(),
 		order.InitiatedAt(), order.SettledAt(), order.Version(), order.CreatedAt(), order.UpdatedAt(),
 	)
 	if err != nil {
@@ -80,8 +94,7 @@ func (r *PaymentOrderRepo) Save(ctx context.Context, order model.PaymentOrder) e
 			return fmt.Errorf("insert outbox event: %w", err)
 		}
 	}
-
-	return tx.Commit(ctx)
+	return nil
 }
 
 func (r *PaymentOrderRepo) FindByID(ctx context.Context, id uuid.UUID) (model.PaymentOrder, error) {
@@ -99,6 +112,7 @@ func (r *PaymentOrderRepo) FindByID(ctx context.Context, id uuid.UUID) (model.Pa
 		reference     string
 		description   string
 		failureReason string
+		country       string
 		initiatedAt   time.Time
 		settledAt     *time.Time
 		version       int
@@ -110,14 +124,14 @@ func (r *PaymentOrderRepo) FindByID(ctx context.Context, id uuid.UUID) (model.Pa
 		SELECT id, tenant_id, source_account_id, destination_account_id,
 			amount, currency, rail, status,
 			routing_number, external_account_number,
-			reference, description, failure_reason,
+			reference, description, failure_reason, destination_country,
 			initiated_at, settled_at, version, created_at, updated_at
 		FROM payment_orders WHERE id = $1
 	`, id).Scan(
 		&orderID, &tenantID, &sourceAcctID, &destAcctID,
 		&amount, &currency, &railStr, &statusStr,
-		&routingNumber, &extAcctNumber,
-		&reference, &description, &failureReason,
+		r.pii.Dest(ctx, &routingNumber), r.pii.Dest(ctx, &extAcctNumber),
+		&reference, &description, &failureReason, &country,
 		&initiatedAt, &settledAt, &version, &createdAt, &updatedAt,
 	)
 	if err != nil {
@@ -139,7 +153,7 @@ func (r *PaymentOrderRepo) FindByID(ctx context.Context, id uuid.UUID) (model.Pa
 	return model.Reconstruct(
 		orderID, tenantID, sourceAcctID, destinationAccountID,
 		amount, currency, rail, status, routingInfo,
-		reference, description, failureReason,
+		reference, description, failureReason, country,
 		initiatedAt, settledAt, version, createdAt, updatedAt,
 	), nil
 }
@@ -9,7 +9,7 @@ import (
 // TestNewPaymentOrderRepo tests the constructor.
 func TestNewPaymentOrderRepo(t *testing.T) {
 	t.Run("creates repo with nil pool", func(t *testing.T) {
-		repo := NewPaymentOrderRepo(nil)
+		repo := NewPaymentOrderRepo(nil, nil)
 		assert.NotNil(t, repo)
 		assert.Nil(t, repo.pool)
 	})
@@ -19,6 +19,6 @@ func TestNewPaymentOrderRepo(t *testing.T) {
 func TestPaymentOrderRepoImplementsInterface(t *testing.T) {
 	// The source file has:
 	//   var _ port.PaymentOrderRepository = (*PaymentOrderRepo)(nil)
-	repo := NewPaymentOrderRepo(nil)
+	repo := NewPaymentOrderRepo(nil, nil)
 	assert.NotNil(t, repo)
 }
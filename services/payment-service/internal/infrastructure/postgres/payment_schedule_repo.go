@@ -0,0 +1,237 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/crypto"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+// Compile-time interface check.
+var _ port.PaymentScheduleRepository = (*PaymentScheduleRepo)(nil)
+
+const scheduleColumns = `id, tenant_id, source_account_id, destination_account_id,
+	amount, currency, routing_number, external_account_number,
+	reference, description, destination_country,
+	frequency, interval_count, cron_expression, start_at, end_at, max_runs,
+	status, next_run_at, run_count, last_payment_id, last_run_at, last_failure,
+	version, created_at, updated_at`
+
+// PaymentScheduleRepo implements PaymentScheduleRepository using PostgreSQL.
+// Routing and external account numbers are encrypted as in PaymentOrderRepo.
+type PaymentScheduleRepo struct {
+	pool *pgxpool.Pool
+	pii  *crypto.Cipher
+}
+
+func NewPaymentScheduleRepo(pool *pgxpool.Pool, pii *crypto.Cipher) *PaymentScheduleRepo {
+	return &PaymentScheduleRepo{pool: pool, pii: pii}
+}
+
+func (r *PaymentScheduleRepo) Save(ctx context.Context, schedule model.PaymentSchedule) error {
+	return r.save(ctx, r.pool, schedule)
+}
+
+func (r *PaymentScheduleRepo) SaveRun(ctx context.Context, schedule model.PaymentSchedule, order model.PaymentOrder) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }() //nolint:errcheck
+
+	if err := r.save(ctx, tx, schedule); err != nil {
+		return err
+	}
+	if err := saveOrder(ctx, tx, r.pii, order); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// execer is satisfied by both *pgxpool.Pool and pgx.Tx.
+type execer interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+// save inserts a new schedule, or updates one whose stored version is one
+// behind it.
+func (r *PaymentScheduleRepo) save(ctx context.Context, db execer, s model.PaymentSchedule) error {
+	var destAcctID, lastPaymentID *uuid.UUID
+	if id := s.DestinationAccountID(); id != uuid.Nil {
+		destAcctID = &id
+	}
+	if id := s.LastPaymentID(); id != uuid.Nil {
+		lastPaymentID = &id
+	}
+
+	if s.Version() == 1 {
+		_, err := db.Exec(ctx, `
+			INSERT INTO payment_schedules (`+scheduleColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13,
+				$14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26)
+		`,
+			s.ID(), s.TenantID(), s.SourceAccountID(), destAcctID,
+			s.Amount(), s.Currency(),
+			r.pii.Arg(ctx, s.TenantID(), s.RoutingInfo().RoutingNumber()),
+			r.pii.Arg(ctx, s.TenantID(), s.RoutingInfo().ExternalAccountNumber()),
+			s.Reference(), s.Description(), s.DestinationCountry(),
+			s.Recurrence().Frequency(), s.Recurrence().Interval(), s.Recurrence().CronExpression(),
+			s.StartAt(), s.EndAt(), s.MaxRuns(),
+			s.Status().String(), s.NextRunAt(), s.RunCount(), lastPaymentID, s.LastRunAt(), s.LastFailure(),
+			s.Version(), s.CreatedAt(), s.UpdatedAt(),
+		)
+		if err != nil {
+			return fmt.Errorf("insert payment schedule: %w", err)
+		}
+		return nil
+	}
+
+	tag, err := db.Exec(ctx, `
+		UPDATE payment_schedules SET
+			status = $2, next_run_at = $3, run_count = $4, last_payment_id = $5,
+			last_run_at = $6, last_failure = $7, version = $8, updated_at = $9
+		WHERE id = $1 AND version = $8 - 1
+	`,
+		s.ID(), s.Status().String(), s.NextRunAt(), s.RunCount(), lastPaymentID,
+		s.LastRunAt(), s.LastFailure(), s.Version(), s.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("update payment schedule: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return port.ErrScheduleConflict
+	}
+	return nil
+}
+
+func (r *PaymentScheduleRepo) FindByID(ctx context.Context, id uuid.UUID) (model.PaymentSchedule, error) {
+	schedule, err := r.scan(ctx, r.pool.QueryRow(ctx, `SELECT `+scheduleColumns+` FROM payment_schedules WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.PaymentSchedule{}, fmt.Errorf("payment schedule %s not found", id)
+	}
+	if err != nil {
+		return model.PaymentSchedule{}, fmt.Errorf("query payment schedule: %w", err)
+	}
+	return schedule, nil
+}
+
+func (r *PaymentScheduleRepo) ListByTenant(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]model.PaymentSchedule, int, error) {
+	var total int
+	err := r.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM payment_schedules WHERE tenant_id = $1
+	`, tenantID).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count payment schedules: %w", err)
+	}
+
+	schedules, err := r.query(ctx, `
+		SELECT `+scheduleColumns+` FROM payment_schedules
+		WHERE tenant_id = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`, tenantID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return schedules, total, nil
+}
+
+func (r *PaymentScheduleRepo) ListDue(ctx context.Context, now time.Time, limit int) ([]model.PaymentSchedule, error) {
+	return r.query(ctx, `
+		SELECT `+scheduleColumns+` FROM payment_schedules
+		WHERE status = 'ACTIVE' AND next_run_at <= $1
+		ORDER BY next_run_at, id
+		LIMIT $2
+	`, now, limit)
+}
+
+func (r *PaymentScheduleRepo) query(ctx context.Context, sql string, args ...any) ([]model.PaymentSchedule, error) {
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query payment schedules: %w", err)
+	}
+	defer rows.Close()
+
+	var schedules []model.PaymentSchedule
+	for rows.Next() {
+		schedule, err := r.scan(ctx, rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan payment schedule: %w", err)
+		}
+		schedules = append(schedules, schedule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate payment schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+func (r *PaymentScheduleRepo) scan(ctx context.Context, row pgx.Row) (model.PaymentSchedule, error) {
+	var (
+		id, tenantID, sourceAcctID  uuid.UUID
+		destAcctID, lastPaymentID   *uuid.UUID
+		amount                      decimal.Decimal
+		currency                    string
+		routingNumber, extAcctNum   string
+		reference, description      string
+		country                     string
+		frequency, cronExpr         string
+		interval, maxRuns, runCount int
+		startAt                     time.Time
+		endAt, nextRunAt, lastRunAt *time.Time
+		statusStr, lastFailure      string
+		version                     int
+		createdAt, updatedAt        time.Time
+	)
+	err := row.Scan(
+		&id, &tenantID, &sourceAcctID, &destAcctID,
+		&amount, &currency, r.pii.Dest(ctx, &routingNumber), r.pii.Dest(ctx, &extAcctNum),
+		&reference, &description, &country,
+		&frequency, &interval, &cronExpr, &startAt, &endAt, &maxRuns,
+		&statusStr, &nextRunAt, &runCount, &lastPaymentID, &lastRunAt, &lastFailure,
+		&version, &createdAt, &updatedAt,
+	)
+	if err != nil {
+		return model.PaymentSchedule{}, err
+	}
+
+	routingInfo, _ := valueobject.NewRoutingInfo(routingNumber, extAcctNum)   //nolint:errcheck // DB stores valid values
+	recurrence, _ := valueobject.NewRecurrence(frequency, interval, cronExpr) //nolint:errcheck // DB stores valid values
+	status, _ := valueobject.NewScheduleStatus(statusStr)                     //nolint:errcheck // DB stores valid values
+
+	var destinationAccountID, lastPayment uuid.UUID
+	if destAcctID != nil {
+		destinationAccountID = *destAcctID
+	}
+	if lastPaymentID != nil {
+		lastPayment = *lastPaymentID
+	}
+
+	return model.ReconstructPaymentSchedule(
+		id, tenantID, sourceAcctID, destinationAccountID,
+		amount, currency, routingInfo,
+		reference, description, country,
+		recurrence, startAt.UTC(), utcPtr(endAt), maxRuns,
+		status, utcPtr(nextRunAt), runCount, lastPayment, utcPtr(lastRunAt), lastFailure,
+		version, createdAt, updatedAt,
+	), nil
+}
+
+func utcPtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	u := t.UTC()
+	return &u
+}
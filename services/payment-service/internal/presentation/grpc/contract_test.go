@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	grpclib "google.golang.org/grpc"
+
+	paymentv1 "github.com/bibbank/bib/api/gen/go/bib/payment/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractScheduleID is the payment schedule the gateway's recorded calls
+// act on.
+var contractScheduleID = uuid.MustParse("e7b2c9d4-1f3a-4b6c-8d5e-9a0b1c2d3e4f")
+
+// contractBatchID is the payment batch the gateway's recorded calls act on.
+var contractBatchID = uuid.MustParse("3c9e1f7a-5b2d-4e8c-a6f0-7d1b2c3e4f5a")
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	repo, schedules, batches := &mockPaymentRepo{}, &mockScheduleRepo{}, &mockBatchRepo{}
+	h := buildHandlerWithRepos(repo, schedules, batches)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { paymentv1.RegisterPaymentServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-payment-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) {
+			repo.findByIDFunc, repo.listFunc = nil, nil
+			schedules.schedules = nil
+			batches.batches = nil
+		},
+		"a payment exists": func(*testing.T) {
+			payment := makeTestPaymentOrder()
+			repo.findByIDFunc = func(context.Context, uuid.UUID) (model.PaymentOrder, error) {
+				return payment, nil
+			}
+			repo.listFunc = func(context.Context, uuid.UUID, int, int) ([]model.PaymentOrder, int, error) {
+				return []model.PaymentOrder{payment}, 1, nil
+			}
+		},
+		"a settled payment exists": func(*testing.T) {
+			now := time.Now().UTC()
+			payment := model.Reconstruct(
+				uuid.New(), contractTenantID, uuid.New(), uuid.Nil,
+				decimal.NewFromInt(100), "USD", valueobject.RailACH, valueobject.PaymentStatusSettled,
+				valueobject.RoutingInfo{}, "REF-001", "Rent", "", "",
+				now, &now, 1, now, now,
+			)
+			repo.findByIDFunc = func(context.Context, uuid.UUID) (model.PaymentOrder, error) {
+				return payment, nil
+			}
+		},
+		"a payment schedule exists": func(t *testing.T) {
+			recurrence, err := valueobject.NewRecurrence(valueobject.FrequencyMonthly, 1, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			now := time.Now().UTC()
+			next := now.Add(time.Hour)
+			schedules.schedules = map[uuid.UUID]model.PaymentSchedule{contractScheduleID: model.ReconstructPaymentSchedule(
+				contractScheduleID, contractTenantID, uuid.New(), uuid.Nil,
+				decimal.NewFromInt(100), "USD", valueobject.RoutingInfo{},
+				"REF-001", "Rent", "",
+				recurrence, next, nil, 0,
+				valueobject.ScheduleStatusActive, &next, 0, uuid.Nil, nil, "",
+				1, now, now,
+			)}
+		},
+		"a payment batch exists": func(*testing.T) {
+			entries := []model.BatchEntry{
+				model.ReconstructBatchEntry(1, model.BatchInstruction{
+					Amount: decimal.NewFromInt(100), Currency: "USD", Reference: "E2E-1",
+				}, uuid.New(), valueobject.PaymentStatusSettled, ""),
+				model.NewRejectedBatchEntry(2, model.BatchInstruction{
+					Amount: decimal.NewFromInt(200), Currency: "USD", Reference: "E2E-2",
+				}, "creditor account is required"),
+			}
+			batches.batches = map[uuid.UUID]model.PaymentBatch{contractBatchID: model.ReconstructPaymentBatch(
+				contractBatchID, contractTenantID, uuid.New(), valueobject.BatchFileFormatPain001,
+				"suppliers.xml", "hash", entries, time.Now().UTC(),
+			)}
+		},
+	})
+}
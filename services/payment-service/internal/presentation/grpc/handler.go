@@ -2,17 +2,26 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/bibbank/bib/pkg/auth"
-	"github.com/bibbank/bib/services/payment-service/internal/application/dto"
-	"github.com/bibbank/bib/services/payment-service/internal/application/usecase"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	paymentv1 "github.com/bibbank/bib/api/gen/go/bib/payment/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/payment-service/internal/application/dto"
+	"github.com/bibbank/bib/services/payment-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
+	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
 )
 
 var currencyCodeRE = regexp.MustCompile(`^[A-Z]{3}$`)
@@ -41,14 +50,20 @@ func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
 }
 
 // Compile-time assertion that PaymentHandler implements PaymentServiceServer.
-var _ PaymentServiceServer = (*PaymentHandler)(nil)
+var _ paymentv1.PaymentServiceServer = (*PaymentHandler)(nil)
 
 // PaymentHandler implements the gRPC PaymentService server.
 type PaymentHandler struct {
-	UnimplementedPaymentServiceServer
+	paymentv1.UnimplementedPaymentServiceServer
 	initiatePayment *usecase.InitiatePayment
 	getPayment      *usecase.GetPayment
 	listPayments    *usecase.ListPayments
+	schedulePayment *usecase.SchedulePayment
+	listSchedules   *usecase.ListPaymentSchedules
+	cancelSchedule  *usecase.CancelPaymentSchedule
+	processPayment  *usecase.ProcessPayment
+	importBatch     *usecase.ImportPaymentBatch
+	getBatch        *usecase.GetPaymentBatch
 
 	logger *slog.Logger
 }
@@ -57,95 +72,29 @@ func NewPaymentHandler(
 	initiatePayment *usecase.InitiatePayment,
 	getPayment *usecase.GetPayment,
 	listPayments *usecase.ListPayments,
+	schedulePayment *usecase.SchedulePayment,
+	listSchedules *usecase.ListPaymentSchedules,
+	cancelSchedule *usecase.CancelPaymentSchedule,
+	processPayment *usecase.ProcessPayment,
+	importBatch *usecase.ImportPaymentBatch,
+	getBatch *usecase.GetPaymentBatch,
 	logger *slog.Logger,
 ) *PaymentHandler {
 	return &PaymentHandler{
 		initiatePayment: initiatePayment,
 		getPayment:      getPayment,
 		listPayments:    listPayments,
+		schedulePayment: schedulePayment,
+		listSchedules:   listSchedules,
+		cancelSchedule:  cancelSchedule,
+		processPayment:  processPayment,
+		importBatch:     importBatch,
+		getBatch:        getBatch,
 
 		logger: logger}
 }
 
-// InitiatePayment implements PaymentServiceServer by delegating to HandleInitiatePayment.
-func (h *PaymentHandler) InitiatePayment(ctx context.Context, req *InitiatePaymentRequest) (*InitiatePaymentResponse, error) {
-	return h.HandleInitiatePayment(ctx, req)
-}
-
-// GetPayment implements PaymentServiceServer by delegating to HandleGetPayment.
-func (h *PaymentHandler) GetPayment(ctx context.Context, req *GetPaymentRequestMsg) (*GetPaymentResponseMsg, error) {
-	return h.HandleGetPayment(ctx, req)
-}
-
-// ListPayments implements PaymentServiceServer by delegating to HandleListPayments.
-func (h *PaymentHandler) ListPayments(ctx context.Context, req *ListPaymentsRequestMsg) (*ListPaymentsResponseMsg, error) {
-	return h.HandleListPayments(ctx, req)
-}
-
-// Temporary gRPC message types until proto generation is wired.
-
-type InitiatePaymentRequest struct {
-	TenantID              string `json:"tenant_id"`
-	SourceAccountID       string `json:"source_account_id"`
-	DestinationAccountID  string `json:"destination_account_id,omitempty"`
-	Amount                string `json:"amount"`
-	Currency              string `json:"currency"`
-	RoutingNumber         string `json:"routing_number,omitempty"`
-	ExternalAccountNumber string `json:"external_account_number,omitempty"`
-	DestinationCountry    string `json:"destination_country,omitempty"`
-	Reference             string `json:"reference,omitempty"`
-	Description           string `json:"description,omitempty"`
-}
-
-type InitiatePaymentResponse struct {
-	ID        string `json:"id"`
-	Status    string `json:"status"`
-	Rail      string `json:"rail"`
-	CreatedAt string `json:"created_at"`
-}
-
-type GetPaymentRequestMsg struct {
-	PaymentID string `json:"payment_id"`
-}
-
-type PaymentOrderMsg struct {
-	ID                    string `json:"id"`
-	TenantID              string `json:"tenant_id"`
-	SourceAccountID       string `json:"source_account_id"`
-	DestinationAccountID  string `json:"destination_account_id"`
-	Amount                string `json:"amount"`
-	Currency              string `json:"currency"`
-	RoutingNumber         string `json:"routing_number"`
-	ExternalAccountNumber string `json:"external_account_number"`
-	Rail                  string `json:"rail"`
-	Status                string `json:"status"`
-	Reference             string `json:"reference"`
-	Description           string `json:"description"`
-	FailureReason         string `json:"failure_reason,omitempty"`
-	InitiatedAt           string `json:"initiated_at"`
-	SettledAt             string `json:"settled_at,omitempty"`
-	UpdatedAt             string `json:"updated_at"`
-	CreatedAt             string `json:"created_at"`
-	Version               int32  `json:"version"`
-}
-
-type GetPaymentResponseMsg struct {
-	Payment *PaymentOrderMsg `json:"payment"`
-}
-
-type ListPaymentsRequestMsg struct {
-	TenantID  string `json:"tenant_id"`
-	AccountID string `json:"account_id"`
-	PageSize  int32  `json:"page_size"`
-	Offset    int32  `json:"offset"`
-}
-
-type ListPaymentsResponseMsg struct {
-	Payments   []*PaymentOrderMsg `json:"payments"`
-	TotalCount int32              `json:"total_count"`
-}
-
-func (h *PaymentHandler) HandleInitiatePayment(ctx context.Context, req *InitiatePaymentRequest) (*InitiatePaymentResponse, error) {
+func (h *PaymentHandler) InitiatePayment(ctx context.Context, req *paymentv1.InitiatePaymentRequest) (*paymentv1.InitiatePaymentResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -159,20 +108,20 @@ func (h *PaymentHandler) HandleInitiatePayment(ctx context.Context, req *Initiat
 		return nil, err
 	}
 
-	sourceAcctID, err := uuid.Parse(req.SourceAccountID)
+	sourceAcctID, err := uuid.Parse(req.GetSourceAccountId())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid source_account_id: %v", err)
 	}
 
 	var destAcctID uuid.UUID
-	if req.DestinationAccountID != "" {
-		destAcctID, err = uuid.Parse(req.DestinationAccountID)
+	if req.GetDestinationAccountId() != "" {
+		destAcctID, err = uuid.Parse(req.GetDestinationAccountId())
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid destination_account_id: %v", err)
 		}
 	}
 
-	amount, err := decimal.NewFromString(req.Amount)
+	amount, err := decimal.NewFromString(req.GetAmount().GetAmount())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
 	}
@@ -180,10 +129,11 @@ func (h *PaymentHandler) HandleInitiatePayment(ctx context.Context, req *Initiat
 		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
 	}
 
-	if req.Currency == "" {
+	currency := req.GetAmount().GetCurrency()
+	if currency == "" {
 		return nil, status.Error(codes.InvalidArgument, "currency is required")
 	}
-	if !currencyCodeRE.MatchString(req.Currency) {
+	if !currencyCodeRE.MatchString(currency) {
 		return nil, status.Error(codes.InvalidArgument, "currency must be a 3-letter uppercase ISO code")
 	}
 
@@ -192,27 +142,30 @@ func (h *PaymentHandler) HandleInitiatePayment(ctx context.Context, req *Initiat
 		SourceAccountID:       sourceAcctID,
 		DestinationAccountID:  destAcctID,
 		Amount:                amount,
-		Currency:              req.Currency,
-		RoutingNumber:         req.RoutingNumber,
-		ExternalAccountNumber: req.ExternalAccountNumber,
-		DestinationCountry:    req.DestinationCountry,
-		Reference:             req.Reference,
-		Description:           req.Description,
+		Currency:              currency,
+		RoutingNumber:         req.GetRoutingNumber(),
+		ExternalAccountNumber: req.GetExternalAccountNumber(),
+		DestinationCountry:    req.GetDestinationCountry(),
+		Reference:             req.GetReference(),
+		Description:           req.GetDescription(),
 	})
 	if err != nil {
 		h.logger.Error("handler error", "error", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	return &InitiatePaymentResponse{
-		ID:        result.ID.String(),
-		Status:    result.Status,
-		Rail:      result.Rail,
-		CreatedAt: result.CreatedAt.Format(time.RFC3339),
+	return &paymentv1.InitiatePaymentResponse{
+		Order: &paymentv1.PaymentOrder{
+			Id:          result.ID.String(),
+			Status:      paymentStatus(result.Status),
+			Rail:        paymentRail(result.Rail),
+			InitiatedAt: timestamppb.New(result.CreatedAt),
+			Audit:       &commonv1.AuditInfo{CreatedAt: timestamppb.New(result.CreatedAt)},
+		},
 	}, nil
 }
 
-func (h *PaymentHandler) HandleGetPayment(ctx context.Context, req *GetPaymentRequestMsg) (*GetPaymentResponseMsg, error) {
+func (h *PaymentHandler) GetPayment(ctx context.Context, req *paymentv1.GetPaymentRequest) (*paymentv1.GetPaymentResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -221,9 +174,9 @@ func (h *PaymentHandler) HandleGetPayment(ctx context.Context, req *GetPaymentRe
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
 
-	paymentID, err := uuid.Parse(req.PaymentID)
+	paymentID, err := uuid.Parse(req.GetId())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid payment_id: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
 	}
 
 	result, err := h.getPayment.Execute(ctx, dto.GetPaymentRequest{
@@ -234,12 +187,12 @@ func (h *PaymentHandler) HandleGetPayment(ctx context.Context, req *GetPaymentRe
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	return &GetPaymentResponseMsg{
-		Payment: toPaymentOrderMsg(result),
+	return &paymentv1.GetPaymentResponse{
+		Order: toPaymentOrderMsg(result),
 	}, nil
 }
 
-func (h *PaymentHandler) HandleListPayments(ctx context.Context, req *ListPaymentsRequestMsg) (*ListPaymentsResponseMsg, error) {
+func (h *PaymentHandler) ListPayments(ctx context.Context, req *paymentv1.ListPaymentsRequest) (*paymentv1.ListPaymentsResponse, error) {
 	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
@@ -248,15 +201,9 @@ func (h *PaymentHandler) HandleListPayments(ctx context.Context, req *ListPaymen
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
 
-	pageSize := req.PageSize
-	if pageSize == 0 {
-		pageSize = 20
-	}
-	if pageSize < 0 || pageSize > 100 {
-		return nil, status.Error(codes.InvalidArgument, "page_size must be between 1 and 100")
-	}
-	if req.Offset < 0 {
-		return nil, status.Error(codes.InvalidArgument, "offset must be >= 0")
+	pageSize, offset, err := readPagination(req.GetPagination())
+	if err != nil {
+		return nil, err
 	}
 
 	tenantID, err := tenantIDFromContext(ctx)
@@ -265,8 +212,8 @@ func (h *PaymentHandler) HandleListPayments(ctx context.Context, req *ListPaymen
 	}
 
 	var accountID uuid.UUID
-	if req.AccountID != "" {
-		accountID, err = uuid.Parse(req.AccountID)
+	if req.GetAccountId() != "" {
+		accountID, err = uuid.Parse(req.GetAccountId())
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid account_id: %v", err)
 		}
@@ -275,47 +222,518 @@ func (h *PaymentHandler) HandleListPayments(ctx context.Context, req *ListPaymen
 	result, err := h.listPayments.Execute(ctx, dto.ListPaymentsRequest{
 		TenantID:  tenantID,
 		AccountID: accountID,
-		PageSize:  int(pageSize),
-		Offset:    int(req.Offset),
+		PageSize:  pageSize,
+		Offset:    offset,
 	})
 	if err != nil {
 		h.logger.Error("handler error", "error", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
-	var payments []*PaymentOrderMsg
+	orders := make([]*paymentv1.PaymentOrder, 0, len(result.Payments))
 	for _, p := range result.Payments {
-		payments = append(payments, toPaymentOrderMsg(p))
+		orders = append(orders, toPaymentOrderMsg(p))
 	}
 
-	return &ListPaymentsResponseMsg{
-		Payments:   payments,
-		TotalCount: int32(result.TotalCount), //nolint:gosec // bounded
+	return &paymentv1.ListPaymentsResponse{
+		Orders:     orders,
+		Pagination: pageResponse(offset, len(orders), result.TotalCount),
 	}, nil
 }
 
-func toPaymentOrderMsg(r dto.PaymentOrderResponse) *PaymentOrderMsg {
-	msg := &PaymentOrderMsg{
-		ID:                    r.ID.String(),
-		TenantID:              r.TenantID.String(),
-		SourceAccountID:       r.SourceAccountID.String(),
-		DestinationAccountID:  r.DestinationAccountID.String(),
-		Amount:                r.Amount.StringFixed(2),
-		Currency:              r.Currency,
-		Rail:                  r.Rail,
-		Status:                r.Status,
+// readPagination returns the page size and offset of a list request. The
+// page token is the offset of the page, as callers page through a tenant's
+// payments in order.
+func readPagination(p *commonv1.Pagination) (pageSize, offset int, err error) {
+	size := p.GetPageSize()
+	if size == 0 {
+		size = 20
+	}
+	if size < 0 || size > 100 {
+		return 0, 0, status.Error(codes.InvalidArgument, "page_size must be between 1 and 100")
+	}
+	if token := p.GetPageToken(); token != "" {
+		n, convErr := strconv.Atoi(token)
+		if convErr != nil || n < 0 {
+			return 0, 0, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		offset = n
+	}
+	return int(size), offset, nil
+}
+
+// pageResponse reports the total and, unless the page of n items starting
+// at offset is the last, the token of the next page.
+func pageResponse(offset, n, total int) *commonv1.PaginationResponse {
+	page := &commonv1.PaginationResponse{
+		TotalCount: int32(total), //nolint:gosec // bounded
+	}
+	if next := offset + n; n > 0 && next < total {
+		page.NextPageToken = strconv.Itoa(next)
+	}
+	return page
+}
+
+func toPaymentOrderMsg(r dto.PaymentOrderResponse) *paymentv1.PaymentOrder {
+	msg := &paymentv1.PaymentOrder{
+		Id:                    r.ID.String(),
+		TenantId:              r.TenantID.String(),
+		SourceAccountId:       r.SourceAccountID.String(),
+		DestinationAccountId:  r.DestinationAccountID.String(),
+		Amount:                &commonv1.Money{Amount: r.Amount.StringFixed(2), Currency: r.Currency},
+		Rail:                  paymentRail(r.Rail),
+		Status:                paymentStatus(r.Status),
 		RoutingNumber:         r.RoutingNumber,
 		ExternalAccountNumber: r.ExternalAccountNumber,
 		Reference:             r.Reference,
 		Description:           r.Description,
 		FailureReason:         r.FailureReason,
-		InitiatedAt:           r.InitiatedAt.Format(time.RFC3339),
-		Version:               int32(r.Version), //nolint:gosec // bounded
-		CreatedAt:             r.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:             r.UpdatedAt.Format(time.RFC3339),
+		Counterparty: &paymentv1.Counterparty{
+			Name:             r.Counterparty.Name,
+			MaskedIdentifier: r.Counterparty.MaskedIdentifier,
+			Category:         r.Counterparty.Category,
+			Logo:             r.Counterparty.Logo,
+		},
+		InitiatedAt: timestamppb.New(r.InitiatedAt),
+		Audit:       toAuditInfo(r.CreatedAt, r.UpdatedAt, r.Version),
 	}
 	if r.SettledAt != nil {
-		msg.SettledAt = r.SettledAt.Format(time.RFC3339)
+		msg.SettledAt = timestamppb.New(*r.SettledAt)
+	}
+	return msg
+}
+
+func toAuditInfo(createdAt, updatedAt time.Time, version int) *commonv1.AuditInfo {
+	return &commonv1.AuditInfo{
+		CreatedAt: timestamppb.New(createdAt),
+		UpdatedAt: timestamppb.New(updatedAt),
+		Version:   int32(version), //nolint:gosec // versions stay far below MaxInt32
+	}
+}
+
+func paymentStatus(s string) paymentv1.PaymentStatus {
+	return paymentv1.PaymentStatus(paymentv1.PaymentStatus_value["PAYMENT_STATUS_"+s])
+}
+
+func paymentRail(s string) paymentv1.PaymentRail {
+	return paymentv1.PaymentRail(paymentv1.PaymentRail_value["PAYMENT_RAIL_"+s])
+}
+
+func (h *PaymentHandler) SchedulePayment(ctx context.Context, req *paymentv1.SchedulePaymentRequest) (*paymentv1.SchedulePaymentResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceAcctID, err := uuid.Parse(req.GetSourceAccountId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid source_account_id: %v", err)
+	}
+
+	var destAcctID uuid.UUID
+	if req.GetDestinationAccountId() != "" {
+		destAcctID, err = uuid.Parse(req.GetDestinationAccountId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid destination_account_id: %v", err)
+		}
+	}
+
+	amount, err := decimal.NewFromString(req.GetAmount().GetAmount())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
+	}
+	if !amount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+
+	currency := req.GetAmount().GetCurrency()
+	if !currencyCodeRE.MatchString(currency) {
+		return nil, status.Error(codes.InvalidArgument, "currency must be a 3-letter uppercase ISO code")
+	}
+
+	frequency := enumName(req.GetFrequency().String(), "SCHEDULE_FREQUENCY_")
+	if _, err := valueobject.NewRecurrence(frequency, int(req.GetInterval()), req.GetCronExpression()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.GetStartAt() == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_at is required")
+	}
+	startAt, err := optionalTime("start_at", req.GetStartAt())
+	if err != nil {
+		return nil, err
+	}
+	var endAt *time.Time
+	if req.GetEndAt() != nil {
+		end, timeErr := optionalTime("end_at", req.GetEndAt())
+		if timeErr != nil {
+			return nil, timeErr
+		}
+		if end.Before(startAt) {
+			return nil, status.Error(codes.InvalidArgument, "end_at must not be before start_at")
+		}
+		endAt = &end
+	}
+	if req.GetMaxRuns() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_runs must be >= 0")
+	}
+
+	result, err := h.schedulePayment.Execute(ctx, dto.SchedulePaymentRequest{
+		TenantID:              tenantID,
+		SourceAccountID:       sourceAcctID,
+		DestinationAccountID:  destAcctID,
+		Amount:                amount,
+		Currency:              currency,
+		RoutingNumber:         req.GetRoutingNumber(),
+		ExternalAccountNumber: req.GetExternalAccountNumber(),
+		DestinationCountry:    req.GetDestinationCountry(),
+		Reference:             req.GetReference(),
+		Description:           req.GetDescription(),
+		Frequency:             frequency,
+		Interval:              int(req.GetInterval()),
+		CronExpression:        req.GetCronExpression(),
+		StartAt:               startAt,
+		EndAt:                 endAt,
+		MaxRuns:               int(req.GetMaxRuns()),
+	})
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &paymentv1.SchedulePaymentResponse{Schedule: toPaymentScheduleMsg(result)}, nil
+}
+
+func (h *PaymentHandler) ListPaymentSchedules(ctx context.Context, req *paymentv1.ListPaymentSchedulesRequest) (*paymentv1.ListPaymentSchedulesResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	pageSize, offset, err := readPagination(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.listSchedules.Execute(ctx, dto.ListPaymentSchedulesRequest{
+		TenantID: tenantID,
+		PageSize: pageSize,
+		Offset:   offset,
+	})
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	schedules := make([]*paymentv1.PaymentSchedule, 0, len(result.Schedules))
+	for _, s := range result.Schedules {
+		schedules = append(schedules, toPaymentScheduleMsg(s))
+	}
+
+	return &paymentv1.ListPaymentSchedulesResponse{
+		Schedules:  schedules,
+		Pagination: pageResponse(offset, len(schedules), result.TotalCount),
+	}, nil
+}
+
+func (h *PaymentHandler) CancelPaymentSchedule(ctx context.Context, req *paymentv1.CancelPaymentScheduleRequest) (*paymentv1.CancelPaymentScheduleResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleID, err := uuid.Parse(req.GetScheduleId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schedule_id: %v", err)
+	}
+
+	result, err := h.cancelSchedule.Execute(ctx, dto.CancelPaymentScheduleRequest{
+		TenantID:   tenantID,
+		ScheduleID: scheduleID,
+	})
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &paymentv1.CancelPaymentScheduleResponse{Schedule: toPaymentScheduleMsg(result)}, nil
+}
+
+func toPaymentScheduleMsg(r dto.PaymentScheduleResponse) *paymentv1.PaymentSchedule {
+	msg := &paymentv1.PaymentSchedule{
+		Id:                    r.ID.String(),
+		TenantId:              r.TenantID.String(),
+		SourceAccountId:       r.SourceAccountID.String(),
+		DestinationAccountId:  r.DestinationAccountID.String(),
+		Amount:                &commonv1.Money{Amount: r.Amount.StringFixed(2), Currency: r.Currency},
+		RoutingNumber:         r.RoutingNumber,
+		ExternalAccountNumber: r.ExternalAccountNumber,
+		DestinationCountry:    r.DestinationCountry,
+		Reference:             r.Reference,
+		Description:           r.Description,
+		Frequency:             paymentv1.ScheduleFrequency(paymentv1.ScheduleFrequency_value["SCHEDULE_FREQUENCY_"+r.Frequency]),
+		Interval:              int32(r.Interval), //nolint:gosec // bounded
+		CronExpression:        r.CronExpression,
+		StartAt:               timestamppb.New(r.StartAt),
+		MaxRuns:               int32(r.MaxRuns), //nolint:gosec // bounded
+		Status:                paymentv1.ScheduleStatus(paymentv1.ScheduleStatus_value["SCHEDULE_STATUS_"+r.Status]),
+		RunCount:              int32(r.RunCount), //nolint:gosec // bounded
+		LastFailure:           r.LastFailure,
+		Audit: &commonv1.AuditInfo{
+			CreatedAt: timestamppb.New(r.CreatedAt),
+			UpdatedAt: timestamppb.New(r.UpdatedAt),
+		},
+	}
+	if r.EndAt != nil {
+		msg.EndAt = timestamppb.New(*r.EndAt)
+	}
+	if r.NextRunAt != nil {
+		msg.NextRunAt = timestamppb.New(*r.NextRunAt)
+	}
+	if r.LastRunAt != nil {
+		msg.LastRunAt = timestamppb.New(*r.LastRunAt)
+	}
+	if r.LastPaymentID != uuid.Nil {
+		msg.LastPaymentId = r.LastPaymentID.String()
 	}
 	return msg
 }
+
+// defaultRepostLimit bounds RepostStuckPayments when no limit is given.
+const defaultRepostLimit = 100
+
+// RepostStuckPayments retries the sagas of payments that ran out of
+// attempts, such as while the ledger was down. Sagas span tenants, so only
+// platform admins may repost.
+func (h *PaymentHandler) RepostStuckPayments(ctx context.Context, req *paymentv1.RepostStuckPaymentsRequest) (*paymentv1.RepostStuckPaymentsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if req.GetPaymentId() != "" {
+		paymentID, err := uuid.Parse(req.GetPaymentId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid payment_id: %v", err)
+		}
+		if err := h.processPayment.Repost(ctx, paymentID); err != nil {
+			if errors.Is(err, usecase.ErrPaymentSagaNotFound) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			h.logger.Error("payment repost failed", "payment_id", paymentID, "error", err)
+			return nil, status.Errorf(codes.FailedPrecondition, "payment not reposted: %v", err)
+		}
+		return &paymentv1.RepostStuckPaymentsResponse{Reposted: 1}, nil
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultRepostLimit
+	}
+	reposted, err := h.processPayment.RepostFailed(ctx, limit)
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &paymentv1.RepostStuckPaymentsResponse{Reposted: int32(reposted)}, nil //nolint:gosec // bounded by limit
+}
+
+// ReversePayment reverses a settled payment of the caller's tenant,
+// posting the reversing journal entry and marking the order reversed. A
+// reversal that fails part way is finished by saga recovery, or by calling
+// again.
+func (h *PaymentHandler) ReversePayment(ctx context.Context, req *paymentv1.ReversePaymentRequest) (*paymentv1.ReversePaymentResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	paymentID, err := uuid.Parse(req.GetPaymentId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid payment_id: %v", err)
+	}
+	if req.GetReason() == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.processPayment.Reverse(ctx, tenantID, paymentID, req.GetReason()); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrPaymentNotFound):
+			return nil, status.Error(codes.NotFound, "payment not found")
+		case errors.Is(err, usecase.ErrPaymentNotSettled):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		h.logger.Error("payment reversal failed", "payment_id", paymentID, "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	result, err := h.getPayment.Execute(ctx, dto.GetPaymentRequest{PaymentID: paymentID})
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &paymentv1.ReversePaymentResponse{Payment: toPaymentOrderMsg(result)}, nil
+}
+
+// ImportPaymentBatch imports a NACHA or pain.001 file, initiating a
+// payment for each usable instruction. A file that is malformed as a whole
+// is rejected, as is a file the tenant already imported.
+func (h *PaymentHandler) ImportPaymentBatch(ctx context.Context, req *paymentv1.ImportPaymentBatchRequest) (*paymentv1.ImportPaymentBatchResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceAccountID, err := uuid.Parse(req.GetSourceAccountId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid source_account_id: %v", err)
+	}
+	format := enumName(req.GetFormat().String(), "BATCH_FILE_FORMAT_")
+	if _, err := valueobject.NewBatchFileFormat(format); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "format must be NACHA or PAIN001")
+	}
+	if len(req.GetFileName()) > 255 {
+		return nil, status.Error(codes.InvalidArgument, "file_name must be at most 255 characters")
+	}
+
+	result, err := h.importBatch.Execute(ctx, dto.ImportPaymentBatchRequest{
+		TenantID:        tenantID,
+		SourceAccountID: sourceAccountID,
+		Format:          format,
+		FileName:        req.GetFileName(),
+		Content:         req.GetContent(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, port.ErrInvalidBatchFile):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, port.ErrDuplicateBatch):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &paymentv1.ImportPaymentBatchResponse{Batch: toPaymentBatchMsg(result)}, nil
+}
+
+// GetPaymentBatch reports a payment batch's progress and error report.
+func (h *PaymentHandler) GetPaymentBatch(ctx context.Context, req *paymentv1.GetPaymentBatchRequest) (*paymentv1.GetPaymentBatchResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	batchID, err := uuid.Parse(req.GetBatchId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid batch_id: %v", err)
+	}
+
+	result, err := h.getBatch.Execute(ctx, dto.GetPaymentBatchRequest{BatchID: batchID})
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &paymentv1.GetPaymentBatchResponse{Batch: toPaymentBatchMsg(result)}, nil
+}
+
+func toPaymentBatchMsg(r dto.PaymentBatchResponse) *paymentv1.PaymentBatch {
+	errs := make([]*paymentv1.BatchEntryError, 0, len(r.Errors))
+	for _, e := range r.Errors {
+		msg := &paymentv1.BatchEntryError{
+			Sequence:        int32(e.Sequence), //nolint:gosec // bounded by MaxBatchEntries
+			Reference:       e.Reference,
+			BeneficiaryName: e.BeneficiaryName,
+			Amount:          &commonv1.Money{Amount: e.Amount.StringFixed(2), Currency: e.Currency},
+			Status:          e.Status,
+			Error:           e.Error,
+		}
+		if e.PaymentID != uuid.Nil {
+			msg.PaymentId = e.PaymentID.String()
+		}
+		errs = append(errs, msg)
+	}
+	return &paymentv1.PaymentBatch{
+		Id:              r.ID.String(),
+		TenantId:        r.TenantID.String(),
+		SourceAccountId: r.SourceAccountID.String(),
+		Format:          paymentv1.BatchFileFormat(paymentv1.BatchFileFormat_value["BATCH_FILE_FORMAT_"+r.Format]),
+		FileName:        r.FileName,
+		Status:          paymentv1.BatchStatus(paymentv1.BatchStatus_value["BATCH_STATUS_"+r.Status]),
+		TotalEntries:    int32(r.TotalEntries),    //nolint:gosec // bounded by MaxBatchEntries
+		RejectedEntries: int32(r.RejectedEntries), //nolint:gosec // bounded by MaxBatchEntries
+		PendingEntries:  int32(r.PendingEntries),  //nolint:gosec // bounded by MaxBatchEntries
+		SettledEntries:  int32(r.SettledEntries),  //nolint:gosec // bounded by MaxBatchEntries
+		FailedEntries:   int32(r.FailedEntries),   //nolint:gosec // bounded by MaxBatchEntries
+		Errors:          errs,
+		CreatedAt:       timestamppb.New(r.CreatedAt),
+	}
+}
+
+// optionalTime converts an optional timestamp field, returning the zero
+// time when it is unset.
+func optionalTime(field string, ts *timestamppb.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "invalid %s: %v", field, err)
+	}
+	return ts.AsTime(), nil
+}
+
+// enumName strips prefix from a proto enum name, mapping UNSPECIFIED to "".
+func enumName(name, prefix string) string {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "UNSPECIFIED" {
+		return ""
+	}
+	return name
+}
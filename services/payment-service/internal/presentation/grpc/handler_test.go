@@ -13,15 +13,22 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	paymentv1 "github.com/bibbank/bib/api/gen/go/bib/payment/v1"
 	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/enrichment"
 	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/pkg/saga"
 	"github.com/bibbank/bib/services/payment-service/internal/application/dto"
 	"github.com/bibbank/bib/services/payment-service/internal/application/usecase"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/model"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/port"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/service"
 	"github.com/bibbank/bib/services/payment-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/payment-service/internal/infrastructure/batchfile"
+	"github.com/bibbank/bib/services/payment-service/internal/infrastructure/client"
 )
 
 // --- Mock implementations ---
@@ -57,6 +64,67 @@ func (m *mockPaymentRepo) ListByTenant(ctx context.Context, tenantID uuid.UUID,
 	return nil, 0, nil
 }
 
+type mockScheduleRepo struct {
+	schedules map[uuid.UUID]model.PaymentSchedule
+}
+
+func (m *mockScheduleRepo) Save(_ context.Context, schedule model.PaymentSchedule) error {
+	if m.schedules == nil {
+		m.schedules = make(map[uuid.UUID]model.PaymentSchedule)
+	}
+	m.schedules[schedule.ID()] = schedule
+	return nil
+}
+
+func (m *mockScheduleRepo) SaveRun(ctx context.Context, schedule model.PaymentSchedule, _ model.PaymentOrder) error {
+	return m.Save(ctx, schedule)
+}
+
+func (m *mockScheduleRepo) FindByID(_ context.Context, id uuid.UUID) (model.PaymentSchedule, error) {
+	if schedule, ok := m.schedules[id]; ok {
+		return schedule, nil
+	}
+	return model.PaymentSchedule{}, fmt.Errorf("not found")
+}
+
+func (m *mockScheduleRepo) ListByTenant(_ context.Context, tenantID uuid.UUID, _, _ int) ([]model.PaymentSchedule, int, error) {
+	var out []model.PaymentSchedule
+	for _, schedule := range m.schedules {
+		if schedule.TenantID() == tenantID {
+			out = append(out, schedule)
+		}
+	}
+	return out, len(out), nil
+}
+
+func (m *mockScheduleRepo) ListDue(context.Context, time.Time, int) ([]model.PaymentSchedule, error) {
+	return nil, nil
+}
+
+type mockBatchRepo struct {
+	batches map[uuid.UUID]model.PaymentBatch
+}
+
+func (m *mockBatchRepo) Save(_ context.Context, batch model.PaymentBatch, _ []model.PaymentOrder) error {
+	if m.batches == nil {
+		m.batches = make(map[uuid.UUID]model.PaymentBatch)
+	}
+	for _, b := range m.batches {
+		if b.FileHash() == batch.FileHash() {
+			return port.ErrDuplicateBatch
+		}
+	}
+	m.batches[batch.ID()] = batch
+	return nil
+}
+
+func (m *mockBatchRepo) FindByID(_ context.Context, id uuid.UUID) (model.PaymentBatch, error) {
+	if batch, ok := m.batches[id]; ok {
+		return batch, nil
+	}
+	return model.PaymentBatch{}, fmt.Errorf("not found")
+}
+
 type mockEventPublisher struct {
 	publishErr error
 }
@@ -77,28 +145,29 @@ func contextWithClaims() context.Context {
 }
 
 func buildTestHandler() *PaymentHandler {
-	repo := &mockPaymentRepo{}
-	publisher := &mockEventPublisher{}
-	routingEngine := service.NewRoutingEngine()
-	logger := slog.Default()
-
-	return NewPaymentHandler(
-		usecase.NewInitiatePayment(repo, publisher, routingEngine, nil),
-		usecase.NewGetPayment(repo),
-		usecase.NewListPayments(repo),
-		logger,
-	)
+	return buildHandlerWithRepo(&mockPaymentRepo{})
 }
 
 func buildHandlerWithRepo(repo port.PaymentOrderRepository) *PaymentHandler {
+	return buildHandlerWithRepos(repo, &mockScheduleRepo{}, &mockBatchRepo{})
+}
+
+func buildHandlerWithRepos(repo port.PaymentOrderRepository, schedules port.PaymentScheduleRepository, batches port.PaymentBatchRepository) *PaymentHandler {
 	publisher := &mockEventPublisher{}
 	routingEngine := service.NewRoutingEngine()
 	logger := slog.Default()
 
 	return NewPaymentHandler(
 		usecase.NewInitiatePayment(repo, publisher, routingEngine, nil),
-		usecase.NewGetPayment(repo),
-		usecase.NewListPayments(repo),
+		usecase.NewGetPayment(repo, enrichment.Default),
+		usecase.NewListPayments(repo, enrichment.Default),
+		usecase.NewSchedulePayment(schedules),
+		usecase.NewListPaymentSchedules(schedules),
+		usecase.NewCancelPaymentSchedule(schedules),
+		usecase.NewProcessPayment(repo, nil, publisher, nil, client.NewStubAccountClient(), client.NewStubLedgerClient(logger), saga.NewMemoryStore(),
+			usecase.PaymentSagaConfig{}, logger),
+		usecase.NewImportPaymentBatch(batches, batchfile.NewParser(), publisher, routingEngine, nil, logger),
+		usecase.NewGetPaymentBatch(batches),
 		logger,
 	)
 }
@@ -111,27 +180,26 @@ func makeTestPaymentOrder() model.PaymentOrder {
 	return model.Reconstruct(
 		uuid.New(), uuid.New(), uuid.New(), uuid.Nil,
 		decimal.NewFromInt(100), "USD", rail, st, routingInfo,
-		"REF-001", "Test payment", "",
+		"REF-001", "Test payment", "", "",
 		time.Now().UTC(), nil, 1, time.Now().UTC(), time.Now().UTC(),
 	)
 }
 
 // --- Tests ---
 
-func TestHandleInitiatePayment(t *testing.T) {
+func TestInitiatePayment(t *testing.T) {
 	t.Run("nil request returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandleInitiatePayment(contextWithClaims(), nil)
+		_, err := h.InitiatePayment(contextWithClaims(), nil)
 		requireGRPCCode(t, err, codes.InvalidArgument)
 	})
 
 	t.Run("missing currency returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandleInitiatePayment(contextWithClaims(), &InitiatePaymentRequest{
-			TenantID:        uuid.New().String(),
-			SourceAccountID: uuid.New().String(),
-			Amount:          "100.00",
-			Currency:        "",
+		_, err := h.InitiatePayment(contextWithClaims(), &paymentv1.InitiatePaymentRequest{
+			TenantId:        uuid.New().String(),
+			SourceAccountId: uuid.New().String(),
+			Amount:          &commonv1.Money{Amount: "100.00", Currency: ""},
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 		assert.Contains(t, err.Error(), "currency is required")
@@ -139,11 +207,10 @@ func TestHandleInitiatePayment(t *testing.T) {
 
 	t.Run("invalid source_account_id returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandleInitiatePayment(contextWithClaims(), &InitiatePaymentRequest{
-			TenantID:        uuid.New().String(),
-			SourceAccountID: "bad-uuid",
-			Amount:          "100.00",
-			Currency:        "USD",
+		_, err := h.InitiatePayment(contextWithClaims(), &paymentv1.InitiatePaymentRequest{
+			TenantId:        uuid.New().String(),
+			SourceAccountId: "bad-uuid",
+			Amount:          &commonv1.Money{Amount: "100.00", Currency: "USD"},
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 		assert.Contains(t, err.Error(), "invalid source_account_id")
@@ -151,12 +218,11 @@ func TestHandleInitiatePayment(t *testing.T) {
 
 	t.Run("invalid destination_account_id returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandleInitiatePayment(contextWithClaims(), &InitiatePaymentRequest{
-			TenantID:             uuid.New().String(),
-			SourceAccountID:      uuid.New().String(),
-			DestinationAccountID: "bad-uuid",
-			Amount:               "100.00",
-			Currency:             "USD",
+		_, err := h.InitiatePayment(contextWithClaims(), &paymentv1.InitiatePaymentRequest{
+			TenantId:             uuid.New().String(),
+			SourceAccountId:      uuid.New().String(),
+			DestinationAccountId: "bad-uuid",
+			Amount:               &commonv1.Money{Amount: "100.00", Currency: "USD"},
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 		assert.Contains(t, err.Error(), "invalid destination_account_id")
@@ -164,11 +230,10 @@ func TestHandleInitiatePayment(t *testing.T) {
 
 	t.Run("invalid amount returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandleInitiatePayment(contextWithClaims(), &InitiatePaymentRequest{
-			TenantID:        uuid.New().String(),
-			SourceAccountID: uuid.New().String(),
-			Amount:          "not-a-number",
-			Currency:        "USD",
+		_, err := h.InitiatePayment(contextWithClaims(), &paymentv1.InitiatePaymentRequest{
+			TenantId:        uuid.New().String(),
+			SourceAccountId: uuid.New().String(),
+			Amount:          &commonv1.Money{Amount: "not-a-number", Currency: "USD"},
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 		assert.Contains(t, err.Error(), "invalid amount")
@@ -176,28 +241,26 @@ func TestHandleInitiatePayment(t *testing.T) {
 
 	t.Run("happy path for internal payment", func(t *testing.T) {
 		h := buildTestHandler()
-		resp, err := h.HandleInitiatePayment(contextWithClaims(), &InitiatePaymentRequest{
-			TenantID:             uuid.New().String(),
-			SourceAccountID:      uuid.New().String(),
-			DestinationAccountID: uuid.New().String(),
-			Amount:               "500.00",
-			Currency:             "USD",
+		resp, err := h.InitiatePayment(contextWithClaims(), &paymentv1.InitiatePaymentRequest{
+			TenantId:             uuid.New().String(),
+			SourceAccountId:      uuid.New().String(),
+			DestinationAccountId: uuid.New().String(),
+			Amount:               &commonv1.Money{Amount: "500.00", Currency: "USD"},
 			Reference:            "TRANSFER-001",
 			Description:          "Internal transfer",
 		})
 		require.NoError(t, err)
-		assert.NotEmpty(t, resp.ID)
-		assert.NotEmpty(t, resp.Status)
-		assert.NotEmpty(t, resp.Rail)
+		assert.NotEmpty(t, resp.GetOrder().GetId())
+		assert.NotEqual(t, paymentv1.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED, resp.GetOrder().GetStatus())
+		assert.NotEqual(t, paymentv1.PaymentRail_PAYMENT_RAIL_UNSPECIFIED, resp.GetOrder().GetRail())
 	})
 
 	t.Run("happy path for external ACH payment", func(t *testing.T) {
 		h := buildTestHandler()
-		resp, err := h.HandleInitiatePayment(contextWithClaims(), &InitiatePaymentRequest{
-			TenantID:              uuid.New().String(),
-			SourceAccountID:       uuid.New().String(),
-			Amount:                "250.00",
-			Currency:              "USD",
+		resp, err := h.InitiatePayment(contextWithClaims(), &paymentv1.InitiatePaymentRequest{
+			TenantId:              uuid.New().String(),
+			SourceAccountId:       uuid.New().String(),
+			Amount:                &commonv1.Money{Amount: "250.00", Currency: "USD"},
 			RoutingNumber:         "021000021",
 			ExternalAccountNumber: "123456789",
 			DestinationCountry:    "US",
@@ -205,20 +268,19 @@ func TestHandleInitiatePayment(t *testing.T) {
 			Description:           "External payment",
 		})
 		require.NoError(t, err)
-		assert.NotEmpty(t, resp.ID)
-		assert.NotEmpty(t, resp.Status)
+		assert.NotEmpty(t, resp.GetOrder().GetId())
+		assert.NotEqual(t, paymentv1.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED, resp.GetOrder().GetStatus())
 	})
 
 	t.Run("save failure returns Internal", func(t *testing.T) {
 		repo := &mockPaymentRepo{saveErr: fmt.Errorf("db error")}
 		h := buildHandlerWithRepo(repo)
 
-		_, err := h.HandleInitiatePayment(contextWithClaims(), &InitiatePaymentRequest{
-			TenantID:             uuid.New().String(),
-			SourceAccountID:      uuid.New().String(),
-			DestinationAccountID: uuid.New().String(),
-			Amount:               "100.00",
-			Currency:             "USD",
+		_, err := h.InitiatePayment(contextWithClaims(), &paymentv1.InitiatePaymentRequest{
+			TenantId:             uuid.New().String(),
+			SourceAccountId:      uuid.New().String(),
+			DestinationAccountId: uuid.New().String(),
+			Amount:               &commonv1.Money{Amount: "100.00", Currency: "USD"},
 			Reference:            "REF-001",
 			Description:          "test",
 		})
@@ -226,16 +288,16 @@ func TestHandleInitiatePayment(t *testing.T) {
 	})
 }
 
-func TestHandleGetPayment(t *testing.T) {
+func TestGetPayment(t *testing.T) {
 	t.Run("nil request returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandleGetPayment(contextWithClaims(), nil)
+		_, err := h.GetPayment(contextWithClaims(), nil)
 		requireGRPCCode(t, err, codes.InvalidArgument)
 	})
 
 	t.Run("invalid payment_id returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandleGetPayment(contextWithClaims(), &GetPaymentRequestMsg{PaymentID: "bad"})
+		_, err := h.GetPayment(contextWithClaims(), &paymentv1.GetPaymentRequest{Id: "bad"})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 	})
 
@@ -251,56 +313,305 @@ func TestHandleGetPayment(t *testing.T) {
 		}
 		h := buildHandlerWithRepo(repo)
 
-		resp, err := h.HandleGetPayment(contextWithClaims(), &GetPaymentRequestMsg{
-			PaymentID: order.ID().String(),
+		resp, err := h.GetPayment(contextWithClaims(), &paymentv1.GetPaymentRequest{
+			Id: order.ID().String(),
 		})
 		require.NoError(t, err)
-		require.NotNil(t, resp.Payment)
-		assert.Equal(t, order.ID().String(), resp.Payment.ID)
+		require.NotNil(t, resp.GetOrder())
+		assert.Equal(t, order.ID().String(), resp.GetOrder().GetId())
 	})
 
 	t.Run("not found returns Internal", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandleGetPayment(contextWithClaims(), &GetPaymentRequestMsg{
-			PaymentID: uuid.New().String(),
+		_, err := h.GetPayment(contextWithClaims(), &paymentv1.GetPaymentRequest{
+			Id: uuid.New().String(),
 		})
 		requireGRPCCode(t, err, codes.Internal)
 	})
 }
 
-func TestHandleListPayments(t *testing.T) {
+func TestListPayments(t *testing.T) {
 	t.Run("nil request returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandleListPayments(contextWithClaims(), nil)
+		_, err := h.ListPayments(contextWithClaims(), nil)
 		requireGRPCCode(t, err, codes.InvalidArgument)
 	})
 
 	t.Run("invalid page_size returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandleListPayments(contextWithClaims(), &ListPaymentsRequestMsg{
-			TenantID: uuid.New().String(),
-			PageSize: -1,
+		_, err := h.ListPayments(contextWithClaims(), &paymentv1.ListPaymentsRequest{
+			TenantId:   uuid.New().String(),
+			Pagination: &commonv1.Pagination{PageSize: -1},
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 	})
 
 	t.Run("invalid account_id returns InvalidArgument", func(t *testing.T) {
 		h := buildTestHandler()
-		_, err := h.HandleListPayments(contextWithClaims(), &ListPaymentsRequestMsg{
-			TenantID:  uuid.New().String(),
-			AccountID: "bad-uuid",
+		_, err := h.ListPayments(contextWithClaims(), &paymentv1.ListPaymentsRequest{
+			TenantId:  uuid.New().String(),
+			AccountId: "bad-uuid",
 		})
 		requireGRPCCode(t, err, codes.InvalidArgument)
 	})
 
 	t.Run("happy path returns payments list", func(t *testing.T) {
 		h := buildTestHandler()
-		resp, err := h.HandleListPayments(contextWithClaims(), &ListPaymentsRequestMsg{
-			TenantID: uuid.New().String(),
-			PageSize: 10,
+		resp, err := h.ListPayments(contextWithClaims(), &paymentv1.ListPaymentsRequest{
+			TenantId:   uuid.New().String(),
+			Pagination: &commonv1.Pagination{PageSize: 10},
 		})
 		require.NoError(t, err)
-		assert.Equal(t, int32(0), resp.TotalCount)
+		assert.Equal(t, int32(0), resp.GetPagination().GetTotalCount())
+		assert.Empty(t, resp.GetPagination().GetNextPageToken())
+	})
+
+	t.Run("invalid page_token returns InvalidArgument", func(t *testing.T) {
+		h := buildTestHandler()
+		_, err := h.ListPayments(contextWithClaims(), &paymentv1.ListPaymentsRequest{
+			Pagination: &commonv1.Pagination{PageToken: "next"},
+		})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+}
+
+func validScheduleRequest() *paymentv1.SchedulePaymentRequest {
+	return &paymentv1.SchedulePaymentRequest{
+		SourceAccountId: uuid.New().String(),
+		Amount:          &commonv1.Money{Amount: "1200.00", Currency: "USD"},
+		Frequency:       paymentv1.ScheduleFrequency_SCHEDULE_FREQUENCY_MONTHLY,
+		Interval:        1,
+		StartAt:         timestamppb.New(time.Now().UTC().Add(24 * time.Hour).Truncate(time.Minute)),
+		Description:     "Rent",
+	}
+}
+
+func TestSchedulePayment(t *testing.T) {
+	t.Run("nil request returns InvalidArgument", func(t *testing.T) {
+		h := buildTestHandler()
+		_, err := h.SchedulePayment(contextWithClaims(), nil)
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("missing frequency returns InvalidArgument", func(t *testing.T) {
+		h := buildTestHandler()
+		req := validScheduleRequest()
+		req.Frequency = paymentv1.ScheduleFrequency_SCHEDULE_FREQUENCY_UNSPECIFIED
+		_, err := h.SchedulePayment(contextWithClaims(), req)
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("invalid cron expression returns InvalidArgument", func(t *testing.T) {
+		h := buildTestHandler()
+		req := validScheduleRequest()
+		req.Frequency, req.CronExpression = paymentv1.ScheduleFrequency_SCHEDULE_FREQUENCY_CRON, "0 9 * *"
+		_, err := h.SchedulePayment(contextWithClaims(), req)
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("end before start returns InvalidArgument", func(t *testing.T) {
+		h := buildTestHandler()
+		req := validScheduleRequest()
+		req.EndAt = timestamppb.New(time.Now().UTC())
+		_, err := h.SchedulePayment(contextWithClaims(), req)
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("happy path returns the active schedule", func(t *testing.T) {
+		schedules := &mockScheduleRepo{}
+		h := buildHandlerWithRepos(&mockPaymentRepo{}, schedules, &mockBatchRepo{})
+		req := validScheduleRequest()
+
+		resp, err := h.SchedulePayment(contextWithClaims(), req)
+		require.NoError(t, err)
+		assert.Equal(t, paymentv1.ScheduleStatus_SCHEDULE_STATUS_ACTIVE, resp.GetSchedule().GetStatus())
+		assert.Equal(t, paymentv1.ScheduleFrequency_SCHEDULE_FREQUENCY_MONTHLY, resp.GetSchedule().GetFrequency())
+		assert.True(t, req.GetStartAt().AsTime().Equal(resp.GetSchedule().GetNextRunAt().AsTime()))
+		assert.Len(t, schedules.schedules, 1)
+	})
+}
+
+func TestCancelPaymentSchedule(t *testing.T) {
+	t.Run("invalid schedule_id returns InvalidArgument", func(t *testing.T) {
+		h := buildTestHandler()
+		_, err := h.CancelPaymentSchedule(contextWithClaims(), &paymentv1.CancelPaymentScheduleRequest{ScheduleId: "bad"})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("cancels the caller's schedule", func(t *testing.T) {
+		schedules := &mockScheduleRepo{}
+		h := buildHandlerWithRepos(&mockPaymentRepo{}, schedules, &mockBatchRepo{})
+		ctx := contextWithClaims()
+		created, err := h.SchedulePayment(ctx, validScheduleRequest())
+		require.NoError(t, err)
+
+		resp, err := h.CancelPaymentSchedule(ctx, &paymentv1.CancelPaymentScheduleRequest{ScheduleId: created.GetSchedule().GetId()})
+		require.NoError(t, err)
+		assert.Equal(t, paymentv1.ScheduleStatus_SCHEDULE_STATUS_CANCELLED, resp.GetSchedule().GetStatus())
+		assert.Nil(t, resp.GetSchedule().GetNextRunAt())
+	})
+
+	t.Run("another tenant's schedule is not found", func(t *testing.T) {
+		schedules := &mockScheduleRepo{}
+		h := buildHandlerWithRepos(&mockPaymentRepo{}, schedules, &mockBatchRepo{})
+		created, err := h.SchedulePayment(contextWithClaims(), validScheduleRequest())
+		require.NoError(t, err)
+
+		_, err = h.CancelPaymentSchedule(contextWithClaims(), &paymentv1.CancelPaymentScheduleRequest{ScheduleId: created.GetSchedule().GetId()})
+		requireGRPCCode(t, err, codes.Internal)
+	})
+}
+
+func TestRepostStuckPayments(t *testing.T) {
+	t.Run("requires a platform admin", func(t *testing.T) {
+		h := buildTestHandler()
+		ctx := auth.ContextWithClaims(context.Background(), &auth.Claims{
+			UserID:   uuid.New(),
+			TenantID: uuid.New(),
+			Roles:    []string{auth.RoleOperator},
+		})
+		_, err := h.RepostStuckPayments(ctx, &paymentv1.RepostStuckPaymentsRequest{})
+		requireGRPCCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("invalid payment_id returns InvalidArgument", func(t *testing.T) {
+		h := buildTestHandler()
+		_, err := h.RepostStuckPayments(contextWithClaims(), &paymentv1.RepostStuckPaymentsRequest{PaymentId: "bad"})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("payment without a saga is not found", func(t *testing.T) {
+		h := buildTestHandler()
+		_, err := h.RepostStuckPayments(contextWithClaims(), &paymentv1.RepostStuckPaymentsRequest{PaymentId: uuid.New().String()})
+		requireGRPCCode(t, err, codes.NotFound)
+	})
+
+	t.Run("reposts nothing when no payment is stuck", func(t *testing.T) {
+		h := buildTestHandler()
+		resp, err := h.RepostStuckPayments(contextWithClaims(), &paymentv1.RepostStuckPaymentsRequest{})
+		require.NoError(t, err)
+		assert.Zero(t, resp.GetReposted())
+	})
+}
+
+func TestReversePayment(t *testing.T) {
+	t.Run("requires an operator", func(t *testing.T) {
+		h := buildTestHandler()
+		ctx := auth.ContextWithClaims(context.Background(), &auth.Claims{
+			UserID:   uuid.New(),
+			TenantID: uuid.New(),
+			Roles:    []string{auth.RoleCustomer},
+		})
+		_, err := h.ReversePayment(ctx, &paymentv1.ReversePaymentRequest{PaymentId: uuid.New().String(), Reason: "returned"})
+		requireGRPCCode(t, err, codes.PermissionDenied)
+	})
+
+	t.Run("invalid payment_id returns InvalidArgument", func(t *testing.T) {
+		h := buildTestHandler()
+		_, err := h.ReversePayment(contextWithClaims(), &paymentv1.ReversePaymentRequest{PaymentId: "bad", Reason: "returned"})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("missing reason returns InvalidArgument", func(t *testing.T) {
+		h := buildTestHandler()
+		_, err := h.ReversePayment(contextWithClaims(), &paymentv1.ReversePaymentRequest{PaymentId: uuid.New().String()})
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	order := makeTestPaymentOrder()
+	repo := &mockPaymentRepo{
+		findByIDFunc: func(_ context.Context, id uuid.UUID) (model.PaymentOrder, error) {
+			if id == order.ID() {
+				return order, nil
+			}
+			return model.PaymentOrder{}, fmt.Errorf("not found")
+		},
+	}
+
+	t.Run("another tenant's payment is not found", func(t *testing.T) {
+		h := buildHandlerWithRepo(repo)
+		_, err := h.ReversePayment(contextWithClaims(), &paymentv1.ReversePaymentRequest{PaymentId: order.ID().String(), Reason: "returned"})
+		requireGRPCCode(t, err, codes.NotFound)
+	})
+
+	t.Run("unsettled payment returns FailedPrecondition", func(t *testing.T) {
+		h := buildHandlerWithRepo(repo)
+		ctx := auth.ContextWithClaims(context.Background(), &auth.Claims{
+			UserID:   uuid.New(),
+			TenantID: order.TenantID(),
+			Roles:    []string{auth.RoleOperator},
+		})
+		_, err := h.ReversePayment(ctx, &paymentv1.ReversePaymentRequest{PaymentId: order.ID().String(), Reason: "returned"})
+		requireGRPCCode(t, err, codes.FailedPrecondition)
+	})
+}
+
+const testBatchPain001 = `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pain.001.001.09"><CstmrCdtTrfInitn>
+<GrpHdr><NbOfTxs>2</NbOfTxs><CtrlSum>300.00</CtrlSum></GrpHdr>
+<PmtInf><PmtInfId>P1</PmtInfId>
+<CdtTrfTxInf><PmtId><EndToEndId>E2E-1</EndToEndId></PmtId><Amt><InstdAmt Ccy="USD">100.00</InstdAmt></Amt>
+<CdtrAgt><FinInstnId><ClrSysMmbId><MmbId>021000021</MmbId></ClrSysMmbId></FinInstnId></CdtrAgt>
+<Cdtr><Nm>Supplier Inc</Nm></Cdtr><CdtrAcct><Id><Othr><Id>12345678</Id></Othr></Id></CdtrAcct></CdtTrfTxInf>
+<CdtTrfTxInf><PmtId><EndToEndId>E2E-2</EndToEndId></PmtId><Amt><InstdAmt Ccy="USD">200.00</InstdAmt></Amt>
+<Cdtr><Nm>No Agent Ltd</Nm></Cdtr><CdtrAcct><Id><Othr><Id>999</Id></Othr></Id></CdtrAcct></CdtTrfTxInf>
+</PmtInf></CstmrCdtTrfInitn></Document>`
+
+func TestImportPaymentBatch(t *testing.T) {
+	validReq := func() *paymentv1.ImportPaymentBatchRequest {
+		return &paymentv1.ImportPaymentBatchRequest{
+			SourceAccountId: uuid.New().String(),
+			Format:          paymentv1.BatchFileFormat_BATCH_FILE_FORMAT_PAIN001,
+			FileName:        "suppliers.xml",
+			Content:         []byte(testBatchPain001),
+		}
+	}
+
+	t.Run("imports a file and reports its progress", func(t *testing.T) {
+		h := buildTestHandler()
+		resp, err := h.ImportPaymentBatch(contextWithClaims(), validReq())
+		require.NoError(t, err)
+		batch := resp.GetBatch()
+		assert.Equal(t, paymentv1.BatchStatus_BATCH_STATUS_PROCESSING, batch.GetStatus())
+		assert.Equal(t, int32(2), batch.GetTotalEntries())
+		assert.Equal(t, int32(1), batch.GetPendingEntries())
+		require.Len(t, batch.GetErrors(), 1)
+		assert.Equal(t, int32(2), batch.GetErrors()[0].GetSequence())
+		assert.Equal(t, "E2E-2", batch.GetErrors()[0].GetReference())
+		assert.Equal(t, "200.00", batch.GetErrors()[0].GetAmount().GetAmount())
+
+		got, err := h.GetPaymentBatch(contextWithClaims(), &paymentv1.GetPaymentBatchRequest{BatchId: batch.GetId()})
+		require.NoError(t, err)
+		assert.Equal(t, batch.GetId(), got.GetBatch().GetId())
+
+		_, err = h.ImportPaymentBatch(contextWithClaims(), validReq())
+		requireGRPCCode(t, err, codes.AlreadyExists)
+	})
+
+	t.Run("malformed file returns InvalidArgument", func(t *testing.T) {
+		h := buildTestHandler()
+		req := validReq()
+		req.Content = []byte("<Document>")
+		_, err := h.ImportPaymentBatch(contextWithClaims(), req)
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("missing format returns InvalidArgument", func(t *testing.T) {
+		h := buildTestHandler()
+		req := validReq()
+		req.Format = paymentv1.BatchFileFormat_BATCH_FILE_FORMAT_UNSPECIFIED
+		_, err := h.ImportPaymentBatch(contextWithClaims(), req)
+		requireGRPCCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("requires an operator", func(t *testing.T) {
+		h := buildTestHandler()
+		ctx := auth.ContextWithClaims(context.Background(), &auth.Claims{
+			UserID:   uuid.New(),
+			TenantID: uuid.New(),
+			Roles:    []string{auth.RoleCustomer},
+		})
+		_, err := h.ImportPaymentBatch(ctx, validReq())
+		requireGRPCCode(t, err, codes.PermissionDenied)
 	})
 }
 
@@ -331,13 +642,14 @@ func TestToPaymentOrderMsg(t *testing.T) {
 		UpdatedAt:             now,
 	})
 
-	assert.Equal(t, orderID.String(), msg.ID)
-	assert.Equal(t, tenantID.String(), msg.TenantID)
-	assert.Equal(t, "250.00", msg.Amount)
-	assert.Equal(t, "USD", msg.Currency)
-	assert.Equal(t, "ACH", msg.Rail)
-	assert.Equal(t, "INITIATED", msg.Status)
-	assert.Empty(t, msg.SettledAt)
+	assert.Equal(t, orderID.String(), msg.GetId())
+	assert.Equal(t, tenantID.String(), msg.GetTenantId())
+	assert.Equal(t, "250.00", msg.GetAmount().GetAmount())
+	assert.Equal(t, "USD", msg.GetAmount().GetCurrency())
+	assert.Equal(t, paymentv1.PaymentRail_PAYMENT_RAIL_ACH, msg.GetRail())
+	assert.Equal(t, paymentv1.PaymentStatus_PAYMENT_STATUS_INITIATED, msg.GetStatus())
+	assert.Equal(t, int32(1), msg.GetAudit().GetVersion())
+	assert.Nil(t, msg.GetSettledAt())
 }
 
 // requireGRPCCode asserts that an error is a gRPC status error with the given code.
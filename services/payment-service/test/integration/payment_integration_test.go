@@ -57,6 +57,7 @@ func newTestPaymentOrder(t *testing.T, tenantID uuid.UUID) model.PaymentOrder {
 		routingInfo,
 		"PAY-REF-001",
 		"Test payment order",
+		"",
 	)
 	require.NoError(t, err)
 
@@ -68,7 +69,7 @@ func newTestPaymentOrder(t *testing.T, tenantID uuid.UUID) model.PaymentOrder {
 
 func TestPaymentRepository_SaveAndGet(t *testing.T) {
 	pool := setupTestDB(t)
-	repo := postgres.NewPaymentOrderRepo(pool)
+	repo := postgres.NewPaymentOrderRepo(pool, nil)
 	ctx := context.Background()
 
 	tenantID := uuid.New()
@@ -100,7 +101,7 @@ func TestPaymentRepository_SaveAndGet(t *testing.T) {
 
 func TestPaymentRepository_List(t *testing.T) {
 	pool := setupTestDB(t)
-	repo := postgres.NewPaymentOrderRepo(pool)
+	repo := postgres.NewPaymentOrderRepo(pool, nil)
 	ctx := context.Background()
 
 	tenantA := uuid.New()
@@ -140,7 +141,7 @@ func TestPaymentRepository_List(t *testing.T) {
 
 func TestPaymentRepository_UpdateStatus(t *testing.T) {
 	pool := setupTestDB(t)
-	repo := postgres.NewPaymentOrderRepo(pool)
+	repo := postgres.NewPaymentOrderRepo(pool, nil)
 	ctx := context.Background()
 
 	tenantID := uuid.New()
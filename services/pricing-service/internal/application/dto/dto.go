@@ -0,0 +1,144 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// FeeRule prices one kind of transaction in one currency. Rate is a
+// fraction of the amount; a zero Maximum leaves the fee uncapped.
+type FeeRule struct {
+	FeeCode  string
+	Currency string
+	Fixed    decimal.Decimal
+	Rate     decimal.Decimal
+	Minimum  decimal.Decimal
+	Maximum  decimal.Decimal
+}
+
+// CreateFeeScheduleRequest is the input for creating a fee schedule. A
+// zero EffectiveFrom creates a schedule taking effect at once.
+type CreateFeeScheduleRequest struct {
+	EffectiveFrom time.Time
+	Product       string
+	Channel       string
+	Rules         []FeeRule
+	TenantID      uuid.UUID
+}
+
+// ReviseFeeScheduleRequest is the input for revising a fee schedule before
+// it takes effect. A zero EffectiveFrom keeps the schedule's.
+type ReviseFeeScheduleRequest struct {
+	EffectiveFrom time.Time
+	Rules         []FeeRule
+	TenantID      uuid.UUID
+	ScheduleID    uuid.UUID
+}
+
+// ListFeeSchedulesRequest is the input for listing a tenant's fee
+// schedules. Empty Product and Channel do not filter.
+type ListFeeSchedulesRequest struct {
+	Product  string
+	Channel  string
+	Limit    int
+	Offset   int
+	TenantID uuid.UUID
+}
+
+// FeeScheduleResponse is a fee schedule.
+type FeeScheduleResponse struct {
+	EffectiveFrom time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Product       string
+	Channel       string
+	Rules         []FeeRule
+	Version       int
+	ID            uuid.UUID
+	TenantID      uuid.UUID
+}
+
+// ListFeeSchedulesResponse is one page of fee schedules.
+type ListFeeSchedulesResponse struct {
+	Schedules  []FeeScheduleResponse
+	TotalCount int
+}
+
+// EvaluateFeeRequest is the input for pricing a transaction at At, or now
+// when At is zero. The reference is the caller's for the transaction, such
+// as a payment ID: when it is set the fee is recorded as charged and a
+// repeated request with the same reference returns the fee first charged;
+// when it is empty the fee is only quoted.
+type EvaluateFeeRequest struct {
+	At        time.Time
+	Product   string
+	Channel   string
+	FeeCode   string
+	Currency  string
+	Reference string
+	Amount    decimal.Decimal
+	TenantID  uuid.UUID
+}
+
+// EvaluateFeeResponse is the fee on a transaction. Priced is false when no
+// schedule in effect prices it, and the fee is then zero.
+type EvaluateFeeResponse struct {
+	ScheduleID *uuid.UUID
+	Rule       *FeeRule
+	Assessment *FeeAssessmentResponse
+	Currency   string
+	Fee        decimal.Decimal
+	Priced     bool
+}
+
+// FeeAssessmentResponse is a fee charged on a transaction.
+type FeeAssessmentResponse struct {
+	AssessedAt time.Time
+	ScheduleID *uuid.UUID
+	Reference  string
+	Product    string
+	Channel    string
+	FeeCode    string
+	Currency   string
+	Amount     decimal.Decimal
+	Fee        decimal.Decimal
+	ID         uuid.UUID
+	TenantID   uuid.UUID
+}
+
+// SimulateFeeChangeRequest is the input for repricing the fees charged
+// over a period under proposed pricing: a schedule's when ScheduleID is
+// set, otherwise Rules for Product on Channel. Zero From and To simulate
+// against the service's default window up to now.
+type SimulateFeeChangeRequest struct {
+	From       time.Time
+	To         time.Time
+	ScheduleID *uuid.UUID
+	Product    string
+	Channel    string
+	Rules      []FeeRule
+	TenantID   uuid.UUID
+}
+
+// FeeImpact is the volume of one kind of transaction in one currency over
+// a simulated period, with the fees charged on it and those the proposed
+// pricing would charge.
+type FeeImpact struct {
+	FeeCode      string
+	Currency     string
+	Volume       decimal.Decimal
+	CurrentFees  decimal.Decimal
+	ProposedFees decimal.Decimal
+	Difference   decimal.Decimal
+	Count        int
+}
+
+// SimulateFeeChangeResponse is the impact of a fee change over a period,
+// by fee code and currency.
+type SimulateFeeChangeResponse struct {
+	From    time.Time
+	To      time.Time
+	Impacts []FeeImpact
+}
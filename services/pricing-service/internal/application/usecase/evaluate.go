@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/pricing-service/internal/application/dto"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/model"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/port"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/valueobject"
+)
+
+// ErrInvalidFee is returned when a fee evaluation request is malformed.
+var ErrInvalidFee = errors.New("invalid fee evaluation")
+
+// EvaluateFeeUseCase prices a transaction and, when the caller gives its
+// reference, records the fee as charged.
+type EvaluateFeeUseCase struct {
+	schedules   port.FeeScheduleRepository
+	assessments port.AssessmentRepository
+	publisher   port.EventPublisher
+}
+
+// NewEvaluateFeeUseCase creates a new EvaluateFeeUseCase.
+func NewEvaluateFeeUseCase(
+	schedules port.FeeScheduleRepository,
+	assessments port.AssessmentRepository,
+	publisher port.EventPublisher,
+) *EvaluateFeeUseCase {
+	return &EvaluateFeeUseCase{schedules: schedules, assessments: assessments, publisher: publisher}
+}
+
+// Execute prices the transaction under the schedule in effect for its
+// product and channel, falling back to the product's schedule for any
+// channel. A transaction no schedule prices is free. A repeated request
+// with the same reference returns the fee first charged.
+func (uc *EvaluateFeeUseCase) Execute(ctx context.Context, req dto.EvaluateFeeRequest) (dto.EvaluateFeeResponse, error) {
+	product, channel, err := parseScope(req.Product, req.Channel)
+	if err != nil {
+		return dto.EvaluateFeeResponse{}, fmt.Errorf("%w: %w", ErrInvalidFee, err)
+	}
+	if req.Amount.IsNegative() {
+		return dto.EvaluateFeeResponse{}, fmt.Errorf("%w: amount must not be negative", ErrInvalidFee)
+	}
+	if req.Reference != "" {
+		resp, found, err := uc.replay(ctx, req.TenantID, req.Reference)
+		if err != nil || found {
+			return resp, err
+		}
+	}
+
+	now := time.Now().UTC()
+	at := req.At
+	if at.IsZero() {
+		at = now
+	}
+	schedule, err := findEffective(ctx, uc.schedules, req.TenantID, product, channel, at)
+	if err != nil {
+		return dto.EvaluateFeeResponse{}, err
+	}
+	if req.Reference == "" {
+		return quote(schedule, req.FeeCode, req.Currency, req.Amount), nil
+	}
+
+	assessment, err := model.AssessFee(req.TenantID, req.Reference, product, channel, req.FeeCode, req.Currency, req.Amount, schedule, now)
+	if err != nil {
+		return dto.EvaluateFeeResponse{}, fmt.Errorf("%w: %w", ErrInvalidFee, err)
+	}
+	if err := uc.assessments.Save(ctx, assessment); err != nil {
+		if errors.Is(err, port.ErrAssessmentExists) {
+			// The same reference was assessed concurrently.
+			resp, _, err := uc.replay(ctx, req.TenantID, req.Reference)
+			return resp, err
+		}
+		return dto.EvaluateFeeResponse{}, fmt.Errorf("failed to save fee assessment: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, assessment.DomainEvents()); err != nil {
+		return dto.EvaluateFeeResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	resp := quote(schedule, req.FeeCode, req.Currency, req.Amount)
+	a := toFeeAssessmentResponse(assessment)
+	resp.Assessment = &a
+	return resp, nil
+}
+
+// replay returns the response to the request that first recorded a fee
+// with the reference, and false when there was none.
+func (uc *EvaluateFeeUseCase) replay(ctx context.Context, tenantID uuid.UUID, reference string) (dto.EvaluateFeeResponse, bool, error) {
+	assessment, err := uc.assessments.FindByReference(ctx, tenantID, reference)
+	if errors.Is(err, port.ErrAssessmentNotFound) {
+		return dto.EvaluateFeeResponse{}, false, nil
+	}
+	if err != nil {
+		return dto.EvaluateFeeResponse{}, false, fmt.Errorf("failed to find fee assessment: %w", err)
+	}
+	a := toFeeAssessmentResponse(assessment)
+	resp := dto.EvaluateFeeResponse{
+		Fee:        assessment.Fee(),
+		Currency:   assessment.Currency(),
+		Priced:     assessment.ScheduleID() != nil,
+		ScheduleID: assessment.ScheduleID(),
+		Assessment: &a,
+	}
+	if id := assessment.ScheduleID(); id != nil {
+		schedule, err := uc.schedules.FindByID(ctx, tenantID, *id)
+		if err != nil {
+			return dto.EvaluateFeeResponse{}, false, fmt.Errorf("failed to find fee schedule: %w", err)
+		}
+		if rule, ok := schedule.Rule(assessment.FeeCode(), assessment.Currency()); ok {
+			r := toFeeRule(rule)
+			resp.Rule = &r
+		}
+	}
+	return resp, true, nil
+}
+
+// findEffective returns the schedule in effect at a time for a product on
+// a channel, else the product's schedule for any channel, and nil when
+// neither exists.
+func findEffective(
+	ctx context.Context,
+	schedules port.FeeScheduleRepository,
+	tenantID uuid.UUID,
+	product valueobject.Product,
+	channel valueobject.Channel,
+	at time.Time,
+) (*model.FeeSchedule, error) {
+	channels := []valueobject.Channel{channel}
+	if channel != valueobject.ChannelAny {
+		channels = append(channels, valueobject.ChannelAny)
+	}
+	for _, c := range channels {
+		schedule, err := schedules.FindEffective(ctx, port.ScheduleScope{TenantID: tenantID, Product: product, Channel: c}, at)
+		if errors.Is(err, port.ErrScheduleNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find fee schedule: %w", err)
+		}
+		return &schedule, nil
+	}
+	return nil, nil
+}
+
+// quote prices a transaction under schedule without recording the fee.
+func quote(schedule *model.FeeSchedule, feeCode, currency string, amount decimal.Decimal) dto.EvaluateFeeResponse {
+	resp := dto.EvaluateFeeResponse{Fee: decimal.Zero, Currency: currency}
+	if schedule == nil {
+		return resp
+	}
+	rule, ok := schedule.Rule(feeCode, currency)
+	if !ok {
+		return resp
+	}
+	scheduleID := schedule.ID()
+	r := toFeeRule(rule)
+	resp.Fee = rule.Apply(amount)
+	resp.Priced = true
+	resp.ScheduleID = &scheduleID
+	resp.Rule = &r
+	return resp
+}
+
+func toFeeAssessmentResponse(a model.FeeAssessment) dto.FeeAssessmentResponse {
+	return dto.FeeAssessmentResponse{
+		ID:         a.ID(),
+		TenantID:   a.TenantID(),
+		Reference:  a.Reference(),
+		Product:    a.Product().String(),
+		Channel:    a.Channel().String(),
+		FeeCode:    a.FeeCode(),
+		Currency:   a.Currency(),
+		Amount:     a.Amount(),
+		Fee:        a.Fee(),
+		ScheduleID: a.ScheduleID(),
+		AssessedAt: a.AssessedAt(),
+	}
+}
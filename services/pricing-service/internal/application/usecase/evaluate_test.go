@@ -0,0 +1,134 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/pricing-service/internal/application/dto"
+	"github.com/bibbank/bib/services/pricing-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/model"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/port"
+)
+
+// inMemoryAssessmentRepo is an in-memory AssessmentRepository.
+type inMemoryAssessmentRepo struct {
+	assessments []model.FeeAssessment
+}
+
+func (r *inMemoryAssessmentRepo) Save(ctx context.Context, assessment model.FeeAssessment) error {
+	if _, err := r.FindByReference(ctx, assessment.TenantID(), assessment.Reference()); err == nil {
+		return port.ErrAssessmentExists
+	}
+	r.assessments = append(r.assessments, assessment.ClearDomainEvents())
+	return nil
+}
+
+func (r *inMemoryAssessmentRepo) FindByReference(_ context.Context, tenantID uuid.UUID, reference string) (model.FeeAssessment, error) {
+	for _, a := range r.assessments {
+		if a.TenantID() == tenantID && a.Reference() == reference {
+			return a, nil
+		}
+	}
+	return model.FeeAssessment{}, port.ErrAssessmentNotFound
+}
+
+func (r *inMemoryAssessmentRepo) List(_ context.Context, filter port.AssessmentFilter, limit, offset int) ([]model.FeeAssessment, error) {
+	var out []model.FeeAssessment
+	for _, a := range r.assessments {
+		switch {
+		case a.TenantID() != filter.TenantID,
+			!filter.Product.IsZero() && a.Product() != filter.Product,
+			!filter.Channel.IsZero() && a.Channel() != filter.Channel,
+			!filter.From.IsZero() && a.AssessedAt().Before(filter.From),
+			!filter.To.IsZero() && !a.AssessedAt().Before(filter.To):
+			continue
+		}
+		out = append(out, a)
+	}
+	return out[min(offset, len(out)):min(offset+limit, len(out))], nil
+}
+
+func evaluateRequest(tenantID uuid.UUID, channel, feeCode, reference, amount string) dto.EvaluateFeeRequest {
+	return dto.EvaluateFeeRequest{
+		TenantID:  tenantID,
+		Product:   "PAYMENTS",
+		Channel:   channel,
+		FeeCode:   feeCode,
+		Currency:  "EUR",
+		Reference: reference,
+		Amount:    d(amount),
+	}
+}
+
+func TestEvaluateFee_ChannelFallback(t *testing.T) {
+	schedules, assessments, publisher := &inMemoryScheduleRepo{}, &inMemoryAssessmentRepo{}, &recordingPublisher{}
+	tenantID := uuid.New()
+	evaluate := usecase.NewEvaluateFeeUseCase(schedules, assessments, publisher)
+	anyChannel := createSchedule(t, schedules, publisher, tenantID, "ANY", time.Time{}, fixedFee("ACH", "0.25"))
+	mobile := createSchedule(t, schedules, publisher, tenantID, "MOBILE", time.Time{}, fixedFee("ACH", "0.10"))
+
+	resp, err := evaluate.Execute(context.Background(), evaluateRequest(tenantID, "MOBILE", "ACH", "", "100"))
+	require.NoError(t, err)
+	assert.True(t, resp.Priced)
+	assert.True(t, d("0.10").Equal(resp.Fee))
+	assert.Equal(t, mobile.ID, *resp.ScheduleID)
+
+	resp, err = evaluate.Execute(context.Background(), evaluateRequest(tenantID, "BRANCH", "ACH", "", "100"))
+	require.NoError(t, err)
+	assert.True(t, d("0.25").Equal(resp.Fee), "a channel without a schedule falls back to any channel's")
+	assert.Equal(t, anyChannel.ID, *resp.ScheduleID)
+
+	resp, err = evaluate.Execute(context.Background(), evaluateRequest(tenantID, "BRANCH", "WIRE", "", "100"))
+	require.NoError(t, err)
+	assert.False(t, resp.Priced, "no rule prices the fee")
+	assert.True(t, resp.Fee.IsZero())
+	assert.Empty(t, assessments.assessments, "a quote records nothing")
+
+	_, err = evaluate.Execute(context.Background(), evaluateRequest(tenantID, "FAX", "ACH", "", "100"))
+	assert.ErrorIs(t, err, usecase.ErrInvalidFee)
+}
+
+func TestEvaluateFee_EffectiveDating(t *testing.T) {
+	schedules, assessments, publisher := &inMemoryScheduleRepo{}, &inMemoryAssessmentRepo{}, &recordingPublisher{}
+	tenantID := uuid.New()
+	evaluate := usecase.NewEvaluateFeeUseCase(schedules, assessments, publisher)
+	createSchedule(t, schedules, publisher, tenantID, "ANY", time.Time{}, fixedFee("ACH", "0.25"))
+	from := time.Now().UTC().Add(24 * time.Hour)
+	createSchedule(t, schedules, publisher, tenantID, "ANY", from, fixedFee("ACH", "0.30"))
+
+	resp, err := evaluate.Execute(context.Background(), evaluateRequest(tenantID, "ONLINE", "ACH", "", "100"))
+	require.NoError(t, err)
+	assert.True(t, d("0.25").Equal(resp.Fee), "the later schedule is not yet in effect")
+
+	req := evaluateRequest(tenantID, "ONLINE", "ACH", "", "100")
+	req.At = from
+	resp, err = evaluate.Execute(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, d("0.30").Equal(resp.Fee))
+}
+
+func TestEvaluateFee_RecordsOncePerReference(t *testing.T) {
+	schedules, assessments, publisher := &inMemoryScheduleRepo{}, &inMemoryAssessmentRepo{}, &recordingPublisher{}
+	tenantID := uuid.New()
+	evaluate := usecase.NewEvaluateFeeUseCase(schedules, assessments, publisher)
+	createSchedule(t, schedules, publisher, tenantID, "ANY", time.Time{}, fixedFee("ACH", "0.25"))
+
+	resp, err := evaluate.Execute(context.Background(), evaluateRequest(tenantID, "ONLINE", "ACH", "payment-1", "100"))
+	require.NoError(t, err)
+	require.NotNil(t, resp.Assessment)
+	assert.True(t, d("0.25").Equal(resp.Assessment.Fee))
+	assert.Contains(t, publisher.eventTypes(), "pricing.fee.assessed")
+
+	// The fee changes, but the transaction was already charged.
+	createSchedule(t, schedules, publisher, tenantID, "ANY", time.Time{}, fixedFee("ACH", "0.50"))
+	again, err := evaluate.Execute(context.Background(), evaluateRequest(tenantID, "ONLINE", "ACH", "payment-1", "100"))
+	require.NoError(t, err)
+	assert.Equal(t, resp.Assessment.ID, again.Assessment.ID)
+	assert.True(t, d("0.25").Equal(again.Fee))
+	assert.Len(t, assessments.assessments, 1)
+}
@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/pricing-service/internal/application/dto"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/model"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/port"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/valueobject"
+)
+
+// ErrInvalidSchedule is returned when a fee schedule request is malformed.
+var ErrInvalidSchedule = errors.New("invalid fee schedule")
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// CreateFeeScheduleUseCase creates a fee schedule.
+type CreateFeeScheduleUseCase struct {
+	schedules port.FeeScheduleRepository
+	publisher port.EventPublisher
+}
+
+// NewCreateFeeScheduleUseCase creates a new CreateFeeScheduleUseCase.
+func NewCreateFeeScheduleUseCase(schedules port.FeeScheduleRepository, publisher port.EventPublisher) *CreateFeeScheduleUseCase {
+	return &CreateFeeScheduleUseCase{schedules: schedules, publisher: publisher}
+}
+
+// Execute creates the fee schedule. It takes the place of the product and
+// channel's schedule in effect from its effective date on.
+func (uc *CreateFeeScheduleUseCase) Execute(ctx context.Context, req dto.CreateFeeScheduleRequest) (dto.FeeScheduleResponse, error) {
+	product, channel, err := parseScope(req.Product, req.Channel)
+	if err != nil {
+		return dto.FeeScheduleResponse{}, fmt.Errorf("%w: %w", ErrInvalidSchedule, err)
+	}
+	rules, err := toFeeRules(req.Rules)
+	if err != nil {
+		return dto.FeeScheduleResponse{}, fmt.Errorf("%w: %w", ErrInvalidSchedule, err)
+	}
+	schedule, err := model.NewFeeSchedule(req.TenantID, product, channel, req.EffectiveFrom, rules, time.Now().UTC())
+	if err != nil {
+		return dto.FeeScheduleResponse{}, fmt.Errorf("%w: %w", ErrInvalidSchedule, err)
+	}
+
+	if err := uc.schedules.Save(ctx, schedule); err != nil {
+		return dto.FeeScheduleResponse{}, fmt.Errorf("failed to save fee schedule: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, schedule.DomainEvents()); err != nil {
+		return dto.FeeScheduleResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toFeeScheduleResponse(schedule), nil
+}
+
+// ReviseFeeScheduleUseCase revises a fee schedule before it takes effect.
+type ReviseFeeScheduleUseCase struct {
+	schedules port.FeeScheduleRepository
+	publisher port.EventPublisher
+}
+
+// NewReviseFeeScheduleUseCase creates a new ReviseFeeScheduleUseCase.
+func NewReviseFeeScheduleUseCase(schedules port.FeeScheduleRepository, publisher port.EventPublisher) *ReviseFeeScheduleUseCase {
+	return &ReviseFeeScheduleUseCase{schedules: schedules, publisher: publisher}
+}
+
+// Execute replaces the schedule's rules and, when one is given, its
+// effective date.
+func (uc *ReviseFeeScheduleUseCase) Execute(ctx context.Context, req dto.ReviseFeeScheduleRequest) (dto.FeeScheduleResponse, error) {
+	rules, err := toFeeRules(req.Rules)
+	if err != nil {
+		return dto.FeeScheduleResponse{}, fmt.Errorf("%w: %w", ErrInvalidSchedule, err)
+	}
+	schedule, err := uc.schedules.FindByID(ctx, req.TenantID, req.ScheduleID)
+	if err != nil {
+		return dto.FeeScheduleResponse{}, fmt.Errorf("failed to find fee schedule: %w", err)
+	}
+	schedule, err = schedule.Revise(rules, req.EffectiveFrom, time.Now().UTC())
+	if errors.Is(err, model.ErrScheduleInEffect) {
+		return dto.FeeScheduleResponse{}, err
+	}
+	if err != nil {
+		return dto.FeeScheduleResponse{}, fmt.Errorf("%w: %w", ErrInvalidSchedule, err)
+	}
+
+	if err := uc.schedules.Save(ctx, schedule); err != nil {
+		return dto.FeeScheduleResponse{}, fmt.Errorf("failed to save fee schedule: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, schedule.DomainEvents()); err != nil {
+		return dto.FeeScheduleResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toFeeScheduleResponse(schedule), nil
+}
+
+// GetFeeScheduleUseCase retrieves a fee schedule.
+type GetFeeScheduleUseCase struct {
+	schedules port.FeeScheduleRepository
+}
+
+// NewGetFeeScheduleUseCase creates a new GetFeeScheduleUseCase.
+func NewGetFeeScheduleUseCase(schedules port.FeeScheduleRepository) *GetFeeScheduleUseCase {
+	return &GetFeeScheduleUseCase{schedules: schedules}
+}
+
+// Execute retrieves the fee schedule.
+func (uc *GetFeeScheduleUseCase) Execute(ctx context.Context, tenantID, scheduleID uuid.UUID) (dto.FeeScheduleResponse, error) {
+	schedule, err := uc.schedules.FindByID(ctx, tenantID, scheduleID)
+	if err != nil {
+		return dto.FeeScheduleResponse{}, fmt.Errorf("failed to find fee schedule: %w", err)
+	}
+	return toFeeScheduleResponse(schedule), nil
+}
+
+// ListFeeSchedulesUseCase lists a tenant's fee schedules.
+type ListFeeSchedulesUseCase struct {
+	schedules port.FeeScheduleRepository
+}
+
+// NewListFeeSchedulesUseCase creates a new ListFeeSchedulesUseCase.
+func NewListFeeSchedulesUseCase(schedules port.FeeScheduleRepository) *ListFeeSchedulesUseCase {
+	return &ListFeeSchedulesUseCase{schedules: schedules}
+}
+
+// Execute returns one page of the tenant's fee schedules.
+func (uc *ListFeeSchedulesUseCase) Execute(ctx context.Context, req dto.ListFeeSchedulesRequest) (dto.ListFeeSchedulesResponse, error) {
+	filter := port.ScheduleFilter{TenantID: req.TenantID}
+	if req.Product != "" {
+		product, err := valueobject.NewProduct(req.Product)
+		if err != nil {
+			return dto.ListFeeSchedulesResponse{}, fmt.Errorf("%w: %w", ErrInvalidSchedule, err)
+		}
+		filter.Product = product
+	}
+	if req.Channel != "" {
+		channel, err := valueobject.NewChannel(req.Channel)
+		if err != nil {
+			return dto.ListFeeSchedulesResponse{}, fmt.Errorf("%w: %w", ErrInvalidSchedule, err)
+		}
+		filter.Channel = channel
+	}
+	limit, offset := page(req.Limit, req.Offset)
+	schedules, total, err := uc.schedules.List(ctx, filter, limit, offset)
+	if err != nil {
+		return dto.ListFeeSchedulesResponse{}, fmt.Errorf("failed to list fee schedules: %w", err)
+	}
+	resp := dto.ListFeeSchedulesResponse{
+		Schedules:  make([]dto.FeeScheduleResponse, 0, len(schedules)),
+		TotalCount: total,
+	}
+	for _, s := range schedules {
+		resp.Schedules = append(resp.Schedules, toFeeScheduleResponse(s))
+	}
+	return resp, nil
+}
+
+func parseScope(product, channel string) (valueobject.Product, valueobject.Channel, error) {
+	p, err := valueobject.NewProduct(product)
+	if err != nil {
+		return valueobject.Product{}, valueobject.Channel{}, err
+	}
+	c, err := valueobject.NewChannel(channel)
+	if err != nil {
+		return valueobject.Product{}, valueobject.Channel{}, err
+	}
+	return p, c, nil
+}
+
+func toFeeRules(rules []dto.FeeRule) ([]model.FeeRule, error) {
+	out := make([]model.FeeRule, 0, len(rules))
+	for _, r := range rules {
+		rule, err := model.NewFeeRule(r.FeeCode, r.Currency, r.Fixed, r.Rate, r.Minimum, r.Maximum)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rule)
+	}
+	return out, nil
+}
+
+func page(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	return min(limit, maxLimit), max(offset, 0)
+}
+
+func toFeeRule(r model.FeeRule) dto.FeeRule {
+	return dto.FeeRule{
+		FeeCode:  r.FeeCode(),
+		Currency: r.Currency(),
+		Fixed:    r.Fixed(),
+		Rate:     r.Rate(),
+		Minimum:  r.Minimum(),
+		Maximum:  r.Maximum(),
+	}
+}
+
+func toFeeScheduleResponse(s model.FeeSchedule) dto.FeeScheduleResponse {
+	rules := make([]dto.FeeRule, len(s.Rules()))
+	for i, r := range s.Rules() {
+		rules[i] = toFeeRule(r)
+	}
+	return dto.FeeScheduleResponse{
+		ID:            s.ID(),
+		TenantID:      s.TenantID(),
+		Product:       s.Product().String(),
+		Channel:       s.Channel().String(),
+		EffectiveFrom: s.EffectiveFrom(),
+		Rules:         rules,
+		Version:       s.Version(),
+		CreatedAt:     s.CreatedAt(),
+		UpdatedAt:     s.UpdatedAt(),
+	}
+}
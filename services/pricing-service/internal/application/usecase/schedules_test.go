@@ -0,0 +1,176 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/pricing-service/internal/application/dto"
+	"github.com/bibbank/bib/services/pricing-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/event"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/model"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/port"
+)
+
+func d(v string) decimal.Decimal { return decimal.RequireFromString(v) }
+
+// inMemoryScheduleRepo is an in-memory FeeScheduleRepository.
+type inMemoryScheduleRepo struct {
+	schedules []model.FeeSchedule
+}
+
+func (r *inMemoryScheduleRepo) Save(_ context.Context, schedule model.FeeSchedule) error {
+	i := slices.IndexFunc(r.schedules, func(s model.FeeSchedule) bool { return s.ID() == schedule.ID() })
+	if i >= 0 && r.schedules[i].Version() != schedule.Version()-1 {
+		return port.ErrVersionConflict
+	}
+	for _, s := range r.schedules {
+		if s.ID() != schedule.ID() && s.TenantID() == schedule.TenantID() && s.Product() == schedule.Product() &&
+			s.Channel() == schedule.Channel() && s.EffectiveFrom().Equal(schedule.EffectiveFrom()) {
+			return port.ErrScheduleExists
+		}
+	}
+	// Events are not persisted.
+	schedule = schedule.ClearDomainEvents()
+	if i >= 0 {
+		r.schedules[i] = schedule
+	} else {
+		r.schedules = append(r.schedules, schedule)
+	}
+	return nil
+}
+
+func (r *inMemoryScheduleRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.FeeSchedule, error) {
+	for _, s := range r.schedules {
+		if s.TenantID() == tenantID && s.ID() == id {
+			return s, nil
+		}
+	}
+	return model.FeeSchedule{}, port.ErrScheduleNotFound
+}
+
+func (r *inMemoryScheduleRepo) FindEffective(_ context.Context, scope port.ScheduleScope, at time.Time) (model.FeeSchedule, error) {
+	var found *model.FeeSchedule
+	for _, s := range r.schedules {
+		if s.TenantID() == scope.TenantID && s.Product() == scope.Product && s.Channel() == scope.Channel &&
+			s.InEffect(at) && (found == nil || s.EffectiveFrom().After(found.EffectiveFrom())) {
+			found = &s
+		}
+	}
+	if found == nil {
+		return model.FeeSchedule{}, port.ErrScheduleNotFound
+	}
+	return *found, nil
+}
+
+func (r *inMemoryScheduleRepo) List(_ context.Context, filter port.ScheduleFilter, limit, offset int) ([]model.FeeSchedule, int, error) {
+	var out []model.FeeSchedule
+	for _, s := range r.schedules {
+		switch {
+		case s.TenantID() != filter.TenantID,
+			!filter.Product.IsZero() && s.Product() != filter.Product,
+			!filter.Channel.IsZero() && s.Channel() != filter.Channel:
+			continue
+		}
+		out = append(out, s)
+	}
+	total := len(out)
+	return out[min(offset, total):min(offset+limit, total)], total, nil
+}
+
+// recordingPublisher is an EventPublisher that records what it publishes.
+type recordingPublisher struct {
+	events []event.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, events []event.DomainEvent) error {
+	p.events = append(p.events, events...)
+	return nil
+}
+
+func (p *recordingPublisher) eventTypes() []string {
+	types := make([]string, len(p.events))
+	for i, e := range p.events {
+		types[i] = e.EventType()
+	}
+	return types
+}
+
+// createSchedule creates a schedule for payments on a channel taking effect
+// at effectiveFrom, or at once when it is zero.
+func createSchedule(t *testing.T, schedules *inMemoryScheduleRepo, publisher *recordingPublisher, tenantID uuid.UUID, channel string, effectiveFrom time.Time, rules ...dto.FeeRule) dto.FeeScheduleResponse {
+	t.Helper()
+	schedule, err := usecase.NewCreateFeeScheduleUseCase(schedules, publisher).Execute(context.Background(), dto.CreateFeeScheduleRequest{
+		TenantID:      tenantID,
+		Product:       "PAYMENTS",
+		Channel:       channel,
+		EffectiveFrom: effectiveFrom,
+		Rules:         rules,
+	})
+	require.NoError(t, err)
+	return schedule
+}
+
+func fixedFee(feeCode, fixed string) dto.FeeRule {
+	return dto.FeeRule{FeeCode: feeCode, Currency: "EUR", Fixed: d(fixed)}
+}
+
+func TestCreateFeeSchedule(t *testing.T) {
+	schedules, publisher := &inMemoryScheduleRepo{}, &recordingPublisher{}
+	tenantID := uuid.New()
+	schedule := createSchedule(t, schedules, publisher, tenantID, "ANY", time.Time{}, fixedFee("ACH", "0.25"))
+	assert.Equal(t, "PAYMENTS", schedule.Product)
+	assert.Equal(t, 1, schedule.Version)
+	assert.Equal(t, []string{"pricing.schedule.created"}, publisher.eventTypes())
+
+	create := usecase.NewCreateFeeScheduleUseCase(schedules, publisher)
+	_, err := create.Execute(context.Background(), dto.CreateFeeScheduleRequest{
+		TenantID: tenantID, Product: "LOANS", Channel: "ANY", Rules: []dto.FeeRule{fixedFee("ACH", "1")},
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidSchedule)
+	_, err = create.Execute(context.Background(), dto.CreateFeeScheduleRequest{
+		TenantID: tenantID, Product: "PAYMENTS", Channel: "ANY", Rules: []dto.FeeRule{{FeeCode: "ACH", Currency: "EUR", Rate: d("2")}},
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidSchedule, "a rate above 1")
+
+	from := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	createSchedule(t, schedules, publisher, tenantID, "ANY", from, fixedFee("ACH", "0.30"))
+	_, err = create.Execute(context.Background(), dto.CreateFeeScheduleRequest{
+		TenantID: tenantID, Product: "PAYMENTS", Channel: "ANY", EffectiveFrom: from, Rules: []dto.FeeRule{fixedFee("ACH", "0.35")},
+	})
+	assert.ErrorIs(t, err, port.ErrScheduleExists, "two schedules for the scope taking effect at once")
+
+	list, err := usecase.NewListFeeSchedulesUseCase(schedules).Execute(context.Background(), dto.ListFeeSchedulesRequest{
+		TenantID: tenantID, Product: "PAYMENTS",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, list.TotalCount)
+}
+
+func TestReviseFeeSchedule(t *testing.T) {
+	schedules, publisher := &inMemoryScheduleRepo{}, &recordingPublisher{}
+	tenantID := uuid.New()
+	current := createSchedule(t, schedules, publisher, tenantID, "ANY", time.Time{}, fixedFee("ACH", "0.25"))
+	next := createSchedule(t, schedules, publisher, tenantID, "ANY", time.Now().UTC().Add(24*time.Hour), fixedFee("ACH", "0.30"))
+	revise := usecase.NewReviseFeeScheduleUseCase(schedules, publisher)
+
+	revised, err := revise.Execute(context.Background(), dto.ReviseFeeScheduleRequest{
+		TenantID: tenantID, ScheduleID: next.ID, Rules: []dto.FeeRule{fixedFee("ACH", "0.28")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, next.EffectiveFrom, revised.EffectiveFrom)
+	assert.Equal(t, 2, revised.Version)
+	assert.True(t, d("0.28").Equal(revised.Rules[0].Fixed))
+
+	_, err = revise.Execute(context.Background(), dto.ReviseFeeScheduleRequest{
+		TenantID: tenantID, ScheduleID: current.ID, Rules: []dto.FeeRule{fixedFee("ACH", "0.20")},
+	})
+	assert.ErrorIs(t, err, model.ErrScheduleInEffect)
+	assert.Equal(t, []string{"pricing.schedule.created", "pricing.schedule.created", "pricing.schedule.revised"}, publisher.eventTypes())
+}
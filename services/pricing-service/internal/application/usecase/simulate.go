@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/pricing-service/internal/application/dto"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/model"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/port"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/valueobject"
+)
+
+// ErrInvalidSimulation is returned when a fee change simulation request is
+// malformed.
+var ErrInvalidSimulation = errors.New("invalid fee simulation")
+
+// simulationPageSize is how many fee assessments a simulation reads at a
+// time.
+const simulationPageSize = 500
+
+// SimulateFeeChangeUseCase reprices the fees charged over a period under
+// proposed pricing, so the impact of a fee change can be seen before it
+// takes effect.
+type SimulateFeeChangeUseCase struct {
+	schedules   port.FeeScheduleRepository
+	assessments port.AssessmentRepository
+	window      time.Duration
+}
+
+// NewSimulateFeeChangeUseCase creates a new SimulateFeeChangeUseCase that
+// simulates against the window up to now when a request gives no period.
+func NewSimulateFeeChangeUseCase(
+	schedules port.FeeScheduleRepository,
+	assessments port.AssessmentRepository,
+	window time.Duration,
+) *SimulateFeeChangeUseCase {
+	return &SimulateFeeChangeUseCase{schedules: schedules, assessments: assessments, window: window}
+}
+
+// Execute reprices every fee recorded for the proposal's product over the
+// period, on its channel or, for a proposal for any channel, on every
+// channel. The impact is reported by fee code and currency; fees the
+// proposal does not price would be free.
+func (uc *SimulateFeeChangeUseCase) Execute(ctx context.Context, req dto.SimulateFeeChangeRequest) (dto.SimulateFeeChangeResponse, error) {
+	now := time.Now().UTC()
+	proposal, err := uc.proposal(ctx, req, now)
+	if err != nil {
+		return dto.SimulateFeeChangeResponse{}, err
+	}
+	to := req.To
+	if to.IsZero() {
+		to = now
+	}
+	from := req.From
+	if from.IsZero() {
+		from = to.Add(-uc.window)
+	}
+	if !from.Before(to) {
+		return dto.SimulateFeeChangeResponse{}, fmt.Errorf("%w: the period must end after it starts", ErrInvalidSimulation)
+	}
+
+	filter := port.AssessmentFilter{TenantID: req.TenantID, Product: proposal.Product(), From: from, To: to}
+	if proposal.Channel() != valueobject.ChannelAny {
+		filter.Channel = proposal.Channel()
+	}
+	impacts := make(map[[2]string]*dto.FeeImpact)
+	for offset := 0; ; offset += simulationPageSize {
+		assessments, err := uc.assessments.List(ctx, filter, simulationPageSize, offset)
+		if err != nil {
+			return dto.SimulateFeeChangeResponse{}, fmt.Errorf("failed to list fee assessments: %w", err)
+		}
+		for _, a := range assessments {
+			key := [2]string{a.FeeCode(), a.Currency()}
+			impact, ok := impacts[key]
+			if !ok {
+				impact = &dto.FeeImpact{
+					FeeCode:      a.FeeCode(),
+					Currency:     a.Currency(),
+					Volume:       decimal.Zero,
+					CurrentFees:  decimal.Zero,
+					ProposedFees: decimal.Zero,
+				}
+				impacts[key] = impact
+			}
+			impact.Count++
+			impact.Volume = impact.Volume.Add(a.Amount())
+			impact.CurrentFees = impact.CurrentFees.Add(a.Fee())
+			if rule, ok := proposal.Rule(a.FeeCode(), a.Currency()); ok {
+				impact.ProposedFees = impact.ProposedFees.Add(rule.Apply(a.Amount()))
+			}
+		}
+		if len(assessments) < simulationPageSize {
+			break
+		}
+	}
+
+	resp := dto.SimulateFeeChangeResponse{From: from, To: to, Impacts: make([]dto.FeeImpact, 0, len(impacts))}
+	for _, impact := range impacts {
+		impact.Difference = impact.ProposedFees.Sub(impact.CurrentFees)
+		resp.Impacts = append(resp.Impacts, *impact)
+	}
+	slices.SortFunc(resp.Impacts, func(a, b dto.FeeImpact) int {
+		return cmp.Or(cmp.Compare(a.FeeCode, b.FeeCode), cmp.Compare(a.Currency, b.Currency))
+	})
+	return resp, nil
+}
+
+// proposal returns the schedule the request proposes: a saved one, or one
+// built from the request's rules that is never saved.
+func (uc *SimulateFeeChangeUseCase) proposal(ctx context.Context, req dto.SimulateFeeChangeRequest, now time.Time) (model.FeeSchedule, error) {
+	if req.ScheduleID != nil {
+		schedule, err := uc.schedules.FindByID(ctx, req.TenantID, *req.ScheduleID)
+		if err != nil {
+			return model.FeeSchedule{}, fmt.Errorf("failed to find fee schedule: %w", err)
+		}
+		return schedule, nil
+	}
+	product, channel, err := parseScope(req.Product, req.Channel)
+	if err != nil {
+		return model.FeeSchedule{}, fmt.Errorf("%w: %w", ErrInvalidSimulation, err)
+	}
+	rules, err := toFeeRules(req.Rules)
+	if err != nil {
+		return model.FeeSchedule{}, fmt.Errorf("%w: %w", ErrInvalidSimulation, err)
+	}
+	schedule, err := model.NewFeeSchedule(req.TenantID, product, channel, time.Time{}, rules, now)
+	if err != nil {
+		return model.FeeSchedule{}, fmt.Errorf("%w: %w", ErrInvalidSimulation, err)
+	}
+	return schedule, nil
+}
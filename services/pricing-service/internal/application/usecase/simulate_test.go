@@ -0,0 +1,69 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/pricing-service/internal/application/dto"
+	"github.com/bibbank/bib/services/pricing-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/model"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/valueobject"
+)
+
+// recordFee adds a fee charged on a transaction at a time.
+func recordFee(assessments *inMemoryAssessmentRepo, tenantID uuid.UUID, channel valueobject.Channel, feeCode, amount, fee string, at time.Time) {
+	assessments.assessments = append(assessments.assessments, model.ReconstructFeeAssessment(
+		uuid.New(), tenantID, uuid.NewString(), valueobject.ProductPayments, channel, feeCode, "EUR",
+		d(amount), d(fee), nil, at))
+}
+
+func TestSimulateFeeChange(t *testing.T) {
+	schedules, assessments := &inMemoryScheduleRepo{}, &inMemoryAssessmentRepo{}
+	tenantID := uuid.New()
+	now := time.Now().UTC()
+	recordFee(assessments, tenantID, valueobject.ChannelOnline, "ACH", "100", "0.25", now.Add(-48*time.Hour))
+	recordFee(assessments, tenantID, valueobject.ChannelMobile, "ACH", "300", "0.25", now.Add(-24*time.Hour))
+	recordFee(assessments, tenantID, valueobject.ChannelOnline, "WIRE", "10000", "15", now.Add(-24*time.Hour))
+	recordFee(assessments, tenantID, valueobject.ChannelOnline, "ACH", "100", "0.25", now.Add(-60*24*time.Hour))
+	simulate := usecase.NewSimulateFeeChangeUseCase(schedules, assessments, 30*24*time.Hour)
+
+	resp, err := simulate.Execute(context.Background(), dto.SimulateFeeChangeRequest{
+		TenantID: tenantID,
+		Product:  "PAYMENTS",
+		Channel:  "ANY",
+		Rules:    []dto.FeeRule{{FeeCode: "ACH", Currency: "EUR", Rate: d("0.001"), Minimum: d("0.20")}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Impacts, 2)
+
+	ach := resp.Impacts[0]
+	assert.Equal(t, "ACH", ach.FeeCode)
+	assert.Equal(t, 2, ach.Count, "fees charged before the window are left out")
+	assert.True(t, d("400").Equal(ach.Volume))
+	assert.True(t, d("0.50").Equal(ach.CurrentFees))
+	assert.True(t, d("0.50").Equal(ach.ProposedFees), ach.ProposedFees.String())
+	assert.True(t, ach.Difference.IsZero())
+
+	wire := resp.Impacts[1]
+	assert.Equal(t, "WIRE", wire.FeeCode)
+	assert.True(t, wire.ProposedFees.IsZero(), "the proposal does not price wires")
+	assert.True(t, d("-15").Equal(wire.Difference))
+
+	// A saved schedule for one channel is simulated against that channel.
+	next := createSchedule(t, schedules, &recordingPublisher{}, tenantID, "MOBILE", now.Add(24*time.Hour), fixedFee("ACH", "0.10"))
+	resp, err = simulate.Execute(context.Background(), dto.SimulateFeeChangeRequest{TenantID: tenantID, ScheduleID: &next.ID})
+	require.NoError(t, err)
+	require.Len(t, resp.Impacts, 1)
+	assert.Equal(t, 1, resp.Impacts[0].Count)
+	assert.True(t, d("-0.15").Equal(resp.Impacts[0].Difference))
+
+	_, err = simulate.Execute(context.Background(), dto.SimulateFeeChangeRequest{
+		TenantID: tenantID, ScheduleID: &next.ID, From: now, To: now.Add(-time.Hour),
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidSimulation)
+}
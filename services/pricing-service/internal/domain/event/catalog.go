@@ -0,0 +1,13 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[FeeScheduleCreated]("pricing.schedule.created", 1, "Emitted when a fee schedule is created."),
+		events.Declare[FeeScheduleRevised]("pricing.schedule.revised", 1, "Emitted when a fee schedule's rules or effective date are replaced before it takes effect."),
+		events.Declare[FeeAssessed]("pricing.fee.assessed", 1, "Emitted when a fee is charged on a transaction."),
+	}
+}
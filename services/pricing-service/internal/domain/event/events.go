@@ -0,0 +1,106 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// DomainEvent is an alias for the shared pkg/events.DomainEvent interface.
+type DomainEvent = events.DomainEvent
+
+// Aggregate types of pricing events.
+const (
+	AggregateTypeFeeSchedule   = "FeeSchedule"
+	AggregateTypeFeeAssessment = "FeeAssessment"
+)
+
+// FeeRule is a fee schedule's rule as carried by its events. Maximum is
+// zero for a fee without a cap.
+type FeeRule struct {
+	FeeCode  string          `json:"fee_code"`
+	Currency string          `json:"currency"`
+	Fixed    decimal.Decimal `json:"fixed"`
+	Rate     decimal.Decimal `json:"rate"`
+	Minimum  decimal.Decimal `json:"minimum"`
+	Maximum  decimal.Decimal `json:"maximum"`
+}
+
+// FeeScheduleCreated is emitted when a fee schedule is created.
+type FeeScheduleCreated struct {
+	EffectiveFrom time.Time `json:"effective_from"`
+	events.BaseEvent
+	Product string    `json:"product"`
+	Channel string    `json:"channel"`
+	Rules   []FeeRule `json:"rules"`
+}
+
+// NewFeeScheduleCreated creates a new FeeScheduleCreated event.
+func NewFeeScheduleCreated(scheduleID, tenantID uuid.UUID, product, channel string, effectiveFrom time.Time, rules []FeeRule) FeeScheduleCreated {
+	return FeeScheduleCreated{
+		BaseEvent:     events.NewBaseEvent("pricing.schedule.created", scheduleID.String(), AggregateTypeFeeSchedule, tenantID.String()),
+		Product:       product,
+		Channel:       channel,
+		EffectiveFrom: effectiveFrom,
+		Rules:         rules,
+	}
+}
+
+// FeeScheduleRevised is emitted when a fee schedule's rules or effective
+// date are replaced before it takes effect.
+type FeeScheduleRevised struct {
+	PreviousEffectiveFrom time.Time `json:"previous_effective_from"`
+	EffectiveFrom         time.Time `json:"effective_from"`
+	events.BaseEvent
+	Rules   []FeeRule `json:"rules"`
+	Version int       `json:"version"`
+}
+
+// NewFeeScheduleRevised creates a new FeeScheduleRevised event.
+func NewFeeScheduleRevised(scheduleID, tenantID uuid.UUID, previousEffectiveFrom, effectiveFrom time.Time, rules []FeeRule, version int) FeeScheduleRevised {
+	return FeeScheduleRevised{
+		BaseEvent:             events.NewBaseEvent("pricing.schedule.revised", scheduleID.String(), AggregateTypeFeeSchedule, tenantID.String()),
+		PreviousEffectiveFrom: previousEffectiveFrom,
+		EffectiveFrom:         effectiveFrom,
+		Rules:                 rules,
+		Version:               version,
+	}
+}
+
+// FeeAssessed is emitted when a fee is charged on a transaction.
+// ScheduleID is nil when no schedule priced the transaction and the fee is
+// zero.
+type FeeAssessed struct {
+	ScheduleID *uuid.UUID `json:"schedule_id,omitempty"`
+	events.BaseEvent
+	Reference string          `json:"reference"`
+	Product   string          `json:"product"`
+	Channel   string          `json:"channel"`
+	FeeCode   string          `json:"fee_code"`
+	Currency  string          `json:"currency"`
+	Amount    decimal.Decimal `json:"amount"`
+	Fee       decimal.Decimal `json:"fee"`
+}
+
+// NewFeeAssessed creates a new FeeAssessed event.
+func NewFeeAssessed(
+	assessmentID, tenantID uuid.UUID,
+	scheduleID *uuid.UUID,
+	reference, product, channel, feeCode, currency string,
+	amount, fee decimal.Decimal,
+) FeeAssessed {
+	return FeeAssessed{
+		BaseEvent:  events.NewBaseEvent("pricing.fee.assessed", assessmentID.String(), AggregateTypeFeeAssessment, tenantID.String()),
+		ScheduleID: scheduleID,
+		Reference:  reference,
+		Product:    product,
+		Channel:    channel,
+		FeeCode:    feeCode,
+		Currency:   currency,
+		Amount:     amount,
+		Fee:        fee,
+	}
+}
@@ -0,0 +1,142 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/event"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/valueobject"
+)
+
+const maxReferenceLength = 128
+
+// FeeAssessment is the aggregate root for a fee charged on a transaction.
+// It records the transaction's amount and the schedule that priced it, so
+// a fee change can be simulated against the volume actually charged.
+type FeeAssessment struct {
+	assessedAt   time.Time
+	scheduleID   *uuid.UUID
+	amount       decimal.Decimal
+	fee          decimal.Decimal
+	product      valueobject.Product
+	channel      valueobject.Channel
+	reference    string
+	feeCode      string
+	currency     string
+	domainEvents []events.DomainEvent
+	id           uuid.UUID
+	tenantID     uuid.UUID
+}
+
+// AssessFee prices a transaction of amount under schedule, or charges no
+// fee when schedule is nil or has no rule for the fee code and currency,
+// and records it against the caller's reference.
+func AssessFee(
+	tenantID uuid.UUID,
+	reference string,
+	product valueobject.Product,
+	channel valueobject.Channel,
+	feeCode, currency string,
+	amount decimal.Decimal,
+	schedule *FeeSchedule,
+	now time.Time,
+) (FeeAssessment, error) {
+	if tenantID == uuid.Nil {
+		return FeeAssessment{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	if strings.TrimSpace(reference) == "" || len(reference) > maxReferenceLength {
+		return FeeAssessment{}, fmt.Errorf("reference must be 1 to %d characters", maxReferenceLength)
+	}
+	if product.IsZero() || channel.IsZero() {
+		return FeeAssessment{}, fmt.Errorf("product and channel must not be empty")
+	}
+	if !feeCodePattern.MatchString(feeCode) {
+		return FeeAssessment{}, fmt.Errorf("invalid fee code %q", feeCode)
+	}
+	if !currencyPattern.MatchString(currency) {
+		return FeeAssessment{}, fmt.Errorf("currency must be a 3-letter ISO 4217 code, got %q", currency)
+	}
+	if amount.IsNegative() {
+		return FeeAssessment{}, fmt.Errorf("amount must not be negative")
+	}
+
+	a := FeeAssessment{
+		id:         uuid.New(),
+		tenantID:   tenantID,
+		reference:  reference,
+		product:    product,
+		channel:    channel,
+		feeCode:    feeCode,
+		currency:   currency,
+		amount:     amount,
+		fee:        decimal.Zero,
+		assessedAt: now,
+	}
+	if schedule != nil {
+		if rule, ok := schedule.Rule(feeCode, currency); ok {
+			scheduleID := schedule.id
+			a.scheduleID = &scheduleID
+			a.fee = rule.Apply(amount)
+		}
+	}
+	a.domainEvents = append(a.domainEvents, event.NewFeeAssessed(
+		a.id, tenantID, a.scheduleID, reference, product.String(), channel.String(), feeCode, currency, amount, a.fee))
+	return a, nil
+}
+
+// ReconstructFeeAssessment recreates a FeeAssessment from persisted data
+// without validation or events.
+func ReconstructFeeAssessment(
+	id, tenantID uuid.UUID,
+	reference string,
+	product valueobject.Product,
+	channel valueobject.Channel,
+	feeCode, currency string,
+	amount, fee decimal.Decimal,
+	scheduleID *uuid.UUID,
+	assessedAt time.Time,
+) FeeAssessment {
+	return FeeAssessment{
+		id:         id,
+		tenantID:   tenantID,
+		reference:  reference,
+		product:    product,
+		channel:    channel,
+		feeCode:    feeCode,
+		currency:   currency,
+		amount:     amount,
+		fee:        fee,
+		scheduleID: scheduleID,
+		assessedAt: assessedAt,
+	}
+}
+
+// --- Accessors ---
+
+func (a FeeAssessment) ID() uuid.UUID                { return a.id }
+func (a FeeAssessment) TenantID() uuid.UUID          { return a.tenantID }
+func (a FeeAssessment) Reference() string            { return a.reference }
+func (a FeeAssessment) Product() valueobject.Product { return a.product }
+func (a FeeAssessment) Channel() valueobject.Channel { return a.channel }
+func (a FeeAssessment) FeeCode() string              { return a.feeCode }
+func (a FeeAssessment) Currency() string             { return a.currency }
+func (a FeeAssessment) Amount() decimal.Decimal      { return a.amount }
+func (a FeeAssessment) Fee() decimal.Decimal         { return a.fee }
+func (a FeeAssessment) ScheduleID() *uuid.UUID       { return a.scheduleID }
+func (a FeeAssessment) AssessedAt() time.Time        { return a.assessedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (a FeeAssessment) DomainEvents() []events.DomainEvent {
+	return a.domainEvents
+}
+
+// ClearDomainEvents returns a copy with cleared domain events.
+func (a FeeAssessment) ClearDomainEvents() FeeAssessment {
+	a.domainEvents = nil
+	return a
+}
@@ -0,0 +1,109 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/event"
+)
+
+// feeDecimals is the number of decimal places a fee is rounded to.
+const feeDecimals = 2
+
+var (
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	feeCodePattern  = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,63}$`)
+)
+
+// FeeRule prices one kind of transaction in one currency: a fixed amount
+// plus a rate of the transaction's amount, bounded below by a minimum and
+// above by a maximum unless the maximum is zero. It is an immutable value
+// object.
+type FeeRule struct {
+	fixed    decimal.Decimal
+	rate     decimal.Decimal
+	minimum  decimal.Decimal
+	maximum  decimal.Decimal
+	feeCode  string
+	currency string
+}
+
+// NewFeeRule creates a FeeRule. The rate is a fraction of the amount, so
+// 0.015 charges 1.5%.
+func NewFeeRule(feeCode, currency string, fixed, rate, minimum, maximum decimal.Decimal) (FeeRule, error) {
+	if !feeCodePattern.MatchString(feeCode) {
+		return FeeRule{}, fmt.Errorf("fee code must be 1 to 64 upper-case letters, digits or underscores starting with a letter, got %q", feeCode)
+	}
+	if !currencyPattern.MatchString(currency) {
+		return FeeRule{}, fmt.Errorf("currency must be a 3-letter ISO 4217 code, got %q", currency)
+	}
+	if fixed.IsNegative() || minimum.IsNegative() || maximum.IsNegative() {
+		return FeeRule{}, fmt.Errorf("fee %s: fixed, minimum and maximum fees must not be negative", feeCode)
+	}
+	if rate.IsNegative() || rate.GreaterThan(decimal.NewFromInt(1)) {
+		return FeeRule{}, fmt.Errorf("fee %s: rate must be between 0 and 1", feeCode)
+	}
+	if maximum.IsPositive() && maximum.LessThan(minimum) {
+		return FeeRule{}, fmt.Errorf("fee %s: maximum must not be below minimum", feeCode)
+	}
+	return FeeRule{
+		feeCode:  feeCode,
+		currency: currency,
+		fixed:    fixed,
+		rate:     rate,
+		minimum:  minimum,
+		maximum:  maximum,
+	}, nil
+}
+
+// ReconstructFeeRule recreates a FeeRule from persisted data without
+// validation.
+func ReconstructFeeRule(feeCode, currency string, fixed, rate, minimum, maximum decimal.Decimal) FeeRule {
+	return FeeRule{
+		feeCode:  feeCode,
+		currency: currency,
+		fixed:    fixed,
+		rate:     rate,
+		minimum:  minimum,
+		maximum:  maximum,
+	}
+}
+
+// Apply returns the fee the rule charges on a transaction of amount,
+// rounded to the cent.
+func (r FeeRule) Apply(amount decimal.Decimal) decimal.Decimal {
+	fee := r.fixed.Add(amount.Mul(r.rate))
+	fee = decimal.Max(fee, r.minimum)
+	if r.maximum.IsPositive() {
+		fee = decimal.Min(fee, r.maximum)
+	}
+	return fee.Round(feeDecimals)
+}
+
+// Prices returns true if the rule prices a transaction of the fee code in
+// the currency.
+func (r FeeRule) Prices(feeCode, currency string) bool {
+	return r.feeCode == feeCode && r.currency == currency
+}
+
+func (r FeeRule) toEvent() event.FeeRule {
+	return event.FeeRule{
+		FeeCode:  r.feeCode,
+		Currency: r.currency,
+		Fixed:    r.fixed,
+		Rate:     r.rate,
+		Minimum:  r.minimum,
+		Maximum:  r.maximum,
+	}
+}
+
+// --- Accessors ---
+
+func (r FeeRule) FeeCode() string          { return r.feeCode }
+func (r FeeRule) Currency() string         { return r.currency }
+func (r FeeRule) Fixed() decimal.Decimal   { return r.fixed }
+func (r FeeRule) Rate() decimal.Decimal    { return r.rate }
+func (r FeeRule) Minimum() decimal.Decimal { return r.minimum }
+func (r FeeRule) Maximum() decimal.Decimal { return r.maximum }
@@ -0,0 +1,216 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/event"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/valueobject"
+)
+
+// MaxRules bounds how many rules a fee schedule holds.
+const MaxRules = 200
+
+// ErrScheduleInEffect is returned when a fee schedule that has taken effect
+// is revised. Its pricing is changed by a new schedule taking effect later
+// instead, so fees already charged keep the schedule that priced them.
+var ErrScheduleInEffect = errors.New("fee schedule already in effect")
+
+// FeeSchedule is the aggregate root for a tenant's pricing of a product on
+// a channel. It prices transactions from its effective date until a later
+// schedule for the same product and channel takes effect, so a fee change
+// is made by creating a schedule effective from the date of the change. A
+// schedule for ChannelAny prices every channel without a schedule of its
+// own.
+type FeeSchedule struct {
+	effectiveFrom time.Time
+	createdAt     time.Time
+	updatedAt     time.Time
+	product       valueobject.Product
+	channel       valueobject.Channel
+	rules         []FeeRule
+	domainEvents  []events.DomainEvent
+	version       int
+	id            uuid.UUID
+	tenantID      uuid.UUID
+}
+
+// NewFeeSchedule creates a fee schedule taking effect at effectiveFrom, or
+// at now when effectiveFrom is zero. A schedule cannot take effect in the
+// past, which would reprice fees already charged.
+func NewFeeSchedule(
+	tenantID uuid.UUID,
+	product valueobject.Product,
+	channel valueobject.Channel,
+	effectiveFrom time.Time,
+	rules []FeeRule,
+	now time.Time,
+) (FeeSchedule, error) {
+	if tenantID == uuid.Nil {
+		return FeeSchedule{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	if product.IsZero() {
+		return FeeSchedule{}, fmt.Errorf("product must not be empty")
+	}
+	if channel.IsZero() {
+		return FeeSchedule{}, fmt.Errorf("channel must not be empty")
+	}
+	effectiveFrom, err := checkEffectiveFrom(effectiveFrom, now)
+	if err != nil {
+		return FeeSchedule{}, err
+	}
+	if err := checkRules(rules); err != nil {
+		return FeeSchedule{}, err
+	}
+
+	s := FeeSchedule{
+		id:            uuid.New(),
+		tenantID:      tenantID,
+		product:       product,
+		channel:       channel,
+		effectiveFrom: effectiveFrom,
+		rules:         rules,
+		version:       1,
+		createdAt:     now,
+		updatedAt:     now,
+	}
+	s.domainEvents = append(s.domainEvents, event.NewFeeScheduleCreated(
+		s.id, tenantID, product.String(), channel.String(), effectiveFrom, s.eventRules()))
+	return s, nil
+}
+
+// ReconstructFeeSchedule recreates a FeeSchedule from persisted data
+// without validation or events.
+func ReconstructFeeSchedule(
+	id, tenantID uuid.UUID,
+	product valueobject.Product,
+	channel valueobject.Channel,
+	effectiveFrom time.Time,
+	rules []FeeRule,
+	version int,
+	createdAt, updatedAt time.Time,
+) FeeSchedule {
+	return FeeSchedule{
+		id:            id,
+		tenantID:      tenantID,
+		product:       product,
+		channel:       channel,
+		effectiveFrom: effectiveFrom,
+		rules:         rules,
+		version:       version,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
+
+// Revise replaces the schedule's rules and moves its effective date to
+// effectiveFrom, or keeps it when effectiveFrom is zero. Only a schedule
+// that has not yet taken effect can be revised.
+func (s FeeSchedule) Revise(rules []FeeRule, effectiveFrom, now time.Time) (FeeSchedule, error) {
+	if s.InEffect(now) {
+		return s, fmt.Errorf("%w since %s", ErrScheduleInEffect, s.effectiveFrom.Format(time.RFC3339))
+	}
+	if effectiveFrom.IsZero() {
+		effectiveFrom = s.effectiveFrom
+	}
+	effectiveFrom, err := checkEffectiveFrom(effectiveFrom, now)
+	if err != nil {
+		return s, err
+	}
+	if err := checkRules(rules); err != nil {
+		return s, err
+	}
+	previous := s.effectiveFrom
+	s.rules = rules
+	s.effectiveFrom = effectiveFrom
+	s = s.touch(now)
+	s.domainEvents = append(s.domainEvents, event.NewFeeScheduleRevised(
+		s.id, s.tenantID, previous, effectiveFrom, s.eventRules(), s.version))
+	return s, nil
+}
+
+// InEffect returns true if the schedule has taken effect at t. It prices
+// transactions from then until a later schedule takes its place.
+func (s FeeSchedule) InEffect(t time.Time) bool {
+	return !s.effectiveFrom.After(t)
+}
+
+// Rule returns the rule pricing a transaction of the fee code in the
+// currency, and false when the schedule has none.
+func (s FeeSchedule) Rule(feeCode, currency string) (FeeRule, bool) {
+	for _, r := range s.rules {
+		if r.Prices(feeCode, currency) {
+			return r, true
+		}
+	}
+	return FeeRule{}, false
+}
+
+func (s FeeSchedule) touch(now time.Time) FeeSchedule {
+	s.version++
+	s.updatedAt = now
+	return s
+}
+
+func (s FeeSchedule) eventRules() []event.FeeRule {
+	rules := make([]event.FeeRule, len(s.rules))
+	for i, r := range s.rules {
+		rules[i] = r.toEvent()
+	}
+	return rules
+}
+
+func checkEffectiveFrom(effectiveFrom, now time.Time) (time.Time, error) {
+	if effectiveFrom.IsZero() {
+		return now, nil
+	}
+	if effectiveFrom.Before(now) {
+		return time.Time{}, fmt.Errorf("effective date %s is in the past", effectiveFrom.Format(time.RFC3339))
+	}
+	return effectiveFrom, nil
+}
+
+func checkRules(rules []FeeRule) error {
+	if len(rules) == 0 || len(rules) > MaxRules {
+		return fmt.Errorf("a fee schedule holds 1 to %d rules", MaxRules)
+	}
+	seen := make(map[[2]string]bool, len(rules))
+	for _, r := range rules {
+		if r.feeCode == "" {
+			return fmt.Errorf("fee rule must not be empty")
+		}
+		key := [2]string{r.feeCode, r.currency}
+		if seen[key] {
+			return fmt.Errorf("fee %s in %s is priced twice", r.feeCode, r.currency)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// --- Accessors ---
+
+func (s FeeSchedule) ID() uuid.UUID                { return s.id }
+func (s FeeSchedule) TenantID() uuid.UUID          { return s.tenantID }
+func (s FeeSchedule) Product() valueobject.Product { return s.product }
+func (s FeeSchedule) Channel() valueobject.Channel { return s.channel }
+func (s FeeSchedule) EffectiveFrom() time.Time     { return s.effectiveFrom }
+func (s FeeSchedule) Rules() []FeeRule             { return s.rules }
+func (s FeeSchedule) Version() int                 { return s.version }
+func (s FeeSchedule) CreatedAt() time.Time         { return s.createdAt }
+func (s FeeSchedule) UpdatedAt() time.Time         { return s.updatedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (s FeeSchedule) DomainEvents() []events.DomainEvent {
+	return s.domainEvents
+}
+
+// ClearDomainEvents returns a copy with cleared domain events.
+func (s FeeSchedule) ClearDomainEvents() FeeSchedule {
+	s.domainEvents = nil
+	return s
+}
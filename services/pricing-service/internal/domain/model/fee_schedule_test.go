@@ -0,0 +1,135 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/model"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/valueobject"
+)
+
+var now = time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+func d(v string) decimal.Decimal { return decimal.RequireFromString(v) }
+
+func rule(t *testing.T, feeCode, fixed, rate, minimum, maximum string) model.FeeRule {
+	t.Helper()
+	r, err := model.NewFeeRule(feeCode, "EUR", d(fixed), d(rate), d(minimum), d(maximum))
+	require.NoError(t, err)
+	return r
+}
+
+func TestFeeRule_Apply(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   model.FeeRule
+		amount string
+		fee    string
+	}{
+		{"fixed", rule(t, "ACH", "0.25", "0", "0", "0"), "1000", "0.25"},
+		{"rate", rule(t, "CARD", "0", "0.015", "0", "0"), "200", "3"},
+		{"fixed plus rate", rule(t, "WIRE", "5", "0.001", "0", "0"), "10000", "15"},
+		{"minimum", rule(t, "FX", "0", "0.005", "2", "0"), "100", "2"},
+		{"maximum", rule(t, "FX", "0", "0.005", "2", "25"), "100000", "25"},
+		{"rounded to the cent", rule(t, "CARD", "0", "0.0125", "0", "0"), "10.10", "0.13"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, d(tt.fee).Equal(tt.rule.Apply(d(tt.amount))), tt.rule.Apply(d(tt.amount)).String())
+		})
+	}
+}
+
+func TestNewFeeRule_Validation(t *testing.T) {
+	_, err := model.NewFeeRule("ach", "EUR", d("1"), d("0"), d("0"), d("0"))
+	assert.Error(t, err, "lower-case fee code")
+	_, err = model.NewFeeRule("ACH", "eur", d("1"), d("0"), d("0"), d("0"))
+	assert.Error(t, err, "invalid currency")
+	_, err = model.NewFeeRule("ACH", "EUR", d("-1"), d("0"), d("0"), d("0"))
+	assert.Error(t, err, "negative fixed fee")
+	_, err = model.NewFeeRule("ACH", "EUR", d("0"), d("1.5"), d("0"), d("0"))
+	assert.Error(t, err, "rate above 1")
+	_, err = model.NewFeeRule("ACH", "EUR", d("0"), d("0.01"), d("5"), d("2"))
+	assert.Error(t, err, "maximum below minimum")
+}
+
+func TestNewFeeSchedule(t *testing.T) {
+	tenantID := uuid.New()
+	rules := []model.FeeRule{rule(t, "ACH", "0.25", "0", "0", "0")}
+
+	s, err := model.NewFeeSchedule(tenantID, valueobject.ProductPayments, valueobject.ChannelAny, time.Time{}, rules, now)
+	require.NoError(t, err)
+	assert.Equal(t, now, s.EffectiveFrom(), "a zero effective date is now")
+	assert.True(t, s.InEffect(now))
+	assert.Equal(t, 1, s.Version())
+	require.Len(t, s.DomainEvents(), 1)
+	assert.Equal(t, "pricing.schedule.created", s.DomainEvents()[0].EventType())
+
+	r, ok := s.Rule("ACH", "EUR")
+	require.True(t, ok)
+	assert.True(t, d("0.25").Equal(r.Fixed()))
+	_, ok = s.Rule("ACH", "USD")
+	assert.False(t, ok)
+
+	_, err = model.NewFeeSchedule(tenantID, valueobject.ProductPayments, valueobject.ChannelAny, now.Add(-time.Hour), rules, now)
+	assert.Error(t, err, "effective in the past")
+	_, err = model.NewFeeSchedule(tenantID, valueobject.ProductPayments, valueobject.ChannelAny, time.Time{}, nil, now)
+	assert.Error(t, err, "no rules")
+	_, err = model.NewFeeSchedule(tenantID, valueobject.ProductPayments, valueobject.ChannelAny, time.Time{}, append(rules, rules[0]), now)
+	assert.Error(t, err, "a fee priced twice")
+	_, err = model.NewFeeSchedule(tenantID, valueobject.Product{}, valueobject.ChannelAny, time.Time{}, rules, now)
+	assert.Error(t, err, "no product")
+}
+
+func TestFeeSchedule_Revise(t *testing.T) {
+	tenantID := uuid.New()
+	from := now.Add(24 * time.Hour)
+	s, err := model.NewFeeSchedule(tenantID, valueobject.ProductCards, valueobject.ChannelPOS, from,
+		[]model.FeeRule{rule(t, "CARD", "0", "0.015", "0", "0")}, now)
+	require.NoError(t, err)
+	assert.False(t, s.InEffect(now))
+	s = s.ClearDomainEvents()
+
+	revised, err := s.Revise([]model.FeeRule{rule(t, "CARD", "0", "0.012", "0", "0")}, time.Time{}, now)
+	require.NoError(t, err)
+	assert.Equal(t, from, revised.EffectiveFrom(), "a zero effective date keeps the schedule's")
+	assert.Equal(t, 2, revised.Version())
+	require.Len(t, revised.DomainEvents(), 1)
+	assert.Equal(t, "pricing.schedule.revised", revised.DomainEvents()[0].EventType())
+
+	_, err = s.Revise(s.Rules(), now.Add(-time.Minute), now)
+	assert.Error(t, err, "moved into the past")
+
+	_, err = s.Revise(s.Rules(), time.Time{}, from)
+	assert.ErrorIs(t, err, model.ErrScheduleInEffect)
+}
+
+func TestAssessFee(t *testing.T) {
+	tenantID := uuid.New()
+	s, err := model.NewFeeSchedule(tenantID, valueobject.ProductPayments, valueobject.ChannelAny, time.Time{},
+		[]model.FeeRule{rule(t, "WIRE", "5", "0.001", "0", "0")}, now)
+	require.NoError(t, err)
+
+	a, err := model.AssessFee(tenantID, "payment-1", valueobject.ProductPayments, valueobject.ChannelOnline, "WIRE", "EUR", d("10000"), &s, now)
+	require.NoError(t, err)
+	assert.True(t, d("15").Equal(a.Fee()))
+	require.NotNil(t, a.ScheduleID())
+	assert.Equal(t, s.ID(), *a.ScheduleID())
+	require.Len(t, a.DomainEvents(), 1)
+	assert.Equal(t, "pricing.fee.assessed", a.DomainEvents()[0].EventType())
+
+	free, err := model.AssessFee(tenantID, "payment-2", valueobject.ProductPayments, valueobject.ChannelOnline, "ACH", "EUR", d("100"), &s, now)
+	require.NoError(t, err)
+	assert.True(t, free.Fee().IsZero(), "no rule prices the fee")
+	assert.Nil(t, free.ScheduleID())
+
+	_, err = model.AssessFee(tenantID, "", valueobject.ProductPayments, valueobject.ChannelOnline, "WIRE", "EUR", d("100"), &s, now)
+	assert.Error(t, err, "no reference")
+	_, err = model.AssessFee(tenantID, "payment-3", valueobject.ProductPayments, valueobject.ChannelOnline, "WIRE", "EUR", d("-1"), &s, now)
+	assert.Error(t, err, "negative amount")
+}
@@ -0,0 +1,99 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/event"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/model"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/valueobject"
+)
+
+// ErrScheduleNotFound is returned when a fee schedule does not exist.
+var ErrScheduleNotFound = errors.New("fee schedule not found")
+
+// ErrScheduleExists is returned when a fee schedule is saved for a product
+// and channel that already has one taking effect at the same time.
+var ErrScheduleExists = errors.New("fee schedule already exists")
+
+// ErrAssessmentExists is returned when a fee assessment is saved with a
+// reference its tenant already used.
+var ErrAssessmentExists = errors.New("fee assessment already exists")
+
+// ErrAssessmentNotFound is returned when a fee assessment does not exist.
+var ErrAssessmentNotFound = errors.New("fee assessment not found")
+
+// ErrVersionConflict is returned when a fee schedule was modified
+// concurrently.
+var ErrVersionConflict = errors.New("version conflict")
+
+// ScheduleScope identifies the fee schedules of a tenant's product on a
+// channel.
+type ScheduleScope struct {
+	Product  valueobject.Product
+	Channel  valueobject.Channel
+	TenantID uuid.UUID
+}
+
+// ScheduleFilter narrows a listing of a tenant's fee schedules. Zero fields
+// do not filter.
+type ScheduleFilter struct {
+	Product  valueobject.Product
+	Channel  valueobject.Channel
+	TenantID uuid.UUID
+}
+
+// FeeScheduleRepository defines the persistence port for fee schedules.
+type FeeScheduleRepository interface {
+	// Save persists a fee schedule with optimistic locking on its version.
+	Save(ctx context.Context, schedule model.FeeSchedule) error
+
+	// FindByID retrieves a tenant's fee schedule.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.FeeSchedule, error)
+
+	// FindEffective retrieves the schedule of a scope in effect at a time,
+	// the one taking effect latest at or before it, returning
+	// ErrScheduleNotFound when there is none.
+	FindEffective(ctx context.Context, scope ScheduleScope, at time.Time) (model.FeeSchedule, error)
+
+	// List returns a page of the fee schedules matching the filter, by
+	// product and channel and then latest effective first, and the total
+	// number of them.
+	List(ctx context.Context, filter ScheduleFilter, limit, offset int) ([]model.FeeSchedule, int, error)
+}
+
+// AssessmentFilter narrows a listing of a tenant's fee assessments. Zero
+// fields do not filter.
+type AssessmentFilter struct {
+	// From and To bound when the fees were assessed, From inclusive and
+	// To exclusive.
+	From     time.Time
+	To       time.Time
+	Product  valueobject.Product
+	Channel  valueobject.Channel
+	TenantID uuid.UUID
+}
+
+// AssessmentRepository defines the persistence port for fee assessments.
+type AssessmentRepository interface {
+	// Save persists a fee assessment, returning ErrAssessmentExists when
+	// its tenant already used the reference.
+	Save(ctx context.Context, assessment model.FeeAssessment) error
+
+	// FindByReference retrieves the fee assessment a tenant recorded with
+	// a reference.
+	FindByReference(ctx context.Context, tenantID uuid.UUID, reference string) (model.FeeAssessment, error)
+
+	// List returns a page of the fee assessments matching the filter,
+	// oldest first.
+	List(ctx context.Context, filter AssessmentFilter, limit, offset int) ([]model.FeeAssessment, error)
+}
+
+// EventPublisher defines the port for publishing domain events.
+type EventPublisher interface {
+	// Publish sends domain events to the event bus.
+	Publish(ctx context.Context, events []event.DomainEvent) error
+}
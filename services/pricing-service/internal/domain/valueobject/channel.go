@@ -0,0 +1,72 @@
+package valueobject
+
+import "fmt"
+
+// Channel is the channel a transaction is made through.
+// It is an immutable value object.
+type Channel struct {
+	value string
+}
+
+const (
+	channelAny    = "ANY"
+	channelBranch = "BRANCH"
+	channelOnline = "ONLINE"
+	channelMobile = "MOBILE"
+	channelAPI    = "API"
+	channelATM    = "ATM"
+	channelPOS    = "POS"
+)
+
+var (
+	// ChannelAny is the channel of a schedule pricing every channel that
+	// has no schedule of its own.
+	ChannelAny = Channel{value: channelAny}
+	// ChannelBranch is a transaction made in a branch.
+	ChannelBranch = Channel{value: channelBranch}
+	// ChannelOnline is a transaction made in online banking.
+	ChannelOnline = Channel{value: channelOnline}
+	// ChannelMobile is a transaction made in the mobile app.
+	ChannelMobile = Channel{value: channelMobile}
+	// ChannelAPI is a transaction made through the API, such as by a
+	// third party provider.
+	ChannelAPI = Channel{value: channelAPI}
+	// ChannelATM is a transaction made at an ATM.
+	ChannelATM = Channel{value: channelATM}
+	// ChannelPOS is a transaction made at a merchant's point of sale.
+	ChannelPOS = Channel{value: channelPOS}
+)
+
+var validChannels = map[string]Channel{
+	channelAny:    ChannelAny,
+	channelBranch: ChannelBranch,
+	channelOnline: ChannelOnline,
+	channelMobile: ChannelMobile,
+	channelAPI:    ChannelAPI,
+	channelATM:    ChannelATM,
+	channelPOS:    ChannelPOS,
+}
+
+// NewChannel creates a Channel from a string, validating it is known.
+func NewChannel(s string) (Channel, error) {
+	v, ok := validChannels[s]
+	if !ok {
+		return Channel{}, fmt.Errorf("invalid channel: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the Channel.
+func (c Channel) String() string {
+	return c.value
+}
+
+// IsZero returns true if the Channel has not been set.
+func (c Channel) IsZero() bool {
+	return c.value == ""
+}
+
+// Equal returns true if two Channel values are equal.
+func (c Channel) Equal(other Channel) bool {
+	return c.value == other.value
+}
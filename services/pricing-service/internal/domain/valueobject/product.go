@@ -0,0 +1,58 @@
+package valueobject
+
+import "fmt"
+
+// Product is the line of business a fee schedule prices.
+// It is an immutable value object.
+type Product struct {
+	value string
+}
+
+const (
+	productPayments = "PAYMENTS"
+	productCards    = "CARDS"
+	productFX       = "FX"
+	productDeposits = "DEPOSITS"
+)
+
+var (
+	// ProductPayments prices payments and transfers.
+	ProductPayments = Product{value: productPayments}
+	// ProductCards prices card transactions and card servicing.
+	ProductCards = Product{value: productCards}
+	// ProductFX prices currency conversions.
+	ProductFX = Product{value: productFX}
+	// ProductDeposits prices deposit account servicing.
+	ProductDeposits = Product{value: productDeposits}
+)
+
+var validProducts = map[string]Product{
+	productPayments: ProductPayments,
+	productCards:    ProductCards,
+	productFX:       ProductFX,
+	productDeposits: ProductDeposits,
+}
+
+// NewProduct creates a Product from a string, validating it is known.
+func NewProduct(s string) (Product, error) {
+	v, ok := validProducts[s]
+	if !ok {
+		return Product{}, fmt.Errorf("invalid product: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the Product.
+func (p Product) String() string {
+	return p.value
+}
+
+// IsZero returns true if the Product has not been set.
+func (p Product) IsZero() bool {
+	return p.value == ""
+}
+
+// Equal returns true if two Product values are equal.
+func (p Product) Equal(other Product) bool {
+	return p.value == other.value
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/model"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/port"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/valueobject"
+)
+
+const assessmentColumns = `
+	id, tenant_id, reference, product, channel, fee_code, currency, amount, fee, schedule_id, assessed_at`
+
+// FeeAssessmentRepo is the PostgreSQL implementation of
+// AssessmentRepository.
+type FeeAssessmentRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewFeeAssessmentRepo creates a new FeeAssessmentRepo.
+func NewFeeAssessmentRepo(pool *pgxpool.Pool) *FeeAssessmentRepo {
+	return &FeeAssessmentRepo{pool: pool}
+}
+
+// Save inserts a fee assessment.
+func (r *FeeAssessmentRepo) Save(ctx context.Context, a model.FeeAssessment) error {
+	query := `INSERT INTO fee_assessments (` + assessmentColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+	_, err := r.pool.Exec(ctx, query,
+		a.ID(), a.TenantID(), a.Reference(), a.Product().String(), a.Channel().String(), a.FeeCode(),
+		a.Currency(), a.Amount(), a.Fee(), a.ScheduleID(), a.AssessedAt(),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: reference %s", port.ErrAssessmentExists, a.Reference())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert fee assessment: %w", err)
+	}
+	return nil
+}
+
+// FindByReference retrieves the fee assessment a tenant recorded with a
+// reference.
+func (r *FeeAssessmentRepo) FindByReference(ctx context.Context, tenantID uuid.UUID, reference string) (model.FeeAssessment, error) {
+	query := `SELECT ` + assessmentColumns + ` FROM fee_assessments WHERE tenant_id = $1 AND reference = $2`
+	a, err := scanAssessment(r.pool.QueryRow(ctx, query, tenantID, reference))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.FeeAssessment{}, port.ErrAssessmentNotFound
+	}
+	if err != nil {
+		return model.FeeAssessment{}, fmt.Errorf("failed to scan fee assessment: %w", err)
+	}
+	return a, nil
+}
+
+// List returns a page of the fee assessments matching the filter, oldest
+// first.
+func (r *FeeAssessmentRepo) List(ctx context.Context, filter port.AssessmentFilter, limit, offset int) ([]model.FeeAssessment, error) {
+	query := `SELECT ` + assessmentColumns + ` FROM fee_assessments
+		WHERE tenant_id = $1
+			AND ($2 = '' OR product = $2)
+			AND ($3 = '' OR channel = $3)
+			AND ($4::timestamptz IS NULL OR assessed_at >= $4)
+			AND ($5::timestamptz IS NULL OR assessed_at < $5)
+		ORDER BY assessed_at, id
+		LIMIT $6 OFFSET $7`
+	rows, err := r.pool.Query(ctx, query,
+		filter.TenantID, filter.Product.String(), filter.Channel.String(),
+		optionalTime(filter.From), optionalTime(filter.To), limit, offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query fee assessments: %w", err)
+	}
+	defer rows.Close()
+
+	var assessments []model.FeeAssessment
+	for rows.Next() {
+		a, err := scanAssessment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan fee assessment row: %w", err)
+		}
+		assessments = append(assessments, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return assessments, nil
+}
+
+// optionalTime returns nil for a zero time, so the query does not bound by
+// it.
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func scanAssessment(row pgx.Row) (model.FeeAssessment, error) {
+	var (
+		id, tenantID                                         uuid.UUID
+		scheduleID                                           *uuid.UUID
+		reference, productStr, channelStr, feeCode, currency string
+		amount, fee                                          decimal.Decimal
+		assessedAt                                           time.Time
+	)
+
+	err := row.Scan(&id, &tenantID, &reference, &productStr, &channelStr, &feeCode, &currency,
+		&amount, &fee, &scheduleID, &assessedAt)
+	if err != nil {
+		return model.FeeAssessment{}, err
+	}
+
+	product, err := valueobject.NewProduct(productStr)
+	if err != nil {
+		return model.FeeAssessment{}, fmt.Errorf("invalid product in database: %w", err)
+	}
+	channel, err := valueobject.NewChannel(channelStr)
+	if err != nil {
+		return model.FeeAssessment{}, fmt.Errorf("invalid channel in database: %w", err)
+	}
+
+	return model.ReconstructFeeAssessment(
+		id, tenantID, reference, product, channel, feeCode, currency, amount, fee, scheduleID, assessedAt,
+	), nil
+}
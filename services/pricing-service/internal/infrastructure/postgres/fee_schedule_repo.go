@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/model"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/port"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/valueobject"
+)
+
+// uniqueViolation is the PostgreSQL error code for a unique constraint
+// violation.
+const uniqueViolation = "23505"
+
+const scheduleColumns = `
+	id, tenant_id, product, channel, effective_from, rules, version, created_at, updated_at`
+
+// ruleRecord is a fee rule as stored in a schedule's rules column.
+type ruleRecord struct {
+	FeeCode  string          `json:"fee_code"`
+	Currency string          `json:"currency"`
+	Fixed    decimal.Decimal `json:"fixed"`
+	Rate     decimal.Decimal `json:"rate"`
+	Minimum  decimal.Decimal `json:"minimum"`
+	Maximum  decimal.Decimal `json:"maximum"`
+}
+
+// FeeScheduleRepo is the PostgreSQL implementation of
+// FeeScheduleRepository.
+type FeeScheduleRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewFeeScheduleRepo creates a new FeeScheduleRepo.
+func NewFeeScheduleRepo(pool *pgxpool.Pool) *FeeScheduleRepo {
+	return &FeeScheduleRepo{pool: pool}
+}
+
+// Save upserts a fee schedule with optimistic locking on its version.
+func (r *FeeScheduleRepo) Save(ctx context.Context, s model.FeeSchedule) error {
+	records := make([]ruleRecord, len(s.Rules()))
+	for i, rule := range s.Rules() {
+		records[i] = ruleRecord{
+			FeeCode:  rule.FeeCode(),
+			Currency: rule.Currency(),
+			Fixed:    rule.Fixed(),
+			Rate:     rule.Rate(),
+			Minimum:  rule.Minimum(),
+			Maximum:  rule.Maximum(),
+		}
+	}
+	rules, err := json.Marshal(records)
+	if err != nil {
+		return fmt.Errorf("failed to marshal fee rules: %w", err)
+	}
+
+	query := `
+		INSERT INTO fee_schedules (` + scheduleColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (id) DO UPDATE SET
+			effective_from = EXCLUDED.effective_from,
+			rules = EXCLUDED.rules,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE fee_schedules.version = EXCLUDED.version - 1
+	`
+	result, err := r.pool.Exec(ctx, query,
+		s.ID(), s.TenantID(), s.Product().String(), s.Channel().String(), s.EffectiveFrom(), rules,
+		s.Version(), s.CreatedAt(), s.UpdatedAt(),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: %s %s from %s", port.ErrScheduleExists, s.Product(), s.Channel(), s.EffectiveFrom().Format(time.RFC3339))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to upsert fee schedule: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: fee schedule %s", port.ErrVersionConflict, s.ID())
+	}
+	return nil
+}
+
+// FindByID retrieves a tenant's fee schedule.
+func (r *FeeScheduleRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.FeeSchedule, error) {
+	return r.findOne(ctx, `SELECT `+scheduleColumns+` FROM fee_schedules WHERE id = $1 AND tenant_id = $2`, id, tenantID)
+}
+
+// FindEffective retrieves the schedule of a scope in effect at a time.
+func (r *FeeScheduleRepo) FindEffective(ctx context.Context, scope port.ScheduleScope, at time.Time) (model.FeeSchedule, error) {
+	query := `SELECT ` + scheduleColumns + ` FROM fee_schedules
+		WHERE tenant_id = $1 AND product = $2 AND channel = $3 AND effective_from <= $4
+		ORDER BY effective_from DESC
+		LIMIT 1`
+	return r.findOne(ctx, query, scope.TenantID, scope.Product.String(), scope.Channel.String(), at)
+}
+
+// List returns a page of the fee schedules matching the filter, by product
+// and channel and then latest effective first, and the total number of
+// them.
+func (r *FeeScheduleRepo) List(ctx context.Context, filter port.ScheduleFilter, limit, offset int) ([]model.FeeSchedule, int, error) {
+	where := `WHERE tenant_id = $1
+		AND ($2 = '' OR product = $2)
+		AND ($3 = '' OR channel = $3)`
+	args := []any{filter.TenantID, filter.Product.String(), filter.Channel.String()}
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM fee_schedules `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count fee schedules: %w", err)
+	}
+	rows, err := r.pool.Query(ctx, `SELECT `+scheduleColumns+` FROM fee_schedules `+where+`
+		ORDER BY product, channel, effective_from DESC, id
+		LIMIT $4 OFFSET $5
+	`, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query fee schedules: %w", err)
+	}
+	defer rows.Close()
+
+	var schedules []model.FeeSchedule
+	for rows.Next() {
+		s, err := scanSchedule(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan fee schedule row: %w", err)
+		}
+		schedules = append(schedules, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("row iteration error: %w", err)
+	}
+	return schedules, total, nil
+}
+
+func (r *FeeScheduleRepo) findOne(ctx context.Context, query string, args ...any) (model.FeeSchedule, error) {
+	s, err := scanSchedule(r.pool.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.FeeSchedule{}, port.ErrScheduleNotFound
+	}
+	if err != nil {
+		return model.FeeSchedule{}, fmt.Errorf("failed to scan fee schedule: %w", err)
+	}
+	return s, nil
+}
+
+func scanSchedule(row pgx.Row) (model.FeeSchedule, error) {
+	var (
+		id, tenantID                        uuid.UUID
+		productStr, channelStr              string
+		effectiveFrom, createdAt, updatedAt time.Time
+		rulesJSON                           []byte
+		version                             int
+	)
+
+	err := row.Scan(&id, &tenantID, &productStr, &channelStr, &effectiveFrom, &rulesJSON, &version, &createdAt, &updatedAt)
+	if err != nil {
+		return model.FeeSchedule{}, err
+	}
+
+	product, err := valueobject.NewProduct(productStr)
+	if err != nil {
+		return model.FeeSchedule{}, fmt.Errorf("invalid product in database: %w", err)
+	}
+	channel, err := valueobject.NewChannel(channelStr)
+	if err != nil {
+		return model.FeeSchedule{}, fmt.Errorf("invalid channel in database: %w", err)
+	}
+	var records []ruleRecord
+	if err := json.Unmarshal(rulesJSON, &records); err != nil {
+		return model.FeeSchedule{}, fmt.Errorf("invalid fee rules in database: %w", err)
+	}
+	rules := make([]model.FeeRule, len(records))
+	for i, rec := range records {
+		rules[i] = model.ReconstructFeeRule(rec.FeeCode, rec.Currency, rec.Fixed, rec.Rate, rec.Minimum, rec.Maximum)
+	}
+
+	return model.ReconstructFeeSchedule(id, tenantID, product, channel, effectiveFrom, rules, version, createdAt, updatedAt), nil
+}
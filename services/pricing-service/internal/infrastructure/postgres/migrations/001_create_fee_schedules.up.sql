@@ -0,0 +1,16 @@
+-- A tenant's pricing of a product on a channel, in effect from
+-- effective_from until a later schedule of the same product and channel
+-- takes effect. A schedule for channel ANY prices every channel without one
+-- of its own. Rules are stored as a JSON array of fee rules.
+CREATE TABLE IF NOT EXISTS fee_schedules (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    product VARCHAR(20) NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    effective_from TIMESTAMPTZ NOT NULL,
+    rules JSONB NOT NULL,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, product, channel, effective_from)
+);
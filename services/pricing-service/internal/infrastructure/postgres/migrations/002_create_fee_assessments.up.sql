@@ -0,0 +1,19 @@
+-- Fees charged on transactions, recorded once per caller reference. The
+-- schedule_id is NULL when no schedule priced the transaction.
+CREATE TABLE IF NOT EXISTS fee_assessments (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    reference VARCHAR(128) NOT NULL,
+    product VARCHAR(20) NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    fee_code VARCHAR(64) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    amount NUMERIC(20, 4) NOT NULL,
+    fee NUMERIC(20, 4) NOT NULL,
+    schedule_id UUID REFERENCES fee_schedules (id),
+    assessed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, reference)
+);
+
+-- Fee change simulations read a product's fees over a period.
+CREATE INDEX idx_fee_assessments_volume ON fee_assessments (tenant_id, product, assessed_at);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS outbox (
+    id              UUID PRIMARY KEY,
+    aggregate_id    TEXT         NOT NULL,
+    aggregate_type  VARCHAR(100) NOT NULL,
+    event_type      VARCHAR(100) NOT NULL,
+    topic           VARCHAR(255) NOT NULL DEFAULT '',
+    payload         JSONB        NOT NULL,
+    created_at      TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    published_at    TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_unpublished ON outbox (created_at) WHERE published_at IS NULL;
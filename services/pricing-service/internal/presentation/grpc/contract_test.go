@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	grpclib "google.golang.org/grpc"
+
+	pricingv1 "github.com/bibbank/bib/api/gen/go/bib/pricing/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/pricing-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/event"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/model"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/port"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractScheduleRepo holds at most one fee schedule.
+type contractScheduleRepo struct {
+	schedule *model.FeeSchedule
+}
+
+func (r *contractScheduleRepo) Save(context.Context, model.FeeSchedule) error { return nil }
+
+func (r *contractScheduleRepo) FindByID(context.Context, uuid.UUID, uuid.UUID) (model.FeeSchedule, error) {
+	if r.schedule == nil {
+		return model.FeeSchedule{}, port.ErrScheduleNotFound
+	}
+	return *r.schedule, nil
+}
+
+func (r *contractScheduleRepo) FindEffective(context.Context, port.ScheduleScope, time.Time) (model.FeeSchedule, error) {
+	return model.FeeSchedule{}, port.ErrScheduleNotFound
+}
+
+func (r *contractScheduleRepo) List(context.Context, port.ScheduleFilter, int, int) ([]model.FeeSchedule, int, error) {
+	return nil, 0, nil
+}
+
+type discardPublisher struct{}
+
+func (discardPublisher) Publish(context.Context, []event.DomainEvent) error { return nil }
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	schedules := &contractScheduleRepo{}
+	h := NewHandler(
+		usecase.NewCreateFeeScheduleUseCase(schedules, discardPublisher{}), nil,
+		usecase.NewGetFeeScheduleUseCase(schedules), nil, nil, nil,
+		slog.Default(),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { pricingv1.RegisterPricingServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-pricing-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { schedules.schedule = nil },
+		"an online payments fee schedule is in effect": func(t *testing.T) {
+			product, err := valueobject.NewProduct("PAYMENTS")
+			if err != nil {
+				t.Fatal(err)
+			}
+			channel, err := valueobject.NewChannel("ONLINE")
+			if err != nil {
+				t.Fatal(err)
+			}
+			rule, err := model.NewFeeRule("FASTER_PAYMENT", "GBP", decimal.RequireFromString("0.20"),
+				decimal.RequireFromString("0.001"), decimal.RequireFromString("0.20"), decimal.NewFromInt(5))
+			if err != nil {
+				t.Fatal(err)
+			}
+			now := time.Now().UTC()
+			schedule, err := model.NewFeeSchedule(contractTenantID, product, channel, now, []model.FeeRule{rule}, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			schedules.schedule = &schedule
+		},
+	})
+}
@@ -0,0 +1,457 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pricingv1 "github.com/bibbank/bib/api/gen/go/bib/pricing/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/pricing-service/internal/application/dto"
+	"github.com/bibbank/bib/services/pricing-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/model"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/port"
+)
+
+// staffRoles may read a tenant's fee schedules and simulate fee changes;
+// scheduleRoles may create and revise schedules; evaluateRoles, which
+// include the services pricing their transactions, may evaluate fees.
+var (
+	staffRoles    = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleAPIClient}
+	scheduleRoles = []string{auth.RoleAdmin, auth.RoleOperator}
+	evaluateRoles = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient}
+)
+
+// requireRole checks that the caller has at least one of the given roles.
+func requireRole(ctx context.Context, roles ...string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "insufficient permissions")
+}
+
+// tenantIDFromContext extracts the tenant ID from JWT claims in the context.
+func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return claims.TenantID, nil
+}
+
+// optionalUUID parses an optional ID, returning nil when it is empty.
+func optionalUUID(raw, field string) (*uuid.UUID, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s", field)
+	}
+	return &id, nil
+}
+
+// parseAmount parses a decimal amount, an empty one being zero.
+func parseAmount(raw, field string) (decimal.Decimal, error) {
+	if raw == "" {
+		return decimal.Zero, nil
+	}
+	amount, err := decimal.NewFromString(raw)
+	if err != nil {
+		return decimal.Decimal{}, status.Errorf(codes.InvalidArgument, "invalid %s", field)
+	}
+	return amount, nil
+}
+
+// optionalTime converts an optional timestamp, returning the zero time when
+// it is unset.
+func optionalTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// enumName strips an enum value's prefix, returning "" for the unspecified
+// value.
+func enumName(name, prefix string) string {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "UNSPECIFIED" {
+		return ""
+	}
+	return name
+}
+
+// uuidString formats an optional ID, returning "" when it is nil.
+func uuidString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+// parseRules converts fee rules from their messages.
+func parseRules(msgs []*pricingv1.FeeRule) ([]dto.FeeRule, error) {
+	rules := make([]dto.FeeRule, 0, len(msgs))
+	for _, m := range msgs {
+		if m == nil {
+			return nil, status.Error(codes.InvalidArgument, "fee rule is required")
+		}
+		var (
+			r   = dto.FeeRule{FeeCode: m.FeeCode, Currency: m.Currency}
+			err error
+		)
+		if r.Fixed, err = parseAmount(m.Fixed, "fixed fee"); err != nil {
+			return nil, err
+		}
+		if r.Rate, err = parseAmount(m.Rate, "rate"); err != nil {
+			return nil, err
+		}
+		if r.Minimum, err = parseAmount(m.Minimum, "minimum fee"); err != nil {
+			return nil, err
+		}
+		if r.Maximum, err = parseAmount(m.Maximum, "maximum fee"); err != nil {
+			return nil, err
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+// Compile-time assertion that Handler implements PricingServiceServer.
+var _ pricingv1.PricingServiceServer = (*Handler)(nil)
+
+// Handler implements the PricingServiceServer gRPC interface.
+type Handler struct {
+	pricingv1.UnimplementedPricingServiceServer
+	createSchedule *usecase.CreateFeeScheduleUseCase
+	reviseSchedule *usecase.ReviseFeeScheduleUseCase
+	getSchedule    *usecase.GetFeeScheduleUseCase
+	listSchedules  *usecase.ListFeeSchedulesUseCase
+	evaluateFee    *usecase.EvaluateFeeUseCase
+	simulateChange *usecase.SimulateFeeChangeUseCase
+	logger         *slog.Logger
+}
+
+// NewHandler creates a new gRPC Handler.
+func NewHandler(
+	createSchedule *usecase.CreateFeeScheduleUseCase,
+	reviseSchedule *usecase.ReviseFeeScheduleUseCase,
+	getSchedule *usecase.GetFeeScheduleUseCase,
+	listSchedules *usecase.ListFeeSchedulesUseCase,
+	evaluateFee *usecase.EvaluateFeeUseCase,
+	simulateChange *usecase.SimulateFeeChangeUseCase,
+	logger *slog.Logger,
+) *Handler {
+	return &Handler{
+		createSchedule: createSchedule,
+		reviseSchedule: reviseSchedule,
+		getSchedule:    getSchedule,
+		listSchedules:  listSchedules,
+		evaluateFee:    evaluateFee,
+		simulateChange: simulateChange,
+		logger:         logger,
+	}
+}
+
+// CreateFeeSchedule creates a fee schedule for a product on a channel.
+func (h *Handler) CreateFeeSchedule(ctx context.Context, req *pricingv1.CreateFeeScheduleRequest) (*pricingv1.CreateFeeScheduleResponse, error) {
+	if err := requireRole(ctx, scheduleRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	rules, err := parseRules(req.Rules)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.createSchedule.Execute(ctx, dto.CreateFeeScheduleRequest{
+		TenantID:      tenantID,
+		Product:       enumName(req.Product.String(), "PRODUCT_"),
+		Channel:       enumName(req.Channel.String(), "CHANNEL_"),
+		EffectiveFrom: optionalTime(req.EffectiveFrom),
+		Rules:         rules,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &pricingv1.CreateFeeScheduleResponse{Schedule: toScheduleMsg(resp)}, nil
+}
+
+// ReviseFeeSchedule replaces a fee schedule's rules and effective date
+// before it takes effect.
+func (h *Handler) ReviseFeeSchedule(ctx context.Context, req *pricingv1.ReviseFeeScheduleRequest) (*pricingv1.ReviseFeeScheduleResponse, error) {
+	if err := requireRole(ctx, scheduleRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	scheduleID, err := uuid.Parse(req.ScheduleId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid schedule ID")
+	}
+	rules, err := parseRules(req.Rules)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.reviseSchedule.Execute(ctx, dto.ReviseFeeScheduleRequest{
+		TenantID:      tenantID,
+		ScheduleID:    scheduleID,
+		EffectiveFrom: optionalTime(req.EffectiveFrom),
+		Rules:         rules,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &pricingv1.ReviseFeeScheduleResponse{Schedule: toScheduleMsg(resp)}, nil
+}
+
+// GetFeeSchedule retrieves a fee schedule.
+func (h *Handler) GetFeeSchedule(ctx context.Context, req *pricingv1.GetFeeScheduleRequest) (*pricingv1.GetFeeScheduleResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	scheduleID, err := uuid.Parse(req.ScheduleId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid schedule ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.getSchedule.Execute(ctx, tenantID, scheduleID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &pricingv1.GetFeeScheduleResponse{Schedule: toScheduleMsg(resp)}, nil
+}
+
+// ListFeeSchedules lists the tenant's fee schedules, latest effective
+// first within a product and channel.
+func (h *Handler) ListFeeSchedules(ctx context.Context, req *pricingv1.ListFeeSchedulesRequest) (*pricingv1.ListFeeSchedulesResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listSchedules.Execute(ctx, dto.ListFeeSchedulesRequest{
+		TenantID: tenantID,
+		Product:  enumName(req.Product.String(), "PRODUCT_"),
+		Channel:  enumName(req.Channel.String(), "CHANNEL_"),
+		Limit:    int(req.PageSize),
+		Offset:   int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &pricingv1.ListFeeSchedulesResponse{
+		Schedules:  make([]*pricingv1.FeeSchedule, 0, len(resp.Schedules)),
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, s := range resp.Schedules {
+		out.Schedules = append(out.Schedules, toScheduleMsg(s))
+	}
+	return out, nil
+}
+
+// EvaluateFee prices a transaction under the schedule in effect and, when
+// the caller gives its reference, records the fee as charged.
+func (h *Handler) EvaluateFee(ctx context.Context, req *pricingv1.EvaluateFeeRequest) (*pricingv1.EvaluateFeeResponse, error) {
+	if err := requireRole(ctx, evaluateRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	amount, err := parseAmount(req.Amount, "amount")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.evaluateFee.Execute(ctx, dto.EvaluateFeeRequest{
+		TenantID:  tenantID,
+		Product:   enumName(req.Product.String(), "PRODUCT_"),
+		Channel:   enumName(req.Channel.String(), "CHANNEL_"),
+		FeeCode:   req.FeeCode,
+		Currency:  req.Currency,
+		Amount:    amount,
+		Reference: req.Reference,
+		At:        optionalTime(req.At),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &pricingv1.EvaluateFeeResponse{
+		Fee:        resp.Fee.String(),
+		Currency:   resp.Currency,
+		Priced:     resp.Priced,
+		ScheduleId: uuidString(resp.ScheduleID),
+	}
+	if resp.Rule != nil {
+		out.Rule = toRuleMsg(*resp.Rule)
+	}
+	if resp.Assessment != nil {
+		out.Assessment = toAssessmentMsg(*resp.Assessment)
+	}
+	return out, nil
+}
+
+// SimulateFeeChange reprices the fees recorded over a period under a
+// proposed schedule.
+func (h *Handler) SimulateFeeChange(ctx context.Context, req *pricingv1.SimulateFeeChangeRequest) (*pricingv1.SimulateFeeChangeResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	scheduleID, err := optionalUUID(req.ScheduleId, "schedule ID")
+	if err != nil {
+		return nil, err
+	}
+	rules, err := parseRules(req.Rules)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.simulateChange.Execute(ctx, dto.SimulateFeeChangeRequest{
+		TenantID:   tenantID,
+		ScheduleID: scheduleID,
+		Product:    enumName(req.Product.String(), "PRODUCT_"),
+		Channel:    enumName(req.Channel.String(), "CHANNEL_"),
+		Rules:      rules,
+		From:       optionalTime(req.From),
+		To:         optionalTime(req.To),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &pricingv1.SimulateFeeChangeResponse{
+		From:    timestamppb.New(resp.From),
+		To:      timestamppb.New(resp.To),
+		Impacts: make([]*pricingv1.FeeImpact, 0, len(resp.Impacts)),
+	}
+	for _, i := range resp.Impacts {
+		out.Impacts = append(out.Impacts, &pricingv1.FeeImpact{
+			FeeCode:      i.FeeCode,
+			Currency:     i.Currency,
+			Count:        int32(i.Count), //nolint:gosec // bounded by row count
+			Volume:       i.Volume.String(),
+			CurrentFees:  i.CurrentFees.String(),
+			ProposedFees: i.ProposedFees.String(),
+			Difference:   i.Difference.String(),
+		})
+	}
+	return out, nil
+}
+
+func (h *Handler) toStatus(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidSchedule), errors.Is(err, usecase.ErrInvalidFee),
+		errors.Is(err, usecase.ErrInvalidSimulation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrScheduleInEffect):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, port.ErrScheduleExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, port.ErrScheduleNotFound):
+		return status.Error(codes.NotFound, "fee schedule not found")
+	case errors.Is(err, port.ErrVersionConflict):
+		return status.Error(codes.Aborted, "modified concurrently, retry")
+	default:
+		h.logger.Error("handler error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func toRuleMsg(r dto.FeeRule) *pricingv1.FeeRule {
+	return &pricingv1.FeeRule{
+		FeeCode:  r.FeeCode,
+		Currency: r.Currency,
+		Fixed:    r.Fixed.String(),
+		Rate:     r.Rate.String(),
+		Minimum:  r.Minimum.String(),
+		Maximum:  r.Maximum.String(),
+	}
+}
+
+func toScheduleMsg(s dto.FeeScheduleResponse) *pricingv1.FeeSchedule {
+	rules := make([]*pricingv1.FeeRule, 0, len(s.Rules))
+	for _, r := range s.Rules {
+		rules = append(rules, toRuleMsg(r))
+	}
+	return &pricingv1.FeeSchedule{
+		ScheduleId:    s.ID.String(),
+		TenantId:      s.TenantID.String(),
+		Product:       pricingv1.Product(pricingv1.Product_value["PRODUCT_"+s.Product]),
+		Channel:       pricingv1.Channel(pricingv1.Channel_value["CHANNEL_"+s.Channel]),
+		EffectiveFrom: timestamppb.New(s.EffectiveFrom),
+		Rules:         rules,
+		Version:       int32(s.Version), //nolint:gosec // version counter
+		CreatedAt:     timestamppb.New(s.CreatedAt),
+		UpdatedAt:     timestamppb.New(s.UpdatedAt),
+	}
+}
+
+func toAssessmentMsg(a dto.FeeAssessmentResponse) *pricingv1.FeeAssessment {
+	return &pricingv1.FeeAssessment{
+		AssessmentId: a.ID.String(),
+		TenantId:     a.TenantID.String(),
+		Reference:    a.Reference,
+		Product:      pricingv1.Product(pricingv1.Product_value["PRODUCT_"+a.Product]),
+		Channel:      pricingv1.Channel(pricingv1.Channel_value["CHANNEL_"+a.Channel]),
+		FeeCode:      a.FeeCode,
+		Currency:     a.Currency,
+		Amount:       a.Amount.String(),
+		Fee:          a.Fee.String(),
+		ScheduleId:   uuidString(a.ScheduleID),
+		AssessedAt:   timestamppb.New(a.AssessedAt),
+	}
+}
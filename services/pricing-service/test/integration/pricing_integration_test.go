@@ -0,0 +1,151 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/outbox"
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/pricing-service/internal/application/dto"
+	"github.com/bibbank/bib/services/pricing-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/pricing-service/internal/domain/port"
+	"github.com/bibbank/bib/services/pricing-service/internal/infrastructure/postgres"
+)
+
+const topic = "pricing-events"
+
+// suite wires the use cases over the real repositories and outbox.
+type suite struct {
+	env         *testutil.Env
+	schedules   *postgres.FeeScheduleRepo
+	assessments *postgres.FeeAssessmentRepo
+	publisher   *postgres.OutboxPublisher
+	tenantID    uuid.UUID
+}
+
+func setup(t *testing.T, withKafka bool) *suite {
+	t.Helper()
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir(), Kafka: withKafka})
+	return &suite{
+		env:         env,
+		schedules:   postgres.NewFeeScheduleRepo(env.Pool()),
+		assessments: postgres.NewFeeAssessmentRepo(env.Pool()),
+		publisher:   postgres.NewOutboxPublisher(outbox.NewPublisher(outbox.NewStore(env.Pool())), topic),
+		tenantID:    uuid.New(),
+	}
+}
+
+func (s *suite) createSchedule(t *testing.T, effectiveFrom time.Time, fixed string) dto.FeeScheduleResponse {
+	t.Helper()
+	resp, err := usecase.NewCreateFeeScheduleUseCase(s.schedules, s.publisher).Execute(context.Background(), dto.CreateFeeScheduleRequest{
+		TenantID:      s.tenantID,
+		Product:       "PAYMENTS",
+		Channel:       "ANY",
+		EffectiveFrom: effectiveFrom,
+		Rules:         []dto.FeeRule{{FeeCode: "ACH", Currency: "USD", Fixed: decimal.RequireFromString(fixed)}},
+	})
+	require.NoError(t, err)
+	return resp
+}
+
+func (s *suite) evaluate(reference string, at time.Time) (dto.EvaluateFeeResponse, error) {
+	uc := usecase.NewEvaluateFeeUseCase(s.schedules, s.assessments, s.publisher)
+	return uc.Execute(context.Background(), dto.EvaluateFeeRequest{
+		TenantID:  s.tenantID,
+		Product:   "PAYMENTS",
+		Channel:   "ONLINE",
+		FeeCode:   "ACH",
+		Currency:  "USD",
+		Amount:    decimal.NewFromInt(100),
+		Reference: reference,
+		At:        at,
+	})
+}
+
+func TestFeeScheduleRepo_EffectiveDating(t *testing.T) {
+	s := setup(t, false)
+	current := s.createSchedule(t, time.Time{}, "0.25")
+	nextFrom := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Microsecond)
+	next := s.createSchedule(t, nextFrom, "0.30")
+
+	resp, err := s.evaluate("", time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, current.ID, *resp.ScheduleID)
+
+	resp, err = s.evaluate("", nextFrom)
+	require.NoError(t, err)
+	assert.Equal(t, next.ID, *resp.ScheduleID)
+	assert.True(t, decimal.RequireFromString("0.30").Equal(resp.Fee))
+
+	_, err = usecase.NewCreateFeeScheduleUseCase(s.schedules, s.publisher).Execute(context.Background(), dto.CreateFeeScheduleRequest{
+		TenantID:      s.tenantID,
+		Product:       "PAYMENTS",
+		Channel:       "ANY",
+		EffectiveFrom: nextFrom,
+		Rules:         []dto.FeeRule{{FeeCode: "ACH", Currency: "USD"}},
+	})
+	assert.ErrorIs(t, err, port.ErrScheduleExists)
+}
+
+func TestEvaluateFee_RecordsOnceAndSimulates(t *testing.T) {
+	s := setup(t, false)
+	s.createSchedule(t, time.Time{}, "0.25")
+
+	first, err := s.evaluate("payment-1", time.Time{})
+	require.NoError(t, err)
+	again, err := s.evaluate("payment-1", time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, first.Assessment.ID, again.Assessment.ID, "a repeated reference returns the fee first charged")
+	_, err = s.evaluate("payment-2", time.Time{})
+	require.NoError(t, err)
+
+	resp, err := usecase.NewSimulateFeeChangeUseCase(s.schedules, s.assessments, time.Hour).Execute(context.Background(), dto.SimulateFeeChangeRequest{
+		TenantID: s.tenantID,
+		Product:  "PAYMENTS",
+		Channel:  "ANY",
+		Rules:    []dto.FeeRule{{FeeCode: "ACH", Currency: "USD", Fixed: decimal.RequireFromString("0.40")}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Impacts, 1)
+	assert.Equal(t, 2, resp.Impacts[0].Count)
+	assert.True(t, decimal.RequireFromString("0.30").Equal(resp.Impacts[0].Difference))
+}
+
+func TestOutbox_RelaysEventsToKafka(t *testing.T) {
+	s := setup(t, true)
+	ctx := context.Background()
+	s.createSchedule(t, time.Time{}, "0.25")
+	_, err := s.evaluate("payment-1", time.Time{})
+	require.NoError(t, err)
+
+	producer := kafka.NewProducer(kafka.Config{Brokers: s.env.Brokers()})
+	t.Cleanup(func() { _ = producer.Close() })
+	relay, err := outbox.NewRelay(outbox.NewStore(s.env.Pool()), producer,
+		outbox.RelayConfig{Route: outbox.StaticRoute(topic)}, slog.Default())
+	require.NoError(t, err)
+
+	published, err := relay.Drain(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, published)
+
+	messages := s.env.Kafka.ReadMessages(t, topic, published, 30*time.Second)
+	var types []string
+	for _, msg := range messages {
+		for _, h := range msg.Headers {
+			if h.Key == "event_type" {
+				types = append(types, string(h.Value))
+			}
+		}
+	}
+	assert.Equal(t, []string{"pricing.schedule.created", "pricing.fee.assessed"}, types)
+}
@@ -0,0 +1,99 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/erasure"
+)
+
+// RequestErasureRequest is the input for erasing a data subject.
+type RequestErasureRequest struct {
+	Email       string
+	Reference   string
+	RequestedBy string
+	CustomerID  uuid.UUID
+	TenantID    uuid.UUID
+}
+
+// ListErasureJobsRequest is the input for listing a tenant's erasure jobs.
+type ListErasureJobsRequest struct {
+	Kind     string
+	Status   string
+	Limit    int
+	Offset   int
+	TenantID uuid.UUID
+}
+
+// ErasureTaskResponse is one service's part in an erasure job.
+type ErasureTaskResponse struct {
+	CompletedAt *time.Time
+	Service     string
+	Status      string
+	Error       string
+	Retained    []erasure.Retained
+	Erased      int
+	Attempts    int
+}
+
+// ErasureJobResponse is an erasure job. It carries the subject's email
+// digest, never the address.
+type ErasureJobResponse struct {
+	Cutoff             time.Time
+	Deadline           time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	CompletedAt        *time.Time
+	Kind               string
+	Status             string
+	SubjectEmailSHA256 string
+	Reference          string
+	Category           string
+	RequestedBy        string
+	Tasks              []ErasureTaskResponse
+	Version            int
+	ID                 uuid.UUID
+	TenantID           uuid.UUID
+	CustomerID         uuid.UUID
+	CertificateIssued  bool
+}
+
+// ListErasureJobsResponse is one page of erasure jobs.
+type ListErasureJobsResponse struct {
+	Jobs       []ErasureJobResponse
+	TotalCount int
+}
+
+// ParticipantResponse is a registered participant.
+type ParticipantResponse struct {
+	RegisteredAt time.Time
+	Service      string
+	Categories   []string
+}
+
+// UpsertRetentionPolicyRequest is the input for setting a tenant's
+// retention period for a data category.
+type UpsertRetentionPolicyRequest struct {
+	Category      string
+	RetentionDays int
+	TenantID      uuid.UUID
+}
+
+// RetentionPolicyResponse is a retention policy.
+type RetentionPolicyResponse struct {
+	LastRunOn     time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Category      string
+	RetentionDays int
+	Version       int
+}
+
+// RetentionRunResponse is what a retention run started.
+type RetentionRunResponse struct {
+	Jobs []ErasureJobResponse
+	// SkippedCategories are the due policies whose category no registered
+	// participant erases.
+	SkippedCategories []string
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/port"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/service"
+)
+
+// ErrCertificateNotIssued is returned when a job has no certificate: it is
+// a retention job or has not completed.
+var ErrCertificateNotIssued = errors.New("certificate of erasure not issued")
+
+// GetErasureCertificateUseCase retrieves the certificate of a completed
+// subject erasure.
+type GetErasureCertificateUseCase struct {
+	jobs port.JobRepository
+}
+
+// NewGetErasureCertificateUseCase creates a new GetErasureCertificateUseCase.
+func NewGetErasureCertificateUseCase(jobs port.JobRepository) *GetErasureCertificateUseCase {
+	return &GetErasureCertificateUseCase{jobs: jobs}
+}
+
+// Execute returns the job's certificate.
+func (uc *GetErasureCertificateUseCase) Execute(ctx context.Context, tenantID, jobID uuid.UUID) (model.Certificate, error) {
+	job, err := findTenantJob(ctx, uc.jobs, tenantID, jobID)
+	if err != nil {
+		return model.Certificate{}, err
+	}
+	if job.Certificate() == nil {
+		return model.Certificate{}, fmt.Errorf("%w: job is %s", ErrCertificateNotIssued, job.Status())
+	}
+	return *job.Certificate(), nil
+}
+
+// finishJob closes a job whose tasks have all settled, certifying it when
+// it is a subject erasure every service completed. It returns false when
+// tasks are still pending.
+func finishJob(job model.ErasureJob, certifier service.Certifier, now time.Time) (model.ErasureJob, bool, error) {
+	if !job.IsInProgress() || !job.Settled() {
+		return job, false, nil
+	}
+	var cert *model.Certificate
+	if job.Kind() == erasure.KindSubject && job.Succeeded() {
+		issued, err := certifier.Issue(job, now)
+		if err != nil {
+			return job, false, fmt.Errorf("failed to certify erasure: %w", err)
+		}
+		cert = &issued
+	}
+	job, err := job.Finish(cert, now)
+	if err != nil {
+		return job, false, err
+	}
+	return job, true, nil
+}
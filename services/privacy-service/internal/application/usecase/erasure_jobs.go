@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/services/privacy-service/internal/application/dto"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/port"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/valueobject"
+)
+
+// ErrInvalidErasureRequest is returned when an erasure request or listing
+// is malformed.
+var ErrInvalidErasureRequest = errors.New("invalid erasure request")
+
+// ErrJobNotRetryable is returned when retrying a job that has not failed.
+var ErrJobNotRetryable = errors.New("erasure job cannot be retried")
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// RequestErasureUseCase fans a data subject's erasure out to every
+// registered participant.
+type RequestErasureUseCase struct {
+	participants port.ParticipantRepository
+	jobs         port.JobRepository
+	publisher    port.EventPublisher
+	timeout      time.Duration
+}
+
+// NewRequestErasureUseCase creates a new RequestErasureUseCase. Services
+// that have not reported timeout after the request are timed out.
+func NewRequestErasureUseCase(
+	participants port.ParticipantRepository,
+	jobs port.JobRepository,
+	publisher port.EventPublisher,
+	timeout time.Duration,
+) *RequestErasureUseCase {
+	return &RequestErasureUseCase{participants: participants, jobs: jobs, publisher: publisher, timeout: timeout}
+}
+
+// Execute starts the erasure job.
+func (uc *RequestErasureUseCase) Execute(ctx context.Context, req dto.RequestErasureRequest) (dto.ErasureJobResponse, error) {
+	participants, err := uc.participants.List(ctx)
+	if err != nil {
+		return dto.ErasureJobResponse{}, fmt.Errorf("failed to list participants: %w", err)
+	}
+	subject := erasure.Subject{Email: req.Email, CustomerID: req.CustomerID}
+	job, err := model.NewSubjectErasureJob(
+		req.TenantID, subject, req.Reference, req.RequestedBy, participants, time.Now().UTC(), uc.timeout)
+	if err != nil {
+		return dto.ErasureJobResponse{}, fmt.Errorf("%w: %w", ErrInvalidErasureRequest, err)
+	}
+	if err := uc.jobs.Save(ctx, job); err != nil {
+		return dto.ErasureJobResponse{}, fmt.Errorf("failed to save erasure job: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, job.DomainEvents()); err != nil {
+		return dto.ErasureJobResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toErasureJobResponse(job), nil
+}
+
+// GetErasureJobUseCase retrieves a tenant's erasure job.
+type GetErasureJobUseCase struct {
+	jobs port.JobRepository
+}
+
+// NewGetErasureJobUseCase creates a new GetErasureJobUseCase.
+func NewGetErasureJobUseCase(jobs port.JobRepository) *GetErasureJobUseCase {
+	return &GetErasureJobUseCase{jobs: jobs}
+}
+
+// Execute returns the job.
+func (uc *GetErasureJobUseCase) Execute(ctx context.Context, tenantID, jobID uuid.UUID) (dto.ErasureJobResponse, error) {
+	job, err := findTenantJob(ctx, uc.jobs, tenantID, jobID)
+	if err != nil {
+		return dto.ErasureJobResponse{}, err
+	}
+	return toErasureJobResponse(job), nil
+}
+
+// ListErasureJobsUseCase lists a tenant's erasure jobs.
+type ListErasureJobsUseCase struct {
+	jobs port.JobRepository
+}
+
+// NewListErasureJobsUseCase creates a new ListErasureJobsUseCase.
+func NewListErasureJobsUseCase(jobs port.JobRepository) *ListErasureJobsUseCase {
+	return &ListErasureJobsUseCase{jobs: jobs}
+}
+
+// Execute returns one page of the jobs matching the request.
+func (uc *ListErasureJobsUseCase) Execute(ctx context.Context, req dto.ListErasureJobsRequest) (dto.ListErasureJobsResponse, error) {
+	filter := port.JobFilter{TenantID: req.TenantID}
+	switch kind := erasure.Kind(req.Kind); kind {
+	case "":
+	case erasure.KindSubject, erasure.KindRetention:
+		filter.Kind = kind
+	default:
+		return dto.ListErasureJobsResponse{}, fmt.Errorf("%w: unknown kind %q", ErrInvalidErasureRequest, req.Kind)
+	}
+	if req.Status != "" {
+		status, err := valueobject.NewJobStatus(req.Status)
+		if err != nil {
+			return dto.ListErasureJobsResponse{}, fmt.Errorf("%w: %w", ErrInvalidErasureRequest, err)
+		}
+		filter.Status = status
+	}
+	limit, offset := page(req.Limit, req.Offset)
+	jobs, total, err := uc.jobs.List(ctx, filter, limit, offset)
+	if err != nil {
+		return dto.ListErasureJobsResponse{}, fmt.Errorf("failed to list erasure jobs: %w", err)
+	}
+	resp := dto.ListErasureJobsResponse{
+		Jobs:       make([]dto.ErasureJobResponse, 0, len(jobs)),
+		TotalCount: total,
+	}
+	for _, j := range jobs {
+		resp.Jobs = append(resp.Jobs, toErasureJobResponse(j))
+	}
+	return resp, nil
+}
+
+// RetryErasureJobUseCase re-requests a failed job's erasure from the
+// services that failed or timed out.
+type RetryErasureJobUseCase struct {
+	jobs      port.JobRepository
+	publisher port.EventPublisher
+	timeout   time.Duration
+}
+
+// NewRetryErasureJobUseCase creates a new RetryErasureJobUseCase.
+func NewRetryErasureJobUseCase(jobs port.JobRepository, publisher port.EventPublisher, timeout time.Duration) *RetryErasureJobUseCase {
+	return &RetryErasureJobUseCase{jobs: jobs, publisher: publisher, timeout: timeout}
+}
+
+// Execute retries the job.
+func (uc *RetryErasureJobUseCase) Execute(ctx context.Context, tenantID, jobID uuid.UUID) (dto.ErasureJobResponse, error) {
+	job, err := findTenantJob(ctx, uc.jobs, tenantID, jobID)
+	if err != nil {
+		return dto.ErasureJobResponse{}, err
+	}
+	job, _, err = job.Retry(time.Now().UTC(), uc.timeout)
+	if err != nil {
+		return dto.ErasureJobResponse{}, fmt.Errorf("%w: %w", ErrJobNotRetryable, err)
+	}
+	if err := uc.jobs.Save(ctx, job); err != nil {
+		return dto.ErasureJobResponse{}, fmt.Errorf("failed to save erasure job: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, job.DomainEvents()); err != nil {
+		return dto.ErasureJobResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toErasureJobResponse(job), nil
+}
+
+// findTenantJob retrieves a job, treating another tenant's as not found.
+func findTenantJob(ctx context.Context, jobs port.JobRepository, tenantID, jobID uuid.UUID) (model.ErasureJob, error) {
+	job, err := jobs.FindByID(ctx, jobID)
+	if err == nil && job.TenantID() != tenantID {
+		err = port.ErrJobNotFound
+	}
+	if err != nil {
+		return model.ErasureJob{}, fmt.Errorf("failed to find erasure job: %w", err)
+	}
+	return job, nil
+}
+
+func page(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	return min(limit, maxLimit), max(offset, 0)
+}
+
+func toErasureJobResponse(j model.ErasureJob) dto.ErasureJobResponse {
+	tasks := make([]dto.ErasureTaskResponse, 0, len(j.Tasks()))
+	for _, t := range j.Tasks() {
+		tasks = append(tasks, dto.ErasureTaskResponse{
+			Service:     t.Service,
+			Status:      t.Status.String(),
+			Erased:      t.Erased,
+			Retained:    t.Retained,
+			Error:       t.Error,
+			Attempts:    t.Attempts,
+			CompletedAt: t.CompletedAt,
+		})
+	}
+	return dto.ErasureJobResponse{
+		ID:                 j.ID(),
+		TenantID:           j.TenantID(),
+		Kind:               string(j.Kind()),
+		Status:             j.Status().String(),
+		SubjectEmailSHA256: j.SubjectDigest(),
+		CustomerID:         j.Subject().CustomerID,
+		Reference:          j.Reference(),
+		Category:           j.Category(),
+		Cutoff:             j.Cutoff(),
+		RequestedBy:        j.RequestedBy(),
+		Tasks:              tasks,
+		Deadline:           j.Deadline(),
+		CertificateIssued:  j.Certificate() != nil,
+		Version:            j.Version(),
+		CreatedAt:          j.CreatedAt(),
+		UpdatedAt:          j.UpdatedAt(),
+		CompletedAt:        j.CompletedAt(),
+	}
+}
@@ -0,0 +1,183 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/services/privacy-service/internal/application/dto"
+	"github.com/bibbank/bib/services/privacy-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/port"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/service"
+)
+
+type coordinator struct {
+	participants *inMemoryParticipantRepo
+	jobs         *inMemoryJobRepo
+	publisher    *recordingPublisher
+	certifier    service.Certifier
+	request      *usecase.RequestErasureUseCase
+	handle       *usecase.HandleErasureEventUseCase
+	retry        *usecase.RetryErasureJobUseCase
+	certificate  *usecase.GetErasureCertificateUseCase
+	expire       *usecase.ExpireOverdueJobsUseCase
+}
+
+func newCoordinator(t *testing.T) coordinator {
+	t.Helper()
+	certifier, err := service.NewCertifier([]byte("0123456789abcdef"))
+	require.NoError(t, err)
+	c := coordinator{
+		participants: &inMemoryParticipantRepo{},
+		jobs:         &inMemoryJobRepo{},
+		publisher:    &recordingPublisher{},
+		certifier:    certifier,
+	}
+	c.request = usecase.NewRequestErasureUseCase(c.participants, c.jobs, c.publisher, time.Hour)
+	c.handle = usecase.NewHandleErasureEventUseCase(c.participants, c.jobs, c.publisher, certifier, logger)
+	c.retry = usecase.NewRetryErasureJobUseCase(c.jobs, c.publisher, time.Hour)
+	c.certificate = usecase.NewGetErasureCertificateUseCase(c.jobs)
+	c.expire = usecase.NewExpireOverdueJobsUseCase(c.jobs, c.publisher)
+	return c
+}
+
+// deliver hands an event to the coordinator as the consumer would.
+func (c coordinator) deliver(t *testing.T, evt interface{ EventType() string }) {
+	t.Helper()
+	payload, err := json.Marshal(evt)
+	require.NoError(t, err)
+	require.NoError(t, c.handle.Execute(context.Background(), evt.EventType(), payload))
+}
+
+func (c coordinator) register(t *testing.T, service string, categories ...string) {
+	t.Helper()
+	c.deliver(t, erasure.NewParticipantRegistered(service, categories))
+}
+
+func (c coordinator) report(t *testing.T, job dto.ErasureJobResponse, service string, result erasure.Result, eraseErr error) {
+	t.Helper()
+	req := erasure.Request{JobID: job.ID, TenantID: job.TenantID}
+	c.deliver(t, erasure.NewErasureCompleted(req, service, result, eraseErr, time.Now().UTC()))
+}
+
+func TestRequestErasure_CompletesAndCertifies(t *testing.T) {
+	ctx := context.Background()
+	c := newCoordinator(t)
+	c.register(t, "identity-service", "identity.applicant_data")
+	c.register(t, "customer-service")
+	tenantID := uuid.New()
+
+	job, err := c.request.Execute(ctx, dto.RequestErasureRequest{
+		TenantID:    tenantID,
+		Email:       "jane@example.com",
+		Reference:   "DSR-42",
+		RequestedBy: "officer",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "IN_PROGRESS", job.Status)
+	assert.Equal(t, erasure.EmailDigest("jane@example.com"), job.SubjectEmailSHA256)
+	require.Len(t, job.Tasks, 2)
+	assert.Equal(t, []string{erasure.EventErasureRequested}, c.publisher.types())
+
+	c.report(t, job, "identity-service", erasure.Result{Erased: 3}, nil)
+	c.report(t, job, "identity-service", erasure.Result{Erased: 3}, nil) // redelivered
+	_, err = c.certificate.Execute(ctx, tenantID, job.ID)
+	assert.ErrorIs(t, err, usecase.ErrCertificateNotIssued, "still in progress")
+
+	retained := []erasure.Retained{{Record: "party/1", Reason: "open account"}}
+	c.report(t, job, "customer-service", erasure.Result{Erased: 1, Retained: retained}, nil)
+
+	stored, err := c.jobs.FindByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "COMPLETED", stored.Status().String())
+	assert.Empty(t, stored.Subject().Email)
+	assert.Equal(t, []string{erasure.EventErasureRequested, "privacy.erasure.job_completed"}, c.publisher.types())
+
+	cert, err := c.certificate.Execute(ctx, tenantID, job.ID)
+	require.NoError(t, err)
+	require.NoError(t, c.certifier.Verify(cert))
+	require.Len(t, cert.Body.Services, 2)
+	assert.Equal(t, "DSR-42", cert.Body.Reference)
+
+	_, err = c.certificate.Execute(ctx, uuid.New(), job.ID)
+	assert.ErrorIs(t, err, port.ErrJobNotFound, "another tenant's job")
+}
+
+func TestRequestErasure_FailureIsRetried(t *testing.T) {
+	ctx := context.Background()
+	c := newCoordinator(t)
+	c.register(t, "identity-service")
+	c.register(t, "customer-service")
+	tenantID := uuid.New()
+
+	job, err := c.request.Execute(ctx, dto.RequestErasureRequest{
+		TenantID: tenantID, CustomerID: uuid.New(), Reference: "DSR-43",
+	})
+	require.NoError(t, err)
+	c.report(t, job, "identity-service", erasure.Result{Erased: 1}, nil)
+	c.report(t, job, "customer-service", erasure.Result{}, assert.AnError)
+
+	failed, err := c.jobs.FindByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "FAILED", failed.Status().String())
+	assert.Nil(t, failed.Certificate())
+
+	retried, err := c.retry.Execute(ctx, tenantID, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "IN_PROGRESS", retried.Status)
+	last := c.publisher.events[len(c.publisher.events)-1].(erasure.ErasureRequested)
+	assert.Equal(t, "customer-service", last.Service, "only the failed service is asked again")
+
+	c.report(t, job, "customer-service", erasure.Result{Erased: 2}, nil)
+	cert, err := c.certificate.Execute(ctx, tenantID, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, cert.Body.Services[1].Erased)
+
+	_, err = c.retry.Execute(ctx, tenantID, job.ID)
+	assert.ErrorIs(t, err, usecase.ErrJobNotRetryable)
+}
+
+func TestRequestErasure_Validation(t *testing.T) {
+	ctx := context.Background()
+	c := newCoordinator(t)
+
+	_, err := c.request.Execute(ctx, dto.RequestErasureRequest{TenantID: uuid.New(), Email: "a@b.c", Reference: "DSR"})
+	assert.ErrorIs(t, err, usecase.ErrInvalidErasureRequest, "no participants")
+
+	c.register(t, "identity-service")
+	_, err = c.request.Execute(ctx, dto.RequestErasureRequest{TenantID: uuid.New(), Reference: "DSR"})
+	assert.ErrorIs(t, err, usecase.ErrInvalidErasureRequest, "no subject")
+}
+
+func TestExpireOverdueJobs(t *testing.T) {
+	ctx := context.Background()
+	c := newCoordinator(t)
+	c.register(t, "identity-service")
+	job, err := c.request.Execute(ctx, dto.RequestErasureRequest{TenantID: uuid.New(), Email: "a@b.c", Reference: "DSR"})
+	require.NoError(t, err)
+
+	expired, err := c.expire.Execute(ctx, time.Now().UTC())
+	require.NoError(t, err)
+	assert.Zero(t, expired)
+
+	expired, err = c.expire.Execute(ctx, time.Now().UTC().Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+	stored, err := c.jobs.FindByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "FAILED", stored.Status().String())
+	assert.Equal(t, "TIMED_OUT", stored.Tasks()[0].Status.String())
+
+	// A result arriving after the deadline is ignored until the job is
+	// retried.
+	c.report(t, job, "identity-service", erasure.Result{Erased: 1}, nil)
+	stored, err = c.jobs.FindByID(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "FAILED", stored.Status().String())
+}
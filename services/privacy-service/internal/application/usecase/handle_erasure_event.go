@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/port"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/service"
+)
+
+// HandleErasureEventUseCase consumes the participants' side of
+// erasure.Topic: registrations update the registry and results are
+// recorded on their jobs, which finish once every service has reported.
+type HandleErasureEventUseCase struct {
+	participants port.ParticipantRepository
+	jobs         port.JobRepository
+	publisher    port.EventPublisher
+	certifier    service.Certifier
+	logger       *slog.Logger
+}
+
+// NewHandleErasureEventUseCase creates a new HandleErasureEventUseCase.
+func NewHandleErasureEventUseCase(
+	participants port.ParticipantRepository,
+	jobs port.JobRepository,
+	publisher port.EventPublisher,
+	certifier service.Certifier,
+	logger *slog.Logger,
+) *HandleErasureEventUseCase {
+	return &HandleErasureEventUseCase{
+		participants: participants,
+		jobs:         jobs,
+		publisher:    publisher,
+		certifier:    certifier,
+		logger:       logger,
+	}
+}
+
+// Execute handles an event consumed from erasure.Topic. The coordinator's
+// own requests are ignored, as are results for unknown jobs or tasks that
+// have already settled, so redelivered and late results are harmless.
+func (uc *HandleErasureEventUseCase) Execute(ctx context.Context, eventType string, payload []byte) error {
+	switch eventType {
+	case erasure.EventParticipantRegistered:
+		var evt erasure.ParticipantRegistered
+		if err := json.Unmarshal(payload, &evt); err != nil {
+			return fmt.Errorf("failed to decode participant registration: %w", err)
+		}
+		return uc.register(ctx, evt)
+	case erasure.EventErasureCompleted:
+		var evt erasure.ErasureCompleted
+		if err := json.Unmarshal(payload, &evt); err != nil {
+			return fmt.Errorf("failed to decode erasure result: %w", err)
+		}
+		return uc.record(ctx, evt)
+	default:
+		return nil
+	}
+}
+
+func (uc *HandleErasureEventUseCase) register(ctx context.Context, evt erasure.ParticipantRegistered) error {
+	participant, err := model.NewParticipant(evt.Service, evt.Categories, time.Now().UTC())
+	if err != nil {
+		uc.logger.Warn("ignoring invalid participant registration", "error", err)
+		return nil
+	}
+	if err := uc.participants.Save(ctx, participant); err != nil {
+		return fmt.Errorf("failed to save participant: %w", err)
+	}
+	uc.logger.Info("erasure participant registered",
+		"service", participant.Service(), "categories", participant.Categories())
+	return nil
+}
+
+func (uc *HandleErasureEventUseCase) record(ctx context.Context, evt erasure.ErasureCompleted) error {
+	job, err := uc.jobs.FindByID(ctx, evt.JobID)
+	if errors.Is(err, port.ErrJobNotFound) {
+		uc.logger.Warn("ignoring erasure result for unknown job", "job_id", evt.JobID, "service", evt.Service)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find erasure job: %w", err)
+	}
+
+	now := time.Now().UTC()
+	job, err = job.RecordResult(evt.Service, evt.Outcome, evt.Result, evt.Error, now)
+	if errors.Is(err, model.ErrTaskSettled) || errors.Is(err, model.ErrUnknownTask) {
+		uc.logger.Warn("ignoring erasure result", "job_id", evt.JobID, "service", evt.Service, "error", err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	job, _, err = finishJob(job, uc.certifier, now)
+	if err != nil {
+		return err
+	}
+	if err := uc.jobs.Save(ctx, job); err != nil {
+		return fmt.Errorf("failed to save erasure job: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, job.DomainEvents()); err != nil {
+		return fmt.Errorf("failed to publish events: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/privacy-service/internal/application/dto"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/port"
+)
+
+// ListParticipantsUseCase lists the registered participants.
+type ListParticipantsUseCase struct {
+	participants port.ParticipantRepository
+}
+
+// NewListParticipantsUseCase creates a new ListParticipantsUseCase.
+func NewListParticipantsUseCase(participants port.ParticipantRepository) *ListParticipantsUseCase {
+	return &ListParticipantsUseCase{participants: participants}
+}
+
+// Execute returns every registered participant.
+func (uc *ListParticipantsUseCase) Execute(ctx context.Context) ([]dto.ParticipantResponse, error) {
+	participants, err := uc.participants.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list participants: %w", err)
+	}
+	resp := make([]dto.ParticipantResponse, 0, len(participants))
+	for _, p := range participants {
+		resp = append(resp, dto.ParticipantResponse{
+			Service:      p.Service(),
+			Categories:   p.Categories(),
+			RegisteredAt: p.RegisteredAt(),
+		})
+	}
+	return resp, nil
+}
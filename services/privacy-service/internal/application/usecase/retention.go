@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/privacy-service/internal/application/dto"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/port"
+)
+
+// ErrInvalidRetentionPolicy is returned when a retention policy request is
+// malformed.
+var ErrInvalidRetentionPolicy = errors.New("invalid retention policy")
+
+// overdueBatchSize bounds the overdue jobs expired per call.
+const overdueBatchSize = 100
+
+// UpsertRetentionPolicyUseCase sets a tenant's retention period for a data
+// category.
+type UpsertRetentionPolicyUseCase struct {
+	policies port.RetentionPolicyRepository
+}
+
+// NewUpsertRetentionPolicyUseCase creates a new UpsertRetentionPolicyUseCase.
+func NewUpsertRetentionPolicyUseCase(policies port.RetentionPolicyRepository) *UpsertRetentionPolicyUseCase {
+	return &UpsertRetentionPolicyUseCase{policies: policies}
+}
+
+// Execute creates the policy or changes its retention period.
+func (uc *UpsertRetentionPolicyUseCase) Execute(ctx context.Context, req dto.UpsertRetentionPolicyRequest) (dto.RetentionPolicyResponse, error) {
+	now := time.Now().UTC()
+	policy, err := uc.policies.Find(ctx, req.TenantID, req.Category)
+	switch {
+	case errors.Is(err, port.ErrPolicyNotFound):
+		policy, err = model.NewRetentionPolicy(req.TenantID, req.Category, req.RetentionDays, now)
+	case err != nil:
+		return dto.RetentionPolicyResponse{}, fmt.Errorf("failed to find retention policy: %w", err)
+	default:
+		policy, err = policy.Update(req.RetentionDays, now)
+	}
+	if err != nil {
+		return dto.RetentionPolicyResponse{}, fmt.Errorf("%w: %w", ErrInvalidRetentionPolicy, err)
+	}
+	if err := uc.policies.Save(ctx, policy); err != nil {
+		return dto.RetentionPolicyResponse{}, fmt.Errorf("failed to save retention policy: %w", err)
+	}
+	return toRetentionPolicyResponse(policy), nil
+}
+
+// ListRetentionPoliciesUseCase lists a tenant's retention policies.
+type ListRetentionPoliciesUseCase struct {
+	policies port.RetentionPolicyRepository
+}
+
+// NewListRetentionPoliciesUseCase creates a new ListRetentionPoliciesUseCase.
+func NewListRetentionPoliciesUseCase(policies port.RetentionPolicyRepository) *ListRetentionPoliciesUseCase {
+	return &ListRetentionPoliciesUseCase{policies: policies}
+}
+
+// Execute returns the tenant's policies.
+func (uc *ListRetentionPoliciesUseCase) Execute(ctx context.Context, tenantID uuid.UUID) ([]dto.RetentionPolicyResponse, error) {
+	policies, err := uc.policies.List(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list retention policies: %w", err)
+	}
+	resp := make([]dto.RetentionPolicyResponse, 0, len(policies))
+	for _, p := range policies {
+		resp = append(resp, toRetentionPolicyResponse(p))
+	}
+	return resp, nil
+}
+
+// DeleteRetentionPolicyUseCase removes a tenant's retention policy; its
+// records are then kept indefinitely.
+type DeleteRetentionPolicyUseCase struct {
+	policies port.RetentionPolicyRepository
+}
+
+// NewDeleteRetentionPolicyUseCase creates a new DeleteRetentionPolicyUseCase.
+func NewDeleteRetentionPolicyUseCase(policies port.RetentionPolicyRepository) *DeleteRetentionPolicyUseCase {
+	return &DeleteRetentionPolicyUseCase{policies: policies}
+}
+
+// Execute deletes the policy.
+func (uc *DeleteRetentionPolicyUseCase) Execute(ctx context.Context, tenantID uuid.UUID, category string) error {
+	if err := uc.policies.Delete(ctx, tenantID, category); err != nil {
+		return fmt.Errorf("failed to delete retention policy: %w", err)
+	}
+	return nil
+}
+
+// RunRetentionUseCase starts a retention job for each policy that has not
+// run yet today.
+type RunRetentionUseCase struct {
+	participants port.ParticipantRepository
+	policies     port.RetentionPolicyRepository
+	jobs         port.JobRepository
+	publisher    port.EventPublisher
+	logger       *slog.Logger
+	timeout      time.Duration
+}
+
+// NewRunRetentionUseCase creates a new RunRetentionUseCase.
+func NewRunRetentionUseCase(
+	participants port.ParticipantRepository,
+	policies port.RetentionPolicyRepository,
+	jobs port.JobRepository,
+	publisher port.EventPublisher,
+	timeout time.Duration,
+	logger *slog.Logger,
+) *RunRetentionUseCase {
+	return &RunRetentionUseCase{
+		participants: participants,
+		policies:     policies,
+		jobs:         jobs,
+		publisher:    publisher,
+		timeout:      timeout,
+		logger:       logger,
+	}
+}
+
+// ExecuteAll runs every tenant's due policies. A tenant that fails is
+// logged and does not stop the others.
+func (uc *RunRetentionUseCase) ExecuteAll(ctx context.Context, now time.Time) (int, error) {
+	tenants, err := uc.policies.ListTenants(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list tenants: %w", err)
+	}
+	var started int
+	var errs []error
+	for _, tenantID := range tenants {
+		result, err := uc.Execute(ctx, tenantID, now)
+		started += len(result.Jobs)
+		if err != nil {
+			uc.logger.Error("retention run failed", "tenant_id", tenantID, "error", err)
+			errs = append(errs, err)
+		}
+	}
+	return started, errors.Join(errs...)
+}
+
+// Execute runs one tenant's due policies at now. A policy whose category
+// no participant erases is skipped and stays due, so it runs once a
+// participant registers for it.
+func (uc *RunRetentionUseCase) Execute(ctx context.Context, tenantID uuid.UUID, now time.Time) (dto.RetentionRunResponse, error) {
+	var resp dto.RetentionRunResponse
+	policies, err := uc.policies.List(ctx, tenantID)
+	if err != nil {
+		return resp, fmt.Errorf("failed to list retention policies: %w", err)
+	}
+	participants, err := uc.participants.List(ctx)
+	if err != nil {
+		return resp, fmt.Errorf("failed to list participants: %w", err)
+	}
+	for _, policy := range policies {
+		if !policy.Due(now) {
+			continue
+		}
+		job, err := model.NewRetentionJob(tenantID, policy.Category(), policy.Cutoff(now), participants, now, uc.timeout)
+		if errors.Is(err, model.ErrNoParticipants) {
+			resp.SkippedCategories = append(resp.SkippedCategories, policy.Category())
+			continue
+		}
+		if err != nil {
+			return resp, fmt.Errorf("failed to create retention job: %w", err)
+		}
+		// The policy is marked first: should the job not be saved, the
+		// policy is skipped until tomorrow rather than erasing twice.
+		if err := uc.policies.Save(ctx, policy.RecordRun(now, now)); err != nil {
+			return resp, fmt.Errorf("failed to save retention policy: %w", err)
+		}
+		if err := uc.jobs.Save(ctx, job); err != nil {
+			return resp, fmt.Errorf("failed to save retention job: %w", err)
+		}
+		if err := uc.publisher.Publish(ctx, job.DomainEvents()); err != nil {
+			return resp, fmt.Errorf("failed to publish events: %w", err)
+		}
+		resp.Jobs = append(resp.Jobs, toErasureJobResponse(job))
+	}
+	return resp, nil
+}
+
+// ExpireOverdueJobsUseCase times out the services that have not reported
+// on a job by its deadline and fails the job, so it can be retried.
+type ExpireOverdueJobsUseCase struct {
+	jobs      port.JobRepository
+	publisher port.EventPublisher
+}
+
+// NewExpireOverdueJobsUseCase creates a new ExpireOverdueJobsUseCase.
+func NewExpireOverdueJobsUseCase(jobs port.JobRepository, publisher port.EventPublisher) *ExpireOverdueJobsUseCase {
+	return &ExpireOverdueJobsUseCase{jobs: jobs, publisher: publisher}
+}
+
+// Execute expires the jobs overdue at now, returning how many it expired.
+func (uc *ExpireOverdueJobsUseCase) Execute(ctx context.Context, now time.Time) (int, error) {
+	jobs, err := uc.jobs.ListOverdue(ctx, now, overdueBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list overdue erasure jobs: %w", err)
+	}
+	var expired int
+	for _, job := range jobs {
+		job, changed := job.ExpireOverdue(now)
+		if !changed {
+			continue
+		}
+		// A timed-out task fails the job, which is therefore not certified.
+		job, err := job.Finish(nil, now)
+		if err != nil {
+			return expired, err
+		}
+		if err := uc.jobs.Save(ctx, job); err != nil {
+			return expired, fmt.Errorf("failed to save erasure job: %w", err)
+		}
+		if err := uc.publisher.Publish(ctx, job.DomainEvents()); err != nil {
+			return expired, fmt.Errorf("failed to publish events: %w", err)
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+func toRetentionPolicyResponse(p model.RetentionPolicy) dto.RetentionPolicyResponse {
+	return dto.RetentionPolicyResponse{
+		Category:      p.Category(),
+		RetentionDays: p.RetentionDays(),
+		LastRunOn:     p.LastRunOn(),
+		Version:       p.Version(),
+		CreatedAt:     p.CreatedAt(),
+		UpdatedAt:     p.UpdatedAt(),
+	}
+}
@@ -0,0 +1,61 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/services/privacy-service/internal/application/dto"
+	"github.com/bibbank/bib/services/privacy-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+)
+
+func TestRunRetention(t *testing.T) {
+	ctx := context.Background()
+	participants := &inMemoryParticipantRepo{}
+	policies := &inMemoryPolicyRepo{}
+	jobs := &inMemoryJobRepo{}
+	publisher := &recordingPublisher{}
+	upsert := usecase.NewUpsertRetentionPolicyUseCase(policies)
+	run := usecase.NewRunRetentionUseCase(participants, policies, jobs, publisher, time.Hour, logger)
+
+	identity, err := model.NewParticipant("identity-service", []string{"identity.applicant_data"}, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, participants.Save(ctx, identity))
+
+	tenantID := uuid.New()
+	_, err = upsert.Execute(ctx, dto.UpsertRetentionPolicyRequest{TenantID: tenantID, Category: "identity.applicant_data", RetentionDays: 30})
+	require.NoError(t, err)
+	updated, err := upsert.Execute(ctx, dto.UpsertRetentionPolicyRequest{TenantID: tenantID, Category: "identity.applicant_data", RetentionDays: 60})
+	require.NoError(t, err)
+	assert.Equal(t, 60, updated.RetentionDays)
+	assert.Equal(t, 2, updated.Version)
+	_, err = upsert.Execute(ctx, dto.UpsertRetentionPolicyRequest{TenantID: tenantID, Category: "cards.pan", RetentionDays: 10})
+	require.NoError(t, err)
+	_, err = upsert.Execute(ctx, dto.UpsertRetentionPolicyRequest{TenantID: tenantID, Category: "cards.pan", RetentionDays: 0})
+	assert.ErrorIs(t, err, usecase.ErrInvalidRetentionPolicy)
+
+	now := time.Date(2026, 3, 10, 2, 0, 0, 0, time.UTC)
+	resp, err := run.Execute(ctx, tenantID, now)
+	require.NoError(t, err)
+	require.Len(t, resp.Jobs, 1)
+	assert.Equal(t, "RETENTION", resp.Jobs[0].Kind)
+	assert.Equal(t, time.Date(2026, 1, 9, 0, 0, 0, 0, time.UTC), resp.Jobs[0].Cutoff)
+	assert.Equal(t, []string{"cards.pan"}, resp.SkippedCategories, "no participant erases it")
+	requested := publisher.events[0].(erasure.ErasureRequested)
+	assert.Equal(t, erasure.KindRetention, requested.Request.Kind)
+	assert.Equal(t, "identity.applicant_data", requested.Request.Category)
+
+	resp, err = run.Execute(ctx, tenantID, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, resp.Jobs, "already run today")
+
+	started, err := run.ExecuteAll(ctx, now.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	assert.Equal(t, 1, started)
+}
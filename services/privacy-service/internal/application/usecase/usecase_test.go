@@ -0,0 +1,167 @@
+package usecase_test
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/event"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/port"
+)
+
+var logger = slog.New(slog.DiscardHandler)
+
+// inMemoryParticipantRepo is an in-memory ParticipantRepository.
+type inMemoryParticipantRepo struct {
+	participants []model.Participant
+}
+
+func (r *inMemoryParticipantRepo) Save(_ context.Context, participant model.Participant) error {
+	for i, p := range r.participants {
+		if p.Service() == participant.Service() {
+			r.participants[i] = participant
+			return nil
+		}
+	}
+	r.participants = append(r.participants, participant)
+	return nil
+}
+
+func (r *inMemoryParticipantRepo) List(_ context.Context) ([]model.Participant, error) {
+	return r.participants, nil
+}
+
+// inMemoryJobRepo is an in-memory JobRepository.
+type inMemoryJobRepo struct {
+	jobs []model.ErasureJob
+}
+
+func (r *inMemoryJobRepo) Save(_ context.Context, job model.ErasureJob) error {
+	// Events are not persisted.
+	job = job.ClearDomainEvents()
+	for i, j := range r.jobs {
+		if j.ID() == job.ID() {
+			if j.Version() != job.Version()-1 {
+				return port.ErrVersionConflict
+			}
+			r.jobs[i] = job
+			return nil
+		}
+	}
+	r.jobs = append(r.jobs, job)
+	return nil
+}
+
+func (r *inMemoryJobRepo) FindByID(_ context.Context, id uuid.UUID) (model.ErasureJob, error) {
+	for _, j := range r.jobs {
+		if j.ID() == id {
+			return j, nil
+		}
+	}
+	return model.ErasureJob{}, port.ErrJobNotFound
+}
+
+func (r *inMemoryJobRepo) List(_ context.Context, filter port.JobFilter, limit, offset int) ([]model.ErasureJob, int, error) {
+	var out []model.ErasureJob
+	for _, j := range slices.Backward(r.jobs) {
+		switch {
+		case j.TenantID() != filter.TenantID,
+			filter.Kind != "" && j.Kind() != filter.Kind,
+			!filter.Status.IsZero() && !j.Status().Equal(filter.Status):
+			continue
+		}
+		out = append(out, j)
+	}
+	total := len(out)
+	return out[min(offset, total):min(offset+limit, total)], total, nil
+}
+
+func (r *inMemoryJobRepo) ListOverdue(_ context.Context, now time.Time, limit int) ([]model.ErasureJob, error) {
+	var out []model.ErasureJob
+	for _, j := range r.jobs {
+		if j.IsInProgress() && j.Deadline().Before(now) && len(out) < limit {
+			out = append(out, j)
+		}
+	}
+	return out, nil
+}
+
+// inMemoryPolicyRepo is an in-memory RetentionPolicyRepository.
+type inMemoryPolicyRepo struct {
+	policies []model.RetentionPolicy
+}
+
+func (r *inMemoryPolicyRepo) Save(_ context.Context, policy model.RetentionPolicy) error {
+	for i, p := range r.policies {
+		if p.TenantID() == policy.TenantID() && p.Category() == policy.Category() {
+			if p.Version() != policy.Version()-1 {
+				return port.ErrVersionConflict
+			}
+			r.policies[i] = policy
+			return nil
+		}
+	}
+	r.policies = append(r.policies, policy)
+	return nil
+}
+
+func (r *inMemoryPolicyRepo) Find(_ context.Context, tenantID uuid.UUID, category string) (model.RetentionPolicy, error) {
+	for _, p := range r.policies {
+		if p.TenantID() == tenantID && p.Category() == category {
+			return p, nil
+		}
+	}
+	return model.RetentionPolicy{}, port.ErrPolicyNotFound
+}
+
+func (r *inMemoryPolicyRepo) List(_ context.Context, tenantID uuid.UUID) ([]model.RetentionPolicy, error) {
+	var out []model.RetentionPolicy
+	for _, p := range r.policies {
+		if p.TenantID() == tenantID {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+func (r *inMemoryPolicyRepo) Delete(_ context.Context, tenantID uuid.UUID, category string) error {
+	for i, p := range r.policies {
+		if p.TenantID() == tenantID && p.Category() == category {
+			r.policies = slices.Delete(r.policies, i, i+1)
+			return nil
+		}
+	}
+	return port.ErrPolicyNotFound
+}
+
+func (r *inMemoryPolicyRepo) ListTenants(_ context.Context) ([]uuid.UUID, error) {
+	var out []uuid.UUID
+	for _, p := range r.policies {
+		if !slices.Contains(out, p.TenantID()) {
+			out = append(out, p.TenantID())
+		}
+	}
+	return out, nil
+}
+
+// recordingPublisher records published events.
+type recordingPublisher struct {
+	events []event.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, events []event.DomainEvent) error {
+	p.events = append(p.events, events...)
+	return nil
+}
+
+func (p *recordingPublisher) types() []string {
+	out := make([]string, 0, len(p.events))
+	for _, e := range p.events {
+		out = append(out, e.EventType())
+	}
+	return out
+}
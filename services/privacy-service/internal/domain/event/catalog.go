@@ -0,0 +1,12 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[ErasureJobFinished]("privacy.erasure.job_completed", 1, "Emitted when every service has reported on an erasure job, or the job's deadline passed."),
+		events.Declare[ErasureJobFinished]("privacy.erasure.job_failed", 1, "Emitted when every service has reported on an erasure job, or the job's deadline passed."),
+	}
+}
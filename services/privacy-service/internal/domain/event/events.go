@@ -0,0 +1,59 @@
+package event
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// DomainEvent is an alias for the shared pkg/events.DomainEvent interface.
+type DomainEvent = events.DomainEvent
+
+// AggregateTypeErasureJob is the aggregate type of erasure job events.
+const AggregateTypeErasureJob = "ErasureJob"
+
+// ErasureJobFinished is emitted when every service has reported on an
+// erasure job, or the job's deadline passed. It carries the subject's email
+// digest, never the address.
+type ErasureJobFinished struct {
+	events.BaseEvent
+	Kind               string   `json:"kind"`
+	Status             string   `json:"status"`
+	SubjectEmailSHA256 string   `json:"subject_email_sha256,omitempty"`
+	Reference          string   `json:"reference,omitempty"`
+	Category           string   `json:"category,omitempty"`
+	FailedServices     []string `json:"failed_services,omitempty"`
+	Erased             int      `json:"erased"`
+	Retained           int      `json:"retained"`
+}
+
+// NewErasureJobCompleted creates the event for a job every service
+// completed.
+func NewErasureJobCompleted(jobID, tenantID uuid.UUID, kind, digest, reference, category string, erased, retained int) ErasureJobFinished {
+	return ErasureJobFinished{
+		BaseEvent:          events.NewBaseEvent("privacy.erasure.job_completed", jobID.String(), AggregateTypeErasureJob, tenantID.String()),
+		Kind:               kind,
+		Status:             "COMPLETED",
+		SubjectEmailSHA256: digest,
+		Reference:          reference,
+		Category:           category,
+		Erased:             erased,
+		Retained:           retained,
+	}
+}
+
+// NewErasureJobFailed creates the event for a job some services failed or
+// did not report on in time.
+func NewErasureJobFailed(jobID, tenantID uuid.UUID, kind, digest, reference, category string, failed []string, erased, retained int) ErasureJobFinished {
+	return ErasureJobFinished{
+		BaseEvent:          events.NewBaseEvent("privacy.erasure.job_failed", jobID.String(), AggregateTypeErasureJob, tenantID.String()),
+		Kind:               kind,
+		Status:             "FAILED",
+		SubjectEmailSHA256: digest,
+		Reference:          reference,
+		Category:           category,
+		FailedServices:     failed,
+		Erased:             erased,
+		Retained:           retained,
+	}
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/erasure"
+)
+
+// CertifiedService is what one service erased for a certified job.
+type CertifiedService struct {
+	Service  string             `json:"service"`
+	Retained []erasure.Retained `json:"retained,omitempty"`
+	Erased   int                `json:"erased"`
+}
+
+// CertificateBody is the signed content of a certificate of erasure. It
+// identifies the subject by their email digest and customer ID only.
+type CertificateBody struct {
+	RequestedAt        time.Time          `json:"requested_at"`
+	CompletedAt        time.Time          `json:"completed_at"`
+	IssuedAt           time.Time          `json:"issued_at"`
+	SubjectEmailSHA256 string             `json:"subject_email_sha256,omitempty"`
+	Reference          string             `json:"reference"`
+	Services           []CertifiedService `json:"services"`
+	JobID              uuid.UUID          `json:"job_id"`
+	TenantID           uuid.UUID          `json:"tenant_id"`
+	CustomerID         uuid.UUID          `json:"customer_id,omitempty"`
+}
+
+// Certificate attests that a subject erasure completed. Document is the
+// exact JSON encoding of the body that was signed, so the signature can be
+// checked without re-encoding it.
+type Certificate struct {
+	Document  string          `json:"document"`
+	Digest    string          `json:"digest"`
+	Signature string          `json:"signature"`
+	Algorithm string          `json:"algorithm"`
+	Body      CertificateBody `json:"body"`
+}
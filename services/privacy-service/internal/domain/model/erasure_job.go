@@ -0,0 +1,401 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/event"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/valueobject"
+)
+
+// ErrNoParticipants is returned when no registered service would take part
+// in an erasure.
+var ErrNoParticipants = errors.New("no participant handles the erasure")
+
+// ErrTaskSettled is returned when a service reports on a task that is not
+// pending, as when a result is redelivered or arrives after the deadline.
+var ErrTaskSettled = errors.New("erasure task already settled")
+
+// ErrUnknownTask is returned when a service that is not part of a job
+// reports on it.
+var ErrUnknownTask = errors.New("service is not part of the erasure job")
+
+// ErasureTask is one service's part in an erasure job.
+type ErasureTask struct {
+	CompletedAt *time.Time             `json:"completed_at,omitempty"`
+	Service     string                 `json:"service"`
+	Status      valueobject.TaskStatus `json:"-"`
+	Error       string                 `json:"error,omitempty"`
+	Retained    []erasure.Retained     `json:"retained,omitempty"`
+	Erased      int                    `json:"erased"`
+	Attempts    int                    `json:"attempts"`
+}
+
+// ErasureJob is the aggregate root for an erasure fanned out to the
+// registered services. It completes once every service has erased its
+// share, and fails when one fails or has not reported by the deadline;
+// a failed job can be retried for those services. A completed subject
+// erasure forgets the subject's email, keeping only its digest, and
+// carries the certificate of erasure.
+type ErasureJob struct {
+	cutoff        time.Time
+	deadline      time.Time
+	createdAt     time.Time
+	updatedAt     time.Time
+	completedAt   *time.Time
+	certificate   *Certificate
+	subject       erasure.Subject
+	kind          erasure.Kind
+	status        valueobject.JobStatus
+	subjectDigest string
+	reference     string
+	category      string
+	requestedBy   string
+	tasks         []ErasureTask
+	domainEvents  []events.DomainEvent
+	version       int
+	id            uuid.UUID
+	tenantID      uuid.UUID
+}
+
+// NewSubjectErasureJob asks every participant to erase a data subject's
+// personal data. reference identifies the subject's verified request.
+func NewSubjectErasureJob(
+	tenantID uuid.UUID,
+	subject erasure.Subject,
+	reference, requestedBy string,
+	participants []Participant,
+	now time.Time,
+	timeout time.Duration,
+) (ErasureJob, error) {
+	if tenantID == uuid.Nil {
+		return ErasureJob{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	subject.Email = strings.TrimSpace(subject.Email)
+	if subject.IsZero() {
+		return ErasureJob{}, fmt.Errorf("subject email or customer ID is required")
+	}
+	reference = strings.TrimSpace(reference)
+	if reference == "" {
+		return ErasureJob{}, fmt.Errorf("reference is required")
+	}
+	if len(participants) == 0 {
+		return ErasureJob{}, ErrNoParticipants
+	}
+	services := make([]string, 0, len(participants))
+	for _, p := range participants {
+		services = append(services, p.Service())
+	}
+	j := newErasureJob(tenantID, erasure.KindSubject, requestedBy, services, now, timeout)
+	j.subject = subject
+	j.subjectDigest = erasure.EmailDigest(subject.Email)
+	j.reference = reference
+	j.domainEvents = append(j.domainEvents, erasure.NewErasureRequested(j.Request(), ""))
+	return j, nil
+}
+
+// NewRetentionJob asks the participants handling a data category to erase
+// the tenant's records of it kept since before cutoff.
+func NewRetentionJob(
+	tenantID uuid.UUID,
+	category string,
+	cutoff time.Time,
+	participants []Participant,
+	now time.Time,
+	timeout time.Duration,
+) (ErasureJob, error) {
+	if tenantID == uuid.Nil {
+		return ErasureJob{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	if category == "" || cutoff.IsZero() {
+		return ErasureJob{}, fmt.Errorf("category and cutoff are required")
+	}
+	var services []string
+	for _, p := range participants {
+		if p.Handles(category) {
+			services = append(services, p.Service())
+		}
+	}
+	if len(services) == 0 {
+		return ErasureJob{}, fmt.Errorf("%w: category %q", ErrNoParticipants, category)
+	}
+	j := newErasureJob(tenantID, erasure.KindRetention, "retention-policy", services, now, timeout)
+	j.category = category
+	j.cutoff = cutoff
+	j.domainEvents = append(j.domainEvents, erasure.NewErasureRequested(j.Request(), ""))
+	return j, nil
+}
+
+func newErasureJob(tenantID uuid.UUID, kind erasure.Kind, requestedBy string, services []string, now time.Time, timeout time.Duration) ErasureJob {
+	tasks := make([]ErasureTask, 0, len(services))
+	for _, s := range services {
+		tasks = append(tasks, ErasureTask{Service: s, Status: valueobject.TaskStatusPending, Attempts: 1})
+	}
+	return ErasureJob{
+		id:          uuid.New(),
+		tenantID:    tenantID,
+		kind:        kind,
+		status:      valueobject.JobStatusInProgress,
+		requestedBy: requestedBy,
+		tasks:       tasks,
+		deadline:    now.Add(timeout),
+		version:     1,
+		createdAt:   now,
+		updatedAt:   now,
+	}
+}
+
+// ReconstructErasureJob recreates an ErasureJob from persisted data
+// without validation or events.
+func ReconstructErasureJob(
+	id, tenantID uuid.UUID,
+	kind erasure.Kind,
+	status valueobject.JobStatus,
+	subject erasure.Subject,
+	subjectDigest, reference, category string,
+	cutoff time.Time,
+	requestedBy string,
+	tasks []ErasureTask,
+	deadline time.Time,
+	certificate *Certificate,
+	version int,
+	createdAt, updatedAt time.Time,
+	completedAt *time.Time,
+) ErasureJob {
+	return ErasureJob{
+		id:            id,
+		tenantID:      tenantID,
+		kind:          kind,
+		status:        status,
+		subject:       subject,
+		subjectDigest: subjectDigest,
+		reference:     reference,
+		category:      category,
+		cutoff:        cutoff,
+		requestedBy:   requestedBy,
+		tasks:         tasks,
+		deadline:      deadline,
+		certificate:   certificate,
+		version:       version,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+		completedAt:   completedAt,
+	}
+}
+
+// Request returns the erasure request sent to the participants.
+func (j ErasureJob) Request() erasure.Request {
+	return erasure.Request{
+		JobID:     j.id,
+		TenantID:  j.tenantID,
+		Kind:      j.kind,
+		Subject:   j.subject,
+		Reference: j.reference,
+		Category:  j.category,
+		Cutoff:    j.cutoff,
+	}
+}
+
+// RecordResult records a service's report on its pending task.
+func (j ErasureJob) RecordResult(service string, outcome erasure.Outcome, result erasure.Result, errMsg string, now time.Time) (ErasureJob, error) {
+	i := j.taskIndex(service)
+	if i < 0 {
+		return j, fmt.Errorf("%w: %s", ErrUnknownTask, service)
+	}
+	if !j.IsInProgress() || !j.tasks[i].Status.Equal(valueobject.TaskStatusPending) {
+		return j, fmt.Errorf("%w: %s", ErrTaskSettled, service)
+	}
+	tasks := j.copyTasks()
+	task := &tasks[i]
+	task.CompletedAt = &now
+	if outcome == erasure.OutcomeCompleted {
+		task.Status = valueobject.TaskStatusCompleted
+		task.Erased = result.Erased
+		task.Retained = result.Retained
+		task.Error = ""
+	} else {
+		task.Status = valueobject.TaskStatusFailed
+		task.Error = errMsg
+	}
+	j.tasks = tasks
+	j.version++
+	j.updatedAt = now
+	return j, nil
+}
+
+// ExpireOverdue times out the tasks still pending once the job's deadline
+// has passed. It returns false, leaving the job unchanged, when there are
+// none.
+func (j ErasureJob) ExpireOverdue(now time.Time) (ErasureJob, bool) {
+	if !j.IsInProgress() || now.Before(j.deadline) || j.Settled() {
+		return j, false
+	}
+	tasks := j.copyTasks()
+	for i := range tasks {
+		if tasks[i].Status.Equal(valueobject.TaskStatusPending) {
+			tasks[i].Status = valueobject.TaskStatusTimedOut
+			tasks[i].Error = "no report before the deadline"
+		}
+	}
+	j.tasks = tasks
+	j.version++
+	j.updatedAt = now
+	return j, true
+}
+
+// Finish closes a job every task of which has settled: completed when all
+// of them completed, failed otherwise. certificate is attached to a
+// completed subject erasure, which then forgets the subject's email.
+// Finish is saved together with the RecordResult or ExpireOverdue that
+// settled the last task, so it does not bump the version again.
+func (j ErasureJob) Finish(certificate *Certificate, now time.Time) (ErasureJob, error) {
+	if !j.IsInProgress() {
+		return j, fmt.Errorf("erasure job is already %s", j.status)
+	}
+	if !j.Settled() {
+		return j, fmt.Errorf("erasure job has pending tasks")
+	}
+	erased, retained := j.Totals()
+	if j.Succeeded() {
+		j.status = valueobject.JobStatusCompleted
+		j.certificate = certificate
+		j.subject.Email = ""
+		j.domainEvents = append(j.domainEvents, event.NewErasureJobCompleted(
+			j.id, j.tenantID, string(j.kind), j.subjectDigest, j.reference, j.category, erased, retained))
+	} else {
+		j.status = valueobject.JobStatusFailed
+		j.domainEvents = append(j.domainEvents, event.NewErasureJobFailed(
+			j.id, j.tenantID, string(j.kind), j.subjectDigest, j.reference, j.category, j.unsettledServices(), erased, retained))
+	}
+	j.completedAt = &now
+	j.updatedAt = now
+	return j, nil
+}
+
+// Retry asks the services whose tasks failed or timed out to erase again,
+// giving them until timeout from now. It returns the retried services.
+func (j ErasureJob) Retry(now time.Time, timeout time.Duration) (ErasureJob, []string, error) {
+	if !j.status.Equal(valueobject.JobStatusFailed) {
+		return j, nil, fmt.Errorf("only failed erasure jobs can be retried, job is %s", j.status)
+	}
+	tasks := j.copyTasks()
+	var retried []string
+	for i := range tasks {
+		if tasks[i].Status.Equal(valueobject.TaskStatusCompleted) {
+			continue
+		}
+		tasks[i].Status = valueobject.TaskStatusPending
+		tasks[i].Error = ""
+		tasks[i].CompletedAt = nil
+		tasks[i].Attempts++
+		retried = append(retried, tasks[i].Service)
+	}
+	j.tasks = tasks
+	j.status = valueobject.JobStatusInProgress
+	j.deadline = now.Add(timeout)
+	j.completedAt = nil
+	j.version++
+	j.updatedAt = now
+	for _, service := range retried {
+		j.domainEvents = append(j.domainEvents, erasure.NewErasureRequested(j.Request(), service))
+	}
+	return j, retried, nil
+}
+
+// Settled reports whether no task is pending.
+func (j ErasureJob) Settled() bool {
+	for _, t := range j.tasks {
+		if t.Status.Equal(valueobject.TaskStatusPending) {
+			return false
+		}
+	}
+	return true
+}
+
+// Succeeded reports whether every task completed.
+func (j ErasureJob) Succeeded() bool {
+	for _, t := range j.tasks {
+		if !t.Status.Equal(valueobject.TaskStatusCompleted) {
+			return false
+		}
+	}
+	return true
+}
+
+// Totals returns the number of records erased and retained across tasks.
+func (j ErasureJob) Totals() (erased, retained int) {
+	for _, t := range j.tasks {
+		erased += t.Erased
+		retained += len(t.Retained)
+	}
+	return erased, retained
+}
+
+// IsInProgress returns true while the job waits on services to report.
+func (j ErasureJob) IsInProgress() bool {
+	return j.status.Equal(valueobject.JobStatusInProgress)
+}
+
+func (j ErasureJob) taskIndex(service string) int {
+	for i, t := range j.tasks {
+		if t.Service == service {
+			return i
+		}
+	}
+	return -1
+}
+
+func (j ErasureJob) unsettledServices() []string {
+	var services []string
+	for _, t := range j.tasks {
+		if !t.Status.Equal(valueobject.TaskStatusCompleted) {
+			services = append(services, t.Service)
+		}
+	}
+	return services
+}
+
+// copyTasks copies the tasks so changes do not show through earlier
+// copies of the job.
+func (j ErasureJob) copyTasks() []ErasureTask {
+	tasks := make([]ErasureTask, len(j.tasks))
+	copy(tasks, j.tasks)
+	return tasks
+}
+
+// --- Accessors ---
+
+func (j ErasureJob) ID() uuid.UUID                 { return j.id }
+func (j ErasureJob) TenantID() uuid.UUID           { return j.tenantID }
+func (j ErasureJob) Kind() erasure.Kind            { return j.kind }
+func (j ErasureJob) Status() valueobject.JobStatus { return j.status }
+func (j ErasureJob) Subject() erasure.Subject      { return j.subject }
+func (j ErasureJob) SubjectDigest() string         { return j.subjectDigest }
+func (j ErasureJob) Reference() string             { return j.reference }
+func (j ErasureJob) Category() string              { return j.category }
+func (j ErasureJob) Cutoff() time.Time             { return j.cutoff }
+func (j ErasureJob) RequestedBy() string           { return j.requestedBy }
+func (j ErasureJob) Tasks() []ErasureTask          { return j.tasks }
+func (j ErasureJob) Deadline() time.Time           { return j.deadline }
+func (j ErasureJob) Certificate() *Certificate     { return j.certificate }
+func (j ErasureJob) Version() int                  { return j.version }
+func (j ErasureJob) CreatedAt() time.Time          { return j.createdAt }
+func (j ErasureJob) UpdatedAt() time.Time          { return j.updatedAt }
+func (j ErasureJob) CompletedAt() *time.Time       { return j.completedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (j ErasureJob) DomainEvents() []events.DomainEvent {
+	return j.domainEvents
+}
+
+// ClearDomainEvents returns a copy with cleared domain events.
+func (j ErasureJob) ClearDomainEvents() ErasureJob {
+	j.domainEvents = nil
+	return j
+}
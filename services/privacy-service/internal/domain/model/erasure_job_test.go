@@ -0,0 +1,134 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/valueobject"
+)
+
+var now = time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+func participants(t *testing.T) []model.Participant {
+	t.Helper()
+	identity, err := model.NewParticipant("identity-service", []string{"identity.applicant_data"}, now)
+	require.NoError(t, err)
+	customer, err := model.NewParticipant("customer-service", nil, now)
+	require.NoError(t, err)
+	return []model.Participant{identity, customer}
+}
+
+func TestNewSubjectErasureJob(t *testing.T) {
+	subject := erasure.Subject{Email: " Jane@Example.com "}
+	job, err := model.NewSubjectErasureJob(uuid.New(), subject, "TICKET-1", "officer", participants(t), now, time.Hour)
+	require.NoError(t, err)
+
+	assert.True(t, job.IsInProgress())
+	assert.Equal(t, now.Add(time.Hour), job.Deadline())
+	assert.Equal(t, erasure.EmailDigest("jane@example.com"), job.SubjectDigest())
+	require.Len(t, job.Tasks(), 2)
+	assert.Equal(t, valueobject.TaskStatusPending, job.Tasks()[0].Status)
+	require.Len(t, job.DomainEvents(), 1)
+	requested, ok := job.DomainEvents()[0].(erasure.ErasureRequested)
+	require.True(t, ok)
+	assert.Empty(t, requested.Service, "sent to every participant")
+	assert.Equal(t, "Jane@Example.com", requested.Request.Subject.Email)
+	assert.Equal(t, job.ID(), requested.Request.JobID)
+
+	_, err = model.NewSubjectErasureJob(uuid.New(), erasure.Subject{}, "TICKET-1", "officer", participants(t), now, time.Hour)
+	assert.Error(t, err, "no subject")
+	_, err = model.NewSubjectErasureJob(uuid.New(), subject, "", "officer", participants(t), now, time.Hour)
+	assert.Error(t, err, "no reference")
+	_, err = model.NewSubjectErasureJob(uuid.New(), subject, "TICKET-1", "officer", nil, now, time.Hour)
+	assert.ErrorIs(t, err, model.ErrNoParticipants)
+}
+
+func TestNewRetentionJob_OnlyParticipantsHandlingTheCategory(t *testing.T) {
+	cutoff := now.AddDate(0, 0, -30)
+	job, err := model.NewRetentionJob(uuid.New(), "identity.applicant_data", cutoff, participants(t), now, time.Hour)
+	require.NoError(t, err)
+	require.Len(t, job.Tasks(), 1)
+	assert.Equal(t, "identity-service", job.Tasks()[0].Service)
+	assert.Equal(t, cutoff, job.Request().Cutoff)
+
+	_, err = model.NewRetentionJob(uuid.New(), "cards.pan", cutoff, participants(t), now, time.Hour)
+	assert.ErrorIs(t, err, model.ErrNoParticipants)
+}
+
+func TestErasureJob_CompletesAndForgetsEmail(t *testing.T) {
+	job, err := model.NewSubjectErasureJob(uuid.New(), erasure.Subject{Email: "jane@example.com"}, "TICKET-1", "officer",
+		participants(t), now, time.Hour)
+	require.NoError(t, err)
+	job = job.ClearDomainEvents()
+
+	job, err = job.RecordResult("identity-service", erasure.OutcomeCompleted, erasure.Result{Erased: 2}, "", now)
+	require.NoError(t, err)
+	assert.False(t, job.Settled())
+	_, err = job.Finish(nil, now)
+	assert.Error(t, err, "tasks pending")
+
+	_, err = job.RecordResult("identity-service", erasure.OutcomeCompleted, erasure.Result{}, "", now)
+	assert.ErrorIs(t, err, model.ErrTaskSettled)
+	_, err = job.RecordResult("card-service", erasure.OutcomeCompleted, erasure.Result{}, "", now)
+	assert.ErrorIs(t, err, model.ErrUnknownTask)
+
+	retained := []erasure.Retained{{Record: "party/1", Reason: "open account"}}
+	job, err = job.RecordResult("customer-service", erasure.OutcomeCompleted, erasure.Result{Erased: 1, Retained: retained}, "", now)
+	require.NoError(t, err)
+	require.True(t, job.Settled())
+	require.True(t, job.Succeeded())
+	erased, kept := job.Totals()
+	assert.Equal(t, 3, erased)
+	assert.Equal(t, 1, kept)
+
+	job, err = job.Finish(&model.Certificate{Signature: "sig"}, now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.JobStatusCompleted, job.Status())
+	assert.Empty(t, job.Subject().Email, "the coordinator forgets the address")
+	assert.NotEmpty(t, job.SubjectDigest())
+	require.NotNil(t, job.Certificate())
+	require.Len(t, job.DomainEvents(), 1)
+	assert.Equal(t, "privacy.erasure.job_completed", job.DomainEvents()[0].EventType())
+}
+
+func TestErasureJob_TimeoutAndRetry(t *testing.T) {
+	job, err := model.NewSubjectErasureJob(uuid.New(), erasure.Subject{Email: "jane@example.com"}, "TICKET-1", "officer",
+		participants(t), now, time.Hour)
+	require.NoError(t, err)
+	job = job.ClearDomainEvents()
+	job, err = job.RecordResult("identity-service", erasure.OutcomeCompleted, erasure.Result{Erased: 1}, "", now)
+	require.NoError(t, err)
+
+	_, changed := job.ExpireOverdue(now.Add(30 * time.Minute))
+	assert.False(t, changed, "not yet overdue")
+	job, changed = job.ExpireOverdue(now.Add(2 * time.Hour))
+	require.True(t, changed)
+	assert.Equal(t, valueobject.TaskStatusTimedOut, job.Tasks()[1].Status)
+
+	job, err = job.Finish(nil, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.JobStatusFailed, job.Status())
+	assert.Equal(t, "jane@example.com", job.Subject().Email, "kept for the retry")
+	assert.Equal(t, "privacy.erasure.job_failed", job.DomainEvents()[0].EventType())
+	job = job.ClearDomainEvents()
+
+	job, retried, err := job.Retry(now.Add(3*time.Hour), time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"customer-service"}, retried)
+	assert.True(t, job.IsInProgress())
+	assert.Equal(t, now.Add(4*time.Hour), job.Deadline())
+	assert.Equal(t, 2, job.Tasks()[1].Attempts)
+	assert.Equal(t, valueobject.TaskStatusCompleted, job.Tasks()[0].Status, "completed tasks are not retried")
+	require.Len(t, job.DomainEvents(), 1)
+	requested := job.DomainEvents()[0].(erasure.ErasureRequested)
+	assert.Equal(t, "customer-service", requested.Service)
+
+	_, _, err = job.Retry(now, time.Hour)
+	assert.Error(t, err, "only failed jobs are retried")
+}
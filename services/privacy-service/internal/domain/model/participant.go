@@ -0,0 +1,52 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Participant is a service registered to take part in erasures. Every
+// registered participant is asked to erase a data subject; retention runs
+// only go to the participants declaring the policy's category.
+type Participant struct {
+	registeredAt time.Time
+	service      string
+	categories   []string
+}
+
+// NewParticipant registers a service and the data categories it erases on
+// retention runs.
+func NewParticipant(service string, categories []string, now time.Time) (Participant, error) {
+	service = strings.TrimSpace(service)
+	if service == "" {
+		return Participant{}, fmt.Errorf("participant service must not be empty")
+	}
+	cleaned := make([]string, 0, len(categories))
+	for _, c := range categories {
+		if c = strings.TrimSpace(c); c != "" && !slices.Contains(cleaned, c) {
+			cleaned = append(cleaned, c)
+		}
+	}
+	slices.Sort(cleaned)
+	return Participant{service: service, categories: cleaned, registeredAt: now}, nil
+}
+
+// ReconstructParticipant recreates a Participant from persisted data
+// without validation.
+func ReconstructParticipant(service string, categories []string, registeredAt time.Time) Participant {
+	return Participant{service: service, categories: categories, registeredAt: registeredAt}
+}
+
+// Handles reports whether the participant erases a data category on
+// retention runs.
+func (p Participant) Handles(category string) bool {
+	return slices.Contains(p.categories, category)
+}
+
+// --- Accessors ---
+
+func (p Participant) Service() string         { return p.service }
+func (p Participant) Categories() []string    { return p.categories }
+func (p Participant) RegisteredAt() time.Time { return p.registeredAt }
@@ -0,0 +1,121 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxRetentionDays bounds a retention period at a hundred years.
+const MaxRetentionDays = 36500
+
+// RetentionPolicy keeps a tenant's records of a data category for a number
+// of days. Once a day a retention job erases the records kept longer.
+type RetentionPolicy struct {
+	lastRunOn     time.Time
+	createdAt     time.Time
+	updatedAt     time.Time
+	category      string
+	retentionDays int
+	version       int
+	tenantID      uuid.UUID
+}
+
+// NewRetentionPolicy creates a retention policy for a tenant's data
+// category.
+func NewRetentionPolicy(tenantID uuid.UUID, category string, retentionDays int, now time.Time) (RetentionPolicy, error) {
+	if tenantID == uuid.Nil {
+		return RetentionPolicy{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	category = strings.TrimSpace(category)
+	if category == "" {
+		return RetentionPolicy{}, fmt.Errorf("category must not be empty")
+	}
+	if err := validateRetentionDays(retentionDays); err != nil {
+		return RetentionPolicy{}, err
+	}
+	return RetentionPolicy{
+		tenantID:      tenantID,
+		category:      category,
+		retentionDays: retentionDays,
+		version:       1,
+		createdAt:     now,
+		updatedAt:     now,
+	}, nil
+}
+
+// ReconstructRetentionPolicy recreates a RetentionPolicy from persisted
+// data without validation.
+func ReconstructRetentionPolicy(
+	tenantID uuid.UUID,
+	category string,
+	retentionDays int,
+	lastRunOn time.Time,
+	version int,
+	createdAt, updatedAt time.Time,
+) RetentionPolicy {
+	return RetentionPolicy{
+		tenantID:      tenantID,
+		category:      category,
+		retentionDays: retentionDays,
+		lastRunOn:     lastRunOn,
+		version:       version,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
+
+// Update changes the retention period.
+func (p RetentionPolicy) Update(retentionDays int, now time.Time) (RetentionPolicy, error) {
+	if err := validateRetentionDays(retentionDays); err != nil {
+		return p, err
+	}
+	p.retentionDays = retentionDays
+	p.version++
+	p.updatedAt = now
+	return p, nil
+}
+
+// Due reports whether the policy has not run yet on a day.
+func (p RetentionPolicy) Due(today time.Time) bool {
+	return p.lastRunOn.IsZero() || p.lastRunOn.Before(DateOf(today))
+}
+
+// Cutoff returns the time before which records are kept longer than the
+// policy allows on a day.
+func (p RetentionPolicy) Cutoff(today time.Time) time.Time {
+	return DateOf(today).AddDate(0, 0, -p.retentionDays)
+}
+
+// RecordRun marks the policy as run on a day.
+func (p RetentionPolicy) RecordRun(today, now time.Time) RetentionPolicy {
+	p.lastRunOn = DateOf(today)
+	p.version++
+	p.updatedAt = now
+	return p
+}
+
+func validateRetentionDays(days int) error {
+	if days < 1 || days > MaxRetentionDays {
+		return fmt.Errorf("retention days must be between 1 and %d", MaxRetentionDays)
+	}
+	return nil
+}
+
+// DateOf truncates a time to its UTC day.
+func DateOf(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// --- Accessors ---
+
+func (p RetentionPolicy) TenantID() uuid.UUID  { return p.tenantID }
+func (p RetentionPolicy) Category() string     { return p.category }
+func (p RetentionPolicy) RetentionDays() int   { return p.retentionDays }
+func (p RetentionPolicy) LastRunOn() time.Time { return p.lastRunOn }
+func (p RetentionPolicy) Version() int         { return p.version }
+func (p RetentionPolicy) CreatedAt() time.Time { return p.createdAt }
+func (p RetentionPolicy) UpdatedAt() time.Time { return p.updatedAt }
@@ -0,0 +1,35 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+)
+
+func TestRetentionPolicy_DueOncePerDay(t *testing.T) {
+	p, err := model.NewRetentionPolicy(uuid.New(), "identity.applicant_data", 30, now)
+	require.NoError(t, err)
+	assert.True(t, p.Due(now), "never run")
+	assert.Equal(t, time.Date(2026, 2, 8, 0, 0, 0, 0, time.UTC), p.Cutoff(now))
+
+	p = p.RecordRun(now, now)
+	assert.False(t, p.Due(now.Add(time.Hour)))
+	assert.True(t, p.Due(now.AddDate(0, 0, 1)))
+	assert.Equal(t, 2, p.Version())
+
+	p, err = p.Update(90, now)
+	require.NoError(t, err)
+	assert.Equal(t, 90, p.RetentionDays())
+
+	_, err = p.Update(0, now)
+	assert.Error(t, err)
+	_, err = model.NewRetentionPolicy(uuid.New(), " ", 30, now)
+	assert.Error(t, err)
+	_, err = model.NewRetentionPolicy(uuid.New(), "x", model.MaxRetentionDays+1, now)
+	assert.Error(t, err)
+}
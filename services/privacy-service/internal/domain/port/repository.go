@@ -0,0 +1,84 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/event"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/valueobject"
+)
+
+// ErrJobNotFound is returned when an erasure job does not exist.
+var ErrJobNotFound = errors.New("erasure job not found")
+
+// ErrPolicyNotFound is returned when a retention policy does not exist.
+var ErrPolicyNotFound = errors.New("retention policy not found")
+
+// ErrVersionConflict is returned when an aggregate was modified concurrently.
+var ErrVersionConflict = errors.New("version conflict")
+
+// ParticipantRepository defines the persistence port for the registry of
+// participating services.
+type ParticipantRepository interface {
+	// Save registers a participant, replacing its earlier registration.
+	Save(ctx context.Context, participant model.Participant) error
+
+	// List returns every registered participant, by service.
+	List(ctx context.Context) ([]model.Participant, error)
+}
+
+// JobFilter narrows a listing of a tenant's erasure jobs. Zero fields do
+// not filter.
+type JobFilter struct {
+	Kind     erasure.Kind
+	Status   valueobject.JobStatus
+	TenantID uuid.UUID
+}
+
+// JobRepository defines the persistence port for erasure jobs.
+type JobRepository interface {
+	// Save persists a job with optimistic locking on its version.
+	Save(ctx context.Context, job model.ErasureJob) error
+
+	// FindByID retrieves a job of any tenant; results reported by the
+	// participants carry no tenant to scope the lookup by.
+	FindByID(ctx context.Context, id uuid.UUID) (model.ErasureJob, error)
+
+	// List returns a page of the jobs matching the filter, newest first,
+	// and the total number of them.
+	List(ctx context.Context, filter JobFilter, limit, offset int) ([]model.ErasureJob, int, error)
+
+	// ListOverdue returns up to limit in-progress jobs of any tenant whose
+	// deadline is before now.
+	ListOverdue(ctx context.Context, now time.Time, limit int) ([]model.ErasureJob, error)
+}
+
+// RetentionPolicyRepository defines the persistence port for retention
+// policies.
+type RetentionPolicyRepository interface {
+	// Save persists a policy with optimistic locking on its version.
+	Save(ctx context.Context, policy model.RetentionPolicy) error
+
+	// Find retrieves a tenant's policy for a data category.
+	Find(ctx context.Context, tenantID uuid.UUID, category string) (model.RetentionPolicy, error)
+
+	// List returns a tenant's policies, by category.
+	List(ctx context.Context, tenantID uuid.UUID) ([]model.RetentionPolicy, error)
+
+	// Delete removes a tenant's policy for a data category.
+	Delete(ctx context.Context, tenantID uuid.UUID, category string) error
+
+	// ListTenants returns the tenants with at least one policy.
+	ListTenants(ctx context.Context) ([]uuid.UUID, error)
+}
+
+// EventPublisher defines the port for publishing domain events.
+type EventPublisher interface {
+	// Publish sends domain events to the event bus.
+	Publish(ctx context.Context, events []event.DomainEvent) error
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+)
+
+// CertificateAlgorithm names how certificates are signed.
+const CertificateAlgorithm = "HMAC-SHA256"
+
+// ErrInvalidCertificate is returned when a certificate's document does not
+// match its digest or signature.
+var ErrInvalidCertificate = errors.New("invalid certificate of erasure")
+
+// Certifier issues and verifies certificates of erasure. Certificates are
+// signed with a key only the privacy service holds, so one presented later
+// can be shown to have been issued by it unaltered.
+type Certifier struct {
+	key []byte
+}
+
+// NewCertifier creates a Certifier signing with key.
+func NewCertifier(key []byte) (Certifier, error) {
+	if len(key) < 16 {
+		return Certifier{}, fmt.Errorf("certificate key must be at least 16 bytes")
+	}
+	return Certifier{key: key}, nil
+}
+
+// Issue certifies a subject erasure every service completed.
+func (c Certifier) Issue(job model.ErasureJob, now time.Time) (model.Certificate, error) {
+	if job.Kind() != erasure.KindSubject {
+		return model.Certificate{}, fmt.Errorf("only subject erasures are certified")
+	}
+	if !job.Settled() || !job.Succeeded() {
+		return model.Certificate{}, fmt.Errorf("erasure job has not completed on every service")
+	}
+
+	services := make([]model.CertifiedService, 0, len(job.Tasks()))
+	completedAt := job.CreatedAt()
+	for _, t := range job.Tasks() {
+		services = append(services, model.CertifiedService{
+			Service:  t.Service,
+			Erased:   t.Erased,
+			Retained: t.Retained,
+		})
+		if t.CompletedAt != nil && t.CompletedAt.After(completedAt) {
+			completedAt = *t.CompletedAt
+		}
+	}
+	body := model.CertificateBody{
+		JobID:              job.ID(),
+		TenantID:           job.TenantID(),
+		SubjectEmailSHA256: job.SubjectDigest(),
+		CustomerID:         job.Subject().CustomerID,
+		Reference:          job.Reference(),
+		Services:           services,
+		RequestedAt:        job.CreatedAt().UTC(),
+		CompletedAt:        completedAt.UTC(),
+		IssuedAt:           now.UTC(),
+	}
+	document, err := json.Marshal(body)
+	if err != nil {
+		return model.Certificate{}, fmt.Errorf("failed to encode certificate: %w", err)
+	}
+	digest := sha256.Sum256(document)
+	return model.Certificate{
+		Body:      body,
+		Document:  string(document),
+		Digest:    hex.EncodeToString(digest[:]),
+		Signature: c.sign(document),
+		Algorithm: CertificateAlgorithm,
+	}, nil
+}
+
+// Verify checks that a certificate's document was signed by the certifier
+// and matches its digest.
+func (c Certifier) Verify(cert model.Certificate) error {
+	digest := sha256.Sum256([]byte(cert.Document))
+	if hex.EncodeToString(digest[:]) != cert.Digest {
+		return fmt.Errorf("%w: digest mismatch", ErrInvalidCertificate)
+	}
+	if !hmac.Equal([]byte(c.sign([]byte(cert.Document))), []byte(cert.Signature)) {
+		return fmt.Errorf("%w: signature mismatch", ErrInvalidCertificate)
+	}
+	return nil
+}
+
+func (c Certifier) sign(document []byte) string {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write(document)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,78 @@
+package service_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/service"
+)
+
+var now = time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+func completedJob(t *testing.T) model.ErasureJob {
+	t.Helper()
+	p, err := model.NewParticipant("identity-service", nil, now)
+	require.NoError(t, err)
+	customerID := uuid.New()
+	job, err := model.NewSubjectErasureJob(uuid.New(), erasure.Subject{Email: "jane@example.com", CustomerID: customerID},
+		"TICKET-1", "officer", []model.Participant{p}, now, time.Hour)
+	require.NoError(t, err)
+	job, err = job.RecordResult("identity-service", erasure.OutcomeCompleted, erasure.Result{Erased: 2}, "", now.Add(time.Minute))
+	require.NoError(t, err)
+	return job
+}
+
+func TestCertifier_IssueAndVerify(t *testing.T) {
+	certifier, err := service.NewCertifier([]byte("0123456789abcdef"))
+	require.NoError(t, err)
+	job := completedJob(t)
+
+	cert, err := certifier.Issue(job, now.Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, service.CertificateAlgorithm, cert.Algorithm)
+	assert.Equal(t, job.ID(), cert.Body.JobID)
+	assert.Equal(t, now.Add(time.Minute), cert.Body.CompletedAt)
+	assert.NotContains(t, cert.Document, "jane@example.com", "the certificate holds no email")
+
+	var body model.CertificateBody
+	require.NoError(t, json.Unmarshal([]byte(cert.Document), &body))
+	assert.Equal(t, job.SubjectDigest(), body.SubjectEmailSHA256)
+	require.Len(t, body.Services, 1)
+	assert.Equal(t, 2, body.Services[0].Erased)
+
+	require.NoError(t, certifier.Verify(cert))
+	tampered := cert
+	tampered.Document = cert.Document[:len(cert.Document)-1] + " }"
+	assert.ErrorIs(t, certifier.Verify(tampered), service.ErrInvalidCertificate)
+
+	other, err := service.NewCertifier([]byte("another-key-0123"))
+	require.NoError(t, err)
+	assert.ErrorIs(t, other.Verify(cert), service.ErrInvalidCertificate, "signed with another key")
+}
+
+func TestCertifier_RejectsUncompletedJobs(t *testing.T) {
+	certifier, err := service.NewCertifier([]byte("0123456789abcdef"))
+	require.NoError(t, err)
+	p, err := model.NewParticipant("identity-service", nil, now)
+	require.NoError(t, err)
+	job, err := model.NewSubjectErasureJob(uuid.New(), erasure.Subject{Email: "jane@example.com"},
+		"TICKET-1", "officer", []model.Participant{p}, now, time.Hour)
+	require.NoError(t, err)
+
+	_, err = certifier.Issue(job, now)
+	assert.Error(t, err, "pending")
+	failed, err := job.RecordResult("identity-service", erasure.OutcomeFailed, erasure.Result{}, "boom", now)
+	require.NoError(t, err)
+	_, err = certifier.Issue(failed, now)
+	assert.Error(t, err, "failed")
+
+	_, err = service.NewCertifier([]byte("short"))
+	assert.Error(t, err)
+}
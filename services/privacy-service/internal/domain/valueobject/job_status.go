@@ -0,0 +1,55 @@
+package valueobject
+
+import "fmt"
+
+// JobStatus is where an erasure job stands.
+// It is an immutable value object.
+type JobStatus struct {
+	value string
+}
+
+const (
+	jobStatusInProgress = "IN_PROGRESS"
+	jobStatusCompleted  = "COMPLETED"
+	jobStatusFailed     = "FAILED"
+)
+
+var (
+	// JobStatusInProgress is a job waiting on services to report.
+	JobStatusInProgress = JobStatus{value: jobStatusInProgress}
+	// JobStatusCompleted is a job every service completed.
+	JobStatusCompleted = JobStatus{value: jobStatusCompleted}
+	// JobStatusFailed is a job at least one service failed or did not
+	// report in time. It can be retried.
+	JobStatusFailed = JobStatus{value: jobStatusFailed}
+)
+
+var validJobStatuses = map[string]JobStatus{
+	jobStatusInProgress: JobStatusInProgress,
+	jobStatusCompleted:  JobStatusCompleted,
+	jobStatusFailed:     JobStatusFailed,
+}
+
+// NewJobStatus creates a JobStatus from a string, validating it is known.
+func NewJobStatus(s string) (JobStatus, error) {
+	v, ok := validJobStatuses[s]
+	if !ok {
+		return JobStatus{}, fmt.Errorf("invalid job status: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the JobStatus.
+func (j JobStatus) String() string {
+	return j.value
+}
+
+// IsZero returns true if the JobStatus has not been set.
+func (j JobStatus) IsZero() bool {
+	return j.value == ""
+}
+
+// Equal returns true if two JobStatus values are equal.
+func (j JobStatus) Equal(other JobStatus) bool {
+	return j.value == other.value
+}
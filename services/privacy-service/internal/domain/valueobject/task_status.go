@@ -0,0 +1,59 @@
+package valueobject
+
+import "fmt"
+
+// TaskStatus is where one service's part in an erasure job stands.
+// It is an immutable value object.
+type TaskStatus struct {
+	value string
+}
+
+const (
+	taskStatusPending   = "PENDING"
+	taskStatusCompleted = "COMPLETED"
+	taskStatusFailed    = "FAILED"
+	taskStatusTimedOut  = "TIMED_OUT"
+)
+
+var (
+	// TaskStatusPending is a task the service has not reported on yet.
+	TaskStatusPending = TaskStatus{value: taskStatusPending}
+	// TaskStatusCompleted is a task the service reported erased.
+	TaskStatusCompleted = TaskStatus{value: taskStatusCompleted}
+	// TaskStatusFailed is a task the service reported failed.
+	TaskStatusFailed = TaskStatus{value: taskStatusFailed}
+	// TaskStatusTimedOut is a task the service did not report on before
+	// the job's deadline.
+	TaskStatusTimedOut = TaskStatus{value: taskStatusTimedOut}
+)
+
+var validTaskStatuses = map[string]TaskStatus{
+	taskStatusPending:   TaskStatusPending,
+	taskStatusCompleted: TaskStatusCompleted,
+	taskStatusFailed:    TaskStatusFailed,
+	taskStatusTimedOut:  TaskStatusTimedOut,
+}
+
+// NewTaskStatus creates a TaskStatus from a string, validating it is known.
+func NewTaskStatus(s string) (TaskStatus, error) {
+	v, ok := validTaskStatuses[s]
+	if !ok {
+		return TaskStatus{}, fmt.Errorf("invalid task status: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the TaskStatus.
+func (t TaskStatus) String() string {
+	return t.value
+}
+
+// IsZero returns true if the TaskStatus has not been set.
+func (t TaskStatus) IsZero() bool {
+	return t.value == ""
+}
+
+// Equal returns true if two TaskStatus values are equal.
+func (t TaskStatus) Equal(other TaskStatus) bool {
+	return t.value == other.value
+}
@@ -0,0 +1,216 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/port"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/valueobject"
+)
+
+const jobColumns = `
+	id, tenant_id, kind, status, subject_email, subject_email_sha256, customer_id, reference, category,
+	cutoff, requested_by, tasks, deadline, certificate, version, created_at, updated_at, completed_at`
+
+// taskRow is the JSON form of an erasure task in the tasks column.
+type taskRow struct {
+	model.ErasureTask
+	Status string `json:"status"`
+}
+
+// JobRepo is the PostgreSQL implementation of JobRepository.
+type JobRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewJobRepo creates a new JobRepo.
+func NewJobRepo(pool *pgxpool.Pool) *JobRepo {
+	return &JobRepo{pool: pool}
+}
+
+// Save persists the job with optimistic locking on its version.
+func (r *JobRepo) Save(ctx context.Context, j model.ErasureJob) error {
+	query := `
+		INSERT INTO erasure_jobs (` + jobColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			subject_email = EXCLUDED.subject_email,
+			tasks = EXCLUDED.tasks,
+			deadline = EXCLUDED.deadline,
+			certificate = EXCLUDED.certificate,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at,
+			completed_at = EXCLUDED.completed_at
+		WHERE erasure_jobs.version = EXCLUDED.version - 1
+	`
+	rows := make([]taskRow, 0, len(j.Tasks()))
+	for _, t := range j.Tasks() {
+		rows = append(rows, taskRow{ErasureTask: t, Status: t.Status.String()})
+	}
+	tasks, err := json.Marshal(rows)
+	if err != nil {
+		return fmt.Errorf("failed to marshal erasure tasks: %w", err)
+	}
+	var certificate []byte
+	if j.Certificate() != nil {
+		if certificate, err = json.Marshal(j.Certificate()); err != nil {
+			return fmt.Errorf("failed to marshal certificate: %w", err)
+		}
+	}
+	var customerID *uuid.UUID
+	if id := j.Subject().CustomerID; id != uuid.Nil {
+		customerID = &id
+	}
+	var cutoff *time.Time
+	if !j.Cutoff().IsZero() {
+		c := j.Cutoff()
+		cutoff = &c
+	}
+
+	result, err := r.pool.Exec(ctx, query,
+		j.ID(), j.TenantID(), string(j.Kind()), j.Status().String(), j.Subject().Email, j.SubjectDigest(),
+		customerID, j.Reference(), j.Category(), cutoff, j.RequestedBy(), tasks, j.Deadline(), certificate,
+		j.Version(), j.CreatedAt(), j.UpdatedAt(), j.CompletedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert erasure job: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: erasure job %s", port.ErrVersionConflict, j.ID())
+	}
+	return nil
+}
+
+// FindByID retrieves a job of any tenant.
+func (r *JobRepo) FindByID(ctx context.Context, id uuid.UUID) (model.ErasureJob, error) {
+	query := `SELECT ` + jobColumns + ` FROM erasure_jobs WHERE id = $1`
+
+	j, err := scanJob(r.pool.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.ErasureJob{}, port.ErrJobNotFound
+	}
+	if err != nil {
+		return model.ErasureJob{}, fmt.Errorf("failed to scan erasure job: %w", err)
+	}
+	return j, nil
+}
+
+// List returns a page of the jobs matching the filter, newest first, and
+// the total number of them.
+func (r *JobRepo) List(ctx context.Context, filter port.JobFilter, limit, offset int) ([]model.ErasureJob, int, error) {
+	const where = `WHERE tenant_id = $1
+		AND ($2 = '' OR kind = $2)
+		AND ($3 = '' OR status = $3)`
+	args := []any{filter.TenantID, string(filter.Kind), filter.Status.String()}
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM erasure_jobs `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count erasure jobs: %w", err)
+	}
+	jobs, err := r.query(ctx, `SELECT `+jobColumns+` FROM erasure_jobs `+where+`
+		ORDER BY created_at DESC
+		LIMIT $4 OFFSET $5
+	`, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return jobs, total, nil
+}
+
+// ListOverdue returns up to limit in-progress jobs whose deadline is
+// before now.
+func (r *JobRepo) ListOverdue(ctx context.Context, now time.Time, limit int) ([]model.ErasureJob, error) {
+	return r.query(ctx, `SELECT `+jobColumns+` FROM erasure_jobs
+		WHERE status = 'IN_PROGRESS' AND deadline < $1
+		ORDER BY deadline
+		LIMIT $2
+	`, now, limit)
+}
+
+func (r *JobRepo) query(ctx context.Context, query string, args ...any) ([]model.ErasureJob, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query erasure jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []model.ErasureJob
+	for rows.Next() {
+		j, err := scanJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan erasure job row: %w", err)
+		}
+		jobs = append(jobs, j)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return jobs, nil
+}
+
+func scanJob(row pgx.Row) (model.ErasureJob, error) {
+	var (
+		id, tenantID                   uuid.UUID
+		kind, status                   string
+		email, digest, reference       string
+		customerID                     *uuid.UUID
+		category, requestedBy          string
+		cutoff, completedAt            *time.Time
+		tasksJSON, certificateJSON     []byte
+		deadline, createdAt, updatedAt time.Time
+		version                        int
+	)
+
+	err := row.Scan(&id, &tenantID, &kind, &status, &email, &digest, &customerID, &reference, &category,
+		&cutoff, &requestedBy, &tasksJSON, &deadline, &certificateJSON, &version, &createdAt, &updatedAt, &completedAt)
+	if err != nil {
+		return model.ErasureJob{}, err
+	}
+
+	jobStatus, err := valueobject.NewJobStatus(status)
+	if err != nil {
+		return model.ErasureJob{}, fmt.Errorf("invalid status in database: %w", err)
+	}
+	var rows []taskRow
+	if err := json.Unmarshal(tasksJSON, &rows); err != nil {
+		return model.ErasureJob{}, fmt.Errorf("failed to unmarshal erasure tasks: %w", err)
+	}
+	tasks := make([]model.ErasureTask, 0, len(rows))
+	for _, row := range rows {
+		task := row.ErasureTask
+		if task.Status, err = valueobject.NewTaskStatus(row.Status); err != nil {
+			return model.ErasureJob{}, fmt.Errorf("invalid task status in database: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+	var certificate *model.Certificate
+	if len(certificateJSON) > 0 {
+		certificate = &model.Certificate{}
+		if err := json.Unmarshal(certificateJSON, certificate); err != nil {
+			return model.ErasureJob{}, fmt.Errorf("failed to unmarshal certificate: %w", err)
+		}
+	}
+	subject := erasure.Subject{Email: email}
+	if customerID != nil {
+		subject.CustomerID = *customerID
+	}
+	var cutoffAt time.Time
+	if cutoff != nil {
+		cutoffAt = cutoff.UTC()
+	}
+
+	return model.ReconstructErasureJob(
+		id, tenantID, erasure.Kind(kind), jobStatus, subject, digest, reference, category, cutoffAt,
+		requestedBy, tasks, deadline, certificate, version, createdAt, updatedAt, completedAt,
+	), nil
+}
@@ -0,0 +1,7 @@
+-- Services registered to take part in erasures, with the data categories
+-- they erase on retention runs. Services re-register on every start.
+CREATE TABLE IF NOT EXISTS erasure_participants (
+    service VARCHAR(100) PRIMARY KEY,
+    categories TEXT[] NOT NULL DEFAULT '{}',
+    registered_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,26 @@
+-- Erasures fanned out to the participants. tasks holds each service's
+-- report; subject_email is cleared once a subject erasure completes,
+-- leaving only its digest.
+CREATE TABLE IF NOT EXISTS erasure_jobs (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    subject_email TEXT NOT NULL DEFAULT '',
+    subject_email_sha256 CHAR(64) NOT NULL DEFAULT '',
+    customer_id UUID,
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    category VARCHAR(100) NOT NULL DEFAULT '',
+    cutoff TIMESTAMPTZ,
+    requested_by VARCHAR(255) NOT NULL DEFAULT '',
+    tasks JSONB NOT NULL DEFAULT '[]',
+    deadline TIMESTAMPTZ NOT NULL,
+    certificate JSONB,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_erasure_jobs_tenant ON erasure_jobs (tenant_id, created_at DESC);
+CREATE INDEX idx_erasure_jobs_in_progress ON erasure_jobs (deadline) WHERE status = 'IN_PROGRESS';
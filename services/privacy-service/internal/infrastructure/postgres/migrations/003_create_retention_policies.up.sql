@@ -0,0 +1,11 @@
+-- How long each tenant keeps the records of a data category.
+CREATE TABLE IF NOT EXISTS retention_policies (
+    tenant_id UUID NOT NULL,
+    category VARCHAR(100) NOT NULL,
+    retention_days INT NOT NULL,
+    last_run_on DATE,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, category)
+);
@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/pkg/outbox"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/event"
+)
+
+// OutboxPublisher implements port.EventPublisher by writing events to the
+// outbox, from which the relay publishes them. Erasure requests go to the
+// participants on erasure.Topic, every other event to topic.
+type OutboxPublisher struct {
+	publisher *outbox.Publisher
+	topic     string
+}
+
+// NewOutboxPublisher creates a new OutboxPublisher.
+func NewOutboxPublisher(publisher *outbox.Publisher, topic string) *OutboxPublisher {
+	return &OutboxPublisher{publisher: publisher, topic: topic}
+}
+
+// Publish writes the events to the outbox.
+func (p *OutboxPublisher) Publish(ctx context.Context, events []event.DomainEvent) error {
+	var requests, others []event.DomainEvent
+	for _, evt := range events {
+		if evt.EventType() == erasure.EventErasureRequested {
+			requests = append(requests, evt)
+		} else {
+			others = append(others, evt)
+		}
+	}
+	if len(requests) > 0 {
+		if err := p.publisher.Publish(ctx, erasure.Topic, requests...); err != nil {
+			return err
+		}
+	}
+	if len(others) > 0 {
+		return p.publisher.Publish(ctx, p.topic, others...)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+)
+
+// ParticipantRepo is the PostgreSQL implementation of ParticipantRepository.
+type ParticipantRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewParticipantRepo creates a new ParticipantRepo.
+func NewParticipantRepo(pool *pgxpool.Pool) *ParticipantRepo {
+	return &ParticipantRepo{pool: pool}
+}
+
+// Save registers the participant, replacing its earlier registration.
+func (r *ParticipantRepo) Save(ctx context.Context, p model.Participant) error {
+	query := `
+		INSERT INTO erasure_participants (service, categories, registered_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (service) DO UPDATE SET
+			categories = EXCLUDED.categories,
+			registered_at = EXCLUDED.registered_at
+	`
+	if _, err := r.pool.Exec(ctx, query, p.Service(), p.Categories(), p.RegisteredAt()); err != nil {
+		return fmt.Errorf("failed to upsert erasure participant: %w", err)
+	}
+	return nil
+}
+
+// List returns every registered participant, by service.
+func (r *ParticipantRepo) List(ctx context.Context) ([]model.Participant, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT service, categories, registered_at FROM erasure_participants ORDER BY service
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query erasure participants: %w", err)
+	}
+	defer rows.Close()
+
+	var participants []model.Participant
+	for rows.Next() {
+		var (
+			service      string
+			categories   []string
+			registeredAt time.Time
+		)
+		if err := rows.Scan(&service, &categories, &registeredAt); err != nil {
+			return nil, fmt.Errorf("failed to scan erasure participant row: %w", err)
+		}
+		participants = append(participants, model.ReconstructParticipant(service, categories, registeredAt))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return participants, nil
+}
@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/port"
+)
+
+const policyColumns = `tenant_id, category, retention_days, last_run_on, version, created_at, updated_at`
+
+// RetentionPolicyRepo is the PostgreSQL implementation of
+// RetentionPolicyRepository.
+type RetentionPolicyRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewRetentionPolicyRepo creates a new RetentionPolicyRepo.
+func NewRetentionPolicyRepo(pool *pgxpool.Pool) *RetentionPolicyRepo {
+	return &RetentionPolicyRepo{pool: pool}
+}
+
+// Save persists the policy with optimistic locking on its version.
+func (r *RetentionPolicyRepo) Save(ctx context.Context, p model.RetentionPolicy) error {
+	query := `
+		INSERT INTO retention_policies (` + policyColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (tenant_id, category) DO UPDATE SET
+			retention_days = EXCLUDED.retention_days,
+			last_run_on = EXCLUDED.last_run_on,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE retention_policies.version = EXCLUDED.version - 1
+	`
+	var lastRunOn *time.Time
+	if !p.LastRunOn().IsZero() {
+		d := p.LastRunOn()
+		lastRunOn = &d
+	}
+	result, err := r.pool.Exec(ctx, query,
+		p.TenantID(), p.Category(), p.RetentionDays(), lastRunOn, p.Version(), p.CreatedAt(), p.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert retention policy: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: retention policy %s", port.ErrVersionConflict, p.Category())
+	}
+	return nil
+}
+
+// Find retrieves a tenant's policy for a data category.
+func (r *RetentionPolicyRepo) Find(ctx context.Context, tenantID uuid.UUID, category string) (model.RetentionPolicy, error) {
+	query := `SELECT ` + policyColumns + ` FROM retention_policies WHERE tenant_id = $1 AND category = $2`
+
+	p, err := scanPolicy(r.pool.QueryRow(ctx, query, tenantID, category))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.RetentionPolicy{}, port.ErrPolicyNotFound
+	}
+	if err != nil {
+		return model.RetentionPolicy{}, fmt.Errorf("failed to scan retention policy: %w", err)
+	}
+	return p, nil
+}
+
+// List returns a tenant's policies, by category.
+func (r *RetentionPolicyRepo) List(ctx context.Context, tenantID uuid.UUID) ([]model.RetentionPolicy, error) {
+	rows, err := r.pool.Query(ctx, `SELECT `+policyColumns+` FROM retention_policies
+		WHERE tenant_id = $1 ORDER BY category`, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query retention policies: %w", err)
+	}
+	defer rows.Close()
+
+	var policies []model.RetentionPolicy
+	for rows.Next() {
+		p, err := scanPolicy(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan retention policy row: %w", err)
+		}
+		policies = append(policies, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return policies, nil
+}
+
+// Delete removes a tenant's policy for a data category.
+func (r *RetentionPolicyRepo) Delete(ctx context.Context, tenantID uuid.UUID, category string) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM retention_policies WHERE tenant_id = $1 AND category = $2`,
+		tenantID, category)
+	if err != nil {
+		return fmt.Errorf("failed to delete retention policy: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return port.ErrPolicyNotFound
+	}
+	return nil
+}
+
+// ListTenants returns the tenants with at least one policy.
+func (r *RetentionPolicyRepo) ListTenants(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := r.pool.Query(ctx, `SELECT DISTINCT tenant_id FROM retention_policies`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query retention policy tenants: %w", err)
+	}
+	defer rows.Close()
+
+	var tenants []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan tenant row: %w", err)
+		}
+		tenants = append(tenants, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return tenants, nil
+}
+
+func scanPolicy(row pgx.Row) (model.RetentionPolicy, error) {
+	var (
+		tenantID             uuid.UUID
+		category             string
+		retentionDays        int
+		lastRunOn            *time.Time
+		version              int
+		createdAt, updatedAt time.Time
+	)
+	err := row.Scan(&tenantID, &category, &retentionDays, &lastRunOn, &version, &createdAt, &updatedAt)
+	if err != nil {
+		return model.RetentionPolicy{}, err
+	}
+	var lastRun time.Time
+	if lastRunOn != nil {
+		lastRun = lastRunOn.UTC()
+	}
+	return model.ReconstructRetentionPolicy(tenantID, category, retentionDays, lastRun, version, createdAt, updatedAt), nil
+}
@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	privacyv1 "github.com/bibbank/bib/api/gen/go/bib/privacy/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/services/privacy-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/event"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/port"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractParticipantRepo holds the registered participants.
+type contractParticipantRepo struct {
+	participants []model.Participant
+}
+
+func (r *contractParticipantRepo) Save(context.Context, model.Participant) error { return nil }
+
+func (r *contractParticipantRepo) List(context.Context) ([]model.Participant, error) {
+	return r.participants, nil
+}
+
+// contractJobRepo holds at most one erasure job.
+type contractJobRepo struct {
+	job *model.ErasureJob
+}
+
+func (r *contractJobRepo) Save(context.Context, model.ErasureJob) error { return nil }
+
+func (r *contractJobRepo) FindByID(context.Context, uuid.UUID) (model.ErasureJob, error) {
+	if r.job == nil {
+		return model.ErasureJob{}, port.ErrJobNotFound
+	}
+	return *r.job, nil
+}
+
+func (r *contractJobRepo) List(context.Context, port.JobFilter, int, int) ([]model.ErasureJob, int, error) {
+	return nil, 0, nil
+}
+
+func (r *contractJobRepo) ListOverdue(context.Context, time.Time, int) ([]model.ErasureJob, error) {
+	return nil, nil
+}
+
+type discardPublisher struct{}
+
+func (discardPublisher) Publish(context.Context, []event.DomainEvent) error { return nil }
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	participants := &contractParticipantRepo{}
+	jobs := &contractJobRepo{}
+	h := NewHandler(
+		usecase.NewRequestErasureUseCase(participants, jobs, discardPublisher{}, 30*24*time.Hour),
+		usecase.NewGetErasureJobUseCase(jobs), nil, nil, nil, nil, nil, nil, nil, nil,
+		slog.Default(),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { privacyv1.RegisterPrivacyServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				UserID:   uuid.New(),
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	customerService := func(t *testing.T) []model.Participant {
+		participant, err := model.NewParticipant("customer-service", []string{"customer"}, time.Now().UTC())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return []model.Participant{participant}
+	}
+	contract.Verify(t, conn, "../../../../../contracts/gateway-privacy-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { participants.participants, jobs.job = nil, nil },
+		"customer-service takes part in erasures": func(t *testing.T) {
+			participants.participants = customerService(t)
+		},
+		"a customer's erasure is in progress": func(t *testing.T) {
+			subject := erasure.Subject{CustomerID: uuid.MustParse("a4c6e8b0-2d4f-4a1c-9e3b-5d7f9b1d3f6a")}
+			job, err := model.NewSubjectErasureJob(contractTenantID, subject, "PRIV-1042", uuid.NewString(),
+				customerService(t), time.Now().UTC(), 30*24*time.Hour)
+			if err != nil {
+				t.Fatal(err)
+			}
+			jobs.job = &job
+		},
+	})
+}
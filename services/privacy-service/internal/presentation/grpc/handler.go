@@ -0,0 +1,491 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	privacyv1 "github.com/bibbank/bib/api/gen/go/bib/privacy/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/services/privacy-service/internal/application/dto"
+	"github.com/bibbank/bib/services/privacy-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/port"
+)
+
+// reviewRoles may read a tenant's erasure jobs, certificates, participants
+// and retention policies; privacyRoles may request erasures and manage
+// retention.
+var (
+	reviewRoles  = []string{auth.RoleAdmin, auth.RoleCompliance, auth.RoleAuditor, auth.RoleOperator}
+	privacyRoles = []string{auth.RoleAdmin, auth.RoleCompliance}
+)
+
+// requireRole checks that the caller has at least one of the given roles.
+func requireRole(ctx context.Context, roles ...string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "insufficient permissions")
+}
+
+// tenantIDFromContext extracts the tenant ID from JWT claims in the context.
+func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return claims.TenantID, nil
+}
+
+// optionalUUID parses an optional ID, returning uuid.Nil when it is empty.
+func optionalUUID(raw, field string) (uuid.UUID, error) {
+	if raw == "" {
+		return uuid.Nil, nil
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid %s", field)
+	}
+	return id, nil
+}
+
+// enumName strips an enum value's prefix, returning "" for the unspecified
+// value.
+func enumName(name, prefix string) string {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "UNSPECIFIED" {
+		return ""
+	}
+	return name
+}
+
+// Compile-time assertion that Handler implements PrivacyServiceServer.
+var _ privacyv1.PrivacyServiceServer = (*Handler)(nil)
+
+// Handler implements the PrivacyServiceServer gRPC interface.
+type Handler struct {
+	privacyv1.UnimplementedPrivacyServiceServer
+	requestErasure   *usecase.RequestErasureUseCase
+	getJob           *usecase.GetErasureJobUseCase
+	listJobs         *usecase.ListErasureJobsUseCase
+	retryJob         *usecase.RetryErasureJobUseCase
+	getCertificate   *usecase.GetErasureCertificateUseCase
+	listParticipants *usecase.ListParticipantsUseCase
+	upsertPolicy     *usecase.UpsertRetentionPolicyUseCase
+	listPolicies     *usecase.ListRetentionPoliciesUseCase
+	deletePolicy     *usecase.DeleteRetentionPolicyUseCase
+	runRetention     *usecase.RunRetentionUseCase
+	logger           *slog.Logger
+}
+
+// NewHandler creates a new gRPC Handler.
+func NewHandler(
+	requestErasure *usecase.RequestErasureUseCase,
+	getJob *usecase.GetErasureJobUseCase,
+	listJobs *usecase.ListErasureJobsUseCase,
+	retryJob *usecase.RetryErasureJobUseCase,
+	getCertificate *usecase.GetErasureCertificateUseCase,
+	listParticipants *usecase.ListParticipantsUseCase,
+	upsertPolicy *usecase.UpsertRetentionPolicyUseCase,
+	listPolicies *usecase.ListRetentionPoliciesUseCase,
+	deletePolicy *usecase.DeleteRetentionPolicyUseCase,
+	runRetention *usecase.RunRetentionUseCase,
+	logger *slog.Logger,
+) *Handler {
+	return &Handler{
+		requestErasure:   requestErasure,
+		getJob:           getJob,
+		listJobs:         listJobs,
+		retryJob:         retryJob,
+		getCertificate:   getCertificate,
+		listParticipants: listParticipants,
+		upsertPolicy:     upsertPolicy,
+		listPolicies:     listPolicies,
+		deletePolicy:     deletePolicy,
+		runRetention:     runRetention,
+		logger:           logger,
+	}
+}
+
+// RequestErasure erases a data subject's personal data across the
+// registered services.
+func (h *Handler) RequestErasure(ctx context.Context, req *privacyv1.RequestErasureRequest) (*privacyv1.RequestErasureResponse, error) {
+	if err := requireRole(ctx, privacyRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	customerID, err := optionalUUID(req.CustomerId, "customer ID")
+	if err != nil {
+		return nil, err
+	}
+	claims, _ := auth.ClaimsFromContext(ctx)
+
+	resp, err := h.requestErasure.Execute(ctx, dto.RequestErasureRequest{
+		TenantID:    claims.TenantID,
+		Email:       req.Email,
+		CustomerID:  customerID,
+		Reference:   req.Reference,
+		RequestedBy: claims.UserID.String(),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &privacyv1.RequestErasureResponse{Job: toJobMsg(resp)}, nil
+}
+
+// GetErasureJob retrieves an erasure job.
+func (h *Handler) GetErasureJob(ctx context.Context, req *privacyv1.GetErasureJobRequest) (*privacyv1.GetErasureJobResponse, error) {
+	if err := requireRole(ctx, reviewRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	jobID, err := uuid.Parse(req.JobId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.getJob.Execute(ctx, tenantID, jobID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &privacyv1.GetErasureJobResponse{Job: toJobMsg(resp)}, nil
+}
+
+// ListErasureJobs lists the tenant's erasure jobs, newest first.
+func (h *Handler) ListErasureJobs(ctx context.Context, req *privacyv1.ListErasureJobsRequest) (*privacyv1.ListErasureJobsResponse, error) {
+	if err := requireRole(ctx, reviewRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listJobs.Execute(ctx, dto.ListErasureJobsRequest{
+		TenantID: tenantID,
+		Kind:     enumName(req.Kind.String(), "ERASURE_KIND_"),
+		Status:   enumName(req.Status.String(), "ERASURE_JOB_STATUS_"),
+		Limit:    int(req.PageSize),
+		Offset:   int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &privacyv1.ListErasureJobsResponse{
+		Jobs:       make([]*privacyv1.ErasureJob, 0, len(resp.Jobs)),
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, j := range resp.Jobs {
+		out.Jobs = append(out.Jobs, toJobMsg(j))
+	}
+	return out, nil
+}
+
+// RetryErasureJob re-requests a failed job's erasure from the services
+// that failed or timed out.
+func (h *Handler) RetryErasureJob(ctx context.Context, req *privacyv1.RetryErasureJobRequest) (*privacyv1.RetryErasureJobResponse, error) {
+	if err := requireRole(ctx, privacyRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	jobID, err := uuid.Parse(req.JobId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.retryJob.Execute(ctx, tenantID, jobID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &privacyv1.RetryErasureJobResponse{Job: toJobMsg(resp)}, nil
+}
+
+// GetErasureCertificate retrieves the certificate of a completed subject
+// erasure.
+func (h *Handler) GetErasureCertificate(ctx context.Context, req *privacyv1.GetErasureCertificateRequest) (*privacyv1.GetErasureCertificateResponse, error) {
+	if err := requireRole(ctx, reviewRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	jobID, err := uuid.Parse(req.JobId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := h.getCertificate.Execute(ctx, tenantID, jobID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &privacyv1.GetErasureCertificateResponse{Certificate: toCertificateMsg(cert)}, nil
+}
+
+// ListParticipants lists the services registered to take part in
+// erasures.
+func (h *Handler) ListParticipants(ctx context.Context, req *privacyv1.ListParticipantsRequest) (*privacyv1.ListParticipantsResponse, error) {
+	if err := requireRole(ctx, reviewRoles...); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listParticipants.Execute(ctx)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	out := &privacyv1.ListParticipantsResponse{
+		Participants: make([]*privacyv1.Participant, 0, len(resp)),
+	}
+	for _, p := range resp {
+		out.Participants = append(out.Participants, &privacyv1.Participant{
+			Service:      p.Service,
+			Categories:   p.Categories,
+			RegisteredAt: timestamppb.New(p.RegisteredAt),
+		})
+	}
+	return out, nil
+}
+
+// UpsertRetentionPolicy sets the tenant's retention period for a data
+// category.
+func (h *Handler) UpsertRetentionPolicy(ctx context.Context, req *privacyv1.UpsertRetentionPolicyRequest) (*privacyv1.UpsertRetentionPolicyResponse, error) {
+	if err := requireRole(ctx, privacyRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.upsertPolicy.Execute(ctx, dto.UpsertRetentionPolicyRequest{
+		TenantID:      tenantID,
+		Category:      req.Category,
+		RetentionDays: int(req.RetentionDays),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &privacyv1.UpsertRetentionPolicyResponse{Policy: toPolicyMsg(resp)}, nil
+}
+
+// ListRetentionPolicies lists the tenant's retention policies.
+func (h *Handler) ListRetentionPolicies(ctx context.Context, req *privacyv1.ListRetentionPoliciesRequest) (*privacyv1.ListRetentionPoliciesResponse, error) {
+	if err := requireRole(ctx, reviewRoles...); err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listPolicies.Execute(ctx, tenantID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	out := &privacyv1.ListRetentionPoliciesResponse{
+		Policies: make([]*privacyv1.RetentionPolicy, 0, len(resp)),
+	}
+	for _, p := range resp {
+		out.Policies = append(out.Policies, toPolicyMsg(p))
+	}
+	return out, nil
+}
+
+// DeleteRetentionPolicy removes the tenant's retention policy for a data
+// category.
+func (h *Handler) DeleteRetentionPolicy(ctx context.Context, req *privacyv1.DeleteRetentionPolicyRequest) (*privacyv1.DeleteRetentionPolicyResponse, error) {
+	if err := requireRole(ctx, privacyRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.deletePolicy.Execute(ctx, tenantID, req.Category); err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &privacyv1.DeleteRetentionPolicyResponse{}, nil
+}
+
+// RunRetention starts the tenant's due retention jobs now rather than at
+// the next daily run.
+func (h *Handler) RunRetention(ctx context.Context, req *privacyv1.RunRetentionRequest) (*privacyv1.RunRetentionResponse, error) {
+	if err := requireRole(ctx, privacyRoles...); err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.runRetention.Execute(ctx, tenantID, time.Now().UTC())
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	h.logger.Info("retention run on demand", "tenant_id", tenantID,
+		"jobs_started", len(resp.Jobs), "skipped_categories", resp.SkippedCategories)
+	out := &privacyv1.RunRetentionResponse{
+		Jobs:              make([]*privacyv1.ErasureJob, 0, len(resp.Jobs)),
+		SkippedCategories: resp.SkippedCategories,
+	}
+	for _, j := range resp.Jobs {
+		out.Jobs = append(out.Jobs, toJobMsg(j))
+	}
+	return out, nil
+}
+
+func (h *Handler) toStatus(err error) error {
+	switch {
+	case errors.Is(err, model.ErrNoParticipants):
+		return status.Error(codes.FailedPrecondition, "no service is registered to take part in erasures")
+	case errors.Is(err, usecase.ErrInvalidErasureRequest), errors.Is(err, usecase.ErrInvalidRetentionPolicy):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrJobNotRetryable), errors.Is(err, usecase.ErrCertificateNotIssued):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, port.ErrJobNotFound):
+		return status.Error(codes.NotFound, "erasure job not found")
+	case errors.Is(err, port.ErrPolicyNotFound):
+		return status.Error(codes.NotFound, "retention policy not found")
+	case errors.Is(err, port.ErrVersionConflict):
+		return status.Error(codes.Aborted, "modified concurrently, retry")
+	default:
+		h.logger.Error("handler error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func toJobMsg(j dto.ErasureJobResponse) *privacyv1.ErasureJob {
+	msg := &privacyv1.ErasureJob{
+		JobId:              j.ID.String(),
+		TenantId:           j.TenantID.String(),
+		Kind:               privacyv1.ErasureKind(privacyv1.ErasureKind_value["ERASURE_KIND_"+j.Kind]),
+		Status:             privacyv1.ErasureJobStatus(privacyv1.ErasureJobStatus_value["ERASURE_JOB_STATUS_"+j.Status]),
+		SubjectEmailSha256: j.SubjectEmailSHA256,
+		Reference:          j.Reference,
+		Category:           j.Category,
+		RequestedBy:        j.RequestedBy,
+		Tasks:              make([]*privacyv1.ErasureTask, 0, len(j.Tasks)),
+		Deadline:           timestamppb.New(j.Deadline),
+		CertificateIssued:  j.CertificateIssued,
+		Version:            int32(j.Version), //nolint:gosec // version counter
+		CreatedAt:          timestamppb.New(j.CreatedAt),
+		UpdatedAt:          timestamppb.New(j.UpdatedAt),
+	}
+	if j.CustomerID != uuid.Nil {
+		msg.CustomerId = j.CustomerID.String()
+	}
+	if !j.Cutoff.IsZero() {
+		msg.Cutoff = timestamppb.New(j.Cutoff)
+	}
+	if j.CompletedAt != nil {
+		msg.CompletedAt = timestamppb.New(*j.CompletedAt)
+	}
+	for _, t := range j.Tasks {
+		task := &privacyv1.ErasureTask{
+			Service:  t.Service,
+			Status:   privacyv1.ErasureTaskStatus(privacyv1.ErasureTaskStatus_value["ERASURE_TASK_STATUS_"+t.Status]),
+			Erased:   int32(t.Erased), //nolint:gosec // bounded by record count
+			Retained: toRetainedMsgs(t.Retained),
+			Error:    t.Error,
+			Attempts: int32(t.Attempts), //nolint:gosec // retry counter
+		}
+		if t.CompletedAt != nil {
+			task.CompletedAt = timestamppb.New(*t.CompletedAt)
+		}
+		msg.Tasks = append(msg.Tasks, task)
+	}
+	return msg
+}
+
+func toCertificateMsg(c model.Certificate) *privacyv1.ErasureCertificate {
+	msg := &privacyv1.ErasureCertificate{
+		JobId:              c.Body.JobID.String(),
+		TenantId:           c.Body.TenantID.String(),
+		SubjectEmailSha256: c.Body.SubjectEmailSHA256,
+		Reference:          c.Body.Reference,
+		Services:           make([]*privacyv1.CertifiedService, 0, len(c.Body.Services)),
+		RequestedAt:        timestamppb.New(c.Body.RequestedAt),
+		CompletedAt:        timestamppb.New(c.Body.CompletedAt),
+		IssuedAt:           timestamppb.New(c.Body.IssuedAt),
+		Document:           c.Document,
+		Digest:             c.Digest,
+		Signature:          c.Signature,
+		Algorithm:          c.Algorithm,
+	}
+	if c.Body.CustomerID != uuid.Nil {
+		msg.CustomerId = c.Body.CustomerID.String()
+	}
+	for _, s := range c.Body.Services {
+		msg.Services = append(msg.Services, &privacyv1.CertifiedService{
+			Service:  s.Service,
+			Erased:   int32(s.Erased), //nolint:gosec // bounded by record count
+			Retained: toRetainedMsgs(s.Retained),
+		})
+	}
+	return msg
+}
+
+func toRetainedMsgs(retained []erasure.Retained) []*privacyv1.RetainedRecord {
+	out := make([]*privacyv1.RetainedRecord, 0, len(retained))
+	for _, r := range retained {
+		out = append(out, &privacyv1.RetainedRecord{Record: r.Record, Reason: r.Reason})
+	}
+	return out
+}
+
+func toPolicyMsg(p dto.RetentionPolicyResponse) *privacyv1.RetentionPolicy {
+	msg := &privacyv1.RetentionPolicy{
+		Category:      p.Category,
+		RetentionDays: int32(p.RetentionDays), //nolint:gosec // bounded by MaxRetentionDays
+		Version:       int32(p.Version),       //nolint:gosec // version counter
+		CreatedAt:     timestamppb.New(p.CreatedAt),
+		UpdatedAt:     timestamppb.New(p.UpdatedAt),
+	}
+	if !p.LastRunOn.IsZero() {
+		msg.LastRunOn = p.LastRunOn.Format(time.DateOnly)
+	}
+	return msg
+}
@@ -0,0 +1,96 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/erasure"
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/model"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/port"
+	"github.com/bibbank/bib/services/privacy-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/privacy-service/internal/infrastructure/postgres"
+)
+
+func saveParticipants(t *testing.T, repo *postgres.ParticipantRepo, services ...string) []model.Participant {
+	t.Helper()
+	var participants []model.Participant
+	for _, s := range services {
+		p, err := model.NewParticipant(s, []string{"kyc"}, time.Now().UTC())
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(context.Background(), p))
+		participants = append(participants, p)
+	}
+	return participants
+}
+
+func TestJobRepo_TaskResultsRoundTrip(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	participantRepo := postgres.NewParticipantRepo(env.Pool())
+	jobs := postgres.NewJobRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	saveParticipants(t, participantRepo, "identity-service", "notification-service")
+	participants, err := participantRepo.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, participants, 2)
+
+	job, err := model.NewSubjectErasureJob(tenantID, erasure.Subject{Email: "ada@example.com"},
+		"DSR-2041", "dpo@bib.example", participants, now, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, jobs.Save(ctx, job))
+
+	reported, err := job.RecordResult("identity-service", erasure.OutcomeCompleted, erasure.Result{Erased: 3}, "", now)
+	require.NoError(t, err)
+	require.NoError(t, jobs.Save(ctx, reported))
+
+	// Saving the stale copy again conflicts on the version.
+	assert.ErrorIs(t, jobs.Save(ctx, reported), port.ErrVersionConflict)
+
+	found, err := jobs.FindByID(ctx, job.ID())
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.JobStatusInProgress, found.Status())
+	assert.Equal(t, job.SubjectDigest(), found.SubjectDigest())
+	statuses := map[string]valueobject.TaskStatus{}
+	for _, task := range found.Tasks() {
+		statuses[task.Service] = task.Status
+	}
+	assert.Equal(t, valueobject.TaskStatusCompleted, statuses["identity-service"])
+	assert.Equal(t, valueobject.TaskStatusPending, statuses["notification-service"])
+
+	list, total, err := jobs.List(ctx, port.JobFilter{TenantID: tenantID, Kind: erasure.KindSubject}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Len(t, list, 1)
+}
+
+func TestJobRepo_ListOverdueReturnsInProgressJobsPastDeadline(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	jobs := postgres.NewJobRepo(env.Pool())
+	ctx := context.Background()
+	participants := saveParticipants(t, postgres.NewParticipantRepo(env.Pool()), "identity-service")
+	now := time.Now().UTC()
+
+	overdue, err := model.NewSubjectErasureJob(uuid.New(), erasure.Subject{CustomerID: uuid.New()},
+		"DSR-1", "dpo@bib.example", participants, now.Add(-2*time.Hour), time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, jobs.Save(ctx, overdue))
+	pending, err := model.NewSubjectErasureJob(uuid.New(), erasure.Subject{CustomerID: uuid.New()},
+		"DSR-2", "dpo@bib.example", participants, now, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, jobs.Save(ctx, pending))
+
+	due, err := jobs.ListOverdue(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, overdue.ID(), due[0].ID())
+}
@@ -7,19 +7,32 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/bibbank/bib/pkg/archive"
 	"github.com/bibbank/bib/pkg/auth"
 	pkgkafka "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
+	"github.com/bibbank/bib/pkg/lock"
 	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
 	pkgpostgres "github.com/bibbank/bib/pkg/postgres"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
 	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
 	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
 	"github.com/bibbank/bib/services/reporting-service/internal/infrastructure/client"
 	"github.com/bibbank/bib/services/reporting-service/internal/infrastructure/config"
-	"github.com/bibbank/bib/services/reporting-service/internal/infrastructure/kafka"
+	"github.com/bibbank/bib/services/reporting-service/internal/infrastructure/objectstore"
 	pgRepo "github.com/bibbank/bib/services/reporting-service/internal/infrastructure/postgres"
+	"github.com/bibbank/bib/services/reporting-service/internal/infrastructure/submission"
 	grpcpresentation "github.com/bibbank/bib/services/reporting-service/internal/presentation/grpc"
 	"github.com/bibbank/bib/services/reporting-service/internal/presentation/rest"
 )
@@ -37,6 +50,7 @@ func main() {
 		Format: "json",
 	})
 	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
 
 	logger.Info("starting reporting-service",
 		"http_port", cfg.HTTPPort,
@@ -52,7 +66,7 @@ func main() {
 	if err != nil {
 		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
 	} else {
-		defer func() { _ = shutdown(ctx) }() //nolint:errcheck // best-effort tracer shutdown
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
 	}
 
 	// Database connection.
@@ -71,7 +85,7 @@ func main() {
 		logger.Error("failed to connect to database", "error", err)
 		os.Exit(1)
 	}
-	defer pool.Close()
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
 	logger.Info("connected to database")
 
 	// Run database migrations.
@@ -89,24 +103,215 @@ func main() {
 
 	// Wire infrastructure adapters.
 	reportRepo := pgRepo.NewReportSubmissionRepo(pool)
+	scheduleRepo := pgRepo.NewReportScheduleRepo(pool)
+	runRepo := pgRepo.NewScheduledRunRepo(pool)
+	auditRepo := pgRepo.NewSubmissionAuditRepo(pool)
+	reviewRepo := pgRepo.NewReportReviewRepo(pool)
+	dashboardReadModel := pgRepo.NewDashboardReadModelRepo(pool)
 	kafkaProducer := pkgkafka.NewProducer(pkgkafka.Config{
 		Brokers: cfg.Kafka.Brokers,
 	})
-	defer kafkaProducer.Close()
-	eventPublisher := kafka.NewPublisher(kafkaProducer, logger)
-	ledgerClient := client.NewStubLedgerDataClient()
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(kafkaProducer.Close))
+
+	// Outbox relay: events are written to the outbox and published from it,
+	// so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     outbox.StaticRoute(pgRepo.UnknownEventTopic),
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: cfg.ServiceName,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, kafkaProducer, relayCfg, logger)
+	if err != nil {
+		logger.Error("failed to create outbox relay", "error", err)
+		os.Exit(1)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+	eventPublisher := pgRepo.NewOutboxPublisher(outbox.NewPublisher(outboxStore))
+	liquidityWeights := service.DefaultLiquidityWeights()
+	if cfg.Ledger.LiquidityWeightsFile != "" {
+		raw, readErr := os.ReadFile(cfg.Ledger.LiquidityWeightsFile)
+		if readErr != nil {
+			logger.Error("failed to read liquidity weights file", "path", cfg.Ledger.LiquidityWeightsFile, "error", readErr)
+			os.Exit(1)
+		}
+		liquidityWeights, err = service.ParseLiquidityWeights(raw)
+		if err != nil {
+			logger.Error("failed to load liquidity weights", "path", cfg.Ledger.LiquidityWeightsFile, "error", err)
+			os.Exit(1)
+		}
+	}
+	stubLedger := client.NewStubLedgerDataClient(liquidityWeights)
+	var (
+		ledgerClient  port.LedgerDataClient    = stubLedger
+		trialBalances port.TrialBalanceClient  = stubLedger
+		journals      port.JournalEntryClient  = stubLedger
+		balances      port.LedgerBalanceClient = stubLedger
+	)
+	if cfg.Ledger.GRPCAddr != "" {
+		ledgerConn, dialErr := grpc.NewClient(cfg.Ledger.GRPCAddr,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if dialErr != nil {
+			logger.Error("failed to create ledger service client", "addr", cfg.Ledger.GRPCAddr, "error", dialErr)
+			os.Exit(1)
+		}
+		lc.OnStop(lifecycle.PhaseResources, "ledger client", lifecycle.Close(ledgerConn.Close))
+		ledgerGRPC := client.NewLedgerGRPCClient(ledgerConn, client.LedgerClientConfig{
+			PageSize:         cfg.Ledger.PageSize,
+			MaxRetries:       cfg.Ledger.MaxRetries,
+			RetryBackoff:     time.Duration(cfg.Ledger.RetryBackoffMs) * time.Millisecond,
+			Timeout:          time.Duration(cfg.Ledger.TimeoutSeconds) * time.Second,
+			LiquidityWeights: liquidityWeights,
+		})
+		ledgerClient, trialBalances, journals, balances = ledgerGRPC, ledgerGRPC, ledgerGRPC, ledgerGRPC
+		logger.Info("sourcing report figures from ledger service", "addr", cfg.Ledger.GRPCAddr)
+	} else {
+		logger.Warn("LEDGER_SERVICE_ADDR not set, using stub ledger figures")
+	}
+	amlClientConfig := client.AMLClientConfig{
+		PageSize:     cfg.AML.PageSize,
+		MaxRetries:   cfg.AML.MaxRetries,
+		RetryBackoff: time.Duration(cfg.AML.RetryBackoffMs) * time.Millisecond,
+		Timeout:      time.Duration(cfg.AML.TimeoutSeconds) * time.Second,
+	}
+	var fraudClient port.FraudCaseClient = client.NewStubFraudCaseClient()
+	if cfg.AML.FraudGRPCAddr != "" {
+		fraudConn, dialErr := grpc.NewClient(cfg.AML.FraudGRPCAddr,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if dialErr != nil {
+			logger.Error("failed to create fraud service client", "addr", cfg.AML.FraudGRPCAddr, "error", dialErr)
+			os.Exit(1)
+		}
+		lc.OnStop(lifecycle.PhaseResources, "fraud client", lifecycle.Close(fraudConn.Close))
+		fraudClient = client.NewFraudGRPCClient(fraudConn, amlClientConfig)
+	} else {
+		logger.Warn("FRAUD_SERVICE_ADDR not set, suspicious activity reports will list no cases")
+	}
+	var paymentClient port.PaymentDataClient = client.NewStubPaymentDataClient()
+	if cfg.AML.PaymentGRPCAddr != "" {
+		paymentConn, dialErr := grpc.NewClient(cfg.AML.PaymentGRPCAddr,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if dialErr != nil {
+			logger.Error("failed to create payment service client", "addr", cfg.AML.PaymentGRPCAddr, "error", dialErr)
+			os.Exit(1)
+		}
+		lc.OnStop(lifecycle.PhaseResources, "payment client", lifecycle.Close(paymentConn.Close))
+		paymentClient = client.NewPaymentGRPCClient(paymentConn, amlClientConfig)
+	} else {
+		logger.Warn("PAYMENT_SERVICE_ADDR not set, currency transaction reports will list no payments")
+	}
+	ctrThreshold, err := decimal.NewFromString(cfg.AML.CTRThreshold)
+	if err != nil {
+		logger.Error("invalid CTR_THRESHOLD", "value", cfg.AML.CTRThreshold, "error", err)
+		os.Exit(1)
+	}
+	var submissionGateway port.SubmissionGateway
+	switch cfg.Submission.Channel {
+	case "SFTP":
+		if cfg.Submission.SFTPOutboundDir == "" || cfg.Submission.SFTPInboundDir == "" {
+			logger.Error("REPORT_SFTP_OUTBOUND_DIR and REPORT_SFTP_INBOUND_DIR are required for SFTP submission")
+			os.Exit(1)
+		}
+		submissionGateway = submission.NewSFTPDropGateway(submission.SFTPDropConfig{
+			OutboundDir: cfg.Submission.SFTPOutboundDir,
+			InboundDir:  cfg.Submission.SFTPInboundDir,
+		})
+	case "API":
+		if cfg.Submission.APIBaseURL == "" {
+			logger.Error("REGULATOR_API_URL is required for API submission")
+			os.Exit(1)
+		}
+		submissionGateway = submission.NewAPIGateway(submission.APIConfig{
+			BaseURL: cfg.Submission.APIBaseURL,
+			Token:   cfg.Submission.APIToken,
+		}, &http.Client{Timeout: time.Duration(cfg.Submission.APITimeoutSeconds) * time.Second})
+	case "MANUAL":
+		submissionGateway = submission.NewManualGateway()
+	default:
+		logger.Error("unknown REPORT_SUBMISSION_CHANNEL", "channel", cfg.Submission.Channel)
+		os.Exit(1)
+	}
+	logger.Info("filing reports with the regulator", "channel", submissionGateway.Channel().String())
 	xbrlGenerator := service.NewXBRLGenerator()
+	reportRenderer := service.NewReportRenderer()
+	amlReports := usecase.NewAMLReportBuilder(fraudClient, paymentClient,
+		service.NewGoAMLGenerator(cfg.AML.ReportingEntityID, cfg.AML.InstitutionName, cfg.AML.LocalCurrency), ctrThreshold)
 
 	// Wire use cases.
-	generateReportUC := usecase.NewGenerateReportUseCase(reportRepo, eventPublisher, ledgerClient, xbrlGenerator)
+	generateReportUC := usecase.NewGenerateReportUseCase(reportRepo, eventPublisher, ledgerClient, xbrlGenerator, reportRenderer, amlReports)
 	getReportUC := usecase.NewGetReportUseCase(reportRepo)
-	submitReportUC := usecase.NewSubmitReportUseCase(reportRepo, eventPublisher)
+	submitReportUC := usecase.NewSubmitReportUseCase(reportRepo, auditRepo, submissionGateway, eventPublisher)
+	recordAckUC := usecase.NewRecordAcknowledgmentUseCase(reportRepo, auditRepo, eventPublisher)
+	ingestAcksUC := usecase.NewIngestAcknowledgmentsUseCase(reportRepo, auditRepo, submissionGateway, eventPublisher)
+	listAuditUC := usecase.NewListSubmissionAuditUseCase(auditRepo)
+	upsertScheduleUC := usecase.NewUpsertReportScheduleUseCase(scheduleRepo)
+	listSchedulesUC := usecase.NewListReportSchedulesUseCase(scheduleRepo)
+	listRunsUC := usecase.NewListScheduledRunsUseCase(runRepo)
+	amendReportUC := usecase.NewAmendReportUseCase(reportRepo, generateReportUC, eventPublisher)
+	listVersionsUC := usecase.NewListReportVersionsUseCase(reportRepo)
+	diffVersionsUC := usecase.NewDiffReportVersionsUseCase(reportRepo)
+	reviewReportUC := usecase.NewReviewReportUseCase(reportRepo, reviewRepo, eventPublisher)
+	reviseReportUC := usecase.NewReviseReportUseCase(reportRepo, generateReportUC, eventPublisher)
+	listReviewsUC := usecase.NewListReportReviewsUseCase(reviewRepo)
+
+	// Background report jobs, for reports too large to generate within a
+	// request deadline.
+	jobRepo := pgRepo.NewReportJobRepo(pool)
+	artifactStore := objectstore.New(archive.NewStore(archive.StoreConfig{
+		Dir:         cfg.Jobs.ArtifactDir,
+		S3Endpoint:  cfg.Warehouse.S3Endpoint,
+		S3Region:    cfg.Warehouse.S3Region,
+		S3Bucket:    cfg.Jobs.ArtifactS3Bucket,
+		S3AccessKey: cfg.Warehouse.S3AccessKey,
+		S3SecretKey: cfg.Warehouse.S3SecretKey,
+	}))
+	createJobUC := usecase.NewCreateReportJobUseCase(jobRepo)
+	runJobsUC := usecase.NewRunReportJobsUseCase(jobRepo, generateReportUC, artifactStore, eventPublisher,
+		cfg.Jobs.ArtifactPrefix, time.Duration(cfg.Jobs.LeaseMinutes)*time.Minute)
+	getJobUC := usecase.NewGetReportJobUseCase(jobRepo)
+	listJobsUC := usecase.NewListReportJobsUseCase(jobRepo)
+	getArtifactUC := usecase.NewGetReportJobArtifactUseCase(jobRepo, artifactStore)
+	liquidityMetricsUC := usecase.NewGetLiquidityMetricsUseCase(trialBalances, liquidityWeights)
+	balanceSheetUC := usecase.NewGetBalanceSheetSummaryUseCase(dashboardReadModel)
+	depositGrowthUC := usecase.NewGetDepositGrowthUseCase(dashboardReadModel)
+	loanBookUC := usecase.NewGetLoanBookUseCase(dashboardReadModel)
+	paymentVolumesUC := usecase.NewGetPaymentVolumesUseCase(dashboardReadModel)
+	fraudDecisionsUC := usecase.NewGetFraudDecisionRatesUseCase(dashboardReadModel)
+	projectDashboardEventUC := usecase.NewProjectDashboardEventUseCase(dashboardReadModel)
+	runSchedulesUC := usecase.NewRunReportSchedulesUseCase(scheduleRepo, runRepo, reportRepo, generateReportUC,
+		eventPublisher, cfg.Scheduler.MaxAttempts)
+
+	// Consume deposit, lending, payment and fraud events into the dashboard read model.
+	for _, topic := range usecase.DashboardTopics {
+		consumer := pkgkafka.NewConsumer(pkgkafka.Config{
+			Brokers:       cfg.Kafka.Brokers,
+			ConsumerGroup: cfg.Kafka.ConsumerGroup,
+		}, topic, func(ctx context.Context, msg pkgkafka.Message) error {
+			return projectDashboardEventUC.Execute(ctx, msg.Headers["event_type"], msg.Value)
+		}, logger)
+		lc.Go(lifecycle.PhaseConsumers, topic+" consumer", consumer.Start)
+		lc.OnStop(lifecycle.PhaseResources, topic+" consumer", lifecycle.Close(consumer.Close))
+	}
 
 	// JWT service (validation-only: public key preferred, secret as fallback).
 	jwtCfg := auth.JWTConfig{
 		Issuer: "bib-gateway",
 	}
 	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
 	case os.Getenv("JWT_PUBLIC_KEY") != "":
 		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
 	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
@@ -129,15 +334,142 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Background jobs read accounts from the account service and authorize
+	// their calls with tenant tokens signed like the gateway's.
+	serviceClientConfig := client.WarehouseClientConfig{
+		PageSize:     cfg.Ledger.PageSize,
+		MaxRetries:   cfg.Ledger.MaxRetries,
+		RetryBackoff: time.Duration(cfg.Ledger.RetryBackoffMs) * time.Millisecond,
+		Timeout:      time.Duration(cfg.Ledger.TimeoutSeconds) * time.Second,
+	}
+	var accountClient port.AccountDataClient = client.NewStubAccountDataClient()
+	if cfg.Warehouse.AccountGRPCAddr != "" {
+		accountConn, dialErr := grpc.NewClient(cfg.Warehouse.AccountGRPCAddr,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if dialErr != nil {
+			logger.Error("failed to create account service client", "addr", cfg.Warehouse.AccountGRPCAddr, "error", dialErr)
+			os.Exit(1)
+		}
+		lc.OnStop(lifecycle.PhaseResources, "account client", lifecycle.Close(accountConn.Close))
+		accountClient = client.NewAccountGRPCClient(accountConn, serviceClientConfig)
+	} else if cfg.Warehouse.TenantIDs != "" || cfg.Consistency.TenantIDs != "" {
+		logger.Warn("ACCOUNT_SERVICE_ADDR not set, background jobs will see no accounts")
+	}
+	var signer *auth.JWTService
+	switch {
+	case cfg.Warehouse.SigningKeyFile != "":
+		keyData, loadErr := auth.LoadKeyFromFile(cfg.Warehouse.SigningKeyFile)
+		if loadErr != nil {
+			logger.Error("failed to load warehouse signing key file", "error", loadErr)
+			os.Exit(1)
+		}
+		signer, err = auth.NewJWTService(auth.JWTConfig{
+			PrivateKeyPEM: string(keyData),
+			Issuer:        "bib-gateway",
+			Expiration:    15 * time.Minute,
+		})
+		if err != nil {
+			logger.Error("failed to initialize warehouse token signer", "error", err)
+			os.Exit(1)
+		}
+	case jwtCfg.Secret != "":
+		signer, err = auth.NewJWTService(auth.JWTConfig{
+			Secret:     jwtCfg.Secret,
+			Issuer:     "bib-gateway",
+			Expiration: 15 * time.Minute,
+		})
+		if err != nil {
+			logger.Error("failed to initialize warehouse token signer", "error", err)
+			os.Exit(1)
+		}
+	case cfg.Warehouse.TenantIDs != "" || cfg.Consistency.TenantIDs != "":
+		logger.Warn("WAREHOUSE_SIGNING_KEY_FILE not set, background job calls will be unauthenticated")
+	}
+	serviceCredentials := client.NewServiceCredentials(signer)
+
+	// Nightly warehouse export of curated datasets.
+	var exportWarehouseUC *usecase.ExportWarehouseUseCase
+	if cfg.Warehouse.TenantIDs != "" {
+		tenants, parseErr := parseTenantIDs(cfg.Warehouse.TenantIDs)
+		if parseErr != nil {
+			logger.Error("invalid WAREHOUSE_TENANT_IDS", "error", parseErr)
+			os.Exit(1)
+		}
+		store := objectstore.New(archive.NewStore(archive.StoreConfig{
+			Dir:         cfg.Warehouse.Dir,
+			S3Endpoint:  cfg.Warehouse.S3Endpoint,
+			S3Region:    cfg.Warehouse.S3Region,
+			S3Bucket:    cfg.Warehouse.S3Bucket,
+			S3AccessKey: cfg.Warehouse.S3AccessKey,
+			S3SecretKey: cfg.Warehouse.S3SecretKey,
+		}))
+		exportWarehouseUC = usecase.NewExportWarehouseUseCase(accountClient, paymentClient, journals,
+			dashboardReadModel, serviceCredentials, store,
+			pgRepo.NewWarehouseRepo(pool), cfg.Warehouse.Prefix, tenants)
+	} else {
+		logger.Warn("WAREHOUSE_TENANT_IDS not set, warehouse export disabled")
+	}
+
+	// Periodic cross-service consistency checks.
+	var checkConsistencyUC *usecase.CheckConsistencyUseCase
+	if cfg.Consistency.TenantIDs != "" {
+		tenants, parseErr := parseTenantIDs(cfg.Consistency.TenantIDs)
+		if parseErr != nil {
+			logger.Error("invalid CONSISTENCY_TENANT_IDS", "error", parseErr)
+			os.Exit(1)
+		}
+		var cardClient port.CardDataClient = client.NewStubCardDataClient()
+		if cfg.Consistency.CardGRPCAddr != "" {
+			cardConn, dialErr := grpc.NewClient(cfg.Consistency.CardGRPCAddr,
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+			)
+			if dialErr != nil {
+				logger.Error("failed to create card service client", "addr", cfg.Consistency.CardGRPCAddr, "error", dialErr)
+				os.Exit(1)
+			}
+			lc.OnStop(lifecycle.PhaseResources, "card client", lifecycle.Close(cardConn.Close))
+			cardClient = client.NewCardGRPCClient(cardConn, serviceClientConfig)
+		} else {
+			logger.Warn("CARD_SERVICE_ADDR not set, card limits will not be checked")
+		}
+		var controlAccounts []string
+		for _, code := range strings.Split(cfg.Consistency.ControlAccounts, ",") {
+			if code = strings.TrimSpace(code); code != "" {
+				controlAccounts = append(controlAccounts, code)
+			}
+		}
+		checkConsistencyUC = usecase.NewCheckConsistencyUseCase(accountClient, balances, journals,
+			paymentClient, cardClient, serviceCredentials, pgRepo.NewConsistencyReportRepo(pool), eventPublisher,
+			usecase.ConsistencyCheckConfig{
+				ControlAccounts: controlAccounts,
+				PaymentLookback: time.Duration(cfg.Consistency.PaymentLookbackDays) * 24 * time.Hour,
+			}, tenants)
+	} else {
+		logger.Warn("CONSISTENCY_TENANT_IDS not set, consistency checks disabled")
+	}
+
 	// gRPC server.
 	handler := grpcpresentation.NewReportingHandler(generateReportUC, getReportUC, submitReportUC,
-		logger)
-	grpcServer := grpcpresentation.NewServer(handler, logger, jwtSvc)
+		upsertScheduleUC, listSchedulesUC, listRunsUC, recordAckUC, listAuditUC,
+		amendReportUC, listVersionsUC, diffVersionsUC, liquidityMetricsUC,
+		balanceSheetUC, depositGrowthUC, loanBookUC, paymentVolumesUC, fraudDecisionsUC,
+		reviewReportUC, reviseReportUC, listReviewsUC,
+		createJobUC, getJobUC, listJobsUC, getArtifactUC, logger)
+	grpcServer, err := grpcpresentation.NewServer(handler, logger, jwtSvc)
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
 
 	// HTTP server (health checks).
 	httpMux := http.NewServeMux()
 	healthHandler := rest.NewHealthHandler(logger)
 	healthHandler.RegisterRoutes(httpMux)
+	if metricsHandler != nil {
+		httpMux.Handle("/metrics", metricsHandler)
+	}
 
 	httpServer := &http.Server{
 		Addr:         cfg.HTTPAddr(),
@@ -146,42 +478,167 @@ func main() {
 		WriteTimeout: 10 * time.Second,
 	}
 
-	// Start servers.
-	errCh := make(chan error, 2)
+	// Scheduled batch work runs on the replica elected leader for it, so
+	// reports are not generated or exported twice.
+	locker := lock.NewPostgresLocker(pool)
 
-	go func() {
-		if err := grpcServer.Start(cfg.GRPCAddr()); err != nil {
-			errCh <- fmt.Errorf("gRPC server error: %w", err)
-		}
-	}()
+	// Generate scheduled drafts ahead of their deadlines and track SLAs.
+	schedulesElector := lock.NewElector(locker, "reporting.schedules", lock.ElectorConfig{}, logger)
+	lc.Go(lifecycle.PhaseWorkers, "scheduled reports", func(ctx context.Context) error {
+		schedulesElector.Run(ctx, func(ctx context.Context) {
+			ticker := time.NewTicker(time.Duration(cfg.Scheduler.IntervalMinutes) * time.Minute)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case now := <-ticker.C:
+					result, runErr := runSchedulesUC.Execute(ctx, now.UTC())
+					if runErr != nil {
+						logger.Error("scheduled report run failed", "error", runErr)
+					}
+					if result != (dto.RunReportSchedulesResult{}) {
+						logger.Info("scheduled report run",
+							"generated", result.Generated,
+							"failed", result.Failed,
+							"submitted", result.Submitted,
+							"missed", result.Missed,
+						)
+					}
+				}
+			}
+		})
+		return nil
+	})
+
+	// Run queued report jobs. Each worker drains the queue, then waits for
+	// the next poll.
+	for i := 0; i < cfg.Jobs.Workers; i++ {
+		lc.Go(lifecycle.PhaseWorkers, "report job worker", func(ctx context.Context) error {
+			ticker := time.NewTicker(time.Duration(cfg.Jobs.PollIntervalSeconds) * time.Second)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+					for ctx.Err() == nil {
+						ran, runErr := runJobsUC.Execute(ctx)
+						if runErr != nil {
+							logger.Error("report job failed", "error", runErr)
+						}
+						if !ran || runErr != nil {
+							break
+						}
+					}
+				}
+			}
+		})
+	}
 
-	go func() {
-		logger.Info("HTTP server starting", "address", cfg.HTTPAddr())
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			errCh <- fmt.Errorf("HTTP server error: %w", err)
+	// Collect regulator acknowledgments of outstanding filings.
+	lc.Go(lifecycle.PhaseWorkers, "acknowledgment ingestion", func(ctx context.Context) error {
+		ticker := time.NewTicker(time.Duration(cfg.Submission.AckPollIntervalMinutes) * time.Minute)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				result, ingestErr := ingestAcksUC.Execute(ctx)
+				if ingestErr != nil {
+					logger.Error("acknowledgment ingestion failed", "error", ingestErr)
+				}
+				if result != (dto.IngestAcknowledgmentsResult{}) {
+					logger.Info("ingested regulator acknowledgments",
+						"accepted", result.Accepted,
+						"rejected", result.Rejected,
+					)
+				}
+			}
 		}
-	}()
+	})
 
-	// Wait for shutdown signal.
-	select {
-	case <-ctx.Done():
-		logger.Info("shutdown signal received")
-	case err := <-errCh:
-		logger.Error("server error", "error", err)
+	// Land the previous day's datasets in the warehouse once the run hour has
+	// passed; partitions already landed are skipped, so later ticks and
+	// restarts only retry failures.
+	if exportWarehouseUC != nil {
+		warehouseElector := lock.NewElector(locker, "reporting.warehouse-export", lock.ElectorConfig{}, logger)
+		lc.Go(lifecycle.PhaseWorkers, "warehouse export", func(ctx context.Context) error {
+			warehouseElector.Run(ctx, func(ctx context.Context) {
+				ticker := time.NewTicker(time.Hour)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case now := <-ticker.C:
+						now = now.UTC()
+						if now.Hour() < cfg.Warehouse.RunHourUTC {
+							continue
+						}
+						result, exportErr := exportWarehouseUC.Execute(ctx, now.AddDate(0, 0, -1))
+						if exportErr != nil {
+							logger.Error("warehouse export failed", "error", exportErr)
+						}
+						if result.Exported > 0 || result.Failed > 0 {
+							logger.Info("warehouse export",
+								"exported", result.Exported,
+								"skipped", result.Skipped,
+								"failed", result.Failed,
+								"rows", result.Rows,
+							)
+						}
+					}
+				}
+			})
+			return nil
+		})
 	}
 
-	// Graceful shutdown.
-	logger.Info("shutting down servers")
-
-	grpcServer.Stop()
+	// Check the invariants every interval; discrepancies are reported and
+	// alerted on every run until they are resolved.
+	if checkConsistencyUC != nil {
+		consistencyElector := lock.NewElector(locker, "reporting.consistency-check", lock.ElectorConfig{}, logger)
+		lc.Go(lifecycle.PhaseWorkers, "consistency check", func(ctx context.Context) error {
+			consistencyElector.Run(ctx, func(ctx context.Context) {
+				ticker := time.NewTicker(time.Duration(cfg.Consistency.IntervalMinutes) * time.Minute)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case now := <-ticker.C:
+						result, checkErr := checkConsistencyUC.Execute(ctx, now)
+						if checkErr != nil {
+							logger.Error("consistency check failed", "error", checkErr)
+						}
+						if result.Discrepancies > 0 {
+							logger.Warn("consistency check found discrepancies",
+								"checked", result.Checked,
+								"failed", result.Failed,
+								"discrepancies", result.Discrepancies,
+							)
+						}
+					}
+				}
+			})
+			return nil
+		})
+	}
 
-	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer shutdownCancel()
+	// Start servers.
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", func(context.Context) error {
+		return grpcServer.Start(cfg.GRPCAddr())
+	})
+	lc.OnStop(lifecycle.PhaseIngress, "gRPC server", lifecycle.Func(grpcServer.Stop))
+	lc.ServeHTTP("HTTP server", httpServer)
 
-	if err := httpServer.Shutdown(shutdownCtx); err != nil {
-		logger.Error("HTTP server shutdown error", "error", err)
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
 	}
-
 	logger.Info("reporting-service stopped")
 }
 
@@ -191,3 +648,16 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+// parseTenantIDs parses a comma-separated list of tenant IDs.
+func parseTenantIDs(raw string) ([]uuid.UUID, error) {
+	var tenants []uuid.UUID
+	for _, s := range strings.Split(raw, ",") {
+		tenantID, err := uuid.Parse(strings.TrimSpace(s))
+		if err != nil {
+			return nil, fmt.Errorf("tenant %q: %w", s, err)
+		}
+		tenants = append(tenants, tenantID)
+	}
+	return tenants, nil
+}
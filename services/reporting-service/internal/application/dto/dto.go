@@ -8,19 +8,27 @@ import (
 
 // GenerateReportRequest holds the input for generating a report.
 type GenerateReportRequest struct {
-	ReportType string    `json:"report_type"`
-	Period     string    `json:"period"`
-	TenantID   uuid.UUID `json:"tenant_id"`
+	ReportType string `json:"report_type"`
+	Period     string `json:"period"`
+	Format     string `json:"format,omitempty"`
+	// Actor is the user preparing the report, who may not also approve it.
+	// It is empty for scheduled runs.
+	Actor    string    `json:"actor,omitempty"`
+	TenantID uuid.UUID `json:"tenant_id"`
 }
 
 // GenerateReportResponse holds the output after generating a report.
 type GenerateReportResponse struct {
-	ReportType      string    `json:"report_type"`
-	ReportingPeriod string    `json:"reporting_period"`
-	Status          string    `json:"status"`
-	GeneratedAt     string    `json:"generated_at,omitempty"`
-	ID              uuid.UUID `json:"id"`
-	TenantID        uuid.UUID `json:"tenant_id"`
+	GeneratedAt     *time.Time `json:"generated_at,omitempty"`
+	ReportType      string     `json:"report_type"`
+	ReportingPeriod string     `json:"reporting_period"`
+	Status          string     `json:"status"`
+	Format          string     `json:"format"`
+	ContentType     string     `json:"content_type"`
+	FileName        string     `json:"file_name"`
+	Content         []byte     `json:"content,omitempty"`
+	ID              uuid.UUID  `json:"id"`
+	TenantID        uuid.UUID  `json:"tenant_id"`
 }
 
 // GetReportRequest holds the input for retrieving a report.
@@ -30,28 +38,401 @@ type GetReportRequest struct {
 
 // GetReportResponse holds the full report submission data.
 type GetReportResponse struct {
-	CreatedAt        time.Time  `json:"created_at"`
-	UpdatedAt        time.Time  `json:"updated_at"`
-	GeneratedAt      *time.Time `json:"generated_at,omitempty"`
-	SubmittedAt      *time.Time `json:"submitted_at,omitempty"`
-	ReportType       string     `json:"report_type"`
-	ReportingPeriod  string     `json:"reporting_period"`
-	Status           string     `json:"status"`
-	XBRLContent      string     `json:"xbrl_content,omitempty"`
-	ValidationErrors []string   `json:"validation_errors,omitempty"`
-	Version          int        `json:"version"`
-	ID               uuid.UUID  `json:"id"`
-	TenantID         uuid.UUID  `json:"tenant_id"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+	GeneratedAt         *time.Time `json:"generated_at,omitempty"`
+	SubmittedAt         *time.Time `json:"submitted_at,omitempty"`
+	ReportType          string     `json:"report_type"`
+	ReportingPeriod     string     `json:"reporting_period"`
+	Status              string     `json:"status"`
+	XBRLContent         string     `json:"xbrl_content,omitempty"`
+	ValidationErrors    []string   `json:"validation_errors,omitempty"`
+	SubmissionChannel   string     `json:"submission_channel,omitempty"`
+	SubmissionReference string     `json:"submission_reference,omitempty"`
+	SubmissionAttempts  int        `json:"submission_attempts"`
+	PreparedBy          string     `json:"prepared_by,omitempty"`
+	ApprovedBy          string     `json:"approved_by,omitempty"`
+	ApprovedAt          *time.Time `json:"approved_at,omitempty"`
+	Revision            int        `json:"revision"`
+	AmendsID            *uuid.UUID `json:"amends_id,omitempty"`
+	AmendmentReason     string     `json:"amendment_reason,omitempty"`
+	AmendmentNote       string     `json:"amendment_note,omitempty"`
+	LinkedCaseIDs       []string   `json:"linked_case_ids,omitempty"`
+	Version             int        `json:"version"`
+	ID                  uuid.UUID  `json:"id"`
+	OriginalID          uuid.UUID  `json:"original_id"`
+	TenantID            uuid.UUID  `json:"tenant_id"`
 }
 
 // SubmitReportRequest holds the input for submitting a report to the regulator.
 type SubmitReportRequest struct {
-	ID uuid.UUID `json:"id"`
+	Actor string    `json:"actor"`
+	ID    uuid.UUID `json:"id"`
 }
 
 // SubmitReportResponse holds the output after submitting a report.
 type SubmitReportResponse struct {
-	Status      string    `json:"status"`
-	SubmittedAt string    `json:"submitted_at"`
-	ID          uuid.UUID `json:"id"`
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+	Status      string     `json:"status"`
+	Channel     string     `json:"channel"`
+	Reference   string     `json:"reference"`
+	Attempt     int        `json:"attempt"`
+	ID          uuid.UUID  `json:"id"`
+}
+
+// RecordAcknowledgmentRequest holds a regulator acknowledgment recorded by an
+// operator. Reference defaults to the submission's latest transmission.
+type RecordAcknowledgmentRequest struct {
+	Reference string    `json:"reference,omitempty"`
+	Actor     string    `json:"actor"`
+	Errors    []string  `json:"errors,omitempty"`
+	Accepted  bool      `json:"accepted"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// AcknowledgmentResponse holds a submission's status after an acknowledgment.
+type AcknowledgmentResponse struct {
+	Status           string    `json:"status"`
+	ValidationErrors []string  `json:"validation_errors,omitempty"`
+	ID               uuid.UUID `json:"id"`
+}
+
+// IngestAcknowledgmentsResult summarises one pass of acknowledgment polling.
+type IngestAcknowledgmentsResult struct {
+	Accepted int `json:"accepted"`
+	Rejected int `json:"rejected"`
+}
+
+// SubmissionAuditEntryResponse holds one entry of a submission's audit trail.
+type SubmissionAuditEntryResponse struct {
+	OccurredAt    time.Time `json:"occurred_at"`
+	Channel       string    `json:"channel"`
+	Action        string    `json:"action"`
+	Reference     string    `json:"reference,omitempty"`
+	Detail        string    `json:"detail,omitempty"`
+	Actor         string    `json:"actor,omitempty"`
+	ContentSHA256 string    `json:"content_sha256"`
+	Attempt       int       `json:"attempt"`
+	ID            uuid.UUID `json:"id"`
+}
+
+// AmendReportRequest holds the input for amending a filed report.
+type AmendReportRequest struct {
+	Reason string    `json:"reason"`
+	Note   string    `json:"note,omitempty"`
+	Actor  string    `json:"actor,omitempty"`
+	ID     uuid.UUID `json:"id"`
+}
+
+// AmendReportResponse holds the generated amendment and its changes against
+// the version it amends.
+type AmendReportResponse struct {
+	Status   string               `json:"status"`
+	Reason   string               `json:"reason"`
+	Note     string               `json:"note,omitempty"`
+	Changes  []FactChangeResponse `json:"changes"`
+	Revision int                  `json:"revision"`
+	ID       uuid.UUID            `json:"id"`
+	AmendsID uuid.UUID            `json:"amends_id"`
+	// OriginalID is the first version of the report.
+	OriginalID uuid.UUID `json:"original_id"`
+}
+
+// ReportVersionResponse holds one version of a report.
+type ReportVersionResponse struct {
+	CreatedAt       time.Time  `json:"created_at"`
+	GeneratedAt     *time.Time `json:"generated_at,omitempty"`
+	SubmittedAt     *time.Time `json:"submitted_at,omitempty"`
+	AmendsID        *uuid.UUID `json:"amends_id,omitempty"`
+	Status          string     `json:"status"`
+	AmendmentReason string     `json:"amendment_reason,omitempty"`
+	AmendmentNote   string     `json:"amendment_note,omitempty"`
+	Revision        int        `json:"revision"`
+	ID              uuid.UUID  `json:"id"`
+}
+
+// DiffReportVersionsRequest holds the versions to compare. FromID defaults
+// to the version that ToID amends.
+type DiffReportVersionsRequest struct {
+	FromID *uuid.UUID `json:"from_id,omitempty"`
+	ToID   uuid.UUID  `json:"to_id"`
+}
+
+// FactChangeResponse holds the change of one reported fact between versions.
+type FactChangeResponse struct {
+	Concept string `json:"concept"`
+	Context string `json:"context"`
+	Unit    string `json:"unit,omitempty"`
+	Change  string `json:"change"`
+	From    string `json:"from,omitempty"`
+	To      string `json:"to,omitempty"`
+	Delta   string `json:"delta,omitempty"`
+}
+
+// DiffReportVersionsResponse holds the fact changes between two versions.
+type DiffReportVersionsResponse struct {
+	Changes      []FactChangeResponse `json:"changes"`
+	FromRevision int                  `json:"from_revision"`
+	ToRevision   int                  `json:"to_revision"`
+	FromID       uuid.UUID            `json:"from_id"`
+	ToID         uuid.UUID            `json:"to_id"`
+}
+
+// UpsertReportScheduleRequest holds the input for creating or changing a
+// tenant's reporting calendar for a report type.
+type UpsertReportScheduleRequest struct {
+	ReportType   string    `json:"report_type"`
+	Frequency    string    `json:"frequency"`
+	DeadlineDays int       `json:"deadline_days"`
+	LeadDays     int       `json:"lead_days"`
+	Active       bool      `json:"active"`
+	TenantID     uuid.UUID `json:"tenant_id"`
+}
+
+// ReportScheduleResponse holds a report schedule.
+type ReportScheduleResponse struct {
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	ReportType   string    `json:"report_type"`
+	Frequency    string    `json:"frequency"`
+	DeadlineDays int       `json:"deadline_days"`
+	LeadDays     int       `json:"lead_days"`
+	Active       bool      `json:"active"`
+	ID           uuid.UUID `json:"id"`
+	TenantID     uuid.UUID `json:"tenant_id"`
+}
+
+// ListScheduledRunsRequest holds the input for listing a tenant's scheduled runs.
+type ListScheduledRunsRequest struct {
+	Since    time.Time `json:"since"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+// ReportArtifactResponse describes a file produced by a report job.
+type ReportArtifactResponse struct {
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	SHA256      string `json:"sha256"`
+	SizeBytes   int64  `json:"size_bytes"`
+}
+
+// ReportJobResponse holds a report generation job and its progress.
+type ReportJobResponse struct {
+	CreatedAt       time.Time                `json:"created_at"`
+	UpdatedAt       time.Time                `json:"updated_at"`
+	StartedAt       *time.Time               `json:"started_at,omitempty"`
+	CompletedAt     *time.Time               `json:"completed_at,omitempty"`
+	ReportID        *uuid.UUID               `json:"report_id,omitempty"`
+	ReportType      string                   `json:"report_type"`
+	ReportingPeriod string                   `json:"reporting_period"`
+	Format          string                   `json:"format,omitempty"`
+	RequestedBy     string                   `json:"requested_by,omitempty"`
+	Status          string                   `json:"status"`
+	Stage           string                   `json:"stage,omitempty"`
+	FailureDetail   string                   `json:"failure_detail,omitempty"`
+	Artifacts       []ReportArtifactResponse `json:"artifacts"`
+	Progress        int                      `json:"progress"`
+	Attempts        int                      `json:"attempts"`
+	ID              uuid.UUID                `json:"id"`
+	TenantID        uuid.UUID                `json:"tenant_id"`
+}
+
+// ReportArtifactContent holds a report job's artifact for download.
+type ReportArtifactContent struct {
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	Content     []byte `json:"content"`
+}
+
+// ScheduledRunResponse holds a scheduled run and its deadline SLA.
+type ScheduledRunResponse struct {
+	Deadline        time.Time  `json:"deadline"`
+	SubmittedAt     *time.Time `json:"submitted_at,omitempty"`
+	ReportID        *uuid.UUID `json:"report_id,omitempty"`
+	ReportType      string     `json:"report_type"`
+	ReportingPeriod string     `json:"reporting_period"`
+	Status          string     `json:"status"`
+	FailureReason   string     `json:"failure_reason,omitempty"`
+	Attempts        int        `json:"attempts"`
+	SLAMet          bool       `json:"sla_met"`
+	ID              uuid.UUID  `json:"id"`
+	ScheduleID      uuid.UUID  `json:"schedule_id"`
+}
+
+// DeadlineSLASummary counts scheduled runs by deadline outcome.
+type DeadlineSLASummary struct {
+	// Open runs have not been submitted and their deadline has not passed.
+	Open int `json:"open"`
+	// Met runs were submitted by their deadline.
+	Met int `json:"met"`
+	// Late runs were submitted after their deadline.
+	Late int `json:"late"`
+	// Missed runs have passed their deadline without a submission.
+	Missed int `json:"missed"`
+}
+
+// ListScheduledRunsResponse holds a tenant's scheduled runs and their SLA summary.
+type ListScheduledRunsResponse struct {
+	Runs []ScheduledRunResponse `json:"runs"`
+	SLA  DeadlineSLASummary     `json:"sla"`
+}
+
+// RunReportSchedulesResult summarizes one pass of the report scheduler.
+type RunReportSchedulesResult struct {
+	Generated int `json:"generated"`
+	Failed    int `json:"failed"`
+	Submitted int `json:"submitted"`
+	Missed    int `json:"missed"`
+}
+
+// GetLiquidityMetricsRequest holds the input for computing a tenant's
+// liquidity ratios for a period.
+type GetLiquidityMetricsRequest struct {
+	Period   string    `json:"period"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+// LiquidityContributionResponse holds one source account's contribution to a
+// liquidity figure.
+type LiquidityContributionResponse struct {
+	AccountCode string `json:"account_code"`
+	Rule        string `json:"rule"`
+	Level       string `json:"level,omitempty"`
+	Balance     string `json:"balance"`
+	Weight      string `json:"weight"`
+	Amount      string `json:"amount"`
+}
+
+// LiquidityFigureResponse holds a component of the liquidity ratios and the
+// accounts it is drawn from. Amount differs from Gross where a regulatory cap
+// applies.
+type LiquidityFigureResponse struct {
+	Name          string                          `json:"name"`
+	Gross         string                          `json:"gross"`
+	Amount        string                          `json:"amount"`
+	Contributions []LiquidityContributionResponse `json:"contributions"`
+}
+
+// LiquidityMetricsResponse holds the LCR and NSFR with their drill-down.
+type LiquidityMetricsResponse struct {
+	Period      string                    `json:"period"`
+	LCR         string                    `json:"lcr"`
+	NSFR        string                    `json:"nsfr"`
+	NetOutflows string                    `json:"net_outflows"`
+	Figures     []LiquidityFigureResponse `json:"figures"`
+	TenantID    uuid.UUID                 `json:"tenant_id"`
+}
+
+// DashboardRangeRequest holds the input for a dashboard aggregate over
+// [From, To).
+type DashboardRangeRequest struct {
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+// DepositGrowthRequest holds the input for the deposit growth series.
+type DepositGrowthRequest struct {
+	From        time.Time `json:"from"`
+	To          time.Time `json:"to"`
+	Granularity string    `json:"granularity"`
+	TenantID    uuid.UUID `json:"tenant_id"`
+}
+
+// BalanceSheetLineResponse holds the customer book in one currency.
+type BalanceSheetLineResponse struct {
+	Currency        string `json:"currency"`
+	Loans           string `json:"loans"`
+	Deposits        string `json:"deposits"`
+	AccruedInterest string `json:"accrued_interest"`
+	LoanToDeposit   string `json:"loan_to_deposit"`
+	LoanCount       int    `json:"loan_count"`
+	DepositCount    int    `json:"deposit_count"`
+}
+
+// DepositGrowthPointResponse holds the deposit flows of one period and currency.
+type DepositGrowthPointResponse struct {
+	Period         string `json:"period"`
+	Currency       string `json:"currency"`
+	Opened         string `json:"opened"`
+	Closed         string `json:"closed"`
+	Net            string `json:"net"`
+	ClosingBalance string `json:"closing_balance"`
+}
+
+// LoanBookBucketResponse holds the loans in one bucket and currency.
+type LoanBookBucketResponse struct {
+	Bucket      string `json:"bucket"`
+	Currency    string `json:"currency"`
+	Outstanding string `json:"outstanding"`
+	Count       int    `json:"count"`
+}
+
+// RailVolumeResponse holds the payment volumes of one rail and currency.
+type RailVolumeResponse struct {
+	Rail          string `json:"rail"`
+	Currency      string `json:"currency"`
+	SettledAmount string `json:"settled_amount"`
+	Initiated     int    `json:"initiated"`
+	Settled       int    `json:"settled"`
+	Failed        int    `json:"failed"`
+	Reversed      int    `json:"reversed"`
+}
+
+// FraudDecisionRatesResponse holds the share of fraud assessments that
+// received each decision.
+type FraudDecisionRatesResponse struct {
+	Rates       []DecisionRateResponse `json:"rates"`
+	Assessments int                    `json:"assessments"`
+}
+
+// DecisionRateResponse holds the share of assessments with one decision.
+type DecisionRateResponse struct {
+	Decision string `json:"decision"`
+	Rate     string `json:"rate"`
+	Count    int    `json:"count"`
+}
+
+// ExportWarehouseResult summarizes one run of the warehouse export.
+type ExportWarehouseResult struct {
+	Exported int `json:"exported"`
+	Skipped  int `json:"skipped"`
+	Failed   int `json:"failed"`
+	Rows     int `json:"rows"`
+}
+
+// CheckConsistencyResult summarizes one run of the consistency checker.
+type CheckConsistencyResult struct {
+	Checked       int `json:"checked"`
+	Failed        int `json:"failed"`
+	Discrepancies int `json:"discrepancies"`
+}
+
+// ReviewReportRequest holds a compliance reviewer's decision on a generated
+// report: APPROVED, or CHANGES_REQUESTED with a comment.
+type ReviewReportRequest struct {
+	Decision string    `json:"decision"`
+	Reviewer string    `json:"reviewer"`
+	Comment  string    `json:"comment,omitempty"`
+	ID       uuid.UUID `json:"id"`
+}
+
+// ReviseReportRequest holds the input for regenerating a report its reviewer
+// sent back.
+type ReviseReportRequest struct {
+	Actor string    `json:"actor,omitempty"`
+	ID    uuid.UUID `json:"id"`
+}
+
+// ReportReviewResponse holds one compliance review of a report.
+type ReportReviewResponse struct {
+	ReviewedAt    time.Time `json:"reviewed_at"`
+	Decision      string    `json:"decision"`
+	Reviewer      string    `json:"reviewer"`
+	Comment       string    `json:"comment,omitempty"`
+	ContentSHA256 string    `json:"content_sha256"`
+	ID            uuid.UUID `json:"id"`
+	ReportID      uuid.UUID `json:"report_id"`
+	// Status is the report's status after the review.
+	Status string `json:"status"`
 }
@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+)
+
+// ErrStaleAcknowledgment is returned when an acknowledgment names a
+// transmission other than the submission's latest one.
+var ErrStaleAcknowledgment = errors.New("acknowledgment does not match the latest transmission")
+
+// ingestActor is the audit actor for acknowledgments collected by polling.
+const ingestActor = "system:ack-ingest"
+
+// RecordAcknowledgmentUseCase records a regulator ACK or NACK entered by an
+// operator, for channels the regulator acknowledges out of band.
+type RecordAcknowledgmentUseCase struct {
+	repo           port.ReportSubmissionRepository
+	audit          port.SubmissionAuditRepository
+	eventPublisher port.EventPublisher
+}
+
+// NewRecordAcknowledgmentUseCase creates a new RecordAcknowledgmentUseCase.
+func NewRecordAcknowledgmentUseCase(
+	repo port.ReportSubmissionRepository,
+	audit port.SubmissionAuditRepository,
+	eventPublisher port.EventPublisher,
+) *RecordAcknowledgmentUseCase {
+	return &RecordAcknowledgmentUseCase{
+		repo:           repo,
+		audit:          audit,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// Execute applies the acknowledgment to the submission.
+func (uc *RecordAcknowledgmentUseCase) Execute(ctx context.Context, req dto.RecordAcknowledgmentRequest) (dto.AcknowledgmentResponse, error) {
+	submission, err := uc.repo.FindByID(ctx, req.ID)
+	if err != nil {
+		return dto.AcknowledgmentResponse{}, fmt.Errorf("failed to find report submission: %w", err)
+	}
+
+	reference := req.Reference
+	if reference == "" {
+		reference = submission.Reference()
+	}
+	submission, err = applyAcknowledgment(ctx, uc.repo, uc.audit, uc.eventPublisher, submission, port.Acknowledgment{
+		Reference:  reference,
+		Accepted:   req.Accepted,
+		Errors:     req.Errors,
+		ReceivedAt: time.Now().UTC(),
+	}, req.Actor)
+	if err != nil {
+		return dto.AcknowledgmentResponse{}, err
+	}
+
+	return dto.AcknowledgmentResponse{
+		ID:               submission.ID(),
+		Status:           submission.Status().String(),
+		ValidationErrors: submission.ValidationErrors(),
+	}, nil
+}
+
+// IngestAcknowledgmentsUseCase polls the submission gateway for ACKs and NACKs
+// of outstanding filings and applies them.
+type IngestAcknowledgmentsUseCase struct {
+	repo           port.ReportSubmissionRepository
+	audit          port.SubmissionAuditRepository
+	gateway        port.SubmissionGateway
+	eventPublisher port.EventPublisher
+}
+
+// NewIngestAcknowledgmentsUseCase creates a new IngestAcknowledgmentsUseCase.
+func NewIngestAcknowledgmentsUseCase(
+	repo port.ReportSubmissionRepository,
+	audit port.SubmissionAuditRepository,
+	gateway port.SubmissionGateway,
+	eventPublisher port.EventPublisher,
+) *IngestAcknowledgmentsUseCase {
+	return &IngestAcknowledgmentsUseCase{
+		repo:           repo,
+		audit:          audit,
+		gateway:        gateway,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// Execute applies the acknowledgments received since the last pass. A failure
+// on one submission does not stop the pass; failures are returned together.
+func (uc *IngestAcknowledgmentsUseCase) Execute(ctx context.Context) (dto.IngestAcknowledgmentsResult, error) {
+	var result dto.IngestAcknowledgmentsResult
+
+	pending, err := uc.repo.ListAwaitingAcknowledgment(ctx, uc.gateway.Channel().String())
+	if err != nil {
+		return result, fmt.Errorf("failed to list submissions awaiting acknowledgment: %w", err)
+	}
+	if len(pending) == 0 {
+		return result, nil
+	}
+
+	byReference := make(map[string]model.ReportSubmission, len(pending))
+	references := make([]string, 0, len(pending))
+	for _, s := range pending {
+		byReference[s.Reference()] = s
+		references = append(references, s.Reference())
+	}
+
+	// Apply whatever was received even when some references failed to poll.
+	var errs []error
+	acks, err := uc.gateway.PollAcknowledgments(ctx, references)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to poll acknowledgments: %w", err))
+	}
+	for _, ack := range acks {
+		submission, ok := byReference[ack.Reference]
+		if !ok {
+			continue
+		}
+		if _, err := applyAcknowledgment(ctx, uc.repo, uc.audit, uc.eventPublisher, submission, ack, ingestActor); err != nil {
+			errs = append(errs, fmt.Errorf("submission %s: %w", submission.ID(), err))
+			continue
+		}
+		if ack.Accepted {
+			result.Accepted++
+		} else {
+			result.Rejected++
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+// applyAcknowledgment accepts or rejects the submission, audits the
+// acknowledgment and publishes the resulting events.
+func applyAcknowledgment(
+	ctx context.Context,
+	repo port.ReportSubmissionRepository,
+	audit port.SubmissionAuditRepository,
+	eventPublisher port.EventPublisher,
+	submission model.ReportSubmission,
+	ack port.Acknowledgment,
+	actor string,
+) (model.ReportSubmission, error) {
+	if ack.Reference != submission.Reference() {
+		return submission, fmt.Errorf("%w: got %q, latest is %q", ErrStaleAcknowledgment, ack.Reference, submission.Reference())
+	}
+
+	var (
+		action string
+		err    error
+	)
+	if ack.Accepted {
+		action = model.AuditActionAccepted
+		submission, err = submission.Accept(ack.ReceivedAt)
+	} else {
+		action = model.AuditActionRejected
+		reasons := ack.Errors
+		if len(reasons) == 0 {
+			reasons = []string{"rejected by regulator without detail"}
+		}
+		submission, err = submission.Reject(reasons, ack.ReceivedAt)
+	}
+	if err != nil {
+		return submission, fmt.Errorf("failed to apply acknowledgment: %w", err)
+	}
+
+	if err := repo.Save(ctx, submission); err != nil {
+		return submission, fmt.Errorf("failed to save acknowledged report: %w", err)
+	}
+
+	entry, err := model.NewSubmissionAuditEntry(submission, submission.Channel(), submission.Attempts(),
+		action, ack.Reference, strings.Join(ack.Errors, "; "), actor, ack.ReceivedAt)
+	if err != nil {
+		return submission, fmt.Errorf("failed to audit acknowledgment: %w", err)
+	}
+	if err := audit.Append(ctx, entry); err != nil {
+		return submission, fmt.Errorf("failed to audit acknowledgment: %w", err)
+	}
+
+	if events := submission.DomainEvents(); len(events) > 0 {
+		if err := eventPublisher.Publish(ctx, events...); err != nil {
+			return submission, fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+	return submission, nil
+}
+
+// ListSubmissionAuditUseCase lists a submission's audit trail.
+type ListSubmissionAuditUseCase struct {
+	audit port.SubmissionAuditRepository
+}
+
+// NewListSubmissionAuditUseCase creates a new ListSubmissionAuditUseCase.
+func NewListSubmissionAuditUseCase(audit port.SubmissionAuditRepository) *ListSubmissionAuditUseCase {
+	return &ListSubmissionAuditUseCase{audit: audit}
+}
+
+// Execute lists the submission's audit entries, oldest first.
+func (uc *ListSubmissionAuditUseCase) Execute(ctx context.Context, submissionID uuid.UUID) ([]dto.SubmissionAuditEntryResponse, error) {
+	entries, err := uc.audit.ListBySubmission(ctx, submissionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list submission audit: %w", err)
+	}
+	resp := make([]dto.SubmissionAuditEntryResponse, 0, len(entries))
+	for _, e := range entries {
+		resp = append(resp, dto.SubmissionAuditEntryResponse{
+			ID:            e.ID(),
+			Channel:       e.Channel().String(),
+			Attempt:       e.Attempt(),
+			Action:        e.Action(),
+			Reference:     e.Reference(),
+			Detail:        e.Detail(),
+			Actor:         e.Actor(),
+			ContentSHA256: e.ContentSHA256(),
+			OccurredAt:    e.OccurredAt(),
+		})
+	}
+	return resp, nil
+}
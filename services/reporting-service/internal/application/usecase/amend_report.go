@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+var (
+	// ErrInvalidAmendment is returned when an amendment lacks a valid reason.
+	ErrInvalidAmendment = errors.New("invalid report amendment")
+	// ErrAmendmentNotAllowed is returned when the report cannot be amended:
+	// it has not been filed, or a later version already amends it.
+	ErrAmendmentNotAllowed = errors.New("report cannot be amended")
+	// ErrInvalidVersionDiff is returned when two reports are not versions of
+	// the same filing.
+	ErrInvalidVersionDiff = errors.New("reports are not versions of the same filing")
+)
+
+// AmendReportUseCase generates an amended version of a filed report from the
+// ledger's current figures.
+type AmendReportUseCase struct {
+	repo           port.ReportSubmissionRepository
+	generateReport *GenerateReportUseCase
+	eventPublisher port.EventPublisher
+}
+
+// NewAmendReportUseCase creates a new AmendReportUseCase.
+func NewAmendReportUseCase(
+	repo port.ReportSubmissionRepository,
+	generateReport *GenerateReportUseCase,
+	eventPublisher port.EventPublisher,
+) *AmendReportUseCase {
+	return &AmendReportUseCase{
+		repo:           repo,
+		generateReport: generateReport,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// Execute amends the report. Only the latest version of a filing can be
+// amended, so the lineage stays linear.
+func (uc *AmendReportUseCase) Execute(ctx context.Context, req dto.AmendReportRequest) (dto.AmendReportResponse, error) {
+	reason, err := valueobject.NewAmendmentReason(strings.ToUpper(req.Reason))
+	if err != nil {
+		return dto.AmendReportResponse{}, fmt.Errorf("%w: %w", ErrInvalidAmendment, err)
+	}
+
+	previous, err := uc.repo.FindByID(ctx, req.ID)
+	if err != nil {
+		return dto.AmendReportResponse{}, fmt.Errorf("failed to find report submission: %w", err)
+	}
+	if previous.SubmittedAt() == nil {
+		return dto.AmendReportResponse{}, fmt.Errorf("%w: version %d has not been filed", ErrAmendmentNotAllowed, previous.Revision())
+	}
+
+	versions, err := uc.repo.FindVersions(ctx, previous.OriginalID())
+	if err != nil {
+		return dto.AmendReportResponse{}, fmt.Errorf("failed to find report versions: %w", err)
+	}
+	if latest := versions[len(versions)-1]; latest.ID() != previous.ID() {
+		return dto.AmendReportResponse{}, fmt.Errorf("%w: superseded by version %d", ErrAmendmentNotAllowed, latest.Revision())
+	}
+
+	amendment, err := model.NewAmendment(previous, reason, req.Note)
+	if err != nil {
+		return dto.AmendReportResponse{}, fmt.Errorf("%w: %w", ErrInvalidAmendment, err)
+	}
+	amendment = amendment.SetPreparer(req.Actor)
+	amendment, _, err = uc.generateReport.generate(ctx, amendment, noProgress)
+	if err != nil {
+		return dto.AmendReportResponse{}, err
+	}
+
+	changes, err := service.DiffFacts(previous.XBRLContent(), amendment.XBRLContent())
+	if err != nil {
+		return dto.AmendReportResponse{}, fmt.Errorf("failed to diff report versions: %w", err)
+	}
+
+	if err := uc.repo.Save(ctx, amendment); err != nil {
+		return dto.AmendReportResponse{}, fmt.Errorf("failed to save report amendment: %w", err)
+	}
+	if events := amendment.DomainEvents(); len(events) > 0 {
+		if err := uc.eventPublisher.Publish(ctx, events...); err != nil {
+			return dto.AmendReportResponse{}, fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+
+	return dto.AmendReportResponse{
+		ID:         amendment.ID(),
+		OriginalID: amendment.OriginalID(),
+		AmendsID:   previous.ID(),
+		Revision:   amendment.Revision(),
+		Status:     amendment.Status().String(),
+		Reason:     amendment.AmendmentReason().String(),
+		Note:       amendment.AmendmentNote(),
+		Changes:    toFactChangeResponses(changes),
+	}, nil
+}
+
+// ListReportVersionsUseCase lists every version of a report's filing.
+type ListReportVersionsUseCase struct {
+	repo port.ReportSubmissionRepository
+}
+
+// NewListReportVersionsUseCase creates a new ListReportVersionsUseCase.
+func NewListReportVersionsUseCase(repo port.ReportSubmissionRepository) *ListReportVersionsUseCase {
+	return &ListReportVersionsUseCase{repo: repo}
+}
+
+// Execute lists the versions of the filing the report belongs to, oldest first.
+func (uc *ListReportVersionsUseCase) Execute(ctx context.Context, id uuid.UUID) ([]dto.ReportVersionResponse, error) {
+	report, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find report submission: %w", err)
+	}
+	versions, err := uc.repo.FindVersions(ctx, report.OriginalID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find report versions: %w", err)
+	}
+
+	resp := make([]dto.ReportVersionResponse, 0, len(versions))
+	for _, v := range versions {
+		resp = append(resp, dto.ReportVersionResponse{
+			ID:              v.ID(),
+			Revision:        v.Revision(),
+			Status:          v.Status().String(),
+			AmendsID:        v.AmendsID(),
+			AmendmentReason: v.AmendmentReason().String(),
+			AmendmentNote:   v.AmendmentNote(),
+			GeneratedAt:     v.GeneratedAt(),
+			SubmittedAt:     v.SubmittedAt(),
+			CreatedAt:       v.CreatedAt(),
+		})
+	}
+	return resp, nil
+}
+
+// DiffReportVersionsUseCase compares the reported facts of two versions of a
+// filing.
+type DiffReportVersionsUseCase struct {
+	repo port.ReportSubmissionRepository
+}
+
+// NewDiffReportVersionsUseCase creates a new DiffReportVersionsUseCase.
+func NewDiffReportVersionsUseCase(repo port.ReportSubmissionRepository) *DiffReportVersionsUseCase {
+	return &DiffReportVersionsUseCase{repo: repo}
+}
+
+// Execute returns the facts that changed from one version to the other.
+func (uc *DiffReportVersionsUseCase) Execute(ctx context.Context, req dto.DiffReportVersionsRequest) (dto.DiffReportVersionsResponse, error) {
+	to, err := uc.repo.FindByID(ctx, req.ToID)
+	if err != nil {
+		return dto.DiffReportVersionsResponse{}, fmt.Errorf("failed to find report submission: %w", err)
+	}
+
+	fromID := req.FromID
+	if fromID == nil {
+		fromID = to.AmendsID()
+	}
+	if fromID == nil {
+		return dto.DiffReportVersionsResponse{}, fmt.Errorf("%w: version %d is the original filing", ErrInvalidVersionDiff, to.Revision())
+	}
+	from, err := uc.repo.FindByID(ctx, *fromID)
+	if err != nil {
+		return dto.DiffReportVersionsResponse{}, fmt.Errorf("failed to find report submission: %w", err)
+	}
+	if from.OriginalID() != to.OriginalID() {
+		return dto.DiffReportVersionsResponse{}, ErrInvalidVersionDiff
+	}
+
+	changes, err := service.DiffFacts(from.XBRLContent(), to.XBRLContent())
+	if err != nil {
+		return dto.DiffReportVersionsResponse{}, fmt.Errorf("failed to diff report versions: %w", err)
+	}
+	return dto.DiffReportVersionsResponse{
+		FromID:       from.ID(),
+		ToID:         to.ID(),
+		FromRevision: from.Revision(),
+		ToRevision:   to.Revision(),
+		Changes:      toFactChangeResponses(changes),
+	}, nil
+}
+
+func toFactChangeResponses(changes []service.FactChange) []dto.FactChangeResponse {
+	resp := make([]dto.FactChangeResponse, 0, len(changes))
+	for _, c := range changes {
+		r := dto.FactChangeResponse{
+			Concept: c.Concept,
+			Context: c.Context,
+			Unit:    c.Unit,
+			Change:  c.Change,
+			From:    c.From,
+			To:      c.To,
+		}
+		if c.Delta != nil {
+			r.Delta = c.Delta.String()
+		}
+		resp = append(resp, r)
+	}
+	return resp
+}
@@ -0,0 +1,146 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/event"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+// restatedLedgerClient returns the mock ledger's figures with a late income
+// adjustment booked to assets and equity.
+type restatedLedgerClient struct {
+	adjustment decimal.Decimal
+}
+
+func (c *restatedLedgerClient) GetFinancialData(ctx context.Context, tenantID uuid.UUID, period string) (service.ReportData, error) {
+	data, err := (&mockLedgerClient{}).GetFinancialData(ctx, tenantID, period)
+	data.TotalAssets = data.TotalAssets.Add(c.adjustment)
+	data.TotalEquity = data.TotalEquity.Add(c.adjustment)
+	data.NetIncome = data.NetIncome.Add(c.adjustment)
+	return data, err
+}
+
+type amendmentFixture struct {
+	repo      *inMemoryRepo
+	ledger    *restatedLedgerClient
+	publisher *mockEventPublisher
+	amend     *usecase.AmendReportUseCase
+	original  uuid.UUID
+}
+
+// newAmendmentFixture generates and files a FINREP report.
+func newAmendmentFixture(t *testing.T) amendmentFixture {
+	t.Helper()
+	f := amendmentFixture{
+		repo:      newInMemoryRepo(),
+		ledger:    &restatedLedgerClient{},
+		publisher: &mockEventPublisher{},
+	}
+	generate := usecase.NewGenerateReportUseCase(f.repo, f.publisher, f.ledger, service.NewXBRLGenerator(), service.NewReportRenderer(), nil)
+	f.amend = usecase.NewAmendReportUseCase(f.repo, generate, f.publisher)
+
+	report, err := generate.Execute(context.Background(), dto.GenerateReportRequest{
+		TenantID: uuid.New(), ReportType: "FINREP", Period: "2025-Q1",
+	})
+	require.NoError(t, err)
+	f.original = report.ID
+	f.file(t, report.ID)
+	return f
+}
+
+func (f amendmentFixture) file(t *testing.T, id uuid.UUID) {
+	t.Helper()
+	ctx := context.Background()
+	report, err := f.repo.FindByID(ctx, id)
+	require.NoError(t, err)
+	report, err = report.Approve("checker", report.UpdatedAt())
+	require.NoError(t, err)
+	report, err = report.Submit(valueobject.SubmissionChannelManual, "MANUAL-"+id.String(), report.UpdatedAt())
+	require.NoError(t, err)
+	require.NoError(t, f.repo.Save(ctx, report))
+}
+
+func TestAmendReportUseCase(t *testing.T) {
+	f := newAmendmentFixture(t)
+	ctx := context.Background()
+	f.ledger.adjustment = decimal.NewFromInt(2_000_000)
+	f.publisher.publishedEvents = nil
+
+	resp, err := f.amend.Execute(ctx, dto.AmendReportRequest{ID: f.original, Reason: "late_adjustment", Note: "Q1 accrual"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Revision)
+	assert.Equal(t, f.original, resp.OriginalID)
+	assert.Equal(t, f.original, resp.AmendsID)
+	assert.Equal(t, "READY", resp.Status)
+	assert.Equal(t, "LATE_ADJUSTMENT", resp.Reason)
+
+	require.Len(t, resp.Changes, 3)
+	assert.Equal(t, dto.FactChangeResponse{
+		Concept: "finrep:NetIncome", Context: "ctx_2025-Q1", Unit: "u_EUR", Change: "MODIFIED",
+		From: "15000000", To: "17000000", Delta: "2000000",
+	}, resp.Changes[0])
+	assert.Equal(t, "finrep:TotalAssets", resp.Changes[1].Concept)
+	assert.Equal(t, "finrep:TotalEquity", resp.Changes[2].Concept)
+
+	var amended int
+	for _, e := range f.publisher.publishedEvents {
+		if _, ok := e.(event.ReportAmended); ok {
+			amended++
+		}
+	}
+	assert.Equal(t, 1, amended)
+
+	// The original is superseded; only the latest version can be amended,
+	// and only once it has been filed.
+	_, err = f.amend.Execute(ctx, dto.AmendReportRequest{ID: f.original, Reason: "DATA_CORRECTION"})
+	require.ErrorIs(t, err, usecase.ErrAmendmentNotAllowed)
+	_, err = f.amend.Execute(ctx, dto.AmendReportRequest{ID: resp.ID, Reason: "DATA_CORRECTION"})
+	require.ErrorIs(t, err, usecase.ErrAmendmentNotAllowed)
+
+	f.file(t, resp.ID)
+	third, err := f.amend.Execute(ctx, dto.AmendReportRequest{ID: resp.ID, Reason: "REGULATOR_REQUEST"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, third.Revision)
+	assert.Empty(t, third.Changes)
+
+	versions, err := usecase.NewListReportVersionsUseCase(f.repo).Execute(ctx, third.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 3)
+	assert.Equal(t, []int{1, 2, 3}, []int{versions[0].Revision, versions[1].Revision, versions[2].Revision})
+	assert.Nil(t, versions[0].AmendsID)
+	assert.Equal(t, resp.ID, *versions[2].AmendsID)
+
+	diff := usecase.NewDiffReportVersionsUseCase(f.repo)
+	fromOriginal, err := diff.Execute(ctx, dto.DiffReportVersionsRequest{FromID: &f.original, ToID: third.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, fromOriginal.FromRevision)
+	assert.Equal(t, 3, fromOriginal.ToRevision)
+	assert.Len(t, fromOriginal.Changes, 3)
+
+	_, err = diff.Execute(ctx, dto.DiffReportVersionsRequest{ToID: f.original})
+	assert.ErrorIs(t, err, usecase.ErrInvalidVersionDiff, "the original has no predecessor")
+}
+
+func TestAmendReportUseCase_EnforcesReasonCodes(t *testing.T) {
+	f := newAmendmentFixture(t)
+	ctx := context.Background()
+
+	_, err := f.amend.Execute(ctx, dto.AmendReportRequest{ID: f.original, Reason: "TYPO"})
+	require.ErrorIs(t, err, usecase.ErrInvalidAmendment)
+	_, err = f.amend.Execute(ctx, dto.AmendReportRequest{ID: f.original, Reason: "OTHER"})
+	require.ErrorIs(t, err, usecase.ErrInvalidAmendment)
+
+	resp, err := f.amend.Execute(ctx, dto.AmendReportRequest{ID: f.original, Reason: "OTHER", Note: "auditor finding"})
+	require.NoError(t, err)
+	assert.Equal(t, "auditor finding", resp.Note)
+}
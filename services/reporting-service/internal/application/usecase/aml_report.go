@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+// ErrInvalidPeriod is returned when an AML report's period is not a day,
+// month or quarter.
+var ErrInvalidPeriod = errors.New("invalid reporting period")
+
+// AMLReportBuilder sources AML reports from the fraud and payment services
+// and generates their goAML filings. Suspicious activity reports cover the
+// cases confirmed as fraud in the period; currency transaction reports cover
+// the payments of accounts whose daily totals reach the CTR threshold.
+type AMLReportBuilder struct {
+	cases        port.FraudCaseClient
+	payments     port.PaymentDataClient
+	generator    *service.GoAMLGenerator
+	ctrThreshold decimal.Decimal
+}
+
+// NewAMLReportBuilder creates a new AMLReportBuilder.
+func NewAMLReportBuilder(
+	cases port.FraudCaseClient,
+	payments port.PaymentDataClient,
+	generator *service.GoAMLGenerator,
+	ctrThreshold decimal.Decimal,
+) *AMLReportBuilder {
+	return &AMLReportBuilder{
+		cases:        cases,
+		payments:     payments,
+		generator:    generator,
+		ctrThreshold: ctrThreshold,
+	}
+}
+
+// generate fetches the report's data, links the cases it was built from and
+// sets its goAML content, leaving the submission READY.
+func (b *AMLReportBuilder) generate(ctx context.Context, submission model.ReportSubmission) (model.ReportSubmission, error) {
+	from, to, err := service.PeriodRange(submission.ReportingPeriod())
+	if err != nil {
+		return submission, fmt.Errorf("%w: %w", ErrInvalidPeriod, err)
+	}
+
+	data := service.AMLReportData{TenantID: submission.TenantID(), Period: submission.ReportingPeriod()}
+	switch {
+	case submission.ReportType().Equal(valueobject.ReportTypeSAR):
+		data.Cases, err = b.suspiciousActivity(ctx, submission, from, to)
+	case submission.ReportType().Equal(valueobject.ReportTypeCTR):
+		data.Transactions, err = b.currencyTransactions(ctx, submission, from, to)
+	default:
+		err = fmt.Errorf("unsupported AML report type: %s", submission.ReportType())
+	}
+	if err != nil {
+		return submission, err
+	}
+
+	submission, err = submission.LinkCases(data.CaseIDs())
+	if err != nil {
+		return submission, fmt.Errorf("failed to link cases: %w", err)
+	}
+
+	now := time.Now().UTC()
+	content, err := b.generator.Generate(submission.ReportType(), submission.ID().String(), data, now)
+	if err != nil {
+		return submission, fmt.Errorf("failed to generate goAML: %w", err)
+	}
+	submission, err = submission.SetGenerated(content, now)
+	if err != nil {
+		return submission, fmt.Errorf("failed to set generated content: %w", err)
+	}
+	return submission, nil
+}
+
+// suspiciousActivity returns the cases confirmed as fraud in the period, each
+// with the payment it was raised on and, for AML alerts, the payments making
+// up the pattern.
+func (b *AMLReportBuilder) suspiciousActivity(ctx context.Context, submission model.ReportSubmission, from, to time.Time) ([]service.AMLCase, error) {
+	cases, err := b.cases.ListConfirmedCases(ctx, submission.TenantID(), from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch fraud cases: %w", err)
+	}
+	for i, c := range cases {
+		ids := append([]string{c.TransactionID}, c.AlertTransactionIDs...)
+		seen := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			payment, err := b.payments.GetPayment(ctx, submission.TenantID(), id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch payment %s for case %s: %w", id, c.ID, err)
+			}
+			cases[i].Transactions = append(cases[i].Transactions, payment)
+		}
+	}
+	return cases, nil
+}
+
+// currencyTransactions returns the period's payments that reach the CTR
+// threshold.
+func (b *AMLReportBuilder) currencyTransactions(ctx context.Context, submission model.ReportSubmission, from, to time.Time) ([]service.AMLTransaction, error) {
+	payments, err := b.payments.ListSettledPayments(ctx, submission.TenantID(), from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch payments: %w", err)
+	}
+	return service.SelectCurrencyTransactions(payments, b.ctrThreshold), nil
+}
@@ -0,0 +1,156 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+type fakeFraudCaseClient struct {
+	cases []service.AMLCase
+}
+
+func (c *fakeFraudCaseClient) ListConfirmedCases(_ context.Context, _ uuid.UUID, from, to time.Time) ([]service.AMLCase, error) {
+	var result []service.AMLCase
+	for _, fc := range c.cases {
+		if !fc.ResolvedAt.Before(from) && fc.ResolvedAt.Before(to) {
+			result = append(result, fc)
+		}
+	}
+	return result, nil
+}
+
+type fakePaymentDataClient struct {
+	payments map[string]service.AMLTransaction
+	records  []service.PaymentRecord
+}
+
+func (c *fakePaymentDataClient) GetPayment(_ context.Context, _ uuid.UUID, paymentID string) (service.AMLTransaction, error) {
+	p, ok := c.payments[paymentID]
+	if !ok {
+		return service.AMLTransaction{}, errors.New("payment not found")
+	}
+	return p, nil
+}
+
+func (c *fakePaymentDataClient) ListSettledPayments(_ context.Context, _ uuid.UUID, from, to time.Time) ([]service.AMLTransaction, error) {
+	var result []service.AMLTransaction
+	for _, p := range c.payments {
+		if !p.Date.Before(from) && p.Date.Before(to) {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+func (c *fakePaymentDataClient) ListPayments(_ context.Context, _ uuid.UUID, from, to time.Time) ([]service.PaymentRecord, error) {
+	var result []service.PaymentRecord
+	for _, p := range c.records {
+		if !p.CreatedAt.Before(from) && p.CreatedAt.Before(to) {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+// amlFixture holds a confirmed fraud case resolved in March 2025 on a large
+// payment, a small payment on the same day, and a confirmed structuring
+// alert over two payments just below the threshold.
+type amlFixture struct {
+	repo *inMemoryRepo
+	uc   *usecase.GenerateReportUseCase
+}
+
+func newAMLFixture() amlFixture {
+	day := time.Date(2025, 3, 14, 10, 0, 0, 0, time.UTC)
+	payments := &fakePaymentDataClient{payments: map[string]service.AMLTransaction{
+		"pay-1": {ID: "pay-1", FromAccount: "acc-1", ToAccount: "ext-1", Currency: "EUR", Amount: decimal.NewFromInt(12_000), Rail: "SEPA", Date: day},
+		"pay-2": {ID: "pay-2", FromAccount: "acc-2", ToAccount: "ext-2", Currency: "EUR", Amount: decimal.NewFromInt(300), Rail: "SEPA", Date: day},
+		"pay-3": {ID: "pay-3", FromAccount: "acc-3", ToAccount: "ext-3", Currency: "EUR", Amount: decimal.NewFromInt(9_500), Rail: "SEPA", Date: day},
+		"pay-4": {ID: "pay-4", FromAccount: "acc-3", ToAccount: "ext-3", Currency: "EUR", Amount: decimal.NewFromInt(9_800), Rail: "SEPA", Date: day},
+	}}
+	cases := &fakeFraudCaseClient{cases: []service.AMLCase{{
+		ID: "case-1", TransactionID: "pay-1", RiskLevel: "HIGH", RiskScore: 88,
+		ResolvedAt: day.AddDate(0, 0, 2), Notes: []string{"Funds moved on within minutes."},
+	}, {
+		ID: "case-2", TransactionID: "pay-4", RiskLevel: "HIGH", RiskScore: 70, Scenario: "STRUCTURING",
+		AlertTransactionIDs: []string{"pay-3", "pay-4"}, ResolvedAt: day.AddDate(0, 0, 3),
+	}}}
+
+	repo := newInMemoryRepo()
+	aml := usecase.NewAMLReportBuilder(cases, payments, service.NewGoAMLGenerator("4711", "BIB Bank", "EUR"), decimal.NewFromInt(10_000))
+	return amlFixture{
+		repo: repo,
+		uc: usecase.NewGenerateReportUseCase(repo, &mockEventPublisher{}, &mockLedgerClient{},
+			service.NewXBRLGenerator(), service.NewReportRenderer(), aml),
+	}
+}
+
+func TestGenerateReportUseCase_SuspiciousActivityReport(t *testing.T) {
+	f := newAMLFixture()
+	ctx := context.Background()
+
+	resp, err := f.uc.Execute(ctx, dto.GenerateReportRequest{TenantID: uuid.New(), ReportType: "SAR", Period: "2025-03"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "READY", resp.Status)
+	assert.Equal(t, "GOAML", resp.Format)
+	assert.Equal(t, "application/xml", resp.ContentType)
+	assert.Equal(t, "sar-2025-03.xml", resp.FileName)
+	assert.Contains(t, string(resp.Content), "<report_code>STR</report_code>")
+	assert.Contains(t, string(resp.Content), "<internal_ref_number>case-1</internal_ref_number>")
+	assert.Contains(t, string(resp.Content), "Funds moved on within minutes.")
+	assert.Contains(t, string(resp.Content), "AML STRUCTURING case case-2")
+	assert.Contains(t, string(resp.Content), "<transactionnumber>pay-3</transactionnumber>")
+	assert.Equal(t, 1, strings.Count(string(resp.Content), "<transactionnumber>pay-4</transactionnumber>"))
+
+	report, err := usecase.NewGetReportUseCase(f.repo).Execute(ctx, dto.GetReportRequest{ID: resp.ID})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"case-1", "case-2"}, report.LinkedCaseIDs)
+	assert.Equal(t, string(resp.Content), report.XBRLContent)
+
+	// No cases were resolved in February.
+	resp, err = f.uc.Execute(ctx, dto.GenerateReportRequest{TenantID: uuid.New(), ReportType: "SAR", Period: "2025-02"})
+	require.NoError(t, err)
+	assert.NotContains(t, string(resp.Content), "<transaction>")
+}
+
+func TestGenerateReportUseCase_CurrencyTransactionReport(t *testing.T) {
+	f := newAMLFixture()
+
+	resp, err := f.uc.Execute(context.Background(), dto.GenerateReportRequest{TenantID: uuid.New(), ReportType: "CTR", Period: "2025-03-14"})
+	require.NoError(t, err)
+
+	assert.Contains(t, string(resp.Content), "<report_code>CTR</report_code>")
+	assert.Contains(t, string(resp.Content), "<transactionnumber>pay-1</transactionnumber>")
+	assert.NotContains(t, string(resp.Content), "pay-2", "below the CTR threshold")
+
+	report, err := f.repo.FindByID(context.Background(), resp.ID)
+	require.NoError(t, err)
+	assert.Empty(t, report.LinkedCaseIDs())
+}
+
+func TestGenerateReportUseCase_AMLReportValidation(t *testing.T) {
+	f := newAMLFixture()
+	ctx := context.Background()
+
+	_, err := f.uc.Execute(ctx, dto.GenerateReportRequest{TenantID: uuid.New(), ReportType: "SAR", Period: "2025-03", Format: "csv"})
+	assert.ErrorIs(t, err, usecase.ErrUnsupportedFormat)
+
+	_, err = f.uc.Execute(ctx, dto.GenerateReportRequest{TenantID: uuid.New(), ReportType: "COREP", Period: "2025-Q1", Format: "goaml"})
+	assert.ErrorIs(t, err, usecase.ErrUnsupportedFormat)
+
+	_, err = f.uc.Execute(ctx, dto.GenerateReportRequest{TenantID: uuid.New(), ReportType: "CTR", Period: "March"})
+	assert.ErrorIs(t, err, usecase.ErrInvalidPeriod)
+}
@@ -0,0 +1,139 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/event"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+type fakeLedgerBalanceClient struct {
+	balances map[string]decimal.Decimal
+}
+
+func (c *fakeLedgerBalanceClient) GetBalance(_ context.Context, accountCode, _ string) (decimal.Decimal, error) {
+	return c.balances[accountCode], nil
+}
+
+type fakeCardDataClient struct {
+	cards []service.CardExposure
+	err   error
+}
+
+func (c *fakeCardDataClient) ListCards(context.Context, uuid.UUID) ([]service.CardExposure, error) {
+	return c.cards, c.err
+}
+
+type memConsistencyReportRepo struct {
+	reports []service.ConsistencyReport
+}
+
+func (r *memConsistencyReportRepo) Save(_ context.Context, report service.ConsistencyReport) error {
+	r.reports = append(r.reports, report)
+	return nil
+}
+
+// consistencyFixture holds one tenant with a checking account, a payment
+// settled on 14 March 2025 and a card, all consistent.
+type consistencyFixture struct {
+	balances  *fakeLedgerBalanceClient
+	journals  *fakeJournalEntryClient
+	cards     *fakeCardDataClient
+	reports   *memConsistencyReportRepo
+	publisher *mockEventPublisher
+	now       time.Time
+	tenantID  uuid.UUID
+}
+
+func newConsistencyFixture() (consistencyFixture, *usecase.CheckConsistencyUseCase) {
+	f := consistencyFixture{
+		balances:  &fakeLedgerBalanceClient{balances: map[string]decimal.Decimal{"2000-001": decimal.NewFromInt(-60)}},
+		cards:     &fakeCardDataClient{},
+		reports:   &memConsistencyReportRepo{},
+		publisher: &mockEventPublisher{},
+		now:       time.Date(2025, time.March, 15, 6, 0, 0, 0, time.UTC),
+		tenantID:  uuid.New(),
+	}
+	day := time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC)
+	accounts := &fakeAccountDataClient{accounts: []service.AccountRecord{
+		{ID: "a1", Currency: "USD", LedgerAccountCode: "2000-001", TenantID: f.tenantID},
+		{ID: "a2", Currency: "USD", TenantID: f.tenantID},
+	}}
+	payments := &fakePaymentDataClient{records: []service.PaymentRecord{
+		{ID: "p1", Status: "SETTLED", Currency: "USD", Amount: decimal.NewFromInt(40), CreatedAt: day.Add(9 * time.Hour), TenantID: f.tenantID},
+	}}
+	usd := func(debit, credit string, amount int64) []service.JournalPostingRecord {
+		return []service.JournalPostingRecord{{DebitAccount: debit, CreditAccount: credit, Currency: "USD", Amount: decimal.NewFromInt(amount)}}
+	}
+	f.journals = &fakeJournalEntryClient{entries: []service.JournalEntryRecord{
+		{ID: "je1", Status: "POSTED", EffectiveDate: day.AddDate(-1, 0, 0), Postings: usd("1000", "2000-001", 100)},
+		{ID: "je2", Status: "POSTED", EffectiveDate: day, Reference: "payment/p1/hold", Postings: usd("2000-001", "2900-001", 40)},
+		{ID: "je3", Status: "POSTED", EffectiveDate: day, Reference: "payment/p1/clearing", Postings: usd("2900-001", "1150-001", 40)},
+	}}
+	f.cards.cards = []service.CardExposure{{ID: "c1", Currency: "USD",
+		DailyLimit: decimal.NewFromInt(500), MonthlyLimit: decimal.NewFromInt(2000),
+		DailySpent: decimal.NewFromInt(100), MonthlySpent: decimal.NewFromInt(100)}}
+
+	uc := usecase.NewCheckConsistencyUseCase(accounts, f.balances, f.journals, payments, f.cards,
+		&tenantRecordingCredentials{}, f.reports, f.publisher, usecase.ConsistencyCheckConfig{
+			ControlAccounts: []string{"2000"},
+			PaymentLookback: 7 * 24 * time.Hour,
+		}, []uuid.UUID{f.tenantID})
+	return f, uc
+}
+
+func TestCheckConsistency_Consistent(t *testing.T) {
+	f, uc := newConsistencyFixture()
+
+	result, err := uc.Execute(context.Background(), f.now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Checked)
+	assert.Zero(t, result.Discrepancies)
+
+	require.Len(t, f.reports.reports, 1, "clean runs are reported too")
+	assert.Equal(t, f.tenantID, f.reports.reports[0].TenantID)
+	assert.Len(t, f.reports.reports[0].Invariants, 3)
+	assert.Empty(t, f.publisher.publishedEvents, "no alert without discrepancies")
+}
+
+func TestCheckConsistency_Discrepancies(t *testing.T) {
+	f, uc := newConsistencyFixture()
+	f.balances.balances["2000-001"] = decimal.NewFromInt(-65)
+	f.journals.entries = f.journals.entries[:2] // the clearing entry is missing
+	f.cards.cards[0].DailySpent = decimal.NewFromInt(600)
+
+	result, err := uc.Execute(context.Background(), f.now)
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Discrepancies)
+
+	require.Len(t, f.publisher.publishedEvents, 1)
+	alert, ok := f.publisher.publishedEvents[0].(event.ConsistencyCheckFailed)
+	require.True(t, ok)
+	assert.Equal(t, "consistency.check.failed", alert.EventType())
+	require.Len(t, alert.Discrepancies, 3)
+	assert.Equal(t, service.InvariantControlAccount, alert.Discrepancies[0].Invariant)
+	assert.Equal(t, "-60", alert.Discrepancies[0].Expected)
+	assert.Equal(t, "-65", alert.Discrepancies[0].Actual)
+	assert.Equal(t, service.InvariantSettledPaymentJournal, alert.Discrepancies[1].Invariant)
+	assert.Equal(t, service.InvariantCardHoldsWithinLimits, alert.Discrepancies[2].Invariant)
+	assert.Equal(t, f.reports.reports[0].ID.String(), alert.AggregateID())
+}
+
+func TestCheckConsistency_TenantFailure(t *testing.T) {
+	f, uc := newConsistencyFixture()
+	f.cards.err = errors.New("card service unavailable")
+
+	result, err := uc.Execute(context.Background(), f.now)
+	assert.ErrorContains(t, err, "card service unavailable")
+	assert.Equal(t, 1, result.Failed)
+	assert.Empty(t, f.reports.reports, "a partial check is not reported")
+}
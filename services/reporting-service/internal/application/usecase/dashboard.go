@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// maxDashboardRange bounds the period a dashboard aggregate may cover.
+const maxDashboardRange = 366 * 24 * time.Hour
+
+// ErrInvalidRange is returned when a dashboard's date range is empty, too
+// long or uses an unknown granularity.
+var ErrInvalidRange = errors.New("invalid dashboard range")
+
+func validateRange(from, to time.Time) error {
+	if !from.Before(to) {
+		return fmt.Errorf("%w: from %s is not before to %s", ErrInvalidRange, from.Format(time.DateOnly), to.Format(time.DateOnly))
+	}
+	if to.Sub(from) > maxDashboardRange {
+		return fmt.Errorf("%w: ranges are limited to a year", ErrInvalidRange)
+	}
+	return nil
+}
+
+// GetBalanceSheetSummaryUseCase summarizes the customer loans and deposits on
+// a tenant's book.
+type GetBalanceSheetSummaryUseCase struct {
+	readModel port.DashboardReadModel
+}
+
+// NewGetBalanceSheetSummaryUseCase creates a new GetBalanceSheetSummaryUseCase.
+func NewGetBalanceSheetSummaryUseCase(readModel port.DashboardReadModel) *GetBalanceSheetSummaryUseCase {
+	return &GetBalanceSheetSummaryUseCase{readModel: readModel}
+}
+
+// Execute summarizes the tenant's book by currency.
+func (uc *GetBalanceSheetSummaryUseCase) Execute(ctx context.Context, tenantID uuid.UUID) ([]dto.BalanceSheetLineResponse, error) {
+	deposits, err := uc.readModel.ListDepositPositions(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deposit positions: %w", err)
+	}
+	loans, err := uc.readModel.ListLoanPositions(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list loans: %w", err)
+	}
+
+	lines := service.SummarizeBalanceSheet(deposits, loans)
+	resp := make([]dto.BalanceSheetLineResponse, 0, len(lines))
+	for _, l := range lines {
+		resp = append(resp, dto.BalanceSheetLineResponse{
+			Currency:        l.Currency,
+			Loans:           l.Loans.String(),
+			Deposits:        l.Deposits.String(),
+			AccruedInterest: l.AccruedInterest.String(),
+			LoanToDeposit:   l.LoanToDeposit.StringFixed(4),
+			LoanCount:       l.LoanCount,
+			DepositCount:    l.DepositCount,
+		})
+	}
+	return resp, nil
+}
+
+// GetDepositGrowthUseCase returns a tenant's deposit flows and balances by
+// day or month.
+type GetDepositGrowthUseCase struct {
+	readModel port.DashboardReadModel
+}
+
+// NewGetDepositGrowthUseCase creates a new GetDepositGrowthUseCase.
+func NewGetDepositGrowthUseCase(readModel port.DashboardReadModel) *GetDepositGrowthUseCase {
+	return &GetDepositGrowthUseCase{readModel: readModel}
+}
+
+// Execute returns the deposit growth series over the request's range. The
+// granularity defaults to DAY.
+func (uc *GetDepositGrowthUseCase) Execute(ctx context.Context, req dto.DepositGrowthRequest) ([]dto.DepositGrowthPointResponse, error) {
+	if err := validateRange(req.From, req.To); err != nil {
+		return nil, err
+	}
+	granularity := req.Granularity
+	if granularity == "" {
+		granularity = service.GranularityDay
+	}
+	if granularity != service.GranularityDay && granularity != service.GranularityMonth {
+		return nil, fmt.Errorf("%w: unsupported granularity %q", ErrInvalidRange, granularity)
+	}
+
+	deposits, err := uc.readModel.ListDepositPositions(ctx, req.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deposit positions: %w", err)
+	}
+	points, err := service.DepositGrowth(deposits, req.From, req.To, granularity)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRange, err)
+	}
+
+	resp := make([]dto.DepositGrowthPointResponse, 0, len(points))
+	for _, p := range points {
+		resp = append(resp, dto.DepositGrowthPointResponse{
+			Period:         p.Period,
+			Currency:       p.Currency,
+			Opened:         p.Opened.String(),
+			Closed:         p.Closed.String(),
+			Net:            p.Net.String(),
+			ClosingBalance: p.ClosingBalance.String(),
+		})
+	}
+	return resp, nil
+}
+
+// GetLoanBookUseCase groups a tenant's loan book by repayment bucket.
+type GetLoanBookUseCase struct {
+	readModel port.DashboardReadModel
+}
+
+// NewGetLoanBookUseCase creates a new GetLoanBookUseCase.
+func NewGetLoanBookUseCase(readModel port.DashboardReadModel) *GetLoanBookUseCase {
+	return &GetLoanBookUseCase{readModel: readModel}
+}
+
+// Execute returns the tenant's loans on book by bucket and currency.
+func (uc *GetLoanBookUseCase) Execute(ctx context.Context, tenantID uuid.UUID) ([]dto.LoanBookBucketResponse, error) {
+	loans, err := uc.readModel.ListLoanPositions(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list loans: %w", err)
+	}
+
+	buckets := service.BucketLoanBook(loans)
+	resp := make([]dto.LoanBookBucketResponse, 0, len(buckets))
+	for _, b := range buckets {
+		resp = append(resp, dto.LoanBookBucketResponse{
+			Bucket:      b.Bucket,
+			Currency:    b.Currency,
+			Outstanding: b.Outstanding.String(),
+			Count:       b.Count,
+		})
+	}
+	return resp, nil
+}
+
+// GetPaymentVolumesUseCase returns a tenant's payment volumes by rail.
+type GetPaymentVolumesUseCase struct {
+	readModel port.DashboardReadModel
+}
+
+// NewGetPaymentVolumesUseCase creates a new GetPaymentVolumesUseCase.
+func NewGetPaymentVolumesUseCase(readModel port.DashboardReadModel) *GetPaymentVolumesUseCase {
+	return &GetPaymentVolumesUseCase{readModel: readModel}
+}
+
+// Execute returns the volumes of the payments initiated in the request's range.
+func (uc *GetPaymentVolumesUseCase) Execute(ctx context.Context, req dto.DashboardRangeRequest) ([]dto.RailVolumeResponse, error) {
+	if err := validateRange(req.From, req.To); err != nil {
+		return nil, err
+	}
+	payments, err := uc.readModel.ListPayments(ctx, req.TenantID, req.From, req.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payments: %w", err)
+	}
+
+	volumes := service.PaymentVolumesByRail(payments)
+	resp := make([]dto.RailVolumeResponse, 0, len(volumes))
+	for _, v := range volumes {
+		resp = append(resp, dto.RailVolumeResponse{
+			Rail:          v.Rail,
+			Currency:      v.Currency,
+			SettledAmount: v.SettledAmount.String(),
+			Initiated:     v.Initiated,
+			Settled:       v.Settled,
+			Failed:        v.Failed,
+			Reversed:      v.Reversed,
+		})
+	}
+	return resp, nil
+}
+
+// GetFraudDecisionRatesUseCase returns the rates of a tenant's fraud decisions.
+type GetFraudDecisionRatesUseCase struct {
+	readModel port.DashboardReadModel
+}
+
+// NewGetFraudDecisionRatesUseCase creates a new GetFraudDecisionRatesUseCase.
+func NewGetFraudDecisionRatesUseCase(readModel port.DashboardReadModel) *GetFraudDecisionRatesUseCase {
+	return &GetFraudDecisionRatesUseCase{readModel: readModel}
+}
+
+// Execute returns the decision rates of the assessments made in the request's range.
+func (uc *GetFraudDecisionRatesUseCase) Execute(ctx context.Context, req dto.DashboardRangeRequest) (dto.FraudDecisionRatesResponse, error) {
+	if err := validateRange(req.From, req.To); err != nil {
+		return dto.FraudDecisionRatesResponse{}, err
+	}
+	decisions, err := uc.readModel.ListFraudDecisions(ctx, req.TenantID, req.From, req.To)
+	if err != nil {
+		return dto.FraudDecisionRatesResponse{}, fmt.Errorf("failed to list fraud decisions: %w", err)
+	}
+
+	rates, total := service.FraudDecisionRates(decisions)
+	resp := dto.FraudDecisionRatesResponse{
+		Assessments: total,
+		Rates:       make([]dto.DecisionRateResponse, 0, len(rates)),
+	}
+	for _, r := range rates {
+		resp.Rates = append(resp.Rates, dto.DecisionRateResponse{
+			Decision: r.Decision,
+			Rate:     r.Rate.StringFixed(4),
+			Count:    r.Count,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// warehouseDataset is a curated dataset landed by the warehouse export.
+type warehouseDataset struct {
+	rows    func(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([][]any, error)
+	name    string
+	columns []service.WarehouseColumn
+}
+
+// ExportWarehouseUseCase lands a day's curated datasets in object storage as
+// Parquet, partitioned by day and tenant, so analysts query the warehouse
+// rather than the production databases. Payments are partitioned by the day
+// they were created and journal entries by the day they take effect; accounts
+// and loans are snapshots taken when the day is exported.
+type ExportWarehouseUseCase struct {
+	accounts    port.AccountDataClient
+	payments    port.PaymentDataClient
+	journals    port.JournalEntryClient
+	readModel   port.DashboardReadModel
+	credentials port.TenantCredentials
+	store       port.ObjectStore
+	repo        port.WarehouseRepository
+	prefix      string
+	tenants     []uuid.UUID
+}
+
+// NewExportWarehouseUseCase creates a new ExportWarehouseUseCase exporting
+// the given tenants' data under the object key prefix.
+func NewExportWarehouseUseCase(
+	accounts port.AccountDataClient,
+	payments port.PaymentDataClient,
+	journals port.JournalEntryClient,
+	readModel port.DashboardReadModel,
+	credentials port.TenantCredentials,
+	store port.ObjectStore,
+	repo port.WarehouseRepository,
+	prefix string,
+	tenants []uuid.UUID,
+) *ExportWarehouseUseCase {
+	return &ExportWarehouseUseCase{
+		accounts:    accounts,
+		payments:    payments,
+		journals:    journals,
+		readModel:   readModel,
+		credentials: credentials,
+		store:       store,
+		repo:        repo,
+		prefix:      prefix,
+		tenants:     tenants,
+	}
+}
+
+// Execute exports every dataset for every tenant for the UTC day containing
+// day. Partitions already landed are skipped, so a failed run can be retried;
+// a failure on one partition does not stop the others.
+func (uc *ExportWarehouseUseCase) Execute(ctx context.Context, day time.Time) (dto.ExportWarehouseResult, error) {
+	var (
+		result dto.ExportWarehouseResult
+		errs   []error
+	)
+	from := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+	now := time.Now().UTC()
+
+	for _, ds := range uc.datasets() {
+		schema, err := uc.evolveSchema(ctx, ds, now)
+		if err != nil {
+			result.Failed += len(uc.tenants)
+			errs = append(errs, fmt.Errorf("dataset %s: %w", ds.name, err))
+			continue
+		}
+		for _, tenantID := range uc.tenants {
+			exported, err := uc.exportPartition(ctx, ds, schema, tenantID, from, to, now, &result)
+			if err != nil {
+				result.Failed++
+				errs = append(errs, fmt.Errorf("dataset %s tenant %s: %w", ds.name, tenantID, err))
+				continue
+			}
+			if exported {
+				result.Exported++
+			} else {
+				result.Skipped++
+			}
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+func (uc *ExportWarehouseUseCase) datasets() []warehouseDataset {
+	return []warehouseDataset{
+		{name: service.DatasetAccounts, columns: service.AccountColumns, rows: uc.accountRows},
+		{name: service.DatasetPayments, columns: service.PaymentColumns, rows: uc.paymentRows},
+		{name: service.DatasetLoans, columns: service.LoanColumns, rows: uc.loanRows},
+		{name: service.DatasetJournalEntries, columns: service.JournalEntryColumns, rows: uc.journalEntryRows},
+	}
+}
+
+// evolveSchema reconciles the dataset's columns with the schema it was last
+// exported with, landing the schema file of any new or changed version.
+func (uc *ExportWarehouseUseCase) evolveSchema(ctx context.Context, ds warehouseDataset, now time.Time) (service.WarehouseSchema, error) {
+	var previous *service.WarehouseSchema
+	stored, err := uc.repo.FindSchema(ctx, ds.name)
+	switch {
+	case err == nil:
+		previous = &stored
+	case !errors.Is(err, port.ErrSchemaNotFound):
+		return service.WarehouseSchema{}, fmt.Errorf("failed to find schema: %w", err)
+	}
+
+	schema, change := service.EvolveSchema(previous, ds.name, ds.columns, now)
+	if change == service.SchemaUnchanged {
+		return schema, nil
+	}
+	body, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return service.WarehouseSchema{}, fmt.Errorf("failed to marshal schema: %w", err)
+	}
+	if err := uc.store.Put(ctx, service.WarehouseSchemaKey(uc.prefix, ds.name, schema.Version), body, "application/json"); err != nil {
+		return service.WarehouseSchema{}, fmt.Errorf("failed to write schema: %w", err)
+	}
+	if err := uc.repo.SaveSchema(ctx, schema); err != nil {
+		return service.WarehouseSchema{}, fmt.Errorf("failed to save schema: %w", err)
+	}
+	return schema, nil
+}
+
+// exportPartition lands a tenant's partition of a dataset for the day. It
+// reports false when the partition was already landed.
+func (uc *ExportWarehouseUseCase) exportPartition(
+	ctx context.Context,
+	ds warehouseDataset,
+	schema service.WarehouseSchema,
+	tenantID uuid.UUID,
+	from, to, now time.Time,
+	result *dto.ExportWarehouseResult,
+) (bool, error) {
+	done, err := uc.repo.HasExport(ctx, ds.name, tenantID, from)
+	if err != nil {
+		return false, fmt.Errorf("failed to check export: %w", err)
+	}
+	if done {
+		return false, nil
+	}
+
+	tenantCtx, err := uc.credentials.ForTenant(ctx, tenantID)
+	if err != nil {
+		return false, err
+	}
+	rows, err := ds.rows(tenantCtx, tenantID, from, to)
+	if err != nil {
+		return false, err
+	}
+	data, err := service.WriteParquet(schema.Columns, rows)
+	if err != nil {
+		return false, err
+	}
+
+	key := service.WarehousePartitionKey(uc.prefix, ds.name, schema.Version, from, tenantID)
+	if err := uc.store.Put(ctx, key, data, "application/vnd.apache.parquet"); err != nil {
+		return false, fmt.Errorf("failed to write partition: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	if err := uc.repo.SaveExport(ctx, service.WarehouseExport{
+		Day:           from,
+		ExportedAt:    now,
+		Dataset:       ds.name,
+		ObjectKey:     key,
+		ContentSHA256: hex.EncodeToString(sum[:]),
+		Rows:          len(rows),
+		SchemaVersion: schema.Version,
+		TenantID:      tenantID,
+	}); err != nil {
+		return false, fmt.Errorf("failed to save export: %w", err)
+	}
+	result.Rows += len(rows)
+	return true, nil
+}
+
+func (uc *ExportWarehouseUseCase) accountRows(ctx context.Context, tenantID uuid.UUID, _, _ time.Time) ([][]any, error) {
+	accounts, err := uc.accounts.ListAccounts(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+	return service.AccountRows(accounts), nil
+}
+
+func (uc *ExportWarehouseUseCase) paymentRows(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([][]any, error) {
+	payments, err := uc.payments.ListPayments(ctx, tenantID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payments: %w", err)
+	}
+	return service.PaymentRows(payments), nil
+}
+
+func (uc *ExportWarehouseUseCase) loanRows(ctx context.Context, tenantID uuid.UUID, _, _ time.Time) ([][]any, error) {
+	loans, err := uc.readModel.ListAllLoanPositions(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list loans: %w", err)
+	}
+	return service.LoanRows(loans), nil
+}
+
+func (uc *ExportWarehouseUseCase) journalEntryRows(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([][]any, error) {
+	entries, err := uc.journals.ListJournalEntries(ctx, tenantID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list journal entries: %w", err)
+	}
+	return service.JournalEntryRows(entries), nil
+}
@@ -0,0 +1,203 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+type fakeAccountDataClient struct {
+	accounts []service.AccountRecord
+	err      error
+}
+
+func (c *fakeAccountDataClient) ListAccounts(context.Context, uuid.UUID) ([]service.AccountRecord, error) {
+	return c.accounts, c.err
+}
+
+type fakeJournalEntryClient struct {
+	entries []service.JournalEntryRecord
+}
+
+func (c *fakeJournalEntryClient) ListJournalEntries(_ context.Context, _ uuid.UUID, from, to time.Time) ([]service.JournalEntryRecord, error) {
+	var result []service.JournalEntryRecord
+	for _, e := range c.entries {
+		if !e.EffectiveDate.Before(from) && e.EffectiveDate.Before(to) {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+// tenantRecordingCredentials records the tenants it authorized calls for.
+type tenantRecordingCredentials struct {
+	tenants []uuid.UUID
+}
+
+func (c *tenantRecordingCredentials) ForTenant(ctx context.Context, tenantID uuid.UUID) (context.Context, error) {
+	c.tenants = append(c.tenants, tenantID)
+	return ctx, nil
+}
+
+type memObjectStore struct {
+	objects map[string][]byte
+}
+
+func (s *memObjectStore) Put(_ context.Context, key string, data []byte, _ string) error {
+	s.objects[key] = data
+	return nil
+}
+
+func (s *memObjectStore) Get(_ context.Context, key string) ([]byte, error) {
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, port.ErrObjectNotFound
+	}
+	return data, nil
+}
+
+type memWarehouseRepo struct {
+	schemas map[string]service.WarehouseSchema
+	exports map[string]service.WarehouseExport
+}
+
+func exportKey(dataset string, tenantID uuid.UUID, day time.Time) string {
+	return dataset + "/" + tenantID.String() + "/" + day.Format(time.DateOnly)
+}
+
+func (r *memWarehouseRepo) FindSchema(_ context.Context, dataset string) (service.WarehouseSchema, error) {
+	s, ok := r.schemas[dataset]
+	if !ok {
+		return service.WarehouseSchema{}, port.ErrSchemaNotFound
+	}
+	return s, nil
+}
+
+func (r *memWarehouseRepo) SaveSchema(_ context.Context, schema service.WarehouseSchema) error {
+	r.schemas[schema.Dataset] = schema
+	return nil
+}
+
+func (r *memWarehouseRepo) HasExport(_ context.Context, dataset string, tenantID uuid.UUID, day time.Time) (bool, error) {
+	_, ok := r.exports[exportKey(dataset, tenantID, day)]
+	return ok, nil
+}
+
+func (r *memWarehouseRepo) SaveExport(_ context.Context, e service.WarehouseExport) error {
+	r.exports[exportKey(e.Dataset, e.TenantID, e.Day)] = e
+	return nil
+}
+
+// warehouseFixture holds one tenant with an account, a payment and a journal
+// entry on 14 March 2025, and a loan on the read model.
+type warehouseFixture struct {
+	accounts    *fakeAccountDataClient
+	credentials *tenantRecordingCredentials
+	store       *memObjectStore
+	repo        *memWarehouseRepo
+	day         time.Time
+	tenantID    uuid.UUID
+}
+
+func newWarehouseFixture() (warehouseFixture, *usecase.ExportWarehouseUseCase) {
+	f := warehouseFixture{
+		accounts:    &fakeAccountDataClient{},
+		credentials: &tenantRecordingCredentials{},
+		store:       &memObjectStore{objects: make(map[string][]byte)},
+		repo:        &memWarehouseRepo{schemas: make(map[string]service.WarehouseSchema), exports: make(map[string]service.WarehouseExport)},
+		day:         time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC),
+		tenantID:    uuid.New(),
+	}
+	f.accounts.accounts = []service.AccountRecord{{ID: "a1", AccountNumber: "BIB-1", AccountType: "CHECKING", Status: "ACTIVE", Currency: "USD", TenantID: f.tenantID}}
+	payments := &fakePaymentDataClient{records: []service.PaymentRecord{
+		{ID: "p1", SourceAccountID: "a1", Rail: "ACH", Status: "SETTLED", Currency: "USD", Amount: decimal.NewFromInt(10), CreatedAt: f.day.Add(9 * time.Hour), TenantID: f.tenantID},
+		{ID: "p0", SourceAccountID: "a1", Rail: "ACH", Status: "SETTLED", Currency: "USD", Amount: decimal.NewFromInt(10), CreatedAt: f.day.Add(-time.Hour), TenantID: f.tenantID},
+	}}
+	journals := &fakeJournalEntryClient{entries: []service.JournalEntryRecord{{
+		ID: "je1", Status: "POSTED", EffectiveDate: f.day, CreatedAt: f.day, TenantID: f.tenantID,
+		Postings: []service.JournalPostingRecord{
+			{DebitAccount: "1000", CreditAccount: "4000", Currency: "USD", Amount: decimal.NewFromInt(8)},
+			{DebitAccount: "1000", CreditAccount: "2100", Currency: "USD", Amount: decimal.NewFromInt(2)},
+		},
+	}}}
+	readModel := newMemReadModel()
+	readModel.loans["LN-1"] = service.LoanPosition{ID: "LN-1", TenantID: f.tenantID, Currency: "USD", Bucket: service.LoanBucketPaidOff,
+		Principal: decimal.NewFromInt(100), Outstanding: decimal.Zero, DisbursedAt: f.day.AddDate(0, -1, 0), UpdatedAt: f.day}
+
+	uc := usecase.NewExportWarehouseUseCase(f.accounts, payments, journals, readModel, f.credentials, f.store, f.repo,
+		"curated/", []uuid.UUID{f.tenantID})
+	return f, uc
+}
+
+func TestExportWarehouse_LandsPartitions(t *testing.T) {
+	f, uc := newWarehouseFixture()
+
+	result, err := uc.Execute(context.Background(), f.day.Add(15*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 4, result.Exported)
+	assert.Zero(t, result.Failed)
+	// One account, one payment of the day, one loan and two postings.
+	assert.Equal(t, 5, result.Rows)
+	assert.Len(t, f.credentials.tenants, 4)
+
+	key := service.WarehousePartitionKey("curated/", service.DatasetPayments, 1, f.day, f.tenantID)
+	require.Contains(t, f.store.objects, key)
+	assert.Equal(t, []byte("PAR1"), f.store.objects[key][:4])
+	export := f.repo.exports[exportKey(service.DatasetPayments, f.tenantID, f.day)]
+	assert.Equal(t, key, export.ObjectKey)
+	assert.Equal(t, 1, export.Rows)
+	assert.Len(t, export.ContentSHA256, 64)
+
+	var schema service.WarehouseSchema
+	require.NoError(t, json.Unmarshal(f.store.objects["curated/payments/v1/_schema.json"], &schema))
+	assert.Equal(t, service.PaymentColumns, schema.Columns)
+
+	// A re-run skips the landed partitions.
+	result, err = uc.Execute(context.Background(), f.day)
+	require.NoError(t, err)
+	assert.Equal(t, 4, result.Skipped)
+	assert.Zero(t, result.Exported)
+}
+
+func TestExportWarehouse_BreakingSchemaChangeStartsNewVersion(t *testing.T) {
+	f, uc := newWarehouseFixture()
+	// The loans dataset was last exported with a column it no longer has.
+	f.repo.schemas[service.DatasetLoans] = service.WarehouseSchema{
+		Dataset: service.DatasetLoans,
+		Version: 3,
+		Columns: append(append([]service.WarehouseColumn{}, service.LoanColumns...), service.WarehouseColumn{Name: "officer", Type: service.ColumnString}),
+	}
+
+	_, err := uc.Execute(context.Background(), f.day)
+	require.NoError(t, err)
+	assert.Equal(t, 4, f.repo.schemas[service.DatasetLoans].Version)
+	assert.Contains(t, f.store.objects, "curated/loans/v4/_schema.json")
+	assert.Contains(t, f.store.objects, service.WarehousePartitionKey("curated/", service.DatasetLoans, 4, f.day, f.tenantID))
+}
+
+func TestExportWarehouse_FailureIsRetried(t *testing.T) {
+	f, uc := newWarehouseFixture()
+	f.accounts.err = errors.New("account service unavailable")
+
+	result, err := uc.Execute(context.Background(), f.day)
+	require.ErrorContains(t, err, "account service unavailable")
+	assert.Equal(t, 1, result.Failed)
+	assert.Equal(t, 3, result.Exported)
+
+	f.accounts.err = nil
+	result, err = uc.Execute(context.Background(), f.day)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Exported)
+	assert.Equal(t, 3, result.Skipped)
+}
@@ -2,7 +2,9 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
@@ -12,12 +14,24 @@ import (
 	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
 )
 
+// ErrUnsupportedFormat is returned when a report is requested in an unknown
+// output format.
+var ErrUnsupportedFormat = errors.New("unsupported report format")
+
+// progressFunc receives the stage a report generation has reached and its
+// percentage complete.
+type progressFunc func(stage string, percent int)
+
+func noProgress(string, int) {}
+
 // GenerateReportUseCase orchestrates the generation of a regulatory report.
 type GenerateReportUseCase struct {
 	repo           port.ReportSubmissionRepository
 	eventPublisher port.EventPublisher
 	ledgerClient   port.LedgerDataClient
 	xbrlGenerator  *service.XBRLGenerator
+	renderer       *service.ReportRenderer
+	aml            *AMLReportBuilder
 }
 
 // NewGenerateReportUseCase creates a new GenerateReportUseCase.
@@ -26,84 +40,170 @@ func NewGenerateReportUseCase(
 	eventPublisher port.EventPublisher,
 	ledgerClient port.LedgerDataClient,
 	xbrlGenerator *service.XBRLGenerator,
+	renderer *service.ReportRenderer,
+	aml *AMLReportBuilder,
 ) *GenerateReportUseCase {
 	return &GenerateReportUseCase{
 		repo:           repo,
 		eventPublisher: eventPublisher,
 		ledgerClient:   ledgerClient,
 		xbrlGenerator:  xbrlGenerator,
+		renderer:       renderer,
+		aml:            aml,
 	}
 }
 
-// Execute generates a report for the given request.
+// Execute generates a report for the given request. The regulatory filing is
+// always generated and stored; the response carries the report rendered in
+// the requested format. That defaults to the filing itself: XBRL, or goAML
+// for AML reports, which are available in no other format.
 func (uc *GenerateReportUseCase) Execute(ctx context.Context, req dto.GenerateReportRequest) (dto.GenerateReportResponse, error) {
-	// Validate report type.
+	_, resp, err := uc.execute(ctx, req, noProgress)
+	return resp, err
+}
+
+// execute generates the report, reporting its progress, and returns the
+// saved submission with the response.
+func (uc *GenerateReportUseCase) execute(ctx context.Context, req dto.GenerateReportRequest, progress progressFunc) (model.ReportSubmission, dto.GenerateReportResponse, error) {
+	// Validate report type and output format.
 	reportType, err := valueobject.NewReportType(req.ReportType)
 	if err != nil {
-		return dto.GenerateReportResponse{}, fmt.Errorf("invalid report type: %w", err)
+		return model.ReportSubmission{}, dto.GenerateReportResponse{}, fmt.Errorf("invalid report type: %w", err)
+	}
+	format, filingFormat, err := resolveReportFormat(reportType, req.Format)
+	if err != nil {
+		return model.ReportSubmission{}, dto.GenerateReportResponse{}, err
 	}
 
 	// Create a new submission in DRAFT.
 	submission, err := model.NewReportSubmission(req.TenantID, reportType, req.Period)
 	if err != nil {
-		return dto.GenerateReportResponse{}, fmt.Errorf("failed to create report submission: %w", err)
+		return model.ReportSubmission{}, dto.GenerateReportResponse{}, fmt.Errorf("failed to create report submission: %w", err)
+	}
+	submission = submission.SetPreparer(req.Actor)
+
+	submission, data, err := uc.generate(ctx, submission, progress)
+	if err != nil {
+		return submission, dto.GenerateReportResponse{}, err
+	}
+
+	// Render the requested format.
+	content := []byte(submission.XBRLContent())
+	if !format.Equal(filingFormat) {
+		progress("rendering "+format.String(), 80)
+		content, err = uc.renderer.Render(format, reportType, data)
+		if err != nil {
+			return submission, dto.GenerateReportResponse{}, fmt.Errorf("failed to render %s: %w", format, err)
+		}
+	}
+
+	// Persist submission.
+	progress("saving report", 90)
+	if err := uc.repo.Save(ctx, submission); err != nil {
+		return submission, dto.GenerateReportResponse{}, fmt.Errorf("failed to save report submission: %w", err)
+	}
+
+	// Publish domain events.
+	if events := submission.DomainEvents(); len(events) > 0 {
+		if err := uc.eventPublisher.Publish(ctx, events...); err != nil {
+			return submission, dto.GenerateReportResponse{}, fmt.Errorf("failed to publish events: %w", err)
+		}
 	}
 
+	return submission, dto.GenerateReportResponse{
+		ID:              submission.ID(),
+		TenantID:        submission.TenantID(),
+		ReportType:      submission.ReportType().String(),
+		ReportingPeriod: submission.ReportingPeriod(),
+		Status:          submission.Status().String(),
+		GeneratedAt:     submission.GeneratedAt(),
+		Format:          format.String(),
+		ContentType:     format.ContentType(),
+		FileName:        fmt.Sprintf("%s-%s.%s", strings.ToLower(reportType.String()), submission.ReportingPeriod(), format.Extension()),
+		Content:         content,
+	}, nil
+}
+
+// resolveReportFormat validates the format a report is requested in and
+// returns it with the report type's filing format, which it defaults to: XBRL,
+// or goAML for AML reports, which are available in no other format.
+func resolveReportFormat(reportType valueobject.ReportType, requested string) (format, filingFormat valueobject.ReportFormat, err error) {
+	filingFormat = valueobject.ReportFormatXBRL
+	if reportType.IsAML() {
+		filingFormat = valueobject.ReportFormatGoAML
+	}
+	format = filingFormat
+	if requested != "" {
+		format, err = valueobject.NewReportFormat(strings.ToUpper(requested))
+		if err != nil {
+			return format, filingFormat, fmt.Errorf("%w: %w", ErrUnsupportedFormat, err)
+		}
+	}
+	if reportType.IsAML() != format.Equal(valueobject.ReportFormatGoAML) {
+		return format, filingFormat, fmt.Errorf("%w: %s reports cannot be rendered as %s", ErrUnsupportedFormat, reportType, format)
+	}
+	return format, filingFormat, nil
+}
+
+// generate sources the submission's figures from the ledger and produces and
+// validates its XBRL filing, leaving the submission READY for review. AML reports are
+// sourced and filed by the AMLReportBuilder instead.
+func (uc *GenerateReportUseCase) generate(ctx context.Context, submission model.ReportSubmission, progress progressFunc) (model.ReportSubmission, service.ReportData, error) {
 	// Mark as generating.
 	now := time.Now().UTC()
-	submission, err = submission.MarkGenerating(now)
+	submission, err := submission.MarkGenerating(now)
 	if err != nil {
-		return dto.GenerateReportResponse{}, fmt.Errorf("failed to mark generating: %w", err)
+		return submission, service.ReportData{}, fmt.Errorf("failed to mark generating: %w", err)
+	}
+
+	if submission.ReportType().IsAML() {
+		if uc.aml == nil {
+			return submission, service.ReportData{}, fmt.Errorf("AML reporting is not configured")
+		}
+		progress("sourcing AML cases", 10)
+		submission, err = uc.aml.generate(ctx, submission)
+		if err != nil {
+			return submission, service.ReportData{}, err
+		}
+		progress("validating filing", 70)
+		submission, err = submission.Validate()
+		if err != nil {
+			return submission, service.ReportData{}, fmt.Errorf("goAML validation failed: %w", err)
+		}
+		return submission, service.ReportData{}, nil
 	}
 
 	// Fetch financial data from ledger.
-	data, err := uc.ledgerClient.GetFinancialData(ctx, req.TenantID, req.Period)
+	progress("sourcing ledger data", 10)
+	data, err := uc.ledgerClient.GetFinancialData(ctx, submission.TenantID(), submission.ReportingPeriod())
 	if err != nil {
-		return dto.GenerateReportResponse{}, fmt.Errorf("failed to fetch financial data: %w", err)
+		return submission, data, fmt.Errorf("failed to fetch financial data: %w", err)
+	}
+
+	// Reject figures that do not balance before anything is filed from them.
+	if err := service.Reconcile(data); err != nil {
+		return submission, data, err
 	}
 
 	// Generate XBRL content.
-	xbrlContent, err := uc.xbrlGenerator.Generate(reportType, data)
+	progress("generating filing", 50)
+	xbrlContent, err := uc.xbrlGenerator.Generate(submission.ReportType(), data)
 	if err != nil {
-		return dto.GenerateReportResponse{}, fmt.Errorf("failed to generate XBRL: %w", err)
+		return submission, data, fmt.Errorf("failed to generate XBRL: %w", err)
 	}
 
 	// Set generated content.
 	now = time.Now().UTC()
 	submission, err = submission.SetGenerated(xbrlContent, now)
 	if err != nil {
-		return dto.GenerateReportResponse{}, fmt.Errorf("failed to set generated content: %w", err)
+		return submission, data, fmt.Errorf("failed to set generated content: %w", err)
 	}
 
 	// Validate the generated XBRL.
+	progress("validating filing", 70)
 	submission, err = submission.Validate()
 	if err != nil {
-		return dto.GenerateReportResponse{}, fmt.Errorf("XBRL validation failed: %w", err)
-	}
-
-	// Persist submission.
-	if err := uc.repo.Save(ctx, submission); err != nil {
-		return dto.GenerateReportResponse{}, fmt.Errorf("failed to save report submission: %w", err)
+		return submission, data, fmt.Errorf("XBRL validation failed: %w", err)
 	}
-
-	// Publish domain events.
-	if events := submission.DomainEvents(); len(events) > 0 {
-		if err := uc.eventPublisher.Publish(ctx, events...); err != nil {
-			return dto.GenerateReportResponse{}, fmt.Errorf("failed to publish events: %w", err)
-		}
-	}
-
-	generatedAt := ""
-	if submission.GeneratedAt() != nil {
-		generatedAt = submission.GeneratedAt().Format(time.RFC3339)
-	}
-
-	return dto.GenerateReportResponse{
-		ID:              submission.ID(),
-		TenantID:        submission.TenantID(),
-		ReportType:      submission.ReportType().String(),
-		ReportingPeriod: submission.ReportingPeriod(),
-		Status:          submission.Status().String(),
-		GeneratedAt:     generatedAt,
-	}, nil
+	return submission, data, nil
 }
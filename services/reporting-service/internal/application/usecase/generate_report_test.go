@@ -2,6 +2,7 @@ package usecase_test
 
 import (
 	"context"
+	"sort"
 	"testing"
 
 	"github.com/google/uuid"
@@ -29,7 +30,7 @@ func newInMemoryRepo() *inMemoryRepo {
 }
 
 func (r *inMemoryRepo) Save(_ context.Context, submission model.ReportSubmission) error {
-	r.submissions[submission.ID()] = submission
+	r.submissions[submission.ID()] = submission.ClearDomainEvents()
 	return nil
 }
 
@@ -61,6 +62,27 @@ func (r *inMemoryRepo) FindByTenantAndType(_ context.Context, tenantID uuid.UUID
 	return result, nil
 }
 
+func (r *inMemoryRepo) ListAwaitingAcknowledgment(_ context.Context, channel string) ([]model.ReportSubmission, error) {
+	var result []model.ReportSubmission
+	for _, s := range r.submissions {
+		if s.Status().String() == "SUBMITTED" && s.Channel().String() == channel {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (r *inMemoryRepo) FindVersions(_ context.Context, originalID uuid.UUID) ([]model.ReportSubmission, error) {
+	var result []model.ReportSubmission
+	for _, s := range r.submissions {
+		if s.OriginalID() == originalID {
+			result = append(result, s)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Revision() < result[j].Revision() })
+	return result, nil
+}
+
 type mockEventPublisher struct {
 	publishedEvents []event.DomainEvent
 }
@@ -86,6 +108,15 @@ func (c *mockLedgerClient) GetFinancialData(_ context.Context, tenantID uuid.UUI
 	}, nil
 }
 
+// unbalancedLedgerClient returns figures whose assets exceed liabilities plus equity.
+type unbalancedLedgerClient struct{}
+
+func (c *unbalancedLedgerClient) GetFinancialData(ctx context.Context, tenantID uuid.UUID, period string) (service.ReportData, error) {
+	data, err := (&mockLedgerClient{}).GetFinancialData(ctx, tenantID, period)
+	data.TotalAssets = data.TotalAssets.Add(decimal.NewFromInt(1))
+	return data, err
+}
+
 // --- Tests ---
 
 func TestGenerateReportUseCase_Execute(t *testing.T) {
@@ -94,7 +125,7 @@ func TestGenerateReportUseCase_Execute(t *testing.T) {
 	ledgerClient := &mockLedgerClient{}
 	generator := service.NewXBRLGenerator()
 
-	uc := usecase.NewGenerateReportUseCase(repo, publisher, ledgerClient, generator)
+	uc := usecase.NewGenerateReportUseCase(repo, publisher, ledgerClient, generator, service.NewReportRenderer(), nil)
 	ctx := context.Background()
 
 	t.Run("generates COREP report successfully", func(t *testing.T) {
@@ -171,6 +202,49 @@ func TestGenerateReportUseCase_Execute(t *testing.T) {
 		assert.Contains(t, saved.XBRLContent(), "mrel:")
 	})
 
+	t.Run("returns XBRL content by default", func(t *testing.T) {
+		resp, err := uc.Execute(ctx, dto.GenerateReportRequest{
+			TenantID:   uuid.New(),
+			ReportType: "COREP",
+			Period:     "2025-Q1",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "XBRL", resp.Format)
+		assert.Equal(t, "application/xml", resp.ContentType)
+		assert.Equal(t, "corep-2025-Q1.xbrl", resp.FileName)
+		assert.Contains(t, string(resp.Content), "corep:")
+	})
+
+	t.Run("renders the requested format and still files XBRL", func(t *testing.T) {
+		resp, err := uc.Execute(ctx, dto.GenerateReportRequest{
+			TenantID:   uuid.New(),
+			ReportType: "FINREP",
+			Period:     "2025-Q2",
+			Format:     "csv",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "CSV", resp.Format)
+		assert.Equal(t, "text/csv", resp.ContentType)
+		assert.Equal(t, "finrep-2025-Q2.csv", resp.FileName)
+		assert.Contains(t, string(resp.Content), "FINREP,2025-Q2,TotalAssets")
+
+		saved, err := repo.FindByID(ctx, resp.ID)
+		require.NoError(t, err)
+		assert.Contains(t, saved.XBRLContent(), "finrep:")
+	})
+
+	t.Run("rejects unknown format", func(t *testing.T) {
+		_, err := uc.Execute(ctx, dto.GenerateReportRequest{
+			TenantID:   uuid.New(),
+			ReportType: "COREP",
+			Period:     "2025-Q1",
+			Format:     "DOCX",
+		})
+		assert.ErrorIs(t, err, usecase.ErrUnsupportedFormat)
+	})
+
 	t.Run("rejects invalid report type", func(t *testing.T) {
 		req := dto.GenerateReportRequest{
 			TenantID:   uuid.New(),
@@ -205,3 +279,18 @@ func TestGenerateReportUseCase_Execute(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestGenerateReportUseCase_RejectsUnbalancedFigures(t *testing.T) {
+	repo := newInMemoryRepo()
+	publisher := &mockEventPublisher{}
+	uc := usecase.NewGenerateReportUseCase(repo, publisher, &unbalancedLedgerClient{}, service.NewXBRLGenerator(), service.NewReportRenderer(), nil)
+
+	_, err := uc.Execute(context.Background(), dto.GenerateReportRequest{
+		TenantID:   uuid.New(),
+		ReportType: "FINREP",
+		Period:     "2025-Q1",
+	})
+	require.ErrorIs(t, err, service.ErrReportUnbalanced)
+	assert.Empty(t, repo.submissions)
+	assert.Empty(t, publisher.publishedEvents)
+}
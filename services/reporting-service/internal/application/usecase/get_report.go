@@ -28,17 +28,29 @@ func (uc *GetReportUseCase) Execute(ctx context.Context, req dto.GetReportReques
 	}
 
 	return dto.GetReportResponse{
-		ID:               submission.ID(),
-		TenantID:         submission.TenantID(),
-		ReportType:       submission.ReportType().String(),
-		ReportingPeriod:  submission.ReportingPeriod(),
-		Status:           submission.Status().String(),
-		XBRLContent:      submission.XBRLContent(),
-		GeneratedAt:      submission.GeneratedAt(),
-		SubmittedAt:      submission.SubmittedAt(),
-		ValidationErrors: submission.ValidationErrors(),
-		Version:          submission.Version(),
-		CreatedAt:        submission.CreatedAt(),
-		UpdatedAt:        submission.UpdatedAt(),
+		ID:                  submission.ID(),
+		TenantID:            submission.TenantID(),
+		ReportType:          submission.ReportType().String(),
+		ReportingPeriod:     submission.ReportingPeriod(),
+		Status:              submission.Status().String(),
+		XBRLContent:         submission.XBRLContent(),
+		GeneratedAt:         submission.GeneratedAt(),
+		SubmittedAt:         submission.SubmittedAt(),
+		ValidationErrors:    submission.ValidationErrors(),
+		SubmissionChannel:   submission.Channel().String(),
+		SubmissionReference: submission.Reference(),
+		SubmissionAttempts:  submission.Attempts(),
+		PreparedBy:          submission.PreparedBy(),
+		ApprovedBy:          submission.ApprovedBy(),
+		ApprovedAt:          submission.ApprovedAt(),
+		Revision:            submission.Revision(),
+		OriginalID:          submission.OriginalID(),
+		AmendsID:            submission.AmendsID(),
+		AmendmentReason:     submission.AmendmentReason().String(),
+		AmendmentNote:       submission.AmendmentNote(),
+		LinkedCaseIDs:       submission.LinkedCaseIDs(),
+		Version:             submission.Version(),
+		CreatedAt:           submission.CreatedAt(),
+		UpdatedAt:           submission.UpdatedAt(),
 	}, nil
 }
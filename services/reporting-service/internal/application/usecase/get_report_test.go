@@ -41,6 +41,14 @@ func (m *mockReportSubmissionRepository) FindByTenantAndType(_ context.Context,
 	return nil, nil
 }
 
+func (m *mockReportSubmissionRepository) ListAwaitingAcknowledgment(_ context.Context, _ string) ([]model.ReportSubmission, error) {
+	return nil, nil
+}
+
+func (m *mockReportSubmissionRepository) FindVersions(_ context.Context, _ uuid.UUID) ([]model.ReportSubmission, error) {
+	return nil, nil
+}
+
 // --- Tests ---
 
 func TestGetReportUseCase_Execute(t *testing.T) {
@@ -53,7 +61,8 @@ func TestGetReportUseCase_Execute(t *testing.T) {
 			submissionID, tenantID,
 			valueobject.ReportTypeCOREP, "2025-Q4",
 			valueobject.SubmissionStatusDraft, "",
-			nil, nil, []string{}, 1, now, now,
+			nil, nil, []string{}, valueobject.SubmissionChannel{}, "", 0,
+			1, submissionID, nil, valueobject.AmendmentReason{}, "", nil, "", "", nil, 1, now, now,
 		)
 
 		repo := &mockReportSubmissionRepository{
@@ -88,7 +97,8 @@ func TestGetReportUseCase_Execute(t *testing.T) {
 			valueobject.ReportTypeFINREP, "2025-Q3",
 			valueobject.SubmissionStatusReady,
 			"<?xml version=\"1.0\"?><xbrli:xbrl>...</xbrli:xbrl>",
-			&genAt, nil, []string{}, 2, now, now,
+			&genAt, nil, []string{}, valueobject.SubmissionChannel{}, "", 0,
+			1, submissionID, nil, valueobject.AmendmentReason{}, "", nil, "", "", nil, 2, now, now,
 		)
 
 		repo := &mockReportSubmissionRepository{
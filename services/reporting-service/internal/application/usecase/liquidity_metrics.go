@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// GetLiquidityMetricsUseCase computes a tenant's LCR and NSFR from the
+// ledger's trial balance, with each figure broken down by source account.
+type GetLiquidityMetricsUseCase struct {
+	trialBalances port.TrialBalanceClient
+	weights       service.LiquidityWeights
+}
+
+// NewGetLiquidityMetricsUseCase creates a new GetLiquidityMetricsUseCase.
+func NewGetLiquidityMetricsUseCase(trialBalances port.TrialBalanceClient, weights service.LiquidityWeights) *GetLiquidityMetricsUseCase {
+	return &GetLiquidityMetricsUseCase{
+		trialBalances: trialBalances,
+		weights:       weights,
+	}
+}
+
+// Execute computes the liquidity metrics for the period. Books that do not
+// balance are rejected.
+func (uc *GetLiquidityMetricsUseCase) Execute(ctx context.Context, req dto.GetLiquidityMetricsRequest) (dto.LiquidityMetricsResponse, error) {
+	if _, _, err := service.PeriodRange(req.Period); err != nil {
+		return dto.LiquidityMetricsResponse{}, fmt.Errorf("%w: %w", ErrInvalidPeriod, err)
+	}
+
+	tb, err := uc.trialBalances.GetTrialBalance(ctx, req.TenantID, req.Period)
+	if err != nil {
+		return dto.LiquidityMetricsResponse{}, fmt.Errorf("failed to fetch trial balance: %w", err)
+	}
+	if debits, credits := tb.TotalDebits(), tb.TotalCredits(); !debits.Equal(credits) {
+		return dto.LiquidityMetricsResponse{}, fmt.Errorf("%w: trial balance debits %s != credits %s",
+			service.ErrReportUnbalanced, debits, credits)
+	}
+
+	m := service.ComputeLiquidity(tb, uc.weights)
+	return dto.LiquidityMetricsResponse{
+		TenantID:    req.TenantID,
+		Period:      req.Period,
+		LCR:         m.LCR.StringFixed(4),
+		NSFR:        m.NSFR.StringFixed(4),
+		NetOutflows: m.NetOutflows.String(),
+		Figures: []dto.LiquidityFigureResponse{
+			toLiquidityFigureResponse("HQLA", m.HQLA),
+			toLiquidityFigureResponse("OUTFLOWS", m.Outflows),
+			toLiquidityFigureResponse("INFLOWS", m.Inflows),
+			toLiquidityFigureResponse("AVAILABLE_STABLE_FUNDING", m.AvailableStableFunding),
+			toLiquidityFigureResponse("REQUIRED_STABLE_FUNDING", m.RequiredStableFunding),
+		},
+	}, nil
+}
+
+func toLiquidityFigureResponse(name string, f service.LiquidityFigure) dto.LiquidityFigureResponse {
+	contributions := make([]dto.LiquidityContributionResponse, 0, len(f.Contributions))
+	for _, c := range f.Contributions {
+		contributions = append(contributions, dto.LiquidityContributionResponse{
+			AccountCode: c.AccountCode,
+			Rule:        c.Rule,
+			Level:       c.Level,
+			Balance:     c.Balance.String(),
+			Weight:      c.Weight.String(),
+			Amount:      c.Amount.String(),
+		})
+	}
+	return dto.LiquidityFigureResponse{
+		Name:          name,
+		Gross:         f.Gross.String(),
+		Amount:        f.Amount.String(),
+		Contributions: contributions,
+	}
+}
@@ -0,0 +1,62 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// fakeTrialBalanceClient returns a fixed trial balance.
+type fakeTrialBalanceClient struct {
+	lines []service.TrialBalanceLine
+}
+
+func (c *fakeTrialBalanceClient) GetTrialBalance(_ context.Context, tenantID uuid.UUID, period string) (service.TrialBalance, error) {
+	return service.TrialBalance{TenantID: tenantID, Period: period, Lines: c.lines}, nil
+}
+
+func tbLine(code string, debit, credit int64) service.TrialBalanceLine {
+	return service.TrialBalanceLine{AccountCode: code, Debit: decimal.NewFromInt(debit), Credit: decimal.NewFromInt(credit)}
+}
+
+func TestGetLiquidityMetrics(t *testing.T) {
+	ledger := &fakeTrialBalanceClient{lines: []service.TrialBalanceLine{
+		tbLine("1000", 300, 0),
+		tbLine("1200", 700, 0),
+		tbLine("2000", 0, 900),
+		tbLine("3000", 0, 100),
+	}}
+	uc := usecase.NewGetLiquidityMetricsUseCase(ledger, service.DefaultLiquidityWeights())
+
+	resp, err := uc.Execute(context.Background(), dto.GetLiquidityMetricsRequest{TenantID: uuid.New(), Period: "2025-Q1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "3.3333", resp.LCR)
+	assert.Equal(t, "1.5294", resp.NSFR)
+	require.Len(t, resp.Figures, 5)
+	hqla := resp.Figures[0]
+	assert.Equal(t, "HQLA", hqla.Name)
+	assert.Equal(t, "300", hqla.Amount)
+	require.Len(t, hqla.Contributions, 1)
+	assert.Equal(t, "1000", hqla.Contributions[0].AccountCode)
+	assert.Equal(t, "Cash and central bank reserves", hqla.Contributions[0].Rule)
+}
+
+func TestGetLiquidityMetrics_RejectsUnbalancedBooks(t *testing.T) {
+	ledger := &fakeTrialBalanceClient{lines: []service.TrialBalanceLine{tbLine("1000", 300, 0), tbLine("2000", 0, 200)}}
+	uc := usecase.NewGetLiquidityMetricsUseCase(ledger, service.DefaultLiquidityWeights())
+
+	_, err := uc.Execute(context.Background(), dto.GetLiquidityMetricsRequest{TenantID: uuid.New(), Period: "2025-Q1"})
+	assert.ErrorIs(t, err, service.ErrReportUnbalanced)
+
+	_, err = uc.Execute(context.Background(), dto.GetLiquidityMetricsRequest{TenantID: uuid.New(), Period: "Q1"})
+	assert.ErrorIs(t, err, usecase.ErrInvalidPeriod)
+}
@@ -0,0 +1,230 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// Topics the dashboard read model is projected from.
+const (
+	DepositEventsTopic   = "bib.deposit.events"
+	DepositInterestTopic = "bib.deposit.interest"
+	LendingEventsTopic   = "lending-events"
+	PaymentOrdersTopic   = "bib.payment.orders"
+	FraudEventsTopic     = "fraud-events"
+)
+
+// DashboardTopics lists the topics the dashboard read model is projected from.
+var DashboardTopics = []string{
+	DepositEventsTopic,
+	DepositInterestTopic,
+	LendingEventsTopic,
+	PaymentOrdersTopic,
+	FraudEventsTopic,
+}
+
+// projectedEvent holds the fields of the events the dashboard read model is
+// projected from. Each event sets the subset it carries.
+type projectedEvent struct {
+	OccurredAt         time.Time       `json:"occurred_at"`
+	AssessedAt         time.Time       `json:"assessed_at"`
+	AggregateID        string          `json:"aggregate_id"`
+	TenantID           string          `json:"tenant_id"`
+	Currency           string          `json:"currency"`
+	Rail               string          `json:"rail"`
+	Decision           string          `json:"decision"`
+	Principal          decimal.Decimal `json:"principal"`
+	Amount             decimal.Decimal `json:"amount"`
+	OutstandingBalance decimal.Decimal `json:"outstanding_balance"`
+	PositionID         uuid.UUID       `json:"position_id"`
+	AccountID          uuid.UUID       `json:"account_id"`
+	PaymentID          uuid.UUID       `json:"payment_id"`
+	AssessmentID       uuid.UUID       `json:"assessment_id"`
+}
+
+// ProjectDashboardEventUseCase applies deposit, lending, payment and fraud
+// events to the dashboard read model. Events for one aggregate arrive in
+// order on their topic's partition; an event no later than the last one
+// applied to its aggregate is a redelivery and is skipped, so projection is
+// idempotent. Events the dashboards do not use are ignored.
+type ProjectDashboardEventUseCase struct {
+	readModel port.DashboardReadModel
+}
+
+// NewProjectDashboardEventUseCase creates a new ProjectDashboardEventUseCase.
+func NewProjectDashboardEventUseCase(readModel port.DashboardReadModel) *ProjectDashboardEventUseCase {
+	return &ProjectDashboardEventUseCase{readModel: readModel}
+}
+
+// Execute applies an event of the given type. The payload is a CloudEvents
+// envelope or the bare event.
+func (uc *ProjectDashboardEventUseCase) Execute(ctx context.Context, eventType string, payload []byte) error {
+	switch eventType {
+	case "deposit.position.opened", "deposit.interest.accrued", "deposit.position.closed",
+		"lending.loan.disbursed", "lending.loan.payment_received", "lending.loan.delinquent",
+		"lending.loan.default", "lending.loan.paid_off",
+		"payment.order.initiated", "payment.order.settled", "payment.order.failed", "payment.order.reversed",
+		"fraud.assessment.completed":
+	default:
+		return nil
+	}
+
+	data, err := events.EventData(payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	var evt projectedEvent
+	if err := json.Unmarshal(data, &evt); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	tenantID, err := uuid.Parse(evt.TenantID)
+	if err != nil {
+		return fmt.Errorf("%s event has invalid tenant ID %q: %w", eventType, evt.TenantID, err)
+	}
+	// The read model stores times to the microsecond; compare at that precision.
+	at := evt.OccurredAt.UTC().Truncate(time.Microsecond)
+
+	switch eventType {
+	case "deposit.position.opened", "deposit.interest.accrued", "deposit.position.closed":
+		return uc.projectDeposit(ctx, eventType, tenantID, at, evt)
+	case "fraud.assessment.completed":
+		assessedAt := evt.AssessedAt
+		if assessedAt.IsZero() {
+			assessedAt = at
+		}
+		return uc.readModel.SaveFraudDecision(ctx, service.FraudDecisionFact{
+			AssessmentID: evt.AssessmentID,
+			TenantID:     tenantID,
+			Decision:     evt.Decision,
+			AssessedAt:   assessedAt.UTC(),
+		})
+	case "payment.order.initiated", "payment.order.settled", "payment.order.failed", "payment.order.reversed":
+		return uc.projectPayment(ctx, eventType, tenantID, at, evt)
+	default:
+		return uc.projectLoan(ctx, eventType, tenantID, at, evt)
+	}
+}
+
+func (uc *ProjectDashboardEventUseCase) projectDeposit(ctx context.Context, eventType string, tenantID uuid.UUID, at time.Time, evt projectedEvent) error {
+	position, err := uc.readModel.FindDepositPosition(ctx, evt.PositionID)
+	switch {
+	case errors.Is(err, port.ErrProjectionNotFound):
+		if eventType != "deposit.position.opened" {
+			return nil
+		}
+		position = service.DepositPosition{
+			ID:              evt.PositionID,
+			TenantID:        tenantID,
+			AccountID:       evt.AccountID,
+			Currency:        evt.Currency,
+			Principal:       evt.Principal,
+			AccruedInterest: decimal.Zero,
+			OpenedAt:        at,
+			UpdatedAt:       at,
+		}
+		return uc.readModel.SaveDepositPosition(ctx, position)
+	case err != nil:
+		return fmt.Errorf("failed to find deposit position %s: %w", evt.PositionID, err)
+	case !at.After(position.UpdatedAt):
+		return nil
+	}
+
+	switch eventType {
+	case "deposit.interest.accrued":
+		position.AccruedInterest = position.AccruedInterest.Add(evt.Amount)
+	case "deposit.position.closed":
+		position.ClosedAt = &at
+	default:
+		return nil
+	}
+	position.UpdatedAt = at
+	return uc.readModel.SaveDepositPosition(ctx, position)
+}
+
+func (uc *ProjectDashboardEventUseCase) projectLoan(ctx context.Context, eventType string, tenantID uuid.UUID, at time.Time, evt projectedEvent) error {
+	loan, err := uc.readModel.FindLoanPosition(ctx, evt.AggregateID)
+	switch {
+	case errors.Is(err, port.ErrProjectionNotFound):
+		if eventType != "lending.loan.disbursed" {
+			return nil
+		}
+		loan = service.LoanPosition{
+			ID:          evt.AggregateID,
+			TenantID:    tenantID,
+			Currency:    evt.Currency,
+			Bucket:      service.LoanBucketCurrent,
+			Principal:   evt.Principal,
+			Outstanding: evt.Principal,
+			DisbursedAt: at,
+			UpdatedAt:   at,
+		}
+		return uc.readModel.SaveLoanPosition(ctx, loan)
+	case err != nil:
+		return fmt.Errorf("failed to find loan %s: %w", evt.AggregateID, err)
+	case !at.After(loan.UpdatedAt):
+		return nil
+	}
+
+	switch eventType {
+	case "lending.loan.payment_received":
+		loan.Outstanding = evt.OutstandingBalance
+	case "lending.loan.delinquent":
+		loan.Bucket, loan.Outstanding = service.LoanBucketDelinquent, evt.OutstandingBalance
+	case "lending.loan.default":
+		loan.Bucket, loan.Outstanding = service.LoanBucketDefault, evt.OutstandingBalance
+	case "lending.loan.paid_off":
+		loan.Bucket, loan.Outstanding = service.LoanBucketPaidOff, decimal.Zero
+	default:
+		return nil
+	}
+	loan.UpdatedAt = at
+	return uc.readModel.SaveLoanPosition(ctx, loan)
+}
+
+func (uc *ProjectDashboardEventUseCase) projectPayment(ctx context.Context, eventType string, tenantID uuid.UUID, at time.Time, evt projectedEvent) error {
+	payment, err := uc.readModel.FindPayment(ctx, evt.PaymentID)
+	switch {
+	case errors.Is(err, port.ErrProjectionNotFound):
+		if eventType != "payment.order.initiated" {
+			return nil
+		}
+		payment = service.PaymentFact{
+			ID:          evt.PaymentID,
+			TenantID:    tenantID,
+			Rail:        evt.Rail,
+			Currency:    evt.Currency,
+			Amount:      evt.Amount,
+			Status:      service.PaymentStatusInitiated,
+			InitiatedAt: at,
+			UpdatedAt:   at,
+		}
+		return uc.readModel.SavePayment(ctx, payment)
+	case err != nil:
+		return fmt.Errorf("failed to find payment %s: %w", evt.PaymentID, err)
+	case !at.After(payment.UpdatedAt):
+		return nil
+	}
+
+	switch eventType {
+	case "payment.order.settled":
+		payment.Status = service.PaymentStatusSettled
+	case "payment.order.failed":
+		payment.Status = service.PaymentStatusFailed
+	case "payment.order.reversed":
+		payment.Status = service.PaymentStatusReversed
+	default:
+		return nil
+	}
+	payment.UpdatedAt = at
+	return uc.readModel.SavePayment(ctx, payment)
+}
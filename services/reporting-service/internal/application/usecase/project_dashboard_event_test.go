@@ -0,0 +1,258 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// memReadModel is an in-memory DashboardReadModel.
+type memReadModel struct {
+	deposits  map[uuid.UUID]service.DepositPosition
+	loans     map[string]service.LoanPosition
+	payments  map[uuid.UUID]service.PaymentFact
+	decisions map[uuid.UUID]service.FraudDecisionFact
+}
+
+func newMemReadModel() *memReadModel {
+	return &memReadModel{
+		deposits:  make(map[uuid.UUID]service.DepositPosition),
+		loans:     make(map[string]service.LoanPosition),
+		payments:  make(map[uuid.UUID]service.PaymentFact),
+		decisions: make(map[uuid.UUID]service.FraudDecisionFact),
+	}
+}
+
+func (m *memReadModel) FindDepositPosition(_ context.Context, id uuid.UUID) (service.DepositPosition, error) {
+	p, ok := m.deposits[id]
+	if !ok {
+		return service.DepositPosition{}, port.ErrProjectionNotFound
+	}
+	return p, nil
+}
+
+func (m *memReadModel) SaveDepositPosition(_ context.Context, p service.DepositPosition) error {
+	m.deposits[p.ID] = p
+	return nil
+}
+
+func (m *memReadModel) ListDepositPositions(_ context.Context, tenantID uuid.UUID) ([]service.DepositPosition, error) {
+	var out []service.DepositPosition
+	for _, p := range m.deposits {
+		if p.TenantID == tenantID {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+func (m *memReadModel) FindLoanPosition(_ context.Context, id string) (service.LoanPosition, error) {
+	l, ok := m.loans[id]
+	if !ok {
+		return service.LoanPosition{}, port.ErrProjectionNotFound
+	}
+	return l, nil
+}
+
+func (m *memReadModel) SaveLoanPosition(_ context.Context, l service.LoanPosition) error {
+	m.loans[l.ID] = l
+	return nil
+}
+
+func (m *memReadModel) ListLoanPositions(_ context.Context, tenantID uuid.UUID) ([]service.LoanPosition, error) {
+	var out []service.LoanPosition
+	for _, l := range m.loans {
+		if l.TenantID == tenantID && l.Bucket != service.LoanBucketPaidOff {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+func (m *memReadModel) ListAllLoanPositions(_ context.Context, tenantID uuid.UUID) ([]service.LoanPosition, error) {
+	var out []service.LoanPosition
+	for _, l := range m.loans {
+		if l.TenantID == tenantID {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+func (m *memReadModel) FindPayment(_ context.Context, id uuid.UUID) (service.PaymentFact, error) {
+	p, ok := m.payments[id]
+	if !ok {
+		return service.PaymentFact{}, port.ErrProjectionNotFound
+	}
+	return p, nil
+}
+
+func (m *memReadModel) SavePayment(_ context.Context, p service.PaymentFact) error {
+	m.payments[p.ID] = p
+	return nil
+}
+
+func (m *memReadModel) ListPayments(_ context.Context, tenantID uuid.UUID, from, to time.Time) ([]service.PaymentFact, error) {
+	var out []service.PaymentFact
+	for _, p := range m.payments {
+		if p.TenantID == tenantID && !p.InitiatedAt.Before(from) && p.InitiatedAt.Before(to) {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+func (m *memReadModel) SaveFraudDecision(_ context.Context, d service.FraudDecisionFact) error {
+	m.decisions[d.AssessmentID] = d
+	return nil
+}
+
+func (m *memReadModel) ListFraudDecisions(_ context.Context, tenantID uuid.UUID, from, to time.Time) ([]service.FraudDecisionFact, error) {
+	var out []service.FraudDecisionFact
+	for _, d := range m.decisions {
+		if d.TenantID == tenantID && !d.AssessedAt.Before(from) && d.AssessedAt.Before(to) {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+func eventJSON(t *testing.T, fields map[string]any) []byte {
+	t.Helper()
+	b, err := json.Marshal(fields)
+	require.NoError(t, err)
+	return b
+}
+
+func TestProjectDashboardEvent_DepositLifecycle(t *testing.T) {
+	ctx := context.Background()
+	rm := newMemReadModel()
+	uc := usecase.NewProjectDashboardEventUseCase(rm)
+	tenantID, positionID := uuid.New(), uuid.New()
+	opened := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+
+	require.NoError(t, uc.Execute(ctx, "deposit.position.opened", eventJSON(t, map[string]any{
+		"tenant_id": tenantID, "position_id": positionID, "account_id": uuid.New(),
+		"currency": "USD", "principal": "1000", "occurred_at": opened,
+	})))
+	accrual := eventJSON(t, map[string]any{
+		"tenant_id": tenantID, "position_id": positionID, "amount": "2.5",
+		"occurred_at": opened.Add(24 * time.Hour),
+	})
+	require.NoError(t, uc.Execute(ctx, "deposit.interest.accrued", accrual))
+	// A redelivered accrual is skipped.
+	require.NoError(t, uc.Execute(ctx, "deposit.interest.accrued", accrual))
+
+	resp, err := usecase.NewGetBalanceSheetSummaryUseCase(rm).Execute(ctx, tenantID)
+	require.NoError(t, err)
+	require.Len(t, resp, 1)
+	assert.Equal(t, "1002.5", resp[0].Deposits)
+	assert.Equal(t, "2.5", resp[0].AccruedInterest)
+
+	require.NoError(t, uc.Execute(ctx, "deposit.position.closed", eventJSON(t, map[string]any{
+		"tenant_id": tenantID, "position_id": positionID, "occurred_at": opened.Add(48 * time.Hour),
+	})))
+	resp, err = usecase.NewGetBalanceSheetSummaryUseCase(rm).Execute(ctx, tenantID)
+	require.NoError(t, err)
+	assert.Empty(t, resp)
+}
+
+func TestProjectDashboardEvent_LoanBuckets(t *testing.T) {
+	ctx := context.Background()
+	rm := newMemReadModel()
+	uc := usecase.NewProjectDashboardEventUseCase(rm)
+	tenantID := uuid.New()
+	at := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+
+	// Events for loans the read model has not seen disbursed are ignored.
+	require.NoError(t, uc.Execute(ctx, "lending.loan.delinquent", eventJSON(t, map[string]any{
+		"tenant_id": tenantID, "aggregate_id": "LN-unknown", "outstanding_balance": "10", "occurred_at": at,
+	})))
+	assert.Empty(t, rm.loans)
+
+	require.NoError(t, uc.Execute(ctx, "lending.loan.disbursed", eventJSON(t, map[string]any{
+		"tenant_id": tenantID, "aggregate_id": "LN-1", "currency": "USD", "principal": "5000", "occurred_at": at,
+	})))
+	require.NoError(t, uc.Execute(ctx, "lending.loan.delinquent", eventJSON(t, map[string]any{
+		"tenant_id": tenantID, "aggregate_id": "LN-1", "outstanding_balance": "4200", "occurred_at": at.Add(time.Hour),
+	})))
+
+	resp, err := usecase.NewGetLoanBookUseCase(rm).Execute(ctx, tenantID)
+	require.NoError(t, err)
+	require.Len(t, resp, 1)
+	assert.Equal(t, service.LoanBucketDelinquent, resp[0].Bucket)
+	assert.Equal(t, "4200", resp[0].Outstanding)
+
+	require.NoError(t, uc.Execute(ctx, "lending.loan.paid_off", eventJSON(t, map[string]any{
+		"tenant_id": tenantID, "aggregate_id": "LN-1", "occurred_at": at.Add(2 * time.Hour),
+	})))
+	resp, err = usecase.NewGetLoanBookUseCase(rm).Execute(ctx, tenantID)
+	require.NoError(t, err)
+	assert.Empty(t, resp)
+}
+
+func TestProjectDashboardEvent_PaymentsAndFraudDecisions(t *testing.T) {
+	ctx := context.Background()
+	rm := newMemReadModel()
+	uc := usecase.NewProjectDashboardEventUseCase(rm)
+	tenantID, paymentID := uuid.New(), uuid.New()
+	at := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+
+	require.NoError(t, uc.Execute(ctx, "payment.order.initiated", eventJSON(t, map[string]any{
+		"tenant_id": tenantID, "payment_id": paymentID, "rail": "ACH", "currency": "USD",
+		"amount": "250", "occurred_at": at,
+	})))
+	require.NoError(t, uc.Execute(ctx, "payment.order.settled", eventJSON(t, map[string]any{
+		"tenant_id": tenantID, "payment_id": paymentID, "occurred_at": at.Add(time.Minute),
+	})))
+	// A late, out-of-order event does not override the settlement.
+	require.NoError(t, uc.Execute(ctx, "payment.order.failed", eventJSON(t, map[string]any{
+		"tenant_id": tenantID, "payment_id": paymentID, "occurred_at": at.Add(-time.Minute),
+	})))
+	for _, decision := range []string{"APPROVE", "APPROVE", "DECLINE", "REVIEW"} {
+		require.NoError(t, uc.Execute(ctx, "fraud.assessment.completed", eventJSON(t, map[string]any{
+			"tenant_id": tenantID, "assessment_id": uuid.New(), "decision": decision, "assessed_at": at,
+		})))
+	}
+	require.NoError(t, uc.Execute(ctx, "payment.order.processing", []byte(`{}`)))
+
+	rng := dto.DashboardRangeRequest{TenantID: tenantID, From: at.Truncate(24 * time.Hour), To: at.Truncate(24*time.Hour).AddDate(0, 0, 1)}
+	volumes, err := usecase.NewGetPaymentVolumesUseCase(rm).Execute(ctx, rng)
+	require.NoError(t, err)
+	require.Len(t, volumes, 1)
+	assert.Equal(t, 1, volumes[0].Settled)
+	assert.Zero(t, volumes[0].Failed)
+	assert.Equal(t, "250", volumes[0].SettledAmount)
+
+	rates, err := usecase.NewGetFraudDecisionRatesUseCase(rm).Execute(ctx, rng)
+	require.NoError(t, err)
+	assert.Equal(t, 4, rates.Assessments)
+	require.Len(t, rates.Rates, 3)
+	assert.Equal(t, "APPROVE", rates.Rates[0].Decision)
+	assert.Equal(t, "0.5000", rates.Rates[0].Rate)
+}
+
+func TestDashboardRangeValidation(t *testing.T) {
+	ctx := context.Background()
+	rm := newMemReadModel()
+	from := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := usecase.NewGetPaymentVolumesUseCase(rm).Execute(ctx, dto.DashboardRangeRequest{From: from, To: from})
+	assert.ErrorIs(t, err, usecase.ErrInvalidRange)
+
+	_, err = usecase.NewGetFraudDecisionRatesUseCase(rm).Execute(ctx, dto.DashboardRangeRequest{From: from, To: from.AddDate(2, 0, 0)})
+	assert.ErrorIs(t, err, usecase.ErrInvalidRange)
+
+	_, err = usecase.NewGetDepositGrowthUseCase(rm).Execute(ctx, dto.DepositGrowthRequest{From: from, To: from.AddDate(0, 1, 0), Granularity: "WEEK"})
+	assert.ErrorIs(t, err, usecase.ErrInvalidRange)
+}
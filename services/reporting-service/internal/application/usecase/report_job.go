@@ -0,0 +1,303 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+var (
+	// ErrInvalidReportJob is returned when a report job request is invalid.
+	ErrInvalidReportJob = errors.New("invalid report job")
+	// ErrArtifactNotFound is returned when a report job has no artifact by
+	// the requested name.
+	ErrArtifactNotFound = errors.New("report artifact not found")
+)
+
+// CreateReportJobUseCase queues a report for generation in the background.
+type CreateReportJobUseCase struct {
+	jobs port.ReportJobRepository
+}
+
+// NewCreateReportJobUseCase creates a new CreateReportJobUseCase.
+func NewCreateReportJobUseCase(jobs port.ReportJobRepository) *CreateReportJobUseCase {
+	return &CreateReportJobUseCase{jobs: jobs}
+}
+
+// Execute validates the request and queues the job. The report is generated
+// by RunReportJobsUseCase.
+func (uc *CreateReportJobUseCase) Execute(ctx context.Context, req dto.GenerateReportRequest) (dto.ReportJobResponse, error) {
+	reportType, err := valueobject.NewReportType(req.ReportType)
+	if err != nil {
+		return dto.ReportJobResponse{}, fmt.Errorf("%w: %w", ErrInvalidReportJob, err)
+	}
+	if _, _, err := resolveReportFormat(reportType, req.Format); err != nil {
+		return dto.ReportJobResponse{}, err
+	}
+
+	job, err := model.NewReportJob(req.TenantID, reportType, req.Period, strings.ToUpper(req.Format), req.Actor, time.Now().UTC())
+	if err != nil {
+		return dto.ReportJobResponse{}, fmt.Errorf("%w: %w", ErrInvalidReportJob, err)
+	}
+	if err := uc.jobs.Save(ctx, job); err != nil {
+		return dto.ReportJobResponse{}, fmt.Errorf("failed to save report job: %w", err)
+	}
+	return toReportJobResponse(job), nil
+}
+
+// RunReportJobsUseCase runs queued report jobs. Each job generates its
+// report, stores the filing and the requested rendering as artifacts, and
+// publishes an event when it completes.
+type RunReportJobsUseCase struct {
+	jobs           port.ReportJobRepository
+	generateReport *GenerateReportUseCase
+	store          port.ObjectStore
+	eventPublisher port.EventPublisher
+	prefix         string
+	lease          time.Duration
+}
+
+// NewRunReportJobsUseCase creates a new RunReportJobsUseCase. Artifacts are
+// stored under prefix; a running job that reports no progress for lease is
+// taken over by another worker.
+func NewRunReportJobsUseCase(
+	jobs port.ReportJobRepository,
+	generateReport *GenerateReportUseCase,
+	store port.ObjectStore,
+	eventPublisher port.EventPublisher,
+	prefix string,
+	lease time.Duration,
+) *RunReportJobsUseCase {
+	return &RunReportJobsUseCase{
+		jobs:           jobs,
+		generateReport: generateReport,
+		store:          store,
+		eventPublisher: eventPublisher,
+		prefix:         prefix,
+		lease:          lease,
+	}
+}
+
+// Execute claims the next job and runs it to completion. It returns false
+// when no job was waiting. A job that fails is recorded as FAILED; the error
+// returned is only for failing to record the outcome.
+func (uc *RunReportJobsUseCase) Execute(ctx context.Context) (bool, error) {
+	now := time.Now().UTC()
+	job, ok, err := uc.jobs.ClaimNext(ctx, now, now.Add(-uc.lease))
+	if err != nil {
+		return false, fmt.Errorf("failed to claim report job: %w", err)
+	}
+	if !ok {
+		return false, nil
+	}
+	return true, uc.run(ctx, job)
+}
+
+func (uc *RunReportJobsUseCase) run(ctx context.Context, job model.ReportJob) error {
+	// Progress is saved as the job goes; it also tells other workers the
+	// job is still alive. It is best effort: the outcome is saved regardless.
+	progress := func(stage string, percent int) {
+		updated, err := job.ReportProgress(stage, percent, time.Now().UTC())
+		if err != nil {
+			return
+		}
+		job = updated
+		_ = uc.jobs.Save(ctx, job) //nolint:errcheck // best-effort progress update
+	}
+
+	submission, resp, err := uc.generateReport.execute(ctx, dto.GenerateReportRequest{
+		TenantID:   job.TenantID(),
+		ReportType: job.ReportType().String(),
+		Period:     job.ReportingPeriod(),
+		Format:     job.Format(),
+		Actor:      job.RequestedBy(),
+	}, progress)
+	if err == nil {
+		job, err = job.RecordReport(submission.ID())
+	}
+	if err == nil {
+		progress("storing artifacts", 95)
+		err = uc.storeArtifacts(ctx, &job, submission, resp)
+	}
+
+	now := time.Now().UTC()
+	var completeErr error
+	if err != nil {
+		job, completeErr = job.Fail(err.Error(), now)
+	} else {
+		job, completeErr = job.Succeed(now)
+	}
+	if completeErr != nil {
+		return fmt.Errorf("job %s: %w", job.ID(), completeErr)
+	}
+
+	if err := uc.jobs.Save(ctx, job); err != nil {
+		return fmt.Errorf("failed to save report job: %w", err)
+	}
+	if events := job.DomainEvents(); len(events) > 0 {
+		if err := uc.eventPublisher.Publish(ctx, events...); err != nil {
+			return fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+	return nil
+}
+
+// storeArtifacts stores the filing and, when another format was requested,
+// its rendering. Each artifact is recorded on the job as soon as it is
+// stored, so a failure leaves the job listing what was produced.
+func (uc *RunReportJobsUseCase) storeArtifacts(ctx context.Context, job *model.ReportJob, submission model.ReportSubmission, resp dto.GenerateReportResponse) error {
+	_, filingFormat, err := resolveReportFormat(submission.ReportType(), "")
+	if err != nil {
+		return err
+	}
+	files := []dto.ReportArtifactContent{{
+		Name:        fmt.Sprintf("%s-%s.%s", strings.ToLower(submission.ReportType().String()), submission.ReportingPeriod(), filingFormat.Extension()),
+		ContentType: filingFormat.ContentType(),
+		Content:     []byte(submission.XBRLContent()),
+	}}
+	if resp.FileName != files[0].Name {
+		files = append(files, dto.ReportArtifactContent{Name: resp.FileName, ContentType: resp.ContentType, Content: resp.Content})
+	}
+
+	for _, f := range files {
+		key := fmt.Sprintf("%s%s/%s/%s", uc.prefix, job.TenantID(), job.ID(), f.Name)
+		if err := uc.store.Put(ctx, key, f.Content, f.ContentType); err != nil {
+			return fmt.Errorf("failed to store %s: %w", f.Name, err)
+		}
+		sum := sha256.Sum256(f.Content)
+		updated, err := job.AddArtifact(model.ReportArtifact{
+			Name:        f.Name,
+			ContentType: f.ContentType,
+			Key:         key,
+			SHA256:      hex.EncodeToString(sum[:]),
+			SizeBytes:   int64(len(f.Content)),
+		})
+		if err != nil {
+			return err
+		}
+		*job = updated
+	}
+	return nil
+}
+
+// GetReportJobUseCase retrieves a report job and its progress.
+type GetReportJobUseCase struct {
+	jobs port.ReportJobRepository
+}
+
+// NewGetReportJobUseCase creates a new GetReportJobUseCase.
+func NewGetReportJobUseCase(jobs port.ReportJobRepository) *GetReportJobUseCase {
+	return &GetReportJobUseCase{jobs: jobs}
+}
+
+// Execute retrieves the tenant's job.
+func (uc *GetReportJobUseCase) Execute(ctx context.Context, tenantID, id uuid.UUID) (dto.ReportJobResponse, error) {
+	job, err := uc.jobs.FindByID(ctx, tenantID, id)
+	if err != nil {
+		return dto.ReportJobResponse{}, fmt.Errorf("failed to find report job: %w", err)
+	}
+	return toReportJobResponse(job), nil
+}
+
+// ListReportJobsUseCase lists a tenant's recent report jobs.
+type ListReportJobsUseCase struct {
+	jobs port.ReportJobRepository
+}
+
+// NewListReportJobsUseCase creates a new ListReportJobsUseCase.
+func NewListReportJobsUseCase(jobs port.ReportJobRepository) *ListReportJobsUseCase {
+	return &ListReportJobsUseCase{jobs: jobs}
+}
+
+// Execute lists up to limit of the tenant's jobs, newest first.
+func (uc *ListReportJobsUseCase) Execute(ctx context.Context, tenantID uuid.UUID, limit int) ([]dto.ReportJobResponse, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+	jobs, err := uc.jobs.ListByTenant(ctx, tenantID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list report jobs: %w", err)
+	}
+	resp := make([]dto.ReportJobResponse, 0, len(jobs))
+	for _, j := range jobs {
+		resp = append(resp, toReportJobResponse(j))
+	}
+	return resp, nil
+}
+
+// GetReportJobArtifactUseCase downloads an artifact of a report job.
+type GetReportJobArtifactUseCase struct {
+	jobs  port.ReportJobRepository
+	store port.ObjectStore
+}
+
+// NewGetReportJobArtifactUseCase creates a new GetReportJobArtifactUseCase.
+func NewGetReportJobArtifactUseCase(jobs port.ReportJobRepository, store port.ObjectStore) *GetReportJobArtifactUseCase {
+	return &GetReportJobArtifactUseCase{jobs: jobs, store: store}
+}
+
+// Execute reads the named artifact of the tenant's job.
+func (uc *GetReportJobArtifactUseCase) Execute(ctx context.Context, tenantID, id uuid.UUID, name string) (dto.ReportArtifactContent, error) {
+	job, err := uc.jobs.FindByID(ctx, tenantID, id)
+	if err != nil {
+		return dto.ReportArtifactContent{}, fmt.Errorf("failed to find report job: %w", err)
+	}
+	artifact, ok := job.Artifact(name)
+	if !ok {
+		return dto.ReportArtifactContent{}, fmt.Errorf("%w: %q", ErrArtifactNotFound, name)
+	}
+	content, err := uc.store.Get(ctx, artifact.Key)
+	if errors.Is(err, port.ErrObjectNotFound) {
+		return dto.ReportArtifactContent{}, fmt.Errorf("%w: %w", ErrArtifactNotFound, err)
+	}
+	if err != nil {
+		return dto.ReportArtifactContent{}, fmt.Errorf("failed to read artifact: %w", err)
+	}
+	return dto.ReportArtifactContent{
+		Name:        artifact.Name,
+		ContentType: artifact.ContentType,
+		Content:     content,
+	}, nil
+}
+
+func toReportJobResponse(j model.ReportJob) dto.ReportJobResponse {
+	artifacts := make([]dto.ReportArtifactResponse, 0, len(j.Artifacts()))
+	for _, a := range j.Artifacts() {
+		artifacts = append(artifacts, dto.ReportArtifactResponse{
+			Name:        a.Name,
+			ContentType: a.ContentType,
+			SHA256:      a.SHA256,
+			SizeBytes:   a.SizeBytes,
+		})
+	}
+	return dto.ReportJobResponse{
+		ID:              j.ID(),
+		TenantID:        j.TenantID(),
+		ReportType:      j.ReportType().String(),
+		ReportingPeriod: j.ReportingPeriod(),
+		Format:          j.Format(),
+		RequestedBy:     j.RequestedBy(),
+		Status:          j.Status().String(),
+		Progress:        j.Progress(),
+		Stage:           j.Stage(),
+		ReportID:        j.ReportID(),
+		Artifacts:       artifacts,
+		FailureDetail:   j.FailureDetail(),
+		Attempts:        j.Attempts(),
+		StartedAt:       j.StartedAt(),
+		CompletedAt:     j.CompletedAt(),
+		CreatedAt:       j.CreatedAt(),
+		UpdatedAt:       j.UpdatedAt(),
+	}
+}
@@ -0,0 +1,217 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/event"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+type inMemoryJobRepo struct {
+	jobs map[uuid.UUID]model.ReportJob
+}
+
+func (r *inMemoryJobRepo) Save(_ context.Context, job model.ReportJob) error {
+	r.jobs[job.ID()] = job.ClearDomainEvents()
+	return nil
+}
+
+func (r *inMemoryJobRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.ReportJob, error) {
+	job, ok := r.jobs[id]
+	if !ok || job.TenantID() != tenantID {
+		return model.ReportJob{}, port.ErrReportJobNotFound
+	}
+	return job, nil
+}
+
+func (r *inMemoryJobRepo) ListByTenant(_ context.Context, tenantID uuid.UUID, limit int) ([]model.ReportJob, error) {
+	var result []model.ReportJob
+	for _, j := range r.jobs {
+		if j.TenantID() == tenantID {
+			result = append(result, j)
+		}
+	}
+	sort.Slice(result, func(a, b int) bool { return result[a].CreatedAt().After(result[b].CreatedAt()) })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (r *inMemoryJobRepo) ClaimNext(_ context.Context, now, staleBefore time.Time) (model.ReportJob, bool, error) {
+	var next *model.ReportJob
+	for _, j := range r.jobs {
+		claimable := j.Status().Equal(valueobject.JobStatusQueued) ||
+			(j.Status().Equal(valueobject.JobStatusRunning) && j.UpdatedAt().Before(staleBefore))
+		if claimable && (next == nil || j.CreatedAt().Before(next.CreatedAt())) {
+			j := j
+			next = &j
+		}
+	}
+	if next == nil {
+		return model.ReportJob{}, false, nil
+	}
+	job, err := next.Start(now)
+	if err != nil {
+		return model.ReportJob{}, false, err
+	}
+	r.jobs[job.ID()] = job
+	return job, true, nil
+}
+
+type failingObjectStore struct {
+	memObjectStore
+	failOn string
+}
+
+func (s *failingObjectStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	if len(key) >= len(s.failOn) && key[len(key)-len(s.failOn):] == s.failOn {
+		return errors.New("bucket unavailable")
+	}
+	return s.memObjectStore.Put(ctx, key, data, contentType)
+}
+
+type reportJobFixture struct {
+	jobs      *inMemoryJobRepo
+	store     port.ObjectStore
+	publisher *mockEventPublisher
+	create    *usecase.CreateReportJobUseCase
+	run       *usecase.RunReportJobsUseCase
+	tenantID  uuid.UUID
+}
+
+func newReportJobFixture(ledger port.LedgerDataClient, store port.ObjectStore) reportJobFixture {
+	f := reportJobFixture{
+		jobs:      &inMemoryJobRepo{jobs: make(map[uuid.UUID]model.ReportJob)},
+		store:     store,
+		publisher: &mockEventPublisher{},
+		tenantID:  uuid.New(),
+	}
+	generate := usecase.NewGenerateReportUseCase(newInMemoryRepo(), f.publisher, ledger,
+		service.NewXBRLGenerator(), service.NewReportRenderer(), nil)
+	f.create = usecase.NewCreateReportJobUseCase(f.jobs)
+	f.run = usecase.NewRunReportJobsUseCase(f.jobs, generate, store, f.publisher, "report-jobs/", 15*time.Minute)
+	return f
+}
+
+func (f reportJobFixture) completion(t *testing.T) event.ReportJobCompleted {
+	t.Helper()
+	for _, e := range f.publisher.publishedEvents {
+		if c, ok := e.(event.ReportJobCompleted); ok {
+			return c
+		}
+	}
+	t.Fatal("no report.job.completed event published")
+	return event.ReportJobCompleted{}
+}
+
+func TestRunReportJobsUseCase_GeneratesArtifacts(t *testing.T) {
+	ctx := context.Background()
+	f := newReportJobFixture(&mockLedgerClient{}, &memObjectStore{objects: map[string][]byte{}})
+
+	queued, err := f.create.Execute(ctx, dto.GenerateReportRequest{
+		TenantID: f.tenantID, ReportType: "FINREP", Period: "2025-Q1", Format: "csv", Actor: "maker",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "QUEUED", queued.Status)
+	assert.Equal(t, "CSV", queued.Format)
+
+	ran, err := f.run.Execute(ctx)
+	require.NoError(t, err)
+	assert.True(t, ran)
+
+	job, err := usecase.NewGetReportJobUseCase(f.jobs).Execute(ctx, f.tenantID, queued.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "SUCCEEDED", job.Status)
+	assert.Equal(t, 100, job.Progress)
+	assert.Equal(t, 1, job.Attempts)
+	require.NotNil(t, job.ReportID)
+	require.Len(t, job.Artifacts, 2)
+	assert.Equal(t, "finrep-2025-Q1.xbrl", job.Artifacts[0].Name)
+	assert.Equal(t, "finrep-2025-Q1.csv", job.Artifacts[1].Name)
+	assert.Len(t, job.Artifacts[1].SHA256, 64)
+
+	download := usecase.NewGetReportJobArtifactUseCase(f.jobs, f.store)
+	artifact, err := download.Execute(ctx, f.tenantID, queued.ID, "finrep-2025-Q1.csv")
+	require.NoError(t, err)
+	assert.Equal(t, "text/csv", artifact.ContentType)
+	assert.NotEmpty(t, artifact.Content)
+	_, err = download.Execute(ctx, f.tenantID, queued.ID, "finrep-2025-Q1.pdf")
+	assert.ErrorIs(t, err, usecase.ErrArtifactNotFound)
+	_, err = download.Execute(ctx, uuid.New(), queued.ID, "finrep-2025-Q1.csv")
+	assert.ErrorIs(t, err, port.ErrReportJobNotFound, "jobs are scoped to their tenant")
+
+	completed := f.completion(t)
+	assert.Equal(t, "SUCCEEDED", completed.Status)
+	assert.Equal(t, job.ReportID.String(), completed.ReportID)
+
+	ran, err = f.run.Execute(ctx)
+	require.NoError(t, err)
+	assert.False(t, ran, "no job left to run")
+
+	listed, err := usecase.NewListReportJobsUseCase(f.jobs).Execute(ctx, f.tenantID, 0)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+}
+
+func TestRunReportJobsUseCase_RecordsFailures(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("generation failure", func(t *testing.T) {
+		f := newReportJobFixture(&unbalancedLedgerClient{}, &memObjectStore{objects: map[string][]byte{}})
+		queued, err := f.create.Execute(ctx, dto.GenerateReportRequest{TenantID: f.tenantID, ReportType: "FINREP", Period: "2025-Q1"})
+		require.NoError(t, err)
+
+		_, err = f.run.Execute(ctx)
+		require.NoError(t, err)
+
+		job, err := usecase.NewGetReportJobUseCase(f.jobs).Execute(ctx, f.tenantID, queued.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "FAILED", job.Status)
+		assert.Contains(t, job.FailureDetail, "failed while sourcing ledger data")
+		assert.Nil(t, job.ReportID)
+		assert.Equal(t, "FAILED", f.completion(t).Status)
+	})
+
+	t.Run("partial failure keeps the report and stored artifacts", func(t *testing.T) {
+		f := newReportJobFixture(&mockLedgerClient{}, &failingObjectStore{memObjectStore: memObjectStore{objects: map[string][]byte{}}, failOn: ".csv"})
+		queued, err := f.create.Execute(ctx, dto.GenerateReportRequest{TenantID: f.tenantID, ReportType: "FINREP", Period: "2025-Q1", Format: "CSV"})
+		require.NoError(t, err)
+
+		_, err = f.run.Execute(ctx)
+		require.NoError(t, err)
+
+		job, err := usecase.NewGetReportJobUseCase(f.jobs).Execute(ctx, f.tenantID, queued.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "FAILED", job.Status)
+		assert.Equal(t, "failed while storing artifacts: failed to store finrep-2025-Q1.csv: bucket unavailable", job.FailureDetail)
+		assert.NotNil(t, job.ReportID)
+		require.Len(t, job.Artifacts, 1)
+		assert.Equal(t, "finrep-2025-Q1.xbrl", job.Artifacts[0].Name)
+	})
+}
+
+func TestCreateReportJobUseCase_Validation(t *testing.T) {
+	ctx := context.Background()
+	f := newReportJobFixture(&mockLedgerClient{}, &memObjectStore{objects: map[string][]byte{}})
+
+	_, err := f.create.Execute(ctx, dto.GenerateReportRequest{TenantID: f.tenantID, ReportType: "BOGUS", Period: "2025-Q1"})
+	assert.ErrorIs(t, err, usecase.ErrInvalidReportJob)
+	_, err = f.create.Execute(ctx, dto.GenerateReportRequest{TenantID: f.tenantID, ReportType: "SAR", Period: "2025-03", Format: "csv"})
+	assert.ErrorIs(t, err, usecase.ErrUnsupportedFormat)
+	_, err = f.create.Execute(ctx, dto.GenerateReportRequest{TenantID: f.tenantID, ReportType: "COREP"})
+	assert.ErrorIs(t, err, usecase.ErrInvalidReportJob)
+}
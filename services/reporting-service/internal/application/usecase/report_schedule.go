@@ -0,0 +1,302 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+// ErrInvalidSchedule is returned when a report schedule request is invalid.
+var ErrInvalidSchedule = errors.New("invalid report schedule")
+
+// UpsertReportScheduleUseCase creates or changes a tenant's reporting calendar
+// for a report type.
+type UpsertReportScheduleUseCase struct {
+	schedules port.ReportScheduleRepository
+}
+
+// NewUpsertReportScheduleUseCase creates a new UpsertReportScheduleUseCase.
+func NewUpsertReportScheduleUseCase(schedules port.ReportScheduleRepository) *UpsertReportScheduleUseCase {
+	return &UpsertReportScheduleUseCase{schedules: schedules}
+}
+
+// Execute creates the schedule, or reschedules the tenant's existing one.
+func (uc *UpsertReportScheduleUseCase) Execute(ctx context.Context, req dto.UpsertReportScheduleRequest) (dto.ReportScheduleResponse, error) {
+	reportType, err := valueobject.NewReportType(req.ReportType)
+	if err != nil {
+		return dto.ReportScheduleResponse{}, fmt.Errorf("%w: %w", ErrInvalidSchedule, err)
+	}
+	frequency, err := valueobject.NewReportingFrequency(req.Frequency)
+	if err != nil {
+		return dto.ReportScheduleResponse{}, fmt.Errorf("%w: %w", ErrInvalidSchedule, err)
+	}
+
+	now := time.Now().UTC()
+	schedule, err := uc.schedules.FindByTenantAndType(ctx, req.TenantID, reportType.String())
+	switch {
+	case errors.Is(err, port.ErrScheduleNotFound):
+		schedule, err = model.NewReportSchedule(req.TenantID, reportType, frequency, req.DeadlineDays, req.LeadDays, now)
+		if err == nil && !req.Active {
+			schedule, err = schedule.Reschedule(frequency, req.DeadlineDays, req.LeadDays, false, now)
+		}
+	case err != nil:
+		return dto.ReportScheduleResponse{}, fmt.Errorf("failed to find report schedule: %w", err)
+	default:
+		schedule, err = schedule.Reschedule(frequency, req.DeadlineDays, req.LeadDays, req.Active, now)
+	}
+	if err != nil {
+		return dto.ReportScheduleResponse{}, fmt.Errorf("%w: %w", ErrInvalidSchedule, err)
+	}
+
+	if err := uc.schedules.Save(ctx, schedule); err != nil {
+		return dto.ReportScheduleResponse{}, fmt.Errorf("failed to save report schedule: %w", err)
+	}
+	return toReportScheduleResponse(schedule), nil
+}
+
+// ListReportSchedulesUseCase lists a tenant's reporting calendar.
+type ListReportSchedulesUseCase struct {
+	schedules port.ReportScheduleRepository
+}
+
+// NewListReportSchedulesUseCase creates a new ListReportSchedulesUseCase.
+func NewListReportSchedulesUseCase(schedules port.ReportScheduleRepository) *ListReportSchedulesUseCase {
+	return &ListReportSchedulesUseCase{schedules: schedules}
+}
+
+// Execute lists the tenant's report schedules.
+func (uc *ListReportSchedulesUseCase) Execute(ctx context.Context, tenantID uuid.UUID) ([]dto.ReportScheduleResponse, error) {
+	schedules, err := uc.schedules.ListByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list report schedules: %w", err)
+	}
+	resp := make([]dto.ReportScheduleResponse, 0, len(schedules))
+	for _, s := range schedules {
+		resp = append(resp, toReportScheduleResponse(s))
+	}
+	return resp, nil
+}
+
+// ListScheduledRunsUseCase lists a tenant's scheduled runs with their
+// deadline SLA outcomes.
+type ListScheduledRunsUseCase struct {
+	runs port.ScheduledRunRepository
+}
+
+// NewListScheduledRunsUseCase creates a new ListScheduledRunsUseCase.
+func NewListScheduledRunsUseCase(runs port.ScheduledRunRepository) *ListScheduledRunsUseCase {
+	return &ListScheduledRunsUseCase{runs: runs}
+}
+
+// Execute lists the tenant's runs with deadlines from req.Since onwards.
+func (uc *ListScheduledRunsUseCase) Execute(ctx context.Context, req dto.ListScheduledRunsRequest) (dto.ListScheduledRunsResponse, error) {
+	runs, err := uc.runs.ListByTenant(ctx, req.TenantID, req.Since)
+	if err != nil {
+		return dto.ListScheduledRunsResponse{}, fmt.Errorf("failed to list scheduled runs: %w", err)
+	}
+
+	now := time.Now().UTC()
+	resp := dto.ListScheduledRunsResponse{Runs: make([]dto.ScheduledRunResponse, 0, len(runs))}
+	for _, r := range runs {
+		resp.Runs = append(resp.Runs, toScheduledRunResponse(r))
+		switch {
+		case r.SubmittedAt() != nil && r.SLAMet():
+			resp.SLA.Met++
+		case r.SubmittedAt() != nil:
+			resp.SLA.Late++
+		case r.Status().Equal(valueobject.RunStatusMissed) || r.IsOverdue(now):
+			resp.SLA.Missed++
+		default:
+			resp.SLA.Open++
+		}
+	}
+	return resp, nil
+}
+
+// RunReportSchedulesUseCase generates draft reports ahead of their regulatory
+// deadlines and tracks each run until the report is submitted. Failed
+// generations are retried up to maxAttempts; failures and missed deadlines
+// raise alerts.
+type RunReportSchedulesUseCase struct {
+	schedules      port.ReportScheduleRepository
+	runs           port.ScheduledRunRepository
+	reports        port.ReportSubmissionRepository
+	generateReport *GenerateReportUseCase
+	eventPublisher port.EventPublisher
+	maxAttempts    int
+}
+
+// NewRunReportSchedulesUseCase creates a new RunReportSchedulesUseCase.
+func NewRunReportSchedulesUseCase(
+	schedules port.ReportScheduleRepository,
+	runs port.ScheduledRunRepository,
+	reports port.ReportSubmissionRepository,
+	generateReport *GenerateReportUseCase,
+	eventPublisher port.EventPublisher,
+	maxAttempts int,
+) *RunReportSchedulesUseCase {
+	return &RunReportSchedulesUseCase{
+		schedules:      schedules,
+		runs:           runs,
+		reports:        reports,
+		generateReport: generateReport,
+		eventPublisher: eventPublisher,
+		maxAttempts:    maxAttempts,
+	}
+}
+
+// Execute starts the runs that are due, retries failed ones and checks open
+// runs against their deadlines. A failure on one run does not stop the pass;
+// failures are returned together.
+func (uc *RunReportSchedulesUseCase) Execute(ctx context.Context, now time.Time) (dto.RunReportSchedulesResult, error) {
+	var (
+		result  dto.RunReportSchedulesResult
+		errs    []error
+		started = make(map[uuid.UUID]bool)
+	)
+
+	schedules, err := uc.schedules.ListActive(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to list active report schedules: %w", err)
+	}
+	for _, s := range schedules {
+		period, deadline, ok := s.DueRun(now)
+		if !ok {
+			continue
+		}
+		if _, exists, err := uc.runs.FindBySchedulePeriod(ctx, s.ID(), period); err != nil || exists {
+			if err != nil {
+				errs = append(errs, fmt.Errorf("schedule %s: %w", s.ID(), err))
+			}
+			continue
+		}
+		run, err := model.NewScheduledRun(s, period, deadline, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("schedule %s: %w", s.ID(), err))
+			continue
+		}
+		started[run.ID()] = true
+		if err := uc.generate(ctx, run, now, &result); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	open, err := uc.runs.ListOpen(ctx)
+	if err != nil {
+		return result, errors.Join(append(errs, fmt.Errorf("failed to list open scheduled runs: %w", err))...)
+	}
+	for _, run := range open {
+		if started[run.ID()] {
+			continue
+		}
+		if err := uc.track(ctx, run, now, &result); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+// generate generates the run's draft report and records the outcome.
+func (uc *RunReportSchedulesUseCase) generate(ctx context.Context, run model.ScheduledRun, now time.Time, result *dto.RunReportSchedulesResult) error {
+	report, genErr := uc.generateReport.Execute(ctx, dto.GenerateReportRequest{
+		TenantID:   run.TenantID(),
+		ReportType: run.ReportType().String(),
+		Period:     run.ReportingPeriod(),
+	})
+
+	var err error
+	if genErr != nil {
+		run, err = run.MarkFailed(genErr.Error(), now)
+		result.Failed++
+	} else {
+		run, err = run.MarkGenerated(report.ID, now)
+		result.Generated++
+	}
+	if err != nil {
+		return fmt.Errorf("run %s: %w", run.ID(), err)
+	}
+	return uc.save(ctx, run)
+}
+
+// track retries a failed run, records the submission of a generated report,
+// and flags runs whose deadline has passed without a submission.
+func (uc *RunReportSchedulesUseCase) track(ctx context.Context, run model.ScheduledRun, now time.Time, result *dto.RunReportSchedulesResult) error {
+	if run.Status().Equal(valueobject.RunStatusFailed) && run.Attempts() < uc.maxAttempts && !run.IsOverdue(now) {
+		return uc.generate(ctx, run, now, result)
+	}
+
+	if run.ReportID() != nil {
+		report, err := uc.reports.FindByID(ctx, *run.ReportID())
+		if err != nil {
+			return fmt.Errorf("run %s: failed to find report: %w", run.ID(), err)
+		}
+		if report.SubmittedAt() != nil {
+			run, err = run.MarkSubmitted(*report.SubmittedAt(), now)
+			if err != nil {
+				return fmt.Errorf("run %s: %w", run.ID(), err)
+			}
+			result.Submitted++
+			return uc.save(ctx, run)
+		}
+	}
+
+	if !run.IsOverdue(now) {
+		return nil
+	}
+	run, err := run.MarkMissed(now)
+	if err != nil {
+		return fmt.Errorf("run %s: %w", run.ID(), err)
+	}
+	result.Missed++
+	return uc.save(ctx, run)
+}
+
+func (uc *RunReportSchedulesUseCase) save(ctx context.Context, run model.ScheduledRun) error {
+	if err := uc.runs.Save(ctx, run); err != nil {
+		return fmt.Errorf("run %s: failed to save scheduled run: %w", run.ID(), err)
+	}
+	if events := run.DomainEvents(); len(events) > 0 {
+		if err := uc.eventPublisher.Publish(ctx, events...); err != nil {
+			return fmt.Errorf("run %s: failed to publish events: %w", run.ID(), err)
+		}
+	}
+	return nil
+}
+
+func toReportScheduleResponse(s model.ReportSchedule) dto.ReportScheduleResponse {
+	return dto.ReportScheduleResponse{
+		ID:           s.ID(),
+		TenantID:     s.TenantID(),
+		ReportType:   s.ReportType().String(),
+		Frequency:    s.Frequency().String(),
+		DeadlineDays: s.DeadlineDays(),
+		LeadDays:     s.LeadDays(),
+		Active:       s.Active(),
+		CreatedAt:    s.CreatedAt(),
+		UpdatedAt:    s.UpdatedAt(),
+	}
+}
+
+func toScheduledRunResponse(r model.ScheduledRun) dto.ScheduledRunResponse {
+	return dto.ScheduledRunResponse{
+		ID:              r.ID(),
+		ScheduleID:      r.ScheduleID(),
+		ReportType:      r.ReportType().String(),
+		ReportingPeriod: r.ReportingPeriod(),
+		Deadline:        r.Deadline(),
+		Status:          r.Status().String(),
+		ReportID:        r.ReportID(),
+		Attempts:        r.Attempts(),
+		FailureReason:   r.FailureReason(),
+		SubmittedAt:     r.SubmittedAt(),
+		SLAMet:          r.SLAMet(),
+	}
+}
@@ -0,0 +1,264 @@
+package usecase_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/event"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+type inMemoryScheduleRepo struct {
+	schedules map[uuid.UUID]model.ReportSchedule
+}
+
+func newInMemoryScheduleRepo() *inMemoryScheduleRepo {
+	return &inMemoryScheduleRepo{schedules: make(map[uuid.UUID]model.ReportSchedule)}
+}
+
+func (r *inMemoryScheduleRepo) Save(_ context.Context, schedule model.ReportSchedule) error {
+	r.schedules[schedule.ID()] = schedule
+	return nil
+}
+
+func (r *inMemoryScheduleRepo) FindByTenantAndType(_ context.Context, tenantID uuid.UUID, reportType string) (model.ReportSchedule, error) {
+	for _, s := range r.schedules {
+		if s.TenantID() == tenantID && s.ReportType().String() == reportType {
+			return s, nil
+		}
+	}
+	return model.ReportSchedule{}, port.ErrScheduleNotFound
+}
+
+func (r *inMemoryScheduleRepo) ListByTenant(_ context.Context, tenantID uuid.UUID) ([]model.ReportSchedule, error) {
+	var result []model.ReportSchedule
+	for _, s := range r.schedules {
+		if s.TenantID() == tenantID {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (r *inMemoryScheduleRepo) ListActive(_ context.Context) ([]model.ReportSchedule, error) {
+	var result []model.ReportSchedule
+	for _, s := range r.schedules {
+		if s.Active() {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+type inMemoryRunRepo struct {
+	runs map[uuid.UUID]model.ScheduledRun
+}
+
+func newInMemoryRunRepo() *inMemoryRunRepo {
+	return &inMemoryRunRepo{runs: make(map[uuid.UUID]model.ScheduledRun)}
+}
+
+func (r *inMemoryRunRepo) Save(_ context.Context, run model.ScheduledRun) error {
+	r.runs[run.ID()] = run.ClearDomainEvents()
+	return nil
+}
+
+func (r *inMemoryRunRepo) FindBySchedulePeriod(_ context.Context, scheduleID uuid.UUID, period string) (model.ScheduledRun, bool, error) {
+	for _, run := range r.runs {
+		if run.ScheduleID() == scheduleID && run.ReportingPeriod() == period {
+			return run, true, nil
+		}
+	}
+	return model.ScheduledRun{}, false, nil
+}
+
+func (r *inMemoryRunRepo) ListByTenant(_ context.Context, tenantID uuid.UUID, since time.Time) ([]model.ScheduledRun, error) {
+	var result []model.ScheduledRun
+	for _, run := range r.runs {
+		if run.TenantID() == tenantID && !run.Deadline().Before(since) {
+			result = append(result, run)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Deadline().Before(result[j].Deadline()) })
+	return result, nil
+}
+
+func (r *inMemoryRunRepo) ListOpen(_ context.Context) ([]model.ScheduledRun, error) {
+	var result []model.ScheduledRun
+	for _, run := range r.runs {
+		switch {
+		case run.Status().Equal(valueobject.RunStatusSubmitted):
+		case run.Status().Equal(valueobject.RunStatusMissed) && run.ReportID() == nil:
+		default:
+			result = append(result, run)
+		}
+	}
+	return result, nil
+}
+
+func (r *inMemoryRunRepo) only(t *testing.T) model.ScheduledRun {
+	t.Helper()
+	require.Len(t, r.runs, 1)
+	for _, run := range r.runs {
+		return run
+	}
+	return model.ScheduledRun{}
+}
+
+// schedulerFixture holds a monthly FINREP schedule whose current draft is
+// due now, with its deadline five days away.
+type schedulerFixture struct {
+	now       time.Time
+	schedule  model.ReportSchedule
+	reports   *inMemoryRepo
+	schedules *inMemoryScheduleRepo
+	runs      *inMemoryRunRepo
+	publisher *mockEventPublisher
+}
+
+func newSchedulerFixture(t *testing.T) schedulerFixture {
+	t.Helper()
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	deadlineDays := int(now.Sub(monthStart).Hours()/24) + 5
+
+	schedule, err := model.NewReportSchedule(uuid.New(), valueobject.ReportTypeFINREP, valueobject.ReportingFrequencyMonthly,
+		deadlineDays, deadlineDays, now.AddDate(-1, 0, 0))
+	require.NoError(t, err)
+
+	f := schedulerFixture{
+		now:       now,
+		schedule:  schedule,
+		reports:   newInMemoryRepo(),
+		schedules: newInMemoryScheduleRepo(),
+		runs:      newInMemoryRunRepo(),
+		publisher: &mockEventPublisher{},
+	}
+	f.schedules.schedules[schedule.ID()] = schedule
+	return f
+}
+
+func (f schedulerFixture) useCase(ledger port.LedgerDataClient) *usecase.RunReportSchedulesUseCase {
+	generate := usecase.NewGenerateReportUseCase(f.reports, f.publisher, ledger, service.NewXBRLGenerator(), service.NewReportRenderer(), nil)
+	return usecase.NewRunReportSchedulesUseCase(f.schedules, f.runs, f.reports, generate, f.publisher, 2)
+}
+
+func TestRunReportSchedules_GeneratesDraftAndTracksSubmission(t *testing.T) {
+	f := newSchedulerFixture(t)
+	uc := f.useCase(&mockLedgerClient{})
+	ctx := context.Background()
+
+	result, err := uc.Execute(ctx, f.now)
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunReportSchedulesResult{Generated: 1}, result)
+
+	run := f.runs.only(t)
+	assert.Equal(t, valueobject.RunStatusGenerated, run.Status())
+	monthStart := time.Date(f.now.Year(), f.now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, monthStart.AddDate(0, -1, 0).Format("2006-01"), run.ReportingPeriod())
+	require.NotNil(t, run.ReportID())
+	report, err := f.reports.FindByID(ctx, *run.ReportID())
+	require.NoError(t, err)
+	assert.Equal(t, "FINREP", report.ReportType().String())
+
+	// A second pass does not generate the period again.
+	result, err = uc.Execute(ctx, f.now)
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunReportSchedulesResult{}, result)
+
+	approved, err := report.Approve("checker", f.now)
+	require.NoError(t, err)
+	submitted, err := approved.Submit(valueobject.SubmissionChannelManual, "MANUAL-1", f.now)
+	require.NoError(t, err)
+	require.NoError(t, f.reports.Save(ctx, submitted))
+
+	result, err = uc.Execute(ctx, f.now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunReportSchedulesResult{Submitted: 1}, result)
+	assert.True(t, f.runs.only(t).SLAMet())
+
+	runs, err := usecase.NewListScheduledRunsUseCase(f.runs).Execute(ctx, dto.ListScheduledRunsRequest{
+		TenantID: f.schedule.TenantID(),
+		Since:    f.now.AddDate(0, -2, 0),
+	})
+	require.NoError(t, err)
+	require.Len(t, runs.Runs, 1)
+	assert.Equal(t, dto.DeadlineSLASummary{Met: 1}, runs.SLA)
+}
+
+func TestRunReportSchedules_RetriesFailuresAndAlertsOnMissedDeadline(t *testing.T) {
+	f := newSchedulerFixture(t)
+	uc := f.useCase(&unbalancedLedgerClient{})
+	ctx := context.Background()
+
+	result, err := uc.Execute(ctx, f.now)
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunReportSchedulesResult{Failed: 1}, result)
+
+	result, err = uc.Execute(ctx, f.now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunReportSchedulesResult{Failed: 1}, result)
+	run := f.runs.only(t)
+	assert.Equal(t, 2, run.Attempts())
+	assert.Contains(t, run.FailureReason(), "do not balance")
+
+	// Attempts are exhausted; the run is flagged once its deadline passes.
+	result, err = uc.Execute(ctx, f.now.Add(time.Hour*2))
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunReportSchedulesResult{}, result)
+
+	result, err = uc.Execute(ctx, f.now.AddDate(0, 0, 6))
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunReportSchedulesResult{Missed: 1}, result)
+	assert.Equal(t, valueobject.RunStatusMissed, f.runs.only(t).Status())
+
+	var failed, missed int
+	for _, e := range f.publisher.publishedEvents {
+		switch e.(type) {
+		case event.ScheduledReportFailed:
+			failed++
+		case event.ReportDeadlineMissed:
+			missed++
+		}
+	}
+	assert.Equal(t, 2, failed)
+	assert.Equal(t, 1, missed)
+}
+
+func TestUpsertReportSchedule(t *testing.T) {
+	schedules := newInMemoryScheduleRepo()
+	uc := usecase.NewUpsertReportScheduleUseCase(schedules)
+	ctx := context.Background()
+	tenantID := uuid.New()
+
+	created, err := uc.Execute(ctx, dto.UpsertReportScheduleRequest{
+		TenantID: tenantID, ReportType: "COREP", Frequency: "QUARTERLY", DeadlineDays: 42, LeadDays: 10, Active: true,
+	})
+	require.NoError(t, err)
+	assert.True(t, created.Active)
+
+	updated, err := uc.Execute(ctx, dto.UpsertReportScheduleRequest{
+		TenantID: tenantID, ReportType: "COREP", Frequency: "QUARTERLY", DeadlineDays: 42, LeadDays: 15, Active: false,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, updated.ID)
+	assert.Equal(t, 15, updated.LeadDays)
+	assert.False(t, updated.Active)
+	assert.Len(t, schedules.schedules, 1)
+
+	_, err = uc.Execute(ctx, dto.UpsertReportScheduleRequest{
+		TenantID: tenantID, ReportType: "COREP", Frequency: "WEEKLY", DeadlineDays: 42, LeadDays: 10,
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidSchedule)
+}
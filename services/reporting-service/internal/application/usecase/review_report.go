@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+var (
+	// ErrInvalidReview is returned when a review has an unknown decision or
+	// requests changes without saying what to change.
+	ErrInvalidReview = errors.New("invalid report review")
+	// ErrReviewNotAllowed is returned when the report is not awaiting review,
+	// or not awaiting revision.
+	ErrReviewNotAllowed = errors.New("report is not awaiting review")
+	// ErrSelfApproval is returned when the user who prepared a report tries
+	// to approve it.
+	ErrSelfApproval = errors.New("report cannot be approved by its preparer")
+)
+
+// ReviewReportUseCase records a compliance reviewer's sign-off on a generated
+// report, or sends it back for changes. A report is only submitted once it
+// has been approved by someone other than the user who prepared it.
+type ReviewReportUseCase struct {
+	repo           port.ReportSubmissionRepository
+	reviews        port.ReportReviewRepository
+	eventPublisher port.EventPublisher
+}
+
+// NewReviewReportUseCase creates a new ReviewReportUseCase.
+func NewReviewReportUseCase(
+	repo port.ReportSubmissionRepository,
+	reviews port.ReportReviewRepository,
+	eventPublisher port.EventPublisher,
+) *ReviewReportUseCase {
+	return &ReviewReportUseCase{
+		repo:           repo,
+		reviews:        reviews,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// Execute records the review and moves the report to APPROVED or
+// CHANGES_REQUESTED.
+func (uc *ReviewReportUseCase) Execute(ctx context.Context, req dto.ReviewReportRequest) (dto.ReportReviewResponse, error) {
+	decision := strings.ToUpper(req.Decision)
+	comment := strings.TrimSpace(req.Comment)
+	switch decision {
+	case model.ReviewDecisionApproved:
+	case model.ReviewDecisionChangesRequested:
+		if comment == "" {
+			return dto.ReportReviewResponse{}, fmt.Errorf("%w: a comment is required when requesting changes", ErrInvalidReview)
+		}
+	default:
+		return dto.ReportReviewResponse{}, fmt.Errorf("%w: unknown decision %q", ErrInvalidReview, req.Decision)
+	}
+
+	submission, err := uc.repo.FindByID(ctx, req.ID)
+	if err != nil {
+		return dto.ReportReviewResponse{}, fmt.Errorf("failed to find report submission: %w", err)
+	}
+	if !submission.Status().Equal(valueobject.SubmissionStatusReady) {
+		return dto.ReportReviewResponse{}, fmt.Errorf("%w: current status is %s", ErrReviewNotAllowed, submission.Status())
+	}
+	if decision == model.ReviewDecisionApproved && req.Reviewer == submission.PreparedBy() {
+		return dto.ReportReviewResponse{}, ErrSelfApproval
+	}
+
+	// The review is taken on the content as generated, before it changes status.
+	now := time.Now().UTC()
+	review, err := model.NewReportReview(submission, decision, req.Reviewer, comment, now)
+	if err != nil {
+		return dto.ReportReviewResponse{}, fmt.Errorf("%w: %w", ErrInvalidReview, err)
+	}
+	if decision == model.ReviewDecisionApproved {
+		submission, err = submission.Approve(req.Reviewer, now)
+	} else {
+		submission, err = submission.RequestChanges(req.Reviewer, comment, now)
+	}
+	if err != nil {
+		return dto.ReportReviewResponse{}, fmt.Errorf("failed to review report: %w", err)
+	}
+
+	if err := uc.repo.Save(ctx, submission); err != nil {
+		return dto.ReportReviewResponse{}, fmt.Errorf("failed to save report submission: %w", err)
+	}
+	if err := uc.reviews.Append(ctx, review); err != nil {
+		return dto.ReportReviewResponse{}, fmt.Errorf("failed to record report review: %w", err)
+	}
+	if events := submission.DomainEvents(); len(events) > 0 {
+		if err := uc.eventPublisher.Publish(ctx, events...); err != nil {
+			return dto.ReportReviewResponse{}, fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+
+	resp := toReportReviewResponse(review)
+	resp.Status = submission.Status().String()
+	return resp, nil
+}
+
+// ReviseReportUseCase regenerates a report its reviewer sent back, from the
+// ledger's current figures, returning it for review.
+type ReviseReportUseCase struct {
+	repo           port.ReportSubmissionRepository
+	generateReport *GenerateReportUseCase
+	eventPublisher port.EventPublisher
+}
+
+// NewReviseReportUseCase creates a new ReviseReportUseCase.
+func NewReviseReportUseCase(
+	repo port.ReportSubmissionRepository,
+	generateReport *GenerateReportUseCase,
+	eventPublisher port.EventPublisher,
+) *ReviseReportUseCase {
+	return &ReviseReportUseCase{
+		repo:           repo,
+		generateReport: generateReport,
+		eventPublisher: eventPublisher,
+	}
+}
+
+// Execute regenerates the report. The report keeps its ID, so its reviews
+// stay together; the user revising it becomes its preparer.
+func (uc *ReviseReportUseCase) Execute(ctx context.Context, req dto.ReviseReportRequest) (dto.GetReportResponse, error) {
+	submission, err := uc.repo.FindByID(ctx, req.ID)
+	if err != nil {
+		return dto.GetReportResponse{}, fmt.Errorf("failed to find report submission: %w", err)
+	}
+	if !submission.Status().Equal(valueobject.SubmissionStatusChangesRequested) {
+		return dto.GetReportResponse{}, fmt.Errorf("%w: current status is %s, expected CHANGES_REQUESTED", ErrReviewNotAllowed, submission.Status())
+	}
+
+	submission, err = submission.Revise(time.Now().UTC())
+	if err != nil {
+		return dto.GetReportResponse{}, fmt.Errorf("failed to revise report: %w", err)
+	}
+	submission = submission.SetPreparer(req.Actor)
+	submission, _, err = uc.generateReport.generate(ctx, submission, noProgress)
+	if err != nil {
+		return dto.GetReportResponse{}, err
+	}
+
+	if err := uc.repo.Save(ctx, submission); err != nil {
+		return dto.GetReportResponse{}, fmt.Errorf("failed to save report submission: %w", err)
+	}
+	if events := submission.DomainEvents(); len(events) > 0 {
+		if err := uc.eventPublisher.Publish(ctx, events...); err != nil {
+			return dto.GetReportResponse{}, fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+
+	return dto.GetReportResponse{
+		ID:              submission.ID(),
+		TenantID:        submission.TenantID(),
+		ReportType:      submission.ReportType().String(),
+		ReportingPeriod: submission.ReportingPeriod(),
+		Status:          submission.Status().String(),
+		GeneratedAt:     submission.GeneratedAt(),
+		PreparedBy:      submission.PreparedBy(),
+		Revision:        submission.Revision(),
+		OriginalID:      submission.OriginalID(),
+		AmendsID:        submission.AmendsID(),
+		Version:         submission.Version(),
+		CreatedAt:       submission.CreatedAt(),
+		UpdatedAt:       submission.UpdatedAt(),
+	}, nil
+}
+
+// ListReportReviewsUseCase lists a report's compliance reviews.
+type ListReportReviewsUseCase struct {
+	reviews port.ReportReviewRepository
+}
+
+// NewListReportReviewsUseCase creates a new ListReportReviewsUseCase.
+func NewListReportReviewsUseCase(reviews port.ReportReviewRepository) *ListReportReviewsUseCase {
+	return &ListReportReviewsUseCase{reviews: reviews}
+}
+
+// Execute lists the report's reviews, oldest first.
+func (uc *ListReportReviewsUseCase) Execute(ctx context.Context, submissionID uuid.UUID) ([]dto.ReportReviewResponse, error) {
+	reviews, err := uc.reviews.ListBySubmission(ctx, submissionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list report reviews: %w", err)
+	}
+	resp := make([]dto.ReportReviewResponse, 0, len(reviews))
+	for _, r := range reviews {
+		resp = append(resp, toReportReviewResponse(r))
+	}
+	return resp, nil
+}
+
+func toReportReviewResponse(r model.ReportReview) dto.ReportReviewResponse {
+	return dto.ReportReviewResponse{
+		ID:            r.ID(),
+		ReportID:      r.SubmissionID(),
+		Decision:      r.Decision(),
+		Reviewer:      r.Reviewer(),
+		Comment:       r.Comment(),
+		ContentSHA256: r.ContentSHA256(),
+		ReviewedAt:    r.ReviewedAt(),
+	}
+}
@@ -0,0 +1,102 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+type inMemoryReviewRepo struct {
+	reviews []model.ReportReview
+}
+
+func (r *inMemoryReviewRepo) Append(_ context.Context, review model.ReportReview) error {
+	r.reviews = append(r.reviews, review)
+	return nil
+}
+
+func (r *inMemoryReviewRepo) ListBySubmission(_ context.Context, submissionID uuid.UUID) ([]model.ReportReview, error) {
+	var result []model.ReportReview
+	for _, rv := range r.reviews {
+		if rv.SubmissionID() == submissionID {
+			result = append(result, rv)
+		}
+	}
+	return result, nil
+}
+
+func TestReviewReportUseCase_ChangesRequestedThenApproved(t *testing.T) {
+	ctx := context.Background()
+	repo := newInMemoryRepo()
+	reviews := &inMemoryReviewRepo{}
+	publisher := &mockEventPublisher{}
+	generate := usecase.NewGenerateReportUseCase(repo, publisher, &mockLedgerClient{},
+		service.NewXBRLGenerator(), service.NewReportRenderer(), nil)
+	review := usecase.NewReviewReportUseCase(repo, reviews, publisher)
+	revise := usecase.NewReviseReportUseCase(repo, generate, publisher)
+	submit := usecase.NewSubmitReportUseCase(repo, &inMemoryAuditRepo{}, &fakeGateway{}, publisher)
+
+	report, err := generate.Execute(ctx, dto.GenerateReportRequest{
+		TenantID: uuid.New(), ReportType: "COREP", Period: "2025-Q1", Actor: "maker",
+	})
+	require.NoError(t, err)
+
+	_, err = submit.Execute(ctx, dto.SubmitReportRequest{ID: report.ID, Actor: "maker"})
+	assert.ErrorIs(t, err, usecase.ErrApprovalRequired)
+
+	_, err = review.Execute(ctx, dto.ReviewReportRequest{ID: report.ID, Decision: "APPROVED", Reviewer: "maker"})
+	assert.ErrorIs(t, err, usecase.ErrSelfApproval)
+	_, err = review.Execute(ctx, dto.ReviewReportRequest{ID: report.ID, Decision: "CHANGES_REQUESTED", Reviewer: "checker"})
+	assert.ErrorIs(t, err, usecase.ErrInvalidReview, "a comment is required")
+	_, err = review.Execute(ctx, dto.ReviewReportRequest{ID: report.ID, Decision: "MAYBE", Reviewer: "checker"})
+	assert.ErrorIs(t, err, usecase.ErrInvalidReview)
+
+	resp, err := review.Execute(ctx, dto.ReviewReportRequest{
+		ID: report.ID, Decision: "changes_requested", Reviewer: "checker", Comment: "Use the restated Q1 figures",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "CHANGES_REQUESTED", resp.Status)
+	assert.NotEmpty(t, resp.ContentSHA256)
+
+	_, err = review.Execute(ctx, dto.ReviewReportRequest{ID: report.ID, Decision: "APPROVED", Reviewer: "checker"})
+	assert.ErrorIs(t, err, usecase.ErrReviewNotAllowed, "the report must be revised first")
+
+	revised, err := revise.Execute(ctx, dto.ReviseReportRequest{ID: report.ID, Actor: "maker"})
+	require.NoError(t, err)
+	assert.Equal(t, report.ID, revised.ID)
+	assert.Equal(t, "READY", revised.Status)
+	assert.Equal(t, "maker", revised.PreparedBy)
+
+	_, err = revise.Execute(ctx, dto.ReviseReportRequest{ID: report.ID, Actor: "maker"})
+	assert.ErrorIs(t, err, usecase.ErrReviewNotAllowed)
+
+	resp, err = review.Execute(ctx, dto.ReviewReportRequest{ID: report.ID, Decision: "APPROVED", Reviewer: "checker", Comment: "ok"})
+	require.NoError(t, err)
+	assert.Equal(t, "APPROVED", resp.Status)
+
+	got, err := usecase.NewGetReportUseCase(repo).Execute(ctx, dto.GetReportRequest{ID: report.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "maker", got.PreparedBy)
+	assert.Equal(t, "checker", got.ApprovedBy)
+	assert.NotNil(t, got.ApprovedAt)
+
+	history, err := usecase.NewListReportReviewsUseCase(reviews).Execute(ctx, report.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "CHANGES_REQUESTED", history[0].Decision)
+	assert.Equal(t, "Use the restated Q1 figures", history[0].Comment)
+	assert.Equal(t, "APPROVED", history[1].Decision)
+	assert.Equal(t, "checker", history[1].Reviewer)
+
+	submitted, err := submit.Execute(ctx, dto.SubmitReportRequest{ID: report.ID, Actor: "maker"})
+	require.NoError(t, err)
+	assert.Equal(t, "SUBMITTED", submitted.Status)
+}
@@ -2,41 +2,104 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
 	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
 )
 
+// ErrTransmissionFailed is returned when the submission gateway could not
+// hand a filing to the regulator. The report stays unsubmitted and the
+// attempt is recorded in the audit trail.
+var ErrTransmissionFailed = errors.New("report transmission failed")
+
+// ErrApprovalRequired is returned when a report is submitted before a
+// compliance reviewer has approved it.
+var ErrApprovalRequired = errors.New("report requires compliance approval")
+
 // SubmitReportUseCase orchestrates the submission of a generated report to the regulator.
 type SubmitReportUseCase struct {
 	repo           port.ReportSubmissionRepository
+	audit          port.SubmissionAuditRepository
+	gateway        port.SubmissionGateway
 	eventPublisher port.EventPublisher
 }
 
 // NewSubmitReportUseCase creates a new SubmitReportUseCase.
 func NewSubmitReportUseCase(
 	repo port.ReportSubmissionRepository,
+	audit port.SubmissionAuditRepository,
+	gateway port.SubmissionGateway,
 	eventPublisher port.EventPublisher,
 ) *SubmitReportUseCase {
 	return &SubmitReportUseCase{
 		repo:           repo,
+		audit:          audit,
+		gateway:        gateway,
 		eventPublisher: eventPublisher,
 	}
 }
 
-// Execute submits a report to the regulatory authority.
+// Execute files a report with the regulatory authority through the configured
+// submission gateway. Only approved reports are filed; rejected reports may be
+// resubmitted.
 func (uc *SubmitReportUseCase) Execute(ctx context.Context, req dto.SubmitReportRequest) (dto.SubmitReportResponse, error) {
 	// Retrieve the submission.
 	submission, err := uc.repo.FindByID(ctx, req.ID)
 	if err != nil {
 		return dto.SubmitReportResponse{}, fmt.Errorf("failed to find report submission: %w", err)
 	}
+	if err := submission.CanSubmit(); err != nil {
+		if submission.ApprovedAt() == nil {
+			return dto.SubmitReportResponse{}, fmt.Errorf("%w: %w", ErrApprovalRequired, err)
+		}
+		return dto.SubmitReportResponse{}, fmt.Errorf("failed to submit report: %w", err)
+	}
 
-	// Submit.
+	// Transmit.
+	channel := uc.gateway.Channel()
+	attempt := submission.Attempts() + 1
+	reference, txErr := uc.gateway.Transmit(ctx, port.SubmissionPackage{
+		SubmissionID:    submission.ID(),
+		TenantID:        submission.TenantID(),
+		ReportType:      submission.ReportType().String(),
+		ReportingPeriod: submission.ReportingPeriod(),
+		FileName: fmt.Sprintf("%s-%s-%s-%d.%s",
+			strings.ToLower(submission.ReportType().String()), submission.ReportingPeriod(), submission.ID(), attempt,
+			submission.ReportType().FileExtension()),
+		Content: []byte(submission.XBRLContent()),
+		Attempt: attempt,
+	})
 	now := time.Now().UTC()
-	submission, err = submission.Submit(now)
+	if txErr != nil {
+		txErr = fmt.Errorf("%w: %w", ErrTransmissionFailed, txErr)
+		entry, err := model.NewSubmissionAuditEntry(submission, channel, attempt,
+			model.AuditActionTransmissionFailed, "", txErr.Error(), req.Actor, now)
+		if err == nil {
+			err = uc.audit.Append(ctx, entry)
+		}
+		if err != nil {
+			return dto.SubmitReportResponse{}, errors.Join(txErr, fmt.Errorf("failed to audit transmission: %w", err))
+		}
+		return dto.SubmitReportResponse{}, txErr
+	}
+
+	// The filing has left the building: audit it before anything else can fail.
+	entry, err := model.NewSubmissionAuditEntry(submission, channel, attempt,
+		model.AuditActionTransmitted, reference, "", req.Actor, now)
+	if err != nil {
+		return dto.SubmitReportResponse{}, fmt.Errorf("failed to audit transmission: %w", err)
+	}
+	if err := uc.audit.Append(ctx, entry); err != nil {
+		return dto.SubmitReportResponse{}, fmt.Errorf("failed to audit transmission: %w", err)
+	}
+
+	// Submit.
+	submission, err = submission.Submit(channel, reference, now)
 	if err != nil {
 		return dto.SubmitReportResponse{}, fmt.Errorf("failed to submit report: %w", err)
 	}
@@ -53,14 +116,12 @@ func (uc *SubmitReportUseCase) Execute(ctx context.Context, req dto.SubmitReport
 		}
 	}
 
-	submittedAt := ""
-	if submission.SubmittedAt() != nil {
-		submittedAt = submission.SubmittedAt().Format(time.RFC3339)
-	}
-
 	return dto.SubmitReportResponse{
 		ID:          submission.ID(),
 		Status:      submission.Status().String(),
-		SubmittedAt: submittedAt,
+		SubmittedAt: submission.SubmittedAt(),
+		Channel:     submission.Channel().String(),
+		Reference:   submission.Reference(),
+		Attempt:     submission.Attempts(),
 	}, nil
 }
@@ -0,0 +1,233 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/event"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+type inMemoryAuditRepo struct {
+	entries []model.SubmissionAuditEntry
+}
+
+func (r *inMemoryAuditRepo) Append(_ context.Context, entry model.SubmissionAuditEntry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *inMemoryAuditRepo) ListBySubmission(_ context.Context, submissionID uuid.UUID) ([]model.SubmissionAuditEntry, error) {
+	var result []model.SubmissionAuditEntry
+	for _, e := range r.entries {
+		if e.SubmissionID() == submissionID {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+func (r *inMemoryAuditRepo) actions() []string {
+	actions := make([]string, 0, len(r.entries))
+	for _, e := range r.entries {
+		actions = append(actions, e.Action())
+	}
+	return actions
+}
+
+// fakeGateway transmits over the API channel and serves queued acknowledgments.
+type fakeGateway struct {
+	transmitErr error
+	sent        []port.SubmissionPackage
+	acks        []port.Acknowledgment
+}
+
+func (g *fakeGateway) Channel() valueobject.SubmissionChannel {
+	return valueobject.SubmissionChannelAPI
+}
+
+func (g *fakeGateway) Transmit(_ context.Context, pkg port.SubmissionPackage) (string, error) {
+	if g.transmitErr != nil {
+		return "", g.transmitErr
+	}
+	g.sent = append(g.sent, pkg)
+	return fmt.Sprintf("EBA-%d", len(g.sent)), nil
+}
+
+func (g *fakeGateway) PollAcknowledgments(_ context.Context, references []string) ([]port.Acknowledgment, error) {
+	wanted := make(map[string]bool, len(references))
+	for _, ref := range references {
+		wanted[ref] = true
+	}
+	var acks []port.Acknowledgment
+	for _, ack := range g.acks {
+		if wanted[ack.Reference] {
+			acks = append(acks, ack)
+		}
+	}
+	return acks, nil
+}
+
+type submissionFixture struct {
+	repo      *inMemoryRepo
+	audit     *inMemoryAuditRepo
+	gateway   *fakeGateway
+	publisher *mockEventPublisher
+	reportID  uuid.UUID
+}
+
+func newSubmissionFixture(t *testing.T) submissionFixture {
+	t.Helper()
+	f := submissionFixture{
+		repo:      newInMemoryRepo(),
+		audit:     &inMemoryAuditRepo{},
+		gateway:   &fakeGateway{},
+		publisher: &mockEventPublisher{},
+	}
+	generate := usecase.NewGenerateReportUseCase(f.repo, f.publisher, &mockLedgerClient{},
+		service.NewXBRLGenerator(), service.NewReportRenderer(), nil)
+	report, err := generate.Execute(context.Background(), dto.GenerateReportRequest{
+		TenantID:   uuid.New(),
+		ReportType: "COREP",
+		Period:     "2025-Q1",
+		Actor:      "maker",
+	})
+	require.NoError(t, err)
+	f.reportID = report.ID
+	_, err = usecase.NewReviewReportUseCase(f.repo, &inMemoryReviewRepo{}, f.publisher).Execute(context.Background(),
+		dto.ReviewReportRequest{ID: report.ID, Decision: "APPROVED", Reviewer: "checker"})
+	require.NoError(t, err)
+	f.publisher.publishedEvents = nil
+	return f
+}
+
+func (f submissionFixture) submit() (dto.SubmitReportResponse, error) {
+	uc := usecase.NewSubmitReportUseCase(f.repo, f.audit, f.gateway, f.publisher)
+	return uc.Execute(context.Background(), dto.SubmitReportRequest{ID: f.reportID, Actor: "user-1"})
+}
+
+func (f submissionFixture) ingest() (dto.IngestAcknowledgmentsResult, error) {
+	uc := usecase.NewIngestAcknowledgmentsUseCase(f.repo, f.audit, f.gateway, f.publisher)
+	return uc.Execute(context.Background())
+}
+
+func TestSubmitReportUseCase_TransmitsAndAudits(t *testing.T) {
+	f := newSubmissionFixture(t)
+
+	resp, err := f.submit()
+	require.NoError(t, err)
+	assert.Equal(t, "SUBMITTED", resp.Status)
+	assert.Equal(t, "API", resp.Channel)
+	assert.Equal(t, "EBA-1", resp.Reference)
+	assert.Equal(t, 1, resp.Attempt)
+
+	require.Len(t, f.gateway.sent, 1)
+	sent := f.gateway.sent[0]
+	assert.Equal(t, fmt.Sprintf("corep-2025-Q1-%s-1.xbrl", f.reportID), sent.FileName)
+	assert.Contains(t, string(sent.Content), "corep:")
+
+	require.Len(t, f.audit.entries, 1)
+	entry := f.audit.entries[0]
+	assert.Equal(t, model.AuditActionTransmitted, entry.Action())
+	assert.Equal(t, "EBA-1", entry.Reference())
+	assert.Equal(t, "user-1", entry.Actor())
+	assert.Len(t, entry.ContentSHA256(), 64)
+
+	require.Len(t, f.publisher.publishedEvents, 1)
+	submitted, ok := f.publisher.publishedEvents[0].(event.ReportSubmitted)
+	require.True(t, ok)
+	assert.Equal(t, "EBA-1", submitted.Reference)
+}
+
+func TestSubmitReportUseCase_TransmissionFailureIsAuditedAndRetryable(t *testing.T) {
+	f := newSubmissionFixture(t)
+	f.gateway.transmitErr = errors.New("connection refused")
+
+	_, err := f.submit()
+	require.ErrorIs(t, err, usecase.ErrTransmissionFailed)
+	assert.Equal(t, []string{model.AuditActionTransmissionFailed}, f.audit.actions())
+	assert.Contains(t, f.audit.entries[0].Detail(), "connection refused")
+	assert.Empty(t, f.publisher.publishedEvents)
+
+	saved, err := f.repo.FindByID(context.Background(), f.reportID)
+	require.NoError(t, err)
+	assert.Equal(t, "APPROVED", saved.Status().String())
+
+	f.gateway.transmitErr = nil
+	resp, err := f.submit()
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Attempt)
+}
+
+func TestIngestAcknowledgments_NACKThenResubmissionAccepted(t *testing.T) {
+	f := newSubmissionFixture(t)
+	ctx := context.Background()
+
+	_, err := f.submit()
+	require.NoError(t, err)
+
+	f.gateway.acks = []port.Acknowledgment{{
+		Reference: "EBA-1", Errors: []string{"v1234: CET1 ratio missing"}, ReceivedAt: time.Now().UTC(),
+	}}
+	result, err := f.ingest()
+	require.NoError(t, err)
+	assert.Equal(t, dto.IngestAcknowledgmentsResult{Rejected: 1}, result)
+
+	saved, err := f.repo.FindByID(ctx, f.reportID)
+	require.NoError(t, err)
+	assert.Equal(t, "REJECTED", saved.Status().String())
+	assert.Equal(t, []string{"v1234: CET1 ratio missing"}, saved.ValidationErrors())
+
+	// Nothing is outstanding until the report is resubmitted.
+	result, err = f.ingest()
+	require.NoError(t, err)
+	assert.Equal(t, dto.IngestAcknowledgmentsResult{}, result)
+
+	resp, err := f.submit()
+	require.NoError(t, err)
+	assert.Equal(t, "EBA-2", resp.Reference)
+	assert.Equal(t, 2, resp.Attempt)
+
+	f.gateway.acks = append(f.gateway.acks, port.Acknowledgment{Reference: "EBA-2", Accepted: true, ReceivedAt: time.Now().UTC()})
+	result, err = f.ingest()
+	require.NoError(t, err)
+	assert.Equal(t, dto.IngestAcknowledgmentsResult{Accepted: 1}, result)
+
+	trail, err := usecase.NewListSubmissionAuditUseCase(f.audit).Execute(ctx, f.reportID)
+	require.NoError(t, err)
+	require.Len(t, trail, 4)
+	assert.Equal(t, []string{
+		model.AuditActionTransmitted, model.AuditActionRejected,
+		model.AuditActionTransmitted, model.AuditActionAccepted,
+	}, f.audit.actions())
+	assert.Equal(t, 2, trail[3].Attempt)
+	assert.Equal(t, "system:ack-ingest", trail[3].Actor)
+}
+
+func TestRecordAcknowledgmentUseCase(t *testing.T) {
+	f := newSubmissionFixture(t)
+	ctx := context.Background()
+	_, err := f.submit()
+	require.NoError(t, err)
+	uc := usecase.NewRecordAcknowledgmentUseCase(f.repo, f.audit, f.publisher)
+
+	_, err = uc.Execute(ctx, dto.RecordAcknowledgmentRequest{ID: f.reportID, Reference: "EBA-0", Accepted: true})
+	require.ErrorIs(t, err, usecase.ErrStaleAcknowledgment)
+
+	resp, err := uc.Execute(ctx, dto.RecordAcknowledgmentRequest{ID: f.reportID, Accepted: true, Actor: "user-2"})
+	require.NoError(t, err)
+	assert.Equal(t, "ACCEPTED", resp.Status)
+	assert.Equal(t, "user-2", f.audit.entries[len(f.audit.entries)-1].Actor())
+}
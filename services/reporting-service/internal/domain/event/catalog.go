@@ -0,0 +1,21 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[ReportGenerated]("report.generated", 1, "Emitted when a report's XBRL content has been generated."),
+		events.Declare[ReportSubmitted]("report.submitted", 1, "Emitted when a report has been submitted to a regulatory authority."),
+		events.Declare[ReportAccepted]("report.accepted", 1, "Emitted when a submitted report has been accepted by the regulator."),
+		events.Declare[ReportRejected]("report.rejected", 1, "Emitted when a submitted report has been rejected by the regulator."),
+		events.Declare[ReportApproved]("report.approved", 1, "Emitted when a compliance reviewer signs a report off for submission."),
+		events.Declare[ReportChangesRequested]("report.changes_requested", 1, "Emitted when a compliance reviewer sends a report back to be revised."),
+		events.Declare[ReportAmended]("report.amended", 1, "Emitted when an amended version of a filed report is generated."),
+		events.Declare[ScheduledReportFailed]("report.schedule.run_failed", 1, "Emitted as an alert when a scheduled report run fails to generate its draft."),
+		events.Declare[ReportDeadlineMissed]("report.deadline.missed", 1, "Emitted as an alert when a scheduled report has not been submitted by its regulatory deadline."),
+		events.Declare[ReportJobCompleted]("report.job.completed", 1, "Emitted when an asynchronous report generation job finishes, successfully or not."),
+		events.Declare[ConsistencyCheckFailed]("consistency.check.failed", 1, "Emitted as an alert when the consistency checker finds money-movement invariants violated for a tenant."),
+	}
+}
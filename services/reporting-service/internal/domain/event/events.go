@@ -31,13 +31,19 @@ type ReportSubmitted struct {
 	events.BaseEvent
 	ReportType      string `json:"report_type"`
 	ReportingPeriod string `json:"reporting_period"`
+	Channel         string `json:"channel"`
+	Reference       string `json:"reference"`
+	Attempt         int    `json:"attempt"`
 }
 
-func NewReportSubmitted(id, tenantID uuid.UUID, reportType, reportingPeriod string, _ time.Time) ReportSubmitted {
+func NewReportSubmitted(id, tenantID uuid.UUID, reportType, reportingPeriod, channel, reference string, attempt int, _ time.Time) ReportSubmitted {
 	return ReportSubmitted{
 		BaseEvent:       events.NewBaseEvent("report.submitted", id.String(), "ReportSubmission", tenantID.String()),
 		ReportType:      reportType,
 		ReportingPeriod: reportingPeriod,
+		Channel:         channel,
+		Reference:       reference,
+		Attempt:         attempt,
 	}
 }
 
@@ -72,3 +78,173 @@ func NewReportRejected(id, tenantID uuid.UUID, reportType, reportingPeriod strin
 		ValidationErrors: validationErrors,
 	}
 }
+
+// ReportApproved is emitted when a compliance reviewer signs a report off for
+// submission.
+type ReportApproved struct {
+	events.BaseEvent
+	ReportType      string `json:"report_type"`
+	ReportingPeriod string `json:"reporting_period"`
+	ApprovedBy      string `json:"approved_by"`
+}
+
+func NewReportApproved(id, tenantID uuid.UUID, reportType, reportingPeriod, approvedBy string, _ time.Time) ReportApproved {
+	return ReportApproved{
+		BaseEvent:       events.NewBaseEvent("report.approved", id.String(), "ReportSubmission", tenantID.String()),
+		ReportType:      reportType,
+		ReportingPeriod: reportingPeriod,
+		ApprovedBy:      approvedBy,
+	}
+}
+
+// ReportChangesRequested is emitted when a compliance reviewer sends a report
+// back to be revised.
+type ReportChangesRequested struct {
+	events.BaseEvent
+	ReportType      string `json:"report_type"`
+	ReportingPeriod string `json:"reporting_period"`
+	ReviewedBy      string `json:"reviewed_by"`
+	Comment         string `json:"comment"`
+}
+
+func NewReportChangesRequested(id, tenantID uuid.UUID, reportType, reportingPeriod, reviewedBy, comment string, _ time.Time) ReportChangesRequested {
+	return ReportChangesRequested{
+		BaseEvent:       events.NewBaseEvent("report.changes_requested", id.String(), "ReportSubmission", tenantID.String()),
+		ReportType:      reportType,
+		ReportingPeriod: reportingPeriod,
+		ReviewedBy:      reviewedBy,
+		Comment:         comment,
+	}
+}
+
+// ReportAmended is emitted when an amended version of a filed report is
+// generated.
+type ReportAmended struct {
+	events.BaseEvent
+	ReportType      string `json:"report_type"`
+	ReportingPeriod string `json:"reporting_period"`
+	OriginalID      string `json:"original_id"`
+	AmendsID        string `json:"amends_id"`
+	Revision        int    `json:"revision"`
+	Reason          string `json:"reason"`
+	Note            string `json:"note,omitempty"`
+}
+
+func NewReportAmended(id, tenantID, originalID, amendsID uuid.UUID, reportType, reportingPeriod string, revision int, reason, note string) ReportAmended {
+	return ReportAmended{
+		BaseEvent:       events.NewBaseEvent("report.amended", id.String(), "ReportSubmission", tenantID.String()),
+		ReportType:      reportType,
+		ReportingPeriod: reportingPeriod,
+		OriginalID:      originalID.String(),
+		AmendsID:        amendsID.String(),
+		Revision:        revision,
+		Reason:          reason,
+		Note:            note,
+	}
+}
+
+// ScheduledReportFailed is emitted as an alert when a scheduled report run
+// fails to generate its draft.
+type ScheduledReportFailed struct {
+	events.BaseEvent
+	ScheduleID      string `json:"schedule_id"`
+	ReportType      string `json:"report_type"`
+	ReportingPeriod string `json:"reporting_period"`
+	Deadline        string `json:"deadline"`
+	Reason          string `json:"reason"`
+	Attempts        int    `json:"attempts"`
+}
+
+func NewScheduledReportFailed(runID, tenantID, scheduleID uuid.UUID, reportType, reportingPeriod string, deadline time.Time, reason string, attempts int) ScheduledReportFailed {
+	return ScheduledReportFailed{
+		BaseEvent:       events.NewBaseEvent("report.schedule.run_failed", runID.String(), "ScheduledRun", tenantID.String()),
+		ScheduleID:      scheduleID.String(),
+		ReportType:      reportType,
+		ReportingPeriod: reportingPeriod,
+		Deadline:        deadline.Format(time.RFC3339),
+		Reason:          reason,
+		Attempts:        attempts,
+	}
+}
+
+// ReportDeadlineMissed is emitted as an alert when a scheduled report has not
+// been submitted by its regulatory deadline.
+type ReportDeadlineMissed struct {
+	events.BaseEvent
+	ScheduleID      string `json:"schedule_id"`
+	ReportID        string `json:"report_id,omitempty"`
+	ReportType      string `json:"report_type"`
+	ReportingPeriod string `json:"reporting_period"`
+	Deadline        string `json:"deadline"`
+}
+
+func NewReportDeadlineMissed(runID, tenantID, scheduleID uuid.UUID, reportID *uuid.UUID, reportType, reportingPeriod string, deadline time.Time) ReportDeadlineMissed {
+	e := ReportDeadlineMissed{
+		BaseEvent:       events.NewBaseEvent("report.deadline.missed", runID.String(), "ScheduledRun", tenantID.String()),
+		ScheduleID:      scheduleID.String(),
+		ReportType:      reportType,
+		ReportingPeriod: reportingPeriod,
+		Deadline:        deadline.Format(time.RFC3339),
+	}
+	if reportID != nil {
+		e.ReportID = reportID.String()
+	}
+	return e
+}
+
+// ReportJobCompleted is emitted when an asynchronous report generation job
+// finishes, successfully or not.
+type ReportJobCompleted struct {
+	events.BaseEvent
+	ReportID        string   `json:"report_id,omitempty"`
+	ReportType      string   `json:"report_type"`
+	ReportingPeriod string   `json:"reporting_period"`
+	Status          string   `json:"status"`
+	FailureDetail   string   `json:"failure_detail,omitempty"`
+	Artifacts       []string `json:"artifacts,omitempty"`
+}
+
+func NewReportJobCompleted(jobID, tenantID uuid.UUID, reportID *uuid.UUID, reportType, reportingPeriod, status, failureDetail string, artifacts []string) ReportJobCompleted {
+	e := ReportJobCompleted{
+		BaseEvent:       events.NewBaseEvent("report.job.completed", jobID.String(), "ReportJob", tenantID.String()),
+		ReportType:      reportType,
+		ReportingPeriod: reportingPeriod,
+		Status:          status,
+		FailureDetail:   failureDetail,
+		Artifacts:       artifacts,
+	}
+	if reportID != nil {
+		e.ReportID = reportID.String()
+	}
+	return e
+}
+
+// ConsistencyDiscrepancy is an invariant violation as carried by a
+// ConsistencyCheckFailed alert.
+type ConsistencyDiscrepancy struct {
+	Invariant string `json:"invariant"`
+	Subject   string `json:"subject"`
+	Currency  string `json:"currency,omitempty"`
+	Expected  string `json:"expected"`
+	Actual    string `json:"actual"`
+	Detail    string `json:"detail"`
+}
+
+// ConsistencyCheckFailed is emitted as an alert when the consistency checker
+// finds money-movement invariants violated for a tenant. It carries the
+// discrepancy report.
+type ConsistencyCheckFailed struct {
+	events.BaseEvent
+	CheckedAt     string                   `json:"checked_at"`
+	Invariants    []string                 `json:"invariants"`
+	Discrepancies []ConsistencyDiscrepancy `json:"discrepancies"`
+}
+
+func NewConsistencyCheckFailed(reportID, tenantID uuid.UUID, checkedAt time.Time, invariants []string, discrepancies []ConsistencyDiscrepancy) ConsistencyCheckFailed {
+	return ConsistencyCheckFailed{
+		BaseEvent:     events.NewBaseEvent("consistency.check.failed", reportID.String(), "ConsistencyReport", tenantID.String()),
+		CheckedAt:     checkedAt.Format(time.RFC3339),
+		Invariants:    invariants,
+		Discrepancies: discrepancies,
+	}
+}
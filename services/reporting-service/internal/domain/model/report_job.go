@@ -0,0 +1,264 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/event"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+// ReportArtifact is a file produced by a report job and kept in object
+// storage under Key.
+type ReportArtifact struct {
+	Name        string
+	ContentType string
+	Key         string
+	SHA256      string
+	SizeBytes   int64
+}
+
+// ReportJob generates a report in the background, for reports too large to
+// generate within a request deadline. Workers claim QUEUED jobs and report
+// their progress as they go; a job that fails after its report was generated
+// keeps the report and the artifacts already stored, and says where it failed.
+type ReportJob struct {
+	createdAt       time.Time
+	updatedAt       time.Time
+	startedAt       *time.Time
+	completedAt     *time.Time
+	reportID        *uuid.UUID
+	reportingPeriod string
+	format          string
+	requestedBy     string
+	stage           string
+	failureDetail   string
+	status          valueobject.JobStatus
+	reportType      valueobject.ReportType
+	artifacts       []ReportArtifact
+	domainEvents    []events.DomainEvent
+	progress        int
+	attempts        int
+	version         int
+	id              uuid.UUID
+	tenantID        uuid.UUID
+}
+
+// NewReportJob queues a job generating the report type for a period. format
+// is the rendering requested, empty for the filing itself.
+func NewReportJob(tenantID uuid.UUID, reportType valueobject.ReportType, period, format, requestedBy string, now time.Time) (ReportJob, error) {
+	if tenantID == uuid.Nil {
+		return ReportJob{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	if reportType.IsZero() {
+		return ReportJob{}, fmt.Errorf("report type must not be empty")
+	}
+	if period == "" {
+		return ReportJob{}, fmt.Errorf("reporting period must not be empty")
+	}
+	return ReportJob{
+		id:              uuid.New(),
+		tenantID:        tenantID,
+		reportType:      reportType,
+		reportingPeriod: period,
+		format:          format,
+		requestedBy:     requestedBy,
+		status:          valueobject.JobStatusQueued,
+		artifacts:       []ReportArtifact{},
+		version:         1,
+		createdAt:       now,
+		updatedAt:       now,
+	}, nil
+}
+
+// ReconstructReportJob recreates a ReportJob from persisted data without emitting events.
+func ReconstructReportJob(
+	id uuid.UUID,
+	tenantID uuid.UUID,
+	reportType valueobject.ReportType,
+	reportingPeriod string,
+	format string,
+	requestedBy string,
+	status valueobject.JobStatus,
+	progress int,
+	stage string,
+	reportID *uuid.UUID,
+	artifacts []ReportArtifact,
+	failureDetail string,
+	attempts int,
+	startedAt *time.Time,
+	completedAt *time.Time,
+	version int,
+	createdAt time.Time,
+	updatedAt time.Time,
+) ReportJob {
+	if artifacts == nil {
+		artifacts = []ReportArtifact{}
+	}
+	return ReportJob{
+		id:              id,
+		tenantID:        tenantID,
+		reportType:      reportType,
+		reportingPeriod: reportingPeriod,
+		format:          format,
+		requestedBy:     requestedBy,
+		status:          status,
+		progress:        progress,
+		stage:           stage,
+		reportID:        reportID,
+		artifacts:       artifacts,
+		failureDetail:   failureDetail,
+		attempts:        attempts,
+		startedAt:       startedAt,
+		completedAt:     completedAt,
+		version:         version,
+		createdAt:       createdAt,
+		updatedAt:       updatedAt,
+	}
+}
+
+// Start transitions the job to RUNNING for a worker. A RUNNING job whose
+// worker stopped reporting progress may be started again by another.
+func (j ReportJob) Start(now time.Time) (ReportJob, error) {
+	if !j.status.Equal(valueobject.JobStatusQueued) && !j.status.Equal(valueobject.JobStatusRunning) {
+		return j, fmt.Errorf("cannot start job: current status is %s, expected QUEUED or RUNNING", j.status)
+	}
+	j.status = valueobject.JobStatusRunning
+	j.attempts++
+	j.progress = 0
+	j.stage = ""
+	j.startedAt = &now
+	j.version++
+	j.updatedAt = now
+	return j, nil
+}
+
+// ReportProgress records the stage the job has reached and its percentage
+// complete. Progress never goes backwards.
+func (j ReportJob) ReportProgress(stage string, percent int, now time.Time) (ReportJob, error) {
+	if !j.status.Equal(valueobject.JobStatusRunning) {
+		return j, fmt.Errorf("cannot report progress: current status is %s, expected RUNNING", j.status)
+	}
+	if percent < j.progress {
+		percent = j.progress
+	}
+	if percent > 100 {
+		percent = 100
+	}
+	j.stage = stage
+	j.progress = percent
+	j.version++
+	j.updatedAt = now
+	return j, nil
+}
+
+// RecordReport records the report submission the job generated.
+func (j ReportJob) RecordReport(reportID uuid.UUID) (ReportJob, error) {
+	if !j.status.Equal(valueobject.JobStatusRunning) {
+		return j, fmt.Errorf("cannot record report: current status is %s, expected RUNNING", j.status)
+	}
+	j.reportID = &reportID
+	return j, nil
+}
+
+// AddArtifact records a file the job stored.
+func (j ReportJob) AddArtifact(artifact ReportArtifact) (ReportJob, error) {
+	if !j.status.Equal(valueobject.JobStatusRunning) {
+		return j, fmt.Errorf("cannot add artifact: current status is %s, expected RUNNING", j.status)
+	}
+	if artifact.Name == "" || artifact.Key == "" {
+		return j, fmt.Errorf("artifact name and key must not be empty")
+	}
+	j.artifacts = append(append([]ReportArtifact{}, j.artifacts...), artifact)
+	return j, nil
+}
+
+// Succeed transitions from RUNNING to SUCCEEDED.
+func (j ReportJob) Succeed(now time.Time) (ReportJob, error) {
+	if !j.status.Equal(valueobject.JobStatusRunning) {
+		return j, fmt.Errorf("cannot complete job: current status is %s, expected RUNNING", j.status)
+	}
+	if j.reportID == nil {
+		return j, fmt.Errorf("cannot complete job: no report was generated")
+	}
+	j.status = valueobject.JobStatusSucceeded
+	j.progress = 100
+	j.stage = ""
+	return j.complete(now), nil
+}
+
+// Fail transitions from RUNNING to FAILED. The detail names the stage the job
+// failed at; the report and artifacts produced before it are kept.
+func (j ReportJob) Fail(reason string, now time.Time) (ReportJob, error) {
+	if !j.status.Equal(valueobject.JobStatusRunning) {
+		return j, fmt.Errorf("cannot fail job: current status is %s, expected RUNNING", j.status)
+	}
+	if reason == "" {
+		return j, fmt.Errorf("failure reason must not be empty")
+	}
+	j.status = valueobject.JobStatusFailed
+	j.failureDetail = reason
+	if j.stage != "" {
+		j.failureDetail = fmt.Sprintf("failed while %s: %s", j.stage, reason)
+	}
+	return j.complete(now), nil
+}
+
+func (j ReportJob) complete(now time.Time) ReportJob {
+	j.completedAt = &now
+	j.version++
+	j.updatedAt = now
+	names := make([]string, 0, len(j.artifacts))
+	for _, a := range j.artifacts {
+		names = append(names, a.Name)
+	}
+	j.domainEvents = append(j.domainEvents, event.NewReportJobCompleted(
+		j.id, j.tenantID, j.reportID, j.reportType.String(), j.reportingPeriod, j.status.String(), j.failureDetail, names,
+	))
+	return j
+}
+
+// Artifact returns the named artifact, and false when the job has none by that name.
+func (j ReportJob) Artifact(name string) (ReportArtifact, bool) {
+	for _, a := range j.artifacts {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return ReportArtifact{}, false
+}
+
+// --- Accessors ---
+
+func (j ReportJob) ID() uuid.UUID                      { return j.id }
+func (j ReportJob) TenantID() uuid.UUID                { return j.tenantID }
+func (j ReportJob) ReportType() valueobject.ReportType { return j.reportType }
+func (j ReportJob) ReportingPeriod() string            { return j.reportingPeriod }
+func (j ReportJob) Format() string                     { return j.format }
+func (j ReportJob) RequestedBy() string                { return j.requestedBy }
+func (j ReportJob) Status() valueobject.JobStatus      { return j.status }
+func (j ReportJob) Progress() int                      { return j.progress }
+func (j ReportJob) Stage() string                      { return j.stage }
+func (j ReportJob) ReportID() *uuid.UUID               { return j.reportID }
+func (j ReportJob) Artifacts() []ReportArtifact        { return j.artifacts }
+func (j ReportJob) FailureDetail() string              { return j.failureDetail }
+func (j ReportJob) Attempts() int                      { return j.attempts }
+func (j ReportJob) StartedAt() *time.Time              { return j.startedAt }
+func (j ReportJob) CompletedAt() *time.Time            { return j.completedAt }
+func (j ReportJob) Version() int                       { return j.version }
+func (j ReportJob) CreatedAt() time.Time               { return j.createdAt }
+func (j ReportJob) UpdatedAt() time.Time               { return j.updatedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (j ReportJob) DomainEvents() []events.DomainEvent {
+	return j.domainEvents
+}
+
+// ClearDomainEvents returns a copy with cleared domain events.
+func (j ReportJob) ClearDomainEvents() ReportJob {
+	j.domainEvents = nil
+	return j
+}
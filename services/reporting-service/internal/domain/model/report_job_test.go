@@ -0,0 +1,76 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/event"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+func TestReportJob_Lifecycle(t *testing.T) {
+	now := time.Now().UTC()
+	job, err := model.NewReportJob(uuid.New(), valueobject.ReportTypeFINREP, "2025-Q1", "CSV", "maker", now)
+	require.NoError(t, err)
+	assert.True(t, job.Status().Equal(valueobject.JobStatusQueued))
+
+	_, err = job.ReportProgress("generating filing", 50, now)
+	assert.Error(t, err, "a queued job reports no progress")
+
+	job, err = job.Start(now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, job.Attempts())
+
+	job, err = job.ReportProgress("generating filing", 50, now)
+	require.NoError(t, err)
+	job, err = job.ReportProgress("late stage", 30, now)
+	require.NoError(t, err)
+	assert.Equal(t, 50, job.Progress(), "progress never goes backwards")
+
+	_, err = job.Succeed(now)
+	assert.Error(t, err, "a job succeeds only with a report")
+
+	job, err = job.RecordReport(uuid.New())
+	require.NoError(t, err)
+	job, err = job.AddArtifact(model.ReportArtifact{Name: "finrep-2025-Q1.csv", Key: "jobs/finrep-2025-Q1.csv"})
+	require.NoError(t, err)
+	job, err = job.Succeed(now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, job.Status().Equal(valueobject.JobStatusSucceeded))
+	assert.Equal(t, 100, job.Progress())
+	require.NotNil(t, job.CompletedAt())
+
+	require.Len(t, job.DomainEvents(), 1)
+	completed, ok := job.DomainEvents()[0].(event.ReportJobCompleted)
+	require.True(t, ok)
+	assert.Equal(t, "SUCCEEDED", completed.Status)
+	assert.Equal(t, []string{"finrep-2025-Q1.csv"}, completed.Artifacts)
+
+	_, err = job.Start(now)
+	assert.Error(t, err, "a finished job is not run again")
+}
+
+func TestReportJob_FailureKeepsPartialResults(t *testing.T) {
+	now := time.Now().UTC()
+	job, err := model.NewReportJob(uuid.New(), valueobject.ReportTypeCOREP, "2025-Q1", "", "", now)
+	require.NoError(t, err)
+	job, _ = job.Start(now)
+	job, _ = job.RecordReport(uuid.New())
+	job, _ = job.AddArtifact(model.ReportArtifact{Name: "corep-2025-Q1.xbrl", Key: "jobs/corep-2025-Q1.xbrl"})
+	job, _ = job.ReportProgress("storing artifacts", 95, now)
+
+	job, err = job.Fail("bucket unavailable", now)
+	require.NoError(t, err)
+	assert.True(t, job.Status().Equal(valueobject.JobStatusFailed))
+	assert.Equal(t, "failed while storing artifacts: bucket unavailable", job.FailureDetail())
+	assert.NotNil(t, job.ReportID())
+	assert.Len(t, job.Artifacts(), 1)
+
+	_, err = model.NewReportJob(uuid.New(), valueobject.ReportTypeCOREP, "", "", "", now)
+	assert.Error(t, err)
+}
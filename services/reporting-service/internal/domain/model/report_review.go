@@ -0,0 +1,87 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Report review decisions.
+const (
+	ReviewDecisionApproved         = "APPROVED"
+	ReviewDecisionChangesRequested = "CHANGES_REQUESTED"
+)
+
+// ReportReview records a compliance reviewer's decision on a generated
+// report: sign-off for submission, or a request for changes. The report's
+// SHA-256 ties each review to the exact content that was reviewed, so a
+// revised report's reviews can be told apart. Reviews are append-only.
+type ReportReview struct {
+	reviewedAt    time.Time
+	decision      string
+	reviewer      string
+	comment       string
+	contentSHA256 string
+	id            uuid.UUID
+	submissionID  uuid.UUID
+	tenantID      uuid.UUID
+}
+
+// NewReportReview records reviewer's decision on submission.
+func NewReportReview(submission ReportSubmission, decision, reviewer, comment string, now time.Time) (ReportReview, error) {
+	if decision != ReviewDecisionApproved && decision != ReviewDecisionChangesRequested {
+		return ReportReview{}, fmt.Errorf("invalid review decision: %q", decision)
+	}
+	if reviewer == "" {
+		return ReportReview{}, fmt.Errorf("reviewer must not be empty")
+	}
+
+	sum := sha256.Sum256([]byte(submission.XBRLContent()))
+	return ReportReview{
+		id:            uuid.New(),
+		submissionID:  submission.ID(),
+		tenantID:      submission.TenantID(),
+		decision:      decision,
+		reviewer:      reviewer,
+		comment:       comment,
+		contentSHA256: hex.EncodeToString(sum[:]),
+		reviewedAt:    now,
+	}, nil
+}
+
+// ReconstructReportReview recreates a ReportReview from persisted data.
+func ReconstructReportReview(
+	id uuid.UUID,
+	submissionID uuid.UUID,
+	tenantID uuid.UUID,
+	decision string,
+	reviewer string,
+	comment string,
+	contentSHA256 string,
+	reviewedAt time.Time,
+) ReportReview {
+	return ReportReview{
+		id:            id,
+		submissionID:  submissionID,
+		tenantID:      tenantID,
+		decision:      decision,
+		reviewer:      reviewer,
+		comment:       comment,
+		contentSHA256: contentSHA256,
+		reviewedAt:    reviewedAt,
+	}
+}
+
+// --- Accessors ---
+
+func (r ReportReview) ID() uuid.UUID           { return r.id }
+func (r ReportReview) SubmissionID() uuid.UUID { return r.submissionID }
+func (r ReportReview) TenantID() uuid.UUID     { return r.tenantID }
+func (r ReportReview) Decision() string        { return r.decision }
+func (r ReportReview) Reviewer() string        { return r.reviewer }
+func (r ReportReview) Comment() string         { return r.comment }
+func (r ReportReview) ContentSHA256() string   { return r.contentSHA256 }
+func (r ReportReview) ReviewedAt() time.Time   { return r.reviewedAt }
@@ -0,0 +1,144 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+// ReportSchedule is the aggregate root for a tenant's regulatory reporting
+// calendar for one report type. Drafts are generated LeadDays before the
+// submission deadline, which falls DeadlineDays after each period ends.
+type ReportSchedule struct {
+	createdAt    time.Time
+	updatedAt    time.Time
+	reportType   valueobject.ReportType
+	frequency    valueobject.ReportingFrequency
+	deadlineDays int
+	leadDays     int
+	version      int
+	active       bool
+	id           uuid.UUID
+	tenantID     uuid.UUID
+}
+
+// NewReportSchedule creates a new active ReportSchedule.
+func NewReportSchedule(
+	tenantID uuid.UUID,
+	reportType valueobject.ReportType,
+	frequency valueobject.ReportingFrequency,
+	deadlineDays, leadDays int,
+	now time.Time,
+) (ReportSchedule, error) {
+	if tenantID == uuid.Nil {
+		return ReportSchedule{}, fmt.Errorf("tenant ID must not be nil")
+	}
+	if reportType.IsZero() {
+		return ReportSchedule{}, fmt.Errorf("report type must not be empty")
+	}
+	if frequency.IsZero() {
+		return ReportSchedule{}, fmt.Errorf("reporting frequency must not be empty")
+	}
+	if err := validateCalendar(deadlineDays, leadDays); err != nil {
+		return ReportSchedule{}, err
+	}
+
+	return ReportSchedule{
+		id:           uuid.New(),
+		tenantID:     tenantID,
+		reportType:   reportType,
+		frequency:    frequency,
+		deadlineDays: deadlineDays,
+		leadDays:     leadDays,
+		active:       true,
+		version:      1,
+		createdAt:    now,
+		updatedAt:    now,
+	}, nil
+}
+
+// ReconstructReportSchedule recreates a ReportSchedule from persisted data.
+func ReconstructReportSchedule(
+	id uuid.UUID,
+	tenantID uuid.UUID,
+	reportType valueobject.ReportType,
+	frequency valueobject.ReportingFrequency,
+	deadlineDays int,
+	leadDays int,
+	active bool,
+	version int,
+	createdAt time.Time,
+	updatedAt time.Time,
+) ReportSchedule {
+	return ReportSchedule{
+		id:           id,
+		tenantID:     tenantID,
+		reportType:   reportType,
+		frequency:    frequency,
+		deadlineDays: deadlineDays,
+		leadDays:     leadDays,
+		active:       active,
+		version:      version,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}
+}
+
+func validateCalendar(deadlineDays, leadDays int) error {
+	if deadlineDays <= 0 {
+		return fmt.Errorf("deadline days must be positive")
+	}
+	if leadDays < 0 || leadDays > deadlineDays {
+		return fmt.Errorf("lead days must be between 0 and the deadline days")
+	}
+	return nil
+}
+
+// Reschedule changes the calendar and active flag of the schedule.
+func (s ReportSchedule) Reschedule(frequency valueobject.ReportingFrequency, deadlineDays, leadDays int, active bool, now time.Time) (ReportSchedule, error) {
+	if frequency.IsZero() {
+		return s, fmt.Errorf("reporting frequency must not be empty")
+	}
+	if err := validateCalendar(deadlineDays, leadDays); err != nil {
+		return s, err
+	}
+	s.frequency = frequency
+	s.deadlineDays = deadlineDays
+	s.leadDays = leadDays
+	s.active = active
+	s.version++
+	s.updatedAt = now
+	return s, nil
+}
+
+// DueRun returns the period whose draft should exist as of now and its
+// submission deadline. ok is false while the schedule is inactive, before the
+// generation window opens, and for deadlines that passed before the schedule
+// was created.
+func (s ReportSchedule) DueRun(now time.Time) (period string, deadline time.Time, ok bool) {
+	if !s.active {
+		return "", time.Time{}, false
+	}
+	period, periodEnd := s.frequency.LastCompletedPeriod(now)
+	deadline = periodEnd.AddDate(0, 0, s.deadlineDays)
+	if now.Before(deadline.AddDate(0, 0, -s.leadDays)) || deadline.Before(s.createdAt) {
+		return "", time.Time{}, false
+	}
+	return period, deadline, true
+}
+
+// --- Accessors ---
+
+func (s ReportSchedule) ID() uuid.UUID                             { return s.id }
+func (s ReportSchedule) TenantID() uuid.UUID                       { return s.tenantID }
+func (s ReportSchedule) ReportType() valueobject.ReportType        { return s.reportType }
+func (s ReportSchedule) Frequency() valueobject.ReportingFrequency { return s.frequency }
+func (s ReportSchedule) DeadlineDays() int                         { return s.deadlineDays }
+func (s ReportSchedule) LeadDays() int                             { return s.leadDays }
+func (s ReportSchedule) Active() bool                              { return s.active }
+func (s ReportSchedule) Version() int                              { return s.version }
+func (s ReportSchedule) CreatedAt() time.Time                      { return s.createdAt }
+func (s ReportSchedule) UpdatedAt() time.Time                      { return s.updatedAt }
@@ -0,0 +1,130 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/event"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// quarterlyCOREP is a COREP schedule due 42 days after each quarter end,
+// generated ten days ahead.
+func quarterlyCOREP(t *testing.T, createdAt time.Time) model.ReportSchedule {
+	t.Helper()
+	s, err := model.NewReportSchedule(uuid.New(), valueobject.ReportTypeCOREP, valueobject.ReportingFrequencyQuarterly, 42, 10, createdAt)
+	require.NoError(t, err)
+	return s
+}
+
+func TestNewReportSchedule_Validation(t *testing.T) {
+	now := time.Now().UTC()
+	_, err := model.NewReportSchedule(uuid.Nil, valueobject.ReportTypeCOREP, valueobject.ReportingFrequencyQuarterly, 42, 10, now)
+	assert.Error(t, err)
+	_, err = model.NewReportSchedule(uuid.New(), valueobject.ReportTypeCOREP, valueobject.ReportingFrequencyQuarterly, 0, 0, now)
+	assert.Error(t, err)
+	_, err = model.NewReportSchedule(uuid.New(), valueobject.ReportTypeCOREP, valueobject.ReportingFrequencyQuarterly, 10, 11, now)
+	assert.Error(t, err)
+}
+
+func TestReportSchedule_DueRun(t *testing.T) {
+	s := quarterlyCOREP(t, date(2025, 1, 1))
+
+	// Q1 closes at the start of 1 April, so the deadline is the end of 12 May
+	// and drafts start on 3 May.
+	_, _, ok := s.DueRun(date(2025, 5, 2))
+	assert.False(t, ok, "generation window not yet open")
+
+	period, deadline, ok := s.DueRun(date(2025, 5, 3))
+	require.True(t, ok)
+	assert.Equal(t, "2025-Q1", period)
+	assert.Equal(t, date(2025, 5, 13), deadline)
+
+	period, _, ok = s.DueRun(date(2026, 2, 20))
+	require.True(t, ok)
+	assert.Equal(t, "2025-Q4", period)
+
+	inactive, err := s.Reschedule(s.Frequency(), 42, 10, false, date(2025, 5, 1))
+	require.NoError(t, err)
+	_, _, ok = inactive.DueRun(date(2025, 5, 3))
+	assert.False(t, ok)
+}
+
+func TestReportSchedule_DueRun_SkipsDeadlinesBeforeCreation(t *testing.T) {
+	s := quarterlyCOREP(t, date(2025, 6, 1))
+
+	_, _, ok := s.DueRun(date(2025, 6, 2))
+	assert.False(t, ok, "Q1 deadline passed before the schedule existed")
+}
+
+func TestReportSchedule_DueRun_Monthly(t *testing.T) {
+	s, err := model.NewReportSchedule(uuid.New(), valueobject.ReportTypeFINREP, valueobject.ReportingFrequencyMonthly, 20, 5, date(2025, 1, 1))
+	require.NoError(t, err)
+
+	period, deadline, ok := s.DueRun(date(2025, 3, 16))
+	require.True(t, ok)
+	assert.Equal(t, "2025-02", period)
+	assert.Equal(t, date(2025, 3, 21), deadline)
+}
+
+func TestScheduledRun_Lifecycle(t *testing.T) {
+	s := quarterlyCOREP(t, date(2025, 1, 1))
+	period, deadline, _ := s.DueRun(date(2025, 5, 3))
+	run, err := model.NewScheduledRun(s, period, deadline, date(2025, 5, 3))
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.RunStatusPending, run.Status())
+
+	run, err = run.MarkFailed("ledger unavailable", date(2025, 5, 3))
+	require.NoError(t, err)
+	assert.Equal(t, 1, run.Attempts())
+	require.Len(t, run.DomainEvents(), 1)
+	failed, ok := run.DomainEvents()[0].(event.ScheduledReportFailed)
+	require.True(t, ok)
+	assert.Equal(t, "ledger unavailable", failed.Reason)
+
+	reportID := uuid.New()
+	run, err = run.MarkGenerated(reportID, date(2025, 5, 4))
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.RunStatusGenerated, run.Status())
+	assert.Equal(t, 2, run.Attempts())
+	assert.Empty(t, run.FailureReason())
+
+	assert.False(t, run.IsOverdue(date(2025, 5, 12)))
+	run, err = run.MarkSubmitted(date(2025, 5, 12), date(2025, 5, 12))
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.RunStatusSubmitted, run.Status())
+	assert.True(t, run.SLAMet())
+	assert.False(t, run.IsOverdue(date(2025, 6, 1)))
+}
+
+func TestScheduledRun_MissedDeadline(t *testing.T) {
+	s := quarterlyCOREP(t, date(2025, 1, 1))
+	run, err := model.NewScheduledRun(s, "2025-Q1", date(2025, 5, 13), date(2025, 5, 2))
+	require.NoError(t, err)
+	run, err = run.MarkGenerated(uuid.New(), date(2025, 5, 2))
+	require.NoError(t, err)
+
+	_, err = run.MarkMissed(date(2025, 5, 12))
+	assert.Error(t, err, "deadline has not passed")
+
+	run, err = run.MarkMissed(date(2025, 5, 14))
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.RunStatusMissed, run.Status())
+	require.Len(t, run.DomainEvents(), 1)
+	_, ok := run.DomainEvents()[0].(event.ReportDeadlineMissed)
+	assert.True(t, ok)
+
+	// A late submission is still recorded, outside the SLA.
+	run, err = run.MarkSubmitted(date(2025, 5, 20), date(2025, 5, 20))
+	require.NoError(t, err)
+	assert.False(t, run.SLAMet())
+}
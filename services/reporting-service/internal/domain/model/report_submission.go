@@ -18,14 +18,26 @@ type ReportSubmission struct {
 	createdAt        time.Time
 	generatedAt      *time.Time
 	submittedAt      *time.Time
+	approvedAt       *time.Time
 	reportingPeriod  string
 	xbrlContent      string
+	reference        string
+	amendmentNote    string
+	preparedBy       string
+	approvedBy       string
 	status           valueobject.SubmissionStatus
 	reportType       valueobject.ReportType
+	channel          valueobject.SubmissionChannel
+	amendmentReason  valueobject.AmendmentReason
+	amendsID         *uuid.UUID
 	validationErrors []string
+	linkedCaseIDs    []string
 	domainEvents     []events.DomainEvent
+	attempts         int
+	revision         int
 	version          int
 	id               uuid.UUID
+	originalID       uuid.UUID
 	tenantID         uuid.UUID
 }
 
@@ -42,20 +54,57 @@ func NewReportSubmission(tenantID uuid.UUID, reportType valueobject.ReportType,
 	}
 
 	now := time.Now().UTC()
+	id := uuid.New()
 	return ReportSubmission{
-		id:               uuid.New(),
+		id:               id,
+		originalID:       id,
+		revision:         1,
 		tenantID:         tenantID,
 		reportType:       reportType,
 		reportingPeriod:  period,
 		status:           valueobject.SubmissionStatusDraft,
 		xbrlContent:      "",
 		validationErrors: []string{},
+		linkedCaseIDs:    []string{},
 		version:          1,
 		createdAt:        now,
 		updatedAt:        now,
 	}, nil
 }
 
+// NewAmendment creates the next version of a filed report in DRAFT status,
+// for the same tenant, type and period. The amendment keeps the lineage to
+// the original filing and must state a reason; AmendmentReasonOther also
+// requires a note.
+func NewAmendment(previous ReportSubmission, reason valueobject.AmendmentReason, note string) (ReportSubmission, error) {
+	if previous.submittedAt == nil {
+		return ReportSubmission{}, fmt.Errorf("cannot amend: report %s has not been filed", previous.id)
+	}
+	if reason.IsZero() {
+		return ReportSubmission{}, fmt.Errorf("amendment reason must not be empty")
+	}
+	note = strings.TrimSpace(note)
+	if reason.Equal(valueobject.AmendmentReasonOther) && note == "" {
+		return ReportSubmission{}, fmt.Errorf("amendment reason OTHER requires a note")
+	}
+
+	amendment, err := NewReportSubmission(previous.tenantID, previous.reportType, previous.reportingPeriod)
+	if err != nil {
+		return ReportSubmission{}, err
+	}
+	amendsID := previous.id
+	amendment.originalID = previous.originalID
+	amendment.amendsID = &amendsID
+	amendment.revision = previous.revision + 1
+	amendment.amendmentReason = reason
+	amendment.amendmentNote = note
+	amendment.domainEvents = append(amendment.domainEvents, event.NewReportAmended(
+		amendment.id, amendment.tenantID, amendment.originalID, amendsID,
+		amendment.reportType.String(), amendment.reportingPeriod, amendment.revision, reason.String(), note,
+	))
+	return amendment, nil
+}
+
 // Reconstruct recreates a ReportSubmission from persisted data without emitting events.
 func Reconstruct(
 	id uuid.UUID,
@@ -67,6 +116,18 @@ func Reconstruct(
 	generatedAt *time.Time,
 	submittedAt *time.Time,
 	validationErrors []string,
+	channel valueobject.SubmissionChannel,
+	reference string,
+	attempts int,
+	revision int,
+	originalID uuid.UUID,
+	amendsID *uuid.UUID,
+	amendmentReason valueobject.AmendmentReason,
+	amendmentNote string,
+	linkedCaseIDs []string,
+	preparedBy string,
+	approvedBy string,
+	approvedAt *time.Time,
 	version int,
 	createdAt time.Time,
 	updatedAt time.Time,
@@ -74,6 +135,12 @@ func Reconstruct(
 	if validationErrors == nil {
 		validationErrors = []string{}
 	}
+	if linkedCaseIDs == nil {
+		linkedCaseIDs = []string{}
+	}
+	if originalID == uuid.Nil {
+		originalID = id
+	}
 	return ReportSubmission{
 		id:               id,
 		tenantID:         tenantID,
@@ -84,6 +151,18 @@ func Reconstruct(
 		generatedAt:      generatedAt,
 		submittedAt:      submittedAt,
 		validationErrors: validationErrors,
+		channel:          channel,
+		reference:        reference,
+		attempts:         attempts,
+		revision:         revision,
+		originalID:       originalID,
+		amendsID:         amendsID,
+		amendmentReason:  amendmentReason,
+		amendmentNote:    amendmentNote,
+		linkedCaseIDs:    linkedCaseIDs,
+		preparedBy:       preparedBy,
+		approvedBy:       approvedBy,
+		approvedAt:       approvedAt,
 		version:          version,
 		createdAt:        createdAt,
 		updatedAt:        updatedAt,
@@ -118,49 +197,176 @@ func (r ReportSubmission) SetGenerated(xbrlContent string, now time.Time) (Repor
 	return r, nil
 }
 
-// Validate performs basic XBRL validation on the content.
+// SetPreparer records the user who generated the report's content, who may
+// not also approve it. An empty preparer marks a system-generated report.
+func (r ReportSubmission) SetPreparer(actor string) ReportSubmission {
+	r.preparedBy = actor
+	return r
+}
+
+// LinkCases records the fraud cases an AML report was built from, so that
+// each filing can be traced back to its investigations.
+func (r ReportSubmission) LinkCases(caseIDs []string) (ReportSubmission, error) {
+	if !r.reportType.IsAML() {
+		return r, fmt.Errorf("cannot link cases: %s is not an AML report", r.reportType)
+	}
+	if !r.status.Equal(valueobject.SubmissionStatusGenerating) {
+		return r, fmt.Errorf("cannot link cases: current status is %s, expected GENERATING", r.status)
+	}
+	r.linkedCaseIDs = append([]string{}, caseIDs...)
+	return r, nil
+}
+
+// Validate performs basic validation of the generated filing: XBRL, or goAML
+// XML for AML reports.
 func (r ReportSubmission) Validate() (ReportSubmission, error) {
 	if !r.status.Equal(valueobject.SubmissionStatusReady) {
 		return r, fmt.Errorf("cannot validate: current status is %s, expected READY", r.status)
 	}
 
-	var errors []string
+	format := "XBRL"
+	errors := validateXBRL(r.xbrlContent)
+	if r.reportType.IsAML() {
+		format = "goAML"
+		errors = validateGoAML(r.xbrlContent)
+	}
 
-	if r.xbrlContent == "" {
+	if len(errors) > 0 {
+		r.validationErrors = errors
+		return r, fmt.Errorf("%s validation failed: %s", format, strings.Join(errors, "; "))
+	}
+
+	r.validationErrors = []string{}
+	return r, nil
+}
+
+func validateXBRL(content string) []string {
+	var errors []string
+	if content == "" {
 		errors = append(errors, "XBRL content is empty")
 	}
-	if !strings.Contains(r.xbrlContent, "<?xml") {
+	if !strings.Contains(content, "<?xml") {
 		errors = append(errors, "XBRL content missing XML declaration")
 	}
-	if !strings.Contains(r.xbrlContent, "xbrli:xbrl") {
+	if !strings.Contains(content, "xbrli:xbrl") {
 		errors = append(errors, "XBRL content missing xbrli:xbrl root element")
 	}
-	if !strings.Contains(r.xbrlContent, "xbrli:context") {
+	if !strings.Contains(content, "xbrli:context") {
 		errors = append(errors, "XBRL content missing xbrli:context element")
 	}
-	if !strings.Contains(r.xbrlContent, "xbrli:period") {
+	if !strings.Contains(content, "xbrli:period") {
 		errors = append(errors, "XBRL content missing xbrli:period element")
 	}
+	return errors
+}
 
-	if len(errors) > 0 {
-		r.validationErrors = errors
-		return r, fmt.Errorf("XBRL validation failed: %s", strings.Join(errors, "; "))
+func validateGoAML(content string) []string {
+	var errors []string
+	if content == "" {
+		errors = append(errors, "goAML content is empty")
 	}
+	if !strings.Contains(content, "<?xml") {
+		errors = append(errors, "goAML content missing XML declaration")
+	}
+	for _, element := range []string{"report", "rentity_id", "report_code", "submission_date"} {
+		if !strings.Contains(content, "<"+element+">") {
+			errors = append(errors, fmt.Sprintf("goAML content missing %s element", element))
+		}
+	}
+	if strings.Contains(content, "<rentity_id></rentity_id>") {
+		errors = append(errors, "goAML reporting entity ID is empty")
+	}
+	return errors
+}
 
-	r.validationErrors = []string{}
+// Approve transitions from READY to APPROVED, signing the report off for
+// submission. The reviewer must not be the user who prepared the report.
+func (r ReportSubmission) Approve(reviewer string, now time.Time) (ReportSubmission, error) {
+	if !r.status.Equal(valueobject.SubmissionStatusReady) {
+		return r, fmt.Errorf("cannot approve: current status is %s, expected READY", r.status)
+	}
+	if reviewer == "" {
+		return r, fmt.Errorf("reviewer must not be empty")
+	}
+	if reviewer == r.preparedBy {
+		return r, fmt.Errorf("cannot approve: %s prepared the report", reviewer)
+	}
+	r.status = valueobject.SubmissionStatusApproved
+	r.approvedBy = reviewer
+	r.approvedAt = &now
+	r.updatedAt = now
+	r.domainEvents = append(r.domainEvents, event.NewReportApproved(
+		r.id, r.tenantID, r.reportType.String(), r.reportingPeriod, reviewer, now,
+	))
 	return r, nil
 }
 
-// Submit transitions from READY to SUBMITTED.
-func (r ReportSubmission) Submit(now time.Time) (ReportSubmission, error) {
+// RequestChanges transitions from READY to CHANGES_REQUESTED, sending the
+// report back to be revised. The reviewer must say what needs to change.
+func (r ReportSubmission) RequestChanges(reviewer, comment string, now time.Time) (ReportSubmission, error) {
 	if !r.status.Equal(valueobject.SubmissionStatusReady) {
-		return r, fmt.Errorf("cannot submit: current status is %s, expected READY", r.status)
+		return r, fmt.Errorf("cannot request changes: current status is %s, expected READY", r.status)
+	}
+	if reviewer == "" {
+		return r, fmt.Errorf("reviewer must not be empty")
+	}
+	if strings.TrimSpace(comment) == "" {
+		return r, fmt.Errorf("a comment is required when requesting changes")
+	}
+	r.status = valueobject.SubmissionStatusChangesRequested
+	r.updatedAt = now
+	r.domainEvents = append(r.domainEvents, event.NewReportChangesRequested(
+		r.id, r.tenantID, r.reportType.String(), r.reportingPeriod, reviewer, comment, now,
+	))
+	return r, nil
+}
+
+// Revise transitions from CHANGES_REQUESTED back to DRAFT so the report can
+// be regenerated and reviewed again.
+func (r ReportSubmission) Revise(now time.Time) (ReportSubmission, error) {
+	if !r.status.Equal(valueobject.SubmissionStatusChangesRequested) {
+		return r, fmt.Errorf("cannot revise: current status is %s, expected CHANGES_REQUESTED", r.status)
+	}
+	r.status = valueobject.SubmissionStatusDraft
+	r.xbrlContent = ""
+	r.generatedAt = nil
+	r.validationErrors = []string{}
+	r.linkedCaseIDs = []string{}
+	r.updatedAt = now
+	return r, nil
+}
+
+// CanSubmit reports whether the submission can be filed: it must be APPROVED,
+// or REJECTED for a resubmission of the approved content.
+func (r ReportSubmission) CanSubmit() error {
+	if !r.status.Equal(valueobject.SubmissionStatusApproved) && !r.status.Equal(valueobject.SubmissionStatusRejected) {
+		return fmt.Errorf("cannot submit: current status is %s, expected APPROVED or REJECTED", r.status)
+	}
+	return nil
+}
+
+// Submit transitions from APPROVED, or REJECTED for a resubmission, to SUBMITTED
+// once the filing has been handed to the regulator over channel. reference
+// identifies the transmission in the regulator's acknowledgments.
+func (r ReportSubmission) Submit(channel valueobject.SubmissionChannel, reference string, now time.Time) (ReportSubmission, error) {
+	if err := r.CanSubmit(); err != nil {
+		return r, err
+	}
+	if channel.IsZero() {
+		return r, fmt.Errorf("submission channel must not be empty")
+	}
+	if reference == "" {
+		return r, fmt.Errorf("submission reference must not be empty")
 	}
 	r.status = valueobject.SubmissionStatusSubmitted
+	r.channel = channel
+	r.reference = reference
+	r.attempts++
+	r.validationErrors = []string{}
 	r.submittedAt = &now
 	r.updatedAt = now
 	r.domainEvents = append(r.domainEvents, event.NewReportSubmitted(
-		r.id, r.tenantID, r.reportType.String(), r.reportingPeriod, now,
+		r.id, r.tenantID, r.reportType.String(), r.reportingPeriod, channel.String(), reference, r.attempts, now,
 	))
 	return r, nil
 }
@@ -195,20 +401,37 @@ func (r ReportSubmission) Reject(errors []string, now time.Time) (ReportSubmissi
 	return r, nil
 }
 
+// IsAmendment reports whether the submission amends an earlier version.
+func (r ReportSubmission) IsAmendment() bool {
+	return r.amendsID != nil
+}
+
 // --- Accessors ---
 
-func (r ReportSubmission) ID() uuid.UUID                        { return r.id }
-func (r ReportSubmission) TenantID() uuid.UUID                  { return r.tenantID }
-func (r ReportSubmission) ReportType() valueobject.ReportType   { return r.reportType }
-func (r ReportSubmission) ReportingPeriod() string              { return r.reportingPeriod }
-func (r ReportSubmission) Status() valueobject.SubmissionStatus { return r.status }
-func (r ReportSubmission) XBRLContent() string                  { return r.xbrlContent }
-func (r ReportSubmission) GeneratedAt() *time.Time              { return r.generatedAt }
-func (r ReportSubmission) SubmittedAt() *time.Time              { return r.submittedAt }
-func (r ReportSubmission) ValidationErrors() []string           { return r.validationErrors }
-func (r ReportSubmission) Version() int                         { return r.version }
-func (r ReportSubmission) CreatedAt() time.Time                 { return r.createdAt }
-func (r ReportSubmission) UpdatedAt() time.Time                 { return r.updatedAt }
+func (r ReportSubmission) ID() uuid.UUID                                { return r.id }
+func (r ReportSubmission) TenantID() uuid.UUID                          { return r.tenantID }
+func (r ReportSubmission) ReportType() valueobject.ReportType           { return r.reportType }
+func (r ReportSubmission) ReportingPeriod() string                      { return r.reportingPeriod }
+func (r ReportSubmission) Status() valueobject.SubmissionStatus         { return r.status }
+func (r ReportSubmission) XBRLContent() string                          { return r.xbrlContent }
+func (r ReportSubmission) GeneratedAt() *time.Time                      { return r.generatedAt }
+func (r ReportSubmission) SubmittedAt() *time.Time                      { return r.submittedAt }
+func (r ReportSubmission) ValidationErrors() []string                   { return r.validationErrors }
+func (r ReportSubmission) Channel() valueobject.SubmissionChannel       { return r.channel }
+func (r ReportSubmission) Reference() string                            { return r.reference }
+func (r ReportSubmission) Attempts() int                                { return r.attempts }
+func (r ReportSubmission) Revision() int                                { return r.revision }
+func (r ReportSubmission) OriginalID() uuid.UUID                        { return r.originalID }
+func (r ReportSubmission) AmendsID() *uuid.UUID                         { return r.amendsID }
+func (r ReportSubmission) AmendmentReason() valueobject.AmendmentReason { return r.amendmentReason }
+func (r ReportSubmission) AmendmentNote() string                        { return r.amendmentNote }
+func (r ReportSubmission) LinkedCaseIDs() []string                      { return r.linkedCaseIDs }
+func (r ReportSubmission) PreparedBy() string                           { return r.preparedBy }
+func (r ReportSubmission) ApprovedBy() string                           { return r.approvedBy }
+func (r ReportSubmission) ApprovedAt() *time.Time                       { return r.approvedAt }
+func (r ReportSubmission) Version() int                                 { return r.version }
+func (r ReportSubmission) CreatedAt() time.Time                         { return r.createdAt }
+func (r ReportSubmission) UpdatedAt() time.Time                         { return r.updatedAt }
 
 // DomainEvents returns the uncommitted domain events.
 func (r ReportSubmission) DomainEvents() []events.DomainEvent {
@@ -101,21 +101,30 @@ func TestReportSubmission_FullLifecycle_Accept(t *testing.T) {
 	require.NoError(t, err)
 	assert.Empty(t, sub.ValidationErrors())
 
-	// Step 5: Submit.
+	// Step 5: Approve.
+	sub, err = sub.Approve("checker", now.Add(8*time.Second))
+	require.NoError(t, err)
+	assert.True(t, sub.Status().Equal(valueobject.SubmissionStatusApproved))
+	assert.Equal(t, "checker", sub.ApprovedBy())
+
+	// Step 6: Submit.
 	submitTime := now.Add(10 * time.Second)
-	sub, err = sub.Submit(submitTime)
+	sub, err = sub.Submit(valueobject.SubmissionChannelSFTP, "REF-1", submitTime)
 	require.NoError(t, err)
 	assert.True(t, sub.Status().Equal(valueobject.SubmissionStatusSubmitted))
 	assert.NotNil(t, sub.SubmittedAt())
+	assert.Equal(t, valueobject.SubmissionChannelSFTP, sub.Channel())
+	assert.Equal(t, "REF-1", sub.Reference())
+	assert.Equal(t, 1, sub.Attempts())
 
 	// Verify ReportSubmitted event was emitted.
 	events = sub.DomainEvents()
-	require.Len(t, events, 2)
-	subEvent, ok := events[1].(event.ReportSubmitted)
+	require.Len(t, events, 3)
+	subEvent, ok := events[2].(event.ReportSubmitted)
 	require.True(t, ok)
 	assert.Equal(t, sub.ID().String(), subEvent.AggregateID())
 
-	// Step 6: Accept.
+	// Step 7: Accept.
 	acceptTime := now.Add(60 * time.Second)
 	sub, err = sub.Accept(acceptTime)
 	require.NoError(t, err)
@@ -123,8 +132,8 @@ func TestReportSubmission_FullLifecycle_Accept(t *testing.T) {
 
 	// Verify ReportAccepted event.
 	events = sub.DomainEvents()
-	require.Len(t, events, 3)
-	accEvent, ok := events[2].(event.ReportAccepted)
+	require.Len(t, events, 4)
+	accEvent, ok := events[3].(event.ReportAccepted)
 	require.True(t, ok)
 	assert.Equal(t, sub.ID().String(), accEvent.AggregateID())
 }
@@ -133,7 +142,7 @@ func TestReportSubmission_FullLifecycle_Reject(t *testing.T) {
 	tenantID := uuid.New()
 	now := time.Now().UTC()
 
-	// Create -> Generate -> SetGenerated -> Validate -> Submit -> Reject.
+	// Create -> Generate -> SetGenerated -> Validate -> Approve -> Submit -> Reject.
 	sub, err := model.NewReportSubmission(tenantID, valueobject.ReportTypeMREL, "2025-Q2")
 	require.NoError(t, err)
 
@@ -146,7 +155,10 @@ func TestReportSubmission_FullLifecycle_Reject(t *testing.T) {
 	sub, err = sub.Validate()
 	require.NoError(t, err)
 
-	sub, err = sub.Submit(now.Add(10 * time.Second))
+	sub, err = sub.Approve("checker", now.Add(8*time.Second))
+	require.NoError(t, err)
+
+	sub, err = sub.Submit(valueobject.SubmissionChannelSFTP, "REF-1", now.Add(10*time.Second))
 	require.NoError(t, err)
 
 	// Reject with errors.
@@ -158,12 +170,40 @@ func TestReportSubmission_FullLifecycle_Reject(t *testing.T) {
 
 	// Verify ReportRejected event.
 	events := sub.DomainEvents()
-	require.Len(t, events, 3) // Generated + Submitted + Rejected
-	rejEvent, ok := events[2].(event.ReportRejected)
+	require.Len(t, events, 4) // Generated + Approved + Submitted + Rejected
+	rejEvent, ok := events[3].(event.ReportRejected)
 	require.True(t, ok)
 	assert.Equal(t, rejErrors, rejEvent.ValidationErrors)
 }
 
+func TestReportSubmission_ResubmitAfterRejection(t *testing.T) {
+	now := time.Now().UTC()
+	sub, err := model.NewReportSubmission(uuid.New(), valueobject.ReportTypeCOREP, "2025-Q1")
+	require.NoError(t, err)
+	sub, _ = sub.MarkGenerating(now)
+	sub, _ = sub.SetGenerated(validXBRL(), now)
+	sub, _ = sub.Approve("checker", now)
+	sub, err = sub.Submit(valueobject.SubmissionChannelAPI, "EBA-1", now)
+	require.NoError(t, err)
+	sub, err = sub.Reject([]string{"file rejected: checksum mismatch"}, now.Add(time.Minute))
+	require.NoError(t, err)
+
+	sub, err = sub.Submit(valueobject.SubmissionChannelAPI, "EBA-2", now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, sub.Status().Equal(valueobject.SubmissionStatusSubmitted))
+	assert.Equal(t, "EBA-2", sub.Reference())
+	assert.Equal(t, 2, sub.Attempts())
+	assert.Empty(t, sub.ValidationErrors())
+
+	resubmitted, ok := sub.DomainEvents()[len(sub.DomainEvents())-1].(event.ReportSubmitted)
+	require.True(t, ok)
+	assert.Equal(t, 2, resubmitted.Attempt)
+	assert.Equal(t, "API", resubmitted.Channel)
+
+	_, err = sub.Submit(valueobject.SubmissionChannelAPI, "EBA-3", now.Add(2*time.Hour))
+	assert.Error(t, err, "already awaiting acknowledgment")
+}
+
 func TestReportSubmission_InvalidTransitions(t *testing.T) {
 	tenantID := uuid.New()
 	now := time.Now().UTC()
@@ -191,11 +231,13 @@ func TestReportSubmission_InvalidTransitions(t *testing.T) {
 		assert.Contains(t, err.Error(), "empty")
 	})
 
-	t.Run("cannot submit from non-READY", func(t *testing.T) {
+	t.Run("cannot submit before approval", func(t *testing.T) {
 		sub, _ := model.NewReportSubmission(tenantID, valueobject.ReportTypeCOREP, "2025-Q1")
-		_, err := sub.Submit(now) // still DRAFT
+		sub, _ = sub.MarkGenerating(now)
+		sub, _ = sub.SetGenerated(validXBRL(), now)
+		_, err := sub.Submit(valueobject.SubmissionChannelSFTP, "REF-1", now) // still READY
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "READY")
+		assert.Contains(t, err.Error(), "APPROVED")
 	})
 
 	t.Run("cannot accept from non-SUBMITTED", func(t *testing.T) {
@@ -216,7 +258,8 @@ func TestReportSubmission_InvalidTransitions(t *testing.T) {
 		sub, _ := model.NewReportSubmission(tenantID, valueobject.ReportTypeCOREP, "2025-Q1")
 		sub, _ = sub.MarkGenerating(now)
 		sub, _ = sub.SetGenerated(validXBRL(), now)
-		sub, _ = sub.Submit(now)
+		sub, _ = sub.Approve("checker", now)
+		sub, _ = sub.Submit(valueobject.SubmissionChannelSFTP, "REF-1", now)
 		_, err := sub.Reject([]string{}, now)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "at least one error")
@@ -247,6 +290,32 @@ func TestReportSubmission_Validate(t *testing.T) {
 		assert.Contains(t, err.Error(), "validation failed")
 	})
 
+	t.Run("AML reports are validated as goAML", func(t *testing.T) {
+		sub, _ := model.NewReportSubmission(tenantID, valueobject.ReportTypeSAR, "2025-03")
+		sub, _ = sub.MarkGenerating(now)
+		sub, err := sub.LinkCases([]string{"case-1"})
+		require.NoError(t, err)
+		sub, _ = sub.SetGenerated(validXBRL(), now)
+
+		sub, err = sub.Validate()
+		require.Error(t, err)
+		assert.Contains(t, sub.ValidationErrors(), "goAML content missing rentity_id element")
+
+		sub, _ = model.NewReportSubmission(tenantID, valueobject.ReportTypeSAR, "2025-03")
+		sub, _ = sub.MarkGenerating(now)
+		sub, _ = sub.SetGenerated(`<?xml version="1.0"?><report><rentity_id>4711</rentity_id>`+
+			`<report_code>STR</report_code><submission_date>2025-04-01T00:00:00</submission_date></report>`, now)
+		_, err = sub.Validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("only AML reports link fraud cases", func(t *testing.T) {
+		sub, _ := model.NewReportSubmission(tenantID, valueobject.ReportTypeCOREP, "2025-Q1")
+		sub, _ = sub.MarkGenerating(now)
+		_, err := sub.LinkCases([]string{"case-1"})
+		assert.Error(t, err)
+	})
+
 	t.Run("cannot validate from non-READY status", func(t *testing.T) {
 		sub, _ := model.NewReportSubmission(tenantID, valueobject.ReportTypeCOREP, "2025-Q1")
 		_, err := sub.Validate()
@@ -261,11 +330,17 @@ func TestReportSubmission_Reconstruct(t *testing.T) {
 	now := time.Now().UTC()
 	genAt := now.Add(-5 * time.Minute)
 	subAt := now.Add(-1 * time.Minute)
+	apprAt := now.Add(-3 * time.Minute)
+
+	originalID := uuid.New()
+	amendsID := uuid.New()
 
 	sub := model.Reconstruct(
 		id, tenantID, valueobject.ReportTypeFINREP, "2025-Q3",
 		valueobject.SubmissionStatusSubmitted, "<xbrl/>",
-		&genAt, &subAt, []string{}, 3, now.Add(-10*time.Minute), now,
+		&genAt, &subAt, []string{}, valueobject.SubmissionChannelAPI, "EBA-42", 2,
+		2, originalID, &amendsID, valueobject.AmendmentReasonDataCorrection, "restated loans",
+		[]string{"case-1"}, "maker", "checker", &apprAt, 3, now.Add(-10*time.Minute), now,
 	)
 
 	assert.Equal(t, id, sub.ID())
@@ -276,10 +351,104 @@ func TestReportSubmission_Reconstruct(t *testing.T) {
 	assert.Equal(t, "<xbrl/>", sub.XBRLContent())
 	assert.NotNil(t, sub.GeneratedAt())
 	assert.NotNil(t, sub.SubmittedAt())
+	assert.Equal(t, valueobject.SubmissionChannelAPI, sub.Channel())
+	assert.Equal(t, "EBA-42", sub.Reference())
+	assert.Equal(t, 2, sub.Attempts())
+	assert.Equal(t, 2, sub.Revision())
+	assert.Equal(t, originalID, sub.OriginalID())
+	assert.Equal(t, &amendsID, sub.AmendsID())
+	assert.True(t, sub.IsAmendment())
+	assert.Equal(t, []string{"case-1"}, sub.LinkedCaseIDs())
+	assert.Equal(t, valueobject.AmendmentReasonDataCorrection, sub.AmendmentReason())
+	assert.Equal(t, "restated loans", sub.AmendmentNote())
+	assert.Equal(t, "maker", sub.PreparedBy())
+	assert.Equal(t, "checker", sub.ApprovedBy())
+	assert.Equal(t, &apprAt, sub.ApprovedAt())
 	assert.Equal(t, 3, sub.Version())
 	assert.Empty(t, sub.DomainEvents())
 }
 
+func TestReportSubmission_ApprovalWorkflow(t *testing.T) {
+	now := time.Now().UTC()
+	sub, err := model.NewReportSubmission(uuid.New(), valueobject.ReportTypeCOREP, "2025-Q1")
+	require.NoError(t, err)
+	sub = sub.SetPreparer("maker")
+	sub, _ = sub.MarkGenerating(now)
+	sub, _ = sub.SetGenerated(validXBRL(), now)
+	sub = sub.ClearDomainEvents()
+
+	_, err = sub.Approve("maker", now)
+	assert.Error(t, err, "the preparer cannot approve their own report")
+	_, err = sub.RequestChanges("checker", " ", now)
+	assert.Error(t, err, "a comment is required")
+
+	sub, err = sub.RequestChanges("checker", "CET1 figure looks stale", now)
+	require.NoError(t, err)
+	assert.True(t, sub.Status().Equal(valueobject.SubmissionStatusChangesRequested))
+	changes, ok := sub.DomainEvents()[0].(event.ReportChangesRequested)
+	require.True(t, ok)
+	assert.Equal(t, "CET1 figure looks stale", changes.Comment)
+
+	_, err = sub.Approve("checker", now)
+	assert.Error(t, err, "only READY reports can be approved")
+
+	sub, err = sub.Revise(now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, sub.Status().Equal(valueobject.SubmissionStatusDraft))
+	assert.Empty(t, sub.XBRLContent())
+	assert.Nil(t, sub.GeneratedAt())
+
+	sub, _ = sub.MarkGenerating(now.Add(time.Hour))
+	sub, _ = sub.SetGenerated(validXBRL(), now.Add(time.Hour))
+	sub, err = sub.Approve("checker", now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.True(t, sub.Status().Equal(valueobject.SubmissionStatusApproved))
+	assert.Equal(t, "maker", sub.PreparedBy())
+	assert.Equal(t, "checker", sub.ApprovedBy())
+	require.NotNil(t, sub.ApprovedAt())
+	require.NoError(t, sub.CanSubmit())
+}
+
+func TestNewAmendment(t *testing.T) {
+	now := time.Now().UTC()
+	original, err := model.NewReportSubmission(uuid.New(), valueobject.ReportTypeCOREP, "2025-Q1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, original.Revision())
+	assert.Equal(t, original.ID(), original.OriginalID())
+	assert.False(t, original.IsAmendment())
+
+	_, err = model.NewAmendment(original, valueobject.AmendmentReasonDataCorrection, "")
+	assert.Error(t, err, "a report that was never filed cannot be amended")
+
+	original, _ = original.MarkGenerating(now)
+	original, _ = original.SetGenerated(validXBRL(), now)
+	original, _ = original.Approve("checker", now)
+	original, err = original.Submit(valueobject.SubmissionChannelAPI, "EBA-1", now)
+	require.NoError(t, err)
+
+	_, err = model.NewAmendment(original, valueobject.AmendmentReason{}, "")
+	assert.Error(t, err, "reason is required")
+	_, err = model.NewAmendment(original, valueobject.AmendmentReasonOther, "  ")
+	assert.Error(t, err, "OTHER requires a note")
+
+	amendment, err := model.NewAmendment(original, valueobject.AmendmentReasonLateAdjustment, " accrual posted after close ")
+	require.NoError(t, err)
+	assert.NotEqual(t, original.ID(), amendment.ID())
+	assert.Equal(t, original.ID(), amendment.OriginalID())
+	require.NotNil(t, amendment.AmendsID())
+	assert.Equal(t, original.ID(), *amendment.AmendsID())
+	assert.Equal(t, 2, amendment.Revision())
+	assert.Equal(t, "accrual posted after close", amendment.AmendmentNote())
+	assert.True(t, amendment.Status().Equal(valueobject.SubmissionStatusDraft))
+	assert.Equal(t, original.ReportingPeriod(), amendment.ReportingPeriod())
+
+	require.Len(t, amendment.DomainEvents(), 1)
+	amended, ok := amendment.DomainEvents()[0].(event.ReportAmended)
+	require.True(t, ok)
+	assert.Equal(t, "LATE_ADJUSTMENT", amended.Reason)
+	assert.Equal(t, 2, amended.Revision)
+}
+
 func TestReportSubmission_ClearDomainEvents(t *testing.T) {
 	tenantID := uuid.New()
 	now := time.Now().UTC()
@@ -0,0 +1,191 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/event"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+// ScheduledRun records a schedule's draft generation for one reporting period
+// and tracks the report against its submission deadline.
+type ScheduledRun struct {
+	deadline        time.Time
+	createdAt       time.Time
+	updatedAt       time.Time
+	reportID        *uuid.UUID
+	submittedAt     *time.Time
+	reportingPeriod string
+	failureReason   string
+	status          valueobject.RunStatus
+	reportType      valueobject.ReportType
+	domainEvents    []events.DomainEvent
+	attempts        int
+	version         int
+	id              uuid.UUID
+	scheduleID      uuid.UUID
+	tenantID        uuid.UUID
+}
+
+// NewScheduledRun creates a PENDING run of the schedule for a period.
+func NewScheduledRun(schedule ReportSchedule, period string, deadline, now time.Time) (ScheduledRun, error) {
+	if period == "" {
+		return ScheduledRun{}, fmt.Errorf("reporting period must not be empty")
+	}
+	return ScheduledRun{
+		id:              uuid.New(),
+		scheduleID:      schedule.ID(),
+		tenantID:        schedule.TenantID(),
+		reportType:      schedule.ReportType(),
+		reportingPeriod: period,
+		deadline:        deadline,
+		status:          valueobject.RunStatusPending,
+		version:         1,
+		createdAt:       now,
+		updatedAt:       now,
+	}, nil
+}
+
+// ReconstructScheduledRun recreates a ScheduledRun from persisted data without emitting events.
+func ReconstructScheduledRun(
+	id uuid.UUID,
+	scheduleID uuid.UUID,
+	tenantID uuid.UUID,
+	reportType valueobject.ReportType,
+	reportingPeriod string,
+	deadline time.Time,
+	status valueobject.RunStatus,
+	reportID *uuid.UUID,
+	attempts int,
+	failureReason string,
+	submittedAt *time.Time,
+	version int,
+	createdAt time.Time,
+	updatedAt time.Time,
+) ScheduledRun {
+	return ScheduledRun{
+		id:              id,
+		scheduleID:      scheduleID,
+		tenantID:        tenantID,
+		reportType:      reportType,
+		reportingPeriod: reportingPeriod,
+		deadline:        deadline,
+		status:          status,
+		reportID:        reportID,
+		attempts:        attempts,
+		failureReason:   failureReason,
+		submittedAt:     submittedAt,
+		version:         version,
+		createdAt:       createdAt,
+		updatedAt:       updatedAt,
+	}
+}
+
+// MarkGenerated records the draft generated for the run.
+func (r ScheduledRun) MarkGenerated(reportID uuid.UUID, now time.Time) (ScheduledRun, error) {
+	if !r.status.Equal(valueobject.RunStatusPending) && !r.status.Equal(valueobject.RunStatusFailed) {
+		return r, fmt.Errorf("cannot mark generated: current status is %s, expected PENDING or FAILED", r.status)
+	}
+	r.status = valueobject.RunStatusGenerated
+	r.reportID = &reportID
+	r.attempts++
+	r.failureReason = ""
+	r.version++
+	r.updatedAt = now
+	return r, nil
+}
+
+// MarkFailed records a failed generation attempt and raises an alert.
+func (r ScheduledRun) MarkFailed(reason string, now time.Time) (ScheduledRun, error) {
+	if !r.status.Equal(valueobject.RunStatusPending) && !r.status.Equal(valueobject.RunStatusFailed) {
+		return r, fmt.Errorf("cannot mark failed: current status is %s, expected PENDING or FAILED", r.status)
+	}
+	if reason == "" {
+		return r, fmt.Errorf("failure reason must not be empty")
+	}
+	r.status = valueobject.RunStatusFailed
+	r.attempts++
+	r.failureReason = reason
+	r.version++
+	r.updatedAt = now
+	r.domainEvents = append(r.domainEvents, event.NewScheduledReportFailed(
+		r.id, r.tenantID, r.scheduleID, r.reportType.String(), r.reportingPeriod, r.deadline, reason, r.attempts,
+	))
+	return r, nil
+}
+
+// MarkSubmitted records that the run's report was submitted to the regulator.
+// A report submitted after a missed deadline is still recorded, late.
+func (r ScheduledRun) MarkSubmitted(submittedAt, now time.Time) (ScheduledRun, error) {
+	if r.reportID == nil {
+		return r, fmt.Errorf("cannot mark submitted: run has no generated report")
+	}
+	if !r.status.Equal(valueobject.RunStatusGenerated) && !r.status.Equal(valueobject.RunStatusMissed) {
+		return r, fmt.Errorf("cannot mark submitted: current status is %s, expected GENERATED or MISSED", r.status)
+	}
+	r.status = valueobject.RunStatusSubmitted
+	r.submittedAt = &submittedAt
+	r.version++
+	r.updatedAt = now
+	return r, nil
+}
+
+// MarkMissed records that the deadline passed without a submission and raises an alert.
+func (r ScheduledRun) MarkMissed(now time.Time) (ScheduledRun, error) {
+	if !r.IsOverdue(now) {
+		return r, fmt.Errorf("cannot mark missed: run is not overdue")
+	}
+	r.status = valueobject.RunStatusMissed
+	r.version++
+	r.updatedAt = now
+	r.domainEvents = append(r.domainEvents, event.NewReportDeadlineMissed(
+		r.id, r.tenantID, r.scheduleID, r.reportID, r.reportType.String(), r.reportingPeriod, r.deadline,
+	))
+	return r, nil
+}
+
+// IsOverdue reports whether the deadline has passed as of now without the
+// report being submitted or already flagged as missed.
+func (r ScheduledRun) IsOverdue(now time.Time) bool {
+	if r.status.Equal(valueobject.RunStatusSubmitted) || r.status.Equal(valueobject.RunStatusMissed) {
+		return false
+	}
+	return now.After(r.deadline)
+}
+
+// SLAMet reports whether the report was submitted by its deadline.
+func (r ScheduledRun) SLAMet() bool {
+	return r.submittedAt != nil && !r.submittedAt.After(r.deadline)
+}
+
+// --- Accessors ---
+
+func (r ScheduledRun) ID() uuid.UUID                      { return r.id }
+func (r ScheduledRun) ScheduleID() uuid.UUID              { return r.scheduleID }
+func (r ScheduledRun) TenantID() uuid.UUID                { return r.tenantID }
+func (r ScheduledRun) ReportType() valueobject.ReportType { return r.reportType }
+func (r ScheduledRun) ReportingPeriod() string            { return r.reportingPeriod }
+func (r ScheduledRun) Deadline() time.Time                { return r.deadline }
+func (r ScheduledRun) Status() valueobject.RunStatus      { return r.status }
+func (r ScheduledRun) ReportID() *uuid.UUID               { return r.reportID }
+func (r ScheduledRun) Attempts() int                      { return r.attempts }
+func (r ScheduledRun) FailureReason() string              { return r.failureReason }
+func (r ScheduledRun) SubmittedAt() *time.Time            { return r.submittedAt }
+func (r ScheduledRun) Version() int                       { return r.version }
+func (r ScheduledRun) CreatedAt() time.Time               { return r.createdAt }
+func (r ScheduledRun) UpdatedAt() time.Time               { return r.updatedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (r ScheduledRun) DomainEvents() []events.DomainEvent {
+	return r.domainEvents
+}
+
+// ClearDomainEvents returns a copy with cleared domain events.
+func (r ScheduledRun) ClearDomainEvents() ScheduledRun {
+	r.domainEvents = nil
+	return r
+}
@@ -0,0 +1,123 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+// Submission audit actions.
+const (
+	AuditActionTransmitted        = "TRANSMITTED"
+	AuditActionTransmissionFailed = "TRANSMISSION_FAILED"
+	AuditActionAccepted           = "ACCEPTED"
+	AuditActionRejected           = "REJECTED"
+)
+
+var validAuditActions = map[string]bool{
+	AuditActionTransmitted:        true,
+	AuditActionTransmissionFailed: true,
+	AuditActionAccepted:           true,
+	AuditActionRejected:           true,
+}
+
+// SubmissionAuditEntry records one step in filing a report with the
+// regulator: a transmission attempt or the regulator's acknowledgment. The
+// filing's SHA-256 ties each entry to the exact content that was sent.
+// Entries are append-only.
+type SubmissionAuditEntry struct {
+	occurredAt    time.Time
+	action        string
+	reference     string
+	detail        string
+	actor         string
+	contentSHA256 string
+	channel       valueobject.SubmissionChannel
+	attempt       int
+	id            uuid.UUID
+	submissionID  uuid.UUID
+	tenantID      uuid.UUID
+}
+
+// NewSubmissionAuditEntry records action on the given attempt of filing
+// submission over channel.
+func NewSubmissionAuditEntry(
+	submission ReportSubmission,
+	channel valueobject.SubmissionChannel,
+	attempt int,
+	action, reference, detail, actor string,
+	now time.Time,
+) (SubmissionAuditEntry, error) {
+	if !validAuditActions[action] {
+		return SubmissionAuditEntry{}, fmt.Errorf("invalid submission audit action: %q", action)
+	}
+	if channel.IsZero() {
+		return SubmissionAuditEntry{}, fmt.Errorf("submission channel must not be empty")
+	}
+	if attempt < 1 {
+		return SubmissionAuditEntry{}, fmt.Errorf("attempt must be positive")
+	}
+
+	sum := sha256.Sum256([]byte(submission.XBRLContent()))
+	return SubmissionAuditEntry{
+		id:            uuid.New(),
+		submissionID:  submission.ID(),
+		tenantID:      submission.TenantID(),
+		channel:       channel,
+		attempt:       attempt,
+		action:        action,
+		reference:     reference,
+		detail:        detail,
+		actor:         actor,
+		contentSHA256: hex.EncodeToString(sum[:]),
+		occurredAt:    now,
+	}, nil
+}
+
+// ReconstructSubmissionAuditEntry recreates a SubmissionAuditEntry from persisted data.
+func ReconstructSubmissionAuditEntry(
+	id uuid.UUID,
+	submissionID uuid.UUID,
+	tenantID uuid.UUID,
+	channel valueobject.SubmissionChannel,
+	attempt int,
+	action string,
+	reference string,
+	detail string,
+	actor string,
+	contentSHA256 string,
+	occurredAt time.Time,
+) SubmissionAuditEntry {
+	return SubmissionAuditEntry{
+		id:            id,
+		submissionID:  submissionID,
+		tenantID:      tenantID,
+		channel:       channel,
+		attempt:       attempt,
+		action:        action,
+		reference:     reference,
+		detail:        detail,
+		actor:         actor,
+		contentSHA256: contentSHA256,
+		occurredAt:    occurredAt,
+	}
+}
+
+// --- Accessors ---
+
+func (e SubmissionAuditEntry) ID() uuid.UUID                          { return e.id }
+func (e SubmissionAuditEntry) SubmissionID() uuid.UUID                { return e.submissionID }
+func (e SubmissionAuditEntry) TenantID() uuid.UUID                    { return e.tenantID }
+func (e SubmissionAuditEntry) Channel() valueobject.SubmissionChannel { return e.channel }
+func (e SubmissionAuditEntry) Attempt() int                           { return e.attempt }
+func (e SubmissionAuditEntry) Action() string                         { return e.action }
+func (e SubmissionAuditEntry) Reference() string                      { return e.reference }
+func (e SubmissionAuditEntry) Detail() string                         { return e.detail }
+func (e SubmissionAuditEntry) Actor() string                          { return e.actor }
+func (e SubmissionAuditEntry) ContentSHA256() string                  { return e.contentSHA256 }
+func (e SubmissionAuditEntry) OccurredAt() time.Time                  { return e.occurredAt }
@@ -2,12 +2,16 @@ package port
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 
 	"github.com/bibbank/bib/services/reporting-service/internal/domain/event"
 	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
 	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
 )
 
 // ReportSubmissionRepository defines the persistence port for report submissions.
@@ -20,6 +24,79 @@ type ReportSubmissionRepository interface {
 	FindByTenantAndPeriod(ctx context.Context, tenantID uuid.UUID, period string) ([]model.ReportSubmission, error)
 	// FindByTenantAndType retrieves report submissions for a tenant and type.
 	FindByTenantAndType(ctx context.Context, tenantID uuid.UUID, reportType string) ([]model.ReportSubmission, error)
+	// ListAwaitingAcknowledgment retrieves the submissions filed over a
+	// channel that the regulator has not yet acknowledged.
+	ListAwaitingAcknowledgment(ctx context.Context, channel string) ([]model.ReportSubmission, error)
+	// FindVersions retrieves every version of the report first filed as
+	// originalID, ordered by revision.
+	FindVersions(ctx context.Context, originalID uuid.UUID) ([]model.ReportSubmission, error)
+}
+
+// SubmissionAuditRepository defines the persistence port for the append-only
+// submission audit trail.
+type SubmissionAuditRepository interface {
+	// Append records an audit entry.
+	Append(ctx context.Context, entry model.SubmissionAuditEntry) error
+	// ListBySubmission retrieves a submission's audit entries, oldest first.
+	ListBySubmission(ctx context.Context, submissionID uuid.UUID) ([]model.SubmissionAuditEntry, error)
+}
+
+// ReportReviewRepository defines the persistence port for the append-only
+// record of compliance reviews.
+type ReportReviewRepository interface {
+	// Append records a review.
+	Append(ctx context.Context, review model.ReportReview) error
+	// ListBySubmission retrieves a submission's reviews, oldest first.
+	ListBySubmission(ctx context.Context, submissionID uuid.UUID) ([]model.ReportReview, error)
+}
+
+// ErrScheduleNotFound is returned when a report schedule does not exist.
+var ErrScheduleNotFound = errors.New("report schedule not found")
+
+// ReportScheduleRepository defines the persistence port for report schedules.
+type ReportScheduleRepository interface {
+	// Save persists a new or updated report schedule.
+	Save(ctx context.Context, schedule model.ReportSchedule) error
+	// FindByTenantAndType retrieves a tenant's schedule for a report type.
+	// It returns ErrScheduleNotFound when there is none.
+	FindByTenantAndType(ctx context.Context, tenantID uuid.UUID, reportType string) (model.ReportSchedule, error)
+	// ListByTenant retrieves all schedules of a tenant.
+	ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]model.ReportSchedule, error)
+	// ListActive retrieves the active schedules of all tenants.
+	ListActive(ctx context.Context) ([]model.ReportSchedule, error)
+}
+
+// ErrReportJobNotFound is returned when a report job does not exist.
+var ErrReportJobNotFound = errors.New("report job not found")
+
+// ReportJobRepository defines the persistence port for report generation jobs.
+type ReportJobRepository interface {
+	// Save persists a new or updated report job.
+	Save(ctx context.Context, job model.ReportJob) error
+	// FindByID retrieves a tenant's job. It returns ErrReportJobNotFound
+	// when the tenant has no such job.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.ReportJob, error)
+	// ListByTenant retrieves a tenant's most recent jobs, newest first.
+	ListByTenant(ctx context.Context, tenantID uuid.UUID, limit int) ([]model.ReportJob, error)
+	// ClaimNext starts the oldest QUEUED job, or a RUNNING job that has not
+	// reported progress since staleBefore, so that exactly one worker runs
+	// it. It returns false when there is no job to run.
+	ClaimNext(ctx context.Context, now, staleBefore time.Time) (model.ReportJob, bool, error)
+}
+
+// ScheduledRunRepository defines the persistence port for scheduled report runs.
+type ScheduledRunRepository interface {
+	// Save persists a new or updated scheduled run.
+	Save(ctx context.Context, run model.ScheduledRun) error
+	// FindBySchedulePeriod retrieves the run of a schedule for a period, and
+	// false when the period has not been run.
+	FindBySchedulePeriod(ctx context.Context, scheduleID uuid.UUID, period string) (model.ScheduledRun, bool, error)
+	// ListByTenant retrieves a tenant's runs with deadlines from since
+	// onwards, nearest deadline first.
+	ListByTenant(ctx context.Context, tenantID uuid.UUID, since time.Time) ([]model.ScheduledRun, error)
+	// ListOpen retrieves runs that still need attention: pending, failed or
+	// generated runs, and missed runs with a report that may yet be submitted.
+	ListOpen(ctx context.Context) ([]model.ScheduledRun, error)
 }
 
 // EventPublisher defines the port for publishing domain events.
@@ -28,8 +105,178 @@ type EventPublisher interface {
 	Publish(ctx context.Context, events ...event.DomainEvent) error
 }
 
+// SubmissionPackage is a report filing handed to a SubmissionGateway.
+type SubmissionPackage struct {
+	ReportType      string
+	ReportingPeriod string
+	FileName        string
+	Content         []byte
+	Attempt         int
+	SubmissionID    uuid.UUID
+	TenantID        uuid.UUID
+}
+
+// Acknowledgment is the regulator's ACK or NACK of a transmitted filing.
+type Acknowledgment struct {
+	ReceivedAt time.Time
+	Reference  string
+	Errors     []string
+	Accepted   bool
+}
+
+// SubmissionGateway defines the port for filing reports with a regulator over
+// one channel.
+type SubmissionGateway interface {
+	// Channel returns the channel the gateway files through.
+	Channel() valueobject.SubmissionChannel
+	// Transmit hands the filing to the regulator and returns the reference
+	// its acknowledgment will carry.
+	Transmit(ctx context.Context, pkg SubmissionPackage) (string, error)
+	// PollAcknowledgments returns the acknowledgments received so far for the
+	// given references. Channels whose acknowledgments are recorded by an
+	// operator return none.
+	PollAcknowledgments(ctx context.Context, references []string) ([]Acknowledgment, error)
+}
+
 // LedgerDataClient defines the port for retrieving financial data from the ledger service.
 type LedgerDataClient interface {
 	// GetFinancialData retrieves aggregated financial data for a tenant and reporting period.
 	GetFinancialData(ctx context.Context, tenantID uuid.UUID, period string) (service.ReportData, error)
 }
+
+// TrialBalanceClient defines the port for retrieving the ledger's trial balance.
+type TrialBalanceClient interface {
+	// GetTrialBalance retrieves the per-account totals for a tenant and reporting period.
+	GetTrialBalance(ctx context.Context, tenantID uuid.UUID, period string) (service.TrialBalance, error)
+}
+
+// FraudCaseClient defines the port for retrieving fraud cases from the fraud service.
+type FraudCaseClient interface {
+	// ListConfirmedCases retrieves the tenant's cases resolved as confirmed
+	// fraud within [from, to), with their investigation notes. The cases'
+	// transactions are left empty; PaymentDataClient supplies them.
+	ListConfirmedCases(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]service.AMLCase, error)
+}
+
+// PaymentDataClient defines the port for retrieving payments from the payment service.
+type PaymentDataClient interface {
+	// GetPayment retrieves a single payment.
+	GetPayment(ctx context.Context, tenantID uuid.UUID, paymentID string) (service.AMLTransaction, error)
+	// ListSettledPayments retrieves the tenant's settled payments initiated
+	// within [from, to).
+	ListSettledPayments(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]service.AMLTransaction, error)
+	// ListPayments retrieves the tenant's payments, in any status, created
+	// within [from, to).
+	ListPayments(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]service.PaymentRecord, error)
+}
+
+// AccountDataClient defines the port for retrieving customer accounts from the account service.
+type AccountDataClient interface {
+	// ListAccounts retrieves all of the tenant's accounts.
+	ListAccounts(ctx context.Context, tenantID uuid.UUID) ([]service.AccountRecord, error)
+}
+
+// JournalEntryClient defines the port for retrieving journal entries from the ledger service.
+type JournalEntryClient interface {
+	// ListJournalEntries retrieves the tenant's journal entries effective
+	// within [from, to).
+	ListJournalEntries(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]service.JournalEntryRecord, error)
+}
+
+// LedgerBalanceClient defines the port for retrieving account balances from the ledger service.
+type LedgerBalanceClient interface {
+	// GetBalance retrieves the ledger's current balance of an account in a
+	// currency, signed debit-positive.
+	GetBalance(ctx context.Context, accountCode, currency string) (decimal.Decimal, error)
+}
+
+// CardDataClient defines the port for retrieving cards from the card service.
+type CardDataClient interface {
+	// ListCards retrieves all of the tenant's cards with their limits and
+	// the spend authorized against them.
+	ListCards(ctx context.Context, tenantID uuid.UUID) ([]service.CardExposure, error)
+}
+
+// TenantCredentials defines the port for authenticating background jobs to
+// other services on a tenant's behalf.
+type TenantCredentials interface {
+	// ForTenant returns a context whose outgoing calls are authorized for the tenant.
+	ForTenant(ctx context.Context, tenantID uuid.UUID) (context.Context, error)
+}
+
+// ErrProjectionNotFound is returned when the dashboard read model holds no
+// record of an aggregate.
+var ErrProjectionNotFound = errors.New("dashboard projection not found")
+
+// DashboardReadModel defines the persistence port for the dashboard read
+// model: a projection of other services' events, kept so that dashboards
+// never query those services.
+type DashboardReadModel interface {
+	// FindDepositPosition retrieves a deposit position. It returns
+	// ErrProjectionNotFound when there is none.
+	FindDepositPosition(ctx context.Context, id uuid.UUID) (service.DepositPosition, error)
+	// SaveDepositPosition persists a new or updated deposit position.
+	SaveDepositPosition(ctx context.Context, position service.DepositPosition) error
+	// ListDepositPositions retrieves all of a tenant's deposit positions, closed ones included.
+	ListDepositPositions(ctx context.Context, tenantID uuid.UUID) ([]service.DepositPosition, error)
+
+	// FindLoanPosition retrieves a loan. It returns ErrProjectionNotFound when there is none.
+	FindLoanPosition(ctx context.Context, id string) (service.LoanPosition, error)
+	// SaveLoanPosition persists a new or updated loan.
+	SaveLoanPosition(ctx context.Context, loan service.LoanPosition) error
+	// ListLoanPositions retrieves a tenant's loans that are not paid off.
+	ListLoanPositions(ctx context.Context, tenantID uuid.UUID) ([]service.LoanPosition, error)
+	// ListAllLoanPositions retrieves all of a tenant's loans, paid-off ones included.
+	ListAllLoanPositions(ctx context.Context, tenantID uuid.UUID) ([]service.LoanPosition, error)
+
+	// FindPayment retrieves a payment. It returns ErrProjectionNotFound when there is none.
+	FindPayment(ctx context.Context, id uuid.UUID) (service.PaymentFact, error)
+	// SavePayment persists a new or updated payment.
+	SavePayment(ctx context.Context, payment service.PaymentFact) error
+	// ListPayments retrieves a tenant's payments initiated within [from, to).
+	ListPayments(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]service.PaymentFact, error)
+
+	// SaveFraudDecision records a fraud assessment's decision. Recording the
+	// same assessment again replaces it.
+	SaveFraudDecision(ctx context.Context, decision service.FraudDecisionFact) error
+	// ListFraudDecisions retrieves a tenant's fraud decisions made within [from, to).
+	ListFraudDecisions(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]service.FraudDecisionFact, error)
+}
+
+// ErrObjectNotFound is returned when object storage has no object under a key.
+var ErrObjectNotFound = errors.New("object not found")
+
+// ObjectStore defines the port for object storage: the data warehouse's, and
+// the artifacts of report jobs.
+type ObjectStore interface {
+	// Put writes data under key, replacing any object already there.
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get reads the object under key. It returns ErrObjectNotFound when
+	// there is none.
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// ErrSchemaNotFound is returned when a warehouse dataset has not been exported yet.
+var ErrSchemaNotFound = errors.New("warehouse schema not found")
+
+// WarehouseRepository defines the persistence port for the warehouse
+// export's schemas and landed partitions.
+type WarehouseRepository interface {
+	// FindSchema retrieves the schema a dataset was last exported with. It
+	// returns ErrSchemaNotFound when the dataset has not been exported.
+	FindSchema(ctx context.Context, dataset string) (service.WarehouseSchema, error)
+	// SaveSchema persists a dataset's schema.
+	SaveSchema(ctx context.Context, schema service.WarehouseSchema) error
+	// HasExport reports whether a tenant's partition of a dataset has been
+	// landed for a day.
+	HasExport(ctx context.Context, dataset string, tenantID uuid.UUID, day time.Time) (bool, error)
+	// SaveExport records a landed partition.
+	SaveExport(ctx context.Context, export service.WarehouseExport) error
+}
+
+// ConsistencyReportRepository defines the persistence port for the
+// consistency checker's discrepancy reports.
+type ConsistencyReportRepository interface {
+	// Save persists a report.
+	Save(ctx context.Context, report service.ConsistencyReport) error
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// AMLTransaction is a payment reported in an AML report.
+type AMLTransaction struct {
+	Date        time.Time
+	Amount      decimal.Decimal
+	ID          string
+	Reference   string
+	Currency    string
+	FromAccount string
+	ToAccount   string
+	Rail        string
+	Description string
+}
+
+// AMLCase is a fraud case confirmed as suspicious activity, with the payments
+// it concerns. Cases opened by AML monitoring carry the Scenario that raised
+// them and the AlertTransactionIDs of the payments making up the pattern.
+type AMLCase struct {
+	ResolvedAt          time.Time
+	ID                  string
+	AccountID           string
+	TransactionID       string
+	RiskLevel           string
+	Scenario            string
+	Notes               []string
+	AlertTransactionIDs []string
+	Transactions        []AMLTransaction
+	RiskScore           int
+}
+
+// AMLReportData holds the data needed to generate an AML report: the confirmed
+// cases for a suspicious activity report, or the reportable payments for a
+// currency transaction report.
+type AMLReportData struct {
+	Period       string
+	Cases        []AMLCase
+	Transactions []AMLTransaction
+	TenantID     uuid.UUID
+}
+
+// CaseIDs returns the IDs of the cases the report was built from.
+func (d AMLReportData) CaseIDs() []string {
+	ids := make([]string, 0, len(d.Cases))
+	for _, c := range d.Cases {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
+// PeriodRange returns the half-open interval [start, end) covered by a
+// reporting period: a day (YYYY-MM-DD), a month (YYYY-MM) or a quarter
+// (YYYY-Qn).
+func PeriodRange(period string) (start, end time.Time, err error) {
+	if day, err := time.Parse("2006-01-02", period); err == nil {
+		return day, day.AddDate(0, 0, 1), nil
+	}
+	if month, err := time.Parse("2006-01", period); err == nil {
+		return month, month.AddDate(0, 1, 0), nil
+	}
+	year, quarter, ok := strings.Cut(period, "-Q")
+	y, errY := strconv.Atoi(year)
+	q, errQ := strconv.Atoi(quarter)
+	if !ok || errY != nil || errQ != nil || len(year) != 4 || q < 1 || q > 4 {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid reporting period %q", period)
+	}
+	start = time.Date(y, time.Month(3*(q-1)+1), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 3, 0), nil
+}
+
+// SelectCurrencyTransactions returns the payments a currency transaction
+// report must include: every payment of an account on a day when that
+// account's payments in a currency reach the threshold. Payments are
+// aggregated per day so that structured transactions just under the threshold
+// are still reported.
+func SelectCurrencyTransactions(payments []AMLTransaction, threshold decimal.Decimal) []AMLTransaction {
+	type key struct {
+		account  string
+		currency string
+		day      string
+	}
+	totals := make(map[key]decimal.Decimal)
+	keyOf := func(p AMLTransaction) key {
+		return key{account: p.FromAccount, currency: p.Currency, day: p.Date.UTC().Format("2006-01-02")}
+	}
+	for _, p := range payments {
+		totals[keyOf(p)] = totals[keyOf(p)].Add(p.Amount)
+	}
+
+	var selected []AMLTransaction
+	for _, p := range payments {
+		if totals[keyOf(p)].GreaterThanOrEqual(threshold) {
+			selected = append(selected, p)
+		}
+	}
+	sort.SliceStable(selected, func(i, j int) bool { return selected[i].Date.Before(selected[j].Date) })
+	return selected
+}
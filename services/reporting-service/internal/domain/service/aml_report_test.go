@@ -0,0 +1,125 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+func TestPeriodRange(t *testing.T) {
+	tests := []struct {
+		period     string
+		start, end time.Time
+	}{
+		{"2025-03-14", time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{"2025-12", time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"2025-Q2", time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.period, func(t *testing.T) {
+			start, end, err := service.PeriodRange(tt.period)
+			require.NoError(t, err)
+			assert.Equal(t, tt.start, start)
+			assert.Equal(t, tt.end, end)
+		})
+	}
+
+	for _, invalid := range []string{"", "2025", "2025-Q5", "25-Q1", "2025-13"} {
+		_, _, err := service.PeriodRange(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestSelectCurrencyTransactions(t *testing.T) {
+	day := time.Date(2025, 3, 14, 9, 0, 0, 0, time.UTC)
+	payment := func(id, account string, amount int64, at time.Time) service.AMLTransaction {
+		return service.AMLTransaction{ID: id, FromAccount: account, Currency: "EUR", Amount: decimal.NewFromInt(amount), Date: at}
+	}
+	payments := []service.AMLTransaction{
+		payment("large", "acc-1", 12_000, day),
+		// Structured just under the threshold, on the same day.
+		payment("split-2", "acc-2", 6_000, day.Add(3*time.Hour)),
+		payment("split-1", "acc-2", 5_000, day.Add(time.Hour)),
+		payment("small", "acc-3", 9_999, day),
+		// Same account, next day: totals are per day.
+		payment("next-day", "acc-2", 5_000, day.AddDate(0, 0, 1)),
+	}
+
+	selected := service.SelectCurrencyTransactions(payments, decimal.NewFromInt(10_000))
+
+	ids := make([]string, 0, len(selected))
+	for _, p := range selected {
+		ids = append(ids, p.ID)
+	}
+	assert.Equal(t, []string{"large", "split-1", "split-2"}, ids)
+}
+
+func TestGoAMLGenerator_SuspiciousActivityReport(t *testing.T) {
+	generator := service.NewGoAMLGenerator("4711", "BIB Bank", "EUR")
+	resolved := time.Date(2025, 3, 20, 16, 0, 0, 0, time.UTC)
+	data := service.AMLReportData{
+		Period:   "2025-03",
+		TenantID: uuid.New(),
+		Cases: []service.AMLCase{{
+			ID:         "case-1",
+			RiskLevel:  "HIGH",
+			RiskScore:  91,
+			ResolvedAt: resolved,
+			Notes:      []string{"Mule account pattern confirmed."},
+			Transactions: []service.AMLTransaction{{
+				ID: "pay-1", Amount: decimal.RequireFromString("2500.5"), Currency: "USD",
+				FromAccount: "acc-1", ToAccount: "ext-9", Rail: "ACH", Date: resolved.AddDate(0, 0, -3),
+			}},
+		}},
+	}
+
+	content, err := generator.Generate(valueobject.ReportTypeSAR, "report-1", data, resolved)
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(content, "<?xml"))
+	for _, want := range []string{
+		"<rentity_id>4711</rentity_id>",
+		"<submission_code>E</submission_code>",
+		"<report_code>STR</report_code>",
+		"<entity_reference>report-1</entity_reference>",
+		"<currency_code_local>EUR</currency_code_local>",
+		"<transactionnumber>pay-1</transactionnumber>",
+		"<internal_ref_number>case-1</internal_ref_number>",
+		"<amount_local>2500.50</amount_local>",
+		"<foreign_currency_code>USD</foreign_currency_code>",
+		"<institution_name>BIB Bank</institution_name>",
+		"Mule account pattern confirmed.",
+		"<indicator>RISK_HIGH</indicator>",
+	} {
+		assert.Contains(t, content, want)
+	}
+	// The sender's funds and account share one t_from_my_client element.
+	assert.Equal(t, 1, strings.Count(content, "<t_from_my_client>"))
+}
+
+func TestGoAMLGenerator_CurrencyTransactionReport(t *testing.T) {
+	generator := service.NewGoAMLGenerator("4711", "BIB Bank", "EUR")
+	content, err := generator.Generate(valueobject.ReportTypeCTR, "report-2", service.AMLReportData{
+		Period: "2025-03-14",
+		Transactions: []service.AMLTransaction{{
+			ID: "pay-2", Amount: decimal.NewFromInt(15_000), Currency: "EUR", Reference: "INV-7",
+			FromAccount: "acc-1", ToAccount: "acc-2", Rail: "SEPA", Date: time.Now(),
+		}},
+	}, time.Now())
+	require.NoError(t, err)
+
+	assert.Contains(t, content, "<report_code>CTR</report_code>")
+	assert.Contains(t, content, "<comments>Reference: INV-7</comments>")
+	assert.NotContains(t, content, "foreign_currency")
+
+	_, err = generator.Generate(valueobject.ReportTypeCOREP, "report-3", service.AMLReportData{}, time.Now())
+	assert.Error(t, err)
+}
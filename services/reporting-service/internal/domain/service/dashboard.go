@@ -0,0 +1,318 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Loan book buckets, by the repayment status reported by the lending service.
+const (
+	LoanBucketCurrent    = "CURRENT"
+	LoanBucketDelinquent = "DELINQUENT"
+	LoanBucketDefault    = "DEFAULT"
+	LoanBucketPaidOff    = "PAID_OFF"
+)
+
+// Payment statuses tracked by the dashboard read model.
+const (
+	PaymentStatusInitiated = "INITIATED"
+	PaymentStatusSettled   = "SETTLED"
+	PaymentStatusFailed    = "FAILED"
+	PaymentStatusReversed  = "REVERSED"
+)
+
+// Deposit growth granularities.
+const (
+	GranularityDay   = "DAY"
+	GranularityMonth = "MONTH"
+)
+
+// DepositPosition is the dashboard read model's view of a deposit position.
+// UpdatedAt is the time of the last event applied to it.
+type DepositPosition struct {
+	OpenedAt        time.Time
+	UpdatedAt       time.Time
+	ClosedAt        *time.Time
+	Currency        string
+	Principal       decimal.Decimal
+	AccruedInterest decimal.Decimal
+	ID              uuid.UUID
+	TenantID        uuid.UUID
+	AccountID       uuid.UUID
+}
+
+// LoanPosition is the dashboard read model's view of a disbursed loan.
+type LoanPosition struct {
+	DisbursedAt time.Time
+	UpdatedAt   time.Time
+	ID          string
+	Currency    string
+	Bucket      string
+	Principal   decimal.Decimal
+	Outstanding decimal.Decimal
+	TenantID    uuid.UUID
+}
+
+// PaymentFact is the dashboard read model's view of a payment order.
+type PaymentFact struct {
+	InitiatedAt time.Time
+	UpdatedAt   time.Time
+	Rail        string
+	Currency    string
+	Status      string
+	Amount      decimal.Decimal
+	ID          uuid.UUID
+	TenantID    uuid.UUID
+}
+
+// FraudDecisionFact records the decision of one fraud assessment.
+type FraudDecisionFact struct {
+	AssessedAt   time.Time
+	Decision     string
+	AssessmentID uuid.UUID
+	TenantID     uuid.UUID
+}
+
+// BalanceSheetLine summarizes the customer book in one currency.
+type BalanceSheetLine struct {
+	Currency        string
+	Loans           decimal.Decimal
+	Deposits        decimal.Decimal
+	AccruedInterest decimal.Decimal
+	LoanToDeposit   decimal.Decimal
+	LoanCount       int
+	DepositCount    int
+}
+
+// DepositGrowthPoint holds the deposit flows of one day or month in one
+// currency and the principal on book at its end.
+type DepositGrowthPoint struct {
+	Period         string
+	Currency       string
+	Opened         decimal.Decimal
+	Closed         decimal.Decimal
+	Net            decimal.Decimal
+	ClosingBalance decimal.Decimal
+}
+
+// LoanBookBucket holds the loans in one bucket and currency.
+type LoanBookBucket struct {
+	Bucket      string
+	Currency    string
+	Outstanding decimal.Decimal
+	Count       int
+}
+
+// RailVolume holds the payment volumes of one rail and currency.
+type RailVolume struct {
+	Rail          string
+	Currency      string
+	SettledAmount decimal.Decimal
+	Initiated     int
+	Settled       int
+	Failed        int
+	Reversed      int
+}
+
+// DecisionRate holds the share of assessments that received a decision.
+type DecisionRate struct {
+	Decision string
+	Rate     decimal.Decimal
+	Count    int
+}
+
+// SummarizeBalanceSheet summarizes the loans and deposits currently on book
+// by currency. Loans are assets and deposits, with their accrued interest,
+// liabilities.
+func SummarizeBalanceSheet(deposits []DepositPosition, loans []LoanPosition) []BalanceSheetLine {
+	lines := make(map[string]*BalanceSheetLine)
+	line := func(currency string) *BalanceSheetLine {
+		if l, ok := lines[currency]; ok {
+			return l
+		}
+		l := &BalanceSheetLine{Currency: currency, Loans: decimal.Zero, Deposits: decimal.Zero, AccruedInterest: decimal.Zero}
+		lines[currency] = l
+		return l
+	}
+	for _, d := range deposits {
+		if d.ClosedAt != nil {
+			continue
+		}
+		l := line(d.Currency)
+		l.Deposits = l.Deposits.Add(d.Principal).Add(d.AccruedInterest)
+		l.AccruedInterest = l.AccruedInterest.Add(d.AccruedInterest)
+		l.DepositCount++
+	}
+	for _, loan := range loans {
+		if loan.Bucket == LoanBucketPaidOff {
+			continue
+		}
+		l := line(loan.Currency)
+		l.Loans = l.Loans.Add(loan.Outstanding)
+		l.LoanCount++
+	}
+
+	out := make([]BalanceSheetLine, 0, len(lines))
+	for _, l := range lines {
+		l.LoanToDeposit = ratio(l.Loans, l.Deposits)
+		out = append(out, *l)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Currency < out[j].Currency })
+	return out
+}
+
+// DepositGrowth returns the principal opened and closed in each day or month
+// of [from, to), by currency, with the principal on book at the end of each.
+func DepositGrowth(deposits []DepositPosition, from, to time.Time, granularity string) ([]DepositGrowthPoint, error) {
+	var (
+		next   func(time.Time) time.Time
+		layout string
+	)
+	switch granularity {
+	case GranularityDay:
+		from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+		next, layout = func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }, "2006-01-02"
+	case GranularityMonth:
+		from = time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.UTC)
+		next, layout = func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }, "2006-01"
+	default:
+		return nil, fmt.Errorf("unsupported granularity %q", granularity)
+	}
+
+	currencies := make(map[string]bool)
+	for _, d := range deposits {
+		currencies[d.Currency] = true
+	}
+	sorted := make([]string, 0, len(currencies))
+	for c := range currencies {
+		sorted = append(sorted, c)
+	}
+	sort.Strings(sorted)
+
+	var points []DepositGrowthPoint
+	for start := from; start.Before(to); start = next(start) {
+		end := next(start)
+		for _, currency := range sorted {
+			p := DepositGrowthPoint{
+				Period: start.Format(layout), Currency: currency,
+				Opened: decimal.Zero, Closed: decimal.Zero, ClosingBalance: decimal.Zero,
+			}
+			for _, d := range deposits {
+				if d.Currency != currency {
+					continue
+				}
+				if !d.OpenedAt.Before(start) && d.OpenedAt.Before(end) {
+					p.Opened = p.Opened.Add(d.Principal)
+				}
+				if d.ClosedAt != nil && !d.ClosedAt.Before(start) && d.ClosedAt.Before(end) {
+					p.Closed = p.Closed.Add(d.Principal)
+				}
+				if d.onBook(end.Add(-time.Nanosecond)) {
+					p.ClosingBalance = p.ClosingBalance.Add(d.Principal)
+				}
+			}
+			p.Net = p.Opened.Sub(p.Closed)
+			points = append(points, p)
+		}
+	}
+	return points, nil
+}
+
+// BucketLoanBook groups the loans still on book by bucket and currency.
+func BucketLoanBook(loans []LoanPosition) []LoanBookBucket {
+	type key struct{ bucket, currency string }
+	buckets := make(map[key]*LoanBookBucket)
+	for _, loan := range loans {
+		if loan.Bucket == LoanBucketPaidOff {
+			continue
+		}
+		k := key{loan.Bucket, loan.Currency}
+		b, ok := buckets[k]
+		if !ok {
+			b = &LoanBookBucket{Bucket: loan.Bucket, Currency: loan.Currency, Outstanding: decimal.Zero}
+			buckets[k] = b
+		}
+		b.Outstanding = b.Outstanding.Add(loan.Outstanding)
+		b.Count++
+	}
+
+	order := map[string]int{LoanBucketCurrent: 0, LoanBucketDelinquent: 1, LoanBucketDefault: 2}
+	out := make([]LoanBookBucket, 0, len(buckets))
+	for _, b := range buckets {
+		out = append(out, *b)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Bucket != out[j].Bucket {
+			return order[out[i].Bucket] < order[out[j].Bucket]
+		}
+		return out[i].Currency < out[j].Currency
+	})
+	return out
+}
+
+// PaymentVolumesByRail counts payments by rail and currency and totals the
+// amounts settled. Payments are counted by their current status.
+func PaymentVolumesByRail(payments []PaymentFact) []RailVolume {
+	type key struct{ rail, currency string }
+	volumes := make(map[key]*RailVolume)
+	for _, p := range payments {
+		k := key{p.Rail, p.Currency}
+		v, ok := volumes[k]
+		if !ok {
+			v = &RailVolume{Rail: p.Rail, Currency: p.Currency, SettledAmount: decimal.Zero}
+			volumes[k] = v
+		}
+		v.Initiated++
+		switch p.Status {
+		case PaymentStatusSettled:
+			v.Settled++
+			v.SettledAmount = v.SettledAmount.Add(p.Amount)
+		case PaymentStatusFailed:
+			v.Failed++
+		case PaymentStatusReversed:
+			v.Reversed++
+		}
+	}
+
+	out := make([]RailVolume, 0, len(volumes))
+	for _, v := range volumes {
+		out = append(out, *v)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Rail != out[j].Rail {
+			return out[i].Rail < out[j].Rail
+		}
+		return out[i].Currency < out[j].Currency
+	})
+	return out
+}
+
+// FraudDecisionRates returns the share of assessments that received each
+// decision, and the number of assessments.
+func FraudDecisionRates(decisions []FraudDecisionFact) ([]DecisionRate, int) {
+	counts := make(map[string]int)
+	for _, d := range decisions {
+		counts[d.Decision]++
+	}
+	total := decimal.NewFromInt(int64(len(decisions)))
+
+	out := make([]DecisionRate, 0, len(counts))
+	for decision, count := range counts {
+		out = append(out, DecisionRate{
+			Decision: decision,
+			Count:    count,
+			Rate:     ratio(decimal.NewFromInt(int64(count)), total),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Decision < out[j].Decision })
+	return out, len(decisions)
+}
+
+// onBook reports whether the position was open at t.
+func (d DepositPosition) onBook(t time.Time) bool {
+	return !d.OpenedAt.After(t) && (d.ClosedAt == nil || d.ClosedAt.After(t))
+}
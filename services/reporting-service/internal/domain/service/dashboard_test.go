@@ -0,0 +1,132 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+func day(d int) time.Time {
+	return time.Date(2025, time.March, d, 12, 0, 0, 0, time.UTC)
+}
+
+func TestSummarizeBalanceSheet(t *testing.T) {
+	closed := day(3)
+	deposits := []service.DepositPosition{
+		{Currency: "USD", Principal: decimal.NewFromInt(1000), AccruedInterest: decimal.NewFromInt(10), OpenedAt: day(1)},
+		{Currency: "USD", Principal: decimal.NewFromInt(500), AccruedInterest: decimal.Zero, OpenedAt: day(1), ClosedAt: &closed},
+		{Currency: "EUR", Principal: decimal.NewFromInt(200), AccruedInterest: decimal.Zero, OpenedAt: day(2)},
+	}
+	loans := []service.LoanPosition{
+		{Currency: "USD", Bucket: service.LoanBucketCurrent, Outstanding: decimal.NewFromInt(505)},
+		{Currency: "USD", Bucket: service.LoanBucketPaidOff, Outstanding: decimal.Zero},
+	}
+
+	lines := service.SummarizeBalanceSheet(deposits, loans)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "EUR", lines[0].Currency)
+	assert.True(t, lines[0].LoanToDeposit.IsZero())
+
+	usd := lines[1]
+	assert.True(t, usd.Deposits.Equal(decimal.NewFromInt(1010)), usd.Deposits.String())
+	assert.True(t, usd.AccruedInterest.Equal(decimal.NewFromInt(10)), usd.AccruedInterest.String())
+	assert.True(t, usd.Loans.Equal(decimal.NewFromInt(505)), usd.Loans.String())
+	assert.Equal(t, "0.5000", usd.LoanToDeposit.StringFixed(4))
+	assert.Equal(t, 1, usd.LoanCount)
+	assert.Equal(t, 1, usd.DepositCount)
+}
+
+func TestDepositGrowth_Daily(t *testing.T) {
+	closed := day(2)
+	deposits := []service.DepositPosition{
+		{Currency: "USD", Principal: decimal.NewFromInt(100), OpenedAt: day(1)},
+		{Currency: "USD", Principal: decimal.NewFromInt(40), OpenedAt: day(1), ClosedAt: &closed},
+		{Currency: "USD", Principal: decimal.NewFromInt(60), OpenedAt: day(3)},
+	}
+	from := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	points, err := service.DepositGrowth(deposits, from, from.AddDate(0, 0, 3), service.GranularityDay)
+	require.NoError(t, err)
+	require.Len(t, points, 3)
+
+	assert.Equal(t, "2025-03-01", points[0].Period)
+	assert.True(t, points[0].Opened.Equal(decimal.NewFromInt(140)), points[0].Opened.String())
+	assert.True(t, points[0].ClosingBalance.Equal(decimal.NewFromInt(140)), points[0].ClosingBalance.String())
+
+	assert.True(t, points[1].Closed.Equal(decimal.NewFromInt(40)), points[1].Closed.String())
+	assert.True(t, points[1].Net.Equal(decimal.NewFromInt(-40)), points[1].Net.String())
+	assert.True(t, points[1].ClosingBalance.Equal(decimal.NewFromInt(100)), points[1].ClosingBalance.String())
+
+	assert.True(t, points[2].ClosingBalance.Equal(decimal.NewFromInt(160)), points[2].ClosingBalance.String())
+}
+
+func TestDepositGrowth_Monthly(t *testing.T) {
+	deposits := []service.DepositPosition{{Currency: "USD", Principal: decimal.NewFromInt(100), OpenedAt: day(15)}}
+	from := time.Date(2025, time.February, 10, 0, 0, 0, 0, time.UTC)
+
+	points, err := service.DepositGrowth(deposits, from, from.AddDate(0, 2, 0), service.GranularityMonth)
+	require.NoError(t, err)
+	require.Len(t, points, 3)
+	assert.Equal(t, []string{"2025-02", "2025-03", "2025-04"}, []string{points[0].Period, points[1].Period, points[2].Period})
+	assert.True(t, points[1].Opened.Equal(decimal.NewFromInt(100)))
+
+	_, err = service.DepositGrowth(deposits, from, from.AddDate(0, 1, 0), "WEEK")
+	assert.Error(t, err)
+}
+
+func TestBucketLoanBook(t *testing.T) {
+	loans := []service.LoanPosition{
+		{Currency: "USD", Bucket: service.LoanBucketDefault, Outstanding: decimal.NewFromInt(70)},
+		{Currency: "USD", Bucket: service.LoanBucketCurrent, Outstanding: decimal.NewFromInt(100)},
+		{Currency: "USD", Bucket: service.LoanBucketCurrent, Outstanding: decimal.NewFromInt(50)},
+		{Currency: "USD", Bucket: service.LoanBucketPaidOff, Outstanding: decimal.Zero},
+	}
+
+	buckets := service.BucketLoanBook(loans)
+	require.Len(t, buckets, 2)
+	assert.Equal(t, service.LoanBucketCurrent, buckets[0].Bucket)
+	assert.Equal(t, 2, buckets[0].Count)
+	assert.True(t, buckets[0].Outstanding.Equal(decimal.NewFromInt(150)))
+	assert.Equal(t, service.LoanBucketDefault, buckets[1].Bucket)
+}
+
+func TestPaymentVolumesByRail(t *testing.T) {
+	payments := []service.PaymentFact{
+		{Rail: "ACH", Currency: "USD", Status: service.PaymentStatusSettled, Amount: decimal.NewFromInt(100)},
+		{Rail: "ACH", Currency: "USD", Status: service.PaymentStatusFailed, Amount: decimal.NewFromInt(50)},
+		{Rail: "FEDNOW", Currency: "USD", Status: service.PaymentStatusInitiated, Amount: decimal.NewFromInt(10)},
+	}
+
+	volumes := service.PaymentVolumesByRail(payments)
+	require.Len(t, volumes, 2)
+	ach := volumes[0]
+	assert.Equal(t, "ACH", ach.Rail)
+	assert.Equal(t, 2, ach.Initiated)
+	assert.Equal(t, 1, ach.Settled)
+	assert.Equal(t, 1, ach.Failed)
+	assert.True(t, ach.SettledAmount.Equal(decimal.NewFromInt(100)))
+	assert.Equal(t, 1, volumes[1].Initiated)
+	assert.True(t, volumes[1].SettledAmount.IsZero())
+}
+
+func TestFraudDecisionRates(t *testing.T) {
+	decisions := []service.FraudDecisionFact{
+		{Decision: "APPROVE"}, {Decision: "APPROVE"}, {Decision: "APPROVE"}, {Decision: "DECLINE"},
+	}
+
+	rates, total := service.FraudDecisionRates(decisions)
+	assert.Equal(t, 4, total)
+	require.Len(t, rates, 2)
+	assert.Equal(t, "APPROVE", rates[0].Decision)
+	assert.Equal(t, "0.7500", rates[0].Rate.StringFixed(4))
+	assert.Equal(t, "0.2500", rates[1].Rate.StringFixed(4))
+
+	rates, total = service.FraudDecisionRates(nil)
+	assert.Empty(t, rates)
+	assert.Zero(t, total)
+}
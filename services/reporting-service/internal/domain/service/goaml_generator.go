@@ -0,0 +1,170 @@
+package service
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+// goAML codes used by the generated reports.
+const (
+	goAMLSubmissionElectronic = "E"
+	goAMLReportSTR            = "STR"
+	goAMLReportCTR            = "CTR"
+	goAMLDateFormat           = "2006-01-02T15:04:05"
+	goAMLFundsBankTransfer    = "K"
+)
+
+// GoAMLGenerator is a domain service that generates goAML XML for AML reports
+// filed with the financial intelligence unit.
+type GoAMLGenerator struct {
+	reportingEntityID string
+	institutionName   string
+	localCurrency     string
+}
+
+// NewGoAMLGenerator creates a new GoAMLGenerator. reportingEntityID is the
+// rentity_id the financial intelligence unit registered the bank under.
+func NewGoAMLGenerator(reportingEntityID, institutionName, localCurrency string) *GoAMLGenerator {
+	return &GoAMLGenerator{
+		reportingEntityID: reportingEntityID,
+		institutionName:   institutionName,
+		localCurrency:     localCurrency,
+	}
+}
+
+type goAMLReport struct {
+	XMLName           xml.Name           `xml:"report"`
+	RentityID         string             `xml:"rentity_id"`
+	SubmissionCode    string             `xml:"submission_code"`
+	ReportCode        string             `xml:"report_code"`
+	EntityReference   string             `xml:"entity_reference"`
+	SubmissionDate    string             `xml:"submission_date"`
+	CurrencyCodeLocal string             `xml:"currency_code_local"`
+	Reason            string             `xml:"reason,omitempty"`
+	Action            string             `xml:"action,omitempty"`
+	Transactions      []goAMLTransaction `xml:"transaction"`
+	Indicators        []string           `xml:"report_indicators>indicator,omitempty"`
+}
+
+// goAMLTransaction fields are in schema order, which encoding/xml follows.
+type goAMLTransaction struct {
+	TransactionNumber string                `xml:"transactionnumber"`
+	InternalRef       string                `xml:"internal_ref_number,omitempty"`
+	Description       string                `xml:"transaction_description,omitempty"`
+	Date              string                `xml:"date_transaction"`
+	TransmodeCode     string                `xml:"transmode_code"`
+	AmountLocal       string                `xml:"amount_local"`
+	FromFundsCode     string                `xml:"t_from_my_client>from_funds_code"`
+	ForeignCurrency   *goAMLForeignCurrency `xml:"t_from_my_client>from_foreign_currency,omitempty"`
+	FromAccount       goAMLAccount          `xml:"t_from_my_client>from_account"`
+	ToFundsCode       string                `xml:"t_to>to_funds_code"`
+	ToAccount         goAMLAccount          `xml:"t_to>to_account"`
+	Comments          string                `xml:"comments,omitempty"`
+}
+
+type goAMLAccount struct {
+	InstitutionName string `xml:"institution_name,omitempty"`
+	Account         string `xml:"account"`
+}
+
+type goAMLForeignCurrency struct {
+	Code   string `xml:"foreign_currency_code"`
+	Amount string `xml:"foreign_amount"`
+}
+
+// Generate creates goAML XML for the given AML report type. reference is the
+// bank's own reference for the report, echoed back in the unit's
+// acknowledgment.
+func (g *GoAMLGenerator) Generate(reportType valueobject.ReportType, reference string, data AMLReportData, now time.Time) (string, error) {
+	report := goAMLReport{
+		RentityID:         g.reportingEntityID,
+		SubmissionCode:    goAMLSubmissionElectronic,
+		EntityReference:   reference,
+		SubmissionDate:    now.UTC().Format(goAMLDateFormat),
+		CurrencyCodeLocal: g.localCurrency,
+	}
+
+	switch {
+	case reportType.Equal(valueobject.ReportTypeSAR):
+		report.ReportCode = goAMLReportSTR
+		report.Reason = fmt.Sprintf("%d fraud case(s) confirmed as suspicious activity in %s", len(data.Cases), data.Period)
+		report.Action = "Accounts reviewed and cases resolved as confirmed fraud"
+		for _, c := range data.Cases {
+			report.Indicators = append(report.Indicators, "RISK_"+c.RiskLevel)
+			narrative := caseNarrative(c)
+			for _, t := range c.Transactions {
+				tx := g.transaction(t)
+				tx.InternalRef = c.ID
+				tx.Comments = narrative
+				report.Transactions = append(report.Transactions, tx)
+			}
+		}
+		report.Indicators = dedupe(report.Indicators)
+	case reportType.Equal(valueobject.ReportTypeCTR):
+		report.ReportCode = goAMLReportCTR
+		for _, t := range data.Transactions {
+			report.Transactions = append(report.Transactions, g.transaction(t))
+		}
+	default:
+		return "", fmt.Errorf("unsupported AML report type: %s", reportType)
+	}
+
+	out, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal goAML report: %w", err)
+	}
+	return xml.Header + string(out) + "\n", nil
+}
+
+// transaction maps a payment to a goAML transaction. Amounts are reported as
+// paid until FX rates are sourced; payments in a foreign currency also carry
+// their original currency.
+func (g *GoAMLGenerator) transaction(t AMLTransaction) goAMLTransaction {
+	tx := goAMLTransaction{
+		TransactionNumber: t.ID,
+		Description:       t.Description,
+		Date:              t.Date.UTC().Format(goAMLDateFormat),
+		TransmodeCode:     t.Rail,
+		AmountLocal:       t.Amount.StringFixed(2),
+		FromFundsCode:     goAMLFundsBankTransfer,
+		FromAccount:       goAMLAccount{InstitutionName: g.institutionName, Account: t.FromAccount},
+		ToFundsCode:       goAMLFundsBankTransfer,
+		ToAccount:         goAMLAccount{Account: t.ToAccount},
+	}
+	if t.Currency != "" && t.Currency != g.localCurrency {
+		tx.ForeignCurrency = &goAMLForeignCurrency{Code: t.Currency, Amount: t.Amount.StringFixed(2)}
+	}
+	if t.Reference != "" {
+		tx.Comments = "Reference: " + t.Reference
+	}
+	return tx
+}
+
+// caseNarrative summarises a case and its investigation notes for the
+// transaction comments.
+func caseNarrative(c AMLCase) string {
+	kind := "Fraud case"
+	if c.Scenario != "" {
+		kind = "AML " + c.Scenario + " case"
+	}
+	parts := []string{fmt.Sprintf("%s %s (risk %s, score %d) resolved %s.",
+		kind, c.ID, c.RiskLevel, c.RiskScore, c.ResolvedAt.UTC().Format("2006-01-02"))}
+	parts = append(parts, c.Notes...)
+	return strings.Join(parts, " ")
+}
+
+func dedupe(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	out := values[:0]
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
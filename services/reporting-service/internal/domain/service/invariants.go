@@ -0,0 +1,261 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Invariants verified by the consistency checker.
+const (
+	// InvariantControlAccount holds when the balances of the customer
+	// accounts under a ledger control account sum to the control account's
+	// balance as recomputed from the journal.
+	InvariantControlAccount = "CONTROL_ACCOUNT_BALANCE"
+	// InvariantSettledPaymentJournal holds when every settled payment has
+	// posted hold and clearing entries for its amount and no release.
+	InvariantSettledPaymentJournal = "SETTLED_PAYMENT_JOURNAL"
+	// InvariantCardHoldsWithinLimits holds when no card's authorized spend
+	// exceeds its daily or monthly limit.
+	InvariantCardHoldsWithinLimits = "CARD_HOLDS_WITHIN_LIMITS"
+)
+
+// Journal entry status and payment saga legs the checks rely on.
+const (
+	journalStatusPosted = "POSTED"
+	paymentLegHold      = "hold"
+	paymentLegClearing  = "clearing"
+	paymentLegRelease   = "release"
+)
+
+// Discrepancy is one violation of an invariant. Subject names what violates
+// it: a control account, a payment or a card.
+type Discrepancy struct {
+	Invariant string          `json:"invariant"`
+	Subject   string          `json:"subject"`
+	Currency  string          `json:"currency,omitempty"`
+	Detail    string          `json:"detail"`
+	Expected  decimal.Decimal `json:"expected"`
+	Actual    decimal.Decimal `json:"actual"`
+}
+
+// ConsistencyReport is the outcome of one run of the consistency checker for
+// a tenant.
+type ConsistencyReport struct {
+	CheckedAt     time.Time     `json:"checked_at"`
+	Invariants    []string      `json:"invariants"`
+	Discrepancies []Discrepancy `json:"discrepancies"`
+	ID            uuid.UUID     `json:"id"`
+	TenantID      uuid.UUID     `json:"tenant_id"`
+}
+
+// HasDiscrepancies reports whether any invariant was violated.
+func (r ConsistencyReport) HasDiscrepancies() bool {
+	return len(r.Discrepancies) > 0
+}
+
+// AccountBalance is a customer account's balance as the ledger reports it.
+// Balances are signed debit-positive, like the ledger's.
+type AccountBalance struct {
+	AccountCode string
+	Currency    string
+	Balance     decimal.Decimal
+}
+
+// CardExposure is a card's limits and the spend authorized against them in
+// the current day and month.
+type CardExposure struct {
+	ID           string
+	Currency     string
+	DailyLimit   decimal.Decimal
+	MonthlyLimit decimal.Decimal
+	DailySpent   decimal.Decimal
+	MonthlySpent decimal.Decimal
+}
+
+// ControlAccountOf returns the control account a ledger sub-account is held
+// under: "2000" for "2000-001". Codes that are not sub-accounts have none.
+func ControlAccountOf(code string) string {
+	control, _, ok := strings.Cut(code, "-")
+	if !ok {
+		return ""
+	}
+	return control
+}
+
+// CheckControlAccounts verifies, for each control account and currency, that
+// the balances of the customer accounts under it sum to the net of the posted
+// journal entries under it. The entries must cover the books from their
+// opening. A shortfall either way means postings to sub-accounts no customer
+// account owns, or account balances that drifted from the journal.
+func CheckControlAccounts(controls []string, entries []JournalEntryRecord, balances []AccountBalance) []Discrepancy {
+	type key struct{ control, currency string }
+	isControl := make(map[string]bool, len(controls))
+	for _, c := range controls {
+		isControl[c] = true
+	}
+
+	journal := make(map[key]decimal.Decimal)
+	for _, e := range entries {
+		if e.Status != journalStatusPosted {
+			continue
+		}
+		for _, p := range e.Postings {
+			if c := ControlAccountOf(p.DebitAccount); isControl[c] {
+				k := key{c, p.Currency}
+				journal[k] = journal[k].Add(p.Amount)
+			}
+			if c := ControlAccountOf(p.CreditAccount); isControl[c] {
+				k := key{c, p.Currency}
+				journal[k] = journal[k].Sub(p.Amount)
+			}
+		}
+	}
+	accounts := make(map[key]decimal.Decimal)
+	for _, b := range balances {
+		if c := ControlAccountOf(b.AccountCode); isControl[c] {
+			k := key{c, b.Currency}
+			accounts[k] = accounts[k].Add(b.Balance)
+		}
+	}
+
+	keys := make([]key, 0, len(journal))
+	for k := range journal {
+		keys = append(keys, k)
+	}
+	for k := range accounts {
+		if _, ok := journal[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].control != keys[j].control {
+			return keys[i].control < keys[j].control
+		}
+		return keys[i].currency < keys[j].currency
+	})
+
+	var out []Discrepancy
+	for _, k := range keys {
+		expected, actual := journal[k], accounts[k]
+		if expected.Equal(actual) {
+			continue
+		}
+		out = append(out, Discrepancy{
+			Invariant: InvariantControlAccount,
+			Subject:   k.control,
+			Currency:  k.currency,
+			Expected:  expected,
+			Actual:    actual,
+			Detail: fmt.Sprintf("customer account balances under control account %s differ from the journal by %s",
+				k.control, actual.Sub(expected)),
+		})
+	}
+	return out
+}
+
+// CheckSettledPayments verifies that each settled payment has posted hold and
+// clearing entries, each for the payment's amount in its currency, and that
+// its hold was not released. The entries must reach back to the payments'
+// creation.
+func CheckSettledPayments(payments []PaymentRecord, entries []JournalEntryRecord) []Discrepancy {
+	type leg struct{ payment, leg string }
+	posted := make(map[leg][]JournalEntryRecord)
+	for _, e := range entries {
+		if e.Status != journalStatusPosted {
+			continue
+		}
+		parts := strings.Split(e.Reference, "/")
+		if len(parts) != 3 || parts[0] != "payment" {
+			continue
+		}
+		k := leg{parts[1], parts[2]}
+		posted[k] = append(posted[k], e)
+	}
+
+	var out []Discrepancy
+	for _, p := range payments {
+		if p.Status != PaymentStatusSettled {
+			continue
+		}
+		for _, name := range []string{paymentLegHold, paymentLegClearing} {
+			legEntries := posted[leg{p.ID, name}]
+			actual := decimal.Zero
+			for _, e := range legEntries {
+				actual = actual.Add(postedAmount(e, p.Currency))
+			}
+			var detail string
+			switch {
+			case len(legEntries) == 0:
+				detail = fmt.Sprintf("settled payment has no %s entry", name)
+			case !actual.Equal(p.Amount):
+				detail = fmt.Sprintf("settled payment's %s entries post %s %s, not its amount", name, actual, p.Currency)
+			default:
+				continue
+			}
+			out = append(out, Discrepancy{
+				Invariant: InvariantSettledPaymentJournal,
+				Subject:   p.ID,
+				Currency:  p.Currency,
+				Expected:  p.Amount,
+				Actual:    actual,
+				Detail:    detail,
+			})
+		}
+		if released := posted[leg{p.ID, paymentLegRelease}]; len(released) > 0 {
+			out = append(out, Discrepancy{
+				Invariant: InvariantSettledPaymentJournal,
+				Subject:   p.ID,
+				Currency:  p.Currency,
+				Expected:  decimal.Zero,
+				Actual:    postedAmount(released[0], p.Currency),
+				Detail:    "settled payment's hold was released",
+			})
+		}
+	}
+	return out
+}
+
+// postedAmount sums an entry's postings in the currency.
+func postedAmount(e JournalEntryRecord, currency string) decimal.Decimal {
+	total := decimal.Zero
+	for _, p := range e.Postings {
+		if p.Currency == currency {
+			total = total.Add(p.Amount)
+		}
+	}
+	return total
+}
+
+// CheckCardLimits verifies that no card's authorized spend exceeds its daily
+// or monthly limit.
+func CheckCardLimits(cards []CardExposure) []Discrepancy {
+	var out []Discrepancy
+	for _, c := range cards {
+		if c.DailySpent.GreaterThan(c.DailyLimit) {
+			out = append(out, Discrepancy{
+				Invariant: InvariantCardHoldsWithinLimits,
+				Subject:   c.ID,
+				Currency:  c.Currency,
+				Expected:  c.DailyLimit,
+				Actual:    c.DailySpent,
+				Detail:    "card holds exceed the daily limit",
+			})
+		}
+		if c.MonthlySpent.GreaterThan(c.MonthlyLimit) {
+			out = append(out, Discrepancy{
+				Invariant: InvariantCardHoldsWithinLimits,
+				Subject:   c.ID,
+				Currency:  c.Currency,
+				Expected:  c.MonthlyLimit,
+				Actual:    c.MonthlySpent,
+				Detail:    "card holds exceed the monthly limit",
+			})
+		}
+	}
+	return out
+}
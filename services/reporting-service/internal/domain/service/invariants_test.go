@@ -0,0 +1,97 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+func posting(debit, credit string, amount int64) service.JournalPostingRecord {
+	return service.JournalPostingRecord{DebitAccount: debit, CreditAccount: credit, Currency: "USD", Amount: decimal.NewFromInt(amount)}
+}
+
+func TestControlAccountOf(t *testing.T) {
+	assert.Equal(t, "2000", service.ControlAccountOf("2000-001"))
+	assert.Equal(t, "", service.ControlAccountOf("1000"))
+}
+
+func TestCheckControlAccounts(t *testing.T) {
+	entries := []service.JournalEntryRecord{
+		{Status: "POSTED", Postings: []service.JournalPostingRecord{
+			posting("1000", "2000-001", 100),
+			posting("1000", "2000-002", 50),
+		}},
+		{Status: "POSTED", Postings: []service.JournalPostingRecord{posting("2000-001", "1150-001", 30)}},
+		{Status: "REVERSED", Postings: []service.JournalPostingRecord{posting("1000", "2000-001", 999)}},
+		{Status: "POSTED", Postings: []service.JournalPostingRecord{posting("1000", "2100-001", 10)}},
+	}
+	balances := []service.AccountBalance{
+		{AccountCode: "2000-001", Currency: "USD", Balance: decimal.NewFromInt(-70)},
+		{AccountCode: "2000-002", Currency: "USD", Balance: decimal.NewFromInt(-50)},
+		{AccountCode: "2100-001", Currency: "USD", Balance: decimal.NewFromInt(-10)},
+	}
+	assert.Empty(t, service.CheckControlAccounts([]string{"2000", "2100"}, entries, balances))
+
+	// A posting to a sub-account no customer account owns.
+	entries = append(entries, service.JournalEntryRecord{Status: "POSTED", Postings: []service.JournalPostingRecord{posting("1000", "2000-999", 25)}})
+	got := service.CheckControlAccounts([]string{"2000", "2100"}, entries, balances)
+	require.Len(t, got, 1)
+	assert.Equal(t, service.InvariantControlAccount, got[0].Invariant)
+	assert.Equal(t, "2000", got[0].Subject)
+	assert.True(t, decimal.NewFromInt(-145).Equal(got[0].Expected))
+	assert.True(t, decimal.NewFromInt(-120).Equal(got[0].Actual))
+
+	// A drifted balance under a control account with no journal activity.
+	balances = append(balances, service.AccountBalance{AccountCode: "2200-001", Currency: "EUR", Balance: decimal.NewFromInt(5)})
+	got = service.CheckControlAccounts([]string{"2200"}, entries, balances)
+	require.Len(t, got, 1)
+	assert.Equal(t, "2200", got[0].Subject)
+	assert.Equal(t, "EUR", got[0].Currency)
+}
+
+func TestCheckSettledPayments(t *testing.T) {
+	amount := decimal.NewFromInt(40)
+	entry := func(ref string, amount int64) service.JournalEntryRecord {
+		return service.JournalEntryRecord{Status: "POSTED", Reference: ref, Postings: []service.JournalPostingRecord{posting("2000-001", "2900-001", amount)}}
+	}
+	payments := []service.PaymentRecord{
+		{ID: "ok", Status: "SETTLED", Currency: "USD", Amount: amount},
+		{ID: "unposted", Status: "SETTLED", Currency: "USD", Amount: amount},
+		{ID: "short", Status: "SETTLED", Currency: "USD", Amount: amount},
+		{ID: "released", Status: "SETTLED", Currency: "USD", Amount: amount},
+		{ID: "pending", Status: "PROCESSING", Currency: "USD", Amount: amount},
+	}
+	entries := []service.JournalEntryRecord{
+		entry("payment/ok/hold", 40), entry("payment/ok/clearing", 40),
+		entry("payment/unposted/hold", 40),
+		entry("payment/short/hold", 40), entry("payment/short/clearing", 35),
+		entry("payment/released/hold", 40), entry("payment/released/clearing", 40), entry("payment/released/release", 40),
+	}
+
+	got := service.CheckSettledPayments(payments, entries)
+	require.Len(t, got, 3)
+	assert.Equal(t, "unposted", got[0].Subject)
+	assert.Equal(t, "settled payment has no clearing entry", got[0].Detail)
+	assert.Equal(t, "short", got[1].Subject)
+	assert.True(t, decimal.NewFromInt(35).Equal(got[1].Actual))
+	assert.Equal(t, "released", got[2].Subject)
+	assert.Equal(t, "settled payment's hold was released", got[2].Detail)
+}
+
+func TestCheckCardLimits(t *testing.T) {
+	cards := []service.CardExposure{
+		{ID: "within", DailyLimit: decimal.NewFromInt(500), MonthlyLimit: decimal.NewFromInt(2000), DailySpent: decimal.NewFromInt(500), MonthlySpent: decimal.NewFromInt(1500)},
+		{ID: "over", DailyLimit: decimal.NewFromInt(500), MonthlyLimit: decimal.NewFromInt(2000), DailySpent: decimal.NewFromInt(520), MonthlySpent: decimal.NewFromInt(2100)},
+	}
+
+	got := service.CheckCardLimits(cards)
+	require.Len(t, got, 2)
+	assert.Equal(t, "over", got[0].Subject)
+	assert.Equal(t, "card holds exceed the daily limit", got[0].Detail)
+	assert.Equal(t, "card holds exceed the monthly limit", got[1].Detail)
+	assert.True(t, decimal.NewFromInt(2000).Equal(got[1].Expected))
+}
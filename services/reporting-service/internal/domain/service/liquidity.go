@@ -0,0 +1,252 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// HQLA levels. Level 2 assets count towards the liquidity buffer only up to
+// the Basel III caps.
+const (
+	HQLALevel1  = "1"
+	HQLALevel2A = "2A"
+	HQLALevel2B = "2B"
+)
+
+var (
+	// inflowCap limits inflows to 75% of outflows in the LCR denominator.
+	inflowCap = decimal.NewFromFloat(0.75)
+	// level2BCap and level2Cap limit level 2B assets to 15% and all level 2
+	// assets to 40% of the liquidity buffer.
+	level2BCap = decimal.NewFromInt(15)
+	level2Cap  = decimal.NewFromInt(40)
+)
+
+// WeightRule weights the balances of the ledger accounts under a prefix.
+// Accounts take the rule with the longest matching prefix.
+type WeightRule struct {
+	Prefix string          `json:"prefix"`
+	Label  string          `json:"label"`
+	Level  string          `json:"level,omitempty"` // HQLA rules only
+	Weight decimal.Decimal `json:"weight"`
+}
+
+// LiquidityWeights classifies ledger balances for the liquidity ratios: the
+// high-quality liquid assets and their haircut-adjusted weights, the 30-day
+// stressed outflow and inflow rates, and the available and required stable
+// funding factors.
+type LiquidityWeights struct {
+	HQLA                   []WeightRule `json:"hqla"`
+	Outflows               []WeightRule `json:"outflows"`
+	Inflows                []WeightRule `json:"inflows"`
+	AvailableStableFunding []WeightRule `json:"available_stable_funding"`
+	RequiredStableFunding  []WeightRule `json:"required_stable_funding"`
+}
+
+// DefaultLiquidityWeights returns the standardised approximations used until
+// a tenant's balance sheet is classified in detail: cash and central bank
+// reserves are the only level 1 assets and a tenth of all liabilities runs off
+// over the stress horizon.
+func DefaultLiquidityWeights() LiquidityWeights {
+	rule := func(prefix, label string, weight float64) WeightRule {
+		return WeightRule{Prefix: prefix, Label: label, Weight: decimal.NewFromFloat(weight)}
+	}
+	cash := rule("10", "Cash and central bank reserves", 1)
+	cash.Level = HQLALevel1
+	return LiquidityWeights{
+		HQLA:     []WeightRule{cash},
+		Outflows: []WeightRule{rule("2", "Liabilities", 0.1)},
+		AvailableStableFunding: []WeightRule{
+			rule("2", "Deposits and other liabilities", 0.9),
+			rule("3", "Regulatory capital", 1),
+			rule("4", "Current year earnings", 1),
+			rule("5", "Current year earnings", 1),
+			rule("6", "Current year earnings", 1),
+			rule("7", "Current year earnings", 1),
+			rule("8", "Current year earnings", 1),
+			rule("9", "Current year earnings", 1),
+		},
+		RequiredStableFunding: []WeightRule{
+			rule("1", "Other assets", 0.85),
+			rule("10", "Cash and central bank reserves", 0),
+			rule("11", "Loans and advances to banks", 0.15),
+		},
+	}
+}
+
+// ParseLiquidityWeights parses a JSON weighting configuration. Weights must
+// lie between 0 and 1 and HQLA rules must state their level.
+func ParseLiquidityWeights(data []byte) (LiquidityWeights, error) {
+	var w LiquidityWeights
+	if err := json.Unmarshal(data, &w); err != nil {
+		return LiquidityWeights{}, fmt.Errorf("invalid liquidity weights: %w", err)
+	}
+	lists := map[string][]WeightRule{
+		"hqla": w.HQLA, "outflows": w.Outflows, "inflows": w.Inflows,
+		"available_stable_funding": w.AvailableStableFunding, "required_stable_funding": w.RequiredStableFunding,
+	}
+	for name, rules := range lists {
+		for _, r := range rules {
+			if r.Prefix == "" {
+				return LiquidityWeights{}, fmt.Errorf("invalid liquidity weights: %s rule %q has no prefix", name, r.Label)
+			}
+			if r.Weight.IsNegative() || r.Weight.GreaterThan(decimal.NewFromInt(1)) {
+				return LiquidityWeights{}, fmt.Errorf("invalid liquidity weights: %s rule %s weight %s is not between 0 and 1", name, r.Prefix, r.Weight)
+			}
+		}
+	}
+	for _, r := range w.HQLA {
+		switch r.Level {
+		case HQLALevel1, HQLALevel2A, HQLALevel2B:
+		default:
+			return LiquidityWeights{}, fmt.Errorf("invalid liquidity weights: HQLA rule %s has invalid level %q", r.Prefix, r.Level)
+		}
+	}
+	return w, nil
+}
+
+// LiquidityContribution is one source account's contribution to a liquidity
+// figure: its balance times the weight of the rule it matched.
+type LiquidityContribution struct {
+	AccountCode string
+	Rule        string
+	Level       string
+	Balance     decimal.Decimal
+	Weight      decimal.Decimal
+	Amount      decimal.Decimal
+}
+
+// LiquidityFigure is a component of a liquidity ratio with the accounts it is
+// drawn from. Amount is Gross after any regulatory cap.
+type LiquidityFigure struct {
+	Contributions []LiquidityContribution
+	Gross         decimal.Decimal
+	Amount        decimal.Decimal
+}
+
+// LiquidityMetrics holds the liquidity coverage ratio and the net stable
+// funding ratio with the figures they are computed from.
+type LiquidityMetrics struct {
+	Period                 string
+	HQLA                   LiquidityFigure
+	Outflows               LiquidityFigure
+	Inflows                LiquidityFigure
+	AvailableStableFunding LiquidityFigure
+	RequiredStableFunding  LiquidityFigure
+	NetOutflows            decimal.Decimal
+	LCR                    decimal.Decimal
+	NSFR                   decimal.Decimal
+	TenantID               uuid.UUID
+}
+
+// ComputeLiquidity derives the LCR and NSFR from a trial balance. Asset rules
+// weight debit balances and funding rules weight credit balances; accounts
+// matching no rule do not contribute.
+//
+//	LCR  = HQLA / (outflows - min(inflows, 75% of outflows))
+//	NSFR = available stable funding / required stable funding
+func ComputeLiquidity(tb TrialBalance, weights LiquidityWeights) LiquidityMetrics {
+	balances := accountBalances(tb)
+
+	m := LiquidityMetrics{
+		TenantID:               tb.TenantID,
+		Period:                 tb.Period,
+		HQLA:                   weigh(balances, weights.HQLA, false),
+		Outflows:               weigh(balances, weights.Outflows, true),
+		Inflows:                weigh(balances, weights.Inflows, false),
+		AvailableStableFunding: weigh(balances, weights.AvailableStableFunding, true),
+		RequiredStableFunding:  weigh(balances, weights.RequiredStableFunding, false),
+	}
+	m.HQLA.Amount = cappedHQLA(m.HQLA.Contributions)
+	m.Inflows.Amount = decimal.Min(m.Inflows.Gross, m.Outflows.Gross.Mul(inflowCap))
+	m.NetOutflows = m.Outflows.Amount.Sub(m.Inflows.Amount)
+	m.LCR = ratio(m.HQLA.Amount, m.NetOutflows)
+	m.NSFR = ratio(m.AvailableStableFunding.Amount, m.RequiredStableFunding.Amount)
+	return m
+}
+
+// accountBalances nets each account's debits and credits into its debit
+// balance, in account order.
+func accountBalances(tb TrialBalance) []TrialBalanceLine {
+	byAccount := make(map[string]decimal.Decimal)
+	for _, l := range tb.Lines {
+		byAccount[l.AccountCode] = byAccount[l.AccountCode].Add(l.Debit).Sub(l.Credit)
+	}
+	balances := make([]TrialBalanceLine, 0, len(byAccount))
+	for code, balance := range byAccount {
+		balances = append(balances, TrialBalanceLine{AccountCode: code, Debit: balance})
+	}
+	sort.Slice(balances, func(i, j int) bool { return balances[i].AccountCode < balances[j].AccountCode })
+	return balances
+}
+
+// weigh applies the rules to the account balances; credit selects credit
+// balances for funding rules.
+func weigh(balances []TrialBalanceLine, rules []WeightRule, credit bool) LiquidityFigure {
+	figure := LiquidityFigure{Gross: decimal.Zero}
+	for _, b := range balances {
+		r, ok := matchRule(b.AccountCode, rules)
+		if !ok {
+			continue
+		}
+		balance := b.Debit
+		if credit {
+			balance = balance.Neg()
+		}
+		c := LiquidityContribution{
+			AccountCode: b.AccountCode,
+			Rule:        r.Label,
+			Level:       r.Level,
+			Balance:     balance,
+			Weight:      r.Weight,
+			Amount:      balance.Mul(r.Weight),
+		}
+		figure.Contributions = append(figure.Contributions, c)
+		figure.Gross = figure.Gross.Add(c.Amount)
+	}
+	figure.Amount = figure.Gross
+	return figure
+}
+
+func matchRule(accountCode string, rules []WeightRule) (WeightRule, bool) {
+	var (
+		best  WeightRule
+		found bool
+	)
+	for _, r := range rules {
+		if strings.HasPrefix(accountCode, r.Prefix) && (!found || len(r.Prefix) > len(best.Prefix)) {
+			best, found = r, true
+		}
+	}
+	return best, found
+}
+
+// cappedHQLA applies the Basel III caps on level 2 assets: level 2B may make
+// up at most 15% and level 2 at most 40% of the buffer.
+func cappedHQLA(contributions []LiquidityContribution) decimal.Decimal {
+	l1, l2a, l2b := decimal.Zero, decimal.Zero, decimal.Zero
+	for _, c := range contributions {
+		switch c.Level {
+		case HQLALevel2A:
+			l2a = l2a.Add(c.Amount)
+		case HQLALevel2B:
+			l2b = l2b.Add(c.Amount)
+		default:
+			l1 = l1.Add(c.Amount)
+		}
+	}
+	hundred := decimal.NewFromInt(100)
+	// Excess level 2B over 15/85 of level 1 and 2A, or over 15/60 of level 1.
+	adj15 := decimal.Max(decimal.Zero,
+		l2b.Sub(level2BCap.Div(hundred.Sub(level2BCap)).Mul(l1.Add(l2a))),
+		l2b.Sub(level2BCap.Div(hundred.Sub(level2Cap)).Mul(l1)))
+	// Excess level 2 over 40/60 of level 1.
+	adj40 := decimal.Max(decimal.Zero,
+		l2a.Add(l2b).Sub(adj15).Sub(level2Cap.Div(hundred.Sub(level2Cap)).Mul(l1)))
+	return l1.Add(l2a).Add(l2b).Sub(adj15).Sub(adj40)
+}
@@ -0,0 +1,96 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+func TestComputeLiquidity_DrillsDownToSourceAccounts(t *testing.T) {
+	m := service.ComputeLiquidity(balancedTrialBalance(), service.DefaultLiquidityWeights())
+
+	require.Len(t, m.HQLA.Contributions, 1)
+	assert.Equal(t, "1000-001", m.HQLA.Contributions[0].AccountCode)
+	assert.Equal(t, service.HQLALevel1, m.HQLA.Contributions[0].Level)
+	assert.True(t, m.HQLA.Amount.Equal(decimal.NewFromInt(200)), m.HQLA.Amount.String())
+
+	require.Len(t, m.Outflows.Contributions, 1)
+	out := m.Outflows.Contributions[0]
+	assert.Equal(t, "2000-001", out.AccountCode)
+	assert.True(t, out.Balance.Equal(decimal.NewFromInt(800)), out.Balance.String())
+	assert.True(t, out.Amount.Equal(decimal.NewFromInt(80)), out.Amount.String())
+	assert.True(t, m.NetOutflows.Equal(decimal.NewFromInt(80)), m.NetOutflows.String())
+	assert.True(t, m.LCR.Equal(decimal.RequireFromString("2.5")), m.LCR.String())
+
+	// Deposits at 90%, capital and earnings in full; the longest prefix wins,
+	// so cash requires no stable funding and interbank loans 15%.
+	assert.True(t, m.AvailableStableFunding.Amount.Equal(decimal.NewFromInt(920)), m.AvailableStableFunding.Amount.String())
+	assert.True(t, m.RequiredStableFunding.Amount.Equal(decimal.NewFromInt(610)), m.RequiredStableFunding.Amount.String())
+	assert.Len(t, m.RequiredStableFunding.Contributions, 3)
+	assert.True(t, m.NSFR.Equal(decimal.RequireFromString("1.5082")), m.NSFR.String())
+}
+
+func TestComputeLiquidity_CapsLevel2Assets(t *testing.T) {
+	tb := service.TrialBalance{Period: "2025-Q1", Lines: []service.TrialBalanceLine{
+		line("1000", 100, 0), // level 1
+		line("1300", 100, 0), // level 2A
+		line("1400", 50, 0),  // level 2B
+		line("2000", 0, 250),
+	}}
+	one := decimal.NewFromInt(1)
+	weights := service.LiquidityWeights{
+		HQLA: []service.WeightRule{
+			{Prefix: "10", Level: service.HQLALevel1, Weight: one},
+			{Prefix: "13", Level: service.HQLALevel2A, Weight: one},
+			{Prefix: "14", Level: service.HQLALevel2B, Weight: one},
+		},
+		Outflows: []service.WeightRule{{Prefix: "2", Weight: decimal.NewFromFloat(0.4)}},
+	}
+
+	m := service.ComputeLiquidity(tb, weights)
+
+	// Level 2B is cut to 15% and level 2 to 40% of the buffer.
+	assert.True(t, m.HQLA.Gross.Equal(decimal.NewFromInt(250)), m.HQLA.Gross.String())
+	assert.Equal(t, "166.67", m.HQLA.Amount.StringFixed(2))
+	assert.Equal(t, "1.6667", m.LCR.String())
+}
+
+func TestComputeLiquidity_CapsInflows(t *testing.T) {
+	tb := service.TrialBalance{Period: "2025-Q1", Lines: []service.TrialBalanceLine{
+		line("1000", 100, 0),
+		line("1200", 200, 0),
+		line("2000", 0, 300),
+	}}
+	weights := service.LiquidityWeights{
+		HQLA:     []service.WeightRule{{Prefix: "10", Level: service.HQLALevel1, Weight: decimal.NewFromInt(1)}},
+		Outflows: []service.WeightRule{{Prefix: "2", Weight: decimal.NewFromFloat(0.5)}},
+		Inflows:  []service.WeightRule{{Prefix: "12", Weight: decimal.NewFromFloat(0.75)}},
+	}
+
+	m := service.ComputeLiquidity(tb, weights)
+
+	assert.True(t, m.Inflows.Gross.Equal(decimal.NewFromInt(150)), m.Inflows.Gross.String())
+	assert.Equal(t, "112.5", m.Inflows.Amount.String())
+	assert.Equal(t, "37.5", m.NetOutflows.String())
+	assert.Equal(t, "2.6667", m.LCR.String())
+}
+
+func TestParseLiquidityWeights(t *testing.T) {
+	w, err := service.ParseLiquidityWeights([]byte(`{
+		"hqla": [{"prefix": "10", "label": "Cash", "level": "1", "weight": "1"}],
+		"outflows": [{"prefix": "20", "label": "Retail deposits", "weight": "0.05"}]
+	}`))
+	require.NoError(t, err)
+	require.Len(t, w.Outflows, 1)
+	assert.True(t, w.Outflows[0].Weight.Equal(decimal.NewFromFloat(0.05)))
+
+	_, err = service.ParseLiquidityWeights([]byte(`{"outflows": [{"prefix": "2", "weight": "1.5"}]}`))
+	assert.ErrorContains(t, err, "not between 0 and 1")
+
+	_, err = service.ParseLiquidityWeights([]byte(`{"hqla": [{"prefix": "10", "weight": "1"}]}`))
+	assert.ErrorContains(t, err, "invalid level")
+}
@@ -0,0 +1,406 @@
+package service
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Parquet physical types, repetitions, converted types, encodings and codecs
+// used by the writer, numbered as in the Parquet format's Thrift definitions.
+const (
+	parquetInt32     = 1
+	parquetInt64     = 2
+	parquetByteArray = 6
+
+	parquetRequired = 0
+	parquetOptional = 1
+
+	parquetUTF8            = 0
+	parquetDecimal         = 5
+	parquetDate            = 6
+	parquetTimestampMicros = 10
+
+	parquetPlain = 0
+	parquetRLE   = 3
+
+	parquetGzip = 2
+
+	parquetDataPage = 0
+)
+
+// Decimal columns are written as DECIMAL(38, 6).
+const (
+	WarehouseDecimalPrecision = 38
+	WarehouseDecimalScale     = 6
+)
+
+var parquetMagic = []byte("PAR1")
+
+// WriteParquet encodes rows as a Parquet file with one row group and one
+// GZIP-compressed PLAIN data page per column. Row values must match their
+// column's type: string for STRING, int64 for INT64, time.Time for TIMESTAMP
+// and DATE, and decimal.Decimal for DECIMAL. Nil marks a null, which only
+// nullable columns accept.
+func WriteParquet(columns []WarehouseColumn, rows [][]any) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(parquetMagic)
+
+	chunks := make([]parquetChunk, 0, len(columns))
+	for i, col := range columns {
+		page, err := encodeParquetColumn(col, i, rows)
+		if err != nil {
+			return nil, err
+		}
+		compressed, err := gzipBytes(page)
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %w", col.Name, err)
+		}
+
+		header := &thriftWriter{}
+		header.i32Field(1, parquetDataPage)
+		header.i32Field(2, int32(len(page)))       //nolint:gosec // bounded by the export's size
+		header.i32Field(3, int32(len(compressed))) //nolint:gosec // bounded by the export's size
+		header.structField(5, func(w *thriftWriter) {
+			w.i32Field(1, int32(len(rows))) //nolint:gosec // bounded by the export's size
+			w.i32Field(2, parquetPlain)
+			w.i32Field(3, parquetRLE)
+			w.i32Field(4, parquetRLE)
+		})
+		header.stop()
+
+		chunk := parquetChunk{
+			column:       col,
+			offset:       int64(buf.Len()),
+			uncompressed: int64(header.buf.Len() + len(page)),
+			compressed:   int64(header.buf.Len() + len(compressed)),
+		}
+		buf.Write(header.buf.Bytes())
+		buf.Write(compressed)
+		chunks = append(chunks, chunk)
+	}
+
+	meta := encodeParquetFooter(columns, chunks, int64(len(rows)))
+	buf.Write(meta)
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(len(meta))) //nolint:gosec // footer is far below 4 GiB
+	buf.Write(parquetMagic)
+	return buf.Bytes(), nil
+}
+
+type parquetChunk struct {
+	column       WarehouseColumn
+	offset       int64
+	uncompressed int64
+	compressed   int64
+}
+
+// encodeParquetColumn encodes the i-th value of each row as a data page body:
+// definition levels for a nullable column, then the non-null values.
+func encodeParquetColumn(col WarehouseColumn, i int, rows [][]any) ([]byte, error) {
+	var values bytes.Buffer
+	levels := make([]bool, 0, len(rows))
+	for r, row := range rows {
+		if i >= len(row) {
+			return nil, fmt.Errorf("row %d has no value for column %s", r, col.Name)
+		}
+		v := row[i]
+		if v == nil {
+			if !col.Nullable {
+				return nil, fmt.Errorf("row %d: column %s is not nullable", r, col.Name)
+			}
+			levels = append(levels, false)
+			continue
+		}
+		levels = append(levels, true)
+		if err := encodeParquetValue(&values, col, v); err != nil {
+			return nil, fmt.Errorf("row %d: column %s: %w", r, col.Name, err)
+		}
+	}
+
+	var page bytes.Buffer
+	if col.Nullable {
+		encoded := encodeDefinitionLevels(levels)
+		_ = binary.Write(&page, binary.LittleEndian, uint32(len(encoded))) //nolint:gosec // bounded by the export's size
+		page.Write(encoded)
+	}
+	page.Write(values.Bytes())
+	return page.Bytes(), nil
+}
+
+func encodeParquetValue(buf *bytes.Buffer, col WarehouseColumn, v any) error {
+	switch col.Type {
+	case ColumnString:
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("expected string, got %T", v)
+		}
+		writeByteArray(buf, []byte(s))
+	case ColumnInt64:
+		n, ok := v.(int64)
+		if !ok {
+			return fmt.Errorf("expected int64, got %T", v)
+		}
+		_ = binary.Write(buf, binary.LittleEndian, n)
+	case ColumnTimestamp:
+		t, ok := v.(time.Time)
+		if !ok {
+			return fmt.Errorf("expected time.Time, got %T", v)
+		}
+		_ = binary.Write(buf, binary.LittleEndian, t.UnixMicro())
+	case ColumnDate:
+		t, ok := v.(time.Time)
+		if !ok {
+			return fmt.Errorf("expected time.Time, got %T", v)
+		}
+		days := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / 86400
+		_ = binary.Write(buf, binary.LittleEndian, int32(days)) //nolint:gosec // dates fit in 32 bits of days
+	case ColumnDecimal:
+		d, ok := v.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("expected decimal.Decimal, got %T", v)
+		}
+		writeByteArray(buf, twosComplement(d.Shift(WarehouseDecimalScale).Round(0).BigInt()))
+	default:
+		return fmt.Errorf("unsupported column type %q", col.Type)
+	}
+	return nil
+}
+
+func writeByteArray(buf *bytes.Buffer, b []byte) {
+	_ = binary.Write(buf, binary.LittleEndian, uint32(len(b))) //nolint:gosec // values are far below 4 GiB
+	buf.Write(b)
+}
+
+// twosComplement returns the minimal big-endian two's complement encoding of n.
+func twosComplement(n *big.Int) []byte {
+	if n.Sign() >= 0 {
+		b := n.Bytes()
+		if len(b) == 0 || b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		return b
+	}
+	// -n - 1 has the complemented bits of n.
+	m := new(big.Int).Neg(n)
+	m.Sub(m, big.NewInt(1))
+	b := m.Bytes()
+	if len(b) == 0 || b[0]&0x80 != 0 {
+		b = append([]byte{0}, b...)
+	}
+	for i := range b {
+		b[i] = ^b[i]
+	}
+	return b
+}
+
+// encodeDefinitionLevels encodes one-bit definition levels with the RLE
+// encoding, as runs of equal levels.
+func encodeDefinitionLevels(levels []bool) []byte {
+	var buf bytes.Buffer
+	for start := 0; start < len(levels); {
+		end := start
+		for end < len(levels) && levels[end] == levels[start] {
+			end++
+		}
+		writeUvarint(&buf, uint64(end-start)<<1) //nolint:gosec // run lengths are positive
+		if levels[start] {
+			buf.WriteByte(1)
+		} else {
+			buf.WriteByte(0)
+		}
+		start = end
+	}
+	return buf.Bytes()
+}
+
+func gzipBytes(b []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(b); err != nil {
+		return nil, fmt.Errorf("failed to compress page: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress page: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func encodeParquetFooter(columns []WarehouseColumn, chunks []parquetChunk, numRows int64) []byte {
+	w := &thriftWriter{}
+	w.i32Field(1, 1)
+	w.listField(2, thriftStruct, len(columns)+1, func(w *thriftWriter) {
+		w.structElem(func(w *thriftWriter) {
+			w.binaryField(4, []byte("schema"))
+			w.i32Field(5, int32(len(columns))) //nolint:gosec // a handful of columns
+		})
+		for _, col := range columns {
+			w.structElem(func(w *thriftWriter) { writeSchemaElement(w, col) })
+		}
+	})
+	w.i64Field(3, numRows)
+
+	var total int64
+	for _, c := range chunks {
+		total += c.uncompressed
+	}
+	w.listField(4, thriftStruct, 1, func(w *thriftWriter) {
+		w.structElem(func(w *thriftWriter) {
+			w.listField(1, thriftStruct, len(chunks), func(w *thriftWriter) {
+				for _, c := range chunks {
+					w.structElem(func(w *thriftWriter) {
+						w.i64Field(2, c.offset)
+						w.structField(3, func(w *thriftWriter) {
+							w.i32Field(1, physicalType(c.column.Type))
+							w.listField(2, thriftI32, 2, func(w *thriftWriter) {
+								w.varint(parquetPlain)
+								w.varint(parquetRLE)
+							})
+							w.listField(3, thriftBinary, 1, func(w *thriftWriter) {
+								w.binary([]byte(c.column.Name))
+							})
+							w.i32Field(4, parquetGzip)
+							w.i64Field(5, numRows)
+							w.i64Field(6, c.uncompressed)
+							w.i64Field(7, c.compressed)
+							w.i64Field(9, c.offset)
+						})
+					})
+				}
+			})
+			w.i64Field(2, total)
+			w.i64Field(3, numRows)
+		})
+	})
+	w.binaryField(6, []byte("bib reporting-service"))
+	w.stop()
+	return w.buf.Bytes()
+}
+
+func writeSchemaElement(w *thriftWriter, col WarehouseColumn) {
+	w.i32Field(1, physicalType(col.Type))
+	repetition := int32(parquetRequired)
+	if col.Nullable {
+		repetition = parquetOptional
+	}
+	w.i32Field(3, repetition)
+	w.binaryField(4, []byte(col.Name))
+	switch col.Type {
+	case ColumnString:
+		w.i32Field(6, parquetUTF8)
+	case ColumnDate:
+		w.i32Field(6, parquetDate)
+	case ColumnTimestamp:
+		w.i32Field(6, parquetTimestampMicros)
+	case ColumnDecimal:
+		w.i32Field(6, parquetDecimal)
+		w.i32Field(7, WarehouseDecimalScale)
+		w.i32Field(8, WarehouseDecimalPrecision)
+	}
+}
+
+func physicalType(t ColumnType) int32 {
+	switch t {
+	case ColumnInt64, ColumnTimestamp:
+		return parquetInt64
+	case ColumnDate:
+		return parquetInt32
+	default:
+		return parquetByteArray
+	}
+}
+
+// Thrift compact protocol type IDs.
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftWriter writes the Thrift compact protocol encoding of the Parquet
+// metadata structures.
+type thriftWriter struct {
+	buf  bytes.Buffer
+	last []int16
+}
+
+func (w *thriftWriter) fieldHeader(id int16, typ byte) {
+	var last int16
+	if n := len(w.last); n > 0 {
+		last = w.last[n-1]
+		w.last[n-1] = id
+	} else {
+		w.last = append(w.last, id)
+	}
+	if delta := id - last; delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | typ)
+		return
+	}
+	w.buf.WriteByte(typ)
+	w.varint(int64(id))
+}
+
+func (w *thriftWriter) i32Field(id int16, v int32) {
+	w.fieldHeader(id, thriftI32)
+	w.varint(int64(v))
+}
+
+func (w *thriftWriter) i64Field(id int16, v int64) {
+	w.fieldHeader(id, thriftI64)
+	w.varint(v)
+}
+
+func (w *thriftWriter) binaryField(id int16, b []byte) {
+	w.fieldHeader(id, thriftBinary)
+	w.binary(b)
+}
+
+func (w *thriftWriter) structField(id int16, body func(*thriftWriter)) {
+	w.fieldHeader(id, thriftStruct)
+	w.structElem(body)
+}
+
+func (w *thriftWriter) listField(id int16, elem byte, size int, body func(*thriftWriter)) {
+	w.fieldHeader(id, thriftList)
+	if size < 15 {
+		w.buf.WriteByte(byte(size)<<4 | elem) //nolint:gosec // size is below 15
+	} else {
+		w.buf.WriteByte(0xF0 | elem)
+		writeUvarint(&w.buf, uint64(size)) //nolint:gosec // sizes are positive
+	}
+	body(w)
+}
+
+// structElem writes a nested struct with its own field ID sequence.
+func (w *thriftWriter) structElem(body func(*thriftWriter)) {
+	w.last = append(w.last, 0)
+	body(w)
+	w.stop()
+	w.last = w.last[:len(w.last)-1]
+}
+
+func (w *thriftWriter) binary(b []byte) {
+	writeUvarint(&w.buf, uint64(len(b)))
+	w.buf.Write(b)
+}
+
+// varint writes a zigzag-encoded integer.
+func (w *thriftWriter) varint(v int64) {
+	writeUvarint(&w.buf, uint64((v<<1)^(v>>63))) //nolint:gosec // zigzag encoding
+}
+
+func (w *thriftWriter) stop() {
+	w.buf.WriteByte(0)
+}
+
+func writeUvarint(buf *bytes.Buffer, v uint64) {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	buf.Write(tmp[:n])
+}
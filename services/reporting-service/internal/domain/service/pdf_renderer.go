@@ -0,0 +1,61 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+// renderPDF lays the report out as a single-page A4 PDF summary using the
+// standard Helvetica fonts, which every PDF reader provides.
+func renderPDF(reportType valueobject.ReportType, data ReportData, lines []ReportLine) []byte {
+	var content strings.Builder
+	text := func(font string, size, x, y int, s string) {
+		content.WriteString(fmt.Sprintf("BT /%s %d Tf %d %d Td (%s) Tj ET\n", font, size, x, y, escapePDF(s)))
+	}
+
+	text("F2", 18, 56, 780, fmt.Sprintf("%s report - %s", reportType, data.Period))
+	text("F1", 10, 56, 760, fmt.Sprintf("Tenant %s", data.TenantID))
+	text("F2", 11, 56, 724, "Metric")
+	text("F2", 11, 300, 724, "Value")
+	text("F2", 11, 460, 724, "Unit")
+	y := 704
+	for _, l := range lines {
+		text("F1", 11, 56, y, l.Label)
+		text("F1", 11, 300, y, l.FormattedValue())
+		text("F1", 11, 460, y, l.Unit)
+		y -= 20
+	}
+
+	stream := content.String()
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] /Contents 4 0 R /Resources << /Font << /F1 5 0 R /F2 6 0 R >> >> >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(stream), stream),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold >>",
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		buf.WriteString(fmt.Sprintf("%d 0 obj\n%s\nendobj\n", i+1, obj))
+	}
+	xref := buf.Len()
+	buf.WriteString(fmt.Sprintf("xref\n0 %d\n0000000000 65535 f \n", len(objects)+1))
+	for _, off := range offsets {
+		buf.WriteString(fmt.Sprintf("%010d 00000 n \n", off))
+	}
+	buf.WriteString(fmt.Sprintf("trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref))
+	return buf.Bytes()
+}
+
+// escapePDF escapes the characters that delimit PDF string literals.
+func escapePDF(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`).Replace(s)
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Fact change kinds reported by DiffFacts.
+const (
+	FactAdded    = "ADDED"
+	FactRemoved  = "REMOVED"
+	FactModified = "MODIFIED"
+)
+
+// XBRLFact is one reported fact of an XBRL instance.
+type XBRLFact struct {
+	Concept string
+	Context string
+	Unit    string
+	Value   string
+}
+
+// key identifies the fact across versions of the same report.
+func (f XBRLFact) key() string {
+	return f.Concept + "|" + f.Context
+}
+
+// FactChange is a difference in one fact between two report versions. Delta
+// is set when both values are numeric.
+type FactChange struct {
+	Delta   *decimal.Decimal
+	Concept string
+	Context string
+	Unit    string
+	Change  string
+	From    string
+	To      string
+}
+
+// ParseXBRLFacts extracts the facts of an XBRL instance: the elements that
+// carry a contextRef. Concepts are named prefix:LocalName using the prefix
+// declared in the instance.
+func ParseXBRLFacts(content string) ([]XBRLFact, error) {
+	var (
+		facts    []XBRLFact
+		prefixes = make(map[string]string)
+		current  *XBRLFact
+		value    strings.Builder
+	)
+
+	dec := xml.NewDecoder(strings.NewReader(content))
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse XBRL: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			fact := XBRLFact{Concept: t.Name.Local}
+			isFact := false
+			for _, a := range t.Attr {
+				switch {
+				case a.Name.Space == "xmlns":
+					prefixes[a.Value] = a.Name.Local
+				case a.Name.Local == "contextRef":
+					fact.Context = a.Value
+					isFact = true
+				case a.Name.Local == "unitRef":
+					fact.Unit = a.Value
+				}
+			}
+			if isFact {
+				if prefix, ok := prefixes[t.Name.Space]; ok {
+					fact.Concept = prefix + ":" + t.Name.Local
+				}
+				current = &fact
+				value.Reset()
+			}
+		case xml.CharData:
+			if current != nil {
+				value.Write(t)
+			}
+		case xml.EndElement:
+			if current != nil {
+				current.Value = strings.TrimSpace(value.String())
+				facts = append(facts, *current)
+				current = nil
+			}
+		}
+	}
+	return facts, nil
+}
+
+// DiffFacts compares the facts of two XBRL instances and returns the facts
+// that were added, removed or changed, ordered by concept.
+func DiffFacts(from, to string) ([]FactChange, error) {
+	fromFacts, err := ParseXBRLFacts(from)
+	if err != nil {
+		return nil, err
+	}
+	toFacts, err := ParseXBRLFacts(to)
+	if err != nil {
+		return nil, err
+	}
+
+	before := make(map[string]XBRLFact, len(fromFacts))
+	for _, f := range fromFacts {
+		before[f.key()] = f
+	}
+
+	var changes []FactChange
+	for _, f := range toFacts {
+		prev, ok := before[f.key()]
+		delete(before, f.key())
+		switch {
+		case !ok:
+			changes = append(changes, FactChange{Concept: f.Concept, Context: f.Context, Unit: f.Unit, Change: FactAdded, To: f.Value})
+		case !sameValue(prev.Value, f.Value) || prev.Unit != f.Unit:
+			change := FactChange{
+				Concept: f.Concept, Context: f.Context, Unit: f.Unit, Change: FactModified,
+				From: prev.Value, To: f.Value,
+			}
+			if a, errA := decimal.NewFromString(prev.Value); errA == nil {
+				if b, errB := decimal.NewFromString(f.Value); errB == nil {
+					delta := b.Sub(a)
+					change.Delta = &delta
+				}
+			}
+			changes = append(changes, change)
+		}
+	}
+	for _, f := range before {
+		changes = append(changes, FactChange{Concept: f.Concept, Context: f.Context, Unit: f.Unit, Change: FactRemoved, From: f.Value})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Concept != changes[j].Concept {
+			return changes[i].Concept < changes[j].Concept
+		}
+		return changes[i].Context < changes[j].Context
+	})
+	return changes, nil
+}
+
+// sameValue compares fact values numerically when both are numbers, so that
+// 1.50 and 1.5 are not reported as a change.
+func sameValue(a, b string) bool {
+	da, errA := decimal.NewFromString(a)
+	db, errB := decimal.NewFromString(b)
+	if errA == nil && errB == nil {
+		return da.Equal(db)
+	}
+	return a == b
+}
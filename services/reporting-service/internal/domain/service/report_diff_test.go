@@ -0,0 +1,62 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+func TestParseXBRLFacts(t *testing.T) {
+	content, err := service.NewXBRLGenerator().Generate(valueobject.ReportTypeCOREP, service.ReportData{
+		Period:             "2025-Q1",
+		TenantID:           uuid.New(),
+		RiskWeightedAssets: decimal.NewFromInt(600),
+		CET1Ratio:          decimal.NewFromFloat(0.16),
+	})
+	require.NoError(t, err)
+
+	facts, err := service.ParseXBRLFacts(content)
+	require.NoError(t, err)
+	require.Len(t, facts, 5)
+	assert.Equal(t, service.XBRLFact{Concept: "corep:RiskWeightedAssets", Context: "ctx_2025-Q1", Unit: "u_EUR", Value: "600"}, facts[0])
+	assert.Equal(t, "0.1600", facts[1].Value)
+}
+
+func TestDiffFacts(t *testing.T) {
+	from := `<x:xbrl xmlns:x="http://www.xbrl.org/2003/instance" xmlns:m="urn:m">
+  <m:A contextRef="c" unitRef="u">100</m:A>
+  <m:B contextRef="c" unitRef="u">1.50</m:B>
+  <m:C contextRef="c">old</m:C>
+</x:xbrl>`
+	to := `<x:xbrl xmlns:x="http://www.xbrl.org/2003/instance" xmlns:m="urn:m">
+  <m:A contextRef="c" unitRef="u">90.5</m:A>
+  <m:B contextRef="c" unitRef="u">1.5</m:B>
+  <m:D contextRef="c">new</m:D>
+</x:xbrl>`
+
+	changes, err := service.DiffFacts(from, to)
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+
+	assert.Equal(t, "m:A", changes[0].Concept)
+	assert.Equal(t, service.FactModified, changes[0].Change)
+	require.NotNil(t, changes[0].Delta)
+	assert.Equal(t, "-9.5", changes[0].Delta.String())
+
+	assert.Equal(t, "m:C", changes[1].Concept)
+	assert.Equal(t, service.FactRemoved, changes[1].Change)
+	assert.Equal(t, "old", changes[1].From)
+
+	assert.Equal(t, "m:D", changes[2].Concept)
+	assert.Equal(t, service.FactAdded, changes[2].Change)
+	assert.Nil(t, changes[2].Delta)
+
+	_, err = service.DiffFacts("<broken", to)
+	assert.Error(t, err)
+}
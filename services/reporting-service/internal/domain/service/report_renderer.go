@@ -0,0 +1,97 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+// ReportLine is one reported figure, as it appears in the report's XBRL facts.
+type ReportLine struct {
+	Metric string
+	Label  string
+	Unit   string
+	Value  decimal.Decimal
+	Places int32
+}
+
+// FormattedValue returns the value rounded to the line's reporting precision.
+func (l ReportLine) FormattedValue() string {
+	return l.Value.StringFixed(l.Places)
+}
+
+// ReportLines returns the figures a report of the given type discloses, in
+// the order its XBRL instance lists them.
+func ReportLines(reportType valueobject.ReportType, data ReportData) ([]ReportLine, error) {
+	var (
+		totalAssets      = ReportLine{Metric: "TotalAssets", Label: "Total assets", Unit: "EUR", Value: data.TotalAssets}
+		totalLiabilities = ReportLine{Metric: "TotalLiabilities", Label: "Total liabilities", Unit: "EUR", Value: data.TotalLiabilities}
+		totalEquity      = ReportLine{Metric: "TotalEquity", Label: "Total equity", Unit: "EUR", Value: data.TotalEquity}
+		netIncome        = ReportLine{Metric: "NetIncome", Label: "Net income", Unit: "EUR", Value: data.NetIncome}
+		interestIncome   = ReportLine{Metric: "InterestIncome", Label: "Interest income", Unit: "EUR", Value: data.InterestIncome}
+		interestExpense  = ReportLine{Metric: "InterestExpense", Label: "Interest expense", Unit: "EUR", Value: data.InterestExpense}
+		rwa              = ReportLine{Metric: "RiskWeightedAssets", Label: "Risk-weighted assets", Unit: "EUR", Value: data.RiskWeightedAssets}
+		cet1             = ReportLine{Metric: "CET1Ratio", Label: "CET1 ratio", Unit: "pure", Value: data.CET1Ratio, Places: 4}
+		lcr              = ReportLine{Metric: "LCRRatio", Label: "Liquidity coverage ratio", Unit: "pure", Value: data.LCRRatio, Places: 4}
+		nsfr             = ReportLine{Metric: "NSFRRatio", Label: "Net stable funding ratio", Unit: "pure", Value: data.NSFRRatio, Places: 4}
+	)
+
+	switch {
+	case reportType.Equal(valueobject.ReportTypeCOREP):
+		return []ReportLine{rwa, cet1, totalEquity, lcr, nsfr}, nil
+	case reportType.Equal(valueobject.ReportTypeFINREP):
+		return []ReportLine{totalAssets, totalLiabilities, totalEquity, interestIncome, interestExpense, netIncome}, nil
+	case reportType.Equal(valueobject.ReportTypeMREL):
+		return []ReportLine{totalEquity, totalLiabilities, rwa, cet1}, nil
+	case reportType.Equal(valueobject.ReportTypeCUSTOM):
+		return []ReportLine{totalAssets, totalLiabilities, totalEquity, netIncome}, nil
+	default:
+		return nil, fmt.Errorf("unsupported report type: %s", reportType)
+	}
+}
+
+// ReportRenderer is a domain service that renders report data in the
+// formats internal consumers download: CSV, XLSX and a PDF summary.
+// Regulatory filings remain XBRL, produced by the XBRLGenerator.
+type ReportRenderer struct{}
+
+// NewReportRenderer creates a new ReportRenderer.
+func NewReportRenderer() *ReportRenderer {
+	return &ReportRenderer{}
+}
+
+// Render renders the report's figures in the given format.
+func (r *ReportRenderer) Render(format valueobject.ReportFormat, reportType valueobject.ReportType, data ReportData) ([]byte, error) {
+	lines, err := ReportLines(reportType, data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case format.Equal(valueobject.ReportFormatCSV):
+		return renderCSV(reportType, data, lines)
+	case format.Equal(valueobject.ReportFormatXLSX):
+		return renderXLSX(reportType, data, lines)
+	case format.Equal(valueobject.ReportFormatPDF):
+		return renderPDF(reportType, data, lines), nil
+	default:
+		return nil, fmt.Errorf("unsupported render format: %s", format)
+	}
+}
+
+func renderCSV(reportType valueobject.ReportType, data ReportData, lines []ReportLine) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	records := [][]string{{"report_type", "period", "metric", "label", "value", "unit"}}
+	for _, l := range lines {
+		records = append(records, []string{reportType.String(), data.Period, l.Metric, l.Label, l.FormattedValue(), l.Unit})
+	}
+	if err := w.WriteAll(records); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,68 @@
+package service_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+func TestReportRenderer_CSV(t *testing.T) {
+	out, err := service.NewReportRenderer().Render(valueobject.ReportFormatCSV, valueobject.ReportTypeCOREP, sampleReportData())
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(bytes.NewReader(out)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 6)
+	assert.Equal(t, []string{"report_type", "period", "metric", "label", "value", "unit"}, records[0])
+	assert.Equal(t, []string{"COREP", "2025-Q1", "RiskWeightedAssets", "Risk-weighted assets", "800000000", "EUR"}, records[1])
+	assert.Equal(t, []string{"COREP", "2025-Q1", "CET1Ratio", "CET1 ratio", "0.1475", "pure"}, records[2])
+}
+
+func TestReportRenderer_XLSX(t *testing.T) {
+	out, err := service.NewReportRenderer().Render(valueobject.ReportFormatXLSX, valueobject.ReportTypeFINREP, sampleReportData())
+	require.NoError(t, err)
+
+	zr, err := zip.NewReader(bytes.NewReader(out), int64(len(out)))
+	require.NoError(t, err)
+	files := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		body, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		files[f.Name] = string(body)
+	}
+
+	assert.Contains(t, files, "[Content_Types].xml")
+	assert.Contains(t, files["xl/workbook.xml"], `name="FINREP"`)
+	sheet := files["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, sheet, "<t>Total assets</t>")
+	assert.Contains(t, sheet, `<c r="C6"><v>1500000000</v></c>`)
+}
+
+func TestReportRenderer_PDF(t *testing.T) {
+	out, err := service.NewReportRenderer().Render(valueobject.ReportFormatPDF, valueobject.ReportTypeMREL, sampleReportData())
+	require.NoError(t, err)
+
+	pdf := string(out)
+	assert.True(t, strings.HasPrefix(pdf, "%PDF-1.4"))
+	assert.True(t, strings.HasSuffix(pdf, "%%EOF\n"))
+	assert.Contains(t, pdf, "(MREL report - 2025-Q1)")
+	assert.Contains(t, pdf, "(Risk-weighted assets)")
+	assert.Contains(t, pdf, "(0.1475)")
+}
+
+func TestReportRenderer_RejectsXBRL(t *testing.T) {
+	_, err := service.NewReportRenderer().Render(valueobject.ReportFormatXBRL, valueobject.ReportTypeCOREP, sampleReportData())
+	assert.Error(t, err)
+}
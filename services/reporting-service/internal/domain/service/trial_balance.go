@@ -0,0 +1,166 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ErrReportUnbalanced is returned when the books or the report figures do not
+// balance. A report built from them would misstate the institution's position
+// and must not be generated.
+var ErrReportUnbalanced = errors.New("report totals do not balance")
+
+// Account classes, taken from the leading digit of the ledger account code.
+const (
+	accountClassAsset     = '1'
+	accountClassLiability = '2'
+	accountClassEquity    = '3'
+	accountClassRevenue   = '4' // classes 4 to 9 are income statement accounts
+)
+
+// Income statement account prefixes FINREP breaks interest out under. The
+// ledger recognizes accrued loan interest as interest income and accrued
+// deposit interest as interest expense, so both are reported as earned and
+// incurred rather than as paid.
+const (
+	interestIncomePrefix  = "40"
+	interestExpensePrefix = "51"
+)
+
+// Standardised approximations used to derive the prudential figures from the
+// balance sheet until risk weights are held per exposure.
+var (
+	// riskWeights maps asset account prefixes to their risk weight; assets
+	// under any other prefix carry the full weight.
+	riskWeights = map[string]decimal.Decimal{
+		"10": decimal.Zero,              // cash and central bank reserves
+		"11": decimal.NewFromFloat(0.2), // loans and advances to banks
+	}
+)
+
+// TrialBalanceLine holds the debit and credit totals posted to one ledger
+// account in a period.
+type TrialBalanceLine struct {
+	AccountCode string
+	Debit       decimal.Decimal
+	Credit      decimal.Decimal
+}
+
+// TrialBalance is the ledger's trial balance for a tenant and period.
+type TrialBalance struct {
+	Period   string
+	Lines    []TrialBalanceLine
+	TenantID uuid.UUID
+}
+
+// TotalDebits returns the sum of debits across all accounts.
+func (tb TrialBalance) TotalDebits() decimal.Decimal {
+	total := decimal.Zero
+	for _, l := range tb.Lines {
+		total = total.Add(l.Debit)
+	}
+	return total
+}
+
+// TotalCredits returns the sum of credits across all accounts.
+func (tb TrialBalance) TotalCredits() decimal.Decimal {
+	total := decimal.Zero
+	for _, l := range tb.Lines {
+		total = total.Add(l.Credit)
+	}
+	return total
+}
+
+// AggregateTrialBalance builds the report figures from a trial balance. Debits
+// must equal credits and every account must belong to a known class. Revenue
+// and expenses not yet closed to retained earnings are reported as net income
+// and included in equity. The liquidity ratios are computed from the balances
+// as classified by weights.
+func AggregateTrialBalance(tb TrialBalance, weights LiquidityWeights) (ReportData, error) {
+	debits, credits := tb.TotalDebits(), tb.TotalCredits()
+	if !debits.Equal(credits) {
+		return ReportData{}, fmt.Errorf("%w: trial balance debits %s != credits %s", ErrReportUnbalanced, debits, credits)
+	}
+
+	var (
+		assets, liabilities, equity, netIncome = decimal.Zero, decimal.Zero, decimal.Zero, decimal.Zero
+		interestIncome, interestExpense        = decimal.Zero, decimal.Zero
+		rwa                                    = decimal.Zero
+	)
+	for _, l := range tb.Lines {
+		if l.AccountCode == "" {
+			return ReportData{}, fmt.Errorf("trial balance line has no account code")
+		}
+		debitBalance := l.Debit.Sub(l.Credit)
+		switch class := l.AccountCode[0]; {
+		case class == accountClassAsset:
+			assets = assets.Add(debitBalance)
+			rwa = rwa.Add(debitBalance.Mul(riskWeight(l.AccountCode)))
+		case class == accountClassLiability:
+			liabilities = liabilities.Sub(debitBalance)
+		case class == accountClassEquity:
+			equity = equity.Sub(debitBalance)
+		case class >= accountClassRevenue && class <= '9':
+			netIncome = netIncome.Sub(debitBalance)
+			switch {
+			case strings.HasPrefix(l.AccountCode, interestIncomePrefix):
+				interestIncome = interestIncome.Sub(debitBalance)
+			case strings.HasPrefix(l.AccountCode, interestExpensePrefix):
+				interestExpense = interestExpense.Add(debitBalance)
+			}
+		default:
+			return ReportData{}, fmt.Errorf("account %s has no balance sheet classification", l.AccountCode)
+		}
+	}
+	equity = equity.Add(netIncome)
+	liquidity := ComputeLiquidity(tb, weights)
+
+	data := ReportData{
+		TenantID:           tb.TenantID,
+		Period:             tb.Period,
+		TotalAssets:        assets,
+		TotalLiabilities:   liabilities,
+		TotalEquity:        equity,
+		NetIncome:          netIncome,
+		InterestIncome:     interestIncome,
+		InterestExpense:    interestExpense,
+		RiskWeightedAssets: rwa,
+		CET1Ratio:          ratio(equity, rwa),
+		LCRRatio:           liquidity.LCR,
+		NSFRRatio:          liquidity.NSFR,
+	}
+	return data, Reconcile(data)
+}
+
+// Reconcile checks that the report figures balance: total assets must equal
+// total liabilities plus equity.
+func Reconcile(data ReportData) error {
+	funding := data.TotalLiabilities.Add(data.TotalEquity)
+	if !data.TotalAssets.Equal(funding) {
+		return fmt.Errorf("%w: total assets %s != liabilities %s + equity %s",
+			ErrReportUnbalanced, data.TotalAssets, data.TotalLiabilities, data.TotalEquity)
+	}
+	return nil
+}
+
+func riskWeight(accountCode string) decimal.Decimal {
+	for prefix, weight := range riskWeights {
+		if strings.HasPrefix(accountCode, prefix) {
+			return weight
+		}
+	}
+	return decimal.NewFromInt(1)
+}
+
+// ratio returns numerator/denominator rounded to four places, or zero when the
+// denominator is not positive.
+func ratio(numerator, denominator decimal.Decimal) decimal.Decimal {
+	if !denominator.IsPositive() {
+		return decimal.Zero
+	}
+	return numerator.DivRound(denominator, 4)
+}
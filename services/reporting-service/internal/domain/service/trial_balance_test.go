@@ -0,0 +1,97 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+func line(code string, debit, credit int64) service.TrialBalanceLine {
+	return service.TrialBalanceLine{
+		AccountCode: code,
+		Debit:       decimal.NewFromInt(debit),
+		Credit:      decimal.NewFromInt(credit),
+	}
+}
+
+func balancedTrialBalance() service.TrialBalance {
+	return service.TrialBalance{
+		TenantID: uuid.New(),
+		Period:   "2025-Q1",
+		Lines: []service.TrialBalanceLine{
+			line("1000-001", 200, 0), // cash
+			line("1100", 100, 0),     // interbank loans
+			line("1200", 700, 0),     // customer loans
+			line("2000-001", 0, 800), // customer deposits
+			line("3000", 0, 150),     // share capital
+			line("4000", 0, 80),      // interest income
+			line("5000", 30, 0),      // operating expenses
+		},
+	}
+}
+
+func TestAggregateTrialBalance(t *testing.T) {
+	tb := balancedTrialBalance()
+
+	data, err := service.AggregateTrialBalance(tb, service.DefaultLiquidityWeights())
+	require.NoError(t, err)
+
+	assert.Equal(t, tb.TenantID, data.TenantID)
+	assert.Equal(t, "2025-Q1", data.Period)
+	assert.True(t, data.TotalAssets.Equal(decimal.NewFromInt(1000)), data.TotalAssets.String())
+	assert.True(t, data.TotalLiabilities.Equal(decimal.NewFromInt(800)), data.TotalLiabilities.String())
+	assert.True(t, data.NetIncome.Equal(decimal.NewFromInt(50)), data.NetIncome.String())
+	assert.True(t, data.TotalEquity.Equal(decimal.NewFromInt(200)), data.TotalEquity.String())
+	// Cash at 0%, interbank at 20%, customer loans at 100%.
+	assert.True(t, data.RiskWeightedAssets.Equal(decimal.NewFromInt(720)), data.RiskWeightedAssets.String())
+	assert.True(t, data.CET1Ratio.Equal(decimal.RequireFromString("0.2778")), data.CET1Ratio.String())
+	assert.True(t, data.LCRRatio.Equal(decimal.RequireFromString("2.5")), data.LCRRatio.String())
+	assert.True(t, data.NSFRRatio.Equal(decimal.RequireFromString("1.5082")), data.NSFRRatio.String())
+}
+
+func TestAggregateTrialBalance_BreaksOutAccruedInterest(t *testing.T) {
+	tb := balancedTrialBalance()
+	tb.Lines = append(tb.Lines,
+		line("1210-001", 6, 0), // loan interest receivable
+		line("4000-001", 0, 6), // accrued loan interest income
+		line("5100-001", 4, 0), // accrued deposit interest expense
+		line("2010-001", 0, 4), // deposit interest payable
+	)
+
+	data, err := service.AggregateTrialBalance(tb, service.DefaultLiquidityWeights())
+	require.NoError(t, err)
+
+	assert.True(t, data.InterestIncome.Equal(decimal.NewFromInt(86)), data.InterestIncome.String())
+	assert.True(t, data.InterestExpense.Equal(decimal.NewFromInt(4)), data.InterestExpense.String())
+	assert.True(t, data.NetIncome.Equal(decimal.NewFromInt(52)), data.NetIncome.String())
+}
+
+func TestAggregateTrialBalance_RejectsUnbalancedBooks(t *testing.T) {
+	tb := balancedTrialBalance()
+	tb.Lines = append(tb.Lines, line("1200", 5, 0))
+
+	_, err := service.AggregateTrialBalance(tb, service.DefaultLiquidityWeights())
+	assert.ErrorIs(t, err, service.ErrReportUnbalanced)
+}
+
+func TestAggregateTrialBalance_RejectsUnclassifiedAccount(t *testing.T) {
+	tb := balancedTrialBalance()
+	tb.Lines = append(tb.Lines, line("0900", 5, 0), line("2000-001", 0, 5))
+
+	_, err := service.AggregateTrialBalance(tb, service.DefaultLiquidityWeights())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "0900")
+}
+
+func TestReconcile(t *testing.T) {
+	data := sampleReportData()
+	assert.NoError(t, service.Reconcile(data))
+
+	data.TotalEquity = data.TotalEquity.Sub(decimal.NewFromInt(1))
+	assert.ErrorIs(t, service.Reconcile(data), service.ErrReportUnbalanced)
+}
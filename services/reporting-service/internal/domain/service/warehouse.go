@@ -0,0 +1,289 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ColumnType is the logical type of a warehouse column.
+type ColumnType string
+
+// Warehouse column types.
+const (
+	ColumnString    ColumnType = "STRING"
+	ColumnInt64     ColumnType = "INT64"
+	ColumnTimestamp ColumnType = "TIMESTAMP"
+	ColumnDate      ColumnType = "DATE"
+	ColumnDecimal   ColumnType = "DECIMAL"
+)
+
+// Warehouse datasets.
+const (
+	DatasetAccounts       = "accounts"
+	DatasetPayments       = "payments"
+	DatasetLoans          = "loans"
+	DatasetJournalEntries = "journal_entries"
+)
+
+// WarehouseColumn describes one column of a warehouse dataset.
+type WarehouseColumn struct {
+	Name     string     `json:"name"`
+	Type     ColumnType `json:"type"`
+	Nullable bool       `json:"nullable"`
+}
+
+// WarehouseSchema is a version of a dataset's schema. Additive changes keep
+// the version; breaking changes start a new one, landed under its own prefix.
+type WarehouseSchema struct {
+	UpdatedAt time.Time         `json:"updated_at"`
+	Dataset   string            `json:"dataset"`
+	Columns   []WarehouseColumn `json:"columns"`
+	Version   int               `json:"version"`
+}
+
+// SchemaChange classifies how a dataset's columns changed between exports.
+type SchemaChange string
+
+// Schema changes.
+const (
+	SchemaUnchanged SchemaChange = "UNCHANGED"
+	SchemaAdditive  SchemaChange = "ADDITIVE"
+	SchemaBreaking  SchemaChange = "BREAKING"
+	SchemaCreated   SchemaChange = "CREATED"
+)
+
+// EvolveSchema compares a dataset's current columns with the schema it was
+// last exported with and returns the schema to export with. Adding nullable
+// columns is additive: files already landed read the new columns as null, so
+// the version is kept, as it is when a required column becomes nullable. Dropping or retyping a column, or adding a required
+// one, is breaking and starts a new version. A nil previous schema starts
+// version 1.
+func EvolveSchema(previous *WarehouseSchema, dataset string, columns []WarehouseColumn, now time.Time) (WarehouseSchema, SchemaChange) {
+	next := WarehouseSchema{Dataset: dataset, Columns: columns, Version: 1, UpdatedAt: now}
+	if previous == nil {
+		return next, SchemaCreated
+	}
+	next.Version = previous.Version
+
+	current := make(map[string]WarehouseColumn, len(columns))
+	for _, c := range columns {
+		current[c.Name] = c
+	}
+	relaxed := false
+	for _, old := range previous.Columns {
+		c, ok := current[old.Name]
+		if !ok || c.Type != old.Type || (old.Nullable && !c.Nullable) {
+			next.Version++
+			return next, SchemaBreaking
+		}
+		relaxed = relaxed || c.Nullable != old.Nullable
+	}
+	if len(columns) == len(previous.Columns) && !relaxed {
+		next.UpdatedAt = previous.UpdatedAt
+		return next, SchemaUnchanged
+	}
+
+	known := make(map[string]bool, len(previous.Columns))
+	for _, old := range previous.Columns {
+		known[old.Name] = true
+	}
+	for _, c := range columns {
+		if !known[c.Name] && !c.Nullable {
+			next.Version++
+			return next, SchemaBreaking
+		}
+	}
+	return next, SchemaAdditive
+}
+
+// WarehouseExport records a tenant's partition of a dataset landed in the
+// warehouse for a day.
+type WarehouseExport struct {
+	Day           time.Time
+	ExportedAt    time.Time
+	Dataset       string
+	ObjectKey     string
+	ContentSHA256 string
+	Rows          int
+	SchemaVersion int
+	TenantID      uuid.UUID
+}
+
+// WarehousePartitionKey returns the object key of a tenant's partition of a
+// dataset for a day, laid out as Hive-style partitions under the schema
+// version.
+func WarehousePartitionKey(prefix, dataset string, version int, day time.Time, tenantID uuid.UUID) string {
+	return fmt.Sprintf("%s%s/v%d/dt=%s/tenant_id=%s/part-00000.parquet",
+		prefix, dataset, version, day.Format(time.DateOnly), tenantID)
+}
+
+// WarehouseSchemaKey returns the object key of a dataset version's schema file.
+func WarehouseSchemaKey(prefix, dataset string, version int) string {
+	return fmt.Sprintf("%s%s/v%d/_schema.json", prefix, dataset, version)
+}
+
+// AccountRecord is a customer account as exported to the warehouse.
+type AccountRecord struct {
+	ID                string
+	AccountNumber     string
+	AccountType       string
+	Status            string
+	Currency          string
+	LedgerAccountCode string
+	TenantID          uuid.UUID
+}
+
+// PaymentRecord is a payment order as exported to the warehouse.
+type PaymentRecord struct {
+	CreatedAt            time.Time
+	SettledAt            *time.Time
+	ID                   string
+	SourceAccountID      string
+	DestinationAccountID string
+	Rail                 string
+	Status               string
+	Currency             string
+	Reference            string
+	Amount               decimal.Decimal
+	TenantID             uuid.UUID
+}
+
+// JournalEntryRecord is a journal entry as exported to the warehouse.
+type JournalEntryRecord struct {
+	EffectiveDate time.Time
+	CreatedAt     time.Time
+	ID            string
+	Status        string
+	Description   string
+	Reference     string
+	Postings      []JournalPostingRecord
+	TenantID      uuid.UUID
+}
+
+// JournalPostingRecord is one debit/credit pair of a journal entry.
+type JournalPostingRecord struct {
+	DebitAccount  string
+	CreditAccount string
+	Currency      string
+	Description   string
+	Amount        decimal.Decimal
+}
+
+// Warehouse dataset columns. Columns added later must be nullable for the
+// change to stay additive.
+var (
+	AccountColumns = []WarehouseColumn{
+		{Name: "account_id", Type: ColumnString},
+		{Name: "tenant_id", Type: ColumnString},
+		{Name: "account_number", Type: ColumnString},
+		{Name: "account_type", Type: ColumnString},
+		{Name: "status", Type: ColumnString},
+		{Name: "currency", Type: ColumnString},
+		{Name: "ledger_account_code", Type: ColumnString, Nullable: true},
+	}
+	PaymentColumns = []WarehouseColumn{
+		{Name: "payment_id", Type: ColumnString},
+		{Name: "tenant_id", Type: ColumnString},
+		{Name: "source_account_id", Type: ColumnString},
+		{Name: "destination_account_id", Type: ColumnString, Nullable: true},
+		{Name: "rail", Type: ColumnString},
+		{Name: "status", Type: ColumnString},
+		{Name: "currency", Type: ColumnString},
+		{Name: "amount", Type: ColumnDecimal},
+		{Name: "reference", Type: ColumnString, Nullable: true},
+		{Name: "created_at", Type: ColumnTimestamp},
+		{Name: "settled_at", Type: ColumnTimestamp, Nullable: true},
+	}
+	LoanColumns = []WarehouseColumn{
+		{Name: "loan_id", Type: ColumnString},
+		{Name: "tenant_id", Type: ColumnString},
+		{Name: "currency", Type: ColumnString},
+		{Name: "bucket", Type: ColumnString},
+		{Name: "principal", Type: ColumnDecimal},
+		{Name: "outstanding", Type: ColumnDecimal},
+		{Name: "disbursed_at", Type: ColumnTimestamp},
+		{Name: "updated_at", Type: ColumnTimestamp},
+	}
+	JournalEntryColumns = []WarehouseColumn{
+		{Name: "entry_id", Type: ColumnString},
+		{Name: "tenant_id", Type: ColumnString},
+		{Name: "effective_date", Type: ColumnDate},
+		{Name: "status", Type: ColumnString},
+		{Name: "description", Type: ColumnString, Nullable: true},
+		{Name: "reference", Type: ColumnString, Nullable: true},
+		{Name: "posting_index", Type: ColumnInt64},
+		{Name: "debit_account", Type: ColumnString},
+		{Name: "credit_account", Type: ColumnString},
+		{Name: "currency", Type: ColumnString},
+		{Name: "amount", Type: ColumnDecimal},
+		{Name: "created_at", Type: ColumnTimestamp},
+	}
+)
+
+// AccountRows flattens accounts into rows of AccountColumns.
+func AccountRows(accounts []AccountRecord) [][]any {
+	rows := make([][]any, 0, len(accounts))
+	for _, a := range accounts {
+		rows = append(rows, []any{
+			a.ID, a.TenantID.String(), a.AccountNumber, a.AccountType, a.Status, a.Currency,
+			nullString(a.LedgerAccountCode),
+		})
+	}
+	return rows
+}
+
+// PaymentRows flattens payments into rows of PaymentColumns.
+func PaymentRows(payments []PaymentRecord) [][]any {
+	rows := make([][]any, 0, len(payments))
+	for _, p := range payments {
+		var settledAt any
+		if p.SettledAt != nil {
+			settledAt = *p.SettledAt
+		}
+		rows = append(rows, []any{
+			p.ID, p.TenantID.String(), p.SourceAccountID, nullString(p.DestinationAccountID),
+			p.Rail, p.Status, p.Currency, p.Amount, nullString(p.Reference), p.CreatedAt, settledAt,
+		})
+	}
+	return rows
+}
+
+// LoanRows flattens loans into rows of LoanColumns.
+func LoanRows(loans []LoanPosition) [][]any {
+	rows := make([][]any, 0, len(loans))
+	for _, l := range loans {
+		rows = append(rows, []any{
+			l.ID, l.TenantID.String(), l.Currency, l.Bucket, l.Principal, l.Outstanding, l.DisbursedAt, l.UpdatedAt,
+		})
+	}
+	return rows
+}
+
+// JournalEntryRows flattens journal entries into rows of JournalEntryColumns,
+// one per posting.
+func JournalEntryRows(entries []JournalEntryRecord) [][]any {
+	var rows [][]any
+	for _, e := range entries {
+		for i, p := range e.Postings {
+			description := e.Description
+			if p.Description != "" {
+				description = p.Description
+			}
+			rows = append(rows, []any{
+				e.ID, e.TenantID.String(), e.EffectiveDate, e.Status, nullString(description), nullString(e.Reference),
+				int64(i), p.DebitAccount, p.CreditAccount, p.Currency, p.Amount, e.CreatedAt,
+			})
+		}
+	}
+	return rows
+}
+
+func nullString(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
@@ -0,0 +1,152 @@
+package service_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+func TestEvolveSchema(t *testing.T) {
+	created := day(1)
+	base := []service.WarehouseColumn{
+		{Name: "id", Type: service.ColumnString},
+		{Name: "amount", Type: service.ColumnDecimal},
+		{Name: "note", Type: service.ColumnString, Nullable: true},
+	}
+
+	first, change := service.EvolveSchema(nil, "payments", base, created)
+	assert.Equal(t, service.SchemaCreated, change)
+	assert.Equal(t, 1, first.Version)
+
+	same, change := service.EvolveSchema(&first, "payments", base, day(2))
+	assert.Equal(t, service.SchemaUnchanged, change)
+	assert.Equal(t, 1, same.Version)
+	assert.Equal(t, created, same.UpdatedAt)
+
+	tests := []struct {
+		name    string
+		columns []service.WarehouseColumn
+		change  service.SchemaChange
+		version int
+	}{
+		{
+			name:    "nullable column added",
+			columns: append(append([]service.WarehouseColumn{}, base...), service.WarehouseColumn{Name: "rail", Type: service.ColumnString, Nullable: true}),
+			change:  service.SchemaAdditive,
+			version: 1,
+		},
+		{
+			name:    "column relaxed to nullable",
+			columns: []service.WarehouseColumn{base[0], {Name: "amount", Type: service.ColumnDecimal, Nullable: true}, base[2]},
+			change:  service.SchemaAdditive,
+			version: 1,
+		},
+		{
+			name:    "required column added",
+			columns: append(append([]service.WarehouseColumn{}, base...), service.WarehouseColumn{Name: "rail", Type: service.ColumnString}),
+			change:  service.SchemaBreaking,
+			version: 2,
+		},
+		{
+			name:    "column dropped",
+			columns: base[:2],
+			change:  service.SchemaBreaking,
+			version: 2,
+		},
+		{
+			name:    "column retyped",
+			columns: []service.WarehouseColumn{base[0], {Name: "amount", Type: service.ColumnInt64}, base[2]},
+			change:  service.SchemaBreaking,
+			version: 2,
+		},
+		{
+			name:    "column tightened to required",
+			columns: []service.WarehouseColumn{base[0], base[1], {Name: "note", Type: service.ColumnString}},
+			change:  service.SchemaBreaking,
+			version: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, change := service.EvolveSchema(&first, "payments", tt.columns, day(2))
+			assert.Equal(t, tt.change, change)
+			assert.Equal(t, tt.version, next.Version)
+			assert.Equal(t, day(2), next.UpdatedAt)
+		})
+	}
+}
+
+func TestWarehouseKeys(t *testing.T) {
+	tenantID := uuid.MustParse("7d9f0c3e-0000-4000-8000-000000000001")
+	assert.Equal(t,
+		"curated/payments/v2/dt=2025-03-14/tenant_id=7d9f0c3e-0000-4000-8000-000000000001/part-00000.parquet",
+		service.WarehousePartitionKey("curated/", "payments", 2, day(14), tenantID))
+	assert.Equal(t, "curated/payments/v2/_schema.json", service.WarehouseSchemaKey("curated/", "payments", 2))
+}
+
+func TestJournalEntryRows(t *testing.T) {
+	entries := []service.JournalEntryRecord{{
+		ID: "je1", Description: "fee", EffectiveDate: day(14), CreatedAt: day(14),
+		Postings: []service.JournalPostingRecord{
+			{DebitAccount: "1000", CreditAccount: "4000", Amount: decimal.NewFromInt(10)},
+			{DebitAccount: "1000", CreditAccount: "2100", Amount: decimal.NewFromInt(2), Description: "tax"},
+		},
+	}}
+
+	rows := service.JournalEntryRows(entries)
+	require.Len(t, rows, 2)
+	for _, row := range rows {
+		require.Len(t, row, len(service.JournalEntryColumns))
+	}
+	assert.Equal(t, "fee", rows[0][4])
+	assert.Equal(t, "tax", rows[1][4])
+	assert.Nil(t, rows[0][5])
+	assert.Equal(t, int64(1), rows[1][6])
+}
+
+func TestWriteParquet(t *testing.T) {
+	settled := day(14)
+	payments := []service.PaymentRecord{
+		{ID: "p1", SourceAccountID: "a1", Rail: "ACH", Status: "SETTLED", Currency: "USD",
+			Amount: decimal.RequireFromString("-12.345678"), CreatedAt: day(14), SettledAt: &settled},
+		{ID: "p2", SourceAccountID: "a2", Rail: "FEDNOW", Status: "INITIATED", Currency: "USD",
+			Amount: decimal.NewFromInt(5), CreatedAt: day(14)},
+	}
+
+	data, err := service.WriteParquet(service.PaymentColumns, service.PaymentRows(payments))
+	require.NoError(t, err)
+
+	require.Greater(t, len(data), 12)
+	assert.Equal(t, []byte("PAR1"), data[:4])
+	assert.Equal(t, []byte("PAR1"), data[len(data)-4:])
+	footer := binary.LittleEndian.Uint32(data[len(data)-8 : len(data)-4])
+	require.Less(t, int(footer), len(data)-12)
+	assert.True(t, bytes.Contains(data[len(data)-8-int(footer):], []byte("destination_account_id")))
+}
+
+func TestWriteParquet_RejectsInvalidValues(t *testing.T) {
+	columns := []service.WarehouseColumn{
+		{Name: "id", Type: service.ColumnString},
+		{Name: "at", Type: service.ColumnTimestamp, Nullable: true},
+	}
+
+	_, err := service.WriteParquet(columns, [][]any{{"a", nil}, {"b", time.Now()}})
+	require.NoError(t, err)
+
+	_, err = service.WriteParquet(columns, [][]any{{nil, nil}})
+	assert.ErrorContains(t, err, "column id is not nullable")
+
+	_, err = service.WriteParquet(columns, [][]any{{"a", "yesterday"}})
+	assert.ErrorContains(t, err, "expected time.Time")
+
+	_, err = service.WriteParquet(columns, [][]any{{"a"}})
+	assert.ErrorContains(t, err, "no value for column at")
+}
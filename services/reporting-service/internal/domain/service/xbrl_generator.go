@@ -3,6 +3,7 @@ package service
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -17,9 +18,12 @@ type ReportData struct {
 	TotalLiabilities   decimal.Decimal
 	TotalEquity        decimal.Decimal
 	NetIncome          decimal.Decimal
+	InterestIncome     decimal.Decimal
+	InterestExpense    decimal.Decimal
 	RiskWeightedAssets decimal.Decimal
 	CET1Ratio          decimal.Decimal
 	LCRRatio           decimal.Decimal
+	NSFRRatio          decimal.Decimal
 	TenantID           uuid.UUID
 }
 
@@ -99,6 +103,9 @@ func (g *XBRLGenerator) generateCOREP(data ReportData) string {
 	b.WriteString(fmt.Sprintf(`  <corep:LCRRatio contextRef="ctx_%s" unitRef="u_pure" decimals="4">%s</corep:LCRRatio>`,
 		data.Period, data.LCRRatio.StringFixed(4)))
 	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf(`  <corep:NSFRRatio contextRef="ctx_%s" unitRef="u_pure" decimals="4">%s</corep:NSFRRatio>`,
+		data.Period, data.NSFRRatio.StringFixed(4)))
+	b.WriteString("\n")
 	b.WriteString(`</xbrli:xbrl>`)
 	b.WriteString("\n")
 	return b.String()
@@ -145,6 +152,12 @@ func (g *XBRLGenerator) generateFINREP(data ReportData) string {
 	b.WriteString(fmt.Sprintf(`  <finrep:TotalEquity contextRef="ctx_%s" unitRef="u_EUR" decimals="0">%s</finrep:TotalEquity>`,
 		data.Period, data.TotalEquity.StringFixed(0)))
 	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf(`  <finrep:InterestIncome contextRef="ctx_%s" unitRef="u_EUR" decimals="0">%s</finrep:InterestIncome>`,
+		data.Period, data.InterestIncome.StringFixed(0)))
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf(`  <finrep:InterestExpense contextRef="ctx_%s" unitRef="u_EUR" decimals="0">%s</finrep:InterestExpense>`,
+		data.Period, data.InterestExpense.StringFixed(0)))
+	b.WriteString("\n")
 	b.WriteString(fmt.Sprintf(`  <finrep:NetIncome contextRef="ctx_%s" unitRef="u_EUR" decimals="0">%s</finrep:NetIncome>`,
 		data.Period, data.NetIncome.StringFixed(0)))
 	b.WriteString("\n")
@@ -254,8 +267,12 @@ func (g *XBRLGenerator) generateCustom(data ReportData) string {
 	return b.String()
 }
 
-// periodToInstant converts a period like "2025-Q1" to an instant date.
+// periodToInstant converts a period like "2025-Q1" or "2025-01" to the
+// instant it ends.
 func periodToInstant(period string) string {
+	if month, err := time.Parse("2006-01", period); err == nil {
+		return month.AddDate(0, 1, -1).Format("2006-01-02")
+	}
 	parts := strings.Split(period, "-")
 	if len(parts) != 2 {
 		return period
@@ -25,6 +25,7 @@ func sampleReportData() service.ReportData {
 		RiskWeightedAssets: decimal.NewFromInt(800_000_000),
 		CET1Ratio:          decimal.NewFromFloat(0.1475),
 		LCRRatio:           decimal.NewFromFloat(1.2500),
+		NSFRRatio:          decimal.NewFromFloat(1.1800),
 	}
 }
 
@@ -50,6 +51,7 @@ func TestXBRLGenerator_GenerateCOREP(t *testing.T) {
 	assert.Contains(t, content, `corep:RiskWeightedAssets`)
 	assert.Contains(t, content, `corep:CET1Ratio`)
 	assert.Contains(t, content, `corep:LCRRatio`)
+	assert.Contains(t, content, `corep:NSFRRatio`)
 	assert.Contains(t, content, `800000000`)
 	assert.Contains(t, content, `0.1475`)
 	assert.Contains(t, content, `1.2500`)
@@ -74,6 +76,8 @@ func TestXBRLGenerator_GenerateFINREP(t *testing.T) {
 	assert.Contains(t, content, `finrep:TotalAssets`)
 	assert.Contains(t, content, `finrep:TotalLiabilities`)
 	assert.Contains(t, content, `finrep:TotalEquity`)
+	assert.Contains(t, content, `finrep:InterestIncome`)
+	assert.Contains(t, content, `finrep:InterestExpense`)
 	assert.Contains(t, content, `finrep:NetIncome`)
 	assert.Contains(t, content, `1500000000`)
 	assert.Contains(t, content, `1350000000`)
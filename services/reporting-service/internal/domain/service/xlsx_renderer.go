@@ -0,0 +1,111 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+// The parts of a minimal single-sheet Office Open XML workbook. Cells use
+// inline strings so no shared string table is needed.
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+  <Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+  <Default Extension="xml" ContentType="application/xml"/>
+  <Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+  <Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>
+</Types>`
+	xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+</Relationships>`
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+  <sheets>
+    <sheet name="%s" sheetId="1" r:id="rId1"/>
+  </sheets>
+</workbook>`
+)
+
+func renderXLSX(reportType valueobject.ReportType, data ReportData, lines []ReportLine) ([]byte, error) {
+	rows := [][]xlsxCell{
+		{textCell("Report"), textCell(reportType.String())},
+		{textCell("Period"), textCell(data.Period)},
+		{textCell("Tenant"), textCell(data.TenantID.String())},
+		{},
+		{textCell("Metric"), textCell("Label"), textCell("Value"), textCell("Unit")},
+	}
+	for _, l := range lines {
+		rows = append(rows, []xlsxCell{textCell(l.Metric), textCell(l.Label), {number: l.FormattedValue()}, textCell(l.Unit)})
+	}
+
+	parts := []struct{ name, body string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", fmt.Sprintf(xlsxWorkbook, escapeXML(reportType.String()))},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/worksheets/sheet1.xml", xlsxSheet(rows)},
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, p := range parts {
+		f, err := zw.Create(p.name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s: %w", p.name, err)
+		}
+		if _, err := f.Write([]byte(p.body)); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", p.name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write XLSX: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// xlsxCell holds either a text or a numeric cell value.
+type xlsxCell struct {
+	text   string
+	number string
+}
+
+func textCell(s string) xlsxCell {
+	return xlsxCell{text: s}
+}
+
+func xlsxSheet(rows [][]xlsxCell) string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`)
+	b.WriteString("\n")
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for i, row := range rows {
+		b.WriteString(fmt.Sprintf(`<row r="%d">`, i+1))
+		for j, c := range row {
+			ref := fmt.Sprintf("%c%d", 'A'+j, i+1)
+			if c.number != "" {
+				b.WriteString(fmt.Sprintf(`<c r="%s"><v>%s</v></c>`, ref, c.number))
+				continue
+			}
+			b.WriteString(fmt.Sprintf(`<c r="%s" t="inlineStr"><is><t>%s</t></is></c>`, ref, escapeXML(c.text)))
+		}
+		b.WriteString(`</row>`)
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.String()
+}
+
+func escapeXML(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s)) //nolint:errcheck // strings.Builder writes do not fail
+	return b.String()
+}
@@ -0,0 +1,66 @@
+package valueobject
+
+import "fmt"
+
+// AmendmentReason represents why a filed report is amended. It is an
+// immutable value object.
+type AmendmentReason struct {
+	value string
+}
+
+const (
+	amendmentReasonDataCorrection    = "DATA_CORRECTION"
+	amendmentReasonLateAdjustment    = "LATE_ADJUSTMENT"
+	amendmentReasonReclassification  = "RECLASSIFICATION"
+	amendmentReasonMethodologyChange = "METHODOLOGY_CHANGE"
+	amendmentReasonRegulatorRequest  = "REGULATOR_REQUEST"
+	amendmentReasonOther             = "OTHER"
+)
+
+var (
+	// AmendmentReasonDataCorrection corrects figures that were reported in error.
+	AmendmentReasonDataCorrection = AmendmentReason{value: amendmentReasonDataCorrection}
+	// AmendmentReasonLateAdjustment reflects postings made after the period was filed.
+	AmendmentReasonLateAdjustment = AmendmentReason{value: amendmentReasonLateAdjustment}
+	// AmendmentReasonReclassification moves balances between reported lines.
+	AmendmentReasonReclassification = AmendmentReason{value: amendmentReasonReclassification}
+	// AmendmentReasonMethodologyChange applies a changed calculation method.
+	AmendmentReasonMethodologyChange = AmendmentReason{value: amendmentReasonMethodologyChange}
+	// AmendmentReasonRegulatorRequest answers a resubmission request from the regulator.
+	AmendmentReasonRegulatorRequest = AmendmentReason{value: amendmentReasonRegulatorRequest}
+	// AmendmentReasonOther covers anything else; it requires an explanatory note.
+	AmendmentReasonOther = AmendmentReason{value: amendmentReasonOther}
+)
+
+var validAmendmentReasons = map[string]AmendmentReason{
+	amendmentReasonDataCorrection:    AmendmentReasonDataCorrection,
+	amendmentReasonLateAdjustment:    AmendmentReasonLateAdjustment,
+	amendmentReasonReclassification:  AmendmentReasonReclassification,
+	amendmentReasonMethodologyChange: AmendmentReasonMethodologyChange,
+	amendmentReasonRegulatorRequest:  AmendmentReasonRegulatorRequest,
+	amendmentReasonOther:             AmendmentReasonOther,
+}
+
+// NewAmendmentReason creates an AmendmentReason from a string, validating it is known.
+func NewAmendmentReason(s string) (AmendmentReason, error) {
+	r, ok := validAmendmentReasons[s]
+	if !ok {
+		return AmendmentReason{}, fmt.Errorf("invalid amendment reason: %q", s)
+	}
+	return r, nil
+}
+
+// String returns the string representation of the AmendmentReason.
+func (r AmendmentReason) String() string {
+	return r.value
+}
+
+// IsZero returns true if the AmendmentReason has not been set.
+func (r AmendmentReason) IsZero() bool {
+	return r.value == ""
+}
+
+// Equal returns true if two AmendmentReason values are equal.
+func (r AmendmentReason) Equal(other AmendmentReason) bool {
+	return r.value == other.value
+}
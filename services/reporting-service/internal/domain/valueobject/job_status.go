@@ -0,0 +1,59 @@
+package valueobject
+
+import "fmt"
+
+// JobStatus represents the status of an asynchronous report generation job.
+// It is an immutable value object.
+type JobStatus struct {
+	value string
+}
+
+const (
+	jobStatusQueued    = "QUEUED"
+	jobStatusRunning   = "RUNNING"
+	jobStatusSucceeded = "SUCCEEDED"
+	jobStatusFailed    = "FAILED"
+)
+
+var (
+	JobStatusQueued    = JobStatus{value: jobStatusQueued}
+	JobStatusRunning   = JobStatus{value: jobStatusRunning}
+	JobStatusSucceeded = JobStatus{value: jobStatusSucceeded}
+	JobStatusFailed    = JobStatus{value: jobStatusFailed}
+)
+
+var validJobStatuses = map[string]JobStatus{
+	jobStatusQueued:    JobStatusQueued,
+	jobStatusRunning:   JobStatusRunning,
+	jobStatusSucceeded: JobStatusSucceeded,
+	jobStatusFailed:    JobStatusFailed,
+}
+
+// NewJobStatus creates a JobStatus from a string, validating it is known.
+func NewJobStatus(s string) (JobStatus, error) {
+	js, ok := validJobStatuses[s]
+	if !ok {
+		return JobStatus{}, fmt.Errorf("invalid job status: %q", s)
+	}
+	return js, nil
+}
+
+// String returns the string representation of the JobStatus.
+func (s JobStatus) String() string {
+	return s.value
+}
+
+// IsZero returns true if the JobStatus has not been set.
+func (s JobStatus) IsZero() bool {
+	return s.value == ""
+}
+
+// IsTerminal returns true once the job has finished, successfully or not.
+func (s JobStatus) IsTerminal() bool {
+	return s.value == jobStatusSucceeded || s.value == jobStatusFailed
+}
+
+// Equal returns true if two JobStatus values are equal.
+func (s JobStatus) Equal(other JobStatus) bool {
+	return s.value == other.value
+}
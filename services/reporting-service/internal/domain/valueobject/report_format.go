@@ -0,0 +1,81 @@
+package valueobject
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReportFormat represents the output format a report is rendered in.
+// It is an immutable value object.
+type ReportFormat struct {
+	value string
+}
+
+const (
+	reportFormatXBRL  = "XBRL"
+	reportFormatCSV   = "CSV"
+	reportFormatXLSX  = "XLSX"
+	reportFormatPDF   = "PDF"
+	reportFormatGoAML = "GOAML"
+)
+
+var (
+	ReportFormatXBRL  = ReportFormat{value: reportFormatXBRL}
+	ReportFormatCSV   = ReportFormat{value: reportFormatCSV}
+	ReportFormatXLSX  = ReportFormat{value: reportFormatXLSX}
+	ReportFormatPDF   = ReportFormat{value: reportFormatPDF}
+	ReportFormatGoAML = ReportFormat{value: reportFormatGoAML}
+)
+
+var validReportFormats = map[string]ReportFormat{
+	reportFormatXBRL:  ReportFormatXBRL,
+	reportFormatCSV:   ReportFormatCSV,
+	reportFormatXLSX:  ReportFormatXLSX,
+	reportFormatPDF:   ReportFormatPDF,
+	reportFormatGoAML: ReportFormatGoAML,
+}
+
+var reportFormatContentTypes = map[string]string{
+	reportFormatXBRL:  "application/xml",
+	reportFormatCSV:   "text/csv",
+	reportFormatXLSX:  "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	reportFormatPDF:   "application/pdf",
+	reportFormatGoAML: "application/xml",
+}
+
+// NewReportFormat creates a ReportFormat from a string, validating it is a known format.
+func NewReportFormat(s string) (ReportFormat, error) {
+	f, ok := validReportFormats[s]
+	if !ok {
+		return ReportFormat{}, fmt.Errorf("invalid report format: %q", s)
+	}
+	return f, nil
+}
+
+// String returns the string representation of the ReportFormat.
+func (f ReportFormat) String() string {
+	return f.value
+}
+
+// ContentType returns the MIME type of content in this format.
+func (f ReportFormat) ContentType() string {
+	return reportFormatContentTypes[f.value]
+}
+
+// Extension returns the file extension, without the dot, for this format.
+func (f ReportFormat) Extension() string {
+	if f.value == reportFormatGoAML {
+		return "xml"
+	}
+	return strings.ToLower(f.value)
+}
+
+// IsZero returns true if the ReportFormat has not been set.
+func (f ReportFormat) IsZero() bool {
+	return f.value == ""
+}
+
+// Equal returns true if two ReportFormat values are equal.
+func (f ReportFormat) Equal(other ReportFormat) bool {
+	return f.value == other.value
+}
@@ -13,6 +13,8 @@ const (
 	reportTypeFINREP = "FINREP"
 	reportTypeMREL   = "MREL"
 	reportTypeCUSTOM = "CUSTOM"
+	reportTypeSAR    = "SAR"
+	reportTypeCTR    = "CTR"
 )
 
 var (
@@ -20,6 +22,8 @@ var (
 	ReportTypeFINREP = ReportType{value: reportTypeFINREP}
 	ReportTypeMREL   = ReportType{value: reportTypeMREL}
 	ReportTypeCUSTOM = ReportType{value: reportTypeCUSTOM}
+	ReportTypeSAR    = ReportType{value: reportTypeSAR}
+	ReportTypeCTR    = ReportType{value: reportTypeCTR}
 )
 
 var validReportTypes = map[string]ReportType{
@@ -27,6 +31,8 @@ var validReportTypes = map[string]ReportType{
 	reportTypeFINREP: ReportTypeFINREP,
 	reportTypeMREL:   ReportTypeMREL,
 	reportTypeCUSTOM: ReportTypeCUSTOM,
+	reportTypeSAR:    ReportTypeSAR,
+	reportTypeCTR:    ReportTypeCTR,
 }
 
 // NewReportType creates a ReportType from a string, validating it is a known type.
@@ -43,6 +49,21 @@ func (r ReportType) String() string {
 	return r.value
 }
 
+// IsAML reports whether the report is an anti-money-laundering report, filed
+// as goAML XML rather than XBRL.
+func (r ReportType) IsAML() bool {
+	return r.value == reportTypeSAR || r.value == reportTypeCTR
+}
+
+// FileExtension returns the extension, without the dot, of the report's
+// regulatory filing.
+func (r ReportType) FileExtension() string {
+	if r.IsAML() {
+		return "xml"
+	}
+	return "xbrl"
+}
+
 // IsZero returns true if the ReportType has not been set.
 func (r ReportType) IsZero() bool {
 	return r.value == ""
@@ -0,0 +1,68 @@
+package valueobject
+
+import (
+	"fmt"
+	"time"
+)
+
+// ReportingFrequency represents how often a report is due to the regulator.
+// It is an immutable value object.
+type ReportingFrequency struct {
+	value string
+}
+
+const (
+	frequencyMonthly   = "MONTHLY"
+	frequencyQuarterly = "QUARTERLY"
+)
+
+var (
+	ReportingFrequencyMonthly   = ReportingFrequency{value: frequencyMonthly}
+	ReportingFrequencyQuarterly = ReportingFrequency{value: frequencyQuarterly}
+)
+
+var validReportingFrequencies = map[string]ReportingFrequency{
+	frequencyMonthly:   ReportingFrequencyMonthly,
+	frequencyQuarterly: ReportingFrequencyQuarterly,
+}
+
+// NewReportingFrequency creates a ReportingFrequency from a string, validating it is a known frequency.
+func NewReportingFrequency(s string) (ReportingFrequency, error) {
+	f, ok := validReportingFrequencies[s]
+	if !ok {
+		return ReportingFrequency{}, fmt.Errorf("invalid reporting frequency: %q", s)
+	}
+	return f, nil
+}
+
+// LastCompletedPeriod returns the most recent reporting period that ended on
+// or before now, and the instant it ended. Quarterly periods are named
+// "2025-Q1" and monthly periods "2025-01".
+func (f ReportingFrequency) LastCompletedPeriod(now time.Time) (string, time.Time) {
+	now = now.UTC()
+	switch f.value {
+	case frequencyQuarterly:
+		quarterStart := time.Date(now.Year(), ((now.Month()-1)/3)*3+1, 1, 0, 0, 0, 0, time.UTC)
+		start := quarterStart.AddDate(0, -3, 0)
+		return fmt.Sprintf("%d-Q%d", start.Year(), (start.Month()-1)/3+1), quarterStart
+	default:
+		monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		start := monthStart.AddDate(0, -1, 0)
+		return start.Format("2006-01"), monthStart
+	}
+}
+
+// String returns the string representation of the ReportingFrequency.
+func (f ReportingFrequency) String() string {
+	return f.value
+}
+
+// IsZero returns true if the ReportingFrequency has not been set.
+func (f ReportingFrequency) IsZero() bool {
+	return f.value == ""
+}
+
+// Equal returns true if two ReportingFrequency values are equal.
+func (f ReportingFrequency) Equal(other ReportingFrequency) bool {
+	return f.value == other.value
+}
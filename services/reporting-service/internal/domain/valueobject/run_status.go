@@ -0,0 +1,57 @@
+package valueobject
+
+import "fmt"
+
+// RunStatus represents the status of a scheduled report run.
+// It is an immutable value object.
+type RunStatus struct {
+	value string
+}
+
+const (
+	runStatusPending   = "PENDING"
+	runStatusGenerated = "GENERATED"
+	runStatusFailed    = "FAILED"
+	runStatusSubmitted = "SUBMITTED"
+	runStatusMissed    = "MISSED"
+)
+
+var (
+	RunStatusPending   = RunStatus{value: runStatusPending}
+	RunStatusGenerated = RunStatus{value: runStatusGenerated}
+	RunStatusFailed    = RunStatus{value: runStatusFailed}
+	RunStatusSubmitted = RunStatus{value: runStatusSubmitted}
+	RunStatusMissed    = RunStatus{value: runStatusMissed}
+)
+
+var validRunStatuses = map[string]RunStatus{
+	runStatusPending:   RunStatusPending,
+	runStatusGenerated: RunStatusGenerated,
+	runStatusFailed:    RunStatusFailed,
+	runStatusSubmitted: RunStatusSubmitted,
+	runStatusMissed:    RunStatusMissed,
+}
+
+// NewRunStatus creates a RunStatus from a string, validating it is known.
+func NewRunStatus(s string) (RunStatus, error) {
+	rs, ok := validRunStatuses[s]
+	if !ok {
+		return RunStatus{}, fmt.Errorf("invalid run status: %q", s)
+	}
+	return rs, nil
+}
+
+// String returns the string representation of the RunStatus.
+func (s RunStatus) String() string {
+	return s.value
+}
+
+// IsZero returns true if the RunStatus has not been set.
+func (s RunStatus) IsZero() bool {
+	return s.value == ""
+}
+
+// Equal returns true if two RunStatus values are equal.
+func (s RunStatus) Equal(other RunStatus) bool {
+	return s.value == other.value
+}
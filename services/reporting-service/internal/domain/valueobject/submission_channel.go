@@ -0,0 +1,55 @@
+package valueobject
+
+import "fmt"
+
+// SubmissionChannel represents the channel a report is filed with the
+// regulator through. It is an immutable value object.
+type SubmissionChannel struct {
+	value string
+}
+
+const (
+	submissionChannelSFTP   = "SFTP"
+	submissionChannelAPI    = "API"
+	submissionChannelManual = "MANUAL"
+)
+
+var (
+	// SubmissionChannelSFTP drops filings on the regulator's SFTP exchange.
+	SubmissionChannelSFTP = SubmissionChannel{value: submissionChannelSFTP}
+	// SubmissionChannelAPI posts filings to the regulator's (EBA/ECB) API.
+	SubmissionChannelAPI = SubmissionChannel{value: submissionChannelAPI}
+	// SubmissionChannelManual leaves the upload to an operator, who downloads
+	// the filing and records the regulator's acknowledgment.
+	SubmissionChannelManual = SubmissionChannel{value: submissionChannelManual}
+)
+
+var validSubmissionChannels = map[string]SubmissionChannel{
+	submissionChannelSFTP:   SubmissionChannelSFTP,
+	submissionChannelAPI:    SubmissionChannelAPI,
+	submissionChannelManual: SubmissionChannelManual,
+}
+
+// NewSubmissionChannel creates a SubmissionChannel from a string, validating it is known.
+func NewSubmissionChannel(s string) (SubmissionChannel, error) {
+	c, ok := validSubmissionChannels[s]
+	if !ok {
+		return SubmissionChannel{}, fmt.Errorf("invalid submission channel: %q", s)
+	}
+	return c, nil
+}
+
+// String returns the string representation of the SubmissionChannel.
+func (c SubmissionChannel) String() string {
+	return c.value
+}
+
+// IsZero returns true if the SubmissionChannel has not been set.
+func (c SubmissionChannel) IsZero() bool {
+	return c.value == ""
+}
+
+// Equal returns true if two SubmissionChannel values are equal.
+func (c SubmissionChannel) Equal(other SubmissionChannel) bool {
+	return c.value == other.value
+}
@@ -12,24 +12,30 @@ const (
 	statusDraft      = "DRAFT"
 	statusGenerating = "GENERATING"
 	statusReady      = "READY"
+	statusApproved   = "APPROVED"
+	statusChanges    = "CHANGES_REQUESTED"
 	statusSubmitted  = "SUBMITTED"
 	statusAccepted   = "ACCEPTED"
 	statusRejected   = "REJECTED"
 )
 
 var (
-	SubmissionStatusDraft      = SubmissionStatus{value: statusDraft}
-	SubmissionStatusGenerating = SubmissionStatus{value: statusGenerating}
-	SubmissionStatusReady      = SubmissionStatus{value: statusReady}
-	SubmissionStatusSubmitted  = SubmissionStatus{value: statusSubmitted}
-	SubmissionStatusAccepted   = SubmissionStatus{value: statusAccepted}
-	SubmissionStatusRejected   = SubmissionStatus{value: statusRejected}
+	SubmissionStatusDraft            = SubmissionStatus{value: statusDraft}
+	SubmissionStatusGenerating       = SubmissionStatus{value: statusGenerating}
+	SubmissionStatusReady            = SubmissionStatus{value: statusReady}
+	SubmissionStatusApproved         = SubmissionStatus{value: statusApproved}
+	SubmissionStatusChangesRequested = SubmissionStatus{value: statusChanges}
+	SubmissionStatusSubmitted        = SubmissionStatus{value: statusSubmitted}
+	SubmissionStatusAccepted         = SubmissionStatus{value: statusAccepted}
+	SubmissionStatusRejected         = SubmissionStatus{value: statusRejected}
 )
 
 var validSubmissionStatuses = map[string]SubmissionStatus{
 	statusDraft:      SubmissionStatusDraft,
 	statusGenerating: SubmissionStatusGenerating,
 	statusReady:      SubmissionStatusReady,
+	statusApproved:   SubmissionStatusApproved,
+	statusChanges:    SubmissionStatusChangesRequested,
 	statusSubmitted:  SubmissionStatusSubmitted,
 	statusAccepted:   SubmissionStatusAccepted,
 	statusRejected:   SubmissionStatusRejected,
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	accountv1 "github.com/bibbank/bib/api/gen/go/bib/account/v1"
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// WarehouseClientConfig holds configuration for the account and ledger gRPC
+// clients that source the warehouse export.
+type WarehouseClientConfig struct {
+	// PageSize is the number of accounts or journal entries requested per page.
+	PageSize int
+	// MaxRetries is the maximum number of retry attempts on transient failures.
+	MaxRetries int
+	// RetryBackoff is the base backoff between retries, doubled on each attempt.
+	RetryBackoff time.Duration
+	// Timeout bounds each call.
+	Timeout time.Duration
+}
+
+// AccountGRPCClient implements the AccountDataClient port against the account
+// service's account API.
+type AccountGRPCClient struct {
+	conn   grpc.ClientConnInterface
+	config WarehouseClientConfig
+}
+
+// NewAccountGRPCClient creates a new AccountGRPCClient.
+func NewAccountGRPCClient(conn grpc.ClientConnInterface, config WarehouseClientConfig) *AccountGRPCClient {
+	return &AccountGRPCClient{conn: conn, config: config}
+}
+
+// ListAccounts pages through all of the tenant's accounts.
+func (c *AccountGRPCClient) ListAccounts(ctx context.Context, tenantID uuid.UUID) ([]service.AccountRecord, error) {
+	ctx = forwardAuthorization(ctx)
+
+	var accounts []service.AccountRecord
+	req := &accountv1.ListAccountsRequest{
+		TenantId: tenantID.String(),
+		Pagination: &commonv1.Pagination{
+			PageSize: int32(c.config.PageSize), //nolint:gosec // page size is configured, not user input
+		},
+	}
+	seen := make(map[string]bool)
+	for {
+		var resp accountv1.ListAccountsResponse
+		err := invokeWithRetry(ctx, c.conn, accountv1.AccountService_ListAccounts_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("list accounts: %w", err)
+		}
+		added := 0
+		for _, m := range resp.GetAccounts() {
+			if seen[m.GetId()] {
+				continue
+			}
+			seen[m.GetId()] = true
+			added++
+			accounts = append(accounts, service.AccountRecord{
+				ID:                m.GetId(),
+				AccountNumber:     m.GetAccountNumber(),
+				AccountType:       strings.TrimPrefix(m.GetType().String(), "ACCOUNT_TYPE_"),
+				Status:            strings.TrimPrefix(m.GetStatus().String(), "ACCOUNT_STATUS_"),
+				Currency:          m.GetCurrency(),
+				LedgerAccountCode: m.GetLedgerAccountCode(),
+				TenantID:          tenantID,
+			})
+		}
+		next := resp.GetPagination().GetNextPageToken()
+		if next == "" {
+			return accounts, nil
+		}
+		if added == 0 {
+			return nil, fmt.Errorf("account service returned no new accounts for page token %q", req.Pagination.PageToken)
+		}
+		req.Pagination.PageToken = next
+	}
+}
@@ -0,0 +1,123 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/infrastructure/client"
+)
+
+// scriptedConn answers each method with its responses in turn.
+type scriptedConn struct {
+	responses map[string][]string
+	requests  map[string][]map[string]any
+}
+
+func (c *scriptedConn) Invoke(_ context.Context, method string, args, reply any, _ ...grpc.CallOption) error {
+	queue := c.responses[method]
+	if len(queue) == 0 {
+		return status.Error(codes.Unimplemented, method)
+	}
+	c.responses[method] = queue[1:]
+
+	raw, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(args.(proto.Message))
+	if err != nil {
+		return err
+	}
+	var req map[string]any
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return err
+	}
+	if c.requests == nil {
+		c.requests = make(map[string][]map[string]any)
+	}
+	c.requests[method] = append(c.requests[method], req)
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal([]byte(queue[0]), reply.(proto.Message))
+}
+
+func (c *scriptedConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not supported")
+}
+
+var amlConfig = client.AMLClientConfig{PageSize: 2, MaxRetries: 1, RetryBackoff: time.Millisecond, Timeout: time.Second}
+
+func TestFraudGRPCClient_ListConfirmedCases(t *testing.T) {
+	const method = "/bib.fraud.v1.FraudCaseService/ListCases"
+	conn := &scriptedConn{responses: map[string][]string{method: {
+		`{"cases":[` +
+			`{"id":"c1","transaction_id":"p1","risk_level":"RISK_LEVEL_HIGH","risk_score":90,"disposition":"CASE_DISPOSITION_CONFIRMED_FRAUD",` +
+			`"aml_scenario":"STRUCTURING","alert_transaction_ids":["p0","p1"],` +
+			`"resolved_at":"2025-03-10T12:00:00Z","notes":[{"body":"confirmed by customer"}]},` +
+			`{"id":"c2","disposition":"CASE_DISPOSITION_FALSE_POSITIVE","resolved_at":"2025-03-11T12:00:00Z"}],"total_count":3}`,
+		`{"cases":[{"id":"c3","disposition":"CASE_DISPOSITION_CONFIRMED_FRAUD","resolved_at":"2025-04-02T12:00:00Z"}],"total_count":3}`,
+	}}}
+
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	cases, err := client.NewFraudGRPCClient(conn, amlConfig).ListConfirmedCases(context.Background(), uuid.New(), from, from.AddDate(0, 1, 0))
+	require.NoError(t, err)
+
+	require.Len(t, cases, 1)
+	assert.Equal(t, "c1", cases[0].ID)
+	assert.Equal(t, "p1", cases[0].TransactionID)
+	assert.Equal(t, "HIGH", cases[0].RiskLevel)
+	assert.Equal(t, 90, cases[0].RiskScore)
+	assert.Equal(t, "STRUCTURING", cases[0].Scenario)
+	assert.Equal(t, []string{"p0", "p1"}, cases[0].AlertTransactionIDs)
+	assert.Equal(t, []string{"confirmed by customer"}, cases[0].Notes)
+
+	require.Len(t, conn.requests[method], 2)
+	assert.Equal(t, "CASE_STATUS_RESOLVED", conn.requests[method][0]["status"])
+	assert.EqualValues(t, 2, conn.requests[method][1]["offset"])
+}
+
+func TestPaymentGRPCClient_ListSettledPayments(t *testing.T) {
+	const method = "/bib.payment.v1.PaymentService/ListPayments"
+	conn := &scriptedConn{responses: map[string][]string{method: {
+		`{"orders":[` +
+			`{"id":"p4","status":"PAYMENT_STATUS_SETTLED","amount":{"amount":"1.00"},"audit":{"created_at":"2025-04-01T09:00:00Z"}},` +
+			`{"id":"p3","status":"PAYMENT_STATUS_SETTLED","amount":{"amount":"12000.00","currency":"EUR"},"source_account_id":"a1",` +
+			`"external_account_number":"DE89","audit":{"created_at":"2025-03-14T09:00:00Z"},"settled_at":"2025-03-14T10:00:00Z"}],` +
+			`"pagination":{"total_count":5,"next_page_token":"2"}}`,
+		`{"orders":[` +
+			`{"id":"p2","status":"PAYMENT_STATUS_FAILED","amount":{"amount":"50.00"},"audit":{"created_at":"2025-03-02T09:00:00Z"}},` +
+			`{"id":"p1","status":"PAYMENT_STATUS_SETTLED","amount":{"amount":"70.00"},"audit":{"created_at":"2025-02-27T09:00:00Z"}}],` +
+			`"pagination":{"total_count":5,"next_page_token":"4"}}`,
+	}}}
+
+	tenantID := uuid.New()
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	payments, err := client.NewPaymentGRPCClient(conn, amlConfig).ListSettledPayments(context.Background(), tenantID, from, from.AddDate(0, 1, 0))
+	require.NoError(t, err)
+
+	require.Len(t, payments, 1)
+	assert.Equal(t, "p3", payments[0].ID)
+	assert.Equal(t, "12000", payments[0].Amount.String())
+	assert.Equal(t, "DE89", payments[0].ToAccount)
+	assert.Equal(t, time.Date(2025, 3, 14, 10, 0, 0, 0, time.UTC), payments[0].Date)
+
+	// Paging stopped at the page reaching back into February.
+	require.Len(t, conn.requests[method], 2)
+	assert.Equal(t, tenantID.String(), conn.requests[method][0]["tenant_id"])
+	assert.Equal(t, map[string]any{"page_size": float64(2), "page_token": "2"}, conn.requests[method][1]["pagination"])
+}
+
+func TestPaymentGRPCClient_GetPayment(t *testing.T) {
+	conn := &scriptedConn{responses: map[string][]string{"/bib.payment.v1.PaymentService/GetPayment": {
+		`{"order":{"id":"p1","amount":{"amount":"not-a-number"}}}`,
+	}}}
+
+	_, err := client.NewPaymentGRPCClient(conn, amlConfig).GetPayment(context.Background(), uuid.New(), "p1")
+	assert.ErrorContains(t, err, "invalid amount")
+}
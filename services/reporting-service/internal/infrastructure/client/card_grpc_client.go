@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+
+	cardv1 "github.com/bibbank/bib/api/gen/go/bib/card/v1"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// CardGRPCClient implements the CardDataClient port against the card
+// service's card API. The card service lists the cards of the tenant the
+// call is authorized for.
+type CardGRPCClient struct {
+	conn   grpc.ClientConnInterface
+	config WarehouseClientConfig
+}
+
+// NewCardGRPCClient creates a new CardGRPCClient.
+func NewCardGRPCClient(conn grpc.ClientConnInterface, config WarehouseClientConfig) *CardGRPCClient {
+	return &CardGRPCClient{conn: conn, config: config}
+}
+
+// ListCards fetches all of the tenant's cards with their limits and spend.
+func (c *CardGRPCClient) ListCards(ctx context.Context, _ uuid.UUID) ([]service.CardExposure, error) {
+	ctx = forwardAuthorization(ctx)
+
+	var resp cardv1.ListCardsResponse
+	if err := invokeWithRetry(ctx, c.conn, cardv1.CardService_ListCards_FullMethodName, &cardv1.ListCardsRequest{}, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout); err != nil {
+		return nil, fmt.Errorf("list cards: %w", err)
+	}
+	cards := make([]service.CardExposure, 0, len(resp.Cards))
+	for _, m := range resp.Cards {
+		card, err := toCardExposure(m)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, card)
+	}
+	return cards, nil
+}
+
+func toCardExposure(m *cardv1.Card) (service.CardExposure, error) {
+	card := service.CardExposure{ID: m.GetId(), Currency: m.GetDailyLimit().GetCurrency()}
+	for _, f := range []struct {
+		into  *decimal.Decimal
+		name  string
+		value string
+	}{
+		{&card.DailyLimit, "daily limit", m.GetDailyLimit().GetAmount()},
+		{&card.MonthlyLimit, "monthly limit", m.GetMonthlyLimit().GetAmount()},
+		{&card.DailySpent, "daily spend", m.GetDailySpent().GetAmount()},
+		{&card.MonthlySpent, "monthly spend", m.GetMonthlySpent().GetAmount()},
+	} {
+		v, err := decimal.NewFromString(f.value)
+		if err != nil {
+			return service.CardExposure{}, fmt.Errorf("card %s: invalid %s %q: %w", m.GetId(), f.name, f.value, err)
+		}
+		*f.into = v
+	}
+	return card, nil
+}
@@ -0,0 +1,48 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/infrastructure/client"
+)
+
+func TestLedgerGRPCClient_GetBalance(t *testing.T) {
+	const method = "/bib.ledger.v1.LedgerService/GetBalance"
+	conn := &scriptedConn{responses: map[string][]string{method: {
+		`{"account_code":"2000-001","balance":{"amount":"-150.25","currency":"USD"},"as_of":"2025-03-14T00:00:00Z"}`,
+	}}}
+
+	balance, err := client.NewLedgerGRPCClient(conn, client.LedgerClientConfig{MaxRetries: 1}).GetBalance(context.Background(), "2000-001", "USD")
+	require.NoError(t, err)
+	assert.True(t, decimal.RequireFromString("-150.25").Equal(balance))
+	require.Len(t, conn.requests[method], 1)
+	assert.Equal(t, "2000-001", conn.requests[method][0]["account_code"])
+	assert.Equal(t, "USD", conn.requests[method][0]["currency"])
+}
+
+func TestCardGRPCClient_ListCards(t *testing.T) {
+	const method = "/bib.card.v1.CardService/ListCards"
+	conn := &scriptedConn{responses: map[string][]string{method: {
+		`{"cards":[{"id":"c1","daily_limit":{"amount":"500.00","currency":"USD"},` +
+			`"monthly_limit":{"amount":"2000.00","currency":"USD"},"daily_spent":{"amount":"120.00","currency":"USD"},` +
+			`"monthly_spent":{"amount":"900.00","currency":"USD"}}]}`,
+	}}}
+
+	cards, err := client.NewCardGRPCClient(conn, warehouseConfig).ListCards(context.Background(), uuid.New())
+	require.NoError(t, err)
+	require.Len(t, cards, 1)
+	assert.Equal(t, "c1", cards[0].ID)
+	assert.Equal(t, "USD", cards[0].Currency)
+	assert.True(t, decimal.NewFromInt(500).Equal(cards[0].DailyLimit))
+	assert.True(t, decimal.NewFromInt(900).Equal(cards[0].MonthlySpent))
+
+	conn = &scriptedConn{responses: map[string][]string{method: {`{"cards":[{"id":"c1","daily_limit":{"amount":"lots"}}]}`}}}
+	_, err = client.NewCardGRPCClient(conn, warehouseConfig).ListCards(context.Background(), uuid.New())
+	assert.ErrorContains(t, err, "invalid daily limit")
+}
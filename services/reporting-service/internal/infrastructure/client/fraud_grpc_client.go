@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	fraudv1 "github.com/bibbank/bib/api/gen/go/bib/fraud/v1"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// AMLClientConfig holds configuration for the fraud and payment gRPC clients
+// that source AML reports.
+type AMLClientConfig struct {
+	// PageSize is the number of cases or payments requested per page.
+	PageSize int
+	// MaxRetries is the maximum number of retry attempts on transient failures.
+	MaxRetries int
+	// RetryBackoff is the base backoff between retries, doubled on each attempt.
+	RetryBackoff time.Duration
+	// Timeout bounds each call.
+	Timeout time.Duration
+}
+
+// FraudGRPCClient implements the FraudCaseClient port against the fraud
+// service's case management API.
+type FraudGRPCClient struct {
+	conn   grpc.ClientConnInterface
+	config AMLClientConfig
+}
+
+// NewFraudGRPCClient creates a new FraudGRPCClient.
+func NewFraudGRPCClient(conn grpc.ClientConnInterface, config AMLClientConfig) *FraudGRPCClient {
+	return &FraudGRPCClient{conn: conn, config: config}
+}
+
+// ListConfirmedCases pages through the tenant's resolved cases and keeps those
+// confirmed as fraud and resolved within [from, to). The fraud service scopes
+// the listing to the tenant of the forwarded caller.
+func (c *FraudGRPCClient) ListConfirmedCases(ctx context.Context, _ uuid.UUID, from, to time.Time) ([]service.AMLCase, error) {
+	ctx = forwardAuthorization(ctx)
+
+	var cases []service.AMLCase
+	req := &fraudv1.ListCasesRequest{
+		Status:   fraudv1.CaseStatus_CASE_STATUS_RESOLVED,
+		PageSize: int32(c.config.PageSize), //nolint:gosec // page size is configured, not user input
+	}
+	for {
+		var resp fraudv1.ListCasesResponse
+		err := invokeWithRetry(ctx, c.conn, fraudv1.FraudCaseService_ListCases_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("list fraud cases: %w", err)
+		}
+		for _, m := range resp.GetCases() {
+			if m.GetDisposition() != fraudv1.CaseDisposition_CASE_DISPOSITION_CONFIRMED_FRAUD || m.GetResolvedAt() == nil {
+				continue
+			}
+			if resolvedAt := m.GetResolvedAt().AsTime(); resolvedAt.Before(from) || !resolvedAt.Before(to) {
+				continue
+			}
+			cases = append(cases, toAMLCase(m))
+		}
+		req.Offset += int32(len(resp.GetCases())) //nolint:gosec // bounded by the page size
+		if len(resp.GetCases()) == 0 || req.GetOffset() >= resp.GetTotalCount() {
+			break
+		}
+	}
+	return cases, nil
+}
+
+func toAMLCase(m *fraudv1.FraudCase) service.AMLCase {
+	notes := make([]string, 0, len(m.GetNotes()))
+	for _, n := range m.GetNotes() {
+		notes = append(notes, n.GetBody())
+	}
+	riskLevel := strings.TrimPrefix(m.GetRiskLevel().String(), "RISK_LEVEL_")
+	if m.GetRiskLevel() == fraudv1.RiskLevel_RISK_LEVEL_UNSPECIFIED {
+		riskLevel = ""
+	}
+	return service.AMLCase{
+		ResolvedAt:          m.GetResolvedAt().AsTime(),
+		ID:                  m.GetId(),
+		AccountID:           m.GetAccountId(),
+		TransactionID:       m.GetTransactionId(),
+		RiskLevel:           riskLevel,
+		RiskScore:           int(m.GetRiskScore()),
+		Scenario:            m.GetAmlScenario(),
+		AlertTransactionIDs: m.GetAlertTransactionIds(),
+		Notes:               notes,
+	}
+}
@@ -0,0 +1,199 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	ledgerv1 "github.com/bibbank/bib/api/gen/go/bib/ledger/v1"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// LedgerClientConfig holds configuration for the ledger gRPC client.
+type LedgerClientConfig struct {
+	// PageSize is the number of trial balance lines requested per page.
+	PageSize int
+	// MaxRetries is the maximum number of retry attempts on transient failures.
+	MaxRetries int
+	// RetryBackoff is the base backoff between retries, doubled on each attempt.
+	RetryBackoff time.Duration
+	// Timeout bounds each call to the ledger service.
+	Timeout time.Duration
+	// LiquidityWeights classifies the trial balance for the liquidity ratios.
+	LiquidityWeights service.LiquidityWeights
+}
+
+// LedgerGRPCClient implements the LedgerDataClient and TrialBalanceClient ports
+// against the ledger service's trial balance API. It pages through the trial balance, retrying
+// transient failures, and aggregates it into report figures.
+type LedgerGRPCClient struct {
+	conn   grpc.ClientConnInterface
+	config LedgerClientConfig
+}
+
+// NewLedgerGRPCClient creates a new LedgerGRPCClient.
+func NewLedgerGRPCClient(conn grpc.ClientConnInterface, config LedgerClientConfig) *LedgerGRPCClient {
+	return &LedgerGRPCClient{conn: conn, config: config}
+}
+
+// GetFinancialData fetches the tenant's trial balance for the period and
+// aggregates it into report figures. Books that do not balance are rejected.
+func (c *LedgerGRPCClient) GetFinancialData(ctx context.Context, tenantID uuid.UUID, period string) (service.ReportData, error) {
+	tb, err := c.GetTrialBalance(ctx, tenantID, period)
+	if err != nil {
+		return service.ReportData{}, err
+	}
+	return service.AggregateTrialBalance(tb, c.config.LiquidityWeights)
+}
+
+// GetTrialBalance fetches every page of the tenant's trial balance for the period.
+func (c *LedgerGRPCClient) GetTrialBalance(ctx context.Context, tenantID uuid.UUID, period string) (service.TrialBalance, error) {
+	ctx = forwardAuthorization(ctx)
+
+	tb := service.TrialBalance{TenantID: tenantID, Period: period}
+	req := &ledgerv1.GetTrialBalanceRequest{
+		TenantId: tenantID.String(),
+		Period:   period,
+		PageSize: int32(c.config.PageSize), //nolint:gosec // page size is configured, not user input
+	}
+	for {
+		resp, err := c.fetchPageWithRetry(ctx, req)
+		if err != nil {
+			return service.TrialBalance{}, err
+		}
+		for _, l := range resp.GetLines() {
+			line, err := toTrialBalanceLine(l)
+			if err != nil {
+				return service.TrialBalance{}, err
+			}
+			tb.Lines = append(tb.Lines, line)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		if resp.NextPageToken == req.PageToken {
+			return service.TrialBalance{}, fmt.Errorf("ledger returned the same page token %q twice", resp.NextPageToken)
+		}
+		req.PageToken = resp.NextPageToken
+	}
+
+	return tb, nil
+}
+
+// fetchPageWithRetry fetches one trial balance page with exponential backoff
+// on transient failures.
+func (c *LedgerGRPCClient) fetchPageWithRetry(ctx context.Context, req *ledgerv1.GetTrialBalanceRequest) (*ledgerv1.GetTrialBalanceResponse, error) {
+	var resp ledgerv1.GetTrialBalanceResponse
+	err := invokeWithRetry(ctx, c.conn, ledgerv1.LedgerService_GetTrialBalance_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("get trial balance: %w", err)
+	}
+	return &resp, nil
+}
+
+func toTrialBalanceLine(l *ledgerv1.TrialBalanceLine) (service.TrialBalanceLine, error) {
+	debit, err := decimal.NewFromString(l.GetDebit())
+	if err != nil {
+		return service.TrialBalanceLine{}, fmt.Errorf("account %s: invalid debit %q: %w", l.GetAccountCode(), l.GetDebit(), err)
+	}
+	credit, err := decimal.NewFromString(l.GetCredit())
+	if err != nil {
+		return service.TrialBalanceLine{}, fmt.Errorf("account %s: invalid credit %q: %w", l.GetAccountCode(), l.GetCredit(), err)
+	}
+	return service.TrialBalanceLine{AccountCode: l.GetAccountCode(), Debit: debit, Credit: credit}, nil
+}
+
+// ListJournalEntries pages through the tenant's journal entries effective
+// within [from, to).
+func (c *LedgerGRPCClient) ListJournalEntries(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]service.JournalEntryRecord, error) {
+	ctx = forwardAuthorization(ctx)
+
+	var entries []service.JournalEntryRecord
+	req := &ledgerv1.ListJournalEntriesRequest{
+		TenantId: tenantID.String(),
+		FromDate: timestamppb.New(from),
+		// The ledger's range is inclusive of its end date.
+		ToDate: timestamppb.New(to.Add(-time.Nanosecond)),
+		Pagination: &commonv1.Pagination{
+			PageSize: int32(c.config.PageSize), //nolint:gosec // page size is configured, not user input
+		},
+	}
+	for {
+		var resp ledgerv1.ListJournalEntriesResponse
+		err := invokeWithRetry(ctx, c.conn, ledgerv1.LedgerService_ListJournalEntries_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("list journal entries: %w", err)
+		}
+		for _, m := range resp.GetEntries() {
+			entry, err := toJournalEntryRecord(tenantID, m)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, entry)
+		}
+		next := resp.GetPagination().GetNextPageToken()
+		if next == "" {
+			return entries, nil
+		}
+		if next == req.Pagination.PageToken {
+			return nil, fmt.Errorf("ledger returned the same page token %q twice", next)
+		}
+		req.Pagination.PageToken = next
+	}
+}
+
+func toJournalEntryRecord(tenantID uuid.UUID, m *ledgerv1.JournalEntry) (service.JournalEntryRecord, error) {
+	if m.GetEffectiveDate() == nil {
+		return service.JournalEntryRecord{}, fmt.Errorf("journal entry %s: no effective date", m.GetId())
+	}
+	entry := service.JournalEntryRecord{
+		EffectiveDate: m.GetEffectiveDate().AsTime(),
+		ID:            m.GetId(),
+		Status:        strings.TrimPrefix(m.GetStatus().String(), "ENTRY_STATUS_"),
+		Description:   m.GetDescription(),
+		Reference:     m.GetReference(),
+		TenantID:      tenantID,
+		Postings:      make([]service.JournalPostingRecord, 0, len(m.GetPostings())),
+	}
+	if created := m.GetAudit().GetCreatedAt(); created != nil {
+		entry.CreatedAt = created.AsTime()
+	}
+	for _, p := range m.GetPostings() {
+		amount, err := decimal.NewFromString(p.GetAmount().GetAmount())
+		if err != nil {
+			return service.JournalEntryRecord{}, fmt.Errorf("journal entry %s: invalid amount %q: %w", m.GetId(), p.GetAmount().GetAmount(), err)
+		}
+		entry.Postings = append(entry.Postings, service.JournalPostingRecord{
+			DebitAccount:  p.GetDebitAccount(),
+			CreditAccount: p.GetCreditAccount(),
+			Currency:      p.GetAmount().GetCurrency(),
+			Description:   p.GetDescription(),
+			Amount:        amount,
+		})
+	}
+	return entry, nil
+}
+
+// GetBalance fetches the ledger's current balance of an account in a currency.
+func (c *LedgerGRPCClient) GetBalance(ctx context.Context, accountCode, currency string) (decimal.Decimal, error) {
+	ctx = forwardAuthorization(ctx)
+
+	var resp ledgerv1.GetBalanceResponse
+	req := &ledgerv1.GetBalanceRequest{AccountCode: accountCode, Currency: currency}
+	if err := invokeWithRetry(ctx, c.conn, ledgerv1.LedgerService_GetBalance_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout); err != nil {
+		return decimal.Zero, fmt.Errorf("get balance of %s: %w", accountCode, err)
+	}
+	balance, err := decimal.NewFromString(resp.GetBalance().GetAmount())
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("account %s: invalid balance %q: %w", accountCode, resp.GetBalance().GetAmount(), err)
+	}
+	return balance, nil
+}
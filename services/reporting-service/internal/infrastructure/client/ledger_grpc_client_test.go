@@ -0,0 +1,152 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+	"github.com/bibbank/bib/services/reporting-service/internal/infrastructure/client"
+)
+
+// fakeLedgerConn serves trial balance pages keyed by page token and fails the
+// first failures calls with the given error.
+type fakeLedgerConn struct {
+	pages    map[string]string
+	failWith error
+	failures int
+	calls    int
+	requests []map[string]any
+	authz    []string
+}
+
+func (c *fakeLedgerConn) Invoke(ctx context.Context, method string, args, reply any, _ ...grpc.CallOption) error {
+	c.calls++
+	if method != "/bib.ledger.v1.LedgerService/GetTrialBalance" {
+		return status.Error(codes.Unimplemented, method)
+	}
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		c.authz = append(c.authz, md.Get("authorization")...)
+	}
+	if c.failures > 0 {
+		c.failures--
+		return c.failWith
+	}
+
+	raw, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(args.(proto.Message))
+	if err != nil {
+		return err
+	}
+	var req map[string]any
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return err
+	}
+	c.requests = append(c.requests, req)
+
+	token, _ := req["page_token"].(string)
+	page, ok := c.pages[token]
+	if !ok {
+		return status.Error(codes.InvalidArgument, "unknown page token")
+	}
+	return protojson.Unmarshal([]byte(page), reply.(proto.Message))
+}
+
+func (c *fakeLedgerConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not supported")
+}
+
+func twoPageLedger() *fakeLedgerConn {
+	return &fakeLedgerConn{pages: map[string]string{
+		"": `{"lines":[{"account_code":"1000","debit":"300.00","credit":"100.00"},` +
+			`{"account_code":"1200","debit":"800.00","credit":"0"}],"next_page_token":"p2"}`,
+		"p2": `{"lines":[{"account_code":"2000","debit":"0","credit":"850.00"},` +
+			`{"account_code":"3000","debit":"0","credit":"120.00"},` +
+			`{"account_code":"4000","debit":"0","credit":"30.00"}]}`,
+	}}
+}
+
+func newClient(conn grpc.ClientConnInterface) *client.LedgerGRPCClient {
+	return client.NewLedgerGRPCClient(conn, client.LedgerClientConfig{
+		PageSize:     2,
+		MaxRetries:   2,
+		RetryBackoff: time.Millisecond,
+		Timeout:      time.Second,
+
+		LiquidityWeights: service.DefaultLiquidityWeights(),
+	})
+}
+
+func TestLedgerGRPCClient_PagesThroughTrialBalance(t *testing.T) {
+	conn := twoPageLedger()
+	tenantID := uuid.New()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+
+	data, err := newClient(conn).GetFinancialData(ctx, tenantID, "2025-Q1")
+	require.NoError(t, err)
+
+	require.Len(t, conn.requests, 2)
+	assert.Equal(t, tenantID.String(), conn.requests[0]["tenant_id"])
+	assert.Equal(t, "2025-Q1", conn.requests[0]["period"])
+	assert.EqualValues(t, 2, conn.requests[0]["page_size"])
+	assert.Equal(t, "p2", conn.requests[1]["page_token"])
+	assert.Equal(t, []string{"Bearer token", "Bearer token"}, conn.authz)
+
+	assert.Equal(t, tenantID, data.TenantID)
+	assert.True(t, data.TotalAssets.Equal(decimal.NewFromInt(1000)), data.TotalAssets.String())
+	assert.True(t, data.TotalLiabilities.Equal(decimal.NewFromInt(850)), data.TotalLiabilities.String())
+	assert.True(t, data.TotalEquity.Equal(decimal.NewFromInt(150)), data.TotalEquity.String())
+	assert.True(t, data.NetIncome.Equal(decimal.NewFromInt(30)), data.NetIncome.String())
+	assert.True(t, data.LCRRatio.Equal(decimal.RequireFromString("2.3529")), data.LCRRatio.String())
+}
+
+func TestLedgerGRPCClient_RetriesTransientFailures(t *testing.T) {
+	conn := twoPageLedger()
+	conn.failWith = status.Error(codes.Unavailable, "ledger restarting")
+	conn.failures = 2
+
+	_, err := newClient(conn).GetFinancialData(context.Background(), uuid.New(), "2025-Q1")
+	require.NoError(t, err)
+	assert.Equal(t, 4, conn.calls)
+}
+
+func TestLedgerGRPCClient_GivesUpAfterRetries(t *testing.T) {
+	conn := twoPageLedger()
+	conn.failWith = status.Error(codes.Unavailable, "ledger down")
+	conn.failures = 10
+
+	_, err := newClient(conn).GetFinancialData(context.Background(), uuid.New(), "2025-Q1")
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 3, conn.calls)
+}
+
+func TestLedgerGRPCClient_DoesNotRetryPermanentFailures(t *testing.T) {
+	conn := twoPageLedger()
+	conn.failWith = status.Error(codes.PermissionDenied, "insufficient permissions")
+	conn.failures = 1
+
+	_, err := newClient(conn).GetFinancialData(context.Background(), uuid.New(), "2025-Q1")
+	require.Error(t, err)
+	assert.Equal(t, 1, conn.calls)
+}
+
+func TestLedgerGRPCClient_RejectsUnbalancedBooks(t *testing.T) {
+	conn := twoPageLedger()
+	conn.pages["p2"] = `{"lines":[{"account_code":"2000","debit":"0","credit":"850.00"}]}`
+
+	_, err := newClient(conn).GetFinancialData(context.Background(), uuid.New(), "2025-Q1")
+	assert.ErrorIs(t, err, service.ErrReportUnbalanced)
+}
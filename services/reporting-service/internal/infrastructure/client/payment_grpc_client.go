@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	paymentv1 "github.com/bibbank/bib/api/gen/go/bib/payment/v1"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// paymentStatusSettled is the status of payments that left the bank.
+const paymentStatusSettled = paymentv1.PaymentStatus_PAYMENT_STATUS_SETTLED
+
+// PaymentGRPCClient implements the PaymentDataClient port against the payment
+// service's payment order API.
+type PaymentGRPCClient struct {
+	conn   grpc.ClientConnInterface
+	config AMLClientConfig
+}
+
+// NewPaymentGRPCClient creates a new PaymentGRPCClient.
+func NewPaymentGRPCClient(conn grpc.ClientConnInterface, config AMLClientConfig) *PaymentGRPCClient {
+	return &PaymentGRPCClient{conn: conn, config: config}
+}
+
+// GetPayment fetches a single payment.
+func (c *PaymentGRPCClient) GetPayment(ctx context.Context, _ uuid.UUID, paymentID string) (service.AMLTransaction, error) {
+	ctx = forwardAuthorization(ctx)
+
+	var resp paymentv1.GetPaymentResponse
+	req := &paymentv1.GetPaymentRequest{Id: paymentID}
+	if err := invokeWithRetry(ctx, c.conn, paymentv1.PaymentService_GetPayment_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout); err != nil {
+		return service.AMLTransaction{}, fmt.Errorf("get payment %s: %w", paymentID, err)
+	}
+	return toAMLTransaction(resp.GetOrder())
+}
+
+// ListSettledPayments keeps the settled payments among the tenant's payments
+// created within [from, to).
+func (c *PaymentGRPCClient) ListSettledPayments(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]service.AMLTransaction, error) {
+	var payments []service.AMLTransaction
+	err := c.eachPayment(ctx, tenantID, from, to, func(m *paymentv1.PaymentOrder) error {
+		if m.GetStatus() != paymentStatusSettled {
+			return nil
+		}
+		payment, err := toAMLTransaction(m)
+		if err != nil {
+			return err
+		}
+		payments = append(payments, payment)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
+// ListPayments returns the tenant's payments created within [from, to), in
+// any status.
+func (c *PaymentGRPCClient) ListPayments(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]service.PaymentRecord, error) {
+	var payments []service.PaymentRecord
+	err := c.eachPayment(ctx, tenantID, from, to, func(m *paymentv1.PaymentOrder) error {
+		amount, err := decimal.NewFromString(m.GetAmount().GetAmount())
+		if err != nil {
+			return fmt.Errorf("payment %s: invalid amount %q: %w", m.GetId(), m.GetAmount().GetAmount(), err)
+		}
+		payments = append(payments, service.PaymentRecord{
+			CreatedAt:            paymentCreatedAt(m),
+			SettledAt:            paymentSettledAt(m),
+			ID:                   m.GetId(),
+			SourceAccountID:      m.GetSourceAccountId(),
+			DestinationAccountID: m.GetDestinationAccountId(),
+			Rail:                 strings.TrimPrefix(m.GetRail().String(), "PAYMENT_RAIL_"),
+			Status:               strings.TrimPrefix(m.GetStatus().String(), "PAYMENT_STATUS_"),
+			Currency:             m.GetAmount().GetCurrency(),
+			Reference:            m.GetReference(),
+			Amount:               amount,
+			TenantID:             tenantID,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
+// eachPayment pages through the tenant's payments, newest first, and calls fn
+// for each one created within [from, to). Paging stops at the first page that
+// reaches back before from.
+func (c *PaymentGRPCClient) eachPayment(ctx context.Context, tenantID uuid.UUID, from, to time.Time, fn func(*paymentv1.PaymentOrder) error) error {
+	ctx = forwardAuthorization(ctx)
+
+	req := &paymentv1.ListPaymentsRequest{
+		TenantId: tenantID.String(),
+		Pagination: &commonv1.Pagination{
+			PageSize: int32(c.config.PageSize), //nolint:gosec // page size is configured, not user input
+		},
+	}
+	for {
+		var resp paymentv1.ListPaymentsResponse
+		err := invokeWithRetry(ctx, c.conn, paymentv1.PaymentService_ListPayments_FullMethodName, req, &resp, c.config.MaxRetries, c.config.RetryBackoff, c.config.Timeout)
+		if err != nil {
+			return fmt.Errorf("list payments: %w", err)
+		}
+		reachedStart := false
+		for _, m := range resp.GetOrders() {
+			createdAt := paymentCreatedAt(m)
+			if createdAt.Before(from) {
+				reachedStart = true
+				continue
+			}
+			if !createdAt.Before(to) {
+				continue
+			}
+			if err := fn(m); err != nil {
+				return err
+			}
+		}
+		next := resp.GetPagination().GetNextPageToken()
+		if reachedStart || len(resp.GetOrders()) == 0 || next == "" {
+			return nil
+		}
+		req.Pagination.PageToken = next
+	}
+}
+
+func toAMLTransaction(m *paymentv1.PaymentOrder) (service.AMLTransaction, error) {
+	amount, err := decimal.NewFromString(m.GetAmount().GetAmount())
+	if err != nil {
+		return service.AMLTransaction{}, fmt.Errorf("payment %s: invalid amount %q: %w", m.GetId(), m.GetAmount().GetAmount(), err)
+	}
+	date := paymentCreatedAt(m)
+	if settledAt := paymentSettledAt(m); settledAt != nil {
+		date = *settledAt
+	}
+	to := m.GetDestinationAccountId()
+	if to == "" {
+		to = m.GetExternalAccountNumber()
+	}
+	return service.AMLTransaction{
+		Date:        date,
+		Amount:      amount,
+		ID:          m.GetId(),
+		Reference:   m.GetReference(),
+		Currency:    m.GetAmount().GetCurrency(),
+		FromAccount: m.GetSourceAccountId(),
+		ToAccount:   to,
+		Rail:        strings.TrimPrefix(m.GetRail().String(), "PAYMENT_RAIL_"),
+		Description: m.GetDescription(),
+	}, nil
+}
+
+func paymentCreatedAt(m *paymentv1.PaymentOrder) time.Time {
+	return m.GetAudit().GetCreatedAt().AsTime()
+}
+
+func paymentSettledAt(m *paymentv1.PaymentOrder) *time.Time {
+	if m.GetSettledAt() == nil {
+		return nil
+	}
+	t := m.GetSettledAt().AsTime()
+	return &t
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/bibbank/bib/pkg/auth"
+)
+
+// ServiceCredentials implements the TenantCredentials port. Background jobs
+// have no caller token to forward, so it mints a short-lived auditor token for
+// the tenant, signed with the platform's issuing key. Without a signer the
+// context is returned unchanged and downstream calls go unauthenticated.
+type ServiceCredentials struct {
+	signer *auth.JWTService
+}
+
+// NewServiceCredentials creates a new ServiceCredentials. signer may be nil.
+func NewServiceCredentials(signer *auth.JWTService) *ServiceCredentials {
+	return &ServiceCredentials{signer: signer}
+}
+
+// ForTenant returns a context whose outgoing calls carry a token for the tenant.
+func (c *ServiceCredentials) ForTenant(ctx context.Context, tenantID uuid.UUID) (context.Context, error) {
+	if c.signer == nil {
+		return ctx, nil
+	}
+	token, err := c.signer.GenerateToken(uuid.Nil, tenantID, []string{auth.RoleAuditor})
+	if err != nil {
+		return nil, fmt.Errorf("mint service token: %w", err)
+	}
+	// Replace any caller token so forwardAuthorization picks up this one.
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Set("authorization", "Bearer "+token)
+	return metadata.NewIncomingContext(ctx, md), nil
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// StubFraudCaseClient is a stub implementation of the FraudCaseClient port.
+// It is used when no fraud service address is configured and reports no
+// confirmed cases.
+type StubFraudCaseClient struct{}
+
+// NewStubFraudCaseClient creates a new StubFraudCaseClient.
+func NewStubFraudCaseClient() *StubFraudCaseClient {
+	return &StubFraudCaseClient{}
+}
+
+// ListConfirmedCases returns no cases.
+func (c *StubFraudCaseClient) ListConfirmedCases(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]service.AMLCase, error) {
+	return nil, nil
+}
+
+// StubPaymentDataClient is a stub implementation of the PaymentDataClient
+// port. It is used when no payment service address is configured and holds
+// no payments.
+type StubPaymentDataClient struct{}
+
+// NewStubPaymentDataClient creates a new StubPaymentDataClient.
+func NewStubPaymentDataClient() *StubPaymentDataClient {
+	return &StubPaymentDataClient{}
+}
+
+// GetPayment reports that the payment does not exist.
+func (c *StubPaymentDataClient) GetPayment(_ context.Context, _ uuid.UUID, paymentID string) (service.AMLTransaction, error) {
+	return service.AMLTransaction{}, fmt.Errorf("payment %s not found", paymentID)
+}
+
+// ListSettledPayments returns no payments.
+func (c *StubPaymentDataClient) ListSettledPayments(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]service.AMLTransaction, error) {
+	return nil, nil
+}
+
+// ListPayments returns no payments.
+func (c *StubPaymentDataClient) ListPayments(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]service.PaymentRecord, error) {
+	return nil, nil
+}
+
+// StubAccountDataClient is a stub implementation of the AccountDataClient
+// port. It is used when no account service address is configured and holds
+// no accounts.
+type StubAccountDataClient struct{}
+
+// NewStubAccountDataClient creates a new StubAccountDataClient.
+func NewStubAccountDataClient() *StubAccountDataClient {
+	return &StubAccountDataClient{}
+}
+
+// ListAccounts returns no accounts.
+func (c *StubAccountDataClient) ListAccounts(_ context.Context, _ uuid.UUID) ([]service.AccountRecord, error) {
+	return nil, nil
+}
+
+// StubCardDataClient is a stub implementation of the CardDataClient port. It
+// is used when no card service address is configured and holds no cards.
+type StubCardDataClient struct{}
+
+// NewStubCardDataClient creates a new StubCardDataClient.
+func NewStubCardDataClient() *StubCardDataClient {
+	return &StubCardDataClient{}
+}
+
+// ListCards returns no cards.
+func (c *StubCardDataClient) ListCards(_ context.Context, _ uuid.UUID) ([]service.CardExposure, error) {
+	return nil, nil
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -9,26 +10,54 @@ import (
 	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
 )
 
-// StubLedgerDataClient is a stub implementation of the LedgerDataClient port.
-// In production, this would make gRPC calls to the ledger service.
-type StubLedgerDataClient struct{}
+// StubLedgerDataClient is a stub implementation of the LedgerDataClient and
+// TrialBalanceClient ports. It is used when no ledger service address is
+// configured; otherwise LedgerGRPCClient sources the figures from the
+// ledger's trial balance.
+type StubLedgerDataClient struct {
+	weights service.LiquidityWeights
+}
 
 // NewStubLedgerDataClient creates a new StubLedgerDataClient.
-func NewStubLedgerDataClient() *StubLedgerDataClient {
-	return &StubLedgerDataClient{}
+func NewStubLedgerDataClient(weights service.LiquidityWeights) *StubLedgerDataClient {
+	return &StubLedgerDataClient{weights: weights}
+}
+
+// GetFinancialData returns figures aggregated from the sample trial balance.
+func (c *StubLedgerDataClient) GetFinancialData(ctx context.Context, tenantID uuid.UUID, period string) (service.ReportData, error) {
+	tb, err := c.GetTrialBalance(ctx, tenantID, period)
+	if err != nil {
+		return service.ReportData{}, err
+	}
+	return service.AggregateTrialBalance(tb, c.weights)
 }
 
-// GetFinancialData returns sample financial data for development and testing.
-func (c *StubLedgerDataClient) GetFinancialData(_ context.Context, tenantID uuid.UUID, period string) (service.ReportData, error) {
-	return service.ReportData{
-		TenantID:           tenantID,
-		Period:             period,
-		TotalAssets:        decimal.NewFromInt(1_500_000_000),
-		TotalLiabilities:   decimal.NewFromInt(1_350_000_000),
-		TotalEquity:        decimal.NewFromInt(150_000_000),
-		NetIncome:          decimal.NewFromInt(25_000_000),
-		RiskWeightedAssets: decimal.NewFromInt(800_000_000),
-		CET1Ratio:          decimal.NewFromFloat(0.1475),
-		LCRRatio:           decimal.NewFromFloat(1.2500),
+// GetTrialBalance returns a sample trial balance for development and testing.
+func (c *StubLedgerDataClient) GetTrialBalance(_ context.Context, tenantID uuid.UUID, period string) (service.TrialBalance, error) {
+	line := func(code string, debit, credit int64) service.TrialBalanceLine {
+		return service.TrialBalanceLine{AccountCode: code, Debit: decimal.NewFromInt(debit), Credit: decimal.NewFromInt(credit)}
+	}
+	return service.TrialBalance{
+		TenantID: tenantID,
+		Period:   period,
+		Lines: []service.TrialBalanceLine{
+			line("1000", 300_000_000, 0),   // cash and central bank reserves
+			line("1100", 500_000_000, 0),   // loans and advances to banks
+			line("1200", 700_000_000, 0),   // customer loans
+			line("2000", 0, 1_350_000_000), // customer deposits
+			line("3000", 0, 125_000_000),   // share capital
+			line("4000", 0, 60_000_000),    // interest income
+			line("5000", 35_000_000, 0),    // operating expenses
+		},
 	}, nil
 }
+
+// ListJournalEntries returns no journal entries.
+func (c *StubLedgerDataClient) ListJournalEntries(_ context.Context, _ uuid.UUID, _, _ time.Time) ([]service.JournalEntryRecord, error) {
+	return nil, nil
+}
+
+// GetBalance returns a zero balance.
+func (c *StubLedgerDataClient) GetBalance(_ context.Context, _, _ string) (decimal.Decimal, error) {
+	return decimal.Zero, nil
+}
@@ -0,0 +1,100 @@
+package client_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/reporting-service/internal/infrastructure/client"
+)
+
+var warehouseConfig = client.WarehouseClientConfig{PageSize: 2, MaxRetries: 1, RetryBackoff: time.Millisecond, Timeout: time.Second}
+
+func TestAccountGRPCClient_ListAccounts(t *testing.T) {
+	const method = "/bib.account.v1.AccountService/ListAccounts"
+	conn := &scriptedConn{responses: map[string][]string{method: {
+		`{"accounts":[{"id":"a1","account_number":"BIB-1","type":"ACCOUNT_TYPE_CHECKING","status":"ACCOUNT_STATUS_ACTIVE","currency":"USD"},` +
+			`{"id":"a2","account_number":"BIB-2","type":"ACCOUNT_TYPE_SAVINGS","status":"ACCOUNT_STATUS_FROZEN","currency":"USD"}],` +
+			`"pagination":{"next_page_token":"2","total_count":3}}`,
+		`{"accounts":[{"id":"a3","account_number":"BIB-3","type":"ACCOUNT_TYPE_LOAN","status":"ACCOUNT_STATUS_ACTIVE","currency":"EUR",` +
+			`"ledger_account_code":"1200"}],"pagination":{"total_count":3}}`,
+	}}}
+
+	tenantID := uuid.New()
+	accounts, err := client.NewAccountGRPCClient(conn, warehouseConfig).ListAccounts(context.Background(), tenantID)
+	require.NoError(t, err)
+
+	require.Len(t, accounts, 3)
+	assert.Equal(t, "BIB-2", accounts[1].AccountNumber)
+	assert.Equal(t, "FROZEN", accounts[1].Status)
+	assert.Equal(t, "1200", accounts[2].LedgerAccountCode)
+	assert.Equal(t, tenantID, accounts[2].TenantID)
+
+	require.Len(t, conn.requests[method], 2)
+	assert.Equal(t, map[string]any{"page_size": float64(2), "page_token": "2"}, conn.requests[method][1]["pagination"])
+}
+
+func TestAccountGRPCClient_ListAccounts_RejectsIgnoredPageToken(t *testing.T) {
+	const method = "/bib.account.v1.AccountService/ListAccounts"
+	page := `{"accounts":[{"id":"a1"},{"id":"a2"}],"pagination":{"next_page_token":"2","total_count":3}}`
+	conn := &scriptedConn{responses: map[string][]string{method: {page, page}}}
+
+	_, err := client.NewAccountGRPCClient(conn, warehouseConfig).ListAccounts(context.Background(), uuid.New())
+	assert.ErrorContains(t, err, "no new accounts")
+}
+
+func TestLedgerGRPCClient_ListJournalEntries(t *testing.T) {
+	const method = "/bib.ledger.v1.LedgerService/ListJournalEntries"
+	conn := &scriptedConn{responses: map[string][]string{method: {
+		`{"entries":[{"id":"je1","effective_date":"2025-03-14T00:00:00Z","status":"ENTRY_STATUS_POSTED","description":"fee",` +
+			`"audit":{"created_at":"2025-03-14T09:00:00Z"},"postings":[` +
+			`{"debit_account":"1000","credit_account":"4000","amount":{"amount":"12.50","currency":"USD"}},` +
+			`{"debit_account":"1000","credit_account":"2100","amount":{"amount":"2.50","currency":"USD"},"description":"tax"}]}],` +
+			`"pagination":{"total_count":1}}`,
+	}}}
+
+	day := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	ledger := client.NewLedgerGRPCClient(conn, client.LedgerClientConfig{PageSize: 2, MaxRetries: 1, RetryBackoff: time.Millisecond, Timeout: time.Second})
+	entries, err := ledger.ListJournalEntries(context.Background(), uuid.New(), day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+
+	require.Len(t, entries, 1)
+	assert.Equal(t, day, entries[0].EffectiveDate)
+	assert.Equal(t, "POSTED", entries[0].Status)
+	require.Len(t, entries[0].Postings, 2)
+	assert.Equal(t, "12.5", entries[0].Postings[0].Amount.String())
+	assert.Equal(t, "tax", entries[0].Postings[1].Description)
+
+	require.Len(t, conn.requests[method], 1)
+	assert.Equal(t, "2025-03-14T23:59:59.999999999Z", conn.requests[method][0]["to_date"])
+}
+
+func TestServiceCredentials_ForTenant(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer caller"))
+
+	// Without a signer the context is unchanged.
+	got, err := client.NewServiceCredentials(nil).ForTenant(ctx, uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, ctx, got)
+
+	signer, err := auth.NewJWTService(auth.JWTConfig{Secret: "test-secret", Issuer: "bib-gateway", Expiration: time.Minute})
+	require.NoError(t, err)
+	tenantID := uuid.New()
+	got, err = client.NewServiceCredentials(signer).ForTenant(ctx, tenantID)
+	require.NoError(t, err)
+
+	md, ok := metadata.FromIncomingContext(got)
+	require.True(t, ok)
+	require.Len(t, md.Get("authorization"), 1)
+	claims, err := signer.ValidateToken(strings.TrimPrefix(md.Get("authorization")[0], "Bearer "))
+	require.NoError(t, err)
+	assert.Equal(t, tenantID, claims.TenantID)
+	assert.True(t, claims.HasRole(auth.RoleAuditor))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 )
 
 type DatabaseConfig struct {
@@ -16,13 +17,116 @@ type DatabaseConfig struct {
 }
 
 type KafkaConfig struct {
-	Brokers []string
+	ConsumerGroup string
+	Brokers       []string
+}
+
+// LedgerConfig configures the ledger service client. Report figures come from
+// a stub when GRPCAddr is empty. LiquidityWeightsFile names a JSON file
+// classifying ledger accounts for the LCR and NSFR; the standardised defaults
+// apply when it is empty.
+type LedgerConfig struct {
+	GRPCAddr             string
+	LiquidityWeightsFile string
+	PageSize             int
+	MaxRetries           int
+	RetryBackoffMs       int
+	TimeoutSeconds       int
+}
+
+// AMLConfig configures AML reporting: the fraud and payment service clients
+// the reports are sourced from, which are stubbed when their address is empty,
+// and the reporting entity registered with the financial intelligence unit.
+type AMLConfig struct {
+	FraudGRPCAddr     string
+	PaymentGRPCAddr   string
+	ReportingEntityID string
+	InstitutionName   string
+	LocalCurrency     string
+	CTRThreshold      string
+	PageSize          int
+	MaxRetries        int
+	RetryBackoffMs    int
+	TimeoutSeconds    int
+}
+
+// SchedulerConfig configures the scheduled report runs.
+type SchedulerConfig struct {
+	IntervalMinutes int
+	MaxAttempts     int
+}
+
+// SubmissionConfig configures how reports are filed with the regulator.
+// Channel is one of MANUAL, SFTP or API.
+type SubmissionConfig struct {
+	Channel                string
+	SFTPOutboundDir        string
+	SFTPInboundDir         string
+	APIBaseURL             string
+	APIToken               string
+	APITimeoutSeconds      int
+	AckPollIntervalMinutes int
+}
+
+// WarehouseConfig configures the nightly warehouse export. The export runs
+// when TenantIDs lists at least one tenant, landing Parquet in the S3 bucket
+// when one is configured and under Dir otherwise. Accounts come from the
+// account service, which is stubbed when AccountGRPCAddr is empty. Calls are
+// authorized with tenant tokens signed by the key in SigningKeyFile.
+type WarehouseConfig struct {
+	TenantIDs       string
+	AccountGRPCAddr string
+	Prefix          string
+	Dir             string
+	S3Endpoint      string
+	S3Region        string
+	S3Bucket        string
+	S3AccessKey     string
+	S3SecretKey     string
+	SigningKeyFile  string
+	RunHourUTC      int
+}
+
+// JobsConfig configures the workers running background report jobs. A
+// running job that reports no progress for LeaseMinutes is taken over by
+// another worker. Artifacts are stored in the S3 bucket ArtifactS3Bucket,
+// reached with the warehouse's S3 endpoint and credentials, when it is set,
+// and under ArtifactDir otherwise.
+type JobsConfig struct {
+	ArtifactDir         string
+	ArtifactS3Bucket    string
+	ArtifactPrefix      string
+	Workers             int
+	PollIntervalSeconds int
+	LeaseMinutes        int
+}
+
+// ConsistencyConfig configures the cross-service consistency checker. It runs
+// every IntervalMinutes for the tenants in TenantIDs, and is disabled when
+// there are none. ControlAccounts lists the ledger control accounts whose
+// customer sub-accounts are reconciled. Cards come from the card service,
+// which is stubbed when CardGRPCAddr is empty. Calls are authorized like the
+// warehouse export's.
+type ConsistencyConfig struct {
+	TenantIDs           string
+	ControlAccounts     string
+	CardGRPCAddr        string
+	IntervalMinutes     int
+	PaymentLookbackDays int
 }
 
 type Config struct {
 	DB          DatabaseConfig
 	ServiceName string
 	Kafka       KafkaConfig
+	Ledger      LedgerConfig
+	AML         AMLConfig
+	Scheduler   SchedulerConfig
+	Submission  SubmissionConfig
+	Warehouse   WarehouseConfig
+	Jobs        JobsConfig
+	Consistency ConsistencyConfig
+	Outbox      OutboxConfig
 	GRPCPort    int
 	HTTPPort    int
 }
@@ -33,6 +137,12 @@ func (c Config) Validate() {
 	}
 }
 
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
 func Load() Config {
 	return Config{
 		GRPCPort: getEnvInt("GRPC_PORT", 9090),
@@ -46,9 +156,76 @@ func Load() Config {
 			SSLMode:  getEnv("DB_SSLMODE", "require"),
 		},
 		Kafka: KafkaConfig{
-			Brokers: []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+			Brokers:       []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+			ConsumerGroup: getEnv("KAFKA_CONSUMER_GROUP", "reporting-service"),
+		},
+		Ledger: LedgerConfig{
+			GRPCAddr:       getEnv("LEDGER_SERVICE_ADDR", ""),
+			PageSize:       getEnvInt("LEDGER_PAGE_SIZE", 500),
+			MaxRetries:     getEnvInt("LEDGER_MAX_RETRIES", 3),
+			RetryBackoffMs: getEnvInt("LEDGER_RETRY_BACKOFF_MS", 200),
+			TimeoutSeconds: getEnvInt("LEDGER_TIMEOUT_SECONDS", 10),
+
+			LiquidityWeightsFile: getEnv("LIQUIDITY_WEIGHTS_FILE", ""),
+		},
+		AML: AMLConfig{
+			FraudGRPCAddr:     getEnv("FRAUD_SERVICE_ADDR", ""),
+			PaymentGRPCAddr:   getEnv("PAYMENT_SERVICE_ADDR", ""),
+			ReportingEntityID: getEnv("GOAML_REPORTING_ENTITY_ID", ""),
+			InstitutionName:   getEnv("GOAML_INSTITUTION_NAME", "BIB Bank"),
+			LocalCurrency:     getEnv("GOAML_LOCAL_CURRENCY", "EUR"),
+			CTRThreshold:      getEnv("CTR_THRESHOLD", "10000"),
+			PageSize:          getEnvInt("AML_PAGE_SIZE", 100),
+			MaxRetries:        getEnvInt("AML_MAX_RETRIES", 3),
+			RetryBackoffMs:    getEnvInt("AML_RETRY_BACKOFF_MS", 200),
+			TimeoutSeconds:    getEnvInt("AML_TIMEOUT_SECONDS", 10),
+		},
+		Scheduler: SchedulerConfig{
+			IntervalMinutes: getEnvInt("REPORT_SCHEDULER_INTERVAL_MINUTES", 60),
+			MaxAttempts:     getEnvInt("REPORT_SCHEDULER_MAX_ATTEMPTS", 3),
+		},
+		Submission: SubmissionConfig{
+			Channel:                getEnv("REPORT_SUBMISSION_CHANNEL", "MANUAL"),
+			SFTPOutboundDir:        getEnv("REPORT_SFTP_OUTBOUND_DIR", ""),
+			SFTPInboundDir:         getEnv("REPORT_SFTP_INBOUND_DIR", ""),
+			APIBaseURL:             getEnv("REGULATOR_API_URL", ""),
+			APIToken:               getEnv("REGULATOR_API_TOKEN", ""),
+			APITimeoutSeconds:      getEnvInt("REGULATOR_API_TIMEOUT_SECONDS", 30),
+			AckPollIntervalMinutes: getEnvInt("REPORT_ACK_POLL_INTERVAL_MINUTES", 15),
+		},
+		Warehouse: WarehouseConfig{
+			TenantIDs:       getEnv("WAREHOUSE_TENANT_IDS", ""),
+			AccountGRPCAddr: getEnv("ACCOUNT_SERVICE_ADDR", ""),
+			Prefix:          getEnv("WAREHOUSE_PREFIX", "curated/"),
+			Dir:             getEnv("WAREHOUSE_DIR", "/var/lib/bib/warehouse"),
+			S3Endpoint:      getEnv("WAREHOUSE_S3_ENDPOINT", "https://s3.amazonaws.com"),
+			S3Region:        getEnv("WAREHOUSE_S3_REGION", "us-east-1"),
+			S3Bucket:        getEnv("WAREHOUSE_S3_BUCKET", ""),
+			S3AccessKey:     getEnv("WAREHOUSE_S3_ACCESS_KEY", ""),
+			S3SecretKey:     getEnv("WAREHOUSE_S3_SECRET_KEY", ""),
+			SigningKeyFile:  getEnv("WAREHOUSE_SIGNING_KEY_FILE", ""),
+			RunHourUTC:      getEnvInt("WAREHOUSE_RUN_HOUR_UTC", 2),
+		},
+		Jobs: JobsConfig{
+			ArtifactDir:         getEnv("REPORT_ARTIFACT_DIR", "/var/lib/bib/report-artifacts"),
+			ArtifactS3Bucket:    getEnv("REPORT_ARTIFACT_S3_BUCKET", ""),
+			ArtifactPrefix:      getEnv("REPORT_ARTIFACT_PREFIX", "report-jobs/"),
+			Workers:             getEnvInt("REPORT_JOB_WORKERS", 2),
+			PollIntervalSeconds: getEnvInt("REPORT_JOB_POLL_INTERVAL_SECONDS", 5),
+			LeaseMinutes:        getEnvInt("REPORT_JOB_LEASE_MINUTES", 30),
+		},
+		Consistency: ConsistencyConfig{
+			TenantIDs:           getEnv("CONSISTENCY_TENANT_IDS", ""),
+			ControlAccounts:     getEnv("CONSISTENCY_CONTROL_ACCOUNTS", "2000,2100,2200"),
+			CardGRPCAddr:        getEnv("CARD_SERVICE_ADDR", ""),
+			IntervalMinutes:     getEnvInt("CONSISTENCY_INTERVAL_MINUTES", 60),
+			PaymentLookbackDays: getEnvInt("CONSISTENCY_PAYMENT_LOOKBACK_DAYS", 7),
 		},
 		ServiceName: "reporting-service",
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
 	}
 }
 
@@ -75,3 +252,31 @@ func getEnvInt(key string, fallback int) int {
 	}
 	return fallback
 }
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
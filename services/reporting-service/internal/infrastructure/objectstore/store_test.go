@@ -0,0 +1,45 @@
+package objectstore_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/archive"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/infrastructure/objectstore"
+)
+
+const partitionKey = "curated/payments/v1/dt=2025-03-14/tenant_id=t1/part-00000.parquet"
+
+func TestStore_Put(t *testing.T) {
+	dir := t.TempDir()
+	store := objectstore.New(archive.NewFileStore(dir))
+
+	require.NoError(t, store.Put(context.Background(), partitionKey, []byte("v1"), "application/vnd.apache.parquet"))
+	require.NoError(t, store.Put(context.Background(), partitionKey, []byte("v2"), "application/vnd.apache.parquet"))
+
+	data, err := os.ReadFile(filepath.Join(dir, partitionKey))
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(data))
+
+	assert.Error(t, store.Put(context.Background(), "../escape", []byte("x"), ""))
+}
+
+func TestStore_Get(t *testing.T) {
+	store := objectstore.New(archive.NewFileStore(t.TempDir()))
+	require.NoError(t, store.Put(context.Background(), "reports/t1/job/finrep-2025-Q1.xbrl", []byte("<xbrl/>"), "application/xml"))
+
+	data, err := store.Get(context.Background(), "reports/t1/job/finrep-2025-Q1.xbrl")
+	require.NoError(t, err)
+	assert.Equal(t, "<xbrl/>", string(data))
+
+	_, err = store.Get(context.Background(), "reports/t1/job/missing.csv")
+	assert.ErrorIs(t, err, port.ErrObjectNotFound)
+	_, err = store.Get(context.Background(), "../escape")
+	assert.Error(t, err)
+}
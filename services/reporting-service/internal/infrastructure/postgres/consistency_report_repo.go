@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// ConsistencyReportRepo is the PostgreSQL implementation of ConsistencyReportRepository.
+type ConsistencyReportRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewConsistencyReportRepo creates a new ConsistencyReportRepo.
+func NewConsistencyReportRepo(pool *pgxpool.Pool) *ConsistencyReportRepo {
+	return &ConsistencyReportRepo{pool: pool}
+}
+
+// Save inserts a discrepancy report.
+func (r *ConsistencyReportRepo) Save(ctx context.Context, report service.ConsistencyReport) error {
+	invariantsJSON, err := json.Marshal(report.Invariants)
+	if err != nil {
+		return fmt.Errorf("failed to marshal invariants: %w", err)
+	}
+	discrepancies := report.Discrepancies
+	if discrepancies == nil {
+		discrepancies = []service.Discrepancy{}
+	}
+	discrepanciesJSON, err := json.Marshal(discrepancies)
+	if err != nil {
+		return fmt.Errorf("failed to marshal discrepancies: %w", err)
+	}
+
+	query := `
+		INSERT INTO consistency_reports (
+			id, tenant_id, checked_at, invariants, discrepancy_count, discrepancies
+		) VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err = r.pool.Exec(ctx, query,
+		report.ID, report.TenantID, report.CheckedAt, invariantsJSON,
+		len(report.Discrepancies), discrepanciesJSON,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save consistency report: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,297 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+const (
+	depositPositionColumns = `
+	id, tenant_id, account_id, currency, principal, accrued_interest,
+	opened_at, closed_at, updated_at`
+	loanPositionColumns = `
+	id, tenant_id, currency, bucket, principal, outstanding, disbursed_at, updated_at`
+	paymentFactColumns = `
+	id, tenant_id, rail, currency, amount, status, initiated_at, updated_at`
+)
+
+// DashboardReadModelRepo is the PostgreSQL implementation of DashboardReadModel.
+type DashboardReadModelRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewDashboardReadModelRepo creates a new DashboardReadModelRepo.
+func NewDashboardReadModelRepo(pool *pgxpool.Pool) *DashboardReadModelRepo {
+	return &DashboardReadModelRepo{pool: pool}
+}
+
+// FindDepositPosition retrieves a deposit position.
+func (r *DashboardReadModelRepo) FindDepositPosition(ctx context.Context, id uuid.UUID) (service.DepositPosition, error) {
+	query := `SELECT ` + depositPositionColumns + ` FROM dashboard_deposit_positions WHERE id = $1`
+
+	position, err := scanDepositPosition(r.pool.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.DepositPosition{}, port.ErrProjectionNotFound
+	}
+	if err != nil {
+		return service.DepositPosition{}, fmt.Errorf("failed to scan deposit position: %w", err)
+	}
+	return position, nil
+}
+
+// SaveDepositPosition upserts a deposit position.
+func (r *DashboardReadModelRepo) SaveDepositPosition(ctx context.Context, p service.DepositPosition) error {
+	query := `
+		INSERT INTO dashboard_deposit_positions (` + depositPositionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (id) DO UPDATE SET
+			accrued_interest = EXCLUDED.accrued_interest,
+			closed_at = EXCLUDED.closed_at,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		p.ID, p.TenantID, p.AccountID, p.Currency, p.Principal, p.AccruedInterest,
+		p.OpenedAt, p.ClosedAt, p.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save deposit position: %w", err)
+	}
+	return nil
+}
+
+// ListDepositPositions retrieves all of a tenant's deposit positions.
+func (r *DashboardReadModelRepo) ListDepositPositions(ctx context.Context, tenantID uuid.UUID) ([]service.DepositPosition, error) {
+	query := `SELECT ` + depositPositionColumns + `
+		FROM dashboard_deposit_positions
+		WHERE tenant_id = $1
+		ORDER BY opened_at
+	`
+
+	rows, err := r.pool.Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query deposit positions: %w", err)
+	}
+	defer rows.Close()
+
+	var positions []service.DepositPosition
+	for rows.Next() {
+		position, err := scanDepositPosition(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan deposit position row: %w", err)
+		}
+		positions = append(positions, position)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return positions, nil
+}
+
+// FindLoanPosition retrieves a loan.
+func (r *DashboardReadModelRepo) FindLoanPosition(ctx context.Context, id string) (service.LoanPosition, error) {
+	query := `SELECT ` + loanPositionColumns + ` FROM dashboard_loans WHERE id = $1`
+
+	loan, err := scanLoanPosition(r.pool.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.LoanPosition{}, port.ErrProjectionNotFound
+	}
+	if err != nil {
+		return service.LoanPosition{}, fmt.Errorf("failed to scan loan: %w", err)
+	}
+	return loan, nil
+}
+
+// SaveLoanPosition upserts a loan.
+func (r *DashboardReadModelRepo) SaveLoanPosition(ctx context.Context, l service.LoanPosition) error {
+	query := `
+		INSERT INTO dashboard_loans (` + loanPositionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (id) DO UPDATE SET
+			bucket = EXCLUDED.bucket,
+			outstanding = EXCLUDED.outstanding,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		l.ID, l.TenantID, l.Currency, l.Bucket, l.Principal, l.Outstanding, l.DisbursedAt, l.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save loan: %w", err)
+	}
+	return nil
+}
+
+// ListLoanPositions retrieves a tenant's loans that are not paid off.
+func (r *DashboardReadModelRepo) ListLoanPositions(ctx context.Context, tenantID uuid.UUID) ([]service.LoanPosition, error) {
+	return r.listLoanPositions(ctx, `SELECT `+loanPositionColumns+`
+		FROM dashboard_loans
+		WHERE tenant_id = $1 AND bucket <> 'PAID_OFF'
+		ORDER BY disbursed_at
+	`, tenantID)
+}
+
+// ListAllLoanPositions retrieves all of a tenant's loans, paid-off ones included.
+func (r *DashboardReadModelRepo) ListAllLoanPositions(ctx context.Context, tenantID uuid.UUID) ([]service.LoanPosition, error) {
+	return r.listLoanPositions(ctx, `SELECT `+loanPositionColumns+`
+		FROM dashboard_loans
+		WHERE tenant_id = $1
+		ORDER BY disbursed_at
+	`, tenantID)
+}
+
+func (r *DashboardReadModelRepo) listLoanPositions(ctx context.Context, query string, tenantID uuid.UUID) ([]service.LoanPosition, error) {
+	rows, err := r.pool.Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query loans: %w", err)
+	}
+	defer rows.Close()
+
+	var loans []service.LoanPosition
+	for rows.Next() {
+		loan, err := scanLoanPosition(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan loan row: %w", err)
+		}
+		loans = append(loans, loan)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return loans, nil
+}
+
+// FindPayment retrieves a payment.
+func (r *DashboardReadModelRepo) FindPayment(ctx context.Context, id uuid.UUID) (service.PaymentFact, error) {
+	query := `SELECT ` + paymentFactColumns + ` FROM dashboard_payments WHERE id = $1`
+
+	payment, err := scanPaymentFact(r.pool.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.PaymentFact{}, port.ErrProjectionNotFound
+	}
+	if err != nil {
+		return service.PaymentFact{}, fmt.Errorf("failed to scan payment: %w", err)
+	}
+	return payment, nil
+}
+
+// SavePayment upserts a payment.
+func (r *DashboardReadModelRepo) SavePayment(ctx context.Context, p service.PaymentFact) error {
+	query := `
+		INSERT INTO dashboard_payments (` + paymentFactColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		p.ID, p.TenantID, p.Rail, p.Currency, p.Amount, p.Status, p.InitiatedAt, p.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save payment: %w", err)
+	}
+	return nil
+}
+
+// ListPayments retrieves a tenant's payments initiated within [from, to).
+func (r *DashboardReadModelRepo) ListPayments(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]service.PaymentFact, error) {
+	query := `SELECT ` + paymentFactColumns + `
+		FROM dashboard_payments
+		WHERE tenant_id = $1 AND initiated_at >= $2 AND initiated_at < $3
+	`
+
+	rows, err := r.pool.Query(ctx, query, tenantID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query payments: %w", err)
+	}
+	defer rows.Close()
+
+	var payments []service.PaymentFact
+	for rows.Next() {
+		payment, err := scanPaymentFact(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan payment row: %w", err)
+		}
+		payments = append(payments, payment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return payments, nil
+}
+
+// SaveFraudDecision upserts a fraud assessment's decision.
+func (r *DashboardReadModelRepo) SaveFraudDecision(ctx context.Context, d service.FraudDecisionFact) error {
+	query := `
+		INSERT INTO dashboard_fraud_decisions (assessment_id, tenant_id, decision, assessed_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (assessment_id) DO UPDATE SET
+			decision = EXCLUDED.decision,
+			assessed_at = EXCLUDED.assessed_at
+	`
+
+	_, err := r.pool.Exec(ctx, query, d.AssessmentID, d.TenantID, d.Decision, d.AssessedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save fraud decision: %w", err)
+	}
+	return nil
+}
+
+// ListFraudDecisions retrieves a tenant's fraud decisions made within [from, to).
+func (r *DashboardReadModelRepo) ListFraudDecisions(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]service.FraudDecisionFact, error) {
+	query := `
+		SELECT assessment_id, tenant_id, decision, assessed_at
+		FROM dashboard_fraud_decisions
+		WHERE tenant_id = $1 AND assessed_at >= $2 AND assessed_at < $3
+	`
+
+	rows, err := r.pool.Query(ctx, query, tenantID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query fraud decisions: %w", err)
+	}
+	defer rows.Close()
+
+	var decisions []service.FraudDecisionFact
+	for rows.Next() {
+		var d service.FraudDecisionFact
+		if err := rows.Scan(&d.AssessmentID, &d.TenantID, &d.Decision, &d.AssessedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan fraud decision row: %w", err)
+		}
+		decisions = append(decisions, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return decisions, nil
+}
+
+func scanDepositPosition(row pgx.Row) (service.DepositPosition, error) {
+	var p service.DepositPosition
+	err := row.Scan(
+		&p.ID, &p.TenantID, &p.AccountID, &p.Currency, &p.Principal, &p.AccruedInterest,
+		&p.OpenedAt, &p.ClosedAt, &p.UpdatedAt,
+	)
+	return p, err
+}
+
+func scanLoanPosition(row pgx.Row) (service.LoanPosition, error) {
+	var l service.LoanPosition
+	err := row.Scan(&l.ID, &l.TenantID, &l.Currency, &l.Bucket, &l.Principal, &l.Outstanding, &l.DisbursedAt, &l.UpdatedAt)
+	return l, err
+}
+
+func scanPaymentFact(row pgx.Row) (service.PaymentFact, error) {
+	var p service.PaymentFact
+	err := row.Scan(&p.ID, &p.TenantID, &p.Rail, &p.Currency, &p.Amount, &p.Status, &p.InitiatedAt, &p.UpdatedAt)
+	return p, err
+}
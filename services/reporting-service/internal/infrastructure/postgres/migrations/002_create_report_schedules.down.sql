@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_scheduled_runs_open;
+DROP INDEX IF EXISTS idx_scheduled_runs_tenant_deadline;
+DROP TABLE IF EXISTS scheduled_runs;
+
+DROP INDEX IF EXISTS idx_report_schedules_active;
+DROP TABLE IF EXISTS report_schedules;
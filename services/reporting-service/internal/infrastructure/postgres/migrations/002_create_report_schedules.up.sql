@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS report_schedules (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    report_type VARCHAR(20) NOT NULL,
+    frequency VARCHAR(20) NOT NULL,
+    deadline_days INT NOT NULL,
+    lead_days INT NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, report_type)
+);
+
+CREATE INDEX idx_report_schedules_active ON report_schedules (tenant_id) WHERE active;
+
+CREATE TABLE IF NOT EXISTS scheduled_runs (
+    id UUID PRIMARY KEY,
+    schedule_id UUID NOT NULL REFERENCES report_schedules (id),
+    tenant_id UUID NOT NULL,
+    report_type VARCHAR(20) NOT NULL,
+    reporting_period VARCHAR(10) NOT NULL,
+    deadline TIMESTAMPTZ NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    report_id UUID REFERENCES report_submissions (id),
+    attempts INT NOT NULL DEFAULT 0,
+    failure_reason TEXT NOT NULL DEFAULT '',
+    submitted_at TIMESTAMPTZ,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (schedule_id, reporting_period)
+);
+
+CREATE INDEX idx_scheduled_runs_tenant_deadline ON scheduled_runs (tenant_id, deadline);
+CREATE INDEX idx_scheduled_runs_open ON scheduled_runs (deadline)
+    WHERE status IN ('PENDING', 'FAILED', 'GENERATED')
+       OR (status = 'MISSED' AND report_id IS NOT NULL);
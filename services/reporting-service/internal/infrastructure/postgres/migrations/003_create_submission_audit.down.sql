@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_submission_audit_submission;
+DROP TABLE IF EXISTS submission_audit;
+
+DROP INDEX IF EXISTS idx_reports_awaiting_ack;
+ALTER TABLE report_submissions
+    DROP COLUMN IF EXISTS submission_attempts,
+    DROP COLUMN IF EXISTS submission_reference,
+    DROP COLUMN IF EXISTS submission_channel;
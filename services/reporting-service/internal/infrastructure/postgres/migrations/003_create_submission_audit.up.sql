@@ -0,0 +1,23 @@
+ALTER TABLE report_submissions
+    ADD COLUMN IF NOT EXISTS submission_channel VARCHAR(10) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS submission_reference VARCHAR(200) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS submission_attempts INT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_reports_awaiting_ack ON report_submissions (submission_channel)
+    WHERE status = 'SUBMITTED';
+
+CREATE TABLE IF NOT EXISTS submission_audit (
+    id UUID PRIMARY KEY,
+    submission_id UUID NOT NULL REFERENCES report_submissions (id),
+    tenant_id UUID NOT NULL,
+    channel VARCHAR(10) NOT NULL,
+    attempt INT NOT NULL,
+    action VARCHAR(30) NOT NULL,
+    reference VARCHAR(200) NOT NULL DEFAULT '',
+    detail TEXT NOT NULL DEFAULT '',
+    actor VARCHAR(100) NOT NULL DEFAULT '',
+    content_sha256 CHAR(64) NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_submission_audit_submission ON submission_audit (submission_id, occurred_at);
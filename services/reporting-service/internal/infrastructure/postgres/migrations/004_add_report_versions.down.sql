@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_reports_versions;
+ALTER TABLE report_submissions
+    DROP COLUMN IF EXISTS amendment_note,
+    DROP COLUMN IF EXISTS amendment_reason,
+    DROP COLUMN IF EXISTS amends_id,
+    DROP COLUMN IF EXISTS original_id,
+    DROP COLUMN IF EXISTS revision;
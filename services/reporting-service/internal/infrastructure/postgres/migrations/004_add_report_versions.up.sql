@@ -0,0 +1,12 @@
+ALTER TABLE report_submissions
+    ADD COLUMN IF NOT EXISTS revision INT NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS original_id UUID,
+    ADD COLUMN IF NOT EXISTS amends_id UUID REFERENCES report_submissions (id),
+    ADD COLUMN IF NOT EXISTS amendment_reason VARCHAR(30) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS amendment_note TEXT NOT NULL DEFAULT '';
+
+UPDATE report_submissions SET original_id = id WHERE original_id IS NULL;
+ALTER TABLE report_submissions ALTER COLUMN original_id SET NOT NULL;
+
+-- One version per revision keeps each filing's lineage linear.
+CREATE UNIQUE INDEX idx_reports_versions ON report_submissions (original_id, revision);
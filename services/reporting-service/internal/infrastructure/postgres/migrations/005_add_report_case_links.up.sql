@@ -0,0 +1,3 @@
+-- Fraud cases an AML report was filed for.
+ALTER TABLE report_submissions
+    ADD COLUMN IF NOT EXISTS linked_case_ids JSONB NOT NULL DEFAULT '[]';
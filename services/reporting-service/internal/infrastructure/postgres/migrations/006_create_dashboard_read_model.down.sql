@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_dashboard_fraud_decisions_tenant;
+DROP TABLE IF EXISTS dashboard_fraud_decisions;
+
+DROP INDEX IF EXISTS idx_dashboard_payments_tenant;
+DROP TABLE IF EXISTS dashboard_payments;
+
+DROP INDEX IF EXISTS idx_dashboard_loans_on_book;
+DROP TABLE IF EXISTS dashboard_loans;
+
+DROP INDEX IF EXISTS idx_dashboard_deposits_tenant;
+DROP TABLE IF EXISTS dashboard_deposit_positions;
@@ -0,0 +1,48 @@
+CREATE TABLE IF NOT EXISTS dashboard_deposit_positions (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    account_id UUID NOT NULL,
+    currency CHAR(3) NOT NULL,
+    principal NUMERIC(20, 4) NOT NULL,
+    accrued_interest NUMERIC(20, 4) NOT NULL DEFAULT 0,
+    opened_at TIMESTAMPTZ NOT NULL,
+    closed_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_dashboard_deposits_tenant ON dashboard_deposit_positions (tenant_id, opened_at);
+
+CREATE TABLE IF NOT EXISTS dashboard_loans (
+    id VARCHAR(64) PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    currency CHAR(3) NOT NULL,
+    bucket VARCHAR(20) NOT NULL,
+    principal NUMERIC(20, 4) NOT NULL,
+    outstanding NUMERIC(20, 4) NOT NULL,
+    disbursed_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_dashboard_loans_on_book ON dashboard_loans (tenant_id) WHERE bucket <> 'PAID_OFF';
+
+CREATE TABLE IF NOT EXISTS dashboard_payments (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    rail VARCHAR(20) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    amount NUMERIC(20, 4) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    initiated_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_dashboard_payments_tenant ON dashboard_payments (tenant_id, initiated_at);
+
+CREATE TABLE IF NOT EXISTS dashboard_fraud_decisions (
+    assessment_id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    decision VARCHAR(20) NOT NULL,
+    assessed_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_dashboard_fraud_decisions_tenant ON dashboard_fraud_decisions (tenant_id, assessed_at);
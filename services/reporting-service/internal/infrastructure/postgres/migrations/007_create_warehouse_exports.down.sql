@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_warehouse_exports_day;
+DROP TABLE IF EXISTS warehouse_exports;
+
+DROP TABLE IF EXISTS warehouse_schemas;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS warehouse_schemas (
+    dataset VARCHAR(64) PRIMARY KEY,
+    version INTEGER NOT NULL,
+    columns JSONB NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS warehouse_exports (
+    dataset VARCHAR(64) NOT NULL,
+    tenant_id UUID NOT NULL,
+    day DATE NOT NULL,
+    schema_version INTEGER NOT NULL,
+    object_key TEXT NOT NULL,
+    content_sha256 CHAR(64) NOT NULL,
+    rows INTEGER NOT NULL,
+    exported_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (dataset, tenant_id, day)
+);
+
+CREATE INDEX idx_warehouse_exports_day ON warehouse_exports (day);
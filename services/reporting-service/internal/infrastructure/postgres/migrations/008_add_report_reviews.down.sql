@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_report_reviews_submission;
+DROP TABLE IF EXISTS report_reviews;
+
+ALTER TABLE report_submissions
+    DROP COLUMN IF EXISTS approved_at,
+    DROP COLUMN IF EXISTS approved_by,
+    DROP COLUMN IF EXISTS prepared_by;
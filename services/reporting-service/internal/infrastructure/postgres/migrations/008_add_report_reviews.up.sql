@@ -0,0 +1,18 @@
+-- Maker-checker sign-off: who prepared each report and who approved it.
+ALTER TABLE report_submissions
+    ADD COLUMN IF NOT EXISTS prepared_by VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS approved_by VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS approved_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS report_reviews (
+    id UUID PRIMARY KEY,
+    submission_id UUID NOT NULL REFERENCES report_submissions (id),
+    tenant_id UUID NOT NULL,
+    decision VARCHAR(20) NOT NULL,
+    reviewer VARCHAR(100) NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    content_sha256 CHAR(64) NOT NULL,
+    reviewed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_report_reviews_submission ON report_reviews (submission_id, reviewed_at);
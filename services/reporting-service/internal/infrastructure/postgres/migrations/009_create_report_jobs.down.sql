@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_report_jobs_claimable;
+DROP INDEX IF EXISTS idx_report_jobs_tenant_created;
+DROP TABLE IF EXISTS report_jobs;
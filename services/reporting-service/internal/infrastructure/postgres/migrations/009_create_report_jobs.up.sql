@@ -0,0 +1,26 @@
+-- Background report generation jobs, for reports too large to generate
+-- within a request deadline.
+CREATE TABLE IF NOT EXISTS report_jobs (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    report_type VARCHAR(20) NOT NULL,
+    reporting_period VARCHAR(10) NOT NULL,
+    format VARCHAR(10) NOT NULL DEFAULT '',
+    requested_by VARCHAR(100) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'QUEUED',
+    progress INT NOT NULL DEFAULT 0,
+    stage VARCHAR(100) NOT NULL DEFAULT '',
+    report_id UUID REFERENCES report_submissions (id),
+    artifacts JSONB NOT NULL DEFAULT '[]',
+    failure_detail TEXT NOT NULL DEFAULT '',
+    attempts INT NOT NULL DEFAULT 0,
+    started_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_report_jobs_tenant_created ON report_jobs (tenant_id, created_at DESC);
+CREATE INDEX idx_report_jobs_claimable ON report_jobs (created_at)
+    WHERE status IN ('QUEUED', 'RUNNING');
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_consistency_reports_tenant;
+DROP TABLE IF EXISTS consistency_reports;
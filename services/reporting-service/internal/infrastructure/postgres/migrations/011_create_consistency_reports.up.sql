@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS consistency_reports (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    checked_at TIMESTAMPTZ NOT NULL,
+    invariants JSONB NOT NULL,
+    discrepancy_count INTEGER NOT NULL,
+    discrepancies JSONB NOT NULL
+);
+
+CREATE INDEX idx_consistency_reports_tenant ON consistency_reports (tenant_id, checked_at DESC);
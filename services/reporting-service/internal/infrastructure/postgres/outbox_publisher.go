@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/bibbank/bib/pkg/outbox"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/event"
+)
+
+// UnknownEventTopic receives events that have no topic of their own.
+const UnknownEventTopic = "reporting.unknown"
+
+// OutboxPublisher implements port.EventPublisher by writing events to the
+// outbox, from which the relay publishes each to the topic for its type.
+type OutboxPublisher struct {
+	publisher *outbox.Publisher
+}
+
+// NewOutboxPublisher creates a new OutboxPublisher.
+func NewOutboxPublisher(publisher *outbox.Publisher) *OutboxPublisher {
+	return &OutboxPublisher{publisher: publisher}
+}
+
+// Publish writes the events to the outbox.
+func (p *OutboxPublisher) Publish(ctx context.Context, events ...event.DomainEvent) error {
+	for _, evt := range events {
+		if err := p.publisher.Publish(ctx, topicForEvent(evt), evt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// topicForEvent returns the Kafka topic for a given domain event.
+func topicForEvent(evt event.DomainEvent) string {
+	switch evt.(type) {
+	case event.ReportGenerated:
+		return "reporting.report.generated"
+	case event.ReportSubmitted:
+		return "reporting.report.submitted"
+	case event.ReportApproved:
+		return "reporting.report.approved"
+	case event.ReportChangesRequested:
+		return "reporting.report.changes_requested"
+	case event.ReportAccepted:
+		return "reporting.report.accepted"
+	case event.ReportRejected:
+		return "reporting.report.rejected"
+	case event.ReportJobCompleted:
+		return "reporting.report.job_completed"
+	case event.ScheduledReportFailed, event.ReportDeadlineMissed:
+		return "reporting.schedule.alerts"
+	case event.ConsistencyCheckFailed:
+		return "reporting.consistency.alerts"
+	default:
+		return UnknownEventTopic
+	}
+}
@@ -0,0 +1,244 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+const reportJobColumns = `
+	id, tenant_id, report_type, reporting_period, format, requested_by,
+	status, progress, stage, report_id, artifacts, failure_detail, attempts,
+	started_at, completed_at, version, created_at, updated_at`
+
+// reportArtifactRow is the JSON form of a job's artifact in the artifacts column.
+type reportArtifactRow struct {
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	Key         string `json:"key"`
+	SHA256      string `json:"sha256"`
+	SizeBytes   int64  `json:"size_bytes"`
+}
+
+// ReportJobRepo is the PostgreSQL implementation of ReportJobRepository.
+type ReportJobRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewReportJobRepo creates a new ReportJobRepo.
+func NewReportJobRepo(pool *pgxpool.Pool) *ReportJobRepo {
+	return &ReportJobRepo{pool: pool}
+}
+
+// Save persists a report job. It uses upsert to handle both create and update.
+func (r *ReportJobRepo) Save(ctx context.Context, job model.ReportJob) error {
+	return saveReportJob(ctx, r.pool, job)
+}
+
+// FindByID retrieves a tenant's report job.
+func (r *ReportJobRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.ReportJob, error) {
+	query := `SELECT ` + reportJobColumns + `
+		FROM report_jobs
+		WHERE id = $1 AND tenant_id = $2
+	`
+
+	job, err := scanReportJob(r.pool.QueryRow(ctx, query, id, tenantID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.ReportJob{}, port.ErrReportJobNotFound
+	}
+	if err != nil {
+		return model.ReportJob{}, fmt.Errorf("failed to scan report job: %w", err)
+	}
+	return job, nil
+}
+
+// ListByTenant retrieves a tenant's most recent report jobs.
+func (r *ReportJobRepo) ListByTenant(ctx context.Context, tenantID uuid.UUID, limit int) ([]model.ReportJob, error) {
+	query := `SELECT ` + reportJobColumns + `
+		FROM report_jobs
+		WHERE tenant_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+
+	rows, err := r.pool.Query(ctx, query, tenantID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query report jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []model.ReportJob
+	for rows.Next() {
+		job, err := scanReportJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan report job row: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return jobs, nil
+}
+
+// ClaimNext locks the oldest claimable job, skipping those other workers
+// hold, and starts it in the same transaction.
+func (r *ReportJobRepo) ClaimNext(ctx context.Context, now, staleBefore time.Time) (model.ReportJob, bool, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return model.ReportJob{}, false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }() //nolint:errcheck // no-op after commit
+
+	query := `SELECT ` + reportJobColumns + `
+		FROM report_jobs
+		WHERE status = 'QUEUED' OR (status = 'RUNNING' AND updated_at < $1)
+		ORDER BY created_at
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	`
+	job, err := scanReportJob(tx.QueryRow(ctx, query, staleBefore))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.ReportJob{}, false, nil
+	}
+	if err != nil {
+		return model.ReportJob{}, false, fmt.Errorf("failed to scan report job: %w", err)
+	}
+
+	job, err = job.Start(now)
+	if err != nil {
+		return model.ReportJob{}, false, err
+	}
+	if err := saveReportJob(ctx, tx, job); err != nil {
+		return model.ReportJob{}, false, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return model.ReportJob{}, false, fmt.Errorf("failed to commit report job claim: %w", err)
+	}
+	return job, true, nil
+}
+
+type execer interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+func saveReportJob(ctx context.Context, db execer, job model.ReportJob) error {
+	artifacts := make([]reportArtifactRow, 0, len(job.Artifacts()))
+	for _, a := range job.Artifacts() {
+		artifacts = append(artifacts, reportArtifactRow(a))
+	}
+	artifactsJSON, err := json.Marshal(artifacts)
+	if err != nil {
+		return fmt.Errorf("failed to marshal artifacts: %w", err)
+	}
+
+	query := `
+		INSERT INTO report_jobs (` + reportJobColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			progress = EXCLUDED.progress,
+			stage = EXCLUDED.stage,
+			report_id = EXCLUDED.report_id,
+			artifacts = EXCLUDED.artifacts,
+			failure_detail = EXCLUDED.failure_detail,
+			attempts = EXCLUDED.attempts,
+			started_at = EXCLUDED.started_at,
+			completed_at = EXCLUDED.completed_at,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err = db.Exec(ctx, query,
+		job.ID(),
+		job.TenantID(),
+		job.ReportType().String(),
+		job.ReportingPeriod(),
+		job.Format(),
+		job.RequestedBy(),
+		job.Status().String(),
+		job.Progress(),
+		job.Stage(),
+		job.ReportID(),
+		artifactsJSON,
+		job.FailureDetail(),
+		job.Attempts(),
+		job.StartedAt(),
+		job.CompletedAt(),
+		job.Version(),
+		job.CreatedAt(),
+		job.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save report job: %w", err)
+	}
+	return nil
+}
+
+func scanReportJob(row pgx.Row) (model.ReportJob, error) {
+	var (
+		id              uuid.UUID
+		tenantID        uuid.UUID
+		reportTypeStr   string
+		reportingPeriod string
+		format          string
+		requestedBy     string
+		statusStr       string
+		progress        int
+		stage           string
+		reportID        *uuid.UUID
+		artifactsJSON   []byte
+		failureDetail   string
+		attempts        int
+		startedAt       *time.Time
+		completedAt     *time.Time
+		version         int
+		createdAt       time.Time
+		updatedAt       time.Time
+	)
+
+	err := row.Scan(
+		&id, &tenantID, &reportTypeStr, &reportingPeriod, &format, &requestedBy,
+		&statusStr, &progress, &stage, &reportID, &artifactsJSON, &failureDetail, &attempts,
+		&startedAt, &completedAt, &version, &createdAt, &updatedAt,
+	)
+	if err != nil {
+		return model.ReportJob{}, err
+	}
+
+	reportType, err := valueobject.NewReportType(reportTypeStr)
+	if err != nil {
+		return model.ReportJob{}, fmt.Errorf("invalid report type in database: %w", err)
+	}
+
+	status, err := valueobject.NewJobStatus(statusStr)
+	if err != nil {
+		return model.ReportJob{}, fmt.Errorf("invalid job status in database: %w", err)
+	}
+
+	var rows []reportArtifactRow
+	if err := json.Unmarshal(artifactsJSON, &rows); err != nil {
+		return model.ReportJob{}, fmt.Errorf("failed to unmarshal artifacts: %w", err)
+	}
+	artifacts := make([]model.ReportArtifact, 0, len(rows))
+	for _, a := range rows {
+		artifacts = append(artifacts, model.ReportArtifact(a))
+	}
+
+	return model.ReconstructReportJob(
+		id, tenantID, reportType, reportingPeriod, format, requestedBy,
+		status, progress, stage, reportID, artifacts, failureDetail, attempts,
+		startedAt, completedAt, version, createdAt, updatedAt,
+	), nil
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+)
+
+// ReportReviewRepo is the PostgreSQL implementation of ReportReviewRepository.
+type ReportReviewRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewReportReviewRepo creates a new ReportReviewRepo.
+func NewReportReviewRepo(pool *pgxpool.Pool) *ReportReviewRepo {
+	return &ReportReviewRepo{pool: pool}
+}
+
+// Append records a review. Reviews are never updated.
+func (r *ReportReviewRepo) Append(ctx context.Context, review model.ReportReview) error {
+	query := `
+		INSERT INTO report_reviews (
+			id, submission_id, tenant_id, decision, reviewer,
+			comment, content_sha256, reviewed_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		review.ID(),
+		review.SubmissionID(),
+		review.TenantID(),
+		review.Decision(),
+		review.Reviewer(),
+		review.Comment(),
+		review.ContentSHA256(),
+		review.ReviewedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to append report review: %w", err)
+	}
+
+	return nil
+}
+
+// ListBySubmission retrieves a submission's reviews, oldest first.
+func (r *ReportReviewRepo) ListBySubmission(ctx context.Context, submissionID uuid.UUID) ([]model.ReportReview, error) {
+	query := `
+		SELECT id, submission_id, tenant_id, decision, reviewer,
+			comment, content_sha256, reviewed_at
+		FROM report_reviews
+		WHERE submission_id = $1
+		ORDER BY reviewed_at
+	`
+
+	rows, err := r.pool.Query(ctx, query, submissionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query report reviews: %w", err)
+	}
+	defer rows.Close()
+
+	var reviews []model.ReportReview
+	for rows.Next() {
+		var (
+			id            uuid.UUID
+			subID         uuid.UUID
+			tenantID      uuid.UUID
+			decision      string
+			reviewer      string
+			comment       string
+			contentSHA256 string
+			reviewedAt    time.Time
+		)
+		if err := rows.Scan(&id, &subID, &tenantID, &decision, &reviewer,
+			&comment, &contentSHA256, &reviewedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan report review row: %w", err)
+		}
+
+		reviews = append(reviews, model.ReconstructReportReview(
+			id, subID, tenantID, decision, reviewer, comment, contentSHA256, reviewedAt,
+		))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return reviews, nil
+}
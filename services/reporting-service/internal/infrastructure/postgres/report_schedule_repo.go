@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+const reportScheduleColumns = `
+	id, tenant_id, report_type, frequency, deadline_days, lead_days,
+	active, version, created_at, updated_at`
+
+// ReportScheduleRepo is the PostgreSQL implementation of ReportScheduleRepository.
+type ReportScheduleRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewReportScheduleRepo creates a new ReportScheduleRepo.
+func NewReportScheduleRepo(pool *pgxpool.Pool) *ReportScheduleRepo {
+	return &ReportScheduleRepo{pool: pool}
+}
+
+// Save persists a report schedule. It uses upsert to handle both create and update.
+func (r *ReportScheduleRepo) Save(ctx context.Context, schedule model.ReportSchedule) error {
+	query := `
+		INSERT INTO report_schedules (` + reportScheduleColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE SET
+			frequency = EXCLUDED.frequency,
+			deadline_days = EXCLUDED.deadline_days,
+			lead_days = EXCLUDED.lead_days,
+			active = EXCLUDED.active,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		schedule.ID(),
+		schedule.TenantID(),
+		schedule.ReportType().String(),
+		schedule.Frequency().String(),
+		schedule.DeadlineDays(),
+		schedule.LeadDays(),
+		schedule.Active(),
+		schedule.Version(),
+		schedule.CreatedAt(),
+		schedule.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save report schedule: %w", err)
+	}
+
+	return nil
+}
+
+// FindByTenantAndType retrieves a tenant's schedule for a report type.
+func (r *ReportScheduleRepo) FindByTenantAndType(ctx context.Context, tenantID uuid.UUID, reportType string) (model.ReportSchedule, error) {
+	query := `SELECT ` + reportScheduleColumns + `
+		FROM report_schedules
+		WHERE tenant_id = $1 AND report_type = $2
+	`
+
+	schedule, err := scanReportSchedule(r.pool.QueryRow(ctx, query, tenantID, reportType))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.ReportSchedule{}, port.ErrScheduleNotFound
+	}
+	if err != nil {
+		return model.ReportSchedule{}, fmt.Errorf("failed to scan report schedule: %w", err)
+	}
+	return schedule, nil
+}
+
+// ListByTenant retrieves all schedules of a tenant.
+func (r *ReportScheduleRepo) ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]model.ReportSchedule, error) {
+	query := `SELECT ` + reportScheduleColumns + `
+		FROM report_schedules
+		WHERE tenant_id = $1
+		ORDER BY report_type
+	`
+	return r.list(ctx, query, tenantID)
+}
+
+// ListActive retrieves the active schedules of all tenants.
+func (r *ReportScheduleRepo) ListActive(ctx context.Context) ([]model.ReportSchedule, error) {
+	query := `SELECT ` + reportScheduleColumns + `
+		FROM report_schedules
+		WHERE active
+		ORDER BY tenant_id, report_type
+	`
+	return r.list(ctx, query)
+}
+
+func (r *ReportScheduleRepo) list(ctx context.Context, query string, args ...any) ([]model.ReportSchedule, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query report schedules: %w", err)
+	}
+	defer rows.Close()
+
+	var schedules []model.ReportSchedule
+	for rows.Next() {
+		schedule, err := scanReportSchedule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan report schedule row: %w", err)
+		}
+		schedules = append(schedules, schedule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return schedules, nil
+}
+
+func scanReportSchedule(row pgx.Row) (model.ReportSchedule, error) {
+	var (
+		id            uuid.UUID
+		tenantID      uuid.UUID
+		reportTypeStr string
+		frequencyStr  string
+		deadlineDays  int
+		leadDays      int
+		active        bool
+		version       int
+		createdAt     time.Time
+		updatedAt     time.Time
+	)
+
+	err := row.Scan(
+		&id, &tenantID, &reportTypeStr, &frequencyStr, &deadlineDays, &leadDays,
+		&active, &version, &createdAt, &updatedAt,
+	)
+	if err != nil {
+		return model.ReportSchedule{}, err
+	}
+
+	reportType, err := valueobject.NewReportType(reportTypeStr)
+	if err != nil {
+		return model.ReportSchedule{}, fmt.Errorf("invalid report type in database: %w", err)
+	}
+
+	frequency, err := valueobject.NewReportingFrequency(frequencyStr)
+	if err != nil {
+		return model.ReportSchedule{}, fmt.Errorf("invalid frequency in database: %w", err)
+	}
+
+	return model.ReconstructReportSchedule(
+		id, tenantID, reportType, frequency, deadlineDays, leadDays,
+		active, version, createdAt, updatedAt,
+	), nil
+}
@@ -30,19 +30,32 @@ func (r *ReportSubmissionRepo) Save(ctx context.Context, submission model.Report
 	if err != nil {
 		return fmt.Errorf("failed to marshal validation errors: %w", err)
 	}
+	linkedCasesJSON, err := json.Marshal(submission.LinkedCaseIDs())
+	if err != nil {
+		return fmt.Errorf("failed to marshal linked case IDs: %w", err)
+	}
 
 	query := `
 		INSERT INTO report_submissions (
 			id, tenant_id, report_type, reporting_period, status,
 			xbrl_content, generated_at, submitted_at, validation_errors,
-			version, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+			submission_channel, submission_reference, submission_attempts,
+			revision, original_id, amends_id, amendment_reason, amendment_note,
+			linked_case_ids, prepared_by, approved_by, approved_at, version, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24)
 		ON CONFLICT (id) DO UPDATE SET
 			status = EXCLUDED.status,
 			xbrl_content = EXCLUDED.xbrl_content,
 			generated_at = EXCLUDED.generated_at,
 			submitted_at = EXCLUDED.submitted_at,
 			validation_errors = EXCLUDED.validation_errors,
+			submission_channel = EXCLUDED.submission_channel,
+			submission_reference = EXCLUDED.submission_reference,
+			submission_attempts = EXCLUDED.submission_attempts,
+			linked_case_ids = EXCLUDED.linked_case_ids,
+			prepared_by = EXCLUDED.prepared_by,
+			approved_by = EXCLUDED.approved_by,
+			approved_at = EXCLUDED.approved_at,
 			version = EXCLUDED.version,
 			updated_at = EXCLUDED.updated_at
 	`
@@ -57,6 +70,18 @@ func (r *ReportSubmissionRepo) Save(ctx context.Context, submission model.Report
 		submission.GeneratedAt(),
 		submission.SubmittedAt(),
 		validationErrorsJSON,
+		submission.Channel().String(),
+		submission.Reference(),
+		submission.Attempts(),
+		submission.Revision(),
+		submission.OriginalID(),
+		submission.AmendsID(),
+		submission.AmendmentReason().String(),
+		submission.AmendmentNote(),
+		linkedCasesJSON,
+		submission.PreparedBy(),
+		submission.ApprovedBy(),
+		submission.ApprovedAt(),
 		submission.Version(),
 		submission.CreatedAt(),
 		submission.UpdatedAt(),
@@ -73,7 +98,9 @@ func (r *ReportSubmissionRepo) FindByID(ctx context.Context, id uuid.UUID) (mode
 	query := `
 		SELECT id, tenant_id, report_type, reporting_period, status,
 			xbrl_content, generated_at, submitted_at, validation_errors,
-			version, created_at, updated_at
+			submission_channel, submission_reference, submission_attempts,
+			revision, original_id, amends_id, amendment_reason, amendment_note,
+			linked_case_ids, prepared_by, approved_by, approved_at, version, created_at, updated_at
 		FROM report_submissions
 		WHERE id = $1
 	`
@@ -87,7 +114,9 @@ func (r *ReportSubmissionRepo) FindByTenantAndPeriod(ctx context.Context, tenant
 	query := `
 		SELECT id, tenant_id, report_type, reporting_period, status,
 			xbrl_content, generated_at, submitted_at, validation_errors,
-			version, created_at, updated_at
+			submission_channel, submission_reference, submission_attempts,
+			revision, original_id, amends_id, amendment_reason, amendment_note,
+			linked_case_ids, prepared_by, approved_by, approved_at, version, created_at, updated_at
 		FROM report_submissions
 		WHERE tenant_id = $1 AND reporting_period = $2
 		ORDER BY created_at DESC
@@ -107,7 +136,9 @@ func (r *ReportSubmissionRepo) FindByTenantAndType(ctx context.Context, tenantID
 	query := `
 		SELECT id, tenant_id, report_type, reporting_period, status,
 			xbrl_content, generated_at, submitted_at, validation_errors,
-			version, created_at, updated_at
+			submission_channel, submission_reference, submission_attempts,
+			revision, original_id, amends_id, amendment_reason, amendment_note,
+			linked_case_ids, prepared_by, approved_by, approved_at, version, created_at, updated_at
 		FROM report_submissions
 		WHERE tenant_id = $1 AND report_type = $2
 		ORDER BY created_at DESC
@@ -122,6 +153,52 @@ func (r *ReportSubmissionRepo) FindByTenantAndType(ctx context.Context, tenantID
 	return scanReportSubmissions(rows)
 }
 
+// ListAwaitingAcknowledgment retrieves the submissions filed over a channel
+// that the regulator has not yet acknowledged.
+func (r *ReportSubmissionRepo) ListAwaitingAcknowledgment(ctx context.Context, channel string) ([]model.ReportSubmission, error) {
+	query := `
+		SELECT id, tenant_id, report_type, reporting_period, status,
+			xbrl_content, generated_at, submitted_at, validation_errors,
+			submission_channel, submission_reference, submission_attempts,
+			revision, original_id, amends_id, amendment_reason, amendment_note,
+			linked_case_ids, prepared_by, approved_by, approved_at, version, created_at, updated_at
+		FROM report_submissions
+		WHERE status = 'SUBMITTED' AND submission_channel = $1
+		ORDER BY submitted_at
+	`
+
+	rows, err := r.pool.Query(ctx, query, channel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query report submissions: %w", err)
+	}
+	defer rows.Close()
+
+	return scanReportSubmissions(rows)
+}
+
+// FindVersions retrieves every version of the report first filed as
+// originalID, ordered by revision.
+func (r *ReportSubmissionRepo) FindVersions(ctx context.Context, originalID uuid.UUID) ([]model.ReportSubmission, error) {
+	query := `
+		SELECT id, tenant_id, report_type, reporting_period, status,
+			xbrl_content, generated_at, submitted_at, validation_errors,
+			submission_channel, submission_reference, submission_attempts,
+			revision, original_id, amends_id, amendment_reason, amendment_note,
+			linked_case_ids, prepared_by, approved_by, approved_at, version, created_at, updated_at
+		FROM report_submissions
+		WHERE original_id = $1
+		ORDER BY revision
+	`
+
+	rows, err := r.pool.Query(ctx, query, originalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query report versions: %w", err)
+	}
+	defer rows.Close()
+
+	return scanReportSubmissions(rows)
+}
+
 func scanReportSubmission(row pgx.Row) (model.ReportSubmission, error) {
 	var (
 		id              uuid.UUID
@@ -133,6 +210,18 @@ func scanReportSubmission(row pgx.Row) (model.ReportSubmission, error) {
 		generatedAt     *time.Time
 		submittedAt     *time.Time
 		validationJSON  []byte
+		channelStr      string
+		reference       string
+		attempts        int
+		revision        int
+		originalID      uuid.UUID
+		amendsID        *uuid.UUID
+		reasonStr       string
+		amendmentNote   string
+		linkedCasesJSON []byte
+		preparedBy      string
+		approvedBy      string
+		approvedAt      *time.Time
 		version         int
 		createdAt       time.Time
 		updatedAt       time.Time
@@ -141,7 +230,9 @@ func scanReportSubmission(row pgx.Row) (model.ReportSubmission, error) {
 	err := row.Scan(
 		&id, &tenantID, &reportTypeStr, &reportingPeriod, &statusStr,
 		&xbrlContent, &generatedAt, &submittedAt, &validationJSON,
-		&version, &createdAt, &updatedAt,
+		&channelStr, &reference, &attempts,
+		&revision, &originalID, &amendsID, &reasonStr, &amendmentNote,
+		&linkedCasesJSON, &preparedBy, &approvedBy, &approvedAt, &version, &createdAt, &updatedAt,
 	)
 	if err != nil {
 		return model.ReportSubmission{}, fmt.Errorf("failed to scan report submission: %w", err)
@@ -162,10 +253,33 @@ func scanReportSubmission(row pgx.Row) (model.ReportSubmission, error) {
 		return model.ReportSubmission{}, fmt.Errorf("failed to unmarshal validation errors: %w", err)
 	}
 
+	var linkedCaseIDs []string
+	if err := json.Unmarshal(linkedCasesJSON, &linkedCaseIDs); err != nil {
+		return model.ReportSubmission{}, fmt.Errorf("failed to unmarshal linked case IDs: %w", err)
+	}
+
+	var channel valueobject.SubmissionChannel
+	if channelStr != "" {
+		channel, err = valueobject.NewSubmissionChannel(channelStr)
+		if err != nil {
+			return model.ReportSubmission{}, fmt.Errorf("invalid submission channel in database: %w", err)
+		}
+	}
+
+	var reason valueobject.AmendmentReason
+	if reasonStr != "" {
+		reason, err = valueobject.NewAmendmentReason(reasonStr)
+		if err != nil {
+			return model.ReportSubmission{}, fmt.Errorf("invalid amendment reason in database: %w", err)
+		}
+	}
+
 	return model.Reconstruct(
 		id, tenantID, reportType, reportingPeriod, status,
 		xbrlContent, generatedAt, submittedAt, validationErrors,
-		version, createdAt, updatedAt,
+		channel, reference, attempts,
+		revision, originalID, amendsID, reason, amendmentNote,
+		linkedCaseIDs, preparedBy, approvedBy, approvedAt, version, createdAt, updatedAt,
 	), nil
 }
 
@@ -182,6 +296,18 @@ func scanReportSubmissions(rows pgx.Rows) ([]model.ReportSubmission, error) {
 			generatedAt     *time.Time
 			submittedAt     *time.Time
 			validationJSON  []byte
+			channelStr      string
+			reference       string
+			attempts        int
+			revision        int
+			originalID      uuid.UUID
+			amendsID        *uuid.UUID
+			reasonStr       string
+			amendmentNote   string
+			linkedCasesJSON []byte
+			preparedBy      string
+			approvedBy      string
+			approvedAt      *time.Time
 			version         int
 			createdAt       time.Time
 			updatedAt       time.Time
@@ -190,7 +316,9 @@ func scanReportSubmissions(rows pgx.Rows) ([]model.ReportSubmission, error) {
 		err := rows.Scan(
 			&id, &tenantID, &reportTypeStr, &reportingPeriod, &statusStr,
 			&xbrlContent, &generatedAt, &submittedAt, &validationJSON,
-			&version, &createdAt, &updatedAt,
+			&channelStr, &reference, &attempts,
+			&revision, &originalID, &amendsID, &reasonStr, &amendmentNote,
+			&linkedCasesJSON, &preparedBy, &approvedBy, &approvedAt, &version, &createdAt, &updatedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan report submission row: %w", err)
@@ -211,10 +339,33 @@ func scanReportSubmissions(rows pgx.Rows) ([]model.ReportSubmission, error) {
 			return nil, fmt.Errorf("failed to unmarshal validation errors: %w", err)
 		}
 
+		var linkedCaseIDs []string
+		if err := json.Unmarshal(linkedCasesJSON, &linkedCaseIDs); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal linked case IDs: %w", err)
+		}
+
+		var channel valueobject.SubmissionChannel
+		if channelStr != "" {
+			channel, err = valueobject.NewSubmissionChannel(channelStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid submission channel in database: %w", err)
+			}
+		}
+
+		var reason valueobject.AmendmentReason
+		if reasonStr != "" {
+			reason, err = valueobject.NewAmendmentReason(reasonStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid amendment reason in database: %w", err)
+			}
+		}
+
 		submission := model.Reconstruct(
 			id, tenantID, reportType, reportingPeriod, status,
 			xbrlContent, generatedAt, submittedAt, validationErrors,
-			version, createdAt, updatedAt,
+			channel, reference, attempts,
+			revision, originalID, amendsID, reason, amendmentNote,
+			linkedCaseIDs, preparedBy, approvedBy, approvedAt, version, createdAt, updatedAt,
 		)
 		submissions = append(submissions, submission)
 	}
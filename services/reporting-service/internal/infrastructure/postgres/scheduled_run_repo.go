@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+const scheduledRunColumns = `
+	id, schedule_id, tenant_id, report_type, reporting_period, deadline,
+	status, report_id, attempts, failure_reason, submitted_at,
+	version, created_at, updated_at`
+
+// ScheduledRunRepo is the PostgreSQL implementation of ScheduledRunRepository.
+type ScheduledRunRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewScheduledRunRepo creates a new ScheduledRunRepo.
+func NewScheduledRunRepo(pool *pgxpool.Pool) *ScheduledRunRepo {
+	return &ScheduledRunRepo{pool: pool}
+}
+
+// Save persists a scheduled run. It uses upsert to handle both create and update.
+func (r *ScheduledRunRepo) Save(ctx context.Context, run model.ScheduledRun) error {
+	query := `
+		INSERT INTO scheduled_runs (` + scheduledRunColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			report_id = EXCLUDED.report_id,
+			attempts = EXCLUDED.attempts,
+			failure_reason = EXCLUDED.failure_reason,
+			submitted_at = EXCLUDED.submitted_at,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		run.ID(),
+		run.ScheduleID(),
+		run.TenantID(),
+		run.ReportType().String(),
+		run.ReportingPeriod(),
+		run.Deadline(),
+		run.Status().String(),
+		run.ReportID(),
+		run.Attempts(),
+		run.FailureReason(),
+		run.SubmittedAt(),
+		run.Version(),
+		run.CreatedAt(),
+		run.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save scheduled run: %w", err)
+	}
+
+	return nil
+}
+
+// FindBySchedulePeriod retrieves the run of a schedule for a period.
+func (r *ScheduledRunRepo) FindBySchedulePeriod(ctx context.Context, scheduleID uuid.UUID, period string) (model.ScheduledRun, bool, error) {
+	query := `SELECT ` + scheduledRunColumns + `
+		FROM scheduled_runs
+		WHERE schedule_id = $1 AND reporting_period = $2
+	`
+
+	run, err := scanScheduledRun(r.pool.QueryRow(ctx, query, scheduleID, period))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.ScheduledRun{}, false, nil
+	}
+	if err != nil {
+		return model.ScheduledRun{}, false, fmt.Errorf("failed to scan scheduled run: %w", err)
+	}
+	return run, true, nil
+}
+
+// ListByTenant retrieves a tenant's runs with deadlines from since onwards.
+func (r *ScheduledRunRepo) ListByTenant(ctx context.Context, tenantID uuid.UUID, since time.Time) ([]model.ScheduledRun, error) {
+	query := `SELECT ` + scheduledRunColumns + `
+		FROM scheduled_runs
+		WHERE tenant_id = $1 AND deadline >= $2
+		ORDER BY deadline, report_type
+	`
+	return r.list(ctx, query, tenantID, since)
+}
+
+// ListOpen retrieves runs that still need attention.
+func (r *ScheduledRunRepo) ListOpen(ctx context.Context) ([]model.ScheduledRun, error) {
+	query := `SELECT ` + scheduledRunColumns + `
+		FROM scheduled_runs
+		WHERE status IN ('PENDING', 'FAILED', 'GENERATED')
+		   OR (status = 'MISSED' AND report_id IS NOT NULL)
+		ORDER BY deadline
+	`
+	return r.list(ctx, query)
+}
+
+func (r *ScheduledRunRepo) list(ctx context.Context, query string, args ...any) ([]model.ScheduledRun, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scheduled runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []model.ScheduledRun
+	for rows.Next() {
+		run, err := scanScheduledRun(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan scheduled run row: %w", err)
+		}
+		runs = append(runs, run)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return runs, nil
+}
+
+func scanScheduledRun(row pgx.Row) (model.ScheduledRun, error) {
+	var (
+		id              uuid.UUID
+		scheduleID      uuid.UUID
+		tenantID        uuid.UUID
+		reportTypeStr   string
+		reportingPeriod string
+		deadline        time.Time
+		statusStr       string
+		reportID        *uuid.UUID
+		attempts        int
+		failureReason   string
+		submittedAt     *time.Time
+		version         int
+		createdAt       time.Time
+		updatedAt       time.Time
+	)
+
+	err := row.Scan(
+		&id, &scheduleID, &tenantID, &reportTypeStr, &reportingPeriod, &deadline,
+		&statusStr, &reportID, &attempts, &failureReason, &submittedAt,
+		&version, &createdAt, &updatedAt,
+	)
+	if err != nil {
+		return model.ScheduledRun{}, err
+	}
+
+	reportType, err := valueobject.NewReportType(reportTypeStr)
+	if err != nil {
+		return model.ScheduledRun{}, fmt.Errorf("invalid report type in database: %w", err)
+	}
+
+	status, err := valueobject.NewRunStatus(statusStr)
+	if err != nil {
+		return model.ScheduledRun{}, fmt.Errorf("invalid run status in database: %w", err)
+	}
+
+	return model.ReconstructScheduledRun(
+		id, scheduleID, tenantID, reportType, reportingPeriod, deadline,
+		status, reportID, attempts, failureReason, submittedAt,
+		version, createdAt, updatedAt,
+	), nil
+}
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+// SubmissionAuditRepo is the PostgreSQL implementation of SubmissionAuditRepository.
+type SubmissionAuditRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewSubmissionAuditRepo creates a new SubmissionAuditRepo.
+func NewSubmissionAuditRepo(pool *pgxpool.Pool) *SubmissionAuditRepo {
+	return &SubmissionAuditRepo{pool: pool}
+}
+
+// Append records an audit entry. Entries are never updated.
+func (r *SubmissionAuditRepo) Append(ctx context.Context, entry model.SubmissionAuditEntry) error {
+	query := `
+		INSERT INTO submission_audit (
+			id, submission_id, tenant_id, channel, attempt, action,
+			reference, detail, actor, content_sha256, occurred_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		entry.ID(),
+		entry.SubmissionID(),
+		entry.TenantID(),
+		entry.Channel().String(),
+		entry.Attempt(),
+		entry.Action(),
+		entry.Reference(),
+		entry.Detail(),
+		entry.Actor(),
+		entry.ContentSHA256(),
+		entry.OccurredAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to append submission audit entry: %w", err)
+	}
+
+	return nil
+}
+
+// ListBySubmission retrieves a submission's audit entries, oldest first.
+func (r *SubmissionAuditRepo) ListBySubmission(ctx context.Context, submissionID uuid.UUID) ([]model.SubmissionAuditEntry, error) {
+	query := `
+		SELECT id, submission_id, tenant_id, channel, attempt, action,
+			reference, detail, actor, content_sha256, occurred_at
+		FROM submission_audit
+		WHERE submission_id = $1
+		ORDER BY occurred_at, attempt
+	`
+
+	rows, err := r.pool.Query(ctx, query, submissionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query submission audit: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []model.SubmissionAuditEntry
+	for rows.Next() {
+		var (
+			id            uuid.UUID
+			subID         uuid.UUID
+			tenantID      uuid.UUID
+			channelStr    string
+			attempt       int
+			action        string
+			reference     string
+			detail        string
+			actor         string
+			contentSHA256 string
+			occurredAt    time.Time
+		)
+		if err := rows.Scan(&id, &subID, &tenantID, &channelStr, &attempt, &action,
+			&reference, &detail, &actor, &contentSHA256, &occurredAt); err != nil {
+			return nil, fmt.Errorf("failed to scan submission audit row: %w", err)
+		}
+
+		channel, err := valueobject.NewSubmissionChannel(channelStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid submission channel in database: %w", err)
+		}
+
+		entries = append(entries, model.ReconstructSubmissionAuditEntry(
+			id, subID, tenantID, channel, attempt, action,
+			reference, detail, actor, contentSHA256, occurredAt,
+		))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// WarehouseRepo is the PostgreSQL implementation of WarehouseRepository.
+type WarehouseRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewWarehouseRepo creates a new WarehouseRepo.
+func NewWarehouseRepo(pool *pgxpool.Pool) *WarehouseRepo {
+	return &WarehouseRepo{pool: pool}
+}
+
+// FindSchema retrieves the schema a dataset was last exported with.
+func (r *WarehouseRepo) FindSchema(ctx context.Context, dataset string) (service.WarehouseSchema, error) {
+	query := `SELECT dataset, version, columns, updated_at FROM warehouse_schemas WHERE dataset = $1`
+
+	var (
+		schema      service.WarehouseSchema
+		columnsJSON []byte
+	)
+	err := r.pool.QueryRow(ctx, query, dataset).Scan(&schema.Dataset, &schema.Version, &columnsJSON, &schema.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.WarehouseSchema{}, port.ErrSchemaNotFound
+	}
+	if err != nil {
+		return service.WarehouseSchema{}, fmt.Errorf("failed to scan warehouse schema: %w", err)
+	}
+	if err := json.Unmarshal(columnsJSON, &schema.Columns); err != nil {
+		return service.WarehouseSchema{}, fmt.Errorf("failed to unmarshal warehouse columns: %w", err)
+	}
+	return schema, nil
+}
+
+// SaveSchema upserts a dataset's schema.
+func (r *WarehouseRepo) SaveSchema(ctx context.Context, schema service.WarehouseSchema) error {
+	columnsJSON, err := json.Marshal(schema.Columns)
+	if err != nil {
+		return fmt.Errorf("failed to marshal warehouse columns: %w", err)
+	}
+
+	query := `
+		INSERT INTO warehouse_schemas (dataset, version, columns, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (dataset) DO UPDATE SET
+			version = EXCLUDED.version,
+			columns = EXCLUDED.columns,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	if _, err := r.pool.Exec(ctx, query, schema.Dataset, schema.Version, columnsJSON, schema.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to save warehouse schema: %w", err)
+	}
+	return nil
+}
+
+// HasExport reports whether a tenant's partition of a dataset has been landed for a day.
+func (r *WarehouseRepo) HasExport(ctx context.Context, dataset string, tenantID uuid.UUID, day time.Time) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM warehouse_exports
+			WHERE dataset = $1 AND tenant_id = $2 AND day = $3
+		)
+	`
+
+	var exists bool
+	if err := r.pool.QueryRow(ctx, query, dataset, tenantID, day).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check warehouse export: %w", err)
+	}
+	return exists, nil
+}
+
+// SaveExport records a landed partition. A re-export of the same day
+// replaces the record.
+func (r *WarehouseRepo) SaveExport(ctx context.Context, e service.WarehouseExport) error {
+	query := `
+		INSERT INTO warehouse_exports (
+			dataset, tenant_id, day, schema_version, object_key,
+			content_sha256, rows, exported_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (dataset, tenant_id, day) DO UPDATE SET
+			schema_version = EXCLUDED.schema_version,
+			object_key = EXCLUDED.object_key,
+			content_sha256 = EXCLUDED.content_sha256,
+			rows = EXCLUDED.rows,
+			exported_at = EXCLUDED.exported_at
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		e.Dataset, e.TenantID, e.Day, e.SchemaVersion, e.ObjectKey,
+		e.ContentSHA256, e.Rows, e.ExportedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save warehouse export: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package submission
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+// maxErrorBody bounds how much of an error response is kept for the audit trail.
+const maxErrorBody = 1 << 10
+
+// APIConfig configures the regulator API gateway.
+type APIConfig struct {
+	// BaseURL is the regulator's (EBA/ECB) submission API root.
+	BaseURL string
+	// Token is the bearer token issued to the bank as a reporting entity.
+	Token string
+}
+
+// APIGateway files reports through the regulator's submission API:
+// POST {base}/submissions with the XBRL instance as the body, and
+// GET {base}/submissions/{reference} for its acknowledgment status.
+type APIGateway struct {
+	client *http.Client
+	cfg    APIConfig
+}
+
+// NewAPIGateway creates a new APIGateway.
+func NewAPIGateway(cfg APIConfig, client *http.Client) *APIGateway {
+	cfg.BaseURL = strings.TrimSuffix(cfg.BaseURL, "/")
+	return &APIGateway{cfg: cfg, client: client}
+}
+
+// Channel returns the API channel.
+func (g *APIGateway) Channel() valueobject.SubmissionChannel {
+	return valueobject.SubmissionChannelAPI
+}
+
+type apiSubmitResponse struct {
+	Reference string `json:"reference"`
+}
+
+type apiStatusResponse struct {
+	Status         string   `json:"status"`
+	Errors         []string `json:"errors"`
+	AcknowledgedAt string   `json:"acknowledged_at"`
+}
+
+// Transmit posts the filing. The idempotency key makes a retried transmission
+// of the same attempt safe.
+func (g *APIGateway) Transmit(ctx context.Context, pkg port.SubmissionPackage) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, g.cfg.BaseURL+"/submissions", bytes.NewReader(pkg.Content))
+	if err != nil {
+		return "", fmt.Errorf("failed to build submission request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("Idempotency-Key", fmt.Sprintf("%s-%d", pkg.SubmissionID, pkg.Attempt))
+	req.Header.Set("X-Entity-ID", pkg.TenantID.String())
+	req.Header.Set("X-Report-Type", pkg.ReportType)
+	req.Header.Set("X-Reporting-Period", pkg.ReportingPeriod)
+	req.Header.Set("X-File-Name", pkg.FileName)
+
+	var resp apiSubmitResponse
+	if err := g.do(req, &resp); err != nil {
+		return "", err
+	}
+	if resp.Reference == "" {
+		return "", fmt.Errorf("regulator API returned no submission reference")
+	}
+	return resp.Reference, nil
+}
+
+// PollAcknowledgments fetches the status of each reference and returns the
+// ones the regulator has accepted or rejected.
+func (g *APIGateway) PollAcknowledgments(ctx context.Context, references []string) ([]port.Acknowledgment, error) {
+	var (
+		acks []port.Acknowledgment
+		errs []error
+	)
+	for _, ref := range references {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.cfg.BaseURL+"/submissions/"+url.PathEscape(ref), nil)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to build status request: %w", err))
+			continue
+		}
+
+		var resp apiStatusResponse
+		if err := g.do(req, &resp); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ref, err))
+			continue
+		}
+
+		var accepted bool
+		switch resp.Status {
+		case "ACCEPTED":
+			accepted = true
+		case "REJECTED":
+		default:
+			continue // not yet acknowledged
+		}
+
+		receivedAt := time.Now().UTC()
+		if t, err := time.Parse(time.RFC3339, resp.AcknowledgedAt); err == nil {
+			receivedAt = t.UTC()
+		}
+		acks = append(acks, port.Acknowledgment{
+			Reference:  ref,
+			Accepted:   accepted,
+			Errors:     resp.Errors,
+			ReceivedAt: receivedAt,
+		})
+	}
+	return acks, errors.Join(errs...)
+}
+
+func (g *APIGateway) do(req *http.Request, out any) error {
+	req.Header.Set("Accept", "application/json")
+	if g.cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+g.cfg.Token)
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("regulator API request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }() //nolint:errcheck // response already consumed
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody)) //nolint:errcheck // best-effort error detail
+		return fmt.Errorf("regulator API returned %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode regulator API response: %w", err)
+	}
+	return nil
+}
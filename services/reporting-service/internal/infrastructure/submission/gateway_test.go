@@ -0,0 +1,105 @@
+package submission_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/infrastructure/submission"
+)
+
+func testPackage() port.SubmissionPackage {
+	return port.SubmissionPackage{
+		SubmissionID:    uuid.New(),
+		TenantID:        uuid.New(),
+		ReportType:      "COREP",
+		ReportingPeriod: "2025-Q1",
+		FileName:        "corep-2025-Q1-1.xbrl",
+		Content:         []byte("<xbrli:xbrl/>"),
+		Attempt:         1,
+	}
+}
+
+func TestSFTPDropGateway(t *testing.T) {
+	outbound, inbound := t.TempDir(), t.TempDir()
+	gw := submission.NewSFTPDropGateway(submission.SFTPDropConfig{OutboundDir: outbound, InboundDir: inbound})
+	ctx := context.Background()
+
+	ref, err := gw.Transmit(ctx, testPackage())
+	require.NoError(t, err)
+	assert.Equal(t, "corep-2025-Q1-1", ref)
+
+	written, err := os.ReadFile(filepath.Join(outbound, "corep-2025-Q1-1.xbrl"))
+	require.NoError(t, err)
+	assert.Equal(t, "<xbrli:xbrl/>", string(written))
+	entries, err := os.ReadDir(outbound)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "no partial file is left behind")
+
+	acks, err := gw.PollAcknowledgments(ctx, []string{ref, "finrep-2025-03-1"})
+	require.NoError(t, err)
+	assert.Empty(t, acks)
+
+	require.NoError(t, os.WriteFile(filepath.Join(inbound, ref+".nack"), []byte("v1234: CET1 missing\n\nv5678: bad period\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(inbound, "finrep-2025-03-1.ack"), nil, 0o600))
+
+	acks, err = gw.PollAcknowledgments(ctx, []string{ref, "finrep-2025-03-1"})
+	require.NoError(t, err)
+	require.Len(t, acks, 2)
+	assert.False(t, acks[0].Accepted)
+	assert.Equal(t, []string{"v1234: CET1 missing", "v5678: bad period"}, acks[0].Errors)
+	assert.True(t, acks[1].Accepted)
+	assert.Equal(t, "finrep-2025-03-1", acks[1].Reference)
+}
+
+func TestAPIGateway(t *testing.T) {
+	pkg := testPackage()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/submissions":
+			assert.Equal(t, pkg.SubmissionID.String()+"-1", r.Header.Get("Idempotency-Key"))
+			assert.Equal(t, "COREP", r.Header.Get("X-Report-Type"))
+			body, _ := io.ReadAll(r.Body)
+			assert.Equal(t, "<xbrli:xbrl/>", string(body))
+			_ = json.NewEncoder(w).Encode(map[string]string{"reference": "EBA-42"})
+		case r.URL.Path == "/v1/submissions/EBA-42":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"status":          "REJECTED",
+				"errors":          []string{"v1234: CET1 missing"},
+				"acknowledged_at": "2025-05-02T10:00:00Z",
+			})
+		case r.URL.Path == "/v1/submissions/EBA-43":
+			_ = json.NewEncoder(w).Encode(map[string]string{"status": "RECEIVED"})
+		default:
+			http.Error(w, "unknown submission", http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	gw := submission.NewAPIGateway(submission.APIConfig{BaseURL: srv.URL + "/v1/", Token: "secret"}, srv.Client())
+	ctx := context.Background()
+
+	ref, err := gw.Transmit(ctx, pkg)
+	require.NoError(t, err)
+	assert.Equal(t, "EBA-42", ref)
+
+	acks, err := gw.PollAcknowledgments(ctx, []string{"EBA-42", "EBA-43", "EBA-44"})
+	require.Error(t, err, "unknown reference is reported")
+	assert.Contains(t, err.Error(), "EBA-44")
+	require.Len(t, acks, 1)
+	assert.False(t, acks[0].Accepted)
+	assert.Equal(t, []string{"v1234: CET1 missing"}, acks[0].Errors)
+	assert.Equal(t, "2025-05-02T10:00:00Z", acks[0].ReceivedAt.Format(time.RFC3339))
+}
@@ -0,0 +1,34 @@
+package submission
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+// ManualGateway is the manual-download submission mode: nothing is sent.
+// An operator downloads the filing, uploads it to the regulator's portal and
+// records the acknowledgment through the RecordSubmissionAcknowledgment RPC.
+type ManualGateway struct{}
+
+// NewManualGateway creates a new ManualGateway.
+func NewManualGateway() *ManualGateway {
+	return &ManualGateway{}
+}
+
+// Channel returns the MANUAL channel.
+func (g *ManualGateway) Channel() valueobject.SubmissionChannel {
+	return valueobject.SubmissionChannelManual
+}
+
+// Transmit hands the filing over for manual upload and returns its reference.
+func (g *ManualGateway) Transmit(_ context.Context, pkg port.SubmissionPackage) (string, error) {
+	return fmt.Sprintf("MANUAL-%s-%d", pkg.SubmissionID, pkg.Attempt), nil
+}
+
+// PollAcknowledgments returns none; manual acknowledgments are recorded by an operator.
+func (g *ManualGateway) PollAcknowledgments(_ context.Context, _ []string) ([]port.Acknowledgment, error) {
+	return nil, nil
+}
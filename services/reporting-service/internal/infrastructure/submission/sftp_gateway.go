@@ -0,0 +1,111 @@
+package submission
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+// SFTPDropConfig configures the SFTP drop gateway. The directories are the
+// local side of the regulator's SFTP exchange, kept in sync with the remote
+// inbox and outbox by the bank's managed file transfer agent.
+type SFTPDropConfig struct {
+	// OutboundDir receives filings for upload.
+	OutboundDir string
+	// InboundDir holds the regulator's acknowledgment files: <reference>.ack
+	// for an ACK and <reference>.nack, one error per line, for a NACK.
+	InboundDir string
+}
+
+// SFTPDropGateway files reports by dropping them on the SFTP exchange.
+type SFTPDropGateway struct {
+	cfg SFTPDropConfig
+}
+
+// NewSFTPDropGateway creates a new SFTPDropGateway.
+func NewSFTPDropGateway(cfg SFTPDropConfig) *SFTPDropGateway {
+	return &SFTPDropGateway{cfg: cfg}
+}
+
+// Channel returns the SFTP channel.
+func (g *SFTPDropGateway) Channel() valueobject.SubmissionChannel {
+	return valueobject.SubmissionChannelSFTP
+}
+
+// Transmit writes the filing to the outbound directory. The file is written
+// under a temporary name and renamed so the transfer agent never picks up a
+// partial file. The reference is the file name without its extension.
+func (g *SFTPDropGateway) Transmit(_ context.Context, pkg port.SubmissionPackage) (string, error) {
+	name := filepath.Base(pkg.FileName)
+	final := filepath.Join(g.cfg.OutboundDir, name)
+	tmp := filepath.Join(g.cfg.OutboundDir, "."+name+".part")
+
+	if err := os.WriteFile(tmp, pkg.Content, 0o600); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, final); err != nil {
+		_ = os.Remove(tmp) //nolint:errcheck // best-effort cleanup of the partial file
+		return "", fmt.Errorf("failed to publish %s: %w", final, err)
+	}
+	return strings.TrimSuffix(name, filepath.Ext(name)), nil
+}
+
+// PollAcknowledgments reads the acknowledgment files for the given references.
+func (g *SFTPDropGateway) PollAcknowledgments(_ context.Context, references []string) ([]port.Acknowledgment, error) {
+	var (
+		acks []port.Acknowledgment
+		errs []error
+	)
+	for _, ref := range references {
+		ack, ok, err := g.readAcknowledgment(ref)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if ok {
+			acks = append(acks, ack)
+		}
+	}
+	return acks, errors.Join(errs...)
+}
+
+func (g *SFTPDropGateway) readAcknowledgment(reference string) (port.Acknowledgment, bool, error) {
+	for _, ext := range []string{".ack", ".nack"} {
+		path := filepath.Join(g.cfg.InboundDir, filepath.Base(reference)+ext)
+		info, err := os.Stat(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return port.Acknowledgment{}, false, fmt.Errorf("failed to stat %s: %w", path, err)
+		}
+		content, err := os.ReadFile(path) //nolint:gosec // path is built from the configured inbound directory
+		if err != nil {
+			return port.Acknowledgment{}, false, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		ack := port.Acknowledgment{
+			Reference:  reference,
+			Accepted:   ext == ".ack",
+			ReceivedAt: info.ModTime().UTC(),
+		}
+		if !ack.Accepted {
+			scanner := bufio.NewScanner(bytes.NewReader(content))
+			for scanner.Scan() {
+				if line := strings.TrimSpace(scanner.Text()); line != "" {
+					ack.Errors = append(ack.Errors, line)
+				}
+			}
+		}
+		return ack, true, nil
+	}
+	return port.Acknowledgment{}, false, nil
+}
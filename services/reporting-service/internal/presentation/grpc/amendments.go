@@ -0,0 +1,160 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	reportingv1 "github.com/bibbank/bib/api/gen/go/bib/reporting/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// AmendReport handles the amend report request.
+func (h *ReportingHandler) AmendReport(ctx context.Context, req *reportingv1.AmendReportRequest) (*reportingv1.AmendReportResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid report ID")
+	}
+
+	result, err := h.amendReport.Execute(ctx, dto.AmendReportRequest{
+		ID:     id,
+		Reason: enumName(req.GetReason().String(), "AMENDMENT_REASON_"),
+		Note:   req.GetNote(),
+		Actor:  actorFromContext(ctx),
+	})
+	if errors.Is(err, usecase.ErrInvalidAmendment) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, usecase.ErrAmendmentNotAllowed) || errors.Is(err, service.ErrReportUnbalanced) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &reportingv1.AmendReportResponse{
+		Submission: &reportingv1.ReportSubmission{
+			Id:              result.ID.String(),
+			OriginalId:      result.OriginalID.String(),
+			AmendsId:        result.AmendsID.String(),
+			Revision:        int32(result.Revision), //nolint:gosec // bounded by amendments
+			Status:          submissionStatus(result.Status),
+			AmendmentReason: amendmentReason(result.Reason),
+			AmendmentNote:   result.Note,
+		},
+		Changes: toFactChangeMsgs(result.Changes),
+	}, nil
+}
+
+// ListReportVersions handles the list report versions request.
+func (h *ReportingHandler) ListReportVersions(ctx context.Context, req *reportingv1.ListReportVersionsRequest) (*reportingv1.ListReportVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid report ID")
+	}
+
+	result, err := h.listVersions.Execute(ctx, id)
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &reportingv1.ListReportVersionsResponse{Versions: make([]*reportingv1.ReportSubmission, 0, len(result))}
+	for _, v := range result {
+		msg := &reportingv1.ReportSubmission{
+			Id:              v.ID.String(),
+			Revision:        int32(v.Revision), //nolint:gosec // bounded by amendments
+			Status:          submissionStatus(v.Status),
+			AmendmentReason: amendmentReason(v.AmendmentReason),
+			AmendmentNote:   v.AmendmentNote,
+			GeneratedAt:     optionalTimestamp(v.GeneratedAt),
+			SubmittedAt:     optionalTimestamp(v.SubmittedAt),
+			Audit:           &commonv1.AuditInfo{CreatedAt: timestamppb.New(v.CreatedAt)},
+		}
+		if v.AmendsID != nil {
+			msg.AmendsId = v.AmendsID.String()
+		}
+		resp.Versions = append(resp.Versions, msg)
+	}
+	return resp, nil
+}
+
+// DiffReportVersions handles the diff report versions request.
+func (h *ReportingHandler) DiffReportVersions(ctx context.Context, req *reportingv1.DiffReportVersionsRequest) (*reportingv1.DiffReportVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	toID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid report ID")
+	}
+	dtoReq := dto.DiffReportVersionsRequest{ToID: toID}
+	if req.GetFromId() != "" {
+		fromID, err := uuid.Parse(req.GetFromId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid from report ID")
+		}
+		dtoReq.FromID = &fromID
+	}
+
+	result, err := h.diffVersions.Execute(ctx, dtoReq)
+	if errors.Is(err, usecase.ErrInvalidVersionDiff) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &reportingv1.DiffReportVersionsResponse{
+		FromId:       result.FromID.String(),
+		ToId:         result.ToID.String(),
+		FromRevision: int32(result.FromRevision), //nolint:gosec // bounded by amendments
+		ToRevision:   int32(result.ToRevision),   //nolint:gosec // bounded by amendments
+		Changes:      toFactChangeMsgs(result.Changes),
+	}, nil
+}
+
+func toFactChangeMsgs(changes []dto.FactChangeResponse) []*reportingv1.FactChange {
+	msgs := make([]*reportingv1.FactChange, 0, len(changes))
+	for _, c := range changes {
+		msgs = append(msgs, &reportingv1.FactChange{
+			Concept: c.Concept,
+			Context: c.Context,
+			Unit:    c.Unit,
+			Change:  c.Change,
+			From:    c.From,
+			To:      c.To,
+			Delta:   c.Delta,
+		})
+	}
+	return msgs
+}
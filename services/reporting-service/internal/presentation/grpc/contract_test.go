@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	reportingv1 "github.com/bibbank/bib/api/gen/go/bib/reporting/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractJobRepo holds at most one report job.
+type contractJobRepo struct {
+	job *model.ReportJob
+}
+
+func (r *contractJobRepo) Save(context.Context, model.ReportJob) error { return nil }
+
+func (r *contractJobRepo) FindByID(context.Context, uuid.UUID, uuid.UUID) (model.ReportJob, error) {
+	if r.job == nil {
+		return model.ReportJob{}, port.ErrReportJobNotFound
+	}
+	return *r.job, nil
+}
+
+func (r *contractJobRepo) ListByTenant(context.Context, uuid.UUID, int) ([]model.ReportJob, error) {
+	return nil, nil
+}
+
+func (r *contractJobRepo) ClaimNext(context.Context, time.Time, time.Time) (model.ReportJob, bool, error) {
+	return model.ReportJob{}, false, nil
+}
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	jobs := &contractJobRepo{}
+	h := NewReportingHandler(
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		usecase.NewCreateReportJobUseCase(jobs), usecase.NewGetReportJobUseCase(jobs), nil, nil,
+		slog.Default(),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { reportingv1.RegisterReportingServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-reporting-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { jobs.job = nil },
+		"a report job is queued": func(t *testing.T) {
+			reportType, err := valueobject.NewReportType("COREP")
+			if err != nil {
+				t.Fatal(err)
+			}
+			job, err := model.NewReportJob(contractTenantID, reportType, "2026-Q1", "XBRL", "", time.Now().UTC())
+			if err != nil {
+				t.Fatal(err)
+			}
+			jobs.job = &job
+		},
+	})
+}
@@ -0,0 +1,233 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	reportingv1 "github.com/bibbank/bib/api/gen/go/bib/reporting/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+)
+
+// GetBalanceSheetSummary handles the get balance sheet summary request.
+func (h *ReportingHandler) GetBalanceSheetSummary(ctx context.Context, req *reportingv1.GetBalanceSheetSummaryRequest) (*reportingv1.GetBalanceSheetSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	tid, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.balanceSheet.Execute(ctx, tid)
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &reportingv1.GetBalanceSheetSummaryResponse{Lines: make([]*reportingv1.BalanceSheetLine, 0, len(result))}
+	for _, l := range result {
+		resp.Lines = append(resp.Lines, &reportingv1.BalanceSheetLine{
+			Currency:        l.Currency,
+			Loans:           l.Loans,
+			Deposits:        l.Deposits,
+			AccruedInterest: l.AccruedInterest,
+			LoanToDeposit:   l.LoanToDeposit,
+			LoanCount:       int32(l.LoanCount),    //nolint:gosec // bounded by the tenant's book
+			DepositCount:    int32(l.DepositCount), //nolint:gosec // bounded by the tenant's book
+		})
+	}
+	return resp, nil
+}
+
+// GetDepositGrowth handles the get deposit growth request.
+func (h *ReportingHandler) GetDepositGrowth(ctx context.Context, req *reportingv1.GetDepositGrowthRequest) (*reportingv1.GetDepositGrowthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	tid, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, err := parseDashboardRange(req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.depositGrowth.Execute(ctx, dto.DepositGrowthRequest{
+		TenantID:    tid,
+		From:        from,
+		To:          to,
+		Granularity: req.GetGranularity(),
+	})
+	if errors.Is(err, usecase.ErrInvalidRange) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &reportingv1.GetDepositGrowthResponse{Points: make([]*reportingv1.DepositGrowthPoint, 0, len(result))}
+	for _, p := range result {
+		resp.Points = append(resp.Points, &reportingv1.DepositGrowthPoint{
+			Period:         p.Period,
+			Currency:       p.Currency,
+			Opened:         p.Opened,
+			Closed:         p.Closed,
+			Net:            p.Net,
+			ClosingBalance: p.ClosingBalance,
+		})
+	}
+	return resp, nil
+}
+
+// GetLoanBook handles the get loan book request.
+func (h *ReportingHandler) GetLoanBook(ctx context.Context, req *reportingv1.GetLoanBookRequest) (*reportingv1.GetLoanBookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	tid, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.loanBook.Execute(ctx, tid)
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &reportingv1.GetLoanBookResponse{Buckets: make([]*reportingv1.LoanBookBucket, 0, len(result))}
+	for _, b := range result {
+		resp.Buckets = append(resp.Buckets, &reportingv1.LoanBookBucket{
+			Bucket:      b.Bucket,
+			Currency:    b.Currency,
+			Outstanding: b.Outstanding,
+			Count:       int32(b.Count), //nolint:gosec // bounded by the tenant's book
+		})
+	}
+	return resp, nil
+}
+
+// GetPaymentVolumes handles the get payment volumes request.
+func (h *ReportingHandler) GetPaymentVolumes(ctx context.Context, req *reportingv1.DashboardRangeRequest) (*reportingv1.GetPaymentVolumesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	tid, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, err := parseDashboardRange(req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.paymentVolumes.Execute(ctx, dto.DashboardRangeRequest{TenantID: tid, From: from, To: to})
+	if errors.Is(err, usecase.ErrInvalidRange) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &reportingv1.GetPaymentVolumesResponse{Rails: make([]*reportingv1.RailVolume, 0, len(result))}
+	for _, v := range result {
+		resp.Rails = append(resp.Rails, &reportingv1.RailVolume{
+			Rail:          v.Rail,
+			Currency:      v.Currency,
+			SettledAmount: v.SettledAmount,
+			Initiated:     int32(v.Initiated), //nolint:gosec // bounded by the range's payments
+			Settled:       int32(v.Settled),   //nolint:gosec // bounded by the range's payments
+			Failed:        int32(v.Failed),    //nolint:gosec // bounded by the range's payments
+			Reversed:      int32(v.Reversed),  //nolint:gosec // bounded by the range's payments
+		})
+	}
+	return resp, nil
+}
+
+// GetFraudDecisionRates handles the get fraud decision rates request.
+func (h *ReportingHandler) GetFraudDecisionRates(ctx context.Context, req *reportingv1.DashboardRangeRequest) (*reportingv1.GetFraudDecisionRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	tid, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, err := parseDashboardRange(req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.fraudDecisions.Execute(ctx, dto.DashboardRangeRequest{TenantID: tid, From: from, To: to})
+	if errors.Is(err, usecase.ErrInvalidRange) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &reportingv1.GetFraudDecisionRatesResponse{
+		Rates:       make([]*reportingv1.DecisionRate, 0, len(result.Rates)),
+		Assessments: int32(result.Assessments), //nolint:gosec // bounded by the range's assessments
+	}
+	for _, r := range result.Rates {
+		resp.Rates = append(resp.Rates, &reportingv1.DecisionRate{
+			Decision: r.Decision,
+			Rate:     r.Rate,
+			Count:    int32(r.Count), //nolint:gosec // bounded by the range's assessments
+		})
+	}
+	return resp, nil
+}
+
+// parseDashboardRange parses an inclusive range of YYYY-MM-DD dates into the
+// half-open range [from, to) the use cases take.
+func parseDashboardRange(from, to string) (time.Time, time.Time, error) {
+	start, err := time.Parse(time.DateOnly, from)
+	if err != nil {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "from must be a YYYY-MM-DD date")
+	}
+	end, err := time.Parse(time.DateOnly, to)
+	if err != nil {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "to must be a YYYY-MM-DD date")
+	}
+	return start, end.AddDate(0, 0, 1), nil
+}
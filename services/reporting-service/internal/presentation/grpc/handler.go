@@ -2,16 +2,23 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	reportingv1 "github.com/bibbank/bib/api/gen/go/bib/reporting/v1"
 	"github.com/bibbank/bib/pkg/auth"
 	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
 	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
 )
 
 // requireRole checks that the caller has at least one of the given roles.
@@ -37,61 +44,53 @@ func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
 	return claims.TenantID, nil
 }
 
-// ---------------------------------------------------------------------------
-// Request / Response types (stand-in for proto-generated messages)
-// ---------------------------------------------------------------------------
-
-// GenerateReportRequest represents the proto GenerateReportRequest message.
-type GenerateReportRequest struct {
-	TenantID   string `json:"tenant_id"`
-	ReportType string `json:"report_type"`
-	Period     string `json:"period"`
-}
-
-// GenerateReportResponse represents the proto GenerateReportResponse message.
-type GenerateReportResponse struct {
-	ReportID  string `json:"report_id"`
-	Status    string `json:"status"`
-	CreatedAt string `json:"created_at"`
-}
-
-// GetReportRequest represents the proto GetReportRequest message.
-type GetReportRequest struct {
-	ReportID string `json:"report_id"`
-}
-
-// GetReportResponse represents the proto GetReportResponse message.
-type GetReportResponse struct {
-	ReportID   string `json:"report_id"`
-	TenantID   string `json:"tenant_id"`
-	ReportType string `json:"report_type"`
-	Period     string `json:"period"`
-	Status     string `json:"status"`
-	CreatedAt  string `json:"created_at"`
-	UpdatedAt  string `json:"updated_at"`
-}
-
-// SubmitReportRequest represents the proto SubmitReportRequest message.
-type SubmitReportRequest struct {
-	ReportID string `json:"report_id"`
-}
-
-// SubmitReportResponse represents the proto SubmitReportResponse message.
-type SubmitReportResponse struct {
-	ReportID string `json:"report_id"`
-	Status   string `json:"status"`
+// actorFromContext returns the calling user recorded in the submission audit trail.
+func actorFromContext(ctx context.Context) string {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return ""
+	}
+	return claims.UserID.String()
 }
 
 // ---------------------------------------------------------------------------
 // ReportingHandler
 // ---------------------------------------------------------------------------
 
+// Compile-time assertion that ReportingHandler implements ReportingServiceServer.
+var _ reportingv1.ReportingServiceServer = (*ReportingHandler)(nil)
+
 // ReportingHandler handles gRPC requests for the reporting service.
 type ReportingHandler struct {
-	UnimplementedReportingServiceServer
+	reportingv1.UnimplementedReportingServiceServer
 	generateReport *usecase.GenerateReportUseCase
 	getReport      *usecase.GetReportUseCase
 	submitReport   *usecase.SubmitReportUseCase
+	upsertSchedule *usecase.UpsertReportScheduleUseCase
+	listSchedules  *usecase.ListReportSchedulesUseCase
+	listRuns       *usecase.ListScheduledRunsUseCase
+	recordAck      *usecase.RecordAcknowledgmentUseCase
+	listAudit      *usecase.ListSubmissionAuditUseCase
+	amendReport    *usecase.AmendReportUseCase
+	listVersions   *usecase.ListReportVersionsUseCase
+	diffVersions   *usecase.DiffReportVersionsUseCase
+
+	liquidityMetrics *usecase.GetLiquidityMetricsUseCase
+
+	balanceSheet   *usecase.GetBalanceSheetSummaryUseCase
+	depositGrowth  *usecase.GetDepositGrowthUseCase
+	loanBook       *usecase.GetLoanBookUseCase
+	paymentVolumes *usecase.GetPaymentVolumesUseCase
+	fraudDecisions *usecase.GetFraudDecisionRatesUseCase
+
+	reviewReport *usecase.ReviewReportUseCase
+	reviseReport *usecase.ReviseReportUseCase
+	listReviews  *usecase.ListReportReviewsUseCase
+
+	createJob   *usecase.CreateReportJobUseCase
+	getJob      *usecase.GetReportJobUseCase
+	listJobs    *usecase.ListReportJobsUseCase
+	getArtifact *usecase.GetReportJobArtifactUseCase
 
 	logger *slog.Logger
 }
@@ -101,18 +100,64 @@ func NewReportingHandler(
 	generateReport *usecase.GenerateReportUseCase,
 	getReport *usecase.GetReportUseCase,
 	submitReport *usecase.SubmitReportUseCase,
+	upsertSchedule *usecase.UpsertReportScheduleUseCase,
+	listSchedules *usecase.ListReportSchedulesUseCase,
+	listRuns *usecase.ListScheduledRunsUseCase,
+	recordAck *usecase.RecordAcknowledgmentUseCase,
+	listAudit *usecase.ListSubmissionAuditUseCase,
+	amendReport *usecase.AmendReportUseCase,
+	listVersions *usecase.ListReportVersionsUseCase,
+	diffVersions *usecase.DiffReportVersionsUseCase,
+	liquidityMetrics *usecase.GetLiquidityMetricsUseCase,
+	balanceSheet *usecase.GetBalanceSheetSummaryUseCase,
+	depositGrowth *usecase.GetDepositGrowthUseCase,
+	loanBook *usecase.GetLoanBookUseCase,
+	paymentVolumes *usecase.GetPaymentVolumesUseCase,
+	fraudDecisions *usecase.GetFraudDecisionRatesUseCase,
+	reviewReport *usecase.ReviewReportUseCase,
+	reviseReport *usecase.ReviseReportUseCase,
+	listReviews *usecase.ListReportReviewsUseCase,
+	createJob *usecase.CreateReportJobUseCase,
+	getJob *usecase.GetReportJobUseCase,
+	listJobs *usecase.ListReportJobsUseCase,
+	getArtifact *usecase.GetReportJobArtifactUseCase,
 	logger *slog.Logger,
 ) *ReportingHandler {
 	return &ReportingHandler{
 		generateReport: generateReport,
 		getReport:      getReport,
 		submitReport:   submitReport,
+		upsertSchedule: upsertSchedule,
+		listSchedules:  listSchedules,
+		listRuns:       listRuns,
+		recordAck:      recordAck,
+		listAudit:      listAudit,
+		amendReport:    amendReport,
+		listVersions:   listVersions,
+		diffVersions:   diffVersions,
+
+		liquidityMetrics: liquidityMetrics,
+
+		balanceSheet:   balanceSheet,
+		depositGrowth:  depositGrowth,
+		loanBook:       loanBook,
+		paymentVolumes: paymentVolumes,
+		fraudDecisions: fraudDecisions,
+
+		reviewReport: reviewReport,
+		reviseReport: reviseReport,
+		listReviews:  listReviews,
+
+		createJob:   createJob,
+		getJob:      getJob,
+		listJobs:    listJobs,
+		getArtifact: getArtifact,
 
 		logger: logger}
 }
 
 // GenerateReport handles the generate report request.
-func (h *ReportingHandler) GenerateReport(ctx context.Context, req *GenerateReportRequest) (*GenerateReportResponse, error) {
+func (h *ReportingHandler) GenerateReport(ctx context.Context, req *reportingv1.GenerateReportRequest) (*reportingv1.GenerateReportResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
@@ -128,33 +173,51 @@ func (h *ReportingHandler) GenerateReport(ctx context.Context, req *GenerateRepo
 
 	dtoReq := dto.GenerateReportRequest{
 		TenantID:   tid,
-		ReportType: req.ReportType,
-		Period:     req.Period,
+		ReportType: enumName(req.GetType().String(), "REPORT_TYPE_"),
+		Period:     req.GetReportingPeriod(),
+		Format:     enumName(req.GetFormat().String(), "REPORT_FORMAT_"),
+		Actor:      actorFromContext(ctx),
 	}
 
 	result, err := h.generateReport.Execute(ctx, dtoReq)
+	if errors.Is(err, usecase.ErrUnsupportedFormat) || errors.Is(err, usecase.ErrInvalidPeriod) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, service.ErrReportUnbalanced) {
+		h.logger.Warn("report rejected by reconciliation", "error", err)
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
 		h.logger.Error("handler error", "error", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
-	return &GenerateReportResponse{
-		ReportID:  result.ID.String(),
-		Status:    result.Status,
-		CreatedAt: result.GeneratedAt,
+	return &reportingv1.GenerateReportResponse{
+		Submission: &reportingv1.ReportSubmission{
+			Id:              result.ID.String(),
+			TenantId:        result.TenantID.String(),
+			Type:            reportType(result.ReportType),
+			ReportingPeriod: result.ReportingPeriod,
+			Status:          submissionStatus(result.Status),
+			GeneratedAt:     optionalTimestamp(result.GeneratedAt),
+		},
+		Format:      reportFormat(result.Format),
+		ContentType: result.ContentType,
+		FileName:    result.FileName,
+		Content:     result.Content,
 	}, nil
 }
 
 // GetReport handles the get report request.
-func (h *ReportingHandler) GetReport(ctx context.Context, req *GetReportRequest) (*GetReportResponse, error) {
+func (h *ReportingHandler) GetReport(ctx context.Context, req *reportingv1.GetReportRequest) (*reportingv1.GetReportResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
 
-	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCompliance, auth.RoleCustomer, auth.RoleAPIClient); err != nil {
 		return nil, err
 	}
 
-	id, err := uuid.Parse(req.ReportID)
+	id, err := uuid.Parse(req.GetId())
 	if err != nil {
 		return nil, fmt.Errorf("invalid report ID: %w", err)
 	}
@@ -168,19 +231,11 @@ func (h *ReportingHandler) GetReport(ctx context.Context, req *GetReportRequest)
 		h.logger.Error("handler error", "error", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
-	return &GetReportResponse{
-		ReportID:   result.ID.String(),
-		TenantID:   result.TenantID.String(),
-		ReportType: result.ReportType,
-		Period:     result.ReportingPeriod,
-		Status:     result.Status,
-		CreatedAt:  result.CreatedAt.Format("2006-01-02T15:04:05Z"),
-		UpdatedAt:  result.UpdatedAt.Format("2006-01-02T15:04:05Z"),
-	}, nil
+	return &reportingv1.GetReportResponse{Submission: toReportSubmissionMsg(result)}, nil
 }
 
 // SubmitReport handles the submit report request.
-func (h *ReportingHandler) SubmitReport(ctx context.Context, req *SubmitReportRequest) (*SubmitReportResponse, error) {
+func (h *ReportingHandler) SubmitReport(ctx context.Context, req *reportingv1.SubmitReportRequest) (*reportingv1.SubmitReportResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
@@ -189,22 +244,110 @@ func (h *ReportingHandler) SubmitReport(ctx context.Context, req *SubmitReportRe
 		return nil, err
 	}
 
-	id, err := uuid.Parse(req.ReportID)
+	id, err := uuid.Parse(req.GetId())
 	if err != nil {
 		return nil, fmt.Errorf("invalid report ID: %w", err)
 	}
 
 	dtoReq := dto.SubmitReportRequest{
-		ID: id,
+		ID:    id,
+		Actor: actorFromContext(ctx),
 	}
 
 	result, err := h.submitReport.Execute(ctx, dtoReq)
+	if errors.Is(err, usecase.ErrTransmissionFailed) {
+		h.logger.Warn("report transmission failed", "report_id", id, "error", err)
+		return nil, status.Error(codes.Unavailable, "report transmission failed")
+	}
+	if errors.Is(err, usecase.ErrApprovalRequired) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
 		h.logger.Error("handler error", "error", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
-	return &SubmitReportResponse{
-		ReportID: result.ID.String(),
-		Status:   result.Status,
+	return &reportingv1.SubmitReportResponse{
+		Submission: &reportingv1.ReportSubmission{
+			Id:          result.ID.String(),
+			Status:      submissionStatus(result.Status),
+			SubmittedAt: optionalTimestamp(result.SubmittedAt),
+		},
+		Channel:   submissionChannel(result.Channel),
+		Reference: result.Reference,
+		Attempt:   int32(result.Attempt), //nolint:gosec // bounded by resubmissions
 	}, nil
 }
+
+func toReportSubmissionMsg(r dto.GetReportResponse) *reportingv1.ReportSubmission {
+	msg := &reportingv1.ReportSubmission{
+		Id:                  r.ID.String(),
+		TenantId:            r.TenantID.String(),
+		Type:                reportType(r.ReportType),
+		ReportingPeriod:     r.ReportingPeriod,
+		Status:              submissionStatus(r.Status),
+		XbrlContent:         r.XBRLContent,
+		GeneratedAt:         optionalTimestamp(r.GeneratedAt),
+		SubmittedAt:         optionalTimestamp(r.SubmittedAt),
+		Audit:               toAuditInfo(r.CreatedAt, r.UpdatedAt, r.Version),
+		SubmissionChannel:   submissionChannel(r.SubmissionChannel),
+		SubmissionReference: r.SubmissionReference,
+		SubmissionAttempts:  int32(r.SubmissionAttempts), //nolint:gosec // bounded by resubmissions
+		ValidationErrors:    r.ValidationErrors,
+		Revision:            int32(r.Revision), //nolint:gosec // bounded by amendments
+		OriginalId:          r.OriginalID.String(),
+		AmendmentReason:     amendmentReason(r.AmendmentReason),
+		AmendmentNote:       r.AmendmentNote,
+		LinkedCaseIds:       r.LinkedCaseIDs,
+		PreparedBy:          r.PreparedBy,
+		ApprovedBy:          r.ApprovedBy,
+		ApprovedAt:          optionalTimestamp(r.ApprovedAt),
+	}
+	if r.AmendsID != nil {
+		msg.AmendsId = r.AmendsID.String()
+	}
+	return msg
+}
+
+func toAuditInfo(createdAt, updatedAt time.Time, version int) *commonv1.AuditInfo {
+	return &commonv1.AuditInfo{
+		CreatedAt: timestamppb.New(createdAt),
+		UpdatedAt: timestamppb.New(updatedAt),
+		Version:   int32(version), //nolint:gosec // versions stay far below MaxInt32
+	}
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func reportType(s string) reportingv1.ReportType {
+	return reportingv1.ReportType(reportingv1.ReportType_value["REPORT_TYPE_"+s])
+}
+
+func reportFormat(s string) reportingv1.ReportFormat {
+	return reportingv1.ReportFormat(reportingv1.ReportFormat_value["REPORT_FORMAT_"+s])
+}
+
+func submissionStatus(s string) reportingv1.SubmissionStatus {
+	return reportingv1.SubmissionStatus(reportingv1.SubmissionStatus_value["SUBMISSION_STATUS_"+s])
+}
+
+func submissionChannel(s string) reportingv1.SubmissionChannel {
+	return reportingv1.SubmissionChannel(reportingv1.SubmissionChannel_value["SUBMISSION_CHANNEL_"+s])
+}
+
+func amendmentReason(s string) reportingv1.AmendmentReason {
+	return reportingv1.AmendmentReason(reportingv1.AmendmentReason_value["AMENDMENT_REASON_"+s])
+}
+
+// enumName strips prefix from a proto enum name, mapping UNSPECIFIED to "".
+func enumName(name, prefix string) string {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "UNSPECIFIED" {
+		return ""
+	}
+	return name
+}
@@ -0,0 +1,179 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	reportingv1 "github.com/bibbank/bib/api/gen/go/bib/reporting/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+)
+
+// CreateReportJob handles the create report job request. The report is
+// generated in the background; callers poll GetReportJob for its progress.
+func (h *ReportingHandler) CreateReportJob(ctx context.Context, req *reportingv1.CreateReportJobRequest) (*reportingv1.CreateReportJobResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	tid, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.createJob.Execute(ctx, dto.GenerateReportRequest{
+		TenantID:   tid,
+		ReportType: enumName(req.GetReportType().String(), "REPORT_TYPE_"),
+		Period:     req.GetPeriod(),
+		Format:     enumName(req.GetFormat().String(), "REPORT_FORMAT_"),
+		Actor:      actorFromContext(ctx),
+	})
+	if errors.Is(err, usecase.ErrInvalidReportJob) || errors.Is(err, usecase.ErrUnsupportedFormat) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &reportingv1.CreateReportJobResponse{Job: toReportJobMsg(result)}, nil
+}
+
+// GetReportJob handles the get report job request.
+func (h *ReportingHandler) GetReportJob(ctx context.Context, req *reportingv1.GetReportJobRequest) (*reportingv1.GetReportJobResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	tid, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.GetJobId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job ID")
+	}
+
+	result, err := h.getJob.Execute(ctx, tid, id)
+	if errors.Is(err, port.ErrReportJobNotFound) {
+		return nil, status.Error(codes.NotFound, "report job not found")
+	}
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &reportingv1.GetReportJobResponse{Job: toReportJobMsg(result)}, nil
+}
+
+// ListReportJobs handles the list report jobs request.
+func (h *ReportingHandler) ListReportJobs(ctx context.Context, req *reportingv1.ListReportJobsRequest) (*reportingv1.ListReportJobsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	tid, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.listJobs.Execute(ctx, tid, int(req.GetLimit()))
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &reportingv1.ListReportJobsResponse{Jobs: make([]*reportingv1.ReportJob, 0, len(result))}
+	for _, j := range result {
+		resp.Jobs = append(resp.Jobs, toReportJobMsg(j))
+	}
+	return resp, nil
+}
+
+// GetReportJobArtifact handles the get report job artifact request.
+func (h *ReportingHandler) GetReportJobArtifact(ctx context.Context, req *reportingv1.GetReportJobArtifactRequest) (*reportingv1.GetReportJobArtifactResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	tid, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.GetJobId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job ID")
+	}
+
+	result, err := h.getArtifact.Execute(ctx, tid, id, req.GetName())
+	if errors.Is(err, port.ErrReportJobNotFound) {
+		return nil, status.Error(codes.NotFound, "report job not found")
+	}
+	if errors.Is(err, usecase.ErrArtifactNotFound) {
+		return nil, status.Error(codes.NotFound, "report artifact not found")
+	}
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &reportingv1.GetReportJobArtifactResponse{
+		Name:        result.Name,
+		ContentType: result.ContentType,
+		Content:     result.Content,
+	}, nil
+}
+
+func toReportJobMsg(j dto.ReportJobResponse) *reportingv1.ReportJob {
+	msg := &reportingv1.ReportJob{
+		JobId:         j.ID.String(),
+		ReportType:    reportType(j.ReportType),
+		Period:        j.ReportingPeriod,
+		Format:        reportFormat(j.Format),
+		RequestedBy:   j.RequestedBy,
+		Status:        reportingv1.ReportJobStatus(reportingv1.ReportJobStatus_value["REPORT_JOB_STATUS_"+j.Status]),
+		Progress:      int32(j.Progress), //nolint:gosec // a percentage
+		Stage:         j.Stage,
+		Artifacts:     make([]*reportingv1.ReportArtifact, 0, len(j.Artifacts)),
+		FailureDetail: j.FailureDetail,
+		Attempts:      int32(j.Attempts), //nolint:gosec // bounded by worker restarts
+		StartedAt:     optionalTimestamp(j.StartedAt),
+		CompletedAt:   optionalTimestamp(j.CompletedAt),
+		CreatedAt:     timestamppb.New(j.CreatedAt),
+		UpdatedAt:     timestamppb.New(j.UpdatedAt),
+	}
+	if j.ReportID != nil {
+		msg.ReportId = j.ReportID.String()
+	}
+	for _, a := range j.Artifacts {
+		msg.Artifacts = append(msg.Artifacts, &reportingv1.ReportArtifact{
+			Name:        a.Name,
+			ContentType: a.ContentType,
+			Sha256:      a.SHA256,
+			SizeBytes:   a.SizeBytes,
+		})
+	}
+	return msg
+}
@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	reportingv1 "github.com/bibbank/bib/api/gen/go/bib/reporting/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// GetLiquidityMetrics handles the get liquidity metrics request.
+func (h *ReportingHandler) GetLiquidityMetrics(ctx context.Context, req *reportingv1.GetLiquidityMetricsRequest) (*reportingv1.GetLiquidityMetricsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	tid, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.liquidityMetrics.Execute(ctx, dto.GetLiquidityMetricsRequest{
+		TenantID: tid,
+		Period:   req.GetPeriod(),
+	})
+	if errors.Is(err, usecase.ErrInvalidPeriod) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, service.ErrReportUnbalanced) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &reportingv1.GetLiquidityMetricsResponse{
+		TenantId:    result.TenantID.String(),
+		Period:      result.Period,
+		Lcr:         result.LCR,
+		Nsfr:        result.NSFR,
+		NetOutflows: result.NetOutflows,
+		Figures:     make([]*reportingv1.LiquidityFigure, 0, len(result.Figures)),
+	}
+	for _, f := range result.Figures {
+		msg := &reportingv1.LiquidityFigure{
+			Name:          f.Name,
+			Gross:         f.Gross,
+			Amount:        f.Amount,
+			Contributions: make([]*reportingv1.LiquidityContribution, 0, len(f.Contributions)),
+		}
+		for _, c := range f.Contributions {
+			msg.Contributions = append(msg.Contributions, &reportingv1.LiquidityContribution{
+				AccountCode: c.AccountCode,
+				Rule:        c.Rule,
+				Level:       c.Level,
+				Balance:     c.Balance,
+				Weight:      c.Weight,
+				Amount:      c.Amount,
+			})
+		}
+		resp.Figures = append(resp.Figures, msg)
+	}
+	return resp, nil
+}
@@ -0,0 +1,127 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	reportingv1 "github.com/bibbank/bib/api/gen/go/bib/reporting/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/service"
+)
+
+// ReviewReport handles the review report request. Only compliance users may
+// sign off a report, and never one they prepared.
+func (h *ReportingHandler) ReviewReport(ctx context.Context, req *reportingv1.ReviewReportRequest) (*reportingv1.ReviewReportResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleCompliance); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid report ID")
+	}
+
+	result, err := h.reviewReport.Execute(ctx, dto.ReviewReportRequest{
+		ID:       id,
+		Decision: enumName(req.GetDecision().String(), "REVIEW_DECISION_"),
+		Reviewer: actorFromContext(ctx),
+		Comment:  req.GetComment(),
+	})
+	if errors.Is(err, usecase.ErrInvalidReview) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, usecase.ErrSelfApproval) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if errors.Is(err, usecase.ErrReviewNotAllowed) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &reportingv1.ReviewReportResponse{
+		Review: toReportReviewMsg(result),
+		Status: submissionStatus(result.Status),
+	}, nil
+}
+
+// ReviseReport handles the revise report request.
+func (h *ReportingHandler) ReviseReport(ctx context.Context, req *reportingv1.ReviseReportRequest) (*reportingv1.ReviseReportResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid report ID")
+	}
+
+	result, err := h.reviseReport.Execute(ctx, dto.ReviseReportRequest{
+		ID:    id,
+		Actor: actorFromContext(ctx),
+	})
+	if errors.Is(err, usecase.ErrReviewNotAllowed) || errors.Is(err, service.ErrReportUnbalanced) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &reportingv1.ReviseReportResponse{Submission: toReportSubmissionMsg(result)}, nil
+}
+
+// ListReportReviews handles the list report reviews request.
+func (h *ReportingHandler) ListReportReviews(ctx context.Context, req *reportingv1.ListReportReviewsRequest) (*reportingv1.ListReportReviewsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleCompliance); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid report ID")
+	}
+
+	result, err := h.listReviews.Execute(ctx, id)
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &reportingv1.ListReportReviewsResponse{Reviews: make([]*reportingv1.ReportReview, 0, len(result))}
+	for _, r := range result {
+		resp.Reviews = append(resp.Reviews, toReportReviewMsg(r))
+	}
+	return resp, nil
+}
+
+func toReportReviewMsg(r dto.ReportReviewResponse) *reportingv1.ReportReview {
+	return &reportingv1.ReportReview{
+		Id:            r.ID.String(),
+		ReportId:      r.ReportID.String(),
+		Decision:      reportingv1.ReviewDecision(reportingv1.ReviewDecision_value["REVIEW_DECISION_"+r.Decision]),
+		Reviewer:      r.Reviewer,
+		Comment:       r.Comment,
+		ContentSha256: r.ContentSHA256,
+		ReviewedAt:    timestamppb.New(r.ReviewedAt),
+	}
+}
@@ -0,0 +1,156 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	reportingv1 "github.com/bibbank/bib/api/gen/go/bib/reporting/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+)
+
+// UpsertReportSchedule handles the upsert report schedule request.
+func (h *ReportingHandler) UpsertReportSchedule(ctx context.Context, req *reportingv1.UpsertReportScheduleRequest) (*reportingv1.UpsertReportScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	tid, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.upsertSchedule.Execute(ctx, dto.UpsertReportScheduleRequest{
+		TenantID:     tid,
+		ReportType:   enumName(req.GetType().String(), "REPORT_TYPE_"),
+		Frequency:    enumName(req.GetFrequency().String(), "REPORTING_FREQUENCY_"),
+		DeadlineDays: int(req.GetDeadlineDays()),
+		LeadDays:     int(req.GetLeadDays()),
+		Active:       req.GetActive(),
+	})
+	if errors.Is(err, usecase.ErrInvalidSchedule) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &reportingv1.UpsertReportScheduleResponse{Schedule: toReportScheduleMsg(result)}, nil
+}
+
+// ListReportSchedules handles the list report schedules request.
+func (h *ReportingHandler) ListReportSchedules(ctx context.Context, req *reportingv1.ListReportSchedulesRequest) (*reportingv1.ListReportSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	tid, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.listSchedules.Execute(ctx, tid)
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	resp := &reportingv1.ListReportSchedulesResponse{Schedules: make([]*reportingv1.ReportSchedule, 0, len(result))}
+	for _, s := range result {
+		resp.Schedules = append(resp.Schedules, toReportScheduleMsg(s))
+	}
+	return resp, nil
+}
+
+// ListScheduledRuns handles the list scheduled runs request.
+func (h *ReportingHandler) ListScheduledRuns(ctx context.Context, req *reportingv1.ListScheduledRunsRequest) (*reportingv1.ListScheduledRunsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	tid, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	since := time.Now().UTC().AddDate(-1, 0, 0)
+	if req.GetSince() != nil {
+		if err := req.GetSince().CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid since: %v", err)
+		}
+		since = req.GetSince().AsTime()
+	}
+
+	result, err := h.listRuns.Execute(ctx, dto.ListScheduledRunsRequest{TenantID: tid, Since: since})
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &reportingv1.ListScheduledRunsResponse{
+		Runs: make([]*reportingv1.ScheduledRun, 0, len(result.Runs)),
+		Sla: &reportingv1.DeadlineSLA{
+			Open:   int32(result.SLA.Open),   //nolint:gosec // bounded by the runs listed
+			Met:    int32(result.SLA.Met),    //nolint:gosec // bounded by the runs listed
+			Late:   int32(result.SLA.Late),   //nolint:gosec // bounded by the runs listed
+			Missed: int32(result.SLA.Missed), //nolint:gosec // bounded by the runs listed
+		},
+	}
+	for _, r := range result.Runs {
+		msg := &reportingv1.ScheduledRun{
+			Id:              r.ID.String(),
+			ScheduleId:      r.ScheduleID.String(),
+			Type:            reportType(r.ReportType),
+			ReportingPeriod: r.ReportingPeriod,
+			Deadline:        timestamppb.New(r.Deadline),
+			Status:          runStatus(r.Status),
+			Attempts:        int32(r.Attempts), //nolint:gosec // bounded by the scheduler's max attempts
+			FailureReason:   r.FailureReason,
+			SubmittedAt:     optionalTimestamp(r.SubmittedAt),
+			SlaMet:          r.SLAMet,
+		}
+		if r.ReportID != nil {
+			msg.ReportId = r.ReportID.String()
+		}
+		resp.Runs = append(resp.Runs, msg)
+	}
+	return resp, nil
+}
+
+func toReportScheduleMsg(s dto.ReportScheduleResponse) *reportingv1.ReportSchedule {
+	return &reportingv1.ReportSchedule{
+		Id:           s.ID.String(),
+		Type:         reportType(s.ReportType),
+		Frequency:    reportingFrequency(s.Frequency),
+		DeadlineDays: int32(s.DeadlineDays), //nolint:gosec // validated calendar days
+		LeadDays:     int32(s.LeadDays),     //nolint:gosec // validated calendar days
+		Active:       s.Active,
+		CreatedAt:    timestamppb.New(s.CreatedAt),
+		UpdatedAt:    timestamppb.New(s.UpdatedAt),
+	}
+}
+
+func reportingFrequency(s string) reportingv1.ReportingFrequency {
+	return reportingv1.ReportingFrequency(reportingv1.ReportingFrequency_value["REPORTING_FREQUENCY_"+s])
+}
+
+func runStatus(s string) reportingv1.RunStatus {
+	return reportingv1.RunStatus(reportingv1.RunStatus_value["RUN_STATUS_"+s])
+}
@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	reportingv1 "github.com/bibbank/bib/api/gen/go/bib/reporting/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/dto"
+	"github.com/bibbank/bib/services/reporting-service/internal/application/usecase"
+)
+
+// RecordSubmissionAcknowledgment handles the record submission acknowledgment request.
+func (h *ReportingHandler) RecordSubmissionAcknowledgment(ctx context.Context, req *reportingv1.RecordSubmissionAcknowledgmentRequest) (*reportingv1.RecordSubmissionAcknowledgmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid report ID")
+	}
+
+	result, err := h.recordAck.Execute(ctx, dto.RecordAcknowledgmentRequest{
+		ID:        id,
+		Reference: req.GetReference(),
+		Accepted:  req.GetAccepted(),
+		Errors:    req.GetErrors(),
+		Actor:     actorFromContext(ctx),
+	})
+	if errors.Is(err, usecase.ErrStaleAcknowledgment) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &reportingv1.RecordSubmissionAcknowledgmentResponse{
+		Submission: &reportingv1.ReportSubmission{
+			Id:               result.ID.String(),
+			Status:           submissionStatus(result.Status),
+			ValidationErrors: result.ValidationErrors,
+		},
+	}, nil
+}
+
+// ListSubmissionAudit handles the list submission audit request.
+func (h *ReportingHandler) ListSubmissionAudit(ctx context.Context, req *reportingv1.ListSubmissionAuditRequest) (*reportingv1.ListSubmissionAuditResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid report ID")
+	}
+
+	result, err := h.listAudit.Execute(ctx, id)
+	if err != nil {
+		h.logger.Error("handler error", "error", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &reportingv1.ListSubmissionAuditResponse{Entries: make([]*reportingv1.SubmissionAuditEntry, 0, len(result))}
+	for _, e := range result {
+		resp.Entries = append(resp.Entries, &reportingv1.SubmissionAuditEntry{
+			Id:            e.ID.String(),
+			Channel:       submissionChannel(e.Channel),
+			Attempt:       int32(e.Attempt), //nolint:gosec // bounded by resubmissions
+			Action:        e.Action,
+			Reference:     e.Reference,
+			Detail:        e.Detail,
+			Actor:         e.Actor,
+			ContentSha256: e.ContentSHA256,
+			OccurredAt:    timestamppb.New(e.OccurredAt),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,142 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/model"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/port"
+	"github.com/bibbank/bib/services/reporting-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/reporting-service/internal/infrastructure/postgres"
+)
+
+const xbrlContent = `<?xml version="1.0" encoding="UTF-8"?>
+<xbrli:xbrl xmlns:xbrli="http://www.xbrl.org/2003/instance">
+  <xbrli:context id="ctx"><xbrli:period><xbrli:instant>2025-03-31</xbrli:instant></xbrli:period></xbrli:context>
+</xbrli:xbrl>`
+
+func fileSubmission(t *testing.T, submission model.ReportSubmission, now time.Time) model.ReportSubmission {
+	t.Helper()
+	submission, err := submission.MarkGenerating(now)
+	require.NoError(t, err)
+	submission, err = submission.SetGenerated(xbrlContent, now)
+	require.NoError(t, err)
+	submission = submission.SetPreparer("preparer")
+	submission, err = submission.Approve("reviewer", now)
+	require.NoError(t, err)
+	submission, err = submission.Submit(valueobject.SubmissionChannelSFTP, "REF-"+submission.ID().String()[:8], now)
+	require.NoError(t, err)
+	return submission
+}
+
+func TestReportSubmissionRepo_AmendmentsShareLineage(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewReportSubmissionRepo(env.Pool())
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	original, err := model.NewReportSubmission(uuid.New(), valueobject.ReportTypeCOREP, "2025-Q1")
+	require.NoError(t, err)
+	original = fileSubmission(t, original, now)
+	require.NoError(t, repo.Save(ctx, original))
+
+	found, err := repo.FindByID(ctx, original.ID())
+	require.NoError(t, err)
+	assert.True(t, found.Status().Equal(valueobject.SubmissionStatusSubmitted))
+	assert.Equal(t, xbrlContent, found.XBRLContent())
+	assert.Equal(t, "preparer", found.PreparedBy())
+	assert.Equal(t, "reviewer", found.ApprovedBy())
+	assert.True(t, found.Channel().Equal(valueobject.SubmissionChannelSFTP))
+	assert.Equal(t, 1, found.Attempts())
+
+	awaiting, err := repo.ListAwaitingAcknowledgment(ctx, valueobject.SubmissionChannelSFTP.String())
+	require.NoError(t, err)
+	require.Len(t, awaiting, 1)
+	assert.Equal(t, original.ID(), awaiting[0].ID())
+
+	amendment, err := model.NewAmendment(found, valueobject.AmendmentReasonDataCorrection, "restated provisions")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, amendment))
+
+	versions, err := repo.FindVersions(ctx, original.OriginalID())
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, original.ID(), versions[0].ID())
+	assert.Equal(t, amendment.ID(), versions[1].ID())
+	require.NotNil(t, versions[1].AmendsID())
+	assert.Equal(t, original.ID(), *versions[1].AmendsID())
+	assert.True(t, versions[1].AmendmentReason().Equal(valueobject.AmendmentReasonDataCorrection))
+	assert.Equal(t, "restated provisions", versions[1].AmendmentNote())
+}
+
+func TestReportSubmissionRepo_FindByTenantAndPeriodIsScopedToTenant(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewReportSubmissionRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+
+	for _, s := range []struct {
+		tenantID uuid.UUID
+		period   string
+	}{
+		{tenantID, "2025-Q1"},
+		{tenantID, "2025-Q2"},
+		{uuid.New(), "2025-Q1"},
+	} {
+		submission, err := model.NewReportSubmission(s.tenantID, valueobject.ReportTypeCOREP, s.period)
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, submission))
+	}
+
+	submissions, err := repo.FindByTenantAndPeriod(ctx, tenantID, "2025-Q1")
+	require.NoError(t, err)
+	require.Len(t, submissions, 1)
+	assert.Equal(t, tenantID, submissions[0].TenantID())
+	assert.Equal(t, "2025-Q1", submissions[0].ReportingPeriod())
+}
+
+func TestReportJobRepo_ClaimNextStartsOldestQueuedJob(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewReportJobRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	older, err := model.NewReportJob(tenantID, valueobject.ReportTypeCOREP, "2025-Q1", "xbrl", "analyst", now.Add(-time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, older))
+	newer, err := model.NewReportJob(tenantID, valueobject.ReportTypeCOREP, "2025-Q2", "xbrl", "analyst", now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, newer))
+
+	claimed, ok, err := repo.ClaimNext(ctx, now, now.Add(-10*time.Minute))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, older.ID(), claimed.ID())
+	assert.True(t, claimed.Status().Equal(valueobject.JobStatusRunning))
+
+	found, err := repo.FindByID(ctx, tenantID, older.ID())
+	require.NoError(t, err)
+	assert.True(t, found.Status().Equal(valueobject.JobStatusRunning))
+
+	// A running job is only reclaimed once it has gone stale.
+	claimed, ok, err = repo.ClaimNext(ctx, now, now.Add(-10*time.Minute))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, newer.ID(), claimed.ID())
+
+	_, ok, err = repo.ClaimNext(ctx, now, now.Add(-10*time.Minute))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, err = repo.FindByID(ctx, uuid.New(), older.ID())
+	assert.ErrorIs(t, err, port.ErrReportJobNotFound)
+}
@@ -0,0 +1,357 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/clock"
+	pkgkafka "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
+	"github.com/bibbank/bib/pkg/lock"
+	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
+	pkgpostgres "github.com/bibbank/bib/pkg/postgres"
+	"github.com/bibbank/bib/services/scheduler-service/internal/application/dto"
+	"github.com/bibbank/bib/services/scheduler-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/scheduler-service/internal/infrastructure/config"
+	"github.com/bibbank/bib/services/scheduler-service/internal/infrastructure/dispatch"
+	"github.com/bibbank/bib/services/scheduler-service/internal/infrastructure/postgres"
+	grpcpresentation "github.com/bibbank/bib/services/scheduler-service/internal/presentation/grpc"
+	"github.com/bibbank/bib/services/scheduler-service/internal/presentation/rest"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// Load configuration.
+	cfg := config.Load()
+
+	// Initialize structured logger via shared observability package.
+	logger := observability.InitLogger(observability.LogConfig{
+		Level:  "info",
+		Format: "json",
+	})
+	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
+
+	logger.Info("starting scheduler-service",
+		"http_port", cfg.HTTPPort,
+		"grpc_port", cfg.GRPCPort,
+	)
+
+	// Initialize tracing.
+	shutdown, err := observability.InitTracer(ctx, observability.TracingConfig{
+		ServiceName: cfg.ServiceName,
+		Endpoint:    getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4317"),
+		Insecure:    true,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
+	} else {
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
+	}
+
+	// Database connection.
+	dbCtx, dbCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer dbCancel()
+
+	pool, err := pkgpostgres.NewPool(dbCtx, pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	})
+	if err != nil {
+		logger.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
+	logger.Info("connected to database")
+
+	// Run database migrations.
+	migDSN := pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	}.DSN()
+	if migErr := pkgpostgres.RunMigrations(migDSN, "file://internal/infrastructure/postgres/migrations"); migErr != nil {
+		logger.Warn("migration warning", "error", migErr)
+	}
+
+	// Wire infrastructure adapters.
+	jobRepo := postgres.NewJobRepo(pool)
+	runRepo := postgres.NewRunRepo(pool)
+	eodRunRepo := postgres.NewEODRunRepo(pool)
+	kafkaProducer := pkgkafka.NewProducer(pkgkafka.Config{
+		Brokers: cfg.Kafka.Brokers,
+	})
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(kafkaProducer.Close))
+
+	// Outbox relay: events are written to the outbox and published from it,
+	// so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     outbox.StaticRoute("scheduler-events"),
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: cfg.ServiceName,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, kafkaProducer, relayCfg, logger)
+	if err != nil {
+		logger.Error("failed to create outbox relay", "error", err)
+		os.Exit(1)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+	eventPublisher := postgres.NewOutboxPublisher(outbox.NewPublisher(outboxStore), "scheduler-events")
+
+	// JWT service for gRPC auth (validation-only: public key preferred, secret as fallback).
+	jwtCfg := auth.JWTConfig{
+		Issuer: "bib-gateway",
+	}
+	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
+	case os.Getenv("JWT_PUBLIC_KEY") != "":
+		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
+	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
+		keyData, loadErr := auth.LoadKeyFromFile(os.Getenv("JWT_PUBLIC_KEY_FILE"))
+		if loadErr != nil {
+			logger.Error("failed to load JWT public key file", "error", loadErr)
+			os.Exit(1)
+		}
+		jwtCfg.PublicKeyPEM = string(keyData)
+	default:
+		jwtSecret := os.Getenv("JWT_SECRET")
+		if jwtSecret == "" {
+			jwtSecret = "test-e2e-secret" // Match gateway default for E2E tests
+		}
+		jwtCfg.Secret = jwtSecret
+	}
+	jwtSvc, err := auth.NewJWTService(jwtCfg)
+	if err != nil {
+		logger.Error("failed to initialize JWT service", "error", err)
+		os.Exit(1)
+	}
+
+	// Jobs call other services without a caller token, so the dispatcher
+	// signs its own like the gateway's.
+	signer, err := newTokenSigner(cfg.Scheduler.SigningKeyFile, jwtCfg.Secret)
+	if err != nil {
+		logger.Error("failed to initialize job token signer", "error", err)
+		os.Exit(1)
+	}
+	conns := make(map[string]grpc.ClientConnInterface, len(cfg.Scheduler.Targets))
+	for service, addr := range cfg.Scheduler.Targets {
+		conn, dialErr := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if dialErr != nil {
+			logger.Error("failed to create job target client", "service", service, "addr", addr, "error", dialErr)
+			os.Exit(1)
+		}
+		lc.OnStop(lifecycle.PhaseResources, service+" client", lifecycle.Close(conn.Close))
+		conns[service] = conn
+	}
+	dispatcher := dispatch.NewDispatcher(conns, signer, kafkaProducer)
+	runner := usecase.NewRunner(runRepo, dispatcher, eventPublisher, cfg.Scheduler.DispatchTimeout, clock.System)
+
+	// Wire use cases.
+	registerJobUC := usecase.NewRegisterJobUseCase(jobRepo, eventPublisher)
+	updateJobUC := usecase.NewUpdateJobUseCase(jobRepo, eventPublisher)
+	getJobUC := usecase.NewGetJobUseCase(jobRepo)
+	listJobsUC := usecase.NewListJobsUseCase(jobRepo)
+	pauseJobUC := usecase.NewPauseJobUseCase(jobRepo, eventPublisher)
+	resumeJobUC := usecase.NewResumeJobUseCase(jobRepo, eventPublisher)
+	triggerJobUC := usecase.NewTriggerJobUseCase(jobRepo, runner)
+	retryRunUC := usecase.NewRetryRunUseCase(jobRepo, runRepo, runner)
+	getRunUC := usecase.NewGetRunUseCase(runRepo)
+	listRunsUC := usecase.NewListRunsUseCase(jobRepo, runRepo)
+	runDueJobsUC := usecase.NewRunDueJobsUseCase(jobRepo, runRepo, runner, logger)
+
+	eodPlan, err := newEODPlan(cfg.Scheduler.EODJobs)
+	if err != nil {
+		logger.Error("invalid end-of-day plan", "error", err)
+		os.Exit(1)
+	}
+	var eodSchedule valueobject.CronSchedule
+	if cfg.Scheduler.EODSchedule != "" {
+		if eodSchedule, err = valueobject.NewCronSchedule(cfg.Scheduler.EODSchedule); err != nil {
+			logger.Error("invalid end-of-day schedule", "error", err)
+			os.Exit(1)
+		}
+	}
+	startEODUC := usecase.NewStartEODRunUseCase(eodPlan, eodRunRepo, eventPublisher, clock.System)
+	getEODUC := usecase.NewGetEODRunUseCase(eodRunRepo)
+	listEODUC := usecase.NewListEODRunsUseCase(eodRunRepo)
+	resumeEODUC := usecase.NewResumeEODRunUseCase(eodRunRepo, eventPublisher, clock.System)
+	advanceEODUC := usecase.NewAdvanceEODRunsUseCase(eodPlan, eodSchedule, eodRunRepo, jobRepo, runRepo, runner, eventPublisher, logger)
+
+	// Sandbox tenants move their own virtual time; live deployments leave
+	// the use cases nil so the API refuses.
+	var advanceClockUC *usecase.AdvanceTenantClockUseCase
+	var getClockUC *usecase.GetTenantClockUseCase
+	if cfg.Scheduler.Sandbox {
+		tenantClockRepo := postgres.NewTenantClockRepo(pool)
+		advanceClockUC = usecase.NewAdvanceTenantClockUseCase(eodPlan, tenantClockRepo, jobRepo, runner, clock.System)
+		getClockUC = usecase.NewGetTenantClockUseCase(tenantClockRepo, clock.System)
+		logger.Info("sandbox time travel enabled")
+	}
+
+	// Run due jobs, retries and the end-of-day close on the elected replica
+	// only.
+	elector := lock.NewElector(lock.NewPostgresLocker(pool), "scheduler.dispatch", lock.ElectorConfig{}, logger)
+	lc.Go(lifecycle.PhaseWorkers, "job dispatch", func(ctx context.Context) error {
+		elector.Run(ctx, func(ctx context.Context) {
+			ticker := time.NewTicker(cfg.Scheduler.PollInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					now := clock.System.Now()
+					result, runErr := runDueJobsUC.Execute(ctx, now)
+					if runErr != nil {
+						logger.Error("scheduler pass failed", "error", runErr)
+					}
+					if result != (dto.RunDueJobsResult{}) {
+						logger.Info("scheduler pass",
+							"started", result.Started,
+							"retried", result.Retried,
+							"succeeded", result.Succeeded,
+							"failed", result.Failed,
+						)
+					}
+					eodResult, eodErr := advanceEODUC.Execute(ctx, now)
+					if eodErr != nil {
+						logger.Error("end-of-day pass failed", "error", eodErr)
+					}
+					if eodResult != (dto.AdvanceEODResult{}) {
+						logger.Info("end-of-day pass",
+							"runs_started", eodResult.RunsStarted,
+							"steps_started", eodResult.StepsStarted,
+							"steps_succeeded", eodResult.StepsSucceeded,
+							"steps_failed", eodResult.StepsFailed,
+							"runs_completed", eodResult.RunsCompleted,
+						)
+					}
+				}
+			}
+		})
+		return nil
+	})
+
+	// gRPC server.
+	grpcHandler := grpcpresentation.NewSchedulerServiceHandler(
+		registerJobUC, updateJobUC, getJobUC, listJobsUC, pauseJobUC,
+		resumeJobUC, triggerJobUC, retryRunUC, getRunUC, listRunsUC,
+		startEODUC, getEODUC, listEODUC, resumeEODUC, advanceClockUC, getClockUC, logger,
+	)
+	grpcServer, err := grpcpresentation.NewServer(grpcHandler, logger, jwtSvc)
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
+
+	// HTTP server (health checks and metrics).
+	healthHandler := rest.NewHealthHandler(logger)
+	httpMux := http.NewServeMux()
+	healthHandler.RegisterRoutes(httpMux)
+	if metricsHandler != nil {
+		httpMux.Handle("/metrics", metricsHandler)
+	}
+
+	httpServer := &http.Server{
+		Addr:              cfg.HTTPAddr(),
+		Handler:           httpMux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Start servers.
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", func(context.Context) error {
+		return grpcServer.Start(cfg.GRPCAddr())
+	})
+	lc.OnStop(lifecycle.PhaseIngress, "gRPC server", lifecycle.Func(grpcServer.Stop))
+	lc.ServeHTTP("HTTP server", httpServer)
+
+	logger.Info("scheduler-service is running",
+		"grpc_addr", cfg.GRPCAddr(),
+		"http_addr", cfg.HTTPAddr(),
+		"targets", len(conns),
+	)
+
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
+	}
+	logger.Info("scheduler-service stopped")
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// newEODPlan builds the end-of-day plan from the default close sequence,
+// running the jobs named in jobs in place of the steps' own.
+func newEODPlan(jobs map[string]string) (model.EODPlan, error) {
+	defs := make([]model.EODStepDefinition, 0, len(model.DefaultEODSteps))
+	for _, d := range model.DefaultEODSteps {
+		if job, ok := jobs[d.Name]; ok {
+			d.JobName = job
+		}
+		defs = append(defs, d)
+	}
+	return model.NewEODPlan(defs)
+}
+
+// newTokenSigner creates the signer for tokens jobs call other services
+// with: the gateway's private key when keyFile is set, otherwise the shared
+// JWT secret.
+func newTokenSigner(keyFile, secret string) (*auth.JWTService, error) {
+	cfg := auth.JWTConfig{
+		Secret:     secret,
+		Issuer:     "bib-gateway",
+		Expiration: 15 * time.Minute,
+	}
+	if keyFile != "" {
+		keyData, err := auth.LoadKeyFromFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load signing key: %w", err)
+		}
+		cfg = auth.JWTConfig{
+			PrivateKeyPEM: string(keyData),
+			Issuer:        "bib-gateway",
+			Expiration:    15 * time.Minute,
+		}
+	}
+	return auth.NewJWTService(cfg)
+}
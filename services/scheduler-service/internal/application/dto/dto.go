@@ -0,0 +1,174 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobTargetDTO is where a job's command is sent.
+type JobTargetDTO struct {
+	// Kind is GRPC or KAFKA.
+	Kind    string          `json:"kind"`
+	Service string          `json:"service,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Topic   string          `json:"topic,omitempty"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// RetryPolicyDTO controls how a job's failed runs are retried.
+type RetryPolicyDTO struct {
+	MaxAttempts int           `json:"max_attempts"`
+	Backoff     time.Duration `json:"backoff"`
+}
+
+// JobDefinitionDTO holds the configurable fields of a job.
+type JobDefinitionDTO struct {
+	Description string         `json:"description"`
+	Schedule    string         `json:"schedule"`
+	Target      JobTargetDTO   `json:"target"`
+	Retry       RetryPolicyDTO `json:"retry"`
+}
+
+// RegisterJobRequest is the input DTO for registering a job.
+type RegisterJobRequest struct {
+	Name       string           `json:"name"`
+	Definition JobDefinitionDTO `json:"definition"`
+}
+
+// UpdateJobRequest is the input DTO for replacing a job's definition.
+type UpdateJobRequest struct {
+	Definition JobDefinitionDTO `json:"definition"`
+	JobID      uuid.UUID        `json:"job_id"`
+}
+
+// ListJobsRequest is the input DTO for listing jobs with pagination.
+type ListJobsRequest struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+// ListRunsRequest is the input DTO for listing a job's runs.
+type ListRunsRequest struct {
+	Limit  int       `json:"limit"`
+	Offset int       `json:"offset"`
+	JobID  uuid.UUID `json:"job_id"`
+}
+
+// JobResponse is the output DTO for a job.
+type JobResponse struct {
+	CreatedAt  time.Time        `json:"created_at"`
+	UpdatedAt  time.Time        `json:"updated_at"`
+	NextRunAt  time.Time        `json:"next_run_at"`
+	LastRunAt  *time.Time       `json:"last_run_at,omitempty"`
+	Name       string           `json:"name"`
+	Definition JobDefinitionDTO `json:"definition"`
+	Version    int              `json:"version"`
+	Enabled    bool             `json:"enabled"`
+	ID         uuid.UUID        `json:"id"`
+}
+
+// ListJobsResponse is the output DTO for listing jobs.
+type ListJobsResponse struct {
+	Jobs       []JobResponse `json:"jobs"`
+	TotalCount int           `json:"total_count"`
+}
+
+// RunResponse is the output DTO for a job run.
+type RunResponse struct {
+	ScheduledFor time.Time  `json:"scheduled_for"`
+	StartedAt    time.Time  `json:"started_at"`
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+	NextRetryAt  *time.Time `json:"next_retry_at,omitempty"`
+	JobName      string     `json:"job_name"`
+	Trigger      string     `json:"trigger"`
+	Status       string     `json:"status"`
+	Error        string     `json:"error,omitempty"`
+	Attempt      int        `json:"attempt"`
+	MaxAttempts  int        `json:"max_attempts"`
+	ID           uuid.UUID  `json:"id"`
+	JobID        uuid.UUID  `json:"job_id"`
+}
+
+// ListRunsResponse is the output DTO for listing a job's runs.
+type ListRunsResponse struct {
+	Runs       []RunResponse `json:"runs"`
+	TotalCount int           `json:"total_count"`
+}
+
+// RunDueJobsResult summarises one pass of the scheduler.
+type RunDueJobsResult struct {
+	Started   int `json:"started"`
+	Retried   int `json:"retried"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+}
+
+// StartEODRunRequest is the input DTO for starting the end-of-day close of
+// a business date.
+type StartEODRunRequest struct {
+	BusinessDate time.Time `json:"business_date"`
+}
+
+// ListEODRunsRequest is the input DTO for listing end-of-day runs.
+type ListEODRunsRequest struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+// EODStepResponse is the output DTO for a step of an end-of-day run.
+type EODStepResponse struct {
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	RunID      *uuid.UUID `json:"run_id,omitempty"`
+	Name       string     `json:"name"`
+	JobName    string     `json:"job_name"`
+	Status     string     `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	DependsOn  []string   `json:"depends_on"`
+}
+
+// EODRunResponse is the output DTO for an end-of-day run.
+type EODRunResponse struct {
+	BusinessDate time.Time         `json:"business_date"`
+	StartedAt    time.Time         `json:"started_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+	FinishedAt   *time.Time        `json:"finished_at,omitempty"`
+	Trigger      string            `json:"trigger"`
+	Status       string            `json:"status"`
+	Steps        []EODStepResponse `json:"steps"`
+	Version      int               `json:"version"`
+	ID           uuid.UUID         `json:"id"`
+}
+
+// ListEODRunsResponse is the output DTO for listing end-of-day runs.
+type ListEODRunsResponse struct {
+	Runs       []EODRunResponse `json:"runs"`
+	TotalCount int              `json:"total_count"`
+}
+
+// AdvanceEODResult summarises one pass of the end-of-day coordinator.
+type AdvanceEODResult struct {
+	RunsStarted    int `json:"runs_started"`
+	StepsStarted   int `json:"steps_started"`
+	StepsSucceeded int `json:"steps_succeeded"`
+	StepsFailed    int `json:"steps_failed"`
+	RunsCompleted  int `json:"runs_completed"`
+}
+
+// AdvanceTenantClockRequest is the input DTO for moving a sandbox tenant's
+// virtual time forward by a number of days.
+type AdvanceTenantClockRequest struct {
+	Days     int       `json:"days"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+// TenantClockResponse is the output DTO for a sandbox tenant's virtual
+// time. DaysSimulated and JobRuns count the work of an advance.
+type TenantClockResponse struct {
+	BusinessDate  time.Time `json:"business_date"`
+	DaysSimulated int       `json:"days_simulated"`
+	JobRuns       int       `json:"job_runs"`
+	TenantID      uuid.UUID `json:"tenant_id"`
+}
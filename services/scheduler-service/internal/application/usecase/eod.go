@@ -0,0 +1,370 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/clock"
+	"github.com/bibbank/bib/services/scheduler-service/internal/application/dto"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/port"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+)
+
+// ErrInvalidEODRun is returned when an end-of-day run cannot be started
+// for the requested business date.
+var ErrInvalidEODRun = errors.New("invalid end-of-day run")
+
+// ErrEODRunInProgress is returned when an end-of-day run is started while
+// an earlier one has not succeeded: business dates close one at a time.
+var ErrEODRunInProgress = errors.New("an earlier end-of-day run has not succeeded")
+
+// eodStarter starts end-of-day runs following a plan.
+type eodStarter struct {
+	plan      model.EODPlan
+	eodRuns   port.EODRunRepository
+	publisher port.EventPublisher
+}
+
+func (s eodStarter) start(ctx context.Context, businessDate time.Time, trigger valueobject.RunTrigger, now time.Time) (model.EODRun, error) {
+	businessDate = model.BusinessDate(businessDate)
+	if businessDate.After(now) {
+		return model.EODRun{}, fmt.Errorf("%w: business date %s has not begun", ErrInvalidEODRun, businessDate.Format(time.DateOnly))
+	}
+	if _, err := s.eodRuns.FindByBusinessDate(ctx, businessDate); err == nil {
+		return model.EODRun{}, fmt.Errorf("%w: %s", port.ErrEODRunExists, businessDate.Format(time.DateOnly))
+	} else if !errors.Is(err, port.ErrEODRunNotFound) {
+		return model.EODRun{}, fmt.Errorf("failed to find end-of-day run: %w", err)
+	}
+	unfinished, err := s.eodRuns.ListUnfinished(ctx)
+	if err != nil {
+		return model.EODRun{}, fmt.Errorf("failed to list unfinished end-of-day runs: %w", err)
+	}
+	if len(unfinished) > 0 {
+		return model.EODRun{}, fmt.Errorf("%w: %s is %s", ErrEODRunInProgress,
+			unfinished[0].BusinessDate().Format(time.DateOnly), unfinished[0].Status())
+	}
+
+	run, err := model.NewEODRun(s.plan, businessDate, trigger, now)
+	if err != nil {
+		return model.EODRun{}, fmt.Errorf("%w: %w", ErrInvalidEODRun, err)
+	}
+	return saveEODRun(ctx, s.eodRuns, s.publisher, run)
+}
+
+// saveEODRun checkpoints the run and publishes its events.
+func saveEODRun(ctx context.Context, eodRuns port.EODRunRepository, publisher port.EventPublisher, run model.EODRun) (model.EODRun, error) {
+	if err := eodRuns.Save(ctx, run); err != nil {
+		return run, fmt.Errorf("failed to save end-of-day run: %w", err)
+	}
+	if err := publisher.Publish(ctx, run.DomainEvents()); err != nil {
+		return run, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return run.ClearDomainEvents(), nil
+}
+
+// StartEODRunUseCase starts the end-of-day close of a business date on an
+// operator's request.
+type StartEODRunUseCase struct {
+	clock   clock.Clock
+	starter eodStarter
+}
+
+// NewStartEODRunUseCase creates a new StartEODRunUseCase reading the time
+// from clk.
+func NewStartEODRunUseCase(plan model.EODPlan, eodRuns port.EODRunRepository, publisher port.EventPublisher, clk clock.Clock) *StartEODRunUseCase {
+	return &StartEODRunUseCase{clock: clk, starter: eodStarter{plan: plan, eodRuns: eodRuns, publisher: publisher}}
+}
+
+// Execute starts a MANUAL run for the business date. Its steps are started
+// by the coordinator's next pass.
+func (uc *StartEODRunUseCase) Execute(ctx context.Context, req dto.StartEODRunRequest) (dto.EODRunResponse, error) {
+	run, err := uc.starter.start(ctx, req.BusinessDate, valueobject.RunTriggerManual, uc.clock.Now())
+	if err != nil {
+		return dto.EODRunResponse{}, err
+	}
+	return toEODRunResponse(run), nil
+}
+
+// AdvanceEODRunsUseCase coordinates the end-of-day close. It starts the
+// day's run once its schedule fires, starts each step's job run once the
+// steps it depends on have succeeded, and records the outcome of the job
+// runs. Only one replica should run it at a time.
+type AdvanceEODRunsUseCase struct {
+	starter  eodStarter
+	schedule valueobject.CronSchedule
+	jobs     port.JobRepository
+	runs     port.RunRepository
+	runner   *Runner
+	logger   *slog.Logger
+}
+
+// NewAdvanceEODRunsUseCase creates a new AdvanceEODRunsUseCase. A zero
+// schedule never starts runs itself, leaving it to operators. logger may be
+// nil.
+func NewAdvanceEODRunsUseCase(
+	plan model.EODPlan,
+	schedule valueobject.CronSchedule,
+	eodRuns port.EODRunRepository,
+	jobs port.JobRepository,
+	runs port.RunRepository,
+	runner *Runner,
+	publisher port.EventPublisher,
+	logger *slog.Logger,
+) *AdvanceEODRunsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &AdvanceEODRunsUseCase{
+		starter:  eodStarter{plan: plan, eodRuns: eodRuns, publisher: publisher},
+		schedule: schedule,
+		jobs:     jobs,
+		runs:     runs,
+		runner:   runner,
+		logger:   logger,
+	}
+}
+
+// Execute starts today's run if its schedule has fired and advances every
+// unfinished run as far as it can go at now. A run that cannot be advanced
+// is logged and left for the next pass.
+func (uc *AdvanceEODRunsUseCase) Execute(ctx context.Context, now time.Time) (dto.AdvanceEODResult, error) {
+	var result dto.AdvanceEODResult
+	uc.startScheduled(ctx, now, &result)
+
+	unfinished, err := uc.starter.eodRuns.ListUnfinished(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to list unfinished end-of-day runs: %w", err)
+	}
+	for _, run := range unfinished {
+		if err := uc.advance(ctx, run, now, &result); err != nil && !errors.Is(err, port.ErrVersionConflict) {
+			uc.logger.Error("failed to advance end-of-day run",
+				"business_date", run.BusinessDate().Format(time.DateOnly), "error", err)
+		}
+	}
+	return result, nil
+}
+
+// startScheduled starts the run of today's business date once the schedule
+// has fired today.
+func (uc *AdvanceEODRunsUseCase) startScheduled(ctx context.Context, now time.Time, result *dto.AdvanceEODResult) {
+	if uc.schedule.IsZero() {
+		return
+	}
+	today := model.BusinessDate(now)
+	fire := uc.schedule.Next(today.Add(-time.Nanosecond))
+	if fire.IsZero() || fire.After(now) || !model.BusinessDate(fire).Equal(today) {
+		return
+	}
+	_, err := uc.starter.start(ctx, today, valueobject.RunTriggerScheduled, now)
+	switch {
+	case err == nil:
+		result.RunsStarted++
+	case errors.Is(err, port.ErrEODRunExists):
+	case errors.Is(err, ErrEODRunInProgress):
+		uc.logger.Warn("end-of-day run not started", "business_date", today.Format(time.DateOnly), "reason", err)
+	default:
+		uc.logger.Error("failed to start end-of-day run", "business_date", today.Format(time.DateOnly), "error", err)
+	}
+}
+
+// advance records the outcome of the run's finished job runs and starts its
+// ready steps until neither changes anything. Each change is checkpointed
+// before the next, and a step is checkpointed before its job run is
+// dispatched so a restart finds it.
+func (uc *AdvanceEODRunsUseCase) advance(ctx context.Context, run model.EODRun, now time.Time, result *dto.AdvanceEODResult) error {
+	for progressed := true; progressed; {
+		progressed = false
+
+		for _, step := range run.RunningSteps() {
+			next, outcome, err := uc.settle(ctx, run, step, now)
+			if err != nil {
+				return err
+			}
+			if outcome.IsZero() {
+				continue
+			}
+			if run, err = saveEODRun(ctx, uc.starter.eodRuns, uc.starter.publisher, next); err != nil {
+				return err
+			}
+			progressed = true
+			if outcome.Equal(valueobject.StepStatusSucceeded) {
+				result.StepsSucceeded++
+			} else {
+				result.StepsFailed++
+			}
+			if run.Status().Equal(valueobject.RunStatusSucceeded) {
+				result.RunsCompleted++
+			}
+		}
+
+		for _, step := range run.ReadySteps() {
+			job, err := uc.jobs.FindByName(ctx, step.JobName)
+			if errors.Is(err, port.ErrJobNotFound) {
+				next, err := run.FailStep(step.Name, fmt.Sprintf("job %q is not registered", step.JobName), now)
+				if err != nil {
+					return err
+				}
+				if run, err = saveEODRun(ctx, uc.starter.eodRuns, uc.starter.publisher, next); err != nil {
+					return err
+				}
+				result.StepsFailed++
+				progressed = true
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to find job of step %s: %w", step.Name, err)
+			}
+
+			jobRun := model.NewJobRun(job, valueobject.RunTriggerEOD, run.BusinessDate(), now)
+			next, err := run.StartStep(step.Name, jobRun.ID(), now)
+			if err != nil {
+				return err
+			}
+			if run, err = saveEODRun(ctx, uc.starter.eodRuns, uc.starter.publisher, next); err != nil {
+				return err
+			}
+			result.StepsStarted++
+			progressed = true
+			if _, err := uc.runner.execute(ctx, job, jobRun); err != nil {
+				return fmt.Errorf("failed to run step %s: %w", step.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// settle applies the outcome of a running step's job run to the run,
+// returning the step's new status. The status is zero while the job run is
+// in progress or waiting for a retry.
+func (uc *AdvanceEODRunsUseCase) settle(ctx context.Context, run model.EODRun, step model.EODStep, now time.Time) (model.EODRun, valueobject.StepStatus, error) {
+	var cause string
+	if step.RunID == nil {
+		cause = "step has no job run"
+	} else {
+		jobRun, err := uc.runs.FindByID(ctx, *step.RunID)
+		switch {
+		case errors.Is(err, port.ErrRunNotFound):
+			// The step was checkpointed but its job run was never recorded,
+			// so it was not dispatched either.
+			cause = "job run was not recorded"
+		case err != nil:
+			return run, valueobject.StepStatus{}, fmt.Errorf("failed to find job run of step %s: %w", step.Name, err)
+		case jobRun.Status().Equal(valueobject.RunStatusSucceeded):
+			next, err := run.CompleteStep(step.Name, now)
+			return next, valueobject.StepStatusSucceeded, err
+		case jobRun.Status().Equal(valueobject.RunStatusFailed) && jobRun.NextRetryAt() == nil:
+			cause = jobRun.LastError()
+		default:
+			return run, valueobject.StepStatus{}, nil
+		}
+	}
+	next, err := run.FailStep(step.Name, cause, now)
+	return next, valueobject.StepStatusFailed, err
+}
+
+// ResumeEODRunUseCase restarts the failed steps of a failed end-of-day run.
+type ResumeEODRunUseCase struct {
+	eodRuns   port.EODRunRepository
+	publisher port.EventPublisher
+	clock     clock.Clock
+}
+
+// NewResumeEODRunUseCase creates a new ResumeEODRunUseCase reading the time
+// from clk.
+func NewResumeEODRunUseCase(eodRuns port.EODRunRepository, publisher port.EventPublisher, clk clock.Clock) *ResumeEODRunUseCase {
+	return &ResumeEODRunUseCase{eodRuns: eodRuns, publisher: publisher, clock: clk}
+}
+
+// Execute resumes the run. Its failed steps are started again, as new job
+// runs, by the coordinator's next pass.
+func (uc *ResumeEODRunUseCase) Execute(ctx context.Context, runID uuid.UUID) (dto.EODRunResponse, error) {
+	run, err := uc.eodRuns.FindByID(ctx, runID)
+	if err != nil {
+		return dto.EODRunResponse{}, fmt.Errorf("failed to find end-of-day run: %w", err)
+	}
+	run, err = run.Resume(uc.clock.Now())
+	if err != nil {
+		return dto.EODRunResponse{}, err
+	}
+	run, err = saveEODRun(ctx, uc.eodRuns, uc.publisher, run)
+	if err != nil {
+		return dto.EODRunResponse{}, err
+	}
+	return toEODRunResponse(run), nil
+}
+
+// GetEODRunUseCase retrieves an end-of-day run.
+type GetEODRunUseCase struct {
+	eodRuns port.EODRunRepository
+}
+
+// NewGetEODRunUseCase creates a new GetEODRunUseCase.
+func NewGetEODRunUseCase(eodRuns port.EODRunRepository) *GetEODRunUseCase {
+	return &GetEODRunUseCase{eodRuns: eodRuns}
+}
+
+// Execute retrieves the run.
+func (uc *GetEODRunUseCase) Execute(ctx context.Context, runID uuid.UUID) (dto.EODRunResponse, error) {
+	run, err := uc.eodRuns.FindByID(ctx, runID)
+	if err != nil {
+		return dto.EODRunResponse{}, fmt.Errorf("failed to find end-of-day run: %w", err)
+	}
+	return toEODRunResponse(run), nil
+}
+
+// ListEODRunsUseCase lists end-of-day runs for the operator dashboard.
+type ListEODRunsUseCase struct {
+	eodRuns port.EODRunRepository
+}
+
+// NewListEODRunsUseCase creates a new ListEODRunsUseCase.
+func NewListEODRunsUseCase(eodRuns port.EODRunRepository) *ListEODRunsUseCase {
+	return &ListEODRunsUseCase{eodRuns: eodRuns}
+}
+
+// Execute lists runs, latest business date first.
+func (uc *ListEODRunsUseCase) Execute(ctx context.Context, req dto.ListEODRunsRequest) (dto.ListEODRunsResponse, error) {
+	limit, offset := page(req.Limit, req.Offset)
+	runs, total, err := uc.eodRuns.List(ctx, limit, offset)
+	if err != nil {
+		return dto.ListEODRunsResponse{}, fmt.Errorf("failed to list end-of-day runs: %w", err)
+	}
+	responses := make([]dto.EODRunResponse, 0, len(runs))
+	for _, r := range runs {
+		responses = append(responses, toEODRunResponse(r))
+	}
+	return dto.ListEODRunsResponse{Runs: responses, TotalCount: total}, nil
+}
+
+func toEODRunResponse(r model.EODRun) dto.EODRunResponse {
+	steps := make([]dto.EODStepResponse, 0, len(r.Steps()))
+	for _, s := range r.Steps() {
+		steps = append(steps, dto.EODStepResponse{
+			Name:       s.Name,
+			JobName:    s.JobName,
+			DependsOn:  s.DependsOn,
+			Status:     s.Status.String(),
+			RunID:      s.RunID,
+			Error:      s.Error,
+			StartedAt:  s.StartedAt,
+			FinishedAt: s.FinishedAt,
+		})
+	}
+	return dto.EODRunResponse{
+		ID:           r.ID(),
+		BusinessDate: r.BusinessDate(),
+		Trigger:      r.Trigger().String(),
+		Status:       r.Status().String(),
+		Steps:        steps,
+		StartedAt:    r.StartedAt(),
+		UpdatedAt:    r.UpdatedAt(),
+		FinishedAt:   r.FinishedAt(),
+		Version:      r.Version(),
+	}
+}
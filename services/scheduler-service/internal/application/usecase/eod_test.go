@@ -0,0 +1,171 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/clock"
+	"github.com/bibbank/bib/services/scheduler-service/internal/application/dto"
+	"github.com/bibbank/bib/services/scheduler-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/port"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+)
+
+var businessDate = time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+func eodPlan(t *testing.T) model.EODPlan {
+	t.Helper()
+	plan, err := model.NewEODPlan([]model.EODStepDefinition{
+		{Name: "payments.cutoff"},
+		{Name: "deposits.accrue", DependsOn: []string{"payments.cutoff"}},
+	})
+	require.NoError(t, err)
+	return plan
+}
+
+// registerEODJob registers a job run only by end-of-day steps: it is paused
+// so its own schedule never fires.
+func (f *fixture) registerEODJob(t *testing.T, name string, maxAttempts int) {
+	t.Helper()
+	req := registerRequest(name, maxAttempts)
+	req.Definition.Target.Payload = json.RawMessage(`{"as_of_date":"{{business_date}}"}`)
+	job := f.register(t, req)
+	_, err := usecase.NewPauseJobUseCase(f.jobs, f.pub).Execute(context.Background(), job.ID)
+	require.NoError(t, err)
+}
+
+// eodEventTypes returns the published event types other than job
+// registrations and pauses.
+func (f *fixture) eodEventTypes() []string {
+	var types []string
+	for _, et := range f.pub.eventTypes() {
+		if !strings.HasPrefix(et, "scheduler.job.") {
+			types = append(types, et)
+		}
+	}
+	return types
+}
+
+func (f *fixture) advanceEOD(t *testing.T, schedule valueobject.CronSchedule) *usecase.AdvanceEODRunsUseCase {
+	t.Helper()
+	return usecase.NewAdvanceEODRunsUseCase(eodPlan(t), schedule, f.eodRuns, f.jobs, f.runs, f.runner, f.pub, nil)
+}
+
+func TestAdvanceEODRuns_RunsStepsInOrder(t *testing.T) {
+	f := newFixture()
+	f.registerEODJob(t, "payments.cutoff", 1)
+	f.registerEODJob(t, "deposits.accrue", 1)
+	start := usecase.NewStartEODRunUseCase(eodPlan(t), f.eodRuns, f.pub, clock.System)
+
+	_, err := start.Execute(context.Background(), dto.StartEODRunRequest{BusinessDate: time.Now().Add(48 * time.Hour)})
+	assert.ErrorIs(t, err, usecase.ErrInvalidEODRun, "the business date has not begun")
+
+	run, err := start.Execute(context.Background(), dto.StartEODRunRequest{BusinessDate: businessDate.Add(15 * time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, businessDate, run.BusinessDate)
+	assert.Equal(t, "MANUAL", run.Trigger)
+	_, err = start.Execute(context.Background(), dto.StartEODRunRequest{BusinessDate: businessDate})
+	assert.ErrorIs(t, err, port.ErrEODRunExists)
+
+	result, err := f.advanceEOD(t, valueobject.CronSchedule{}).Execute(context.Background(), time.Now().UTC())
+	require.NoError(t, err)
+	assert.Equal(t, dto.AdvanceEODResult{StepsStarted: 2, StepsSucceeded: 2, RunsCompleted: 1}, result)
+
+	require.Len(t, f.dispatcher.dispatched, 2)
+	assert.Equal(t, "payments.cutoff", f.dispatcher.dispatched[0].JobName())
+	assert.Equal(t, "deposits.accrue", f.dispatcher.dispatched[1].JobName())
+	assert.Equal(t, valueobject.RunTriggerEOD, f.dispatcher.dispatched[0].Trigger())
+	assert.JSONEq(t, `{"as_of_date":"2026-03-10"}`, string(f.dispatcher.payloads[0]), "the payload names the business date")
+
+	stored, err := usecase.NewGetEODRunUseCase(f.eodRuns).Execute(context.Background(), run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "SUCCEEDED", stored.Status)
+	for _, s := range stored.Steps {
+		assert.Equal(t, "SUCCEEDED", s.Status, s.Name)
+		assert.NotNil(t, s.RunID, s.Name)
+	}
+	assert.Contains(t, f.pub.eventTypes(), "scheduler.eod.completed")
+}
+
+func TestAdvanceEODRuns_FailedStepBlocksUntilResumed(t *testing.T) {
+	f := newFixture()
+	f.registerEODJob(t, "payments.cutoff", 2)
+	advance := f.advanceEOD(t, valueobject.CronSchedule{})
+	run, err := usecase.NewStartEODRunUseCase(eodPlan(t), f.eodRuns, f.pub, clock.System).
+		Execute(context.Background(), dto.StartEODRunRequest{BusinessDate: businessDate})
+	require.NoError(t, err)
+
+	// The cutoff fails its first attempt and is retried by the job runner.
+	f.dispatcher.err = errors.New("broker unavailable")
+	now := time.Now().UTC()
+	result, err := advance.Execute(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, dto.AdvanceEODResult{StepsStarted: 1}, result, "the step waits for its retry")
+
+	f.dispatcher.err = nil
+	_, err = usecase.NewRunDueJobsUseCase(f.jobs, f.runs, f.runner, nil).Execute(context.Background(), now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"as_of_date":"2026-03-10"}`, string(f.dispatcher.payloads[1]), "retries keep the business date")
+
+	// The accrual job was never registered.
+	result, err = advance.Execute(context.Background(), now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, dto.AdvanceEODResult{StepsSucceeded: 1, StepsFailed: 1}, result)
+
+	failed, err := usecase.NewGetEODRunUseCase(f.eodRuns).Execute(context.Background(), run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "FAILED", failed.Status)
+	assert.Equal(t, `job "deposits.accrue" is not registered`, failed.Steps[1].Error)
+
+	_, err = usecase.NewStartEODRunUseCase(eodPlan(t), f.eodRuns, f.pub, clock.System).
+		Execute(context.Background(), dto.StartEODRunRequest{BusinessDate: businessDate.AddDate(0, 0, 1)})
+	assert.ErrorIs(t, err, usecase.ErrEODRunInProgress, "business dates close in order")
+
+	f.registerEODJob(t, "deposits.accrue", 1)
+	resumed, err := usecase.NewResumeEODRunUseCase(f.eodRuns, f.pub, clock.System).Execute(context.Background(), run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "RUNNING", resumed.Status)
+
+	result, err = advance.Execute(context.Background(), now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, dto.AdvanceEODResult{StepsStarted: 1, StepsSucceeded: 1, RunsCompleted: 1}, result)
+	assert.Equal(t, []string{
+		"scheduler.eod.started", "scheduler.run.failed", "scheduler.run.succeeded",
+		"scheduler.eod.failed", "scheduler.eod.resumed", "scheduler.run.succeeded",
+		"scheduler.eod.completed",
+	}, f.eodEventTypes())
+}
+
+func TestAdvanceEODRuns_StartsOnSchedule(t *testing.T) {
+	f := newFixture()
+	f.registerEODJob(t, "payments.cutoff", 1)
+	f.registerEODJob(t, "deposits.accrue", 1)
+	schedule, err := valueobject.NewCronSchedule("0 22 * * *")
+	require.NoError(t, err)
+	advance := f.advanceEOD(t, schedule)
+
+	result, err := advance.Execute(context.Background(), businessDate.Add(21*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, dto.AdvanceEODResult{}, result, "not yet due")
+
+	result, err = advance.Execute(context.Background(), businessDate.Add(22*time.Hour+time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, dto.AdvanceEODResult{RunsStarted: 1, StepsStarted: 2, StepsSucceeded: 2, RunsCompleted: 1}, result)
+
+	result, err = advance.Execute(context.Background(), businessDate.Add(23*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, dto.AdvanceEODResult{}, result, "one run per business date")
+
+	runs, err := usecase.NewListEODRunsUseCase(f.eodRuns).Execute(context.Background(), dto.ListEODRunsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, runs.TotalCount)
+	assert.Equal(t, "SCHEDULED", runs.Runs[0].Trigger)
+}
@@ -0,0 +1,246 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/scheduler-service/internal/application/dto"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/port"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+)
+
+// ErrInvalidJob is returned when a job, or a change to it, is malformed.
+var ErrInvalidJob = errors.New("invalid job")
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// RegisterJobUseCase registers a job with the scheduler.
+type RegisterJobUseCase struct {
+	jobs      port.JobRepository
+	publisher port.EventPublisher
+}
+
+// NewRegisterJobUseCase creates a new RegisterJobUseCase.
+func NewRegisterJobUseCase(jobs port.JobRepository, publisher port.EventPublisher) *RegisterJobUseCase {
+	return &RegisterJobUseCase{jobs: jobs, publisher: publisher}
+}
+
+// Execute registers the job. It first runs the next time its schedule fires.
+func (uc *RegisterJobUseCase) Execute(ctx context.Context, req dto.RegisterJobRequest) (dto.JobResponse, error) {
+	def, err := toDefinition(req.Definition)
+	if err != nil {
+		return dto.JobResponse{}, fmt.Errorf("%w: %w", ErrInvalidJob, err)
+	}
+	job, err := model.NewJob(req.Name, def, time.Now().UTC())
+	if err != nil {
+		return dto.JobResponse{}, fmt.Errorf("%w: %w", ErrInvalidJob, err)
+	}
+	if err := saveAndPublishJob(ctx, uc.jobs, uc.publisher, job); err != nil {
+		return dto.JobResponse{}, err
+	}
+	return toJobResponse(job), nil
+}
+
+// UpdateJobUseCase replaces a job's definition.
+type UpdateJobUseCase struct {
+	jobs      port.JobRepository
+	publisher port.EventPublisher
+}
+
+// NewUpdateJobUseCase creates a new UpdateJobUseCase.
+func NewUpdateJobUseCase(jobs port.JobRepository, publisher port.EventPublisher) *UpdateJobUseCase {
+	return &UpdateJobUseCase{jobs: jobs, publisher: publisher}
+}
+
+// Execute replaces the job's definition.
+func (uc *UpdateJobUseCase) Execute(ctx context.Context, req dto.UpdateJobRequest) (dto.JobResponse, error) {
+	def, err := toDefinition(req.Definition)
+	if err != nil {
+		return dto.JobResponse{}, fmt.Errorf("%w: %w", ErrInvalidJob, err)
+	}
+	return modifyJob(ctx, uc.jobs, uc.publisher, req.JobID, func(j model.Job) (model.Job, error) {
+		return j.Update(def, time.Now().UTC())
+	})
+}
+
+// PauseJobUseCase stops a job running on its schedule.
+type PauseJobUseCase struct {
+	jobs      port.JobRepository
+	publisher port.EventPublisher
+}
+
+// NewPauseJobUseCase creates a new PauseJobUseCase.
+func NewPauseJobUseCase(jobs port.JobRepository, publisher port.EventPublisher) *PauseJobUseCase {
+	return &PauseJobUseCase{jobs: jobs, publisher: publisher}
+}
+
+// Execute pauses the job.
+func (uc *PauseJobUseCase) Execute(ctx context.Context, jobID uuid.UUID) (dto.JobResponse, error) {
+	return modifyJob(ctx, uc.jobs, uc.publisher, jobID, func(j model.Job) (model.Job, error) {
+		return j.Pause(time.Now().UTC())
+	})
+}
+
+// ResumeJobUseCase runs a paused job on its schedule again.
+type ResumeJobUseCase struct {
+	jobs      port.JobRepository
+	publisher port.EventPublisher
+}
+
+// NewResumeJobUseCase creates a new ResumeJobUseCase.
+func NewResumeJobUseCase(jobs port.JobRepository, publisher port.EventPublisher) *ResumeJobUseCase {
+	return &ResumeJobUseCase{jobs: jobs, publisher: publisher}
+}
+
+// Execute resumes the job.
+func (uc *ResumeJobUseCase) Execute(ctx context.Context, jobID uuid.UUID) (dto.JobResponse, error) {
+	return modifyJob(ctx, uc.jobs, uc.publisher, jobID, func(j model.Job) (model.Job, error) {
+		return j.Resume(time.Now().UTC())
+	})
+}
+
+// GetJobUseCase retrieves a job.
+type GetJobUseCase struct {
+	jobs port.JobRepository
+}
+
+// NewGetJobUseCase creates a new GetJobUseCase.
+func NewGetJobUseCase(jobs port.JobRepository) *GetJobUseCase {
+	return &GetJobUseCase{jobs: jobs}
+}
+
+// Execute retrieves the job.
+func (uc *GetJobUseCase) Execute(ctx context.Context, jobID uuid.UUID) (dto.JobResponse, error) {
+	job, err := uc.jobs.FindByID(ctx, jobID)
+	if err != nil {
+		return dto.JobResponse{}, fmt.Errorf("failed to find job: %w", err)
+	}
+	return toJobResponse(job), nil
+}
+
+// ListJobsUseCase lists jobs with pagination.
+type ListJobsUseCase struct {
+	jobs port.JobRepository
+}
+
+// NewListJobsUseCase creates a new ListJobsUseCase.
+func NewListJobsUseCase(jobs port.JobRepository) *ListJobsUseCase {
+	return &ListJobsUseCase{jobs: jobs}
+}
+
+// Execute lists jobs ordered by name.
+func (uc *ListJobsUseCase) Execute(ctx context.Context, req dto.ListJobsRequest) (dto.ListJobsResponse, error) {
+	limit, offset := page(req.Limit, req.Offset)
+	jobs, total, err := uc.jobs.List(ctx, limit, offset)
+	if err != nil {
+		return dto.ListJobsResponse{}, fmt.Errorf("failed to list jobs: %w", err)
+	}
+	responses := make([]dto.JobResponse, 0, len(jobs))
+	for _, j := range jobs {
+		responses = append(responses, toJobResponse(j))
+	}
+	return dto.ListJobsResponse{Jobs: responses, TotalCount: total}, nil
+}
+
+func modifyJob(
+	ctx context.Context,
+	jobs port.JobRepository,
+	publisher port.EventPublisher,
+	jobID uuid.UUID,
+	change func(model.Job) (model.Job, error),
+) (dto.JobResponse, error) {
+	job, err := jobs.FindByID(ctx, jobID)
+	if err != nil {
+		return dto.JobResponse{}, fmt.Errorf("failed to find job: %w", err)
+	}
+	job, err = change(job)
+	if errors.Is(err, model.ErrInvalidTransition) {
+		return dto.JobResponse{}, err
+	}
+	if err != nil {
+		return dto.JobResponse{}, fmt.Errorf("%w: %w", ErrInvalidJob, err)
+	}
+	if err := saveAndPublishJob(ctx, jobs, publisher, job); err != nil {
+		return dto.JobResponse{}, err
+	}
+	return toJobResponse(job), nil
+}
+
+// saveAndPublishJob persists the job, then publishes its events.
+func saveAndPublishJob(ctx context.Context, jobs port.JobRepository, publisher port.EventPublisher, job model.Job) error {
+	if err := jobs.Save(ctx, job); err != nil {
+		return fmt.Errorf("failed to save job: %w", err)
+	}
+	if events := job.DomainEvents(); len(events) > 0 {
+		if err := publisher.Publish(ctx, events); err != nil {
+			return fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+	return nil
+}
+
+func page(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	return min(limit, maxLimit), max(offset, 0)
+}
+
+func toDefinition(d dto.JobDefinitionDTO) (model.JobDefinition, error) {
+	kind, err := valueobject.NewTargetKind(d.Target.Kind)
+	if err != nil {
+		return model.JobDefinition{}, err
+	}
+	return model.JobDefinition{
+		Description: d.Description,
+		Schedule:    d.Schedule,
+		Target: model.JobTarget{
+			Kind:    kind,
+			Service: d.Target.Service,
+			Method:  d.Target.Method,
+			Topic:   d.Target.Topic,
+			Payload: d.Target.Payload,
+		},
+		Retry: model.RetryPolicy{
+			MaxAttempts: d.Retry.MaxAttempts,
+			Backoff:     d.Retry.Backoff,
+		},
+	}, nil
+}
+
+func toJobResponse(j model.Job) dto.JobResponse {
+	target := j.Target()
+	return dto.JobResponse{
+		ID:   j.ID(),
+		Name: j.Name(),
+		Definition: dto.JobDefinitionDTO{
+			Description: j.Description(),
+			Schedule:    j.Schedule().String(),
+			Target: dto.JobTargetDTO{
+				Kind:    target.Kind.String(),
+				Service: target.Service,
+				Method:  target.Method,
+				Topic:   target.Topic,
+				Payload: target.Payload,
+			},
+			Retry: dto.RetryPolicyDTO{
+				MaxAttempts: j.Retry().MaxAttempts,
+				Backoff:     j.Retry().Backoff,
+			},
+		},
+		Enabled:   j.Enabled(),
+		NextRunAt: j.NextRunAt(),
+		LastRunAt: j.LastRunAt(),
+		Version:   j.Version(),
+		CreatedAt: j.CreatedAt(),
+		UpdatedAt: j.UpdatedAt(),
+	}
+}
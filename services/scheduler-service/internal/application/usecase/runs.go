@@ -0,0 +1,287 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/clock"
+	"github.com/bibbank/bib/services/scheduler-service/internal/application/dto"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/port"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+)
+
+// dueBatchSize bounds the jobs and retries started by one scheduler pass;
+// the rest are started by the next.
+const dueBatchSize = 100
+
+// Runner dispatches job runs and records their outcome.
+type Runner struct {
+	runs       port.RunRepository
+	dispatcher port.Dispatcher
+	publisher  port.EventPublisher
+	clock      clock.Clock
+	timeout    time.Duration
+}
+
+// NewRunner creates a Runner reading the time from clk. Each dispatch is
+// bounded by timeout; zero means no bound beyond the caller's context.
+func NewRunner(runs port.RunRepository, dispatcher port.Dispatcher, publisher port.EventPublisher, timeout time.Duration, clk clock.Clock) *Runner {
+	return &Runner{runs: runs, dispatcher: dispatcher, publisher: publisher, timeout: timeout, clock: clk}
+}
+
+// execute records the RUNNING run, dispatches its command and records the
+// outcome. A dispatch failure is recorded on the run rather than returned.
+// The run of an end-of-day step, real or simulated, is dispatched for the
+// step's business date, which is the run's scheduled time.
+func (r *Runner) execute(ctx context.Context, job model.Job, run model.JobRun) (model.JobRun, error) {
+	if run.Trigger().Equal(valueobject.RunTriggerEOD) || run.Trigger().Equal(valueobject.RunTriggerSimulation) {
+		job = job.ForBusinessDate(run.ScheduledFor())
+	}
+	if err := r.runs.Save(ctx, run); err != nil {
+		return run, fmt.Errorf("failed to save run: %w", err)
+	}
+
+	dispatchCtx := ctx
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		dispatchCtx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+	dispatchErr := r.dispatcher.Dispatch(dispatchCtx, job, run)
+
+	var err error
+	now := r.clock.Now()
+	if dispatchErr == nil {
+		run, err = run.Succeed(now)
+	} else {
+		run, err = run.Fail(dispatchErr, job.Retry(), now)
+	}
+	if err != nil {
+		return run, err
+	}
+	if err := r.runs.Save(ctx, run); err != nil {
+		return run, fmt.Errorf("failed to save run: %w", err)
+	}
+	if err := r.publisher.Publish(ctx, run.DomainEvents()); err != nil {
+		return run, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return run.ClearDomainEvents(), nil
+}
+
+// RunDueJobsUseCase starts the runs of jobs whose schedule has fired and
+// retries failed runs whose retry is due. Only one replica should run it at
+// a time.
+type RunDueJobsUseCase struct {
+	jobs   port.JobRepository
+	runs   port.RunRepository
+	runner *Runner
+	logger *slog.Logger
+}
+
+// NewRunDueJobsUseCase creates a new RunDueJobsUseCase. logger may be nil.
+func NewRunDueJobsUseCase(jobs port.JobRepository, runs port.RunRepository, runner *Runner, logger *slog.Logger) *RunDueJobsUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RunDueJobsUseCase{jobs: jobs, runs: runs, runner: runner, logger: logger}
+}
+
+// Execute runs every job due at now and retries every run due at now. A job
+// or run that cannot be recorded is logged and left for the next pass.
+func (uc *RunDueJobsUseCase) Execute(ctx context.Context, now time.Time) (dto.RunDueJobsResult, error) {
+	var result dto.RunDueJobsResult
+
+	// Retries are started first so a run that fails in this pass is not
+	// retried before its backoff.
+	retries, err := uc.runs.FindRetryDue(ctx, now, dueBatchSize)
+	if err != nil {
+		return result, fmt.Errorf("failed to find runs to retry: %w", err)
+	}
+	for _, run := range retries {
+		job, err := uc.jobs.FindByID(ctx, run.JobID())
+		if err != nil {
+			uc.logger.Error("failed to find job of run", "run_id", run.ID(), "error", err)
+			continue
+		}
+		run, err = run.Retry(now)
+		if err != nil {
+			continue
+		}
+		result.Retried++
+		uc.record(&result, uc.runOnce(ctx, job, run))
+	}
+
+	due, err := uc.jobs.FindDue(ctx, now, dueBatchSize)
+	if err != nil {
+		return result, fmt.Errorf("failed to find due jobs: %w", err)
+	}
+	for _, job := range due {
+		job, scheduledFor, err := job.Advance(now)
+		if err != nil {
+			continue
+		}
+		// Saving the advanced job claims the run: a concurrent pass that
+		// loaded the same job fails with a version conflict.
+		if err := uc.jobs.Save(ctx, job); err != nil {
+			if !errors.Is(err, port.ErrVersionConflict) {
+				uc.logger.Error("failed to advance job", "job", job.Name(), "error", err)
+			}
+			continue
+		}
+		run := model.NewJobRun(job, valueobject.RunTriggerScheduled, scheduledFor, now)
+		result.Started++
+		uc.record(&result, uc.runOnce(ctx, job, run))
+	}
+	return result, nil
+}
+
+func (uc *RunDueJobsUseCase) runOnce(ctx context.Context, job model.Job, run model.JobRun) model.JobRun {
+	run, err := uc.runner.execute(ctx, job, run)
+	if err != nil && !errors.Is(err, port.ErrVersionConflict) {
+		uc.logger.Error("failed to record job run", "job", job.Name(), "run_id", run.ID(), "error", err)
+	}
+	if run.Status().Equal(valueobject.RunStatusFailed) {
+		uc.logger.Warn("job run failed", "job", job.Name(), "run_id", run.ID(),
+			"attempt", run.Attempt(), "error", run.LastError(), "next_retry_at", run.NextRetryAt())
+	}
+	return run
+}
+
+func (uc *RunDueJobsUseCase) record(result *dto.RunDueJobsResult, run model.JobRun) {
+	switch {
+	case run.Status().Equal(valueobject.RunStatusSucceeded):
+		result.Succeeded++
+	case run.Status().Equal(valueobject.RunStatusFailed):
+		result.Failed++
+	}
+}
+
+// TriggerJobUseCase runs a job now, outside its schedule.
+type TriggerJobUseCase struct {
+	jobs   port.JobRepository
+	runner *Runner
+}
+
+// NewTriggerJobUseCase creates a new TriggerJobUseCase.
+func NewTriggerJobUseCase(jobs port.JobRepository, runner *Runner) *TriggerJobUseCase {
+	return &TriggerJobUseCase{jobs: jobs, runner: runner}
+}
+
+// Execute starts a MANUAL run of the job and waits for its command to be
+// dispatched. Paused jobs can be triggered; the job's schedule is unchanged.
+func (uc *TriggerJobUseCase) Execute(ctx context.Context, jobID uuid.UUID) (dto.RunResponse, error) {
+	job, err := uc.jobs.FindByID(ctx, jobID)
+	if err != nil {
+		return dto.RunResponse{}, fmt.Errorf("failed to find job: %w", err)
+	}
+	now := uc.runner.clock.Now()
+	run, err := uc.runner.execute(ctx, job, model.NewJobRun(job, valueobject.RunTriggerManual, now, now))
+	if err != nil {
+		return dto.RunResponse{}, err
+	}
+	return toRunResponse(run), nil
+}
+
+// RetryRunUseCase retries a failed run now.
+type RetryRunUseCase struct {
+	jobs   port.JobRepository
+	runs   port.RunRepository
+	runner *Runner
+}
+
+// NewRetryRunUseCase creates a new RetryRunUseCase.
+func NewRetryRunUseCase(jobs port.JobRepository, runs port.RunRepository, runner *Runner) *RetryRunUseCase {
+	return &RetryRunUseCase{jobs: jobs, runs: runs, runner: runner}
+}
+
+// Execute starts the next attempt of the run, even one that has used all its
+// attempts, and waits for its command to be dispatched.
+func (uc *RetryRunUseCase) Execute(ctx context.Context, runID uuid.UUID) (dto.RunResponse, error) {
+	run, err := uc.runs.FindByID(ctx, runID)
+	if err != nil {
+		return dto.RunResponse{}, fmt.Errorf("failed to find run: %w", err)
+	}
+	job, err := uc.jobs.FindByID(ctx, run.JobID())
+	if err != nil {
+		return dto.RunResponse{}, fmt.Errorf("failed to find job: %w", err)
+	}
+	run, err = run.Retry(uc.runner.clock.Now())
+	if err != nil {
+		return dto.RunResponse{}, err
+	}
+	run, err = uc.runner.execute(ctx, job, run)
+	if err != nil {
+		return dto.RunResponse{}, err
+	}
+	return toRunResponse(run), nil
+}
+
+// GetRunUseCase retrieves a job run.
+type GetRunUseCase struct {
+	runs port.RunRepository
+}
+
+// NewGetRunUseCase creates a new GetRunUseCase.
+func NewGetRunUseCase(runs port.RunRepository) *GetRunUseCase {
+	return &GetRunUseCase{runs: runs}
+}
+
+// Execute retrieves the run.
+func (uc *GetRunUseCase) Execute(ctx context.Context, runID uuid.UUID) (dto.RunResponse, error) {
+	run, err := uc.runs.FindByID(ctx, runID)
+	if err != nil {
+		return dto.RunResponse{}, fmt.Errorf("failed to find run: %w", err)
+	}
+	return toRunResponse(run), nil
+}
+
+// ListRunsUseCase lists a job's run history.
+type ListRunsUseCase struct {
+	jobs port.JobRepository
+	runs port.RunRepository
+}
+
+// NewListRunsUseCase creates a new ListRunsUseCase.
+func NewListRunsUseCase(jobs port.JobRepository, runs port.RunRepository) *ListRunsUseCase {
+	return &ListRunsUseCase{jobs: jobs, runs: runs}
+}
+
+// Execute lists the job's runs, most recent first.
+func (uc *ListRunsUseCase) Execute(ctx context.Context, req dto.ListRunsRequest) (dto.ListRunsResponse, error) {
+	if _, err := uc.jobs.FindByID(ctx, req.JobID); err != nil {
+		return dto.ListRunsResponse{}, fmt.Errorf("failed to find job: %w", err)
+	}
+	limit, offset := page(req.Limit, req.Offset)
+	runs, total, err := uc.runs.ListByJob(ctx, req.JobID, limit, offset)
+	if err != nil {
+		return dto.ListRunsResponse{}, fmt.Errorf("failed to list runs: %w", err)
+	}
+	responses := make([]dto.RunResponse, 0, len(runs))
+	for _, r := range runs {
+		responses = append(responses, toRunResponse(r))
+	}
+	return dto.ListRunsResponse{Runs: responses, TotalCount: total}, nil
+}
+
+func toRunResponse(r model.JobRun) dto.RunResponse {
+	return dto.RunResponse{
+		ID:           r.ID(),
+		JobID:        r.JobID(),
+		JobName:      r.JobName(),
+		Trigger:      r.Trigger().String(),
+		Status:       r.Status().String(),
+		ScheduledFor: r.ScheduledFor(),
+		Attempt:      r.Attempt(),
+		MaxAttempts:  r.MaxAttempts(),
+		Error:        r.LastError(),
+		StartedAt:    r.StartedAt(),
+		FinishedAt:   r.FinishedAt(),
+		NextRetryAt:  r.NextRetryAt(),
+	}
+}
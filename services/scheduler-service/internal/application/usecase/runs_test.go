@@ -0,0 +1,163 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/clock"
+	"github.com/bibbank/bib/services/scheduler-service/internal/application/dto"
+	"github.com/bibbank/bib/services/scheduler-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/port"
+)
+
+func registerRequest(name string, maxAttempts int) dto.RegisterJobRequest {
+	return dto.RegisterJobRequest{
+		Name: name,
+		Definition: dto.JobDefinitionDTO{
+			Schedule: "*/5 * * * *",
+			Target: dto.JobTargetDTO{
+				Kind:    "KAFKA",
+				Topic:   "reporting-commands",
+				Payload: json.RawMessage(`{"report_type":"COREP"}`),
+			},
+			Retry: dto.RetryPolicyDTO{MaxAttempts: maxAttempts},
+		},
+	}
+}
+
+type fixture struct {
+	jobs       *inMemoryJobRepo
+	runs       *inMemoryRunRepo
+	dispatcher *fakeDispatcher
+	pub        *recordingPublisher
+	eodRuns    *inMemoryEODRunRepo
+	runner     *usecase.Runner
+}
+
+func newFixture() *fixture {
+	f := &fixture{
+		jobs:       &inMemoryJobRepo{},
+		runs:       &inMemoryRunRepo{},
+		dispatcher: &fakeDispatcher{},
+		pub:        &recordingPublisher{},
+		eodRuns:    &inMemoryEODRunRepo{},
+	}
+	f.runner = usecase.NewRunner(f.runs, f.dispatcher, f.pub, time.Second, clock.System)
+	return f
+}
+
+func (f *fixture) register(t *testing.T, req dto.RegisterJobRequest) dto.JobResponse {
+	t.Helper()
+	job, err := usecase.NewRegisterJobUseCase(f.jobs, f.pub).Execute(context.Background(), req)
+	require.NoError(t, err)
+	return job
+}
+
+func TestRegisterJob_Validation(t *testing.T) {
+	f := newFixture()
+	uc := usecase.NewRegisterJobUseCase(f.jobs, f.pub)
+
+	f.register(t, registerRequest("reporting.corep", 1))
+	_, err := uc.Execute(context.Background(), registerRequest("reporting.corep", 1))
+	assert.ErrorIs(t, err, port.ErrJobNameTaken)
+
+	req := registerRequest("reporting.finrep", 1)
+	req.Definition.Schedule = "every day"
+	_, err = uc.Execute(context.Background(), req)
+	assert.ErrorIs(t, err, usecase.ErrInvalidJob)
+
+	req = registerRequest("reporting.finrep", 1)
+	req.Definition.Target.Kind = "HTTP"
+	_, err = uc.Execute(context.Background(), req)
+	assert.ErrorIs(t, err, usecase.ErrInvalidJob)
+}
+
+func TestRunDueJobs_RunsEachDueJobOnce(t *testing.T) {
+	f := newFixture()
+	job := f.register(t, registerRequest("reporting.corep", 1))
+	runDue := usecase.NewRunDueJobsUseCase(f.jobs, f.runs, f.runner, nil)
+
+	result, err := runDue.Execute(context.Background(), job.NextRunAt.Add(-time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunDueJobsResult{}, result, "not yet due")
+
+	now := job.NextRunAt.Add(time.Second)
+	result, err = runDue.Execute(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunDueJobsResult{Started: 1, Succeeded: 1}, result)
+	require.Len(t, f.dispatcher.dispatched, 1)
+	assert.Equal(t, job.NextRunAt, f.dispatcher.dispatched[0].ScheduledFor())
+
+	result, err = runDue.Execute(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunDueJobsResult{}, result, "the job was advanced past now")
+
+	stored, err := usecase.NewGetJobUseCase(f.jobs).Execute(context.Background(), job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, job.NextRunAt.Add(5*time.Minute), stored.NextRunAt)
+
+	runs, err := usecase.NewListRunsUseCase(f.jobs, f.runs).Execute(context.Background(), dto.ListRunsRequest{JobID: job.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, runs.TotalCount)
+	assert.Equal(t, "SUCCEEDED", runs.Runs[0].Status)
+	assert.Equal(t, "SCHEDULED", runs.Runs[0].Trigger)
+	assert.Contains(t, f.pub.eventTypes(), "scheduler.run.succeeded")
+}
+
+func TestRunDueJobs_RetriesFailedRuns(t *testing.T) {
+	f := newFixture()
+	job := f.register(t, registerRequest("reporting.corep", 2))
+	runDue := usecase.NewRunDueJobsUseCase(f.jobs, f.runs, f.runner, nil)
+	f.dispatcher.err = errors.New("broker unavailable")
+
+	now := job.NextRunAt.Add(time.Second)
+	result, err := runDue.Execute(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunDueJobsResult{Started: 1, Failed: 1}, result)
+
+	f.dispatcher.err = nil
+	result, err = runDue.Execute(context.Background(), now.Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunDueJobsResult{Retried: 1, Succeeded: 1}, result)
+
+	require.Len(t, f.dispatcher.dispatched, 2)
+	first, retry := f.dispatcher.dispatched[0], f.dispatcher.dispatched[1]
+	assert.Equal(t, first.ID(), retry.ID(), "a retry is another attempt of the same run")
+	assert.Equal(t, 2, retry.Attempt())
+	assert.Equal(t, []string{"scheduler.job.registered", "scheduler.run.failed", "scheduler.run.succeeded"}, f.pub.eventTypes())
+}
+
+func TestTriggerJob_AndRetryRun(t *testing.T) {
+	f := newFixture()
+	job := f.register(t, registerRequest("accounts.dormancy-sweep", 1))
+	_, err := usecase.NewPauseJobUseCase(f.jobs, f.pub).Execute(context.Background(), job.ID)
+	require.NoError(t, err)
+
+	f.dispatcher.err = errors.New("broker unavailable")
+	run, err := usecase.NewTriggerJobUseCase(f.jobs, f.runner).Execute(context.Background(), job.ID)
+	require.NoError(t, err, "a dispatch failure is recorded on the run")
+	assert.Equal(t, "MANUAL", run.Trigger)
+	assert.Equal(t, "FAILED", run.Status)
+	assert.Nil(t, run.NextRetryAt, "no attempts left")
+
+	f.dispatcher.err = nil
+	retryRun := usecase.NewRetryRunUseCase(f.jobs, f.runs, f.runner)
+	run, err = retryRun.Execute(context.Background(), run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "SUCCEEDED", run.Status)
+	assert.Equal(t, 2, run.Attempt)
+
+	_, err = retryRun.Execute(context.Background(), run.ID)
+	assert.Error(t, err, "only failed runs can be retried")
+
+	stored, err := usecase.NewGetJobUseCase(f.jobs).Execute(context.Background(), job.ID)
+	require.NoError(t, err)
+	assert.False(t, stored.Enabled, "triggering a paused job leaves it paused")
+	assert.Nil(t, stored.LastRunAt, "manual runs do not advance the schedule")
+}
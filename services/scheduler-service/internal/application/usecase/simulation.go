@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/clock"
+	"github.com/bibbank/bib/services/scheduler-service/internal/application/dto"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/port"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+)
+
+// ErrInvalidSimulation is returned when a sandbox tenant's clock cannot be
+// advanced as requested.
+var ErrInvalidSimulation = errors.New("invalid simulation")
+
+// ErrSimulationStepFailed is returned when a step of a simulated end of day
+// fails. The tenant's clock stays on that date, so the next advance runs it
+// again.
+var ErrSimulationStepFailed = errors.New("simulated end-of-day step failed")
+
+// AdvanceTenantClockUseCase moves a sandbox tenant's virtual time forward,
+// running the end-of-day plan for each day it passes on the tenant's behalf
+// only. The real business date and every other tenant are unaffected.
+type AdvanceTenantClockUseCase struct {
+	plan   model.EODPlan
+	clocks port.TenantClockRepository
+	jobs   port.JobRepository
+	runner *Runner
+	clock  clock.Clock
+}
+
+// NewAdvanceTenantClockUseCase creates a new AdvanceTenantClockUseCase
+// reading the real time from clk.
+func NewAdvanceTenantClockUseCase(
+	plan model.EODPlan,
+	clocks port.TenantClockRepository,
+	jobs port.JobRepository,
+	runner *Runner,
+	clk clock.Clock,
+) *AdvanceTenantClockUseCase {
+	return &AdvanceTenantClockUseCase{plan: plan, clocks: clocks, jobs: jobs, runner: runner, clock: clk}
+}
+
+// Execute simulates the end of day of the tenant's business date req.Days
+// times. Each day's steps run in plan order and the clock is saved after
+// every day, so a failure keeps the days already simulated.
+func (uc *AdvanceTenantClockUseCase) Execute(ctx context.Context, req dto.AdvanceTenantClockRequest) (dto.TenantClockResponse, error) {
+	if req.TenantID == uuid.Nil {
+		return dto.TenantClockResponse{}, fmt.Errorf("%w: tenant ID is required", ErrInvalidSimulation)
+	}
+	if req.Days < 1 || req.Days > model.MaxSimulatedDays {
+		return dto.TenantClockResponse{}, fmt.Errorf("%w: days must be between 1 and %d", ErrInvalidSimulation, model.MaxSimulatedDays)
+	}
+
+	tc, err := findTenantClock(ctx, uc.clocks, req.TenantID, uc.clock.Now())
+	if err != nil {
+		return dto.TenantClockResponse{}, err
+	}
+
+	resp := dto.TenantClockResponse{TenantID: req.TenantID}
+	for day := 0; day < req.Days; day++ {
+		runs, err := uc.simulateDay(ctx, tc)
+		resp.JobRuns += runs
+		if err != nil {
+			resp.BusinessDate = tc.BusinessDate()
+			return resp, err
+		}
+		tc = tc.Advance(uc.clock.Now())
+		if err := uc.clocks.Save(ctx, tc); err != nil {
+			return resp, fmt.Errorf("failed to save tenant clock: %w", err)
+		}
+		resp.DaysSimulated++
+	}
+	resp.BusinessDate = tc.BusinessDate()
+	return resp, nil
+}
+
+// simulateDay runs each step of the plan for the clock's business date,
+// returning the number of job runs dispatched.
+func (uc *AdvanceTenantClockUseCase) simulateDay(ctx context.Context, tc model.TenantClock) (int, error) {
+	runs := 0
+	for _, step := range uc.plan.Steps() {
+		job, err := uc.jobs.FindByName(ctx, step.JobName)
+		if err != nil {
+			return runs, fmt.Errorf("failed to find job of step %s: %w", step.Name, err)
+		}
+		run, err := uc.runner.execute(ctx, job, model.NewSimulationRun(job, tc.TenantID(), tc.BusinessDate(), uc.clock.Now()))
+		runs++
+		if err != nil {
+			return runs, fmt.Errorf("failed to run step %s: %w", step.Name, err)
+		}
+		if !run.Status().Equal(valueobject.RunStatusSucceeded) {
+			return runs, fmt.Errorf("%w: %s on %s: %s", ErrSimulationStepFailed,
+				step.Name, tc.BusinessDate().Format(time.DateOnly), run.LastError())
+		}
+	}
+	return runs, nil
+}
+
+// GetTenantClockUseCase retrieves a sandbox tenant's virtual business date.
+type GetTenantClockUseCase struct {
+	clocks port.TenantClockRepository
+	clock  clock.Clock
+}
+
+// NewGetTenantClockUseCase creates a new GetTenantClockUseCase reading the
+// real time from clk.
+func NewGetTenantClockUseCase(clocks port.TenantClockRepository, clk clock.Clock) *GetTenantClockUseCase {
+	return &GetTenantClockUseCase{clocks: clocks, clock: clk}
+}
+
+// Execute retrieves the tenant's business date. A tenant that has never
+// advanced its clock is on the real business date.
+func (uc *GetTenantClockUseCase) Execute(ctx context.Context, tenantID uuid.UUID) (dto.TenantClockResponse, error) {
+	tc, err := findTenantClock(ctx, uc.clocks, tenantID, uc.clock.Now())
+	if err != nil {
+		return dto.TenantClockResponse{}, err
+	}
+	return dto.TenantClockResponse{TenantID: tenantID, BusinessDate: tc.BusinessDate()}, nil
+}
+
+// findTenantClock loads the tenant's clock, starting a new one at now if the
+// tenant has none.
+func findTenantClock(ctx context.Context, clocks port.TenantClockRepository, tenantID uuid.UUID, now time.Time) (model.TenantClock, error) {
+	tc, err := clocks.Find(ctx, tenantID)
+	if errors.Is(err, port.ErrTenantClockNotFound) {
+		tc, err = model.NewTenantClock(tenantID, now)
+		if err != nil {
+			return model.TenantClock{}, fmt.Errorf("%w: %w", ErrInvalidSimulation, err)
+		}
+		return tc, nil
+	}
+	if err != nil {
+		return model.TenantClock{}, fmt.Errorf("failed to find tenant clock: %w", err)
+	}
+	return tc, nil
+}
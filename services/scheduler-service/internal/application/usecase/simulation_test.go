@@ -0,0 +1,92 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/clock"
+	"github.com/bibbank/bib/services/scheduler-service/internal/application/dto"
+	"github.com/bibbank/bib/services/scheduler-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+)
+
+func TestAdvanceTenantClock_RunsEachDayForTheTenant(t *testing.T) {
+	f := newFixture()
+	f.registerEODJob(t, "payments.cutoff", 1)
+	f.registerEODJob(t, "deposits.accrue", 1)
+	clk := clock.NewManual(businessDate.Add(9 * time.Hour))
+	clocks := &inMemoryTenantClockRepo{}
+	advance := usecase.NewAdvanceTenantClockUseCase(eodPlan(t), clocks, f.jobs, f.runner, clk)
+	tenantID := uuid.New()
+
+	resp, err := advance.Execute(context.Background(), dto.AdvanceTenantClockRequest{TenantID: tenantID, Days: 3})
+	require.NoError(t, err)
+	assert.Equal(t, dto.TenantClockResponse{
+		TenantID:      tenantID,
+		BusinessDate:  businessDate.AddDate(0, 0, 3),
+		DaysSimulated: 3,
+		JobRuns:       6,
+	}, resp)
+
+	require.Len(t, f.dispatcher.dispatched, 6)
+	for _, run := range f.dispatcher.dispatched {
+		assert.Equal(t, valueobject.RunTriggerSimulation, run.Trigger())
+		assert.Equal(t, tenantID, run.TenantID(), "simulated runs act for the tenant only")
+	}
+	assert.Equal(t, "payments.cutoff", f.dispatcher.dispatched[4].JobName())
+	assert.JSONEq(t, `{"as_of_date":"2026-03-12"}`, string(f.dispatcher.payloads[5]), "each day runs for its own date")
+
+	got, err := usecase.NewGetTenantClockUseCase(clocks, clk).Execute(context.Background(), tenantID)
+	require.NoError(t, err)
+	assert.Equal(t, businessDate.AddDate(0, 0, 3), got.BusinessDate)
+
+	other, err := usecase.NewGetTenantClockUseCase(clocks, clk).Execute(context.Background(), uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, businessDate, other.BusinessDate, "other tenants stay on the real business date")
+}
+
+func TestAdvanceTenantClock_FailedStepKeepsTheDay(t *testing.T) {
+	f := newFixture()
+	f.registerEODJob(t, "payments.cutoff", 1)
+	f.registerEODJob(t, "deposits.accrue", 1)
+	clk := clock.NewManual(businessDate)
+	clocks := &inMemoryTenantClockRepo{}
+	advance := usecase.NewAdvanceTenantClockUseCase(eodPlan(t), clocks, f.jobs, f.runner, clk)
+	tenantID := uuid.New()
+
+	_, err := advance.Execute(context.Background(), dto.AdvanceTenantClockRequest{TenantID: tenantID, Days: 1})
+	require.NoError(t, err)
+
+	f.dispatcher.err = errors.New("deposit service unavailable")
+	resp, err := advance.Execute(context.Background(), dto.AdvanceTenantClockRequest{TenantID: tenantID, Days: 5})
+	assert.ErrorIs(t, err, usecase.ErrSimulationStepFailed)
+	assert.Equal(t, 0, resp.DaysSimulated)
+	assert.Equal(t, 1, resp.JobRuns, "later steps are not run")
+	assert.Equal(t, businessDate.AddDate(0, 0, 1), resp.BusinessDate)
+
+	f.dispatcher.err = nil
+	resp, err = advance.Execute(context.Background(), dto.AdvanceTenantClockRequest{TenantID: tenantID, Days: 1})
+	require.NoError(t, err)
+	assert.Equal(t, businessDate.AddDate(0, 0, 2), resp.BusinessDate, "the failed day is run again")
+}
+
+func TestAdvanceTenantClock_Validation(t *testing.T) {
+	f := newFixture()
+	advance := usecase.NewAdvanceTenantClockUseCase(eodPlan(t), &inMemoryTenantClockRepo{}, f.jobs, f.runner, clock.System)
+
+	for _, req := range []dto.AdvanceTenantClockRequest{
+		{Days: 1},
+		{TenantID: uuid.New()},
+		{TenantID: uuid.New(), Days: 367},
+	} {
+		_, err := advance.Execute(context.Background(), req)
+		assert.ErrorIs(t, err, usecase.ErrInvalidSimulation)
+	}
+	assert.Empty(t, f.dispatcher.dispatched)
+}
@@ -0,0 +1,239 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/event"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/port"
+)
+
+type inMemoryJobRepo struct {
+	jobs []model.Job
+}
+
+func (r *inMemoryJobRepo) Save(_ context.Context, job model.Job) error {
+	job = job.ClearDomainEvents()
+	i := slices.IndexFunc(r.jobs, func(existing model.Job) bool { return existing.ID() == job.ID() })
+	if i < 0 {
+		if slices.ContainsFunc(r.jobs, func(existing model.Job) bool { return existing.Name() == job.Name() }) {
+			return port.ErrJobNameTaken
+		}
+		r.jobs = append(r.jobs, job)
+		return nil
+	}
+	if r.jobs[i].Version() != job.Version()-1 {
+		return port.ErrVersionConflict
+	}
+	r.jobs[i] = job
+	return nil
+}
+
+func (r *inMemoryJobRepo) FindByID(_ context.Context, id uuid.UUID) (model.Job, error) {
+	for _, j := range r.jobs {
+		if j.ID() == id {
+			return j, nil
+		}
+	}
+	return model.Job{}, port.ErrJobNotFound
+}
+
+func (r *inMemoryJobRepo) FindByName(_ context.Context, name string) (model.Job, error) {
+	for _, j := range r.jobs {
+		if j.Name() == name {
+			return j, nil
+		}
+	}
+	return model.Job{}, port.ErrJobNotFound
+}
+
+func (r *inMemoryJobRepo) List(_ context.Context, limit, offset int) ([]model.Job, int, error) {
+	jobs := slices.Clone(r.jobs)
+	slices.SortFunc(jobs, func(a, b model.Job) int { return strings.Compare(a.Name(), b.Name()) })
+	total := len(jobs)
+	jobs = jobs[min(offset, total):]
+	return jobs[:min(limit, len(jobs))], total, nil
+}
+
+func (r *inMemoryJobRepo) FindDue(_ context.Context, now time.Time, limit int) ([]model.Job, error) {
+	var due []model.Job
+	for _, j := range r.jobs {
+		if j.IsDue(now) {
+			due = append(due, j)
+		}
+	}
+	return due[:min(limit, len(due))], nil
+}
+
+type inMemoryRunRepo struct {
+	runs []model.JobRun
+}
+
+func (r *inMemoryRunRepo) Save(_ context.Context, run model.JobRun) error {
+	run = run.ClearDomainEvents()
+	i := slices.IndexFunc(r.runs, func(existing model.JobRun) bool { return existing.ID() == run.ID() })
+	if i < 0 {
+		r.runs = append(r.runs, run)
+		return nil
+	}
+	if r.runs[i].Version() != run.Version()-1 {
+		return port.ErrVersionConflict
+	}
+	r.runs[i] = run
+	return nil
+}
+
+func (r *inMemoryRunRepo) FindByID(_ context.Context, id uuid.UUID) (model.JobRun, error) {
+	for _, run := range r.runs {
+		if run.ID() == id {
+			return run, nil
+		}
+	}
+	return model.JobRun{}, port.ErrRunNotFound
+}
+
+func (r *inMemoryRunRepo) ListByJob(_ context.Context, jobID uuid.UUID, limit, offset int) ([]model.JobRun, int, error) {
+	var runs []model.JobRun
+	for i := len(r.runs) - 1; i >= 0; i-- {
+		if r.runs[i].JobID() == jobID {
+			runs = append(runs, r.runs[i])
+		}
+	}
+	total := len(runs)
+	runs = runs[min(offset, total):]
+	return runs[:min(limit, len(runs))], total, nil
+}
+
+func (r *inMemoryRunRepo) FindRetryDue(_ context.Context, now time.Time, limit int) ([]model.JobRun, error) {
+	var due []model.JobRun
+	for _, run := range r.runs {
+		if run.IsRetryDue(now) {
+			due = append(due, run)
+		}
+	}
+	return due[:min(limit, len(due))], nil
+}
+
+type inMemoryEODRunRepo struct {
+	runs []model.EODRun
+}
+
+func (r *inMemoryEODRunRepo) Save(_ context.Context, run model.EODRun) error {
+	run = run.ClearDomainEvents()
+	i := slices.IndexFunc(r.runs, func(existing model.EODRun) bool { return existing.ID() == run.ID() })
+	if i < 0 {
+		if slices.ContainsFunc(r.runs, func(existing model.EODRun) bool { return existing.BusinessDate().Equal(run.BusinessDate()) }) {
+			return port.ErrEODRunExists
+		}
+		r.runs = append(r.runs, run)
+		return nil
+	}
+	if r.runs[i].Version() != run.Version()-1 {
+		return port.ErrVersionConflict
+	}
+	r.runs[i] = run
+	return nil
+}
+
+func (r *inMemoryEODRunRepo) FindByID(_ context.Context, id uuid.UUID) (model.EODRun, error) {
+	for _, run := range r.runs {
+		if run.ID() == id {
+			return run, nil
+		}
+	}
+	return model.EODRun{}, port.ErrEODRunNotFound
+}
+
+func (r *inMemoryEODRunRepo) FindByBusinessDate(_ context.Context, businessDate time.Time) (model.EODRun, error) {
+	for _, run := range r.runs {
+		if run.BusinessDate().Equal(model.BusinessDate(businessDate)) {
+			return run, nil
+		}
+	}
+	return model.EODRun{}, port.ErrEODRunNotFound
+}
+
+func (r *inMemoryEODRunRepo) List(_ context.Context, limit, offset int) ([]model.EODRun, int, error) {
+	runs := slices.Clone(r.runs)
+	slices.SortFunc(runs, func(a, b model.EODRun) int { return b.BusinessDate().Compare(a.BusinessDate()) })
+	total := len(runs)
+	runs = runs[min(offset, total):]
+	return runs[:min(limit, len(runs))], total, nil
+}
+
+func (r *inMemoryEODRunRepo) ListUnfinished(_ context.Context) ([]model.EODRun, error) {
+	var unfinished []model.EODRun
+	for _, run := range r.runs {
+		if s := run.Status().String(); s == "RUNNING" || s == "FAILED" {
+			unfinished = append(unfinished, run)
+		}
+	}
+	slices.SortFunc(unfinished, func(a, b model.EODRun) int { return a.BusinessDate().Compare(b.BusinessDate()) })
+	return unfinished, nil
+}
+
+type inMemoryTenantClockRepo struct {
+	clocks map[uuid.UUID]model.TenantClock
+}
+
+func (r *inMemoryTenantClockRepo) Save(_ context.Context, clock model.TenantClock) error {
+	if r.clocks == nil {
+		r.clocks = map[uuid.UUID]model.TenantClock{}
+	}
+	if existing, ok := r.clocks[clock.TenantID()]; ok && existing.Version() != clock.Version()-1 {
+		return port.ErrVersionConflict
+	}
+	r.clocks[clock.TenantID()] = clock
+	return nil
+}
+
+func (r *inMemoryTenantClockRepo) Find(_ context.Context, tenantID uuid.UUID) (model.TenantClock, error) {
+	clock, ok := r.clocks[tenantID]
+	if !ok {
+		return model.TenantClock{}, port.ErrTenantClockNotFound
+	}
+	return clock, nil
+}
+
+// fakeDispatcher records dispatched runs and their payloads, failing while
+// err is set.
+type fakeDispatcher struct {
+	err        error
+	dispatched []model.JobRun
+	payloads   []json.RawMessage
+}
+
+func (d *fakeDispatcher) Dispatch(_ context.Context, job model.Job, run model.JobRun) error {
+	d.dispatched = append(d.dispatched, run)
+	d.payloads = append(d.payloads, job.Target().Payload)
+	return d.err
+}
+
+type recordingPublisher struct {
+	mu     sync.Mutex
+	events []event.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, events []event.DomainEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, events...)
+	return nil
+}
+
+func (p *recordingPublisher) eventTypes() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	types := make([]string, 0, len(p.events))
+	for _, e := range p.events {
+		types = append(types, e.EventType())
+	}
+	return types
+}
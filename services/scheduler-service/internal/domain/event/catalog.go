@@ -0,0 +1,20 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[JobRegistered]("scheduler.job.registered", 1, "Emitted when a job is registered with the scheduler."),
+		events.Declare[JobUpdated]("scheduler.job.updated", 1, "Emitted when a job's schedule, target or retry policy changes."),
+		events.Declare[JobPaused]("scheduler.job.paused", 1, "Emitted when a job is paused."),
+		events.Declare[JobResumed]("scheduler.job.resumed", 1, "Emitted when a paused job is resumed."),
+		events.Declare[JobRunSucceeded]("scheduler.run.succeeded", 1, "Emitted when a job run's command was accepted by the target service."),
+		events.Declare[JobRunFailed]("scheduler.run.failed", 1, "Emitted when a job run's command failed."),
+		events.Declare[EODRunStarted]("scheduler.eod.started", 1, "Emitted when the end-of-day close of a business date starts."),
+		events.Declare[EODRunCompleted]("scheduler.eod.completed", 1, "Emitted when every step of an end-of-day run has succeeded and the business date is closed."),
+		events.Declare[EODRunFailed]("scheduler.eod.failed", 1, "Emitted when a step of an end-of-day run fails for good."),
+		events.Declare[EODRunResumed]("scheduler.eod.resumed", 1, "Emitted when an operator resumes a failed end-of-day run, restarting its failed steps."),
+	}
+}
@@ -0,0 +1,203 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// DomainEvent is an alias for the shared pkg/events.DomainEvent interface.
+type DomainEvent = events.DomainEvent
+
+// Aggregate types of scheduler events.
+const (
+	AggregateTypeJob    = "Job"
+	AggregateTypeJobRun = "JobRun"
+	AggregateTypeEODRun = "EODRun"
+)
+
+// platformTenant is the tenant of scheduler events: jobs run across every
+// tenant rather than belonging to one.
+var platformTenant = uuid.Nil.String()
+
+// JobRegistered is emitted when a job is registered with the scheduler.
+type JobRegistered struct {
+	NextRunAt time.Time `json:"next_run_at"`
+	events.BaseEvent
+	Name     string `json:"name"`
+	Schedule string `json:"schedule"`
+}
+
+// NewJobRegistered creates a new JobRegistered event.
+func NewJobRegistered(jobID uuid.UUID, name, schedule string, nextRunAt time.Time) JobRegistered {
+	return JobRegistered{
+		BaseEvent: events.NewBaseEvent("scheduler.job.registered", jobID.String(), AggregateTypeJob, platformTenant),
+		Name:      name,
+		Schedule:  schedule,
+		NextRunAt: nextRunAt,
+	}
+}
+
+// JobUpdated is emitted when a job's schedule, target or retry policy
+// changes.
+type JobUpdated struct {
+	NextRunAt time.Time `json:"next_run_at"`
+	events.BaseEvent
+	Schedule string `json:"schedule"`
+	Version  int    `json:"version"`
+}
+
+// NewJobUpdated creates a new JobUpdated event.
+func NewJobUpdated(jobID uuid.UUID, schedule string, nextRunAt time.Time, version int) JobUpdated {
+	return JobUpdated{
+		BaseEvent: events.NewBaseEvent("scheduler.job.updated", jobID.String(), AggregateTypeJob, platformTenant),
+		Schedule:  schedule,
+		NextRunAt: nextRunAt,
+		Version:   version,
+	}
+}
+
+// JobPaused is emitted when a job is paused. It is not run on its schedule
+// until resumed, though it can still be triggered manually.
+type JobPaused struct {
+	events.BaseEvent
+}
+
+// NewJobPaused creates a new JobPaused event.
+func NewJobPaused(jobID uuid.UUID) JobPaused {
+	return JobPaused{
+		BaseEvent: events.NewBaseEvent("scheduler.job.paused", jobID.String(), AggregateTypeJob, platformTenant),
+	}
+}
+
+// JobResumed is emitted when a paused job is resumed.
+type JobResumed struct {
+	NextRunAt time.Time `json:"next_run_at"`
+	events.BaseEvent
+}
+
+// NewJobResumed creates a new JobResumed event.
+func NewJobResumed(jobID uuid.UUID, nextRunAt time.Time) JobResumed {
+	return JobResumed{
+		BaseEvent: events.NewBaseEvent("scheduler.job.resumed", jobID.String(), AggregateTypeJob, platformTenant),
+		NextRunAt: nextRunAt,
+	}
+}
+
+// JobRunSucceeded is emitted when a job run's command was accepted by the
+// target service.
+type JobRunSucceeded struct {
+	events.BaseEvent
+	JobName string    `json:"job_name"`
+	Trigger string    `json:"trigger"`
+	Attempt int       `json:"attempt"`
+	JobID   uuid.UUID `json:"job_id"`
+}
+
+// NewJobRunSucceeded creates a new JobRunSucceeded event.
+func NewJobRunSucceeded(runID, jobID uuid.UUID, jobName, trigger string, attempt int) JobRunSucceeded {
+	return JobRunSucceeded{
+		BaseEvent: events.NewBaseEvent("scheduler.run.succeeded", runID.String(), AggregateTypeJobRun, platformTenant),
+		JobID:     jobID,
+		JobName:   jobName,
+		Trigger:   trigger,
+		Attempt:   attempt,
+	}
+}
+
+// JobRunFailed is emitted when a job run's command failed. WillRetry is
+// false once the run has used all its attempts; such a run needs an
+// operator to retry it.
+type JobRunFailed struct {
+	events.BaseEvent
+	JobName   string    `json:"job_name"`
+	Error     string    `json:"error"`
+	Attempt   int       `json:"attempt"`
+	WillRetry bool      `json:"will_retry"`
+	JobID     uuid.UUID `json:"job_id"`
+}
+
+// NewJobRunFailed creates a new JobRunFailed event.
+func NewJobRunFailed(runID, jobID uuid.UUID, jobName, errMsg string, attempt int, willRetry bool) JobRunFailed {
+	return JobRunFailed{
+		BaseEvent: events.NewBaseEvent("scheduler.run.failed", runID.String(), AggregateTypeJobRun, platformTenant),
+		JobID:     jobID,
+		JobName:   jobName,
+		Error:     errMsg,
+		Attempt:   attempt,
+		WillRetry: willRetry,
+	}
+}
+
+// EODRunStarted is emitted when the end-of-day close of a business date
+// starts.
+type EODRunStarted struct {
+	BusinessDate time.Time `json:"business_date"`
+	events.BaseEvent
+	Trigger string   `json:"trigger"`
+	Steps   []string `json:"steps"`
+}
+
+// NewEODRunStarted creates a new EODRunStarted event.
+func NewEODRunStarted(runID uuid.UUID, businessDate time.Time, trigger string, steps []string) EODRunStarted {
+	return EODRunStarted{
+		BaseEvent:    events.NewBaseEvent("scheduler.eod.started", runID.String(), AggregateTypeEODRun, platformTenant),
+		BusinessDate: businessDate,
+		Trigger:      trigger,
+		Steps:        steps,
+	}
+}
+
+// EODRunCompleted is emitted when every step of an end-of-day run has
+// succeeded and the business date is closed.
+type EODRunCompleted struct {
+	BusinessDate time.Time `json:"business_date"`
+	events.BaseEvent
+}
+
+// NewEODRunCompleted creates a new EODRunCompleted event.
+func NewEODRunCompleted(runID uuid.UUID, businessDate time.Time) EODRunCompleted {
+	return EODRunCompleted{
+		BaseEvent:    events.NewBaseEvent("scheduler.eod.completed", runID.String(), AggregateTypeEODRun, platformTenant),
+		BusinessDate: businessDate,
+	}
+}
+
+// EODRunFailed is emitted when a step of an end-of-day run fails for good.
+// The steps depending on it are not started until an operator resumes the
+// run.
+type EODRunFailed struct {
+	BusinessDate time.Time `json:"business_date"`
+	events.BaseEvent
+	Step  string `json:"step"`
+	Error string `json:"error"`
+}
+
+// NewEODRunFailed creates a new EODRunFailed event.
+func NewEODRunFailed(runID uuid.UUID, businessDate time.Time, step, errMsg string) EODRunFailed {
+	return EODRunFailed{
+		BaseEvent:    events.NewBaseEvent("scheduler.eod.failed", runID.String(), AggregateTypeEODRun, platformTenant),
+		BusinessDate: businessDate,
+		Step:         step,
+		Error:        errMsg,
+	}
+}
+
+// EODRunResumed is emitted when an operator resumes a failed end-of-day
+// run, restarting its failed steps.
+type EODRunResumed struct {
+	BusinessDate time.Time `json:"business_date"`
+	events.BaseEvent
+	Steps []string `json:"steps"`
+}
+
+// NewEODRunResumed creates a new EODRunResumed event.
+func NewEODRunResumed(runID uuid.UUID, businessDate time.Time, steps []string) EODRunResumed {
+	return EODRunResumed{
+		BaseEvent:    events.NewBaseEvent("scheduler.eod.resumed", runID.String(), AggregateTypeEODRun, platformTenant),
+		BusinessDate: businessDate,
+		Steps:        steps,
+	}
+}
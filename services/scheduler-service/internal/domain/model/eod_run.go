@@ -0,0 +1,392 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/event"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+)
+
+// ErrUnknownStep is returned when an end-of-day run has no step of the
+// given name.
+var ErrUnknownStep = errors.New("unknown end-of-day step")
+
+// EODStepDefinition is one step of the end-of-day close: the scheduler job
+// it runs and the steps that must succeed before it starts.
+type EODStepDefinition struct {
+	Name string
+	// JobName is the registered job the step runs; it defaults to Name.
+	JobName   string
+	DependsOn []string
+}
+
+// DefaultEODSteps is the daily close sequence: payments are cut off, then
+// deposit and loan interest accrue, FX positions are revalued at the
+// closing rates, balances are snapshotted and finally the ledger day is
+// closed. Each step runs the job of the same name.
+var DefaultEODSteps = []EODStepDefinition{
+	{Name: "payments.cutoff"},
+	{Name: "deposits.accrue", DependsOn: []string{"payments.cutoff"}},
+	{Name: "loans.accrue", DependsOn: []string{"payments.cutoff"}},
+	{Name: "fx.revalue", DependsOn: []string{"deposits.accrue", "loans.accrue"}},
+	{Name: "balances.snapshot", DependsOn: []string{"fx.revalue"}},
+	{Name: "ledger.close-day", DependsOn: []string{"balances.snapshot"}},
+}
+
+// EODPlan is a validated end-of-day close sequence, ordered so that every
+// step follows the steps it depends on.
+type EODPlan struct {
+	steps []EODStepDefinition
+}
+
+// NewEODPlan validates the step definitions and orders them by their
+// dependencies, keeping the given order between independent steps.
+func NewEODPlan(defs []EODStepDefinition) (EODPlan, error) {
+	if len(defs) == 0 {
+		return EODPlan{}, fmt.Errorf("an end-of-day plan needs at least one step")
+	}
+	byName := make(map[string]EODStepDefinition, len(defs))
+	for _, d := range defs {
+		if !jobNameRE.MatchString(d.Name) {
+			return EODPlan{}, fmt.Errorf("step name %q must be 3-100 lowercase letters, digits, dots, hyphens or underscores, starting with a letter", d.Name)
+		}
+		if _, dup := byName[d.Name]; dup {
+			return EODPlan{}, fmt.Errorf("step %q is defined twice", d.Name)
+		}
+		if d.JobName == "" {
+			d.JobName = d.Name
+		}
+		d.DependsOn = slices.Clone(d.DependsOn)
+		byName[d.Name] = d
+	}
+	for _, d := range byName {
+		for _, dep := range d.DependsOn {
+			if _, ok := byName[dep]; !ok {
+				return EODPlan{}, fmt.Errorf("step %q depends on unknown step %q", d.Name, dep)
+			}
+		}
+	}
+
+	// Sweep the steps in the given order, placing each whose dependencies
+	// have been placed, until all are placed; a sweep placing none means
+	// the rest form a cycle.
+	placed := make(map[string]bool, len(defs))
+	steps := make([]EODStepDefinition, 0, len(defs))
+	for len(steps) < len(defs) {
+		progressed := false
+		for _, d := range defs {
+			if placed[d.Name] || !allPlaced(byName[d.Name].DependsOn, placed) {
+				continue
+			}
+			placed[d.Name] = true
+			steps = append(steps, byName[d.Name])
+			progressed = true
+		}
+		if !progressed {
+			return EODPlan{}, fmt.Errorf("end-of-day steps have a dependency cycle")
+		}
+	}
+	return EODPlan{steps: steps}, nil
+}
+
+func allPlaced(names []string, placed map[string]bool) bool {
+	for _, n := range names {
+		if !placed[n] {
+			return false
+		}
+	}
+	return true
+}
+
+// Steps returns the plan's steps in dependency order.
+func (p EODPlan) Steps() []EODStepDefinition {
+	return slices.Clone(p.steps)
+}
+
+// EODStep is the progress of one step of an end-of-day run.
+type EODStep struct {
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	// RunID is the job run of the step's current attempt.
+	RunID     *uuid.UUID
+	Name      string
+	JobName   string
+	Error     string
+	DependsOn []string
+	Status    valueobject.StepStatus
+}
+
+// EODRun is the end-of-day close of one business date. Its steps run in
+// dependency order, each as a job run of its job, and every change of step
+// is saved so the close resumes where it left off after a restart. A step
+// that fails once its job run has used all its attempts fails the run;
+// steps depending on it are not started until an operator resumes it.
+type EODRun struct {
+	businessDate time.Time
+	startedAt    time.Time
+	updatedAt    time.Time
+	finishedAt   *time.Time
+	trigger      valueobject.RunTrigger
+	status       valueobject.RunStatus
+	steps        []EODStep
+	domainEvents []events.DomainEvent
+	version      int
+	id           uuid.UUID
+}
+
+// NewEODRun starts the close of businessDate following plan. Only the
+// date of businessDate, in UTC, is kept.
+func NewEODRun(plan EODPlan, businessDate time.Time, trigger valueobject.RunTrigger, now time.Time) (EODRun, error) {
+	if len(plan.steps) == 0 {
+		return EODRun{}, fmt.Errorf("an end-of-day run needs a plan")
+	}
+	if !trigger.Equal(valueobject.RunTriggerScheduled) && !trigger.Equal(valueobject.RunTriggerManual) {
+		return EODRun{}, fmt.Errorf("an end-of-day run is started by its schedule or manually, not %s", trigger)
+	}
+	r := EODRun{
+		id:           uuid.New(),
+		businessDate: BusinessDate(businessDate),
+		trigger:      trigger,
+		status:       valueobject.RunStatusRunning,
+		steps:        make([]EODStep, 0, len(plan.steps)),
+		version:      1,
+		startedAt:    now,
+		updatedAt:    now,
+	}
+	names := make([]string, 0, len(plan.steps))
+	for _, d := range plan.steps {
+		r.steps = append(r.steps, EODStep{
+			Name:      d.Name,
+			JobName:   d.JobName,
+			DependsOn: slices.Clone(d.DependsOn),
+			Status:    valueobject.StepStatusPending,
+		})
+		names = append(names, d.Name)
+	}
+	r.domainEvents = append(r.domainEvents, event.NewEODRunStarted(r.id, r.businessDate, trigger.String(), names))
+	return r, nil
+}
+
+// ReconstructEODRun recreates an EODRun from persisted data without
+// validation or events.
+func ReconstructEODRun(
+	id uuid.UUID,
+	businessDate time.Time,
+	trigger valueobject.RunTrigger,
+	status valueobject.RunStatus,
+	steps []EODStep,
+	startedAt, updatedAt time.Time,
+	finishedAt *time.Time,
+	version int,
+) EODRun {
+	return EODRun{
+		id:           id,
+		businessDate: businessDate,
+		trigger:      trigger,
+		status:       status,
+		steps:        steps,
+		startedAt:    startedAt,
+		updatedAt:    updatedAt,
+		finishedAt:   finishedAt,
+		version:      version,
+	}
+}
+
+// BusinessDate returns the UTC date of t, at midnight.
+func BusinessDate(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// ReadySteps returns the PENDING steps of a RUNNING run whose dependencies
+// have all succeeded, in plan order.
+func (r EODRun) ReadySteps() []EODStep {
+	if !r.status.Equal(valueobject.RunStatusRunning) {
+		return nil
+	}
+	var ready []EODStep
+	for _, s := range r.steps {
+		if s.Status.Equal(valueobject.StepStatusPending) && r.dependenciesSucceeded(s) {
+			ready = append(ready, r.copyStep(s))
+		}
+	}
+	return ready
+}
+
+// RunningSteps returns the steps whose job run is in progress, in plan
+// order.
+func (r EODRun) RunningSteps() []EODStep {
+	var running []EODStep
+	for _, s := range r.steps {
+		if s.Status.Equal(valueobject.StepStatusRunning) {
+			running = append(running, r.copyStep(s))
+		}
+	}
+	return running
+}
+
+// StartStep records that a ready step's job run, runID, has started.
+func (r EODRun) StartStep(name string, runID uuid.UUID, now time.Time) (EODRun, error) {
+	i, err := r.stepIndex(name)
+	if err != nil {
+		return r, err
+	}
+	s := r.steps[i]
+	if !r.status.Equal(valueobject.RunStatusRunning) || !s.Status.Equal(valueobject.StepStatusPending) || !r.dependenciesSucceeded(s) {
+		return r, fmt.Errorf("cannot start %s step %s of %s end-of-day run: %w", s.Status, name, r.status, ErrInvalidTransition)
+	}
+	r.steps = slices.Clone(r.steps)
+	r.steps[i].Status = valueobject.StepStatusRunning
+	r.steps[i].RunID = &runID
+	r.steps[i].StartedAt = &now
+	r.steps[i].FinishedAt = nil
+	r.steps[i].Error = ""
+	r.version++
+	r.updatedAt = now
+	return r, nil
+}
+
+// CompleteStep records that a running step's job run succeeded. Once every
+// step has succeeded the run succeeds.
+func (r EODRun) CompleteStep(name string, now time.Time) (EODRun, error) {
+	i, err := r.stepIndex(name)
+	if err != nil {
+		return r, err
+	}
+	if !r.steps[i].Status.Equal(valueobject.StepStatusRunning) {
+		return r, fmt.Errorf("cannot complete %s step %s: %w", r.steps[i].Status, name, ErrInvalidTransition)
+	}
+	r.steps = slices.Clone(r.steps)
+	r.steps[i].Status = valueobject.StepStatusSucceeded
+	r.steps[i].FinishedAt = &now
+	r.version++
+	r.updatedAt = now
+	if r.status.Equal(valueobject.RunStatusRunning) && r.allSucceeded() {
+		r.status = valueobject.RunStatusSucceeded
+		r.finishedAt = &now
+		r.domainEvents = append(r.domainEvents, event.NewEODRunCompleted(r.id, r.businessDate))
+	}
+	return r, nil
+}
+
+// FailStep records that a step cannot complete: its job run used all its
+// attempts, or its job is missing. The run fails with it; steps already
+// running carry on, but no new step starts until the run is resumed.
+func (r EODRun) FailStep(name, cause string, now time.Time) (EODRun, error) {
+	i, err := r.stepIndex(name)
+	if err != nil {
+		return r, err
+	}
+	s := r.steps[i]
+	if !s.Status.Equal(valueobject.StepStatusPending) && !s.Status.Equal(valueobject.StepStatusRunning) {
+		return r, fmt.Errorf("cannot fail %s step %s: %w", s.Status, name, ErrInvalidTransition)
+	}
+	r.steps = slices.Clone(r.steps)
+	r.steps[i].Status = valueobject.StepStatusFailed
+	r.steps[i].Error = cause
+	r.steps[i].FinishedAt = &now
+	r.version++
+	r.updatedAt = now
+	if r.status.Equal(valueobject.RunStatusRunning) {
+		r.status = valueobject.RunStatusFailed
+		r.finishedAt = &now
+		r.domainEvents = append(r.domainEvents, event.NewEODRunFailed(r.id, r.businessDate, name, cause))
+	}
+	return r, nil
+}
+
+// Resume restarts a FAILED run: its failed steps return to PENDING and are
+// started again, as new job runs, once their dependencies have succeeded.
+func (r EODRun) Resume(now time.Time) (EODRun, error) {
+	if !r.status.Equal(valueobject.RunStatusFailed) {
+		return r, fmt.Errorf("cannot resume %s end-of-day run for %s: %w", r.status, r.businessDate.Format(time.DateOnly), ErrInvalidTransition)
+	}
+	r.steps = slices.Clone(r.steps)
+	var restarted []string
+	for i, s := range r.steps {
+		if !s.Status.Equal(valueobject.StepStatusFailed) {
+			continue
+		}
+		r.steps[i].Status = valueobject.StepStatusPending
+		r.steps[i].RunID = nil
+		r.steps[i].StartedAt = nil
+		r.steps[i].FinishedAt = nil
+		r.steps[i].Error = ""
+		restarted = append(restarted, s.Name)
+	}
+	r.status = valueobject.RunStatusRunning
+	r.finishedAt = nil
+	r.version++
+	r.updatedAt = now
+	r.domainEvents = append(r.domainEvents, event.NewEODRunResumed(r.id, r.businessDate, restarted))
+	return r, nil
+}
+
+func (r EODRun) stepIndex(name string) (int, error) {
+	i := slices.IndexFunc(r.steps, func(s EODStep) bool { return s.Name == name })
+	if i < 0 {
+		return -1, fmt.Errorf("%w: %q", ErrUnknownStep, name)
+	}
+	return i, nil
+}
+
+func (r EODRun) dependenciesSucceeded(s EODStep) bool {
+	for _, dep := range s.DependsOn {
+		i := slices.IndexFunc(r.steps, func(d EODStep) bool { return d.Name == dep })
+		if i < 0 || !r.steps[i].Status.Equal(valueobject.StepStatusSucceeded) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r EODRun) allSucceeded() bool {
+	for _, s := range r.steps {
+		if !s.Status.Equal(valueobject.StepStatusSucceeded) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r EODRun) copyStep(s EODStep) EODStep {
+	s.DependsOn = slices.Clone(s.DependsOn)
+	return s
+}
+
+// --- Accessors ---
+
+func (r EODRun) ID() uuid.UUID                   { return r.id }
+func (r EODRun) BusinessDate() time.Time         { return r.businessDate }
+func (r EODRun) Trigger() valueobject.RunTrigger { return r.trigger }
+func (r EODRun) Status() valueobject.RunStatus   { return r.status }
+func (r EODRun) StartedAt() time.Time            { return r.startedAt }
+func (r EODRun) UpdatedAt() time.Time            { return r.updatedAt }
+func (r EODRun) FinishedAt() *time.Time          { return r.finishedAt }
+func (r EODRun) Version() int                    { return r.version }
+
+// Steps returns the run's steps in plan order.
+func (r EODRun) Steps() []EODStep {
+	steps := make([]EODStep, 0, len(r.steps))
+	for _, s := range r.steps {
+		steps = append(steps, r.copyStep(s))
+	}
+	return steps
+}
+
+// DomainEvents returns the uncommitted domain events.
+func (r EODRun) DomainEvents() []events.DomainEvent {
+	return r.domainEvents
+}
+
+// ClearDomainEvents returns a copy of the run with no uncommitted events.
+func (r EODRun) ClearDomainEvents() EODRun {
+	r.domainEvents = nil
+	return r
+}
@@ -0,0 +1,140 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+)
+
+func stepNames(steps []model.EODStep) []string {
+	names := make([]string, 0, len(steps))
+	for _, s := range steps {
+		names = append(names, s.Name)
+	}
+	return names
+}
+
+func TestNewEODPlan_OrdersByDependency(t *testing.T) {
+	plan, err := model.NewEODPlan([]model.EODStepDefinition{
+		{Name: "ledger.close-day", DependsOn: []string{"deposits.accrue"}},
+		{Name: "deposits.accrue", DependsOn: []string{"payments.cutoff"}, JobName: "deposit.interest-accrual"},
+		{Name: "payments.cutoff"},
+	})
+	require.NoError(t, err)
+	steps := plan.Steps()
+	require.Len(t, steps, 3)
+	assert.Equal(t, "payments.cutoff", steps[0].Name)
+	assert.Equal(t, "payments.cutoff", steps[0].JobName, "the job name defaults to the step name")
+	assert.Equal(t, "deposit.interest-accrual", steps[1].JobName)
+	assert.Equal(t, "ledger.close-day", steps[2].Name)
+
+	_, err = model.NewEODPlan(model.DefaultEODSteps)
+	assert.NoError(t, err)
+
+	_, err = model.NewEODPlan([]model.EODStepDefinition{
+		{Name: "a.step", DependsOn: []string{"b.step"}},
+		{Name: "b.step", DependsOn: []string{"a.step"}},
+	})
+	assert.Error(t, err, "cycle")
+
+	_, err = model.NewEODPlan([]model.EODStepDefinition{{Name: "a.step", DependsOn: []string{"missing"}}})
+	assert.Error(t, err, "unknown dependency")
+
+	_, err = model.NewEODPlan([]model.EODStepDefinition{{Name: "a.step"}, {Name: "a.step"}})
+	assert.Error(t, err, "duplicate")
+}
+
+func TestEODRun_RunsStepsInDependencyOrder(t *testing.T) {
+	plan, err := model.NewEODPlan(model.DefaultEODSteps)
+	require.NoError(t, err)
+	now := time.Date(2026, 3, 10, 22, 0, 0, 0, time.UTC)
+
+	_, err = model.NewEODRun(plan, now, valueobject.RunTriggerEOD, now)
+	assert.Error(t, err, "EOD is the trigger of the job runs an end-of-day run starts")
+
+	run, err := model.NewEODRun(plan, now, valueobject.RunTriggerScheduled, now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), run.BusinessDate())
+	assert.Equal(t, []string{"payments.cutoff"}, stepNames(run.ReadySteps()))
+
+	_, err = run.StartStep("fx.revalue", uuid.New(), now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition, "its dependencies have not succeeded")
+	_, err = run.StartStep("eod.unknown", uuid.New(), now)
+	assert.ErrorIs(t, err, model.ErrUnknownStep)
+
+	run, err = run.StartStep("payments.cutoff", uuid.New(), now)
+	require.NoError(t, err)
+	assert.Empty(t, run.ReadySteps())
+	run, err = run.CompleteStep("payments.cutoff", now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"deposits.accrue", "loans.accrue"}, stepNames(run.ReadySteps()))
+
+	for len(run.ReadySteps()) > 0 {
+		for _, s := range run.ReadySteps() {
+			run, err = run.StartStep(s.Name, uuid.New(), now)
+			require.NoError(t, err)
+			run, err = run.CompleteStep(s.Name, now)
+			require.NoError(t, err)
+		}
+	}
+	assert.Equal(t, valueobject.RunStatusSucceeded, run.Status())
+	assert.NotNil(t, run.FinishedAt())
+	assert.Equal(t, 13, run.Version(), "created, then started and completed six steps")
+
+	var types []string
+	for _, e := range run.DomainEvents() {
+		types = append(types, e.EventType())
+	}
+	assert.Equal(t, []string{"scheduler.eod.started", "scheduler.eod.completed"}, types)
+}
+
+func TestEODRun_FailAndResume(t *testing.T) {
+	plan, err := model.NewEODPlan(model.DefaultEODSteps)
+	require.NoError(t, err)
+	now := time.Date(2026, 3, 10, 22, 0, 0, 0, time.UTC)
+	run, err := model.NewEODRun(plan, now, valueobject.RunTriggerManual, now)
+	require.NoError(t, err)
+
+	_, err = run.Resume(now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition, "only failed runs resume")
+
+	run, err = run.StartStep("payments.cutoff", uuid.New(), now)
+	require.NoError(t, err)
+	run, err = run.CompleteStep("payments.cutoff", now)
+	require.NoError(t, err)
+	run, err = run.StartStep("deposits.accrue", uuid.New(), now)
+	require.NoError(t, err)
+	run, err = run.StartStep("loans.accrue", uuid.New(), now)
+	require.NoError(t, err)
+
+	run, err = run.FailStep("loans.accrue", "deadline exceeded", now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.RunStatusFailed, run.Status())
+	assert.Empty(t, run.ReadySteps(), "a failed run starts no steps")
+	run, err = run.CompleteStep("deposits.accrue", now)
+	require.NoError(t, err, "steps already running carry on")
+	assert.Equal(t, valueobject.RunStatusFailed, run.Status())
+
+	later := now.Add(time.Hour)
+	run, err = run.Resume(later)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.RunStatusRunning, run.Status())
+	assert.Nil(t, run.FinishedAt())
+	ready := run.ReadySteps()
+	require.Len(t, ready, 1)
+	assert.Equal(t, "loans.accrue", ready[0].Name)
+	assert.Nil(t, ready[0].RunID, "the step starts a new job run")
+	assert.Empty(t, ready[0].Error)
+
+	var types []string
+	for _, e := range run.DomainEvents() {
+		types = append(types, e.EventType())
+	}
+	assert.Equal(t, []string{"scheduler.eod.started", "scheduler.eod.failed", "scheduler.eod.resumed"}, types)
+}
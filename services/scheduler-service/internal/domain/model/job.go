@@ -0,0 +1,297 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/event"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+)
+
+var (
+	jobNameRE    = regexp.MustCompile(`^[a-z][a-z0-9_.-]{2,99}$`)
+	grpcMethodRE = regexp.MustCompile(`^/[A-Za-z0-9_.]+/[A-Za-z0-9_]+$`)
+)
+
+const (
+	maxAttempts   = 10
+	maxRetryDelay = 6 * time.Hour
+)
+
+// BusinessDatePlaceholder is replaced, wherever it appears in a job's
+// target payload, by the business date (YYYY-MM-DD) of an end-of-day run
+// the job runs for, such as {"as_of_date":"{{business_date}}"}.
+const BusinessDatePlaceholder = "{{business_date}}"
+
+// ErrInvalidTransition is returned when a job or run cannot make the
+// requested change from its current state.
+var ErrInvalidTransition = errors.New("invalid state transition")
+
+// JobTarget is where a job's command is sent each time it runs.
+type JobTarget struct {
+	Kind valueobject.TargetKind
+	// Service names the service a GRPC target calls; the scheduler is
+	// configured with each service's address.
+	Service string
+	// Method is the full gRPC method a GRPC target calls, such as
+	// "/bib.deposit.v1.DepositService/AccrueInterest".
+	Method string
+	// Topic is the Kafka topic a KAFKA target publishes the command to.
+	Topic string
+	// Payload is the JSON request body of a GRPC target, or the arguments
+	// carried in a KAFKA target's command.
+	Payload json.RawMessage
+}
+
+// RetryPolicy controls how a failed run is retried. The delay before each
+// retry doubles, starting at Backoff.
+type RetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+}
+
+// Delay returns how long to wait before retrying a run whose attempt failed.
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	delay := p.Backoff
+	for i := 1; i < attempt && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// JobDefinition holds the configurable fields of a job.
+type JobDefinition struct {
+	Description string
+	Schedule    string
+	Target      JobTarget
+	Retry       RetryPolicy
+}
+
+// Job is a batch job run by another service, such as interest accrual or a
+// dormancy sweep, that the scheduler triggers on a cron schedule. Each
+// trigger creates a JobRun recording the outcome.
+type Job struct {
+	createdAt    time.Time
+	updatedAt    time.Time
+	nextRunAt    time.Time
+	lastRunAt    *time.Time
+	name         string
+	description  string
+	schedule     valueobject.CronSchedule
+	target       JobTarget
+	retry        RetryPolicy
+	domainEvents []events.DomainEvent
+	version      int
+	enabled      bool
+	id           uuid.UUID
+}
+
+// NewJob registers an enabled job, first due at the next time its schedule
+// fires after now.
+func NewJob(name string, def JobDefinition, now time.Time) (Job, error) {
+	if !jobNameRE.MatchString(name) {
+		return Job{}, fmt.Errorf("job name %q must be 3-100 lowercase letters, digits, dots, hyphens or underscores, starting with a letter", name)
+	}
+	j := Job{
+		id:        uuid.New(),
+		name:      name,
+		enabled:   true,
+		version:   1,
+		createdAt: now,
+		updatedAt: now,
+	}
+	j, err := j.define(def, now)
+	if err != nil {
+		return Job{}, err
+	}
+	j.domainEvents = append(j.domainEvents, event.NewJobRegistered(j.id, name, j.schedule.String(), j.nextRunAt))
+	return j, nil
+}
+
+// ReconstructJob recreates a Job from persisted data without validation or
+// events.
+func ReconstructJob(
+	id uuid.UUID,
+	name, description string,
+	schedule valueobject.CronSchedule,
+	target JobTarget,
+	retry RetryPolicy,
+	enabled bool,
+	nextRunAt time.Time,
+	lastRunAt *time.Time,
+	version int,
+	createdAt, updatedAt time.Time,
+) Job {
+	return Job{
+		id:          id,
+		name:        name,
+		description: description,
+		schedule:    schedule,
+		target:      target,
+		retry:       retry,
+		enabled:     enabled,
+		nextRunAt:   nextRunAt,
+		lastRunAt:   lastRunAt,
+		version:     version,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// Update replaces the job's definition. Its next run is recomputed from the
+// new schedule; a job's name never changes.
+func (j Job) Update(def JobDefinition, now time.Time) (Job, error) {
+	j, err := j.define(def, now)
+	if err != nil {
+		return j, err
+	}
+	j.version++
+	j.updatedAt = now
+	j.domainEvents = append(j.domainEvents, event.NewJobUpdated(j.id, j.schedule.String(), j.nextRunAt, j.version))
+	return j, nil
+}
+
+// Pause stops the job running on its schedule.
+func (j Job) Pause(now time.Time) (Job, error) {
+	if !j.enabled {
+		return j, fmt.Errorf("job %s is already paused: %w", j.name, ErrInvalidTransition)
+	}
+	j.enabled = false
+	j.version++
+	j.updatedAt = now
+	j.domainEvents = append(j.domainEvents, event.NewJobPaused(j.id))
+	return j, nil
+}
+
+// Resume runs a paused job on its schedule again. Runs missed while it was
+// paused are skipped.
+func (j Job) Resume(now time.Time) (Job, error) {
+	if j.enabled {
+		return j, fmt.Errorf("job %s is not paused: %w", j.name, ErrInvalidTransition)
+	}
+	j.enabled = true
+	j.nextRunAt = j.schedule.Next(now)
+	j.version++
+	j.updatedAt = now
+	j.domainEvents = append(j.domainEvents, event.NewJobResumed(j.id, j.nextRunAt))
+	return j, nil
+}
+
+// IsDue reports whether the job should run on its schedule at now.
+func (j Job) IsDue(now time.Time) bool {
+	return j.enabled && !j.nextRunAt.IsZero() && !j.nextRunAt.After(now)
+}
+
+// Advance records that the job's scheduled run has started and moves its
+// next run to the next time the schedule fires after now. If the scheduler
+// was down across several fire times, they are run once rather than
+// replayed. It returns the time the started run was scheduled for.
+func (j Job) Advance(now time.Time) (Job, time.Time, error) {
+	if !j.IsDue(now) {
+		return j, time.Time{}, fmt.Errorf("job %s is not due: %w", j.name, ErrInvalidTransition)
+	}
+	scheduledFor := j.nextRunAt
+	j.lastRunAt = &scheduledFor
+	j.nextRunAt = j.schedule.Next(now)
+	j.version++
+	j.updatedAt = now
+	return j, scheduledFor, nil
+}
+
+// ForBusinessDate returns a copy of the job whose target payload has
+// BusinessDatePlaceholder replaced by businessDate.
+func (j Job) ForBusinessDate(businessDate time.Time) Job {
+	payload := strings.ReplaceAll(string(j.target.Payload), BusinessDatePlaceholder, businessDate.UTC().Format(time.DateOnly))
+	j.target.Payload = json.RawMessage(payload)
+	return j
+}
+
+func (j Job) define(def JobDefinition, now time.Time) (Job, error) {
+	schedule, err := valueobject.NewCronSchedule(def.Schedule)
+	if err != nil {
+		return j, err
+	}
+	nextRunAt := schedule.Next(now)
+	if nextRunAt.IsZero() {
+		return j, fmt.Errorf("cron expression %q never fires", def.Schedule)
+	}
+	target, err := validateTarget(def.Target)
+	if err != nil {
+		return j, err
+	}
+	if def.Retry.MaxAttempts < 1 || def.Retry.MaxAttempts > maxAttempts {
+		return j, fmt.Errorf("max attempts must be between 1 and %d", maxAttempts)
+	}
+	if def.Retry.Backoff < 0 {
+		return j, fmt.Errorf("retry backoff must not be negative")
+	}
+
+	j.description = strings.TrimSpace(def.Description)
+	j.schedule = schedule
+	j.target = target
+	j.retry = def.Retry
+	if j.enabled {
+		j.nextRunAt = nextRunAt
+	}
+	return j, nil
+}
+
+func validateTarget(t JobTarget) (JobTarget, error) {
+	if len(t.Payload) == 0 {
+		t.Payload = json.RawMessage(`{}`)
+	}
+	if !json.Valid(t.Payload) {
+		return t, fmt.Errorf("target payload must be valid JSON")
+	}
+	switch {
+	case t.Kind.Equal(valueobject.TargetKindGRPC):
+		if strings.TrimSpace(t.Service) == "" {
+			return t, fmt.Errorf("a gRPC target needs a service")
+		}
+		if !grpcMethodRE.MatchString(t.Method) {
+			return t, fmt.Errorf("gRPC method %q must be a full method name such as /pkg.Service/Method", t.Method)
+		}
+		t.Topic = ""
+	case t.Kind.Equal(valueobject.TargetKindKafka):
+		if strings.TrimSpace(t.Topic) == "" {
+			return t, fmt.Errorf("a Kafka target needs a topic")
+		}
+		t.Service, t.Method = "", ""
+	default:
+		return t, fmt.Errorf("target kind must be GRPC or KAFKA")
+	}
+	return t, nil
+}
+
+// --- Accessors ---
+
+func (j Job) ID() uuid.UUID                      { return j.id }
+func (j Job) Name() string                       { return j.name }
+func (j Job) Description() string                { return j.description }
+func (j Job) Schedule() valueobject.CronSchedule { return j.schedule }
+func (j Job) Target() JobTarget                  { return j.target }
+func (j Job) Retry() RetryPolicy                 { return j.retry }
+func (j Job) Enabled() bool                      { return j.enabled }
+func (j Job) NextRunAt() time.Time               { return j.nextRunAt }
+func (j Job) LastRunAt() *time.Time              { return j.lastRunAt }
+func (j Job) Version() int                       { return j.version }
+func (j Job) CreatedAt() time.Time               { return j.createdAt }
+func (j Job) UpdatedAt() time.Time               { return j.updatedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (j Job) DomainEvents() []events.DomainEvent {
+	return j.domainEvents
+}
+
+// ClearDomainEvents returns a copy of the job with no uncommitted events.
+func (j Job) ClearDomainEvents() Job {
+	j.domainEvents = nil
+	return j
+}
@@ -0,0 +1,176 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/event"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+)
+
+// JobRun is one execution of a job: its command dispatched to the target
+// service, retried under the job's retry policy until it succeeds or runs
+// out of attempts. Every attempt of a run carries the run's ID, so a target
+// deduplicating on it does the work once. A simulation run is dispatched on
+// behalf of the one tenant whose virtual time it advances; every other run
+// is dispatched for all tenants.
+type JobRun struct {
+	scheduledFor time.Time
+	startedAt    time.Time
+	finishedAt   *time.Time
+	nextRetryAt  *time.Time
+	jobName      string
+	lastError    string
+	trigger      valueobject.RunTrigger
+	status       valueobject.RunStatus
+	domainEvents []events.DomainEvent
+	attempt      int
+	maxAttempts  int
+	version      int
+	id           uuid.UUID
+	jobID        uuid.UUID
+	tenantID     uuid.UUID
+}
+
+// NewJobRun starts the first attempt of a run of job scheduled for
+// scheduledFor.
+func NewJobRun(job Job, trigger valueobject.RunTrigger, scheduledFor, now time.Time) JobRun {
+	return JobRun{
+		id:           uuid.New(),
+		jobID:        job.ID(),
+		jobName:      job.Name(),
+		trigger:      trigger,
+		scheduledFor: scheduledFor,
+		status:       valueobject.RunStatusRunning,
+		attempt:      1,
+		maxAttempts:  job.Retry().MaxAttempts,
+		version:      1,
+		startedAt:    now,
+	}
+}
+
+// NewSimulationRun starts the first attempt of a run of job for a sandbox
+// tenant's simulated business date.
+func NewSimulationRun(job Job, tenantID uuid.UUID, businessDate, now time.Time) JobRun {
+	run := NewJobRun(job, valueobject.RunTriggerSimulation, BusinessDate(businessDate), now)
+	run.tenantID = tenantID
+	return run
+}
+
+// ReconstructJobRun recreates a JobRun from persisted data without
+// validation or events.
+func ReconstructJobRun(
+	id, jobID, tenantID uuid.UUID,
+	jobName string,
+	trigger valueobject.RunTrigger,
+	status valueobject.RunStatus,
+	scheduledFor time.Time,
+	attempt, maxAttempts int,
+	lastError string,
+	startedAt time.Time,
+	finishedAt, nextRetryAt *time.Time,
+	version int,
+) JobRun {
+	return JobRun{
+		id:           id,
+		jobID:        jobID,
+		tenantID:     tenantID,
+		jobName:      jobName,
+		trigger:      trigger,
+		status:       status,
+		scheduledFor: scheduledFor,
+		attempt:      attempt,
+		maxAttempts:  maxAttempts,
+		lastError:    lastError,
+		startedAt:    startedAt,
+		finishedAt:   finishedAt,
+		nextRetryAt:  nextRetryAt,
+		version:      version,
+	}
+}
+
+// Succeed records that the target service accepted the run's command.
+func (r JobRun) Succeed(now time.Time) (JobRun, error) {
+	if !r.status.Equal(valueobject.RunStatusRunning) {
+		return r, fmt.Errorf("cannot complete %s run %s: %w", r.status, r.id, ErrInvalidTransition)
+	}
+	r.status = valueobject.RunStatusSucceeded
+	r.lastError = ""
+	r.finishedAt = &now
+	r.version++
+	r.domainEvents = append(r.domainEvents,
+		event.NewJobRunSucceeded(r.id, r.jobID, r.jobName, r.trigger.String(), r.attempt))
+	return r, nil
+}
+
+// Fail records that the run's command failed. If the run has attempts left
+// its retry is scheduled after the policy's delay.
+func (r JobRun) Fail(cause error, policy RetryPolicy, now time.Time) (JobRun, error) {
+	if !r.status.Equal(valueobject.RunStatusRunning) {
+		return r, fmt.Errorf("cannot fail %s run %s: %w", r.status, r.id, ErrInvalidTransition)
+	}
+	r.status = valueobject.RunStatusFailed
+	r.lastError = cause.Error()
+	r.finishedAt = &now
+	r.nextRetryAt = nil
+	if r.attempt < r.maxAttempts {
+		retryAt := now.Add(policy.Delay(r.attempt))
+		r.nextRetryAt = &retryAt
+	}
+	r.version++
+	r.domainEvents = append(r.domainEvents,
+		event.NewJobRunFailed(r.id, r.jobID, r.jobName, r.lastError, r.attempt, r.nextRetryAt != nil))
+	return r, nil
+}
+
+// Retry starts the next attempt of a FAILED run. The scheduler retries runs
+// whose retry is due; an operator may retry any failed run, including one
+// that has used all its attempts, which grants it one more.
+func (r JobRun) Retry(now time.Time) (JobRun, error) {
+	if !r.status.Equal(valueobject.RunStatusFailed) {
+		return r, fmt.Errorf("cannot retry %s run %s: %w", r.status, r.id, ErrInvalidTransition)
+	}
+	r.attempt++
+	r.maxAttempts = max(r.maxAttempts, r.attempt)
+	r.status = valueobject.RunStatusRunning
+	r.finishedAt = nil
+	r.nextRetryAt = nil
+	r.version++
+	return r, nil
+}
+
+// IsRetryDue reports whether the scheduler should retry the run at now.
+func (r JobRun) IsRetryDue(now time.Time) bool {
+	return r.status.Equal(valueobject.RunStatusFailed) && r.nextRetryAt != nil && !r.nextRetryAt.After(now)
+}
+
+// --- Accessors ---
+
+func (r JobRun) ID() uuid.UUID                   { return r.id }
+func (r JobRun) JobID() uuid.UUID                { return r.jobID }
+func (r JobRun) TenantID() uuid.UUID             { return r.tenantID }
+func (r JobRun) JobName() string                 { return r.jobName }
+func (r JobRun) Trigger() valueobject.RunTrigger { return r.trigger }
+func (r JobRun) Status() valueobject.RunStatus   { return r.status }
+func (r JobRun) ScheduledFor() time.Time         { return r.scheduledFor }
+func (r JobRun) Attempt() int                    { return r.attempt }
+func (r JobRun) MaxAttempts() int                { return r.maxAttempts }
+func (r JobRun) LastError() string               { return r.lastError }
+func (r JobRun) StartedAt() time.Time            { return r.startedAt }
+func (r JobRun) FinishedAt() *time.Time          { return r.finishedAt }
+func (r JobRun) NextRetryAt() *time.Time         { return r.nextRetryAt }
+func (r JobRun) Version() int                    { return r.version }
+
+// DomainEvents returns the uncommitted domain events.
+func (r JobRun) DomainEvents() []events.DomainEvent {
+	return r.domainEvents
+}
+
+// ClearDomainEvents returns a copy of the run with no uncommitted events.
+func (r JobRun) ClearDomainEvents() JobRun {
+	r.domainEvents = nil
+	return r
+}
@@ -0,0 +1,145 @@
+package model_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+)
+
+func definition() model.JobDefinition {
+	return model.JobDefinition{
+		Description: " Daily interest accrual ",
+		Schedule:    "0 1 * * *",
+		Target: model.JobTarget{
+			Kind:    valueobject.TargetKindGRPC,
+			Service: "deposit-service",
+			Method:  "/bib.deposit.v1.DepositService/AccrueInterest",
+			Payload: json.RawMessage(`{"as_of":"today"}`),
+		},
+		Retry: model.RetryPolicy{MaxAttempts: 3, Backoff: time.Minute},
+	}
+}
+
+func TestNewJob_Validation(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	job, err := model.NewJob("deposit.interest-accrual", definition(), now)
+	require.NoError(t, err)
+	assert.Equal(t, "Daily interest accrual", job.Description())
+	assert.True(t, job.Enabled())
+	assert.Equal(t, time.Date(2026, 3, 11, 1, 0, 0, 0, time.UTC), job.NextRunAt())
+	require.Len(t, job.DomainEvents(), 1)
+	assert.Equal(t, "scheduler.job.registered", job.DomainEvents()[0].EventType())
+
+	_, err = model.NewJob("Interest", definition(), now)
+	assert.Error(t, err, "names are lowercase")
+
+	def := definition()
+	def.Schedule = "0 0 30 2 *"
+	_, err = model.NewJob("never", def, now)
+	assert.Error(t, err, "schedule never fires")
+
+	def = definition()
+	def.Target.Method = "AccrueInterest"
+	_, err = model.NewJob("bad-method", def, now)
+	assert.Error(t, err, "method must be a full name")
+
+	def = definition()
+	def.Target = model.JobTarget{Kind: valueobject.TargetKindKafka}
+	_, err = model.NewJob("no-topic", def, now)
+	assert.Error(t, err, "Kafka targets need a topic")
+
+	def = definition()
+	def.Retry.MaxAttempts = 0
+	_, err = model.NewJob("no-attempts", def, now)
+	assert.Error(t, err)
+}
+
+func TestJob_AdvanceSkipsMissedRuns(t *testing.T) {
+	created := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	job, err := model.NewJob("deposit.interest-accrual", definition(), created)
+	require.NoError(t, err)
+
+	assert.False(t, job.IsDue(created))
+	_, _, err = job.Advance(created)
+	assert.True(t, errors.Is(err, model.ErrInvalidTransition))
+
+	// The scheduler was down for three days.
+	now := time.Date(2026, 3, 13, 9, 0, 0, 0, time.UTC)
+	require.True(t, job.IsDue(now))
+	advanced, scheduledFor, err := job.Advance(now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 11, 1, 0, 0, 0, time.UTC), scheduledFor)
+	assert.Equal(t, time.Date(2026, 3, 14, 1, 0, 0, 0, time.UTC), advanced.NextRunAt())
+	assert.Equal(t, scheduledFor, *advanced.LastRunAt())
+	assert.Equal(t, job.Version()+1, advanced.Version())
+}
+
+func TestJob_PauseAndResume(t *testing.T) {
+	created := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	job, err := model.NewJob("accounts.dormancy-sweep", definition(), created)
+	require.NoError(t, err)
+
+	paused, err := job.Pause(created)
+	require.NoError(t, err)
+	assert.False(t, paused.IsDue(created.AddDate(0, 0, 5)))
+	_, err = paused.Pause(created)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+
+	later := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	resumed, err := paused.Resume(later)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 21, 1, 0, 0, 0, time.UTC), resumed.NextRunAt(), "runs missed while paused are skipped")
+}
+
+func TestJobRun_RetriesUntilAttemptsRunOut(t *testing.T) {
+	now := time.Date(2026, 3, 11, 1, 0, 0, 0, time.UTC)
+	job, err := model.NewJob("deposit.interest-accrual", definition(), now.Add(-time.Hour))
+	require.NoError(t, err)
+	run := model.NewJobRun(job, valueobject.RunTriggerScheduled, now, now)
+	cause := errors.New("deposit service unavailable")
+
+	run, err = run.Fail(cause, job.Retry(), now)
+	require.NoError(t, err)
+	require.NotNil(t, run.NextRetryAt())
+	assert.Equal(t, now.Add(time.Minute), *run.NextRetryAt())
+	assert.False(t, run.IsRetryDue(now))
+	assert.True(t, run.IsRetryDue(now.Add(time.Minute)))
+
+	run, err = run.Retry(now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 2, run.Attempt())
+	run, err = run.Fail(cause, job.Retry(), now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(3*time.Minute), *run.NextRetryAt(), "backoff doubles")
+
+	run, err = run.Retry(now.Add(3 * time.Minute))
+	require.NoError(t, err)
+	run, err = run.Fail(cause, job.Retry(), now.Add(3*time.Minute))
+	require.NoError(t, err)
+	assert.Nil(t, run.NextRetryAt(), "no attempts left")
+	assert.Equal(t, "deposit service unavailable", run.LastError())
+
+	var types []string
+	for _, e := range run.DomainEvents() {
+		types = append(types, e.EventType())
+	}
+	assert.Equal(t, []string{"scheduler.run.failed", "scheduler.run.failed", "scheduler.run.failed"}, types)
+
+	// An operator can still retry it.
+	run, err = run.Retry(now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 4, run.Attempt())
+	run, err = run.Succeed(now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.RunStatusSucceeded, run.Status())
+	_, err = run.Retry(now.Add(time.Hour))
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+}
@@ -0,0 +1,118 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/event"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+)
+
+// ErrJobNotFound is returned when a job does not exist.
+var ErrJobNotFound = errors.New("job not found")
+
+// ErrRunNotFound is returned when a job run does not exist.
+var ErrRunNotFound = errors.New("job run not found")
+
+// ErrJobNameTaken is returned when another job already has the name.
+var ErrJobNameTaken = errors.New("job name is already taken")
+
+// ErrEODRunNotFound is returned when an end-of-day run does not exist.
+var ErrEODRunNotFound = errors.New("end-of-day run not found")
+
+// ErrEODRunExists is returned when the business date already has an
+// end-of-day run.
+var ErrEODRunExists = errors.New("business date already has an end-of-day run")
+
+// ErrTenantClockNotFound is returned when a tenant's time has never been
+// advanced.
+var ErrTenantClockNotFound = errors.New("tenant clock not found")
+
+// ErrVersionConflict is returned when a job, run or end-of-day run was
+// modified concurrently.
+var ErrVersionConflict = errors.New("modified concurrently")
+
+// JobRepository defines the persistence port for jobs.
+type JobRepository interface {
+	// Save persists the job, failing with ErrVersionConflict if it was
+	// modified since it was loaded.
+	Save(ctx context.Context, job model.Job) error
+
+	// FindByID retrieves a job.
+	FindByID(ctx context.Context, id uuid.UUID) (model.Job, error)
+
+	// FindByName retrieves a job by its name.
+	FindByName(ctx context.Context, name string) (model.Job, error)
+
+	// List returns jobs ordered by name and the total number of jobs.
+	List(ctx context.Context, limit, offset int) ([]model.Job, int, error)
+
+	// FindDue returns enabled jobs whose next run is at or before now,
+	// earliest first.
+	FindDue(ctx context.Context, now time.Time, limit int) ([]model.Job, error)
+}
+
+// RunRepository defines the persistence port for job runs.
+type RunRepository interface {
+	// Save persists the run, failing with ErrVersionConflict if it was
+	// modified since it was loaded.
+	Save(ctx context.Context, run model.JobRun) error
+
+	// FindByID retrieves a run.
+	FindByID(ctx context.Context, id uuid.UUID) (model.JobRun, error)
+
+	// ListByJob returns a job's runs, most recent first, and the total
+	// number of its runs.
+	ListByJob(ctx context.Context, jobID uuid.UUID, limit, offset int) ([]model.JobRun, int, error)
+
+	// FindRetryDue returns failed runs whose retry is at or before now,
+	// earliest first.
+	FindRetryDue(ctx context.Context, now time.Time, limit int) ([]model.JobRun, error)
+}
+
+// EODRunRepository defines the persistence port for end-of-day runs.
+type EODRunRepository interface {
+	// Save persists the run, failing with ErrVersionConflict if it was
+	// modified since it was loaded and with ErrEODRunExists if another run
+	// has its business date.
+	Save(ctx context.Context, run model.EODRun) error
+
+	// FindByID retrieves a run.
+	FindByID(ctx context.Context, id uuid.UUID) (model.EODRun, error)
+
+	// FindByBusinessDate retrieves the run of a business date.
+	FindByBusinessDate(ctx context.Context, businessDate time.Time) (model.EODRun, error)
+
+	// List returns runs, latest business date first, and the total number
+	// of runs.
+	List(ctx context.Context, limit, offset int) ([]model.EODRun, int, error)
+
+	// ListUnfinished returns the RUNNING and FAILED runs, earliest business
+	// date first.
+	ListUnfinished(ctx context.Context) ([]model.EODRun, error)
+}
+
+// TenantClockRepository defines the persistence port for sandbox tenants'
+// virtual business dates.
+type TenantClockRepository interface {
+	// Save persists the clock, failing with ErrVersionConflict if it was
+	// advanced since it was loaded.
+	Save(ctx context.Context, clock model.TenantClock) error
+	// Find retrieves a tenant's clock.
+	Find(ctx context.Context, tenantID uuid.UUID) (model.TenantClock, error)
+}
+
+// Dispatcher sends a job run's command to the service running the job.
+type Dispatcher interface {
+	// Dispatch sends the command for the run's current attempt. A nil error
+	// means the target accepted it.
+	Dispatch(ctx context.Context, job model.Job, run model.JobRun) error
+}
+
+// EventPublisher defines the port for publishing domain events.
+type EventPublisher interface {
+	Publish(ctx context.Context, events []event.DomainEvent) error
+}
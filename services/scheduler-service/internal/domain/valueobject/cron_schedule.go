@@ -0,0 +1,46 @@
+package valueobject
+
+import (
+	"time"
+
+	"github.com/bibbank/bib/pkg/cron"
+)
+
+// CronSchedule is a standard five-field cron expression (minute, hour, day
+// of month, month, day of week) evaluated in UTC, as parsed by cron.Parse.
+// It is an immutable value object.
+type CronSchedule struct {
+	schedule cron.Schedule
+}
+
+// NewCronSchedule parses a cron expression or one of the aliases @yearly,
+// @monthly, @weekly, @daily and @hourly.
+func NewCronSchedule(expr string) (CronSchedule, error) {
+	schedule, err := cron.Parse(expr)
+	if err != nil {
+		return CronSchedule{}, err
+	}
+	return CronSchedule{schedule: schedule}, nil
+}
+
+// Next returns the first time the schedule fires strictly after t, in UTC.
+// It returns the zero time if the schedule never fires, such as on
+// February 30th.
+func (c CronSchedule) Next(t time.Time) time.Time {
+	return c.schedule.Next(t)
+}
+
+// String returns the expression the schedule was parsed from.
+func (c CronSchedule) String() string {
+	return c.schedule.String()
+}
+
+// IsZero returns true if the CronSchedule has not been set.
+func (c CronSchedule) IsZero() bool {
+	return c.schedule.IsZero()
+}
+
+// Equal returns true if two CronSchedule values have the same expression.
+func (c CronSchedule) Equal(other CronSchedule) bool {
+	return c.String() == other.String()
+}
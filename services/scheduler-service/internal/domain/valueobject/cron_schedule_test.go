@@ -0,0 +1,79 @@
+package valueobject_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+)
+
+func at(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestCronSchedule_Next(t *testing.T) {
+	tests := []struct {
+		expr  string
+		after string
+		want  string
+	}{
+		{"*/15 * * * *", "2026-03-10T10:07:30Z", "2026-03-10T10:15:00Z"},
+		{"*/15 * * * *", "2026-03-10T10:15:00Z", "2026-03-10T10:30:00Z"},
+		{"30 1 * * *", "2026-03-10T02:00:00Z", "2026-03-11T01:30:00Z"},
+		{"0 9 * * 1-5", "2026-03-13T10:00:00Z", "2026-03-16T09:00:00Z"},
+		{"0 0 1 * *", "2026-12-15T00:00:00Z", "2027-01-01T00:00:00Z"},
+		{"0 0 29 2 *", "2026-03-01T00:00:00Z", "2028-02-29T00:00:00Z"},
+		{"0 6 1,15 * *", "2026-03-02T00:00:00Z", "2026-03-15T06:00:00Z"},
+		{"0 0 * * 7", "2026-03-10T00:00:00Z", "2026-03-15T00:00:00Z"},
+		{"@daily", "2026-03-10T23:59:00Z", "2026-03-11T00:00:00Z"},
+		{"@hourly", "2026-03-10T23:00:00Z", "2026-03-11T00:00:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr+" after "+tt.after, func(t *testing.T) {
+			c, err := valueobject.NewCronSchedule(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, at(tt.want), c.Next(at(tt.after)))
+		})
+	}
+}
+
+func TestCronSchedule_DayFieldsMatchEither(t *testing.T) {
+	// The 13th, or any Friday.
+	c, err := valueobject.NewCronSchedule("0 0 13 * 5")
+	require.NoError(t, err)
+
+	assert.Equal(t, at("2026-03-06T00:00:00Z"), c.Next(at("2026-03-01T00:00:00Z")), "Friday")
+	assert.Equal(t, at("2026-03-13T00:00:00Z"), c.Next(at("2026-03-06T00:00:00Z")), "the 13th")
+}
+
+func TestCronSchedule_NeverFires(t *testing.T) {
+	c, err := valueobject.NewCronSchedule("0 0 30 2 *")
+	require.NoError(t, err)
+	assert.True(t, c.Next(at("2026-01-01T00:00:00Z")).IsZero())
+}
+
+func TestNewCronSchedule_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"@fortnightly",
+	} {
+		_, err := valueobject.NewCronSchedule(expr)
+		assert.Error(t, err, expr)
+	}
+}
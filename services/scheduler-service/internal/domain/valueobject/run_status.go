@@ -0,0 +1,53 @@
+package valueobject
+
+import "fmt"
+
+// RunStatus is the state of a job run. A run is RUNNING while its command is
+// dispatched, then SUCCEEDED or FAILED. A FAILED run with attempts left is
+// retried, returning it to RUNNING.
+// It is an immutable value object.
+type RunStatus struct {
+	value string
+}
+
+const (
+	runStatusRunning   = "RUNNING"
+	runStatusSucceeded = "SUCCEEDED"
+	runStatusFailed    = "FAILED"
+)
+
+var (
+	RunStatusRunning   = RunStatus{value: runStatusRunning}
+	RunStatusSucceeded = RunStatus{value: runStatusSucceeded}
+	RunStatusFailed    = RunStatus{value: runStatusFailed}
+)
+
+var validRunStatuses = map[string]RunStatus{
+	runStatusRunning:   RunStatusRunning,
+	runStatusSucceeded: RunStatusSucceeded,
+	runStatusFailed:    RunStatusFailed,
+}
+
+// NewRunStatus creates a RunStatus from a string, validating it is known.
+func NewRunStatus(s string) (RunStatus, error) {
+	v, ok := validRunStatuses[s]
+	if !ok {
+		return RunStatus{}, fmt.Errorf("invalid run status: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the RunStatus.
+func (v RunStatus) String() string {
+	return v.value
+}
+
+// IsZero returns true if the RunStatus has not been set.
+func (v RunStatus) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two RunStatus values are equal.
+func (v RunStatus) Equal(other RunStatus) bool {
+	return v.value == other.value
+}
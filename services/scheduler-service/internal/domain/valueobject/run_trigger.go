@@ -0,0 +1,56 @@
+package valueobject
+
+import "fmt"
+
+// RunTrigger records why a job run was started: by its schedule, by an
+// operator asking for it to run now, as a step of an end-of-day run, or as
+// a step of a sandbox tenant's simulated end of day.
+// It is an immutable value object.
+type RunTrigger struct {
+	value string
+}
+
+const (
+	runTriggerScheduled  = "SCHEDULED"
+	runTriggerManual     = "MANUAL"
+	runTriggerEOD        = "EOD"
+	runTriggerSimulation = "SIMULATION"
+)
+
+var (
+	RunTriggerScheduled  = RunTrigger{value: runTriggerScheduled}
+	RunTriggerManual     = RunTrigger{value: runTriggerManual}
+	RunTriggerEOD        = RunTrigger{value: runTriggerEOD}
+	RunTriggerSimulation = RunTrigger{value: runTriggerSimulation}
+)
+
+var validRunTriggers = map[string]RunTrigger{
+	runTriggerScheduled:  RunTriggerScheduled,
+	runTriggerManual:     RunTriggerManual,
+	runTriggerEOD:        RunTriggerEOD,
+	runTriggerSimulation: RunTriggerSimulation,
+}
+
+// NewRunTrigger creates a RunTrigger from a string, validating it is known.
+func NewRunTrigger(s string) (RunTrigger, error) {
+	v, ok := validRunTriggers[s]
+	if !ok {
+		return RunTrigger{}, fmt.Errorf("invalid run trigger: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the RunTrigger.
+func (v RunTrigger) String() string {
+	return v.value
+}
+
+// IsZero returns true if the RunTrigger has not been set.
+func (v RunTrigger) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two RunTrigger values are equal.
+func (v RunTrigger) Equal(other RunTrigger) bool {
+	return v.value == other.value
+}
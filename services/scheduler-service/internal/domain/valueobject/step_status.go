@@ -0,0 +1,57 @@
+package valueobject
+
+import "fmt"
+
+// StepStatus is the state of a step of an end-of-day run. A step is PENDING
+// until the steps it depends on have succeeded, RUNNING while its job run
+// is dispatched or retried, then SUCCEEDED or FAILED. Resuming a failed
+// end-of-day run returns its FAILED steps to PENDING.
+// It is an immutable value object.
+type StepStatus struct {
+	value string
+}
+
+const (
+	stepStatusPending   = "PENDING"
+	stepStatusRunning   = "RUNNING"
+	stepStatusSucceeded = "SUCCEEDED"
+	stepStatusFailed    = "FAILED"
+)
+
+var (
+	StepStatusPending   = StepStatus{value: stepStatusPending}
+	StepStatusRunning   = StepStatus{value: stepStatusRunning}
+	StepStatusSucceeded = StepStatus{value: stepStatusSucceeded}
+	StepStatusFailed    = StepStatus{value: stepStatusFailed}
+)
+
+var validStepStatuses = map[string]StepStatus{
+	stepStatusPending:   StepStatusPending,
+	stepStatusRunning:   StepStatusRunning,
+	stepStatusSucceeded: StepStatusSucceeded,
+	stepStatusFailed:    StepStatusFailed,
+}
+
+// NewStepStatus creates a StepStatus from a string, validating it is known.
+func NewStepStatus(s string) (StepStatus, error) {
+	v, ok := validStepStatuses[s]
+	if !ok {
+		return StepStatus{}, fmt.Errorf("invalid step status: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the StepStatus.
+func (v StepStatus) String() string {
+	return v.value
+}
+
+// IsZero returns true if the StepStatus has not been set.
+func (v StepStatus) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two StepStatus values are equal.
+func (v StepStatus) Equal(other StepStatus) bool {
+	return v.value == other.value
+}
@@ -0,0 +1,49 @@
+package valueobject
+
+import "fmt"
+
+// TargetKind is how a job's command reaches the service running it: a gRPC
+// call to one of its methods, or a message on a Kafka topic it consumes.
+// It is an immutable value object.
+type TargetKind struct {
+	value string
+}
+
+const (
+	targetKindGRPC  = "GRPC"
+	targetKindKafka = "KAFKA"
+)
+
+var (
+	TargetKindGRPC  = TargetKind{value: targetKindGRPC}
+	TargetKindKafka = TargetKind{value: targetKindKafka}
+)
+
+var validTargetKinds = map[string]TargetKind{
+	targetKindGRPC:  TargetKindGRPC,
+	targetKindKafka: TargetKindKafka,
+}
+
+// NewTargetKind creates a TargetKind from a string, validating it is known.
+func NewTargetKind(s string) (TargetKind, error) {
+	v, ok := validTargetKinds[s]
+	if !ok {
+		return TargetKind{}, fmt.Errorf("invalid target kind: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the TargetKind.
+func (v TargetKind) String() string {
+	return v.value
+}
+
+// IsZero returns true if the TargetKind has not been set.
+func (v TargetKind) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two TargetKind values are equal.
+func (v TargetKind) Equal(other TargetKind) bool {
+	return v.value == other.value
+}
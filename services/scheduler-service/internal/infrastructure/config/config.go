@@ -0,0 +1,176 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type DatabaseConfig struct {
+	Host     string
+	User     string
+	Password string
+	Name     string
+	SSLMode  string
+	Port     int
+}
+
+type KafkaConfig struct {
+	Brokers []string
+}
+
+// SchedulerConfig configures how jobs are run.
+type SchedulerConfig struct {
+	// Targets maps the service names GRPC job targets use to the services'
+	// gRPC addresses.
+	Targets map[string]string
+	// SigningKeyFile holds the private key used to sign the tokens jobs
+	// call other services with. Without it the gateway's JWT secret is used.
+	SigningKeyFile string
+	// PollInterval is how often the leader looks for due jobs and retries.
+	PollInterval time.Duration
+	// DispatchTimeout bounds each dispatch of a run's command.
+	DispatchTimeout time.Duration
+	// EODSchedule is the cron expression on which the day's end-of-day
+	// close starts; empty leaves starting it to operators.
+	EODSchedule string
+	// EODJobs maps end-of-day step names to the jobs they run, for steps
+	// whose job is not named after the step.
+	EODJobs map[string]string
+	// Sandbox lets sandbox tenants advance their own virtual time, running
+	// the end-of-day steps on their behalf. Only sandbox deployments set it.
+	Sandbox bool
+}
+
+type Config struct {
+	DB          DatabaseConfig
+	ServiceName string
+	Kafka       KafkaConfig
+	Scheduler   SchedulerConfig
+	Outbox      OutboxConfig
+	GRPCPort    int
+	HTTPPort    int
+}
+
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+func Load() Config {
+	return Config{
+		GRPCPort: getEnvInt("GRPC_PORT", 9095),
+		HTTPPort: getEnvInt("HTTP_PORT", 8095),
+		DB: DatabaseConfig{
+			Host:     getEnv("DB_HOST", "localhost"),
+			Port:     getEnvInt("DB_PORT", 5432),
+			User:     getEnv("DB_USER", "bib"),
+			Password: getEnv("DB_PASSWORD", ""),
+			Name:     getEnv("DB_NAME", "bib_scheduler"),
+			SSLMode:  getEnv("DB_SSLMODE", "require"),
+		},
+		Kafka: KafkaConfig{
+			Brokers: []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+		},
+		Scheduler: SchedulerConfig{
+			Targets:         getEnvMap("SCHEDULER_TARGETS"),
+			SigningKeyFile:  getEnv("SCHEDULER_SIGNING_KEY_FILE", ""),
+			PollInterval:    getEnvDuration("SCHEDULER_POLL_INTERVAL", 15*time.Second),
+			DispatchTimeout: getEnvDuration("SCHEDULER_DISPATCH_TIMEOUT", 2*time.Minute),
+			EODSchedule:     getEnv("SCHEDULER_EOD_SCHEDULE", ""),
+			EODJobs:         getEnvMap("SCHEDULER_EOD_JOBS"),
+			Sandbox:         getEnvBool("SANDBOX_MODE", false),
+		},
+		ServiceName: "scheduler-service",
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
+	}
+}
+
+func (c Config) GRPCAddr() string {
+	return fmt.Sprintf(":%d", c.GRPCPort)
+}
+
+func (c Config) HTTPAddr() string {
+	return fmt.Sprintf(":%d", c.HTTPPort)
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) int {
+	if v := os.Getenv(key); v != "" {
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+	return fallback
+}
+
+func getEnvBool(key string, fallback bool) bool {
+	if v := os.Getenv(key); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+	return fallback
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return fallback
+}
+
+// getEnvMap reads a comma-separated list of name=value pairs, ignoring
+// blank or malformed entries.
+func getEnvMap(key string) map[string]string {
+	m := map[string]string{}
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(v), "=")
+		if ok && name != "" && value != "" {
+			m[strings.TrimSpace(name)] = strings.TrimSpace(value)
+		}
+	}
+	return m
+}
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
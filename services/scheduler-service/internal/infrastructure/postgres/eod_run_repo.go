@@ -0,0 +1,224 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/port"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+)
+
+const eodRunColumns = `
+	id, business_date, trigger, status, steps, started_at, updated_at, finished_at, version`
+
+// eodStepRow is how a step of an end-of-day run is stored in the steps
+// column.
+type eodStepRow struct {
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	RunID      *uuid.UUID `json:"run_id,omitempty"`
+	Name       string     `json:"name"`
+	JobName    string     `json:"job_name"`
+	Status     string     `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	DependsOn  []string   `json:"depends_on,omitempty"`
+}
+
+// EODRunRepo is the PostgreSQL implementation of EODRunRepository.
+type EODRunRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewEODRunRepo creates a new EODRunRepo.
+func NewEODRunRepo(pool *pgxpool.Pool) *EODRunRepo {
+	return &EODRunRepo{pool: pool}
+}
+
+// Save persists the run with optimistic locking on its version.
+func (r *EODRunRepo) Save(ctx context.Context, run model.EODRun) error {
+	const upsertEODRunSQL = `
+		INSERT INTO eod_runs (
+			id, business_date, trigger, status, steps, started_at, updated_at, finished_at, version
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			steps = EXCLUDED.steps,
+			updated_at = EXCLUDED.updated_at,
+			finished_at = EXCLUDED.finished_at,
+			version = EXCLUDED.version
+		WHERE eod_runs.version = EXCLUDED.version - 1
+	`
+
+	steps := run.Steps()
+	rows := make([]eodStepRow, 0, len(steps))
+	for _, s := range steps {
+		rows = append(rows, eodStepRow{
+			Name:       s.Name,
+			JobName:    s.JobName,
+			DependsOn:  s.DependsOn,
+			Status:     s.Status.String(),
+			RunID:      s.RunID,
+			Error:      s.Error,
+			StartedAt:  s.StartedAt,
+			FinishedAt: s.FinishedAt,
+		})
+	}
+	stepsJSON, err := json.Marshal(rows)
+	if err != nil {
+		return fmt.Errorf("failed to marshal end-of-day steps: %w", err)
+	}
+
+	result, err := r.pool.Exec(ctx, upsertEODRunSQL,
+		run.ID(),
+		run.BusinessDate(),
+		run.Trigger().String(),
+		run.Status().String(),
+		stepsJSON,
+		run.StartedAt(),
+		run.UpdatedAt(),
+		run.FinishedAt(),
+		run.Version(),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: %s", port.ErrEODRunExists, run.BusinessDate().Format(time.DateOnly))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to upsert end-of-day run: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: end-of-day run %s", port.ErrVersionConflict, run.ID())
+	}
+	return nil
+}
+
+// FindByID retrieves a run.
+func (r *EODRunRepo) FindByID(ctx context.Context, id uuid.UUID) (model.EODRun, error) {
+	return r.findOne(ctx, `SELECT `+eodRunColumns+` FROM eod_runs WHERE id = $1`, id)
+}
+
+// FindByBusinessDate retrieves the run of a business date.
+func (r *EODRunRepo) FindByBusinessDate(ctx context.Context, businessDate time.Time) (model.EODRun, error) {
+	return r.findOne(ctx, `SELECT `+eodRunColumns+` FROM eod_runs WHERE business_date = $1`, model.BusinessDate(businessDate))
+}
+
+// List returns runs, latest business date first, and the total number of
+// runs.
+func (r *EODRunRepo) List(ctx context.Context, limit, offset int) ([]model.EODRun, int, error) {
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM eod_runs`).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count end-of-day runs: %w", err)
+	}
+	runs, err := r.query(ctx, `SELECT `+eodRunColumns+` FROM eod_runs ORDER BY business_date DESC LIMIT $1 OFFSET $2`, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return runs, total, nil
+}
+
+// ListUnfinished returns the RUNNING and FAILED runs, earliest business
+// date first.
+func (r *EODRunRepo) ListUnfinished(ctx context.Context) ([]model.EODRun, error) {
+	const unfinishedQuery = `SELECT ` + eodRunColumns + `
+		FROM eod_runs
+		WHERE status IN ('RUNNING', 'FAILED')
+		ORDER BY business_date
+	`
+	return r.query(ctx, unfinishedQuery)
+}
+
+func (r *EODRunRepo) findOne(ctx context.Context, query string, args ...any) (model.EODRun, error) {
+	run, err := scanEODRun(r.pool.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.EODRun{}, port.ErrEODRunNotFound
+	}
+	if err != nil {
+		return model.EODRun{}, fmt.Errorf("failed to scan end-of-day run: %w", err)
+	}
+	return run, nil
+}
+
+func (r *EODRunRepo) query(ctx context.Context, query string, args ...any) ([]model.EODRun, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query end-of-day runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []model.EODRun
+	for rows.Next() {
+		run, err := scanEODRun(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan end-of-day run row: %w", err)
+		}
+		runs = append(runs, run)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return runs, nil
+}
+
+func scanEODRun(row pgx.Row) (model.EODRun, error) {
+	var (
+		id           uuid.UUID
+		businessDate time.Time
+		triggerStr   string
+		statusStr    string
+		stepsJSON    []byte
+		startedAt    time.Time
+		updatedAt    time.Time
+		finishedAt   *time.Time
+		version      int
+	)
+
+	err := row.Scan(&id, &businessDate, &triggerStr, &statusStr, &stepsJSON,
+		&startedAt, &updatedAt, &finishedAt, &version)
+	if err != nil {
+		return model.EODRun{}, err
+	}
+
+	trigger, err := valueobject.NewRunTrigger(triggerStr)
+	if err != nil {
+		return model.EODRun{}, fmt.Errorf("invalid run trigger in database: %w", err)
+	}
+	status, err := valueobject.NewRunStatus(statusStr)
+	if err != nil {
+		return model.EODRun{}, fmt.Errorf("invalid run status in database: %w", err)
+	}
+	var rows []eodStepRow
+	if err := json.Unmarshal(stepsJSON, &rows); err != nil {
+		return model.EODRun{}, fmt.Errorf("failed to unmarshal end-of-day steps: %w", err)
+	}
+	steps := make([]model.EODStep, 0, len(rows))
+	for _, s := range rows {
+		stepStatus, err := valueobject.NewStepStatus(s.Status)
+		if err != nil {
+			return model.EODRun{}, fmt.Errorf("invalid step status in database: %w", err)
+		}
+		steps = append(steps, model.EODStep{
+			Name:       s.Name,
+			JobName:    s.JobName,
+			DependsOn:  s.DependsOn,
+			Status:     stepStatus,
+			RunID:      s.RunID,
+			Error:      s.Error,
+			StartedAt:  s.StartedAt,
+			FinishedAt: s.FinishedAt,
+		})
+	}
+
+	return model.ReconstructEODRun(
+		id, model.BusinessDate(businessDate), trigger, status, steps,
+		startedAt, updatedAt, finishedAt, version,
+	), nil
+}
@@ -0,0 +1,212 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/port"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+)
+
+const jobColumns = `
+	id, name, description, schedule, target_kind, target_service, target_method,
+	target_topic, target_payload, max_attempts, retry_backoff_ms, enabled,
+	next_run_at, last_run_at, version, created_at, updated_at`
+
+// uniqueViolation is the PostgreSQL error code for a unique constraint
+// violation.
+const uniqueViolation = "23505"
+
+// JobRepo is the PostgreSQL implementation of JobRepository.
+type JobRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewJobRepo creates a new JobRepo.
+func NewJobRepo(pool *pgxpool.Pool) *JobRepo {
+	return &JobRepo{pool: pool}
+}
+
+// Save persists the job with optimistic locking on its version.
+func (r *JobRepo) Save(ctx context.Context, job model.Job) error {
+	const upsertJobSQL = `
+		INSERT INTO jobs (
+			id, name, description, schedule, target_kind, target_service, target_method,
+			target_topic, target_payload, max_attempts, retry_backoff_ms, enabled,
+			next_run_at, last_run_at, version, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		ON CONFLICT (id) DO UPDATE SET
+			description = EXCLUDED.description,
+			schedule = EXCLUDED.schedule,
+			target_kind = EXCLUDED.target_kind,
+			target_service = EXCLUDED.target_service,
+			target_method = EXCLUDED.target_method,
+			target_topic = EXCLUDED.target_topic,
+			target_payload = EXCLUDED.target_payload,
+			max_attempts = EXCLUDED.max_attempts,
+			retry_backoff_ms = EXCLUDED.retry_backoff_ms,
+			enabled = EXCLUDED.enabled,
+			next_run_at = EXCLUDED.next_run_at,
+			last_run_at = EXCLUDED.last_run_at,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE jobs.version = EXCLUDED.version - 1
+	`
+
+	target := job.Target()
+	result, err := r.pool.Exec(ctx, upsertJobSQL,
+		job.ID(),
+		job.Name(),
+		job.Description(),
+		job.Schedule().String(),
+		target.Kind.String(),
+		target.Service,
+		target.Method,
+		target.Topic,
+		[]byte(target.Payload),
+		job.Retry().MaxAttempts,
+		job.Retry().Backoff.Milliseconds(),
+		job.Enabled(),
+		job.NextRunAt(),
+		job.LastRunAt(),
+		job.Version(),
+		job.CreatedAt(),
+		job.UpdatedAt(),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: %s", port.ErrJobNameTaken, job.Name())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to upsert job: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: job %s", port.ErrVersionConflict, job.ID())
+	}
+	return nil
+}
+
+// FindByID retrieves a job.
+func (r *JobRepo) FindByID(ctx context.Context, id uuid.UUID) (model.Job, error) {
+	j, err := scanJob(r.pool.QueryRow(ctx, `SELECT `+jobColumns+` FROM jobs WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Job{}, port.ErrJobNotFound
+	}
+	if err != nil {
+		return model.Job{}, fmt.Errorf("failed to scan job: %w", err)
+	}
+	return j, nil
+}
+
+// FindByName retrieves a job by its unique name.
+func (r *JobRepo) FindByName(ctx context.Context, name string) (model.Job, error) {
+	j, err := scanJob(r.pool.QueryRow(ctx, `SELECT `+jobColumns+` FROM jobs WHERE name = $1`, name))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Job{}, port.ErrJobNotFound
+	}
+	if err != nil {
+		return model.Job{}, fmt.Errorf("failed to scan job: %w", err)
+	}
+	return j, nil
+}
+
+// List returns jobs ordered by name and the total number of jobs.
+func (r *JobRepo) List(ctx context.Context, limit, offset int) ([]model.Job, int, error) {
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM jobs`).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count jobs: %w", err)
+	}
+	jobs, err := r.query(ctx, `SELECT `+jobColumns+` FROM jobs ORDER BY name LIMIT $1 OFFSET $2`, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return jobs, total, nil
+}
+
+// FindDue returns enabled jobs whose next run is at or before now, earliest
+// first.
+func (r *JobRepo) FindDue(ctx context.Context, now time.Time, limit int) ([]model.Job, error) {
+	const dueQuery = `SELECT ` + jobColumns + `
+		FROM jobs
+		WHERE enabled AND next_run_at <= $1
+		ORDER BY next_run_at
+		LIMIT $2
+	`
+	return r.query(ctx, dueQuery, now, limit)
+}
+
+func (r *JobRepo) query(ctx context.Context, query string, args ...any) ([]model.Job, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []model.Job
+	for rows.Next() {
+		j, err := scanJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job row: %w", err)
+		}
+		jobs = append(jobs, j)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return jobs, nil
+}
+
+func scanJob(row pgx.Row) (model.Job, error) {
+	var (
+		id             uuid.UUID
+		name           string
+		description    string
+		scheduleStr    string
+		kindStr        string
+		service        string
+		method         string
+		topic          string
+		payload        []byte
+		maxAttempts    int
+		retryBackoffMS int64
+		enabled        bool
+		nextRunAt      time.Time
+		lastRunAt      *time.Time
+		version        int
+		createdAt      time.Time
+		updatedAt      time.Time
+	)
+
+	err := row.Scan(&id, &name, &description, &scheduleStr, &kindStr, &service, &method,
+		&topic, &payload, &maxAttempts, &retryBackoffMS, &enabled,
+		&nextRunAt, &lastRunAt, &version, &createdAt, &updatedAt)
+	if err != nil {
+		return model.Job{}, err
+	}
+
+	schedule, err := valueobject.NewCronSchedule(scheduleStr)
+	if err != nil {
+		return model.Job{}, fmt.Errorf("invalid schedule in database: %w", err)
+	}
+	kind, err := valueobject.NewTargetKind(kindStr)
+	if err != nil {
+		return model.Job{}, fmt.Errorf("invalid target kind in database: %w", err)
+	}
+
+	return model.ReconstructJob(
+		id, name, description, schedule,
+		model.JobTarget{Kind: kind, Service: service, Method: method, Topic: topic, Payload: json.RawMessage(payload)},
+		model.RetryPolicy{MaxAttempts: maxAttempts, Backoff: time.Duration(retryBackoffMS) * time.Millisecond},
+		enabled, nextRunAt, lastRunAt,
+		version, createdAt, updatedAt,
+	), nil
+}
@@ -0,0 +1,44 @@
+-- Jobs are batch jobs run by other services that the scheduler triggers on
+-- a cron schedule. The target says where each run's command is sent.
+CREATE TABLE IF NOT EXISTS jobs (
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    schedule VARCHAR(100) NOT NULL,
+    target_kind VARCHAR(10) NOT NULL,
+    target_service VARCHAR(100) NOT NULL DEFAULT '',
+    target_method VARCHAR(255) NOT NULL DEFAULT '',
+    target_topic VARCHAR(255) NOT NULL DEFAULT '',
+    target_payload JSONB NOT NULL DEFAULT '{}',
+    max_attempts INT NOT NULL DEFAULT 1,
+    retry_backoff_ms BIGINT NOT NULL DEFAULT 0,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    next_run_at TIMESTAMPTZ NOT NULL,
+    last_run_at TIMESTAMPTZ,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_jobs_name ON jobs(name);
+CREATE INDEX idx_jobs_due ON jobs(next_run_at) WHERE enabled;
+
+-- Job runs are the run history: one row per run, updated as it is retried.
+CREATE TABLE IF NOT EXISTS job_runs (
+    id UUID PRIMARY KEY,
+    job_id UUID NOT NULL REFERENCES jobs(id),
+    job_name VARCHAR(100) NOT NULL,
+    trigger VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    scheduled_for TIMESTAMPTZ NOT NULL,
+    attempt INT NOT NULL DEFAULT 1,
+    max_attempts INT NOT NULL DEFAULT 1,
+    last_error TEXT NOT NULL DEFAULT '',
+    started_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ,
+    next_retry_at TIMESTAMPTZ,
+    version INT NOT NULL DEFAULT 1
+);
+
+CREATE INDEX idx_job_runs_job ON job_runs(job_id, started_at DESC);
+CREATE INDEX idx_job_runs_retry ON job_runs(next_retry_at) WHERE status = 'FAILED' AND next_retry_at IS NOT NULL;
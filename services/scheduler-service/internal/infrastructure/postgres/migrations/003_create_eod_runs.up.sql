@@ -0,0 +1,17 @@
+-- EOD runs are the end-of-day closes of business dates, one row per date.
+-- Steps holds each step's status and the job run of its current attempt,
+-- and is rewritten as the close advances so it resumes after a restart.
+CREATE TABLE IF NOT EXISTS eod_runs (
+    id UUID PRIMARY KEY,
+    business_date DATE NOT NULL,
+    trigger VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    steps JSONB NOT NULL DEFAULT '[]',
+    started_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ,
+    version INT NOT NULL DEFAULT 1
+);
+
+CREATE UNIQUE INDEX idx_eod_runs_business_date ON eod_runs(business_date);
+CREATE INDEX idx_eod_runs_unfinished ON eod_runs(business_date) WHERE status IN ('RUNNING', 'FAILED');
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS tenant_clocks;
+ALTER TABLE job_runs DROP COLUMN IF EXISTS tenant_id;
@@ -0,0 +1,12 @@
+-- Simulation runs are dispatched on behalf of one sandbox tenant; other
+-- runs are for all tenants and leave tenant_id NULL.
+ALTER TABLE job_runs ADD COLUMN IF NOT EXISTS tenant_id UUID;
+
+-- Tenant clocks hold each sandbox tenant's virtual business date, the first
+-- date whose end of day has not been simulated for it.
+CREATE TABLE IF NOT EXISTS tenant_clocks (
+    tenant_id UUID PRIMARY KEY,
+    business_date DATE NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    version INT NOT NULL DEFAULT 1
+);
@@ -0,0 +1,190 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/port"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+)
+
+const runColumns = `
+	id, job_id, job_name, trigger, status, scheduled_for, attempt, max_attempts,
+	last_error, started_at, finished_at, next_retry_at, version, tenant_id`
+
+// RunRepo is the PostgreSQL implementation of RunRepository.
+type RunRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewRunRepo creates a new RunRepo.
+func NewRunRepo(pool *pgxpool.Pool) *RunRepo {
+	return &RunRepo{pool: pool}
+}
+
+// Save persists the run with optimistic locking on its version.
+func (r *RunRepo) Save(ctx context.Context, run model.JobRun) error {
+	const upsertRunSQL = `
+		INSERT INTO job_runs (
+			id, job_id, job_name, trigger, status, scheduled_for, attempt, max_attempts,
+			last_error, started_at, finished_at, next_retry_at, version, tenant_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			attempt = EXCLUDED.attempt,
+			max_attempts = EXCLUDED.max_attempts,
+			last_error = EXCLUDED.last_error,
+			finished_at = EXCLUDED.finished_at,
+			next_retry_at = EXCLUDED.next_retry_at,
+			version = EXCLUDED.version
+		WHERE job_runs.version = EXCLUDED.version - 1
+	`
+
+	result, err := r.pool.Exec(ctx, upsertRunSQL,
+		run.ID(),
+		run.JobID(),
+		run.JobName(),
+		run.Trigger().String(),
+		run.Status().String(),
+		run.ScheduledFor(),
+		run.Attempt(),
+		run.MaxAttempts(),
+		run.LastError(),
+		run.StartedAt(),
+		run.FinishedAt(),
+		run.NextRetryAt(),
+		run.Version(),
+		nullTenant(run.TenantID()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert job run: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: job run %s", port.ErrVersionConflict, run.ID())
+	}
+	return nil
+}
+
+// FindByID retrieves a run.
+func (r *RunRepo) FindByID(ctx context.Context, id uuid.UUID) (model.JobRun, error) {
+	run, err := scanRun(r.pool.QueryRow(ctx, `SELECT `+runColumns+` FROM job_runs WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.JobRun{}, port.ErrRunNotFound
+	}
+	if err != nil {
+		return model.JobRun{}, fmt.Errorf("failed to scan job run: %w", err)
+	}
+	return run, nil
+}
+
+// ListByJob returns a job's runs, most recent first, and the total number of
+// its runs.
+func (r *RunRepo) ListByJob(ctx context.Context, jobID uuid.UUID, limit, offset int) ([]model.JobRun, int, error) {
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM job_runs WHERE job_id = $1`, jobID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count job runs: %w", err)
+	}
+	const listQuery = `SELECT ` + runColumns + `
+		FROM job_runs
+		WHERE job_id = $1
+		ORDER BY started_at DESC
+		LIMIT $2 OFFSET $3
+	`
+	runs, err := r.query(ctx, listQuery, jobID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return runs, total, nil
+}
+
+// FindRetryDue returns failed runs whose retry is at or before now, earliest
+// first.
+func (r *RunRepo) FindRetryDue(ctx context.Context, now time.Time, limit int) ([]model.JobRun, error) {
+	const retryQuery = `SELECT ` + runColumns + `
+		FROM job_runs
+		WHERE status = 'FAILED' AND next_retry_at IS NOT NULL AND next_retry_at <= $1
+		ORDER BY next_retry_at
+		LIMIT $2
+	`
+	return r.query(ctx, retryQuery, now, limit)
+}
+
+func (r *RunRepo) query(ctx context.Context, query string, args ...any) ([]model.JobRun, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query job runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []model.JobRun
+	for rows.Next() {
+		run, err := scanRun(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job run row: %w", err)
+		}
+		runs = append(runs, run)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return runs, nil
+}
+
+func scanRun(row pgx.Row) (model.JobRun, error) {
+	var (
+		id           uuid.UUID
+		jobID        uuid.UUID
+		jobName      string
+		triggerStr   string
+		statusStr    string
+		scheduledFor time.Time
+		attempt      int
+		maxAttempts  int
+		lastError    string
+		startedAt    time.Time
+		finishedAt   *time.Time
+		nextRetryAt  *time.Time
+		version      int
+		tenantID     *uuid.UUID
+	)
+
+	err := row.Scan(&id, &jobID, &jobName, &triggerStr, &statusStr, &scheduledFor, &attempt, &maxAttempts,
+		&lastError, &startedAt, &finishedAt, &nextRetryAt, &version, &tenantID)
+	if err != nil {
+		return model.JobRun{}, err
+	}
+
+	trigger, err := valueobject.NewRunTrigger(triggerStr)
+	if err != nil {
+		return model.JobRun{}, fmt.Errorf("invalid run trigger in database: %w", err)
+	}
+	status, err := valueobject.NewRunStatus(statusStr)
+	if err != nil {
+		return model.JobRun{}, fmt.Errorf("invalid run status in database: %w", err)
+	}
+
+	var tenant uuid.UUID
+	if tenantID != nil {
+		tenant = *tenantID
+	}
+	return model.ReconstructJobRun(
+		id, jobID, tenant, jobName, trigger, status, scheduledFor,
+		attempt, maxAttempts, lastError,
+		startedAt, finishedAt, nextRetryAt, version,
+	), nil
+}
+
+// nullTenant stores the nil tenant of a run for all tenants as NULL.
+func nullTenant(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/port"
+)
+
+// TenantClockRepo is the PostgreSQL implementation of TenantClockRepository.
+type TenantClockRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewTenantClockRepo creates a new TenantClockRepo.
+func NewTenantClockRepo(pool *pgxpool.Pool) *TenantClockRepo {
+	return &TenantClockRepo{pool: pool}
+}
+
+// Save persists the clock with optimistic locking on its version.
+func (r *TenantClockRepo) Save(ctx context.Context, clock model.TenantClock) error {
+	const upsertTenantClockSQL = `
+		INSERT INTO tenant_clocks (tenant_id, business_date, updated_at, version)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (tenant_id) DO UPDATE SET
+			business_date = EXCLUDED.business_date,
+			updated_at = EXCLUDED.updated_at,
+			version = EXCLUDED.version
+		WHERE tenant_clocks.version = EXCLUDED.version - 1
+	`
+	result, err := r.pool.Exec(ctx, upsertTenantClockSQL,
+		clock.TenantID(), clock.BusinessDate(), clock.UpdatedAt(), clock.Version())
+	if err != nil {
+		return fmt.Errorf("failed to upsert tenant clock: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: tenant clock %s", port.ErrVersionConflict, clock.TenantID())
+	}
+	return nil
+}
+
+// Find retrieves a tenant's clock.
+func (r *TenantClockRepo) Find(ctx context.Context, tenantID uuid.UUID) (model.TenantClock, error) {
+	var (
+		businessDate time.Time
+		updatedAt    time.Time
+		version      int
+	)
+	err := r.pool.QueryRow(ctx, `
+		SELECT business_date, updated_at, version FROM tenant_clocks WHERE tenant_id = $1
+	`, tenantID).Scan(&businessDate, &updatedAt, &version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.TenantClock{}, port.ErrTenantClockNotFound
+	}
+	if err != nil {
+		return model.TenantClock{}, fmt.Errorf("failed to find tenant clock: %w", err)
+	}
+	return model.ReconstructTenantClock(tenantID, model.BusinessDate(businessDate), updatedAt, version), nil
+}
@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	schedulerv1 "github.com/bibbank/bib/api/gen/go/bib/scheduler/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/scheduler-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/event"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/port"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+)
+
+// contractJobRepo holds at most one job.
+type contractJobRepo struct {
+	job *model.Job
+}
+
+func (r *contractJobRepo) Save(context.Context, model.Job) error { return nil }
+
+func (r *contractJobRepo) FindByID(context.Context, uuid.UUID) (model.Job, error) {
+	if r.job == nil {
+		return model.Job{}, port.ErrJobNotFound
+	}
+	return *r.job, nil
+}
+
+func (r *contractJobRepo) FindByName(context.Context, string) (model.Job, error) {
+	return model.Job{}, port.ErrJobNotFound
+}
+
+func (r *contractJobRepo) List(context.Context, int, int) ([]model.Job, int, error) {
+	return nil, 0, nil
+}
+
+func (r *contractJobRepo) FindDue(context.Context, time.Time, int) ([]model.Job, error) {
+	return nil, nil
+}
+
+type discardPublisher struct{}
+
+func (discardPublisher) Publish(context.Context, []event.DomainEvent) error { return nil }
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	jobs := &contractJobRepo{}
+	h := NewSchedulerServiceHandler(
+		usecase.NewRegisterJobUseCase(jobs, discardPublisher{}), nil, usecase.NewGetJobUseCase(jobs),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		slog.Default(),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { schedulerv1.RegisterSchedulerServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: uuid.New(),
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-scheduler-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { jobs.job = nil },
+		"an enabled job is registered": func(t *testing.T) {
+			job, err := model.NewJob("deposit.accrue-interest", model.JobDefinition{
+				Description: "Nightly interest accrual",
+				Schedule:    "@daily",
+				Target: model.JobTarget{
+					Kind:    valueobject.TargetKindGRPC,
+					Service: "deposit-service",
+					Method:  "/bib.deposit.v1.DepositService/AccrueInterest",
+				},
+				Retry: model.RetryPolicy{MaxAttempts: 3, Backoff: 30 * time.Second},
+			}, time.Now().UTC())
+			if err != nil {
+				t.Fatal(err)
+			}
+			jobs.job = &job
+		},
+	})
+}
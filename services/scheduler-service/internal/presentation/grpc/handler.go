@@ -0,0 +1,692 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	schedulerv1 "github.com/bibbank/bib/api/gen/go/bib/scheduler/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/scheduler-service/internal/application/dto"
+	"github.com/bibbank/bib/services/scheduler-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/port"
+)
+
+// tenantIDFromContext extracts the tenant ID from JWT claims in the context.
+func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return claims.TenantID, nil
+}
+
+// requireRole checks that the caller has at least one of the given roles.
+func requireRole(ctx context.Context, roles ...string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "insufficient permissions")
+}
+
+// Compile-time assertion that SchedulerServiceHandler implements SchedulerServiceServer.
+var _ schedulerv1.SchedulerServiceServer = (*SchedulerServiceHandler)(nil)
+
+// SchedulerServiceHandler implements the gRPC SchedulerServiceServer interface.
+type SchedulerServiceHandler struct {
+	schedulerv1.UnimplementedSchedulerServiceServer
+	registerJobUC *usecase.RegisterJobUseCase
+	updateJobUC   *usecase.UpdateJobUseCase
+	getJobUC      *usecase.GetJobUseCase
+	listJobsUC    *usecase.ListJobsUseCase
+	pauseJobUC    *usecase.PauseJobUseCase
+	resumeJobUC   *usecase.ResumeJobUseCase
+	triggerJobUC  *usecase.TriggerJobUseCase
+	retryRunUC    *usecase.RetryRunUseCase
+	getRunUC      *usecase.GetRunUseCase
+	listRunsUC    *usecase.ListRunsUseCase
+	startEODUC    *usecase.StartEODRunUseCase
+	getEODUC      *usecase.GetEODRunUseCase
+	listEODUC     *usecase.ListEODRunsUseCase
+	resumeEODUC   *usecase.ResumeEODRunUseCase
+	// advanceClockUC and getClockUC are nil outside sandbox deployments.
+	advanceClockUC *usecase.AdvanceTenantClockUseCase
+	getClockUC     *usecase.GetTenantClockUseCase
+	logger         *slog.Logger
+}
+
+// NewSchedulerServiceHandler creates a new SchedulerServiceHandler. The
+// tenant clock use cases are nil unless the deployment is a sandbox.
+func NewSchedulerServiceHandler(
+	registerJobUC *usecase.RegisterJobUseCase,
+	updateJobUC *usecase.UpdateJobUseCase,
+	getJobUC *usecase.GetJobUseCase,
+	listJobsUC *usecase.ListJobsUseCase,
+	pauseJobUC *usecase.PauseJobUseCase,
+	resumeJobUC *usecase.ResumeJobUseCase,
+	triggerJobUC *usecase.TriggerJobUseCase,
+	retryRunUC *usecase.RetryRunUseCase,
+	getRunUC *usecase.GetRunUseCase,
+	listRunsUC *usecase.ListRunsUseCase,
+	startEODUC *usecase.StartEODRunUseCase,
+	getEODUC *usecase.GetEODRunUseCase,
+	listEODUC *usecase.ListEODRunsUseCase,
+	resumeEODUC *usecase.ResumeEODRunUseCase,
+	advanceClockUC *usecase.AdvanceTenantClockUseCase,
+	getClockUC *usecase.GetTenantClockUseCase,
+	logger *slog.Logger,
+) *SchedulerServiceHandler {
+	return &SchedulerServiceHandler{
+		registerJobUC:  registerJobUC,
+		updateJobUC:    updateJobUC,
+		getJobUC:       getJobUC,
+		listJobsUC:     listJobsUC,
+		pauseJobUC:     pauseJobUC,
+		resumeJobUC:    resumeJobUC,
+		triggerJobUC:   triggerJobUC,
+		retryRunUC:     retryRunUC,
+		getRunUC:       getRunUC,
+		listRunsUC:     listRunsUC,
+		startEODUC:     startEODUC,
+		getEODUC:       getEODUC,
+		listEODUC:      listEODUC,
+		resumeEODUC:    resumeEODUC,
+		advanceClockUC: advanceClockUC,
+		getClockUC:     getClockUC,
+		logger:         logger,
+	}
+}
+
+// RegisterJob handles the gRPC request to register a job.
+func (h *SchedulerServiceHandler) RegisterJob(ctx context.Context, req *schedulerv1.RegisterJobRequest) (*schedulerv1.RegisterJobResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	def, err := toDefinitionDTO(req.Description, req.Schedule, req.Target, req.Retry)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.registerJobUC.Execute(ctx, dto.RegisterJobRequest{
+		Name:       req.Name,
+		Definition: def,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &schedulerv1.RegisterJobResponse{Job: toJobMsg(resp)}, nil
+}
+
+// UpdateJob handles the gRPC request to replace a job's definition.
+func (h *SchedulerServiceHandler) UpdateJob(ctx context.Context, req *schedulerv1.UpdateJobRequest) (*schedulerv1.UpdateJobResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	jobID, err := uuid.Parse(req.JobId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job ID")
+	}
+
+	def, err := toDefinitionDTO(req.Description, req.Schedule, req.Target, req.Retry)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.updateJobUC.Execute(ctx, dto.UpdateJobRequest{
+		JobID:      jobID,
+		Definition: def,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &schedulerv1.UpdateJobResponse{Job: toJobMsg(resp)}, nil
+}
+
+// GetJob handles the gRPC request to retrieve a job.
+func (h *SchedulerServiceHandler) GetJob(ctx context.Context, req *schedulerv1.GetJobRequest) (*schedulerv1.GetJobResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	jobID, err := uuid.Parse(req.JobId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job ID")
+	}
+
+	resp, err := h.getJobUC.Execute(ctx, jobID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &schedulerv1.GetJobResponse{Job: toJobMsg(resp)}, nil
+}
+
+// ListJobs handles the gRPC request to list jobs.
+func (h *SchedulerServiceHandler) ListJobs(ctx context.Context, req *schedulerv1.ListJobsRequest) (*schedulerv1.ListJobsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	resp, err := h.listJobsUC.Execute(ctx, dto.ListJobsRequest{
+		Limit:  int(req.PageSize),
+		Offset: int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	jobs := make([]*schedulerv1.Job, 0, len(resp.Jobs))
+	for _, j := range resp.Jobs {
+		jobs = append(jobs, toJobMsg(j))
+	}
+	return &schedulerv1.ListJobsResponse{
+		Jobs:       jobs,
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // job counts are small
+	}, nil
+}
+
+// PauseJob handles the gRPC request to stop a job running on its schedule.
+func (h *SchedulerServiceHandler) PauseJob(ctx context.Context, req *schedulerv1.PauseJobRequest) (*schedulerv1.PauseJobResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	jobID, err := uuid.Parse(req.JobId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job ID")
+	}
+
+	resp, err := h.pauseJobUC.Execute(ctx, jobID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &schedulerv1.PauseJobResponse{Job: toJobMsg(resp)}, nil
+}
+
+// ResumeJob handles the gRPC request to run a paused job on its schedule
+// again.
+func (h *SchedulerServiceHandler) ResumeJob(ctx context.Context, req *schedulerv1.ResumeJobRequest) (*schedulerv1.ResumeJobResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	jobID, err := uuid.Parse(req.JobId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job ID")
+	}
+
+	resp, err := h.resumeJobUC.Execute(ctx, jobID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &schedulerv1.ResumeJobResponse{Job: toJobMsg(resp)}, nil
+}
+
+// TriggerJob handles the gRPC request to run a job now.
+func (h *SchedulerServiceHandler) TriggerJob(ctx context.Context, req *schedulerv1.TriggerJobRequest) (*schedulerv1.TriggerJobResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	jobID, err := uuid.Parse(req.JobId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job ID")
+	}
+
+	resp, err := h.triggerJobUC.Execute(ctx, jobID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &schedulerv1.TriggerJobResponse{Run: toJobRunMsg(resp)}, nil
+}
+
+// RetryJobRun handles the gRPC request to retry a failed run now.
+func (h *SchedulerServiceHandler) RetryJobRun(ctx context.Context, req *schedulerv1.RetryJobRunRequest) (*schedulerv1.RetryJobRunResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	runID, err := uuid.Parse(req.RunId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid run ID")
+	}
+
+	resp, err := h.retryRunUC.Execute(ctx, runID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &schedulerv1.RetryJobRunResponse{Run: toJobRunMsg(resp)}, nil
+}
+
+// GetJobRun handles the gRPC request to retrieve a job run.
+func (h *SchedulerServiceHandler) GetJobRun(ctx context.Context, req *schedulerv1.GetJobRunRequest) (*schedulerv1.GetJobRunResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	runID, err := uuid.Parse(req.RunId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid run ID")
+	}
+
+	resp, err := h.getRunUC.Execute(ctx, runID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &schedulerv1.GetJobRunResponse{Run: toJobRunMsg(resp)}, nil
+}
+
+// ListJobRuns handles the gRPC request to list a job's run history.
+func (h *SchedulerServiceHandler) ListJobRuns(ctx context.Context, req *schedulerv1.ListJobRunsRequest) (*schedulerv1.ListJobRunsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	jobID, err := uuid.Parse(req.JobId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid job ID")
+	}
+
+	resp, err := h.listRunsUC.Execute(ctx, dto.ListRunsRequest{
+		JobID:  jobID,
+		Limit:  int(req.PageSize),
+		Offset: int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	runs := make([]*schedulerv1.JobRun, 0, len(resp.Runs))
+	for _, r := range resp.Runs {
+		runs = append(runs, toJobRunMsg(r))
+	}
+	return &schedulerv1.ListJobRunsResponse{
+		Runs:       runs,
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // bounded by retention
+	}, nil
+}
+
+// StartEODRun handles the gRPC request to start the end-of-day close of a
+// business date.
+func (h *SchedulerServiceHandler) StartEODRun(ctx context.Context, req *schedulerv1.StartEODRunRequest) (*schedulerv1.StartEODRunResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	businessDate, err := time.Parse(time.DateOnly, req.BusinessDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "business date must be YYYY-MM-DD")
+	}
+
+	resp, err := h.startEODUC.Execute(ctx, dto.StartEODRunRequest{BusinessDate: businessDate})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &schedulerv1.StartEODRunResponse{Run: toEODRunMsg(resp)}, nil
+}
+
+// GetEODRun handles the gRPC request to retrieve an end-of-day run.
+func (h *SchedulerServiceHandler) GetEODRun(ctx context.Context, req *schedulerv1.GetEODRunRequest) (*schedulerv1.GetEODRunResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	runID, err := uuid.Parse(req.EodRunId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid end-of-day run ID")
+	}
+
+	resp, err := h.getEODUC.Execute(ctx, runID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &schedulerv1.GetEODRunResponse{Run: toEODRunMsg(resp)}, nil
+}
+
+// ListEODRuns handles the gRPC request to list end-of-day runs.
+func (h *SchedulerServiceHandler) ListEODRuns(ctx context.Context, req *schedulerv1.ListEODRunsRequest) (*schedulerv1.ListEODRunsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	resp, err := h.listEODUC.Execute(ctx, dto.ListEODRunsRequest{
+		Limit:  int(req.PageSize),
+		Offset: int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	runs := make([]*schedulerv1.EODRun, 0, len(resp.Runs))
+	for _, r := range resp.Runs {
+		runs = append(runs, toEODRunMsg(r))
+	}
+	return &schedulerv1.ListEODRunsResponse{
+		Runs:       runs,
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // one run per business date
+	}, nil
+}
+
+// ResumeEODRun handles the gRPC request to restart the failed steps of a
+// failed end-of-day run.
+func (h *SchedulerServiceHandler) ResumeEODRun(ctx context.Context, req *schedulerv1.ResumeEODRunRequest) (*schedulerv1.ResumeEODRunResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	runID, err := uuid.Parse(req.EodRunId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid end-of-day run ID")
+	}
+
+	resp, err := h.resumeEODUC.Execute(ctx, runID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &schedulerv1.ResumeEODRunResponse{Run: toEODRunMsg(resp)}, nil
+}
+
+// AdvanceTenantClock handles the gRPC request to simulate the calling
+// sandbox tenant's end of day.
+func (h *SchedulerServiceHandler) AdvanceTenantClock(ctx context.Context, req *schedulerv1.AdvanceTenantClockRequest) (*schedulerv1.AdvanceTenantClockResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+	if h.advanceClockUC == nil {
+		return nil, status.Error(codes.FailedPrecondition, "time travel is only available in the sandbox")
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.advanceClockUC.Execute(ctx, dto.AdvanceTenantClockRequest{TenantID: tenantID, Days: int(req.Days)})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &schedulerv1.AdvanceTenantClockResponse{
+		Clock:         toTenantClockMsg(resp),
+		DaysSimulated: int32(resp.DaysSimulated), //nolint:gosec // bounded by MaxSimulatedDays
+		JobRuns:       int32(resp.JobRuns),       //nolint:gosec // bounded by MaxSimulatedDays times the plan's steps
+	}, nil
+}
+
+// GetTenantClock handles the gRPC request to retrieve the calling sandbox
+// tenant's business date.
+func (h *SchedulerServiceHandler) GetTenantClock(ctx context.Context, req *schedulerv1.GetTenantClockRequest) (*schedulerv1.GetTenantClockResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+	if h.getClockUC == nil {
+		return nil, status.Error(codes.FailedPrecondition, "time travel is only available in the sandbox")
+	}
+
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.getClockUC.Execute(ctx, tenantID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &schedulerv1.GetTenantClockResponse{Clock: toTenantClockMsg(resp)}, nil
+}
+
+func (h *SchedulerServiceHandler) toStatus(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidJob), errors.Is(err, usecase.ErrInvalidEODRun),
+		errors.Is(err, usecase.ErrInvalidSimulation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrInvalidTransition), errors.Is(err, model.ErrUnknownStep),
+		errors.Is(err, usecase.ErrEODRunInProgress), errors.Is(err, usecase.ErrSimulationStepFailed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, port.ErrJobNotFound):
+		return status.Error(codes.NotFound, "job not found")
+	case errors.Is(err, port.ErrRunNotFound):
+		return status.Error(codes.NotFound, "job run not found")
+	case errors.Is(err, port.ErrEODRunNotFound):
+		return status.Error(codes.NotFound, "end-of-day run not found")
+	case errors.Is(err, port.ErrJobNameTaken), errors.Is(err, port.ErrEODRunExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, port.ErrVersionConflict):
+		return status.Error(codes.Aborted, "modified concurrently, retry")
+	default:
+		h.logger.Error("handler error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func toDefinitionDTO(description, schedule string, target *schedulerv1.JobTarget, retry *schedulerv1.RetryPolicy) (dto.JobDefinitionDTO, error) {
+	if target == nil {
+		return dto.JobDefinitionDTO{}, status.Error(codes.InvalidArgument, "target is required")
+	}
+	def := dto.JobDefinitionDTO{
+		Description: description,
+		Schedule:    schedule,
+		Target: dto.JobTargetDTO{
+			Service: target.Service,
+			Method:  target.Method,
+			Topic:   target.Topic,
+		},
+		Retry: dto.RetryPolicyDTO{MaxAttempts: 1},
+	}
+	if target.Kind != schedulerv1.TargetKind_TARGET_KIND_UNSPECIFIED {
+		def.Target.Kind = strings.TrimPrefix(target.Kind.String(), "TARGET_KIND_")
+	}
+	if target.Payload != nil {
+		payload, err := json.Marshal(target.Payload.AsMap())
+		if err != nil {
+			return dto.JobDefinitionDTO{}, status.Errorf(codes.InvalidArgument, "invalid target payload: %v", err)
+		}
+		def.Target.Payload = payload
+	}
+	if retry != nil {
+		def.Retry.MaxAttempts = int(retry.MaxAttempts)
+		if retry.Backoff != "" {
+			backoff, err := time.ParseDuration(retry.Backoff)
+			if err != nil {
+				return dto.JobDefinitionDTO{}, status.Errorf(codes.InvalidArgument, "invalid retry backoff: %v", err)
+			}
+			def.Retry.Backoff = backoff
+		}
+	}
+	return def, nil
+}
+
+func toJobMsg(j dto.JobResponse) *schedulerv1.Job {
+	msg := &schedulerv1.Job{
+		JobId:       j.ID.String(),
+		Name:        j.Name,
+		Description: j.Definition.Description,
+		Schedule:    j.Definition.Schedule,
+		Target: &schedulerv1.JobTarget{
+			Kind:    schedulerv1.TargetKind(schedulerv1.TargetKind_value["TARGET_KIND_"+j.Definition.Target.Kind]),
+			Service: j.Definition.Target.Service,
+			Method:  j.Definition.Target.Method,
+			Topic:   j.Definition.Target.Topic,
+		},
+		Retry: &schedulerv1.RetryPolicy{
+			MaxAttempts: int32(j.Definition.Retry.MaxAttempts), //nolint:gosec // at most 10
+			Backoff:     j.Definition.Retry.Backoff.String(),
+		},
+		Enabled:   j.Enabled,
+		NextRunAt: timestamppb.New(j.NextRunAt),
+		Version:   int32(j.Version), //nolint:gosec // versions are small
+		CreatedAt: timestamppb.New(j.CreatedAt),
+		UpdatedAt: timestamppb.New(j.UpdatedAt),
+	}
+	// Payloads were validated as JSON objects when the job was registered.
+	var fields map[string]any
+	if err := json.Unmarshal(j.Definition.Target.Payload, &fields); err == nil && fields != nil {
+		msg.Target.Payload, _ = structpb.NewStruct(fields)
+	}
+	if j.LastRunAt != nil {
+		msg.LastRunAt = timestamppb.New(*j.LastRunAt)
+	}
+	return msg
+}
+
+func toJobRunMsg(r dto.RunResponse) *schedulerv1.JobRun {
+	msg := &schedulerv1.JobRun{
+		RunId:        r.ID.String(),
+		JobId:        r.JobID.String(),
+		JobName:      r.JobName,
+		Trigger:      runTriggerToProto(r.Trigger),
+		Status:       runStatusToProto(r.Status),
+		ScheduledFor: timestamppb.New(r.ScheduledFor),
+		Attempt:      int32(r.Attempt),     //nolint:gosec // attempts are small
+		MaxAttempts:  int32(r.MaxAttempts), //nolint:gosec // attempts are small
+		Error:        r.Error,
+		StartedAt:    timestamppb.New(r.StartedAt),
+	}
+	if r.FinishedAt != nil {
+		msg.FinishedAt = timestamppb.New(*r.FinishedAt)
+	}
+	if r.NextRetryAt != nil {
+		msg.NextRetryAt = timestamppb.New(*r.NextRetryAt)
+	}
+	return msg
+}
+
+func toEODRunMsg(r dto.EODRunResponse) *schedulerv1.EODRun {
+	msg := &schedulerv1.EODRun{
+		EodRunId:     r.ID.String(),
+		BusinessDate: r.BusinessDate.Format(time.DateOnly),
+		Trigger:      runTriggerToProto(r.Trigger),
+		Status:       runStatusToProto(r.Status),
+		Steps:        make([]*schedulerv1.EODStep, 0, len(r.Steps)),
+		StartedAt:    timestamppb.New(r.StartedAt),
+		UpdatedAt:    timestamppb.New(r.UpdatedAt),
+		Version:      int32(r.Version), //nolint:gosec // versions are small
+	}
+	if r.FinishedAt != nil {
+		msg.FinishedAt = timestamppb.New(*r.FinishedAt)
+	}
+	for _, s := range r.Steps {
+		step := &schedulerv1.EODStep{
+			Name:      s.Name,
+			JobName:   s.JobName,
+			DependsOn: s.DependsOn,
+			Status:    schedulerv1.EODStepStatus(schedulerv1.EODStepStatus_value["EOD_STEP_STATUS_"+s.Status]),
+			Error:     s.Error,
+		}
+		if s.RunID != nil {
+			step.RunId = s.RunID.String()
+		}
+		if s.StartedAt != nil {
+			step.StartedAt = timestamppb.New(*s.StartedAt)
+		}
+		if s.FinishedAt != nil {
+			step.FinishedAt = timestamppb.New(*s.FinishedAt)
+		}
+		msg.Steps = append(msg.Steps, step)
+	}
+	return msg
+}
+
+func toTenantClockMsg(r dto.TenantClockResponse) *schedulerv1.TenantClock {
+	return &schedulerv1.TenantClock{
+		BusinessDate: r.BusinessDate.Format(time.DateOnly),
+		TenantId:     r.TenantID.String(),
+	}
+}
+
+func runTriggerToProto(t string) schedulerv1.RunTrigger {
+	return schedulerv1.RunTrigger(schedulerv1.RunTrigger_value["RUN_TRIGGER_"+t])
+}
+
+func runStatusToProto(s string) schedulerv1.RunStatus {
+	return schedulerv1.RunStatus(schedulerv1.RunStatus_value["RUN_STATUS_"+s])
+}
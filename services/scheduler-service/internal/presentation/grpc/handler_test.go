@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	schedulerv1 "github.com/bibbank/bib/api/gen/go/bib/scheduler/v1"
+	"github.com/bibbank/bib/services/scheduler-service/internal/application/dto"
+)
+
+func TestJobTargetPayloadRoundTrip(t *testing.T) {
+	payload, err := structpb.NewStruct(map[string]any{"as_of_date": "{{business_date}}"})
+	require.NoError(t, err)
+
+	def, err := toDefinitionDTO("accrue", "@daily", &schedulerv1.JobTarget{
+		Kind:    schedulerv1.TargetKind_TARGET_KIND_GRPC,
+		Service: "deposit-service",
+		Method:  "/bib.deposit.v1.DepositService/AccrueInterest",
+		Payload: payload,
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "GRPC", def.Target.Kind)
+	assert.JSONEq(t, `{"as_of_date":"{{business_date}}"}`, string(def.Target.Payload))
+
+	msg := toJobMsg(dto.JobResponse{Definition: def})
+	assert.Equal(t, schedulerv1.TargetKind_TARGET_KIND_GRPC, msg.GetTarget().GetKind())
+	assert.Equal(t, "{{business_date}}", msg.GetTarget().GetPayload().GetFields()["as_of_date"].GetStringValue())
+}
+
+func TestToDefinitionDTO_UnspecifiedKind(t *testing.T) {
+	// The use case rejects the empty kind.
+	def, err := toDefinitionDTO("", "@daily", &schedulerv1.JobTarget{Topic: "t"}, nil)
+	require.NoError(t, err)
+	assert.Empty(t, def.Target.Kind)
+	assert.Empty(t, def.Target.Payload)
+}
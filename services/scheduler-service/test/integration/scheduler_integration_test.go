@@ -0,0 +1,131 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/model"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/port"
+	"github.com/bibbank/bib/services/scheduler-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/scheduler-service/internal/infrastructure/postgres"
+)
+
+func newJob(t *testing.T, name string, now time.Time) model.Job {
+	t.Helper()
+	job, err := model.NewJob(name, model.JobDefinition{
+		Description: "Accrue deposit interest",
+		Schedule:    "0 1 * * *",
+		Target: model.JobTarget{
+			Kind:    valueobject.TargetKindGRPC,
+			Service: "deposit-service",
+			Method:  "/bib.deposit.v1.DepositService/AccrueInterest",
+			Payload: []byte(`{"as_of_date":"{{business_date}}"}`),
+		},
+		Retry: model.RetryPolicy{MaxAttempts: 3, Backoff: time.Minute},
+	}, now)
+	require.NoError(t, err)
+	return job
+}
+
+func TestJobRepo_SaveRoundTripsAndLocksOnVersion(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewJobRepo(env.Pool())
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	job := newJob(t, "deposit.accrue-interest", now)
+	require.NoError(t, repo.Save(ctx, job))
+
+	found, err := repo.FindByName(ctx, "deposit.accrue-interest")
+	require.NoError(t, err)
+	assert.Equal(t, job.ID(), found.ID())
+	assert.True(t, found.Target().Kind.Equal(valueobject.TargetKindGRPC))
+	assert.Equal(t, "/bib.deposit.v1.DepositService/AccrueInterest", found.Target().Method)
+	assert.JSONEq(t, `{"as_of_date":"{{business_date}}"}`, string(found.Target().Payload))
+	assert.Equal(t, 3, found.Retry().MaxAttempts)
+	assert.Equal(t, time.Minute, found.Retry().Backoff)
+	assert.True(t, found.NextRunAt().Equal(job.NextRunAt()))
+
+	paused, err := found.Pause(now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, paused))
+
+	// Saving the stale copy again conflicts on the version.
+	assert.ErrorIs(t, repo.Save(ctx, paused), port.ErrVersionConflict)
+
+	found, err = repo.FindByID(ctx, job.ID())
+	require.NoError(t, err)
+	assert.False(t, found.Enabled())
+	assert.Equal(t, 2, found.Version())
+
+	_, err = repo.FindByID(ctx, uuid.New())
+	assert.ErrorIs(t, err, port.ErrJobNotFound)
+}
+
+func TestJobRepo_NameIsUnique(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewJobRepo(env.Pool())
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, repo.Save(ctx, newJob(t, "deposit.accrue-interest", now)))
+	err := repo.Save(ctx, newJob(t, "deposit.accrue-interest", now))
+	assert.ErrorIs(t, err, port.ErrJobNameTaken)
+}
+
+func TestJobRepo_FindDueSkipsPausedAndFutureJobs(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewJobRepo(env.Pool())
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	due := newJob(t, "deposit.accrue-interest", now)
+	require.NoError(t, repo.Save(ctx, due))
+
+	paused, err := newJob(t, "account.dormancy-sweep", now).Pause(now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, paused))
+
+	// Looking past the next fire time makes both jobs eligible but for the pause.
+	jobs, err := repo.FindDue(ctx, due.NextRunAt(), 10)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, due.ID(), jobs[0].ID())
+
+	jobs, err = repo.FindDue(ctx, now, 10)
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+}
+
+func TestTenantClockRepo_AdvanceLocksOnVersion(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewTenantClockRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	_, err := repo.Find(ctx, tenantID)
+	assert.ErrorIs(t, err, port.ErrTenantClockNotFound)
+
+	clock, err := model.NewTenantClock(tenantID, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, clock))
+
+	advanced := clock.Advance(now)
+	require.NoError(t, repo.Save(ctx, advanced))
+
+	// Saving the stale copy again conflicts on the version.
+	assert.ErrorIs(t, repo.Save(ctx, advanced), port.ErrVersionConflict)
+
+	found, err := repo.Find(ctx, tenantID)
+	require.NoError(t, err)
+	assert.True(t, found.BusinessDate().Equal(clock.BusinessDate().AddDate(0, 0, 1)))
+}
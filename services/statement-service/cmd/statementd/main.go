@@ -0,0 +1,313 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/bibbank/bib/pkg/archive"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/enrichment"
+	pkgkafka "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
+	"github.com/bibbank/bib/pkg/lock"
+	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
+	pkgpostgres "github.com/bibbank/bib/pkg/postgres"
+	"github.com/bibbank/bib/services/statement-service/internal/application/dto"
+	"github.com/bibbank/bib/services/statement-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/port"
+	"github.com/bibbank/bib/services/statement-service/internal/infrastructure/client"
+	"github.com/bibbank/bib/services/statement-service/internal/infrastructure/config"
+	"github.com/bibbank/bib/services/statement-service/internal/infrastructure/objectstore"
+	"github.com/bibbank/bib/services/statement-service/internal/infrastructure/postgres"
+	grpcpresentation "github.com/bibbank/bib/services/statement-service/internal/presentation/grpc"
+	"github.com/bibbank/bib/services/statement-service/internal/presentation/rest"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// Load configuration.
+	cfg := config.Load()
+
+	// Initialize structured logger via shared observability package.
+	logger := observability.InitLogger(observability.LogConfig{
+		Level:  "info",
+		Format: "json",
+	})
+	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
+
+	logger.Info("starting statement-service",
+		"http_port", cfg.HTTPPort,
+		"grpc_port", cfg.GRPCPort,
+	)
+
+	// Initialize tracing.
+	shutdown, err := observability.InitTracer(ctx, observability.TracingConfig{
+		ServiceName: cfg.ServiceName,
+		Endpoint:    getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4317"),
+		Insecure:    true,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
+	} else {
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
+	}
+
+	// Database connection.
+	dbCtx, dbCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer dbCancel()
+
+	pool, err := pkgpostgres.NewPool(dbCtx, pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	})
+	if err != nil {
+		logger.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
+	logger.Info("connected to database")
+
+	// Run database migrations.
+	migDSN := pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	}.DSN()
+	if migErr := pkgpostgres.RunMigrations(migDSN, "file://internal/infrastructure/postgres/migrations"); migErr != nil {
+		logger.Warn("migration warning", "error", migErr)
+	}
+
+	// Wire infrastructure adapters.
+	statementRepo := postgres.NewStatementRepo(pool)
+	scheduleRepo := postgres.NewScheduleRepo(pool)
+	readModel := postgres.NewActivityReadModelRepo(pool)
+	kafkaProducer := pkgkafka.NewProducer(pkgkafka.Config{
+		Brokers: cfg.Kafka.Brokers,
+	})
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(kafkaProducer.Close))
+
+	// Outbox relay: events are written to the outbox and published from it,
+	// so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     outbox.StaticRoute("statement-events"),
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: cfg.ServiceName,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, kafkaProducer, relayCfg, logger)
+	if err != nil {
+		logger.Error("failed to create outbox relay", "error", err)
+		os.Exit(1)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+	eventPublisher := postgres.NewOutboxPublisher(outbox.NewPublisher(outboxStore), "statement-events")
+
+	// JWT service for gRPC auth (validation-only: public key preferred, secret as fallback).
+	jwtCfg := auth.JWTConfig{
+		Issuer: "bib-gateway",
+	}
+	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
+	case os.Getenv("JWT_PUBLIC_KEY") != "":
+		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
+	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
+		keyData, loadErr := auth.LoadKeyFromFile(os.Getenv("JWT_PUBLIC_KEY_FILE"))
+		if loadErr != nil {
+			logger.Error("failed to load JWT public key file", "error", loadErr)
+			os.Exit(1)
+		}
+		jwtCfg.PublicKeyPEM = string(keyData)
+	default:
+		jwtSecret := os.Getenv("JWT_SECRET")
+		if jwtSecret == "" {
+			jwtSecret = "test-e2e-secret" // Match gateway default for E2E tests
+		}
+		jwtCfg.Secret = jwtSecret
+	}
+	jwtSvc, err := auth.NewJWTService(jwtCfg)
+	if err != nil {
+		logger.Error("failed to initialize JWT service", "error", err)
+		os.Exit(1)
+	}
+
+	// Statement documents go to S3 when a bucket is configured, otherwise to
+	// the local statement directory.
+	store := objectstore.New(archive.NewStore(cfg.Storage))
+
+	// Statements list the postings of accounts linked to a ledger account.
+	// The ledger calls carry a tenant auditor token, since neither customers
+	// nor the schedule worker may read the journal themselves.
+	var ledger port.LedgerPostings
+	if cfg.Ledger.GRPCAddr != "" {
+		ledgerConn, dialErr := grpc.NewClient(cfg.Ledger.GRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if dialErr != nil {
+			logger.Error("failed to create ledger service client", "addr", cfg.Ledger.GRPCAddr, "error", dialErr)
+			os.Exit(1)
+		}
+		lc.OnStop(lifecycle.PhaseResources, "ledger client", lifecycle.Close(ledgerConn.Close))
+
+		var signer *auth.JWTService
+		switch {
+		case cfg.Ledger.SigningKeyFile != "":
+			keyData, loadErr := auth.LoadKeyFromFile(cfg.Ledger.SigningKeyFile)
+			if loadErr != nil {
+				logger.Error("failed to load statement signing key file", "error", loadErr)
+				os.Exit(1)
+			}
+			signer, err = auth.NewJWTService(auth.JWTConfig{
+				PrivateKeyPEM: string(keyData),
+				Issuer:        "bib-gateway",
+				Expiration:    5 * time.Minute,
+			})
+		case jwtCfg.Secret != "":
+			signer, err = auth.NewJWTService(auth.JWTConfig{
+				Secret:     jwtCfg.Secret,
+				Issuer:     "bib-gateway",
+				Expiration: 5 * time.Minute,
+			})
+		default:
+			logger.Warn("STATEMENT_SIGNING_KEY_FILE not set, ledger calls will carry the caller's token")
+		}
+		if err != nil {
+			logger.Error("failed to initialize statement token signer", "error", err)
+			os.Exit(1)
+		}
+		ledger = client.NewLedgerGRPCClient(ledgerConn, signer, client.LedgerClientConfig{
+			PageSize: int32(min(cfg.Ledger.PageSize, 1000)), //nolint:gosec // capped at the ledger's maximum
+			Timeout:  cfg.Ledger.Timeout,
+		})
+	} else {
+		logger.Warn("LEDGER_SERVICE_ADDR not set, statements will list projected activity only")
+	}
+
+	// Wire use cases.
+	generateUC := usecase.NewGenerateStatementUseCase(readModel, ledger, statementRepo, store, eventPublisher)
+	getUC := usecase.NewGetStatementUseCase(statementRepo)
+	listUC := usecase.NewListStatementsUseCase(statementRepo)
+	contentUC := usecase.NewGetStatementContentUseCase(statementRepo, store)
+	upsertScheduleUC := usecase.NewUpsertScheduleUseCase(scheduleRepo)
+	listSchedulesUC := usecase.NewListSchedulesUseCase(scheduleRepo)
+	runDueUC := usecase.NewRunDueSchedulesUseCase(scheduleRepo, readModel, ledger, statementRepo, store, eventPublisher,
+		cfg.Schedules.Grace, logger)
+	projectActivityUC := usecase.NewProjectActivityUseCase(readModel, enrichment.Default)
+
+	// Consume account, payment, card, deposit and lending events into the
+	// activity read model statements are rendered from.
+	for _, topic := range usecase.ActivityTopics {
+		consumer := pkgkafka.NewConsumer(pkgkafka.Config{
+			Brokers:       cfg.Kafka.Brokers,
+			ConsumerGroup: cfg.Kafka.ConsumerGroup,
+		}, topic, func(ctx context.Context, msg pkgkafka.Message) error {
+			return projectActivityUC.Execute(ctx, msg.Headers["event_type"], msg.Value)
+		}, logger)
+		lc.Go(lifecycle.PhaseConsumers, topic+" consumer", consumer.Start)
+		lc.OnStop(lifecycle.PhaseResources, topic+" consumer", lifecycle.Close(consumer.Close))
+	}
+
+	// Generate scheduled statements on the elected replica only.
+	elector := lock.NewElector(lock.NewPostgresLocker(pool), "statement.schedules", lock.ElectorConfig{}, logger)
+	lc.Go(lifecycle.PhaseWorkers, "scheduled statements", func(ctx context.Context) error {
+		elector.Run(ctx, func(ctx context.Context) {
+			ticker := time.NewTicker(cfg.Schedules.PollInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case now := <-ticker.C:
+					result, runErr := runDueUC.Execute(ctx, now.UTC())
+					if runErr != nil {
+						logger.Error("statement schedule pass failed", "error", runErr)
+					}
+					if result != (dto.RunDueSchedulesResult{}) {
+						logger.Info("statement schedule pass",
+							"generated", result.Generated,
+							"failed", result.Failed,
+						)
+					}
+				}
+			}
+		})
+		return nil
+	})
+
+	// gRPC server.
+	grpcHandler := grpcpresentation.NewHandler(
+		generateUC, getUC, listUC, contentUC, upsertScheduleUC, listSchedulesUC, runDueUC, logger,
+	)
+	grpcServer, err := grpcpresentation.NewServer(grpcHandler, logger, jwtSvc)
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
+
+	// HTTP server (health checks and metrics).
+	healthHandler := rest.NewHealthHandler(logger)
+	httpMux := http.NewServeMux()
+	healthHandler.RegisterRoutes(httpMux)
+	if metricsHandler != nil {
+		httpMux.Handle("/metrics", metricsHandler)
+	}
+
+	httpServer := &http.Server{
+		Addr:              cfg.HTTPAddr(),
+		Handler:           httpMux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Start servers.
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", func(context.Context) error {
+		return grpcServer.Start(cfg.GRPCAddr())
+	})
+	lc.OnStop(lifecycle.PhaseIngress, "gRPC server", lifecycle.Func(grpcServer.Stop))
+	lc.ServeHTTP("HTTP server", httpServer)
+
+	logger.Info("statement-service is running",
+		"grpc_addr", cfg.GRPCAddr(),
+		"http_addr", cfg.HTTPAddr(),
+	)
+
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
+	}
+	logger.Info("statement-service stopped")
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
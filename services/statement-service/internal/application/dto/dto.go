@@ -0,0 +1,104 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GenerateStatementRequest is the input DTO for generating a statement on
+// demand. Periods are inclusive dates; Format defaults to PDF.
+type GenerateStatementRequest struct {
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+	Format      string    `json:"format"`
+	TenantID    uuid.UUID `json:"tenant_id"`
+	CustomerID  uuid.UUID `json:"customer_id"`
+}
+
+// ListStatementsRequest is the input DTO for listing a customer's
+// statements.
+type ListStatementsRequest struct {
+	Limit      int       `json:"limit"`
+	Offset     int       `json:"offset"`
+	TenantID   uuid.UUID `json:"tenant_id"`
+	CustomerID uuid.UUID `json:"customer_id"`
+}
+
+// StatementResponse is the output DTO for a statement.
+type StatementResponse struct {
+	PeriodStart   time.Time `json:"period_start"`
+	PeriodEnd     time.Time `json:"period_end"`
+	GeneratedAt   time.Time `json:"generated_at"`
+	Format        string    `json:"format"`
+	Status        string    `json:"status"`
+	ContentType   string    `json:"content_type"`
+	SHA256        string    `json:"sha256"`
+	FailureReason string    `json:"failure_reason,omitempty"`
+	SizeBytes     int64     `json:"size_bytes"`
+	EntryCount    int       `json:"entry_count"`
+	Version       int       `json:"version"`
+	ID            uuid.UUID `json:"id"`
+	TenantID      uuid.UUID `json:"tenant_id"`
+	CustomerID    uuid.UUID `json:"customer_id"`
+	ScheduleID    uuid.UUID `json:"schedule_id"`
+}
+
+// ListStatementsResponse is the output DTO for listing statements.
+type ListStatementsResponse struct {
+	Statements []StatementResponse `json:"statements"`
+	TotalCount int                 `json:"total_count"`
+}
+
+// StatementContentResponse is the output DTO for a statement's document.
+type StatementContentResponse struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Content     []byte `json:"content"`
+}
+
+// UpsertScheduleRequest is the input DTO for creating or changing a
+// customer's statement schedule. Format defaults to PDF.
+type UpsertScheduleRequest struct {
+	Frequency  string    `json:"frequency"`
+	Format     string    `json:"format"`
+	Paused     bool      `json:"paused"`
+	TenantID   uuid.UUID `json:"tenant_id"`
+	CustomerID uuid.UUID `json:"customer_id"`
+}
+
+// ListSchedulesRequest is the input DTO for listing a tenant's statement
+// schedules, optionally those of one customer.
+type ListSchedulesRequest struct {
+	Limit      int       `json:"limit"`
+	Offset     int       `json:"offset"`
+	TenantID   uuid.UUID `json:"tenant_id"`
+	CustomerID uuid.UUID `json:"customer_id"`
+}
+
+// ScheduleResponse is the output DTO for a statement schedule.
+type ScheduleResponse struct {
+	LastPeriodEnd time.Time `json:"last_period_end"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	Frequency     string    `json:"frequency"`
+	Format        string    `json:"format"`
+	Version       int       `json:"version"`
+	Active        bool      `json:"active"`
+	ID            uuid.UUID `json:"id"`
+	TenantID      uuid.UUID `json:"tenant_id"`
+	CustomerID    uuid.UUID `json:"customer_id"`
+}
+
+// ListSchedulesResponse is the output DTO for listing statement schedules.
+type ListSchedulesResponse struct {
+	Schedules  []ScheduleResponse `json:"schedules"`
+	TotalCount int                `json:"total_count"`
+}
+
+// RunDueSchedulesResult counts the statements a pass over the schedules
+// generated and failed to generate.
+type RunDueSchedulesResult struct {
+	Generated int `json:"generated"`
+	Failed    int `json:"failed"`
+}
@@ -0,0 +1,302 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/enrichment"
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/port"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/service"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+)
+
+// Topics the activity read model is projected from.
+const (
+	AccountEventsTopic   = "account-events"
+	PaymentOrdersTopic   = "bib.payment.orders"
+	CardEventsTopic      = "card-events"
+	DepositEventsTopic   = "bib.deposit.events"
+	DepositInterestTopic = "bib.deposit.interest"
+	LendingEventsTopic   = "lending-events"
+)
+
+// ActivityTopics lists the topics the activity read model is projected from.
+var ActivityTopics = []string{
+	AccountEventsTopic,
+	PaymentOrdersTopic,
+	CardEventsTopic,
+	DepositEventsTopic,
+	DepositInterestTopic,
+	LendingEventsTopic,
+}
+
+// projectedEvent holds the fields of the events the activity read model is
+// projected from. Each event sets the subset it carries.
+type projectedEvent struct {
+	OccurredAt           time.Time       `json:"occurred_at"`
+	SettledAt            time.Time       `json:"settled_at"`
+	EventID              string          `json:"event_id"`
+	AggregateID          string          `json:"aggregate_id"`
+	TenantID             string          `json:"tenant_id"`
+	AccountNumber        string          `json:"account_number"`
+	AccountType          string          `json:"account_type"`
+	LedgerAccountCode    string          `json:"ledger_account_code"`
+	Currency             string          `json:"currency"`
+	Description          string          `json:"description"`
+	Rail                 string          `json:"rail"`
+	MerchantName         string          `json:"merchant_name"`
+	MerchantCategory     string          `json:"merchant_category"`
+	MerchantDisplayName  string          `json:"merchant_display_name"`
+	Category             string          `json:"category"`
+	MerchantLogo         string          `json:"merchant_logo"`
+	AuthCode             string          `json:"auth_code"`
+	BorrowerAccountID    string          `json:"borrower_account_id"`
+	Amount               decimal.Decimal `json:"amount"`
+	Principal            decimal.Decimal `json:"principal"`
+	HolderID             uuid.UUID       `json:"holder_id"`
+	PaymentID            uuid.UUID       `json:"payment_id"`
+	SourceAccountID      uuid.UUID       `json:"source_account_id"`
+	DestinationAccountID uuid.UUID       `json:"destination_account_id"`
+	AccountID            uuid.UUID       `json:"account_id"`
+	PositionID           uuid.UUID       `json:"position_id"`
+}
+
+// ProjectActivityUseCase applies account, payment, card, deposit and lending
+// events to the activity read model statements are built from. Each event
+// records at most one activity line per account, keyed by the event's ID, so
+// projection is idempotent. Events statements do not use are ignored.
+type ProjectActivityUseCase struct {
+	readModel port.ActivityReadModel
+	enricher  *enrichment.Enricher
+}
+
+// NewProjectActivityUseCase creates a new ProjectActivityUseCase. The
+// enricher names and categorizes card merchants whose events predate
+// enrichment, and payment counterparties.
+func NewProjectActivityUseCase(readModel port.ActivityReadModel, enricher *enrichment.Enricher) *ProjectActivityUseCase {
+	return &ProjectActivityUseCase{readModel: readModel, enricher: enricher}
+}
+
+// Execute applies an event of the given type. The payload is a CloudEvents
+// envelope or the bare event.
+func (uc *ProjectActivityUseCase) Execute(ctx context.Context, eventType string, payload []byte) error {
+	switch eventType {
+	case "account.opened", "account.activated",
+		"payment.order.initiated", "payment.order.settled", "payment.order.reversed",
+		"card.transaction.authorized",
+		"deposit.position.opened", "deposit.interest.accrued",
+		"lending.loan.disbursed", "lending.loan.payment_received":
+	default:
+		return nil
+	}
+
+	data, err := events.EventData(payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	var evt projectedEvent
+	if err := json.Unmarshal(data, &evt); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	tenantID, err := uuid.Parse(evt.TenantID)
+	if err != nil {
+		return fmt.Errorf("%s event has invalid tenant ID %q: %w", eventType, evt.TenantID, err)
+	}
+	if evt.EventID == "" {
+		// Events published before they carried an ID are keyed by what
+		// they describe instead.
+		evt.EventID = fmt.Sprintf("%s/%s/%s", eventType, evt.AggregateID, evt.OccurredAt.UTC().Format(time.RFC3339Nano))
+	}
+	// The read model stores times to the microsecond.
+	at := evt.OccurredAt.UTC().Truncate(time.Microsecond)
+
+	line := func(accountID uuid.UUID, source valueobject.ActivitySource, amount decimal.Decimal, description, reference string) service.Activity {
+		return service.Activity{
+			EventID:     evt.EventID,
+			TenantID:    tenantID,
+			AccountID:   accountID,
+			OccurredAt:  at,
+			Source:      source,
+			Description: description,
+			Reference:   reference,
+			Amount:      amount,
+			Currency:    evt.Currency,
+		}
+	}
+
+	switch eventType {
+	case "account.opened":
+		return uc.projectAccount(ctx, tenantID, at, evt)
+	case "account.activated":
+		accountID, err := uuid.Parse(evt.AggregateID)
+		if err != nil {
+			return fmt.Errorf("%s event has invalid account ID %q: %w", eventType, evt.AggregateID, err)
+		}
+		if evt.LedgerAccountCode == "" {
+			// Activations published before the event named the ledger
+			// account leave the account's lines to projected activity.
+			return nil
+		}
+		return uc.readModel.SetLedgerAccountCode(ctx, tenantID, accountID, evt.LedgerAccountCode)
+	case "payment.order.initiated", "payment.order.settled", "payment.order.reversed":
+		return uc.projectPayment(ctx, eventType, tenantID, at, evt)
+	case "card.transaction.authorized":
+		merchant := uc.merchant(evt)
+		activity := line(evt.AccountID, valueobject.ActivitySourceCard, evt.Amount.Neg(), merchant.Name, evt.AuthCode)
+		activity.Category, activity.Logo = merchant.Category.String(), merchant.Logo
+		return uc.readModel.RecordActivity(ctx, activity)
+	case "deposit.position.opened":
+		return uc.readModel.RecordActivity(ctx,
+			line(evt.AccountID, valueobject.ActivitySourceDeposit, evt.Principal.Neg(), "Term deposit placed", evt.PositionID.String()))
+	case "deposit.interest.accrued":
+		return uc.readModel.RecordActivity(ctx,
+			line(evt.AccountID, valueobject.ActivitySourceDeposit, evt.Amount, "Deposit interest accrued", evt.PositionID.String()))
+	case "lending.loan.disbursed":
+		accountID, err := uuid.Parse(evt.BorrowerAccountID)
+		if err != nil {
+			return fmt.Errorf("%s event has invalid borrower account ID %q: %w", eventType, evt.BorrowerAccountID, err)
+		}
+		if err := uc.readModel.RecordActivity(ctx,
+			line(accountID, valueobject.ActivitySourceLoan, evt.Principal, "Loan disbursement", evt.AggregateID)); err != nil {
+			return err
+		}
+		return uc.readModel.SaveLoan(ctx, service.LoanFact{
+			ID:        evt.AggregateID,
+			TenantID:  tenantID,
+			AccountID: accountID,
+			Currency:  evt.Currency,
+		})
+	default: // lending.loan.payment_received
+		loan, err := uc.readModel.FindLoan(ctx, evt.AggregateID)
+		switch {
+		case errors.Is(err, port.ErrProjectionNotFound):
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to find loan %s: %w", evt.AggregateID, err)
+		}
+		return uc.readModel.RecordActivity(ctx,
+			line(loan.AccountID, valueobject.ActivitySourceLoan, evt.Amount.Neg(), "Loan repayment", evt.AggregateID))
+	}
+}
+
+// merchant returns the card event's enriched merchant, enriching the
+// descriptor and MCC of events published before the card service did.
+func (uc *ProjectActivityUseCase) merchant(evt projectedEvent) enrichment.Merchant {
+	if evt.MerchantDisplayName == "" {
+		return uc.enricher.Merchant(evt.MerchantName, evt.MerchantCategory)
+	}
+	return enrichment.Merchant{
+		RawName:  evt.MerchantName,
+		MCC:      evt.MerchantCategory,
+		Name:     evt.MerchantDisplayName,
+		Category: enrichment.Category(evt.Category),
+		Logo:     evt.MerchantLogo,
+	}
+}
+
+func (uc *ProjectActivityUseCase) projectAccount(ctx context.Context, tenantID uuid.UUID, at time.Time, evt projectedEvent) error {
+	accountID, err := uuid.Parse(evt.AggregateID)
+	if err != nil {
+		return fmt.Errorf("account.opened event has invalid account ID %q: %w", evt.AggregateID, err)
+	}
+	if evt.HolderID == uuid.Nil {
+		// Accounts opened before the event named its holder cannot be
+		// attributed to a customer.
+		return nil
+	}
+	return uc.readModel.SaveAccount(ctx, service.CustomerAccount{
+		ID:            accountID,
+		TenantID:      tenantID,
+		CustomerID:    evt.HolderID,
+		AccountNumber: evt.AccountNumber,
+		AccountType:   evt.AccountType,
+		Currency:      evt.Currency,
+		OpenedAt:      at,
+	})
+}
+
+// projectPayment records a payment when it is initiated, and its activity
+// when it settles or a settled payment is reversed: a debit to the source
+// account and, for payments between the bank's accounts, a credit to the
+// destination.
+func (uc *ProjectActivityUseCase) projectPayment(ctx context.Context, eventType string, tenantID uuid.UUID, at time.Time, evt projectedEvent) error {
+	payment, err := uc.readModel.FindPayment(ctx, evt.PaymentID)
+	switch {
+	case errors.Is(err, port.ErrProjectionNotFound):
+		if eventType != "payment.order.initiated" {
+			return nil
+		}
+		return uc.readModel.SavePayment(ctx, service.PaymentFact{
+			ID:                   evt.PaymentID,
+			TenantID:             tenantID,
+			SourceAccountID:      evt.SourceAccountID,
+			DestinationAccountID: evt.DestinationAccountID,
+			Amount:               evt.Amount,
+			Currency:             evt.Currency,
+			Rail:                 evt.Rail,
+			Description:          evt.Description,
+			InitiatedAt:          at,
+		})
+	case err != nil:
+		return fmt.Errorf("failed to find payment %s: %w", evt.PaymentID, err)
+	}
+
+	counterparty := uc.enricher.Counterparty(payment.Description, "")
+	description := payment.Description
+	if description == "" {
+		description = fmt.Sprintf("%s payment", payment.Rail)
+	}
+	sign := decimal.NewFromInt(1)
+	switch eventType {
+	case "payment.order.settled":
+		if payment.SettledAt != nil {
+			return nil
+		}
+		if !evt.SettledAt.IsZero() {
+			at = evt.SettledAt.UTC().Truncate(time.Microsecond)
+		}
+	case "payment.order.reversed":
+		if payment.SettledAt == nil {
+			return nil
+		}
+		description = "Reversal: " + description
+		sign = sign.Neg()
+	default:
+		return nil
+	}
+
+	lines := []service.Activity{{
+		EventID:     evt.EventID,
+		TenantID:    tenantID,
+		AccountID:   payment.SourceAccountID,
+		OccurredAt:  at,
+		Source:      valueobject.ActivitySourceAccount,
+		Description: description,
+		Category:    counterparty.Category.String(),
+		Logo:        counterparty.Logo,
+		Reference:   payment.ID.String(),
+		Amount:      payment.Amount.Mul(sign).Neg(),
+		Currency:    payment.Currency,
+	}}
+	if payment.DestinationAccountID != uuid.Nil {
+		credit := lines[0]
+		credit.AccountID = payment.DestinationAccountID
+		credit.Amount = payment.Amount.Mul(sign)
+		lines = append(lines, credit)
+	}
+	if err := uc.readModel.RecordActivity(ctx, lines...); err != nil {
+		return err
+	}
+	if eventType == "payment.order.settled" {
+		payment.SettledAt = &at
+		return uc.readModel.SavePayment(ctx, payment)
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/enrichment"
+	"github.com/bibbank/bib/services/statement-service/internal/application/usecase"
+)
+
+func project(t *testing.T, uc *usecase.ProjectActivityUseCase, eventType string, fields map[string]any) {
+	t.Helper()
+	payload, err := json.Marshal(fields)
+	require.NoError(t, err)
+	require.NoError(t, uc.Execute(context.Background(), eventType, payload))
+}
+
+func amounts(m *memReadModel, accountID uuid.UUID) []string {
+	var out []string
+	for _, a := range m.activityOf(accountID) {
+		out = append(out, a.Amount.StringFixed(2))
+	}
+	return out
+}
+
+func TestProjectActivity_AccountOpened(t *testing.T) {
+	m := newMemReadModel()
+	uc := usecase.NewProjectActivityUseCase(m, enrichment.Default)
+	tenantID, holderID, accountID := uuid.New(), uuid.New(), uuid.New()
+
+	project(t, uc, "account.opened", map[string]any{
+		"event_id": uuid.NewString(), "aggregate_id": accountID, "tenant_id": tenantID,
+		"occurred_at": time.Now(), "account_number": "BIB-0001", "account_type": "CURRENT",
+		"currency": "EUR", "holder_id": holderID,
+	})
+	// Accounts opened before the event named the holder are skipped.
+	project(t, uc, "account.opened", map[string]any{
+		"event_id": uuid.NewString(), "aggregate_id": uuid.New(), "tenant_id": tenantID,
+		"occurred_at": time.Now(), "account_number": "BIB-0002", "currency": "EUR",
+	})
+
+	accounts, err := m.ListCustomerAccounts(context.Background(), tenantID, holderID)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, accountID, accounts[0].ID)
+	assert.Len(t, m.accounts, 1)
+}
+
+func TestProjectActivity_AccountActivatedLinksLedgerAccount(t *testing.T) {
+	m := newMemReadModel()
+	uc := usecase.NewProjectActivityUseCase(m, enrichment.Default)
+	tenantID, holderID, accountID := uuid.New(), uuid.New(), uuid.New()
+
+	project(t, uc, "account.opened", map[string]any{
+		"event_id": uuid.NewString(), "aggregate_id": accountID, "tenant_id": tenantID,
+		"occurred_at": time.Now(), "account_number": "BIB-0001", "currency": "EUR", "holder_id": holderID,
+	})
+	project(t, uc, "account.activated", map[string]any{
+		"event_id": uuid.NewString(), "aggregate_id": accountID, "tenant_id": tenantID,
+		"occurred_at": time.Now(), "account_number": "BIB-0001", "ledger_account_code": "2000-001",
+	})
+	// A redelivered account.opened keeps the link.
+	project(t, uc, "account.opened", map[string]any{
+		"event_id": uuid.NewString(), "aggregate_id": accountID, "tenant_id": tenantID,
+		"occurred_at": time.Now(), "account_number": "BIB-0001", "currency": "EUR", "holder_id": holderID,
+	})
+
+	accounts, err := m.ListCustomerAccounts(context.Background(), tenantID, holderID)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, "2000-001", accounts[0].LedgerAccountCode)
+}
+
+func TestProjectActivity_PaymentLifecycle(t *testing.T) {
+	m := newMemReadModel()
+	uc := usecase.NewProjectActivityUseCase(m, enrichment.Default)
+	tenantID, paymentID, source, destination := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	base := map[string]any{"tenant_id": tenantID, "aggregate_id": paymentID, "payment_id": paymentID}
+	with := func(extra map[string]any) map[string]any {
+		out := map[string]any{"event_id": uuid.NewString(), "occurred_at": time.Now()}
+		for k, v := range base {
+			out[k] = v
+		}
+		for k, v := range extra {
+			out[k] = v
+		}
+		return out
+	}
+
+	// Settlement of an unknown payment is ignored.
+	project(t, uc, "payment.order.settled", with(nil))
+	assert.Empty(t, m.activity)
+
+	project(t, uc, "payment.order.initiated", with(map[string]any{
+		"source_account_id": source, "destination_account_id": destination,
+		"amount": "25.50", "currency": "EUR", "rail": "INTERNAL", "description": "Rent",
+	}))
+	assert.Empty(t, m.activity)
+
+	settled := with(nil)
+	project(t, uc, "payment.order.settled", settled)
+	// Redelivery records nothing new.
+	project(t, uc, "payment.order.settled", settled)
+	assert.Equal(t, []string{"-25.50"}, amounts(m, source))
+	assert.Equal(t, []string{"25.50"}, amounts(m, destination))
+	assert.Equal(t, "Rent", m.activityOf(source)[0].Description)
+	assert.Equal(t, "TRANSFERS", m.activityOf(source)[0].Category)
+
+	project(t, uc, "payment.order.reversed", with(nil))
+	assert.Equal(t, []string{"-25.50", "25.50"}, amounts(m, source))
+	assert.Equal(t, []string{"25.50", "-25.50"}, amounts(m, destination))
+}
+
+func TestProjectActivity_ExternalPaymentDebitsSourceOnly(t *testing.T) {
+	m := newMemReadModel()
+	uc := usecase.NewProjectActivityUseCase(m, enrichment.Default)
+	tenantID, paymentID, source := uuid.New(), uuid.New(), uuid.New()
+
+	project(t, uc, "payment.order.initiated", map[string]any{
+		"event_id": uuid.NewString(), "tenant_id": tenantID, "occurred_at": time.Now(),
+		"payment_id": paymentID, "source_account_id": source, "amount": "10", "currency": "GBP", "rail": "FPS",
+	})
+	project(t, uc, "payment.order.settled", map[string]any{
+		"event_id": uuid.NewString(), "tenant_id": tenantID, "occurred_at": time.Now(), "payment_id": paymentID,
+	})
+
+	require.Len(t, m.activity, 1)
+	assert.Equal(t, source, m.activity[0].AccountID)
+	assert.Equal(t, "FPS payment", m.activity[0].Description)
+}
+
+func TestProjectActivity_CardTransactionIsEnriched(t *testing.T) {
+	m := newMemReadModel()
+	uc := usecase.NewProjectActivityUseCase(m, enrichment.Default)
+	tenantID, accountID := uuid.New(), uuid.New()
+	authorized := func(extra map[string]any) map[string]any {
+		out := map[string]any{
+			"event_id": uuid.NewString(), "tenant_id": tenantID, "occurred_at": time.Now(),
+			"account_id": accountID, "amount": "4.50", "currency": "USD", "auth_code": "A1B2C3",
+		}
+		for k, v := range extra {
+			out[k] = v
+		}
+		return out
+	}
+
+	project(t, uc, "card.transaction.authorized", authorized(map[string]any{
+		"merchant_name": "SQ *BLUE BOTTLE #0412", "merchant_category": "5814",
+		"merchant_display_name": "Blue Bottle Coffee", "category": "DINING",
+	}))
+	// Events published before the card service enriched them are enriched
+	// from the descriptor and MCC.
+	project(t, uc, "card.transaction.authorized", authorized(map[string]any{
+		"merchant_name": "STARBUCKS STORE 01234", "merchant_category": "5814",
+	}))
+
+	activity := m.activityOf(accountID)
+	require.Len(t, activity, 2)
+	assert.Equal(t, "Blue Bottle Coffee", activity[0].Description)
+	assert.Equal(t, "DINING", activity[0].Category)
+	assert.Equal(t, "Starbucks", activity[1].Description)
+	assert.Equal(t, "DINING", activity[1].Category)
+	assert.Equal(t, "starbucks", activity[1].Logo)
+}
+
+func TestProjectActivity_LoanDisbursementAndRepayment(t *testing.T) {
+	m := newMemReadModel()
+	uc := usecase.NewProjectActivityUseCase(m, enrichment.Default)
+	tenantID, accountID := uuid.New(), uuid.New()
+
+	project(t, uc, "lending.loan.disbursed", map[string]any{
+		"event_id": uuid.NewString(), "tenant_id": tenantID, "occurred_at": time.Now(),
+		"aggregate_id": "LOAN-1", "borrower_account_id": accountID.String(),
+		"principal": decimal.NewFromInt(5000), "currency": "USD",
+	})
+	project(t, uc, "lending.loan.payment_received", map[string]any{
+		"event_id": uuid.NewString(), "tenant_id": tenantID, "occurred_at": time.Now(),
+		"aggregate_id": "LOAN-1", "amount": "250", "currency": "USD",
+	})
+	// Repayments of loans disbursed before projection began are ignored.
+	project(t, uc, "lending.loan.payment_received", map[string]any{
+		"event_id": uuid.NewString(), "tenant_id": tenantID, "occurred_at": time.Now(),
+		"aggregate_id": "LOAN-0", "amount": "250", "currency": "USD",
+	})
+
+	assert.Equal(t, []string{"5000.00", "-250.00"}, amounts(m, accountID))
+}
+
+func TestProjectActivity_IgnoresOtherEvents(t *testing.T) {
+	m := newMemReadModel()
+	uc := usecase.NewProjectActivityUseCase(m, enrichment.Default)
+
+	require.NoError(t, uc.Execute(context.Background(), "account.frozen", []byte(`not json`)))
+	assert.Empty(t, m.activity)
+}
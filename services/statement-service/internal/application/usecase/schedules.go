@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/bibbank/bib/services/statement-service/internal/application/dto"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/model"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/port"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+)
+
+// ErrInvalidSchedule is returned when a statement schedule is malformed.
+var ErrInvalidSchedule = errors.New("invalid statement schedule")
+
+// UpsertScheduleUseCase creates a customer's statement schedule or changes
+// the one they have.
+type UpsertScheduleUseCase struct {
+	schedules port.ScheduleRepository
+}
+
+// NewUpsertScheduleUseCase creates a new UpsertScheduleUseCase.
+func NewUpsertScheduleUseCase(schedules port.ScheduleRepository) *UpsertScheduleUseCase {
+	return &UpsertScheduleUseCase{schedules: schedules}
+}
+
+// Execute creates or changes the schedule.
+func (uc *UpsertScheduleUseCase) Execute(ctx context.Context, req dto.UpsertScheduleRequest) (dto.ScheduleResponse, error) {
+	frequency, err := valueobject.NewStatementFrequency(req.Frequency)
+	if err != nil {
+		return dto.ScheduleResponse{}, fmt.Errorf("%w: %w", ErrInvalidSchedule, err)
+	}
+	format, err := formatOrDefault(req.Format)
+	if err != nil {
+		return dto.ScheduleResponse{}, fmt.Errorf("%w: %w", ErrInvalidSchedule, err)
+	}
+	now := time.Now().UTC()
+
+	schedule, err := uc.schedules.FindByCustomer(ctx, req.TenantID, req.CustomerID)
+	switch {
+	case errors.Is(err, port.ErrScheduleNotFound):
+		schedule, err = model.NewStatementSchedule(req.TenantID, req.CustomerID, frequency, format, now)
+		if err == nil && req.Paused {
+			schedule, err = schedule.Reconfigure(frequency, format, false, now)
+		}
+	case err != nil:
+		return dto.ScheduleResponse{}, fmt.Errorf("failed to find statement schedule: %w", err)
+	default:
+		schedule, err = schedule.Reconfigure(frequency, format, !req.Paused, now)
+	}
+	if err != nil {
+		return dto.ScheduleResponse{}, fmt.Errorf("%w: %w", ErrInvalidSchedule, err)
+	}
+
+	if err := uc.schedules.Save(ctx, schedule); err != nil {
+		return dto.ScheduleResponse{}, fmt.Errorf("failed to save statement schedule: %w", err)
+	}
+	return toScheduleResponse(schedule), nil
+}
+
+// ListSchedulesUseCase lists a tenant's statement schedules.
+type ListSchedulesUseCase struct {
+	schedules port.ScheduleRepository
+}
+
+// NewListSchedulesUseCase creates a new ListSchedulesUseCase.
+func NewListSchedulesUseCase(schedules port.ScheduleRepository) *ListSchedulesUseCase {
+	return &ListSchedulesUseCase{schedules: schedules}
+}
+
+// Execute lists the schedules.
+func (uc *ListSchedulesUseCase) Execute(ctx context.Context, req dto.ListSchedulesRequest) (dto.ListSchedulesResponse, error) {
+	limit, offset := page(req.Limit, req.Offset)
+	schedules, total, err := uc.schedules.List(ctx, req.TenantID, req.CustomerID, limit, offset)
+	if err != nil {
+		return dto.ListSchedulesResponse{}, fmt.Errorf("failed to list statement schedules: %w", err)
+	}
+	resp := dto.ListSchedulesResponse{
+		Schedules:  make([]dto.ScheduleResponse, 0, len(schedules)),
+		TotalCount: total,
+	}
+	for _, s := range schedules {
+		resp.Schedules = append(resp.Schedules, toScheduleResponse(s))
+	}
+	return resp, nil
+}
+
+// RunDueSchedulesUseCase generates the statements of schedules whose period
+// has ended. A period counts as ended grace after its last day, giving late
+// events time to be projected. Only one replica should run it at a time.
+type RunDueSchedulesUseCase struct {
+	schedules port.ScheduleRepository
+	gen       *generator
+	logger    *slog.Logger
+	grace     time.Duration
+}
+
+// NewRunDueSchedulesUseCase creates a new RunDueSchedulesUseCase. ledger and
+// logger may be nil.
+func NewRunDueSchedulesUseCase(
+	schedules port.ScheduleRepository,
+	readModel port.ActivityReadModel,
+	ledger port.LedgerPostings,
+	statements port.StatementRepository,
+	store port.ObjectStore,
+	publisher port.EventPublisher,
+	grace time.Duration,
+	logger *slog.Logger,
+) *RunDueSchedulesUseCase {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &RunDueSchedulesUseCase{
+		schedules: schedules,
+		gen: &generator{
+			readModel: readModel, ledger: ledger, statements: statements, objects: store, publisher: publisher,
+		},
+		logger: logger,
+		grace:  grace,
+	}
+}
+
+// Execute generates every statement due at now. A schedule that cannot be
+// recorded is logged and left for the next pass.
+func (uc *RunDueSchedulesUseCase) Execute(ctx context.Context, now time.Time) (dto.RunDueSchedulesResult, error) {
+	var result dto.RunDueSchedulesResult
+
+	schedules, err := uc.schedules.ListActive(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to list statement schedules: %w", err)
+	}
+	for _, schedule := range schedules {
+		start, end, ok := schedule.DuePeriod(now.Add(-uc.grace))
+		if !ok {
+			continue
+		}
+		statement, err := model.NewStatement(schedule.TenantID(), schedule.CustomerID(), schedule.ID(), start, end, schedule.Format())
+		if err != nil {
+			uc.logger.Error("invalid scheduled statement", "schedule_id", schedule.ID(), "error", err)
+			continue
+		}
+		// Saving the advanced schedule claims the period: a concurrent pass
+		// that loaded the same schedule fails with a version conflict.
+		if err := uc.schedules.Save(ctx, schedule.MarkGenerated(end, now)); err != nil {
+			if !errors.Is(err, port.ErrVersionConflict) {
+				uc.logger.Error("failed to advance statement schedule", "schedule_id", schedule.ID(), "error", err)
+			}
+			continue
+		}
+
+		statement, err = uc.gen.generate(ctx, statement, now)
+		if err != nil {
+			uc.logger.Error("failed to record scheduled statement", "schedule_id", schedule.ID(), "error", err)
+			result.Failed++
+			continue
+		}
+		if statement.Status().Equal(valueobject.StatementStatusFailed) {
+			uc.logger.Warn("scheduled statement failed", "schedule_id", schedule.ID(),
+				"statement_id", statement.ID(), "error", statement.FailureReason())
+			result.Failed++
+			continue
+		}
+		result.Generated++
+	}
+	return result, nil
+}
+
+func toScheduleResponse(s model.StatementSchedule) dto.ScheduleResponse {
+	return dto.ScheduleResponse{
+		ID:            s.ID(),
+		TenantID:      s.TenantID(),
+		CustomerID:    s.CustomerID(),
+		Frequency:     s.Frequency().String(),
+		Format:        s.Format().String(),
+		Active:        s.Active(),
+		LastPeriodEnd: s.LastPeriodEnd(),
+		Version:       s.Version(),
+		CreatedAt:     s.CreatedAt(),
+		UpdatedAt:     s.UpdatedAt(),
+	}
+}
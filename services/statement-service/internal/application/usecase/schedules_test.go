@@ -0,0 +1,110 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/statement-service/internal/application/dto"
+	"github.com/bibbank/bib/services/statement-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/model"
+)
+
+// reconstructWithLastPeriodEnd returns the schedule as if its last statement
+// covered the period ending lastPeriodEnd.
+func reconstructWithLastPeriodEnd(s model.StatementSchedule, lastPeriodEnd time.Time) model.StatementSchedule {
+	return model.ReconstructStatementSchedule(s.ID(), s.TenantID(), s.CustomerID(), s.Frequency(), s.Format(),
+		lastPeriodEnd, s.Active(), s.Version(), s.CreatedAt(), s.UpdatedAt())
+}
+
+func TestUpsertSchedule_CreatesThenReconfigures(t *testing.T) {
+	f := newFixture()
+	uc := usecase.NewUpsertScheduleUseCase(f.schedules)
+
+	created, err := uc.Execute(context.Background(), dto.UpsertScheduleRequest{
+		TenantID: f.tenantID, CustomerID: f.customerID, Frequency: "MONTHLY",
+	})
+	require.NoError(t, err)
+	assert.True(t, created.Active)
+	assert.Equal(t, "PDF", created.Format)
+
+	updated, err := uc.Execute(context.Background(), dto.UpsertScheduleRequest{
+		TenantID: f.tenantID, CustomerID: f.customerID, Frequency: "QUARTERLY", Format: "CSV", Paused: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, updated.ID)
+	assert.False(t, updated.Active)
+	assert.Equal(t, "QUARTERLY", updated.Frequency)
+	assert.Equal(t, 2, updated.Version)
+
+	_, err = uc.Execute(context.Background(), dto.UpsertScheduleRequest{
+		TenantID: f.tenantID, CustomerID: f.customerID, Frequency: "WEEKLY",
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidSchedule)
+
+	list, err := usecase.NewListSchedulesUseCase(f.schedules).Execute(context.Background(), dto.ListSchedulesRequest{TenantID: f.tenantID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, list.TotalCount)
+}
+
+func TestRunDueSchedules_GeneratesEachPeriodOnce(t *testing.T) {
+	f := newFixture()
+	_, err := usecase.NewUpsertScheduleUseCase(f.schedules).Execute(context.Background(), dto.UpsertScheduleRequest{
+		TenantID: f.tenantID, CustomerID: f.customerID, Frequency: "MONTHLY", Format: "CSV",
+	})
+	require.NoError(t, err)
+	// The schedule was created now, so its first statement would cover
+	// this month; backdate it to cover January.
+	f.schedules.schedules[0] = reconstructWithLastPeriodEnd(f.schedules.schedules[0], time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC))
+
+	uc := usecase.NewRunDueSchedulesUseCase(f.schedules, f.readModel, nil, f.statements, f.store, f.pub, 6*time.Hour, nil)
+
+	// Within the grace period January is not yet due.
+	result, err := uc.Execute(context.Background(), time.Date(2026, 2, 1, 3, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunDueSchedulesResult{}, result)
+
+	result, err = uc.Execute(context.Background(), time.Date(2026, 2, 1, 7, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunDueSchedulesResult{Generated: 1}, result)
+
+	result, err = uc.Execute(context.Background(), time.Date(2026, 2, 2, 7, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunDueSchedulesResult{}, result)
+
+	list, err := usecase.NewListStatementsUseCase(f.statements).Execute(context.Background(), dto.ListStatementsRequest{
+		TenantID: f.tenantID, CustomerID: f.customerID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, list.TotalCount)
+	stmt := list.Statements[0]
+	assert.Equal(t, "2026-01-01", stmt.PeriodStart.Format(time.DateOnly))
+	assert.Equal(t, "2026-01-31", stmt.PeriodEnd.Format(time.DateOnly))
+	assert.Equal(t, f.schedules.schedules[0].ID(), stmt.ScheduleID)
+	assert.Equal(t, 2, stmt.EntryCount)
+}
+
+func TestRunDueSchedules_CountsFailures(t *testing.T) {
+	f := newFixture()
+	_, err := usecase.NewUpsertScheduleUseCase(f.schedules).Execute(context.Background(), dto.UpsertScheduleRequest{
+		TenantID: f.tenantID, CustomerID: f.customerID, Frequency: "MONTHLY",
+	})
+	require.NoError(t, err)
+	f.schedules.schedules[0] = reconstructWithLastPeriodEnd(f.schedules.schedules[0], time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC))
+	f.store.err = errStoreDown
+
+	uc := usecase.NewRunDueSchedulesUseCase(f.schedules, f.readModel, nil, f.statements, f.store, f.pub, 0, nil)
+	result, err := uc.Execute(context.Background(), time.Date(2026, 2, 1, 7, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunDueSchedulesResult{Failed: 1}, result)
+	assert.Equal(t, []string{"statement.failed"}, f.pub.eventTypes())
+
+	// The period was claimed, so a failed statement is not retried by the
+	// schedule; it can be requested on demand.
+	result, err = uc.Execute(context.Background(), time.Date(2026, 2, 1, 8, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, dto.RunDueSchedulesResult{}, result)
+}
@@ -0,0 +1,283 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/statement-service/internal/application/dto"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/model"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/port"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/service"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+)
+
+// ErrInvalidStatement is returned when a statement request is malformed.
+var ErrInvalidStatement = errors.New("invalid statement")
+
+// ErrStatementNotGenerated is returned when the document of a statement that
+// failed to generate is requested.
+var ErrStatementNotGenerated = errors.New("statement was not generated")
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// generator renders statements and stores their documents. A statement whose
+// activity cannot be read, rendered or stored is recorded as FAILED rather
+// than returned as an error, so every attempt leaves a statement behind.
+// Accounts linked to a ledger account list the ledger's postings; the rest,
+// and every account when ledger is nil, list projected activity.
+type generator struct {
+	readModel  port.ActivityReadModel
+	ledger     port.LedgerPostings
+	statements port.StatementRepository
+	objects    port.ObjectStore
+	publisher  port.EventPublisher
+}
+
+func (g *generator) generate(ctx context.Context, statement model.Statement, now time.Time) (model.Statement, error) {
+	generated, err := g.store(ctx, statement, now)
+	if err == nil {
+		statement = generated
+	} else if statement, err = statement.MarkFailed(err.Error(), now); err != nil {
+		return statement, err
+	}
+
+	if err := g.statements.Save(ctx, statement); err != nil {
+		return statement, fmt.Errorf("failed to save statement: %w", err)
+	}
+	if events := statement.DomainEvents(); len(events) > 0 {
+		if err := g.publisher.Publish(ctx, events); err != nil {
+			return statement, fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+	return statement.ClearDomainEvents(), nil
+}
+
+// store renders the statement's document and writes it to object storage.
+func (g *generator) store(ctx context.Context, statement model.Statement, now time.Time) (model.Statement, error) {
+	content, entries, err := g.render(ctx, statement, now)
+	if err != nil {
+		return statement, err
+	}
+	key := objectKey(statement)
+	if err := g.objects.Put(ctx, key, content, statement.Format().ContentType()); err != nil {
+		return statement, fmt.Errorf("failed to store statement: %w", err)
+	}
+	sum := sha256.Sum256(content)
+	return statement.MarkGenerated(key, hex.EncodeToString(sum[:]), int64(len(content)), entries, now)
+}
+
+// render builds the statement's document from the activity of the
+// customer's accounts, returning it and its number of activity lines.
+func (g *generator) render(ctx context.Context, statement model.Statement, now time.Time) ([]byte, int, error) {
+	accounts, err := g.readModel.ListCustomerAccounts(ctx, statement.TenantID(), statement.CustomerID())
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list customer accounts: %w", err)
+	}
+	from, to := statement.PeriodStart(), statement.PeriodEnd().AddDate(0, 0, 1)
+
+	var activity []service.Activity
+	var projected []uuid.UUID
+	for _, a := range accounts {
+		if g.ledger == nil || a.LedgerAccountCode == "" {
+			projected = append(projected, a.ID)
+			continue
+		}
+		postings, err := g.ledger.ListPostings(ctx, statement.TenantID(), a.LedgerAccountCode, from, to)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list ledger postings of account %s: %w", a.AccountNumber, err)
+		}
+		activity = append(activity, service.PostingActivity(a, postings)...)
+	}
+	if len(projected) > 0 {
+		lines, err := g.readModel.ListActivity(ctx, statement.TenantID(), projected, from, to)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to list account activity: %w", err)
+		}
+		activity = append(activity, lines...)
+	}
+
+	doc := service.BuildStatementDocument(service.StatementDocument{
+		StatementID: statement.ID(),
+		TenantID:    statement.TenantID(),
+		CustomerID:  statement.CustomerID(),
+		PeriodStart: statement.PeriodStart(),
+		PeriodEnd:   statement.PeriodEnd(),
+		GeneratedAt: now,
+	}, accounts, activity)
+	content, err := service.RenderStatement(statement.Format(), doc)
+	if err != nil {
+		return nil, 0, err
+	}
+	return content, doc.EntryCount(), nil
+}
+
+// objectKey returns the key a statement's document is stored under.
+func objectKey(s model.Statement) string {
+	return fmt.Sprintf("statements/%s/%s/%s.%s", s.TenantID(), s.CustomerID(), s.ID(), s.Format().Extension())
+}
+
+// GenerateStatementUseCase generates a customer's statement on demand.
+type GenerateStatementUseCase struct {
+	gen *generator
+}
+
+// NewGenerateStatementUseCase creates a new GenerateStatementUseCase. ledger
+// may be nil, in which case statements list projected activity only.
+func NewGenerateStatementUseCase(
+	readModel port.ActivityReadModel,
+	ledger port.LedgerPostings,
+	statements port.StatementRepository,
+	store port.ObjectStore,
+	publisher port.EventPublisher,
+) *GenerateStatementUseCase {
+	return &GenerateStatementUseCase{gen: &generator{
+		readModel: readModel, ledger: ledger, statements: statements, objects: store, publisher: publisher,
+	}}
+}
+
+// Execute generates the statement. A statement that could not be rendered or
+// stored is returned with status FAILED.
+func (uc *GenerateStatementUseCase) Execute(ctx context.Context, req dto.GenerateStatementRequest) (dto.StatementResponse, error) {
+	format, err := formatOrDefault(req.Format)
+	if err != nil {
+		return dto.StatementResponse{}, fmt.Errorf("%w: %w", ErrInvalidStatement, err)
+	}
+	now := time.Now().UTC()
+	if req.PeriodEnd.After(now) {
+		return dto.StatementResponse{}, fmt.Errorf("%w: period must not end in the future", ErrInvalidStatement)
+	}
+	statement, err := model.NewStatement(req.TenantID, req.CustomerID, uuid.Nil, req.PeriodStart, req.PeriodEnd, format)
+	if err != nil {
+		return dto.StatementResponse{}, fmt.Errorf("%w: %w", ErrInvalidStatement, err)
+	}
+	statement, err = uc.gen.generate(ctx, statement, now)
+	if err != nil {
+		return dto.StatementResponse{}, err
+	}
+	return toStatementResponse(statement), nil
+}
+
+// GetStatementUseCase retrieves a statement.
+type GetStatementUseCase struct {
+	statements port.StatementRepository
+}
+
+// NewGetStatementUseCase creates a new GetStatementUseCase.
+func NewGetStatementUseCase(statements port.StatementRepository) *GetStatementUseCase {
+	return &GetStatementUseCase{statements: statements}
+}
+
+// Execute retrieves one of the tenant's statements.
+func (uc *GetStatementUseCase) Execute(ctx context.Context, tenantID, statementID uuid.UUID) (dto.StatementResponse, error) {
+	statement, err := uc.statements.FindByID(ctx, tenantID, statementID)
+	if err != nil {
+		return dto.StatementResponse{}, fmt.Errorf("failed to find statement: %w", err)
+	}
+	return toStatementResponse(statement), nil
+}
+
+// ListStatementsUseCase lists a customer's statements.
+type ListStatementsUseCase struct {
+	statements port.StatementRepository
+}
+
+// NewListStatementsUseCase creates a new ListStatementsUseCase.
+func NewListStatementsUseCase(statements port.StatementRepository) *ListStatementsUseCase {
+	return &ListStatementsUseCase{statements: statements}
+}
+
+// Execute lists the customer's statements, latest period first.
+func (uc *ListStatementsUseCase) Execute(ctx context.Context, req dto.ListStatementsRequest) (dto.ListStatementsResponse, error) {
+	limit, offset := page(req.Limit, req.Offset)
+	statements, total, err := uc.statements.ListByCustomer(ctx, req.TenantID, req.CustomerID, limit, offset)
+	if err != nil {
+		return dto.ListStatementsResponse{}, fmt.Errorf("failed to list statements: %w", err)
+	}
+	resp := dto.ListStatementsResponse{
+		Statements: make([]dto.StatementResponse, 0, len(statements)),
+		TotalCount: total,
+	}
+	for _, s := range statements {
+		resp.Statements = append(resp.Statements, toStatementResponse(s))
+	}
+	return resp, nil
+}
+
+// GetStatementContentUseCase retrieves a statement's document.
+type GetStatementContentUseCase struct {
+	statements port.StatementRepository
+	store      port.ObjectStore
+}
+
+// NewGetStatementContentUseCase creates a new GetStatementContentUseCase.
+func NewGetStatementContentUseCase(statements port.StatementRepository, store port.ObjectStore) *GetStatementContentUseCase {
+	return &GetStatementContentUseCase{statements: statements, store: store}
+}
+
+// Execute reads the statement's document from object storage, checking it
+// against the checksum recorded when it was generated.
+func (uc *GetStatementContentUseCase) Execute(ctx context.Context, tenantID, statementID uuid.UUID) (dto.StatementContentResponse, error) {
+	statement, err := uc.statements.FindByID(ctx, tenantID, statementID)
+	if err != nil {
+		return dto.StatementContentResponse{}, fmt.Errorf("failed to find statement: %w", err)
+	}
+	if !statement.Status().Equal(valueobject.StatementStatusGenerated) {
+		return dto.StatementContentResponse{}, fmt.Errorf("statement %s: %w", statementID, ErrStatementNotGenerated)
+	}
+	content, err := uc.store.Get(ctx, statement.ObjectKey())
+	if err != nil {
+		return dto.StatementContentResponse{}, fmt.Errorf("failed to read statement %s: %w", statementID, err)
+	}
+	sum := sha256.Sum256(content)
+	if hex.EncodeToString(sum[:]) != statement.SHA256() {
+		return dto.StatementContentResponse{}, fmt.Errorf("statement %s does not match its checksum", statementID)
+	}
+	return dto.StatementContentResponse{
+		FileName:    statement.FileName(),
+		ContentType: statement.Format().ContentType(),
+		Content:     content,
+	}, nil
+}
+
+func formatOrDefault(s string) (valueobject.StatementFormat, error) {
+	if s == "" {
+		return valueobject.StatementFormatPDF, nil
+	}
+	return valueobject.NewStatementFormat(s)
+}
+
+func page(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	return min(limit, maxLimit), max(offset, 0)
+}
+
+func toStatementResponse(s model.Statement) dto.StatementResponse {
+	return dto.StatementResponse{
+		ID:            s.ID(),
+		TenantID:      s.TenantID(),
+		CustomerID:    s.CustomerID(),
+		ScheduleID:    s.ScheduleID(),
+		PeriodStart:   s.PeriodStart(),
+		PeriodEnd:     s.PeriodEnd(),
+		Format:        s.Format().String(),
+		Status:        s.Status().String(),
+		ContentType:   s.Format().ContentType(),
+		SHA256:        s.SHA256(),
+		SizeBytes:     s.SizeBytes(),
+		EntryCount:    s.EntryCount(),
+		FailureReason: s.FailureReason(),
+		GeneratedAt:   s.GeneratedAt(),
+		Version:       s.Version(),
+	}
+}
@@ -0,0 +1,197 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/statement-service/internal/application/dto"
+	"github.com/bibbank/bib/services/statement-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/service"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+)
+
+type fixture struct {
+	readModel  *memReadModel
+	statements *inMemoryStatementRepo
+	schedules  *inMemoryScheduleRepo
+	store      *memStore
+	pub        *recordingPublisher
+	tenantID   uuid.UUID
+	customerID uuid.UUID
+	accountID  uuid.UUID
+}
+
+// newFixture creates a customer with one account that was credited 100.00
+// and debited 40.00 on 15 January 2026.
+func newFixture() *fixture {
+	f := &fixture{
+		readModel:  newMemReadModel(),
+		statements: &inMemoryStatementRepo{},
+		schedules:  &inMemoryScheduleRepo{},
+		store:      newMemStore(),
+		pub:        &recordingPublisher{},
+		tenantID:   uuid.New(),
+		customerID: uuid.New(),
+		accountID:  uuid.New(),
+	}
+	f.readModel.accounts = append(f.readModel.accounts, service.CustomerAccount{
+		ID:            f.accountID,
+		TenantID:      f.tenantID,
+		CustomerID:    f.customerID,
+		AccountNumber: "BIB-0001",
+		AccountType:   "CURRENT",
+		Currency:      "EUR",
+	})
+	at := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+	f.readModel.activity = append(f.readModel.activity,
+		service.Activity{
+			EventID: "evt-1", TenantID: f.tenantID, AccountID: f.accountID, OccurredAt: at,
+			Source: valueobject.ActivitySourceAccount, Description: "Salary",
+			Amount: decimal.NewFromInt(100), Currency: "EUR",
+		},
+		service.Activity{
+			EventID: "evt-2", TenantID: f.tenantID, AccountID: f.accountID, OccurredAt: at.Add(time.Hour),
+			Source: valueobject.ActivitySourceCard, Description: "Coffee Shop",
+			Amount: decimal.NewFromInt(-40), Currency: "EUR",
+		},
+	)
+	return f
+}
+
+func (f *fixture) generateRequest(format string) dto.GenerateStatementRequest {
+	return dto.GenerateStatementRequest{
+		TenantID:    f.tenantID,
+		CustomerID:  f.customerID,
+		PeriodStart: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:   time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+		Format:      format,
+	}
+}
+
+func TestGenerateStatement_RendersAndStoresDocument(t *testing.T) {
+	f := newFixture()
+	uc := usecase.NewGenerateStatementUseCase(f.readModel, nil, f.statements, f.store, f.pub)
+
+	resp, err := uc.Execute(context.Background(), f.generateRequest("CSV"))
+	require.NoError(t, err)
+	assert.Equal(t, "GENERATED", resp.Status)
+	assert.Equal(t, 2, resp.EntryCount)
+	assert.Equal(t, "text/csv", resp.ContentType)
+	assert.Equal(t, []string{"statement.generated"}, f.pub.eventTypes())
+
+	content, err := usecase.NewGetStatementContentUseCase(f.statements, f.store).Execute(context.Background(), f.tenantID, resp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "statement-2026-01-01-2026-01-31.csv", content.FileName)
+	assert.Equal(t, int64(len(content.Content)), resp.SizeBytes)
+	lines := strings.Split(strings.TrimSpace(string(content.Content)), "\n")
+	require.Len(t, lines, 3)
+	assert.Contains(t, lines[1], "Salary")
+	assert.Contains(t, lines[2], "-40.00")
+}
+
+func TestGenerateStatement_ListsLedgerPostings(t *testing.T) {
+	f := newFixture()
+	f.readModel.accounts[0].LedgerAccountCode = "2000-001"
+	day := time.Date(2026, 1, 31, 18, 0, 0, 0, time.UTC)
+	ledger := &memLedger{postings: map[string][]service.LedgerPosting{"2000-001": {
+		{EntryID: "je-1", Leg: 1, EffectiveDate: day, Description: "Salary", Amount: decimal.NewFromInt(2500), Currency: "EUR", Credit: true},
+		{EntryID: "je-2", Leg: 0, EffectiveDate: day.Add(time.Hour), Description: "Rent", Amount: decimal.NewFromInt(900), Currency: "EUR"},
+		// Postings after the period are left out.
+		{EntryID: "je-3", Leg: 0, EffectiveDate: day.AddDate(0, 0, 1), Description: "Groceries", Amount: decimal.NewFromInt(60), Currency: "EUR"},
+	}}}
+	uc := usecase.NewGenerateStatementUseCase(f.readModel, ledger, f.statements, f.store, f.pub)
+
+	resp, err := uc.Execute(context.Background(), f.generateRequest("CSV"))
+	require.NoError(t, err)
+	assert.Equal(t, "GENERATED", resp.Status)
+	assert.Equal(t, 2, resp.EntryCount)
+
+	content, err := usecase.NewGetStatementContentUseCase(f.statements, f.store).Execute(context.Background(), f.tenantID, resp.ID)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content.Content)), "\n")
+	require.Len(t, lines, 3)
+	assert.Contains(t, lines[1], "2500.00")
+	assert.Contains(t, lines[2], "-900.00")
+	// The account's projected activity is superseded by the ledger.
+	assert.NotContains(t, string(content.Content), "Coffee Shop")
+}
+
+func TestGenerateStatement_RecordsLedgerFailure(t *testing.T) {
+	f := newFixture()
+	f.readModel.accounts[0].LedgerAccountCode = "2000-001"
+	uc := usecase.NewGenerateStatementUseCase(f.readModel, &memLedger{err: errLedgerDown}, f.statements, f.store, f.pub)
+
+	resp, err := uc.Execute(context.Background(), f.generateRequest("CSV"))
+	require.NoError(t, err)
+	assert.Equal(t, "FAILED", resp.Status)
+	assert.Contains(t, resp.FailureReason, errLedgerDown.Error())
+}
+
+func TestGenerateStatement_DefaultsToPDF(t *testing.T) {
+	f := newFixture()
+	uc := usecase.NewGenerateStatementUseCase(f.readModel, nil, f.statements, f.store, f.pub)
+
+	resp, err := uc.Execute(context.Background(), f.generateRequest(""))
+	require.NoError(t, err)
+	assert.Equal(t, "PDF", resp.Format)
+
+	content, err := usecase.NewGetStatementContentUseCase(f.statements, f.store).Execute(context.Background(), f.tenantID, resp.ID)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(content.Content), "%PDF-"))
+}
+
+func TestGenerateStatement_RecordsFailure(t *testing.T) {
+	f := newFixture()
+	f.store.err = errStoreDown
+	uc := usecase.NewGenerateStatementUseCase(f.readModel, nil, f.statements, f.store, f.pub)
+
+	resp, err := uc.Execute(context.Background(), f.generateRequest("CSV"))
+	require.NoError(t, err)
+	assert.Equal(t, "FAILED", resp.Status)
+	assert.Contains(t, resp.FailureReason, errStoreDown.Error())
+	assert.Equal(t, []string{"statement.failed"}, f.pub.eventTypes())
+
+	_, err = usecase.NewGetStatementContentUseCase(f.statements, f.store).Execute(context.Background(), f.tenantID, resp.ID)
+	assert.ErrorIs(t, err, usecase.ErrStatementNotGenerated)
+}
+
+func TestGenerateStatement_Validation(t *testing.T) {
+	f := newFixture()
+	uc := usecase.NewGenerateStatementUseCase(f.readModel, nil, f.statements, f.store, f.pub)
+
+	req := f.generateRequest("XLSX")
+	_, err := uc.Execute(context.Background(), req)
+	assert.ErrorIs(t, err, usecase.ErrInvalidStatement)
+
+	req = f.generateRequest("CSV")
+	req.PeriodStart, req.PeriodEnd = req.PeriodEnd, req.PeriodStart
+	_, err = uc.Execute(context.Background(), req)
+	assert.ErrorIs(t, err, usecase.ErrInvalidStatement)
+
+	req = f.generateRequest("CSV")
+	req.PeriodEnd = time.Now().AddDate(0, 0, 2)
+	_, err = uc.Execute(context.Background(), req)
+	assert.ErrorIs(t, err, usecase.ErrInvalidStatement)
+
+	assert.Empty(t, f.statements.statements)
+}
+
+func TestGetStatementContent_DetectsTampering(t *testing.T) {
+	f := newFixture()
+	resp, err := usecase.NewGenerateStatementUseCase(f.readModel, nil, f.statements, f.store, f.pub).
+		Execute(context.Background(), f.generateRequest("CSV"))
+	require.NoError(t, err)
+
+	for key := range f.store.objects {
+		f.store.objects[key] = []byte("tampered")
+	}
+	_, err = usecase.NewGetStatementContentUseCase(f.statements, f.store).Execute(context.Background(), f.tenantID, resp.ID)
+	assert.ErrorContains(t, err, "checksum")
+}
@@ -0,0 +1,271 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/statement-service/internal/domain/event"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/model"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/port"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/service"
+)
+
+// memReadModel is an in-memory ActivityReadModel.
+type memReadModel struct {
+	accounts []service.CustomerAccount
+	payments map[uuid.UUID]service.PaymentFact
+	loans    map[string]service.LoanFact
+	activity []service.Activity
+}
+
+func newMemReadModel() *memReadModel {
+	return &memReadModel{
+		payments: make(map[uuid.UUID]service.PaymentFact),
+		loans:    make(map[string]service.LoanFact),
+	}
+}
+
+func (m *memReadModel) SaveAccount(_ context.Context, account service.CustomerAccount) error {
+	if i := slices.IndexFunc(m.accounts, func(a service.CustomerAccount) bool { return a.ID == account.ID }); i >= 0 {
+		// As in PostgreSQL, saving an account keeps its ledger account code.
+		account.LedgerAccountCode = m.accounts[i].LedgerAccountCode
+		m.accounts[i] = account
+		return nil
+	}
+	m.accounts = append(m.accounts, account)
+	return nil
+}
+
+func (m *memReadModel) SetLedgerAccountCode(_ context.Context, tenantID, accountID uuid.UUID, code string) error {
+	for i, a := range m.accounts {
+		if a.TenantID == tenantID && a.ID == accountID {
+			m.accounts[i].LedgerAccountCode = code
+		}
+	}
+	return nil
+}
+
+func (m *memReadModel) ListCustomerAccounts(_ context.Context, tenantID, customerID uuid.UUID) ([]service.CustomerAccount, error) {
+	var out []service.CustomerAccount
+	for _, a := range m.accounts {
+		if a.TenantID == tenantID && a.CustomerID == customerID {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (m *memReadModel) FindPayment(_ context.Context, id uuid.UUID) (service.PaymentFact, error) {
+	p, ok := m.payments[id]
+	if !ok {
+		return service.PaymentFact{}, port.ErrProjectionNotFound
+	}
+	return p, nil
+}
+
+func (m *memReadModel) SavePayment(_ context.Context, payment service.PaymentFact) error {
+	m.payments[payment.ID] = payment
+	return nil
+}
+
+func (m *memReadModel) FindLoan(_ context.Context, id string) (service.LoanFact, error) {
+	l, ok := m.loans[id]
+	if !ok {
+		return service.LoanFact{}, port.ErrProjectionNotFound
+	}
+	return l, nil
+}
+
+func (m *memReadModel) SaveLoan(_ context.Context, loan service.LoanFact) error {
+	m.loans[loan.ID] = loan
+	return nil
+}
+
+func (m *memReadModel) RecordActivity(_ context.Context, activity ...service.Activity) error {
+	for _, a := range activity {
+		if slices.ContainsFunc(m.activity, func(existing service.Activity) bool {
+			return existing.EventID == a.EventID && existing.AccountID == a.AccountID
+		}) {
+			continue
+		}
+		m.activity = append(m.activity, a)
+	}
+	return nil
+}
+
+func (m *memReadModel) ListActivity(_ context.Context, tenantID uuid.UUID, accountIDs []uuid.UUID, from, to time.Time) ([]service.Activity, error) {
+	var out []service.Activity
+	for _, a := range m.activity {
+		if a.TenantID == tenantID && slices.Contains(accountIDs, a.AccountID) &&
+			!a.OccurredAt.Before(from) && a.OccurredAt.Before(to) {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+// activityOf returns the activity recorded on an account.
+func (m *memReadModel) activityOf(accountID uuid.UUID) []service.Activity {
+	var out []service.Activity
+	for _, a := range m.activity {
+		if a.AccountID == accountID {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+// memLedger is an in-memory LedgerPostings that fails while err is set.
+type memLedger struct {
+	postings map[string][]service.LedgerPosting
+	err      error
+}
+
+func (l *memLedger) ListPostings(_ context.Context, _ uuid.UUID, accountCode string, from, to time.Time) ([]service.LedgerPosting, error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+	var out []service.LedgerPosting
+	for _, p := range l.postings[accountCode] {
+		if !p.EffectiveDate.Before(from) && p.EffectiveDate.Before(to) {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+type inMemoryStatementRepo struct {
+	statements []model.Statement
+}
+
+func (r *inMemoryStatementRepo) Save(_ context.Context, statement model.Statement) error {
+	r.statements = append(r.statements, statement.ClearDomainEvents())
+	return nil
+}
+
+func (r *inMemoryStatementRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.Statement, error) {
+	for _, s := range r.statements {
+		if s.TenantID() == tenantID && s.ID() == id {
+			return s, nil
+		}
+	}
+	return model.Statement{}, port.ErrStatementNotFound
+}
+
+func (r *inMemoryStatementRepo) ListByCustomer(_ context.Context, tenantID, customerID uuid.UUID, limit, offset int) ([]model.Statement, int, error) {
+	var out []model.Statement
+	for _, s := range r.statements {
+		if s.TenantID() == tenantID && s.CustomerID() == customerID {
+			out = append(out, s)
+		}
+	}
+	slices.SortFunc(out, func(a, b model.Statement) int { return b.PeriodEnd().Compare(a.PeriodEnd()) })
+	total := len(out)
+	out = out[min(offset, total):]
+	return out[:min(limit, len(out))], total, nil
+}
+
+type inMemoryScheduleRepo struct {
+	schedules []model.StatementSchedule
+}
+
+func (r *inMemoryScheduleRepo) Save(_ context.Context, schedule model.StatementSchedule) error {
+	i := slices.IndexFunc(r.schedules, func(existing model.StatementSchedule) bool { return existing.ID() == schedule.ID() })
+	if i < 0 {
+		if slices.ContainsFunc(r.schedules, func(existing model.StatementSchedule) bool {
+			return existing.TenantID() == schedule.TenantID() && existing.CustomerID() == schedule.CustomerID()
+		}) {
+			return port.ErrVersionConflict
+		}
+		r.schedules = append(r.schedules, schedule)
+		return nil
+	}
+	if r.schedules[i].Version() != schedule.Version()-1 {
+		return port.ErrVersionConflict
+	}
+	r.schedules[i] = schedule
+	return nil
+}
+
+func (r *inMemoryScheduleRepo) FindByCustomer(_ context.Context, tenantID, customerID uuid.UUID) (model.StatementSchedule, error) {
+	for _, s := range r.schedules {
+		if s.TenantID() == tenantID && s.CustomerID() == customerID {
+			return s, nil
+		}
+	}
+	return model.StatementSchedule{}, port.ErrScheduleNotFound
+}
+
+func (r *inMemoryScheduleRepo) List(_ context.Context, tenantID, customerID uuid.UUID, limit, offset int) ([]model.StatementSchedule, int, error) {
+	var out []model.StatementSchedule
+	for _, s := range r.schedules {
+		if s.TenantID() == tenantID && (customerID == uuid.Nil || s.CustomerID() == customerID) {
+			out = append(out, s)
+		}
+	}
+	total := len(out)
+	out = out[min(offset, total):]
+	return out[:min(limit, len(out))], total, nil
+}
+
+func (r *inMemoryScheduleRepo) ListActive(_ context.Context) ([]model.StatementSchedule, error) {
+	var out []model.StatementSchedule
+	for _, s := range r.schedules {
+		if s.Active() {
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+
+// memStore is an in-memory ObjectStore that fails writes while err is set.
+type memStore struct {
+	objects map[string][]byte
+	err     error
+}
+
+func newMemStore() *memStore {
+	return &memStore{objects: make(map[string][]byte)}
+}
+
+func (s *memStore) Put(_ context.Context, key string, data []byte, _ string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.objects[key] = slices.Clone(data)
+	return nil
+}
+
+func (s *memStore) Get(_ context.Context, key string) ([]byte, error) {
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, port.ErrObjectNotFound
+	}
+	return data, nil
+}
+
+var (
+	errStoreDown  = errors.New("object store unavailable")
+	errLedgerDown = errors.New("ledger unavailable")
+)
+
+type recordingPublisher struct {
+	events []event.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, events []event.DomainEvent) error {
+	p.events = append(p.events, events...)
+	return nil
+}
+
+func (p *recordingPublisher) eventTypes() []string {
+	types := make([]string, 0, len(p.events))
+	for _, e := range p.events {
+		types = append(types, e.EventType())
+	}
+	return types
+}
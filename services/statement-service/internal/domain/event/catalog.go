@@ -0,0 +1,12 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[StatementGenerated]("statement.generated", 1, "Emitted when a statement's document has been rendered and stored."),
+		events.Declare[StatementFailed]("statement.failed", 1, "Emitted when a statement could not be rendered or stored."),
+	}
+}
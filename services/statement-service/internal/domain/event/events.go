@@ -0,0 +1,71 @@
+package event
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// DomainEvent is an alias for the shared pkg/events.DomainEvent interface.
+type DomainEvent = events.DomainEvent
+
+// AggregateTypeStatement is the aggregate type of statement events.
+const AggregateTypeStatement = "Statement"
+
+// StatementGenerated is emitted when a statement's document has been rendered
+// and stored.
+type StatementGenerated struct {
+	events.BaseEvent
+	PeriodStart string    `json:"period_start"`
+	PeriodEnd   string    `json:"period_end"`
+	Format      string    `json:"format"`
+	SHA256      string    `json:"sha256"`
+	CustomerID  uuid.UUID `json:"customer_id"`
+	ScheduleID  uuid.UUID `json:"schedule_id,omitempty"`
+	SizeBytes   int64     `json:"size_bytes"`
+	EntryCount  int       `json:"entry_count"`
+}
+
+// NewStatementGenerated creates a new StatementGenerated event. Periods are
+// YYYY-MM-DD dates.
+func NewStatementGenerated(
+	statementID, tenantID, customerID, scheduleID uuid.UUID,
+	periodStart, periodEnd, format, sha256 string,
+	sizeBytes int64,
+	entryCount int,
+) StatementGenerated {
+	return StatementGenerated{
+		BaseEvent:   events.NewBaseEvent("statement.generated", statementID.String(), AggregateTypeStatement, tenantID.String()),
+		CustomerID:  customerID,
+		ScheduleID:  scheduleID,
+		PeriodStart: periodStart,
+		PeriodEnd:   periodEnd,
+		Format:      format,
+		SHA256:      sha256,
+		SizeBytes:   sizeBytes,
+		EntryCount:  entryCount,
+	}
+}
+
+// StatementFailed is emitted when a statement could not be rendered or
+// stored.
+type StatementFailed struct {
+	events.BaseEvent
+	PeriodStart string    `json:"period_start"`
+	PeriodEnd   string    `json:"period_end"`
+	Reason      string    `json:"reason"`
+	CustomerID  uuid.UUID `json:"customer_id"`
+	ScheduleID  uuid.UUID `json:"schedule_id,omitempty"`
+}
+
+// NewStatementFailed creates a new StatementFailed event.
+func NewStatementFailed(statementID, tenantID, customerID, scheduleID uuid.UUID, periodStart, periodEnd, reason string) StatementFailed {
+	return StatementFailed{
+		BaseEvent:   events.NewBaseEvent("statement.failed", statementID.String(), AggregateTypeStatement, tenantID.String()),
+		CustomerID:  customerID,
+		ScheduleID:  scheduleID,
+		PeriodStart: periodStart,
+		PeriodEnd:   periodEnd,
+		Reason:      reason,
+	}
+}
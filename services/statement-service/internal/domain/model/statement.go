@@ -0,0 +1,194 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/event"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+)
+
+// MaxPeriodDays bounds the length of a statement period.
+const MaxPeriodDays = 366
+
+// ErrInvalidTransition is returned when a statement is generated or failed
+// more than once.
+var ErrInvalidTransition = errors.New("invalid statement transition")
+
+// Statement is the aggregate root for one customer's statement over a
+// period: the customer's account, card, deposit and loan activity rendered
+// as a PDF or CSV document kept in object storage. A new statement has no
+// status until MarkGenerated or MarkFailed records the outcome of rendering
+// it.
+type Statement struct {
+	periodStart   time.Time
+	periodEnd     time.Time
+	generatedAt   time.Time
+	format        valueobject.StatementFormat
+	status        valueobject.StatementStatus
+	objectKey     string
+	sha256        string
+	failureReason string
+	domainEvents  []events.DomainEvent
+	sizeBytes     int64
+	entryCount    int
+	version       int
+	id            uuid.UUID
+	tenantID      uuid.UUID
+	customerID    uuid.UUID
+	scheduleID    uuid.UUID
+}
+
+// NewStatement creates a statement for the customer's activity from
+// periodStart to periodEnd, both inclusive dates. scheduleID is uuid.Nil for
+// statements requested on demand.
+func NewStatement(
+	tenantID, customerID, scheduleID uuid.UUID,
+	periodStart, periodEnd time.Time,
+	format valueobject.StatementFormat,
+) (Statement, error) {
+	if tenantID == uuid.Nil {
+		return Statement{}, fmt.Errorf("tenant ID must not be nil")
+	}
+	if customerID == uuid.Nil {
+		return Statement{}, fmt.Errorf("customer ID must not be nil")
+	}
+	if format.IsZero() {
+		return Statement{}, fmt.Errorf("statement format must not be empty")
+	}
+	periodStart, periodEnd = truncateDay(periodStart), truncateDay(periodEnd)
+	if periodEnd.Before(periodStart) {
+		return Statement{}, fmt.Errorf("period end must not be before period start")
+	}
+	if periodEnd.Sub(periodStart) >= MaxPeriodDays*24*time.Hour {
+		return Statement{}, fmt.Errorf("period must not exceed %d days", MaxPeriodDays)
+	}
+
+	return Statement{
+		id:          uuid.New(),
+		tenantID:    tenantID,
+		customerID:  customerID,
+		scheduleID:  scheduleID,
+		periodStart: periodStart,
+		periodEnd:   periodEnd,
+		format:      format,
+		version:     1,
+	}, nil
+}
+
+// ReconstructStatement recreates a Statement from persisted data without
+// validation or events.
+func ReconstructStatement(
+	id, tenantID, customerID, scheduleID uuid.UUID,
+	periodStart, periodEnd time.Time,
+	format valueobject.StatementFormat,
+	status valueobject.StatementStatus,
+	objectKey, sha256 string,
+	sizeBytes int64,
+	entryCount int,
+	failureReason string,
+	generatedAt time.Time,
+	version int,
+) Statement {
+	return Statement{
+		id:            id,
+		tenantID:      tenantID,
+		customerID:    customerID,
+		scheduleID:    scheduleID,
+		periodStart:   periodStart,
+		periodEnd:     periodEnd,
+		format:        format,
+		status:        status,
+		objectKey:     objectKey,
+		sha256:        sha256,
+		sizeBytes:     sizeBytes,
+		entryCount:    entryCount,
+		failureReason: failureReason,
+		generatedAt:   generatedAt,
+		version:       version,
+	}
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// MarkGenerated records that the statement's document was stored under
+// objectKey.
+func (s Statement) MarkGenerated(objectKey, sha256 string, sizeBytes int64, entryCount int, now time.Time) (Statement, error) {
+	if !s.status.IsZero() {
+		return s, fmt.Errorf("cannot generate %s statement %s: %w", s.status, s.id, ErrInvalidTransition)
+	}
+	if objectKey == "" {
+		return s, fmt.Errorf("object key must not be empty")
+	}
+	s.status = valueobject.StatementStatusGenerated
+	s.objectKey = objectKey
+	s.sha256 = sha256
+	s.sizeBytes = sizeBytes
+	s.entryCount = entryCount
+	s.generatedAt = now
+	s.version++
+	s.domainEvents = append(s.domainEvents, event.NewStatementGenerated(
+		s.id, s.tenantID, s.customerID, s.scheduleID,
+		s.periodStart.Format(time.DateOnly), s.periodEnd.Format(time.DateOnly), s.format.String(), sha256, sizeBytes, entryCount,
+	))
+	return s, nil
+}
+
+// MarkFailed records that the statement could not be rendered or stored.
+func (s Statement) MarkFailed(reason string, now time.Time) (Statement, error) {
+	if !s.status.IsZero() {
+		return s, fmt.Errorf("cannot fail %s statement %s: %w", s.status, s.id, ErrInvalidTransition)
+	}
+	s.status = valueobject.StatementStatusFailed
+	s.failureReason = reason
+	s.generatedAt = now
+	s.version++
+	s.domainEvents = append(s.domainEvents, event.NewStatementFailed(
+		s.id, s.tenantID, s.customerID, s.scheduleID,
+		s.periodStart.Format(time.DateOnly), s.periodEnd.Format(time.DateOnly), reason,
+	))
+	return s, nil
+}
+
+// FileName returns the name the statement's document is downloaded as.
+func (s Statement) FileName() string {
+	return fmt.Sprintf("statement-%s-%s.%s",
+		s.periodStart.Format(time.DateOnly), s.periodEnd.Format(time.DateOnly), s.format.Extension())
+}
+
+// --- Accessors ---
+
+func (s Statement) ID() uuid.UUID                       { return s.id }
+func (s Statement) TenantID() uuid.UUID                 { return s.tenantID }
+func (s Statement) CustomerID() uuid.UUID               { return s.customerID }
+func (s Statement) ScheduleID() uuid.UUID               { return s.scheduleID }
+func (s Statement) PeriodStart() time.Time              { return s.periodStart }
+func (s Statement) PeriodEnd() time.Time                { return s.periodEnd }
+func (s Statement) Format() valueobject.StatementFormat { return s.format }
+func (s Statement) Status() valueobject.StatementStatus { return s.status }
+func (s Statement) ObjectKey() string                   { return s.objectKey }
+func (s Statement) SHA256() string                      { return s.sha256 }
+func (s Statement) SizeBytes() int64                    { return s.sizeBytes }
+func (s Statement) EntryCount() int                     { return s.entryCount }
+func (s Statement) FailureReason() string               { return s.failureReason }
+func (s Statement) GeneratedAt() time.Time              { return s.generatedAt }
+func (s Statement) Version() int                        { return s.version }
+
+// DomainEvents returns the uncommitted domain events.
+func (s Statement) DomainEvents() []events.DomainEvent {
+	return s.domainEvents
+}
+
+// ClearDomainEvents returns a copy of the statement with no uncommitted
+// events.
+func (s Statement) ClearDomainEvents() Statement {
+	s.domainEvents = nil
+	return s
+}
@@ -0,0 +1,155 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+)
+
+// StatementSchedule is the aggregate root for generating a customer's
+// statements after each monthly or quarterly period. A customer has at most
+// one schedule. Periods that ended before the schedule was created or its
+// frequency last changed are not generated, and when several periods are
+// outstanding only the latest is.
+type StatementSchedule struct {
+	createdAt     time.Time
+	updatedAt     time.Time
+	lastPeriodEnd time.Time
+	frequency     valueobject.StatementFrequency
+	format        valueobject.StatementFormat
+	version       int
+	active        bool
+	id            uuid.UUID
+	tenantID      uuid.UUID
+	customerID    uuid.UUID
+}
+
+// NewStatementSchedule creates an active schedule whose first statement
+// covers the period in progress at now.
+func NewStatementSchedule(
+	tenantID, customerID uuid.UUID,
+	frequency valueobject.StatementFrequency,
+	format valueobject.StatementFormat,
+	now time.Time,
+) (StatementSchedule, error) {
+	if tenantID == uuid.Nil {
+		return StatementSchedule{}, fmt.Errorf("tenant ID must not be nil")
+	}
+	if customerID == uuid.Nil {
+		return StatementSchedule{}, fmt.Errorf("customer ID must not be nil")
+	}
+	if frequency.IsZero() {
+		return StatementSchedule{}, fmt.Errorf("statement frequency must not be empty")
+	}
+	if format.IsZero() {
+		return StatementSchedule{}, fmt.Errorf("statement format must not be empty")
+	}
+	_, lastPeriodEnd := frequency.LastCompletedPeriod(now)
+
+	return StatementSchedule{
+		id:            uuid.New(),
+		tenantID:      tenantID,
+		customerID:    customerID,
+		frequency:     frequency,
+		format:        format,
+		lastPeriodEnd: lastPeriodEnd,
+		active:        true,
+		version:       1,
+		createdAt:     now,
+		updatedAt:     now,
+	}, nil
+}
+
+// ReconstructStatementSchedule recreates a StatementSchedule from persisted
+// data.
+func ReconstructStatementSchedule(
+	id, tenantID, customerID uuid.UUID,
+	frequency valueobject.StatementFrequency,
+	format valueobject.StatementFormat,
+	lastPeriodEnd time.Time,
+	active bool,
+	version int,
+	createdAt, updatedAt time.Time,
+) StatementSchedule {
+	return StatementSchedule{
+		id:            id,
+		tenantID:      tenantID,
+		customerID:    customerID,
+		frequency:     frequency,
+		format:        format,
+		lastPeriodEnd: lastPeriodEnd,
+		active:        active,
+		version:       version,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
+
+// Reconfigure changes the frequency, format and active flag of the schedule.
+// After a change of frequency the next statement covers the new frequency's
+// period in progress at now.
+func (s StatementSchedule) Reconfigure(
+	frequency valueobject.StatementFrequency,
+	format valueobject.StatementFormat,
+	active bool,
+	now time.Time,
+) (StatementSchedule, error) {
+	if frequency.IsZero() {
+		return s, fmt.Errorf("statement frequency must not be empty")
+	}
+	if format.IsZero() {
+		return s, fmt.Errorf("statement format must not be empty")
+	}
+	if !frequency.Equal(s.frequency) {
+		if _, end := frequency.LastCompletedPeriod(now); end.After(s.lastPeriodEnd) {
+			s.lastPeriodEnd = end
+		}
+	}
+	s.frequency = frequency
+	s.format = format
+	s.active = active
+	s.version++
+	s.updatedAt = now
+	return s, nil
+}
+
+// DuePeriod returns the period whose statement is due as of now, as inclusive
+// dates. ok is false while the schedule is inactive and once the latest
+// completed period has been generated.
+func (s StatementSchedule) DuePeriod(now time.Time) (start, end time.Time, ok bool) {
+	if !s.active {
+		return time.Time{}, time.Time{}, false
+	}
+	start, end = s.frequency.LastCompletedPeriod(now)
+	if !end.After(s.lastPeriodEnd) {
+		return time.Time{}, time.Time{}, false
+	}
+	return start, end, true
+}
+
+// MarkGenerated records that the statement for the period ending periodEnd
+// was generated.
+func (s StatementSchedule) MarkGenerated(periodEnd, now time.Time) StatementSchedule {
+	if periodEnd.After(s.lastPeriodEnd) {
+		s.lastPeriodEnd = periodEnd
+	}
+	s.version++
+	s.updatedAt = now
+	return s
+}
+
+// --- Accessors ---
+
+func (s StatementSchedule) ID() uuid.UUID                             { return s.id }
+func (s StatementSchedule) TenantID() uuid.UUID                       { return s.tenantID }
+func (s StatementSchedule) CustomerID() uuid.UUID                     { return s.customerID }
+func (s StatementSchedule) Frequency() valueobject.StatementFrequency { return s.frequency }
+func (s StatementSchedule) Format() valueobject.StatementFormat       { return s.format }
+func (s StatementSchedule) LastPeriodEnd() time.Time                  { return s.lastPeriodEnd }
+func (s StatementSchedule) Active() bool                              { return s.active }
+func (s StatementSchedule) Version() int                              { return s.version }
+func (s StatementSchedule) CreatedAt() time.Time                      { return s.createdAt }
+func (s StatementSchedule) UpdatedAt() time.Time                      { return s.updatedAt }
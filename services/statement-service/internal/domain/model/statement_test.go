@@ -0,0 +1,107 @@
+package model_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/statement-service/internal/domain/model"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+)
+
+func TestNewStatement_Validation(t *testing.T) {
+	tenantID, customerID := uuid.New(), uuid.New()
+	start := time.Date(2026, 1, 1, 15, 30, 0, 0, time.UTC)
+	end := time.Date(2026, 1, 31, 23, 59, 0, 0, time.UTC)
+
+	s, err := model.NewStatement(tenantID, customerID, uuid.Nil, start, end, valueobject.StatementFormatPDF)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), s.PeriodStart())
+	assert.Equal(t, time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC), s.PeriodEnd())
+	assert.True(t, s.Status().IsZero())
+	assert.Equal(t, "statement-2026-01-01-2026-01-31.pdf", s.FileName())
+
+	_, err = model.NewStatement(tenantID, customerID, uuid.Nil, end, start, valueobject.StatementFormatPDF)
+	assert.Error(t, err)
+	_, err = model.NewStatement(tenantID, customerID, uuid.Nil, start, start.AddDate(1, 1, 0), valueobject.StatementFormatPDF)
+	assert.Error(t, err)
+	_, err = model.NewStatement(tenantID, uuid.Nil, uuid.Nil, start, end, valueobject.StatementFormatPDF)
+	assert.Error(t, err)
+	_, err = model.NewStatement(tenantID, customerID, uuid.Nil, start, end, valueobject.StatementFormat{})
+	assert.Error(t, err)
+}
+
+func TestStatement_Transitions(t *testing.T) {
+	now := time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)
+	s, err := model.NewStatement(uuid.New(), uuid.New(), uuid.Nil,
+		time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+		valueobject.StatementFormatCSV)
+	require.NoError(t, err)
+
+	generated, err := s.MarkGenerated("statements/key.csv", "abc", 120, 3, now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.StatementStatusGenerated, generated.Status())
+	assert.Equal(t, 2, generated.Version())
+	require.Len(t, generated.DomainEvents(), 1)
+	assert.Equal(t, "statement.generated", generated.DomainEvents()[0].EventType())
+
+	_, err = generated.MarkFailed("boom", now)
+	assert.True(t, errors.Is(err, model.ErrInvalidTransition))
+
+	failed, err := s.MarkFailed("boom", now)
+	require.NoError(t, err)
+	assert.Equal(t, "boom", failed.FailureReason())
+	assert.Equal(t, "statement.failed", failed.DomainEvents()[0].EventType())
+
+	_, err = failed.MarkGenerated("statements/key.csv", "abc", 120, 3, now)
+	assert.True(t, errors.Is(err, model.ErrInvalidTransition))
+}
+
+func TestStatementSchedule_DuePeriod(t *testing.T) {
+	created := time.Date(2026, 1, 20, 10, 0, 0, 0, time.UTC)
+	s, err := model.NewStatementSchedule(uuid.New(), uuid.New(),
+		valueobject.StatementFrequencyMonthly, valueobject.StatementFormatPDF, created)
+	require.NoError(t, err)
+
+	// The first statement covers the month the schedule was created in.
+	_, _, ok := s.DuePeriod(created)
+	assert.False(t, ok)
+	start, end, ok := s.DuePeriod(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC), end)
+
+	s = s.MarkGenerated(end, created)
+	_, _, ok = s.DuePeriod(time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+
+	paused, err := s.Reconfigure(valueobject.StatementFrequencyMonthly, valueobject.StatementFormatPDF, false, created)
+	require.NoError(t, err)
+	_, _, ok = paused.DuePeriod(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestStatementSchedule_ChangingFrequencySkipsCoveredPeriods(t *testing.T) {
+	created := time.Date(2026, 1, 20, 10, 0, 0, 0, time.UTC)
+	s, err := model.NewStatementSchedule(uuid.New(), uuid.New(),
+		valueobject.StatementFrequencyMonthly, valueobject.StatementFormatPDF, created)
+	require.NoError(t, err)
+
+	// Switching to quarterly in May starts from the quarter in progress,
+	// April to June.
+	s = s.MarkGenerated(time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC), created)
+	s, err = s.Reconfigure(valueobject.StatementFrequencyQuarterly, valueobject.StatementFormatCSV, true,
+		time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	_, _, ok := s.DuePeriod(time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+	start, end, ok := s.DuePeriod(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC), end)
+}
@@ -0,0 +1,111 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/statement-service/internal/domain/event"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/model"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/service"
+)
+
+// ErrStatementNotFound is returned when a statement does not exist.
+var ErrStatementNotFound = errors.New("statement not found")
+
+// ErrScheduleNotFound is returned when a statement schedule does not exist.
+var ErrScheduleNotFound = errors.New("statement schedule not found")
+
+// ErrProjectionNotFound is returned when the activity read model has no
+// record of a payment or loan.
+var ErrProjectionNotFound = errors.New("projection not found")
+
+// ErrVersionConflict is returned when a schedule was modified concurrently.
+var ErrVersionConflict = errors.New("modified concurrently")
+
+// StatementRepository defines the persistence port for statements.
+type StatementRepository interface {
+	// Save persists a new statement.
+	Save(ctx context.Context, statement model.Statement) error
+
+	// FindByID retrieves one of a tenant's statements.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Statement, error)
+
+	// ListByCustomer returns a customer's statements, latest period first,
+	// and the total number of them.
+	ListByCustomer(ctx context.Context, tenantID, customerID uuid.UUID, limit, offset int) ([]model.Statement, int, error)
+}
+
+// ScheduleRepository defines the persistence port for statement schedules.
+type ScheduleRepository interface {
+	// Save persists the schedule, failing with ErrVersionConflict if it was
+	// modified since it was loaded.
+	Save(ctx context.Context, schedule model.StatementSchedule) error
+
+	// FindByCustomer retrieves a customer's schedule.
+	FindByCustomer(ctx context.Context, tenantID, customerID uuid.UUID) (model.StatementSchedule, error)
+
+	// List returns a tenant's schedules, or one customer's when customerID
+	// is set, and the total number of them.
+	List(ctx context.Context, tenantID, customerID uuid.UUID, limit, offset int) ([]model.StatementSchedule, int, error)
+
+	// ListActive returns the active schedules of every tenant.
+	ListActive(ctx context.Context) ([]model.StatementSchedule, error)
+}
+
+// ActivityReadModel defines the port for the activity statements are built
+// from, projected from account, payment, card, deposit and lending events.
+type ActivityReadModel interface {
+	// SaveAccount persists a new or updated customer account.
+	SaveAccount(ctx context.Context, account service.CustomerAccount) error
+	// SetLedgerAccountCode records the ledger account code of an account.
+	// Accounts not in the read model are left alone.
+	SetLedgerAccountCode(ctx context.Context, tenantID, accountID uuid.UUID, code string) error
+	// ListCustomerAccounts retrieves a customer's accounts.
+	ListCustomerAccounts(ctx context.Context, tenantID, customerID uuid.UUID) ([]service.CustomerAccount, error)
+
+	// FindPayment retrieves a payment. It returns ErrProjectionNotFound when there is none.
+	FindPayment(ctx context.Context, id uuid.UUID) (service.PaymentFact, error)
+	// SavePayment persists a new or updated payment.
+	SavePayment(ctx context.Context, payment service.PaymentFact) error
+
+	// FindLoan retrieves a loan. It returns ErrProjectionNotFound when there is none.
+	FindLoan(ctx context.Context, id string) (service.LoanFact, error)
+	// SaveLoan persists a new or updated loan.
+	SaveLoan(ctx context.Context, loan service.LoanFact) error
+
+	// RecordActivity persists activity lines, skipping lines already
+	// recorded for the same event and account.
+	RecordActivity(ctx context.Context, activity ...service.Activity) error
+	// ListActivity retrieves the activity of the accounts that occurred
+	// within [from, to), oldest first.
+	ListActivity(ctx context.Context, tenantID uuid.UUID, accountIDs []uuid.UUID, from, to time.Time) ([]service.Activity, error)
+}
+
+// LedgerPostings defines the port for the ledger postings statements are
+// built from.
+type LedgerPostings interface {
+	// ListPostings retrieves the postings the tenant's ledger made to the
+	// account code with effective dates within [from, to), oldest first.
+	ListPostings(ctx context.Context, tenantID uuid.UUID, accountCode string, from, to time.Time) ([]service.LedgerPosting, error)
+}
+
+// ErrObjectNotFound is returned when object storage has no object under a key.
+var ErrObjectNotFound = errors.New("object not found")
+
+// ObjectStore defines the port for the object storage statement documents
+// are kept in.
+type ObjectStore interface {
+	// Put writes data under key, replacing any object already there.
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get reads the object under key. It returns ErrObjectNotFound when
+	// there is none.
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// EventPublisher defines the port for publishing domain events.
+type EventPublisher interface {
+	Publish(ctx context.Context, events []event.DomainEvent) error
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+)
+
+// CustomerAccount is the activity read model's view of a customer account:
+// the account a customer's statements list activity for. LedgerAccountCode
+// is empty for accounts projected before their events carried it.
+type CustomerAccount struct {
+	OpenedAt          time.Time
+	AccountNumber     string
+	AccountType       string
+	Currency          string
+	LedgerAccountCode string
+	ID                uuid.UUID
+	TenantID          uuid.UUID
+	CustomerID        uuid.UUID
+}
+
+// Activity is one line of activity on an account. Amount is signed: credits
+// to the account are positive and debits negative. An event produces at most
+// one line per account, so EventID and AccountID identify a line. Category
+// and Logo are the enriched merchant's or counterparty's; Logo is empty when
+// the brand is unknown.
+type Activity struct {
+	OccurredAt  time.Time
+	Source      valueobject.ActivitySource
+	EventID     string
+	Description string
+	Category    string
+	Logo        string
+	Reference   string
+	Currency    string
+	Amount      decimal.Decimal
+	AccountID   uuid.UUID
+	TenantID    uuid.UUID
+}
+
+// PaymentFact is the activity read model's view of a payment order. A
+// payment appears on statements once it settles; DestinationAccountID is
+// uuid.Nil for payments leaving the bank.
+type PaymentFact struct {
+	InitiatedAt          time.Time
+	SettledAt            *time.Time
+	Currency             string
+	Description          string
+	Rail                 string
+	Amount               decimal.Decimal
+	ID                   uuid.UUID
+	TenantID             uuid.UUID
+	SourceAccountID      uuid.UUID
+	DestinationAccountID uuid.UUID
+}
+
+// LoanFact links a disbursed loan to the account it was paid into and is
+// repaid from.
+type LoanFact struct {
+	ID        string
+	Currency  string
+	TenantID  uuid.UUID
+	AccountID uuid.UUID
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+)
+
+// LedgerPosting is one leg a posted journal entry made to an account's
+// ledger account. Amount is positive; Credit reports the leg's side. Leg is
+// the leg's position in the entry, so EntryID and Leg identify a posting.
+type LedgerPosting struct {
+	EffectiveDate time.Time
+	EntryID       string
+	Description   string
+	Reference     string
+	Currency      string
+	Amount        decimal.Decimal
+	Leg           int
+	Credit        bool
+}
+
+// PostingActivity turns the postings to an account's ledger account into the
+// account's activity lines. Customer accounts are liabilities of the bank, so
+// credits raise the customer's balance and are positive, and debits negative.
+func PostingActivity(account CustomerAccount, postings []LedgerPosting) []Activity {
+	activity := make([]Activity, 0, len(postings))
+	for _, p := range postings {
+		amount := p.Amount
+		if !p.Credit {
+			amount = amount.Neg()
+		}
+		activity = append(activity, Activity{
+			EventID:     fmt.Sprintf("ledger/%s/%d", p.EntryID, p.Leg),
+			AccountID:   account.ID,
+			TenantID:    account.TenantID,
+			OccurredAt:  p.EffectiveDate,
+			Source:      valueobject.ActivitySourceLedger,
+			Description: p.Description,
+			Reference:   p.Reference,
+			Amount:      amount,
+			Currency:    p.Currency,
+		})
+	}
+	return activity
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// StatementDocument is the content of a statement: each of the customer's
+// accounts with its activity over the period and the totals of that
+// activity.
+type StatementDocument struct {
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	GeneratedAt time.Time
+	Accounts    []AccountSection
+	StatementID uuid.UUID
+	TenantID    uuid.UUID
+	CustomerID  uuid.UUID
+}
+
+// AccountSection is one account's part of a statement.
+type AccountSection struct {
+	Activity []Activity
+	Totals   []CurrencyTotal
+	Account  CustomerAccount
+}
+
+// CurrencyTotal sums an account's activity in one currency. Debits is
+// positive.
+type CurrencyTotal struct {
+	Currency string
+	Credits  decimal.Decimal
+	Debits   decimal.Decimal
+	Net      decimal.Decimal
+}
+
+// BuildStatementDocument groups activity under the accounts it belongs to,
+// ordering accounts by number and each account's activity by time, and totals
+// each account's activity per currency. Activity on other accounts is
+// ignored.
+func BuildStatementDocument(doc StatementDocument, accounts []CustomerAccount, activity []Activity) StatementDocument {
+	sorted := append([]CustomerAccount(nil), accounts...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].AccountNumber < sorted[j].AccountNumber })
+
+	byAccount := make(map[uuid.UUID][]Activity, len(sorted))
+	for _, a := range activity {
+		byAccount[a.AccountID] = append(byAccount[a.AccountID], a)
+	}
+
+	doc.Accounts = make([]AccountSection, 0, len(sorted))
+	for _, account := range sorted {
+		lines := byAccount[account.ID]
+		sort.SliceStable(lines, func(i, j int) bool { return lines[i].OccurredAt.Before(lines[j].OccurredAt) })
+		doc.Accounts = append(doc.Accounts, AccountSection{
+			Account:  account,
+			Activity: lines,
+			Totals:   totals(lines),
+		})
+	}
+	return doc
+}
+
+func totals(lines []Activity) []CurrencyTotal {
+	var out []CurrencyTotal
+	index := map[string]int{}
+	for _, l := range lines {
+		i, ok := index[l.Currency]
+		if !ok {
+			i = len(out)
+			index[l.Currency] = i
+			out = append(out, CurrencyTotal{Currency: l.Currency})
+		}
+		if l.Amount.IsNegative() {
+			out[i].Debits = out[i].Debits.Sub(l.Amount)
+		} else {
+			out[i].Credits = out[i].Credits.Add(l.Amount)
+		}
+		out[i].Net = out[i].Net.Add(l.Amount)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Currency < out[j].Currency })
+	return out
+}
+
+// EntryCount returns the number of activity lines on the statement.
+func (d StatementDocument) EntryCount() int {
+	n := 0
+	for _, s := range d.Accounts {
+		n += len(s.Activity)
+	}
+	return n
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+)
+
+// RenderStatement renders the statement document in the given format.
+func RenderStatement(format valueobject.StatementFormat, doc StatementDocument) ([]byte, error) {
+	switch {
+	case format.Equal(valueobject.StatementFormatCSV):
+		return renderCSV(doc)
+	case format.Equal(valueobject.StatementFormatPDF):
+		return renderPDF(doc), nil
+	default:
+		return nil, fmt.Errorf("unsupported statement format %q", format)
+	}
+}
+
+// renderCSV writes one row per activity line.
+func renderCSV(doc StatementDocument) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	rows := [][]string{{"account_number", "occurred_at", "source", "description", "category", "reference", "amount", "currency"}}
+	for _, s := range doc.Accounts {
+		for _, a := range s.Activity {
+			rows = append(rows, []string{
+				s.Account.AccountNumber,
+				a.OccurredAt.UTC().Format(time.RFC3339),
+				a.Source.String(),
+				a.Description,
+				a.Category,
+				a.Reference,
+				a.Amount.StringFixed(2),
+				a.Currency,
+			})
+		}
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return nil, fmt.Errorf("write statement csv: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+const (
+	pdfTop        = 780
+	pdfBottom     = 60
+	pdfLineHeight = 16
+	// pdfDescriptionWidth is the number of characters of a description that
+	// fit in its column.
+	pdfDescriptionWidth = 48
+)
+
+// pdfWriter lays text out top to bottom on A4 pages, starting a new page
+// when one is full.
+type pdfWriter struct {
+	pages []*strings.Builder
+	y     int
+}
+
+func (w *pdfWriter) newPage() {
+	w.pages = append(w.pages, &strings.Builder{})
+	w.y = pdfTop
+}
+
+// line reserves a line of the given height, starting a new page when it
+// would not fit.
+func (w *pdfWriter) line(height int) int {
+	if len(w.pages) == 0 || w.y-height < pdfBottom {
+		w.newPage()
+	}
+	y := w.y
+	w.y -= height
+	return y
+}
+
+func (w *pdfWriter) text(font string, size, x, y int, s string) {
+	page := w.pages[len(w.pages)-1]
+	page.WriteString(fmt.Sprintf("BT /%s %d Tf %d %d Td (%s) Tj ET\n", font, size, x, y, escapePDF(s)))
+}
+
+// renderPDF lays the statement out as an A4 PDF using the standard Helvetica
+// fonts, which every PDF reader provides.
+func renderPDF(doc StatementDocument) []byte {
+	w := &pdfWriter{}
+	y := w.line(22)
+	w.text("F2", 18, 56, y, "Account statement")
+	y = w.line(pdfLineHeight)
+	w.text("F1", 10, 56, y, fmt.Sprintf("Period %s to %s",
+		doc.PeriodStart.Format(time.DateOnly), doc.PeriodEnd.Format(time.DateOnly)))
+	y = w.line(pdfLineHeight)
+	w.text("F1", 10, 56, y, fmt.Sprintf("Customer %s", doc.CustomerID))
+	y = w.line(pdfLineHeight * 2)
+	w.text("F1", 10, 56, y, fmt.Sprintf("Statement %s generated %s", doc.StatementID, doc.GeneratedAt.UTC().Format(time.RFC3339)))
+
+	if len(doc.Accounts) == 0 {
+		y = w.line(pdfLineHeight)
+		w.text("F1", 11, 56, y, "No accounts.")
+	}
+	for _, s := range doc.Accounts {
+		w.line(pdfLineHeight)
+		y = w.line(pdfLineHeight + 4)
+		w.text("F2", 12, 56, y, fmt.Sprintf("Account %s  %s %s",
+			s.Account.AccountNumber, s.Account.AccountType, s.Account.Currency))
+
+		if len(s.Activity) == 0 {
+			y = w.line(pdfLineHeight)
+			w.text("F1", 10, 56, y, "No activity in this period.")
+			continue
+		}
+		y = w.line(pdfLineHeight)
+		w.text("F2", 10, 56, y, "Date")
+		w.text("F2", 10, 130, y, "Description")
+		w.text("F2", 10, 400, y, "Source")
+		w.text("F2", 10, 470, y, "Amount")
+		for _, a := range s.Activity {
+			y = w.line(pdfLineHeight)
+			w.text("F1", 10, 56, y, a.OccurredAt.UTC().Format(time.DateOnly))
+			w.text("F1", 10, 130, y, truncate(a.Description, pdfDescriptionWidth))
+			w.text("F1", 10, 400, y, a.Source.String())
+			w.text("F1", 10, 470, y, fmt.Sprintf("%s %s", a.Amount.StringFixed(2), a.Currency))
+		}
+		for _, t := range s.Totals {
+			y = w.line(pdfLineHeight)
+			w.text("F2", 10, 130, y, fmt.Sprintf("%s  credits %s  debits %s  net %s",
+				t.Currency, t.Credits.StringFixed(2), t.Debits.StringFixed(2), t.Net.StringFixed(2)))
+		}
+	}
+
+	for i, page := range w.pages {
+		page.WriteString(fmt.Sprintf("BT /F1 8 Tf 500 30 Td (%s) Tj ET\n",
+			escapePDF(fmt.Sprintf("Page %d of %d", i+1, len(w.pages)))))
+	}
+	return assemblePDF(w.pages)
+}
+
+// assemblePDF writes the pages' content streams out as a PDF document.
+func assemblePDF(pages []*strings.Builder) []byte {
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold >>",
+	}
+	for i, page := range pages {
+		stream := page.String()
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] /Contents %d 0 R /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> >>", 6+2*i),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(stream), stream),
+		)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		buf.WriteString(fmt.Sprintf("%d 0 obj\n%s\nendobj\n", i+1, obj))
+	}
+	xref := buf.Len()
+	buf.WriteString(fmt.Sprintf("xref\n0 %d\n0000000000 65535 f \n", len(objects)+1))
+	for _, off := range offsets {
+		buf.WriteString(fmt.Sprintf("%010d 00000 n \n", off))
+	}
+	buf.WriteString(fmt.Sprintf("trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref))
+	return buf.Bytes()
+}
+
+// escapePDF escapes the characters that delimit PDF string literals.
+func escapePDF(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`).Replace(s)
+}
+
+// truncate shortens s to at most n runes, marking the cut with "...".
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-3]) + "..."
+}
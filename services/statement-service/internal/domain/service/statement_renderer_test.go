@@ -0,0 +1,92 @@
+package service_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/statement-service/internal/domain/service"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+)
+
+func sampleDocument(lines int) service.StatementDocument {
+	current := service.CustomerAccount{ID: uuid.New(), AccountNumber: "BIB-0002", AccountType: "CURRENT", Currency: "EUR"}
+	savings := service.CustomerAccount{ID: uuid.New(), AccountNumber: "BIB-0001", AccountType: "SAVINGS", Currency: "EUR"}
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var activity []service.Activity
+	for i := range lines {
+		amount := decimal.NewFromInt(int64(10 * (i + 1)))
+		if i%2 == 1 {
+			amount = amount.Neg()
+		}
+		activity = append(activity, service.Activity{
+			AccountID:   current.ID,
+			OccurredAt:  start.Add(time.Duration(lines-i) * time.Hour),
+			Source:      valueobject.ActivitySourceCard,
+			Description: fmt.Sprintf("Purchase %d (online)", i),
+			Category:    "SHOPPING",
+			Amount:      amount,
+			Currency:    "EUR",
+		})
+	}
+	// Activity on another customer's account is left out.
+	activity = append(activity, service.Activity{AccountID: uuid.New(), OccurredAt: start, Amount: decimal.NewFromInt(1), Currency: "EUR"})
+
+	return service.BuildStatementDocument(service.StatementDocument{
+		StatementID: uuid.New(),
+		CustomerID:  uuid.New(),
+		PeriodStart: start,
+		PeriodEnd:   time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+		GeneratedAt: time.Date(2026, 2, 1, 6, 0, 0, 0, time.UTC),
+	}, []service.CustomerAccount{current, savings}, activity)
+}
+
+func TestBuildStatementDocument(t *testing.T) {
+	doc := sampleDocument(3)
+
+	require.Len(t, doc.Accounts, 2)
+	assert.Equal(t, "BIB-0001", doc.Accounts[0].Account.AccountNumber)
+	assert.Empty(t, doc.Accounts[0].Activity)
+	assert.Equal(t, 3, doc.EntryCount())
+
+	current := doc.Accounts[1]
+	assert.Equal(t, "Purchase 2 (online)", current.Activity[0].Description, "activity is ordered by time")
+	require.Len(t, current.Totals, 1)
+	assert.Equal(t, "40", current.Totals[0].Credits.String())
+	assert.Equal(t, "20", current.Totals[0].Debits.String())
+	assert.Equal(t, "20", current.Totals[0].Net.String())
+}
+
+func TestRenderStatement_CSV(t *testing.T) {
+	out, err := service.RenderStatement(valueobject.StatementFormatCSV, sampleDocument(2))
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(bytes.NewReader(out)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, []string{"account_number", "occurred_at", "source", "description", "category", "reference", "amount", "currency"}, records[0])
+	assert.Equal(t, []string{"BIB-0002", "2026-01-01T01:00:00Z", "CARD", "Purchase 1 (online)", "SHOPPING", "", "-20.00", "EUR"}, records[1])
+}
+
+func TestRenderStatement_PDFPaginates(t *testing.T) {
+	out, err := service.RenderStatement(valueobject.StatementFormatPDF, sampleDocument(100))
+	require.NoError(t, err)
+
+	pdf := string(out)
+	assert.True(t, strings.HasPrefix(pdf, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(pdf, "%%EOF\n"))
+	assert.Contains(t, pdf, `Purchase 0 \(online\)`)
+	pages := strings.Count(pdf, "/Type /Page ")
+	assert.Greater(t, pages, 1)
+	assert.Contains(t, pdf, fmt.Sprintf("Page %d of %d", pages, pages))
+	assert.Contains(t, pdf, fmt.Sprintf("/Count %d", pages))
+}
@@ -0,0 +1,60 @@
+package valueobject
+
+import "fmt"
+
+// ActivitySource is the product an activity line on a statement comes from.
+// It is an immutable value object.
+type ActivitySource struct {
+	value string
+}
+
+const (
+	activitySourceAccount = "ACCOUNT"
+	activitySourceCard    = "CARD"
+	activitySourceDeposit = "DEPOSIT"
+	activitySourceLoan    = "LOAN"
+	activitySourceLedger  = "LEDGER"
+)
+
+var (
+	ActivitySourceAccount = ActivitySource{value: activitySourceAccount}
+	ActivitySourceCard    = ActivitySource{value: activitySourceCard}
+	ActivitySourceDeposit = ActivitySource{value: activitySourceDeposit}
+	ActivitySourceLoan    = ActivitySource{value: activitySourceLoan}
+	// ActivitySourceLedger marks lines read from the ledger's postings
+	// rather than projected from product events.
+	ActivitySourceLedger = ActivitySource{value: activitySourceLedger}
+)
+
+var validActivitySources = map[string]ActivitySource{
+	activitySourceAccount: ActivitySourceAccount,
+	activitySourceCard:    ActivitySourceCard,
+	activitySourceDeposit: ActivitySourceDeposit,
+	activitySourceLoan:    ActivitySourceLoan,
+	activitySourceLedger:  ActivitySourceLedger,
+}
+
+// NewActivitySource creates an ActivitySource from a string, validating it is
+// known.
+func NewActivitySource(s string) (ActivitySource, error) {
+	v, ok := validActivitySources[s]
+	if !ok {
+		return ActivitySource{}, fmt.Errorf("invalid activity source: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the ActivitySource.
+func (v ActivitySource) String() string {
+	return v.value
+}
+
+// IsZero returns true if the ActivitySource has not been set.
+func (v ActivitySource) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two ActivitySource values are equal.
+func (v ActivitySource) Equal(other ActivitySource) bool {
+	return v.value == other.value
+}
@@ -0,0 +1,65 @@
+package valueobject
+
+import "fmt"
+
+// StatementFormat is the document format a statement is rendered in.
+// It is an immutable value object.
+type StatementFormat struct {
+	value string
+}
+
+const (
+	statementFormatPDF = "PDF"
+	statementFormatCSV = "CSV"
+)
+
+var (
+	StatementFormatPDF = StatementFormat{value: statementFormatPDF}
+	StatementFormatCSV = StatementFormat{value: statementFormatCSV}
+)
+
+var validStatementFormats = map[string]StatementFormat{
+	statementFormatPDF: StatementFormatPDF,
+	statementFormatCSV: StatementFormatCSV,
+}
+
+// NewStatementFormat creates a StatementFormat from a string, validating it is
+// known.
+func NewStatementFormat(s string) (StatementFormat, error) {
+	v, ok := validStatementFormats[s]
+	if !ok {
+		return StatementFormat{}, fmt.Errorf("invalid statement format: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the StatementFormat.
+func (v StatementFormat) String() string {
+	return v.value
+}
+
+// IsZero returns true if the StatementFormat has not been set.
+func (v StatementFormat) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two StatementFormat values are equal.
+func (v StatementFormat) Equal(other StatementFormat) bool {
+	return v.value == other.value
+}
+
+// ContentType returns the MIME type of documents in this format.
+func (v StatementFormat) ContentType() string {
+	if v.value == statementFormatCSV {
+		return "text/csv"
+	}
+	return "application/pdf"
+}
+
+// Extension returns the file extension of documents in this format.
+func (v StatementFormat) Extension() string {
+	if v.value == statementFormatCSV {
+		return "csv"
+	}
+	return "pdf"
+}
@@ -0,0 +1,65 @@
+package valueobject
+
+import (
+	"fmt"
+	"time"
+)
+
+// StatementFrequency is how often a statement schedule produces a statement.
+// It is an immutable value object.
+type StatementFrequency struct {
+	value string
+}
+
+const (
+	frequencyMonthly   = "MONTHLY"
+	frequencyQuarterly = "QUARTERLY"
+)
+
+var (
+	StatementFrequencyMonthly   = StatementFrequency{value: frequencyMonthly}
+	StatementFrequencyQuarterly = StatementFrequency{value: frequencyQuarterly}
+)
+
+var validStatementFrequencies = map[string]StatementFrequency{
+	frequencyMonthly:   StatementFrequencyMonthly,
+	frequencyQuarterly: StatementFrequencyQuarterly,
+}
+
+// NewStatementFrequency creates a StatementFrequency from a string, validating
+// it is a known frequency.
+func NewStatementFrequency(s string) (StatementFrequency, error) {
+	f, ok := validStatementFrequencies[s]
+	if !ok {
+		return StatementFrequency{}, fmt.Errorf("invalid statement frequency: %q", s)
+	}
+	return f, nil
+}
+
+// LastCompletedPeriod returns the first and last day of the most recent
+// statement period that ended before now, as UTC midnights.
+func (f StatementFrequency) LastCompletedPeriod(now time.Time) (start, end time.Time) {
+	now = now.UTC()
+	periodStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	months := 1
+	if f.value == frequencyQuarterly {
+		periodStart = time.Date(now.Year(), ((now.Month()-1)/3)*3+1, 1, 0, 0, 0, 0, time.UTC)
+		months = 3
+	}
+	return periodStart.AddDate(0, -months, 0), periodStart.AddDate(0, 0, -1)
+}
+
+// String returns the string representation of the StatementFrequency.
+func (f StatementFrequency) String() string {
+	return f.value
+}
+
+// IsZero returns true if the StatementFrequency has not been set.
+func (f StatementFrequency) IsZero() bool {
+	return f.value == ""
+}
+
+// Equal returns true if two StatementFrequency values are equal.
+func (f StatementFrequency) Equal(other StatementFrequency) bool {
+	return f.value == other.value
+}
@@ -0,0 +1,54 @@
+package valueobject_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+)
+
+func day(s string) time.Time {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestStatementFrequency_LastCompletedPeriod(t *testing.T) {
+	tests := []struct {
+		frequency valueobject.StatementFrequency
+		now       string
+		start     string
+		end       string
+	}{
+		{valueobject.StatementFrequencyMonthly, "2026-03-10T10:00:00Z", "2026-02-01", "2026-02-28"},
+		{valueobject.StatementFrequencyMonthly, "2026-03-01T00:00:00Z", "2026-02-01", "2026-02-28"},
+		{valueobject.StatementFrequencyMonthly, "2026-01-15T00:00:00Z", "2025-12-01", "2025-12-31"},
+		{valueobject.StatementFrequencyQuarterly, "2026-05-20T00:00:00Z", "2026-01-01", "2026-03-31"},
+		{valueobject.StatementFrequencyQuarterly, "2026-01-02T00:00:00Z", "2025-10-01", "2025-12-31"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.frequency.String()+" at "+tt.now, func(t *testing.T) {
+			now, err := time.Parse(time.RFC3339, tt.now)
+			require.NoError(t, err)
+			start, end := tt.frequency.LastCompletedPeriod(now)
+			assert.Equal(t, day(tt.start), start)
+			assert.Equal(t, day(tt.end), end)
+		})
+	}
+}
+
+func TestNewStatementFormat(t *testing.T) {
+	f, err := valueobject.NewStatementFormat("CSV")
+	require.NoError(t, err)
+	assert.Equal(t, "text/csv", f.ContentType())
+	assert.Equal(t, "csv", f.Extension())
+	assert.Equal(t, "application/pdf", valueobject.StatementFormatPDF.ContentType())
+
+	_, err = valueobject.NewStatementFormat("XLSX")
+	assert.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package valueobject
+
+import "fmt"
+
+// StatementStatus is the outcome of generating a statement: GENERATED once
+// its document is stored, or FAILED when rendering or storing it failed.
+// It is an immutable value object.
+type StatementStatus struct {
+	value string
+}
+
+const (
+	statementStatusGenerated = "GENERATED"
+	statementStatusFailed    = "FAILED"
+)
+
+var (
+	StatementStatusGenerated = StatementStatus{value: statementStatusGenerated}
+	StatementStatusFailed    = StatementStatus{value: statementStatusFailed}
+)
+
+var validStatementStatuses = map[string]StatementStatus{
+	statementStatusGenerated: StatementStatusGenerated,
+	statementStatusFailed:    StatementStatusFailed,
+}
+
+// NewStatementStatus creates a StatementStatus from a string, validating it
+// is known.
+func NewStatementStatus(s string) (StatementStatus, error) {
+	v, ok := validStatementStatuses[s]
+	if !ok {
+		return StatementStatus{}, fmt.Errorf("invalid statement status: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the StatementStatus.
+func (v StatementStatus) String() string {
+	return v.value
+}
+
+// IsZero returns true if the StatementStatus has not been set.
+func (v StatementStatus) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two StatementStatus values are equal.
+func (v StatementStatus) Equal(other StatementStatus) bool {
+	return v.value == other.value
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	ledgerv1 "github.com/bibbank/bib/api/gen/go/bib/ledger/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/port"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/service"
+)
+
+// Compile-time interface check.
+var _ port.LedgerPostings = (*LedgerGRPCClient)(nil)
+
+// LedgerClientConfig holds configuration for the ledger gRPC client.
+type LedgerClientConfig struct {
+	// PageSize is the number of journal entries requested per page.
+	PageSize int32
+	// Timeout bounds each call to the ledger service when it is positive.
+	Timeout time.Duration
+}
+
+// LedgerGRPCClient implements the LedgerPostings port against the ledger
+// service's journal. Statements are generated for customers, who may not
+// read the journal, and by the schedule worker, which has no caller, so each
+// call carries a short-lived auditor token for the statement's tenant. Without
+// a signer the caller's bearer token is forwarded instead.
+type LedgerGRPCClient struct {
+	client ledgerv1.LedgerServiceClient
+	signer *auth.JWTService
+	config LedgerClientConfig
+}
+
+// NewLedgerGRPCClient creates a new LedgerGRPCClient. signer may be nil.
+func NewLedgerGRPCClient(conn grpc.ClientConnInterface, signer *auth.JWTService, config LedgerClientConfig) *LedgerGRPCClient {
+	return &LedgerGRPCClient{client: ledgerv1.NewLedgerServiceClient(conn), signer: signer, config: config}
+}
+
+// ListPostings pages through the journal entries that touch the account code
+// and returns their legs on it. Pending entries have not hit the account and
+// are skipped; a reversed entry is listed with the entry that reverses it.
+func (c *LedgerGRPCClient) ListPostings(ctx context.Context, tenantID uuid.UUID, accountCode string, from, to time.Time) ([]service.LedgerPosting, error) {
+	ctx, err := c.authorize(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	req := &ledgerv1.ListJournalEntriesRequest{
+		TenantId:    tenantID.String(),
+		AccountCode: accountCode,
+		FromDate:    timestamppb.New(from),
+		// The ledger's range is inclusive; the journal keeps microseconds.
+		ToDate:     timestamppb.New(to.Add(-time.Microsecond)),
+		Pagination: &commonv1.Pagination{PageSize: c.config.PageSize},
+	}
+
+	var postings []service.LedgerPosting
+	for {
+		resp, err := c.listPage(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("list journal entries of %s: %w", accountCode, err)
+		}
+		for _, e := range resp.GetEntries() {
+			if e.GetStatus() == ledgerv1.EntryStatus_ENTRY_STATUS_PENDING {
+				continue
+			}
+			for i, leg := range e.GetLegs() {
+				if leg.GetAccountCode() != accountCode {
+					continue
+				}
+				amount, err := decimal.NewFromString(leg.GetAmount().GetAmount())
+				if err != nil {
+					return nil, fmt.Errorf("journal entry %s has invalid amount %q: %w", e.GetId(), leg.GetAmount().GetAmount(), err)
+				}
+				description := leg.GetDescription()
+				if description == "" {
+					description = e.GetDescription()
+				}
+				postings = append(postings, service.LedgerPosting{
+					EntryID:       e.GetId(),
+					Leg:           i,
+					EffectiveDate: e.GetEffectiveDate().AsTime(),
+					Description:   description,
+					Reference:     e.GetReference(),
+					Currency:      leg.GetAmount().GetCurrency(),
+					Amount:        amount,
+					Credit:        leg.GetSide() == ledgerv1.PostingSide_POSTING_SIDE_CREDIT,
+				})
+			}
+		}
+		next := resp.GetPagination().GetNextPageToken()
+		if next == "" {
+			break
+		}
+		req.Pagination.PageToken = next
+	}
+
+	sort.SliceStable(postings, func(i, j int) bool { return postings[i].EffectiveDate.Before(postings[j].EffectiveDate) })
+	return postings, nil
+}
+
+func (c *LedgerGRPCClient) listPage(ctx context.Context, req *ledgerv1.ListJournalEntriesRequest) (*ledgerv1.ListJournalEntriesResponse, error) {
+	if c.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.config.Timeout)
+		defer cancel()
+	}
+	return c.client.ListJournalEntries(ctx, req)
+}
+
+// authorize returns a context whose outgoing calls carry a token for the
+// tenant: one minted by the signer, or the caller's own.
+func (c *LedgerGRPCClient) authorize(ctx context.Context, tenantID uuid.UUID) (context.Context, error) {
+	if c.signer == nil {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("authorization"); len(values) > 0 {
+				return metadata.AppendToOutgoingContext(ctx, "authorization", values[0]), nil
+			}
+		}
+		return ctx, nil
+	}
+	token, err := c.signer.GenerateToken(uuid.Nil, tenantID, []string{auth.RoleAuditor})
+	if err != nil {
+		return nil, fmt.Errorf("mint ledger token: %w", err)
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token), nil
+}
@@ -0,0 +1,119 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv1 "github.com/bibbank/bib/api/gen/go/bib/common/v1"
+	ledgerv1 "github.com/bibbank/bib/api/gen/go/bib/ledger/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/statement-service/internal/infrastructure/client"
+)
+
+// fakeLedgerConn serves journal entry pages keyed by page token.
+type fakeLedgerConn struct {
+	pages    map[string]*ledgerv1.ListJournalEntriesResponse
+	requests []*ledgerv1.ListJournalEntriesRequest
+	authz    []string
+}
+
+func (c *fakeLedgerConn) Invoke(ctx context.Context, method string, args, reply any, _ ...grpc.CallOption) error {
+	if method != ledgerv1.LedgerService_ListJournalEntries_FullMethodName {
+		return status.Error(codes.Unimplemented, method)
+	}
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		c.authz = append(c.authz, md.Get("authorization")...)
+	}
+	req := proto.Clone(args.(proto.Message)).(*ledgerv1.ListJournalEntriesRequest)
+	c.requests = append(c.requests, req)
+	page, ok := c.pages[req.GetPagination().GetPageToken()]
+	if !ok {
+		return status.Error(codes.InvalidArgument, "unknown page token")
+	}
+	proto.Merge(reply.(proto.Message), page)
+	return nil
+}
+
+func (c *fakeLedgerConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("not supported")
+}
+
+func leg(code string, side ledgerv1.PostingSide, amount, description string) *ledgerv1.PostingLeg {
+	return &ledgerv1.PostingLeg{
+		AccountCode: code,
+		Side:        side,
+		Amount:      &commonv1.Money{Amount: amount, Currency: "EUR"},
+		Description: description,
+	}
+}
+
+func TestLedgerGRPCClient_ListPostings(t *testing.T) {
+	day := time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC)
+	conn := &fakeLedgerConn{pages: map[string]*ledgerv1.ListJournalEntriesResponse{
+		"": {
+			Entries: []*ledgerv1.JournalEntry{{
+				Id: "je-2", EffectiveDate: timestamppb.New(day.Add(time.Hour)), Status: ledgerv1.EntryStatus_ENTRY_STATUS_POSTED,
+				Description: "Card settlement", Reference: "pay-2",
+				Legs: []*ledgerv1.PostingLeg{
+					leg("2000-001", ledgerv1.PostingSide_POSTING_SIDE_DEBIT, "40.00", ""),
+					leg("1000", ledgerv1.PostingSide_POSTING_SIDE_CREDIT, "40.00", ""),
+				},
+			}, {
+				// Pending entries have not hit the account.
+				Id: "je-3", EffectiveDate: timestamppb.New(day), Status: ledgerv1.EntryStatus_ENTRY_STATUS_PENDING,
+				Legs: []*ledgerv1.PostingLeg{leg("2000-001", ledgerv1.PostingSide_POSTING_SIDE_DEBIT, "5.00", "")},
+			}},
+			Pagination: &commonv1.PaginationResponse{NextPageToken: "2"},
+		},
+		"2": {Entries: []*ledgerv1.JournalEntry{{
+			Id: "je-1", EffectiveDate: timestamppb.New(day), Status: ledgerv1.EntryStatus_ENTRY_STATUS_POSTED,
+			Description: "Payroll",
+			Legs: []*ledgerv1.PostingLeg{
+				leg("1000", ledgerv1.PostingSide_POSTING_SIDE_DEBIT, "100.00", ""),
+				leg("2000-001", ledgerv1.PostingSide_POSTING_SIDE_CREDIT, "100.00", "Salary"),
+			},
+		}}},
+	}}
+	signer, err := auth.NewJWTService(auth.JWTConfig{Secret: "test-secret", Issuer: "bib-gateway", Expiration: time.Minute})
+	require.NoError(t, err)
+	c := client.NewLedgerGRPCClient(conn, signer, client.LedgerClientConfig{PageSize: 2})
+	tenantID := uuid.New()
+
+	from, to := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	postings, err := c.ListPostings(context.Background(), tenantID, "2000-001", from, to)
+	require.NoError(t, err)
+
+	require.Len(t, postings, 2)
+	assert.Equal(t, "je-1", postings[0].EntryID)
+	assert.Equal(t, 1, postings[0].Leg)
+	assert.True(t, postings[0].Credit)
+	assert.Equal(t, "Salary", postings[0].Description)
+	assert.Equal(t, "100", postings[0].Amount.String())
+	assert.Equal(t, "je-2", postings[1].EntryID)
+	assert.False(t, postings[1].Credit)
+	assert.Equal(t, "Card settlement", postings[1].Description)
+	assert.Equal(t, "pay-2", postings[1].Reference)
+
+	require.Len(t, conn.requests, 2)
+	assert.Equal(t, "2000-001", conn.requests[0].GetAccountCode())
+	assert.Equal(t, int32(2), conn.requests[0].GetPagination().GetPageSize())
+	assert.True(t, conn.requests[0].GetToDate().AsTime().Before(to), "the ledger's range is inclusive")
+
+	require.Len(t, conn.authz, 2)
+	claims, err := signer.ValidateToken(conn.authz[0][len("Bearer "):])
+	require.NoError(t, err)
+	assert.Equal(t, tenantID, claims.TenantID)
+	assert.Equal(t, []string{auth.RoleAuditor}, claims.Roles)
+}
@@ -0,0 +1,170 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/bibbank/bib/pkg/archive"
+)
+
+type DatabaseConfig struct {
+	Host     string
+	User     string
+	Password string
+	Name     string
+	SSLMode  string
+	Port     int
+}
+
+type KafkaConfig struct {
+	ConsumerGroup string
+	Brokers       []string
+}
+
+// ScheduleConfig configures scheduled statement generation.
+type ScheduleConfig struct {
+	// PollInterval is how often the leader looks for due schedules.
+	PollInterval time.Duration
+	// Grace is how long after a period ends its statements wait for late
+	// events before they are generated.
+	Grace time.Duration
+}
+
+// LedgerConfig configures the ledger statements list postings from.
+type LedgerConfig struct {
+	// GRPCAddr is the ledger service's address. When empty, statements
+	// list projected activity only.
+	GRPCAddr string
+	// SigningKeyFile holds the private key the ledger calls' tenant tokens
+	// are signed with. When empty they are signed with JWT_SECRET, if set.
+	SigningKeyFile string
+	// Timeout bounds each call to the ledger service.
+	Timeout time.Duration
+	// PageSize is the number of journal entries requested per page.
+	PageSize int
+}
+
+type Config struct {
+	DB          DatabaseConfig
+	ServiceName string
+	Kafka       KafkaConfig
+	// Storage is where statement documents are kept: the S3 bucket S3Bucket when
+	// it is set, and under Dir otherwise.
+	Storage   archive.StoreConfig
+	Schedules ScheduleConfig
+	Ledger    LedgerConfig
+	Outbox    OutboxConfig
+	GRPCPort  int
+	HTTPPort  int
+}
+
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+func Load() Config {
+	return Config{
+		GRPCPort: getEnvInt("GRPC_PORT", 9096),
+		HTTPPort: getEnvInt("HTTP_PORT", 8096),
+		DB: DatabaseConfig{
+			Host:     getEnv("DB_HOST", "localhost"),
+			Port:     getEnvInt("DB_PORT", 5432),
+			User:     getEnv("DB_USER", "bib"),
+			Password: getEnv("DB_PASSWORD", ""),
+			Name:     getEnv("DB_NAME", "bib_statement"),
+			SSLMode:  getEnv("DB_SSLMODE", "require"),
+		},
+		Kafka: KafkaConfig{
+			Brokers:       []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+			ConsumerGroup: getEnv("KAFKA_CONSUMER_GROUP", "statement-service"),
+		},
+		Storage: archive.StoreConfig{
+			Dir:         getEnv("STATEMENT_STORAGE_DIR", "/var/lib/bib/statements"),
+			S3Endpoint:  getEnv("STATEMENT_S3_ENDPOINT", "https://s3.amazonaws.com"),
+			S3Region:    getEnv("STATEMENT_S3_REGION", "us-east-1"),
+			S3Bucket:    getEnv("STATEMENT_S3_BUCKET", ""),
+			S3AccessKey: getEnv("STATEMENT_S3_ACCESS_KEY", ""),
+			S3SecretKey: getEnv("STATEMENT_S3_SECRET_KEY", ""),
+		},
+		Schedules: ScheduleConfig{
+			PollInterval: getEnvDuration("STATEMENT_SCHEDULE_POLL_INTERVAL", 15*time.Minute),
+			Grace:        getEnvDuration("STATEMENT_SCHEDULE_GRACE", 6*time.Hour),
+		},
+		Ledger: LedgerConfig{
+			GRPCAddr:       getEnv("LEDGER_SERVICE_ADDR", ""),
+			SigningKeyFile: getEnv("STATEMENT_SIGNING_KEY_FILE", ""),
+			Timeout:        getEnvDuration("STATEMENT_LEDGER_TIMEOUT", 5*time.Second),
+			PageSize:       getEnvInt("STATEMENT_LEDGER_PAGE_SIZE", 500),
+		},
+		ServiceName: "statement-service",
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
+	}
+}
+
+func (c Config) GRPCAddr() string {
+	return fmt.Sprintf(":%d", c.GRPCPort)
+}
+
+func (c Config) HTTPAddr() string {
+	return fmt.Sprintf(":%d", c.HTTPPort)
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) int {
+	if v := os.Getenv(key); v != "" {
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+	return fallback
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return fallback
+}
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
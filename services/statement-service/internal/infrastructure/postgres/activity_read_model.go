@@ -0,0 +1,242 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/statement-service/internal/domain/port"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/service"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+)
+
+const (
+	customerAccountColumns = `
+	id, tenant_id, customer_id, account_number, account_type, currency, opened_at,
+	ledger_account_code`
+	paymentFactColumns = `
+	id, tenant_id, source_account_id, destination_account_id, amount, currency,
+	rail, description, initiated_at, settled_at`
+	loanFactColumns = `
+	id, tenant_id, account_id, currency`
+	activityColumns = `
+	event_id, account_id, tenant_id, occurred_at, source, description, reference, amount, currency,
+	category, logo`
+)
+
+// ActivityReadModelRepo is the PostgreSQL implementation of ActivityReadModel.
+type ActivityReadModelRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewActivityReadModelRepo creates a new ActivityReadModelRepo.
+func NewActivityReadModelRepo(pool *pgxpool.Pool) *ActivityReadModelRepo {
+	return &ActivityReadModelRepo{pool: pool}
+}
+
+// SaveAccount upserts a customer account. An account's ledger account code
+// is only ever set by SetLedgerAccountCode, so a redelivered account.opened
+// event does not clear it.
+func (r *ActivityReadModelRepo) SaveAccount(ctx context.Context, a service.CustomerAccount) error {
+	query := `
+		INSERT INTO statement_accounts (` + customerAccountColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (id) DO UPDATE SET
+			customer_id = EXCLUDED.customer_id,
+			account_number = EXCLUDED.account_number,
+			account_type = EXCLUDED.account_type,
+			currency = EXCLUDED.currency
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		a.ID, a.TenantID, a.CustomerID, a.AccountNumber, a.AccountType, a.Currency, a.OpenedAt,
+		a.LedgerAccountCode,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save customer account: %w", err)
+	}
+	return nil
+}
+
+// SetLedgerAccountCode records the ledger account code of an account.
+func (r *ActivityReadModelRepo) SetLedgerAccountCode(ctx context.Context, tenantID, accountID uuid.UUID, code string) error {
+	query := `
+		UPDATE statement_accounts SET ledger_account_code = $3
+		WHERE tenant_id = $1 AND id = $2
+	`
+
+	if _, err := r.pool.Exec(ctx, query, tenantID, accountID, code); err != nil {
+		return fmt.Errorf("failed to set ledger account code: %w", err)
+	}
+	return nil
+}
+
+// ListCustomerAccounts retrieves a customer's accounts.
+func (r *ActivityReadModelRepo) ListCustomerAccounts(ctx context.Context, tenantID, customerID uuid.UUID) ([]service.CustomerAccount, error) {
+	query := `SELECT ` + customerAccountColumns + `
+		FROM statement_accounts
+		WHERE tenant_id = $1 AND customer_id = $2
+		ORDER BY account_number
+	`
+
+	rows, err := r.pool.Query(ctx, query, tenantID, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query customer accounts: %w", err)
+	}
+	defer rows.Close()
+
+	var accounts []service.CustomerAccount
+	for rows.Next() {
+		var a service.CustomerAccount
+		if err := rows.Scan(&a.ID, &a.TenantID, &a.CustomerID, &a.AccountNumber, &a.AccountType, &a.Currency, &a.OpenedAt,
+			&a.LedgerAccountCode); err != nil {
+			return nil, fmt.Errorf("failed to scan customer account row: %w", err)
+		}
+		accounts = append(accounts, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return accounts, nil
+}
+
+// FindPayment retrieves a payment.
+func (r *ActivityReadModelRepo) FindPayment(ctx context.Context, id uuid.UUID) (service.PaymentFact, error) {
+	query := `SELECT ` + paymentFactColumns + ` FROM statement_payments WHERE id = $1`
+
+	var (
+		p           service.PaymentFact
+		destination *uuid.UUID
+	)
+	err := r.pool.QueryRow(ctx, query, id).Scan(
+		&p.ID, &p.TenantID, &p.SourceAccountID, &destination, &p.Amount, &p.Currency,
+		&p.Rail, &p.Description, &p.InitiatedAt, &p.SettledAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.PaymentFact{}, port.ErrProjectionNotFound
+	}
+	if err != nil {
+		return service.PaymentFact{}, fmt.Errorf("failed to scan payment: %w", err)
+	}
+	if destination != nil {
+		p.DestinationAccountID = *destination
+	}
+	return p, nil
+}
+
+// SavePayment upserts a payment.
+func (r *ActivityReadModelRepo) SavePayment(ctx context.Context, p service.PaymentFact) error {
+	query := `
+		INSERT INTO statement_payments (` + paymentFactColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE SET
+			settled_at = EXCLUDED.settled_at
+	`
+
+	var destination *uuid.UUID
+	if p.DestinationAccountID != uuid.Nil {
+		destination = &p.DestinationAccountID
+	}
+	_, err := r.pool.Exec(ctx, query,
+		p.ID, p.TenantID, p.SourceAccountID, destination, p.Amount, p.Currency,
+		p.Rail, p.Description, p.InitiatedAt, p.SettledAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save payment: %w", err)
+	}
+	return nil
+}
+
+// FindLoan retrieves a loan.
+func (r *ActivityReadModelRepo) FindLoan(ctx context.Context, id string) (service.LoanFact, error) {
+	query := `SELECT ` + loanFactColumns + ` FROM statement_loans WHERE id = $1`
+
+	var l service.LoanFact
+	err := r.pool.QueryRow(ctx, query, id).Scan(&l.ID, &l.TenantID, &l.AccountID, &l.Currency)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.LoanFact{}, port.ErrProjectionNotFound
+	}
+	if err != nil {
+		return service.LoanFact{}, fmt.Errorf("failed to scan loan: %w", err)
+	}
+	return l, nil
+}
+
+// SaveLoan upserts a loan.
+func (r *ActivityReadModelRepo) SaveLoan(ctx context.Context, l service.LoanFact) error {
+	query := `
+		INSERT INTO statement_loans (` + loanFactColumns + `)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO UPDATE SET
+			account_id = EXCLUDED.account_id
+	`
+
+	if _, err := r.pool.Exec(ctx, query, l.ID, l.TenantID, l.AccountID, l.Currency); err != nil {
+		return fmt.Errorf("failed to save loan: %w", err)
+	}
+	return nil
+}
+
+// RecordActivity inserts activity lines, skipping lines already recorded for
+// the same event and account.
+func (r *ActivityReadModelRepo) RecordActivity(ctx context.Context, activity ...service.Activity) error {
+	query := `
+		INSERT INTO statement_activity (` + activityColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (event_id, account_id) DO NOTHING
+	`
+
+	batch := &pgx.Batch{}
+	for _, a := range activity {
+		batch.Queue(query,
+			a.EventID, a.AccountID, a.TenantID, a.OccurredAt, a.Source.String(),
+			a.Description, a.Reference, a.Amount, a.Currency,
+			a.Category, a.Logo,
+		)
+	}
+	if err := r.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to record activity: %w", err)
+	}
+	return nil
+}
+
+// ListActivity retrieves the accounts' activity within [from, to), oldest
+// first.
+func (r *ActivityReadModelRepo) ListActivity(ctx context.Context, tenantID uuid.UUID, accountIDs []uuid.UUID, from, to time.Time) ([]service.Activity, error) {
+	query := `SELECT ` + activityColumns + `
+		FROM statement_activity
+		WHERE tenant_id = $1 AND account_id = ANY($2) AND occurred_at >= $3 AND occurred_at < $4
+		ORDER BY occurred_at, event_id
+	`
+
+	rows, err := r.pool.Query(ctx, query, tenantID, accountIDs, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query activity: %w", err)
+	}
+	defer rows.Close()
+
+	var activity []service.Activity
+	for rows.Next() {
+		var (
+			a      service.Activity
+			source string
+		)
+		if err := rows.Scan(&a.EventID, &a.AccountID, &a.TenantID, &a.OccurredAt, &source,
+			&a.Description, &a.Reference, &a.Amount, &a.Currency, &a.Category, &a.Logo); err != nil {
+			return nil, fmt.Errorf("failed to scan activity row: %w", err)
+		}
+		if a.Source, err = valueobject.NewActivitySource(source); err != nil {
+			return nil, fmt.Errorf("invalid activity source in database: %w", err)
+		}
+		activity = append(activity, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return activity, nil
+}
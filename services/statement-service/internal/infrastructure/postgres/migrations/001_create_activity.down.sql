@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS statement_activity;
+DROP TABLE IF EXISTS statement_loans;
+DROP TABLE IF EXISTS statement_payments;
+DROP TABLE IF EXISTS statement_accounts;
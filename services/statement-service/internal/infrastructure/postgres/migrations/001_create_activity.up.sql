@@ -0,0 +1,54 @@
+-- The activity read model statements are built from, projected from
+-- account, payment, card, deposit and lending events.
+
+-- Customer accounts: which customer each account's activity belongs to.
+CREATE TABLE IF NOT EXISTS statement_accounts (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    customer_id UUID NOT NULL,
+    account_number VARCHAR(50) NOT NULL,
+    account_type VARCHAR(50) NOT NULL DEFAULT '',
+    currency VARCHAR(3) NOT NULL,
+    opened_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_statement_accounts_customer ON statement_accounts(tenant_id, customer_id);
+
+-- Payments awaiting settlement; their activity is recorded when they settle.
+CREATE TABLE IF NOT EXISTS statement_payments (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    source_account_id UUID NOT NULL,
+    destination_account_id UUID,
+    amount NUMERIC(20, 4) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    rail VARCHAR(20) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    initiated_at TIMESTAMPTZ NOT NULL,
+    settled_at TIMESTAMPTZ
+);
+
+-- Loans: the account each loan was disbursed to and is repaid from.
+CREATE TABLE IF NOT EXISTS statement_loans (
+    id VARCHAR(64) PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    account_id UUID NOT NULL,
+    currency VARCHAR(3) NOT NULL
+);
+
+-- Activity lines. Credits are positive and debits negative. An event
+-- records at most one line per account.
+CREATE TABLE IF NOT EXISTS statement_activity (
+    event_id VARCHAR(255) NOT NULL,
+    account_id UUID NOT NULL,
+    tenant_id UUID NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    source VARCHAR(20) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    amount NUMERIC(20, 4) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    PRIMARY KEY (event_id, account_id)
+);
+
+CREATE INDEX idx_statement_activity_account ON statement_activity(tenant_id, account_id, occurred_at);
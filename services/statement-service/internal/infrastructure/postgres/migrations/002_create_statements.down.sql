@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS statement_schedules;
+DROP TABLE IF EXISTS statements;
@@ -0,0 +1,38 @@
+-- Statements are customers' generated statements. Their documents are kept
+-- in object storage under object_key.
+CREATE TABLE IF NOT EXISTS statements (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    customer_id UUID NOT NULL,
+    schedule_id UUID,
+    period_start DATE NOT NULL,
+    period_end DATE NOT NULL,
+    format VARCHAR(10) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    object_key TEXT NOT NULL DEFAULT '',
+    sha256 VARCHAR(64) NOT NULL DEFAULT '',
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    entry_count INT NOT NULL DEFAULT 0,
+    failure_reason TEXT NOT NULL DEFAULT '',
+    generated_at TIMESTAMPTZ NOT NULL,
+    version INT NOT NULL DEFAULT 1
+);
+
+CREATE INDEX idx_statements_customer ON statements(tenant_id, customer_id, period_end DESC, generated_at DESC);
+
+-- Statement schedules generate a customer's statement after each period.
+CREATE TABLE IF NOT EXISTS statement_schedules (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    customer_id UUID NOT NULL,
+    frequency VARCHAR(20) NOT NULL,
+    format VARCHAR(10) NOT NULL,
+    last_period_end DATE NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_statement_schedules_customer ON statement_schedules(tenant_id, customer_id);
+CREATE INDEX idx_statement_schedules_active ON statement_schedules(id) WHERE active;
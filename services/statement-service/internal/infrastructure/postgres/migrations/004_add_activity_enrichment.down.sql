@@ -0,0 +1,3 @@
+ALTER TABLE statement_activity
+    DROP COLUMN logo,
+    DROP COLUMN category;
@@ -0,0 +1,5 @@
+-- The category and logo of each activity line's merchant or counterparty.
+-- Lines recorded before enrichment keep empty values.
+ALTER TABLE statement_activity
+    ADD COLUMN category VARCHAR(20) NOT NULL DEFAULT '',
+    ADD COLUMN logo VARCHAR(64) NOT NULL DEFAULT '';
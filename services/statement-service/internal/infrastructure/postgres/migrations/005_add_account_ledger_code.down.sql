@@ -0,0 +1,2 @@
+ALTER TABLE statement_accounts
+    DROP COLUMN ledger_account_code;
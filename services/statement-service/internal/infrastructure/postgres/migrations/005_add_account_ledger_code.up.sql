@@ -0,0 +1,5 @@
+-- The ledger account each customer account posts to, which statements read
+-- their lines from. Accounts projected before account.opened carried it keep
+-- an empty code and are listed from projected activity.
+ALTER TABLE statement_accounts
+    ADD COLUMN ledger_account_code VARCHAR(20) NOT NULL DEFAULT '';
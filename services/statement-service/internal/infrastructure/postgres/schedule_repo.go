@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/statement-service/internal/domain/model"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/port"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+)
+
+const scheduleColumns = `
+	id, tenant_id, customer_id, frequency, format, last_period_end, active,
+	version, created_at, updated_at`
+
+// uniqueViolation is the PostgreSQL error code for a unique constraint
+// violation.
+const uniqueViolation = "23505"
+
+// ScheduleRepo is the PostgreSQL implementation of ScheduleRepository.
+type ScheduleRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewScheduleRepo creates a new ScheduleRepo.
+func NewScheduleRepo(pool *pgxpool.Pool) *ScheduleRepo {
+	return &ScheduleRepo{pool: pool}
+}
+
+// Save persists the schedule with optimistic locking on its version. A
+// schedule created concurrently for the same customer is a version conflict.
+func (r *ScheduleRepo) Save(ctx context.Context, s model.StatementSchedule) error {
+	query := `
+		INSERT INTO statement_schedules (` + scheduleColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE SET
+			frequency = EXCLUDED.frequency,
+			format = EXCLUDED.format,
+			last_period_end = EXCLUDED.last_period_end,
+			active = EXCLUDED.active,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE statement_schedules.version = EXCLUDED.version - 1
+	`
+
+	result, err := r.pool.Exec(ctx, query,
+		s.ID(), s.TenantID(), s.CustomerID(), s.Frequency().String(), s.Format().String(),
+		s.LastPeriodEnd(), s.Active(), s.Version(), s.CreatedAt(), s.UpdatedAt(),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: schedule of customer %s", port.ErrVersionConflict, s.CustomerID())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to upsert statement schedule: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: schedule %s", port.ErrVersionConflict, s.ID())
+	}
+	return nil
+}
+
+// FindByCustomer retrieves a customer's schedule.
+func (r *ScheduleRepo) FindByCustomer(ctx context.Context, tenantID, customerID uuid.UUID) (model.StatementSchedule, error) {
+	query := `SELECT ` + scheduleColumns + ` FROM statement_schedules WHERE tenant_id = $1 AND customer_id = $2`
+
+	s, err := scanSchedule(r.pool.QueryRow(ctx, query, tenantID, customerID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.StatementSchedule{}, port.ErrScheduleNotFound
+	}
+	if err != nil {
+		return model.StatementSchedule{}, fmt.Errorf("failed to scan statement schedule: %w", err)
+	}
+	return s, nil
+}
+
+// List returns a tenant's schedules, or one customer's when customerID is
+// set, and the total number of them.
+func (r *ScheduleRepo) List(ctx context.Context, tenantID, customerID uuid.UUID, limit, offset int) ([]model.StatementSchedule, int, error) {
+	const filter = ` WHERE tenant_id = $1 AND ($2 = '00000000-0000-0000-0000-000000000000'::uuid OR customer_id = $2)`
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM statement_schedules`+filter, tenantID, customerID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count statement schedules: %w", err)
+	}
+	schedules, err := r.query(ctx, `SELECT `+scheduleColumns+` FROM statement_schedules`+filter+`
+		ORDER BY created_at
+		LIMIT $3 OFFSET $4
+	`, tenantID, customerID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return schedules, total, nil
+}
+
+// ListActive returns the active schedules of every tenant.
+func (r *ScheduleRepo) ListActive(ctx context.Context) ([]model.StatementSchedule, error) {
+	return r.query(ctx, `SELECT `+scheduleColumns+` FROM statement_schedules WHERE active ORDER BY created_at`)
+}
+
+func (r *ScheduleRepo) query(ctx context.Context, query string, args ...any) ([]model.StatementSchedule, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query statement schedules: %w", err)
+	}
+	defer rows.Close()
+
+	var schedules []model.StatementSchedule
+	for rows.Next() {
+		s, err := scanSchedule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan statement schedule row: %w", err)
+		}
+		schedules = append(schedules, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return schedules, nil
+}
+
+func scanSchedule(row pgx.Row) (model.StatementSchedule, error) {
+	var (
+		id, tenantID, customerID uuid.UUID
+		frequencyStr, formatStr  string
+		lastPeriodEnd            time.Time
+		active                   bool
+		version                  int
+		createdAt, updatedAt     time.Time
+	)
+
+	err := row.Scan(&id, &tenantID, &customerID, &frequencyStr, &formatStr, &lastPeriodEnd, &active,
+		&version, &createdAt, &updatedAt)
+	if err != nil {
+		return model.StatementSchedule{}, err
+	}
+
+	frequency, err := valueobject.NewStatementFrequency(frequencyStr)
+	if err != nil {
+		return model.StatementSchedule{}, fmt.Errorf("invalid frequency in database: %w", err)
+	}
+	format, err := valueobject.NewStatementFormat(formatStr)
+	if err != nil {
+		return model.StatementSchedule{}, fmt.Errorf("invalid format in database: %w", err)
+	}
+
+	return model.ReconstructStatementSchedule(
+		id, tenantID, customerID, frequency, format,
+		lastPeriodEnd.UTC(), active, version, createdAt, updatedAt,
+	), nil
+}
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/statement-service/internal/domain/model"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/port"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+)
+
+const statementColumns = `
+	id, tenant_id, customer_id, schedule_id, period_start, period_end, format, status,
+	object_key, sha256, size_bytes, entry_count, failure_reason, generated_at, version`
+
+// StatementRepo is the PostgreSQL implementation of StatementRepository.
+type StatementRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewStatementRepo creates a new StatementRepo.
+func NewStatementRepo(pool *pgxpool.Pool) *StatementRepo {
+	return &StatementRepo{pool: pool}
+}
+
+// Save inserts a new statement.
+func (r *StatementRepo) Save(ctx context.Context, s model.Statement) error {
+	query := `
+		INSERT INTO statements (` + statementColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	`
+
+	var scheduleID *uuid.UUID
+	if id := s.ScheduleID(); id != uuid.Nil {
+		scheduleID = &id
+	}
+	_, err := r.pool.Exec(ctx, query,
+		s.ID(), s.TenantID(), s.CustomerID(), scheduleID, s.PeriodStart(), s.PeriodEnd(),
+		s.Format().String(), s.Status().String(), s.ObjectKey(), s.SHA256(), s.SizeBytes(),
+		s.EntryCount(), s.FailureReason(), s.GeneratedAt(), s.Version(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert statement: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves one of a tenant's statements.
+func (r *StatementRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Statement, error) {
+	query := `SELECT ` + statementColumns + ` FROM statements WHERE tenant_id = $1 AND id = $2`
+
+	s, err := scanStatement(r.pool.QueryRow(ctx, query, tenantID, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Statement{}, port.ErrStatementNotFound
+	}
+	if err != nil {
+		return model.Statement{}, fmt.Errorf("failed to scan statement: %w", err)
+	}
+	return s, nil
+}
+
+// ListByCustomer returns a customer's statements, latest period first, and
+// the total number of them.
+func (r *StatementRepo) ListByCustomer(ctx context.Context, tenantID, customerID uuid.UUID, limit, offset int) ([]model.Statement, int, error) {
+	var total int
+	err := r.pool.QueryRow(ctx,
+		`SELECT COUNT(*) FROM statements WHERE tenant_id = $1 AND customer_id = $2`, tenantID, customerID,
+	).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count statements: %w", err)
+	}
+
+	query := `SELECT ` + statementColumns + `
+		FROM statements
+		WHERE tenant_id = $1 AND customer_id = $2
+		ORDER BY period_end DESC, generated_at DESC
+		LIMIT $3 OFFSET $4
+	`
+	rows, err := r.pool.Query(ctx, query, tenantID, customerID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query statements: %w", err)
+	}
+	defer rows.Close()
+
+	var statements []model.Statement
+	for rows.Next() {
+		s, err := scanStatement(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan statement row: %w", err)
+		}
+		statements = append(statements, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("row iteration error: %w", err)
+	}
+	return statements, total, nil
+}
+
+func scanStatement(row pgx.Row) (model.Statement, error) {
+	var (
+		id, tenantID, customerID uuid.UUID
+		scheduleID               *uuid.UUID
+		periodStart, periodEnd   time.Time
+		formatStr, statusStr     string
+		objectKey, sha256        string
+		sizeBytes                int64
+		entryCount               int
+		failureReason            string
+		generatedAt              time.Time
+		version                  int
+	)
+
+	err := row.Scan(&id, &tenantID, &customerID, &scheduleID, &periodStart, &periodEnd, &formatStr, &statusStr,
+		&objectKey, &sha256, &sizeBytes, &entryCount, &failureReason, &generatedAt, &version)
+	if err != nil {
+		return model.Statement{}, err
+	}
+
+	format, err := valueobject.NewStatementFormat(formatStr)
+	if err != nil {
+		return model.Statement{}, fmt.Errorf("invalid format in database: %w", err)
+	}
+	status, err := valueobject.NewStatementStatus(statusStr)
+	if err != nil {
+		return model.Statement{}, fmt.Errorf("invalid status in database: %w", err)
+	}
+	var schedule uuid.UUID
+	if scheduleID != nil {
+		schedule = *scheduleID
+	}
+
+	return model.ReconstructStatement(
+		id, tenantID, customerID, schedule,
+		periodStart.UTC(), periodEnd.UTC(),
+		format, status,
+		objectKey, sha256, sizeBytes, entryCount, failureReason,
+		generatedAt, version,
+	), nil
+}
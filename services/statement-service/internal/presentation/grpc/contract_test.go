@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	statementv1 "github.com/bibbank/bib/api/gen/go/bib/statement/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/statement-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/model"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/port"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractStatementRepo holds at most one statement.
+type contractStatementRepo struct {
+	statement *model.Statement
+}
+
+func (r *contractStatementRepo) Save(context.Context, model.Statement) error { return nil }
+
+func (r *contractStatementRepo) FindByID(context.Context, uuid.UUID, uuid.UUID) (model.Statement, error) {
+	if r.statement == nil {
+		return model.Statement{}, port.ErrStatementNotFound
+	}
+	return *r.statement, nil
+}
+
+func (r *contractStatementRepo) ListByCustomer(context.Context, uuid.UUID, uuid.UUID, int, int) ([]model.Statement, int, error) {
+	return nil, 0, nil
+}
+
+// contractScheduleRepo has no schedules, so every upsert creates one.
+type contractScheduleRepo struct{}
+
+func (contractScheduleRepo) Save(context.Context, model.StatementSchedule) error { return nil }
+
+func (contractScheduleRepo) FindByCustomer(context.Context, uuid.UUID, uuid.UUID) (model.StatementSchedule, error) {
+	return model.StatementSchedule{}, port.ErrScheduleNotFound
+}
+
+func (contractScheduleRepo) List(context.Context, uuid.UUID, uuid.UUID, int, int) ([]model.StatementSchedule, int, error) {
+	return nil, 0, nil
+}
+
+func (contractScheduleRepo) ListActive(context.Context) ([]model.StatementSchedule, error) {
+	return nil, nil
+}
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	statements := &contractStatementRepo{}
+	h := NewHandler(
+		nil, usecase.NewGetStatementUseCase(statements), nil, nil,
+		usecase.NewUpsertScheduleUseCase(contractScheduleRepo{}), nil, nil,
+		slog.Default(),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { statementv1.RegisterStatementServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-statement-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { statements.statement = nil },
+		"a statement was generated": func(t *testing.T) {
+			stmt, err := model.NewStatement(contractTenantID, uuid.New(), uuid.Nil,
+				time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+				valueobject.StatementFormatPDF)
+			if err != nil {
+				t.Fatal(err)
+			}
+			stmt, err = stmt.MarkGenerated("statements/contract.pdf", "e3b0c442", 2048, 12, time.Now().UTC())
+			if err != nil {
+				t.Fatal(err)
+			}
+			statements.statement = &stmt
+		},
+	})
+}
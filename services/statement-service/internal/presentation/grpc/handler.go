@@ -0,0 +1,446 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	statementv1 "github.com/bibbank/bib/api/gen/go/bib/statement/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/statement-service/internal/application/dto"
+	"github.com/bibbank/bib/services/statement-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/port"
+)
+
+// staffRoles may act on any customer of their tenant; a caller with only the
+// customer role is limited to their own statements. readerRoles may read
+// statements.
+var (
+	staffRoles  = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleAPIClient}
+	readerRoles = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleAPIClient, auth.RoleCustomer}
+)
+
+var (
+	formatToProto = map[string]statementv1.StatementFormat{
+		"PDF": statementv1.StatementFormat_STATEMENT_FORMAT_PDF,
+		"CSV": statementv1.StatementFormat_STATEMENT_FORMAT_CSV,
+	}
+	statusToProto = map[string]statementv1.StatementStatus{
+		"GENERATED": statementv1.StatementStatus_STATEMENT_STATUS_GENERATED,
+		"FAILED":    statementv1.StatementStatus_STATEMENT_STATUS_FAILED,
+	}
+	frequencyToProto = map[string]statementv1.StatementFrequency{
+		"MONTHLY":   statementv1.StatementFrequency_STATEMENT_FREQUENCY_MONTHLY,
+		"QUARTERLY": statementv1.StatementFrequency_STATEMENT_FREQUENCY_QUARTERLY,
+	}
+)
+
+// requireRole checks that the caller has at least one of the given roles.
+func requireRole(ctx context.Context, roles ...string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "insufficient permissions")
+}
+
+// tenantIDFromContext extracts the tenant ID from JWT claims in the context.
+func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return claims.TenantID, nil
+}
+
+// ownCustomerID returns the customer a customer-only caller is limited to, or
+// uuid.Nil for staff callers.
+func ownCustomerID(ctx context.Context) uuid.UUID {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil
+	}
+	for _, role := range staffRoles {
+		if claims.HasRole(role) {
+			return uuid.Nil
+		}
+	}
+	return claims.UserID
+}
+
+// customerIDFromRequest parses the request's customer ID and checks a
+// customer-only caller is asking for their own.
+func customerIDFromRequest(ctx context.Context, raw string) (uuid.UUID, error) {
+	customerID, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid customer ID")
+	}
+	if own := ownCustomerID(ctx); own != uuid.Nil && own != customerID {
+		return uuid.Nil, status.Error(codes.PermissionDenied, "customers may only access their own statements")
+	}
+	return customerID, nil
+}
+
+// Compile-time assertion that Handler implements StatementServiceServer.
+var _ statementv1.StatementServiceServer = (*Handler)(nil)
+
+// Handler implements the StatementServiceServer gRPC interface.
+type Handler struct {
+	statementv1.UnimplementedStatementServiceServer
+	generate     *usecase.GenerateStatementUseCase
+	get          *usecase.GetStatementUseCase
+	list         *usecase.ListStatementsUseCase
+	content      *usecase.GetStatementContentUseCase
+	upsert       *usecase.UpsertScheduleUseCase
+	listSchedule *usecase.ListSchedulesUseCase
+	runDue       *usecase.RunDueSchedulesUseCase
+	logger       *slog.Logger
+}
+
+// NewHandler creates a new gRPC Handler.
+func NewHandler(
+	generate *usecase.GenerateStatementUseCase,
+	get *usecase.GetStatementUseCase,
+	list *usecase.ListStatementsUseCase,
+	content *usecase.GetStatementContentUseCase,
+	upsert *usecase.UpsertScheduleUseCase,
+	listSchedule *usecase.ListSchedulesUseCase,
+	runDue *usecase.RunDueSchedulesUseCase,
+	logger *slog.Logger,
+) *Handler {
+	return &Handler{
+		generate:     generate,
+		get:          get,
+		list:         list,
+		content:      content,
+		upsert:       upsert,
+		listSchedule: listSchedule,
+		runDue:       runDue,
+		logger:       logger,
+	}
+}
+
+// GenerateStatement generates a customer's statement for a period on demand.
+func (h *Handler) GenerateStatement(ctx context.Context, req *statementv1.GenerateStatementRequest) (*statementv1.GenerateStatementResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleCustomer); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	customerID, err := customerIDFromRequest(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	periodStart, err := time.Parse(time.DateOnly, req.PeriodStart)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "period_start must be a YYYY-MM-DD date")
+	}
+	periodEnd, err := time.Parse(time.DateOnly, req.PeriodEnd)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "period_end must be a YYYY-MM-DD date")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.generate.Execute(ctx, dto.GenerateStatementRequest{
+		TenantID:    tenantID,
+		CustomerID:  customerID,
+		PeriodStart: periodStart,
+		PeriodEnd:   periodEnd,
+		Format:      formatFromProto(req.Format),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &statementv1.GenerateStatementResponse{Statement: toStatementMsg(resp)}, nil
+}
+
+// GetStatement retrieves a statement.
+func (h *Handler) GetStatement(ctx context.Context, req *statementv1.GetStatementRequest) (*statementv1.GetStatementResponse, error) {
+	if err := requireRole(ctx, readerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	statementID, err := uuid.Parse(req.StatementId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid statement ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.get.Execute(ctx, tenantID, statementID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	if own := ownCustomerID(ctx); own != uuid.Nil && own != resp.CustomerID {
+		return nil, status.Error(codes.NotFound, "statement not found")
+	}
+	return &statementv1.GetStatementResponse{Statement: toStatementMsg(resp)}, nil
+}
+
+// ListStatements lists a customer's statements, latest period first.
+func (h *Handler) ListStatements(ctx context.Context, req *statementv1.ListStatementsRequest) (*statementv1.ListStatementsResponse, error) {
+	if err := requireRole(ctx, readerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	customerID, err := customerIDFromRequest(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.list.Execute(ctx, dto.ListStatementsRequest{
+		TenantID:   tenantID,
+		CustomerID: customerID,
+		Limit:      int(req.PageSize),
+		Offset:     int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &statementv1.ListStatementsResponse{
+		Statements: make([]*statementv1.Statement, 0, len(resp.Statements)),
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, s := range resp.Statements {
+		out.Statements = append(out.Statements, toStatementMsg(s))
+	}
+	return out, nil
+}
+
+// GetStatementContent returns a generated statement's document.
+func (h *Handler) GetStatementContent(ctx context.Context, req *statementv1.GetStatementContentRequest) (*statementv1.GetStatementContentResponse, error) {
+	if err := requireRole(ctx, readerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	statementID, err := uuid.Parse(req.StatementId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid statement ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check ownership before reading the document.
+	if own := ownCustomerID(ctx); own != uuid.Nil {
+		stmt, getErr := h.get.Execute(ctx, tenantID, statementID)
+		if getErr != nil {
+			return nil, h.toStatus(getErr)
+		}
+		if stmt.CustomerID != own {
+			return nil, status.Error(codes.NotFound, "statement not found")
+		}
+	}
+
+	resp, err := h.content.Execute(ctx, tenantID, statementID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &statementv1.GetStatementContentResponse{
+		FileName:    resp.FileName,
+		ContentType: resp.ContentType,
+		Content:     resp.Content,
+	}, nil
+}
+
+// UpsertStatementSchedule creates or changes a customer's statement schedule.
+func (h *Handler) UpsertStatementSchedule(ctx context.Context, req *statementv1.UpsertStatementScheduleRequest) (*statementv1.UpsertStatementScheduleResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleCustomer); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	customerID, err := customerIDFromRequest(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.upsert.Execute(ctx, dto.UpsertScheduleRequest{
+		TenantID:   tenantID,
+		CustomerID: customerID,
+		Frequency:  frequencyFromProto(req.Frequency),
+		Format:     formatFromProto(req.Format),
+		Paused:     req.Paused,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &statementv1.UpsertStatementScheduleResponse{Schedule: toScheduleMsg(resp)}, nil
+}
+
+// ListStatementSchedules lists the tenant's statement schedules, or one
+// customer's. A customer-only caller sees only their own.
+func (h *Handler) ListStatementSchedules(ctx context.Context, req *statementv1.ListStatementSchedulesRequest) (*statementv1.ListStatementSchedulesResponse, error) {
+	if err := requireRole(ctx, readerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	customerID := ownCustomerID(ctx)
+	if req.CustomerId != "" {
+		id, err := customerIDFromRequest(ctx, req.CustomerId)
+		if err != nil {
+			return nil, err
+		}
+		customerID = id
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listSchedule.Execute(ctx, dto.ListSchedulesRequest{
+		TenantID:   tenantID,
+		CustomerID: customerID,
+		Limit:      int(req.PageSize),
+		Offset:     int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &statementv1.ListStatementSchedulesResponse{
+		Schedules:  make([]*statementv1.StatementSchedule, 0, len(resp.Schedules)),
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, s := range resp.Schedules {
+		out.Schedules = append(out.Schedules, toScheduleMsg(s))
+	}
+	return out, nil
+}
+
+// RunDueSchedules generates the statements of every schedule whose period
+// has ended.
+func (h *Handler) RunDueSchedules(ctx context.Context, req *statementv1.RunDueSchedulesRequest) (*statementv1.RunDueSchedulesResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	result, err := h.runDue.Execute(ctx, time.Now().UTC())
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	h.logger.Info("RunDueSchedules completed", "generated", result.Generated, "failed", result.Failed)
+	return &statementv1.RunDueSchedulesResponse{
+		Generated: int32(result.Generated), //nolint:gosec // bounded by schedule count
+		Failed:    int32(result.Failed),    //nolint:gosec // bounded by schedule count
+	}, nil
+}
+
+func (h *Handler) toStatus(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidStatement), errors.Is(err, usecase.ErrInvalidSchedule):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrStatementNotGenerated):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, port.ErrStatementNotFound):
+		return status.Error(codes.NotFound, "statement not found")
+	case errors.Is(err, port.ErrObjectNotFound):
+		return status.Error(codes.NotFound, "statement document not found")
+	case errors.Is(err, port.ErrScheduleNotFound):
+		return status.Error(codes.NotFound, "statement schedule not found")
+	case errors.Is(err, port.ErrVersionConflict):
+		return status.Error(codes.Aborted, "statement schedule was modified concurrently, retry")
+	default:
+		h.logger.Error("handler error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func formatFromProto(f statementv1.StatementFormat) string {
+	for name, v := range formatToProto {
+		if v == f {
+			return name
+		}
+	}
+	return ""
+}
+
+func frequencyFromProto(f statementv1.StatementFrequency) string {
+	for name, v := range frequencyToProto {
+		if v == f {
+			return name
+		}
+	}
+	return ""
+}
+
+func toStatementMsg(s dto.StatementResponse) *statementv1.Statement {
+	msg := &statementv1.Statement{
+		StatementId:   s.ID.String(),
+		TenantId:      s.TenantID.String(),
+		CustomerId:    s.CustomerID.String(),
+		PeriodStart:   s.PeriodStart.Format(time.DateOnly),
+		PeriodEnd:     s.PeriodEnd.Format(time.DateOnly),
+		Format:        formatToProto[s.Format],
+		Status:        statusToProto[s.Status],
+		ContentType:   s.ContentType,
+		SizeBytes:     s.SizeBytes,
+		Sha256:        s.SHA256,
+		EntryCount:    int32(s.EntryCount), //nolint:gosec // bounded by statement size
+		FailureReason: s.FailureReason,
+		GeneratedAt:   timestamppb.New(s.GeneratedAt),
+		Version:       int32(s.Version), //nolint:gosec // version is small
+	}
+	if s.ScheduleID != uuid.Nil {
+		msg.ScheduleId = s.ScheduleID.String()
+	}
+	return msg
+}
+
+func toScheduleMsg(s dto.ScheduleResponse) *statementv1.StatementSchedule {
+	return &statementv1.StatementSchedule{
+		ScheduleId:    s.ID.String(),
+		TenantId:      s.TenantID.String(),
+		CustomerId:    s.CustomerID.String(),
+		Frequency:     frequencyToProto[s.Frequency],
+		Format:        formatToProto[s.Format],
+		Active:        s.Active,
+		LastPeriodEnd: s.LastPeriodEnd.Format(time.DateOnly),
+		Version:       int32(s.Version), //nolint:gosec // version is small
+		CreatedAt:     timestamppb.New(s.CreatedAt),
+		UpdatedAt:     timestamppb.New(s.UpdatedAt),
+	}
+}
@@ -0,0 +1,130 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/model"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/port"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/service"
+	"github.com/bibbank/bib/services/statement-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/statement-service/internal/infrastructure/postgres"
+)
+
+func TestStatementRepo_ListByCustomerReturnsLatestPeriodFirst(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewStatementRepo(env.Pool())
+	ctx := context.Background()
+	tenantID, customerID := uuid.New(), uuid.New()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	for _, month := range []time.Month{time.January, time.February} {
+		start := time.Date(2025, month, 1, 0, 0, 0, 0, time.UTC)
+		statement, err := model.NewStatement(tenantID, customerID, uuid.Nil, start, start.AddDate(0, 1, -1), valueobject.StatementFormatPDF)
+		require.NoError(t, err)
+		statement, err = statement.MarkGenerated("statements/"+statement.ID().String()+".pdf", "abc123", 2048, 7, now)
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, statement))
+	}
+
+	statements, total, err := repo.ListByCustomer(ctx, tenantID, customerID, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, statements, 2)
+	assert.Equal(t, time.February, statements[0].PeriodStart().Month())
+	assert.True(t, statements[0].Status().Equal(valueobject.StatementStatusGenerated))
+	assert.Equal(t, int64(2048), statements[0].SizeBytes())
+	assert.Equal(t, 7, statements[0].EntryCount())
+	assert.Equal(t, uuid.Nil, statements[0].ScheduleID())
+
+	_, err = repo.FindByID(ctx, uuid.New(), statements[0].ID())
+	assert.ErrorIs(t, err, port.ErrStatementNotFound)
+}
+
+func TestScheduleRepo_OneSchedulePerCustomer(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewScheduleRepo(env.Pool())
+	ctx := context.Background()
+	tenantID, customerID := uuid.New(), uuid.New()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	schedule, err := model.NewStatementSchedule(tenantID, customerID, valueobject.StatementFrequencyMonthly, valueobject.StatementFormatPDF, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, schedule))
+
+	duplicate, err := model.NewStatementSchedule(tenantID, customerID, valueobject.StatementFrequencyQuarterly, valueobject.StatementFormatCSV, now)
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Save(ctx, duplicate), port.ErrVersionConflict)
+
+	paused, err := schedule.Reconfigure(valueobject.StatementFrequencyMonthly, valueobject.StatementFormatCSV, false, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, paused))
+
+	// Saving the stale copy again conflicts on the version.
+	assert.ErrorIs(t, repo.Save(ctx, paused), port.ErrVersionConflict)
+
+	found, err := repo.FindByCustomer(ctx, tenantID, customerID)
+	require.NoError(t, err)
+	assert.Equal(t, schedule.ID(), found.ID())
+	assert.False(t, found.Active())
+	assert.True(t, found.Format().Equal(valueobject.StatementFormatCSV))
+
+	active, err := repo.ListActive(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, active)
+}
+
+func TestActivityReadModelRepo_RecordActivityIsIdempotentPerEventAndAccount(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewActivityReadModelRepo(env.Pool())
+	ctx := context.Background()
+	tenantID, customerID, accountID := uuid.New(), uuid.New(), uuid.New()
+	openedAt := time.Date(2025, time.January, 1, 9, 0, 0, 0, time.UTC)
+
+	account := service.CustomerAccount{
+		ID: accountID, TenantID: tenantID, CustomerID: customerID,
+		AccountNumber: "GB00BIB00000000001", AccountType: "CURRENT", Currency: "GBP", OpenedAt: openedAt,
+	}
+	require.NoError(t, repo.SaveAccount(ctx, account))
+	require.NoError(t, repo.SetLedgerAccountCode(ctx, tenantID, accountID, "2100-001"))
+
+	// A redelivered account.opened event keeps the ledger account code.
+	require.NoError(t, repo.SaveAccount(ctx, account))
+	accounts, err := repo.ListCustomerAccounts(ctx, tenantID, customerID)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, "2100-001", accounts[0].LedgerAccountCode)
+
+	line := func(eventID string, occurredAt time.Time, amount string) service.Activity {
+		return service.Activity{
+			EventID: eventID, AccountID: accountID, TenantID: tenantID, OccurredAt: occurredAt,
+			Source: valueobject.ActivitySourceAccount, Description: "Card purchase", Reference: eventID,
+			Amount: decimal.RequireFromString(amount), Currency: "GBP",
+		}
+	}
+	require.NoError(t, repo.RecordActivity(ctx,
+		line("evt-1", openedAt.Add(time.Hour), "-12.50"),
+		line("evt-2", openedAt.AddDate(0, 1, 0), "100.00"),
+	))
+	require.NoError(t, repo.RecordActivity(ctx, line("evt-1", openedAt.Add(time.Hour), "-12.50")))
+
+	activity, err := repo.ListActivity(ctx, tenantID, []uuid.UUID{accountID}, openedAt, openedAt.AddDate(0, 1, 0))
+	require.NoError(t, err)
+	require.Len(t, activity, 1)
+	assert.Equal(t, "evt-1", activity[0].EventID)
+	assert.True(t, activity[0].Amount.Equal(decimal.RequireFromString("-12.50")))
+	assert.True(t, activity[0].Source.Equal(valueobject.ActivitySourceAccount))
+
+	activity, err = repo.ListActivity(ctx, uuid.New(), []uuid.UUID{accountID}, openedAt, openedAt.AddDate(0, 2, 0))
+	require.NoError(t, err)
+	assert.Empty(t, activity)
+}
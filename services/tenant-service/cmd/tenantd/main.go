@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/bibbank/bib/pkg/auth"
+	pkgkafka "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
+	"github.com/bibbank/bib/pkg/observability"
+	pkgpostgres "github.com/bibbank/bib/pkg/postgres"
+	"github.com/bibbank/bib/services/tenant-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/port"
+	"github.com/bibbank/bib/services/tenant-service/internal/infrastructure/config"
+	"github.com/bibbank/bib/services/tenant-service/internal/infrastructure/kafka"
+	"github.com/bibbank/bib/services/tenant-service/internal/infrastructure/postgres"
+	"github.com/bibbank/bib/services/tenant-service/internal/infrastructure/provisioning"
+	grpcpresentation "github.com/bibbank/bib/services/tenant-service/internal/presentation/grpc"
+	"github.com/bibbank/bib/services/tenant-service/internal/presentation/rest"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// Load configuration.
+	cfg := config.Load()
+
+	// Initialize structured logger via shared observability package.
+	logger := observability.InitLogger(observability.LogConfig{
+		Level:  "info",
+		Format: "json",
+	})
+	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
+
+	logger.Info("starting tenant-service",
+		"http_port", cfg.HTTPPort,
+		"grpc_port", cfg.GRPCPort,
+	)
+
+	// Initialize tracing.
+	shutdown, err := observability.InitTracer(ctx, observability.TracingConfig{
+		ServiceName: cfg.ServiceName,
+		Endpoint:    getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4317"),
+		Insecure:    true,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
+	} else {
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
+	}
+
+	// Database connection.
+	dbCtx, dbCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer dbCancel()
+
+	pool, err := pkgpostgres.NewPool(dbCtx, pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	})
+	if err != nil {
+		logger.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
+	logger.Info("connected to database")
+
+	// Run database migrations.
+	migDSN := pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	}.DSN()
+	if migErr := pkgpostgres.RunMigrations(migDSN, "file://internal/infrastructure/postgres/migrations"); migErr != nil {
+		logger.Warn("migration warning", "error", migErr)
+	}
+
+	// Wire infrastructure adapters.
+	tenantRepo := postgres.NewTenantRepo(pool)
+	kafkaProducer := pkgkafka.NewProducer(pkgkafka.Config{
+		Brokers: cfg.Kafka.Brokers,
+	})
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(kafkaProducer.Close))
+	eventPublisher := kafka.NewEventPublisher(kafkaProducer, "tenant-events", logger)
+
+	// JWT service for gRPC auth (validation-only: public key preferred, secret as fallback).
+	jwtCfg := auth.JWTConfig{
+		Issuer: "bib-gateway",
+	}
+	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
+	case os.Getenv("JWT_PUBLIC_KEY") != "":
+		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
+	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
+		keyData, loadErr := auth.LoadKeyFromFile(os.Getenv("JWT_PUBLIC_KEY_FILE"))
+		if loadErr != nil {
+			logger.Error("failed to load JWT public key file", "error", loadErr)
+			os.Exit(1)
+		}
+		jwtCfg.PublicKeyPEM = string(keyData)
+	default:
+		jwtSecret := os.Getenv("JWT_SECRET")
+		if jwtSecret == "" {
+			jwtSecret = "test-e2e-secret" // Match gateway default for E2E tests
+		}
+		jwtCfg.Secret = jwtSecret
+	}
+	jwtSvc, err := auth.NewJWTService(jwtCfg)
+	if err != nil {
+		logger.Error("failed to initialize JWT service", "error", err)
+		os.Exit(1)
+	}
+
+	// Provisioning hooks, each enabled by its configuration.
+	var hooks []port.ProvisioningHook
+	if len(cfg.Provisioning.Topics) > 0 {
+		hooks = append(hooks, provisioning.NewTopicHook(cfg.Kafka.Brokers, cfg.Provisioning.Topics,
+			cfg.Provisioning.TopicPartitions, cfg.Provisioning.TopicReplication))
+	}
+	if cfg.Provisioning.SchemaDSN != "" {
+		hooks = append(hooks, provisioning.NewSchemaHook(cfg.Provisioning.SchemaDSN))
+	}
+	if cfg.Provisioning.DefaultProductsFile != "" && cfg.Provisioning.DepositServiceAddr != "" {
+		products, loadErr := provisioning.LoadDepositProducts(cfg.Provisioning.DefaultProductsFile)
+		if loadErr != nil {
+			logger.Error("failed to load default deposit products", "error", loadErr)
+			os.Exit(1)
+		}
+		// Products are created as the new tenant, which has no caller
+		// token, so the hooks sign their own like the gateway's.
+		signer, signerErr := newTokenSigner(cfg.Provisioning.SigningKeyFile, jwtCfg.Secret)
+		if signerErr != nil {
+			logger.Error("failed to initialize provisioning token signer", "error", signerErr)
+			os.Exit(1)
+		}
+		depositConn, dialErr := grpc.NewClient(cfg.Provisioning.DepositServiceAddr,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if dialErr != nil {
+			logger.Error("failed to create deposit service client", "addr", cfg.Provisioning.DepositServiceAddr, "error", dialErr)
+			os.Exit(1)
+		}
+		lc.OnStop(lifecycle.PhaseResources, "deposit client", lifecycle.Close(depositConn.Close))
+		for _, product := range products {
+			hooks = append(hooks, provisioning.NewDepositProductHook(depositConn, signer, product))
+		}
+	}
+	provisioner := usecase.NewProvisioner(tenantRepo, eventPublisher, hooks, cfg.Provisioning.HookTimeout, logger)
+
+	// Wire use cases.
+	createTenantUC := usecase.NewCreateTenantUseCase(tenantRepo, eventPublisher, provisioner)
+	getTenantUC := usecase.NewGetTenantUseCase(tenantRepo)
+	listTenantsUC := usecase.NewListTenantsUseCase(tenantRepo)
+	configureTenantUC := usecase.NewConfigureTenantUseCase(tenantRepo, eventPublisher)
+	setFeatureFlagUC := usecase.NewSetFeatureFlagUseCase(tenantRepo, eventPublisher)
+	setLimitUC := usecase.NewSetLimitUseCase(tenantRepo, eventPublisher)
+	suspendTenantUC := usecase.NewSuspendTenantUseCase(tenantRepo, eventPublisher)
+	reactivateTenantUC := usecase.NewReactivateTenantUseCase(tenantRepo, eventPublisher)
+	provisionTenantUC := usecase.NewProvisionTenantUseCase(tenantRepo, provisioner)
+	getTenantConfigUC := usecase.NewGetTenantConfigUseCase(tenantRepo)
+
+	// gRPC server.
+	grpcHandler := grpcpresentation.NewTenantServiceHandler(
+		createTenantUC, getTenantUC, listTenantsUC, configureTenantUC, setFeatureFlagUC,
+		setLimitUC, suspendTenantUC, reactivateTenantUC, provisionTenantUC,
+		getTenantConfigUC, logger,
+	)
+	grpcServer, err := grpcpresentation.NewServer(grpcHandler, logger, jwtSvc)
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+
+	// HTTP server (health checks).
+	healthHandler := rest.NewHealthHandler(logger)
+	httpMux := http.NewServeMux()
+	healthHandler.RegisterRoutes(httpMux)
+
+	httpServer := &http.Server{
+		Addr:              cfg.HTTPAddr(),
+		Handler:           httpMux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Start servers.
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", func(context.Context) error {
+		return grpcServer.Start(cfg.GRPCAddr())
+	})
+	lc.OnStop(lifecycle.PhaseIngress, "gRPC server", lifecycle.Func(grpcServer.Stop))
+	lc.ServeHTTP("HTTP server", httpServer)
+
+	logger.Info("tenant-service is running",
+		"grpc_addr", cfg.GRPCAddr(),
+		"http_addr", cfg.HTTPAddr(),
+		"provisioning_hooks", provisioner.HookNames(),
+	)
+
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
+	}
+	logger.Info("tenant-service stopped")
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// newTokenSigner creates the signer for tokens provisioning hooks call other
+// services with: the gateway's private key when keyFile is set, otherwise
+// the shared JWT secret.
+func newTokenSigner(keyFile, secret string) (*auth.JWTService, error) {
+	cfg := auth.JWTConfig{
+		Secret:     secret,
+		Issuer:     "bib-gateway",
+		Expiration: 15 * time.Minute,
+	}
+	if keyFile != "" {
+		keyData, err := auth.LoadKeyFromFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load signing key: %w", err)
+		}
+		cfg = auth.JWTConfig{
+			PrivateKeyPEM: string(keyData),
+			Issuer:        "bib-gateway",
+			Expiration:    15 * time.Minute,
+		}
+	}
+	return auth.NewJWTService(cfg)
+}
@@ -0,0 +1,97 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TenantSettingsDTO holds the configurable fields of a tenant.
+type TenantSettingsDTO struct {
+	Name         string `json:"name"`
+	BaseCurrency string `json:"base_currency"`
+	Jurisdiction string `json:"jurisdiction"`
+}
+
+// CreateTenantRequest is the input DTO for creating a tenant.
+type CreateTenantRequest struct {
+	Slug     string            `json:"slug"`
+	Settings TenantSettingsDTO `json:"settings"`
+}
+
+// ConfigureTenantRequest is the input DTO for replacing a tenant's settings.
+type ConfigureTenantRequest struct {
+	Settings TenantSettingsDTO `json:"settings"`
+	TenantID uuid.UUID         `json:"tenant_id"`
+}
+
+// SetFeatureFlagRequest is the input DTO for turning a feature on or off.
+type SetFeatureFlagRequest struct {
+	Key      string    `json:"key"`
+	Enabled  bool      `json:"enabled"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+// SetLimitRequest is the input DTO for setting or removing a limit. A nil
+// Value removes the limit.
+type SetLimitRequest struct {
+	Value    *decimal.Decimal `json:"value"`
+	Key      string           `json:"key"`
+	TenantID uuid.UUID        `json:"tenant_id"`
+}
+
+// SuspendTenantRequest is the input DTO for suspending a tenant.
+type SuspendTenantRequest struct {
+	Reason   string    `json:"reason"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+// ListTenantsRequest is the input DTO for listing tenants with pagination.
+type ListTenantsRequest struct {
+	Status string `json:"status"`
+	Limit  int    `json:"limit"`
+	Offset int    `json:"offset"`
+}
+
+// ProvisioningStepDTO is the outcome of a provisioning hook for a tenant.
+type ProvisioningStepDTO struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	Hook      string    `json:"hook"`
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// TenantResponse is the output DTO for a tenant.
+type TenantResponse struct {
+	CreatedAt     time.Time                  `json:"created_at"`
+	UpdatedAt     time.Time                  `json:"updated_at"`
+	FeatureFlags  map[string]bool            `json:"feature_flags"`
+	Limits        map[string]decimal.Decimal `json:"limits"`
+	Settings      TenantSettingsDTO          `json:"settings"`
+	Slug          string                     `json:"slug"`
+	Status        string                     `json:"status"`
+	SuspendReason string                     `json:"suspend_reason,omitempty"`
+	Provisioning  []ProvisioningStepDTO      `json:"provisioning"`
+	Version       int                        `json:"version"`
+	ID            uuid.UUID                  `json:"id"`
+}
+
+// ListTenantsResponse is the output DTO for listing tenants.
+type ListTenantsResponse struct {
+	Tenants    []TenantResponse `json:"tenants"`
+	TotalCount int              `json:"total_count"`
+}
+
+// TenantConfigResponse is the configuration other services consult for a
+// tenant: whether it may operate, and its feature flags and limits.
+type TenantConfigResponse struct {
+	FeatureFlags map[string]bool            `json:"feature_flags"`
+	Limits       map[string]decimal.Decimal `json:"limits"`
+	Status       string                     `json:"status"`
+	BaseCurrency string                     `json:"base_currency"`
+	Jurisdiction string                     `json:"jurisdiction"`
+	Active       bool                       `json:"active"`
+	Version      int                        `json:"version"`
+	TenantID     uuid.UUID                  `json:"tenant_id"`
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/tenant-service/internal/application/dto"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/model"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/port"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/valueobject"
+)
+
+// errHookNotConfigured is recorded for a tenant's pending hook that this
+// service no longer runs, so the operator can see why it never completes.
+var errHookNotConfigured = errors.New("provisioning hook is not configured")
+
+// Provisioner runs the provisioning hooks a tenant has not yet completed,
+// saving the tenant after each so progress survives a crash.
+type Provisioner struct {
+	tenants   port.TenantRepository
+	publisher port.EventPublisher
+	logger    *slog.Logger
+	hooks     []port.ProvisioningHook
+	timeout   time.Duration
+}
+
+// NewProvisioner creates a new Provisioner running the hooks in order, each
+// bounded by timeout when it is positive.
+func NewProvisioner(
+	tenants port.TenantRepository,
+	publisher port.EventPublisher,
+	hooks []port.ProvisioningHook,
+	timeout time.Duration,
+	logger *slog.Logger,
+) *Provisioner {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Provisioner{tenants: tenants, publisher: publisher, hooks: hooks, timeout: timeout, logger: logger}
+}
+
+// HookNames returns the names of the hooks a new tenant is provisioned by.
+func (p *Provisioner) HookNames() []string {
+	names := make([]string, 0, len(p.hooks))
+	for _, hook := range p.hooks {
+		names = append(names, hook.Name())
+	}
+	return names
+}
+
+// Provision runs the tenant's pending hooks and records their outcomes. A
+// failed hook is recorded on the tenant rather than returned; an error means
+// an outcome could not be saved.
+func (p *Provisioner) Provision(ctx context.Context, tenant model.Tenant) (model.Tenant, error) {
+	for _, name := range tenant.PendingHooks() {
+		hookErr := p.run(ctx, name, tenant)
+		if hookErr != nil {
+			p.logger.Warn("tenant provisioning hook failed",
+				"tenant_id", tenant.ID(), "hook", name, "error", hookErr)
+		}
+
+		recorded, err := tenant.RecordProvisioning(name, hookErr, time.Now().UTC())
+		if err != nil {
+			return tenant, fmt.Errorf("failed to record provisioning: %w", err)
+		}
+		if err := saveAndPublish(ctx, p.tenants, p.publisher, recorded); err != nil {
+			return tenant, err
+		}
+		tenant = recorded.ClearDomainEvents()
+	}
+	return tenant, nil
+}
+
+func (p *Provisioner) run(ctx context.Context, name string, tenant model.Tenant) error {
+	for _, hook := range p.hooks {
+		if hook.Name() != name {
+			continue
+		}
+		if p.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, p.timeout)
+			defer cancel()
+		}
+		return hook.Provision(ctx, tenant)
+	}
+	return errHookNotConfigured
+}
+
+// ProvisionTenantUseCase retries the provisioning hooks a tenant has not
+// yet completed.
+type ProvisionTenantUseCase struct {
+	tenants     port.TenantRepository
+	provisioner *Provisioner
+}
+
+// NewProvisionTenantUseCase creates a new ProvisionTenantUseCase.
+func NewProvisionTenantUseCase(tenants port.TenantRepository, provisioner *Provisioner) *ProvisionTenantUseCase {
+	return &ProvisionTenantUseCase{tenants: tenants, provisioner: provisioner}
+}
+
+// Execute runs the tenant's pending hooks. Only a PROVISIONING tenant can be
+// provisioned.
+func (uc *ProvisionTenantUseCase) Execute(ctx context.Context, tenantID uuid.UUID) (dto.TenantResponse, error) {
+	tenant, err := uc.tenants.FindByID(ctx, tenantID)
+	if err != nil {
+		return dto.TenantResponse{}, fmt.Errorf("failed to find tenant: %w", err)
+	}
+	if !tenant.Status().Equal(valueobject.TenantStatusProvisioning) {
+		return dto.TenantResponse{}, fmt.Errorf("cannot provision %s tenant %s: %w", tenant.Status(), tenant.ID(), model.ErrInvalidTransition)
+	}
+	tenant, err = uc.provisioner.Provision(ctx, tenant)
+	if err != nil {
+		return dto.TenantResponse{}, err
+	}
+	return toTenantResponse(tenant), nil
+}
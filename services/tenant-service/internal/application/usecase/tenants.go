@@ -0,0 +1,315 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/tenant-service/internal/application/dto"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/model"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/port"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/valueobject"
+)
+
+// ErrInvalidTenant is returned when a tenant, or a change to it, is malformed.
+var ErrInvalidTenant = errors.New("invalid tenant")
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// CreateTenantUseCase creates a tenant and provisions it.
+type CreateTenantUseCase struct {
+	tenants     port.TenantRepository
+	publisher   port.EventPublisher
+	provisioner *Provisioner
+}
+
+// NewCreateTenantUseCase creates a new CreateTenantUseCase.
+func NewCreateTenantUseCase(tenants port.TenantRepository, publisher port.EventPublisher, provisioner *Provisioner) *CreateTenantUseCase {
+	return &CreateTenantUseCase{tenants: tenants, publisher: publisher, provisioner: provisioner}
+}
+
+// Execute creates the tenant and runs every provisioning hook for it. A
+// failed hook does not fail creation; the tenant stays PROVISIONING with the
+// failure recorded until provisioning is retried.
+func (uc *CreateTenantUseCase) Execute(ctx context.Context, req dto.CreateTenantRequest) (dto.TenantResponse, error) {
+	tenant, err := model.NewTenant(req.Slug, toSettings(req.Settings), uc.provisioner.HookNames(), time.Now().UTC())
+	if err != nil {
+		return dto.TenantResponse{}, fmt.Errorf("%w: %w", ErrInvalidTenant, err)
+	}
+	if err := saveAndPublish(ctx, uc.tenants, uc.publisher, tenant); err != nil {
+		return dto.TenantResponse{}, err
+	}
+	tenant, err = uc.provisioner.Provision(ctx, tenant.ClearDomainEvents())
+	if err != nil {
+		return dto.TenantResponse{}, err
+	}
+	return toTenantResponse(tenant), nil
+}
+
+// GetTenantUseCase retrieves a tenant.
+type GetTenantUseCase struct {
+	tenants port.TenantRepository
+}
+
+// NewGetTenantUseCase creates a new GetTenantUseCase.
+func NewGetTenantUseCase(tenants port.TenantRepository) *GetTenantUseCase {
+	return &GetTenantUseCase{tenants: tenants}
+}
+
+// Execute retrieves the tenant.
+func (uc *GetTenantUseCase) Execute(ctx context.Context, tenantID uuid.UUID) (dto.TenantResponse, error) {
+	tenant, err := uc.tenants.FindByID(ctx, tenantID)
+	if err != nil {
+		return dto.TenantResponse{}, fmt.Errorf("failed to find tenant: %w", err)
+	}
+	return toTenantResponse(tenant), nil
+}
+
+// ListTenantsUseCase lists tenants with pagination.
+type ListTenantsUseCase struct {
+	tenants port.TenantRepository
+}
+
+// NewListTenantsUseCase creates a new ListTenantsUseCase.
+func NewListTenantsUseCase(tenants port.TenantRepository) *ListTenantsUseCase {
+	return &ListTenantsUseCase{tenants: tenants}
+}
+
+// Execute lists tenants ordered by slug, optionally only those with the
+// requested status.
+func (uc *ListTenantsUseCase) Execute(ctx context.Context, req dto.ListTenantsRequest) (dto.ListTenantsResponse, error) {
+	var status valueobject.TenantStatus
+	if req.Status != "" {
+		s, err := valueobject.NewTenantStatus(req.Status)
+		if err != nil {
+			return dto.ListTenantsResponse{}, fmt.Errorf("%w: %w", ErrInvalidTenant, err)
+		}
+		status = s
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	limit = min(limit, maxLimit)
+	offset := max(req.Offset, 0)
+
+	tenants, total, err := uc.tenants.List(ctx, status, limit, offset)
+	if err != nil {
+		return dto.ListTenantsResponse{}, fmt.Errorf("failed to list tenants: %w", err)
+	}
+	responses := make([]dto.TenantResponse, 0, len(tenants))
+	for _, t := range tenants {
+		responses = append(responses, toTenantResponse(t))
+	}
+	return dto.ListTenantsResponse{Tenants: responses, TotalCount: total}, nil
+}
+
+// ConfigureTenantUseCase replaces a tenant's settings.
+type ConfigureTenantUseCase struct {
+	tenants   port.TenantRepository
+	publisher port.EventPublisher
+}
+
+// NewConfigureTenantUseCase creates a new ConfigureTenantUseCase.
+func NewConfigureTenantUseCase(tenants port.TenantRepository, publisher port.EventPublisher) *ConfigureTenantUseCase {
+	return &ConfigureTenantUseCase{tenants: tenants, publisher: publisher}
+}
+
+// Execute replaces the tenant's settings.
+func (uc *ConfigureTenantUseCase) Execute(ctx context.Context, req dto.ConfigureTenantRequest) (dto.TenantResponse, error) {
+	settings := toSettings(req.Settings)
+	return modifyTenant(ctx, uc.tenants, uc.publisher, req.TenantID, func(t model.Tenant) (model.Tenant, error) {
+		return t.Configure(settings, time.Now().UTC())
+	})
+}
+
+// SetFeatureFlagUseCase turns a feature on or off for a tenant.
+type SetFeatureFlagUseCase struct {
+	tenants   port.TenantRepository
+	publisher port.EventPublisher
+}
+
+// NewSetFeatureFlagUseCase creates a new SetFeatureFlagUseCase.
+func NewSetFeatureFlagUseCase(tenants port.TenantRepository, publisher port.EventPublisher) *SetFeatureFlagUseCase {
+	return &SetFeatureFlagUseCase{tenants: tenants, publisher: publisher}
+}
+
+// Execute sets the feature flag.
+func (uc *SetFeatureFlagUseCase) Execute(ctx context.Context, req dto.SetFeatureFlagRequest) (dto.TenantResponse, error) {
+	return modifyTenant(ctx, uc.tenants, uc.publisher, req.TenantID, func(t model.Tenant) (model.Tenant, error) {
+		return t.SetFeatureFlag(req.Key, req.Enabled, time.Now().UTC())
+	})
+}
+
+// SetLimitUseCase sets or removes one of a tenant's limits.
+type SetLimitUseCase struct {
+	tenants   port.TenantRepository
+	publisher port.EventPublisher
+}
+
+// NewSetLimitUseCase creates a new SetLimitUseCase.
+func NewSetLimitUseCase(tenants port.TenantRepository, publisher port.EventPublisher) *SetLimitUseCase {
+	return &SetLimitUseCase{tenants: tenants, publisher: publisher}
+}
+
+// Execute sets the limit, or removes it when no value is given.
+func (uc *SetLimitUseCase) Execute(ctx context.Context, req dto.SetLimitRequest) (dto.TenantResponse, error) {
+	return modifyTenant(ctx, uc.tenants, uc.publisher, req.TenantID, func(t model.Tenant) (model.Tenant, error) {
+		if req.Value == nil {
+			return t.RemoveLimit(req.Key, time.Now().UTC()), nil
+		}
+		return t.SetLimit(req.Key, *req.Value, time.Now().UTC())
+	})
+}
+
+// SuspendTenantUseCase suspends a tenant.
+type SuspendTenantUseCase struct {
+	tenants   port.TenantRepository
+	publisher port.EventPublisher
+}
+
+// NewSuspendTenantUseCase creates a new SuspendTenantUseCase.
+func NewSuspendTenantUseCase(tenants port.TenantRepository, publisher port.EventPublisher) *SuspendTenantUseCase {
+	return &SuspendTenantUseCase{tenants: tenants, publisher: publisher}
+}
+
+// Execute suspends the tenant.
+func (uc *SuspendTenantUseCase) Execute(ctx context.Context, req dto.SuspendTenantRequest) (dto.TenantResponse, error) {
+	return modifyTenant(ctx, uc.tenants, uc.publisher, req.TenantID, func(t model.Tenant) (model.Tenant, error) {
+		return t.Suspend(req.Reason, time.Now().UTC())
+	})
+}
+
+// ReactivateTenantUseCase reactivates a suspended tenant.
+type ReactivateTenantUseCase struct {
+	tenants   port.TenantRepository
+	publisher port.EventPublisher
+}
+
+// NewReactivateTenantUseCase creates a new ReactivateTenantUseCase.
+func NewReactivateTenantUseCase(tenants port.TenantRepository, publisher port.EventPublisher) *ReactivateTenantUseCase {
+	return &ReactivateTenantUseCase{tenants: tenants, publisher: publisher}
+}
+
+// Execute reactivates the tenant.
+func (uc *ReactivateTenantUseCase) Execute(ctx context.Context, tenantID uuid.UUID) (dto.TenantResponse, error) {
+	return modifyTenant(ctx, uc.tenants, uc.publisher, tenantID, func(t model.Tenant) (model.Tenant, error) {
+		return t.Reactivate(time.Now().UTC())
+	})
+}
+
+// GetTenantConfigUseCase returns the configuration other services consult
+// for a tenant.
+type GetTenantConfigUseCase struct {
+	tenants port.TenantRepository
+}
+
+// NewGetTenantConfigUseCase creates a new GetTenantConfigUseCase.
+func NewGetTenantConfigUseCase(tenants port.TenantRepository) *GetTenantConfigUseCase {
+	return &GetTenantConfigUseCase{tenants: tenants}
+}
+
+// Execute returns the tenant's configuration.
+func (uc *GetTenantConfigUseCase) Execute(ctx context.Context, tenantID uuid.UUID) (dto.TenantConfigResponse, error) {
+	tenant, err := uc.tenants.FindByID(ctx, tenantID)
+	if err != nil {
+		return dto.TenantConfigResponse{}, fmt.Errorf("failed to find tenant: %w", err)
+	}
+	return dto.TenantConfigResponse{
+		TenantID:     tenant.ID(),
+		Status:       tenant.Status().String(),
+		Active:       tenant.Status().Equal(valueobject.TenantStatusActive),
+		BaseCurrency: tenant.Settings().BaseCurrency,
+		Jurisdiction: tenant.Settings().Jurisdiction,
+		FeatureFlags: tenant.FeatureFlags(),
+		Limits:       tenant.Limits(),
+		Version:      tenant.Version(),
+	}, nil
+}
+
+// modifyTenant loads a tenant, applies a change and saves and publishes the
+// result. Changes not allowed in the tenant's status fail with
+// model.ErrInvalidTransition; other rejected changes with ErrInvalidTenant.
+func modifyTenant(
+	ctx context.Context,
+	tenants port.TenantRepository,
+	publisher port.EventPublisher,
+	tenantID uuid.UUID,
+	change func(model.Tenant) (model.Tenant, error),
+) (dto.TenantResponse, error) {
+	tenant, err := tenants.FindByID(ctx, tenantID)
+	if err != nil {
+		return dto.TenantResponse{}, fmt.Errorf("failed to find tenant: %w", err)
+	}
+	tenant, err = change(tenant)
+	if errors.Is(err, model.ErrInvalidTransition) {
+		return dto.TenantResponse{}, err
+	}
+	if err != nil {
+		return dto.TenantResponse{}, fmt.Errorf("%w: %w", ErrInvalidTenant, err)
+	}
+	if len(tenant.DomainEvents()) == 0 {
+		return toTenantResponse(tenant), nil
+	}
+	if err := saveAndPublish(ctx, tenants, publisher, tenant); err != nil {
+		return dto.TenantResponse{}, err
+	}
+	return toTenantResponse(tenant), nil
+}
+
+// saveAndPublish saves the tenant, then publishes its events.
+func saveAndPublish(ctx context.Context, tenants port.TenantRepository, publisher port.EventPublisher, tenant model.Tenant) error {
+	if err := tenants.Save(ctx, tenant); err != nil {
+		return fmt.Errorf("failed to save tenant: %w", err)
+	}
+	if events := tenant.DomainEvents(); len(events) > 0 {
+		if err := publisher.Publish(ctx, events); err != nil {
+			return fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+	return nil
+}
+
+func toSettings(s dto.TenantSettingsDTO) model.TenantSettings {
+	return model.TenantSettings{
+		Name:         s.Name,
+		BaseCurrency: s.BaseCurrency,
+		Jurisdiction: s.Jurisdiction,
+	}
+}
+
+func toTenantResponse(t model.Tenant) dto.TenantResponse {
+	provisioning := make([]dto.ProvisioningStepDTO, 0, len(t.Provisioning()))
+	for _, step := range t.Provisioning() {
+		provisioning = append(provisioning, dto.ProvisioningStepDTO{
+			Hook:      step.Hook,
+			Status:    step.Status.String(),
+			Error:     step.Error,
+			UpdatedAt: step.UpdatedAt,
+		})
+	}
+	s := t.Settings()
+	return dto.TenantResponse{
+		ID:   t.ID(),
+		Slug: t.Slug(),
+		Settings: dto.TenantSettingsDTO{
+			Name:         s.Name,
+			BaseCurrency: s.BaseCurrency,
+			Jurisdiction: s.Jurisdiction,
+		},
+		Status:        t.Status().String(),
+		SuspendReason: t.SuspendReason(),
+		FeatureFlags:  t.FeatureFlags(),
+		Limits:        t.Limits(),
+		Provisioning:  provisioning,
+		Version:       t.Version(),
+		CreatedAt:     t.CreatedAt(),
+		UpdatedAt:     t.UpdatedAt(),
+	}
+}
@@ -0,0 +1,166 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/tenant-service/internal/application/dto"
+	"github.com/bibbank/bib/services/tenant-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/model"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/port"
+)
+
+func createRequest(slug string) dto.CreateTenantRequest {
+	return dto.CreateTenantRequest{
+		Slug:     slug,
+		Settings: dto.TenantSettingsDTO{Name: "Acme Bank", BaseCurrency: "GBP", Jurisdiction: "GB"},
+	}
+}
+
+func TestCreateTenant_ProvisionsAndActivates(t *testing.T) {
+	repo := &inMemoryTenantRepo{}
+	pub := &recordingPublisher{}
+	topics, schema := &fakeHook{name: "kafka_topics"}, &fakeHook{name: "postgres_schema"}
+	provisioner := usecase.NewProvisioner(repo, pub, []port.ProvisioningHook{topics, schema}, 0, nil)
+	uc := usecase.NewCreateTenantUseCase(repo, pub, provisioner)
+
+	resp, err := uc.Execute(context.Background(), createRequest("acme-bank"))
+	require.NoError(t, err)
+	assert.Equal(t, "ACTIVE", resp.Status)
+	require.Len(t, resp.Provisioning, 2)
+	assert.Equal(t, "SUCCEEDED", resp.Provisioning[1].Status)
+	assert.Equal(t, []string{"tenant.created", "tenant.activated"}, pub.eventTypes())
+
+	stored, err := repo.FindByID(context.Background(), resp.ID)
+	require.NoError(t, err)
+	assert.Equal(t, resp.Version, stored.Version())
+
+	_, err = uc.Execute(context.Background(), createRequest("acme-bank"))
+	assert.ErrorIs(t, err, port.ErrSlugTaken)
+
+	_, err = uc.Execute(context.Background(), createRequest("Acme"))
+	assert.ErrorIs(t, err, usecase.ErrInvalidTenant)
+}
+
+func TestProvisionTenant_RetriesFailedHooks(t *testing.T) {
+	repo := &inMemoryTenantRepo{}
+	pub := &recordingPublisher{}
+	topics := &fakeHook{name: "kafka_topics"}
+	schema := &fakeHook{name: "postgres_schema", err: errors.New("connection refused")}
+	provisioner := usecase.NewProvisioner(repo, pub, []port.ProvisioningHook{topics, schema}, 0, nil)
+
+	created, err := usecase.NewCreateTenantUseCase(repo, pub, provisioner).Execute(context.Background(), createRequest("acme-bank"))
+	require.NoError(t, err, "a failed hook does not fail creation")
+	assert.Equal(t, "PROVISIONING", created.Status)
+	assert.Equal(t, "FAILED", created.Provisioning[1].Status)
+	assert.Equal(t, "connection refused", created.Provisioning[1].Error)
+
+	schema.err = nil
+	uc := usecase.NewProvisionTenantUseCase(repo, provisioner)
+	resp, err := uc.Execute(context.Background(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "ACTIVE", resp.Status)
+	assert.Equal(t, 1, topics.calls, "succeeded hooks are not run again")
+	assert.Equal(t, 2, schema.calls)
+	assert.Equal(t, []string{"tenant.created", "tenant.provisioning_failed", "tenant.activated"}, pub.eventTypes())
+
+	_, err = uc.Execute(context.Background(), created.ID)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition, "an active tenant is not provisioned again")
+}
+
+func TestProvisionTenant_RecordsUnconfiguredHook(t *testing.T) {
+	repo := &inMemoryTenantRepo{}
+	pub := &recordingPublisher{}
+	hook := &fakeHook{name: "kafka_topics", err: errors.New("broker unavailable")}
+	created, err := usecase.NewCreateTenantUseCase(repo, pub,
+		usecase.NewProvisioner(repo, pub, []port.ProvisioningHook{hook}, 0, nil),
+	).Execute(context.Background(), createRequest("acme-bank"))
+	require.NoError(t, err)
+
+	// The hook is no longer configured when provisioning is retried.
+	resp, err := usecase.NewProvisionTenantUseCase(repo, usecase.NewProvisioner(repo, pub, nil, 0, nil)).
+		Execute(context.Background(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "PROVISIONING", resp.Status)
+	assert.Contains(t, resp.Provisioning[0].Error, "not configured")
+}
+
+func TestTenantConfig_ReflectsFlagsLimitsAndSuspension(t *testing.T) {
+	ctx := context.Background()
+	repo := &inMemoryTenantRepo{}
+	pub := &recordingPublisher{}
+	created, err := usecase.NewCreateTenantUseCase(repo, pub, usecase.NewProvisioner(repo, pub, nil, 0, nil)).
+		Execute(ctx, createRequest("acme-bank"))
+	require.NoError(t, err)
+
+	_, err = usecase.NewSetFeatureFlagUseCase(repo, pub).Execute(ctx, dto.SetFeatureFlagRequest{
+		TenantID: created.ID, Key: "payments.sepa", Enabled: true,
+	})
+	require.NoError(t, err)
+	limit := decimal.NewFromInt(50000)
+	_, err = usecase.NewSetLimitUseCase(repo, pub).Execute(ctx, dto.SetLimitRequest{
+		TenantID: created.ID, Key: "payment.max_amount", Value: &limit,
+	})
+	require.NoError(t, err)
+	_, err = usecase.NewSuspendTenantUseCase(repo, pub).Execute(ctx, dto.SuspendTenantRequest{
+		TenantID: created.ID, Reason: "unpaid invoices",
+	})
+	require.NoError(t, err)
+
+	config, err := usecase.NewGetTenantConfigUseCase(repo).Execute(ctx, created.ID)
+	require.NoError(t, err)
+	assert.False(t, config.Active)
+	assert.Equal(t, "SUSPENDED", config.Status)
+	assert.True(t, config.FeatureFlags["payments.sepa"])
+	assert.True(t, limit.Equal(config.Limits["payment.max_amount"]))
+
+	_, err = usecase.NewSuspendTenantUseCase(repo, pub).Execute(ctx, dto.SuspendTenantRequest{
+		TenantID: created.ID, Reason: "again",
+	})
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+
+	removed, err := usecase.NewSetLimitUseCase(repo, pub).Execute(ctx, dto.SetLimitRequest{
+		TenantID: created.ID, Key: "payment.max_amount",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, removed.Limits)
+
+	_, err = usecase.NewGetTenantConfigUseCase(repo).Execute(ctx, uuid.New())
+	assert.ErrorIs(t, err, port.ErrTenantNotFound)
+}
+
+func TestListTenants_FiltersByStatus(t *testing.T) {
+	ctx := context.Background()
+	repo := &inMemoryTenantRepo{}
+	pub := &recordingPublisher{}
+	create := usecase.NewCreateTenantUseCase(repo, pub, usecase.NewProvisioner(repo, pub, nil, 0, nil))
+	for _, slug := range []string{"zeta-bank", "acme-bank", "beta-bank"} {
+		_, err := create.Execute(ctx, createRequest(slug))
+		require.NoError(t, err)
+	}
+	beta, err := repo.FindBySlug(ctx, "beta-bank")
+	require.NoError(t, err)
+	_, err = usecase.NewSuspendTenantUseCase(repo, pub).Execute(ctx, dto.SuspendTenantRequest{TenantID: beta.ID(), Reason: "review"})
+	require.NoError(t, err)
+
+	uc := usecase.NewListTenantsUseCase(repo)
+	all, err := uc.Execute(ctx, dto.ListTenantsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, all.TotalCount)
+	assert.Equal(t, "acme-bank", all.Tenants[0].Slug)
+
+	active, err := uc.Execute(ctx, dto.ListTenantsRequest{Status: "ACTIVE", Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 2, active.TotalCount)
+	require.Len(t, active.Tenants, 1)
+	assert.Equal(t, "zeta-bank", active.Tenants[0].Slug)
+
+	_, err = uc.Execute(ctx, dto.ListTenantsRequest{Status: "CLOSED"})
+	assert.ErrorIs(t, err, usecase.ErrInvalidTenant)
+}
@@ -0,0 +1,112 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/event"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/model"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/port"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/valueobject"
+)
+
+type inMemoryTenantRepo struct {
+	tenants []model.Tenant
+}
+
+func (r *inMemoryTenantRepo) Save(_ context.Context, tenant model.Tenant) error {
+	tenant = tenant.ClearDomainEvents()
+	i := slices.IndexFunc(r.tenants, func(existing model.Tenant) bool { return existing.ID() == tenant.ID() })
+	if i < 0 {
+		if slices.ContainsFunc(r.tenants, func(existing model.Tenant) bool { return existing.Slug() == tenant.Slug() }) {
+			return port.ErrSlugTaken
+		}
+		r.tenants = append(r.tenants, tenant)
+		return nil
+	}
+	if r.tenants[i].Version() != tenant.Version()-1 {
+		return port.ErrVersionConflict
+	}
+	r.tenants[i] = tenant
+	return nil
+}
+
+func (r *inMemoryTenantRepo) FindByID(_ context.Context, id uuid.UUID) (model.Tenant, error) {
+	for _, t := range r.tenants {
+		if t.ID() == id {
+			return t, nil
+		}
+	}
+	return model.Tenant{}, port.ErrTenantNotFound
+}
+
+func (r *inMemoryTenantRepo) FindBySlug(_ context.Context, slug string) (model.Tenant, error) {
+	for _, t := range r.tenants {
+		if t.Slug() == slug {
+			return t, nil
+		}
+	}
+	return model.Tenant{}, port.ErrTenantNotFound
+}
+
+func (r *inMemoryTenantRepo) List(_ context.Context, status valueobject.TenantStatus, limit, offset int) ([]model.Tenant, int, error) {
+	var matching []model.Tenant
+	for _, t := range r.tenants {
+		if status.IsZero() || t.Status().Equal(status) {
+			matching = append(matching, t)
+		}
+	}
+	slices.SortFunc(matching, func(a, b model.Tenant) int {
+		switch {
+		case a.Slug() < b.Slug():
+			return -1
+		case a.Slug() > b.Slug():
+			return 1
+		}
+		return 0
+	})
+	total := len(matching)
+	matching = matching[min(offset, total):]
+	return matching[:min(limit, len(matching))], total, nil
+}
+
+type recordingPublisher struct {
+	mu     sync.Mutex
+	events []event.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, events []event.DomainEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, events...)
+	return nil
+}
+
+func (p *recordingPublisher) eventTypes() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	types := make([]string, 0, len(p.events))
+	for _, e := range p.events {
+		types = append(types, e.EventType())
+	}
+	return types
+}
+
+// fakeHook is a provisioning hook that fails while err is set.
+type fakeHook struct {
+	err   error
+	name  string
+	calls int
+}
+
+func (h *fakeHook) Name() string {
+	return h.name
+}
+
+func (h *fakeHook) Provision(_ context.Context, _ model.Tenant) error {
+	h.calls++
+	return h.err
+}
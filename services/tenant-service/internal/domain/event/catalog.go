@@ -0,0 +1,16 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[TenantCreated]("tenant.created", 1, "Emitted when a tenant is created, before it is provisioned."),
+		events.Declare[TenantActivated]("tenant.activated", 1, "Emitted when every provisioning hook has succeeded for a tenant and it can be used."),
+		events.Declare[TenantProvisioningFailed]("tenant.provisioning_failed", 1, "Emitted when a provisioning hook fails for a tenant."),
+		events.Declare[TenantConfigured]("tenant.configured", 1, "Emitted when a tenant's settings, feature flags or limits change."),
+		events.Declare[TenantSuspended]("tenant.suspended", 1, "Emitted when a tenant is suspended."),
+		events.Declare[TenantReactivated]("tenant.reactivated", 1, "Emitted when a suspended tenant is reactivated."),
+	}
+}
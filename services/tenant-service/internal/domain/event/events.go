@@ -0,0 +1,100 @@
+package event
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// DomainEvent is an alias for the shared pkg/events.DomainEvent interface.
+type DomainEvent = events.DomainEvent
+
+// AggregateTypeTenant is the aggregate type of tenant events.
+const AggregateTypeTenant = "Tenant"
+
+// TenantCreated is emitted when a tenant is created, before it is provisioned.
+type TenantCreated struct {
+	events.BaseEvent
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+// NewTenantCreated creates a new TenantCreated event.
+func NewTenantCreated(tenantID uuid.UUID, slug, name string) TenantCreated {
+	return TenantCreated{
+		BaseEvent: events.NewBaseEvent("tenant.created", tenantID.String(), AggregateTypeTenant, tenantID.String()),
+		Slug:      slug,
+		Name:      name,
+	}
+}
+
+// TenantActivated is emitted when every provisioning hook has succeeded for
+// a tenant and it can be used.
+type TenantActivated struct {
+	events.BaseEvent
+}
+
+// NewTenantActivated creates a new TenantActivated event.
+func NewTenantActivated(tenantID uuid.UUID) TenantActivated {
+	return TenantActivated{
+		BaseEvent: events.NewBaseEvent("tenant.activated", tenantID.String(), AggregateTypeTenant, tenantID.String()),
+	}
+}
+
+// TenantProvisioningFailed is emitted when a provisioning hook fails for a
+// tenant. The hook is retried when provisioning is run again.
+type TenantProvisioningFailed struct {
+	events.BaseEvent
+	Hook  string `json:"hook"`
+	Error string `json:"error"`
+}
+
+// NewTenantProvisioningFailed creates a new TenantProvisioningFailed event.
+func NewTenantProvisioningFailed(tenantID uuid.UUID, hook, errMsg string) TenantProvisioningFailed {
+	return TenantProvisioningFailed{
+		BaseEvent: events.NewBaseEvent("tenant.provisioning_failed", tenantID.String(), AggregateTypeTenant, tenantID.String()),
+		Hook:      hook,
+		Error:     errMsg,
+	}
+}
+
+// TenantConfigured is emitted when a tenant's settings, feature flags or
+// limits change. Services caching tenant configuration refresh it.
+type TenantConfigured struct {
+	events.BaseEvent
+	Version int `json:"version"`
+}
+
+// NewTenantConfigured creates a new TenantConfigured event.
+func NewTenantConfigured(tenantID uuid.UUID, version int) TenantConfigured {
+	return TenantConfigured{
+		BaseEvent: events.NewBaseEvent("tenant.configured", tenantID.String(), AggregateTypeTenant, tenantID.String()),
+		Version:   version,
+	}
+}
+
+// TenantSuspended is emitted when a tenant is suspended.
+type TenantSuspended struct {
+	events.BaseEvent
+	Reason string `json:"reason"`
+}
+
+// NewTenantSuspended creates a new TenantSuspended event.
+func NewTenantSuspended(tenantID uuid.UUID, reason string) TenantSuspended {
+	return TenantSuspended{
+		BaseEvent: events.NewBaseEvent("tenant.suspended", tenantID.String(), AggregateTypeTenant, tenantID.String()),
+		Reason:    reason,
+	}
+}
+
+// TenantReactivated is emitted when a suspended tenant is reactivated.
+type TenantReactivated struct {
+	events.BaseEvent
+}
+
+// NewTenantReactivated creates a new TenantReactivated event.
+func NewTenantReactivated(tenantID uuid.UUID) TenantReactivated {
+	return TenantReactivated{
+		BaseEvent: events.NewBaseEvent("tenant.reactivated", tenantID.String(), AggregateTypeTenant, tenantID.String()),
+	}
+}
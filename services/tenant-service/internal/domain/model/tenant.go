@@ -0,0 +1,299 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/event"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/valueobject"
+)
+
+var (
+	slugRE     = regexp.MustCompile(`^[a-z][a-z0-9-]{1,38}[a-z0-9]$`)
+	currencyRE = regexp.MustCompile(`^[A-Z]{3}$`)
+	countryRE  = regexp.MustCompile(`^[A-Z]{2}$`)
+	keyRE      = regexp.MustCompile(`^[a-z][a-z0-9_.]{0,63}$`)
+)
+
+// ErrInvalidTransition is returned when a tenant cannot move to the
+// requested status from its current one.
+var ErrInvalidTransition = errors.New("invalid tenant status transition")
+
+// TenantSettings are the configurable fields of a tenant.
+type TenantSettings struct {
+	Name string
+	// BaseCurrency is an ISO 4217 code.
+	BaseCurrency string
+	// Jurisdiction is the ISO 3166-1 alpha-2 code of the country whose
+	// regulator the tenant reports to.
+	Jurisdiction string
+}
+
+// ProvisioningStep records the outcome of a provisioning hook for a tenant.
+type ProvisioningStep struct {
+	UpdatedAt time.Time
+	Hook      string
+	Status    valueobject.ProvisioningStatus
+	Error     string
+}
+
+// Tenant is a bank operating on the platform. Every other service scopes its
+// data by tenant ID. A new tenant is provisioned by running a hook per
+// resource it needs, such as its topics or default products; it becomes
+// ACTIVE once every hook has succeeded. Feature flags and limits are
+// per-tenant configuration other services consult.
+type Tenant struct {
+	createdAt     time.Time
+	updatedAt     time.Time
+	featureFlags  map[string]bool
+	limits        map[string]decimal.Decimal
+	settings      TenantSettings
+	slug          string
+	suspendReason string
+	status        valueobject.TenantStatus
+	provisioning  []ProvisioningStep
+	domainEvents  []events.DomainEvent
+	version       int
+	id            uuid.UUID
+}
+
+// NewTenant creates a tenant that is provisioned by the named hooks. Without
+// hooks it is ACTIVE straight away.
+func NewTenant(slug string, settings TenantSettings, hooks []string, now time.Time) (Tenant, error) {
+	if !slugRE.MatchString(slug) {
+		return Tenant{}, fmt.Errorf("slug %q must be 3-40 lowercase letters, digits or hyphens, starting with a letter", slug)
+	}
+	settings, err := validateSettings(settings)
+	if err != nil {
+		return Tenant{}, err
+	}
+	t := Tenant{
+		id:           uuid.New(),
+		slug:         slug,
+		settings:     settings,
+		status:       valueobject.TenantStatusProvisioning,
+		featureFlags: map[string]bool{},
+		limits:       map[string]decimal.Decimal{},
+		version:      1,
+		createdAt:    now,
+		updatedAt:    now,
+	}
+	for _, hook := range hooks {
+		t.provisioning = append(t.provisioning, ProvisioningStep{
+			Hook:      hook,
+			Status:    valueobject.ProvisioningStatusPending,
+			UpdatedAt: now,
+		})
+	}
+	t.domainEvents = append(t.domainEvents, event.NewTenantCreated(t.id, slug, settings.Name))
+	if len(hooks) == 0 {
+		t.status = valueobject.TenantStatusActive
+		t.domainEvents = append(t.domainEvents, event.NewTenantActivated(t.id))
+	}
+	return t, nil
+}
+
+// ReconstructTenant recreates a Tenant from persisted data without
+// validation or events.
+func ReconstructTenant(
+	id uuid.UUID,
+	slug string,
+	settings TenantSettings,
+	status valueobject.TenantStatus,
+	suspendReason string,
+	featureFlags map[string]bool,
+	limits map[string]decimal.Decimal,
+	provisioning []ProvisioningStep,
+	version int,
+	createdAt, updatedAt time.Time,
+) Tenant {
+	if featureFlags == nil {
+		featureFlags = map[string]bool{}
+	}
+	if limits == nil {
+		limits = map[string]decimal.Decimal{}
+	}
+	return Tenant{
+		id:            id,
+		slug:          slug,
+		settings:      settings,
+		status:        status,
+		suspendReason: suspendReason,
+		featureFlags:  featureFlags,
+		limits:        limits,
+		provisioning:  provisioning,
+		version:       version,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
+
+// Configure replaces the tenant's settings. A tenant's slug never changes.
+func (t Tenant) Configure(settings TenantSettings, now time.Time) (Tenant, error) {
+	settings, err := validateSettings(settings)
+	if err != nil {
+		return t, err
+	}
+	t.settings = settings
+	return t.configured(now), nil
+}
+
+// SetFeatureFlag turns a feature on or off for the tenant.
+func (t Tenant) SetFeatureFlag(key string, enabled bool, now time.Time) (Tenant, error) {
+	if !keyRE.MatchString(key) {
+		return t, fmt.Errorf("feature flag %q must be lowercase letters, digits, dots or underscores", key)
+	}
+	t.featureFlags = maps.Clone(t.featureFlags)
+	t.featureFlags[key] = enabled
+	return t.configured(now), nil
+}
+
+// SetLimit sets one of the tenant's limits, such as a maximum payment amount.
+func (t Tenant) SetLimit(key string, value decimal.Decimal, now time.Time) (Tenant, error) {
+	if !keyRE.MatchString(key) {
+		return t, fmt.Errorf("limit %q must be lowercase letters, digits, dots or underscores", key)
+	}
+	if value.IsNegative() {
+		return t, fmt.Errorf("limit %q must not be negative", key)
+	}
+	t.limits = maps.Clone(t.limits)
+	t.limits[key] = value
+	return t.configured(now), nil
+}
+
+// RemoveLimit removes one of the tenant's limits. Removing an unset limit
+// leaves the tenant unchanged.
+func (t Tenant) RemoveLimit(key string, now time.Time) Tenant {
+	if _, ok := t.limits[key]; !ok {
+		return t
+	}
+	t.limits = maps.Clone(t.limits)
+	delete(t.limits, key)
+	return t.configured(now)
+}
+
+// PendingHooks returns the provisioning hooks that have not yet succeeded.
+func (t Tenant) PendingHooks() []string {
+	var pending []string
+	for _, step := range t.provisioning {
+		if !step.Status.Equal(valueobject.ProvisioningStatusSucceeded) {
+			pending = append(pending, step.Hook)
+		}
+	}
+	return pending
+}
+
+// RecordProvisioning records the outcome of a provisioning hook. The tenant
+// becomes ACTIVE once every hook has succeeded.
+func (t Tenant) RecordProvisioning(hook string, hookErr error, now time.Time) (Tenant, error) {
+	if !t.status.Equal(valueobject.TenantStatusProvisioning) {
+		return t, fmt.Errorf("cannot provision %s tenant %s: %w", t.status, t.id, ErrInvalidTransition)
+	}
+	i := slices.IndexFunc(t.provisioning, func(s ProvisioningStep) bool { return s.Hook == hook })
+	if i < 0 {
+		return t, fmt.Errorf("tenant %s has no provisioning hook %q", t.id, hook)
+	}
+
+	t.provisioning = slices.Clone(t.provisioning)
+	step := ProvisioningStep{Hook: hook, Status: valueobject.ProvisioningStatusSucceeded, UpdatedAt: now}
+	if hookErr != nil {
+		step.Status = valueobject.ProvisioningStatusFailed
+		step.Error = hookErr.Error()
+		t.domainEvents = append(t.domainEvents, event.NewTenantProvisioningFailed(t.id, hook, step.Error))
+	}
+	t.provisioning[i] = step
+	t.version++
+	t.updatedAt = now
+
+	if len(t.PendingHooks()) == 0 {
+		t.status = valueobject.TenantStatusActive
+		t.domainEvents = append(t.domainEvents, event.NewTenantActivated(t.id))
+	}
+	return t, nil
+}
+
+// Suspend suspends an ACTIVE tenant. Other services refuse a suspended
+// tenant's requests until it is reactivated.
+func (t Tenant) Suspend(reason string, now time.Time) (Tenant, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return t, fmt.Errorf("a suspension reason is required")
+	}
+	if !t.status.Equal(valueobject.TenantStatusActive) {
+		return t, fmt.Errorf("cannot suspend %s tenant %s: %w", t.status, t.id, ErrInvalidTransition)
+	}
+	t.status = valueobject.TenantStatusSuspended
+	t.suspendReason = reason
+	t.version++
+	t.updatedAt = now
+	t.domainEvents = append(t.domainEvents, event.NewTenantSuspended(t.id, reason))
+	return t, nil
+}
+
+// Reactivate returns a SUSPENDED tenant to ACTIVE.
+func (t Tenant) Reactivate(now time.Time) (Tenant, error) {
+	if !t.status.Equal(valueobject.TenantStatusSuspended) {
+		return t, fmt.Errorf("cannot reactivate %s tenant %s: %w", t.status, t.id, ErrInvalidTransition)
+	}
+	t.status = valueobject.TenantStatusActive
+	t.suspendReason = ""
+	t.version++
+	t.updatedAt = now
+	t.domainEvents = append(t.domainEvents, event.NewTenantReactivated(t.id))
+	return t, nil
+}
+
+func (t Tenant) configured(now time.Time) Tenant {
+	t.version++
+	t.updatedAt = now
+	t.domainEvents = append(t.domainEvents, event.NewTenantConfigured(t.id, t.version))
+	return t
+}
+
+func validateSettings(s TenantSettings) (TenantSettings, error) {
+	s.Name = strings.TrimSpace(s.Name)
+	if s.Name == "" {
+		return s, fmt.Errorf("tenant name must not be empty")
+	}
+	if !currencyRE.MatchString(s.BaseCurrency) {
+		return s, fmt.Errorf("base currency %q must be an ISO 4217 code", s.BaseCurrency)
+	}
+	if !countryRE.MatchString(s.Jurisdiction) {
+		return s, fmt.Errorf("jurisdiction %q must be an ISO 3166-1 alpha-2 code", s.Jurisdiction)
+	}
+	return s, nil
+}
+
+// --- Accessors ---
+
+func (t Tenant) ID() uuid.UUID                      { return t.id }
+func (t Tenant) Slug() string                       { return t.slug }
+func (t Tenant) Settings() TenantSettings           { return t.settings }
+func (t Tenant) Status() valueobject.TenantStatus   { return t.status }
+func (t Tenant) SuspendReason() string              { return t.suspendReason }
+func (t Tenant) FeatureFlags() map[string]bool      { return t.featureFlags }
+func (t Tenant) Limits() map[string]decimal.Decimal { return t.limits }
+func (t Tenant) Provisioning() []ProvisioningStep   { return t.provisioning }
+func (t Tenant) Version() int                       { return t.version }
+func (t Tenant) CreatedAt() time.Time               { return t.createdAt }
+func (t Tenant) UpdatedAt() time.Time               { return t.updatedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (t Tenant) DomainEvents() []events.DomainEvent {
+	return t.domainEvents
+}
+
+// ClearDomainEvents returns a copy of the tenant with no uncommitted events.
+func (t Tenant) ClearDomainEvents() Tenant {
+	t.domainEvents = nil
+	return t
+}
@@ -0,0 +1,138 @@
+package model_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/model"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/valueobject"
+)
+
+func settings() model.TenantSettings {
+	return model.TenantSettings{Name: " Acme Bank ", BaseCurrency: "GBP", Jurisdiction: "GB"}
+}
+
+func eventTypes(tenant model.Tenant) []string {
+	types := make([]string, 0, len(tenant.DomainEvents()))
+	for _, e := range tenant.DomainEvents() {
+		types = append(types, e.EventType())
+	}
+	return types
+}
+
+func TestNewTenant_Validation(t *testing.T) {
+	now := time.Now().UTC()
+
+	tenant, err := model.NewTenant("acme-bank", settings(), []string{"kafka_topics"}, now)
+	require.NoError(t, err)
+	assert.Equal(t, "Acme Bank", tenant.Settings().Name)
+	assert.Equal(t, valueobject.TenantStatusProvisioning, tenant.Status())
+	assert.Equal(t, []string{"kafka_topics"}, tenant.PendingHooks())
+	assert.Equal(t, []string{"tenant.created"}, eventTypes(tenant))
+
+	_, err = model.NewTenant("Acme", settings(), nil, now)
+	assert.Error(t, err, "slugs are lowercase")
+	_, err = model.NewTenant("acme-", settings(), nil, now)
+	assert.Error(t, err, "slugs do not end with a hyphen")
+
+	s := settings()
+	s.BaseCurrency = "gbp"
+	_, err = model.NewTenant("acme-bank", s, nil, now)
+	assert.Error(t, err)
+
+	s = settings()
+	s.Jurisdiction = "GBR"
+	_, err = model.NewTenant("acme-bank", s, nil, now)
+	assert.Error(t, err)
+}
+
+func TestNewTenant_WithoutHooksIsActive(t *testing.T) {
+	tenant, err := model.NewTenant("acme-bank", settings(), nil, time.Now().UTC())
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.TenantStatusActive, tenant.Status())
+	assert.Equal(t, []string{"tenant.created", "tenant.activated"}, eventTypes(tenant))
+}
+
+func TestRecordProvisioning_ActivatesOnceAllHooksSucceed(t *testing.T) {
+	now := time.Now().UTC()
+	tenant, err := model.NewTenant("acme-bank", settings(), []string{"kafka_topics", "postgres_schema"}, now)
+	require.NoError(t, err)
+	tenant = tenant.ClearDomainEvents()
+
+	tenant, err = tenant.RecordProvisioning("kafka_topics", nil, now)
+	require.NoError(t, err)
+	tenant, err = tenant.RecordProvisioning("postgres_schema", errors.New("connection refused"), now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.TenantStatusProvisioning, tenant.Status())
+	assert.Equal(t, []string{"postgres_schema"}, tenant.PendingHooks())
+	assert.Equal(t, "connection refused", tenant.Provisioning()[1].Error)
+	assert.Equal(t, []string{"tenant.provisioning_failed"}, eventTypes(tenant))
+
+	tenant, err = tenant.RecordProvisioning("postgres_schema", nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.TenantStatusActive, tenant.Status())
+	assert.Empty(t, tenant.PendingHooks())
+	assert.Empty(t, tenant.Provisioning()[1].Error)
+	assert.Equal(t, 4, tenant.Version())
+
+	_, err = tenant.RecordProvisioning("postgres_schema", nil, now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+}
+
+func TestTenant_SuspendAndReactivate(t *testing.T) {
+	now := time.Now().UTC()
+	tenant, err := model.NewTenant("acme-bank", settings(), nil, now)
+	require.NoError(t, err)
+
+	_, err = tenant.Suspend(" ", now)
+	assert.Error(t, err, "a reason is required")
+
+	suspended, err := tenant.Suspend("unpaid invoices", now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.TenantStatusSuspended, suspended.Status())
+	assert.Equal(t, "unpaid invoices", suspended.SuspendReason())
+
+	_, err = suspended.Suspend("again", now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+
+	reactivated, err := suspended.Reactivate(now)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.TenantStatusActive, reactivated.Status())
+	assert.Empty(t, reactivated.SuspendReason())
+
+	_, err = reactivated.Reactivate(now)
+	assert.ErrorIs(t, err, model.ErrInvalidTransition)
+}
+
+func TestTenant_FlagsAndLimitsDoNotAlias(t *testing.T) {
+	now := time.Now().UTC()
+	tenant, err := model.NewTenant("acme-bank", settings(), nil, now)
+	require.NoError(t, err)
+
+	withFlag, err := tenant.SetFeatureFlag("payments.sepa", true, now)
+	require.NoError(t, err)
+	assert.True(t, withFlag.FeatureFlags()["payments.sepa"])
+	assert.Empty(t, tenant.FeatureFlags(), "the original tenant is unchanged")
+
+	_, err = tenant.SetFeatureFlag("Payments SEPA", true, now)
+	assert.Error(t, err)
+
+	withLimit, err := withFlag.SetLimit("payment.max_amount", decimal.NewFromInt(50000), now)
+	require.NoError(t, err)
+	assert.True(t, decimal.NewFromInt(50000).Equal(withLimit.Limits()["payment.max_amount"]))
+	assert.Empty(t, withFlag.Limits())
+
+	_, err = withFlag.SetLimit("payment.max_amount", decimal.NewFromInt(-1), now)
+	assert.Error(t, err)
+
+	removed := withLimit.RemoveLimit("payment.max_amount", now)
+	assert.Empty(t, removed.Limits())
+	assert.Len(t, withLimit.Limits(), 1)
+	assert.Equal(t, removed.Version(), removed.RemoveLimit("payment.max_amount", now).Version(),
+		"removing an unset limit changes nothing")
+}
@@ -0,0 +1,53 @@
+package port
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/event"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/model"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/valueobject"
+)
+
+// ErrTenantNotFound is returned when a tenant does not exist.
+var ErrTenantNotFound = errors.New("tenant not found")
+
+// ErrSlugTaken is returned when another tenant already has the slug.
+var ErrSlugTaken = errors.New("tenant slug is already taken")
+
+// ErrVersionConflict is returned when a tenant was modified concurrently.
+var ErrVersionConflict = errors.New("tenant was modified concurrently")
+
+// TenantRepository defines the persistence port for tenants.
+type TenantRepository interface {
+	// Save persists the tenant, failing with ErrVersionConflict if it was
+	// modified since it was loaded.
+	Save(ctx context.Context, tenant model.Tenant) error
+
+	// FindByID retrieves a tenant.
+	FindByID(ctx context.Context, id uuid.UUID) (model.Tenant, error)
+
+	// FindBySlug retrieves a tenant by its slug.
+	FindBySlug(ctx context.Context, slug string) (model.Tenant, error)
+
+	// List returns tenants ordered by slug, optionally only those with the
+	// given status, and the total number matching.
+	List(ctx context.Context, status valueobject.TenantStatus, limit, offset int) ([]model.Tenant, int, error)
+}
+
+// EventPublisher defines the port for publishing domain events.
+type EventPublisher interface {
+	Publish(ctx context.Context, events []event.DomainEvent) error
+}
+
+// ProvisioningHook creates a resource a new tenant needs, such as its Kafka
+// topics or default products. A failed hook is run again when provisioning
+// is retried, so hooks must be idempotent.
+type ProvisioningHook interface {
+	// Name identifies the hook in the tenant's provisioning record.
+	Name() string
+	// Provision creates the tenant's resource.
+	Provision(ctx context.Context, tenant model.Tenant) error
+}
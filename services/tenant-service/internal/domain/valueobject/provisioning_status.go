@@ -0,0 +1,51 @@
+package valueobject
+
+import "fmt"
+
+// ProvisioningStatus is the outcome of a provisioning hook for a tenant.
+// It is an immutable value object.
+type ProvisioningStatus struct {
+	value string
+}
+
+const (
+	provisioningStatusPending   = "PENDING"
+	provisioningStatusSucceeded = "SUCCEEDED"
+	provisioningStatusFailed    = "FAILED"
+)
+
+var (
+	ProvisioningStatusPending   = ProvisioningStatus{value: provisioningStatusPending}
+	ProvisioningStatusSucceeded = ProvisioningStatus{value: provisioningStatusSucceeded}
+	ProvisioningStatusFailed    = ProvisioningStatus{value: provisioningStatusFailed}
+)
+
+var validProvisioningStatuses = map[string]ProvisioningStatus{
+	provisioningStatusPending:   ProvisioningStatusPending,
+	provisioningStatusSucceeded: ProvisioningStatusSucceeded,
+	provisioningStatusFailed:    ProvisioningStatusFailed,
+}
+
+// NewProvisioningStatus creates a ProvisioningStatus from a string, validating it is known.
+func NewProvisioningStatus(s string) (ProvisioningStatus, error) {
+	v, ok := validProvisioningStatuses[s]
+	if !ok {
+		return ProvisioningStatus{}, fmt.Errorf("invalid provisioning status: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the ProvisioningStatus.
+func (v ProvisioningStatus) String() string {
+	return v.value
+}
+
+// IsZero returns true if the ProvisioningStatus has not been set.
+func (v ProvisioningStatus) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two ProvisioningStatus values are equal.
+func (v ProvisioningStatus) Equal(other ProvisioningStatus) bool {
+	return v.value == other.value
+}
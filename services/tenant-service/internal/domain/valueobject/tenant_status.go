@@ -0,0 +1,53 @@
+package valueobject
+
+import "fmt"
+
+// TenantStatus is the lifecycle state of a tenant. A tenant is PROVISIONING
+// until every provisioning hook has succeeded, then ACTIVE. A SUSPENDED
+// tenant keeps its data but other services refuse its requests.
+// It is an immutable value object.
+type TenantStatus struct {
+	value string
+}
+
+const (
+	tenantStatusProvisioning = "PROVISIONING"
+	tenantStatusActive       = "ACTIVE"
+	tenantStatusSuspended    = "SUSPENDED"
+)
+
+var (
+	TenantStatusProvisioning = TenantStatus{value: tenantStatusProvisioning}
+	TenantStatusActive       = TenantStatus{value: tenantStatusActive}
+	TenantStatusSuspended    = TenantStatus{value: tenantStatusSuspended}
+)
+
+var validTenantStatuses = map[string]TenantStatus{
+	tenantStatusProvisioning: TenantStatusProvisioning,
+	tenantStatusActive:       TenantStatusActive,
+	tenantStatusSuspended:    TenantStatusSuspended,
+}
+
+// NewTenantStatus creates a TenantStatus from a string, validating it is known.
+func NewTenantStatus(s string) (TenantStatus, error) {
+	v, ok := validTenantStatuses[s]
+	if !ok {
+		return TenantStatus{}, fmt.Errorf("invalid tenant status: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the TenantStatus.
+func (v TenantStatus) String() string {
+	return v.value
+}
+
+// IsZero returns true if the TenantStatus has not been set.
+func (v TenantStatus) IsZero() bool {
+	return v.value == ""
+}
+
+// Equal returns true if two TenantStatus values are equal.
+func (v TenantStatus) Equal(other TenantStatus) bool {
+	return v.value == other.value
+}
@@ -0,0 +1,125 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type DatabaseConfig struct {
+	Host     string
+	User     string
+	Password string
+	Name     string
+	SSLMode  string
+	Port     int
+}
+
+type KafkaConfig struct {
+	Brokers []string
+}
+
+// ProvisioningConfig configures the hooks run for each new tenant. A hook
+// whose setting is empty is not run.
+type ProvisioningConfig struct {
+	// Topics are created for each tenant; {tenant} is replaced by the
+	// tenant's slug.
+	Topics           []string
+	TopicPartitions  int
+	TopicReplication int
+	// SchemaDSN is the database a schema named after the tenant is created in.
+	SchemaDSN string
+	// DefaultProductsFile is a JSON file listing the deposit products created
+	// for each tenant.
+	DefaultProductsFile string
+	DepositServiceAddr  string
+	// SigningKeyFile holds the private key used to sign the tokens hooks
+	// call other services with. Without it the gateway's JWT secret is used.
+	SigningKeyFile string
+	// HookTimeout bounds each hook.
+	HookTimeout time.Duration
+}
+
+type Config struct {
+	DB           DatabaseConfig
+	ServiceName  string
+	Kafka        KafkaConfig
+	Provisioning ProvisioningConfig
+	GRPCPort     int
+	HTTPPort     int
+}
+
+func Load() Config {
+	return Config{
+		GRPCPort: getEnvInt("GRPC_PORT", 9094),
+		HTTPPort: getEnvInt("HTTP_PORT", 8094),
+		DB: DatabaseConfig{
+			Host:     getEnv("DB_HOST", "localhost"),
+			Port:     getEnvInt("DB_PORT", 5432),
+			User:     getEnv("DB_USER", "bib"),
+			Password: getEnv("DB_PASSWORD", ""),
+			Name:     getEnv("DB_NAME", "bib_tenant"),
+			SSLMode:  getEnv("DB_SSLMODE", "require"),
+		},
+		Kafka: KafkaConfig{
+			Brokers: []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+		},
+		Provisioning: ProvisioningConfig{
+			Topics:              getEnvList("TENANT_TOPICS"),
+			TopicPartitions:     getEnvInt("TENANT_TOPIC_PARTITIONS", 3),
+			TopicReplication:    getEnvInt("TENANT_TOPIC_REPLICATION", 1),
+			SchemaDSN:           getEnv("TENANT_SCHEMA_DSN", ""),
+			DefaultProductsFile: getEnv("TENANT_DEFAULT_PRODUCTS_FILE", ""),
+			DepositServiceAddr:  getEnv("DEPOSIT_SERVICE_ADDR", ""),
+			SigningKeyFile:      getEnv("TENANT_SIGNING_KEY_FILE", ""),
+			HookTimeout:         getEnvDuration("TENANT_PROVISIONING_HOOK_TIMEOUT", 30*time.Second),
+		},
+		ServiceName: "tenant-service",
+	}
+}
+
+func (c Config) GRPCAddr() string {
+	return fmt.Sprintf(":%d", c.GRPCPort)
+}
+
+func (c Config) HTTPAddr() string {
+	return fmt.Sprintf(":%d", c.HTTPPort)
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) int {
+	if v := os.Getenv(key); v != "" {
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+	return fallback
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return fallback
+}
+
+// getEnvList reads a comma-separated list, ignoring blank entries.
+func getEnvList(key string) []string {
+	var list []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
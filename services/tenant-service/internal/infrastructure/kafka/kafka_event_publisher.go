@@ -7,7 +7,7 @@ import (
 	"log/slog"
 
 	pkgkafka "github.com/bibbank/bib/pkg/kafka"
-	"github.com/bibbank/bib/services/card-service/internal/domain/event"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/event"
 )
 
 // EventPublisher implements the EventPublisher port using Kafka.
@@ -42,6 +42,7 @@ func (p *EventPublisher) Publish(ctx context.Context, events []event.DomainEvent
 		)
 
 		messages = append(messages, pkgkafka.Message{
+			Key:   []byte(evt.AggregateID()),
 			Value: payload,
 			Headers: map[string]string{
 				"event_type": evt.EventType(),
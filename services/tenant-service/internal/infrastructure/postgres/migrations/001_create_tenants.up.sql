@@ -0,0 +1,21 @@
+-- Tenants are the banks operating on the platform. Feature flags, limits and
+-- the outcome of each provisioning hook are held as JSON; other services
+-- read them through the tenant service rather than this table.
+CREATE TABLE IF NOT EXISTS tenants (
+    id UUID PRIMARY KEY,
+    slug VARCHAR(40) NOT NULL,
+    name VARCHAR(200) NOT NULL,
+    base_currency CHAR(3) NOT NULL,
+    jurisdiction CHAR(2) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PROVISIONING',
+    suspend_reason TEXT NOT NULL DEFAULT '',
+    feature_flags JSONB NOT NULL DEFAULT '{}',
+    limits JSONB NOT NULL DEFAULT '{}',
+    provisioning JSONB NOT NULL DEFAULT '[]',
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_tenants_slug ON tenants(slug);
+CREATE INDEX idx_tenants_status ON tenants(status, slug);
@@ -0,0 +1,240 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/model"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/port"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/valueobject"
+)
+
+const tenantColumns = `
+	id, slug, name, base_currency, jurisdiction, status, suspend_reason,
+	feature_flags, limits, provisioning, version, created_at, updated_at`
+
+// uniqueViolation is the PostgreSQL error code for a unique constraint
+// violation.
+const uniqueViolation = "23505"
+
+type provisioningRow struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	Hook      string    `json:"hook"`
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// TenantRepo is the PostgreSQL implementation of TenantRepository.
+type TenantRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewTenantRepo creates a new TenantRepo.
+func NewTenantRepo(pool *pgxpool.Pool) *TenantRepo {
+	return &TenantRepo{pool: pool}
+}
+
+// Save persists the tenant with optimistic locking on its version.
+func (r *TenantRepo) Save(ctx context.Context, tenant model.Tenant) error {
+	const upsertTenantSQL = `
+		INSERT INTO tenants (
+			id, slug, name, base_currency, jurisdiction, status, suspend_reason,
+			feature_flags, limits, provisioning, version, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name,
+			base_currency = EXCLUDED.base_currency,
+			jurisdiction = EXCLUDED.jurisdiction,
+			status = EXCLUDED.status,
+			suspend_reason = EXCLUDED.suspend_reason,
+			feature_flags = EXCLUDED.feature_flags,
+			limits = EXCLUDED.limits,
+			provisioning = EXCLUDED.provisioning,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE tenants.version = EXCLUDED.version - 1
+	`
+
+	flags, limits, provisioning, err := marshalTenantCollections(tenant)
+	if err != nil {
+		return err
+	}
+	s := tenant.Settings()
+	result, err := r.pool.Exec(ctx, upsertTenantSQL,
+		tenant.ID(),
+		tenant.Slug(),
+		s.Name,
+		s.BaseCurrency,
+		s.Jurisdiction,
+		tenant.Status().String(),
+		tenant.SuspendReason(),
+		flags,
+		limits,
+		provisioning,
+		tenant.Version(),
+		tenant.CreatedAt(),
+		tenant.UpdatedAt(),
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: %s", port.ErrSlugTaken, tenant.Slug())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to upsert tenant: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: tenant %s", port.ErrVersionConflict, tenant.ID())
+	}
+	return nil
+}
+
+// FindByID retrieves a tenant.
+func (r *TenantRepo) FindByID(ctx context.Context, id uuid.UUID) (model.Tenant, error) {
+	return r.findOne(ctx, `SELECT `+tenantColumns+` FROM tenants WHERE id = $1`, id)
+}
+
+// FindBySlug retrieves a tenant by its slug.
+func (r *TenantRepo) FindBySlug(ctx context.Context, slug string) (model.Tenant, error) {
+	return r.findOne(ctx, `SELECT `+tenantColumns+` FROM tenants WHERE slug = $1`, slug)
+}
+
+// List returns tenants ordered by slug, optionally only those with the given
+// status, and the total number matching.
+func (r *TenantRepo) List(ctx context.Context, status valueobject.TenantStatus, limit, offset int) ([]model.Tenant, int, error) {
+	const countQuery = `SELECT COUNT(*) FROM tenants WHERE ($1 = '' OR status = $1)`
+	const listQuery = `SELECT ` + tenantColumns + `
+		FROM tenants
+		WHERE ($1 = '' OR status = $1)
+		ORDER BY slug
+		LIMIT $2 OFFSET $3
+	`
+
+	var total int
+	if err := r.pool.QueryRow(ctx, countQuery, status.String()).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count tenants: %w", err)
+	}
+
+	rows, err := r.pool.Query(ctx, listQuery, status.String(), limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query tenants: %w", err)
+	}
+	defer rows.Close()
+
+	var tenants []model.Tenant
+	for rows.Next() {
+		t, err := scanTenant(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan tenant row: %w", err)
+		}
+		tenants = append(tenants, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("row iteration error: %w", err)
+	}
+	return tenants, total, nil
+}
+
+func (r *TenantRepo) findOne(ctx context.Context, query string, arg any) (model.Tenant, error) {
+	t, err := scanTenant(r.pool.QueryRow(ctx, query, arg))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Tenant{}, port.ErrTenantNotFound
+	}
+	if err != nil {
+		return model.Tenant{}, fmt.Errorf("failed to scan tenant: %w", err)
+	}
+	return t, nil
+}
+
+func marshalTenantCollections(t model.Tenant) (flags, limits, provisioning []byte, err error) {
+	if flags, err = json.Marshal(t.FeatureFlags()); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to marshal feature flags: %w", err)
+	}
+	if limits, err = json.Marshal(t.Limits()); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to marshal limits: %w", err)
+	}
+	rows := make([]provisioningRow, 0, len(t.Provisioning()))
+	for _, step := range t.Provisioning() {
+		rows = append(rows, provisioningRow{
+			Hook:      step.Hook,
+			Status:    step.Status.String(),
+			Error:     step.Error,
+			UpdatedAt: step.UpdatedAt,
+		})
+	}
+	if provisioning, err = json.Marshal(rows); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to marshal provisioning: %w", err)
+	}
+	return flags, limits, provisioning, nil
+}
+
+func scanTenant(row pgx.Row) (model.Tenant, error) {
+	var (
+		id               uuid.UUID
+		slug             string
+		name             string
+		baseCurrency     string
+		jurisdiction     string
+		statusStr        string
+		suspendReason    string
+		flagsJSON        []byte
+		limitsJSON       []byte
+		provisioningJSON []byte
+		version          int
+		createdAt        time.Time
+		updatedAt        time.Time
+	)
+
+	err := row.Scan(&id, &slug, &name, &baseCurrency, &jurisdiction, &statusStr, &suspendReason,
+		&flagsJSON, &limitsJSON, &provisioningJSON, &version, &createdAt, &updatedAt)
+	if err != nil {
+		return model.Tenant{}, err
+	}
+
+	status, err := valueobject.NewTenantStatus(statusStr)
+	if err != nil {
+		return model.Tenant{}, fmt.Errorf("invalid tenant status in database: %w", err)
+	}
+
+	var flags map[string]bool
+	if err := json.Unmarshal(flagsJSON, &flags); err != nil {
+		return model.Tenant{}, fmt.Errorf("failed to unmarshal feature flags: %w", err)
+	}
+	var limits map[string]decimal.Decimal
+	if err := json.Unmarshal(limitsJSON, &limits); err != nil {
+		return model.Tenant{}, fmt.Errorf("failed to unmarshal limits: %w", err)
+	}
+
+	var rows []provisioningRow
+	if err := json.Unmarshal(provisioningJSON, &rows); err != nil {
+		return model.Tenant{}, fmt.Errorf("failed to unmarshal provisioning: %w", err)
+	}
+	provisioning := make([]model.ProvisioningStep, 0, len(rows))
+	for _, row := range rows {
+		stepStatus, err := valueobject.NewProvisioningStatus(row.Status)
+		if err != nil {
+			return model.Tenant{}, fmt.Errorf("invalid provisioning status in database: %w", err)
+		}
+		provisioning = append(provisioning, model.ProvisioningStep{
+			Hook:      row.Hook,
+			Status:    stepStatus,
+			Error:     row.Error,
+			UpdatedAt: row.UpdatedAt,
+		})
+	}
+
+	return model.ReconstructTenant(
+		id, slug,
+		model.TenantSettings{Name: name, BaseCurrency: baseCurrency, Jurisdiction: jurisdiction},
+		status, suspendReason, flags, limits, provisioning,
+		version, createdAt, updatedAt,
+	), nil
+}
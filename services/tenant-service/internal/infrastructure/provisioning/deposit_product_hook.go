@@ -0,0 +1,104 @@
+package provisioning
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	depositv1 "github.com/bibbank/bib/api/gen/go/bib/deposit/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/model"
+)
+
+// InterestTier is a balance band of a default deposit product.
+type InterestTier struct {
+	MinBalance string `json:"min_balance"`
+	MaxBalance string `json:"max_balance"`
+	RateBps    int32  `json:"rate_bps"`
+}
+
+// DepositProduct is a deposit product created for every new tenant. An
+// empty Currency means the tenant's base currency.
+type DepositProduct struct {
+	Name     string         `json:"name"`
+	Currency string         `json:"currency,omitempty"`
+	Tiers    []InterestTier `json:"tiers"`
+	TermDays int32          `json:"term_days"`
+}
+
+// LoadDepositProducts reads the default deposit products from a JSON file
+// holding an array of products.
+func LoadDepositProducts(path string) ([]DepositProduct, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read default products: %w", err)
+	}
+	var products []DepositProduct
+	if err := json.Unmarshal(data, &products); err != nil {
+		return nil, fmt.Errorf("failed to parse default products: %w", err)
+	}
+	for _, p := range products {
+		if p.Name == "" {
+			return nil, fmt.Errorf("default product in %s has no name", path)
+		}
+	}
+	return products, nil
+}
+
+// DepositProductHook creates one default deposit product for a tenant in the
+// deposit service. The deposit service does not deduplicate products, so
+// the hook is only retried once it has failed.
+type DepositProductHook struct {
+	client  depositv1.DepositServiceClient
+	signer  *auth.JWTService
+	product DepositProduct
+}
+
+// NewDepositProductHook creates a hook creating the product through conn,
+// authorized by an admin token for the tenant signed by signer.
+func NewDepositProductHook(conn grpc.ClientConnInterface, signer *auth.JWTService, product DepositProduct) *DepositProductHook {
+	return &DepositProductHook{client: depositv1.NewDepositServiceClient(conn), signer: signer, product: product}
+}
+
+// Name identifies the hook by the product it creates.
+func (h *DepositProductHook) Name() string {
+	return "deposit_product:" + h.product.Name
+}
+
+// Provision creates the product for the tenant.
+func (h *DepositProductHook) Provision(ctx context.Context, tenant model.Tenant) error {
+	token, err := h.signer.GenerateToken(uuid.Nil, tenant.ID(), []string{auth.RoleAdmin})
+	if err != nil {
+		return fmt.Errorf("mint service token: %w", err)
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+
+	currency := h.product.Currency
+	if currency == "" {
+		currency = tenant.Settings().BaseCurrency
+	}
+	tiers := make([]*depositv1.InterestTier, 0, len(h.product.Tiers))
+	for _, t := range h.product.Tiers {
+		tiers = append(tiers, &depositv1.InterestTier{
+			MinBalance: t.MinBalance,
+			MaxBalance: t.MaxBalance,
+			RateBps:    t.RateBps,
+		})
+	}
+	_, err = h.client.CreateProduct(ctx, &depositv1.CreateDepositProductRequest{
+		TenantId: tenant.ID().String(),
+		Name:     h.product.Name,
+		Currency: currency,
+		Tiers:    tiers,
+		TermDays: h.product.TermDays,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create deposit product %q: %w", h.product.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package provisioning
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/model"
+)
+
+// SchemaHook creates a PostgreSQL schema for the tenant's data in a shared
+// database. Existing schemas are left as they are, so the hook can be
+// retried.
+type SchemaHook struct {
+	dsn string
+}
+
+// NewSchemaHook creates a hook creating tenant schemas in the database at dsn.
+func NewSchemaHook(dsn string) *SchemaHook {
+	return &SchemaHook{dsn: dsn}
+}
+
+// Name identifies the hook.
+func (h *SchemaHook) Name() string {
+	return "postgres_schema"
+}
+
+// SchemaName returns the tenant's schema name, its slug with hyphens
+// replaced by underscores.
+func SchemaName(tenant model.Tenant) string {
+	return "tenant_" + strings.ReplaceAll(tenant.Slug(), "-", "_")
+}
+
+// Provision creates the tenant's schema.
+func (h *SchemaHook) Provision(ctx context.Context, tenant model.Tenant) error {
+	conn, err := pgx.Connect(ctx, h.dsn)
+	if err != nil {
+		return fmt.Errorf("failed to connect to tenant database: %w", err)
+	}
+	defer conn.Close(ctx) //nolint:errcheck
+
+	schema := pgx.Identifier{SchemaName(tenant)}.Sanitize()
+	if _, err := conn.Exec(ctx, "CREATE SCHEMA IF NOT EXISTS "+schema); err != nil {
+		return fmt.Errorf("failed to create schema: %w", err)
+	}
+	return nil
+}
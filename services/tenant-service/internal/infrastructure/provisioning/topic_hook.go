@@ -0,0 +1,77 @@
+package provisioning
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/model"
+)
+
+// TopicHook creates a tenant's Kafka topics. Topics that already exist are
+// left as they are, so the hook can be retried.
+type TopicHook struct {
+	brokers     []string
+	templates   []string
+	partitions  int
+	replication int
+}
+
+// NewTopicHook creates a hook creating a topic per template, with {tenant}
+// replaced by the tenant's slug.
+func NewTopicHook(brokers, templates []string, partitions, replication int) *TopicHook {
+	return &TopicHook{brokers: brokers, templates: templates, partitions: partitions, replication: replication}
+}
+
+// Name identifies the hook.
+func (h *TopicHook) Name() string {
+	return "kafka_topics"
+}
+
+// Topics returns the names of the tenant's topics.
+func (h *TopicHook) Topics(tenant model.Tenant) []string {
+	topics := make([]string, 0, len(h.templates))
+	for _, tmpl := range h.templates {
+		topics = append(topics, strings.ReplaceAll(tmpl, "{tenant}", tenant.Slug()))
+	}
+	return topics
+}
+
+// Provision creates the tenant's topics on the cluster controller.
+func (h *TopicHook) Provision(ctx context.Context, tenant model.Tenant) error {
+	if len(h.brokers) == 0 {
+		return fmt.Errorf("no Kafka brokers configured")
+	}
+	conn, err := kafka.DialContext(ctx, "tcp", h.brokers[0])
+	if err != nil {
+		return fmt.Errorf("failed to dial Kafka: %w", err)
+	}
+	defer conn.Close()
+
+	controller, err := conn.Controller()
+	if err != nil {
+		return fmt.Errorf("failed to find Kafka controller: %w", err)
+	}
+	controllerConn, err := kafka.DialContext(ctx, "tcp", net.JoinHostPort(controller.Host, strconv.Itoa(controller.Port)))
+	if err != nil {
+		return fmt.Errorf("failed to dial Kafka controller: %w", err)
+	}
+	defer controllerConn.Close()
+
+	configs := make([]kafka.TopicConfig, 0, len(h.templates))
+	for _, topic := range h.Topics(tenant) {
+		configs = append(configs, kafka.TopicConfig{
+			Topic:             topic,
+			NumPartitions:     h.partitions,
+			ReplicationFactor: h.replication,
+		})
+	}
+	if err := controllerConn.CreateTopics(configs...); err != nil {
+		return fmt.Errorf("failed to create topics: %w", err)
+	}
+	return nil
+}
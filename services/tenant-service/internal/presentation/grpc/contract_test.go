@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	tenantv1 "github.com/bibbank/bib/api/gen/go/bib/tenant/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/tenant-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/event"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/model"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/port"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/valueobject"
+)
+
+// contractTenantRepo holds at most one tenant.
+type contractTenantRepo struct {
+	tenant *model.Tenant
+}
+
+func (r *contractTenantRepo) Save(context.Context, model.Tenant) error { return nil }
+
+func (r *contractTenantRepo) FindByID(context.Context, uuid.UUID) (model.Tenant, error) {
+	if r.tenant == nil {
+		return model.Tenant{}, port.ErrTenantNotFound
+	}
+	return *r.tenant, nil
+}
+
+func (r *contractTenantRepo) FindBySlug(context.Context, string) (model.Tenant, error) {
+	return model.Tenant{}, port.ErrTenantNotFound
+}
+
+func (r *contractTenantRepo) List(context.Context, valueobject.TenantStatus, int, int) ([]model.Tenant, int, error) {
+	return nil, 0, nil
+}
+
+type discardPublisher struct{}
+
+func (discardPublisher) Publish(context.Context, []event.DomainEvent) error { return nil }
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	tenants := &contractTenantRepo{}
+	provisioner := usecase.NewProvisioner(tenants, discardPublisher{}, nil, 0, slog.Default())
+	h := NewTenantServiceHandler(
+		usecase.NewCreateTenantUseCase(tenants, discardPublisher{}, provisioner),
+		usecase.NewGetTenantUseCase(tenants), nil, nil, nil, nil, nil, nil, nil, nil,
+		slog.Default(),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { tenantv1.RegisterTenantServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: uuid.New(),
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-tenant-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { tenants.tenant = nil },
+		"an active tenant exists": func(t *testing.T) {
+			tenant, err := model.NewTenant("acme-bank", model.TenantSettings{
+				Name:         "Acme Bank",
+				BaseCurrency: "GBP",
+				Jurisdiction: "GB",
+			}, nil, time.Now().UTC())
+			if err != nil {
+				t.Fatal(err)
+			}
+			tenants.tenant = &tenant
+		},
+	})
+}
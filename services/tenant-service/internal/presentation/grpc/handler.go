@@ -0,0 +1,438 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tenantv1 "github.com/bibbank/bib/api/gen/go/bib/tenant/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/tenant-service/internal/application/dto"
+	"github.com/bibbank/bib/services/tenant-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/model"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/port"
+)
+
+// requireRole checks that the caller has at least one of the given roles.
+func requireRole(ctx context.Context, roles ...string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "insufficient permissions")
+}
+
+// Compile-time assertion that TenantServiceHandler implements TenantServiceServer.
+var _ tenantv1.TenantServiceServer = (*TenantServiceHandler)(nil)
+
+// TenantServiceHandler implements the gRPC TenantServiceServer interface.
+type TenantServiceHandler struct {
+	tenantv1.UnimplementedTenantServiceServer
+	createTenantUC     *usecase.CreateTenantUseCase
+	getTenantUC        *usecase.GetTenantUseCase
+	listTenantsUC      *usecase.ListTenantsUseCase
+	configureTenantUC  *usecase.ConfigureTenantUseCase
+	setFeatureFlagUC   *usecase.SetFeatureFlagUseCase
+	setLimitUC         *usecase.SetLimitUseCase
+	suspendTenantUC    *usecase.SuspendTenantUseCase
+	reactivateTenantUC *usecase.ReactivateTenantUseCase
+	provisionTenantUC  *usecase.ProvisionTenantUseCase
+	getTenantConfigUC  *usecase.GetTenantConfigUseCase
+	logger             *slog.Logger
+}
+
+// NewTenantServiceHandler creates a new TenantServiceHandler.
+func NewTenantServiceHandler(
+	createTenantUC *usecase.CreateTenantUseCase,
+	getTenantUC *usecase.GetTenantUseCase,
+	listTenantsUC *usecase.ListTenantsUseCase,
+	configureTenantUC *usecase.ConfigureTenantUseCase,
+	setFeatureFlagUC *usecase.SetFeatureFlagUseCase,
+	setLimitUC *usecase.SetLimitUseCase,
+	suspendTenantUC *usecase.SuspendTenantUseCase,
+	reactivateTenantUC *usecase.ReactivateTenantUseCase,
+	provisionTenantUC *usecase.ProvisionTenantUseCase,
+	getTenantConfigUC *usecase.GetTenantConfigUseCase,
+	logger *slog.Logger,
+) *TenantServiceHandler {
+	return &TenantServiceHandler{
+		createTenantUC:     createTenantUC,
+		getTenantUC:        getTenantUC,
+		listTenantsUC:      listTenantsUC,
+		configureTenantUC:  configureTenantUC,
+		setFeatureFlagUC:   setFeatureFlagUC,
+		setLimitUC:         setLimitUC,
+		suspendTenantUC:    suspendTenantUC,
+		reactivateTenantUC: reactivateTenantUC,
+		provisionTenantUC:  provisionTenantUC,
+		getTenantConfigUC:  getTenantConfigUC,
+		logger:             logger,
+	}
+}
+
+// CreateTenant handles the gRPC request to create and provision a tenant.
+func (h *TenantServiceHandler) CreateTenant(ctx context.Context, req *tenantv1.CreateTenantRequest) (*tenantv1.CreateTenantResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req == nil || req.Settings == nil {
+		return nil, status.Error(codes.InvalidArgument, "settings are required")
+	}
+
+	resp, err := h.createTenantUC.Execute(ctx, dto.CreateTenantRequest{
+		Slug:     req.Slug,
+		Settings: fromSettingsMsg(req.Settings),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &tenantv1.CreateTenantResponse{Tenant: toTenantMsg(resp)}, nil
+}
+
+// GetTenant handles the gRPC request to retrieve a tenant.
+func (h *TenantServiceHandler) GetTenant(ctx context.Context, req *tenantv1.GetTenantRequest) (*tenantv1.GetTenantResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := uuid.Parse(req.TenantId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tenant ID")
+	}
+
+	resp, err := h.getTenantUC.Execute(ctx, tenantID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &tenantv1.GetTenantResponse{Tenant: toTenantMsg(resp)}, nil
+}
+
+// ListTenants handles the gRPC request to list tenants.
+func (h *TenantServiceHandler) ListTenants(ctx context.Context, req *tenantv1.ListTenantsRequest) (*tenantv1.ListTenantsResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleAuditor); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	resp, err := h.listTenantsUC.Execute(ctx, dto.ListTenantsRequest{
+		Status: tenantStatusFromProto(req.Status),
+		Limit:  int(req.PageSize),
+		Offset: int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	tenants := make([]*tenantv1.Tenant, 0, len(resp.Tenants))
+	for _, t := range resp.Tenants {
+		tenants = append(tenants, toTenantMsg(t))
+	}
+	return &tenantv1.ListTenantsResponse{
+		Tenants:    tenants,
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // tenant counts are small
+	}, nil
+}
+
+// ConfigureTenant handles the gRPC request to replace a tenant's settings.
+func (h *TenantServiceHandler) ConfigureTenant(ctx context.Context, req *tenantv1.ConfigureTenantRequest) (*tenantv1.ConfigureTenantResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req == nil || req.Settings == nil {
+		return nil, status.Error(codes.InvalidArgument, "settings are required")
+	}
+
+	tenantID, err := uuid.Parse(req.TenantId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tenant ID")
+	}
+
+	resp, err := h.configureTenantUC.Execute(ctx, dto.ConfigureTenantRequest{
+		TenantID: tenantID,
+		Settings: fromSettingsMsg(req.Settings),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &tenantv1.ConfigureTenantResponse{Tenant: toTenantMsg(resp)}, nil
+}
+
+// SetFeatureFlag handles the gRPC request to turn a feature on or off for a
+// tenant.
+func (h *TenantServiceHandler) SetFeatureFlag(ctx context.Context, req *tenantv1.SetFeatureFlagRequest) (*tenantv1.SetFeatureFlagResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := uuid.Parse(req.TenantId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tenant ID")
+	}
+
+	resp, err := h.setFeatureFlagUC.Execute(ctx, dto.SetFeatureFlagRequest{
+		TenantID: tenantID,
+		Key:      req.Key,
+		Enabled:  req.Enabled,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &tenantv1.SetFeatureFlagResponse{Tenant: toTenantMsg(resp)}, nil
+}
+
+// SetLimit handles the gRPC request to set or remove one of a tenant's limits.
+func (h *TenantServiceHandler) SetLimit(ctx context.Context, req *tenantv1.SetLimitRequest) (*tenantv1.SetLimitResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := uuid.Parse(req.TenantId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tenant ID")
+	}
+
+	var value *decimal.Decimal
+	if req.Value != "" {
+		v, parseErr := decimal.NewFromString(req.Value)
+		if parseErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid value: %v", parseErr)
+		}
+		value = &v
+	}
+
+	resp, err := h.setLimitUC.Execute(ctx, dto.SetLimitRequest{
+		TenantID: tenantID,
+		Key:      req.Key,
+		Value:    value,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &tenantv1.SetLimitResponse{Tenant: toTenantMsg(resp)}, nil
+}
+
+// SuspendTenant handles the gRPC request to suspend a tenant.
+func (h *TenantServiceHandler) SuspendTenant(ctx context.Context, req *tenantv1.SuspendTenantRequest) (*tenantv1.SuspendTenantResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := uuid.Parse(req.TenantId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tenant ID")
+	}
+
+	resp, err := h.suspendTenantUC.Execute(ctx, dto.SuspendTenantRequest{
+		TenantID: tenantID,
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &tenantv1.SuspendTenantResponse{Tenant: toTenantMsg(resp)}, nil
+}
+
+// ReactivateTenant handles the gRPC request to reactivate a suspended tenant.
+func (h *TenantServiceHandler) ReactivateTenant(ctx context.Context, req *tenantv1.ReactivateTenantRequest) (*tenantv1.ReactivateTenantResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := uuid.Parse(req.TenantId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tenant ID")
+	}
+
+	resp, err := h.reactivateTenantUC.Execute(ctx, tenantID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &tenantv1.ReactivateTenantResponse{Tenant: toTenantMsg(resp)}, nil
+}
+
+// ProvisionTenant handles the gRPC request to retry a tenant's failed
+// provisioning hooks.
+func (h *TenantServiceHandler) ProvisionTenant(ctx context.Context, req *tenantv1.ProvisionTenantRequest) (*tenantv1.ProvisionTenantResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID, err := uuid.Parse(req.TenantId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid tenant ID")
+	}
+
+	resp, err := h.provisionTenantUC.Execute(ctx, tenantID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &tenantv1.ProvisionTenantResponse{Tenant: toTenantMsg(resp)}, nil
+}
+
+// GetTenantConfig handles the gRPC request for the configuration services
+// consult for a tenant. Admins may read any tenant's configuration; other
+// callers only their own tenant's.
+func (h *TenantServiceHandler) GetTenantConfig(ctx context.Context, req *tenantv1.GetTenantConfigRequest) (*tenantv1.GetTenantConfigResponse, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	tenantID := claims.TenantID
+	if req.TenantId != "" {
+		id, err := uuid.Parse(req.TenantId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid tenant ID")
+		}
+		tenantID = id
+	}
+	if tenantID != claims.TenantID && !claims.HasRole(auth.RoleAdmin) {
+		return nil, status.Error(codes.PermissionDenied, "insufficient permissions")
+	}
+
+	resp, err := h.getTenantConfigUC.Execute(ctx, tenantID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	return &tenantv1.GetTenantConfigResponse{
+		TenantId:     resp.TenantID.String(),
+		Status:       tenantStatusToProto(resp.Status),
+		Active:       resp.Active,
+		BaseCurrency: resp.BaseCurrency,
+		Jurisdiction: resp.Jurisdiction,
+		FeatureFlags: resp.FeatureFlags,
+		Limits:       toLimitStrings(resp.Limits),
+		Version:      int32(resp.Version), //nolint:gosec // versions are small
+	}, nil
+}
+
+// toStatus maps use case errors to gRPC statuses.
+func (h *TenantServiceHandler) toStatus(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidTenant):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrInvalidTransition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, port.ErrTenantNotFound):
+		return status.Error(codes.NotFound, "tenant not found")
+	case errors.Is(err, port.ErrSlugTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, port.ErrVersionConflict):
+		return status.Error(codes.Aborted, "tenant was modified concurrently, retry")
+	default:
+		h.logger.Error("handler error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func fromSettingsMsg(s *tenantv1.TenantSettings) dto.TenantSettingsDTO {
+	return dto.TenantSettingsDTO{
+		Name:         s.GetName(),
+		BaseCurrency: s.GetBaseCurrency(),
+		Jurisdiction: s.GetJurisdiction(),
+	}
+}
+
+func toLimitStrings(limits map[string]decimal.Decimal) map[string]string {
+	out := make(map[string]string, len(limits))
+	for k, v := range limits {
+		out[k] = v.String()
+	}
+	return out
+}
+
+func toTenantMsg(t dto.TenantResponse) *tenantv1.Tenant {
+	provisioning := make([]*tenantv1.ProvisioningStep, 0, len(t.Provisioning))
+	for _, step := range t.Provisioning {
+		provisioning = append(provisioning, &tenantv1.ProvisioningStep{
+			Hook:      step.Hook,
+			Status:    tenantv1.ProvisioningStatus(tenantv1.ProvisioningStatus_value["PROVISIONING_STATUS_"+step.Status]),
+			Error:     step.Error,
+			UpdatedAt: timestamppb.New(step.UpdatedAt),
+		})
+	}
+	return &tenantv1.Tenant{
+		TenantId: t.ID.String(),
+		Slug:     t.Slug,
+		Settings: &tenantv1.TenantSettings{
+			Name:         t.Settings.Name,
+			BaseCurrency: t.Settings.BaseCurrency,
+			Jurisdiction: t.Settings.Jurisdiction,
+		},
+		Status:        tenantStatusToProto(t.Status),
+		SuspendReason: t.SuspendReason,
+		FeatureFlags:  t.FeatureFlags,
+		Limits:        toLimitStrings(t.Limits),
+		Provisioning:  provisioning,
+		Version:       int32(t.Version), //nolint:gosec // versions are small
+		CreatedAt:     timestamppb.New(t.CreatedAt),
+		UpdatedAt:     timestamppb.New(t.UpdatedAt),
+	}
+}
+
+func tenantStatusToProto(s string) tenantv1.TenantStatus {
+	return tenantv1.TenantStatus(tenantv1.TenantStatus_value["TENANT_STATUS_"+s])
+}
+
+// tenantStatusFromProto maps a status filter to its domain name; an
+// unspecified status lists tenants of every status.
+func tenantStatusFromProto(s tenantv1.TenantStatus) string {
+	if s == tenantv1.TenantStatus_TENANT_STATUS_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(s.String(), "TENANT_STATUS_")
+}
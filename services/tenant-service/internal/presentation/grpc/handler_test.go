@@ -0,0 +1,16 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	tenantv1 "github.com/bibbank/bib/api/gen/go/bib/tenant/v1"
+)
+
+func TestTenantStatusMapping(t *testing.T) {
+	assert.Equal(t, tenantv1.TenantStatus_TENANT_STATUS_SUSPENDED, tenantStatusToProto("SUSPENDED"))
+	assert.Equal(t, "ACTIVE", tenantStatusFromProto(tenantv1.TenantStatus_TENANT_STATUS_ACTIVE))
+	// An unspecified filter lists tenants of every status.
+	assert.Equal(t, "", tenantStatusFromProto(tenantv1.TenantStatus_TENANT_STATUS_UNSPECIFIED))
+}
@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	"log/slog"
+
+	tenantv1 "github.com/bibbank/bib/api/gen/go/bib/tenant/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/grpcserver"
+)
+
+// Server wraps the gRPC server for tenant-service.
+type Server struct {
+	server  *grpcserver.Server
+	handler *TenantServiceHandler
+}
+
+// NewServer creates a new gRPC server with the given handler.
+func NewServer(handler *TenantServiceHandler, logger *slog.Logger, jwtService *auth.JWTService) (*Server, error) {
+	srv, err := grpcserver.New(grpcserver.Config{
+		ServiceName: "tenant-service",
+		JWTService:  jwtService,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+	tenantv1.RegisterTenantServiceServer(srv, handler)
+
+	return &Server{
+		server:  srv,
+		handler: handler,
+	}, nil
+}
+
+// Start begins listening on the specified address.
+func (s *Server) Start(addr string) error {
+	return s.server.ListenAndServe(addr)
+}
+
+// Stop gracefully stops the gRPC server.
+func (s *Server) Stop() {
+	s.server.Stop()
+}
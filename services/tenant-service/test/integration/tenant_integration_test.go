@@ -0,0 +1,92 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/model"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/port"
+	"github.com/bibbank/bib/services/tenant-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/tenant-service/internal/infrastructure/postgres"
+)
+
+func settings() model.TenantSettings {
+	return model.TenantSettings{Name: "Acme Bank", BaseCurrency: "GBP", Jurisdiction: "GB"}
+}
+
+func TestTenantRepo_ProvisioningAndSettingsRoundTrip(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewTenantRepo(env.Pool())
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	tenant, err := model.NewTenant("acme-bank", settings(), []string{"kafka_topics", "ledger_accounts"}, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, tenant))
+
+	tenant, err = tenant.RecordProvisioning("kafka_topics", nil, now)
+	require.NoError(t, err)
+	tenant, err = tenant.RecordProvisioning("ledger_accounts", errors.New("ledger unavailable"), now)
+	require.NoError(t, err)
+	tenant, err = tenant.SetFeatureFlag("open_banking", true, now)
+	require.NoError(t, err)
+	tenant, err = tenant.SetLimit("max_payment", decimal.RequireFromString("25000.00"), now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, tenant))
+
+	found, err := repo.FindBySlug(ctx, "acme-bank")
+	require.NoError(t, err)
+	assert.Equal(t, tenant.ID(), found.ID())
+	assert.Equal(t, tenant.Version(), found.Version())
+	assert.True(t, found.Status().Equal(valueobject.TenantStatusProvisioning))
+	assert.Equal(t, []string{"ledger_accounts"}, found.PendingHooks())
+	require.Len(t, found.Provisioning(), 2)
+	assert.Equal(t, "ledger unavailable", found.Provisioning()[1].Error)
+	assert.True(t, found.FeatureFlags()["open_banking"])
+	assert.True(t, found.Limits()["max_payment"].Equal(decimal.RequireFromString("25000")))
+
+	_, err = repo.FindByID(ctx, uuid.New())
+	assert.ErrorIs(t, err, port.ErrTenantNotFound)
+}
+
+func TestTenantRepo_SaveLocksOnVersion(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewTenantRepo(env.Pool())
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	tenant, err := model.NewTenant("acme-bank", settings(), nil, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, tenant))
+
+	suspended, err := tenant.Suspend("regulatory review", now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, suspended))
+
+	// Saving the stale copy again conflicts on the version.
+	assert.ErrorIs(t, repo.Save(ctx, suspended), port.ErrVersionConflict)
+
+	other, err := model.NewTenant("acme-bank", settings(), nil, now)
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Save(ctx, other), port.ErrSlugTaken)
+
+	tenants, total, err := repo.List(ctx, valueobject.TenantStatusSuspended, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, tenants, 1)
+	assert.Equal(t, "regulatory review", tenants[0].SuspendReason())
+
+	_, total, err = repo.List(ctx, valueobject.TenantStatusActive, 10, 0)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
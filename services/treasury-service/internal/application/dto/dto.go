@@ -0,0 +1,233 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CreateNostroAccountRequest is the input for starting to track a nostro
+// account.
+type CreateNostroAccountRequest struct {
+	Name              string
+	CorrespondentBIC  string
+	AccountNumber     string
+	Currency          string
+	LedgerAccountCode string
+	Rails             []string
+	OpeningBalance    decimal.Decimal
+	MinimumBalance    decimal.Decimal
+	TenantID          uuid.UUID
+}
+
+// UpdateNostroAccountRequest is the input for changing a nostro account.
+type UpdateNostroAccountRequest struct {
+	Name           string
+	Rails          []string
+	MinimumBalance decimal.Decimal
+	TenantID       uuid.UUID
+	NostroID       uuid.UUID
+	Active         bool
+}
+
+// ListNostroAccountsRequest is the input for listing a tenant's nostro
+// accounts.
+type ListNostroAccountsRequest struct {
+	Currency string
+	Limit    int
+	Offset   int
+	TenantID uuid.UUID
+}
+
+// NostroAccountResponse is a nostro account.
+type NostroAccountResponse struct {
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	Name              string
+	CorrespondentBIC  string
+	AccountNumber     string
+	Currency          string
+	LedgerAccountCode string
+	Rails             []string
+	OpeningBalance    decimal.Decimal
+	MinimumBalance    decimal.Decimal
+	Version           int
+	ID                uuid.UUID
+	TenantID          uuid.UUID
+	Active            bool
+}
+
+// ListNostroAccountsResponse is one page of nostro accounts.
+type ListNostroAccountsResponse struct {
+	NostroAccounts []NostroAccountResponse
+	TotalCount     int
+}
+
+// GetLiquidityPositionRequest is the input for projecting one nostro
+// account's position. A zero horizon uses the service default.
+type GetLiquidityPositionRequest struct {
+	HorizonDays int
+	TenantID    uuid.UUID
+	NostroID    uuid.UUID
+}
+
+// ListLiquidityPositionsRequest is the input for projecting the positions
+// of a tenant's active nostro accounts.
+type ListLiquidityPositionsRequest struct {
+	Currency    string
+	HorizonDays int
+	TenantID    uuid.UUID
+}
+
+// DailyProjectionResponse is a projected day of a position.
+type DailyProjectionResponse struct {
+	Date           time.Time
+	Inflows        decimal.Decimal
+	Outflows       decimal.Decimal
+	ClosingBalance decimal.Decimal
+	BelowMinimum   bool
+}
+
+// LiquidityPositionResponse is a nostro account's position.
+type LiquidityPositionResponse struct {
+	AsOf             time.Time
+	Currency         string
+	BookedBalance    decimal.Decimal
+	PendingOutflows  decimal.Decimal
+	AvailableBalance decimal.Decimal
+	MinimumBalance   decimal.Decimal
+	Projections      []DailyProjectionResponse
+	NostroID         uuid.UUID
+}
+
+// CreateForecastRequest is the input for forecasting a movement.
+type CreateForecastRequest struct {
+	ValueDate   time.Time
+	Direction   string
+	Description string
+	Amount      decimal.Decimal
+	TenantID    uuid.UUID
+	NostroID    uuid.UUID
+}
+
+// ListForecastsRequest is the input for listing a tenant's forecasts. Zero
+// fields do not filter.
+type ListForecastsRequest struct {
+	From             time.Time
+	To               time.Time
+	Limit            int
+	Offset           int
+	TenantID         uuid.UUID
+	NostroID         uuid.UUID
+	IncludeCancelled bool
+}
+
+// ForecastResponse is a funding forecast.
+type ForecastResponse struct {
+	ValueDate   time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Direction   string
+	Description string
+	Status      string
+	Amount      decimal.Decimal
+	Version     int
+	ID          uuid.UUID
+	TenantID    uuid.UUID
+	NostroID    uuid.UUID
+	SweepID     uuid.UUID
+}
+
+// ListForecastsResponse is one page of forecasts.
+type ListForecastsResponse struct {
+	Forecasts  []ForecastResponse
+	TotalCount int
+}
+
+// CreateSweepRequest is the input for creating a sweep instruction.
+type CreateSweepRequest struct {
+	Type           string
+	TriggerBalance decimal.Decimal
+	TargetBalance  decimal.Decimal
+	TenantID       uuid.UUID
+	SourceNostroID uuid.UUID
+	TargetNostroID uuid.UUID
+}
+
+// UpdateSweepRequest is the input for changing a sweep instruction.
+type UpdateSweepRequest struct {
+	TriggerBalance decimal.Decimal
+	TargetBalance  decimal.Decimal
+	TenantID       uuid.UUID
+	SweepID        uuid.UUID
+	Active         bool
+}
+
+// ListSweepsRequest is the input for listing a tenant's sweep
+// instructions.
+type ListSweepsRequest struct {
+	Limit    int
+	Offset   int
+	TenantID uuid.UUID
+	NostroID uuid.UUID
+}
+
+// SweepResponse is a sweep instruction.
+type SweepResponse struct {
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	LastExecutedOn *time.Time
+	Type           string
+	TriggerBalance decimal.Decimal
+	TargetBalance  decimal.Decimal
+	Version        int
+	ID             uuid.UUID
+	TenantID       uuid.UUID
+	SourceNostroID uuid.UUID
+	TargetNostroID uuid.UUID
+	Active         bool
+}
+
+// ListSweepsResponse is one page of sweep instructions.
+type ListSweepsResponse struct {
+	Sweeps     []SweepResponse
+	TotalCount int
+}
+
+// ListAlertsRequest is the input for listing a tenant's liquidity alerts.
+// Zero fields do not filter.
+type ListAlertsRequest struct {
+	Status   string
+	Limit    int
+	Offset   int
+	TenantID uuid.UUID
+	NostroID uuid.UUID
+}
+
+// AlertResponse is a liquidity alert.
+type AlertResponse struct {
+	BreachDate       time.Time
+	OpenedAt         time.Time
+	ResolvedAt       *time.Time
+	Status           string
+	ProjectedBalance decimal.Decimal
+	MinimumBalance   decimal.Decimal
+	Version          int
+	ID               uuid.UUID
+	TenantID         uuid.UUID
+	NostroID         uuid.UUID
+}
+
+// ListAlertsResponse is one page of liquidity alerts.
+type ListAlertsResponse struct {
+	Alerts     []AlertResponse
+	TotalCount int
+}
+
+// EvaluationResult counts what a liquidity evaluation did.
+type EvaluationResult struct {
+	SweepsExecuted int
+	AlertsOpened   int
+	AlertsResolved int
+}
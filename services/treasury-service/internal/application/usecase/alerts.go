@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/application/dto"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+// ErrInvalidAlertFilter is returned when an alert listing names an unknown
+// status.
+var ErrInvalidAlertFilter = errors.New("invalid alert filter")
+
+// ListAlertsUseCase lists a tenant's liquidity alerts.
+type ListAlertsUseCase struct {
+	alerts port.AlertRepository
+}
+
+// NewListAlertsUseCase creates a new ListAlertsUseCase.
+func NewListAlertsUseCase(alerts port.AlertRepository) *ListAlertsUseCase {
+	return &ListAlertsUseCase{alerts: alerts}
+}
+
+// Execute returns one page of the alerts matching the request.
+func (uc *ListAlertsUseCase) Execute(ctx context.Context, req dto.ListAlertsRequest) (dto.ListAlertsResponse, error) {
+	filter := port.AlertFilter{TenantID: req.TenantID, NostroID: req.NostroID}
+	if req.Status != "" {
+		status, err := valueobject.NewAlertStatus(req.Status)
+		if err != nil {
+			return dto.ListAlertsResponse{}, fmt.Errorf("%w: %w", ErrInvalidAlertFilter, err)
+		}
+		filter.Status = status
+	}
+	limit, offset := page(req.Limit, req.Offset)
+	alerts, total, err := uc.alerts.List(ctx, filter, limit, offset)
+	if err != nil {
+		return dto.ListAlertsResponse{}, fmt.Errorf("failed to list alerts: %w", err)
+	}
+	resp := dto.ListAlertsResponse{
+		Alerts:     make([]dto.AlertResponse, 0, len(alerts)),
+		TotalCount: total,
+	}
+	for _, a := range alerts {
+		resp.Alerts = append(resp.Alerts, toAlertResponse(a))
+	}
+	return resp, nil
+}
+
+func toAlertResponse(a model.LiquidityAlert) dto.AlertResponse {
+	return dto.AlertResponse{
+		ID:               a.ID(),
+		TenantID:         a.TenantID(),
+		NostroID:         a.NostroID(),
+		Status:           a.Status().String(),
+		BreachDate:       a.BreachDate(),
+		ProjectedBalance: a.ProjectedBalance(),
+		MinimumBalance:   a.MinimumBalance(),
+		OpenedAt:         a.OpenedAt(),
+		ResolvedAt:       a.ResolvedAt(),
+		Version:          a.Version(),
+	}
+}
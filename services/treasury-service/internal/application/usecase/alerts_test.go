@@ -0,0 +1,64 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/application/dto"
+	"github.com/bibbank/bib/services/treasury-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+)
+
+// inMemoryAlertRepo is an in-memory AlertRepository.
+type inMemoryAlertRepo struct {
+	alerts []model.LiquidityAlert
+}
+
+func (r *inMemoryAlertRepo) Save(_ context.Context, alert model.LiquidityAlert) error {
+	alert = alert.ClearDomainEvents()
+	for i, a := range r.alerts {
+		if a.ID() == alert.ID() {
+			if a.Version() != alert.Version()-1 {
+				return port.ErrVersionConflict
+			}
+			r.alerts[i] = alert
+			return nil
+		}
+	}
+	r.alerts = append(r.alerts, alert)
+	return nil
+}
+
+func (r *inMemoryAlertRepo) FindOpen(_ context.Context, tenantID, nostroID uuid.UUID) (model.LiquidityAlert, error) {
+	for _, a := range r.alerts {
+		if a.TenantID() == tenantID && a.NostroID() == nostroID && a.IsOpen() {
+			return a, nil
+		}
+	}
+	return model.LiquidityAlert{}, port.ErrAlertNotFound
+}
+
+func (r *inMemoryAlertRepo) List(_ context.Context, filter port.AlertFilter, limit, offset int) ([]model.LiquidityAlert, int, error) {
+	var out []model.LiquidityAlert
+	for _, a := range r.alerts {
+		switch {
+		case a.TenantID() != filter.TenantID,
+			filter.NostroID != uuid.Nil && a.NostroID() != filter.NostroID,
+			!filter.Status.IsZero() && !a.Status().Equal(filter.Status):
+			continue
+		}
+		out = append(out, a)
+	}
+	total := len(out)
+	return out[min(offset, total):min(offset+limit, total)], total, nil
+}
+
+func TestListAlerts_RejectsUnknownStatus(t *testing.T) {
+	_, err := usecase.NewListAlertsUseCase(&inMemoryAlertRepo{}).Execute(context.Background(),
+		dto.ListAlertsRequest{TenantID: uuid.New(), Status: "SNOOZED"})
+	assert.ErrorIs(t, err, usecase.ErrInvalidAlertFilter)
+}
@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/application/dto"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/service"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+// EvaluateLiquidityUseCase projects the positions of a tenant's nostro
+// accounts, executes the sweeps they call for and keeps one alert open per
+// account whose projected balance breaches its minimum.
+type EvaluateLiquidityUseCase struct {
+	nostros   port.NostroRepository
+	forecasts port.ForecastRepository
+	sweeps    port.SweepRepository
+	alerts    port.AlertRepository
+	publisher port.EventPublisher
+	logger    *slog.Logger
+	projector positionProjector
+}
+
+// NewEvaluateLiquidityUseCase creates a new EvaluateLiquidityUseCase.
+// Breaches are looked for horizonDays days ahead.
+func NewEvaluateLiquidityUseCase(
+	nostros port.NostroRepository,
+	forecasts port.ForecastRepository,
+	sweeps port.SweepRepository,
+	alerts port.AlertRepository,
+	readModel port.PositionReadModel,
+	publisher port.EventPublisher,
+	horizonDays int,
+	logger *slog.Logger,
+) *EvaluateLiquidityUseCase {
+	return &EvaluateLiquidityUseCase{
+		nostros:   nostros,
+		forecasts: forecasts,
+		sweeps:    sweeps,
+		alerts:    alerts,
+		publisher: publisher,
+		logger:    logger,
+		projector: positionProjector{forecasts: forecasts, readModel: readModel, defaultHorizon: horizonDays},
+	}
+}
+
+// ExecuteAll evaluates every tenant with an active nostro account. A tenant
+// that fails is logged and does not stop the others.
+func (uc *EvaluateLiquidityUseCase) ExecuteAll(ctx context.Context, now time.Time) (dto.EvaluationResult, error) {
+	tenants, err := uc.nostros.ListTenants(ctx)
+	if err != nil {
+		return dto.EvaluationResult{}, fmt.Errorf("failed to list tenants: %w", err)
+	}
+	var total dto.EvaluationResult
+	var errs []error
+	for _, tenantID := range tenants {
+		result, err := uc.Execute(ctx, tenantID, now)
+		total.SweepsExecuted += result.SweepsExecuted
+		total.AlertsOpened += result.AlertsOpened
+		total.AlertsResolved += result.AlertsResolved
+		if err != nil {
+			uc.logger.Error("liquidity evaluation failed", "tenant_id", tenantID, "error", err)
+			errs = append(errs, err)
+		}
+	}
+	return total, errors.Join(errs...)
+}
+
+// Execute evaluates one tenant at now. Sweeps run first, in the order they
+// were created, each seeing the forecasts of those before it; alerts then
+// follow the positions the sweeps leave.
+func (uc *EvaluateLiquidityUseCase) Execute(ctx context.Context, tenantID uuid.UUID, now time.Time) (dto.EvaluationResult, error) {
+	var result dto.EvaluationResult
+	all, err := uc.nostros.ListAll(ctx, tenantID)
+	if err != nil {
+		return result, fmt.Errorf("failed to list nostro accounts: %w", err)
+	}
+	nostros := make(map[uuid.UUID]model.NostroAccount, len(all))
+	active := make([]model.NostroAccount, 0, len(all))
+	for _, n := range all {
+		if n.Active() {
+			nostros[n.ID()] = n
+			active = append(active, n)
+		}
+	}
+	projected, err := uc.projector.project(ctx, tenantID, active, now, uc.projector.defaultHorizon)
+	if err != nil {
+		return result, err
+	}
+	positions := make(map[uuid.UUID]service.LiquidityPosition, len(projected))
+	for _, p := range projected {
+		positions[p.NostroID] = p
+	}
+
+	sweeps, err := uc.sweeps.ListActive(ctx, tenantID)
+	if err != nil {
+		return result, fmt.Errorf("failed to list sweep instructions: %w", err)
+	}
+	for _, sweep := range sweeps {
+		source, sok := nostros[sweep.SourceNostroID()]
+		target, tok := nostros[sweep.TargetNostroID()]
+		if !sok || !tok {
+			continue
+		}
+		executed, err := uc.executeSweep(ctx, sweep, source, target, positions, now)
+		if err != nil {
+			return result, err
+		}
+		if executed {
+			result.SweepsExecuted++
+		}
+	}
+
+	for _, n := range all {
+		opened, resolved, err := uc.reconcileAlert(ctx, n, positions, now)
+		if err != nil {
+			return result, err
+		}
+		if opened {
+			result.AlertsOpened++
+		}
+		if resolved {
+			result.AlertsResolved++
+		}
+	}
+	return result, nil
+}
+
+// executeSweep runs a sweep when the source account's projection for
+// today calls for it, forecasting both legs for today and reprojecting the
+// two accounts.
+func (uc *EvaluateLiquidityUseCase) executeSweep(
+	ctx context.Context,
+	sweep model.SweepInstruction,
+	source, target model.NostroAccount,
+	positions map[uuid.UUID]service.LiquidityPosition,
+	now time.Time,
+) (bool, error) {
+	sourceProjected := positions[source.ID()].Today()
+	transfer, due := sweep.Due(sourceProjected, now)
+	if !due {
+		return false, nil
+	}
+	description := fmt.Sprintf("%s sweep %s", sweep.Type(), sweep.ID())
+	outflow, err := model.NewSweepForecast(
+		sweep.TenantID(), transfer.FromNostroID, sweep.ID(), valueobject.ForecastDirectionOutflow, transfer.Amount, description, now)
+	if err != nil {
+		return false, fmt.Errorf("failed to forecast sweep %s: %w", sweep.ID(), err)
+	}
+	inflow, err := model.NewSweepForecast(
+		sweep.TenantID(), transfer.ToNostroID, sweep.ID(), valueobject.ForecastDirectionInflow, transfer.Amount, description, now)
+	if err != nil {
+		return false, fmt.Errorf("failed to forecast sweep %s: %w", sweep.ID(), err)
+	}
+	sweep = sweep.RecordExecution(transfer, sourceProjected, source.Currency(), outflow.ID(), inflow.ID(), now)
+
+	// The sweep is saved first so that, should another evaluation race
+	// this one, only the winner forecasts the transfer.
+	if err := uc.sweeps.Save(ctx, sweep); err != nil {
+		if errors.Is(err, port.ErrVersionConflict) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to save sweep instruction: %w", err)
+	}
+	for _, f := range []model.FundingForecast{outflow, inflow} {
+		if err := uc.forecasts.Save(ctx, f); err != nil {
+			return false, fmt.Errorf("failed to save sweep forecast: %w", err)
+		}
+	}
+	if err := uc.publisher.Publish(ctx, sweep.DomainEvents()); err != nil {
+		return false, fmt.Errorf("failed to publish events: %w", err)
+	}
+
+	for _, n := range []model.NostroAccount{source, target} {
+		reprojected, err := uc.projector.project(ctx, sweep.TenantID(), []model.NostroAccount{n}, now, uc.projector.defaultHorizon)
+		if err != nil {
+			return true, err
+		}
+		positions[n.ID()] = reprojected[0]
+	}
+	return true, nil
+}
+
+// reconcileAlert opens, refreshes or resolves a nostro account's alert to
+// match its projected breach. Inactive accounts have none.
+func (uc *EvaluateLiquidityUseCase) reconcileAlert(
+	ctx context.Context,
+	nostro model.NostroAccount,
+	positions map[uuid.UUID]service.LiquidityPosition,
+	now time.Time,
+) (opened, resolved bool, err error) {
+	var breach service.DailyProjection
+	breached := false
+	if position, ok := positions[nostro.ID()]; ok {
+		breach, breached = position.FirstBreach()
+	}
+
+	alert, err := uc.alerts.FindOpen(ctx, nostro.TenantID(), nostro.ID())
+	switch {
+	case errors.Is(err, port.ErrAlertNotFound):
+		if !breached {
+			return false, false, nil
+		}
+		alert, err = model.OpenLiquidityAlert(nostro.TenantID(), nostro.ID(), nostro.Currency(),
+			breach.Date, breach.ClosingBalance, nostro.MinimumBalance(), now)
+		if err != nil {
+			return false, false, fmt.Errorf("failed to open alert: %w", err)
+		}
+		opened = true
+	case err != nil:
+		return false, false, fmt.Errorf("failed to find open alert: %w", err)
+	case breached:
+		var changed bool
+		alert, changed = alert.Refresh(breach.Date, breach.ClosingBalance, nostro.MinimumBalance(), now)
+		if !changed {
+			return false, false, nil
+		}
+	default:
+		alert, err = alert.Resolve(now)
+		if err != nil {
+			return false, false, fmt.Errorf("failed to resolve alert: %w", err)
+		}
+		resolved = true
+	}
+
+	if err := uc.alerts.Save(ctx, alert); err != nil {
+		return false, false, fmt.Errorf("failed to save alert: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, alert.DomainEvents()); err != nil {
+		return false, false, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return opened, resolved, nil
+}
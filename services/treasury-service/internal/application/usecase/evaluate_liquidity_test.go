@@ -0,0 +1,134 @@
+package usecase_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/application/dto"
+	"github.com/bibbank/bib/services/treasury-service/internal/application/usecase"
+)
+
+// liquidity wires EvaluateLiquidityUseCase over in-memory adapters.
+type liquidity struct {
+	nostros   *inMemoryNostroRepo
+	forecasts *inMemoryForecastRepo
+	sweeps    *inMemorySweepRepo
+	alerts    *inMemoryAlertRepo
+	readModel *memReadModel
+	publisher *recordingPublisher
+	evaluator *usecase.EvaluateLiquidityUseCase
+	tenantID  uuid.UUID
+}
+
+func newLiquidity() liquidity {
+	l := liquidity{
+		nostros:   &inMemoryNostroRepo{},
+		forecasts: &inMemoryForecastRepo{},
+		sweeps:    &inMemorySweepRepo{},
+		alerts:    &inMemoryAlertRepo{},
+		readModel: newMemReadModel(),
+		publisher: &recordingPublisher{},
+		tenantID:  uuid.New(),
+	}
+	l.evaluator = usecase.NewEvaluateLiquidityUseCase(l.nostros, l.forecasts, l.sweeps, l.alerts, l.readModel, l.publisher,
+		5, slog.Default())
+	return l
+}
+
+func (l liquidity) closingToday(t *testing.T, nostro dto.NostroAccountResponse) string {
+	t.Helper()
+	return position(t, l.nostros, l.forecasts, l.readModel, l.tenantID, nostro.ID).Projections[0].ClosingBalance.String()
+}
+
+func TestEvaluateLiquidity_ExecutesSweepsOncePerDay(t *testing.T) {
+	ctx := context.Background()
+	l := newLiquidity()
+	operating := createNostro(t, l.nostros, l.tenantID, "USD", "1100-USD", 5000, 500)
+	reserve := createNostro(t, l.nostros, l.tenantID, "USD", "1101-USD", 0, 0)
+
+	_, err := usecase.NewCreateSweepUseCase(l.nostros, l.sweeps).Execute(ctx, dto.CreateSweepRequest{
+		TenantID: l.tenantID, SourceNostroID: operating.ID, TargetNostroID: reserve.ID, Type: "EXCESS",
+		TriggerBalance: d(3000), TargetBalance: d(2000),
+	})
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	result, err := l.evaluator.ExecuteAll(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, dto.EvaluationResult{SweepsExecuted: 1}, result)
+	assert.Equal(t, []string{"treasury.sweep.executed"}, l.publisher.eventTypes())
+
+	require.Len(t, l.forecasts.forecasts, 2)
+	assert.Equal(t, "2000", l.closingToday(t, operating))
+	assert.Equal(t, "3000", l.closingToday(t, reserve))
+
+	result, err = l.evaluator.Execute(ctx, l.tenantID, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, dto.EvaluationResult{}, result, "the sweep already ran today")
+}
+
+func TestEvaluateLiquidity_CoverSweepPreventsBreach(t *testing.T) {
+	ctx := context.Background()
+	l := newLiquidity()
+	operating := createNostro(t, l.nostros, l.tenantID, "EUR", "1100-EUR", 1000, 500)
+	funding := createNostro(t, l.nostros, l.tenantID, "EUR", "1101-EUR", 10_000, 0)
+	createForecast(t, l.nostros, l.forecasts, l.tenantID, operating.ID, 0, "OUTFLOW", 800)
+
+	_, err := usecase.NewCreateSweepUseCase(l.nostros, l.sweeps).Execute(ctx, dto.CreateSweepRequest{
+		TenantID: l.tenantID, SourceNostroID: operating.ID, TargetNostroID: funding.ID, Type: "COVER",
+		TriggerBalance: d(500), TargetBalance: d(1000),
+	})
+	require.NoError(t, err)
+
+	result, err := l.evaluator.Execute(ctx, l.tenantID, time.Now().UTC())
+	require.NoError(t, err)
+	assert.Equal(t, dto.EvaluationResult{SweepsExecuted: 1}, result, "the sweep runs before alerts are reconciled")
+	assert.Equal(t, "1000", l.closingToday(t, operating))
+	assert.Equal(t, "9200", l.closingToday(t, funding))
+	assert.Empty(t, l.alerts.alerts)
+}
+
+func TestEvaluateLiquidity_OpensRefreshesAndResolvesAlerts(t *testing.T) {
+	ctx := context.Background()
+	l := newLiquidity()
+	usd := createNostro(t, l.nostros, l.tenantID, "USD", "1100-USD", 1000, 500)
+	outflow := createForecast(t, l.nostros, l.forecasts, l.tenantID, usd.ID, 2, "OUTFLOW", 700)
+
+	now := time.Now().UTC()
+	result, err := l.evaluator.Execute(ctx, l.tenantID, now)
+	require.NoError(t, err)
+	assert.Equal(t, dto.EvaluationResult{AlertsOpened: 1}, result)
+	require.Len(t, l.alerts.alerts, 1)
+	assert.Equal(t, today().AddDate(0, 0, 2), l.alerts.alerts[0].BreachDate())
+	assert.Equal(t, "300", l.alerts.alerts[0].ProjectedBalance().String())
+
+	// An earlier breach moves the open alert rather than opening another.
+	createForecast(t, l.nostros, l.forecasts, l.tenantID, usd.ID, 1, "OUTFLOW", 600)
+	result, err = l.evaluator.Execute(ctx, l.tenantID, now)
+	require.NoError(t, err)
+	assert.Equal(t, dto.EvaluationResult{}, result)
+	require.Len(t, l.alerts.alerts, 1)
+	assert.Equal(t, today().AddDate(0, 0, 1), l.alerts.alerts[0].BreachDate())
+	assert.Equal(t, 2, l.alerts.alerts[0].Version())
+
+	_, err = usecase.NewCancelForecastUseCase(l.forecasts).Execute(ctx, l.tenantID, outflow.ID)
+	require.NoError(t, err)
+	createForecast(t, l.nostros, l.forecasts, l.tenantID, usd.ID, 1, "INFLOW", 600)
+	result, err = l.evaluator.Execute(ctx, l.tenantID, now)
+	require.NoError(t, err)
+	assert.Equal(t, dto.EvaluationResult{AlertsResolved: 1}, result)
+	assert.False(t, l.alerts.alerts[0].IsOpen())
+
+	assert.Equal(t, []string{"treasury.liquidity.threshold_breached", "treasury.liquidity.threshold_restored"},
+		l.publisher.eventTypes())
+
+	alerts, err := usecase.NewListAlertsUseCase(l.alerts).Execute(ctx, dto.ListAlertsRequest{TenantID: l.tenantID, Status: "RESOLVED"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, alerts.TotalCount)
+}
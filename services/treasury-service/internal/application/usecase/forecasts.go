@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/application/dto"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+// ErrInvalidForecast is returned when a forecast request is malformed.
+var ErrInvalidForecast = errors.New("invalid funding forecast")
+
+// ErrNostroInactive is returned when a forecast or sweep names an inactive
+// nostro account.
+var ErrNostroInactive = errors.New("nostro account is inactive")
+
+// CreateForecastUseCase forecasts a movement on a nostro account.
+type CreateForecastUseCase struct {
+	nostros   port.NostroRepository
+	forecasts port.ForecastRepository
+}
+
+// NewCreateForecastUseCase creates a new CreateForecastUseCase.
+func NewCreateForecastUseCase(nostros port.NostroRepository, forecasts port.ForecastRepository) *CreateForecastUseCase {
+	return &CreateForecastUseCase{nostros: nostros, forecasts: forecasts}
+}
+
+// Execute creates the forecast.
+func (uc *CreateForecastUseCase) Execute(ctx context.Context, req dto.CreateForecastRequest) (dto.ForecastResponse, error) {
+	direction, err := valueobject.NewForecastDirection(req.Direction)
+	if err != nil {
+		return dto.ForecastResponse{}, fmt.Errorf("%w: %w", ErrInvalidForecast, err)
+	}
+	nostro, err := uc.nostros.FindByID(ctx, req.TenantID, req.NostroID)
+	if err != nil {
+		return dto.ForecastResponse{}, fmt.Errorf("failed to find nostro account: %w", err)
+	}
+	if !nostro.Active() {
+		return dto.ForecastResponse{}, ErrNostroInactive
+	}
+	forecast, err := model.NewFundingForecast(
+		req.TenantID, nostro.ID(), req.ValueDate, direction, req.Amount, req.Description, time.Now().UTC())
+	if err != nil {
+		return dto.ForecastResponse{}, fmt.Errorf("%w: %w", ErrInvalidForecast, err)
+	}
+
+	if err := uc.forecasts.Save(ctx, forecast); err != nil {
+		return dto.ForecastResponse{}, fmt.Errorf("failed to save forecast: %w", err)
+	}
+	return toForecastResponse(forecast), nil
+}
+
+// CancelForecastUseCase stops a forecast counting towards projections.
+type CancelForecastUseCase struct {
+	forecasts port.ForecastRepository
+}
+
+// NewCancelForecastUseCase creates a new CancelForecastUseCase.
+func NewCancelForecastUseCase(forecasts port.ForecastRepository) *CancelForecastUseCase {
+	return &CancelForecastUseCase{forecasts: forecasts}
+}
+
+// Execute cancels the forecast.
+func (uc *CancelForecastUseCase) Execute(ctx context.Context, tenantID, forecastID uuid.UUID) (dto.ForecastResponse, error) {
+	forecast, err := uc.forecasts.FindByID(ctx, tenantID, forecastID)
+	if err != nil {
+		return dto.ForecastResponse{}, fmt.Errorf("failed to find forecast: %w", err)
+	}
+	forecast, err = forecast.Cancel(time.Now().UTC())
+	if err != nil {
+		return dto.ForecastResponse{}, fmt.Errorf("%w: %w", ErrInvalidForecast, err)
+	}
+
+	if err := uc.forecasts.Save(ctx, forecast); err != nil {
+		return dto.ForecastResponse{}, fmt.Errorf("failed to save forecast: %w", err)
+	}
+	return toForecastResponse(forecast), nil
+}
+
+// ListForecastsUseCase lists a tenant's forecasts.
+type ListForecastsUseCase struct {
+	forecasts port.ForecastRepository
+}
+
+// NewListForecastsUseCase creates a new ListForecastsUseCase.
+func NewListForecastsUseCase(forecasts port.ForecastRepository) *ListForecastsUseCase {
+	return &ListForecastsUseCase{forecasts: forecasts}
+}
+
+// Execute returns one page of the forecasts matching the request.
+func (uc *ListForecastsUseCase) Execute(ctx context.Context, req dto.ListForecastsRequest) (dto.ListForecastsResponse, error) {
+	if !req.From.IsZero() && !req.To.IsZero() && req.To.Before(req.From) {
+		return dto.ListForecastsResponse{}, fmt.Errorf("%w: to date is before from date", ErrInvalidForecast)
+	}
+	limit, offset := page(req.Limit, req.Offset)
+	forecasts, total, err := uc.forecasts.List(ctx, port.ForecastFilter{
+		TenantID:         req.TenantID,
+		NostroID:         req.NostroID,
+		From:             req.From,
+		To:               req.To,
+		IncludeCancelled: req.IncludeCancelled,
+	}, limit, offset)
+	if err != nil {
+		return dto.ListForecastsResponse{}, fmt.Errorf("failed to list forecasts: %w", err)
+	}
+	resp := dto.ListForecastsResponse{
+		Forecasts:  make([]dto.ForecastResponse, 0, len(forecasts)),
+		TotalCount: total,
+	}
+	for _, f := range forecasts {
+		resp.Forecasts = append(resp.Forecasts, toForecastResponse(f))
+	}
+	return resp, nil
+}
+
+func toForecastResponse(f model.FundingForecast) dto.ForecastResponse {
+	return dto.ForecastResponse{
+		ID:          f.ID(),
+		TenantID:    f.TenantID(),
+		NostroID:    f.NostroID(),
+		SweepID:     f.SweepID(),
+		ValueDate:   f.ValueDate(),
+		Direction:   f.Direction().String(),
+		Amount:      f.Amount(),
+		Description: f.Description(),
+		Status:      f.Status().String(),
+		Version:     f.Version(),
+		CreatedAt:   f.CreatedAt(),
+		UpdatedAt:   f.UpdatedAt(),
+	}
+}
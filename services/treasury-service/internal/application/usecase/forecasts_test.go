@@ -0,0 +1,108 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/application/dto"
+	"github.com/bibbank/bib/services/treasury-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+)
+
+func today() time.Time { return model.DateOf(time.Now()) }
+
+// inMemoryForecastRepo is an in-memory ForecastRepository.
+type inMemoryForecastRepo struct {
+	forecasts []model.FundingForecast
+}
+
+func (r *inMemoryForecastRepo) Save(_ context.Context, forecast model.FundingForecast) error {
+	for i, f := range r.forecasts {
+		if f.ID() == forecast.ID() {
+			if f.Version() != forecast.Version()-1 {
+				return port.ErrVersionConflict
+			}
+			r.forecasts[i] = forecast
+			return nil
+		}
+	}
+	r.forecasts = append(r.forecasts, forecast)
+	return nil
+}
+
+func (r *inMemoryForecastRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.FundingForecast, error) {
+	for _, f := range r.forecasts {
+		if f.TenantID() == tenantID && f.ID() == id {
+			return f, nil
+		}
+	}
+	return model.FundingForecast{}, port.ErrForecastNotFound
+}
+
+func (r *inMemoryForecastRepo) List(_ context.Context, filter port.ForecastFilter, limit, offset int) ([]model.FundingForecast, int, error) {
+	var out []model.FundingForecast
+	for _, f := range r.forecasts {
+		switch {
+		case f.TenantID() != filter.TenantID,
+			filter.NostroID != uuid.Nil && f.NostroID() != filter.NostroID,
+			!filter.From.IsZero() && f.ValueDate().Before(filter.From),
+			!filter.To.IsZero() && f.ValueDate().After(filter.To),
+			!filter.IncludeCancelled && f.Status().String() != "EXPECTED":
+			continue
+		}
+		out = append(out, f)
+	}
+	total := len(out)
+	return out[min(offset, total):min(offset+limit, total)], total, nil
+}
+
+func (r *inMemoryForecastRepo) ListExpected(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]model.FundingForecast, error) {
+	out, _, err := r.List(ctx, port.ForecastFilter{TenantID: tenantID, From: from, To: to}, len(r.forecasts), 0)
+	return out, err
+}
+
+func createForecast(t *testing.T, nostros *inMemoryNostroRepo, forecasts *inMemoryForecastRepo, tenantID, nostroID uuid.UUID, days int, direction string, amount int64) dto.ForecastResponse {
+	t.Helper()
+	resp, err := usecase.NewCreateForecastUseCase(nostros, forecasts).Execute(context.Background(), dto.CreateForecastRequest{
+		TenantID:  tenantID,
+		NostroID:  nostroID,
+		ValueDate: today().AddDate(0, 0, days),
+		Direction: direction,
+		Amount:    d(amount),
+	})
+	require.NoError(t, err)
+	return resp
+}
+
+func TestForecasts_Validation(t *testing.T) {
+	ctx := context.Background()
+	nostros, forecasts := &inMemoryNostroRepo{}, &inMemoryForecastRepo{}
+	tenantID := uuid.New()
+	usd := createNostro(t, nostros, tenantID, "USD", "1100-USD", 1000, 200)
+	eur := createNostro(t, nostros, tenantID, "EUR", "1100-EUR", 1000, 200)
+
+	_, err := usecase.NewUpdateNostroAccountUseCase(nostros).Execute(ctx, dto.UpdateNostroAccountRequest{
+		TenantID: tenantID, NostroID: usd.ID, Name: usd.Name, Active: false,
+	})
+	require.NoError(t, err)
+	_, err = usecase.NewCreateForecastUseCase(nostros, forecasts).Execute(ctx, dto.CreateForecastRequest{
+		TenantID: tenantID, NostroID: usd.ID, Direction: "INFLOW", Amount: d(10), ValueDate: today(),
+	})
+	assert.ErrorIs(t, err, usecase.ErrNostroInactive)
+
+	_, err = usecase.NewCreateForecastUseCase(nostros, forecasts).Execute(ctx, dto.CreateForecastRequest{
+		TenantID: tenantID, NostroID: eur.ID, Direction: "SIDEWAYS", Amount: d(10), ValueDate: today(),
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidForecast)
+
+	_, err = usecase.NewListForecastsUseCase(forecasts).Execute(ctx, dto.ListForecastsRequest{
+		TenantID: tenantID, From: today(), To: today().AddDate(0, 0, -1),
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidForecast)
+}
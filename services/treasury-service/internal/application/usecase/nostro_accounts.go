@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/application/dto"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+// ErrInvalidNostroAccount is returned when a nostro account request is
+// malformed.
+var ErrInvalidNostroAccount = errors.New("invalid nostro account")
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// CreateNostroAccountUseCase starts tracking a nostro account.
+type CreateNostroAccountUseCase struct {
+	nostros   port.NostroRepository
+	publisher port.EventPublisher
+}
+
+// NewCreateNostroAccountUseCase creates a new CreateNostroAccountUseCase.
+func NewCreateNostroAccountUseCase(nostros port.NostroRepository, publisher port.EventPublisher) *CreateNostroAccountUseCase {
+	return &CreateNostroAccountUseCase{nostros: nostros, publisher: publisher}
+}
+
+// Execute creates the nostro account.
+func (uc *CreateNostroAccountUseCase) Execute(ctx context.Context, req dto.CreateNostroAccountRequest) (dto.NostroAccountResponse, error) {
+	bic, err := valueobject.NewBIC(req.CorrespondentBIC)
+	if err != nil {
+		return dto.NostroAccountResponse{}, fmt.Errorf("%w: %w", ErrInvalidNostroAccount, err)
+	}
+	nostro, err := model.NewNostroAccount(
+		req.TenantID, req.Name, bic, req.AccountNumber, req.Currency, req.LedgerAccountCode,
+		req.Rails, req.OpeningBalance, req.MinimumBalance, time.Now().UTC(),
+	)
+	if err != nil {
+		return dto.NostroAccountResponse{}, fmt.Errorf("%w: %w", ErrInvalidNostroAccount, err)
+	}
+
+	if err := uc.nostros.Save(ctx, nostro); err != nil {
+		return dto.NostroAccountResponse{}, fmt.Errorf("failed to save nostro account: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, nostro.DomainEvents()); err != nil {
+		return dto.NostroAccountResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return toNostroAccountResponse(nostro), nil
+}
+
+// UpdateNostroAccountUseCase changes a nostro account's name, rails and
+// minimum balance, or activates or deactivates it.
+type UpdateNostroAccountUseCase struct {
+	nostros port.NostroRepository
+}
+
+// NewUpdateNostroAccountUseCase creates a new UpdateNostroAccountUseCase.
+func NewUpdateNostroAccountUseCase(nostros port.NostroRepository) *UpdateNostroAccountUseCase {
+	return &UpdateNostroAccountUseCase{nostros: nostros}
+}
+
+// Execute updates the nostro account.
+func (uc *UpdateNostroAccountUseCase) Execute(ctx context.Context, req dto.UpdateNostroAccountRequest) (dto.NostroAccountResponse, error) {
+	nostro, err := uc.nostros.FindByID(ctx, req.TenantID, req.NostroID)
+	if err != nil {
+		return dto.NostroAccountResponse{}, fmt.Errorf("failed to find nostro account: %w", err)
+	}
+	nostro, err = nostro.Update(req.Name, req.Rails, req.MinimumBalance, req.Active, time.Now().UTC())
+	if err != nil {
+		return dto.NostroAccountResponse{}, fmt.Errorf("%w: %w", ErrInvalidNostroAccount, err)
+	}
+
+	if err := uc.nostros.Save(ctx, nostro); err != nil {
+		return dto.NostroAccountResponse{}, fmt.Errorf("failed to save nostro account: %w", err)
+	}
+	return toNostroAccountResponse(nostro), nil
+}
+
+// GetNostroAccountUseCase retrieves a nostro account.
+type GetNostroAccountUseCase struct {
+	nostros port.NostroRepository
+}
+
+// NewGetNostroAccountUseCase creates a new GetNostroAccountUseCase.
+func NewGetNostroAccountUseCase(nostros port.NostroRepository) *GetNostroAccountUseCase {
+	return &GetNostroAccountUseCase{nostros: nostros}
+}
+
+// Execute retrieves the nostro account.
+func (uc *GetNostroAccountUseCase) Execute(ctx context.Context, tenantID, nostroID uuid.UUID) (dto.NostroAccountResponse, error) {
+	nostro, err := uc.nostros.FindByID(ctx, tenantID, nostroID)
+	if err != nil {
+		return dto.NostroAccountResponse{}, fmt.Errorf("failed to find nostro account: %w", err)
+	}
+	return toNostroAccountResponse(nostro), nil
+}
+
+// ListNostroAccountsUseCase lists a tenant's nostro accounts.
+type ListNostroAccountsUseCase struct {
+	nostros port.NostroRepository
+}
+
+// NewListNostroAccountsUseCase creates a new ListNostroAccountsUseCase.
+func NewListNostroAccountsUseCase(nostros port.NostroRepository) *ListNostroAccountsUseCase {
+	return &ListNostroAccountsUseCase{nostros: nostros}
+}
+
+// Execute returns one page of the tenant's nostro accounts.
+func (uc *ListNostroAccountsUseCase) Execute(ctx context.Context, req dto.ListNostroAccountsRequest) (dto.ListNostroAccountsResponse, error) {
+	limit, offset := page(req.Limit, req.Offset)
+	nostros, total, err := uc.nostros.List(ctx, req.TenantID, req.Currency, limit, offset)
+	if err != nil {
+		return dto.ListNostroAccountsResponse{}, fmt.Errorf("failed to list nostro accounts: %w", err)
+	}
+	resp := dto.ListNostroAccountsResponse{
+		NostroAccounts: make([]dto.NostroAccountResponse, 0, len(nostros)),
+		TotalCount:     total,
+	}
+	for _, n := range nostros {
+		resp.NostroAccounts = append(resp.NostroAccounts, toNostroAccountResponse(n))
+	}
+	return resp, nil
+}
+
+func page(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	return min(limit, maxLimit), max(offset, 0)
+}
+
+func toNostroAccountResponse(n model.NostroAccount) dto.NostroAccountResponse {
+	return dto.NostroAccountResponse{
+		ID:                n.ID(),
+		TenantID:          n.TenantID(),
+		Name:              n.Name(),
+		CorrespondentBIC:  n.CorrespondentBIC().String(),
+		AccountNumber:     n.AccountNumber(),
+		Currency:          n.Currency(),
+		LedgerAccountCode: n.LedgerAccountCode(),
+		Rails:             n.Rails(),
+		OpeningBalance:    n.OpeningBalance(),
+		MinimumBalance:    n.MinimumBalance(),
+		Active:            n.Active(),
+		Version:           n.Version(),
+		CreatedAt:         n.CreatedAt(),
+		UpdatedAt:         n.UpdatedAt(),
+	}
+}
@@ -0,0 +1,158 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/application/dto"
+	"github.com/bibbank/bib/services/treasury-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/event"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+)
+
+func d(v int64) decimal.Decimal { return decimal.NewFromInt(v) }
+
+// inMemoryNostroRepo is an in-memory NostroRepository.
+type inMemoryNostroRepo struct {
+	nostros []model.NostroAccount
+}
+
+func (r *inMemoryNostroRepo) Save(_ context.Context, nostro model.NostroAccount) error {
+	// Events are not persisted.
+	nostro = nostro.ClearDomainEvents()
+	for i, n := range r.nostros {
+		if n.ID() == nostro.ID() {
+			if n.Version() != nostro.Version()-1 {
+				return port.ErrVersionConflict
+			}
+			r.nostros[i] = nostro
+			return nil
+		}
+	}
+	r.nostros = append(r.nostros, nostro)
+	return nil
+}
+
+func (r *inMemoryNostroRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.NostroAccount, error) {
+	for _, n := range r.nostros {
+		if n.TenantID() == tenantID && n.ID() == id {
+			return n, nil
+		}
+	}
+	return model.NostroAccount{}, port.ErrNostroNotFound
+}
+
+func (r *inMemoryNostroRepo) List(ctx context.Context, tenantID uuid.UUID, currency string, limit, offset int) ([]model.NostroAccount, int, error) {
+	all, _ := r.ListAll(ctx, tenantID)
+	var out []model.NostroAccount
+	for _, n := range all {
+		if currency == "" || n.Currency() == currency {
+			out = append(out, n)
+		}
+	}
+	total := len(out)
+	return out[min(offset, total):min(offset+limit, total)], total, nil
+}
+
+func (r *inMemoryNostroRepo) ListAll(_ context.Context, tenantID uuid.UUID) ([]model.NostroAccount, error) {
+	var out []model.NostroAccount
+	for _, n := range r.nostros {
+		if n.TenantID() == tenantID {
+			out = append(out, n)
+		}
+	}
+	return out, nil
+}
+
+func (r *inMemoryNostroRepo) ListTenants(_ context.Context) ([]uuid.UUID, error) {
+	var out []uuid.UUID
+	seen := map[uuid.UUID]bool{}
+	for _, n := range r.nostros {
+		if n.Active() && !seen[n.TenantID()] {
+			seen[n.TenantID()] = true
+			out = append(out, n.TenantID())
+		}
+	}
+	return out, nil
+}
+
+// recordingPublisher is an EventPublisher that records what it publishes.
+type recordingPublisher struct {
+	events []event.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, events []event.DomainEvent) error {
+	p.events = append(p.events, events...)
+	return nil
+}
+
+func (p *recordingPublisher) eventTypes() []string {
+	types := make([]string, len(p.events))
+	for i, e := range p.events {
+		types[i] = e.EventType()
+	}
+	return types
+}
+
+func createNostroRequest(tenantID uuid.UUID, currency, ledgerCode string, opening, minimum int64, rails ...string) dto.CreateNostroAccountRequest {
+	return dto.CreateNostroAccountRequest{
+		TenantID:          tenantID,
+		Name:              currency + " nostro " + ledgerCode,
+		CorrespondentBIC:  "chasus33",
+		AccountNumber:     "0001234567",
+		Currency:          currency,
+		LedgerAccountCode: ledgerCode,
+		Rails:             rails,
+		OpeningBalance:    d(opening),
+		MinimumBalance:    d(minimum),
+	}
+}
+
+func createNostro(t *testing.T, nostros *inMemoryNostroRepo, tenantID uuid.UUID, currency, ledgerCode string, opening, minimum int64, rails ...string) dto.NostroAccountResponse {
+	t.Helper()
+	resp, err := usecase.NewCreateNostroAccountUseCase(nostros, &recordingPublisher{}).Execute(context.Background(),
+		createNostroRequest(tenantID, currency, ledgerCode, opening, minimum, rails...))
+	require.NoError(t, err)
+	return resp
+}
+
+func TestNostroAccounts(t *testing.T) {
+	ctx := context.Background()
+	nostros, publisher := &inMemoryNostroRepo{}, &recordingPublisher{}
+	tenantID := uuid.New()
+
+	created, err := usecase.NewCreateNostroAccountUseCase(nostros, publisher).Execute(ctx,
+		createNostroRequest(tenantID, "USD", "1100-USD", 1000, 200, "swift"))
+	require.NoError(t, err)
+	assert.Equal(t, "CHASUS33", created.CorrespondentBIC)
+	assert.Equal(t, []string{"SWIFT"}, created.Rails)
+	assert.Equal(t, []string{"treasury.nostro.created"}, publisher.eventTypes())
+	createNostro(t, nostros, tenantID, "EUR", "1100-EUR", 0, 0)
+
+	_, err = usecase.NewCreateNostroAccountUseCase(nostros, publisher).Execute(ctx, dto.CreateNostroAccountRequest{
+		TenantID: tenantID, Name: "x", CorrespondentBIC: "nope", AccountNumber: "1", Currency: "USD", LedgerAccountCode: "1",
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidNostroAccount)
+
+	updated, err := usecase.NewUpdateNostroAccountUseCase(nostros).Execute(ctx, dto.UpdateNostroAccountRequest{
+		TenantID: tenantID, NostroID: created.ID, Name: "USD main", MinimumBalance: d(300), Active: false,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Version)
+	assert.False(t, updated.Active)
+
+	list, err := usecase.NewListNostroAccountsUseCase(nostros).Execute(ctx, dto.ListNostroAccountsRequest{
+		TenantID: tenantID, Currency: "USD",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, list.TotalCount)
+
+	_, err = usecase.NewGetNostroAccountUseCase(nostros).Execute(ctx, uuid.New(), created.ID)
+	assert.ErrorIs(t, err, port.ErrNostroNotFound, "other tenants' accounts are not found")
+}
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/application/dto"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/service"
+)
+
+// ErrInvalidHorizon is returned when a projection horizon is out of range.
+var ErrInvalidHorizon = errors.New("invalid projection horizon")
+
+// positionProjector projects nostro account positions from the read model
+// and forecasts.
+type positionProjector struct {
+	forecasts      port.ForecastRepository
+	readModel      port.PositionReadModel
+	defaultHorizon int
+}
+
+// horizon resolves a requested horizon, zero meaning the default.
+func (p positionProjector) horizon(days int) (int, error) {
+	if days == 0 {
+		days = p.defaultHorizon
+	}
+	if days < 0 || days > service.MaxHorizonDays {
+		return 0, fmt.Errorf("%w: must be between 0 and %d days", ErrInvalidHorizon, service.MaxHorizonDays)
+	}
+	return days, nil
+}
+
+// project returns the positions of the given nostro accounts, all of one
+// tenant, at now.
+func (p positionProjector) project(
+	ctx context.Context,
+	tenantID uuid.UUID,
+	nostros []model.NostroAccount,
+	now time.Time,
+	horizonDays int,
+) ([]service.LiquidityPosition, error) {
+	if len(nostros) == 0 {
+		return nil, nil
+	}
+	today := model.DateOf(now)
+	forecasts, err := p.forecasts.ListExpected(ctx, tenantID, today, today.AddDate(0, 0, horizonDays))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list forecasts: %w", err)
+	}
+	positions := make([]service.LiquidityPosition, 0, len(nostros))
+	for _, n := range nostros {
+		movements, pending, err := p.readModel.Balances(ctx, n.ID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read balances of nostro account %s: %w", n.ID(), err)
+		}
+		positions = append(positions, service.ProjectPosition(n, movements, pending, forecasts, now, horizonDays))
+	}
+	return positions, nil
+}
+
+// GetLiquidityPositionUseCase projects a nostro account's position.
+type GetLiquidityPositionUseCase struct {
+	nostros   port.NostroRepository
+	projector positionProjector
+}
+
+// NewGetLiquidityPositionUseCase creates a new GetLiquidityPositionUseCase.
+// Positions are projected defaultHorizon days ahead unless the caller asks
+// otherwise.
+func NewGetLiquidityPositionUseCase(
+	nostros port.NostroRepository,
+	forecasts port.ForecastRepository,
+	readModel port.PositionReadModel,
+	defaultHorizon int,
+) *GetLiquidityPositionUseCase {
+	return &GetLiquidityPositionUseCase{
+		nostros:   nostros,
+		projector: positionProjector{forecasts: forecasts, readModel: readModel, defaultHorizon: defaultHorizon},
+	}
+}
+
+// Execute projects the nostro account's position now.
+func (uc *GetLiquidityPositionUseCase) Execute(ctx context.Context, req dto.GetLiquidityPositionRequest) (dto.LiquidityPositionResponse, error) {
+	horizon, err := uc.projector.horizon(req.HorizonDays)
+	if err != nil {
+		return dto.LiquidityPositionResponse{}, err
+	}
+	nostro, err := uc.nostros.FindByID(ctx, req.TenantID, req.NostroID)
+	if err != nil {
+		return dto.LiquidityPositionResponse{}, fmt.Errorf("failed to find nostro account: %w", err)
+	}
+	positions, err := uc.projector.project(ctx, req.TenantID, []model.NostroAccount{nostro}, time.Now().UTC(), horizon)
+	if err != nil {
+		return dto.LiquidityPositionResponse{}, err
+	}
+	return toLiquidityPositionResponse(positions[0]), nil
+}
+
+// ListLiquidityPositionsUseCase projects the positions of a tenant's
+// active nostro accounts.
+type ListLiquidityPositionsUseCase struct {
+	nostros   port.NostroRepository
+	projector positionProjector
+}
+
+// NewListLiquidityPositionsUseCase creates a new ListLiquidityPositionsUseCase.
+func NewListLiquidityPositionsUseCase(
+	nostros port.NostroRepository,
+	forecasts port.ForecastRepository,
+	readModel port.PositionReadModel,
+	defaultHorizon int,
+) *ListLiquidityPositionsUseCase {
+	return &ListLiquidityPositionsUseCase{
+		nostros:   nostros,
+		projector: positionProjector{forecasts: forecasts, readModel: readModel, defaultHorizon: defaultHorizon},
+	}
+}
+
+// Execute projects the positions now, those of one currency when the
+// request names it.
+func (uc *ListLiquidityPositionsUseCase) Execute(ctx context.Context, req dto.ListLiquidityPositionsRequest) ([]dto.LiquidityPositionResponse, error) {
+	horizon, err := uc.projector.horizon(req.HorizonDays)
+	if err != nil {
+		return nil, err
+	}
+	all, err := uc.nostros.ListAll(ctx, req.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nostro accounts: %w", err)
+	}
+	nostros := make([]model.NostroAccount, 0, len(all))
+	for _, n := range all {
+		if n.Active() && (req.Currency == "" || n.Currency() == req.Currency) {
+			nostros = append(nostros, n)
+		}
+	}
+	positions, err := uc.projector.project(ctx, req.TenantID, nostros, time.Now().UTC(), horizon)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]dto.LiquidityPositionResponse, 0, len(positions))
+	for _, p := range positions {
+		resp = append(resp, toLiquidityPositionResponse(p))
+	}
+	return resp, nil
+}
+
+func toLiquidityPositionResponse(p service.LiquidityPosition) dto.LiquidityPositionResponse {
+	resp := dto.LiquidityPositionResponse{
+		NostroID:         p.NostroID,
+		Currency:         p.Currency,
+		BookedBalance:    p.BookedBalance,
+		PendingOutflows:  p.PendingOutflows,
+		AvailableBalance: p.AvailableBalance,
+		MinimumBalance:   p.MinimumBalance,
+		Projections:      make([]dto.DailyProjectionResponse, 0, len(p.Projections)),
+		AsOf:             p.AsOf,
+	}
+	for _, d := range p.Projections {
+		resp.Projections = append(resp.Projections, dto.DailyProjectionResponse{
+			Date:           d.Date,
+			Inflows:        d.Inflows,
+			Outflows:       d.Outflows,
+			ClosingBalance: d.ClosingBalance,
+			BelowMinimum:   d.BelowMinimum,
+		})
+	}
+	return resp
+}
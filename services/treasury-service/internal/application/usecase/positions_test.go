@@ -0,0 +1,87 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/application/dto"
+	"github.com/bibbank/bib/services/treasury-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/service"
+)
+
+// memReadModel is an in-memory PositionReadModel.
+type memReadModel struct {
+	movements map[[2]uuid.UUID]service.LedgerMovement
+	exposures map[uuid.UUID]service.PaymentExposure
+}
+
+func newMemReadModel() *memReadModel {
+	return &memReadModel{
+		movements: map[[2]uuid.UUID]service.LedgerMovement{},
+		exposures: map[uuid.UUID]service.PaymentExposure{},
+	}
+}
+
+func (m *memReadModel) RecordMovements(_ context.Context, movements ...service.LedgerMovement) error {
+	for _, mv := range movements {
+		key := [2]uuid.UUID{mv.EntryID, mv.NostroID}
+		if _, ok := m.movements[key]; !ok {
+			m.movements[key] = mv
+		}
+	}
+	return nil
+}
+
+func (m *memReadModel) SaveExposure(_ context.Context, exposure service.PaymentExposure) error {
+	m.exposures[exposure.PaymentID] = exposure
+	return nil
+}
+
+func (m *memReadModel) FindExposure(_ context.Context, paymentID uuid.UUID) (service.PaymentExposure, error) {
+	e, ok := m.exposures[paymentID]
+	if !ok {
+		return service.PaymentExposure{}, port.ErrProjectionNotFound
+	}
+	return e, nil
+}
+
+func (m *memReadModel) Balances(_ context.Context, nostroID uuid.UUID) (decimal.Decimal, decimal.Decimal, error) {
+	movements, pending := decimal.Zero, decimal.Zero
+	for _, mv := range m.movements {
+		if mv.NostroID == nostroID {
+			movements = movements.Add(mv.Amount)
+		}
+	}
+	for _, e := range m.exposures {
+		if e.NostroID == nostroID && e.Status == service.ExposurePending {
+			pending = pending.Add(e.Amount)
+		}
+	}
+	return movements, pending, nil
+}
+
+func position(t *testing.T, nostros *inMemoryNostroRepo, forecasts *inMemoryForecastRepo, readModel *memReadModel, tenantID, nostroID uuid.UUID) dto.LiquidityPositionResponse {
+	t.Helper()
+	resp, err := usecase.NewGetLiquidityPositionUseCase(nostros, forecasts, readModel, 5).Execute(context.Background(),
+		dto.GetLiquidityPositionRequest{TenantID: tenantID, NostroID: nostroID})
+	require.NoError(t, err)
+	return resp
+}
+
+func TestGetLiquidityPosition_RejectsHorizonOutOfRange(t *testing.T) {
+	nostros := &inMemoryNostroRepo{}
+	tenantID := uuid.New()
+	usd := createNostro(t, nostros, tenantID, "USD", "1100-USD", 1000, 0)
+	uc := usecase.NewGetLiquidityPositionUseCase(nostros, &inMemoryForecastRepo{}, newMemReadModel(), 5)
+
+	for _, days := range []int{-1, service.MaxHorizonDays + 1} {
+		_, err := uc.Execute(context.Background(), dto.GetLiquidityPositionRequest{TenantID: tenantID, NostroID: usd.ID, HorizonDays: days})
+		assert.ErrorIs(t, err, usecase.ErrInvalidHorizon, "horizon %d", days)
+	}
+}
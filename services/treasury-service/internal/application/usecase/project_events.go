@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/service"
+)
+
+// Topics nostro positions are projected from.
+const (
+	PaymentOrdersTopic = "bib.payment.orders"
+	LedgerEntriesTopic = "bib.ledger.entries"
+)
+
+// Topics lists the topics nostro positions are projected from.
+var Topics = []string{
+	PaymentOrdersTopic,
+	LedgerEntriesTopic,
+}
+
+// projectedEvent holds the fields of the events positions are projected
+// from. Each event sets the subset it carries.
+type projectedEvent struct {
+	OccurredAt           time.Time          `json:"occurred_at"`
+	TenantID             string             `json:"tenant_id"`
+	Currency             string             `json:"currency"`
+	Rail                 string             `json:"rail"`
+	Postings             []projectedPosting `json:"postings"`
+	Amount               decimal.Decimal    `json:"amount"`
+	EntryID              uuid.UUID          `json:"entry_id"`
+	ReversalEntryID      uuid.UUID          `json:"reversal_entry_id"`
+	PaymentID            uuid.UUID          `json:"payment_id"`
+	DestinationAccountID uuid.UUID          `json:"destination_account_id"`
+}
+
+// projectedPosting is a debit/credit pair of a ledger entry event.
+type projectedPosting struct {
+	DebitAccount  string          `json:"debit_account"`
+	CreditAccount string          `json:"credit_account"`
+	Currency      string          `json:"currency"`
+	Amount        decimal.Decimal `json:"amount"`
+}
+
+// ProjectEventUseCase applies ledger and payment events to the read model
+// nostro balances are derived from. Ledger entries touching a nostro
+// account's ledger account move its booked balance; outgoing payments are
+// pending outflows on the nostro account they settle through until they
+// settle or fail. Projection is idempotent, and events positions do not
+// use are ignored.
+type ProjectEventUseCase struct {
+	nostros   port.NostroRepository
+	readModel port.PositionReadModel
+}
+
+// NewProjectEventUseCase creates a new ProjectEventUseCase.
+func NewProjectEventUseCase(nostros port.NostroRepository, readModel port.PositionReadModel) *ProjectEventUseCase {
+	return &ProjectEventUseCase{nostros: nostros, readModel: readModel}
+}
+
+// Execute applies an event of the given type. The payload is a CloudEvents
+// envelope or the bare event.
+func (uc *ProjectEventUseCase) Execute(ctx context.Context, eventType string, payload []byte) error {
+	switch eventType {
+	case "ledger.entry.posted", "ledger.entry.reversed",
+		"payment.order.initiated", "payment.order.settled", "payment.order.failed":
+	default:
+		return nil
+	}
+
+	data, err := events.EventData(payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	var evt projectedEvent
+	if err := json.Unmarshal(data, &evt); err != nil {
+		return fmt.Errorf("failed to decode %s event: %w", eventType, err)
+	}
+	tenantID, err := uuid.Parse(evt.TenantID)
+	if err != nil {
+		return fmt.Errorf("%s event has invalid tenant ID %q: %w", eventType, evt.TenantID, err)
+	}
+	// The read model stores times to the microsecond.
+	at := evt.OccurredAt.UTC().Truncate(time.Microsecond)
+
+	switch eventType {
+	case "ledger.entry.posted":
+		return uc.projectEntry(ctx, tenantID, evt.EntryID, at, evt.Postings)
+	case "ledger.entry.reversed":
+		// The reversal is an entry of its own, with the postings swapped.
+		return uc.projectEntry(ctx, tenantID, evt.ReversalEntryID, at, evt.Postings)
+	case "payment.order.initiated":
+		return uc.projectInitiated(ctx, tenantID, at, evt)
+	default:
+		return uc.projectOutcome(ctx, eventType, at, evt.PaymentID)
+	}
+}
+
+func (uc *ProjectEventUseCase) projectEntry(ctx context.Context, tenantID, entryID uuid.UUID, at time.Time, projected []projectedPosting) error {
+	if entryID == uuid.Nil || len(projected) == 0 {
+		// Entries published before events carried their postings cannot
+		// be attributed to accounts.
+		return nil
+	}
+	nostros, err := uc.nostros.ListAll(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to list nostro accounts: %w", err)
+	}
+	postings := make([]service.Posting, 0, len(projected))
+	for _, p := range projected {
+		postings = append(postings, service.Posting(p))
+	}
+	movements := service.NostroMovements(nostros, entryID, tenantID, at, postings)
+	if len(movements) == 0 {
+		return nil
+	}
+	return uc.readModel.RecordMovements(ctx, movements...)
+}
+
+// projectInitiated starts tracking an outgoing payment against the nostro
+// account it settles through. Payments between the bank's own accounts
+// never leave it and are ignored.
+func (uc *ProjectEventUseCase) projectInitiated(ctx context.Context, tenantID uuid.UUID, at time.Time, evt projectedEvent) error {
+	if evt.DestinationAccountID != uuid.Nil {
+		return nil
+	}
+	_, err := uc.readModel.FindExposure(ctx, evt.PaymentID)
+	switch {
+	case err == nil:
+		return nil
+	case !errors.Is(err, port.ErrProjectionNotFound):
+		return fmt.Errorf("failed to find payment %s: %w", evt.PaymentID, err)
+	}
+
+	nostros, err := uc.nostros.ListAll(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to list nostro accounts: %w", err)
+	}
+	nostro, ok := service.SelectNostro(nostros, evt.Currency, evt.Rail)
+	if !ok {
+		return nil
+	}
+	return uc.readModel.SaveExposure(ctx, service.PaymentExposure{
+		PaymentID:   evt.PaymentID,
+		TenantID:    tenantID,
+		NostroID:    nostro.ID(),
+		Status:      service.ExposurePending,
+		Currency:    evt.Currency,
+		Rail:        evt.Rail,
+		Amount:      evt.Amount,
+		InitiatedAt: at,
+		UpdatedAt:   at,
+	})
+}
+
+// projectOutcome stops counting a pending payment as an outflow once it
+// settles, when the ledger books it, or fails.
+func (uc *ProjectEventUseCase) projectOutcome(ctx context.Context, eventType string, at time.Time, paymentID uuid.UUID) error {
+	exposure, err := uc.readModel.FindExposure(ctx, paymentID)
+	switch {
+	case errors.Is(err, port.ErrProjectionNotFound):
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to find payment %s: %w", paymentID, err)
+	}
+	if exposure.Status != service.ExposurePending {
+		return nil
+	}
+	exposure.Status = service.ExposureSettled
+	if eventType == "payment.order.failed" {
+		exposure.Status = service.ExposureCancelled
+	}
+	exposure.UpdatedAt = at
+	return uc.readModel.SaveExposure(ctx, exposure)
+}
@@ -0,0 +1,94 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/application/dto"
+	"github.com/bibbank/bib/services/treasury-service/internal/application/usecase"
+)
+
+func project(t *testing.T, uc *usecase.ProjectEventUseCase, eventType string, fields map[string]any) {
+	t.Helper()
+	payload, err := json.Marshal(fields)
+	require.NoError(t, err)
+	require.NoError(t, uc.Execute(context.Background(), eventType, payload))
+}
+
+func TestProjectEvent_LedgerEntries(t *testing.T) {
+	nostros, readModel := &inMemoryNostroRepo{}, newMemReadModel()
+	tenantID := uuid.New()
+	usd := createNostro(t, nostros, tenantID, "USD", "1100-USD", 1000, 0)
+	uc := usecase.NewProjectEventUseCase(nostros, readModel)
+	booked := func() string {
+		return position(t, nostros, &inMemoryForecastRepo{}, readModel, tenantID, usd.ID).BookedBalance.String()
+	}
+	entryID, reversalID := uuid.New(), uuid.New()
+
+	posted := map[string]any{
+		"tenant_id": tenantID, "occurred_at": time.Now(), "entry_id": entryID,
+		"postings": []map[string]any{
+			{"debit_account": "1100-USD", "credit_account": "2000", "currency": "USD", "amount": "250.50"},
+			{"debit_account": "1100-USD", "credit_account": "2000", "currency": "EUR", "amount": "99"},
+		},
+	}
+	project(t, uc, "ledger.entry.posted", posted)
+	project(t, uc, "ledger.entry.posted", posted) // redelivered
+	assert.Equal(t, "1250.5", booked())
+
+	project(t, uc, "ledger.entry.reversed", map[string]any{
+		"tenant_id": tenantID, "occurred_at": time.Now(), "entry_id": entryID, "reversal_entry_id": reversalID,
+		"postings": []map[string]any{
+			{"debit_account": "2000", "credit_account": "1100-USD", "currency": "USD", "amount": "250.50"},
+		},
+	})
+	assert.Equal(t, "1000", booked())
+
+	// Entries from before events carried postings are skipped.
+	project(t, uc, "ledger.entry.posted", map[string]any{"tenant_id": tenantID, "entry_id": uuid.New()})
+	project(t, uc, "ledger.period.closed", map[string]any{"tenant_id": "not a uuid"})
+}
+
+func TestProjectEvent_PaymentExposure(t *testing.T) {
+	nostros, readModel := &inMemoryNostroRepo{}, newMemReadModel()
+	tenantID := uuid.New()
+	swift := createNostro(t, nostros, tenantID, "USD", "1100-USD", 1000, 0, "SWIFT")
+	catchAll := createNostro(t, nostros, tenantID, "USD", "1101-USD", 1000, 0)
+	uc := usecase.NewProjectEventUseCase(nostros, readModel)
+	positionOf := func(n dto.NostroAccountResponse) dto.LiquidityPositionResponse {
+		return position(t, nostros, &inMemoryForecastRepo{}, readModel, tenantID, n.ID)
+	}
+	paymentID, failedID := uuid.New(), uuid.New()
+
+	initiated := func(id uuid.UUID, rail string, destination uuid.UUID) map[string]any {
+		return map[string]any{
+			"tenant_id": tenantID, "occurred_at": time.Now(), "payment_id": id, "amount": "300",
+			"currency": "USD", "rail": rail, "source_account_id": uuid.New(), "destination_account_id": destination,
+		}
+	}
+	project(t, uc, "payment.order.initiated", initiated(paymentID, "SWIFT", uuid.Nil))
+	project(t, uc, "payment.order.initiated", initiated(failedID, "ACH", uuid.Nil))
+	project(t, uc, "payment.order.initiated", initiated(uuid.New(), "INTERNAL", uuid.New()))
+
+	pending := func(n dto.NostroAccountResponse) string { return positionOf(n).PendingOutflows.String() }
+	assert.Equal(t, "300", pending(swift))
+	assert.Equal(t, "300", pending(catchAll), "unclaimed rails settle through the catch-all account; internal payments are ignored")
+	assert.Equal(t, "700", positionOf(swift).AvailableBalance.String())
+
+	project(t, uc, "payment.order.settled", map[string]any{"tenant_id": tenantID, "payment_id": paymentID})
+	project(t, uc, "payment.order.failed", map[string]any{"tenant_id": tenantID, "payment_id": failedID})
+	project(t, uc, "payment.order.settled", map[string]any{"tenant_id": tenantID, "payment_id": uuid.New()})
+	assert.Equal(t, "0", pending(swift))
+	assert.Equal(t, "0", pending(catchAll))
+	assert.Equal(t, "CANCELLED", readModel.exposures[failedID].Status)
+
+	// A late failure does not reopen a settled payment.
+	project(t, uc, "payment.order.failed", map[string]any{"tenant_id": tenantID, "payment_id": paymentID})
+	assert.Equal(t, "SETTLED", readModel.exposures[paymentID].Status)
+}
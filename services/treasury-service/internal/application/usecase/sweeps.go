@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/application/dto"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+// ErrInvalidSweep is returned when a sweep instruction request is
+// malformed.
+var ErrInvalidSweep = errors.New("invalid sweep instruction")
+
+// CreateSweepUseCase creates a sweep instruction between two nostro
+// accounts.
+type CreateSweepUseCase struct {
+	nostros port.NostroRepository
+	sweeps  port.SweepRepository
+}
+
+// NewCreateSweepUseCase creates a new CreateSweepUseCase.
+func NewCreateSweepUseCase(nostros port.NostroRepository, sweeps port.SweepRepository) *CreateSweepUseCase {
+	return &CreateSweepUseCase{nostros: nostros, sweeps: sweeps}
+}
+
+// Execute creates the sweep instruction. Both accounts must be active and
+// in the same currency.
+func (uc *CreateSweepUseCase) Execute(ctx context.Context, req dto.CreateSweepRequest) (dto.SweepResponse, error) {
+	sweepType, err := valueobject.NewSweepType(req.Type)
+	if err != nil {
+		return dto.SweepResponse{}, fmt.Errorf("%w: %w", ErrInvalidSweep, err)
+	}
+	source, err := uc.nostros.FindByID(ctx, req.TenantID, req.SourceNostroID)
+	if err != nil {
+		return dto.SweepResponse{}, fmt.Errorf("failed to find source nostro account: %w", err)
+	}
+	target, err := uc.nostros.FindByID(ctx, req.TenantID, req.TargetNostroID)
+	if err != nil {
+		return dto.SweepResponse{}, fmt.Errorf("failed to find target nostro account: %w", err)
+	}
+	if !source.Active() || !target.Active() {
+		return dto.SweepResponse{}, ErrNostroInactive
+	}
+	if source.Currency() != target.Currency() {
+		return dto.SweepResponse{}, fmt.Errorf("%w: source account is in %s but target account is in %s",
+			ErrInvalidSweep, source.Currency(), target.Currency())
+	}
+	sweep, err := model.NewSweepInstruction(
+		req.TenantID, source.ID(), target.ID(), sweepType, req.TriggerBalance, req.TargetBalance, time.Now().UTC())
+	if err != nil {
+		return dto.SweepResponse{}, fmt.Errorf("%w: %w", ErrInvalidSweep, err)
+	}
+
+	if err := uc.sweeps.Save(ctx, sweep); err != nil {
+		return dto.SweepResponse{}, fmt.Errorf("failed to save sweep instruction: %w", err)
+	}
+	return toSweepResponse(sweep), nil
+}
+
+// UpdateSweepUseCase changes a sweep instruction's balances, or pauses or
+// resumes it.
+type UpdateSweepUseCase struct {
+	sweeps port.SweepRepository
+}
+
+// NewUpdateSweepUseCase creates a new UpdateSweepUseCase.
+func NewUpdateSweepUseCase(sweeps port.SweepRepository) *UpdateSweepUseCase {
+	return &UpdateSweepUseCase{sweeps: sweeps}
+}
+
+// Execute updates the sweep instruction.
+func (uc *UpdateSweepUseCase) Execute(ctx context.Context, req dto.UpdateSweepRequest) (dto.SweepResponse, error) {
+	sweep, err := uc.sweeps.FindByID(ctx, req.TenantID, req.SweepID)
+	if err != nil {
+		return dto.SweepResponse{}, fmt.Errorf("failed to find sweep instruction: %w", err)
+	}
+	sweep, err = sweep.Update(req.TriggerBalance, req.TargetBalance, req.Active, time.Now().UTC())
+	if err != nil {
+		return dto.SweepResponse{}, fmt.Errorf("%w: %w", ErrInvalidSweep, err)
+	}
+
+	if err := uc.sweeps.Save(ctx, sweep); err != nil {
+		return dto.SweepResponse{}, fmt.Errorf("failed to save sweep instruction: %w", err)
+	}
+	return toSweepResponse(sweep), nil
+}
+
+// ListSweepsUseCase lists a tenant's sweep instructions.
+type ListSweepsUseCase struct {
+	sweeps port.SweepRepository
+}
+
+// NewListSweepsUseCase creates a new ListSweepsUseCase.
+func NewListSweepsUseCase(sweeps port.SweepRepository) *ListSweepsUseCase {
+	return &ListSweepsUseCase{sweeps: sweeps}
+}
+
+// Execute returns one page of the tenant's sweep instructions.
+func (uc *ListSweepsUseCase) Execute(ctx context.Context, req dto.ListSweepsRequest) (dto.ListSweepsResponse, error) {
+	limit, offset := page(req.Limit, req.Offset)
+	sweeps, total, err := uc.sweeps.List(ctx, req.TenantID, req.NostroID, limit, offset)
+	if err != nil {
+		return dto.ListSweepsResponse{}, fmt.Errorf("failed to list sweep instructions: %w", err)
+	}
+	resp := dto.ListSweepsResponse{
+		Sweeps:     make([]dto.SweepResponse, 0, len(sweeps)),
+		TotalCount: total,
+	}
+	for _, s := range sweeps {
+		resp.Sweeps = append(resp.Sweeps, toSweepResponse(s))
+	}
+	return resp, nil
+}
+
+func toSweepResponse(s model.SweepInstruction) dto.SweepResponse {
+	return dto.SweepResponse{
+		ID:             s.ID(),
+		TenantID:       s.TenantID(),
+		SourceNostroID: s.SourceNostroID(),
+		TargetNostroID: s.TargetNostroID(),
+		Type:           s.Type().String(),
+		TriggerBalance: s.TriggerBalance(),
+		TargetBalance:  s.TargetBalance(),
+		Active:         s.Active(),
+		LastExecutedOn: s.LastExecutedOn(),
+		Version:        s.Version(),
+		CreatedAt:      s.CreatedAt(),
+		UpdatedAt:      s.UpdatedAt(),
+	}
+}
@@ -0,0 +1,77 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/application/dto"
+	"github.com/bibbank/bib/services/treasury-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+)
+
+// inMemorySweepRepo is an in-memory SweepRepository.
+type inMemorySweepRepo struct {
+	sweeps []model.SweepInstruction
+}
+
+func (r *inMemorySweepRepo) Save(_ context.Context, sweep model.SweepInstruction) error {
+	sweep = sweep.ClearDomainEvents()
+	for i, s := range r.sweeps {
+		if s.ID() == sweep.ID() {
+			if s.Version() != sweep.Version()-1 {
+				return port.ErrVersionConflict
+			}
+			r.sweeps[i] = sweep
+			return nil
+		}
+	}
+	r.sweeps = append(r.sweeps, sweep)
+	return nil
+}
+
+func (r *inMemorySweepRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.SweepInstruction, error) {
+	for _, s := range r.sweeps {
+		if s.TenantID() == tenantID && s.ID() == id {
+			return s, nil
+		}
+	}
+	return model.SweepInstruction{}, port.ErrSweepNotFound
+}
+
+func (r *inMemorySweepRepo) List(_ context.Context, tenantID, nostroID uuid.UUID, limit, offset int) ([]model.SweepInstruction, int, error) {
+	var out []model.SweepInstruction
+	for _, s := range r.sweeps {
+		if s.TenantID() == tenantID && (nostroID == uuid.Nil || s.Involves(nostroID)) {
+			out = append(out, s)
+		}
+	}
+	total := len(out)
+	return out[min(offset, total):min(offset+limit, total)], total, nil
+}
+
+func (r *inMemorySweepRepo) ListActive(_ context.Context, tenantID uuid.UUID) ([]model.SweepInstruction, error) {
+	var out []model.SweepInstruction
+	for _, s := range r.sweeps {
+		if s.TenantID() == tenantID && s.Active() {
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+
+func TestCreateSweep_RejectsCurrencyMismatch(t *testing.T) {
+	nostros := &inMemoryNostroRepo{}
+	tenantID := uuid.New()
+	usd := createNostro(t, nostros, tenantID, "USD", "1100-USD", 1000, 200)
+	eur := createNostro(t, nostros, tenantID, "EUR", "1100-EUR", 1000, 200)
+
+	_, err := usecase.NewCreateSweepUseCase(nostros, &inMemorySweepRepo{}).Execute(context.Background(), dto.CreateSweepRequest{
+		TenantID: tenantID, SourceNostroID: usd.ID, TargetNostroID: eur.ID, Type: "EXCESS",
+		TriggerBalance: d(900), TargetBalance: d(800),
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidSweep, "currencies differ")
+}
@@ -0,0 +1,14 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[NostroAccountCreated]("treasury.nostro.created", 1, "Emitted when a nostro account starts being tracked."),
+		events.Declare[SweepExecuted]("treasury.sweep.executed", 1, "Emitted when a sweep instruction moves funds between two nostro accounts."),
+		events.Declare[LiquidityThresholdBreached]("treasury.liquidity.threshold_breached", 1, "Emitted when a nostro account's projected balance first falls below its minimum."),
+		events.Declare[LiquidityThresholdRestored]("treasury.liquidity.threshold_restored", 1, "Emitted when no projected balance of a nostro account with an open alert is below its minimum any more."),
+	}
+}
@@ -0,0 +1,122 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// DomainEvent is an alias for the shared pkg/events.DomainEvent interface.
+type DomainEvent = events.DomainEvent
+
+// Aggregate types of treasury events.
+const (
+	AggregateTypeNostroAccount = "NostroAccount"
+	AggregateTypeSweep         = "SweepInstruction"
+	AggregateTypeAlert         = "LiquidityAlert"
+)
+
+// NostroAccountCreated is emitted when a nostro account starts being
+// tracked.
+type NostroAccountCreated struct {
+	events.BaseEvent
+	Name              string `json:"name"`
+	CorrespondentBIC  string `json:"correspondent_bic"`
+	Currency          string `json:"currency"`
+	LedgerAccountCode string `json:"ledger_account_code"`
+}
+
+// NewNostroAccountCreated creates a new NostroAccountCreated event.
+func NewNostroAccountCreated(nostroID, tenantID uuid.UUID, name, bic, currency, ledgerAccountCode string) NostroAccountCreated {
+	return NostroAccountCreated{
+		BaseEvent:         events.NewBaseEvent("treasury.nostro.created", nostroID.String(), AggregateTypeNostroAccount, tenantID.String()),
+		Name:              name,
+		CorrespondentBIC:  bic,
+		Currency:          currency,
+		LedgerAccountCode: ledgerAccountCode,
+	}
+}
+
+// SweepExecuted is emitted when a sweep instruction moves funds between
+// two nostro accounts. The movement is forecast on both accounts for
+// ValueDate; treasury operations make the transfer.
+type SweepExecuted struct {
+	ValueDate time.Time `json:"value_date"`
+	events.BaseEvent
+	SweepType              string          `json:"sweep_type"`
+	Currency               string          `json:"currency"`
+	Amount                 decimal.Decimal `json:"amount"`
+	FromNostroID           uuid.UUID       `json:"from_nostro_id"`
+	ToNostroID             uuid.UUID       `json:"to_nostro_id"`
+	OutflowForecastID      uuid.UUID       `json:"outflow_forecast_id"`
+	InflowForecastID       uuid.UUID       `json:"inflow_forecast_id"`
+	SourceProjectedBalance decimal.Decimal `json:"source_projected_balance"`
+}
+
+// NewSweepExecuted creates a new SweepExecuted event.
+func NewSweepExecuted(
+	sweepID, tenantID uuid.UUID,
+	sweepType, currency string,
+	amount, sourceProjected decimal.Decimal,
+	fromNostroID, toNostroID, outflowID, inflowID uuid.UUID,
+	valueDate time.Time,
+) SweepExecuted {
+	return SweepExecuted{
+		BaseEvent:              events.NewBaseEvent("treasury.sweep.executed", sweepID.String(), AggregateTypeSweep, tenantID.String()),
+		SweepType:              sweepType,
+		Currency:               currency,
+		Amount:                 amount,
+		SourceProjectedBalance: sourceProjected,
+		FromNostroID:           fromNostroID,
+		ToNostroID:             toNostroID,
+		OutflowForecastID:      outflowID,
+		InflowForecastID:       inflowID,
+		ValueDate:              valueDate,
+	}
+}
+
+// LiquidityThresholdBreached is emitted when a nostro account's projected
+// balance first falls below its minimum.
+type LiquidityThresholdBreached struct {
+	BreachDate time.Time `json:"breach_date"`
+	events.BaseEvent
+	Currency         string          `json:"currency"`
+	ProjectedBalance decimal.Decimal `json:"projected_balance"`
+	MinimumBalance   decimal.Decimal `json:"minimum_balance"`
+	NostroID         uuid.UUID       `json:"nostro_id"`
+}
+
+// NewLiquidityThresholdBreached creates a new LiquidityThresholdBreached event.
+func NewLiquidityThresholdBreached(
+	alertID, tenantID, nostroID uuid.UUID,
+	currency string,
+	breachDate time.Time,
+	projected, minimum decimal.Decimal,
+) LiquidityThresholdBreached {
+	return LiquidityThresholdBreached{
+		BaseEvent:        events.NewBaseEvent("treasury.liquidity.threshold_breached", alertID.String(), AggregateTypeAlert, tenantID.String()),
+		NostroID:         nostroID,
+		Currency:         currency,
+		BreachDate:       breachDate,
+		ProjectedBalance: projected,
+		MinimumBalance:   minimum,
+	}
+}
+
+// LiquidityThresholdRestored is emitted when no projected balance of a
+// nostro account with an open alert is below its minimum any more.
+type LiquidityThresholdRestored struct {
+	events.BaseEvent
+	NostroID uuid.UUID `json:"nostro_id"`
+}
+
+// NewLiquidityThresholdRestored creates a new LiquidityThresholdRestored event.
+func NewLiquidityThresholdRestored(alertID, tenantID, nostroID uuid.UUID) LiquidityThresholdRestored {
+	return LiquidityThresholdRestored{
+		BaseEvent: events.NewBaseEvent("treasury.liquidity.threshold_restored", alertID.String(), AggregateTypeAlert, tenantID.String()),
+		NostroID:  nostroID,
+	}
+}
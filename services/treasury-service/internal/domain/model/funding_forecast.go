@@ -0,0 +1,166 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+const maxDescriptionLength = 256
+
+// FundingForecast is the aggregate root for a movement expected on a nostro
+// account on a value date, such as a scheduled funding transfer or a large
+// settlement. It counts towards the account's projected balances from its
+// value date until that day is over, by when the movement is expected to
+// have been booked.
+type FundingForecast struct {
+	valueDate   time.Time
+	createdAt   time.Time
+	updatedAt   time.Time
+	amount      decimal.Decimal
+	direction   valueobject.ForecastDirection
+	status      valueobject.ForecastStatus
+	description string
+	version     int
+	id          uuid.UUID
+	tenantID    uuid.UUID
+	nostroID    uuid.UUID
+	sweepID     uuid.UUID
+}
+
+// NewFundingForecast creates an expected forecast. The value date is taken
+// as a UTC calendar day and must not be before now's.
+func NewFundingForecast(
+	tenantID, nostroID uuid.UUID,
+	valueDate time.Time,
+	direction valueobject.ForecastDirection,
+	amount decimal.Decimal,
+	description string,
+	now time.Time,
+) (FundingForecast, error) {
+	if tenantID == uuid.Nil {
+		return FundingForecast{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	if nostroID == uuid.Nil {
+		return FundingForecast{}, fmt.Errorf("nostro account ID must not be empty")
+	}
+	valueDate = DateOf(valueDate)
+	if valueDate.Before(DateOf(now)) {
+		return FundingForecast{}, fmt.Errorf("value date %s is in the past", valueDate.Format(time.DateOnly))
+	}
+	if direction.IsZero() {
+		return FundingForecast{}, fmt.Errorf("direction is required")
+	}
+	if !amount.IsPositive() {
+		return FundingForecast{}, fmt.Errorf("amount must be positive")
+	}
+	description = strings.TrimSpace(description)
+	if len(description) > maxDescriptionLength {
+		return FundingForecast{}, fmt.Errorf("description must be at most %d characters", maxDescriptionLength)
+	}
+
+	return FundingForecast{
+		id:          uuid.New(),
+		tenantID:    tenantID,
+		nostroID:    nostroID,
+		valueDate:   valueDate,
+		direction:   direction,
+		amount:      amount,
+		description: description,
+		status:      valueobject.ForecastStatusExpected,
+		version:     1,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// NewSweepForecast creates the forecast of one leg of a sweep, value dated
+// now's day.
+func NewSweepForecast(
+	tenantID, nostroID, sweepID uuid.UUID,
+	direction valueobject.ForecastDirection,
+	amount decimal.Decimal,
+	description string,
+	now time.Time,
+) (FundingForecast, error) {
+	f, err := NewFundingForecast(tenantID, nostroID, now, direction, amount, description, now)
+	if err != nil {
+		return FundingForecast{}, err
+	}
+	f.sweepID = sweepID
+	return f, nil
+}
+
+// ReconstructFundingForecast recreates a FundingForecast from persisted data
+// without validation.
+func ReconstructFundingForecast(
+	id, tenantID, nostroID, sweepID uuid.UUID,
+	valueDate time.Time,
+	direction valueobject.ForecastDirection,
+	amount decimal.Decimal,
+	description string,
+	status valueobject.ForecastStatus,
+	version int,
+	createdAt, updatedAt time.Time,
+) FundingForecast {
+	return FundingForecast{
+		id:          id,
+		tenantID:    tenantID,
+		nostroID:    nostroID,
+		sweepID:     sweepID,
+		valueDate:   valueDate,
+		direction:   direction,
+		amount:      amount,
+		description: description,
+		status:      status,
+		version:     version,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// Cancel stops the forecast counting towards projections.
+func (f FundingForecast) Cancel(now time.Time) (FundingForecast, error) {
+	if !f.status.Equal(valueobject.ForecastStatusExpected) {
+		return f, fmt.Errorf("forecast is already %s", f.status)
+	}
+	f.status = valueobject.ForecastStatusCancelled
+	f.version++
+	f.updatedAt = now
+	return f, nil
+}
+
+// SignedAmount returns the forecast's effect on the balance: positive for
+// inflows, negative for outflows.
+func (f FundingForecast) SignedAmount() decimal.Decimal {
+	if f.direction.Equal(valueobject.ForecastDirectionOutflow) {
+		return f.amount.Neg()
+	}
+	return f.amount
+}
+
+// DateOf returns the UTC calendar day of t, as midnight.
+func DateOf(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// --- Accessors ---
+
+func (f FundingForecast) ID() uuid.UUID                            { return f.id }
+func (f FundingForecast) TenantID() uuid.UUID                      { return f.tenantID }
+func (f FundingForecast) NostroID() uuid.UUID                      { return f.nostroID }
+func (f FundingForecast) SweepID() uuid.UUID                       { return f.sweepID }
+func (f FundingForecast) ValueDate() time.Time                     { return f.valueDate }
+func (f FundingForecast) Direction() valueobject.ForecastDirection { return f.direction }
+func (f FundingForecast) Amount() decimal.Decimal                  { return f.amount }
+func (f FundingForecast) Description() string                      { return f.description }
+func (f FundingForecast) Status() valueobject.ForecastStatus       { return f.status }
+func (f FundingForecast) Version() int                             { return f.version }
+func (f FundingForecast) CreatedAt() time.Time                     { return f.createdAt }
+func (f FundingForecast) UpdatedAt() time.Time                     { return f.updatedAt }
@@ -0,0 +1,58 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+func TestNewFundingForecast(t *testing.T) {
+	tenantID, nostroID := uuid.New(), uuid.New()
+	amount := decimal.NewFromInt(50_000)
+
+	// The value date counts as its UTC day, so today is allowed.
+	f, err := model.NewFundingForecast(tenantID, nostroID, now.Add(-time.Hour), valueobject.ForecastDirectionOutflow,
+		amount, " Coupon payment ", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), f.ValueDate())
+	assert.Equal(t, "Coupon payment", f.Description())
+	assert.Equal(t, valueobject.ForecastStatusExpected, f.Status())
+	assert.Equal(t, uuid.Nil, f.SweepID())
+	assert.True(t, amount.Neg().Equal(f.SignedAmount()), "outflows are negative")
+
+	_, err = model.NewFundingForecast(tenantID, nostroID, now.AddDate(0, 0, -1), valueobject.ForecastDirectionInflow, amount, "", now)
+	assert.Error(t, err, "past value date")
+	_, err = model.NewFundingForecast(tenantID, nostroID, now, valueobject.ForecastDirection{}, amount, "", now)
+	assert.Error(t, err, "no direction")
+	_, err = model.NewFundingForecast(tenantID, nostroID, now, valueobject.ForecastDirectionInflow, decimal.Zero, "", now)
+	assert.Error(t, err, "zero amount")
+	_, err = model.NewFundingForecast(tenantID, nostroID, now, valueobject.ForecastDirectionInflow, amount, strings.Repeat("x", 257), now)
+	assert.Error(t, err, "description too long")
+	_, err = model.NewFundingForecast(tenantID, uuid.Nil, now, valueobject.ForecastDirectionInflow, amount, "", now)
+	assert.Error(t, err, "no nostro account")
+}
+
+func TestFundingForecast_Cancel(t *testing.T) {
+	sweepID := uuid.New()
+	f, err := model.NewSweepForecast(uuid.New(), uuid.New(), sweepID, valueobject.ForecastDirectionInflow,
+		decimal.NewFromInt(10), "sweep", now)
+	require.NoError(t, err)
+	assert.Equal(t, sweepID, f.SweepID())
+	assert.True(t, f.SignedAmount().IsPositive())
+
+	cancelled, err := f.Cancel(now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.ForecastStatusCancelled, cancelled.Status())
+	assert.Equal(t, 2, cancelled.Version())
+
+	_, err = cancelled.Cancel(now.Add(2 * time.Minute))
+	assert.Error(t, err, "already cancelled")
+}
@@ -0,0 +1,147 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/event"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+// LiquidityAlert is the aggregate root for a nostro account's projected
+// balance breaching its minimum. A nostro account has at most one open
+// alert; it follows the earliest breach while one is projected and is
+// resolved when none is.
+type LiquidityAlert struct {
+	breachDate       time.Time
+	openedAt         time.Time
+	updatedAt        time.Time
+	resolvedAt       *time.Time
+	projectedBalance decimal.Decimal
+	minimumBalance   decimal.Decimal
+	status           valueobject.AlertStatus
+	domainEvents     []events.DomainEvent
+	version          int
+	id               uuid.UUID
+	tenantID         uuid.UUID
+	nostroID         uuid.UUID
+}
+
+// OpenLiquidityAlert opens an alert for the earliest projected breach of a
+// nostro account's minimum balance.
+func OpenLiquidityAlert(
+	tenantID, nostroID uuid.UUID,
+	currency string,
+	breachDate time.Time,
+	projectedBalance, minimumBalance decimal.Decimal,
+	now time.Time,
+) (LiquidityAlert, error) {
+	if tenantID == uuid.Nil || nostroID == uuid.Nil {
+		return LiquidityAlert{}, fmt.Errorf("tenant and nostro account IDs must not be empty")
+	}
+	a := LiquidityAlert{
+		id:               uuid.New(),
+		tenantID:         tenantID,
+		nostroID:         nostroID,
+		status:           valueobject.AlertStatusOpen,
+		breachDate:       DateOf(breachDate),
+		projectedBalance: projectedBalance,
+		minimumBalance:   minimumBalance,
+		version:          1,
+		openedAt:         now,
+		updatedAt:        now,
+	}
+	a.domainEvents = append(a.domainEvents, event.NewLiquidityThresholdBreached(
+		a.id, tenantID, nostroID, currency, a.breachDate, projectedBalance, minimumBalance))
+	return a, nil
+}
+
+// ReconstructLiquidityAlert recreates a LiquidityAlert from persisted data
+// without validation or events.
+func ReconstructLiquidityAlert(
+	id, tenantID, nostroID uuid.UUID,
+	status valueobject.AlertStatus,
+	breachDate time.Time,
+	projectedBalance, minimumBalance decimal.Decimal,
+	openedAt time.Time,
+	resolvedAt *time.Time,
+	version int,
+	updatedAt time.Time,
+) LiquidityAlert {
+	return LiquidityAlert{
+		id:               id,
+		tenantID:         tenantID,
+		nostroID:         nostroID,
+		status:           status,
+		breachDate:       breachDate,
+		projectedBalance: projectedBalance,
+		minimumBalance:   minimumBalance,
+		openedAt:         openedAt,
+		resolvedAt:       resolvedAt,
+		version:          version,
+		updatedAt:        updatedAt,
+	}
+}
+
+// Refresh moves an open alert to the current earliest breach. It returns
+// false, leaving the alert unchanged, when the breach is the same.
+func (a LiquidityAlert) Refresh(breachDate time.Time, projectedBalance, minimumBalance decimal.Decimal, now time.Time) (LiquidityAlert, bool) {
+	breachDate = DateOf(breachDate)
+	if !a.IsOpen() || (a.breachDate.Equal(breachDate) &&
+		a.projectedBalance.Equal(projectedBalance) && a.minimumBalance.Equal(minimumBalance)) {
+		return a, false
+	}
+	a.breachDate = breachDate
+	a.projectedBalance = projectedBalance
+	a.minimumBalance = minimumBalance
+	a.version++
+	a.updatedAt = now
+	return a, true
+}
+
+// Resolve closes the alert once no breach is projected.
+func (a LiquidityAlert) Resolve(now time.Time) (LiquidityAlert, error) {
+	if !a.IsOpen() {
+		return a, fmt.Errorf("alert is already %s", a.status)
+	}
+	a.status = valueobject.AlertStatusResolved
+	a.resolvedAt = &now
+	a.version++
+	a.updatedAt = now
+	a.domainEvents = append(a.domainEvents, event.NewLiquidityThresholdRestored(a.id, a.tenantID, a.nostroID))
+	return a, nil
+}
+
+// IsOpen returns true while the alert's breach is still projected.
+func (a LiquidityAlert) IsOpen() bool {
+	return a.status.Equal(valueobject.AlertStatusOpen)
+}
+
+// --- Accessors ---
+
+func (a LiquidityAlert) ID() uuid.UUID                     { return a.id }
+func (a LiquidityAlert) TenantID() uuid.UUID               { return a.tenantID }
+func (a LiquidityAlert) NostroID() uuid.UUID               { return a.nostroID }
+func (a LiquidityAlert) Status() valueobject.AlertStatus   { return a.status }
+func (a LiquidityAlert) BreachDate() time.Time             { return a.breachDate }
+func (a LiquidityAlert) ProjectedBalance() decimal.Decimal { return a.projectedBalance }
+func (a LiquidityAlert) MinimumBalance() decimal.Decimal   { return a.minimumBalance }
+func (a LiquidityAlert) OpenedAt() time.Time               { return a.openedAt }
+func (a LiquidityAlert) ResolvedAt() *time.Time            { return a.resolvedAt }
+func (a LiquidityAlert) Version() int                      { return a.version }
+func (a LiquidityAlert) UpdatedAt() time.Time              { return a.updatedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (a LiquidityAlert) DomainEvents() []events.DomainEvent {
+	return a.domainEvents
+}
+
+// ClearDomainEvents returns a copy with cleared domain events.
+func (a LiquidityAlert) ClearDomainEvents() LiquidityAlert {
+	a.domainEvents = nil
+	return a
+}
@@ -0,0 +1,49 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+func TestLiquidityAlert_Lifecycle(t *testing.T) {
+	breach := now.AddDate(0, 0, 2)
+	a, err := model.OpenLiquidityAlert(uuid.New(), uuid.New(), "EUR", breach, d(-50), d(100), now)
+	require.NoError(t, err)
+	assert.True(t, a.IsOpen())
+	assert.Equal(t, model.DateOf(breach), a.BreachDate())
+	require.Len(t, a.DomainEvents(), 1)
+	assert.Equal(t, "treasury.liquidity.threshold_breached", a.DomainEvents()[0].EventType())
+	a = a.ClearDomainEvents()
+
+	same, changed := a.Refresh(breach.Add(time.Hour), d(-50), d(100), now.Add(time.Minute))
+	assert.False(t, changed, "same breach")
+	assert.Equal(t, a.Version(), same.Version())
+
+	refreshed, changed := a.Refresh(now, d(20), d(100), now.Add(time.Minute))
+	require.True(t, changed)
+	assert.Equal(t, 2, refreshed.Version())
+	assert.Equal(t, model.DateOf(now), refreshed.BreachDate())
+	assert.Empty(t, refreshed.DomainEvents(), "refreshing raises no event")
+
+	resolved, err := refreshed.Resolve(now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.AlertStatusResolved, resolved.Status())
+	require.NotNil(t, resolved.ResolvedAt())
+	require.Len(t, resolved.DomainEvents(), 1)
+	assert.Equal(t, "treasury.liquidity.threshold_restored", resolved.DomainEvents()[0].EventType())
+
+	_, err = resolved.Resolve(now.Add(2 * time.Hour))
+	assert.Error(t, err, "already resolved")
+	_, changed = resolved.Refresh(now, d(0), d(100), now)
+	assert.False(t, changed, "resolved alerts are not refreshed")
+
+	_, err = model.OpenLiquidityAlert(uuid.Nil, uuid.New(), "EUR", breach, d(-50), d(100), now)
+	assert.Error(t, err)
+}
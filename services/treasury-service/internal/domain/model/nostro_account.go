@@ -0,0 +1,212 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/event"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+// MaxRails bounds the payment rails one nostro account settles.
+const MaxRails = 20
+
+const (
+	maxNameLength          = 100
+	maxAccountNumberLength = 34
+)
+
+var (
+	currencyPattern          = regexp.MustCompile(`^[A-Z]{3}$`)
+	ledgerAccountCodePattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,32}$`)
+	railPattern              = regexp.MustCompile(`^[A-Z0-9_]{1,32}$`)
+)
+
+// NostroAccount is the aggregate root for an account the bank holds with a
+// correspondent bank. Its booked balance is the opening balance plus the
+// postings to its ledger account since it was created; outgoing payments
+// in the account's currency on one of its rails settle through it.
+type NostroAccount struct {
+	createdAt         time.Time
+	updatedAt         time.Time
+	openingBalance    decimal.Decimal
+	minimumBalance    decimal.Decimal
+	name              string
+	accountNumber     string
+	currency          string
+	ledgerAccountCode string
+	correspondentBIC  valueobject.BIC
+	rails             []string
+	domainEvents      []events.DomainEvent
+	version           int
+	id                uuid.UUID
+	tenantID          uuid.UUID
+	active            bool
+}
+
+// NewNostroAccount creates an active nostro account.
+func NewNostroAccount(
+	tenantID uuid.UUID,
+	name string,
+	correspondentBIC valueobject.BIC,
+	accountNumber, currency, ledgerAccountCode string,
+	rails []string,
+	openingBalance, minimumBalance decimal.Decimal,
+	now time.Time,
+) (NostroAccount, error) {
+	if tenantID == uuid.Nil {
+		return NostroAccount{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	if correspondentBIC.IsZero() {
+		return NostroAccount{}, fmt.Errorf("correspondent BIC must not be empty")
+	}
+	accountNumber = strings.TrimSpace(accountNumber)
+	if accountNumber == "" || len(accountNumber) > maxAccountNumberLength {
+		return NostroAccount{}, fmt.Errorf("account number must be 1 to %d characters", maxAccountNumberLength)
+	}
+	if !currencyPattern.MatchString(currency) {
+		return NostroAccount{}, fmt.Errorf("currency must be a 3-letter ISO 4217 code, got %q", currency)
+	}
+	if !ledgerAccountCodePattern.MatchString(ledgerAccountCode) {
+		return NostroAccount{}, fmt.Errorf("invalid ledger account code: %q", ledgerAccountCode)
+	}
+
+	n := NostroAccount{
+		id:                uuid.New(),
+		tenantID:          tenantID,
+		correspondentBIC:  correspondentBIC,
+		accountNumber:     accountNumber,
+		currency:          currency,
+		ledgerAccountCode: ledgerAccountCode,
+		openingBalance:    openingBalance,
+		createdAt:         now,
+	}
+	n, err := n.apply(name, rails, minimumBalance, true, now)
+	if err != nil {
+		return NostroAccount{}, err
+	}
+	n.domainEvents = append(n.domainEvents, event.NewNostroAccountCreated(
+		n.id, n.tenantID, n.name, correspondentBIC.String(), currency, ledgerAccountCode))
+	return n, nil
+}
+
+// ReconstructNostroAccount recreates a NostroAccount from persisted data
+// without validation or events.
+func ReconstructNostroAccount(
+	id, tenantID uuid.UUID,
+	name string,
+	correspondentBIC valueobject.BIC,
+	accountNumber, currency, ledgerAccountCode string,
+	rails []string,
+	openingBalance, minimumBalance decimal.Decimal,
+	active bool,
+	version int,
+	createdAt, updatedAt time.Time,
+) NostroAccount {
+	return NostroAccount{
+		id:                id,
+		tenantID:          tenantID,
+		name:              name,
+		correspondentBIC:  correspondentBIC,
+		accountNumber:     accountNumber,
+		currency:          currency,
+		ledgerAccountCode: ledgerAccountCode,
+		rails:             rails,
+		openingBalance:    openingBalance,
+		minimumBalance:    minimumBalance,
+		active:            active,
+		version:           version,
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
+	}
+}
+
+// Update renames the account, replaces the rails it settles and its
+// minimum balance, and activates or deactivates it. Inactive accounts are
+// neither projected nor alerted on, and settle no new payments.
+func (n NostroAccount) Update(name string, rails []string, minimumBalance decimal.Decimal, active bool, now time.Time) (NostroAccount, error) {
+	return n.apply(name, rails, minimumBalance, active, now)
+}
+
+func (n NostroAccount) apply(name string, rails []string, minimumBalance decimal.Decimal, active bool, now time.Time) (NostroAccount, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxNameLength {
+		return n, fmt.Errorf("name must be 1 to %d characters", maxNameLength)
+	}
+	if len(rails) > MaxRails {
+		return n, fmt.Errorf("at most %d rails are allowed", MaxRails)
+	}
+	normalized := make([]string, 0, len(rails))
+	for _, r := range rails {
+		r = strings.ToUpper(strings.TrimSpace(r))
+		if !railPattern.MatchString(r) {
+			return n, fmt.Errorf("invalid payment rail: %q", r)
+		}
+		duplicate := false
+		for _, seen := range normalized {
+			duplicate = duplicate || seen == r
+		}
+		if !duplicate {
+			normalized = append(normalized, r)
+		}
+	}
+
+	n.name = name
+	n.rails = normalized
+	n.minimumBalance = minimumBalance
+	n.active = active
+	n.version++
+	n.updatedAt = now
+	return n, nil
+}
+
+// SettlesRail returns true if the account names the payment rail among
+// those it settles.
+func (n NostroAccount) SettlesRail(rail string) bool {
+	for _, r := range n.rails {
+		if strings.EqualFold(r, rail) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCatchAll returns true if the account names no rails, so it settles the
+// payments in its currency no other account claims.
+func (n NostroAccount) IsCatchAll() bool {
+	return len(n.rails) == 0
+}
+
+// --- Accessors ---
+
+func (n NostroAccount) ID() uuid.UUID                     { return n.id }
+func (n NostroAccount) TenantID() uuid.UUID               { return n.tenantID }
+func (n NostroAccount) Name() string                      { return n.name }
+func (n NostroAccount) CorrespondentBIC() valueobject.BIC { return n.correspondentBIC }
+func (n NostroAccount) AccountNumber() string             { return n.accountNumber }
+func (n NostroAccount) Currency() string                  { return n.currency }
+func (n NostroAccount) LedgerAccountCode() string         { return n.ledgerAccountCode }
+func (n NostroAccount) Rails() []string                   { return n.rails }
+func (n NostroAccount) OpeningBalance() decimal.Decimal   { return n.openingBalance }
+func (n NostroAccount) MinimumBalance() decimal.Decimal   { return n.minimumBalance }
+func (n NostroAccount) Active() bool                      { return n.active }
+func (n NostroAccount) Version() int                      { return n.version }
+func (n NostroAccount) CreatedAt() time.Time              { return n.createdAt }
+func (n NostroAccount) UpdatedAt() time.Time              { return n.updatedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (n NostroAccount) DomainEvents() []events.DomainEvent {
+	return n.domainEvents
+}
+
+// ClearDomainEvents returns a copy with cleared domain events.
+func (n NostroAccount) ClearDomainEvents() NostroAccount {
+	n.domainEvents = nil
+	return n
+}
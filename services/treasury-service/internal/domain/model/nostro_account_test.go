@@ -0,0 +1,90 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+var now = time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)
+
+func newNostro(t *testing.T, rails ...string) model.NostroAccount {
+	t.Helper()
+	bic, err := valueobject.NewBIC("deutdeff")
+	require.NoError(t, err)
+	n, err := model.NewNostroAccount(uuid.New(), "EUR at Deutsche", bic, "DE89370400440532013000", "EUR",
+		"1100-EUR", rails, decimal.NewFromInt(1_000_000), decimal.NewFromInt(250_000), now)
+	require.NoError(t, err)
+	return n
+}
+
+func TestNewNostroAccount(t *testing.T) {
+	n := newNostro(t, "sepa", " SEPA_INSTANT ", "SEPA")
+	assert.Equal(t, 1, n.Version())
+	assert.True(t, n.Active())
+	assert.Equal(t, "DEUTDEFF", n.CorrespondentBIC().String())
+	assert.Equal(t, []string{"SEPA", "SEPA_INSTANT"}, n.Rails(), "rails are normalized and deduplicated")
+	assert.True(t, n.SettlesRail("sepa"))
+	assert.False(t, n.SettlesRail("SWIFT"))
+	assert.False(t, n.IsCatchAll())
+	require.Len(t, n.DomainEvents(), 1)
+	assert.Equal(t, "treasury.nostro.created", n.DomainEvents()[0].EventType())
+
+	assert.True(t, newNostro(t).IsCatchAll())
+
+	bic := n.CorrespondentBIC()
+	cases := map[string]func() error{
+		"nil tenant": func() error {
+			_, err := model.NewNostroAccount(uuid.Nil, "x", bic, "1", "EUR", "1100", nil, decimal.Zero, decimal.Zero, now)
+			return err
+		},
+		"no BIC": func() error {
+			_, err := model.NewNostroAccount(uuid.New(), "x", valueobject.BIC{}, "1", "EUR", "1100", nil, decimal.Zero, decimal.Zero, now)
+			return err
+		},
+		"bad currency": func() error {
+			_, err := model.NewNostroAccount(uuid.New(), "x", bic, "1", "eur", "1100", nil, decimal.Zero, decimal.Zero, now)
+			return err
+		},
+		"bad ledger code": func() error {
+			_, err := model.NewNostroAccount(uuid.New(), "x", bic, "1", "EUR", "11 00", nil, decimal.Zero, decimal.Zero, now)
+			return err
+		},
+		"no name": func() error {
+			_, err := model.NewNostroAccount(uuid.New(), " ", bic, "1", "EUR", "1100", nil, decimal.Zero, decimal.Zero, now)
+			return err
+		},
+		"bad rail": func() error {
+			_, err := model.NewNostroAccount(uuid.New(), "x", bic, "1", "EUR", "1100", []string{"SEPA-CT"}, decimal.Zero, decimal.Zero, now)
+			return err
+		},
+	}
+	for name, create := range cases {
+		assert.Error(t, create(), name)
+	}
+}
+
+func TestNostroAccount_Update(t *testing.T) {
+	n := newNostro(t, "SEPA")
+	later := now.Add(time.Hour)
+
+	updated, err := n.Update("EUR main", nil, decimal.NewFromInt(100_000), false, later)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Version())
+	assert.Equal(t, "EUR main", updated.Name())
+	assert.True(t, updated.IsCatchAll())
+	assert.False(t, updated.Active())
+	assert.True(t, decimal.NewFromInt(100_000).Equal(updated.MinimumBalance()))
+	assert.Equal(t, later, updated.UpdatedAt())
+
+	_, err = n.Update("", nil, decimal.Zero, true, later)
+	assert.Error(t, err)
+	assert.Equal(t, "EUR at Deutsche", n.Name(), "the original is unchanged")
+}
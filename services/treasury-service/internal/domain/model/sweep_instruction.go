@@ -0,0 +1,199 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/event"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+// SweepTransfer is a movement a sweep instruction calls for.
+type SweepTransfer struct {
+	Amount       decimal.Decimal
+	FromNostroID uuid.UUID
+	ToNostroID   uuid.UUID
+}
+
+// SweepInstruction is the aggregate root for a standing instruction to move
+// funds between two nostro accounts in the same currency, keeping the
+// source account's projected balance for the day in line. An EXCESS sweep
+// moves the balance above the target to the other account once it rises
+// above the trigger; a COVER sweep tops the source account up to the target
+// from the other account once it falls below the trigger. A sweep runs at
+// most once a day.
+type SweepInstruction struct {
+	createdAt      time.Time
+	updatedAt      time.Time
+	lastExecutedOn *time.Time
+	triggerBalance decimal.Decimal
+	targetBalance  decimal.Decimal
+	sweepType      valueobject.SweepType
+	domainEvents   []events.DomainEvent
+	version        int
+	id             uuid.UUID
+	tenantID       uuid.UUID
+	sourceNostroID uuid.UUID
+	targetNostroID uuid.UUID
+	active         bool
+}
+
+// NewSweepInstruction creates an active sweep instruction.
+func NewSweepInstruction(
+	tenantID, sourceNostroID, targetNostroID uuid.UUID,
+	sweepType valueobject.SweepType,
+	triggerBalance, targetBalance decimal.Decimal,
+	now time.Time,
+) (SweepInstruction, error) {
+	if tenantID == uuid.Nil {
+		return SweepInstruction{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	if sourceNostroID == uuid.Nil || targetNostroID == uuid.Nil {
+		return SweepInstruction{}, fmt.Errorf("source and target nostro accounts are required")
+	}
+	if sourceNostroID == targetNostroID {
+		return SweepInstruction{}, fmt.Errorf("source and target nostro accounts must differ")
+	}
+	if sweepType.IsZero() {
+		return SweepInstruction{}, fmt.Errorf("sweep type is required")
+	}
+	s := SweepInstruction{
+		id:             uuid.New(),
+		tenantID:       tenantID,
+		sourceNostroID: sourceNostroID,
+		targetNostroID: targetNostroID,
+		sweepType:      sweepType,
+		createdAt:      now,
+	}
+	return s.Update(triggerBalance, targetBalance, true, now)
+}
+
+// ReconstructSweepInstruction recreates a SweepInstruction from persisted
+// data without validation or events.
+func ReconstructSweepInstruction(
+	id, tenantID, sourceNostroID, targetNostroID uuid.UUID,
+	sweepType valueobject.SweepType,
+	triggerBalance, targetBalance decimal.Decimal,
+	active bool,
+	lastExecutedOn *time.Time,
+	version int,
+	createdAt, updatedAt time.Time,
+) SweepInstruction {
+	return SweepInstruction{
+		id:             id,
+		tenantID:       tenantID,
+		sourceNostroID: sourceNostroID,
+		targetNostroID: targetNostroID,
+		sweepType:      sweepType,
+		triggerBalance: triggerBalance,
+		targetBalance:  targetBalance,
+		active:         active,
+		lastExecutedOn: lastExecutedOn,
+		version:        version,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}
+}
+
+// Update replaces the sweep's balances and pauses or resumes it. An EXCESS
+// sweep's target may not be above its trigger, nor a COVER sweep's below
+// it.
+func (s SweepInstruction) Update(triggerBalance, targetBalance decimal.Decimal, active bool, now time.Time) (SweepInstruction, error) {
+	switch {
+	case s.sweepType.Equal(valueobject.SweepTypeExcess) && targetBalance.GreaterThan(triggerBalance):
+		return s, fmt.Errorf("an EXCESS sweep's target balance must not exceed its trigger balance")
+	case s.sweepType.Equal(valueobject.SweepTypeCover) && targetBalance.LessThan(triggerBalance):
+		return s, fmt.Errorf("a COVER sweep's target balance must not be below its trigger balance")
+	}
+	s.triggerBalance = triggerBalance
+	s.targetBalance = targetBalance
+	s.active = active
+	s.version++
+	s.updatedAt = now
+	return s, nil
+}
+
+// Due returns the transfer the sweep calls for given the source account's
+// projected balance for today, and false when it calls for none: because
+// it is paused, already ran today, or the balance is within its trigger.
+func (s SweepInstruction) Due(sourceProjected decimal.Decimal, today time.Time) (SweepTransfer, bool) {
+	if !s.active {
+		return SweepTransfer{}, false
+	}
+	if s.lastExecutedOn != nil && !s.lastExecutedOn.Before(DateOf(today)) {
+		return SweepTransfer{}, false
+	}
+	if s.sweepType.Equal(valueobject.SweepTypeExcess) {
+		if !sourceProjected.GreaterThan(s.triggerBalance) {
+			return SweepTransfer{}, false
+		}
+		return SweepTransfer{
+			Amount:       sourceProjected.Sub(s.targetBalance),
+			FromNostroID: s.sourceNostroID,
+			ToNostroID:   s.targetNostroID,
+		}, true
+	}
+	if !sourceProjected.LessThan(s.triggerBalance) {
+		return SweepTransfer{}, false
+	}
+	return SweepTransfer{
+		Amount:       s.targetBalance.Sub(sourceProjected),
+		FromNostroID: s.targetNostroID,
+		ToNostroID:   s.sourceNostroID,
+	}, true
+}
+
+// RecordExecution marks the sweep as run today for a transfer forecast as
+// the outflow and inflow forecasts given.
+func (s SweepInstruction) RecordExecution(
+	transfer SweepTransfer,
+	sourceProjected decimal.Decimal,
+	currency string,
+	outflowForecastID, inflowForecastID uuid.UUID,
+	now time.Time,
+) SweepInstruction {
+	today := DateOf(now)
+	s.lastExecutedOn = &today
+	s.version++
+	s.updatedAt = now
+	s.domainEvents = append(s.domainEvents, event.NewSweepExecuted(
+		s.id, s.tenantID, s.sweepType.String(), currency, transfer.Amount, sourceProjected,
+		transfer.FromNostroID, transfer.ToNostroID, outflowForecastID, inflowForecastID, today,
+	))
+	return s
+}
+
+// Involves returns true if the sweep moves funds from or to the account.
+func (s SweepInstruction) Involves(nostroID uuid.UUID) bool {
+	return s.sourceNostroID == nostroID || s.targetNostroID == nostroID
+}
+
+// --- Accessors ---
+
+func (s SweepInstruction) ID() uuid.UUID                   { return s.id }
+func (s SweepInstruction) TenantID() uuid.UUID             { return s.tenantID }
+func (s SweepInstruction) SourceNostroID() uuid.UUID       { return s.sourceNostroID }
+func (s SweepInstruction) TargetNostroID() uuid.UUID       { return s.targetNostroID }
+func (s SweepInstruction) Type() valueobject.SweepType     { return s.sweepType }
+func (s SweepInstruction) TriggerBalance() decimal.Decimal { return s.triggerBalance }
+func (s SweepInstruction) TargetBalance() decimal.Decimal  { return s.targetBalance }
+func (s SweepInstruction) Active() bool                    { return s.active }
+func (s SweepInstruction) LastExecutedOn() *time.Time      { return s.lastExecutedOn }
+func (s SweepInstruction) Version() int                    { return s.version }
+func (s SweepInstruction) CreatedAt() time.Time            { return s.createdAt }
+func (s SweepInstruction) UpdatedAt() time.Time            { return s.updatedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (s SweepInstruction) DomainEvents() []events.DomainEvent {
+	return s.domainEvents
+}
+
+// ClearDomainEvents returns a copy with cleared domain events.
+func (s SweepInstruction) ClearDomainEvents() SweepInstruction {
+	s.domainEvents = nil
+	return s
+}
@@ -0,0 +1,95 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/event"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+func d(v int64) decimal.Decimal { return decimal.NewFromInt(v) }
+
+func TestNewSweepInstruction(t *testing.T) {
+	tenantID, source, target := uuid.New(), uuid.New(), uuid.New()
+
+	s, err := model.NewSweepInstruction(tenantID, source, target, valueobject.SweepTypeExcess, d(500), d(400), now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, s.Version())
+	assert.True(t, s.Active())
+	assert.Nil(t, s.LastExecutedOn())
+	assert.True(t, s.Involves(source))
+	assert.True(t, s.Involves(target))
+	assert.False(t, s.Involves(uuid.New()))
+
+	_, err = model.NewSweepInstruction(tenantID, source, target, valueobject.SweepTypeExcess, d(400), d(500), now)
+	assert.Error(t, err, "EXCESS target above trigger")
+	_, err = model.NewSweepInstruction(tenantID, source, target, valueobject.SweepTypeCover, d(500), d(400), now)
+	assert.Error(t, err, "COVER target below trigger")
+	_, err = model.NewSweepInstruction(tenantID, source, source, valueobject.SweepTypeCover, d(100), d(200), now)
+	assert.Error(t, err, "same account")
+	_, err = model.NewSweepInstruction(tenantID, source, target, valueobject.SweepType{}, d(100), d(200), now)
+	assert.Error(t, err, "no type")
+}
+
+func TestSweepInstruction_Due(t *testing.T) {
+	source, target := uuid.New(), uuid.New()
+
+	excess, err := model.NewSweepInstruction(uuid.New(), source, target, valueobject.SweepTypeExcess, d(1000), d(800), now)
+	require.NoError(t, err)
+	_, due := excess.Due(d(1000), now)
+	assert.False(t, due, "at the trigger")
+	transfer, due := excess.Due(d(1300), now)
+	require.True(t, due)
+	assert.True(t, d(500).Equal(transfer.Amount), "down to the target")
+	assert.Equal(t, source, transfer.FromNostroID)
+	assert.Equal(t, target, transfer.ToNostroID)
+
+	cover, err := model.NewSweepInstruction(uuid.New(), source, target, valueobject.SweepTypeCover, d(100), d(300), now)
+	require.NoError(t, err)
+	_, due = cover.Due(d(100), now)
+	assert.False(t, due, "at the trigger")
+	transfer, due = cover.Due(d(40), now)
+	require.True(t, due)
+	assert.True(t, d(260).Equal(transfer.Amount), "up to the target")
+	assert.Equal(t, target, transfer.FromNostroID, "cover sweeps fund the source")
+	assert.Equal(t, source, transfer.ToNostroID)
+
+	paused, err := cover.Update(d(100), d(300), false, now)
+	require.NoError(t, err)
+	_, due = paused.Due(d(40), now)
+	assert.False(t, due, "paused")
+}
+
+func TestSweepInstruction_RecordExecution(t *testing.T) {
+	s, err := model.NewSweepInstruction(uuid.New(), uuid.New(), uuid.New(), valueobject.SweepTypeExcess, d(1000), d(800), now)
+	require.NoError(t, err)
+	transfer, due := s.Due(d(1300), now)
+	require.True(t, due)
+
+	outflowID, inflowID := uuid.New(), uuid.New()
+	executed := s.RecordExecution(transfer, d(1300), "EUR", outflowID, inflowID, now)
+	assert.Equal(t, 2, executed.Version())
+	require.NotNil(t, executed.LastExecutedOn())
+	assert.Equal(t, model.DateOf(now), *executed.LastExecutedOn())
+
+	require.Len(t, executed.DomainEvents(), 1)
+	evt, ok := executed.DomainEvents()[0].(event.SweepExecuted)
+	require.True(t, ok)
+	assert.Equal(t, "treasury.sweep.executed", evt.EventType())
+	assert.Equal(t, "EXCESS", evt.SweepType)
+	assert.True(t, d(500).Equal(evt.Amount))
+	assert.Equal(t, outflowID, evt.OutflowForecastID)
+	assert.Equal(t, inflowID, evt.InflowForecastID)
+
+	_, due = executed.Due(d(5000), now.Add(6*time.Hour))
+	assert.False(t, due, "once a day")
+	_, due = executed.Due(d(5000), now.AddDate(0, 0, 1))
+	assert.True(t, due, "again the next day")
+}
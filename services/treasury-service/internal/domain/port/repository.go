@@ -0,0 +1,148 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/event"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/service"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+// ErrNostroNotFound is returned when a nostro account does not exist.
+var ErrNostroNotFound = errors.New("nostro account not found")
+
+// ErrForecastNotFound is returned when a funding forecast does not exist.
+var ErrForecastNotFound = errors.New("funding forecast not found")
+
+// ErrSweepNotFound is returned when a sweep instruction does not exist.
+var ErrSweepNotFound = errors.New("sweep instruction not found")
+
+// ErrAlertNotFound is returned when a liquidity alert does not exist.
+var ErrAlertNotFound = errors.New("liquidity alert not found")
+
+// ErrProjectionNotFound is returned when the read model has no record of
+// what was looked up.
+var ErrProjectionNotFound = errors.New("projection not found")
+
+// ErrVersionConflict is returned when an aggregate was modified concurrently.
+var ErrVersionConflict = errors.New("version conflict")
+
+// NostroRepository defines the persistence port for nostro accounts.
+type NostroRepository interface {
+	// Save persists a nostro account with optimistic locking on its version.
+	Save(ctx context.Context, nostro model.NostroAccount) error
+
+	// FindByID retrieves a tenant's nostro account.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.NostroAccount, error)
+
+	// List returns a page of a tenant's nostro accounts, those in one
+	// currency when it is not empty, ordered by currency and name, and the
+	// total number of them.
+	List(ctx context.Context, tenantID uuid.UUID, currency string, limit, offset int) ([]model.NostroAccount, int, error)
+
+	// ListAll returns all of a tenant's nostro accounts, active or not.
+	ListAll(ctx context.Context, tenantID uuid.UUID) ([]model.NostroAccount, error)
+
+	// ListTenants returns the tenants with at least one active nostro
+	// account.
+	ListTenants(ctx context.Context) ([]uuid.UUID, error)
+}
+
+// ForecastFilter narrows a listing of a tenant's forecasts. Zero fields do
+// not filter; dates are inclusive.
+type ForecastFilter struct {
+	From             time.Time
+	To               time.Time
+	TenantID         uuid.UUID
+	NostroID         uuid.UUID
+	IncludeCancelled bool
+}
+
+// ForecastRepository defines the persistence port for funding forecasts.
+type ForecastRepository interface {
+	// Save persists a forecast with optimistic locking on its version.
+	Save(ctx context.Context, forecast model.FundingForecast) error
+
+	// FindByID retrieves a tenant's forecast.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.FundingForecast, error)
+
+	// List returns a page of the forecasts matching the filter, by value
+	// date, and the total number of them.
+	List(ctx context.Context, filter ForecastFilter, limit, offset int) ([]model.FundingForecast, int, error)
+
+	// ListExpected returns a tenant's expected forecasts value dated from
+	// one day to another, inclusive.
+	ListExpected(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]model.FundingForecast, error)
+}
+
+// SweepRepository defines the persistence port for sweep instructions.
+type SweepRepository interface {
+	// Save persists a sweep instruction with optimistic locking on its
+	// version.
+	Save(ctx context.Context, sweep model.SweepInstruction) error
+
+	// FindByID retrieves a tenant's sweep instruction.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.SweepInstruction, error)
+
+	// List returns a page of a tenant's sweep instructions, those from or
+	// to one nostro account when nostroID is not uuid.Nil, oldest first,
+	// and the total number of them.
+	List(ctx context.Context, tenantID, nostroID uuid.UUID, limit, offset int) ([]model.SweepInstruction, int, error)
+
+	// ListActive returns all of a tenant's active sweep instructions.
+	ListActive(ctx context.Context, tenantID uuid.UUID) ([]model.SweepInstruction, error)
+}
+
+// AlertFilter narrows a listing of a tenant's alerts. Zero fields do not
+// filter.
+type AlertFilter struct {
+	Status   valueobject.AlertStatus
+	TenantID uuid.UUID
+	NostroID uuid.UUID
+}
+
+// AlertRepository defines the persistence port for liquidity alerts.
+type AlertRepository interface {
+	// Save persists an alert with optimistic locking on its version.
+	Save(ctx context.Context, alert model.LiquidityAlert) error
+
+	// FindOpen retrieves a nostro account's open alert, returning
+	// ErrAlertNotFound when it has none.
+	FindOpen(ctx context.Context, tenantID, nostroID uuid.UUID) (model.LiquidityAlert, error)
+
+	// List returns a page of the alerts matching the filter, newest first,
+	// and the total number of them.
+	List(ctx context.Context, filter AlertFilter, limit, offset int) ([]model.LiquidityAlert, int, error)
+}
+
+// PositionReadModel is the projection of ledger and payment events that
+// nostro balances are derived from.
+type PositionReadModel interface {
+	// RecordMovements stores ledger movements on nostro accounts. A
+	// movement already recorded for the same entry and account is ignored,
+	// so replayed events are not counted twice.
+	RecordMovements(ctx context.Context, movements ...service.LedgerMovement) error
+
+	// SaveExposure stores a payment settling through a nostro account.
+	SaveExposure(ctx context.Context, exposure service.PaymentExposure) error
+
+	// FindExposure retrieves a payment's exposure, returning
+	// ErrProjectionNotFound when the payment is not tracked.
+	FindExposure(ctx context.Context, paymentID uuid.UUID) (service.PaymentExposure, error)
+
+	// Balances returns the sum of a nostro account's ledger movements and
+	// of its pending payment outflows.
+	Balances(ctx context.Context, nostroID uuid.UUID) (movements, pendingOutflows decimal.Decimal, err error)
+}
+
+// EventPublisher defines the port for publishing domain events.
+type EventPublisher interface {
+	// Publish sends domain events to the event bus.
+	Publish(ctx context.Context, events []event.DomainEvent) error
+}
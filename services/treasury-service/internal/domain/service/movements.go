@@ -0,0 +1,105 @@
+package service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+)
+
+// Posting is one debit/credit pair of a ledger entry.
+type Posting struct {
+	DebitAccount  string
+	CreditAccount string
+	Currency      string
+	Amount        decimal.Decimal
+}
+
+// LedgerMovement is the net effect of one ledger entry on a nostro
+// account's booked balance.
+type LedgerMovement struct {
+	PostedAt time.Time
+	Amount   decimal.Decimal
+	EntryID  uuid.UUID
+	NostroID uuid.UUID
+	TenantID uuid.UUID
+}
+
+// Payment exposure statuses.
+const (
+	ExposurePending   = "PENDING"
+	ExposureSettled   = "SETTLED"
+	ExposureCancelled = "CANCELLED"
+)
+
+// PaymentExposure is an outgoing payment settling through a nostro
+// account. It counts as a pending outflow until it settles, when the
+// ledger books it, or fails.
+type PaymentExposure struct {
+	InitiatedAt time.Time
+	UpdatedAt   time.Time
+	Status      string
+	Currency    string
+	Rail        string
+	Amount      decimal.Decimal
+	PaymentID   uuid.UUID
+	TenantID    uuid.UUID
+	NostroID    uuid.UUID
+}
+
+// NostroMovements returns the movements a ledger entry makes on nostro
+// accounts: a debit to an account's ledger account in its currency raises
+// its balance, a credit lowers it. Accounts the entry does not touch, or
+// whose postings net to zero, are left out.
+func NostroMovements(nostros []model.NostroAccount, entryID, tenantID uuid.UUID, postedAt time.Time, postings []Posting) []LedgerMovement {
+	var movements []LedgerMovement
+	for _, n := range nostros {
+		net := decimal.Zero
+		for _, p := range postings {
+			if p.Currency != n.Currency() {
+				continue
+			}
+			if p.DebitAccount == n.LedgerAccountCode() {
+				net = net.Add(p.Amount)
+			}
+			if p.CreditAccount == n.LedgerAccountCode() {
+				net = net.Sub(p.Amount)
+			}
+		}
+		if net.IsZero() {
+			continue
+		}
+		movements = append(movements, LedgerMovement{
+			EntryID:  entryID,
+			NostroID: n.ID(),
+			TenantID: tenantID,
+			Amount:   net,
+			PostedAt: postedAt,
+		})
+	}
+	return movements
+}
+
+// SelectNostro picks the active nostro account an outgoing payment settles
+// through: one in the payment's currency naming its rail, otherwise the
+// currency's catch-all account. It returns false when there is none.
+func SelectNostro(nostros []model.NostroAccount, currency, rail string) (model.NostroAccount, bool) {
+	var catchAll *model.NostroAccount
+	for i, n := range nostros {
+		if !n.Active() || n.Currency() != currency {
+			continue
+		}
+		if n.SettlesRail(rail) {
+			return n, true
+		}
+		if n.IsCatchAll() && catchAll == nil {
+			catchAll = &nostros[i]
+		}
+	}
+	if catchAll != nil {
+		return *catchAll, true
+	}
+	return model.NostroAccount{}, false
+}
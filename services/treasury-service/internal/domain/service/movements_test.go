@@ -0,0 +1,51 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/service"
+)
+
+func TestNostroMovements(t *testing.T) {
+	usd := newNostro(t, "USD", "1100")
+	eur := newNostro(t, "EUR", "1100")
+	untouched := newNostro(t, "USD", "1200")
+	entryID, tenantID := uuid.New(), uuid.New()
+
+	movements := service.NostroMovements([]model.NostroAccount{usd, eur, untouched}, entryID, tenantID, now, []service.Posting{
+		{DebitAccount: "1100", CreditAccount: "2000", Currency: "USD", Amount: d(500)},
+		{DebitAccount: "3000", CreditAccount: "1100", Currency: "USD", Amount: d(120)},
+		{DebitAccount: "1100", CreditAccount: "1100", Currency: "EUR", Amount: d(80)},
+	})
+
+	require.Len(t, movements, 1, "accounts netting to zero are left out")
+	assert.Equal(t, usd.ID(), movements[0].NostroID)
+	assert.Equal(t, entryID, movements[0].EntryID)
+	assert.Equal(t, tenantID, movements[0].TenantID)
+	assert.True(t, d(380).Equal(movements[0].Amount))
+}
+
+func TestSelectNostro(t *testing.T) {
+	swift := newNostro(t, "USD", "1100", "SWIFT")
+	catchAll := newNostro(t, "USD", "1101")
+	fedwire := newNostro(t, "USD", "1102", "FEDWIRE")
+	fedwire, err := fedwire.Update(fedwire.Name(), fedwire.Rails(), fedwire.MinimumBalance(), false, now)
+	require.NoError(t, err)
+	nostros := []model.NostroAccount{catchAll, swift, fedwire}
+
+	n, ok := service.SelectNostro(nostros, "USD", "swift")
+	require.True(t, ok)
+	assert.Equal(t, swift.ID(), n.ID())
+
+	n, ok = service.SelectNostro(nostros, "USD", "FEDWIRE")
+	require.True(t, ok)
+	assert.Equal(t, catchAll.ID(), n.ID(), "inactive accounts are skipped")
+
+	_, ok = service.SelectNostro(nostros, "GBP", "SWIFT")
+	assert.False(t, ok)
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+// MaxHorizonDays bounds how many days after today a position is projected.
+const MaxHorizonDays = 90
+
+// DailyProjection is a nostro account's projected movements and closing
+// balance on a day.
+type DailyProjection struct {
+	Date           time.Time
+	Inflows        decimal.Decimal
+	Outflows       decimal.Decimal
+	ClosingBalance decimal.Decimal
+	BelowMinimum   bool
+}
+
+// LiquidityPosition is a nostro account's intraday position and its
+// projected closing balances for today and each day of the horizon.
+type LiquidityPosition struct {
+	AsOf             time.Time
+	Currency         string
+	BookedBalance    decimal.Decimal
+	PendingOutflows  decimal.Decimal
+	AvailableBalance decimal.Decimal
+	MinimumBalance   decimal.Decimal
+	Projections      []DailyProjection
+	NostroID         uuid.UUID
+}
+
+// ProjectPosition builds a nostro account's position at now. The booked
+// balance is the opening balance plus the account's ledger movements;
+// pending outflows are payments initiated through it but not yet settled,
+// and are taken out of today's balance. Expected forecasts then move the
+// closing balance of their value day; those dated before today are assumed
+// booked and those after the horizon are left out.
+func ProjectPosition(
+	nostro model.NostroAccount,
+	movements, pendingOutflows decimal.Decimal,
+	forecasts []model.FundingForecast,
+	now time.Time,
+	horizonDays int,
+) LiquidityPosition {
+	horizonDays = min(max(horizonDays, 0), MaxHorizonDays)
+	booked := nostro.OpeningBalance().Add(movements)
+	available := booked.Sub(pendingOutflows)
+	today := model.DateOf(now)
+
+	projections := make([]DailyProjection, horizonDays+1)
+	for i := range projections {
+		projections[i] = DailyProjection{Date: today.AddDate(0, 0, i)}
+	}
+	for _, f := range forecasts {
+		if f.NostroID() != nostro.ID() || !f.Status().Equal(valueobject.ForecastStatusExpected) {
+			continue
+		}
+		day := int(f.ValueDate().Sub(today).Hours() / 24)
+		if day < 0 || day > horizonDays {
+			continue
+		}
+		if f.SignedAmount().IsNegative() {
+			projections[day].Outflows = projections[day].Outflows.Add(f.Amount())
+		} else {
+			projections[day].Inflows = projections[day].Inflows.Add(f.Amount())
+		}
+	}
+
+	balance := available
+	for i := range projections {
+		balance = balance.Add(projections[i].Inflows).Sub(projections[i].Outflows)
+		projections[i].ClosingBalance = balance
+		projections[i].BelowMinimum = balance.LessThan(nostro.MinimumBalance())
+	}
+
+	return LiquidityPosition{
+		NostroID:         nostro.ID(),
+		Currency:         nostro.Currency(),
+		BookedBalance:    booked,
+		PendingOutflows:  pendingOutflows,
+		AvailableBalance: available,
+		MinimumBalance:   nostro.MinimumBalance(),
+		Projections:      projections,
+		AsOf:             now,
+	}
+}
+
+// Today returns the projected closing balance for today.
+func (p LiquidityPosition) Today() decimal.Decimal {
+	if len(p.Projections) == 0 {
+		return p.AvailableBalance
+	}
+	return p.Projections[0].ClosingBalance
+}
+
+// FirstBreach returns the earliest projected day closing below the
+// minimum balance, and false when there is none.
+func (p LiquidityPosition) FirstBreach() (DailyProjection, bool) {
+	for _, d := range p.Projections {
+		if d.BelowMinimum {
+			return d, true
+		}
+	}
+	return DailyProjection{}, false
+}
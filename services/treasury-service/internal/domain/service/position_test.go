@@ -0,0 +1,77 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/service"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+var now = time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)
+
+func d(v int64) decimal.Decimal { return decimal.NewFromInt(v) }
+
+func newNostro(t *testing.T, currency, ledgerCode string, rails ...string) model.NostroAccount {
+	t.Helper()
+	bic, err := valueobject.NewBIC("CHASUS33")
+	require.NoError(t, err)
+	n, err := model.NewNostroAccount(uuid.New(), currency+" nostro", bic, "000123", currency, ledgerCode,
+		rails, d(1000), d(200), now)
+	require.NoError(t, err)
+	return n
+}
+
+func forecast(t *testing.T, n model.NostroAccount, days int, direction valueobject.ForecastDirection, amount int64) model.FundingForecast {
+	t.Helper()
+	f, err := model.NewFundingForecast(n.TenantID(), n.ID(), now.AddDate(0, 0, days), direction, d(amount), "", now)
+	require.NoError(t, err)
+	return f
+}
+
+func TestProjectPosition(t *testing.T) {
+	n := newNostro(t, "USD", "1100-USD")
+	other := newNostro(t, "USD", "1101-USD")
+	cancelled, err := forecast(t, n, 1, valueobject.ForecastDirectionOutflow, 10_000).Cancel(now)
+	require.NoError(t, err)
+
+	forecasts := []model.FundingForecast{
+		forecast(t, n, 0, valueobject.ForecastDirectionInflow, 100),
+		forecast(t, n, 1, valueobject.ForecastDirectionOutflow, 600),
+		forecast(t, n, 1, valueobject.ForecastDirectionOutflow, 250),
+		forecast(t, n, 3, valueobject.ForecastDirectionInflow, 900),
+		forecast(t, n, 4, valueobject.ForecastDirectionInflow, 5_000), // beyond the horizon
+		forecast(t, other, 0, valueobject.ForecastDirectionOutflow, 5_000),
+		cancelled,
+	}
+
+	p := service.ProjectPosition(n, d(150), d(300), forecasts, now, 3)
+	assert.True(t, d(1150).Equal(p.BookedBalance), "opening plus movements")
+	assert.True(t, d(850).Equal(p.AvailableBalance), "less pending outflows")
+	require.Len(t, p.Projections, 4)
+
+	closing := make([]string, len(p.Projections))
+	for i, day := range p.Projections {
+		assert.Equal(t, model.DateOf(now).AddDate(0, 0, i), day.Date)
+		closing[i] = day.ClosingBalance.String()
+	}
+	assert.Equal(t, []string{"950", "100", "100", "1000"}, closing)
+	assert.True(t, d(850).Equal(p.Projections[1].Outflows))
+	assert.True(t, d(950).Equal(p.Today()))
+
+	breach, ok := p.FirstBreach()
+	require.True(t, ok)
+	assert.Equal(t, model.DateOf(now).AddDate(0, 0, 1), breach.Date)
+	assert.False(t, p.Projections[3].BelowMinimum)
+
+	healthy := service.ProjectPosition(n, d(0), d(0), nil, now, 0)
+	require.Len(t, healthy.Projections, 1)
+	_, ok = healthy.FirstBreach()
+	assert.False(t, ok)
+}
@@ -0,0 +1,50 @@
+package valueobject
+
+import "fmt"
+
+// AlertStatus is where a liquidity alert stands.
+// It is an immutable value object.
+type AlertStatus struct {
+	value string
+}
+
+const (
+	alertStatusOpen     = "OPEN"
+	alertStatusResolved = "RESOLVED"
+)
+
+var (
+	// AlertStatusOpen is an alert whose breach is still projected.
+	AlertStatusOpen = AlertStatus{value: alertStatusOpen}
+	// AlertStatusResolved is an alert whose breach is no longer projected.
+	AlertStatusResolved = AlertStatus{value: alertStatusResolved}
+)
+
+var validAlertStatuses = map[string]AlertStatus{
+	alertStatusOpen:     AlertStatusOpen,
+	alertStatusResolved: AlertStatusResolved,
+}
+
+// NewAlertStatus creates a AlertStatus from a string, validating it is known.
+func NewAlertStatus(s string) (AlertStatus, error) {
+	v, ok := validAlertStatuses[s]
+	if !ok {
+		return AlertStatus{}, fmt.Errorf("invalid alert status: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the AlertStatus.
+func (a AlertStatus) String() string {
+	return a.value
+}
+
+// IsZero returns true if the AlertStatus has not been set.
+func (a AlertStatus) IsZero() bool {
+	return a.value == ""
+}
+
+// Equal returns true if two AlertStatus values are equal.
+func (a AlertStatus) Equal(other AlertStatus) bool {
+	return a.value == other.value
+}
@@ -0,0 +1,34 @@
+package valueobject
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var bicPattern = regexp.MustCompile(`^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+
+// BIC is a correspondent bank's ISO 9362 business identifier code.
+// It is an immutable value object.
+type BIC struct {
+	value string
+}
+
+// NewBIC creates a BIC from an 8 or 11 character code, ignoring case.
+func NewBIC(s string) (BIC, error) {
+	code := strings.ToUpper(strings.TrimSpace(s))
+	if !bicPattern.MatchString(code) {
+		return BIC{}, fmt.Errorf("invalid BIC: %q", s)
+	}
+	return BIC{value: code}, nil
+}
+
+// String returns the string representation of the BIC.
+func (b BIC) String() string {
+	return b.value
+}
+
+// IsZero returns true if the BIC has not been set.
+func (b BIC) IsZero() bool {
+	return b.value == ""
+}
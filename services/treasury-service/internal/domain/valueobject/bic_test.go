@@ -0,0 +1,26 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+func TestNewBIC(t *testing.T) {
+	for _, valid := range []string{"DEUTDEFF", "deutdeff500", " CHASUS33 "} {
+		bic, err := valueobject.NewBIC(valid)
+		require.NoError(t, err, valid)
+		assert.False(t, bic.IsZero())
+	}
+	bic, err := valueobject.NewBIC("deutdeff500")
+	require.NoError(t, err)
+	assert.Equal(t, "DEUTDEFF500", bic.String())
+
+	for _, invalid := range []string{"", "DEUT", "DEUTDEFF5", "1EUTDEFF", "DEUT-DEFF"} {
+		_, err := valueobject.NewBIC(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,50 @@
+package valueobject
+
+import "fmt"
+
+// ForecastDirection is whether a funding forecast brings money into a nostro account or takes it out.
+// It is an immutable value object.
+type ForecastDirection struct {
+	value string
+}
+
+const (
+	forecastDirectionInflow  = "INFLOW"
+	forecastDirectionOutflow = "OUTFLOW"
+)
+
+var (
+	// ForecastDirectionInflow is money expected into the account.
+	ForecastDirectionInflow = ForecastDirection{value: forecastDirectionInflow}
+	// ForecastDirectionOutflow is money expected out of the account.
+	ForecastDirectionOutflow = ForecastDirection{value: forecastDirectionOutflow}
+)
+
+var validForecastDirections = map[string]ForecastDirection{
+	forecastDirectionInflow:  ForecastDirectionInflow,
+	forecastDirectionOutflow: ForecastDirectionOutflow,
+}
+
+// NewForecastDirection creates a ForecastDirection from a string, validating it is known.
+func NewForecastDirection(s string) (ForecastDirection, error) {
+	v, ok := validForecastDirections[s]
+	if !ok {
+		return ForecastDirection{}, fmt.Errorf("invalid forecast direction: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the ForecastDirection.
+func (f ForecastDirection) String() string {
+	return f.value
+}
+
+// IsZero returns true if the ForecastDirection has not been set.
+func (f ForecastDirection) IsZero() bool {
+	return f.value == ""
+}
+
+// Equal returns true if two ForecastDirection values are equal.
+func (f ForecastDirection) Equal(other ForecastDirection) bool {
+	return f.value == other.value
+}
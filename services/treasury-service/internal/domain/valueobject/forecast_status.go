@@ -0,0 +1,50 @@
+package valueobject
+
+import "fmt"
+
+// ForecastStatus is where a funding forecast stands.
+// It is an immutable value object.
+type ForecastStatus struct {
+	value string
+}
+
+const (
+	forecastStatusExpected  = "EXPECTED"
+	forecastStatusCancelled = "CANCELLED"
+)
+
+var (
+	// ForecastStatusExpected is a forecast that counts towards projections.
+	ForecastStatusExpected = ForecastStatus{value: forecastStatusExpected}
+	// ForecastStatusCancelled is a forecast that no longer counts.
+	ForecastStatusCancelled = ForecastStatus{value: forecastStatusCancelled}
+)
+
+var validForecastStatuses = map[string]ForecastStatus{
+	forecastStatusExpected:  ForecastStatusExpected,
+	forecastStatusCancelled: ForecastStatusCancelled,
+}
+
+// NewForecastStatus creates a ForecastStatus from a string, validating it is known.
+func NewForecastStatus(s string) (ForecastStatus, error) {
+	v, ok := validForecastStatuses[s]
+	if !ok {
+		return ForecastStatus{}, fmt.Errorf("invalid forecast status: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the ForecastStatus.
+func (f ForecastStatus) String() string {
+	return f.value
+}
+
+// IsZero returns true if the ForecastStatus has not been set.
+func (f ForecastStatus) IsZero() bool {
+	return f.value == ""
+}
+
+// Equal returns true if two ForecastStatus values are equal.
+func (f ForecastStatus) Equal(other ForecastStatus) bool {
+	return f.value == other.value
+}
@@ -0,0 +1,52 @@
+package valueobject
+
+import "fmt"
+
+// SweepType is which way a sweep instruction moves funds.
+// It is an immutable value object.
+type SweepType struct {
+	value string
+}
+
+const (
+	sweepTypeExcess = "EXCESS"
+	sweepTypeCover  = "COVER"
+)
+
+var (
+	// SweepTypeExcess moves the source account's balance above the target
+	// out once it rises above the trigger.
+	SweepTypeExcess = SweepType{value: sweepTypeExcess}
+	// SweepTypeCover tops the source account up to the target once it falls
+	// below the trigger.
+	SweepTypeCover = SweepType{value: sweepTypeCover}
+)
+
+var validSweepTypes = map[string]SweepType{
+	sweepTypeExcess: SweepTypeExcess,
+	sweepTypeCover:  SweepTypeCover,
+}
+
+// NewSweepType creates a SweepType from a string, validating it is known.
+func NewSweepType(s string) (SweepType, error) {
+	v, ok := validSweepTypes[s]
+	if !ok {
+		return SweepType{}, fmt.Errorf("invalid sweep type: %q", s)
+	}
+	return v, nil
+}
+
+// String returns the string representation of the SweepType.
+func (s SweepType) String() string {
+	return s.value
+}
+
+// IsZero returns true if the SweepType has not been set.
+func (s SweepType) IsZero() bool {
+	return s.value == ""
+}
+
+// Equal returns true if two SweepType values are equal.
+func (s SweepType) Equal(other SweepType) bool {
+	return s.value == other.value
+}
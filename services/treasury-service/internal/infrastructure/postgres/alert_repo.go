@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+const alertColumns = `
+	id, tenant_id, nostro_id, status, breach_date, projected_balance, minimum_balance,
+	opened_at, resolved_at, version, updated_at`
+
+// AlertRepo is the PostgreSQL implementation of AlertRepository.
+type AlertRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewAlertRepo creates a new AlertRepo.
+func NewAlertRepo(pool *pgxpool.Pool) *AlertRepo {
+	return &AlertRepo{pool: pool}
+}
+
+// Save persists the alert with optimistic locking on its version.
+func (r *AlertRepo) Save(ctx context.Context, a model.LiquidityAlert) error {
+	query := `
+		INSERT INTO liquidity_alerts (` + alertColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			breach_date = EXCLUDED.breach_date,
+			projected_balance = EXCLUDED.projected_balance,
+			minimum_balance = EXCLUDED.minimum_balance,
+			resolved_at = EXCLUDED.resolved_at,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE liquidity_alerts.version = EXCLUDED.version - 1
+	`
+
+	result, err := r.pool.Exec(ctx, query,
+		a.ID(), a.TenantID(), a.NostroID(), a.Status().String(), a.BreachDate(), a.ProjectedBalance(),
+		a.MinimumBalance(), a.OpenedAt(), a.ResolvedAt(), a.Version(), a.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert liquidity alert: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: alert %s", port.ErrVersionConflict, a.ID())
+	}
+	return nil
+}
+
+// FindOpen retrieves a nostro account's open alert.
+func (r *AlertRepo) FindOpen(ctx context.Context, tenantID, nostroID uuid.UUID) (model.LiquidityAlert, error) {
+	query := `SELECT ` + alertColumns + ` FROM liquidity_alerts
+		WHERE tenant_id = $1 AND nostro_id = $2 AND status = 'OPEN'`
+
+	a, err := scanAlert(r.pool.QueryRow(ctx, query, tenantID, nostroID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.LiquidityAlert{}, port.ErrAlertNotFound
+	}
+	if err != nil {
+		return model.LiquidityAlert{}, fmt.Errorf("failed to scan liquidity alert: %w", err)
+	}
+	return a, nil
+}
+
+// List returns a page of the alerts matching the filter, newest first, and
+// the total number of them.
+func (r *AlertRepo) List(ctx context.Context, filter port.AlertFilter, limit, offset int) ([]model.LiquidityAlert, int, error) {
+	const where = `WHERE tenant_id = $1
+		AND ($2 = '00000000-0000-0000-0000-000000000000'::uuid OR nostro_id = $2)
+		AND ($3 = '' OR status = $3)`
+	args := []any{filter.TenantID, filter.NostroID, filter.Status.String()}
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM liquidity_alerts `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count liquidity alerts: %w", err)
+	}
+	rows, err := r.pool.Query(ctx, `SELECT `+alertColumns+` FROM liquidity_alerts `+where+`
+		ORDER BY opened_at DESC
+		LIMIT $4 OFFSET $5
+	`, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query liquidity alerts: %w", err)
+	}
+	defer rows.Close()
+
+	var alerts []model.LiquidityAlert
+	for rows.Next() {
+		a, err := scanAlert(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan liquidity alert row: %w", err)
+		}
+		alerts = append(alerts, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("row iteration error: %w", err)
+	}
+	return alerts, total, nil
+}
+
+func scanAlert(row pgx.Row) (model.LiquidityAlert, error) {
+	var (
+		id, tenantID, nostroID           uuid.UUID
+		status                           string
+		breachDate                       time.Time
+		projectedBalance, minimumBalance decimal.Decimal
+		openedAt                         time.Time
+		resolvedAt                       *time.Time
+		version                          int
+		updatedAt                        time.Time
+	)
+
+	err := row.Scan(&id, &tenantID, &nostroID, &status, &breachDate, &projectedBalance, &minimumBalance,
+		&openedAt, &resolvedAt, &version, &updatedAt)
+	if err != nil {
+		return model.LiquidityAlert{}, err
+	}
+
+	alertStatus, err := valueobject.NewAlertStatus(status)
+	if err != nil {
+		return model.LiquidityAlert{}, fmt.Errorf("invalid status in database: %w", err)
+	}
+
+	return model.ReconstructLiquidityAlert(
+		id, tenantID, nostroID, alertStatus, breachDate.UTC(), projectedBalance, minimumBalance,
+		openedAt, resolvedAt, version, updatedAt,
+	), nil
+}
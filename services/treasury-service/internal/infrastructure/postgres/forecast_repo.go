@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+const forecastColumns = `
+	id, tenant_id, nostro_id, sweep_id, value_date, direction, amount, description,
+	status, version, created_at, updated_at`
+
+// ForecastRepo is the PostgreSQL implementation of ForecastRepository.
+type ForecastRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewForecastRepo creates a new ForecastRepo.
+func NewForecastRepo(pool *pgxpool.Pool) *ForecastRepo {
+	return &ForecastRepo{pool: pool}
+}
+
+// Save persists the forecast with optimistic locking on its version.
+func (r *ForecastRepo) Save(ctx context.Context, f model.FundingForecast) error {
+	query := `
+		INSERT INTO funding_forecasts (` + forecastColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE funding_forecasts.version = EXCLUDED.version - 1
+	`
+
+	var sweepID *uuid.UUID
+	if f.SweepID() != uuid.Nil {
+		id := f.SweepID()
+		sweepID = &id
+	}
+	result, err := r.pool.Exec(ctx, query,
+		f.ID(), f.TenantID(), f.NostroID(), sweepID, f.ValueDate(), f.Direction().String(), f.Amount(),
+		f.Description(), f.Status().String(), f.Version(), f.CreatedAt(), f.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert funding forecast: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: forecast %s", port.ErrVersionConflict, f.ID())
+	}
+	return nil
+}
+
+// FindByID retrieves a tenant's forecast.
+func (r *ForecastRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.FundingForecast, error) {
+	query := `SELECT ` + forecastColumns + ` FROM funding_forecasts WHERE id = $1 AND tenant_id = $2`
+
+	f, err := scanForecast(r.pool.QueryRow(ctx, query, id, tenantID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.FundingForecast{}, port.ErrForecastNotFound
+	}
+	if err != nil {
+		return model.FundingForecast{}, fmt.Errorf("failed to scan funding forecast: %w", err)
+	}
+	return f, nil
+}
+
+// List returns a page of the forecasts matching the filter, by value date,
+// and the total number of them.
+func (r *ForecastRepo) List(ctx context.Context, filter port.ForecastFilter, limit, offset int) ([]model.FundingForecast, int, error) {
+	const where = `WHERE tenant_id = $1
+		AND ($2 = '00000000-0000-0000-0000-000000000000'::uuid OR nostro_id = $2)
+		AND ($3::date IS NULL OR value_date >= $3)
+		AND ($4::date IS NULL OR value_date <= $4)
+		AND ($5 OR status = 'EXPECTED')`
+	args := []any{filter.TenantID, filter.NostroID, nullableDate(filter.From), nullableDate(filter.To), filter.IncludeCancelled}
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM funding_forecasts `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count funding forecasts: %w", err)
+	}
+	forecasts, err := r.query(ctx, `SELECT `+forecastColumns+` FROM funding_forecasts `+where+`
+		ORDER BY value_date, created_at
+		LIMIT $6 OFFSET $7
+	`, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return forecasts, total, nil
+}
+
+// ListExpected returns a tenant's expected forecasts value dated from one
+// day to another, inclusive.
+func (r *ForecastRepo) ListExpected(ctx context.Context, tenantID uuid.UUID, from, to time.Time) ([]model.FundingForecast, error) {
+	return r.query(ctx, `SELECT `+forecastColumns+` FROM funding_forecasts
+		WHERE tenant_id = $1 AND status = 'EXPECTED' AND value_date BETWEEN $2 AND $3
+		ORDER BY value_date, created_at
+	`, tenantID, from, to)
+}
+
+func (r *ForecastRepo) query(ctx context.Context, query string, args ...any) ([]model.FundingForecast, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query funding forecasts: %w", err)
+	}
+	defer rows.Close()
+
+	var forecasts []model.FundingForecast
+	for rows.Next() {
+		f, err := scanForecast(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan funding forecast row: %w", err)
+		}
+		forecasts = append(forecasts, f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return forecasts, nil
+}
+
+func scanForecast(row pgx.Row) (model.FundingForecast, error) {
+	var (
+		id, tenantID, nostroID uuid.UUID
+		sweepID                *uuid.UUID
+		valueDate              time.Time
+		direction, status      string
+		amount                 decimal.Decimal
+		description            string
+		version                int
+		createdAt, updatedAt   time.Time
+	)
+
+	err := row.Scan(&id, &tenantID, &nostroID, &sweepID, &valueDate, &direction, &amount, &description,
+		&status, &version, &createdAt, &updatedAt)
+	if err != nil {
+		return model.FundingForecast{}, err
+	}
+
+	forecastDirection, err := valueobject.NewForecastDirection(direction)
+	if err != nil {
+		return model.FundingForecast{}, fmt.Errorf("invalid direction in database: %w", err)
+	}
+	forecastStatus, err := valueobject.NewForecastStatus(status)
+	if err != nil {
+		return model.FundingForecast{}, fmt.Errorf("invalid status in database: %w", err)
+	}
+	var sweep uuid.UUID
+	if sweepID != nil {
+		sweep = *sweepID
+	}
+
+	return model.ReconstructFundingForecast(
+		id, tenantID, nostroID, sweep, valueDate.UTC(), forecastDirection, amount, description,
+		forecastStatus, version, createdAt, updatedAt,
+	), nil
+}
+
+// nullableDate returns nil for the zero time so that an optional filter is
+// skipped.
+func nullableDate(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,20 @@
+-- Tenants' accounts at correspondent banks. The booked balance is
+-- opening_balance plus the account's ledger movements.
+CREATE TABLE IF NOT EXISTS nostro_accounts (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    correspondent_bic VARCHAR(11) NOT NULL,
+    account_number VARCHAR(34) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    ledger_account_code VARCHAR(32) NOT NULL,
+    rails TEXT[] NOT NULL DEFAULT '{}',
+    opening_balance NUMERIC(20, 4) NOT NULL DEFAULT 0,
+    minimum_balance NUMERIC(20, 4) NOT NULL DEFAULT 0,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_nostro_accounts_tenant ON nostro_accounts (tenant_id, currency, name);
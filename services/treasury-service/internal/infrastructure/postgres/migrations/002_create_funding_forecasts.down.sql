@@ -0,0 +1 @@
+DROP TABLE IF EXISTS funding_forecasts;
@@ -0,0 +1,19 @@
+-- Expected movements on nostro accounts. sweep_id is set on the two legs
+-- of an executed sweep.
+CREATE TABLE IF NOT EXISTS funding_forecasts (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    nostro_id UUID NOT NULL REFERENCES nostro_accounts (id),
+    sweep_id UUID,
+    value_date DATE NOT NULL,
+    direction VARCHAR(10) NOT NULL,
+    amount NUMERIC(20, 4) NOT NULL,
+    description VARCHAR(256) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_funding_forecasts_tenant ON funding_forecasts (tenant_id, value_date);
+CREATE INDEX idx_funding_forecasts_nostro ON funding_forecasts (nostro_id, value_date);
@@ -0,0 +1,18 @@
+-- Standing instructions to move funds between two nostro accounts in the
+-- same currency when the source's projected balance crosses a trigger.
+CREATE TABLE IF NOT EXISTS sweep_instructions (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    source_nostro_id UUID NOT NULL REFERENCES nostro_accounts (id),
+    target_nostro_id UUID NOT NULL REFERENCES nostro_accounts (id),
+    type VARCHAR(10) NOT NULL,
+    trigger_balance NUMERIC(20, 4) NOT NULL,
+    target_balance NUMERIC(20, 4) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    last_executed_on DATE,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_sweep_instructions_tenant ON sweep_instructions (tenant_id, created_at);
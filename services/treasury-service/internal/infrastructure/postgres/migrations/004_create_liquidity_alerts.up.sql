@@ -0,0 +1,18 @@
+-- Projected breaches of nostro accounts' minimum balances. An account has
+-- at most one open alert.
+CREATE TABLE IF NOT EXISTS liquidity_alerts (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    nostro_id UUID NOT NULL REFERENCES nostro_accounts (id),
+    status VARCHAR(20) NOT NULL,
+    breach_date DATE NOT NULL,
+    projected_balance NUMERIC(20, 4) NOT NULL,
+    minimum_balance NUMERIC(20, 4) NOT NULL,
+    opened_at TIMESTAMPTZ NOT NULL,
+    resolved_at TIMESTAMPTZ,
+    version INT NOT NULL DEFAULT 1,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_liquidity_alerts_open ON liquidity_alerts (nostro_id) WHERE status = 'OPEN';
+CREATE INDEX idx_liquidity_alerts_tenant ON liquidity_alerts (tenant_id, opened_at DESC);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS treasury_payment_exposures;
+DROP TABLE IF EXISTS treasury_ledger_movements;
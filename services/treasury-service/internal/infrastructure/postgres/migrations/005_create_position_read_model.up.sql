@@ -0,0 +1,27 @@
+-- Read model projected from ledger and payment events: the movements ledger
+-- entries made on nostro accounts, and outgoing payments settling through
+-- them.
+CREATE TABLE IF NOT EXISTS treasury_ledger_movements (
+    entry_id UUID NOT NULL,
+    nostro_id UUID NOT NULL,
+    tenant_id UUID NOT NULL,
+    amount NUMERIC(20, 4) NOT NULL,
+    posted_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (entry_id, nostro_id)
+);
+
+CREATE INDEX idx_treasury_ledger_movements_nostro ON treasury_ledger_movements (nostro_id);
+
+CREATE TABLE IF NOT EXISTS treasury_payment_exposures (
+    payment_id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    nostro_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    rail VARCHAR(32) NOT NULL,
+    amount NUMERIC(20, 4) NOT NULL,
+    initiated_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_treasury_payment_exposures_pending ON treasury_payment_exposures (nostro_id) WHERE status = 'PENDING';
@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+const nostroColumns = `
+	id, tenant_id, name, correspondent_bic, account_number, currency, ledger_account_code,
+	rails, opening_balance, minimum_balance, active, version, created_at, updated_at`
+
+// NostroRepo is the PostgreSQL implementation of NostroRepository.
+type NostroRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewNostroRepo creates a new NostroRepo.
+func NewNostroRepo(pool *pgxpool.Pool) *NostroRepo {
+	return &NostroRepo{pool: pool}
+}
+
+// Save persists the nostro account with optimistic locking on its version.
+func (r *NostroRepo) Save(ctx context.Context, n model.NostroAccount) error {
+	query := `
+		INSERT INTO nostro_accounts (` + nostroColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name,
+			rails = EXCLUDED.rails,
+			minimum_balance = EXCLUDED.minimum_balance,
+			active = EXCLUDED.active,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE nostro_accounts.version = EXCLUDED.version - 1
+	`
+
+	result, err := r.pool.Exec(ctx, query,
+		n.ID(), n.TenantID(), n.Name(), n.CorrespondentBIC().String(), n.AccountNumber(), n.Currency(),
+		n.LedgerAccountCode(), n.Rails(), n.OpeningBalance(), n.MinimumBalance(), n.Active(),
+		n.Version(), n.CreatedAt(), n.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert nostro account: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: nostro account %s", port.ErrVersionConflict, n.ID())
+	}
+	return nil
+}
+
+// FindByID retrieves a tenant's nostro account.
+func (r *NostroRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.NostroAccount, error) {
+	query := `SELECT ` + nostroColumns + ` FROM nostro_accounts WHERE id = $1 AND tenant_id = $2`
+
+	n, err := scanNostro(r.pool.QueryRow(ctx, query, id, tenantID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.NostroAccount{}, port.ErrNostroNotFound
+	}
+	if err != nil {
+		return model.NostroAccount{}, fmt.Errorf("failed to scan nostro account: %w", err)
+	}
+	return n, nil
+}
+
+// List returns a page of a tenant's nostro accounts, by currency and name,
+// and the total number of them.
+func (r *NostroRepo) List(ctx context.Context, tenantID uuid.UUID, currency string, limit, offset int) ([]model.NostroAccount, int, error) {
+	where := `WHERE tenant_id = $1 AND ($2 = '' OR currency = $2)`
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM nostro_accounts `+where, tenantID, currency).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count nostro accounts: %w", err)
+	}
+	nostros, err := r.query(ctx, `SELECT `+nostroColumns+` FROM nostro_accounts `+where+`
+		ORDER BY currency, name, id
+		LIMIT $3 OFFSET $4
+	`, tenantID, currency, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return nostros, total, nil
+}
+
+// ListAll returns all of a tenant's nostro accounts.
+func (r *NostroRepo) ListAll(ctx context.Context, tenantID uuid.UUID) ([]model.NostroAccount, error) {
+	return r.query(ctx, `SELECT `+nostroColumns+` FROM nostro_accounts
+		WHERE tenant_id = $1
+		ORDER BY currency, name, id
+	`, tenantID)
+}
+
+// ListTenants returns the tenants with at least one active nostro account.
+func (r *NostroRepo) ListTenants(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := r.pool.Query(ctx, `SELECT DISTINCT tenant_id FROM nostro_accounts WHERE active`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tenants: %w", err)
+	}
+	tenants, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan tenant row: %w", err)
+	}
+	return tenants, nil
+}
+
+func (r *NostroRepo) query(ctx context.Context, query string, args ...any) ([]model.NostroAccount, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query nostro accounts: %w", err)
+	}
+	defer rows.Close()
+
+	var nostros []model.NostroAccount
+	for rows.Next() {
+		n, err := scanNostro(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan nostro account row: %w", err)
+		}
+		nostros = append(nostros, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return nostros, nil
+}
+
+func scanNostro(row pgx.Row) (model.NostroAccount, error) {
+	var (
+		id, tenantID                   uuid.UUID
+		name, bic, accountNumber       string
+		currency, ledgerAccountCode    string
+		rails                          []string
+		openingBalance, minimumBalance decimal.Decimal
+		active                         bool
+		version                        int
+		createdAt, updatedAt           time.Time
+	)
+
+	err := row.Scan(&id, &tenantID, &name, &bic, &accountNumber, &currency, &ledgerAccountCode,
+		&rails, &openingBalance, &minimumBalance, &active, &version, &createdAt, &updatedAt)
+	if err != nil {
+		return model.NostroAccount{}, err
+	}
+
+	correspondentBIC, err := valueobject.NewBIC(bic)
+	if err != nil {
+		return model.NostroAccount{}, fmt.Errorf("invalid BIC in database: %w", err)
+	}
+
+	return model.ReconstructNostroAccount(
+		id, tenantID, name, correspondentBIC, accountNumber, currency, ledgerAccountCode,
+		rails, openingBalance, minimumBalance, active, version, createdAt, updatedAt,
+	), nil
+}
@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/service"
+)
+
+// PositionReadModel is the PostgreSQL implementation of PositionReadModel.
+type PositionReadModel struct {
+	pool *pgxpool.Pool
+}
+
+// NewPositionReadModel creates a new PositionReadModel.
+func NewPositionReadModel(pool *pgxpool.Pool) *PositionReadModel {
+	return &PositionReadModel{pool: pool}
+}
+
+// RecordMovements stores ledger movements, ignoring any already recorded
+// for the same entry and nostro account.
+func (m *PositionReadModel) RecordMovements(ctx context.Context, movements ...service.LedgerMovement) error {
+	batch := &pgx.Batch{}
+	for _, mv := range movements {
+		batch.Queue(`
+			INSERT INTO treasury_ledger_movements (entry_id, nostro_id, tenant_id, amount, posted_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (entry_id, nostro_id) DO NOTHING
+		`, mv.EntryID, mv.NostroID, mv.TenantID, mv.Amount, mv.PostedAt)
+	}
+	if err := m.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to record ledger movements: %w", err)
+	}
+	return nil
+}
+
+// SaveExposure upserts a payment's exposure.
+func (m *PositionReadModel) SaveExposure(ctx context.Context, e service.PaymentExposure) error {
+	_, err := m.pool.Exec(ctx, `
+		INSERT INTO treasury_payment_exposures
+			(payment_id, tenant_id, nostro_id, status, currency, rail, amount, initiated_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (payment_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			updated_at = EXCLUDED.updated_at
+	`, e.PaymentID, e.TenantID, e.NostroID, e.Status, e.Currency, e.Rail, e.Amount, e.InitiatedAt, e.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert payment exposure: %w", err)
+	}
+	return nil
+}
+
+// FindExposure retrieves a payment's exposure.
+func (m *PositionReadModel) FindExposure(ctx context.Context, paymentID uuid.UUID) (service.PaymentExposure, error) {
+	var e service.PaymentExposure
+	err := m.pool.QueryRow(ctx, `
+		SELECT payment_id, tenant_id, nostro_id, status, currency, rail, amount, initiated_at, updated_at
+		FROM treasury_payment_exposures WHERE payment_id = $1
+	`, paymentID).Scan(&e.PaymentID, &e.TenantID, &e.NostroID, &e.Status, &e.Currency, &e.Rail, &e.Amount,
+		&e.InitiatedAt, &e.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return service.PaymentExposure{}, port.ErrProjectionNotFound
+	}
+	if err != nil {
+		return service.PaymentExposure{}, fmt.Errorf("failed to scan payment exposure: %w", err)
+	}
+	return e, nil
+}
+
+// Balances sums a nostro account's ledger movements and pending outflows.
+func (m *PositionReadModel) Balances(ctx context.Context, nostroID uuid.UUID) (decimal.Decimal, decimal.Decimal, error) {
+	var movements, pending decimal.Decimal
+	err := m.pool.QueryRow(ctx, `
+		SELECT
+			(SELECT COALESCE(SUM(amount), 0) FROM treasury_ledger_movements WHERE nostro_id = $1),
+			(SELECT COALESCE(SUM(amount), 0) FROM treasury_payment_exposures WHERE nostro_id = $1 AND status = 'PENDING')
+	`, nostroID).Scan(&movements, &pending)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("failed to sum nostro balances: %w", err)
+	}
+	return movements, pending, nil
+}
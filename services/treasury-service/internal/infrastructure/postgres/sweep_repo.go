@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+const sweepColumns = `
+	id, tenant_id, source_nostro_id, target_nostro_id, type, trigger_balance, target_balance,
+	active, last_executed_on, version, created_at, updated_at`
+
+// SweepRepo is the PostgreSQL implementation of SweepRepository.
+type SweepRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewSweepRepo creates a new SweepRepo.
+func NewSweepRepo(pool *pgxpool.Pool) *SweepRepo {
+	return &SweepRepo{pool: pool}
+}
+
+// Save persists the sweep instruction with optimistic locking on its
+// version.
+func (r *SweepRepo) Save(ctx context.Context, s model.SweepInstruction) error {
+	query := `
+		INSERT INTO sweep_instructions (` + sweepColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (id) DO UPDATE SET
+			trigger_balance = EXCLUDED.trigger_balance,
+			target_balance = EXCLUDED.target_balance,
+			active = EXCLUDED.active,
+			last_executed_on = EXCLUDED.last_executed_on,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE sweep_instructions.version = EXCLUDED.version - 1
+	`
+
+	result, err := r.pool.Exec(ctx, query,
+		s.ID(), s.TenantID(), s.SourceNostroID(), s.TargetNostroID(), s.Type().String(), s.TriggerBalance(),
+		s.TargetBalance(), s.Active(), s.LastExecutedOn(), s.Version(), s.CreatedAt(), s.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert sweep instruction: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: sweep %s", port.ErrVersionConflict, s.ID())
+	}
+	return nil
+}
+
+// FindByID retrieves a tenant's sweep instruction.
+func (r *SweepRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.SweepInstruction, error) {
+	query := `SELECT ` + sweepColumns + ` FROM sweep_instructions WHERE id = $1 AND tenant_id = $2`
+
+	s, err := scanSweep(r.pool.QueryRow(ctx, query, id, tenantID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.SweepInstruction{}, port.ErrSweepNotFound
+	}
+	if err != nil {
+		return model.SweepInstruction{}, fmt.Errorf("failed to scan sweep instruction: %w", err)
+	}
+	return s, nil
+}
+
+// List returns a page of a tenant's sweep instructions, oldest first, and
+// the total number of them.
+func (r *SweepRepo) List(ctx context.Context, tenantID, nostroID uuid.UUID, limit, offset int) ([]model.SweepInstruction, int, error) {
+	const where = `WHERE tenant_id = $1
+		AND ($2 = '00000000-0000-0000-0000-000000000000'::uuid OR source_nostro_id = $2 OR target_nostro_id = $2)`
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM sweep_instructions `+where, tenantID, nostroID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count sweep instructions: %w", err)
+	}
+	sweeps, err := r.query(ctx, `SELECT `+sweepColumns+` FROM sweep_instructions `+where+`
+		ORDER BY created_at
+		LIMIT $3 OFFSET $4
+	`, tenantID, nostroID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return sweeps, total, nil
+}
+
+// ListActive returns all of a tenant's active sweep instructions, oldest
+// first.
+func (r *SweepRepo) ListActive(ctx context.Context, tenantID uuid.UUID) ([]model.SweepInstruction, error) {
+	return r.query(ctx, `SELECT `+sweepColumns+` FROM sweep_instructions
+		WHERE tenant_id = $1 AND active
+		ORDER BY created_at
+	`, tenantID)
+}
+
+func (r *SweepRepo) query(ctx context.Context, query string, args ...any) ([]model.SweepInstruction, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sweep instructions: %w", err)
+	}
+	defer rows.Close()
+
+	var sweeps []model.SweepInstruction
+	for rows.Next() {
+		s, err := scanSweep(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan sweep instruction row: %w", err)
+		}
+		sweeps = append(sweeps, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return sweeps, nil
+}
+
+func scanSweep(row pgx.Row) (model.SweepInstruction, error) {
+	var (
+		id, tenantID, sourceID, targetID uuid.UUID
+		sweepType                        string
+		triggerBalance, targetBalance    decimal.Decimal
+		active                           bool
+		lastExecutedOn                   *time.Time
+		version                          int
+		createdAt, updatedAt             time.Time
+	)
+
+	err := row.Scan(&id, &tenantID, &sourceID, &targetID, &sweepType, &triggerBalance, &targetBalance,
+		&active, &lastExecutedOn, &version, &createdAt, &updatedAt)
+	if err != nil {
+		return model.SweepInstruction{}, err
+	}
+
+	t, err := valueobject.NewSweepType(sweepType)
+	if err != nil {
+		return model.SweepInstruction{}, fmt.Errorf("invalid sweep type in database: %w", err)
+	}
+	if lastExecutedOn != nil {
+		day := lastExecutedOn.UTC()
+		lastExecutedOn = &day
+	}
+
+	return model.ReconstructSweepInstruction(
+		id, tenantID, sourceID, targetID, t, triggerBalance, targetBalance,
+		active, lastExecutedOn, version, createdAt, updatedAt,
+	), nil
+}
@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	grpclib "google.golang.org/grpc"
+
+	treasuryv1 "github.com/bibbank/bib/api/gen/go/bib/treasury/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/treasury-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/event"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractNostroRepo holds at most one nostro account.
+type contractNostroRepo struct {
+	nostro *model.NostroAccount
+}
+
+func (r *contractNostroRepo) Save(context.Context, model.NostroAccount) error { return nil }
+
+func (r *contractNostroRepo) FindByID(context.Context, uuid.UUID, uuid.UUID) (model.NostroAccount, error) {
+	if r.nostro == nil {
+		return model.NostroAccount{}, port.ErrNostroNotFound
+	}
+	return *r.nostro, nil
+}
+
+func (r *contractNostroRepo) List(context.Context, uuid.UUID, string, int, int) ([]model.NostroAccount, int, error) {
+	return nil, 0, nil
+}
+
+func (r *contractNostroRepo) ListAll(context.Context, uuid.UUID) ([]model.NostroAccount, error) {
+	return nil, nil
+}
+
+func (r *contractNostroRepo) ListTenants(context.Context) ([]uuid.UUID, error) { return nil, nil }
+
+type discardPublisher struct{}
+
+func (discardPublisher) Publish(context.Context, []event.DomainEvent) error { return nil }
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	nostros := &contractNostroRepo{}
+	h := NewHandler(
+		usecase.NewCreateNostroAccountUseCase(nostros, discardPublisher{}), nil,
+		usecase.NewGetNostroAccountUseCase(nostros), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		slog.Default(),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { treasuryv1.RegisterTreasuryServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-treasury-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { nostros.nostro = nil },
+		"an active nostro account exists": func(t *testing.T) {
+			bic, err := valueobject.NewBIC("DEUTDEFF")
+			if err != nil {
+				t.Fatal(err)
+			}
+			nostro, err := model.NewNostroAccount(contractTenantID, "EUR correspondent", bic, "100200300", "EUR", "1050",
+				[]string{"SEPA"}, decimal.NewFromInt(1000000), decimal.NewFromInt(250000), time.Now().UTC())
+			if err != nil {
+				t.Fatal(err)
+			}
+			nostros.nostro = &nostro
+		},
+	})
+}
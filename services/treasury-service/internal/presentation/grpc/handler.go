@@ -0,0 +1,767 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	treasuryv1 "github.com/bibbank/bib/api/gen/go/bib/treasury/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/treasury-service/internal/application/dto"
+	"github.com/bibbank/bib/services/treasury-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+)
+
+// staffRoles may read a tenant's accounts, positions, forecasts, sweeps and
+// alerts; treasuryRoles may change them and run an evaluation.
+var (
+	staffRoles    = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleAPIClient}
+	treasuryRoles = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient}
+)
+
+// requireRole checks that the caller has at least one of the given roles.
+func requireRole(ctx context.Context, roles ...string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "insufficient permissions")
+}
+
+// tenantIDFromContext extracts the tenant ID from JWT claims in the context.
+func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return claims.TenantID, nil
+}
+
+// optionalUUID parses an optional ID, returning uuid.Nil when it is empty.
+func optionalUUID(raw, field string) (uuid.UUID, error) {
+	if raw == "" {
+		return uuid.Nil, nil
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid %s", field)
+	}
+	return id, nil
+}
+
+// optionalDate parses an optional YYYY-MM-DD date, returning the zero time
+// when it is empty.
+func optionalDate(raw, field string) (time.Time, error) {
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	date, err := time.Parse(time.DateOnly, raw)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "invalid %s: expected YYYY-MM-DD", field)
+	}
+	return date, nil
+}
+
+// parseAmount parses a decimal amount, an empty one being zero.
+func parseAmount(raw, field string) (decimal.Decimal, error) {
+	if raw == "" {
+		return decimal.Zero, nil
+	}
+	amount, err := decimal.NewFromString(raw)
+	if err != nil {
+		return decimal.Decimal{}, status.Errorf(codes.InvalidArgument, "invalid %s", field)
+	}
+	return amount, nil
+}
+
+// enumName strips an enum value's prefix, returning "" for the unspecified
+// value.
+func enumName(name, prefix string) string {
+	name = strings.TrimPrefix(name, prefix)
+	if name == "UNSPECIFIED" {
+		return ""
+	}
+	return name
+}
+
+// Compile-time assertion that Handler implements TreasuryServiceServer.
+var _ treasuryv1.TreasuryServiceServer = (*Handler)(nil)
+
+// Handler implements the TreasuryServiceServer gRPC interface.
+type Handler struct {
+	treasuryv1.UnimplementedTreasuryServiceServer
+	createNostro      *usecase.CreateNostroAccountUseCase
+	updateNostro      *usecase.UpdateNostroAccountUseCase
+	getNostro         *usecase.GetNostroAccountUseCase
+	listNostros       *usecase.ListNostroAccountsUseCase
+	getPosition       *usecase.GetLiquidityPositionUseCase
+	listPositions     *usecase.ListLiquidityPositionsUseCase
+	createForecast    *usecase.CreateForecastUseCase
+	cancelForecast    *usecase.CancelForecastUseCase
+	listForecasts     *usecase.ListForecastsUseCase
+	createSweep       *usecase.CreateSweepUseCase
+	updateSweep       *usecase.UpdateSweepUseCase
+	listSweeps        *usecase.ListSweepsUseCase
+	listAlerts        *usecase.ListAlertsUseCase
+	evaluateLiquidity *usecase.EvaluateLiquidityUseCase
+	logger            *slog.Logger
+}
+
+// NewHandler creates a new gRPC Handler.
+func NewHandler(
+	createNostro *usecase.CreateNostroAccountUseCase,
+	updateNostro *usecase.UpdateNostroAccountUseCase,
+	getNostro *usecase.GetNostroAccountUseCase,
+	listNostros *usecase.ListNostroAccountsUseCase,
+	getPosition *usecase.GetLiquidityPositionUseCase,
+	listPositions *usecase.ListLiquidityPositionsUseCase,
+	createForecast *usecase.CreateForecastUseCase,
+	cancelForecast *usecase.CancelForecastUseCase,
+	listForecasts *usecase.ListForecastsUseCase,
+	createSweep *usecase.CreateSweepUseCase,
+	updateSweep *usecase.UpdateSweepUseCase,
+	listSweeps *usecase.ListSweepsUseCase,
+	listAlerts *usecase.ListAlertsUseCase,
+	evaluateLiquidity *usecase.EvaluateLiquidityUseCase,
+	logger *slog.Logger,
+) *Handler {
+	return &Handler{
+		createNostro:      createNostro,
+		updateNostro:      updateNostro,
+		getNostro:         getNostro,
+		listNostros:       listNostros,
+		getPosition:       getPosition,
+		listPositions:     listPositions,
+		createForecast:    createForecast,
+		cancelForecast:    cancelForecast,
+		listForecasts:     listForecasts,
+		createSweep:       createSweep,
+		updateSweep:       updateSweep,
+		listSweeps:        listSweeps,
+		listAlerts:        listAlerts,
+		evaluateLiquidity: evaluateLiquidity,
+		logger:            logger,
+	}
+}
+
+// CreateNostroAccount starts tracking a nostro account.
+func (h *Handler) CreateNostroAccount(ctx context.Context, req *treasuryv1.CreateNostroAccountRequest) (*treasuryv1.CreateNostroAccountResponse, error) {
+	if err := requireRole(ctx, treasuryRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	opening, err := parseAmount(req.OpeningBalance, "opening balance")
+	if err != nil {
+		return nil, err
+	}
+	minimum, err := parseAmount(req.MinimumBalance, "minimum balance")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.createNostro.Execute(ctx, dto.CreateNostroAccountRequest{
+		TenantID:          tenantID,
+		Name:              req.Name,
+		CorrespondentBIC:  req.CorrespondentBic,
+		AccountNumber:     req.AccountNumber,
+		Currency:          req.Currency,
+		LedgerAccountCode: req.LedgerAccountCode,
+		Rails:             req.Rails,
+		OpeningBalance:    opening,
+		MinimumBalance:    minimum,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &treasuryv1.CreateNostroAccountResponse{NostroAccount: toNostroAccountMsg(resp)}, nil
+}
+
+// UpdateNostroAccount changes a nostro account's name, rails and minimum
+// balance, or activates or deactivates it.
+func (h *Handler) UpdateNostroAccount(ctx context.Context, req *treasuryv1.UpdateNostroAccountRequest) (*treasuryv1.UpdateNostroAccountResponse, error) {
+	if err := requireRole(ctx, treasuryRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	nostroID, err := uuid.Parse(req.NostroId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid nostro account ID")
+	}
+	minimum, err := parseAmount(req.MinimumBalance, "minimum balance")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.updateNostro.Execute(ctx, dto.UpdateNostroAccountRequest{
+		TenantID:       tenantID,
+		NostroID:       nostroID,
+		Name:           req.Name,
+		Rails:          req.Rails,
+		MinimumBalance: minimum,
+		Active:         req.Active,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &treasuryv1.UpdateNostroAccountResponse{NostroAccount: toNostroAccountMsg(resp)}, nil
+}
+
+// GetNostroAccount retrieves a nostro account.
+func (h *Handler) GetNostroAccount(ctx context.Context, req *treasuryv1.GetNostroAccountRequest) (*treasuryv1.GetNostroAccountResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	nostroID, err := uuid.Parse(req.NostroId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid nostro account ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.getNostro.Execute(ctx, tenantID, nostroID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &treasuryv1.GetNostroAccountResponse{NostroAccount: toNostroAccountMsg(resp)}, nil
+}
+
+// ListNostroAccounts lists the tenant's nostro accounts by currency and
+// name.
+func (h *Handler) ListNostroAccounts(ctx context.Context, req *treasuryv1.ListNostroAccountsRequest) (*treasuryv1.ListNostroAccountsResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listNostros.Execute(ctx, dto.ListNostroAccountsRequest{
+		TenantID: tenantID,
+		Currency: req.Currency,
+		Limit:    int(req.PageSize),
+		Offset:   int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &treasuryv1.ListNostroAccountsResponse{
+		NostroAccounts: make([]*treasuryv1.NostroAccount, 0, len(resp.NostroAccounts)),
+		TotalCount:     int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, n := range resp.NostroAccounts {
+		out.NostroAccounts = append(out.NostroAccounts, toNostroAccountMsg(n))
+	}
+	return out, nil
+}
+
+// GetLiquidityPosition projects a nostro account's position.
+func (h *Handler) GetLiquidityPosition(ctx context.Context, req *treasuryv1.GetLiquidityPositionRequest) (*treasuryv1.GetLiquidityPositionResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	nostroID, err := uuid.Parse(req.NostroId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid nostro account ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.getPosition.Execute(ctx, dto.GetLiquidityPositionRequest{
+		TenantID:    tenantID,
+		NostroID:    nostroID,
+		HorizonDays: int(req.HorizonDays),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &treasuryv1.GetLiquidityPositionResponse{Position: toLiquidityPositionMsg(resp)}, nil
+}
+
+// ListLiquidityPositions projects the positions of the tenant's active
+// nostro accounts.
+func (h *Handler) ListLiquidityPositions(ctx context.Context, req *treasuryv1.ListLiquidityPositionsRequest) (*treasuryv1.ListLiquidityPositionsResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listPositions.Execute(ctx, dto.ListLiquidityPositionsRequest{
+		TenantID:    tenantID,
+		Currency:    req.Currency,
+		HorizonDays: int(req.HorizonDays),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &treasuryv1.ListLiquidityPositionsResponse{
+		Positions: make([]*treasuryv1.LiquidityPosition, 0, len(resp)),
+	}
+	for _, p := range resp {
+		out.Positions = append(out.Positions, toLiquidityPositionMsg(p))
+	}
+	return out, nil
+}
+
+// CreateForecast forecasts a movement on a nostro account.
+func (h *Handler) CreateForecast(ctx context.Context, req *treasuryv1.CreateForecastRequest) (*treasuryv1.CreateForecastResponse, error) {
+	if err := requireRole(ctx, treasuryRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	nostroID, err := uuid.Parse(req.NostroId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid nostro account ID")
+	}
+	valueDate, err := time.Parse(time.DateOnly, req.ValueDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid value date: expected YYYY-MM-DD")
+	}
+	amount, err := parseAmount(req.Amount, "amount")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.createForecast.Execute(ctx, dto.CreateForecastRequest{
+		TenantID:    tenantID,
+		NostroID:    nostroID,
+		ValueDate:   valueDate,
+		Direction:   enumName(req.Direction.String(), "FORECAST_DIRECTION_"),
+		Amount:      amount,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &treasuryv1.CreateForecastResponse{Forecast: toForecastMsg(resp)}, nil
+}
+
+// CancelForecast stops a forecast counting towards projections.
+func (h *Handler) CancelForecast(ctx context.Context, req *treasuryv1.CancelForecastRequest) (*treasuryv1.CancelForecastResponse, error) {
+	if err := requireRole(ctx, treasuryRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	forecastID, err := uuid.Parse(req.ForecastId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid forecast ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.cancelForecast.Execute(ctx, tenantID, forecastID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &treasuryv1.CancelForecastResponse{Forecast: toForecastMsg(resp)}, nil
+}
+
+// ListForecasts lists the tenant's forecasts by value date.
+func (h *Handler) ListForecasts(ctx context.Context, req *treasuryv1.ListForecastsRequest) (*treasuryv1.ListForecastsResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	nostroID, err := optionalUUID(req.NostroId, "nostro account ID")
+	if err != nil {
+		return nil, err
+	}
+	from, err := optionalDate(req.FromDate, "from date")
+	if err != nil {
+		return nil, err
+	}
+	to, err := optionalDate(req.ToDate, "to date")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listForecasts.Execute(ctx, dto.ListForecastsRequest{
+		TenantID:         tenantID,
+		NostroID:         nostroID,
+		From:             from,
+		To:               to,
+		IncludeCancelled: req.IncludeCancelled,
+		Limit:            int(req.PageSize),
+		Offset:           int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &treasuryv1.ListForecastsResponse{
+		Forecasts:  make([]*treasuryv1.FundingForecast, 0, len(resp.Forecasts)),
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, f := range resp.Forecasts {
+		out.Forecasts = append(out.Forecasts, toForecastMsg(f))
+	}
+	return out, nil
+}
+
+// CreateSweepInstruction creates a sweep between two nostro accounts.
+func (h *Handler) CreateSweepInstruction(ctx context.Context, req *treasuryv1.CreateSweepInstructionRequest) (*treasuryv1.CreateSweepInstructionResponse, error) {
+	if err := requireRole(ctx, treasuryRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	sourceID, err := uuid.Parse(req.SourceNostroId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid source nostro account ID")
+	}
+	targetID, err := uuid.Parse(req.TargetNostroId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid target nostro account ID")
+	}
+	trigger, err := parseAmount(req.TriggerBalance, "trigger balance")
+	if err != nil {
+		return nil, err
+	}
+	target, err := parseAmount(req.TargetBalance, "target balance")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.createSweep.Execute(ctx, dto.CreateSweepRequest{
+		TenantID:       tenantID,
+		SourceNostroID: sourceID,
+		TargetNostroID: targetID,
+		Type:           enumName(req.Type.String(), "SWEEP_TYPE_"),
+		TriggerBalance: trigger,
+		TargetBalance:  target,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &treasuryv1.CreateSweepInstructionResponse{Sweep: toSweepMsg(resp)}, nil
+}
+
+// UpdateSweepInstruction changes a sweep's balances, or pauses or resumes
+// it.
+func (h *Handler) UpdateSweepInstruction(ctx context.Context, req *treasuryv1.UpdateSweepInstructionRequest) (*treasuryv1.UpdateSweepInstructionResponse, error) {
+	if err := requireRole(ctx, treasuryRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	sweepID, err := uuid.Parse(req.SweepId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid sweep ID")
+	}
+	trigger, err := parseAmount(req.TriggerBalance, "trigger balance")
+	if err != nil {
+		return nil, err
+	}
+	target, err := parseAmount(req.TargetBalance, "target balance")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.updateSweep.Execute(ctx, dto.UpdateSweepRequest{
+		TenantID:       tenantID,
+		SweepID:        sweepID,
+		TriggerBalance: trigger,
+		TargetBalance:  target,
+		Active:         req.Active,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &treasuryv1.UpdateSweepInstructionResponse{Sweep: toSweepMsg(resp)}, nil
+}
+
+// ListSweepInstructions lists the tenant's sweeps, oldest first.
+func (h *Handler) ListSweepInstructions(ctx context.Context, req *treasuryv1.ListSweepInstructionsRequest) (*treasuryv1.ListSweepInstructionsResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	nostroID, err := optionalUUID(req.NostroId, "nostro account ID")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listSweeps.Execute(ctx, dto.ListSweepsRequest{
+		TenantID: tenantID,
+		NostroID: nostroID,
+		Limit:    int(req.PageSize),
+		Offset:   int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &treasuryv1.ListSweepInstructionsResponse{
+		Sweeps:     make([]*treasuryv1.SweepInstruction, 0, len(resp.Sweeps)),
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, s := range resp.Sweeps {
+		out.Sweeps = append(out.Sweeps, toSweepMsg(s))
+	}
+	return out, nil
+}
+
+// ListAlerts lists the tenant's liquidity alerts, newest first.
+func (h *Handler) ListAlerts(ctx context.Context, req *treasuryv1.ListAlertsRequest) (*treasuryv1.ListAlertsResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	nostroID, err := optionalUUID(req.NostroId, "nostro account ID")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listAlerts.Execute(ctx, dto.ListAlertsRequest{
+		TenantID: tenantID,
+		NostroID: nostroID,
+		Status:   enumName(req.Status.String(), "ALERT_STATUS_"),
+		Limit:    int(req.PageSize),
+		Offset:   int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &treasuryv1.ListAlertsResponse{
+		Alerts:     make([]*treasuryv1.LiquidityAlert, 0, len(resp.Alerts)),
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, a := range resp.Alerts {
+		out.Alerts = append(out.Alerts, toAlertMsg(a))
+	}
+	return out, nil
+}
+
+// EvaluateLiquidity runs the tenant's due sweeps and reconciles its alerts
+// now rather than at the next scheduled evaluation.
+func (h *Handler) EvaluateLiquidity(ctx context.Context, req *treasuryv1.EvaluateLiquidityRequest) (*treasuryv1.EvaluateLiquidityResponse, error) {
+	if err := requireRole(ctx, treasuryRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.evaluateLiquidity.Execute(ctx, tenantID, time.Now().UTC())
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	h.logger.Info("liquidity evaluated on demand", "tenant_id", tenantID,
+		"sweeps_executed", resp.SweepsExecuted, "alerts_opened", resp.AlertsOpened, "alerts_resolved", resp.AlertsResolved)
+	return &treasuryv1.EvaluateLiquidityResponse{
+		SweepsExecuted: int32(resp.SweepsExecuted), //nolint:gosec // bounded by sweep count
+		AlertsOpened:   int32(resp.AlertsOpened),   //nolint:gosec // bounded by account count
+		AlertsResolved: int32(resp.AlertsResolved), //nolint:gosec // bounded by account count
+	}, nil
+}
+
+func (h *Handler) toStatus(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidNostroAccount), errors.Is(err, usecase.ErrInvalidForecast),
+		errors.Is(err, usecase.ErrInvalidSweep), errors.Is(err, usecase.ErrInvalidAlertFilter),
+		errors.Is(err, usecase.ErrInvalidHorizon):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrNostroInactive):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, port.ErrNostroNotFound):
+		return status.Error(codes.NotFound, "nostro account not found")
+	case errors.Is(err, port.ErrForecastNotFound):
+		return status.Error(codes.NotFound, "funding forecast not found")
+	case errors.Is(err, port.ErrSweepNotFound):
+		return status.Error(codes.NotFound, "sweep instruction not found")
+	case errors.Is(err, port.ErrVersionConflict):
+		return status.Error(codes.Aborted, "modified concurrently, retry")
+	default:
+		h.logger.Error("handler error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func toNostroAccountMsg(n dto.NostroAccountResponse) *treasuryv1.NostroAccount {
+	return &treasuryv1.NostroAccount{
+		NostroId:          n.ID.String(),
+		TenantId:          n.TenantID.String(),
+		Name:              n.Name,
+		CorrespondentBic:  n.CorrespondentBIC,
+		AccountNumber:     n.AccountNumber,
+		Currency:          n.Currency,
+		LedgerAccountCode: n.LedgerAccountCode,
+		Rails:             n.Rails,
+		OpeningBalance:    n.OpeningBalance.String(),
+		MinimumBalance:    n.MinimumBalance.String(),
+		Active:            n.Active,
+		Version:           int32(n.Version), //nolint:gosec // version counter
+		CreatedAt:         timestamppb.New(n.CreatedAt),
+		UpdatedAt:         timestamppb.New(n.UpdatedAt),
+	}
+}
+
+func toLiquidityPositionMsg(p dto.LiquidityPositionResponse) *treasuryv1.LiquidityPosition {
+	msg := &treasuryv1.LiquidityPosition{
+		NostroId:         p.NostroID.String(),
+		Currency:         p.Currency,
+		BookedBalance:    p.BookedBalance.String(),
+		PendingOutflows:  p.PendingOutflows.String(),
+		AvailableBalance: p.AvailableBalance.String(),
+		MinimumBalance:   p.MinimumBalance.String(),
+		Projections:      make([]*treasuryv1.DailyProjection, 0, len(p.Projections)),
+		AsOf:             timestamppb.New(p.AsOf),
+	}
+	for _, d := range p.Projections {
+		msg.Projections = append(msg.Projections, &treasuryv1.DailyProjection{
+			Date:           d.Date.Format(time.DateOnly),
+			Inflows:        d.Inflows.String(),
+			Outflows:       d.Outflows.String(),
+			ClosingBalance: d.ClosingBalance.String(),
+			BelowMinimum:   d.BelowMinimum,
+		})
+	}
+	return msg
+}
+
+func toForecastMsg(f dto.ForecastResponse) *treasuryv1.FundingForecast {
+	msg := &treasuryv1.FundingForecast{
+		ForecastId:  f.ID.String(),
+		TenantId:    f.TenantID.String(),
+		NostroId:    f.NostroID.String(),
+		ValueDate:   f.ValueDate.Format(time.DateOnly),
+		Direction:   treasuryv1.ForecastDirection(treasuryv1.ForecastDirection_value["FORECAST_DIRECTION_"+f.Direction]),
+		Amount:      f.Amount.String(),
+		Description: f.Description,
+		Status:      treasuryv1.ForecastStatus(treasuryv1.ForecastStatus_value["FORECAST_STATUS_"+f.Status]),
+		Version:     int32(f.Version), //nolint:gosec // version counter
+		CreatedAt:   timestamppb.New(f.CreatedAt),
+		UpdatedAt:   timestamppb.New(f.UpdatedAt),
+	}
+	if f.SweepID != uuid.Nil {
+		msg.SweepId = f.SweepID.String()
+	}
+	return msg
+}
+
+func toSweepMsg(s dto.SweepResponse) *treasuryv1.SweepInstruction {
+	msg := &treasuryv1.SweepInstruction{
+		SweepId:        s.ID.String(),
+		TenantId:       s.TenantID.String(),
+		SourceNostroId: s.SourceNostroID.String(),
+		TargetNostroId: s.TargetNostroID.String(),
+		Type:           treasuryv1.SweepType(treasuryv1.SweepType_value["SWEEP_TYPE_"+s.Type]),
+		TriggerBalance: s.TriggerBalance.String(),
+		TargetBalance:  s.TargetBalance.String(),
+		Active:         s.Active,
+		Version:        int32(s.Version), //nolint:gosec // version counter
+		CreatedAt:      timestamppb.New(s.CreatedAt),
+		UpdatedAt:      timestamppb.New(s.UpdatedAt),
+	}
+	if s.LastExecutedOn != nil {
+		msg.LastExecutedOn = s.LastExecutedOn.Format(time.DateOnly)
+	}
+	return msg
+}
+
+func toAlertMsg(a dto.AlertResponse) *treasuryv1.LiquidityAlert {
+	msg := &treasuryv1.LiquidityAlert{
+		AlertId:          a.ID.String(),
+		TenantId:         a.TenantID.String(),
+		NostroId:         a.NostroID.String(),
+		Status:           treasuryv1.AlertStatus(treasuryv1.AlertStatus_value["ALERT_STATUS_"+a.Status]),
+		BreachDate:       a.BreachDate.Format(time.DateOnly),
+		ProjectedBalance: a.ProjectedBalance.String(),
+		MinimumBalance:   a.MinimumBalance.String(),
+		OpenedAt:         timestamppb.New(a.OpenedAt),
+		Version:          int32(a.Version), //nolint:gosec // version counter
+	}
+	if a.ResolvedAt != nil {
+		msg.ResolvedAt = timestamppb.New(*a.ResolvedAt)
+	}
+	return msg
+}
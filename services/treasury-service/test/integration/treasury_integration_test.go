@@ -0,0 +1,129 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/model"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/port"
+	"github.com/bibbank/bib/services/treasury-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/treasury-service/internal/infrastructure/postgres"
+)
+
+func newNostro(t *testing.T, tenantID uuid.UUID, name, currency string, now time.Time) model.NostroAccount {
+	t.Helper()
+	bic, err := valueobject.NewBIC("deutdeff")
+	require.NoError(t, err)
+	n, err := model.NewNostroAccount(tenantID, name, bic, "DE89370400440532013000", currency,
+		"1100-"+currency, []string{"sepa"}, decimal.NewFromInt(1_000_000), decimal.NewFromInt(250_000), now)
+	require.NoError(t, err)
+	return n
+}
+
+func TestNostroRepo_SaveRoundTripsAndLocksOnVersion(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewNostroRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	nostro := newNostro(t, tenantID, "EUR at Deutsche", "EUR", now)
+	require.NoError(t, repo.Save(ctx, nostro))
+
+	updated, err := nostro.Update("EUR at Deutsche", []string{"sepa", "target2"}, decimal.NewFromInt(500_000), true, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, updated))
+
+	// Saving the stale copy again conflicts on the version.
+	assert.ErrorIs(t, repo.Save(ctx, updated), port.ErrVersionConflict)
+
+	found, err := repo.FindByID(ctx, tenantID, nostro.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "DEUTDEFF", found.CorrespondentBIC().String())
+	assert.Equal(t, []string{"SEPA", "TARGET2"}, found.Rails())
+	assert.True(t, found.OpeningBalance().Equal(decimal.NewFromInt(1_000_000)))
+	assert.True(t, found.MinimumBalance().Equal(decimal.NewFromInt(500_000)))
+	assert.Equal(t, 2, found.Version())
+
+	_, err = repo.FindByID(ctx, uuid.New(), nostro.ID())
+	assert.ErrorIs(t, err, port.ErrNostroNotFound)
+}
+
+func TestNostroRepo_ListFiltersByCurrencyWithinTenant(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewNostroRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, repo.Save(ctx, newNostro(t, tenantID, "EUR at Deutsche", "EUR", now)))
+	require.NoError(t, repo.Save(ctx, newNostro(t, tenantID, "USD at Deutsche", "USD", now)))
+	require.NoError(t, repo.Save(ctx, newNostro(t, uuid.New(), "EUR at Deutsche", "EUR", now)))
+
+	nostros, total, err := repo.List(ctx, tenantID, "EUR", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, nostros, 1)
+	assert.Equal(t, "EUR", nostros[0].Currency())
+
+	all, err := repo.ListAll(ctx, tenantID)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	tenants, err := repo.ListTenants(ctx)
+	require.NoError(t, err)
+	assert.Len(t, tenants, 2)
+}
+
+func TestAlertRepo_OneOpenAlertPerNostro(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	nostros := postgres.NewNostroRepo(env.Pool())
+	repo := postgres.NewAlertRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	nostro := newNostro(t, tenantID, "EUR at Deutsche", "EUR", now)
+	require.NoError(t, nostros.Save(ctx, nostro))
+
+	_, err := repo.FindOpen(ctx, tenantID, nostro.ID())
+	assert.ErrorIs(t, err, port.ErrAlertNotFound)
+
+	breach := now.AddDate(0, 0, 3)
+	alert, err := model.OpenLiquidityAlert(tenantID, nostro.ID(), "EUR", breach,
+		decimal.NewFromInt(100_000), decimal.NewFromInt(250_000), now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, alert))
+
+	second, err := model.OpenLiquidityAlert(tenantID, nostro.ID(), "EUR", breach,
+		decimal.NewFromInt(90_000), decimal.NewFromInt(250_000), now)
+	require.NoError(t, err)
+	assert.Error(t, repo.Save(ctx, second))
+
+	found, err := repo.FindOpen(ctx, tenantID, nostro.ID())
+	require.NoError(t, err)
+	assert.Equal(t, alert.ID(), found.ID())
+	assert.True(t, found.ProjectedBalance().Equal(decimal.NewFromInt(100_000)))
+
+	resolved, err := found.Resolve(now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, resolved))
+
+	_, err = repo.FindOpen(ctx, tenantID, nostro.ID())
+	assert.ErrorIs(t, err, port.ErrAlertNotFound)
+
+	alerts, total, err := repo.List(ctx, port.AlertFilter{TenantID: tenantID, Status: valueobject.AlertStatusResolved}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, alerts, 1)
+	assert.NotNil(t, alerts[0].ResolvedAt())
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/bibbank/bib/pkg/auth"
+	pkgkafka "github.com/bibbank/bib/pkg/kafka"
+	"github.com/bibbank/bib/pkg/lifecycle"
+	"github.com/bibbank/bib/pkg/observability"
+	"github.com/bibbank/bib/pkg/outbox"
+	pkgpostgres "github.com/bibbank/bib/pkg/postgres"
+	"github.com/bibbank/bib/services/webhooks-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/webhooks-service/internal/infrastructure/config"
+	"github.com/bibbank/bib/services/webhooks-service/internal/infrastructure/delivery"
+	"github.com/bibbank/bib/services/webhooks-service/internal/infrastructure/postgres"
+	grpcpresentation "github.com/bibbank/bib/services/webhooks-service/internal/presentation/grpc"
+	"github.com/bibbank/bib/services/webhooks-service/internal/presentation/rest"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// Load configuration.
+	cfg := config.Load()
+
+	// Initialize structured logger via shared observability package.
+	logger := observability.InitLogger(observability.LogConfig{
+		Level:  "info",
+		Format: "json",
+	})
+	slog.SetDefault(logger)
+	lc := lifecycle.New(logger, lifecycle.DefaultTimeout)
+
+	logger.Info("starting webhooks-service",
+		"http_port", cfg.HTTPPort,
+		"grpc_port", cfg.GRPCPort,
+	)
+
+	// Initialize tracing.
+	shutdown, err := observability.InitTracer(ctx, observability.TracingConfig{
+		ServiceName: cfg.ServiceName,
+		Endpoint:    getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4317"),
+		Insecure:    true,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize tracer, continuing without tracing", "error", err)
+	} else {
+		lc.OnStop(lifecycle.PhaseResources, "tracer", shutdown)
+	}
+
+	// Database connection.
+	dbCtx, dbCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer dbCancel()
+
+	pool, err := pkgpostgres.NewPool(dbCtx, pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	})
+	if err != nil {
+		logger.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	lc.OnStop(lifecycle.PhaseResources, "database pool", lifecycle.Func(pool.Close))
+	logger.Info("connected to database")
+
+	// Run database migrations.
+	migDSN := pkgpostgres.Config{
+		Host:     cfg.DB.Host,
+		Port:     cfg.DB.Port,
+		User:     cfg.DB.User,
+		Password: cfg.DB.Password,
+		Database: cfg.DB.Name,
+		SSLMode:  cfg.DB.SSLMode,
+	}.DSN()
+	if migErr := pkgpostgres.RunMigrations(migDSN, "file://internal/infrastructure/postgres/migrations"); migErr != nil {
+		logger.Warn("migration warning", "error", migErr)
+	}
+
+	// Wire infrastructure adapters.
+	subscriptionRepo := postgres.NewSubscriptionRepo(pool)
+	deliveryRepo := postgres.NewDeliveryRepo(pool)
+	sender := delivery.NewHTTPSender(cfg.Dispatch.RequestTimeout)
+	kafkaProducer := pkgkafka.NewProducer(pkgkafka.Config{
+		Brokers: cfg.Kafka.Brokers,
+	})
+	lc.OnStop(lifecycle.PhaseProducers, "kafka producer", lifecycle.Close(kafkaProducer.Close))
+
+	// Outbox relay: events are written to the outbox and published from it,
+	// so none are lost while Kafka is unavailable.
+	outboxStore := outbox.NewStore(pool)
+	relayCfg := outbox.RelayConfig{
+		Route:     outbox.StaticRoute("webhook-events"),
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: cfg.Outbox.BatchSize,
+	}
+	meterProvider, metricsHandler, err := observability.InitMetrics(observability.MetricsConfig{
+		ServiceName: cfg.ServiceName,
+	})
+	if err != nil {
+		logger.Warn("failed to initialize metrics, continuing without metrics", "error", err)
+	} else {
+		relayCfg.MeterProvider = meterProvider
+	}
+	relay, err := outbox.NewRelay(outboxStore, kafkaProducer, relayCfg, logger)
+	if err != nil {
+		logger.Error("failed to create outbox relay", "error", err)
+		os.Exit(1)
+	}
+	lc.Go(lifecycle.PhaseWorkers, "outbox relay", relay.Run)
+	lc.OnStop(lifecycle.PhaseOutbox, "outbox", relay.Flush)
+	eventPublisher := postgres.NewOutboxPublisher(outbox.NewPublisher(outboxStore), "webhook-events")
+
+	// JWT service for gRPC auth (validation-only: public key preferred, secret as fallback).
+	jwtCfg := auth.JWTConfig{
+		Issuer: "bib-gateway",
+	}
+	switch {
+	case os.Getenv("JWT_JWKS_URL") != "":
+		jwtCfg.JWKSURL = os.Getenv("JWT_JWKS_URL")
+	case os.Getenv("JWT_PUBLIC_KEY") != "":
+		jwtCfg.PublicKeyPEM = os.Getenv("JWT_PUBLIC_KEY")
+	case os.Getenv("JWT_PUBLIC_KEY_FILE") != "":
+		keyData, loadErr := auth.LoadKeyFromFile(os.Getenv("JWT_PUBLIC_KEY_FILE"))
+		if loadErr != nil {
+			logger.Error("failed to load JWT public key file", "error", loadErr)
+			os.Exit(1)
+		}
+		jwtCfg.PublicKeyPEM = string(keyData)
+	default:
+		jwtSecret := os.Getenv("JWT_SECRET")
+		if jwtSecret == "" {
+			jwtSecret = "test-e2e-secret" // Match gateway default for E2E tests
+		}
+		jwtCfg.Secret = jwtSecret
+	}
+	jwtSvc, err := auth.NewJWTService(jwtCfg)
+	if err != nil {
+		logger.Error("failed to initialize JWT service", "error", err)
+		os.Exit(1)
+	}
+
+	if cfg.Dispatch.AllowHTTP {
+		logger.Warn("WEBHOOK_ALLOW_HTTP set, subscriptions may use plain HTTP endpoints")
+	}
+
+	// Wire use cases.
+	createSubscriptionUC := usecase.NewCreateSubscriptionUseCase(subscriptionRepo, eventPublisher, cfg.Dispatch.AllowHTTP)
+	updateSubscriptionUC := usecase.NewUpdateSubscriptionUseCase(subscriptionRepo, cfg.Dispatch.AllowHTTP)
+	deleteSubscriptionUC := usecase.NewDeleteSubscriptionUseCase(subscriptionRepo)
+	getSubscriptionUC := usecase.NewGetSubscriptionUseCase(subscriptionRepo)
+	listSubscriptionsUC := usecase.NewListSubscriptionsUseCase(subscriptionRepo)
+	rotateSecretUC := usecase.NewRotateSecretUseCase(subscriptionRepo, eventPublisher)
+	getDeliveryUC := usecase.NewGetDeliveryUseCase(deliveryRepo)
+	listDeliveriesUC := usecase.NewListDeliveriesUseCase(deliveryRepo)
+	redeliverUC := usecase.NewRedeliverUseCase(deliveryRepo)
+	handleEventUC := usecase.NewHandleEventUseCase(subscriptionRepo, deliveryRepo, logger)
+	dispatchUC := usecase.NewDispatchDeliveriesUseCase(subscriptionRepo, deliveryRepo, sender, eventPublisher,
+		usecase.RetryPolicy{
+			MaxAttempts: cfg.Dispatch.MaxAttempts,
+			BaseBackoff: cfg.Dispatch.BaseBackoff,
+			MaxBackoff:  cfg.Dispatch.MaxBackoff,
+		},
+		cfg.Dispatch.Lease,
+		cfg.Dispatch.BatchSize,
+	)
+
+	// Consume every service's domain events into the delivery queue.
+	topics := cfg.Kafka.Topics
+	if len(topics) == 0 {
+		topics = usecase.DefaultTopics
+	}
+	for _, topic := range topics {
+		consumer := pkgkafka.NewConsumer(pkgkafka.Config{
+			Brokers:       cfg.Kafka.Brokers,
+			ConsumerGroup: cfg.Kafka.ConsumerGroup,
+		}, topic, func(ctx context.Context, msg pkgkafka.Message) error {
+			_, handleErr := handleEventUC.Execute(ctx, msg.Headers["event_type"], msg.Value)
+			return handleErr
+		}, logger)
+		lc.Go(lifecycle.PhaseConsumers, topic+" consumer", consumer.Start)
+		lc.OnStop(lifecycle.PhaseResources, topic+" consumer", lifecycle.Close(consumer.Close))
+	}
+
+	// Send due deliveries, draining full batches before waiting for the next
+	// tick. Claims are leased, so every replica may dispatch.
+	lc.Go(lifecycle.PhaseWorkers, "webhook dispatch", func(ctx context.Context) error {
+		ticker := time.NewTicker(cfg.Dispatch.PollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				for ctx.Err() == nil {
+					n, dispatchErr := dispatchUC.Execute(ctx)
+					if dispatchErr != nil {
+						logger.Error("webhook dispatch failed", "error", dispatchErr)
+					}
+					if n < cfg.Dispatch.BatchSize || dispatchErr != nil {
+						break
+					}
+				}
+			}
+		}
+	})
+
+	// gRPC server.
+	grpcHandler := grpcpresentation.NewHandler(
+		createSubscriptionUC, updateSubscriptionUC, deleteSubscriptionUC, getSubscriptionUC, listSubscriptionsUC,
+		rotateSecretUC, getDeliveryUC, listDeliveriesUC, redeliverUC, logger,
+	)
+	grpcServer, err := grpcpresentation.NewServer(grpcHandler, logger, jwtSvc)
+	if err != nil {
+		logger.Error("failed to create gRPC server", "error", err)
+		os.Exit(1)
+	}
+	outbox.RegisterAdmin(grpcServer, outbox.NewAdminServer(outboxStore))
+
+	// HTTP server (health checks and metrics).
+	healthHandler := rest.NewHealthHandler(logger)
+	httpMux := http.NewServeMux()
+	healthHandler.RegisterRoutes(httpMux)
+	if metricsHandler != nil {
+		httpMux.Handle("/metrics", metricsHandler)
+	}
+
+	httpServer := &http.Server{
+		Addr:              cfg.HTTPAddr(),
+		Handler:           httpMux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Start servers.
+	lc.Go(lifecycle.PhaseIngress, "gRPC server", func(context.Context) error {
+		return grpcServer.Start(cfg.GRPCAddr())
+	})
+	lc.OnStop(lifecycle.PhaseIngress, "gRPC server", lifecycle.Func(grpcServer.Stop))
+	lc.ServeHTTP("HTTP server", httpServer)
+
+	logger.Info("webhooks-service is running",
+		"grpc_addr", cfg.GRPCAddr(),
+		"http_addr", cfg.HTTPAddr(),
+	)
+
+	// Wait for a shutdown signal or a component failure, then shut down
+	// gracefully.
+	if err := lc.Wait(ctx); err != nil {
+		os.Exit(1)
+	}
+	logger.Info("webhooks-service stopped")
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
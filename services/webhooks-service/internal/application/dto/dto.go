@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateSubscriptionRequest is the input DTO for subscribing an endpoint to
+// a tenant's events.
+type CreateSubscriptionRequest struct {
+	URL         string    `json:"url"`
+	Description string    `json:"description"`
+	EventTypes  []string  `json:"event_types"`
+	TenantID    uuid.UUID `json:"tenant_id"`
+}
+
+// UpdateSubscriptionRequest is the input DTO for changing a subscription.
+type UpdateSubscriptionRequest struct {
+	URL            string    `json:"url"`
+	Description    string    `json:"description"`
+	EventTypes     []string  `json:"event_types"`
+	TenantID       uuid.UUID `json:"tenant_id"`
+	SubscriptionID uuid.UUID `json:"subscription_id"`
+	Active         bool      `json:"active"`
+}
+
+// SubscriptionResponse is the output DTO for a subscription. It never
+// carries the signing secret.
+type SubscriptionResponse struct {
+	CreatedAt               time.Time  `json:"created_at"`
+	UpdatedAt               time.Time  `json:"updated_at"`
+	PreviousSecretExpiresAt *time.Time `json:"previous_secret_expires_at,omitempty"`
+	URL                     string     `json:"url"`
+	Description             string     `json:"description"`
+	EventTypes              []string   `json:"event_types"`
+	Version                 int        `json:"version"`
+	ID                      uuid.UUID  `json:"id"`
+	TenantID                uuid.UUID  `json:"tenant_id"`
+	Active                  bool       `json:"active"`
+}
+
+// SubscriptionSecretResponse is the output DTO for a subscription whose
+// signing secret was just created or rotated; the secret is shown only
+// then.
+type SubscriptionSecretResponse struct {
+	Secret       string               `json:"secret"`
+	Subscription SubscriptionResponse `json:"subscription"`
+}
+
+// ListSubscriptionsRequest is the input DTO for listing a tenant's
+// subscriptions.
+type ListSubscriptionsRequest struct {
+	Limit    int       `json:"limit"`
+	Offset   int       `json:"offset"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+// ListSubscriptionsResponse is the output DTO for listing subscriptions.
+type ListSubscriptionsResponse struct {
+	Subscriptions []SubscriptionResponse `json:"subscriptions"`
+	TotalCount    int                    `json:"total_count"`
+}
+
+// RotateSecretRequest is the input DTO for rotating a subscription's
+// signing secret.
+type RotateSecretRequest struct {
+	GracePeriod    time.Duration `json:"grace_period"`
+	TenantID       uuid.UUID     `json:"tenant_id"`
+	SubscriptionID uuid.UUID     `json:"subscription_id"`
+}
+
+// AttemptResponse is the output DTO for one attempt of a delivery.
+type AttemptResponse struct {
+	AttemptedAt time.Time     `json:"attempted_at"`
+	Error       string        `json:"error,omitempty"`
+	Duration    time.Duration `json:"duration"`
+	Number      int           `json:"number"`
+	StatusCode  int           `json:"status_code"`
+}
+
+// DeliveryResponse is the output DTO for a delivery and its attempt log.
+type DeliveryResponse struct {
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+	NextAttemptAt  time.Time         `json:"next_attempt_at"`
+	DeliveredAt    *time.Time        `json:"delivered_at,omitempty"`
+	EventID        string            `json:"event_id"`
+	EventType      string            `json:"event_type"`
+	Status         string            `json:"status"`
+	LastError      string            `json:"last_error,omitempty"`
+	Payload        json.RawMessage   `json:"payload"`
+	AttemptLog     []AttemptResponse `json:"attempt_log"`
+	Attempts       int               `json:"attempts"`
+	LastStatusCode int               `json:"last_status_code"`
+	Version        int               `json:"version"`
+	ID             uuid.UUID         `json:"id"`
+	TenantID       uuid.UUID         `json:"tenant_id"`
+	SubscriptionID uuid.UUID         `json:"subscription_id"`
+}
+
+// ListDeliveriesRequest is the input DTO for listing a tenant's deliveries.
+// Empty filters match every delivery.
+type ListDeliveriesRequest struct {
+	Status         string    `json:"status"`
+	EventType      string    `json:"event_type"`
+	Limit          int       `json:"limit"`
+	Offset         int       `json:"offset"`
+	TenantID       uuid.UUID `json:"tenant_id"`
+	SubscriptionID uuid.UUID `json:"subscription_id"`
+}
+
+// ListDeliveriesResponse is the output DTO for listing deliveries.
+type ListDeliveriesResponse struct {
+	Deliveries []DeliveryResponse `json:"deliveries"`
+	TotalCount int                `json:"total_count"`
+}
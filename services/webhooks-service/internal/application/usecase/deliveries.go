@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/webhooks-service/internal/application/dto"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/model"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/port"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/valueobject"
+)
+
+// ErrInvalidDeliveryFilter is returned when a delivery listing names an
+// unknown status.
+var ErrInvalidDeliveryFilter = errors.New("invalid delivery filter")
+
+// ErrDeliveryPending is returned when redelivering a delivery that has not
+// finished.
+var ErrDeliveryPending = errors.New("delivery is still pending")
+
+// GetDeliveryUseCase retrieves a delivery and its attempt log.
+type GetDeliveryUseCase struct {
+	deliveries port.DeliveryRepository
+}
+
+// NewGetDeliveryUseCase creates a new GetDeliveryUseCase.
+func NewGetDeliveryUseCase(deliveries port.DeliveryRepository) *GetDeliveryUseCase {
+	return &GetDeliveryUseCase{deliveries: deliveries}
+}
+
+// Execute retrieves the delivery.
+func (uc *GetDeliveryUseCase) Execute(ctx context.Context, tenantID, deliveryID uuid.UUID) (dto.DeliveryResponse, error) {
+	delivery, err := uc.deliveries.FindByID(ctx, tenantID, deliveryID)
+	if err != nil {
+		return dto.DeliveryResponse{}, fmt.Errorf("failed to find delivery: %w", err)
+	}
+	return toDeliveryResponse(delivery), nil
+}
+
+// ListDeliveriesUseCase lists a tenant's deliveries: the delivery log.
+type ListDeliveriesUseCase struct {
+	deliveries port.DeliveryRepository
+}
+
+// NewListDeliveriesUseCase creates a new ListDeliveriesUseCase.
+func NewListDeliveriesUseCase(deliveries port.DeliveryRepository) *ListDeliveriesUseCase {
+	return &ListDeliveriesUseCase{deliveries: deliveries}
+}
+
+// Execute returns a page of the deliveries matching the request's filters,
+// newest first.
+func (uc *ListDeliveriesUseCase) Execute(ctx context.Context, req dto.ListDeliveriesRequest) (dto.ListDeliveriesResponse, error) {
+	filter := port.DeliveryFilter{
+		TenantID:       req.TenantID,
+		SubscriptionID: req.SubscriptionID,
+		EventType:      req.EventType,
+	}
+	if req.Status != "" {
+		status, err := valueobject.NewDeliveryStatus(req.Status)
+		if err != nil {
+			return dto.ListDeliveriesResponse{}, fmt.Errorf("%w: %w", ErrInvalidDeliveryFilter, err)
+		}
+		filter.Status = status
+	}
+
+	limit, offset := page(req.Limit, req.Offset)
+	deliveries, total, err := uc.deliveries.List(ctx, filter, limit, offset)
+	if err != nil {
+		return dto.ListDeliveriesResponse{}, fmt.Errorf("failed to list deliveries: %w", err)
+	}
+
+	resp := dto.ListDeliveriesResponse{
+		Deliveries: make([]dto.DeliveryResponse, 0, len(deliveries)),
+		TotalCount: total,
+	}
+	for _, d := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, toDeliveryResponse(d))
+	}
+	return resp, nil
+}
+
+// RedeliverUseCase queues a finished delivery to be sent again, e.g. after
+// the tenant fixed their endpoint.
+type RedeliverUseCase struct {
+	deliveries port.DeliveryRepository
+}
+
+// NewRedeliverUseCase creates a new RedeliverUseCase.
+func NewRedeliverUseCase(deliveries port.DeliveryRepository) *RedeliverUseCase {
+	return &RedeliverUseCase{deliveries: deliveries}
+}
+
+// Execute queues the delivery for an attempt now.
+func (uc *RedeliverUseCase) Execute(ctx context.Context, tenantID, deliveryID uuid.UUID) (dto.DeliveryResponse, error) {
+	delivery, err := uc.deliveries.FindByID(ctx, tenantID, deliveryID)
+	if err != nil {
+		return dto.DeliveryResponse{}, fmt.Errorf("failed to find delivery: %w", err)
+	}
+	if !delivery.Status().IsTerminal() {
+		return dto.DeliveryResponse{}, ErrDeliveryPending
+	}
+	delivery, err = delivery.Redeliver(time.Now().UTC())
+	if err != nil {
+		return dto.DeliveryResponse{}, err
+	}
+
+	if err := uc.deliveries.Save(ctx, delivery); err != nil {
+		return dto.DeliveryResponse{}, fmt.Errorf("failed to save delivery: %w", err)
+	}
+	return toDeliveryResponse(delivery), nil
+}
+
+func toDeliveryResponse(d model.Delivery) dto.DeliveryResponse {
+	log := make([]dto.AttemptResponse, 0, len(d.AttemptLog()))
+	for _, a := range d.AttemptLog() {
+		log = append(log, dto.AttemptResponse{
+			Number:      a.Number,
+			AttemptedAt: a.AttemptedAt,
+			StatusCode:  a.StatusCode,
+			Error:       a.Error,
+			Duration:    a.Duration,
+		})
+	}
+	return dto.DeliveryResponse{
+		ID:             d.ID(),
+		TenantID:       d.TenantID(),
+		SubscriptionID: d.SubscriptionID(),
+		EventID:        d.EventID(),
+		EventType:      d.EventType(),
+		Status:         d.Status().String(),
+		Attempts:       d.Attempts(),
+		NextAttemptAt:  d.NextAttemptAt(),
+		LastStatusCode: d.LastStatusCode(),
+		LastError:      d.LastError(),
+		DeliveredAt:    d.DeliveredAt(),
+		AttemptLog:     log,
+		Payload:        d.Payload(),
+		Version:        d.Version(),
+		CreatedAt:      d.CreatedAt(),
+		UpdatedAt:      d.UpdatedAt(),
+	}
+}
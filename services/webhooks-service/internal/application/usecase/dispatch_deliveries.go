@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/model"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/port"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/service"
+)
+
+// Request headers identifying a delivery to the endpoint, alongside
+// service.SignatureHeader.
+const (
+	EventIDHeader    = "X-Bib-Event-ID"
+	EventTypeHeader  = "X-Bib-Event-Type"
+	DeliveryIDHeader = "X-Bib-Delivery-ID"
+)
+
+// RetryPolicy is how failed deliveries are retried: up to MaxAttempts in
+// all, backing off exponentially from BaseBackoff up to MaxBackoff.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// backoff returns the wait before the attempt after the given number of
+// failed ones.
+func (p RetryPolicy) backoff(failed int) time.Duration {
+	d := p.BaseBackoff
+	for i := 1; i < failed && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, p.MaxBackoff)
+}
+
+// DispatchDeliveriesUseCase sends due deliveries to their subscriptions'
+// endpoints, signed with the subscriptions' secrets. A 2xx answer
+// acknowledges a delivery. Any other answer, or none, is retried with
+// backoff until the policy's attempts run out, except 410 Gone, which gives
+// up at once, as does a paused subscription.
+type DispatchDeliveriesUseCase struct {
+	subscriptions port.SubscriptionRepository
+	deliveries    port.DeliveryRepository
+	sender        port.Sender
+	publisher     port.EventPublisher
+	policy        RetryPolicy
+	lease         time.Duration
+	batchSize     int
+}
+
+// NewDispatchDeliveriesUseCase creates a new DispatchDeliveriesUseCase.
+// lease bounds how long one attempt may take before the delivery is claimed
+// again.
+func NewDispatchDeliveriesUseCase(
+	subscriptions port.SubscriptionRepository,
+	deliveries port.DeliveryRepository,
+	sender port.Sender,
+	publisher port.EventPublisher,
+	policy RetryPolicy,
+	lease time.Duration,
+	batchSize int,
+) *DispatchDeliveriesUseCase {
+	return &DispatchDeliveriesUseCase{
+		subscriptions: subscriptions,
+		deliveries:    deliveries,
+		sender:        sender,
+		publisher:     publisher,
+		policy:        policy,
+		lease:         lease,
+		batchSize:     batchSize,
+	}
+}
+
+// Execute sends one batch of due deliveries and returns how many it
+// attempted. Failures of individual attempts are recorded on the
+// deliveries, not returned.
+func (uc *DispatchDeliveriesUseCase) Execute(ctx context.Context) (int, error) {
+	due, err := uc.deliveries.ClaimDue(ctx, time.Now().UTC(), uc.lease, uc.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim due deliveries: %w", err)
+	}
+
+	var errs []error
+	for _, d := range due {
+		if err := uc.deliver(ctx, d); err != nil {
+			errs = append(errs, fmt.Errorf("delivery %s: %w", d.ID(), err))
+		}
+	}
+	return len(due), errors.Join(errs...)
+}
+
+func (uc *DispatchDeliveriesUseCase) deliver(ctx context.Context, d model.Delivery) error {
+	subscription, err := uc.subscriptions.FindByID(ctx, d.TenantID(), d.SubscriptionID())
+	if errors.Is(err, port.ErrSubscriptionNotFound) {
+		// Deleting a subscription deletes its deliveries.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find subscription: %w", err)
+	}
+
+	start := time.Now().UTC()
+	attempt := model.Attempt{AttemptedAt: start}
+	permanent := false
+	if !subscription.Active() {
+		attempt.Error = "subscription is paused"
+		permanent = true
+	} else {
+		body, err := d.Envelope()
+		if err != nil {
+			return err
+		}
+		attempt.StatusCode, err = uc.sender.Send(ctx, port.WebhookRequest{
+			URL: subscription.URL().String(),
+			Headers: map[string]string{
+				"Content-Type":          "application/json",
+				EventIDHeader:           d.EventID(),
+				EventTypeHeader:         d.EventType(),
+				DeliveryIDHeader:        d.ID().String(),
+				service.SignatureHeader: service.Sign(subscription.SigningSecrets(start), start, body),
+			},
+			Body: body,
+		})
+		attempt.Duration = time.Since(start)
+		switch {
+		case err != nil:
+			attempt.Error = err.Error()
+		case attempt.StatusCode == http.StatusGone:
+			attempt.Error = "endpoint answered 410 Gone"
+			permanent = true
+		case attempt.StatusCode < 200 || attempt.StatusCode > 299:
+			attempt.Error = fmt.Sprintf("endpoint answered %d", attempt.StatusCode)
+		}
+	}
+
+	now := time.Now().UTC()
+	switch {
+	case attempt.Error == "":
+		d, err = d.Succeed(attempt, now)
+	case permanent || d.Attempts()+1 >= uc.policy.MaxAttempts:
+		d, err = d.Fail(attempt, now)
+	default:
+		d, err = d.RetryAt(attempt, now.Add(uc.policy.backoff(d.Attempts()+1)), now)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := uc.deliveries.Save(ctx, d); err != nil {
+		return fmt.Errorf("failed to save delivery: %w", err)
+	}
+	if events := d.DomainEvents(); len(events) > 0 {
+		if err := uc.publisher.Publish(ctx, events); err != nil {
+			return fmt.Errorf("failed to publish events: %w", err)
+		}
+	}
+	return nil
+}
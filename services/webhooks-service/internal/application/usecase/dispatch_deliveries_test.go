@@ -0,0 +1,170 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/webhooks-service/internal/application/dto"
+	"github.com/bibbank/bib/services/webhooks-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/model"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/service"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/valueobject"
+)
+
+var policy = usecase.RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Minute, MaxBackoff: time.Hour}
+
+type dispatchFixture struct {
+	subscriptions *inMemorySubscriptionRepo
+	deliveries    *inMemoryDeliveryRepo
+	sender        *recordingSender
+	publisher     *recordingPublisher
+	subscription  dto.SubscriptionSecretResponse
+	tenantID      uuid.UUID
+}
+
+func newDispatchFixture(t *testing.T, statuses ...int) *dispatchFixture {
+	t.Helper()
+	f := &dispatchFixture{
+		subscriptions: &inMemorySubscriptionRepo{},
+		deliveries:    &inMemoryDeliveryRepo{},
+		sender:        &recordingSender{statuses: statuses},
+		publisher:     &recordingPublisher{},
+		tenantID:      uuid.New(),
+	}
+	f.subscription = createSubscription(t, f.subscriptions, f.tenantID, "*")
+	d, err := model.NewDelivery(f.tenantID, f.subscription.Subscription.ID, "evt-1", "card.issued",
+		[]byte(`{"card_id":"c1"}`), time.Now().UTC().Add(-time.Second))
+	require.NoError(t, err)
+	_, err = f.deliveries.Create(context.Background(), d)
+	require.NoError(t, err)
+	return f
+}
+
+func (f *dispatchFixture) dispatch(t *testing.T) model.Delivery {
+	t.Helper()
+	uc := usecase.NewDispatchDeliveriesUseCase(f.subscriptions, f.deliveries, f.sender, f.publisher, policy, time.Minute, 10)
+	_, err := uc.Execute(context.Background())
+	require.NoError(t, err)
+	return f.deliveries.deliveries[0]
+}
+
+// makeDue moves the delivery's next attempt to now, as if its backoff had
+// passed.
+func (f *dispatchFixture) makeDue() {
+	d := f.deliveries.deliveries[0]
+	f.deliveries.deliveries[0] = model.ReconstructDelivery(
+		d.ID(), d.TenantID(), d.SubscriptionID(), d.EventID(), d.EventType(), d.Payload(), d.Status(),
+		d.Attempts(), time.Now().UTC().Add(-time.Second), d.LastStatusCode(), d.LastError(), d.DeliveredAt(),
+		d.AttemptLog(), d.Version(), d.CreatedAt(), d.UpdatedAt(),
+	)
+}
+
+func TestDispatch_SendsSignedRequest(t *testing.T) {
+	f := newDispatchFixture(t, 204)
+
+	d := f.dispatch(t)
+	assert.True(t, d.Status().Equal(valueobject.DeliveryStatusSucceeded))
+	assert.Equal(t, 204, d.LastStatusCode())
+
+	require.Len(t, f.sender.requests, 1)
+	req := f.sender.requests[0]
+	assert.Equal(t, "https://hooks.example.com/bib", req.URL)
+	assert.Equal(t, "evt-1", req.Headers[usecase.EventIDHeader])
+	assert.Equal(t, "card.issued", req.Headers[usecase.EventTypeHeader])
+	assert.Equal(t, d.ID().String(), req.Headers[usecase.DeliveryIDHeader])
+	assert.True(t, service.Verify(req.Headers[service.SignatureHeader], f.subscription.Secret, req.Body, time.Minute, time.Now()),
+		"the endpoint can verify the payload with its secret")
+	assert.Empty(t, f.publisher.events)
+
+	f.dispatch(t)
+	assert.Len(t, f.sender.requests, 1, "delivered deliveries are not sent again")
+}
+
+func TestDispatch_RetriesWithBackoffThenFails(t *testing.T) {
+	f := newDispatchFixture(t, 500)
+
+	d := f.dispatch(t)
+	assert.True(t, d.Status().Equal(valueobject.DeliveryStatusPending))
+	assert.Equal(t, "endpoint answered 500", d.LastError())
+	assert.WithinDuration(t, time.Now().Add(time.Minute), d.NextAttemptAt(), 5*time.Second)
+
+	f.dispatch(t)
+	assert.Len(t, f.sender.requests, 1, "not due until the backoff passes")
+
+	f.makeDue()
+	d = f.dispatch(t)
+	assert.WithinDuration(t, time.Now().Add(2*time.Minute), d.NextAttemptAt(), 5*time.Second, "backoff doubles")
+
+	f.makeDue()
+	d = f.dispatch(t)
+	assert.True(t, d.Status().Equal(valueobject.DeliveryStatusFailed))
+	assert.Equal(t, 3, d.Attempts())
+	assert.Len(t, d.AttemptLog(), 3)
+	assert.Equal(t, []string{"webhook.delivery.failed"}, f.publisher.eventTypes())
+}
+
+func TestDispatch_GivesUpOnGoneAndPausedEndpoints(t *testing.T) {
+	f := newDispatchFixture(t, 410)
+	d := f.dispatch(t)
+	assert.True(t, d.Status().Equal(valueobject.DeliveryStatusFailed))
+	assert.Equal(t, 1, d.Attempts())
+
+	f = newDispatchFixture(t, 200)
+	s := f.subscriptions.subscriptions[0]
+	paused, err := s.Update(s.URL(), s.EventTypes(), s.Description(), false, time.Now().UTC())
+	require.NoError(t, err)
+	require.NoError(t, f.subscriptions.Save(context.Background(), paused))
+
+	d = f.dispatch(t)
+	assert.True(t, d.Status().Equal(valueobject.DeliveryStatusFailed))
+	assert.Equal(t, "subscription is paused", d.LastError())
+	assert.Empty(t, f.sender.requests)
+}
+
+func TestDispatch_RecordsTransportErrors(t *testing.T) {
+	f := newDispatchFixture(t)
+	f.sender.err = errors.New("webhook request failed: connection refused")
+
+	d := f.dispatch(t)
+	assert.True(t, d.Status().Equal(valueobject.DeliveryStatusPending))
+	assert.Equal(t, 0, d.LastStatusCode())
+	assert.Contains(t, d.LastError(), "connection refused")
+}
+
+func TestRedeliver(t *testing.T) {
+	f := newDispatchFixture(t, 410, 200)
+	d := f.dispatch(t)
+	require.True(t, d.Status().Equal(valueobject.DeliveryStatusFailed))
+
+	redeliver := usecase.NewRedeliverUseCase(f.deliveries)
+	resp, err := redeliver.Execute(context.Background(), f.tenantID, d.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "PENDING", resp.Status)
+
+	_, err = redeliver.Execute(context.Background(), f.tenantID, d.ID())
+	assert.ErrorIs(t, err, usecase.ErrDeliveryPending)
+
+	d = f.dispatch(t)
+	assert.True(t, d.Status().Equal(valueobject.DeliveryStatusSucceeded))
+	assert.Len(t, f.sender.requests, 2)
+
+	log, err := usecase.NewListDeliveriesUseCase(f.deliveries).Execute(context.Background(), dto.ListDeliveriesRequest{
+		TenantID: f.tenantID,
+		Status:   "SUCCEEDED",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, log.TotalCount)
+	assert.Len(t, log.Deliveries[0].AttemptLog, 2)
+
+	_, err = usecase.NewListDeliveriesUseCase(f.deliveries).Execute(context.Background(), dto.ListDeliveriesRequest{
+		TenantID: f.tenantID,
+		Status:   "DONE",
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidDeliveryFilter)
+}
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/model"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/port"
+)
+
+// DefaultTopics lists the domain event topics of every service, consumed
+// unless WEBHOOK_TOPICS overrides them. The service's own topic is left out
+// so failed deliveries are not themselves delivered.
+var DefaultTopics = []string{
+	"account-events",
+	"bib.deposit.events",
+	"bib.deposit.interest",
+	"bib.fx.rates",
+	"bib.fx.revaluation",
+	"bib.identity.verifications",
+	"bib.ledger.entries",
+	"bib.payment.orders",
+	"card-events",
+	"consent-events",
+	"customer-events",
+	"document-events",
+	"fraud-events",
+	"lending-events",
+	"limits-events",
+	"pricing-events",
+	"privacy-events",
+	"reporting.report.generated",
+	"reporting.report.submitted",
+	"reporting.report.approved",
+	"reporting.report.changes_requested",
+	"reporting.report.accepted",
+	"reporting.report.rejected",
+	"reporting.report.job_completed",
+	"statement-events",
+	"treasury-events",
+}
+
+// HandleEventUseCase fans a domain event out to the webhook subscriptions
+// of its tenant whose filters match its type. Deliveries are queued for the
+// dispatcher, once per event and subscription however often the event is
+// redelivered.
+type HandleEventUseCase struct {
+	subscriptions port.SubscriptionRepository
+	deliveries    port.DeliveryRepository
+	logger        *slog.Logger
+}
+
+// NewHandleEventUseCase creates a new HandleEventUseCase.
+func NewHandleEventUseCase(
+	subscriptions port.SubscriptionRepository,
+	deliveries port.DeliveryRepository,
+	logger *slog.Logger,
+) *HandleEventUseCase {
+	return &HandleEventUseCase{
+		subscriptions: subscriptions,
+		deliveries:    deliveries,
+		logger:        logger,
+	}
+}
+
+// Execute handles an event of the given type, falling back to the type the
+// event names when the message carries none. The payload is a CloudEvents
+// envelope or the bare event. It returns the number of deliveries queued.
+func (uc *HandleEventUseCase) Execute(ctx context.Context, eventType string, payload []byte) (int, error) {
+	data, err := events.EventData(payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode event: %w", err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return 0, fmt.Errorf("failed to decode event: %w", err)
+	}
+
+	if eventType == "" {
+		eventType, _ = fields["event_type"].(string)
+	}
+	eventID, _ := fields["event_id"].(string)
+	if eventType == "" || eventID == "" {
+		return 0, fmt.Errorf("event is missing its ID or type")
+	}
+	tenant, _ := fields["tenant_id"].(string)
+	tenantID, err := uuid.Parse(tenant)
+	if err != nil {
+		// Platform events not owned by a tenant have no subscribers.
+		uc.logger.DebugContext(ctx, "skipping event without tenant", "event_type", eventType, "event_id", eventID)
+		return 0, nil
+	}
+
+	subscriptions, err := uc.subscriptions.ListActive(ctx, tenantID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list subscriptions: %w", err)
+	}
+
+	queued := 0
+	now := time.Now().UTC()
+	for _, s := range subscriptions {
+		if !s.Matches(eventType) {
+			continue
+		}
+		d, err := model.NewDelivery(tenantID, s.ID(), eventID, eventType, data, now)
+		if err != nil {
+			return queued, fmt.Errorf("failed to create delivery: %w", err)
+		}
+		created, err := uc.deliveries.Create(ctx, d)
+		if err != nil {
+			return queued, fmt.Errorf("failed to save delivery: %w", err)
+		}
+		if created {
+			queued++
+		}
+	}
+	return queued, nil
+}
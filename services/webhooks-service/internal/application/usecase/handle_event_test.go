@@ -0,0 +1,62 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/webhooks-service/internal/application/usecase"
+)
+
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+func TestHandleEvent_FansOutToMatchingSubscriptions(t *testing.T) {
+	subscriptions := &inMemorySubscriptionRepo{}
+	deliveries := &inMemoryDeliveryRepo{}
+	tenantID := uuid.New()
+	cards := createSubscription(t, subscriptions, tenantID, "card.*")
+	createSubscription(t, subscriptions, tenantID, "payment.*")
+	all := createSubscription(t, subscriptions, tenantID, "*")
+	createSubscription(t, subscriptions, uuid.New(), "*")
+
+	uc := usecase.NewHandleEventUseCase(subscriptions, deliveries, discardLogger)
+
+	issued := events.NewBaseEvent("card.issued", uuid.NewString(), "Card", tenantID.String())
+	envelope, err := events.NewCloudEvent("/bib/card-service", issued)
+	require.NoError(t, err)
+	payload, err := json.Marshal(envelope)
+	require.NoError(t, err)
+
+	queued, err := uc.Execute(context.Background(), "", payload)
+	require.NoError(t, err)
+	assert.Equal(t, 2, queued)
+	require.Len(t, deliveries.deliveries, 2)
+	got := []uuid.UUID{deliveries.deliveries[0].SubscriptionID(), deliveries.deliveries[1].SubscriptionID()}
+	assert.ElementsMatch(t, []uuid.UUID{cards.Subscription.ID, all.Subscription.ID}, got)
+
+	var data map[string]any
+	require.NoError(t, json.Unmarshal(deliveries.deliveries[0].Payload(), &data))
+	assert.Equal(t, "card.issued", data["event_type"], "the delivery carries the event, not its envelope")
+
+	queued, err = uc.Execute(context.Background(), "", payload)
+	require.NoError(t, err)
+	assert.Equal(t, 0, queued, "redelivered events are not delivered twice")
+}
+
+func TestHandleEvent_SkipsEventsWithoutTenant(t *testing.T) {
+	uc := usecase.NewHandleEventUseCase(&inMemorySubscriptionRepo{}, &inMemoryDeliveryRepo{}, discardLogger)
+
+	queued, err := uc.Execute(context.Background(), "fx.rate.updated", []byte(`{"event_id":"e1","event_type":"fx.rate.updated"}`))
+	require.NoError(t, err)
+	assert.Equal(t, 0, queued)
+
+	_, err = uc.Execute(context.Background(), "", []byte(`{"tenant_id":"x"}`))
+	assert.Error(t, err, "events need an ID and type")
+}
@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/webhooks-service/internal/application/dto"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/model"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/port"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/valueobject"
+)
+
+// ErrInvalidSubscription is returned when a subscription request is
+// malformed.
+var ErrInvalidSubscription = errors.New("invalid webhook subscription")
+
+// DefaultSecretGracePeriod is how long a rotated-out secret keeps signing
+// payloads when the caller names no grace period.
+const DefaultSecretGracePeriod = 24 * time.Hour
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// CreateSubscriptionUseCase subscribes an endpoint to a tenant's events.
+type CreateSubscriptionUseCase struct {
+	subscriptions port.SubscriptionRepository
+	publisher     port.EventPublisher
+	allowHTTP     bool
+}
+
+// NewCreateSubscriptionUseCase creates a new CreateSubscriptionUseCase.
+// Plain HTTP endpoints are rejected unless allowHTTP is set.
+func NewCreateSubscriptionUseCase(
+	subscriptions port.SubscriptionRepository,
+	publisher port.EventPublisher,
+	allowHTTP bool,
+) *CreateSubscriptionUseCase {
+	return &CreateSubscriptionUseCase{subscriptions: subscriptions, publisher: publisher, allowHTTP: allowHTTP}
+}
+
+// Execute creates the subscription and returns it with its signing secret.
+func (uc *CreateSubscriptionUseCase) Execute(ctx context.Context, req dto.CreateSubscriptionRequest) (dto.SubscriptionSecretResponse, error) {
+	url, filters, err := parseEndpoint(req.URL, req.EventTypes, uc.allowHTTP)
+	if err != nil {
+		return dto.SubscriptionSecretResponse{}, err
+	}
+	subscription, err := model.NewSubscription(req.TenantID, url, filters, req.Description, time.Now().UTC())
+	if err != nil {
+		return dto.SubscriptionSecretResponse{}, fmt.Errorf("%w: %w", ErrInvalidSubscription, err)
+	}
+
+	if err := uc.subscriptions.Save(ctx, subscription); err != nil {
+		return dto.SubscriptionSecretResponse{}, fmt.Errorf("failed to save subscription: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, subscription.DomainEvents()); err != nil {
+		return dto.SubscriptionSecretResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return dto.SubscriptionSecretResponse{
+		Subscription: toSubscriptionResponse(subscription),
+		Secret:       subscription.Secret(),
+	}, nil
+}
+
+// UpdateSubscriptionUseCase changes a subscription's endpoint, filters and
+// description, or pauses or resumes it.
+type UpdateSubscriptionUseCase struct {
+	subscriptions port.SubscriptionRepository
+	allowHTTP     bool
+}
+
+// NewUpdateSubscriptionUseCase creates a new UpdateSubscriptionUseCase.
+// Plain HTTP endpoints are rejected unless allowHTTP is set.
+func NewUpdateSubscriptionUseCase(subscriptions port.SubscriptionRepository, allowHTTP bool) *UpdateSubscriptionUseCase {
+	return &UpdateSubscriptionUseCase{subscriptions: subscriptions, allowHTTP: allowHTTP}
+}
+
+// Execute updates the subscription.
+func (uc *UpdateSubscriptionUseCase) Execute(ctx context.Context, req dto.UpdateSubscriptionRequest) (dto.SubscriptionResponse, error) {
+	url, filters, err := parseEndpoint(req.URL, req.EventTypes, uc.allowHTTP)
+	if err != nil {
+		return dto.SubscriptionResponse{}, err
+	}
+	subscription, err := uc.subscriptions.FindByID(ctx, req.TenantID, req.SubscriptionID)
+	if err != nil {
+		return dto.SubscriptionResponse{}, fmt.Errorf("failed to find subscription: %w", err)
+	}
+	subscription, err = subscription.Update(url, filters, req.Description, req.Active, time.Now().UTC())
+	if err != nil {
+		return dto.SubscriptionResponse{}, fmt.Errorf("%w: %w", ErrInvalidSubscription, err)
+	}
+
+	if err := uc.subscriptions.Save(ctx, subscription); err != nil {
+		return dto.SubscriptionResponse{}, fmt.Errorf("failed to save subscription: %w", err)
+	}
+	return toSubscriptionResponse(subscription), nil
+}
+
+// DeleteSubscriptionUseCase removes a subscription along with its delivery
+// log.
+type DeleteSubscriptionUseCase struct {
+	subscriptions port.SubscriptionRepository
+}
+
+// NewDeleteSubscriptionUseCase creates a new DeleteSubscriptionUseCase.
+func NewDeleteSubscriptionUseCase(subscriptions port.SubscriptionRepository) *DeleteSubscriptionUseCase {
+	return &DeleteSubscriptionUseCase{subscriptions: subscriptions}
+}
+
+// Execute deletes the subscription.
+func (uc *DeleteSubscriptionUseCase) Execute(ctx context.Context, tenantID, subscriptionID uuid.UUID) error {
+	if err := uc.subscriptions.Delete(ctx, tenantID, subscriptionID); err != nil {
+		return fmt.Errorf("failed to delete subscription: %w", err)
+	}
+	return nil
+}
+
+// GetSubscriptionUseCase retrieves a subscription.
+type GetSubscriptionUseCase struct {
+	subscriptions port.SubscriptionRepository
+}
+
+// NewGetSubscriptionUseCase creates a new GetSubscriptionUseCase.
+func NewGetSubscriptionUseCase(subscriptions port.SubscriptionRepository) *GetSubscriptionUseCase {
+	return &GetSubscriptionUseCase{subscriptions: subscriptions}
+}
+
+// Execute retrieves the subscription.
+func (uc *GetSubscriptionUseCase) Execute(ctx context.Context, tenantID, subscriptionID uuid.UUID) (dto.SubscriptionResponse, error) {
+	subscription, err := uc.subscriptions.FindByID(ctx, tenantID, subscriptionID)
+	if err != nil {
+		return dto.SubscriptionResponse{}, fmt.Errorf("failed to find subscription: %w", err)
+	}
+	return toSubscriptionResponse(subscription), nil
+}
+
+// ListSubscriptionsUseCase lists a tenant's subscriptions.
+type ListSubscriptionsUseCase struct {
+	subscriptions port.SubscriptionRepository
+}
+
+// NewListSubscriptionsUseCase creates a new ListSubscriptionsUseCase.
+func NewListSubscriptionsUseCase(subscriptions port.SubscriptionRepository) *ListSubscriptionsUseCase {
+	return &ListSubscriptionsUseCase{subscriptions: subscriptions}
+}
+
+// Execute returns a page of the tenant's subscriptions, oldest first.
+func (uc *ListSubscriptionsUseCase) Execute(ctx context.Context, req dto.ListSubscriptionsRequest) (dto.ListSubscriptionsResponse, error) {
+	limit, offset := page(req.Limit, req.Offset)
+	subscriptions, total, err := uc.subscriptions.List(ctx, req.TenantID, limit, offset)
+	if err != nil {
+		return dto.ListSubscriptionsResponse{}, fmt.Errorf("failed to list subscriptions: %w", err)
+	}
+
+	resp := dto.ListSubscriptionsResponse{
+		Subscriptions: make([]dto.SubscriptionResponse, 0, len(subscriptions)),
+		TotalCount:    total,
+	}
+	for _, s := range subscriptions {
+		resp.Subscriptions = append(resp.Subscriptions, toSubscriptionResponse(s))
+	}
+	return resp, nil
+}
+
+// RotateSecretUseCase replaces a subscription's signing secret.
+type RotateSecretUseCase struct {
+	subscriptions port.SubscriptionRepository
+	publisher     port.EventPublisher
+}
+
+// NewRotateSecretUseCase creates a new RotateSecretUseCase.
+func NewRotateSecretUseCase(subscriptions port.SubscriptionRepository, publisher port.EventPublisher) *RotateSecretUseCase {
+	return &RotateSecretUseCase{subscriptions: subscriptions, publisher: publisher}
+}
+
+// Execute rotates the secret and returns the subscription with its new
+// secret. The old secret keeps signing payloads for the grace period.
+func (uc *RotateSecretUseCase) Execute(ctx context.Context, req dto.RotateSecretRequest) (dto.SubscriptionSecretResponse, error) {
+	subscription, err := uc.subscriptions.FindByID(ctx, req.TenantID, req.SubscriptionID)
+	if err != nil {
+		return dto.SubscriptionSecretResponse{}, fmt.Errorf("failed to find subscription: %w", err)
+	}
+	subscription, err = subscription.RotateSecret(req.GracePeriod, time.Now().UTC())
+	if err != nil {
+		return dto.SubscriptionSecretResponse{}, fmt.Errorf("%w: %w", ErrInvalidSubscription, err)
+	}
+
+	if err := uc.subscriptions.Save(ctx, subscription); err != nil {
+		return dto.SubscriptionSecretResponse{}, fmt.Errorf("failed to save subscription: %w", err)
+	}
+	if err := uc.publisher.Publish(ctx, subscription.DomainEvents()); err != nil {
+		return dto.SubscriptionSecretResponse{}, fmt.Errorf("failed to publish events: %w", err)
+	}
+	return dto.SubscriptionSecretResponse{
+		Subscription: toSubscriptionResponse(subscription),
+		Secret:       subscription.Secret(),
+	}, nil
+}
+
+func parseEndpoint(rawURL string, eventTypes []string, allowHTTP bool) (valueobject.EndpointURL, []valueobject.EventFilter, error) {
+	url, err := valueobject.NewEndpointURL(rawURL, allowHTTP)
+	if err != nil {
+		return valueobject.EndpointURL{}, nil, fmt.Errorf("%w: %w", ErrInvalidSubscription, err)
+	}
+	filters := make([]valueobject.EventFilter, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		f, err := valueobject.NewEventFilter(t)
+		if err != nil {
+			return valueobject.EndpointURL{}, nil, fmt.Errorf("%w: %w", ErrInvalidSubscription, err)
+		}
+		filters = append(filters, f)
+	}
+	return url, filters, nil
+}
+
+func page(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	return min(limit, maxLimit), max(offset, 0)
+}
+
+func toSubscriptionResponse(s model.Subscription) dto.SubscriptionResponse {
+	return dto.SubscriptionResponse{
+		ID:                      s.ID(),
+		TenantID:                s.TenantID(),
+		URL:                     s.URL().String(),
+		EventTypes:              s.EventTypeStrings(),
+		Description:             s.Description(),
+		Active:                  s.Active(),
+		PreviousSecretExpiresAt: s.PreviousSecretExpiresAt(),
+		Version:                 s.Version(),
+		CreatedAt:               s.CreatedAt(),
+		UpdatedAt:               s.UpdatedAt(),
+	}
+}
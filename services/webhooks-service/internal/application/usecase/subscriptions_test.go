@@ -0,0 +1,111 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/webhooks-service/internal/application/dto"
+	"github.com/bibbank/bib/services/webhooks-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/port"
+)
+
+func createSubscription(t *testing.T, repo *inMemorySubscriptionRepo, tenantID uuid.UUID, eventTypes ...string) dto.SubscriptionSecretResponse {
+	t.Helper()
+	uc := usecase.NewCreateSubscriptionUseCase(repo, &recordingPublisher{}, false)
+	resp, err := uc.Execute(context.Background(), dto.CreateSubscriptionRequest{
+		TenantID:   tenantID,
+		URL:        "https://hooks.example.com/bib",
+		EventTypes: eventTypes,
+	})
+	require.NoError(t, err)
+	return resp
+}
+
+func TestCreateSubscription(t *testing.T) {
+	repo := &inMemorySubscriptionRepo{}
+	publisher := &recordingPublisher{}
+	uc := usecase.NewCreateSubscriptionUseCase(repo, publisher, false)
+
+	resp, err := uc.Execute(context.Background(), dto.CreateSubscriptionRequest{
+		TenantID:    uuid.New(),
+		URL:         "https://hooks.example.com/bib",
+		EventTypes:  []string{"payment.*"},
+		Description: " ERP sync ",
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Secret)
+	assert.Equal(t, "ERP sync", resp.Subscription.Description)
+	assert.Equal(t, []string{"webhook.subscription.created"}, publisher.eventTypes())
+	require.Len(t, repo.subscriptions, 1)
+
+	for name, req := range map[string]dto.CreateSubscriptionRequest{
+		"plain HTTP":     {TenantID: uuid.New(), URL: "http://hooks.example.com", EventTypes: []string{"*"}},
+		"bad filter":     {TenantID: uuid.New(), URL: "https://hooks.example.com", EventTypes: []string{"payment*"}},
+		"no filters":     {TenantID: uuid.New(), URL: "https://hooks.example.com"},
+		"missing tenant": {URL: "https://hooks.example.com", EventTypes: []string{"*"}},
+	} {
+		_, err := uc.Execute(context.Background(), req)
+		assert.True(t, errors.Is(err, usecase.ErrInvalidSubscription), name)
+	}
+}
+
+func TestUpdateAndDeleteSubscription(t *testing.T) {
+	repo := &inMemorySubscriptionRepo{}
+	tenantID := uuid.New()
+	created := createSubscription(t, repo, tenantID, "*")
+
+	updated, err := usecase.NewUpdateSubscriptionUseCase(repo, false).Execute(context.Background(), dto.UpdateSubscriptionRequest{
+		TenantID:       tenantID,
+		SubscriptionID: created.Subscription.ID,
+		URL:            "https://hooks.example.com/v2",
+		EventTypes:     []string{"card.*"},
+		Active:         false,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://hooks.example.com/v2", updated.URL)
+	assert.False(t, updated.Active)
+	assert.Equal(t, 2, updated.Version)
+
+	_, err = usecase.NewUpdateSubscriptionUseCase(repo, false).Execute(context.Background(), dto.UpdateSubscriptionRequest{
+		TenantID:       uuid.New(),
+		SubscriptionID: created.Subscription.ID,
+		URL:            "https://hooks.example.com/v2",
+		EventTypes:     []string{"card.*"},
+	})
+	assert.ErrorIs(t, err, port.ErrSubscriptionNotFound, "other tenants' subscriptions are not found")
+
+	del := usecase.NewDeleteSubscriptionUseCase(repo)
+	require.NoError(t, del.Execute(context.Background(), tenantID, created.Subscription.ID))
+	assert.ErrorIs(t, del.Execute(context.Background(), tenantID, created.Subscription.ID), port.ErrSubscriptionNotFound)
+}
+
+func TestRotateSecret(t *testing.T) {
+	repo := &inMemorySubscriptionRepo{}
+	publisher := &recordingPublisher{}
+	tenantID := uuid.New()
+	created := createSubscription(t, repo, tenantID, "*")
+
+	resp, err := usecase.NewRotateSecretUseCase(repo, publisher).Execute(context.Background(), dto.RotateSecretRequest{
+		TenantID:       tenantID,
+		SubscriptionID: created.Subscription.ID,
+		GracePeriod:    usecase.DefaultSecretGracePeriod,
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, created.Secret, resp.Secret)
+	require.NotNil(t, resp.Subscription.PreviousSecretExpiresAt)
+	assert.WithinDuration(t, time.Now().Add(usecase.DefaultSecretGracePeriod), *resp.Subscription.PreviousSecretExpiresAt, time.Minute)
+	assert.Equal(t, []string{"webhook.secret.rotated"}, publisher.eventTypes())
+
+	_, err = usecase.NewRotateSecretUseCase(repo, publisher).Execute(context.Background(), dto.RotateSecretRequest{
+		TenantID:       tenantID,
+		SubscriptionID: created.Subscription.ID,
+		GracePeriod:    -time.Second,
+	})
+	assert.ErrorIs(t, err, usecase.ErrInvalidSubscription)
+}
@@ -0,0 +1,178 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/event"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/model"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/port"
+)
+
+// inMemorySubscriptionRepo is an in-memory SubscriptionRepository.
+type inMemorySubscriptionRepo struct {
+	subscriptions []model.Subscription
+}
+
+func (r *inMemorySubscriptionRepo) Save(_ context.Context, subscription model.Subscription) error {
+	// Events are not persisted.
+	subscription = subscription.ClearDomainEvents()
+	for i, s := range r.subscriptions {
+		if s.ID() == subscription.ID() {
+			if s.Version() != subscription.Version()-1 {
+				return port.ErrVersionConflict
+			}
+			r.subscriptions[i] = subscription
+			return nil
+		}
+	}
+	r.subscriptions = append(r.subscriptions, subscription)
+	return nil
+}
+
+func (r *inMemorySubscriptionRepo) Delete(_ context.Context, tenantID, id uuid.UUID) error {
+	for i, s := range r.subscriptions {
+		if s.TenantID() == tenantID && s.ID() == id {
+			r.subscriptions = slices.Delete(r.subscriptions, i, i+1)
+			return nil
+		}
+	}
+	return port.ErrSubscriptionNotFound
+}
+
+func (r *inMemorySubscriptionRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.Subscription, error) {
+	for _, s := range r.subscriptions {
+		if s.TenantID() == tenantID && s.ID() == id {
+			return s, nil
+		}
+	}
+	return model.Subscription{}, port.ErrSubscriptionNotFound
+}
+
+func (r *inMemorySubscriptionRepo) List(_ context.Context, tenantID uuid.UUID, limit, offset int) ([]model.Subscription, int, error) {
+	var out []model.Subscription
+	for _, s := range r.subscriptions {
+		if s.TenantID() == tenantID {
+			out = append(out, s)
+		}
+	}
+	total := len(out)
+	out = out[min(offset, total):min(offset+limit, total)]
+	return out, total, nil
+}
+
+func (r *inMemorySubscriptionRepo) ListActive(_ context.Context, tenantID uuid.UUID) ([]model.Subscription, error) {
+	var out []model.Subscription
+	for _, s := range r.subscriptions {
+		if s.TenantID() == tenantID && s.Active() {
+			out = append(out, s)
+		}
+	}
+	return out, nil
+}
+
+// inMemoryDeliveryRepo is an in-memory DeliveryRepository.
+type inMemoryDeliveryRepo struct {
+	deliveries []model.Delivery
+}
+
+func (r *inMemoryDeliveryRepo) Create(_ context.Context, delivery model.Delivery) (bool, error) {
+	for _, d := range r.deliveries {
+		if d.SubscriptionID() == delivery.SubscriptionID() && d.EventID() == delivery.EventID() {
+			return false, nil
+		}
+	}
+	r.deliveries = append(r.deliveries, delivery)
+	return true, nil
+}
+
+func (r *inMemoryDeliveryRepo) Save(_ context.Context, delivery model.Delivery) error {
+	delivery = delivery.ClearDomainEvents()
+	for i, d := range r.deliveries {
+		if d.ID() == delivery.ID() {
+			if d.Version() != delivery.Version()-1 {
+				return port.ErrVersionConflict
+			}
+			r.deliveries[i] = delivery
+			return nil
+		}
+	}
+	return port.ErrDeliveryNotFound
+}
+
+func (r *inMemoryDeliveryRepo) FindByID(_ context.Context, tenantID, id uuid.UUID) (model.Delivery, error) {
+	for _, d := range r.deliveries {
+		if d.TenantID() == tenantID && d.ID() == id {
+			return d, nil
+		}
+	}
+	return model.Delivery{}, port.ErrDeliveryNotFound
+}
+
+func (r *inMemoryDeliveryRepo) List(_ context.Context, filter port.DeliveryFilter, limit, offset int) ([]model.Delivery, int, error) {
+	var out []model.Delivery
+	for _, d := range r.deliveries {
+		if d.TenantID() != filter.TenantID ||
+			filter.SubscriptionID != uuid.Nil && d.SubscriptionID() != filter.SubscriptionID ||
+			!filter.Status.IsZero() && !d.Status().Equal(filter.Status) ||
+			filter.EventType != "" && d.EventType() != filter.EventType {
+			continue
+		}
+		out = append(out, d)
+	}
+	total := len(out)
+	out = out[min(offset, total):min(offset+limit, total)]
+	return out, total, nil
+}
+
+func (r *inMemoryDeliveryRepo) ClaimDue(_ context.Context, now time.Time, _ time.Duration, limit int) ([]model.Delivery, error) {
+	var out []model.Delivery
+	for _, d := range r.deliveries {
+		if !d.Status().IsTerminal() && !d.NextAttemptAt().After(now) {
+			out = append(out, d)
+		}
+	}
+	return out[:min(limit, len(out))], nil
+}
+
+// recordingSender is a Sender that records requests and answers them with
+// the next of its status codes, repeating the last.
+type recordingSender struct {
+	err      error
+	requests []port.WebhookRequest
+	statuses []int
+	mu       sync.Mutex
+}
+
+func (s *recordingSender) Send(_ context.Context, req port.WebhookRequest) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, req)
+	if s.err != nil {
+		return 0, s.err
+	}
+	status := s.statuses[min(len(s.requests), len(s.statuses))-1]
+	return status, nil
+}
+
+// recordingPublisher is an EventPublisher that records what it publishes.
+type recordingPublisher struct {
+	events []event.DomainEvent
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, events []event.DomainEvent) error {
+	p.events = append(p.events, events...)
+	return nil
+}
+
+func (p *recordingPublisher) eventTypes() []string {
+	types := make([]string, len(p.events))
+	for i, e := range p.events {
+		types[i] = e.EventType()
+	}
+	return types
+}
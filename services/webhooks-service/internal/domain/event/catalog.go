@@ -0,0 +1,13 @@
+package event
+
+import "github.com/bibbank/bib/pkg/events"
+
+// Catalog declares the schemas of the events the service publishes. The
+// package's tests check them against the repository's event catalog.
+func Catalog() []events.Schema {
+	return []events.Schema{
+		events.Declare[SubscriptionCreated]("webhook.subscription.created", 1, "Emitted when a tenant subscribes an endpoint to events."),
+		events.Declare[SecretRotated]("webhook.secret.rotated", 1, "Emitted when a subscription's signing secret is replaced."),
+		events.Declare[DeliveryFailed]("webhook.delivery.failed", 1, "Emitted when delivery of an event to a subscription is given up on."),
+	}
+}
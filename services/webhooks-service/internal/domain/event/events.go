@@ -0,0 +1,79 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+)
+
+// DomainEvent is an alias for the shared pkg/events.DomainEvent interface.
+type DomainEvent = events.DomainEvent
+
+// Aggregate types of webhook events.
+const (
+	AggregateTypeSubscription = "WebhookSubscription"
+	AggregateTypeDelivery     = "WebhookDelivery"
+)
+
+// SubscriptionCreated is emitted when a tenant subscribes an endpoint to
+// events.
+type SubscriptionCreated struct {
+	events.BaseEvent
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+}
+
+// NewSubscriptionCreated creates a new SubscriptionCreated event.
+func NewSubscriptionCreated(subscriptionID, tenantID uuid.UUID, url string, eventTypes []string) SubscriptionCreated {
+	return SubscriptionCreated{
+		BaseEvent:  events.NewBaseEvent("webhook.subscription.created", subscriptionID.String(), AggregateTypeSubscription, tenantID.String()),
+		URL:        url,
+		EventTypes: eventTypes,
+	}
+}
+
+// SecretRotated is emitted when a subscription's signing secret is
+// replaced. The old secret keeps signing payloads until PreviousExpiresAt.
+type SecretRotated struct {
+	events.BaseEvent
+	PreviousExpiresAt time.Time `json:"previous_expires_at"`
+}
+
+// NewSecretRotated creates a new SecretRotated event.
+func NewSecretRotated(subscriptionID, tenantID uuid.UUID, previousExpiresAt time.Time) SecretRotated {
+	return SecretRotated{
+		BaseEvent:         events.NewBaseEvent("webhook.secret.rotated", subscriptionID.String(), AggregateTypeSubscription, tenantID.String()),
+		PreviousExpiresAt: previousExpiresAt,
+	}
+}
+
+// DeliveryFailed is emitted when delivery of an event to a subscription is
+// given up on. The source event is the one that was not delivered.
+type DeliveryFailed struct {
+	events.BaseEvent
+	SourceEventID   string    `json:"source_event_id"`
+	SourceEventType string    `json:"source_event_type"`
+	LastError       string    `json:"last_error"`
+	SubscriptionID  uuid.UUID `json:"subscription_id"`
+	LastStatusCode  int       `json:"last_status_code,omitempty"`
+	Attempts        int       `json:"attempts"`
+}
+
+// NewDeliveryFailed creates a new DeliveryFailed event.
+func NewDeliveryFailed(
+	deliveryID, tenantID, subscriptionID uuid.UUID,
+	sourceEventID, sourceEventType, lastError string,
+	lastStatusCode, attempts int,
+) DeliveryFailed {
+	return DeliveryFailed{
+		BaseEvent:       events.NewBaseEvent("webhook.delivery.failed", deliveryID.String(), AggregateTypeDelivery, tenantID.String()),
+		SubscriptionID:  subscriptionID,
+		SourceEventID:   sourceEventID,
+		SourceEventType: sourceEventType,
+		LastError:       lastError,
+		LastStatusCode:  lastStatusCode,
+		Attempts:        attempts,
+	}
+}
@@ -0,0 +1,250 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/event"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/valueobject"
+)
+
+// MaxAttemptLog bounds the attempts a delivery keeps in its log; older ones
+// are dropped.
+const MaxAttemptLog = 50
+
+// Attempt is one HTTP request made for a delivery. StatusCode is 0 when no
+// response was received.
+type Attempt struct {
+	AttemptedAt time.Time
+	Error       string
+	Duration    time.Duration
+	Number      int
+	StatusCode  int
+}
+
+// Delivery is the aggregate root for one event sent to one subscription. A
+// PENDING delivery is attempted at its next attempt time until the
+// endpoint acknowledges it (SUCCEEDED) or delivery is given up on (FAILED).
+// A finished delivery may be redelivered. A subscription gets at most one
+// delivery per event.
+type Delivery struct {
+	createdAt      time.Time
+	updatedAt      time.Time
+	nextAttemptAt  time.Time
+	deliveredAt    *time.Time
+	status         valueobject.DeliveryStatus
+	eventID        string
+	eventType      string
+	lastError      string
+	payload        json.RawMessage
+	attemptLog     []Attempt
+	domainEvents   []events.DomainEvent
+	attempts       int
+	lastStatusCode int
+	version        int
+	id             uuid.UUID
+	tenantID       uuid.UUID
+	subscriptionID uuid.UUID
+}
+
+// NewDelivery creates a PENDING delivery of an event, due now. The payload
+// is the event as JSON.
+func NewDelivery(
+	tenantID, subscriptionID uuid.UUID,
+	eventID, eventType string,
+	payload json.RawMessage,
+	now time.Time,
+) (Delivery, error) {
+	if tenantID == uuid.Nil {
+		return Delivery{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	if subscriptionID == uuid.Nil {
+		return Delivery{}, fmt.Errorf("subscription ID must not be empty")
+	}
+	if eventID == "" || eventType == "" {
+		return Delivery{}, fmt.Errorf("event ID and type must not be empty")
+	}
+	if !json.Valid(payload) {
+		return Delivery{}, fmt.Errorf("payload must be valid JSON")
+	}
+	return Delivery{
+		id:             uuid.New(),
+		tenantID:       tenantID,
+		subscriptionID: subscriptionID,
+		eventID:        eventID,
+		eventType:      eventType,
+		payload:        payload,
+		status:         valueobject.DeliveryStatusPending,
+		nextAttemptAt:  now,
+		version:        1,
+		createdAt:      now,
+		updatedAt:      now,
+	}, nil
+}
+
+// ReconstructDelivery recreates a Delivery from persisted data without
+// validation or events.
+func ReconstructDelivery(
+	id, tenantID, subscriptionID uuid.UUID,
+	eventID, eventType string,
+	payload json.RawMessage,
+	status valueobject.DeliveryStatus,
+	attempts int,
+	nextAttemptAt time.Time,
+	lastStatusCode int,
+	lastError string,
+	deliveredAt *time.Time,
+	attemptLog []Attempt,
+	version int,
+	createdAt, updatedAt time.Time,
+) Delivery {
+	return Delivery{
+		id:             id,
+		tenantID:       tenantID,
+		subscriptionID: subscriptionID,
+		eventID:        eventID,
+		eventType:      eventType,
+		payload:        payload,
+		status:         status,
+		attempts:       attempts,
+		nextAttemptAt:  nextAttemptAt,
+		lastStatusCode: lastStatusCode,
+		lastError:      lastError,
+		deliveredAt:    deliveredAt,
+		attemptLog:     attemptLog,
+		version:        version,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}
+}
+
+// Succeed records an attempt the endpoint acknowledged.
+func (d Delivery) Succeed(a Attempt, now time.Time) (Delivery, error) {
+	if !d.status.Equal(valueobject.DeliveryStatusPending) {
+		return d, fmt.Errorf("cannot succeed: current status is %s, expected PENDING", d.status)
+	}
+	d = d.record(a, now)
+	d.status = valueobject.DeliveryStatusSucceeded
+	d.lastError = ""
+	d.deliveredAt = &now
+	return d, nil
+}
+
+// RetryAt records a failed attempt and schedules the next one.
+func (d Delivery) RetryAt(a Attempt, next, now time.Time) (Delivery, error) {
+	if !d.status.Equal(valueobject.DeliveryStatusPending) {
+		return d, fmt.Errorf("cannot retry: current status is %s, expected PENDING", d.status)
+	}
+	if a.Error == "" {
+		return d, fmt.Errorf("failure reason must not be empty")
+	}
+	d = d.record(a, now)
+	d.lastError = a.Error
+	d.nextAttemptAt = next
+	return d, nil
+}
+
+// Fail records a failed attempt and gives up on the delivery.
+func (d Delivery) Fail(a Attempt, now time.Time) (Delivery, error) {
+	if !d.status.Equal(valueobject.DeliveryStatusPending) {
+		return d, fmt.Errorf("cannot fail: current status is %s, expected PENDING", d.status)
+	}
+	if a.Error == "" {
+		return d, fmt.Errorf("failure reason must not be empty")
+	}
+	d = d.record(a, now)
+	d.status = valueobject.DeliveryStatusFailed
+	d.lastError = a.Error
+	d.domainEvents = append(d.domainEvents, event.NewDeliveryFailed(
+		d.id, d.tenantID, d.subscriptionID, d.eventID, d.eventType, a.Error, a.StatusCode, d.attempts,
+	))
+	return d, nil
+}
+
+// Redeliver queues a finished delivery to be sent again now, with a fresh
+// allowance of attempts. Its attempt log is kept.
+func (d Delivery) Redeliver(now time.Time) (Delivery, error) {
+	if !d.status.IsTerminal() {
+		return d, fmt.Errorf("cannot redeliver: delivery is still %s", d.status)
+	}
+	d.status = valueobject.DeliveryStatusPending
+	d.attempts = 0
+	d.nextAttemptAt = now
+	d.version++
+	d.updatedAt = now
+	return d, nil
+}
+
+// Envelope returns the request body the delivery is sent as. It is the same
+// on every attempt, so endpoints can deduplicate on the event ID.
+func (d Delivery) Envelope() ([]byte, error) {
+	body, err := json.Marshal(struct {
+		CreatedAt time.Time       `json:"created_at"`
+		ID        string          `json:"id"`
+		Type      string          `json:"type"`
+		TenantID  string          `json:"tenant_id"`
+		Data      json.RawMessage `json:"data"`
+	}{
+		ID:        d.eventID,
+		Type:      d.eventType,
+		TenantID:  d.tenantID.String(),
+		CreatedAt: d.createdAt,
+		Data:      d.payload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode delivery: %w", err)
+	}
+	return body, nil
+}
+
+func (d Delivery) record(a Attempt, now time.Time) Delivery {
+	d.attempts++
+	a.Number = 1
+	if n := len(d.attemptLog); n > 0 {
+		a.Number = d.attemptLog[n-1].Number + 1
+	}
+	log := append(make([]Attempt, 0, len(d.attemptLog)+1), d.attemptLog...)
+	log = append(log, a)
+	if len(log) > MaxAttemptLog {
+		log = log[len(log)-MaxAttemptLog:]
+	}
+	d.attemptLog = log
+	d.lastStatusCode = a.StatusCode
+	d.version++
+	d.updatedAt = now
+	return d
+}
+
+// --- Accessors ---
+
+func (d Delivery) ID() uuid.UUID                      { return d.id }
+func (d Delivery) TenantID() uuid.UUID                { return d.tenantID }
+func (d Delivery) SubscriptionID() uuid.UUID          { return d.subscriptionID }
+func (d Delivery) EventID() string                    { return d.eventID }
+func (d Delivery) EventType() string                  { return d.eventType }
+func (d Delivery) Payload() json.RawMessage           { return d.payload }
+func (d Delivery) Status() valueobject.DeliveryStatus { return d.status }
+func (d Delivery) Attempts() int                      { return d.attempts }
+func (d Delivery) NextAttemptAt() time.Time           { return d.nextAttemptAt }
+func (d Delivery) LastStatusCode() int                { return d.lastStatusCode }
+func (d Delivery) LastError() string                  { return d.lastError }
+func (d Delivery) DeliveredAt() *time.Time            { return d.deliveredAt }
+func (d Delivery) AttemptLog() []Attempt              { return d.attemptLog }
+func (d Delivery) Version() int                       { return d.version }
+func (d Delivery) CreatedAt() time.Time               { return d.createdAt }
+func (d Delivery) UpdatedAt() time.Time               { return d.updatedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (d Delivery) DomainEvents() []events.DomainEvent {
+	return d.domainEvents
+}
+
+// ClearDomainEvents returns a copy with cleared domain events.
+func (d Delivery) ClearDomainEvents() Delivery {
+	d.domainEvents = nil
+	return d
+}
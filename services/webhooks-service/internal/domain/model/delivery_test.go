@@ -0,0 +1,111 @@
+package model_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/model"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/valueobject"
+)
+
+func newDelivery(t *testing.T) model.Delivery {
+	t.Helper()
+	d, err := model.NewDelivery(uuid.New(), uuid.New(), "evt-1", "card.issued", json.RawMessage(`{"card_id":"c1"}`), now)
+	require.NoError(t, err)
+	return d
+}
+
+func TestNewDelivery_Validation(t *testing.T) {
+	d := newDelivery(t)
+	assert.True(t, d.Status().Equal(valueobject.DeliveryStatusPending))
+	assert.Equal(t, now, d.NextAttemptAt())
+
+	_, err := model.NewDelivery(uuid.New(), uuid.Nil, "evt-1", "card.issued", json.RawMessage(`{}`), now)
+	assert.Error(t, err, "nil subscription")
+	_, err = model.NewDelivery(uuid.New(), uuid.New(), "", "card.issued", json.RawMessage(`{}`), now)
+	assert.Error(t, err, "no event ID")
+	_, err = model.NewDelivery(uuid.New(), uuid.New(), "evt-1", "card.issued", json.RawMessage(`{`), now)
+	assert.Error(t, err, "invalid payload")
+}
+
+func TestDelivery_RetryThenSucceed(t *testing.T) {
+	d := newDelivery(t)
+
+	d, err := d.RetryAt(model.Attempt{AttemptedAt: now, StatusCode: 503, Error: "endpoint answered 503"}, now.Add(time.Minute), now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, d.Attempts())
+	assert.Equal(t, now.Add(time.Minute), d.NextAttemptAt())
+	assert.Equal(t, 503, d.LastStatusCode())
+
+	_, err = d.RetryAt(model.Attempt{AttemptedAt: now}, now, now)
+	assert.Error(t, err, "a retry needs a reason")
+
+	later := now.Add(time.Minute)
+	d, err = d.Succeed(model.Attempt{AttemptedAt: later, StatusCode: 204}, later)
+	require.NoError(t, err)
+	assert.True(t, d.Status().Equal(valueobject.DeliveryStatusSucceeded))
+	assert.Empty(t, d.LastError())
+	require.NotNil(t, d.DeliveredAt())
+	require.Len(t, d.AttemptLog(), 2)
+	assert.Equal(t, []int{1, 2}, []int{d.AttemptLog()[0].Number, d.AttemptLog()[1].Number})
+	assert.Equal(t, 3, d.Version())
+	assert.Empty(t, d.DomainEvents())
+
+	_, err = d.Succeed(model.Attempt{AttemptedAt: later, StatusCode: 200}, later)
+	assert.Error(t, err, "already succeeded")
+}
+
+func TestDelivery_FailAndRedeliver(t *testing.T) {
+	d := newDelivery(t)
+	_, err := d.Redeliver(now)
+	assert.Error(t, err, "pending deliveries cannot be redelivered")
+
+	d, err = d.Fail(model.Attempt{AttemptedAt: now, StatusCode: 410, Error: "endpoint answered 410 Gone"}, now)
+	require.NoError(t, err)
+	assert.True(t, d.Status().Equal(valueobject.DeliveryStatusFailed))
+	require.Len(t, d.DomainEvents(), 1)
+	assert.Equal(t, "webhook.delivery.failed", d.DomainEvents()[0].EventType())
+
+	later := now.Add(time.Hour)
+	d, err = d.ClearDomainEvents().Redeliver(later)
+	require.NoError(t, err)
+	assert.True(t, d.Status().Equal(valueobject.DeliveryStatusPending))
+	assert.Equal(t, 0, d.Attempts(), "redelivery gets a fresh allowance of attempts")
+	assert.Equal(t, later, d.NextAttemptAt())
+	assert.Len(t, d.AttemptLog(), 1, "the attempt log is kept")
+
+	d, err = d.Succeed(model.Attempt{AttemptedAt: later, StatusCode: 200}, later)
+	require.NoError(t, err)
+	assert.Equal(t, 2, d.AttemptLog()[1].Number, "attempts are numbered across redeliveries")
+}
+
+func TestDelivery_AttemptLogIsBounded(t *testing.T) {
+	d := newDelivery(t)
+	var err error
+	for i := range model.MaxAttemptLog + 5 {
+		at := now.Add(time.Duration(i) * time.Minute)
+		d, err = d.RetryAt(model.Attempt{AttemptedAt: at, Error: "connection refused"}, at, at)
+		require.NoError(t, err)
+	}
+	require.Len(t, d.AttemptLog(), model.MaxAttemptLog)
+	assert.Equal(t, 6, d.AttemptLog()[0].Number)
+	assert.Equal(t, model.MaxAttemptLog+5, d.Attempts())
+}
+
+func TestDelivery_Envelope(t *testing.T) {
+	d := newDelivery(t)
+	body, err := d.Envelope()
+	require.NoError(t, err)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(body, &got))
+	assert.Equal(t, "evt-1", got["id"])
+	assert.Equal(t, "card.issued", got["type"])
+	assert.Equal(t, d.TenantID().String(), got["tenant_id"])
+	assert.Equal(t, map[string]any{"card_id": "c1"}, got["data"])
+}
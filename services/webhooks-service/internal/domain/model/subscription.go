@@ -0,0 +1,258 @@
+package model
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/pkg/events"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/event"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/valueobject"
+)
+
+const (
+	// MaxEventFilters bounds the event type filters of one subscription.
+	MaxEventFilters = 50
+	// MaxSecretGracePeriod bounds how long a rotated-out secret keeps
+	// signing payloads.
+	MaxSecretGracePeriod = 7 * 24 * time.Hour
+
+	maxDescriptionLength = 256
+	secretPrefix         = "whsec_"
+	secretBytes          = 32
+)
+
+// Subscription is the aggregate root for a tenant endpoint receiving the
+// domain events its filters match. Payloads are signed with the
+// subscription's secret; after a rotation the previous secret also signs
+// them for a grace period, so the endpoint can switch over without
+// rejecting deliveries.
+type Subscription struct {
+	createdAt               time.Time
+	updatedAt               time.Time
+	previousSecretExpiresAt *time.Time
+	url                     valueobject.EndpointURL
+	description             string
+	secret                  string
+	previousSecret          string
+	eventTypes              []valueobject.EventFilter
+	domainEvents            []events.DomainEvent
+	version                 int
+	id                      uuid.UUID
+	tenantID                uuid.UUID
+	active                  bool
+}
+
+// NewSubscription creates an active subscription with a new signing secret.
+func NewSubscription(
+	tenantID uuid.UUID,
+	url valueobject.EndpointURL,
+	eventTypes []valueobject.EventFilter,
+	description string,
+	now time.Time,
+) (Subscription, error) {
+	if tenantID == uuid.Nil {
+		return Subscription{}, fmt.Errorf("tenant ID must not be empty")
+	}
+	secret, err := newSecret()
+	if err != nil {
+		return Subscription{}, err
+	}
+	s := Subscription{
+		id:        uuid.New(),
+		tenantID:  tenantID,
+		secret:    secret,
+		active:    true,
+		createdAt: now,
+	}
+	s, err = s.apply(url, eventTypes, description, true, now)
+	if err != nil {
+		return Subscription{}, err
+	}
+	s.domainEvents = append(s.domainEvents, event.NewSubscriptionCreated(s.id, s.tenantID, url.String(), s.EventTypeStrings()))
+	return s, nil
+}
+
+// ReconstructSubscription recreates a Subscription from persisted data
+// without validation or events.
+func ReconstructSubscription(
+	id, tenantID uuid.UUID,
+	url valueobject.EndpointURL,
+	eventTypes []valueobject.EventFilter,
+	description string,
+	active bool,
+	secret, previousSecret string,
+	previousSecretExpiresAt *time.Time,
+	version int,
+	createdAt, updatedAt time.Time,
+) Subscription {
+	return Subscription{
+		id:                      id,
+		tenantID:                tenantID,
+		url:                     url,
+		eventTypes:              eventTypes,
+		description:             description,
+		active:                  active,
+		secret:                  secret,
+		previousSecret:          previousSecret,
+		previousSecretExpiresAt: previousSecretExpiresAt,
+		version:                 version,
+		createdAt:               createdAt,
+		updatedAt:               updatedAt,
+	}
+}
+
+// Update replaces the subscription's endpoint, filters and description and
+// pauses or resumes it. Deliveries already queued are sent to the new
+// endpoint.
+func (s Subscription) Update(
+	url valueobject.EndpointURL,
+	eventTypes []valueobject.EventFilter,
+	description string,
+	active bool,
+	now time.Time,
+) (Subscription, error) {
+	return s.apply(url, eventTypes, description, active, now)
+}
+
+func (s Subscription) apply(
+	url valueobject.EndpointURL,
+	eventTypes []valueobject.EventFilter,
+	description string,
+	active bool,
+	now time.Time,
+) (Subscription, error) {
+	if url.IsZero() {
+		return s, fmt.Errorf("endpoint URL must not be empty")
+	}
+	if len(eventTypes) == 0 {
+		return s, fmt.Errorf("at least one event type filter is required")
+	}
+	if len(eventTypes) > MaxEventFilters {
+		return s, fmt.Errorf("at most %d event type filters are allowed", MaxEventFilters)
+	}
+	description = strings.TrimSpace(description)
+	if len(description) > maxDescriptionLength {
+		return s, fmt.Errorf("description must be at most %d characters", maxDescriptionLength)
+	}
+
+	filters := make([]valueobject.EventFilter, 0, len(eventTypes))
+	for _, f := range eventTypes {
+		if f.IsZero() {
+			return s, fmt.Errorf("event type filter must not be empty")
+		}
+		duplicate := false
+		for _, seen := range filters {
+			duplicate = duplicate || seen.Equal(f)
+		}
+		if !duplicate {
+			filters = append(filters, f)
+		}
+	}
+
+	s.url = url
+	s.eventTypes = filters
+	s.description = description
+	s.active = active
+	s.version++
+	s.updatedAt = now
+	return s, nil
+}
+
+// RotateSecret replaces the signing secret. The old secret keeps signing
+// payloads alongside the new one for the grace period; a zero grace
+// period revokes it at once.
+func (s Subscription) RotateSecret(grace time.Duration, now time.Time) (Subscription, error) {
+	if grace < 0 || grace > MaxSecretGracePeriod {
+		return s, fmt.Errorf("grace period must be between 0 and %s", MaxSecretGracePeriod)
+	}
+	secret, err := newSecret()
+	if err != nil {
+		return s, err
+	}
+	expiresAt := now.Add(grace)
+	if grace > 0 {
+		s.previousSecret = s.secret
+		s.previousSecretExpiresAt = &expiresAt
+	} else {
+		s.previousSecret = ""
+		s.previousSecretExpiresAt = nil
+	}
+	s.secret = secret
+	s.version++
+	s.updatedAt = now
+	s.domainEvents = append(s.domainEvents, event.NewSecretRotated(s.id, s.tenantID, expiresAt))
+	return s, nil
+}
+
+// Matches returns true if the subscription is active and one of its
+// filters selects the event type.
+func (s Subscription) Matches(eventType string) bool {
+	if !s.active {
+		return false
+	}
+	for _, f := range s.eventTypes {
+		if f.Matches(eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// SigningSecrets returns the secrets payloads are signed with at now: the
+// current one, and the previous one while its grace period lasts.
+func (s Subscription) SigningSecrets(now time.Time) []string {
+	secrets := []string{s.secret}
+	if s.previousSecret != "" && s.previousSecretExpiresAt != nil && now.Before(*s.previousSecretExpiresAt) {
+		secrets = append(secrets, s.previousSecret)
+	}
+	return secrets
+}
+
+// EventTypeStrings returns the subscription's filters as strings.
+func (s Subscription) EventTypeStrings() []string {
+	out := make([]string, len(s.eventTypes))
+	for i, f := range s.eventTypes {
+		out[i] = f.String()
+	}
+	return out
+}
+
+// newSecret generates a random signing secret.
+func newSecret() (string, error) {
+	b := make([]byte, secretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate signing secret: %w", err)
+	}
+	return secretPrefix + hex.EncodeToString(b), nil
+}
+
+// --- Accessors ---
+
+func (s Subscription) ID() uuid.UUID                         { return s.id }
+func (s Subscription) TenantID() uuid.UUID                   { return s.tenantID }
+func (s Subscription) URL() valueobject.EndpointURL          { return s.url }
+func (s Subscription) EventTypes() []valueobject.EventFilter { return s.eventTypes }
+func (s Subscription) Description() string                   { return s.description }
+func (s Subscription) Active() bool                          { return s.active }
+func (s Subscription) Secret() string                        { return s.secret }
+func (s Subscription) PreviousSecret() string                { return s.previousSecret }
+func (s Subscription) PreviousSecretExpiresAt() *time.Time   { return s.previousSecretExpiresAt }
+func (s Subscription) Version() int                          { return s.version }
+func (s Subscription) CreatedAt() time.Time                  { return s.createdAt }
+func (s Subscription) UpdatedAt() time.Time                  { return s.updatedAt }
+
+// DomainEvents returns the uncommitted domain events.
+func (s Subscription) DomainEvents() []events.DomainEvent {
+	return s.domainEvents
+}
+
+// ClearDomainEvents returns a copy with cleared domain events.
+func (s Subscription) ClearDomainEvents() Subscription {
+	s.domainEvents = nil
+	return s
+}
@@ -0,0 +1,94 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/event"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/model"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/valueobject"
+)
+
+// ErrSubscriptionNotFound is returned when a subscription does not exist.
+var ErrSubscriptionNotFound = errors.New("webhook subscription not found")
+
+// ErrDeliveryNotFound is returned when a delivery does not exist.
+var ErrDeliveryNotFound = errors.New("webhook delivery not found")
+
+// ErrVersionConflict is returned when an aggregate was modified concurrently.
+var ErrVersionConflict = errors.New("version conflict")
+
+// SubscriptionRepository defines the persistence port for subscriptions.
+type SubscriptionRepository interface {
+	// Save persists a subscription with optimistic locking on its version.
+	Save(ctx context.Context, subscription model.Subscription) error
+
+	// Delete removes a tenant's subscription and its deliveries.
+	Delete(ctx context.Context, tenantID, id uuid.UUID) error
+
+	// FindByID retrieves a tenant's subscription.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Subscription, error)
+
+	// List returns a page of a tenant's subscriptions, oldest first, and the
+	// total number of them.
+	List(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]model.Subscription, int, error)
+
+	// ListActive returns all of a tenant's active subscriptions.
+	ListActive(ctx context.Context, tenantID uuid.UUID) ([]model.Subscription, error)
+}
+
+// DeliveryFilter narrows a listing of a tenant's deliveries. Zero fields do
+// not filter.
+type DeliveryFilter struct {
+	Status         valueobject.DeliveryStatus
+	EventType      string
+	TenantID       uuid.UUID
+	SubscriptionID uuid.UUID
+}
+
+// DeliveryRepository defines the persistence port for deliveries.
+type DeliveryRepository interface {
+	// Create persists a new delivery. It returns false, without error, when
+	// the subscription already has a delivery of the same event, so
+	// redelivered events are not sent twice.
+	Create(ctx context.Context, delivery model.Delivery) (bool, error)
+
+	// Save persists changes to an existing delivery with optimistic locking
+	// on its version.
+	Save(ctx context.Context, delivery model.Delivery) error
+
+	// FindByID retrieves a tenant's delivery.
+	FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Delivery, error)
+
+	// List returns a page of the deliveries matching the filter, newest
+	// first, and the total number of them.
+	List(ctx context.Context, filter DeliveryFilter, limit, offset int) ([]model.Delivery, int, error)
+
+	// ClaimDue returns up to limit PENDING deliveries due by now and pushes
+	// their next attempt back by lease, so no other worker claims them while
+	// they are being sent and a crashed worker's claims are retried.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]model.Delivery, error)
+}
+
+// EventPublisher defines the port for publishing domain events.
+type EventPublisher interface {
+	// Publish sends domain events to the event bus.
+	Publish(ctx context.Context, events []event.DomainEvent) error
+}
+
+// WebhookRequest is a signed delivery as handed to the sender.
+type WebhookRequest struct {
+	Headers map[string]string
+	URL     string
+	Body    []byte
+}
+
+// Sender POSTs webhook requests to subscriber endpoints.
+type Sender interface {
+	// Send makes the request and returns the HTTP status the endpoint
+	// answered with. An error means no response was received.
+	Send(ctx context.Context, req WebhookRequest) (int, error)
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignatureHeader is the request header carrying a payload's signatures.
+const SignatureHeader = "X-Bib-Signature"
+
+// Sign returns the signature header value of a payload sent at timestamp:
+// "t=<unix seconds>" followed by a "v1=<hex>" entry per secret, each the
+// HMAC-SHA256 under that secret of "<unix seconds>.<body>". Endpoints
+// verify any v1 entry against their secret and reject stale timestamps to
+// stop replays.
+func Sign(secrets []string, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	parts := make([]string, 0, len(secrets)+1)
+	parts = append(parts, "t="+ts)
+	for _, secret := range secrets {
+		parts = append(parts, "v1="+signature(secret, ts, body))
+	}
+	return strings.Join(parts, ",")
+}
+
+// Verify checks a signature header against a secret, accepting it when one
+// of its v1 entries matches and its timestamp is no more than tolerance
+// away from now.
+func Verify(header, secret string, body []byte, tolerance time.Duration, now time.Time) bool {
+	var (
+		ts         string
+		signatures []string
+	)
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			ts = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return false
+	}
+	if age := now.Sub(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+		return false
+	}
+	expected := signature(secret, ts, body)
+	for _, s := range signatures {
+		if hmac.Equal([]byte(s), []byte(expected)) {
+			return true
+		}
+	}
+	return false
+}
+
+func signature(secret, ts string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
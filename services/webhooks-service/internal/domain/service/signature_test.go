@@ -0,0 +1,41 @@
+package service_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/service"
+)
+
+func TestSign_Format(t *testing.T) {
+	ts := time.Unix(1772355600, 0)
+	body := []byte(`{"id":"evt-1"}`)
+
+	header := service.Sign([]string{"whsec_new", "whsec_old"}, ts, body)
+	parts := strings.Split(header, ",")
+	assert.Len(t, parts, 3)
+	assert.Equal(t, "t=1772355600", parts[0])
+
+	mac := hmac.New(sha256.New, []byte("whsec_new"))
+	mac.Write([]byte("1772355600." + string(body)))
+	assert.Equal(t, "v1="+hex.EncodeToString(mac.Sum(nil)), parts[1])
+}
+
+func TestVerify(t *testing.T) {
+	ts := time.Unix(1772355600, 0)
+	body := []byte(`{"id":"evt-1"}`)
+	header := service.Sign([]string{"whsec_new", "whsec_old"}, ts, body)
+
+	assert.True(t, service.Verify(header, "whsec_new", body, 5*time.Minute, ts))
+	assert.True(t, service.Verify(header, "whsec_old", body, 5*time.Minute, ts.Add(time.Minute)), "any v1 entry")
+	assert.False(t, service.Verify(header, "whsec_other", body, 5*time.Minute, ts), "wrong secret")
+	assert.False(t, service.Verify(header, "whsec_new", []byte(`{"id":"evt-2"}`), 5*time.Minute, ts), "tampered body")
+	assert.False(t, service.Verify(header, "whsec_new", body, 5*time.Minute, ts.Add(6*time.Minute)), "replayed")
+	assert.False(t, service.Verify("v1=abc", "whsec_new", body, 5*time.Minute, ts), "no timestamp")
+}
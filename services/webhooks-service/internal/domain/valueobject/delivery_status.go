@@ -0,0 +1,60 @@
+package valueobject
+
+import "fmt"
+
+// DeliveryStatus is where a webhook delivery stands.
+// It is an immutable value object.
+type DeliveryStatus struct {
+	value string
+}
+
+const (
+	deliveryStatusPending   = "PENDING"
+	deliveryStatusSucceeded = "SUCCEEDED"
+	deliveryStatusFailed    = "FAILED"
+)
+
+var (
+	// DeliveryStatusPending is a delivery awaiting its first or next attempt.
+	DeliveryStatusPending = DeliveryStatus{value: deliveryStatusPending}
+	// DeliveryStatusSucceeded is a delivery the endpoint acknowledged.
+	DeliveryStatusSucceeded = DeliveryStatus{value: deliveryStatusSucceeded}
+	// DeliveryStatusFailed is a delivery that will not be retried unless it
+	// is redelivered.
+	DeliveryStatusFailed = DeliveryStatus{value: deliveryStatusFailed}
+)
+
+var validDeliveryStatuses = map[string]DeliveryStatus{
+	deliveryStatusPending:   DeliveryStatusPending,
+	deliveryStatusSucceeded: DeliveryStatusSucceeded,
+	deliveryStatusFailed:    DeliveryStatusFailed,
+}
+
+// NewDeliveryStatus creates a DeliveryStatus from a string, validating it is known.
+func NewDeliveryStatus(s string) (DeliveryStatus, error) {
+	ds, ok := validDeliveryStatuses[s]
+	if !ok {
+		return DeliveryStatus{}, fmt.Errorf("invalid delivery status: %q", s)
+	}
+	return ds, nil
+}
+
+// String returns the string representation of the DeliveryStatus.
+func (s DeliveryStatus) String() string {
+	return s.value
+}
+
+// IsZero returns true if the DeliveryStatus has not been set.
+func (s DeliveryStatus) IsZero() bool {
+	return s.value == ""
+}
+
+// IsTerminal returns true once delivery has succeeded or been given up on.
+func (s DeliveryStatus) IsTerminal() bool {
+	return s.value == deliveryStatusSucceeded || s.value == deliveryStatusFailed
+}
+
+// Equal returns true if two DeliveryStatus values are equal.
+func (s DeliveryStatus) Equal(other DeliveryStatus) bool {
+	return s.value == other.value
+}
@@ -0,0 +1,55 @@
+package valueobject
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const maxEndpointURLLength = 2048
+
+// EndpointURL is where a subscription's deliveries are POSTed: an absolute
+// HTTPS URL, or an HTTP one where plain HTTP endpoints are allowed.
+// It is an immutable value object.
+type EndpointURL struct {
+	value string
+}
+
+// NewEndpointURL creates an EndpointURL from a string, validating its form.
+// Plain HTTP URLs are rejected unless allowHTTP is set.
+func NewEndpointURL(s string, allowHTTP bool) (EndpointURL, error) {
+	if len(s) > maxEndpointURLLength {
+		return EndpointURL{}, fmt.Errorf("endpoint URL must be at most %d characters", maxEndpointURLLength)
+	}
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" {
+		return EndpointURL{}, fmt.Errorf("invalid endpoint URL %q: must be absolute", s)
+	}
+	switch {
+	case u.Scheme == "https":
+	case u.Scheme == "http" && allowHTTP:
+	default:
+		return EndpointURL{}, fmt.Errorf("invalid endpoint URL %q: must use https", s)
+	}
+	if u.User != nil {
+		return EndpointURL{}, fmt.Errorf("invalid endpoint URL %q: must not contain credentials", s)
+	}
+	if u.Fragment != "" {
+		return EndpointURL{}, fmt.Errorf("invalid endpoint URL %q: must not contain a fragment", s)
+	}
+	return EndpointURL{value: s}, nil
+}
+
+// String returns the string representation of the EndpointURL.
+func (u EndpointURL) String() string {
+	return u.value
+}
+
+// IsZero returns true if the EndpointURL has not been set.
+func (u EndpointURL) IsZero() bool {
+	return u.value == ""
+}
+
+// Equal returns true if two EndpointURL values are equal.
+func (u EndpointURL) Equal(other EndpointURL) bool {
+	return u.value == other.value
+}
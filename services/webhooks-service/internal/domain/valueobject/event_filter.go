@@ -0,0 +1,55 @@
+package valueobject
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// eventFilterPattern allows a dotted event type such as
+// "payment.order.completed", optionally ending in ".*", or a lone "*".
+var eventFilterPattern = regexp.MustCompile(`^(\*|[a-z0-9_]+(\.[a-z0-9_]+)*(\.\*)?)$`)
+
+const maxEventFilterLength = 128
+
+// EventFilter selects the event types a subscription receives: one event
+// type, every type under a prefix ("card.*" matches "card.issued" and
+// "card.transaction.declined") or every type ("*").
+// It is an immutable value object.
+type EventFilter struct {
+	value string
+}
+
+// NewEventFilter creates an EventFilter from a string, validating its form.
+func NewEventFilter(s string) (EventFilter, error) {
+	if len(s) > maxEventFilterLength || !eventFilterPattern.MatchString(s) {
+		return EventFilter{}, fmt.Errorf("invalid event type filter %q: must be an event type, a prefix ending in \".*\" or \"*\"", s)
+	}
+	return EventFilter{value: s}, nil
+}
+
+// Matches returns true if the filter selects the event type.
+func (f EventFilter) Matches(eventType string) bool {
+	if f.value == "*" {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(f.value, "*"); ok {
+		return strings.HasPrefix(eventType, prefix)
+	}
+	return f.value == eventType
+}
+
+// String returns the string representation of the EventFilter.
+func (f EventFilter) String() string {
+	return f.value
+}
+
+// IsZero returns true if the EventFilter has not been set.
+func (f EventFilter) IsZero() bool {
+	return f.value == ""
+}
+
+// Equal returns true if two EventFilter values are equal.
+func (f EventFilter) Equal(other EventFilter) bool {
+	return f.value == other.value
+}
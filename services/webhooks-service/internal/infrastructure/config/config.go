@@ -0,0 +1,165 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type DatabaseConfig struct {
+	Host     string
+	User     string
+	Password string
+	Name     string
+	SSLMode  string
+	Port     int
+}
+
+type KafkaConfig struct {
+	ConsumerGroup string
+	Brokers       []string
+	// Topics consumed for events to deliver; every service's domain event
+	// topics when empty.
+	Topics []string
+}
+
+// DispatchConfig configures delivery of queued webhooks. Failed deliveries
+// are retried up to MaxAttempts times in all, backing off exponentially
+// from BaseBackoff up to MaxBackoff.
+type DispatchConfig struct {
+	PollInterval   time.Duration
+	BaseBackoff    time.Duration
+	MaxBackoff     time.Duration
+	Lease          time.Duration
+	RequestTimeout time.Duration
+	BatchSize      int
+	MaxAttempts    int
+	// AllowHTTP permits plain HTTP endpoints, for development; production
+	// subscriptions must use HTTPS.
+	AllowHTTP bool
+}
+
+type Config struct {
+	DB          DatabaseConfig
+	ServiceName string
+	Kafka       KafkaConfig
+	Dispatch    DispatchConfig
+	Outbox      OutboxConfig
+	GRPCPort    int
+	HTTPPort    int
+}
+
+// OutboxConfig configures the outbox relay.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+func Load() Config {
+	return Config{
+		GRPCPort: getEnvInt("GRPC_PORT", 9098),
+		HTTPPort: getEnvInt("HTTP_PORT", 8098),
+		DB: DatabaseConfig{
+			Host:     getEnv("DB_HOST", "localhost"),
+			Port:     getEnvInt("DB_PORT", 5432),
+			User:     getEnv("DB_USER", "bib"),
+			Password: getEnv("DB_PASSWORD", ""),
+			Name:     getEnv("DB_NAME", "bib_webhooks"),
+			SSLMode:  getEnv("DB_SSLMODE", "require"),
+		},
+		Kafka: KafkaConfig{
+			Brokers:       []string{getEnv("KAFKA_BROKERS", "localhost:9092")},
+			ConsumerGroup: getEnv("KAFKA_CONSUMER_GROUP", "webhooks-service"),
+			Topics:        getEnvList("WEBHOOK_TOPICS"),
+		},
+		Dispatch: DispatchConfig{
+			PollInterval:   getEnvDuration("WEBHOOK_POLL_INTERVAL", 5*time.Second),
+			BatchSize:      getEnvInt("WEBHOOK_BATCH_SIZE", 50),
+			MaxAttempts:    getEnvInt("WEBHOOK_MAX_ATTEMPTS", 8),
+			BaseBackoff:    getEnvDuration("WEBHOOK_BASE_BACKOFF", 30*time.Second),
+			MaxBackoff:     getEnvDuration("WEBHOOK_MAX_BACKOFF", 6*time.Hour),
+			Lease:          getEnvDuration("WEBHOOK_LEASE", 2*time.Minute),
+			RequestTimeout: getEnvDuration("WEBHOOK_REQUEST_TIMEOUT", 10*time.Second),
+			AllowHTTP:      getEnv("WEBHOOK_ALLOW_HTTP", "false") == "true",
+		},
+		ServiceName: "webhooks-service",
+		Outbox: OutboxConfig{
+			PollInterval: mustEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    mustEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
+	}
+}
+
+func (c Config) GRPCAddr() string {
+	return fmt.Sprintf(":%d", c.GRPCPort)
+}
+
+func (c Config) HTTPAddr() string {
+	return fmt.Sprintf(":%d", c.HTTPPort)
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) int {
+	if v := os.Getenv(key); v != "" {
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+	return fallback
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if v := os.Getenv(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return fallback
+}
+
+// getEnvList reads a comma-separated list, ignoring blank entries.
+func getEnvList(key string) []string {
+	var list []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// mustEnvDuration reads a positive duration, failing rather than falling
+// back to the default when the value is invalid.
+func mustEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("%s must be a positive duration, got %q", key, val))
+	}
+	return d
+}
+
+// mustEnvInt reads a positive integer, failing rather than falling back to
+// the default when the value is invalid.
+func mustEnvInt(key string, defaultVal int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return defaultVal
+	}
+	i, err := strconv.Atoi(val)
+	if err != nil || i <= 0 {
+		panic(fmt.Sprintf("%s must be a positive integer, got %q", key, val))
+	}
+	return i
+}
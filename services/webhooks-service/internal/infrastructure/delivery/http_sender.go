@@ -0,0 +1,57 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/port"
+)
+
+// userAgent identifies webhook requests to endpoints.
+const userAgent = "bib-webhooks/1.0"
+
+// maxDrainBytes bounds how much of a response body is read so the
+// connection can be reused; endpoints' answers are otherwise ignored.
+const maxDrainBytes = 64 << 10
+
+// HTTPSender implements port.Sender with an HTTP client. Redirects are not
+// followed: an endpoint that moved must be updated on its subscription.
+type HTTPSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender creates a new HTTPSender whose requests time out after
+// timeout.
+func NewHTTPSender(timeout time.Duration) *HTTPSender {
+	return &HTTPSender{client: &http.Client{
+		Timeout: timeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// Send POSTs the request and returns the endpoint's status code.
+func (s *HTTPSender) Send(ctx context.Context, req port.WebhookRequest) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	for k, v := range req.Headers {
+		httpReq.Header.Set(k, v)
+	}
+	httpReq.Header.Set("User-Agent", userAgent)
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return 0, fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }() //nolint:errcheck // body only drained
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainBytes)) //nolint:errcheck // best-effort drain
+	return resp.StatusCode, nil
+}
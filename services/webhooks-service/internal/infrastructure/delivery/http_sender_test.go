@@ -0,0 +1,62 @@
+package delivery_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/port"
+	"github.com/bibbank/bib/services/webhooks-service/internal/infrastructure/delivery"
+)
+
+func TestHTTPSender_PostsRequest(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "t=1,v1=abc", r.Header.Get("X-Bib-Signature"))
+		assert.Contains(t, r.Header.Get("User-Agent"), "bib-webhooks")
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"id":"evt-1"}`, string(body))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	status, err := delivery.NewHTTPSender(time.Second).Send(context.Background(), port.WebhookRequest{
+		URL:     srv.URL,
+		Headers: map[string]string{"Content-Type": "application/json", "X-Bib-Signature": "t=1,v1=abc"},
+		Body:    []byte(`{"id":"evt-1"}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, status)
+}
+
+func TestHTTPSender_DoesNotFollowRedirects(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/moved" {
+			t.Error("redirect was followed")
+		}
+		http.Redirect(w, r, "/moved", http.StatusTemporaryRedirect)
+	}))
+	defer srv.Close()
+
+	status, err := delivery.NewHTTPSender(time.Second).Send(context.Background(), port.WebhookRequest{URL: srv.URL})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTemporaryRedirect, status)
+}
+
+func TestHTTPSender_Timeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	_, err := delivery.NewHTTPSender(20*time.Millisecond).Send(context.Background(), port.WebhookRequest{URL: srv.URL})
+	assert.Error(t, err)
+}
@@ -0,0 +1,238 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/model"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/port"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/valueobject"
+)
+
+const deliveryColumns = `
+	id, tenant_id, subscription_id, event_id, event_type, payload, status,
+	attempts, next_attempt_at, last_status_code, last_error, delivered_at,
+	attempt_log, version, created_at, updated_at`
+
+// attemptRecord is how an attempt is stored in a delivery's attempt log.
+type attemptRecord struct {
+	AttemptedAt time.Time `json:"attempted_at"`
+	Error       string    `json:"error,omitempty"`
+	DurationMS  int64     `json:"duration_ms"`
+	Number      int       `json:"number"`
+	StatusCode  int       `json:"status_code"`
+}
+
+// DeliveryRepo is the PostgreSQL implementation of DeliveryRepository.
+type DeliveryRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewDeliveryRepo creates a new DeliveryRepo.
+func NewDeliveryRepo(pool *pgxpool.Pool) *DeliveryRepo {
+	return &DeliveryRepo{pool: pool}
+}
+
+// Create persists a new delivery, skipping it when the subscription already
+// has a delivery of the same event.
+func (r *DeliveryRepo) Create(ctx context.Context, d model.Delivery) (bool, error) {
+	log, err := encodeAttemptLog(d.AttemptLog())
+	if err != nil {
+		return false, err
+	}
+	query := `
+		INSERT INTO webhook_deliveries (` + deliveryColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		ON CONFLICT (subscription_id, event_id) DO NOTHING
+	`
+
+	tag, err := r.pool.Exec(ctx, query,
+		d.ID(), d.TenantID(), d.SubscriptionID(), d.EventID(), d.EventType(), []byte(d.Payload()), d.Status().String(),
+		d.Attempts(), d.NextAttemptAt(), d.LastStatusCode(), d.LastError(), d.DeliveredAt(),
+		log, d.Version(), d.CreatedAt(), d.UpdatedAt(),
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// Save persists changes to an existing delivery with optimistic locking on
+// its version.
+func (r *DeliveryRepo) Save(ctx context.Context, d model.Delivery) error {
+	log, err := encodeAttemptLog(d.AttemptLog())
+	if err != nil {
+		return err
+	}
+	query := `
+		UPDATE webhook_deliveries SET
+			status = $2,
+			attempts = $3,
+			next_attempt_at = $4,
+			last_status_code = $5,
+			last_error = $6,
+			delivered_at = $7,
+			attempt_log = $8,
+			version = $9,
+			updated_at = $10
+		WHERE id = $1 AND version = $9 - 1
+	`
+
+	tag, err := r.pool.Exec(ctx, query,
+		d.ID(), d.Status().String(), d.Attempts(), d.NextAttemptAt(), d.LastStatusCode(), d.LastError(),
+		d.DeliveredAt(), log, d.Version(), d.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save webhook delivery: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: delivery %s", port.ErrVersionConflict, d.ID())
+	}
+	return nil
+}
+
+// FindByID retrieves a tenant's delivery.
+func (r *DeliveryRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Delivery, error) {
+	query := `SELECT ` + deliveryColumns + ` FROM webhook_deliveries WHERE id = $1 AND tenant_id = $2`
+
+	d, err := scanDelivery(r.pool.QueryRow(ctx, query, id, tenantID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Delivery{}, port.ErrDeliveryNotFound
+	}
+	if err != nil {
+		return model.Delivery{}, fmt.Errorf("failed to scan webhook delivery: %w", err)
+	}
+	return d, nil
+}
+
+// List returns a page of the deliveries matching the filter, newest first,
+// and the total number of them.
+func (r *DeliveryRepo) List(ctx context.Context, filter port.DeliveryFilter, limit, offset int) ([]model.Delivery, int, error) {
+	const where = ` WHERE tenant_id = $1
+		AND ($2 = '00000000-0000-0000-0000-000000000000'::uuid OR subscription_id = $2)
+		AND ($3 = '' OR status = $3)
+		AND ($4 = '' OR event_type = $4)`
+	args := []any{filter.TenantID, filter.SubscriptionID, filter.Status.String(), filter.EventType}
+
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM webhook_deliveries`+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+	deliveries, err := r.query(ctx, `SELECT `+deliveryColumns+` FROM webhook_deliveries`+where+`
+		ORDER BY created_at DESC
+		LIMIT $5 OFFSET $6
+	`, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return deliveries, total, nil
+}
+
+// ClaimDue claims up to limit due PENDING deliveries, skipping those other
+// workers hold, by pushing their next attempt back by the lease.
+func (r *DeliveryRepo) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]model.Delivery, error) {
+	query := `
+		UPDATE webhook_deliveries SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = 'PENDING' AND next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + deliveryColumns
+
+	return r.query(ctx, query, now, now.Add(lease), limit)
+}
+
+func (r *DeliveryRepo) query(ctx context.Context, query string, args ...any) ([]model.Delivery, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []model.Delivery
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery row: %w", err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return deliveries, nil
+}
+
+func scanDelivery(row pgx.Row) (model.Delivery, error) {
+	var (
+		id, tenantID, subscriptionID uuid.UUID
+		eventID, eventType           string
+		payload, rawLog              []byte
+		statusStr, lastError         string
+		attempts, lastStatusCode     int
+		nextAttemptAt                time.Time
+		deliveredAt                  *time.Time
+		version                      int
+		createdAt, updatedAt         time.Time
+	)
+
+	err := row.Scan(&id, &tenantID, &subscriptionID, &eventID, &eventType, &payload, &statusStr,
+		&attempts, &nextAttemptAt, &lastStatusCode, &lastError, &deliveredAt,
+		&rawLog, &version, &createdAt, &updatedAt)
+	if err != nil {
+		return model.Delivery{}, err
+	}
+
+	status, err := valueobject.NewDeliveryStatus(statusStr)
+	if err != nil {
+		return model.Delivery{}, fmt.Errorf("invalid status in database: %w", err)
+	}
+	var records []attemptRecord
+	if err := json.Unmarshal(rawLog, &records); err != nil {
+		return model.Delivery{}, fmt.Errorf("invalid attempt log in database: %w", err)
+	}
+	log := make([]model.Attempt, 0, len(records))
+	for _, a := range records {
+		log = append(log, model.Attempt{
+			Number:      a.Number,
+			AttemptedAt: a.AttemptedAt,
+			StatusCode:  a.StatusCode,
+			Error:       a.Error,
+			Duration:    time.Duration(a.DurationMS) * time.Millisecond,
+		})
+	}
+
+	return model.ReconstructDelivery(
+		id, tenantID, subscriptionID, eventID, eventType, payload, status,
+		attempts, nextAttemptAt, lastStatusCode, lastError, deliveredAt,
+		log, version, createdAt, updatedAt,
+	), nil
+}
+
+func encodeAttemptLog(log []model.Attempt) ([]byte, error) {
+	records := make([]attemptRecord, 0, len(log))
+	for _, a := range log {
+		records = append(records, attemptRecord{
+			Number:      a.Number,
+			AttemptedAt: a.AttemptedAt,
+			StatusCode:  a.StatusCode,
+			Error:       a.Error,
+			DurationMS:  a.Duration.Milliseconds(),
+		})
+	}
+	b, err := json.Marshal(records)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode attempt log: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,18 @@
+-- Tenants' webhook subscriptions. secret and previous_secret sign payloads
+-- and must be readable by the service, so they are stored as issued.
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    url TEXT NOT NULL,
+    event_types TEXT[] NOT NULL,
+    description VARCHAR(256) NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    secret VARCHAR(100) NOT NULL,
+    previous_secret VARCHAR(100) NOT NULL DEFAULT '',
+    previous_secret_expires_at TIMESTAMPTZ,
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_webhook_subscriptions_tenant ON webhook_subscriptions (tenant_id, created_at);
@@ -0,0 +1,25 @@
+-- Deliveries of events to subscriptions, with their attempt logs. A
+-- subscription gets at most one delivery per event.
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    subscription_id UUID NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id VARCHAR(100) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'PENDING',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    last_status_code INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    delivered_at TIMESTAMPTZ,
+    attempt_log JSONB NOT NULL DEFAULT '[]',
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (subscription_id, event_id)
+);
+
+CREATE INDEX idx_webhook_deliveries_tenant_created ON webhook_deliveries (tenant_id, created_at DESC);
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at)
+    WHERE status = 'PENDING';
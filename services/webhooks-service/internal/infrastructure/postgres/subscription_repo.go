@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/model"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/port"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/valueobject"
+)
+
+const subscriptionColumns = `
+	id, tenant_id, url, event_types, description, active, secret,
+	previous_secret, previous_secret_expires_at, version, created_at, updated_at`
+
+// SubscriptionRepo is the PostgreSQL implementation of SubscriptionRepository.
+type SubscriptionRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewSubscriptionRepo creates a new SubscriptionRepo.
+func NewSubscriptionRepo(pool *pgxpool.Pool) *SubscriptionRepo {
+	return &SubscriptionRepo{pool: pool}
+}
+
+// Save persists the subscription with optimistic locking on its version.
+func (r *SubscriptionRepo) Save(ctx context.Context, s model.Subscription) error {
+	query := `
+		INSERT INTO webhook_subscriptions (` + subscriptionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (id) DO UPDATE SET
+			url = EXCLUDED.url,
+			event_types = EXCLUDED.event_types,
+			description = EXCLUDED.description,
+			active = EXCLUDED.active,
+			secret = EXCLUDED.secret,
+			previous_secret = EXCLUDED.previous_secret,
+			previous_secret_expires_at = EXCLUDED.previous_secret_expires_at,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE webhook_subscriptions.version = EXCLUDED.version - 1
+	`
+
+	result, err := r.pool.Exec(ctx, query,
+		s.ID(), s.TenantID(), s.URL().String(), s.EventTypeStrings(), s.Description(), s.Active(), s.Secret(),
+		s.PreviousSecret(), s.PreviousSecretExpiresAt(), s.Version(), s.CreatedAt(), s.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert webhook subscription: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("%w: subscription %s", port.ErrVersionConflict, s.ID())
+	}
+	return nil
+}
+
+// Delete removes a tenant's subscription; its deliveries cascade.
+func (r *SubscriptionRepo) Delete(ctx context.Context, tenantID, id uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM webhook_subscriptions WHERE id = $1 AND tenant_id = $2`, id, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return port.ErrSubscriptionNotFound
+	}
+	return nil
+}
+
+// FindByID retrieves a tenant's subscription.
+func (r *SubscriptionRepo) FindByID(ctx context.Context, tenantID, id uuid.UUID) (model.Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + ` FROM webhook_subscriptions WHERE id = $1 AND tenant_id = $2`
+
+	s, err := scanSubscription(r.pool.QueryRow(ctx, query, id, tenantID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Subscription{}, port.ErrSubscriptionNotFound
+	}
+	if err != nil {
+		return model.Subscription{}, fmt.Errorf("failed to scan webhook subscription: %w", err)
+	}
+	return s, nil
+}
+
+// List returns a page of a tenant's subscriptions and the total number of
+// them.
+func (r *SubscriptionRepo) List(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]model.Subscription, int, error) {
+	var total int
+	if err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM webhook_subscriptions WHERE tenant_id = $1`, tenantID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook subscriptions: %w", err)
+	}
+	subscriptions, err := r.query(ctx, `SELECT `+subscriptionColumns+` FROM webhook_subscriptions
+		WHERE tenant_id = $1
+		ORDER BY created_at
+		LIMIT $2 OFFSET $3
+	`, tenantID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	return subscriptions, total, nil
+}
+
+// ListActive returns all of a tenant's active subscriptions.
+func (r *SubscriptionRepo) ListActive(ctx context.Context, tenantID uuid.UUID) ([]model.Subscription, error) {
+	return r.query(ctx, `SELECT `+subscriptionColumns+` FROM webhook_subscriptions
+		WHERE tenant_id = $1 AND active
+		ORDER BY created_at
+	`, tenantID)
+}
+
+func (r *SubscriptionRepo) query(ctx context.Context, query string, args ...any) ([]model.Subscription, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var subscriptions []model.Subscription
+	for rows.Next() {
+		s, err := scanSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook subscription row: %w", err)
+		}
+		subscriptions = append(subscriptions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+	return subscriptions, nil
+}
+
+func scanSubscription(row pgx.Row) (model.Subscription, error) {
+	var (
+		id, tenantID            uuid.UUID
+		rawURL, description     string
+		eventTypes              []string
+		active                  bool
+		secret, previousSecret  string
+		previousSecretExpiresAt *time.Time
+		version                 int
+		createdAt, updatedAt    time.Time
+	)
+
+	err := row.Scan(&id, &tenantID, &rawURL, &eventTypes, &description, &active, &secret,
+		&previousSecret, &previousSecretExpiresAt, &version, &createdAt, &updatedAt)
+	if err != nil {
+		return model.Subscription{}, err
+	}
+
+	// The URL was validated when saved; whether plain HTTP is allowed then
+	// does not matter now.
+	url, err := valueobject.NewEndpointURL(rawURL, true)
+	if err != nil {
+		return model.Subscription{}, fmt.Errorf("invalid URL in database: %w", err)
+	}
+	filters := make([]valueobject.EventFilter, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		f, err := valueobject.NewEventFilter(t)
+		if err != nil {
+			return model.Subscription{}, fmt.Errorf("invalid event type filter in database: %w", err)
+		}
+		filters = append(filters, f)
+	}
+
+	return model.ReconstructSubscription(
+		id, tenantID, url, filters, description, active, secret, previousSecret,
+		previousSecretExpiresAt, version, createdAt, updatedAt,
+	), nil
+}
@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	grpclib "google.golang.org/grpc"
+
+	webhooksv1 "github.com/bibbank/bib/api/gen/go/bib/webhooks/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/pkg/contract"
+	"github.com/bibbank/bib/services/webhooks-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/event"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/model"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/port"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/valueobject"
+)
+
+// contractTenantID is the tenant the gateway's recorded calls act for.
+var contractTenantID = uuid.MustParse("8d4f6c1e-3a5b-4c2d-9e7f-1a2b3c4d5e6f")
+
+// contractSubscriptionRepo holds at most one subscription.
+type contractSubscriptionRepo struct {
+	subscription *model.Subscription
+}
+
+func (r *contractSubscriptionRepo) Save(context.Context, model.Subscription) error { return nil }
+
+func (r *contractSubscriptionRepo) Delete(context.Context, uuid.UUID, uuid.UUID) error { return nil }
+
+func (r *contractSubscriptionRepo) FindByID(context.Context, uuid.UUID, uuid.UUID) (model.Subscription, error) {
+	if r.subscription == nil {
+		return model.Subscription{}, port.ErrSubscriptionNotFound
+	}
+	return *r.subscription, nil
+}
+
+func (r *contractSubscriptionRepo) List(context.Context, uuid.UUID, int, int) ([]model.Subscription, int, error) {
+	return nil, 0, nil
+}
+
+func (r *contractSubscriptionRepo) ListActive(context.Context, uuid.UUID) ([]model.Subscription, error) {
+	return nil, nil
+}
+
+type discardPublisher struct{}
+
+func (discardPublisher) Publish(context.Context, []event.DomainEvent) error { return nil }
+
+// TestGatewayContract verifies the handler against the requests the gateway
+// sends and the responses it reads, as recorded in contracts/.
+func TestGatewayContract(t *testing.T) {
+	subscriptions := &contractSubscriptionRepo{}
+	h := NewHandler(
+		usecase.NewCreateSubscriptionUseCase(subscriptions, discardPublisher{}, false), nil, nil,
+		usecase.NewGetSubscriptionUseCase(subscriptions), nil, nil, nil, nil, nil,
+		slog.Default(),
+	)
+	conn := contract.Serve(t,
+		func(s *grpclib.Server) { webhooksv1.RegisterWebhooksServiceServer(s, h) },
+		grpclib.UnaryInterceptor(func(ctx context.Context, req any, _ *grpclib.UnaryServerInfo, handler grpclib.UnaryHandler) (any, error) {
+			return handler(auth.ContextWithClaims(ctx, &auth.Claims{
+				TenantID: contractTenantID,
+				Roles:    []string{auth.RoleAdmin},
+			}), req)
+		}),
+	)
+
+	contract.Verify(t, conn, "../../../../../contracts/gateway-webhooks-service.json", map[string]func(*testing.T){
+		"": func(*testing.T) { subscriptions.subscription = nil },
+		"an active subscription exists": func(t *testing.T) {
+			url, err := valueobject.NewEndpointURL("https://hooks.example.com/bib", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			filter, err := valueobject.NewEventFilter("payment.*")
+			if err != nil {
+				t.Fatal(err)
+			}
+			sub, err := model.NewSubscription(contractTenantID, url, []valueobject.EventFilter{filter}, "Payment updates", time.Now().UTC())
+			if err != nil {
+				t.Fatal(err)
+			}
+			subscriptions.subscription = &sub
+		},
+	})
+}
@@ -0,0 +1,448 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	webhooksv1 "github.com/bibbank/bib/api/gen/go/bib/webhooks/v1"
+	"github.com/bibbank/bib/pkg/auth"
+	"github.com/bibbank/bib/services/webhooks-service/internal/application/dto"
+	"github.com/bibbank/bib/services/webhooks-service/internal/application/usecase"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/port"
+)
+
+// staffRoles may read a tenant's subscriptions and delivery log;
+// managerRoles may change its subscriptions and secrets.
+var (
+	staffRoles   = []string{auth.RoleAdmin, auth.RoleOperator, auth.RoleAuditor, auth.RoleAPIClient}
+	managerRoles = []string{auth.RoleAdmin, auth.RoleAPIClient}
+)
+
+var statusToProto = map[string]webhooksv1.DeliveryStatus{
+	"PENDING":   webhooksv1.DeliveryStatus_DELIVERY_STATUS_PENDING,
+	"SUCCEEDED": webhooksv1.DeliveryStatus_DELIVERY_STATUS_SUCCEEDED,
+	"FAILED":    webhooksv1.DeliveryStatus_DELIVERY_STATUS_FAILED,
+}
+
+// requireRole checks that the caller has at least one of the given roles.
+func requireRole(ctx context.Context, roles ...string) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	for _, role := range roles {
+		if claims.HasRole(role) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "insufficient permissions")
+}
+
+// tenantIDFromContext extracts the tenant ID from JWT claims in the context.
+func tenantIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return claims.TenantID, nil
+}
+
+// optionalUUID parses an optional ID, returning uuid.Nil when it is empty.
+func optionalUUID(raw, field string) (uuid.UUID, error) {
+	if raw == "" {
+		return uuid.Nil, nil
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid %s", field)
+	}
+	return id, nil
+}
+
+// Compile-time assertion that Handler implements WebhooksServiceServer.
+var _ webhooksv1.WebhooksServiceServer = (*Handler)(nil)
+
+// Handler implements the WebhooksServiceServer gRPC interface.
+type Handler struct {
+	webhooksv1.UnimplementedWebhooksServiceServer
+	createSubscription *usecase.CreateSubscriptionUseCase
+	updateSubscription *usecase.UpdateSubscriptionUseCase
+	deleteSubscription *usecase.DeleteSubscriptionUseCase
+	getSubscription    *usecase.GetSubscriptionUseCase
+	listSubscriptions  *usecase.ListSubscriptionsUseCase
+	rotateSecret       *usecase.RotateSecretUseCase
+	getDelivery        *usecase.GetDeliveryUseCase
+	listDeliveries     *usecase.ListDeliveriesUseCase
+	redeliver          *usecase.RedeliverUseCase
+	logger             *slog.Logger
+}
+
+// NewHandler creates a new gRPC Handler.
+func NewHandler(
+	createSubscription *usecase.CreateSubscriptionUseCase,
+	updateSubscription *usecase.UpdateSubscriptionUseCase,
+	deleteSubscription *usecase.DeleteSubscriptionUseCase,
+	getSubscription *usecase.GetSubscriptionUseCase,
+	listSubscriptions *usecase.ListSubscriptionsUseCase,
+	rotateSecret *usecase.RotateSecretUseCase,
+	getDelivery *usecase.GetDeliveryUseCase,
+	listDeliveries *usecase.ListDeliveriesUseCase,
+	redeliver *usecase.RedeliverUseCase,
+	logger *slog.Logger,
+) *Handler {
+	return &Handler{
+		createSubscription: createSubscription,
+		updateSubscription: updateSubscription,
+		deleteSubscription: deleteSubscription,
+		getSubscription:    getSubscription,
+		listSubscriptions:  listSubscriptions,
+		rotateSecret:       rotateSecret,
+		getDelivery:        getDelivery,
+		listDeliveries:     listDeliveries,
+		redeliver:          redeliver,
+		logger:             logger,
+	}
+}
+
+// CreateSubscription subscribes an endpoint to the tenant's events.
+func (h *Handler) CreateSubscription(ctx context.Context, req *webhooksv1.CreateSubscriptionRequest) (*webhooksv1.CreateSubscriptionResponse, error) {
+	if err := requireRole(ctx, managerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.createSubscription.Execute(ctx, dto.CreateSubscriptionRequest{
+		TenantID:    tenantID,
+		URL:         req.Url,
+		EventTypes:  req.EventTypes,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &webhooksv1.CreateSubscriptionResponse{
+		Subscription: toSubscriptionMsg(resp.Subscription),
+		Secret:       resp.Secret,
+	}, nil
+}
+
+// UpdateSubscription changes a subscription's endpoint, filters and
+// description, or pauses or resumes it.
+func (h *Handler) UpdateSubscription(ctx context.Context, req *webhooksv1.UpdateSubscriptionRequest) (*webhooksv1.UpdateSubscriptionResponse, error) {
+	if err := requireRole(ctx, managerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	subscriptionID, err := uuid.Parse(req.SubscriptionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid subscription ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.updateSubscription.Execute(ctx, dto.UpdateSubscriptionRequest{
+		TenantID:       tenantID,
+		SubscriptionID: subscriptionID,
+		URL:            req.Url,
+		EventTypes:     req.EventTypes,
+		Description:    req.Description,
+		Active:         req.Active,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &webhooksv1.UpdateSubscriptionResponse{Subscription: toSubscriptionMsg(resp)}, nil
+}
+
+// DeleteSubscription removes a subscription and its delivery log.
+func (h *Handler) DeleteSubscription(ctx context.Context, req *webhooksv1.DeleteSubscriptionRequest) (*webhooksv1.DeleteSubscriptionResponse, error) {
+	if err := requireRole(ctx, managerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	subscriptionID, err := uuid.Parse(req.SubscriptionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid subscription ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.deleteSubscription.Execute(ctx, tenantID, subscriptionID); err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &webhooksv1.DeleteSubscriptionResponse{}, nil
+}
+
+// GetSubscription retrieves a subscription.
+func (h *Handler) GetSubscription(ctx context.Context, req *webhooksv1.GetSubscriptionRequest) (*webhooksv1.GetSubscriptionResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	subscriptionID, err := uuid.Parse(req.SubscriptionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid subscription ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.getSubscription.Execute(ctx, tenantID, subscriptionID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &webhooksv1.GetSubscriptionResponse{Subscription: toSubscriptionMsg(resp)}, nil
+}
+
+// ListSubscriptions lists the tenant's subscriptions, oldest first.
+func (h *Handler) ListSubscriptions(ctx context.Context, req *webhooksv1.ListSubscriptionsRequest) (*webhooksv1.ListSubscriptionsResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.listSubscriptions.Execute(ctx, dto.ListSubscriptionsRequest{
+		TenantID: tenantID,
+		Limit:    int(req.PageSize),
+		Offset:   int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &webhooksv1.ListSubscriptionsResponse{
+		Subscriptions: make([]*webhooksv1.Subscription, 0, len(resp.Subscriptions)),
+		TotalCount:    int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, s := range resp.Subscriptions {
+		out.Subscriptions = append(out.Subscriptions, toSubscriptionMsg(s))
+	}
+	return out, nil
+}
+
+// RotateSecret replaces a subscription's signing secret.
+func (h *Handler) RotateSecret(ctx context.Context, req *webhooksv1.RotateSecretRequest) (*webhooksv1.RotateSecretResponse, error) {
+	if err := requireRole(ctx, managerRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	subscriptionID, err := uuid.Parse(req.SubscriptionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid subscription ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	grace := usecase.DefaultSecretGracePeriod
+	if req.GracePeriodSeconds != nil {
+		grace = time.Duration(req.GetGracePeriodSeconds()) * time.Second
+	}
+
+	resp, err := h.rotateSecret.Execute(ctx, dto.RotateSecretRequest{
+		TenantID:       tenantID,
+		SubscriptionID: subscriptionID,
+		GracePeriod:    grace,
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &webhooksv1.RotateSecretResponse{
+		Subscription: toSubscriptionMsg(resp.Subscription),
+		Secret:       resp.Secret,
+	}, nil
+}
+
+// GetDelivery retrieves a delivery and its attempt log.
+func (h *Handler) GetDelivery(ctx context.Context, req *webhooksv1.GetDeliveryRequest) (*webhooksv1.GetDeliveryResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	deliveryID, err := uuid.Parse(req.DeliveryId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid delivery ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.getDelivery.Execute(ctx, tenantID, deliveryID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	return &webhooksv1.GetDeliveryResponse{Delivery: toDeliveryMsg(resp)}, nil
+}
+
+// ListDeliveries lists the tenant's deliveries, newest first.
+func (h *Handler) ListDeliveries(ctx context.Context, req *webhooksv1.ListDeliveriesRequest) (*webhooksv1.ListDeliveriesResponse, error) {
+	if err := requireRole(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	subscriptionID, err := optionalUUID(req.SubscriptionId, "subscription ID")
+	if err != nil {
+		return nil, err
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var deliveryStatus string
+	if req.Status != webhooksv1.DeliveryStatus_DELIVERY_STATUS_UNSPECIFIED {
+		deliveryStatus = strings.TrimPrefix(req.Status.String(), "DELIVERY_STATUS_")
+	}
+
+	resp, err := h.listDeliveries.Execute(ctx, dto.ListDeliveriesRequest{
+		TenantID:       tenantID,
+		SubscriptionID: subscriptionID,
+		Status:         deliveryStatus,
+		EventType:      req.EventType,
+		Limit:          int(req.PageSize),
+		Offset:         int(req.Offset),
+	})
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+
+	out := &webhooksv1.ListDeliveriesResponse{
+		Deliveries: make([]*webhooksv1.Delivery, 0, len(resp.Deliveries)),
+		TotalCount: int32(resp.TotalCount), //nolint:gosec // bounded by row count
+	}
+	for _, d := range resp.Deliveries {
+		out.Deliveries = append(out.Deliveries, toDeliveryMsg(d))
+	}
+	return out, nil
+}
+
+// Redeliver queues a finished delivery to be sent again now.
+func (h *Handler) Redeliver(ctx context.Context, req *webhooksv1.RedeliverRequest) (*webhooksv1.RedeliverResponse, error) {
+	if err := requireRole(ctx, auth.RoleAdmin, auth.RoleOperator, auth.RoleAPIClient); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	deliveryID, err := uuid.Parse(req.DeliveryId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid delivery ID")
+	}
+	tenantID, err := tenantIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.redeliver.Execute(ctx, tenantID, deliveryID)
+	if err != nil {
+		return nil, h.toStatus(err)
+	}
+	h.logger.Info("webhook delivery queued for redelivery", "delivery_id", resp.ID, "tenant_id", tenantID)
+	return &webhooksv1.RedeliverResponse{Delivery: toDeliveryMsg(resp)}, nil
+}
+
+func (h *Handler) toStatus(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidSubscription), errors.Is(err, usecase.ErrInvalidDeliveryFilter):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrDeliveryPending):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, port.ErrSubscriptionNotFound):
+		return status.Error(codes.NotFound, "webhook subscription not found")
+	case errors.Is(err, port.ErrDeliveryNotFound):
+		return status.Error(codes.NotFound, "webhook delivery not found")
+	case errors.Is(err, port.ErrVersionConflict):
+		return status.Error(codes.Aborted, "modified concurrently, retry")
+	default:
+		h.logger.Error("handler error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func toSubscriptionMsg(s dto.SubscriptionResponse) *webhooksv1.Subscription {
+	msg := &webhooksv1.Subscription{
+		SubscriptionId: s.ID.String(),
+		TenantId:       s.TenantID.String(),
+		Url:            s.URL,
+		EventTypes:     s.EventTypes,
+		Description:    s.Description,
+		Active:         s.Active,
+		Version:        int32(s.Version), //nolint:gosec // version counter
+		CreatedAt:      timestamppb.New(s.CreatedAt),
+		UpdatedAt:      timestamppb.New(s.UpdatedAt),
+	}
+	if s.PreviousSecretExpiresAt != nil {
+		msg.PreviousSecretExpiresAt = timestamppb.New(*s.PreviousSecretExpiresAt)
+	}
+	return msg
+}
+
+func toDeliveryMsg(d dto.DeliveryResponse) *webhooksv1.Delivery {
+	msg := &webhooksv1.Delivery{
+		DeliveryId:     d.ID.String(),
+		TenantId:       d.TenantID.String(),
+		SubscriptionId: d.SubscriptionID.String(),
+		EventId:        d.EventID,
+		EventType:      d.EventType,
+		Status:         statusToProto[d.Status],
+		Attempts:       int32(d.Attempts), //nolint:gosec // bounded by the retry policy
+		NextAttemptAt:  timestamppb.New(d.NextAttemptAt),
+		LastStatusCode: int32(d.LastStatusCode), //nolint:gosec // HTTP status code
+		LastError:      d.LastError,
+		AttemptLog:     make([]*webhooksv1.DeliveryAttempt, 0, len(d.AttemptLog)),
+		Payload:        string(d.Payload),
+		Version:        int32(d.Version), //nolint:gosec // version counter
+		CreatedAt:      timestamppb.New(d.CreatedAt),
+		UpdatedAt:      timestamppb.New(d.UpdatedAt),
+	}
+	if d.DeliveredAt != nil {
+		msg.DeliveredAt = timestamppb.New(*d.DeliveredAt)
+	}
+	for _, a := range d.AttemptLog {
+		msg.AttemptLog = append(msg.AttemptLog, &webhooksv1.DeliveryAttempt{
+			Number:      int32(a.Number), //nolint:gosec // attempt counter
+			AttemptedAt: timestamppb.New(a.AttemptedAt),
+			StatusCode:  int32(a.StatusCode), //nolint:gosec // HTTP status code
+			Error:       a.Error,
+			DurationMs:  a.Duration.Milliseconds(),
+		})
+	}
+	return msg
+}
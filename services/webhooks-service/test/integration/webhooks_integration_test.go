@@ -0,0 +1,142 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bibbank/bib/pkg/testutil"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/model"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/port"
+	"github.com/bibbank/bib/services/webhooks-service/internal/domain/valueobject"
+	"github.com/bibbank/bib/services/webhooks-service/internal/infrastructure/postgres"
+)
+
+func newSubscription(t *testing.T, tenantID uuid.UUID, now time.Time) model.Subscription {
+	t.Helper()
+	url, err := valueobject.NewEndpointURL("https://hooks.example.com/bib", false)
+	require.NoError(t, err)
+	filter, err := valueobject.NewEventFilter("card.*")
+	require.NoError(t, err)
+	s, err := model.NewSubscription(tenantID, url, []valueobject.EventFilter{filter}, "Card events", now)
+	require.NoError(t, err)
+	return s
+}
+
+func TestSubscriptionRepo_RotatedSecretRoundTrips(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	repo := postgres.NewSubscriptionRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	subscription := newSubscription(t, tenantID, now)
+	require.NoError(t, repo.Save(ctx, subscription))
+
+	rotated, err := subscription.RotateSecret(time.Hour, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, rotated))
+
+	// Saving the stale copy again conflicts on the version.
+	assert.ErrorIs(t, repo.Save(ctx, rotated), port.ErrVersionConflict)
+
+	found, err := repo.FindByID(ctx, tenantID, subscription.ID())
+	require.NoError(t, err)
+	assert.Equal(t, rotated.Secret(), found.Secret())
+	assert.Equal(t, subscription.Secret(), found.PreviousSecret())
+	require.NotNil(t, found.PreviousSecretExpiresAt())
+	assert.True(t, found.PreviousSecretExpiresAt().Equal(now.Add(time.Hour)))
+	assert.Equal(t, []string{"card.*"}, found.EventTypeStrings())
+	assert.True(t, found.Matches("card.issued"))
+
+	_, err = repo.FindByID(ctx, uuid.New(), subscription.ID())
+	assert.ErrorIs(t, err, port.ErrSubscriptionNotFound)
+}
+
+func TestDeliveryRepo_CreateIsIdempotentPerSubscriptionAndEvent(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	subscriptions := postgres.NewSubscriptionRepo(env.Pool())
+	repo := postgres.NewDeliveryRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	subscription := newSubscription(t, tenantID, now)
+	require.NoError(t, subscriptions.Save(ctx, subscription))
+
+	delivery, err := model.NewDelivery(tenantID, subscription.ID(), "evt-1", "card.issued", json.RawMessage(`{"card_id":"c1"}`), now)
+	require.NoError(t, err)
+	created, err := repo.Create(ctx, delivery)
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	redelivered, err := model.NewDelivery(tenantID, subscription.ID(), "evt-1", "card.issued", json.RawMessage(`{"card_id":"c1"}`), now)
+	require.NoError(t, err)
+	created, err = repo.Create(ctx, redelivered)
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	failed, err := delivery.Fail(model.Attempt{AttemptedAt: now, StatusCode: 500, Error: "internal server error", Duration: time.Second}, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, failed))
+
+	// Saving the stale copy again conflicts on the version.
+	assert.ErrorIs(t, repo.Save(ctx, failed), port.ErrVersionConflict)
+
+	found, err := repo.FindByID(ctx, tenantID, delivery.ID())
+	require.NoError(t, err)
+	assert.True(t, found.Status().Equal(valueobject.DeliveryStatusFailed))
+	assert.Equal(t, 500, found.LastStatusCode())
+	require.Len(t, found.AttemptLog(), 1)
+	assert.Equal(t, 1, found.AttemptLog()[0].Number)
+	assert.JSONEq(t, `{"card_id":"c1"}`, string(found.Payload()))
+
+	deliveries, total, err := repo.List(ctx, port.DeliveryFilter{TenantID: tenantID, Status: valueobject.DeliveryStatusFailed}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, deliveries, 1)
+
+	// Deleting the subscription removes its deliveries.
+	require.NoError(t, subscriptions.Delete(ctx, tenantID, subscription.ID()))
+	_, err = repo.FindByID(ctx, tenantID, delivery.ID())
+	assert.ErrorIs(t, err, port.ErrDeliveryNotFound)
+}
+
+func TestDeliveryRepo_ClaimDueLeasesPendingDeliveries(t *testing.T) {
+	env := testutil.NewEnv(t, testutil.EnvOptions{MigrationsDir: testutil.MigrationsDir()})
+	subscriptions := postgres.NewSubscriptionRepo(env.Pool())
+	repo := postgres.NewDeliveryRepo(env.Pool())
+	ctx := context.Background()
+	tenantID := uuid.New()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	subscription := newSubscription(t, tenantID, now)
+	require.NoError(t, subscriptions.Save(ctx, subscription))
+
+	delivery, err := model.NewDelivery(tenantID, subscription.ID(), "evt-1", "card.issued", json.RawMessage(`{}`), now)
+	require.NoError(t, err)
+	_, err = repo.Create(ctx, delivery)
+	require.NoError(t, err)
+
+	claimed, err := repo.ClaimDue(ctx, now, time.Minute, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, delivery.ID(), claimed[0].ID())
+	assert.True(t, claimed[0].NextAttemptAt().Equal(now.Add(time.Minute)))
+
+	// A leased delivery is not claimed again until the lease runs out.
+	claimed, err = repo.ClaimDue(ctx, now, time.Minute, 10)
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	claimed, err = repo.ClaimDue(ctx, now.Add(time.Minute), time.Minute, 10)
+	require.NoError(t, err)
+	assert.Len(t, claimed, 1)
+}